@@ -2,6 +2,7 @@
 package dexv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -64,12 +65,66 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*DenomFilter
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomFilter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomFilter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(DenomFilter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(DenomFilter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                  protoreflect.MessageDescriptor
-	fd_GenesisState_params           protoreflect.FieldDescriptor
-	fd_GenesisState_port_id          protoreflect.FieldDescriptor
-	fd_GenesisState_accounts         protoreflect.FieldDescriptor
-	fd_GenesisState_account_sequence protoreflect.FieldDescriptor
+	md_GenesisState                      protoreflect.MessageDescriptor
+	fd_GenesisState_params               protoreflect.FieldDescriptor
+	fd_GenesisState_port_id              protoreflect.FieldDescriptor
+	fd_GenesisState_accounts             protoreflect.FieldDescriptor
+	fd_GenesisState_account_sequence     protoreflect.FieldDescriptor
+	fd_GenesisState_batch_params         protoreflect.FieldDescriptor
+	fd_GenesisState_denom_filters        protoreflect.FieldDescriptor
+	fd_GenesisState_price_history_params protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +134,9 @@ func init() {
 	fd_GenesisState_port_id = md_GenesisState.Fields().ByName("port_id")
 	fd_GenesisState_accounts = md_GenesisState.Fields().ByName("accounts")
 	fd_GenesisState_account_sequence = md_GenesisState.Fields().ByName("account_sequence")
+	fd_GenesisState_batch_params = md_GenesisState.Fields().ByName("batch_params")
+	fd_GenesisState_denom_filters = md_GenesisState.Fields().ByName("denom_filters")
+	fd_GenesisState_price_history_params = md_GenesisState.Fields().ByName("price_history_params")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -170,6 +228,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.BatchParams != nil {
+		value := protoreflect.ValueOfMessage(x.BatchParams.ProtoReflect())
+		if !f(fd_GenesisState_batch_params, value) {
+			return
+		}
+	}
+	if len(x.DenomFilters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.DenomFilters})
+		if !f(fd_GenesisState_denom_filters, value) {
+			return
+		}
+	}
+	if x.PriceHistoryParams != nil {
+		value := protoreflect.ValueOfMessage(x.PriceHistoryParams.ProtoReflect())
+		if !f(fd_GenesisState_price_history_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -193,6 +269,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Accounts) != 0
 	case "dex.v1.GenesisState.account_sequence":
 		return x.AccountSequence != uint64(0)
+	case "dex.v1.GenesisState.batch_params":
+		return x.BatchParams != nil
+	case "dex.v1.GenesisState.denom_filters":
+		return len(x.DenomFilters) != 0
+	case "dex.v1.GenesisState.price_history_params":
+		return x.PriceHistoryParams != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.GenesisState"))
@@ -217,6 +299,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Accounts = nil
 	case "dex.v1.GenesisState.account_sequence":
 		x.AccountSequence = uint64(0)
+	case "dex.v1.GenesisState.batch_params":
+		x.BatchParams = nil
+	case "dex.v1.GenesisState.denom_filters":
+		x.DenomFilters = nil
+	case "dex.v1.GenesisState.price_history_params":
+		x.PriceHistoryParams = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.GenesisState"))
@@ -248,6 +336,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "dex.v1.GenesisState.account_sequence":
 		value := x.AccountSequence
 		return protoreflect.ValueOfUint64(value)
+	case "dex.v1.GenesisState.batch_params":
+		value := x.BatchParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dex.v1.GenesisState.denom_filters":
+		if len(x.DenomFilters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.DenomFilters}
+		return protoreflect.ValueOfList(listValue)
+	case "dex.v1.GenesisState.price_history_params":
+		value := x.PriceHistoryParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.GenesisState"))
@@ -278,6 +378,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Accounts = *clv.list
 	case "dex.v1.GenesisState.account_sequence":
 		x.AccountSequence = value.Uint()
+	case "dex.v1.GenesisState.batch_params":
+		x.BatchParams = value.Message().Interface().(*BatchParams)
+	case "dex.v1.GenesisState.denom_filters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.DenomFilters = *clv.list
+	case "dex.v1.GenesisState.price_history_params":
+		x.PriceHistoryParams = value.Message().Interface().(*PriceHistoryParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.GenesisState"))
@@ -309,6 +417,22 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.Accounts}
 		return protoreflect.ValueOfList(value)
+	case "dex.v1.GenesisState.batch_params":
+		if x.BatchParams == nil {
+			x.BatchParams = new(BatchParams)
+		}
+		return protoreflect.ValueOfMessage(x.BatchParams.ProtoReflect())
+	case "dex.v1.GenesisState.denom_filters":
+		if x.DenomFilters == nil {
+			x.DenomFilters = []*DenomFilter{}
+		}
+		value := &_GenesisState_6_list{list: &x.DenomFilters}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.GenesisState.price_history_params":
+		if x.PriceHistoryParams == nil {
+			x.PriceHistoryParams = new(PriceHistoryParams)
+		}
+		return protoreflect.ValueOfMessage(x.PriceHistoryParams.ProtoReflect())
 	case "dex.v1.GenesisState.port_id":
 		panic(fmt.Errorf("field port_id of message dex.v1.GenesisState is not mutable"))
 	case "dex.v1.GenesisState.account_sequence":
@@ -336,6 +460,15 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
 	case "dex.v1.GenesisState.account_sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "dex.v1.GenesisState.batch_params":
+		m := new(BatchParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dex.v1.GenesisState.denom_filters":
+		list := []*DenomFilter{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "dex.v1.GenesisState.price_history_params":
+		m := new(PriceHistoryParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.GenesisState"))
@@ -422,6 +555,20 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.AccountSequence != 0 {
 			n += 1 + runtime.Sov(uint64(x.AccountSequence))
 		}
+		if x.BatchParams != nil {
+			l = options.Size(x.BatchParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DenomFilters) > 0 {
+			for _, e := range x.DenomFilters {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PriceHistoryParams != nil {
+			l = options.Size(x.PriceHistoryParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -451,6 +598,50 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PriceHistoryParams != nil {
+			encoded, err := options.Marshal(x.PriceHistoryParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.DenomFilters) > 0 {
+			for iNdEx := len(x.DenomFilters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomFilters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.BatchParams != nil {
+			encoded, err := options.Marshal(x.BatchParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.AccountSequence != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountSequence))
 			i--
@@ -663,6 +854,112 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BatchParams == nil {
+					x.BatchParams = &BatchParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BatchParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomFilters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomFilters = append(x.DenomFilters, &DenomFilter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomFilters[len(x.DenomFilters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PriceHistoryParams == nil {
+					x.PriceHistoryParams = &PriceHistoryParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceHistoryParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -744,6 +1041,57 @@ func (x *_Params_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*NobleRoute
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NobleRoute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NobleRoute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(NobleRoute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(NobleRoute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                         protoreflect.MessageDescriptor
 	fd_Params_enabled                 protoreflect.FieldDescriptor
@@ -754,6 +1102,13 @@ var (
 	fd_Params_max_daily_volume        protoreflect.FieldDescriptor
 	fd_Params_rate_limits             protoreflect.FieldDescriptor
 	fd_Params_fees                    protoreflect.FieldDescriptor
+	fd_Params_noble_routes            protoreflect.FieldDescriptor
+	fd_Params_pricing                 protoreflect.FieldDescriptor
+	fd_Params_routing                 protoreflect.FieldDescriptor
+	fd_Params_order_monitor           protoreflect.FieldDescriptor
+	fd_Params_strict_ucan             protoreflect.FieldDescriptor
+	fd_Params_screening               protoreflect.FieldDescriptor
+	fd_Params_arbitrage               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -767,6 +1122,13 @@ func init() {
 	fd_Params_max_daily_volume = md_Params.Fields().ByName("max_daily_volume")
 	fd_Params_rate_limits = md_Params.Fields().ByName("rate_limits")
 	fd_Params_fees = md_Params.Fields().ByName("fees")
+	fd_Params_noble_routes = md_Params.Fields().ByName("noble_routes")
+	fd_Params_pricing = md_Params.Fields().ByName("pricing")
+	fd_Params_routing = md_Params.Fields().ByName("routing")
+	fd_Params_order_monitor = md_Params.Fields().ByName("order_monitor")
+	fd_Params_strict_ucan = md_Params.Fields().ByName("strict_ucan")
+	fd_Params_screening = md_Params.Fields().ByName("screening")
+	fd_Params_arbitrage = md_Params.Fields().ByName("arbitrage")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -882,6 +1244,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.NobleRoutes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.NobleRoutes})
+		if !f(fd_Params_noble_routes, value) {
+			return
+		}
+	}
+	if x.Pricing != nil {
+		value := protoreflect.ValueOfMessage(x.Pricing.ProtoReflect())
+		if !f(fd_Params_pricing, value) {
+			return
+		}
+	}
+	if x.Routing != nil {
+		value := protoreflect.ValueOfMessage(x.Routing.ProtoReflect())
+		if !f(fd_Params_routing, value) {
+			return
+		}
+	}
+	if x.OrderMonitor != nil {
+		value := protoreflect.ValueOfMessage(x.OrderMonitor.ProtoReflect())
+		if !f(fd_Params_order_monitor, value) {
+			return
+		}
+	}
+	if x.StrictUcan != false {
+		value := protoreflect.ValueOfBool(x.StrictUcan)
+		if !f(fd_Params_strict_ucan, value) {
+			return
+		}
+	}
+	if x.Screening != nil {
+		value := protoreflect.ValueOfMessage(x.Screening.ProtoReflect())
+		if !f(fd_Params_screening, value) {
+			return
+		}
+	}
+	if x.Arbitrage != nil {
+		value := protoreflect.ValueOfMessage(x.Arbitrage.ProtoReflect())
+		if !f(fd_Params_arbitrage, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -913,6 +1317,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.RateLimits != nil
 	case "dex.v1.Params.fees":
 		return x.Fees != nil
+	case "dex.v1.Params.noble_routes":
+		return len(x.NobleRoutes) != 0
+	case "dex.v1.Params.pricing":
+		return x.Pricing != nil
+	case "dex.v1.Params.routing":
+		return x.Routing != nil
+	case "dex.v1.Params.order_monitor":
+		return x.OrderMonitor != nil
+	case "dex.v1.Params.strict_ucan":
+		return x.StrictUcan != false
+	case "dex.v1.Params.screening":
+		return x.Screening != nil
+	case "dex.v1.Params.arbitrage":
+		return x.Arbitrage != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
@@ -945,6 +1363,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.RateLimits = nil
 	case "dex.v1.Params.fees":
 		x.Fees = nil
+	case "dex.v1.Params.noble_routes":
+		x.NobleRoutes = nil
+	case "dex.v1.Params.pricing":
+		x.Pricing = nil
+	case "dex.v1.Params.routing":
+		x.Routing = nil
+	case "dex.v1.Params.order_monitor":
+		x.OrderMonitor = nil
+	case "dex.v1.Params.strict_ucan":
+		x.StrictUcan = false
+	case "dex.v1.Params.screening":
+		x.Screening = nil
+	case "dex.v1.Params.arbitrage":
+		x.Arbitrage = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
@@ -988,11 +1420,35 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "dex.v1.Params.fees":
 		value := x.Fees
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
+	case "dex.v1.Params.noble_routes":
+		if len(x.NobleRoutes) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
 		}
-		panic(fmt.Errorf("message dex.v1.Params does not contain field %s", descriptor.FullName()))
+		listValue := &_Params_9_list{list: &x.NobleRoutes}
+		return protoreflect.ValueOfList(listValue)
+	case "dex.v1.Params.pricing":
+		value := x.Pricing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dex.v1.Params.routing":
+		value := x.Routing
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dex.v1.Params.order_monitor":
+		value := x.OrderMonitor
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dex.v1.Params.strict_ucan":
+		value := x.StrictUcan
+		return protoreflect.ValueOfBool(value)
+	case "dex.v1.Params.screening":
+		value := x.Screening
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dex.v1.Params.arbitrage":
+		value := x.Arbitrage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
+		}
+		panic(fmt.Errorf("message dex.v1.Params does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1026,6 +1482,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.RateLimits = value.Message().Interface().(*RateLimitParams)
 	case "dex.v1.Params.fees":
 		x.Fees = value.Message().Interface().(*FeeParams)
+	case "dex.v1.Params.noble_routes":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.NobleRoutes = *clv.list
+	case "dex.v1.Params.pricing":
+		x.Pricing = value.Message().Interface().(*PricingParams)
+	case "dex.v1.Params.routing":
+		x.Routing = value.Message().Interface().(*RoutingParams)
+	case "dex.v1.Params.order_monitor":
+		x.OrderMonitor = value.Message().Interface().(*OrderMonitorParams)
+	case "dex.v1.Params.strict_ucan":
+		x.StrictUcan = value.Bool()
+	case "dex.v1.Params.screening":
+		x.Screening = value.Message().Interface().(*ScreeningParams)
+	case "dex.v1.Params.arbitrage":
+		x.Arbitrage = value.Message().Interface().(*ArbitrageParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
@@ -1062,6 +1534,37 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.Fees = new(FeeParams)
 		}
 		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+	case "dex.v1.Params.noble_routes":
+		if x.NobleRoutes == nil {
+			x.NobleRoutes = []*NobleRoute{}
+		}
+		value := &_Params_9_list{list: &x.NobleRoutes}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.Params.pricing":
+		if x.Pricing == nil {
+			x.Pricing = new(PricingParams)
+		}
+		return protoreflect.ValueOfMessage(x.Pricing.ProtoReflect())
+	case "dex.v1.Params.routing":
+		if x.Routing == nil {
+			x.Routing = new(RoutingParams)
+		}
+		return protoreflect.ValueOfMessage(x.Routing.ProtoReflect())
+	case "dex.v1.Params.order_monitor":
+		if x.OrderMonitor == nil {
+			x.OrderMonitor = new(OrderMonitorParams)
+		}
+		return protoreflect.ValueOfMessage(x.OrderMonitor.ProtoReflect())
+	case "dex.v1.Params.screening":
+		if x.Screening == nil {
+			x.Screening = new(ScreeningParams)
+		}
+		return protoreflect.ValueOfMessage(x.Screening.ProtoReflect())
+	case "dex.v1.Params.arbitrage":
+		if x.Arbitrage == nil {
+			x.Arbitrage = new(ArbitrageParams)
+		}
+		return protoreflect.ValueOfMessage(x.Arbitrage.ProtoReflect())
 	case "dex.v1.Params.enabled":
 		panic(fmt.Errorf("field enabled of message dex.v1.Params is not mutable"))
 	case "dex.v1.Params.max_accounts_per_did":
@@ -1072,6 +1575,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_swap_amount of message dex.v1.Params is not mutable"))
 	case "dex.v1.Params.max_daily_volume":
 		panic(fmt.Errorf("field max_daily_volume of message dex.v1.Params is not mutable"))
+	case "dex.v1.Params.strict_ucan":
+		panic(fmt.Errorf("field strict_ucan of message dex.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
@@ -1104,6 +1609,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "dex.v1.Params.fees":
 		m := new(FeeParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dex.v1.Params.noble_routes":
+		list := []*NobleRoute{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "dex.v1.Params.pricing":
+		m := new(PricingParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dex.v1.Params.routing":
+		m := new(RoutingParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dex.v1.Params.order_monitor":
+		m := new(OrderMonitorParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dex.v1.Params.strict_ucan":
+		return protoreflect.ValueOfBool(false)
+	case "dex.v1.Params.screening":
+		m := new(ScreeningParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dex.v1.Params.arbitrage":
+		m := new(ArbitrageParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.Params"))
@@ -1204,6 +1729,35 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Fees)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.NobleRoutes) > 0 {
+			for _, e := range x.NobleRoutes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pricing != nil {
+			l = options.Size(x.Pricing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Routing != nil {
+			l = options.Size(x.Routing)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OrderMonitor != nil {
+			l = options.Size(x.OrderMonitor)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StrictUcan {
+			n += 2
+		}
+		if x.Screening != nil {
+			l = options.Size(x.Screening)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Arbitrage != nil {
+			l = options.Size(x.Arbitrage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1233,6 +1787,102 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Arbitrage != nil {
+			encoded, err := options.Marshal(x.Arbitrage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.Screening != nil {
+			encoded, err := options.Marshal(x.Screening)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.StrictUcan {
+			i--
+			if x.StrictUcan {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.OrderMonitor != nil {
+			encoded, err := options.Marshal(x.OrderMonitor)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Routing != nil {
+			encoded, err := options.Marshal(x.Routing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Pricing != nil {
+			encoded, err := options.Marshal(x.Pricing)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.NobleRoutes) > 0 {
+			for iNdEx := len(x.NobleRoutes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NobleRoutes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if x.Fees != nil {
 			encoded, err := options.Marshal(x.Fees)
 			if err != nil {
@@ -1579,107 +2229,343 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NobleRoutes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NobleRoutes = append(x.NobleRoutes, &NobleRoute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NobleRoutes[len(x.NobleRoutes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pricing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.Pricing == nil {
+					x.Pricing = &PricingParams{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_RateLimitParams                         protoreflect.MessageDescriptor
-	fd_RateLimitParams_max_ops_per_block       protoreflect.FieldDescriptor
-	fd_RateLimitParams_max_ops_per_did_per_day protoreflect.FieldDescriptor
-	fd_RateLimitParams_cooldown_blocks         protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_dex_v1_genesis_proto_init()
-	md_RateLimitParams = File_dex_v1_genesis_proto.Messages().ByName("RateLimitParams")
-	fd_RateLimitParams_max_ops_per_block = md_RateLimitParams.Fields().ByName("max_ops_per_block")
-	fd_RateLimitParams_max_ops_per_did_per_day = md_RateLimitParams.Fields().ByName("max_ops_per_did_per_day")
-	fd_RateLimitParams_cooldown_blocks = md_RateLimitParams.Fields().ByName("cooldown_blocks")
-}
-
-var _ protoreflect.Message = (*fastReflection_RateLimitParams)(nil)
-
-type fastReflection_RateLimitParams RateLimitParams
-
-func (x *RateLimitParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RateLimitParams)(x)
-}
-
-func (x *RateLimitParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_dex_v1_genesis_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_RateLimitParams_messageType fastReflection_RateLimitParams_messageType
-var _ protoreflect.MessageType = fastReflection_RateLimitParams_messageType{}
-
-type fastReflection_RateLimitParams_messageType struct{}
-
-func (x fastReflection_RateLimitParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RateLimitParams)(nil)
-}
-func (x fastReflection_RateLimitParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_RateLimitParams)
-}
-func (x fastReflection_RateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RateLimitParams
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_RateLimitParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_RateLimitParams
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RateLimitParams) Type() protoreflect.MessageType {
-	return _fastReflection_RateLimitParams_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RateLimitParams) New() protoreflect.Message {
-	return new(fastReflection_RateLimitParams)
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pricing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Routing == nil {
+					x.Routing = &RoutingParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Routing); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderMonitor", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OrderMonitor == nil {
+					x.OrderMonitor = &OrderMonitorParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrderMonitor); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrictUcan", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.StrictUcan = bool(v != 0)
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Screening", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Screening == nil {
+					x.Screening = &ScreeningParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Screening); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Arbitrage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Arbitrage == nil {
+					x.Arbitrage = &ArbitrageParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Arbitrage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RateLimitParams                         protoreflect.MessageDescriptor
+	fd_RateLimitParams_max_ops_per_block       protoreflect.FieldDescriptor
+	fd_RateLimitParams_max_ops_per_did_per_day protoreflect.FieldDescriptor
+	fd_RateLimitParams_cooldown_blocks         protoreflect.FieldDescriptor
+	fd_RateLimitParams_verified_multiplier     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_RateLimitParams = File_dex_v1_genesis_proto.Messages().ByName("RateLimitParams")
+	fd_RateLimitParams_max_ops_per_block = md_RateLimitParams.Fields().ByName("max_ops_per_block")
+	fd_RateLimitParams_max_ops_per_did_per_day = md_RateLimitParams.Fields().ByName("max_ops_per_did_per_day")
+	fd_RateLimitParams_cooldown_blocks = md_RateLimitParams.Fields().ByName("cooldown_blocks")
+	fd_RateLimitParams_verified_multiplier = md_RateLimitParams.Fields().ByName("verified_multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_RateLimitParams)(nil)
+
+type fastReflection_RateLimitParams RateLimitParams
+
+func (x *RateLimitParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RateLimitParams)(x)
+}
+
+func (x *RateLimitParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RateLimitParams_messageType fastReflection_RateLimitParams_messageType
+var _ protoreflect.MessageType = fastReflection_RateLimitParams_messageType{}
+
+type fastReflection_RateLimitParams_messageType struct{}
+
+func (x fastReflection_RateLimitParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RateLimitParams)(nil)
+}
+func (x fastReflection_RateLimitParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_RateLimitParams)
+}
+func (x fastReflection_RateLimitParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RateLimitParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_RateLimitParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RateLimitParams) Type() protoreflect.MessageType {
+	return _fastReflection_RateLimitParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RateLimitParams) New() protoreflect.Message {
+	return new(fastReflection_RateLimitParams)
 }
 
 // Interface unwraps the message reflection interface and
@@ -1712,6 +2598,12 @@ func (x *fastReflection_RateLimitParams) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.VerifiedMultiplier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VerifiedMultiplier)
+		if !f(fd_RateLimitParams_verified_multiplier, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1733,6 +2625,8 @@ func (x *fastReflection_RateLimitParams) Has(fd protoreflect.FieldDescriptor) bo
 		return x.MaxOpsPerDidPerDay != uint32(0)
 	case "dex.v1.RateLimitParams.cooldown_blocks":
 		return x.CooldownBlocks != uint32(0)
+	case "dex.v1.RateLimitParams.verified_multiplier":
+		return x.VerifiedMultiplier != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RateLimitParams"))
@@ -1755,6 +2649,8 @@ func (x *fastReflection_RateLimitParams) Clear(fd protoreflect.FieldDescriptor)
 		x.MaxOpsPerDidPerDay = uint32(0)
 	case "dex.v1.RateLimitParams.cooldown_blocks":
 		x.CooldownBlocks = uint32(0)
+	case "dex.v1.RateLimitParams.verified_multiplier":
+		x.VerifiedMultiplier = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RateLimitParams"))
@@ -1780,6 +2676,9 @@ func (x *fastReflection_RateLimitParams) Get(descriptor protoreflect.FieldDescri
 	case "dex.v1.RateLimitParams.cooldown_blocks":
 		value := x.CooldownBlocks
 		return protoreflect.ValueOfUint32(value)
+	case "dex.v1.RateLimitParams.verified_multiplier":
+		value := x.VerifiedMultiplier
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RateLimitParams"))
@@ -1806,6 +2705,8 @@ func (x *fastReflection_RateLimitParams) Set(fd protoreflect.FieldDescriptor, va
 		x.MaxOpsPerDidPerDay = uint32(value.Uint())
 	case "dex.v1.RateLimitParams.cooldown_blocks":
 		x.CooldownBlocks = uint32(value.Uint())
+	case "dex.v1.RateLimitParams.verified_multiplier":
+		x.VerifiedMultiplier = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RateLimitParams"))
@@ -1832,6 +2733,8 @@ func (x *fastReflection_RateLimitParams) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field max_ops_per_did_per_day of message dex.v1.RateLimitParams is not mutable"))
 	case "dex.v1.RateLimitParams.cooldown_blocks":
 		panic(fmt.Errorf("field cooldown_blocks of message dex.v1.RateLimitParams is not mutable"))
+	case "dex.v1.RateLimitParams.verified_multiplier":
+		panic(fmt.Errorf("field verified_multiplier of message dex.v1.RateLimitParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RateLimitParams"))
@@ -1851,6 +2754,8 @@ func (x *fastReflection_RateLimitParams) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "dex.v1.RateLimitParams.cooldown_blocks":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "dex.v1.RateLimitParams.verified_multiplier":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RateLimitParams"))
@@ -1929,6 +2834,9 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 		if x.CooldownBlocks != 0 {
 			n += 1 + runtime.Sov(uint64(x.CooldownBlocks))
 		}
+		if x.VerifiedMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifiedMultiplier))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1958,6 +2866,11 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VerifiedMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifiedMultiplier))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.CooldownBlocks != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.CooldownBlocks))
 			i--
@@ -2079,8 +2992,27 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiedMultiplier", wireType)
+				}
+				x.VerifiedMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifiedMultiplier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
 				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -2114,12 +3046,63 @@ func (x *fastReflection_RateLimitParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_FeeParams_5_list)(nil)
+
+type _FeeParams_5_list struct {
+	list *[]*FeeCollector
+}
+
+func (x *_FeeParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeCollector)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeCollector)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(FeeCollector)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeParams_5_list) NewElement() protoreflect.Value {
+	v := new(FeeCollector)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_FeeParams                   protoreflect.MessageDescriptor
 	fd_FeeParams_swap_fee_bps      protoreflect.FieldDescriptor
 	fd_FeeParams_liquidity_fee_bps protoreflect.FieldDescriptor
 	fd_FeeParams_order_fee_bps     protoreflect.FieldDescriptor
-	fd_FeeParams_fee_collector     protoreflect.FieldDescriptor
+	fd_FeeParams_fee_collectors    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2128,7 +3111,7 @@ func init() {
 	fd_FeeParams_swap_fee_bps = md_FeeParams.Fields().ByName("swap_fee_bps")
 	fd_FeeParams_liquidity_fee_bps = md_FeeParams.Fields().ByName("liquidity_fee_bps")
 	fd_FeeParams_order_fee_bps = md_FeeParams.Fields().ByName("order_fee_bps")
-	fd_FeeParams_fee_collector = md_FeeParams.Fields().ByName("fee_collector")
+	fd_FeeParams_fee_collectors = md_FeeParams.Fields().ByName("fee_collectors")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeeParams)(nil)
@@ -2214,9 +3197,9 @@ func (x *fastReflection_FeeParams) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
-	if x.FeeCollector != "" {
-		value := protoreflect.ValueOfString(x.FeeCollector)
-		if !f(fd_FeeParams_fee_collector, value) {
+	if len(x.FeeCollectors) != 0 {
+		value := protoreflect.ValueOfList(&_FeeParams_5_list{list: &x.FeeCollectors})
+		if !f(fd_FeeParams_fee_collectors, value) {
 			return
 		}
 	}
@@ -2241,8 +3224,8 @@ func (x *fastReflection_FeeParams) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.LiquidityFeeBps != uint32(0)
 	case "dex.v1.FeeParams.order_fee_bps":
 		return x.OrderFeeBps != uint32(0)
-	case "dex.v1.FeeParams.fee_collector":
-		return x.FeeCollector != ""
+	case "dex.v1.FeeParams.fee_collectors":
+		return len(x.FeeCollectors) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeParams"))
@@ -2265,8 +3248,8 @@ func (x *fastReflection_FeeParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.LiquidityFeeBps = uint32(0)
 	case "dex.v1.FeeParams.order_fee_bps":
 		x.OrderFeeBps = uint32(0)
-	case "dex.v1.FeeParams.fee_collector":
-		x.FeeCollector = ""
+	case "dex.v1.FeeParams.fee_collectors":
+		x.FeeCollectors = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeParams"))
@@ -2292,9 +3275,12 @@ func (x *fastReflection_FeeParams) Get(descriptor protoreflect.FieldDescriptor)
 	case "dex.v1.FeeParams.order_fee_bps":
 		value := x.OrderFeeBps
 		return protoreflect.ValueOfUint32(value)
-	case "dex.v1.FeeParams.fee_collector":
-		value := x.FeeCollector
-		return protoreflect.ValueOfString(value)
+	case "dex.v1.FeeParams.fee_collectors":
+		if len(x.FeeCollectors) == 0 {
+			return protoreflect.ValueOfList(&_FeeParams_5_list{})
+		}
+		listValue := &_FeeParams_5_list{list: &x.FeeCollectors}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeParams"))
@@ -2321,8 +3307,10 @@ func (x *fastReflection_FeeParams) Set(fd protoreflect.FieldDescriptor, value pr
 		x.LiquidityFeeBps = uint32(value.Uint())
 	case "dex.v1.FeeParams.order_fee_bps":
 		x.OrderFeeBps = uint32(value.Uint())
-	case "dex.v1.FeeParams.fee_collector":
-		x.FeeCollector = value.Interface().(string)
+	case "dex.v1.FeeParams.fee_collectors":
+		lv := value.List()
+		clv := lv.(*_FeeParams_5_list)
+		x.FeeCollectors = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeParams"))
@@ -2343,14 +3331,18 @@ func (x *fastReflection_FeeParams) Set(fd protoreflect.FieldDescriptor, value pr
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_FeeParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "dex.v1.FeeParams.fee_collectors":
+		if x.FeeCollectors == nil {
+			x.FeeCollectors = []*FeeCollector{}
+		}
+		value := &_FeeParams_5_list{list: &x.FeeCollectors}
+		return protoreflect.ValueOfList(value)
 	case "dex.v1.FeeParams.swap_fee_bps":
 		panic(fmt.Errorf("field swap_fee_bps of message dex.v1.FeeParams is not mutable"))
 	case "dex.v1.FeeParams.liquidity_fee_bps":
 		panic(fmt.Errorf("field liquidity_fee_bps of message dex.v1.FeeParams is not mutable"))
 	case "dex.v1.FeeParams.order_fee_bps":
 		panic(fmt.Errorf("field order_fee_bps of message dex.v1.FeeParams is not mutable"))
-	case "dex.v1.FeeParams.fee_collector":
-		panic(fmt.Errorf("field fee_collector of message dex.v1.FeeParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeParams"))
@@ -2370,8 +3362,9 @@ func (x *fastReflection_FeeParams) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "dex.v1.FeeParams.order_fee_bps":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "dex.v1.FeeParams.fee_collector":
-		return protoreflect.ValueOfString("")
+	case "dex.v1.FeeParams.fee_collectors":
+		list := []*FeeCollector{}
+		return protoreflect.ValueOfList(&_FeeParams_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeParams"))
@@ -2450,9 +3443,11 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 		if x.OrderFeeBps != 0 {
 			n += 1 + runtime.Sov(uint64(x.OrderFeeBps))
 		}
-		l = len(x.FeeCollector)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.FeeCollectors) > 0 {
+			for _, e := range x.FeeCollectors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2483,12 +3478,21 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FeeCollector) > 0 {
-			i -= len(x.FeeCollector)
-			copy(dAtA[i:], x.FeeCollector)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeCollector)))
-			i--
-			dAtA[i] = 0x22
+		if len(x.FeeCollectors) > 0 {
+			for iNdEx := len(x.FeeCollectors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeCollectors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
 		}
 		if x.OrderFeeBps != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderFeeBps))
@@ -2611,11 +3615,11 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 4:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCollectors", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2625,23 +3629,25 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FeeCollector = string(dAtA[iNdEx:postIndex])
+				x.FeeCollectors = append(x.FeeCollectors, &FeeCollector{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeCollectors[len(x.FeeCollectors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2678,310 +3684,7891 @@ func (x *fastReflection_FeeParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: dex/v1/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_FeeCollector               protoreflect.MessageDescriptor
+	fd_FeeCollector_connection_id protoreflect.FieldDescriptor
+	fd_FeeCollector_address       protoreflect.FieldDescriptor
 )
 
-// GenesisState defines the DEX module's genesis state
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Module parameters
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	// IBC port ID for the module
-	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	// Registered DEX accounts
-	Accounts []*InterchainDEXAccount `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	// Account sequence counter
-	AccountSequence uint64 `protobuf:"varint,4,opt,name=account_sequence,json=accountSequence,proto3" json:"account_sequence,omitempty"`
-}
-
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_dex_v1_genesis_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_FeeCollector = File_dex_v1_genesis_proto.Messages().ByName("FeeCollector")
+	fd_FeeCollector_connection_id = md_FeeCollector.Fields().ByName("connection_id")
+	fd_FeeCollector_address = md_FeeCollector.Fields().ByName("address")
 }
 
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _ protoreflect.Message = (*fastReflection_FeeCollector)(nil)
 
-func (*GenesisState) ProtoMessage() {}
+type fastReflection_FeeCollector FeeCollector
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{0}
+func (x *FeeCollector) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeCollector)(x)
 }
 
-func (x *GenesisState) GetParams() *Params {
-	if x != nil {
-		return x.Params
+func (x *FeeCollector) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GenesisState) GetPortId() string {
-	if x != nil {
-		return x.PortId
-	}
-	return ""
-}
+var _fastReflection_FeeCollector_messageType fastReflection_FeeCollector_messageType
+var _ protoreflect.MessageType = fastReflection_FeeCollector_messageType{}
 
-func (x *GenesisState) GetAccounts() []*InterchainDEXAccount {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
+type fastReflection_FeeCollector_messageType struct{}
 
-func (x *GenesisState) GetAccountSequence() uint64 {
-	if x != nil {
-		return x.AccountSequence
-	}
-	return 0
+func (x fastReflection_FeeCollector_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeCollector)(nil)
 }
-
-// Params defines the parameters for the DEX module
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Enable/disable the module
-	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// Maximum accounts per DID
-	MaxAccountsPerDid uint32 `protobuf:"varint,2,opt,name=max_accounts_per_did,json=maxAccountsPerDid,proto3" json:"max_accounts_per_did,omitempty"`
-	// Default timeout for ICA operations (in seconds)
-	DefaultTimeoutSeconds uint64 `protobuf:"varint,3,opt,name=default_timeout_seconds,json=defaultTimeoutSeconds,proto3" json:"default_timeout_seconds,omitempty"`
-	// Allowed DEX connections
-	AllowedConnections []string `protobuf:"bytes,4,rep,name=allowed_connections,json=allowedConnections,proto3" json:"allowed_connections,omitempty"`
-	// Minimum swap amount (in base denom)
-	MinSwapAmount string `protobuf:"bytes,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	// Maximum daily volume per DID (in USD equivalent)
-	MaxDailyVolume string `protobuf:"bytes,6,opt,name=max_daily_volume,json=maxDailyVolume,proto3" json:"max_daily_volume,omitempty"`
-	// Rate limit parameters
-	RateLimits *RateLimitParams `protobuf:"bytes,7,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	// Fee parameters
-	Fees *FeeParams `protobuf:"bytes,8,opt,name=fees,proto3" json:"fees,omitempty"`
+func (x fastReflection_FeeCollector_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeCollector)
+}
+func (x fastReflection_FeeCollector_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeCollector
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_dex_v1_genesis_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeCollector) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeCollector
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeCollector) Type() protoreflect.MessageType {
+	return _fastReflection_FeeCollector_messageType
 }
 
-func (*Params) ProtoMessage() {}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeCollector) New() protoreflect.Message {
+	return new(fastReflection_FeeCollector)
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeCollector) Interface() protoreflect.ProtoMessage {
+	return (*FeeCollector)(x)
 }
 
-func (x *Params) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeCollector) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_FeeCollector_connection_id, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_FeeCollector_address, value) {
+			return
+		}
 	}
-	return false
 }
 
-func (x *Params) GetMaxAccountsPerDid() uint32 {
-	if x != nil {
-		return x.MaxAccountsPerDid
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeCollector) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.FeeCollector.connection_id":
+		return x.ConnectionId != ""
+	case "dex.v1.FeeCollector.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeCollector"))
+		}
+		panic(fmt.Errorf("message dex.v1.FeeCollector does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-func (x *Params) GetDefaultTimeoutSeconds() uint64 {
-	if x != nil {
-		return x.DefaultTimeoutSeconds
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeCollector) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.FeeCollector.connection_id":
+		x.ConnectionId = ""
+	case "dex.v1.FeeCollector.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeCollector"))
+		}
+		panic(fmt.Errorf("message dex.v1.FeeCollector does not contain field %s", fd.FullName()))
 	}
-	return 0
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeCollector) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.FeeCollector.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.FeeCollector.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeCollector"))
+		}
+		panic(fmt.Errorf("message dex.v1.FeeCollector does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeCollector) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.FeeCollector.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "dex.v1.FeeCollector.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeCollector"))
+		}
+		panic(fmt.Errorf("message dex.v1.FeeCollector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeCollector) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.FeeCollector.connection_id":
+		panic(fmt.Errorf("field connection_id of message dex.v1.FeeCollector is not mutable"))
+	case "dex.v1.FeeCollector.address":
+		panic(fmt.Errorf("field address of message dex.v1.FeeCollector is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeCollector"))
+		}
+		panic(fmt.Errorf("message dex.v1.FeeCollector does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeCollector) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.FeeCollector.connection_id":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.FeeCollector.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.FeeCollector"))
+		}
+		panic(fmt.Errorf("message dex.v1.FeeCollector does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeCollector) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.FeeCollector", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeCollector) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeCollector) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeCollector) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeCollector) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeCollector)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeCollector)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeCollector)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeCollector: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeCollector: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PricingParams                      protoreflect.MessageDescriptor
+	fd_PricingParams_reserves_ttl_seconds protoreflect.FieldDescriptor
+	fd_PricingParams_require_reserves     protoreflect.FieldDescriptor
+	fd_PricingParams_usd_denom            protoreflect.FieldDescriptor
+	fd_PricingParams_usd_exponent         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_PricingParams = File_dex_v1_genesis_proto.Messages().ByName("PricingParams")
+	fd_PricingParams_reserves_ttl_seconds = md_PricingParams.Fields().ByName("reserves_ttl_seconds")
+	fd_PricingParams_require_reserves = md_PricingParams.Fields().ByName("require_reserves")
+	fd_PricingParams_usd_denom = md_PricingParams.Fields().ByName("usd_denom")
+	fd_PricingParams_usd_exponent = md_PricingParams.Fields().ByName("usd_exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_PricingParams)(nil)
+
+type fastReflection_PricingParams PricingParams
+
+func (x *PricingParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PricingParams)(x)
+}
+
+func (x *PricingParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PricingParams_messageType fastReflection_PricingParams_messageType
+var _ protoreflect.MessageType = fastReflection_PricingParams_messageType{}
+
+type fastReflection_PricingParams_messageType struct{}
+
+func (x fastReflection_PricingParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PricingParams)(nil)
+}
+func (x fastReflection_PricingParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_PricingParams)
+}
+func (x fastReflection_PricingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricingParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PricingParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricingParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PricingParams) Type() protoreflect.MessageType {
+	return _fastReflection_PricingParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PricingParams) New() protoreflect.Message {
+	return new(fastReflection_PricingParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PricingParams) Interface() protoreflect.ProtoMessage {
+	return (*PricingParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PricingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReservesTtlSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReservesTtlSeconds)
+		if !f(fd_PricingParams_reserves_ttl_seconds, value) {
+			return
+		}
+	}
+	if x.RequireReserves != false {
+		value := protoreflect.ValueOfBool(x.RequireReserves)
+		if !f(fd_PricingParams_require_reserves, value) {
+			return
+		}
+	}
+	if x.UsdDenom != "" {
+		value := protoreflect.ValueOfString(x.UsdDenom)
+		if !f(fd_PricingParams_usd_denom, value) {
+			return
+		}
+	}
+	if x.UsdExponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.UsdExponent)
+		if !f(fd_PricingParams_usd_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PricingParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.PricingParams.reserves_ttl_seconds":
+		return x.ReservesTtlSeconds != uint64(0)
+	case "dex.v1.PricingParams.require_reserves":
+		return x.RequireReserves != false
+	case "dex.v1.PricingParams.usd_denom":
+		return x.UsdDenom != ""
+	case "dex.v1.PricingParams.usd_exponent":
+		return x.UsdExponent != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PricingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PricingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricingParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.PricingParams.reserves_ttl_seconds":
+		x.ReservesTtlSeconds = uint64(0)
+	case "dex.v1.PricingParams.require_reserves":
+		x.RequireReserves = false
+	case "dex.v1.PricingParams.usd_denom":
+		x.UsdDenom = ""
+	case "dex.v1.PricingParams.usd_exponent":
+		x.UsdExponent = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PricingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PricingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PricingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.PricingParams.reserves_ttl_seconds":
+		value := x.ReservesTtlSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "dex.v1.PricingParams.require_reserves":
+		value := x.RequireReserves
+		return protoreflect.ValueOfBool(value)
+	case "dex.v1.PricingParams.usd_denom":
+		value := x.UsdDenom
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.PricingParams.usd_exponent":
+		value := x.UsdExponent
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PricingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PricingParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.PricingParams.reserves_ttl_seconds":
+		x.ReservesTtlSeconds = value.Uint()
+	case "dex.v1.PricingParams.require_reserves":
+		x.RequireReserves = value.Bool()
+	case "dex.v1.PricingParams.usd_denom":
+		x.UsdDenom = value.Interface().(string)
+	case "dex.v1.PricingParams.usd_exponent":
+		x.UsdExponent = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PricingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PricingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.PricingParams.reserves_ttl_seconds":
+		panic(fmt.Errorf("field reserves_ttl_seconds of message dex.v1.PricingParams is not mutable"))
+	case "dex.v1.PricingParams.require_reserves":
+		panic(fmt.Errorf("field require_reserves of message dex.v1.PricingParams is not mutable"))
+	case "dex.v1.PricingParams.usd_denom":
+		panic(fmt.Errorf("field usd_denom of message dex.v1.PricingParams is not mutable"))
+	case "dex.v1.PricingParams.usd_exponent":
+		panic(fmt.Errorf("field usd_exponent of message dex.v1.PricingParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PricingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PricingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PricingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.PricingParams.reserves_ttl_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dex.v1.PricingParams.require_reserves":
+		return protoreflect.ValueOfBool(false)
+	case "dex.v1.PricingParams.usd_denom":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.PricingParams.usd_exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PricingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PricingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PricingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.PricingParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PricingParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricingParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PricingParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PricingParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PricingParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ReservesTtlSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReservesTtlSeconds))
+		}
+		if x.RequireReserves {
+			n += 2
+		}
+		l = len(x.UsdDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UsdExponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.UsdExponent))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PricingParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UsdExponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UsdExponent))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.UsdDenom) > 0 {
+			i -= len(x.UsdDenom)
+			copy(dAtA[i:], x.UsdDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UsdDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.RequireReserves {
+			i--
+			if x.RequireReserves {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ReservesTtlSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReservesTtlSeconds))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PricingParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricingParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservesTtlSeconds", wireType)
+				}
+				x.ReservesTtlSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReservesTtlSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireReserves", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireReserves = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsdDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UsdDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsdExponent", wireType)
+				}
+				x.UsdExponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UsdExponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RoutingParams_1_list)(nil)
+
+type _RoutingParams_1_list struct {
+	list *[]*SwapPool
+}
+
+func (x *_RoutingParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RoutingParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RoutingParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SwapPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RoutingParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SwapPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RoutingParams_1_list) AppendMutable() protoreflect.Value {
+	v := new(SwapPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RoutingParams_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RoutingParams_1_list) NewElement() protoreflect.Value {
+	v := new(SwapPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RoutingParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RoutingParams          protoreflect.MessageDescriptor
+	fd_RoutingParams_pools    protoreflect.FieldDescriptor
+	fd_RoutingParams_max_hops protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_RoutingParams = File_dex_v1_genesis_proto.Messages().ByName("RoutingParams")
+	fd_RoutingParams_pools = md_RoutingParams.Fields().ByName("pools")
+	fd_RoutingParams_max_hops = md_RoutingParams.Fields().ByName("max_hops")
+}
+
+var _ protoreflect.Message = (*fastReflection_RoutingParams)(nil)
+
+type fastReflection_RoutingParams RoutingParams
+
+func (x *RoutingParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RoutingParams)(x)
+}
+
+func (x *RoutingParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RoutingParams_messageType fastReflection_RoutingParams_messageType
+var _ protoreflect.MessageType = fastReflection_RoutingParams_messageType{}
+
+type fastReflection_RoutingParams_messageType struct{}
+
+func (x fastReflection_RoutingParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RoutingParams)(nil)
+}
+func (x fastReflection_RoutingParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_RoutingParams)
+}
+func (x fastReflection_RoutingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoutingParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RoutingParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoutingParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RoutingParams) Type() protoreflect.MessageType {
+	return _fastReflection_RoutingParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RoutingParams) New() protoreflect.Message {
+	return new(fastReflection_RoutingParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RoutingParams) Interface() protoreflect.ProtoMessage {
+	return (*RoutingParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RoutingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Pools) != 0 {
+		value := protoreflect.ValueOfList(&_RoutingParams_1_list{list: &x.Pools})
+		if !f(fd_RoutingParams_pools, value) {
+			return
+		}
+	}
+	if x.MaxHops != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxHops)
+		if !f(fd_RoutingParams_max_hops, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RoutingParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.RoutingParams.pools":
+		return len(x.Pools) != 0
+	case "dex.v1.RoutingParams.max_hops":
+		return x.MaxHops != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RoutingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.RoutingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoutingParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.RoutingParams.pools":
+		x.Pools = nil
+	case "dex.v1.RoutingParams.max_hops":
+		x.MaxHops = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RoutingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.RoutingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RoutingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.RoutingParams.pools":
+		if len(x.Pools) == 0 {
+			return protoreflect.ValueOfList(&_RoutingParams_1_list{})
+		}
+		listValue := &_RoutingParams_1_list{list: &x.Pools}
+		return protoreflect.ValueOfList(listValue)
+	case "dex.v1.RoutingParams.max_hops":
+		value := x.MaxHops
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RoutingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.RoutingParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoutingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.RoutingParams.pools":
+		lv := value.List()
+		clv := lv.(*_RoutingParams_1_list)
+		x.Pools = *clv.list
+	case "dex.v1.RoutingParams.max_hops":
+		x.MaxHops = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RoutingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.RoutingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoutingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.RoutingParams.pools":
+		if x.Pools == nil {
+			x.Pools = []*SwapPool{}
+		}
+		value := &_RoutingParams_1_list{list: &x.Pools}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.RoutingParams.max_hops":
+		panic(fmt.Errorf("field max_hops of message dex.v1.RoutingParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RoutingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.RoutingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RoutingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.RoutingParams.pools":
+		list := []*SwapPool{}
+		return protoreflect.ValueOfList(&_RoutingParams_1_list{list: &list})
+	case "dex.v1.RoutingParams.max_hops":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.RoutingParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.RoutingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RoutingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.RoutingParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RoutingParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoutingParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RoutingParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RoutingParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RoutingParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Pools) > 0 {
+			for _, e := range x.Pools {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxHops != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxHops))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RoutingParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxHops != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxHops))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Pools) > 0 {
+			for iNdEx := len(x.Pools) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pools[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RoutingParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoutingParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoutingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pools = append(x.Pools, &SwapPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pools[len(x.Pools)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+				}
+				x.MaxHops = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxHops |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_OrderMonitorParams_3_list)(nil)
+
+type _OrderMonitorParams_3_list struct {
+	list *[]*OrderBook
+}
+
+func (x *_OrderMonitorParams_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OrderMonitorParams_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OrderMonitorParams_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderBook)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OrderMonitorParams_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderBook)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OrderMonitorParams_3_list) AppendMutable() protoreflect.Value {
+	v := new(OrderBook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OrderMonitorParams_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OrderMonitorParams_3_list) NewElement() protoreflect.Value {
+	v := new(OrderBook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OrderMonitorParams_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OrderMonitorParams                      protoreflect.MessageDescriptor
+	fd_OrderMonitorParams_interval_blocks      protoreflect.FieldDescriptor
+	fd_OrderMonitorParams_max_orders_per_check protoreflect.FieldDescriptor
+	fd_OrderMonitorParams_order_books          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_OrderMonitorParams = File_dex_v1_genesis_proto.Messages().ByName("OrderMonitorParams")
+	fd_OrderMonitorParams_interval_blocks = md_OrderMonitorParams.Fields().ByName("interval_blocks")
+	fd_OrderMonitorParams_max_orders_per_check = md_OrderMonitorParams.Fields().ByName("max_orders_per_check")
+	fd_OrderMonitorParams_order_books = md_OrderMonitorParams.Fields().ByName("order_books")
+}
+
+var _ protoreflect.Message = (*fastReflection_OrderMonitorParams)(nil)
+
+type fastReflection_OrderMonitorParams OrderMonitorParams
+
+func (x *OrderMonitorParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OrderMonitorParams)(x)
+}
+
+func (x *OrderMonitorParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OrderMonitorParams_messageType fastReflection_OrderMonitorParams_messageType
+var _ protoreflect.MessageType = fastReflection_OrderMonitorParams_messageType{}
+
+type fastReflection_OrderMonitorParams_messageType struct{}
+
+func (x fastReflection_OrderMonitorParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OrderMonitorParams)(nil)
+}
+func (x fastReflection_OrderMonitorParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_OrderMonitorParams)
+}
+func (x fastReflection_OrderMonitorParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderMonitorParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OrderMonitorParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderMonitorParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OrderMonitorParams) Type() protoreflect.MessageType {
+	return _fastReflection_OrderMonitorParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OrderMonitorParams) New() protoreflect.Message {
+	return new(fastReflection_OrderMonitorParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OrderMonitorParams) Interface() protoreflect.ProtoMessage {
+	return (*OrderMonitorParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OrderMonitorParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IntervalBlocks)
+		if !f(fd_OrderMonitorParams_interval_blocks, value) {
+			return
+		}
+	}
+	if x.MaxOrdersPerCheck != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxOrdersPerCheck)
+		if !f(fd_OrderMonitorParams_max_orders_per_check, value) {
+			return
+		}
+	}
+	if len(x.OrderBooks) != 0 {
+		value := protoreflect.ValueOfList(&_OrderMonitorParams_3_list{list: &x.OrderBooks})
+		if !f(fd_OrderMonitorParams_order_books, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OrderMonitorParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.OrderMonitorParams.interval_blocks":
+		return x.IntervalBlocks != uint64(0)
+	case "dex.v1.OrderMonitorParams.max_orders_per_check":
+		return x.MaxOrdersPerCheck != uint32(0)
+	case "dex.v1.OrderMonitorParams.order_books":
+		return len(x.OrderBooks) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderMonitorParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderMonitorParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderMonitorParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.OrderMonitorParams.interval_blocks":
+		x.IntervalBlocks = uint64(0)
+	case "dex.v1.OrderMonitorParams.max_orders_per_check":
+		x.MaxOrdersPerCheck = uint32(0)
+	case "dex.v1.OrderMonitorParams.order_books":
+		x.OrderBooks = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderMonitorParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderMonitorParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OrderMonitorParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.OrderMonitorParams.interval_blocks":
+		value := x.IntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "dex.v1.OrderMonitorParams.max_orders_per_check":
+		value := x.MaxOrdersPerCheck
+		return protoreflect.ValueOfUint32(value)
+	case "dex.v1.OrderMonitorParams.order_books":
+		if len(x.OrderBooks) == 0 {
+			return protoreflect.ValueOfList(&_OrderMonitorParams_3_list{})
+		}
+		listValue := &_OrderMonitorParams_3_list{list: &x.OrderBooks}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderMonitorParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderMonitorParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderMonitorParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.OrderMonitorParams.interval_blocks":
+		x.IntervalBlocks = value.Uint()
+	case "dex.v1.OrderMonitorParams.max_orders_per_check":
+		x.MaxOrdersPerCheck = uint32(value.Uint())
+	case "dex.v1.OrderMonitorParams.order_books":
+		lv := value.List()
+		clv := lv.(*_OrderMonitorParams_3_list)
+		x.OrderBooks = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderMonitorParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderMonitorParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderMonitorParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.OrderMonitorParams.order_books":
+		if x.OrderBooks == nil {
+			x.OrderBooks = []*OrderBook{}
+		}
+		value := &_OrderMonitorParams_3_list{list: &x.OrderBooks}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.OrderMonitorParams.interval_blocks":
+		panic(fmt.Errorf("field interval_blocks of message dex.v1.OrderMonitorParams is not mutable"))
+	case "dex.v1.OrderMonitorParams.max_orders_per_check":
+		panic(fmt.Errorf("field max_orders_per_check of message dex.v1.OrderMonitorParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderMonitorParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderMonitorParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OrderMonitorParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.OrderMonitorParams.interval_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dex.v1.OrderMonitorParams.max_orders_per_check":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dex.v1.OrderMonitorParams.order_books":
+		list := []*OrderBook{}
+		return protoreflect.ValueOfList(&_OrderMonitorParams_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderMonitorParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderMonitorParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OrderMonitorParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.OrderMonitorParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OrderMonitorParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderMonitorParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OrderMonitorParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OrderMonitorParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OrderMonitorParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalBlocks))
+		}
+		if x.MaxOrdersPerCheck != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOrdersPerCheck))
+		}
+		if len(x.OrderBooks) > 0 {
+			for _, e := range x.OrderBooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OrderMonitorParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OrderBooks) > 0 {
+			for iNdEx := len(x.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OrderBooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.MaxOrdersPerCheck != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOrdersPerCheck))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.IntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalBlocks))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OrderMonitorParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderMonitorParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderMonitorParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+				}
+				x.IntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerCheck", wireType)
+				}
+				x.MaxOrdersPerCheck = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOrdersPerCheck |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OrderBooks = append(x.OrderBooks, &OrderBook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrderBooks[len(x.OrderBooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ScreeningParams_3_list)(nil)
+
+type _ScreeningParams_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ScreeningParams_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScreeningParams_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScreeningParams_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScreeningParams_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScreeningParams_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScreeningParams_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScreeningParams_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScreeningParams_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScreeningParams               protoreflect.MessageDescriptor
+	fd_ScreeningParams_mode          protoreflect.FieldDescriptor
+	fd_ScreeningParams_threshold_usd protoreflect.FieldDescriptor
+	fd_ScreeningParams_thresholds    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_ScreeningParams = File_dex_v1_genesis_proto.Messages().ByName("ScreeningParams")
+	fd_ScreeningParams_mode = md_ScreeningParams.Fields().ByName("mode")
+	fd_ScreeningParams_threshold_usd = md_ScreeningParams.Fields().ByName("threshold_usd")
+	fd_ScreeningParams_thresholds = md_ScreeningParams.Fields().ByName("thresholds")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScreeningParams)(nil)
+
+type fastReflection_ScreeningParams ScreeningParams
+
+func (x *ScreeningParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScreeningParams)(x)
+}
+
+func (x *ScreeningParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScreeningParams_messageType fastReflection_ScreeningParams_messageType
+var _ protoreflect.MessageType = fastReflection_ScreeningParams_messageType{}
+
+type fastReflection_ScreeningParams_messageType struct{}
+
+func (x fastReflection_ScreeningParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScreeningParams)(nil)
+}
+func (x fastReflection_ScreeningParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScreeningParams)
+}
+func (x fastReflection_ScreeningParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScreeningParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScreeningParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScreeningParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScreeningParams) Type() protoreflect.MessageType {
+	return _fastReflection_ScreeningParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScreeningParams) New() protoreflect.Message {
+	return new(fastReflection_ScreeningParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScreeningParams) Interface() protoreflect.ProtoMessage {
+	return (*ScreeningParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScreeningParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_ScreeningParams_mode, value) {
+			return
+		}
+	}
+	if x.ThresholdUsd != "" {
+		value := protoreflect.ValueOfString(x.ThresholdUsd)
+		if !f(fd_ScreeningParams_threshold_usd, value) {
+			return
+		}
+	}
+	if len(x.Thresholds) != 0 {
+		value := protoreflect.ValueOfList(&_ScreeningParams_3_list{list: &x.Thresholds})
+		if !f(fd_ScreeningParams_thresholds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScreeningParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.ScreeningParams.mode":
+		return x.Mode != 0
+	case "dex.v1.ScreeningParams.threshold_usd":
+		return x.ThresholdUsd != ""
+	case "dex.v1.ScreeningParams.thresholds":
+		return len(x.Thresholds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ScreeningParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ScreeningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreeningParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.ScreeningParams.mode":
+		x.Mode = 0
+	case "dex.v1.ScreeningParams.threshold_usd":
+		x.ThresholdUsd = ""
+	case "dex.v1.ScreeningParams.thresholds":
+		x.Thresholds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ScreeningParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ScreeningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScreeningParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.ScreeningParams.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "dex.v1.ScreeningParams.threshold_usd":
+		value := x.ThresholdUsd
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.ScreeningParams.thresholds":
+		if len(x.Thresholds) == 0 {
+			return protoreflect.ValueOfList(&_ScreeningParams_3_list{})
+		}
+		listValue := &_ScreeningParams_3_list{list: &x.Thresholds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ScreeningParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ScreeningParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreeningParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.ScreeningParams.mode":
+		x.Mode = (ScreeningMode)(value.Enum())
+	case "dex.v1.ScreeningParams.threshold_usd":
+		x.ThresholdUsd = value.Interface().(string)
+	case "dex.v1.ScreeningParams.thresholds":
+		lv := value.List()
+		clv := lv.(*_ScreeningParams_3_list)
+		x.Thresholds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ScreeningParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ScreeningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreeningParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.ScreeningParams.thresholds":
+		if x.Thresholds == nil {
+			x.Thresholds = []*v1beta1.Coin{}
+		}
+		value := &_ScreeningParams_3_list{list: &x.Thresholds}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.ScreeningParams.mode":
+		panic(fmt.Errorf("field mode of message dex.v1.ScreeningParams is not mutable"))
+	case "dex.v1.ScreeningParams.threshold_usd":
+		panic(fmt.Errorf("field threshold_usd of message dex.v1.ScreeningParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ScreeningParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ScreeningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScreeningParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.ScreeningParams.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "dex.v1.ScreeningParams.threshold_usd":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.ScreeningParams.thresholds":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ScreeningParams_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ScreeningParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ScreeningParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScreeningParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.ScreeningParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScreeningParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScreeningParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScreeningParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScreeningParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScreeningParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
+		l = len(x.ThresholdUsd)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Thresholds) > 0 {
+			for _, e := range x.Thresholds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScreeningParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Thresholds) > 0 {
+			for iNdEx := len(x.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Thresholds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.ThresholdUsd) > 0 {
+			i -= len(x.ThresholdUsd)
+			copy(dAtA[i:], x.ThresholdUsd)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThresholdUsd)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScreeningParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScreeningParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScreeningParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= ScreeningMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThresholdUsd", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThresholdUsd = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Thresholds = append(x.Thresholds, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Thresholds[len(x.Thresholds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ArbitrageParams               protoreflect.MessageDescriptor
+	fd_ArbitrageParams_enabled       protoreflect.FieldDescriptor
+	fd_ArbitrageParams_window_blocks protoreflect.FieldDescriptor
+	fd_ArbitrageParams_action        protoreflect.FieldDescriptor
+	fd_ArbitrageParams_extra_fee_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_ArbitrageParams = File_dex_v1_genesis_proto.Messages().ByName("ArbitrageParams")
+	fd_ArbitrageParams_enabled = md_ArbitrageParams.Fields().ByName("enabled")
+	fd_ArbitrageParams_window_blocks = md_ArbitrageParams.Fields().ByName("window_blocks")
+	fd_ArbitrageParams_action = md_ArbitrageParams.Fields().ByName("action")
+	fd_ArbitrageParams_extra_fee_bps = md_ArbitrageParams.Fields().ByName("extra_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_ArbitrageParams)(nil)
+
+type fastReflection_ArbitrageParams ArbitrageParams
+
+func (x *ArbitrageParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ArbitrageParams)(x)
+}
+
+func (x *ArbitrageParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ArbitrageParams_messageType fastReflection_ArbitrageParams_messageType
+var _ protoreflect.MessageType = fastReflection_ArbitrageParams_messageType{}
+
+type fastReflection_ArbitrageParams_messageType struct{}
+
+func (x fastReflection_ArbitrageParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ArbitrageParams)(nil)
+}
+func (x fastReflection_ArbitrageParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ArbitrageParams)
+}
+func (x fastReflection_ArbitrageParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ArbitrageParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ArbitrageParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ArbitrageParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ArbitrageParams) Type() protoreflect.MessageType {
+	return _fastReflection_ArbitrageParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ArbitrageParams) New() protoreflect.Message {
+	return new(fastReflection_ArbitrageParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ArbitrageParams) Interface() protoreflect.ProtoMessage {
+	return (*ArbitrageParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ArbitrageParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_ArbitrageParams_enabled, value) {
+			return
+		}
+	}
+	if x.WindowBlocks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.WindowBlocks)
+		if !f(fd_ArbitrageParams_window_blocks, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_ArbitrageParams_action, value) {
+			return
+		}
+	}
+	if x.ExtraFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExtraFeeBps)
+		if !f(fd_ArbitrageParams_extra_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ArbitrageParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.ArbitrageParams.enabled":
+		return x.Enabled != false
+	case "dex.v1.ArbitrageParams.window_blocks":
+		return x.WindowBlocks != uint32(0)
+	case "dex.v1.ArbitrageParams.action":
+		return x.Action != 0
+	case "dex.v1.ArbitrageParams.extra_fee_bps":
+		return x.ExtraFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ArbitrageParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ArbitrageParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArbitrageParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.ArbitrageParams.enabled":
+		x.Enabled = false
+	case "dex.v1.ArbitrageParams.window_blocks":
+		x.WindowBlocks = uint32(0)
+	case "dex.v1.ArbitrageParams.action":
+		x.Action = 0
+	case "dex.v1.ArbitrageParams.extra_fee_bps":
+		x.ExtraFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ArbitrageParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ArbitrageParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ArbitrageParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.ArbitrageParams.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "dex.v1.ArbitrageParams.window_blocks":
+		value := x.WindowBlocks
+		return protoreflect.ValueOfUint32(value)
+	case "dex.v1.ArbitrageParams.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "dex.v1.ArbitrageParams.extra_fee_bps":
+		value := x.ExtraFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ArbitrageParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ArbitrageParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArbitrageParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.ArbitrageParams.enabled":
+		x.Enabled = value.Bool()
+	case "dex.v1.ArbitrageParams.window_blocks":
+		x.WindowBlocks = uint32(value.Uint())
+	case "dex.v1.ArbitrageParams.action":
+		x.Action = (ArbitrageAction)(value.Enum())
+	case "dex.v1.ArbitrageParams.extra_fee_bps":
+		x.ExtraFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ArbitrageParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ArbitrageParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArbitrageParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.ArbitrageParams.enabled":
+		panic(fmt.Errorf("field enabled of message dex.v1.ArbitrageParams is not mutable"))
+	case "dex.v1.ArbitrageParams.window_blocks":
+		panic(fmt.Errorf("field window_blocks of message dex.v1.ArbitrageParams is not mutable"))
+	case "dex.v1.ArbitrageParams.action":
+		panic(fmt.Errorf("field action of message dex.v1.ArbitrageParams is not mutable"))
+	case "dex.v1.ArbitrageParams.extra_fee_bps":
+		panic(fmt.Errorf("field extra_fee_bps of message dex.v1.ArbitrageParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ArbitrageParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ArbitrageParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ArbitrageParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.ArbitrageParams.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "dex.v1.ArbitrageParams.window_blocks":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dex.v1.ArbitrageParams.action":
+		return protoreflect.ValueOfEnum(0)
+	case "dex.v1.ArbitrageParams.extra_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.ArbitrageParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.ArbitrageParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ArbitrageParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.ArbitrageParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ArbitrageParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArbitrageParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ArbitrageParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ArbitrageParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ArbitrageParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if x.WindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowBlocks))
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		if x.ExtraFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExtraFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ArbitrageParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExtraFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExtraFeeBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.WindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ArbitrageParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ArbitrageParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ArbitrageParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+				}
+				x.WindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowBlocks |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= ArbitrageAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraFeeBps", wireType)
+				}
+				x.ExtraFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExtraFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OrderBook               protoreflect.MessageDescriptor
+	fd_OrderBook_connection_id protoreflect.FieldDescriptor
+	fd_OrderBook_contract      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_OrderBook = File_dex_v1_genesis_proto.Messages().ByName("OrderBook")
+	fd_OrderBook_connection_id = md_OrderBook.Fields().ByName("connection_id")
+	fd_OrderBook_contract = md_OrderBook.Fields().ByName("contract")
+}
+
+var _ protoreflect.Message = (*fastReflection_OrderBook)(nil)
+
+type fastReflection_OrderBook OrderBook
+
+func (x *OrderBook) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OrderBook)(x)
+}
+
+func (x *OrderBook) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OrderBook_messageType fastReflection_OrderBook_messageType
+var _ protoreflect.MessageType = fastReflection_OrderBook_messageType{}
+
+type fastReflection_OrderBook_messageType struct{}
+
+func (x fastReflection_OrderBook_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OrderBook)(nil)
+}
+func (x fastReflection_OrderBook_messageType) New() protoreflect.Message {
+	return new(fastReflection_OrderBook)
+}
+func (x fastReflection_OrderBook_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderBook
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OrderBook) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderBook
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OrderBook) Type() protoreflect.MessageType {
+	return _fastReflection_OrderBook_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OrderBook) New() protoreflect.Message {
+	return new(fastReflection_OrderBook)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OrderBook) Interface() protoreflect.ProtoMessage {
+	return (*OrderBook)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OrderBook) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_OrderBook_connection_id, value) {
+			return
+		}
+	}
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_OrderBook_contract, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OrderBook) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.OrderBook.connection_id":
+		return x.ConnectionId != ""
+	case "dex.v1.OrderBook.contract":
+		return x.Contract != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderBook"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderBook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBook) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.OrderBook.connection_id":
+		x.ConnectionId = ""
+	case "dex.v1.OrderBook.contract":
+		x.Contract = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderBook"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderBook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OrderBook) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.OrderBook.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.OrderBook.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderBook"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderBook does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBook) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.OrderBook.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "dex.v1.OrderBook.contract":
+		x.Contract = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderBook"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderBook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBook) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.OrderBook.connection_id":
+		panic(fmt.Errorf("field connection_id of message dex.v1.OrderBook is not mutable"))
+	case "dex.v1.OrderBook.contract":
+		panic(fmt.Errorf("field contract of message dex.v1.OrderBook is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderBook"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderBook does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OrderBook) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.OrderBook.connection_id":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.OrderBook.contract":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.OrderBook"))
+		}
+		panic(fmt.Errorf("message dex.v1.OrderBook does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OrderBook) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.OrderBook", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OrderBook) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBook) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OrderBook) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OrderBook) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OrderBook)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OrderBook)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OrderBook)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderBook: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SwapPool_3_list)(nil)
+
+type _SwapPool_3_list struct {
+	list *[]string
+}
+
+func (x *_SwapPool_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SwapPool_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SwapPool_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SwapPool_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SwapPool_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SwapPool at list field Denoms as it is not of Message kind"))
+}
+
+func (x *_SwapPool_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SwapPool_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SwapPool_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SwapPool               protoreflect.MessageDescriptor
+	fd_SwapPool_connection_id protoreflect.FieldDescriptor
+	fd_SwapPool_pool_id       protoreflect.FieldDescriptor
+	fd_SwapPool_denoms        protoreflect.FieldDescriptor
+	fd_SwapPool_swap_fee_bps  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_SwapPool = File_dex_v1_genesis_proto.Messages().ByName("SwapPool")
+	fd_SwapPool_connection_id = md_SwapPool.Fields().ByName("connection_id")
+	fd_SwapPool_pool_id = md_SwapPool.Fields().ByName("pool_id")
+	fd_SwapPool_denoms = md_SwapPool.Fields().ByName("denoms")
+	fd_SwapPool_swap_fee_bps = md_SwapPool.Fields().ByName("swap_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_SwapPool)(nil)
+
+type fastReflection_SwapPool SwapPool
+
+func (x *SwapPool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SwapPool)(x)
+}
+
+func (x *SwapPool) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SwapPool_messageType fastReflection_SwapPool_messageType
+var _ protoreflect.MessageType = fastReflection_SwapPool_messageType{}
+
+type fastReflection_SwapPool_messageType struct{}
+
+func (x fastReflection_SwapPool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SwapPool)(nil)
+}
+func (x fastReflection_SwapPool_messageType) New() protoreflect.Message {
+	return new(fastReflection_SwapPool)
+}
+func (x fastReflection_SwapPool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapPool
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SwapPool) Descriptor() protoreflect.MessageDescriptor {
+	return md_SwapPool
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SwapPool) Type() protoreflect.MessageType {
+	return _fastReflection_SwapPool_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SwapPool) New() protoreflect.Message {
+	return new(fastReflection_SwapPool)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SwapPool) Interface() protoreflect.ProtoMessage {
+	return (*SwapPool)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SwapPool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_SwapPool_connection_id, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_SwapPool_pool_id, value) {
+			return
+		}
+	}
+	if len(x.Denoms) != 0 {
+		value := protoreflect.ValueOfList(&_SwapPool_3_list{list: &x.Denoms})
+		if !f(fd_SwapPool_denoms, value) {
+			return
+		}
+	}
+	if x.SwapFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SwapFeeBps)
+		if !f(fd_SwapPool_swap_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SwapPool) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.SwapPool.connection_id":
+		return x.ConnectionId != ""
+	case "dex.v1.SwapPool.pool_id":
+		return x.PoolId != uint64(0)
+	case "dex.v1.SwapPool.denoms":
+		return len(x.Denoms) != 0
+	case "dex.v1.SwapPool.swap_fee_bps":
+		return x.SwapFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.SwapPool"))
+		}
+		panic(fmt.Errorf("message dex.v1.SwapPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPool) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.SwapPool.connection_id":
+		x.ConnectionId = ""
+	case "dex.v1.SwapPool.pool_id":
+		x.PoolId = uint64(0)
+	case "dex.v1.SwapPool.denoms":
+		x.Denoms = nil
+	case "dex.v1.SwapPool.swap_fee_bps":
+		x.SwapFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.SwapPool"))
+		}
+		panic(fmt.Errorf("message dex.v1.SwapPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SwapPool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.SwapPool.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.SwapPool.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "dex.v1.SwapPool.denoms":
+		if len(x.Denoms) == 0 {
+			return protoreflect.ValueOfList(&_SwapPool_3_list{})
+		}
+		listValue := &_SwapPool_3_list{list: &x.Denoms}
+		return protoreflect.ValueOfList(listValue)
+	case "dex.v1.SwapPool.swap_fee_bps":
+		value := x.SwapFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.SwapPool"))
+		}
+		panic(fmt.Errorf("message dex.v1.SwapPool does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.SwapPool.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "dex.v1.SwapPool.pool_id":
+		x.PoolId = value.Uint()
+	case "dex.v1.SwapPool.denoms":
+		lv := value.List()
+		clv := lv.(*_SwapPool_3_list)
+		x.Denoms = *clv.list
+	case "dex.v1.SwapPool.swap_fee_bps":
+		x.SwapFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.SwapPool"))
+		}
+		panic(fmt.Errorf("message dex.v1.SwapPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.SwapPool.denoms":
+		if x.Denoms == nil {
+			x.Denoms = []string{}
+		}
+		value := &_SwapPool_3_list{list: &x.Denoms}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.SwapPool.connection_id":
+		panic(fmt.Errorf("field connection_id of message dex.v1.SwapPool is not mutable"))
+	case "dex.v1.SwapPool.pool_id":
+		panic(fmt.Errorf("field pool_id of message dex.v1.SwapPool is not mutable"))
+	case "dex.v1.SwapPool.swap_fee_bps":
+		panic(fmt.Errorf("field swap_fee_bps of message dex.v1.SwapPool is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.SwapPool"))
+		}
+		panic(fmt.Errorf("message dex.v1.SwapPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SwapPool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.SwapPool.connection_id":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.SwapPool.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dex.v1.SwapPool.denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SwapPool_3_list{list: &list})
+	case "dex.v1.SwapPool.swap_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.SwapPool"))
+		}
+		panic(fmt.Errorf("message dex.v1.SwapPool does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SwapPool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.SwapPool", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SwapPool) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SwapPool) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SwapPool) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SwapPool) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SwapPool)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if len(x.Denoms) > 0 {
+			for _, s := range x.Denoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SwapFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SwapFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SwapPool)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SwapFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SwapFeeBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Denoms) > 0 {
+			for iNdEx := len(x.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Denoms[iNdEx])
+				copy(dAtA[i:], x.Denoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SwapPool)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapPool: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SwapPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denoms = append(x.Denoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapFeeBps", wireType)
+				}
+				x.SwapFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SwapFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NobleRoute               protoreflect.MessageDescriptor
+	fd_NobleRoute_connection_id protoreflect.FieldDescriptor
+	fd_NobleRoute_channel_id    protoreflect.FieldDescriptor
+	fd_NobleRoute_swap_contract protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_NobleRoute = File_dex_v1_genesis_proto.Messages().ByName("NobleRoute")
+	fd_NobleRoute_connection_id = md_NobleRoute.Fields().ByName("connection_id")
+	fd_NobleRoute_channel_id = md_NobleRoute.Fields().ByName("channel_id")
+	fd_NobleRoute_swap_contract = md_NobleRoute.Fields().ByName("swap_contract")
+}
+
+var _ protoreflect.Message = (*fastReflection_NobleRoute)(nil)
+
+type fastReflection_NobleRoute NobleRoute
+
+func (x *NobleRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NobleRoute)(x)
+}
+
+func (x *NobleRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NobleRoute_messageType fastReflection_NobleRoute_messageType
+var _ protoreflect.MessageType = fastReflection_NobleRoute_messageType{}
+
+type fastReflection_NobleRoute_messageType struct{}
+
+func (x fastReflection_NobleRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NobleRoute)(nil)
+}
+func (x fastReflection_NobleRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_NobleRoute)
+}
+func (x fastReflection_NobleRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NobleRoute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NobleRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_NobleRoute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NobleRoute) Type() protoreflect.MessageType {
+	return _fastReflection_NobleRoute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NobleRoute) New() protoreflect.Message {
+	return new(fastReflection_NobleRoute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NobleRoute) Interface() protoreflect.ProtoMessage {
+	return (*NobleRoute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NobleRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_NobleRoute_connection_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_NobleRoute_channel_id, value) {
+			return
+		}
+	}
+	if x.SwapContract != "" {
+		value := protoreflect.ValueOfString(x.SwapContract)
+		if !f(fd_NobleRoute_swap_contract, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NobleRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.NobleRoute.connection_id":
+		return x.ConnectionId != ""
+	case "dex.v1.NobleRoute.channel_id":
+		return x.ChannelId != ""
+	case "dex.v1.NobleRoute.swap_contract":
+		return x.SwapContract != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.NobleRoute"))
+		}
+		panic(fmt.Errorf("message dex.v1.NobleRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NobleRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.NobleRoute.connection_id":
+		x.ConnectionId = ""
+	case "dex.v1.NobleRoute.channel_id":
+		x.ChannelId = ""
+	case "dex.v1.NobleRoute.swap_contract":
+		x.SwapContract = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.NobleRoute"))
+		}
+		panic(fmt.Errorf("message dex.v1.NobleRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NobleRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.NobleRoute.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.NobleRoute.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.NobleRoute.swap_contract":
+		value := x.SwapContract
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.NobleRoute"))
+		}
+		panic(fmt.Errorf("message dex.v1.NobleRoute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NobleRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.NobleRoute.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "dex.v1.NobleRoute.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "dex.v1.NobleRoute.swap_contract":
+		x.SwapContract = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.NobleRoute"))
+		}
+		panic(fmt.Errorf("message dex.v1.NobleRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NobleRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.NobleRoute.connection_id":
+		panic(fmt.Errorf("field connection_id of message dex.v1.NobleRoute is not mutable"))
+	case "dex.v1.NobleRoute.channel_id":
+		panic(fmt.Errorf("field channel_id of message dex.v1.NobleRoute is not mutable"))
+	case "dex.v1.NobleRoute.swap_contract":
+		panic(fmt.Errorf("field swap_contract of message dex.v1.NobleRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.NobleRoute"))
+		}
+		panic(fmt.Errorf("message dex.v1.NobleRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NobleRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.NobleRoute.connection_id":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.NobleRoute.channel_id":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.NobleRoute.swap_contract":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.NobleRoute"))
+		}
+		panic(fmt.Errorf("message dex.v1.NobleRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NobleRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.NobleRoute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NobleRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NobleRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NobleRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NobleRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NobleRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SwapContract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NobleRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SwapContract) > 0 {
+			i -= len(x.SwapContract)
+			copy(dAtA[i:], x.SwapContract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SwapContract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NobleRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NobleRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NobleRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapContract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwapContract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceHistoryParams                  protoreflect.MessageDescriptor
+	fd_PriceHistoryParams_epoch_seconds    protoreflect.FieldDescriptor
+	fd_PriceHistoryParams_retention_epochs protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_PriceHistoryParams = File_dex_v1_genesis_proto.Messages().ByName("PriceHistoryParams")
+	fd_PriceHistoryParams_epoch_seconds = md_PriceHistoryParams.Fields().ByName("epoch_seconds")
+	fd_PriceHistoryParams_retention_epochs = md_PriceHistoryParams.Fields().ByName("retention_epochs")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceHistoryParams)(nil)
+
+type fastReflection_PriceHistoryParams PriceHistoryParams
+
+func (x *PriceHistoryParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceHistoryParams)(x)
+}
+
+func (x *PriceHistoryParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceHistoryParams_messageType fastReflection_PriceHistoryParams_messageType
+var _ protoreflect.MessageType = fastReflection_PriceHistoryParams_messageType{}
+
+type fastReflection_PriceHistoryParams_messageType struct{}
+
+func (x fastReflection_PriceHistoryParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceHistoryParams)(nil)
+}
+func (x fastReflection_PriceHistoryParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceHistoryParams)
+}
+func (x fastReflection_PriceHistoryParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHistoryParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceHistoryParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHistoryParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceHistoryParams) Type() protoreflect.MessageType {
+	return _fastReflection_PriceHistoryParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceHistoryParams) New() protoreflect.Message {
+	return new(fastReflection_PriceHistoryParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceHistoryParams) Interface() protoreflect.ProtoMessage {
+	return (*PriceHistoryParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceHistoryParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochSeconds)
+		if !f(fd_PriceHistoryParams_epoch_seconds, value) {
+			return
+		}
+	}
+	if x.RetentionEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetentionEpochs)
+		if !f(fd_PriceHistoryParams_retention_epochs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceHistoryParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.PriceHistoryParams.epoch_seconds":
+		return x.EpochSeconds != uint64(0)
+	case "dex.v1.PriceHistoryParams.retention_epochs":
+		return x.RetentionEpochs != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PriceHistoryParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PriceHistoryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHistoryParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.PriceHistoryParams.epoch_seconds":
+		x.EpochSeconds = uint64(0)
+	case "dex.v1.PriceHistoryParams.retention_epochs":
+		x.RetentionEpochs = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PriceHistoryParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PriceHistoryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceHistoryParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.PriceHistoryParams.epoch_seconds":
+		value := x.EpochSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "dex.v1.PriceHistoryParams.retention_epochs":
+		value := x.RetentionEpochs
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PriceHistoryParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PriceHistoryParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHistoryParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.PriceHistoryParams.epoch_seconds":
+		x.EpochSeconds = value.Uint()
+	case "dex.v1.PriceHistoryParams.retention_epochs":
+		x.RetentionEpochs = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PriceHistoryParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PriceHistoryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHistoryParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.PriceHistoryParams.epoch_seconds":
+		panic(fmt.Errorf("field epoch_seconds of message dex.v1.PriceHistoryParams is not mutable"))
+	case "dex.v1.PriceHistoryParams.retention_epochs":
+		panic(fmt.Errorf("field retention_epochs of message dex.v1.PriceHistoryParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PriceHistoryParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PriceHistoryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceHistoryParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.PriceHistoryParams.epoch_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dex.v1.PriceHistoryParams.retention_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.PriceHistoryParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.PriceHistoryParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceHistoryParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.PriceHistoryParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceHistoryParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHistoryParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceHistoryParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceHistoryParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceHistoryParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochSeconds))
+		}
+		if x.RetentionEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetentionEpochs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceHistoryParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RetentionEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetentionEpochs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EpochSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochSeconds))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceHistoryParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHistoryParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHistoryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochSeconds", wireType)
+				}
+				x.EpochSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetentionEpochs", wireType)
+				}
+				x.RetentionEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetentionEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BatchParams                       protoreflect.MessageDescriptor
+	fd_BatchParams_enabled               protoreflect.FieldDescriptor
+	fd_BatchParams_max_msgs_per_packet   protoreflect.FieldDescriptor
+	fd_BatchParams_max_packet_bytes      protoreflect.FieldDescriptor
+	fd_BatchParams_flush_interval_blocks protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_BatchParams = File_dex_v1_genesis_proto.Messages().ByName("BatchParams")
+	fd_BatchParams_enabled = md_BatchParams.Fields().ByName("enabled")
+	fd_BatchParams_max_msgs_per_packet = md_BatchParams.Fields().ByName("max_msgs_per_packet")
+	fd_BatchParams_max_packet_bytes = md_BatchParams.Fields().ByName("max_packet_bytes")
+	fd_BatchParams_flush_interval_blocks = md_BatchParams.Fields().ByName("flush_interval_blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchParams)(nil)
+
+type fastReflection_BatchParams BatchParams
+
+func (x *BatchParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchParams)(x)
+}
+
+func (x *BatchParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchParams_messageType fastReflection_BatchParams_messageType
+var _ protoreflect.MessageType = fastReflection_BatchParams_messageType{}
+
+type fastReflection_BatchParams_messageType struct{}
+
+func (x fastReflection_BatchParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchParams)(nil)
+}
+func (x fastReflection_BatchParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchParams)
+}
+func (x fastReflection_BatchParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BatchParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BatchParams) Type() protoreflect.MessageType {
+	return _fastReflection_BatchParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BatchParams) New() protoreflect.Message {
+	return new(fastReflection_BatchParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BatchParams) Interface() protoreflect.ProtoMessage {
+	return (*BatchParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BatchParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_BatchParams_enabled, value) {
+			return
+		}
+	}
+	if x.MaxMsgsPerPacket != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxMsgsPerPacket)
+		if !f(fd_BatchParams_max_msgs_per_packet, value) {
+			return
+		}
+	}
+	if x.MaxPacketBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPacketBytes)
+		if !f(fd_BatchParams_max_packet_bytes, value) {
+			return
+		}
+	}
+	if x.FlushIntervalBlocks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.FlushIntervalBlocks)
+		if !f(fd_BatchParams_flush_interval_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BatchParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.BatchParams.enabled":
+		return x.Enabled != false
+	case "dex.v1.BatchParams.max_msgs_per_packet":
+		return x.MaxMsgsPerPacket != uint32(0)
+	case "dex.v1.BatchParams.max_packet_bytes":
+		return x.MaxPacketBytes != uint64(0)
+	case "dex.v1.BatchParams.flush_interval_blocks":
+		return x.FlushIntervalBlocks != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.BatchParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.BatchParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.BatchParams.enabled":
+		x.Enabled = false
+	case "dex.v1.BatchParams.max_msgs_per_packet":
+		x.MaxMsgsPerPacket = uint32(0)
+	case "dex.v1.BatchParams.max_packet_bytes":
+		x.MaxPacketBytes = uint64(0)
+	case "dex.v1.BatchParams.flush_interval_blocks":
+		x.FlushIntervalBlocks = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.BatchParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.BatchParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BatchParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.BatchParams.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "dex.v1.BatchParams.max_msgs_per_packet":
+		value := x.MaxMsgsPerPacket
+		return protoreflect.ValueOfUint32(value)
+	case "dex.v1.BatchParams.max_packet_bytes":
+		value := x.MaxPacketBytes
+		return protoreflect.ValueOfUint64(value)
+	case "dex.v1.BatchParams.flush_interval_blocks":
+		value := x.FlushIntervalBlocks
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.BatchParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.BatchParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.BatchParams.enabled":
+		x.Enabled = value.Bool()
+	case "dex.v1.BatchParams.max_msgs_per_packet":
+		x.MaxMsgsPerPacket = uint32(value.Uint())
+	case "dex.v1.BatchParams.max_packet_bytes":
+		x.MaxPacketBytes = value.Uint()
+	case "dex.v1.BatchParams.flush_interval_blocks":
+		x.FlushIntervalBlocks = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.BatchParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.BatchParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.BatchParams.enabled":
+		panic(fmt.Errorf("field enabled of message dex.v1.BatchParams is not mutable"))
+	case "dex.v1.BatchParams.max_msgs_per_packet":
+		panic(fmt.Errorf("field max_msgs_per_packet of message dex.v1.BatchParams is not mutable"))
+	case "dex.v1.BatchParams.max_packet_bytes":
+		panic(fmt.Errorf("field max_packet_bytes of message dex.v1.BatchParams is not mutable"))
+	case "dex.v1.BatchParams.flush_interval_blocks":
+		panic(fmt.Errorf("field flush_interval_blocks of message dex.v1.BatchParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.BatchParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.BatchParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BatchParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.BatchParams.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "dex.v1.BatchParams.max_msgs_per_packet":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dex.v1.BatchParams.max_packet_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dex.v1.BatchParams.flush_interval_blocks":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.BatchParams"))
+		}
+		panic(fmt.Errorf("message dex.v1.BatchParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BatchParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.BatchParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BatchParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BatchParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BatchParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BatchParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if x.MaxMsgsPerPacket != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMsgsPerPacket))
+		}
+		if x.MaxPacketBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPacketBytes))
+		}
+		if x.FlushIntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.FlushIntervalBlocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BatchParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FlushIntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FlushIntervalBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxPacketBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPacketBytes))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxMsgsPerPacket != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMsgsPerPacket))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BatchParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerPacket", wireType)
+				}
+				x.MaxMsgsPerPacket = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMsgsPerPacket |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPacketBytes", wireType)
+				}
+				x.MaxPacketBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPacketBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FlushIntervalBlocks", wireType)
+				}
+				x.FlushIntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FlushIntervalBlocks |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DenomFilter_2_list)(nil)
+
+type _DenomFilter_2_list struct {
+	list *[]string
+}
+
+func (x *_DenomFilter_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomFilter_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DenomFilter_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomFilter_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomFilter_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomFilter at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_DenomFilter_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomFilter_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DenomFilter_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DenomFilter_3_list)(nil)
+
+type _DenomFilter_3_list struct {
+	list *[]string
+}
+
+func (x *_DenomFilter_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomFilter_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DenomFilter_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomFilter_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomFilter_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomFilter at list field DeniedDenoms as it is not of Message kind"))
+}
+
+func (x *_DenomFilter_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomFilter_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DenomFilter_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DenomFilter                protoreflect.MessageDescriptor
+	fd_DenomFilter_connection_id  protoreflect.FieldDescriptor
+	fd_DenomFilter_allowed_denoms protoreflect.FieldDescriptor
+	fd_DenomFilter_denied_denoms  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dex_v1_genesis_proto_init()
+	md_DenomFilter = File_dex_v1_genesis_proto.Messages().ByName("DenomFilter")
+	fd_DenomFilter_connection_id = md_DenomFilter.Fields().ByName("connection_id")
+	fd_DenomFilter_allowed_denoms = md_DenomFilter.Fields().ByName("allowed_denoms")
+	fd_DenomFilter_denied_denoms = md_DenomFilter.Fields().ByName("denied_denoms")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomFilter)(nil)
+
+type fastReflection_DenomFilter DenomFilter
+
+func (x *DenomFilter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomFilter)(x)
+}
+
+func (x *DenomFilter) slowProtoReflect() protoreflect.Message {
+	mi := &file_dex_v1_genesis_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomFilter_messageType fastReflection_DenomFilter_messageType
+var _ protoreflect.MessageType = fastReflection_DenomFilter_messageType{}
+
+type fastReflection_DenomFilter_messageType struct{}
+
+func (x fastReflection_DenomFilter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomFilter)(nil)
+}
+func (x fastReflection_DenomFilter_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomFilter)
+}
+func (x fastReflection_DenomFilter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomFilter
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomFilter) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomFilter
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomFilter) Type() protoreflect.MessageType {
+	return _fastReflection_DenomFilter_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomFilter) New() protoreflect.Message {
+	return new(fastReflection_DenomFilter)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomFilter) Interface() protoreflect.ProtoMessage {
+	return (*DenomFilter)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomFilter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_DenomFilter_connection_id, value) {
+			return
+		}
+	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_DenomFilter_2_list{list: &x.AllowedDenoms})
+		if !f(fd_DenomFilter_allowed_denoms, value) {
+			return
+		}
+	}
+	if len(x.DeniedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_DenomFilter_3_list{list: &x.DeniedDenoms})
+		if !f(fd_DenomFilter_denied_denoms, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomFilter) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dex.v1.DenomFilter.connection_id":
+		return x.ConnectionId != ""
+	case "dex.v1.DenomFilter.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "dex.v1.DenomFilter.denied_denoms":
+		return len(x.DeniedDenoms) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.DenomFilter"))
+		}
+		panic(fmt.Errorf("message dex.v1.DenomFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomFilter) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dex.v1.DenomFilter.connection_id":
+		x.ConnectionId = ""
+	case "dex.v1.DenomFilter.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "dex.v1.DenomFilter.denied_denoms":
+		x.DeniedDenoms = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.DenomFilter"))
+		}
+		panic(fmt.Errorf("message dex.v1.DenomFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomFilter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dex.v1.DenomFilter.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "dex.v1.DenomFilter.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_DenomFilter_2_list{})
+		}
+		listValue := &_DenomFilter_2_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "dex.v1.DenomFilter.denied_denoms":
+		if len(x.DeniedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_DenomFilter_3_list{})
+		}
+		listValue := &_DenomFilter_3_list{list: &x.DeniedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.DenomFilter"))
+		}
+		panic(fmt.Errorf("message dex.v1.DenomFilter does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomFilter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dex.v1.DenomFilter.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "dex.v1.DenomFilter.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_DenomFilter_2_list)
+		x.AllowedDenoms = *clv.list
+	case "dex.v1.DenomFilter.denied_denoms":
+		lv := value.List()
+		clv := lv.(*_DenomFilter_3_list)
+		x.DeniedDenoms = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.DenomFilter"))
+		}
+		panic(fmt.Errorf("message dex.v1.DenomFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomFilter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.DenomFilter.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_DenomFilter_2_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.DenomFilter.denied_denoms":
+		if x.DeniedDenoms == nil {
+			x.DeniedDenoms = []string{}
+		}
+		value := &_DenomFilter_3_list{list: &x.DeniedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "dex.v1.DenomFilter.connection_id":
+		panic(fmt.Errorf("field connection_id of message dex.v1.DenomFilter is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.DenomFilter"))
+		}
+		panic(fmt.Errorf("message dex.v1.DenomFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomFilter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dex.v1.DenomFilter.connection_id":
+		return protoreflect.ValueOfString("")
+	case "dex.v1.DenomFilter.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DenomFilter_2_list{list: &list})
+	case "dex.v1.DenomFilter.denied_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DenomFilter_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dex.v1.DenomFilter"))
+		}
+		panic(fmt.Errorf("message dex.v1.DenomFilter does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomFilter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dex.v1.DenomFilter", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomFilter) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomFilter) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomFilter) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomFilter) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomFilter)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedDenoms) > 0 {
+			for _, s := range x.DeniedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomFilter)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DeniedDenoms) > 0 {
+			for iNdEx := len(x.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedDenoms[iNdEx])
+				copy(dAtA[i:], x.DeniedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomFilter)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomFilter: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedDenoms = append(x.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: dex/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScreeningMode is how compliance screening decisions are enforced
+type ScreeningMode int32
+
+const (
+	// Operations are not screened
+	ScreeningMode_SCREENING_MODE_OFF ScreeningMode = 0
+	// Operations are screened and decisions recorded, but none is refused
+	ScreeningMode_SCREENING_MODE_LOG_ONLY ScreeningMode = 1
+	// Flagged operations are refused, as are operations that could not be
+	// screened
+	ScreeningMode_SCREENING_MODE_BLOCK ScreeningMode = 2
+)
+
+// Enum value maps for ScreeningMode.
+var (
+	ScreeningMode_name = map[int32]string{
+		0: "SCREENING_MODE_OFF",
+		1: "SCREENING_MODE_LOG_ONLY",
+		2: "SCREENING_MODE_BLOCK",
+	}
+	ScreeningMode_value = map[string]int32{
+		"SCREENING_MODE_OFF":      0,
+		"SCREENING_MODE_LOG_ONLY": 1,
+		"SCREENING_MODE_BLOCK":    2,
+	}
+)
+
+func (x ScreeningMode) Enum() *ScreeningMode {
+	p := new(ScreeningMode)
+	*p = x
+	return p
+}
+
+func (x ScreeningMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreeningMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_dex_v1_genesis_proto_enumTypes[0].Descriptor()
+}
+
+func (ScreeningMode) Type() protoreflect.EnumType {
+	return &file_dex_v1_genesis_proto_enumTypes[0]
+}
+
+func (x ScreeningMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreeningMode.Descriptor instead.
+func (ScreeningMode) EnumDescriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+// ArbitrageAction is how an economically opposite swap is handled
+type ArbitrageAction int32
+
+const (
+	// Only emits an event
+	ArbitrageAction_ARBITRAGE_ACTION_WARN ArbitrageAction = 0
+	// Charges an extra fee on the swap input
+	ArbitrageAction_ARBITRAGE_ACTION_FEE ArbitrageAction = 1
+	// Fails the swap
+	ArbitrageAction_ARBITRAGE_ACTION_REJECT ArbitrageAction = 2
+)
+
+// Enum value maps for ArbitrageAction.
+var (
+	ArbitrageAction_name = map[int32]string{
+		0: "ARBITRAGE_ACTION_WARN",
+		1: "ARBITRAGE_ACTION_FEE",
+		2: "ARBITRAGE_ACTION_REJECT",
+	}
+	ArbitrageAction_value = map[string]int32{
+		"ARBITRAGE_ACTION_WARN":   0,
+		"ARBITRAGE_ACTION_FEE":    1,
+		"ARBITRAGE_ACTION_REJECT": 2,
+	}
+)
+
+func (x ArbitrageAction) Enum() *ArbitrageAction {
+	p := new(ArbitrageAction)
+	*p = x
+	return p
+}
+
+func (x ArbitrageAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArbitrageAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_dex_v1_genesis_proto_enumTypes[1].Descriptor()
+}
+
+func (ArbitrageAction) Type() protoreflect.EnumType {
+	return &file_dex_v1_genesis_proto_enumTypes[1]
+}
+
+func (x ArbitrageAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArbitrageAction.Descriptor instead.
+func (ArbitrageAction) EnumDescriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+// GenesisState defines the DEX module's genesis state
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Module parameters
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// IBC port ID for the module
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// Registered DEX accounts
+	Accounts []*InterchainDEXAccount `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Account sequence counter
+	AccountSequence uint64 `protobuf:"varint,4,opt,name=account_sequence,json=accountSequence,proto3" json:"account_sequence,omitempty"`
+	// ICA batching parameters
+	BatchParams *BatchParams `protobuf:"bytes,5,opt,name=batch_params,json=batchParams,proto3" json:"batch_params,omitempty"`
+	// Per-connection denom allow/deny lists
+	DenomFilters []*DenomFilter `protobuf:"bytes,6,rep,name=denom_filters,json=denomFilters,proto3" json:"denom_filters,omitempty"`
+	// Price history aggregation parameters
+	PriceHistoryParams *PriceHistoryParams `protobuf:"bytes,7,opt,name=price_history_params,json=priceHistoryParams,proto3" json:"price_history_params,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *GenesisState) GetAccounts() []*InterchainDEXAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAccountSequence() uint64 {
+	if x != nil {
+		return x.AccountSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetBatchParams() *BatchParams {
+	if x != nil {
+		return x.BatchParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomFilters() []*DenomFilter {
+	if x != nil {
+		return x.DenomFilters
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPriceHistoryParams() *PriceHistoryParams {
+	if x != nil {
+		return x.PriceHistoryParams
+	}
+	return nil
+}
+
+// Params defines the parameters for the DEX module
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enable/disable the module
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Maximum accounts per DID
+	MaxAccountsPerDid uint32 `protobuf:"varint,2,opt,name=max_accounts_per_did,json=maxAccountsPerDid,proto3" json:"max_accounts_per_did,omitempty"`
+	// Default timeout for ICA operations (in seconds)
+	DefaultTimeoutSeconds uint64 `protobuf:"varint,3,opt,name=default_timeout_seconds,json=defaultTimeoutSeconds,proto3" json:"default_timeout_seconds,omitempty"`
+	// Allowed DEX connections
+	AllowedConnections []string `protobuf:"bytes,4,rep,name=allowed_connections,json=allowedConnections,proto3" json:"allowed_connections,omitempty"`
+	// Minimum swap amount (in base denom)
+	MinSwapAmount string `protobuf:"bytes,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
+	// Maximum daily volume per DID (in USD equivalent)
+	MaxDailyVolume string `protobuf:"bytes,6,opt,name=max_daily_volume,json=maxDailyVolume,proto3" json:"max_daily_volume,omitempty"`
+	// Rate limit parameters
+	RateLimits *RateLimitParams `protobuf:"bytes,7,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// Fee parameters
+	Fees *FeeParams `protobuf:"bytes,8,opt,name=fees,proto3" json:"fees,omitempty"`
+	// Connections to Noble whose swaps are routed over IBC transfer
+	NobleRoutes []*NobleRoute `protobuf:"bytes,9,rep,name=noble_routes,json=nobleRoutes,proto3" json:"noble_routes,omitempty"`
+	// Swap estimate pricing parameters
+	Pricing *PricingParams `protobuf:"bytes,10,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	// Pools the swap router may route through
+	Routing *RoutingParams `protobuf:"bytes,11,opt,name=routing,proto3" json:"routing,omitempty"`
+	// Remote order book monitoring
+	OrderMonitor *OrderMonitorParams `protobuf:"bytes,12,opt,name=order_monitor,json=orderMonitor,proto3" json:"order_monitor,omitempty"`
+	// Reject UCAN tokens when no permission validator is configured instead
+	// of skipping their validation
+	StrictUcan bool `protobuf:"varint,13,opt,name=strict_ucan,json=strictUcan,proto3" json:"strict_ucan,omitempty"`
+	// Compliance screening of large swaps, orders and OTC offers
+	Screening *ScreeningParams `protobuf:"bytes,14,opt,name=screening,proto3" json:"screening,omitempty"`
+	// Detection of opposite swaps across connections
+	Arbitrage *ArbitrageParams `protobuf:"bytes,15,opt,name=arbitrage,proto3" json:"arbitrage,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Params) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Params) GetMaxAccountsPerDid() uint32 {
+	if x != nil {
+		return x.MaxAccountsPerDid
+	}
+	return 0
+}
+
+func (x *Params) GetDefaultTimeoutSeconds() uint64 {
+	if x != nil {
+		return x.DefaultTimeoutSeconds
+	}
+	return 0
 }
 
 func (x *Params) GetAllowedConnections() []string {
 	if x != nil {
-		return x.AllowedConnections
+		return x.AllowedConnections
+	}
+	return nil
+}
+
+func (x *Params) GetMinSwapAmount() string {
+	if x != nil {
+		return x.MinSwapAmount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxDailyVolume() string {
+	if x != nil {
+		return x.MaxDailyVolume
+	}
+	return ""
+}
+
+func (x *Params) GetRateLimits() *RateLimitParams {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *Params) GetFees() *FeeParams {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *Params) GetNobleRoutes() []*NobleRoute {
+	if x != nil {
+		return x.NobleRoutes
+	}
+	return nil
+}
+
+func (x *Params) GetPricing() *PricingParams {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *Params) GetRouting() *RoutingParams {
+	if x != nil {
+		return x.Routing
+	}
+	return nil
+}
+
+func (x *Params) GetOrderMonitor() *OrderMonitorParams {
+	if x != nil {
+		return x.OrderMonitor
+	}
+	return nil
+}
+
+func (x *Params) GetStrictUcan() bool {
+	if x != nil {
+		return x.StrictUcan
+	}
+	return false
+}
+
+func (x *Params) GetScreening() *ScreeningParams {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+func (x *Params) GetArbitrage() *ArbitrageParams {
+	if x != nil {
+		return x.Arbitrage
+	}
+	return nil
+}
+
+// RateLimitParams defines rate limiting parameters
+type RateLimitParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum operations per block
+	MaxOpsPerBlock uint32 `protobuf:"varint,1,opt,name=max_ops_per_block,json=maxOpsPerBlock,proto3" json:"max_ops_per_block,omitempty"`
+	// Maximum operations per DID per day
+	MaxOpsPerDidPerDay uint32 `protobuf:"varint,2,opt,name=max_ops_per_did_per_day,json=maxOpsPerDidPerDay,proto3" json:"max_ops_per_did_per_day,omitempty"`
+	// Cooldown period between operations (in blocks)
+	CooldownBlocks uint32 `protobuf:"varint,3,opt,name=cooldown_blocks,json=cooldownBlocks,proto3" json:"cooldown_blocks,omitempty"`
+	// Factor applied to max_ops_per_did_per_day and max_daily_volume for DIDs
+	// holding a verification badge; 0 and 1 grant verified DIDs no more
+	VerifiedMultiplier uint32 `protobuf:"varint,4,opt,name=verified_multiplier,json=verifiedMultiplier,proto3" json:"verified_multiplier,omitempty"`
+}
+
+func (x *RateLimitParams) Reset() {
+	*x = RateLimitParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitParams) ProtoMessage() {}
+
+// Deprecated: Use RateLimitParams.ProtoReflect.Descriptor instead.
+func (*RateLimitParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RateLimitParams) GetMaxOpsPerBlock() uint32 {
+	if x != nil {
+		return x.MaxOpsPerBlock
+	}
+	return 0
+}
+
+func (x *RateLimitParams) GetMaxOpsPerDidPerDay() uint32 {
+	if x != nil {
+		return x.MaxOpsPerDidPerDay
+	}
+	return 0
+}
+
+func (x *RateLimitParams) GetCooldownBlocks() uint32 {
+	if x != nil {
+		return x.CooldownBlocks
+	}
+	return 0
+}
+
+func (x *RateLimitParams) GetVerifiedMultiplier() uint32 {
+	if x != nil {
+		return x.VerifiedMultiplier
+	}
+	return 0
+}
+
+// FeeParams defines fee parameters for DEX operations
+type FeeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Platform fee for swaps (basis points, e.g., 30 = 0.3%)
+	SwapFeeBps uint32 `protobuf:"varint,1,opt,name=swap_fee_bps,json=swapFeeBps,proto3" json:"swap_fee_bps,omitempty"`
+	// Platform fee for liquidity operations
+	LiquidityFeeBps uint32 `protobuf:"varint,2,opt,name=liquidity_fee_bps,json=liquidityFeeBps,proto3" json:"liquidity_fee_bps,omitempty"`
+	// Platform fee for orders
+	OrderFeeBps uint32 `protobuf:"varint,3,opt,name=order_fee_bps,json=orderFeeBps,proto3" json:"order_fee_bps,omitempty"`
+	// Fee collector address on the host chain of each connection; operations
+	// on a connection without one are not charged
+	FeeCollectors []*FeeCollector `protobuf:"bytes,5,rep,name=fee_collectors,json=feeCollectors,proto3" json:"fee_collectors,omitempty"`
+}
+
+func (x *FeeParams) Reset() {
+	*x = FeeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeParams) ProtoMessage() {}
+
+// Deprecated: Use FeeParams.ProtoReflect.Descriptor instead.
+func (*FeeParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeParams) GetSwapFeeBps() uint32 {
+	if x != nil {
+		return x.SwapFeeBps
+	}
+	return 0
+}
+
+func (x *FeeParams) GetLiquidityFeeBps() uint32 {
+	if x != nil {
+		return x.LiquidityFeeBps
+	}
+	return 0
+}
+
+func (x *FeeParams) GetOrderFeeBps() uint32 {
+	if x != nil {
+		return x.OrderFeeBps
+	}
+	return 0
+}
+
+func (x *FeeParams) GetFeeCollectors() []*FeeCollector {
+	if x != nil {
+		return x.FeeCollectors
+	}
+	return nil
+}
+
+// FeeCollector is the address platform fees are sent to on a connection's
+// host chain
+type FeeCollector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IBC connection the collector receives fees on
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Address on the host chain
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *FeeCollector) Reset() {
+	*x = FeeCollector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeCollector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeCollector) ProtoMessage() {}
+
+// Deprecated: Use FeeCollector.ProtoReflect.Descriptor instead.
+func (*FeeCollector) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeeCollector) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *FeeCollector) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// PricingParams controls how swap estimates are priced. Estimates use pool
+// reserves fetched from the host chain over ICA while they are fresh, and the
+// oracle otherwise.
+type PricingParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seconds a pool reserve snapshot is used for estimates; zero uses the
+	// default of one minute
+	ReservesTtlSeconds uint64 `protobuf:"varint,1,opt,name=reserves_ttl_seconds,json=reservesTtlSeconds,proto3" json:"reserves_ttl_seconds,omitempty"`
+	// Reject estimates without fresh pool reserves instead of falling back to
+	// oracle prices
+	RequireReserves bool `protobuf:"varint,2,opt,name=require_reserves,json=requireReserves,proto3" json:"require_reserves,omitempty"`
+	// USD stablecoin, as named on the swap chain, that pool reserves price
+	// other denoms against when no oracle module is configured
+	UsdDenom string `protobuf:"bytes,3,opt,name=usd_denom,json=usdDenom,proto3" json:"usd_denom,omitempty"`
+	// Decimal exponent of usd_denom, e.g. 6 for uusdc
+	UsdExponent uint32 `protobuf:"varint,4,opt,name=usd_exponent,json=usdExponent,proto3" json:"usd_exponent,omitempty"`
+}
+
+func (x *PricingParams) Reset() {
+	*x = PricingParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricingParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingParams) ProtoMessage() {}
+
+// Deprecated: Use PricingParams.ProtoReflect.Descriptor instead.
+func (*PricingParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PricingParams) GetReservesTtlSeconds() uint64 {
+	if x != nil {
+		return x.ReservesTtlSeconds
+	}
+	return 0
+}
+
+func (x *PricingParams) GetRequireReserves() bool {
+	if x != nil {
+		return x.RequireReserves
+	}
+	return false
+}
+
+func (x *PricingParams) GetUsdDenom() string {
+	if x != nil {
+		return x.UsdDenom
+	}
+	return ""
+}
+
+func (x *PricingParams) GetUsdExponent() uint32 {
+	if x != nil {
+		return x.UsdExponent
+	}
+	return 0
+}
+
+// RoutingParams configures the swap router, which picks the route with the
+// best estimated output when a swap names none.
+type RoutingParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pools the router may route through
+	Pools []*SwapPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// Most hops a selected route may take; zero uses the default of three
+	MaxHops uint32 `protobuf:"varint,2,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+}
+
+func (x *RoutingParams) Reset() {
+	*x = RoutingParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoutingParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingParams) ProtoMessage() {}
+
+// Deprecated: Use RoutingParams.ProtoReflect.Descriptor instead.
+func (*RoutingParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoutingParams) GetPools() []*SwapPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *RoutingParams) GetMaxHops() uint32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+// OrderMonitorParams configures the monitor that queries remote order books
+// for the status of open limit orders
+type OrderMonitorParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Blocks between order status checks; zero disables the monitor
+	IntervalBlocks uint64 `protobuf:"varint,1,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// Most orders queried per check; zero uses the default of 20
+	MaxOrdersPerCheck uint32 `protobuf:"varint,2,opt,name=max_orders_per_check,json=maxOrdersPerCheck,proto3" json:"max_orders_per_check,omitempty"`
+	// Order book contracts queried for each connection
+	OrderBooks []*OrderBook `protobuf:"bytes,3,rep,name=order_books,json=orderBooks,proto3" json:"order_books,omitempty"`
+}
+
+func (x *OrderMonitorParams) Reset() {
+	*x = OrderMonitorParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderMonitorParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderMonitorParams) ProtoMessage() {}
+
+// Deprecated: Use OrderMonitorParams.ProtoReflect.Descriptor instead.
+func (*OrderMonitorParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OrderMonitorParams) GetIntervalBlocks() uint64 {
+	if x != nil {
+		return x.IntervalBlocks
+	}
+	return 0
+}
+
+func (x *OrderMonitorParams) GetMaxOrdersPerCheck() uint32 {
+	if x != nil {
+		return x.MaxOrdersPerCheck
+	}
+	return 0
+}
+
+func (x *OrderMonitorParams) GetOrderBooks() []*OrderBook {
+	if x != nil {
+		return x.OrderBooks
+	}
+	return nil
+}
+
+// ScreeningParams configures compliance screening. When enabled, swaps,
+// limit orders and OTC offers at or above a threshold are passed to the
+// screener the node was configured with before any funds move.
+type ScreeningParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How screening decisions are enforced
+	Mode ScreeningMode `protobuf:"varint,1,opt,name=mode,proto3,enum=dex.v1.ScreeningMode" json:"mode,omitempty"`
+	// USD value from which an operation is screened; operations the oracle
+	// cannot price are screened too
+	ThresholdUsd string `protobuf:"bytes,2,opt,name=threshold_usd,json=thresholdUsd,proto3" json:"threshold_usd,omitempty"`
+	// Amounts from which an operation is screened, per denom, whatever their
+	// price. Without any threshold every operation is screened.
+	Thresholds []*v1beta1.Coin `protobuf:"bytes,3,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
+}
+
+func (x *ScreeningParams) Reset() {
+	*x = ScreeningParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreeningParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningParams) ProtoMessage() {}
+
+// Deprecated: Use ScreeningParams.ProtoReflect.Descriptor instead.
+func (*ScreeningParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScreeningParams) GetMode() ScreeningMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ScreeningMode_SCREENING_MODE_OFF
+}
+
+func (x *ScreeningParams) GetThresholdUsd() string {
+	if x != nil {
+		return x.ThresholdUsd
+	}
+	return ""
+}
+
+func (x *ScreeningParams) GetThresholds() []*v1beta1.Coin {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+// ArbitrageParams controls detection of a DID swapping A->B on one connection
+// and B->A on another within a short window
+type ArbitrageParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Toggles detection; disabled params record nothing
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Blocks before the current one that still count; zero means the same
+	// block only
+	WindowBlocks uint32 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// Applied to the second, opposite swap
+	Action ArbitrageAction `protobuf:"varint,3,opt,name=action,proto3,enum=dex.v1.ArbitrageAction" json:"action,omitempty"`
+	// Charged on the swap input when action is fee
+	ExtraFeeBps uint32 `protobuf:"varint,4,opt,name=extra_fee_bps,json=extraFeeBps,proto3" json:"extra_fee_bps,omitempty"`
+}
+
+func (x *ArbitrageParams) Reset() {
+	*x = ArbitrageParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArbitrageParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArbitrageParams) ProtoMessage() {}
+
+// Deprecated: Use ArbitrageParams.ProtoReflect.Descriptor instead.
+func (*ArbitrageParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArbitrageParams) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ArbitrageParams) GetWindowBlocks() uint32 {
+	if x != nil {
+		return x.WindowBlocks
+	}
+	return 0
+}
+
+func (x *ArbitrageParams) GetAction() ArbitrageAction {
+	if x != nil {
+		return x.Action
+	}
+	return ArbitrageAction_ARBITRAGE_ACTION_WARN
+}
+
+func (x *ArbitrageParams) GetExtraFeeBps() uint32 {
+	if x != nil {
+		return x.ExtraFeeBps
+	}
+	return 0
+}
+
+// OrderBook is the order book contract holding a connection's limit orders
+type OrderBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Connection to the chain hosting the order book
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Order book contract address on the host chain
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (x *OrderBook) Reset() {
+	*x = OrderBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBook) ProtoMessage() {}
+
+// Deprecated: Use OrderBook.ProtoReflect.Descriptor instead.
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OrderBook) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *OrderBook) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+// SwapPool is a swap chain pool the router may route through
+type SwapPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Connection whose swaps may use the pool. Pools used by a Noble route are
+	// listed under the Noble connection.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Pool ID on the swap chain
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Denoms the pool trades, as named on the swap chain
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// Pool swap fee in basis points, taken at every hop through the pool
+	SwapFeeBps uint32 `protobuf:"varint,4,opt,name=swap_fee_bps,json=swapFeeBps,proto3" json:"swap_fee_bps,omitempty"`
+}
+
+func (x *SwapPool) Reset() {
+	*x = SwapPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Params) GetMinSwapAmount() string {
+func (x *SwapPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapPool) ProtoMessage() {}
+
+// Deprecated: Use SwapPool.ProtoReflect.Descriptor instead.
+func (*SwapPool) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SwapPool) GetConnectionId() string {
 	if x != nil {
-		return x.MinSwapAmount
+		return x.ConnectionId
 	}
 	return ""
 }
 
-func (x *Params) GetMaxDailyVolume() string {
+func (x *SwapPool) GetPoolId() uint64 {
 	if x != nil {
-		return x.MaxDailyVolume
+		return x.PoolId
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetRateLimits() *RateLimitParams {
+func (x *SwapPool) GetDenoms() []string {
 	if x != nil {
-		return x.RateLimits
+		return x.Denoms
 	}
 	return nil
 }
 
-func (x *Params) GetFees() *FeeParams {
+func (x *SwapPool) GetSwapFeeBps() uint32 {
 	if x != nil {
-		return x.Fees
+		return x.SwapFeeBps
 	}
-	return nil
+	return 0
 }
 
-// RateLimitParams defines rate limiting parameters
-type RateLimitParams struct {
+// NobleRoute routes swaps from a Noble ICA account to a swap chain. Noble has
+// no general purpose pools, so the tokens are sent over IBC with a memo that
+// swaps them on arrival.
+type NobleRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maximum operations per block
-	MaxOpsPerBlock uint32 `protobuf:"varint,1,opt,name=max_ops_per_block,json=maxOpsPerBlock,proto3" json:"max_ops_per_block,omitempty"`
-	// Maximum operations per DID per day
-	MaxOpsPerDidPerDay uint32 `protobuf:"varint,2,opt,name=max_ops_per_did_per_day,json=maxOpsPerDidPerDay,proto3" json:"max_ops_per_did_per_day,omitempty"`
-	// Cooldown period between operations (in blocks)
-	CooldownBlocks uint32 `protobuf:"varint,3,opt,name=cooldown_blocks,json=cooldownBlocks,proto3" json:"cooldown_blocks,omitempty"`
+	// IBC connection to Noble
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Noble's transfer channel to the swap chain
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Cross-chain swap contract on the swap chain that the memo calls
+	SwapContract string `protobuf:"bytes,3,opt,name=swap_contract,json=swapContract,proto3" json:"swap_contract,omitempty"`
 }
 
-func (x *RateLimitParams) Reset() {
-	*x = RateLimitParams{}
+func (x *NobleRoute) Reset() {
+	*x = NobleRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dex_v1_genesis_proto_msgTypes[2]
+		mi := &file_dex_v1_genesis_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimitParams) String() string {
+func (x *NobleRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimitParams) ProtoMessage() {}
+func (*NobleRoute) ProtoMessage() {}
 
-// Deprecated: Use RateLimitParams.ProtoReflect.Descriptor instead.
-func (*RateLimitParams) Descriptor() ([]byte, []int) {
-	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use NobleRoute.ProtoReflect.Descriptor instead.
+func (*NobleRoute) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RateLimitParams) GetMaxOpsPerBlock() uint32 {
+func (x *NobleRoute) GetConnectionId() string {
 	if x != nil {
-		return x.MaxOpsPerBlock
+		return x.ConnectionId
 	}
-	return 0
+	return ""
 }
 
-func (x *RateLimitParams) GetMaxOpsPerDidPerDay() uint32 {
+func (x *NobleRoute) GetChannelId() string {
 	if x != nil {
-		return x.MaxOpsPerDidPerDay
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *NobleRoute) GetSwapContract() string {
+	if x != nil {
+		return x.SwapContract
+	}
+	return ""
+}
+
+// PriceHistoryParams controls how observed prices are aggregated and retained
+type PriceHistoryParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Length of a single aggregation epoch
+	EpochSeconds uint64 `protobuf:"varint,1,opt,name=epoch_seconds,json=epochSeconds,proto3" json:"epoch_seconds,omitempty"`
+	// Epochs kept before being pruned; zero keeps everything
+	RetentionEpochs uint64 `protobuf:"varint,2,opt,name=retention_epochs,json=retentionEpochs,proto3" json:"retention_epochs,omitempty"`
+}
+
+func (x *PriceHistoryParams) Reset() {
+	*x = PriceHistoryParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceHistoryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryParams) ProtoMessage() {}
+
+// Deprecated: Use PriceHistoryParams.ProtoReflect.Descriptor instead.
+func (*PriceHistoryParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PriceHistoryParams) GetEpochSeconds() uint64 {
+	if x != nil {
+		return x.EpochSeconds
 	}
 	return 0
 }
 
-func (x *RateLimitParams) GetCooldownBlocks() uint32 {
+func (x *PriceHistoryParams) GetRetentionEpochs() uint64 {
 	if x != nil {
-		return x.CooldownBlocks
+		return x.RetentionEpochs
 	}
 	return 0
 }
 
-// FeeParams defines fee parameters for DEX operations
-type FeeParams struct {
+// BatchParams controls how ICA messages are batched into packets
+type BatchParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Platform fee for swaps (basis points, e.g., 30 = 0.3%)
-	SwapFeeBps uint32 `protobuf:"varint,1,opt,name=swap_fee_bps,json=swapFeeBps,proto3" json:"swap_fee_bps,omitempty"`
-	// Platform fee for liquidity operations
-	LiquidityFeeBps uint32 `protobuf:"varint,2,opt,name=liquidity_fee_bps,json=liquidityFeeBps,proto3" json:"liquidity_fee_bps,omitempty"`
-	// Platform fee for orders
-	OrderFeeBps uint32 `protobuf:"varint,3,opt,name=order_fee_bps,json=orderFeeBps,proto3" json:"order_fee_bps,omitempty"`
-	// Fee collector address
-	FeeCollector string `protobuf:"bytes,4,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// Toggles batching; when disabled every operation is sent immediately
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Maximum number of messages in a single CosmosTx
+	MaxMsgsPerPacket uint32 `protobuf:"varint,2,opt,name=max_msgs_per_packet,json=maxMsgsPerPacket,proto3" json:"max_msgs_per_packet,omitempty"`
+	// Maximum serialized size of a batched CosmosTx
+	MaxPacketBytes uint64 `protobuf:"varint,3,opt,name=max_packet_bytes,json=maxPacketBytes,proto3" json:"max_packet_bytes,omitempty"`
+	// Number of blocks a batch may wait before it is flushed
+	FlushIntervalBlocks uint32 `protobuf:"varint,4,opt,name=flush_interval_blocks,json=flushIntervalBlocks,proto3" json:"flush_interval_blocks,omitempty"`
 }
 
-func (x *FeeParams) Reset() {
-	*x = FeeParams{}
+func (x *BatchParams) Reset() {
+	*x = BatchParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dex_v1_genesis_proto_msgTypes[3]
+		mi := &file_dex_v1_genesis_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeParams) String() string {
+func (x *BatchParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeParams) ProtoMessage() {}
+func (*BatchParams) ProtoMessage() {}
 
-// Deprecated: Use FeeParams.ProtoReflect.Descriptor instead.
-func (*FeeParams) Descriptor() ([]byte, []int) {
-	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BatchParams.ProtoReflect.Descriptor instead.
+func (*BatchParams) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FeeParams) GetSwapFeeBps() uint32 {
+func (x *BatchParams) GetEnabled() bool {
 	if x != nil {
-		return x.SwapFeeBps
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BatchParams) GetMaxMsgsPerPacket() uint32 {
+	if x != nil {
+		return x.MaxMsgsPerPacket
 	}
 	return 0
 }
 
-func (x *FeeParams) GetLiquidityFeeBps() uint32 {
+func (x *BatchParams) GetMaxPacketBytes() uint64 {
 	if x != nil {
-		return x.LiquidityFeeBps
+		return x.MaxPacketBytes
 	}
 	return 0
 }
 
-func (x *FeeParams) GetOrderFeeBps() uint32 {
+func (x *BatchParams) GetFlushIntervalBlocks() uint32 {
 	if x != nil {
-		return x.OrderFeeBps
+		return x.FlushIntervalBlocks
 	}
 	return 0
 }
 
-func (x *FeeParams) GetFeeCollector() string {
+// DenomFilter is a governance-managed allow/deny list of denoms for a connection
+type DenomFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IBC connection the filter applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Denoms that may be traded; empty allows every denom not denied.
+	// A trailing "*" matches by prefix, e.g. "ibc/*".
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// Denoms that may never be traded; deny entries always win
+	DeniedDenoms []string `protobuf:"bytes,3,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+}
+
+func (x *DenomFilter) Reset() {
+	*x = DenomFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dex_v1_genesis_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomFilter) ProtoMessage() {}
+
+// Deprecated: Use DenomFilter.ProtoReflect.Descriptor instead.
+func (*DenomFilter) Descriptor() ([]byte, []int) {
+	return file_dex_v1_genesis_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DenomFilter) GetConnectionId() string {
 	if x != nil {
-		return x.FeeCollector
+		return x.ConnectionId
 	}
 	return ""
 }
 
+func (x *DenomFilter) GetAllowedDenoms() []string {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *DenomFilter) GetDeniedDenoms() []string {
+	if x != nil {
+		return x.DeniedDenoms
+	}
+	return nil
+}
+
 var File_dex_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_dex_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x63, 0x61,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
 	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31,
 	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
@@ -2993,41 +11580,82 @@ var file_dex_v1_genesis_proto_rawDesc = []byte{
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x22, 0x81, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x44, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
-	0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61,
-	0x78, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x66, 0x65, 0x65,
-	0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x52, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x6d,
-	0x61, 0x78, 0x5f, 0x6f, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x73, 0x50, 0x65,
-	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70,
-	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x73, 0x50,
-	0x65, 0x72, 0x44, 0x69, 0x64, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x52, 0x0a, 0x14, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8e, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x44, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d,
+	0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x66,
+	0x65, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x35, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x45,
+	0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f,
+	0x75, 0x63, 0x61, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x55, 0x63, 0x61, 0x6e, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x09, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x6f, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x73, 0x50, 0x65,
+	0x72, 0x44, 0x69, 0x64, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62,
 	0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65,
 	0x65, 0x42, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
@@ -3035,17 +11663,136 @@ var file_dex_v1_genesis_proto_rawDesc = []byte{
 	0x0f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73,
 	0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70,
 	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x65,
-	0x65, 0x42, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x7d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x78, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x65, 0x78, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x06, 0x44, 0x65, 0x78, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x65, 0x78, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x07, 0x44, 0x65, 0x78, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x42, 0x70, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64,
+	0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0d, 0x66,
+	0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4d, 0x0a, 0x0c,
+	0x46, 0x65, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0d,
+	0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x73, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73,
+	0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x64, 0x5f, 0x65,
+	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75,
+	0x73, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x0d, 0x52, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x48, 0x6f, 0x70, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x50, 0x65, 0x72,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x22,
+	0xce, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x75, 0x73, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x55, 0x73, 0x64, 0x12, 0x6b, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x62,
+	0x69, 0x74, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x22, 0x4c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x22, 0x75, 0x0a, 0x0a, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x22, 0x64, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x67, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x66,
+	0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x66, 0x6c, 0x75, 0x73,
+	0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
+	0x7e, 0x0a, 0x0b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x2a,
+	0x64, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x52, 0x45,
+	0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49,
+	0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x69, 0x0a, 0x0f, 0x41, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61,
+	0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x42, 0x49,
+	0x54, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52,
+	0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0x7d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d,
+	0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x78, 0x2f,
+	0x76, 0x31, 0x3b, 0x64, 0x65, 0x78, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02,
+	0x06, 0x44, 0x65, 0x78, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x65, 0x78, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x12, 0x44, 0x65, 0x78, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x65, 0x78, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3060,24 +11807,55 @@ func file_dex_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_dex_v1_genesis_proto_rawDescData
 }
 
-var file_dex_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dex_v1_genesis_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_dex_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_dex_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),         // 0: dex.v1.GenesisState
-	(*Params)(nil),               // 1: dex.v1.Params
-	(*RateLimitParams)(nil),      // 2: dex.v1.RateLimitParams
-	(*FeeParams)(nil),            // 3: dex.v1.FeeParams
-	(*InterchainDEXAccount)(nil), // 4: dex.v1.InterchainDEXAccount
+	(ScreeningMode)(0),           // 0: dex.v1.ScreeningMode
+	(ArbitrageAction)(0),         // 1: dex.v1.ArbitrageAction
+	(*GenesisState)(nil),         // 2: dex.v1.GenesisState
+	(*Params)(nil),               // 3: dex.v1.Params
+	(*RateLimitParams)(nil),      // 4: dex.v1.RateLimitParams
+	(*FeeParams)(nil),            // 5: dex.v1.FeeParams
+	(*FeeCollector)(nil),         // 6: dex.v1.FeeCollector
+	(*PricingParams)(nil),        // 7: dex.v1.PricingParams
+	(*RoutingParams)(nil),        // 8: dex.v1.RoutingParams
+	(*OrderMonitorParams)(nil),   // 9: dex.v1.OrderMonitorParams
+	(*ScreeningParams)(nil),      // 10: dex.v1.ScreeningParams
+	(*ArbitrageParams)(nil),      // 11: dex.v1.ArbitrageParams
+	(*OrderBook)(nil),            // 12: dex.v1.OrderBook
+	(*SwapPool)(nil),             // 13: dex.v1.SwapPool
+	(*NobleRoute)(nil),           // 14: dex.v1.NobleRoute
+	(*PriceHistoryParams)(nil),   // 15: dex.v1.PriceHistoryParams
+	(*BatchParams)(nil),          // 16: dex.v1.BatchParams
+	(*DenomFilter)(nil),          // 17: dex.v1.DenomFilter
+	(*InterchainDEXAccount)(nil), // 18: dex.v1.InterchainDEXAccount
+	(*v1beta1.Coin)(nil),         // 19: cosmos.base.v1beta1.Coin
 }
 var file_dex_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: dex.v1.GenesisState.params:type_name -> dex.v1.Params
-	4, // 1: dex.v1.GenesisState.accounts:type_name -> dex.v1.InterchainDEXAccount
-	2, // 2: dex.v1.Params.rate_limits:type_name -> dex.v1.RateLimitParams
-	3, // 3: dex.v1.Params.fees:type_name -> dex.v1.FeeParams
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3,  // 0: dex.v1.GenesisState.params:type_name -> dex.v1.Params
+	18, // 1: dex.v1.GenesisState.accounts:type_name -> dex.v1.InterchainDEXAccount
+	16, // 2: dex.v1.GenesisState.batch_params:type_name -> dex.v1.BatchParams
+	17, // 3: dex.v1.GenesisState.denom_filters:type_name -> dex.v1.DenomFilter
+	15, // 4: dex.v1.GenesisState.price_history_params:type_name -> dex.v1.PriceHistoryParams
+	4,  // 5: dex.v1.Params.rate_limits:type_name -> dex.v1.RateLimitParams
+	5,  // 6: dex.v1.Params.fees:type_name -> dex.v1.FeeParams
+	14, // 7: dex.v1.Params.noble_routes:type_name -> dex.v1.NobleRoute
+	7,  // 8: dex.v1.Params.pricing:type_name -> dex.v1.PricingParams
+	8,  // 9: dex.v1.Params.routing:type_name -> dex.v1.RoutingParams
+	9,  // 10: dex.v1.Params.order_monitor:type_name -> dex.v1.OrderMonitorParams
+	10, // 11: dex.v1.Params.screening:type_name -> dex.v1.ScreeningParams
+	11, // 12: dex.v1.Params.arbitrage:type_name -> dex.v1.ArbitrageParams
+	6,  // 13: dex.v1.FeeParams.fee_collectors:type_name -> dex.v1.FeeCollector
+	13, // 14: dex.v1.RoutingParams.pools:type_name -> dex.v1.SwapPool
+	12, // 15: dex.v1.OrderMonitorParams.order_books:type_name -> dex.v1.OrderBook
+	0,  // 16: dex.v1.ScreeningParams.mode:type_name -> dex.v1.ScreeningMode
+	19, // 17: dex.v1.ScreeningParams.thresholds:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 18: dex.v1.ArbitrageParams.action:type_name -> dex.v1.ArbitrageAction
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_dex_v1_genesis_proto_init() }
@@ -3135,19 +11913,164 @@ func file_dex_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_dex_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeCollector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PricingParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoutingParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderMonitorParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArbitrageParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NobleRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceHistoryParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dex_v1_genesis_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dex_v1_genesis_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_dex_v1_genesis_proto_goTypes,
 		DependencyIndexes: file_dex_v1_genesis_proto_depIdxs,
+		EnumInfos:         file_dex_v1_genesis_proto_enumTypes,
 		MessageInfos:      file_dex_v1_genesis_proto_msgTypes,
 	}.Build()
 	File_dex_v1_genesis_proto = out.File
@@ -19,13 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/dex.v1.Query/Params"
-	Query_Account_FullMethodName  = "/dex.v1.Query/Account"
-	Query_Accounts_FullMethodName = "/dex.v1.Query/Accounts"
-	Query_Balance_FullMethodName  = "/dex.v1.Query/Balance"
-	Query_Pool_FullMethodName     = "/dex.v1.Query/Pool"
-	Query_Orders_FullMethodName   = "/dex.v1.Query/Orders"
-	Query_History_FullMethodName  = "/dex.v1.Query/History"
+	Query_Params_FullMethodName            = "/dex.v1.Query/Params"
+	Query_Account_FullMethodName           = "/dex.v1.Query/Account"
+	Query_Accounts_FullMethodName          = "/dex.v1.Query/Accounts"
+	Query_Balance_FullMethodName           = "/dex.v1.Query/Balance"
+	Query_Pool_FullMethodName              = "/dex.v1.Query/Pool"
+	Query_EstimateSwap_FullMethodName      = "/dex.v1.Query/EstimateSwap"
+	Query_SwapRoute_FullMethodName         = "/dex.v1.Query/SwapRoute"
+	Query_Orders_FullMethodName            = "/dex.v1.Query/Orders"
+	Query_OrdersByDID_FullMethodName       = "/dex.v1.Query/OrdersByDID"
+	Query_History_FullMethodName           = "/dex.v1.Query/History"
+	Query_DenomFilter_FullMethodName       = "/dex.v1.Query/DenomFilter"
+	Query_DailyVolume_FullMethodName       = "/dex.v1.Query/DailyVolume"
+	Query_CollectedFees_FullMethodName     = "/dex.v1.Query/CollectedFees"
+	Query_OTCOffer_FullMethodName          = "/dex.v1.Query/OTCOffer"
+	Query_OTCOffers_FullMethodName         = "/dex.v1.Query/OTCOffers"
+	Query_DWNActivity_FullMethodName       = "/dex.v1.Query/DWNActivity"
+	Query_ProtocolPosition_FullMethodName  = "/dex.v1.Query/ProtocolPosition"
+	Query_ProtocolPositions_FullMethodName = "/dex.v1.Query/ProtocolPositions"
+	Query_ICAAddress_FullMethodName        = "/dex.v1.Query/ICAAddress"
+	Query_TWAMMOrder_FullMethodName        = "/dex.v1.Query/TWAMMOrder"
+	Query_PriceHistory_FullMethodName      = "/dex.v1.Query/PriceHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -67,6 +81,21 @@ type QueryClient interface {
 	//
 	// {{import "dex_query_docs.md"}}
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// EstimateSwap quotes the output and platform fee of a swap
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	EstimateSwap(ctx context.Context, in *QueryEstimateSwapRequest, opts ...grpc.CallOption) (*QueryEstimateSwapResponse, error)
+	// SwapRoute finds the route through configured pools with the best
+	// estimated output
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	SwapRoute(ctx context.Context, in *QuerySwapRouteRequest, opts ...grpc.CallOption) (*QuerySwapRouteResponse, error)
 	// Orders queries orders for a DID
 	//
 	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
@@ -74,6 +103,13 @@ type QueryClient interface {
 	//
 	// {{import "dex_query_docs.md"}}
 	Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	// OrdersByDID queries the orders of a DID across all connections
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	OrdersByDID(ctx context.Context, in *QueryOrdersByDIDRequest, opts ...grpc.CallOption) (*QueryOrdersByDIDResponse, error)
 	// History queries transaction history
 	//
 	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
@@ -81,6 +117,84 @@ type QueryClient interface {
 	//
 	// {{import "dex_query_docs.md"}}
 	History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+	// DenomFilter queries the denom allow/deny list for a connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	DenomFilter(ctx context.Context, in *QueryDenomFilterRequest, opts ...grpc.CallOption) (*QueryDenomFilterResponse, error)
+	// DailyVolume queries a DID's swap volume today and its remaining allowance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	DailyVolume(ctx context.Context, in *QueryDailyVolumeRequest, opts ...grpc.CallOption) (*QueryDailyVolumeResponse, error)
+	// CollectedFees queries the platform fees collected on a connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
+	// OTCOffer queries an OTC offer by ID
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	OTCOffer(ctx context.Context, in *QueryOTCOfferRequest, opts ...grpc.CallOption) (*QueryOTCOfferResponse, error)
+	// OTCOffers lists OTC offers, optionally those involving a DID or in a status
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	OTCOffers(ctx context.Context, in *QueryOTCOffersRequest, opts ...grpc.CallOption) (*QueryOTCOffersResponse, error)
+	// DWNActivity reads the DEX activity stored in a DID's DWN vault
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	DWNActivity(ctx context.Context, in *QueryDWNActivityRequest, opts ...grpc.CallOption) (*QueryDWNActivityResponse, error)
+	// ProtocolPosition returns the protocol-owned liquidity held in a pool
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	ProtocolPosition(ctx context.Context, in *QueryProtocolPositionRequest, opts ...grpc.CallOption) (*QueryProtocolPositionResponse, error)
+	// ProtocolPositions lists the protocol-owned liquidity positions
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	ProtocolPositions(ctx context.Context, in *QueryProtocolPositionsRequest, opts ...grpc.CallOption) (*QueryProtocolPositionsResponse, error)
+	// ICAAddress returns the interchain account port of a DID on a connection,
+	// which is known before registration, and its host address once assigned
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	ICAAddress(ctx context.Context, in *QueryICAAddressRequest, opts ...grpc.CallOption) (*QueryICAAddressResponse, error)
+	// TWAMMOrder queries the progress of a TWAMM order
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	TWAMMOrder(ctx context.Context, in *QueryTWAMMOrderRequest, opts ...grpc.CallOption) (*QueryTWAMMOrderResponse, error)
+	// PriceHistory queries the aggregated prices of a denom in a time range
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -136,6 +250,24 @@ func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) EstimateSwap(ctx context.Context, in *QueryEstimateSwapRequest, opts ...grpc.CallOption) (*QueryEstimateSwapResponse, error) {
+	out := new(QueryEstimateSwapResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapRoute(ctx context.Context, in *QuerySwapRouteRequest, opts ...grpc.CallOption) (*QuerySwapRouteResponse, error) {
+	out := new(QuerySwapRouteResponse)
+	err := c.cc.Invoke(ctx, Query_SwapRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
 	out := new(QueryOrdersResponse)
 	err := c.cc.Invoke(ctx, Query_Orders_FullMethodName, in, out, opts...)
@@ -145,6 +277,15 @@ func (c *queryClient) Orders(ctx context.Context, in *QueryOrdersRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) OrdersByDID(ctx context.Context, in *QueryOrdersByDIDRequest, opts ...grpc.CallOption) (*QueryOrdersByDIDResponse, error) {
+	out := new(QueryOrdersByDIDResponse)
+	err := c.cc.Invoke(ctx, Query_OrdersByDID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
 	out := new(QueryHistoryResponse)
 	err := c.cc.Invoke(ctx, Query_History_FullMethodName, in, out, opts...)
@@ -154,6 +295,105 @@ func (c *queryClient) History(ctx context.Context, in *QueryHistoryRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) DenomFilter(ctx context.Context, in *QueryDenomFilterRequest, opts ...grpc.CallOption) (*QueryDenomFilterResponse, error) {
+	out := new(QueryDenomFilterResponse)
+	err := c.cc.Invoke(ctx, Query_DenomFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DailyVolume(ctx context.Context, in *QueryDailyVolumeRequest, opts ...grpc.CallOption) (*QueryDailyVolumeResponse, error) {
+	out := new(QueryDailyVolumeResponse)
+	err := c.cc.Invoke(ctx, Query_DailyVolume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_CollectedFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OTCOffer(ctx context.Context, in *QueryOTCOfferRequest, opts ...grpc.CallOption) (*QueryOTCOfferResponse, error) {
+	out := new(QueryOTCOfferResponse)
+	err := c.cc.Invoke(ctx, Query_OTCOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OTCOffers(ctx context.Context, in *QueryOTCOffersRequest, opts ...grpc.CallOption) (*QueryOTCOffersResponse, error) {
+	out := new(QueryOTCOffersResponse)
+	err := c.cc.Invoke(ctx, Query_OTCOffers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DWNActivity(ctx context.Context, in *QueryDWNActivityRequest, opts ...grpc.CallOption) (*QueryDWNActivityResponse, error) {
+	out := new(QueryDWNActivityResponse)
+	err := c.cc.Invoke(ctx, Query_DWNActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolPosition(ctx context.Context, in *QueryProtocolPositionRequest, opts ...grpc.CallOption) (*QueryProtocolPositionResponse, error) {
+	out := new(QueryProtocolPositionResponse)
+	err := c.cc.Invoke(ctx, Query_ProtocolPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolPositions(ctx context.Context, in *QueryProtocolPositionsRequest, opts ...grpc.CallOption) (*QueryProtocolPositionsResponse, error) {
+	out := new(QueryProtocolPositionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProtocolPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ICAAddress(ctx context.Context, in *QueryICAAddressRequest, opts ...grpc.CallOption) (*QueryICAAddressResponse, error) {
+	out := new(QueryICAAddressResponse)
+	err := c.cc.Invoke(ctx, Query_ICAAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAMMOrder(ctx context.Context, in *QueryTWAMMOrderRequest, opts ...grpc.CallOption) (*QueryTWAMMOrderResponse, error) {
+	out := new(QueryTWAMMOrderResponse)
+	err := c.cc.Invoke(ctx, Query_TWAMMOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -193,6 +433,21 @@ type QueryServer interface {
 	//
 	// {{import "dex_query_docs.md"}}
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// EstimateSwap quotes the output and platform fee of a swap
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	EstimateSwap(context.Context, *QueryEstimateSwapRequest) (*QueryEstimateSwapResponse, error)
+	// SwapRoute finds the route through configured pools with the best
+	// estimated output
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	SwapRoute(context.Context, *QuerySwapRouteRequest) (*QuerySwapRouteResponse, error)
 	// Orders queries orders for a DID
 	//
 	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
@@ -200,6 +455,13 @@ type QueryServer interface {
 	//
 	// {{import "dex_query_docs.md"}}
 	Orders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
+	// OrdersByDID queries the orders of a DID across all connections
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	OrdersByDID(context.Context, *QueryOrdersByDIDRequest) (*QueryOrdersByDIDResponse, error)
 	// History queries transaction history
 	//
 	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
@@ -207,6 +469,84 @@ type QueryServer interface {
 	//
 	// {{import "dex_query_docs.md"}}
 	History(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+	// DenomFilter queries the denom allow/deny list for a connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	DenomFilter(context.Context, *QueryDenomFilterRequest) (*QueryDenomFilterResponse, error)
+	// DailyVolume queries a DID's swap volume today and its remaining allowance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	DailyVolume(context.Context, *QueryDailyVolumeRequest) (*QueryDailyVolumeResponse, error)
+	// CollectedFees queries the platform fees collected on a connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
+	// OTCOffer queries an OTC offer by ID
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	OTCOffer(context.Context, *QueryOTCOfferRequest) (*QueryOTCOfferResponse, error)
+	// OTCOffers lists OTC offers, optionally those involving a DID or in a status
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	OTCOffers(context.Context, *QueryOTCOffersRequest) (*QueryOTCOffersResponse, error)
+	// DWNActivity reads the DEX activity stored in a DID's DWN vault
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	DWNActivity(context.Context, *QueryDWNActivityRequest) (*QueryDWNActivityResponse, error)
+	// ProtocolPosition returns the protocol-owned liquidity held in a pool
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	ProtocolPosition(context.Context, *QueryProtocolPositionRequest) (*QueryProtocolPositionResponse, error)
+	// ProtocolPositions lists the protocol-owned liquidity positions
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	ProtocolPositions(context.Context, *QueryProtocolPositionsRequest) (*QueryProtocolPositionsResponse, error)
+	// ICAAddress returns the interchain account port of a DID on a connection,
+	// which is known before registration, and its host address once assigned
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	ICAAddress(context.Context, *QueryICAAddressRequest) (*QueryICAAddressResponse, error)
+	// TWAMMOrder queries the progress of a TWAMM order
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	TWAMMOrder(context.Context, *QueryTWAMMOrderRequest) (*QueryTWAMMOrderResponse, error)
+	// PriceHistory queries the aggregated prices of a denom in a time range
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_query_docs.md"}}
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -229,12 +569,54 @@ func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (
 func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
+func (UnimplementedQueryServer) EstimateSwap(context.Context, *QueryEstimateSwapRequest) (*QueryEstimateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwap not implemented")
+}
+func (UnimplementedQueryServer) SwapRoute(context.Context, *QuerySwapRouteRequest) (*QuerySwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
 func (UnimplementedQueryServer) Orders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Orders not implemented")
 }
+func (UnimplementedQueryServer) OrdersByDID(context.Context, *QueryOrdersByDIDRequest) (*QueryOrdersByDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrdersByDID not implemented")
+}
 func (UnimplementedQueryServer) History(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
 }
+func (UnimplementedQueryServer) DenomFilter(context.Context, *QueryDenomFilterRequest) (*QueryDenomFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomFilter not implemented")
+}
+func (UnimplementedQueryServer) DailyVolume(context.Context, *QueryDailyVolumeRequest) (*QueryDailyVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DailyVolume not implemented")
+}
+func (UnimplementedQueryServer) CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
+func (UnimplementedQueryServer) OTCOffer(context.Context, *QueryOTCOfferRequest) (*QueryOTCOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OTCOffer not implemented")
+}
+func (UnimplementedQueryServer) OTCOffers(context.Context, *QueryOTCOffersRequest) (*QueryOTCOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OTCOffers not implemented")
+}
+func (UnimplementedQueryServer) DWNActivity(context.Context, *QueryDWNActivityRequest) (*QueryDWNActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DWNActivity not implemented")
+}
+func (UnimplementedQueryServer) ProtocolPosition(context.Context, *QueryProtocolPositionRequest) (*QueryProtocolPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolPosition not implemented")
+}
+func (UnimplementedQueryServer) ProtocolPositions(context.Context, *QueryProtocolPositionsRequest) (*QueryProtocolPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolPositions not implemented")
+}
+func (UnimplementedQueryServer) ICAAddress(context.Context, *QueryICAAddressRequest) (*QueryICAAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICAAddress not implemented")
+}
+func (UnimplementedQueryServer) TWAMMOrder(context.Context, *QueryTWAMMOrderRequest) (*QueryTWAMMOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAMMOrder not implemented")
+}
+func (UnimplementedQueryServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -338,6 +720,42 @@ func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwap(ctx, req.(*QueryEstimateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SwapRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapRoute(ctx, req.(*QuerySwapRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Orders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryOrdersRequest)
 	if err := dec(in); err != nil {
@@ -356,6 +774,24 @@ func _Query_Orders_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OrdersByDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrdersByDIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrdersByDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OrdersByDID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrdersByDID(ctx, req.(*QueryOrdersByDIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHistoryRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +810,204 @@ func _Query_History_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomFilter(ctx, req.(*QueryDenomFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DailyVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDailyVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DailyVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DailyVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DailyVolume(ctx, req.(*QueryDailyVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CollectedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OTCOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOTCOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OTCOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OTCOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OTCOffer(ctx, req.(*QueryOTCOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OTCOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOTCOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OTCOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OTCOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OTCOffers(ctx, req.(*QueryOTCOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DWNActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDWNActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DWNActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DWNActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DWNActivity(ctx, req.(*QueryDWNActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProtocolPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolPosition(ctx, req.(*QueryProtocolPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProtocolPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolPositions(ctx, req.(*QueryProtocolPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ICAAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryICAAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICAAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ICAAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICAAddress(ctx, req.(*QueryICAAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAMMOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAMMOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAMMOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TWAMMOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAMMOrder(ctx, req.(*QueryTWAMMOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -401,14 +1035,70 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
 		},
+		{
+			MethodName: "EstimateSwap",
+			Handler:    _Query_EstimateSwap_Handler,
+		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Query_SwapRoute_Handler,
+		},
 		{
 			MethodName: "Orders",
 			Handler:    _Query_Orders_Handler,
 		},
+		{
+			MethodName: "OrdersByDID",
+			Handler:    _Query_OrdersByDID_Handler,
+		},
 		{
 			MethodName: "History",
 			Handler:    _Query_History_Handler,
 		},
+		{
+			MethodName: "DenomFilter",
+			Handler:    _Query_DenomFilter_Handler,
+		},
+		{
+			MethodName: "DailyVolume",
+			Handler:    _Query_DailyVolume_Handler,
+		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
+		{
+			MethodName: "OTCOffer",
+			Handler:    _Query_OTCOffer_Handler,
+		},
+		{
+			MethodName: "OTCOffers",
+			Handler:    _Query_OTCOffers_Handler,
+		},
+		{
+			MethodName: "DWNActivity",
+			Handler:    _Query_DWNActivity_Handler,
+		},
+		{
+			MethodName: "ProtocolPosition",
+			Handler:    _Query_ProtocolPosition_Handler,
+		},
+		{
+			MethodName: "ProtocolPositions",
+			Handler:    _Query_ProtocolPositions_Handler,
+		},
+		{
+			MethodName: "ICAAddress",
+			Handler:    _Query_ICAAddress_Handler,
+		},
+		{
+			MethodName: "TWAMMOrder",
+			Handler:    _Query_TWAMMOrder_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/v1/query.proto",
@@ -19,12 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_RegisterDEXAccount_FullMethodName = "/dex.v1.Msg/RegisterDEXAccount"
-	Msg_ExecuteSwap_FullMethodName        = "/dex.v1.Msg/ExecuteSwap"
-	Msg_ProvideLiquidity_FullMethodName   = "/dex.v1.Msg/ProvideLiquidity"
-	Msg_RemoveLiquidity_FullMethodName    = "/dex.v1.Msg/RemoveLiquidity"
-	Msg_CreateLimitOrder_FullMethodName   = "/dex.v1.Msg/CreateLimitOrder"
-	Msg_CancelOrder_FullMethodName        = "/dex.v1.Msg/CancelOrder"
+	Msg_RegisterDEXAccount_FullMethodName       = "/dex.v1.Msg/RegisterDEXAccount"
+	Msg_ExecuteSwap_FullMethodName              = "/dex.v1.Msg/ExecuteSwap"
+	Msg_ProvideLiquidity_FullMethodName         = "/dex.v1.Msg/ProvideLiquidity"
+	Msg_RemoveLiquidity_FullMethodName          = "/dex.v1.Msg/RemoveLiquidity"
+	Msg_CreateLimitOrder_FullMethodName         = "/dex.v1.Msg/CreateLimitOrder"
+	Msg_CancelOrder_FullMethodName              = "/dex.v1.Msg/CancelOrder"
+	Msg_UpdateBatchParams_FullMethodName        = "/dex.v1.Msg/UpdateBatchParams"
+	Msg_SetDenomFilter_FullMethodName           = "/dex.v1.Msg/SetDenomFilter"
+	Msg_RemoveDenomFilter_FullMethodName        = "/dex.v1.Msg/RemoveDenomFilter"
+	Msg_CreateOTCOffer_FullMethodName           = "/dex.v1.Msg/CreateOTCOffer"
+	Msg_AcceptOTCOffer_FullMethodName           = "/dex.v1.Msg/AcceptOTCOffer"
+	Msg_ConfirmOTCOffer_FullMethodName          = "/dex.v1.Msg/ConfirmOTCOffer"
+	Msg_CancelOTCOffer_FullMethodName           = "/dex.v1.Msg/CancelOTCOffer"
+	Msg_ResolveOTCOffer_FullMethodName          = "/dex.v1.Msg/ResolveOTCOffer"
+	Msg_RefreshPoolReserves_FullMethodName      = "/dex.v1.Msg/RefreshPoolReserves"
+	Msg_UpdateParams_FullMethodName             = "/dex.v1.Msg/UpdateParams"
+	Msg_AddAllowedConnection_FullMethodName     = "/dex.v1.Msg/AddAllowedConnection"
+	Msg_RemoveAllowedConnection_FullMethodName  = "/dex.v1.Msg/RemoveAllowedConnection"
+	Msg_RegisterProtocolAccount_FullMethodName  = "/dex.v1.Msg/RegisterProtocolAccount"
+	Msg_FundProtocolAccount_FullMethodName      = "/dex.v1.Msg/FundProtocolAccount"
+	Msg_GovProvideLiquidity_FullMethodName      = "/dex.v1.Msg/GovProvideLiquidity"
+	Msg_GovRemoveLiquidity_FullMethodName       = "/dex.v1.Msg/GovRemoveLiquidity"
+	Msg_CreateTWAMMOrder_FullMethodName         = "/dex.v1.Msg/CreateTWAMMOrder"
+	Msg_CancelTWAMMOrder_FullMethodName         = "/dex.v1.Msg/CancelTWAMMOrder"
+	Msg_UpdatePriceHistoryParams_FullMethodName = "/dex.v1.Msg/UpdatePriceHistoryParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -73,6 +92,139 @@ type MsgClient interface {
 	//
 	// {{import "dex_tx_docs.md"}}
 	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// UpdateBatchParams updates the ICA batching parameters through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	UpdateBatchParams(ctx context.Context, in *MsgUpdateBatchParams, opts ...grpc.CallOption) (*MsgUpdateBatchParamsResponse, error)
+	// SetDenomFilter sets the denom allow/deny list for a connection through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	SetDenomFilter(ctx context.Context, in *MsgSetDenomFilter, opts ...grpc.CallOption) (*MsgSetDenomFilterResponse, error)
+	// RemoveDenomFilter clears the denom allow/deny list for a connection through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RemoveDenomFilter(ctx context.Context, in *MsgRemoveDenomFilter, opts ...grpc.CallOption) (*MsgRemoveDenomFilterResponse, error)
+	// CreateOTCOffer escrows the maker's side of a peer-to-peer trade and opens an offer
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CreateOTCOffer(ctx context.Context, in *MsgCreateOTCOffer, opts ...grpc.CallOption) (*MsgCreateOTCOfferResponse, error)
+	// AcceptOTCOffer escrows the taker's side of an open OTC offer
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	AcceptOTCOffer(ctx context.Context, in *MsgAcceptOTCOffer, opts ...grpc.CallOption) (*MsgAcceptOTCOfferResponse, error)
+	// ConfirmOTCOffer confirms a funded OTC offer; the escrow is exchanged once both parties confirm
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	ConfirmOTCOffer(ctx context.Context, in *MsgConfirmOTCOffer, opts ...grpc.CallOption) (*MsgConfirmOTCOfferResponse, error)
+	// CancelOTCOffer withdraws an OTC offer nobody has taken yet and refunds the maker
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CancelOTCOffer(ctx context.Context, in *MsgCancelOTCOffer, opts ...grpc.CallOption) (*MsgCancelOTCOfferResponse, error)
+	// ResolveOTCOffer lets the arbiter of a funded OTC offer release or refund the escrow
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	ResolveOTCOffer(ctx context.Context, in *MsgResolveOTCOffer, opts ...grpc.CallOption) (*MsgResolveOTCOfferResponse, error)
+	// RefreshPoolReserves queries the reserves of a host chain pool over ICA so swap estimates can price against them
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RefreshPoolReserves(ctx context.Context, in *MsgRefreshPoolReserves, opts ...grpc.CallOption) (*MsgRefreshPoolReservesResponse, error)
+	// UpdateParams replaces the module parameters through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddAllowedConnection enables DEX operations over an open IBC connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	AddAllowedConnection(ctx context.Context, in *MsgAddAllowedConnection, opts ...grpc.CallOption) (*MsgAddAllowedConnectionResponse, error)
+	// RemoveAllowedConnection disables new DEX operations over an IBC connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RemoveAllowedConnection(ctx context.Context, in *MsgRemoveAllowedConnection, opts ...grpc.CallOption) (*MsgRemoveAllowedConnectionResponse, error)
+	// RegisterProtocolAccount opens the interchain account holding protocol-owned liquidity on a connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RegisterProtocolAccount(ctx context.Context, in *MsgRegisterProtocolAccount, opts ...grpc.CallOption) (*MsgRegisterProtocolAccountResponse, error)
+	// FundProtocolAccount transfers treasury funds held by the module to the protocol's interchain account
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	FundProtocolAccount(ctx context.Context, in *MsgFundProtocolAccount, opts ...grpc.CallOption) (*MsgFundProtocolAccountResponse, error)
+	// GovProvideLiquidity provides protocol-owned liquidity to a host chain pool
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	GovProvideLiquidity(ctx context.Context, in *MsgGovProvideLiquidity, opts ...grpc.CallOption) (*MsgGovProvideLiquidityResponse, error)
+	// GovRemoveLiquidity withdraws protocol-owned liquidity once its timelock expired
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	GovRemoveLiquidity(ctx context.Context, in *MsgGovRemoveLiquidity, opts ...grpc.CallOption) (*MsgGovRemoveLiquidityResponse, error)
+	// CreateTWAMMOrder schedules a swap executed as equal slices across blocks
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CreateTWAMMOrder(ctx context.Context, in *MsgCreateTWAMMOrder, opts ...grpc.CallOption) (*MsgCreateTWAMMOrderResponse, error)
+	// CancelTWAMMOrder stops a TWAMM order before its remaining slices execute
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CancelTWAMMOrder(ctx context.Context, in *MsgCancelTWAMMOrder, opts ...grpc.CallOption) (*MsgCancelTWAMMOrderResponse, error)
+	// UpdatePriceHistoryParams updates the price history parameters through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	UpdatePriceHistoryParams(ctx context.Context, in *MsgUpdatePriceHistoryParams, opts ...grpc.CallOption) (*MsgUpdatePriceHistoryParamsResponse, error)
 }
 
 type msgClient struct {
@@ -83,248 +235,951 @@ func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
 	return &msgClient{cc}
 }
 
-func (c *msgClient) RegisterDEXAccount(ctx context.Context, in *MsgRegisterDEXAccount, opts ...grpc.CallOption) (*MsgRegisterDEXAccountResponse, error) {
-	out := new(MsgRegisterDEXAccountResponse)
-	err := c.cc.Invoke(ctx, Msg_RegisterDEXAccount_FullMethodName, in, out, opts...)
-	if err != nil {
+func (c *msgClient) RegisterDEXAccount(ctx context.Context, in *MsgRegisterDEXAccount, opts ...grpc.CallOption) (*MsgRegisterDEXAccountResponse, error) {
+	out := new(MsgRegisterDEXAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterDEXAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteSwap(ctx context.Context, in *MsgExecuteSwap, opts ...grpc.CallOption) (*MsgExecuteSwapResponse, error) {
+	out := new(MsgExecuteSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecuteSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProvideLiquidity(ctx context.Context, in *MsgProvideLiquidity, opts ...grpc.CallOption) (*MsgProvideLiquidityResponse, error) {
+	out := new(MsgProvideLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_ProvideLiquidity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error) {
+	out := new(MsgRemoveLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveLiquidity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLimitOrder(ctx context.Context, in *MsgCreateLimitOrder, opts ...grpc.CallOption) (*MsgCreateLimitOrderResponse, error) {
+	out := new(MsgCreateLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBatchParams(ctx context.Context, in *MsgUpdateBatchParams, opts ...grpc.CallOption) (*MsgUpdateBatchParamsResponse, error) {
+	out := new(MsgUpdateBatchParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBatchParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomFilter(ctx context.Context, in *MsgSetDenomFilter, opts ...grpc.CallOption) (*MsgSetDenomFilterResponse, error) {
+	out := new(MsgSetDenomFilterResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomFilter(ctx context.Context, in *MsgRemoveDenomFilter, opts ...grpc.CallOption) (*MsgRemoveDenomFilterResponse, error) {
+	out := new(MsgRemoveDenomFilterResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDenomFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateOTCOffer(ctx context.Context, in *MsgCreateOTCOffer, opts ...grpc.CallOption) (*MsgCreateOTCOfferResponse, error) {
+	out := new(MsgCreateOTCOfferResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateOTCOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOTCOffer(ctx context.Context, in *MsgAcceptOTCOffer, opts ...grpc.CallOption) (*MsgAcceptOTCOfferResponse, error) {
+	out := new(MsgAcceptOTCOfferResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptOTCOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmOTCOffer(ctx context.Context, in *MsgConfirmOTCOffer, opts ...grpc.CallOption) (*MsgConfirmOTCOfferResponse, error) {
+	out := new(MsgConfirmOTCOfferResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfirmOTCOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOTCOffer(ctx context.Context, in *MsgCancelOTCOffer, opts ...grpc.CallOption) (*MsgCancelOTCOfferResponse, error) {
+	out := new(MsgCancelOTCOfferResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelOTCOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveOTCOffer(ctx context.Context, in *MsgResolveOTCOffer, opts ...grpc.CallOption) (*MsgResolveOTCOfferResponse, error) {
+	out := new(MsgResolveOTCOfferResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveOTCOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefreshPoolReserves(ctx context.Context, in *MsgRefreshPoolReserves, opts ...grpc.CallOption) (*MsgRefreshPoolReservesResponse, error) {
+	out := new(MsgRefreshPoolReservesResponse)
+	err := c.cc.Invoke(ctx, Msg_RefreshPoolReserves_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedConnection(ctx context.Context, in *MsgAddAllowedConnection, opts ...grpc.CallOption) (*MsgAddAllowedConnectionResponse, error) {
+	out := new(MsgAddAllowedConnectionResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAllowedConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedConnection(ctx context.Context, in *MsgRemoveAllowedConnection, opts ...grpc.CallOption) (*MsgRemoveAllowedConnectionResponse, error) {
+	out := new(MsgRemoveAllowedConnectionResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAllowedConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterProtocolAccount(ctx context.Context, in *MsgRegisterProtocolAccount, opts ...grpc.CallOption) (*MsgRegisterProtocolAccountResponse, error) {
+	out := new(MsgRegisterProtocolAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterProtocolAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundProtocolAccount(ctx context.Context, in *MsgFundProtocolAccount, opts ...grpc.CallOption) (*MsgFundProtocolAccountResponse, error) {
+	out := new(MsgFundProtocolAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_FundProtocolAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovProvideLiquidity(ctx context.Context, in *MsgGovProvideLiquidity, opts ...grpc.CallOption) (*MsgGovProvideLiquidityResponse, error) {
+	out := new(MsgGovProvideLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_GovProvideLiquidity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovRemoveLiquidity(ctx context.Context, in *MsgGovRemoveLiquidity, opts ...grpc.CallOption) (*MsgGovRemoveLiquidityResponse, error) {
+	out := new(MsgGovRemoveLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_GovRemoveLiquidity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTWAMMOrder(ctx context.Context, in *MsgCreateTWAMMOrder, opts ...grpc.CallOption) (*MsgCreateTWAMMOrderResponse, error) {
+	out := new(MsgCreateTWAMMOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateTWAMMOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTWAMMOrder(ctx context.Context, in *MsgCancelTWAMMOrder, opts ...grpc.CallOption) (*MsgCancelTWAMMOrderResponse, error) {
+	out := new(MsgCancelTWAMMOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelTWAMMOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePriceHistoryParams(ctx context.Context, in *MsgUpdatePriceHistoryParams, opts ...grpc.CallOption) (*MsgUpdatePriceHistoryParamsResponse, error) {
+	out := new(MsgUpdatePriceHistoryParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePriceHistoryParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// RegisterDEXAccount creates a new ICA account for DEX operations
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RegisterDEXAccount(context.Context, *MsgRegisterDEXAccount) (*MsgRegisterDEXAccountResponse, error)
+	// ExecuteSwap performs a token swap on a remote chain
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	ExecuteSwap(context.Context, *MsgExecuteSwap) (*MsgExecuteSwapResponse, error)
+	// ProvideLiquidity adds liquidity to a pool
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	ProvideLiquidity(context.Context, *MsgProvideLiquidity) (*MsgProvideLiquidityResponse, error)
+	// RemoveLiquidity removes liquidity from a pool
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	// CreateLimitOrder creates a limit order
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CreateLimitOrder(context.Context, *MsgCreateLimitOrder) (*MsgCreateLimitOrderResponse, error)
+	// CancelOrder cancels an existing order
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	// UpdateBatchParams updates the ICA batching parameters through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	UpdateBatchParams(context.Context, *MsgUpdateBatchParams) (*MsgUpdateBatchParamsResponse, error)
+	// SetDenomFilter sets the denom allow/deny list for a connection through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	SetDenomFilter(context.Context, *MsgSetDenomFilter) (*MsgSetDenomFilterResponse, error)
+	// RemoveDenomFilter clears the denom allow/deny list for a connection through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RemoveDenomFilter(context.Context, *MsgRemoveDenomFilter) (*MsgRemoveDenomFilterResponse, error)
+	// CreateOTCOffer escrows the maker's side of a peer-to-peer trade and opens an offer
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CreateOTCOffer(context.Context, *MsgCreateOTCOffer) (*MsgCreateOTCOfferResponse, error)
+	// AcceptOTCOffer escrows the taker's side of an open OTC offer
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	AcceptOTCOffer(context.Context, *MsgAcceptOTCOffer) (*MsgAcceptOTCOfferResponse, error)
+	// ConfirmOTCOffer confirms a funded OTC offer; the escrow is exchanged once both parties confirm
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	ConfirmOTCOffer(context.Context, *MsgConfirmOTCOffer) (*MsgConfirmOTCOfferResponse, error)
+	// CancelOTCOffer withdraws an OTC offer nobody has taken yet and refunds the maker
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CancelOTCOffer(context.Context, *MsgCancelOTCOffer) (*MsgCancelOTCOfferResponse, error)
+	// ResolveOTCOffer lets the arbiter of a funded OTC offer release or refund the escrow
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	ResolveOTCOffer(context.Context, *MsgResolveOTCOffer) (*MsgResolveOTCOfferResponse, error)
+	// RefreshPoolReserves queries the reserves of a host chain pool over ICA so swap estimates can price against them
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RefreshPoolReserves(context.Context, *MsgRefreshPoolReserves) (*MsgRefreshPoolReservesResponse, error)
+	// UpdateParams replaces the module parameters through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddAllowedConnection enables DEX operations over an open IBC connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	AddAllowedConnection(context.Context, *MsgAddAllowedConnection) (*MsgAddAllowedConnectionResponse, error)
+	// RemoveAllowedConnection disables new DEX operations over an IBC connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RemoveAllowedConnection(context.Context, *MsgRemoveAllowedConnection) (*MsgRemoveAllowedConnectionResponse, error)
+	// RegisterProtocolAccount opens the interchain account holding protocol-owned liquidity on a connection
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	RegisterProtocolAccount(context.Context, *MsgRegisterProtocolAccount) (*MsgRegisterProtocolAccountResponse, error)
+	// FundProtocolAccount transfers treasury funds held by the module to the protocol's interchain account
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	FundProtocolAccount(context.Context, *MsgFundProtocolAccount) (*MsgFundProtocolAccountResponse, error)
+	// GovProvideLiquidity provides protocol-owned liquidity to a host chain pool
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	GovProvideLiquidity(context.Context, *MsgGovProvideLiquidity) (*MsgGovProvideLiquidityResponse, error)
+	// GovRemoveLiquidity withdraws protocol-owned liquidity once its timelock expired
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	GovRemoveLiquidity(context.Context, *MsgGovRemoveLiquidity) (*MsgGovRemoveLiquidityResponse, error)
+	// CreateTWAMMOrder schedules a swap executed as equal slices across blocks
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CreateTWAMMOrder(context.Context, *MsgCreateTWAMMOrder) (*MsgCreateTWAMMOrderResponse, error)
+	// CancelTWAMMOrder stops a TWAMM order before its remaining slices execute
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	CancelTWAMMOrder(context.Context, *MsgCancelTWAMMOrder) (*MsgCancelTWAMMOrderResponse, error)
+	// UpdatePriceHistoryParams updates the price history parameters through governance
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "dex_tx_docs.md"}}
+	UpdatePriceHistoryParams(context.Context, *MsgUpdatePriceHistoryParams) (*MsgUpdatePriceHistoryParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) RegisterDEXAccount(context.Context, *MsgRegisterDEXAccount) (*MsgRegisterDEXAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDEXAccount not implemented")
+}
+func (UnimplementedMsgServer) ExecuteSwap(context.Context, *MsgExecuteSwap) (*MsgExecuteSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSwap not implemented")
+}
+func (UnimplementedMsgServer) ProvideLiquidity(context.Context, *MsgProvideLiquidity) (*MsgProvideLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvideLiquidity not implemented")
+}
+func (UnimplementedMsgServer) RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
+}
+func (UnimplementedMsgServer) CreateLimitOrder(context.Context, *MsgCreateLimitOrder) (*MsgCreateLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedMsgServer) UpdateBatchParams(context.Context, *MsgUpdateBatchParams) (*MsgUpdateBatchParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchParams not implemented")
+}
+func (UnimplementedMsgServer) SetDenomFilter(context.Context, *MsgSetDenomFilter) (*MsgSetDenomFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomFilter not implemented")
+}
+func (UnimplementedMsgServer) RemoveDenomFilter(context.Context, *MsgRemoveDenomFilter) (*MsgRemoveDenomFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomFilter not implemented")
+}
+func (UnimplementedMsgServer) CreateOTCOffer(context.Context, *MsgCreateOTCOffer) (*MsgCreateOTCOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOTCOffer not implemented")
+}
+func (UnimplementedMsgServer) AcceptOTCOffer(context.Context, *MsgAcceptOTCOffer) (*MsgAcceptOTCOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOTCOffer not implemented")
+}
+func (UnimplementedMsgServer) ConfirmOTCOffer(context.Context, *MsgConfirmOTCOffer) (*MsgConfirmOTCOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOTCOffer not implemented")
+}
+func (UnimplementedMsgServer) CancelOTCOffer(context.Context, *MsgCancelOTCOffer) (*MsgCancelOTCOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOTCOffer not implemented")
+}
+func (UnimplementedMsgServer) ResolveOTCOffer(context.Context, *MsgResolveOTCOffer) (*MsgResolveOTCOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveOTCOffer not implemented")
+}
+func (UnimplementedMsgServer) RefreshPoolReserves(context.Context, *MsgRefreshPoolReserves) (*MsgRefreshPoolReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPoolReserves not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) AddAllowedConnection(context.Context, *MsgAddAllowedConnection) (*MsgAddAllowedConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedConnection not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowedConnection(context.Context, *MsgRemoveAllowedConnection) (*MsgRemoveAllowedConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedConnection not implemented")
+}
+func (UnimplementedMsgServer) RegisterProtocolAccount(context.Context, *MsgRegisterProtocolAccount) (*MsgRegisterProtocolAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProtocolAccount not implemented")
+}
+func (UnimplementedMsgServer) FundProtocolAccount(context.Context, *MsgFundProtocolAccount) (*MsgFundProtocolAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundProtocolAccount not implemented")
+}
+func (UnimplementedMsgServer) GovProvideLiquidity(context.Context, *MsgGovProvideLiquidity) (*MsgGovProvideLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovProvideLiquidity not implemented")
+}
+func (UnimplementedMsgServer) GovRemoveLiquidity(context.Context, *MsgGovRemoveLiquidity) (*MsgGovRemoveLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovRemoveLiquidity not implemented")
+}
+func (UnimplementedMsgServer) CreateTWAMMOrder(context.Context, *MsgCreateTWAMMOrder) (*MsgCreateTWAMMOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTWAMMOrder not implemented")
+}
+func (UnimplementedMsgServer) CancelTWAMMOrder(context.Context, *MsgCancelTWAMMOrder) (*MsgCancelTWAMMOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTWAMMOrder not implemented")
+}
+func (UnimplementedMsgServer) UpdatePriceHistoryParams(context.Context, *MsgUpdatePriceHistoryParams) (*MsgUpdatePriceHistoryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceHistoryParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_RegisterDEXAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDEXAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDEXAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterDEXAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDEXAccount(ctx, req.(*MsgRegisterDEXAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecuteSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteSwap(ctx, req.(*MsgExecuteSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProvideLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProvideLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProvideLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProvideLiquidity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProvideLiquidity(ctx, req.(*MsgProvideLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveLiquidity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLiquidity(ctx, req.(*MsgRemoveLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLimitOrder(ctx, req.(*MsgCreateLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBatchParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBatchParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBatchParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBatchParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBatchParams(ctx, req.(*MsgUpdateBatchParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomFilter(ctx, req.(*MsgSetDenomFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDenomFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomFilter(ctx, req.(*MsgRemoveDenomFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateOTCOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOTCOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOTCOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateOTCOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOTCOffer(ctx, req.(*MsgCreateOTCOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOTCOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOTCOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOTCOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptOTCOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOTCOffer(ctx, req.(*MsgAcceptOTCOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmOTCOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmOTCOffer)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmOTCOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfirmOTCOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmOTCOffer(ctx, req.(*MsgConfirmOTCOffer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *msgClient) ExecuteSwap(ctx context.Context, in *MsgExecuteSwap, opts ...grpc.CallOption) (*MsgExecuteSwapResponse, error) {
-	out := new(MsgExecuteSwapResponse)
-	err := c.cc.Invoke(ctx, Msg_ExecuteSwap_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Msg_CancelOTCOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOTCOffer)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOTCOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelOTCOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOTCOffer(ctx, req.(*MsgCancelOTCOffer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *msgClient) ProvideLiquidity(ctx context.Context, in *MsgProvideLiquidity, opts ...grpc.CallOption) (*MsgProvideLiquidityResponse, error) {
-	out := new(MsgProvideLiquidityResponse)
-	err := c.cc.Invoke(ctx, Msg_ProvideLiquidity_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Msg_ResolveOTCOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveOTCOffer)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveOTCOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveOTCOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveOTCOffer(ctx, req.(*MsgResolveOTCOffer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error) {
-	out := new(MsgRemoveLiquidityResponse)
-	err := c.cc.Invoke(ctx, Msg_RemoveLiquidity_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Msg_RefreshPoolReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefreshPoolReserves)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RefreshPoolReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefreshPoolReserves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefreshPoolReserves(ctx, req.(*MsgRefreshPoolReserves))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *msgClient) CreateLimitOrder(ctx context.Context, in *MsgCreateLimitOrder, opts ...grpc.CallOption) (*MsgCreateLimitOrderResponse, error) {
-	out := new(MsgCreateLimitOrderResponse)
-	err := c.cc.Invoke(ctx, Msg_CreateLimitOrder_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, Msg_CancelOrder_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Msg_AddAllowedConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedConnection)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-// All implementations must embed UnimplementedMsgServer
-// for forward compatibility
-type MsgServer interface {
-	// RegisterDEXAccount creates a new ICA account for DEX operations
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "dex_tx_docs.md"}}
-	RegisterDEXAccount(context.Context, *MsgRegisterDEXAccount) (*MsgRegisterDEXAccountResponse, error)
-	// ExecuteSwap performs a token swap on a remote chain
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "dex_tx_docs.md"}}
-	ExecuteSwap(context.Context, *MsgExecuteSwap) (*MsgExecuteSwapResponse, error)
-	// ProvideLiquidity adds liquidity to a pool
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "dex_tx_docs.md"}}
-	ProvideLiquidity(context.Context, *MsgProvideLiquidity) (*MsgProvideLiquidityResponse, error)
-	// RemoveLiquidity removes liquidity from a pool
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "dex_tx_docs.md"}}
-	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
-	// CreateLimitOrder creates a limit order
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "dex_tx_docs.md"}}
-	CreateLimitOrder(context.Context, *MsgCreateLimitOrder) (*MsgCreateLimitOrderResponse, error)
-	// CancelOrder cancels an existing order
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "dex_tx_docs.md"}}
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	mustEmbedUnimplementedMsgServer()
-}
-
-// UnimplementedMsgServer must be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
-
-func (UnimplementedMsgServer) RegisterDEXAccount(context.Context, *MsgRegisterDEXAccount) (*MsgRegisterDEXAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterDEXAccount not implemented")
-}
-func (UnimplementedMsgServer) ExecuteSwap(context.Context, *MsgExecuteSwap) (*MsgExecuteSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSwap not implemented")
-}
-func (UnimplementedMsgServer) ProvideLiquidity(context.Context, *MsgProvideLiquidity) (*MsgProvideLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvideLiquidity not implemented")
-}
-func (UnimplementedMsgServer) RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
-}
-func (UnimplementedMsgServer) CreateLimitOrder(context.Context, *MsgCreateLimitOrder) (*MsgCreateLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateLimitOrder not implemented")
-}
-func (UnimplementedMsgServer) CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAllowedConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedConnection(ctx, req.(*MsgAddAllowedConnection))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
-// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MsgServer will
-// result in compilation errors.
-type UnsafeMsgServer interface {
-	mustEmbedUnimplementedMsgServer()
+func _Msg_RemoveAllowedConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedConnection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAllowedConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedConnection(ctx, req.(*MsgRemoveAllowedConnection))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	s.RegisterService(&Msg_ServiceDesc, srv)
+func _Msg_RegisterProtocolAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterProtocolAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterProtocolAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterProtocolAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterProtocolAccount(ctx, req.(*MsgRegisterProtocolAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RegisterDEXAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterDEXAccount)
+func _Msg_FundProtocolAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundProtocolAccount)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RegisterDEXAccount(ctx, in)
+		return srv.(MsgServer).FundProtocolAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RegisterDEXAccount_FullMethodName,
+		FullMethod: Msg_FundProtocolAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterDEXAccount(ctx, req.(*MsgRegisterDEXAccount))
+		return srv.(MsgServer).FundProtocolAccount(ctx, req.(*MsgFundProtocolAccount))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ExecuteSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteSwap)
+func _Msg_GovProvideLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovProvideLiquidity)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ExecuteSwap(ctx, in)
+		return srv.(MsgServer).GovProvideLiquidity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_ExecuteSwap_FullMethodName,
+		FullMethod: Msg_GovProvideLiquidity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteSwap(ctx, req.(*MsgExecuteSwap))
+		return srv.(MsgServer).GovProvideLiquidity(ctx, req.(*MsgGovProvideLiquidity))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ProvideLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProvideLiquidity)
+func _Msg_GovRemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovRemoveLiquidity)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ProvideLiquidity(ctx, in)
+		return srv.(MsgServer).GovRemoveLiquidity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_ProvideLiquidity_FullMethodName,
+		FullMethod: Msg_GovRemoveLiquidity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProvideLiquidity(ctx, req.(*MsgProvideLiquidity))
+		return srv.(MsgServer).GovRemoveLiquidity(ctx, req.(*MsgGovRemoveLiquidity))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveLiquidity)
+func _Msg_CreateTWAMMOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTWAMMOrder)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveLiquidity(ctx, in)
+		return srv.(MsgServer).CreateTWAMMOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RemoveLiquidity_FullMethodName,
+		FullMethod: Msg_CreateTWAMMOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveLiquidity(ctx, req.(*MsgRemoveLiquidity))
+		return srv.(MsgServer).CreateTWAMMOrder(ctx, req.(*MsgCreateTWAMMOrder))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateLimitOrder)
+func _Msg_CancelTWAMMOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTWAMMOrder)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateLimitOrder(ctx, in)
+		return srv.(MsgServer).CancelTWAMMOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_CreateLimitOrder_FullMethodName,
+		FullMethod: Msg_CancelTWAMMOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateLimitOrder(ctx, req.(*MsgCreateLimitOrder))
+		return srv.(MsgServer).CancelTWAMMOrder(ctx, req.(*MsgCancelTWAMMOrder))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
+func _Msg_UpdatePriceHistoryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceHistoryParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
+		return srv.(MsgServer).UpdatePriceHistoryParams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_CancelOrder_FullMethodName,
+		FullMethod: Msg_UpdatePriceHistoryParams_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+		return srv.(MsgServer).UpdatePriceHistoryParams(ctx, req.(*MsgUpdatePriceHistoryParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -360,6 +1215,82 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Msg_CancelOrder_Handler,
 		},
+		{
+			MethodName: "UpdateBatchParams",
+			Handler:    _Msg_UpdateBatchParams_Handler,
+		},
+		{
+			MethodName: "SetDenomFilter",
+			Handler:    _Msg_SetDenomFilter_Handler,
+		},
+		{
+			MethodName: "RemoveDenomFilter",
+			Handler:    _Msg_RemoveDenomFilter_Handler,
+		},
+		{
+			MethodName: "CreateOTCOffer",
+			Handler:    _Msg_CreateOTCOffer_Handler,
+		},
+		{
+			MethodName: "AcceptOTCOffer",
+			Handler:    _Msg_AcceptOTCOffer_Handler,
+		},
+		{
+			MethodName: "ConfirmOTCOffer",
+			Handler:    _Msg_ConfirmOTCOffer_Handler,
+		},
+		{
+			MethodName: "CancelOTCOffer",
+			Handler:    _Msg_CancelOTCOffer_Handler,
+		},
+		{
+			MethodName: "ResolveOTCOffer",
+			Handler:    _Msg_ResolveOTCOffer_Handler,
+		},
+		{
+			MethodName: "RefreshPoolReserves",
+			Handler:    _Msg_RefreshPoolReserves_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddAllowedConnection",
+			Handler:    _Msg_AddAllowedConnection_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedConnection",
+			Handler:    _Msg_RemoveAllowedConnection_Handler,
+		},
+		{
+			MethodName: "RegisterProtocolAccount",
+			Handler:    _Msg_RegisterProtocolAccount_Handler,
+		},
+		{
+			MethodName: "FundProtocolAccount",
+			Handler:    _Msg_FundProtocolAccount_Handler,
+		},
+		{
+			MethodName: "GovProvideLiquidity",
+			Handler:    _Msg_GovProvideLiquidity_Handler,
+		},
+		{
+			MethodName: "GovRemoveLiquidity",
+			Handler:    _Msg_GovRemoveLiquidity_Handler,
+		},
+		{
+			MethodName: "CreateTWAMMOrder",
+			Handler:    _Msg_CreateTWAMMOrder_Handler,
+		},
+		{
+			MethodName: "CancelTWAMMOrder",
+			Handler:    _Msg_CancelTWAMMOrder_Handler,
+		},
+		{
+			MethodName: "UpdatePriceHistoryParams",
+			Handler:    _Msg_UpdatePriceHistoryParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/v1/tx.proto",
@@ -2220,33 +2220,31 @@ func (x *fastReflection_EventDIDDeactivated) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EventVerificationMethodAdded              protoreflect.MessageDescriptor
-	fd_EventVerificationMethodAdded_did          protoreflect.FieldDescriptor
-	fd_EventVerificationMethodAdded_method_id    protoreflect.FieldDescriptor
-	fd_EventVerificationMethodAdded_key_type     protoreflect.FieldDescriptor
-	fd_EventVerificationMethodAdded_public_key   protoreflect.FieldDescriptor
-	fd_EventVerificationMethodAdded_block_height protoreflect.FieldDescriptor
+	md_EventDIDReactivated                protoreflect.MessageDescriptor
+	fd_EventDIDReactivated_did            protoreflect.FieldDescriptor
+	fd_EventDIDReactivated_reactivator    protoreflect.FieldDescriptor
+	fd_EventDIDReactivated_reactivated_at protoreflect.FieldDescriptor
+	fd_EventDIDReactivated_block_height   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventVerificationMethodAdded = File_did_v1_events_proto.Messages().ByName("EventVerificationMethodAdded")
-	fd_EventVerificationMethodAdded_did = md_EventVerificationMethodAdded.Fields().ByName("did")
-	fd_EventVerificationMethodAdded_method_id = md_EventVerificationMethodAdded.Fields().ByName("method_id")
-	fd_EventVerificationMethodAdded_key_type = md_EventVerificationMethodAdded.Fields().ByName("key_type")
-	fd_EventVerificationMethodAdded_public_key = md_EventVerificationMethodAdded.Fields().ByName("public_key")
-	fd_EventVerificationMethodAdded_block_height = md_EventVerificationMethodAdded.Fields().ByName("block_height")
+	md_EventDIDReactivated = File_did_v1_events_proto.Messages().ByName("EventDIDReactivated")
+	fd_EventDIDReactivated_did = md_EventDIDReactivated.Fields().ByName("did")
+	fd_EventDIDReactivated_reactivator = md_EventDIDReactivated.Fields().ByName("reactivator")
+	fd_EventDIDReactivated_reactivated_at = md_EventDIDReactivated.Fields().ByName("reactivated_at")
+	fd_EventDIDReactivated_block_height = md_EventDIDReactivated.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventVerificationMethodAdded)(nil)
+var _ protoreflect.Message = (*fastReflection_EventDIDReactivated)(nil)
 
-type fastReflection_EventVerificationMethodAdded EventVerificationMethodAdded
+type fastReflection_EventDIDReactivated EventDIDReactivated
 
-func (x *EventVerificationMethodAdded) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventVerificationMethodAdded)(x)
+func (x *EventDIDReactivated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDIDReactivated)(x)
 }
 
-func (x *EventVerificationMethodAdded) slowProtoReflect() protoreflect.Message {
+func (x *EventDIDReactivated) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2258,43 +2256,43 @@ func (x *EventVerificationMethodAdded) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventVerificationMethodAdded_messageType fastReflection_EventVerificationMethodAdded_messageType
-var _ protoreflect.MessageType = fastReflection_EventVerificationMethodAdded_messageType{}
+var _fastReflection_EventDIDReactivated_messageType fastReflection_EventDIDReactivated_messageType
+var _ protoreflect.MessageType = fastReflection_EventDIDReactivated_messageType{}
 
-type fastReflection_EventVerificationMethodAdded_messageType struct{}
+type fastReflection_EventDIDReactivated_messageType struct{}
 
-func (x fastReflection_EventVerificationMethodAdded_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventVerificationMethodAdded)(nil)
+func (x fastReflection_EventDIDReactivated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDIDReactivated)(nil)
 }
-func (x fastReflection_EventVerificationMethodAdded_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventVerificationMethodAdded)
+func (x fastReflection_EventDIDReactivated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDIDReactivated)
 }
-func (x fastReflection_EventVerificationMethodAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVerificationMethodAdded
+func (x fastReflection_EventDIDReactivated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDIDReactivated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventVerificationMethodAdded) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVerificationMethodAdded
+func (x *fastReflection_EventDIDReactivated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDIDReactivated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventVerificationMethodAdded) Type() protoreflect.MessageType {
-	return _fastReflection_EventVerificationMethodAdded_messageType
+func (x *fastReflection_EventDIDReactivated) Type() protoreflect.MessageType {
+	return _fastReflection_EventDIDReactivated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventVerificationMethodAdded) New() protoreflect.Message {
-	return new(fastReflection_EventVerificationMethodAdded)
+func (x *fastReflection_EventDIDReactivated) New() protoreflect.Message {
+	return new(fastReflection_EventDIDReactivated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventVerificationMethodAdded) Interface() protoreflect.ProtoMessage {
-	return (*EventVerificationMethodAdded)(x)
+func (x *fastReflection_EventDIDReactivated) Interface() protoreflect.ProtoMessage {
+	return (*EventDIDReactivated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2302,34 +2300,28 @@ func (x *fastReflection_EventVerificationMethodAdded) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventVerificationMethodAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventDIDReactivated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_EventVerificationMethodAdded_did, value) {
-			return
-		}
-	}
-	if x.MethodId != "" {
-		value := protoreflect.ValueOfString(x.MethodId)
-		if !f(fd_EventVerificationMethodAdded_method_id, value) {
+		if !f(fd_EventDIDReactivated_did, value) {
 			return
 		}
 	}
-	if x.KeyType != "" {
-		value := protoreflect.ValueOfString(x.KeyType)
-		if !f(fd_EventVerificationMethodAdded_key_type, value) {
+	if x.Reactivator != "" {
+		value := protoreflect.ValueOfString(x.Reactivator)
+		if !f(fd_EventDIDReactivated_reactivator, value) {
 			return
 		}
 	}
-	if x.PublicKey != "" {
-		value := protoreflect.ValueOfString(x.PublicKey)
-		if !f(fd_EventVerificationMethodAdded_public_key, value) {
+	if x.ReactivatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ReactivatedAt.ProtoReflect())
+		if !f(fd_EventDIDReactivated_reactivated_at, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventVerificationMethodAdded_block_height, value) {
+		if !f(fd_EventDIDReactivated_block_height, value) {
 			return
 		}
 	}
@@ -2346,23 +2338,21 @@ func (x *fastReflection_EventVerificationMethodAdded) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventVerificationMethodAdded) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventDIDReactivated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodAdded.did":
+	case "did.v1.EventDIDReactivated.did":
 		return x.Did != ""
-	case "did.v1.EventVerificationMethodAdded.method_id":
-		return x.MethodId != ""
-	case "did.v1.EventVerificationMethodAdded.key_type":
-		return x.KeyType != ""
-	case "did.v1.EventVerificationMethodAdded.public_key":
-		return x.PublicKey != ""
-	case "did.v1.EventVerificationMethodAdded.block_height":
+	case "did.v1.EventDIDReactivated.reactivator":
+		return x.Reactivator != ""
+	case "did.v1.EventDIDReactivated.reactivated_at":
+		return x.ReactivatedAt != nil
+	case "did.v1.EventDIDReactivated.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDIDReactivated"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventDIDReactivated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2372,23 +2362,21 @@ func (x *fastReflection_EventVerificationMethodAdded) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodAdded) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventDIDReactivated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodAdded.did":
+	case "did.v1.EventDIDReactivated.did":
 		x.Did = ""
-	case "did.v1.EventVerificationMethodAdded.method_id":
-		x.MethodId = ""
-	case "did.v1.EventVerificationMethodAdded.key_type":
-		x.KeyType = ""
-	case "did.v1.EventVerificationMethodAdded.public_key":
-		x.PublicKey = ""
-	case "did.v1.EventVerificationMethodAdded.block_height":
+	case "did.v1.EventDIDReactivated.reactivator":
+		x.Reactivator = ""
+	case "did.v1.EventDIDReactivated.reactivated_at":
+		x.ReactivatedAt = nil
+	case "did.v1.EventDIDReactivated.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDIDReactivated"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventDIDReactivated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2398,28 +2386,25 @@ func (x *fastReflection_EventVerificationMethodAdded) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventVerificationMethodAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventDIDReactivated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventVerificationMethodAdded.did":
+	case "did.v1.EventDIDReactivated.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventVerificationMethodAdded.method_id":
-		value := x.MethodId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventVerificationMethodAdded.key_type":
-		value := x.KeyType
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventVerificationMethodAdded.public_key":
-		value := x.PublicKey
+	case "did.v1.EventDIDReactivated.reactivator":
+		value := x.Reactivator
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventVerificationMethodAdded.block_height":
+	case "did.v1.EventDIDReactivated.reactivated_at":
+		value := x.ReactivatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.EventDIDReactivated.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDIDReactivated"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventDIDReactivated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2433,23 +2418,21 @@ func (x *fastReflection_EventVerificationMethodAdded) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventDIDReactivated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodAdded.did":
+	case "did.v1.EventDIDReactivated.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.EventVerificationMethodAdded.method_id":
-		x.MethodId = value.Interface().(string)
-	case "did.v1.EventVerificationMethodAdded.key_type":
-		x.KeyType = value.Interface().(string)
-	case "did.v1.EventVerificationMethodAdded.public_key":
-		x.PublicKey = value.Interface().(string)
-	case "did.v1.EventVerificationMethodAdded.block_height":
+	case "did.v1.EventDIDReactivated.reactivator":
+		x.Reactivator = value.Interface().(string)
+	case "did.v1.EventDIDReactivated.reactivated_at":
+		x.ReactivatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "did.v1.EventDIDReactivated.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDIDReactivated"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventDIDReactivated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2463,56 +2446,56 @@ func (x *fastReflection_EventVerificationMethodAdded) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventDIDReactivated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodAdded.did":
-		panic(fmt.Errorf("field did of message did.v1.EventVerificationMethodAdded is not mutable"))
-	case "did.v1.EventVerificationMethodAdded.method_id":
-		panic(fmt.Errorf("field method_id of message did.v1.EventVerificationMethodAdded is not mutable"))
-	case "did.v1.EventVerificationMethodAdded.key_type":
-		panic(fmt.Errorf("field key_type of message did.v1.EventVerificationMethodAdded is not mutable"))
-	case "did.v1.EventVerificationMethodAdded.public_key":
-		panic(fmt.Errorf("field public_key of message did.v1.EventVerificationMethodAdded is not mutable"))
-	case "did.v1.EventVerificationMethodAdded.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventVerificationMethodAdded is not mutable"))
+	case "did.v1.EventDIDReactivated.reactivated_at":
+		if x.ReactivatedAt == nil {
+			x.ReactivatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ReactivatedAt.ProtoReflect())
+	case "did.v1.EventDIDReactivated.did":
+		panic(fmt.Errorf("field did of message did.v1.EventDIDReactivated is not mutable"))
+	case "did.v1.EventDIDReactivated.reactivator":
+		panic(fmt.Errorf("field reactivator of message did.v1.EventDIDReactivated is not mutable"))
+	case "did.v1.EventDIDReactivated.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventDIDReactivated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDIDReactivated"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventDIDReactivated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventVerificationMethodAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventDIDReactivated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodAdded.did":
-		return protoreflect.ValueOfString("")
-	case "did.v1.EventVerificationMethodAdded.method_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.EventVerificationMethodAdded.key_type":
+	case "did.v1.EventDIDReactivated.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventVerificationMethodAdded.public_key":
+	case "did.v1.EventDIDReactivated.reactivator":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventVerificationMethodAdded.block_height":
+	case "did.v1.EventDIDReactivated.reactivated_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.EventDIDReactivated.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDIDReactivated"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventDIDReactivated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventVerificationMethodAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventDIDReactivated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventVerificationMethodAdded", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventDIDReactivated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2520,7 +2503,7 @@ func (x *fastReflection_EventVerificationMethodAdded) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventVerificationMethodAdded) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventDIDReactivated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2531,7 +2514,7 @@ func (x *fastReflection_EventVerificationMethodAdded) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodAdded) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventDIDReactivated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2543,7 +2526,7 @@ func (x *fastReflection_EventVerificationMethodAdded) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventVerificationMethodAdded) IsValid() bool {
+func (x *fastReflection_EventDIDReactivated) IsValid() bool {
 	return x != nil
 }
 
@@ -2553,9 +2536,9 @@ func (x *fastReflection_EventVerificationMethodAdded) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventDIDReactivated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventVerificationMethodAdded)
+		x := input.Message.Interface().(*EventDIDReactivated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2571,16 +2554,12 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MethodId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.KeyType)
+		l = len(x.Reactivator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PublicKey)
-		if l > 0 {
+		if x.ReactivatedAt != nil {
+			l = options.Size(x.ReactivatedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.BlockHeight != 0 {
@@ -2596,7 +2575,7 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventVerificationMethodAdded)
+		x := input.Message.Interface().(*EventDIDReactivated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2618,26 +2597,26 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
-			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if len(x.KeyType) > 0 {
-			i -= len(x.KeyType)
-			copy(dAtA[i:], x.KeyType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyType)))
+		if x.ReactivatedAt != nil {
+			encoded, err := options.Marshal(x.ReactivatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.MethodId) > 0 {
-			i -= len(x.MethodId)
-			copy(dAtA[i:], x.MethodId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MethodId)))
+		if len(x.Reactivator) > 0 {
+			i -= len(x.Reactivator)
+			copy(dAtA[i:], x.Reactivator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reactivator)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -2659,7 +2638,7 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventVerificationMethodAdded)
+		x := input.Message.Interface().(*EventDIDReactivated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2691,10 +2670,10 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodAdded: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDIDReactivated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDIDReactivated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2731,7 +2710,7 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reactivator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2759,13 +2738,13 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MethodId = string(dAtA[iNdEx:postIndex])
+				x.Reactivator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReactivatedAt", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2775,57 +2754,29 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.KeyType = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.ReactivatedAt == nil {
+					x.ReactivatedAt = &timestamppb.Timestamp{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReactivatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.PublicKey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -2880,29 +2831,33 @@ func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface
 }
 
 var (
-	md_EventVerificationMethodRemoved              protoreflect.MessageDescriptor
-	fd_EventVerificationMethodRemoved_did          protoreflect.FieldDescriptor
-	fd_EventVerificationMethodRemoved_method_id    protoreflect.FieldDescriptor
-	fd_EventVerificationMethodRemoved_block_height protoreflect.FieldDescriptor
+	md_EventVerificationMethodAdded              protoreflect.MessageDescriptor
+	fd_EventVerificationMethodAdded_did          protoreflect.FieldDescriptor
+	fd_EventVerificationMethodAdded_method_id    protoreflect.FieldDescriptor
+	fd_EventVerificationMethodAdded_key_type     protoreflect.FieldDescriptor
+	fd_EventVerificationMethodAdded_public_key   protoreflect.FieldDescriptor
+	fd_EventVerificationMethodAdded_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventVerificationMethodRemoved = File_did_v1_events_proto.Messages().ByName("EventVerificationMethodRemoved")
-	fd_EventVerificationMethodRemoved_did = md_EventVerificationMethodRemoved.Fields().ByName("did")
-	fd_EventVerificationMethodRemoved_method_id = md_EventVerificationMethodRemoved.Fields().ByName("method_id")
-	fd_EventVerificationMethodRemoved_block_height = md_EventVerificationMethodRemoved.Fields().ByName("block_height")
+	md_EventVerificationMethodAdded = File_did_v1_events_proto.Messages().ByName("EventVerificationMethodAdded")
+	fd_EventVerificationMethodAdded_did = md_EventVerificationMethodAdded.Fields().ByName("did")
+	fd_EventVerificationMethodAdded_method_id = md_EventVerificationMethodAdded.Fields().ByName("method_id")
+	fd_EventVerificationMethodAdded_key_type = md_EventVerificationMethodAdded.Fields().ByName("key_type")
+	fd_EventVerificationMethodAdded_public_key = md_EventVerificationMethodAdded.Fields().ByName("public_key")
+	fd_EventVerificationMethodAdded_block_height = md_EventVerificationMethodAdded.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventVerificationMethodRemoved)(nil)
+var _ protoreflect.Message = (*fastReflection_EventVerificationMethodAdded)(nil)
 
-type fastReflection_EventVerificationMethodRemoved EventVerificationMethodRemoved
+type fastReflection_EventVerificationMethodAdded EventVerificationMethodAdded
 
-func (x *EventVerificationMethodRemoved) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventVerificationMethodRemoved)(x)
+func (x *EventVerificationMethodAdded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVerificationMethodAdded)(x)
 }
 
-func (x *EventVerificationMethodRemoved) slowProtoReflect() protoreflect.Message {
+func (x *EventVerificationMethodAdded) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2914,43 +2869,43 @@ func (x *EventVerificationMethodRemoved) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventVerificationMethodRemoved_messageType fastReflection_EventVerificationMethodRemoved_messageType
-var _ protoreflect.MessageType = fastReflection_EventVerificationMethodRemoved_messageType{}
+var _fastReflection_EventVerificationMethodAdded_messageType fastReflection_EventVerificationMethodAdded_messageType
+var _ protoreflect.MessageType = fastReflection_EventVerificationMethodAdded_messageType{}
 
-type fastReflection_EventVerificationMethodRemoved_messageType struct{}
+type fastReflection_EventVerificationMethodAdded_messageType struct{}
 
-func (x fastReflection_EventVerificationMethodRemoved_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventVerificationMethodRemoved)(nil)
+func (x fastReflection_EventVerificationMethodAdded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVerificationMethodAdded)(nil)
 }
-func (x fastReflection_EventVerificationMethodRemoved_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventVerificationMethodRemoved)
+func (x fastReflection_EventVerificationMethodAdded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVerificationMethodAdded)
 }
-func (x fastReflection_EventVerificationMethodRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVerificationMethodRemoved
+func (x fastReflection_EventVerificationMethodAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVerificationMethodAdded
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventVerificationMethodRemoved) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVerificationMethodRemoved
+func (x *fastReflection_EventVerificationMethodAdded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVerificationMethodAdded
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventVerificationMethodRemoved) Type() protoreflect.MessageType {
-	return _fastReflection_EventVerificationMethodRemoved_messageType
+func (x *fastReflection_EventVerificationMethodAdded) Type() protoreflect.MessageType {
+	return _fastReflection_EventVerificationMethodAdded_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventVerificationMethodRemoved) New() protoreflect.Message {
-	return new(fastReflection_EventVerificationMethodRemoved)
+func (x *fastReflection_EventVerificationMethodAdded) New() protoreflect.Message {
+	return new(fastReflection_EventVerificationMethodAdded)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventVerificationMethodRemoved) Interface() protoreflect.ProtoMessage {
-	return (*EventVerificationMethodRemoved)(x)
+func (x *fastReflection_EventVerificationMethodAdded) Interface() protoreflect.ProtoMessage {
+	return (*EventVerificationMethodAdded)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2958,22 +2913,34 @@ func (x *fastReflection_EventVerificationMethodRemoved) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventVerificationMethodRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventVerificationMethodAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_EventVerificationMethodRemoved_did, value) {
+		if !f(fd_EventVerificationMethodAdded_did, value) {
 			return
 		}
 	}
 	if x.MethodId != "" {
 		value := protoreflect.ValueOfString(x.MethodId)
-		if !f(fd_EventVerificationMethodRemoved_method_id, value) {
+		if !f(fd_EventVerificationMethodAdded_method_id, value) {
+			return
+		}
+	}
+	if x.KeyType != "" {
+		value := protoreflect.ValueOfString(x.KeyType)
+		if !f(fd_EventVerificationMethodAdded_key_type, value) {
+			return
+		}
+	}
+	if x.PublicKey != "" {
+		value := protoreflect.ValueOfString(x.PublicKey)
+		if !f(fd_EventVerificationMethodAdded_public_key, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventVerificationMethodRemoved_block_height, value) {
+		if !f(fd_EventVerificationMethodAdded_block_height, value) {
 			return
 		}
 	}
@@ -2990,19 +2957,23 @@ func (x *fastReflection_EventVerificationMethodRemoved) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventVerificationMethodRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventVerificationMethodAdded) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodRemoved.did":
+	case "did.v1.EventVerificationMethodAdded.did":
 		return x.Did != ""
-	case "did.v1.EventVerificationMethodRemoved.method_id":
+	case "did.v1.EventVerificationMethodAdded.method_id":
 		return x.MethodId != ""
-	case "did.v1.EventVerificationMethodRemoved.block_height":
+	case "did.v1.EventVerificationMethodAdded.key_type":
+		return x.KeyType != ""
+	case "did.v1.EventVerificationMethodAdded.public_key":
+		return x.PublicKey != ""
+	case "did.v1.EventVerificationMethodAdded.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3012,19 +2983,23 @@ func (x *fastReflection_EventVerificationMethodRemoved) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodRemoved) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventVerificationMethodAdded) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodRemoved.did":
+	case "did.v1.EventVerificationMethodAdded.did":
 		x.Did = ""
-	case "did.v1.EventVerificationMethodRemoved.method_id":
+	case "did.v1.EventVerificationMethodAdded.method_id":
 		x.MethodId = ""
-	case "did.v1.EventVerificationMethodRemoved.block_height":
+	case "did.v1.EventVerificationMethodAdded.key_type":
+		x.KeyType = ""
+	case "did.v1.EventVerificationMethodAdded.public_key":
+		x.PublicKey = ""
+	case "did.v1.EventVerificationMethodAdded.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3034,22 +3009,28 @@ func (x *fastReflection_EventVerificationMethodRemoved) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventVerificationMethodRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVerificationMethodAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventVerificationMethodRemoved.did":
+	case "did.v1.EventVerificationMethodAdded.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventVerificationMethodRemoved.method_id":
+	case "did.v1.EventVerificationMethodAdded.method_id":
 		value := x.MethodId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventVerificationMethodRemoved.block_height":
+	case "did.v1.EventVerificationMethodAdded.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventVerificationMethodAdded.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventVerificationMethodAdded.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3063,19 +3044,23 @@ func (x *fastReflection_EventVerificationMethodRemoved) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventVerificationMethodAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodRemoved.did":
+	case "did.v1.EventVerificationMethodAdded.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.EventVerificationMethodRemoved.method_id":
+	case "did.v1.EventVerificationMethodAdded.method_id":
 		x.MethodId = value.Interface().(string)
-	case "did.v1.EventVerificationMethodRemoved.block_height":
+	case "did.v1.EventVerificationMethodAdded.key_type":
+		x.KeyType = value.Interface().(string)
+	case "did.v1.EventVerificationMethodAdded.public_key":
+		x.PublicKey = value.Interface().(string)
+	case "did.v1.EventVerificationMethodAdded.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3089,48 +3074,56 @@ func (x *fastReflection_EventVerificationMethodRemoved) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVerificationMethodAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodRemoved.did":
-		panic(fmt.Errorf("field did of message did.v1.EventVerificationMethodRemoved is not mutable"))
-	case "did.v1.EventVerificationMethodRemoved.method_id":
-		panic(fmt.Errorf("field method_id of message did.v1.EventVerificationMethodRemoved is not mutable"))
-	case "did.v1.EventVerificationMethodRemoved.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventVerificationMethodRemoved is not mutable"))
+	case "did.v1.EventVerificationMethodAdded.did":
+		panic(fmt.Errorf("field did of message did.v1.EventVerificationMethodAdded is not mutable"))
+	case "did.v1.EventVerificationMethodAdded.method_id":
+		panic(fmt.Errorf("field method_id of message did.v1.EventVerificationMethodAdded is not mutable"))
+	case "did.v1.EventVerificationMethodAdded.key_type":
+		panic(fmt.Errorf("field key_type of message did.v1.EventVerificationMethodAdded is not mutable"))
+	case "did.v1.EventVerificationMethodAdded.public_key":
+		panic(fmt.Errorf("field public_key of message did.v1.EventVerificationMethodAdded is not mutable"))
+	case "did.v1.EventVerificationMethodAdded.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventVerificationMethodAdded is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventVerificationMethodRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVerificationMethodAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventVerificationMethodRemoved.did":
+	case "did.v1.EventVerificationMethodAdded.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventVerificationMethodRemoved.method_id":
+	case "did.v1.EventVerificationMethodAdded.method_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventVerificationMethodRemoved.block_height":
+	case "did.v1.EventVerificationMethodAdded.key_type":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventVerificationMethodAdded.public_key":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventVerificationMethodAdded.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodAdded does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventVerificationMethodRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventVerificationMethodAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventVerificationMethodRemoved", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventVerificationMethodAdded", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3138,7 +3131,7 @@ func (x *fastReflection_EventVerificationMethodRemoved) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventVerificationMethodRemoved) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventVerificationMethodAdded) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3149,7 +3142,7 @@ func (x *fastReflection_EventVerificationMethodRemoved) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVerificationMethodRemoved) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventVerificationMethodAdded) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3161,7 +3154,7 @@ func (x *fastReflection_EventVerificationMethodRemoved) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventVerificationMethodRemoved) IsValid() bool {
+func (x *fastReflection_EventVerificationMethodAdded) IsValid() bool {
 	return x != nil
 }
 
@@ -3171,9 +3164,9 @@ func (x *fastReflection_EventVerificationMethodRemoved) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventVerificationMethodAdded) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventVerificationMethodRemoved)
+		x := input.Message.Interface().(*EventVerificationMethodAdded)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3193,6 +3186,14 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.KeyType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
@@ -3206,7 +3207,7 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventVerificationMethodRemoved)
+		x := input.Message.Interface().(*EventVerificationMethodAdded)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3228,7 +3229,21 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x28
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.KeyType) > 0 {
+			i -= len(x.KeyType)
+			copy(dAtA[i:], x.KeyType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyType)))
+			i--
+			dAtA[i] = 0x1a
 		}
 		if len(x.MethodId) > 0 {
 			i -= len(x.MethodId)
@@ -3255,7 +3270,7 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventVerificationMethodRemoved)
+		x := input.Message.Interface().(*EventVerificationMethodAdded)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3287,10 +3302,10 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodRemoved: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodAdded: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3358,6 +3373,70 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 				x.MethodId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -3412,33 +3491,29 @@ func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoifa
 }
 
 var (
-	md_EventServiceAdded              protoreflect.MessageDescriptor
-	fd_EventServiceAdded_did          protoreflect.FieldDescriptor
-	fd_EventServiceAdded_service_id   protoreflect.FieldDescriptor
-	fd_EventServiceAdded_type         protoreflect.FieldDescriptor
-	fd_EventServiceAdded_endpoint     protoreflect.FieldDescriptor
-	fd_EventServiceAdded_block_height protoreflect.FieldDescriptor
+	md_EventVerificationMethodRemoved              protoreflect.MessageDescriptor
+	fd_EventVerificationMethodRemoved_did          protoreflect.FieldDescriptor
+	fd_EventVerificationMethodRemoved_method_id    protoreflect.FieldDescriptor
+	fd_EventVerificationMethodRemoved_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventServiceAdded = File_did_v1_events_proto.Messages().ByName("EventServiceAdded")
-	fd_EventServiceAdded_did = md_EventServiceAdded.Fields().ByName("did")
-	fd_EventServiceAdded_service_id = md_EventServiceAdded.Fields().ByName("service_id")
-	fd_EventServiceAdded_type = md_EventServiceAdded.Fields().ByName("type")
-	fd_EventServiceAdded_endpoint = md_EventServiceAdded.Fields().ByName("endpoint")
-	fd_EventServiceAdded_block_height = md_EventServiceAdded.Fields().ByName("block_height")
+	md_EventVerificationMethodRemoved = File_did_v1_events_proto.Messages().ByName("EventVerificationMethodRemoved")
+	fd_EventVerificationMethodRemoved_did = md_EventVerificationMethodRemoved.Fields().ByName("did")
+	fd_EventVerificationMethodRemoved_method_id = md_EventVerificationMethodRemoved.Fields().ByName("method_id")
+	fd_EventVerificationMethodRemoved_block_height = md_EventVerificationMethodRemoved.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventServiceAdded)(nil)
+var _ protoreflect.Message = (*fastReflection_EventVerificationMethodRemoved)(nil)
 
-type fastReflection_EventServiceAdded EventServiceAdded
+type fastReflection_EventVerificationMethodRemoved EventVerificationMethodRemoved
 
-func (x *EventServiceAdded) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventServiceAdded)(x)
+func (x *EventVerificationMethodRemoved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVerificationMethodRemoved)(x)
 }
 
-func (x *EventServiceAdded) slowProtoReflect() protoreflect.Message {
+func (x *EventVerificationMethodRemoved) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3450,43 +3525,43 @@ func (x *EventServiceAdded) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventServiceAdded_messageType fastReflection_EventServiceAdded_messageType
-var _ protoreflect.MessageType = fastReflection_EventServiceAdded_messageType{}
+var _fastReflection_EventVerificationMethodRemoved_messageType fastReflection_EventVerificationMethodRemoved_messageType
+var _ protoreflect.MessageType = fastReflection_EventVerificationMethodRemoved_messageType{}
 
-type fastReflection_EventServiceAdded_messageType struct{}
+type fastReflection_EventVerificationMethodRemoved_messageType struct{}
 
-func (x fastReflection_EventServiceAdded_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventServiceAdded)(nil)
+func (x fastReflection_EventVerificationMethodRemoved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVerificationMethodRemoved)(nil)
 }
-func (x fastReflection_EventServiceAdded_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventServiceAdded)
+func (x fastReflection_EventVerificationMethodRemoved_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVerificationMethodRemoved)
 }
-func (x fastReflection_EventServiceAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventServiceAdded
+func (x fastReflection_EventVerificationMethodRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVerificationMethodRemoved
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventServiceAdded) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventServiceAdded
+func (x *fastReflection_EventVerificationMethodRemoved) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVerificationMethodRemoved
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventServiceAdded) Type() protoreflect.MessageType {
-	return _fastReflection_EventServiceAdded_messageType
+func (x *fastReflection_EventVerificationMethodRemoved) Type() protoreflect.MessageType {
+	return _fastReflection_EventVerificationMethodRemoved_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventServiceAdded) New() protoreflect.Message {
-	return new(fastReflection_EventServiceAdded)
+func (x *fastReflection_EventVerificationMethodRemoved) New() protoreflect.Message {
+	return new(fastReflection_EventVerificationMethodRemoved)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventServiceAdded) Interface() protoreflect.ProtoMessage {
-	return (*EventServiceAdded)(x)
+func (x *fastReflection_EventVerificationMethodRemoved) Interface() protoreflect.ProtoMessage {
+	return (*EventVerificationMethodRemoved)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3494,34 +3569,22 @@ func (x *fastReflection_EventServiceAdded) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventServiceAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventVerificationMethodRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_EventServiceAdded_did, value) {
-			return
-		}
-	}
-	if x.ServiceId != "" {
-		value := protoreflect.ValueOfString(x.ServiceId)
-		if !f(fd_EventServiceAdded_service_id, value) {
-			return
-		}
-	}
-	if x.Type_ != "" {
-		value := protoreflect.ValueOfString(x.Type_)
-		if !f(fd_EventServiceAdded_type, value) {
+		if !f(fd_EventVerificationMethodRemoved_did, value) {
 			return
 		}
 	}
-	if x.Endpoint != "" {
-		value := protoreflect.ValueOfString(x.Endpoint)
-		if !f(fd_EventServiceAdded_endpoint, value) {
+	if x.MethodId != "" {
+		value := protoreflect.ValueOfString(x.MethodId)
+		if !f(fd_EventVerificationMethodRemoved_method_id, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventServiceAdded_block_height, value) {
+		if !f(fd_EventVerificationMethodRemoved_block_height, value) {
 			return
 		}
 	}
@@ -3538,23 +3601,19 @@ func (x *fastReflection_EventServiceAdded) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventServiceAdded) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventVerificationMethodRemoved) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventServiceAdded.did":
+	case "did.v1.EventVerificationMethodRemoved.did":
 		return x.Did != ""
-	case "did.v1.EventServiceAdded.service_id":
-		return x.ServiceId != ""
-	case "did.v1.EventServiceAdded.type":
-		return x.Type_ != ""
-	case "did.v1.EventServiceAdded.endpoint":
-		return x.Endpoint != ""
-	case "did.v1.EventServiceAdded.block_height":
+	case "did.v1.EventVerificationMethodRemoved.method_id":
+		return x.MethodId != ""
+	case "did.v1.EventVerificationMethodRemoved.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3564,23 +3623,19 @@ func (x *fastReflection_EventServiceAdded) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceAdded) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventVerificationMethodRemoved) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventServiceAdded.did":
+	case "did.v1.EventVerificationMethodRemoved.did":
 		x.Did = ""
-	case "did.v1.EventServiceAdded.service_id":
-		x.ServiceId = ""
-	case "did.v1.EventServiceAdded.type":
-		x.Type_ = ""
-	case "did.v1.EventServiceAdded.endpoint":
-		x.Endpoint = ""
-	case "did.v1.EventServiceAdded.block_height":
+	case "did.v1.EventVerificationMethodRemoved.method_id":
+		x.MethodId = ""
+	case "did.v1.EventVerificationMethodRemoved.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3590,28 +3645,22 @@ func (x *fastReflection_EventServiceAdded) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventServiceAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVerificationMethodRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventServiceAdded.did":
+	case "did.v1.EventVerificationMethodRemoved.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventServiceAdded.service_id":
-		value := x.ServiceId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventServiceAdded.type":
-		value := x.Type_
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventServiceAdded.endpoint":
-		value := x.Endpoint
+	case "did.v1.EventVerificationMethodRemoved.method_id":
+		value := x.MethodId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventServiceAdded.block_height":
+	case "did.v1.EventVerificationMethodRemoved.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3625,23 +3674,19 @@ func (x *fastReflection_EventServiceAdded) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventVerificationMethodRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventServiceAdded.did":
+	case "did.v1.EventVerificationMethodRemoved.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.EventServiceAdded.service_id":
-		x.ServiceId = value.Interface().(string)
-	case "did.v1.EventServiceAdded.type":
-		x.Type_ = value.Interface().(string)
-	case "did.v1.EventServiceAdded.endpoint":
-		x.Endpoint = value.Interface().(string)
-	case "did.v1.EventServiceAdded.block_height":
+	case "did.v1.EventVerificationMethodRemoved.method_id":
+		x.MethodId = value.Interface().(string)
+	case "did.v1.EventVerificationMethodRemoved.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3655,56 +3700,48 @@ func (x *fastReflection_EventServiceAdded) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVerificationMethodRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventServiceAdded.did":
-		panic(fmt.Errorf("field did of message did.v1.EventServiceAdded is not mutable"))
-	case "did.v1.EventServiceAdded.service_id":
-		panic(fmt.Errorf("field service_id of message did.v1.EventServiceAdded is not mutable"))
-	case "did.v1.EventServiceAdded.type":
-		panic(fmt.Errorf("field type of message did.v1.EventServiceAdded is not mutable"))
-	case "did.v1.EventServiceAdded.endpoint":
-		panic(fmt.Errorf("field endpoint of message did.v1.EventServiceAdded is not mutable"))
-	case "did.v1.EventServiceAdded.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventServiceAdded is not mutable"))
+	case "did.v1.EventVerificationMethodRemoved.did":
+		panic(fmt.Errorf("field did of message did.v1.EventVerificationMethodRemoved is not mutable"))
+	case "did.v1.EventVerificationMethodRemoved.method_id":
+		panic(fmt.Errorf("field method_id of message did.v1.EventVerificationMethodRemoved is not mutable"))
+	case "did.v1.EventVerificationMethodRemoved.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventVerificationMethodRemoved is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventServiceAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVerificationMethodRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventServiceAdded.did":
-		return protoreflect.ValueOfString("")
-	case "did.v1.EventServiceAdded.service_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.EventServiceAdded.type":
+	case "did.v1.EventVerificationMethodRemoved.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventServiceAdded.endpoint":
+	case "did.v1.EventVerificationMethodRemoved.method_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventServiceAdded.block_height":
+	case "did.v1.EventVerificationMethodRemoved.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventVerificationMethodRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventVerificationMethodRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventServiceAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventVerificationMethodRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventServiceAdded", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventVerificationMethodRemoved", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3712,7 +3749,7 @@ func (x *fastReflection_EventServiceAdded) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventServiceAdded) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventVerificationMethodRemoved) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3723,7 +3760,7 @@ func (x *fastReflection_EventServiceAdded) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceAdded) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventVerificationMethodRemoved) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3735,7 +3772,7 @@ func (x *fastReflection_EventServiceAdded) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventServiceAdded) IsValid() bool {
+func (x *fastReflection_EventVerificationMethodRemoved) IsValid() bool {
 	return x != nil
 }
 
@@ -3745,9 +3782,9 @@ func (x *fastReflection_EventServiceAdded) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventVerificationMethodRemoved) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventServiceAdded)
+		x := input.Message.Interface().(*EventVerificationMethodRemoved)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3763,15 +3800,7 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ServiceId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Type_)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Endpoint)
+		l = len(x.MethodId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3788,7 +3817,7 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventServiceAdded)
+		x := input.Message.Interface().(*EventVerificationMethodRemoved)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3810,26 +3839,12 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Endpoint) > 0 {
-			i -= len(x.Endpoint)
-			copy(dAtA[i:], x.Endpoint)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Endpoint)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Type_) > 0 {
-			i -= len(x.Type_)
-			copy(dAtA[i:], x.Type_)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
-			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.ServiceId) > 0 {
-			i -= len(x.ServiceId)
-			copy(dAtA[i:], x.ServiceId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+		if len(x.MethodId) > 0 {
+			i -= len(x.MethodId)
+			copy(dAtA[i:], x.MethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MethodId)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3851,7 +3866,7 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventServiceAdded)
+		x := input.Message.Interface().(*EventVerificationMethodRemoved)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3883,10 +3898,10 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceAdded: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodRemoved: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVerificationMethodRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3923,7 +3938,7 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3951,73 +3966,9 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				x.MethodId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Type_ = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Endpoint = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -4072,29 +4023,33 @@ func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventServiceRemoved              protoreflect.MessageDescriptor
-	fd_EventServiceRemoved_did          protoreflect.FieldDescriptor
-	fd_EventServiceRemoved_service_id   protoreflect.FieldDescriptor
-	fd_EventServiceRemoved_block_height protoreflect.FieldDescriptor
+	md_EventServiceAdded              protoreflect.MessageDescriptor
+	fd_EventServiceAdded_did          protoreflect.FieldDescriptor
+	fd_EventServiceAdded_service_id   protoreflect.FieldDescriptor
+	fd_EventServiceAdded_type         protoreflect.FieldDescriptor
+	fd_EventServiceAdded_endpoint     protoreflect.FieldDescriptor
+	fd_EventServiceAdded_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventServiceRemoved = File_did_v1_events_proto.Messages().ByName("EventServiceRemoved")
-	fd_EventServiceRemoved_did = md_EventServiceRemoved.Fields().ByName("did")
-	fd_EventServiceRemoved_service_id = md_EventServiceRemoved.Fields().ByName("service_id")
-	fd_EventServiceRemoved_block_height = md_EventServiceRemoved.Fields().ByName("block_height")
+	md_EventServiceAdded = File_did_v1_events_proto.Messages().ByName("EventServiceAdded")
+	fd_EventServiceAdded_did = md_EventServiceAdded.Fields().ByName("did")
+	fd_EventServiceAdded_service_id = md_EventServiceAdded.Fields().ByName("service_id")
+	fd_EventServiceAdded_type = md_EventServiceAdded.Fields().ByName("type")
+	fd_EventServiceAdded_endpoint = md_EventServiceAdded.Fields().ByName("endpoint")
+	fd_EventServiceAdded_block_height = md_EventServiceAdded.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventServiceRemoved)(nil)
+var _ protoreflect.Message = (*fastReflection_EventServiceAdded)(nil)
 
-type fastReflection_EventServiceRemoved EventServiceRemoved
+type fastReflection_EventServiceAdded EventServiceAdded
 
-func (x *EventServiceRemoved) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventServiceRemoved)(x)
+func (x *EventServiceAdded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventServiceAdded)(x)
 }
 
-func (x *EventServiceRemoved) slowProtoReflect() protoreflect.Message {
+func (x *EventServiceAdded) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4106,43 +4061,43 @@ func (x *EventServiceRemoved) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventServiceRemoved_messageType fastReflection_EventServiceRemoved_messageType
-var _ protoreflect.MessageType = fastReflection_EventServiceRemoved_messageType{}
+var _fastReflection_EventServiceAdded_messageType fastReflection_EventServiceAdded_messageType
+var _ protoreflect.MessageType = fastReflection_EventServiceAdded_messageType{}
 
-type fastReflection_EventServiceRemoved_messageType struct{}
+type fastReflection_EventServiceAdded_messageType struct{}
 
-func (x fastReflection_EventServiceRemoved_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventServiceRemoved)(nil)
+func (x fastReflection_EventServiceAdded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventServiceAdded)(nil)
 }
-func (x fastReflection_EventServiceRemoved_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventServiceRemoved)
+func (x fastReflection_EventServiceAdded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventServiceAdded)
 }
-func (x fastReflection_EventServiceRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventServiceRemoved
+func (x fastReflection_EventServiceAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceAdded
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventServiceRemoved) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventServiceRemoved
+func (x *fastReflection_EventServiceAdded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceAdded
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventServiceRemoved) Type() protoreflect.MessageType {
-	return _fastReflection_EventServiceRemoved_messageType
+func (x *fastReflection_EventServiceAdded) Type() protoreflect.MessageType {
+	return _fastReflection_EventServiceAdded_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventServiceRemoved) New() protoreflect.Message {
-	return new(fastReflection_EventServiceRemoved)
+func (x *fastReflection_EventServiceAdded) New() protoreflect.Message {
+	return new(fastReflection_EventServiceAdded)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventServiceRemoved) Interface() protoreflect.ProtoMessage {
-	return (*EventServiceRemoved)(x)
+func (x *fastReflection_EventServiceAdded) Interface() protoreflect.ProtoMessage {
+	return (*EventServiceAdded)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4150,22 +4105,34 @@ func (x *fastReflection_EventServiceRemoved) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventServiceRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventServiceAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_EventServiceRemoved_did, value) {
+		if !f(fd_EventServiceAdded_did, value) {
 			return
 		}
 	}
 	if x.ServiceId != "" {
 		value := protoreflect.ValueOfString(x.ServiceId)
-		if !f(fd_EventServiceRemoved_service_id, value) {
+		if !f(fd_EventServiceAdded_service_id, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_EventServiceAdded_type, value) {
+			return
+		}
+	}
+	if x.Endpoint != "" {
+		value := protoreflect.ValueOfString(x.Endpoint)
+		if !f(fd_EventServiceAdded_endpoint, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventServiceRemoved_block_height, value) {
+		if !f(fd_EventServiceAdded_block_height, value) {
 			return
 		}
 	}
@@ -4182,19 +4149,23 @@ func (x *fastReflection_EventServiceRemoved) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventServiceRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventServiceAdded) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventServiceRemoved.did":
+	case "did.v1.EventServiceAdded.did":
 		return x.Did != ""
-	case "did.v1.EventServiceRemoved.service_id":
+	case "did.v1.EventServiceAdded.service_id":
 		return x.ServiceId != ""
-	case "did.v1.EventServiceRemoved.block_height":
+	case "did.v1.EventServiceAdded.type":
+		return x.Type_ != ""
+	case "did.v1.EventServiceAdded.endpoint":
+		return x.Endpoint != ""
+	case "did.v1.EventServiceAdded.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4204,19 +4175,23 @@ func (x *fastReflection_EventServiceRemoved) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceRemoved) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventServiceAdded) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventServiceRemoved.did":
+	case "did.v1.EventServiceAdded.did":
 		x.Did = ""
-	case "did.v1.EventServiceRemoved.service_id":
+	case "did.v1.EventServiceAdded.service_id":
 		x.ServiceId = ""
-	case "did.v1.EventServiceRemoved.block_height":
+	case "did.v1.EventServiceAdded.type":
+		x.Type_ = ""
+	case "did.v1.EventServiceAdded.endpoint":
+		x.Endpoint = ""
+	case "did.v1.EventServiceAdded.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4226,22 +4201,28 @@ func (x *fastReflection_EventServiceRemoved) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventServiceRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventServiceAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventServiceRemoved.did":
+	case "did.v1.EventServiceAdded.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventServiceRemoved.service_id":
+	case "did.v1.EventServiceAdded.service_id":
 		value := x.ServiceId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventServiceRemoved.block_height":
+	case "did.v1.EventServiceAdded.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventServiceAdded.endpoint":
+		value := x.Endpoint
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventServiceAdded.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4255,19 +4236,23 @@ func (x *fastReflection_EventServiceRemoved) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventServiceAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventServiceRemoved.did":
+	case "did.v1.EventServiceAdded.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.EventServiceRemoved.service_id":
+	case "did.v1.EventServiceAdded.service_id":
 		x.ServiceId = value.Interface().(string)
-	case "did.v1.EventServiceRemoved.block_height":
+	case "did.v1.EventServiceAdded.type":
+		x.Type_ = value.Interface().(string)
+	case "did.v1.EventServiceAdded.endpoint":
+		x.Endpoint = value.Interface().(string)
+	case "did.v1.EventServiceAdded.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4281,48 +4266,56 @@ func (x *fastReflection_EventServiceRemoved) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventServiceAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventServiceRemoved.did":
-		panic(fmt.Errorf("field did of message did.v1.EventServiceRemoved is not mutable"))
-	case "did.v1.EventServiceRemoved.service_id":
-		panic(fmt.Errorf("field service_id of message did.v1.EventServiceRemoved is not mutable"))
-	case "did.v1.EventServiceRemoved.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventServiceRemoved is not mutable"))
+	case "did.v1.EventServiceAdded.did":
+		panic(fmt.Errorf("field did of message did.v1.EventServiceAdded is not mutable"))
+	case "did.v1.EventServiceAdded.service_id":
+		panic(fmt.Errorf("field service_id of message did.v1.EventServiceAdded is not mutable"))
+	case "did.v1.EventServiceAdded.type":
+		panic(fmt.Errorf("field type of message did.v1.EventServiceAdded is not mutable"))
+	case "did.v1.EventServiceAdded.endpoint":
+		panic(fmt.Errorf("field endpoint of message did.v1.EventServiceAdded is not mutable"))
+	case "did.v1.EventServiceAdded.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventServiceAdded is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventServiceRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventServiceAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventServiceRemoved.did":
+	case "did.v1.EventServiceAdded.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventServiceRemoved.service_id":
+	case "did.v1.EventServiceAdded.service_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventServiceRemoved.block_height":
+	case "did.v1.EventServiceAdded.type":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventServiceAdded.endpoint":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventServiceAdded.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceAdded"))
 		}
-		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceAdded does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventServiceRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventServiceAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventServiceRemoved", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventServiceAdded", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4330,7 +4323,7 @@ func (x *fastReflection_EventServiceRemoved) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventServiceRemoved) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventServiceAdded) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4341,7 +4334,7 @@ func (x *fastReflection_EventServiceRemoved) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventServiceRemoved) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventServiceAdded) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4353,7 +4346,7 @@ func (x *fastReflection_EventServiceRemoved) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventServiceRemoved) IsValid() bool {
+func (x *fastReflection_EventServiceAdded) IsValid() bool {
 	return x != nil
 }
 
@@ -4363,9 +4356,9 @@ func (x *fastReflection_EventServiceRemoved) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventServiceAdded) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventServiceRemoved)
+		x := input.Message.Interface().(*EventServiceAdded)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4385,6 +4378,14 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Endpoint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
@@ -4398,7 +4399,7 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventServiceRemoved)
+		x := input.Message.Interface().(*EventServiceAdded)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4420,7 +4421,21 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x28
+		}
+		if len(x.Endpoint) > 0 {
+			i -= len(x.Endpoint)
+			copy(dAtA[i:], x.Endpoint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Endpoint)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0x1a
 		}
 		if len(x.ServiceId) > 0 {
 			i -= len(x.ServiceId)
@@ -4447,7 +4462,7 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventServiceRemoved)
+		x := input.Message.Interface().(*EventServiceAdded)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4479,10 +4494,10 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceRemoved: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceAdded: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4550,6 +4565,70 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 				x.ServiceId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Endpoint = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -4604,35 +4683,29 @@ func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EventCredentialIssued               protoreflect.MessageDescriptor
-	fd_EventCredentialIssued_credential_id protoreflect.FieldDescriptor
-	fd_EventCredentialIssued_issuer        protoreflect.FieldDescriptor
-	fd_EventCredentialIssued_subject       protoreflect.FieldDescriptor
-	fd_EventCredentialIssued_type          protoreflect.FieldDescriptor
-	fd_EventCredentialIssued_issued_at     protoreflect.FieldDescriptor
-	fd_EventCredentialIssued_block_height  protoreflect.FieldDescriptor
+	md_EventServiceRemoved              protoreflect.MessageDescriptor
+	fd_EventServiceRemoved_did          protoreflect.FieldDescriptor
+	fd_EventServiceRemoved_service_id   protoreflect.FieldDescriptor
+	fd_EventServiceRemoved_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventCredentialIssued = File_did_v1_events_proto.Messages().ByName("EventCredentialIssued")
-	fd_EventCredentialIssued_credential_id = md_EventCredentialIssued.Fields().ByName("credential_id")
-	fd_EventCredentialIssued_issuer = md_EventCredentialIssued.Fields().ByName("issuer")
-	fd_EventCredentialIssued_subject = md_EventCredentialIssued.Fields().ByName("subject")
-	fd_EventCredentialIssued_type = md_EventCredentialIssued.Fields().ByName("type")
-	fd_EventCredentialIssued_issued_at = md_EventCredentialIssued.Fields().ByName("issued_at")
-	fd_EventCredentialIssued_block_height = md_EventCredentialIssued.Fields().ByName("block_height")
+	md_EventServiceRemoved = File_did_v1_events_proto.Messages().ByName("EventServiceRemoved")
+	fd_EventServiceRemoved_did = md_EventServiceRemoved.Fields().ByName("did")
+	fd_EventServiceRemoved_service_id = md_EventServiceRemoved.Fields().ByName("service_id")
+	fd_EventServiceRemoved_block_height = md_EventServiceRemoved.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventCredentialIssued)(nil)
+var _ protoreflect.Message = (*fastReflection_EventServiceRemoved)(nil)
 
-type fastReflection_EventCredentialIssued EventCredentialIssued
+type fastReflection_EventServiceRemoved EventServiceRemoved
 
-func (x *EventCredentialIssued) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventCredentialIssued)(x)
+func (x *EventServiceRemoved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventServiceRemoved)(x)
 }
 
-func (x *EventCredentialIssued) slowProtoReflect() protoreflect.Message {
+func (x *EventServiceRemoved) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4644,43 +4717,43 @@ func (x *EventCredentialIssued) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventCredentialIssued_messageType fastReflection_EventCredentialIssued_messageType
-var _ protoreflect.MessageType = fastReflection_EventCredentialIssued_messageType{}
+var _fastReflection_EventServiceRemoved_messageType fastReflection_EventServiceRemoved_messageType
+var _ protoreflect.MessageType = fastReflection_EventServiceRemoved_messageType{}
 
-type fastReflection_EventCredentialIssued_messageType struct{}
+type fastReflection_EventServiceRemoved_messageType struct{}
 
-func (x fastReflection_EventCredentialIssued_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventCredentialIssued)(nil)
+func (x fastReflection_EventServiceRemoved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventServiceRemoved)(nil)
 }
-func (x fastReflection_EventCredentialIssued_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventCredentialIssued)
+func (x fastReflection_EventServiceRemoved_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventServiceRemoved)
 }
-func (x fastReflection_EventCredentialIssued_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCredentialIssued
+func (x fastReflection_EventServiceRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceRemoved
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventCredentialIssued) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCredentialIssued
+func (x *fastReflection_EventServiceRemoved) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceRemoved
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventCredentialIssued) Type() protoreflect.MessageType {
-	return _fastReflection_EventCredentialIssued_messageType
+func (x *fastReflection_EventServiceRemoved) Type() protoreflect.MessageType {
+	return _fastReflection_EventServiceRemoved_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventCredentialIssued) New() protoreflect.Message {
-	return new(fastReflection_EventCredentialIssued)
+func (x *fastReflection_EventServiceRemoved) New() protoreflect.Message {
+	return new(fastReflection_EventServiceRemoved)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventCredentialIssued) Interface() protoreflect.ProtoMessage {
-	return (*EventCredentialIssued)(x)
+func (x *fastReflection_EventServiceRemoved) Interface() protoreflect.ProtoMessage {
+	return (*EventServiceRemoved)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4688,40 +4761,22 @@ func (x *fastReflection_EventCredentialIssued) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventCredentialIssued) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CredentialId != "" {
-		value := protoreflect.ValueOfString(x.CredentialId)
-		if !f(fd_EventCredentialIssued_credential_id, value) {
-			return
-		}
-	}
-	if x.Issuer != "" {
-		value := protoreflect.ValueOfString(x.Issuer)
-		if !f(fd_EventCredentialIssued_issuer, value) {
-			return
-		}
-	}
-	if x.Subject != "" {
-		value := protoreflect.ValueOfString(x.Subject)
-		if !f(fd_EventCredentialIssued_subject, value) {
-			return
-		}
-	}
-	if x.Type_ != "" {
-		value := protoreflect.ValueOfString(x.Type_)
-		if !f(fd_EventCredentialIssued_type, value) {
+func (x *fastReflection_EventServiceRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventServiceRemoved_did, value) {
 			return
 		}
 	}
-	if x.IssuedAt != nil {
-		value := protoreflect.ValueOfMessage(x.IssuedAt.ProtoReflect())
-		if !f(fd_EventCredentialIssued_issued_at, value) {
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_EventServiceRemoved_service_id, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventCredentialIssued_block_height, value) {
+		if !f(fd_EventServiceRemoved_block_height, value) {
 			return
 		}
 	}
@@ -4738,25 +4793,19 @@ func (x *fastReflection_EventCredentialIssued) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventCredentialIssued) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventServiceRemoved) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialIssued.credential_id":
-		return x.CredentialId != ""
-	case "did.v1.EventCredentialIssued.issuer":
-		return x.Issuer != ""
-	case "did.v1.EventCredentialIssued.subject":
-		return x.Subject != ""
-	case "did.v1.EventCredentialIssued.type":
-		return x.Type_ != ""
-	case "did.v1.EventCredentialIssued.issued_at":
-		return x.IssuedAt != nil
-	case "did.v1.EventCredentialIssued.block_height":
+	case "did.v1.EventServiceRemoved.did":
+		return x.Did != ""
+	case "did.v1.EventServiceRemoved.service_id":
+		return x.ServiceId != ""
+	case "did.v1.EventServiceRemoved.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4766,25 +4815,19 @@ func (x *fastReflection_EventCredentialIssued) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialIssued) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventServiceRemoved) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialIssued.credential_id":
-		x.CredentialId = ""
-	case "did.v1.EventCredentialIssued.issuer":
-		x.Issuer = ""
-	case "did.v1.EventCredentialIssued.subject":
-		x.Subject = ""
-	case "did.v1.EventCredentialIssued.type":
-		x.Type_ = ""
-	case "did.v1.EventCredentialIssued.issued_at":
-		x.IssuedAt = nil
-	case "did.v1.EventCredentialIssued.block_height":
+	case "did.v1.EventServiceRemoved.did":
+		x.Did = ""
+	case "did.v1.EventServiceRemoved.service_id":
+		x.ServiceId = ""
+	case "did.v1.EventServiceRemoved.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4794,31 +4837,22 @@ func (x *fastReflection_EventCredentialIssued) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventCredentialIssued) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventServiceRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventCredentialIssued.credential_id":
-		value := x.CredentialId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialIssued.issuer":
-		value := x.Issuer
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialIssued.subject":
-		value := x.Subject
+	case "did.v1.EventServiceRemoved.did":
+		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialIssued.type":
-		value := x.Type_
+	case "did.v1.EventServiceRemoved.service_id":
+		value := x.ServiceId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialIssued.issued_at":
-		value := x.IssuedAt
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.EventCredentialIssued.block_height":
+	case "did.v1.EventServiceRemoved.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4832,25 +4866,19 @@ func (x *fastReflection_EventCredentialIssued) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialIssued) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventServiceRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialIssued.credential_id":
-		x.CredentialId = value.Interface().(string)
-	case "did.v1.EventCredentialIssued.issuer":
-		x.Issuer = value.Interface().(string)
-	case "did.v1.EventCredentialIssued.subject":
-		x.Subject = value.Interface().(string)
-	case "did.v1.EventCredentialIssued.type":
-		x.Type_ = value.Interface().(string)
-	case "did.v1.EventCredentialIssued.issued_at":
-		x.IssuedAt = value.Message().Interface().(*timestamppb.Timestamp)
-	case "did.v1.EventCredentialIssued.block_height":
+	case "did.v1.EventServiceRemoved.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventServiceRemoved.service_id":
+		x.ServiceId = value.Interface().(string)
+	case "did.v1.EventServiceRemoved.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4864,64 +4892,48 @@ func (x *fastReflection_EventCredentialIssued) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialIssued) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventServiceRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialIssued.issued_at":
-		if x.IssuedAt == nil {
-			x.IssuedAt = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.IssuedAt.ProtoReflect())
-	case "did.v1.EventCredentialIssued.credential_id":
-		panic(fmt.Errorf("field credential_id of message did.v1.EventCredentialIssued is not mutable"))
-	case "did.v1.EventCredentialIssued.issuer":
-		panic(fmt.Errorf("field issuer of message did.v1.EventCredentialIssued is not mutable"))
-	case "did.v1.EventCredentialIssued.subject":
-		panic(fmt.Errorf("field subject of message did.v1.EventCredentialIssued is not mutable"))
-	case "did.v1.EventCredentialIssued.type":
-		panic(fmt.Errorf("field type of message did.v1.EventCredentialIssued is not mutable"))
-	case "did.v1.EventCredentialIssued.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventCredentialIssued is not mutable"))
+	case "did.v1.EventServiceRemoved.did":
+		panic(fmt.Errorf("field did of message did.v1.EventServiceRemoved is not mutable"))
+	case "did.v1.EventServiceRemoved.service_id":
+		panic(fmt.Errorf("field service_id of message did.v1.EventServiceRemoved is not mutable"))
+	case "did.v1.EventServiceRemoved.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventServiceRemoved is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventCredentialIssued) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventServiceRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialIssued.credential_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialIssued.issuer":
-		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialIssued.subject":
+	case "did.v1.EventServiceRemoved.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialIssued.type":
+	case "did.v1.EventServiceRemoved.service_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialIssued.issued_at":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "did.v1.EventCredentialIssued.block_height":
+	case "did.v1.EventServiceRemoved.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventServiceRemoved"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventServiceRemoved does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventCredentialIssued) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventServiceRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventCredentialIssued", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventServiceRemoved", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4929,7 +4941,7 @@ func (x *fastReflection_EventCredentialIssued) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventCredentialIssued) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventServiceRemoved) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4940,7 +4952,7 @@ func (x *fastReflection_EventCredentialIssued) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialIssued) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventServiceRemoved) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4952,7 +4964,7 @@ func (x *fastReflection_EventCredentialIssued) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventCredentialIssued) IsValid() bool {
+func (x *fastReflection_EventServiceRemoved) IsValid() bool {
 	return x != nil
 }
 
@@ -4962,9 +4974,9 @@ func (x *fastReflection_EventCredentialIssued) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventServiceRemoved) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventCredentialIssued)
+		x := input.Message.Interface().(*EventServiceRemoved)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4976,26 +4988,14 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.CredentialId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Issuer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Subject)
+		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Type_)
+		l = len(x.ServiceId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IssuedAt != nil {
-			l = options.Size(x.IssuedAt)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
@@ -5009,7 +5009,7 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventCredentialIssued)
+		x := input.Message.Interface().(*EventServiceRemoved)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5031,47 +5031,19 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x30
-		}
-		if x.IssuedAt != nil {
-			encoded, err := options.Marshal(x.IssuedAt)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Type_) > 0 {
-			i -= len(x.Type_)
-			copy(dAtA[i:], x.Type_)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Subject) > 0 {
-			i -= len(x.Subject)
-			copy(dAtA[i:], x.Subject)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Subject)))
-			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.Issuer) > 0 {
-			i -= len(x.Issuer)
-			copy(dAtA[i:], x.Issuer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.CredentialId) > 0 {
-			i -= len(x.CredentialId)
-			copy(dAtA[i:], x.CredentialId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5086,7 +5058,7 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventCredentialIssued)
+		x := input.Message.Interface().(*EventServiceRemoved)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5118,15 +5090,15 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialIssued: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceRemoved: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialIssued: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5154,11 +5126,11 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5186,45 +5158,13 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Issuer = string(dAtA[iNdEx:postIndex])
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Subject = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var stringLen uint64
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5234,93 +5174,25 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Type_ = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.IssuedAt == nil {
-					x.IssuedAt = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IssuedAt); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5343,33 +5215,35 @@ func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventCredentialRevoked               protoreflect.MessageDescriptor
-	fd_EventCredentialRevoked_credential_id protoreflect.FieldDescriptor
-	fd_EventCredentialRevoked_revoker       protoreflect.FieldDescriptor
-	fd_EventCredentialRevoked_reason        protoreflect.FieldDescriptor
-	fd_EventCredentialRevoked_revoked_at    protoreflect.FieldDescriptor
-	fd_EventCredentialRevoked_block_height  protoreflect.FieldDescriptor
+	md_EventCredentialIssued               protoreflect.MessageDescriptor
+	fd_EventCredentialIssued_credential_id protoreflect.FieldDescriptor
+	fd_EventCredentialIssued_issuer        protoreflect.FieldDescriptor
+	fd_EventCredentialIssued_subject       protoreflect.FieldDescriptor
+	fd_EventCredentialIssued_type          protoreflect.FieldDescriptor
+	fd_EventCredentialIssued_issued_at     protoreflect.FieldDescriptor
+	fd_EventCredentialIssued_block_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventCredentialRevoked = File_did_v1_events_proto.Messages().ByName("EventCredentialRevoked")
-	fd_EventCredentialRevoked_credential_id = md_EventCredentialRevoked.Fields().ByName("credential_id")
-	fd_EventCredentialRevoked_revoker = md_EventCredentialRevoked.Fields().ByName("revoker")
-	fd_EventCredentialRevoked_reason = md_EventCredentialRevoked.Fields().ByName("reason")
-	fd_EventCredentialRevoked_revoked_at = md_EventCredentialRevoked.Fields().ByName("revoked_at")
-	fd_EventCredentialRevoked_block_height = md_EventCredentialRevoked.Fields().ByName("block_height")
+	md_EventCredentialIssued = File_did_v1_events_proto.Messages().ByName("EventCredentialIssued")
+	fd_EventCredentialIssued_credential_id = md_EventCredentialIssued.Fields().ByName("credential_id")
+	fd_EventCredentialIssued_issuer = md_EventCredentialIssued.Fields().ByName("issuer")
+	fd_EventCredentialIssued_subject = md_EventCredentialIssued.Fields().ByName("subject")
+	fd_EventCredentialIssued_type = md_EventCredentialIssued.Fields().ByName("type")
+	fd_EventCredentialIssued_issued_at = md_EventCredentialIssued.Fields().ByName("issued_at")
+	fd_EventCredentialIssued_block_height = md_EventCredentialIssued.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventCredentialRevoked)(nil)
+var _ protoreflect.Message = (*fastReflection_EventCredentialIssued)(nil)
 
-type fastReflection_EventCredentialRevoked EventCredentialRevoked
+type fastReflection_EventCredentialIssued EventCredentialIssued
 
-func (x *EventCredentialRevoked) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventCredentialRevoked)(x)
+func (x *EventCredentialIssued) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCredentialIssued)(x)
 }
 
-func (x *EventCredentialRevoked) slowProtoReflect() protoreflect.Message {
+func (x *EventCredentialIssued) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5381,43 +5255,43 @@ func (x *EventCredentialRevoked) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventCredentialRevoked_messageType fastReflection_EventCredentialRevoked_messageType
-var _ protoreflect.MessageType = fastReflection_EventCredentialRevoked_messageType{}
+var _fastReflection_EventCredentialIssued_messageType fastReflection_EventCredentialIssued_messageType
+var _ protoreflect.MessageType = fastReflection_EventCredentialIssued_messageType{}
 
-type fastReflection_EventCredentialRevoked_messageType struct{}
+type fastReflection_EventCredentialIssued_messageType struct{}
 
-func (x fastReflection_EventCredentialRevoked_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventCredentialRevoked)(nil)
+func (x fastReflection_EventCredentialIssued_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCredentialIssued)(nil)
 }
-func (x fastReflection_EventCredentialRevoked_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventCredentialRevoked)
+func (x fastReflection_EventCredentialIssued_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialIssued)
 }
-func (x fastReflection_EventCredentialRevoked_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCredentialRevoked
+func (x fastReflection_EventCredentialIssued_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialIssued
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventCredentialRevoked) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCredentialRevoked
+func (x *fastReflection_EventCredentialIssued) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialIssued
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventCredentialRevoked) Type() protoreflect.MessageType {
-	return _fastReflection_EventCredentialRevoked_messageType
+func (x *fastReflection_EventCredentialIssued) Type() protoreflect.MessageType {
+	return _fastReflection_EventCredentialIssued_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventCredentialRevoked) New() protoreflect.Message {
-	return new(fastReflection_EventCredentialRevoked)
+func (x *fastReflection_EventCredentialIssued) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialIssued)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventCredentialRevoked) Interface() protoreflect.ProtoMessage {
-	return (*EventCredentialRevoked)(x)
+func (x *fastReflection_EventCredentialIssued) Interface() protoreflect.ProtoMessage {
+	return (*EventCredentialIssued)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5425,34 +5299,40 @@ func (x *fastReflection_EventCredentialRevoked) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventCredentialRevoked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventCredentialIssued) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.CredentialId != "" {
 		value := protoreflect.ValueOfString(x.CredentialId)
-		if !f(fd_EventCredentialRevoked_credential_id, value) {
+		if !f(fd_EventCredentialIssued_credential_id, value) {
 			return
 		}
 	}
-	if x.Revoker != "" {
-		value := protoreflect.ValueOfString(x.Revoker)
-		if !f(fd_EventCredentialRevoked_revoker, value) {
+	if x.Issuer != "" {
+		value := protoreflect.ValueOfString(x.Issuer)
+		if !f(fd_EventCredentialIssued_issuer, value) {
 			return
 		}
 	}
-	if x.Reason != "" {
-		value := protoreflect.ValueOfString(x.Reason)
-		if !f(fd_EventCredentialRevoked_reason, value) {
+	if x.Subject != "" {
+		value := protoreflect.ValueOfString(x.Subject)
+		if !f(fd_EventCredentialIssued_subject, value) {
 			return
 		}
 	}
-	if x.RevokedAt != nil {
-		value := protoreflect.ValueOfMessage(x.RevokedAt.ProtoReflect())
-		if !f(fd_EventCredentialRevoked_revoked_at, value) {
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_EventCredentialIssued_type, value) {
+			return
+		}
+	}
+	if x.IssuedAt != nil {
+		value := protoreflect.ValueOfMessage(x.IssuedAt.ProtoReflect())
+		if !f(fd_EventCredentialIssued_issued_at, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventCredentialRevoked_block_height, value) {
+		if !f(fd_EventCredentialIssued_block_height, value) {
 			return
 		}
 	}
@@ -5469,23 +5349,25 @@ func (x *fastReflection_EventCredentialRevoked) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventCredentialRevoked) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventCredentialIssued) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialRevoked.credential_id":
+	case "did.v1.EventCredentialIssued.credential_id":
 		return x.CredentialId != ""
-	case "did.v1.EventCredentialRevoked.revoker":
-		return x.Revoker != ""
-	case "did.v1.EventCredentialRevoked.reason":
-		return x.Reason != ""
-	case "did.v1.EventCredentialRevoked.revoked_at":
-		return x.RevokedAt != nil
-	case "did.v1.EventCredentialRevoked.block_height":
+	case "did.v1.EventCredentialIssued.issuer":
+		return x.Issuer != ""
+	case "did.v1.EventCredentialIssued.subject":
+		return x.Subject != ""
+	case "did.v1.EventCredentialIssued.type":
+		return x.Type_ != ""
+	case "did.v1.EventCredentialIssued.issued_at":
+		return x.IssuedAt != nil
+	case "did.v1.EventCredentialIssued.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5495,23 +5377,25 @@ func (x *fastReflection_EventCredentialRevoked) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialRevoked) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventCredentialIssued) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialRevoked.credential_id":
+	case "did.v1.EventCredentialIssued.credential_id":
 		x.CredentialId = ""
-	case "did.v1.EventCredentialRevoked.revoker":
-		x.Revoker = ""
-	case "did.v1.EventCredentialRevoked.reason":
-		x.Reason = ""
-	case "did.v1.EventCredentialRevoked.revoked_at":
-		x.RevokedAt = nil
-	case "did.v1.EventCredentialRevoked.block_height":
+	case "did.v1.EventCredentialIssued.issuer":
+		x.Issuer = ""
+	case "did.v1.EventCredentialIssued.subject":
+		x.Subject = ""
+	case "did.v1.EventCredentialIssued.type":
+		x.Type_ = ""
+	case "did.v1.EventCredentialIssued.issued_at":
+		x.IssuedAt = nil
+	case "did.v1.EventCredentialIssued.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5521,28 +5405,31 @@ func (x *fastReflection_EventCredentialRevoked) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventCredentialRevoked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCredentialIssued) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventCredentialRevoked.credential_id":
+	case "did.v1.EventCredentialIssued.credential_id":
 		value := x.CredentialId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialRevoked.revoker":
-		value := x.Revoker
+	case "did.v1.EventCredentialIssued.issuer":
+		value := x.Issuer
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialRevoked.reason":
-		value := x.Reason
+	case "did.v1.EventCredentialIssued.subject":
+		value := x.Subject
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventCredentialRevoked.revoked_at":
-		value := x.RevokedAt
+	case "did.v1.EventCredentialIssued.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialIssued.issued_at":
+		value := x.IssuedAt
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.EventCredentialRevoked.block_height":
+	case "did.v1.EventCredentialIssued.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5556,23 +5443,25 @@ func (x *fastReflection_EventCredentialRevoked) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialRevoked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventCredentialIssued) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialRevoked.credential_id":
+	case "did.v1.EventCredentialIssued.credential_id":
 		x.CredentialId = value.Interface().(string)
-	case "did.v1.EventCredentialRevoked.revoker":
-		x.Revoker = value.Interface().(string)
-	case "did.v1.EventCredentialRevoked.reason":
-		x.Reason = value.Interface().(string)
-	case "did.v1.EventCredentialRevoked.revoked_at":
-		x.RevokedAt = value.Message().Interface().(*timestamppb.Timestamp)
-	case "did.v1.EventCredentialRevoked.block_height":
+	case "did.v1.EventCredentialIssued.issuer":
+		x.Issuer = value.Interface().(string)
+	case "did.v1.EventCredentialIssued.subject":
+		x.Subject = value.Interface().(string)
+	case "did.v1.EventCredentialIssued.type":
+		x.Type_ = value.Interface().(string)
+	case "did.v1.EventCredentialIssued.issued_at":
+		x.IssuedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "did.v1.EventCredentialIssued.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5586,60 +5475,64 @@ func (x *fastReflection_EventCredentialRevoked) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialRevoked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCredentialIssued) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialRevoked.revoked_at":
-		if x.RevokedAt == nil {
-			x.RevokedAt = new(timestamppb.Timestamp)
+	case "did.v1.EventCredentialIssued.issued_at":
+		if x.IssuedAt == nil {
+			x.IssuedAt = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.RevokedAt.ProtoReflect())
-	case "did.v1.EventCredentialRevoked.credential_id":
-		panic(fmt.Errorf("field credential_id of message did.v1.EventCredentialRevoked is not mutable"))
-	case "did.v1.EventCredentialRevoked.revoker":
-		panic(fmt.Errorf("field revoker of message did.v1.EventCredentialRevoked is not mutable"))
-	case "did.v1.EventCredentialRevoked.reason":
-		panic(fmt.Errorf("field reason of message did.v1.EventCredentialRevoked is not mutable"))
-	case "did.v1.EventCredentialRevoked.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventCredentialRevoked is not mutable"))
+		return protoreflect.ValueOfMessage(x.IssuedAt.ProtoReflect())
+	case "did.v1.EventCredentialIssued.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.EventCredentialIssued is not mutable"))
+	case "did.v1.EventCredentialIssued.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.EventCredentialIssued is not mutable"))
+	case "did.v1.EventCredentialIssued.subject":
+		panic(fmt.Errorf("field subject of message did.v1.EventCredentialIssued is not mutable"))
+	case "did.v1.EventCredentialIssued.type":
+		panic(fmt.Errorf("field type of message did.v1.EventCredentialIssued is not mutable"))
+	case "did.v1.EventCredentialIssued.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventCredentialIssued is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventCredentialRevoked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCredentialIssued) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventCredentialRevoked.credential_id":
+	case "did.v1.EventCredentialIssued.credential_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialRevoked.revoker":
+	case "did.v1.EventCredentialIssued.issuer":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialRevoked.reason":
+	case "did.v1.EventCredentialIssued.subject":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventCredentialRevoked.revoked_at":
+	case "did.v1.EventCredentialIssued.type":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialIssued.issued_at":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "did.v1.EventCredentialRevoked.block_height":
+	case "did.v1.EventCredentialIssued.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialIssued"))
 		}
-		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialIssued does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventCredentialRevoked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventCredentialIssued) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventCredentialRevoked", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventCredentialIssued", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5647,7 +5540,7 @@ func (x *fastReflection_EventCredentialRevoked) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventCredentialRevoked) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventCredentialIssued) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5658,7 +5551,7 @@ func (x *fastReflection_EventCredentialRevoked) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCredentialRevoked) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventCredentialIssued) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5670,7 +5563,7 @@ func (x *fastReflection_EventCredentialRevoked) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventCredentialRevoked) IsValid() bool {
+func (x *fastReflection_EventCredentialIssued) IsValid() bool {
 	return x != nil
 }
 
@@ -5680,9 +5573,9 @@ func (x *fastReflection_EventCredentialRevoked) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventCredentialIssued) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventCredentialRevoked)
+		x := input.Message.Interface().(*EventCredentialIssued)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5698,16 +5591,20 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Revoker)
+		l = len(x.Issuer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Reason)
+		l = len(x.Subject)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RevokedAt != nil {
-			l = options.Size(x.RevokedAt)
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IssuedAt != nil {
+			l = options.Size(x.IssuedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.BlockHeight != 0 {
@@ -5723,7 +5620,7 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventCredentialRevoked)
+		x := input.Message.Interface().(*EventCredentialIssued)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5745,10 +5642,10 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x30
 		}
-		if x.RevokedAt != nil {
-			encoded, err := options.Marshal(x.RevokedAt)
+		if x.IssuedAt != nil {
+			encoded, err := options.Marshal(x.IssuedAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5759,19 +5656,26 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.Reason) > 0 {
-			i -= len(x.Reason)
-			copy(dAtA[i:], x.Reason)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+		if len(x.Subject) > 0 {
+			i -= len(x.Subject)
+			copy(dAtA[i:], x.Subject)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Subject)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Revoker) > 0 {
-			i -= len(x.Revoker)
-			copy(dAtA[i:], x.Revoker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Revoker)))
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -5793,7 +5697,7 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventCredentialRevoked)
+		x := input.Message.Interface().(*EventCredentialIssued)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5825,10 +5729,10 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialRevoked: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialIssued: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialIssued: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5865,7 +5769,7 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5893,11 +5797,11 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Revoker = string(dAtA[iNdEx:postIndex])
+				x.Issuer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5925,11 +5829,43 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reason = string(dAtA[iNdEx:postIndex])
+				x.Subject = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5956,14 +5892,14 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.RevokedAt == nil {
-					x.RevokedAt = &timestamppb.Timestamp{}
+				if x.IssuedAt == nil {
+					x.IssuedAt = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevokedAt); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IssuedAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 6:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -6018,31 +5954,33 @@ func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_EventWebAuthnRegistered                  protoreflect.MessageDescriptor
-	fd_EventWebAuthnRegistered_did              protoreflect.FieldDescriptor
-	fd_EventWebAuthnRegistered_credential_id    protoreflect.FieldDescriptor
-	fd_EventWebAuthnRegistered_attestation_type protoreflect.FieldDescriptor
-	fd_EventWebAuthnRegistered_block_height     protoreflect.FieldDescriptor
+	md_EventCredentialRevoked               protoreflect.MessageDescriptor
+	fd_EventCredentialRevoked_credential_id protoreflect.FieldDescriptor
+	fd_EventCredentialRevoked_revoker       protoreflect.FieldDescriptor
+	fd_EventCredentialRevoked_reason        protoreflect.FieldDescriptor
+	fd_EventCredentialRevoked_revoked_at    protoreflect.FieldDescriptor
+	fd_EventCredentialRevoked_block_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventWebAuthnRegistered = File_did_v1_events_proto.Messages().ByName("EventWebAuthnRegistered")
-	fd_EventWebAuthnRegistered_did = md_EventWebAuthnRegistered.Fields().ByName("did")
-	fd_EventWebAuthnRegistered_credential_id = md_EventWebAuthnRegistered.Fields().ByName("credential_id")
-	fd_EventWebAuthnRegistered_attestation_type = md_EventWebAuthnRegistered.Fields().ByName("attestation_type")
-	fd_EventWebAuthnRegistered_block_height = md_EventWebAuthnRegistered.Fields().ByName("block_height")
+	md_EventCredentialRevoked = File_did_v1_events_proto.Messages().ByName("EventCredentialRevoked")
+	fd_EventCredentialRevoked_credential_id = md_EventCredentialRevoked.Fields().ByName("credential_id")
+	fd_EventCredentialRevoked_revoker = md_EventCredentialRevoked.Fields().ByName("revoker")
+	fd_EventCredentialRevoked_reason = md_EventCredentialRevoked.Fields().ByName("reason")
+	fd_EventCredentialRevoked_revoked_at = md_EventCredentialRevoked.Fields().ByName("revoked_at")
+	fd_EventCredentialRevoked_block_height = md_EventCredentialRevoked.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventWebAuthnRegistered)(nil)
+var _ protoreflect.Message = (*fastReflection_EventCredentialRevoked)(nil)
 
-type fastReflection_EventWebAuthnRegistered EventWebAuthnRegistered
+type fastReflection_EventCredentialRevoked EventCredentialRevoked
 
-func (x *EventWebAuthnRegistered) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventWebAuthnRegistered)(x)
+func (x *EventCredentialRevoked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCredentialRevoked)(x)
 }
 
-func (x *EventWebAuthnRegistered) slowProtoReflect() protoreflect.Message {
+func (x *EventCredentialRevoked) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6054,43 +5992,43 @@ func (x *EventWebAuthnRegistered) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventWebAuthnRegistered_messageType fastReflection_EventWebAuthnRegistered_messageType
-var _ protoreflect.MessageType = fastReflection_EventWebAuthnRegistered_messageType{}
+var _fastReflection_EventCredentialRevoked_messageType fastReflection_EventCredentialRevoked_messageType
+var _ protoreflect.MessageType = fastReflection_EventCredentialRevoked_messageType{}
 
-type fastReflection_EventWebAuthnRegistered_messageType struct{}
+type fastReflection_EventCredentialRevoked_messageType struct{}
 
-func (x fastReflection_EventWebAuthnRegistered_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventWebAuthnRegistered)(nil)
+func (x fastReflection_EventCredentialRevoked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCredentialRevoked)(nil)
 }
-func (x fastReflection_EventWebAuthnRegistered_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventWebAuthnRegistered)
+func (x fastReflection_EventCredentialRevoked_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialRevoked)
 }
-func (x fastReflection_EventWebAuthnRegistered_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventWebAuthnRegistered
+func (x fastReflection_EventCredentialRevoked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialRevoked
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventWebAuthnRegistered) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventWebAuthnRegistered
+func (x *fastReflection_EventCredentialRevoked) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialRevoked
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventWebAuthnRegistered) Type() protoreflect.MessageType {
-	return _fastReflection_EventWebAuthnRegistered_messageType
+func (x *fastReflection_EventCredentialRevoked) Type() protoreflect.MessageType {
+	return _fastReflection_EventCredentialRevoked_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventWebAuthnRegistered) New() protoreflect.Message {
-	return new(fastReflection_EventWebAuthnRegistered)
+func (x *fastReflection_EventCredentialRevoked) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialRevoked)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventWebAuthnRegistered) Interface() protoreflect.ProtoMessage {
-	return (*EventWebAuthnRegistered)(x)
+func (x *fastReflection_EventCredentialRevoked) Interface() protoreflect.ProtoMessage {
+	return (*EventCredentialRevoked)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6098,28 +6036,34 @@ func (x *fastReflection_EventWebAuthnRegistered) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventWebAuthnRegistered) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Did != "" {
-		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_EventWebAuthnRegistered_did, value) {
+func (x *fastReflection_EventCredentialRevoked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_EventCredentialRevoked_credential_id, value) {
 			return
 		}
 	}
-	if x.CredentialId != "" {
-		value := protoreflect.ValueOfString(x.CredentialId)
-		if !f(fd_EventWebAuthnRegistered_credential_id, value) {
+	if x.Revoker != "" {
+		value := protoreflect.ValueOfString(x.Revoker)
+		if !f(fd_EventCredentialRevoked_revoker, value) {
 			return
 		}
 	}
-	if x.AttestationType != "" {
-		value := protoreflect.ValueOfString(x.AttestationType)
-		if !f(fd_EventWebAuthnRegistered_attestation_type, value) {
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventCredentialRevoked_reason, value) {
+			return
+		}
+	}
+	if x.RevokedAt != nil {
+		value := protoreflect.ValueOfMessage(x.RevokedAt.ProtoReflect())
+		if !f(fd_EventCredentialRevoked_revoked_at, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventWebAuthnRegistered_block_height, value) {
+		if !f(fd_EventCredentialRevoked_block_height, value) {
 			return
 		}
 	}
@@ -6136,21 +6080,23 @@ func (x *fastReflection_EventWebAuthnRegistered) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventWebAuthnRegistered) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventCredentialRevoked) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventWebAuthnRegistered.did":
-		return x.Did != ""
-	case "did.v1.EventWebAuthnRegistered.credential_id":
+	case "did.v1.EventCredentialRevoked.credential_id":
 		return x.CredentialId != ""
-	case "did.v1.EventWebAuthnRegistered.attestation_type":
-		return x.AttestationType != ""
-	case "did.v1.EventWebAuthnRegistered.block_height":
+	case "did.v1.EventCredentialRevoked.revoker":
+		return x.Revoker != ""
+	case "did.v1.EventCredentialRevoked.reason":
+		return x.Reason != ""
+	case "did.v1.EventCredentialRevoked.revoked_at":
+		return x.RevokedAt != nil
+	case "did.v1.EventCredentialRevoked.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
 		}
-		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6160,21 +6106,23 @@ func (x *fastReflection_EventWebAuthnRegistered) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventWebAuthnRegistered) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventCredentialRevoked) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventWebAuthnRegistered.did":
-		x.Did = ""
-	case "did.v1.EventWebAuthnRegistered.credential_id":
+	case "did.v1.EventCredentialRevoked.credential_id":
 		x.CredentialId = ""
-	case "did.v1.EventWebAuthnRegistered.attestation_type":
-		x.AttestationType = ""
-	case "did.v1.EventWebAuthnRegistered.block_height":
+	case "did.v1.EventCredentialRevoked.revoker":
+		x.Revoker = ""
+	case "did.v1.EventCredentialRevoked.reason":
+		x.Reason = ""
+	case "did.v1.EventCredentialRevoked.revoked_at":
+		x.RevokedAt = nil
+	case "did.v1.EventCredentialRevoked.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
 		}
-		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6184,25 +6132,28 @@ func (x *fastReflection_EventWebAuthnRegistered) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventWebAuthnRegistered) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCredentialRevoked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventWebAuthnRegistered.did":
-		value := x.Did
-		return protoreflect.ValueOfString(value)
-	case "did.v1.EventWebAuthnRegistered.credential_id":
+	case "did.v1.EventCredentialRevoked.credential_id":
 		value := x.CredentialId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventWebAuthnRegistered.attestation_type":
-		value := x.AttestationType
+	case "did.v1.EventCredentialRevoked.revoker":
+		value := x.Revoker
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventWebAuthnRegistered.block_height":
+	case "did.v1.EventCredentialRevoked.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialRevoked.revoked_at":
+		value := x.RevokedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.EventCredentialRevoked.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
 		}
-		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6216,21 +6167,23 @@ func (x *fastReflection_EventWebAuthnRegistered) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventWebAuthnRegistered) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventCredentialRevoked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventWebAuthnRegistered.did":
-		x.Did = value.Interface().(string)
-	case "did.v1.EventWebAuthnRegistered.credential_id":
+	case "did.v1.EventCredentialRevoked.credential_id":
 		x.CredentialId = value.Interface().(string)
-	case "did.v1.EventWebAuthnRegistered.attestation_type":
-		x.AttestationType = value.Interface().(string)
-	case "did.v1.EventWebAuthnRegistered.block_height":
+	case "did.v1.EventCredentialRevoked.revoker":
+		x.Revoker = value.Interface().(string)
+	case "did.v1.EventCredentialRevoked.reason":
+		x.Reason = value.Interface().(string)
+	case "did.v1.EventCredentialRevoked.revoked_at":
+		x.RevokedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "did.v1.EventCredentialRevoked.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
 		}
-		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6244,52 +6197,60 @@ func (x *fastReflection_EventWebAuthnRegistered) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventWebAuthnRegistered) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCredentialRevoked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventWebAuthnRegistered.did":
-		panic(fmt.Errorf("field did of message did.v1.EventWebAuthnRegistered is not mutable"))
-	case "did.v1.EventWebAuthnRegistered.credential_id":
-		panic(fmt.Errorf("field credential_id of message did.v1.EventWebAuthnRegistered is not mutable"))
-	case "did.v1.EventWebAuthnRegistered.attestation_type":
-		panic(fmt.Errorf("field attestation_type of message did.v1.EventWebAuthnRegistered is not mutable"))
-	case "did.v1.EventWebAuthnRegistered.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventWebAuthnRegistered is not mutable"))
+	case "did.v1.EventCredentialRevoked.revoked_at":
+		if x.RevokedAt == nil {
+			x.RevokedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RevokedAt.ProtoReflect())
+	case "did.v1.EventCredentialRevoked.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.EventCredentialRevoked is not mutable"))
+	case "did.v1.EventCredentialRevoked.revoker":
+		panic(fmt.Errorf("field revoker of message did.v1.EventCredentialRevoked is not mutable"))
+	case "did.v1.EventCredentialRevoked.reason":
+		panic(fmt.Errorf("field reason of message did.v1.EventCredentialRevoked is not mutable"))
+	case "did.v1.EventCredentialRevoked.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventCredentialRevoked is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
 		}
-		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventWebAuthnRegistered) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCredentialRevoked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventWebAuthnRegistered.did":
+	case "did.v1.EventCredentialRevoked.credential_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventWebAuthnRegistered.credential_id":
+	case "did.v1.EventCredentialRevoked.revoker":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventWebAuthnRegistered.attestation_type":
+	case "did.v1.EventCredentialRevoked.reason":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventWebAuthnRegistered.block_height":
+	case "did.v1.EventCredentialRevoked.revoked_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.EventCredentialRevoked.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialRevoked"))
 		}
-		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventCredentialRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventWebAuthnRegistered) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventCredentialRevoked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventWebAuthnRegistered", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventCredentialRevoked", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6297,7 +6258,7 @@ func (x *fastReflection_EventWebAuthnRegistered) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventWebAuthnRegistered) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventCredentialRevoked) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6308,7 +6269,7 @@ func (x *fastReflection_EventWebAuthnRegistered) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventWebAuthnRegistered) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventCredentialRevoked) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6320,7 +6281,7 @@ func (x *fastReflection_EventWebAuthnRegistered) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventWebAuthnRegistered) IsValid() bool {
+func (x *fastReflection_EventCredentialRevoked) IsValid() bool {
 	return x != nil
 }
 
@@ -6330,9 +6291,9 @@ func (x *fastReflection_EventWebAuthnRegistered) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventCredentialRevoked) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventWebAuthnRegistered)
+		x := input.Message.Interface().(*EventCredentialRevoked)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6344,18 +6305,22 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Did)
+		l = len(x.CredentialId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CredentialId)
+		l = len(x.Revoker)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AttestationType)
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RevokedAt != nil {
+			l = options.Size(x.RevokedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
@@ -6369,7 +6334,7 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventWebAuthnRegistered)
+		x := input.Message.Interface().(*EventCredentialRevoked)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6391,27 +6356,41 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x28
 		}
-		if len(x.AttestationType) > 0 {
-			i -= len(x.AttestationType)
-			copy(dAtA[i:], x.AttestationType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AttestationType)))
+		if x.RevokedAt != nil {
+			encoded, err := options.Marshal(x.RevokedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
 			dAtA[i] = 0x1a
 		}
+		if len(x.Revoker) > 0 {
+			i -= len(x.Revoker)
+			copy(dAtA[i:], x.Revoker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Revoker)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.CredentialId) > 0 {
 			i -= len(x.CredentialId)
 			copy(dAtA[i:], x.CredentialId)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Did) > 0 {
-			i -= len(x.Did)
-			copy(dAtA[i:], x.Did)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -6425,7 +6404,7 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventWebAuthnRegistered)
+		x := input.Message.Interface().(*EventCredentialRevoked)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6457,15 +6436,15 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventWebAuthnRegistered: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialRevoked: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventWebAuthnRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6493,11 +6472,11 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Did = string(dAtA[iNdEx:postIndex])
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoker", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6525,11 +6504,11 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				x.Revoker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6557,9 +6536,45 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AttestationType = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevokedAt == nil {
+					x.RevokedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevokedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -6614,31 +6629,31 @@ func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_EventExternalWalletLinked                protoreflect.MessageDescriptor
-	fd_EventExternalWalletLinked_did            protoreflect.FieldDescriptor
-	fd_EventExternalWalletLinked_wallet_type    protoreflect.FieldDescriptor
-	fd_EventExternalWalletLinked_wallet_address protoreflect.FieldDescriptor
-	fd_EventExternalWalletLinked_block_height   protoreflect.FieldDescriptor
+	md_EventWebAuthnRegistered                  protoreflect.MessageDescriptor
+	fd_EventWebAuthnRegistered_did              protoreflect.FieldDescriptor
+	fd_EventWebAuthnRegistered_credential_id    protoreflect.FieldDescriptor
+	fd_EventWebAuthnRegistered_attestation_type protoreflect.FieldDescriptor
+	fd_EventWebAuthnRegistered_block_height     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_events_proto_init()
-	md_EventExternalWalletLinked = File_did_v1_events_proto.Messages().ByName("EventExternalWalletLinked")
-	fd_EventExternalWalletLinked_did = md_EventExternalWalletLinked.Fields().ByName("did")
-	fd_EventExternalWalletLinked_wallet_type = md_EventExternalWalletLinked.Fields().ByName("wallet_type")
-	fd_EventExternalWalletLinked_wallet_address = md_EventExternalWalletLinked.Fields().ByName("wallet_address")
-	fd_EventExternalWalletLinked_block_height = md_EventExternalWalletLinked.Fields().ByName("block_height")
-}
-
-var _ protoreflect.Message = (*fastReflection_EventExternalWalletLinked)(nil)
-
-type fastReflection_EventExternalWalletLinked EventExternalWalletLinked
+	md_EventWebAuthnRegistered = File_did_v1_events_proto.Messages().ByName("EventWebAuthnRegistered")
+	fd_EventWebAuthnRegistered_did = md_EventWebAuthnRegistered.Fields().ByName("did")
+	fd_EventWebAuthnRegistered_credential_id = md_EventWebAuthnRegistered.Fields().ByName("credential_id")
+	fd_EventWebAuthnRegistered_attestation_type = md_EventWebAuthnRegistered.Fields().ByName("attestation_type")
+	fd_EventWebAuthnRegistered_block_height = md_EventWebAuthnRegistered.Fields().ByName("block_height")
+}
 
-func (x *EventExternalWalletLinked) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventExternalWalletLinked)(x)
+var _ protoreflect.Message = (*fastReflection_EventWebAuthnRegistered)(nil)
+
+type fastReflection_EventWebAuthnRegistered EventWebAuthnRegistered
+
+func (x *EventWebAuthnRegistered) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventWebAuthnRegistered)(x)
 }
 
-func (x *EventExternalWalletLinked) slowProtoReflect() protoreflect.Message {
+func (x *EventWebAuthnRegistered) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6650,43 +6665,43 @@ func (x *EventExternalWalletLinked) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventExternalWalletLinked_messageType fastReflection_EventExternalWalletLinked_messageType
-var _ protoreflect.MessageType = fastReflection_EventExternalWalletLinked_messageType{}
+var _fastReflection_EventWebAuthnRegistered_messageType fastReflection_EventWebAuthnRegistered_messageType
+var _ protoreflect.MessageType = fastReflection_EventWebAuthnRegistered_messageType{}
 
-type fastReflection_EventExternalWalletLinked_messageType struct{}
+type fastReflection_EventWebAuthnRegistered_messageType struct{}
 
-func (x fastReflection_EventExternalWalletLinked_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventExternalWalletLinked)(nil)
+func (x fastReflection_EventWebAuthnRegistered_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventWebAuthnRegistered)(nil)
 }
-func (x fastReflection_EventExternalWalletLinked_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventExternalWalletLinked)
+func (x fastReflection_EventWebAuthnRegistered_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventWebAuthnRegistered)
 }
-func (x fastReflection_EventExternalWalletLinked_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventExternalWalletLinked
+func (x fastReflection_EventWebAuthnRegistered_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventWebAuthnRegistered
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventExternalWalletLinked) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventExternalWalletLinked
+func (x *fastReflection_EventWebAuthnRegistered) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventWebAuthnRegistered
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventExternalWalletLinked) Type() protoreflect.MessageType {
-	return _fastReflection_EventExternalWalletLinked_messageType
+func (x *fastReflection_EventWebAuthnRegistered) Type() protoreflect.MessageType {
+	return _fastReflection_EventWebAuthnRegistered_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventExternalWalletLinked) New() protoreflect.Message {
-	return new(fastReflection_EventExternalWalletLinked)
+func (x *fastReflection_EventWebAuthnRegistered) New() protoreflect.Message {
+	return new(fastReflection_EventWebAuthnRegistered)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventExternalWalletLinked) Interface() protoreflect.ProtoMessage {
-	return (*EventExternalWalletLinked)(x)
+func (x *fastReflection_EventWebAuthnRegistered) Interface() protoreflect.ProtoMessage {
+	return (*EventWebAuthnRegistered)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6694,28 +6709,28 @@ func (x *fastReflection_EventExternalWalletLinked) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventExternalWalletLinked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventWebAuthnRegistered) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_EventExternalWalletLinked_did, value) {
+		if !f(fd_EventWebAuthnRegistered_did, value) {
 			return
 		}
 	}
-	if x.WalletType != "" {
-		value := protoreflect.ValueOfString(x.WalletType)
-		if !f(fd_EventExternalWalletLinked_wallet_type, value) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_EventWebAuthnRegistered_credential_id, value) {
 			return
 		}
 	}
-	if x.WalletAddress != "" {
-		value := protoreflect.ValueOfString(x.WalletAddress)
-		if !f(fd_EventExternalWalletLinked_wallet_address, value) {
+	if x.AttestationType != "" {
+		value := protoreflect.ValueOfString(x.AttestationType)
+		if !f(fd_EventWebAuthnRegistered_attestation_type, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventExternalWalletLinked_block_height, value) {
+		if !f(fd_EventWebAuthnRegistered_block_height, value) {
 			return
 		}
 	}
@@ -6732,21 +6747,21 @@ func (x *fastReflection_EventExternalWalletLinked) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventExternalWalletLinked) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventWebAuthnRegistered) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.EventExternalWalletLinked.did":
+	case "did.v1.EventWebAuthnRegistered.did":
 		return x.Did != ""
-	case "did.v1.EventExternalWalletLinked.wallet_type":
-		return x.WalletType != ""
-	case "did.v1.EventExternalWalletLinked.wallet_address":
-		return x.WalletAddress != ""
-	case "did.v1.EventExternalWalletLinked.block_height":
+	case "did.v1.EventWebAuthnRegistered.credential_id":
+		return x.CredentialId != ""
+	case "did.v1.EventWebAuthnRegistered.attestation_type":
+		return x.AttestationType != ""
+	case "did.v1.EventWebAuthnRegistered.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
 		}
-		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6756,21 +6771,21 @@ func (x *fastReflection_EventExternalWalletLinked) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExternalWalletLinked) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventWebAuthnRegistered) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.EventExternalWalletLinked.did":
+	case "did.v1.EventWebAuthnRegistered.did":
 		x.Did = ""
-	case "did.v1.EventExternalWalletLinked.wallet_type":
-		x.WalletType = ""
-	case "did.v1.EventExternalWalletLinked.wallet_address":
-		x.WalletAddress = ""
-	case "did.v1.EventExternalWalletLinked.block_height":
+	case "did.v1.EventWebAuthnRegistered.credential_id":
+		x.CredentialId = ""
+	case "did.v1.EventWebAuthnRegistered.attestation_type":
+		x.AttestationType = ""
+	case "did.v1.EventWebAuthnRegistered.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
 		}
-		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6780,25 +6795,25 @@ func (x *fastReflection_EventExternalWalletLinked) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventExternalWalletLinked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventWebAuthnRegistered) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.EventExternalWalletLinked.did":
+	case "did.v1.EventWebAuthnRegistered.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventExternalWalletLinked.wallet_type":
-		value := x.WalletType
+	case "did.v1.EventWebAuthnRegistered.credential_id":
+		value := x.CredentialId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventExternalWalletLinked.wallet_address":
-		value := x.WalletAddress
+	case "did.v1.EventWebAuthnRegistered.attestation_type":
+		value := x.AttestationType
 		return protoreflect.ValueOfString(value)
-	case "did.v1.EventExternalWalletLinked.block_height":
+	case "did.v1.EventWebAuthnRegistered.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
 		}
-		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6812,21 +6827,21 @@ func (x *fastReflection_EventExternalWalletLinked) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExternalWalletLinked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventWebAuthnRegistered) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.EventExternalWalletLinked.did":
+	case "did.v1.EventWebAuthnRegistered.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.EventExternalWalletLinked.wallet_type":
-		x.WalletType = value.Interface().(string)
-	case "did.v1.EventExternalWalletLinked.wallet_address":
-		x.WalletAddress = value.Interface().(string)
-	case "did.v1.EventExternalWalletLinked.block_height":
+	case "did.v1.EventWebAuthnRegistered.credential_id":
+		x.CredentialId = value.Interface().(string)
+	case "did.v1.EventWebAuthnRegistered.attestation_type":
+		x.AttestationType = value.Interface().(string)
+	case "did.v1.EventWebAuthnRegistered.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
 		}
-		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6840,52 +6855,52 @@ func (x *fastReflection_EventExternalWalletLinked) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExternalWalletLinked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventWebAuthnRegistered) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventExternalWalletLinked.did":
-		panic(fmt.Errorf("field did of message did.v1.EventExternalWalletLinked is not mutable"))
-	case "did.v1.EventExternalWalletLinked.wallet_type":
-		panic(fmt.Errorf("field wallet_type of message did.v1.EventExternalWalletLinked is not mutable"))
-	case "did.v1.EventExternalWalletLinked.wallet_address":
-		panic(fmt.Errorf("field wallet_address of message did.v1.EventExternalWalletLinked is not mutable"))
-	case "did.v1.EventExternalWalletLinked.block_height":
-		panic(fmt.Errorf("field block_height of message did.v1.EventExternalWalletLinked is not mutable"))
+	case "did.v1.EventWebAuthnRegistered.did":
+		panic(fmt.Errorf("field did of message did.v1.EventWebAuthnRegistered is not mutable"))
+	case "did.v1.EventWebAuthnRegistered.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.EventWebAuthnRegistered is not mutable"))
+	case "did.v1.EventWebAuthnRegistered.attestation_type":
+		panic(fmt.Errorf("field attestation_type of message did.v1.EventWebAuthnRegistered is not mutable"))
+	case "did.v1.EventWebAuthnRegistered.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventWebAuthnRegistered is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
 		}
-		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventExternalWalletLinked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventWebAuthnRegistered) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.EventExternalWalletLinked.did":
+	case "did.v1.EventWebAuthnRegistered.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventExternalWalletLinked.wallet_type":
+	case "did.v1.EventWebAuthnRegistered.credential_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventExternalWalletLinked.wallet_address":
+	case "did.v1.EventWebAuthnRegistered.attestation_type":
 		return protoreflect.ValueOfString("")
-	case "did.v1.EventExternalWalletLinked.block_height":
+	case "did.v1.EventWebAuthnRegistered.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventWebAuthnRegistered"))
 		}
-		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.EventWebAuthnRegistered does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventExternalWalletLinked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventWebAuthnRegistered) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventExternalWalletLinked", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventWebAuthnRegistered", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6893,7 +6908,7 @@ func (x *fastReflection_EventExternalWalletLinked) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventExternalWalletLinked) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventWebAuthnRegistered) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6904,7 +6919,7 @@ func (x *fastReflection_EventExternalWalletLinked) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExternalWalletLinked) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventWebAuthnRegistered) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6916,7 +6931,7 @@ func (x *fastReflection_EventExternalWalletLinked) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventExternalWalletLinked) IsValid() bool {
+func (x *fastReflection_EventWebAuthnRegistered) IsValid() bool {
 	return x != nil
 }
 
@@ -6926,9 +6941,9 @@ func (x *fastReflection_EventExternalWalletLinked) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventWebAuthnRegistered) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventExternalWalletLinked)
+		x := input.Message.Interface().(*EventWebAuthnRegistered)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6944,11 +6959,11 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.WalletType)
+		l = len(x.CredentialId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.WalletAddress)
+		l = len(x.AttestationType)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6965,7 +6980,7 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventExternalWalletLinked)
+		x := input.Message.Interface().(*EventWebAuthnRegistered)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6989,17 +7004,17 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.WalletAddress) > 0 {
-			i -= len(x.WalletAddress)
-			copy(dAtA[i:], x.WalletAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+		if len(x.AttestationType) > 0 {
+			i -= len(x.AttestationType)
+			copy(dAtA[i:], x.AttestationType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AttestationType)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.WalletType) > 0 {
-			i -= len(x.WalletType)
-			copy(dAtA[i:], x.WalletType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletType)))
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -7021,7 +7036,7 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventExternalWalletLinked)
+		x := input.Message.Interface().(*EventWebAuthnRegistered)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7053,10 +7068,10 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExternalWalletLinked: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventWebAuthnRegistered: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExternalWalletLinked: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventWebAuthnRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7093,7 +7108,7 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7121,11 +7136,11 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WalletType = string(dAtA[iNdEx:postIndex])
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7153,7 +7168,7 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				x.AttestationType = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 0 {
@@ -7209,771 +7224,5963 @@ func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: did/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventExternalWalletLinked                protoreflect.MessageDescriptor
+	fd_EventExternalWalletLinked_did            protoreflect.FieldDescriptor
+	fd_EventExternalWalletLinked_wallet_type    protoreflect.FieldDescriptor
+	fd_EventExternalWalletLinked_wallet_address protoreflect.FieldDescriptor
+	fd_EventExternalWalletLinked_block_height   protoreflect.FieldDescriptor
 )
 
-// EventDIDCreated is emitted when a new DID is created
-type EventDIDCreated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// DID identifier
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Creator address
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Public keys added
-	PublicKeys []string `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventExternalWalletLinked = File_did_v1_events_proto.Messages().ByName("EventExternalWalletLinked")
+	fd_EventExternalWalletLinked_did = md_EventExternalWalletLinked.Fields().ByName("did")
+	fd_EventExternalWalletLinked_wallet_type = md_EventExternalWalletLinked.Fields().ByName("wallet_type")
+	fd_EventExternalWalletLinked_wallet_address = md_EventExternalWalletLinked.Fields().ByName("wallet_address")
+	fd_EventExternalWalletLinked_block_height = md_EventExternalWalletLinked.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventExternalWalletLinked)(nil)
+
+type fastReflection_EventExternalWalletLinked EventExternalWalletLinked
+
+func (x *EventExternalWalletLinked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventExternalWalletLinked)(x)
+}
+
+func (x *EventExternalWalletLinked) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventExternalWalletLinked_messageType fastReflection_EventExternalWalletLinked_messageType
+var _ protoreflect.MessageType = fastReflection_EventExternalWalletLinked_messageType{}
+
+type fastReflection_EventExternalWalletLinked_messageType struct{}
+
+func (x fastReflection_EventExternalWalletLinked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventExternalWalletLinked)(nil)
+}
+func (x fastReflection_EventExternalWalletLinked_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventExternalWalletLinked)
+}
+func (x fastReflection_EventExternalWalletLinked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExternalWalletLinked
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventExternalWalletLinked) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExternalWalletLinked
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventExternalWalletLinked) Type() protoreflect.MessageType {
+	return _fastReflection_EventExternalWalletLinked_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventExternalWalletLinked) New() protoreflect.Message {
+	return new(fastReflection_EventExternalWalletLinked)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventExternalWalletLinked) Interface() protoreflect.ProtoMessage {
+	return (*EventExternalWalletLinked)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventExternalWalletLinked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventExternalWalletLinked_did, value) {
+			return
+		}
+	}
+	if x.WalletType != "" {
+		value := protoreflect.ValueOfString(x.WalletType)
+		if !f(fd_EventExternalWalletLinked_wallet_type, value) {
+			return
+		}
+	}
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_EventExternalWalletLinked_wallet_address, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventExternalWalletLinked_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventExternalWalletLinked) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventExternalWalletLinked.did":
+		return x.Did != ""
+	case "did.v1.EventExternalWalletLinked.wallet_type":
+		return x.WalletType != ""
+	case "did.v1.EventExternalWalletLinked.wallet_address":
+		return x.WalletAddress != ""
+	case "did.v1.EventExternalWalletLinked.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+		}
+		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExternalWalletLinked) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventExternalWalletLinked.did":
+		x.Did = ""
+	case "did.v1.EventExternalWalletLinked.wallet_type":
+		x.WalletType = ""
+	case "did.v1.EventExternalWalletLinked.wallet_address":
+		x.WalletAddress = ""
+	case "did.v1.EventExternalWalletLinked.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+		}
+		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventExternalWalletLinked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventExternalWalletLinked.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventExternalWalletLinked.wallet_type":
+		value := x.WalletType
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventExternalWalletLinked.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventExternalWalletLinked.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+		}
+		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExternalWalletLinked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventExternalWalletLinked.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventExternalWalletLinked.wallet_type":
+		x.WalletType = value.Interface().(string)
+	case "did.v1.EventExternalWalletLinked.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "did.v1.EventExternalWalletLinked.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+		}
+		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExternalWalletLinked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventExternalWalletLinked.did":
+		panic(fmt.Errorf("field did of message did.v1.EventExternalWalletLinked is not mutable"))
+	case "did.v1.EventExternalWalletLinked.wallet_type":
+		panic(fmt.Errorf("field wallet_type of message did.v1.EventExternalWalletLinked is not mutable"))
+	case "did.v1.EventExternalWalletLinked.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message did.v1.EventExternalWalletLinked is not mutable"))
+	case "did.v1.EventExternalWalletLinked.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventExternalWalletLinked is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+		}
+		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventExternalWalletLinked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventExternalWalletLinked.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventExternalWalletLinked.wallet_type":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventExternalWalletLinked.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventExternalWalletLinked.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventExternalWalletLinked"))
+		}
+		panic(fmt.Errorf("message did.v1.EventExternalWalletLinked does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventExternalWalletLinked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventExternalWalletLinked", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventExternalWalletLinked) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExternalWalletLinked) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventExternalWalletLinked) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventExternalWalletLinked) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventExternalWalletLinked)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WalletType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventExternalWalletLinked)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.WalletType) > 0 {
+			i -= len(x.WalletType)
+			copy(dAtA[i:], x.WalletType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventExternalWalletLinked)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExternalWalletLinked: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExternalWalletLinked: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventCredentialOriginUnverified               protoreflect.MessageDescriptor
+	fd_EventCredentialOriginUnverified_did           protoreflect.FieldDescriptor
+	fd_EventCredentialOriginUnverified_credential_id protoreflect.FieldDescriptor
+	fd_EventCredentialOriginUnverified_origin        protoreflect.FieldDescriptor
+	fd_EventCredentialOriginUnverified_reason        protoreflect.FieldDescriptor
+	fd_EventCredentialOriginUnverified_downgraded    protoreflect.FieldDescriptor
+	fd_EventCredentialOriginUnverified_block_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventCredentialOriginUnverified = File_did_v1_events_proto.Messages().ByName("EventCredentialOriginUnverified")
+	fd_EventCredentialOriginUnverified_did = md_EventCredentialOriginUnverified.Fields().ByName("did")
+	fd_EventCredentialOriginUnverified_credential_id = md_EventCredentialOriginUnverified.Fields().ByName("credential_id")
+	fd_EventCredentialOriginUnverified_origin = md_EventCredentialOriginUnverified.Fields().ByName("origin")
+	fd_EventCredentialOriginUnverified_reason = md_EventCredentialOriginUnverified.Fields().ByName("reason")
+	fd_EventCredentialOriginUnverified_downgraded = md_EventCredentialOriginUnverified.Fields().ByName("downgraded")
+	fd_EventCredentialOriginUnverified_block_height = md_EventCredentialOriginUnverified.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCredentialOriginUnverified)(nil)
+
+type fastReflection_EventCredentialOriginUnverified EventCredentialOriginUnverified
+
+func (x *EventCredentialOriginUnverified) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCredentialOriginUnverified)(x)
+}
+
+func (x *EventCredentialOriginUnverified) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCredentialOriginUnverified_messageType fastReflection_EventCredentialOriginUnverified_messageType
+var _ protoreflect.MessageType = fastReflection_EventCredentialOriginUnverified_messageType{}
+
+type fastReflection_EventCredentialOriginUnverified_messageType struct{}
+
+func (x fastReflection_EventCredentialOriginUnverified_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCredentialOriginUnverified)(nil)
+}
+func (x fastReflection_EventCredentialOriginUnverified_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialOriginUnverified)
+}
+func (x fastReflection_EventCredentialOriginUnverified_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialOriginUnverified
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCredentialOriginUnverified) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialOriginUnverified
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCredentialOriginUnverified) Type() protoreflect.MessageType {
+	return _fastReflection_EventCredentialOriginUnverified_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventCredentialOriginUnverified) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialOriginUnverified)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventCredentialOriginUnverified) Interface() protoreflect.ProtoMessage {
+	return (*EventCredentialOriginUnverified)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventCredentialOriginUnverified) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventCredentialOriginUnverified_did, value) {
+			return
+		}
+	}
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_EventCredentialOriginUnverified_credential_id, value) {
+			return
+		}
+	}
+	if x.Origin != "" {
+		value := protoreflect.ValueOfString(x.Origin)
+		if !f(fd_EventCredentialOriginUnverified_origin, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventCredentialOriginUnverified_reason, value) {
+			return
+		}
+	}
+	if x.Downgraded != false {
+		value := protoreflect.ValueOfBool(x.Downgraded)
+		if !f(fd_EventCredentialOriginUnverified_downgraded, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventCredentialOriginUnverified_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventCredentialOriginUnverified) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginUnverified.did":
+		return x.Did != ""
+	case "did.v1.EventCredentialOriginUnverified.credential_id":
+		return x.CredentialId != ""
+	case "did.v1.EventCredentialOriginUnverified.origin":
+		return x.Origin != ""
+	case "did.v1.EventCredentialOriginUnverified.reason":
+		return x.Reason != ""
+	case "did.v1.EventCredentialOriginUnverified.downgraded":
+		return x.Downgraded != false
+	case "did.v1.EventCredentialOriginUnverified.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginUnverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginUnverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginUnverified) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginUnverified.did":
+		x.Did = ""
+	case "did.v1.EventCredentialOriginUnverified.credential_id":
+		x.CredentialId = ""
+	case "did.v1.EventCredentialOriginUnverified.origin":
+		x.Origin = ""
+	case "did.v1.EventCredentialOriginUnverified.reason":
+		x.Reason = ""
+	case "did.v1.EventCredentialOriginUnverified.downgraded":
+		x.Downgraded = false
+	case "did.v1.EventCredentialOriginUnverified.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginUnverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginUnverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventCredentialOriginUnverified) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventCredentialOriginUnverified.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginUnverified.credential_id":
+		value := x.CredentialId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginUnverified.origin":
+		value := x.Origin
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginUnverified.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginUnverified.downgraded":
+		value := x.Downgraded
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.EventCredentialOriginUnverified.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginUnverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginUnverified does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginUnverified) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginUnverified.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventCredentialOriginUnverified.credential_id":
+		x.CredentialId = value.Interface().(string)
+	case "did.v1.EventCredentialOriginUnverified.origin":
+		x.Origin = value.Interface().(string)
+	case "did.v1.EventCredentialOriginUnverified.reason":
+		x.Reason = value.Interface().(string)
+	case "did.v1.EventCredentialOriginUnverified.downgraded":
+		x.Downgraded = value.Bool()
+	case "did.v1.EventCredentialOriginUnverified.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginUnverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginUnverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginUnverified) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginUnverified.did":
+		panic(fmt.Errorf("field did of message did.v1.EventCredentialOriginUnverified is not mutable"))
+	case "did.v1.EventCredentialOriginUnverified.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.EventCredentialOriginUnverified is not mutable"))
+	case "did.v1.EventCredentialOriginUnverified.origin":
+		panic(fmt.Errorf("field origin of message did.v1.EventCredentialOriginUnverified is not mutable"))
+	case "did.v1.EventCredentialOriginUnverified.reason":
+		panic(fmt.Errorf("field reason of message did.v1.EventCredentialOriginUnverified is not mutable"))
+	case "did.v1.EventCredentialOriginUnverified.downgraded":
+		panic(fmt.Errorf("field downgraded of message did.v1.EventCredentialOriginUnverified is not mutable"))
+	case "did.v1.EventCredentialOriginUnverified.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventCredentialOriginUnverified is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginUnverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginUnverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventCredentialOriginUnverified) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginUnverified.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginUnverified.credential_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginUnverified.origin":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginUnverified.reason":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginUnverified.downgraded":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.EventCredentialOriginUnverified.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginUnverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginUnverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventCredentialOriginUnverified) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventCredentialOriginUnverified", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventCredentialOriginUnverified) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginUnverified) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventCredentialOriginUnverified) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventCredentialOriginUnverified) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventCredentialOriginUnverified)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CredentialId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Origin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Downgraded {
+			n += 2
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventCredentialOriginUnverified)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Downgraded {
+			i--
+			if x.Downgraded {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Origin) > 0 {
+			i -= len(x.Origin)
+			copy(dAtA[i:], x.Origin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Origin)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventCredentialOriginUnverified)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialOriginUnverified: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialOriginUnverified: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Origin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Downgraded", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Downgraded = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventCredentialOriginReverified               protoreflect.MessageDescriptor
+	fd_EventCredentialOriginReverified_did           protoreflect.FieldDescriptor
+	fd_EventCredentialOriginReverified_credential_id protoreflect.FieldDescriptor
+	fd_EventCredentialOriginReverified_origin        protoreflect.FieldDescriptor
+	fd_EventCredentialOriginReverified_block_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventCredentialOriginReverified = File_did_v1_events_proto.Messages().ByName("EventCredentialOriginReverified")
+	fd_EventCredentialOriginReverified_did = md_EventCredentialOriginReverified.Fields().ByName("did")
+	fd_EventCredentialOriginReverified_credential_id = md_EventCredentialOriginReverified.Fields().ByName("credential_id")
+	fd_EventCredentialOriginReverified_origin = md_EventCredentialOriginReverified.Fields().ByName("origin")
+	fd_EventCredentialOriginReverified_block_height = md_EventCredentialOriginReverified.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCredentialOriginReverified)(nil)
+
+type fastReflection_EventCredentialOriginReverified EventCredentialOriginReverified
+
+func (x *EventCredentialOriginReverified) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCredentialOriginReverified)(x)
+}
+
+func (x *EventCredentialOriginReverified) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCredentialOriginReverified_messageType fastReflection_EventCredentialOriginReverified_messageType
+var _ protoreflect.MessageType = fastReflection_EventCredentialOriginReverified_messageType{}
+
+type fastReflection_EventCredentialOriginReverified_messageType struct{}
+
+func (x fastReflection_EventCredentialOriginReverified_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCredentialOriginReverified)(nil)
+}
+func (x fastReflection_EventCredentialOriginReverified_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialOriginReverified)
+}
+func (x fastReflection_EventCredentialOriginReverified_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialOriginReverified
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCredentialOriginReverified) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCredentialOriginReverified
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCredentialOriginReverified) Type() protoreflect.MessageType {
+	return _fastReflection_EventCredentialOriginReverified_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventCredentialOriginReverified) New() protoreflect.Message {
+	return new(fastReflection_EventCredentialOriginReverified)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventCredentialOriginReverified) Interface() protoreflect.ProtoMessage {
+	return (*EventCredentialOriginReverified)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventCredentialOriginReverified) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventCredentialOriginReverified_did, value) {
+			return
+		}
+	}
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_EventCredentialOriginReverified_credential_id, value) {
+			return
+		}
+	}
+	if x.Origin != "" {
+		value := protoreflect.ValueOfString(x.Origin)
+		if !f(fd_EventCredentialOriginReverified_origin, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventCredentialOriginReverified_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventCredentialOriginReverified) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginReverified.did":
+		return x.Did != ""
+	case "did.v1.EventCredentialOriginReverified.credential_id":
+		return x.CredentialId != ""
+	case "did.v1.EventCredentialOriginReverified.origin":
+		return x.Origin != ""
+	case "did.v1.EventCredentialOriginReverified.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginReverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginReverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginReverified) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginReverified.did":
+		x.Did = ""
+	case "did.v1.EventCredentialOriginReverified.credential_id":
+		x.CredentialId = ""
+	case "did.v1.EventCredentialOriginReverified.origin":
+		x.Origin = ""
+	case "did.v1.EventCredentialOriginReverified.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginReverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginReverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventCredentialOriginReverified) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventCredentialOriginReverified.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginReverified.credential_id":
+		value := x.CredentialId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginReverified.origin":
+		value := x.Origin
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventCredentialOriginReverified.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginReverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginReverified does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginReverified) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginReverified.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventCredentialOriginReverified.credential_id":
+		x.CredentialId = value.Interface().(string)
+	case "did.v1.EventCredentialOriginReverified.origin":
+		x.Origin = value.Interface().(string)
+	case "did.v1.EventCredentialOriginReverified.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginReverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginReverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginReverified) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginReverified.did":
+		panic(fmt.Errorf("field did of message did.v1.EventCredentialOriginReverified is not mutable"))
+	case "did.v1.EventCredentialOriginReverified.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.EventCredentialOriginReverified is not mutable"))
+	case "did.v1.EventCredentialOriginReverified.origin":
+		panic(fmt.Errorf("field origin of message did.v1.EventCredentialOriginReverified is not mutable"))
+	case "did.v1.EventCredentialOriginReverified.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventCredentialOriginReverified is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginReverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginReverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventCredentialOriginReverified) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventCredentialOriginReverified.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginReverified.credential_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginReverified.origin":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventCredentialOriginReverified.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventCredentialOriginReverified"))
+		}
+		panic(fmt.Errorf("message did.v1.EventCredentialOriginReverified does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventCredentialOriginReverified) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventCredentialOriginReverified", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventCredentialOriginReverified) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCredentialOriginReverified) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventCredentialOriginReverified) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventCredentialOriginReverified) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventCredentialOriginReverified)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CredentialId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Origin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventCredentialOriginReverified)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Origin) > 0 {
+			i -= len(x.Origin)
+			copy(dAtA[i:], x.Origin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Origin)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventCredentialOriginReverified)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialOriginReverified: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCredentialOriginReverified: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Origin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventDelegatedControllerAdded              protoreflect.MessageDescriptor
+	fd_EventDelegatedControllerAdded_did          protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerAdded_delegate     protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerAdded_granted_by   protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerAdded_purpose      protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerAdded_expires_at   protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerAdded_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventDelegatedControllerAdded = File_did_v1_events_proto.Messages().ByName("EventDelegatedControllerAdded")
+	fd_EventDelegatedControllerAdded_did = md_EventDelegatedControllerAdded.Fields().ByName("did")
+	fd_EventDelegatedControllerAdded_delegate = md_EventDelegatedControllerAdded.Fields().ByName("delegate")
+	fd_EventDelegatedControllerAdded_granted_by = md_EventDelegatedControllerAdded.Fields().ByName("granted_by")
+	fd_EventDelegatedControllerAdded_purpose = md_EventDelegatedControllerAdded.Fields().ByName("purpose")
+	fd_EventDelegatedControllerAdded_expires_at = md_EventDelegatedControllerAdded.Fields().ByName("expires_at")
+	fd_EventDelegatedControllerAdded_block_height = md_EventDelegatedControllerAdded.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDelegatedControllerAdded)(nil)
+
+type fastReflection_EventDelegatedControllerAdded EventDelegatedControllerAdded
+
+func (x *EventDelegatedControllerAdded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDelegatedControllerAdded)(x)
+}
+
+func (x *EventDelegatedControllerAdded) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDelegatedControllerAdded_messageType fastReflection_EventDelegatedControllerAdded_messageType
+var _ protoreflect.MessageType = fastReflection_EventDelegatedControllerAdded_messageType{}
+
+type fastReflection_EventDelegatedControllerAdded_messageType struct{}
+
+func (x fastReflection_EventDelegatedControllerAdded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDelegatedControllerAdded)(nil)
+}
+func (x fastReflection_EventDelegatedControllerAdded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDelegatedControllerAdded)
+}
+func (x fastReflection_EventDelegatedControllerAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDelegatedControllerAdded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDelegatedControllerAdded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDelegatedControllerAdded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDelegatedControllerAdded) Type() protoreflect.MessageType {
+	return _fastReflection_EventDelegatedControllerAdded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDelegatedControllerAdded) New() protoreflect.Message {
+	return new(fastReflection_EventDelegatedControllerAdded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDelegatedControllerAdded) Interface() protoreflect.ProtoMessage {
+	return (*EventDelegatedControllerAdded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDelegatedControllerAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventDelegatedControllerAdded_did, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_EventDelegatedControllerAdded_delegate, value) {
+			return
+		}
+	}
+	if x.GrantedBy != "" {
+		value := protoreflect.ValueOfString(x.GrantedBy)
+		if !f(fd_EventDelegatedControllerAdded_granted_by, value) {
+			return
+		}
+	}
+	if x.Purpose != "" {
+		value := protoreflect.ValueOfString(x.Purpose)
+		if !f(fd_EventDelegatedControllerAdded_purpose, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+		if !f(fd_EventDelegatedControllerAdded_expires_at, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventDelegatedControllerAdded_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDelegatedControllerAdded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerAdded.did":
+		return x.Did != ""
+	case "did.v1.EventDelegatedControllerAdded.delegate":
+		return x.Delegate != ""
+	case "did.v1.EventDelegatedControllerAdded.granted_by":
+		return x.GrantedBy != ""
+	case "did.v1.EventDelegatedControllerAdded.purpose":
+		return x.Purpose != ""
+	case "did.v1.EventDelegatedControllerAdded.expires_at":
+		return x.ExpiresAt != nil
+	case "did.v1.EventDelegatedControllerAdded.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerAdded"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerAdded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerAdded.did":
+		x.Did = ""
+	case "did.v1.EventDelegatedControllerAdded.delegate":
+		x.Delegate = ""
+	case "did.v1.EventDelegatedControllerAdded.granted_by":
+		x.GrantedBy = ""
+	case "did.v1.EventDelegatedControllerAdded.purpose":
+		x.Purpose = ""
+	case "did.v1.EventDelegatedControllerAdded.expires_at":
+		x.ExpiresAt = nil
+	case "did.v1.EventDelegatedControllerAdded.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerAdded"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDelegatedControllerAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventDelegatedControllerAdded.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerAdded.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerAdded.granted_by":
+		value := x.GrantedBy
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerAdded.purpose":
+		value := x.Purpose
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerAdded.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.EventDelegatedControllerAdded.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerAdded"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerAdded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerAdded.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerAdded.delegate":
+		x.Delegate = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerAdded.granted_by":
+		x.GrantedBy = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerAdded.purpose":
+		x.Purpose = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerAdded.expires_at":
+		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "did.v1.EventDelegatedControllerAdded.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerAdded"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerAdded.expires_at":
+		if x.ExpiresAt == nil {
+			x.ExpiresAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+	case "did.v1.EventDelegatedControllerAdded.did":
+		panic(fmt.Errorf("field did of message did.v1.EventDelegatedControllerAdded is not mutable"))
+	case "did.v1.EventDelegatedControllerAdded.delegate":
+		panic(fmt.Errorf("field delegate of message did.v1.EventDelegatedControllerAdded is not mutable"))
+	case "did.v1.EventDelegatedControllerAdded.granted_by":
+		panic(fmt.Errorf("field granted_by of message did.v1.EventDelegatedControllerAdded is not mutable"))
+	case "did.v1.EventDelegatedControllerAdded.purpose":
+		panic(fmt.Errorf("field purpose of message did.v1.EventDelegatedControllerAdded is not mutable"))
+	case "did.v1.EventDelegatedControllerAdded.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventDelegatedControllerAdded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerAdded"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDelegatedControllerAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerAdded.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerAdded.delegate":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerAdded.granted_by":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerAdded.purpose":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerAdded.expires_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.EventDelegatedControllerAdded.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerAdded"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDelegatedControllerAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventDelegatedControllerAdded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDelegatedControllerAdded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerAdded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDelegatedControllerAdded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDelegatedControllerAdded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDelegatedControllerAdded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrantedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Purpose)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiresAt != nil {
+			l = options.Size(x.ExpiresAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDelegatedControllerAdded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExpiresAt != nil {
+			encoded, err := options.Marshal(x.ExpiresAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Purpose) > 0 {
+			i -= len(x.Purpose)
+			copy(dAtA[i:], x.Purpose)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Purpose)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.GrantedBy) > 0 {
+			i -= len(x.GrantedBy)
+			copy(dAtA[i:], x.GrantedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrantedBy)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDelegatedControllerAdded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDelegatedControllerAdded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDelegatedControllerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrantedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Purpose = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAt == nil {
+					x.ExpiresAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventDelegatedControllerRemoved              protoreflect.MessageDescriptor
+	fd_EventDelegatedControllerRemoved_did          protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerRemoved_delegate     protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerRemoved_removed_by   protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerRemoved_expired      protoreflect.FieldDescriptor
+	fd_EventDelegatedControllerRemoved_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventDelegatedControllerRemoved = File_did_v1_events_proto.Messages().ByName("EventDelegatedControllerRemoved")
+	fd_EventDelegatedControllerRemoved_did = md_EventDelegatedControllerRemoved.Fields().ByName("did")
+	fd_EventDelegatedControllerRemoved_delegate = md_EventDelegatedControllerRemoved.Fields().ByName("delegate")
+	fd_EventDelegatedControllerRemoved_removed_by = md_EventDelegatedControllerRemoved.Fields().ByName("removed_by")
+	fd_EventDelegatedControllerRemoved_expired = md_EventDelegatedControllerRemoved.Fields().ByName("expired")
+	fd_EventDelegatedControllerRemoved_block_height = md_EventDelegatedControllerRemoved.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDelegatedControllerRemoved)(nil)
+
+type fastReflection_EventDelegatedControllerRemoved EventDelegatedControllerRemoved
+
+func (x *EventDelegatedControllerRemoved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDelegatedControllerRemoved)(x)
+}
+
+func (x *EventDelegatedControllerRemoved) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDelegatedControllerRemoved_messageType fastReflection_EventDelegatedControllerRemoved_messageType
+var _ protoreflect.MessageType = fastReflection_EventDelegatedControllerRemoved_messageType{}
+
+type fastReflection_EventDelegatedControllerRemoved_messageType struct{}
+
+func (x fastReflection_EventDelegatedControllerRemoved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDelegatedControllerRemoved)(nil)
+}
+func (x fastReflection_EventDelegatedControllerRemoved_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDelegatedControllerRemoved)
+}
+func (x fastReflection_EventDelegatedControllerRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDelegatedControllerRemoved
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDelegatedControllerRemoved) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDelegatedControllerRemoved
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDelegatedControllerRemoved) Type() protoreflect.MessageType {
+	return _fastReflection_EventDelegatedControllerRemoved_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDelegatedControllerRemoved) New() protoreflect.Message {
+	return new(fastReflection_EventDelegatedControllerRemoved)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDelegatedControllerRemoved) Interface() protoreflect.ProtoMessage {
+	return (*EventDelegatedControllerRemoved)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDelegatedControllerRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventDelegatedControllerRemoved_did, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_EventDelegatedControllerRemoved_delegate, value) {
+			return
+		}
+	}
+	if x.RemovedBy != "" {
+		value := protoreflect.ValueOfString(x.RemovedBy)
+		if !f(fd_EventDelegatedControllerRemoved_removed_by, value) {
+			return
+		}
+	}
+	if x.Expired != false {
+		value := protoreflect.ValueOfBool(x.Expired)
+		if !f(fd_EventDelegatedControllerRemoved_expired, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventDelegatedControllerRemoved_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDelegatedControllerRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerRemoved.did":
+		return x.Did != ""
+	case "did.v1.EventDelegatedControllerRemoved.delegate":
+		return x.Delegate != ""
+	case "did.v1.EventDelegatedControllerRemoved.removed_by":
+		return x.RemovedBy != ""
+	case "did.v1.EventDelegatedControllerRemoved.expired":
+		return x.Expired != false
+	case "did.v1.EventDelegatedControllerRemoved.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerRemoved"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerRemoved) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerRemoved.did":
+		x.Did = ""
+	case "did.v1.EventDelegatedControllerRemoved.delegate":
+		x.Delegate = ""
+	case "did.v1.EventDelegatedControllerRemoved.removed_by":
+		x.RemovedBy = ""
+	case "did.v1.EventDelegatedControllerRemoved.expired":
+		x.Expired = false
+	case "did.v1.EventDelegatedControllerRemoved.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerRemoved"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDelegatedControllerRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventDelegatedControllerRemoved.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerRemoved.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerRemoved.removed_by":
+		value := x.RemovedBy
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventDelegatedControllerRemoved.expired":
+		value := x.Expired
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.EventDelegatedControllerRemoved.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerRemoved"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerRemoved does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerRemoved.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerRemoved.delegate":
+		x.Delegate = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerRemoved.removed_by":
+		x.RemovedBy = value.Interface().(string)
+	case "did.v1.EventDelegatedControllerRemoved.expired":
+		x.Expired = value.Bool()
+	case "did.v1.EventDelegatedControllerRemoved.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerRemoved"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerRemoved.did":
+		panic(fmt.Errorf("field did of message did.v1.EventDelegatedControllerRemoved is not mutable"))
+	case "did.v1.EventDelegatedControllerRemoved.delegate":
+		panic(fmt.Errorf("field delegate of message did.v1.EventDelegatedControllerRemoved is not mutable"))
+	case "did.v1.EventDelegatedControllerRemoved.removed_by":
+		panic(fmt.Errorf("field removed_by of message did.v1.EventDelegatedControllerRemoved is not mutable"))
+	case "did.v1.EventDelegatedControllerRemoved.expired":
+		panic(fmt.Errorf("field expired of message did.v1.EventDelegatedControllerRemoved is not mutable"))
+	case "did.v1.EventDelegatedControllerRemoved.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventDelegatedControllerRemoved is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerRemoved"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDelegatedControllerRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventDelegatedControllerRemoved.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerRemoved.delegate":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerRemoved.removed_by":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventDelegatedControllerRemoved.expired":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.EventDelegatedControllerRemoved.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventDelegatedControllerRemoved"))
+		}
+		panic(fmt.Errorf("message did.v1.EventDelegatedControllerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDelegatedControllerRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventDelegatedControllerRemoved", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDelegatedControllerRemoved) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDelegatedControllerRemoved) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDelegatedControllerRemoved) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDelegatedControllerRemoved) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDelegatedControllerRemoved)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RemovedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expired {
+			n += 2
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDelegatedControllerRemoved)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Expired {
+			i--
+			if x.Expired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RemovedBy) > 0 {
+			i -= len(x.RemovedBy)
+			copy(dAtA[i:], x.RemovedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedBy)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDelegatedControllerRemoved)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDelegatedControllerRemoved: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDelegatedControllerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expired = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventNFTAttached              protoreflect.MessageDescriptor
+	fd_EventNFTAttached_did          protoreflect.FieldDescriptor
+	fd_EventNFTAttached_class_id     protoreflect.FieldDescriptor
+	fd_EventNFTAttached_nft_id       protoreflect.FieldDescriptor
+	fd_EventNFTAttached_owner        protoreflect.FieldDescriptor
+	fd_EventNFTAttached_interchain   protoreflect.FieldDescriptor
+	fd_EventNFTAttached_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventNFTAttached = File_did_v1_events_proto.Messages().ByName("EventNFTAttached")
+	fd_EventNFTAttached_did = md_EventNFTAttached.Fields().ByName("did")
+	fd_EventNFTAttached_class_id = md_EventNFTAttached.Fields().ByName("class_id")
+	fd_EventNFTAttached_nft_id = md_EventNFTAttached.Fields().ByName("nft_id")
+	fd_EventNFTAttached_owner = md_EventNFTAttached.Fields().ByName("owner")
+	fd_EventNFTAttached_interchain = md_EventNFTAttached.Fields().ByName("interchain")
+	fd_EventNFTAttached_block_height = md_EventNFTAttached.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventNFTAttached)(nil)
+
+type fastReflection_EventNFTAttached EventNFTAttached
+
+func (x *EventNFTAttached) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventNFTAttached)(x)
+}
+
+func (x *EventNFTAttached) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventNFTAttached_messageType fastReflection_EventNFTAttached_messageType
+var _ protoreflect.MessageType = fastReflection_EventNFTAttached_messageType{}
+
+type fastReflection_EventNFTAttached_messageType struct{}
+
+func (x fastReflection_EventNFTAttached_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventNFTAttached)(nil)
+}
+func (x fastReflection_EventNFTAttached_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventNFTAttached)
+}
+func (x fastReflection_EventNFTAttached_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNFTAttached
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventNFTAttached) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNFTAttached
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventNFTAttached) Type() protoreflect.MessageType {
+	return _fastReflection_EventNFTAttached_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventNFTAttached) New() protoreflect.Message {
+	return new(fastReflection_EventNFTAttached)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventNFTAttached) Interface() protoreflect.ProtoMessage {
+	return (*EventNFTAttached)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventNFTAttached) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventNFTAttached_did, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventNFTAttached_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_EventNFTAttached_nft_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventNFTAttached_owner, value) {
+			return
+		}
+	}
+	if x.Interchain != false {
+		value := protoreflect.ValueOfBool(x.Interchain)
+		if !f(fd_EventNFTAttached_interchain, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventNFTAttached_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventNFTAttached) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventNFTAttached.did":
+		return x.Did != ""
+	case "did.v1.EventNFTAttached.class_id":
+		return x.ClassId != ""
+	case "did.v1.EventNFTAttached.nft_id":
+		return x.NftId != ""
+	case "did.v1.EventNFTAttached.owner":
+		return x.Owner != ""
+	case "did.v1.EventNFTAttached.interchain":
+		return x.Interchain != false
+	case "did.v1.EventNFTAttached.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTAttached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTAttached does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTAttached) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventNFTAttached.did":
+		x.Did = ""
+	case "did.v1.EventNFTAttached.class_id":
+		x.ClassId = ""
+	case "did.v1.EventNFTAttached.nft_id":
+		x.NftId = ""
+	case "did.v1.EventNFTAttached.owner":
+		x.Owner = ""
+	case "did.v1.EventNFTAttached.interchain":
+		x.Interchain = false
+	case "did.v1.EventNFTAttached.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTAttached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTAttached does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventNFTAttached) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventNFTAttached.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTAttached.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTAttached.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTAttached.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTAttached.interchain":
+		value := x.Interchain
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.EventNFTAttached.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTAttached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTAttached does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTAttached) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventNFTAttached.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventNFTAttached.class_id":
+		x.ClassId = value.Interface().(string)
+	case "did.v1.EventNFTAttached.nft_id":
+		x.NftId = value.Interface().(string)
+	case "did.v1.EventNFTAttached.owner":
+		x.Owner = value.Interface().(string)
+	case "did.v1.EventNFTAttached.interchain":
+		x.Interchain = value.Bool()
+	case "did.v1.EventNFTAttached.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTAttached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTAttached does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTAttached) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventNFTAttached.did":
+		panic(fmt.Errorf("field did of message did.v1.EventNFTAttached is not mutable"))
+	case "did.v1.EventNFTAttached.class_id":
+		panic(fmt.Errorf("field class_id of message did.v1.EventNFTAttached is not mutable"))
+	case "did.v1.EventNFTAttached.nft_id":
+		panic(fmt.Errorf("field nft_id of message did.v1.EventNFTAttached is not mutable"))
+	case "did.v1.EventNFTAttached.owner":
+		panic(fmt.Errorf("field owner of message did.v1.EventNFTAttached is not mutable"))
+	case "did.v1.EventNFTAttached.interchain":
+		panic(fmt.Errorf("field interchain of message did.v1.EventNFTAttached is not mutable"))
+	case "did.v1.EventNFTAttached.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventNFTAttached is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTAttached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTAttached does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventNFTAttached) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventNFTAttached.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTAttached.class_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTAttached.nft_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTAttached.owner":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTAttached.interchain":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.EventNFTAttached.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTAttached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTAttached does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventNFTAttached) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventNFTAttached", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventNFTAttached) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTAttached) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventNFTAttached) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventNFTAttached) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventNFTAttached)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Interchain {
+			n += 2
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventNFTAttached)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Interchain {
+			i--
+			if x.Interchain {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventNFTAttached)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNFTAttached: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNFTAttached: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interchain", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Interchain = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventNFTDetached              protoreflect.MessageDescriptor
+	fd_EventNFTDetached_did          protoreflect.FieldDescriptor
+	fd_EventNFTDetached_class_id     protoreflect.FieldDescriptor
+	fd_EventNFTDetached_nft_id       protoreflect.FieldDescriptor
+	fd_EventNFTDetached_removed_by   protoreflect.FieldDescriptor
+	fd_EventNFTDetached_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_events_proto_init()
+	md_EventNFTDetached = File_did_v1_events_proto.Messages().ByName("EventNFTDetached")
+	fd_EventNFTDetached_did = md_EventNFTDetached.Fields().ByName("did")
+	fd_EventNFTDetached_class_id = md_EventNFTDetached.Fields().ByName("class_id")
+	fd_EventNFTDetached_nft_id = md_EventNFTDetached.Fields().ByName("nft_id")
+	fd_EventNFTDetached_removed_by = md_EventNFTDetached.Fields().ByName("removed_by")
+	fd_EventNFTDetached_block_height = md_EventNFTDetached.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventNFTDetached)(nil)
+
+type fastReflection_EventNFTDetached EventNFTDetached
+
+func (x *EventNFTDetached) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventNFTDetached)(x)
+}
+
+func (x *EventNFTDetached) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_events_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventNFTDetached_messageType fastReflection_EventNFTDetached_messageType
+var _ protoreflect.MessageType = fastReflection_EventNFTDetached_messageType{}
+
+type fastReflection_EventNFTDetached_messageType struct{}
+
+func (x fastReflection_EventNFTDetached_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventNFTDetached)(nil)
+}
+func (x fastReflection_EventNFTDetached_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventNFTDetached)
+}
+func (x fastReflection_EventNFTDetached_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNFTDetached
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventNFTDetached) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNFTDetached
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventNFTDetached) Type() protoreflect.MessageType {
+	return _fastReflection_EventNFTDetached_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventNFTDetached) New() protoreflect.Message {
+	return new(fastReflection_EventNFTDetached)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventNFTDetached) Interface() protoreflect.ProtoMessage {
+	return (*EventNFTDetached)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventNFTDetached) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventNFTDetached_did, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventNFTDetached_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_EventNFTDetached_nft_id, value) {
+			return
+		}
+	}
+	if x.RemovedBy != "" {
+		value := protoreflect.ValueOfString(x.RemovedBy)
+		if !f(fd_EventNFTDetached_removed_by, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventNFTDetached_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventNFTDetached) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.EventNFTDetached.did":
+		return x.Did != ""
+	case "did.v1.EventNFTDetached.class_id":
+		return x.ClassId != ""
+	case "did.v1.EventNFTDetached.nft_id":
+		return x.NftId != ""
+	case "did.v1.EventNFTDetached.removed_by":
+		return x.RemovedBy != ""
+	case "did.v1.EventNFTDetached.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTDetached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTDetached does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTDetached) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.EventNFTDetached.did":
+		x.Did = ""
+	case "did.v1.EventNFTDetached.class_id":
+		x.ClassId = ""
+	case "did.v1.EventNFTDetached.nft_id":
+		x.NftId = ""
+	case "did.v1.EventNFTDetached.removed_by":
+		x.RemovedBy = ""
+	case "did.v1.EventNFTDetached.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTDetached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTDetached does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventNFTDetached) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.EventNFTDetached.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTDetached.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTDetached.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTDetached.removed_by":
+		value := x.RemovedBy
+		return protoreflect.ValueOfString(value)
+	case "did.v1.EventNFTDetached.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTDetached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTDetached does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTDetached) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.EventNFTDetached.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.EventNFTDetached.class_id":
+		x.ClassId = value.Interface().(string)
+	case "did.v1.EventNFTDetached.nft_id":
+		x.NftId = value.Interface().(string)
+	case "did.v1.EventNFTDetached.removed_by":
+		x.RemovedBy = value.Interface().(string)
+	case "did.v1.EventNFTDetached.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTDetached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTDetached does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTDetached) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventNFTDetached.did":
+		panic(fmt.Errorf("field did of message did.v1.EventNFTDetached is not mutable"))
+	case "did.v1.EventNFTDetached.class_id":
+		panic(fmt.Errorf("field class_id of message did.v1.EventNFTDetached is not mutable"))
+	case "did.v1.EventNFTDetached.nft_id":
+		panic(fmt.Errorf("field nft_id of message did.v1.EventNFTDetached is not mutable"))
+	case "did.v1.EventNFTDetached.removed_by":
+		panic(fmt.Errorf("field removed_by of message did.v1.EventNFTDetached is not mutable"))
+	case "did.v1.EventNFTDetached.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.EventNFTDetached is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTDetached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTDetached does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventNFTDetached) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.EventNFTDetached.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTDetached.class_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTDetached.nft_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTDetached.removed_by":
+		return protoreflect.ValueOfString("")
+	case "did.v1.EventNFTDetached.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.EventNFTDetached"))
+		}
+		panic(fmt.Errorf("message did.v1.EventNFTDetached does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventNFTDetached) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.EventNFTDetached", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventNFTDetached) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNFTDetached) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventNFTDetached) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventNFTDetached) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventNFTDetached)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RemovedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventNFTDetached)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.RemovedBy) > 0 {
+			i -= len(x.RemovedBy)
+			copy(dAtA[i:], x.RemovedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedBy)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventNFTDetached)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNFTDetached: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNFTDetached: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: did/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventDIDCreated is emitted when a new DID is created
+type EventDIDCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Creator address
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Public keys added
+	PublicKeys []string `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	// Services added
 	Services []string `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
 	// Creation timestamp
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventDIDCreated) Reset() {
+	*x = EventDIDCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDIDCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDIDCreated) ProtoMessage() {}
+
+// Deprecated: Use EventDIDCreated.ProtoReflect.Descriptor instead.
+func (*EventDIDCreated) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventDIDCreated) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventDIDCreated) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EventDIDCreated) GetPublicKeys() []string {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *EventDIDCreated) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *EventDIDCreated) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventDIDCreated) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventDIDUpdated is emitted when a DID is updated
+type EventDIDUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Updater address
+	Updater string `protobuf:"bytes,2,opt,name=updater,proto3" json:"updater,omitempty"`
+	// Fields that were updated
+	FieldsUpdated []string `protobuf:"bytes,3,rep,name=fields_updated,json=fieldsUpdated,proto3" json:"fields_updated,omitempty"`
+	// Update timestamp
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventDIDUpdated) Reset() {
+	*x = EventDIDUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDIDUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDIDUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventDIDUpdated.ProtoReflect.Descriptor instead.
+func (*EventDIDUpdated) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventDIDUpdated) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventDIDUpdated) GetUpdater() string {
+	if x != nil {
+		return x.Updater
+	}
+	return ""
+}
+
+func (x *EventDIDUpdated) GetFieldsUpdated() []string {
+	if x != nil {
+		return x.FieldsUpdated
+	}
+	return nil
+}
+
+func (x *EventDIDUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *EventDIDUpdated) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventDIDDeactivated is emitted when a DID is deactivated
+type EventDIDDeactivated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Deactivator address
+	Deactivator string `protobuf:"bytes,2,opt,name=deactivator,proto3" json:"deactivator,omitempty"`
+	// Deactivation timestamp
+	DeactivatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventDIDDeactivated) Reset() {
+	*x = EventDIDDeactivated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDIDDeactivated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDIDDeactivated) ProtoMessage() {}
+
+// Deprecated: Use EventDIDDeactivated.ProtoReflect.Descriptor instead.
+func (*EventDIDDeactivated) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventDIDDeactivated) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventDIDDeactivated) GetDeactivator() string {
+	if x != nil {
+		return x.Deactivator
+	}
+	return ""
+}
+
+func (x *EventDIDDeactivated) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *EventDIDDeactivated) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventDIDReactivated is emitted when a deactivated DID is reactivated
+type EventDIDReactivated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Reactivator address
+	Reactivator string `protobuf:"bytes,2,opt,name=reactivator,proto3" json:"reactivator,omitempty"`
+	// Reactivation timestamp
+	ReactivatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reactivated_at,json=reactivatedAt,proto3" json:"reactivated_at,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventDIDCreated) Reset() {
-	*x = EventDIDCreated{}
+func (x *EventDIDReactivated) Reset() {
+	*x = EventDIDReactivated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[0]
+		mi := &file_did_v1_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventDIDCreated) String() string {
+func (x *EventDIDReactivated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventDIDCreated) ProtoMessage() {}
+func (*EventDIDReactivated) ProtoMessage() {}
 
-// Deprecated: Use EventDIDCreated.ProtoReflect.Descriptor instead.
-func (*EventDIDCreated) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EventDIDReactivated.ProtoReflect.Descriptor instead.
+func (*EventDIDReactivated) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EventDIDCreated) GetDid() string {
+func (x *EventDIDReactivated) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventDIDCreated) GetCreator() string {
+func (x *EventDIDReactivated) GetReactivator() string {
 	if x != nil {
-		return x.Creator
+		return x.Reactivator
 	}
 	return ""
 }
 
-func (x *EventDIDCreated) GetPublicKeys() []string {
+func (x *EventDIDReactivated) GetReactivatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PublicKeys
+		return x.ReactivatedAt
 	}
 	return nil
 }
 
-func (x *EventDIDCreated) GetServices() []string {
+func (x *EventDIDReactivated) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventVerificationMethodAdded is emitted when a verification method is added
+type EventVerificationMethodAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Method ID
+	MethodId string `protobuf:"bytes,2,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty"`
+	// Key type
+	KeyType string `protobuf:"bytes,3,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// Public key (encoded)
+	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventVerificationMethodAdded) Reset() {
+	*x = EventVerificationMethodAdded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVerificationMethodAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVerificationMethodAdded) ProtoMessage() {}
+
+// Deprecated: Use EventVerificationMethodAdded.ProtoReflect.Descriptor instead.
+func (*EventVerificationMethodAdded) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventVerificationMethodAdded) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventVerificationMethodAdded) GetMethodId() string {
+	if x != nil {
+		return x.MethodId
+	}
+	return ""
+}
+
+func (x *EventVerificationMethodAdded) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *EventVerificationMethodAdded) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *EventVerificationMethodAdded) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventVerificationMethodRemoved is emitted when a verification method is removed
+type EventVerificationMethodRemoved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Method ID
+	MethodId string `protobuf:"bytes,2,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventVerificationMethodRemoved) Reset() {
+	*x = EventVerificationMethodRemoved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVerificationMethodRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVerificationMethodRemoved) ProtoMessage() {}
+
+// Deprecated: Use EventVerificationMethodRemoved.ProtoReflect.Descriptor instead.
+func (*EventVerificationMethodRemoved) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventVerificationMethodRemoved) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventVerificationMethodRemoved) GetMethodId() string {
+	if x != nil {
+		return x.MethodId
+	}
+	return ""
+}
+
+func (x *EventVerificationMethodRemoved) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventServiceAdded is emitted when a service is added to a DID
+type EventServiceAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Service ID
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Service type
+	Type_ string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Service endpoint
+	Endpoint string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventServiceAdded) Reset() {
+	*x = EventServiceAdded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventServiceAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventServiceAdded) ProtoMessage() {}
+
+// Deprecated: Use EventServiceAdded.ProtoReflect.Descriptor instead.
+func (*EventServiceAdded) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventServiceAdded) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventServiceAdded) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *EventServiceAdded) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *EventServiceAdded) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *EventServiceAdded) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// EventServiceRemoved is emitted when a service is removed from a DID
+type EventServiceRemoved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Service ID
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventServiceRemoved) Reset() {
+	*x = EventServiceRemoved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventServiceRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventServiceRemoved) ProtoMessage() {}
+
+// Deprecated: Use EventServiceRemoved.ProtoReflect.Descriptor instead.
+func (*EventServiceRemoved) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventServiceRemoved) GetDid() string {
 	if x != nil {
-		return x.Services
+		return x.Did
 	}
-	return nil
+	return ""
 }
 
-func (x *EventDIDCreated) GetCreatedAt() *timestamppb.Timestamp {
+func (x *EventServiceRemoved) GetServiceId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *EventDIDCreated) GetBlockHeight() uint64 {
+func (x *EventServiceRemoved) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventDIDUpdated is emitted when a DID is updated
-type EventDIDUpdated struct {
+// EventCredentialIssued is emitted when a verifiable credential is issued
+type EventCredentialIssued struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DID identifier
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Updater address
-	Updater string `protobuf:"bytes,2,opt,name=updater,proto3" json:"updater,omitempty"`
-	// Fields that were updated
-	FieldsUpdated []string `protobuf:"bytes,3,rep,name=fields_updated,json=fieldsUpdated,proto3" json:"fields_updated,omitempty"`
-	// Update timestamp
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Credential ID
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Issuer DID
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Subject DID
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Credential type
+	Type_ string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Issuance timestamp
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventDIDUpdated) Reset() {
-	*x = EventDIDUpdated{}
+func (x *EventCredentialIssued) Reset() {
+	*x = EventCredentialIssued{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[1]
+		mi := &file_did_v1_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventDIDUpdated) String() string {
+func (x *EventCredentialIssued) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventDIDUpdated) ProtoMessage() {}
+func (*EventCredentialIssued) ProtoMessage() {}
 
-// Deprecated: Use EventDIDUpdated.ProtoReflect.Descriptor instead.
-func (*EventDIDUpdated) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventCredentialIssued.ProtoReflect.Descriptor instead.
+func (*EventCredentialIssued) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EventDIDUpdated) GetDid() string {
+func (x *EventCredentialIssued) GetCredentialId() string {
 	if x != nil {
-		return x.Did
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *EventDIDUpdated) GetUpdater() string {
+func (x *EventCredentialIssued) GetIssuer() string {
 	if x != nil {
-		return x.Updater
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *EventDIDUpdated) GetFieldsUpdated() []string {
+func (x *EventCredentialIssued) GetSubject() string {
 	if x != nil {
-		return x.FieldsUpdated
+		return x.Subject
 	}
-	return nil
+	return ""
 }
 
-func (x *EventDIDUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *EventCredentialIssued) GetType_() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *EventCredentialIssued) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
 	}
 	return nil
 }
 
-func (x *EventDIDUpdated) GetBlockHeight() uint64 {
+func (x *EventCredentialIssued) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventDIDDeactivated is emitted when a DID is deactivated
-type EventDIDDeactivated struct {
+// EventCredentialRevoked is emitted when a credential is revoked
+type EventCredentialRevoked struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DID identifier
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Deactivator address
-	Deactivator string `protobuf:"bytes,2,opt,name=deactivator,proto3" json:"deactivator,omitempty"`
-	// Deactivation timestamp
-	DeactivatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	// Credential ID
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Revoker DID
+	Revoker string `protobuf:"bytes,2,opt,name=revoker,proto3" json:"revoker,omitempty"`
+	// Revocation reason
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Revocation timestamp
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventDIDDeactivated) Reset() {
-	*x = EventDIDDeactivated{}
+func (x *EventCredentialRevoked) Reset() {
+	*x = EventCredentialRevoked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[2]
+		mi := &file_did_v1_events_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventDIDDeactivated) String() string {
+func (x *EventCredentialRevoked) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventDIDDeactivated) ProtoMessage() {}
+func (*EventCredentialRevoked) ProtoMessage() {}
 
-// Deprecated: Use EventDIDDeactivated.ProtoReflect.Descriptor instead.
-func (*EventDIDDeactivated) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventCredentialRevoked.ProtoReflect.Descriptor instead.
+func (*EventCredentialRevoked) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EventDIDDeactivated) GetDid() string {
+func (x *EventCredentialRevoked) GetCredentialId() string {
 	if x != nil {
-		return x.Did
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *EventDIDDeactivated) GetDeactivator() string {
+func (x *EventCredentialRevoked) GetRevoker() string {
 	if x != nil {
-		return x.Deactivator
+		return x.Revoker
 	}
 	return ""
 }
 
-func (x *EventDIDDeactivated) GetDeactivatedAt() *timestamppb.Timestamp {
+func (x *EventCredentialRevoked) GetReason() string {
 	if x != nil {
-		return x.DeactivatedAt
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventCredentialRevoked) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
 	}
 	return nil
 }
 
-func (x *EventDIDDeactivated) GetBlockHeight() uint64 {
+func (x *EventCredentialRevoked) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventVerificationMethodAdded is emitted when a verification method is added
-type EventVerificationMethodAdded struct {
+// EventWebAuthnRegistered is emitted when a WebAuthn credential is registered
+type EventWebAuthnRegistered struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// DID identifier
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Method ID
-	MethodId string `protobuf:"bytes,2,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty"`
-	// Key type
-	KeyType string `protobuf:"bytes,3,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
-	// Public key (encoded)
-	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Attestation type
+	AttestationType string `protobuf:"bytes,3,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventVerificationMethodAdded) Reset() {
-	*x = EventVerificationMethodAdded{}
+func (x *EventWebAuthnRegistered) Reset() {
+	*x = EventWebAuthnRegistered{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[3]
+		mi := &file_did_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventVerificationMethodAdded) String() string {
+func (x *EventWebAuthnRegistered) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventVerificationMethodAdded) ProtoMessage() {}
+func (*EventWebAuthnRegistered) ProtoMessage() {}
 
-// Deprecated: Use EventVerificationMethodAdded.ProtoReflect.Descriptor instead.
-func (*EventVerificationMethodAdded) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventWebAuthnRegistered.ProtoReflect.Descriptor instead.
+func (*EventWebAuthnRegistered) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EventVerificationMethodAdded) GetDid() string {
+func (x *EventWebAuthnRegistered) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventVerificationMethodAdded) GetMethodId() string {
-	if x != nil {
-		return x.MethodId
-	}
-	return ""
-}
-
-func (x *EventVerificationMethodAdded) GetKeyType() string {
+func (x *EventWebAuthnRegistered) GetCredentialId() string {
 	if x != nil {
-		return x.KeyType
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *EventVerificationMethodAdded) GetPublicKey() string {
+func (x *EventWebAuthnRegistered) GetAttestationType() string {
 	if x != nil {
-		return x.PublicKey
+		return x.AttestationType
 	}
 	return ""
 }
 
-func (x *EventVerificationMethodAdded) GetBlockHeight() uint64 {
+func (x *EventWebAuthnRegistered) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventVerificationMethodRemoved is emitted when a verification method is removed
-type EventVerificationMethodRemoved struct {
+// EventExternalWalletLinked is emitted when an external wallet is linked
+type EventExternalWalletLinked struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// DID identifier
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Method ID
-	MethodId string `protobuf:"bytes,2,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty"`
+	// Wallet type (ethereum, bitcoin, etc.)
+	WalletType string `protobuf:"bytes,2,opt,name=wallet_type,json=walletType,proto3" json:"wallet_type,omitempty"`
+	// Wallet address
+	WalletAddress string `protobuf:"bytes,3,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventVerificationMethodRemoved) Reset() {
-	*x = EventVerificationMethodRemoved{}
+func (x *EventExternalWalletLinked) Reset() {
+	*x = EventExternalWalletLinked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[4]
+		mi := &file_did_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventVerificationMethodRemoved) String() string {
+func (x *EventExternalWalletLinked) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventVerificationMethodRemoved) ProtoMessage() {}
+func (*EventExternalWalletLinked) ProtoMessage() {}
 
-// Deprecated: Use EventVerificationMethodRemoved.ProtoReflect.Descriptor instead.
-func (*EventVerificationMethodRemoved) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EventExternalWalletLinked.ProtoReflect.Descriptor instead.
+func (*EventExternalWalletLinked) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EventVerificationMethodRemoved) GetDid() string {
+func (x *EventExternalWalletLinked) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventVerificationMethodRemoved) GetMethodId() string {
+func (x *EventExternalWalletLinked) GetWalletType() string {
 	if x != nil {
-		return x.MethodId
+		return x.WalletType
 	}
 	return ""
 }
 
-func (x *EventVerificationMethodRemoved) GetBlockHeight() uint64 {
+func (x *EventExternalWalletLinked) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *EventExternalWalletLinked) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventServiceAdded is emitted when a service is added to a DID
-type EventServiceAdded struct {
+// EventCredentialOriginUnverified is emitted when a WebAuthn credential's
+// origin is found to be no longer verified
+type EventCredentialOriginUnverified struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// DID identifier
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Service ID
-	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Service type
-	Type_ string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	// Service endpoint
-	Endpoint string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Origin the credential was registered at
+	Origin string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Why the origin failed verification
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the credential is refused until its origin is verified again
+	Downgraded bool `protobuf:"varint,5,opt,name=downgraded,proto3" json:"downgraded,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventServiceAdded) Reset() {
-	*x = EventServiceAdded{}
+func (x *EventCredentialOriginUnverified) Reset() {
+	*x = EventCredentialOriginUnverified{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[5]
+		mi := &file_did_v1_events_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventServiceAdded) String() string {
+func (x *EventCredentialOriginUnverified) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventServiceAdded) ProtoMessage() {}
+func (*EventCredentialOriginUnverified) ProtoMessage() {}
 
-// Deprecated: Use EventServiceAdded.ProtoReflect.Descriptor instead.
-func (*EventServiceAdded) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EventCredentialOriginUnverified.ProtoReflect.Descriptor instead.
+func (*EventCredentialOriginUnverified) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EventServiceAdded) GetDid() string {
+func (x *EventCredentialOriginUnverified) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventServiceAdded) GetServiceId() string {
+func (x *EventCredentialOriginUnverified) GetCredentialId() string {
 	if x != nil {
-		return x.ServiceId
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *EventServiceAdded) GetType_() string {
+func (x *EventCredentialOriginUnverified) GetOrigin() string {
 	if x != nil {
-		return x.Type_
+		return x.Origin
 	}
 	return ""
 }
 
-func (x *EventServiceAdded) GetEndpoint() string {
+func (x *EventCredentialOriginUnverified) GetReason() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *EventServiceAdded) GetBlockHeight() uint64 {
+func (x *EventCredentialOriginUnverified) GetDowngraded() bool {
+	if x != nil {
+		return x.Downgraded
+	}
+	return false
+}
+
+func (x *EventCredentialOriginUnverified) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventServiceRemoved is emitted when a service is removed from a DID
-type EventServiceRemoved struct {
+// EventCredentialOriginReverified is emitted when a flagged WebAuthn
+// credential's origin is verified again
+type EventCredentialOriginReverified struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// DID identifier
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Service ID
-	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Origin the credential was registered at
+	Origin string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventServiceRemoved) Reset() {
-	*x = EventServiceRemoved{}
+func (x *EventCredentialOriginReverified) Reset() {
+	*x = EventCredentialOriginReverified{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[6]
+		mi := &file_did_v1_events_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventServiceRemoved) String() string {
+func (x *EventCredentialOriginReverified) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventServiceRemoved) ProtoMessage() {}
+func (*EventCredentialOriginReverified) ProtoMessage() {}
 
-// Deprecated: Use EventServiceRemoved.ProtoReflect.Descriptor instead.
-func (*EventServiceRemoved) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EventCredentialOriginReverified.ProtoReflect.Descriptor instead.
+func (*EventCredentialOriginReverified) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *EventServiceRemoved) GetDid() string {
+func (x *EventCredentialOriginReverified) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventServiceRemoved) GetServiceId() string {
+func (x *EventCredentialOriginReverified) GetCredentialId() string {
 	if x != nil {
-		return x.ServiceId
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *EventServiceRemoved) GetBlockHeight() uint64 {
+func (x *EventCredentialOriginReverified) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *EventCredentialOriginReverified) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventCredentialIssued is emitted when a verifiable credential is issued
-type EventCredentialIssued struct {
+// EventDelegatedControllerAdded is emitted when control of a DID is delegated
+type EventDelegatedControllerAdded struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Credential ID
-	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
-	// Issuer DID
-	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// Subject DID
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	// Credential type
-	Type_ string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	// Issuance timestamp
-	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Delegated controller address
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// Controller that added the delegation
+	GrantedBy string `protobuf:"bytes,3,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	// What the delegation is for
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// When the delegation ends
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	// Block height
 	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventCredentialIssued) Reset() {
-	*x = EventCredentialIssued{}
+func (x *EventDelegatedControllerAdded) Reset() {
+	*x = EventDelegatedControllerAdded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[7]
+		mi := &file_did_v1_events_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCredentialIssued) String() string {
+func (x *EventDelegatedControllerAdded) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCredentialIssued) ProtoMessage() {}
+func (*EventDelegatedControllerAdded) ProtoMessage() {}
 
-// Deprecated: Use EventCredentialIssued.ProtoReflect.Descriptor instead.
-func (*EventCredentialIssued) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use EventDelegatedControllerAdded.ProtoReflect.Descriptor instead.
+func (*EventDelegatedControllerAdded) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EventCredentialIssued) GetCredentialId() string {
+func (x *EventDelegatedControllerAdded) GetDid() string {
 	if x != nil {
-		return x.CredentialId
+		return x.Did
 	}
 	return ""
 }
 
-func (x *EventCredentialIssued) GetIssuer() string {
+func (x *EventDelegatedControllerAdded) GetDelegate() string {
 	if x != nil {
-		return x.Issuer
+		return x.Delegate
 	}
 	return ""
 }
 
-func (x *EventCredentialIssued) GetSubject() string {
+func (x *EventDelegatedControllerAdded) GetGrantedBy() string {
 	if x != nil {
-		return x.Subject
+		return x.GrantedBy
 	}
 	return ""
 }
 
-func (x *EventCredentialIssued) GetType_() string {
+func (x *EventDelegatedControllerAdded) GetPurpose() string {
 	if x != nil {
-		return x.Type_
+		return x.Purpose
 	}
 	return ""
 }
 
-func (x *EventCredentialIssued) GetIssuedAt() *timestamppb.Timestamp {
+func (x *EventDelegatedControllerAdded) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IssuedAt
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *EventCredentialIssued) GetBlockHeight() uint64 {
+func (x *EventDelegatedControllerAdded) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventCredentialRevoked is emitted when a credential is revoked
-type EventCredentialRevoked struct {
+// EventDelegatedControllerRemoved is emitted when a delegation ends, either
+// removed by a controller or the delegate, or expired
+type EventDelegatedControllerRemoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Credential ID
-	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
-	// Revoker DID
-	Revoker string `protobuf:"bytes,2,opt,name=revoker,proto3" json:"revoker,omitempty"`
-	// Revocation reason
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	// Revocation timestamp
-	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Delegated controller address
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// Address that removed the delegation, empty when it expired
+	RemovedBy string `protobuf:"bytes,3,opt,name=removed_by,json=removedBy,proto3" json:"removed_by,omitempty"`
+	// Whether the delegation expired
+	Expired bool `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
 	// Block height
 	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventCredentialRevoked) Reset() {
-	*x = EventCredentialRevoked{}
+func (x *EventDelegatedControllerRemoved) Reset() {
+	*x = EventDelegatedControllerRemoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[8]
+		mi := &file_did_v1_events_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCredentialRevoked) String() string {
+func (x *EventDelegatedControllerRemoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCredentialRevoked) ProtoMessage() {}
+func (*EventDelegatedControllerRemoved) ProtoMessage() {}
 
-// Deprecated: Use EventCredentialRevoked.ProtoReflect.Descriptor instead.
-func (*EventCredentialRevoked) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EventDelegatedControllerRemoved.ProtoReflect.Descriptor instead.
+func (*EventDelegatedControllerRemoved) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *EventCredentialRevoked) GetCredentialId() string {
+func (x *EventDelegatedControllerRemoved) GetDid() string {
 	if x != nil {
-		return x.CredentialId
+		return x.Did
 	}
 	return ""
 }
 
-func (x *EventCredentialRevoked) GetRevoker() string {
+func (x *EventDelegatedControllerRemoved) GetDelegate() string {
 	if x != nil {
-		return x.Revoker
+		return x.Delegate
 	}
 	return ""
 }
 
-func (x *EventCredentialRevoked) GetReason() string {
+func (x *EventDelegatedControllerRemoved) GetRemovedBy() string {
 	if x != nil {
-		return x.Reason
+		return x.RemovedBy
 	}
 	return ""
 }
 
-func (x *EventCredentialRevoked) GetRevokedAt() *timestamppb.Timestamp {
+func (x *EventDelegatedControllerRemoved) GetExpired() bool {
 	if x != nil {
-		return x.RevokedAt
+		return x.Expired
 	}
-	return nil
+	return false
 }
 
-func (x *EventCredentialRevoked) GetBlockHeight() uint64 {
+func (x *EventDelegatedControllerRemoved) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventWebAuthnRegistered is emitted when a WebAuthn credential is registered
-type EventWebAuthnRegistered struct {
+// EventNFTAttached is emitted when a token is attached to a DID's profile
+type EventNFTAttached struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// DID identifier
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// WebAuthn credential ID
-	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
-	// Attestation type
-	AttestationType string `protobuf:"bytes,3,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
+	// Token class
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// Token ID
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// Controller that owns the token
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Whether the token was received over ICS-721
+	Interchain bool `protobuf:"varint,5,opt,name=interchain,proto3" json:"interchain,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventWebAuthnRegistered) Reset() {
-	*x = EventWebAuthnRegistered{}
+func (x *EventNFTAttached) Reset() {
+	*x = EventNFTAttached{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[9]
+		mi := &file_did_v1_events_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventWebAuthnRegistered) String() string {
+func (x *EventNFTAttached) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventWebAuthnRegistered) ProtoMessage() {}
+func (*EventNFTAttached) ProtoMessage() {}
 
-// Deprecated: Use EventWebAuthnRegistered.ProtoReflect.Descriptor instead.
-func (*EventWebAuthnRegistered) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EventNFTAttached.ProtoReflect.Descriptor instead.
+func (*EventNFTAttached) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EventWebAuthnRegistered) GetDid() string {
+func (x *EventNFTAttached) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventWebAuthnRegistered) GetCredentialId() string {
+func (x *EventNFTAttached) GetClassId() string {
 	if x != nil {
-		return x.CredentialId
+		return x.ClassId
 	}
 	return ""
 }
 
-func (x *EventWebAuthnRegistered) GetAttestationType() string {
+func (x *EventNFTAttached) GetNftId() string {
 	if x != nil {
-		return x.AttestationType
+		return x.NftId
 	}
 	return ""
 }
 
-func (x *EventWebAuthnRegistered) GetBlockHeight() uint64 {
+func (x *EventNFTAttached) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventNFTAttached) GetInterchain() bool {
+	if x != nil {
+		return x.Interchain
+	}
+	return false
+}
+
+func (x *EventNFTAttached) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-// EventExternalWalletLinked is emitted when an external wallet is linked
-type EventExternalWalletLinked struct {
+// EventNFTDetached is emitted when a token is removed from a DID's profile
+type EventNFTDetached struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// DID identifier
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// Wallet type (ethereum, bitcoin, etc.)
-	WalletType string `protobuf:"bytes,2,opt,name=wallet_type,json=walletType,proto3" json:"wallet_type,omitempty"`
-	// Wallet address
-	WalletAddress string `protobuf:"bytes,3,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// Token class
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// Token ID
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// Address that removed the token
+	RemovedBy string `protobuf:"bytes,4,opt,name=removed_by,json=removedBy,proto3" json:"removed_by,omitempty"`
 	// Block height
-	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *EventExternalWalletLinked) Reset() {
-	*x = EventExternalWalletLinked{}
+func (x *EventNFTDetached) Reset() {
+	*x = EventNFTDetached{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_events_proto_msgTypes[10]
+		mi := &file_did_v1_events_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventExternalWalletLinked) String() string {
+func (x *EventNFTDetached) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventExternalWalletLinked) ProtoMessage() {}
+func (*EventNFTDetached) ProtoMessage() {}
 
-// Deprecated: Use EventExternalWalletLinked.ProtoReflect.Descriptor instead.
-func (*EventExternalWalletLinked) Descriptor() ([]byte, []int) {
-	return file_did_v1_events_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use EventNFTDetached.ProtoReflect.Descriptor instead.
+func (*EventNFTDetached) Descriptor() ([]byte, []int) {
+	return file_did_v1_events_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *EventExternalWalletLinked) GetDid() string {
+func (x *EventNFTDetached) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *EventExternalWalletLinked) GetWalletType() string {
+func (x *EventNFTDetached) GetClassId() string {
 	if x != nil {
-		return x.WalletType
+		return x.ClassId
 	}
 	return ""
 }
 
-func (x *EventExternalWalletLinked) GetWalletAddress() string {
+func (x *EventNFTDetached) GetNftId() string {
 	if x != nil {
-		return x.WalletAddress
+		return x.NftId
 	}
 	return ""
 }
 
-func (x *EventExternalWalletLinked) GetBlockHeight() uint64 {
+func (x *EventNFTDetached) GetRemovedBy() string {
+	if x != nil {
+		return x.RemovedBy
+	}
+	return ""
+}
+
+func (x *EventNFTDetached) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
@@ -8027,98 +13234,178 @@ var file_did_v1_events_proto_rawDesc = []byte{
 	0x1f, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x41,
 	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
 	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x49,
+	0x44, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x4b, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xaa, 0x01, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x72, 0x0a,
+	0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x72, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x69, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x15, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x73,
-	0x73, 0x75, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x41, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x74, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x69, 0x0a, 0x13, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64,
 	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
-	0x01, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x9e, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68,
-	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xd7, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x17,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x98, 0x01, 0x0a,
+	0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x1f, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x23, 0x0a,
 	0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x22, 0x98, 0x01, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x1f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x7c, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e,
-	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x64,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x69, 0x64, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x69, 0x64,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x1d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72,
+	0x70, 0x6f, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xab, 0x01, 0x0a,
+	0x1f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x10, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x46, 0x54, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x98, 0x01, 0x0a,
+	0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x46, 0x54, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x15,
+	0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x66, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x7c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06,
+	0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69,
+	0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8133,32 +13420,41 @@ func file_did_v1_events_proto_rawDescGZIP() []byte {
 	return file_did_v1_events_proto_rawDescData
 }
 
-var file_did_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_did_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_did_v1_events_proto_goTypes = []interface{}{
-	(*EventDIDCreated)(nil),                // 0: did.v1.EventDIDCreated
-	(*EventDIDUpdated)(nil),                // 1: did.v1.EventDIDUpdated
-	(*EventDIDDeactivated)(nil),            // 2: did.v1.EventDIDDeactivated
-	(*EventVerificationMethodAdded)(nil),   // 3: did.v1.EventVerificationMethodAdded
-	(*EventVerificationMethodRemoved)(nil), // 4: did.v1.EventVerificationMethodRemoved
-	(*EventServiceAdded)(nil),              // 5: did.v1.EventServiceAdded
-	(*EventServiceRemoved)(nil),            // 6: did.v1.EventServiceRemoved
-	(*EventCredentialIssued)(nil),          // 7: did.v1.EventCredentialIssued
-	(*EventCredentialRevoked)(nil),         // 8: did.v1.EventCredentialRevoked
-	(*EventWebAuthnRegistered)(nil),        // 9: did.v1.EventWebAuthnRegistered
-	(*EventExternalWalletLinked)(nil),      // 10: did.v1.EventExternalWalletLinked
-	(*timestamppb.Timestamp)(nil),          // 11: google.protobuf.Timestamp
+	(*EventDIDCreated)(nil),                 // 0: did.v1.EventDIDCreated
+	(*EventDIDUpdated)(nil),                 // 1: did.v1.EventDIDUpdated
+	(*EventDIDDeactivated)(nil),             // 2: did.v1.EventDIDDeactivated
+	(*EventDIDReactivated)(nil),             // 3: did.v1.EventDIDReactivated
+	(*EventVerificationMethodAdded)(nil),    // 4: did.v1.EventVerificationMethodAdded
+	(*EventVerificationMethodRemoved)(nil),  // 5: did.v1.EventVerificationMethodRemoved
+	(*EventServiceAdded)(nil),               // 6: did.v1.EventServiceAdded
+	(*EventServiceRemoved)(nil),             // 7: did.v1.EventServiceRemoved
+	(*EventCredentialIssued)(nil),           // 8: did.v1.EventCredentialIssued
+	(*EventCredentialRevoked)(nil),          // 9: did.v1.EventCredentialRevoked
+	(*EventWebAuthnRegistered)(nil),         // 10: did.v1.EventWebAuthnRegistered
+	(*EventExternalWalletLinked)(nil),       // 11: did.v1.EventExternalWalletLinked
+	(*EventCredentialOriginUnverified)(nil), // 12: did.v1.EventCredentialOriginUnverified
+	(*EventCredentialOriginReverified)(nil), // 13: did.v1.EventCredentialOriginReverified
+	(*EventDelegatedControllerAdded)(nil),   // 14: did.v1.EventDelegatedControllerAdded
+	(*EventDelegatedControllerRemoved)(nil), // 15: did.v1.EventDelegatedControllerRemoved
+	(*EventNFTAttached)(nil),                // 16: did.v1.EventNFTAttached
+	(*EventNFTDetached)(nil),                // 17: did.v1.EventNFTDetached
+	(*timestamppb.Timestamp)(nil),           // 18: google.protobuf.Timestamp
 }
 var file_did_v1_events_proto_depIdxs = []int32{
-	11, // 0: did.v1.EventDIDCreated.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: did.v1.EventDIDUpdated.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 2: did.v1.EventDIDDeactivated.deactivated_at:type_name -> google.protobuf.Timestamp
-	11, // 3: did.v1.EventCredentialIssued.issued_at:type_name -> google.protobuf.Timestamp
-	11, // 4: did.v1.EventCredentialRevoked.revoked_at:type_name -> google.protobuf.Timestamp
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	18, // 0: did.v1.EventDIDCreated.created_at:type_name -> google.protobuf.Timestamp
+	18, // 1: did.v1.EventDIDUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 2: did.v1.EventDIDDeactivated.deactivated_at:type_name -> google.protobuf.Timestamp
+	18, // 3: did.v1.EventDIDReactivated.reactivated_at:type_name -> google.protobuf.Timestamp
+	18, // 4: did.v1.EventCredentialIssued.issued_at:type_name -> google.protobuf.Timestamp
+	18, // 5: did.v1.EventCredentialRevoked.revoked_at:type_name -> google.protobuf.Timestamp
+	18, // 6: did.v1.EventDelegatedControllerAdded.expires_at:type_name -> google.protobuf.Timestamp
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_did_v1_events_proto_init() }
@@ -8204,7 +13500,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventVerificationMethodAdded); i {
+			switch v := v.(*EventDIDReactivated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8216,7 +13512,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventVerificationMethodRemoved); i {
+			switch v := v.(*EventVerificationMethodAdded); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8228,7 +13524,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventServiceAdded); i {
+			switch v := v.(*EventVerificationMethodRemoved); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8240,7 +13536,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventServiceRemoved); i {
+			switch v := v.(*EventServiceAdded); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8252,7 +13548,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventCredentialIssued); i {
+			switch v := v.(*EventServiceRemoved); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8264,7 +13560,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventCredentialRevoked); i {
+			switch v := v.(*EventCredentialIssued); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8276,7 +13572,7 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventWebAuthnRegistered); i {
+			switch v := v.(*EventCredentialRevoked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8288,6 +13584,18 @@ func file_did_v1_events_proto_init() {
 			}
 		}
 		file_did_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventWebAuthnRegistered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventExternalWalletLinked); i {
 			case 0:
 				return &v.state
@@ -8299,6 +13607,78 @@ func file_did_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_did_v1_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCredentialOriginUnverified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCredentialOriginReverified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDelegatedControllerAdded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDelegatedControllerRemoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventNFTAttached); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventNFTDetached); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8306,7 +13686,7 @@ func file_did_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_did_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1209,6 +1209,8 @@ var (
 	fd_DocumentParams_supported_authentication_methods protoreflect.FieldDescriptor
 	fd_DocumentParams_supported_invocation_methods     protoreflect.FieldDescriptor
 	fd_DocumentParams_supported_delegation_methods     protoreflect.FieldDescriptor
+	fd_DocumentParams_max_delegation_duration          protoreflect.FieldDescriptor
+	fd_DocumentParams_max_delegated_controllers        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1226,6 +1228,8 @@ func init() {
 	fd_DocumentParams_supported_authentication_methods = md_DocumentParams.Fields().ByName("supported_authentication_methods")
 	fd_DocumentParams_supported_invocation_methods = md_DocumentParams.Fields().ByName("supported_invocation_methods")
 	fd_DocumentParams_supported_delegation_methods = md_DocumentParams.Fields().ByName("supported_delegation_methods")
+	fd_DocumentParams_max_delegation_duration = md_DocumentParams.Fields().ByName("max_delegation_duration")
+	fd_DocumentParams_max_delegated_controllers = md_DocumentParams.Fields().ByName("max_delegated_controllers")
 }
 
 var _ protoreflect.Message = (*fastReflection_DocumentParams)(nil)
@@ -1365,6 +1369,18 @@ func (x *fastReflection_DocumentParams) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.MaxDelegationDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxDelegationDuration)
+		if !f(fd_DocumentParams_max_delegation_duration, value) {
+			return
+		}
+	}
+	if x.MaxDelegatedControllers != int32(0) {
+		value := protoreflect.ValueOfInt32(x.MaxDelegatedControllers)
+		if !f(fd_DocumentParams_max_delegated_controllers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1404,6 +1420,10 @@ func (x *fastReflection_DocumentParams) Has(fd protoreflect.FieldDescriptor) boo
 		return len(x.SupportedInvocationMethods) != 0
 	case "did.v1.DocumentParams.supported_delegation_methods":
 		return len(x.SupportedDelegationMethods) != 0
+	case "did.v1.DocumentParams.max_delegation_duration":
+		return x.MaxDelegationDuration != int64(0)
+	case "did.v1.DocumentParams.max_delegated_controllers":
+		return x.MaxDelegatedControllers != int32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DocumentParams"))
@@ -1444,6 +1464,10 @@ func (x *fastReflection_DocumentParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.SupportedInvocationMethods = nil
 	case "did.v1.DocumentParams.supported_delegation_methods":
 		x.SupportedDelegationMethods = nil
+	case "did.v1.DocumentParams.max_delegation_duration":
+		x.MaxDelegationDuration = int64(0)
+	case "did.v1.DocumentParams.max_delegated_controllers":
+		x.MaxDelegatedControllers = int32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DocumentParams"))
@@ -1508,6 +1532,12 @@ func (x *fastReflection_DocumentParams) Get(descriptor protoreflect.FieldDescrip
 		}
 		listValue := &_DocumentParams_12_list{list: &x.SupportedDelegationMethods}
 		return protoreflect.ValueOfList(listValue)
+	case "did.v1.DocumentParams.max_delegation_duration":
+		value := x.MaxDelegationDuration
+		return protoreflect.ValueOfInt64(value)
+	case "did.v1.DocumentParams.max_delegated_controllers":
+		value := x.MaxDelegatedControllers
+		return protoreflect.ValueOfInt32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DocumentParams"))
@@ -1560,6 +1590,10 @@ func (x *fastReflection_DocumentParams) Set(fd protoreflect.FieldDescriptor, val
 		lv := value.List()
 		clv := lv.(*_DocumentParams_12_list)
 		x.SupportedDelegationMethods = *clv.list
+	case "did.v1.DocumentParams.max_delegation_duration":
+		x.MaxDelegationDuration = value.Int()
+	case "did.v1.DocumentParams.max_delegated_controllers":
+		x.MaxDelegatedControllers = int32(value.Int())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DocumentParams"))
@@ -1620,6 +1654,10 @@ func (x *fastReflection_DocumentParams) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field key_rotation_interval of message did.v1.DocumentParams is not mutable"))
 	case "did.v1.DocumentParams.credential_lifetime":
 		panic(fmt.Errorf("field credential_lifetime of message did.v1.DocumentParams is not mutable"))
+	case "did.v1.DocumentParams.max_delegation_duration":
+		panic(fmt.Errorf("field max_delegation_duration of message did.v1.DocumentParams is not mutable"))
+	case "did.v1.DocumentParams.max_delegated_controllers":
+		panic(fmt.Errorf("field max_delegated_controllers of message did.v1.DocumentParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DocumentParams"))
@@ -1661,6 +1699,10 @@ func (x *fastReflection_DocumentParams) NewField(fd protoreflect.FieldDescriptor
 	case "did.v1.DocumentParams.supported_delegation_methods":
 		list := []string{}
 		return protoreflect.ValueOfList(&_DocumentParams_12_list{list: &list})
+	case "did.v1.DocumentParams.max_delegation_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "did.v1.DocumentParams.max_delegated_controllers":
+		return protoreflect.ValueOfInt32(int32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DocumentParams"))
@@ -1778,6 +1820,12 @@ func (x *fastReflection_DocumentParams) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.MaxDelegationDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDelegationDuration))
+		}
+		if x.MaxDelegatedControllers != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDelegatedControllers))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1807,6 +1855,16 @@ func (x *fastReflection_DocumentParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxDelegatedControllers != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelegatedControllers))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MaxDelegationDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelegationDuration))
+			i--
+			dAtA[i] = 0x68
+		}
 		if len(x.SupportedDelegationMethods) > 0 {
 			for iNdEx := len(x.SupportedDelegationMethods) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.SupportedDelegationMethods[iNdEx])
@@ -2218,6 +2276,44 @@ func (x *fastReflection_DocumentParams) ProtoMethods() *protoiface.Methods {
 				}
 				x.SupportedDelegationMethods = append(x.SupportedDelegationMethods, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationDuration", wireType)
+				}
+				x.MaxDelegationDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDelegationDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedControllers", wireType)
+				}
+				x.MaxDelegatedControllers = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDelegatedControllers |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2346,14 +2442,17 @@ func (x *_WebauthnParams_3_list) IsValid() bool {
 }
 
 var (
-	md_WebauthnParams                           protoreflect.MessageDescriptor
-	fd_WebauthnParams_challenge_timeout         protoreflect.FieldDescriptor
-	fd_WebauthnParams_allowed_origins           protoreflect.FieldDescriptor
-	fd_WebauthnParams_supported_algorithms      protoreflect.FieldDescriptor
-	fd_WebauthnParams_require_user_verification protoreflect.FieldDescriptor
-	fd_WebauthnParams_max_credentials_per_did   protoreflect.FieldDescriptor
-	fd_WebauthnParams_default_rp_id             protoreflect.FieldDescriptor
-	fd_WebauthnParams_default_rp_name           protoreflect.FieldDescriptor
+	md_WebauthnParams                              protoreflect.MessageDescriptor
+	fd_WebauthnParams_challenge_timeout            protoreflect.FieldDescriptor
+	fd_WebauthnParams_allowed_origins              protoreflect.FieldDescriptor
+	fd_WebauthnParams_supported_algorithms         protoreflect.FieldDescriptor
+	fd_WebauthnParams_require_user_verification    protoreflect.FieldDescriptor
+	fd_WebauthnParams_max_credentials_per_did      protoreflect.FieldDescriptor
+	fd_WebauthnParams_default_rp_id                protoreflect.FieldDescriptor
+	fd_WebauthnParams_default_rp_name              protoreflect.FieldDescriptor
+	fd_WebauthnParams_origin_check_interval        protoreflect.FieldDescriptor
+	fd_WebauthnParams_origin_check_batch_size      protoreflect.FieldDescriptor
+	fd_WebauthnParams_downgrade_unverified_origins protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2366,6 +2465,9 @@ func init() {
 	fd_WebauthnParams_max_credentials_per_did = md_WebauthnParams.Fields().ByName("max_credentials_per_did")
 	fd_WebauthnParams_default_rp_id = md_WebauthnParams.Fields().ByName("default_rp_id")
 	fd_WebauthnParams_default_rp_name = md_WebauthnParams.Fields().ByName("default_rp_name")
+	fd_WebauthnParams_origin_check_interval = md_WebauthnParams.Fields().ByName("origin_check_interval")
+	fd_WebauthnParams_origin_check_batch_size = md_WebauthnParams.Fields().ByName("origin_check_batch_size")
+	fd_WebauthnParams_downgrade_unverified_origins = md_WebauthnParams.Fields().ByName("downgrade_unverified_origins")
 }
 
 var _ protoreflect.Message = (*fastReflection_WebauthnParams)(nil)
@@ -2475,6 +2577,24 @@ func (x *fastReflection_WebauthnParams) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.OriginCheckInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OriginCheckInterval)
+		if !f(fd_WebauthnParams_origin_check_interval, value) {
+			return
+		}
+	}
+	if x.OriginCheckBatchSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OriginCheckBatchSize)
+		if !f(fd_WebauthnParams_origin_check_batch_size, value) {
+			return
+		}
+	}
+	if x.DowngradeUnverifiedOrigins != false {
+		value := protoreflect.ValueOfBool(x.DowngradeUnverifiedOrigins)
+		if !f(fd_WebauthnParams_downgrade_unverified_origins, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2504,6 +2624,12 @@ func (x *fastReflection_WebauthnParams) Has(fd protoreflect.FieldDescriptor) boo
 		return x.DefaultRpId != ""
 	case "did.v1.WebauthnParams.default_rp_name":
 		return x.DefaultRpName != ""
+	case "did.v1.WebauthnParams.origin_check_interval":
+		return x.OriginCheckInterval != int64(0)
+	case "did.v1.WebauthnParams.origin_check_batch_size":
+		return x.OriginCheckBatchSize != uint32(0)
+	case "did.v1.WebauthnParams.downgrade_unverified_origins":
+		return x.DowngradeUnverifiedOrigins != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.WebauthnParams"))
@@ -2534,6 +2660,12 @@ func (x *fastReflection_WebauthnParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.DefaultRpId = ""
 	case "did.v1.WebauthnParams.default_rp_name":
 		x.DefaultRpName = ""
+	case "did.v1.WebauthnParams.origin_check_interval":
+		x.OriginCheckInterval = int64(0)
+	case "did.v1.WebauthnParams.origin_check_batch_size":
+		x.OriginCheckBatchSize = uint32(0)
+	case "did.v1.WebauthnParams.downgrade_unverified_origins":
+		x.DowngradeUnverifiedOrigins = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.WebauthnParams"))
@@ -2577,6 +2709,15 @@ func (x *fastReflection_WebauthnParams) Get(descriptor protoreflect.FieldDescrip
 	case "did.v1.WebauthnParams.default_rp_name":
 		value := x.DefaultRpName
 		return protoreflect.ValueOfString(value)
+	case "did.v1.WebauthnParams.origin_check_interval":
+		value := x.OriginCheckInterval
+		return protoreflect.ValueOfInt64(value)
+	case "did.v1.WebauthnParams.origin_check_batch_size":
+		value := x.OriginCheckBatchSize
+		return protoreflect.ValueOfUint32(value)
+	case "did.v1.WebauthnParams.downgrade_unverified_origins":
+		value := x.DowngradeUnverifiedOrigins
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.WebauthnParams"))
@@ -2615,6 +2756,12 @@ func (x *fastReflection_WebauthnParams) Set(fd protoreflect.FieldDescriptor, val
 		x.DefaultRpId = value.Interface().(string)
 	case "did.v1.WebauthnParams.default_rp_name":
 		x.DefaultRpName = value.Interface().(string)
+	case "did.v1.WebauthnParams.origin_check_interval":
+		x.OriginCheckInterval = value.Int()
+	case "did.v1.WebauthnParams.origin_check_batch_size":
+		x.OriginCheckBatchSize = uint32(value.Uint())
+	case "did.v1.WebauthnParams.downgrade_unverified_origins":
+		x.DowngradeUnverifiedOrigins = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.WebauthnParams"))
@@ -2657,6 +2804,12 @@ func (x *fastReflection_WebauthnParams) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field default_rp_id of message did.v1.WebauthnParams is not mutable"))
 	case "did.v1.WebauthnParams.default_rp_name":
 		panic(fmt.Errorf("field default_rp_name of message did.v1.WebauthnParams is not mutable"))
+	case "did.v1.WebauthnParams.origin_check_interval":
+		panic(fmt.Errorf("field origin_check_interval of message did.v1.WebauthnParams is not mutable"))
+	case "did.v1.WebauthnParams.origin_check_batch_size":
+		panic(fmt.Errorf("field origin_check_batch_size of message did.v1.WebauthnParams is not mutable"))
+	case "did.v1.WebauthnParams.downgrade_unverified_origins":
+		panic(fmt.Errorf("field downgrade_unverified_origins of message did.v1.WebauthnParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.WebauthnParams"))
@@ -2686,6 +2839,12 @@ func (x *fastReflection_WebauthnParams) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "did.v1.WebauthnParams.default_rp_name":
 		return protoreflect.ValueOfString("")
+	case "did.v1.WebauthnParams.origin_check_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "did.v1.WebauthnParams.origin_check_batch_size":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "did.v1.WebauthnParams.downgrade_unverified_origins":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.WebauthnParams"))
@@ -2784,6 +2943,15 @@ func (x *fastReflection_WebauthnParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.OriginCheckInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.OriginCheckInterval))
+		}
+		if x.OriginCheckBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.OriginCheckBatchSize))
+		}
+		if x.DowngradeUnverifiedOrigins {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2813,6 +2981,26 @@ func (x *fastReflection_WebauthnParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DowngradeUnverifiedOrigins {
+			i--
+			if x.DowngradeUnverifiedOrigins {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.OriginCheckBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OriginCheckBatchSize))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.OriginCheckInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OriginCheckInterval))
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.DefaultRpName) > 0 {
 			i -= len(x.DefaultRpName)
 			copy(dAtA[i:], x.DefaultRpName)
@@ -3100,6 +3288,64 @@ func (x *fastReflection_WebauthnParams) ProtoMethods() *protoiface.Methods {
 				}
 				x.DefaultRpName = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginCheckInterval", wireType)
+				}
+				x.OriginCheckInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OriginCheckInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginCheckBatchSize", wireType)
+				}
+				x.OriginCheckBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OriginCheckBatchSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowngradeUnverifiedOrigins", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DowngradeUnverifiedOrigins = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3268,6 +3514,12 @@ type DocumentParams struct {
 	SupportedInvocationMethods []string `protobuf:"bytes,11,rep,name=supported_invocation_methods,json=supportedInvocationMethods,proto3" json:"supported_invocation_methods,omitempty"`
 	// Supported Delegation methods
 	SupportedDelegationMethods []string `protobuf:"bytes,12,rep,name=supported_delegation_methods,json=supportedDelegationMethods,proto3" json:"supported_delegation_methods,omitempty"`
+	// MaxDelegationDuration is the longest a delegated controller may be
+	// granted for, in seconds; zero leaves it unbounded
+	MaxDelegationDuration int64 `protobuf:"varint,13,opt,name=max_delegation_duration,json=maxDelegationDuration,proto3" json:"max_delegation_duration,omitempty"`
+	// MaxDelegatedControllers limits the number of active delegated
+	// controllers per DID; zero disables delegation
+	MaxDelegatedControllers int32 `protobuf:"varint,14,opt,name=max_delegated_controllers,json=maxDelegatedControllers,proto3" json:"max_delegated_controllers,omitempty"`
 }
 
 func (x *DocumentParams) Reset() {
@@ -3374,6 +3626,20 @@ func (x *DocumentParams) GetSupportedDelegationMethods() []string {
 	return nil
 }
 
+func (x *DocumentParams) GetMaxDelegationDuration() int64 {
+	if x != nil {
+		return x.MaxDelegationDuration
+	}
+	return 0
+}
+
+func (x *DocumentParams) GetMaxDelegatedControllers() int32 {
+	if x != nil {
+		return x.MaxDelegatedControllers
+	}
+	return 0
+}
+
 // WebauthnParams defines the parameters for the WebAuthn module.
 type WebauthnParams struct {
 	state         protoimpl.MessageState
@@ -3394,6 +3660,14 @@ type WebauthnParams struct {
 	DefaultRpId string `protobuf:"bytes,6,opt,name=default_rp_id,json=defaultRpId,proto3" json:"default_rp_id,omitempty"`
 	// DefaultRPName is the default Relying Party name for WebAuthn operations
 	DefaultRpName string `protobuf:"bytes,7,opt,name=default_rp_name,json=defaultRpName,proto3" json:"default_rp_name,omitempty"`
+	// OriginCheckInterval is how many blocks apart the origins of stored
+	// WebAuthn credentials are re-checked; zero disables the check
+	OriginCheckInterval int64 `protobuf:"varint,8,opt,name=origin_check_interval,json=originCheckInterval,proto3" json:"origin_check_interval,omitempty"`
+	// OriginCheckBatchSize is the number of DID documents checked per run
+	OriginCheckBatchSize uint32 `protobuf:"varint,9,opt,name=origin_check_batch_size,json=originCheckBatchSize,proto3" json:"origin_check_batch_size,omitempty"`
+	// DowngradeUnverifiedOrigins refuses credentials whose origin is no longer
+	// verified until the origin is verified again
+	DowngradeUnverifiedOrigins bool `protobuf:"varint,10,opt,name=downgrade_unverified_origins,json=downgradeUnverifiedOrigins,proto3" json:"downgrade_unverified_origins,omitempty"`
 }
 
 func (x *WebauthnParams) Reset() {
@@ -3465,6 +3739,27 @@ func (x *WebauthnParams) GetDefaultRpName() string {
 	return ""
 }
 
+func (x *WebauthnParams) GetOriginCheckInterval() int64 {
+	if x != nil {
+		return x.OriginCheckInterval
+	}
+	return 0
+}
+
+func (x *WebauthnParams) GetOriginCheckBatchSize() uint32 {
+	if x != nil {
+		return x.OriginCheckBatchSize
+	}
+	return 0
+}
+
+func (x *WebauthnParams) GetDowngradeUnverifiedOrigins() bool {
+	if x != nil {
+		return x.DowngradeUnverifiedOrigins
+	}
+	return false
+}
+
 var File_did_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_did_v1_genesis_proto_rawDesc = []byte{
@@ -3487,7 +3782,7 @@ var file_did_v1_genesis_proto_rawDesc = []byte{
 	0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x50,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x08, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x3a,
 	0x17, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0a, 0x64, 0x69,
-	0x64, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb5, 0x05, 0x0a, 0x0e, 0x44, 0x6f, 0x63,
+	0x64, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa9, 0x06, 0x0a, 0x0e, 0x44, 0x6f, 0x63,
 	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61,
 	0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x72, 0x65, 0x61,
@@ -3530,38 +3825,56 @@ var file_did_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1a,
 	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x22, 0xde, 0x02, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x19,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f,
-	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x64, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x44, 0x69, 0x64, 0x12,
-	0x22, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
-	0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72,
-	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x42, 0x7d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72,
-	0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64,
-	0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa,
-	0x02, 0x06, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8b, 0x04, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a,
+	0x14, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73,
+	0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x17,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d,
+	0x61, 0x78, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x50, 0x65, 0x72,
+	0x44, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x72, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x6f,
+	0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1a, 0x64, 0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x42, 0x7d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e,
+	0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69,
+	0x64, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58,
+	0xaa, 0x02, 0x06, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
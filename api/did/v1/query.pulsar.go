@@ -2676,25 +2676,29 @@ func (x *fastReflection_QueryGetDIDDocumentResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_QueryListDIDDocumentsRequest            protoreflect.MessageDescriptor
-	fd_QueryListDIDDocumentsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGetDIDDocumentVersionRequest              protoreflect.MessageDescriptor
+	fd_QueryGetDIDDocumentVersionRequest_did          protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentVersionRequest_version_id   protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentVersionRequest_version_time protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryListDIDDocumentsRequest = File_did_v1_query_proto.Messages().ByName("QueryListDIDDocumentsRequest")
-	fd_QueryListDIDDocumentsRequest_pagination = md_QueryListDIDDocumentsRequest.Fields().ByName("pagination")
+	md_QueryGetDIDDocumentVersionRequest = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentVersionRequest")
+	fd_QueryGetDIDDocumentVersionRequest_did = md_QueryGetDIDDocumentVersionRequest.Fields().ByName("did")
+	fd_QueryGetDIDDocumentVersionRequest_version_id = md_QueryGetDIDDocumentVersionRequest.Fields().ByName("version_id")
+	fd_QueryGetDIDDocumentVersionRequest_version_time = md_QueryGetDIDDocumentVersionRequest.Fields().ByName("version_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryListDIDDocumentsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentVersionRequest)(nil)
 
-type fastReflection_QueryListDIDDocumentsRequest QueryListDIDDocumentsRequest
+type fastReflection_QueryGetDIDDocumentVersionRequest QueryGetDIDDocumentVersionRequest
 
-func (x *QueryListDIDDocumentsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryListDIDDocumentsRequest)(x)
+func (x *QueryGetDIDDocumentVersionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentVersionRequest)(x)
 }
 
-func (x *QueryListDIDDocumentsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDDocumentVersionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2706,43 +2710,43 @@ func (x *QueryListDIDDocumentsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryListDIDDocumentsRequest_messageType fastReflection_QueryListDIDDocumentsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryListDIDDocumentsRequest_messageType{}
+var _fastReflection_QueryGetDIDDocumentVersionRequest_messageType fastReflection_QueryGetDIDDocumentVersionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentVersionRequest_messageType{}
 
-type fastReflection_QueryListDIDDocumentsRequest_messageType struct{}
+type fastReflection_QueryGetDIDDocumentVersionRequest_messageType struct{}
 
-func (x fastReflection_QueryListDIDDocumentsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryListDIDDocumentsRequest)(nil)
+func (x fastReflection_QueryGetDIDDocumentVersionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentVersionRequest)(nil)
 }
-func (x fastReflection_QueryListDIDDocumentsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryListDIDDocumentsRequest)
+func (x fastReflection_QueryGetDIDDocumentVersionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentVersionRequest)
 }
-func (x fastReflection_QueryListDIDDocumentsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListDIDDocumentsRequest
+func (x fastReflection_QueryGetDIDDocumentVersionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentVersionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListDIDDocumentsRequest
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentVersionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryListDIDDocumentsRequest_messageType
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDDocumentVersionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryListDIDDocumentsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryListDIDDocumentsRequest)
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentVersionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryListDIDDocumentsRequest)(x)
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDDocumentVersionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2750,10 +2754,22 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryListDIDDocumentsRequest_pagination, value) {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_QueryGetDIDDocumentVersionRequest_did, value) {
+			return
+		}
+	}
+	if x.VersionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VersionId)
+		if !f(fd_QueryGetDIDDocumentVersionRequest_version_id, value) {
+			return
+		}
+	}
+	if x.VersionTime != "" {
+		value := protoreflect.ValueOfString(x.VersionTime)
+		if !f(fd_QueryGetDIDDocumentVersionRequest_version_time, value) {
 			return
 		}
 	}
@@ -2770,15 +2786,19 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsRequest.pagination":
-		return x.Pagination != nil
+	case "did.v1.QueryGetDIDDocumentVersionRequest.did":
+		return x.Did != ""
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_id":
+		return x.VersionId != uint64(0)
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_time":
+		return x.VersionTime != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2788,15 +2808,19 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsRequest.pagination":
-		x.Pagination = nil
+	case "did.v1.QueryGetDIDDocumentVersionRequest.did":
+		x.Did = ""
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_id":
+		x.VersionId = uint64(0)
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_time":
+		x.VersionTime = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2806,16 +2830,22 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryListDIDDocumentsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentVersionRequest.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfUint64(value)
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_time":
+		value := x.VersionTime
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2829,15 +2859,19 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "did.v1.QueryGetDIDDocumentVersionRequest.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_id":
+		x.VersionId = value.Uint()
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_time":
+		x.VersionTime = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2851,44 +2885,48 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentVersionRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetDIDDocumentVersionRequest is not mutable"))
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_id":
+		panic(fmt.Errorf("field version_id of message did.v1.QueryGetDIDDocumentVersionRequest is not mutable"))
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_time":
+		panic(fmt.Errorf("field version_time of message did.v1.QueryGetDIDDocumentVersionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryListDIDDocumentsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentVersionRequest.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "did.v1.QueryGetDIDDocumentVersionRequest.version_time":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryListDIDDocumentsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListDIDDocumentsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentVersionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2896,7 +2934,7 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryListDIDDocumentsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2907,7 +2945,7 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2919,7 +2957,7 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryListDIDDocumentsRequest) IsValid() bool {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2929,9 +2967,9 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDDocumentVersionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryListDIDDocumentsRequest)
+		x := input.Message.Interface().(*QueryGetDIDDocumentVersionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2943,8 +2981,15 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VersionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VersionId))
+		}
+		l = len(x.VersionTime)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2957,7 +3002,7 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListDIDDocumentsRequest)
+		x := input.Message.Interface().(*QueryGetDIDDocumentVersionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2976,17 +3021,22 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.VersionTime) > 0 {
+			i -= len(x.VersionTime)
+			copy(dAtA[i:], x.VersionTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionTime)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.VersionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VersionId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3001,7 +3051,7 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListDIDDocumentsRequest)
+		x := input.Message.Interface().(*QueryGetDIDDocumentVersionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3033,17 +3083,17 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentVersionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3053,27 +3103,74 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.VersionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VersionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.VersionTime = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3110,79 +3207,28 @@ func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QueryListDIDDocumentsResponse_1_list)(nil)
-
-type _QueryListDIDDocumentsResponse_1_list struct {
-	list *[]*DIDDocument
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(DIDDocument)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(DIDDocument)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryListDIDDocumentsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryListDIDDocumentsResponse               protoreflect.MessageDescriptor
-	fd_QueryListDIDDocumentsResponse_did_documents protoreflect.FieldDescriptor
-	fd_QueryListDIDDocumentsResponse_pagination    protoreflect.FieldDescriptor
+	md_QueryGetDIDDocumentVersionResponse                       protoreflect.MessageDescriptor
+	fd_QueryGetDIDDocumentVersionResponse_did_document          protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentVersionResponse_did_document_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryListDIDDocumentsResponse = File_did_v1_query_proto.Messages().ByName("QueryListDIDDocumentsResponse")
-	fd_QueryListDIDDocumentsResponse_did_documents = md_QueryListDIDDocumentsResponse.Fields().ByName("did_documents")
-	fd_QueryListDIDDocumentsResponse_pagination = md_QueryListDIDDocumentsResponse.Fields().ByName("pagination")
+	md_QueryGetDIDDocumentVersionResponse = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentVersionResponse")
+	fd_QueryGetDIDDocumentVersionResponse_did_document = md_QueryGetDIDDocumentVersionResponse.Fields().ByName("did_document")
+	fd_QueryGetDIDDocumentVersionResponse_did_document_metadata = md_QueryGetDIDDocumentVersionResponse.Fields().ByName("did_document_metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryListDIDDocumentsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentVersionResponse)(nil)
 
-type fastReflection_QueryListDIDDocumentsResponse QueryListDIDDocumentsResponse
+type fastReflection_QueryGetDIDDocumentVersionResponse QueryGetDIDDocumentVersionResponse
 
-func (x *QueryListDIDDocumentsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryListDIDDocumentsResponse)(x)
+func (x *QueryGetDIDDocumentVersionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentVersionResponse)(x)
 }
 
-func (x *QueryListDIDDocumentsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDDocumentVersionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3194,43 +3240,43 @@ func (x *QueryListDIDDocumentsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryListDIDDocumentsResponse_messageType fastReflection_QueryListDIDDocumentsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryListDIDDocumentsResponse_messageType{}
+var _fastReflection_QueryGetDIDDocumentVersionResponse_messageType fastReflection_QueryGetDIDDocumentVersionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentVersionResponse_messageType{}
 
-type fastReflection_QueryListDIDDocumentsResponse_messageType struct{}
+type fastReflection_QueryGetDIDDocumentVersionResponse_messageType struct{}
 
-func (x fastReflection_QueryListDIDDocumentsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryListDIDDocumentsResponse)(nil)
+func (x fastReflection_QueryGetDIDDocumentVersionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentVersionResponse)(nil)
 }
-func (x fastReflection_QueryListDIDDocumentsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryListDIDDocumentsResponse)
+func (x fastReflection_QueryGetDIDDocumentVersionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentVersionResponse)
 }
-func (x fastReflection_QueryListDIDDocumentsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListDIDDocumentsResponse
+func (x fastReflection_QueryGetDIDDocumentVersionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentVersionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListDIDDocumentsResponse
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentVersionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryListDIDDocumentsResponse_messageType
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDDocumentVersionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryListDIDDocumentsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryListDIDDocumentsResponse)
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentVersionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryListDIDDocumentsResponse)(x)
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDDocumentVersionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3238,16 +3284,16 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.DidDocuments) != 0 {
-		value := protoreflect.ValueOfList(&_QueryListDIDDocumentsResponse_1_list{list: &x.DidDocuments})
-		if !f(fd_QueryListDIDDocumentsResponse_did_documents, value) {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DidDocument != nil {
+		value := protoreflect.ValueOfMessage(x.DidDocument.ProtoReflect())
+		if !f(fd_QueryGetDIDDocumentVersionResponse_did_document, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryListDIDDocumentsResponse_pagination, value) {
+	if x.DidDocumentMetadata != nil {
+		value := protoreflect.ValueOfMessage(x.DidDocumentMetadata.ProtoReflect())
+		if !f(fd_QueryGetDIDDocumentVersionResponse_did_document_metadata, value) {
 			return
 		}
 	}
@@ -3264,17 +3310,17 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
-		return len(x.DidDocuments) != 0
-	case "did.v1.QueryListDIDDocumentsResponse.pagination":
-		return x.Pagination != nil
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document":
+		return x.DidDocument != nil
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata":
+		return x.DidDocumentMetadata != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3284,17 +3330,17 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
-		x.DidDocuments = nil
-	case "did.v1.QueryListDIDDocumentsResponse.pagination":
-		x.Pagination = nil
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document":
+		x.DidDocument = nil
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata":
+		x.DidDocumentMetadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3304,22 +3350,19 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
-		if len(x.DidDocuments) == 0 {
-			return protoreflect.ValueOfList(&_QueryListDIDDocumentsResponse_1_list{})
-		}
-		listValue := &_QueryListDIDDocumentsResponse_1_list{list: &x.DidDocuments}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.QueryListDIDDocumentsResponse.pagination":
-		value := x.Pagination
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document":
+		value := x.DidDocument
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata":
+		value := x.DidDocumentMetadata
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3333,19 +3376,17 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
-		lv := value.List()
-		clv := lv.(*_QueryListDIDDocumentsResponse_1_list)
-		x.DidDocuments = *clv.list
-	case "did.v1.QueryListDIDDocumentsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document":
+		x.DidDocument = value.Message().Interface().(*DIDDocument)
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata":
+		x.DidDocumentMetadata = value.Message().Interface().(*DIDDocumentMetadata)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3359,53 +3400,52 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
-		if x.DidDocuments == nil {
-			x.DidDocuments = []*DIDDocument{}
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document":
+		if x.DidDocument == nil {
+			x.DidDocument = new(DIDDocument)
 		}
-		value := &_QueryListDIDDocumentsResponse_1_list{list: &x.DidDocuments}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.QueryListDIDDocumentsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(x.DidDocument.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata":
+		if x.DidDocumentMetadata == nil {
+			x.DidDocumentMetadata = new(DIDDocumentMetadata)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.DidDocumentMetadata.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryListDIDDocumentsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
-		list := []*DIDDocument{}
-		return protoreflect.ValueOfList(&_QueryListDIDDocumentsResponse_1_list{list: &list})
-	case "did.v1.QueryListDIDDocumentsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document":
+		m := new(DIDDocument)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata":
+		m := new(DIDDocumentMetadata)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentVersionResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentVersionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryListDIDDocumentsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListDIDDocumentsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentVersionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3413,7 +3453,7 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryListDIDDocumentsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3424,7 +3464,7 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListDIDDocumentsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3436,7 +3476,7 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryListDIDDocumentsResponse) IsValid() bool {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3446,9 +3486,9 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDDocumentVersionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryListDIDDocumentsResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentVersionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3460,14 +3500,12 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if len(x.DidDocuments) > 0 {
-			for _, e := range x.DidDocuments {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.DidDocument != nil {
+			l = options.Size(x.DidDocument)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.DidDocumentMetadata != nil {
+			l = options.Size(x.DidDocumentMetadata)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3480,7 +3518,7 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListDIDDocumentsResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentVersionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3499,8 +3537,8 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.DidDocumentMetadata != nil {
+			encoded, err := options.Marshal(x.DidDocumentMetadata)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3513,21 +3551,19 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.DidDocuments) > 0 {
-			for iNdEx := len(x.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DidDocuments[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.DidDocument != nil {
+			encoded, err := options.Marshal(x.DidDocument)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3540,7 +3576,7 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListDIDDocumentsResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentVersionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3572,15 +3608,15 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentVersionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3607,14 +3643,16 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DidDocuments = append(x.DidDocuments, &DIDDocument{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDocuments[len(x.DidDocuments)-1]); err != nil {
+				if x.DidDocument == nil {
+					x.DidDocument = &DIDDocument{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDocument); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3641,10 +3679,10 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.DidDocumentMetadata == nil {
+					x.DidDocumentMetadata = &DIDDocumentMetadata{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDocumentMetadata); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3684,27 +3722,27 @@ func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryGetDIDDocumentsByControllerRequest            protoreflect.MessageDescriptor
-	fd_QueryGetDIDDocumentsByControllerRequest_controller protoreflect.FieldDescriptor
-	fd_QueryGetDIDDocumentsByControllerRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGetDIDDocumentHistoryRequest            protoreflect.MessageDescriptor
+	fd_QueryGetDIDDocumentHistoryRequest_did        protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentHistoryRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetDIDDocumentsByControllerRequest = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentsByControllerRequest")
-	fd_QueryGetDIDDocumentsByControllerRequest_controller = md_QueryGetDIDDocumentsByControllerRequest.Fields().ByName("controller")
-	fd_QueryGetDIDDocumentsByControllerRequest_pagination = md_QueryGetDIDDocumentsByControllerRequest.Fields().ByName("pagination")
+	md_QueryGetDIDDocumentHistoryRequest = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentHistoryRequest")
+	fd_QueryGetDIDDocumentHistoryRequest_did = md_QueryGetDIDDocumentHistoryRequest.Fields().ByName("did")
+	fd_QueryGetDIDDocumentHistoryRequest_pagination = md_QueryGetDIDDocumentHistoryRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentsByControllerRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentHistoryRequest)(nil)
 
-type fastReflection_QueryGetDIDDocumentsByControllerRequest QueryGetDIDDocumentsByControllerRequest
+type fastReflection_QueryGetDIDDocumentHistoryRequest QueryGetDIDDocumentHistoryRequest
 
-func (x *QueryGetDIDDocumentsByControllerRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetDIDDocumentsByControllerRequest)(x)
+func (x *QueryGetDIDDocumentHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentHistoryRequest)(x)
 }
 
-func (x *QueryGetDIDDocumentsByControllerRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDDocumentHistoryRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3716,43 +3754,43 @@ func (x *QueryGetDIDDocumentsByControllerRequest) slowProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType{}
+var _fastReflection_QueryGetDIDDocumentHistoryRequest_messageType fastReflection_QueryGetDIDDocumentHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentHistoryRequest_messageType{}
 
-type fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType struct{}
+type fastReflection_QueryGetDIDDocumentHistoryRequest_messageType struct{}
 
-func (x fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetDIDDocumentsByControllerRequest)(nil)
+func (x fastReflection_QueryGetDIDDocumentHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentHistoryRequest)(nil)
 }
-func (x fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetDIDDocumentsByControllerRequest)
+func (x fastReflection_QueryGetDIDDocumentHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentHistoryRequest)
 }
-func (x fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetDIDDocumentsByControllerRequest
+func (x fastReflection_QueryGetDIDDocumentHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentHistoryRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetDIDDocumentsByControllerRequest
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentHistoryRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDDocumentHistoryRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetDIDDocumentsByControllerRequest)
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentHistoryRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetDIDDocumentsByControllerRequest)(x)
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDDocumentHistoryRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3760,16 +3798,16 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Interface() pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Controller != "" {
-		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_QueryGetDIDDocumentsByControllerRequest_controller, value) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_QueryGetDIDDocumentHistoryRequest_did, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetDIDDocumentsByControllerRequest_pagination, value) {
+		if !f(fd_QueryGetDIDDocumentHistoryRequest_pagination, value) {
 			return
 		}
 	}
@@ -3786,17 +3824,17 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Range(f func(pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
-		return x.Controller != ""
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.did":
+		return x.Did != ""
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3806,17 +3844,17 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Has(fd protoref
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
-		x.Controller = ""
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.did":
+		x.Did = ""
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3826,19 +3864,19 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Clear(fd protor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
-		value := x.Controller
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.did":
+		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3852,17 +3890,17 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Get(descriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
-		x.Controller = value.Interface().(string)
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3876,48 +3914,48 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Set(fd protoref
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
-		panic(fmt.Errorf("field controller of message did.v1.QueryGetDIDDocumentsByControllerRequest is not mutable"))
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetDIDDocumentHistoryRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentsByControllerRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentHistoryRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3925,7 +3963,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) WhichOneof(d pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3936,7 +3974,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) GetUnknown() pr
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3948,7 +3986,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) SetUnknown(fiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) IsValid() bool {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3958,9 +3996,9 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) IsValid() bool
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDDocumentHistoryRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerRequest)
+		x := input.Message.Interface().(*QueryGetDIDDocumentHistoryRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3972,7 +4010,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 		var n int
 		var l int
 		_ = l
-		l = len(x.Controller)
+		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3990,7 +4028,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerRequest)
+		x := input.Message.Interface().(*QueryGetDIDDocumentHistoryRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4023,10 +4061,10 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Controller) > 0 {
-			i -= len(x.Controller)
-			copy(dAtA[i:], x.Controller)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4041,7 +4079,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerRequest)
+		x := input.Message.Interface().(*QueryGetDIDDocumentHistoryRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4073,15 +4111,15 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentHistoryRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4109,7 +4147,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Controller = string(dAtA[iNdEx:postIndex])
+				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -4182,79 +4220,79 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods()
 	}
 }
 
-var _ protoreflect.List = (*_QueryGetDIDDocumentsByControllerResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGetDIDDocumentHistoryResponse_1_list)(nil)
 
-type _QueryGetDIDDocumentsByControllerResponse_1_list struct {
-	list *[]*DIDDocument
+type _QueryGetDIDDocumentHistoryResponse_1_list struct {
+	list *[]*DIDDocumentVersion
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Len() int {
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
+	concreteValue := valueUnwrapped.Interface().(*DIDDocumentVersion)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
+	concreteValue := valueUnwrapped.Interface().(*DIDDocumentVersion)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(DIDDocument)
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DIDDocumentVersion)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Truncate(n int) {
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) NewElement() protoreflect.Value {
-	v := new(DIDDocument)
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DIDDocumentVersion)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) IsValid() bool {
+func (x *_QueryGetDIDDocumentHistoryResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGetDIDDocumentsByControllerResponse               protoreflect.MessageDescriptor
-	fd_QueryGetDIDDocumentsByControllerResponse_did_documents protoreflect.FieldDescriptor
-	fd_QueryGetDIDDocumentsByControllerResponse_pagination    protoreflect.FieldDescriptor
+	md_QueryGetDIDDocumentHistoryResponse            protoreflect.MessageDescriptor
+	fd_QueryGetDIDDocumentHistoryResponse_versions   protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentHistoryResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetDIDDocumentsByControllerResponse = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentsByControllerResponse")
-	fd_QueryGetDIDDocumentsByControllerResponse_did_documents = md_QueryGetDIDDocumentsByControllerResponse.Fields().ByName("did_documents")
-	fd_QueryGetDIDDocumentsByControllerResponse_pagination = md_QueryGetDIDDocumentsByControllerResponse.Fields().ByName("pagination")
+	md_QueryGetDIDDocumentHistoryResponse = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentHistoryResponse")
+	fd_QueryGetDIDDocumentHistoryResponse_versions = md_QueryGetDIDDocumentHistoryResponse.Fields().ByName("versions")
+	fd_QueryGetDIDDocumentHistoryResponse_pagination = md_QueryGetDIDDocumentHistoryResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentsByControllerResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentHistoryResponse)(nil)
 
-type fastReflection_QueryGetDIDDocumentsByControllerResponse QueryGetDIDDocumentsByControllerResponse
+type fastReflection_QueryGetDIDDocumentHistoryResponse QueryGetDIDDocumentHistoryResponse
 
-func (x *QueryGetDIDDocumentsByControllerResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetDIDDocumentsByControllerResponse)(x)
+func (x *QueryGetDIDDocumentHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentHistoryResponse)(x)
 }
 
-func (x *QueryGetDIDDocumentsByControllerResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDDocumentHistoryResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4266,43 +4304,43 @@ func (x *QueryGetDIDDocumentsByControllerResponse) slowProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType{}
+var _fastReflection_QueryGetDIDDocumentHistoryResponse_messageType fastReflection_QueryGetDIDDocumentHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentHistoryResponse_messageType{}
 
-type fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType struct{}
+type fastReflection_QueryGetDIDDocumentHistoryResponse_messageType struct{}
 
-func (x fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetDIDDocumentsByControllerResponse)(nil)
+func (x fastReflection_QueryGetDIDDocumentHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentHistoryResponse)(nil)
 }
-func (x fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetDIDDocumentsByControllerResponse)
+func (x fastReflection_QueryGetDIDDocumentHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentHistoryResponse)
 }
-func (x fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetDIDDocumentsByControllerResponse
+func (x fastReflection_QueryGetDIDDocumentHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentHistoryResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetDIDDocumentsByControllerResponse
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentHistoryResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDDocumentHistoryResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetDIDDocumentsByControllerResponse)
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentHistoryResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetDIDDocumentsByControllerResponse)(x)
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDDocumentHistoryResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4310,16 +4348,16 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Interface() pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.DidDocuments) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGetDIDDocumentsByControllerResponse_1_list{list: &x.DidDocuments})
-		if !f(fd_QueryGetDIDDocumentsByControllerResponse_did_documents, value) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Versions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetDIDDocumentHistoryResponse_1_list{list: &x.Versions})
+		if !f(fd_QueryGetDIDDocumentHistoryResponse_versions, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetDIDDocumentsByControllerResponse_pagination, value) {
+		if !f(fd_QueryGetDIDDocumentHistoryResponse_pagination, value) {
 			return
 		}
 	}
@@ -4336,17 +4374,17 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Range(f func(p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
-		return len(x.DidDocuments) != 0
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.versions":
+		return len(x.Versions) != 0
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4356,17 +4394,17 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Has(fd protore
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
-		x.DidDocuments = nil
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.versions":
+		x.Versions = nil
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4376,22 +4414,22 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Clear(fd proto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
-		if len(x.DidDocuments) == 0 {
-			return protoreflect.ValueOfList(&_QueryGetDIDDocumentsByControllerResponse_1_list{})
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.versions":
+		if len(x.Versions) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetDIDDocumentHistoryResponse_1_list{})
 		}
-		listValue := &_QueryGetDIDDocumentsByControllerResponse_1_list{list: &x.DidDocuments}
+		listValue := &_QueryGetDIDDocumentHistoryResponse_1_list{list: &x.Versions}
 		return protoreflect.ValueOfList(listValue)
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4405,19 +4443,19 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Get(descriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.versions":
 		lv := value.List()
-		clv := lv.(*_QueryGetDIDDocumentsByControllerResponse_1_list)
-		x.DidDocuments = *clv.list
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		clv := lv.(*_QueryGetDIDDocumentHistoryResponse_1_list)
+		x.Versions = *clv.list
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4431,53 +4469,53 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Set(fd protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
-		if x.DidDocuments == nil {
-			x.DidDocuments = []*DIDDocument{}
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.versions":
+		if x.Versions == nil {
+			x.Versions = []*DIDDocumentVersion{}
 		}
-		value := &_QueryGetDIDDocumentsByControllerResponse_1_list{list: &x.DidDocuments}
+		value := &_QueryGetDIDDocumentHistoryResponse_1_list{list: &x.Versions}
 		return protoreflect.ValueOfList(value)
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
-		list := []*DIDDocument{}
-		return protoreflect.ValueOfList(&_QueryGetDIDDocumentsByControllerResponse_1_list{list: &list})
-	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.versions":
+		list := []*DIDDocumentVersion{}
+		return protoreflect.ValueOfList(&_QueryGetDIDDocumentHistoryResponse_1_list{list: &list})
+	case "did.v1.QueryGetDIDDocumentHistoryResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentHistoryResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentHistoryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentsByControllerResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentHistoryResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4485,7 +4523,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) WhichOneof(d p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4496,7 +4534,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) GetUnknown() p
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4508,7 +4546,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) SetUnknown(fie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) IsValid() bool {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4518,9 +4556,9 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) IsValid() bool
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDDocumentHistoryResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentHistoryResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4532,8 +4570,8 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 		var n int
 		var l int
 		_ = l
-		if len(x.DidDocuments) > 0 {
-			for _, e := range x.DidDocuments {
+		if len(x.Versions) > 0 {
+			for _, e := range x.Versions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4552,7 +4590,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentHistoryResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4585,9 +4623,9 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.DidDocuments) > 0 {
-			for iNdEx := len(x.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DidDocuments[iNdEx])
+		if len(x.Versions) > 0 {
+			for iNdEx := len(x.Versions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Versions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4612,7 +4650,7 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentHistoryResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4644,15 +4682,15 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentHistoryResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4679,8 +4717,8 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DidDocuments = append(x.DidDocuments, &DIDDocument{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDocuments[len(x.DidDocuments)-1]); err != nil {
+				x.Versions = append(x.Versions, &DIDDocumentVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Versions[len(x.Versions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4756,27 +4794,25 @@ func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods()
 }
 
 var (
-	md_QueryGetVerificationMethodRequest           protoreflect.MessageDescriptor
-	fd_QueryGetVerificationMethodRequest_did       protoreflect.FieldDescriptor
-	fd_QueryGetVerificationMethodRequest_method_id protoreflect.FieldDescriptor
+	md_QueryGetDelegatedControllersRequest     protoreflect.MessageDescriptor
+	fd_QueryGetDelegatedControllersRequest_did protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetVerificationMethodRequest = File_did_v1_query_proto.Messages().ByName("QueryGetVerificationMethodRequest")
-	fd_QueryGetVerificationMethodRequest_did = md_QueryGetVerificationMethodRequest.Fields().ByName("did")
-	fd_QueryGetVerificationMethodRequest_method_id = md_QueryGetVerificationMethodRequest.Fields().ByName("method_id")
+	md_QueryGetDelegatedControllersRequest = File_did_v1_query_proto.Messages().ByName("QueryGetDelegatedControllersRequest")
+	fd_QueryGetDelegatedControllersRequest_did = md_QueryGetDelegatedControllersRequest.Fields().ByName("did")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetVerificationMethodRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDelegatedControllersRequest)(nil)
 
-type fastReflection_QueryGetVerificationMethodRequest QueryGetVerificationMethodRequest
+type fastReflection_QueryGetDelegatedControllersRequest QueryGetDelegatedControllersRequest
 
-func (x *QueryGetVerificationMethodRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetVerificationMethodRequest)(x)
+func (x *QueryGetDelegatedControllersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDelegatedControllersRequest)(x)
 }
 
-func (x *QueryGetVerificationMethodRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDelegatedControllersRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4788,43 +4824,43 @@ func (x *QueryGetVerificationMethodRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetVerificationMethodRequest_messageType fastReflection_QueryGetVerificationMethodRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetVerificationMethodRequest_messageType{}
+var _fastReflection_QueryGetDelegatedControllersRequest_messageType fastReflection_QueryGetDelegatedControllersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDelegatedControllersRequest_messageType{}
 
-type fastReflection_QueryGetVerificationMethodRequest_messageType struct{}
+type fastReflection_QueryGetDelegatedControllersRequest_messageType struct{}
 
-func (x fastReflection_QueryGetVerificationMethodRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetVerificationMethodRequest)(nil)
+func (x fastReflection_QueryGetDelegatedControllersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDelegatedControllersRequest)(nil)
 }
-func (x fastReflection_QueryGetVerificationMethodRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerificationMethodRequest)
+func (x fastReflection_QueryGetDelegatedControllersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDelegatedControllersRequest)
 }
-func (x fastReflection_QueryGetVerificationMethodRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerificationMethodRequest
+func (x fastReflection_QueryGetDelegatedControllersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDelegatedControllersRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerificationMethodRequest
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDelegatedControllersRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetVerificationMethodRequest_messageType
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDelegatedControllersRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetVerificationMethodRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerificationMethodRequest)
+func (x *fastReflection_QueryGetDelegatedControllersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDelegatedControllersRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetVerificationMethodRequest)(x)
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDelegatedControllersRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4832,16 +4868,10 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_QueryGetVerificationMethodRequest_did, value) {
-			return
-		}
-	}
-	if x.MethodId != "" {
-		value := protoreflect.ValueOfString(x.MethodId)
-		if !f(fd_QueryGetVerificationMethodRequest_method_id, value) {
+		if !f(fd_QueryGetDelegatedControllersRequest_did, value) {
 			return
 		}
 	}
@@ -4858,17 +4888,15 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodRequest.did":
+	case "did.v1.QueryGetDelegatedControllersRequest.did":
 		return x.Did != ""
-	case "did.v1.QueryGetVerificationMethodRequest.method_id":
-		return x.MethodId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4878,17 +4906,15 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodRequest.did":
+	case "did.v1.QueryGetDelegatedControllersRequest.did":
 		x.Did = ""
-	case "did.v1.QueryGetVerificationMethodRequest.method_id":
-		x.MethodId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4898,19 +4924,16 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetVerificationMethodRequest.did":
+	case "did.v1.QueryGetDelegatedControllersRequest.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryGetVerificationMethodRequest.method_id":
-		value := x.MethodId
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4924,17 +4947,15 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodRequest.did":
+	case "did.v1.QueryGetDelegatedControllersRequest.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.QueryGetVerificationMethodRequest.method_id":
-		x.MethodId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4948,44 +4969,40 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodRequest.did":
-		panic(fmt.Errorf("field did of message did.v1.QueryGetVerificationMethodRequest is not mutable"))
-	case "did.v1.QueryGetVerificationMethodRequest.method_id":
-		panic(fmt.Errorf("field method_id of message did.v1.QueryGetVerificationMethodRequest is not mutable"))
+	case "did.v1.QueryGetDelegatedControllersRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetDelegatedControllersRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetVerificationMethodRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodRequest.did":
-		return protoreflect.ValueOfString("")
-	case "did.v1.QueryGetVerificationMethodRequest.method_id":
+	case "did.v1.QueryGetDelegatedControllersRequest.did":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetVerificationMethodRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerificationMethodRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDelegatedControllersRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4993,7 +5010,7 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetVerificationMethodRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5004,7 +5021,7 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5016,7 +5033,7 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetVerificationMethodRequest) IsValid() bool {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5026,9 +5043,9 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDelegatedControllersRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetVerificationMethodRequest)
+		x := input.Message.Interface().(*QueryGetDelegatedControllersRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5044,10 +5061,6 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MethodId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5058,7 +5071,7 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerificationMethodRequest)
+		x := input.Message.Interface().(*QueryGetDelegatedControllersRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5077,13 +5090,6 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MethodId) > 0 {
-			i -= len(x.MethodId)
-			copy(dAtA[i:], x.MethodId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MethodId)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
 			copy(dAtA[i:], x.Did)
@@ -5102,7 +5108,7 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerificationMethodRequest)
+		x := input.Message.Interface().(*QueryGetDelegatedControllersRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5134,10 +5140,10 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDelegatedControllersRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDelegatedControllersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5172,38 +5178,6 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 				}
 				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MethodId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5239,26 +5213,77 @@ func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *proto
 	}
 }
 
-var (
-	md_QueryGetVerificationMethodResponse                     protoreflect.MessageDescriptor
-	fd_QueryGetVerificationMethodResponse_verification_method protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryGetDelegatedControllersResponse_1_list)(nil)
 
-func init() {
-	file_did_v1_query_proto_init()
-	md_QueryGetVerificationMethodResponse = File_did_v1_query_proto.Messages().ByName("QueryGetVerificationMethodResponse")
-	fd_QueryGetVerificationMethodResponse_verification_method = md_QueryGetVerificationMethodResponse.Fields().ByName("verification_method")
+type _QueryGetDelegatedControllersResponse_1_list struct {
+	list *[]*DelegatedController
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetVerificationMethodResponse)(nil)
-
-type fastReflection_QueryGetVerificationMethodResponse QueryGetVerificationMethodResponse
+func (x *_QueryGetDelegatedControllersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *QueryGetVerificationMethodResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetVerificationMethodResponse)(x)
+func (x *_QueryGetDelegatedControllersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *QueryGetVerificationMethodResponse) slowProtoReflect() protoreflect.Message {
+func (x *_QueryGetDelegatedControllersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegatedController)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetDelegatedControllersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegatedController)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetDelegatedControllersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DelegatedController)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetDelegatedControllersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetDelegatedControllersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DelegatedController)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetDelegatedControllersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetDelegatedControllersResponse             protoreflect.MessageDescriptor
+	fd_QueryGetDelegatedControllersResponse_delegations protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetDelegatedControllersResponse = File_did_v1_query_proto.Messages().ByName("QueryGetDelegatedControllersResponse")
+	fd_QueryGetDelegatedControllersResponse_delegations = md_QueryGetDelegatedControllersResponse.Fields().ByName("delegations")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetDelegatedControllersResponse)(nil)
+
+type fastReflection_QueryGetDelegatedControllersResponse QueryGetDelegatedControllersResponse
+
+func (x *QueryGetDelegatedControllersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDelegatedControllersResponse)(x)
+}
+
+func (x *QueryGetDelegatedControllersResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5270,43 +5295,43 @@ func (x *QueryGetVerificationMethodResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetVerificationMethodResponse_messageType fastReflection_QueryGetVerificationMethodResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetVerificationMethodResponse_messageType{}
+var _fastReflection_QueryGetDelegatedControllersResponse_messageType fastReflection_QueryGetDelegatedControllersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDelegatedControllersResponse_messageType{}
 
-type fastReflection_QueryGetVerificationMethodResponse_messageType struct{}
+type fastReflection_QueryGetDelegatedControllersResponse_messageType struct{}
 
-func (x fastReflection_QueryGetVerificationMethodResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetVerificationMethodResponse)(nil)
+func (x fastReflection_QueryGetDelegatedControllersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDelegatedControllersResponse)(nil)
 }
-func (x fastReflection_QueryGetVerificationMethodResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerificationMethodResponse)
+func (x fastReflection_QueryGetDelegatedControllersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDelegatedControllersResponse)
 }
-func (x fastReflection_QueryGetVerificationMethodResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerificationMethodResponse
+func (x fastReflection_QueryGetDelegatedControllersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDelegatedControllersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerificationMethodResponse
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDelegatedControllersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetVerificationMethodResponse_messageType
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDelegatedControllersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetVerificationMethodResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerificationMethodResponse)
+func (x *fastReflection_QueryGetDelegatedControllersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDelegatedControllersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetVerificationMethodResponse)(x)
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDelegatedControllersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5314,10 +5339,10 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VerificationMethod != nil {
-		value := protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
-		if !f(fd_QueryGetVerificationMethodResponse_verification_method, value) {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetDelegatedControllersResponse_1_list{list: &x.Delegations})
+		if !f(fd_QueryGetDelegatedControllersResponse_delegations, value) {
 			return
 		}
 	}
@@ -5334,15 +5359,15 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
-		return x.VerificationMethod != nil
+	case "did.v1.QueryGetDelegatedControllersResponse.delegations":
+		return len(x.Delegations) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5352,15 +5377,15 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
-		x.VerificationMethod = nil
+	case "did.v1.QueryGetDelegatedControllersResponse.delegations":
+		x.Delegations = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5370,16 +5395,19 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
-		value := x.VerificationMethod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetDelegatedControllersResponse.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetDelegatedControllersResponse_1_list{})
+		}
+		listValue := &_QueryGetDelegatedControllersResponse_1_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5393,15 +5421,17 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
-		x.VerificationMethod = value.Message().Interface().(*VerificationMethod)
+	case "did.v1.QueryGetDelegatedControllersResponse.delegations":
+		lv := value.List()
+		clv := lv.(*_QueryGetDelegatedControllersResponse_1_list)
+		x.Delegations = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5415,44 +5445,45 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
-		if x.VerificationMethod == nil {
-			x.VerificationMethod = new(VerificationMethod)
+	case "did.v1.QueryGetDelegatedControllersResponse.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*DelegatedController{}
 		}
-		return protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
+		value := &_QueryGetDelegatedControllersResponse_1_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetVerificationMethodResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
-		m := new(VerificationMethod)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.QueryGetDelegatedControllersResponse.delegations":
+		list := []*DelegatedController{}
+		return protoreflect.ValueOfList(&_QueryGetDelegatedControllersResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDelegatedControllersResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDelegatedControllersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetVerificationMethodResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerificationMethodResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDelegatedControllersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5460,7 +5491,7 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetVerificationMethodResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5471,7 +5502,7 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerificationMethodResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5483,7 +5514,7 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetVerificationMethodResponse) IsValid() bool {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5493,9 +5524,9 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDelegatedControllersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetVerificationMethodResponse)
+		x := input.Message.Interface().(*QueryGetDelegatedControllersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5507,9 +5538,11 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.VerificationMethod != nil {
-			l = options.Size(x.VerificationMethod)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5521,7 +5554,7 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerificationMethodResponse)
+		x := input.Message.Interface().(*QueryGetDelegatedControllersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5540,19 +5573,21 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VerificationMethod != nil {
-			encoded, err := options.Marshal(x.VerificationMethod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5565,7 +5600,7 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerificationMethodResponse)
+		x := input.Message.Interface().(*QueryGetDelegatedControllersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5597,15 +5632,15 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDelegatedControllersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDelegatedControllersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5632,10 +5667,8 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VerificationMethod == nil {
-					x.VerificationMethod = &VerificationMethod{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationMethod); err != nil {
+				x.Delegations = append(x.Delegations, &DelegatedController{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5675,27 +5708,27 @@ func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *prot
 }
 
 var (
-	md_QueryGetServiceRequest            protoreflect.MessageDescriptor
-	fd_QueryGetServiceRequest_did        protoreflect.FieldDescriptor
-	fd_QueryGetServiceRequest_service_id protoreflect.FieldDescriptor
+	md_QueryGetDIDAssetsRequest            protoreflect.MessageDescriptor
+	fd_QueryGetDIDAssetsRequest_did        protoreflect.FieldDescriptor
+	fd_QueryGetDIDAssetsRequest_owned_only protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetServiceRequest = File_did_v1_query_proto.Messages().ByName("QueryGetServiceRequest")
-	fd_QueryGetServiceRequest_did = md_QueryGetServiceRequest.Fields().ByName("did")
-	fd_QueryGetServiceRequest_service_id = md_QueryGetServiceRequest.Fields().ByName("service_id")
+	md_QueryGetDIDAssetsRequest = File_did_v1_query_proto.Messages().ByName("QueryGetDIDAssetsRequest")
+	fd_QueryGetDIDAssetsRequest_did = md_QueryGetDIDAssetsRequest.Fields().ByName("did")
+	fd_QueryGetDIDAssetsRequest_owned_only = md_QueryGetDIDAssetsRequest.Fields().ByName("owned_only")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetServiceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDAssetsRequest)(nil)
 
-type fastReflection_QueryGetServiceRequest QueryGetServiceRequest
+type fastReflection_QueryGetDIDAssetsRequest QueryGetDIDAssetsRequest
 
-func (x *QueryGetServiceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetServiceRequest)(x)
+func (x *QueryGetDIDAssetsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDAssetsRequest)(x)
 }
 
-func (x *QueryGetServiceRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDAssetsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5707,43 +5740,43 @@ func (x *QueryGetServiceRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetServiceRequest_messageType fastReflection_QueryGetServiceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetServiceRequest_messageType{}
+var _fastReflection_QueryGetDIDAssetsRequest_messageType fastReflection_QueryGetDIDAssetsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDAssetsRequest_messageType{}
 
-type fastReflection_QueryGetServiceRequest_messageType struct{}
+type fastReflection_QueryGetDIDAssetsRequest_messageType struct{}
 
-func (x fastReflection_QueryGetServiceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetServiceRequest)(nil)
+func (x fastReflection_QueryGetDIDAssetsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDAssetsRequest)(nil)
 }
-func (x fastReflection_QueryGetServiceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetServiceRequest)
+func (x fastReflection_QueryGetDIDAssetsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDAssetsRequest)
 }
-func (x fastReflection_QueryGetServiceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetServiceRequest
+func (x fastReflection_QueryGetDIDAssetsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDAssetsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetServiceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetServiceRequest
+func (x *fastReflection_QueryGetDIDAssetsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDAssetsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetServiceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetServiceRequest_messageType
+func (x *fastReflection_QueryGetDIDAssetsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDAssetsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetServiceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetServiceRequest)
+func (x *fastReflection_QueryGetDIDAssetsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDAssetsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetServiceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetServiceRequest)(x)
+func (x *fastReflection_QueryGetDIDAssetsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDAssetsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5751,16 +5784,16 @@ func (x *fastReflection_QueryGetServiceRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetServiceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGetDIDAssetsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_QueryGetServiceRequest_did, value) {
+		if !f(fd_QueryGetDIDAssetsRequest_did, value) {
 			return
 		}
 	}
-	if x.ServiceId != "" {
-		value := protoreflect.ValueOfString(x.ServiceId)
-		if !f(fd_QueryGetServiceRequest_service_id, value) {
+	if x.OwnedOnly != false {
+		value := protoreflect.ValueOfBool(x.OwnedOnly)
+		if !f(fd_QueryGetDIDAssetsRequest_owned_only, value) {
 			return
 		}
 	}
@@ -5777,17 +5810,17 @@ func (x *fastReflection_QueryGetServiceRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetServiceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDAssetsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceRequest.did":
+	case "did.v1.QueryGetDIDAssetsRequest.did":
 		return x.Did != ""
-	case "did.v1.QueryGetServiceRequest.service_id":
-		return x.ServiceId != ""
+	case "did.v1.QueryGetDIDAssetsRequest.owned_only":
+		return x.OwnedOnly != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5797,17 +5830,17 @@ func (x *fastReflection_QueryGetServiceRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDAssetsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceRequest.did":
+	case "did.v1.QueryGetDIDAssetsRequest.did":
 		x.Did = ""
-	case "did.v1.QueryGetServiceRequest.service_id":
-		x.ServiceId = ""
+	case "did.v1.QueryGetDIDAssetsRequest.owned_only":
+		x.OwnedOnly = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5817,19 +5850,19 @@ func (x *fastReflection_QueryGetServiceRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetServiceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDAssetsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetServiceRequest.did":
+	case "did.v1.QueryGetDIDAssetsRequest.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryGetServiceRequest.service_id":
-		value := x.ServiceId
-		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetDIDAssetsRequest.owned_only":
+		value := x.OwnedOnly
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5843,17 +5876,17 @@ func (x *fastReflection_QueryGetServiceRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDAssetsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceRequest.did":
+	case "did.v1.QueryGetDIDAssetsRequest.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.QueryGetServiceRequest.service_id":
-		x.ServiceId = value.Interface().(string)
+	case "did.v1.QueryGetDIDAssetsRequest.owned_only":
+		x.OwnedOnly = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5867,44 +5900,44 @@ func (x *fastReflection_QueryGetServiceRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDAssetsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceRequest.did":
-		panic(fmt.Errorf("field did of message did.v1.QueryGetServiceRequest is not mutable"))
-	case "did.v1.QueryGetServiceRequest.service_id":
-		panic(fmt.Errorf("field service_id of message did.v1.QueryGetServiceRequest is not mutable"))
+	case "did.v1.QueryGetDIDAssetsRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetDIDAssetsRequest is not mutable"))
+	case "did.v1.QueryGetDIDAssetsRequest.owned_only":
+		panic(fmt.Errorf("field owned_only of message did.v1.QueryGetDIDAssetsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetServiceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDAssetsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceRequest.did":
-		return protoreflect.ValueOfString("")
-	case "did.v1.QueryGetServiceRequest.service_id":
+	case "did.v1.QueryGetDIDAssetsRequest.did":
 		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetDIDAssetsRequest.owned_only":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetServiceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDAssetsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetServiceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDAssetsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5912,7 +5945,7 @@ func (x *fastReflection_QueryGetServiceRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetServiceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDAssetsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5923,7 +5956,7 @@ func (x *fastReflection_QueryGetServiceRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDAssetsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5935,7 +5968,7 @@ func (x *fastReflection_QueryGetServiceRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetServiceRequest) IsValid() bool {
+func (x *fastReflection_QueryGetDIDAssetsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5945,9 +5978,9 @@ func (x *fastReflection_QueryGetServiceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDAssetsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetServiceRequest)
+		x := input.Message.Interface().(*QueryGetDIDAssetsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5963,9 +5996,8 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ServiceId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OwnedOnly {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5977,7 +6009,7 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetServiceRequest)
+		x := input.Message.Interface().(*QueryGetDIDAssetsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5996,12 +6028,15 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ServiceId) > 0 {
-			i -= len(x.ServiceId)
-			copy(dAtA[i:], x.ServiceId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+		if x.OwnedOnly {
 			i--
-			dAtA[i] = 0x12
+			if x.OwnedOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
 		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
@@ -6021,7 +6056,7 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetServiceRequest)
+		x := input.Message.Interface().(*QueryGetDIDAssetsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6053,10 +6088,10 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDAssetsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6092,10 +6127,10 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnedOnly", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6105,31 +6140,19 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.OwnedOnly = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ServiceId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -6158,26 +6181,77 @@ func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_QueryGetDIDAssetsResponse_1_list)(nil)
+
+type _QueryGetDIDAssetsResponse_1_list struct {
+	list *[]*DIDAssetInfo
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DIDAssetInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DIDAssetInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DIDAssetInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DIDAssetInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetDIDAssetsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryGetServiceResponse         protoreflect.MessageDescriptor
-	fd_QueryGetServiceResponse_service protoreflect.FieldDescriptor
+	md_QueryGetDIDAssetsResponse        protoreflect.MessageDescriptor
+	fd_QueryGetDIDAssetsResponse_assets protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetServiceResponse = File_did_v1_query_proto.Messages().ByName("QueryGetServiceResponse")
-	fd_QueryGetServiceResponse_service = md_QueryGetServiceResponse.Fields().ByName("service")
+	md_QueryGetDIDAssetsResponse = File_did_v1_query_proto.Messages().ByName("QueryGetDIDAssetsResponse")
+	fd_QueryGetDIDAssetsResponse_assets = md_QueryGetDIDAssetsResponse.Fields().ByName("assets")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetServiceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDAssetsResponse)(nil)
 
-type fastReflection_QueryGetServiceResponse QueryGetServiceResponse
+type fastReflection_QueryGetDIDAssetsResponse QueryGetDIDAssetsResponse
 
-func (x *QueryGetServiceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetServiceResponse)(x)
+func (x *QueryGetDIDAssetsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDAssetsResponse)(x)
 }
 
-func (x *QueryGetServiceResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDAssetsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6189,43 +6263,43 @@ func (x *QueryGetServiceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetServiceResponse_messageType fastReflection_QueryGetServiceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetServiceResponse_messageType{}
+var _fastReflection_QueryGetDIDAssetsResponse_messageType fastReflection_QueryGetDIDAssetsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDAssetsResponse_messageType{}
 
-type fastReflection_QueryGetServiceResponse_messageType struct{}
+type fastReflection_QueryGetDIDAssetsResponse_messageType struct{}
 
-func (x fastReflection_QueryGetServiceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetServiceResponse)(nil)
+func (x fastReflection_QueryGetDIDAssetsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDAssetsResponse)(nil)
 }
-func (x fastReflection_QueryGetServiceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetServiceResponse)
+func (x fastReflection_QueryGetDIDAssetsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDAssetsResponse)
 }
-func (x fastReflection_QueryGetServiceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetServiceResponse
+func (x fastReflection_QueryGetDIDAssetsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDAssetsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetServiceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetServiceResponse
+func (x *fastReflection_QueryGetDIDAssetsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDAssetsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetServiceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetServiceResponse_messageType
+func (x *fastReflection_QueryGetDIDAssetsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDAssetsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetServiceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetServiceResponse)
+func (x *fastReflection_QueryGetDIDAssetsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDAssetsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetServiceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetServiceResponse)(x)
+func (x *fastReflection_QueryGetDIDAssetsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDAssetsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6233,10 +6307,10 @@ func (x *fastReflection_QueryGetServiceResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetServiceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Service != nil {
-		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
-		if !f(fd_QueryGetServiceResponse_service, value) {
+func (x *fastReflection_QueryGetDIDAssetsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Assets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetDIDAssetsResponse_1_list{list: &x.Assets})
+		if !f(fd_QueryGetDIDAssetsResponse_assets, value) {
 			return
 		}
 	}
@@ -6253,15 +6327,15 @@ func (x *fastReflection_QueryGetServiceResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetServiceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDAssetsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceResponse.service":
-		return x.Service != nil
+	case "did.v1.QueryGetDIDAssetsResponse.assets":
+		return len(x.Assets) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6271,15 +6345,15 @@ func (x *fastReflection_QueryGetServiceResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDAssetsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceResponse.service":
-		x.Service = nil
+	case "did.v1.QueryGetDIDAssetsResponse.assets":
+		x.Assets = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6289,16 +6363,19 @@ func (x *fastReflection_QueryGetServiceResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetServiceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDAssetsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetServiceResponse.service":
-		value := x.Service
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetDIDAssetsResponse.assets":
+		if len(x.Assets) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetDIDAssetsResponse_1_list{})
+		}
+		listValue := &_QueryGetDIDAssetsResponse_1_list{list: &x.Assets}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6312,15 +6389,17 @@ func (x *fastReflection_QueryGetServiceResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDAssetsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceResponse.service":
-		x.Service = value.Message().Interface().(*Service)
+	case "did.v1.QueryGetDIDAssetsResponse.assets":
+		lv := value.List()
+		clv := lv.(*_QueryGetDIDAssetsResponse_1_list)
+		x.Assets = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6334,44 +6413,45 @@ func (x *fastReflection_QueryGetServiceResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDAssetsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceResponse.service":
-		if x.Service == nil {
-			x.Service = new(Service)
+	case "did.v1.QueryGetDIDAssetsResponse.assets":
+		if x.Assets == nil {
+			x.Assets = []*DIDAssetInfo{}
 		}
-		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		value := &_QueryGetDIDAssetsResponse_1_list{list: &x.Assets}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetServiceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDAssetsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetServiceResponse.service":
-		m := new(Service)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.QueryGetDIDAssetsResponse.assets":
+		list := []*DIDAssetInfo{}
+		return protoreflect.ValueOfList(&_QueryGetDIDAssetsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDAssetsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDAssetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetServiceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDAssetsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetServiceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDAssetsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6379,7 +6459,7 @@ func (x *fastReflection_QueryGetServiceResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetServiceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDAssetsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6390,7 +6470,7 @@ func (x *fastReflection_QueryGetServiceResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetServiceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDAssetsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6402,7 +6482,7 @@ func (x *fastReflection_QueryGetServiceResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetServiceResponse) IsValid() bool {
+func (x *fastReflection_QueryGetDIDAssetsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6412,9 +6492,9 @@ func (x *fastReflection_QueryGetServiceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDAssetsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetServiceResponse)
+		x := input.Message.Interface().(*QueryGetDIDAssetsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6426,9 +6506,11 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Service != nil {
-			l = options.Size(x.Service)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Assets) > 0 {
+			for _, e := range x.Assets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6440,7 +6522,7 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetServiceResponse)
+		x := input.Message.Interface().(*QueryGetDIDAssetsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6459,19 +6541,21 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Service != nil {
-			encoded, err := options.Marshal(x.Service)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Assets) > 0 {
+			for iNdEx := len(x.Assets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Assets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6484,7 +6568,7 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetServiceResponse)
+		x := input.Message.Interface().(*QueryGetDIDAssetsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6516,15 +6600,15 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDAssetsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6551,10 +6635,8 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Service == nil {
-					x.Service = &Service{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+				x.Assets = append(x.Assets, &DIDAssetInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Assets[len(x.Assets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6594,25 +6676,29 @@ func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryGetVerifiableCredentialRequest               protoreflect.MessageDescriptor
-	fd_QueryGetVerifiableCredentialRequest_credential_id protoreflect.FieldDescriptor
+	md_DIDAssetInfo               protoreflect.MessageDescriptor
+	fd_DIDAssetInfo_asset         protoreflect.FieldDescriptor
+	fd_DIDAssetInfo_current_owner protoreflect.FieldDescriptor
+	fd_DIDAssetInfo_owned         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetVerifiableCredentialRequest = File_did_v1_query_proto.Messages().ByName("QueryGetVerifiableCredentialRequest")
-	fd_QueryGetVerifiableCredentialRequest_credential_id = md_QueryGetVerifiableCredentialRequest.Fields().ByName("credential_id")
+	md_DIDAssetInfo = File_did_v1_query_proto.Messages().ByName("DIDAssetInfo")
+	fd_DIDAssetInfo_asset = md_DIDAssetInfo.Fields().ByName("asset")
+	fd_DIDAssetInfo_current_owner = md_DIDAssetInfo.Fields().ByName("current_owner")
+	fd_DIDAssetInfo_owned = md_DIDAssetInfo.Fields().ByName("owned")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetVerifiableCredentialRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_DIDAssetInfo)(nil)
 
-type fastReflection_QueryGetVerifiableCredentialRequest QueryGetVerifiableCredentialRequest
+type fastReflection_DIDAssetInfo DIDAssetInfo
 
-func (x *QueryGetVerifiableCredentialRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetVerifiableCredentialRequest)(x)
+func (x *DIDAssetInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DIDAssetInfo)(x)
 }
 
-func (x *QueryGetVerifiableCredentialRequest) slowProtoReflect() protoreflect.Message {
+func (x *DIDAssetInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6624,43 +6710,43 @@ func (x *QueryGetVerifiableCredentialRequest) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetVerifiableCredentialRequest_messageType fastReflection_QueryGetVerifiableCredentialRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetVerifiableCredentialRequest_messageType{}
+var _fastReflection_DIDAssetInfo_messageType fastReflection_DIDAssetInfo_messageType
+var _ protoreflect.MessageType = fastReflection_DIDAssetInfo_messageType{}
 
-type fastReflection_QueryGetVerifiableCredentialRequest_messageType struct{}
+type fastReflection_DIDAssetInfo_messageType struct{}
 
-func (x fastReflection_QueryGetVerifiableCredentialRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetVerifiableCredentialRequest)(nil)
+func (x fastReflection_DIDAssetInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DIDAssetInfo)(nil)
 }
-func (x fastReflection_QueryGetVerifiableCredentialRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerifiableCredentialRequest)
+func (x fastReflection_DIDAssetInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_DIDAssetInfo)
 }
-func (x fastReflection_QueryGetVerifiableCredentialRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerifiableCredentialRequest
+func (x fastReflection_DIDAssetInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDAssetInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerifiableCredentialRequest
+func (x *fastReflection_DIDAssetInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDAssetInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetVerifiableCredentialRequest_messageType
+func (x *fastReflection_DIDAssetInfo) Type() protoreflect.MessageType {
+	return _fastReflection_DIDAssetInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerifiableCredentialRequest)
+func (x *fastReflection_DIDAssetInfo) New() protoreflect.Message {
+	return new(fastReflection_DIDAssetInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetVerifiableCredentialRequest)(x)
+func (x *fastReflection_DIDAssetInfo) Interface() protoreflect.ProtoMessage {
+	return (*DIDAssetInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6668,10 +6754,22 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CredentialId != "" {
-		value := protoreflect.ValueOfString(x.CredentialId)
-		if !f(fd_QueryGetVerifiableCredentialRequest_credential_id, value) {
+func (x *fastReflection_DIDAssetInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != nil {
+		value := protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
+		if !f(fd_DIDAssetInfo_asset, value) {
+			return
+		}
+	}
+	if x.CurrentOwner != "" {
+		value := protoreflect.ValueOfString(x.CurrentOwner)
+		if !f(fd_DIDAssetInfo_current_owner, value) {
+			return
+		}
+	}
+	if x.Owned != false {
+		value := protoreflect.ValueOfBool(x.Owned)
+		if !f(fd_DIDAssetInfo_owned, value) {
 			return
 		}
 	}
@@ -6688,15 +6786,19 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DIDAssetInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
-		return x.CredentialId != ""
+	case "did.v1.DIDAssetInfo.asset":
+		return x.Asset != nil
+	case "did.v1.DIDAssetInfo.current_owner":
+		return x.CurrentOwner != ""
+	case "did.v1.DIDAssetInfo.owned":
+		return x.Owned != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAssetInfo"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDAssetInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6706,15 +6808,19 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DIDAssetInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
-		x.CredentialId = ""
+	case "did.v1.DIDAssetInfo.asset":
+		x.Asset = nil
+	case "did.v1.DIDAssetInfo.current_owner":
+		x.CurrentOwner = ""
+	case "did.v1.DIDAssetInfo.owned":
+		x.Owned = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAssetInfo"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDAssetInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6724,16 +6830,22 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DIDAssetInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
-		value := x.CredentialId
+	case "did.v1.DIDAssetInfo.asset":
+		value := x.Asset
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.DIDAssetInfo.current_owner":
+		value := x.CurrentOwner
 		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAssetInfo.owned":
+		value := x.Owned
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAssetInfo"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDAssetInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6747,15 +6859,19 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DIDAssetInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
-		x.CredentialId = value.Interface().(string)
+	case "did.v1.DIDAssetInfo.asset":
+		x.Asset = value.Message().Interface().(*DIDAsset)
+	case "did.v1.DIDAssetInfo.current_owner":
+		x.CurrentOwner = value.Interface().(string)
+	case "did.v1.DIDAssetInfo.owned":
+		x.Owned = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAssetInfo"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDAssetInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6769,40 +6885,52 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DIDAssetInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
-		panic(fmt.Errorf("field credential_id of message did.v1.QueryGetVerifiableCredentialRequest is not mutable"))
+	case "did.v1.DIDAssetInfo.asset":
+		if x.Asset == nil {
+			x.Asset = new(DIDAsset)
+		}
+		return protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
+	case "did.v1.DIDAssetInfo.current_owner":
+		panic(fmt.Errorf("field current_owner of message did.v1.DIDAssetInfo is not mutable"))
+	case "did.v1.DIDAssetInfo.owned":
+		panic(fmt.Errorf("field owned of message did.v1.DIDAssetInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAssetInfo"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDAssetInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DIDAssetInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
+	case "did.v1.DIDAssetInfo.asset":
+		m := new(DIDAsset)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.DIDAssetInfo.current_owner":
 		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAssetInfo.owned":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAssetInfo"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDAssetInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DIDAssetInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerifiableCredentialRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.DIDAssetInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6810,7 +6938,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DIDAssetInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6821,7 +6949,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DIDAssetInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6833,7 +6961,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) IsValid() bool {
+func (x *fastReflection_DIDAssetInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -6843,9 +6971,9 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DIDAssetInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetVerifiableCredentialRequest)
+		x := input.Message.Interface().(*DIDAssetInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6857,10 +6985,17 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		l = len(x.CredentialId)
+		if x.Asset != nil {
+			l = options.Size(x.Asset)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CurrentOwner)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Owned {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6871,7 +7006,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerifiableCredentialRequest)
+		x := input.Message.Interface().(*DIDAssetInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6890,12 +7025,36 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CredentialId) > 0 {
-			i -= len(x.CredentialId)
-			copy(dAtA[i:], x.CredentialId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+		if x.Owned {
 			i--
-			dAtA[i] = 0xa
+			if x.Owned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.CurrentOwner) > 0 {
+			i -= len(x.CurrentOwner)
+			copy(dAtA[i:], x.CurrentOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrentOwner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Asset != nil {
+			encoded, err := options.Marshal(x.Asset)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6908,7 +7067,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerifiableCredentialRequest)
+		x := input.Message.Interface().(*DIDAssetInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6940,15 +7099,51 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDAssetInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Asset == nil {
+					x.Asset = &DIDAsset{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Asset); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6976,8 +7171,28 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				x.CurrentOwner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Owned = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7014,25 +7229,25 @@ func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *pro
 }
 
 var (
-	md_QueryGetVerifiableCredentialResponse            protoreflect.MessageDescriptor
-	fd_QueryGetVerifiableCredentialResponse_credential protoreflect.FieldDescriptor
+	md_QueryListDIDDocumentsRequest            protoreflect.MessageDescriptor
+	fd_QueryListDIDDocumentsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetVerifiableCredentialResponse = File_did_v1_query_proto.Messages().ByName("QueryGetVerifiableCredentialResponse")
-	fd_QueryGetVerifiableCredentialResponse_credential = md_QueryGetVerifiableCredentialResponse.Fields().ByName("credential")
+	md_QueryListDIDDocumentsRequest = File_did_v1_query_proto.Messages().ByName("QueryListDIDDocumentsRequest")
+	fd_QueryListDIDDocumentsRequest_pagination = md_QueryListDIDDocumentsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetVerifiableCredentialResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryListDIDDocumentsRequest)(nil)
 
-type fastReflection_QueryGetVerifiableCredentialResponse QueryGetVerifiableCredentialResponse
+type fastReflection_QueryListDIDDocumentsRequest QueryListDIDDocumentsRequest
 
-func (x *QueryGetVerifiableCredentialResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetVerifiableCredentialResponse)(x)
+func (x *QueryListDIDDocumentsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryListDIDDocumentsRequest)(x)
 }
 
-func (x *QueryGetVerifiableCredentialResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryListDIDDocumentsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7044,43 +7259,43 @@ func (x *QueryGetVerifiableCredentialResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetVerifiableCredentialResponse_messageType fastReflection_QueryGetVerifiableCredentialResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetVerifiableCredentialResponse_messageType{}
+var _fastReflection_QueryListDIDDocumentsRequest_messageType fastReflection_QueryListDIDDocumentsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryListDIDDocumentsRequest_messageType{}
 
-type fastReflection_QueryGetVerifiableCredentialResponse_messageType struct{}
+type fastReflection_QueryListDIDDocumentsRequest_messageType struct{}
 
-func (x fastReflection_QueryGetVerifiableCredentialResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetVerifiableCredentialResponse)(nil)
+func (x fastReflection_QueryListDIDDocumentsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryListDIDDocumentsRequest)(nil)
 }
-func (x fastReflection_QueryGetVerifiableCredentialResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerifiableCredentialResponse)
+func (x fastReflection_QueryListDIDDocumentsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryListDIDDocumentsRequest)
 }
-func (x fastReflection_QueryGetVerifiableCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerifiableCredentialResponse
+func (x fastReflection_QueryListDIDDocumentsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListDIDDocumentsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetVerifiableCredentialResponse
+func (x *fastReflection_QueryListDIDDocumentsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListDIDDocumentsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetVerifiableCredentialResponse_messageType
+func (x *fastReflection_QueryListDIDDocumentsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryListDIDDocumentsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetVerifiableCredentialResponse)
+func (x *fastReflection_QueryListDIDDocumentsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryListDIDDocumentsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetVerifiableCredentialResponse)(x)
+func (x *fastReflection_QueryListDIDDocumentsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryListDIDDocumentsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7088,10 +7303,10 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Credential != nil {
-		value := protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
-		if !f(fd_QueryGetVerifiableCredentialResponse_credential, value) {
+func (x *fastReflection_QueryListDIDDocumentsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryListDIDDocumentsRequest_pagination, value) {
 			return
 		}
 	}
@@ -7108,15 +7323,15 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryListDIDDocumentsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
-		return x.Credential != nil
+	case "did.v1.QueryListDIDDocumentsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7126,15 +7341,15 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryListDIDDocumentsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
-		x.Credential = nil
+	case "did.v1.QueryListDIDDocumentsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7144,16 +7359,16 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListDIDDocumentsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
-		value := x.Credential
+	case "did.v1.QueryListDIDDocumentsRequest.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7167,15 +7382,15 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryListDIDDocumentsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
-		x.Credential = value.Message().Interface().(*VerifiableCredential)
+	case "did.v1.QueryListDIDDocumentsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7189,44 +7404,44 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListDIDDocumentsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
-		if x.Credential == nil {
-			x.Credential = new(VerifiableCredential)
+	case "did.v1.QueryListDIDDocumentsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListDIDDocumentsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
-		m := new(VerifiableCredential)
+	case "did.v1.QueryListDIDDocumentsRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryListDIDDocumentsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerifiableCredentialResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListDIDDocumentsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7234,7 +7449,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryListDIDDocumentsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7245,7 +7460,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryListDIDDocumentsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7257,7 +7472,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) IsValid() bool {
+func (x *fastReflection_QueryListDIDDocumentsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7267,9 +7482,9 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryListDIDDocumentsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetVerifiableCredentialResponse)
+		x := input.Message.Interface().(*QueryListDIDDocumentsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7281,8 +7496,8 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		if x.Credential != nil {
-			l = options.Size(x.Credential)
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7295,7 +7510,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerifiableCredentialResponse)
+		x := input.Message.Interface().(*QueryListDIDDocumentsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7314,8 +7529,8 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Credential != nil {
-			encoded, err := options.Marshal(x.Credential)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7339,7 +7554,7 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetVerifiableCredentialResponse)
+		x := input.Message.Interface().(*QueryListDIDDocumentsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7371,15 +7586,15 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7406,10 +7621,10 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Credential == nil {
-					x.Credential = &VerifiableCredential{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credential); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7448,32 +7663,79 @@ func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *pr
 	}
 }
 
-var (
-	md_QueryListVerifiableCredentialsRequest                 protoreflect.MessageDescriptor
-	fd_QueryListVerifiableCredentialsRequest_pagination      protoreflect.FieldDescriptor
-	fd_QueryListVerifiableCredentialsRequest_issuer          protoreflect.FieldDescriptor
-	fd_QueryListVerifiableCredentialsRequest_holder          protoreflect.FieldDescriptor
-	fd_QueryListVerifiableCredentialsRequest_include_revoked protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryListDIDDocumentsResponse_1_list)(nil)
 
-func init() {
-	file_did_v1_query_proto_init()
-	md_QueryListVerifiableCredentialsRequest = File_did_v1_query_proto.Messages().ByName("QueryListVerifiableCredentialsRequest")
-	fd_QueryListVerifiableCredentialsRequest_pagination = md_QueryListVerifiableCredentialsRequest.Fields().ByName("pagination")
-	fd_QueryListVerifiableCredentialsRequest_issuer = md_QueryListVerifiableCredentialsRequest.Fields().ByName("issuer")
-	fd_QueryListVerifiableCredentialsRequest_holder = md_QueryListVerifiableCredentialsRequest.Fields().ByName("holder")
-	fd_QueryListVerifiableCredentialsRequest_include_revoked = md_QueryListVerifiableCredentialsRequest.Fields().ByName("include_revoked")
+type _QueryListDIDDocumentsResponse_1_list struct {
+	list *[]*DIDDocument
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryListVerifiableCredentialsRequest)(nil)
+func (x *_QueryListDIDDocumentsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_QueryListVerifiableCredentialsRequest QueryListVerifiableCredentialsRequest
+func (x *_QueryListDIDDocumentsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *QueryListVerifiableCredentialsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryListVerifiableCredentialsRequest)(x)
+func (x *_QueryListDIDDocumentsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *QueryListVerifiableCredentialsRequest) slowProtoReflect() protoreflect.Message {
+func (x *_QueryListDIDDocumentsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryListDIDDocumentsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DIDDocument)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryListDIDDocumentsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryListDIDDocumentsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DIDDocument)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryListDIDDocumentsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryListDIDDocumentsResponse               protoreflect.MessageDescriptor
+	fd_QueryListDIDDocumentsResponse_did_documents protoreflect.FieldDescriptor
+	fd_QueryListDIDDocumentsResponse_pagination    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryListDIDDocumentsResponse = File_did_v1_query_proto.Messages().ByName("QueryListDIDDocumentsResponse")
+	fd_QueryListDIDDocumentsResponse_did_documents = md_QueryListDIDDocumentsResponse.Fields().ByName("did_documents")
+	fd_QueryListDIDDocumentsResponse_pagination = md_QueryListDIDDocumentsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryListDIDDocumentsResponse)(nil)
+
+type fastReflection_QueryListDIDDocumentsResponse QueryListDIDDocumentsResponse
+
+func (x *QueryListDIDDocumentsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryListDIDDocumentsResponse)(x)
+}
+
+func (x *QueryListDIDDocumentsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7485,43 +7747,43 @@ func (x *QueryListVerifiableCredentialsRequest) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryListVerifiableCredentialsRequest_messageType fastReflection_QueryListVerifiableCredentialsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryListVerifiableCredentialsRequest_messageType{}
+var _fastReflection_QueryListDIDDocumentsResponse_messageType fastReflection_QueryListDIDDocumentsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryListDIDDocumentsResponse_messageType{}
 
-type fastReflection_QueryListVerifiableCredentialsRequest_messageType struct{}
+type fastReflection_QueryListDIDDocumentsResponse_messageType struct{}
 
-func (x fastReflection_QueryListVerifiableCredentialsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryListVerifiableCredentialsRequest)(nil)
+func (x fastReflection_QueryListDIDDocumentsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryListDIDDocumentsResponse)(nil)
 }
-func (x fastReflection_QueryListVerifiableCredentialsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryListVerifiableCredentialsRequest)
+func (x fastReflection_QueryListDIDDocumentsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryListDIDDocumentsResponse)
 }
-func (x fastReflection_QueryListVerifiableCredentialsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListVerifiableCredentialsRequest
+func (x fastReflection_QueryListDIDDocumentsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListDIDDocumentsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListVerifiableCredentialsRequest
+func (x *fastReflection_QueryListDIDDocumentsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListDIDDocumentsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryListVerifiableCredentialsRequest_messageType
+func (x *fastReflection_QueryListDIDDocumentsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryListDIDDocumentsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryListVerifiableCredentialsRequest)
+func (x *fastReflection_QueryListDIDDocumentsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryListDIDDocumentsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryListVerifiableCredentialsRequest)(x)
+func (x *fastReflection_QueryListDIDDocumentsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryListDIDDocumentsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7529,28 +7791,16 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryListVerifiableCredentialsRequest_pagination, value) {
-			return
-		}
-	}
-	if x.Issuer != "" {
-		value := protoreflect.ValueOfString(x.Issuer)
-		if !f(fd_QueryListVerifiableCredentialsRequest_issuer, value) {
-			return
-		}
-	}
-	if x.Holder != "" {
-		value := protoreflect.ValueOfString(x.Holder)
-		if !f(fd_QueryListVerifiableCredentialsRequest_holder, value) {
+func (x *fastReflection_QueryListDIDDocumentsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DidDocuments) != 0 {
+		value := protoreflect.ValueOfList(&_QueryListDIDDocumentsResponse_1_list{list: &x.DidDocuments})
+		if !f(fd_QueryListDIDDocumentsResponse_did_documents, value) {
 			return
 		}
 	}
-	if x.IncludeRevoked != false {
-		value := protoreflect.ValueOfBool(x.IncludeRevoked)
-		if !f(fd_QueryListVerifiableCredentialsRequest_include_revoked, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryListDIDDocumentsResponse_pagination, value) {
 			return
 		}
 	}
@@ -7567,21 +7817,17 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryListDIDDocumentsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
+		return len(x.DidDocuments) != 0
+	case "did.v1.QueryListDIDDocumentsResponse.pagination":
 		return x.Pagination != nil
-	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
-		return x.Issuer != ""
-	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
-		return x.Holder != ""
-	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
-		return x.IncludeRevoked != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7591,21 +7837,17 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryListDIDDocumentsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
+		x.DidDocuments = nil
+	case "did.v1.QueryListDIDDocumentsResponse.pagination":
 		x.Pagination = nil
-	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
-		x.Issuer = ""
-	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
-		x.Holder = ""
-	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
-		x.IncludeRevoked = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7615,25 +7857,22 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListDIDDocumentsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
+		if len(x.DidDocuments) == 0 {
+			return protoreflect.ValueOfList(&_QueryListDIDDocumentsResponse_1_list{})
+		}
+		listValue := &_QueryListDIDDocumentsResponse_1_list{list: &x.DidDocuments}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.QueryListDIDDocumentsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
-		value := x.Issuer
-		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
-		value := x.Holder
-		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
-		value := x.IncludeRevoked
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7647,21 +7886,19 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryListDIDDocumentsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
-	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
-		x.Issuer = value.Interface().(string)
-	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
-		x.Holder = value.Interface().(string)
-	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
-		x.IncludeRevoked = value.Bool()
+	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
+		lv := value.List()
+		clv := lv.(*_QueryListDIDDocumentsResponse_1_list)
+		x.DidDocuments = *clv.list
+	case "did.v1.QueryListDIDDocumentsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7675,56 +7912,53 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListDIDDocumentsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
+		if x.DidDocuments == nil {
+			x.DidDocuments = []*DIDDocument{}
+		}
+		value := &_QueryListDIDDocumentsResponse_1_list{list: &x.DidDocuments}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.QueryListDIDDocumentsResponse.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
-		panic(fmt.Errorf("field issuer of message did.v1.QueryListVerifiableCredentialsRequest is not mutable"))
-	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
-		panic(fmt.Errorf("field holder of message did.v1.QueryListVerifiableCredentialsRequest is not mutable"))
-	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
-		panic(fmt.Errorf("field include_revoked of message did.v1.QueryListVerifiableCredentialsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryListDIDDocumentsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
-		m := new(v1beta1.PageRequest)
+	case "did.v1.QueryListDIDDocumentsResponse.did_documents":
+		list := []*DIDDocument{}
+		return protoreflect.ValueOfList(&_QueryListDIDDocumentsResponse_1_list{list: &list})
+	case "did.v1.QueryListDIDDocumentsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
-		return protoreflect.ValueOfString("")
-	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
-		return protoreflect.ValueOfString("")
-	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListDIDDocumentsResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryListDIDDocumentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryListDIDDocumentsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListVerifiableCredentialsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListDIDDocumentsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7732,7 +7966,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryListDIDDocumentsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7743,7 +7977,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryListDIDDocumentsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7755,7 +7989,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) IsValid() bool {
+func (x *fastReflection_QueryListDIDDocumentsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7765,9 +7999,9 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryListDIDDocumentsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryListVerifiableCredentialsRequest)
+		x := input.Message.Interface().(*QueryListDIDDocumentsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7779,21 +8013,16 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Issuer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.DidDocuments) > 0 {
+			for _, e := range x.DidDocuments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Holder)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IncludeRevoked {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7804,7 +8033,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListVerifiableCredentialsRequest)
+		x := input.Message.Interface().(*QueryListDIDDocumentsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7823,30 +8052,6 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IncludeRevoked {
-			i--
-			if x.IncludeRevoked {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Holder) > 0 {
-			i -= len(x.Holder)
-			copy(dAtA[i:], x.Holder)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Holder)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Issuer) > 0 {
-			i -= len(x.Issuer)
-			copy(dAtA[i:], x.Issuer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -7859,7 +8064,23 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.DidDocuments) > 0 {
+			for iNdEx := len(x.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DidDocuments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7872,7 +8093,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListVerifiableCredentialsRequest)
+		x := input.Message.Interface().(*QueryListDIDDocumentsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7904,15 +8125,15 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListDIDDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7939,18 +8160,16 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				x.DidDocuments = append(x.DidDocuments, &DIDDocument{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDocuments[len(x.DidDocuments)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7960,76 +8179,28 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Issuer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Holder = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeRevoked", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IncludeRevoked = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8065,79 +8236,28 @@ func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *p
 	}
 }
 
-var _ protoreflect.List = (*_QueryListVerifiableCredentialsResponse_1_list)(nil)
-
-type _QueryListVerifiableCredentialsResponse_1_list struct {
-	list *[]*VerifiableCredential
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerifiableCredential)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*VerifiableCredential)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(VerifiableCredential)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(VerifiableCredential)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryListVerifiableCredentialsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryListVerifiableCredentialsResponse             protoreflect.MessageDescriptor
-	fd_QueryListVerifiableCredentialsResponse_credentials protoreflect.FieldDescriptor
-	fd_QueryListVerifiableCredentialsResponse_pagination  protoreflect.FieldDescriptor
+	md_QueryGetDIDDocumentsByControllerRequest            protoreflect.MessageDescriptor
+	fd_QueryGetDIDDocumentsByControllerRequest_controller protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentsByControllerRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryListVerifiableCredentialsResponse = File_did_v1_query_proto.Messages().ByName("QueryListVerifiableCredentialsResponse")
-	fd_QueryListVerifiableCredentialsResponse_credentials = md_QueryListVerifiableCredentialsResponse.Fields().ByName("credentials")
-	fd_QueryListVerifiableCredentialsResponse_pagination = md_QueryListVerifiableCredentialsResponse.Fields().ByName("pagination")
+	md_QueryGetDIDDocumentsByControllerRequest = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentsByControllerRequest")
+	fd_QueryGetDIDDocumentsByControllerRequest_controller = md_QueryGetDIDDocumentsByControllerRequest.Fields().ByName("controller")
+	fd_QueryGetDIDDocumentsByControllerRequest_pagination = md_QueryGetDIDDocumentsByControllerRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryListVerifiableCredentialsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentsByControllerRequest)(nil)
 
-type fastReflection_QueryListVerifiableCredentialsResponse QueryListVerifiableCredentialsResponse
+type fastReflection_QueryGetDIDDocumentsByControllerRequest QueryGetDIDDocumentsByControllerRequest
 
-func (x *QueryListVerifiableCredentialsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryListVerifiableCredentialsResponse)(x)
+func (x *QueryGetDIDDocumentsByControllerRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentsByControllerRequest)(x)
 }
 
-func (x *QueryListVerifiableCredentialsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDDocumentsByControllerRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8149,43 +8269,43 @@ func (x *QueryListVerifiableCredentialsResponse) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryListVerifiableCredentialsResponse_messageType fastReflection_QueryListVerifiableCredentialsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryListVerifiableCredentialsResponse_messageType{}
+var _fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType{}
 
-type fastReflection_QueryListVerifiableCredentialsResponse_messageType struct{}
+type fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType struct{}
 
-func (x fastReflection_QueryListVerifiableCredentialsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryListVerifiableCredentialsResponse)(nil)
+func (x fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentsByControllerRequest)(nil)
 }
-func (x fastReflection_QueryListVerifiableCredentialsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryListVerifiableCredentialsResponse)
+func (x fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentsByControllerRequest)
 }
-func (x fastReflection_QueryListVerifiableCredentialsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListVerifiableCredentialsResponse
+func (x fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentsByControllerRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryListVerifiableCredentialsResponse
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentsByControllerRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryListVerifiableCredentialsResponse_messageType
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDDocumentsByControllerRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryListVerifiableCredentialsResponse)
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentsByControllerRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryListVerifiableCredentialsResponse)(x)
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDDocumentsByControllerRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8193,16 +8313,16 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Credentials) != 0 {
-		value := protoreflect.ValueOfList(&_QueryListVerifiableCredentialsResponse_1_list{list: &x.Credentials})
-		if !f(fd_QueryListVerifiableCredentialsResponse_credentials, value) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_QueryGetDIDDocumentsByControllerRequest_controller, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryListVerifiableCredentialsResponse_pagination, value) {
+		if !f(fd_QueryGetDIDDocumentsByControllerRequest_pagination, value) {
 			return
 		}
 	}
@@ -8219,17 +8339,17 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
-		return len(x.Credentials) != 0
-	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+		return x.Controller != ""
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8239,17 +8359,17 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
-		x.Credentials = nil
-	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+		x.Controller = ""
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8259,22 +8379,19 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
-		if len(x.Credentials) == 0 {
-			return protoreflect.ValueOfList(&_QueryListVerifiableCredentialsResponse_1_list{})
-		}
-		listValue := &_QueryListVerifiableCredentialsResponse_1_list{list: &x.Credentials}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8288,19 +8405,17 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
-		lv := value.List()
-		clv := lv.(*_QueryListVerifiableCredentialsResponse_1_list)
-		x.Credentials = *clv.list
-	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8314,53 +8429,48 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
-		if x.Credentials == nil {
-			x.Credentials = []*VerifiableCredential{}
-		}
-		value := &_QueryListVerifiableCredentialsResponse_1_list{list: &x.Credentials}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+		panic(fmt.Errorf("field controller of message did.v1.QueryGetDIDDocumentsByControllerRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
-		list := []*VerifiableCredential{}
-		return protoreflect.ValueOfList(&_QueryListVerifiableCredentialsResponse_1_list{list: &list})
-	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetDIDDocumentsByControllerRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListVerifiableCredentialsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentsByControllerRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8368,7 +8478,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8379,7 +8489,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8391,7 +8501,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) IsValid() bool {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8401,9 +8511,9 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryListVerifiableCredentialsResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8415,11 +8525,9 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 		var n int
 		var l int
 		_ = l
-		if len(x.Credentials) > 0 {
-			for _, e := range x.Credentials {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -8435,7 +8543,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListVerifiableCredentialsResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8468,21 +8576,12 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Credentials) > 0 {
-			for iNdEx := len(x.Credentials) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Credentials[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8495,7 +8594,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryListVerifiableCredentialsResponse)
+		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8527,17 +8626,17 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8547,25 +8646,23 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Credentials = append(x.Credentials, &VerifiableCredential{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credentials[len(x.Credentials)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -8597,7 +8694,7 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+					x.Pagination = &v1beta1.PageRequest{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -8638,32 +8735,79 @@ func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *
 	}
 }
 
+var _ protoreflect.List = (*_QueryGetDIDDocumentsByControllerResponse_1_list)(nil)
+
+type _QueryGetDIDDocumentsByControllerResponse_1_list struct {
+	list *[]*DIDDocument
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DIDDocument)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DIDDocument)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DIDDocument)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetDIDDocumentsByControllerResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_CredentialInfo                       protoreflect.MessageDescriptor
-	fd_CredentialInfo_verifiable_credential protoreflect.FieldDescriptor
-	fd_CredentialInfo_webauthn_credential   protoreflect.FieldDescriptor
-	fd_CredentialInfo_vault_id              protoreflect.FieldDescriptor
-	fd_CredentialInfo_is_encrypted          protoreflect.FieldDescriptor
+	md_QueryGetDIDDocumentsByControllerResponse               protoreflect.MessageDescriptor
+	fd_QueryGetDIDDocumentsByControllerResponse_did_documents protoreflect.FieldDescriptor
+	fd_QueryGetDIDDocumentsByControllerResponse_pagination    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_CredentialInfo = File_did_v1_query_proto.Messages().ByName("CredentialInfo")
-	fd_CredentialInfo_verifiable_credential = md_CredentialInfo.Fields().ByName("verifiable_credential")
-	fd_CredentialInfo_webauthn_credential = md_CredentialInfo.Fields().ByName("webauthn_credential")
-	fd_CredentialInfo_vault_id = md_CredentialInfo.Fields().ByName("vault_id")
-	fd_CredentialInfo_is_encrypted = md_CredentialInfo.Fields().ByName("is_encrypted")
+	md_QueryGetDIDDocumentsByControllerResponse = File_did_v1_query_proto.Messages().ByName("QueryGetDIDDocumentsByControllerResponse")
+	fd_QueryGetDIDDocumentsByControllerResponse_did_documents = md_QueryGetDIDDocumentsByControllerResponse.Fields().ByName("did_documents")
+	fd_QueryGetDIDDocumentsByControllerResponse_pagination = md_QueryGetDIDDocumentsByControllerResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_CredentialInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetDIDDocumentsByControllerResponse)(nil)
 
-type fastReflection_CredentialInfo CredentialInfo
+type fastReflection_QueryGetDIDDocumentsByControllerResponse QueryGetDIDDocumentsByControllerResponse
 
-func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CredentialInfo)(x)
+func (x *QueryGetDIDDocumentsByControllerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentsByControllerResponse)(x)
 }
 
-func (x *CredentialInfo) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetDIDDocumentsByControllerResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8675,43 +8819,43 @@ func (x *CredentialInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CredentialInfo_messageType fastReflection_CredentialInfo_messageType
-var _ protoreflect.MessageType = fastReflection_CredentialInfo_messageType{}
+var _fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType{}
 
-type fastReflection_CredentialInfo_messageType struct{}
+type fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType struct{}
 
-func (x fastReflection_CredentialInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CredentialInfo)(nil)
+func (x fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDIDDocumentsByControllerResponse)(nil)
 }
-func (x fastReflection_CredentialInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_CredentialInfo)
+func (x fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentsByControllerResponse)
 }
-func (x fastReflection_CredentialInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CredentialInfo
+func (x fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentsByControllerResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CredentialInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_CredentialInfo
-}
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDIDDocumentsByControllerResponse
+}
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CredentialInfo) Type() protoreflect.MessageType {
-	return _fastReflection_CredentialInfo_messageType
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDIDDocumentsByControllerResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CredentialInfo) New() protoreflect.Message {
-	return new(fastReflection_CredentialInfo)
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDIDDocumentsByControllerResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CredentialInfo) Interface() protoreflect.ProtoMessage {
-	return (*CredentialInfo)(x)
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDIDDocumentsByControllerResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8719,32 +8863,16 @@ func (x *fastReflection_CredentialInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CredentialInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Credential != nil {
-		switch o := x.Credential.(type) {
-		case *CredentialInfo_VerifiableCredential:
-			v := o.VerifiableCredential
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_CredentialInfo_verifiable_credential, value) {
-				return
-			}
-		case *CredentialInfo_WebauthnCredential:
-			v := o.WebauthnCredential
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_CredentialInfo_webauthn_credential, value) {
-				return
-			}
-		}
-	}
-	if x.VaultId != "" {
-		value := protoreflect.ValueOfString(x.VaultId)
-		if !f(fd_CredentialInfo_vault_id, value) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DidDocuments) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetDIDDocumentsByControllerResponse_1_list{list: &x.DidDocuments})
+		if !f(fd_QueryGetDIDDocumentsByControllerResponse_did_documents, value) {
 			return
 		}
 	}
-	if x.IsEncrypted != false {
-		value := protoreflect.ValueOfBool(x.IsEncrypted)
-		if !f(fd_CredentialInfo_is_encrypted, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetDIDDocumentsByControllerResponse_pagination, value) {
 			return
 		}
 	}
@@ -8761,33 +8889,17 @@ func (x *fastReflection_CredentialInfo) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CredentialInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.CredentialInfo.verifiable_credential":
-		if x.Credential == nil {
-			return false
-		} else if _, ok := x.Credential.(*CredentialInfo_VerifiableCredential); ok {
-			return true
-		} else {
-			return false
-		}
-	case "did.v1.CredentialInfo.webauthn_credential":
-		if x.Credential == nil {
-			return false
-		} else if _, ok := x.Credential.(*CredentialInfo_WebauthnCredential); ok {
-			return true
-		} else {
-			return false
-		}
-	case "did.v1.CredentialInfo.vault_id":
-		return x.VaultId != ""
-	case "did.v1.CredentialInfo.is_encrypted":
-		return x.IsEncrypted != false
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+		return len(x.DidDocuments) != 0
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8797,21 +8909,17 @@ func (x *fastReflection_CredentialInfo) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CredentialInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.CredentialInfo.verifiable_credential":
-		x.Credential = nil
-	case "did.v1.CredentialInfo.webauthn_credential":
-		x.Credential = nil
-	case "did.v1.CredentialInfo.vault_id":
-		x.VaultId = ""
-	case "did.v1.CredentialInfo.is_encrypted":
-		x.IsEncrypted = false
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+		x.DidDocuments = nil
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8821,35 +8929,22 @@ func (x *fastReflection_CredentialInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CredentialInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.CredentialInfo.verifiable_credential":
-		if x.Credential == nil {
-			return protoreflect.ValueOfMessage((*VerifiableCredential)(nil).ProtoReflect())
-		} else if v, ok := x.Credential.(*CredentialInfo_VerifiableCredential); ok {
-			return protoreflect.ValueOfMessage(v.VerifiableCredential.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*VerifiableCredential)(nil).ProtoReflect())
-		}
-	case "did.v1.CredentialInfo.webauthn_credential":
-		if x.Credential == nil {
-			return protoreflect.ValueOfMessage((*WebAuthnCredential)(nil).ProtoReflect())
-		} else if v, ok := x.Credential.(*CredentialInfo_WebauthnCredential); ok {
-			return protoreflect.ValueOfMessage(v.WebauthnCredential.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*WebAuthnCredential)(nil).ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+		if len(x.DidDocuments) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetDIDDocumentsByControllerResponse_1_list{})
 		}
-	case "did.v1.CredentialInfo.vault_id":
-		value := x.VaultId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.CredentialInfo.is_encrypted":
-		value := x.IsEncrypted
-		return protoreflect.ValueOfBool(value)
+		listValue := &_QueryGetDIDDocumentsByControllerResponse_1_list{list: &x.DidDocuments}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8863,23 +8958,19 @@ func (x *fastReflection_CredentialInfo) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CredentialInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.CredentialInfo.verifiable_credential":
-		cv := value.Message().Interface().(*VerifiableCredential)
-		x.Credential = &CredentialInfo_VerifiableCredential{VerifiableCredential: cv}
-	case "did.v1.CredentialInfo.webauthn_credential":
-		cv := value.Message().Interface().(*WebAuthnCredential)
-		x.Credential = &CredentialInfo_WebauthnCredential{WebauthnCredential: cv}
-	case "did.v1.CredentialInfo.vault_id":
-		x.VaultId = value.Interface().(string)
-	case "did.v1.CredentialInfo.is_encrypted":
-		x.IsEncrypted = value.Bool()
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+		lv := value.List()
+		clv := lv.(*_QueryGetDIDDocumentsByControllerResponse_1_list)
+		x.DidDocuments = *clv.list
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8893,92 +8984,53 @@ func (x *fastReflection_CredentialInfo) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CredentialInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.CredentialInfo.verifiable_credential":
-		if x.Credential == nil {
-			value := &VerifiableCredential{}
-			oneofValue := &CredentialInfo_VerifiableCredential{VerifiableCredential: value}
-			x.Credential = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.Credential.(type) {
-		case *CredentialInfo_VerifiableCredential:
-			return protoreflect.ValueOfMessage(m.VerifiableCredential.ProtoReflect())
-		default:
-			value := &VerifiableCredential{}
-			oneofValue := &CredentialInfo_VerifiableCredential{VerifiableCredential: value}
-			x.Credential = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "did.v1.CredentialInfo.webauthn_credential":
-		if x.Credential == nil {
-			value := &WebAuthnCredential{}
-			oneofValue := &CredentialInfo_WebauthnCredential{WebauthnCredential: value}
-			x.Credential = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+		if x.DidDocuments == nil {
+			x.DidDocuments = []*DIDDocument{}
 		}
-		switch m := x.Credential.(type) {
-		case *CredentialInfo_WebauthnCredential:
-			return protoreflect.ValueOfMessage(m.WebauthnCredential.ProtoReflect())
-		default:
-			value := &WebAuthnCredential{}
-			oneofValue := &CredentialInfo_WebauthnCredential{WebauthnCredential: value}
-			x.Credential = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		value := &_QueryGetDIDDocumentsByControllerResponse_1_list{list: &x.DidDocuments}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
 		}
-	case "did.v1.CredentialInfo.vault_id":
-		panic(fmt.Errorf("field vault_id of message did.v1.CredentialInfo is not mutable"))
-	case "did.v1.CredentialInfo.is_encrypted":
-		panic(fmt.Errorf("field is_encrypted of message did.v1.CredentialInfo is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CredentialInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.CredentialInfo.verifiable_credential":
-		value := &VerifiableCredential{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.CredentialInfo.webauthn_credential":
-		value := &WebAuthnCredential{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.CredentialInfo.vault_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.CredentialInfo.is_encrypted":
-		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents":
+		list := []*DIDDocument{}
+		return protoreflect.ValueOfList(&_QueryGetDIDDocumentsByControllerResponse_1_list{list: &list})
+	case "did.v1.QueryGetDIDDocumentsByControllerResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetDIDDocumentsByControllerResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetDIDDocumentsByControllerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CredentialInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
-	case "did.v1.CredentialInfo.credential":
-		if x.Credential == nil {
-			return nil
-		}
-		switch x.Credential.(type) {
-		case *CredentialInfo_VerifiableCredential:
-			return x.Descriptor().Fields().ByName("verifiable_credential")
-		case *CredentialInfo_WebauthnCredential:
-			return x.Descriptor().Fields().ByName("webauthn_credential")
-		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.CredentialInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetDIDDocumentsByControllerResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8986,7 +9038,7 @@ func (x *fastReflection_CredentialInfo) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CredentialInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8997,7 +9049,7 @@ func (x *fastReflection_CredentialInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CredentialInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9009,7 +9061,7 @@ func (x *fastReflection_CredentialInfo) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CredentialInfo) IsValid() bool {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9019,9 +9071,9 @@ func (x *fastReflection_CredentialInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetDIDDocumentsByControllerResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CredentialInfo)
+		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9033,27 +9085,16 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		switch x := x.Credential.(type) {
-		case *CredentialInfo_VerifiableCredential:
-			if x == nil {
-				break
+		if len(x.DidDocuments) > 0 {
+			for _, e := range x.DidDocuments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
-			l = options.Size(x.VerifiableCredential)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *CredentialInfo_WebauthnCredential:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.WebauthnCredential)
-			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VaultId)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IsEncrypted {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9064,7 +9105,7 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CredentialInfo)
+		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9083,22 +9124,8 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		switch x := x.Credential.(type) {
-		case *CredentialInfo_VerifiableCredential:
-			encoded, err := options.Marshal(x.VerifiableCredential)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		case *CredentialInfo_WebauthnCredential:
-			encoded, err := options.Marshal(x.WebauthnCredential)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9111,22 +9138,21 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.IsEncrypted {
-			i--
-			if x.IsEncrypted {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.DidDocuments) > 0 {
+			for iNdEx := len(x.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DidDocuments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.VaultId) > 0 {
-			i -= len(x.VaultId)
-			copy(dAtA[i:], x.VaultId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultId)))
-			i--
-			dAtA[i] = 0x1a
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9139,7 +9165,7 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CredentialInfo)
+		x := input.Message.Interface().(*QueryGetDIDDocumentsByControllerResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9171,15 +9197,15 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CredentialInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CredentialInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiableCredential", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9206,15 +9232,14 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &VerifiableCredential{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+				x.DidDocuments = append(x.DidDocuments, &DIDDocument{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DidDocuments[len(x.DidDocuments)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Credential = &CredentialInfo_VerifiableCredential{v}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredential", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9241,64 +9266,13 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &WebAuthnCredential{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Credential = &CredentialInfo_WebauthnCredential{v}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.VaultId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsEncrypted", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IsEncrypted = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9335,33 +9309,27 @@ func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryGetCredentialsByDIDRequest                    protoreflect.MessageDescriptor
-	fd_QueryGetCredentialsByDIDRequest_did                protoreflect.FieldDescriptor
-	fd_QueryGetCredentialsByDIDRequest_include_verifiable protoreflect.FieldDescriptor
-	fd_QueryGetCredentialsByDIDRequest_include_webauthn   protoreflect.FieldDescriptor
-	fd_QueryGetCredentialsByDIDRequest_include_revoked    protoreflect.FieldDescriptor
-	fd_QueryGetCredentialsByDIDRequest_pagination         protoreflect.FieldDescriptor
+	md_QueryGetVerificationMethodRequest           protoreflect.MessageDescriptor
+	fd_QueryGetVerificationMethodRequest_did       protoreflect.FieldDescriptor
+	fd_QueryGetVerificationMethodRequest_method_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetCredentialsByDIDRequest = File_did_v1_query_proto.Messages().ByName("QueryGetCredentialsByDIDRequest")
-	fd_QueryGetCredentialsByDIDRequest_did = md_QueryGetCredentialsByDIDRequest.Fields().ByName("did")
-	fd_QueryGetCredentialsByDIDRequest_include_verifiable = md_QueryGetCredentialsByDIDRequest.Fields().ByName("include_verifiable")
-	fd_QueryGetCredentialsByDIDRequest_include_webauthn = md_QueryGetCredentialsByDIDRequest.Fields().ByName("include_webauthn")
-	fd_QueryGetCredentialsByDIDRequest_include_revoked = md_QueryGetCredentialsByDIDRequest.Fields().ByName("include_revoked")
-	fd_QueryGetCredentialsByDIDRequest_pagination = md_QueryGetCredentialsByDIDRequest.Fields().ByName("pagination")
+	md_QueryGetVerificationMethodRequest = File_did_v1_query_proto.Messages().ByName("QueryGetVerificationMethodRequest")
+	fd_QueryGetVerificationMethodRequest_did = md_QueryGetVerificationMethodRequest.Fields().ByName("did")
+	fd_QueryGetVerificationMethodRequest_method_id = md_QueryGetVerificationMethodRequest.Fields().ByName("method_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetCredentialsByDIDRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetVerificationMethodRequest)(nil)
 
-type fastReflection_QueryGetCredentialsByDIDRequest QueryGetCredentialsByDIDRequest
+type fastReflection_QueryGetVerificationMethodRequest QueryGetVerificationMethodRequest
 
-func (x *QueryGetCredentialsByDIDRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetCredentialsByDIDRequest)(x)
+func (x *QueryGetVerificationMethodRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetVerificationMethodRequest)(x)
 }
 
-func (x *QueryGetCredentialsByDIDRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetVerificationMethodRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9373,43 +9341,43 @@ func (x *QueryGetCredentialsByDIDRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetCredentialsByDIDRequest_messageType fastReflection_QueryGetCredentialsByDIDRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetCredentialsByDIDRequest_messageType{}
+var _fastReflection_QueryGetVerificationMethodRequest_messageType fastReflection_QueryGetVerificationMethodRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetVerificationMethodRequest_messageType{}
 
-type fastReflection_QueryGetCredentialsByDIDRequest_messageType struct{}
+type fastReflection_QueryGetVerificationMethodRequest_messageType struct{}
 
-func (x fastReflection_QueryGetCredentialsByDIDRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetCredentialsByDIDRequest)(nil)
+func (x fastReflection_QueryGetVerificationMethodRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetVerificationMethodRequest)(nil)
 }
-func (x fastReflection_QueryGetCredentialsByDIDRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetCredentialsByDIDRequest)
+func (x fastReflection_QueryGetVerificationMethodRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerificationMethodRequest)
 }
-func (x fastReflection_QueryGetCredentialsByDIDRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetCredentialsByDIDRequest
+func (x fastReflection_QueryGetVerificationMethodRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerificationMethodRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetCredentialsByDIDRequest
+func (x *fastReflection_QueryGetVerificationMethodRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerificationMethodRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetCredentialsByDIDRequest_messageType
+func (x *fastReflection_QueryGetVerificationMethodRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetVerificationMethodRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetCredentialsByDIDRequest)
+func (x *fastReflection_QueryGetVerificationMethodRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerificationMethodRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetCredentialsByDIDRequest)(x)
+func (x *fastReflection_QueryGetVerificationMethodRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetVerificationMethodRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9417,34 +9385,16 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGetVerificationMethodRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_QueryGetCredentialsByDIDRequest_did, value) {
-			return
-		}
-	}
-	if x.IncludeVerifiable != false {
-		value := protoreflect.ValueOfBool(x.IncludeVerifiable)
-		if !f(fd_QueryGetCredentialsByDIDRequest_include_verifiable, value) {
-			return
-		}
-	}
-	if x.IncludeWebauthn != false {
-		value := protoreflect.ValueOfBool(x.IncludeWebauthn)
-		if !f(fd_QueryGetCredentialsByDIDRequest_include_webauthn, value) {
-			return
-		}
-	}
-	if x.IncludeRevoked != false {
-		value := protoreflect.ValueOfBool(x.IncludeRevoked)
-		if !f(fd_QueryGetCredentialsByDIDRequest_include_revoked, value) {
+		if !f(fd_QueryGetVerificationMethodRequest_did, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetCredentialsByDIDRequest_pagination, value) {
+	if x.MethodId != "" {
+		value := protoreflect.ValueOfString(x.MethodId)
+		if !f(fd_QueryGetVerificationMethodRequest_method_id, value) {
 			return
 		}
 	}
@@ -9461,23 +9411,17 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetVerificationMethodRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+	case "did.v1.QueryGetVerificationMethodRequest.did":
 		return x.Did != ""
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
-		return x.IncludeVerifiable != false
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
-		return x.IncludeWebauthn != false
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
-		return x.IncludeRevoked != false
-	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
-		return x.Pagination != nil
+	case "did.v1.QueryGetVerificationMethodRequest.method_id":
+		return x.MethodId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9487,23 +9431,17 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetVerificationMethodRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+	case "did.v1.QueryGetVerificationMethodRequest.did":
 		x.Did = ""
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
-		x.IncludeVerifiable = false
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
-		x.IncludeWebauthn = false
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
-		x.IncludeRevoked = false
-	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
-		x.Pagination = nil
-	default:
+	case "did.v1.QueryGetVerificationMethodRequest.method_id":
+		x.MethodId = ""
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9513,28 +9451,19 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerificationMethodRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+	case "did.v1.QueryGetVerificationMethodRequest.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
-		value := x.IncludeVerifiable
-		return protoreflect.ValueOfBool(value)
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
-		value := x.IncludeWebauthn
-		return protoreflect.ValueOfBool(value)
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
-		value := x.IncludeRevoked
-		return protoreflect.ValueOfBool(value)
-	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetVerificationMethodRequest.method_id":
+		value := x.MethodId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9548,23 +9477,17 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetVerificationMethodRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+	case "did.v1.QueryGetVerificationMethodRequest.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
-		x.IncludeVerifiable = value.Bool()
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
-		x.IncludeWebauthn = value.Bool()
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
-		x.IncludeRevoked = value.Bool()
-	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "did.v1.QueryGetVerificationMethodRequest.method_id":
+		x.MethodId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9578,60 +9501,44 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerificationMethodRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "did.v1.QueryGetCredentialsByDIDRequest.did":
-		panic(fmt.Errorf("field did of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
-		panic(fmt.Errorf("field include_verifiable of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
-		panic(fmt.Errorf("field include_webauthn of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
-		panic(fmt.Errorf("field include_revoked of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
+	case "did.v1.QueryGetVerificationMethodRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetVerificationMethodRequest is not mutable"))
+	case "did.v1.QueryGetVerificationMethodRequest.method_id":
+		panic(fmt.Errorf("field method_id of message did.v1.QueryGetVerificationMethodRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerificationMethodRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+	case "did.v1.QueryGetVerificationMethodRequest.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetVerificationMethodRequest.method_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
-		return protoreflect.ValueOfBool(false)
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
-		return protoreflect.ValueOfBool(false)
-	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
-		return protoreflect.ValueOfBool(false)
-	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetVerificationMethodRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetCredentialsByDIDRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerificationMethodRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9639,7 +9546,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetVerificationMethodRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9650,7 +9557,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetVerificationMethodRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9662,7 +9569,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) IsValid() bool {
+func (x *fastReflection_QueryGetVerificationMethodRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9672,9 +9579,9 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetVerificationMethodRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetCredentialsByDIDRequest)
+		x := input.Message.Interface().(*QueryGetVerificationMethodRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9690,17 +9597,8 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IncludeVerifiable {
-			n += 2
-		}
-		if x.IncludeWebauthn {
-			n += 2
-		}
-		if x.IncludeRevoked {
-			n += 2
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.MethodId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9713,7 +9611,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetCredentialsByDIDRequest)
+		x := input.Message.Interface().(*QueryGetVerificationMethodRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9732,49 +9630,12 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.IncludeRevoked {
-			i--
-			if x.IncludeRevoked {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.IncludeWebauthn {
-			i--
-			if x.IncludeWebauthn {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.IncludeVerifiable {
-			i--
-			if x.IncludeVerifiable {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.MethodId) > 0 {
+			i -= len(x.MethodId)
+			copy(dAtA[i:], x.MethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MethodId)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
@@ -9794,7 +9655,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetCredentialsByDIDRequest)
+		x := input.Message.Interface().(*QueryGetVerificationMethodRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9826,10 +9687,10 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -9865,70 +9726,10 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeVerifiable", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IncludeVerifiable = bool(v != 0)
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeWebauthn", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IncludeWebauthn = bool(v != 0)
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeRevoked", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IncludeRevoked = bool(v != 0)
-			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9938,27 +9739,23 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.MethodId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9995,79 +9792,26 @@ func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoif
 	}
 }
 
-var _ protoreflect.List = (*_QueryGetCredentialsByDIDResponse_1_list)(nil)
-
-type _QueryGetCredentialsByDIDResponse_1_list struct {
-	list *[]*CredentialInfo
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CredentialInfo)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CredentialInfo)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(CredentialInfo)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) NewElement() protoreflect.Value {
-	v := new(CredentialInfo)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryGetCredentialsByDIDResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryGetCredentialsByDIDResponse             protoreflect.MessageDescriptor
-	fd_QueryGetCredentialsByDIDResponse_credentials protoreflect.FieldDescriptor
-	fd_QueryGetCredentialsByDIDResponse_pagination  protoreflect.FieldDescriptor
-)
+var (
+	md_QueryGetVerificationMethodResponse                     protoreflect.MessageDescriptor
+	fd_QueryGetVerificationMethodResponse_verification_method protoreflect.FieldDescriptor
+)
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryGetCredentialsByDIDResponse = File_did_v1_query_proto.Messages().ByName("QueryGetCredentialsByDIDResponse")
-	fd_QueryGetCredentialsByDIDResponse_credentials = md_QueryGetCredentialsByDIDResponse.Fields().ByName("credentials")
-	fd_QueryGetCredentialsByDIDResponse_pagination = md_QueryGetCredentialsByDIDResponse.Fields().ByName("pagination")
+	md_QueryGetVerificationMethodResponse = File_did_v1_query_proto.Messages().ByName("QueryGetVerificationMethodResponse")
+	fd_QueryGetVerificationMethodResponse_verification_method = md_QueryGetVerificationMethodResponse.Fields().ByName("verification_method")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetCredentialsByDIDResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetVerificationMethodResponse)(nil)
 
-type fastReflection_QueryGetCredentialsByDIDResponse QueryGetCredentialsByDIDResponse
+type fastReflection_QueryGetVerificationMethodResponse QueryGetVerificationMethodResponse
 
-func (x *QueryGetCredentialsByDIDResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetCredentialsByDIDResponse)(x)
+func (x *QueryGetVerificationMethodResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetVerificationMethodResponse)(x)
 }
 
-func (x *QueryGetCredentialsByDIDResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetVerificationMethodResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10079,43 +9823,43 @@ func (x *QueryGetCredentialsByDIDResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetCredentialsByDIDResponse_messageType fastReflection_QueryGetCredentialsByDIDResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetCredentialsByDIDResponse_messageType{}
+var _fastReflection_QueryGetVerificationMethodResponse_messageType fastReflection_QueryGetVerificationMethodResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetVerificationMethodResponse_messageType{}
 
-type fastReflection_QueryGetCredentialsByDIDResponse_messageType struct{}
+type fastReflection_QueryGetVerificationMethodResponse_messageType struct{}
 
-func (x fastReflection_QueryGetCredentialsByDIDResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetCredentialsByDIDResponse)(nil)
+func (x fastReflection_QueryGetVerificationMethodResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetVerificationMethodResponse)(nil)
 }
-func (x fastReflection_QueryGetCredentialsByDIDResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetCredentialsByDIDResponse)
+func (x fastReflection_QueryGetVerificationMethodResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerificationMethodResponse)
 }
-func (x fastReflection_QueryGetCredentialsByDIDResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetCredentialsByDIDResponse
+func (x fastReflection_QueryGetVerificationMethodResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerificationMethodResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetCredentialsByDIDResponse
+func (x *fastReflection_QueryGetVerificationMethodResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerificationMethodResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetCredentialsByDIDResponse_messageType
+func (x *fastReflection_QueryGetVerificationMethodResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetVerificationMethodResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetCredentialsByDIDResponse)
+func (x *fastReflection_QueryGetVerificationMethodResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerificationMethodResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetCredentialsByDIDResponse)(x)
+func (x *fastReflection_QueryGetVerificationMethodResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetVerificationMethodResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10123,16 +9867,10 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Credentials) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGetCredentialsByDIDResponse_1_list{list: &x.Credentials})
-		if !f(fd_QueryGetCredentialsByDIDResponse_credentials, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetCredentialsByDIDResponse_pagination, value) {
+func (x *fastReflection_QueryGetVerificationMethodResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VerificationMethod != nil {
+		value := protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
+		if !f(fd_QueryGetVerificationMethodResponse_verification_method, value) {
 			return
 		}
 	}
@@ -10149,17 +9887,15 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetVerificationMethodResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
-		return len(x.Credentials) != 0
-	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
-		return x.Pagination != nil
+	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
+		return x.VerificationMethod != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10169,17 +9905,15 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetVerificationMethodResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
-		x.Credentials = nil
-	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
-		x.Pagination = nil
+	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
+		x.VerificationMethod = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10189,22 +9923,16 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerificationMethodResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
-		if len(x.Credentials) == 0 {
-			return protoreflect.ValueOfList(&_QueryGetCredentialsByDIDResponse_1_list{})
-		}
-		listValue := &_QueryGetCredentialsByDIDResponse_1_list{list: &x.Credentials}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
-		value := x.Pagination
+	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
+		value := x.VerificationMethod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10218,19 +9946,15 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetVerificationMethodResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
-		lv := value.List()
-		clv := lv.(*_QueryGetCredentialsByDIDResponse_1_list)
-		x.Credentials = *clv.list
-	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
+		x.VerificationMethod = value.Message().Interface().(*VerificationMethod)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10244,53 +9968,44 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerificationMethodResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
-		if x.Credentials == nil {
-			x.Credentials = []*CredentialInfo{}
-		}
-		value := &_QueryGetCredentialsByDIDResponse_1_list{list: &x.Credentials}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
+		if x.VerificationMethod == nil {
+			x.VerificationMethod = new(VerificationMethod)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerificationMethodResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
-		list := []*CredentialInfo{}
-		return protoreflect.ValueOfList(&_QueryGetCredentialsByDIDResponse_1_list{list: &list})
-	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "did.v1.QueryGetVerificationMethodResponse.verification_method":
+		m := new(VerificationMethod)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetVerificationMethodResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetCredentialsByDIDResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerificationMethodResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10298,7 +10013,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetVerificationMethodResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10309,7 +10024,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetVerificationMethodResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10321,7 +10036,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) IsValid() bool {
+func (x *fastReflection_QueryGetVerificationMethodResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10331,9 +10046,9 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetVerificationMethodResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetCredentialsByDIDResponse)
+		x := input.Message.Interface().(*QueryGetVerificationMethodResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10345,14 +10060,8 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if len(x.Credentials) > 0 {
-			for _, e := range x.Credentials {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.VerificationMethod != nil {
+			l = options.Size(x.VerificationMethod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -10365,7 +10074,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetCredentialsByDIDResponse)
+		x := input.Message.Interface().(*QueryGetVerificationMethodResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10384,8 +10093,8 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.VerificationMethod != nil {
+			encoded, err := options.Marshal(x.VerificationMethod)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10396,23 +10105,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Credentials) > 0 {
-			for iNdEx := len(x.Credentials) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Credentials[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10425,7 +10118,7 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetCredentialsByDIDResponse)
+		x := input.Message.Interface().(*QueryGetVerificationMethodResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10457,49 +10150,15 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Credentials = append(x.Credentials, &CredentialInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credentials[len(x.Credentials)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10526,10 +10185,10 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.VerificationMethod == nil {
+					x.VerificationMethod = &VerificationMethod{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationMethod); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10569,25 +10228,27 @@ func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_QueryRegisterStartRequest               protoreflect.MessageDescriptor
-	fd_QueryRegisterStartRequest_assertion_did protoreflect.FieldDescriptor
+	md_QueryGetServiceRequest            protoreflect.MessageDescriptor
+	fd_QueryGetServiceRequest_did        protoreflect.FieldDescriptor
+	fd_QueryGetServiceRequest_service_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryRegisterStartRequest = File_did_v1_query_proto.Messages().ByName("QueryRegisterStartRequest")
-	fd_QueryRegisterStartRequest_assertion_did = md_QueryRegisterStartRequest.Fields().ByName("assertion_did")
+	md_QueryGetServiceRequest = File_did_v1_query_proto.Messages().ByName("QueryGetServiceRequest")
+	fd_QueryGetServiceRequest_did = md_QueryGetServiceRequest.Fields().ByName("did")
+	fd_QueryGetServiceRequest_service_id = md_QueryGetServiceRequest.Fields().ByName("service_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRegisterStartRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetServiceRequest)(nil)
 
-type fastReflection_QueryRegisterStartRequest QueryRegisterStartRequest
+type fastReflection_QueryGetServiceRequest QueryGetServiceRequest
 
-func (x *QueryRegisterStartRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRegisterStartRequest)(x)
+func (x *QueryGetServiceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetServiceRequest)(x)
 }
 
-func (x *QueryRegisterStartRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetServiceRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10599,43 +10260,43 @@ func (x *QueryRegisterStartRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRegisterStartRequest_messageType fastReflection_QueryRegisterStartRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRegisterStartRequest_messageType{}
+var _fastReflection_QueryGetServiceRequest_messageType fastReflection_QueryGetServiceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetServiceRequest_messageType{}
 
-type fastReflection_QueryRegisterStartRequest_messageType struct{}
+type fastReflection_QueryGetServiceRequest_messageType struct{}
 
-func (x fastReflection_QueryRegisterStartRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRegisterStartRequest)(nil)
+func (x fastReflection_QueryGetServiceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetServiceRequest)(nil)
 }
-func (x fastReflection_QueryRegisterStartRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRegisterStartRequest)
+func (x fastReflection_QueryGetServiceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetServiceRequest)
 }
-func (x fastReflection_QueryRegisterStartRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRegisterStartRequest
+func (x fastReflection_QueryGetServiceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetServiceRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRegisterStartRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRegisterStartRequest
+func (x *fastReflection_QueryGetServiceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetServiceRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRegisterStartRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRegisterStartRequest_messageType
+func (x *fastReflection_QueryGetServiceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetServiceRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRegisterStartRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRegisterStartRequest)
+func (x *fastReflection_QueryGetServiceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetServiceRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRegisterStartRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRegisterStartRequest)(x)
+func (x *fastReflection_QueryGetServiceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetServiceRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10643,10 +10304,16 @@ func (x *fastReflection_QueryRegisterStartRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRegisterStartRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AssertionDid != "" {
-		value := protoreflect.ValueOfString(x.AssertionDid)
-		if !f(fd_QueryRegisterStartRequest_assertion_did, value) {
+func (x *fastReflection_QueryGetServiceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_QueryGetServiceRequest_did, value) {
+			return
+		}
+	}
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_QueryGetServiceRequest_service_id, value) {
 			return
 		}
 	}
@@ -10663,15 +10330,17 @@ func (x *fastReflection_QueryRegisterStartRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRegisterStartRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetServiceRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartRequest.assertion_did":
-		return x.AssertionDid != ""
+	case "did.v1.QueryGetServiceRequest.did":
+		return x.Did != ""
+	case "did.v1.QueryGetServiceRequest.service_id":
+		return x.ServiceId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10681,15 +10350,17 @@ func (x *fastReflection_QueryRegisterStartRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetServiceRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartRequest.assertion_did":
-		x.AssertionDid = ""
+	case "did.v1.QueryGetServiceRequest.did":
+		x.Did = ""
+	case "did.v1.QueryGetServiceRequest.service_id":
+		x.ServiceId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10699,16 +10370,19 @@ func (x *fastReflection_QueryRegisterStartRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRegisterStartRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetServiceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryRegisterStartRequest.assertion_did":
-		value := x.AssertionDid
+	case "did.v1.QueryGetServiceRequest.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetServiceRequest.service_id":
+		value := x.ServiceId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10722,15 +10396,17 @@ func (x *fastReflection_QueryRegisterStartRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetServiceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartRequest.assertion_did":
-		x.AssertionDid = value.Interface().(string)
+	case "did.v1.QueryGetServiceRequest.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.QueryGetServiceRequest.service_id":
+		x.ServiceId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10744,40 +10420,44 @@ func (x *fastReflection_QueryRegisterStartRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetServiceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartRequest.assertion_did":
-		panic(fmt.Errorf("field assertion_did of message did.v1.QueryRegisterStartRequest is not mutable"))
+	case "did.v1.QueryGetServiceRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetServiceRequest is not mutable"))
+	case "did.v1.QueryGetServiceRequest.service_id":
+		panic(fmt.Errorf("field service_id of message did.v1.QueryGetServiceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRegisterStartRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetServiceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartRequest.assertion_did":
+	case "did.v1.QueryGetServiceRequest.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetServiceRequest.service_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRegisterStartRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetServiceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryRegisterStartRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetServiceRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10785,7 +10465,7 @@ func (x *fastReflection_QueryRegisterStartRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRegisterStartRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetServiceRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10796,7 +10476,7 @@ func (x *fastReflection_QueryRegisterStartRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetServiceRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10808,7 +10488,7 @@ func (x *fastReflection_QueryRegisterStartRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRegisterStartRequest) IsValid() bool {
+func (x *fastReflection_QueryGetServiceRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10818,9 +10498,9 @@ func (x *fastReflection_QueryRegisterStartRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetServiceRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRegisterStartRequest)
+		x := input.Message.Interface().(*QueryGetServiceRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10832,7 +10512,11 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.AssertionDid)
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ServiceId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -10846,7 +10530,7 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRegisterStartRequest)
+		x := input.Message.Interface().(*QueryGetServiceRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10865,10 +10549,17 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AssertionDid) > 0 {
-			i -= len(x.AssertionDid)
-			copy(dAtA[i:], x.AssertionDid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssertionDid)))
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -10883,7 +10574,7 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRegisterStartRequest)
+		x := input.Message.Interface().(*QueryGetServiceRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10915,15 +10606,15 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssertionDid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10951,15 +10642,47 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AssertionDid = string(dAtA[iNdEx:postIndex])
+				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -10988,112 +10711,26 @@ func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.Map = (*_QueryRegisterStartResponse_3_map)(nil)
-
-type _QueryRegisterStartResponse_3_map struct {
-	m *map[string]string
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfString(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_QueryRegisterStartResponse_3_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_QueryRegisterStartResponse_3_map) NewValue() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryRegisterStartResponse_3_map) IsValid() bool {
-	return x.m != nil
-}
-
 var (
-	md_QueryRegisterStartResponse                  protoreflect.MessageDescriptor
-	fd_QueryRegisterStartResponse_challenge        protoreflect.FieldDescriptor
-	fd_QueryRegisterStartResponse_relying_party_id protoreflect.FieldDescriptor
-	fd_QueryRegisterStartResponse_user             protoreflect.FieldDescriptor
+	md_QueryGetServiceResponse         protoreflect.MessageDescriptor
+	fd_QueryGetServiceResponse_service protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryRegisterStartResponse = File_did_v1_query_proto.Messages().ByName("QueryRegisterStartResponse")
-	fd_QueryRegisterStartResponse_challenge = md_QueryRegisterStartResponse.Fields().ByName("challenge")
-	fd_QueryRegisterStartResponse_relying_party_id = md_QueryRegisterStartResponse.Fields().ByName("relying_party_id")
-	fd_QueryRegisterStartResponse_user = md_QueryRegisterStartResponse.Fields().ByName("user")
+	md_QueryGetServiceResponse = File_did_v1_query_proto.Messages().ByName("QueryGetServiceResponse")
+	fd_QueryGetServiceResponse_service = md_QueryGetServiceResponse.Fields().ByName("service")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRegisterStartResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetServiceResponse)(nil)
 
-type fastReflection_QueryRegisterStartResponse QueryRegisterStartResponse
+type fastReflection_QueryGetServiceResponse QueryGetServiceResponse
 
-func (x *QueryRegisterStartResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRegisterStartResponse)(x)
+func (x *QueryGetServiceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetServiceResponse)(x)
 }
 
-func (x *QueryRegisterStartResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetServiceResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11105,43 +10742,43 @@ func (x *QueryRegisterStartResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRegisterStartResponse_messageType fastReflection_QueryRegisterStartResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRegisterStartResponse_messageType{}
+var _fastReflection_QueryGetServiceResponse_messageType fastReflection_QueryGetServiceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetServiceResponse_messageType{}
 
-type fastReflection_QueryRegisterStartResponse_messageType struct{}
+type fastReflection_QueryGetServiceResponse_messageType struct{}
 
-func (x fastReflection_QueryRegisterStartResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRegisterStartResponse)(nil)
+func (x fastReflection_QueryGetServiceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetServiceResponse)(nil)
 }
-func (x fastReflection_QueryRegisterStartResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRegisterStartResponse)
+func (x fastReflection_QueryGetServiceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetServiceResponse)
 }
-func (x fastReflection_QueryRegisterStartResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRegisterStartResponse
+func (x fastReflection_QueryGetServiceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetServiceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRegisterStartResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRegisterStartResponse
+func (x *fastReflection_QueryGetServiceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetServiceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRegisterStartResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRegisterStartResponse_messageType
+func (x *fastReflection_QueryGetServiceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetServiceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRegisterStartResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRegisterStartResponse)
+func (x *fastReflection_QueryGetServiceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetServiceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRegisterStartResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRegisterStartResponse)(x)
+func (x *fastReflection_QueryGetServiceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetServiceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11149,22 +10786,10 @@ func (x *fastReflection_QueryRegisterStartResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRegisterStartResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Challenge) != 0 {
-		value := protoreflect.ValueOfBytes(x.Challenge)
-		if !f(fd_QueryRegisterStartResponse_challenge, value) {
-			return
-		}
-	}
-	if x.RelyingPartyId != "" {
-		value := protoreflect.ValueOfString(x.RelyingPartyId)
-		if !f(fd_QueryRegisterStartResponse_relying_party_id, value) {
-			return
-		}
-	}
-	if len(x.User) != 0 {
-		value := protoreflect.ValueOfMap(&_QueryRegisterStartResponse_3_map{m: &x.User})
-		if !f(fd_QueryRegisterStartResponse_user, value) {
+func (x *fastReflection_QueryGetServiceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_QueryGetServiceResponse_service, value) {
 			return
 		}
 	}
@@ -11181,19 +10806,15 @@ func (x *fastReflection_QueryRegisterStartResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRegisterStartResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetServiceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartResponse.challenge":
-		return len(x.Challenge) != 0
-	case "did.v1.QueryRegisterStartResponse.relying_party_id":
-		return x.RelyingPartyId != ""
-	case "did.v1.QueryRegisterStartResponse.user":
-		return len(x.User) != 0
+	case "did.v1.QueryGetServiceResponse.service":
+		return x.Service != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11203,19 +10824,15 @@ func (x *fastReflection_QueryRegisterStartResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetServiceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartResponse.challenge":
-		x.Challenge = nil
-	case "did.v1.QueryRegisterStartResponse.relying_party_id":
-		x.RelyingPartyId = ""
-	case "did.v1.QueryRegisterStartResponse.user":
-		x.User = nil
+	case "did.v1.QueryGetServiceResponse.service":
+		x.Service = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11225,25 +10842,16 @@ func (x *fastReflection_QueryRegisterStartResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRegisterStartResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetServiceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryRegisterStartResponse.challenge":
-		value := x.Challenge
-		return protoreflect.ValueOfBytes(value)
-	case "did.v1.QueryRegisterStartResponse.relying_party_id":
-		value := x.RelyingPartyId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.QueryRegisterStartResponse.user":
-		if len(x.User) == 0 {
-			return protoreflect.ValueOfMap(&_QueryRegisterStartResponse_3_map{})
-		}
-		mapValue := &_QueryRegisterStartResponse_3_map{m: &x.User}
-		return protoreflect.ValueOfMap(mapValue)
+	case "did.v1.QueryGetServiceResponse.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11257,21 +10865,15 @@ func (x *fastReflection_QueryRegisterStartResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetServiceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartResponse.challenge":
-		x.Challenge = value.Bytes()
-	case "did.v1.QueryRegisterStartResponse.relying_party_id":
-		x.RelyingPartyId = value.Interface().(string)
-	case "did.v1.QueryRegisterStartResponse.user":
-		mv := value.Map()
-		cmv := mv.(*_QueryRegisterStartResponse_3_map)
-		x.User = *cmv.m
+	case "did.v1.QueryGetServiceResponse.service":
+		x.Service = value.Message().Interface().(*Service)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11285,53 +10887,44 @@ func (x *fastReflection_QueryRegisterStartResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetServiceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartResponse.user":
-		if x.User == nil {
-			x.User = make(map[string]string)
+	case "did.v1.QueryGetServiceResponse.service":
+		if x.Service == nil {
+			x.Service = new(Service)
 		}
-		value := &_QueryRegisterStartResponse_3_map{m: &x.User}
-		return protoreflect.ValueOfMap(value)
-	case "did.v1.QueryRegisterStartResponse.challenge":
-		panic(fmt.Errorf("field challenge of message did.v1.QueryRegisterStartResponse is not mutable"))
-	case "did.v1.QueryRegisterStartResponse.relying_party_id":
-		panic(fmt.Errorf("field relying_party_id of message did.v1.QueryRegisterStartResponse is not mutable"))
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRegisterStartResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetServiceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryRegisterStartResponse.challenge":
-		return protoreflect.ValueOfBytes(nil)
-	case "did.v1.QueryRegisterStartResponse.relying_party_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.QueryRegisterStartResponse.user":
-		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_QueryRegisterStartResponse_3_map{m: &m})
+	case "did.v1.QueryGetServiceResponse.service":
+		m := new(Service)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRegisterStartResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetServiceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryRegisterStartResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetServiceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11339,7 +10932,7 @@ func (x *fastReflection_QueryRegisterStartResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRegisterStartResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetServiceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11350,7 +10943,7 @@ func (x *fastReflection_QueryRegisterStartResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRegisterStartResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetServiceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11362,7 +10955,7 @@ func (x *fastReflection_QueryRegisterStartResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRegisterStartResponse) IsValid() bool {
+func (x *fastReflection_QueryGetServiceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11372,9 +10965,9 @@ func (x *fastReflection_QueryRegisterStartResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetServiceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRegisterStartResponse)
+		x := input.Message.Interface().(*QueryGetServiceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11386,35 +10979,10 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Challenge)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RelyingPartyId)
-		if l > 0 {
+		if x.Service != nil {
+			l = options.Size(x.Service)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.User) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.User))
-				for k := range x.User {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.User[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.User {
-					SiZeMaP(k, v)
-				}
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11425,7 +10993,7 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRegisterStartResponse)
+		x := input.Message.Interface().(*QueryGetServiceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11444,60 +11012,17 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.User) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x1a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForUser := make([]string, 0, len(x.User))
-				for k := range x.User {
-					keysForUser = append(keysForUser, string(k))
-				}
-				sort.Slice(keysForUser, func(i, j int) bool {
-					return keysForUser[i] < keysForUser[j]
-				})
-				for iNdEx := len(keysForUser) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.User[string(keysForUser[iNdEx])]
-					out, err := MaRsHaLmAp(keysForUser[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.User {
-					v := x.User[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if len(x.RelyingPartyId) > 0 {
-			i -= len(x.RelyingPartyId)
-			copy(dAtA[i:], x.RelyingPartyId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RelyingPartyId)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Challenge) > 0 {
-			i -= len(x.Challenge)
-			copy(dAtA[i:], x.Challenge)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenge)))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11512,7 +11037,7 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRegisterStartResponse)
+		x := input.Message.Interface().(*QueryGetServiceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11544,17 +11069,17 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11564,199 +11089,42 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Challenge = append(x.Challenge[:0], dAtA[iNdEx:postIndex]...)
-				if x.Challenge == nil {
-					x.Challenge = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Service == nil {
+					x.Service = &Service{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.RelyingPartyId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.User == nil {
-					x.User = make(map[string]string)
-				}
-				var mapkey string
-				var mapvalue string
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
-				}
-				x.User[mapkey] = mapvalue
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -11779,25 +11147,25 @@ func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryLoginStartRequest               protoreflect.MessageDescriptor
-	fd_QueryLoginStartRequest_assertion_did protoreflect.FieldDescriptor
+	md_QueryGetVerifiableCredentialRequest               protoreflect.MessageDescriptor
+	fd_QueryGetVerifiableCredentialRequest_credential_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_query_proto_init()
-	md_QueryLoginStartRequest = File_did_v1_query_proto.Messages().ByName("QueryLoginStartRequest")
-	fd_QueryLoginStartRequest_assertion_did = md_QueryLoginStartRequest.Fields().ByName("assertion_did")
+	md_QueryGetVerifiableCredentialRequest = File_did_v1_query_proto.Messages().ByName("QueryGetVerifiableCredentialRequest")
+	fd_QueryGetVerifiableCredentialRequest_credential_id = md_QueryGetVerifiableCredentialRequest.Fields().ByName("credential_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLoginStartRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetVerifiableCredentialRequest)(nil)
 
-type fastReflection_QueryLoginStartRequest QueryLoginStartRequest
+type fastReflection_QueryGetVerifiableCredentialRequest QueryGetVerifiableCredentialRequest
 
-func (x *QueryLoginStartRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLoginStartRequest)(x)
+func (x *QueryGetVerifiableCredentialRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetVerifiableCredentialRequest)(x)
 }
 
-func (x *QueryLoginStartRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetVerifiableCredentialRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11809,43 +11177,43 @@ func (x *QueryLoginStartRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLoginStartRequest_messageType fastReflection_QueryLoginStartRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLoginStartRequest_messageType{}
+var _fastReflection_QueryGetVerifiableCredentialRequest_messageType fastReflection_QueryGetVerifiableCredentialRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetVerifiableCredentialRequest_messageType{}
 
-type fastReflection_QueryLoginStartRequest_messageType struct{}
+type fastReflection_QueryGetVerifiableCredentialRequest_messageType struct{}
 
-func (x fastReflection_QueryLoginStartRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLoginStartRequest)(nil)
+func (x fastReflection_QueryGetVerifiableCredentialRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetVerifiableCredentialRequest)(nil)
 }
-func (x fastReflection_QueryLoginStartRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLoginStartRequest)
+func (x fastReflection_QueryGetVerifiableCredentialRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerifiableCredentialRequest)
 }
-func (x fastReflection_QueryLoginStartRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLoginStartRequest
+func (x fastReflection_QueryGetVerifiableCredentialRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerifiableCredentialRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLoginStartRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLoginStartRequest
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerifiableCredentialRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLoginStartRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLoginStartRequest_messageType
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetVerifiableCredentialRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLoginStartRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLoginStartRequest)
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerifiableCredentialRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLoginStartRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLoginStartRequest)(x)
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetVerifiableCredentialRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11853,10 +11221,10 @@ func (x *fastReflection_QueryLoginStartRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLoginStartRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AssertionDid != "" {
-		value := protoreflect.ValueOfString(x.AssertionDid)
-		if !f(fd_QueryLoginStartRequest_assertion_did, value) {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_QueryGetVerifiableCredentialRequest_credential_id, value) {
 			return
 		}
 	}
@@ -11873,15 +11241,15 @@ func (x *fastReflection_QueryLoginStartRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLoginStartRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartRequest.assertion_did":
-		return x.AssertionDid != ""
+	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
+		return x.CredentialId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11891,15 +11259,15 @@ func (x *fastReflection_QueryLoginStartRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartRequest.assertion_did":
-		x.AssertionDid = ""
+	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
+		x.CredentialId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11909,16 +11277,16 @@ func (x *fastReflection_QueryLoginStartRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLoginStartRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryLoginStartRequest.assertion_did":
-		value := x.AssertionDid
+	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
+		value := x.CredentialId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11932,15 +11300,15 @@ func (x *fastReflection_QueryLoginStartRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartRequest.assertion_did":
-		x.AssertionDid = value.Interface().(string)
+	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
+		x.CredentialId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11954,40 +11322,40 @@ func (x *fastReflection_QueryLoginStartRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartRequest.assertion_did":
-		panic(fmt.Errorf("field assertion_did of message did.v1.QueryLoginStartRequest is not mutable"))
+	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.QueryGetVerifiableCredentialRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLoginStartRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartRequest.assertion_did":
+	case "did.v1.QueryGetVerifiableCredentialRequest.credential_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialRequest"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLoginStartRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryLoginStartRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerifiableCredentialRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11995,7 +11363,7 @@ func (x *fastReflection_QueryLoginStartRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLoginStartRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12006,7 +11374,7 @@ func (x *fastReflection_QueryLoginStartRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12018,7 +11386,7 @@ func (x *fastReflection_QueryLoginStartRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLoginStartRequest) IsValid() bool {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -12028,9 +11396,9 @@ func (x *fastReflection_QueryLoginStartRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetVerifiableCredentialRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLoginStartRequest)
+		x := input.Message.Interface().(*QueryGetVerifiableCredentialRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12042,7 +11410,7 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.AssertionDid)
+		l = len(x.CredentialId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -12056,7 +11424,7 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLoginStartRequest)
+		x := input.Message.Interface().(*QueryGetVerifiableCredentialRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12075,10 +11443,10 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AssertionDid) > 0 {
-			i -= len(x.AssertionDid)
-			copy(dAtA[i:], x.AssertionDid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssertionDid)))
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -12093,7 +11461,7 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLoginStartRequest)
+		x := input.Message.Interface().(*QueryGetVerifiableCredentialRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12125,15 +11493,15 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssertionDid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12161,7 +11529,7 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AssertionDid = string(dAtA[iNdEx:postIndex])
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -12198,76 +11566,26 @@ func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_QueryLoginStartResponse_1_list)(nil)
+var (
+	md_QueryGetVerifiableCredentialResponse            protoreflect.MessageDescriptor
+	fd_QueryGetVerifiableCredentialResponse_credential protoreflect.FieldDescriptor
+)
 
-type _QueryLoginStartResponse_1_list struct {
-	list *[]string
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetVerifiableCredentialResponse = File_did_v1_query_proto.Messages().ByName("QueryGetVerifiableCredentialResponse")
+	fd_QueryGetVerifiableCredentialResponse_credential = md_QueryGetVerifiableCredentialResponse.Fields().ByName("credential")
 }
 
-func (x *_QueryLoginStartResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryGetVerifiableCredentialResponse)(nil)
 
-func (x *_QueryLoginStartResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_QueryGetVerifiableCredentialResponse QueryGetVerifiableCredentialResponse
 
-func (x *_QueryLoginStartResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *QueryGetVerifiableCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetVerifiableCredentialResponse)(x)
 }
 
-func (x *_QueryLoginStartResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryLoginStartResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryLoginStartResponse at list field CredentialIds as it is not of Message kind"))
-}
-
-func (x *_QueryLoginStartResponse_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryLoginStartResponse_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryLoginStartResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryLoginStartResponse                  protoreflect.MessageDescriptor
-	fd_QueryLoginStartResponse_credential_ids   protoreflect.FieldDescriptor
-	fd_QueryLoginStartResponse_challenge        protoreflect.FieldDescriptor
-	fd_QueryLoginStartResponse_relying_party_id protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_did_v1_query_proto_init()
-	md_QueryLoginStartResponse = File_did_v1_query_proto.Messages().ByName("QueryLoginStartResponse")
-	fd_QueryLoginStartResponse_credential_ids = md_QueryLoginStartResponse.Fields().ByName("credential_ids")
-	fd_QueryLoginStartResponse_challenge = md_QueryLoginStartResponse.Fields().ByName("challenge")
-	fd_QueryLoginStartResponse_relying_party_id = md_QueryLoginStartResponse.Fields().ByName("relying_party_id")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryLoginStartResponse)(nil)
-
-type fastReflection_QueryLoginStartResponse QueryLoginStartResponse
-
-func (x *QueryLoginStartResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLoginStartResponse)(x)
-}
-
-func (x *QueryLoginStartResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGetVerifiableCredentialResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -12279,43 +11597,43 @@ func (x *QueryLoginStartResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLoginStartResponse_messageType fastReflection_QueryLoginStartResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLoginStartResponse_messageType{}
+var _fastReflection_QueryGetVerifiableCredentialResponse_messageType fastReflection_QueryGetVerifiableCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetVerifiableCredentialResponse_messageType{}
 
-type fastReflection_QueryLoginStartResponse_messageType struct{}
+type fastReflection_QueryGetVerifiableCredentialResponse_messageType struct{}
 
-func (x fastReflection_QueryLoginStartResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLoginStartResponse)(nil)
+func (x fastReflection_QueryGetVerifiableCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetVerifiableCredentialResponse)(nil)
 }
-func (x fastReflection_QueryLoginStartResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLoginStartResponse)
+func (x fastReflection_QueryGetVerifiableCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerifiableCredentialResponse)
 }
-func (x fastReflection_QueryLoginStartResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLoginStartResponse
+func (x fastReflection_QueryGetVerifiableCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerifiableCredentialResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLoginStartResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLoginStartResponse
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetVerifiableCredentialResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLoginStartResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLoginStartResponse_messageType
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetVerifiableCredentialResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLoginStartResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLoginStartResponse)
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetVerifiableCredentialResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLoginStartResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLoginStartResponse)(x)
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetVerifiableCredentialResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12323,22 +11641,10 @@ func (x *fastReflection_QueryLoginStartResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLoginStartResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CredentialIds) != 0 {
-		value := protoreflect.ValueOfList(&_QueryLoginStartResponse_1_list{list: &x.CredentialIds})
-		if !f(fd_QueryLoginStartResponse_credential_ids, value) {
-			return
-		}
-	}
-	if len(x.Challenge) != 0 {
-		value := protoreflect.ValueOfBytes(x.Challenge)
-		if !f(fd_QueryLoginStartResponse_challenge, value) {
-			return
-		}
-	}
-	if x.RelyingPartyId != "" {
-		value := protoreflect.ValueOfString(x.RelyingPartyId)
-		if !f(fd_QueryLoginStartResponse_relying_party_id, value) {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Credential != nil {
+		value := protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
+		if !f(fd_QueryGetVerifiableCredentialResponse_credential, value) {
 			return
 		}
 	}
@@ -12355,19 +11661,15 @@ func (x *fastReflection_QueryLoginStartResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLoginStartResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartResponse.credential_ids":
-		return len(x.CredentialIds) != 0
-	case "did.v1.QueryLoginStartResponse.challenge":
-		return len(x.Challenge) != 0
-	case "did.v1.QueryLoginStartResponse.relying_party_id":
-		return x.RelyingPartyId != ""
+	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
+		return x.Credential != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12377,19 +11679,15 @@ func (x *fastReflection_QueryLoginStartResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartResponse.credential_ids":
-		x.CredentialIds = nil
-	case "did.v1.QueryLoginStartResponse.challenge":
-		x.Challenge = nil
-	case "did.v1.QueryLoginStartResponse.relying_party_id":
-		x.RelyingPartyId = ""
+	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
+		x.Credential = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12399,25 +11697,16 @@ func (x *fastReflection_QueryLoginStartResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLoginStartResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.QueryLoginStartResponse.credential_ids":
-		if len(x.CredentialIds) == 0 {
-			return protoreflect.ValueOfList(&_QueryLoginStartResponse_1_list{})
-		}
-		listValue := &_QueryLoginStartResponse_1_list{list: &x.CredentialIds}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.QueryLoginStartResponse.challenge":
-		value := x.Challenge
-		return protoreflect.ValueOfBytes(value)
-	case "did.v1.QueryLoginStartResponse.relying_party_id":
-		value := x.RelyingPartyId
-		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
+		value := x.Credential
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12431,21 +11720,15 @@ func (x *fastReflection_QueryLoginStartResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartResponse.credential_ids":
-		lv := value.List()
-		clv := lv.(*_QueryLoginStartResponse_1_list)
-		x.CredentialIds = *clv.list
-	case "did.v1.QueryLoginStartResponse.challenge":
-		x.Challenge = value.Bytes()
-	case "did.v1.QueryLoginStartResponse.relying_party_id":
-		x.RelyingPartyId = value.Interface().(string)
+	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
+		x.Credential = value.Message().Interface().(*VerifiableCredential)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12459,53 +11742,44 @@ func (x *fastReflection_QueryLoginStartResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartResponse.credential_ids":
-		if x.CredentialIds == nil {
-			x.CredentialIds = []string{}
+	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
+		if x.Credential == nil {
+			x.Credential = new(VerifiableCredential)
 		}
-		value := &_QueryLoginStartResponse_1_list{list: &x.CredentialIds}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.QueryLoginStartResponse.challenge":
-		panic(fmt.Errorf("field challenge of message did.v1.QueryLoginStartResponse is not mutable"))
-	case "did.v1.QueryLoginStartResponse.relying_party_id":
-		panic(fmt.Errorf("field relying_party_id of message did.v1.QueryLoginStartResponse is not mutable"))
+		return protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLoginStartResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.QueryLoginStartResponse.credential_ids":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryLoginStartResponse_1_list{list: &list})
-	case "did.v1.QueryLoginStartResponse.challenge":
-		return protoreflect.ValueOfBytes(nil)
-	case "did.v1.QueryLoginStartResponse.relying_party_id":
-		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetVerifiableCredentialResponse.credential":
+		m := new(VerifiableCredential)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.QueryGetVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLoginStartResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryLoginStartResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetVerifiableCredentialResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12513,7 +11787,7 @@ func (x *fastReflection_QueryLoginStartResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLoginStartResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12524,7 +11798,7 @@ func (x *fastReflection_QueryLoginStartResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLoginStartResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12536,7 +11810,7 @@ func (x *fastReflection_QueryLoginStartResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLoginStartResponse) IsValid() bool {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -12546,9 +11820,9 @@ func (x *fastReflection_QueryLoginStartResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetVerifiableCredentialResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLoginStartResponse)
+		x := input.Message.Interface().(*QueryGetVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12560,18 +11834,8 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.CredentialIds) > 0 {
-			for _, s := range x.CredentialIds {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Challenge)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RelyingPartyId)
-		if l > 0 {
+		if x.Credential != nil {
+			l = options.Size(x.Credential)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -12584,7 +11848,7 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLoginStartResponse)
+		x := input.Message.Interface().(*QueryGetVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12603,33 +11867,24 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RelyingPartyId) > 0 {
-			i -= len(x.RelyingPartyId)
-			copy(dAtA[i:], x.RelyingPartyId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RelyingPartyId)))
+		if x.Credential != nil {
+			encoded, err := options.Marshal(x.Credential)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
-		if len(x.Challenge) > 0 {
-			i -= len(x.Challenge)
-			copy(dAtA[i:], x.Challenge)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenge)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.CredentialIds) > 0 {
-			for iNdEx := len(x.CredentialIds) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.CredentialIds[iNdEx])
-				copy(dAtA[i:], x.CredentialIds[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialIds[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12637,7 +11892,7 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLoginStartResponse)
+		x := input.Message.Interface().(*QueryGetVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12669,49 +11924,17 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialIds", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CredentialIds = append(x.CredentialIds, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12721,57 +11944,27 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Challenge = append(x.Challenge[:0], dAtA[iNdEx:postIndex]...)
-				if x.Challenge == nil {
-					x.Challenge = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Credential == nil {
+					x.Credential = &VerifiableCredential{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credential); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.RelyingPartyId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -12808,253 +12001,9020 @@ func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: did/v1/query.proto
+var (
+	md_QueryVerifyCredentialRequest               protoreflect.MessageDescriptor
+	fd_QueryVerifyCredentialRequest_credential_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryVerifyCredentialRequest = File_did_v1_query_proto.Messages().ByName("QueryVerifyCredentialRequest")
+	fd_QueryVerifyCredentialRequest_credential_id = md_QueryVerifyCredentialRequest.Fields().ByName("credential_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVerifyCredentialRequest)(nil)
+
+type fastReflection_QueryVerifyCredentialRequest QueryVerifyCredentialRequest
+
+func (x *QueryVerifyCredentialRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVerifyCredentialRequest)(x)
+}
+
+func (x *QueryVerifyCredentialRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVerifyCredentialRequest_messageType fastReflection_QueryVerifyCredentialRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVerifyCredentialRequest_messageType{}
+
+type fastReflection_QueryVerifyCredentialRequest_messageType struct{}
+
+func (x fastReflection_QueryVerifyCredentialRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVerifyCredentialRequest)(nil)
+}
+func (x fastReflection_QueryVerifyCredentialRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyCredentialRequest)
+}
+func (x fastReflection_QueryVerifyCredentialRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyCredentialRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVerifyCredentialRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyCredentialRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVerifyCredentialRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVerifyCredentialRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVerifyCredentialRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyCredentialRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVerifyCredentialRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVerifyCredentialRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVerifyCredentialRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_QueryVerifyCredentialRequest_credential_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVerifyCredentialRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialRequest.credential_id":
+		return x.CredentialId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialRequest.credential_id":
+		x.CredentialId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVerifyCredentialRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryVerifyCredentialRequest.credential_id":
+		value := x.CredentialId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialRequest.credential_id":
+		x.CredentialId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialRequest.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.QueryVerifyCredentialRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVerifyCredentialRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialRequest.credential_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVerifyCredentialRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryVerifyCredentialRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVerifyCredentialRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVerifyCredentialRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVerifyCredentialRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVerifyCredentialRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CredentialId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyCredentialRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyCredentialRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyCredentialRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVerifyCredentialResponse                protoreflect.MessageDescriptor
+	fd_QueryVerifyCredentialResponse_valid          protoreflect.FieldDescriptor
+	fd_QueryVerifyCredentialResponse_proof_verified protoreflect.FieldDescriptor
+	fd_QueryVerifyCredentialResponse_revoked        protoreflect.FieldDescriptor
+	fd_QueryVerifyCredentialResponse_expired        protoreflect.FieldDescriptor
+	fd_QueryVerifyCredentialResponse_reason         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryVerifyCredentialResponse = File_did_v1_query_proto.Messages().ByName("QueryVerifyCredentialResponse")
+	fd_QueryVerifyCredentialResponse_valid = md_QueryVerifyCredentialResponse.Fields().ByName("valid")
+	fd_QueryVerifyCredentialResponse_proof_verified = md_QueryVerifyCredentialResponse.Fields().ByName("proof_verified")
+	fd_QueryVerifyCredentialResponse_revoked = md_QueryVerifyCredentialResponse.Fields().ByName("revoked")
+	fd_QueryVerifyCredentialResponse_expired = md_QueryVerifyCredentialResponse.Fields().ByName("expired")
+	fd_QueryVerifyCredentialResponse_reason = md_QueryVerifyCredentialResponse.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVerifyCredentialResponse)(nil)
+
+type fastReflection_QueryVerifyCredentialResponse QueryVerifyCredentialResponse
+
+func (x *QueryVerifyCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVerifyCredentialResponse)(x)
+}
+
+func (x *QueryVerifyCredentialResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVerifyCredentialResponse_messageType fastReflection_QueryVerifyCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVerifyCredentialResponse_messageType{}
+
+type fastReflection_QueryVerifyCredentialResponse_messageType struct{}
+
+func (x fastReflection_QueryVerifyCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVerifyCredentialResponse)(nil)
+}
+func (x fastReflection_QueryVerifyCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyCredentialResponse)
+}
+func (x fastReflection_QueryVerifyCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyCredentialResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVerifyCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVerifyCredentialResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVerifyCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVerifyCredentialResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVerifyCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVerifyCredentialResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVerifyCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVerifyCredentialResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVerifyCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Valid != false {
+		value := protoreflect.ValueOfBool(x.Valid)
+		if !f(fd_QueryVerifyCredentialResponse_valid, value) {
+			return
+		}
+	}
+	if x.ProofVerified != false {
+		value := protoreflect.ValueOfBool(x.ProofVerified)
+		if !f(fd_QueryVerifyCredentialResponse_proof_verified, value) {
+			return
+		}
+	}
+	if x.Revoked != false {
+		value := protoreflect.ValueOfBool(x.Revoked)
+		if !f(fd_QueryVerifyCredentialResponse_revoked, value) {
+			return
+		}
+	}
+	if x.Expired != false {
+		value := protoreflect.ValueOfBool(x.Expired)
+		if !f(fd_QueryVerifyCredentialResponse_expired, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_QueryVerifyCredentialResponse_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVerifyCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialResponse.valid":
+		return x.Valid != false
+	case "did.v1.QueryVerifyCredentialResponse.proof_verified":
+		return x.ProofVerified != false
+	case "did.v1.QueryVerifyCredentialResponse.revoked":
+		return x.Revoked != false
+	case "did.v1.QueryVerifyCredentialResponse.expired":
+		return x.Expired != false
+	case "did.v1.QueryVerifyCredentialResponse.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialResponse.valid":
+		x.Valid = false
+	case "did.v1.QueryVerifyCredentialResponse.proof_verified":
+		x.ProofVerified = false
+	case "did.v1.QueryVerifyCredentialResponse.revoked":
+		x.Revoked = false
+	case "did.v1.QueryVerifyCredentialResponse.expired":
+		x.Expired = false
+	case "did.v1.QueryVerifyCredentialResponse.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVerifyCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryVerifyCredentialResponse.valid":
+		value := x.Valid
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryVerifyCredentialResponse.proof_verified":
+		value := x.ProofVerified
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryVerifyCredentialResponse.revoked":
+		value := x.Revoked
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryVerifyCredentialResponse.expired":
+		value := x.Expired
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryVerifyCredentialResponse.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialResponse.valid":
+		x.Valid = value.Bool()
+	case "did.v1.QueryVerifyCredentialResponse.proof_verified":
+		x.ProofVerified = value.Bool()
+	case "did.v1.QueryVerifyCredentialResponse.revoked":
+		x.Revoked = value.Bool()
+	case "did.v1.QueryVerifyCredentialResponse.expired":
+		x.Expired = value.Bool()
+	case "did.v1.QueryVerifyCredentialResponse.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialResponse.valid":
+		panic(fmt.Errorf("field valid of message did.v1.QueryVerifyCredentialResponse is not mutable"))
+	case "did.v1.QueryVerifyCredentialResponse.proof_verified":
+		panic(fmt.Errorf("field proof_verified of message did.v1.QueryVerifyCredentialResponse is not mutable"))
+	case "did.v1.QueryVerifyCredentialResponse.revoked":
+		panic(fmt.Errorf("field revoked of message did.v1.QueryVerifyCredentialResponse is not mutable"))
+	case "did.v1.QueryVerifyCredentialResponse.expired":
+		panic(fmt.Errorf("field expired of message did.v1.QueryVerifyCredentialResponse is not mutable"))
+	case "did.v1.QueryVerifyCredentialResponse.reason":
+		panic(fmt.Errorf("field reason of message did.v1.QueryVerifyCredentialResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVerifyCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryVerifyCredentialResponse.valid":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryVerifyCredentialResponse.proof_verified":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryVerifyCredentialResponse.revoked":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryVerifyCredentialResponse.expired":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryVerifyCredentialResponse.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryVerifyCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryVerifyCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVerifyCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryVerifyCredentialResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVerifyCredentialResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVerifyCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVerifyCredentialResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVerifyCredentialResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVerifyCredentialResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Valid {
+			n += 2
+		}
+		if x.ProofVerified {
+			n += 2
+		}
+		if x.Revoked {
+			n += 2
+		}
+		if x.Expired {
+			n += 2
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyCredentialResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Expired {
+			i--
+			if x.Expired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Revoked {
+			i--
+			if x.Revoked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ProofVerified {
+			i--
+			if x.ProofVerified {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Valid {
+			i--
+			if x.Valid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVerifyCredentialResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyCredentialResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVerifyCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Valid = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofVerified", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ProofVerified = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revoked = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expired = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetStatusListRequest         protoreflect.MessageDescriptor
+	fd_QueryGetStatusListRequest_issuer  protoreflect.FieldDescriptor
+	fd_QueryGetStatusListRequest_list_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetStatusListRequest = File_did_v1_query_proto.Messages().ByName("QueryGetStatusListRequest")
+	fd_QueryGetStatusListRequest_issuer = md_QueryGetStatusListRequest.Fields().ByName("issuer")
+	fd_QueryGetStatusListRequest_list_id = md_QueryGetStatusListRequest.Fields().ByName("list_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetStatusListRequest)(nil)
+
+type fastReflection_QueryGetStatusListRequest QueryGetStatusListRequest
+
+func (x *QueryGetStatusListRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetStatusListRequest)(x)
+}
+
+func (x *QueryGetStatusListRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetStatusListRequest_messageType fastReflection_QueryGetStatusListRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetStatusListRequest_messageType{}
+
+type fastReflection_QueryGetStatusListRequest_messageType struct{}
+
+func (x fastReflection_QueryGetStatusListRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetStatusListRequest)(nil)
+}
+func (x fastReflection_QueryGetStatusListRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetStatusListRequest)
+}
+func (x fastReflection_QueryGetStatusListRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetStatusListRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetStatusListRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetStatusListRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetStatusListRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetStatusListRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetStatusListRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetStatusListRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetStatusListRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetStatusListRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetStatusListRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Issuer != "" {
+		value := protoreflect.ValueOfString(x.Issuer)
+		if !f(fd_QueryGetStatusListRequest_issuer, value) {
+			return
+		}
+	}
+	if x.ListId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ListId)
+		if !f(fd_QueryGetStatusListRequest_list_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetStatusListRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListRequest.issuer":
+		return x.Issuer != ""
+	case "did.v1.QueryGetStatusListRequest.list_id":
+		return x.ListId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListRequest.issuer":
+		x.Issuer = ""
+	case "did.v1.QueryGetStatusListRequest.list_id":
+		x.ListId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetStatusListRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryGetStatusListRequest.issuer":
+		value := x.Issuer
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetStatusListRequest.list_id":
+		value := x.ListId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListRequest.issuer":
+		x.Issuer = value.Interface().(string)
+	case "did.v1.QueryGetStatusListRequest.list_id":
+		x.ListId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListRequest.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.QueryGetStatusListRequest is not mutable"))
+	case "did.v1.QueryGetStatusListRequest.list_id":
+		panic(fmt.Errorf("field list_id of message did.v1.QueryGetStatusListRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetStatusListRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListRequest.issuer":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetStatusListRequest.list_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetStatusListRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetStatusListRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetStatusListRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetStatusListRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetStatusListRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetStatusListRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Issuer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ListId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ListId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetStatusListRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ListId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ListId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetStatusListRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetStatusListRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetStatusListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Issuer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ListId", wireType)
+				}
+				x.ListId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ListId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetStatusListResponse             protoreflect.MessageDescriptor
+	fd_QueryGetStatusListResponse_status_list protoreflect.FieldDescriptor
+	fd_QueryGetStatusListResponse_credential  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetStatusListResponse = File_did_v1_query_proto.Messages().ByName("QueryGetStatusListResponse")
+	fd_QueryGetStatusListResponse_status_list = md_QueryGetStatusListResponse.Fields().ByName("status_list")
+	fd_QueryGetStatusListResponse_credential = md_QueryGetStatusListResponse.Fields().ByName("credential")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetStatusListResponse)(nil)
+
+type fastReflection_QueryGetStatusListResponse QueryGetStatusListResponse
+
+func (x *QueryGetStatusListResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetStatusListResponse)(x)
+}
+
+func (x *QueryGetStatusListResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetStatusListResponse_messageType fastReflection_QueryGetStatusListResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetStatusListResponse_messageType{}
+
+type fastReflection_QueryGetStatusListResponse_messageType struct{}
+
+func (x fastReflection_QueryGetStatusListResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetStatusListResponse)(nil)
+}
+func (x fastReflection_QueryGetStatusListResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetStatusListResponse)
+}
+func (x fastReflection_QueryGetStatusListResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetStatusListResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetStatusListResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetStatusListResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetStatusListResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetStatusListResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetStatusListResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetStatusListResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetStatusListResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetStatusListResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetStatusListResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StatusList != nil {
+		value := protoreflect.ValueOfMessage(x.StatusList.ProtoReflect())
+		if !f(fd_QueryGetStatusListResponse_status_list, value) {
+			return
+		}
+	}
+	if x.Credential != "" {
+		value := protoreflect.ValueOfString(x.Credential)
+		if !f(fd_QueryGetStatusListResponse_credential, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetStatusListResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListResponse.status_list":
+		return x.StatusList != nil
+	case "did.v1.QueryGetStatusListResponse.credential":
+		return x.Credential != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListResponse.status_list":
+		x.StatusList = nil
+	case "did.v1.QueryGetStatusListResponse.credential":
+		x.Credential = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetStatusListResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryGetStatusListResponse.status_list":
+		value := x.StatusList
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryGetStatusListResponse.credential":
+		value := x.Credential
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListResponse.status_list":
+		x.StatusList = value.Message().Interface().(*StatusList)
+	case "did.v1.QueryGetStatusListResponse.credential":
+		x.Credential = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListResponse.status_list":
+		if x.StatusList == nil {
+			x.StatusList = new(StatusList)
+		}
+		return protoreflect.ValueOfMessage(x.StatusList.ProtoReflect())
+	case "did.v1.QueryGetStatusListResponse.credential":
+		panic(fmt.Errorf("field credential of message did.v1.QueryGetStatusListResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetStatusListResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetStatusListResponse.status_list":
+		m := new(StatusList)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.QueryGetStatusListResponse.credential":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetStatusListResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetStatusListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetStatusListResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetStatusListResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetStatusListResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetStatusListResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetStatusListResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetStatusListResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetStatusListResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StatusList != nil {
+			l = options.Size(x.StatusList)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Credential)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetStatusListResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Credential) > 0 {
+			i -= len(x.Credential)
+			copy(dAtA[i:], x.Credential)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Credential)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StatusList != nil {
+			encoded, err := options.Marshal(x.StatusList)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetStatusListResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetStatusListResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatusList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StatusList == nil {
+					x.StatusList = &StatusList{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StatusList); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Credential = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryListVerifiableCredentialsRequest                 protoreflect.MessageDescriptor
+	fd_QueryListVerifiableCredentialsRequest_pagination      protoreflect.FieldDescriptor
+	fd_QueryListVerifiableCredentialsRequest_issuer          protoreflect.FieldDescriptor
+	fd_QueryListVerifiableCredentialsRequest_holder          protoreflect.FieldDescriptor
+	fd_QueryListVerifiableCredentialsRequest_include_revoked protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryListVerifiableCredentialsRequest = File_did_v1_query_proto.Messages().ByName("QueryListVerifiableCredentialsRequest")
+	fd_QueryListVerifiableCredentialsRequest_pagination = md_QueryListVerifiableCredentialsRequest.Fields().ByName("pagination")
+	fd_QueryListVerifiableCredentialsRequest_issuer = md_QueryListVerifiableCredentialsRequest.Fields().ByName("issuer")
+	fd_QueryListVerifiableCredentialsRequest_holder = md_QueryListVerifiableCredentialsRequest.Fields().ByName("holder")
+	fd_QueryListVerifiableCredentialsRequest_include_revoked = md_QueryListVerifiableCredentialsRequest.Fields().ByName("include_revoked")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryListVerifiableCredentialsRequest)(nil)
+
+type fastReflection_QueryListVerifiableCredentialsRequest QueryListVerifiableCredentialsRequest
+
+func (x *QueryListVerifiableCredentialsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryListVerifiableCredentialsRequest)(x)
+}
+
+func (x *QueryListVerifiableCredentialsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryListVerifiableCredentialsRequest_messageType fastReflection_QueryListVerifiableCredentialsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryListVerifiableCredentialsRequest_messageType{}
+
+type fastReflection_QueryListVerifiableCredentialsRequest_messageType struct{}
+
+func (x fastReflection_QueryListVerifiableCredentialsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryListVerifiableCredentialsRequest)(nil)
+}
+func (x fastReflection_QueryListVerifiableCredentialsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryListVerifiableCredentialsRequest)
+}
+func (x fastReflection_QueryListVerifiableCredentialsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListVerifiableCredentialsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListVerifiableCredentialsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryListVerifiableCredentialsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryListVerifiableCredentialsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryListVerifiableCredentialsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryListVerifiableCredentialsRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Issuer != "" {
+		value := protoreflect.ValueOfString(x.Issuer)
+		if !f(fd_QueryListVerifiableCredentialsRequest_issuer, value) {
+			return
+		}
+	}
+	if x.Holder != "" {
+		value := protoreflect.ValueOfString(x.Holder)
+		if !f(fd_QueryListVerifiableCredentialsRequest_holder, value) {
+			return
+		}
+	}
+	if x.IncludeRevoked != false {
+		value := protoreflect.ValueOfBool(x.IncludeRevoked)
+		if !f(fd_QueryListVerifiableCredentialsRequest_include_revoked, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+		return x.Pagination != nil
+	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
+		return x.Issuer != ""
+	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
+		return x.Holder != ""
+	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
+		return x.IncludeRevoked != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+		x.Pagination = nil
+	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
+		x.Issuer = ""
+	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
+		x.Holder = ""
+	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
+		x.IncludeRevoked = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
+		value := x.Issuer
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
+		value := x.Holder
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
+		value := x.IncludeRevoked
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
+		x.Issuer = value.Interface().(string)
+	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
+		x.Holder = value.Interface().(string)
+	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
+		x.IncludeRevoked = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.QueryListVerifiableCredentialsRequest is not mutable"))
+	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
+		panic(fmt.Errorf("field holder of message did.v1.QueryListVerifiableCredentialsRequest is not mutable"))
+	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
+		panic(fmt.Errorf("field include_revoked of message did.v1.QueryListVerifiableCredentialsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.QueryListVerifiableCredentialsRequest.issuer":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryListVerifiableCredentialsRequest.holder":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryListVerifiableCredentialsRequest.include_revoked":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListVerifiableCredentialsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryListVerifiableCredentialsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryListVerifiableCredentialsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Issuer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Holder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncludeRevoked {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryListVerifiableCredentialsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IncludeRevoked {
+			i--
+			if x.IncludeRevoked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Holder) > 0 {
+			i -= len(x.Holder)
+			copy(dAtA[i:], x.Holder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Holder)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryListVerifiableCredentialsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Issuer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Holder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeRevoked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludeRevoked = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryListVerifiableCredentialsResponse_1_list)(nil)
+
+type _QueryListVerifiableCredentialsResponse_1_list struct {
+	list *[]*VerifiableCredential
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerifiableCredential)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerifiableCredential)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(VerifiableCredential)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(VerifiableCredential)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryListVerifiableCredentialsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryListVerifiableCredentialsResponse             protoreflect.MessageDescriptor
+	fd_QueryListVerifiableCredentialsResponse_credentials protoreflect.FieldDescriptor
+	fd_QueryListVerifiableCredentialsResponse_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryListVerifiableCredentialsResponse = File_did_v1_query_proto.Messages().ByName("QueryListVerifiableCredentialsResponse")
+	fd_QueryListVerifiableCredentialsResponse_credentials = md_QueryListVerifiableCredentialsResponse.Fields().ByName("credentials")
+	fd_QueryListVerifiableCredentialsResponse_pagination = md_QueryListVerifiableCredentialsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryListVerifiableCredentialsResponse)(nil)
+
+type fastReflection_QueryListVerifiableCredentialsResponse QueryListVerifiableCredentialsResponse
+
+func (x *QueryListVerifiableCredentialsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryListVerifiableCredentialsResponse)(x)
+}
+
+func (x *QueryListVerifiableCredentialsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryListVerifiableCredentialsResponse_messageType fastReflection_QueryListVerifiableCredentialsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryListVerifiableCredentialsResponse_messageType{}
+
+type fastReflection_QueryListVerifiableCredentialsResponse_messageType struct{}
+
+func (x fastReflection_QueryListVerifiableCredentialsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryListVerifiableCredentialsResponse)(nil)
+}
+func (x fastReflection_QueryListVerifiableCredentialsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryListVerifiableCredentialsResponse)
+}
+func (x fastReflection_QueryListVerifiableCredentialsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListVerifiableCredentialsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryListVerifiableCredentialsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryListVerifiableCredentialsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryListVerifiableCredentialsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryListVerifiableCredentialsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Credentials) != 0 {
+		value := protoreflect.ValueOfList(&_QueryListVerifiableCredentialsResponse_1_list{list: &x.Credentials})
+		if !f(fd_QueryListVerifiableCredentialsResponse_credentials, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryListVerifiableCredentialsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
+		return len(x.Credentials) != 0
+	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
+		x.Credentials = nil
+	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
+		if len(x.Credentials) == 0 {
+			return protoreflect.ValueOfList(&_QueryListVerifiableCredentialsResponse_1_list{})
+		}
+		listValue := &_QueryListVerifiableCredentialsResponse_1_list{list: &x.Credentials}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
+		lv := value.List()
+		clv := lv.(*_QueryListVerifiableCredentialsResponse_1_list)
+		x.Credentials = *clv.list
+	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
+		if x.Credentials == nil {
+			x.Credentials = []*VerifiableCredential{}
+		}
+		value := &_QueryListVerifiableCredentialsResponse_1_list{list: &x.Credentials}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryListVerifiableCredentialsResponse.credentials":
+		list := []*VerifiableCredential{}
+		return protoreflect.ValueOfList(&_QueryListVerifiableCredentialsResponse_1_list{list: &list})
+	case "did.v1.QueryListVerifiableCredentialsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryListVerifiableCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryListVerifiableCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryListVerifiableCredentialsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryListVerifiableCredentialsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryListVerifiableCredentialsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Credentials) > 0 {
+			for _, e := range x.Credentials {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryListVerifiableCredentialsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Credentials) > 0 {
+			for iNdEx := len(x.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Credentials[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryListVerifiableCredentialsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryListVerifiableCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Credentials = append(x.Credentials, &VerifiableCredential{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credentials[len(x.Credentials)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CredentialInfo                       protoreflect.MessageDescriptor
+	fd_CredentialInfo_verifiable_credential protoreflect.FieldDescriptor
+	fd_CredentialInfo_webauthn_credential   protoreflect.FieldDescriptor
+	fd_CredentialInfo_vault_id              protoreflect.FieldDescriptor
+	fd_CredentialInfo_is_encrypted          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_CredentialInfo = File_did_v1_query_proto.Messages().ByName("CredentialInfo")
+	fd_CredentialInfo_verifiable_credential = md_CredentialInfo.Fields().ByName("verifiable_credential")
+	fd_CredentialInfo_webauthn_credential = md_CredentialInfo.Fields().ByName("webauthn_credential")
+	fd_CredentialInfo_vault_id = md_CredentialInfo.Fields().ByName("vault_id")
+	fd_CredentialInfo_is_encrypted = md_CredentialInfo.Fields().ByName("is_encrypted")
+}
+
+var _ protoreflect.Message = (*fastReflection_CredentialInfo)(nil)
+
+type fastReflection_CredentialInfo CredentialInfo
+
+func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CredentialInfo)(x)
+}
+
+func (x *CredentialInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CredentialInfo_messageType fastReflection_CredentialInfo_messageType
+var _ protoreflect.MessageType = fastReflection_CredentialInfo_messageType{}
+
+type fastReflection_CredentialInfo_messageType struct{}
+
+func (x fastReflection_CredentialInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CredentialInfo)(nil)
+}
+func (x fastReflection_CredentialInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_CredentialInfo)
+}
+func (x fastReflection_CredentialInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CredentialInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CredentialInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_CredentialInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CredentialInfo) Type() protoreflect.MessageType {
+	return _fastReflection_CredentialInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CredentialInfo) New() protoreflect.Message {
+	return new(fastReflection_CredentialInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CredentialInfo) Interface() protoreflect.ProtoMessage {
+	return (*CredentialInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CredentialInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Credential != nil {
+		switch o := x.Credential.(type) {
+		case *CredentialInfo_VerifiableCredential:
+			v := o.VerifiableCredential
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CredentialInfo_verifiable_credential, value) {
+				return
+			}
+		case *CredentialInfo_WebauthnCredential:
+			v := o.WebauthnCredential
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CredentialInfo_webauthn_credential, value) {
+				return
+			}
+		}
+	}
+	if x.VaultId != "" {
+		value := protoreflect.ValueOfString(x.VaultId)
+		if !f(fd_CredentialInfo_vault_id, value) {
+			return
+		}
+	}
+	if x.IsEncrypted != false {
+		value := protoreflect.ValueOfBool(x.IsEncrypted)
+		if !f(fd_CredentialInfo_is_encrypted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CredentialInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.CredentialInfo.verifiable_credential":
+		if x.Credential == nil {
+			return false
+		} else if _, ok := x.Credential.(*CredentialInfo_VerifiableCredential); ok {
+			return true
+		} else {
+			return false
+		}
+	case "did.v1.CredentialInfo.webauthn_credential":
+		if x.Credential == nil {
+			return false
+		} else if _, ok := x.Credential.(*CredentialInfo_WebauthnCredential); ok {
+			return true
+		} else {
+			return false
+		}
+	case "did.v1.CredentialInfo.vault_id":
+		return x.VaultId != ""
+	case "did.v1.CredentialInfo.is_encrypted":
+		return x.IsEncrypted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+		}
+		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CredentialInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.CredentialInfo.verifiable_credential":
+		x.Credential = nil
+	case "did.v1.CredentialInfo.webauthn_credential":
+		x.Credential = nil
+	case "did.v1.CredentialInfo.vault_id":
+		x.VaultId = ""
+	case "did.v1.CredentialInfo.is_encrypted":
+		x.IsEncrypted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+		}
+		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CredentialInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.CredentialInfo.verifiable_credential":
+		if x.Credential == nil {
+			return protoreflect.ValueOfMessage((*VerifiableCredential)(nil).ProtoReflect())
+		} else if v, ok := x.Credential.(*CredentialInfo_VerifiableCredential); ok {
+			return protoreflect.ValueOfMessage(v.VerifiableCredential.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*VerifiableCredential)(nil).ProtoReflect())
+		}
+	case "did.v1.CredentialInfo.webauthn_credential":
+		if x.Credential == nil {
+			return protoreflect.ValueOfMessage((*WebAuthnCredential)(nil).ProtoReflect())
+		} else if v, ok := x.Credential.(*CredentialInfo_WebauthnCredential); ok {
+			return protoreflect.ValueOfMessage(v.WebauthnCredential.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*WebAuthnCredential)(nil).ProtoReflect())
+		}
+	case "did.v1.CredentialInfo.vault_id":
+		value := x.VaultId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.CredentialInfo.is_encrypted":
+		value := x.IsEncrypted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+		}
+		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CredentialInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.CredentialInfo.verifiable_credential":
+		cv := value.Message().Interface().(*VerifiableCredential)
+		x.Credential = &CredentialInfo_VerifiableCredential{VerifiableCredential: cv}
+	case "did.v1.CredentialInfo.webauthn_credential":
+		cv := value.Message().Interface().(*WebAuthnCredential)
+		x.Credential = &CredentialInfo_WebauthnCredential{WebauthnCredential: cv}
+	case "did.v1.CredentialInfo.vault_id":
+		x.VaultId = value.Interface().(string)
+	case "did.v1.CredentialInfo.is_encrypted":
+		x.IsEncrypted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+		}
+		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CredentialInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.CredentialInfo.verifiable_credential":
+		if x.Credential == nil {
+			value := &VerifiableCredential{}
+			oneofValue := &CredentialInfo_VerifiableCredential{VerifiableCredential: value}
+			x.Credential = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Credential.(type) {
+		case *CredentialInfo_VerifiableCredential:
+			return protoreflect.ValueOfMessage(m.VerifiableCredential.ProtoReflect())
+		default:
+			value := &VerifiableCredential{}
+			oneofValue := &CredentialInfo_VerifiableCredential{VerifiableCredential: value}
+			x.Credential = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "did.v1.CredentialInfo.webauthn_credential":
+		if x.Credential == nil {
+			value := &WebAuthnCredential{}
+			oneofValue := &CredentialInfo_WebauthnCredential{WebauthnCredential: value}
+			x.Credential = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Credential.(type) {
+		case *CredentialInfo_WebauthnCredential:
+			return protoreflect.ValueOfMessage(m.WebauthnCredential.ProtoReflect())
+		default:
+			value := &WebAuthnCredential{}
+			oneofValue := &CredentialInfo_WebauthnCredential{WebauthnCredential: value}
+			x.Credential = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "did.v1.CredentialInfo.vault_id":
+		panic(fmt.Errorf("field vault_id of message did.v1.CredentialInfo is not mutable"))
+	case "did.v1.CredentialInfo.is_encrypted":
+		panic(fmt.Errorf("field is_encrypted of message did.v1.CredentialInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+		}
+		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CredentialInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.CredentialInfo.verifiable_credential":
+		value := &VerifiableCredential{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.CredentialInfo.webauthn_credential":
+		value := &WebAuthnCredential{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.CredentialInfo.vault_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.CredentialInfo.is_encrypted":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.CredentialInfo"))
+		}
+		panic(fmt.Errorf("message did.v1.CredentialInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CredentialInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "did.v1.CredentialInfo.credential":
+		if x.Credential == nil {
+			return nil
+		}
+		switch x.Credential.(type) {
+		case *CredentialInfo_VerifiableCredential:
+			return x.Descriptor().Fields().ByName("verifiable_credential")
+		case *CredentialInfo_WebauthnCredential:
+			return x.Descriptor().Fields().ByName("webauthn_credential")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.CredentialInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CredentialInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CredentialInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CredentialInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CredentialInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CredentialInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Credential.(type) {
+		case *CredentialInfo_VerifiableCredential:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.VerifiableCredential)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *CredentialInfo_WebauthnCredential:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.WebauthnCredential)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VaultId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsEncrypted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CredentialInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Credential.(type) {
+		case *CredentialInfo_VerifiableCredential:
+			encoded, err := options.Marshal(x.VerifiableCredential)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *CredentialInfo_WebauthnCredential:
+			encoded, err := options.Marshal(x.WebauthnCredential)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.IsEncrypted {
+			i--
+			if x.IsEncrypted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.VaultId) > 0 {
+			i -= len(x.VaultId)
+			copy(dAtA[i:], x.VaultId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CredentialInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CredentialInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CredentialInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiableCredential", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &VerifiableCredential{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Credential = &CredentialInfo_VerifiableCredential{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredential", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &WebAuthnCredential{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Credential = &CredentialInfo_WebauthnCredential{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsEncrypted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsEncrypted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetCredentialsByDIDRequest                    protoreflect.MessageDescriptor
+	fd_QueryGetCredentialsByDIDRequest_did                protoreflect.FieldDescriptor
+	fd_QueryGetCredentialsByDIDRequest_include_verifiable protoreflect.FieldDescriptor
+	fd_QueryGetCredentialsByDIDRequest_include_webauthn   protoreflect.FieldDescriptor
+	fd_QueryGetCredentialsByDIDRequest_include_revoked    protoreflect.FieldDescriptor
+	fd_QueryGetCredentialsByDIDRequest_pagination         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetCredentialsByDIDRequest = File_did_v1_query_proto.Messages().ByName("QueryGetCredentialsByDIDRequest")
+	fd_QueryGetCredentialsByDIDRequest_did = md_QueryGetCredentialsByDIDRequest.Fields().ByName("did")
+	fd_QueryGetCredentialsByDIDRequest_include_verifiable = md_QueryGetCredentialsByDIDRequest.Fields().ByName("include_verifiable")
+	fd_QueryGetCredentialsByDIDRequest_include_webauthn = md_QueryGetCredentialsByDIDRequest.Fields().ByName("include_webauthn")
+	fd_QueryGetCredentialsByDIDRequest_include_revoked = md_QueryGetCredentialsByDIDRequest.Fields().ByName("include_revoked")
+	fd_QueryGetCredentialsByDIDRequest_pagination = md_QueryGetCredentialsByDIDRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetCredentialsByDIDRequest)(nil)
+
+type fastReflection_QueryGetCredentialsByDIDRequest QueryGetCredentialsByDIDRequest
+
+func (x *QueryGetCredentialsByDIDRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetCredentialsByDIDRequest)(x)
+}
+
+func (x *QueryGetCredentialsByDIDRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetCredentialsByDIDRequest_messageType fastReflection_QueryGetCredentialsByDIDRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetCredentialsByDIDRequest_messageType{}
+
+type fastReflection_QueryGetCredentialsByDIDRequest_messageType struct{}
+
+func (x fastReflection_QueryGetCredentialsByDIDRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetCredentialsByDIDRequest)(nil)
+}
+func (x fastReflection_QueryGetCredentialsByDIDRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetCredentialsByDIDRequest)
+}
+func (x fastReflection_QueryGetCredentialsByDIDRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetCredentialsByDIDRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetCredentialsByDIDRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetCredentialsByDIDRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetCredentialsByDIDRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetCredentialsByDIDRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_QueryGetCredentialsByDIDRequest_did, value) {
+			return
+		}
+	}
+	if x.IncludeVerifiable != false {
+		value := protoreflect.ValueOfBool(x.IncludeVerifiable)
+		if !f(fd_QueryGetCredentialsByDIDRequest_include_verifiable, value) {
+			return
+		}
+	}
+	if x.IncludeWebauthn != false {
+		value := protoreflect.ValueOfBool(x.IncludeWebauthn)
+		if !f(fd_QueryGetCredentialsByDIDRequest_include_webauthn, value) {
+			return
+		}
+	}
+	if x.IncludeRevoked != false {
+		value := protoreflect.ValueOfBool(x.IncludeRevoked)
+		if !f(fd_QueryGetCredentialsByDIDRequest_include_revoked, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetCredentialsByDIDRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+		return x.Did != ""
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
+		return x.IncludeVerifiable != false
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
+		return x.IncludeWebauthn != false
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
+		return x.IncludeRevoked != false
+	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+		x.Did = ""
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
+		x.IncludeVerifiable = false
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
+		x.IncludeWebauthn = false
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
+		x.IncludeRevoked = false
+	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
+		value := x.IncludeVerifiable
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
+		value := x.IncludeWebauthn
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
+		value := x.IncludeRevoked
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
+		x.IncludeVerifiable = value.Bool()
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
+		x.IncludeWebauthn = value.Bool()
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
+		x.IncludeRevoked = value.Bool()
+	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
+		panic(fmt.Errorf("field include_verifiable of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
+		panic(fmt.Errorf("field include_webauthn of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
+		panic(fmt.Errorf("field include_revoked of message did.v1.QueryGetCredentialsByDIDRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDRequest.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_verifiable":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_webauthn":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryGetCredentialsByDIDRequest.include_revoked":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.QueryGetCredentialsByDIDRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetCredentialsByDIDRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetCredentialsByDIDRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetCredentialsByDIDRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncludeVerifiable {
+			n += 2
+		}
+		if x.IncludeWebauthn {
+			n += 2
+		}
+		if x.IncludeRevoked {
+			n += 2
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetCredentialsByDIDRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.IncludeRevoked {
+			i--
+			if x.IncludeRevoked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IncludeWebauthn {
+			i--
+			if x.IncludeWebauthn {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.IncludeVerifiable {
+			i--
+			if x.IncludeVerifiable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetCredentialsByDIDRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeVerifiable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludeVerifiable = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeWebauthn", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludeWebauthn = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeRevoked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludeRevoked = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGetCredentialsByDIDResponse_1_list)(nil)
+
+type _QueryGetCredentialsByDIDResponse_1_list struct {
+	list *[]*CredentialInfo
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CredentialInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CredentialInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(CredentialInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) NewElement() protoreflect.Value {
+	v := new(CredentialInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetCredentialsByDIDResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetCredentialsByDIDResponse             protoreflect.MessageDescriptor
+	fd_QueryGetCredentialsByDIDResponse_credentials protoreflect.FieldDescriptor
+	fd_QueryGetCredentialsByDIDResponse_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetCredentialsByDIDResponse = File_did_v1_query_proto.Messages().ByName("QueryGetCredentialsByDIDResponse")
+	fd_QueryGetCredentialsByDIDResponse_credentials = md_QueryGetCredentialsByDIDResponse.Fields().ByName("credentials")
+	fd_QueryGetCredentialsByDIDResponse_pagination = md_QueryGetCredentialsByDIDResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetCredentialsByDIDResponse)(nil)
+
+type fastReflection_QueryGetCredentialsByDIDResponse QueryGetCredentialsByDIDResponse
+
+func (x *QueryGetCredentialsByDIDResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetCredentialsByDIDResponse)(x)
+}
+
+func (x *QueryGetCredentialsByDIDResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetCredentialsByDIDResponse_messageType fastReflection_QueryGetCredentialsByDIDResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetCredentialsByDIDResponse_messageType{}
+
+type fastReflection_QueryGetCredentialsByDIDResponse_messageType struct{}
+
+func (x fastReflection_QueryGetCredentialsByDIDResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetCredentialsByDIDResponse)(nil)
+}
+func (x fastReflection_QueryGetCredentialsByDIDResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetCredentialsByDIDResponse)
+}
+func (x fastReflection_QueryGetCredentialsByDIDResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetCredentialsByDIDResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetCredentialsByDIDResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetCredentialsByDIDResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetCredentialsByDIDResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetCredentialsByDIDResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Credentials) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetCredentialsByDIDResponse_1_list{list: &x.Credentials})
+		if !f(fd_QueryGetCredentialsByDIDResponse_credentials, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetCredentialsByDIDResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
+		return len(x.Credentials) != 0
+	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
+		x.Credentials = nil
+	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
+		if len(x.Credentials) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetCredentialsByDIDResponse_1_list{})
+		}
+		listValue := &_QueryGetCredentialsByDIDResponse_1_list{list: &x.Credentials}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
+		lv := value.List()
+		clv := lv.(*_QueryGetCredentialsByDIDResponse_1_list)
+		x.Credentials = *clv.list
+	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
+		if x.Credentials == nil {
+			x.Credentials = []*CredentialInfo{}
+		}
+		value := &_QueryGetCredentialsByDIDResponse_1_list{list: &x.Credentials}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetCredentialsByDIDResponse.credentials":
+		list := []*CredentialInfo{}
+		return protoreflect.ValueOfList(&_QueryGetCredentialsByDIDResponse_1_list{list: &list})
+	case "did.v1.QueryGetCredentialsByDIDResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetCredentialsByDIDResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetCredentialsByDIDResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetCredentialsByDIDResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetCredentialsByDIDResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetCredentialsByDIDResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Credentials) > 0 {
+			for _, e := range x.Credentials {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetCredentialsByDIDResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Credentials) > 0 {
+			for iNdEx := len(x.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Credentials[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetCredentialsByDIDResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetCredentialsByDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Credentials = append(x.Credentials, &CredentialInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credentials[len(x.Credentials)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRegisterStartRequest               protoreflect.MessageDescriptor
+	fd_QueryRegisterStartRequest_assertion_did protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryRegisterStartRequest = File_did_v1_query_proto.Messages().ByName("QueryRegisterStartRequest")
+	fd_QueryRegisterStartRequest_assertion_did = md_QueryRegisterStartRequest.Fields().ByName("assertion_did")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRegisterStartRequest)(nil)
+
+type fastReflection_QueryRegisterStartRequest QueryRegisterStartRequest
+
+func (x *QueryRegisterStartRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRegisterStartRequest)(x)
+}
+
+func (x *QueryRegisterStartRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRegisterStartRequest_messageType fastReflection_QueryRegisterStartRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRegisterStartRequest_messageType{}
+
+type fastReflection_QueryRegisterStartRequest_messageType struct{}
+
+func (x fastReflection_QueryRegisterStartRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRegisterStartRequest)(nil)
+}
+func (x fastReflection_QueryRegisterStartRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRegisterStartRequest)
+}
+func (x fastReflection_QueryRegisterStartRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegisterStartRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRegisterStartRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegisterStartRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRegisterStartRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRegisterStartRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRegisterStartRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRegisterStartRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRegisterStartRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRegisterStartRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRegisterStartRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AssertionDid != "" {
+		value := protoreflect.ValueOfString(x.AssertionDid)
+		if !f(fd_QueryRegisterStartRequest_assertion_did, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRegisterStartRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartRequest.assertion_did":
+		return x.AssertionDid != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartRequest.assertion_did":
+		x.AssertionDid = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRegisterStartRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryRegisterStartRequest.assertion_did":
+		value := x.AssertionDid
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartRequest.assertion_did":
+		x.AssertionDid = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartRequest.assertion_did":
+		panic(fmt.Errorf("field assertion_did of message did.v1.QueryRegisterStartRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRegisterStartRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartRequest.assertion_did":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRegisterStartRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryRegisterStartRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRegisterStartRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRegisterStartRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRegisterStartRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRegisterStartRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AssertionDid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegisterStartRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AssertionDid) > 0 {
+			i -= len(x.AssertionDid)
+			copy(dAtA[i:], x.AssertionDid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssertionDid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegisterStartRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssertionDid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssertionDid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.Map = (*_QueryRegisterStartResponse_3_map)(nil)
+
+type _QueryRegisterStartResponse_3_map struct {
+	m *map[string]string
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_QueryRegisterStartResponse_3_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_QueryRegisterStartResponse_3_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryRegisterStartResponse_3_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_QueryRegisterStartResponse                  protoreflect.MessageDescriptor
+	fd_QueryRegisterStartResponse_challenge        protoreflect.FieldDescriptor
+	fd_QueryRegisterStartResponse_relying_party_id protoreflect.FieldDescriptor
+	fd_QueryRegisterStartResponse_user             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryRegisterStartResponse = File_did_v1_query_proto.Messages().ByName("QueryRegisterStartResponse")
+	fd_QueryRegisterStartResponse_challenge = md_QueryRegisterStartResponse.Fields().ByName("challenge")
+	fd_QueryRegisterStartResponse_relying_party_id = md_QueryRegisterStartResponse.Fields().ByName("relying_party_id")
+	fd_QueryRegisterStartResponse_user = md_QueryRegisterStartResponse.Fields().ByName("user")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRegisterStartResponse)(nil)
+
+type fastReflection_QueryRegisterStartResponse QueryRegisterStartResponse
+
+func (x *QueryRegisterStartResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRegisterStartResponse)(x)
+}
+
+func (x *QueryRegisterStartResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRegisterStartResponse_messageType fastReflection_QueryRegisterStartResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRegisterStartResponse_messageType{}
+
+type fastReflection_QueryRegisterStartResponse_messageType struct{}
+
+func (x fastReflection_QueryRegisterStartResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRegisterStartResponse)(nil)
+}
+func (x fastReflection_QueryRegisterStartResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRegisterStartResponse)
+}
+func (x fastReflection_QueryRegisterStartResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegisterStartResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRegisterStartResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRegisterStartResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRegisterStartResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRegisterStartResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRegisterStartResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRegisterStartResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRegisterStartResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRegisterStartResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRegisterStartResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Challenge) != 0 {
+		value := protoreflect.ValueOfBytes(x.Challenge)
+		if !f(fd_QueryRegisterStartResponse_challenge, value) {
+			return
+		}
+	}
+	if x.RelyingPartyId != "" {
+		value := protoreflect.ValueOfString(x.RelyingPartyId)
+		if !f(fd_QueryRegisterStartResponse_relying_party_id, value) {
+			return
+		}
+	}
+	if len(x.User) != 0 {
+		value := protoreflect.ValueOfMap(&_QueryRegisterStartResponse_3_map{m: &x.User})
+		if !f(fd_QueryRegisterStartResponse_user, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRegisterStartResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartResponse.challenge":
+		return len(x.Challenge) != 0
+	case "did.v1.QueryRegisterStartResponse.relying_party_id":
+		return x.RelyingPartyId != ""
+	case "did.v1.QueryRegisterStartResponse.user":
+		return len(x.User) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartResponse.challenge":
+		x.Challenge = nil
+	case "did.v1.QueryRegisterStartResponse.relying_party_id":
+		x.RelyingPartyId = ""
+	case "did.v1.QueryRegisterStartResponse.user":
+		x.User = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRegisterStartResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryRegisterStartResponse.challenge":
+		value := x.Challenge
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.QueryRegisterStartResponse.relying_party_id":
+		value := x.RelyingPartyId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.QueryRegisterStartResponse.user":
+		if len(x.User) == 0 {
+			return protoreflect.ValueOfMap(&_QueryRegisterStartResponse_3_map{})
+		}
+		mapValue := &_QueryRegisterStartResponse_3_map{m: &x.User}
+		return protoreflect.ValueOfMap(mapValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartResponse.challenge":
+		x.Challenge = value.Bytes()
+	case "did.v1.QueryRegisterStartResponse.relying_party_id":
+		x.RelyingPartyId = value.Interface().(string)
+	case "did.v1.QueryRegisterStartResponse.user":
+		mv := value.Map()
+		cmv := mv.(*_QueryRegisterStartResponse_3_map)
+		x.User = *cmv.m
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartResponse.user":
+		if x.User == nil {
+			x.User = make(map[string]string)
+		}
+		value := &_QueryRegisterStartResponse_3_map{m: &x.User}
+		return protoreflect.ValueOfMap(value)
+	case "did.v1.QueryRegisterStartResponse.challenge":
+		panic(fmt.Errorf("field challenge of message did.v1.QueryRegisterStartResponse is not mutable"))
+	case "did.v1.QueryRegisterStartResponse.relying_party_id":
+		panic(fmt.Errorf("field relying_party_id of message did.v1.QueryRegisterStartResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRegisterStartResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryRegisterStartResponse.challenge":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.QueryRegisterStartResponse.relying_party_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.QueryRegisterStartResponse.user":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_QueryRegisterStartResponse_3_map{m: &m})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryRegisterStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryRegisterStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRegisterStartResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryRegisterStartResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRegisterStartResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRegisterStartResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRegisterStartResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRegisterStartResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRegisterStartResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Challenge)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RelyingPartyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.User) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.User))
+				for k := range x.User {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.User[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.User {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegisterStartResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.User) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForUser := make([]string, 0, len(x.User))
+				for k := range x.User {
+					keysForUser = append(keysForUser, string(k))
+				}
+				sort.Slice(keysForUser, func(i, j int) bool {
+					return keysForUser[i] < keysForUser[j]
+				})
+				for iNdEx := len(keysForUser) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.User[string(keysForUser[iNdEx])]
+					out, err := MaRsHaLmAp(keysForUser[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.User {
+					v := x.User[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.RelyingPartyId) > 0 {
+			i -= len(x.RelyingPartyId)
+			copy(dAtA[i:], x.RelyingPartyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RelyingPartyId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Challenge) > 0 {
+			i -= len(x.Challenge)
+			copy(dAtA[i:], x.Challenge)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenge)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRegisterStartResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRegisterStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Challenge = append(x.Challenge[:0], dAtA[iNdEx:postIndex]...)
+				if x.Challenge == nil {
+					x.Challenge = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelyingPartyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.User == nil {
+					x.User = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.User[mapkey] = mapvalue
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLoginStartRequest               protoreflect.MessageDescriptor
+	fd_QueryLoginStartRequest_assertion_did protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryLoginStartRequest = File_did_v1_query_proto.Messages().ByName("QueryLoginStartRequest")
+	fd_QueryLoginStartRequest_assertion_did = md_QueryLoginStartRequest.Fields().ByName("assertion_did")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLoginStartRequest)(nil)
+
+type fastReflection_QueryLoginStartRequest QueryLoginStartRequest
+
+func (x *QueryLoginStartRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLoginStartRequest)(x)
+}
+
+func (x *QueryLoginStartRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLoginStartRequest_messageType fastReflection_QueryLoginStartRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLoginStartRequest_messageType{}
+
+type fastReflection_QueryLoginStartRequest_messageType struct{}
+
+func (x fastReflection_QueryLoginStartRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLoginStartRequest)(nil)
+}
+func (x fastReflection_QueryLoginStartRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLoginStartRequest)
+}
+func (x fastReflection_QueryLoginStartRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLoginStartRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLoginStartRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLoginStartRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLoginStartRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLoginStartRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLoginStartRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLoginStartRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLoginStartRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLoginStartRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLoginStartRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AssertionDid != "" {
+		value := protoreflect.ValueOfString(x.AssertionDid)
+		if !f(fd_QueryLoginStartRequest_assertion_did, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLoginStartRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartRequest.assertion_did":
+		return x.AssertionDid != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartRequest.assertion_did":
+		x.AssertionDid = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLoginStartRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryLoginStartRequest.assertion_did":
+		value := x.AssertionDid
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartRequest.assertion_did":
+		x.AssertionDid = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartRequest.assertion_did":
+		panic(fmt.Errorf("field assertion_did of message did.v1.QueryLoginStartRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLoginStartRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartRequest.assertion_did":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLoginStartRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryLoginStartRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLoginStartRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLoginStartRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLoginStartRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLoginStartRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AssertionDid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLoginStartRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AssertionDid) > 0 {
+			i -= len(x.AssertionDid)
+			copy(dAtA[i:], x.AssertionDid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssertionDid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLoginStartRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssertionDid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssertionDid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryLoginStartResponse_1_list)(nil)
+
+type _QueryLoginStartResponse_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryLoginStartResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLoginStartResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryLoginStartResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLoginStartResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLoginStartResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryLoginStartResponse at list field CredentialIds as it is not of Message kind"))
+}
+
+func (x *_QueryLoginStartResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLoginStartResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryLoginStartResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryLoginStartResponse                  protoreflect.MessageDescriptor
+	fd_QueryLoginStartResponse_credential_ids   protoreflect.FieldDescriptor
+	fd_QueryLoginStartResponse_challenge        protoreflect.FieldDescriptor
+	fd_QueryLoginStartResponse_relying_party_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryLoginStartResponse = File_did_v1_query_proto.Messages().ByName("QueryLoginStartResponse")
+	fd_QueryLoginStartResponse_credential_ids = md_QueryLoginStartResponse.Fields().ByName("credential_ids")
+	fd_QueryLoginStartResponse_challenge = md_QueryLoginStartResponse.Fields().ByName("challenge")
+	fd_QueryLoginStartResponse_relying_party_id = md_QueryLoginStartResponse.Fields().ByName("relying_party_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLoginStartResponse)(nil)
+
+type fastReflection_QueryLoginStartResponse QueryLoginStartResponse
+
+func (x *QueryLoginStartResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLoginStartResponse)(x)
+}
+
+func (x *QueryLoginStartResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLoginStartResponse_messageType fastReflection_QueryLoginStartResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLoginStartResponse_messageType{}
+
+type fastReflection_QueryLoginStartResponse_messageType struct{}
+
+func (x fastReflection_QueryLoginStartResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLoginStartResponse)(nil)
+}
+func (x fastReflection_QueryLoginStartResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLoginStartResponse)
+}
+func (x fastReflection_QueryLoginStartResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLoginStartResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLoginStartResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLoginStartResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLoginStartResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLoginStartResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLoginStartResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLoginStartResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLoginStartResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLoginStartResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLoginStartResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CredentialIds) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLoginStartResponse_1_list{list: &x.CredentialIds})
+		if !f(fd_QueryLoginStartResponse_credential_ids, value) {
+			return
+		}
+	}
+	if len(x.Challenge) != 0 {
+		value := protoreflect.ValueOfBytes(x.Challenge)
+		if !f(fd_QueryLoginStartResponse_challenge, value) {
+			return
+		}
+	}
+	if x.RelyingPartyId != "" {
+		value := protoreflect.ValueOfString(x.RelyingPartyId)
+		if !f(fd_QueryLoginStartResponse_relying_party_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLoginStartResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartResponse.credential_ids":
+		return len(x.CredentialIds) != 0
+	case "did.v1.QueryLoginStartResponse.challenge":
+		return len(x.Challenge) != 0
+	case "did.v1.QueryLoginStartResponse.relying_party_id":
+		return x.RelyingPartyId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartResponse.credential_ids":
+		x.CredentialIds = nil
+	case "did.v1.QueryLoginStartResponse.challenge":
+		x.Challenge = nil
+	case "did.v1.QueryLoginStartResponse.relying_party_id":
+		x.RelyingPartyId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLoginStartResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryLoginStartResponse.credential_ids":
+		if len(x.CredentialIds) == 0 {
+			return protoreflect.ValueOfList(&_QueryLoginStartResponse_1_list{})
+		}
+		listValue := &_QueryLoginStartResponse_1_list{list: &x.CredentialIds}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.QueryLoginStartResponse.challenge":
+		value := x.Challenge
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.QueryLoginStartResponse.relying_party_id":
+		value := x.RelyingPartyId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartResponse.credential_ids":
+		lv := value.List()
+		clv := lv.(*_QueryLoginStartResponse_1_list)
+		x.CredentialIds = *clv.list
+	case "did.v1.QueryLoginStartResponse.challenge":
+		x.Challenge = value.Bytes()
+	case "did.v1.QueryLoginStartResponse.relying_party_id":
+		x.RelyingPartyId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartResponse.credential_ids":
+		if x.CredentialIds == nil {
+			x.CredentialIds = []string{}
+		}
+		value := &_QueryLoginStartResponse_1_list{list: &x.CredentialIds}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.QueryLoginStartResponse.challenge":
+		panic(fmt.Errorf("field challenge of message did.v1.QueryLoginStartResponse is not mutable"))
+	case "did.v1.QueryLoginStartResponse.relying_party_id":
+		panic(fmt.Errorf("field relying_party_id of message did.v1.QueryLoginStartResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLoginStartResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryLoginStartResponse.credential_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryLoginStartResponse_1_list{list: &list})
+	case "did.v1.QueryLoginStartResponse.challenge":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.QueryLoginStartResponse.relying_party_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryLoginStartResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryLoginStartResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLoginStartResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryLoginStartResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLoginStartResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLoginStartResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLoginStartResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLoginStartResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLoginStartResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CredentialIds) > 0 {
+			for _, s := range x.CredentialIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Challenge)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RelyingPartyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLoginStartResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RelyingPartyId) > 0 {
+			i -= len(x.RelyingPartyId)
+			copy(dAtA[i:], x.RelyingPartyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RelyingPartyId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Challenge) > 0 {
+			i -= len(x.Challenge)
+			copy(dAtA[i:], x.Challenge)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenge)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CredentialIds) > 0 {
+			for iNdEx := len(x.CredentialIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CredentialIds[iNdEx])
+				copy(dAtA[i:], x.CredentialIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialIds[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLoginStartResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLoginStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialIds = append(x.CredentialIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Challenge = append(x.Challenge[:0], dAtA[iNdEx:postIndex]...)
+				if x.Challenge == nil {
+					x.Challenge = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelyingPartyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetUnverifiedCredentialsRequest     protoreflect.MessageDescriptor
+	fd_QueryGetUnverifiedCredentialsRequest_did protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetUnverifiedCredentialsRequest = File_did_v1_query_proto.Messages().ByName("QueryGetUnverifiedCredentialsRequest")
+	fd_QueryGetUnverifiedCredentialsRequest_did = md_QueryGetUnverifiedCredentialsRequest.Fields().ByName("did")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetUnverifiedCredentialsRequest)(nil)
+
+type fastReflection_QueryGetUnverifiedCredentialsRequest QueryGetUnverifiedCredentialsRequest
+
+func (x *QueryGetUnverifiedCredentialsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetUnverifiedCredentialsRequest)(x)
+}
+
+func (x *QueryGetUnverifiedCredentialsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetUnverifiedCredentialsRequest_messageType fastReflection_QueryGetUnverifiedCredentialsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetUnverifiedCredentialsRequest_messageType{}
+
+type fastReflection_QueryGetUnverifiedCredentialsRequest_messageType struct{}
+
+func (x fastReflection_QueryGetUnverifiedCredentialsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetUnverifiedCredentialsRequest)(nil)
+}
+func (x fastReflection_QueryGetUnverifiedCredentialsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetUnverifiedCredentialsRequest)
+}
+func (x fastReflection_QueryGetUnverifiedCredentialsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetUnverifiedCredentialsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetUnverifiedCredentialsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetUnverifiedCredentialsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetUnverifiedCredentialsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetUnverifiedCredentialsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_QueryGetUnverifiedCredentialsRequest_did, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsRequest.did":
+		return x.Did != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsRequest.did":
+		x.Did = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsRequest.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsRequest.did":
+		x.Did = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsRequest.did":
+		panic(fmt.Errorf("field did of message did.v1.QueryGetUnverifiedCredentialsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsRequest.did":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsRequest"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetUnverifiedCredentialsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetUnverifiedCredentialsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetUnverifiedCredentialsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetUnverifiedCredentialsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetUnverifiedCredentialsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetUnverifiedCredentialsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetUnverifiedCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGetUnverifiedCredentialsResponse_1_list)(nil)
+
+type _QueryGetUnverifiedCredentialsResponse_1_list struct {
+	list *[]*UnverifiedCredential
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnverifiedCredential)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnverifiedCredential)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(UnverifiedCredential)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(UnverifiedCredential)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetUnverifiedCredentialsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetUnverifiedCredentialsResponse             protoreflect.MessageDescriptor
+	fd_QueryGetUnverifiedCredentialsResponse_credentials protoreflect.FieldDescriptor
+	fd_QueryGetUnverifiedCredentialsResponse_downgraded  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_query_proto_init()
+	md_QueryGetUnverifiedCredentialsResponse = File_did_v1_query_proto.Messages().ByName("QueryGetUnverifiedCredentialsResponse")
+	fd_QueryGetUnverifiedCredentialsResponse_credentials = md_QueryGetUnverifiedCredentialsResponse.Fields().ByName("credentials")
+	fd_QueryGetUnverifiedCredentialsResponse_downgraded = md_QueryGetUnverifiedCredentialsResponse.Fields().ByName("downgraded")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetUnverifiedCredentialsResponse)(nil)
+
+type fastReflection_QueryGetUnverifiedCredentialsResponse QueryGetUnverifiedCredentialsResponse
+
+func (x *QueryGetUnverifiedCredentialsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetUnverifiedCredentialsResponse)(x)
+}
+
+func (x *QueryGetUnverifiedCredentialsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetUnverifiedCredentialsResponse_messageType fastReflection_QueryGetUnverifiedCredentialsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetUnverifiedCredentialsResponse_messageType{}
+
+type fastReflection_QueryGetUnverifiedCredentialsResponse_messageType struct{}
+
+func (x fastReflection_QueryGetUnverifiedCredentialsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetUnverifiedCredentialsResponse)(nil)
+}
+func (x fastReflection_QueryGetUnverifiedCredentialsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetUnverifiedCredentialsResponse)
+}
+func (x fastReflection_QueryGetUnverifiedCredentialsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetUnverifiedCredentialsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetUnverifiedCredentialsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetUnverifiedCredentialsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetUnverifiedCredentialsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetUnverifiedCredentialsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Credentials) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetUnverifiedCredentialsResponse_1_list{list: &x.Credentials})
+		if !f(fd_QueryGetUnverifiedCredentialsResponse_credentials, value) {
+			return
+		}
+	}
+	if x.Downgraded != false {
+		value := protoreflect.ValueOfBool(x.Downgraded)
+		if !f(fd_QueryGetUnverifiedCredentialsResponse_downgraded, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.credentials":
+		return len(x.Credentials) != 0
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.downgraded":
+		return x.Downgraded != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.credentials":
+		x.Credentials = nil
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.downgraded":
+		x.Downgraded = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.credentials":
+		if len(x.Credentials) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetUnverifiedCredentialsResponse_1_list{})
+		}
+		listValue := &_QueryGetUnverifiedCredentialsResponse_1_list{list: &x.Credentials}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.downgraded":
+		value := x.Downgraded
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.credentials":
+		lv := value.List()
+		clv := lv.(*_QueryGetUnverifiedCredentialsResponse_1_list)
+		x.Credentials = *clv.list
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.downgraded":
+		x.Downgraded = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.credentials":
+		if x.Credentials == nil {
+			x.Credentials = []*UnverifiedCredential{}
+		}
+		value := &_QueryGetUnverifiedCredentialsResponse_1_list{list: &x.Credentials}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.downgraded":
+		panic(fmt.Errorf("field downgraded of message did.v1.QueryGetUnverifiedCredentialsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.credentials":
+		list := []*UnverifiedCredential{}
+		return protoreflect.ValueOfList(&_QueryGetUnverifiedCredentialsResponse_1_list{list: &list})
+	case "did.v1.QueryGetUnverifiedCredentialsResponse.downgraded":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.QueryGetUnverifiedCredentialsResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.QueryGetUnverifiedCredentialsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.QueryGetUnverifiedCredentialsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetUnverifiedCredentialsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetUnverifiedCredentialsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Credentials) > 0 {
+			for _, e := range x.Credentials {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Downgraded {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetUnverifiedCredentialsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Downgraded {
+			i--
+			if x.Downgraded {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Credentials) > 0 {
+			for iNdEx := len(x.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Credentials[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetUnverifiedCredentialsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetUnverifiedCredentialsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetUnverifiedCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Credentials = append(x.Credentials, &UnverifiedCredential{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credentials[len(x.Credentials)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Downgraded", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Downgraded = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: did/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryResolveDIDRequest is the request type for the Query/ResolveDID RPC
+// method.
+type QueryResolveDIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did is the DID to resolve
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (x *QueryResolveDIDRequest) Reset() {
+	*x = QueryResolveDIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResolveDIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResolveDIDRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryResolveDIDRequest.ProtoReflect.Descriptor instead.
+func (*QueryResolveDIDRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryResolveDIDRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+// QueryResolveDIDResponse is the response type for the Query/ResolveDID RPC
+// method.
+type QueryResolveDIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did_document is the resolved DID document
+	DidDocument *DIDDocument `protobuf:"bytes,1,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// did_document_metadata contains metadata about the DID document
+	DidDocumentMetadata *DIDDocumentMetadata `protobuf:"bytes,2,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"did_document_metadata,omitempty"`
+}
+
+func (x *QueryResolveDIDResponse) Reset() {
+	*x = QueryResolveDIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResolveDIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResolveDIDResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryResolveDIDResponse.ProtoReflect.Descriptor instead.
+func (*QueryResolveDIDResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryResolveDIDResponse) GetDidDocument() *DIDDocument {
+	if x != nil {
+		return x.DidDocument
+	}
+	return nil
+}
+
+func (x *QueryResolveDIDResponse) GetDidDocumentMetadata() *DIDDocumentMetadata {
+	if x != nil {
+		return x.DidDocumentMetadata
+	}
+	return nil
+}
+
+// QueryGetDIDDocumentRequest is the request type for the
+// Query/GetDIDDocument RPC method.
+type QueryGetDIDDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did is the DID to retrieve
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (x *QueryGetDIDDocumentRequest) Reset() {
+	*x = QueryGetDIDDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetDIDDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetDIDDocumentRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetDIDDocumentRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryGetDIDDocumentRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+// QueryGetDIDDocumentResponse is the response type for the
+// Query/GetDIDDocument RPC method.
+type QueryGetDIDDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did_document is the retrieved DID document
+	DidDocument *DIDDocument `protobuf:"bytes,1,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// did_document_metadata contains metadata about the DID document
+	DidDocumentMetadata *DIDDocumentMetadata `protobuf:"bytes,2,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"did_document_metadata,omitempty"`
+}
+
+func (x *QueryGetDIDDocumentResponse) Reset() {
+	*x = QueryGetDIDDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetDIDDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetDIDDocumentResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetDIDDocumentResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryGetDIDDocumentResponse) GetDidDocument() *DIDDocument {
+	if x != nil {
+		return x.DidDocument
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *QueryGetDIDDocumentResponse) GetDidDocumentMetadata() *DIDDocumentMetadata {
+	if x != nil {
+		return x.DidDocumentMetadata
+	}
+	return nil
+}
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryGetDIDDocumentVersionRequest is the request type for the
+// Query/GetDIDDocumentVersion RPC method. At most one of version_id and
+// version_time may be set; with neither the latest version is returned.
+type QueryGetDIDDocumentVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// did is the DID to resolve
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// version_id selects a version by number
+	VersionId uint64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// version_time selects the version that was current at an RFC 3339 time
+	VersionTime string `protobuf:"bytes,3,opt,name=version_time,json=versionTime,proto3" json:"version_time,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryGetDIDDocumentVersionRequest) Reset() {
+	*x = QueryGetDIDDocumentVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[0]
+		mi := &file_did_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryGetDIDDocumentVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryGetDIDDocumentVersionRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryGetDIDDocumentVersionRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDDocumentVersionRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryGetDIDDocumentVersionRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *QueryGetDIDDocumentVersionRequest) GetVersionId() uint64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *QueryGetDIDDocumentVersionRequest) GetVersionTime() string {
+	if x != nil {
+		return x.VersionTime
+	}
+	return ""
+}
+
+// QueryGetDIDDocumentVersionResponse is the response type for the
+// Query/GetDIDDocumentVersion RPC method.
+type QueryGetDIDDocumentVersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params defines the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// did_document is the DID document at the resolved version
+	DidDocument *DIDDocument `protobuf:"bytes,1,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// did_document_metadata describes the resolved version: updated and
+	// version_id are those of the version, next_update and next_version_id
+	// those of the version that replaced it, if any
+	DidDocumentMetadata *DIDDocumentMetadata `protobuf:"bytes,2,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"did_document_metadata,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryGetDIDDocumentVersionResponse) Reset() {
+	*x = QueryGetDIDDocumentVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[1]
+		mi := &file_did_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryGetDIDDocumentVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryGetDIDDocumentVersionResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryGetDIDDocumentVersionResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDDocumentVersionResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryGetDIDDocumentVersionResponse) GetDidDocument() *DIDDocument {
 	if x != nil {
-		return x.Params
+		return x.DidDocument
 	}
 	return nil
 }
 
-// QueryResolveDIDRequest is the request type for the Query/ResolveDID RPC
-// method.
-type QueryResolveDIDRequest struct {
+func (x *QueryGetDIDDocumentVersionResponse) GetDidDocumentMetadata() *DIDDocumentMetadata {
+	if x != nil {
+		return x.DidDocumentMetadata
+	}
+	return nil
+}
+
+// QueryGetDIDDocumentHistoryRequest is the request type for the
+// Query/GetDIDDocumentHistory RPC method.
+type QueryGetDIDDocumentHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// did is the DID to resolve
+	// did is the DID whose versions are listed
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryResolveDIDRequest) Reset() {
-	*x = QueryResolveDIDRequest{}
+func (x *QueryGetDIDDocumentHistoryRequest) Reset() {
+	*x = QueryGetDIDDocumentHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[2]
+		mi := &file_did_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryResolveDIDRequest) String() string {
+func (x *QueryGetDIDDocumentHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResolveDIDRequest) ProtoMessage() {}
+func (*QueryGetDIDDocumentHistoryRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryResolveDIDRequest.ProtoReflect.Descriptor instead.
-func (*QueryResolveDIDRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryGetDIDDocumentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDDocumentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryResolveDIDRequest) GetDid() string {
+func (x *QueryGetDIDDocumentHistoryRequest) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-// QueryResolveDIDResponse is the response type for the Query/ResolveDID RPC
-// method.
-type QueryResolveDIDResponse struct {
+func (x *QueryGetDIDDocumentHistoryRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGetDIDDocumentHistoryResponse is the response type for the
+// Query/GetDIDDocumentHistory RPC method.
+type QueryGetDIDDocumentHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// did_document is the resolved DID document
-	DidDocument *DIDDocument `protobuf:"bytes,1,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
-	// did_document_metadata contains metadata about the DID document
-	DidDocumentMetadata *DIDDocumentMetadata `protobuf:"bytes,2,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"did_document_metadata,omitempty"`
+	// versions are the document's versions in version order
+	Versions []*DIDDocumentVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryResolveDIDResponse) Reset() {
-	*x = QueryResolveDIDResponse{}
+func (x *QueryGetDIDDocumentHistoryResponse) Reset() {
+	*x = QueryGetDIDDocumentHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[3]
+		mi := &file_did_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryResolveDIDResponse) String() string {
+func (x *QueryGetDIDDocumentHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResolveDIDResponse) ProtoMessage() {}
+func (*QueryGetDIDDocumentHistoryResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryResolveDIDResponse.ProtoReflect.Descriptor instead.
-func (*QueryResolveDIDResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryGetDIDDocumentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDDocumentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryResolveDIDResponse) GetDidDocument() *DIDDocument {
+func (x *QueryGetDIDDocumentHistoryResponse) GetVersions() []*DIDDocumentVersion {
 	if x != nil {
-		return x.DidDocument
+		return x.Versions
 	}
 	return nil
 }
 
-func (x *QueryResolveDIDResponse) GetDidDocumentMetadata() *DIDDocumentMetadata {
+func (x *QueryGetDIDDocumentHistoryResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.DidDocumentMetadata
+		return x.Pagination
 	}
 	return nil
 }
 
-// QueryGetDIDDocumentRequest is the request type for the
-// Query/GetDIDDocument RPC method.
-type QueryGetDIDDocumentRequest struct {
+// QueryGetDelegatedControllersRequest is the request type for the
+// Query/GetDelegatedControllers RPC method.
+type QueryGetDelegatedControllersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// did is the DID to retrieve
+	// did is the DID whose delegations are listed
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-func (x *QueryGetDIDDocumentRequest) Reset() {
-	*x = QueryGetDIDDocumentRequest{}
+func (x *QueryGetDelegatedControllersRequest) Reset() {
+	*x = QueryGetDelegatedControllersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[4]
+		mi := &file_did_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetDIDDocumentRequest) String() string {
+func (x *QueryGetDelegatedControllersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetDIDDocumentRequest) ProtoMessage() {}
+func (*QueryGetDelegatedControllersRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetDIDDocumentRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetDIDDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryGetDelegatedControllersRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetDelegatedControllersRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryGetDIDDocumentRequest) GetDid() string {
+func (x *QueryGetDelegatedControllersRequest) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-// QueryGetDIDDocumentResponse is the response type for the
-// Query/GetDIDDocument RPC method.
-type QueryGetDIDDocumentResponse struct {
+// QueryGetDelegatedControllersResponse is the response type for the
+// Query/GetDelegatedControllers RPC method.
+type QueryGetDelegatedControllersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// did_document is the retrieved DID document
-	DidDocument *DIDDocument `protobuf:"bytes,1,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
-	// did_document_metadata contains metadata about the DID document
-	DidDocumentMetadata *DIDDocumentMetadata `protobuf:"bytes,2,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"did_document_metadata,omitempty"`
+	// delegations are the active delegations, soonest expiry first
+	Delegations []*DelegatedController `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
 }
 
-func (x *QueryGetDIDDocumentResponse) Reset() {
-	*x = QueryGetDIDDocumentResponse{}
+func (x *QueryGetDelegatedControllersResponse) Reset() {
+	*x = QueryGetDelegatedControllersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[5]
+		mi := &file_did_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetDIDDocumentResponse) String() string {
+func (x *QueryGetDelegatedControllersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetDIDDocumentResponse) ProtoMessage() {}
+func (*QueryGetDelegatedControllersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetDIDDocumentResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetDIDDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryGetDelegatedControllersResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetDelegatedControllersResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryGetDIDDocumentResponse) GetDidDocument() *DIDDocument {
+func (x *QueryGetDelegatedControllersResponse) GetDelegations() []*DelegatedController {
 	if x != nil {
-		return x.DidDocument
+		return x.Delegations
 	}
 	return nil
 }
 
-func (x *QueryGetDIDDocumentResponse) GetDidDocumentMetadata() *DIDDocumentMetadata {
+// QueryGetDIDAssetsRequest is the request type for the Query/GetDIDAssets
+// RPC method.
+type QueryGetDIDAssetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did is the DID whose assets are listed
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// owned_only omits tokens the DID's controllers no longer own
+	OwnedOnly bool `protobuf:"varint,2,opt,name=owned_only,json=ownedOnly,proto3" json:"owned_only,omitempty"`
+}
+
+func (x *QueryGetDIDAssetsRequest) Reset() {
+	*x = QueryGetDIDAssetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetDIDAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetDIDAssetsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetDIDAssetsRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryGetDIDAssetsRequest) GetDid() string {
 	if x != nil {
-		return x.DidDocumentMetadata
+		return x.Did
+	}
+	return ""
+}
+
+func (x *QueryGetDIDAssetsRequest) GetOwnedOnly() bool {
+	if x != nil {
+		return x.OwnedOnly
+	}
+	return false
+}
+
+// QueryGetDIDAssetsResponse is the response type for the Query/GetDIDAssets
+// RPC method.
+type QueryGetDIDAssetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// assets are the tokens attached to the DID, by class and token ID
+	Assets []*DIDAssetInfo `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+}
+
+func (x *QueryGetDIDAssetsResponse) Reset() {
+	*x = QueryGetDIDAssetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetDIDAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetDIDAssetsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetDIDAssetsResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetDIDAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryGetDIDAssetsResponse) GetAssets() []*DIDAssetInfo {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+// DIDAssetInfo is an attached token with its current ownership
+type DIDAssetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset *DIDAsset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// current_owner is the address owning the token now, empty when it no
+	// longer exists
+	CurrentOwner string `protobuf:"bytes,2,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	// owned is set while a controller of the DID owns the token
+	Owned bool `protobuf:"varint,3,opt,name=owned,proto3" json:"owned,omitempty"`
+}
+
+func (x *DIDAssetInfo) Reset() {
+	*x = DIDAssetInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DIDAssetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DIDAssetInfo) ProtoMessage() {}
+
+// Deprecated: Use DIDAssetInfo.ProtoReflect.Descriptor instead.
+func (*DIDAssetInfo) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DIDAssetInfo) GetAsset() *DIDAsset {
+	if x != nil {
+		return x.Asset
 	}
 	return nil
 }
 
+func (x *DIDAssetInfo) GetCurrentOwner() string {
+	if x != nil {
+		return x.CurrentOwner
+	}
+	return ""
+}
+
+func (x *DIDAssetInfo) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
 // QueryListDIDDocumentsRequest is the request type for the
 // Query/ListDIDDocuments RPC method.
 type QueryListDIDDocumentsRequest struct {
@@ -13069,7 +21029,7 @@ type QueryListDIDDocumentsRequest struct {
 func (x *QueryListDIDDocumentsRequest) Reset() {
 	*x = QueryListDIDDocumentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[6]
+		mi := &file_did_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13083,7 +21043,7 @@ func (*QueryListDIDDocumentsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryListDIDDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*QueryListDIDDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryListDIDDocumentsRequest) GetPagination() *v1beta1.PageRequest {
@@ -13109,7 +21069,7 @@ type QueryListDIDDocumentsResponse struct {
 func (x *QueryListDIDDocumentsResponse) Reset() {
 	*x = QueryListDIDDocumentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[7]
+		mi := &file_did_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13123,7 +21083,7 @@ func (*QueryListDIDDocumentsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryListDIDDocumentsResponse.ProtoReflect.Descriptor instead.
 func (*QueryListDIDDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryListDIDDocumentsResponse) GetDidDocuments() []*DIDDocument {
@@ -13156,7 +21116,7 @@ type QueryGetDIDDocumentsByControllerRequest struct {
 func (x *QueryGetDIDDocumentsByControllerRequest) Reset() {
 	*x = QueryGetDIDDocumentsByControllerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[8]
+		mi := &file_did_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13170,7 +21130,7 @@ func (*QueryGetDIDDocumentsByControllerRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetDIDDocumentsByControllerRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetDIDDocumentsByControllerRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryGetDIDDocumentsByControllerRequest) GetController() string {
@@ -13203,7 +21163,7 @@ type QueryGetDIDDocumentsByControllerResponse struct {
 func (x *QueryGetDIDDocumentsByControllerResponse) Reset() {
 	*x = QueryGetDIDDocumentsByControllerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[9]
+		mi := &file_did_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13217,7 +21177,7 @@ func (*QueryGetDIDDocumentsByControllerResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetDIDDocumentsByControllerResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetDIDDocumentsByControllerResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryGetDIDDocumentsByControllerResponse) GetDidDocuments() []*DIDDocument {
@@ -13250,7 +21210,7 @@ type QueryGetVerificationMethodRequest struct {
 func (x *QueryGetVerificationMethodRequest) Reset() {
 	*x = QueryGetVerificationMethodRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[10]
+		mi := &file_did_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13264,7 +21224,7 @@ func (*QueryGetVerificationMethodRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetVerificationMethodRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetVerificationMethodRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryGetVerificationMethodRequest) GetDid() string {
@@ -13295,7 +21255,7 @@ type QueryGetVerificationMethodResponse struct {
 func (x *QueryGetVerificationMethodResponse) Reset() {
 	*x = QueryGetVerificationMethodResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[11]
+		mi := &file_did_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13309,7 +21269,7 @@ func (*QueryGetVerificationMethodResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetVerificationMethodResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetVerificationMethodResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryGetVerificationMethodResponse) GetVerificationMethod() *VerificationMethod {
@@ -13335,7 +21295,7 @@ type QueryGetServiceRequest struct {
 func (x *QueryGetServiceRequest) Reset() {
 	*x = QueryGetServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[12]
+		mi := &file_did_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13349,7 +21309,7 @@ func (*QueryGetServiceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetServiceRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetServiceRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryGetServiceRequest) GetDid() string {
@@ -13380,7 +21340,7 @@ type QueryGetServiceResponse struct {
 func (x *QueryGetServiceResponse) Reset() {
 	*x = QueryGetServiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[13]
+		mi := &file_did_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13394,7 +21354,7 @@ func (*QueryGetServiceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetServiceResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetServiceResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryGetServiceResponse) GetService() *Service {
@@ -13418,7 +21378,7 @@ type QueryGetVerifiableCredentialRequest struct {
 func (x *QueryGetVerifiableCredentialRequest) Reset() {
 	*x = QueryGetVerifiableCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[14]
+		mi := &file_did_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13432,7 +21392,7 @@ func (*QueryGetVerifiableCredentialRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetVerifiableCredentialRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetVerifiableCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryGetVerifiableCredentialRequest) GetCredentialId() string {
@@ -13456,7 +21416,7 @@ type QueryGetVerifiableCredentialResponse struct {
 func (x *QueryGetVerifiableCredentialResponse) Reset() {
 	*x = QueryGetVerifiableCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[15]
+		mi := &file_did_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13470,7 +21430,7 @@ func (*QueryGetVerifiableCredentialResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetVerifiableCredentialResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryGetVerifiableCredentialResponse) GetCredential() *VerifiableCredential {
@@ -13480,6 +21440,215 @@ func (x *QueryGetVerifiableCredentialResponse) GetCredential() *VerifiableCreden
 	return nil
 }
 
+// QueryVerifyCredentialRequest is the request type for the
+// Query/VerifyCredential RPC method.
+type QueryVerifyCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// credential_id is the ID of the credential to verify
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+}
+
+func (x *QueryVerifyCredentialRequest) Reset() {
+	*x = QueryVerifyCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVerifyCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVerifyCredentialRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVerifyCredentialRequest.ProtoReflect.Descriptor instead.
+func (*QueryVerifyCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryVerifyCredentialRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+// QueryVerifyCredentialResponse is the response type for the
+// Query/VerifyCredential RPC method.
+type QueryVerifyCredentialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// valid is set when the proof verifies and the credential is neither
+	// revoked nor expired
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// proof_verified is set when every proof verifies against the issuer's
+	// assertion methods
+	ProofVerified bool `protobuf:"varint,2,opt,name=proof_verified,json=proofVerified,proto3" json:"proof_verified,omitempty"`
+	// revoked is set when the credential's status list entry is set
+	Revoked bool `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// expired is set when the expiration date has passed
+	Expired bool `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// reason explains why the credential is not valid
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *QueryVerifyCredentialResponse) Reset() {
+	*x = QueryVerifyCredentialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVerifyCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVerifyCredentialResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVerifyCredentialResponse.ProtoReflect.Descriptor instead.
+func (*QueryVerifyCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryVerifyCredentialResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *QueryVerifyCredentialResponse) GetProofVerified() bool {
+	if x != nil {
+		return x.ProofVerified
+	}
+	return false
+}
+
+func (x *QueryVerifyCredentialResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *QueryVerifyCredentialResponse) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *QueryVerifyCredentialResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// QueryGetStatusListRequest is the request type for the Query/GetStatusList
+// RPC method.
+type QueryGetStatusListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// issuer is the DID of the issuer
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// list_id is the number of the list
+	ListId uint64 `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+}
+
+func (x *QueryGetStatusListRequest) Reset() {
+	*x = QueryGetStatusListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetStatusListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetStatusListRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetStatusListRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetStatusListRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryGetStatusListRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *QueryGetStatusListRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+// QueryGetStatusListResponse is the response type for the
+// Query/GetStatusList RPC method.
+type QueryGetStatusListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status_list is the stored list
+	StatusList *StatusList `protobuf:"bytes,1,opt,name=status_list,json=statusList,proto3" json:"status_list,omitempty"`
+	// credential is the list as a StatusList2021Credential JSON document, with
+	// the bitstring gzip compressed and base64url encoded
+	Credential string `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+}
+
+func (x *QueryGetStatusListResponse) Reset() {
+	*x = QueryGetStatusListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetStatusListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetStatusListResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetStatusListResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetStatusListResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryGetStatusListResponse) GetStatusList() *StatusList {
+	if x != nil {
+		return x.StatusList
+	}
+	return nil
+}
+
+func (x *QueryGetStatusListResponse) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
 // QueryListVerifiableCredentialsRequest is the request type for the
 // Query/ListVerifiableCredentials RPC method.
 type QueryListVerifiableCredentialsRequest struct {
@@ -13500,7 +21669,7 @@ type QueryListVerifiableCredentialsRequest struct {
 func (x *QueryListVerifiableCredentialsRequest) Reset() {
 	*x = QueryListVerifiableCredentialsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[16]
+		mi := &file_did_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13514,7 +21683,7 @@ func (*QueryListVerifiableCredentialsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryListVerifiableCredentialsRequest.ProtoReflect.Descriptor instead.
 func (*QueryListVerifiableCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryListVerifiableCredentialsRequest) GetPagination() *v1beta1.PageRequest {
@@ -13561,7 +21730,7 @@ type QueryListVerifiableCredentialsResponse struct {
 func (x *QueryListVerifiableCredentialsResponse) Reset() {
 	*x = QueryListVerifiableCredentialsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[17]
+		mi := &file_did_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13575,7 +21744,7 @@ func (*QueryListVerifiableCredentialsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryListVerifiableCredentialsResponse.ProtoReflect.Descriptor instead.
 func (*QueryListVerifiableCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryListVerifiableCredentialsResponse) GetCredentials() []*VerifiableCredential {
@@ -13601,7 +21770,6 @@ type CredentialInfo struct {
 	// credential can be either verifiable or WebAuthn
 	//
 	// Types that are assignable to Credential:
-	//
 	//	*CredentialInfo_VerifiableCredential
 	//	*CredentialInfo_WebauthnCredential
 	Credential isCredentialInfo_Credential `protobuf_oneof:"credential"`
@@ -13614,7 +21782,7 @@ type CredentialInfo struct {
 func (x *CredentialInfo) Reset() {
 	*x = CredentialInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[18]
+		mi := &file_did_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13628,7 +21796,7 @@ func (*CredentialInfo) ProtoMessage() {}
 
 // Deprecated: Use CredentialInfo.ProtoReflect.Descriptor instead.
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CredentialInfo) GetCredential() isCredentialInfo_Credential {
@@ -13704,7 +21872,7 @@ type QueryGetCredentialsByDIDRequest struct {
 func (x *QueryGetCredentialsByDIDRequest) Reset() {
 	*x = QueryGetCredentialsByDIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[19]
+		mi := &file_did_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13718,7 +21886,7 @@ func (*QueryGetCredentialsByDIDRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetCredentialsByDIDRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetCredentialsByDIDRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueryGetCredentialsByDIDRequest) GetDid() string {
@@ -13772,7 +21940,7 @@ type QueryGetCredentialsByDIDResponse struct {
 func (x *QueryGetCredentialsByDIDResponse) Reset() {
 	*x = QueryGetCredentialsByDIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[20]
+		mi := &file_did_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13786,7 +21954,7 @@ func (*QueryGetCredentialsByDIDResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetCredentialsByDIDResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetCredentialsByDIDResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryGetCredentialsByDIDResponse) GetCredentials() []*CredentialInfo {
@@ -13817,7 +21985,7 @@ type QueryRegisterStartRequest struct {
 func (x *QueryRegisterStartRequest) Reset() {
 	*x = QueryRegisterStartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[21]
+		mi := &file_did_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13831,7 +21999,7 @@ func (*QueryRegisterStartRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryRegisterStartRequest.ProtoReflect.Descriptor instead.
 func (*QueryRegisterStartRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryRegisterStartRequest) GetAssertionDid() string {
@@ -13859,7 +22027,7 @@ type QueryRegisterStartResponse struct {
 func (x *QueryRegisterStartResponse) Reset() {
 	*x = QueryRegisterStartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[22]
+		mi := &file_did_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13873,7 +22041,7 @@ func (*QueryRegisterStartResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryRegisterStartResponse.ProtoReflect.Descriptor instead.
 func (*QueryRegisterStartResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueryRegisterStartResponse) GetChallenge() []byte {
@@ -13911,7 +22079,7 @@ type QueryLoginStartRequest struct {
 func (x *QueryLoginStartRequest) Reset() {
 	*x = QueryLoginStartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[23]
+		mi := &file_did_v1_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13925,70 +22093,155 @@ func (*QueryLoginStartRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryLoginStartRequest.ProtoReflect.Descriptor instead.
 func (*QueryLoginStartRequest) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_did_v1_query_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *QueryLoginStartRequest) GetAssertionDid() string {
 	if x != nil {
-		return x.AssertionDid
+		return x.AssertionDid
+	}
+	return ""
+}
+
+// QueryLoginStartResponse is the response type for the
+// Query/LoginStart RPC method.
+type QueryLoginStartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// credential_ids associated with this assertion
+	CredentialIds []string `protobuf:"bytes,1,rep,name=credential_ids,json=credentialIds,proto3" json:"credential_ids,omitempty"`
+	// challenge for the assertion ceremony (32 bytes)
+	Challenge []byte `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// relying_party_id identifier
+	RelyingPartyId string `protobuf:"bytes,3,opt,name=relying_party_id,json=relyingPartyId,proto3" json:"relying_party_id,omitempty"`
+}
+
+func (x *QueryLoginStartResponse) Reset() {
+	*x = QueryLoginStartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLoginStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLoginStartResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLoginStartResponse.ProtoReflect.Descriptor instead.
+func (*QueryLoginStartResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QueryLoginStartResponse) GetCredentialIds() []string {
+	if x != nil {
+		return x.CredentialIds
+	}
+	return nil
+}
+
+func (x *QueryLoginStartResponse) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *QueryLoginStartResponse) GetRelyingPartyId() string {
+	if x != nil {
+		return x.RelyingPartyId
+	}
+	return ""
+}
+
+// QueryGetUnverifiedCredentialsRequest is the request type for the
+// Query/GetUnverifiedCredentials RPC method.
+type QueryGetUnverifiedCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did is the DID whose credentials are listed
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (x *QueryGetUnverifiedCredentialsRequest) Reset() {
+	*x = QueryGetUnverifiedCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetUnverifiedCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetUnverifiedCredentialsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetUnverifiedCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetUnverifiedCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryGetUnverifiedCredentialsRequest) GetDid() string {
+	if x != nil {
+		return x.Did
 	}
 	return ""
 }
 
-// QueryLoginStartResponse is the response type for the
-// Query/LoginStart RPC method.
-type QueryLoginStartResponse struct {
+// QueryGetUnverifiedCredentialsResponse is the response type for the
+// Query/GetUnverifiedCredentials RPC method.
+type QueryGetUnverifiedCredentialsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// credential_ids associated with this assertion
-	CredentialIds []string `protobuf:"bytes,1,rep,name=credential_ids,json=credentialIds,proto3" json:"credential_ids,omitempty"`
-	// challenge for the assertion ceremony (32 bytes)
-	Challenge []byte `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	// relying_party_id identifier
-	RelyingPartyId string `protobuf:"bytes,3,opt,name=relying_party_id,json=relyingPartyId,proto3" json:"relying_party_id,omitempty"`
+	// credentials are the flagged WebAuthn credentials
+	Credentials []*UnverifiedCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	// downgraded is set when flagged credentials are refused
+	Downgraded bool `protobuf:"varint,2,opt,name=downgraded,proto3" json:"downgraded,omitempty"`
 }
 
-func (x *QueryLoginStartResponse) Reset() {
-	*x = QueryLoginStartResponse{}
+func (x *QueryGetUnverifiedCredentialsResponse) Reset() {
+	*x = QueryGetUnverifiedCredentialsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_query_proto_msgTypes[24]
+		mi := &file_did_v1_query_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLoginStartResponse) String() string {
+func (x *QueryGetUnverifiedCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLoginStartResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryLoginStartResponse.ProtoReflect.Descriptor instead.
-func (*QueryLoginStartResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_query_proto_rawDescGZIP(), []int{24}
-}
+func (*QueryGetUnverifiedCredentialsResponse) ProtoMessage() {}
 
-func (x *QueryLoginStartResponse) GetCredentialIds() []string {
-	if x != nil {
-		return x.CredentialIds
-	}
-	return nil
+// Deprecated: Use QueryGetUnverifiedCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetUnverifiedCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_query_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *QueryLoginStartResponse) GetChallenge() []byte {
+func (x *QueryGetUnverifiedCredentialsResponse) GetCredentials() []*UnverifiedCredential {
 	if x != nil {
-		return x.Challenge
+		return x.Credentials
 	}
 	return nil
 }
 
-func (x *QueryLoginStartResponse) GetRelyingPartyId() string {
+func (x *QueryGetUnverifiedCredentialsResponse) GetDowngraded() bool {
 	if x != nil {
-		return x.RelyingPartyId
+		return x.Downgraded
 	}
-	return ""
+	return false
 }
 
 var File_did_v1_query_proto protoreflect.FileDescriptor
@@ -14037,284 +22290,455 @@ var file_did_v1_query_proto_rawDesc = []byte{
 	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
 	0x64, 0x61, 0x74, 0x61, 0x52, 0x13, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x66, 0x0a, 0x1c, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x77, 0x0a, 0x21, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44,
+	0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x64, 0x69, 0x64,
+	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4f, 0x0a, 0x15, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x13, 0x64,
+	0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x7d, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49,
+	0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
 	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x0c, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x28, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x64, 0x69, 0x64, 0x5f, 0x64,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x21, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x22, 0x71,
-	0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x22, 0x49, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49,
+	0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x23, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64,
+	0x69, 0x64, 0x22, 0x6b, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x4b, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x17,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x22, 0x4a, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x64,
-	0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x22, 0xc8, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22,
-	0xb1, 0x01, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x4f, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x77, 0x0a,
+	0x0c, 0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2,
+	0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x49, 0x44, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x0d, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x64, 0x69,
+	0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
 	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x80, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x53, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x4d, 0x0a, 0x13, 0x77,
-	0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x12, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xfe, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x42, 0x79,
-	0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x12,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x65,
-	0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12,
-	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
 	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x42,
-	0x79, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b,
-	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x40, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
-	0x64, 0x22, 0xdf, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x28,
-	0x0a, 0x10, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e,
-	0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x73,
-	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x69, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72,
-	0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x32, 0xd3, 0x0c,
-	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x59, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x10, 0x12, 0x0e, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x6c, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x49, 0x44,
-	0x12, 0x1e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x69, 0x64, 0x2f,
-	0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d,
-	0x12, 0x79, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x22, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x24, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44,
-	0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x7d, 0x12, 0xa5, 0x01,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x28, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0c, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x22, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x49,
+	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x17, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x64,
-	0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x7b, 0x64,
-	0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0xa0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2b, 0x2e, 0x64,
-	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
+	0x4a, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
 	0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
-	0x22, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x12, 0x2d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x8f, 0x01,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
-	0x42, 0x79, 0x44, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x24, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x22, 0x43, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x4c, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0x77, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xc8, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x53, 0x0a, 0x15, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x4d, 0x0a, 0x13, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x12, 0x77, 0x65, 0x62, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xfe, 0x01, 0x0a, 0x1f, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x73, 0x42, 0x79, 0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x6c, 0x73, 0x42, 0x79, 0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64,
+	0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x77, 0x65, 0x62, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x20,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x69, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x37,
+	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x79, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49,
+	0x64, 0x22, 0x38, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x25,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x32, 0xef, 0x14, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x59, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x6c, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x49, 0x44, 0x12, 0x1e,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x12, 0x79,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
 	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x73, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x12,
-	0x76, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x21, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22,
-	0x16, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13,
-	0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x42, 0x7b, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72,
-	0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64,
-	0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa,
-	0x02, 0x06, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x12, 0x1e, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x96, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49,
+	0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x64, 0x69,
+	0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x64,
+	0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0xa0, 0x01, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x64, 0x69, 0x64,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x64, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7a,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x64, 0x69,
+	0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x64,
+	0x69, 0x64, 0x7d, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x7a, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x49,
+	0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x12, 0x29, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f,
+	0x7b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x7d, 0x12, 0xa5, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x64, 0x69,
+	0x64, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x7b, 0x64, 0x69,
+	0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xa0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2b, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
+	0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x2d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x92, 0x01, 0x0a,
+	0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x12, 0x27, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x7d, 0x2f, 0x7b, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x42, 0x79,
+	0x44, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x42, 0x79, 0x44, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x12, 0xa9, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x7b, 0x64, 0x69, 0x64, 0x7d, 0x2f, 0x75,
+	0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x76, 0x0a, 0x0d, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x16, 0x2f, 0x64, 0x69, 0x64, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x1e, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76,
+	0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x7b, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f,
+	0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69,
+	0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x69, 0x64, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x69,
+	0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -14329,7 +22753,7 @@ func file_did_v1_query_proto_rawDescGZIP() []byte {
 	return file_did_v1_query_proto_rawDescData
 }
 
-var file_did_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_did_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_did_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                       // 0: did.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                      // 1: did.v1.QueryParamsResponse
@@ -14337,89 +22761,133 @@ var file_did_v1_query_proto_goTypes = []interface{}{
 	(*QueryResolveDIDResponse)(nil),                  // 3: did.v1.QueryResolveDIDResponse
 	(*QueryGetDIDDocumentRequest)(nil),               // 4: did.v1.QueryGetDIDDocumentRequest
 	(*QueryGetDIDDocumentResponse)(nil),              // 5: did.v1.QueryGetDIDDocumentResponse
-	(*QueryListDIDDocumentsRequest)(nil),             // 6: did.v1.QueryListDIDDocumentsRequest
-	(*QueryListDIDDocumentsResponse)(nil),            // 7: did.v1.QueryListDIDDocumentsResponse
-	(*QueryGetDIDDocumentsByControllerRequest)(nil),  // 8: did.v1.QueryGetDIDDocumentsByControllerRequest
-	(*QueryGetDIDDocumentsByControllerResponse)(nil), // 9: did.v1.QueryGetDIDDocumentsByControllerResponse
-	(*QueryGetVerificationMethodRequest)(nil),        // 10: did.v1.QueryGetVerificationMethodRequest
-	(*QueryGetVerificationMethodResponse)(nil),       // 11: did.v1.QueryGetVerificationMethodResponse
-	(*QueryGetServiceRequest)(nil),                   // 12: did.v1.QueryGetServiceRequest
-	(*QueryGetServiceResponse)(nil),                  // 13: did.v1.QueryGetServiceResponse
-	(*QueryGetVerifiableCredentialRequest)(nil),      // 14: did.v1.QueryGetVerifiableCredentialRequest
-	(*QueryGetVerifiableCredentialResponse)(nil),     // 15: did.v1.QueryGetVerifiableCredentialResponse
-	(*QueryListVerifiableCredentialsRequest)(nil),    // 16: did.v1.QueryListVerifiableCredentialsRequest
-	(*QueryListVerifiableCredentialsResponse)(nil),   // 17: did.v1.QueryListVerifiableCredentialsResponse
-	(*CredentialInfo)(nil),                           // 18: did.v1.CredentialInfo
-	(*QueryGetCredentialsByDIDRequest)(nil),          // 19: did.v1.QueryGetCredentialsByDIDRequest
-	(*QueryGetCredentialsByDIDResponse)(nil),         // 20: did.v1.QueryGetCredentialsByDIDResponse
-	(*QueryRegisterStartRequest)(nil),                // 21: did.v1.QueryRegisterStartRequest
-	(*QueryRegisterStartResponse)(nil),               // 22: did.v1.QueryRegisterStartResponse
-	(*QueryLoginStartRequest)(nil),                   // 23: did.v1.QueryLoginStartRequest
-	(*QueryLoginStartResponse)(nil),                  // 24: did.v1.QueryLoginStartResponse
-	nil,                                              // 25: did.v1.QueryRegisterStartResponse.UserEntry
-	(*Params)(nil),                                   // 26: did.v1.Params
-	(*DIDDocument)(nil),                              // 27: did.v1.DIDDocument
-	(*DIDDocumentMetadata)(nil),                      // 28: did.v1.DIDDocumentMetadata
-	(*v1beta1.PageRequest)(nil),                      // 29: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),                     // 30: cosmos.base.query.v1beta1.PageResponse
-	(*VerificationMethod)(nil),                       // 31: did.v1.VerificationMethod
-	(*Service)(nil),                                  // 32: did.v1.Service
-	(*VerifiableCredential)(nil),                     // 33: did.v1.VerifiableCredential
-	(*WebAuthnCredential)(nil),                       // 34: did.v1.WebAuthnCredential
+	(*QueryGetDIDDocumentVersionRequest)(nil),        // 6: did.v1.QueryGetDIDDocumentVersionRequest
+	(*QueryGetDIDDocumentVersionResponse)(nil),       // 7: did.v1.QueryGetDIDDocumentVersionResponse
+	(*QueryGetDIDDocumentHistoryRequest)(nil),        // 8: did.v1.QueryGetDIDDocumentHistoryRequest
+	(*QueryGetDIDDocumentHistoryResponse)(nil),       // 9: did.v1.QueryGetDIDDocumentHistoryResponse
+	(*QueryGetDelegatedControllersRequest)(nil),      // 10: did.v1.QueryGetDelegatedControllersRequest
+	(*QueryGetDelegatedControllersResponse)(nil),     // 11: did.v1.QueryGetDelegatedControllersResponse
+	(*QueryGetDIDAssetsRequest)(nil),                 // 12: did.v1.QueryGetDIDAssetsRequest
+	(*QueryGetDIDAssetsResponse)(nil),                // 13: did.v1.QueryGetDIDAssetsResponse
+	(*DIDAssetInfo)(nil),                             // 14: did.v1.DIDAssetInfo
+	(*QueryListDIDDocumentsRequest)(nil),             // 15: did.v1.QueryListDIDDocumentsRequest
+	(*QueryListDIDDocumentsResponse)(nil),            // 16: did.v1.QueryListDIDDocumentsResponse
+	(*QueryGetDIDDocumentsByControllerRequest)(nil),  // 17: did.v1.QueryGetDIDDocumentsByControllerRequest
+	(*QueryGetDIDDocumentsByControllerResponse)(nil), // 18: did.v1.QueryGetDIDDocumentsByControllerResponse
+	(*QueryGetVerificationMethodRequest)(nil),        // 19: did.v1.QueryGetVerificationMethodRequest
+	(*QueryGetVerificationMethodResponse)(nil),       // 20: did.v1.QueryGetVerificationMethodResponse
+	(*QueryGetServiceRequest)(nil),                   // 21: did.v1.QueryGetServiceRequest
+	(*QueryGetServiceResponse)(nil),                  // 22: did.v1.QueryGetServiceResponse
+	(*QueryGetVerifiableCredentialRequest)(nil),      // 23: did.v1.QueryGetVerifiableCredentialRequest
+	(*QueryGetVerifiableCredentialResponse)(nil),     // 24: did.v1.QueryGetVerifiableCredentialResponse
+	(*QueryVerifyCredentialRequest)(nil),             // 25: did.v1.QueryVerifyCredentialRequest
+	(*QueryVerifyCredentialResponse)(nil),            // 26: did.v1.QueryVerifyCredentialResponse
+	(*QueryGetStatusListRequest)(nil),                // 27: did.v1.QueryGetStatusListRequest
+	(*QueryGetStatusListResponse)(nil),               // 28: did.v1.QueryGetStatusListResponse
+	(*QueryListVerifiableCredentialsRequest)(nil),    // 29: did.v1.QueryListVerifiableCredentialsRequest
+	(*QueryListVerifiableCredentialsResponse)(nil),   // 30: did.v1.QueryListVerifiableCredentialsResponse
+	(*CredentialInfo)(nil),                           // 31: did.v1.CredentialInfo
+	(*QueryGetCredentialsByDIDRequest)(nil),          // 32: did.v1.QueryGetCredentialsByDIDRequest
+	(*QueryGetCredentialsByDIDResponse)(nil),         // 33: did.v1.QueryGetCredentialsByDIDResponse
+	(*QueryRegisterStartRequest)(nil),                // 34: did.v1.QueryRegisterStartRequest
+	(*QueryRegisterStartResponse)(nil),               // 35: did.v1.QueryRegisterStartResponse
+	(*QueryLoginStartRequest)(nil),                   // 36: did.v1.QueryLoginStartRequest
+	(*QueryLoginStartResponse)(nil),                  // 37: did.v1.QueryLoginStartResponse
+	(*QueryGetUnverifiedCredentialsRequest)(nil),     // 38: did.v1.QueryGetUnverifiedCredentialsRequest
+	(*QueryGetUnverifiedCredentialsResponse)(nil),    // 39: did.v1.QueryGetUnverifiedCredentialsResponse
+	nil,                          // 40: did.v1.QueryRegisterStartResponse.UserEntry
+	(*Params)(nil),               // 41: did.v1.Params
+	(*DIDDocument)(nil),          // 42: did.v1.DIDDocument
+	(*DIDDocumentMetadata)(nil),  // 43: did.v1.DIDDocumentMetadata
+	(*v1beta1.PageRequest)(nil),  // 44: cosmos.base.query.v1beta1.PageRequest
+	(*DIDDocumentVersion)(nil),   // 45: did.v1.DIDDocumentVersion
+	(*v1beta1.PageResponse)(nil), // 46: cosmos.base.query.v1beta1.PageResponse
+	(*DelegatedController)(nil),  // 47: did.v1.DelegatedController
+	(*DIDAsset)(nil),             // 48: did.v1.DIDAsset
+	(*VerificationMethod)(nil),   // 49: did.v1.VerificationMethod
+	(*Service)(nil),              // 50: did.v1.Service
+	(*VerifiableCredential)(nil), // 51: did.v1.VerifiableCredential
+	(*StatusList)(nil),           // 52: did.v1.StatusList
+	(*WebAuthnCredential)(nil),   // 53: did.v1.WebAuthnCredential
+	(*UnverifiedCredential)(nil), // 54: did.v1.UnverifiedCredential
 }
 var file_did_v1_query_proto_depIdxs = []int32{
-	26, // 0: did.v1.QueryParamsResponse.params:type_name -> did.v1.Params
-	27, // 1: did.v1.QueryResolveDIDResponse.did_document:type_name -> did.v1.DIDDocument
-	28, // 2: did.v1.QueryResolveDIDResponse.did_document_metadata:type_name -> did.v1.DIDDocumentMetadata
-	27, // 3: did.v1.QueryGetDIDDocumentResponse.did_document:type_name -> did.v1.DIDDocument
-	28, // 4: did.v1.QueryGetDIDDocumentResponse.did_document_metadata:type_name -> did.v1.DIDDocumentMetadata
-	29, // 5: did.v1.QueryListDIDDocumentsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 6: did.v1.QueryListDIDDocumentsResponse.did_documents:type_name -> did.v1.DIDDocument
-	30, // 7: did.v1.QueryListDIDDocumentsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	29, // 8: did.v1.QueryGetDIDDocumentsByControllerRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 9: did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents:type_name -> did.v1.DIDDocument
-	30, // 10: did.v1.QueryGetDIDDocumentsByControllerResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	31, // 11: did.v1.QueryGetVerificationMethodResponse.verification_method:type_name -> did.v1.VerificationMethod
-	32, // 12: did.v1.QueryGetServiceResponse.service:type_name -> did.v1.Service
-	33, // 13: did.v1.QueryGetVerifiableCredentialResponse.credential:type_name -> did.v1.VerifiableCredential
-	29, // 14: did.v1.QueryListVerifiableCredentialsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	33, // 15: did.v1.QueryListVerifiableCredentialsResponse.credentials:type_name -> did.v1.VerifiableCredential
-	30, // 16: did.v1.QueryListVerifiableCredentialsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	33, // 17: did.v1.CredentialInfo.verifiable_credential:type_name -> did.v1.VerifiableCredential
-	34, // 18: did.v1.CredentialInfo.webauthn_credential:type_name -> did.v1.WebAuthnCredential
-	29, // 19: did.v1.QueryGetCredentialsByDIDRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 20: did.v1.QueryGetCredentialsByDIDResponse.credentials:type_name -> did.v1.CredentialInfo
-	30, // 21: did.v1.QueryGetCredentialsByDIDResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	25, // 22: did.v1.QueryRegisterStartResponse.user:type_name -> did.v1.QueryRegisterStartResponse.UserEntry
-	0,  // 23: did.v1.Query.Params:input_type -> did.v1.QueryParamsRequest
-	2,  // 24: did.v1.Query.ResolveDID:input_type -> did.v1.QueryResolveDIDRequest
-	4,  // 25: did.v1.Query.GetDIDDocument:input_type -> did.v1.QueryGetDIDDocumentRequest
-	6,  // 26: did.v1.Query.ListDIDDocuments:input_type -> did.v1.QueryListDIDDocumentsRequest
-	8,  // 27: did.v1.Query.GetDIDDocumentsByController:input_type -> did.v1.QueryGetDIDDocumentsByControllerRequest
-	10, // 28: did.v1.Query.GetVerificationMethod:input_type -> did.v1.QueryGetVerificationMethodRequest
-	12, // 29: did.v1.Query.GetService:input_type -> did.v1.QueryGetServiceRequest
-	14, // 30: did.v1.Query.GetVerifiableCredential:input_type -> did.v1.QueryGetVerifiableCredentialRequest
-	16, // 31: did.v1.Query.ListVerifiableCredentials:input_type -> did.v1.QueryListVerifiableCredentialsRequest
-	19, // 32: did.v1.Query.GetCredentialsByDID:input_type -> did.v1.QueryGetCredentialsByDIDRequest
-	21, // 33: did.v1.Query.RegisterStart:input_type -> did.v1.QueryRegisterStartRequest
-	23, // 34: did.v1.Query.LoginStart:input_type -> did.v1.QueryLoginStartRequest
-	1,  // 35: did.v1.Query.Params:output_type -> did.v1.QueryParamsResponse
-	3,  // 36: did.v1.Query.ResolveDID:output_type -> did.v1.QueryResolveDIDResponse
-	5,  // 37: did.v1.Query.GetDIDDocument:output_type -> did.v1.QueryGetDIDDocumentResponse
-	7,  // 38: did.v1.Query.ListDIDDocuments:output_type -> did.v1.QueryListDIDDocumentsResponse
-	9,  // 39: did.v1.Query.GetDIDDocumentsByController:output_type -> did.v1.QueryGetDIDDocumentsByControllerResponse
-	11, // 40: did.v1.Query.GetVerificationMethod:output_type -> did.v1.QueryGetVerificationMethodResponse
-	13, // 41: did.v1.Query.GetService:output_type -> did.v1.QueryGetServiceResponse
-	15, // 42: did.v1.Query.GetVerifiableCredential:output_type -> did.v1.QueryGetVerifiableCredentialResponse
-	17, // 43: did.v1.Query.ListVerifiableCredentials:output_type -> did.v1.QueryListVerifiableCredentialsResponse
-	20, // 44: did.v1.Query.GetCredentialsByDID:output_type -> did.v1.QueryGetCredentialsByDIDResponse
-	22, // 45: did.v1.Query.RegisterStart:output_type -> did.v1.QueryRegisterStartResponse
-	24, // 46: did.v1.Query.LoginStart:output_type -> did.v1.QueryLoginStartResponse
-	35, // [35:47] is the sub-list for method output_type
-	23, // [23:35] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	41, // 0: did.v1.QueryParamsResponse.params:type_name -> did.v1.Params
+	42, // 1: did.v1.QueryResolveDIDResponse.did_document:type_name -> did.v1.DIDDocument
+	43, // 2: did.v1.QueryResolveDIDResponse.did_document_metadata:type_name -> did.v1.DIDDocumentMetadata
+	42, // 3: did.v1.QueryGetDIDDocumentResponse.did_document:type_name -> did.v1.DIDDocument
+	43, // 4: did.v1.QueryGetDIDDocumentResponse.did_document_metadata:type_name -> did.v1.DIDDocumentMetadata
+	42, // 5: did.v1.QueryGetDIDDocumentVersionResponse.did_document:type_name -> did.v1.DIDDocument
+	43, // 6: did.v1.QueryGetDIDDocumentVersionResponse.did_document_metadata:type_name -> did.v1.DIDDocumentMetadata
+	44, // 7: did.v1.QueryGetDIDDocumentHistoryRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	45, // 8: did.v1.QueryGetDIDDocumentHistoryResponse.versions:type_name -> did.v1.DIDDocumentVersion
+	46, // 9: did.v1.QueryGetDIDDocumentHistoryResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	47, // 10: did.v1.QueryGetDelegatedControllersResponse.delegations:type_name -> did.v1.DelegatedController
+	14, // 11: did.v1.QueryGetDIDAssetsResponse.assets:type_name -> did.v1.DIDAssetInfo
+	48, // 12: did.v1.DIDAssetInfo.asset:type_name -> did.v1.DIDAsset
+	44, // 13: did.v1.QueryListDIDDocumentsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 14: did.v1.QueryListDIDDocumentsResponse.did_documents:type_name -> did.v1.DIDDocument
+	46, // 15: did.v1.QueryListDIDDocumentsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 16: did.v1.QueryGetDIDDocumentsByControllerRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 17: did.v1.QueryGetDIDDocumentsByControllerResponse.did_documents:type_name -> did.v1.DIDDocument
+	46, // 18: did.v1.QueryGetDIDDocumentsByControllerResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	49, // 19: did.v1.QueryGetVerificationMethodResponse.verification_method:type_name -> did.v1.VerificationMethod
+	50, // 20: did.v1.QueryGetServiceResponse.service:type_name -> did.v1.Service
+	51, // 21: did.v1.QueryGetVerifiableCredentialResponse.credential:type_name -> did.v1.VerifiableCredential
+	52, // 22: did.v1.QueryGetStatusListResponse.status_list:type_name -> did.v1.StatusList
+	44, // 23: did.v1.QueryListVerifiableCredentialsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	51, // 24: did.v1.QueryListVerifiableCredentialsResponse.credentials:type_name -> did.v1.VerifiableCredential
+	46, // 25: did.v1.QueryListVerifiableCredentialsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	51, // 26: did.v1.CredentialInfo.verifiable_credential:type_name -> did.v1.VerifiableCredential
+	53, // 27: did.v1.CredentialInfo.webauthn_credential:type_name -> did.v1.WebAuthnCredential
+	44, // 28: did.v1.QueryGetCredentialsByDIDRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	31, // 29: did.v1.QueryGetCredentialsByDIDResponse.credentials:type_name -> did.v1.CredentialInfo
+	46, // 30: did.v1.QueryGetCredentialsByDIDResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	40, // 31: did.v1.QueryRegisterStartResponse.user:type_name -> did.v1.QueryRegisterStartResponse.UserEntry
+	54, // 32: did.v1.QueryGetUnverifiedCredentialsResponse.credentials:type_name -> did.v1.UnverifiedCredential
+	0,  // 33: did.v1.Query.Params:input_type -> did.v1.QueryParamsRequest
+	2,  // 34: did.v1.Query.ResolveDID:input_type -> did.v1.QueryResolveDIDRequest
+	4,  // 35: did.v1.Query.GetDIDDocument:input_type -> did.v1.QueryGetDIDDocumentRequest
+	6,  // 36: did.v1.Query.GetDIDDocumentVersion:input_type -> did.v1.QueryGetDIDDocumentVersionRequest
+	8,  // 37: did.v1.Query.GetDIDDocumentHistory:input_type -> did.v1.QueryGetDIDDocumentHistoryRequest
+	10, // 38: did.v1.Query.GetDelegatedControllers:input_type -> did.v1.QueryGetDelegatedControllersRequest
+	12, // 39: did.v1.Query.GetDIDAssets:input_type -> did.v1.QueryGetDIDAssetsRequest
+	15, // 40: did.v1.Query.ListDIDDocuments:input_type -> did.v1.QueryListDIDDocumentsRequest
+	17, // 41: did.v1.Query.GetDIDDocumentsByController:input_type -> did.v1.QueryGetDIDDocumentsByControllerRequest
+	19, // 42: did.v1.Query.GetVerificationMethod:input_type -> did.v1.QueryGetVerificationMethodRequest
+	21, // 43: did.v1.Query.GetService:input_type -> did.v1.QueryGetServiceRequest
+	23, // 44: did.v1.Query.GetVerifiableCredential:input_type -> did.v1.QueryGetVerifiableCredentialRequest
+	29, // 45: did.v1.Query.ListVerifiableCredentials:input_type -> did.v1.QueryListVerifiableCredentialsRequest
+	25, // 46: did.v1.Query.VerifyCredential:input_type -> did.v1.QueryVerifyCredentialRequest
+	27, // 47: did.v1.Query.GetStatusList:input_type -> did.v1.QueryGetStatusListRequest
+	32, // 48: did.v1.Query.GetCredentialsByDID:input_type -> did.v1.QueryGetCredentialsByDIDRequest
+	38, // 49: did.v1.Query.GetUnverifiedCredentials:input_type -> did.v1.QueryGetUnverifiedCredentialsRequest
+	34, // 50: did.v1.Query.RegisterStart:input_type -> did.v1.QueryRegisterStartRequest
+	36, // 51: did.v1.Query.LoginStart:input_type -> did.v1.QueryLoginStartRequest
+	1,  // 52: did.v1.Query.Params:output_type -> did.v1.QueryParamsResponse
+	3,  // 53: did.v1.Query.ResolveDID:output_type -> did.v1.QueryResolveDIDResponse
+	5,  // 54: did.v1.Query.GetDIDDocument:output_type -> did.v1.QueryGetDIDDocumentResponse
+	7,  // 55: did.v1.Query.GetDIDDocumentVersion:output_type -> did.v1.QueryGetDIDDocumentVersionResponse
+	9,  // 56: did.v1.Query.GetDIDDocumentHistory:output_type -> did.v1.QueryGetDIDDocumentHistoryResponse
+	11, // 57: did.v1.Query.GetDelegatedControllers:output_type -> did.v1.QueryGetDelegatedControllersResponse
+	13, // 58: did.v1.Query.GetDIDAssets:output_type -> did.v1.QueryGetDIDAssetsResponse
+	16, // 59: did.v1.Query.ListDIDDocuments:output_type -> did.v1.QueryListDIDDocumentsResponse
+	18, // 60: did.v1.Query.GetDIDDocumentsByController:output_type -> did.v1.QueryGetDIDDocumentsByControllerResponse
+	20, // 61: did.v1.Query.GetVerificationMethod:output_type -> did.v1.QueryGetVerificationMethodResponse
+	22, // 62: did.v1.Query.GetService:output_type -> did.v1.QueryGetServiceResponse
+	24, // 63: did.v1.Query.GetVerifiableCredential:output_type -> did.v1.QueryGetVerifiableCredentialResponse
+	30, // 64: did.v1.Query.ListVerifiableCredentials:output_type -> did.v1.QueryListVerifiableCredentialsResponse
+	26, // 65: did.v1.Query.VerifyCredential:output_type -> did.v1.QueryVerifyCredentialResponse
+	28, // 66: did.v1.Query.GetStatusList:output_type -> did.v1.QueryGetStatusListResponse
+	33, // 67: did.v1.Query.GetCredentialsByDID:output_type -> did.v1.QueryGetCredentialsByDIDResponse
+	39, // 68: did.v1.Query.GetUnverifiedCredentials:output_type -> did.v1.QueryGetUnverifiedCredentialsResponse
+	35, // 69: did.v1.Query.RegisterStart:output_type -> did.v1.QueryRegisterStartResponse
+	37, // 70: did.v1.Query.LoginStart:output_type -> did.v1.QueryLoginStartResponse
+	52, // [52:71] is the sub-list for method output_type
+	33, // [33:52] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_did_v1_query_proto_init() }
@@ -14504,7 +22972,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryListDIDDocumentsRequest); i {
+			switch v := v.(*QueryGetDIDDocumentVersionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14516,7 +22984,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryListDIDDocumentsResponse); i {
+			switch v := v.(*QueryGetDIDDocumentVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14528,7 +22996,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetDIDDocumentsByControllerRequest); i {
+			switch v := v.(*QueryGetDIDDocumentHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14540,7 +23008,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetDIDDocumentsByControllerResponse); i {
+			switch v := v.(*QueryGetDIDDocumentHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14552,7 +23020,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerificationMethodRequest); i {
+			switch v := v.(*QueryGetDelegatedControllersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14564,7 +23032,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerificationMethodResponse); i {
+			switch v := v.(*QueryGetDelegatedControllersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14576,7 +23044,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetServiceRequest); i {
+			switch v := v.(*QueryGetDIDAssetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14588,7 +23056,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetServiceResponse); i {
+			switch v := v.(*QueryGetDIDAssetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14600,7 +23068,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerifiableCredentialRequest); i {
+			switch v := v.(*DIDAssetInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14612,7 +23080,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerifiableCredentialResponse); i {
+			switch v := v.(*QueryListDIDDocumentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14624,7 +23092,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryListVerifiableCredentialsRequest); i {
+			switch v := v.(*QueryListDIDDocumentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14636,7 +23104,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryListVerifiableCredentialsResponse); i {
+			switch v := v.(*QueryGetDIDDocumentsByControllerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14648,7 +23116,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CredentialInfo); i {
+			switch v := v.(*QueryGetDIDDocumentsByControllerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14660,7 +23128,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetCredentialsByDIDRequest); i {
+			switch v := v.(*QueryGetVerificationMethodRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14672,7 +23140,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetCredentialsByDIDResponse); i {
+			switch v := v.(*QueryGetVerificationMethodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14684,7 +23152,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRegisterStartRequest); i {
+			switch v := v.(*QueryGetServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14696,7 +23164,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRegisterStartResponse); i {
+			switch v := v.(*QueryGetServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14708,7 +23176,7 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLoginStartRequest); i {
+			switch v := v.(*QueryGetVerifiableCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14720,6 +23188,162 @@ func file_did_v1_query_proto_init() {
 			}
 		}
 		file_did_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetVerifiableCredentialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVerifyCredentialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVerifyCredentialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetStatusListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetStatusListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryListVerifiableCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryListVerifiableCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetCredentialsByDIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetCredentialsByDIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRegisterStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRegisterStartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLoginStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryLoginStartResponse); i {
 			case 0:
 				return &v.state
@@ -14731,8 +23355,32 @@ func file_did_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_did_v1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetUnverifiedCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetUnverifiedCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_did_v1_query_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_did_v1_query_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*CredentialInfo_VerifiableCredential)(nil),
 		(*CredentialInfo_WebauthnCredential)(nil),
 	}
@@ -14742,7 +23390,7 @@ func file_did_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_did_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,13 +22,20 @@ const (
 	Query_Params_FullMethodName                      = "/did.v1.Query/Params"
 	Query_ResolveDID_FullMethodName                  = "/did.v1.Query/ResolveDID"
 	Query_GetDIDDocument_FullMethodName              = "/did.v1.Query/GetDIDDocument"
+	Query_GetDIDDocumentVersion_FullMethodName       = "/did.v1.Query/GetDIDDocumentVersion"
+	Query_GetDIDDocumentHistory_FullMethodName       = "/did.v1.Query/GetDIDDocumentHistory"
+	Query_GetDelegatedControllers_FullMethodName     = "/did.v1.Query/GetDelegatedControllers"
+	Query_GetDIDAssets_FullMethodName                = "/did.v1.Query/GetDIDAssets"
 	Query_ListDIDDocuments_FullMethodName            = "/did.v1.Query/ListDIDDocuments"
 	Query_GetDIDDocumentsByController_FullMethodName = "/did.v1.Query/GetDIDDocumentsByController"
 	Query_GetVerificationMethod_FullMethodName       = "/did.v1.Query/GetVerificationMethod"
 	Query_GetService_FullMethodName                  = "/did.v1.Query/GetService"
 	Query_GetVerifiableCredential_FullMethodName     = "/did.v1.Query/GetVerifiableCredential"
 	Query_ListVerifiableCredentials_FullMethodName   = "/did.v1.Query/ListVerifiableCredentials"
+	Query_VerifyCredential_FullMethodName            = "/did.v1.Query/VerifyCredential"
+	Query_GetStatusList_FullMethodName               = "/did.v1.Query/GetStatusList"
 	Query_GetCredentialsByDID_FullMethodName         = "/did.v1.Query/GetCredentialsByDID"
+	Query_GetUnverifiedCredentials_FullMethodName    = "/did.v1.Query/GetUnverifiedCredentials"
 	Query_RegisterStart_FullMethodName               = "/did.v1.Query/RegisterStart"
 	Query_LoginStart_FullMethodName                  = "/did.v1.Query/LoginStart"
 )
@@ -48,6 +55,17 @@ type QueryClient interface {
 	ResolveDID(ctx context.Context, in *QueryResolveDIDRequest, opts ...grpc.CallOption) (*QueryResolveDIDResponse, error)
 	// GetDIDDocument retrieves a DID document by its ID
 	GetDIDDocument(ctx context.Context, in *QueryGetDIDDocumentRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentResponse, error)
+	// GetDIDDocumentVersion resolves a DID document at an earlier version,
+	// selected by version_id or version_time as in DID resolution
+	GetDIDDocumentVersion(ctx context.Context, in *QueryGetDIDDocumentVersionRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentVersionResponse, error)
+	// GetDIDDocumentHistory lists every version of a DID document, oldest first
+	GetDIDDocumentHistory(ctx context.Context, in *QueryGetDIDDocumentHistoryRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentHistoryResponse, error)
+	// GetDelegatedControllers lists the delegations of a DID that have not
+	// expired
+	GetDelegatedControllers(ctx context.Context, in *QueryGetDelegatedControllersRequest, opts ...grpc.CallOption) (*QueryGetDelegatedControllersResponse, error)
+	// GetDIDAssets lists the tokens a DID shows on its profile and whether its
+	// controllers still own them
+	GetDIDAssets(ctx context.Context, in *QueryGetDIDAssetsRequest, opts ...grpc.CallOption) (*QueryGetDIDAssetsResponse, error)
 	// ListDIDDocuments lists all DID documents with pagination
 	ListDIDDocuments(ctx context.Context, in *QueryListDIDDocumentsRequest, opts ...grpc.CallOption) (*QueryListDIDDocumentsResponse, error)
 	// GetDIDDocumentsByController retrieves DID documents by controller
@@ -60,8 +78,17 @@ type QueryClient interface {
 	GetVerifiableCredential(ctx context.Context, in *QueryGetVerifiableCredentialRequest, opts ...grpc.CallOption) (*QueryGetVerifiableCredentialResponse, error)
 	// ListVerifiableCredentials lists all verifiable credentials with filtering options
 	ListVerifiableCredentials(ctx context.Context, in *QueryListVerifiableCredentialsRequest, opts ...grpc.CallOption) (*QueryListVerifiableCredentialsResponse, error)
+	// VerifyCredential checks the proof, revocation status and expiry of a
+	// verifiable credential
+	VerifyCredential(ctx context.Context, in *QueryVerifyCredentialRequest, opts ...grpc.CallOption) (*QueryVerifyCredentialResponse, error)
+	// GetStatusList returns a StatusList2021 revocation list of an issuer as a
+	// StatusList2021Credential
+	GetStatusList(ctx context.Context, in *QueryGetStatusListRequest, opts ...grpc.CallOption) (*QueryGetStatusListResponse, error)
 	// GetCredentialsByDID retrieves all credentials (verifiable and WebAuthn) associated with a DID
 	GetCredentialsByDID(ctx context.Context, in *QueryGetCredentialsByDIDRequest, opts ...grpc.CallOption) (*QueryGetCredentialsByDIDResponse, error)
+	// GetUnverifiedCredentials lists the WebAuthn credentials of a DID whose
+	// origin is no longer verified
+	GetUnverifiedCredentials(ctx context.Context, in *QueryGetUnverifiedCredentialsRequest, opts ...grpc.CallOption) (*QueryGetUnverifiedCredentialsResponse, error)
 	// RegisterStart represents the start of the registration process
 	RegisterStart(ctx context.Context, in *QueryRegisterStartRequest, opts ...grpc.CallOption) (*QueryRegisterStartResponse, error)
 	// LoginStart represents the start of the login process
@@ -103,6 +130,42 @@ func (c *queryClient) GetDIDDocument(ctx context.Context, in *QueryGetDIDDocumen
 	return out, nil
 }
 
+func (c *queryClient) GetDIDDocumentVersion(ctx context.Context, in *QueryGetDIDDocumentVersionRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentVersionResponse, error) {
+	out := new(QueryGetDIDDocumentVersionResponse)
+	err := c.cc.Invoke(ctx, Query_GetDIDDocumentVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDIDDocumentHistory(ctx context.Context, in *QueryGetDIDDocumentHistoryRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentHistoryResponse, error) {
+	out := new(QueryGetDIDDocumentHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetDIDDocumentHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDelegatedControllers(ctx context.Context, in *QueryGetDelegatedControllersRequest, opts ...grpc.CallOption) (*QueryGetDelegatedControllersResponse, error) {
+	out := new(QueryGetDelegatedControllersResponse)
+	err := c.cc.Invoke(ctx, Query_GetDelegatedControllers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDIDAssets(ctx context.Context, in *QueryGetDIDAssetsRequest, opts ...grpc.CallOption) (*QueryGetDIDAssetsResponse, error) {
+	out := new(QueryGetDIDAssetsResponse)
+	err := c.cc.Invoke(ctx, Query_GetDIDAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListDIDDocuments(ctx context.Context, in *QueryListDIDDocumentsRequest, opts ...grpc.CallOption) (*QueryListDIDDocumentsResponse, error) {
 	out := new(QueryListDIDDocumentsResponse)
 	err := c.cc.Invoke(ctx, Query_ListDIDDocuments_FullMethodName, in, out, opts...)
@@ -157,6 +220,24 @@ func (c *queryClient) ListVerifiableCredentials(ctx context.Context, in *QueryLi
 	return out, nil
 }
 
+func (c *queryClient) VerifyCredential(ctx context.Context, in *QueryVerifyCredentialRequest, opts ...grpc.CallOption) (*QueryVerifyCredentialResponse, error) {
+	out := new(QueryVerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStatusList(ctx context.Context, in *QueryGetStatusListRequest, opts ...grpc.CallOption) (*QueryGetStatusListResponse, error) {
+	out := new(QueryGetStatusListResponse)
+	err := c.cc.Invoke(ctx, Query_GetStatusList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetCredentialsByDID(ctx context.Context, in *QueryGetCredentialsByDIDRequest, opts ...grpc.CallOption) (*QueryGetCredentialsByDIDResponse, error) {
 	out := new(QueryGetCredentialsByDIDResponse)
 	err := c.cc.Invoke(ctx, Query_GetCredentialsByDID_FullMethodName, in, out, opts...)
@@ -166,6 +247,15 @@ func (c *queryClient) GetCredentialsByDID(ctx context.Context, in *QueryGetCrede
 	return out, nil
 }
 
+func (c *queryClient) GetUnverifiedCredentials(ctx context.Context, in *QueryGetUnverifiedCredentialsRequest, opts ...grpc.CallOption) (*QueryGetUnverifiedCredentialsResponse, error) {
+	out := new(QueryGetUnverifiedCredentialsResponse)
+	err := c.cc.Invoke(ctx, Query_GetUnverifiedCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RegisterStart(ctx context.Context, in *QueryRegisterStartRequest, opts ...grpc.CallOption) (*QueryRegisterStartResponse, error) {
 	out := new(QueryRegisterStartResponse)
 	err := c.cc.Invoke(ctx, Query_RegisterStart_FullMethodName, in, out, opts...)
@@ -199,6 +289,17 @@ type QueryServer interface {
 	ResolveDID(context.Context, *QueryResolveDIDRequest) (*QueryResolveDIDResponse, error)
 	// GetDIDDocument retrieves a DID document by its ID
 	GetDIDDocument(context.Context, *QueryGetDIDDocumentRequest) (*QueryGetDIDDocumentResponse, error)
+	// GetDIDDocumentVersion resolves a DID document at an earlier version,
+	// selected by version_id or version_time as in DID resolution
+	GetDIDDocumentVersion(context.Context, *QueryGetDIDDocumentVersionRequest) (*QueryGetDIDDocumentVersionResponse, error)
+	// GetDIDDocumentHistory lists every version of a DID document, oldest first
+	GetDIDDocumentHistory(context.Context, *QueryGetDIDDocumentHistoryRequest) (*QueryGetDIDDocumentHistoryResponse, error)
+	// GetDelegatedControllers lists the delegations of a DID that have not
+	// expired
+	GetDelegatedControllers(context.Context, *QueryGetDelegatedControllersRequest) (*QueryGetDelegatedControllersResponse, error)
+	// GetDIDAssets lists the tokens a DID shows on its profile and whether its
+	// controllers still own them
+	GetDIDAssets(context.Context, *QueryGetDIDAssetsRequest) (*QueryGetDIDAssetsResponse, error)
 	// ListDIDDocuments lists all DID documents with pagination
 	ListDIDDocuments(context.Context, *QueryListDIDDocumentsRequest) (*QueryListDIDDocumentsResponse, error)
 	// GetDIDDocumentsByController retrieves DID documents by controller
@@ -211,8 +312,17 @@ type QueryServer interface {
 	GetVerifiableCredential(context.Context, *QueryGetVerifiableCredentialRequest) (*QueryGetVerifiableCredentialResponse, error)
 	// ListVerifiableCredentials lists all verifiable credentials with filtering options
 	ListVerifiableCredentials(context.Context, *QueryListVerifiableCredentialsRequest) (*QueryListVerifiableCredentialsResponse, error)
+	// VerifyCredential checks the proof, revocation status and expiry of a
+	// verifiable credential
+	VerifyCredential(context.Context, *QueryVerifyCredentialRequest) (*QueryVerifyCredentialResponse, error)
+	// GetStatusList returns a StatusList2021 revocation list of an issuer as a
+	// StatusList2021Credential
+	GetStatusList(context.Context, *QueryGetStatusListRequest) (*QueryGetStatusListResponse, error)
 	// GetCredentialsByDID retrieves all credentials (verifiable and WebAuthn) associated with a DID
 	GetCredentialsByDID(context.Context, *QueryGetCredentialsByDIDRequest) (*QueryGetCredentialsByDIDResponse, error)
+	// GetUnverifiedCredentials lists the WebAuthn credentials of a DID whose
+	// origin is no longer verified
+	GetUnverifiedCredentials(context.Context, *QueryGetUnverifiedCredentialsRequest) (*QueryGetUnverifiedCredentialsResponse, error)
 	// RegisterStart represents the start of the registration process
 	RegisterStart(context.Context, *QueryRegisterStartRequest) (*QueryRegisterStartResponse, error)
 	// LoginStart represents the start of the login process
@@ -233,6 +343,18 @@ func (UnimplementedQueryServer) ResolveDID(context.Context, *QueryResolveDIDRequ
 func (UnimplementedQueryServer) GetDIDDocument(context.Context, *QueryGetDIDDocumentRequest) (*QueryGetDIDDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocument not implemented")
 }
+func (UnimplementedQueryServer) GetDIDDocumentVersion(context.Context, *QueryGetDIDDocumentVersionRequest) (*QueryGetDIDDocumentVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocumentVersion not implemented")
+}
+func (UnimplementedQueryServer) GetDIDDocumentHistory(context.Context, *QueryGetDIDDocumentHistoryRequest) (*QueryGetDIDDocumentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocumentHistory not implemented")
+}
+func (UnimplementedQueryServer) GetDelegatedControllers(context.Context, *QueryGetDelegatedControllersRequest) (*QueryGetDelegatedControllersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatedControllers not implemented")
+}
+func (UnimplementedQueryServer) GetDIDAssets(context.Context, *QueryGetDIDAssetsRequest) (*QueryGetDIDAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDAssets not implemented")
+}
 func (UnimplementedQueryServer) ListDIDDocuments(context.Context, *QueryListDIDDocumentsRequest) (*QueryListDIDDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDIDDocuments not implemented")
 }
@@ -251,9 +373,18 @@ func (UnimplementedQueryServer) GetVerifiableCredential(context.Context, *QueryG
 func (UnimplementedQueryServer) ListVerifiableCredentials(context.Context, *QueryListVerifiableCredentialsRequest) (*QueryListVerifiableCredentialsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVerifiableCredentials not implemented")
 }
+func (UnimplementedQueryServer) VerifyCredential(context.Context, *QueryVerifyCredentialRequest) (*QueryVerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (UnimplementedQueryServer) GetStatusList(context.Context, *QueryGetStatusListRequest) (*QueryGetStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusList not implemented")
+}
 func (UnimplementedQueryServer) GetCredentialsByDID(context.Context, *QueryGetCredentialsByDIDRequest) (*QueryGetCredentialsByDIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCredentialsByDID not implemented")
 }
+func (UnimplementedQueryServer) GetUnverifiedCredentials(context.Context, *QueryGetUnverifiedCredentialsRequest) (*QueryGetUnverifiedCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnverifiedCredentials not implemented")
+}
 func (UnimplementedQueryServer) RegisterStart(context.Context, *QueryRegisterStartRequest) (*QueryRegisterStartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterStart not implemented")
 }
@@ -327,6 +458,78 @@ func _Query_GetDIDDocument_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetDIDDocumentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDDocumentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDDocumentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDIDDocumentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDDocumentVersion(ctx, req.(*QueryGetDIDDocumentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDIDDocumentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDDocumentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDDocumentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDIDDocumentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDDocumentHistory(ctx, req.(*QueryGetDIDDocumentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegatedControllers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatedControllersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatedControllers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDelegatedControllers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatedControllers(ctx, req.(*QueryGetDelegatedControllersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDIDAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDIDAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDAssets(ctx, req.(*QueryGetDIDAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListDIDDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryListDIDDocumentsRequest)
 	if err := dec(in); err != nil {
@@ -435,6 +638,42 @@ func _Query_ListVerifiableCredentials_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCredential(ctx, req.(*QueryVerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetStatusList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStatusList(ctx, req.(*QueryGetStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetCredentialsByDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetCredentialsByDIDRequest)
 	if err := dec(in); err != nil {
@@ -453,6 +692,24 @@ func _Query_GetCredentialsByDID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetUnverifiedCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUnverifiedCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUnverifiedCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetUnverifiedCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUnverifiedCredentials(ctx, req.(*QueryGetUnverifiedCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RegisterStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRegisterStartRequest)
 	if err := dec(in); err != nil {
@@ -508,6 +765,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDIDDocument",
 			Handler:    _Query_GetDIDDocument_Handler,
 		},
+		{
+			MethodName: "GetDIDDocumentVersion",
+			Handler:    _Query_GetDIDDocumentVersion_Handler,
+		},
+		{
+			MethodName: "GetDIDDocumentHistory",
+			Handler:    _Query_GetDIDDocumentHistory_Handler,
+		},
+		{
+			MethodName: "GetDelegatedControllers",
+			Handler:    _Query_GetDelegatedControllers_Handler,
+		},
+		{
+			MethodName: "GetDIDAssets",
+			Handler:    _Query_GetDIDAssets_Handler,
+		},
 		{
 			MethodName: "ListDIDDocuments",
 			Handler:    _Query_ListDIDDocuments_Handler,
@@ -532,10 +805,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVerifiableCredentials",
 			Handler:    _Query_ListVerifiableCredentials_Handler,
 		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _Query_VerifyCredential_Handler,
+		},
+		{
+			MethodName: "GetStatusList",
+			Handler:    _Query_GetStatusList_Handler,
+		},
 		{
 			MethodName: "GetCredentialsByDID",
 			Handler:    _Query_GetCredentialsByDID_Handler,
 		},
+		{
+			MethodName: "GetUnverifiedCredentials",
+			Handler:    _Query_GetUnverifiedCredentials_Handler,
+		},
 		{
 			MethodName: "RegisterStart",
 			Handler:    _Query_RegisterStart_Handler,
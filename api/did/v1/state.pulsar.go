@@ -5462,6 +5462,7 @@ var (
 	fd_DIDDocumentMetadata_next_version_id protoreflect.FieldDescriptor
 	fd_DIDDocumentMetadata_equivalent_id   protoreflect.FieldDescriptor
 	fd_DIDDocumentMetadata_canonical_id    protoreflect.FieldDescriptor
+	fd_DIDDocumentMetadata_status          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5476,6 +5477,7 @@ func init() {
 	fd_DIDDocumentMetadata_next_version_id = md_DIDDocumentMetadata.Fields().ByName("next_version_id")
 	fd_DIDDocumentMetadata_equivalent_id = md_DIDDocumentMetadata.Fields().ByName("equivalent_id")
 	fd_DIDDocumentMetadata_canonical_id = md_DIDDocumentMetadata.Fields().ByName("canonical_id")
+	fd_DIDDocumentMetadata_status = md_DIDDocumentMetadata.Fields().ByName("status")
 }
 
 var _ protoreflect.Message = (*fastReflection_DIDDocumentMetadata)(nil)
@@ -5597,6 +5599,12 @@ func (x *fastReflection_DIDDocumentMetadata) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_DIDDocumentMetadata_status, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5630,6 +5638,8 @@ func (x *fastReflection_DIDDocumentMetadata) Has(fd protoreflect.FieldDescriptor
 		return len(x.EquivalentId) != 0
 	case "did.v1.DIDDocumentMetadata.canonical_id":
 		return x.CanonicalId != ""
+	case "did.v1.DIDDocumentMetadata.status":
+		return x.Status != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentMetadata"))
@@ -5664,6 +5674,8 @@ func (x *fastReflection_DIDDocumentMetadata) Clear(fd protoreflect.FieldDescript
 		x.EquivalentId = nil
 	case "did.v1.DIDDocumentMetadata.canonical_id":
 		x.CanonicalId = ""
+	case "did.v1.DIDDocumentMetadata.status":
+		x.Status = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentMetadata"))
@@ -5710,6 +5722,9 @@ func (x *fastReflection_DIDDocumentMetadata) Get(descriptor protoreflect.FieldDe
 	case "did.v1.DIDDocumentMetadata.canonical_id":
 		value := x.CanonicalId
 		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDDocumentMetadata.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentMetadata"))
@@ -5750,6 +5765,8 @@ func (x *fastReflection_DIDDocumentMetadata) Set(fd protoreflect.FieldDescriptor
 		x.EquivalentId = *clv.list
 	case "did.v1.DIDDocumentMetadata.canonical_id":
 		x.CanonicalId = value.Interface().(string)
+	case "did.v1.DIDDocumentMetadata.status":
+		x.Status = (DIDStatus)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentMetadata"))
@@ -5792,6 +5809,8 @@ func (x *fastReflection_DIDDocumentMetadata) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field next_version_id of message did.v1.DIDDocumentMetadata is not mutable"))
 	case "did.v1.DIDDocumentMetadata.canonical_id":
 		panic(fmt.Errorf("field canonical_id of message did.v1.DIDDocumentMetadata is not mutable"))
+	case "did.v1.DIDDocumentMetadata.status":
+		panic(fmt.Errorf("field status of message did.v1.DIDDocumentMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentMetadata"))
@@ -5824,6 +5843,8 @@ func (x *fastReflection_DIDDocumentMetadata) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfList(&_DIDDocumentMetadata_8_list{list: &list})
 	case "did.v1.DIDDocumentMetadata.canonical_id":
 		return protoreflect.ValueOfString("")
+	case "did.v1.DIDDocumentMetadata.status":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentMetadata"))
@@ -5927,6 +5948,9 @@ func (x *fastReflection_DIDDocumentMetadata) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5956,6 +5980,11 @@ func (x *fastReflection_DIDDocumentMetadata) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x50
+		}
 		if len(x.CanonicalId) > 0 {
 			i -= len(x.CanonicalId)
 			copy(dAtA[i:], x.CanonicalId)
@@ -6298,6 +6327,25 @@ func (x *fastReflection_DIDDocumentMetadata) ProtoMethods() *protoiface.Methods
 				}
 				x.CanonicalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= DIDStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6333,193 +6381,34 @@ func (x *fastReflection_DIDDocumentMetadata) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_VerifiableCredential_2_list)(nil)
-
-type _VerifiableCredential_2_list struct {
-	list *[]string
-}
-
-func (x *_VerifiableCredential_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_VerifiableCredential_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_VerifiableCredential_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_VerifiableCredential_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_VerifiableCredential_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message VerifiableCredential at list field Context as it is not of Message kind"))
-}
-
-func (x *_VerifiableCredential_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_VerifiableCredential_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_VerifiableCredential_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_VerifiableCredential_3_list)(nil)
-
-type _VerifiableCredential_3_list struct {
-	list *[]string
-}
-
-func (x *_VerifiableCredential_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_VerifiableCredential_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_VerifiableCredential_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_VerifiableCredential_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_VerifiableCredential_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message VerifiableCredential at list field CredentialKinds as it is not of Message kind"))
-}
-
-func (x *_VerifiableCredential_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_VerifiableCredential_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_VerifiableCredential_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_VerifiableCredential_8_list)(nil)
-
-type _VerifiableCredential_8_list struct {
-	list *[]*CredentialProof
-}
-
-func (x *_VerifiableCredential_8_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_VerifiableCredential_8_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_VerifiableCredential_8_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CredentialProof)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_VerifiableCredential_8_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CredentialProof)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_VerifiableCredential_8_list) AppendMutable() protoreflect.Value {
-	v := new(CredentialProof)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_VerifiableCredential_8_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_VerifiableCredential_8_list) NewElement() protoreflect.Value {
-	v := new(CredentialProof)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_VerifiableCredential_8_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_VerifiableCredential                    protoreflect.MessageDescriptor
-	fd_VerifiableCredential_id                 protoreflect.FieldDescriptor
-	fd_VerifiableCredential_context            protoreflect.FieldDescriptor
-	fd_VerifiableCredential_credential_kinds   protoreflect.FieldDescriptor
-	fd_VerifiableCredential_issuer             protoreflect.FieldDescriptor
-	fd_VerifiableCredential_issuance_date      protoreflect.FieldDescriptor
-	fd_VerifiableCredential_expiration_date    protoreflect.FieldDescriptor
-	fd_VerifiableCredential_credential_subject protoreflect.FieldDescriptor
-	fd_VerifiableCredential_proof              protoreflect.FieldDescriptor
-	fd_VerifiableCredential_credential_status  protoreflect.FieldDescriptor
-	fd_VerifiableCredential_subject            protoreflect.FieldDescriptor
-	fd_VerifiableCredential_issued_at          protoreflect.FieldDescriptor
-	fd_VerifiableCredential_expires_at         protoreflect.FieldDescriptor
-	fd_VerifiableCredential_revoked            protoreflect.FieldDescriptor
+	md_DIDDocumentVersion              protoreflect.MessageDescriptor
+	fd_DIDDocumentVersion_did          protoreflect.FieldDescriptor
+	fd_DIDDocumentVersion_version_id   protoreflect.FieldDescriptor
+	fd_DIDDocumentVersion_version_time protoreflect.FieldDescriptor
+	fd_DIDDocumentVersion_block_height protoreflect.FieldDescriptor
+	fd_DIDDocumentVersion_document     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_state_proto_init()
-	md_VerifiableCredential = File_did_v1_state_proto.Messages().ByName("VerifiableCredential")
-	fd_VerifiableCredential_id = md_VerifiableCredential.Fields().ByName("id")
-	fd_VerifiableCredential_context = md_VerifiableCredential.Fields().ByName("context")
-	fd_VerifiableCredential_credential_kinds = md_VerifiableCredential.Fields().ByName("credential_kinds")
-	fd_VerifiableCredential_issuer = md_VerifiableCredential.Fields().ByName("issuer")
-	fd_VerifiableCredential_issuance_date = md_VerifiableCredential.Fields().ByName("issuance_date")
-	fd_VerifiableCredential_expiration_date = md_VerifiableCredential.Fields().ByName("expiration_date")
-	fd_VerifiableCredential_credential_subject = md_VerifiableCredential.Fields().ByName("credential_subject")
-	fd_VerifiableCredential_proof = md_VerifiableCredential.Fields().ByName("proof")
-	fd_VerifiableCredential_credential_status = md_VerifiableCredential.Fields().ByName("credential_status")
-	fd_VerifiableCredential_subject = md_VerifiableCredential.Fields().ByName("subject")
-	fd_VerifiableCredential_issued_at = md_VerifiableCredential.Fields().ByName("issued_at")
-	fd_VerifiableCredential_expires_at = md_VerifiableCredential.Fields().ByName("expires_at")
-	fd_VerifiableCredential_revoked = md_VerifiableCredential.Fields().ByName("revoked")
+	md_DIDDocumentVersion = File_did_v1_state_proto.Messages().ByName("DIDDocumentVersion")
+	fd_DIDDocumentVersion_did = md_DIDDocumentVersion.Fields().ByName("did")
+	fd_DIDDocumentVersion_version_id = md_DIDDocumentVersion.Fields().ByName("version_id")
+	fd_DIDDocumentVersion_version_time = md_DIDDocumentVersion.Fields().ByName("version_time")
+	fd_DIDDocumentVersion_block_height = md_DIDDocumentVersion.Fields().ByName("block_height")
+	fd_DIDDocumentVersion_document = md_DIDDocumentVersion.Fields().ByName("document")
 }
 
-var _ protoreflect.Message = (*fastReflection_VerifiableCredential)(nil)
+var _ protoreflect.Message = (*fastReflection_DIDDocumentVersion)(nil)
 
-type fastReflection_VerifiableCredential VerifiableCredential
+type fastReflection_DIDDocumentVersion DIDDocumentVersion
 
-func (x *VerifiableCredential) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VerifiableCredential)(x)
+func (x *DIDDocumentVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DIDDocumentVersion)(x)
 }
 
-func (x *VerifiableCredential) slowProtoReflect() protoreflect.Message {
+func (x *DIDDocumentVersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_state_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6531,43 +6420,43 @@ func (x *VerifiableCredential) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VerifiableCredential_messageType fastReflection_VerifiableCredential_messageType
-var _ protoreflect.MessageType = fastReflection_VerifiableCredential_messageType{}
+var _fastReflection_DIDDocumentVersion_messageType fastReflection_DIDDocumentVersion_messageType
+var _ protoreflect.MessageType = fastReflection_DIDDocumentVersion_messageType{}
 
-type fastReflection_VerifiableCredential_messageType struct{}
+type fastReflection_DIDDocumentVersion_messageType struct{}
 
-func (x fastReflection_VerifiableCredential_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VerifiableCredential)(nil)
+func (x fastReflection_DIDDocumentVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DIDDocumentVersion)(nil)
 }
-func (x fastReflection_VerifiableCredential_messageType) New() protoreflect.Message {
-	return new(fastReflection_VerifiableCredential)
+func (x fastReflection_DIDDocumentVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_DIDDocumentVersion)
 }
-func (x fastReflection_VerifiableCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VerifiableCredential
+func (x fastReflection_DIDDocumentVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDDocumentVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VerifiableCredential) Descriptor() protoreflect.MessageDescriptor {
-	return md_VerifiableCredential
+func (x *fastReflection_DIDDocumentVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDDocumentVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VerifiableCredential) Type() protoreflect.MessageType {
-	return _fastReflection_VerifiableCredential_messageType
+func (x *fastReflection_DIDDocumentVersion) Type() protoreflect.MessageType {
+	return _fastReflection_DIDDocumentVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VerifiableCredential) New() protoreflect.Message {
-	return new(fastReflection_VerifiableCredential)
+func (x *fastReflection_DIDDocumentVersion) New() protoreflect.Message {
+	return new(fastReflection_DIDDocumentVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VerifiableCredential) Interface() protoreflect.ProtoMessage {
-	return (*VerifiableCredential)(x)
+func (x *fastReflection_DIDDocumentVersion) Interface() protoreflect.ProtoMessage {
+	return (*DIDDocumentVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6575,131 +6464,67 @@ func (x *fastReflection_VerifiableCredential) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VerifiableCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_VerifiableCredential_id, value) {
+func (x *fastReflection_DIDDocumentVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_DIDDocumentVersion_did, value) {
 			return
 		}
 	}
-	if len(x.Context) != 0 {
-		value := protoreflect.ValueOfList(&_VerifiableCredential_2_list{list: &x.Context})
-		if !f(fd_VerifiableCredential_context, value) {
+	if x.VersionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VersionId)
+		if !f(fd_DIDDocumentVersion_version_id, value) {
 			return
 		}
 	}
-	if len(x.CredentialKinds) != 0 {
-		value := protoreflect.ValueOfList(&_VerifiableCredential_3_list{list: &x.CredentialKinds})
-		if !f(fd_VerifiableCredential_credential_kinds, value) {
+	if x.VersionTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VersionTime)
+		if !f(fd_DIDDocumentVersion_version_time, value) {
 			return
 		}
 	}
-	if x.Issuer != "" {
-		value := protoreflect.ValueOfString(x.Issuer)
-		if !f(fd_VerifiableCredential_issuer, value) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_DIDDocumentVersion_block_height, value) {
 			return
 		}
 	}
-	if x.IssuanceDate != "" {
-		value := protoreflect.ValueOfString(x.IssuanceDate)
-		if !f(fd_VerifiableCredential_issuance_date, value) {
+	if x.Document != nil {
+		value := protoreflect.ValueOfMessage(x.Document.ProtoReflect())
+		if !f(fd_DIDDocumentVersion_document, value) {
 			return
 		}
 	}
-	if x.ExpirationDate != "" {
-		value := protoreflect.ValueOfString(x.ExpirationDate)
-		if !f(fd_VerifiableCredential_expiration_date, value) {
-			return
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DIDDocumentVersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.DIDDocumentVersion.did":
+		return x.Did != ""
+	case "did.v1.DIDDocumentVersion.version_id":
+		return x.VersionId != uint64(0)
+	case "did.v1.DIDDocumentVersion.version_time":
+		return x.VersionTime != int64(0)
+	case "did.v1.DIDDocumentVersion.block_height":
+		return x.BlockHeight != int64(0)
+	case "did.v1.DIDDocumentVersion.document":
+		return x.Document != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentVersion"))
 		}
-	}
-	if len(x.CredentialSubject) != 0 {
-		value := protoreflect.ValueOfBytes(x.CredentialSubject)
-		if !f(fd_VerifiableCredential_credential_subject, value) {
-			return
-		}
-	}
-	if len(x.Proof) != 0 {
-		value := protoreflect.ValueOfList(&_VerifiableCredential_8_list{list: &x.Proof})
-		if !f(fd_VerifiableCredential_proof, value) {
-			return
-		}
-	}
-	if x.CredentialStatus != nil {
-		value := protoreflect.ValueOfMessage(x.CredentialStatus.ProtoReflect())
-		if !f(fd_VerifiableCredential_credential_status, value) {
-			return
-		}
-	}
-	if x.Subject != "" {
-		value := protoreflect.ValueOfString(x.Subject)
-		if !f(fd_VerifiableCredential_subject, value) {
-			return
-		}
-	}
-	if x.IssuedAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.IssuedAt)
-		if !f(fd_VerifiableCredential_issued_at, value) {
-			return
-		}
-	}
-	if x.ExpiresAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ExpiresAt)
-		if !f(fd_VerifiableCredential_expires_at, value) {
-			return
-		}
-	}
-	if x.Revoked != false {
-		value := protoreflect.ValueOfBool(x.Revoked)
-		if !f(fd_VerifiableCredential_revoked, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_VerifiableCredential) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "did.v1.VerifiableCredential.id":
-		return x.Id != ""
-	case "did.v1.VerifiableCredential.context":
-		return len(x.Context) != 0
-	case "did.v1.VerifiableCredential.credential_kinds":
-		return len(x.CredentialKinds) != 0
-	case "did.v1.VerifiableCredential.issuer":
-		return x.Issuer != ""
-	case "did.v1.VerifiableCredential.issuance_date":
-		return x.IssuanceDate != ""
-	case "did.v1.VerifiableCredential.expiration_date":
-		return x.ExpirationDate != ""
-	case "did.v1.VerifiableCredential.credential_subject":
-		return len(x.CredentialSubject) != 0
-	case "did.v1.VerifiableCredential.proof":
-		return len(x.Proof) != 0
-	case "did.v1.VerifiableCredential.credential_status":
-		return x.CredentialStatus != nil
-	case "did.v1.VerifiableCredential.subject":
-		return x.Subject != ""
-	case "did.v1.VerifiableCredential.issued_at":
-		return x.IssuedAt != int64(0)
-	case "did.v1.VerifiableCredential.expires_at":
-		return x.ExpiresAt != int64(0)
-	case "did.v1.VerifiableCredential.revoked":
-		return x.Revoked != false
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
-		}
-		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDDocumentVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6709,39 +6534,23 @@ func (x *fastReflection_VerifiableCredential) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VerifiableCredential) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DIDDocumentVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.VerifiableCredential.id":
-		x.Id = ""
-	case "did.v1.VerifiableCredential.context":
-		x.Context = nil
-	case "did.v1.VerifiableCredential.credential_kinds":
-		x.CredentialKinds = nil
-	case "did.v1.VerifiableCredential.issuer":
-		x.Issuer = ""
-	case "did.v1.VerifiableCredential.issuance_date":
-		x.IssuanceDate = ""
-	case "did.v1.VerifiableCredential.expiration_date":
-		x.ExpirationDate = ""
-	case "did.v1.VerifiableCredential.credential_subject":
-		x.CredentialSubject = nil
-	case "did.v1.VerifiableCredential.proof":
-		x.Proof = nil
-	case "did.v1.VerifiableCredential.credential_status":
-		x.CredentialStatus = nil
-	case "did.v1.VerifiableCredential.subject":
-		x.Subject = ""
-	case "did.v1.VerifiableCredential.issued_at":
-		x.IssuedAt = int64(0)
-	case "did.v1.VerifiableCredential.expires_at":
-		x.ExpiresAt = int64(0)
-	case "did.v1.VerifiableCredential.revoked":
-		x.Revoked = false
+	case "did.v1.DIDDocumentVersion.did":
+		x.Did = ""
+	case "did.v1.DIDDocumentVersion.version_id":
+		x.VersionId = uint64(0)
+	case "did.v1.DIDDocumentVersion.version_time":
+		x.VersionTime = int64(0)
+	case "did.v1.DIDDocumentVersion.block_height":
+		x.BlockHeight = int64(0)
+	case "did.v1.DIDDocumentVersion.document":
+		x.Document = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentVersion"))
 		}
-		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDDocumentVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6751,61 +6560,28 @@ func (x *fastReflection_VerifiableCredential) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VerifiableCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DIDDocumentVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.VerifiableCredential.id":
-		value := x.Id
-		return protoreflect.ValueOfString(value)
-	case "did.v1.VerifiableCredential.context":
-		if len(x.Context) == 0 {
-			return protoreflect.ValueOfList(&_VerifiableCredential_2_list{})
-		}
-		listValue := &_VerifiableCredential_2_list{list: &x.Context}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.VerifiableCredential.credential_kinds":
-		if len(x.CredentialKinds) == 0 {
-			return protoreflect.ValueOfList(&_VerifiableCredential_3_list{})
-		}
-		listValue := &_VerifiableCredential_3_list{list: &x.CredentialKinds}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.VerifiableCredential.issuer":
-		value := x.Issuer
-		return protoreflect.ValueOfString(value)
-	case "did.v1.VerifiableCredential.issuance_date":
-		value := x.IssuanceDate
-		return protoreflect.ValueOfString(value)
-	case "did.v1.VerifiableCredential.expiration_date":
-		value := x.ExpirationDate
-		return protoreflect.ValueOfString(value)
-	case "did.v1.VerifiableCredential.credential_subject":
-		value := x.CredentialSubject
-		return protoreflect.ValueOfBytes(value)
-	case "did.v1.VerifiableCredential.proof":
-		if len(x.Proof) == 0 {
-			return protoreflect.ValueOfList(&_VerifiableCredential_8_list{})
-		}
-		listValue := &_VerifiableCredential_8_list{list: &x.Proof}
-		return protoreflect.ValueOfList(listValue)
-	case "did.v1.VerifiableCredential.credential_status":
-		value := x.CredentialStatus
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.VerifiableCredential.subject":
-		value := x.Subject
+	case "did.v1.DIDDocumentVersion.did":
+		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.VerifiableCredential.issued_at":
-		value := x.IssuedAt
+	case "did.v1.DIDDocumentVersion.version_id":
+		value := x.VersionId
+		return protoreflect.ValueOfUint64(value)
+	case "did.v1.DIDDocumentVersion.version_time":
+		value := x.VersionTime
 		return protoreflect.ValueOfInt64(value)
-	case "did.v1.VerifiableCredential.expires_at":
-		value := x.ExpiresAt
+	case "did.v1.DIDDocumentVersion.block_height":
+		value := x.BlockHeight
 		return protoreflect.ValueOfInt64(value)
-	case "did.v1.VerifiableCredential.revoked":
-		value := x.Revoked
-		return protoreflect.ValueOfBool(value)
+	case "did.v1.DIDDocumentVersion.document":
+		value := x.Document
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentVersion"))
 		}
-		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDDocumentVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6819,45 +6595,23 @@ func (x *fastReflection_VerifiableCredential) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VerifiableCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DIDDocumentVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.VerifiableCredential.id":
-		x.Id = value.Interface().(string)
-	case "did.v1.VerifiableCredential.context":
-		lv := value.List()
-		clv := lv.(*_VerifiableCredential_2_list)
-		x.Context = *clv.list
-	case "did.v1.VerifiableCredential.credential_kinds":
-		lv := value.List()
-		clv := lv.(*_VerifiableCredential_3_list)
-		x.CredentialKinds = *clv.list
-	case "did.v1.VerifiableCredential.issuer":
-		x.Issuer = value.Interface().(string)
-	case "did.v1.VerifiableCredential.issuance_date":
-		x.IssuanceDate = value.Interface().(string)
-	case "did.v1.VerifiableCredential.expiration_date":
-		x.ExpirationDate = value.Interface().(string)
-	case "did.v1.VerifiableCredential.credential_subject":
-		x.CredentialSubject = value.Bytes()
-	case "did.v1.VerifiableCredential.proof":
-		lv := value.List()
-		clv := lv.(*_VerifiableCredential_8_list)
-		x.Proof = *clv.list
-	case "did.v1.VerifiableCredential.credential_status":
-		x.CredentialStatus = value.Message().Interface().(*CredentialStatus)
-	case "did.v1.VerifiableCredential.subject":
-		x.Subject = value.Interface().(string)
-	case "did.v1.VerifiableCredential.issued_at":
-		x.IssuedAt = value.Int()
-	case "did.v1.VerifiableCredential.expires_at":
-		x.ExpiresAt = value.Int()
-	case "did.v1.VerifiableCredential.revoked":
-		x.Revoked = value.Bool()
+	case "did.v1.DIDDocumentVersion.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.DIDDocumentVersion.version_id":
+		x.VersionId = value.Uint()
+	case "did.v1.DIDDocumentVersion.version_time":
+		x.VersionTime = value.Int()
+	case "did.v1.DIDDocumentVersion.block_height":
+		x.BlockHeight = value.Int()
+	case "did.v1.DIDDocumentVersion.document":
+		x.Document = value.Message().Interface().(*DIDDocument)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentVersion"))
 		}
-		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDDocumentVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6871,107 +6625,60 @@ func (x *fastReflection_VerifiableCredential) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VerifiableCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DIDDocumentVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.VerifiableCredential.context":
-		if x.Context == nil {
-			x.Context = []string{}
-		}
-		value := &_VerifiableCredential_2_list{list: &x.Context}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.VerifiableCredential.credential_kinds":
-		if x.CredentialKinds == nil {
-			x.CredentialKinds = []string{}
-		}
-		value := &_VerifiableCredential_3_list{list: &x.CredentialKinds}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.VerifiableCredential.proof":
-		if x.Proof == nil {
-			x.Proof = []*CredentialProof{}
-		}
-		value := &_VerifiableCredential_8_list{list: &x.Proof}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.VerifiableCredential.credential_status":
-		if x.CredentialStatus == nil {
-			x.CredentialStatus = new(CredentialStatus)
-		}
-		return protoreflect.ValueOfMessage(x.CredentialStatus.ProtoReflect())
-	case "did.v1.VerifiableCredential.id":
-		panic(fmt.Errorf("field id of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.issuer":
-		panic(fmt.Errorf("field issuer of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.issuance_date":
-		panic(fmt.Errorf("field issuance_date of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.expiration_date":
-		panic(fmt.Errorf("field expiration_date of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.credential_subject":
-		panic(fmt.Errorf("field credential_subject of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.subject":
-		panic(fmt.Errorf("field subject of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.issued_at":
-		panic(fmt.Errorf("field issued_at of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.expires_at":
-		panic(fmt.Errorf("field expires_at of message did.v1.VerifiableCredential is not mutable"))
-	case "did.v1.VerifiableCredential.revoked":
-		panic(fmt.Errorf("field revoked of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.DIDDocumentVersion.document":
+		if x.Document == nil {
+			x.Document = new(DIDDocument)
+		}
+		return protoreflect.ValueOfMessage(x.Document.ProtoReflect())
+	case "did.v1.DIDDocumentVersion.did":
+		panic(fmt.Errorf("field did of message did.v1.DIDDocumentVersion is not mutable"))
+	case "did.v1.DIDDocumentVersion.version_id":
+		panic(fmt.Errorf("field version_id of message did.v1.DIDDocumentVersion is not mutable"))
+	case "did.v1.DIDDocumentVersion.version_time":
+		panic(fmt.Errorf("field version_time of message did.v1.DIDDocumentVersion is not mutable"))
+	case "did.v1.DIDDocumentVersion.block_height":
+		panic(fmt.Errorf("field block_height of message did.v1.DIDDocumentVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentVersion"))
 		}
-		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDDocumentVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VerifiableCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DIDDocumentVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.VerifiableCredential.id":
+	case "did.v1.DIDDocumentVersion.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.VerifiableCredential.context":
-		list := []string{}
-		return protoreflect.ValueOfList(&_VerifiableCredential_2_list{list: &list})
-	case "did.v1.VerifiableCredential.credential_kinds":
-		list := []string{}
-		return protoreflect.ValueOfList(&_VerifiableCredential_3_list{list: &list})
-	case "did.v1.VerifiableCredential.issuer":
-		return protoreflect.ValueOfString("")
-	case "did.v1.VerifiableCredential.issuance_date":
-		return protoreflect.ValueOfString("")
-	case "did.v1.VerifiableCredential.expiration_date":
-		return protoreflect.ValueOfString("")
-	case "did.v1.VerifiableCredential.credential_subject":
-		return protoreflect.ValueOfBytes(nil)
-	case "did.v1.VerifiableCredential.proof":
-		list := []*CredentialProof{}
-		return protoreflect.ValueOfList(&_VerifiableCredential_8_list{list: &list})
-	case "did.v1.VerifiableCredential.credential_status":
-		m := new(CredentialStatus)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "did.v1.VerifiableCredential.subject":
-		return protoreflect.ValueOfString("")
-	case "did.v1.VerifiableCredential.issued_at":
+	case "did.v1.DIDDocumentVersion.version_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "did.v1.DIDDocumentVersion.version_time":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "did.v1.VerifiableCredential.expires_at":
+	case "did.v1.DIDDocumentVersion.block_height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "did.v1.VerifiableCredential.revoked":
-		return protoreflect.ValueOfBool(false)
+	case "did.v1.DIDDocumentVersion.document":
+		m := new(DIDDocument)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDDocumentVersion"))
 		}
-		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.DIDDocumentVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VerifiableCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DIDDocumentVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.VerifiableCredential", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.DIDDocumentVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6979,7 +6686,7 @@ func (x *fastReflection_VerifiableCredential) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VerifiableCredential) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DIDDocumentVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6990,7 +6697,7 @@ func (x *fastReflection_VerifiableCredential) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VerifiableCredential) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DIDDocumentVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7002,7 +6709,7 @@ func (x *fastReflection_VerifiableCredential) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VerifiableCredential) IsValid() bool {
+func (x *fastReflection_DIDDocumentVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -7012,9 +6719,9 @@ func (x *fastReflection_VerifiableCredential) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DIDDocumentVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VerifiableCredential)
+		x := input.Message.Interface().(*DIDDocumentVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7026,61 +6733,23 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Context) > 0 {
-			for _, s := range x.Context {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.CredentialKinds) > 0 {
-			for _, s := range x.CredentialKinds {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Issuer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.IssuanceDate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpirationDate)
+		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CredentialSubject)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.VersionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VersionId))
 		}
-		if len(x.Proof) > 0 {
-			for _, e := range x.Proof {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.VersionTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.VersionTime))
 		}
-		if x.CredentialStatus != nil {
-			l = options.Size(x.CredentialStatus)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		l = len(x.Subject)
-		if l > 0 {
+		if x.Document != nil {
+			l = options.Size(x.Document)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IssuedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.IssuedAt))
-		}
-		if x.ExpiresAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
-		}
-		if x.Revoked {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7091,7 +6760,7 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VerifiableCredential)
+		x := input.Message.Interface().(*DIDDocumentVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7110,35 +6779,8 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Revoked {
-			i--
-			if x.Revoked {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x68
-		}
-		if x.ExpiresAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
-			i--
-			dAtA[i] = 0x60
-		}
-		if x.IssuedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuedAt))
-			i--
-			dAtA[i] = 0x58
-		}
-		if len(x.Subject) > 0 {
-			i -= len(x.Subject)
-			copy(dAtA[i:], x.Subject)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Subject)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if x.CredentialStatus != nil {
-			encoded, err := options.Marshal(x.CredentialStatus)
+		if x.Document != nil {
+			encoded, err := options.Marshal(x.Document)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7149,74 +6791,27 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.Proof) > 0 {
-			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Proof[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.CredentialSubject) > 0 {
-			i -= len(x.CredentialSubject)
-			copy(dAtA[i:], x.CredentialSubject)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialSubject)))
-			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2a
 		}
-		if len(x.ExpirationDate) > 0 {
-			i -= len(x.ExpirationDate)
-			copy(dAtA[i:], x.ExpirationDate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpirationDate)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x20
 		}
-		if len(x.IssuanceDate) > 0 {
-			i -= len(x.IssuanceDate)
-			copy(dAtA[i:], x.IssuanceDate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IssuanceDate)))
+		if x.VersionTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VersionTime))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x18
 		}
-		if len(x.Issuer) > 0 {
-			i -= len(x.Issuer)
-			copy(dAtA[i:], x.Issuer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+		if x.VersionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VersionId))
 			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.CredentialKinds) > 0 {
-			for iNdEx := len(x.CredentialKinds) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.CredentialKinds[iNdEx])
-				copy(dAtA[i:], x.CredentialKinds[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialKinds[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Context) > 0 {
-			for iNdEx := len(x.Context) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Context[iNdEx])
-				copy(dAtA[i:], x.Context[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Context[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+			dAtA[i] = 0x10
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7231,7 +6826,7 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VerifiableCredential)
+		x := input.Message.Interface().(*DIDDocumentVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7263,15 +6858,15 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerifiableCredential: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDDocumentVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDDocumentVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7299,13 +6894,13 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 				}
-				var stringLen uint64
+				x.VersionId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7315,29 +6910,16 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.VersionId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Context = append(x.Context, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialKinds", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
 				}
-				var stringLen uint64
+				x.VersionTime = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7347,29 +6929,16 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.VersionTime |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CredentialKinds = append(x.CredentialKinds, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var stringLen uint64
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7379,29 +6948,16 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BlockHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Issuer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7411,250 +6967,28 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.IssuanceDate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				if x.Document == nil {
+					x.Document = &DIDDocument{}
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpirationDate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialSubject", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CredentialSubject = append(x.CredentialSubject[:0], dAtA[iNdEx:postIndex]...)
-				if x.CredentialSubject == nil {
-					x.CredentialSubject = []byte{}
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Proof = append(x.Proof, &CredentialProof{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof[len(x.Proof)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialStatus", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CredentialStatus == nil {
-					x.CredentialStatus = &CredentialStatus{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CredentialStatus); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Document); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Subject = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 11:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
-				}
-				x.IssuedAt = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.IssuedAt |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
-				}
-				x.ExpiresAt = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExpiresAt |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 13:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Revoked = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7691,31 +7025,35 @@ func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_DIDController                protoreflect.MessageDescriptor
-	fd_DIDController_id             protoreflect.FieldDescriptor
-	fd_DIDController_did            protoreflect.FieldDescriptor
-	fd_DIDController_controller_did protoreflect.FieldDescriptor
-	fd_DIDController_added_at       protoreflect.FieldDescriptor
+	md_UnverifiedCredential                        protoreflect.MessageDescriptor
+	fd_UnverifiedCredential_did                    protoreflect.FieldDescriptor
+	fd_UnverifiedCredential_verification_method_id protoreflect.FieldDescriptor
+	fd_UnverifiedCredential_credential_id          protoreflect.FieldDescriptor
+	fd_UnverifiedCredential_origin                 protoreflect.FieldDescriptor
+	fd_UnverifiedCredential_reason                 protoreflect.FieldDescriptor
+	fd_UnverifiedCredential_flagged_at             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_state_proto_init()
-	md_DIDController = File_did_v1_state_proto.Messages().ByName("DIDController")
-	fd_DIDController_id = md_DIDController.Fields().ByName("id")
-	fd_DIDController_did = md_DIDController.Fields().ByName("did")
-	fd_DIDController_controller_did = md_DIDController.Fields().ByName("controller_did")
-	fd_DIDController_added_at = md_DIDController.Fields().ByName("added_at")
+	md_UnverifiedCredential = File_did_v1_state_proto.Messages().ByName("UnverifiedCredential")
+	fd_UnverifiedCredential_did = md_UnverifiedCredential.Fields().ByName("did")
+	fd_UnverifiedCredential_verification_method_id = md_UnverifiedCredential.Fields().ByName("verification_method_id")
+	fd_UnverifiedCredential_credential_id = md_UnverifiedCredential.Fields().ByName("credential_id")
+	fd_UnverifiedCredential_origin = md_UnverifiedCredential.Fields().ByName("origin")
+	fd_UnverifiedCredential_reason = md_UnverifiedCredential.Fields().ByName("reason")
+	fd_UnverifiedCredential_flagged_at = md_UnverifiedCredential.Fields().ByName("flagged_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_DIDController)(nil)
+var _ protoreflect.Message = (*fastReflection_UnverifiedCredential)(nil)
 
-type fastReflection_DIDController DIDController
+type fastReflection_UnverifiedCredential UnverifiedCredential
 
-func (x *DIDController) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DIDController)(x)
+func (x *UnverifiedCredential) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnverifiedCredential)(x)
 }
 
-func (x *DIDController) slowProtoReflect() protoreflect.Message {
+func (x *UnverifiedCredential) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_state_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7727,43 +7065,43 @@ func (x *DIDController) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DIDController_messageType fastReflection_DIDController_messageType
-var _ protoreflect.MessageType = fastReflection_DIDController_messageType{}
+var _fastReflection_UnverifiedCredential_messageType fastReflection_UnverifiedCredential_messageType
+var _ protoreflect.MessageType = fastReflection_UnverifiedCredential_messageType{}
 
-type fastReflection_DIDController_messageType struct{}
+type fastReflection_UnverifiedCredential_messageType struct{}
 
-func (x fastReflection_DIDController_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DIDController)(nil)
+func (x fastReflection_UnverifiedCredential_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnverifiedCredential)(nil)
 }
-func (x fastReflection_DIDController_messageType) New() protoreflect.Message {
-	return new(fastReflection_DIDController)
+func (x fastReflection_UnverifiedCredential_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnverifiedCredential)
 }
-func (x fastReflection_DIDController_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DIDController
+func (x fastReflection_UnverifiedCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnverifiedCredential
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DIDController) Descriptor() protoreflect.MessageDescriptor {
-	return md_DIDController
+func (x *fastReflection_UnverifiedCredential) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnverifiedCredential
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DIDController) Type() protoreflect.MessageType {
-	return _fastReflection_DIDController_messageType
+func (x *fastReflection_UnverifiedCredential) Type() protoreflect.MessageType {
+	return _fastReflection_UnverifiedCredential_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DIDController) New() protoreflect.Message {
-	return new(fastReflection_DIDController)
+func (x *fastReflection_UnverifiedCredential) New() protoreflect.Message {
+	return new(fastReflection_UnverifiedCredential)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DIDController) Interface() protoreflect.ProtoMessage {
-	return (*DIDController)(x)
+func (x *fastReflection_UnverifiedCredential) Interface() protoreflect.ProtoMessage {
+	return (*UnverifiedCredential)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7771,28 +7109,40 @@ func (x *fastReflection_DIDController) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DIDController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_DIDController_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_UnverifiedCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_DIDController_did, value) {
+		if !f(fd_UnverifiedCredential_did, value) {
 			return
 		}
 	}
-	if x.ControllerDid != "" {
-		value := protoreflect.ValueOfString(x.ControllerDid)
-		if !f(fd_DIDController_controller_did, value) {
+	if x.VerificationMethodId != "" {
+		value := protoreflect.ValueOfString(x.VerificationMethodId)
+		if !f(fd_UnverifiedCredential_verification_method_id, value) {
 			return
 		}
 	}
-	if x.AddedAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.AddedAt)
-		if !f(fd_DIDController_added_at, value) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_UnverifiedCredential_credential_id, value) {
+			return
+		}
+	}
+	if x.Origin != "" {
+		value := protoreflect.ValueOfString(x.Origin)
+		if !f(fd_UnverifiedCredential_origin, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_UnverifiedCredential_reason, value) {
+			return
+		}
+	}
+	if x.FlaggedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FlaggedAt)
+		if !f(fd_UnverifiedCredential_flagged_at, value) {
 			return
 		}
 	}
@@ -7809,21 +7159,25 @@ func (x *fastReflection_DIDController) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DIDController) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UnverifiedCredential) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.DIDController.id":
-		return x.Id != uint64(0)
-	case "did.v1.DIDController.did":
+	case "did.v1.UnverifiedCredential.did":
 		return x.Did != ""
-	case "did.v1.DIDController.controller_did":
-		return x.ControllerDid != ""
-	case "did.v1.DIDController.added_at":
-		return x.AddedAt != int64(0)
+	case "did.v1.UnverifiedCredential.verification_method_id":
+		return x.VerificationMethodId != ""
+	case "did.v1.UnverifiedCredential.credential_id":
+		return x.CredentialId != ""
+	case "did.v1.UnverifiedCredential.origin":
+		return x.Origin != ""
+	case "did.v1.UnverifiedCredential.reason":
+		return x.Reason != ""
+	case "did.v1.UnverifiedCredential.flagged_at":
+		return x.FlaggedAt != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.UnverifiedCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.UnverifiedCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7833,21 +7187,25 @@ func (x *fastReflection_DIDController) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DIDController) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UnverifiedCredential) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.DIDController.id":
-		x.Id = uint64(0)
-	case "did.v1.DIDController.did":
+	case "did.v1.UnverifiedCredential.did":
 		x.Did = ""
-	case "did.v1.DIDController.controller_did":
-		x.ControllerDid = ""
-	case "did.v1.DIDController.added_at":
-		x.AddedAt = int64(0)
+	case "did.v1.UnverifiedCredential.verification_method_id":
+		x.VerificationMethodId = ""
+	case "did.v1.UnverifiedCredential.credential_id":
+		x.CredentialId = ""
+	case "did.v1.UnverifiedCredential.origin":
+		x.Origin = ""
+	case "did.v1.UnverifiedCredential.reason":
+		x.Reason = ""
+	case "did.v1.UnverifiedCredential.flagged_at":
+		x.FlaggedAt = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.UnverifiedCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.UnverifiedCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7857,25 +7215,31 @@ func (x *fastReflection_DIDController) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DIDController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnverifiedCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.DIDController.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "did.v1.DIDController.did":
+	case "did.v1.UnverifiedCredential.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.DIDController.controller_did":
-		value := x.ControllerDid
+	case "did.v1.UnverifiedCredential.verification_method_id":
+		value := x.VerificationMethodId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.DIDController.added_at":
-		value := x.AddedAt
+	case "did.v1.UnverifiedCredential.credential_id":
+		value := x.CredentialId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.UnverifiedCredential.origin":
+		value := x.Origin
+		return protoreflect.ValueOfString(value)
+	case "did.v1.UnverifiedCredential.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "did.v1.UnverifiedCredential.flagged_at":
+		value := x.FlaggedAt
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.UnverifiedCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.UnverifiedCredential does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7889,21 +7253,25 @@ func (x *fastReflection_DIDController) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DIDController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UnverifiedCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.DIDController.id":
-		x.Id = value.Uint()
-	case "did.v1.DIDController.did":
+	case "did.v1.UnverifiedCredential.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.DIDController.controller_did":
-		x.ControllerDid = value.Interface().(string)
-	case "did.v1.DIDController.added_at":
-		x.AddedAt = value.Int()
+	case "did.v1.UnverifiedCredential.verification_method_id":
+		x.VerificationMethodId = value.Interface().(string)
+	case "did.v1.UnverifiedCredential.credential_id":
+		x.CredentialId = value.Interface().(string)
+	case "did.v1.UnverifiedCredential.origin":
+		x.Origin = value.Interface().(string)
+	case "did.v1.UnverifiedCredential.reason":
+		x.Reason = value.Interface().(string)
+	case "did.v1.UnverifiedCredential.flagged_at":
+		x.FlaggedAt = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.UnverifiedCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.UnverifiedCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7917,52 +7285,60 @@ func (x *fastReflection_DIDController) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DIDController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnverifiedCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.DIDController.id":
-		panic(fmt.Errorf("field id of message did.v1.DIDController is not mutable"))
-	case "did.v1.DIDController.did":
-		panic(fmt.Errorf("field did of message did.v1.DIDController is not mutable"))
-	case "did.v1.DIDController.controller_did":
-		panic(fmt.Errorf("field controller_did of message did.v1.DIDController is not mutable"))
-	case "did.v1.DIDController.added_at":
-		panic(fmt.Errorf("field added_at of message did.v1.DIDController is not mutable"))
+	case "did.v1.UnverifiedCredential.did":
+		panic(fmt.Errorf("field did of message did.v1.UnverifiedCredential is not mutable"))
+	case "did.v1.UnverifiedCredential.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message did.v1.UnverifiedCredential is not mutable"))
+	case "did.v1.UnverifiedCredential.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.UnverifiedCredential is not mutable"))
+	case "did.v1.UnverifiedCredential.origin":
+		panic(fmt.Errorf("field origin of message did.v1.UnverifiedCredential is not mutable"))
+	case "did.v1.UnverifiedCredential.reason":
+		panic(fmt.Errorf("field reason of message did.v1.UnverifiedCredential is not mutable"))
+	case "did.v1.UnverifiedCredential.flagged_at":
+		panic(fmt.Errorf("field flagged_at of message did.v1.UnverifiedCredential is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.UnverifiedCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.UnverifiedCredential does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DIDController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnverifiedCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.DIDController.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "did.v1.DIDController.did":
+	case "did.v1.UnverifiedCredential.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.DIDController.controller_did":
+	case "did.v1.UnverifiedCredential.verification_method_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.DIDController.added_at":
+	case "did.v1.UnverifiedCredential.credential_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.UnverifiedCredential.origin":
+		return protoreflect.ValueOfString("")
+	case "did.v1.UnverifiedCredential.reason":
+		return protoreflect.ValueOfString("")
+	case "did.v1.UnverifiedCredential.flagged_at":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.UnverifiedCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.UnverifiedCredential does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DIDController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UnverifiedCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.DIDController", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.UnverifiedCredential", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7970,7 +7346,7 @@ func (x *fastReflection_DIDController) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DIDController) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UnverifiedCredential) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7981,7 +7357,7 @@ func (x *fastReflection_DIDController) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DIDController) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UnverifiedCredential) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7993,7 +7369,7 @@ func (x *fastReflection_DIDController) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DIDController) IsValid() bool {
+func (x *fastReflection_UnverifiedCredential) IsValid() bool {
 	return x != nil
 }
 
@@ -8003,9 +7379,9 @@ func (x *fastReflection_DIDController) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UnverifiedCredential) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DIDController)
+		x := input.Message.Interface().(*UnverifiedCredential)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8017,19 +7393,28 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
 		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ControllerDid)
+		l = len(x.VerificationMethodId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AddedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.AddedAt))
+		l = len(x.CredentialId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Origin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FlaggedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.FlaggedAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8041,7 +7426,7 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DIDController)
+		x := input.Message.Interface().(*UnverifiedCredential)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8060,29 +7445,45 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AddedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAt))
+		if x.FlaggedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FlaggedAt))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x30
 		}
-		if len(x.ControllerDid) > 0 {
-			i -= len(x.ControllerDid)
-			copy(dAtA[i:], x.ControllerDid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ControllerDid)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Origin) > 0 {
+			i -= len(x.Origin)
+			copy(dAtA[i:], x.Origin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Origin)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
 			i--
 			dAtA[i] = 0x1a
 		}
+		if len(x.VerificationMethodId) > 0 {
+			i -= len(x.VerificationMethodId)
+			copy(dAtA[i:], x.VerificationMethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
 			copy(dAtA[i:], x.Did)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8095,7 +7496,7 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DIDController)
+		x := input.Message.Interface().(*UnverifiedCredential)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8127,17 +7528,17 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDController: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnverifiedCredential: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDController: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnverifiedCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
-				x.Id = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8147,14 +7548,27 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8182,11 +7596,11 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Did = string(dAtA[iNdEx:postIndex])
+				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerDid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8214,13 +7628,77 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ControllerDid = string(dAtA[iNdEx:postIndex])
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Origin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FlaggedAt", wireType)
 				}
-				x.AddedAt = 0
+				x.FlaggedAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8230,7 +7708,7 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AddedAt |= int64(b&0x7F) << shift
+					x.FlaggedAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8270,698 +7748,5593 @@ func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: did/v1/state.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_StatusList            protoreflect.MessageDescriptor
+	fd_StatusList_issuer     protoreflect.FieldDescriptor
+	fd_StatusList_list_id    protoreflect.FieldDescriptor
+	fd_StatusList_purpose    protoreflect.FieldDescriptor
+	fd_StatusList_bitstring  protoreflect.FieldDescriptor
+	fd_StatusList_allocated  protoreflect.FieldDescriptor
+	fd_StatusList_updated_at protoreflect.FieldDescriptor
 )
 
-// Authentication is the authentication method to be used by the DID.
-type Authentication struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_did_v1_state_proto_init()
+	md_StatusList = File_did_v1_state_proto.Messages().ByName("StatusList")
+	fd_StatusList_issuer = md_StatusList.Fields().ByName("issuer")
+	fd_StatusList_list_id = md_StatusList.Fields().ByName("list_id")
+	fd_StatusList_purpose = md_StatusList.Fields().ByName("purpose")
+	fd_StatusList_bitstring = md_StatusList.Fields().ByName("bitstring")
+	fd_StatusList_allocated = md_StatusList.Fields().ByName("allocated")
+	fd_StatusList_updated_at = md_StatusList.Fields().ByName("updated_at")
+}
 
-	// The unique identifier of the assertion
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// The authentication of the DID
-	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
-	// Origin of the authentication
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	// string is the verification method
-	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
-	// AssertionKind is the assertion type
-	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
-	// CreationBlock is the block number of the creation of the authentication
-	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+var _ protoreflect.Message = (*fastReflection_StatusList)(nil)
+
+type fastReflection_StatusList StatusList
+
+func (x *StatusList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StatusList)(x)
+}
+
+func (x *StatusList) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_state_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StatusList_messageType fastReflection_StatusList_messageType
+var _ protoreflect.MessageType = fastReflection_StatusList_messageType{}
+
+type fastReflection_StatusList_messageType struct{}
+
+func (x fastReflection_StatusList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StatusList)(nil)
+}
+func (x fastReflection_StatusList_messageType) New() protoreflect.Message {
+	return new(fastReflection_StatusList)
+}
+func (x fastReflection_StatusList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StatusList
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StatusList) Descriptor() protoreflect.MessageDescriptor {
+	return md_StatusList
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StatusList) Type() protoreflect.MessageType {
+	return _fastReflection_StatusList_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StatusList) New() protoreflect.Message {
+	return new(fastReflection_StatusList)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StatusList) Interface() protoreflect.ProtoMessage {
+	return (*StatusList)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StatusList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Issuer != "" {
+		value := protoreflect.ValueOfString(x.Issuer)
+		if !f(fd_StatusList_issuer, value) {
+			return
+		}
+	}
+	if x.ListId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ListId)
+		if !f(fd_StatusList_list_id, value) {
+			return
+		}
+	}
+	if x.Purpose != "" {
+		value := protoreflect.ValueOfString(x.Purpose)
+		if !f(fd_StatusList_purpose, value) {
+			return
+		}
+	}
+	if len(x.Bitstring) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bitstring)
+		if !f(fd_StatusList_bitstring, value) {
+			return
+		}
+	}
+	if x.Allocated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Allocated)
+		if !f(fd_StatusList_allocated, value) {
+			return
+		}
+	}
+	if x.UpdatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedAt)
+		if !f(fd_StatusList_updated_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StatusList) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.StatusList.issuer":
+		return x.Issuer != ""
+	case "did.v1.StatusList.list_id":
+		return x.ListId != uint64(0)
+	case "did.v1.StatusList.purpose":
+		return x.Purpose != ""
+	case "did.v1.StatusList.bitstring":
+		return len(x.Bitstring) != 0
+	case "did.v1.StatusList.allocated":
+		return x.Allocated != uint64(0)
+	case "did.v1.StatusList.updated_at":
+		return x.UpdatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.StatusList"))
+		}
+		panic(fmt.Errorf("message did.v1.StatusList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusList) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.StatusList.issuer":
+		x.Issuer = ""
+	case "did.v1.StatusList.list_id":
+		x.ListId = uint64(0)
+	case "did.v1.StatusList.purpose":
+		x.Purpose = ""
+	case "did.v1.StatusList.bitstring":
+		x.Bitstring = nil
+	case "did.v1.StatusList.allocated":
+		x.Allocated = uint64(0)
+	case "did.v1.StatusList.updated_at":
+		x.UpdatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.StatusList"))
+		}
+		panic(fmt.Errorf("message did.v1.StatusList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StatusList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.StatusList.issuer":
+		value := x.Issuer
+		return protoreflect.ValueOfString(value)
+	case "did.v1.StatusList.list_id":
+		value := x.ListId
+		return protoreflect.ValueOfUint64(value)
+	case "did.v1.StatusList.purpose":
+		value := x.Purpose
+		return protoreflect.ValueOfString(value)
+	case "did.v1.StatusList.bitstring":
+		value := x.Bitstring
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.StatusList.allocated":
+		value := x.Allocated
+		return protoreflect.ValueOfUint64(value)
+	case "did.v1.StatusList.updated_at":
+		value := x.UpdatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.StatusList"))
+		}
+		panic(fmt.Errorf("message did.v1.StatusList does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.StatusList.issuer":
+		x.Issuer = value.Interface().(string)
+	case "did.v1.StatusList.list_id":
+		x.ListId = value.Uint()
+	case "did.v1.StatusList.purpose":
+		x.Purpose = value.Interface().(string)
+	case "did.v1.StatusList.bitstring":
+		x.Bitstring = value.Bytes()
+	case "did.v1.StatusList.allocated":
+		x.Allocated = value.Uint()
+	case "did.v1.StatusList.updated_at":
+		x.UpdatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.StatusList"))
+		}
+		panic(fmt.Errorf("message did.v1.StatusList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.StatusList.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.StatusList is not mutable"))
+	case "did.v1.StatusList.list_id":
+		panic(fmt.Errorf("field list_id of message did.v1.StatusList is not mutable"))
+	case "did.v1.StatusList.purpose":
+		panic(fmt.Errorf("field purpose of message did.v1.StatusList is not mutable"))
+	case "did.v1.StatusList.bitstring":
+		panic(fmt.Errorf("field bitstring of message did.v1.StatusList is not mutable"))
+	case "did.v1.StatusList.allocated":
+		panic(fmt.Errorf("field allocated of message did.v1.StatusList is not mutable"))
+	case "did.v1.StatusList.updated_at":
+		panic(fmt.Errorf("field updated_at of message did.v1.StatusList is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.StatusList"))
+		}
+		panic(fmt.Errorf("message did.v1.StatusList does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StatusList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.StatusList.issuer":
+		return protoreflect.ValueOfString("")
+	case "did.v1.StatusList.list_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "did.v1.StatusList.purpose":
+		return protoreflect.ValueOfString("")
+	case "did.v1.StatusList.bitstring":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.StatusList.allocated":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "did.v1.StatusList.updated_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.StatusList"))
+		}
+		panic(fmt.Errorf("message did.v1.StatusList does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StatusList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.StatusList", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StatusList) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusList) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StatusList) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StatusList) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StatusList)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Issuer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ListId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ListId))
+		}
+		l = len(x.Purpose)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Bitstring)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Allocated != 0 {
+			n += 1 + runtime.Sov(uint64(x.Allocated))
+		}
+		if x.UpdatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StatusList)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedAt))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Allocated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Allocated))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Bitstring) > 0 {
+			i -= len(x.Bitstring)
+			copy(dAtA[i:], x.Bitstring)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bitstring)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Purpose) > 0 {
+			i -= len(x.Purpose)
+			copy(dAtA[i:], x.Purpose)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Purpose)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ListId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ListId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StatusList)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StatusList: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Issuer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ListId", wireType)
+				}
+				x.ListId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ListId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Purpose = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bitstring", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bitstring = append(x.Bitstring[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bitstring == nil {
+					x.Bitstring = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+				}
+				x.Allocated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Allocated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				}
+				x.UpdatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DelegatedController            protoreflect.MessageDescriptor
+	fd_DelegatedController_did        protoreflect.FieldDescriptor
+	fd_DelegatedController_controller protoreflect.FieldDescriptor
+	fd_DelegatedController_granted_by protoreflect.FieldDescriptor
+	fd_DelegatedController_purpose    protoreflect.FieldDescriptor
+	fd_DelegatedController_granted_at protoreflect.FieldDescriptor
+	fd_DelegatedController_expires_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_state_proto_init()
+	md_DelegatedController = File_did_v1_state_proto.Messages().ByName("DelegatedController")
+	fd_DelegatedController_did = md_DelegatedController.Fields().ByName("did")
+	fd_DelegatedController_controller = md_DelegatedController.Fields().ByName("controller")
+	fd_DelegatedController_granted_by = md_DelegatedController.Fields().ByName("granted_by")
+	fd_DelegatedController_purpose = md_DelegatedController.Fields().ByName("purpose")
+	fd_DelegatedController_granted_at = md_DelegatedController.Fields().ByName("granted_at")
+	fd_DelegatedController_expires_at = md_DelegatedController.Fields().ByName("expires_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegatedController)(nil)
+
+type fastReflection_DelegatedController DelegatedController
+
+func (x *DelegatedController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegatedController)(x)
+}
+
+func (x *DelegatedController) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_state_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegatedController_messageType fastReflection_DelegatedController_messageType
+var _ protoreflect.MessageType = fastReflection_DelegatedController_messageType{}
+
+type fastReflection_DelegatedController_messageType struct{}
+
+func (x fastReflection_DelegatedController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegatedController)(nil)
+}
+func (x fastReflection_DelegatedController_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegatedController)
+}
+func (x fastReflection_DelegatedController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatedController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegatedController) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatedController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegatedController) Type() protoreflect.MessageType {
+	return _fastReflection_DelegatedController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegatedController) New() protoreflect.Message {
+	return new(fastReflection_DelegatedController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegatedController) Interface() protoreflect.ProtoMessage {
+	return (*DelegatedController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegatedController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_DelegatedController_did, value) {
+			return
+		}
+	}
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_DelegatedController_controller, value) {
+			return
+		}
+	}
+	if x.GrantedBy != "" {
+		value := protoreflect.ValueOfString(x.GrantedBy)
+		if !f(fd_DelegatedController_granted_by, value) {
+			return
+		}
+	}
+	if x.Purpose != "" {
+		value := protoreflect.ValueOfString(x.Purpose)
+		if !f(fd_DelegatedController_purpose, value) {
+			return
+		}
+	}
+	if x.GrantedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GrantedAt)
+		if !f(fd_DelegatedController_granted_at, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_DelegatedController_expires_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegatedController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.DelegatedController.did":
+		return x.Did != ""
+	case "did.v1.DelegatedController.controller":
+		return x.Controller != ""
+	case "did.v1.DelegatedController.granted_by":
+		return x.GrantedBy != ""
+	case "did.v1.DelegatedController.purpose":
+		return x.Purpose != ""
+	case "did.v1.DelegatedController.granted_at":
+		return x.GrantedAt != int64(0)
+	case "did.v1.DelegatedController.expires_at":
+		return x.ExpiresAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.DelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatedController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.DelegatedController.did":
+		x.Did = ""
+	case "did.v1.DelegatedController.controller":
+		x.Controller = ""
+	case "did.v1.DelegatedController.granted_by":
+		x.GrantedBy = ""
+	case "did.v1.DelegatedController.purpose":
+		x.Purpose = ""
+	case "did.v1.DelegatedController.granted_at":
+		x.GrantedAt = int64(0)
+	case "did.v1.DelegatedController.expires_at":
+		x.ExpiresAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.DelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegatedController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.DelegatedController.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DelegatedController.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DelegatedController.granted_by":
+		value := x.GrantedBy
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DelegatedController.purpose":
+		value := x.Purpose
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DelegatedController.granted_at":
+		value := x.GrantedAt
+		return protoreflect.ValueOfInt64(value)
+	case "did.v1.DelegatedController.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.DelegatedController does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatedController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.DelegatedController.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.DelegatedController.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.DelegatedController.granted_by":
+		x.GrantedBy = value.Interface().(string)
+	case "did.v1.DelegatedController.purpose":
+		x.Purpose = value.Interface().(string)
+	case "did.v1.DelegatedController.granted_at":
+		x.GrantedAt = value.Int()
+	case "did.v1.DelegatedController.expires_at":
+		x.ExpiresAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.DelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatedController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.DelegatedController.did":
+		panic(fmt.Errorf("field did of message did.v1.DelegatedController is not mutable"))
+	case "did.v1.DelegatedController.controller":
+		panic(fmt.Errorf("field controller of message did.v1.DelegatedController is not mutable"))
+	case "did.v1.DelegatedController.granted_by":
+		panic(fmt.Errorf("field granted_by of message did.v1.DelegatedController is not mutable"))
+	case "did.v1.DelegatedController.purpose":
+		panic(fmt.Errorf("field purpose of message did.v1.DelegatedController is not mutable"))
+	case "did.v1.DelegatedController.granted_at":
+		panic(fmt.Errorf("field granted_at of message did.v1.DelegatedController is not mutable"))
+	case "did.v1.DelegatedController.expires_at":
+		panic(fmt.Errorf("field expires_at of message did.v1.DelegatedController is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.DelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegatedController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.DelegatedController.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DelegatedController.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DelegatedController.granted_by":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DelegatedController.purpose":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DelegatedController.granted_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "did.v1.DelegatedController.expires_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.DelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegatedController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.DelegatedController", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegatedController) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatedController) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegatedController) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegatedController) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegatedController)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrantedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Purpose)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GrantedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.GrantedAt))
+		}
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegatedController)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.GrantedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GrantedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Purpose) > 0 {
+			i -= len(x.Purpose)
+			copy(dAtA[i:], x.Purpose)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Purpose)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.GrantedBy) > 0 {
+			i -= len(x.GrantedBy)
+			copy(dAtA[i:], x.GrantedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrantedBy)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegatedController)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatedController: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatedController: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrantedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Purpose = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
+				}
+				x.GrantedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GrantedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				x.ExpiresAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DIDAsset               protoreflect.MessageDescriptor
+	fd_DIDAsset_did           protoreflect.FieldDescriptor
+	fd_DIDAsset_class_id      protoreflect.FieldDescriptor
+	fd_DIDAsset_nft_id        protoreflect.FieldDescriptor
+	fd_DIDAsset_owner         protoreflect.FieldDescriptor
+	fd_DIDAsset_uri           protoreflect.FieldDescriptor
+	fd_DIDAsset_uri_hash      protoreflect.FieldDescriptor
+	fd_DIDAsset_class_name    protoreflect.FieldDescriptor
+	fd_DIDAsset_class_uri     protoreflect.FieldDescriptor
+	fd_DIDAsset_interchain    protoreflect.FieldDescriptor
+	fd_DIDAsset_dwn_record_id protoreflect.FieldDescriptor
+	fd_DIDAsset_attached_at   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_state_proto_init()
+	md_DIDAsset = File_did_v1_state_proto.Messages().ByName("DIDAsset")
+	fd_DIDAsset_did = md_DIDAsset.Fields().ByName("did")
+	fd_DIDAsset_class_id = md_DIDAsset.Fields().ByName("class_id")
+	fd_DIDAsset_nft_id = md_DIDAsset.Fields().ByName("nft_id")
+	fd_DIDAsset_owner = md_DIDAsset.Fields().ByName("owner")
+	fd_DIDAsset_uri = md_DIDAsset.Fields().ByName("uri")
+	fd_DIDAsset_uri_hash = md_DIDAsset.Fields().ByName("uri_hash")
+	fd_DIDAsset_class_name = md_DIDAsset.Fields().ByName("class_name")
+	fd_DIDAsset_class_uri = md_DIDAsset.Fields().ByName("class_uri")
+	fd_DIDAsset_interchain = md_DIDAsset.Fields().ByName("interchain")
+	fd_DIDAsset_dwn_record_id = md_DIDAsset.Fields().ByName("dwn_record_id")
+	fd_DIDAsset_attached_at = md_DIDAsset.Fields().ByName("attached_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_DIDAsset)(nil)
+
+type fastReflection_DIDAsset DIDAsset
+
+func (x *DIDAsset) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DIDAsset)(x)
+}
+
+func (x *DIDAsset) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_state_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DIDAsset_messageType fastReflection_DIDAsset_messageType
+var _ protoreflect.MessageType = fastReflection_DIDAsset_messageType{}
+
+type fastReflection_DIDAsset_messageType struct{}
+
+func (x fastReflection_DIDAsset_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DIDAsset)(nil)
+}
+func (x fastReflection_DIDAsset_messageType) New() protoreflect.Message {
+	return new(fastReflection_DIDAsset)
+}
+func (x fastReflection_DIDAsset_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDAsset
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DIDAsset) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDAsset
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DIDAsset) Type() protoreflect.MessageType {
+	return _fastReflection_DIDAsset_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DIDAsset) New() protoreflect.Message {
+	return new(fastReflection_DIDAsset)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DIDAsset) Interface() protoreflect.ProtoMessage {
+	return (*DIDAsset)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DIDAsset) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_DIDAsset_did, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_DIDAsset_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_DIDAsset_nft_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_DIDAsset_owner, value) {
+			return
+		}
+	}
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_DIDAsset_uri, value) {
+			return
+		}
+	}
+	if x.UriHash != "" {
+		value := protoreflect.ValueOfString(x.UriHash)
+		if !f(fd_DIDAsset_uri_hash, value) {
+			return
+		}
+	}
+	if x.ClassName != "" {
+		value := protoreflect.ValueOfString(x.ClassName)
+		if !f(fd_DIDAsset_class_name, value) {
+			return
+		}
+	}
+	if x.ClassUri != "" {
+		value := protoreflect.ValueOfString(x.ClassUri)
+		if !f(fd_DIDAsset_class_uri, value) {
+			return
+		}
+	}
+	if x.Interchain != false {
+		value := protoreflect.ValueOfBool(x.Interchain)
+		if !f(fd_DIDAsset_interchain, value) {
+			return
+		}
+	}
+	if x.DwnRecordId != "" {
+		value := protoreflect.ValueOfString(x.DwnRecordId)
+		if !f(fd_DIDAsset_dwn_record_id, value) {
+			return
+		}
+	}
+	if x.AttachedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AttachedAt)
+		if !f(fd_DIDAsset_attached_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DIDAsset) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.DIDAsset.did":
+		return x.Did != ""
+	case "did.v1.DIDAsset.class_id":
+		return x.ClassId != ""
+	case "did.v1.DIDAsset.nft_id":
+		return x.NftId != ""
+	case "did.v1.DIDAsset.owner":
+		return x.Owner != ""
+	case "did.v1.DIDAsset.uri":
+		return x.Uri != ""
+	case "did.v1.DIDAsset.uri_hash":
+		return x.UriHash != ""
+	case "did.v1.DIDAsset.class_name":
+		return x.ClassName != ""
+	case "did.v1.DIDAsset.class_uri":
+		return x.ClassUri != ""
+	case "did.v1.DIDAsset.interchain":
+		return x.Interchain != false
+	case "did.v1.DIDAsset.dwn_record_id":
+		return x.DwnRecordId != ""
+	case "did.v1.DIDAsset.attached_at":
+		return x.AttachedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAsset"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDAsset) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.DIDAsset.did":
+		x.Did = ""
+	case "did.v1.DIDAsset.class_id":
+		x.ClassId = ""
+	case "did.v1.DIDAsset.nft_id":
+		x.NftId = ""
+	case "did.v1.DIDAsset.owner":
+		x.Owner = ""
+	case "did.v1.DIDAsset.uri":
+		x.Uri = ""
+	case "did.v1.DIDAsset.uri_hash":
+		x.UriHash = ""
+	case "did.v1.DIDAsset.class_name":
+		x.ClassName = ""
+	case "did.v1.DIDAsset.class_uri":
+		x.ClassUri = ""
+	case "did.v1.DIDAsset.interchain":
+		x.Interchain = false
+	case "did.v1.DIDAsset.dwn_record_id":
+		x.DwnRecordId = ""
+	case "did.v1.DIDAsset.attached_at":
+		x.AttachedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAsset"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DIDAsset) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.DIDAsset.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.uri_hash":
+		value := x.UriHash
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.class_name":
+		value := x.ClassName
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.class_uri":
+		value := x.ClassUri
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.interchain":
+		value := x.Interchain
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.DIDAsset.dwn_record_id":
+		value := x.DwnRecordId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDAsset.attached_at":
+		value := x.AttachedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAsset"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDAsset does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDAsset) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.DIDAsset.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.DIDAsset.class_id":
+		x.ClassId = value.Interface().(string)
+	case "did.v1.DIDAsset.nft_id":
+		x.NftId = value.Interface().(string)
+	case "did.v1.DIDAsset.owner":
+		x.Owner = value.Interface().(string)
+	case "did.v1.DIDAsset.uri":
+		x.Uri = value.Interface().(string)
+	case "did.v1.DIDAsset.uri_hash":
+		x.UriHash = value.Interface().(string)
+	case "did.v1.DIDAsset.class_name":
+		x.ClassName = value.Interface().(string)
+	case "did.v1.DIDAsset.class_uri":
+		x.ClassUri = value.Interface().(string)
+	case "did.v1.DIDAsset.interchain":
+		x.Interchain = value.Bool()
+	case "did.v1.DIDAsset.dwn_record_id":
+		x.DwnRecordId = value.Interface().(string)
+	case "did.v1.DIDAsset.attached_at":
+		x.AttachedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAsset"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDAsset) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.DIDAsset.did":
+		panic(fmt.Errorf("field did of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.class_id":
+		panic(fmt.Errorf("field class_id of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.nft_id":
+		panic(fmt.Errorf("field nft_id of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.owner":
+		panic(fmt.Errorf("field owner of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.uri":
+		panic(fmt.Errorf("field uri of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.uri_hash":
+		panic(fmt.Errorf("field uri_hash of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.class_name":
+		panic(fmt.Errorf("field class_name of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.class_uri":
+		panic(fmt.Errorf("field class_uri of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.interchain":
+		panic(fmt.Errorf("field interchain of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.dwn_record_id":
+		panic(fmt.Errorf("field dwn_record_id of message did.v1.DIDAsset is not mutable"))
+	case "did.v1.DIDAsset.attached_at":
+		panic(fmt.Errorf("field attached_at of message did.v1.DIDAsset is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAsset"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DIDAsset) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.DIDAsset.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.class_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.nft_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.owner":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.uri":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.uri_hash":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.class_name":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.class_uri":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.interchain":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.DIDAsset.dwn_record_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDAsset.attached_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDAsset"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DIDAsset) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.DIDAsset", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DIDAsset) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDAsset) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DIDAsset) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DIDAsset) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DIDAsset)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Uri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UriHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassUri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Interchain {
+			n += 2
+		}
+		l = len(x.DwnRecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AttachedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AttachedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DIDAsset)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AttachedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttachedAt))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.DwnRecordId) > 0 {
+			i -= len(x.DwnRecordId)
+			copy(dAtA[i:], x.DwnRecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DwnRecordId)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Interchain {
+			i--
+			if x.Interchain {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ClassUri) > 0 {
+			i -= len(x.ClassUri)
+			copy(dAtA[i:], x.ClassUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassUri)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.ClassName) > 0 {
+			i -= len(x.ClassName)
+			copy(dAtA[i:], x.ClassName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassName)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.UriHash) > 0 {
+			i -= len(x.UriHash)
+			copy(dAtA[i:], x.UriHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UriHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DIDAsset)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDAsset: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UriHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassUri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassUri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interchain", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Interchain = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DwnRecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DwnRecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttachedAt", wireType)
+				}
+				x.AttachedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AttachedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VerifiableCredential_2_list)(nil)
+
+type _VerifiableCredential_2_list struct {
+	list *[]string
+}
+
+func (x *_VerifiableCredential_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VerifiableCredential_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VerifiableCredential_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VerifiableCredential_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VerifiableCredential_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VerifiableCredential at list field Context as it is not of Message kind"))
+}
+
+func (x *_VerifiableCredential_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VerifiableCredential_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VerifiableCredential_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VerifiableCredential_3_list)(nil)
+
+type _VerifiableCredential_3_list struct {
+	list *[]string
+}
+
+func (x *_VerifiableCredential_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VerifiableCredential_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VerifiableCredential_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VerifiableCredential_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VerifiableCredential_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VerifiableCredential at list field CredentialKinds as it is not of Message kind"))
+}
+
+func (x *_VerifiableCredential_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VerifiableCredential_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VerifiableCredential_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VerifiableCredential_8_list)(nil)
+
+type _VerifiableCredential_8_list struct {
+	list *[]*CredentialProof
+}
+
+func (x *_VerifiableCredential_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VerifiableCredential_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VerifiableCredential_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CredentialProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VerifiableCredential_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CredentialProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VerifiableCredential_8_list) AppendMutable() protoreflect.Value {
+	v := new(CredentialProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VerifiableCredential_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VerifiableCredential_8_list) NewElement() protoreflect.Value {
+	v := new(CredentialProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VerifiableCredential_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VerifiableCredential                    protoreflect.MessageDescriptor
+	fd_VerifiableCredential_id                 protoreflect.FieldDescriptor
+	fd_VerifiableCredential_context            protoreflect.FieldDescriptor
+	fd_VerifiableCredential_credential_kinds   protoreflect.FieldDescriptor
+	fd_VerifiableCredential_issuer             protoreflect.FieldDescriptor
+	fd_VerifiableCredential_issuance_date      protoreflect.FieldDescriptor
+	fd_VerifiableCredential_expiration_date    protoreflect.FieldDescriptor
+	fd_VerifiableCredential_credential_subject protoreflect.FieldDescriptor
+	fd_VerifiableCredential_proof              protoreflect.FieldDescriptor
+	fd_VerifiableCredential_credential_status  protoreflect.FieldDescriptor
+	fd_VerifiableCredential_subject            protoreflect.FieldDescriptor
+	fd_VerifiableCredential_issued_at          protoreflect.FieldDescriptor
+	fd_VerifiableCredential_expires_at         protoreflect.FieldDescriptor
+	fd_VerifiableCredential_revoked            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_state_proto_init()
+	md_VerifiableCredential = File_did_v1_state_proto.Messages().ByName("VerifiableCredential")
+	fd_VerifiableCredential_id = md_VerifiableCredential.Fields().ByName("id")
+	fd_VerifiableCredential_context = md_VerifiableCredential.Fields().ByName("context")
+	fd_VerifiableCredential_credential_kinds = md_VerifiableCredential.Fields().ByName("credential_kinds")
+	fd_VerifiableCredential_issuer = md_VerifiableCredential.Fields().ByName("issuer")
+	fd_VerifiableCredential_issuance_date = md_VerifiableCredential.Fields().ByName("issuance_date")
+	fd_VerifiableCredential_expiration_date = md_VerifiableCredential.Fields().ByName("expiration_date")
+	fd_VerifiableCredential_credential_subject = md_VerifiableCredential.Fields().ByName("credential_subject")
+	fd_VerifiableCredential_proof = md_VerifiableCredential.Fields().ByName("proof")
+	fd_VerifiableCredential_credential_status = md_VerifiableCredential.Fields().ByName("credential_status")
+	fd_VerifiableCredential_subject = md_VerifiableCredential.Fields().ByName("subject")
+	fd_VerifiableCredential_issued_at = md_VerifiableCredential.Fields().ByName("issued_at")
+	fd_VerifiableCredential_expires_at = md_VerifiableCredential.Fields().ByName("expires_at")
+	fd_VerifiableCredential_revoked = md_VerifiableCredential.Fields().ByName("revoked")
+}
+
+var _ protoreflect.Message = (*fastReflection_VerifiableCredential)(nil)
+
+type fastReflection_VerifiableCredential VerifiableCredential
+
+func (x *VerifiableCredential) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VerifiableCredential)(x)
+}
+
+func (x *VerifiableCredential) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_state_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VerifiableCredential_messageType fastReflection_VerifiableCredential_messageType
+var _ protoreflect.MessageType = fastReflection_VerifiableCredential_messageType{}
+
+type fastReflection_VerifiableCredential_messageType struct{}
+
+func (x fastReflection_VerifiableCredential_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VerifiableCredential)(nil)
+}
+func (x fastReflection_VerifiableCredential_messageType) New() protoreflect.Message {
+	return new(fastReflection_VerifiableCredential)
+}
+func (x fastReflection_VerifiableCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerifiableCredential
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VerifiableCredential) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerifiableCredential
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VerifiableCredential) Type() protoreflect.MessageType {
+	return _fastReflection_VerifiableCredential_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VerifiableCredential) New() protoreflect.Message {
+	return new(fastReflection_VerifiableCredential)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VerifiableCredential) Interface() protoreflect.ProtoMessage {
+	return (*VerifiableCredential)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VerifiableCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_VerifiableCredential_id, value) {
+			return
+		}
+	}
+	if len(x.Context) != 0 {
+		value := protoreflect.ValueOfList(&_VerifiableCredential_2_list{list: &x.Context})
+		if !f(fd_VerifiableCredential_context, value) {
+			return
+		}
+	}
+	if len(x.CredentialKinds) != 0 {
+		value := protoreflect.ValueOfList(&_VerifiableCredential_3_list{list: &x.CredentialKinds})
+		if !f(fd_VerifiableCredential_credential_kinds, value) {
+			return
+		}
+	}
+	if x.Issuer != "" {
+		value := protoreflect.ValueOfString(x.Issuer)
+		if !f(fd_VerifiableCredential_issuer, value) {
+			return
+		}
+	}
+	if x.IssuanceDate != "" {
+		value := protoreflect.ValueOfString(x.IssuanceDate)
+		if !f(fd_VerifiableCredential_issuance_date, value) {
+			return
+		}
+	}
+	if x.ExpirationDate != "" {
+		value := protoreflect.ValueOfString(x.ExpirationDate)
+		if !f(fd_VerifiableCredential_expiration_date, value) {
+			return
+		}
+	}
+	if len(x.CredentialSubject) != 0 {
+		value := protoreflect.ValueOfBytes(x.CredentialSubject)
+		if !f(fd_VerifiableCredential_credential_subject, value) {
+			return
+		}
+	}
+	if len(x.Proof) != 0 {
+		value := protoreflect.ValueOfList(&_VerifiableCredential_8_list{list: &x.Proof})
+		if !f(fd_VerifiableCredential_proof, value) {
+			return
+		}
+	}
+	if x.CredentialStatus != nil {
+		value := protoreflect.ValueOfMessage(x.CredentialStatus.ProtoReflect())
+		if !f(fd_VerifiableCredential_credential_status, value) {
+			return
+		}
+	}
+	if x.Subject != "" {
+		value := protoreflect.ValueOfString(x.Subject)
+		if !f(fd_VerifiableCredential_subject, value) {
+			return
+		}
+	}
+	if x.IssuedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IssuedAt)
+		if !f(fd_VerifiableCredential_issued_at, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_VerifiableCredential_expires_at, value) {
+			return
+		}
+	}
+	if x.Revoked != false {
+		value := protoreflect.ValueOfBool(x.Revoked)
+		if !f(fd_VerifiableCredential_revoked, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VerifiableCredential) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.VerifiableCredential.id":
+		return x.Id != ""
+	case "did.v1.VerifiableCredential.context":
+		return len(x.Context) != 0
+	case "did.v1.VerifiableCredential.credential_kinds":
+		return len(x.CredentialKinds) != 0
+	case "did.v1.VerifiableCredential.issuer":
+		return x.Issuer != ""
+	case "did.v1.VerifiableCredential.issuance_date":
+		return x.IssuanceDate != ""
+	case "did.v1.VerifiableCredential.expiration_date":
+		return x.ExpirationDate != ""
+	case "did.v1.VerifiableCredential.credential_subject":
+		return len(x.CredentialSubject) != 0
+	case "did.v1.VerifiableCredential.proof":
+		return len(x.Proof) != 0
+	case "did.v1.VerifiableCredential.credential_status":
+		return x.CredentialStatus != nil
+	case "did.v1.VerifiableCredential.subject":
+		return x.Subject != ""
+	case "did.v1.VerifiableCredential.issued_at":
+		return x.IssuedAt != int64(0)
+	case "did.v1.VerifiableCredential.expires_at":
+		return x.ExpiresAt != int64(0)
+	case "did.v1.VerifiableCredential.revoked":
+		return x.Revoked != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifiableCredential) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.VerifiableCredential.id":
+		x.Id = ""
+	case "did.v1.VerifiableCredential.context":
+		x.Context = nil
+	case "did.v1.VerifiableCredential.credential_kinds":
+		x.CredentialKinds = nil
+	case "did.v1.VerifiableCredential.issuer":
+		x.Issuer = ""
+	case "did.v1.VerifiableCredential.issuance_date":
+		x.IssuanceDate = ""
+	case "did.v1.VerifiableCredential.expiration_date":
+		x.ExpirationDate = ""
+	case "did.v1.VerifiableCredential.credential_subject":
+		x.CredentialSubject = nil
+	case "did.v1.VerifiableCredential.proof":
+		x.Proof = nil
+	case "did.v1.VerifiableCredential.credential_status":
+		x.CredentialStatus = nil
+	case "did.v1.VerifiableCredential.subject":
+		x.Subject = ""
+	case "did.v1.VerifiableCredential.issued_at":
+		x.IssuedAt = int64(0)
+	case "did.v1.VerifiableCredential.expires_at":
+		x.ExpiresAt = int64(0)
+	case "did.v1.VerifiableCredential.revoked":
+		x.Revoked = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VerifiableCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.VerifiableCredential.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "did.v1.VerifiableCredential.context":
+		if len(x.Context) == 0 {
+			return protoreflect.ValueOfList(&_VerifiableCredential_2_list{})
+		}
+		listValue := &_VerifiableCredential_2_list{list: &x.Context}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.VerifiableCredential.credential_kinds":
+		if len(x.CredentialKinds) == 0 {
+			return protoreflect.ValueOfList(&_VerifiableCredential_3_list{})
+		}
+		listValue := &_VerifiableCredential_3_list{list: &x.CredentialKinds}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.VerifiableCredential.issuer":
+		value := x.Issuer
+		return protoreflect.ValueOfString(value)
+	case "did.v1.VerifiableCredential.issuance_date":
+		value := x.IssuanceDate
+		return protoreflect.ValueOfString(value)
+	case "did.v1.VerifiableCredential.expiration_date":
+		value := x.ExpirationDate
+		return protoreflect.ValueOfString(value)
+	case "did.v1.VerifiableCredential.credential_subject":
+		value := x.CredentialSubject
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.VerifiableCredential.proof":
+		if len(x.Proof) == 0 {
+			return protoreflect.ValueOfList(&_VerifiableCredential_8_list{})
+		}
+		listValue := &_VerifiableCredential_8_list{list: &x.Proof}
+		return protoreflect.ValueOfList(listValue)
+	case "did.v1.VerifiableCredential.credential_status":
+		value := x.CredentialStatus
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.VerifiableCredential.subject":
+		value := x.Subject
+		return protoreflect.ValueOfString(value)
+	case "did.v1.VerifiableCredential.issued_at":
+		value := x.IssuedAt
+		return protoreflect.ValueOfInt64(value)
+	case "did.v1.VerifiableCredential.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	case "did.v1.VerifiableCredential.revoked":
+		value := x.Revoked
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifiableCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.VerifiableCredential.id":
+		x.Id = value.Interface().(string)
+	case "did.v1.VerifiableCredential.context":
+		lv := value.List()
+		clv := lv.(*_VerifiableCredential_2_list)
+		x.Context = *clv.list
+	case "did.v1.VerifiableCredential.credential_kinds":
+		lv := value.List()
+		clv := lv.(*_VerifiableCredential_3_list)
+		x.CredentialKinds = *clv.list
+	case "did.v1.VerifiableCredential.issuer":
+		x.Issuer = value.Interface().(string)
+	case "did.v1.VerifiableCredential.issuance_date":
+		x.IssuanceDate = value.Interface().(string)
+	case "did.v1.VerifiableCredential.expiration_date":
+		x.ExpirationDate = value.Interface().(string)
+	case "did.v1.VerifiableCredential.credential_subject":
+		x.CredentialSubject = value.Bytes()
+	case "did.v1.VerifiableCredential.proof":
+		lv := value.List()
+		clv := lv.(*_VerifiableCredential_8_list)
+		x.Proof = *clv.list
+	case "did.v1.VerifiableCredential.credential_status":
+		x.CredentialStatus = value.Message().Interface().(*CredentialStatus)
+	case "did.v1.VerifiableCredential.subject":
+		x.Subject = value.Interface().(string)
+	case "did.v1.VerifiableCredential.issued_at":
+		x.IssuedAt = value.Int()
+	case "did.v1.VerifiableCredential.expires_at":
+		x.ExpiresAt = value.Int()
+	case "did.v1.VerifiableCredential.revoked":
+		x.Revoked = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifiableCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.VerifiableCredential.context":
+		if x.Context == nil {
+			x.Context = []string{}
+		}
+		value := &_VerifiableCredential_2_list{list: &x.Context}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.VerifiableCredential.credential_kinds":
+		if x.CredentialKinds == nil {
+			x.CredentialKinds = []string{}
+		}
+		value := &_VerifiableCredential_3_list{list: &x.CredentialKinds}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.VerifiableCredential.proof":
+		if x.Proof == nil {
+			x.Proof = []*CredentialProof{}
+		}
+		value := &_VerifiableCredential_8_list{list: &x.Proof}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.VerifiableCredential.credential_status":
+		if x.CredentialStatus == nil {
+			x.CredentialStatus = new(CredentialStatus)
+		}
+		return protoreflect.ValueOfMessage(x.CredentialStatus.ProtoReflect())
+	case "did.v1.VerifiableCredential.id":
+		panic(fmt.Errorf("field id of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.issuance_date":
+		panic(fmt.Errorf("field issuance_date of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.expiration_date":
+		panic(fmt.Errorf("field expiration_date of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.credential_subject":
+		panic(fmt.Errorf("field credential_subject of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.subject":
+		panic(fmt.Errorf("field subject of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.issued_at":
+		panic(fmt.Errorf("field issued_at of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.expires_at":
+		panic(fmt.Errorf("field expires_at of message did.v1.VerifiableCredential is not mutable"))
+	case "did.v1.VerifiableCredential.revoked":
+		panic(fmt.Errorf("field revoked of message did.v1.VerifiableCredential is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VerifiableCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.VerifiableCredential.id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.VerifiableCredential.context":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VerifiableCredential_2_list{list: &list})
+	case "did.v1.VerifiableCredential.credential_kinds":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VerifiableCredential_3_list{list: &list})
+	case "did.v1.VerifiableCredential.issuer":
+		return protoreflect.ValueOfString("")
+	case "did.v1.VerifiableCredential.issuance_date":
+		return protoreflect.ValueOfString("")
+	case "did.v1.VerifiableCredential.expiration_date":
+		return protoreflect.ValueOfString("")
+	case "did.v1.VerifiableCredential.credential_subject":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.VerifiableCredential.proof":
+		list := []*CredentialProof{}
+		return protoreflect.ValueOfList(&_VerifiableCredential_8_list{list: &list})
+	case "did.v1.VerifiableCredential.credential_status":
+		m := new(CredentialStatus)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.VerifiableCredential.subject":
+		return protoreflect.ValueOfString("")
+	case "did.v1.VerifiableCredential.issued_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "did.v1.VerifiableCredential.expires_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "did.v1.VerifiableCredential.revoked":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.VerifiableCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.VerifiableCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VerifiableCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.VerifiableCredential", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VerifiableCredential) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifiableCredential) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VerifiableCredential) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VerifiableCredential) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VerifiableCredential)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Context) > 0 {
+			for _, s := range x.Context {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CredentialKinds) > 0 {
+			for _, s := range x.CredentialKinds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Issuer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IssuanceDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpirationDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CredentialSubject)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Proof) > 0 {
+			for _, e := range x.Proof {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CredentialStatus != nil {
+			l = options.Size(x.CredentialStatus)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Subject)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IssuedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuedAt))
+		}
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		if x.Revoked {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VerifiableCredential)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Revoked {
+			i--
+			if x.Revoked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IssuedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuedAt))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Subject) > 0 {
+			i -= len(x.Subject)
+			copy(dAtA[i:], x.Subject)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Subject)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.CredentialStatus != nil {
+			encoded, err := options.Marshal(x.CredentialStatus)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Proof) > 0 {
+			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proof[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.CredentialSubject) > 0 {
+			i -= len(x.CredentialSubject)
+			copy(dAtA[i:], x.CredentialSubject)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialSubject)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ExpirationDate) > 0 {
+			i -= len(x.ExpirationDate)
+			copy(dAtA[i:], x.ExpirationDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpirationDate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.IssuanceDate) > 0 {
+			i -= len(x.IssuanceDate)
+			copy(dAtA[i:], x.IssuanceDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IssuanceDate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CredentialKinds) > 0 {
+			for iNdEx := len(x.CredentialKinds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CredentialKinds[iNdEx])
+				copy(dAtA[i:], x.CredentialKinds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialKinds[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Context) > 0 {
+			for iNdEx := len(x.Context) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Context[iNdEx])
+				copy(dAtA[i:], x.Context[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Context[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VerifiableCredential)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerifiableCredential: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Context = append(x.Context, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialKinds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialKinds = append(x.CredentialKinds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Issuer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IssuanceDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpirationDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialSubject", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialSubject = append(x.CredentialSubject[:0], dAtA[iNdEx:postIndex]...)
+				if x.CredentialSubject == nil {
+					x.CredentialSubject = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proof = append(x.Proof, &CredentialProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof[len(x.Proof)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialStatus", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CredentialStatus == nil {
+					x.CredentialStatus = &CredentialStatus{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CredentialStatus); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subject = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+				}
+				x.IssuedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				x.ExpiresAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revoked = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DIDController                protoreflect.MessageDescriptor
+	fd_DIDController_id             protoreflect.FieldDescriptor
+	fd_DIDController_did            protoreflect.FieldDescriptor
+	fd_DIDController_controller_did protoreflect.FieldDescriptor
+	fd_DIDController_added_at       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_state_proto_init()
+	md_DIDController = File_did_v1_state_proto.Messages().ByName("DIDController")
+	fd_DIDController_id = md_DIDController.Fields().ByName("id")
+	fd_DIDController_did = md_DIDController.Fields().ByName("did")
+	fd_DIDController_controller_did = md_DIDController.Fields().ByName("controller_did")
+	fd_DIDController_added_at = md_DIDController.Fields().ByName("added_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_DIDController)(nil)
+
+type fastReflection_DIDController DIDController
+
+func (x *DIDController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DIDController)(x)
+}
+
+func (x *DIDController) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_state_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DIDController_messageType fastReflection_DIDController_messageType
+var _ protoreflect.MessageType = fastReflection_DIDController_messageType{}
+
+type fastReflection_DIDController_messageType struct{}
+
+func (x fastReflection_DIDController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DIDController)(nil)
+}
+func (x fastReflection_DIDController_messageType) New() protoreflect.Message {
+	return new(fastReflection_DIDController)
+}
+func (x fastReflection_DIDController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DIDController) Descriptor() protoreflect.MessageDescriptor {
+	return md_DIDController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DIDController) Type() protoreflect.MessageType {
+	return _fastReflection_DIDController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DIDController) New() protoreflect.Message {
+	return new(fastReflection_DIDController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DIDController) Interface() protoreflect.ProtoMessage {
+	return (*DIDController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DIDController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DIDController_id, value) {
+			return
+		}
+	}
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_DIDController_did, value) {
+			return
+		}
+	}
+	if x.ControllerDid != "" {
+		value := protoreflect.ValueOfString(x.ControllerDid)
+		if !f(fd_DIDController_controller_did, value) {
+			return
+		}
+	}
+	if x.AddedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AddedAt)
+		if !f(fd_DIDController_added_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DIDController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.DIDController.id":
+		return x.Id != uint64(0)
+	case "did.v1.DIDController.did":
+		return x.Did != ""
+	case "did.v1.DIDController.controller_did":
+		return x.ControllerDid != ""
+	case "did.v1.DIDController.added_at":
+		return x.AddedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.DIDController.id":
+		x.Id = uint64(0)
+	case "did.v1.DIDController.did":
+		x.Did = ""
+	case "did.v1.DIDController.controller_did":
+		x.ControllerDid = ""
+	case "did.v1.DIDController.added_at":
+		x.AddedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DIDController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.DIDController.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "did.v1.DIDController.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDController.controller_did":
+		value := x.ControllerDid
+		return protoreflect.ValueOfString(value)
+	case "did.v1.DIDController.added_at":
+		value := x.AddedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.DIDController.id":
+		x.Id = value.Uint()
+	case "did.v1.DIDController.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.DIDController.controller_did":
+		x.ControllerDid = value.Interface().(string)
+	case "did.v1.DIDController.added_at":
+		x.AddedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.DIDController.id":
+		panic(fmt.Errorf("field id of message did.v1.DIDController is not mutable"))
+	case "did.v1.DIDController.did":
+		panic(fmt.Errorf("field did of message did.v1.DIDController is not mutable"))
+	case "did.v1.DIDController.controller_did":
+		panic(fmt.Errorf("field controller_did of message did.v1.DIDController is not mutable"))
+	case "did.v1.DIDController.added_at":
+		panic(fmt.Errorf("field added_at of message did.v1.DIDController is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DIDController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.DIDController.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "did.v1.DIDController.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDController.controller_did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.DIDController.added_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.DIDController"))
+		}
+		panic(fmt.Errorf("message did.v1.DIDController does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DIDController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.DIDController", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DIDController) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DIDController) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DIDController) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DIDController) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DIDController)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ControllerDid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DIDController)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AddedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAt))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ControllerDid) > 0 {
+			i -= len(x.ControllerDid)
+			copy(dAtA[i:], x.ControllerDid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ControllerDid)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DIDController)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDController: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DIDController: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerDid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ControllerDid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				}
+				x.AddedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: did/v1/state.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DIDStatus is the lifecycle state of a DID
+type DIDStatus int32
+
+const (
+	// DID_STATUS_UNSPECIFIED is the status of metadata written before statuses
+	// were recorded; the document's deactivated flag applies
+	DIDStatus_DID_STATUS_UNSPECIFIED DIDStatus = 0
+	// DID_STATUS_ACTIVE DIDs can be updated and used to sign
+	DIDStatus_DID_STATUS_ACTIVE DIDStatus = 1
+	// DID_STATUS_DEACTIVATED DIDs resolve but cannot be updated or used to
+	// sign until their controller reactivates them
+	DIDStatus_DID_STATUS_DEACTIVATED DIDStatus = 2
+)
+
+// Enum value maps for DIDStatus.
+var (
+	DIDStatus_name = map[int32]string{
+		0: "DID_STATUS_UNSPECIFIED",
+		1: "DID_STATUS_ACTIVE",
+		2: "DID_STATUS_DEACTIVATED",
+	}
+	DIDStatus_value = map[string]int32{
+		"DID_STATUS_UNSPECIFIED": 0,
+		"DID_STATUS_ACTIVE":      1,
+		"DID_STATUS_DEACTIVATED": 2,
+	}
+)
+
+func (x DIDStatus) Enum() *DIDStatus {
+	p := new(DIDStatus)
+	*p = x
+	return p
+}
+
+func (x DIDStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DIDStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_did_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (DIDStatus) Type() protoreflect.EnumType {
+	return &file_did_v1_state_proto_enumTypes[0]
+}
+
+func (x DIDStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DIDStatus.Descriptor instead.
+func (DIDStatus) EnumDescriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+// Authentication is the authentication method to be used by the DID.
+type Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the assertion
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// The authentication of the DID
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// Origin of the authentication
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// string is the verification method
+	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
+	// AssertionKind is the assertion type
+	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
+	// CreationBlock is the block number of the creation of the authentication
+	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
 }
 
 func (x *Authentication) Reset() {
 	*x = Authentication{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[0]
+		mi := &file_did_v1_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authentication) ProtoMessage() {}
+
+// Deprecated: Use Authentication.ProtoReflect.Descriptor instead.
+func (*Authentication) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Authentication) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Authentication) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *Authentication) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Authentication) GetPublicKeyBase64() string {
+	if x != nil {
+		return x.PublicKeyBase64
+	}
+	return ""
+}
+
+func (x *Authentication) GetDidKind() string {
+	if x != nil {
+		return x.DidKind
+	}
+	return ""
+}
+
+func (x *Authentication) GetCreationBlock() int64 {
+	if x != nil {
+		return x.CreationBlock
+	}
+	return 0
+}
+
+// Assertion is the assertion method to be used by the DID.
+type Assertion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the assertion
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// The authentication of the DID
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// Origin of the authentication
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// string is the verification method
+	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
+	// DIDKind is the DID type
+	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
+	// CreationBlock is the block number of the creation of the authentication
+	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+}
+
+func (x *Assertion) Reset() {
+	*x = Assertion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assertion) ProtoMessage() {}
+
+// Deprecated: Use Assertion.ProtoReflect.Descriptor instead.
+func (*Assertion) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Assertion) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Assertion) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *Assertion) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Assertion) GetPublicKeyBase64() string {
+	if x != nil {
+		return x.PublicKeyBase64
+	}
+	return ""
+}
+
+func (x *Assertion) GetDidKind() string {
+	if x != nil {
+		return x.DidKind
+	}
+	return ""
+}
+
+func (x *Assertion) GetCreationBlock() int64 {
+	if x != nil {
+		return x.CreationBlock
+	}
+	return 0
+}
+
+// Controller is the controller method to be used by the DID.
+type Controller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the assertion
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// The authentication of the DID
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Origin of the authentication
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// string is the verification method
+	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
+	// DIDKind is the DID type
+	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
+	// CreationBlock is the block number of the creation of the authentication
+	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+}
+
+func (x *Controller) Reset() {
+	*x = Controller{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_state_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Controller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Controller) ProtoMessage() {}
+
+// Deprecated: Use Controller.ProtoReflect.Descriptor instead.
+func (*Controller) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Controller) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Controller) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Controller) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Controller) GetPublicKeyBase64() string {
+	if x != nil {
+		return x.PublicKeyBase64
+	}
+	return ""
+}
+
+func (x *Controller) GetDidKind() string {
+	if x != nil {
+		return x.DidKind
+	}
+	return ""
+}
+
+func (x *Controller) GetCreationBlock() int64 {
+	if x != nil {
+		return x.CreationBlock
+	}
+	return 0
+}
+
+// Delegation is usually an external blockchain account that is used to sign
+// transactions on behalf of the DID
+type Delegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the assertion
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// The authentication of the DID
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// Origin of the authentication
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// string is the verification method
+	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
+	// DIDKind is the DID type
+	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
+	// CreationBlock is the block number of the creation of the authentication
+	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+}
+
+func (x *Delegation) Reset() {
+	*x = Delegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_state_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Authentication) String() string {
+func (x *Delegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Authentication) ProtoMessage() {}
+func (*Delegation) ProtoMessage() {}
 
-// Deprecated: Use Authentication.ProtoReflect.Descriptor instead.
-func (*Authentication) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Delegation) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Delegation) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *Delegation) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Delegation) GetPublicKeyBase64() string {
+	if x != nil {
+		return x.PublicKeyBase64
+	}
+	return ""
+}
+
+func (x *Delegation) GetDidKind() string {
+	if x != nil {
+		return x.DidKind
+	}
+	return ""
+}
+
+func (x *Delegation) GetCreationBlock() int64 {
+	if x != nil {
+		return x.CreationBlock
+	}
+	return 0
+}
+
+// Invocation is usually a smart contract that is used to sign transactions on
+// behalf of the DID
+type Invocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the assertion
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// The authentication of the DID
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// Origin of the authentication
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// string is the verification method
+	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
+	// DIDKind is the DID type
+	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
+	// CreationBlock is the block number of the creation of the authentication
+	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+}
+
+func (x *Invocation) Reset() {
+	*x = Invocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invocation) ProtoMessage() {}
+
+// Deprecated: Use Invocation.ProtoReflect.Descriptor instead.
+func (*Invocation) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Invocation) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Invocation) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *Invocation) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Invocation) GetPublicKeyBase64() string {
+	if x != nil {
+		return x.PublicKeyBase64
+	}
+	return ""
+}
+
+func (x *Invocation) GetDidKind() string {
+	if x != nil {
+		return x.DidKind
+	}
+	return ""
+}
+
+func (x *Invocation) GetCreationBlock() int64 {
+	if x != nil {
+		return x.CreationBlock
+	}
+	return 0
+}
+
+// DIDDocument represents a W3C compliant DID Document
+type DIDDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the DID that is the subject of this DID Document (REQUIRED)
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// primary_controller identifies the primary entity that controls the DID
+	// document (OPTIONAL)
+	PrimaryController string `protobuf:"bytes,2,opt,name=primary_controller,json=primaryController,proto3" json:"primary_controller,omitempty"`
+	// alsoKnownAs expresses other identifiers for the DID subject (OPTIONAL)
+	AlsoKnownAs []string `protobuf:"bytes,3,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	// verificationMethod expresses verification methods (OPTIONAL)
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// authentication expresses authentication verification relationships
+	// (OPTIONAL)
+	Authentication []*VerificationMethodReference `protobuf:"bytes,5,rep,name=authentication,proto3" json:"authentication,omitempty"`
+	// assertionMethod expresses assertion verification relationships (OPTIONAL)
+	AssertionMethod []*VerificationMethodReference `protobuf:"bytes,6,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	// keyAgreement expresses key agreement verification relationships (OPTIONAL)
+	KeyAgreement []*VerificationMethodReference `protobuf:"bytes,7,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// capabilityInvocation expresses capability invocation verification
+	// relationships (OPTIONAL)
+	CapabilityInvocation []*VerificationMethodReference `protobuf:"bytes,8,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	// capabilityDelegation expresses capability delegation verification
+	// relationships (OPTIONAL)
+	CapabilityDelegation []*VerificationMethodReference `protobuf:"bytes,9,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	// service expresses service endpoints (OPTIONAL)
+	Service []*Service `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
+	// Block height when the DID document was created
+	CreatedAt int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Block height when the DID document was last updated
+	UpdatedAt int64 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Whether the DID document is deactivated
+	Deactivated bool `protobuf:"varint,13,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	// Version number for the DID document
+	Version uint64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DIDDocument) Reset() {
+	*x = DIDDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DIDDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DIDDocument) ProtoMessage() {}
+
+// Deprecated: Use DIDDocument.ProtoReflect.Descriptor instead.
+func (*DIDDocument) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DIDDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DIDDocument) GetPrimaryController() string {
+	if x != nil {
+		return x.PrimaryController
+	}
+	return ""
+}
+
+func (x *DIDDocument) GetAlsoKnownAs() []string {
+	if x != nil {
+		return x.AlsoKnownAs
+	}
+	return nil
+}
+
+func (x *DIDDocument) GetVerificationMethod() []*VerificationMethod {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return nil
+}
+
+func (x *DIDDocument) GetAuthentication() []*VerificationMethodReference {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *DIDDocument) GetAssertionMethod() []*VerificationMethodReference {
+	if x != nil {
+		return x.AssertionMethod
+	}
+	return nil
+}
+
+func (x *DIDDocument) GetKeyAgreement() []*VerificationMethodReference {
+	if x != nil {
+		return x.KeyAgreement
+	}
+	return nil
+}
+
+func (x *DIDDocument) GetCapabilityInvocation() []*VerificationMethodReference {
+	if x != nil {
+		return x.CapabilityInvocation
+	}
+	return nil
 }
 
-func (x *Authentication) GetDid() string {
+func (x *DIDDocument) GetCapabilityDelegation() []*VerificationMethodReference {
 	if x != nil {
-		return x.Did
+		return x.CapabilityDelegation
 	}
-	return ""
+	return nil
 }
 
-func (x *Authentication) GetController() string {
+func (x *DIDDocument) GetService() []*Service {
 	if x != nil {
-		return x.Controller
+		return x.Service
 	}
-	return ""
+	return nil
 }
 
-func (x *Authentication) GetSubject() string {
+func (x *DIDDocument) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Subject
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Authentication) GetPublicKeyBase64() string {
+func (x *DIDDocument) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.PublicKeyBase64
+		return x.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Authentication) GetDidKind() string {
+func (x *DIDDocument) GetDeactivated() bool {
 	if x != nil {
-		return x.DidKind
+		return x.Deactivated
 	}
-	return ""
+	return false
 }
 
-func (x *Authentication) GetCreationBlock() int64 {
+func (x *DIDDocument) GetVersion() uint64 {
 	if x != nil {
-		return x.CreationBlock
+		return x.Version
 	}
 	return 0
 }
 
-// Assertion is the assertion method to be used by the DID.
-type Assertion struct {
+// DIDDocumentMetadata contains metadata about the DID document
+type DIDDocumentMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique identifier of the assertion
+	// did is the DID this metadata belongs to
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// The authentication of the DID
-	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
-	// Origin of the authentication
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	// string is the verification method
-	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
-	// DIDKind is the DID type
-	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
-	// CreationBlock is the block number of the creation of the authentication
-	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+	// created is when the DID was created
+	Created int64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// updated is when the DID was last updated
+	Updated int64 `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	// deactivated is when the DID was deactivated (if applicable)
+	Deactivated int64 `protobuf:"varint,4,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	// version_id is the version identifier
+	VersionId string `protobuf:"bytes,5,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// next_update is when the next update is scheduled (if applicable)
+	NextUpdate int64 `protobuf:"varint,6,opt,name=next_update,json=nextUpdate,proto3" json:"next_update,omitempty"`
+	// next_version_id is the next version identifier (if applicable)
+	NextVersionId string `protobuf:"bytes,7,opt,name=next_version_id,json=nextVersionId,proto3" json:"next_version_id,omitempty"`
+	// equivalentId lists equivalent DIDs
+	EquivalentId []string `protobuf:"bytes,8,rep,name=equivalent_id,json=equivalentId,proto3" json:"equivalent_id,omitempty"`
+	// canonicalId is the canonical DID
+	CanonicalId string `protobuf:"bytes,9,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	// status is the lifecycle state of the DID
+	Status DIDStatus `protobuf:"varint,10,opt,name=status,proto3,enum=did.v1.DIDStatus" json:"status,omitempty"`
 }
 
-func (x *Assertion) Reset() {
-	*x = Assertion{}
+func (x *DIDDocumentMetadata) Reset() {
+	*x = DIDDocumentMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[1]
+		mi := &file_did_v1_state_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Assertion) String() string {
+func (x *DIDDocumentMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assertion) ProtoMessage() {}
+func (*DIDDocumentMetadata) ProtoMessage() {}
 
-// Deprecated: Use Assertion.ProtoReflect.Descriptor instead.
-func (*Assertion) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DIDDocumentMetadata.ProtoReflect.Descriptor instead.
+func (*DIDDocumentMetadata) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Assertion) GetDid() string {
+func (x *DIDDocumentMetadata) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *Assertion) GetController() string {
-	if x != nil {
-		return x.Controller
-	}
-	return ""
-}
-
-func (x *Assertion) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *Assertion) GetPublicKeyBase64() string {
+func (x *DIDDocumentMetadata) GetCreated() int64 {
 	if x != nil {
-		return x.PublicKeyBase64
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-func (x *Assertion) GetDidKind() string {
+func (x *DIDDocumentMetadata) GetUpdated() int64 {
 	if x != nil {
-		return x.DidKind
+		return x.Updated
 	}
-	return ""
+	return 0
 }
 
-func (x *Assertion) GetCreationBlock() int64 {
+func (x *DIDDocumentMetadata) GetDeactivated() int64 {
 	if x != nil {
-		return x.CreationBlock
+		return x.Deactivated
 	}
 	return 0
 }
 
-// Controller is the controller method to be used by the DID.
-type Controller struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The unique identifier of the assertion
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// The authentication of the DID
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// Origin of the authentication
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	// string is the verification method
-	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
-	// DIDKind is the DID type
-	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
-	// CreationBlock is the block number of the creation of the authentication
-	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
-}
-
-func (x *Controller) Reset() {
-	*x = Controller{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Controller) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Controller) ProtoMessage() {}
-
-// Deprecated: Use Controller.ProtoReflect.Descriptor instead.
-func (*Controller) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *Controller) GetDid() string {
+func (x *DIDDocumentMetadata) GetVersionId() string {
 	if x != nil {
-		return x.Did
+		return x.VersionId
 	}
 	return ""
 }
 
-func (x *Controller) GetAddress() string {
+func (x *DIDDocumentMetadata) GetNextUpdate() int64 {
 	if x != nil {
-		return x.Address
+		return x.NextUpdate
 	}
-	return ""
+	return 0
 }
 
-func (x *Controller) GetSubject() string {
+func (x *DIDDocumentMetadata) GetNextVersionId() string {
 	if x != nil {
-		return x.Subject
+		return x.NextVersionId
 	}
 	return ""
 }
 
-func (x *Controller) GetPublicKeyBase64() string {
+func (x *DIDDocumentMetadata) GetEquivalentId() []string {
 	if x != nil {
-		return x.PublicKeyBase64
+		return x.EquivalentId
 	}
-	return ""
+	return nil
 }
 
-func (x *Controller) GetDidKind() string {
+func (x *DIDDocumentMetadata) GetCanonicalId() string {
 	if x != nil {
-		return x.DidKind
+		return x.CanonicalId
 	}
 	return ""
 }
 
-func (x *Controller) GetCreationBlock() int64 {
+func (x *DIDDocumentMetadata) GetStatus() DIDStatus {
 	if x != nil {
-		return x.CreationBlock
+		return x.Status
 	}
-	return 0
+	return DIDStatus_DID_STATUS_UNSPECIFIED
 }
 
-// Delegation is usually an external blockchain account that is used to sign
-// transactions on behalf of the DID
-type Delegation struct {
+// DIDDocumentVersion is a DID document as it was after one change. Every
+// change to a document is stored as a new version, so earlier versions can be
+// resolved by versionId or versionTime.
+type DIDDocumentVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique identifier of the assertion
+	// did is the DID the document belongs to
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// The authentication of the DID
-	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
-	// Origin of the authentication
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	// string is the verification method
-	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
-	// DIDKind is the DID type
-	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
-	// CreationBlock is the block number of the creation of the authentication
-	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
-}
-
-func (x *Delegation) Reset() {
-	*x = Delegation{}
+	// version_id is the document's version number, starting at 1
+	VersionId uint64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// version_time is the block time the version was written, in unix seconds
+	VersionTime int64 `protobuf:"varint,3,opt,name=version_time,json=versionTime,proto3" json:"version_time,omitempty"`
+	// block_height is the height the version was written at
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// document is the DID document at this version
+	Document *DIDDocument `protobuf:"bytes,5,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *DIDDocumentVersion) Reset() {
+	*x = DIDDocumentVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[3]
+		mi := &file_did_v1_state_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Delegation) String() string {
+func (x *DIDDocumentVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Delegation) ProtoMessage() {}
+func (*DIDDocumentVersion) ProtoMessage() {}
 
-// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
-func (*Delegation) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DIDDocumentVersion.ProtoReflect.Descriptor instead.
+func (*DIDDocumentVersion) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Delegation) GetDid() string {
+func (x *DIDDocumentVersion) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *Delegation) GetController() string {
-	if x != nil {
-		return x.Controller
-	}
-	return ""
-}
-
-func (x *Delegation) GetSubject() string {
+func (x *DIDDocumentVersion) GetVersionId() uint64 {
 	if x != nil {
-		return x.Subject
+		return x.VersionId
 	}
-	return ""
+	return 0
 }
 
-func (x *Delegation) GetPublicKeyBase64() string {
+func (x *DIDDocumentVersion) GetVersionTime() int64 {
 	if x != nil {
-		return x.PublicKeyBase64
+		return x.VersionTime
 	}
-	return ""
+	return 0
 }
 
-func (x *Delegation) GetDidKind() string {
+func (x *DIDDocumentVersion) GetBlockHeight() int64 {
 	if x != nil {
-		return x.DidKind
+		return x.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *Delegation) GetCreationBlock() int64 {
+func (x *DIDDocumentVersion) GetDocument() *DIDDocument {
 	if x != nil {
-		return x.CreationBlock
+		return x.Document
 	}
-	return 0
+	return nil
 }
 
-// Invocation is usually a smart contract that is used to sign transactions on
-// behalf of the DID
-type Invocation struct {
+// UnverifiedCredential is a WebAuthn credential whose origin is no longer
+// verified by x/svc or allowed by the module params. It stays flagged until a
+// later check finds the origin verified again.
+type UnverifiedCredential struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique identifier of the assertion
+	// did is the DID whose document holds the credential
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// The authentication of the DID
-	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
-	// Origin of the authentication
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	// string is the verification method
-	PublicKeyBase64 string `protobuf:"bytes,4,opt,name=public_key_base64,json=publicKeyBase64,proto3" json:"public_key_base64,omitempty"`
-	// DIDKind is the DID type
-	DidKind string `protobuf:"bytes,5,opt,name=did_kind,json=didKind,proto3" json:"did_kind,omitempty"`
-	// CreationBlock is the block number of the creation of the authentication
-	CreationBlock int64 `protobuf:"varint,6,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
-}
-
-func (x *Invocation) Reset() {
-	*x = Invocation{}
+	// verification_method_id is the verification method carrying the credential
+	VerificationMethodId string `protobuf:"bytes,2,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+	// credential_id is the WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// origin is the origin the credential was registered at
+	Origin string `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	// reason is why the origin failed verification
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// flagged_at is the block height the credential was flagged at
+	FlaggedAt int64 `protobuf:"varint,6,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+}
+
+func (x *UnverifiedCredential) Reset() {
+	*x = UnverifiedCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[4]
+		mi := &file_did_v1_state_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Invocation) String() string {
+func (x *UnverifiedCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invocation) ProtoMessage() {}
+func (*UnverifiedCredential) ProtoMessage() {}
 
-// Deprecated: Use Invocation.ProtoReflect.Descriptor instead.
-func (*Invocation) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UnverifiedCredential.ProtoReflect.Descriptor instead.
+func (*UnverifiedCredential) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Invocation) GetDid() string {
+func (x *UnverifiedCredential) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *Invocation) GetController() string {
+func (x *UnverifiedCredential) GetVerificationMethodId() string {
 	if x != nil {
-		return x.Controller
+		return x.VerificationMethodId
 	}
 	return ""
 }
 
-func (x *Invocation) GetSubject() string {
+func (x *UnverifiedCredential) GetCredentialId() string {
 	if x != nil {
-		return x.Subject
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *Invocation) GetPublicKeyBase64() string {
+func (x *UnverifiedCredential) GetOrigin() string {
 	if x != nil {
-		return x.PublicKeyBase64
+		return x.Origin
 	}
 	return ""
 }
 
-func (x *Invocation) GetDidKind() string {
+func (x *UnverifiedCredential) GetReason() string {
 	if x != nil {
-		return x.DidKind
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Invocation) GetCreationBlock() int64 {
+func (x *UnverifiedCredential) GetFlaggedAt() int64 {
 	if x != nil {
-		return x.CreationBlock
+		return x.FlaggedAt
 	}
 	return 0
 }
 
-// DIDDocument represents a W3C compliant DID Document
-type DIDDocument struct {
+// StatusList is a StatusList2021 bitstring of an issuer. Bit i, counted from
+// the most significant bit of the first byte, is set when the credential
+// holding index i of the list is revoked.
+type StatusList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the DID that is the subject of this DID Document (REQUIRED)
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// primary_controller identifies the primary entity that controls the DID
-	// document (OPTIONAL)
-	PrimaryController string `protobuf:"bytes,2,opt,name=primary_controller,json=primaryController,proto3" json:"primary_controller,omitempty"`
-	// alsoKnownAs expresses other identifiers for the DID subject (OPTIONAL)
-	AlsoKnownAs []string `protobuf:"bytes,3,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
-	// verificationMethod expresses verification methods (OPTIONAL)
-	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
-	// authentication expresses authentication verification relationships
-	// (OPTIONAL)
-	Authentication []*VerificationMethodReference `protobuf:"bytes,5,rep,name=authentication,proto3" json:"authentication,omitempty"`
-	// assertionMethod expresses assertion verification relationships (OPTIONAL)
-	AssertionMethod []*VerificationMethodReference `protobuf:"bytes,6,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
-	// keyAgreement expresses key agreement verification relationships (OPTIONAL)
-	KeyAgreement []*VerificationMethodReference `protobuf:"bytes,7,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
-	// capabilityInvocation expresses capability invocation verification
-	// relationships (OPTIONAL)
-	CapabilityInvocation []*VerificationMethodReference `protobuf:"bytes,8,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
-	// capabilityDelegation expresses capability delegation verification
-	// relationships (OPTIONAL)
-	CapabilityDelegation []*VerificationMethodReference `protobuf:"bytes,9,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
-	// service expresses service endpoints (OPTIONAL)
-	Service []*Service `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
-	// Block height when the DID document was created
-	CreatedAt int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Block height when the DID document was last updated
-	UpdatedAt int64 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Whether the DID document is deactivated
-	Deactivated bool `protobuf:"varint,13,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
-	// Version number for the DID document
-	Version uint64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
-}
-
-func (x *DIDDocument) Reset() {
-	*x = DIDDocument{}
+	// issuer is the DID of the issuer the list belongs to
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// list_id numbers the issuer's lists from 1
+	ListId uint64 `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	// purpose is the status purpose, always "revocation"
+	Purpose string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// bitstring is the uncompressed list
+	Bitstring []byte `protobuf:"bytes,4,opt,name=bitstring,proto3" json:"bitstring,omitempty"`
+	// allocated is the number of indexes handed out
+	Allocated uint64 `protobuf:"varint,5,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	// updated_at is the block time of the last change, in unix seconds
+	UpdatedAt int64 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *StatusList) Reset() {
+	*x = StatusList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[5]
+		mi := &file_did_v1_state_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DIDDocument) String() string {
+func (x *StatusList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DIDDocument) ProtoMessage() {}
+func (*StatusList) ProtoMessage() {}
 
-// Deprecated: Use DIDDocument.ProtoReflect.Descriptor instead.
-func (*DIDDocument) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use StatusList.ProtoReflect.Descriptor instead.
+func (*StatusList) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DIDDocument) GetId() string {
+func (x *StatusList) GetIssuer() string {
 	if x != nil {
-		return x.Id
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *DIDDocument) GetPrimaryController() string {
+func (x *StatusList) GetListId() uint64 {
 	if x != nil {
-		return x.PrimaryController
+		return x.ListId
 	}
-	return ""
+	return 0
 }
 
-func (x *DIDDocument) GetAlsoKnownAs() []string {
+func (x *StatusList) GetPurpose() string {
 	if x != nil {
-		return x.AlsoKnownAs
+		return x.Purpose
 	}
-	return nil
+	return ""
 }
 
-func (x *DIDDocument) GetVerificationMethod() []*VerificationMethod {
+func (x *StatusList) GetBitstring() []byte {
 	if x != nil {
-		return x.VerificationMethod
+		return x.Bitstring
 	}
 	return nil
 }
 
-func (x *DIDDocument) GetAuthentication() []*VerificationMethodReference {
+func (x *StatusList) GetAllocated() uint64 {
 	if x != nil {
-		return x.Authentication
+		return x.Allocated
 	}
-	return nil
+	return 0
 }
 
-func (x *DIDDocument) GetAssertionMethod() []*VerificationMethodReference {
+func (x *StatusList) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.AssertionMethod
+		return x.UpdatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *DIDDocument) GetKeyAgreement() []*VerificationMethodReference {
-	if x != nil {
-		return x.KeyAgreement
+// DelegatedController is a temporary controller of a DID, such as a recovery
+// service or an enterprise admin. It is authorized like a controller until
+// expires_at, and removed at the end of the first block past it.
+type DelegatedController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did is the DID the controller was delegated
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// controller is the address of the delegated controller
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// granted_by is the controller that added the delegation
+	GrantedBy string `protobuf:"bytes,3,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	// purpose describes what the delegation is for
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// granted_at is the block time the delegation was added, in unix seconds
+	GrantedAt int64 `protobuf:"varint,5,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	// expires_at is the block time the delegation ends, in unix seconds
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *DelegatedController) Reset() {
+	*x = DelegatedController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_state_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DIDDocument) GetCapabilityInvocation() []*VerificationMethodReference {
-	if x != nil {
-		return x.CapabilityInvocation
-	}
-	return nil
+func (x *DelegatedController) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DIDDocument) GetCapabilityDelegation() []*VerificationMethodReference {
+func (*DelegatedController) ProtoMessage() {}
+
+// Deprecated: Use DelegatedController.ProtoReflect.Descriptor instead.
+func (*DelegatedController) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DelegatedController) GetDid() string {
 	if x != nil {
-		return x.CapabilityDelegation
+		return x.Did
 	}
-	return nil
+	return ""
 }
 
-func (x *DIDDocument) GetService() []*Service {
+func (x *DelegatedController) GetController() string {
 	if x != nil {
-		return x.Service
+		return x.Controller
 	}
-	return nil
+	return ""
 }
 
-func (x *DIDDocument) GetCreatedAt() int64 {
+func (x *DelegatedController) GetGrantedBy() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.GrantedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *DIDDocument) GetUpdatedAt() int64 {
+func (x *DelegatedController) GetPurpose() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Purpose
 	}
-	return 0
+	return ""
 }
 
-func (x *DIDDocument) GetDeactivated() bool {
+func (x *DelegatedController) GetGrantedAt() int64 {
 	if x != nil {
-		return x.Deactivated
+		return x.GrantedAt
 	}
-	return false
+	return 0
 }
 
-func (x *DIDDocument) GetVersion() uint64 {
+func (x *DelegatedController) GetExpiresAt() int64 {
 	if x != nil {
-		return x.Version
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-// DIDDocumentMetadata contains metadata about the DID document
-type DIDDocumentMetadata struct {
+// DIDAsset is an x/nft token a DID shows on its profile, such as one
+// received over ICS-721 from another chain. It records the token's metadata
+// when it was attached; ownership is checked again whenever it is queried.
+type DIDAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// did is the DID this metadata belongs to
+	// did is the DID showing the token
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// created is when the DID was created
-	Created int64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	// updated is when the DID was last updated
-	Updated int64 `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
-	// deactivated is when the DID was deactivated (if applicable)
-	Deactivated int64 `protobuf:"varint,4,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
-	// version_id is the version identifier
-	VersionId string `protobuf:"bytes,5,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	// next_update is when the next update is scheduled (if applicable)
-	NextUpdate int64 `protobuf:"varint,6,opt,name=next_update,json=nextUpdate,proto3" json:"next_update,omitempty"`
-	// next_version_id is the next version identifier (if applicable)
-	NextVersionId string `protobuf:"bytes,7,opt,name=next_version_id,json=nextVersionId,proto3" json:"next_version_id,omitempty"`
-	// equivalentId lists equivalent DIDs
-	EquivalentId []string `protobuf:"bytes,8,rep,name=equivalent_id,json=equivalentId,proto3" json:"equivalent_id,omitempty"`
-	// canonicalId is the canonical DID
-	CanonicalId string `protobuf:"bytes,9,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
-}
-
-func (x *DIDDocumentMetadata) Reset() {
-	*x = DIDDocumentMetadata{}
+	// class_id is the token's x/nft class; tokens received over ICS-721 have a
+	// class ID starting with "ibc/"
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the token's ID within its class
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// owner is the controller address that owned the token when it was attached
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// uri points to the token's off-chain metadata
+	Uri string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the document at uri
+	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// class_name is the name of the token's class
+	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// class_uri points to the class's off-chain metadata
+	ClassUri string `protobuf:"bytes,8,opt,name=class_uri,json=classUri,proto3" json:"class_uri,omitempty"`
+	// interchain is set for tokens received over ICS-721
+	Interchain bool `protobuf:"varint,9,opt,name=interchain,proto3" json:"interchain,omitempty"`
+	// dwn_record_id is the DWN record caching the token's metadata for the DID,
+	// empty when it could not be cached
+	DwnRecordId string `protobuf:"bytes,10,opt,name=dwn_record_id,json=dwnRecordId,proto3" json:"dwn_record_id,omitempty"`
+	// attached_at is the block time the token was attached, in unix seconds
+	AttachedAt int64 `protobuf:"varint,11,opt,name=attached_at,json=attachedAt,proto3" json:"attached_at,omitempty"`
+}
+
+func (x *DIDAsset) Reset() {
+	*x = DIDAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[6]
+		mi := &file_did_v1_state_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DIDDocumentMetadata) String() string {
+func (x *DIDAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DIDDocumentMetadata) ProtoMessage() {}
+func (*DIDAsset) ProtoMessage() {}
 
-// Deprecated: Use DIDDocumentMetadata.ProtoReflect.Descriptor instead.
-func (*DIDDocumentMetadata) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DIDAsset.ProtoReflect.Descriptor instead.
+func (*DIDAsset) Descriptor() ([]byte, []int) {
+	return file_did_v1_state_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DIDDocumentMetadata) GetDid() string {
+func (x *DIDAsset) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *DIDDocumentMetadata) GetCreated() int64 {
+func (x *DIDAsset) GetClassId() string {
 	if x != nil {
-		return x.Created
+		return x.ClassId
 	}
-	return 0
+	return ""
 }
 
-func (x *DIDDocumentMetadata) GetUpdated() int64 {
+func (x *DIDAsset) GetNftId() string {
 	if x != nil {
-		return x.Updated
+		return x.NftId
 	}
-	return 0
+	return ""
 }
 
-func (x *DIDDocumentMetadata) GetDeactivated() int64 {
+func (x *DIDAsset) GetOwner() string {
 	if x != nil {
-		return x.Deactivated
+		return x.Owner
 	}
-	return 0
+	return ""
 }
 
-func (x *DIDDocumentMetadata) GetVersionId() string {
+func (x *DIDAsset) GetUri() string {
 	if x != nil {
-		return x.VersionId
+		return x.Uri
 	}
 	return ""
 }
 
-func (x *DIDDocumentMetadata) GetNextUpdate() int64 {
+func (x *DIDAsset) GetUriHash() string {
 	if x != nil {
-		return x.NextUpdate
+		return x.UriHash
 	}
-	return 0
+	return ""
 }
 
-func (x *DIDDocumentMetadata) GetNextVersionId() string {
+func (x *DIDAsset) GetClassName() string {
 	if x != nil {
-		return x.NextVersionId
+		return x.ClassName
 	}
 	return ""
 }
 
-func (x *DIDDocumentMetadata) GetEquivalentId() []string {
+func (x *DIDAsset) GetClassUri() string {
 	if x != nil {
-		return x.EquivalentId
+		return x.ClassUri
 	}
-	return nil
+	return ""
 }
 
-func (x *DIDDocumentMetadata) GetCanonicalId() string {
+func (x *DIDAsset) GetInterchain() bool {
 	if x != nil {
-		return x.CanonicalId
+		return x.Interchain
+	}
+	return false
+}
+
+func (x *DIDAsset) GetDwnRecordId() string {
+	if x != nil {
+		return x.DwnRecordId
 	}
 	return ""
 }
 
+func (x *DIDAsset) GetAttachedAt() int64 {
+	if x != nil {
+		return x.AttachedAt
+	}
+	return 0
+}
+
 // VerifiableCredential represents a W3C Verifiable Credential
 type VerifiableCredential struct {
 	state         protoimpl.MessageState
@@ -9000,7 +13373,7 @@ type VerifiableCredential struct {
 func (x *VerifiableCredential) Reset() {
 	*x = VerifiableCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[7]
+		mi := &file_did_v1_state_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9014,7 +13387,7 @@ func (*VerifiableCredential) ProtoMessage() {}
 
 // Deprecated: Use VerifiableCredential.ProtoReflect.Descriptor instead.
 func (*VerifiableCredential) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{7}
+	return file_did_v1_state_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *VerifiableCredential) GetId() string {
@@ -9127,7 +13500,7 @@ type DIDController struct {
 func (x *DIDController) Reset() {
 	*x = DIDController{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_state_proto_msgTypes[8]
+		mi := &file_did_v1_state_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9141,7 +13514,7 @@ func (*DIDController) ProtoMessage() {}
 
 // Deprecated: Use DIDController.ProtoReflect.Descriptor instead.
 func (*DIDController) Descriptor() ([]byte, []int) {
-	return file_did_v1_state_proto_rawDescGZIP(), []int{8}
+	return file_did_v1_state_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DIDController) GetId() uint64 {
@@ -9309,7 +13682,7 @@ var file_did_v1_state_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x26, 0xf2,
 	0x9e, 0xd3, 0x8e, 0x03, 0x20, 0x0a, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x70,
 	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x10, 0x01, 0x18, 0x06, 0x22, 0xbe, 0x02, 0x0a, 0x13, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63,
+	0x72, 0x10, 0x01, 0x18, 0x06, 0x22, 0xe9, 0x02, 0x0a, 0x13, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63,
 	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a,
 	0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12,
 	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
@@ -9328,63 +13701,139 @@ var file_did_v1_state_proto_rawDesc = []byte{
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74,
 	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f,
 	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69,
-	0x63, 0x61, 0x6c, 0x49, 0x64, 0x3a, 0x0f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x09, 0x0a, 0x05, 0x0a,
-	0x03, 0x64, 0x69, 0x64, 0x18, 0x07, 0x22, 0xa5, 0x04, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4b,
-	0x69, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
-	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x3a,
-	0x3d, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x37, 0x0a, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x12, 0x0a, 0x0a,
-	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x2c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x03, 0x18, 0x01, 0x18, 0x08, 0x22, 0xc0,
-	0x01, 0x0a, 0x0d, 0x44, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64,
-	0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x5f, 0x64, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x65, 0x64, 0x41, 0x74, 0x3a, 0x4b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x45, 0x0a, 0x06, 0x0a, 0x02,
-	0x69, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x10, 0x01, 0x18, 0x01, 0x12,
-	0x14, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x64, 0x69,
-	0x64, 0x10, 0x02, 0x18, 0x01, 0x12, 0x18, 0x0a, 0x12, 0x64, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x10, 0x03, 0x18, 0x01, 0x18,
-	0x09, 0x42, 0x7b, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x42,
-	0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69,
-	0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76,
-	0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06,
-	0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x49, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x3a, 0x0f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x09, 0x0a, 0x05, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18,
+	0x07, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2f, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xd2, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67,
+	0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x69, 0x74, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x62, 0x69, 0x74, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x64, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x08,
+	0x44, 0x49, 0x44, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x72, 0x69, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x55, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x64,
+	0x77, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x77, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xa5, 0x04, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x3a, 0x3d, 0xf2, 0x9e, 0xd3, 0x8e, 0x03,
+	0x37, 0x0a, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x02,
+	0x12, 0x14, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2c, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x10, 0x03, 0x18, 0x01, 0x18, 0x08, 0x22, 0xc0, 0x01, 0x0a, 0x0d, 0x44, 0x49, 0x44,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x44, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x4b,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x45, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x03, 0x64, 0x69, 0x64, 0x10, 0x01, 0x18, 0x01, 0x12, 0x14, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x10, 0x02, 0x18, 0x01, 0x12,
+	0x18, 0x0a, 0x12, 0x64, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x5f, 0x64, 0x69, 0x64, 0x10, 0x03, 0x18, 0x01, 0x18, 0x09, 0x2a, 0x5a, 0x0a, 0x09, 0x44,
+	0x49, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x44, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44,
+	0x49, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x42, 0x7b, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44,
+	0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9399,38 +13848,47 @@ func file_did_v1_state_proto_rawDescGZIP() []byte {
 	return file_did_v1_state_proto_rawDescData
 }
 
-var file_did_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_did_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_did_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_did_v1_state_proto_goTypes = []interface{}{
-	(*Authentication)(nil),              // 0: did.v1.Authentication
-	(*Assertion)(nil),                   // 1: did.v1.Assertion
-	(*Controller)(nil),                  // 2: did.v1.Controller
-	(*Delegation)(nil),                  // 3: did.v1.Delegation
-	(*Invocation)(nil),                  // 4: did.v1.Invocation
-	(*DIDDocument)(nil),                 // 5: did.v1.DIDDocument
-	(*DIDDocumentMetadata)(nil),         // 6: did.v1.DIDDocumentMetadata
-	(*VerifiableCredential)(nil),        // 7: did.v1.VerifiableCredential
-	(*DIDController)(nil),               // 8: did.v1.DIDController
-	(*VerificationMethod)(nil),          // 9: did.v1.VerificationMethod
-	(*VerificationMethodReference)(nil), // 10: did.v1.VerificationMethodReference
-	(*Service)(nil),                     // 11: did.v1.Service
-	(*CredentialProof)(nil),             // 12: did.v1.CredentialProof
-	(*CredentialStatus)(nil),            // 13: did.v1.CredentialStatus
+	(DIDStatus)(0),                      // 0: did.v1.DIDStatus
+	(*Authentication)(nil),              // 1: did.v1.Authentication
+	(*Assertion)(nil),                   // 2: did.v1.Assertion
+	(*Controller)(nil),                  // 3: did.v1.Controller
+	(*Delegation)(nil),                  // 4: did.v1.Delegation
+	(*Invocation)(nil),                  // 5: did.v1.Invocation
+	(*DIDDocument)(nil),                 // 6: did.v1.DIDDocument
+	(*DIDDocumentMetadata)(nil),         // 7: did.v1.DIDDocumentMetadata
+	(*DIDDocumentVersion)(nil),          // 8: did.v1.DIDDocumentVersion
+	(*UnverifiedCredential)(nil),        // 9: did.v1.UnverifiedCredential
+	(*StatusList)(nil),                  // 10: did.v1.StatusList
+	(*DelegatedController)(nil),         // 11: did.v1.DelegatedController
+	(*DIDAsset)(nil),                    // 12: did.v1.DIDAsset
+	(*VerifiableCredential)(nil),        // 13: did.v1.VerifiableCredential
+	(*DIDController)(nil),               // 14: did.v1.DIDController
+	(*VerificationMethod)(nil),          // 15: did.v1.VerificationMethod
+	(*VerificationMethodReference)(nil), // 16: did.v1.VerificationMethodReference
+	(*Service)(nil),                     // 17: did.v1.Service
+	(*CredentialProof)(nil),             // 18: did.v1.CredentialProof
+	(*CredentialStatus)(nil),            // 19: did.v1.CredentialStatus
 }
 var file_did_v1_state_proto_depIdxs = []int32{
-	9,  // 0: did.v1.DIDDocument.verification_method:type_name -> did.v1.VerificationMethod
-	10, // 1: did.v1.DIDDocument.authentication:type_name -> did.v1.VerificationMethodReference
-	10, // 2: did.v1.DIDDocument.assertion_method:type_name -> did.v1.VerificationMethodReference
-	10, // 3: did.v1.DIDDocument.key_agreement:type_name -> did.v1.VerificationMethodReference
-	10, // 4: did.v1.DIDDocument.capability_invocation:type_name -> did.v1.VerificationMethodReference
-	10, // 5: did.v1.DIDDocument.capability_delegation:type_name -> did.v1.VerificationMethodReference
-	11, // 6: did.v1.DIDDocument.service:type_name -> did.v1.Service
-	12, // 7: did.v1.VerifiableCredential.proof:type_name -> did.v1.CredentialProof
-	13, // 8: did.v1.VerifiableCredential.credential_status:type_name -> did.v1.CredentialStatus
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	15, // 0: did.v1.DIDDocument.verification_method:type_name -> did.v1.VerificationMethod
+	16, // 1: did.v1.DIDDocument.authentication:type_name -> did.v1.VerificationMethodReference
+	16, // 2: did.v1.DIDDocument.assertion_method:type_name -> did.v1.VerificationMethodReference
+	16, // 3: did.v1.DIDDocument.key_agreement:type_name -> did.v1.VerificationMethodReference
+	16, // 4: did.v1.DIDDocument.capability_invocation:type_name -> did.v1.VerificationMethodReference
+	16, // 5: did.v1.DIDDocument.capability_delegation:type_name -> did.v1.VerificationMethodReference
+	17, // 6: did.v1.DIDDocument.service:type_name -> did.v1.Service
+	0,  // 7: did.v1.DIDDocumentMetadata.status:type_name -> did.v1.DIDStatus
+	6,  // 8: did.v1.DIDDocumentVersion.document:type_name -> did.v1.DIDDocument
+	18, // 9: did.v1.VerifiableCredential.proof:type_name -> did.v1.CredentialProof
+	19, // 10: did.v1.VerifiableCredential.credential_status:type_name -> did.v1.CredentialStatus
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_did_v1_state_proto_init() }
@@ -9526,7 +13984,7 @@ func file_did_v1_state_proto_init() {
 			}
 		}
 		file_did_v1_state_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifiableCredential); i {
+			switch v := v.(*DIDDocumentVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9538,6 +13996,66 @@ func file_did_v1_state_proto_init() {
 			}
 		}
 		file_did_v1_state_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnverifiedCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_state_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_state_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegatedController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_state_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DIDAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_state_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifiableCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_state_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DIDController); i {
 			case 0:
 				return &v.state
@@ -9555,13 +14073,14 @@ func file_did_v1_state_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_did_v1_state_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_did_v1_state_proto_goTypes,
 		DependencyIndexes: file_did_v1_state_proto_depIdxs,
+		EnumInfos:         file_did_v1_state_proto_enumTypes,
 		MessageInfos:      file_did_v1_state_proto_msgTypes,
 	}.Build()
 	File_did_v1_state_proto = out.File
@@ -871,9 +871,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_MsgCreateDID              protoreflect.MessageDescriptor
-	fd_MsgCreateDID_controller   protoreflect.FieldDescriptor
-	fd_MsgCreateDID_did_document protoreflect.FieldDescriptor
+	md_MsgCreateDID               protoreflect.MessageDescriptor
+	fd_MsgCreateDID_controller    protoreflect.FieldDescriptor
+	fd_MsgCreateDID_did_document  protoreflect.FieldDescriptor
+	fd_MsgCreateDID_referral_code protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -881,6 +882,7 @@ func init() {
 	md_MsgCreateDID = File_did_v1_tx_proto.Messages().ByName("MsgCreateDID")
 	fd_MsgCreateDID_controller = md_MsgCreateDID.Fields().ByName("controller")
 	fd_MsgCreateDID_did_document = md_MsgCreateDID.Fields().ByName("did_document")
+	fd_MsgCreateDID_referral_code = md_MsgCreateDID.Fields().ByName("referral_code")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateDID)(nil)
@@ -960,6 +962,12 @@ func (x *fastReflection_MsgCreateDID) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.ReferralCode != "" {
+		value := protoreflect.ValueOfString(x.ReferralCode)
+		if !f(fd_MsgCreateDID_referral_code, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -979,6 +987,8 @@ func (x *fastReflection_MsgCreateDID) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Controller != ""
 	case "did.v1.MsgCreateDID.did_document":
 		return x.DidDocument != nil
+	case "did.v1.MsgCreateDID.referral_code":
+		return x.ReferralCode != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgCreateDID"))
@@ -999,6 +1009,8 @@ func (x *fastReflection_MsgCreateDID) Clear(fd protoreflect.FieldDescriptor) {
 		x.Controller = ""
 	case "did.v1.MsgCreateDID.did_document":
 		x.DidDocument = nil
+	case "did.v1.MsgCreateDID.referral_code":
+		x.ReferralCode = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgCreateDID"))
@@ -1021,6 +1033,9 @@ func (x *fastReflection_MsgCreateDID) Get(descriptor protoreflect.FieldDescripto
 	case "did.v1.MsgCreateDID.did_document":
 		value := x.DidDocument
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.MsgCreateDID.referral_code":
+		value := x.ReferralCode
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgCreateDID"))
@@ -1045,6 +1060,8 @@ func (x *fastReflection_MsgCreateDID) Set(fd protoreflect.FieldDescriptor, value
 		x.Controller = value.Interface().(string)
 	case "did.v1.MsgCreateDID.did_document":
 		x.DidDocument = value.Message().Interface().(*DIDDocument)
+	case "did.v1.MsgCreateDID.referral_code":
+		x.ReferralCode = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgCreateDID"))
@@ -1072,6 +1089,8 @@ func (x *fastReflection_MsgCreateDID) Mutable(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfMessage(x.DidDocument.ProtoReflect())
 	case "did.v1.MsgCreateDID.controller":
 		panic(fmt.Errorf("field controller of message did.v1.MsgCreateDID is not mutable"))
+	case "did.v1.MsgCreateDID.referral_code":
+		panic(fmt.Errorf("field referral_code of message did.v1.MsgCreateDID is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgCreateDID"))
@@ -1090,6 +1109,8 @@ func (x *fastReflection_MsgCreateDID) NewField(fd protoreflect.FieldDescriptor)
 	case "did.v1.MsgCreateDID.did_document":
 		m := new(DIDDocument)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.MsgCreateDID.referral_code":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgCreateDID"))
@@ -1167,6 +1188,10 @@ func (x *fastReflection_MsgCreateDID) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.DidDocument)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.ReferralCode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1196,6 +1221,13 @@ func (x *fastReflection_MsgCreateDID) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReferralCode) > 0 {
+			i -= len(x.ReferralCode)
+			copy(dAtA[i:], x.ReferralCode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReferralCode)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.DidDocument != nil {
 			encoded, err := options.Marshal(x.DidDocument)
 			if err != nil {
@@ -1334,6 +1366,38 @@ func (x *fastReflection_MsgCreateDID) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferralCode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferralCode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3742,78 +3806,28 @@ func (x *fastReflection_MsgDeactivateDIDResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_MsgAddVerificationMethod_4_list)(nil)
-
-type _MsgAddVerificationMethod_4_list struct {
-	list *[]string
-}
-
-func (x *_MsgAddVerificationMethod_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgAddVerificationMethod_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgAddVerificationMethod_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgAddVerificationMethod_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgAddVerificationMethod_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddVerificationMethod at list field Relationships as it is not of Message kind"))
-}
-
-func (x *_MsgAddVerificationMethod_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgAddVerificationMethod_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgAddVerificationMethod_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgAddVerificationMethod                     protoreflect.MessageDescriptor
-	fd_MsgAddVerificationMethod_controller          protoreflect.FieldDescriptor
-	fd_MsgAddVerificationMethod_did                 protoreflect.FieldDescriptor
-	fd_MsgAddVerificationMethod_verification_method protoreflect.FieldDescriptor
-	fd_MsgAddVerificationMethod_relationships       protoreflect.FieldDescriptor
+	md_MsgReactivateDID            protoreflect.MessageDescriptor
+	fd_MsgReactivateDID_controller protoreflect.FieldDescriptor
+	fd_MsgReactivateDID_did        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgAddVerificationMethod = File_did_v1_tx_proto.Messages().ByName("MsgAddVerificationMethod")
-	fd_MsgAddVerificationMethod_controller = md_MsgAddVerificationMethod.Fields().ByName("controller")
-	fd_MsgAddVerificationMethod_did = md_MsgAddVerificationMethod.Fields().ByName("did")
-	fd_MsgAddVerificationMethod_verification_method = md_MsgAddVerificationMethod.Fields().ByName("verification_method")
-	fd_MsgAddVerificationMethod_relationships = md_MsgAddVerificationMethod.Fields().ByName("relationships")
+	md_MsgReactivateDID = File_did_v1_tx_proto.Messages().ByName("MsgReactivateDID")
+	fd_MsgReactivateDID_controller = md_MsgReactivateDID.Fields().ByName("controller")
+	fd_MsgReactivateDID_did = md_MsgReactivateDID.Fields().ByName("did")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddVerificationMethod)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgReactivateDID)(nil)
 
-type fastReflection_MsgAddVerificationMethod MsgAddVerificationMethod
+type fastReflection_MsgReactivateDID MsgReactivateDID
 
-func (x *MsgAddVerificationMethod) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddVerificationMethod)(x)
+func (x *MsgReactivateDID) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReactivateDID)(x)
 }
 
-func (x *MsgAddVerificationMethod) slowProtoReflect() protoreflect.Message {
+func (x *MsgReactivateDID) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3825,43 +3839,43 @@ func (x *MsgAddVerificationMethod) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddVerificationMethod_messageType fastReflection_MsgAddVerificationMethod_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddVerificationMethod_messageType{}
+var _fastReflection_MsgReactivateDID_messageType fastReflection_MsgReactivateDID_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReactivateDID_messageType{}
 
-type fastReflection_MsgAddVerificationMethod_messageType struct{}
+type fastReflection_MsgReactivateDID_messageType struct{}
 
-func (x fastReflection_MsgAddVerificationMethod_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddVerificationMethod)(nil)
+func (x fastReflection_MsgReactivateDID_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReactivateDID)(nil)
 }
-func (x fastReflection_MsgAddVerificationMethod_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddVerificationMethod)
+func (x fastReflection_MsgReactivateDID_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReactivateDID)
 }
-func (x fastReflection_MsgAddVerificationMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddVerificationMethod
+func (x fastReflection_MsgReactivateDID_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReactivateDID
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddVerificationMethod) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddVerificationMethod
+func (x *fastReflection_MsgReactivateDID) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReactivateDID
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddVerificationMethod) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddVerificationMethod_messageType
+func (x *fastReflection_MsgReactivateDID) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReactivateDID_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddVerificationMethod) New() protoreflect.Message {
-	return new(fastReflection_MsgAddVerificationMethod)
+func (x *fastReflection_MsgReactivateDID) New() protoreflect.Message {
+	return new(fastReflection_MsgReactivateDID)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddVerificationMethod) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddVerificationMethod)(x)
+func (x *fastReflection_MsgReactivateDID) Interface() protoreflect.ProtoMessage {
+	return (*MsgReactivateDID)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3869,28 +3883,16 @@ func (x *fastReflection_MsgAddVerificationMethod) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddVerificationMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgReactivateDID) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Controller != "" {
 		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_MsgAddVerificationMethod_controller, value) {
+		if !f(fd_MsgReactivateDID_controller, value) {
 			return
 		}
 	}
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_MsgAddVerificationMethod_did, value) {
-			return
-		}
-	}
-	if x.VerificationMethod != nil {
-		value := protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
-		if !f(fd_MsgAddVerificationMethod_verification_method, value) {
-			return
-		}
-	}
-	if len(x.Relationships) != 0 {
-		value := protoreflect.ValueOfList(&_MsgAddVerificationMethod_4_list{list: &x.Relationships})
-		if !f(fd_MsgAddVerificationMethod_relationships, value) {
+		if !f(fd_MsgReactivateDID_did, value) {
 			return
 		}
 	}
@@ -3907,21 +3909,17 @@ func (x *fastReflection_MsgAddVerificationMethod) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddVerificationMethod) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgReactivateDID) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgAddVerificationMethod.controller":
+	case "did.v1.MsgReactivateDID.controller":
 		return x.Controller != ""
-	case "did.v1.MsgAddVerificationMethod.did":
+	case "did.v1.MsgReactivateDID.did":
 		return x.Did != ""
-	case "did.v1.MsgAddVerificationMethod.verification_method":
-		return x.VerificationMethod != nil
-	case "did.v1.MsgAddVerificationMethod.relationships":
-		return len(x.Relationships) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDID"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDID does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3931,21 +3929,17 @@ func (x *fastReflection_MsgAddVerificationMethod) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethod) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgReactivateDID) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgAddVerificationMethod.controller":
+	case "did.v1.MsgReactivateDID.controller":
 		x.Controller = ""
-	case "did.v1.MsgAddVerificationMethod.did":
+	case "did.v1.MsgReactivateDID.did":
 		x.Did = ""
-	case "did.v1.MsgAddVerificationMethod.verification_method":
-		x.VerificationMethod = nil
-	case "did.v1.MsgAddVerificationMethod.relationships":
-		x.Relationships = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDID"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDID does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3955,28 +3949,19 @@ func (x *fastReflection_MsgAddVerificationMethod) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddVerificationMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReactivateDID) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgAddVerificationMethod.controller":
+	case "did.v1.MsgReactivateDID.controller":
 		value := x.Controller
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgAddVerificationMethod.did":
+	case "did.v1.MsgReactivateDID.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgAddVerificationMethod.verification_method":
-		value := x.VerificationMethod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.MsgAddVerificationMethod.relationships":
-		if len(x.Relationships) == 0 {
-			return protoreflect.ValueOfList(&_MsgAddVerificationMethod_4_list{})
-		}
-		listValue := &_MsgAddVerificationMethod_4_list{list: &x.Relationships}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDID"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDID does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3990,23 +3975,17 @@ func (x *fastReflection_MsgAddVerificationMethod) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgReactivateDID) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgAddVerificationMethod.controller":
+	case "did.v1.MsgReactivateDID.controller":
 		x.Controller = value.Interface().(string)
-	case "did.v1.MsgAddVerificationMethod.did":
+	case "did.v1.MsgReactivateDID.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.MsgAddVerificationMethod.verification_method":
-		x.VerificationMethod = value.Message().Interface().(*VerificationMethod)
-	case "did.v1.MsgAddVerificationMethod.relationships":
-		lv := value.List()
-		clv := lv.(*_MsgAddVerificationMethod_4_list)
-		x.Relationships = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDID"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDID does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4020,61 +3999,44 @@ func (x *fastReflection_MsgAddVerificationMethod) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReactivateDID) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgAddVerificationMethod.verification_method":
-		if x.VerificationMethod == nil {
-			x.VerificationMethod = new(VerificationMethod)
-		}
-		return protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
-	case "did.v1.MsgAddVerificationMethod.relationships":
-		if x.Relationships == nil {
-			x.Relationships = []string{}
-		}
-		value := &_MsgAddVerificationMethod_4_list{list: &x.Relationships}
-		return protoreflect.ValueOfList(value)
-	case "did.v1.MsgAddVerificationMethod.controller":
-		panic(fmt.Errorf("field controller of message did.v1.MsgAddVerificationMethod is not mutable"))
-	case "did.v1.MsgAddVerificationMethod.did":
-		panic(fmt.Errorf("field did of message did.v1.MsgAddVerificationMethod is not mutable"))
+	case "did.v1.MsgReactivateDID.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgReactivateDID is not mutable"))
+	case "did.v1.MsgReactivateDID.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgReactivateDID is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDID"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDID does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddVerificationMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReactivateDID) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgAddVerificationMethod.controller":
+	case "did.v1.MsgReactivateDID.controller":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgAddVerificationMethod.did":
+	case "did.v1.MsgReactivateDID.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgAddVerificationMethod.verification_method":
-		m := new(VerificationMethod)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "did.v1.MsgAddVerificationMethod.relationships":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgAddVerificationMethod_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDID"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDID does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddVerificationMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgReactivateDID) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddVerificationMethod", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgReactivateDID", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4082,7 +4044,7 @@ func (x *fastReflection_MsgAddVerificationMethod) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddVerificationMethod) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgReactivateDID) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4093,7 +4055,7 @@ func (x *fastReflection_MsgAddVerificationMethod) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethod) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgReactivateDID) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4105,7 +4067,7 @@ func (x *fastReflection_MsgAddVerificationMethod) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddVerificationMethod) IsValid() bool {
+func (x *fastReflection_MsgReactivateDID) IsValid() bool {
 	return x != nil
 }
 
@@ -4115,9 +4077,9 @@ func (x *fastReflection_MsgAddVerificationMethod) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgReactivateDID) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddVerificationMethod)
+		x := input.Message.Interface().(*MsgReactivateDID)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4137,16 +4099,6 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.VerificationMethod != nil {
-			l = options.Size(x.VerificationMethod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Relationships) > 0 {
-			for _, s := range x.Relationships {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4157,7 +4109,7 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddVerificationMethod)
+		x := input.Message.Interface().(*MsgReactivateDID)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4176,29 +4128,6 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Relationships) > 0 {
-			for iNdEx := len(x.Relationships) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Relationships[iNdEx])
-				copy(dAtA[i:], x.Relationships[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relationships[iNdEx])))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.VerificationMethod != nil {
-			encoded, err := options.Marshal(x.VerificationMethod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
 			copy(dAtA[i:], x.Did)
@@ -4224,7 +4153,7 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddVerificationMethod)
+		x := input.Message.Interface().(*MsgReactivateDID)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4256,10 +4185,10 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethod: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReactivateDID: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReactivateDID: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4326,74 +4255,6 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 				}
 				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.VerificationMethod == nil {
-					x.VerificationMethod = &VerificationMethod{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationMethod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Relationships = append(x.Relationships, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4430,23 +4291,23 @@ func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_MsgAddVerificationMethodResponse protoreflect.MessageDescriptor
+	md_MsgReactivateDIDResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgAddVerificationMethodResponse = File_did_v1_tx_proto.Messages().ByName("MsgAddVerificationMethodResponse")
+	md_MsgReactivateDIDResponse = File_did_v1_tx_proto.Messages().ByName("MsgReactivateDIDResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddVerificationMethodResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgReactivateDIDResponse)(nil)
 
-type fastReflection_MsgAddVerificationMethodResponse MsgAddVerificationMethodResponse
+type fastReflection_MsgReactivateDIDResponse MsgReactivateDIDResponse
 
-func (x *MsgAddVerificationMethodResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddVerificationMethodResponse)(x)
+func (x *MsgReactivateDIDResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReactivateDIDResponse)(x)
 }
 
-func (x *MsgAddVerificationMethodResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgReactivateDIDResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4458,43 +4319,43 @@ func (x *MsgAddVerificationMethodResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddVerificationMethodResponse_messageType fastReflection_MsgAddVerificationMethodResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddVerificationMethodResponse_messageType{}
+var _fastReflection_MsgReactivateDIDResponse_messageType fastReflection_MsgReactivateDIDResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReactivateDIDResponse_messageType{}
 
-type fastReflection_MsgAddVerificationMethodResponse_messageType struct{}
+type fastReflection_MsgReactivateDIDResponse_messageType struct{}
 
-func (x fastReflection_MsgAddVerificationMethodResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddVerificationMethodResponse)(nil)
+func (x fastReflection_MsgReactivateDIDResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReactivateDIDResponse)(nil)
 }
-func (x fastReflection_MsgAddVerificationMethodResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddVerificationMethodResponse)
+func (x fastReflection_MsgReactivateDIDResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReactivateDIDResponse)
 }
-func (x fastReflection_MsgAddVerificationMethodResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddVerificationMethodResponse
+func (x fastReflection_MsgReactivateDIDResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReactivateDIDResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddVerificationMethodResponse
+func (x *fastReflection_MsgReactivateDIDResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReactivateDIDResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddVerificationMethodResponse_messageType
+func (x *fastReflection_MsgReactivateDIDResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReactivateDIDResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddVerificationMethodResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgAddVerificationMethodResponse)
+func (x *fastReflection_MsgReactivateDIDResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReactivateDIDResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddVerificationMethodResponse)(x)
+func (x *fastReflection_MsgReactivateDIDResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReactivateDIDResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4502,7 +4363,7 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgReactivateDIDResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4516,13 +4377,13 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgReactivateDIDResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDIDResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4532,13 +4393,13 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgReactivateDIDResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDIDResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4548,13 +4409,13 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReactivateDIDResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDIDResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDIDResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4568,13 +4429,13 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgReactivateDIDResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDIDResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4588,36 +4449,36 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethodResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReactivateDIDResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDIDResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddVerificationMethodResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReactivateDIDResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgReactivateDIDResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgReactivateDIDResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddVerificationMethodResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgReactivateDIDResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddVerificationMethodResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgReactivateDIDResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4625,7 +4486,7 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddVerificationMethodResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgReactivateDIDResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4636,7 +4497,7 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddVerificationMethodResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgReactivateDIDResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4648,7 +4509,7 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddVerificationMethodResponse) IsValid() bool {
+func (x *fastReflection_MsgReactivateDIDResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4658,9 +4519,9 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddVerificationMethodResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgReactivateDIDResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddVerificationMethodResponse)
+		x := input.Message.Interface().(*MsgReactivateDIDResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4682,7 +4543,7 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddVerificationMethodResponse)
+		x := input.Message.Interface().(*MsgReactivateDIDResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4712,7 +4573,7 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddVerificationMethodResponse)
+		x := input.Message.Interface().(*MsgReactivateDIDResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4744,10 +4605,10 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethodResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReactivateDIDResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReactivateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4785,30 +4646,78 @@ func (x *fastReflection_MsgAddVerificationMethodResponse) ProtoMethods() *protoi
 	}
 }
 
+var _ protoreflect.List = (*_MsgAddVerificationMethod_4_list)(nil)
+
+type _MsgAddVerificationMethod_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgAddVerificationMethod_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddVerificationMethod_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAddVerificationMethod_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddVerificationMethod_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddVerificationMethod_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddVerificationMethod at list field Relationships as it is not of Message kind"))
+}
+
+func (x *_MsgAddVerificationMethod_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddVerificationMethod_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAddVerificationMethod_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgRemoveVerificationMethod                        protoreflect.MessageDescriptor
-	fd_MsgRemoveVerificationMethod_controller             protoreflect.FieldDescriptor
-	fd_MsgRemoveVerificationMethod_did                    protoreflect.FieldDescriptor
-	fd_MsgRemoveVerificationMethod_verification_method_id protoreflect.FieldDescriptor
+	md_MsgAddVerificationMethod                     protoreflect.MessageDescriptor
+	fd_MsgAddVerificationMethod_controller          protoreflect.FieldDescriptor
+	fd_MsgAddVerificationMethod_did                 protoreflect.FieldDescriptor
+	fd_MsgAddVerificationMethod_verification_method protoreflect.FieldDescriptor
+	fd_MsgAddVerificationMethod_relationships       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRemoveVerificationMethod = File_did_v1_tx_proto.Messages().ByName("MsgRemoveVerificationMethod")
-	fd_MsgRemoveVerificationMethod_controller = md_MsgRemoveVerificationMethod.Fields().ByName("controller")
-	fd_MsgRemoveVerificationMethod_did = md_MsgRemoveVerificationMethod.Fields().ByName("did")
-	fd_MsgRemoveVerificationMethod_verification_method_id = md_MsgRemoveVerificationMethod.Fields().ByName("verification_method_id")
+	md_MsgAddVerificationMethod = File_did_v1_tx_proto.Messages().ByName("MsgAddVerificationMethod")
+	fd_MsgAddVerificationMethod_controller = md_MsgAddVerificationMethod.Fields().ByName("controller")
+	fd_MsgAddVerificationMethod_did = md_MsgAddVerificationMethod.Fields().ByName("did")
+	fd_MsgAddVerificationMethod_verification_method = md_MsgAddVerificationMethod.Fields().ByName("verification_method")
+	fd_MsgAddVerificationMethod_relationships = md_MsgAddVerificationMethod.Fields().ByName("relationships")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveVerificationMethod)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddVerificationMethod)(nil)
 
-type fastReflection_MsgRemoveVerificationMethod MsgRemoveVerificationMethod
+type fastReflection_MsgAddVerificationMethod MsgAddVerificationMethod
 
-func (x *MsgRemoveVerificationMethod) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveVerificationMethod)(x)
+func (x *MsgAddVerificationMethod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddVerificationMethod)(x)
 }
 
-func (x *MsgRemoveVerificationMethod) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddVerificationMethod) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4820,43 +4729,43 @@ func (x *MsgRemoveVerificationMethod) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveVerificationMethod_messageType fastReflection_MsgRemoveVerificationMethod_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveVerificationMethod_messageType{}
+var _fastReflection_MsgAddVerificationMethod_messageType fastReflection_MsgAddVerificationMethod_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddVerificationMethod_messageType{}
 
-type fastReflection_MsgRemoveVerificationMethod_messageType struct{}
+type fastReflection_MsgAddVerificationMethod_messageType struct{}
 
-func (x fastReflection_MsgRemoveVerificationMethod_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveVerificationMethod)(nil)
+func (x fastReflection_MsgAddVerificationMethod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddVerificationMethod)(nil)
 }
-func (x fastReflection_MsgRemoveVerificationMethod_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveVerificationMethod)
+func (x fastReflection_MsgAddVerificationMethod_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddVerificationMethod)
 }
-func (x fastReflection_MsgRemoveVerificationMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveVerificationMethod
+func (x fastReflection_MsgAddVerificationMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddVerificationMethod
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveVerificationMethod) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveVerificationMethod
+func (x *fastReflection_MsgAddVerificationMethod) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddVerificationMethod
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveVerificationMethod) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveVerificationMethod_messageType
+func (x *fastReflection_MsgAddVerificationMethod) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddVerificationMethod_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveVerificationMethod) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveVerificationMethod)
+func (x *fastReflection_MsgAddVerificationMethod) New() protoreflect.Message {
+	return new(fastReflection_MsgAddVerificationMethod)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveVerificationMethod) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveVerificationMethod)(x)
+func (x *fastReflection_MsgAddVerificationMethod) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddVerificationMethod)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4864,22 +4773,28 @@ func (x *fastReflection_MsgRemoveVerificationMethod) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveVerificationMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddVerificationMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Controller != "" {
 		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_MsgRemoveVerificationMethod_controller, value) {
+		if !f(fd_MsgAddVerificationMethod_controller, value) {
 			return
 		}
 	}
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_MsgRemoveVerificationMethod_did, value) {
+		if !f(fd_MsgAddVerificationMethod_did, value) {
 			return
 		}
 	}
-	if x.VerificationMethodId != "" {
-		value := protoreflect.ValueOfString(x.VerificationMethodId)
-		if !f(fd_MsgRemoveVerificationMethod_verification_method_id, value) {
+	if x.VerificationMethod != nil {
+		value := protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
+		if !f(fd_MsgAddVerificationMethod_verification_method, value) {
+			return
+		}
+	}
+	if len(x.Relationships) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddVerificationMethod_4_list{list: &x.Relationships})
+		if !f(fd_MsgAddVerificationMethod_relationships, value) {
 			return
 		}
 	}
@@ -4896,19 +4811,21 @@ func (x *fastReflection_MsgRemoveVerificationMethod) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveVerificationMethod) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddVerificationMethod) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveVerificationMethod.controller":
+	case "did.v1.MsgAddVerificationMethod.controller":
 		return x.Controller != ""
-	case "did.v1.MsgRemoveVerificationMethod.did":
+	case "did.v1.MsgAddVerificationMethod.did":
 		return x.Did != ""
-	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
-		return x.VerificationMethodId != ""
+	case "did.v1.MsgAddVerificationMethod.verification_method":
+		return x.VerificationMethod != nil
+	case "did.v1.MsgAddVerificationMethod.relationships":
+		return len(x.Relationships) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4918,19 +4835,21 @@ func (x *fastReflection_MsgRemoveVerificationMethod) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethod) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddVerificationMethod) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveVerificationMethod.controller":
+	case "did.v1.MsgAddVerificationMethod.controller":
 		x.Controller = ""
-	case "did.v1.MsgRemoveVerificationMethod.did":
+	case "did.v1.MsgAddVerificationMethod.did":
 		x.Did = ""
-	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
-		x.VerificationMethodId = ""
+	case "did.v1.MsgAddVerificationMethod.verification_method":
+		x.VerificationMethod = nil
+	case "did.v1.MsgAddVerificationMethod.relationships":
+		x.Relationships = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4940,22 +4859,28 @@ func (x *fastReflection_MsgRemoveVerificationMethod) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveVerificationMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddVerificationMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgRemoveVerificationMethod.controller":
+	case "did.v1.MsgAddVerificationMethod.controller":
 		value := x.Controller
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRemoveVerificationMethod.did":
+	case "did.v1.MsgAddVerificationMethod.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
-		value := x.VerificationMethodId
-		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAddVerificationMethod.verification_method":
+		value := x.VerificationMethod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.MsgAddVerificationMethod.relationships":
+		if len(x.Relationships) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddVerificationMethod_4_list{})
+		}
+		listValue := &_MsgAddVerificationMethod_4_list{list: &x.Relationships}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4969,19 +4894,23 @@ func (x *fastReflection_MsgRemoveVerificationMethod) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddVerificationMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveVerificationMethod.controller":
+	case "did.v1.MsgAddVerificationMethod.controller":
 		x.Controller = value.Interface().(string)
-	case "did.v1.MsgRemoveVerificationMethod.did":
+	case "did.v1.MsgAddVerificationMethod.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
-		x.VerificationMethodId = value.Interface().(string)
+	case "did.v1.MsgAddVerificationMethod.verification_method":
+		x.VerificationMethod = value.Message().Interface().(*VerificationMethod)
+	case "did.v1.MsgAddVerificationMethod.relationships":
+		lv := value.List()
+		clv := lv.(*_MsgAddVerificationMethod_4_list)
+		x.Relationships = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4995,48 +4924,61 @@ func (x *fastReflection_MsgRemoveVerificationMethod) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddVerificationMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveVerificationMethod.controller":
-		panic(fmt.Errorf("field controller of message did.v1.MsgRemoveVerificationMethod is not mutable"))
-	case "did.v1.MsgRemoveVerificationMethod.did":
-		panic(fmt.Errorf("field did of message did.v1.MsgRemoveVerificationMethod is not mutable"))
-	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
-		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgRemoveVerificationMethod is not mutable"))
+	case "did.v1.MsgAddVerificationMethod.verification_method":
+		if x.VerificationMethod == nil {
+			x.VerificationMethod = new(VerificationMethod)
+		}
+		return protoreflect.ValueOfMessage(x.VerificationMethod.ProtoReflect())
+	case "did.v1.MsgAddVerificationMethod.relationships":
+		if x.Relationships == nil {
+			x.Relationships = []string{}
+		}
+		value := &_MsgAddVerificationMethod_4_list{list: &x.Relationships}
+		return protoreflect.ValueOfList(value)
+	case "did.v1.MsgAddVerificationMethod.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgAddVerificationMethod is not mutable"))
+	case "did.v1.MsgAddVerificationMethod.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgAddVerificationMethod is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveVerificationMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddVerificationMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveVerificationMethod.controller":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRemoveVerificationMethod.did":
+	case "did.v1.MsgAddVerificationMethod.controller":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
+	case "did.v1.MsgAddVerificationMethod.did":
 		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAddVerificationMethod.verification_method":
+		m := new(VerificationMethod)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.MsgAddVerificationMethod.relationships":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAddVerificationMethod_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveVerificationMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddVerificationMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveVerificationMethod", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddVerificationMethod", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5044,7 +4986,7 @@ func (x *fastReflection_MsgRemoveVerificationMethod) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveVerificationMethod) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddVerificationMethod) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5055,7 +4997,7 @@ func (x *fastReflection_MsgRemoveVerificationMethod) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethod) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddVerificationMethod) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5067,7 +5009,7 @@ func (x *fastReflection_MsgRemoveVerificationMethod) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveVerificationMethod) IsValid() bool {
+func (x *fastReflection_MsgAddVerificationMethod) IsValid() bool {
 	return x != nil
 }
 
@@ -5077,9 +5019,9 @@ func (x *fastReflection_MsgRemoveVerificationMethod) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddVerificationMethod) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveVerificationMethod)
+		x := input.Message.Interface().(*MsgAddVerificationMethod)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5099,10 +5041,16 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VerificationMethodId)
-		if l > 0 {
+		if x.VerificationMethod != nil {
+			l = options.Size(x.VerificationMethod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Relationships) > 0 {
+			for _, s := range x.Relationships {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5113,7 +5061,7 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveVerificationMethod)
+		x := input.Message.Interface().(*MsgAddVerificationMethod)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5132,10 +5080,26 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VerificationMethodId) > 0 {
-			i -= len(x.VerificationMethodId)
-			copy(dAtA[i:], x.VerificationMethodId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
+		if len(x.Relationships) > 0 {
+			for iNdEx := len(x.Relationships) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Relationships[iNdEx])
+				copy(dAtA[i:], x.Relationships[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relationships[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.VerificationMethod != nil {
+			encoded, err := options.Marshal(x.VerificationMethod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -5164,7 +5128,7 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveVerificationMethod)
+		x := input.Message.Interface().(*MsgAddVerificationMethod)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5196,10 +5160,10 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethod: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethod: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5268,7 +5232,43 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VerificationMethod == nil {
+					x.VerificationMethod = &VerificationMethod{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationMethod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5296,7 +5296,7 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				x.Relationships = append(x.Relationships, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5334,23 +5334,23 @@ func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgRemoveVerificationMethodResponse protoreflect.MessageDescriptor
+	md_MsgAddVerificationMethodResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRemoveVerificationMethodResponse = File_did_v1_tx_proto.Messages().ByName("MsgRemoveVerificationMethodResponse")
+	md_MsgAddVerificationMethodResponse = File_did_v1_tx_proto.Messages().ByName("MsgAddVerificationMethodResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveVerificationMethodResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddVerificationMethodResponse)(nil)
 
-type fastReflection_MsgRemoveVerificationMethodResponse MsgRemoveVerificationMethodResponse
+type fastReflection_MsgAddVerificationMethodResponse MsgAddVerificationMethodResponse
 
-func (x *MsgRemoveVerificationMethodResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveVerificationMethodResponse)(x)
+func (x *MsgAddVerificationMethodResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddVerificationMethodResponse)(x)
 }
 
-func (x *MsgRemoveVerificationMethodResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddVerificationMethodResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5362,43 +5362,43 @@ func (x *MsgRemoveVerificationMethodResponse) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveVerificationMethodResponse_messageType fastReflection_MsgRemoveVerificationMethodResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveVerificationMethodResponse_messageType{}
+var _fastReflection_MsgAddVerificationMethodResponse_messageType fastReflection_MsgAddVerificationMethodResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddVerificationMethodResponse_messageType{}
 
-type fastReflection_MsgRemoveVerificationMethodResponse_messageType struct{}
+type fastReflection_MsgAddVerificationMethodResponse_messageType struct{}
 
-func (x fastReflection_MsgRemoveVerificationMethodResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveVerificationMethodResponse)(nil)
+func (x fastReflection_MsgAddVerificationMethodResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddVerificationMethodResponse)(nil)
 }
-func (x fastReflection_MsgRemoveVerificationMethodResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveVerificationMethodResponse)
+func (x fastReflection_MsgAddVerificationMethodResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddVerificationMethodResponse)
 }
-func (x fastReflection_MsgRemoveVerificationMethodResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveVerificationMethodResponse
+func (x fastReflection_MsgAddVerificationMethodResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddVerificationMethodResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveVerificationMethodResponse
+func (x *fastReflection_MsgAddVerificationMethodResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddVerificationMethodResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveVerificationMethodResponse_messageType
+func (x *fastReflection_MsgAddVerificationMethodResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddVerificationMethodResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveVerificationMethodResponse)
+func (x *fastReflection_MsgAddVerificationMethodResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAddVerificationMethodResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveVerificationMethodResponse)(x)
+func (x *fastReflection_MsgAddVerificationMethodResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddVerificationMethodResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5406,7 +5406,7 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddVerificationMethodResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5420,13 +5420,13 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddVerificationMethodResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5436,13 +5436,13 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddVerificationMethodResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5452,13 +5452,13 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddVerificationMethodResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5472,13 +5472,13 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddVerificationMethodResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5492,36 +5492,36 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddVerificationMethodResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddVerificationMethodResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddVerificationMethodResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveVerificationMethodResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddVerificationMethodResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5529,7 +5529,7 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddVerificationMethodResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5540,7 +5540,7 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddVerificationMethodResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5552,7 +5552,7 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) IsValid() bool {
+func (x *fastReflection_MsgAddVerificationMethodResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5562,9 +5562,9 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveVerificationMethodResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddVerificationMethodResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveVerificationMethodResponse)
+		x := input.Message.Interface().(*MsgAddVerificationMethodResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5586,7 +5586,7 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveVerificationMethodResponse)
+		x := input.Message.Interface().(*MsgAddVerificationMethodResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5616,7 +5616,7 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveVerificationMethodResponse)
+		x := input.Message.Interface().(*MsgAddVerificationMethodResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5648,10 +5648,10 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethodResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5690,29 +5690,29 @@ func (x *fastReflection_MsgRemoveVerificationMethodResponse) ProtoMethods() *pro
 }
 
 var (
-	md_MsgAddService            protoreflect.MessageDescriptor
-	fd_MsgAddService_controller protoreflect.FieldDescriptor
-	fd_MsgAddService_did        protoreflect.FieldDescriptor
-	fd_MsgAddService_service    protoreflect.FieldDescriptor
+	md_MsgRemoveVerificationMethod                        protoreflect.MessageDescriptor
+	fd_MsgRemoveVerificationMethod_controller             protoreflect.FieldDescriptor
+	fd_MsgRemoveVerificationMethod_did                    protoreflect.FieldDescriptor
+	fd_MsgRemoveVerificationMethod_verification_method_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgAddService = File_did_v1_tx_proto.Messages().ByName("MsgAddService")
-	fd_MsgAddService_controller = md_MsgAddService.Fields().ByName("controller")
-	fd_MsgAddService_did = md_MsgAddService.Fields().ByName("did")
-	fd_MsgAddService_service = md_MsgAddService.Fields().ByName("service")
+	md_MsgRemoveVerificationMethod = File_did_v1_tx_proto.Messages().ByName("MsgRemoveVerificationMethod")
+	fd_MsgRemoveVerificationMethod_controller = md_MsgRemoveVerificationMethod.Fields().ByName("controller")
+	fd_MsgRemoveVerificationMethod_did = md_MsgRemoveVerificationMethod.Fields().ByName("did")
+	fd_MsgRemoveVerificationMethod_verification_method_id = md_MsgRemoveVerificationMethod.Fields().ByName("verification_method_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddService)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRemoveVerificationMethod)(nil)
 
-type fastReflection_MsgAddService MsgAddService
+type fastReflection_MsgRemoveVerificationMethod MsgRemoveVerificationMethod
 
-func (x *MsgAddService) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddService)(x)
+func (x *MsgRemoveVerificationMethod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveVerificationMethod)(x)
 }
 
-func (x *MsgAddService) slowProtoReflect() protoreflect.Message {
+func (x *MsgRemoveVerificationMethod) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5724,43 +5724,43 @@ func (x *MsgAddService) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddService_messageType fastReflection_MsgAddService_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddService_messageType{}
+var _fastReflection_MsgRemoveVerificationMethod_messageType fastReflection_MsgRemoveVerificationMethod_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveVerificationMethod_messageType{}
 
-type fastReflection_MsgAddService_messageType struct{}
+type fastReflection_MsgRemoveVerificationMethod_messageType struct{}
 
-func (x fastReflection_MsgAddService_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddService)(nil)
+func (x fastReflection_MsgRemoveVerificationMethod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveVerificationMethod)(nil)
 }
-func (x fastReflection_MsgAddService_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddService)
+func (x fastReflection_MsgRemoveVerificationMethod_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveVerificationMethod)
 }
-func (x fastReflection_MsgAddService_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddService
+func (x fastReflection_MsgRemoveVerificationMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveVerificationMethod
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddService) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddService
+func (x *fastReflection_MsgRemoveVerificationMethod) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveVerificationMethod
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddService) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddService_messageType
+func (x *fastReflection_MsgRemoveVerificationMethod) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveVerificationMethod_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddService) New() protoreflect.Message {
-	return new(fastReflection_MsgAddService)
+func (x *fastReflection_MsgRemoveVerificationMethod) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveVerificationMethod)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddService) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddService)(x)
+func (x *fastReflection_MsgRemoveVerificationMethod) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveVerificationMethod)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5768,22 +5768,22 @@ func (x *fastReflection_MsgAddService) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddService) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRemoveVerificationMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Controller != "" {
 		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_MsgAddService_controller, value) {
+		if !f(fd_MsgRemoveVerificationMethod_controller, value) {
 			return
 		}
 	}
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_MsgAddService_did, value) {
+		if !f(fd_MsgRemoveVerificationMethod_did, value) {
 			return
 		}
 	}
-	if x.Service != nil {
-		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
-		if !f(fd_MsgAddService_service, value) {
+	if x.VerificationMethodId != "" {
+		value := protoreflect.ValueOfString(x.VerificationMethodId)
+		if !f(fd_MsgRemoveVerificationMethod_verification_method_id, value) {
 			return
 		}
 	}
@@ -5800,19 +5800,19 @@ func (x *fastReflection_MsgAddService) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddService) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRemoveVerificationMethod) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgAddService.controller":
+	case "did.v1.MsgRemoveVerificationMethod.controller":
 		return x.Controller != ""
-	case "did.v1.MsgAddService.did":
+	case "did.v1.MsgRemoveVerificationMethod.did":
 		return x.Did != ""
-	case "did.v1.MsgAddService.service":
-		return x.Service != nil
+	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
+		return x.VerificationMethodId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5822,19 +5822,19 @@ func (x *fastReflection_MsgAddService) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddService) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRemoveVerificationMethod) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgAddService.controller":
+	case "did.v1.MsgRemoveVerificationMethod.controller":
 		x.Controller = ""
-	case "did.v1.MsgAddService.did":
+	case "did.v1.MsgRemoveVerificationMethod.did":
 		x.Did = ""
-	case "did.v1.MsgAddService.service":
-		x.Service = nil
+	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
+		x.VerificationMethodId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5844,22 +5844,22 @@ func (x *fastReflection_MsgAddService) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddService) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveVerificationMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgAddService.controller":
+	case "did.v1.MsgRemoveVerificationMethod.controller":
 		value := x.Controller
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgAddService.did":
+	case "did.v1.MsgRemoveVerificationMethod.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgAddService.service":
-		value := x.Service
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
+		value := x.VerificationMethodId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5873,19 +5873,19 @@ func (x *fastReflection_MsgAddService) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddService) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRemoveVerificationMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgAddService.controller":
+	case "did.v1.MsgRemoveVerificationMethod.controller":
 		x.Controller = value.Interface().(string)
-	case "did.v1.MsgAddService.did":
+	case "did.v1.MsgRemoveVerificationMethod.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.MsgAddService.service":
-		x.Service = value.Message().Interface().(*Service)
+	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
+		x.VerificationMethodId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5899,52 +5899,48 @@ func (x *fastReflection_MsgAddService) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddService) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveVerificationMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgAddService.service":
-		if x.Service == nil {
-			x.Service = new(Service)
-		}
-		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
-	case "did.v1.MsgAddService.controller":
-		panic(fmt.Errorf("field controller of message did.v1.MsgAddService is not mutable"))
-	case "did.v1.MsgAddService.did":
-		panic(fmt.Errorf("field did of message did.v1.MsgAddService is not mutable"))
+	case "did.v1.MsgRemoveVerificationMethod.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgRemoveVerificationMethod is not mutable"))
+	case "did.v1.MsgRemoveVerificationMethod.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgRemoveVerificationMethod is not mutable"))
+	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgRemoveVerificationMethod is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddService) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveVerificationMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgAddService.controller":
+	case "did.v1.MsgRemoveVerificationMethod.controller":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgAddService.did":
+	case "did.v1.MsgRemoveVerificationMethod.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRemoveVerificationMethod.verification_method_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgAddService.service":
-		m := new(Service)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethod"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethod does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddService) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRemoveVerificationMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddService", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveVerificationMethod", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5952,7 +5948,7 @@ func (x *fastReflection_MsgAddService) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddService) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRemoveVerificationMethod) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5963,7 +5959,7 @@ func (x *fastReflection_MsgAddService) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddService) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRemoveVerificationMethod) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5975,7 +5971,7 @@ func (x *fastReflection_MsgAddService) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddService) IsValid() bool {
+func (x *fastReflection_MsgRemoveVerificationMethod) IsValid() bool {
 	return x != nil
 }
 
@@ -5985,9 +5981,9 @@ func (x *fastReflection_MsgAddService) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRemoveVerificationMethod) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddService)
+		x := input.Message.Interface().(*MsgRemoveVerificationMethod)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6007,8 +6003,8 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Service != nil {
-			l = options.Size(x.Service)
+		l = len(x.VerificationMethodId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6021,7 +6017,7 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddService)
+		x := input.Message.Interface().(*MsgRemoveVerificationMethod)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6040,17 +6036,10 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Service != nil {
-			encoded, err := options.Marshal(x.Service)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.VerificationMethodId) > 0 {
+			i -= len(x.VerificationMethodId)
+			copy(dAtA[i:], x.VerificationMethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6079,7 +6068,7 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddService)
+		x := input.Message.Interface().(*MsgRemoveVerificationMethod)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6111,10 +6100,10 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddService: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethod: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddService: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6183,9 +6172,9 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6195,27 +6184,23 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Service == nil {
-					x.Service = &Service{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6253,23 +6238,23 @@ func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgAddServiceResponse protoreflect.MessageDescriptor
+	md_MsgRemoveVerificationMethodResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgAddServiceResponse = File_did_v1_tx_proto.Messages().ByName("MsgAddServiceResponse")
+	md_MsgRemoveVerificationMethodResponse = File_did_v1_tx_proto.Messages().ByName("MsgRemoveVerificationMethodResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddServiceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRemoveVerificationMethodResponse)(nil)
 
-type fastReflection_MsgAddServiceResponse MsgAddServiceResponse
+type fastReflection_MsgRemoveVerificationMethodResponse MsgRemoveVerificationMethodResponse
 
-func (x *MsgAddServiceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddServiceResponse)(x)
+func (x *MsgRemoveVerificationMethodResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveVerificationMethodResponse)(x)
 }
 
-func (x *MsgAddServiceResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRemoveVerificationMethodResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6281,43 +6266,43 @@ func (x *MsgAddServiceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddServiceResponse_messageType fastReflection_MsgAddServiceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddServiceResponse_messageType{}
+var _fastReflection_MsgRemoveVerificationMethodResponse_messageType fastReflection_MsgRemoveVerificationMethodResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveVerificationMethodResponse_messageType{}
 
-type fastReflection_MsgAddServiceResponse_messageType struct{}
+type fastReflection_MsgRemoveVerificationMethodResponse_messageType struct{}
 
-func (x fastReflection_MsgAddServiceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddServiceResponse)(nil)
+func (x fastReflection_MsgRemoveVerificationMethodResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveVerificationMethodResponse)(nil)
 }
-func (x fastReflection_MsgAddServiceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddServiceResponse)
+func (x fastReflection_MsgRemoveVerificationMethodResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveVerificationMethodResponse)
 }
-func (x fastReflection_MsgAddServiceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddServiceResponse
+func (x fastReflection_MsgRemoveVerificationMethodResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveVerificationMethodResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddServiceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddServiceResponse
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveVerificationMethodResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddServiceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddServiceResponse_messageType
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveVerificationMethodResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddServiceResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgAddServiceResponse)
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveVerificationMethodResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddServiceResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddServiceResponse)(x)
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveVerificationMethodResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6325,7 +6310,7 @@ func (x *fastReflection_MsgAddServiceResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddServiceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6339,13 +6324,13 @@ func (x *fastReflection_MsgAddServiceResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddServiceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6355,13 +6340,13 @@ func (x *fastReflection_MsgAddServiceResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddServiceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6371,13 +6356,13 @@ func (x *fastReflection_MsgAddServiceResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddServiceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6391,13 +6376,13 @@ func (x *fastReflection_MsgAddServiceResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddServiceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6411,36 +6396,36 @@ func (x *fastReflection_MsgAddServiceResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddServiceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddServiceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveVerificationMethodResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveVerificationMethodResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddServiceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddServiceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveVerificationMethodResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6448,7 +6433,7 @@ func (x *fastReflection_MsgAddServiceResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddServiceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6459,7 +6444,7 @@ func (x *fastReflection_MsgAddServiceResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddServiceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6471,7 +6456,7 @@ func (x *fastReflection_MsgAddServiceResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddServiceResponse) IsValid() bool {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6481,9 +6466,9 @@ func (x *fastReflection_MsgAddServiceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRemoveVerificationMethodResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddServiceResponse)
+		x := input.Message.Interface().(*MsgRemoveVerificationMethodResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6505,7 +6490,7 @@ func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddServiceResponse)
+		x := input.Message.Interface().(*MsgRemoveVerificationMethodResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6535,7 +6520,7 @@ func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddServiceResponse)
+		x := input.Message.Interface().(*MsgRemoveVerificationMethodResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6567,10 +6552,10 @@ func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddServiceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -6609,29 +6594,29 @@ func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgRemoveService            protoreflect.MessageDescriptor
-	fd_MsgRemoveService_controller protoreflect.FieldDescriptor
-	fd_MsgRemoveService_did        protoreflect.FieldDescriptor
-	fd_MsgRemoveService_service_id protoreflect.FieldDescriptor
+	md_MsgAddService            protoreflect.MessageDescriptor
+	fd_MsgAddService_controller protoreflect.FieldDescriptor
+	fd_MsgAddService_did        protoreflect.FieldDescriptor
+	fd_MsgAddService_service    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRemoveService = File_did_v1_tx_proto.Messages().ByName("MsgRemoveService")
-	fd_MsgRemoveService_controller = md_MsgRemoveService.Fields().ByName("controller")
-	fd_MsgRemoveService_did = md_MsgRemoveService.Fields().ByName("did")
-	fd_MsgRemoveService_service_id = md_MsgRemoveService.Fields().ByName("service_id")
+	md_MsgAddService = File_did_v1_tx_proto.Messages().ByName("MsgAddService")
+	fd_MsgAddService_controller = md_MsgAddService.Fields().ByName("controller")
+	fd_MsgAddService_did = md_MsgAddService.Fields().ByName("did")
+	fd_MsgAddService_service = md_MsgAddService.Fields().ByName("service")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveService)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddService)(nil)
 
-type fastReflection_MsgRemoveService MsgRemoveService
+type fastReflection_MsgAddService MsgAddService
 
-func (x *MsgRemoveService) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveService)(x)
+func (x *MsgAddService) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddService)(x)
 }
 
-func (x *MsgRemoveService) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddService) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6643,43 +6628,43 @@ func (x *MsgRemoveService) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveService_messageType fastReflection_MsgRemoveService_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveService_messageType{}
+var _fastReflection_MsgAddService_messageType fastReflection_MsgAddService_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddService_messageType{}
 
-type fastReflection_MsgRemoveService_messageType struct{}
+type fastReflection_MsgAddService_messageType struct{}
 
-func (x fastReflection_MsgRemoveService_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveService)(nil)
+func (x fastReflection_MsgAddService_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddService)(nil)
 }
-func (x fastReflection_MsgRemoveService_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveService)
+func (x fastReflection_MsgAddService_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddService)
 }
-func (x fastReflection_MsgRemoveService_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveService
+func (x fastReflection_MsgAddService_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddService
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveService) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveService
+func (x *fastReflection_MsgAddService) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddService
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveService) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveService_messageType
+func (x *fastReflection_MsgAddService) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddService_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveService) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveService)
+func (x *fastReflection_MsgAddService) New() protoreflect.Message {
+	return new(fastReflection_MsgAddService)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveService) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveService)(x)
+func (x *fastReflection_MsgAddService) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddService)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6687,22 +6672,22 @@ func (x *fastReflection_MsgRemoveService) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveService) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddService) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Controller != "" {
 		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_MsgRemoveService_controller, value) {
+		if !f(fd_MsgAddService_controller, value) {
 			return
 		}
 	}
 	if x.Did != "" {
 		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_MsgRemoveService_did, value) {
+		if !f(fd_MsgAddService_did, value) {
 			return
 		}
 	}
-	if x.ServiceId != "" {
-		value := protoreflect.ValueOfString(x.ServiceId)
-		if !f(fd_MsgRemoveService_service_id, value) {
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_MsgAddService_service, value) {
 			return
 		}
 	}
@@ -6719,19 +6704,19 @@ func (x *fastReflection_MsgRemoveService) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveService) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddService) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveService.controller":
+	case "did.v1.MsgAddService.controller":
 		return x.Controller != ""
-	case "did.v1.MsgRemoveService.did":
+	case "did.v1.MsgAddService.did":
 		return x.Did != ""
-	case "did.v1.MsgRemoveService.service_id":
-		return x.ServiceId != ""
+	case "did.v1.MsgAddService.service":
+		return x.Service != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6741,19 +6726,19 @@ func (x *fastReflection_MsgRemoveService) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveService) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddService) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveService.controller":
+	case "did.v1.MsgAddService.controller":
 		x.Controller = ""
-	case "did.v1.MsgRemoveService.did":
+	case "did.v1.MsgAddService.did":
 		x.Did = ""
-	case "did.v1.MsgRemoveService.service_id":
-		x.ServiceId = ""
+	case "did.v1.MsgAddService.service":
+		x.Service = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6763,22 +6748,22 @@ func (x *fastReflection_MsgRemoveService) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveService) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddService) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgRemoveService.controller":
+	case "did.v1.MsgAddService.controller":
 		value := x.Controller
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRemoveService.did":
+	case "did.v1.MsgAddService.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRemoveService.service_id":
-		value := x.ServiceId
-		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAddService.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6792,19 +6777,19 @@ func (x *fastReflection_MsgRemoveService) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveService) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddService) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveService.controller":
+	case "did.v1.MsgAddService.controller":
 		x.Controller = value.Interface().(string)
-	case "did.v1.MsgRemoveService.did":
+	case "did.v1.MsgAddService.did":
 		x.Did = value.Interface().(string)
-	case "did.v1.MsgRemoveService.service_id":
-		x.ServiceId = value.Interface().(string)
+	case "did.v1.MsgAddService.service":
+		x.Service = value.Message().Interface().(*Service)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6818,48 +6803,52 @@ func (x *fastReflection_MsgRemoveService) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveService) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddService) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveService.controller":
-		panic(fmt.Errorf("field controller of message did.v1.MsgRemoveService is not mutable"))
-	case "did.v1.MsgRemoveService.did":
-		panic(fmt.Errorf("field did of message did.v1.MsgRemoveService is not mutable"))
-	case "did.v1.MsgRemoveService.service_id":
-		panic(fmt.Errorf("field service_id of message did.v1.MsgRemoveService is not mutable"))
+	case "did.v1.MsgAddService.service":
+		if x.Service == nil {
+			x.Service = new(Service)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	case "did.v1.MsgAddService.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgAddService is not mutable"))
+	case "did.v1.MsgAddService.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgAddService is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveService) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddService) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRemoveService.controller":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRemoveService.did":
+	case "did.v1.MsgAddService.controller":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRemoveService.service_id":
+	case "did.v1.MsgAddService.did":
 		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAddService.service":
+		m := new(Service)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddService does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveService) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddService) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveService", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddService", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6867,7 +6856,7 @@ func (x *fastReflection_MsgRemoveService) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveService) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddService) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6878,7 +6867,7 @@ func (x *fastReflection_MsgRemoveService) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveService) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddService) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6890,7 +6879,7 @@ func (x *fastReflection_MsgRemoveService) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveService) IsValid() bool {
+func (x *fastReflection_MsgAddService) IsValid() bool {
 	return x != nil
 }
 
@@ -6900,9 +6889,9 @@ func (x *fastReflection_MsgRemoveService) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddService) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveService)
+		x := input.Message.Interface().(*MsgAddService)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6922,8 +6911,8 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ServiceId)
-		if l > 0 {
+		if x.Service != nil {
+			l = options.Size(x.Service)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6936,7 +6925,7 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveService)
+		x := input.Message.Interface().(*MsgAddService)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6955,10 +6944,17 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ServiceId) > 0 {
-			i -= len(x.ServiceId)
-			copy(dAtA[i:], x.ServiceId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6987,7 +6983,7 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveService)
+		x := input.Message.Interface().(*MsgAddService)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7019,10 +7015,10 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveService: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddService: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveService: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddService: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7091,9 +7087,9 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7103,23 +7099,27 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				if x.Service == nil {
+					x.Service = &Service{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7157,23 +7157,23 @@ func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRemoveServiceResponse protoreflect.MessageDescriptor
+	md_MsgAddServiceResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRemoveServiceResponse = File_did_v1_tx_proto.Messages().ByName("MsgRemoveServiceResponse")
+	md_MsgAddServiceResponse = File_did_v1_tx_proto.Messages().ByName("MsgAddServiceResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveServiceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddServiceResponse)(nil)
 
-type fastReflection_MsgRemoveServiceResponse MsgRemoveServiceResponse
+type fastReflection_MsgAddServiceResponse MsgAddServiceResponse
 
-func (x *MsgRemoveServiceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveServiceResponse)(x)
+func (x *MsgAddServiceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddServiceResponse)(x)
 }
 
-func (x *MsgRemoveServiceResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddServiceResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7185,43 +7185,43 @@ func (x *MsgRemoveServiceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveServiceResponse_messageType fastReflection_MsgRemoveServiceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveServiceResponse_messageType{}
+var _fastReflection_MsgAddServiceResponse_messageType fastReflection_MsgAddServiceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddServiceResponse_messageType{}
 
-type fastReflection_MsgRemoveServiceResponse_messageType struct{}
+type fastReflection_MsgAddServiceResponse_messageType struct{}
 
-func (x fastReflection_MsgRemoveServiceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveServiceResponse)(nil)
+func (x fastReflection_MsgAddServiceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddServiceResponse)(nil)
 }
-func (x fastReflection_MsgRemoveServiceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveServiceResponse)
+func (x fastReflection_MsgAddServiceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddServiceResponse)
 }
-func (x fastReflection_MsgRemoveServiceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveServiceResponse
+func (x fastReflection_MsgAddServiceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddServiceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveServiceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveServiceResponse
+func (x *fastReflection_MsgAddServiceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddServiceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveServiceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveServiceResponse_messageType
+func (x *fastReflection_MsgAddServiceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddServiceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveServiceResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveServiceResponse)
+func (x *fastReflection_MsgAddServiceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAddServiceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveServiceResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveServiceResponse)(x)
+func (x *fastReflection_MsgAddServiceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddServiceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7229,7 +7229,7 @@ func (x *fastReflection_MsgRemoveServiceResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveServiceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddServiceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7243,13 +7243,13 @@ func (x *fastReflection_MsgRemoveServiceResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveServiceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddServiceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7259,13 +7259,13 @@ func (x *fastReflection_MsgRemoveServiceResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveServiceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddServiceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7275,13 +7275,13 @@ func (x *fastReflection_MsgRemoveServiceResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveServiceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddServiceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7295,13 +7295,13 @@ func (x *fastReflection_MsgRemoveServiceResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveServiceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddServiceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7315,36 +7315,36 @@ func (x *fastReflection_MsgRemoveServiceResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveServiceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddServiceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveServiceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddServiceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgAddServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveServiceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddServiceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveServiceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddServiceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7352,7 +7352,7 @@ func (x *fastReflection_MsgRemoveServiceResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveServiceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddServiceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7363,7 +7363,7 @@ func (x *fastReflection_MsgRemoveServiceResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveServiceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddServiceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7375,7 +7375,7 @@ func (x *fastReflection_MsgRemoveServiceResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveServiceResponse) IsValid() bool {
+func (x *fastReflection_MsgAddServiceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7385,9 +7385,9 @@ func (x *fastReflection_MsgRemoveServiceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveServiceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveServiceResponse)
+		x := input.Message.Interface().(*MsgAddServiceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7409,7 +7409,7 @@ func (x *fastReflection_MsgRemoveServiceResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveServiceResponse)
+		x := input.Message.Interface().(*MsgAddServiceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7439,7 +7439,7 @@ func (x *fastReflection_MsgRemoveServiceResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveServiceResponse)
+		x := input.Message.Interface().(*MsgAddServiceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7471,10 +7471,10 @@ func (x *fastReflection_MsgRemoveServiceResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveServiceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddServiceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7513,27 +7513,29 @@ func (x *fastReflection_MsgRemoveServiceResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_MsgIssueVerifiableCredential            protoreflect.MessageDescriptor
-	fd_MsgIssueVerifiableCredential_issuer     protoreflect.FieldDescriptor
-	fd_MsgIssueVerifiableCredential_credential protoreflect.FieldDescriptor
+	md_MsgRemoveService            protoreflect.MessageDescriptor
+	fd_MsgRemoveService_controller protoreflect.FieldDescriptor
+	fd_MsgRemoveService_did        protoreflect.FieldDescriptor
+	fd_MsgRemoveService_service_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgIssueVerifiableCredential = File_did_v1_tx_proto.Messages().ByName("MsgIssueVerifiableCredential")
-	fd_MsgIssueVerifiableCredential_issuer = md_MsgIssueVerifiableCredential.Fields().ByName("issuer")
-	fd_MsgIssueVerifiableCredential_credential = md_MsgIssueVerifiableCredential.Fields().ByName("credential")
+	md_MsgRemoveService = File_did_v1_tx_proto.Messages().ByName("MsgRemoveService")
+	fd_MsgRemoveService_controller = md_MsgRemoveService.Fields().ByName("controller")
+	fd_MsgRemoveService_did = md_MsgRemoveService.Fields().ByName("did")
+	fd_MsgRemoveService_service_id = md_MsgRemoveService.Fields().ByName("service_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgIssueVerifiableCredential)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRemoveService)(nil)
 
-type fastReflection_MsgIssueVerifiableCredential MsgIssueVerifiableCredential
+type fastReflection_MsgRemoveService MsgRemoveService
 
-func (x *MsgIssueVerifiableCredential) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgIssueVerifiableCredential)(x)
+func (x *MsgRemoveService) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveService)(x)
 }
 
-func (x *MsgIssueVerifiableCredential) slowProtoReflect() protoreflect.Message {
+func (x *MsgRemoveService) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7545,43 +7547,43 @@ func (x *MsgIssueVerifiableCredential) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgIssueVerifiableCredential_messageType fastReflection_MsgIssueVerifiableCredential_messageType
-var _ protoreflect.MessageType = fastReflection_MsgIssueVerifiableCredential_messageType{}
+var _fastReflection_MsgRemoveService_messageType fastReflection_MsgRemoveService_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveService_messageType{}
 
-type fastReflection_MsgIssueVerifiableCredential_messageType struct{}
+type fastReflection_MsgRemoveService_messageType struct{}
 
-func (x fastReflection_MsgIssueVerifiableCredential_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgIssueVerifiableCredential)(nil)
+func (x fastReflection_MsgRemoveService_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveService)(nil)
 }
-func (x fastReflection_MsgIssueVerifiableCredential_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgIssueVerifiableCredential)
+func (x fastReflection_MsgRemoveService_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveService)
 }
-func (x fastReflection_MsgIssueVerifiableCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgIssueVerifiableCredential
+func (x fastReflection_MsgRemoveService_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveService
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgIssueVerifiableCredential) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgIssueVerifiableCredential
+func (x *fastReflection_MsgRemoveService) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveService
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgIssueVerifiableCredential) Type() protoreflect.MessageType {
-	return _fastReflection_MsgIssueVerifiableCredential_messageType
+func (x *fastReflection_MsgRemoveService) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveService_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgIssueVerifiableCredential) New() protoreflect.Message {
-	return new(fastReflection_MsgIssueVerifiableCredential)
+func (x *fastReflection_MsgRemoveService) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveService)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgIssueVerifiableCredential) Interface() protoreflect.ProtoMessage {
-	return (*MsgIssueVerifiableCredential)(x)
+func (x *fastReflection_MsgRemoveService) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveService)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7589,16 +7591,22 @@ func (x *fastReflection_MsgIssueVerifiableCredential) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgIssueVerifiableCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Issuer != "" {
-		value := protoreflect.ValueOfString(x.Issuer)
-		if !f(fd_MsgIssueVerifiableCredential_issuer, value) {
+func (x *fastReflection_MsgRemoveService) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgRemoveService_controller, value) {
 			return
 		}
 	}
-	if x.Credential != nil {
-		value := protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
-		if !f(fd_MsgIssueVerifiableCredential_credential, value) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgRemoveService_did, value) {
+			return
+		}
+	}
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_MsgRemoveService_service_id, value) {
 			return
 		}
 	}
@@ -7615,17 +7623,19 @@ func (x *fastReflection_MsgIssueVerifiableCredential) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgIssueVerifiableCredential) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRemoveService) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredential.issuer":
-		return x.Issuer != ""
-	case "did.v1.MsgIssueVerifiableCredential.credential":
-		return x.Credential != nil
+	case "did.v1.MsgRemoveService.controller":
+		return x.Controller != ""
+	case "did.v1.MsgRemoveService.did":
+		return x.Did != ""
+	case "did.v1.MsgRemoveService.service_id":
+		return x.ServiceId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7635,17 +7645,19 @@ func (x *fastReflection_MsgIssueVerifiableCredential) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredential) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRemoveService) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredential.issuer":
-		x.Issuer = ""
-	case "did.v1.MsgIssueVerifiableCredential.credential":
-		x.Credential = nil
+	case "did.v1.MsgRemoveService.controller":
+		x.Controller = ""
+	case "did.v1.MsgRemoveService.did":
+		x.Did = ""
+	case "did.v1.MsgRemoveService.service_id":
+		x.ServiceId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7655,19 +7667,22 @@ func (x *fastReflection_MsgIssueVerifiableCredential) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgIssueVerifiableCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveService) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgIssueVerifiableCredential.issuer":
-		value := x.Issuer
+	case "did.v1.MsgRemoveService.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRemoveService.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRemoveService.service_id":
+		value := x.ServiceId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgIssueVerifiableCredential.credential":
-		value := x.Credential
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7681,17 +7696,19 @@ func (x *fastReflection_MsgIssueVerifiableCredential) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRemoveService) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredential.issuer":
-		x.Issuer = value.Interface().(string)
-	case "did.v1.MsgIssueVerifiableCredential.credential":
-		x.Credential = value.Message().Interface().(*VerifiableCredential)
+	case "did.v1.MsgRemoveService.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.MsgRemoveService.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgRemoveService.service_id":
+		x.ServiceId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7705,48 +7722,48 @@ func (x *fastReflection_MsgIssueVerifiableCredential) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveService) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredential.credential":
-		if x.Credential == nil {
-			x.Credential = new(VerifiableCredential)
-		}
-		return protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
-	case "did.v1.MsgIssueVerifiableCredential.issuer":
-		panic(fmt.Errorf("field issuer of message did.v1.MsgIssueVerifiableCredential is not mutable"))
+	case "did.v1.MsgRemoveService.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgRemoveService is not mutable"))
+	case "did.v1.MsgRemoveService.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgRemoveService is not mutable"))
+	case "did.v1.MsgRemoveService.service_id":
+		panic(fmt.Errorf("field service_id of message did.v1.MsgRemoveService is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgIssueVerifiableCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveService) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredential.issuer":
+	case "did.v1.MsgRemoveService.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRemoveService.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRemoveService.service_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgIssueVerifiableCredential.credential":
-		m := new(VerifiableCredential)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveService"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveService does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgIssueVerifiableCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRemoveService) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgIssueVerifiableCredential", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveService", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7754,7 +7771,7 @@ func (x *fastReflection_MsgIssueVerifiableCredential) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgIssueVerifiableCredential) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRemoveService) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7765,7 +7782,7 @@ func (x *fastReflection_MsgIssueVerifiableCredential) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredential) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRemoveService) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7777,7 +7794,7 @@ func (x *fastReflection_MsgIssueVerifiableCredential) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgIssueVerifiableCredential) IsValid() bool {
+func (x *fastReflection_MsgRemoveService) IsValid() bool {
 	return x != nil
 }
 
@@ -7787,9 +7804,9 @@ func (x *fastReflection_MsgIssueVerifiableCredential) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRemoveService) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgIssueVerifiableCredential)
+		x := input.Message.Interface().(*MsgRemoveService)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7801,12 +7818,16 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.Issuer)
+		l = len(x.Controller)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Credential != nil {
-			l = options.Size(x.Credential)
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ServiceId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7819,7 +7840,7 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgIssueVerifiableCredential)
+		x := input.Message.Interface().(*MsgRemoveService)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7838,24 +7859,24 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Credential != nil {
-			encoded, err := options.Marshal(x.Credential)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Issuer) > 0 {
-			i -= len(x.Issuer)
-			copy(dAtA[i:], x.Issuer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7870,7 +7891,7 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgIssueVerifiableCredential)
+		x := input.Message.Interface().(*MsgRemoveService)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7902,15 +7923,15 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredential: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveService: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveService: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7938,13 +7959,13 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Issuer = string(dAtA[iNdEx:postIndex])
+				x.Controller = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7954,27 +7975,55 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Credential == nil {
-					x.Credential = &VerifiableCredential{}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credential); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8012,25 +8061,23 @@ func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface
 }
 
 var (
-	md_MsgIssueVerifiableCredentialResponse               protoreflect.MessageDescriptor
-	fd_MsgIssueVerifiableCredentialResponse_credential_id protoreflect.FieldDescriptor
+	md_MsgRemoveServiceResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgIssueVerifiableCredentialResponse = File_did_v1_tx_proto.Messages().ByName("MsgIssueVerifiableCredentialResponse")
-	fd_MsgIssueVerifiableCredentialResponse_credential_id = md_MsgIssueVerifiableCredentialResponse.Fields().ByName("credential_id")
+	md_MsgRemoveServiceResponse = File_did_v1_tx_proto.Messages().ByName("MsgRemoveServiceResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgIssueVerifiableCredentialResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRemoveServiceResponse)(nil)
 
-type fastReflection_MsgIssueVerifiableCredentialResponse MsgIssueVerifiableCredentialResponse
+type fastReflection_MsgRemoveServiceResponse MsgRemoveServiceResponse
 
-func (x *MsgIssueVerifiableCredentialResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgIssueVerifiableCredentialResponse)(x)
+func (x *MsgRemoveServiceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveServiceResponse)(x)
 }
 
-func (x *MsgIssueVerifiableCredentialResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRemoveServiceResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8042,43 +8089,43 @@ func (x *MsgIssueVerifiableCredentialResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgIssueVerifiableCredentialResponse_messageType fastReflection_MsgIssueVerifiableCredentialResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgIssueVerifiableCredentialResponse_messageType{}
+var _fastReflection_MsgRemoveServiceResponse_messageType fastReflection_MsgRemoveServiceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveServiceResponse_messageType{}
 
-type fastReflection_MsgIssueVerifiableCredentialResponse_messageType struct{}
+type fastReflection_MsgRemoveServiceResponse_messageType struct{}
 
-func (x fastReflection_MsgIssueVerifiableCredentialResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgIssueVerifiableCredentialResponse)(nil)
+func (x fastReflection_MsgRemoveServiceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveServiceResponse)(nil)
 }
-func (x fastReflection_MsgIssueVerifiableCredentialResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgIssueVerifiableCredentialResponse)
+func (x fastReflection_MsgRemoveServiceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveServiceResponse)
 }
-func (x fastReflection_MsgIssueVerifiableCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgIssueVerifiableCredentialResponse
+func (x fastReflection_MsgRemoveServiceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveServiceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgIssueVerifiableCredentialResponse
+func (x *fastReflection_MsgRemoveServiceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveServiceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgIssueVerifiableCredentialResponse_messageType
+func (x *fastReflection_MsgRemoveServiceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveServiceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgIssueVerifiableCredentialResponse)
+func (x *fastReflection_MsgRemoveServiceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveServiceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgIssueVerifiableCredentialResponse)(x)
+func (x *fastReflection_MsgRemoveServiceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveServiceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8086,13 +8133,7 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CredentialId != "" {
-		value := protoreflect.ValueOfString(x.CredentialId)
-		if !f(fd_MsgIssueVerifiableCredentialResponse_credential_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgRemoveServiceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8106,15 +8147,13 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRemoveServiceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
-		return x.CredentialId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8124,15 +8163,13 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRemoveServiceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
-		x.CredentialId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8142,16 +8179,13 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveServiceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
-		value := x.CredentialId
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8165,15 +8199,13 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRemoveServiceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
-		x.CredentialId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8187,40 +8219,36 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveServiceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
-		panic(fmt.Errorf("field credential_id of message did.v1.MsgIssueVerifiableCredentialResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveServiceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveServiceResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRemoveServiceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRemoveServiceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgIssueVerifiableCredentialResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveServiceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8228,7 +8256,7 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRemoveServiceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8239,7 +8267,7 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRemoveServiceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8251,7 +8279,7 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) IsValid() bool {
+func (x *fastReflection_MsgRemoveServiceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8261,9 +8289,9 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRemoveServiceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgIssueVerifiableCredentialResponse)
+		x := input.Message.Interface().(*MsgRemoveServiceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8275,10 +8303,6 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		l = len(x.CredentialId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8289,7 +8313,7 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgIssueVerifiableCredentialResponse)
+		x := input.Message.Interface().(*MsgRemoveServiceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8308,13 +8332,6 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CredentialId) > 0 {
-			i -= len(x.CredentialId)
-			copy(dAtA[i:], x.CredentialId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8326,7 +8343,7 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgIssueVerifiableCredentialResponse)
+		x := input.Message.Interface().(*MsgRemoveServiceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8358,44 +8375,12 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveServiceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CredentialId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8432,29 +8417,27 @@ func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *pr
 }
 
 var (
-	md_MsgRevokeVerifiableCredential                   protoreflect.MessageDescriptor
-	fd_MsgRevokeVerifiableCredential_issuer            protoreflect.FieldDescriptor
-	fd_MsgRevokeVerifiableCredential_credential_id     protoreflect.FieldDescriptor
-	fd_MsgRevokeVerifiableCredential_revocation_reason protoreflect.FieldDescriptor
+	md_MsgIssueVerifiableCredential            protoreflect.MessageDescriptor
+	fd_MsgIssueVerifiableCredential_issuer     protoreflect.FieldDescriptor
+	fd_MsgIssueVerifiableCredential_credential protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRevokeVerifiableCredential = File_did_v1_tx_proto.Messages().ByName("MsgRevokeVerifiableCredential")
-	fd_MsgRevokeVerifiableCredential_issuer = md_MsgRevokeVerifiableCredential.Fields().ByName("issuer")
-	fd_MsgRevokeVerifiableCredential_credential_id = md_MsgRevokeVerifiableCredential.Fields().ByName("credential_id")
-	fd_MsgRevokeVerifiableCredential_revocation_reason = md_MsgRevokeVerifiableCredential.Fields().ByName("revocation_reason")
+	md_MsgIssueVerifiableCredential = File_did_v1_tx_proto.Messages().ByName("MsgIssueVerifiableCredential")
+	fd_MsgIssueVerifiableCredential_issuer = md_MsgIssueVerifiableCredential.Fields().ByName("issuer")
+	fd_MsgIssueVerifiableCredential_credential = md_MsgIssueVerifiableCredential.Fields().ByName("credential")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevokeVerifiableCredential)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgIssueVerifiableCredential)(nil)
 
-type fastReflection_MsgRevokeVerifiableCredential MsgRevokeVerifiableCredential
+type fastReflection_MsgIssueVerifiableCredential MsgIssueVerifiableCredential
 
-func (x *MsgRevokeVerifiableCredential) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevokeVerifiableCredential)(x)
+func (x *MsgIssueVerifiableCredential) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgIssueVerifiableCredential)(x)
 }
 
-func (x *MsgRevokeVerifiableCredential) slowProtoReflect() protoreflect.Message {
+func (x *MsgIssueVerifiableCredential) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8466,43 +8449,43 @@ func (x *MsgRevokeVerifiableCredential) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevokeVerifiableCredential_messageType fastReflection_MsgRevokeVerifiableCredential_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevokeVerifiableCredential_messageType{}
+var _fastReflection_MsgIssueVerifiableCredential_messageType fastReflection_MsgIssueVerifiableCredential_messageType
+var _ protoreflect.MessageType = fastReflection_MsgIssueVerifiableCredential_messageType{}
 
-type fastReflection_MsgRevokeVerifiableCredential_messageType struct{}
+type fastReflection_MsgIssueVerifiableCredential_messageType struct{}
 
-func (x fastReflection_MsgRevokeVerifiableCredential_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevokeVerifiableCredential)(nil)
+func (x fastReflection_MsgIssueVerifiableCredential_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgIssueVerifiableCredential)(nil)
 }
-func (x fastReflection_MsgRevokeVerifiableCredential_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeVerifiableCredential)
+func (x fastReflection_MsgIssueVerifiableCredential_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgIssueVerifiableCredential)
 }
-func (x fastReflection_MsgRevokeVerifiableCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeVerifiableCredential
+func (x fastReflection_MsgIssueVerifiableCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgIssueVerifiableCredential
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeVerifiableCredential
+func (x *fastReflection_MsgIssueVerifiableCredential) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgIssueVerifiableCredential
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevokeVerifiableCredential_messageType
+func (x *fastReflection_MsgIssueVerifiableCredential) Type() protoreflect.MessageType {
+	return _fastReflection_MsgIssueVerifiableCredential_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevokeVerifiableCredential) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeVerifiableCredential)
+func (x *fastReflection_MsgIssueVerifiableCredential) New() protoreflect.Message {
+	return new(fastReflection_MsgIssueVerifiableCredential)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevokeVerifiableCredential)(x)
+func (x *fastReflection_MsgIssueVerifiableCredential) Interface() protoreflect.ProtoMessage {
+	return (*MsgIssueVerifiableCredential)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8510,22 +8493,16 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgIssueVerifiableCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Issuer != "" {
 		value := protoreflect.ValueOfString(x.Issuer)
-		if !f(fd_MsgRevokeVerifiableCredential_issuer, value) {
-			return
-		}
-	}
-	if x.CredentialId != "" {
-		value := protoreflect.ValueOfString(x.CredentialId)
-		if !f(fd_MsgRevokeVerifiableCredential_credential_id, value) {
+		if !f(fd_MsgIssueVerifiableCredential_issuer, value) {
 			return
 		}
 	}
-	if x.RevocationReason != "" {
-		value := protoreflect.ValueOfString(x.RevocationReason)
-		if !f(fd_MsgRevokeVerifiableCredential_revocation_reason, value) {
+	if x.Credential != nil {
+		value := protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
+		if !f(fd_MsgIssueVerifiableCredential_credential, value) {
 			return
 		}
 	}
@@ -8542,19 +8519,17 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgIssueVerifiableCredential) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+	case "did.v1.MsgIssueVerifiableCredential.issuer":
 		return x.Issuer != ""
-	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
-		return x.CredentialId != ""
-	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
-		return x.RevocationReason != ""
+	case "did.v1.MsgIssueVerifiableCredential.credential":
+		return x.Credential != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8564,19 +8539,17 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgIssueVerifiableCredential) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+	case "did.v1.MsgIssueVerifiableCredential.issuer":
 		x.Issuer = ""
-	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
-		x.CredentialId = ""
-	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
-		x.RevocationReason = ""
+	case "did.v1.MsgIssueVerifiableCredential.credential":
+		x.Credential = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8586,22 +8559,19 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgIssueVerifiableCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+	case "did.v1.MsgIssueVerifiableCredential.issuer":
 		value := x.Issuer
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
-		value := x.CredentialId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
-		value := x.RevocationReason
-		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgIssueVerifiableCredential.credential":
+		value := x.Credential
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8615,19 +8585,17 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgIssueVerifiableCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+	case "did.v1.MsgIssueVerifiableCredential.issuer":
 		x.Issuer = value.Interface().(string)
-	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
-		x.CredentialId = value.Interface().(string)
-	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
-		x.RevocationReason = value.Interface().(string)
+	case "did.v1.MsgIssueVerifiableCredential.credential":
+		x.Credential = value.Message().Interface().(*VerifiableCredential)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8641,48 +8609,48 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgIssueVerifiableCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRevokeVerifiableCredential.issuer":
-		panic(fmt.Errorf("field issuer of message did.v1.MsgRevokeVerifiableCredential is not mutable"))
-	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
-		panic(fmt.Errorf("field credential_id of message did.v1.MsgRevokeVerifiableCredential is not mutable"))
-	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
-		panic(fmt.Errorf("field revocation_reason of message did.v1.MsgRevokeVerifiableCredential is not mutable"))
+	case "did.v1.MsgIssueVerifiableCredential.credential":
+		if x.Credential == nil {
+			x.Credential = new(VerifiableCredential)
+		}
+		return protoreflect.ValueOfMessage(x.Credential.ProtoReflect())
+	case "did.v1.MsgIssueVerifiableCredential.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.MsgIssueVerifiableCredential is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevokeVerifiableCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgIssueVerifiableCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRevokeVerifiableCredential.issuer":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
+	case "did.v1.MsgIssueVerifiableCredential.issuer":
 		return protoreflect.ValueOfString("")
+	case "did.v1.MsgIssueVerifiableCredential.credential":
+		m := new(VerifiableCredential)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevokeVerifiableCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgIssueVerifiableCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRevokeVerifiableCredential", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgIssueVerifiableCredential", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8690,7 +8658,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevokeVerifiableCredential) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgIssueVerifiableCredential) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8701,7 +8669,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredential) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgIssueVerifiableCredential) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8713,7 +8681,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevokeVerifiableCredential) IsValid() bool {
+func (x *fastReflection_MsgIssueVerifiableCredential) IsValid() bool {
 	return x != nil
 }
 
@@ -8723,9 +8691,9 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgIssueVerifiableCredential) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevokeVerifiableCredential)
+		x := input.Message.Interface().(*MsgIssueVerifiableCredential)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8741,12 +8709,8 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CredentialId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RevocationReason)
-		if l > 0 {
+		if x.Credential != nil {
+			l = options.Size(x.Credential)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8759,7 +8723,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeVerifiableCredential)
+		x := input.Message.Interface().(*MsgIssueVerifiableCredential)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8778,17 +8742,17 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RevocationReason) > 0 {
-			i -= len(x.RevocationReason)
-			copy(dAtA[i:], x.RevocationReason)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevocationReason)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.CredentialId) > 0 {
-			i -= len(x.CredentialId)
-			copy(dAtA[i:], x.CredentialId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+		if x.Credential != nil {
+			encoded, err := options.Marshal(x.Credential)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -8810,7 +8774,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeVerifiableCredential)
+		x := input.Message.Interface().(*MsgIssueVerifiableCredential)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8842,10 +8806,10 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredential: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredential: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8882,9 +8846,9 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8894,55 +8858,27 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CredentialId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Credential == nil {
+					x.Credential = &VerifiableCredential{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Credential); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.RevocationReason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8980,23 +8916,25 @@ func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgRevokeVerifiableCredentialResponse protoreflect.MessageDescriptor
+	md_MsgIssueVerifiableCredentialResponse               protoreflect.MessageDescriptor
+	fd_MsgIssueVerifiableCredentialResponse_credential_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRevokeVerifiableCredentialResponse = File_did_v1_tx_proto.Messages().ByName("MsgRevokeVerifiableCredentialResponse")
+	md_MsgIssueVerifiableCredentialResponse = File_did_v1_tx_proto.Messages().ByName("MsgIssueVerifiableCredentialResponse")
+	fd_MsgIssueVerifiableCredentialResponse_credential_id = md_MsgIssueVerifiableCredentialResponse.Fields().ByName("credential_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevokeVerifiableCredentialResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgIssueVerifiableCredentialResponse)(nil)
 
-type fastReflection_MsgRevokeVerifiableCredentialResponse MsgRevokeVerifiableCredentialResponse
+type fastReflection_MsgIssueVerifiableCredentialResponse MsgIssueVerifiableCredentialResponse
 
-func (x *MsgRevokeVerifiableCredentialResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevokeVerifiableCredentialResponse)(x)
+func (x *MsgIssueVerifiableCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgIssueVerifiableCredentialResponse)(x)
 }
 
-func (x *MsgRevokeVerifiableCredentialResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgIssueVerifiableCredentialResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9008,43 +8946,43 @@ func (x *MsgRevokeVerifiableCredentialResponse) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevokeVerifiableCredentialResponse_messageType fastReflection_MsgRevokeVerifiableCredentialResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevokeVerifiableCredentialResponse_messageType{}
+var _fastReflection_MsgIssueVerifiableCredentialResponse_messageType fastReflection_MsgIssueVerifiableCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgIssueVerifiableCredentialResponse_messageType{}
 
-type fastReflection_MsgRevokeVerifiableCredentialResponse_messageType struct{}
+type fastReflection_MsgIssueVerifiableCredentialResponse_messageType struct{}
 
-func (x fastReflection_MsgRevokeVerifiableCredentialResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevokeVerifiableCredentialResponse)(nil)
+func (x fastReflection_MsgIssueVerifiableCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgIssueVerifiableCredentialResponse)(nil)
 }
-func (x fastReflection_MsgRevokeVerifiableCredentialResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeVerifiableCredentialResponse)
+func (x fastReflection_MsgIssueVerifiableCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgIssueVerifiableCredentialResponse)
 }
-func (x fastReflection_MsgRevokeVerifiableCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeVerifiableCredentialResponse
+func (x fastReflection_MsgIssueVerifiableCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgIssueVerifiableCredentialResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeVerifiableCredentialResponse
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgIssueVerifiableCredentialResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevokeVerifiableCredentialResponse_messageType
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgIssueVerifiableCredentialResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeVerifiableCredentialResponse)
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgIssueVerifiableCredentialResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevokeVerifiableCredentialResponse)(x)
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgIssueVerifiableCredentialResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9052,7 +8990,13 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_MsgIssueVerifiableCredentialResponse_credential_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9066,13 +9010,15 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
+		return x.CredentialId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9082,13 +9028,15 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
+		x.CredentialId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9098,13 +9046,16 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
+		value := x.CredentialId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9118,13 +9069,15 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
+		x.CredentialId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9138,36 +9091,40 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.MsgIssueVerifiableCredentialResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "did.v1.MsgIssueVerifiableCredentialResponse.credential_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgIssueVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgIssueVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRevokeVerifiableCredentialResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgIssueVerifiableCredentialResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9175,7 +9132,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9186,7 +9143,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9198,7 +9155,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) IsValid() bool {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9208,9 +9165,9 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgIssueVerifiableCredentialResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevokeVerifiableCredentialResponse)
+		x := input.Message.Interface().(*MsgIssueVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9222,6 +9179,10 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
+		l = len(x.CredentialId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9232,7 +9193,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeVerifiableCredentialResponse)
+		x := input.Message.Interface().(*MsgIssueVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9251,6 +9212,13 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9262,7 +9230,7 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeVerifiableCredentialResponse)
+		x := input.Message.Interface().(*MsgIssueVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9294,12 +9262,44 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9336,39 +9336,29 @@ func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *p
 }
 
 var (
-	md_MsgLinkExternalWallet                        protoreflect.MessageDescriptor
-	fd_MsgLinkExternalWallet_controller             protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_did                    protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_wallet_address         protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_wallet_chain_id        protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_wallet_type            protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_ownership_proof        protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_challenge              protoreflect.FieldDescriptor
-	fd_MsgLinkExternalWallet_verification_method_id protoreflect.FieldDescriptor
+	md_MsgRevokeVerifiableCredential                   protoreflect.MessageDescriptor
+	fd_MsgRevokeVerifiableCredential_issuer            protoreflect.FieldDescriptor
+	fd_MsgRevokeVerifiableCredential_credential_id     protoreflect.FieldDescriptor
+	fd_MsgRevokeVerifiableCredential_revocation_reason protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgLinkExternalWallet = File_did_v1_tx_proto.Messages().ByName("MsgLinkExternalWallet")
-	fd_MsgLinkExternalWallet_controller = md_MsgLinkExternalWallet.Fields().ByName("controller")
-	fd_MsgLinkExternalWallet_did = md_MsgLinkExternalWallet.Fields().ByName("did")
-	fd_MsgLinkExternalWallet_wallet_address = md_MsgLinkExternalWallet.Fields().ByName("wallet_address")
-	fd_MsgLinkExternalWallet_wallet_chain_id = md_MsgLinkExternalWallet.Fields().ByName("wallet_chain_id")
-	fd_MsgLinkExternalWallet_wallet_type = md_MsgLinkExternalWallet.Fields().ByName("wallet_type")
-	fd_MsgLinkExternalWallet_ownership_proof = md_MsgLinkExternalWallet.Fields().ByName("ownership_proof")
-	fd_MsgLinkExternalWallet_challenge = md_MsgLinkExternalWallet.Fields().ByName("challenge")
-	fd_MsgLinkExternalWallet_verification_method_id = md_MsgLinkExternalWallet.Fields().ByName("verification_method_id")
+	md_MsgRevokeVerifiableCredential = File_did_v1_tx_proto.Messages().ByName("MsgRevokeVerifiableCredential")
+	fd_MsgRevokeVerifiableCredential_issuer = md_MsgRevokeVerifiableCredential.Fields().ByName("issuer")
+	fd_MsgRevokeVerifiableCredential_credential_id = md_MsgRevokeVerifiableCredential.Fields().ByName("credential_id")
+	fd_MsgRevokeVerifiableCredential_revocation_reason = md_MsgRevokeVerifiableCredential.Fields().ByName("revocation_reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgLinkExternalWallet)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevokeVerifiableCredential)(nil)
 
-type fastReflection_MsgLinkExternalWallet MsgLinkExternalWallet
+type fastReflection_MsgRevokeVerifiableCredential MsgRevokeVerifiableCredential
 
-func (x *MsgLinkExternalWallet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgLinkExternalWallet)(x)
+func (x *MsgRevokeVerifiableCredential) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVerifiableCredential)(x)
 }
 
-func (x *MsgLinkExternalWallet) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevokeVerifiableCredential) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9380,43 +9370,43 @@ func (x *MsgLinkExternalWallet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgLinkExternalWallet_messageType fastReflection_MsgLinkExternalWallet_messageType
-var _ protoreflect.MessageType = fastReflection_MsgLinkExternalWallet_messageType{}
+var _fastReflection_MsgRevokeVerifiableCredential_messageType fastReflection_MsgRevokeVerifiableCredential_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeVerifiableCredential_messageType{}
 
-type fastReflection_MsgLinkExternalWallet_messageType struct{}
+type fastReflection_MsgRevokeVerifiableCredential_messageType struct{}
 
-func (x fastReflection_MsgLinkExternalWallet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgLinkExternalWallet)(nil)
+func (x fastReflection_MsgRevokeVerifiableCredential_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVerifiableCredential)(nil)
 }
-func (x fastReflection_MsgLinkExternalWallet_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgLinkExternalWallet)
+func (x fastReflection_MsgRevokeVerifiableCredential_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVerifiableCredential)
 }
-func (x fastReflection_MsgLinkExternalWallet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLinkExternalWallet
+func (x fastReflection_MsgRevokeVerifiableCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVerifiableCredential
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgLinkExternalWallet) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLinkExternalWallet
+func (x *fastReflection_MsgRevokeVerifiableCredential) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVerifiableCredential
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgLinkExternalWallet) Type() protoreflect.MessageType {
-	return _fastReflection_MsgLinkExternalWallet_messageType
+func (x *fastReflection_MsgRevokeVerifiableCredential) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeVerifiableCredential_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgLinkExternalWallet) New() protoreflect.Message {
-	return new(fastReflection_MsgLinkExternalWallet)
+func (x *fastReflection_MsgRevokeVerifiableCredential) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVerifiableCredential)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgLinkExternalWallet) Interface() protoreflect.ProtoMessage {
-	return (*MsgLinkExternalWallet)(x)
+func (x *fastReflection_MsgRevokeVerifiableCredential) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeVerifiableCredential)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9424,52 +9414,22 @@ func (x *fastReflection_MsgLinkExternalWallet) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgLinkExternalWallet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Controller != "" {
-		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_MsgLinkExternalWallet_controller, value) {
-			return
-		}
-	}
-	if x.Did != "" {
-		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_MsgLinkExternalWallet_did, value) {
-			return
-		}
-	}
-	if x.WalletAddress != "" {
-		value := protoreflect.ValueOfString(x.WalletAddress)
-		if !f(fd_MsgLinkExternalWallet_wallet_address, value) {
-			return
-		}
-	}
-	if x.WalletChainId != "" {
-		value := protoreflect.ValueOfString(x.WalletChainId)
-		if !f(fd_MsgLinkExternalWallet_wallet_chain_id, value) {
-			return
-		}
-	}
-	if x.WalletType != "" {
-		value := protoreflect.ValueOfString(x.WalletType)
-		if !f(fd_MsgLinkExternalWallet_wallet_type, value) {
-			return
-		}
-	}
-	if len(x.OwnershipProof) != 0 {
-		value := protoreflect.ValueOfBytes(x.OwnershipProof)
-		if !f(fd_MsgLinkExternalWallet_ownership_proof, value) {
+func (x *fastReflection_MsgRevokeVerifiableCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Issuer != "" {
+		value := protoreflect.ValueOfString(x.Issuer)
+		if !f(fd_MsgRevokeVerifiableCredential_issuer, value) {
 			return
 		}
 	}
-	if len(x.Challenge) != 0 {
-		value := protoreflect.ValueOfBytes(x.Challenge)
-		if !f(fd_MsgLinkExternalWallet_challenge, value) {
+	if x.CredentialId != "" {
+		value := protoreflect.ValueOfString(x.CredentialId)
+		if !f(fd_MsgRevokeVerifiableCredential_credential_id, value) {
 			return
 		}
 	}
-	if x.VerificationMethodId != "" {
-		value := protoreflect.ValueOfString(x.VerificationMethodId)
-		if !f(fd_MsgLinkExternalWallet_verification_method_id, value) {
+	if x.RevocationReason != "" {
+		value := protoreflect.ValueOfString(x.RevocationReason)
+		if !f(fd_MsgRevokeVerifiableCredential_revocation_reason, value) {
 			return
 		}
 	}
@@ -9486,29 +9446,19 @@ func (x *fastReflection_MsgLinkExternalWallet) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgLinkExternalWallet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevokeVerifiableCredential) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWallet.controller":
-		return x.Controller != ""
-	case "did.v1.MsgLinkExternalWallet.did":
-		return x.Did != ""
-	case "did.v1.MsgLinkExternalWallet.wallet_address":
-		return x.WalletAddress != ""
-	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
-		return x.WalletChainId != ""
-	case "did.v1.MsgLinkExternalWallet.wallet_type":
-		return x.WalletType != ""
-	case "did.v1.MsgLinkExternalWallet.ownership_proof":
-		return len(x.OwnershipProof) != 0
-	case "did.v1.MsgLinkExternalWallet.challenge":
-		return len(x.Challenge) != 0
-	case "did.v1.MsgLinkExternalWallet.verification_method_id":
-		return x.VerificationMethodId != ""
+	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+		return x.Issuer != ""
+	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
+		return x.CredentialId != ""
+	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
+		return x.RevocationReason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9518,29 +9468,19 @@ func (x *fastReflection_MsgLinkExternalWallet) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWallet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevokeVerifiableCredential) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWallet.controller":
-		x.Controller = ""
-	case "did.v1.MsgLinkExternalWallet.did":
-		x.Did = ""
-	case "did.v1.MsgLinkExternalWallet.wallet_address":
-		x.WalletAddress = ""
-	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
-		x.WalletChainId = ""
-	case "did.v1.MsgLinkExternalWallet.wallet_type":
-		x.WalletType = ""
-	case "did.v1.MsgLinkExternalWallet.ownership_proof":
-		x.OwnershipProof = nil
-	case "did.v1.MsgLinkExternalWallet.challenge":
-		x.Challenge = nil
-	case "did.v1.MsgLinkExternalWallet.verification_method_id":
-		x.VerificationMethodId = ""
+	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+		x.Issuer = ""
+	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
+		x.CredentialId = ""
+	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
+		x.RevocationReason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9550,37 +9490,22 @@ func (x *fastReflection_MsgLinkExternalWallet) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgLinkExternalWallet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeVerifiableCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgLinkExternalWallet.controller":
-		value := x.Controller
-		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgLinkExternalWallet.did":
-		value := x.Did
-		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgLinkExternalWallet.wallet_address":
-		value := x.WalletAddress
-		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
-		value := x.WalletChainId
+	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+		value := x.Issuer
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgLinkExternalWallet.wallet_type":
-		value := x.WalletType
+	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
+		value := x.CredentialId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgLinkExternalWallet.ownership_proof":
-		value := x.OwnershipProof
-		return protoreflect.ValueOfBytes(value)
-	case "did.v1.MsgLinkExternalWallet.challenge":
-		value := x.Challenge
-		return protoreflect.ValueOfBytes(value)
-	case "did.v1.MsgLinkExternalWallet.verification_method_id":
-		value := x.VerificationMethodId
+	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
+		value := x.RevocationReason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9594,29 +9519,19 @@ func (x *fastReflection_MsgLinkExternalWallet) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWallet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevokeVerifiableCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWallet.controller":
-		x.Controller = value.Interface().(string)
-	case "did.v1.MsgLinkExternalWallet.did":
-		x.Did = value.Interface().(string)
-	case "did.v1.MsgLinkExternalWallet.wallet_address":
-		x.WalletAddress = value.Interface().(string)
-	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
-		x.WalletChainId = value.Interface().(string)
-	case "did.v1.MsgLinkExternalWallet.wallet_type":
-		x.WalletType = value.Interface().(string)
-	case "did.v1.MsgLinkExternalWallet.ownership_proof":
-		x.OwnershipProof = value.Bytes()
-	case "did.v1.MsgLinkExternalWallet.challenge":
-		x.Challenge = value.Bytes()
-	case "did.v1.MsgLinkExternalWallet.verification_method_id":
-		x.VerificationMethodId = value.Interface().(string)
+	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+		x.Issuer = value.Interface().(string)
+	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
+		x.CredentialId = value.Interface().(string)
+	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
+		x.RevocationReason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9630,68 +9545,48 @@ func (x *fastReflection_MsgLinkExternalWallet) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWallet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeVerifiableCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWallet.controller":
-		panic(fmt.Errorf("field controller of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.did":
-		panic(fmt.Errorf("field did of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.wallet_address":
-		panic(fmt.Errorf("field wallet_address of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
-		panic(fmt.Errorf("field wallet_chain_id of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.wallet_type":
-		panic(fmt.Errorf("field wallet_type of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.ownership_proof":
-		panic(fmt.Errorf("field ownership_proof of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.challenge":
-		panic(fmt.Errorf("field challenge of message did.v1.MsgLinkExternalWallet is not mutable"))
-	case "did.v1.MsgLinkExternalWallet.verification_method_id":
-		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgRevokeVerifiableCredential.issuer":
+		panic(fmt.Errorf("field issuer of message did.v1.MsgRevokeVerifiableCredential is not mutable"))
+	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
+		panic(fmt.Errorf("field credential_id of message did.v1.MsgRevokeVerifiableCredential is not mutable"))
+	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
+		panic(fmt.Errorf("field revocation_reason of message did.v1.MsgRevokeVerifiableCredential is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgLinkExternalWallet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeVerifiableCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWallet.controller":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgLinkExternalWallet.did":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgLinkExternalWallet.wallet_address":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+	case "did.v1.MsgRevokeVerifiableCredential.issuer":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgLinkExternalWallet.wallet_type":
+	case "did.v1.MsgRevokeVerifiableCredential.credential_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgLinkExternalWallet.ownership_proof":
-		return protoreflect.ValueOfBytes(nil)
-	case "did.v1.MsgLinkExternalWallet.challenge":
-		return protoreflect.ValueOfBytes(nil)
-	case "did.v1.MsgLinkExternalWallet.verification_method_id":
+	case "did.v1.MsgRevokeVerifiableCredential.revocation_reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredential"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredential does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgLinkExternalWallet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevokeVerifiableCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgLinkExternalWallet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRevokeVerifiableCredential", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9699,7 +9594,7 @@ func (x *fastReflection_MsgLinkExternalWallet) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgLinkExternalWallet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevokeVerifiableCredential) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9710,7 +9605,7 @@ func (x *fastReflection_MsgLinkExternalWallet) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWallet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevokeVerifiableCredential) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9722,7 +9617,7 @@ func (x *fastReflection_MsgLinkExternalWallet) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgLinkExternalWallet) IsValid() bool {
+func (x *fastReflection_MsgRevokeVerifiableCredential) IsValid() bool {
 	return x != nil
 }
 
@@ -9732,9 +9627,9 @@ func (x *fastReflection_MsgLinkExternalWallet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevokeVerifiableCredential) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgLinkExternalWallet)
+		x := input.Message.Interface().(*MsgRevokeVerifiableCredential)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9746,35 +9641,15 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Controller)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Did)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.WalletAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.WalletChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.WalletType)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.OwnershipProof)
+		l = len(x.Issuer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Challenge)
+		l = len(x.CredentialId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VerificationMethodId)
+		l = len(x.RevocationReason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -9788,7 +9663,7 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLinkExternalWallet)
+		x := input.Message.Interface().(*MsgRevokeVerifiableCredential)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9807,59 +9682,24 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VerificationMethodId) > 0 {
-			i -= len(x.VerificationMethodId)
-			copy(dAtA[i:], x.VerificationMethodId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Challenge) > 0 {
-			i -= len(x.Challenge)
-			copy(dAtA[i:], x.Challenge)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenge)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.OwnershipProof) > 0 {
-			i -= len(x.OwnershipProof)
-			copy(dAtA[i:], x.OwnershipProof)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnershipProof)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.WalletType) > 0 {
-			i -= len(x.WalletType)
-			copy(dAtA[i:], x.WalletType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletType)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.WalletChainId) > 0 {
-			i -= len(x.WalletChainId)
-			copy(dAtA[i:], x.WalletChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletChainId)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.WalletAddress) > 0 {
-			i -= len(x.WalletAddress)
-			copy(dAtA[i:], x.WalletAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+		if len(x.RevocationReason) > 0 {
+			i -= len(x.RevocationReason)
+			copy(dAtA[i:], x.RevocationReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevocationReason)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Did) > 0 {
-			i -= len(x.Did)
-			copy(dAtA[i:], x.Did)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+		if len(x.CredentialId) > 0 {
+			i -= len(x.CredentialId)
+			copy(dAtA[i:], x.CredentialId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CredentialId)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Controller) > 0 {
-			i -= len(x.Controller)
-			copy(dAtA[i:], x.Controller)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+		if len(x.Issuer) > 0 {
+			i -= len(x.Issuer)
+			copy(dAtA[i:], x.Issuer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Issuer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9874,7 +9714,7 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLinkExternalWallet)
+		x := input.Message.Interface().(*MsgRevokeVerifiableCredential)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9906,15 +9746,15 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWallet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredential: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWallet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9942,11 +9782,11 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Controller = string(dAtA[iNdEx:postIndex])
+				x.Issuer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9974,11 +9814,11 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Did = string(dAtA[iNdEx:postIndex])
+				x.CredentialId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10006,180 +9846,16 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				x.RevocationReason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletChainId", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.WalletChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.WalletType = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnershipProof", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OwnershipProof = append(x.OwnershipProof[:0], dAtA[iNdEx:postIndex]...)
-				if x.OwnershipProof == nil {
-					x.OwnershipProof = []byte{}
-				}
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Challenge = append(x.Challenge[:0], dAtA[iNdEx:postIndex]...)
-				if x.Challenge == nil {
-					x.Challenge = []byte{}
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -10208,25 +9884,23 @@ func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgLinkExternalWalletResponse                        protoreflect.MessageDescriptor
-	fd_MsgLinkExternalWalletResponse_verification_method_id protoreflect.FieldDescriptor
+	md_MsgRevokeVerifiableCredentialResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgLinkExternalWalletResponse = File_did_v1_tx_proto.Messages().ByName("MsgLinkExternalWalletResponse")
-	fd_MsgLinkExternalWalletResponse_verification_method_id = md_MsgLinkExternalWalletResponse.Fields().ByName("verification_method_id")
+	md_MsgRevokeVerifiableCredentialResponse = File_did_v1_tx_proto.Messages().ByName("MsgRevokeVerifiableCredentialResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgLinkExternalWalletResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevokeVerifiableCredentialResponse)(nil)
 
-type fastReflection_MsgLinkExternalWalletResponse MsgLinkExternalWalletResponse
+type fastReflection_MsgRevokeVerifiableCredentialResponse MsgRevokeVerifiableCredentialResponse
 
-func (x *MsgLinkExternalWalletResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgLinkExternalWalletResponse)(x)
+func (x *MsgRevokeVerifiableCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVerifiableCredentialResponse)(x)
 }
 
-func (x *MsgLinkExternalWalletResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevokeVerifiableCredentialResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10238,43 +9912,43 @@ func (x *MsgLinkExternalWalletResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgLinkExternalWalletResponse_messageType fastReflection_MsgLinkExternalWalletResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgLinkExternalWalletResponse_messageType{}
+var _fastReflection_MsgRevokeVerifiableCredentialResponse_messageType fastReflection_MsgRevokeVerifiableCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeVerifiableCredentialResponse_messageType{}
 
-type fastReflection_MsgLinkExternalWalletResponse_messageType struct{}
+type fastReflection_MsgRevokeVerifiableCredentialResponse_messageType struct{}
 
-func (x fastReflection_MsgLinkExternalWalletResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgLinkExternalWalletResponse)(nil)
+func (x fastReflection_MsgRevokeVerifiableCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVerifiableCredentialResponse)(nil)
 }
-func (x fastReflection_MsgLinkExternalWalletResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgLinkExternalWalletResponse)
+func (x fastReflection_MsgRevokeVerifiableCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVerifiableCredentialResponse)
 }
-func (x fastReflection_MsgLinkExternalWalletResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLinkExternalWalletResponse
+func (x fastReflection_MsgRevokeVerifiableCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVerifiableCredentialResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLinkExternalWalletResponse
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVerifiableCredentialResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgLinkExternalWalletResponse_messageType
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeVerifiableCredentialResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgLinkExternalWalletResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgLinkExternalWalletResponse)
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVerifiableCredentialResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgLinkExternalWalletResponse)(x)
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeVerifiableCredentialResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10282,13 +9956,7 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VerificationMethodId != "" {
-		value := protoreflect.ValueOfString(x.VerificationMethodId)
-		if !f(fd_MsgLinkExternalWalletResponse_verification_method_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -10302,15 +9970,13 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
-		return x.VerificationMethodId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10320,15 +9986,13 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
-		x.VerificationMethodId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10338,16 +10002,13 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
-		value := x.VerificationMethodId
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10361,15 +10022,13 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
-		x.VerificationMethodId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10383,40 +10042,36 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWalletResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
-		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgLinkExternalWalletResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgLinkExternalWalletResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRevokeVerifiableCredentialResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgRevokeVerifiableCredentialResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgLinkExternalWalletResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgLinkExternalWalletResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRevokeVerifiableCredentialResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10424,7 +10079,7 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgLinkExternalWalletResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10435,7 +10090,7 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLinkExternalWalletResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10447,7 +10102,7 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgLinkExternalWalletResponse) IsValid() bool {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10457,9 +10112,9 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevokeVerifiableCredentialResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgLinkExternalWalletResponse)
+		x := input.Message.Interface().(*MsgRevokeVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10471,10 +10126,6 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		l = len(x.VerificationMethodId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10485,7 +10136,7 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLinkExternalWalletResponse)
+		x := input.Message.Interface().(*MsgRevokeVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10504,13 +10155,6 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VerificationMethodId) > 0 {
-			i -= len(x.VerificationMethodId)
-			copy(dAtA[i:], x.VerificationMethodId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -10522,7 +10166,7 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLinkExternalWalletResponse)
+		x := input.Message.Interface().(*MsgRevokeVerifiableCredentialResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10554,44 +10198,12 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWalletResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10628,33 +10240,39 @@ func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgRegisterWebAuthnCredential                        protoreflect.MessageDescriptor
-	fd_MsgRegisterWebAuthnCredential_controller             protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredential_username               protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredential_webauthn_credential    protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredential_verification_method_id protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredential_auto_create_vault      protoreflect.FieldDescriptor
+	md_MsgLinkExternalWallet                        protoreflect.MessageDescriptor
+	fd_MsgLinkExternalWallet_controller             protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_did                    protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_wallet_address         protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_wallet_chain_id        protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_wallet_type            protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_ownership_proof        protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_challenge              protoreflect.FieldDescriptor
+	fd_MsgLinkExternalWallet_verification_method_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRegisterWebAuthnCredential = File_did_v1_tx_proto.Messages().ByName("MsgRegisterWebAuthnCredential")
-	fd_MsgRegisterWebAuthnCredential_controller = md_MsgRegisterWebAuthnCredential.Fields().ByName("controller")
-	fd_MsgRegisterWebAuthnCredential_username = md_MsgRegisterWebAuthnCredential.Fields().ByName("username")
-	fd_MsgRegisterWebAuthnCredential_webauthn_credential = md_MsgRegisterWebAuthnCredential.Fields().ByName("webauthn_credential")
-	fd_MsgRegisterWebAuthnCredential_verification_method_id = md_MsgRegisterWebAuthnCredential.Fields().ByName("verification_method_id")
-	fd_MsgRegisterWebAuthnCredential_auto_create_vault = md_MsgRegisterWebAuthnCredential.Fields().ByName("auto_create_vault")
+	md_MsgLinkExternalWallet = File_did_v1_tx_proto.Messages().ByName("MsgLinkExternalWallet")
+	fd_MsgLinkExternalWallet_controller = md_MsgLinkExternalWallet.Fields().ByName("controller")
+	fd_MsgLinkExternalWallet_did = md_MsgLinkExternalWallet.Fields().ByName("did")
+	fd_MsgLinkExternalWallet_wallet_address = md_MsgLinkExternalWallet.Fields().ByName("wallet_address")
+	fd_MsgLinkExternalWallet_wallet_chain_id = md_MsgLinkExternalWallet.Fields().ByName("wallet_chain_id")
+	fd_MsgLinkExternalWallet_wallet_type = md_MsgLinkExternalWallet.Fields().ByName("wallet_type")
+	fd_MsgLinkExternalWallet_ownership_proof = md_MsgLinkExternalWallet.Fields().ByName("ownership_proof")
+	fd_MsgLinkExternalWallet_challenge = md_MsgLinkExternalWallet.Fields().ByName("challenge")
+	fd_MsgLinkExternalWallet_verification_method_id = md_MsgLinkExternalWallet.Fields().ByName("verification_method_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRegisterWebAuthnCredential)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgLinkExternalWallet)(nil)
 
-type fastReflection_MsgRegisterWebAuthnCredential MsgRegisterWebAuthnCredential
+type fastReflection_MsgLinkExternalWallet MsgLinkExternalWallet
 
-func (x *MsgRegisterWebAuthnCredential) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRegisterWebAuthnCredential)(x)
+func (x *MsgLinkExternalWallet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLinkExternalWallet)(x)
 }
 
-func (x *MsgRegisterWebAuthnCredential) slowProtoReflect() protoreflect.Message {
+func (x *MsgLinkExternalWallet) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10666,43 +10284,43 @@ func (x *MsgRegisterWebAuthnCredential) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRegisterWebAuthnCredential_messageType fastReflection_MsgRegisterWebAuthnCredential_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRegisterWebAuthnCredential_messageType{}
+var _fastReflection_MsgLinkExternalWallet_messageType fastReflection_MsgLinkExternalWallet_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLinkExternalWallet_messageType{}
 
-type fastReflection_MsgRegisterWebAuthnCredential_messageType struct{}
+type fastReflection_MsgLinkExternalWallet_messageType struct{}
 
-func (x fastReflection_MsgRegisterWebAuthnCredential_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRegisterWebAuthnCredential)(nil)
+func (x fastReflection_MsgLinkExternalWallet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLinkExternalWallet)(nil)
 }
-func (x fastReflection_MsgRegisterWebAuthnCredential_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRegisterWebAuthnCredential)
+func (x fastReflection_MsgLinkExternalWallet_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLinkExternalWallet)
 }
-func (x fastReflection_MsgRegisterWebAuthnCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRegisterWebAuthnCredential
+func (x fastReflection_MsgLinkExternalWallet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLinkExternalWallet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRegisterWebAuthnCredential
+func (x *fastReflection_MsgLinkExternalWallet) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLinkExternalWallet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRegisterWebAuthnCredential_messageType
+func (x *fastReflection_MsgLinkExternalWallet) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLinkExternalWallet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) New() protoreflect.Message {
-	return new(fastReflection_MsgRegisterWebAuthnCredential)
+func (x *fastReflection_MsgLinkExternalWallet) New() protoreflect.Message {
+	return new(fastReflection_MsgLinkExternalWallet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Interface() protoreflect.ProtoMessage {
-	return (*MsgRegisterWebAuthnCredential)(x)
+func (x *fastReflection_MsgLinkExternalWallet) Interface() protoreflect.ProtoMessage {
+	return (*MsgLinkExternalWallet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10710,34 +10328,52 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgLinkExternalWallet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Controller != "" {
 		value := protoreflect.ValueOfString(x.Controller)
-		if !f(fd_MsgRegisterWebAuthnCredential_controller, value) {
+		if !f(fd_MsgLinkExternalWallet_controller, value) {
 			return
 		}
 	}
-	if x.Username != "" {
-		value := protoreflect.ValueOfString(x.Username)
-		if !f(fd_MsgRegisterWebAuthnCredential_username, value) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgLinkExternalWallet_did, value) {
 			return
 		}
 	}
-	if x.WebauthnCredential != nil {
-		value := protoreflect.ValueOfMessage(x.WebauthnCredential.ProtoReflect())
-		if !f(fd_MsgRegisterWebAuthnCredential_webauthn_credential, value) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_MsgLinkExternalWallet_wallet_address, value) {
 			return
 		}
 	}
-	if x.VerificationMethodId != "" {
-		value := protoreflect.ValueOfString(x.VerificationMethodId)
-		if !f(fd_MsgRegisterWebAuthnCredential_verification_method_id, value) {
+	if x.WalletChainId != "" {
+		value := protoreflect.ValueOfString(x.WalletChainId)
+		if !f(fd_MsgLinkExternalWallet_wallet_chain_id, value) {
 			return
 		}
 	}
-	if x.AutoCreateVault != false {
-		value := protoreflect.ValueOfBool(x.AutoCreateVault)
-		if !f(fd_MsgRegisterWebAuthnCredential_auto_create_vault, value) {
+	if x.WalletType != "" {
+		value := protoreflect.ValueOfString(x.WalletType)
+		if !f(fd_MsgLinkExternalWallet_wallet_type, value) {
+			return
+		}
+	}
+	if len(x.OwnershipProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.OwnershipProof)
+		if !f(fd_MsgLinkExternalWallet_ownership_proof, value) {
+			return
+		}
+	}
+	if len(x.Challenge) != 0 {
+		value := protoreflect.ValueOfBytes(x.Challenge)
+		if !f(fd_MsgLinkExternalWallet_challenge, value) {
+			return
+		}
+	}
+	if x.VerificationMethodId != "" {
+		value := protoreflect.ValueOfString(x.VerificationMethodId)
+		if !f(fd_MsgLinkExternalWallet_verification_method_id, value) {
 			return
 		}
 	}
@@ -10754,23 +10390,29 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgLinkExternalWallet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+	case "did.v1.MsgLinkExternalWallet.controller":
 		return x.Controller != ""
-	case "did.v1.MsgRegisterWebAuthnCredential.username":
-		return x.Username != ""
-	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
-		return x.WebauthnCredential != nil
-	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+	case "did.v1.MsgLinkExternalWallet.did":
+		return x.Did != ""
+	case "did.v1.MsgLinkExternalWallet.wallet_address":
+		return x.WalletAddress != ""
+	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+		return x.WalletChainId != ""
+	case "did.v1.MsgLinkExternalWallet.wallet_type":
+		return x.WalletType != ""
+	case "did.v1.MsgLinkExternalWallet.ownership_proof":
+		return len(x.OwnershipProof) != 0
+	case "did.v1.MsgLinkExternalWallet.challenge":
+		return len(x.Challenge) != 0
+	case "did.v1.MsgLinkExternalWallet.verification_method_id":
 		return x.VerificationMethodId != ""
-	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
-		return x.AutoCreateVault != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10780,23 +10422,29 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgLinkExternalWallet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+	case "did.v1.MsgLinkExternalWallet.controller":
 		x.Controller = ""
-	case "did.v1.MsgRegisterWebAuthnCredential.username":
-		x.Username = ""
-	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
-		x.WebauthnCredential = nil
-	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+	case "did.v1.MsgLinkExternalWallet.did":
+		x.Did = ""
+	case "did.v1.MsgLinkExternalWallet.wallet_address":
+		x.WalletAddress = ""
+	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+		x.WalletChainId = ""
+	case "did.v1.MsgLinkExternalWallet.wallet_type":
+		x.WalletType = ""
+	case "did.v1.MsgLinkExternalWallet.ownership_proof":
+		x.OwnershipProof = nil
+	case "did.v1.MsgLinkExternalWallet.challenge":
+		x.Challenge = nil
+	case "did.v1.MsgLinkExternalWallet.verification_method_id":
 		x.VerificationMethodId = ""
-	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
-		x.AutoCreateVault = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10806,28 +10454,37 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLinkExternalWallet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+	case "did.v1.MsgLinkExternalWallet.controller":
 		value := x.Controller
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRegisterWebAuthnCredential.username":
-		value := x.Username
+	case "did.v1.MsgLinkExternalWallet.did":
+		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
-		value := x.WebauthnCredential
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+	case "did.v1.MsgLinkExternalWallet.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+		value := x.WalletChainId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgLinkExternalWallet.wallet_type":
+		value := x.WalletType
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgLinkExternalWallet.ownership_proof":
+		value := x.OwnershipProof
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.MsgLinkExternalWallet.challenge":
+		value := x.Challenge
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.MsgLinkExternalWallet.verification_method_id":
 		value := x.VerificationMethodId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
-		value := x.AutoCreateVault
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10841,23 +10498,29 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgLinkExternalWallet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+	case "did.v1.MsgLinkExternalWallet.controller":
 		x.Controller = value.Interface().(string)
-	case "did.v1.MsgRegisterWebAuthnCredential.username":
-		x.Username = value.Interface().(string)
-	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
-		x.WebauthnCredential = value.Message().Interface().(*WebAuthnCredential)
-	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+	case "did.v1.MsgLinkExternalWallet.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgLinkExternalWallet.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+		x.WalletChainId = value.Interface().(string)
+	case "did.v1.MsgLinkExternalWallet.wallet_type":
+		x.WalletType = value.Interface().(string)
+	case "did.v1.MsgLinkExternalWallet.ownership_proof":
+		x.OwnershipProof = value.Bytes()
+	case "did.v1.MsgLinkExternalWallet.challenge":
+		x.Challenge = value.Bytes()
+	case "did.v1.MsgLinkExternalWallet.verification_method_id":
 		x.VerificationMethodId = value.Interface().(string)
-	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
-		x.AutoCreateVault = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10871,60 +10534,68 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLinkExternalWallet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
-		if x.WebauthnCredential == nil {
-			x.WebauthnCredential = new(WebAuthnCredential)
-		}
-		return protoreflect.ValueOfMessage(x.WebauthnCredential.ProtoReflect())
-	case "did.v1.MsgRegisterWebAuthnCredential.controller":
-		panic(fmt.Errorf("field controller of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredential.username":
-		panic(fmt.Errorf("field username of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
-		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
-		panic(fmt.Errorf("field auto_create_vault of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+		panic(fmt.Errorf("field wallet_chain_id of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.wallet_type":
+		panic(fmt.Errorf("field wallet_type of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.ownership_proof":
+		panic(fmt.Errorf("field ownership_proof of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.challenge":
+		panic(fmt.Errorf("field challenge of message did.v1.MsgLinkExternalWallet is not mutable"))
+	case "did.v1.MsgLinkExternalWallet.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgLinkExternalWallet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLinkExternalWallet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+	case "did.v1.MsgLinkExternalWallet.controller":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRegisterWebAuthnCredential.username":
+	case "did.v1.MsgLinkExternalWallet.did":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
-		m := new(WebAuthnCredential)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+	case "did.v1.MsgLinkExternalWallet.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgLinkExternalWallet.wallet_chain_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgLinkExternalWallet.wallet_type":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgLinkExternalWallet.ownership_proof":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.MsgLinkExternalWallet.challenge":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.MsgLinkExternalWallet.verification_method_id":
 		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWallet"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWallet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgLinkExternalWallet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRegisterWebAuthnCredential", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgLinkExternalWallet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10932,7 +10603,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgLinkExternalWallet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10943,7 +10614,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgLinkExternalWallet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10955,7 +10626,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) IsValid() bool {
+func (x *fastReflection_MsgLinkExternalWallet) IsValid() bool {
 	return x != nil
 }
 
@@ -10965,9 +10636,9 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgLinkExternalWallet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRegisterWebAuthnCredential)
+		x := input.Message.Interface().(*MsgLinkExternalWallet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10983,20 +10654,33 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Username)
+		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.WebauthnCredential != nil {
-			l = options.Size(x.WebauthnCredential)
+		l = len(x.WalletAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VerificationMethodId)
+		l = len(x.WalletChainId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AutoCreateVault {
-			n += 2
+		l = len(x.WalletType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OwnershipProof)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Challenge)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerificationMethodId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11008,7 +10692,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRegisterWebAuthnCredential)
+		x := input.Message.Interface().(*MsgLinkExternalWallet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11027,41 +10711,52 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AutoCreateVault {
-			i--
-			if x.AutoCreateVault {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
 		if len(x.VerificationMethodId) > 0 {
 			i -= len(x.VerificationMethodId)
 			copy(dAtA[i:], x.VerificationMethodId)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
 			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Challenge) > 0 {
+			i -= len(x.Challenge)
+			copy(dAtA[i:], x.Challenge)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Challenge)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.OwnershipProof) > 0 {
+			i -= len(x.OwnershipProof)
+			copy(dAtA[i:], x.OwnershipProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnershipProof)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.WalletType) > 0 {
+			i -= len(x.WalletType)
+			copy(dAtA[i:], x.WalletType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletType)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.WalletChainId) > 0 {
+			i -= len(x.WalletChainId)
+			copy(dAtA[i:], x.WalletChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletChainId)))
+			i--
 			dAtA[i] = 0x22
 		}
-		if x.WebauthnCredential != nil {
-			encoded, err := options.Marshal(x.WebauthnCredential)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Username) > 0 {
-			i -= len(x.Username)
-			copy(dAtA[i:], x.Username)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Username)))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -11083,7 +10778,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRegisterWebAuthnCredential)
+		x := input.Message.Interface().(*MsgLinkExternalWallet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11115,10 +10810,10 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredential: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWallet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWallet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -11155,7 +10850,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11183,13 +10878,13 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Username = string(dAtA[iNdEx:postIndex])
+				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredential", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11199,31 +10894,27 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.WebauthnCredential == nil {
-					x.WebauthnCredential = &WebAuthnCredential{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WebauthnCredential); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletChainId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11251,13 +10942,13 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				x.WalletChainId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCreateVault", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11267,12 +10958,124 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.AutoCreateVault = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnershipProof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnershipProof = append(x.OwnershipProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.OwnershipProof == nil {
+					x.OwnershipProof = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Challenge = append(x.Challenge[:0], dAtA[iNdEx:postIndex]...)
+				if x.Challenge == nil {
+					x.Challenge = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11309,33 +11112,25 @@ func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgRegisterWebAuthnCredentialResponse                        protoreflect.MessageDescriptor
-	fd_MsgRegisterWebAuthnCredentialResponse_did                    protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredentialResponse_verification_method_id protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredentialResponse_vault_id               protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredentialResponse_vault_public_key       protoreflect.FieldDescriptor
-	fd_MsgRegisterWebAuthnCredentialResponse_enclave_id             protoreflect.FieldDescriptor
+	md_MsgLinkExternalWalletResponse                        protoreflect.MessageDescriptor
+	fd_MsgLinkExternalWalletResponse_verification_method_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_did_v1_tx_proto_init()
-	md_MsgRegisterWebAuthnCredentialResponse = File_did_v1_tx_proto.Messages().ByName("MsgRegisterWebAuthnCredentialResponse")
-	fd_MsgRegisterWebAuthnCredentialResponse_did = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("did")
-	fd_MsgRegisterWebAuthnCredentialResponse_verification_method_id = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("verification_method_id")
-	fd_MsgRegisterWebAuthnCredentialResponse_vault_id = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("vault_id")
-	fd_MsgRegisterWebAuthnCredentialResponse_vault_public_key = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("vault_public_key")
-	fd_MsgRegisterWebAuthnCredentialResponse_enclave_id = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("enclave_id")
+	md_MsgLinkExternalWalletResponse = File_did_v1_tx_proto.Messages().ByName("MsgLinkExternalWalletResponse")
+	fd_MsgLinkExternalWalletResponse_verification_method_id = md_MsgLinkExternalWalletResponse.Fields().ByName("verification_method_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRegisterWebAuthnCredentialResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgLinkExternalWalletResponse)(nil)
 
-type fastReflection_MsgRegisterWebAuthnCredentialResponse MsgRegisterWebAuthnCredentialResponse
+type fastReflection_MsgLinkExternalWalletResponse MsgLinkExternalWalletResponse
 
-func (x *MsgRegisterWebAuthnCredentialResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRegisterWebAuthnCredentialResponse)(x)
+func (x *MsgLinkExternalWalletResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLinkExternalWalletResponse)(x)
 }
 
-func (x *MsgRegisterWebAuthnCredentialResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgLinkExternalWalletResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_did_v1_tx_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11347,43 +11142,43 @@ func (x *MsgRegisterWebAuthnCredentialResponse) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType{}
+var _fastReflection_MsgLinkExternalWalletResponse_messageType fastReflection_MsgLinkExternalWalletResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLinkExternalWalletResponse_messageType{}
 
-type fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType struct{}
+type fastReflection_MsgLinkExternalWalletResponse_messageType struct{}
 
-func (x fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRegisterWebAuthnCredentialResponse)(nil)
+func (x fastReflection_MsgLinkExternalWalletResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLinkExternalWalletResponse)(nil)
 }
-func (x fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRegisterWebAuthnCredentialResponse)
+func (x fastReflection_MsgLinkExternalWalletResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLinkExternalWalletResponse)
 }
-func (x fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRegisterWebAuthnCredentialResponse
+func (x fastReflection_MsgLinkExternalWalletResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLinkExternalWalletResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRegisterWebAuthnCredentialResponse
+func (x *fastReflection_MsgLinkExternalWalletResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLinkExternalWalletResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType
+func (x *fastReflection_MsgLinkExternalWalletResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLinkExternalWalletResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRegisterWebAuthnCredentialResponse)
+func (x *fastReflection_MsgLinkExternalWalletResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgLinkExternalWalletResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRegisterWebAuthnCredentialResponse)(x)
+func (x *fastReflection_MsgLinkExternalWalletResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgLinkExternalWalletResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11391,34 +11186,10 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Did != "" {
-		value := protoreflect.ValueOfString(x.Did)
-		if !f(fd_MsgRegisterWebAuthnCredentialResponse_did, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgLinkExternalWalletResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.VerificationMethodId != "" {
 		value := protoreflect.ValueOfString(x.VerificationMethodId)
-		if !f(fd_MsgRegisterWebAuthnCredentialResponse_verification_method_id, value) {
-			return
-		}
-	}
-	if x.VaultId != "" {
-		value := protoreflect.ValueOfString(x.VaultId)
-		if !f(fd_MsgRegisterWebAuthnCredentialResponse_vault_id, value) {
-			return
-		}
-	}
-	if len(x.VaultPublicKey) != 0 {
-		value := protoreflect.ValueOfBytes(x.VaultPublicKey)
-		if !f(fd_MsgRegisterWebAuthnCredentialResponse_vault_public_key, value) {
-			return
-		}
-	}
-	if x.EnclaveId != "" {
-		value := protoreflect.ValueOfString(x.EnclaveId)
-		if !f(fd_MsgRegisterWebAuthnCredentialResponse_enclave_id, value) {
+		if !f(fd_MsgLinkExternalWalletResponse_verification_method_id, value) {
 			return
 		}
 	}
@@ -11435,23 +11206,15 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgLinkExternalWalletResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
-		return x.Did != ""
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
 		return x.VerificationMethodId != ""
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
-		return x.VaultId != ""
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
-		return len(x.VaultPublicKey) != 0
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
-		return x.EnclaveId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11461,23 +11224,15 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgLinkExternalWalletResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
-		x.Did = ""
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
 		x.VerificationMethodId = ""
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
-		x.VaultId = ""
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
-		x.VaultPublicKey = nil
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
-		x.EnclaveId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11487,28 +11242,16 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLinkExternalWalletResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
-		value := x.Did
-		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
 		value := x.VerificationMethodId
 		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
-		value := x.VaultId
-		return protoreflect.ValueOfString(value)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
-		value := x.VaultPublicKey
-		return protoreflect.ValueOfBytes(value)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
-		value := x.EnclaveId
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11522,23 +11265,15 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgLinkExternalWalletResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
-		x.Did = value.Interface().(string)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
 		x.VerificationMethodId = value.Interface().(string)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
-		x.VaultId = value.Interface().(string)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
-		x.VaultPublicKey = value.Bytes()
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
-		x.EnclaveId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11552,56 +11287,40 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLinkExternalWalletResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
-		panic(fmt.Errorf("field did of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
-		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
-		panic(fmt.Errorf("field vault_id of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
-		panic(fmt.Errorf("field vault_public_key of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
-		panic(fmt.Errorf("field enclave_id of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
+	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgLinkExternalWalletResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLinkExternalWalletResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
-		return protoreflect.ValueOfString("")
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
-		return protoreflect.ValueOfBytes(nil)
-	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+	case "did.v1.MsgLinkExternalWalletResponse.verification_method_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgLinkExternalWalletResponse"))
 		}
-		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message did.v1.MsgLinkExternalWalletResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgLinkExternalWalletResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRegisterWebAuthnCredentialResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgLinkExternalWalletResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11609,7 +11328,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgLinkExternalWalletResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11620,7 +11339,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgLinkExternalWalletResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11632,7 +11351,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) IsValid() bool {
+func (x *fastReflection_MsgLinkExternalWalletResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11642,9 +11361,9 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgLinkExternalWalletResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRegisterWebAuthnCredentialResponse)
+		x := input.Message.Interface().(*MsgLinkExternalWalletResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11656,26 +11375,10 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		l = len(x.Did)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		l = len(x.VerificationMethodId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VaultId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VaultPublicKey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.EnclaveId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11686,7 +11389,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRegisterWebAuthnCredentialResponse)
+		x := input.Message.Interface().(*MsgLinkExternalWalletResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11705,39 +11408,11 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EnclaveId) > 0 {
-			i -= len(x.EnclaveId)
-			copy(dAtA[i:], x.EnclaveId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnclaveId)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.VaultPublicKey) > 0 {
-			i -= len(x.VaultPublicKey)
-			copy(dAtA[i:], x.VaultPublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultPublicKey)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.VaultId) > 0 {
-			i -= len(x.VaultId)
-			copy(dAtA[i:], x.VaultId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultId)))
-			i--
-			dAtA[i] = 0x1a
-		}
 		if len(x.VerificationMethodId) > 0 {
 			i -= len(x.VerificationMethodId)
 			copy(dAtA[i:], x.VerificationMethodId)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Did) > 0 {
-			i -= len(x.Did)
-			copy(dAtA[i:], x.Did)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -11751,7 +11426,7 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRegisterWebAuthnCredentialResponse)
+		x := input.Message.Interface().(*MsgLinkExternalWalletResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11783,45 +11458,13 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredentialResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWalletResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLinkExternalWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Did = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 				}
@@ -11853,104 +11496,6 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 				}
 				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VaultId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultPublicKey", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VaultPublicKey = append(x.VaultPublicKey[:0], dAtA[iNdEx:postIndex]...)
-				if x.VaultPublicKey == nil {
-					x.VaultPublicKey = []byte{}
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnclaveId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.EnclaveId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11986,1005 +11531,6811 @@ func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *p
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: did/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgAddDelegatedController            protoreflect.MessageDescriptor
+	fd_MsgAddDelegatedController_controller protoreflect.FieldDescriptor
+	fd_MsgAddDelegatedController_did        protoreflect.FieldDescriptor
+	fd_MsgAddDelegatedController_delegate   protoreflect.FieldDescriptor
+	fd_MsgAddDelegatedController_expires_at protoreflect.FieldDescriptor
+	fd_MsgAddDelegatedController_purpose    protoreflect.FieldDescriptor
 )
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgAddDelegatedController = File_did_v1_tx_proto.Messages().ByName("MsgAddDelegatedController")
+	fd_MsgAddDelegatedController_controller = md_MsgAddDelegatedController.Fields().ByName("controller")
+	fd_MsgAddDelegatedController_did = md_MsgAddDelegatedController.Fields().ByName("did")
+	fd_MsgAddDelegatedController_delegate = md_MsgAddDelegatedController.Fields().ByName("delegate")
+	fd_MsgAddDelegatedController_expires_at = md_MsgAddDelegatedController.Fields().ByName("expires_at")
+	fd_MsgAddDelegatedController_purpose = md_MsgAddDelegatedController.Fields().ByName("purpose")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAddDelegatedController)(nil)
+
+type fastReflection_MsgAddDelegatedController MsgAddDelegatedController
+
+func (x *MsgAddDelegatedController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddDelegatedController)(x)
+}
+
+func (x *MsgAddDelegatedController) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAddDelegatedController_messageType fastReflection_MsgAddDelegatedController_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddDelegatedController_messageType{}
+
+type fastReflection_MsgAddDelegatedController_messageType struct{}
+
+func (x fastReflection_MsgAddDelegatedController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddDelegatedController)(nil)
+}
+func (x fastReflection_MsgAddDelegatedController_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddDelegatedController)
+}
+func (x fastReflection_MsgAddDelegatedController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddDelegatedController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAddDelegatedController) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddDelegatedController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAddDelegatedController) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddDelegatedController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAddDelegatedController) New() protoreflect.Message {
+	return new(fastReflection_MsgAddDelegatedController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAddDelegatedController) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddDelegatedController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAddDelegatedController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgAddDelegatedController_controller, value) {
+			return
+		}
+	}
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgAddDelegatedController_did, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_MsgAddDelegatedController_delegate, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_MsgAddDelegatedController_expires_at, value) {
+			return
+		}
+	}
+	if x.Purpose != "" {
+		value := protoreflect.ValueOfString(x.Purpose)
+		if !f(fd_MsgAddDelegatedController_purpose, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
 //
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAddDelegatedController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgAddDelegatedController.controller":
+		return x.Controller != ""
+	case "did.v1.MsgAddDelegatedController.did":
+		return x.Did != ""
+	case "did.v1.MsgAddDelegatedController.delegate":
+		return x.Delegate != ""
+	case "did.v1.MsgAddDelegatedController.expires_at":
+		return x.ExpiresAt != int64(0)
+	case "did.v1.MsgAddDelegatedController.purpose":
+		return x.Purpose != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgAddDelegatedController.controller":
+		x.Controller = ""
+	case "did.v1.MsgAddDelegatedController.did":
+		x.Did = ""
+	case "did.v1.MsgAddDelegatedController.delegate":
+		x.Delegate = ""
+	case "did.v1.MsgAddDelegatedController.expires_at":
+		x.ExpiresAt = int64(0)
+	case "did.v1.MsgAddDelegatedController.purpose":
+		x.Purpose = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAddDelegatedController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgAddDelegatedController.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAddDelegatedController.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAddDelegatedController.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAddDelegatedController.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	case "did.v1.MsgAddDelegatedController.purpose":
+		value := x.Purpose
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedController does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgAddDelegatedController.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.MsgAddDelegatedController.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgAddDelegatedController.delegate":
+		x.Delegate = value.Interface().(string)
+	case "did.v1.MsgAddDelegatedController.expires_at":
+		x.ExpiresAt = value.Int()
+	case "did.v1.MsgAddDelegatedController.purpose":
+		x.Purpose = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgAddDelegatedController.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgAddDelegatedController is not mutable"))
+	case "did.v1.MsgAddDelegatedController.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgAddDelegatedController is not mutable"))
+	case "did.v1.MsgAddDelegatedController.delegate":
+		panic(fmt.Errorf("field delegate of message did.v1.MsgAddDelegatedController is not mutable"))
+	case "did.v1.MsgAddDelegatedController.expires_at":
+		panic(fmt.Errorf("field expires_at of message did.v1.MsgAddDelegatedController is not mutable"))
+	case "did.v1.MsgAddDelegatedController.purpose":
+		panic(fmt.Errorf("field purpose of message did.v1.MsgAddDelegatedController is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAddDelegatedController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgAddDelegatedController.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAddDelegatedController.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAddDelegatedController.delegate":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAddDelegatedController.expires_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "did.v1.MsgAddDelegatedController.purpose":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAddDelegatedController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddDelegatedController", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAddDelegatedController) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedController) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAddDelegatedController) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAddDelegatedController) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAddDelegatedController)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		l = len(x.Purpose)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddDelegatedController)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Purpose) > 0 {
+			i -= len(x.Purpose)
+			copy(dAtA[i:], x.Purpose)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Purpose)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddDelegatedController)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDelegatedController: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDelegatedController: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				x.ExpiresAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Purpose = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAddDelegatedControllerResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgAddDelegatedControllerResponse = File_did_v1_tx_proto.Messages().ByName("MsgAddDelegatedControllerResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAddDelegatedControllerResponse)(nil)
+
+type fastReflection_MsgAddDelegatedControllerResponse MsgAddDelegatedControllerResponse
+
+func (x *MsgAddDelegatedControllerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddDelegatedControllerResponse)(x)
+}
+
+func (x *MsgAddDelegatedControllerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAddDelegatedControllerResponse_messageType fastReflection_MsgAddDelegatedControllerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddDelegatedControllerResponse_messageType{}
+
+type fastReflection_MsgAddDelegatedControllerResponse_messageType struct{}
+
+func (x fastReflection_MsgAddDelegatedControllerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddDelegatedControllerResponse)(nil)
+}
+func (x fastReflection_MsgAddDelegatedControllerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddDelegatedControllerResponse)
+}
+func (x fastReflection_MsgAddDelegatedControllerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddDelegatedControllerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddDelegatedControllerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddDelegatedControllerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAddDelegatedControllerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddDelegatedControllerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedControllerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAddDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAddDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAddDelegatedControllerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAddDelegatedControllerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAddDelegatedControllerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddDelegatedControllerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddDelegatedControllerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDelegatedControllerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDelegatedControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRemoveDelegatedController            protoreflect.MessageDescriptor
+	fd_MsgRemoveDelegatedController_controller protoreflect.FieldDescriptor
+	fd_MsgRemoveDelegatedController_did        protoreflect.FieldDescriptor
+	fd_MsgRemoveDelegatedController_delegate   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgRemoveDelegatedController = File_did_v1_tx_proto.Messages().ByName("MsgRemoveDelegatedController")
+	fd_MsgRemoveDelegatedController_controller = md_MsgRemoveDelegatedController.Fields().ByName("controller")
+	fd_MsgRemoveDelegatedController_did = md_MsgRemoveDelegatedController.Fields().ByName("did")
+	fd_MsgRemoveDelegatedController_delegate = md_MsgRemoveDelegatedController.Fields().ByName("delegate")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveDelegatedController)(nil)
+
+type fastReflection_MsgRemoveDelegatedController MsgRemoveDelegatedController
+
+func (x *MsgRemoveDelegatedController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveDelegatedController)(x)
+}
+
+func (x *MsgRemoveDelegatedController) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveDelegatedController_messageType fastReflection_MsgRemoveDelegatedController_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveDelegatedController_messageType{}
+
+type fastReflection_MsgRemoveDelegatedController_messageType struct{}
+
+func (x fastReflection_MsgRemoveDelegatedController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveDelegatedController)(nil)
+}
+func (x fastReflection_MsgRemoveDelegatedController_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveDelegatedController)
+}
+func (x fastReflection_MsgRemoveDelegatedController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveDelegatedController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveDelegatedController) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveDelegatedController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveDelegatedController) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveDelegatedController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveDelegatedController) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveDelegatedController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveDelegatedController) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveDelegatedController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveDelegatedController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgRemoveDelegatedController_controller, value) {
+			return
+		}
+	}
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgRemoveDelegatedController_did, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_MsgRemoveDelegatedController_delegate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveDelegatedController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgRemoveDelegatedController.controller":
+		return x.Controller != ""
+	case "did.v1.MsgRemoveDelegatedController.did":
+		return x.Did != ""
+	case "did.v1.MsgRemoveDelegatedController.delegate":
+		return x.Delegate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgRemoveDelegatedController.controller":
+		x.Controller = ""
+	case "did.v1.MsgRemoveDelegatedController.did":
+		x.Did = ""
+	case "did.v1.MsgRemoveDelegatedController.delegate":
+		x.Delegate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveDelegatedController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgRemoveDelegatedController.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRemoveDelegatedController.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRemoveDelegatedController.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedController does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgRemoveDelegatedController.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.MsgRemoveDelegatedController.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgRemoveDelegatedController.delegate":
+		x.Delegate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgRemoveDelegatedController.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgRemoveDelegatedController is not mutable"))
+	case "did.v1.MsgRemoveDelegatedController.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgRemoveDelegatedController is not mutable"))
+	case "did.v1.MsgRemoveDelegatedController.delegate":
+		panic(fmt.Errorf("field delegate of message did.v1.MsgRemoveDelegatedController is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveDelegatedController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgRemoveDelegatedController.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRemoveDelegatedController.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRemoveDelegatedController.delegate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedController"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedController does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveDelegatedController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveDelegatedController", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveDelegatedController) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedController) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveDelegatedController) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveDelegatedController) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveDelegatedController)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveDelegatedController)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveDelegatedController)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveDelegatedController: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveDelegatedController: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRemoveDelegatedControllerResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgRemoveDelegatedControllerResponse = File_did_v1_tx_proto.Messages().ByName("MsgRemoveDelegatedControllerResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveDelegatedControllerResponse)(nil)
+
+type fastReflection_MsgRemoveDelegatedControllerResponse MsgRemoveDelegatedControllerResponse
+
+func (x *MsgRemoveDelegatedControllerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveDelegatedControllerResponse)(x)
+}
+
+func (x *MsgRemoveDelegatedControllerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveDelegatedControllerResponse_messageType fastReflection_MsgRemoveDelegatedControllerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveDelegatedControllerResponse_messageType{}
+
+type fastReflection_MsgRemoveDelegatedControllerResponse_messageType struct{}
+
+func (x fastReflection_MsgRemoveDelegatedControllerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveDelegatedControllerResponse)(nil)
+}
+func (x fastReflection_MsgRemoveDelegatedControllerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveDelegatedControllerResponse)
+}
+func (x fastReflection_MsgRemoveDelegatedControllerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveDelegatedControllerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveDelegatedControllerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveDelegatedControllerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveDelegatedControllerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveDelegatedControllerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedControllerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRemoveDelegatedControllerResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRemoveDelegatedControllerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRemoveDelegatedControllerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveDelegatedControllerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveDelegatedControllerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveDelegatedControllerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveDelegatedControllerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveDelegatedControllerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveDelegatedControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAttachNFT            protoreflect.MessageDescriptor
+	fd_MsgAttachNFT_controller protoreflect.FieldDescriptor
+	fd_MsgAttachNFT_did        protoreflect.FieldDescriptor
+	fd_MsgAttachNFT_class_id   protoreflect.FieldDescriptor
+	fd_MsgAttachNFT_nft_id     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgAttachNFT = File_did_v1_tx_proto.Messages().ByName("MsgAttachNFT")
+	fd_MsgAttachNFT_controller = md_MsgAttachNFT.Fields().ByName("controller")
+	fd_MsgAttachNFT_did = md_MsgAttachNFT.Fields().ByName("did")
+	fd_MsgAttachNFT_class_id = md_MsgAttachNFT.Fields().ByName("class_id")
+	fd_MsgAttachNFT_nft_id = md_MsgAttachNFT.Fields().ByName("nft_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAttachNFT)(nil)
+
+type fastReflection_MsgAttachNFT MsgAttachNFT
+
+func (x *MsgAttachNFT) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAttachNFT)(x)
+}
+
+func (x *MsgAttachNFT) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAttachNFT_messageType fastReflection_MsgAttachNFT_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAttachNFT_messageType{}
+
+type fastReflection_MsgAttachNFT_messageType struct{}
+
+func (x fastReflection_MsgAttachNFT_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAttachNFT)(nil)
+}
+func (x fastReflection_MsgAttachNFT_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAttachNFT)
+}
+func (x fastReflection_MsgAttachNFT_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAttachNFT
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAttachNFT) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAttachNFT
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAttachNFT) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAttachNFT_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAttachNFT) New() protoreflect.Message {
+	return new(fastReflection_MsgAttachNFT)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAttachNFT) Interface() protoreflect.ProtoMessage {
+	return (*MsgAttachNFT)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAttachNFT) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgAttachNFT_controller, value) {
+			return
+		}
+	}
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgAttachNFT_did, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_MsgAttachNFT_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_MsgAttachNFT_nft_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAttachNFT) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFT.controller":
+		return x.Controller != ""
+	case "did.v1.MsgAttachNFT.did":
+		return x.Did != ""
+	case "did.v1.MsgAttachNFT.class_id":
+		return x.ClassId != ""
+	case "did.v1.MsgAttachNFT.nft_id":
+		return x.NftId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFT) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFT.controller":
+		x.Controller = ""
+	case "did.v1.MsgAttachNFT.did":
+		x.Did = ""
+	case "did.v1.MsgAttachNFT.class_id":
+		x.ClassId = ""
+	case "did.v1.MsgAttachNFT.nft_id":
+		x.NftId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAttachNFT) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgAttachNFT.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAttachNFT.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAttachNFT.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgAttachNFT.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFT does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFT) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFT.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.MsgAttachNFT.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgAttachNFT.class_id":
+		x.ClassId = value.Interface().(string)
+	case "did.v1.MsgAttachNFT.nft_id":
+		x.NftId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFT) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFT.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgAttachNFT is not mutable"))
+	case "did.v1.MsgAttachNFT.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgAttachNFT is not mutable"))
+	case "did.v1.MsgAttachNFT.class_id":
+		panic(fmt.Errorf("field class_id of message did.v1.MsgAttachNFT is not mutable"))
+	case "did.v1.MsgAttachNFT.nft_id":
+		panic(fmt.Errorf("field nft_id of message did.v1.MsgAttachNFT is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAttachNFT) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFT.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAttachNFT.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAttachNFT.class_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgAttachNFT.nft_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAttachNFT) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAttachNFT", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAttachNFT) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFT) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAttachNFT) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAttachNFT) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAttachNFT)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAttachNFT)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAttachNFT)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAttachNFT: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAttachNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAttachNFTResponse               protoreflect.MessageDescriptor
+	fd_MsgAttachNFTResponse_dwn_record_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgAttachNFTResponse = File_did_v1_tx_proto.Messages().ByName("MsgAttachNFTResponse")
+	fd_MsgAttachNFTResponse_dwn_record_id = md_MsgAttachNFTResponse.Fields().ByName("dwn_record_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAttachNFTResponse)(nil)
+
+type fastReflection_MsgAttachNFTResponse MsgAttachNFTResponse
+
+func (x *MsgAttachNFTResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAttachNFTResponse)(x)
+}
+
+func (x *MsgAttachNFTResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAttachNFTResponse_messageType fastReflection_MsgAttachNFTResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAttachNFTResponse_messageType{}
+
+type fastReflection_MsgAttachNFTResponse_messageType struct{}
+
+func (x fastReflection_MsgAttachNFTResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAttachNFTResponse)(nil)
+}
+func (x fastReflection_MsgAttachNFTResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAttachNFTResponse)
+}
+func (x fastReflection_MsgAttachNFTResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAttachNFTResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAttachNFTResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAttachNFTResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAttachNFTResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAttachNFTResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAttachNFTResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAttachNFTResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAttachNFTResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAttachNFTResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAttachNFTResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DwnRecordId != "" {
+		value := protoreflect.ValueOfString(x.DwnRecordId)
+		if !f(fd_MsgAttachNFTResponse_dwn_record_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAttachNFTResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFTResponse.dwn_record_id":
+		return x.DwnRecordId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFTResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFTResponse.dwn_record_id":
+		x.DwnRecordId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAttachNFTResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgAttachNFTResponse.dwn_record_id":
+		value := x.DwnRecordId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFTResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFTResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFTResponse.dwn_record_id":
+		x.DwnRecordId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFTResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFTResponse.dwn_record_id":
+		panic(fmt.Errorf("field dwn_record_id of message did.v1.MsgAttachNFTResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAttachNFTResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgAttachNFTResponse.dwn_record_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgAttachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgAttachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAttachNFTResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgAttachNFTResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAttachNFTResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttachNFTResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAttachNFTResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAttachNFTResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAttachNFTResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DwnRecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAttachNFTResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DwnRecordId) > 0 {
+			i -= len(x.DwnRecordId)
+			copy(dAtA[i:], x.DwnRecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DwnRecordId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAttachNFTResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAttachNFTResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAttachNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DwnRecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DwnRecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDetachNFT            protoreflect.MessageDescriptor
+	fd_MsgDetachNFT_controller protoreflect.FieldDescriptor
+	fd_MsgDetachNFT_did        protoreflect.FieldDescriptor
+	fd_MsgDetachNFT_class_id   protoreflect.FieldDescriptor
+	fd_MsgDetachNFT_nft_id     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgDetachNFT = File_did_v1_tx_proto.Messages().ByName("MsgDetachNFT")
+	fd_MsgDetachNFT_controller = md_MsgDetachNFT.Fields().ByName("controller")
+	fd_MsgDetachNFT_did = md_MsgDetachNFT.Fields().ByName("did")
+	fd_MsgDetachNFT_class_id = md_MsgDetachNFT.Fields().ByName("class_id")
+	fd_MsgDetachNFT_nft_id = md_MsgDetachNFT.Fields().ByName("nft_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDetachNFT)(nil)
+
+type fastReflection_MsgDetachNFT MsgDetachNFT
+
+func (x *MsgDetachNFT) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDetachNFT)(x)
+}
+
+func (x *MsgDetachNFT) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDetachNFT_messageType fastReflection_MsgDetachNFT_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDetachNFT_messageType{}
+
+type fastReflection_MsgDetachNFT_messageType struct{}
+
+func (x fastReflection_MsgDetachNFT_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDetachNFT)(nil)
+}
+func (x fastReflection_MsgDetachNFT_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDetachNFT)
+}
+func (x fastReflection_MsgDetachNFT_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDetachNFT
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDetachNFT) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDetachNFT
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDetachNFT) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDetachNFT_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDetachNFT) New() protoreflect.Message {
+	return new(fastReflection_MsgDetachNFT)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDetachNFT) Interface() protoreflect.ProtoMessage {
+	return (*MsgDetachNFT)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDetachNFT) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgDetachNFT_controller, value) {
+			return
+		}
+	}
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgDetachNFT_did, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_MsgDetachNFT_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_MsgDetachNFT_nft_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDetachNFT) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgDetachNFT.controller":
+		return x.Controller != ""
+	case "did.v1.MsgDetachNFT.did":
+		return x.Did != ""
+	case "did.v1.MsgDetachNFT.class_id":
+		return x.ClassId != ""
+	case "did.v1.MsgDetachNFT.nft_id":
+		return x.NftId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFT) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgDetachNFT.controller":
+		x.Controller = ""
+	case "did.v1.MsgDetachNFT.did":
+		x.Did = ""
+	case "did.v1.MsgDetachNFT.class_id":
+		x.ClassId = ""
+	case "did.v1.MsgDetachNFT.nft_id":
+		x.NftId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDetachNFT) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgDetachNFT.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgDetachNFT.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgDetachNFT.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgDetachNFT.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFT does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFT) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgDetachNFT.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.MsgDetachNFT.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgDetachNFT.class_id":
+		x.ClassId = value.Interface().(string)
+	case "did.v1.MsgDetachNFT.nft_id":
+		x.NftId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFT) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgDetachNFT.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgDetachNFT is not mutable"))
+	case "did.v1.MsgDetachNFT.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgDetachNFT is not mutable"))
+	case "did.v1.MsgDetachNFT.class_id":
+		panic(fmt.Errorf("field class_id of message did.v1.MsgDetachNFT is not mutable"))
+	case "did.v1.MsgDetachNFT.nft_id":
+		panic(fmt.Errorf("field nft_id of message did.v1.MsgDetachNFT is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDetachNFT) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgDetachNFT.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgDetachNFT.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgDetachNFT.class_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgDetachNFT.nft_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFT"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDetachNFT) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgDetachNFT", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDetachNFT) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFT) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDetachNFT) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDetachNFT) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDetachNFT)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDetachNFT)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDetachNFT)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDetachNFT: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDetachNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDetachNFTResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgDetachNFTResponse = File_did_v1_tx_proto.Messages().ByName("MsgDetachNFTResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDetachNFTResponse)(nil)
+
+type fastReflection_MsgDetachNFTResponse MsgDetachNFTResponse
+
+func (x *MsgDetachNFTResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDetachNFTResponse)(x)
+}
+
+func (x *MsgDetachNFTResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDetachNFTResponse_messageType fastReflection_MsgDetachNFTResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDetachNFTResponse_messageType{}
+
+type fastReflection_MsgDetachNFTResponse_messageType struct{}
+
+func (x fastReflection_MsgDetachNFTResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDetachNFTResponse)(nil)
+}
+func (x fastReflection_MsgDetachNFTResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDetachNFTResponse)
+}
+func (x fastReflection_MsgDetachNFTResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDetachNFTResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDetachNFTResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDetachNFTResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDetachNFTResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDetachNFTResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDetachNFTResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDetachNFTResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDetachNFTResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDetachNFTResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDetachNFTResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDetachNFTResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFTResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDetachNFTResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFTResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFTResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFTResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDetachNFTResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgDetachNFTResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgDetachNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDetachNFTResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgDetachNFTResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDetachNFTResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDetachNFTResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDetachNFTResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDetachNFTResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDetachNFTResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDetachNFTResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDetachNFTResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDetachNFTResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDetachNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterWebAuthnCredential                        protoreflect.MessageDescriptor
+	fd_MsgRegisterWebAuthnCredential_controller             protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredential_username               protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredential_webauthn_credential    protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredential_verification_method_id protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredential_auto_create_vault      protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredential_referral_code          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgRegisterWebAuthnCredential = File_did_v1_tx_proto.Messages().ByName("MsgRegisterWebAuthnCredential")
+	fd_MsgRegisterWebAuthnCredential_controller = md_MsgRegisterWebAuthnCredential.Fields().ByName("controller")
+	fd_MsgRegisterWebAuthnCredential_username = md_MsgRegisterWebAuthnCredential.Fields().ByName("username")
+	fd_MsgRegisterWebAuthnCredential_webauthn_credential = md_MsgRegisterWebAuthnCredential.Fields().ByName("webauthn_credential")
+	fd_MsgRegisterWebAuthnCredential_verification_method_id = md_MsgRegisterWebAuthnCredential.Fields().ByName("verification_method_id")
+	fd_MsgRegisterWebAuthnCredential_auto_create_vault = md_MsgRegisterWebAuthnCredential.Fields().ByName("auto_create_vault")
+	fd_MsgRegisterWebAuthnCredential_referral_code = md_MsgRegisterWebAuthnCredential.Fields().ByName("referral_code")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterWebAuthnCredential)(nil)
+
+type fastReflection_MsgRegisterWebAuthnCredential MsgRegisterWebAuthnCredential
+
+func (x *MsgRegisterWebAuthnCredential) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterWebAuthnCredential)(x)
+}
+
+func (x *MsgRegisterWebAuthnCredential) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterWebAuthnCredential_messageType fastReflection_MsgRegisterWebAuthnCredential_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterWebAuthnCredential_messageType{}
+
+type fastReflection_MsgRegisterWebAuthnCredential_messageType struct{}
+
+func (x fastReflection_MsgRegisterWebAuthnCredential_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterWebAuthnCredential)(nil)
+}
+func (x fastReflection_MsgRegisterWebAuthnCredential_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterWebAuthnCredential)
+}
+func (x fastReflection_MsgRegisterWebAuthnCredential_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterWebAuthnCredential
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterWebAuthnCredential
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterWebAuthnCredential_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterWebAuthnCredential)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterWebAuthnCredential)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgRegisterWebAuthnCredential_controller, value) {
+			return
+		}
+	}
+	if x.Username != "" {
+		value := protoreflect.ValueOfString(x.Username)
+		if !f(fd_MsgRegisterWebAuthnCredential_username, value) {
+			return
+		}
+	}
+	if x.WebauthnCredential != nil {
+		value := protoreflect.ValueOfMessage(x.WebauthnCredential.ProtoReflect())
+		if !f(fd_MsgRegisterWebAuthnCredential_webauthn_credential, value) {
+			return
+		}
+	}
+	if x.VerificationMethodId != "" {
+		value := protoreflect.ValueOfString(x.VerificationMethodId)
+		if !f(fd_MsgRegisterWebAuthnCredential_verification_method_id, value) {
+			return
+		}
+	}
+	if x.AutoCreateVault != false {
+		value := protoreflect.ValueOfBool(x.AutoCreateVault)
+		if !f(fd_MsgRegisterWebAuthnCredential_auto_create_vault, value) {
+			return
+		}
+	}
+	if x.ReferralCode != "" {
+		value := protoreflect.ValueOfString(x.ReferralCode)
+		if !f(fd_MsgRegisterWebAuthnCredential_referral_code, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+		return x.Controller != ""
+	case "did.v1.MsgRegisterWebAuthnCredential.username":
+		return x.Username != ""
+	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
+		return x.WebauthnCredential != nil
+	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+		return x.VerificationMethodId != ""
+	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
+		return x.AutoCreateVault != false
+	case "did.v1.MsgRegisterWebAuthnCredential.referral_code":
+		return x.ReferralCode != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+		x.Controller = ""
+	case "did.v1.MsgRegisterWebAuthnCredential.username":
+		x.Username = ""
+	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
+		x.WebauthnCredential = nil
+	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+		x.VerificationMethodId = ""
+	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
+		x.AutoCreateVault = false
+	case "did.v1.MsgRegisterWebAuthnCredential.referral_code":
+		x.ReferralCode = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRegisterWebAuthnCredential.username":
+		value := x.Username
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
+		value := x.WebauthnCredential
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+		value := x.VerificationMethodId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
+		value := x.AutoCreateVault
+		return protoreflect.ValueOfBool(value)
+	case "did.v1.MsgRegisterWebAuthnCredential.referral_code":
+		value := x.ReferralCode
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+		x.Controller = value.Interface().(string)
+	case "did.v1.MsgRegisterWebAuthnCredential.username":
+		x.Username = value.Interface().(string)
+	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
+		x.WebauthnCredential = value.Message().Interface().(*WebAuthnCredential)
+	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+		x.VerificationMethodId = value.Interface().(string)
+	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
+		x.AutoCreateVault = value.Bool()
+	case "did.v1.MsgRegisterWebAuthnCredential.referral_code":
+		x.ReferralCode = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
+		if x.WebauthnCredential == nil {
+			x.WebauthnCredential = new(WebAuthnCredential)
+		}
+		return protoreflect.ValueOfMessage(x.WebauthnCredential.ProtoReflect())
+	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+		panic(fmt.Errorf("field controller of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredential.username":
+		panic(fmt.Errorf("field username of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
+		panic(fmt.Errorf("field auto_create_vault of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredential.referral_code":
+		panic(fmt.Errorf("field referral_code of message did.v1.MsgRegisterWebAuthnCredential is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredential.controller":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRegisterWebAuthnCredential.username":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRegisterWebAuthnCredential.webauthn_credential":
+		m := new(WebAuthnCredential)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "did.v1.MsgRegisterWebAuthnCredential.verification_method_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRegisterWebAuthnCredential.auto_create_vault":
+		return protoreflect.ValueOfBool(false)
+	case "did.v1.MsgRegisterWebAuthnCredential.referral_code":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredential"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredential does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRegisterWebAuthnCredential", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterWebAuthnCredential) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterWebAuthnCredential)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Username)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WebauthnCredential != nil {
+			l = options.Size(x.WebauthnCredential)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerificationMethodId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoCreateVault {
+			n += 2
+		}
+		l = len(x.ReferralCode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterWebAuthnCredential)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReferralCode) > 0 {
+			i -= len(x.ReferralCode)
+			copy(dAtA[i:], x.ReferralCode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReferralCode)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.AutoCreateVault {
+			i--
+			if x.AutoCreateVault {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.VerificationMethodId) > 0 {
+			i -= len(x.VerificationMethodId)
+			copy(dAtA[i:], x.VerificationMethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.WebauthnCredential != nil {
+			encoded, err := options.Marshal(x.WebauthnCredential)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Username) > 0 {
+			i -= len(x.Username)
+			copy(dAtA[i:], x.Username)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Username)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterWebAuthnCredential)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredential: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Username = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredential", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WebauthnCredential == nil {
+					x.WebauthnCredential = &WebAuthnCredential{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WebauthnCredential); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCreateVault", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoCreateVault = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferralCode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferralCode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterWebAuthnCredentialResponse                        protoreflect.MessageDescriptor
+	fd_MsgRegisterWebAuthnCredentialResponse_did                    protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredentialResponse_verification_method_id protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredentialResponse_vault_id               protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredentialResponse_vault_public_key       protoreflect.FieldDescriptor
+	fd_MsgRegisterWebAuthnCredentialResponse_enclave_id             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_did_v1_tx_proto_init()
+	md_MsgRegisterWebAuthnCredentialResponse = File_did_v1_tx_proto.Messages().ByName("MsgRegisterWebAuthnCredentialResponse")
+	fd_MsgRegisterWebAuthnCredentialResponse_did = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("did")
+	fd_MsgRegisterWebAuthnCredentialResponse_verification_method_id = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("verification_method_id")
+	fd_MsgRegisterWebAuthnCredentialResponse_vault_id = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("vault_id")
+	fd_MsgRegisterWebAuthnCredentialResponse_vault_public_key = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("vault_public_key")
+	fd_MsgRegisterWebAuthnCredentialResponse_enclave_id = md_MsgRegisterWebAuthnCredentialResponse.Fields().ByName("enclave_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterWebAuthnCredentialResponse)(nil)
+
+type fastReflection_MsgRegisterWebAuthnCredentialResponse MsgRegisterWebAuthnCredentialResponse
+
+func (x *MsgRegisterWebAuthnCredentialResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterWebAuthnCredentialResponse)(x)
+}
+
+func (x *MsgRegisterWebAuthnCredentialResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_did_v1_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType{}
+
+type fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType struct{}
+
+func (x fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterWebAuthnCredentialResponse)(nil)
+}
+func (x fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterWebAuthnCredentialResponse)
+}
+func (x fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterWebAuthnCredentialResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterWebAuthnCredentialResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterWebAuthnCredentialResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterWebAuthnCredentialResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterWebAuthnCredentialResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_MsgRegisterWebAuthnCredentialResponse_did, value) {
+			return
+		}
+	}
+	if x.VerificationMethodId != "" {
+		value := protoreflect.ValueOfString(x.VerificationMethodId)
+		if !f(fd_MsgRegisterWebAuthnCredentialResponse_verification_method_id, value) {
+			return
+		}
+	}
+	if x.VaultId != "" {
+		value := protoreflect.ValueOfString(x.VaultId)
+		if !f(fd_MsgRegisterWebAuthnCredentialResponse_vault_id, value) {
+			return
+		}
+	}
+	if len(x.VaultPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.VaultPublicKey)
+		if !f(fd_MsgRegisterWebAuthnCredentialResponse_vault_public_key, value) {
+			return
+		}
+	}
+	if x.EnclaveId != "" {
+		value := protoreflect.ValueOfString(x.EnclaveId)
+		if !f(fd_MsgRegisterWebAuthnCredentialResponse_enclave_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
+		return x.Did != ""
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+		return x.VerificationMethodId != ""
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
+		return x.VaultId != ""
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
+		return len(x.VaultPublicKey) != 0
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+		return x.EnclaveId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
+		x.Did = ""
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+		x.VerificationMethodId = ""
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
+		x.VaultId = ""
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
+		x.VaultPublicKey = nil
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+		x.EnclaveId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+		value := x.VerificationMethodId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
+		value := x.VaultId
+		return protoreflect.ValueOfString(value)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
+		value := x.VaultPublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+		value := x.EnclaveId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
+		x.Did = value.Interface().(string)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+		x.VerificationMethodId = value.Interface().(string)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
+		x.VaultId = value.Interface().(string)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
+		x.VaultPublicKey = value.Bytes()
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+		x.EnclaveId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
+		panic(fmt.Errorf("field did of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+		panic(fmt.Errorf("field verification_method_id of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
+		panic(fmt.Errorf("field vault_id of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
+		panic(fmt.Errorf("field vault_public_key of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+		panic(fmt.Errorf("field enclave_id of message did.v1.MsgRegisterWebAuthnCredentialResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.did":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.verification_method_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_id":
+		return protoreflect.ValueOfString("")
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.vault_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "did.v1.MsgRegisterWebAuthnCredentialResponse.enclave_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: did.v1.MsgRegisterWebAuthnCredentialResponse"))
+		}
+		panic(fmt.Errorf("message did.v1.MsgRegisterWebAuthnCredentialResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in did.v1.MsgRegisterWebAuthnCredentialResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterWebAuthnCredentialResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterWebAuthnCredentialResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerificationMethodId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VaultId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VaultPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EnclaveId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterWebAuthnCredentialResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EnclaveId) > 0 {
+			i -= len(x.EnclaveId)
+			copy(dAtA[i:], x.EnclaveId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnclaveId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.VaultPublicKey) > 0 {
+			i -= len(x.VaultPublicKey)
+			copy(dAtA[i:], x.VaultPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultPublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VaultId) > 0 {
+			i -= len(x.VaultId)
+			copy(dAtA[i:], x.VaultId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VerificationMethodId) > 0 {
+			i -= len(x.VerificationMethodId)
+			copy(dAtA[i:], x.VerificationMethodId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationMethodId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterWebAuthnCredentialResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredentialResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterWebAuthnCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultPublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultPublicKey = append(x.VaultPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.VaultPublicKey == nil {
+					x.VaultPublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnclaveId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EnclaveId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: did/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgCreateDID creates a new DID document
+type MsgCreateDID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// controller is the address creating the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did_document is the DID document to create
+	DidDocument *DIDDocument `protobuf:"bytes,2,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// referral_code is the optional invite code the DID was referred with
+	ReferralCode string `protobuf:"bytes,3,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+}
+
+func (x *MsgCreateDID) Reset() {
+	*x = MsgCreateDID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateDID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateDID) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateDID.ProtoReflect.Descriptor instead.
+func (*MsgCreateDID) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgCreateDID) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *MsgCreateDID) GetDidDocument() *DIDDocument {
+	if x != nil {
+		return x.DidDocument
+	}
+	return nil
+}
+
+func (x *MsgCreateDID) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+// MsgCreateDIDResponse defines the response for MsgCreateDID
+type MsgCreateDIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// did is the created DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// vault_id is the ID of the auto-created vault (optional)
+	VaultId string `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	// vault_public_key is the public key of the auto-created vault (optional)
+	VaultPublicKey []byte `protobuf:"bytes,3,opt,name=vault_public_key,json=vaultPublicKey,proto3" json:"vault_public_key,omitempty"`
+	// enclave_id is the enclave ID of the auto-created vault (optional)
+	EnclaveId string `protobuf:"bytes,4,opt,name=enclave_id,json=enclaveId,proto3" json:"enclave_id,omitempty"`
+}
+
+func (x *MsgCreateDIDResponse) Reset() {
+	*x = MsgCreateDIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateDIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateDIDResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateDIDResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateDIDResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgCreateDIDResponse) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgCreateDIDResponse) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *MsgCreateDIDResponse) GetVaultPublicKey() []byte {
+	if x != nil {
+		return x.VaultPublicKey
+	}
+	return nil
+}
+
+func (x *MsgCreateDIDResponse) GetEnclaveId() string {
+	if x != nil {
+		return x.EnclaveId
+	}
+	return ""
+}
+
+// MsgUpdateDID updates an existing DID document
+type MsgUpdateDID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// controller is the address updating the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID to update
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// did_document is the updated DID document
+	DidDocument *DIDDocument `protobuf:"bytes,3,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+}
+
+func (x *MsgUpdateDID) Reset() {
+	*x = MsgUpdateDID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateDID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateDID) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateDID.ProtoReflect.Descriptor instead.
+func (*MsgUpdateDID) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgUpdateDID) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *MsgUpdateDID) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgUpdateDID) GetDidDocument() *DIDDocument {
+	if x != nil {
+		return x.DidDocument
+	}
+	return nil
+}
+
+// MsgUpdateDIDResponse defines the response for MsgUpdateDID
+type MsgUpdateDIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateDIDResponse) Reset() {
+	*x = MsgUpdateDIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateDIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateDIDResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateDIDResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateDIDResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgDeactivateDID deactivates a DID document
+type MsgDeactivateDID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// controller is the address deactivating the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID to deactivate
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (x *MsgDeactivateDID) Reset() {
+	*x = MsgDeactivateDID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeactivateDID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeactivateDID) ProtoMessage() {}
+
+// Deprecated: Use MsgDeactivateDID.ProtoReflect.Descriptor instead.
+func (*MsgDeactivateDID) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgDeactivateDID) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *MsgDeactivateDID) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+// MsgDeactivateDIDResponse defines the response for MsgDeactivateDID
+type MsgDeactivateDIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+func (x *MsgDeactivateDIDResponse) Reset() {
+	*x = MsgDeactivateDIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgDeactivateDIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeactivateDIDResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgDeactivateDIDResponse.ProtoReflect.Descriptor instead.
+func (*MsgDeactivateDIDResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgReactivateDID reactivates a deactivated DID document
+type MsgReactivateDID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// controller is the address reactivating the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID to reactivate
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (x *MsgReactivateDID) Reset() {
+	*x = MsgReactivateDID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[0]
+		mi := &file_did_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgReactivateDID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgReactivateDID) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgReactivateDID.ProtoReflect.Descriptor instead.
+func (*MsgReactivateDID) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgReactivateDID) GetController() string {
 	if x != nil {
-		return x.Authority
+		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgReactivateDID) GetDid() string {
 	if x != nil {
-		return x.Params
+		return x.Did
 	}
-	return nil
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+// MsgReactivateDIDResponse defines the response for MsgReactivateDID
+type MsgReactivateDIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgReactivateDIDResponse) Reset() {
+	*x = MsgReactivateDIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[1]
+		mi := &file_did_v1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgReactivateDIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgReactivateDIDResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgReactivateDIDResponse.ProtoReflect.Descriptor instead.
+func (*MsgReactivateDIDResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
-// MsgCreateDID creates a new DID document
-type MsgCreateDID struct {
+// MsgAddVerificationMethod adds a verification method to a DID document
+type MsgAddVerificationMethod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address creating the DID
+	// controller is the address adding the verification method
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did_document is the DID document to create
-	DidDocument *DIDDocument `protobuf:"bytes,2,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// did is the DID to add the verification method to
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// verification_method is the verification method to add
+	VerificationMethod *VerificationMethod `protobuf:"bytes,3,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// relationships specifies which verification relationships to add
+	Relationships []string `protobuf:"bytes,4,rep,name=relationships,proto3" json:"relationships,omitempty"`
 }
 
-func (x *MsgCreateDID) Reset() {
-	*x = MsgCreateDID{}
+func (x *MsgAddVerificationMethod) Reset() {
+	*x = MsgAddVerificationMethod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[2]
+		mi := &file_did_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateDID) String() string {
+func (x *MsgAddVerificationMethod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateDID) ProtoMessage() {}
+func (*MsgAddVerificationMethod) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateDID.ProtoReflect.Descriptor instead.
-func (*MsgCreateDID) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgAddVerificationMethod.ProtoReflect.Descriptor instead.
+func (*MsgAddVerificationMethod) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgCreateDID) GetController() string {
+func (x *MsgAddVerificationMethod) GetController() string {
 	if x != nil {
 		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgCreateDID) GetDidDocument() *DIDDocument {
+func (x *MsgAddVerificationMethod) GetDid() string {
 	if x != nil {
-		return x.DidDocument
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgAddVerificationMethod) GetVerificationMethod() *VerificationMethod {
+	if x != nil {
+		return x.VerificationMethod
 	}
 	return nil
 }
 
-// MsgCreateDIDResponse defines the response for MsgCreateDID
-type MsgCreateDIDResponse struct {
+func (x *MsgAddVerificationMethod) GetRelationships() []string {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// MsgAddVerificationMethodResponse defines the response for
+// MsgAddVerificationMethod
+type MsgAddVerificationMethodResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// did is the created DID identifier
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// vault_id is the ID of the auto-created vault (optional)
-	VaultId string `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
-	// vault_public_key is the public key of the auto-created vault (optional)
-	VaultPublicKey []byte `protobuf:"bytes,3,opt,name=vault_public_key,json=vaultPublicKey,proto3" json:"vault_public_key,omitempty"`
-	// enclave_id is the enclave ID of the auto-created vault (optional)
-	EnclaveId string `protobuf:"bytes,4,opt,name=enclave_id,json=enclaveId,proto3" json:"enclave_id,omitempty"`
+func (x *MsgAddVerificationMethodResponse) Reset() {
+	*x = MsgAddVerificationMethodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgCreateDIDResponse) Reset() {
-	*x = MsgCreateDIDResponse{}
+func (x *MsgAddVerificationMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddVerificationMethodResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddVerificationMethodResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddVerificationMethodResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgRemoveVerificationMethod removes a verification method from a DID document
+type MsgRemoveVerificationMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// controller is the address removing the verification method
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID to remove the verification method from
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// verification_method_id is the ID of the verification method to remove
+	VerificationMethodId string `protobuf:"bytes,3,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+}
+
+func (x *MsgRemoveVerificationMethod) Reset() {
+	*x = MsgRemoveVerificationMethod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[3]
+		mi := &file_did_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateDIDResponse) String() string {
+func (x *MsgRemoveVerificationMethod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateDIDResponse) ProtoMessage() {}
+func (*MsgRemoveVerificationMethod) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateDIDResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateDIDResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgRemoveVerificationMethod.ProtoReflect.Descriptor instead.
+func (*MsgRemoveVerificationMethod) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgCreateDIDResponse) GetDid() string {
+func (x *MsgRemoveVerificationMethod) GetController() string {
 	if x != nil {
-		return x.Did
+		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgCreateDIDResponse) GetVaultId() string {
+func (x *MsgRemoveVerificationMethod) GetDid() string {
 	if x != nil {
-		return x.VaultId
+		return x.Did
 	}
 	return ""
 }
 
-func (x *MsgCreateDIDResponse) GetVaultPublicKey() []byte {
+func (x *MsgRemoveVerificationMethod) GetVerificationMethodId() string {
 	if x != nil {
-		return x.VaultPublicKey
+		return x.VerificationMethodId
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgCreateDIDResponse) GetEnclaveId() string {
-	if x != nil {
-		return x.EnclaveId
+// MsgRemoveVerificationMethodResponse defines the response for
+// MsgRemoveVerificationMethod
+type MsgRemoveVerificationMethodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRemoveVerificationMethodResponse) Reset() {
+	*x = MsgRemoveVerificationMethodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-// MsgUpdateDID updates an existing DID document
-type MsgUpdateDID struct {
+func (x *MsgRemoveVerificationMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRemoveVerificationMethodResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRemoveVerificationMethodResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveVerificationMethodResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgAddService adds a service endpoint to a DID document
+type MsgAddService struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address updating the DID
+	// controller is the address adding the service
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to update
+	// did is the DID to add the service to
 	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// did_document is the updated DID document
-	DidDocument *DIDDocument `protobuf:"bytes,3,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// service is the service to add
+	Service *Service `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *MsgUpdateDID) Reset() {
-	*x = MsgUpdateDID{}
+func (x *MsgAddService) Reset() {
+	*x = MsgAddService{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[4]
+		mi := &file_did_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateDID) String() string {
+func (x *MsgAddService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateDID) ProtoMessage() {}
-
-// Deprecated: Use MsgUpdateDID.ProtoReflect.Descriptor instead.
-func (*MsgUpdateDID) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{4}
+func (*MsgAddService) ProtoMessage() {}
+
+// Deprecated: Use MsgAddService.ProtoReflect.Descriptor instead.
+func (*MsgAddService) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgUpdateDID) GetController() string {
+func (x *MsgAddService) GetController() string {
 	if x != nil {
 		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgUpdateDID) GetDid() string {
+func (x *MsgAddService) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *MsgUpdateDID) GetDidDocument() *DIDDocument {
+func (x *MsgAddService) GetService() *Service {
 	if x != nil {
-		return x.DidDocument
+		return x.Service
 	}
 	return nil
 }
 
-// MsgUpdateDIDResponse defines the response for MsgUpdateDID
-type MsgUpdateDIDResponse struct {
+// MsgAddServiceResponse defines the response for MsgAddService
+type MsgAddServiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateDIDResponse) Reset() {
-	*x = MsgUpdateDIDResponse{}
+func (x *MsgAddServiceResponse) Reset() {
+	*x = MsgAddServiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[5]
+		mi := &file_did_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateDIDResponse) String() string {
+func (x *MsgAddServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateDIDResponse) ProtoMessage() {}
+func (*MsgAddServiceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateDIDResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateDIDResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgAddServiceResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddServiceResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-// MsgDeactivateDID deactivates a DID document
-type MsgDeactivateDID struct {
+// MsgRemoveService removes a service endpoint from a DID document
+type MsgRemoveService struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address deactivating the DID
+	// controller is the address removing the service
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to deactivate
+	// did is the DID to remove the service from
 	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// service_id is the ID of the service to remove
+	ServiceId string `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *MsgDeactivateDID) Reset() {
-	*x = MsgDeactivateDID{}
+func (x *MsgRemoveService) Reset() {
+	*x = MsgRemoveService{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[6]
+		mi := &file_did_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDeactivateDID) String() string {
+func (x *MsgRemoveService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDeactivateDID) ProtoMessage() {}
+func (*MsgRemoveService) ProtoMessage() {}
 
-// Deprecated: Use MsgDeactivateDID.ProtoReflect.Descriptor instead.
-func (*MsgDeactivateDID) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgRemoveService.ProtoReflect.Descriptor instead.
+func (*MsgRemoveService) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgDeactivateDID) GetController() string {
+func (x *MsgRemoveService) GetController() string {
 	if x != nil {
 		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgDeactivateDID) GetDid() string {
+func (x *MsgRemoveService) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-// MsgDeactivateDIDResponse defines the response for MsgDeactivateDID
-type MsgDeactivateDIDResponse struct {
+func (x *MsgRemoveService) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+// MsgRemoveServiceResponse defines the response for MsgRemoveService
+type MsgRemoveServiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgDeactivateDIDResponse) Reset() {
-	*x = MsgDeactivateDIDResponse{}
+func (x *MsgRemoveServiceResponse) Reset() {
+	*x = MsgRemoveServiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[7]
+		mi := &file_did_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDeactivateDIDResponse) String() string {
+func (x *MsgRemoveServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDeactivateDIDResponse) ProtoMessage() {}
+func (*MsgRemoveServiceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgDeactivateDIDResponse.ProtoReflect.Descriptor instead.
-func (*MsgDeactivateDIDResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgRemoveServiceResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveServiceResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-// MsgAddVerificationMethod adds a verification method to a DID document
-type MsgAddVerificationMethod struct {
+// MsgIssueVerifiableCredential issues a new verifiable credential
+type MsgIssueVerifiableCredential struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address adding the verification method
-	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to add the verification method to
-	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// verification_method is the verification method to add
-	VerificationMethod *VerificationMethod `protobuf:"bytes,3,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
-	// relationships specifies which verification relationships to add
-	Relationships []string `protobuf:"bytes,4,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	// issuer is the address issuing the credential
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// credential is the verifiable credential to issue
+	Credential *VerifiableCredential `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
-func (x *MsgAddVerificationMethod) Reset() {
-	*x = MsgAddVerificationMethod{}
+func (x *MsgIssueVerifiableCredential) Reset() {
+	*x = MsgIssueVerifiableCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[8]
+		mi := &file_did_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddVerificationMethod) String() string {
+func (x *MsgIssueVerifiableCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddVerificationMethod) ProtoMessage() {}
-
-// Deprecated: Use MsgAddVerificationMethod.ProtoReflect.Descriptor instead.
-func (*MsgAddVerificationMethod) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{8}
-}
+func (*MsgIssueVerifiableCredential) ProtoMessage() {}
 
-func (x *MsgAddVerificationMethod) GetController() string {
-	if x != nil {
-		return x.Controller
-	}
-	return ""
+// Deprecated: Use MsgIssueVerifiableCredential.ProtoReflect.Descriptor instead.
+func (*MsgIssueVerifiableCredential) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgAddVerificationMethod) GetDid() string {
+func (x *MsgIssueVerifiableCredential) GetIssuer() string {
 	if x != nil {
-		return x.Did
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *MsgAddVerificationMethod) GetVerificationMethod() *VerificationMethod {
-	if x != nil {
-		return x.VerificationMethod
-	}
-	return nil
-}
-
-func (x *MsgAddVerificationMethod) GetRelationships() []string {
+func (x *MsgIssueVerifiableCredential) GetCredential() *VerifiableCredential {
 	if x != nil {
-		return x.Relationships
+		return x.Credential
 	}
 	return nil
 }
 
-// MsgAddVerificationMethodResponse defines the response for
-// MsgAddVerificationMethod
-type MsgAddVerificationMethodResponse struct {
+// MsgIssueVerifiableCredentialResponse defines the response for
+// MsgIssueVerifiableCredential
+type MsgIssueVerifiableCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// credential_id is the ID of the issued credential
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 }
 
-func (x *MsgAddVerificationMethodResponse) Reset() {
-	*x = MsgAddVerificationMethodResponse{}
+func (x *MsgIssueVerifiableCredentialResponse) Reset() {
+	*x = MsgIssueVerifiableCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[9]
+		mi := &file_did_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddVerificationMethodResponse) String() string {
+func (x *MsgIssueVerifiableCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddVerificationMethodResponse) ProtoMessage() {}
+func (*MsgIssueVerifiableCredentialResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddVerificationMethodResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddVerificationMethodResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgIssueVerifiableCredentialResponse.ProtoReflect.Descriptor instead.
+func (*MsgIssueVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-// MsgRemoveVerificationMethod removes a verification method from a DID document
-type MsgRemoveVerificationMethod struct {
+func (x *MsgIssueVerifiableCredentialResponse) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+// MsgRevokeVerifiableCredential revokes a verifiable credential
+type MsgRevokeVerifiableCredential struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address removing the verification method
-	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to remove the verification method from
-	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// verification_method_id is the ID of the verification method to remove
-	VerificationMethodId string `protobuf:"bytes,3,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+	// issuer is the address revoking the credential
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// credential_id is the ID of the credential to revoke
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// revocation_reason is the reason for revocation
+	RevocationReason string `protobuf:"bytes,3,opt,name=revocation_reason,json=revocationReason,proto3" json:"revocation_reason,omitempty"`
 }
 
-func (x *MsgRemoveVerificationMethod) Reset() {
-	*x = MsgRemoveVerificationMethod{}
+func (x *MsgRevokeVerifiableCredential) Reset() {
+	*x = MsgRevokeVerifiableCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[10]
+		mi := &file_did_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveVerificationMethod) String() string {
+func (x *MsgRevokeVerifiableCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveVerificationMethod) ProtoMessage() {}
+func (*MsgRevokeVerifiableCredential) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveVerificationMethod.ProtoReflect.Descriptor instead.
-func (*MsgRemoveVerificationMethod) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgRevokeVerifiableCredential.ProtoReflect.Descriptor instead.
+func (*MsgRevokeVerifiableCredential) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgRemoveVerificationMethod) GetController() string {
+func (x *MsgRevokeVerifiableCredential) GetIssuer() string {
 	if x != nil {
-		return x.Controller
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *MsgRemoveVerificationMethod) GetDid() string {
+func (x *MsgRevokeVerifiableCredential) GetCredentialId() string {
 	if x != nil {
-		return x.Did
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *MsgRemoveVerificationMethod) GetVerificationMethodId() string {
+func (x *MsgRevokeVerifiableCredential) GetRevocationReason() string {
 	if x != nil {
-		return x.VerificationMethodId
+		return x.RevocationReason
 	}
 	return ""
 }
 
-// MsgRemoveVerificationMethodResponse defines the response for
-// MsgRemoveVerificationMethod
-type MsgRemoveVerificationMethodResponse struct {
+// MsgRevokeVerifiableCredentialResponse defines the response for
+// MsgRevokeVerifiableCredential
+type MsgRevokeVerifiableCredentialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRemoveVerificationMethodResponse) Reset() {
-	*x = MsgRemoveVerificationMethodResponse{}
+func (x *MsgRevokeVerifiableCredentialResponse) Reset() {
+	*x = MsgRevokeVerifiableCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[11]
+		mi := &file_did_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveVerificationMethodResponse) String() string {
+func (x *MsgRevokeVerifiableCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveVerificationMethodResponse) ProtoMessage() {}
+func (*MsgRevokeVerifiableCredentialResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveVerificationMethodResponse.ProtoReflect.Descriptor instead.
-func (*MsgRemoveVerificationMethodResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgRevokeVerifiableCredentialResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokeVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
-// MsgAddService adds a service endpoint to a DID document
-type MsgAddService struct {
+// MsgLinkExternalWallet links an external wallet to a DID as an assertion method
+type MsgLinkExternalWallet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address adding the service
+	// controller is the address that controls the DID
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to add the service to
+	// did is the DID to link the wallet to
 	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// service is the service to add
-	Service *Service `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	// wallet_address is the external wallet address
+	WalletAddress string `protobuf:"bytes,3,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// chain_id identifies the blockchain (e.g., "1" for Ethereum mainnet, "cosmoshub-4")
+	WalletChainId string `protobuf:"bytes,4,opt,name=wallet_chain_id,json=walletChainId,proto3" json:"wallet_chain_id,omitempty"`
+	// wallet_type specifies the wallet type ("ethereum", "cosmos")
+	WalletType string `protobuf:"bytes,5,opt,name=wallet_type,json=walletType,proto3" json:"wallet_type,omitempty"`
+	// ownership_proof is the signature proving ownership of the wallet
+	OwnershipProof []byte `protobuf:"bytes,6,opt,name=ownership_proof,json=ownershipProof,proto3" json:"ownership_proof,omitempty"`
+	// challenge is the message that was signed to create the ownership_proof
+	Challenge []byte `protobuf:"bytes,7,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// verification_method_id is the ID for the new verification method
+	VerificationMethodId string `protobuf:"bytes,8,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 }
 
-func (x *MsgAddService) Reset() {
-	*x = MsgAddService{}
+func (x *MsgLinkExternalWallet) Reset() {
+	*x = MsgLinkExternalWallet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[12]
+		mi := &file_did_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddService) String() string {
+func (x *MsgLinkExternalWallet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddService) ProtoMessage() {}
+func (*MsgLinkExternalWallet) ProtoMessage() {}
 
-// Deprecated: Use MsgAddService.ProtoReflect.Descriptor instead.
-func (*MsgAddService) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgLinkExternalWallet.ProtoReflect.Descriptor instead.
+func (*MsgLinkExternalWallet) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgAddService) GetController() string {
+func (x *MsgLinkExternalWallet) GetController() string {
 	if x != nil {
 		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgAddService) GetDid() string {
+func (x *MsgLinkExternalWallet) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *MsgAddService) GetService() *Service {
+func (x *MsgLinkExternalWallet) GetWalletAddress() string {
 	if x != nil {
-		return x.Service
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *MsgLinkExternalWallet) GetWalletChainId() string {
+	if x != nil {
+		return x.WalletChainId
+	}
+	return ""
+}
+
+func (x *MsgLinkExternalWallet) GetWalletType() string {
+	if x != nil {
+		return x.WalletType
+	}
+	return ""
+}
+
+func (x *MsgLinkExternalWallet) GetOwnershipProof() []byte {
+	if x != nil {
+		return x.OwnershipProof
 	}
 	return nil
 }
 
-// MsgAddServiceResponse defines the response for MsgAddService
-type MsgAddServiceResponse struct {
+func (x *MsgLinkExternalWallet) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *MsgLinkExternalWallet) GetVerificationMethodId() string {
+	if x != nil {
+		return x.VerificationMethodId
+	}
+	return ""
+}
+
+// MsgLinkExternalWalletResponse defines the response for MsgLinkExternalWallet
+type MsgLinkExternalWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// verification_method_id is the ID of the created verification method
+	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 }
 
-func (x *MsgAddServiceResponse) Reset() {
-	*x = MsgAddServiceResponse{}
+func (x *MsgLinkExternalWalletResponse) Reset() {
+	*x = MsgLinkExternalWalletResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[13]
+		mi := &file_did_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddServiceResponse) String() string {
+func (x *MsgLinkExternalWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddServiceResponse) ProtoMessage() {}
+func (*MsgLinkExternalWalletResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddServiceResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddServiceResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgLinkExternalWalletResponse.ProtoReflect.Descriptor instead.
+func (*MsgLinkExternalWalletResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-// MsgRemoveService removes a service endpoint from a DID document
-type MsgRemoveService struct {
+func (x *MsgLinkExternalWalletResponse) GetVerificationMethodId() string {
+	if x != nil {
+		return x.VerificationMethodId
+	}
+	return ""
+}
+
+// MsgAddDelegatedController delegates control of a DID to another address
+// until expires_at. Adding a delegation the address already holds replaces
+// its expiry and purpose.
+type MsgAddDelegatedController struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// controller is the address removing the service
+	// controller is the address that controls the DID
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to remove the service from
+	// did is the DID being delegated
 	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// service_id is the ID of the service to remove
-	ServiceId string `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// delegate is the address receiving control
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// expires_at is when the delegation ends, in unix seconds
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// purpose describes what the delegation is for
+	Purpose string `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`
 }
 
-func (x *MsgRemoveService) Reset() {
-	*x = MsgRemoveService{}
+func (x *MsgAddDelegatedController) Reset() {
+	*x = MsgAddDelegatedController{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[14]
+		mi := &file_did_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveService) String() string {
+func (x *MsgAddDelegatedController) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveService) ProtoMessage() {}
+func (*MsgAddDelegatedController) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveService.ProtoReflect.Descriptor instead.
-func (*MsgRemoveService) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgAddDelegatedController.ProtoReflect.Descriptor instead.
+func (*MsgAddDelegatedController) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgRemoveService) GetController() string {
+func (x *MsgAddDelegatedController) GetController() string {
 	if x != nil {
 		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgRemoveService) GetDid() string {
+func (x *MsgAddDelegatedController) GetDid() string {
 	if x != nil {
 		return x.Did
 	}
 	return ""
 }
 
-func (x *MsgRemoveService) GetServiceId() string {
+func (x *MsgAddDelegatedController) GetDelegate() string {
 	if x != nil {
-		return x.ServiceId
+		return x.Delegate
 	}
 	return ""
 }
 
-// MsgRemoveServiceResponse defines the response for MsgRemoveService
-type MsgRemoveServiceResponse struct {
+func (x *MsgAddDelegatedController) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *MsgAddDelegatedController) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+// MsgAddDelegatedControllerResponse defines the response for
+// MsgAddDelegatedController
+type MsgAddDelegatedControllerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRemoveServiceResponse) Reset() {
-	*x = MsgRemoveServiceResponse{}
+func (x *MsgAddDelegatedControllerResponse) Reset() {
+	*x = MsgAddDelegatedControllerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[15]
+		mi := &file_did_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveServiceResponse) String() string {
+func (x *MsgAddDelegatedControllerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveServiceResponse) ProtoMessage() {}
+func (*MsgAddDelegatedControllerResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveServiceResponse.ProtoReflect.Descriptor instead.
-func (*MsgRemoveServiceResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MsgAddDelegatedControllerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddDelegatedControllerResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
-// MsgIssueVerifiableCredential issues a new verifiable credential
-type MsgIssueVerifiableCredential struct {
+// MsgRemoveDelegatedController ends a delegation before it expires. A
+// delegate may remove its own delegation.
+type MsgRemoveDelegatedController struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// issuer is the address issuing the credential
-	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// credential is the verifiable credential to issue
-	Credential *VerifiableCredential `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	// controller is the address that controls the DID, or the delegate
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the delegated DID
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// delegate is the address whose delegation ends
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
 
-func (x *MsgIssueVerifiableCredential) Reset() {
-	*x = MsgIssueVerifiableCredential{}
+func (x *MsgRemoveDelegatedController) Reset() {
+	*x = MsgRemoveDelegatedController{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[16]
+		mi := &file_did_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgIssueVerifiableCredential) String() string {
+func (x *MsgRemoveDelegatedController) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgIssueVerifiableCredential) ProtoMessage() {}
+func (*MsgRemoveDelegatedController) ProtoMessage() {}
 
-// Deprecated: Use MsgIssueVerifiableCredential.ProtoReflect.Descriptor instead.
-func (*MsgIssueVerifiableCredential) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MsgRemoveDelegatedController.ProtoReflect.Descriptor instead.
+func (*MsgRemoveDelegatedController) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgIssueVerifiableCredential) GetIssuer() string {
+func (x *MsgRemoveDelegatedController) GetController() string {
 	if x != nil {
-		return x.Issuer
+		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgIssueVerifiableCredential) GetCredential() *VerifiableCredential {
+func (x *MsgRemoveDelegatedController) GetDid() string {
 	if x != nil {
-		return x.Credential
+		return x.Did
 	}
-	return nil
+	return ""
 }
 
-// MsgIssueVerifiableCredentialResponse defines the response for
-// MsgIssueVerifiableCredential
-type MsgIssueVerifiableCredentialResponse struct {
+func (x *MsgRemoveDelegatedController) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+// MsgRemoveDelegatedControllerResponse defines the response for
+// MsgRemoveDelegatedController
+type MsgRemoveDelegatedControllerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// credential_id is the ID of the issued credential
-	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 }
 
-func (x *MsgIssueVerifiableCredentialResponse) Reset() {
-	*x = MsgIssueVerifiableCredentialResponse{}
+func (x *MsgRemoveDelegatedControllerResponse) Reset() {
+	*x = MsgRemoveDelegatedControllerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[17]
+		mi := &file_did_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgIssueVerifiableCredentialResponse) String() string {
+func (x *MsgRemoveDelegatedControllerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgIssueVerifiableCredentialResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgIssueVerifiableCredentialResponse.ProtoReflect.Descriptor instead.
-func (*MsgIssueVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{17}
-}
+func (*MsgRemoveDelegatedControllerResponse) ProtoMessage() {}
 
-func (x *MsgIssueVerifiableCredentialResponse) GetCredentialId() string {
-	if x != nil {
-		return x.CredentialId
-	}
-	return ""
+// Deprecated: Use MsgRemoveDelegatedControllerResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveDelegatedControllerResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
-// MsgRevokeVerifiableCredential revokes a verifiable credential
-type MsgRevokeVerifiableCredential struct {
+// MsgAttachNFT shows an x/nft token on a DID's profile. The signing
+// controller must own the token.
+type MsgAttachNFT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// issuer is the address revoking the credential
-	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// credential_id is the ID of the credential to revoke
-	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
-	// revocation_reason is the reason for revocation
-	RevocationReason string `protobuf:"bytes,3,opt,name=revocation_reason,json=revocationReason,proto3" json:"revocation_reason,omitempty"`
+	// controller is the address that controls the DID and owns the token
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID whose profile shows the token
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// class_id is the token's x/nft class
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the token's ID within its class
+	NftId string `protobuf:"bytes,4,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (x *MsgRevokeVerifiableCredential) Reset() {
-	*x = MsgRevokeVerifiableCredential{}
+func (x *MsgAttachNFT) Reset() {
+	*x = MsgAttachNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[18]
+		mi := &file_did_v1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokeVerifiableCredential) String() string {
+func (x *MsgAttachNFT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokeVerifiableCredential) ProtoMessage() {}
+func (*MsgAttachNFT) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokeVerifiableCredential.ProtoReflect.Descriptor instead.
-func (*MsgRevokeVerifiableCredential) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MsgAttachNFT.ProtoReflect.Descriptor instead.
+func (*MsgAttachNFT) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgRevokeVerifiableCredential) GetIssuer() string {
+func (x *MsgAttachNFT) GetController() string {
 	if x != nil {
-		return x.Issuer
+		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgRevokeVerifiableCredential) GetCredentialId() string {
+func (x *MsgAttachNFT) GetDid() string {
 	if x != nil {
-		return x.CredentialId
+		return x.Did
 	}
 	return ""
 }
 
-func (x *MsgRevokeVerifiableCredential) GetRevocationReason() string {
+func (x *MsgAttachNFT) GetClassId() string {
 	if x != nil {
-		return x.RevocationReason
+		return x.ClassId
 	}
 	return ""
 }
 
-// MsgRevokeVerifiableCredentialResponse defines the response for
-// MsgRevokeVerifiableCredential
-type MsgRevokeVerifiableCredentialResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *MsgRevokeVerifiableCredentialResponse) Reset() {
-	*x = MsgRevokeVerifiableCredentialResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MsgAttachNFT) GetNftId() string {
+	if x != nil {
+		return x.NftId
 	}
+	return ""
 }
 
-func (x *MsgRevokeVerifiableCredentialResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgRevokeVerifiableCredentialResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgRevokeVerifiableCredentialResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevokeVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{19}
-}
-
-// MsgLinkExternalWallet links an external wallet to a DID as an assertion method
-type MsgLinkExternalWallet struct {
+// MsgAttachNFTResponse defines the response for MsgAttachNFT
+type MsgAttachNFTResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// controller is the address that controls the DID
-	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// did is the DID to link the wallet to
-	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// wallet_address is the external wallet address
-	WalletAddress string `protobuf:"bytes,3,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	// chain_id identifies the blockchain (e.g., "1" for Ethereum mainnet, "cosmoshub-4")
-	WalletChainId string `protobuf:"bytes,4,opt,name=wallet_chain_id,json=walletChainId,proto3" json:"wallet_chain_id,omitempty"`
-	// wallet_type specifies the wallet type ("ethereum", "cosmos")
-	WalletType string `protobuf:"bytes,5,opt,name=wallet_type,json=walletType,proto3" json:"wallet_type,omitempty"`
-	// ownership_proof is the signature proving ownership of the wallet
-	OwnershipProof []byte `protobuf:"bytes,6,opt,name=ownership_proof,json=ownershipProof,proto3" json:"ownership_proof,omitempty"`
-	// challenge is the message that was signed to create the ownership_proof
-	Challenge []byte `protobuf:"bytes,7,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	// verification_method_id is the ID for the new verification method
-	VerificationMethodId string `protobuf:"bytes,8,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+
+	// dwn_record_id is the DWN record caching the token's metadata, empty when
+	// it could not be cached
+	DwnRecordId string `protobuf:"bytes,1,opt,name=dwn_record_id,json=dwnRecordId,proto3" json:"dwn_record_id,omitempty"`
 }
 
-func (x *MsgLinkExternalWallet) Reset() {
-	*x = MsgLinkExternalWallet{}
+func (x *MsgAttachNFTResponse) Reset() {
+	*x = MsgAttachNFTResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[20]
+		mi := &file_did_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgLinkExternalWallet) String() string {
+func (x *MsgAttachNFTResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgLinkExternalWallet) ProtoMessage() {}
+func (*MsgAttachNFTResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgLinkExternalWallet.ProtoReflect.Descriptor instead.
-func (*MsgLinkExternalWallet) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgAttachNFTResponse.ProtoReflect.Descriptor instead.
+func (*MsgAttachNFTResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MsgLinkExternalWallet) GetController() string {
+func (x *MsgAttachNFTResponse) GetDwnRecordId() string {
 	if x != nil {
-		return x.Controller
+		return x.DwnRecordId
 	}
 	return ""
 }
 
-func (x *MsgLinkExternalWallet) GetDid() string {
-	if x != nil {
-		return x.Did
-	}
-	return ""
+// MsgDetachNFT removes a token from a DID's profile
+type MsgDetachNFT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// controller is the address that controls the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID whose profile shows the token
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// class_id is the token's x/nft class
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the token's ID within its class
+	NftId string `protobuf:"bytes,4,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (x *MsgLinkExternalWallet) GetWalletAddress() string {
-	if x != nil {
-		return x.WalletAddress
+func (x *MsgDetachNFT) Reset() {
+	*x = MsgDetachNFT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_did_v1_tx_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgLinkExternalWallet) GetWalletChainId() string {
-	if x != nil {
-		return x.WalletChainId
-	}
-	return ""
+func (x *MsgDetachNFT) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgLinkExternalWallet) GetWalletType() string {
+func (*MsgDetachNFT) ProtoMessage() {}
+
+// Deprecated: Use MsgDetachNFT.ProtoReflect.Descriptor instead.
+func (*MsgDetachNFT) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MsgDetachNFT) GetController() string {
 	if x != nil {
-		return x.WalletType
+		return x.Controller
 	}
 	return ""
 }
 
-func (x *MsgLinkExternalWallet) GetOwnershipProof() []byte {
+func (x *MsgDetachNFT) GetDid() string {
 	if x != nil {
-		return x.OwnershipProof
+		return x.Did
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgLinkExternalWallet) GetChallenge() []byte {
+func (x *MsgDetachNFT) GetClassId() string {
 	if x != nil {
-		return x.Challenge
+		return x.ClassId
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgLinkExternalWallet) GetVerificationMethodId() string {
+func (x *MsgDetachNFT) GetNftId() string {
 	if x != nil {
-		return x.VerificationMethodId
+		return x.NftId
 	}
 	return ""
 }
 
-// MsgLinkExternalWalletResponse defines the response for MsgLinkExternalWallet
-type MsgLinkExternalWalletResponse struct {
+// MsgDetachNFTResponse defines the response for MsgDetachNFT
+type MsgDetachNFTResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// verification_method_id is the ID of the created verification method
-	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 }
 
-func (x *MsgLinkExternalWalletResponse) Reset() {
-	*x = MsgLinkExternalWalletResponse{}
+func (x *MsgDetachNFTResponse) Reset() {
+	*x = MsgDetachNFTResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[21]
+		mi := &file_did_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgLinkExternalWalletResponse) String() string {
+func (x *MsgDetachNFTResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgLinkExternalWalletResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgLinkExternalWalletResponse.ProtoReflect.Descriptor instead.
-func (*MsgLinkExternalWalletResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{21}
-}
+func (*MsgDetachNFTResponse) ProtoMessage() {}
 
-func (x *MsgLinkExternalWalletResponse) GetVerificationMethodId() string {
-	if x != nil {
-		return x.VerificationMethodId
-	}
-	return ""
+// Deprecated: Use MsgDetachNFTResponse.ProtoReflect.Descriptor instead.
+func (*MsgDetachNFTResponse) Descriptor() ([]byte, []int) {
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 // MsgRegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
@@ -13003,12 +18354,14 @@ type MsgRegisterWebAuthnCredential struct {
 	VerificationMethodId string `protobuf:"bytes,4,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 	// auto_create_vault indicates whether to automatically create a vault
 	AutoCreateVault bool `protobuf:"varint,5,opt,name=auto_create_vault,json=autoCreateVault,proto3" json:"auto_create_vault,omitempty"`
+	// referral_code is the optional invite code the DID was referred with
+	ReferralCode string `protobuf:"bytes,6,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
 }
 
 func (x *MsgRegisterWebAuthnCredential) Reset() {
 	*x = MsgRegisterWebAuthnCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[22]
+		mi := &file_did_v1_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13022,7 +18375,7 @@ func (*MsgRegisterWebAuthnCredential) ProtoMessage() {}
 
 // Deprecated: Use MsgRegisterWebAuthnCredential.ProtoReflect.Descriptor instead.
 func (*MsgRegisterWebAuthnCredential) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{22}
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *MsgRegisterWebAuthnCredential) GetController() string {
@@ -13060,6 +18413,13 @@ func (x *MsgRegisterWebAuthnCredential) GetAutoCreateVault() bool {
 	return false
 }
 
+func (x *MsgRegisterWebAuthnCredential) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
 // MsgRegisterWebAuthnCredentialResponse defines the response for MsgRegisterWebAuthnCredential
 type MsgRegisterWebAuthnCredentialResponse struct {
 	state         protoimpl.MessageState
@@ -13081,7 +18441,7 @@ type MsgRegisterWebAuthnCredentialResponse struct {
 func (x *MsgRegisterWebAuthnCredentialResponse) Reset() {
 	*x = MsgRegisterWebAuthnCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_did_v1_tx_proto_msgTypes[23]
+		mi := &file_did_v1_tx_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13095,7 +18455,7 @@ func (*MsgRegisterWebAuthnCredentialResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgRegisterWebAuthnCredentialResponse.ProtoReflect.Descriptor instead.
 func (*MsgRegisterWebAuthnCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_did_v1_tx_proto_rawDescGZIP(), []int{23}
+	return file_did_v1_tx_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *MsgRegisterWebAuthnCredentialResponse) GetDid() string {
@@ -13156,7 +18516,7 @@ var file_did_v1_tx_proto_rawDesc = []byte{
 	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0,
 	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d,
 	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
 	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
@@ -13164,258 +18524,354 @@ var file_did_v1_tx_proto_rawDesc = []byte{
 	0x72, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
+	0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x49, 0x44, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde,
 	0x1f, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x3a,
 	0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x49,
-	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x22,
-	0xa9, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44,
+	0x22, 0x16, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x44,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0,
+	0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x23,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
+	0x64, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a,
+	0x10, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7,
+	0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x1a, 0x0a,
+	0x18, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x1c, 0x4d, 0x73,
+	0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x4b, 0x0a,
+	0x24, 0x4d, 0x73, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x1d, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x27, 0x0a,
+	0x25, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x4c, 0x69,
+	0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
 	0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a,
 	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0c,
-	0x64, 0x69, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x49, 0x44, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64,
-	0x69, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x69, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xf0, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
-	0x70, 0x73, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x23, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12,
-	0x2f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x1d, 0x4d, 0x73,
+	0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49,
+	0x64, 0x22, 0xe7, 0x01, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
 	0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63,
 	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x3a, 0x0b,
-	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x24, 0x4d,
-	0x73, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x1d, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49,
-	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
-	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x0b,
-	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x27, 0x0a, 0x25, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x4c, 0x69, 0x6e, 0x6b,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x38,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x4c,
-	0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x22,
-	0xbb, 0x02, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57,
-	0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x23, 0x0a, 0x21, 0x4d,
+	0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xb1, 0x01, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x77, 0x65, 0x62, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
-	0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64,
-	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x75, 0x74,
-	0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x0f, 0x82, 0xe7,
-	0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0xd3, 0x01,
-	0x0a, 0x25, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62,
-	0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x49, 0x64, 0x32, 0xbe, 0x08, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x48, 0x0a, 0x0c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x17, 0x2e, 0x64, 0x69,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x49, 0x44, 0x12, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x44, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x44, 0x65, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x34, 0x0a,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a,
+	0x0c, 0x4d, 0x73, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x12, 0x38, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0,
+	0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x14,
+	0x4d, 0x73, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x77, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xe0, 0x02, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x77, 0x65, 0x62, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x75,
+	0x74, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x3a, 0x0f, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x32, 0xe6, 0x0b, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x12, 0x48, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x17, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x1a, 0x1c,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x1a,
+	0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0d, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x18,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x1a, 0x20, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
 	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44,
-	0x49, 0x44, 0x1a, 0x20, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44,
-	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x2e,
-	0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a,
-	0x28, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x18, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x2b, 0x2e, 0x64, 0x69, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1d, 0x2e, 0x64,
-	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x64,
-	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x20, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x19, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x2c, 0x2e, 0x64, 0x69,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x64, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x44, 0x49, 0x44, 0x1a, 0x20, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x20, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x1a, 0x28, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41,
+	0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x18,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x2b, 0x2e,
+	0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a,
+	0x1d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x18, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x20, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x19, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x2c,
+	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1a,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x64, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72,
 	0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1a, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x2d,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
-	0x12, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c,
-	0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1a, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x41,
-	0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x2d,
-	0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x6c, 0x1a, 0x2d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x12, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x16,
+	0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x1a, 0x29, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e,
+	0x46, 0x54, 0x12, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x1a, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x4e, 0x46, 0x54, 0x12, 0x14, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x1a, 0x1c, 0x2e, 0x64, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x46, 0x54, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73,
 	0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80,
-	0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x78, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e,
-	0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69,
-	0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76,
-	0x31, 0x3b, 0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06,
-	0x44, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x12, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74,
+	0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x2d, 0x2e, 0x64,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0,
+	0x2a, 0x01, 0x42, 0x78, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f,
+	0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x3b,
+	0x64, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x69,
+	0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12,
+	0x44, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -13430,7 +18886,7 @@ func file_did_v1_tx_proto_rawDescGZIP() []byte {
 	return file_did_v1_tx_proto_rawDescData
 }
 
-var file_did_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_did_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_did_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParams)(nil),                       // 0: did.v1.MsgUpdateParams
 	(*MsgUpdateParamsResponse)(nil),               // 1: did.v1.MsgUpdateParamsResponse
@@ -13440,63 +18896,83 @@ var file_did_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateDIDResponse)(nil),                  // 5: did.v1.MsgUpdateDIDResponse
 	(*MsgDeactivateDID)(nil),                      // 6: did.v1.MsgDeactivateDID
 	(*MsgDeactivateDIDResponse)(nil),              // 7: did.v1.MsgDeactivateDIDResponse
-	(*MsgAddVerificationMethod)(nil),              // 8: did.v1.MsgAddVerificationMethod
-	(*MsgAddVerificationMethodResponse)(nil),      // 9: did.v1.MsgAddVerificationMethodResponse
-	(*MsgRemoveVerificationMethod)(nil),           // 10: did.v1.MsgRemoveVerificationMethod
-	(*MsgRemoveVerificationMethodResponse)(nil),   // 11: did.v1.MsgRemoveVerificationMethodResponse
-	(*MsgAddService)(nil),                         // 12: did.v1.MsgAddService
-	(*MsgAddServiceResponse)(nil),                 // 13: did.v1.MsgAddServiceResponse
-	(*MsgRemoveService)(nil),                      // 14: did.v1.MsgRemoveService
-	(*MsgRemoveServiceResponse)(nil),              // 15: did.v1.MsgRemoveServiceResponse
-	(*MsgIssueVerifiableCredential)(nil),          // 16: did.v1.MsgIssueVerifiableCredential
-	(*MsgIssueVerifiableCredentialResponse)(nil),  // 17: did.v1.MsgIssueVerifiableCredentialResponse
-	(*MsgRevokeVerifiableCredential)(nil),         // 18: did.v1.MsgRevokeVerifiableCredential
-	(*MsgRevokeVerifiableCredentialResponse)(nil), // 19: did.v1.MsgRevokeVerifiableCredentialResponse
-	(*MsgLinkExternalWallet)(nil),                 // 20: did.v1.MsgLinkExternalWallet
-	(*MsgLinkExternalWalletResponse)(nil),         // 21: did.v1.MsgLinkExternalWalletResponse
-	(*MsgRegisterWebAuthnCredential)(nil),         // 22: did.v1.MsgRegisterWebAuthnCredential
-	(*MsgRegisterWebAuthnCredentialResponse)(nil), // 23: did.v1.MsgRegisterWebAuthnCredentialResponse
-	(*Params)(nil),                                // 24: did.v1.Params
-	(*DIDDocument)(nil),                           // 25: did.v1.DIDDocument
-	(*VerificationMethod)(nil),                    // 26: did.v1.VerificationMethod
-	(*Service)(nil),                               // 27: did.v1.Service
-	(*VerifiableCredential)(nil),                  // 28: did.v1.VerifiableCredential
-	(*WebAuthnCredential)(nil),                    // 29: did.v1.WebAuthnCredential
+	(*MsgReactivateDID)(nil),                      // 8: did.v1.MsgReactivateDID
+	(*MsgReactivateDIDResponse)(nil),              // 9: did.v1.MsgReactivateDIDResponse
+	(*MsgAddVerificationMethod)(nil),              // 10: did.v1.MsgAddVerificationMethod
+	(*MsgAddVerificationMethodResponse)(nil),      // 11: did.v1.MsgAddVerificationMethodResponse
+	(*MsgRemoveVerificationMethod)(nil),           // 12: did.v1.MsgRemoveVerificationMethod
+	(*MsgRemoveVerificationMethodResponse)(nil),   // 13: did.v1.MsgRemoveVerificationMethodResponse
+	(*MsgAddService)(nil),                         // 14: did.v1.MsgAddService
+	(*MsgAddServiceResponse)(nil),                 // 15: did.v1.MsgAddServiceResponse
+	(*MsgRemoveService)(nil),                      // 16: did.v1.MsgRemoveService
+	(*MsgRemoveServiceResponse)(nil),              // 17: did.v1.MsgRemoveServiceResponse
+	(*MsgIssueVerifiableCredential)(nil),          // 18: did.v1.MsgIssueVerifiableCredential
+	(*MsgIssueVerifiableCredentialResponse)(nil),  // 19: did.v1.MsgIssueVerifiableCredentialResponse
+	(*MsgRevokeVerifiableCredential)(nil),         // 20: did.v1.MsgRevokeVerifiableCredential
+	(*MsgRevokeVerifiableCredentialResponse)(nil), // 21: did.v1.MsgRevokeVerifiableCredentialResponse
+	(*MsgLinkExternalWallet)(nil),                 // 22: did.v1.MsgLinkExternalWallet
+	(*MsgLinkExternalWalletResponse)(nil),         // 23: did.v1.MsgLinkExternalWalletResponse
+	(*MsgAddDelegatedController)(nil),             // 24: did.v1.MsgAddDelegatedController
+	(*MsgAddDelegatedControllerResponse)(nil),     // 25: did.v1.MsgAddDelegatedControllerResponse
+	(*MsgRemoveDelegatedController)(nil),          // 26: did.v1.MsgRemoveDelegatedController
+	(*MsgRemoveDelegatedControllerResponse)(nil),  // 27: did.v1.MsgRemoveDelegatedControllerResponse
+	(*MsgAttachNFT)(nil),                          // 28: did.v1.MsgAttachNFT
+	(*MsgAttachNFTResponse)(nil),                  // 29: did.v1.MsgAttachNFTResponse
+	(*MsgDetachNFT)(nil),                          // 30: did.v1.MsgDetachNFT
+	(*MsgDetachNFTResponse)(nil),                  // 31: did.v1.MsgDetachNFTResponse
+	(*MsgRegisterWebAuthnCredential)(nil),         // 32: did.v1.MsgRegisterWebAuthnCredential
+	(*MsgRegisterWebAuthnCredentialResponse)(nil), // 33: did.v1.MsgRegisterWebAuthnCredentialResponse
+	(*Params)(nil),                                // 34: did.v1.Params
+	(*DIDDocument)(nil),                           // 35: did.v1.DIDDocument
+	(*VerificationMethod)(nil),                    // 36: did.v1.VerificationMethod
+	(*Service)(nil),                               // 37: did.v1.Service
+	(*VerifiableCredential)(nil),                  // 38: did.v1.VerifiableCredential
+	(*WebAuthnCredential)(nil),                    // 39: did.v1.WebAuthnCredential
 }
 var file_did_v1_tx_proto_depIdxs = []int32{
-	24, // 0: did.v1.MsgUpdateParams.params:type_name -> did.v1.Params
-	25, // 1: did.v1.MsgCreateDID.did_document:type_name -> did.v1.DIDDocument
-	25, // 2: did.v1.MsgUpdateDID.did_document:type_name -> did.v1.DIDDocument
-	26, // 3: did.v1.MsgAddVerificationMethod.verification_method:type_name -> did.v1.VerificationMethod
-	27, // 4: did.v1.MsgAddService.service:type_name -> did.v1.Service
-	28, // 5: did.v1.MsgIssueVerifiableCredential.credential:type_name -> did.v1.VerifiableCredential
-	29, // 6: did.v1.MsgRegisterWebAuthnCredential.webauthn_credential:type_name -> did.v1.WebAuthnCredential
+	34, // 0: did.v1.MsgUpdateParams.params:type_name -> did.v1.Params
+	35, // 1: did.v1.MsgCreateDID.did_document:type_name -> did.v1.DIDDocument
+	35, // 2: did.v1.MsgUpdateDID.did_document:type_name -> did.v1.DIDDocument
+	36, // 3: did.v1.MsgAddVerificationMethod.verification_method:type_name -> did.v1.VerificationMethod
+	37, // 4: did.v1.MsgAddService.service:type_name -> did.v1.Service
+	38, // 5: did.v1.MsgIssueVerifiableCredential.credential:type_name -> did.v1.VerifiableCredential
+	39, // 6: did.v1.MsgRegisterWebAuthnCredential.webauthn_credential:type_name -> did.v1.WebAuthnCredential
 	0,  // 7: did.v1.Msg.UpdateParams:input_type -> did.v1.MsgUpdateParams
 	2,  // 8: did.v1.Msg.CreateDID:input_type -> did.v1.MsgCreateDID
 	4,  // 9: did.v1.Msg.UpdateDID:input_type -> did.v1.MsgUpdateDID
 	6,  // 10: did.v1.Msg.DeactivateDID:input_type -> did.v1.MsgDeactivateDID
-	8,  // 11: did.v1.Msg.AddVerificationMethod:input_type -> did.v1.MsgAddVerificationMethod
-	10, // 12: did.v1.Msg.RemoveVerificationMethod:input_type -> did.v1.MsgRemoveVerificationMethod
-	12, // 13: did.v1.Msg.AddService:input_type -> did.v1.MsgAddService
-	14, // 14: did.v1.Msg.RemoveService:input_type -> did.v1.MsgRemoveService
-	16, // 15: did.v1.Msg.IssueVerifiableCredential:input_type -> did.v1.MsgIssueVerifiableCredential
-	18, // 16: did.v1.Msg.RevokeVerifiableCredential:input_type -> did.v1.MsgRevokeVerifiableCredential
-	20, // 17: did.v1.Msg.LinkExternalWallet:input_type -> did.v1.MsgLinkExternalWallet
-	22, // 18: did.v1.Msg.RegisterWebAuthnCredential:input_type -> did.v1.MsgRegisterWebAuthnCredential
-	1,  // 19: did.v1.Msg.UpdateParams:output_type -> did.v1.MsgUpdateParamsResponse
-	3,  // 20: did.v1.Msg.CreateDID:output_type -> did.v1.MsgCreateDIDResponse
-	5,  // 21: did.v1.Msg.UpdateDID:output_type -> did.v1.MsgUpdateDIDResponse
-	7,  // 22: did.v1.Msg.DeactivateDID:output_type -> did.v1.MsgDeactivateDIDResponse
-	9,  // 23: did.v1.Msg.AddVerificationMethod:output_type -> did.v1.MsgAddVerificationMethodResponse
-	11, // 24: did.v1.Msg.RemoveVerificationMethod:output_type -> did.v1.MsgRemoveVerificationMethodResponse
-	13, // 25: did.v1.Msg.AddService:output_type -> did.v1.MsgAddServiceResponse
-	15, // 26: did.v1.Msg.RemoveService:output_type -> did.v1.MsgRemoveServiceResponse
-	17, // 27: did.v1.Msg.IssueVerifiableCredential:output_type -> did.v1.MsgIssueVerifiableCredentialResponse
-	19, // 28: did.v1.Msg.RevokeVerifiableCredential:output_type -> did.v1.MsgRevokeVerifiableCredentialResponse
-	21, // 29: did.v1.Msg.LinkExternalWallet:output_type -> did.v1.MsgLinkExternalWalletResponse
-	23, // 30: did.v1.Msg.RegisterWebAuthnCredential:output_type -> did.v1.MsgRegisterWebAuthnCredentialResponse
-	19, // [19:31] is the sub-list for method output_type
-	7,  // [7:19] is the sub-list for method input_type
+	8,  // 11: did.v1.Msg.ReactivateDID:input_type -> did.v1.MsgReactivateDID
+	10, // 12: did.v1.Msg.AddVerificationMethod:input_type -> did.v1.MsgAddVerificationMethod
+	12, // 13: did.v1.Msg.RemoveVerificationMethod:input_type -> did.v1.MsgRemoveVerificationMethod
+	14, // 14: did.v1.Msg.AddService:input_type -> did.v1.MsgAddService
+	16, // 15: did.v1.Msg.RemoveService:input_type -> did.v1.MsgRemoveService
+	18, // 16: did.v1.Msg.IssueVerifiableCredential:input_type -> did.v1.MsgIssueVerifiableCredential
+	20, // 17: did.v1.Msg.RevokeVerifiableCredential:input_type -> did.v1.MsgRevokeVerifiableCredential
+	22, // 18: did.v1.Msg.LinkExternalWallet:input_type -> did.v1.MsgLinkExternalWallet
+	24, // 19: did.v1.Msg.AddDelegatedController:input_type -> did.v1.MsgAddDelegatedController
+	26, // 20: did.v1.Msg.RemoveDelegatedController:input_type -> did.v1.MsgRemoveDelegatedController
+	28, // 21: did.v1.Msg.AttachNFT:input_type -> did.v1.MsgAttachNFT
+	30, // 22: did.v1.Msg.DetachNFT:input_type -> did.v1.MsgDetachNFT
+	32, // 23: did.v1.Msg.RegisterWebAuthnCredential:input_type -> did.v1.MsgRegisterWebAuthnCredential
+	1,  // 24: did.v1.Msg.UpdateParams:output_type -> did.v1.MsgUpdateParamsResponse
+	3,  // 25: did.v1.Msg.CreateDID:output_type -> did.v1.MsgCreateDIDResponse
+	5,  // 26: did.v1.Msg.UpdateDID:output_type -> did.v1.MsgUpdateDIDResponse
+	7,  // 27: did.v1.Msg.DeactivateDID:output_type -> did.v1.MsgDeactivateDIDResponse
+	9,  // 28: did.v1.Msg.ReactivateDID:output_type -> did.v1.MsgReactivateDIDResponse
+	11, // 29: did.v1.Msg.AddVerificationMethod:output_type -> did.v1.MsgAddVerificationMethodResponse
+	13, // 30: did.v1.Msg.RemoveVerificationMethod:output_type -> did.v1.MsgRemoveVerificationMethodResponse
+	15, // 31: did.v1.Msg.AddService:output_type -> did.v1.MsgAddServiceResponse
+	17, // 32: did.v1.Msg.RemoveService:output_type -> did.v1.MsgRemoveServiceResponse
+	19, // 33: did.v1.Msg.IssueVerifiableCredential:output_type -> did.v1.MsgIssueVerifiableCredentialResponse
+	21, // 34: did.v1.Msg.RevokeVerifiableCredential:output_type -> did.v1.MsgRevokeVerifiableCredentialResponse
+	23, // 35: did.v1.Msg.LinkExternalWallet:output_type -> did.v1.MsgLinkExternalWalletResponse
+	25, // 36: did.v1.Msg.AddDelegatedController:output_type -> did.v1.MsgAddDelegatedControllerResponse
+	27, // 37: did.v1.Msg.RemoveDelegatedController:output_type -> did.v1.MsgRemoveDelegatedControllerResponse
+	29, // 38: did.v1.Msg.AttachNFT:output_type -> did.v1.MsgAttachNFTResponse
+	31, // 39: did.v1.Msg.DetachNFT:output_type -> did.v1.MsgDetachNFTResponse
+	33, // 40: did.v1.Msg.RegisterWebAuthnCredential:output_type -> did.v1.MsgRegisterWebAuthnCredentialResponse
+	24, // [24:41] is the sub-list for method output_type
+	7,  // [7:24] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -13608,7 +19084,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddVerificationMethod); i {
+			switch v := v.(*MsgReactivateDID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13620,7 +19096,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddVerificationMethodResponse); i {
+			switch v := v.(*MsgReactivateDIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13632,7 +19108,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveVerificationMethod); i {
+			switch v := v.(*MsgAddVerificationMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13644,7 +19120,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveVerificationMethodResponse); i {
+			switch v := v.(*MsgAddVerificationMethodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13656,7 +19132,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddService); i {
+			switch v := v.(*MsgRemoveVerificationMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13668,7 +19144,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddServiceResponse); i {
+			switch v := v.(*MsgRemoveVerificationMethodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13680,7 +19156,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveService); i {
+			switch v := v.(*MsgAddService); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13692,7 +19168,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveServiceResponse); i {
+			switch v := v.(*MsgAddServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13704,7 +19180,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgIssueVerifiableCredential); i {
+			switch v := v.(*MsgRemoveService); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13716,7 +19192,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgIssueVerifiableCredentialResponse); i {
+			switch v := v.(*MsgRemoveServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13728,7 +19204,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevokeVerifiableCredential); i {
+			switch v := v.(*MsgIssueVerifiableCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13740,7 +19216,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevokeVerifiableCredentialResponse); i {
+			switch v := v.(*MsgIssueVerifiableCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13752,7 +19228,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgLinkExternalWallet); i {
+			switch v := v.(*MsgRevokeVerifiableCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13764,7 +19240,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgLinkExternalWalletResponse); i {
+			switch v := v.(*MsgRevokeVerifiableCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13776,7 +19252,7 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRegisterWebAuthnCredential); i {
+			switch v := v.(*MsgLinkExternalWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13788,6 +19264,126 @@ func file_did_v1_tx_proto_init() {
 			}
 		}
 		file_did_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLinkExternalWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAddDelegatedController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAddDelegatedControllerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveDelegatedController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveDelegatedControllerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAttachNFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAttachNFTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDetachNFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDetachNFTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterWebAuthnCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_did_v1_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgRegisterWebAuthnCredentialResponse); i {
 			case 0:
 				return &v.state
@@ -13806,7 +19402,7 @@ func file_did_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_did_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
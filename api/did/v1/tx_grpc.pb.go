@@ -23,6 +23,7 @@ const (
 	Msg_CreateDID_FullMethodName                  = "/did.v1.Msg/CreateDID"
 	Msg_UpdateDID_FullMethodName                  = "/did.v1.Msg/UpdateDID"
 	Msg_DeactivateDID_FullMethodName              = "/did.v1.Msg/DeactivateDID"
+	Msg_ReactivateDID_FullMethodName              = "/did.v1.Msg/ReactivateDID"
 	Msg_AddVerificationMethod_FullMethodName      = "/did.v1.Msg/AddVerificationMethod"
 	Msg_RemoveVerificationMethod_FullMethodName   = "/did.v1.Msg/RemoveVerificationMethod"
 	Msg_AddService_FullMethodName                 = "/did.v1.Msg/AddService"
@@ -30,6 +31,10 @@ const (
 	Msg_IssueVerifiableCredential_FullMethodName  = "/did.v1.Msg/IssueVerifiableCredential"
 	Msg_RevokeVerifiableCredential_FullMethodName = "/did.v1.Msg/RevokeVerifiableCredential"
 	Msg_LinkExternalWallet_FullMethodName         = "/did.v1.Msg/LinkExternalWallet"
+	Msg_AddDelegatedController_FullMethodName     = "/did.v1.Msg/AddDelegatedController"
+	Msg_RemoveDelegatedController_FullMethodName  = "/did.v1.Msg/RemoveDelegatedController"
+	Msg_AttachNFT_FullMethodName                  = "/did.v1.Msg/AttachNFT"
+	Msg_DetachNFT_FullMethodName                  = "/did.v1.Msg/DetachNFT"
 	Msg_RegisterWebAuthnCredential_FullMethodName = "/did.v1.Msg/RegisterWebAuthnCredential"
 )
 
@@ -57,6 +62,8 @@ type MsgClient interface {
 	UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error)
 	// DeactivateDID deactivates a DID document
 	DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error)
+	// ReactivateDID restores a deactivated DID document
+	ReactivateDID(ctx context.Context, in *MsgReactivateDID, opts ...grpc.CallOption) (*MsgReactivateDIDResponse, error)
 	// AddVerificationMethod adds a new verification method to a DID document
 	AddVerificationMethod(ctx context.Context, in *MsgAddVerificationMethod, opts ...grpc.CallOption) (*MsgAddVerificationMethodResponse, error)
 	// RemoveVerificationMethod removes a verification method from a DID document
@@ -71,6 +78,15 @@ type MsgClient interface {
 	RevokeVerifiableCredential(ctx context.Context, in *MsgRevokeVerifiableCredential, opts ...grpc.CallOption) (*MsgRevokeVerifiableCredentialResponse, error)
 	// LinkExternalWallet links an external wallet as an assertion method
 	LinkExternalWallet(ctx context.Context, in *MsgLinkExternalWallet, opts ...grpc.CallOption) (*MsgLinkExternalWalletResponse, error)
+	// AddDelegatedController delegates control of a DID to another address
+	// until an expiry time
+	AddDelegatedController(ctx context.Context, in *MsgAddDelegatedController, opts ...grpc.CallOption) (*MsgAddDelegatedControllerResponse, error)
+	// RemoveDelegatedController ends a delegation before it expires
+	RemoveDelegatedController(ctx context.Context, in *MsgRemoveDelegatedController, opts ...grpc.CallOption) (*MsgRemoveDelegatedControllerResponse, error)
+	// AttachNFT shows an x/nft token the DID's controller owns on its profile
+	AttachNFT(ctx context.Context, in *MsgAttachNFT, opts ...grpc.CallOption) (*MsgAttachNFTResponse, error)
+	// DetachNFT removes a token from the DID's profile
+	DetachNFT(ctx context.Context, in *MsgDetachNFT, opts ...grpc.CallOption) (*MsgDetachNFTResponse, error)
 	// RegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
 	//
 	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
@@ -124,6 +140,15 @@ func (c *msgClient) DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opt
 	return out, nil
 }
 
+func (c *msgClient) ReactivateDID(ctx context.Context, in *MsgReactivateDID, opts ...grpc.CallOption) (*MsgReactivateDIDResponse, error) {
+	out := new(MsgReactivateDIDResponse)
+	err := c.cc.Invoke(ctx, Msg_ReactivateDID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddVerificationMethod(ctx context.Context, in *MsgAddVerificationMethod, opts ...grpc.CallOption) (*MsgAddVerificationMethodResponse, error) {
 	out := new(MsgAddVerificationMethodResponse)
 	err := c.cc.Invoke(ctx, Msg_AddVerificationMethod_FullMethodName, in, out, opts...)
@@ -187,6 +212,42 @@ func (c *msgClient) LinkExternalWallet(ctx context.Context, in *MsgLinkExternalW
 	return out, nil
 }
 
+func (c *msgClient) AddDelegatedController(ctx context.Context, in *MsgAddDelegatedController, opts ...grpc.CallOption) (*MsgAddDelegatedControllerResponse, error) {
+	out := new(MsgAddDelegatedControllerResponse)
+	err := c.cc.Invoke(ctx, Msg_AddDelegatedController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDelegatedController(ctx context.Context, in *MsgRemoveDelegatedController, opts ...grpc.CallOption) (*MsgRemoveDelegatedControllerResponse, error) {
+	out := new(MsgRemoveDelegatedControllerResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDelegatedController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttachNFT(ctx context.Context, in *MsgAttachNFT, opts ...grpc.CallOption) (*MsgAttachNFTResponse, error) {
+	out := new(MsgAttachNFTResponse)
+	err := c.cc.Invoke(ctx, Msg_AttachNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DetachNFT(ctx context.Context, in *MsgDetachNFT, opts ...grpc.CallOption) (*MsgDetachNFTResponse, error) {
+	out := new(MsgDetachNFTResponse)
+	err := c.cc.Invoke(ctx, Msg_DetachNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RegisterWebAuthnCredential(ctx context.Context, in *MsgRegisterWebAuthnCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthnCredentialResponse, error) {
 	out := new(MsgRegisterWebAuthnCredentialResponse)
 	err := c.cc.Invoke(ctx, Msg_RegisterWebAuthnCredential_FullMethodName, in, out, opts...)
@@ -220,6 +281,8 @@ type MsgServer interface {
 	UpdateDID(context.Context, *MsgUpdateDID) (*MsgUpdateDIDResponse, error)
 	// DeactivateDID deactivates a DID document
 	DeactivateDID(context.Context, *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error)
+	// ReactivateDID restores a deactivated DID document
+	ReactivateDID(context.Context, *MsgReactivateDID) (*MsgReactivateDIDResponse, error)
 	// AddVerificationMethod adds a new verification method to a DID document
 	AddVerificationMethod(context.Context, *MsgAddVerificationMethod) (*MsgAddVerificationMethodResponse, error)
 	// RemoveVerificationMethod removes a verification method from a DID document
@@ -234,6 +297,15 @@ type MsgServer interface {
 	RevokeVerifiableCredential(context.Context, *MsgRevokeVerifiableCredential) (*MsgRevokeVerifiableCredentialResponse, error)
 	// LinkExternalWallet links an external wallet as an assertion method
 	LinkExternalWallet(context.Context, *MsgLinkExternalWallet) (*MsgLinkExternalWalletResponse, error)
+	// AddDelegatedController delegates control of a DID to another address
+	// until an expiry time
+	AddDelegatedController(context.Context, *MsgAddDelegatedController) (*MsgAddDelegatedControllerResponse, error)
+	// RemoveDelegatedController ends a delegation before it expires
+	RemoveDelegatedController(context.Context, *MsgRemoveDelegatedController) (*MsgRemoveDelegatedControllerResponse, error)
+	// AttachNFT shows an x/nft token the DID's controller owns on its profile
+	AttachNFT(context.Context, *MsgAttachNFT) (*MsgAttachNFTResponse, error)
+	// DetachNFT removes a token from the DID's profile
+	DetachNFT(context.Context, *MsgDetachNFT) (*MsgDetachNFTResponse, error)
 	// RegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
 	//
 	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
@@ -260,6 +332,9 @@ func (UnimplementedMsgServer) UpdateDID(context.Context, *MsgUpdateDID) (*MsgUpd
 func (UnimplementedMsgServer) DeactivateDID(context.Context, *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDID not implemented")
 }
+func (UnimplementedMsgServer) ReactivateDID(context.Context, *MsgReactivateDID) (*MsgReactivateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateDID not implemented")
+}
 func (UnimplementedMsgServer) AddVerificationMethod(context.Context, *MsgAddVerificationMethod) (*MsgAddVerificationMethodResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddVerificationMethod not implemented")
 }
@@ -281,6 +356,18 @@ func (UnimplementedMsgServer) RevokeVerifiableCredential(context.Context, *MsgRe
 func (UnimplementedMsgServer) LinkExternalWallet(context.Context, *MsgLinkExternalWallet) (*MsgLinkExternalWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalWallet not implemented")
 }
+func (UnimplementedMsgServer) AddDelegatedController(context.Context, *MsgAddDelegatedController) (*MsgAddDelegatedControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDelegatedController not implemented")
+}
+func (UnimplementedMsgServer) RemoveDelegatedController(context.Context, *MsgRemoveDelegatedController) (*MsgRemoveDelegatedControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegatedController not implemented")
+}
+func (UnimplementedMsgServer) AttachNFT(context.Context, *MsgAttachNFT) (*MsgAttachNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachNFT not implemented")
+}
+func (UnimplementedMsgServer) DetachNFT(context.Context, *MsgDetachNFT) (*MsgDetachNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachNFT not implemented")
+}
 func (UnimplementedMsgServer) RegisterWebAuthnCredential(context.Context, *MsgRegisterWebAuthnCredential) (*MsgRegisterWebAuthnCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebAuthnCredential not implemented")
 }
@@ -369,6 +456,24 @@ func _Msg_DeactivateDID_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReactivateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReactivateDID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReactivateDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReactivateDID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReactivateDID(ctx, req.(*MsgReactivateDID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddVerificationMethod)
 	if err := dec(in); err != nil {
@@ -495,6 +600,78 @@ func _Msg_LinkExternalWallet_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddDelegatedController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDelegatedController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDelegatedController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddDelegatedController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDelegatedController(ctx, req.(*MsgAddDelegatedController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDelegatedController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDelegatedController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDelegatedController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDelegatedController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDelegatedController(ctx, req.(*MsgRemoveDelegatedController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttachNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttachNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttachNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttachNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttachNFT(ctx, req.(*MsgAttachNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DetachNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDetachNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DetachNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DetachNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DetachNFT(ctx, req.(*MsgDetachNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RegisterWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegisterWebAuthnCredential)
 	if err := dec(in); err != nil {
@@ -536,6 +713,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateDID",
 			Handler:    _Msg_DeactivateDID_Handler,
 		},
+		{
+			MethodName: "ReactivateDID",
+			Handler:    _Msg_ReactivateDID_Handler,
+		},
 		{
 			MethodName: "AddVerificationMethod",
 			Handler:    _Msg_AddVerificationMethod_Handler,
@@ -564,6 +745,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LinkExternalWallet",
 			Handler:    _Msg_LinkExternalWallet_Handler,
 		},
+		{
+			MethodName: "AddDelegatedController",
+			Handler:    _Msg_AddDelegatedController_Handler,
+		},
+		{
+			MethodName: "RemoveDelegatedController",
+			Handler:    _Msg_RemoveDelegatedController_Handler,
+		},
+		{
+			MethodName: "AttachNFT",
+			Handler:    _Msg_AttachNFT_Handler,
+		},
+		{
+			MethodName: "DetachNFT",
+			Handler:    _Msg_DetachNFT_Handler,
+		},
 		{
 			MethodName: "RegisterWebAuthnCredential",
 			Handler:    _Msg_RegisterWebAuthnCredential_Handler,
@@ -14,16 +14,64 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_EventRecordWritten_10_list)(nil)
+
+type _EventRecordWritten_10_list struct {
+	list *[]string
+}
+
+func (x *_EventRecordWritten_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventRecordWritten_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventRecordWritten_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventRecordWritten_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventRecordWritten_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventRecordWritten at list field ReleasedCids as it is not of Message kind"))
+}
+
+func (x *_EventRecordWritten_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventRecordWritten_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventRecordWritten_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EventRecordWritten              protoreflect.MessageDescriptor
-	fd_EventRecordWritten_record_id    protoreflect.FieldDescriptor
-	fd_EventRecordWritten_target       protoreflect.FieldDescriptor
-	fd_EventRecordWritten_protocol     protoreflect.FieldDescriptor
-	fd_EventRecordWritten_schema       protoreflect.FieldDescriptor
-	fd_EventRecordWritten_data_cid     protoreflect.FieldDescriptor
-	fd_EventRecordWritten_data_size    protoreflect.FieldDescriptor
-	fd_EventRecordWritten_encrypted    protoreflect.FieldDescriptor
-	fd_EventRecordWritten_block_height protoreflect.FieldDescriptor
+	md_EventRecordWritten               protoreflect.MessageDescriptor
+	fd_EventRecordWritten_record_id     protoreflect.FieldDescriptor
+	fd_EventRecordWritten_target        protoreflect.FieldDescriptor
+	fd_EventRecordWritten_protocol      protoreflect.FieldDescriptor
+	fd_EventRecordWritten_schema        protoreflect.FieldDescriptor
+	fd_EventRecordWritten_data_cid      protoreflect.FieldDescriptor
+	fd_EventRecordWritten_data_size     protoreflect.FieldDescriptor
+	fd_EventRecordWritten_encrypted     protoreflect.FieldDescriptor
+	fd_EventRecordWritten_block_height  protoreflect.FieldDescriptor
+	fd_EventRecordWritten_expires_at    protoreflect.FieldDescriptor
+	fd_EventRecordWritten_released_cids protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +85,8 @@ func init() {
 	fd_EventRecordWritten_data_size = md_EventRecordWritten.Fields().ByName("data_size")
 	fd_EventRecordWritten_encrypted = md_EventRecordWritten.Fields().ByName("encrypted")
 	fd_EventRecordWritten_block_height = md_EventRecordWritten.Fields().ByName("block_height")
+	fd_EventRecordWritten_expires_at = md_EventRecordWritten.Fields().ByName("expires_at")
+	fd_EventRecordWritten_released_cids = md_EventRecordWritten.Fields().ByName("released_cids")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventRecordWritten)(nil)
@@ -152,6 +202,18 @@ func (x *fastReflection_EventRecordWritten) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_EventRecordWritten_expires_at, value) {
+			return
+		}
+	}
+	if len(x.ReleasedCids) != 0 {
+		value := protoreflect.ValueOfList(&_EventRecordWritten_10_list{list: &x.ReleasedCids})
+		if !f(fd_EventRecordWritten_released_cids, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +245,10 @@ func (x *fastReflection_EventRecordWritten) Has(fd protoreflect.FieldDescriptor)
 		return x.Encrypted != false
 	case "dwn.v1.EventRecordWritten.block_height":
 		return x.BlockHeight != uint64(0)
+	case "dwn.v1.EventRecordWritten.expires_at":
+		return x.ExpiresAt != int64(0)
+	case "dwn.v1.EventRecordWritten.released_cids":
+		return len(x.ReleasedCids) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordWritten"))
@@ -215,6 +281,10 @@ func (x *fastReflection_EventRecordWritten) Clear(fd protoreflect.FieldDescripto
 		x.Encrypted = false
 	case "dwn.v1.EventRecordWritten.block_height":
 		x.BlockHeight = uint64(0)
+	case "dwn.v1.EventRecordWritten.expires_at":
+		x.ExpiresAt = int64(0)
+	case "dwn.v1.EventRecordWritten.released_cids":
+		x.ReleasedCids = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordWritten"))
@@ -255,6 +325,15 @@ func (x *fastReflection_EventRecordWritten) Get(descriptor protoreflect.FieldDes
 	case "dwn.v1.EventRecordWritten.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.EventRecordWritten.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	case "dwn.v1.EventRecordWritten.released_cids":
+		if len(x.ReleasedCids) == 0 {
+			return protoreflect.ValueOfList(&_EventRecordWritten_10_list{})
+		}
+		listValue := &_EventRecordWritten_10_list{list: &x.ReleasedCids}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordWritten"))
@@ -291,6 +370,12 @@ func (x *fastReflection_EventRecordWritten) Set(fd protoreflect.FieldDescriptor,
 		x.Encrypted = value.Bool()
 	case "dwn.v1.EventRecordWritten.block_height":
 		x.BlockHeight = value.Uint()
+	case "dwn.v1.EventRecordWritten.expires_at":
+		x.ExpiresAt = value.Int()
+	case "dwn.v1.EventRecordWritten.released_cids":
+		lv := value.List()
+		clv := lv.(*_EventRecordWritten_10_list)
+		x.ReleasedCids = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordWritten"))
@@ -311,6 +396,12 @@ func (x *fastReflection_EventRecordWritten) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EventRecordWritten) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "dwn.v1.EventRecordWritten.released_cids":
+		if x.ReleasedCids == nil {
+			x.ReleasedCids = []string{}
+		}
+		value := &_EventRecordWritten_10_list{list: &x.ReleasedCids}
+		return protoreflect.ValueOfList(value)
 	case "dwn.v1.EventRecordWritten.record_id":
 		panic(fmt.Errorf("field record_id of message dwn.v1.EventRecordWritten is not mutable"))
 	case "dwn.v1.EventRecordWritten.target":
@@ -327,6 +418,8 @@ func (x *fastReflection_EventRecordWritten) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field encrypted of message dwn.v1.EventRecordWritten is not mutable"))
 	case "dwn.v1.EventRecordWritten.block_height":
 		panic(fmt.Errorf("field block_height of message dwn.v1.EventRecordWritten is not mutable"))
+	case "dwn.v1.EventRecordWritten.expires_at":
+		panic(fmt.Errorf("field expires_at of message dwn.v1.EventRecordWritten is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordWritten"))
@@ -356,6 +449,11 @@ func (x *fastReflection_EventRecordWritten) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfBool(false)
 	case "dwn.v1.EventRecordWritten.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.EventRecordWritten.expires_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dwn.v1.EventRecordWritten.released_cids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventRecordWritten_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordWritten"))
@@ -454,6 +552,15 @@ func (x *fastReflection_EventRecordWritten) ProtoMethods() *protoiface.Methods {
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		if len(x.ReleasedCids) > 0 {
+			for _, s := range x.ReleasedCids {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -483,6 +590,20 @@ func (x *fastReflection_EventRecordWritten) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReleasedCids) > 0 {
+			for iNdEx := len(x.ReleasedCids) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ReleasedCids[iNdEx])
+				copy(dAtA[i:], x.ReleasedCids[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReleasedCids[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
+			i--
+			dAtA[i] = 0x48
+		}
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
@@ -805,6 +926,57 @@ func (x *fastReflection_EventRecordWritten) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				x.ExpiresAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedCids", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleasedCids = append(x.ReleasedCids, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -840,12 +1012,59 @@ func (x *fastReflection_EventRecordWritten) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_EventRecordDeleted_5_list)(nil)
+
+type _EventRecordDeleted_5_list struct {
+	list *[]string
+}
+
+func (x *_EventRecordDeleted_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventRecordDeleted_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventRecordDeleted_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventRecordDeleted_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventRecordDeleted_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventRecordDeleted at list field ReleasedCids as it is not of Message kind"))
+}
+
+func (x *_EventRecordDeleted_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventRecordDeleted_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventRecordDeleted_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EventRecordDeleted              protoreflect.MessageDescriptor
-	fd_EventRecordDeleted_record_id    protoreflect.FieldDescriptor
-	fd_EventRecordDeleted_target       protoreflect.FieldDescriptor
-	fd_EventRecordDeleted_deleter      protoreflect.FieldDescriptor
-	fd_EventRecordDeleted_block_height protoreflect.FieldDescriptor
+	md_EventRecordDeleted               protoreflect.MessageDescriptor
+	fd_EventRecordDeleted_record_id     protoreflect.FieldDescriptor
+	fd_EventRecordDeleted_target        protoreflect.FieldDescriptor
+	fd_EventRecordDeleted_deleter       protoreflect.FieldDescriptor
+	fd_EventRecordDeleted_block_height  protoreflect.FieldDescriptor
+	fd_EventRecordDeleted_released_cids protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -855,6 +1074,7 @@ func init() {
 	fd_EventRecordDeleted_target = md_EventRecordDeleted.Fields().ByName("target")
 	fd_EventRecordDeleted_deleter = md_EventRecordDeleted.Fields().ByName("deleter")
 	fd_EventRecordDeleted_block_height = md_EventRecordDeleted.Fields().ByName("block_height")
+	fd_EventRecordDeleted_released_cids = md_EventRecordDeleted.Fields().ByName("released_cids")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventRecordDeleted)(nil)
@@ -946,6 +1166,12 @@ func (x *fastReflection_EventRecordDeleted) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if len(x.ReleasedCids) != 0 {
+		value := protoreflect.ValueOfList(&_EventRecordDeleted_5_list{list: &x.ReleasedCids})
+		if !f(fd_EventRecordDeleted_released_cids, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -969,6 +1195,8 @@ func (x *fastReflection_EventRecordDeleted) Has(fd protoreflect.FieldDescriptor)
 		return x.Deleter != ""
 	case "dwn.v1.EventRecordDeleted.block_height":
 		return x.BlockHeight != uint64(0)
+	case "dwn.v1.EventRecordDeleted.released_cids":
+		return len(x.ReleasedCids) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordDeleted"))
@@ -993,6 +1221,8 @@ func (x *fastReflection_EventRecordDeleted) Clear(fd protoreflect.FieldDescripto
 		x.Deleter = ""
 	case "dwn.v1.EventRecordDeleted.block_height":
 		x.BlockHeight = uint64(0)
+	case "dwn.v1.EventRecordDeleted.released_cids":
+		x.ReleasedCids = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordDeleted"))
@@ -1021,6 +1251,12 @@ func (x *fastReflection_EventRecordDeleted) Get(descriptor protoreflect.FieldDes
 	case "dwn.v1.EventRecordDeleted.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.EventRecordDeleted.released_cids":
+		if len(x.ReleasedCids) == 0 {
+			return protoreflect.ValueOfList(&_EventRecordDeleted_5_list{})
+		}
+		listValue := &_EventRecordDeleted_5_list{list: &x.ReleasedCids}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordDeleted"))
@@ -1049,6 +1285,10 @@ func (x *fastReflection_EventRecordDeleted) Set(fd protoreflect.FieldDescriptor,
 		x.Deleter = value.Interface().(string)
 	case "dwn.v1.EventRecordDeleted.block_height":
 		x.BlockHeight = value.Uint()
+	case "dwn.v1.EventRecordDeleted.released_cids":
+		lv := value.List()
+		clv := lv.(*_EventRecordDeleted_5_list)
+		x.ReleasedCids = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordDeleted"))
@@ -1069,6 +1309,12 @@ func (x *fastReflection_EventRecordDeleted) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EventRecordDeleted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "dwn.v1.EventRecordDeleted.released_cids":
+		if x.ReleasedCids == nil {
+			x.ReleasedCids = []string{}
+		}
+		value := &_EventRecordDeleted_5_list{list: &x.ReleasedCids}
+		return protoreflect.ValueOfList(value)
 	case "dwn.v1.EventRecordDeleted.record_id":
 		panic(fmt.Errorf("field record_id of message dwn.v1.EventRecordDeleted is not mutable"))
 	case "dwn.v1.EventRecordDeleted.target":
@@ -1098,6 +1344,9 @@ func (x *fastReflection_EventRecordDeleted) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "dwn.v1.EventRecordDeleted.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.EventRecordDeleted.released_cids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventRecordDeleted_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordDeleted"))
@@ -1182,6 +1431,12 @@ func (x *fastReflection_EventRecordDeleted) ProtoMethods() *protoiface.Methods {
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
+		if len(x.ReleasedCids) > 0 {
+			for _, s := range x.ReleasedCids {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1211,6 +1466,15 @@ func (x *fastReflection_EventRecordDeleted) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReleasedCids) > 0 {
+			for iNdEx := len(x.ReleasedCids) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ReleasedCids[iNdEx])
+				copy(dAtA[i:], x.ReleasedCids[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReleasedCids[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
@@ -1401,6 +1665,38 @@ func (x *fastReflection_EventRecordDeleted) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedCids", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleasedCids = append(x.ReleasedCids, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1436,32 +1732,80 @@ func (x *fastReflection_EventRecordDeleted) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_EventRecordExpired_4_list)(nil)
+
+type _EventRecordExpired_4_list struct {
+	list *[]string
+}
+
+func (x *_EventRecordExpired_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventRecordExpired_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventRecordExpired_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventRecordExpired_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventRecordExpired_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventRecordExpired at list field ReleasedCids as it is not of Message kind"))
+}
+
+func (x *_EventRecordExpired_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventRecordExpired_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventRecordExpired_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EventProtocolConfigured              protoreflect.MessageDescriptor
-	fd_EventProtocolConfigured_target       protoreflect.FieldDescriptor
-	fd_EventProtocolConfigured_protocol_uri protoreflect.FieldDescriptor
-	fd_EventProtocolConfigured_published    protoreflect.FieldDescriptor
-	fd_EventProtocolConfigured_block_height protoreflect.FieldDescriptor
+	md_EventRecordExpired               protoreflect.MessageDescriptor
+	fd_EventRecordExpired_record_id     protoreflect.FieldDescriptor
+	fd_EventRecordExpired_target        protoreflect.FieldDescriptor
+	fd_EventRecordExpired_expires_at    protoreflect.FieldDescriptor
+	fd_EventRecordExpired_released_cids protoreflect.FieldDescriptor
+	fd_EventRecordExpired_block_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_events_proto_init()
-	md_EventProtocolConfigured = File_dwn_v1_events_proto.Messages().ByName("EventProtocolConfigured")
-	fd_EventProtocolConfigured_target = md_EventProtocolConfigured.Fields().ByName("target")
-	fd_EventProtocolConfigured_protocol_uri = md_EventProtocolConfigured.Fields().ByName("protocol_uri")
-	fd_EventProtocolConfigured_published = md_EventProtocolConfigured.Fields().ByName("published")
-	fd_EventProtocolConfigured_block_height = md_EventProtocolConfigured.Fields().ByName("block_height")
+	md_EventRecordExpired = File_dwn_v1_events_proto.Messages().ByName("EventRecordExpired")
+	fd_EventRecordExpired_record_id = md_EventRecordExpired.Fields().ByName("record_id")
+	fd_EventRecordExpired_target = md_EventRecordExpired.Fields().ByName("target")
+	fd_EventRecordExpired_expires_at = md_EventRecordExpired.Fields().ByName("expires_at")
+	fd_EventRecordExpired_released_cids = md_EventRecordExpired.Fields().ByName("released_cids")
+	fd_EventRecordExpired_block_height = md_EventRecordExpired.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventProtocolConfigured)(nil)
+var _ protoreflect.Message = (*fastReflection_EventRecordExpired)(nil)
 
-type fastReflection_EventProtocolConfigured EventProtocolConfigured
+type fastReflection_EventRecordExpired EventRecordExpired
 
-func (x *EventProtocolConfigured) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventProtocolConfigured)(x)
+func (x *EventRecordExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRecordExpired)(x)
 }
 
-func (x *EventProtocolConfigured) slowProtoReflect() protoreflect.Message {
+func (x *EventRecordExpired) slowProtoReflect() protoreflect.Message {
 	mi := &file_dwn_v1_events_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1473,43 +1817,43 @@ func (x *EventProtocolConfigured) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventProtocolConfigured_messageType fastReflection_EventProtocolConfigured_messageType
-var _ protoreflect.MessageType = fastReflection_EventProtocolConfigured_messageType{}
+var _fastReflection_EventRecordExpired_messageType fastReflection_EventRecordExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventRecordExpired_messageType{}
 
-type fastReflection_EventProtocolConfigured_messageType struct{}
+type fastReflection_EventRecordExpired_messageType struct{}
 
-func (x fastReflection_EventProtocolConfigured_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventProtocolConfigured)(nil)
+func (x fastReflection_EventRecordExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRecordExpired)(nil)
 }
-func (x fastReflection_EventProtocolConfigured_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventProtocolConfigured)
+func (x fastReflection_EventRecordExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRecordExpired)
 }
-func (x fastReflection_EventProtocolConfigured_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventProtocolConfigured
+func (x fastReflection_EventRecordExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRecordExpired
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventProtocolConfigured) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventProtocolConfigured
+func (x *fastReflection_EventRecordExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRecordExpired
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventProtocolConfigured) Type() protoreflect.MessageType {
-	return _fastReflection_EventProtocolConfigured_messageType
+func (x *fastReflection_EventRecordExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventRecordExpired_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventProtocolConfigured) New() protoreflect.Message {
-	return new(fastReflection_EventProtocolConfigured)
+func (x *fastReflection_EventRecordExpired) New() protoreflect.Message {
+	return new(fastReflection_EventRecordExpired)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventProtocolConfigured) Interface() protoreflect.ProtoMessage {
-	return (*EventProtocolConfigured)(x)
+func (x *fastReflection_EventRecordExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventRecordExpired)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1517,28 +1861,34 @@ func (x *fastReflection_EventProtocolConfigured) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventProtocolConfigured) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventRecordExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_EventRecordExpired_record_id, value) {
+			return
+		}
+	}
 	if x.Target != "" {
 		value := protoreflect.ValueOfString(x.Target)
-		if !f(fd_EventProtocolConfigured_target, value) {
+		if !f(fd_EventRecordExpired_target, value) {
 			return
 		}
 	}
-	if x.ProtocolUri != "" {
-		value := protoreflect.ValueOfString(x.ProtocolUri)
-		if !f(fd_EventProtocolConfigured_protocol_uri, value) {
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_EventRecordExpired_expires_at, value) {
 			return
 		}
 	}
-	if x.Published != false {
-		value := protoreflect.ValueOfBool(x.Published)
-		if !f(fd_EventProtocolConfigured_published, value) {
+	if len(x.ReleasedCids) != 0 {
+		value := protoreflect.ValueOfList(&_EventRecordExpired_4_list{list: &x.ReleasedCids})
+		if !f(fd_EventRecordExpired_released_cids, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventProtocolConfigured_block_height, value) {
+		if !f(fd_EventRecordExpired_block_height, value) {
 			return
 		}
 	}
@@ -1555,21 +1905,23 @@ func (x *fastReflection_EventProtocolConfigured) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventProtocolConfigured) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventRecordExpired) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.EventProtocolConfigured.target":
+	case "dwn.v1.EventRecordExpired.record_id":
+		return x.RecordId != ""
+	case "dwn.v1.EventRecordExpired.target":
 		return x.Target != ""
-	case "dwn.v1.EventProtocolConfigured.protocol_uri":
-		return x.ProtocolUri != ""
-	case "dwn.v1.EventProtocolConfigured.published":
-		return x.Published != false
-	case "dwn.v1.EventProtocolConfigured.block_height":
+	case "dwn.v1.EventRecordExpired.expires_at":
+		return x.ExpiresAt != int64(0)
+	case "dwn.v1.EventRecordExpired.released_cids":
+		return len(x.ReleasedCids) != 0
+	case "dwn.v1.EventRecordExpired.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordExpired"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventRecordExpired does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1579,21 +1931,23 @@ func (x *fastReflection_EventProtocolConfigured) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProtocolConfigured) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventRecordExpired) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.EventProtocolConfigured.target":
+	case "dwn.v1.EventRecordExpired.record_id":
+		x.RecordId = ""
+	case "dwn.v1.EventRecordExpired.target":
 		x.Target = ""
-	case "dwn.v1.EventProtocolConfigured.protocol_uri":
-		x.ProtocolUri = ""
-	case "dwn.v1.EventProtocolConfigured.published":
-		x.Published = false
-	case "dwn.v1.EventProtocolConfigured.block_height":
+	case "dwn.v1.EventRecordExpired.expires_at":
+		x.ExpiresAt = int64(0)
+	case "dwn.v1.EventRecordExpired.released_cids":
+		x.ReleasedCids = nil
+	case "dwn.v1.EventRecordExpired.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordExpired"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventRecordExpired does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1603,25 +1957,31 @@ func (x *fastReflection_EventProtocolConfigured) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventProtocolConfigured) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRecordExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.EventProtocolConfigured.target":
-		value := x.Target
+	case "dwn.v1.EventRecordExpired.record_id":
+		value := x.RecordId
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventProtocolConfigured.protocol_uri":
-		value := x.ProtocolUri
+	case "dwn.v1.EventRecordExpired.target":
+		value := x.Target
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventProtocolConfigured.published":
-		value := x.Published
-		return protoreflect.ValueOfBool(value)
-	case "dwn.v1.EventProtocolConfigured.block_height":
+	case "dwn.v1.EventRecordExpired.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	case "dwn.v1.EventRecordExpired.released_cids":
+		if len(x.ReleasedCids) == 0 {
+			return protoreflect.ValueOfList(&_EventRecordExpired_4_list{})
+		}
+		listValue := &_EventRecordExpired_4_list{list: &x.ReleasedCids}
+		return protoreflect.ValueOfList(listValue)
+	case "dwn.v1.EventRecordExpired.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordExpired"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventRecordExpired does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1635,21 +1995,25 @@ func (x *fastReflection_EventProtocolConfigured) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProtocolConfigured) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventRecordExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.EventProtocolConfigured.target":
+	case "dwn.v1.EventRecordExpired.record_id":
+		x.RecordId = value.Interface().(string)
+	case "dwn.v1.EventRecordExpired.target":
 		x.Target = value.Interface().(string)
-	case "dwn.v1.EventProtocolConfigured.protocol_uri":
-		x.ProtocolUri = value.Interface().(string)
-	case "dwn.v1.EventProtocolConfigured.published":
-		x.Published = value.Bool()
-	case "dwn.v1.EventProtocolConfigured.block_height":
+	case "dwn.v1.EventRecordExpired.expires_at":
+		x.ExpiresAt = value.Int()
+	case "dwn.v1.EventRecordExpired.released_cids":
+		lv := value.List()
+		clv := lv.(*_EventRecordExpired_4_list)
+		x.ReleasedCids = *clv.list
+	case "dwn.v1.EventRecordExpired.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordExpired"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventRecordExpired does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1663,52 +2027,61 @@ func (x *fastReflection_EventProtocolConfigured) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProtocolConfigured) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRecordExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventProtocolConfigured.target":
-		panic(fmt.Errorf("field target of message dwn.v1.EventProtocolConfigured is not mutable"))
-	case "dwn.v1.EventProtocolConfigured.protocol_uri":
-		panic(fmt.Errorf("field protocol_uri of message dwn.v1.EventProtocolConfigured is not mutable"))
-	case "dwn.v1.EventProtocolConfigured.published":
-		panic(fmt.Errorf("field published of message dwn.v1.EventProtocolConfigured is not mutable"))
-	case "dwn.v1.EventProtocolConfigured.block_height":
-		panic(fmt.Errorf("field block_height of message dwn.v1.EventProtocolConfigured is not mutable"))
+	case "dwn.v1.EventRecordExpired.released_cids":
+		if x.ReleasedCids == nil {
+			x.ReleasedCids = []string{}
+		}
+		value := &_EventRecordExpired_4_list{list: &x.ReleasedCids}
+		return protoreflect.ValueOfList(value)
+	case "dwn.v1.EventRecordExpired.record_id":
+		panic(fmt.Errorf("field record_id of message dwn.v1.EventRecordExpired is not mutable"))
+	case "dwn.v1.EventRecordExpired.target":
+		panic(fmt.Errorf("field target of message dwn.v1.EventRecordExpired is not mutable"))
+	case "dwn.v1.EventRecordExpired.expires_at":
+		panic(fmt.Errorf("field expires_at of message dwn.v1.EventRecordExpired is not mutable"))
+	case "dwn.v1.EventRecordExpired.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventRecordExpired is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordExpired"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventRecordExpired does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventProtocolConfigured) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRecordExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventProtocolConfigured.target":
+	case "dwn.v1.EventRecordExpired.record_id":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventProtocolConfigured.protocol_uri":
+	case "dwn.v1.EventRecordExpired.target":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventProtocolConfigured.published":
-		return protoreflect.ValueOfBool(false)
-	case "dwn.v1.EventProtocolConfigured.block_height":
+	case "dwn.v1.EventRecordExpired.expires_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dwn.v1.EventRecordExpired.released_cids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventRecordExpired_4_list{list: &list})
+	case "dwn.v1.EventRecordExpired.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventRecordExpired"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventRecordExpired does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventProtocolConfigured) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventRecordExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventProtocolConfigured", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventRecordExpired", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1716,7 +2089,7 @@ func (x *fastReflection_EventProtocolConfigured) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventProtocolConfigured) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventRecordExpired) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1727,7 +2100,7 @@ func (x *fastReflection_EventProtocolConfigured) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProtocolConfigured) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventRecordExpired) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1739,7 +2112,7 @@ func (x *fastReflection_EventProtocolConfigured) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventProtocolConfigured) IsValid() bool {
+func (x *fastReflection_EventRecordExpired) IsValid() bool {
 	return x != nil
 }
 
@@ -1749,9 +2122,9 @@ func (x *fastReflection_EventProtocolConfigured) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventRecordExpired) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventProtocolConfigured)
+		x := input.Message.Interface().(*EventRecordExpired)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1763,16 +2136,22 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Target)
+		l = len(x.RecordId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ProtocolUri)
+		l = len(x.Target)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Published {
-			n += 2
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		if len(x.ReleasedCids) > 0 {
+			for _, s := range x.ReleasedCids {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
@@ -1787,7 +2166,7 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventProtocolConfigured)
+		x := input.Message.Interface().(*EventRecordExpired)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1809,30 +2188,34 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x28
 		}
-		if x.Published {
-			i--
-			if x.Published {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.ReleasedCids) > 0 {
+			for iNdEx := len(x.ReleasedCids) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ReleasedCids[iNdEx])
+				copy(dAtA[i:], x.ReleasedCids[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReleasedCids[iNdEx])))
+				i--
+				dAtA[i] = 0x22
 			}
-			i--
-			dAtA[i] = 0x18
 		}
-		if len(x.ProtocolUri) > 0 {
-			i -= len(x.ProtocolUri)
-			copy(dAtA[i:], x.ProtocolUri)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolUri)))
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x18
 		}
 		if len(x.Target) > 0 {
 			i -= len(x.Target)
 			copy(dAtA[i:], x.Target)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -1846,7 +2229,7 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventProtocolConfigured)
+		x := input.Message.Interface().(*EventRecordExpired)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1878,15 +2261,15 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProtocolConfigured: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRecordExpired: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProtocolConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRecordExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1914,11 +2297,11 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Target = string(dAtA[iNdEx:postIndex])
+				x.RecordId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolUri", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1946,13 +2329,13 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProtocolUri = string(dAtA[iNdEx:postIndex])
+				x.Target = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Published", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 				}
-				var v int
+				x.ExpiresAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1962,13 +2345,44 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.ExpiresAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Published = bool(v != 0)
 			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedCids", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleasedCids = append(x.ReleasedCids, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -2023,37 +2437,31 @@ func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_EventPermissionGranted                protoreflect.MessageDescriptor
-	fd_EventPermissionGranted_permission_id  protoreflect.FieldDescriptor
-	fd_EventPermissionGranted_grantor        protoreflect.FieldDescriptor
-	fd_EventPermissionGranted_grantee        protoreflect.FieldDescriptor
-	fd_EventPermissionGranted_interface_name protoreflect.FieldDescriptor
-	fd_EventPermissionGranted_method         protoreflect.FieldDescriptor
-	fd_EventPermissionGranted_expires_at     protoreflect.FieldDescriptor
-	fd_EventPermissionGranted_block_height   protoreflect.FieldDescriptor
+	md_EventProtocolConfigured              protoreflect.MessageDescriptor
+	fd_EventProtocolConfigured_target       protoreflect.FieldDescriptor
+	fd_EventProtocolConfigured_protocol_uri protoreflect.FieldDescriptor
+	fd_EventProtocolConfigured_published    protoreflect.FieldDescriptor
+	fd_EventProtocolConfigured_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_events_proto_init()
-	md_EventPermissionGranted = File_dwn_v1_events_proto.Messages().ByName("EventPermissionGranted")
-	fd_EventPermissionGranted_permission_id = md_EventPermissionGranted.Fields().ByName("permission_id")
-	fd_EventPermissionGranted_grantor = md_EventPermissionGranted.Fields().ByName("grantor")
-	fd_EventPermissionGranted_grantee = md_EventPermissionGranted.Fields().ByName("grantee")
-	fd_EventPermissionGranted_interface_name = md_EventPermissionGranted.Fields().ByName("interface_name")
-	fd_EventPermissionGranted_method = md_EventPermissionGranted.Fields().ByName("method")
-	fd_EventPermissionGranted_expires_at = md_EventPermissionGranted.Fields().ByName("expires_at")
-	fd_EventPermissionGranted_block_height = md_EventPermissionGranted.Fields().ByName("block_height")
+	md_EventProtocolConfigured = File_dwn_v1_events_proto.Messages().ByName("EventProtocolConfigured")
+	fd_EventProtocolConfigured_target = md_EventProtocolConfigured.Fields().ByName("target")
+	fd_EventProtocolConfigured_protocol_uri = md_EventProtocolConfigured.Fields().ByName("protocol_uri")
+	fd_EventProtocolConfigured_published = md_EventProtocolConfigured.Fields().ByName("published")
+	fd_EventProtocolConfigured_block_height = md_EventProtocolConfigured.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventPermissionGranted)(nil)
+var _ protoreflect.Message = (*fastReflection_EventProtocolConfigured)(nil)
 
-type fastReflection_EventPermissionGranted EventPermissionGranted
+type fastReflection_EventProtocolConfigured EventProtocolConfigured
 
-func (x *EventPermissionGranted) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventPermissionGranted)(x)
+func (x *EventProtocolConfigured) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProtocolConfigured)(x)
 }
 
-func (x *EventPermissionGranted) slowProtoReflect() protoreflect.Message {
+func (x *EventProtocolConfigured) slowProtoReflect() protoreflect.Message {
 	mi := &file_dwn_v1_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2065,43 +2473,43 @@ func (x *EventPermissionGranted) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventPermissionGranted_messageType fastReflection_EventPermissionGranted_messageType
-var _ protoreflect.MessageType = fastReflection_EventPermissionGranted_messageType{}
+var _fastReflection_EventProtocolConfigured_messageType fastReflection_EventProtocolConfigured_messageType
+var _ protoreflect.MessageType = fastReflection_EventProtocolConfigured_messageType{}
 
-type fastReflection_EventPermissionGranted_messageType struct{}
+type fastReflection_EventProtocolConfigured_messageType struct{}
 
-func (x fastReflection_EventPermissionGranted_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventPermissionGranted)(nil)
+func (x fastReflection_EventProtocolConfigured_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProtocolConfigured)(nil)
 }
-func (x fastReflection_EventPermissionGranted_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventPermissionGranted)
+func (x fastReflection_EventProtocolConfigured_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProtocolConfigured)
 }
-func (x fastReflection_EventPermissionGranted_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventPermissionGranted
+func (x fastReflection_EventProtocolConfigured_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProtocolConfigured
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventPermissionGranted) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventPermissionGranted
+func (x *fastReflection_EventProtocolConfigured) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProtocolConfigured
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventPermissionGranted) Type() protoreflect.MessageType {
-	return _fastReflection_EventPermissionGranted_messageType
+func (x *fastReflection_EventProtocolConfigured) Type() protoreflect.MessageType {
+	return _fastReflection_EventProtocolConfigured_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventPermissionGranted) New() protoreflect.Message {
-	return new(fastReflection_EventPermissionGranted)
+func (x *fastReflection_EventProtocolConfigured) New() protoreflect.Message {
+	return new(fastReflection_EventProtocolConfigured)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventPermissionGranted) Interface() protoreflect.ProtoMessage {
-	return (*EventPermissionGranted)(x)
+func (x *fastReflection_EventProtocolConfigured) Interface() protoreflect.ProtoMessage {
+	return (*EventProtocolConfigured)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2109,46 +2517,28 @@ func (x *fastReflection_EventPermissionGranted) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventPermissionGranted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PermissionId != "" {
-		value := protoreflect.ValueOfString(x.PermissionId)
-		if !f(fd_EventPermissionGranted_permission_id, value) {
-			return
-		}
-	}
-	if x.Grantor != "" {
-		value := protoreflect.ValueOfString(x.Grantor)
-		if !f(fd_EventPermissionGranted_grantor, value) {
-			return
-		}
-	}
-	if x.Grantee != "" {
-		value := protoreflect.ValueOfString(x.Grantee)
-		if !f(fd_EventPermissionGranted_grantee, value) {
-			return
-		}
-	}
-	if x.InterfaceName != "" {
-		value := protoreflect.ValueOfString(x.InterfaceName)
-		if !f(fd_EventPermissionGranted_interface_name, value) {
+func (x *fastReflection_EventProtocolConfigured) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_EventProtocolConfigured_target, value) {
 			return
 		}
 	}
-	if x.Method != "" {
-		value := protoreflect.ValueOfString(x.Method)
-		if !f(fd_EventPermissionGranted_method, value) {
+	if x.ProtocolUri != "" {
+		value := protoreflect.ValueOfString(x.ProtocolUri)
+		if !f(fd_EventProtocolConfigured_protocol_uri, value) {
 			return
 		}
 	}
-	if x.ExpiresAt != nil {
-		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
-		if !f(fd_EventPermissionGranted_expires_at, value) {
+	if x.Published != false {
+		value := protoreflect.ValueOfBool(x.Published)
+		if !f(fd_EventProtocolConfigured_published, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventPermissionGranted_block_height, value) {
+		if !f(fd_EventProtocolConfigured_block_height, value) {
 			return
 		}
 	}
@@ -2165,27 +2555,21 @@ func (x *fastReflection_EventPermissionGranted) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventPermissionGranted) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventProtocolConfigured) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionGranted.permission_id":
-		return x.PermissionId != ""
-	case "dwn.v1.EventPermissionGranted.grantor":
-		return x.Grantor != ""
-	case "dwn.v1.EventPermissionGranted.grantee":
-		return x.Grantee != ""
-	case "dwn.v1.EventPermissionGranted.interface_name":
-		return x.InterfaceName != ""
-	case "dwn.v1.EventPermissionGranted.method":
-		return x.Method != ""
-	case "dwn.v1.EventPermissionGranted.expires_at":
-		return x.ExpiresAt != nil
-	case "dwn.v1.EventPermissionGranted.block_height":
+	case "dwn.v1.EventProtocolConfigured.target":
+		return x.Target != ""
+	case "dwn.v1.EventProtocolConfigured.protocol_uri":
+		return x.ProtocolUri != ""
+	case "dwn.v1.EventProtocolConfigured.published":
+		return x.Published != false
+	case "dwn.v1.EventProtocolConfigured.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2195,27 +2579,21 @@ func (x *fastReflection_EventPermissionGranted) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionGranted) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventProtocolConfigured) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionGranted.permission_id":
-		x.PermissionId = ""
-	case "dwn.v1.EventPermissionGranted.grantor":
-		x.Grantor = ""
-	case "dwn.v1.EventPermissionGranted.grantee":
-		x.Grantee = ""
-	case "dwn.v1.EventPermissionGranted.interface_name":
-		x.InterfaceName = ""
-	case "dwn.v1.EventPermissionGranted.method":
-		x.Method = ""
-	case "dwn.v1.EventPermissionGranted.expires_at":
-		x.ExpiresAt = nil
-	case "dwn.v1.EventPermissionGranted.block_height":
+	case "dwn.v1.EventProtocolConfigured.target":
+		x.Target = ""
+	case "dwn.v1.EventProtocolConfigured.protocol_uri":
+		x.ProtocolUri = ""
+	case "dwn.v1.EventProtocolConfigured.published":
+		x.Published = false
+	case "dwn.v1.EventProtocolConfigured.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2225,34 +2603,25 @@ func (x *fastReflection_EventPermissionGranted) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventPermissionGranted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventProtocolConfigured) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.EventPermissionGranted.permission_id":
-		value := x.PermissionId
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionGranted.grantor":
-		value := x.Grantor
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionGranted.grantee":
-		value := x.Grantee
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionGranted.interface_name":
-		value := x.InterfaceName
+	case "dwn.v1.EventProtocolConfigured.target":
+		value := x.Target
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionGranted.method":
-		value := x.Method
+	case "dwn.v1.EventProtocolConfigured.protocol_uri":
+		value := x.ProtocolUri
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionGranted.expires_at":
-		value := x.ExpiresAt
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "dwn.v1.EventPermissionGranted.block_height":
+	case "dwn.v1.EventProtocolConfigured.published":
+		value := x.Published
+		return protoreflect.ValueOfBool(value)
+	case "dwn.v1.EventProtocolConfigured.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2266,27 +2635,21 @@ func (x *fastReflection_EventPermissionGranted) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionGranted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventProtocolConfigured) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionGranted.permission_id":
-		x.PermissionId = value.Interface().(string)
-	case "dwn.v1.EventPermissionGranted.grantor":
-		x.Grantor = value.Interface().(string)
-	case "dwn.v1.EventPermissionGranted.grantee":
-		x.Grantee = value.Interface().(string)
-	case "dwn.v1.EventPermissionGranted.interface_name":
-		x.InterfaceName = value.Interface().(string)
-	case "dwn.v1.EventPermissionGranted.method":
-		x.Method = value.Interface().(string)
-	case "dwn.v1.EventPermissionGranted.expires_at":
-		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
-	case "dwn.v1.EventPermissionGranted.block_height":
+	case "dwn.v1.EventProtocolConfigured.target":
+		x.Target = value.Interface().(string)
+	case "dwn.v1.EventProtocolConfigured.protocol_uri":
+		x.ProtocolUri = value.Interface().(string)
+	case "dwn.v1.EventProtocolConfigured.published":
+		x.Published = value.Bool()
+	case "dwn.v1.EventProtocolConfigured.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2300,68 +2663,52 @@ func (x *fastReflection_EventPermissionGranted) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionGranted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventProtocolConfigured) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionGranted.expires_at":
-		if x.ExpiresAt == nil {
-			x.ExpiresAt = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
-	case "dwn.v1.EventPermissionGranted.permission_id":
-		panic(fmt.Errorf("field permission_id of message dwn.v1.EventPermissionGranted is not mutable"))
-	case "dwn.v1.EventPermissionGranted.grantor":
-		panic(fmt.Errorf("field grantor of message dwn.v1.EventPermissionGranted is not mutable"))
-	case "dwn.v1.EventPermissionGranted.grantee":
-		panic(fmt.Errorf("field grantee of message dwn.v1.EventPermissionGranted is not mutable"))
-	case "dwn.v1.EventPermissionGranted.interface_name":
-		panic(fmt.Errorf("field interface_name of message dwn.v1.EventPermissionGranted is not mutable"))
-	case "dwn.v1.EventPermissionGranted.method":
-		panic(fmt.Errorf("field method of message dwn.v1.EventPermissionGranted is not mutable"))
-	case "dwn.v1.EventPermissionGranted.block_height":
-		panic(fmt.Errorf("field block_height of message dwn.v1.EventPermissionGranted is not mutable"))
+	case "dwn.v1.EventProtocolConfigured.target":
+		panic(fmt.Errorf("field target of message dwn.v1.EventProtocolConfigured is not mutable"))
+	case "dwn.v1.EventProtocolConfigured.protocol_uri":
+		panic(fmt.Errorf("field protocol_uri of message dwn.v1.EventProtocolConfigured is not mutable"))
+	case "dwn.v1.EventProtocolConfigured.published":
+		panic(fmt.Errorf("field published of message dwn.v1.EventProtocolConfigured is not mutable"))
+	case "dwn.v1.EventProtocolConfigured.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventProtocolConfigured is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventPermissionGranted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventProtocolConfigured) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionGranted.permission_id":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionGranted.grantor":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionGranted.grantee":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionGranted.interface_name":
+	case "dwn.v1.EventProtocolConfigured.target":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionGranted.method":
+	case "dwn.v1.EventProtocolConfigured.protocol_uri":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionGranted.expires_at":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "dwn.v1.EventPermissionGranted.block_height":
+	case "dwn.v1.EventProtocolConfigured.published":
+		return protoreflect.ValueOfBool(false)
+	case "dwn.v1.EventProtocolConfigured.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventProtocolConfigured"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventProtocolConfigured does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventPermissionGranted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventProtocolConfigured) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventPermissionGranted", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventProtocolConfigured", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2369,7 +2716,7 @@ func (x *fastReflection_EventPermissionGranted) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventPermissionGranted) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventProtocolConfigured) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2380,7 +2727,7 @@ func (x *fastReflection_EventPermissionGranted) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionGranted) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventProtocolConfigured) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2392,7 +2739,7 @@ func (x *fastReflection_EventPermissionGranted) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventPermissionGranted) IsValid() bool {
+func (x *fastReflection_EventProtocolConfigured) IsValid() bool {
 	return x != nil
 }
 
@@ -2402,9 +2749,9 @@ func (x *fastReflection_EventPermissionGranted) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventProtocolConfigured) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventPermissionGranted)
+		x := input.Message.Interface().(*EventProtocolConfigured)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2416,29 +2763,16 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.PermissionId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Grantor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Grantee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InterfaceName)
+		l = len(x.Target)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Method)
+		l = len(x.ProtocolUri)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExpiresAt != nil {
-			l = options.Size(x.ExpiresAt)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Published {
+			n += 2
 		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
@@ -2453,7 +2787,7 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventPermissionGranted)
+		x := input.Message.Interface().(*EventProtocolConfigured)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2475,54 +2809,29 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x38
-		}
-		if x.ExpiresAt != nil {
-			encoded, err := options.Marshal(x.ExpiresAt)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Method) > 0 {
-			i -= len(x.Method)
-			copy(dAtA[i:], x.Method)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Method)))
-			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x20
 		}
-		if len(x.InterfaceName) > 0 {
-			i -= len(x.InterfaceName)
-			copy(dAtA[i:], x.InterfaceName)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterfaceName)))
+		if x.Published {
 			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Grantee) > 0 {
-			i -= len(x.Grantee)
-			copy(dAtA[i:], x.Grantee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			if x.Published {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.Grantor) > 0 {
-			i -= len(x.Grantor)
-			copy(dAtA[i:], x.Grantor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantor)))
+		if len(x.ProtocolUri) > 0 {
+			i -= len(x.ProtocolUri)
+			copy(dAtA[i:], x.ProtocolUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolUri)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.PermissionId) > 0 {
-			i -= len(x.PermissionId)
-			copy(dAtA[i:], x.PermissionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PermissionId)))
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2537,7 +2846,7 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventPermissionGranted)
+		x := input.Message.Interface().(*EventProtocolConfigured)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2569,15 +2878,15 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionGranted: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProtocolConfigured: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionGranted: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProtocolConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2605,11 +2914,11 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PermissionId = string(dAtA[iNdEx:postIndex])
+				x.Target = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolUri", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2637,13 +2946,13 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Grantor = string(dAtA[iNdEx:postIndex])
+				x.ProtocolUri = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Published", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2653,29 +2962,17 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Grantee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.Published = bool(v != 0)
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceName", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var stringLen uint64
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2685,27 +2982,1266 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventPermissionGranted                protoreflect.MessageDescriptor
+	fd_EventPermissionGranted_permission_id  protoreflect.FieldDescriptor
+	fd_EventPermissionGranted_grantor        protoreflect.FieldDescriptor
+	fd_EventPermissionGranted_grantee        protoreflect.FieldDescriptor
+	fd_EventPermissionGranted_interface_name protoreflect.FieldDescriptor
+	fd_EventPermissionGranted_method         protoreflect.FieldDescriptor
+	fd_EventPermissionGranted_expires_at     protoreflect.FieldDescriptor
+	fd_EventPermissionGranted_block_height   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dwn_v1_events_proto_init()
+	md_EventPermissionGranted = File_dwn_v1_events_proto.Messages().ByName("EventPermissionGranted")
+	fd_EventPermissionGranted_permission_id = md_EventPermissionGranted.Fields().ByName("permission_id")
+	fd_EventPermissionGranted_grantor = md_EventPermissionGranted.Fields().ByName("grantor")
+	fd_EventPermissionGranted_grantee = md_EventPermissionGranted.Fields().ByName("grantee")
+	fd_EventPermissionGranted_interface_name = md_EventPermissionGranted.Fields().ByName("interface_name")
+	fd_EventPermissionGranted_method = md_EventPermissionGranted.Fields().ByName("method")
+	fd_EventPermissionGranted_expires_at = md_EventPermissionGranted.Fields().ByName("expires_at")
+	fd_EventPermissionGranted_block_height = md_EventPermissionGranted.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventPermissionGranted)(nil)
+
+type fastReflection_EventPermissionGranted EventPermissionGranted
+
+func (x *EventPermissionGranted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventPermissionGranted)(x)
+}
+
+func (x *EventPermissionGranted) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventPermissionGranted_messageType fastReflection_EventPermissionGranted_messageType
+var _ protoreflect.MessageType = fastReflection_EventPermissionGranted_messageType{}
+
+type fastReflection_EventPermissionGranted_messageType struct{}
+
+func (x fastReflection_EventPermissionGranted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventPermissionGranted)(nil)
+}
+func (x fastReflection_EventPermissionGranted_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventPermissionGranted)
+}
+func (x fastReflection_EventPermissionGranted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPermissionGranted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventPermissionGranted) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPermissionGranted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventPermissionGranted) Type() protoreflect.MessageType {
+	return _fastReflection_EventPermissionGranted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventPermissionGranted) New() protoreflect.Message {
+	return new(fastReflection_EventPermissionGranted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventPermissionGranted) Interface() protoreflect.ProtoMessage {
+	return (*EventPermissionGranted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventPermissionGranted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PermissionId != "" {
+		value := protoreflect.ValueOfString(x.PermissionId)
+		if !f(fd_EventPermissionGranted_permission_id, value) {
+			return
+		}
+	}
+	if x.Grantor != "" {
+		value := protoreflect.ValueOfString(x.Grantor)
+		if !f(fd_EventPermissionGranted_grantor, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_EventPermissionGranted_grantee, value) {
+			return
+		}
+	}
+	if x.InterfaceName != "" {
+		value := protoreflect.ValueOfString(x.InterfaceName)
+		if !f(fd_EventPermissionGranted_interface_name, value) {
+			return
+		}
+	}
+	if x.Method != "" {
+		value := protoreflect.ValueOfString(x.Method)
+		if !f(fd_EventPermissionGranted_method, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+		if !f(fd_EventPermissionGranted_expires_at, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventPermissionGranted_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventPermissionGranted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionGranted.permission_id":
+		return x.PermissionId != ""
+	case "dwn.v1.EventPermissionGranted.grantor":
+		return x.Grantor != ""
+	case "dwn.v1.EventPermissionGranted.grantee":
+		return x.Grantee != ""
+	case "dwn.v1.EventPermissionGranted.interface_name":
+		return x.InterfaceName != ""
+	case "dwn.v1.EventPermissionGranted.method":
+		return x.Method != ""
+	case "dwn.v1.EventPermissionGranted.expires_at":
+		return x.ExpiresAt != nil
+	case "dwn.v1.EventPermissionGranted.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionGranted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionGranted.permission_id":
+		x.PermissionId = ""
+	case "dwn.v1.EventPermissionGranted.grantor":
+		x.Grantor = ""
+	case "dwn.v1.EventPermissionGranted.grantee":
+		x.Grantee = ""
+	case "dwn.v1.EventPermissionGranted.interface_name":
+		x.InterfaceName = ""
+	case "dwn.v1.EventPermissionGranted.method":
+		x.Method = ""
+	case "dwn.v1.EventPermissionGranted.expires_at":
+		x.ExpiresAt = nil
+	case "dwn.v1.EventPermissionGranted.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventPermissionGranted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dwn.v1.EventPermissionGranted.permission_id":
+		value := x.PermissionId
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionGranted.grantor":
+		value := x.Grantor
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionGranted.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionGranted.interface_name":
+		value := x.InterfaceName
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionGranted.method":
+		value := x.Method
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionGranted.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dwn.v1.EventPermissionGranted.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionGranted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionGranted.permission_id":
+		x.PermissionId = value.Interface().(string)
+	case "dwn.v1.EventPermissionGranted.grantor":
+		x.Grantor = value.Interface().(string)
+	case "dwn.v1.EventPermissionGranted.grantee":
+		x.Grantee = value.Interface().(string)
+	case "dwn.v1.EventPermissionGranted.interface_name":
+		x.InterfaceName = value.Interface().(string)
+	case "dwn.v1.EventPermissionGranted.method":
+		x.Method = value.Interface().(string)
+	case "dwn.v1.EventPermissionGranted.expires_at":
+		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "dwn.v1.EventPermissionGranted.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionGranted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionGranted.expires_at":
+		if x.ExpiresAt == nil {
+			x.ExpiresAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+	case "dwn.v1.EventPermissionGranted.permission_id":
+		panic(fmt.Errorf("field permission_id of message dwn.v1.EventPermissionGranted is not mutable"))
+	case "dwn.v1.EventPermissionGranted.grantor":
+		panic(fmt.Errorf("field grantor of message dwn.v1.EventPermissionGranted is not mutable"))
+	case "dwn.v1.EventPermissionGranted.grantee":
+		panic(fmt.Errorf("field grantee of message dwn.v1.EventPermissionGranted is not mutable"))
+	case "dwn.v1.EventPermissionGranted.interface_name":
+		panic(fmt.Errorf("field interface_name of message dwn.v1.EventPermissionGranted is not mutable"))
+	case "dwn.v1.EventPermissionGranted.method":
+		panic(fmt.Errorf("field method of message dwn.v1.EventPermissionGranted is not mutable"))
+	case "dwn.v1.EventPermissionGranted.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventPermissionGranted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventPermissionGranted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionGranted.permission_id":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionGranted.grantor":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionGranted.grantee":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionGranted.interface_name":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionGranted.method":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionGranted.expires_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dwn.v1.EventPermissionGranted.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionGranted"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionGranted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventPermissionGranted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventPermissionGranted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventPermissionGranted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionGranted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventPermissionGranted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventPermissionGranted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PermissionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InterfaceName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Method)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiresAt != nil {
+			l = options.Size(x.ExpiresAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventPermissionGranted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ExpiresAt != nil {
+			encoded, err := options.Marshal(x.ExpiresAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Method) > 0 {
+			i -= len(x.Method)
+			copy(dAtA[i:], x.Method)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Method)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.InterfaceName) > 0 {
+			i -= len(x.InterfaceName)
+			copy(dAtA[i:], x.InterfaceName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterfaceName)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Grantor) > 0 {
+			i -= len(x.Grantor)
+			copy(dAtA[i:], x.Grantor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PermissionId) > 0 {
+			i -= len(x.PermissionId)
+			copy(dAtA[i:], x.PermissionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PermissionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventPermissionGranted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionGranted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionGranted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PermissionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterfaceName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Method = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAt == nil {
+					x.ExpiresAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventPermissionRevoked               protoreflect.MessageDescriptor
+	fd_EventPermissionRevoked_permission_id protoreflect.FieldDescriptor
+	fd_EventPermissionRevoked_revoker       protoreflect.FieldDescriptor
+	fd_EventPermissionRevoked_block_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dwn_v1_events_proto_init()
+	md_EventPermissionRevoked = File_dwn_v1_events_proto.Messages().ByName("EventPermissionRevoked")
+	fd_EventPermissionRevoked_permission_id = md_EventPermissionRevoked.Fields().ByName("permission_id")
+	fd_EventPermissionRevoked_revoker = md_EventPermissionRevoked.Fields().ByName("revoker")
+	fd_EventPermissionRevoked_block_height = md_EventPermissionRevoked.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventPermissionRevoked)(nil)
+
+type fastReflection_EventPermissionRevoked EventPermissionRevoked
+
+func (x *EventPermissionRevoked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventPermissionRevoked)(x)
+}
+
+func (x *EventPermissionRevoked) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventPermissionRevoked_messageType fastReflection_EventPermissionRevoked_messageType
+var _ protoreflect.MessageType = fastReflection_EventPermissionRevoked_messageType{}
+
+type fastReflection_EventPermissionRevoked_messageType struct{}
+
+func (x fastReflection_EventPermissionRevoked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventPermissionRevoked)(nil)
+}
+func (x fastReflection_EventPermissionRevoked_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventPermissionRevoked)
+}
+func (x fastReflection_EventPermissionRevoked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPermissionRevoked
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventPermissionRevoked) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPermissionRevoked
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventPermissionRevoked) Type() protoreflect.MessageType {
+	return _fastReflection_EventPermissionRevoked_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventPermissionRevoked) New() protoreflect.Message {
+	return new(fastReflection_EventPermissionRevoked)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventPermissionRevoked) Interface() protoreflect.ProtoMessage {
+	return (*EventPermissionRevoked)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventPermissionRevoked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PermissionId != "" {
+		value := protoreflect.ValueOfString(x.PermissionId)
+		if !f(fd_EventPermissionRevoked_permission_id, value) {
+			return
+		}
+	}
+	if x.Revoker != "" {
+		value := protoreflect.ValueOfString(x.Revoker)
+		if !f(fd_EventPermissionRevoked_revoker, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventPermissionRevoked_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventPermissionRevoked) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionRevoked.permission_id":
+		return x.PermissionId != ""
+	case "dwn.v1.EventPermissionRevoked.revoker":
+		return x.Revoker != ""
+	case "dwn.v1.EventPermissionRevoked.block_height":
+		return x.BlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionRevoked) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionRevoked.permission_id":
+		x.PermissionId = ""
+	case "dwn.v1.EventPermissionRevoked.revoker":
+		x.Revoker = ""
+	case "dwn.v1.EventPermissionRevoked.block_height":
+		x.BlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventPermissionRevoked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dwn.v1.EventPermissionRevoked.permission_id":
+		value := x.PermissionId
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionRevoked.revoker":
+		value := x.Revoker
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventPermissionRevoked.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionRevoked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionRevoked.permission_id":
+		x.PermissionId = value.Interface().(string)
+	case "dwn.v1.EventPermissionRevoked.revoker":
+		x.Revoker = value.Interface().(string)
+	case "dwn.v1.EventPermissionRevoked.block_height":
+		x.BlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionRevoked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionRevoked.permission_id":
+		panic(fmt.Errorf("field permission_id of message dwn.v1.EventPermissionRevoked is not mutable"))
+	case "dwn.v1.EventPermissionRevoked.revoker":
+		panic(fmt.Errorf("field revoker of message dwn.v1.EventPermissionRevoked is not mutable"))
+	case "dwn.v1.EventPermissionRevoked.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventPermissionRevoked is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventPermissionRevoked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.EventPermissionRevoked.permission_id":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionRevoked.revoker":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventPermissionRevoked.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+		}
+		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventPermissionRevoked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventPermissionRevoked", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventPermissionRevoked) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPermissionRevoked) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventPermissionRevoked) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventPermissionRevoked)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PermissionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Revoker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventPermissionRevoked)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Revoker) > 0 {
+			i -= len(x.Revoker)
+			copy(dAtA[i:], x.Revoker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Revoker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PermissionId) > 0 {
+			i -= len(x.PermissionId)
+			copy(dAtA[i:], x.PermissionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PermissionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventPermissionRevoked)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InterfaceName = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionRevoked: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2733,13 +4269,13 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Method = string(dAtA[iNdEx:postIndex])
+				x.PermissionId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoker", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2749,29 +4285,25 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExpiresAt == nil {
-					x.ExpiresAt = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Revoker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -2826,30 +4358,32 @@ func (x *fastReflection_EventPermissionGranted) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_EventPermissionRevoked               protoreflect.MessageDescriptor
-	fd_EventPermissionRevoked_permission_id protoreflect.FieldDescriptor
-	fd_EventPermissionRevoked_revoker       protoreflect.FieldDescriptor
-	fd_EventPermissionRevoked_block_height  protoreflect.FieldDescriptor
+	md_EventVaultCreated              protoreflect.MessageDescriptor
+	fd_EventVaultCreated_vault_id     protoreflect.FieldDescriptor
+	fd_EventVaultCreated_owner        protoreflect.FieldDescriptor
+	fd_EventVaultCreated_public_key   protoreflect.FieldDescriptor
+	fd_EventVaultCreated_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_events_proto_init()
-	md_EventPermissionRevoked = File_dwn_v1_events_proto.Messages().ByName("EventPermissionRevoked")
-	fd_EventPermissionRevoked_permission_id = md_EventPermissionRevoked.Fields().ByName("permission_id")
-	fd_EventPermissionRevoked_revoker = md_EventPermissionRevoked.Fields().ByName("revoker")
-	fd_EventPermissionRevoked_block_height = md_EventPermissionRevoked.Fields().ByName("block_height")
+	md_EventVaultCreated = File_dwn_v1_events_proto.Messages().ByName("EventVaultCreated")
+	fd_EventVaultCreated_vault_id = md_EventVaultCreated.Fields().ByName("vault_id")
+	fd_EventVaultCreated_owner = md_EventVaultCreated.Fields().ByName("owner")
+	fd_EventVaultCreated_public_key = md_EventVaultCreated.Fields().ByName("public_key")
+	fd_EventVaultCreated_block_height = md_EventVaultCreated.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventPermissionRevoked)(nil)
+var _ protoreflect.Message = (*fastReflection_EventVaultCreated)(nil)
 
-type fastReflection_EventPermissionRevoked EventPermissionRevoked
+type fastReflection_EventVaultCreated EventVaultCreated
 
-func (x *EventPermissionRevoked) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventPermissionRevoked)(x)
+func (x *EventVaultCreated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVaultCreated)(x)
 }
 
-func (x *EventPermissionRevoked) slowProtoReflect() protoreflect.Message {
-	mi := &file_dwn_v1_events_proto_msgTypes[4]
+func (x *EventVaultCreated) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2860,43 +4394,43 @@ func (x *EventPermissionRevoked) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventPermissionRevoked_messageType fastReflection_EventPermissionRevoked_messageType
-var _ protoreflect.MessageType = fastReflection_EventPermissionRevoked_messageType{}
+var _fastReflection_EventVaultCreated_messageType fastReflection_EventVaultCreated_messageType
+var _ protoreflect.MessageType = fastReflection_EventVaultCreated_messageType{}
 
-type fastReflection_EventPermissionRevoked_messageType struct{}
+type fastReflection_EventVaultCreated_messageType struct{}
 
-func (x fastReflection_EventPermissionRevoked_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventPermissionRevoked)(nil)
+func (x fastReflection_EventVaultCreated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVaultCreated)(nil)
 }
-func (x fastReflection_EventPermissionRevoked_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventPermissionRevoked)
+func (x fastReflection_EventVaultCreated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVaultCreated)
 }
-func (x fastReflection_EventPermissionRevoked_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventPermissionRevoked
+func (x fastReflection_EventVaultCreated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVaultCreated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventPermissionRevoked) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventPermissionRevoked
+func (x *fastReflection_EventVaultCreated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVaultCreated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventPermissionRevoked) Type() protoreflect.MessageType {
-	return _fastReflection_EventPermissionRevoked_messageType
+func (x *fastReflection_EventVaultCreated) Type() protoreflect.MessageType {
+	return _fastReflection_EventVaultCreated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventPermissionRevoked) New() protoreflect.Message {
-	return new(fastReflection_EventPermissionRevoked)
+func (x *fastReflection_EventVaultCreated) New() protoreflect.Message {
+	return new(fastReflection_EventVaultCreated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventPermissionRevoked) Interface() protoreflect.ProtoMessage {
-	return (*EventPermissionRevoked)(x)
+func (x *fastReflection_EventVaultCreated) Interface() protoreflect.ProtoMessage {
+	return (*EventVaultCreated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2904,22 +4438,28 @@ func (x *fastReflection_EventPermissionRevoked) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventPermissionRevoked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PermissionId != "" {
-		value := protoreflect.ValueOfString(x.PermissionId)
-		if !f(fd_EventPermissionRevoked_permission_id, value) {
+func (x *fastReflection_EventVaultCreated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultId != "" {
+		value := protoreflect.ValueOfString(x.VaultId)
+		if !f(fd_EventVaultCreated_vault_id, value) {
 			return
 		}
 	}
-	if x.Revoker != "" {
-		value := protoreflect.ValueOfString(x.Revoker)
-		if !f(fd_EventPermissionRevoked_revoker, value) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventVaultCreated_owner, value) {
+			return
+		}
+	}
+	if x.PublicKey != "" {
+		value := protoreflect.ValueOfString(x.PublicKey)
+		if !f(fd_EventVaultCreated_public_key, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventPermissionRevoked_block_height, value) {
+		if !f(fd_EventVaultCreated_block_height, value) {
 			return
 		}
 	}
@@ -2936,19 +4476,21 @@ func (x *fastReflection_EventPermissionRevoked) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventPermissionRevoked) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventVaultCreated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionRevoked.permission_id":
-		return x.PermissionId != ""
-	case "dwn.v1.EventPermissionRevoked.revoker":
-		return x.Revoker != ""
-	case "dwn.v1.EventPermissionRevoked.block_height":
+	case "dwn.v1.EventVaultCreated.vault_id":
+		return x.VaultId != ""
+	case "dwn.v1.EventVaultCreated.owner":
+		return x.Owner != ""
+	case "dwn.v1.EventVaultCreated.public_key":
+		return x.PublicKey != ""
+	case "dwn.v1.EventVaultCreated.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2958,19 +4500,21 @@ func (x *fastReflection_EventPermissionRevoked) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionRevoked) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventVaultCreated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionRevoked.permission_id":
-		x.PermissionId = ""
-	case "dwn.v1.EventPermissionRevoked.revoker":
-		x.Revoker = ""
-	case "dwn.v1.EventPermissionRevoked.block_height":
+	case "dwn.v1.EventVaultCreated.vault_id":
+		x.VaultId = ""
+	case "dwn.v1.EventVaultCreated.owner":
+		x.Owner = ""
+	case "dwn.v1.EventVaultCreated.public_key":
+		x.PublicKey = ""
+	case "dwn.v1.EventVaultCreated.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2980,22 +4524,25 @@ func (x *fastReflection_EventPermissionRevoked) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventPermissionRevoked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVaultCreated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.EventPermissionRevoked.permission_id":
-		value := x.PermissionId
+	case "dwn.v1.EventVaultCreated.vault_id":
+		value := x.VaultId
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionRevoked.revoker":
-		value := x.Revoker
+	case "dwn.v1.EventVaultCreated.owner":
+		value := x.Owner
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventPermissionRevoked.block_height":
+	case "dwn.v1.EventVaultCreated.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventVaultCreated.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3009,19 +4556,21 @@ func (x *fastReflection_EventPermissionRevoked) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionRevoked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventVaultCreated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionRevoked.permission_id":
-		x.PermissionId = value.Interface().(string)
-	case "dwn.v1.EventPermissionRevoked.revoker":
-		x.Revoker = value.Interface().(string)
-	case "dwn.v1.EventPermissionRevoked.block_height":
+	case "dwn.v1.EventVaultCreated.vault_id":
+		x.VaultId = value.Interface().(string)
+	case "dwn.v1.EventVaultCreated.owner":
+		x.Owner = value.Interface().(string)
+	case "dwn.v1.EventVaultCreated.public_key":
+		x.PublicKey = value.Interface().(string)
+	case "dwn.v1.EventVaultCreated.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3035,48 +4584,52 @@ func (x *fastReflection_EventPermissionRevoked) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionRevoked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "dwn.v1.EventPermissionRevoked.permission_id":
-		panic(fmt.Errorf("field permission_id of message dwn.v1.EventPermissionRevoked is not mutable"))
-	case "dwn.v1.EventPermissionRevoked.revoker":
-		panic(fmt.Errorf("field revoker of message dwn.v1.EventPermissionRevoked is not mutable"))
-	case "dwn.v1.EventPermissionRevoked.block_height":
-		panic(fmt.Errorf("field block_height of message dwn.v1.EventPermissionRevoked is not mutable"))
+func (x *fastReflection_EventVaultCreated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.EventVaultCreated.vault_id":
+		panic(fmt.Errorf("field vault_id of message dwn.v1.EventVaultCreated is not mutable"))
+	case "dwn.v1.EventVaultCreated.owner":
+		panic(fmt.Errorf("field owner of message dwn.v1.EventVaultCreated is not mutable"))
+	case "dwn.v1.EventVaultCreated.public_key":
+		panic(fmt.Errorf("field public_key of message dwn.v1.EventVaultCreated is not mutable"))
+	case "dwn.v1.EventVaultCreated.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventVaultCreated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventPermissionRevoked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVaultCreated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventPermissionRevoked.permission_id":
+	case "dwn.v1.EventVaultCreated.vault_id":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionRevoked.revoker":
+	case "dwn.v1.EventVaultCreated.owner":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventPermissionRevoked.block_height":
+	case "dwn.v1.EventVaultCreated.public_key":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventVaultCreated.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventPermissionRevoked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventPermissionRevoked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventPermissionRevoked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventVaultCreated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventPermissionRevoked", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventVaultCreated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3084,7 +4637,7 @@ func (x *fastReflection_EventPermissionRevoked) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventPermissionRevoked) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventVaultCreated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3095,7 +4648,7 @@ func (x *fastReflection_EventPermissionRevoked) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPermissionRevoked) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventVaultCreated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3107,7 +4660,7 @@ func (x *fastReflection_EventPermissionRevoked) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventPermissionRevoked) IsValid() bool {
+func (x *fastReflection_EventVaultCreated) IsValid() bool {
 	return x != nil
 }
 
@@ -3117,9 +4670,9 @@ func (x *fastReflection_EventPermissionRevoked) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventPermissionRevoked)
+		x := input.Message.Interface().(*EventVaultCreated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3131,11 +4684,15 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.PermissionId)
+		l = len(x.VaultId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Revoker)
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3152,7 +4709,7 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventPermissionRevoked)
+		x := input.Message.Interface().(*EventVaultCreated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3174,19 +4731,26 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x20
 		}
-		if len(x.Revoker) > 0 {
-			i -= len(x.Revoker)
-			copy(dAtA[i:], x.Revoker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Revoker)))
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.PermissionId) > 0 {
-			i -= len(x.PermissionId)
-			copy(dAtA[i:], x.PermissionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PermissionId)))
+		if len(x.VaultId) > 0 {
+			i -= len(x.VaultId)
+			copy(dAtA[i:], x.VaultId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3201,7 +4765,7 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventPermissionRevoked)
+		x := input.Message.Interface().(*EventVaultCreated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3233,15 +4797,15 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionRevoked: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultCreated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPermissionRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3269,11 +4833,11 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PermissionId = string(dAtA[iNdEx:postIndex])
+				x.VaultId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3301,9 +4865,41 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Revoker = string(dAtA[iNdEx:postIndex])
+				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -3358,32 +4954,34 @@ func (x *fastReflection_EventPermissionRevoked) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_EventVaultCreated              protoreflect.MessageDescriptor
-	fd_EventVaultCreated_vault_id     protoreflect.FieldDescriptor
-	fd_EventVaultCreated_owner        protoreflect.FieldDescriptor
-	fd_EventVaultCreated_public_key   protoreflect.FieldDescriptor
-	fd_EventVaultCreated_block_height protoreflect.FieldDescriptor
+	md_EventVaultKeysRotated                 protoreflect.MessageDescriptor
+	fd_EventVaultKeysRotated_vault_id        protoreflect.FieldDescriptor
+	fd_EventVaultKeysRotated_owner           protoreflect.FieldDescriptor
+	fd_EventVaultKeysRotated_new_public_key  protoreflect.FieldDescriptor
+	fd_EventVaultKeysRotated_rotation_height protoreflect.FieldDescriptor
+	fd_EventVaultKeysRotated_block_height    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_events_proto_init()
-	md_EventVaultCreated = File_dwn_v1_events_proto.Messages().ByName("EventVaultCreated")
-	fd_EventVaultCreated_vault_id = md_EventVaultCreated.Fields().ByName("vault_id")
-	fd_EventVaultCreated_owner = md_EventVaultCreated.Fields().ByName("owner")
-	fd_EventVaultCreated_public_key = md_EventVaultCreated.Fields().ByName("public_key")
-	fd_EventVaultCreated_block_height = md_EventVaultCreated.Fields().ByName("block_height")
+	md_EventVaultKeysRotated = File_dwn_v1_events_proto.Messages().ByName("EventVaultKeysRotated")
+	fd_EventVaultKeysRotated_vault_id = md_EventVaultKeysRotated.Fields().ByName("vault_id")
+	fd_EventVaultKeysRotated_owner = md_EventVaultKeysRotated.Fields().ByName("owner")
+	fd_EventVaultKeysRotated_new_public_key = md_EventVaultKeysRotated.Fields().ByName("new_public_key")
+	fd_EventVaultKeysRotated_rotation_height = md_EventVaultKeysRotated.Fields().ByName("rotation_height")
+	fd_EventVaultKeysRotated_block_height = md_EventVaultKeysRotated.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventVaultCreated)(nil)
+var _ protoreflect.Message = (*fastReflection_EventVaultKeysRotated)(nil)
 
-type fastReflection_EventVaultCreated EventVaultCreated
+type fastReflection_EventVaultKeysRotated EventVaultKeysRotated
 
-func (x *EventVaultCreated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventVaultCreated)(x)
+func (x *EventVaultKeysRotated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVaultKeysRotated)(x)
 }
 
-func (x *EventVaultCreated) slowProtoReflect() protoreflect.Message {
-	mi := &file_dwn_v1_events_proto_msgTypes[5]
+func (x *EventVaultKeysRotated) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3394,43 +4992,43 @@ func (x *EventVaultCreated) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventVaultCreated_messageType fastReflection_EventVaultCreated_messageType
-var _ protoreflect.MessageType = fastReflection_EventVaultCreated_messageType{}
+var _fastReflection_EventVaultKeysRotated_messageType fastReflection_EventVaultKeysRotated_messageType
+var _ protoreflect.MessageType = fastReflection_EventVaultKeysRotated_messageType{}
 
-type fastReflection_EventVaultCreated_messageType struct{}
+type fastReflection_EventVaultKeysRotated_messageType struct{}
 
-func (x fastReflection_EventVaultCreated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventVaultCreated)(nil)
+func (x fastReflection_EventVaultKeysRotated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVaultKeysRotated)(nil)
 }
-func (x fastReflection_EventVaultCreated_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventVaultCreated)
+func (x fastReflection_EventVaultKeysRotated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVaultKeysRotated)
 }
-func (x fastReflection_EventVaultCreated_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVaultCreated
+func (x fastReflection_EventVaultKeysRotated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVaultKeysRotated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventVaultCreated) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVaultCreated
+func (x *fastReflection_EventVaultKeysRotated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVaultKeysRotated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventVaultCreated) Type() protoreflect.MessageType {
-	return _fastReflection_EventVaultCreated_messageType
+func (x *fastReflection_EventVaultKeysRotated) Type() protoreflect.MessageType {
+	return _fastReflection_EventVaultKeysRotated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventVaultCreated) New() protoreflect.Message {
-	return new(fastReflection_EventVaultCreated)
+func (x *fastReflection_EventVaultKeysRotated) New() protoreflect.Message {
+	return new(fastReflection_EventVaultKeysRotated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventVaultCreated) Interface() protoreflect.ProtoMessage {
-	return (*EventVaultCreated)(x)
+func (x *fastReflection_EventVaultKeysRotated) Interface() protoreflect.ProtoMessage {
+	return (*EventVaultKeysRotated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3438,28 +5036,34 @@ func (x *fastReflection_EventVaultCreated) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventVaultCreated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventVaultKeysRotated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.VaultId != "" {
 		value := protoreflect.ValueOfString(x.VaultId)
-		if !f(fd_EventVaultCreated_vault_id, value) {
+		if !f(fd_EventVaultKeysRotated_vault_id, value) {
 			return
 		}
 	}
 	if x.Owner != "" {
 		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_EventVaultCreated_owner, value) {
+		if !f(fd_EventVaultKeysRotated_owner, value) {
 			return
 		}
 	}
-	if x.PublicKey != "" {
-		value := protoreflect.ValueOfString(x.PublicKey)
-		if !f(fd_EventVaultCreated_public_key, value) {
+	if x.NewPublicKey != "" {
+		value := protoreflect.ValueOfString(x.NewPublicKey)
+		if !f(fd_EventVaultKeysRotated_new_public_key, value) {
+			return
+		}
+	}
+	if x.RotationHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RotationHeight)
+		if !f(fd_EventVaultKeysRotated_rotation_height, value) {
 			return
 		}
 	}
 	if x.BlockHeight != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventVaultCreated_block_height, value) {
+		if !f(fd_EventVaultKeysRotated_block_height, value) {
 			return
 		}
 	}
@@ -3476,21 +5080,23 @@ func (x *fastReflection_EventVaultCreated) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventVaultCreated) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventVaultKeysRotated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultCreated.vault_id":
+	case "dwn.v1.EventVaultKeysRotated.vault_id":
 		return x.VaultId != ""
-	case "dwn.v1.EventVaultCreated.owner":
+	case "dwn.v1.EventVaultKeysRotated.owner":
 		return x.Owner != ""
-	case "dwn.v1.EventVaultCreated.public_key":
-		return x.PublicKey != ""
-	case "dwn.v1.EventVaultCreated.block_height":
+	case "dwn.v1.EventVaultKeysRotated.new_public_key":
+		return x.NewPublicKey != ""
+	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+		return x.RotationHeight != uint64(0)
+	case "dwn.v1.EventVaultKeysRotated.block_height":
 		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3500,21 +5106,23 @@ func (x *fastReflection_EventVaultCreated) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultCreated) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventVaultKeysRotated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultCreated.vault_id":
+	case "dwn.v1.EventVaultKeysRotated.vault_id":
 		x.VaultId = ""
-	case "dwn.v1.EventVaultCreated.owner":
+	case "dwn.v1.EventVaultKeysRotated.owner":
 		x.Owner = ""
-	case "dwn.v1.EventVaultCreated.public_key":
-		x.PublicKey = ""
-	case "dwn.v1.EventVaultCreated.block_height":
+	case "dwn.v1.EventVaultKeysRotated.new_public_key":
+		x.NewPublicKey = ""
+	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+		x.RotationHeight = uint64(0)
+	case "dwn.v1.EventVaultKeysRotated.block_height":
 		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3524,25 +5132,28 @@ func (x *fastReflection_EventVaultCreated) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventVaultCreated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVaultKeysRotated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.EventVaultCreated.vault_id":
+	case "dwn.v1.EventVaultKeysRotated.vault_id":
 		value := x.VaultId
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventVaultCreated.owner":
+	case "dwn.v1.EventVaultKeysRotated.owner":
 		value := x.Owner
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventVaultCreated.public_key":
-		value := x.PublicKey
+	case "dwn.v1.EventVaultKeysRotated.new_public_key":
+		value := x.NewPublicKey
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventVaultCreated.block_height":
+	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+		value := x.RotationHeight
+		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.EventVaultKeysRotated.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3556,21 +5167,23 @@ func (x *fastReflection_EventVaultCreated) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultCreated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventVaultKeysRotated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultCreated.vault_id":
+	case "dwn.v1.EventVaultKeysRotated.vault_id":
 		x.VaultId = value.Interface().(string)
-	case "dwn.v1.EventVaultCreated.owner":
+	case "dwn.v1.EventVaultKeysRotated.owner":
 		x.Owner = value.Interface().(string)
-	case "dwn.v1.EventVaultCreated.public_key":
-		x.PublicKey = value.Interface().(string)
-	case "dwn.v1.EventVaultCreated.block_height":
+	case "dwn.v1.EventVaultKeysRotated.new_public_key":
+		x.NewPublicKey = value.Interface().(string)
+	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+		x.RotationHeight = value.Uint()
+	case "dwn.v1.EventVaultKeysRotated.block_height":
 		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3584,52 +5197,56 @@ func (x *fastReflection_EventVaultCreated) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultCreated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVaultKeysRotated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultCreated.vault_id":
-		panic(fmt.Errorf("field vault_id of message dwn.v1.EventVaultCreated is not mutable"))
-	case "dwn.v1.EventVaultCreated.owner":
-		panic(fmt.Errorf("field owner of message dwn.v1.EventVaultCreated is not mutable"))
-	case "dwn.v1.EventVaultCreated.public_key":
-		panic(fmt.Errorf("field public_key of message dwn.v1.EventVaultCreated is not mutable"))
-	case "dwn.v1.EventVaultCreated.block_height":
-		panic(fmt.Errorf("field block_height of message dwn.v1.EventVaultCreated is not mutable"))
+	case "dwn.v1.EventVaultKeysRotated.vault_id":
+		panic(fmt.Errorf("field vault_id of message dwn.v1.EventVaultKeysRotated is not mutable"))
+	case "dwn.v1.EventVaultKeysRotated.owner":
+		panic(fmt.Errorf("field owner of message dwn.v1.EventVaultKeysRotated is not mutable"))
+	case "dwn.v1.EventVaultKeysRotated.new_public_key":
+		panic(fmt.Errorf("field new_public_key of message dwn.v1.EventVaultKeysRotated is not mutable"))
+	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+		panic(fmt.Errorf("field rotation_height of message dwn.v1.EventVaultKeysRotated is not mutable"))
+	case "dwn.v1.EventVaultKeysRotated.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventVaultKeysRotated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventVaultCreated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVaultKeysRotated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultCreated.vault_id":
+	case "dwn.v1.EventVaultKeysRotated.vault_id":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventVaultCreated.owner":
+	case "dwn.v1.EventVaultKeysRotated.owner":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventVaultCreated.public_key":
+	case "dwn.v1.EventVaultKeysRotated.new_public_key":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventVaultCreated.block_height":
+	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.EventVaultKeysRotated.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultCreated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultCreated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventVaultCreated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventVaultKeysRotated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventVaultCreated", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventVaultKeysRotated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3637,7 +5254,7 @@ func (x *fastReflection_EventVaultCreated) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventVaultCreated) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventVaultKeysRotated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3648,7 +5265,7 @@ func (x *fastReflection_EventVaultCreated) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultCreated) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventVaultKeysRotated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3660,7 +5277,7 @@ func (x *fastReflection_EventVaultCreated) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventVaultCreated) IsValid() bool {
+func (x *fastReflection_EventVaultKeysRotated) IsValid() bool {
 	return x != nil
 }
 
@@ -3670,9 +5287,9 @@ func (x *fastReflection_EventVaultCreated) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventVaultCreated)
+		x := input.Message.Interface().(*EventVaultKeysRotated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3692,10 +5309,13 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PublicKey)
+		l = len(x.NewPublicKey)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RotationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RotationHeight))
+		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
@@ -3709,7 +5329,7 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventVaultCreated)
+		x := input.Message.Interface().(*EventVaultKeysRotated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3731,12 +5351,17 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
+			dAtA[i] = 0x28
+		}
+		if x.RotationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RotationHeight))
+			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+		if len(x.NewPublicKey) > 0 {
+			i -= len(x.NewPublicKey)
+			copy(dAtA[i:], x.NewPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewPublicKey)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -3765,7 +5390,7 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventVaultCreated)
+		x := input.Message.Interface().(*EventVaultKeysRotated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3797,10 +5422,10 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultCreated: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultKeysRotated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultKeysRotated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3869,7 +5494,7 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3897,9 +5522,28 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				x.NewPublicKey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RotationHeight", wireType)
+				}
+				x.RotationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RotationHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -3954,34 +5598,36 @@ func (x *fastReflection_EventVaultCreated) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventVaultKeysRotated                 protoreflect.MessageDescriptor
-	fd_EventVaultKeysRotated_vault_id        protoreflect.FieldDescriptor
-	fd_EventVaultKeysRotated_owner           protoreflect.FieldDescriptor
-	fd_EventVaultKeysRotated_new_public_key  protoreflect.FieldDescriptor
-	fd_EventVaultKeysRotated_rotation_height protoreflect.FieldDescriptor
-	fd_EventVaultKeysRotated_block_height    protoreflect.FieldDescriptor
+	md_EventKeyRotation                  protoreflect.MessageDescriptor
+	fd_EventKeyRotation_old_key_version  protoreflect.FieldDescriptor
+	fd_EventKeyRotation_new_key_version  protoreflect.FieldDescriptor
+	fd_EventKeyRotation_reason           protoreflect.FieldDescriptor
+	fd_EventKeyRotation_block_height     protoreflect.FieldDescriptor
+	fd_EventKeyRotation_single_node_mode protoreflect.FieldDescriptor
+	fd_EventKeyRotation_validator_count  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_events_proto_init()
-	md_EventVaultKeysRotated = File_dwn_v1_events_proto.Messages().ByName("EventVaultKeysRotated")
-	fd_EventVaultKeysRotated_vault_id = md_EventVaultKeysRotated.Fields().ByName("vault_id")
-	fd_EventVaultKeysRotated_owner = md_EventVaultKeysRotated.Fields().ByName("owner")
-	fd_EventVaultKeysRotated_new_public_key = md_EventVaultKeysRotated.Fields().ByName("new_public_key")
-	fd_EventVaultKeysRotated_rotation_height = md_EventVaultKeysRotated.Fields().ByName("rotation_height")
-	fd_EventVaultKeysRotated_block_height = md_EventVaultKeysRotated.Fields().ByName("block_height")
+	md_EventKeyRotation = File_dwn_v1_events_proto.Messages().ByName("EventKeyRotation")
+	fd_EventKeyRotation_old_key_version = md_EventKeyRotation.Fields().ByName("old_key_version")
+	fd_EventKeyRotation_new_key_version = md_EventKeyRotation.Fields().ByName("new_key_version")
+	fd_EventKeyRotation_reason = md_EventKeyRotation.Fields().ByName("reason")
+	fd_EventKeyRotation_block_height = md_EventKeyRotation.Fields().ByName("block_height")
+	fd_EventKeyRotation_single_node_mode = md_EventKeyRotation.Fields().ByName("single_node_mode")
+	fd_EventKeyRotation_validator_count = md_EventKeyRotation.Fields().ByName("validator_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventVaultKeysRotated)(nil)
+var _ protoreflect.Message = (*fastReflection_EventKeyRotation)(nil)
 
-type fastReflection_EventVaultKeysRotated EventVaultKeysRotated
+type fastReflection_EventKeyRotation EventKeyRotation
 
-func (x *EventVaultKeysRotated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventVaultKeysRotated)(x)
+func (x *EventKeyRotation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventKeyRotation)(x)
 }
 
-func (x *EventVaultKeysRotated) slowProtoReflect() protoreflect.Message {
-	mi := &file_dwn_v1_events_proto_msgTypes[6]
+func (x *EventKeyRotation) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3992,43 +5638,43 @@ func (x *EventVaultKeysRotated) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventVaultKeysRotated_messageType fastReflection_EventVaultKeysRotated_messageType
-var _ protoreflect.MessageType = fastReflection_EventVaultKeysRotated_messageType{}
+var _fastReflection_EventKeyRotation_messageType fastReflection_EventKeyRotation_messageType
+var _ protoreflect.MessageType = fastReflection_EventKeyRotation_messageType{}
 
-type fastReflection_EventVaultKeysRotated_messageType struct{}
+type fastReflection_EventKeyRotation_messageType struct{}
 
-func (x fastReflection_EventVaultKeysRotated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventVaultKeysRotated)(nil)
+func (x fastReflection_EventKeyRotation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventKeyRotation)(nil)
 }
-func (x fastReflection_EventVaultKeysRotated_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventVaultKeysRotated)
+func (x fastReflection_EventKeyRotation_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventKeyRotation)
 }
-func (x fastReflection_EventVaultKeysRotated_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVaultKeysRotated
+func (x fastReflection_EventKeyRotation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventKeyRotation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventVaultKeysRotated) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventVaultKeysRotated
+func (x *fastReflection_EventKeyRotation) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventKeyRotation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventVaultKeysRotated) Type() protoreflect.MessageType {
-	return _fastReflection_EventVaultKeysRotated_messageType
+func (x *fastReflection_EventKeyRotation) Type() protoreflect.MessageType {
+	return _fastReflection_EventKeyRotation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventVaultKeysRotated) New() protoreflect.Message {
-	return new(fastReflection_EventVaultKeysRotated)
+func (x *fastReflection_EventKeyRotation) New() protoreflect.Message {
+	return new(fastReflection_EventKeyRotation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventVaultKeysRotated) Interface() protoreflect.ProtoMessage {
-	return (*EventVaultKeysRotated)(x)
+func (x *fastReflection_EventKeyRotation) Interface() protoreflect.ProtoMessage {
+	return (*EventKeyRotation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4036,34 +5682,40 @@ func (x *fastReflection_EventVaultKeysRotated) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventVaultKeysRotated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VaultId != "" {
-		value := protoreflect.ValueOfString(x.VaultId)
-		if !f(fd_EventVaultKeysRotated_vault_id, value) {
+func (x *fastReflection_EventKeyRotation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldKeyVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OldKeyVersion)
+		if !f(fd_EventKeyRotation_old_key_version, value) {
 			return
 		}
 	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_EventVaultKeysRotated_owner, value) {
+	if x.NewKeyVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewKeyVersion)
+		if !f(fd_EventKeyRotation_new_key_version, value) {
 			return
 		}
 	}
-	if x.NewPublicKey != "" {
-		value := protoreflect.ValueOfString(x.NewPublicKey)
-		if !f(fd_EventVaultKeysRotated_new_public_key, value) {
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventKeyRotation_reason, value) {
 			return
 		}
 	}
-	if x.RotationHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RotationHeight)
-		if !f(fd_EventVaultKeysRotated_rotation_height, value) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventKeyRotation_block_height, value) {
 			return
 		}
 	}
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventVaultKeysRotated_block_height, value) {
+	if x.SingleNodeMode != false {
+		value := protoreflect.ValueOfBool(x.SingleNodeMode)
+		if !f(fd_EventKeyRotation_single_node_mode, value) {
+			return
+		}
+	}
+	if x.ValidatorCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ValidatorCount)
+		if !f(fd_EventKeyRotation_validator_count, value) {
 			return
 		}
 	}
@@ -4080,23 +5732,25 @@ func (x *fastReflection_EventVaultKeysRotated) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventVaultKeysRotated) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventKeyRotation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultKeysRotated.vault_id":
-		return x.VaultId != ""
-	case "dwn.v1.EventVaultKeysRotated.owner":
-		return x.Owner != ""
-	case "dwn.v1.EventVaultKeysRotated.new_public_key":
-		return x.NewPublicKey != ""
-	case "dwn.v1.EventVaultKeysRotated.rotation_height":
-		return x.RotationHeight != uint64(0)
-	case "dwn.v1.EventVaultKeysRotated.block_height":
+	case "dwn.v1.EventKeyRotation.old_key_version":
+		return x.OldKeyVersion != uint64(0)
+	case "dwn.v1.EventKeyRotation.new_key_version":
+		return x.NewKeyVersion != uint64(0)
+	case "dwn.v1.EventKeyRotation.reason":
+		return x.Reason != ""
+	case "dwn.v1.EventKeyRotation.block_height":
 		return x.BlockHeight != uint64(0)
+	case "dwn.v1.EventKeyRotation.single_node_mode":
+		return x.SingleNodeMode != false
+	case "dwn.v1.EventKeyRotation.validator_count":
+		return x.ValidatorCount != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4106,23 +5760,25 @@ func (x *fastReflection_EventVaultKeysRotated) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultKeysRotated) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventKeyRotation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultKeysRotated.vault_id":
-		x.VaultId = ""
-	case "dwn.v1.EventVaultKeysRotated.owner":
-		x.Owner = ""
-	case "dwn.v1.EventVaultKeysRotated.new_public_key":
-		x.NewPublicKey = ""
-	case "dwn.v1.EventVaultKeysRotated.rotation_height":
-		x.RotationHeight = uint64(0)
-	case "dwn.v1.EventVaultKeysRotated.block_height":
+	case "dwn.v1.EventKeyRotation.old_key_version":
+		x.OldKeyVersion = uint64(0)
+	case "dwn.v1.EventKeyRotation.new_key_version":
+		x.NewKeyVersion = uint64(0)
+	case "dwn.v1.EventKeyRotation.reason":
+		x.Reason = ""
+	case "dwn.v1.EventKeyRotation.block_height":
 		x.BlockHeight = uint64(0)
+	case "dwn.v1.EventKeyRotation.single_node_mode":
+		x.SingleNodeMode = false
+	case "dwn.v1.EventKeyRotation.validator_count":
+		x.ValidatorCount = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4132,28 +5788,31 @@ func (x *fastReflection_EventVaultKeysRotated) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventVaultKeysRotated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventKeyRotation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.EventVaultKeysRotated.vault_id":
-		value := x.VaultId
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventVaultKeysRotated.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventVaultKeysRotated.new_public_key":
-		value := x.NewPublicKey
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventVaultKeysRotated.rotation_height":
-		value := x.RotationHeight
+	case "dwn.v1.EventKeyRotation.old_key_version":
+		value := x.OldKeyVersion
 		return protoreflect.ValueOfUint64(value)
-	case "dwn.v1.EventVaultKeysRotated.block_height":
+	case "dwn.v1.EventKeyRotation.new_key_version":
+		value := x.NewKeyVersion
+		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.EventKeyRotation.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventKeyRotation.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.EventKeyRotation.single_node_mode":
+		value := x.SingleNodeMode
+		return protoreflect.ValueOfBool(value)
+	case "dwn.v1.EventKeyRotation.validator_count":
+		value := x.ValidatorCount
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4167,23 +5826,25 @@ func (x *fastReflection_EventVaultKeysRotated) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultKeysRotated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventKeyRotation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultKeysRotated.vault_id":
-		x.VaultId = value.Interface().(string)
-	case "dwn.v1.EventVaultKeysRotated.owner":
-		x.Owner = value.Interface().(string)
-	case "dwn.v1.EventVaultKeysRotated.new_public_key":
-		x.NewPublicKey = value.Interface().(string)
-	case "dwn.v1.EventVaultKeysRotated.rotation_height":
-		x.RotationHeight = value.Uint()
-	case "dwn.v1.EventVaultKeysRotated.block_height":
+	case "dwn.v1.EventKeyRotation.old_key_version":
+		x.OldKeyVersion = value.Uint()
+	case "dwn.v1.EventKeyRotation.new_key_version":
+		x.NewKeyVersion = value.Uint()
+	case "dwn.v1.EventKeyRotation.reason":
+		x.Reason = value.Interface().(string)
+	case "dwn.v1.EventKeyRotation.block_height":
 		x.BlockHeight = value.Uint()
+	case "dwn.v1.EventKeyRotation.single_node_mode":
+		x.SingleNodeMode = value.Bool()
+	case "dwn.v1.EventKeyRotation.validator_count":
+		x.ValidatorCount = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4197,56 +5858,60 @@ func (x *fastReflection_EventVaultKeysRotated) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultKeysRotated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventKeyRotation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultKeysRotated.vault_id":
-		panic(fmt.Errorf("field vault_id of message dwn.v1.EventVaultKeysRotated is not mutable"))
-	case "dwn.v1.EventVaultKeysRotated.owner":
-		panic(fmt.Errorf("field owner of message dwn.v1.EventVaultKeysRotated is not mutable"))
-	case "dwn.v1.EventVaultKeysRotated.new_public_key":
-		panic(fmt.Errorf("field new_public_key of message dwn.v1.EventVaultKeysRotated is not mutable"))
-	case "dwn.v1.EventVaultKeysRotated.rotation_height":
-		panic(fmt.Errorf("field rotation_height of message dwn.v1.EventVaultKeysRotated is not mutable"))
-	case "dwn.v1.EventVaultKeysRotated.block_height":
-		panic(fmt.Errorf("field block_height of message dwn.v1.EventVaultKeysRotated is not mutable"))
+	case "dwn.v1.EventKeyRotation.old_key_version":
+		panic(fmt.Errorf("field old_key_version of message dwn.v1.EventKeyRotation is not mutable"))
+	case "dwn.v1.EventKeyRotation.new_key_version":
+		panic(fmt.Errorf("field new_key_version of message dwn.v1.EventKeyRotation is not mutable"))
+	case "dwn.v1.EventKeyRotation.reason":
+		panic(fmt.Errorf("field reason of message dwn.v1.EventKeyRotation is not mutable"))
+	case "dwn.v1.EventKeyRotation.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventKeyRotation is not mutable"))
+	case "dwn.v1.EventKeyRotation.single_node_mode":
+		panic(fmt.Errorf("field single_node_mode of message dwn.v1.EventKeyRotation is not mutable"))
+	case "dwn.v1.EventKeyRotation.validator_count":
+		panic(fmt.Errorf("field validator_count of message dwn.v1.EventKeyRotation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventVaultKeysRotated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventKeyRotation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventVaultKeysRotated.vault_id":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventVaultKeysRotated.owner":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventVaultKeysRotated.new_public_key":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventVaultKeysRotated.rotation_height":
+	case "dwn.v1.EventKeyRotation.old_key_version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "dwn.v1.EventVaultKeysRotated.block_height":
+	case "dwn.v1.EventKeyRotation.new_key_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.EventKeyRotation.reason":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventKeyRotation.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.EventKeyRotation.single_node_mode":
+		return protoreflect.ValueOfBool(false)
+	case "dwn.v1.EventKeyRotation.validator_count":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventVaultKeysRotated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventVaultKeysRotated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventVaultKeysRotated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventKeyRotation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventVaultKeysRotated", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventKeyRotation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4254,7 +5919,7 @@ func (x *fastReflection_EventVaultKeysRotated) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventVaultKeysRotated) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventKeyRotation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4265,7 +5930,7 @@ func (x *fastReflection_EventVaultKeysRotated) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventVaultKeysRotated) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventKeyRotation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4277,7 +5942,7 @@ func (x *fastReflection_EventVaultKeysRotated) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventVaultKeysRotated) IsValid() bool {
+func (x *fastReflection_EventKeyRotation) IsValid() bool {
 	return x != nil
 }
 
@@ -4287,9 +5952,9 @@ func (x *fastReflection_EventVaultKeysRotated) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventVaultKeysRotated)
+		x := input.Message.Interface().(*EventKeyRotation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4301,24 +5966,25 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.VaultId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OldKeyVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldKeyVersion))
 		}
-		l = len(x.Owner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.NewKeyVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewKeyVersion))
 		}
-		l = len(x.NewPublicKey)
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RotationHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.RotationHeight))
-		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
+		if x.SingleNodeMode {
+			n += 2
+		}
+		if x.ValidatorCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorCount))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4329,7 +5995,7 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventVaultKeysRotated)
+		x := input.Message.Interface().(*EventKeyRotation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4348,36 +6014,42 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+		if x.ValidatorCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SingleNodeMode {
+			i--
+			if x.SingleNodeMode {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 			i--
 			dAtA[i] = 0x28
 		}
-		if x.RotationHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RotationHeight))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.NewPublicKey) > 0 {
-			i -= len(x.NewPublicKey)
-			copy(dAtA[i:], x.NewPublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewPublicKey)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+		if x.NewKeyVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewKeyVersion))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.VaultId) > 0 {
-			i -= len(x.VaultId)
-			copy(dAtA[i:], x.VaultId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultId)))
+		if x.OldKeyVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldKeyVersion))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4390,7 +6062,7 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventVaultKeysRotated)
+		x := input.Message.Interface().(*EventKeyRotation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4422,17 +6094,17 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultKeysRotated: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventKeyRotation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVaultKeysRotated: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldKeyVersion", wireType)
 				}
-				var stringLen uint64
+				x.OldKeyVersion = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4442,29 +6114,16 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OldKeyVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VaultId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewKeyVersion", wireType)
 				}
-				var stringLen uint64
+				x.NewKeyVersion = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4474,27 +6133,14 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.NewKeyVersion |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4522,13 +6168,13 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewPublicKey = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RotationHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				x.RotationHeight = 0
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4538,16 +6184,16 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RotationHeight |= uint64(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingleNodeMode", wireType)
 				}
-				x.BlockHeight = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4557,7 +6203,27 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SingleNodeMode = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+				}
+				x.ValidatorCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorCount |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4598,36 +6264,36 @@ func (x *fastReflection_EventVaultKeysRotated) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventKeyRotation                  protoreflect.MessageDescriptor
-	fd_EventKeyRotation_old_key_version  protoreflect.FieldDescriptor
-	fd_EventKeyRotation_new_key_version  protoreflect.FieldDescriptor
-	fd_EventKeyRotation_reason           protoreflect.FieldDescriptor
-	fd_EventKeyRotation_block_height     protoreflect.FieldDescriptor
-	fd_EventKeyRotation_single_node_mode protoreflect.FieldDescriptor
-	fd_EventKeyRotation_validator_count  protoreflect.FieldDescriptor
+	md_EventStoragePurchased              protoreflect.MessageDescriptor
+	fd_EventStoragePurchased_did          protoreflect.FieldDescriptor
+	fd_EventStoragePurchased_buyer        protoreflect.FieldDescriptor
+	fd_EventStoragePurchased_added_bytes  protoreflect.FieldDescriptor
+	fd_EventStoragePurchased_quota_bytes  protoreflect.FieldDescriptor
+	fd_EventStoragePurchased_cost         protoreflect.FieldDescriptor
+	fd_EventStoragePurchased_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_events_proto_init()
-	md_EventKeyRotation = File_dwn_v1_events_proto.Messages().ByName("EventKeyRotation")
-	fd_EventKeyRotation_old_key_version = md_EventKeyRotation.Fields().ByName("old_key_version")
-	fd_EventKeyRotation_new_key_version = md_EventKeyRotation.Fields().ByName("new_key_version")
-	fd_EventKeyRotation_reason = md_EventKeyRotation.Fields().ByName("reason")
-	fd_EventKeyRotation_block_height = md_EventKeyRotation.Fields().ByName("block_height")
-	fd_EventKeyRotation_single_node_mode = md_EventKeyRotation.Fields().ByName("single_node_mode")
-	fd_EventKeyRotation_validator_count = md_EventKeyRotation.Fields().ByName("validator_count")
+	md_EventStoragePurchased = File_dwn_v1_events_proto.Messages().ByName("EventStoragePurchased")
+	fd_EventStoragePurchased_did = md_EventStoragePurchased.Fields().ByName("did")
+	fd_EventStoragePurchased_buyer = md_EventStoragePurchased.Fields().ByName("buyer")
+	fd_EventStoragePurchased_added_bytes = md_EventStoragePurchased.Fields().ByName("added_bytes")
+	fd_EventStoragePurchased_quota_bytes = md_EventStoragePurchased.Fields().ByName("quota_bytes")
+	fd_EventStoragePurchased_cost = md_EventStoragePurchased.Fields().ByName("cost")
+	fd_EventStoragePurchased_block_height = md_EventStoragePurchased.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventKeyRotation)(nil)
+var _ protoreflect.Message = (*fastReflection_EventStoragePurchased)(nil)
 
-type fastReflection_EventKeyRotation EventKeyRotation
+type fastReflection_EventStoragePurchased EventStoragePurchased
 
-func (x *EventKeyRotation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventKeyRotation)(x)
+func (x *EventStoragePurchased) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventStoragePurchased)(x)
 }
 
-func (x *EventKeyRotation) slowProtoReflect() protoreflect.Message {
-	mi := &file_dwn_v1_events_proto_msgTypes[7]
+func (x *EventStoragePurchased) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4638,43 +6304,43 @@ func (x *EventKeyRotation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventKeyRotation_messageType fastReflection_EventKeyRotation_messageType
-var _ protoreflect.MessageType = fastReflection_EventKeyRotation_messageType{}
+var _fastReflection_EventStoragePurchased_messageType fastReflection_EventStoragePurchased_messageType
+var _ protoreflect.MessageType = fastReflection_EventStoragePurchased_messageType{}
 
-type fastReflection_EventKeyRotation_messageType struct{}
+type fastReflection_EventStoragePurchased_messageType struct{}
 
-func (x fastReflection_EventKeyRotation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventKeyRotation)(nil)
+func (x fastReflection_EventStoragePurchased_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventStoragePurchased)(nil)
 }
-func (x fastReflection_EventKeyRotation_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventKeyRotation)
+func (x fastReflection_EventStoragePurchased_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventStoragePurchased)
 }
-func (x fastReflection_EventKeyRotation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventKeyRotation
+func (x fastReflection_EventStoragePurchased_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventStoragePurchased
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventKeyRotation) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventKeyRotation
+func (x *fastReflection_EventStoragePurchased) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventStoragePurchased
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventKeyRotation) Type() protoreflect.MessageType {
-	return _fastReflection_EventKeyRotation_messageType
+func (x *fastReflection_EventStoragePurchased) Type() protoreflect.MessageType {
+	return _fastReflection_EventStoragePurchased_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventKeyRotation) New() protoreflect.Message {
-	return new(fastReflection_EventKeyRotation)
+func (x *fastReflection_EventStoragePurchased) New() protoreflect.Message {
+	return new(fastReflection_EventStoragePurchased)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventKeyRotation) Interface() protoreflect.ProtoMessage {
-	return (*EventKeyRotation)(x)
+func (x *fastReflection_EventStoragePurchased) Interface() protoreflect.ProtoMessage {
+	return (*EventStoragePurchased)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4682,40 +6348,40 @@ func (x *fastReflection_EventKeyRotation) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventKeyRotation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OldKeyVersion != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OldKeyVersion)
-		if !f(fd_EventKeyRotation_old_key_version, value) {
+func (x *fastReflection_EventStoragePurchased) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_EventStoragePurchased_did, value) {
 			return
 		}
 	}
-	if x.NewKeyVersion != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NewKeyVersion)
-		if !f(fd_EventKeyRotation_new_key_version, value) {
+	if x.Buyer != "" {
+		value := protoreflect.ValueOfString(x.Buyer)
+		if !f(fd_EventStoragePurchased_buyer, value) {
 			return
 		}
 	}
-	if x.Reason != "" {
-		value := protoreflect.ValueOfString(x.Reason)
-		if !f(fd_EventKeyRotation_reason, value) {
+	if x.AddedBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AddedBytes)
+		if !f(fd_EventStoragePurchased_added_bytes, value) {
 			return
 		}
 	}
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_EventKeyRotation_block_height, value) {
+	if x.QuotaBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QuotaBytes)
+		if !f(fd_EventStoragePurchased_quota_bytes, value) {
 			return
 		}
 	}
-	if x.SingleNodeMode != false {
-		value := protoreflect.ValueOfBool(x.SingleNodeMode)
-		if !f(fd_EventKeyRotation_single_node_mode, value) {
+	if x.Cost != "" {
+		value := protoreflect.ValueOfString(x.Cost)
+		if !f(fd_EventStoragePurchased_cost, value) {
 			return
 		}
 	}
-	if x.ValidatorCount != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ValidatorCount)
-		if !f(fd_EventKeyRotation_validator_count, value) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_EventStoragePurchased_block_height, value) {
 			return
 		}
 	}
@@ -4732,25 +6398,25 @@ func (x *fastReflection_EventKeyRotation) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventKeyRotation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventStoragePurchased) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.EventKeyRotation.old_key_version":
-		return x.OldKeyVersion != uint64(0)
-	case "dwn.v1.EventKeyRotation.new_key_version":
-		return x.NewKeyVersion != uint64(0)
-	case "dwn.v1.EventKeyRotation.reason":
-		return x.Reason != ""
-	case "dwn.v1.EventKeyRotation.block_height":
+	case "dwn.v1.EventStoragePurchased.did":
+		return x.Did != ""
+	case "dwn.v1.EventStoragePurchased.buyer":
+		return x.Buyer != ""
+	case "dwn.v1.EventStoragePurchased.added_bytes":
+		return x.AddedBytes != uint64(0)
+	case "dwn.v1.EventStoragePurchased.quota_bytes":
+		return x.QuotaBytes != uint64(0)
+	case "dwn.v1.EventStoragePurchased.cost":
+		return x.Cost != ""
+	case "dwn.v1.EventStoragePurchased.block_height":
 		return x.BlockHeight != uint64(0)
-	case "dwn.v1.EventKeyRotation.single_node_mode":
-		return x.SingleNodeMode != false
-	case "dwn.v1.EventKeyRotation.validator_count":
-		return x.ValidatorCount != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventStoragePurchased"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventStoragePurchased does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4760,25 +6426,25 @@ func (x *fastReflection_EventKeyRotation) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventKeyRotation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventStoragePurchased) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.EventKeyRotation.old_key_version":
-		x.OldKeyVersion = uint64(0)
-	case "dwn.v1.EventKeyRotation.new_key_version":
-		x.NewKeyVersion = uint64(0)
-	case "dwn.v1.EventKeyRotation.reason":
-		x.Reason = ""
-	case "dwn.v1.EventKeyRotation.block_height":
+	case "dwn.v1.EventStoragePurchased.did":
+		x.Did = ""
+	case "dwn.v1.EventStoragePurchased.buyer":
+		x.Buyer = ""
+	case "dwn.v1.EventStoragePurchased.added_bytes":
+		x.AddedBytes = uint64(0)
+	case "dwn.v1.EventStoragePurchased.quota_bytes":
+		x.QuotaBytes = uint64(0)
+	case "dwn.v1.EventStoragePurchased.cost":
+		x.Cost = ""
+	case "dwn.v1.EventStoragePurchased.block_height":
 		x.BlockHeight = uint64(0)
-	case "dwn.v1.EventKeyRotation.single_node_mode":
-		x.SingleNodeMode = false
-	case "dwn.v1.EventKeyRotation.validator_count":
-		x.ValidatorCount = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventStoragePurchased"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventStoragePurchased does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4788,31 +6454,31 @@ func (x *fastReflection_EventKeyRotation) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventKeyRotation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventStoragePurchased) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.EventKeyRotation.old_key_version":
-		value := x.OldKeyVersion
+	case "dwn.v1.EventStoragePurchased.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventStoragePurchased.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.EventStoragePurchased.added_bytes":
+		value := x.AddedBytes
 		return protoreflect.ValueOfUint64(value)
-	case "dwn.v1.EventKeyRotation.new_key_version":
-		value := x.NewKeyVersion
+	case "dwn.v1.EventStoragePurchased.quota_bytes":
+		value := x.QuotaBytes
 		return protoreflect.ValueOfUint64(value)
-	case "dwn.v1.EventKeyRotation.reason":
-		value := x.Reason
+	case "dwn.v1.EventStoragePurchased.cost":
+		value := x.Cost
 		return protoreflect.ValueOfString(value)
-	case "dwn.v1.EventKeyRotation.block_height":
+	case "dwn.v1.EventStoragePurchased.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
-	case "dwn.v1.EventKeyRotation.single_node_mode":
-		value := x.SingleNodeMode
-		return protoreflect.ValueOfBool(value)
-	case "dwn.v1.EventKeyRotation.validator_count":
-		value := x.ValidatorCount
-		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventStoragePurchased"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventStoragePurchased does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4826,25 +6492,25 @@ func (x *fastReflection_EventKeyRotation) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventKeyRotation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventStoragePurchased) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.EventKeyRotation.old_key_version":
-		x.OldKeyVersion = value.Uint()
-	case "dwn.v1.EventKeyRotation.new_key_version":
-		x.NewKeyVersion = value.Uint()
-	case "dwn.v1.EventKeyRotation.reason":
-		x.Reason = value.Interface().(string)
-	case "dwn.v1.EventKeyRotation.block_height":
+	case "dwn.v1.EventStoragePurchased.did":
+		x.Did = value.Interface().(string)
+	case "dwn.v1.EventStoragePurchased.buyer":
+		x.Buyer = value.Interface().(string)
+	case "dwn.v1.EventStoragePurchased.added_bytes":
+		x.AddedBytes = value.Uint()
+	case "dwn.v1.EventStoragePurchased.quota_bytes":
+		x.QuotaBytes = value.Uint()
+	case "dwn.v1.EventStoragePurchased.cost":
+		x.Cost = value.Interface().(string)
+	case "dwn.v1.EventStoragePurchased.block_height":
 		x.BlockHeight = value.Uint()
-	case "dwn.v1.EventKeyRotation.single_node_mode":
-		x.SingleNodeMode = value.Bool()
-	case "dwn.v1.EventKeyRotation.validator_count":
-		x.ValidatorCount = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventStoragePurchased"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventStoragePurchased does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4858,60 +6524,60 @@ func (x *fastReflection_EventKeyRotation) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventKeyRotation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventStoragePurchased) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventKeyRotation.old_key_version":
-		panic(fmt.Errorf("field old_key_version of message dwn.v1.EventKeyRotation is not mutable"))
-	case "dwn.v1.EventKeyRotation.new_key_version":
-		panic(fmt.Errorf("field new_key_version of message dwn.v1.EventKeyRotation is not mutable"))
-	case "dwn.v1.EventKeyRotation.reason":
-		panic(fmt.Errorf("field reason of message dwn.v1.EventKeyRotation is not mutable"))
-	case "dwn.v1.EventKeyRotation.block_height":
-		panic(fmt.Errorf("field block_height of message dwn.v1.EventKeyRotation is not mutable"))
-	case "dwn.v1.EventKeyRotation.single_node_mode":
-		panic(fmt.Errorf("field single_node_mode of message dwn.v1.EventKeyRotation is not mutable"))
-	case "dwn.v1.EventKeyRotation.validator_count":
-		panic(fmt.Errorf("field validator_count of message dwn.v1.EventKeyRotation is not mutable"))
+	case "dwn.v1.EventStoragePurchased.did":
+		panic(fmt.Errorf("field did of message dwn.v1.EventStoragePurchased is not mutable"))
+	case "dwn.v1.EventStoragePurchased.buyer":
+		panic(fmt.Errorf("field buyer of message dwn.v1.EventStoragePurchased is not mutable"))
+	case "dwn.v1.EventStoragePurchased.added_bytes":
+		panic(fmt.Errorf("field added_bytes of message dwn.v1.EventStoragePurchased is not mutable"))
+	case "dwn.v1.EventStoragePurchased.quota_bytes":
+		panic(fmt.Errorf("field quota_bytes of message dwn.v1.EventStoragePurchased is not mutable"))
+	case "dwn.v1.EventStoragePurchased.cost":
+		panic(fmt.Errorf("field cost of message dwn.v1.EventStoragePurchased is not mutable"))
+	case "dwn.v1.EventStoragePurchased.block_height":
+		panic(fmt.Errorf("field block_height of message dwn.v1.EventStoragePurchased is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventStoragePurchased"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventStoragePurchased does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventKeyRotation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventStoragePurchased) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.EventKeyRotation.old_key_version":
+	case "dwn.v1.EventStoragePurchased.did":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventStoragePurchased.buyer":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.EventStoragePurchased.added_bytes":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "dwn.v1.EventKeyRotation.new_key_version":
+	case "dwn.v1.EventStoragePurchased.quota_bytes":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "dwn.v1.EventKeyRotation.reason":
+	case "dwn.v1.EventStoragePurchased.cost":
 		return protoreflect.ValueOfString("")
-	case "dwn.v1.EventKeyRotation.block_height":
+	case "dwn.v1.EventStoragePurchased.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "dwn.v1.EventKeyRotation.single_node_mode":
-		return protoreflect.ValueOfBool(false)
-	case "dwn.v1.EventKeyRotation.validator_count":
-		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventKeyRotation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.EventStoragePurchased"))
 		}
-		panic(fmt.Errorf("message dwn.v1.EventKeyRotation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.EventStoragePurchased does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventKeyRotation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventStoragePurchased) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventKeyRotation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.EventStoragePurchased", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4919,7 +6585,7 @@ func (x *fastReflection_EventKeyRotation) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventKeyRotation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventStoragePurchased) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4930,7 +6596,7 @@ func (x *fastReflection_EventKeyRotation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventKeyRotation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventStoragePurchased) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4942,7 +6608,7 @@ func (x *fastReflection_EventKeyRotation) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventKeyRotation) IsValid() bool {
+func (x *fastReflection_EventStoragePurchased) IsValid() bool {
 	return x != nil
 }
 
@@ -4952,9 +6618,9 @@ func (x *fastReflection_EventKeyRotation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventStoragePurchased) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventKeyRotation)
+		x := input.Message.Interface().(*EventStoragePurchased)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4966,25 +6632,27 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.OldKeyVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.OldKeyVersion))
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.NewKeyVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.NewKeyVersion))
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Reason)
+		if x.AddedBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedBytes))
+		}
+		if x.QuotaBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.QuotaBytes))
+		}
+		l = len(x.Cost)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.SingleNodeMode {
-			n += 2
-		}
-		if x.ValidatorCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ValidatorCount))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4995,7 +6663,7 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventKeyRotation)
+		x := input.Message.Interface().(*EventStoragePurchased)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5014,42 +6682,41 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ValidatorCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorCount))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
 			dAtA[i] = 0x30
 		}
-		if x.SingleNodeMode {
-			i--
-			if x.SingleNodeMode {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.Cost) > 0 {
+			i -= len(x.Cost)
+			copy(dAtA[i:], x.Cost)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cost)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x2a
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+		if x.QuotaBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QuotaBytes))
 			i--
 			dAtA[i] = 0x20
 		}
-		if len(x.Reason) > 0 {
-			i -= len(x.Reason)
-			copy(dAtA[i:], x.Reason)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+		if x.AddedBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedBytes))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if x.NewKeyVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewKeyVersion))
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.OldKeyVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldKeyVersion))
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5062,7 +6729,7 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventKeyRotation)
+		x := input.Message.Interface().(*EventStoragePurchased)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5094,17 +6761,17 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventKeyRotation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventStoragePurchased: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventStoragePurchased: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldKeyVersion", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 				}
-				x.OldKeyVersion = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5114,33 +6781,27 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OldKeyVersion |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewKeyVersion", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.NewKeyVersion = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.NewKeyVersion |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 3:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5168,13 +6829,32 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reason = string(dAtA[iNdEx:postIndex])
+				x.Buyer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedBytes", wireType)
+				}
+				x.AddedBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddedBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuotaBytes", wireType)
 				}
-				x.BlockHeight = 0
+				x.QuotaBytes = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5184,16 +6864,16 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
+					x.QuotaBytes |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingleNodeMode", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5203,17 +6883,29 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.SingleNodeMode = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cost = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				x.ValidatorCount = 0
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5223,7 +6915,7 @@ func (x *fastReflection_EventKeyRotation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ValidatorCount |= uint32(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -5298,6 +6990,10 @@ type EventRecordWritten struct {
 	Encrypted bool `protobuf:"varint,7,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
 	// Block height
 	BlockHeight uint64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Expiry timestamp (Unix timestamp), zero if the record does not expire
+	ExpiresAt int64 `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Attachment CIDs the rewrite dropped that no record references any more
+	ReleasedCids []string `protobuf:"bytes,10,rep,name=released_cids,json=releasedCids,proto3" json:"released_cids,omitempty"`
 }
 
 func (x *EventRecordWritten) Reset() {
@@ -5376,6 +7072,20 @@ func (x *EventRecordWritten) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (x *EventRecordWritten) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *EventRecordWritten) GetReleasedCids() []string {
+	if x != nil {
+		return x.ReleasedCids
+	}
+	return nil
+}
+
 // EventRecordDeleted is emitted when a record is deleted from DWN
 type EventRecordDeleted struct {
 	state         protoimpl.MessageState
@@ -5390,6 +7100,8 @@ type EventRecordDeleted struct {
 	Deleter string `protobuf:"bytes,3,opt,name=deleter,proto3" json:"deleter,omitempty"`
 	// Block height
 	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Attachment CIDs no longer referenced by any record
+	ReleasedCids []string `protobuf:"bytes,5,rep,name=released_cids,json=releasedCids,proto3" json:"released_cids,omitempty"`
 }
 
 func (x *EventRecordDeleted) Reset() {
@@ -5440,6 +7152,86 @@ func (x *EventRecordDeleted) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (x *EventRecordDeleted) GetReleasedCids() []string {
+	if x != nil {
+		return x.ReleasedCids
+	}
+	return nil
+}
+
+// EventRecordExpired is emitted when an expired record is swept from DWN
+type EventRecordExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Record ID
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// Target DID
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Expiry timestamp (Unix timestamp)
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Attachment CIDs no longer referenced by any record
+	ReleasedCids []string `protobuf:"bytes,4,rep,name=released_cids,json=releasedCids,proto3" json:"released_cids,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventRecordExpired) Reset() {
+	*x = EventRecordExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dwn_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRecordExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRecordExpired) ProtoMessage() {}
+
+// Deprecated: Use EventRecordExpired.ProtoReflect.Descriptor instead.
+func (*EventRecordExpired) Descriptor() ([]byte, []int) {
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventRecordExpired) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *EventRecordExpired) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *EventRecordExpired) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *EventRecordExpired) GetReleasedCids() []string {
+	if x != nil {
+		return x.ReleasedCids
+	}
+	return nil
+}
+
+func (x *EventRecordExpired) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
 // EventProtocolConfigured is emitted when a protocol is configured
 type EventProtocolConfigured struct {
 	state         protoimpl.MessageState
@@ -5459,7 +7251,7 @@ type EventProtocolConfigured struct {
 func (x *EventProtocolConfigured) Reset() {
 	*x = EventProtocolConfigured{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_events_proto_msgTypes[2]
+		mi := &file_dwn_v1_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5473,7 +7265,7 @@ func (*EventProtocolConfigured) ProtoMessage() {}
 
 // Deprecated: Use EventProtocolConfigured.ProtoReflect.Descriptor instead.
 func (*EventProtocolConfigured) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_events_proto_rawDescGZIP(), []int{2}
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EventProtocolConfigured) GetTarget() string {
@@ -5529,7 +7321,7 @@ type EventPermissionGranted struct {
 func (x *EventPermissionGranted) Reset() {
 	*x = EventPermissionGranted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_events_proto_msgTypes[3]
+		mi := &file_dwn_v1_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5543,7 +7335,7 @@ func (*EventPermissionGranted) ProtoMessage() {}
 
 // Deprecated: Use EventPermissionGranted.ProtoReflect.Descriptor instead.
 func (*EventPermissionGranted) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_events_proto_rawDescGZIP(), []int{3}
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EventPermissionGranted) GetPermissionId() string {
@@ -5612,7 +7404,7 @@ type EventPermissionRevoked struct {
 func (x *EventPermissionRevoked) Reset() {
 	*x = EventPermissionRevoked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_events_proto_msgTypes[4]
+		mi := &file_dwn_v1_events_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5626,7 +7418,7 @@ func (*EventPermissionRevoked) ProtoMessage() {}
 
 // Deprecated: Use EventPermissionRevoked.ProtoReflect.Descriptor instead.
 func (*EventPermissionRevoked) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_events_proto_rawDescGZIP(), []int{4}
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventPermissionRevoked) GetPermissionId() string {
@@ -5669,7 +7461,7 @@ type EventVaultCreated struct {
 func (x *EventVaultCreated) Reset() {
 	*x = EventVaultCreated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_events_proto_msgTypes[5]
+		mi := &file_dwn_v1_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5683,7 +7475,7 @@ func (*EventVaultCreated) ProtoMessage() {}
 
 // Deprecated: Use EventVaultCreated.ProtoReflect.Descriptor instead.
 func (*EventVaultCreated) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_events_proto_rawDescGZIP(), []int{5}
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventVaultCreated) GetVaultId() string {
@@ -5735,7 +7527,7 @@ type EventVaultKeysRotated struct {
 func (x *EventVaultKeysRotated) Reset() {
 	*x = EventVaultKeysRotated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_events_proto_msgTypes[6]
+		mi := &file_dwn_v1_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5749,7 +7541,7 @@ func (*EventVaultKeysRotated) ProtoMessage() {}
 
 // Deprecated: Use EventVaultKeysRotated.ProtoReflect.Descriptor instead.
 func (*EventVaultKeysRotated) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_events_proto_rawDescGZIP(), []int{6}
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventVaultKeysRotated) GetVaultId() string {
@@ -5810,7 +7602,7 @@ type EventKeyRotation struct {
 func (x *EventKeyRotation) Reset() {
 	*x = EventKeyRotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_events_proto_msgTypes[7]
+		mi := &file_dwn_v1_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5824,7 +7616,7 @@ func (*EventKeyRotation) ProtoMessage() {}
 
 // Deprecated: Use EventKeyRotation.ProtoReflect.Descriptor instead.
 func (*EventKeyRotation) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_events_proto_rawDescGZIP(), []int{7}
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EventKeyRotation) GetOldKeyVersion() uint64 {
@@ -5869,6 +7661,88 @@ func (x *EventKeyRotation) GetValidatorCount() uint32 {
 	return 0
 }
 
+// EventStoragePurchased is emitted when quota is purchased for a DID
+type EventStoragePurchased struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID receiving the quota
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Account that paid
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// Quota added in bytes
+	AddedBytes uint64 `protobuf:"varint,3,opt,name=added_bytes,json=addedBytes,proto3" json:"added_bytes,omitempty"`
+	// Total quota after the purchase in bytes
+	QuotaBytes uint64 `protobuf:"varint,4,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// Amount charged
+	Cost string `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *EventStoragePurchased) Reset() {
+	*x = EventStoragePurchased{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dwn_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStoragePurchased) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStoragePurchased) ProtoMessage() {}
+
+// Deprecated: Use EventStoragePurchased.ProtoReflect.Descriptor instead.
+func (*EventStoragePurchased) Descriptor() ([]byte, []int) {
+	return file_dwn_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventStoragePurchased) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *EventStoragePurchased) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *EventStoragePurchased) GetAddedBytes() uint64 {
+	if x != nil {
+		return x.AddedBytes
+	}
+	return 0
+}
+
+func (x *EventStoragePurchased) GetQuotaBytes() uint64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *EventStoragePurchased) GetCost() string {
+	if x != nil {
+		return x.Cost
+	}
+	return ""
+}
+
+func (x *EventStoragePurchased) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
 var File_dwn_v1_events_proto protoreflect.FileDescriptor
 
 var file_dwn_v1_events_proto_rawDesc = []byte{
@@ -5877,7 +7751,7 @@ var file_dwn_v1_events_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x63, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72,
 	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
@@ -5892,95 +7766,124 @@ var file_dwn_v1_events_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
 	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x01,
-	0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x72, 0x69, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x98,
-	0x02, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x7a, 0x0a, 0x16, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xba,
-	0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4b, 0x65, 0x79,
-	0x73, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
-	0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x10,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x4b, 0x65,
-	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f,
-	0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x7c,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x77, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f,
-	0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x77, 0x6e, 0x2f, 0x76, 0x31, 0x3b,
-	0x64, 0x77, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x77,
-	0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x77, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12,
-	0x44, 0x77, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x77, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x69, 0x64,
+	0x73, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x22,
+	0xb0, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x98, 0x02, 0x0a, 0x16, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x43, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x7a, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e,
+	0x65, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
+	0x6f, 0x6c, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e,
+	0x65, 0x77, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x7c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x77, 0x6e,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x64, 0x77, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x77, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44,
+	0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x77, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x77,
+	0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x77, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x77, 0x6e, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5995,25 +7898,27 @@ func file_dwn_v1_events_proto_rawDescGZIP() []byte {
 	return file_dwn_v1_events_proto_rawDescData
 }
 
-var file_dwn_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_dwn_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_dwn_v1_events_proto_goTypes = []interface{}{
 	(*EventRecordWritten)(nil),      // 0: dwn.v1.EventRecordWritten
 	(*EventRecordDeleted)(nil),      // 1: dwn.v1.EventRecordDeleted
-	(*EventProtocolConfigured)(nil), // 2: dwn.v1.EventProtocolConfigured
-	(*EventPermissionGranted)(nil),  // 3: dwn.v1.EventPermissionGranted
-	(*EventPermissionRevoked)(nil),  // 4: dwn.v1.EventPermissionRevoked
-	(*EventVaultCreated)(nil),       // 5: dwn.v1.EventVaultCreated
-	(*EventVaultKeysRotated)(nil),   // 6: dwn.v1.EventVaultKeysRotated
-	(*EventKeyRotation)(nil),        // 7: dwn.v1.EventKeyRotation
-	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+	(*EventRecordExpired)(nil),      // 2: dwn.v1.EventRecordExpired
+	(*EventProtocolConfigured)(nil), // 3: dwn.v1.EventProtocolConfigured
+	(*EventPermissionGranted)(nil),  // 4: dwn.v1.EventPermissionGranted
+	(*EventPermissionRevoked)(nil),  // 5: dwn.v1.EventPermissionRevoked
+	(*EventVaultCreated)(nil),       // 6: dwn.v1.EventVaultCreated
+	(*EventVaultKeysRotated)(nil),   // 7: dwn.v1.EventVaultKeysRotated
+	(*EventKeyRotation)(nil),        // 8: dwn.v1.EventKeyRotation
+	(*EventStoragePurchased)(nil),   // 9: dwn.v1.EventStoragePurchased
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
 }
 var file_dwn_v1_events_proto_depIdxs = []int32{
-	8, // 0: dwn.v1.EventPermissionGranted.expires_at:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: dwn.v1.EventPermissionGranted.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // [1:1] is the sub-list for method output_type
+	1,  // [1:1] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_dwn_v1_events_proto_init() }
@@ -6047,7 +7952,7 @@ func file_dwn_v1_events_proto_init() {
 			}
 		}
 		file_dwn_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventProtocolConfigured); i {
+			switch v := v.(*EventRecordExpired); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6059,7 +7964,7 @@ func file_dwn_v1_events_proto_init() {
 			}
 		}
 		file_dwn_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPermissionGranted); i {
+			switch v := v.(*EventProtocolConfigured); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6071,7 +7976,7 @@ func file_dwn_v1_events_proto_init() {
 			}
 		}
 		file_dwn_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPermissionRevoked); i {
+			switch v := v.(*EventPermissionGranted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6083,7 +7988,7 @@ func file_dwn_v1_events_proto_init() {
 			}
 		}
 		file_dwn_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventVaultCreated); i {
+			switch v := v.(*EventPermissionRevoked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6095,7 +8000,7 @@ func file_dwn_v1_events_proto_init() {
 			}
 		}
 		file_dwn_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventVaultKeysRotated); i {
+			switch v := v.(*EventVaultCreated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6107,6 +8012,18 @@ func file_dwn_v1_events_proto_init() {
 			}
 		}
 		file_dwn_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVaultKeysRotated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dwn_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventKeyRotation); i {
 			case 0:
 				return &v.state
@@ -6118,6 +8035,18 @@ func file_dwn_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_dwn_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStoragePurchased); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -6125,7 +8054,7 @@ func file_dwn_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dwn_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,7 @@ package dwnv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -218,13 +219,65 @@ func (x *_GenesisState_5_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*StorageAccount
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageAccount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageAccount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(StorageAccount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(StorageAccount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState             protoreflect.MessageDescriptor
-	fd_GenesisState_params      protoreflect.FieldDescriptor
-	fd_GenesisState_records     protoreflect.FieldDescriptor
-	fd_GenesisState_protocols   protoreflect.FieldDescriptor
-	fd_GenesisState_permissions protoreflect.FieldDescriptor
-	fd_GenesisState_vaults      protoreflect.FieldDescriptor
+	md_GenesisState                  protoreflect.MessageDescriptor
+	fd_GenesisState_params           protoreflect.FieldDescriptor
+	fd_GenesisState_records          protoreflect.FieldDescriptor
+	fd_GenesisState_protocols        protoreflect.FieldDescriptor
+	fd_GenesisState_permissions      protoreflect.FieldDescriptor
+	fd_GenesisState_vaults           protoreflect.FieldDescriptor
+	fd_GenesisState_storage_accounts protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -235,6 +288,7 @@ func init() {
 	fd_GenesisState_protocols = md_GenesisState.Fields().ByName("protocols")
 	fd_GenesisState_permissions = md_GenesisState.Fields().ByName("permissions")
 	fd_GenesisState_vaults = md_GenesisState.Fields().ByName("vaults")
+	fd_GenesisState_storage_accounts = md_GenesisState.Fields().ByName("storage_accounts")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -332,6 +386,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.StorageAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.StorageAccounts})
+		if !f(fd_GenesisState_storage_accounts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -357,6 +417,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Permissions) != 0
 	case "dwn.v1.GenesisState.vaults":
 		return len(x.Vaults) != 0
+	case "dwn.v1.GenesisState.storage_accounts":
+		return len(x.StorageAccounts) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.GenesisState"))
@@ -383,6 +445,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Permissions = nil
 	case "dwn.v1.GenesisState.vaults":
 		x.Vaults = nil
+	case "dwn.v1.GenesisState.storage_accounts":
+		x.StorageAccounts = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.GenesisState"))
@@ -426,6 +490,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_5_list{list: &x.Vaults}
 		return protoreflect.ValueOfList(listValue)
+	case "dwn.v1.GenesisState.storage_accounts":
+		if len(x.StorageAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.StorageAccounts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.GenesisState"))
@@ -464,6 +534,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_5_list)
 		x.Vaults = *clv.list
+	case "dwn.v1.GenesisState.storage_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.StorageAccounts = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.GenesisState"))
@@ -513,6 +587,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_5_list{list: &x.Vaults}
 		return protoreflect.ValueOfList(value)
+	case "dwn.v1.GenesisState.storage_accounts":
+		if x.StorageAccounts == nil {
+			x.StorageAccounts = []*StorageAccount{}
+		}
+		value := &_GenesisState_6_list{list: &x.StorageAccounts}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.GenesisState"))
@@ -541,6 +621,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "dwn.v1.GenesisState.vaults":
 		list := []*VaultState{}
 		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "dwn.v1.GenesisState.storage_accounts":
+		list := []*StorageAccount{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.GenesisState"))
@@ -638,6 +721,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.StorageAccounts) > 0 {
+			for _, e := range x.StorageAccounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -667,6 +756,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.StorageAccounts) > 0 {
+			for iNdEx := len(x.StorageAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StorageAccounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.Vaults) > 0 {
 			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Vaults[iNdEx])
@@ -966,6 +1071,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageAccounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageAccounts = append(x.StorageAccounts, &StorageAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StorageAccounts[len(x.StorageAccounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1106,6 +1245,7 @@ var (
 	fd_Params_encrypted_protocols        protoreflect.FieldDescriptor
 	fd_Params_encrypted_schemas          protoreflect.FieldDescriptor
 	fd_Params_single_node_fallback       protoreflect.FieldDescriptor
+	fd_Params_storage                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1122,6 +1262,7 @@ func init() {
 	fd_Params_encrypted_protocols = md_Params.Fields().ByName("encrypted_protocols")
 	fd_Params_encrypted_schemas = md_Params.Fields().ByName("encrypted_schemas")
 	fd_Params_single_node_fallback = md_Params.Fields().ByName("single_node_fallback")
+	fd_Params_storage = md_Params.Fields().ByName("storage")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1255,6 +1396,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Storage != nil {
+		value := protoreflect.ValueOfMessage(x.Storage.ProtoReflect())
+		if !f(fd_Params_storage, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1292,6 +1439,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.EncryptedSchemas) != 0
 	case "dwn.v1.Params.single_node_fallback":
 		return x.SingleNodeFallback != false
+	case "dwn.v1.Params.storage":
+		return x.Storage != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.Params"))
@@ -1330,6 +1479,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.EncryptedSchemas = nil
 	case "dwn.v1.Params.single_node_fallback":
 		x.SingleNodeFallback = false
+	case "dwn.v1.Params.storage":
+		x.Storage = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.Params"))
@@ -1385,6 +1536,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "dwn.v1.Params.single_node_fallback":
 		value := x.SingleNodeFallback
 		return protoreflect.ValueOfBool(value)
+	case "dwn.v1.Params.storage":
+		value := x.Storage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.Params"))
@@ -1431,6 +1585,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.EncryptedSchemas = *clv.list
 	case "dwn.v1.Params.single_node_fallback":
 		x.SingleNodeFallback = value.Bool()
+	case "dwn.v1.Params.storage":
+		x.Storage = value.Message().Interface().(*StorageParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.Params"))
@@ -1463,6 +1619,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_10_list{list: &x.EncryptedSchemas}
 		return protoreflect.ValueOfList(value)
+	case "dwn.v1.Params.storage":
+		if x.Storage == nil {
+			x.Storage = new(StorageParams)
+		}
+		return protoreflect.ValueOfMessage(x.Storage.ProtoReflect())
 	case "dwn.v1.Params.max_record_size":
 		panic(fmt.Errorf("field max_record_size of message dwn.v1.Params is not mutable"))
 	case "dwn.v1.Params.max_protocols_per_dwn":
@@ -1518,6 +1679,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
 	case "dwn.v1.Params.single_node_fallback":
 		return protoreflect.ValueOfBool(false)
+	case "dwn.v1.Params.storage":
+		m := new(StorageParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.Params"))
@@ -1626,6 +1790,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SingleNodeFallback {
 			n += 2
 		}
+		if x.Storage != nil {
+			l = options.Size(x.Storage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1655,6 +1823,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Storage != nil {
+			encoded, err := options.Marshal(x.Storage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
 		if x.SingleNodeFallback {
 			i--
 			if x.SingleNodeFallback {
@@ -2020,6 +2202,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.SingleNodeFallback = bool(v != 0)
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Storage == nil {
+					x.Storage = &StorageParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Storage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2056,31 +2274,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_IPFSStatus           protoreflect.MessageDescriptor
-	fd_IPFSStatus_peer_id   protoreflect.FieldDescriptor
-	fd_IPFSStatus_peer_name protoreflect.FieldDescriptor
-	fd_IPFSStatus_peer_type protoreflect.FieldDescriptor
-	fd_IPFSStatus_version   protoreflect.FieldDescriptor
+	md_StorageParams                  protoreflect.MessageDescriptor
+	fd_StorageParams_free_quota_bytes protoreflect.FieldDescriptor
+	fd_StorageParams_price_per_mib    protoreflect.FieldDescriptor
+	fd_StorageParams_max_purchase_mib protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dwn_v1_genesis_proto_init()
-	md_IPFSStatus = File_dwn_v1_genesis_proto.Messages().ByName("IPFSStatus")
-	fd_IPFSStatus_peer_id = md_IPFSStatus.Fields().ByName("peer_id")
-	fd_IPFSStatus_peer_name = md_IPFSStatus.Fields().ByName("peer_name")
-	fd_IPFSStatus_peer_type = md_IPFSStatus.Fields().ByName("peer_type")
-	fd_IPFSStatus_version = md_IPFSStatus.Fields().ByName("version")
+	md_StorageParams = File_dwn_v1_genesis_proto.Messages().ByName("StorageParams")
+	fd_StorageParams_free_quota_bytes = md_StorageParams.Fields().ByName("free_quota_bytes")
+	fd_StorageParams_price_per_mib = md_StorageParams.Fields().ByName("price_per_mib")
+	fd_StorageParams_max_purchase_mib = md_StorageParams.Fields().ByName("max_purchase_mib")
 }
 
-var _ protoreflect.Message = (*fastReflection_IPFSStatus)(nil)
+var _ protoreflect.Message = (*fastReflection_StorageParams)(nil)
 
-type fastReflection_IPFSStatus IPFSStatus
+type fastReflection_StorageParams StorageParams
 
-func (x *IPFSStatus) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IPFSStatus)(x)
+func (x *StorageParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageParams)(x)
 }
 
-func (x *IPFSStatus) slowProtoReflect() protoreflect.Message {
+func (x *StorageParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_dwn_v1_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2092,43 +2308,43 @@ func (x *IPFSStatus) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IPFSStatus_messageType fastReflection_IPFSStatus_messageType
-var _ protoreflect.MessageType = fastReflection_IPFSStatus_messageType{}
+var _fastReflection_StorageParams_messageType fastReflection_StorageParams_messageType
+var _ protoreflect.MessageType = fastReflection_StorageParams_messageType{}
 
-type fastReflection_IPFSStatus_messageType struct{}
+type fastReflection_StorageParams_messageType struct{}
 
-func (x fastReflection_IPFSStatus_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IPFSStatus)(nil)
+func (x fastReflection_StorageParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageParams)(nil)
 }
-func (x fastReflection_IPFSStatus_messageType) New() protoreflect.Message {
-	return new(fastReflection_IPFSStatus)
+func (x fastReflection_StorageParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageParams)
 }
-func (x fastReflection_IPFSStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IPFSStatus
+func (x fastReflection_StorageParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IPFSStatus) Descriptor() protoreflect.MessageDescriptor {
-	return md_IPFSStatus
+func (x *fastReflection_StorageParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IPFSStatus) Type() protoreflect.MessageType {
-	return _fastReflection_IPFSStatus_messageType
+func (x *fastReflection_StorageParams) Type() protoreflect.MessageType {
+	return _fastReflection_StorageParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IPFSStatus) New() protoreflect.Message {
-	return new(fastReflection_IPFSStatus)
+func (x *fastReflection_StorageParams) New() protoreflect.Message {
+	return new(fastReflection_StorageParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IPFSStatus) Interface() protoreflect.ProtoMessage {
-	return (*IPFSStatus)(x)
+func (x *fastReflection_StorageParams) Interface() protoreflect.ProtoMessage {
+	return (*StorageParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2136,28 +2352,22 @@ func (x *fastReflection_IPFSStatus) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IPFSStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PeerId != "" {
-		value := protoreflect.ValueOfString(x.PeerId)
-		if !f(fd_IPFSStatus_peer_id, value) {
-			return
-		}
-	}
-	if x.PeerName != "" {
-		value := protoreflect.ValueOfString(x.PeerName)
-		if !f(fd_IPFSStatus_peer_name, value) {
+func (x *fastReflection_StorageParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FreeQuotaBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FreeQuotaBytes)
+		if !f(fd_StorageParams_free_quota_bytes, value) {
 			return
 		}
 	}
-	if x.PeerType != "" {
-		value := protoreflect.ValueOfString(x.PeerType)
-		if !f(fd_IPFSStatus_peer_type, value) {
+	if x.PricePerMib != nil {
+		value := protoreflect.ValueOfMessage(x.PricePerMib.ProtoReflect())
+		if !f(fd_StorageParams_price_per_mib, value) {
 			return
 		}
 	}
-	if x.Version != "" {
-		value := protoreflect.ValueOfString(x.Version)
-		if !f(fd_IPFSStatus_version, value) {
+	if x.MaxPurchaseMib != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPurchaseMib)
+		if !f(fd_StorageParams_max_purchase_mib, value) {
 			return
 		}
 	}
@@ -2174,21 +2384,19 @@ func (x *fastReflection_IPFSStatus) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IPFSStatus) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StorageParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dwn.v1.IPFSStatus.peer_id":
-		return x.PeerId != ""
-	case "dwn.v1.IPFSStatus.peer_name":
-		return x.PeerName != ""
-	case "dwn.v1.IPFSStatus.peer_type":
-		return x.PeerType != ""
-	case "dwn.v1.IPFSStatus.version":
-		return x.Version != ""
+	case "dwn.v1.StorageParams.free_quota_bytes":
+		return x.FreeQuotaBytes != uint64(0)
+	case "dwn.v1.StorageParams.price_per_mib":
+		return x.PricePerMib != nil
+	case "dwn.v1.StorageParams.max_purchase_mib":
+		return x.MaxPurchaseMib != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageParams"))
 		}
-		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.StorageParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2198,21 +2406,19 @@ func (x *fastReflection_IPFSStatus) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IPFSStatus) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StorageParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dwn.v1.IPFSStatus.peer_id":
-		x.PeerId = ""
-	case "dwn.v1.IPFSStatus.peer_name":
-		x.PeerName = ""
-	case "dwn.v1.IPFSStatus.peer_type":
-		x.PeerType = ""
-	case "dwn.v1.IPFSStatus.version":
-		x.Version = ""
+	case "dwn.v1.StorageParams.free_quota_bytes":
+		x.FreeQuotaBytes = uint64(0)
+	case "dwn.v1.StorageParams.price_per_mib":
+		x.PricePerMib = nil
+	case "dwn.v1.StorageParams.max_purchase_mib":
+		x.MaxPurchaseMib = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageParams"))
 		}
-		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.StorageParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2222,25 +2428,22 @@ func (x *fastReflection_IPFSStatus) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IPFSStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dwn.v1.IPFSStatus.peer_id":
-		value := x.PeerId
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.IPFSStatus.peer_name":
-		value := x.PeerName
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.IPFSStatus.peer_type":
-		value := x.PeerType
-		return protoreflect.ValueOfString(value)
-	case "dwn.v1.IPFSStatus.version":
-		value := x.Version
-		return protoreflect.ValueOfString(value)
+	case "dwn.v1.StorageParams.free_quota_bytes":
+		value := x.FreeQuotaBytes
+		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.StorageParams.price_per_mib":
+		value := x.PricePerMib
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dwn.v1.StorageParams.max_purchase_mib":
+		value := x.MaxPurchaseMib
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageParams"))
 		}
-		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dwn.v1.StorageParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2254,21 +2457,19 @@ func (x *fastReflection_IPFSStatus) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IPFSStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StorageParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dwn.v1.IPFSStatus.peer_id":
-		x.PeerId = value.Interface().(string)
-	case "dwn.v1.IPFSStatus.peer_name":
-		x.PeerName = value.Interface().(string)
-	case "dwn.v1.IPFSStatus.peer_type":
-		x.PeerType = value.Interface().(string)
-	case "dwn.v1.IPFSStatus.version":
-		x.Version = value.Interface().(string)
+	case "dwn.v1.StorageParams.free_quota_bytes":
+		x.FreeQuotaBytes = value.Uint()
+	case "dwn.v1.StorageParams.price_per_mib":
+		x.PricePerMib = value.Message().Interface().(*v1beta1.Coin)
+	case "dwn.v1.StorageParams.max_purchase_mib":
+		x.MaxPurchaseMib = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageParams"))
 		}
-		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.StorageParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2282,32 +2483,1144 @@ func (x *fastReflection_IPFSStatus) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IPFSStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.IPFSStatus.peer_id":
-		panic(fmt.Errorf("field peer_id of message dwn.v1.IPFSStatus is not mutable"))
-	case "dwn.v1.IPFSStatus.peer_name":
-		panic(fmt.Errorf("field peer_name of message dwn.v1.IPFSStatus is not mutable"))
-	case "dwn.v1.IPFSStatus.peer_type":
-		panic(fmt.Errorf("field peer_type of message dwn.v1.IPFSStatus is not mutable"))
-	case "dwn.v1.IPFSStatus.version":
-		panic(fmt.Errorf("field version of message dwn.v1.IPFSStatus is not mutable"))
+	case "dwn.v1.StorageParams.price_per_mib":
+		if x.PricePerMib == nil {
+			x.PricePerMib = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PricePerMib.ProtoReflect())
+	case "dwn.v1.StorageParams.free_quota_bytes":
+		panic(fmt.Errorf("field free_quota_bytes of message dwn.v1.StorageParams is not mutable"))
+	case "dwn.v1.StorageParams.max_purchase_mib":
+		panic(fmt.Errorf("field max_purchase_mib of message dwn.v1.StorageParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageParams"))
 		}
-		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dwn.v1.StorageParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IPFSStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dwn.v1.IPFSStatus.peer_id":
-		return protoreflect.ValueOfString("")
-	case "dwn.v1.IPFSStatus.peer_name":
+	case "dwn.v1.StorageParams.free_quota_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.StorageParams.price_per_mib":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dwn.v1.StorageParams.max_purchase_mib":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageParams"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StorageParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.StorageParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StorageParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StorageParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StorageParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StorageParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FreeQuotaBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.FreeQuotaBytes))
+		}
+		if x.PricePerMib != nil {
+			l = options.Size(x.PricePerMib)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxPurchaseMib != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPurchaseMib))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StorageParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxPurchaseMib != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPurchaseMib))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PricePerMib != nil {
+			encoded, err := options.Marshal(x.PricePerMib)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.FreeQuotaBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FreeQuotaBytes))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StorageParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FreeQuotaBytes", wireType)
+				}
+				x.FreeQuotaBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FreeQuotaBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricePerMib", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PricePerMib == nil {
+					x.PricePerMib = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PricePerMib); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPurchaseMib", wireType)
+				}
+				x.MaxPurchaseMib = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPurchaseMib |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StorageAccount                 protoreflect.MessageDescriptor
+	fd_StorageAccount_did             protoreflect.FieldDescriptor
+	fd_StorageAccount_record_bytes    protoreflect.FieldDescriptor
+	fd_StorageAccount_record_count    protoreflect.FieldDescriptor
+	fd_StorageAccount_purchased_bytes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dwn_v1_genesis_proto_init()
+	md_StorageAccount = File_dwn_v1_genesis_proto.Messages().ByName("StorageAccount")
+	fd_StorageAccount_did = md_StorageAccount.Fields().ByName("did")
+	fd_StorageAccount_record_bytes = md_StorageAccount.Fields().ByName("record_bytes")
+	fd_StorageAccount_record_count = md_StorageAccount.Fields().ByName("record_count")
+	fd_StorageAccount_purchased_bytes = md_StorageAccount.Fields().ByName("purchased_bytes")
+}
+
+var _ protoreflect.Message = (*fastReflection_StorageAccount)(nil)
+
+type fastReflection_StorageAccount StorageAccount
+
+func (x *StorageAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageAccount)(x)
+}
+
+func (x *StorageAccount) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StorageAccount_messageType fastReflection_StorageAccount_messageType
+var _ protoreflect.MessageType = fastReflection_StorageAccount_messageType{}
+
+type fastReflection_StorageAccount_messageType struct{}
+
+func (x fastReflection_StorageAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageAccount)(nil)
+}
+func (x fastReflection_StorageAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageAccount)
+}
+func (x fastReflection_StorageAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageAccount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StorageAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageAccount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StorageAccount) Type() protoreflect.MessageType {
+	return _fastReflection_StorageAccount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StorageAccount) New() protoreflect.Message {
+	return new(fastReflection_StorageAccount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StorageAccount) Interface() protoreflect.ProtoMessage {
+	return (*StorageAccount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StorageAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Did != "" {
+		value := protoreflect.ValueOfString(x.Did)
+		if !f(fd_StorageAccount_did, value) {
+			return
+		}
+	}
+	if x.RecordBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordBytes)
+		if !f(fd_StorageAccount_record_bytes, value) {
+			return
+		}
+	}
+	if x.RecordCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordCount)
+		if !f(fd_StorageAccount_record_count, value) {
+			return
+		}
+	}
+	if x.PurchasedBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PurchasedBytes)
+		if !f(fd_StorageAccount_purchased_bytes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StorageAccount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dwn.v1.StorageAccount.did":
+		return x.Did != ""
+	case "dwn.v1.StorageAccount.record_bytes":
+		return x.RecordBytes != uint64(0)
+	case "dwn.v1.StorageAccount.record_count":
+		return x.RecordCount != uint64(0)
+	case "dwn.v1.StorageAccount.purchased_bytes":
+		return x.PurchasedBytes != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageAccount"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageAccount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dwn.v1.StorageAccount.did":
+		x.Did = ""
+	case "dwn.v1.StorageAccount.record_bytes":
+		x.RecordBytes = uint64(0)
+	case "dwn.v1.StorageAccount.record_count":
+		x.RecordCount = uint64(0)
+	case "dwn.v1.StorageAccount.purchased_bytes":
+		x.PurchasedBytes = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageAccount"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StorageAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dwn.v1.StorageAccount.did":
+		value := x.Did
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.StorageAccount.record_bytes":
+		value := x.RecordBytes
+		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.StorageAccount.record_count":
+		value := x.RecordCount
+		return protoreflect.ValueOfUint64(value)
+	case "dwn.v1.StorageAccount.purchased_bytes":
+		value := x.PurchasedBytes
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageAccount"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageAccount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dwn.v1.StorageAccount.did":
+		x.Did = value.Interface().(string)
+	case "dwn.v1.StorageAccount.record_bytes":
+		x.RecordBytes = value.Uint()
+	case "dwn.v1.StorageAccount.record_count":
+		x.RecordCount = value.Uint()
+	case "dwn.v1.StorageAccount.purchased_bytes":
+		x.PurchasedBytes = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageAccount"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.StorageAccount.did":
+		panic(fmt.Errorf("field did of message dwn.v1.StorageAccount is not mutable"))
+	case "dwn.v1.StorageAccount.record_bytes":
+		panic(fmt.Errorf("field record_bytes of message dwn.v1.StorageAccount is not mutable"))
+	case "dwn.v1.StorageAccount.record_count":
+		panic(fmt.Errorf("field record_count of message dwn.v1.StorageAccount is not mutable"))
+	case "dwn.v1.StorageAccount.purchased_bytes":
+		panic(fmt.Errorf("field purchased_bytes of message dwn.v1.StorageAccount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageAccount"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StorageAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.StorageAccount.did":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.StorageAccount.record_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.StorageAccount.record_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dwn.v1.StorageAccount.purchased_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.StorageAccount"))
+		}
+		panic(fmt.Errorf("message dwn.v1.StorageAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StorageAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dwn.v1.StorageAccount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StorageAccount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageAccount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StorageAccount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StorageAccount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StorageAccount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Did)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecordBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordBytes))
+		}
+		if x.RecordCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordCount))
+		}
+		if x.PurchasedBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.PurchasedBytes))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StorageAccount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PurchasedBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PurchasedBytes))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RecordCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RecordBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordBytes))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Did) > 0 {
+			i -= len(x.Did)
+			copy(dAtA[i:], x.Did)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Did)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StorageAccount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageAccount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Did = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordBytes", wireType)
+				}
+				x.RecordBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+				}
+				x.RecordCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PurchasedBytes", wireType)
+				}
+				x.PurchasedBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PurchasedBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IPFSStatus           protoreflect.MessageDescriptor
+	fd_IPFSStatus_peer_id   protoreflect.FieldDescriptor
+	fd_IPFSStatus_peer_name protoreflect.FieldDescriptor
+	fd_IPFSStatus_peer_type protoreflect.FieldDescriptor
+	fd_IPFSStatus_version   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dwn_v1_genesis_proto_init()
+	md_IPFSStatus = File_dwn_v1_genesis_proto.Messages().ByName("IPFSStatus")
+	fd_IPFSStatus_peer_id = md_IPFSStatus.Fields().ByName("peer_id")
+	fd_IPFSStatus_peer_name = md_IPFSStatus.Fields().ByName("peer_name")
+	fd_IPFSStatus_peer_type = md_IPFSStatus.Fields().ByName("peer_type")
+	fd_IPFSStatus_version = md_IPFSStatus.Fields().ByName("version")
+}
+
+var _ protoreflect.Message = (*fastReflection_IPFSStatus)(nil)
+
+type fastReflection_IPFSStatus IPFSStatus
+
+func (x *IPFSStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IPFSStatus)(x)
+}
+
+func (x *IPFSStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_dwn_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IPFSStatus_messageType fastReflection_IPFSStatus_messageType
+var _ protoreflect.MessageType = fastReflection_IPFSStatus_messageType{}
+
+type fastReflection_IPFSStatus_messageType struct{}
+
+func (x fastReflection_IPFSStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IPFSStatus)(nil)
+}
+func (x fastReflection_IPFSStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_IPFSStatus)
+}
+func (x fastReflection_IPFSStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IPFSStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IPFSStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_IPFSStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IPFSStatus) Type() protoreflect.MessageType {
+	return _fastReflection_IPFSStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IPFSStatus) New() protoreflect.Message {
+	return new(fastReflection_IPFSStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IPFSStatus) Interface() protoreflect.ProtoMessage {
+	return (*IPFSStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IPFSStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PeerId != "" {
+		value := protoreflect.ValueOfString(x.PeerId)
+		if !f(fd_IPFSStatus_peer_id, value) {
+			return
+		}
+	}
+	if x.PeerName != "" {
+		value := protoreflect.ValueOfString(x.PeerName)
+		if !f(fd_IPFSStatus_peer_name, value) {
+			return
+		}
+	}
+	if x.PeerType != "" {
+		value := protoreflect.ValueOfString(x.PeerType)
+		if !f(fd_IPFSStatus_peer_type, value) {
+			return
+		}
+	}
+	if x.Version != "" {
+		value := protoreflect.ValueOfString(x.Version)
+		if !f(fd_IPFSStatus_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IPFSStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dwn.v1.IPFSStatus.peer_id":
+		return x.PeerId != ""
+	case "dwn.v1.IPFSStatus.peer_name":
+		return x.PeerName != ""
+	case "dwn.v1.IPFSStatus.peer_type":
+		return x.PeerType != ""
+	case "dwn.v1.IPFSStatus.version":
+		return x.Version != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+		}
+		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IPFSStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dwn.v1.IPFSStatus.peer_id":
+		x.PeerId = ""
+	case "dwn.v1.IPFSStatus.peer_name":
+		x.PeerName = ""
+	case "dwn.v1.IPFSStatus.peer_type":
+		x.PeerType = ""
+	case "dwn.v1.IPFSStatus.version":
+		x.Version = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+		}
+		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IPFSStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dwn.v1.IPFSStatus.peer_id":
+		value := x.PeerId
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.IPFSStatus.peer_name":
+		value := x.PeerName
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.IPFSStatus.peer_type":
+		value := x.PeerType
+		return protoreflect.ValueOfString(value)
+	case "dwn.v1.IPFSStatus.version":
+		value := x.Version
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+		}
+		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IPFSStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dwn.v1.IPFSStatus.peer_id":
+		x.PeerId = value.Interface().(string)
+	case "dwn.v1.IPFSStatus.peer_name":
+		x.PeerName = value.Interface().(string)
+	case "dwn.v1.IPFSStatus.peer_type":
+		x.PeerType = value.Interface().(string)
+	case "dwn.v1.IPFSStatus.version":
+		x.Version = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+		}
+		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IPFSStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.IPFSStatus.peer_id":
+		panic(fmt.Errorf("field peer_id of message dwn.v1.IPFSStatus is not mutable"))
+	case "dwn.v1.IPFSStatus.peer_name":
+		panic(fmt.Errorf("field peer_name of message dwn.v1.IPFSStatus is not mutable"))
+	case "dwn.v1.IPFSStatus.peer_type":
+		panic(fmt.Errorf("field peer_type of message dwn.v1.IPFSStatus is not mutable"))
+	case "dwn.v1.IPFSStatus.version":
+		panic(fmt.Errorf("field version of message dwn.v1.IPFSStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dwn.v1.IPFSStatus"))
+		}
+		panic(fmt.Errorf("message dwn.v1.IPFSStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IPFSStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dwn.v1.IPFSStatus.peer_id":
+		return protoreflect.ValueOfString("")
+	case "dwn.v1.IPFSStatus.peer_name":
 		return protoreflect.ValueOfString("")
 	case "dwn.v1.IPFSStatus.peer_type":
 		return protoreflect.ValueOfString("")
@@ -2696,6 +4009,8 @@ type GenesisState struct {
 	Permissions []*DWNPermission `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	// Vaults
 	Vaults []*VaultState `protobuf:"bytes,5,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// Metered storage of each DID
+	StorageAccounts []*StorageAccount `protobuf:"bytes,6,rep,name=storage_accounts,json=storageAccounts,proto3" json:"storage_accounts,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -2753,6 +4068,13 @@ func (x *GenesisState) GetVaults() []*VaultState {
 	return nil
 }
 
+func (x *GenesisState) GetStorageAccounts() []*StorageAccount {
+	if x != nil {
+		return x.StorageAccounts
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	state         protoimpl.MessageState
@@ -2781,6 +4103,8 @@ type Params struct {
 	EncryptedSchemas []string `protobuf:"bytes,10,rep,name=encrypted_schemas,json=encryptedSchemas,proto3" json:"encrypted_schemas,omitempty"`
 	// Enable single-node fallback for development
 	SingleNodeFallback bool `protobuf:"varint,11,opt,name=single_node_fallback,json=singleNodeFallback,proto3" json:"single_node_fallback,omitempty"`
+	// Per-DID storage quota and pricing
+	Storage *StorageParams `protobuf:"bytes,12,opt,name=storage,proto3" json:"storage,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2880,6 +4204,135 @@ func (x *Params) GetSingleNodeFallback() bool {
 	return false
 }
 
+func (x *Params) GetStorage() *StorageParams {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+// StorageParams configures the storage quota of each DID. A DID may store
+// free_quota_bytes plus whatever it has purchased, counting both DWN records
+// and pinned IPFS content.
+type StorageParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes every DID may store for free. Zero disables metering, leaving
+	// only the node's upload policy in force.
+	FreeQuotaBytes uint64 `protobuf:"varint,1,opt,name=free_quota_bytes,json=freeQuotaBytes,proto3" json:"free_quota_bytes,omitempty"`
+	// Price of one MiB of additional quota, paid to the fee collector
+	PricePerMib *v1beta1.Coin `protobuf:"bytes,2,opt,name=price_per_mib,json=pricePerMib,proto3" json:"price_per_mib,omitempty"`
+	// Largest purchase accepted in a single message, in MiB
+	MaxPurchaseMib uint64 `protobuf:"varint,3,opt,name=max_purchase_mib,json=maxPurchaseMib,proto3" json:"max_purchase_mib,omitempty"`
+}
+
+func (x *StorageParams) Reset() {
+	*x = StorageParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dwn_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageParams) ProtoMessage() {}
+
+// Deprecated: Use StorageParams.ProtoReflect.Descriptor instead.
+func (*StorageParams) Descriptor() ([]byte, []int) {
+	return file_dwn_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StorageParams) GetFreeQuotaBytes() uint64 {
+	if x != nil {
+		return x.FreeQuotaBytes
+	}
+	return 0
+}
+
+func (x *StorageParams) GetPricePerMib() *v1beta1.Coin {
+	if x != nil {
+		return x.PricePerMib
+	}
+	return nil
+}
+
+func (x *StorageParams) GetMaxPurchaseMib() uint64 {
+	if x != nil {
+		return x.MaxPurchaseMib
+	}
+	return 0
+}
+
+// StorageAccount is the metered storage of a DID
+type StorageAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DID the storage belongs to
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Bytes held in DWN records targeting the DID
+	RecordBytes uint64 `protobuf:"varint,2,opt,name=record_bytes,json=recordBytes,proto3" json:"record_bytes,omitempty"`
+	// Number of DWN records targeting the DID
+	RecordCount uint64 `protobuf:"varint,3,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// Quota purchased on top of the free tier, in bytes
+	PurchasedBytes uint64 `protobuf:"varint,4,opt,name=purchased_bytes,json=purchasedBytes,proto3" json:"purchased_bytes,omitempty"`
+}
+
+func (x *StorageAccount) Reset() {
+	*x = StorageAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dwn_v1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageAccount) ProtoMessage() {}
+
+// Deprecated: Use StorageAccount.ProtoReflect.Descriptor instead.
+func (*StorageAccount) Descriptor() ([]byte, []int) {
+	return file_dwn_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StorageAccount) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *StorageAccount) GetRecordBytes() uint64 {
+	if x != nil {
+		return x.RecordBytes
+	}
+	return 0
+}
+
+func (x *StorageAccount) GetRecordCount() uint64 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *StorageAccount) GetPurchasedBytes() uint64 {
+	if x != nil {
+		return x.PurchasedBytes
+	}
+	return 0
+}
+
 type IPFSStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2894,7 +4347,7 @@ type IPFSStatus struct {
 func (x *IPFSStatus) Reset() {
 	*x = IPFSStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dwn_v1_genesis_proto_msgTypes[2]
+		mi := &file_dwn_v1_genesis_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2908,7 +4361,7 @@ func (*IPFSStatus) ProtoMessage() {}
 
 // Deprecated: Use IPFSStatus.ProtoReflect.Descriptor instead.
 func (*IPFSStatus) Descriptor() ([]byte, []int) {
-	return file_dwn_v1_genesis_proto_rawDescGZIP(), []int{2}
+	return file_dwn_v1_genesis_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IPFSStatus) GetPeerId() string {
@@ -2945,9 +4398,11 @@ var file_dwn_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x64, 0x77, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64, 0x77, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x12, 0x64, 0x77, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x02, 0x0a, 0x0c,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02, 0x0a, 0x0c,
 	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06,
 	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64,
 	0x77, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
@@ -2965,60 +4420,88 @@ var file_dwn_v1_genesis_proto_rawDesc = []byte{
 	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
 	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x77, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56,
 	0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xcd, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61,
-	0x78, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x64, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x44, 0x77, 0x6e, 0x12, 0x35, 0x0a,
-	0x17, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
-	0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65,
-	0x72, 0x44, 0x77, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x69,
-	0x6e, 0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17,
-	0x6d, 0x69, 0x6e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0f, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x79, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x67, 0x65, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x12, 0x2f,
-	0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x14,
-	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x17,
+	0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x77, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x22, 0x84, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x50, 0x65, 0x72, 0x44, 0x77, 0x6e, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x77,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x44, 0x77, 0x6e, 0x12, 0x34, 0x0a,
+	0x16, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6b, 0x65, 0x79, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x66, 0x6f,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x67, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x16, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x46, 0x6f,
+	0x72, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x77, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x17,
 	0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0a, 0x64, 0x77, 0x6e,
-	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x79, 0x0a, 0x0a, 0x49, 0x50, 0x46, 0x53, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x42, 0x7d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x77, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6e,
-	0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x77,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x77, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58,
-	0xaa, 0x02, 0x06, 0x44, 0x77, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x44, 0x77, 0x6e, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x77, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x77, 0x6e, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x65,
+	0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6d, 0x69, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x62, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69, 0x62, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4d,
+	0x69, 0x62, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x0a,
+	0x49, 0x50, 0x46, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x7d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x77, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x64, 0x77, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x77, 0x6e, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x44, 0x77, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x06, 0x44, 0x77, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x44, 0x77, 0x6e, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44,
+	0x77, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3033,27 +4516,33 @@ func file_dwn_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_dwn_v1_genesis_proto_rawDescData
 }
 
-var file_dwn_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dwn_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_dwn_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: dwn.v1.GenesisState
-	(*Params)(nil),        // 1: dwn.v1.Params
-	(*IPFSStatus)(nil),    // 2: dwn.v1.IPFSStatus
-	(*DWNRecord)(nil),     // 3: dwn.v1.DWNRecord
-	(*DWNProtocol)(nil),   // 4: dwn.v1.DWNProtocol
-	(*DWNPermission)(nil), // 5: dwn.v1.DWNPermission
-	(*VaultState)(nil),    // 6: dwn.v1.VaultState
+	(*GenesisState)(nil),   // 0: dwn.v1.GenesisState
+	(*Params)(nil),         // 1: dwn.v1.Params
+	(*StorageParams)(nil),  // 2: dwn.v1.StorageParams
+	(*StorageAccount)(nil), // 3: dwn.v1.StorageAccount
+	(*IPFSStatus)(nil),     // 4: dwn.v1.IPFSStatus
+	(*DWNRecord)(nil),      // 5: dwn.v1.DWNRecord
+	(*DWNProtocol)(nil),    // 6: dwn.v1.DWNProtocol
+	(*DWNPermission)(nil),  // 7: dwn.v1.DWNPermission
+	(*VaultState)(nil),     // 8: dwn.v1.VaultState
+	(*v1beta1.Coin)(nil),   // 9: cosmos.base.v1beta1.Coin
 }
 var file_dwn_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: dwn.v1.GenesisState.params:type_name -> dwn.v1.Params
-	3, // 1: dwn.v1.GenesisState.records:type_name -> dwn.v1.DWNRecord
-	4, // 2: dwn.v1.GenesisState.protocols:type_name -> dwn.v1.DWNProtocol
-	5, // 3: dwn.v1.GenesisState.permissions:type_name -> dwn.v1.DWNPermission
-	6, // 4: dwn.v1.GenesisState.vaults:type_name -> dwn.v1.VaultState
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 1: dwn.v1.GenesisState.records:type_name -> dwn.v1.DWNRecord
+	6, // 2: dwn.v1.GenesisState.protocols:type_name -> dwn.v1.DWNProtocol
+	7, // 3: dwn.v1.GenesisState.permissions:type_name -> dwn.v1.DWNPermission
+	8, // 4: dwn.v1.GenesisState.vaults:type_name -> dwn.v1.VaultState
+	3, // 5: dwn.v1.GenesisState.storage_accounts:type_name -> dwn.v1.StorageAccount
+	2, // 6: dwn.v1.Params.storage:type_name -> dwn.v1.StorageParams
+	9, // 7: dwn.v1.StorageParams.price_per_mib:type_name -> cosmos.base.v1beta1.Coin
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_dwn_v1_genesis_proto_init() }
@@ -3088,6 +4577,30 @@ func file_dwn_v1_genesis_proto_init() {
 			}
 		}
 		file_dwn_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dwn_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dwn_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IPFSStatus); i {
 			case 0:
 				return &v.state
@@ -3106,7 +4619,7 @@ func file_dwn_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dwn_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
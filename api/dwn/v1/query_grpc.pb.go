@@ -32,6 +32,8 @@ const (
 	Query_EncryptedRecord_FullMethodName  = "/dwn.v1.Query/EncryptedRecord"
 	Query_EncryptionStatus_FullMethodName = "/dwn.v1.Query/EncryptionStatus"
 	Query_VRFContributions_FullMethodName = "/dwn.v1.Query/VRFContributions"
+	Query_Storage_FullMethodName          = "/dwn.v1.Query/Storage"
+	Query_StorageAccounts_FullMethodName  = "/dwn.v1.Query/StorageAccounts"
 )
 
 // QueryClient is the client API for Query service.
@@ -69,6 +71,10 @@ type QueryClient interface {
 	EncryptionStatus(ctx context.Context, in *QueryEncryptionStatusRequest, opts ...grpc.CallOption) (*QueryEncryptionStatusResponse, error)
 	// VRFContributions lists VRF contributions for current consensus round
 	VRFContributions(ctx context.Context, in *QueryVRFContributionsRequest, opts ...grpc.CallOption) (*QueryVRFContributionsResponse, error)
+	// Storage queries the metered storage and quota of a DID
+	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageAccounts lists the metered storage of every DID
+	StorageAccounts(ctx context.Context, in *QueryStorageAccountsRequest, opts ...grpc.CallOption) (*QueryStorageAccountsResponse, error)
 }
 
 type queryClient struct {
@@ -196,6 +202,24 @@ func (c *queryClient) VRFContributions(ctx context.Context, in *QueryVRFContribu
 	return out, nil
 }
 
+func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
+	out := new(QueryStorageResponse)
+	err := c.cc.Invoke(ctx, Query_Storage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageAccounts(ctx context.Context, in *QueryStorageAccountsRequest, opts ...grpc.CallOption) (*QueryStorageAccountsResponse, error) {
+	out := new(QueryStorageAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_StorageAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -231,6 +255,10 @@ type QueryServer interface {
 	EncryptionStatus(context.Context, *QueryEncryptionStatusRequest) (*QueryEncryptionStatusResponse, error)
 	// VRFContributions lists VRF contributions for current consensus round
 	VRFContributions(context.Context, *QueryVRFContributionsRequest) (*QueryVRFContributionsResponse, error)
+	// Storage queries the metered storage and quota of a DID
+	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageAccounts lists the metered storage of every DID
+	StorageAccounts(context.Context, *QueryStorageAccountsRequest) (*QueryStorageAccountsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -277,6 +305,12 @@ func (UnimplementedQueryServer) EncryptionStatus(context.Context, *QueryEncrypti
 func (UnimplementedQueryServer) VRFContributions(context.Context, *QueryVRFContributionsRequest) (*QueryVRFContributionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VRFContributions not implemented")
 }
+func (UnimplementedQueryServer) Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+}
+func (UnimplementedQueryServer) StorageAccounts(context.Context, *QueryStorageAccountsRequest) (*QueryStorageAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageAccounts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -524,6 +558,42 @@ func _Query_VRFContributions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Storage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Storage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Storage(ctx, req.(*QueryStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageAccounts(ctx, req.(*QueryStorageAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -583,6 +653,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VRFContributions",
 			Handler:    _Query_VRFContributions_Handler,
 		},
+		{
+			MethodName: "Storage",
+			Handler:    _Query_Storage_Handler,
+		},
+		{
+			MethodName: "StorageAccounts",
+			Handler:    _Query_StorageAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dwn/v1/query.proto",
@@ -26,6 +26,7 @@ const (
 	Msg_PermissionsGrant_FullMethodName   = "/dwn.v1.Msg/PermissionsGrant"
 	Msg_PermissionsRevoke_FullMethodName  = "/dwn.v1.Msg/PermissionsRevoke"
 	Msg_RotateVaultKeys_FullMethodName    = "/dwn.v1.Msg/RotateVaultKeys"
+	Msg_PurchaseStorage_FullMethodName    = "/dwn.v1.Msg/PurchaseStorage"
 )
 
 // MsgClient is the client API for Msg service.
@@ -49,6 +50,8 @@ type MsgClient interface {
 	PermissionsRevoke(ctx context.Context, in *MsgPermissionsRevoke, opts ...grpc.CallOption) (*MsgPermissionsRevokeResponse, error)
 	// DWN Vault Operations
 	RotateVaultKeys(ctx context.Context, in *MsgRotateVaultKeys, opts ...grpc.CallOption) (*MsgRotateVaultKeysResponse, error)
+	// DWN Storage Operations
+	PurchaseStorage(ctx context.Context, in *MsgPurchaseStorage, opts ...grpc.CallOption) (*MsgPurchaseStorageResponse, error)
 }
 
 type msgClient struct {
@@ -122,6 +125,15 @@ func (c *msgClient) RotateVaultKeys(ctx context.Context, in *MsgRotateVaultKeys,
 	return out, nil
 }
 
+func (c *msgClient) PurchaseStorage(ctx context.Context, in *MsgPurchaseStorage, opts ...grpc.CallOption) (*MsgPurchaseStorageResponse, error) {
+	out := new(MsgPurchaseStorageResponse)
+	err := c.cc.Invoke(ctx, Msg_PurchaseStorage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -143,6 +155,8 @@ type MsgServer interface {
 	PermissionsRevoke(context.Context, *MsgPermissionsRevoke) (*MsgPermissionsRevokeResponse, error)
 	// DWN Vault Operations
 	RotateVaultKeys(context.Context, *MsgRotateVaultKeys) (*MsgRotateVaultKeysResponse, error)
+	// DWN Storage Operations
+	PurchaseStorage(context.Context, *MsgPurchaseStorage) (*MsgPurchaseStorageResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -171,6 +185,9 @@ func (UnimplementedMsgServer) PermissionsRevoke(context.Context, *MsgPermissions
 func (UnimplementedMsgServer) RotateVaultKeys(context.Context, *MsgRotateVaultKeys) (*MsgRotateVaultKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateVaultKeys not implemented")
 }
+func (UnimplementedMsgServer) PurchaseStorage(context.Context, *MsgPurchaseStorage) (*MsgPurchaseStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseStorage not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -310,6 +327,24 @@ func _Msg_RotateVaultKeys_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PurchaseStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseStorage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PurchaseStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseStorage(ctx, req.(*MsgPurchaseStorage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -345,6 +380,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RotateVaultKeys",
 			Handler:    _Msg_RotateVaultKeys_Handler,
 		},
+		{
+			MethodName: "PurchaseStorage",
+			Handler:    _Msg_PurchaseStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dwn/v1/tx.proto",
@@ -27,6 +27,20 @@ const (
 	Query_ServiceOIDCDiscovery_FullMethodName = "/svc.v1.Query/ServiceOIDCDiscovery"
 	Query_ServiceOIDCJWKS_FullMethodName      = "/svc.v1.Query/ServiceOIDCJWKS"
 	Query_ServiceOIDCMetadata_FullMethodName  = "/svc.v1.Query/ServiceOIDCMetadata"
+	Query_FeeSponsorship_FullMethodName       = "/svc.v1.Query/FeeSponsorship"
+	Query_FeeSponsorUsage_FullMethodName      = "/svc.v1.Query/FeeSponsorUsage"
+	Query_ExportCredentials_FullMethodName    = "/svc.v1.Query/ExportCredentials"
+	Query_ImportedCredentials_FullMethodName  = "/svc.v1.Query/ImportedCredentials"
+	Query_Badges_FullMethodName               = "/svc.v1.Query/Badges"
+	Query_BadgeIssuers_FullMethodName         = "/svc.v1.Query/BadgeIssuers"
+	Query_AppManifest_FullMethodName          = "/svc.v1.Query/AppManifest"
+	Query_OIDCClient_FullMethodName           = "/svc.v1.Query/OIDCClient"
+	Query_OIDCClients_FullMethodName          = "/svc.v1.Query/OIDCClients"
+	Query_AttestationPolicy_FullMethodName    = "/svc.v1.Query/AttestationPolicy"
+	Query_BackupAnchors_FullMethodName        = "/svc.v1.Query/BackupAnchors"
+	Query_ViewKey_FullMethodName              = "/svc.v1.Query/ViewKey"
+	Query_ViewKeys_FullMethodName             = "/svc.v1.Query/ViewKeys"
+	Query_AuthorizeView_FullMethodName        = "/svc.v1.Query/AuthorizeView"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +68,38 @@ type QueryClient interface {
 	ServiceOIDCJWKS(ctx context.Context, in *QueryServiceOIDCJWKSRequest, opts ...grpc.CallOption) (*QueryServiceOIDCJWKSResponse, error)
 	// ServiceOIDCMetadata queries OIDC metadata for a service
 	ServiceOIDCMetadata(ctx context.Context, in *QueryServiceOIDCMetadataRequest, opts ...grpc.CallOption) (*QueryServiceOIDCMetadataResponse, error)
+	// FeeSponsorship queries a service's fee payer, budget and spend
+	FeeSponsorship(ctx context.Context, in *QueryFeeSponsorshipRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipResponse, error)
+	// FeeSponsorUsage queries the fees a service has paid for each user
+	FeeSponsorUsage(ctx context.Context, in *QueryFeeSponsorUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorUsageResponse, error)
+	// ExportCredentials exports the credential and profile metadata of a
+	// service's users
+	ExportCredentials(ctx context.Context, in *QueryExportCredentialsRequest, opts ...grpc.CallOption) (*QueryExportCredentialsResponse, error)
+	// ImportedCredentials queries the credentials a service imported
+	ImportedCredentials(ctx context.Context, in *QueryImportedCredentialsRequest, opts ...grpc.CallOption) (*QueryImportedCredentialsResponse, error)
+	// Badges queries the badges issued to a service ID or DID
+	Badges(ctx context.Context, in *QueryBadgesRequest, opts ...grpc.CallOption) (*QueryBadgesResponse, error)
+	// BadgeIssuers queries the designated badge issuers
+	BadgeIssuers(ctx context.Context, in *QueryBadgeIssuersRequest, opts ...grpc.CallOption) (*QueryBadgeIssuersResponse, error)
+	// AppManifest queries the app manifest of a service
+	AppManifest(ctx context.Context, in *QueryAppManifestRequest, opts ...grpc.CallOption) (*QueryAppManifestResponse, error)
+	// OIDCClient queries a registered OpenID Connect client
+	OIDCClient(ctx context.Context, in *QueryOIDCClientRequest, opts ...grpc.CallOption) (*QueryOIDCClientResponse, error)
+	// OIDCClients lists the OpenID Connect clients of a service
+	OIDCClients(ctx context.Context, in *QueryOIDCClientsRequest, opts ...grpc.CallOption) (*QueryOIDCClientsResponse, error)
+	// AttestationPolicy queries the authenticator restrictions of a service
+	AttestationPolicy(ctx context.Context, in *QueryAttestationPolicyRequest, opts ...grpc.CallOption) (*QueryAttestationPolicyResponse, error)
+	// BackupAnchors lists the anchored digests of a service's dataset
+	BackupAnchors(ctx context.Context, in *QueryBackupAnchorsRequest, opts ...grpc.CallOption) (*QueryBackupAnchorsResponse, error)
+	// ViewKey queries a view key by ID
+	ViewKey(ctx context.Context, in *QueryViewKeyRequest, opts ...grpc.CallOption) (*QueryViewKeyResponse, error)
+	// ViewKeys lists the view keys minted by a DID, including revoked and
+	// expired ones
+	ViewKeys(ctx context.Context, in *QueryViewKeysRequest, opts ...grpc.CallOption) (*QueryViewKeysResponse, error)
+	// AuthorizeView checks that a grantee's UCAN may read a scope through a
+	// view key. It has no REST route, so the token stays out of URLs and
+	// access logs.
+	AuthorizeView(ctx context.Context, in *QueryAuthorizeViewRequest, opts ...grpc.CallOption) (*QueryAuthorizeViewResponse, error)
 }
 
 type queryClient struct {
@@ -136,6 +182,132 @@ func (c *queryClient) ServiceOIDCMetadata(ctx context.Context, in *QueryServiceO
 	return out, nil
 }
 
+func (c *queryClient) FeeSponsorship(ctx context.Context, in *QueryFeeSponsorshipRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipResponse, error) {
+	out := new(QueryFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Query_FeeSponsorship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSponsorUsage(ctx context.Context, in *QueryFeeSponsorUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorUsageResponse, error) {
+	out := new(QueryFeeSponsorUsageResponse)
+	err := c.cc.Invoke(ctx, Query_FeeSponsorUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExportCredentials(ctx context.Context, in *QueryExportCredentialsRequest, opts ...grpc.CallOption) (*QueryExportCredentialsResponse, error) {
+	out := new(QueryExportCredentialsResponse)
+	err := c.cc.Invoke(ctx, Query_ExportCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ImportedCredentials(ctx context.Context, in *QueryImportedCredentialsRequest, opts ...grpc.CallOption) (*QueryImportedCredentialsResponse, error) {
+	out := new(QueryImportedCredentialsResponse)
+	err := c.cc.Invoke(ctx, Query_ImportedCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Badges(ctx context.Context, in *QueryBadgesRequest, opts ...grpc.CallOption) (*QueryBadgesResponse, error) {
+	out := new(QueryBadgesResponse)
+	err := c.cc.Invoke(ctx, Query_Badges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BadgeIssuers(ctx context.Context, in *QueryBadgeIssuersRequest, opts ...grpc.CallOption) (*QueryBadgeIssuersResponse, error) {
+	out := new(QueryBadgeIssuersResponse)
+	err := c.cc.Invoke(ctx, Query_BadgeIssuers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppManifest(ctx context.Context, in *QueryAppManifestRequest, opts ...grpc.CallOption) (*QueryAppManifestResponse, error) {
+	out := new(QueryAppManifestResponse)
+	err := c.cc.Invoke(ctx, Query_AppManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OIDCClient(ctx context.Context, in *QueryOIDCClientRequest, opts ...grpc.CallOption) (*QueryOIDCClientResponse, error) {
+	out := new(QueryOIDCClientResponse)
+	err := c.cc.Invoke(ctx, Query_OIDCClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OIDCClients(ctx context.Context, in *QueryOIDCClientsRequest, opts ...grpc.CallOption) (*QueryOIDCClientsResponse, error) {
+	out := new(QueryOIDCClientsResponse)
+	err := c.cc.Invoke(ctx, Query_OIDCClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationPolicy(ctx context.Context, in *QueryAttestationPolicyRequest, opts ...grpc.CallOption) (*QueryAttestationPolicyResponse, error) {
+	out := new(QueryAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_AttestationPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BackupAnchors(ctx context.Context, in *QueryBackupAnchorsRequest, opts ...grpc.CallOption) (*QueryBackupAnchorsResponse, error) {
+	out := new(QueryBackupAnchorsResponse)
+	err := c.cc.Invoke(ctx, Query_BackupAnchors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ViewKey(ctx context.Context, in *QueryViewKeyRequest, opts ...grpc.CallOption) (*QueryViewKeyResponse, error) {
+	out := new(QueryViewKeyResponse)
+	err := c.cc.Invoke(ctx, Query_ViewKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ViewKeys(ctx context.Context, in *QueryViewKeysRequest, opts ...grpc.CallOption) (*QueryViewKeysResponse, error) {
+	out := new(QueryViewKeysResponse)
+	err := c.cc.Invoke(ctx, Query_ViewKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizeView(ctx context.Context, in *QueryAuthorizeViewRequest, opts ...grpc.CallOption) (*QueryAuthorizeViewResponse, error) {
+	out := new(QueryAuthorizeViewResponse)
+	err := c.cc.Invoke(ctx, Query_AuthorizeView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -161,6 +333,38 @@ type QueryServer interface {
 	ServiceOIDCJWKS(context.Context, *QueryServiceOIDCJWKSRequest) (*QueryServiceOIDCJWKSResponse, error)
 	// ServiceOIDCMetadata queries OIDC metadata for a service
 	ServiceOIDCMetadata(context.Context, *QueryServiceOIDCMetadataRequest) (*QueryServiceOIDCMetadataResponse, error)
+	// FeeSponsorship queries a service's fee payer, budget and spend
+	FeeSponsorship(context.Context, *QueryFeeSponsorshipRequest) (*QueryFeeSponsorshipResponse, error)
+	// FeeSponsorUsage queries the fees a service has paid for each user
+	FeeSponsorUsage(context.Context, *QueryFeeSponsorUsageRequest) (*QueryFeeSponsorUsageResponse, error)
+	// ExportCredentials exports the credential and profile metadata of a
+	// service's users
+	ExportCredentials(context.Context, *QueryExportCredentialsRequest) (*QueryExportCredentialsResponse, error)
+	// ImportedCredentials queries the credentials a service imported
+	ImportedCredentials(context.Context, *QueryImportedCredentialsRequest) (*QueryImportedCredentialsResponse, error)
+	// Badges queries the badges issued to a service ID or DID
+	Badges(context.Context, *QueryBadgesRequest) (*QueryBadgesResponse, error)
+	// BadgeIssuers queries the designated badge issuers
+	BadgeIssuers(context.Context, *QueryBadgeIssuersRequest) (*QueryBadgeIssuersResponse, error)
+	// AppManifest queries the app manifest of a service
+	AppManifest(context.Context, *QueryAppManifestRequest) (*QueryAppManifestResponse, error)
+	// OIDCClient queries a registered OpenID Connect client
+	OIDCClient(context.Context, *QueryOIDCClientRequest) (*QueryOIDCClientResponse, error)
+	// OIDCClients lists the OpenID Connect clients of a service
+	OIDCClients(context.Context, *QueryOIDCClientsRequest) (*QueryOIDCClientsResponse, error)
+	// AttestationPolicy queries the authenticator restrictions of a service
+	AttestationPolicy(context.Context, *QueryAttestationPolicyRequest) (*QueryAttestationPolicyResponse, error)
+	// BackupAnchors lists the anchored digests of a service's dataset
+	BackupAnchors(context.Context, *QueryBackupAnchorsRequest) (*QueryBackupAnchorsResponse, error)
+	// ViewKey queries a view key by ID
+	ViewKey(context.Context, *QueryViewKeyRequest) (*QueryViewKeyResponse, error)
+	// ViewKeys lists the view keys minted by a DID, including revoked and
+	// expired ones
+	ViewKeys(context.Context, *QueryViewKeysRequest) (*QueryViewKeysResponse, error)
+	// AuthorizeView checks that a grantee's UCAN may read a scope through a
+	// view key. It has no REST route, so the token stays out of URLs and
+	// access logs.
+	AuthorizeView(context.Context, *QueryAuthorizeViewRequest) (*QueryAuthorizeViewResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -192,6 +396,48 @@ func (UnimplementedQueryServer) ServiceOIDCJWKS(context.Context, *QueryServiceOI
 func (UnimplementedQueryServer) ServiceOIDCMetadata(context.Context, *QueryServiceOIDCMetadataRequest) (*QueryServiceOIDCMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCMetadata not implemented")
 }
+func (UnimplementedQueryServer) FeeSponsorship(context.Context, *QueryFeeSponsorshipRequest) (*QueryFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorship not implemented")
+}
+func (UnimplementedQueryServer) FeeSponsorUsage(context.Context, *QueryFeeSponsorUsageRequest) (*QueryFeeSponsorUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorUsage not implemented")
+}
+func (UnimplementedQueryServer) ExportCredentials(context.Context, *QueryExportCredentialsRequest) (*QueryExportCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCredentials not implemented")
+}
+func (UnimplementedQueryServer) ImportedCredentials(context.Context, *QueryImportedCredentialsRequest) (*QueryImportedCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportedCredentials not implemented")
+}
+func (UnimplementedQueryServer) Badges(context.Context, *QueryBadgesRequest) (*QueryBadgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Badges not implemented")
+}
+func (UnimplementedQueryServer) BadgeIssuers(context.Context, *QueryBadgeIssuersRequest) (*QueryBadgeIssuersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BadgeIssuers not implemented")
+}
+func (UnimplementedQueryServer) AppManifest(context.Context, *QueryAppManifestRequest) (*QueryAppManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppManifest not implemented")
+}
+func (UnimplementedQueryServer) OIDCClient(context.Context, *QueryOIDCClientRequest) (*QueryOIDCClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCClient not implemented")
+}
+func (UnimplementedQueryServer) OIDCClients(context.Context, *QueryOIDCClientsRequest) (*QueryOIDCClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCClients not implemented")
+}
+func (UnimplementedQueryServer) AttestationPolicy(context.Context, *QueryAttestationPolicyRequest) (*QueryAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationPolicy not implemented")
+}
+func (UnimplementedQueryServer) BackupAnchors(context.Context, *QueryBackupAnchorsRequest) (*QueryBackupAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupAnchors not implemented")
+}
+func (UnimplementedQueryServer) ViewKey(context.Context, *QueryViewKeyRequest) (*QueryViewKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewKey not implemented")
+}
+func (UnimplementedQueryServer) ViewKeys(context.Context, *QueryViewKeysRequest) (*QueryViewKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewKeys not implemented")
+}
+func (UnimplementedQueryServer) AuthorizeView(context.Context, *QueryAuthorizeViewRequest) (*QueryAuthorizeViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeView not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -349,6 +595,258 @@ func _Query_ServiceOIDCMetadata_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorship(ctx, req.(*QueryFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSponsorUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeSponsorUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorUsage(ctx, req.(*QueryFeeSponsorUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExportCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExportCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExportCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExportCredentials(ctx, req.(*QueryExportCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ImportedCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryImportedCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ImportedCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ImportedCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ImportedCredentials(ctx, req.(*QueryImportedCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Badges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Badges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Badges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Badges(ctx, req.(*QueryBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BadgeIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadgeIssuersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BadgeIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BadgeIssuers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BadgeIssuers(ctx, req.(*QueryBadgeIssuersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AppManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppManifest(ctx, req.(*QueryAppManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOIDCClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OIDCClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OIDCClient(ctx, req.(*QueryOIDCClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OIDCClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOIDCClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OIDCClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OIDCClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OIDCClients(ctx, req.(*QueryOIDCClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttestationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationPolicy(ctx, req.(*QueryAttestationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BackupAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackupAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BackupAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BackupAnchors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackupAnchors(ctx, req.(*QueryBackupAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ViewKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryViewKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ViewKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ViewKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ViewKey(ctx, req.(*QueryViewKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ViewKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryViewKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ViewKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ViewKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ViewKeys(ctx, req.(*QueryViewKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizeView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizeViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizeView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuthorizeView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizeView(ctx, req.(*QueryAuthorizeViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -388,6 +886,62 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ServiceOIDCMetadata",
 			Handler:    _Query_ServiceOIDCMetadata_Handler,
 		},
+		{
+			MethodName: "FeeSponsorship",
+			Handler:    _Query_FeeSponsorship_Handler,
+		},
+		{
+			MethodName: "FeeSponsorUsage",
+			Handler:    _Query_FeeSponsorUsage_Handler,
+		},
+		{
+			MethodName: "ExportCredentials",
+			Handler:    _Query_ExportCredentials_Handler,
+		},
+		{
+			MethodName: "ImportedCredentials",
+			Handler:    _Query_ImportedCredentials_Handler,
+		},
+		{
+			MethodName: "Badges",
+			Handler:    _Query_Badges_Handler,
+		},
+		{
+			MethodName: "BadgeIssuers",
+			Handler:    _Query_BadgeIssuers_Handler,
+		},
+		{
+			MethodName: "AppManifest",
+			Handler:    _Query_AppManifest_Handler,
+		},
+		{
+			MethodName: "OIDCClient",
+			Handler:    _Query_OIDCClient_Handler,
+		},
+		{
+			MethodName: "OIDCClients",
+			Handler:    _Query_OIDCClients_Handler,
+		},
+		{
+			MethodName: "AttestationPolicy",
+			Handler:    _Query_AttestationPolicy_Handler,
+		},
+		{
+			MethodName: "BackupAnchors",
+			Handler:    _Query_BackupAnchors_Handler,
+		},
+		{
+			MethodName: "ViewKey",
+			Handler:    _Query_ViewKey_Handler,
+		},
+		{
+			MethodName: "ViewKeys",
+			Handler:    _Query_ViewKeys_Handler,
+		},
+		{
+			MethodName: "AuthorizeView",
+			Handler:    _Query_AuthorizeView_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "svc/v1/query.proto",
@@ -23,6 +23,25 @@ const (
 	Msg_InitiateDomainVerification_FullMethodName = "/svc.v1.Msg/InitiateDomainVerification"
 	Msg_VerifyDomain_FullMethodName               = "/svc.v1.Msg/VerifyDomain"
 	Msg_RegisterService_FullMethodName            = "/svc.v1.Msg/RegisterService"
+	Msg_ExecOnBehalf_FullMethodName               = "/svc.v1.Msg/ExecOnBehalf"
+	Msg_SetOnBehalfOptOut_FullMethodName          = "/svc.v1.Msg/SetOnBehalfOptOut"
+	Msg_ClearOnBehalfOptOut_FullMethodName        = "/svc.v1.Msg/ClearOnBehalfOptOut"
+	Msg_SetFeeSponsorship_FullMethodName          = "/svc.v1.Msg/SetFeeSponsorship"
+	Msg_ImportCredentials_FullMethodName          = "/svc.v1.Msg/ImportCredentials"
+	Msg_ReregisterCredential_FullMethodName       = "/svc.v1.Msg/ReregisterCredential"
+	Msg_SetBadgeIssuer_FullMethodName             = "/svc.v1.Msg/SetBadgeIssuer"
+	Msg_RemoveBadgeIssuer_FullMethodName          = "/svc.v1.Msg/RemoveBadgeIssuer"
+	Msg_IssueBadge_FullMethodName                 = "/svc.v1.Msg/IssueBadge"
+	Msg_RevokeBadge_FullMethodName                = "/svc.v1.Msg/RevokeBadge"
+	Msg_SetAppManifest_FullMethodName             = "/svc.v1.Msg/SetAppManifest"
+	Msg_RemoveAppManifest_FullMethodName          = "/svc.v1.Msg/RemoveAppManifest"
+	Msg_RegisterOIDCClient_FullMethodName         = "/svc.v1.Msg/RegisterOIDCClient"
+	Msg_RemoveOIDCClient_FullMethodName           = "/svc.v1.Msg/RemoveOIDCClient"
+	Msg_SetAttestationPolicy_FullMethodName       = "/svc.v1.Msg/SetAttestationPolicy"
+	Msg_RemoveAttestationPolicy_FullMethodName    = "/svc.v1.Msg/RemoveAttestationPolicy"
+	Msg_AnchorBackup_FullMethodName               = "/svc.v1.Msg/AnchorBackup"
+	Msg_MintViewKey_FullMethodName                = "/svc.v1.Msg/MintViewKey"
+	Msg_RevokeViewKey_FullMethodName              = "/svc.v1.Msg/RevokeViewKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +63,51 @@ type MsgClient interface {
 	VerifyDomain(ctx context.Context, in *MsgVerifyDomain, opts ...grpc.CallOption) (*MsgVerifyDomainResponse, error)
 	// RegisterService registers a new service with verified domain binding
 	RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error)
+	// ExecOnBehalf executes allow-listed messages as a user, authorized by a
+	// UCAN the user delegated to the submitting service
+	ExecOnBehalf(ctx context.Context, in *MsgExecOnBehalf, opts ...grpc.CallOption) (*MsgExecOnBehalfResponse, error)
+	// SetOnBehalfOptOut refuses delegated execution for a DID
+	SetOnBehalfOptOut(ctx context.Context, in *MsgSetOnBehalfOptOut, opts ...grpc.CallOption) (*MsgSetOnBehalfOptOutResponse, error)
+	// ClearOnBehalfOptOut allows delegated execution for a DID again
+	ClearOnBehalfOptOut(ctx context.Context, in *MsgClearOnBehalfOptOut, opts ...grpc.CallOption) (*MsgClearOnBehalfOptOutResponse, error)
+	// SetFeeSponsorship sets the account and budget a service uses to pay its
+	// users' transaction fees
+	SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorship, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error)
+	// ImportCredentials migrates credentials exported from another relying party
+	// into a service
+	ImportCredentials(ctx context.Context, in *MsgImportCredentials, opts ...grpc.CallOption) (*MsgImportCredentialsResponse, error)
+	// ReregisterCredential links an imported credential to the credential its
+	// user registered with the service
+	ReregisterCredential(ctx context.Context, in *MsgReregisterCredential, opts ...grpc.CallOption) (*MsgReregisterCredentialResponse, error)
+	// SetBadgeIssuer designates an account to issue badges
+	SetBadgeIssuer(ctx context.Context, in *MsgSetBadgeIssuer, opts ...grpc.CallOption) (*MsgSetBadgeIssuerResponse, error)
+	// RemoveBadgeIssuer withdraws an issuer's designation
+	RemoveBadgeIssuer(ctx context.Context, in *MsgRemoveBadgeIssuer, opts ...grpc.CallOption) (*MsgRemoveBadgeIssuerResponse, error)
+	// IssueBadge attests that a service or profile passed a verification
+	IssueBadge(ctx context.Context, in *MsgIssueBadge, opts ...grpc.CallOption) (*MsgIssueBadgeResponse, error)
+	// RevokeBadge withdraws a badge
+	RevokeBadge(ctx context.Context, in *MsgRevokeBadge, opts ...grpc.CallOption) (*MsgRevokeBadgeResponse, error)
+	// SetAppManifest publishes the app metadata of a service
+	SetAppManifest(ctx context.Context, in *MsgSetAppManifest, opts ...grpc.CallOption) (*MsgSetAppManifestResponse, error)
+	// RemoveAppManifest withdraws the app metadata of a service
+	RemoveAppManifest(ctx context.Context, in *MsgRemoveAppManifest, opts ...grpc.CallOption) (*MsgRemoveAppManifestResponse, error)
+	// RegisterOIDCClient registers an OpenID Connect client for a service
+	RegisterOIDCClient(ctx context.Context, in *MsgRegisterOIDCClient, opts ...grpc.CallOption) (*MsgRegisterOIDCClientResponse, error)
+	// RemoveOIDCClient removes an OpenID Connect client of a service
+	RemoveOIDCClient(ctx context.Context, in *MsgRemoveOIDCClient, opts ...grpc.CallOption) (*MsgRemoveOIDCClientResponse, error)
+	// SetAttestationPolicy restricts the authenticators that may register
+	// credentials with a service
+	SetAttestationPolicy(ctx context.Context, in *MsgSetAttestationPolicy, opts ...grpc.CallOption) (*MsgSetAttestationPolicyResponse, error)
+	// RemoveAttestationPolicy lifts the authenticator restrictions of a service
+	RemoveAttestationPolicy(ctx context.Context, in *MsgRemoveAttestationPolicy, opts ...grpc.CallOption) (*MsgRemoveAttestationPolicyResponse, error)
+	// AnchorBackup records the digest of a snapshot of a service's off-chain
+	// dataset
+	AnchorBackup(ctx context.Context, in *MsgAnchorBackup, opts ...grpc.CallOption) (*MsgAnchorBackupResponse, error)
+	// MintViewKey grants a third party read-only access to slices of a DID's
+	// data until the key expires
+	MintViewKey(ctx context.Context, in *MsgMintViewKey, opts ...grpc.CallOption) (*MsgMintViewKeyResponse, error)
+	// RevokeViewKey withdraws a view key before it expires
+	RevokeViewKey(ctx context.Context, in *MsgRevokeViewKey, opts ...grpc.CallOption) (*MsgRevokeViewKeyResponse, error)
 }
 
 type msgClient struct {
@@ -90,6 +154,177 @@ func (c *msgClient) RegisterService(ctx context.Context, in *MsgRegisterService,
 	return out, nil
 }
 
+func (c *msgClient) ExecOnBehalf(ctx context.Context, in *MsgExecOnBehalf, opts ...grpc.CallOption) (*MsgExecOnBehalfResponse, error) {
+	out := new(MsgExecOnBehalfResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecOnBehalf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOnBehalfOptOut(ctx context.Context, in *MsgSetOnBehalfOptOut, opts ...grpc.CallOption) (*MsgSetOnBehalfOptOutResponse, error) {
+	out := new(MsgSetOnBehalfOptOutResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOnBehalfOptOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearOnBehalfOptOut(ctx context.Context, in *MsgClearOnBehalfOptOut, opts ...grpc.CallOption) (*MsgClearOnBehalfOptOutResponse, error) {
+	out := new(MsgClearOnBehalfOptOutResponse)
+	err := c.cc.Invoke(ctx, Msg_ClearOnBehalfOptOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorship, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error) {
+	out := new(MsgSetFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeSponsorship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ImportCredentials(ctx context.Context, in *MsgImportCredentials, opts ...grpc.CallOption) (*MsgImportCredentialsResponse, error) {
+	out := new(MsgImportCredentialsResponse)
+	err := c.cc.Invoke(ctx, Msg_ImportCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReregisterCredential(ctx context.Context, in *MsgReregisterCredential, opts ...grpc.CallOption) (*MsgReregisterCredentialResponse, error) {
+	out := new(MsgReregisterCredentialResponse)
+	err := c.cc.Invoke(ctx, Msg_ReregisterCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBadgeIssuer(ctx context.Context, in *MsgSetBadgeIssuer, opts ...grpc.CallOption) (*MsgSetBadgeIssuerResponse, error) {
+	out := new(MsgSetBadgeIssuerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBadgeIssuer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBadgeIssuer(ctx context.Context, in *MsgRemoveBadgeIssuer, opts ...grpc.CallOption) (*MsgRemoveBadgeIssuerResponse, error) {
+	out := new(MsgRemoveBadgeIssuerResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveBadgeIssuer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IssueBadge(ctx context.Context, in *MsgIssueBadge, opts ...grpc.CallOption) (*MsgIssueBadgeResponse, error) {
+	out := new(MsgIssueBadgeResponse)
+	err := c.cc.Invoke(ctx, Msg_IssueBadge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeBadge(ctx context.Context, in *MsgRevokeBadge, opts ...grpc.CallOption) (*MsgRevokeBadgeResponse, error) {
+	out := new(MsgRevokeBadgeResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeBadge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAppManifest(ctx context.Context, in *MsgSetAppManifest, opts ...grpc.CallOption) (*MsgSetAppManifestResponse, error) {
+	out := new(MsgSetAppManifestResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAppManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAppManifest(ctx context.Context, in *MsgRemoveAppManifest, opts ...grpc.CallOption) (*MsgRemoveAppManifestResponse, error) {
+	out := new(MsgRemoveAppManifestResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAppManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterOIDCClient(ctx context.Context, in *MsgRegisterOIDCClient, opts ...grpc.CallOption) (*MsgRegisterOIDCClientResponse, error) {
+	out := new(MsgRegisterOIDCClientResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterOIDCClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveOIDCClient(ctx context.Context, in *MsgRemoveOIDCClient, opts ...grpc.CallOption) (*MsgRemoveOIDCClientResponse, error) {
+	out := new(MsgRemoveOIDCClientResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveOIDCClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAttestationPolicy(ctx context.Context, in *MsgSetAttestationPolicy, opts ...grpc.CallOption) (*MsgSetAttestationPolicyResponse, error) {
+	out := new(MsgSetAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAttestationPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAttestationPolicy(ctx context.Context, in *MsgRemoveAttestationPolicy, opts ...grpc.CallOption) (*MsgRemoveAttestationPolicyResponse, error) {
+	out := new(MsgRemoveAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAttestationPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AnchorBackup(ctx context.Context, in *MsgAnchorBackup, opts ...grpc.CallOption) (*MsgAnchorBackupResponse, error) {
+	out := new(MsgAnchorBackupResponse)
+	err := c.cc.Invoke(ctx, Msg_AnchorBackup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintViewKey(ctx context.Context, in *MsgMintViewKey, opts ...grpc.CallOption) (*MsgMintViewKeyResponse, error) {
+	out := new(MsgMintViewKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_MintViewKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeViewKey(ctx context.Context, in *MsgRevokeViewKey, opts ...grpc.CallOption) (*MsgRevokeViewKeyResponse, error) {
+	out := new(MsgRevokeViewKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeViewKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -109,6 +344,51 @@ type MsgServer interface {
 	VerifyDomain(context.Context, *MsgVerifyDomain) (*MsgVerifyDomainResponse, error)
 	// RegisterService registers a new service with verified domain binding
 	RegisterService(context.Context, *MsgRegisterService) (*MsgRegisterServiceResponse, error)
+	// ExecOnBehalf executes allow-listed messages as a user, authorized by a
+	// UCAN the user delegated to the submitting service
+	ExecOnBehalf(context.Context, *MsgExecOnBehalf) (*MsgExecOnBehalfResponse, error)
+	// SetOnBehalfOptOut refuses delegated execution for a DID
+	SetOnBehalfOptOut(context.Context, *MsgSetOnBehalfOptOut) (*MsgSetOnBehalfOptOutResponse, error)
+	// ClearOnBehalfOptOut allows delegated execution for a DID again
+	ClearOnBehalfOptOut(context.Context, *MsgClearOnBehalfOptOut) (*MsgClearOnBehalfOptOutResponse, error)
+	// SetFeeSponsorship sets the account and budget a service uses to pay its
+	// users' transaction fees
+	SetFeeSponsorship(context.Context, *MsgSetFeeSponsorship) (*MsgSetFeeSponsorshipResponse, error)
+	// ImportCredentials migrates credentials exported from another relying party
+	// into a service
+	ImportCredentials(context.Context, *MsgImportCredentials) (*MsgImportCredentialsResponse, error)
+	// ReregisterCredential links an imported credential to the credential its
+	// user registered with the service
+	ReregisterCredential(context.Context, *MsgReregisterCredential) (*MsgReregisterCredentialResponse, error)
+	// SetBadgeIssuer designates an account to issue badges
+	SetBadgeIssuer(context.Context, *MsgSetBadgeIssuer) (*MsgSetBadgeIssuerResponse, error)
+	// RemoveBadgeIssuer withdraws an issuer's designation
+	RemoveBadgeIssuer(context.Context, *MsgRemoveBadgeIssuer) (*MsgRemoveBadgeIssuerResponse, error)
+	// IssueBadge attests that a service or profile passed a verification
+	IssueBadge(context.Context, *MsgIssueBadge) (*MsgIssueBadgeResponse, error)
+	// RevokeBadge withdraws a badge
+	RevokeBadge(context.Context, *MsgRevokeBadge) (*MsgRevokeBadgeResponse, error)
+	// SetAppManifest publishes the app metadata of a service
+	SetAppManifest(context.Context, *MsgSetAppManifest) (*MsgSetAppManifestResponse, error)
+	// RemoveAppManifest withdraws the app metadata of a service
+	RemoveAppManifest(context.Context, *MsgRemoveAppManifest) (*MsgRemoveAppManifestResponse, error)
+	// RegisterOIDCClient registers an OpenID Connect client for a service
+	RegisterOIDCClient(context.Context, *MsgRegisterOIDCClient) (*MsgRegisterOIDCClientResponse, error)
+	// RemoveOIDCClient removes an OpenID Connect client of a service
+	RemoveOIDCClient(context.Context, *MsgRemoveOIDCClient) (*MsgRemoveOIDCClientResponse, error)
+	// SetAttestationPolicy restricts the authenticators that may register
+	// credentials with a service
+	SetAttestationPolicy(context.Context, *MsgSetAttestationPolicy) (*MsgSetAttestationPolicyResponse, error)
+	// RemoveAttestationPolicy lifts the authenticator restrictions of a service
+	RemoveAttestationPolicy(context.Context, *MsgRemoveAttestationPolicy) (*MsgRemoveAttestationPolicyResponse, error)
+	// AnchorBackup records the digest of a snapshot of a service's off-chain
+	// dataset
+	AnchorBackup(context.Context, *MsgAnchorBackup) (*MsgAnchorBackupResponse, error)
+	// MintViewKey grants a third party read-only access to slices of a DID's
+	// data until the key expires
+	MintViewKey(context.Context, *MsgMintViewKey) (*MsgMintViewKeyResponse, error)
+	// RevokeViewKey withdraws a view key before it expires
+	RevokeViewKey(context.Context, *MsgRevokeViewKey) (*MsgRevokeViewKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -128,6 +408,63 @@ func (UnimplementedMsgServer) VerifyDomain(context.Context, *MsgVerifyDomain) (*
 func (UnimplementedMsgServer) RegisterService(context.Context, *MsgRegisterService) (*MsgRegisterServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterService not implemented")
 }
+func (UnimplementedMsgServer) ExecOnBehalf(context.Context, *MsgExecOnBehalf) (*MsgExecOnBehalfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecOnBehalf not implemented")
+}
+func (UnimplementedMsgServer) SetOnBehalfOptOut(context.Context, *MsgSetOnBehalfOptOut) (*MsgSetOnBehalfOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnBehalfOptOut not implemented")
+}
+func (UnimplementedMsgServer) ClearOnBehalfOptOut(context.Context, *MsgClearOnBehalfOptOut) (*MsgClearOnBehalfOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearOnBehalfOptOut not implemented")
+}
+func (UnimplementedMsgServer) SetFeeSponsorship(context.Context, *MsgSetFeeSponsorship) (*MsgSetFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSponsorship not implemented")
+}
+func (UnimplementedMsgServer) ImportCredentials(context.Context, *MsgImportCredentials) (*MsgImportCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCredentials not implemented")
+}
+func (UnimplementedMsgServer) ReregisterCredential(context.Context, *MsgReregisterCredential) (*MsgReregisterCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReregisterCredential not implemented")
+}
+func (UnimplementedMsgServer) SetBadgeIssuer(context.Context, *MsgSetBadgeIssuer) (*MsgSetBadgeIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBadgeIssuer not implemented")
+}
+func (UnimplementedMsgServer) RemoveBadgeIssuer(context.Context, *MsgRemoveBadgeIssuer) (*MsgRemoveBadgeIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBadgeIssuer not implemented")
+}
+func (UnimplementedMsgServer) IssueBadge(context.Context, *MsgIssueBadge) (*MsgIssueBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueBadge not implemented")
+}
+func (UnimplementedMsgServer) RevokeBadge(context.Context, *MsgRevokeBadge) (*MsgRevokeBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBadge not implemented")
+}
+func (UnimplementedMsgServer) SetAppManifest(context.Context, *MsgSetAppManifest) (*MsgSetAppManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppManifest not implemented")
+}
+func (UnimplementedMsgServer) RemoveAppManifest(context.Context, *MsgRemoveAppManifest) (*MsgRemoveAppManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppManifest not implemented")
+}
+func (UnimplementedMsgServer) RegisterOIDCClient(context.Context, *MsgRegisterOIDCClient) (*MsgRegisterOIDCClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOIDCClient not implemented")
+}
+func (UnimplementedMsgServer) RemoveOIDCClient(context.Context, *MsgRemoveOIDCClient) (*MsgRemoveOIDCClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOIDCClient not implemented")
+}
+func (UnimplementedMsgServer) SetAttestationPolicy(context.Context, *MsgSetAttestationPolicy) (*MsgSetAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttestationPolicy not implemented")
+}
+func (UnimplementedMsgServer) RemoveAttestationPolicy(context.Context, *MsgRemoveAttestationPolicy) (*MsgRemoveAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestationPolicy not implemented")
+}
+func (UnimplementedMsgServer) AnchorBackup(context.Context, *MsgAnchorBackup) (*MsgAnchorBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorBackup not implemented")
+}
+func (UnimplementedMsgServer) MintViewKey(context.Context, *MsgMintViewKey) (*MsgMintViewKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintViewKey not implemented")
+}
+func (UnimplementedMsgServer) RevokeViewKey(context.Context, *MsgRevokeViewKey) (*MsgRevokeViewKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeViewKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +550,348 @@ func _Msg_RegisterService_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecOnBehalf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecOnBehalf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecOnBehalf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecOnBehalf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecOnBehalf(ctx, req.(*MsgExecOnBehalf))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOnBehalfOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOnBehalfOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOnBehalfOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOnBehalfOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOnBehalfOptOut(ctx, req.(*MsgSetOnBehalfOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearOnBehalfOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearOnBehalfOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearOnBehalfOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClearOnBehalfOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearOnBehalfOptOut(ctx, req.(*MsgClearOnBehalfOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, req.(*MsgSetFeeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ImportCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgImportCredentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ImportCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ImportCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ImportCredentials(ctx, req.(*MsgImportCredentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReregisterCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReregisterCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReregisterCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReregisterCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReregisterCredential(ctx, req.(*MsgReregisterCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBadgeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBadgeIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBadgeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBadgeIssuer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBadgeIssuer(ctx, req.(*MsgSetBadgeIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBadgeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBadgeIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBadgeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveBadgeIssuer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBadgeIssuer(ctx, req.(*MsgRemoveBadgeIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IssueBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueBadge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_IssueBadge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueBadge(ctx, req.(*MsgIssueBadge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBadge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeBadge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBadge(ctx, req.(*MsgRevokeBadge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAppManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAppManifest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAppManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAppManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAppManifest(ctx, req.(*MsgSetAppManifest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAppManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAppManifest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAppManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAppManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAppManifest(ctx, req.(*MsgRemoveAppManifest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterOIDCClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterOIDCClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOIDCClient(ctx, req.(*MsgRegisterOIDCClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOIDCClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveOIDCClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOIDCClient(ctx, req.(*MsgRemoveOIDCClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttestationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAttestationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttestationPolicy(ctx, req.(*MsgSetAttestationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAttestationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAttestationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAttestationPolicy(ctx, req.(*MsgRemoveAttestationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AnchorBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnchorBackup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnchorBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AnchorBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnchorBackup(ctx, req.(*MsgAnchorBackup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintViewKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintViewKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintViewKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MintViewKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintViewKey(ctx, req.(*MsgMintViewKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeViewKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeViewKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeViewKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeViewKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeViewKey(ctx, req.(*MsgRevokeViewKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -236,6 +915,82 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterService",
 			Handler:    _Msg_RegisterService_Handler,
 		},
+		{
+			MethodName: "ExecOnBehalf",
+			Handler:    _Msg_ExecOnBehalf_Handler,
+		},
+		{
+			MethodName: "SetOnBehalfOptOut",
+			Handler:    _Msg_SetOnBehalfOptOut_Handler,
+		},
+		{
+			MethodName: "ClearOnBehalfOptOut",
+			Handler:    _Msg_ClearOnBehalfOptOut_Handler,
+		},
+		{
+			MethodName: "SetFeeSponsorship",
+			Handler:    _Msg_SetFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "ImportCredentials",
+			Handler:    _Msg_ImportCredentials_Handler,
+		},
+		{
+			MethodName: "ReregisterCredential",
+			Handler:    _Msg_ReregisterCredential_Handler,
+		},
+		{
+			MethodName: "SetBadgeIssuer",
+			Handler:    _Msg_SetBadgeIssuer_Handler,
+		},
+		{
+			MethodName: "RemoveBadgeIssuer",
+			Handler:    _Msg_RemoveBadgeIssuer_Handler,
+		},
+		{
+			MethodName: "IssueBadge",
+			Handler:    _Msg_IssueBadge_Handler,
+		},
+		{
+			MethodName: "RevokeBadge",
+			Handler:    _Msg_RevokeBadge_Handler,
+		},
+		{
+			MethodName: "SetAppManifest",
+			Handler:    _Msg_SetAppManifest_Handler,
+		},
+		{
+			MethodName: "RemoveAppManifest",
+			Handler:    _Msg_RemoveAppManifest_Handler,
+		},
+		{
+			MethodName: "RegisterOIDCClient",
+			Handler:    _Msg_RegisterOIDCClient_Handler,
+		},
+		{
+			MethodName: "RemoveOIDCClient",
+			Handler:    _Msg_RemoveOIDCClient_Handler,
+		},
+		{
+			MethodName: "SetAttestationPolicy",
+			Handler:    _Msg_SetAttestationPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveAttestationPolicy",
+			Handler:    _Msg_RemoveAttestationPolicy_Handler,
+		},
+		{
+			MethodName: "AnchorBackup",
+			Handler:    _Msg_AnchorBackup_Handler,
+		},
+		{
+			MethodName: "MintViewKey",
+			Handler:    _Msg_MintViewKey_Handler,
+		},
+		{
+			MethodName: "RevokeViewKey",
+			Handler:    _Msg_RevokeViewKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "svc/v1/tx.proto",
@@ -0,0 +1,100 @@
+package airgap
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// Data and error correction codewords of the version 1-M "HELLO WORLD"
+	// example of ISO/IEC 18004
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	ecc := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	require.Equal(t, ecc, reedSolomonRemainder(data, reedSolomonDivisor(len(ecc))))
+}
+
+func TestFormatBits(t *testing.T) {
+	qr := newQRCode(1)
+	qr.drawFormatBits(0)
+
+	// Level M with mask 0 is 101010000010010, most significant bit first
+	want := "101010000010010"
+	var got strings.Builder
+	for i := 14; i >= 0; i-- {
+		// Bits 0-7 run left from the right edge of row 8, bits 8-14 down column 8
+		var dark bool
+		if i < 8 {
+			dark = qr.Modules[8][qr.Size-1-i]
+		} else {
+			dark = qr.Modules[qr.Size-15+i][8]
+		}
+		if dark {
+			got.WriteByte('1')
+		} else {
+			got.WriteByte('0')
+		}
+	}
+	require.Equal(t, want, got.String())
+}
+
+func TestEncodeQRVersion(t *testing.T) {
+	for _, tc := range []struct {
+		size    int
+		version int
+	}{
+		{1, 1},
+		{14, 1},
+		{15, 2},
+		{DefaultFrameSize + 32, 20},
+		{MaxQRBytes(), 40},
+	} {
+		qr, err := EncodeQR(bytes.Repeat([]byte{'a'}, tc.size))
+		require.NoError(t, err)
+		require.Equal(t, tc.version, qr.Version, "%d bytes", tc.size)
+		require.Len(t, qr.Modules, tc.version*4+17)
+	}
+
+	_, err := EncodeQR(make([]byte, MaxQRBytes()+1))
+	require.ErrorIs(t, err, ErrPayloadTooLarge)
+}
+
+func TestFramesRoundTrip(t *testing.T) {
+	payload := make([]byte, 4000)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	frames, err := SplitFrames(payload, 500)
+	require.NoError(t, err)
+	require.Greater(t, len(frames), 1)
+
+	// Scanned out of order, with a repeat
+	var text strings.Builder
+	for i := len(frames) - 1; i >= 0; i-- {
+		text.WriteString(frames[i].String() + "\n")
+		require.LessOrEqual(t, len(frames[i].Chunk), 500)
+	}
+	text.WriteString(frames[0].String() + "\n")
+
+	read, err := ReadFrames(strings.NewReader(text.String()))
+	require.NoError(t, err)
+	got, err := JoinFrames(read)
+	require.NoError(t, err)
+	require.Equal(t, payload, got)
+
+	_, err = JoinFrames(read[1:len(frames)])
+	require.ErrorIs(t, err, ErrIncompleteFrames)
+
+	other, err := SplitFrames([]byte("another payload"), 500)
+	require.NoError(t, err)
+	_, err = JoinFrames(append(read, other[0]))
+	require.ErrorIs(t, err, ErrInvalidFrame)
+
+	for _, bad := range []string{"tx:1/1:00000000:abc", "snrtx:2/1:00000000:abc", "snrtx:1/1:zz:abc"} {
+		_, err := ParseFrame(bad)
+		require.ErrorIs(t, err, ErrInvalidFrame, bad)
+	}
+}
@@ -0,0 +1,201 @@
+// Package airgap moves transactions between an online machine and an
+// offline signer. Payloads are compressed and split into text frames small
+// enough for one QR code each, so an unsigned transaction can be shown on
+// one screen and scanned by the other machine's camera, and the signed
+// transaction carried back the same way.
+package airgap
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// FramePrefix starts every frame so scanners can tell frames from other codes
+const FramePrefix = "snrtx:"
+
+// DefaultFrameSize is the default length of a frame's payload chunk. Frames of
+// this size encode as version 20 QR codes, which phone cameras read reliably
+// from a laptop screen.
+const DefaultFrameSize = 600
+
+// maxPayloadSize bounds the decompressed payload of a set of frames
+const maxPayloadSize = 4 << 20
+
+var (
+	// ErrInvalidFrame is returned for text that is not a well formed frame
+	ErrInvalidFrame = errors.New("invalid frame")
+
+	// ErrIncompleteFrames is returned when frames of a payload are missing
+	ErrIncompleteFrames = errors.New("incomplete frames")
+)
+
+// Frame is one part of a payload split across QR codes
+type Frame struct {
+	Index  int
+	Total  int
+	Digest string
+	Chunk  string
+}
+
+// String renders the frame as snrtx:<index>/<total>:<digest>:<chunk>
+func (f Frame) String() string {
+	return fmt.Sprintf("%s%d/%d:%s:%s", FramePrefix, f.Index, f.Total, f.Digest, f.Chunk)
+}
+
+// SplitFrames compresses payload and splits it into frames whose chunks are
+// at most frameSize characters long
+func SplitFrames(payload []byte, frameSize int) ([]Frame, error) {
+	if len(payload) == 0 {
+		return nil, errors.New("empty payload")
+	}
+	if frameSize <= 0 {
+		return nil, fmt.Errorf("frame size must be positive, got %d", frameSize)
+	}
+
+	var compressed bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(compressed.Bytes())
+	digest := frameDigest(encoded)
+	total := (len(encoded) + frameSize - 1) / frameSize
+	frames := make([]Frame, 0, total)
+	for i := 0; i < total; i++ {
+		end := min((i+1)*frameSize, len(encoded))
+		frames = append(frames, Frame{
+			Index:  i + 1,
+			Total:  total,
+			Digest: digest,
+			Chunk:  encoded[i*frameSize : end],
+		})
+	}
+	return frames, nil
+}
+
+// ParseFrame parses the text of a scanned frame
+func ParseFrame(s string) (Frame, error) {
+	s = strings.TrimSpace(s)
+	rest, ok := strings.CutPrefix(s, FramePrefix)
+	if !ok {
+		return Frame{}, fmt.Errorf("%w: missing %q prefix", ErrInvalidFrame, FramePrefix)
+	}
+
+	parts := strings.SplitN(rest, ":", 3)
+	if len(parts) != 3 {
+		return Frame{}, fmt.Errorf("%w: expected %s<index>/<total>:<digest>:<data>", ErrInvalidFrame, FramePrefix)
+	}
+	position, digest, chunk := parts[0], parts[1], parts[2]
+
+	index, total, ok := strings.Cut(position, "/")
+	if !ok {
+		return Frame{}, fmt.Errorf("%w: bad position %q", ErrInvalidFrame, position)
+	}
+	f := Frame{Digest: digest, Chunk: chunk}
+	var err error
+	if f.Index, err = strconv.Atoi(index); err != nil {
+		return Frame{}, fmt.Errorf("%w: bad index %q", ErrInvalidFrame, index)
+	}
+	if f.Total, err = strconv.Atoi(total); err != nil {
+		return Frame{}, fmt.Errorf("%w: bad total %q", ErrInvalidFrame, total)
+	}
+	if f.Total < 1 || f.Index < 1 || f.Index > f.Total {
+		return Frame{}, fmt.Errorf("%w: frame %d of %d", ErrInvalidFrame, f.Index, f.Total)
+	}
+	if _, err := hex.DecodeString(digest); err != nil || len(digest) != 8 {
+		return Frame{}, fmt.Errorf("%w: bad digest %q", ErrInvalidFrame, digest)
+	}
+	return f, nil
+}
+
+// ReadFrames parses one frame per line, ignoring blank lines
+func ReadFrames(r io.Reader) ([]Frame, error) {
+	var frames []Frame
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		f, err := ParseFrame(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		frames = append(frames, f)
+	}
+	return frames, scanner.Err()
+}
+
+// JoinFrames reassembles and decompresses the payload of frames given in any
+// order. Repeated frames, which are common when scanning a looping display,
+// are ignored; frames of different payloads are rejected.
+func JoinFrames(frames []Frame) ([]byte, error) {
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("%w: no frames", ErrIncompleteFrames)
+	}
+
+	total, digest := frames[0].Total, frames[0].Digest
+	chunks := make([]string, total)
+	for _, f := range frames {
+		if f.Total != total || f.Digest != digest {
+			return nil, fmt.Errorf("%w: frame %d/%d:%s belongs to another payload than %s",
+				ErrInvalidFrame, f.Index, f.Total, f.Digest, digest)
+		}
+		chunks[f.Index-1] = f.Chunk
+	}
+
+	var missing []string
+	for i, chunk := range chunks {
+		if chunk == "" {
+			missing = append(missing, strconv.Itoa(i+1))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing frame(s) %s of %d", ErrIncompleteFrames, strings.Join(missing, ", "), total)
+	}
+
+	encoded := strings.Join(chunks, "")
+	if frameDigest(encoded) != digest {
+		return nil, fmt.Errorf("%w: digest mismatch", ErrInvalidFrame)
+	}
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFrame, err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFrame, err)
+	}
+	payload, err := io.ReadAll(io.LimitReader(zr, maxPayloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFrame, err)
+	}
+	if len(payload) > maxPayloadSize {
+		return nil, fmt.Errorf("%w: payload exceeds %d bytes", ErrInvalidFrame, maxPayloadSize)
+	}
+	return payload, nil
+}
+
+// frameDigest is the first four bytes of the SHA-256 of the encoded payload,
+// enough to catch mixed up or corrupted frames
+func frameDigest(encoded string) string {
+	sum := sha256.Sum256([]byte(encoded))
+	return hex.EncodeToString(sum[:4])
+}
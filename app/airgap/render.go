@@ -0,0 +1,76 @@
+package airgap
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+)
+
+// quietZone is the light border scanners need around a symbol, in modules
+const quietZone = 4
+
+// dark reports whether the module at x, y is dark, treating the quiet zone
+// around the symbol as light
+func (qr *QRCode) dark(x, y int) bool {
+	x, y = x-quietZone, y-quietZone
+	if x < 0 || y < 0 || x >= qr.Size || y >= qr.Size {
+		return false
+	}
+	return qr.Modules[y][x]
+}
+
+// WriteText draws the symbol with Unicode half blocks, two module rows per
+// line. Terminals usually draw light text on a dark background, so by
+// default light modules are printed as blocks; set invert for terminals with
+// a light background.
+func (qr *QRCode) WriteText(w io.Writer, invert bool) error {
+	blocks := [4]string{"█", "▀", "▄", " "}
+	if invert {
+		blocks = [4]string{" ", "▄", "▀", "█"}
+	}
+
+	width := qr.Size + 2*quietZone
+	var sb strings.Builder
+	for y := 0; y < width; y += 2 {
+		for x := 0; x < width; x++ {
+			top, bottom := qr.dark(x, y), qr.dark(x, y+1)
+			switch {
+			case !top && !bottom:
+				sb.WriteString(blocks[0])
+			case !top:
+				sb.WriteString(blocks[1])
+			case !bottom:
+				sb.WriteString(blocks[2])
+			default:
+				sb.WriteString(blocks[3])
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Image renders the symbol with scale pixels per module
+func (qr *QRCode) Image(scale int) image.Image {
+	scale = max(scale, 1)
+	width := (qr.Size + 2*quietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, width, width))
+	for py := 0; py < width; py++ {
+		for px := 0; px < width; px++ {
+			c := color.Gray{Y: 0xFF}
+			if qr.dark(px/scale, py/scale) {
+				c = color.Gray{Y: 0x00}
+			}
+			img.SetGray(px, py, c)
+		}
+	}
+	return img
+}
+
+// WritePNG encodes the symbol as a PNG with scale pixels per module
+func (qr *QRCode) WritePNG(w io.Writer, scale int) error {
+	return png.Encode(w, qr.Image(scale))
+}
@@ -19,6 +19,7 @@ import (
 //   - Sets up transaction context
 //   - Validates basic transaction properties
 //   - Handles WebAuthn gasless transactions
+//   - Charges service-sponsored fees to the service budget
 //   - Handles gas consumption and fee deduction
 //   - Performs signature verification
 //   - Manages account sequences
@@ -47,6 +48,7 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 
 		// UCAN validation - must come before fee deduction for gasless support
 		NewConditionalUCANDecorator(NewUCANDecorator()),
+		NewOnBehalfDecorator(onBehalfVerifier(options)),
 		evmoscosmosante.NewMinGasPriceDecorator(
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
@@ -58,6 +60,10 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		// Enhanced mode allows true gasless onboarding without pre-existing accounts
 		NewWebAuthnGaslessDecorator(options.AccountKeeper, options.DidKeeper, enhancedGaslessMode),
 
+		// Service-sponsored fees are charged to the service budget before the
+		// payer is debited
+		NewFeeSponsorDecorator(feeSponsorKeeper(options)),
+
 		// Conditional fee deduction - skips fees for gasless WebAuthn and UCAN
 		NewUCANGaslessDecorator(
 			NewConditionalFeeDecorator(ante.NewDeductFeeDecorator(
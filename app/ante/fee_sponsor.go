@@ -0,0 +1,84 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// FeeSponsorKeeper defines the service keeper methods needed to charge
+// sponsored transaction fees to a service's budget
+type FeeSponsorKeeper interface {
+	ChargeSponsoredFee(ctx context.Context, payer, user string, fee sdk.Coins) (bool, error)
+}
+
+// FeeSponsorDecorator charges the fee of a transaction paid by a service's
+// fee payer to that service's budget. A service sponsors a user's transaction
+// by co-signing it and setting itself as fee payer; the fee itself is then
+// deducted from the payer by the regular fee decorator.
+type FeeSponsorDecorator struct {
+	keeper FeeSponsorKeeper
+}
+
+// NewFeeSponsorDecorator creates a new fee sponsorship decorator
+func NewFeeSponsorDecorator(keeper FeeSponsorKeeper) FeeSponsorDecorator {
+	return FeeSponsorDecorator{keeper: keeper}
+}
+
+// AnteHandle rejects sponsored transactions whose service is suspended or
+// whose fee exceeds the remaining budget. Transactions signed only by the fee
+// payer, or paid through a fee grant, are not sponsored.
+func (fsd FeeSponsorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if fsd.keeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) > 0 {
+		return next(ctx, tx, simulate)
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	payer := feeTx.FeePayer()
+	var user []byte
+	for _, signer := range signers {
+		if !bytes.Equal(signer, payer) {
+			user = signer
+			break
+		}
+	}
+	if user == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	sponsored, err := fsd.keeper.ChargeSponsoredFee(
+		ctx,
+		sdk.AccAddress(payer).String(),
+		sdk.AccAddress(user).String(),
+		feeTx.GetFee(),
+	)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "sponsored fee rejected: %s", err)
+	}
+	if sponsored {
+		ctx.Logger().Debug("charged sponsored fee",
+			"payer", sdk.AccAddress(payer).String(),
+			"user", sdk.AccAddress(user).String(),
+			"fee", feeTx.GetFee().String(),
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -83,6 +83,24 @@ type HandlerOptions struct {
 	SvcKeeper interface{} // Will be cast to proper type in decorator
 }
 
+// onBehalfVerifier returns the service keeper as an OnBehalfVerifier, if set
+func onBehalfVerifier(options HandlerOptions) OnBehalfVerifier {
+	verifier, ok := options.SvcKeeper.(OnBehalfVerifier)
+	if !ok {
+		return nil
+	}
+	return verifier
+}
+
+// feeSponsorKeeper returns the service keeper as a FeeSponsorKeeper, if set
+func feeSponsorKeeper(options HandlerOptions) FeeSponsorKeeper {
+	keeper, ok := options.SvcKeeper.(FeeSponsorKeeper)
+	if !ok {
+		return nil
+	}
+	return keeper
+}
+
 // Validate checks if all required keepers and handlers are properly initialized.
 // It ensures that the HandlerOptions struct has all necessary components to
 // process transactions without nil pointer errors.
@@ -0,0 +1,59 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	svctypes "github.com/sonr-io/sonr/x/svc/types"
+)
+
+// OnBehalfVerifier defines the service keeper methods needed to authorize
+// delegated execution of messages on behalf of a user
+type OnBehalfVerifier interface {
+	VerifyOnBehalfExecution(ctx context.Context, msg *svctypes.MsgExecOnBehalf) (bool, error)
+}
+
+// OnBehalfDecorator verifies the full UCAN capability chain for messages a
+// service submits on behalf of a user, before any fees are deducted.
+type OnBehalfDecorator struct {
+	verifier OnBehalfVerifier
+}
+
+// NewOnBehalfDecorator creates a new on-behalf execution decorator
+func NewOnBehalfDecorator(verifier OnBehalfVerifier) OnBehalfDecorator {
+	return OnBehalfDecorator{verifier: verifier}
+}
+
+// AnteHandle rejects MsgExecOnBehalf wrappers whose messages are not
+// allow-listed, target a user who opted out, or lack a valid UCAN chain from
+// that user
+func (obd OnBehalfDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if obd.verifier == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		execMsg, ok := msg.(*svctypes.MsgExecOnBehalf)
+		if !ok {
+			continue
+		}
+
+		delegated, err := obd.verifier.VerifyOnBehalfExecution(ctx, execMsg)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "on-behalf execution rejected: %s", err)
+		}
+		if delegated {
+			ctx.Logger().Debug("verified on-behalf execution",
+				"sender", execMsg.Sender,
+				"service_did", execMsg.ServiceDid,
+				"did", execMsg.Did,
+				"msgs", len(execMsg.Msgs),
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
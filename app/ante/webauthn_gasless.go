@@ -4,8 +4,6 @@ package ante
 
 import (
 	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -202,14 +200,7 @@ func GenerateAddressFromCredential(credentialID string) sdk.AccAddress {
 // GenerateDIDFromCredential generates a deterministic DID from a WebAuthn credential.
 // This creates a unique, reproducible DID for each WebAuthn credential.
 func GenerateDIDFromCredential(credentialID string, username string) string {
-	// Create a deterministic hash from credential ID and username
-	data := credentialID + ":" + username
-	hash := sha256.Sum256([]byte(data))
-
-	// Create a DID with the sonr method
-	// Format: did:sonr:<hex-encoded-hash-prefix>
-	didSuffix := hex.EncodeToString(hash[:16]) // Use first 16 bytes for shorter DIDs
-	return fmt.Sprintf("did:sonr:%s", didSuffix)
+	return didtypes.GenerateDIDFromCredential(credentialID, username)
 }
 
 // ConditionalFeeDecorator wraps the standard fee deduction decorator to conditionally
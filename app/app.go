@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -126,6 +127,7 @@ import (
 	_ "github.com/cosmos/evm/x/vm/core/tracers/native"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
@@ -159,18 +161,32 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/labstack/echo/v4"
 	chainante "github.com/sonr-io/sonr/app/ante"
+	"github.com/sonr-io/sonr/app/assets"
 	sonrcontext "github.com/sonr-io/sonr/app/context"
+	"github.com/sonr-io/sonr/app/fieldmask"
+	"github.com/sonr-io/sonr/app/msgcatalog"
+	"github.com/sonr-io/sonr/app/screening"
 	dex "github.com/sonr-io/sonr/x/dex"
 	dexkeeper "github.com/sonr-io/sonr/x/dex/keeper"
 	dextypes "github.com/sonr-io/sonr/x/dex/types"
 	did "github.com/sonr-io/sonr/x/did"
+	didresolver "github.com/sonr-io/sonr/x/did/client/resolver"
 	didkeeper "github.com/sonr-io/sonr/x/did/keeper"
 	didtypes "github.com/sonr-io/sonr/x/did/types"
 	dwn "github.com/sonr-io/sonr/x/dwn"
+	dwnattachments "github.com/sonr-io/sonr/x/dwn/client/attachments"
+	dwngateway "github.com/sonr-io/sonr/x/dwn/client/gateway"
+	dwnpincheck "github.com/sonr-io/sonr/x/dwn/client/pincheck"
 	dwnkeeper "github.com/sonr-io/sonr/x/dwn/keeper"
 	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
+	referral "github.com/sonr-io/sonr/x/referral"
+	referralkeeper "github.com/sonr-io/sonr/x/referral/keeper"
+	referraltypes "github.com/sonr-io/sonr/x/referral/types"
 	svc "github.com/sonr-io/sonr/x/svc"
+	svcconsent "github.com/sonr-io/sonr/x/svc/client/consent"
+	svcviewkey "github.com/sonr-io/sonr/x/svc/client/viewkey"
 	svckeeper "github.com/sonr-io/sonr/x/svc/keeper"
 	svctypes "github.com/sonr-io/sonr/x/svc/types"
 	"github.com/spf13/cast"
@@ -255,13 +271,16 @@ var maccPerms = map[string][]string{
 	govtypes.ModuleName:            {authtypes.Burner},
 	nft.ModuleName:                 nil,
 	// non sdk modules
-	ibctransfertypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
-	ibcfeetypes.ModuleName:       nil,
-	icatypes.ModuleName:          nil,
-	tokenfactorytypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-	evmtypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
-	feemarkettypes.ModuleName:    nil,
-	erc20types.ModuleName:        {authtypes.Minter, authtypes.Burner},
+	ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+	ibcfeetypes.ModuleName:            nil,
+	icatypes.ModuleName:               nil,
+	tokenfactorytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+	evmtypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
+	feemarkettypes.ModuleName:         nil,
+	erc20types.ModuleName:             {authtypes.Minter, authtypes.Burner},
+	dextypes.ModuleName:               nil,
+	dextypes.ProtocolLiquidityAccount: nil,
+	referraltypes.ModuleName:          nil,
 }
 
 var (
@@ -278,6 +297,7 @@ type ChainApp struct {
 	appCodec          codec.Codec
 	txConfig          client.TxConfig
 	interfaceRegistry types.InterfaceRegistry
+	homePath          string
 
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
@@ -330,6 +350,7 @@ type ChainApp struct {
 	DwnKeeper                 dwnkeeper.Keeper
 	SvcKeeper                 svckeeper.Keeper
 	DexKeeper                 dexkeeper.Keeper
+	ReferralKeeper            referralkeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -341,6 +362,16 @@ type ChainApp struct {
 	// module configurator
 	configurator module.Configurator
 	once         sync.Once
+
+	// user-facing strings served by the API server
+	messages *msgcatalog.Catalog
+
+	// off-chain services started with the API server
+	vaultPins    dwnpincheck.Config
+	attachments  dwnattachments.ServiceConfig
+	gateways     *dwngateway.Client
+	servicesCtx  context.Context
+	stopServices context.CancelFunc
 }
 
 // NewChainApp creates and initializes a new ChainApp instance.
@@ -470,12 +501,14 @@ func NewChainApp(
 		dwntypes.StoreKey,
 		svctypes.StoreKey,
 		dextypes.StoreKey,
+		referraltypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(
 		paramstypes.TStoreKey,
 		evmtypes.TransientKey,
 		feemarkettypes.TransientKey,
+		dextypes.TransientStoreKey,
 	)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
@@ -647,6 +680,25 @@ func NewChainApp(
 		skipUpgradeHeights[int64(h)] = true
 	}
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	app.homePath = homePath
+
+	// Consent text and other user-facing copy, with the node's overrides
+	messages, err := msgcatalog.Load(
+		msgcatalog.ConfigFromAppOptions(appOpts),
+		svctypes.DefaultScopeCatalog().Messages(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	app.messages = messages
+
+	// Vault pin checks and attachment release, started with the API server
+	app.vaultPins = dwnpincheck.ConfigFromAppOptions(appOpts)
+	if err := app.vaultPins.Validate(); err != nil {
+		panic(err)
+	}
+	app.attachments = dwnattachments.ServiceConfigFromAppOptions(appOpts)
+
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
@@ -700,11 +752,8 @@ func NewChainApp(
 	// Set legacy router for backwards compatibility with gov v1beta1
 	govKeeper.SetLegacyRouter(govRouter)
 
-	app.GovKeeper = *govKeeper.SetHooks(
-		govtypes.NewMultiGovHooks(
-		// register the governance hooks
-		),
-	)
+	// Governance hooks are registered once the svc keeper exists
+	app.GovKeeper = *govKeeper
 
 	app.NFTKeeper = nftkeeper.NewKeeper(
 		runtime.NewKVStoreService(keys[nftkeeper.StoreKey]),
@@ -730,6 +779,7 @@ func NewChainApp(
 	app.DexKeeper = dexkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[dextypes.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[dextypes.TransientStoreKey]),
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 		app.IBCKeeper.PortKeeper,
 		scopedDex,
@@ -752,6 +802,23 @@ func NewChainApp(
 		app.AccountKeeper,
 	)
 
+	// Create the referral Keeper and attribute new DIDs to invite codes. The
+	// dex drops its cached summary of a DID whose document changes. The hooks
+	// must be set before the did keeper is copied into other keepers.
+	app.ReferralKeeper = referralkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[referraltypes.StoreKey]),
+		logger,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DidKeeper,
+	)
+	app.DidKeeper.SetHooks(didtypes.NewMultiDIDHooks(
+		app.ReferralKeeper.Hooks(),
+		app.DexKeeper.DIDHooks(),
+	))
+
 	// Create the svc Keeper with DID dependencies
 	app.SvcKeeper = svckeeper.NewKeeper(
 		appCodec,
@@ -759,8 +826,23 @@ func NewChainApp(
 		logger,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		app.DidKeeper,
+		app.MsgServiceRouter(),
 	)
 
+	app.GovKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(
+			// register the governance hooks
+			svckeeper.NewGovHooks(app.SvcKeeper, app.GovKeeper.Proposals.Get),
+		),
+	)
+
+	// The did keeper checks WebAuthn origins against verified service
+	// domains. Keepers that copied it above only resolve DIDs.
+	app.DidKeeper.SetServiceKeeper(app.SvcKeeper)
+
+	// DID profiles show x/nft tokens their controllers hold
+	app.DidKeeper.SetNFTKeeper(app.NFTKeeper)
+
 	// Create the dwn Keeper with DID, UCAN, and Service keeper dependencies
 	// Create client context for DWN keeper transaction building
 	clientCtx := client.Context{}
@@ -783,9 +865,36 @@ func NewChainApp(
 		clientCtx,
 	)
 
+	// Read vault content from the configured gateways when IPFS cannot serve it
+	gateways, err := dwngateway.NewServiceClient(dwngateway.ServiceConfigFromAppOptions(appOpts))
+	if err != nil {
+		panic(err)
+	}
+	if gateways != nil {
+		app.DwnKeeper.SetContentFetcher(gateways)
+		app.gateways = gateways
+	}
+
 	// Now set the DID and DWN keepers in the DexKeeper
 	app.DexKeeper.SetDIDKeeper(app.DidKeeper)
-	app.DexKeeper.SetDWNKeeper(app.DwnKeeper)
+	app.DexKeeper.SetDWNKeeper(dwnkeeper.NewQuerier(app.DwnKeeper))
+	app.DexKeeper.SetBadgeKeeper(app.SvcKeeper)
+
+	// Without an oracle module, USD prices come from the pool reserves read
+	// over ICA, priced against the pricing usd_denom
+	app.DexKeeper.SetOracleKeeper(dexkeeper.NewReservesOracle(app.DexKeeper))
+
+	// Metadata of NFTs attached to DID profiles is cached in the DID's DWN
+	app.DidKeeper.SetNFTMetadataCache(dwnkeeper.NewNFTMetadataCache(app.DwnKeeper))
+
+	// Compliance screening lists, enforced as the dex params direct
+	screener, err := screening.Load(screening.ConfigFromAppOptions(appOpts))
+	if err != nil {
+		panic(err)
+	}
+	if screener != nil {
+		app.DexKeeper.SetScreener(screener)
+	}
 
 	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
 		appCodec,
@@ -908,6 +1017,9 @@ func NewChainApp(
 	)
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 
+	// The dex module funds its protocol-owned liquidity accounts over ICS-20
+	app.DexKeeper.SetTransferKeeper(app.TransferKeeper)
+
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
 		keys[icahosttypes.StoreKey],
@@ -1146,6 +1258,7 @@ func NewChainApp(
 		dwn.NewAppModule(appCodec, app.DwnKeeper),
 		svc.NewAppModule(appCodec, app.SvcKeeper),
 		dex.NewAppModule(app.DexKeeper),
+		referral.NewAppModule(app.ReferralKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -1197,6 +1310,7 @@ func NewChainApp(
 		dwntypes.ModuleName,
 		svctypes.ModuleName,
 		dextypes.ModuleName,
+		referraltypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -1221,6 +1335,7 @@ func NewChainApp(
 		dwntypes.ModuleName,
 		svctypes.ModuleName,
 		dextypes.ModuleName,
+		referraltypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -1272,6 +1387,7 @@ func NewChainApp(
 		dwntypes.ModuleName,
 		svctypes.ModuleName,
 		dextypes.ModuleName,
+		referraltypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -1361,6 +1477,9 @@ func NewChainApp(
 		// WebAuthn gasless transaction support
 		DidKeeper:             app.DidKeeper,
 		EnableEnhancedGasless: true, // Enable enhanced gasless mode for true onboarding without pre-existing accounts
+
+		// Delegated on-behalf execution verification and sponsored fees
+		SvcKeeper: app.SvcKeeper,
 	})
 
 	// must be before Loading version
@@ -1487,9 +1606,30 @@ func (app *ChainApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker application updates every end block
 func (app *ChainApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	if ctx.BlockHeight()%assets.RegistrationInterval == 0 {
+		app.registerDenomMetadata(ctx)
+	}
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// registerDenomMetadata publishes bank metadata for known assets that do not
+// have any yet, such as newly bridged IBC denoms.
+func (app *ChainApp) registerDenomMetadata(ctx sdk.Context) {
+	registered, err := assets.RegisterDenomMetadata(
+		ctx,
+		app.BankKeeper,
+		app.TransferKeeper,
+		assets.DefaultRegistry(),
+	)
+	if err != nil {
+		app.Logger().Error("failed to register denom metadata", "error", err)
+		return
+	}
+	if len(registered) > 0 {
+		app.Logger().Info("registered denom metadata", "denoms", registered)
+	}
+}
+
 func (a *ChainApp) Configurator() module.Configurator {
 	return a.configurator
 }
@@ -1508,7 +1648,13 @@ func (app *ChainApp) InitChainer(
 		panic(err)
 	}
 	response, err := app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
-	return response, err
+	if err != nil {
+		return response, err
+	}
+
+	// Fill in display units for known denoms missing from bank genesis
+	app.registerDenomMetadata(ctx)
+	return response, nil
 }
 
 // LoadHeight loads a particular height
@@ -1649,6 +1795,37 @@ func (app *ChainApp) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *ChainApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
+
+	// Project REST responses to the fields requested with ?fields=
+	apiSvr.Router.Use(fieldmask.Middleware)
+
+	// Serve the message catalog so clients render the node's copy
+	apiSvr.Router.Handle("/sonr/messages", msgcatalog.Handler(app.messages)).Methods("GET")
+
+	// Resolve DIDs at the Universal Resolver driver path
+	apiSvr.Router.PathPrefix(didresolver.PathPrefix).Handler(didresolver.NewHandler(didtypes.NewQueryClient(clientCtx)))
+
+	// Serve the scope catalog and consent lines rendered from the node's copy
+	consentRouter := echo.New()
+	svcconsent.NewHandler(svctypes.DefaultScopeCatalog(), app.messages).RegisterRoutes(consentRouter)
+	apiSvr.Router.PathPrefix(svcconsent.PathPrefix).Handler(consentRouter)
+
+	// Serve the composite read endpoints third parties reach with a view key
+	apiSvr.Router.PathPrefix(svcviewkey.PathPrefix).Handler(svcviewkey.NewHandler(clientCtx, app.authorizeView))
+
+	// Check vault pins and release attachments when configured
+	app.startVaultPins(clientCtx)
+
+	// Bring failed IPFS gateways back once they serve again
+	if app.gateways != nil {
+		app.gateways.Start(app.servicesContext())
+	}
+
+	// Stream attachment uploads and downloads when configured
+	if err := app.registerAttachments(apiSvr); err != nil {
+		panic(err)
+	}
+
 	// Register new tx routes from grpc-gateway.
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -1667,6 +1844,22 @@ func (app *ChainApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 	}
 }
 
+// authorizeView checks a view key against the latest committed state. It
+// reads the keeper directly so the svc error types reach the middleware.
+func (app *ChainApp) authorizeView(ctx context.Context, id uint64, token, scope string) (svctypes.ViewKey, error) {
+	sdkCtx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return svctypes.ViewKey{}, err
+	}
+	return app.SvcKeeper.AuthorizeView(sdkCtx.WithContext(ctx), id, token, scope)
+}
+
+// RegisterGRPCServer registers gRPC query services, pruning responses to the
+// field mask sent in request metadata.
+func (app *ChainApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(fieldmask.WrapServer(server))
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *ChainApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
@@ -1707,6 +1900,9 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the protocol liquidity account holds treasury funds on their way to
+	// protocol-owned liquidity; the dex module account itself stays blocked
+	delete(blockedAddrs, authtypes.NewModuleAddress(dextypes.ProtocolLiquidityAccount).String())
 
 	blockedPrecompilesHex := evmtypes.AvailableStaticPrecompiles
 	for _, addr := range vm.PrecompiledAddressesBerlin {
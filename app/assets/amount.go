@@ -0,0 +1,260 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxExponent bounds unit exponents; no real asset goes past 18 decimals
+const maxExponent = 36
+
+var (
+	// ErrUnknownDenom is returned when neither the bank metadata nor the
+	// registry knows the precision of a denom. Amounts of such denoms are
+	// never formatted with a guessed number of decimals.
+	ErrUnknownDenom = errors.New("unknown denom precision")
+	// ErrInvalidAmount is returned for amounts that are malformed, negative
+	// or more precise than their unit
+	ErrInvalidAmount = errors.New("invalid amount")
+)
+
+// amountPattern splits a coin string such as "1.5atom" or "1000 uatom"
+var amountPattern = regexp.MustCompile(`^([0-9]+(?:\.[0-9]*)?)\s*([a-zA-Z][a-zA-Z0-9/:._-]{1,127})$`)
+
+// Precision is how the amounts of a denom are displayed
+type Precision struct {
+	// Denom is the base denom on this chain, an ibc/ hash for bridged assets
+	Denom string `json:"denom"`
+	// Display is the unit amounts are shown in
+	Display string `json:"display"`
+	Symbol  string `json:"symbol,omitempty"`
+	// Exponent is the number of decimals of the display unit
+	Exponent uint32 `json:"exponent"`
+}
+
+// MetadataSource looks up x/bank denom metadata
+type MetadataSource interface {
+	DenomMetadata(ctx context.Context, denom string) (banktypes.Metadata, bool, error)
+}
+
+// KeeperMetadata reads denom metadata from a bank keeper
+type KeeperMetadata struct {
+	Bank interface {
+		GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	}
+}
+
+// DenomMetadata implements MetadataSource
+func (m KeeperMetadata) DenomMetadata(ctx context.Context, denom string) (banktypes.Metadata, bool, error) {
+	md, found := m.Bank.GetDenomMetaData(ctx, denom)
+	return md, found, nil
+}
+
+// QueryMetadata reads denom metadata through the bank query service, for
+// clients and off-chain services
+type QueryMetadata struct {
+	Client banktypes.QueryClient
+}
+
+// DenomMetadata implements MetadataSource
+func (m QueryMetadata) DenomMetadata(ctx context.Context, denom string) (banktypes.Metadata, bool, error) {
+	res, err := m.Client.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	if status.Code(err) == codes.NotFound {
+		return banktypes.Metadata{}, false, nil
+	}
+	if err != nil {
+		return banktypes.Metadata{}, false, err
+	}
+	return res.Metadata, true, nil
+}
+
+// Formatter converts between base amounts and display amounts. The CLI, the
+// highway and nebula all format through it so an amount reads the same on
+// every surface and parses back to the same base amount.
+type Formatter struct {
+	registry Registry
+	metadata MetadataSource
+}
+
+// NewFormatter creates a formatter. Bank metadata takes precedence, since it
+// is what wallets see and it covers bridged ibc/ denoms; the registry fills
+// in for denoms without metadata and for assets on counterparty chains.
+// metadata may be nil to use the registry alone.
+func NewFormatter(registry Registry, metadata MetadataSource) *Formatter {
+	return &Formatter{registry: registry, metadata: metadata}
+}
+
+// Precision resolves the display unit and decimals of a base denom
+func (f *Formatter) Precision(ctx context.Context, denom string) (Precision, error) {
+	if f.metadata != nil {
+		md, found, err := f.metadata.DenomMetadata(ctx, denom)
+		if err != nil {
+			return Precision{}, fmt.Errorf("failed to query metadata of %s: %w", denom, err)
+		}
+		if found {
+			if p, ok := precisionOf(md); ok {
+				return p, nil
+			}
+		}
+	}
+	if asset, ok := f.registry.Get(denom); ok {
+		if p, ok := precisionOf(asset.Metadata(denom)); ok {
+			return p, nil
+		}
+	}
+	return Precision{}, fmt.Errorf("%w: %s", ErrUnknownDenom, denom)
+}
+
+// Format renders a coin in its display unit, e.g. 1500000uusdc as 1.5 USDC
+func (f *Formatter) Format(ctx context.Context, coin sdk.Coin) (string, error) {
+	p, err := f.Precision(ctx, coin.Denom)
+	if err != nil {
+		return "", err
+	}
+	unit := p.Symbol
+	if unit == "" {
+		unit = p.Display
+	}
+	return FormatAmount(coin.Amount, p.Exponent) + " " + unit, nil
+}
+
+// Parse reads an amount of denom given in its display unit, e.g. "1.5" of
+// uusdc as 1500000uusdc
+func (f *Formatter) Parse(ctx context.Context, amount, denom string) (sdk.Coin, error) {
+	p, err := f.Precision(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	amt, err := ParseAmount(amount, p.Exponent)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(p.Denom, amt), nil
+}
+
+// ParseCoin reads a coin in any unit of a known asset: "1000uatom" in the
+// base unit or "0.001atom" in the display unit. A unit that is not a base
+// denom is looked up among the registry's units and aliases, so a display
+// unit always means the registered asset rather than a bridged copy of it;
+// bridged assets are given by their ibc/ base denom.
+func (f *Formatter) ParseCoin(ctx context.Context, s string) (sdk.Coin, error) {
+	m := amountPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return sdk.Coin{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	amount, unit := m[1], m[2]
+
+	if base, exponent, ok := f.registry.unit(unit); ok && base != unit {
+		amt, err := ParseAmount(amount, exponent)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(base, amt), nil
+	}
+
+	// A base denom, which need not be registered
+	amt, err := ParseAmount(amount, 0)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	coin := sdk.Coin{Denom: unit, Amount: amt}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, fmt.Errorf("%w: %s", ErrInvalidAmount, err)
+	}
+	return coin, nil
+}
+
+// unit finds the asset a unit denom or alias belongs to
+func (r Registry) unit(denom string) (string, uint32, bool) {
+	for _, base := range r.Bases() {
+		for _, u := range r[base].Units {
+			if strings.EqualFold(u.Denom, denom) {
+				return base, u.Exponent, true
+			}
+			for _, alias := range u.Aliases {
+				if strings.EqualFold(alias, denom) {
+					return base, u.Exponent, true
+				}
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// precisionOf reads the display unit exponent from bank metadata
+func precisionOf(md banktypes.Metadata) (Precision, bool) {
+	for _, u := range md.DenomUnits {
+		if u.Denom == md.Display && u.Exponent <= maxExponent {
+			return Precision{Denom: md.Base, Display: md.Display, Symbol: md.Symbol, Exponent: u.Exponent}, true
+		}
+	}
+	return Precision{}, false
+}
+
+// FormatAmount renders a base amount with exponent decimals, dropping
+// trailing zeros: 1500000 with exponent 6 is "1.5". It is exact for any
+// amount.
+func FormatAmount(amount math.Int, exponent uint32) string {
+	if amount.IsNil() {
+		amount = math.ZeroInt()
+	}
+	digits := amount.Abs().String()
+	sign := ""
+	if amount.IsNegative() {
+		sign = "-"
+	}
+	if exponent == 0 {
+		return sign + digits
+	}
+
+	e := int(exponent)
+	if len(digits) <= e {
+		digits = strings.Repeat("0", e-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-e], strings.TrimRight(digits[len(digits)-e:], "0")
+	if frac == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + frac
+}
+
+// ParseAmount reads a non-negative decimal amount into base units. An amount
+// with more decimals than exponent is rejected rather than rounded, so a
+// parsed amount is always exactly what was typed.
+func ParseAmount(s string, exponent uint32) (math.Int, error) {
+	if exponent > maxExponent {
+		return math.Int{}, fmt.Errorf("%w: exponent %d is too large", ErrInvalidAmount, exponent)
+	}
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if whole == "" || !isDigits(whole) || !isDigits(frac) {
+		return math.Int{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > int(exponent) {
+		return math.Int{}, fmt.Errorf("%w: %q has more than %d decimals", ErrInvalidAmount, s, exponent)
+	}
+
+	amt, ok := math.NewIntFromString(whole + frac + strings.Repeat("0", int(exponent)-len(frac)))
+	if !ok {
+		return math.Int{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	return amt, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
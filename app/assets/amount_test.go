@@ -0,0 +1,149 @@
+package assets_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/assets"
+)
+
+func TestFormatAmount(t *testing.T) {
+	for _, tc := range []struct {
+		amount   int64
+		exponent uint32
+		want     string
+	}{
+		{1500000, 6, "1.5"},
+		{1, 6, "0.000001"},
+		{0, 6, "0"},
+		{1000000, 6, "1"},
+		{-2500, 3, "-2.5"},
+		{42, 0, "42"},
+	} {
+		require.Equal(t, tc.want, assets.FormatAmount(math.NewInt(tc.amount), tc.exponent))
+	}
+
+	wei, ok := math.NewIntFromString("1234500000000000000000")
+	require.True(t, ok)
+	require.Equal(t, "1234.5", assets.FormatAmount(wei, 18))
+}
+
+func TestParseAmount(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		exponent uint32
+		want     int64
+	}{
+		{"1.5", 6, 1500000},
+		{"0.000001", 6, 1},
+		{"1", 6, 1000000},
+		{"1.50", 2, 150},
+		{"7", 0, 7},
+	} {
+		got, err := assets.ParseAmount(tc.in, tc.exponent)
+		require.NoError(t, err, tc.in)
+		require.Equal(t, math.NewInt(tc.want), got, tc.in)
+		require.Equal(t, math.NewInt(tc.want), must(assets.ParseAmount(assets.FormatAmount(got, tc.exponent), tc.exponent)))
+	}
+
+	for _, in := range []string{"", "-1", "1.0000001", "abc", ".5", "1e6", "1.2.3"} {
+		_, err := assets.ParseAmount(in, 6)
+		require.ErrorIs(t, err, assets.ErrInvalidAmount, in)
+	}
+}
+
+type metadataSource map[string]banktypes.Metadata
+
+func (m metadataSource) DenomMetadata(_ context.Context, denom string) (banktypes.Metadata, bool, error) {
+	md, ok := m[denom]
+	return md, ok, nil
+}
+
+func TestFormatter(t *testing.T) {
+	ctx := context.Background()
+	registry := assets.DefaultRegistry()
+	usdcIBC := "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4"
+	wbtcIBC := "ibc/D1542AA8762DB13087D8364F3EA6509FD6F009A34F00426AF9E4F9FA85CBBF1F"
+
+	f := assets.NewFormatter(registry, metadataSource{
+		usdcIBC: registry["uusdc"].Metadata(usdcIBC),
+		wbtcIBC: {
+			Base:    wbtcIBC,
+			Display: "wbtc",
+			Symbol:  "WBTC",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: wbtcIBC, Exponent: 0},
+				{Denom: "wbtc", Exponent: 8},
+			},
+		},
+	})
+
+	t.Run("precision", func(t *testing.T) {
+		p, err := f.Precision(ctx, wbtcIBC)
+		require.NoError(t, err)
+		require.Equal(t, uint32(8), p.Exponent)
+
+		p, err = f.Precision(ctx, "uatom")
+		require.NoError(t, err)
+		require.Equal(t, assets.Precision{Denom: "uatom", Display: "atom", Symbol: "ATOM", Exponent: 6}, p)
+
+		_, err = f.Precision(ctx, "ufoo")
+		require.ErrorIs(t, err, assets.ErrUnknownDenom)
+	})
+
+	t.Run("format", func(t *testing.T) {
+		s, err := f.Format(ctx, sdk.NewInt64Coin(usdcIBC, 1500000))
+		require.NoError(t, err)
+		require.Equal(t, "1.5 USDC", s)
+
+		s, err = f.Format(ctx, sdk.NewInt64Coin(wbtcIBC, 150000000))
+		require.NoError(t, err)
+		require.Equal(t, "1.5 WBTC", s)
+
+		_, err = f.Format(ctx, sdk.NewInt64Coin("ufoo", 1))
+		require.ErrorIs(t, err, assets.ErrUnknownDenom)
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		coin, err := f.Parse(ctx, "0.5", wbtcIBC)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(wbtcIBC, 50000000), coin)
+
+		_, err = f.Parse(ctx, "0.000000001", wbtcIBC)
+		require.ErrorIs(t, err, assets.ErrInvalidAmount)
+	})
+
+	t.Run("parse coin", func(t *testing.T) {
+		for in, want := range map[string]sdk.Coin{
+			"1000uatom":   sdk.NewInt64Coin("uatom", 1000),
+			"0.001atom":   sdk.NewInt64Coin("uatom", 1000),
+			"2.5 SNR":     sdk.NewInt64Coin("usnr", 2500000),
+			"3millisnr":   sdk.NewInt64Coin("usnr", 3000),
+			"7ufoo":       sdk.NewInt64Coin("ufoo", 7),
+			"5" + usdcIBC: sdk.NewInt64Coin(usdcIBC, 5),
+			"12microsnr":  sdk.NewInt64Coin("usnr", 12),
+			"1.25usdc":    sdk.NewInt64Coin("uusdc", 1250000),
+		} {
+			got, err := f.ParseCoin(ctx, in)
+			require.NoError(t, err, in)
+			require.Equal(t, want, got, in)
+		}
+
+		for _, in := range []string{"1.5uatom", "1.0000001atom", "atom", "-1atom", "1"} {
+			_, err := f.ParseCoin(ctx, in)
+			require.ErrorIs(t, err, assets.ErrInvalidAmount, in)
+		}
+	})
+}
+
+func must(v math.Int, err error) math.Int {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,109 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// RegistrationInterval is how often, in blocks, the end blocker looks for
+// newly bridged denoms that have no metadata yet.
+const RegistrationInterval = 1000
+
+// BankKeeper is the subset of x/bank used to publish metadata.
+type BankKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
+}
+
+// DenomTraceKeeper resolves ibc/ denoms to their origin path and base denom.
+type DenomTraceKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+}
+
+// RegisterDenomMetadata publishes metadata for the native denom and for every
+// denom in the total supply whose origin asset is in the registry. Existing
+// metadata, such as entries set in genesis, is never overwritten. It returns
+// the denoms that were registered.
+func RegisterDenomMetadata(
+	ctx sdk.Context,
+	bank BankKeeper,
+	traces DenomTraceKeeper,
+	registry Registry,
+) ([]string, error) {
+	candidates := []string{NativeDenom}
+	bank.IterateTotalSupply(ctx, func(c sdk.Coin) bool {
+		if c.Denom != NativeDenom {
+			candidates = append(candidates, c.Denom)
+		}
+		return false
+	})
+
+	var registered []string
+	for _, denom := range candidates {
+		if _, found := bank.GetDenomMetaData(ctx, denom); found {
+			continue
+		}
+
+		md, ok, err := resolveMetadata(ctx, traces, registry, denom)
+		if err != nil {
+			return registered, err
+		}
+		if !ok {
+			continue
+		}
+		if err := md.Validate(); err != nil {
+			return registered, fmt.Errorf("invalid metadata for %s: %w", denom, err)
+		}
+
+		bank.SetDenomMetaData(ctx, md)
+		registered = append(registered, denom)
+	}
+	return registered, nil
+}
+
+// resolveMetadata finds the registry asset behind denom, following IBC denom
+// traces for bridged assets.
+func resolveMetadata(
+	ctx sdk.Context,
+	traces DenomTraceKeeper,
+	registry Registry,
+	denom string,
+) (banktypes.Metadata, bool, error) {
+	hash, isIBC := strings.CutPrefix(denom, ibctransfertypes.DenomPrefix+"/")
+	if !isIBC {
+		asset, ok := registry.Get(denom)
+		if !ok {
+			return banktypes.Metadata{}, false, nil
+		}
+		return asset.Metadata(denom), true, nil
+	}
+
+	if traces == nil {
+		return banktypes.Metadata{}, false, nil
+	}
+	hexHash, err := ibctransfertypes.ParseHexHash(hash)
+	if err != nil {
+		return banktypes.Metadata{}, false, fmt.Errorf("invalid ibc denom %s: %w", denom, err)
+	}
+	trace, found := traces.GetDenomTrace(ctx, hexHash)
+	if !found {
+		return banktypes.Metadata{}, false, nil
+	}
+
+	asset, ok := registry.Get(trace.BaseDenom)
+	if !ok {
+		return banktypes.Metadata{}, false, nil
+	}
+
+	md := asset.Metadata(denom)
+	md.Name = fmt.Sprintf("%s (%s)", asset.Name, trace.Path)
+	md.Description = fmt.Sprintf("%s bridged over IBC via %s", asset.Symbol, trace.Path)
+	return md, true, nil
+}
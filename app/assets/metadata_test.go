@@ -0,0 +1,114 @@
+package assets_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/assets"
+)
+
+type mockBank struct {
+	metadata map[string]banktypes.Metadata
+	supply   []sdk.Coin
+}
+
+func (b *mockBank) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	md, ok := b.metadata[denom]
+	return md, ok
+}
+
+func (b *mockBank) SetDenomMetaData(_ context.Context, md banktypes.Metadata) {
+	b.metadata[md.Base] = md
+}
+
+func (b *mockBank) IterateTotalSupply(_ context.Context, cb func(sdk.Coin) bool) {
+	for _, c := range b.supply {
+		if cb(c) {
+			return
+		}
+	}
+}
+
+type mockTraces map[string]ibctransfertypes.DenomTrace
+
+func (m mockTraces) GetDenomTrace(_ sdk.Context, hash cmtbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	t, ok := m[hash.String()]
+	return t, ok
+}
+
+func TestRegisterDenomMetadata(t *testing.T) {
+	usdc := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+	unknown := ibctransfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "ufoo"}
+	traces := mockTraces{
+		usdc.Hash().String():    usdc,
+		unknown.Hash().String(): unknown,
+	}
+
+	bank := &mockBank{
+		metadata: map[string]banktypes.Metadata{
+			// Genesis-provided metadata must be left alone.
+			"uatom": {Base: "uatom", Display: "custom"},
+		},
+		supply: []sdk.Coin{
+			sdk.NewInt64Coin("usnr", 1),
+			sdk.NewInt64Coin("uatom", 1),
+			sdk.NewInt64Coin(usdc.IBCDenom(), 1),
+			sdk.NewInt64Coin(unknown.IBCDenom(), 1),
+		},
+	}
+
+	registered, err := assets.RegisterDenomMetadata(sdk.Context{}, bank, traces, assets.DefaultRegistry())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"usnr", usdc.IBCDenom()}, registered)
+
+	native := bank.metadata["usnr"]
+	require.Equal(t, "snr", native.Display)
+	require.Equal(t, "SNR", native.Symbol)
+	require.Len(t, native.DenomUnits, 3)
+	require.Equal(t, uint32(6), native.DenomUnits[2].Exponent)
+
+	bridged := bank.metadata[usdc.IBCDenom()]
+	require.Equal(t, usdc.IBCDenom(), bridged.Base)
+	require.Equal(t, "usdc", bridged.Display)
+	require.Equal(t, "USDC", bridged.Symbol)
+	require.Equal(t, usdc.IBCDenom(), bridged.DenomUnits[0].Denom)
+	require.Contains(t, bridged.DenomUnits[0].Aliases, "uusdc")
+	require.NoError(t, bridged.Validate())
+
+	require.Equal(t, "custom", bank.metadata["uatom"].Display)
+	require.NotContains(t, bank.metadata, unknown.IBCDenom())
+
+	// A second pass is a no-op.
+	registered, err = assets.RegisterDenomMetadata(sdk.Context{}, bank, traces, assets.DefaultRegistry())
+	require.NoError(t, err)
+	require.Empty(t, registered)
+}
+
+func TestParseAssetList(t *testing.T) {
+	bz, err := os.ReadFile("../../chains/registry_assets.json")
+	require.NoError(t, err)
+
+	reg, err := assets.ParseAssetList(bz)
+	require.NoError(t, err)
+
+	native, ok := reg.Get("usnr")
+	require.True(t, ok)
+	require.Equal(t, "snr", native.Display)
+	require.NoError(t, native.Metadata("usnr").Validate())
+}
+
+func TestDefaultRegistryValid(t *testing.T) {
+	reg := assets.DefaultRegistry()
+	for _, base := range reg.Bases() {
+		asset, _ := reg.Get(base)
+		require.NoError(t, asset.Validate(), base)
+		require.NoError(t, asset.Metadata(base).Validate(), base)
+	}
+}
@@ -0,0 +1,164 @@
+// Package assets maintains the registry of known assets and publishes their
+// display units as x/bank denom metadata, so wallets using the standard
+// cosmos.bank.v1beta1 DenomMetadata queries render amounts correctly.
+package assets
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Unit is a named denomination of an asset at a power of ten.
+type Unit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases,omitempty"`
+}
+
+// Asset describes how a base denom is displayed.
+type Asset struct {
+	// Base is the smallest unit as it exists on its origin chain (e.g. uusdc).
+	Base        string `json:"base"`
+	Display     string `json:"display"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	Units       []Unit `json:"denom_units"`
+}
+
+// Validate checks that the asset has an exponent-zero base unit and a display
+// unit among its units.
+func (a Asset) Validate() error {
+	if a.Base == "" || a.Display == "" || a.Symbol == "" {
+		return fmt.Errorf("asset %q: base, display and symbol are required", a.Base)
+	}
+	var hasBase, hasDisplay bool
+	for _, u := range a.Units {
+		hasBase = hasBase || (u.Denom == a.Base && u.Exponent == 0)
+		hasDisplay = hasDisplay || u.Denom == a.Display
+	}
+	if !hasBase {
+		return fmt.Errorf("asset %q: missing exponent 0 base unit", a.Base)
+	}
+	if !hasDisplay {
+		return fmt.Errorf("asset %q: display unit %q not in denom units", a.Base, a.Display)
+	}
+	return nil
+}
+
+// Metadata builds bank metadata for the asset as it exists on this chain
+// under denom. For bridged assets denom is the ibc/ hash and the origin base
+// denom is kept as an alias of the exponent-zero unit.
+func (a Asset) Metadata(denom string) banktypes.Metadata {
+	md := banktypes.Metadata{
+		Description: a.Description,
+		Base:        denom,
+		Display:     a.Display,
+		Name:        a.Name,
+		Symbol:      a.Symbol,
+	}
+	for _, u := range a.Units {
+		unit := &banktypes.DenomUnit{
+			Denom:    u.Denom,
+			Exponent: u.Exponent,
+			Aliases:  append([]string{}, u.Aliases...),
+		}
+		if u.Exponent == 0 && u.Denom == a.Base && denom != a.Base {
+			unit.Denom = denom
+			unit.Aliases = append([]string{a.Base}, unit.Aliases...)
+		}
+		md.DenomUnits = append(md.DenomUnits, unit)
+	}
+	sort.SliceStable(md.DenomUnits, func(i, j int) bool {
+		return md.DenomUnits[i].Exponent < md.DenomUnits[j].Exponent
+	})
+	return md
+}
+
+// Registry maps origin base denoms to assets.
+type Registry map[string]Asset
+
+// Get returns the asset for an origin base denom.
+func (r Registry) Get(base string) (Asset, bool) {
+	a, ok := r[base]
+	return a, ok
+}
+
+// Bases returns the registered base denoms in sorted order.
+func (r Registry) Bases() []string {
+	bases := make([]string, 0, len(r))
+	for b := range r {
+		bases = append(bases, b)
+	}
+	sort.Strings(bases)
+	return bases
+}
+
+// ParseAssetList reads a chain-registry assetlist.json document, such as
+// chains/registry_assets.json, into a registry.
+func ParseAssetList(bz []byte) (Registry, error) {
+	var list struct {
+		Assets []Asset `json:"assets"`
+	}
+	if err := json.Unmarshal(bz, &list); err != nil {
+		return nil, fmt.Errorf("invalid asset list: %w", err)
+	}
+
+	reg := make(Registry, len(list.Assets))
+	for _, a := range list.Assets {
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		reg[a.Base] = a
+	}
+	return reg, nil
+}
+
+// NativeDenom is the base denom of the staking token.
+const NativeDenom = "usnr"
+
+// DefaultRegistry returns the native token and the assets commonly bridged to
+// Sonr over IBC.
+func DefaultRegistry() Registry {
+	return Registry{
+		NativeDenom: {
+			Base:        NativeDenom,
+			Display:     "snr",
+			Name:        "Sonr",
+			Symbol:      "SNR",
+			Description: "The native staking token of the Sonr network",
+			Units: []Unit{
+				{Denom: NativeDenom, Exponent: 0, Aliases: []string{"microsnr"}},
+				{Denom: "msnr", Exponent: 3, Aliases: []string{"millisnr"}},
+				{Denom: "snr", Exponent: 6},
+			},
+		},
+		"uusdc": {
+			Base:        "uusdc",
+			Display:     "usdc",
+			Name:        "USD Coin",
+			Symbol:      "USDC",
+			Description: "Native USDC issued on Noble",
+			Units:       []Unit{{Denom: "uusdc", Exponent: 0}, {Denom: "usdc", Exponent: 6}},
+		},
+		"uatom": {
+			Base:        "uatom",
+			Display:     "atom",
+			Name:        "Cosmos Hub Atom",
+			Symbol:      "ATOM",
+			Description: "The native staking token of the Cosmos Hub",
+			Units:       []Unit{{Denom: "uatom", Exponent: 0}, {Denom: "atom", Exponent: 6}},
+		},
+		"uosmo": {
+			Base:        "uosmo",
+			Display:     "osmo",
+			Name:        "Osmosis",
+			Symbol:      "OSMO",
+			Description: "The native token of Osmosis",
+			Units:       []Unit{{Denom: "uosmo", Exponent: 0}, {Denom: "osmo", Exponent: 6}},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+// Package chaos injects faults into the IBC, database and IPFS layers so the
+// chaos test suite can check that callers degrade gracefully. Fault points
+// are compiled in only with the chaos build tag:
+//
+//	go test -tags chaos ./x/dex/... ./x/dwn/... ./x/did/client/server/...
+//
+// Without the tag Fail always returns nil and the fault points cost nothing.
+package chaos
+
+import "errors"
+
+// Fault names a point where a fault can be injected
+type Fault string
+
+const (
+	// PacketDrop fails sending an ICA packet, as when the channel refuses it
+	PacketDrop Fault = "ibc.packet_drop"
+	// DelayedAck fails handling an ICA acknowledgement, so the relayer has to
+	// deliver it again later
+	DelayedAck Fault = "ibc.delayed_ack"
+	// DBReset fails a database statement as if the connection was reset
+	DBReset Fault = "db.connection_reset"
+	// IPFSTimeout fails an IPFS request as if the node timed out
+	IPFSTimeout Fault = "ipfs.timeout"
+)
+
+// ErrInjected is wrapped by every injected fault
+var ErrInjected = errors.New("chaos: injected fault")
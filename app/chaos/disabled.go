@@ -0,0 +1,9 @@
+//go:build !chaos
+
+package chaos
+
+// Enabled reports whether fault points are compiled in
+const Enabled = false
+
+// Fail returns nil; faults are only injected with the chaos build tag
+func Fail(Fault) error { return nil }
@@ -0,0 +1,55 @@
+//go:build chaos
+
+package chaos
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Enabled reports whether fault points are compiled in
+const Enabled = true
+
+var (
+	mu     sync.Mutex
+	armed  = map[Fault]int{}
+	counts = map[Fault]int{}
+)
+
+// Inject arms a fault for the next times hits of its fault point, or for
+// every hit when times is negative
+func Inject(f Fault, times int) {
+	mu.Lock()
+	defer mu.Unlock()
+	armed[f] = times
+}
+
+// Reset disarms every fault and clears the hit counts
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	armed = map[Fault]int{}
+	counts = map[Fault]int{}
+}
+
+// Hits returns how many times a fault was injected since the last Reset
+func Hits(f Fault) int {
+	mu.Lock()
+	defer mu.Unlock()
+	return counts[f]
+}
+
+// Fail returns an error wrapping ErrInjected when f is armed
+func Fail(f Fault) error {
+	mu.Lock()
+	defer mu.Unlock()
+	times, ok := armed[f]
+	if !ok || times == 0 {
+		return nil
+	}
+	if times > 0 {
+		armed[f] = times - 1
+	}
+	counts[f]++
+	return fmt.Errorf("%w: %s", ErrInjected, f)
+}
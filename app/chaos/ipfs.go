@@ -0,0 +1,30 @@
+package chaos
+
+import "github.com/sonr-io/common/ipfs"
+
+type faultyIPFS struct {
+	ipfs.IPFSClient
+}
+
+// IPFS wraps an IPFS client so its Add and Get requests fail while
+// IPFSTimeout is armed. Without the chaos build tag c is returned as is.
+func IPFS(c ipfs.IPFSClient) ipfs.IPFSClient {
+	if !Enabled || c == nil {
+		return c
+	}
+	return faultyIPFS{IPFSClient: c}
+}
+
+func (c faultyIPFS) Add(data []byte) (string, error) {
+	if err := Fail(IPFSTimeout); err != nil {
+		return "", err
+	}
+	return c.IPFSClient.Add(data)
+}
+
+func (c faultyIPFS) Get(cid string) ([]byte, error) {
+	if err := Fail(IPFSTimeout); err != nil {
+		return nil, err
+	}
+	return c.IPFSClient.Get(cid)
+}
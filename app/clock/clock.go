@@ -0,0 +1,79 @@
+// Package clock separates wall-clock time from block time. State machine
+// code must measure deadlines and expiries against block time so every node
+// agrees; wall-clock time is for clients and off-chain services only.
+// Clients should express timeouts as durations and let the keeper resolve
+// them against the block in which the message executes.
+package clock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrDeadlineElapsed is returned when a deadline is not after the current time
+var ErrDeadlineElapsed = errors.New("deadline already elapsed")
+
+// Clock reports the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// Func adapts a function to the Clock interface
+type Func func() time.Time
+
+// Now implements Clock
+func (f Func) Now() time.Time { return f() }
+
+// System is the wall clock. It must not be used by keepers.
+var System Clock = Func(time.Now)
+
+// Fixed returns a clock that always reports t
+func Fixed(t time.Time) Clock {
+	return Func(func() time.Time { return t })
+}
+
+// Block returns a clock reporting the block time of an SDK context
+func Block(ctx context.Context) Clock {
+	t := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return Fixed(t)
+}
+
+// BlockTime returns the block time of an SDK context
+func BlockTime(ctx context.Context) time.Time {
+	return sdk.UnwrapSDKContext(ctx).BlockTime()
+}
+
+// Remaining resolves an absolute deadline against now. A zero deadline means
+// none was given and fallback is used. The result is capped at limit when
+// limit is positive.
+func Remaining(now, deadline time.Time, fallback, limit time.Duration) (time.Duration, error) {
+	d := fallback
+	if !deadline.IsZero() {
+		d = deadline.Sub(now)
+		if d <= 0 {
+			return 0, fmt.Errorf("%w: %s is %s before %s",
+				ErrDeadlineElapsed, deadline.UTC().Format(time.RFC3339), -d, now.UTC().Format(time.RFC3339))
+		}
+	}
+	if limit > 0 && d > limit {
+		d = limit
+	}
+	return d, nil
+}
+
+// Expired reports whether a unix-seconds expiry has passed at now. A zero
+// expiry never expires.
+func Expired(now time.Time, expiresAt int64) bool {
+	return expiresAt > 0 && now.Unix() > expiresAt
+}
+
+// SameDay reports whether a and b fall on the same UTC calendar day
+func SameDay(a, b time.Time) bool {
+	ay, am, ad := a.UTC().Date()
+	by, bm, bd := b.UTC().Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,47 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/clock"
+)
+
+func TestRemaining(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	d, err := clock.Remaining(now, time.Time{}, 30*time.Second, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, d)
+
+	d, err = clock.Remaining(now, now.Add(5*time.Minute), 30*time.Second, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Minute, d)
+
+	d, err = clock.Remaining(now, now.Add(48*time.Hour), 30*time.Second, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, d)
+
+	// A deadline computed from a client's wall clock that has already
+	// passed in block time is rejected rather than producing a packet that
+	// times out immediately.
+	_, err = clock.Remaining(now, now.Add(-time.Second), 30*time.Second, time.Hour)
+	require.ErrorIs(t, err, clock.ErrDeadlineElapsed)
+	_, err = clock.Remaining(now, now, 30*time.Second, time.Hour)
+	require.ErrorIs(t, err, clock.ErrDeadlineElapsed)
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+	require.False(t, clock.Expired(now, 0))
+	require.False(t, clock.Expired(now, 1000))
+	require.True(t, clock.Expired(now, 999))
+}
+
+func TestSameDay(t *testing.T) {
+	a := time.Date(2025, 3, 1, 23, 59, 0, 0, time.UTC)
+	require.True(t, clock.SameDay(a, a.Add(-time.Hour)))
+	require.False(t, clock.SameDay(a, a.Add(time.Minute)))
+}
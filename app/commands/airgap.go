@@ -0,0 +1,275 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/spf13/cobra"
+
+	"github.com/sonr-io/sonr/app/airgap"
+)
+
+const (
+	flagAirgapFrames    = "frames"
+	flagAirgapOverwrite = "overwrite"
+	flagAirgapQR        = "qr"
+	flagAirgapQRDir     = "qr-dir"
+	flagAirgapQRInvert  = "qr-invert"
+	flagAirgapFrameSize = "frame-size"
+	flagAirgapPNGScale  = "png-scale"
+)
+
+// SignOfflineCmd returns the command that signs a transaction on a machine
+// without network access
+func SignOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-offline [file]",
+		Short: "Sign a transaction without network access",
+		Long: `Sign a transaction generated with --generate-only on an air-gapped machine.
+
+Nothing is queried: the chain ID, account number and sequence, which "sign"
+would otherwise look up, must be given explicitly. Read them on a networked
+machine with "snrd query auth account [address]".
+
+The unsigned transaction is read from a JSON file, or with --frames from the
+text of scanned QR frames (one per line) produced by "snrd tx qr encode".
+With --qr the signed transaction is shown as QR frames to scan back on the
+networked machine, where "snrd tx qr decode" restores it for broadcasting.`,
+		Example: `  snrd tx sign-offline unsigned.json --from cold --chain-id sonrtest_1-1 --account-number 12 --sequence 3
+  snrd tx sign-offline scanned.txt --frames --from cold --chain-id sonrtest_1-1 --account-number 12 --sequence 3 --qr`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, flag := range []string{flags.FlagChainID, flags.FlagAccountNumber, flags.FlagSequence} {
+				if !cmd.Flags().Changed(flag) {
+					return fmt.Errorf("--%s is required to sign offline", flag)
+				}
+			}
+			if err := cmd.Flags().Set(flags.FlagOffline, "true"); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txFactory, err := clienttx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fromFrames, _ := cmd.Flags().GetBool(flagAirgapFrames)
+			unsigned, err := readAirgapTx(clientCtx, cmd.InOrStdin(), args[0], fromFrames)
+			if err != nil {
+				return err
+			}
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(unsigned)
+			if err != nil {
+				return err
+			}
+
+			overwrite, _ := cmd.Flags().GetBool(flagAirgapOverwrite)
+			if err := authclient.SignTx(txFactory, clientCtx, clientCtx.FromName, txBuilder, true, overwrite); err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+			return writeAirgapOutput(cmd, bz)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagAirgapFrames, false, "Read the transaction as scanned QR frames, one per line")
+	cmd.Flags().Bool(flagAirgapOverwrite, false, "Replace existing signatures instead of appending")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the signed transaction to this file instead of stdout")
+	cmd.Flags().Bool(flagAirgapQR, false, "Show the signed transaction as QR frames instead of JSON")
+	addQRFlags(cmd)
+	return cmd
+}
+
+// QRCmd returns the commands that move transactions across an air gap as
+// QR codes
+func QRCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "qr",
+		Short:                      "Transfer transactions to and from an offline signer as QR codes",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(qrEncodeCmd(), qrDecodeCmd())
+	return cmd
+}
+
+func qrEncodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encode [file]",
+		Short: "Show a transaction as QR frames",
+		Long: `Compress a transaction, split it into frames and show each frame as a QR code
+to be scanned by the other machine. Use - to read from stdin.
+
+Frames are printed with Unicode blocks, or written as PNG images to --qr-dir.`,
+		Example: `  snrd tx dex swap ... --generate-only --offline ... | snrd tx qr encode -
+  snrd tx qr encode signed.json --qr-dir ./frames`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := readAirgapFile(cmd.InOrStdin(), args[0])
+			if err != nil {
+				return err
+			}
+			return writeQRFrames(cmd, bz)
+		},
+	}
+
+	addQRFlags(cmd)
+	return cmd
+}
+
+func qrDecodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [file]",
+		Short: "Restore a transaction from scanned QR frames",
+		Long: `Reassemble a transaction from the text of scanned QR frames, one per line in
+any order. Use - to read from stdin. Repeated frames are ignored and missing
+frames are reported by number.`,
+		Example: `  snrd tx qr decode scanned.txt > signed.json && snrd tx broadcast signed.json`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			bz, err := readAirgapFile(cmd.InOrStdin(), args[0])
+			if err != nil {
+				return err
+			}
+			frames, err := airgap.ReadFrames(bytes.NewReader(bz))
+			if err != nil {
+				return err
+			}
+			payload, err := airgap.JoinFrames(frames)
+			if err != nil {
+				return err
+			}
+
+			// Fail here rather than at broadcast if the frames were not a transaction
+			if _, err := clientCtx.TxConfig.TxJSONDecoder()(payload); err != nil {
+				return fmt.Errorf("frames do not hold a transaction: %w", err)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(payload))
+			return err
+		},
+	}
+
+	return cmd
+}
+
+func addQRFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAirgapQRDir, "", "Write QR frames as PNG images to this directory")
+	cmd.Flags().Bool(flagAirgapQRInvert, false, "Draw dark modules as blocks, for terminals with a light background")
+	cmd.Flags().Int(flagAirgapFrameSize, airgap.DefaultFrameSize, "Most characters of payload per QR frame")
+	cmd.Flags().Int(flagAirgapPNGScale, 6, "Pixels per QR module in PNG images")
+}
+
+// readAirgapTx reads an unsigned transaction from a JSON file or from the
+// text of scanned frames
+func readAirgapTx(clientCtx client.Context, stdin io.Reader, path string, fromFrames bool) (sdk.Tx, error) {
+	if !fromFrames {
+		return authclient.ReadTxFromFile(clientCtx, path)
+	}
+
+	bz, err := readAirgapFile(stdin, path)
+	if err != nil {
+		return nil, err
+	}
+	frames, err := airgap.ReadFrames(bytes.NewReader(bz))
+	if err != nil {
+		return nil, err
+	}
+	payload, err := airgap.JoinFrames(frames)
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.TxConfig.TxJSONDecoder()(payload)
+}
+
+func readAirgapFile(stdin io.Reader, path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// writeAirgapOutput writes a signed transaction as JSON to stdout or
+// --output-document, or as QR frames when requested
+func writeAirgapOutput(cmd *cobra.Command, bz []byte) error {
+	showQR, _ := cmd.Flags().GetBool(flagAirgapQR)
+	qrDir, _ := cmd.Flags().GetString(flagAirgapQRDir)
+	if showQR || qrDir != "" {
+		return writeQRFrames(cmd, bz)
+	}
+
+	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDoc == "" {
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+		return err
+	}
+	return os.WriteFile(outputDoc, append(bz, '\n'), 0o600)
+}
+
+// writeQRFrames splits payload into frames and prints each as a QR code, or
+// writes them as numbered PNG images to --qr-dir
+func writeQRFrames(cmd *cobra.Command, payload []byte) error {
+	frameSize, _ := cmd.Flags().GetInt(flagAirgapFrameSize)
+	qrDir, _ := cmd.Flags().GetString(flagAirgapQRDir)
+	invert, _ := cmd.Flags().GetBool(flagAirgapQRInvert)
+	scale, _ := cmd.Flags().GetInt(flagAirgapPNGScale)
+
+	frames, err := airgap.SplitFrames(payload, frameSize)
+	if err != nil {
+		return err
+	}
+	if qrDir != "" {
+		if err := os.MkdirAll(qrDir, 0o700); err != nil {
+			return err
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	for _, frame := range frames {
+		qr, err := airgap.EncodeQR([]byte(frame.String()))
+		if err != nil {
+			return fmt.Errorf("frame %d: %w; lower --%s", frame.Index, err, flagAirgapFrameSize)
+		}
+
+		if qrDir == "" {
+			fmt.Fprintf(out, "Frame %d of %d\n", frame.Index, frame.Total)
+			if err := qr.WriteText(out, invert); err != nil {
+				return err
+			}
+			continue
+		}
+
+		path := filepath.Join(qrDir, fmt.Sprintf("frame-%03d-of-%03d.png", frame.Index, frame.Total))
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		if err := qr.WritePNG(f, scale); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, path)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	sonrctx "github.com/sonr-io/sonr/app/context"
+)
+
+const (
+	flagGasPriceTier = "tier"
+	flagGasPriceOnly = "price-only"
+)
+
+// gasPriceOutput is the fee market status printed by GasPriceCmd
+type gasPriceOutput struct {
+	DynamicBaseFee bool              `json:"dynamic_base_fee"`
+	BaseFee        string            `json:"base_fee"`
+	NextBaseFee    string            `json:"next_base_fee"`
+	MinGasPrice    string            `json:"min_gas_price"`
+	BlockGas       string            `json:"block_gas"`
+	GasTarget      string            `json:"gas_target"`
+	Utilization    string            `json:"utilization"`
+	Suggested      map[string]string `json:"suggested_gas_prices"`
+}
+
+// GasPriceCmd returns the command that shows the fee market's congestion and
+// the gas prices to sign with
+func GasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price",
+		Short: "Show the current base fee, block congestion and suggested gas prices",
+		Long: `Show the EIP-1559 fee market: the current base fee, the last block's gas as a
+fraction of the gas target, and the base fee expected in the next block.
+
+Suggested gas prices are given per tier. "low" pays the expected next base
+fee, "standard" and "fast" add one and two blocks of the largest increase the
+base fee can make, for transactions that must not wait while fees rise. Pass
+one as --gas-prices, in the chain's base denom.`,
+		Example: `  snrd query gas-price
+  snrd tx bank send alice idx1... 1000usnr --gas auto --gas-prices "$(snrd query gas-price --tier fast --price-only)usnr"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, err := sonrctx.QueryFeeMarket(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+
+			tierName, _ := cmd.Flags().GetString(flagGasPriceTier)
+			tier, err := sonrctx.ParseGasPriceTier(tierName)
+			if err != nil {
+				return err
+			}
+			if priceOnly, _ := cmd.Flags().GetBool(flagGasPriceOnly); priceOnly {
+				_, err := fmt.Fprintln(cmd.OutOrStdout(), state.SuggestGasPrice(tier).String())
+				return err
+			}
+
+			out := gasPriceOutput{
+				DynamicBaseFee: !state.NoBaseFee,
+				BaseFee:        state.BaseFee.String(),
+				NextBaseFee:    state.NextBaseFee().String(),
+				MinGasPrice:    state.MinGasPrice.String(),
+				BlockGas:       strconv.FormatUint(state.BlockGas, 10),
+				GasTarget:      strconv.FormatUint(state.GasTarget(), 10),
+				Utilization:    strconv.FormatFloat(state.Utilization(), 'f', 4, 64),
+				Suggested:      make(map[string]string),
+			}
+			for _, t := range []sonrctx.GasPriceTier{sonrctx.GasPriceLow, sonrctx.GasPriceStandard, sonrctx.GasPriceFast} {
+				out.Suggested[t.String()] = state.SuggestGasPrice(t).String()
+			}
+
+			bz, err := json.Marshal(out)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagGasPriceTier, sonrctx.GasPriceStandard.String(), "Tier printed with --price-only: low, standard or fast")
+	cmd.Flags().Bool(flagGasPriceOnly, false, "Print only the suggested gas price of --tier")
+	return cmd
+}
@@ -0,0 +1,255 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	didv1 "github.com/sonr-io/sonr/api/did/v1"
+	svcv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/app/clock"
+	"github.com/sonr-io/sonr/app/legacy"
+	"github.com/sonr-io/sonr/app/upgrades/legacyimport"
+	v016 "github.com/sonr-io/sonr/app/upgrades/v016"
+	"github.com/sonr-io/sonr/x/did/client/server"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	svctypes "github.com/sonr-io/sonr/x/svc/types"
+)
+
+const (
+	flagLegacyDryRun = "dry-run"
+	flagLegacyReport = "report"
+)
+
+// LegacyCmd returns the command for migrating sonrhq/core state
+func LegacyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "legacy",
+		Short: "Migrate accounts, credentials and services from sonrhq/core",
+		Long: `Import a JSON export of sonrhq/core accounts, WebAuthn credentials and
+service records into the x/did and x/svc modules and the local credential
+database.
+
+Chain state is imported by the ` + v016.UpgradeName + ` upgrade. Use 'plan' to
+check an export against a running node and print the upgrade plan info; use
+'import-local' to load the same export into the local credential database.
+Both are safe to re-run: records that were already migrated are reported as
+unchanged and conflicting records are never overwritten.`,
+	}
+
+	cmd.AddCommand(
+		legacyPlanCmd(),
+		legacyImportLocalCmd(),
+	)
+	return cmd
+}
+
+func legacyPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan [export-file]",
+		Short: "Dry-run a legacy export against chain state",
+		Long: `Map every record in a legacy export and compare it with the state of the
+connected node, without writing anything. Prints the migration report and the
+upgrade plan info that pins the export by digest.`,
+		Example: `  snrd legacy plan sonrhq-export.json --node tcp://localhost:26657
+  snrd legacy plan sonrhq-export.json --report plan.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			export, digest, err := legacy.LoadExport(args[0])
+			if err != nil {
+				return err
+			}
+
+			report, err := legacy.Migrate(
+				cmd.Context(),
+				newQueryTarget(clientCtx),
+				export,
+				true,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := writeLegacyReport(cmd, report); err != nil {
+				return err
+			}
+
+			info, err := json.Marshal(legacyimport.PlanInfo{
+				LegacyExport:       args[0],
+				LegacyExportSHA256: digest,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\nUpgrade plan info (%s):\n%s\n", v016.UpgradeName, info)
+			fmt.Fprintln(cmd.OutOrStdout(), "Copy the export to the same path under every node home before the upgrade height.")
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagLegacyReport, "", "Write the full report as JSON to this file")
+	return cmd
+}
+
+func legacyImportLocalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-local [export-file]",
+		Short: "Import legacy accounts and credentials into the local credential database",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, _ := cmd.Flags().GetBool(flagLegacyDryRun)
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+
+			export, _, err := legacy.LoadExport(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := server.InitDB(); err != nil {
+				return err
+			}
+			defer server.CloseDB()
+
+			report, err := legacy.MigrateLocal(server.GetDB(), export, chainID, dryRun)
+			if err != nil {
+				return err
+			}
+			return writeLegacyReport(cmd, report)
+		},
+	}
+
+	cmd.Flags().Bool(flagLegacyDryRun, false, "Report what would be imported without writing")
+	cmd.Flags().String(flagLegacyReport, "", "Write the full report as JSON to this file")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID recorded on imported accounts")
+	return cmd
+}
+
+// writeLegacyReport prints the report summary and, when --report is set,
+// writes the full report as JSON
+func writeLegacyReport(cmd *cobra.Command, report *legacy.Report) error {
+	if err := report.WriteText(cmd.OutOrStdout()); err != nil {
+		return err
+	}
+
+	path, _ := cmd.Flags().GetString(flagLegacyReport)
+	if path == "" {
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, bz, 0o600); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "\nReport written to %s\n", path)
+	return nil
+}
+
+// queryTarget is a read-only legacy.Target backed by node queries, used for
+// dry runs
+type queryTarget struct {
+	did didtypes.QueryClient
+	svc svctypes.QueryClient
+}
+
+var _ legacy.Target = queryTarget{}
+
+func newQueryTarget(clientCtx client.Context) queryTarget {
+	return queryTarget{
+		did: didtypes.NewQueryClient(clientCtx),
+		svc: svctypes.NewQueryClient(clientCtx),
+	}
+}
+
+func (t queryTarget) GetDIDDocument(ctx context.Context, did string) (*didv1.DIDDocument, error) {
+	res, err := t.did.GetDIDDocument(ctx, &didtypes.QueryGetDIDDocumentRequest{Did: did})
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.DidDocument.ToORM(), nil
+}
+
+func (t queryTarget) GetService(ctx context.Context, id string) (*svcv1.Service, error) {
+	res, err := t.svc.Service(ctx, &svctypes.QueryServiceRequest{ServiceId: id})
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return serviceToORM(res.Service), nil
+}
+
+func (t queryTarget) GetServiceByDomain(ctx context.Context, domain string) (*svcv1.Service, error) {
+	res, err := t.svc.ServicesByDomain(ctx, &svctypes.QueryServicesByDomainRequest{Domain: domain})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Services) == 0 {
+		return nil, nil
+	}
+	return serviceToORM(res.Services[0]), nil
+}
+
+func (t queryTarget) IsDomainVerified(ctx context.Context, domain string) (bool, error) {
+	res, err := t.svc.DomainVerification(ctx, &svctypes.QueryDomainVerificationRequest{Domain: domain})
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	v := res.DomainVerification
+	return v != nil &&
+		v.Status == svctypes.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED &&
+		!clock.Expired(time.Now(), v.ExpiresAt), nil
+}
+
+func (t queryTarget) InsertDIDDocument(context.Context, *didv1.DIDDocument) error {
+	return fmt.Errorf("query target is read-only")
+}
+
+func (t queryTarget) InsertService(context.Context, *svcv1.Service) error {
+	return fmt.Errorf("query target is read-only")
+}
+
+// isNotFound reports whether a query error means the record does not exist.
+// Module queriers wrap store misses with a "not found" message rather than a
+// distinct gRPC code.
+func isNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
+
+func serviceToORM(s *svctypes.Service) *svcv1.Service {
+	if s == nil {
+		return nil
+	}
+	return &svcv1.Service{
+		Id:          s.Id,
+		Domain:      s.Domain,
+		Owner:       s.Owner,
+		Permissions: s.Permissions,
+		Status:      svcv1.ServiceStatus(s.Status),
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
@@ -0,0 +1,202 @@
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+// GasPriceTier selects how far ahead of the base fee a suggested gas price is
+type GasPriceTier int
+
+const (
+	// GasPriceLow pays the expected next base fee; it may wait a block or two
+	// while the fee is rising
+	GasPriceLow GasPriceTier = iota
+	// GasPriceStandard covers one block of the base fee's largest increase
+	GasPriceStandard
+	// GasPriceFast covers two blocks of the base fee's largest increase
+	GasPriceFast
+)
+
+// String implements fmt.Stringer
+func (t GasPriceTier) String() string {
+	switch t {
+	case GasPriceLow:
+		return "low"
+	case GasPriceStandard:
+		return "standard"
+	case GasPriceFast:
+		return "fast"
+	default:
+		return fmt.Sprintf("tier(%d)", int(t))
+	}
+}
+
+// ParseGasPriceTier parses a tier name as returned by String
+func ParseGasPriceTier(s string) (GasPriceTier, error) {
+	for _, t := range []GasPriceTier{GasPriceLow, GasPriceStandard, GasPriceFast} {
+		if t.String() == s {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown gas price tier %q, want low, standard or fast", s)
+}
+
+// FeeMarketState is a snapshot of the EIP-1559 fee market, from which the
+// congestion of the last block and the next base fee are derived
+type FeeMarketState struct {
+	// NoBaseFee is set when the dynamic base fee is disabled
+	NoBaseFee bool
+	// BaseFee is the current base fee per unit of gas in the EVM denom
+	BaseFee math.LegacyDec
+	// MinGasPrice is the floor the base fee never drops below
+	MinGasPrice math.LegacyDec
+	// BlockGas is the gas wanted by the last block
+	BlockGas uint64
+	// MaxBlockGas is the consensus block gas limit; zero is unlimited
+	MaxBlockGas uint64
+	// ElasticityMultiplier divides the block gas limit into the gas target
+	ElasticityMultiplier uint32
+	// BaseFeeChangeDenominator bounds the base fee change per block to
+	// 1/BaseFeeChangeDenominator
+	BaseFeeChangeDenominator uint32
+}
+
+// GasTarget is the block gas at which the base fee stays unchanged, or zero
+// when blocks are unlimited and there is no target
+func (s FeeMarketState) GasTarget() uint64 {
+	if s.MaxBlockGas == 0 || s.ElasticityMultiplier == 0 {
+		return 0
+	}
+	return s.MaxBlockGas / uint64(s.ElasticityMultiplier)
+}
+
+// Utilization is the last block's gas as a fraction of the gas target.
+// Above 1 the base fee rises, below 1 it falls.
+func (s FeeMarketState) Utilization() float64 {
+	target := s.GasTarget()
+	if target == 0 {
+		return 0
+	}
+	return float64(s.BlockGas) / float64(target)
+}
+
+// NextBaseFee returns the base fee of the next block, following the
+// feemarket module's EIP-1559 adjustment
+func (s FeeMarketState) NextBaseFee() math.LegacyDec {
+	baseFee := s.floor(s.BaseFee)
+	target := s.GasTarget()
+	if s.NoBaseFee || target == 0 || s.BaseFeeChangeDenominator == 0 || s.BlockGas == target {
+		return baseFee
+	}
+
+	denominator := math.LegacyNewDec(int64(target)).MulInt64(int64(s.BaseFeeChangeDenominator))
+	if s.BlockGas > target {
+		delta := baseFee.MulInt64(int64(s.BlockGas - target)).Quo(denominator)
+		// The base fee rises by at least one unit when blocks are over target
+		delta = math.LegacyMaxDec(delta, math.LegacySmallestDec())
+		return baseFee.Add(delta)
+	}
+
+	delta := baseFee.MulInt64(int64(target - s.BlockGas)).Quo(denominator)
+	return s.floor(baseFee.Sub(delta))
+}
+
+// SuggestGasPrice returns a gas price for the tier. Each tier above low adds
+// one block of the base fee's largest possible increase, so a transaction
+// signed now still clears the base fee if inclusion is delayed.
+func (s FeeMarketState) SuggestGasPrice(tier GasPriceTier) math.LegacyDec {
+	price := s.NextBaseFee()
+	if s.NoBaseFee || s.BaseFeeChangeDenominator == 0 {
+		return price
+	}
+
+	step := math.LegacyOneDec().Add(math.LegacyOneDec().QuoInt64(int64(s.BaseFeeChangeDenominator)))
+	for i := GasPriceLow; i < tier; i++ {
+		price = price.Mul(step)
+	}
+	return price
+}
+
+func (s FeeMarketState) floor(fee math.LegacyDec) math.LegacyDec {
+	if fee.IsNil() || fee.IsNegative() {
+		fee = math.LegacyZeroDec()
+	}
+	if !s.MinGasPrice.IsNil() && fee.LT(s.MinGasPrice) {
+		return s.MinGasPrice
+	}
+	return fee
+}
+
+// consensusParamsClient is implemented by CometBFT RPC clients that expose
+// consensus parameters
+type consensusParamsClient interface {
+	ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error)
+}
+
+// QueryFeeMarket reads the fee market parameters, current base fee and last
+// block's gas from a node, and the block gas limit from its consensus
+// parameters
+func QueryFeeMarket(ctx context.Context, clientCtx client.Context) (FeeMarketState, error) {
+	queryClient := feemarkettypes.NewQueryClient(clientCtx)
+
+	params, err := queryClient.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return FeeMarketState{}, fmt.Errorf("failed to query fee market params: %w", err)
+	}
+	state := FeeMarketState{
+		NoBaseFee:                params.Params.NoBaseFee,
+		BaseFee:                  params.Params.BaseFee,
+		MinGasPrice:              params.Params.MinGasPrice,
+		ElasticityMultiplier:     params.Params.ElasticityMultiplier,
+		BaseFeeChangeDenominator: params.Params.BaseFeeChangeDenominator,
+	}
+
+	baseFee, err := queryClient.BaseFee(ctx, &feemarkettypes.QueryBaseFeeRequest{})
+	if err != nil {
+		return FeeMarketState{}, fmt.Errorf("failed to query base fee: %w", err)
+	}
+	if baseFee.BaseFee != nil {
+		state.BaseFee = *baseFee.BaseFee
+	}
+
+	blockGas, err := queryClient.BlockGas(ctx, &feemarkettypes.QueryBlockGasRequest{})
+	if err != nil {
+		return FeeMarketState{}, fmt.Errorf("failed to query block gas: %w", err)
+	}
+	if blockGas.Gas > 0 {
+		state.BlockGas = uint64(blockGas.Gas)
+	}
+
+	if rpc, ok := clientCtx.Client.(consensusParamsClient); ok {
+		consensus, err := rpc.ConsensusParams(ctx, nil)
+		if err != nil {
+			return FeeMarketState{}, fmt.Errorf("failed to query consensus params: %w", err)
+		}
+		if maxGas := consensus.ConsensusParams.Block.MaxGas; maxGas > 0 {
+			state.MaxBlockGas = uint64(maxGas)
+		}
+	}
+
+	return state, nil
+}
+
+// GasPriceSource returns the gas price a transaction should be signed with
+type GasPriceSource func(ctx context.Context) (math.LegacyDec, error)
+
+// NodeGasPrices returns a GasPriceSource that suggests gas prices for tier
+// from a node's fee market
+func NodeGasPrices(clientCtx client.Context, tier GasPriceTier) GasPriceSource {
+	return func(ctx context.Context) (math.LegacyDec, error) {
+		state, err := QueryFeeMarket(ctx, clientCtx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		return state.SuggestGasPrice(tier), nil
+	}
+}
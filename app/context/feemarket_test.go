@@ -0,0 +1,70 @@
+package context
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func testFeeMarket(blockGas uint64) FeeMarketState {
+	return FeeMarketState{
+		BaseFee:                  math.LegacyMustNewDecFromStr("0.8"),
+		MinGasPrice:              math.LegacyMustNewDecFromStr("0.1"),
+		BlockGas:                 blockGas,
+		MaxBlockGas:              10_000_000,
+		ElasticityMultiplier:     2,
+		BaseFeeChangeDenominator: 8,
+	}
+}
+
+func TestFeeMarketNextBaseFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		state       FeeMarketState
+		utilization float64
+		next        string
+	}{
+		{"at target", testFeeMarket(5_000_000), 1, "0.800000000000000000"},
+		{"full block raises by an eighth", testFeeMarket(10_000_000), 2, "0.900000000000000000"},
+		{"empty block lowers by an eighth", testFeeMarket(0), 0, "0.700000000000000000"},
+		{"half target", testFeeMarket(2_500_000), 0.5, "0.750000000000000000"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, uint64(5_000_000), tc.state.GasTarget())
+			require.InDelta(t, tc.utilization, tc.state.Utilization(), 1e-9)
+			require.Equal(t, tc.next, tc.state.NextBaseFee().String())
+		})
+	}
+
+	t.Run("never below the minimum gas price", func(t *testing.T) {
+		state := testFeeMarket(0)
+		state.BaseFee = math.LegacyMustNewDecFromStr("0.105")
+		require.Equal(t, "0.100000000000000000", state.NextBaseFee().String())
+	})
+
+	t.Run("unchanged without a block gas limit", func(t *testing.T) {
+		state := testFeeMarket(10_000_000)
+		state.MaxBlockGas = 0
+		require.Zero(t, state.Utilization())
+		require.Equal(t, "0.800000000000000000", state.NextBaseFee().String())
+	})
+}
+
+func TestFeeMarketSuggestGasPrice(t *testing.T) {
+	state := testFeeMarket(10_000_000)
+	require.Equal(t, "0.900000000000000000", state.SuggestGasPrice(GasPriceLow).String())
+	require.Equal(t, "1.012500000000000000", state.SuggestGasPrice(GasPriceStandard).String())
+	require.Equal(t, "1.139062500000000000", state.SuggestGasPrice(GasPriceFast).String())
+
+	state.NoBaseFee = true
+	require.Equal(t, "0.800000000000000000", state.SuggestGasPrice(GasPriceFast).String())
+
+	tier, err := ParseGasPriceTier("fast")
+	require.NoError(t, err)
+	require.Equal(t, GasPriceFast, tier)
+	_, err = ParseGasPriceTier("urgent")
+	require.Error(t, err)
+}
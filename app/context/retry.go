@@ -0,0 +1,391 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrSequenceMismatch is returned when the account sequence kept changing under the caller
+	ErrSequenceMismatch = errors.New("account sequence mismatch")
+
+	// ErrTxStuck is returned when a transaction was accepted by the mempool but
+	// neither included nor evicted before the stuck timeout
+	ErrTxStuck = errors.New("transaction stuck in mempool")
+
+	// ErrInsufficientFee is returned when the fee could not be bumped high enough
+	ErrInsufficientFee = errors.New("insufficient fee")
+
+	// ErrTxRejected is returned for CheckTx or DeliverTx failures that retrying cannot fix
+	ErrTxRejected = errors.New("transaction rejected")
+
+	// ErrTxNotFound is returned by a TxBroadcaster when a transaction is not indexed
+	ErrTxNotFound = errors.New("transaction not found")
+
+	// ErrMempoolUnavailable is returned by a TxBroadcaster that cannot inspect the mempool
+	ErrMempoolUnavailable = errors.New("mempool not available")
+)
+
+// expectedSequenceRe extracts the expected sequence from an ErrWrongSequence log
+var expectedSequenceRe = regexp.MustCompile(`expected (\d+), got (\d+)`)
+
+// BroadcastError describes the final failure of a retried broadcast
+type BroadcastError struct {
+	Err       error
+	TxHash    string
+	Code      uint32
+	Codespace string
+	RawLog    string
+	Attempts  int
+}
+
+// Error implements error
+func (e *BroadcastError) Error() string {
+	if e.TxHash != "" {
+		return fmt.Sprintf("%v after %d attempts (tx %s, code %d): %s", e.Err, e.Attempts, e.TxHash, e.Code, e.RawLog)
+	}
+	return fmt.Sprintf("%v after %d attempts (code %d): %s", e.Err, e.Attempts, e.Code, e.RawLog)
+}
+
+// Unwrap returns the typed cause
+func (e *BroadcastError) Unwrap() error {
+	return e.Err
+}
+
+// TxAttempt carries the parameters a transaction must be (re)signed with
+type TxAttempt struct {
+	// Attempt is the zero-based attempt number
+	Attempt int
+	// Sequence is the account sequence to sign with
+	Sequence uint64
+	// GasPriceMultiplier scales the caller's base gas price; it grows on every fee bump
+	GasPriceMultiplier float64
+	// GasPrice is the base gas price read from RetryOptions.GasPrices before
+	// the attempt, or nil without a source. When set it replaces the caller's
+	// base gas price and is scaled by GasPriceMultiplier like it.
+	GasPrice math.LegacyDec
+}
+
+// TxBuildFunc signs and encodes a transaction for the given attempt
+type TxBuildFunc func(ctx context.Context, attempt TxAttempt) ([]byte, error)
+
+// TxBroadcaster is the node access BroadcastWithRetry needs
+type TxBroadcaster interface {
+	// BroadcastTxSync submits a transaction and returns its CheckTx result
+	BroadcastTxSync(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error)
+	// GetTx returns an included transaction, or ErrTxNotFound
+	GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error)
+	// InMempool reports whether a transaction is still waiting in the node's
+	// mempool, or ErrMempoolUnavailable if the node cannot tell
+	InMempool(ctx context.Context, hash string) (bool, error)
+	// AccountSequence returns the committed sequence of an account
+	AccountSequence(ctx context.Context, address string) (uint64, error)
+}
+
+// RetryOptions configures BroadcastWithRetry
+type RetryOptions struct {
+	// Address is the signer, used to look up the current account sequence
+	Address string
+	// MaxAttempts bounds the number of signed transactions that are broadcast
+	MaxAttempts int
+	// StuckTimeout is how long an accepted transaction may wait for inclusion
+	StuckTimeout time.Duration
+	// PollInterval is how often an accepted transaction is looked up
+	PollInterval time.Duration
+	// FeeBump is the multiplier applied to the gas price on each resubmission
+	FeeBump float64
+	// GasPrices, when set, is asked for the current gas price before every
+	// attempt so fees follow the base fee, e.g. NodeGasPrices
+	GasPrices GasPriceSource
+}
+
+// DefaultRetryOptions returns retry options suitable for local and test networks
+func DefaultRetryOptions(address string) RetryOptions {
+	return RetryOptions{
+		Address:      address,
+		MaxAttempts:  4,
+		StuckTimeout: 30 * time.Second,
+		PollInterval: time.Second,
+		FeeBump:      1.25,
+	}
+}
+
+// errTxEvicted reports that an accepted transaction left the mempool without
+// being included, so its sequence is free to be signed again
+var errTxEvicted = errors.New("transaction evicted from mempool")
+
+// BroadcastWithRetry signs, broadcasts and waits for a transaction.
+//
+// Sequence mismatches are resolved by re-signing with the sequence the node
+// expects and fee rejections by re-signing with a bumped gas price. Once a
+// transaction has been accepted it is never replaced while it can still land:
+// the helper waits until it is included or observed evicted from the node's
+// mempool, and only then re-signs the same sequence with a higher fee. A
+// transaction that is still pending after StuckTimeout is reported as
+// ErrTxStuck without being replaced.
+func BroadcastWithRetry(
+	ctx context.Context,
+	b TxBroadcaster,
+	build TxBuildFunc,
+	opts RetryOptions,
+) (*sdk.TxResponse, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	sequence, err := b.AccountSequence(ctx, opts.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := TxAttempt{Sequence: sequence, GasPriceMultiplier: 1}
+	var last *BroadcastError
+
+	for ; attempt.Attempt < opts.MaxAttempts; attempt.Attempt++ {
+		if opts.GasPrices != nil {
+			if attempt.GasPrice, err = opts.GasPrices(ctx); err != nil {
+				return nil, fmt.Errorf("failed to get gas price: %w", err)
+			}
+		}
+
+		txBytes, err := build(ctx, attempt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build transaction: %w", err)
+		}
+		hash := TxHash(txBytes)
+
+		resp, err := b.BroadcastTxSync(ctx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		last = &BroadcastError{
+			TxHash:    hash,
+			Code:      resp.Code,
+			Codespace: resp.Codespace,
+			RawLog:    resp.RawLog,
+			Attempts:  attempt.Attempt + 1,
+		}
+
+		root := resp.Codespace == sdkerrors.RootCodespace
+		switch {
+		case resp.Code == 0,
+			root && resp.Code == sdkerrors.ErrTxInMempoolCache.ABCICode():
+			// Accepted now, or these exact bytes were accepted before: either
+			// way the transaction is pending and must be waited for.
+			included, err := awaitTx(ctx, b, hash, opts)
+			switch {
+			case err == nil:
+				if included.Code != 0 {
+					last.Code = included.Code
+					last.Codespace = included.Codespace
+					last.RawLog = included.RawLog
+					last.Err = ErrTxRejected
+					return nil, last
+				}
+				return included, nil
+
+			case errors.Is(err, errTxEvicted):
+				// The sequence is free again: re-sign it with a higher fee
+				last.Err = ErrTxStuck
+				attempt.GasPriceMultiplier *= opts.FeeBump
+
+			case errors.Is(err, ErrTxStuck):
+				last.Err = ErrTxStuck
+				return nil, last
+
+			default:
+				return nil, err
+			}
+
+		case root && resp.Code == sdkerrors.ErrWrongSequence.ABCICode():
+			last.Err = ErrSequenceMismatch
+			if expected, ok := parseExpectedSequence(resp.RawLog); ok {
+				attempt.Sequence = expected
+			} else if attempt.Sequence, err = b.AccountSequence(ctx, opts.Address); err != nil {
+				return nil, err
+			}
+
+		case root && resp.Code == sdkerrors.ErrInsufficientFee.ABCICode():
+			last.Err = ErrInsufficientFee
+			attempt.GasPriceMultiplier *= opts.FeeBump
+
+		default:
+			last.Err = ErrTxRejected
+			return nil, last
+		}
+	}
+
+	if last == nil {
+		return nil, fmt.Errorf("no broadcast attempts made")
+	}
+	return nil, last
+}
+
+// awaitTx polls until a pending transaction is included, is evicted from the
+// mempool, or StuckTimeout elapses. Eviction is only reported after two
+// consecutive observations so a transaction that was just committed but not
+// yet indexed is not mistaken for an evicted one.
+func awaitTx(ctx context.Context, b TxBroadcaster, hash string, opts RetryOptions) (*sdk.TxResponse, error) {
+	deadline := time.Now().Add(opts.StuckTimeout)
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	missing := 0
+	for {
+		// Check the mempool before the index so a commit in between is seen
+		pending, poolErr := b.InMempool(ctx, hash)
+
+		tx, err := b.GetTx(ctx, hash)
+		if err == nil {
+			return tx, nil
+		}
+		if !errors.Is(err, ErrTxNotFound) {
+			return nil, err
+		}
+
+		if poolErr == nil && !pending {
+			missing++
+			if missing >= 2 {
+				return nil, errTxEvicted
+			}
+		} else {
+			// Pending, or the node cannot tell: keep treating it as live
+			missing = 0
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil, ErrTxStuck
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// TxHash returns the hex-encoded hash CometBFT indexes a transaction under
+func TxHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+}
+
+// parseExpectedSequence extracts the expected sequence from a wrong-sequence raw log
+func parseExpectedSequence(rawLog string) (uint64, bool) {
+	m := expectedSequenceRe.FindStringSubmatch(rawLog)
+	if len(m) != 3 {
+		return 0, false
+	}
+	expected, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return expected, true
+}
+
+// nodeBroadcaster implements TxBroadcaster over a client context's gRPC
+// connection and CometBFT RPC client
+type nodeBroadcaster struct {
+	clientCtx client.Context
+}
+
+// NewNodeBroadcaster returns a TxBroadcaster backed by a client context
+func NewNodeBroadcaster(clientCtx client.Context) TxBroadcaster {
+	return nodeBroadcaster{clientCtx: clientCtx}
+}
+
+// Broadcaster returns a TxBroadcaster for the stored client context
+func (sc *SonrContext) Broadcaster() (TxBroadcaster, error) {
+	clientCtx, err := sc.GetClientContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client context: %w", err)
+	}
+	return NewNodeBroadcaster(clientCtx), nil
+}
+
+// BroadcastTxSync implements TxBroadcaster
+func (n nodeBroadcaster) BroadcastTxSync(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	res, err := txtypes.NewServiceClient(n.clientCtx).BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+	return res.TxResponse, nil
+}
+
+// GetTx implements TxBroadcaster
+func (n nodeBroadcaster) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	res, err := txtypes.NewServiceClient(n.clientCtx).GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+	if status.Code(err) == codes.NotFound ||
+		(err != nil && strings.Contains(err.Error(), "not found")) {
+		return nil, ErrTxNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transaction: %w", err)
+	}
+	return res.TxResponse, nil
+}
+
+// unconfirmedTxsClient is implemented by CometBFT RPC clients that expose the mempool
+type unconfirmedTxsClient interface {
+	UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error)
+}
+
+// InMempool implements TxBroadcaster
+func (n nodeBroadcaster) InMempool(ctx context.Context, hash string) (bool, error) {
+	rpc, ok := n.clientCtx.Client.(unconfirmedTxsClient)
+	if !ok {
+		return false, ErrMempoolUnavailable
+	}
+
+	// A limit of 0 is rewritten by the node to its default page size, so ask
+	// for the node's maximum instead
+	limit := 100
+	res, err := rpc.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return false, fmt.Errorf("failed to query mempool: %w", err)
+	}
+	if res.Total > res.Count {
+		// The mempool is larger than one page; we cannot prove absence
+		return false, ErrMempoolUnavailable
+	}
+	for _, tx := range res.Txs {
+		if fmt.Sprintf("%X", tx.Hash()) == hash {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AccountSequence implements TxBroadcaster
+func (n nodeBroadcaster) AccountSequence(ctx context.Context, address string) (uint64, error) {
+	res, err := authtypes.NewQueryClient(n.clientCtx).AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{
+		Address: address,
+	})
+	if status.Code(err) == codes.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query account sequence: %w", err)
+	}
+	return res.Info.GetSequence(), nil
+}
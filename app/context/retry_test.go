@@ -0,0 +1,198 @@
+package context
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a scripted TxBroadcaster. Each broadcast pops the next CheckTx
+// result; accepted transactions enter the mempool and are included after
+// includeAfter lookups unless they are marked evicted.
+type fakeNode struct {
+	sequence     uint64
+	checkTx      []*sdk.TxResponse
+	includeAfter int
+	evict        bool
+
+	broadcasts []TxAttempt
+	mempool    map[string]bool
+	lookups    map[string]int
+	included   map[string]*sdk.TxResponse
+}
+
+func newFakeNode(sequence uint64, checkTx ...*sdk.TxResponse) *fakeNode {
+	return &fakeNode{
+		sequence: sequence,
+		checkTx:  checkTx,
+		mempool:  make(map[string]bool),
+		lookups:  make(map[string]int),
+		included: make(map[string]*sdk.TxResponse),
+	}
+}
+
+func (n *fakeNode) build(_ context.Context, attempt TxAttempt) ([]byte, error) {
+	n.broadcasts = append(n.broadcasts, attempt)
+	return []byte(fmt.Sprintf("seq=%d gas=%.4f", attempt.Sequence, attempt.GasPriceMultiplier)), nil
+}
+
+func (n *fakeNode) BroadcastTxSync(_ context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	resp := &sdk.TxResponse{}
+	if len(n.checkTx) > 0 {
+		resp, n.checkTx = n.checkTx[0], n.checkTx[1:]
+	}
+	if resp.Code == 0 {
+		n.mempool[TxHash(txBytes)] = !n.evict
+	}
+	return resp, nil
+}
+
+func (n *fakeNode) GetTx(_ context.Context, hash string) (*sdk.TxResponse, error) {
+	if tx, ok := n.included[hash]; ok {
+		return tx, nil
+	}
+	if n.mempool[hash] {
+		n.lookups[hash]++
+		if n.lookups[hash] > n.includeAfter {
+			delete(n.mempool, hash)
+			n.included[hash] = &sdk.TxResponse{TxHash: hash, Height: 10}
+			return n.included[hash], nil
+		}
+	}
+	return nil, ErrTxNotFound
+}
+
+func (n *fakeNode) InMempool(_ context.Context, hash string) (bool, error) {
+	return n.mempool[hash], nil
+}
+
+func (n *fakeNode) AccountSequence(context.Context, string) (uint64, error) {
+	return n.sequence, nil
+}
+
+func testRetryOptions() RetryOptions {
+	opts := DefaultRetryOptions("idx1signer")
+	opts.StuckTimeout = 50 * time.Millisecond
+	opts.PollInterval = time.Millisecond
+	return opts
+}
+
+func rootError(err *errorsmod.Error, log string) *sdk.TxResponse {
+	return &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: err.ABCICode(), RawLog: log}
+}
+
+func TestParseExpectedSequence(t *testing.T) {
+	testCases := []struct {
+		rawLog   string
+		expected uint64
+		ok       bool
+	}{
+		{"account sequence mismatch, expected 7, got 5: incorrect account sequence", 7, true},
+		{"expected 0, got 1", 0, true},
+		{"incorrect account sequence", 0, false},
+		{"expected 99999999999999999999, got 1", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tc := range testCases {
+		expected, ok := parseExpectedSequence(tc.rawLog)
+		require.Equal(t, tc.ok, ok, tc.rawLog)
+		require.Equal(t, tc.expected, expected, tc.rawLog)
+	}
+}
+
+func TestBroadcastWithRetry(t *testing.T) {
+	t.Run("included on first attempt", func(t *testing.T) {
+		node := newFakeNode(3)
+		resp, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.NoError(t, err)
+		require.Equal(t, int64(10), resp.Height)
+		require.Len(t, node.broadcasts, 1)
+		require.Equal(t, uint64(3), node.broadcasts[0].Sequence)
+	})
+
+	t.Run("wrong sequence re-signs with the expected sequence", func(t *testing.T) {
+		node := newFakeNode(3, rootError(sdkerrors.ErrWrongSequence, "expected 5, got 3"))
+		_, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.NoError(t, err)
+		require.Len(t, node.broadcasts, 2)
+		require.Equal(t, uint64(5), node.broadcasts[1].Sequence)
+	})
+
+	t.Run("insufficient fee bumps the gas price", func(t *testing.T) {
+		node := newFakeNode(3, rootError(sdkerrors.ErrInsufficientFee, "insufficient fees"))
+		_, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.NoError(t, err)
+		require.Len(t, node.broadcasts, 2)
+		require.Equal(t, uint64(3), node.broadcasts[1].Sequence)
+		require.InDelta(t, 1.25, node.broadcasts[1].GasPriceMultiplier, 1e-9)
+	})
+
+	t.Run("mempool cache hit waits for the original instead of bumping", func(t *testing.T) {
+		node := newFakeNode(3)
+		// The same bytes were accepted by an earlier call
+		txBytes, _ := node.build(context.Background(), TxAttempt{Sequence: 3, GasPriceMultiplier: 1})
+		node.broadcasts = nil
+		node.mempool[TxHash(txBytes)] = true
+		node.checkTx = []*sdk.TxResponse{rootError(sdkerrors.ErrTxInMempoolCache, "tx already exists in cache")}
+
+		resp, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.NoError(t, err)
+		require.Equal(t, TxHash(txBytes), resp.TxHash)
+		require.Len(t, node.broadcasts, 1)
+	})
+
+	t.Run("pending transaction is not replaced", func(t *testing.T) {
+		node := newFakeNode(3)
+		node.includeAfter = 1 << 30
+
+		_, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.ErrorIs(t, err, ErrTxStuck)
+		require.Len(t, node.broadcasts, 1, "must not re-sign while the original can land")
+	})
+
+	t.Run("evicted transaction is re-signed at the same sequence", func(t *testing.T) {
+		node := newFakeNode(3)
+		node.evict = true
+
+		_, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.ErrorIs(t, err, ErrTxStuck)
+		require.Len(t, node.broadcasts, 4)
+		for _, attempt := range node.broadcasts {
+			require.Equal(t, uint64(3), attempt.Sequence)
+		}
+		require.Greater(t, node.broadcasts[3].GasPriceMultiplier, node.broadcasts[0].GasPriceMultiplier)
+	})
+
+	t.Run("gas price is refreshed before every attempt", func(t *testing.T) {
+		node := newFakeNode(3, rootError(sdkerrors.ErrInsufficientFee, "insufficient fees"))
+		prices := []string{"0.010", "0.012"}
+		opts := testRetryOptions()
+		opts.GasPrices = func(context.Context) (math.LegacyDec, error) {
+			price := math.LegacyMustNewDecFromStr(prices[0])
+			prices = prices[1:]
+			return price, nil
+		}
+
+		_, err := BroadcastWithRetry(context.Background(), node, node.build, opts)
+		require.NoError(t, err)
+		require.Len(t, node.broadcasts, 2)
+		require.Equal(t, "0.010000000000000000", node.broadcasts[0].GasPrice.String())
+		require.Equal(t, "0.012000000000000000", node.broadcasts[1].GasPrice.String())
+		require.InDelta(t, 1.25, node.broadcasts[1].GasPriceMultiplier, 1e-9)
+	})
+
+	t.Run("module errors are not retried", func(t *testing.T) {
+		node := newFakeNode(3, &sdk.TxResponse{Codespace: "dex", Code: 8, RawLog: "invalid swap parameters"})
+		_, err := BroadcastWithRetry(context.Background(), node, node.build, testRetryOptions())
+		require.ErrorIs(t, err, ErrTxRejected)
+		require.Len(t, node.broadcasts, 1)
+	})
+}
@@ -0,0 +1,144 @@
+// Package errreport provides opt-in crash and error reporting for Sonr
+// binaries. Events are delivered to any Sentry-compatible endpoint and are
+// scrubbed of credentials, key material and session secrets before they leave
+// the process.
+package errreport
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Environment variables used by binaries without an app.toml (e.g. the
+// WebAuthn auth server spawned by CLI commands).
+const (
+	EnvEnabled     = "SONR_ERROR_REPORTING_ENABLED"
+	EnvDSN         = "SONR_ERROR_REPORTING_DSN"
+	EnvEnvironment = "SONR_ERROR_REPORTING_ENVIRONMENT"
+	EnvSampleRate  = "SONR_ERROR_REPORTING_SAMPLE_RATE"
+)
+
+// Config controls the error reporting subsystem. Reporting is disabled unless
+// both Enabled is set and a DSN is configured.
+type Config struct {
+	// Enabled opts the deployment in to error reporting.
+	Enabled bool `mapstructure:"enabled"`
+	// DSN is the Sentry-compatible ingestion endpoint.
+	DSN string `mapstructure:"dsn"`
+	// Environment tags events with the deployment they came from (e.g. testnet).
+	Environment string `mapstructure:"environment"`
+	// SampleRate is the fraction of error events sent, in the range (0, 1].
+	SampleRate float64 `mapstructure:"sample-rate"`
+	// ScrubFields lists additional field names to redact, on top of the
+	// built-in sensitive field list.
+	ScrubFields []string `mapstructure:"scrub-fields"`
+
+	// Release is not read from app.toml; binaries set it from their version.
+	Release string `mapstructure:"-"`
+}
+
+// DefaultConfig returns the default (disabled) configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Environment: "local",
+		SampleRate:  1.0,
+		ScrubFields: []string{},
+	}
+}
+
+// Active reports whether the configuration turns reporting on.
+func (c Config) Active() bool {
+	return c.Enabled && c.DSN != ""
+}
+
+// Validate checks the configuration. A disabled configuration is always valid.
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.DSN == "" {
+		return fmt.Errorf("error reporting enabled but no dsn configured")
+	}
+	if c.SampleRate <= 0 || c.SampleRate > 1 {
+		return fmt.Errorf("error reporting sample rate must be in (0, 1], got %v", c.SampleRate)
+	}
+	return nil
+}
+
+// ConfigFromAppOptions reads the [error-reporting] section of app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions, release string) Config {
+	cfg := DefaultConfig()
+	cfg.Release = release
+
+	cfg.Enabled = cast.ToBool(appOpts.Get("error-reporting.enabled"))
+	cfg.DSN = cast.ToString(appOpts.Get("error-reporting.dsn"))
+	if env := cast.ToString(appOpts.Get("error-reporting.environment")); env != "" {
+		cfg.Environment = env
+	}
+	if rate := cast.ToFloat64(appOpts.Get("error-reporting.sample-rate")); rate > 0 {
+		cfg.SampleRate = rate
+	}
+	cfg.ScrubFields = cast.ToStringSlice(appOpts.Get("error-reporting.scrub-fields"))
+
+	return cfg
+}
+
+// ConfigFromEnv builds a configuration from SONR_ERROR_REPORTING_* variables.
+func ConfigFromEnv(release string) Config {
+	cfg := DefaultConfig()
+	cfg.Release = release
+
+	cfg.Enabled, _ = strconv.ParseBool(os.Getenv(EnvEnabled))
+	cfg.DSN = os.Getenv(EnvDSN)
+	if env := os.Getenv(EnvEnvironment); env != "" {
+		cfg.Environment = env
+	}
+	if rate, err := strconv.ParseFloat(os.Getenv(EnvSampleRate), 64); err == nil && rate > 0 {
+		cfg.SampleRate = rate
+	}
+
+	return cfg
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                          Error Reporting                                ###
+###############################################################################
+
+# Opt-in crash and error reporting to a Sentry-compatible endpoint. Payloads
+# are scrubbed of keys, tokens, credentials and session challenges before
+# being sent.
+[error-reporting]
+
+# Enable error reporting for this node.
+enabled = {{ .ErrorReporting.Enabled }}
+
+# Sentry-compatible DSN events are delivered to.
+dsn = "{{ .ErrorReporting.DSN }}"
+
+# Deployment environment attached to every event (e.g. local, testnet, mainnet).
+environment = "{{ .ErrorReporting.Environment }}"
+
+# Fraction of error events to send, in the range (0, 1].
+sample-rate = {{ .ErrorReporting.SampleRate }}
+
+# Additional field names to redact from event payloads.
+scrub-fields = [{{ range $i, $f := .ErrorReporting.ScrubFields }}{{ if $i }}, {{ end }}"{{ $f }}"{{ end }}]
+`
+
+// Release formats a release tag as "<binary>@<version>".
+func Release(binary, version string) string {
+	version = strings.TrimPrefix(version, "v")
+	if version == "" {
+		version = "dev"
+	}
+	return binary + "@" + version
+}
@@ -0,0 +1,107 @@
+package errreport
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// flushTimeout bounds how long Flush blocks on shutdown.
+const flushTimeout = 2 * time.Second
+
+var (
+	mu     sync.RWMutex
+	active bool
+)
+
+// Init configures the global reporter. It is a no-op when the configuration
+// is not active, and subsequent calls after a successful Init are ignored.
+func Init(cfg Config) error {
+	if !cfg.Active() {
+		return nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if active {
+		return nil
+	}
+
+	s := NewScrubber(cfg.ScrubFields...)
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:              cfg.DSN,
+		Environment:      cfg.Environment,
+		Release:          cfg.Release,
+		SampleRate:       cfg.SampleRate,
+		AttachStacktrace: true,
+		SendDefaultPII:   false,
+		BeforeSend: func(event *sentry.Event, _ *sentry.EventHint) *sentry.Event {
+			return s.ScrubEvent(event)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize error reporting: %w", err)
+	}
+
+	active = true
+	return nil
+}
+
+// Enabled reports whether error reporting has been initialized.
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return active
+}
+
+// CaptureError reports err with the given tags. Tag values are scrubbed like
+// any other event field.
+func CaptureError(err error, tags map[string]string) {
+	if err == nil || !Enabled() {
+		return
+	}
+	sentry.WithScope(func(scope *sentry.Scope) {
+		for k, v := range tags {
+			scope.SetTag(k, v)
+		}
+		sentry.CaptureException(err)
+	})
+}
+
+// CapturePanic reports a recovered panic value.
+func CapturePanic(recovered any, tags map[string]string) {
+	if recovered == nil || !Enabled() {
+		return
+	}
+	sentry.WithScope(func(scope *sentry.Scope) {
+		scope.SetLevel(sentry.LevelFatal)
+		for k, v := range tags {
+			scope.SetTag(k, v)
+		}
+		sentry.CurrentHub().Recover(recovered)
+	})
+}
+
+// RecoverAndRepanic reports an in-flight panic, flushes pending events and
+// re-panics so the process still crashes. Use it as `defer
+// errreport.RecoverAndRepanic()` at the top of main and long-lived goroutines.
+func RecoverAndRepanic() {
+	if r := recover(); r != nil {
+		CapturePanic(r, nil)
+		Flush()
+		panic(r)
+	}
+}
+
+// Flush waits for queued events to be delivered.
+func Flush() {
+	if !Enabled() {
+		return
+	}
+	sentry.Flush(flushTimeout)
+}
@@ -0,0 +1,186 @@
+package errreport
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// Redacted replaces any scrubbed value.
+const Redacted = "[redacted]"
+
+// defaultScrubFields are matched case-insensitively as substrings of field,
+// header and query parameter names.
+var defaultScrubFields = []string{
+	"authorization",
+	"cookie",
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"mnemonic",
+	"seed",
+	"private",
+	"privkey",
+	"priv_key",
+	"keyshare",
+	"key_share",
+	"signature",
+	"challenge",
+	"attestation",
+	"assertion",
+	"credential",
+	"session",
+	"ucan",
+	"api_key",
+	"apikey",
+	"dsn",
+}
+
+var (
+	bearerPattern = regexp.MustCompile(`(?i)bearer\s+[a-z0-9\-._~+/]+=*`)
+	jwtPattern    = regexp.MustCompile(`eyJ[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]*`)
+	hexKeyPattern = regexp.MustCompile(`\b(0x)?[0-9a-fA-F]{64,}\b`)
+)
+
+// Scrubber redacts sensitive fields and values from events.
+type Scrubber struct {
+	fields []string
+}
+
+// NewScrubber returns a scrubber matching the default sensitive fields plus
+// any extra field names.
+func NewScrubber(extra ...string) *Scrubber {
+	fields := make([]string, 0, len(defaultScrubFields)+len(extra))
+	fields = append(fields, defaultScrubFields...)
+	for _, f := range extra {
+		if f = strings.ToLower(strings.TrimSpace(f)); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return &Scrubber{fields: fields}
+}
+
+// IsSensitive reports whether a field name should be redacted.
+func (s *Scrubber) IsSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, f := range s.fields {
+		if strings.Contains(name, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScrubString redacts bearer tokens, JWTs and hex-encoded key material
+// embedded in free text such as error messages.
+func (s *Scrubber) ScrubString(v string) string {
+	v = bearerPattern.ReplaceAllString(v, "Bearer "+Redacted)
+	v = jwtPattern.ReplaceAllString(v, Redacted)
+	return hexKeyPattern.ReplaceAllString(v, Redacted)
+}
+
+// ScrubMap redacts sensitive keys of m in place, descending into nested maps
+// and slices.
+func (s *Scrubber) ScrubMap(m map[string]any) {
+	for k, v := range m {
+		if s.IsSensitive(k) {
+			m[k] = Redacted
+			continue
+		}
+		m[k] = s.scrubValue(v)
+	}
+}
+
+func (s *Scrubber) scrubValue(v any) any {
+	switch val := v.(type) {
+	case string:
+		return s.ScrubString(val)
+	case map[string]any:
+		s.ScrubMap(val)
+		return val
+	case map[string]string:
+		s.scrubStringMap(val)
+		return val
+	case []any:
+		for i := range val {
+			val[i] = s.scrubValue(val[i])
+		}
+		return val
+	case []string:
+		for i := range val {
+			val[i] = s.ScrubString(val[i])
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+func (s *Scrubber) scrubStringMap(m map[string]string) {
+	for k, v := range m {
+		if s.IsSensitive(k) {
+			m[k] = Redacted
+			continue
+		}
+		m[k] = s.ScrubString(v)
+	}
+}
+
+// ScrubQuery redacts sensitive parameters of a raw query string.
+func (s *Scrubber) ScrubQuery(raw string) string {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return Redacted
+	}
+	for k := range values {
+		if s.IsSensitive(k) {
+			values[k] = []string{Redacted}
+		}
+	}
+	return values.Encode()
+}
+
+// ScrubEvent redacts an event in place before it is sent. Request bodies and
+// cookies are always dropped since they routinely carry WebAuthn payloads.
+func (s *Scrubber) ScrubEvent(event *sentry.Event) *sentry.Event {
+	if event == nil {
+		return nil
+	}
+
+	event.Message = s.ScrubString(event.Message)
+	for i := range event.Exception {
+		event.Exception[i].Value = s.ScrubString(event.Exception[i].Value)
+	}
+
+	if req := event.Request; req != nil {
+		req.Data = ""
+		req.Cookies = ""
+		req.QueryString = s.ScrubQuery(req.QueryString)
+		if u, err := url.Parse(req.URL); err == nil {
+			u.RawQuery = s.ScrubQuery(u.RawQuery)
+			req.URL = u.String()
+		}
+		s.scrubStringMap(req.Headers)
+		s.scrubStringMap(req.Env)
+	}
+
+	// Never report who the user was beyond an opaque identifier.
+	event.User = sentry.User{ID: event.User.ID}
+	event.ServerName = ""
+
+	s.scrubStringMap(event.Tags)
+	s.ScrubMap(event.Extra)
+	for name, c := range event.Contexts {
+		s.ScrubMap(c)
+		event.Contexts[name] = c
+	}
+	for _, b := range event.Breadcrumbs {
+		b.Message = s.ScrubString(b.Message)
+		s.ScrubMap(b.Data)
+	}
+
+	return event
+}
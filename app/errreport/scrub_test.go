@@ -0,0 +1,84 @@
+package errreport_test
+
+import (
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/errreport"
+)
+
+func TestScrubEvent(t *testing.T) {
+	s := errreport.NewScrubber("vault_cid")
+
+	event := &sentry.Event{
+		Message: "session failed: Authorization: Bearer abc.def-123",
+		Exception: []sentry.Exception{{
+			Type:  "*errors.errorString",
+			Value: "bad key 0x" + "ab12cd34ef56ab12cd34ef56ab12cd34ef56ab12cd34ef56ab12cd34ef56ab12",
+		}},
+		Request: &sentry.Request{
+			URL:         "https://auth.sonr.io/finish-register?username=alice&challenge=xyz",
+			QueryString: "username=alice&challenge=xyz",
+			Data:        `{"clientDataJSON":"..."}`,
+			Cookies:     "session=abc",
+			Headers: map[string]string{
+				"Authorization": "Bearer abc",
+				"User-Agent":    "test",
+			},
+		},
+		User: sentry.User{ID: "did:sonr:z123", Email: "alice@example.com", IPAddress: "10.0.0.1"},
+		Tags: map[string]string{"route": "/finish-register", "session_id": "s1"},
+		Extra: map[string]any{
+			"mnemonic":  "abandon abandon",
+			"vault_cid": "bafy...",
+			"nested":    map[string]any{"private_key": "k", "height": 10},
+		},
+	}
+
+	out := s.ScrubEvent(event)
+	require.NotNil(t, out)
+
+	require.NotContains(t, out.Message, "abc.def-123")
+	require.Contains(t, out.Exception[0].Value, errreport.Redacted)
+
+	require.Empty(t, out.Request.Data)
+	require.Empty(t, out.Request.Cookies)
+	require.NotContains(t, out.Request.QueryString, "xyz")
+	require.Contains(t, out.Request.QueryString, "username=alice")
+	require.NotContains(t, out.Request.URL, "xyz")
+	require.Equal(t, errreport.Redacted, out.Request.Headers["Authorization"])
+	require.Equal(t, "test", out.Request.Headers["User-Agent"])
+
+	require.Equal(t, sentry.User{ID: "did:sonr:z123"}, out.User)
+	require.Equal(t, "/finish-register", out.Tags["route"])
+	require.Equal(t, errreport.Redacted, out.Tags["session_id"])
+
+	require.Equal(t, errreport.Redacted, out.Extra["mnemonic"])
+	require.Equal(t, errreport.Redacted, out.Extra["vault_cid"])
+	nested := out.Extra["nested"].(map[string]any)
+	require.Equal(t, errreport.Redacted, nested["private_key"])
+	require.Equal(t, 10, nested["height"])
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := errreport.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+	require.False(t, cfg.Active())
+
+	cfg.Enabled = true
+	require.Error(t, cfg.Validate())
+
+	cfg.DSN = "https://public@sentry.example.com/1"
+	require.NoError(t, cfg.Validate())
+	require.True(t, cfg.Active())
+
+	cfg.SampleRate = 1.5
+	require.Error(t, cfg.Validate())
+}
+
+func TestRelease(t *testing.T) {
+	require.Equal(t, "snrd@1.2.3", errreport.Release("snrd", "v1.2.3"))
+	require.Equal(t, "snrd@dev", errreport.Release("snrd", ""))
+}
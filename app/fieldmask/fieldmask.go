@@ -0,0 +1,100 @@
+// Package fieldmask implements server-side response projection for query
+// endpoints. Clients name the fields they render using google.protobuf.FieldMask
+// paths, either through gRPC metadata or a `?fields=` REST query parameter, and
+// everything else is dropped before the response is serialized.
+//
+// Paths follow FieldMask semantics: segments are proto field names separated
+// by dots, and a path through a repeated field applies to every element, so
+// `credentials.id` keeps only the id of each credential in a list response.
+package fieldmask
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// AlwaysKept lists top-level fields retained regardless of the mask so that
+// paginated list endpoints stay navigable.
+var AlwaysKept = []string{"pagination"}
+
+// Mask is a parsed tree of field mask paths.
+type Mask struct {
+	// children is nil for a leaf, meaning the entire subtree is kept.
+	children map[string]*Mask
+}
+
+// New builds a mask from FieldMask paths. It returns nil when no paths are
+// given, which callers treat as "return everything".
+func New(paths ...string) *Mask {
+	var root *Mask
+	for _, p := range paths {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if root == nil {
+			root = &Mask{children: map[string]*Mask{}}
+		}
+		root.add(strings.Split(p, "."))
+	}
+	if root != nil {
+		for _, f := range AlwaysKept {
+			if _, ok := root.children[normalize(f)]; !ok {
+				root.children[normalize(f)] = &Mask{}
+			}
+		}
+	}
+	return root
+}
+
+// Parse builds a mask from a comma separated list of paths, as used in the
+// `fields` query parameter.
+func Parse(fields string) *Mask {
+	return New(strings.Split(fields, ",")...)
+}
+
+// FromFieldMask builds a mask from a google.protobuf.FieldMask.
+func FromFieldMask(fm *fieldmaskpb.FieldMask) *Mask {
+	if fm == nil {
+		return nil
+	}
+	return New(fm.GetPaths()...)
+}
+
+func (m *Mask) add(segments []string) {
+	key := normalize(segments[0])
+	child, exists := m.children[key]
+	if len(segments) == 1 {
+		// A shorter path keeps the whole subtree.
+		m.children[key] = &Mask{}
+		return
+	}
+	if exists && child.children == nil {
+		return
+	}
+	if !exists {
+		child = &Mask{children: map[string]*Mask{}}
+		m.children[key] = child
+	}
+	child.add(segments[1:])
+}
+
+// IsLeaf reports whether the mask keeps everything below it.
+func (m *Mask) IsLeaf() bool {
+	return m == nil || m.children == nil
+}
+
+// Child returns the sub-mask for a field and whether the field is kept at all.
+func (m *Mask) Child(field string) (*Mask, bool) {
+	if m.IsLeaf() {
+		return nil, true
+	}
+	child, ok := m.children[normalize(field)]
+	return child, ok
+}
+
+// normalize lets snake_case proto names and lowerCamelCase JSON names match.
+func normalize(field string) string {
+	return strings.ToLower(strings.ReplaceAll(field, "_", ""))
+}
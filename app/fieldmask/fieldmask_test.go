@@ -0,0 +1,94 @@
+package fieldmask_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/fieldmask"
+)
+
+type credential struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Origin    string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+type pageResponse struct {
+	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+type listResponse struct {
+	Credentials []*credential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	Owner       string        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination  *pageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func newListResponse() *listResponse {
+	return &listResponse{
+		Credentials: []*credential{
+			{Id: "a", PublicKey: []byte{1}, Origin: "sonr.id"},
+			{Id: "b", PublicKey: []byte{2}, Origin: "sonr.id"},
+		},
+		Owner:      "idx1owner",
+		Pagination: &pageResponse{NextKey: []byte("next")},
+	}
+}
+
+func TestPrune(t *testing.T) {
+	res := newListResponse()
+	fieldmask.Prune(res, fieldmask.New("credentials.id"))
+
+	require.Empty(t, res.Owner)
+	require.NotNil(t, res.Pagination, "pagination is always kept")
+	require.Len(t, res.Credentials, 2)
+	for _, c := range res.Credentials {
+		require.NotEmpty(t, c.Id)
+		require.Nil(t, c.PublicKey)
+		require.Empty(t, c.Origin)
+	}
+}
+
+func TestPruneNilMaskKeepsEverything(t *testing.T) {
+	res := newListResponse()
+	fieldmask.Prune(res, fieldmask.Parse(""))
+	require.Equal(t, newListResponse(), res)
+}
+
+func TestPruneShorterPathWins(t *testing.T) {
+	res := newListResponse()
+	fieldmask.Prune(res, fieldmask.New("credentials.id", "credentials"))
+	require.Equal(t, newListResponse().Credentials, res.Credentials)
+}
+
+func TestProjectJSON(t *testing.T) {
+	in, err := json.Marshal(newListResponse())
+	require.NoError(t, err)
+
+	out, err := fieldmask.ProjectJSON(in, fieldmask.Parse("credentials.id,credentials.origin"))
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"credentials":[{"id":"a","origin":"sonr.id"},{"id":"b","origin":"sonr.id"}],"pagination":{"next_key":"bmV4dA=="}}`,
+		string(out),
+	)
+}
+
+func TestMiddleware(t *testing.T) {
+	var seenQuery string
+	handler := fieldmask.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(newListResponse())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/did/v1/credentials?fields=owner&pagination.limit=2", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "pagination.limit=2", seenQuery)
+	require.JSONEq(t, `{"owner":"idx1owner","pagination":{"next_key":"bmV4dA=="}}`, rec.Body.String())
+}
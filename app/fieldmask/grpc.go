@@ -0,0 +1,74 @@
+package fieldmask
+
+import (
+	"context"
+	"strings"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// MetadataKey carries comma separated mask paths on a gRPC request.
+	MetadataKey = "x-field-mask"
+	// MetadataBinKey carries a binary encoded google.protobuf.FieldMask.
+	MetadataBinKey = "x-field-mask-bin"
+)
+
+// FromIncomingContext reads the field mask sent by a gRPC client, if any.
+func FromIncomingContext(ctx context.Context) *Mask {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if vals := md.Get(MetadataBinKey); len(vals) > 0 {
+		fm := &fieldmaskpb.FieldMask{}
+		if err := proto.Unmarshal([]byte(vals[0]), fm); err == nil {
+			return FromFieldMask(fm)
+		}
+	}
+	if vals := md.Get(MetadataKey); len(vals) > 0 {
+		return Parse(strings.Join(vals, ","))
+	}
+	return nil
+}
+
+// WrapServer returns a gRPC server whose unary query handlers prune their
+// responses according to the client's field mask. Requests without a mask
+// are served unchanged.
+func WrapServer(server gogogrpc.Server) gogogrpc.Server {
+	return &maskingServer{Server: server}
+}
+
+type maskingServer struct {
+	gogogrpc.Server
+}
+
+// RegisterService implements gogogrpc.Server.
+func (s *maskingServer) RegisterService(sd *grpc.ServiceDesc, ss any) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(
+				srv any,
+				ctx context.Context,
+				dec func(any) error,
+				interceptor grpc.UnaryServerInterceptor,
+			) (any, error) {
+				res, err := handler(srv, ctx, dec, interceptor)
+				if err == nil {
+					Prune(res, FromIncomingContext(ctx))
+				}
+				return res, err
+			},
+		}
+	}
+	s.Server.RegisterService(&desc, ss)
+}
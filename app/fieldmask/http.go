@@ -0,0 +1,100 @@
+package fieldmask
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// QueryParam is the REST query parameter holding comma separated mask paths.
+const QueryParam = "fields"
+
+// ProjectJSON applies the mask to a JSON document. Paths through arrays apply
+// to every element, matching FieldMask semantics for repeated fields.
+func ProjectJSON(data []byte, m *Mask) ([]byte, error) {
+	if m.IsLeaf() {
+		return data, nil
+	}
+
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(projectJSON(doc, m))
+}
+
+func projectJSON(v any, m *Mask) any {
+	if m.IsLeaf() {
+		return v
+	}
+	switch val := v.(type) {
+	case map[string]any:
+		for k, inner := range val {
+			child, keep := m.Child(k)
+			if !keep {
+				delete(val, k)
+				continue
+			}
+			val[k] = projectJSON(inner, child)
+		}
+		return val
+	case []any:
+		for i := range val {
+			val[i] = projectJSON(val[i], m)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// Middleware projects successful JSON responses of REST requests carrying a
+// `?fields=` parameter. The parameter is removed before the request reaches
+// the gRPC gateway, which would otherwise reject it as an unknown field.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		fields := query.Get(QueryParam)
+		if fields == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		mask := Parse(fields)
+		query.Del(QueryParam)
+		r.URL.RawQuery = query.Encode()
+
+		rec := &bufferedWriter{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		body := rec.body.Bytes()
+		if rec.status == http.StatusOK {
+			if projected, err := ProjectJSON(body, mask); err == nil {
+				body = projected
+			}
+		}
+
+		for k, v := range rec.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(body)
+	})
+}
+
+// bufferedWriter holds a response so it can be projected before sending.
+type bufferedWriter struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func (b *bufferedWriter) Header() http.Header { return b.header }
+
+func (b *bufferedWriter) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+func (b *bufferedWriter) WriteHeader(status int) { b.status = status }
@@ -0,0 +1,103 @@
+package fieldmask
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Prune clears every field of msg not selected by the mask. It works on any
+// generated Go protobuf struct (gogoproto or golang/protobuf) by reading the
+// `protobuf` struct tags, so query servers need no per-message code. msg must
+// be a pointer; a nil mask leaves it untouched.
+func Prune(msg any, m *Mask) {
+	if m.IsLeaf() || msg == nil {
+		return
+	}
+	pruneValue(reflect.ValueOf(msg), m)
+}
+
+func pruneValue(v reflect.Value, m *Mask) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			pruneValue(v.Elem(), m)
+		}
+	case reflect.Struct:
+		if v.CanSet() {
+			pruneStruct(v, m)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			pruneValue(v.Index(i), m)
+		}
+	case reflect.Map:
+		// Map values are not addressable; only pointer values can be pruned.
+		iter := v.MapRange()
+		for iter.Next() {
+			if val := iter.Value(); val.Kind() == reflect.Ptr {
+				pruneValue(val, m)
+			}
+		}
+	}
+}
+
+func pruneStruct(v reflect.Value, m *Mask) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field := v.Field(i)
+		name := protoFieldName(sf)
+		if name == "" {
+			// For oneofs, match on the set member and descend into its value.
+			name, field = oneofField(field)
+		}
+		if name == "" {
+			continue
+		}
+
+		child, keep := m.Child(name)
+		switch {
+		case !keep:
+			v.Field(i).Set(reflect.Zero(sf.Type))
+		case !child.IsLeaf():
+			pruneValue(field, child)
+		}
+	}
+}
+
+// protoFieldName extracts the proto field name from a `protobuf` struct tag.
+func protoFieldName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("protobuf")
+	if tag == "" {
+		return ""
+	}
+	for _, part := range strings.Split(tag, ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// oneofField resolves the name and value of the member currently set on a
+// oneof interface, which are carried by the wrapper struct's single field.
+func oneofField(v reflect.Value) (string, reflect.Value) {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return "", v
+	}
+	inner := v.Elem()
+	if inner.Kind() == reflect.Ptr {
+		inner = inner.Elem()
+	}
+	if inner.Kind() != reflect.Struct || inner.NumField() == 0 {
+		return "", v
+	}
+	return protoFieldName(inner.Type().Field(0)), inner.Field(0)
+}
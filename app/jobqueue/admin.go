@@ -0,0 +1,95 @@
+package jobqueue
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// defaultListLimit caps job listings when no limit is given
+const defaultListLimit = 100
+
+// Stats is the number of jobs by kind and state
+type Stats struct {
+	Kinds map[string]map[State]int `json:"kinds"`
+}
+
+// RegisterAdminRoutes registers the job inspection endpoints. They are not
+// authenticated, so mount them on an admin-only listener.
+func RegisterAdminRoutes(e *echo.Echo, q *Queue) {
+	e.GET("/admin/jobs", q.HandleList)
+	e.GET("/admin/jobs/stats", q.HandleStats)
+	e.GET("/admin/jobs/:id", q.HandleGet)
+	e.POST("/admin/jobs/:id/retry", q.HandleRetry)
+}
+
+// HandleList lists jobs, newest first, filtered by the state and kind query
+// parameters. Dead letters are listed with state=dead.
+func (q *Queue) HandleList(ctx echo.Context) error {
+	filter := Filter{
+		State: State(ctx.QueryParam("state")),
+		Kind:  ctx.QueryParam("kind"),
+		Limit: defaultListLimit,
+	}
+	if s := ctx.QueryParam("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "invalid limit"})
+		}
+		filter.Limit = limit
+	}
+
+	jobs, err := q.store.List(ctx.Request().Context(), filter)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, jobs)
+}
+
+// HandleStats returns the number of jobs by kind and state
+func (q *Queue) HandleStats(ctx echo.Context) error {
+	counts, err := q.store.Counts(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, Stats{Kinds: counts})
+}
+
+// HandleGet returns a job with its last error
+func (q *Queue) HandleGet(ctx echo.Context) error {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "invalid job id"})
+	}
+	job, err := q.store.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(statusOf(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, job)
+}
+
+// HandleRetry returns a dead job to the queue
+func (q *Queue) HandleRetry(ctx echo.Context) error {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "invalid job id"})
+	}
+	job, err := q.Retry(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(statusOf(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, job)
+}
+
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, ErrJobNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrNotDead):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
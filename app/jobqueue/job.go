@@ -0,0 +1,94 @@
+// Package jobqueue is a durable queue for asynchronous off-chain work such as
+// vault pinning, DID backfills, webhook deliveries, email sends and
+// reconciliation sweeps. Jobs are stored before they run, retried with
+// backoff when they fail and moved to a dead letter state once their
+// attempts are used up, where an operator can inspect and retry them.
+package jobqueue
+
+import (
+	"errors"
+	"time"
+)
+
+// State is the lifecycle state of a job
+type State string
+
+const (
+	// StatePending jobs wait for their run time
+	StatePending State = "pending"
+	// StateRunning jobs are held by a worker until their lease expires
+	StateRunning State = "running"
+	// StateSucceeded jobs completed
+	StateSucceeded State = "succeeded"
+	// StateDead jobs failed permanently or used up their attempts
+	StateDead State = "dead"
+)
+
+var (
+	// ErrJobNotFound is returned for an unknown job ID
+	ErrJobNotFound = errors.New("job not found")
+	// ErrUnknownKind is returned when enqueuing a kind no handler is
+	// registered for
+	ErrUnknownKind = errors.New("unknown job kind")
+	// ErrNotDead is returned when retrying a job that is not dead
+	ErrNotDead = errors.New("job is not dead")
+)
+
+// Job is a unit of work. Payload is the JSON encoded argument of the
+// handler.
+type Job struct {
+	ID          uint64     `json:"id" gorm:"primaryKey"`
+	Kind        string     `json:"kind" gorm:"index;not null"`
+	Payload     string     `json:"payload"`
+	State       State      `json:"state" gorm:"index:idx_jobs_due;not null"`
+	RunAt       time.Time  `json:"run_at" gorm:"index:idx_jobs_due"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	LockedUntil time.Time  `json:"locked_until"`
+	LastError   string     `json:"last_error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName sets the table of jobs
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// due reports whether a worker may claim the job at now: a pending job whose
+// run time has come, or a running job whose worker let its lease expire
+func (j *Job) due(now time.Time) bool {
+	switch j.State {
+	case StatePending:
+		return !j.RunAt.After(now)
+	case StateRunning:
+		return j.LockedUntil.Before(now)
+	default:
+		return false
+	}
+}
+
+// permanentError marks a failure that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps a handler error so the job is dead-lettered at once
+// instead of retried, e.g. for a malformed payload
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether err was wrapped with Permanent
+func IsPermanent(err error) bool {
+	var p permanentError
+	return errors.As(err, &p)
+}
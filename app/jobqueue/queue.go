@@ -0,0 +1,292 @@
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/sonr-io/sonr/app/clock"
+)
+
+var logger = log.NewLogger(os.Stderr).With("module", "jobqueue")
+
+// Defaults of a Queue and of a Kind
+const (
+	DefaultWorkers      = 4
+	DefaultPollInterval = time.Second
+	DefaultLease        = 5 * time.Minute
+	DefaultMaxAttempts  = 5
+	DefaultTimeout      = time.Minute
+	DefaultMinBackoff   = 10 * time.Second
+	DefaultMaxBackoff   = time.Hour
+)
+
+// Handler runs a job. An error schedules a retry, unless it is wrapped with
+// Permanent or the job used up its attempts, in which case the job is dead.
+type Handler func(ctx context.Context, job *Job) error
+
+// Kind configures how the jobs of one kind run
+type Kind struct {
+	Handler Handler
+	// MaxAttempts is how many times a job runs before it is dead; zero
+	// uses DefaultMaxAttempts
+	MaxAttempts int
+	// Timeout bounds a single attempt; zero uses DefaultTimeout. It should
+	// be well below the queue's Lease, or a slow attempt can be claimed
+	// again by another worker.
+	Timeout time.Duration
+}
+
+// Queue enqueues jobs and runs the kinds registered with it. Several queues
+// may share a store; each only claims the kinds it has handlers for.
+type Queue struct {
+	store Store
+	clock clock.Clock
+
+	// Workers is the number of jobs run concurrently
+	Workers int
+	// PollInterval is how often idle workers look for due jobs. Jobs
+	// enqueued through this queue wake a worker at once.
+	PollInterval time.Duration
+	// Lease is how long a claimed job is held before another worker may
+	// take it over, which recovers jobs of a worker that crashed
+	Lease time.Duration
+	// MinBackoff and MaxBackoff bound the delay before a retry, which
+	// doubles with every failed attempt
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	mu    sync.RWMutex
+	kinds map[string]Kind
+	wake  chan struct{}
+}
+
+// New creates a queue on store with the default settings
+func New(store Store) *Queue {
+	return &Queue{
+		store:        store,
+		clock:        clock.System,
+		Workers:      DefaultWorkers,
+		PollInterval: DefaultPollInterval,
+		Lease:        DefaultLease,
+		MinBackoff:   DefaultMinBackoff,
+		MaxBackoff:   DefaultMaxBackoff,
+		kinds:        make(map[string]Kind),
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// WithClock returns the queue using c for run times and leases
+func (q *Queue) WithClock(c clock.Clock) *Queue {
+	q.clock = c
+	return q
+}
+
+// Register sets the handler and limits of a kind
+func (q *Queue) Register(name string, kind Kind) {
+	if kind.MaxAttempts <= 0 {
+		kind.MaxAttempts = DefaultMaxAttempts
+	}
+	if kind.Timeout <= 0 {
+		kind.Timeout = DefaultTimeout
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.kinds[name] = kind
+}
+
+// Enqueue stores a job to run as soon as a worker is free. payload is
+// encoded as JSON.
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload any) (*Job, error) {
+	return q.EnqueueAt(ctx, kind, payload, q.clock.Now())
+}
+
+// EnqueueAt stores a job to run at runAt
+func (q *Queue) EnqueueAt(ctx context.Context, kind string, payload any, runAt time.Time) (*Job, error) {
+	k, ok := q.kind(kind)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", kind, err)
+	}
+
+	now := q.clock.Now()
+	job := &Job{
+		Kind:        kind,
+		Payload:     string(bz),
+		State:       StatePending,
+		RunAt:       runAt,
+		MaxAttempts: k.MaxAttempts,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := q.store.Insert(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to store %s job: %w", kind, err)
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// Start runs Workers workers until ctx is cancelled
+func (q *Queue) Start(ctx context.Context) {
+	for range max(q.Workers, 1) {
+		go q.work(ctx)
+	}
+}
+
+// work runs due jobs one at a time and waits for the next poll or enqueue
+// when there are none
+func (q *Queue) work(ctx context.Context) {
+	ticker := time.NewTicker(q.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		ran, err := q.RunNext(ctx)
+		if err != nil {
+			logger.Error("failed to run job", "error", err)
+		}
+		if ran && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// RunNext claims and runs a single due job. It reports whether there was
+// one; an error means the store failed, not the job.
+func (q *Queue) RunNext(ctx context.Context) (bool, error) {
+	kinds := q.kindNames()
+	if len(kinds) == 0 {
+		return false, nil
+	}
+
+	jobs, err := q.store.Claim(ctx, kinds, q.clock.Now(), q.Lease, 1)
+	if err != nil || len(jobs) == 0 {
+		return false, err
+	}
+	return true, q.run(ctx, jobs[0])
+}
+
+// run executes a claimed job and records the outcome
+func (q *Queue) run(ctx context.Context, job *Job) error {
+	k, _ := q.kind(job.Kind)
+
+	runCtx, cancel := context.WithTimeout(ctx, k.Timeout)
+	err := q.call(runCtx, k.Handler, job)
+	cancel()
+
+	now := q.clock.Now()
+	job.LockedUntil = time.Time{}
+	job.UpdatedAt = now
+
+	switch {
+	case err == nil:
+		job.State = StateSucceeded
+		job.LastError = ""
+		job.CompletedAt = &now
+	case IsPermanent(err) || job.Attempts >= job.MaxAttempts:
+		job.State = StateDead
+		job.LastError = err.Error()
+		job.CompletedAt = &now
+		logger.Error("job is dead", "id", job.ID, "kind", job.Kind, "attempts", job.Attempts, "error", err)
+	default:
+		job.State = StatePending
+		job.LastError = err.Error()
+		job.RunAt = now.Add(q.backoff(job.Attempts))
+		logger.Info("job failed, retrying", "id", job.ID, "kind", job.Kind, "attempt", job.Attempts, "run_at", job.RunAt, "error", err)
+	}
+
+	return q.store.Update(ctx, job)
+}
+
+// call runs a handler, turning a panic into a failed attempt
+func (q *Queue) call(ctx context.Context, handler Handler, job *Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// backoff returns the delay before the retry that follows attempt
+func (q *Queue) backoff(attempt int) time.Duration {
+	d := q.MinBackoff
+	for i := 1; i < attempt && d < q.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, q.MaxBackoff)
+}
+
+// Retry returns a dead job to the queue with fresh attempts
+func (q *Queue) Retry(ctx context.Context, id uint64) (*Job, error) {
+	job, err := q.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.State != StateDead {
+		return nil, fmt.Errorf("%w: job %d is %s", ErrNotDead, id, job.State)
+	}
+
+	now := q.clock.Now()
+	job.State = StatePending
+	job.Attempts = 0
+	job.RunAt = now
+	job.UpdatedAt = now
+	job.CompletedAt = nil
+	if err := q.store.Update(ctx, job); err != nil {
+		return nil, err
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+func (q *Queue) kind(name string) (Kind, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	k, ok := q.kinds[name]
+	return k, ok
+}
+
+func (q *Queue) kindNames() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	names := make([]string, 0, len(q.kinds))
+	for name := range q.kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Decode unmarshals a job's payload into v
+func Decode(job *Job, v any) error {
+	if err := json.Unmarshal([]byte(job.Payload), v); err != nil {
+		return Permanent(fmt.Errorf("invalid %s payload: %w", job.Kind, err))
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package jobqueue_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/clock"
+	"github.com/sonr-io/sonr/app/jobqueue"
+)
+
+type pinPayload struct {
+	CID string `json:"cid"`
+}
+
+// testQueue returns a queue on a memory store with a clock the test moves
+func testQueue(t *testing.T) (*jobqueue.Queue, *jobqueue.MemoryStore, *time.Time) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := jobqueue.NewMemoryStore()
+	q := jobqueue.New(store).WithClock(clock.Func(func() time.Time { return now }))
+	return q, store, &now
+}
+
+func TestQueueRetriesWithBackoff(t *testing.T) {
+	q, store, now := testQueue(t)
+	ctx := context.Background()
+
+	var calls []string
+	q.Register("pin", jobqueue.Kind{
+		MaxAttempts: 3,
+		Handler: func(_ context.Context, job *jobqueue.Job) error {
+			var p pinPayload
+			if err := jobqueue.Decode(job, &p); err != nil {
+				return err
+			}
+			calls = append(calls, p.CID)
+			if len(calls) < 3 {
+				return errors.New("provider unavailable")
+			}
+			return nil
+		},
+	})
+
+	job, err := q.Enqueue(ctx, "pin", pinPayload{CID: "bafy1"})
+	require.NoError(t, err)
+
+	ran, err := q.RunNext(ctx)
+	require.NoError(t, err)
+	require.True(t, ran)
+
+	got, err := store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, jobqueue.StatePending, got.State)
+	require.Equal(t, 1, got.Attempts)
+	require.Equal(t, "provider unavailable", got.LastError)
+	require.Equal(t, now.Add(jobqueue.DefaultMinBackoff), got.RunAt)
+
+	// Not due until the backoff passes
+	ran, err = q.RunNext(ctx)
+	require.NoError(t, err)
+	require.False(t, ran)
+
+	*now = now.Add(jobqueue.DefaultMinBackoff)
+	ran, err = q.RunNext(ctx)
+	require.NoError(t, err)
+	require.True(t, ran)
+	got, err = store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(2*jobqueue.DefaultMinBackoff), got.RunAt)
+
+	*now = now.Add(2 * jobqueue.DefaultMinBackoff)
+	ran, err = q.RunNext(ctx)
+	require.NoError(t, err)
+	require.True(t, ran)
+
+	got, err = store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, jobqueue.StateSucceeded, got.State)
+	require.Equal(t, 3, got.Attempts)
+	require.Empty(t, got.LastError)
+	require.NotNil(t, got.CompletedAt)
+	require.Equal(t, []string{"bafy1", "bafy1", "bafy1"}, calls)
+}
+
+func TestQueueDeadLetters(t *testing.T) {
+	q, store, now := testQueue(t)
+	ctx := context.Background()
+
+	q.Register("webhook", jobqueue.Kind{
+		MaxAttempts: 2,
+		Handler: func(context.Context, *jobqueue.Job) error {
+			return errors.New("endpoint returned 503")
+		},
+	})
+	q.Register("email", jobqueue.Kind{
+		Handler: func(context.Context, *jobqueue.Job) error {
+			return jobqueue.Permanent(errors.New("invalid address"))
+		},
+	})
+	q.Register("panics", jobqueue.Kind{
+		MaxAttempts: 1,
+		Handler: func(context.Context, *jobqueue.Job) error {
+			panic("boom")
+		},
+	})
+
+	webhook, err := q.Enqueue(ctx, "webhook", map[string]string{"url": "https://example.com"})
+	require.NoError(t, err)
+	email, err := q.Enqueue(ctx, "email", map[string]string{"to": "nobody"})
+	require.NoError(t, err)
+	panics, err := q.Enqueue(ctx, "panics", nil)
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err := q.RunNext(ctx)
+		require.NoError(t, err)
+	}
+	*now = now.Add(time.Hour)
+	_, err = q.RunNext(ctx)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		id       uint64
+		attempts int
+		lastErr  string
+	}{
+		{webhook.ID, 2, "endpoint returned 503"},
+		{email.ID, 1, "invalid address"},
+		{panics.ID, 1, "handler panicked: boom"},
+	} {
+		got, err := store.Get(ctx, tc.id)
+		require.NoError(t, err)
+		require.Equal(t, jobqueue.StateDead, got.State, got.Kind)
+		require.Equal(t, tc.attempts, got.Attempts, got.Kind)
+		require.Equal(t, tc.lastErr, got.LastError, got.Kind)
+	}
+
+	// A dead job goes back to pending with fresh attempts
+	_, err = q.Retry(ctx, email.ID)
+	require.NoError(t, err)
+	got, err := store.Get(ctx, email.ID)
+	require.NoError(t, err)
+	require.Equal(t, jobqueue.StatePending, got.State)
+	require.Zero(t, got.Attempts)
+	require.Nil(t, got.CompletedAt)
+
+	_, err = q.Retry(ctx, email.ID)
+	require.ErrorIs(t, err, jobqueue.ErrNotDead)
+	_, err = q.Retry(ctx, 99)
+	require.ErrorIs(t, err, jobqueue.ErrJobNotFound)
+}
+
+func TestQueueReclaimsExpiredLeases(t *testing.T) {
+	q, store, now := testQueue(t)
+	ctx := context.Background()
+
+	q.Register("sweep", jobqueue.Kind{Handler: func(context.Context, *jobqueue.Job) error { return nil }})
+	job, err := q.Enqueue(ctx, "sweep", nil)
+	require.NoError(t, err)
+
+	// A worker claims the job and dies without reporting back
+	claimed, err := store.Claim(ctx, []string{"sweep"}, *now, q.Lease, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	ran, err := q.RunNext(ctx)
+	require.NoError(t, err)
+	require.False(t, ran)
+
+	*now = now.Add(q.Lease + time.Second)
+	ran, err = q.RunNext(ctx)
+	require.NoError(t, err)
+	require.True(t, ran)
+
+	got, err := store.Get(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, jobqueue.StateSucceeded, got.State)
+	require.Equal(t, 2, got.Attempts)
+}
+
+func TestQueueUnknownKind(t *testing.T) {
+	q, _, _ := testQueue(t)
+	_, err := q.Enqueue(context.Background(), "backfill", nil)
+	require.ErrorIs(t, err, jobqueue.ErrUnknownKind)
+}
+
+func TestAdminRoutes(t *testing.T) {
+	q, _, _ := testQueue(t)
+	ctx := context.Background()
+
+	q.Register("pin", jobqueue.Kind{
+		MaxAttempts: 1,
+		Handler: func(context.Context, *jobqueue.Job) error {
+			return errors.New("pin failed")
+		},
+	})
+	dead, err := q.Enqueue(ctx, "pin", pinPayload{CID: "bafy1"})
+	require.NoError(t, err)
+	_, err = q.RunNext(ctx)
+	require.NoError(t, err)
+	pending, err := q.Enqueue(ctx, "pin", pinPayload{CID: "bafy2"})
+	require.NoError(t, err)
+
+	e := echo.New()
+	jobqueue.RegisterAdminRoutes(e, q)
+	do := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	rec := do(http.MethodGet, "/admin/jobs?state=dead")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var jobs []jobqueue.Job
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &jobs))
+	require.Len(t, jobs, 1)
+	require.Equal(t, dead.ID, jobs[0].ID)
+	require.Equal(t, "pin failed", jobs[0].LastError)
+
+	rec = do(http.MethodGet, "/admin/jobs/stats")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var stats jobqueue.Stats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	require.Equal(t, map[jobqueue.State]int{jobqueue.StateDead: 1, jobqueue.StatePending: 1}, stats.Kinds["pin"])
+
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/admin/jobs/1").Code)
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/admin/jobs/99").Code)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/admin/jobs/x").Code)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/admin/jobs?limit=0").Code)
+
+	require.Equal(t, http.StatusConflict, do(http.MethodPost, "/admin/jobs/2/retry").Code)
+	require.Equal(t, pending.ID, uint64(2))
+	rec = do(http.MethodPost, "/admin/jobs/1/retry")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var job jobqueue.Job
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+	require.Equal(t, jobqueue.StatePending, job.State)
+}
@@ -0,0 +1,282 @@
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Filter selects jobs to list. Empty fields match every job.
+type Filter struct {
+	State State
+	Kind  string
+	// Limit caps the number of jobs returned, newest first
+	Limit int
+}
+
+// Store persists jobs. Claim must be safe for workers in several processes
+// sharing the store: a job is handed to one worker at a time.
+type Store interface {
+	// Insert stores a new job and sets its ID
+	Insert(ctx context.Context, job *Job) error
+	// Claim leases up to limit due jobs of the given kinds until
+	// now+lease, marks them running and counts an attempt
+	Claim(ctx context.Context, kinds []string, now time.Time, lease time.Duration, limit int) ([]*Job, error)
+	// Update saves a job's state after an attempt or an operator action
+	Update(ctx context.Context, job *Job) error
+	// Get returns a job, or ErrJobNotFound
+	Get(ctx context.Context, id uint64) (*Job, error)
+	// List returns the jobs matching filter, newest first
+	List(ctx context.Context, filter Filter) ([]*Job, error)
+	// Counts returns the number of jobs by kind and state
+	Counts(ctx context.Context) (map[string]map[State]int, error)
+}
+
+// GormStore keeps jobs in a SQL database through GORM: SQLite for a single
+// node, or Postgres when several services share the queue.
+type GormStore struct {
+	db *gorm.DB
+}
+
+// NewGormStore creates the jobs table if needed and returns a store using it
+func NewGormStore(db *gorm.DB) (*GormStore, error) {
+	if err := db.AutoMigrate(&Job{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate jobs table: %w", err)
+	}
+	return &GormStore{db: db}, nil
+}
+
+// Insert implements Store
+func (s *GormStore) Insert(ctx context.Context, job *Job) error {
+	return s.db.WithContext(ctx).Create(job).Error
+}
+
+// Claim implements Store. Candidates are read first and each is then taken
+// with a conditional update, so a job another worker claimed in between is
+// skipped rather than run twice.
+func (s *GormStore) Claim(
+	ctx context.Context,
+	kinds []string,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*Job, error) {
+	db := s.db.WithContext(ctx)
+	due := "kind IN ? AND ((state = ? AND run_at <= ?) OR (state = ? AND locked_until < ?))"
+	dueArgs := []any{kinds, StatePending, now, StateRunning, now}
+
+	var candidates []*Job
+	if err := db.Where(due, dueArgs...).Order("run_at, id").Limit(limit).Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*Job, 0, len(candidates))
+	for _, job := range candidates {
+		res := db.Model(&Job{}).
+			Where("id = ?", job.ID).
+			Where(due, dueArgs...).
+			Updates(map[string]any{
+				"state":        StateRunning,
+				"locked_until": now.Add(lease),
+				"attempts":     gorm.Expr("attempts + 1"),
+				"updated_at":   now,
+			})
+		if res.Error != nil {
+			return claimed, res.Error
+		}
+		if res.RowsAffected == 0 {
+			continue
+		}
+		job.State = StateRunning
+		job.LockedUntil = now.Add(lease)
+		job.Attempts++
+		job.UpdatedAt = now
+		claimed = append(claimed, job)
+	}
+	return claimed, nil
+}
+
+// Update implements Store
+func (s *GormStore) Update(ctx context.Context, job *Job) error {
+	return s.db.WithContext(ctx).Save(job).Error
+}
+
+// Get implements Store
+func (s *GormStore) Get(ctx context.Context, id uint64) (*Job, error) {
+	var job Job
+	err := s.db.WithContext(ctx).First(&job, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// List implements Store
+func (s *GormStore) List(ctx context.Context, filter Filter) ([]*Job, error) {
+	db := s.db.WithContext(ctx).Order("id DESC")
+	if filter.State != "" {
+		db = db.Where("state = ?", filter.State)
+	}
+	if filter.Kind != "" {
+		db = db.Where("kind = ?", filter.Kind)
+	}
+	if filter.Limit > 0 {
+		db = db.Limit(filter.Limit)
+	}
+	var jobs []*Job
+	return jobs, db.Find(&jobs).Error
+}
+
+// Counts implements Store
+func (s *GormStore) Counts(ctx context.Context) (map[string]map[State]int, error) {
+	var rows []struct {
+		Kind  string
+		State State
+		Count int
+	}
+	err := s.db.WithContext(ctx).Model(&Job{}).
+		Select("kind, state, COUNT(*) AS count").
+		Group("kind, state").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]map[State]int)
+	for _, r := range rows {
+		if counts[r.Kind] == nil {
+			counts[r.Kind] = make(map[State]int)
+		}
+		counts[r.Kind][r.State] = r.Count
+	}
+	return counts, nil
+}
+
+// MemoryStore keeps jobs in memory. Jobs do not survive a restart, so it is
+// meant for tests and tools that run to completion.
+type MemoryStore struct {
+	mu     sync.Mutex
+	jobs   map[uint64]*Job
+	nextID uint64
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{jobs: make(map[uint64]*Job)}
+}
+
+// Insert implements Store
+func (s *MemoryStore) Insert(_ context.Context, job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	job.ID = s.nextID
+	stored := *job
+	s.jobs[job.ID] = &stored
+	return nil
+}
+
+// Claim implements Store
+func (s *MemoryStore) Claim(
+	_ context.Context,
+	kinds []string,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*Job
+	for _, job := range s.jobs {
+		if slices.Contains(kinds, job.Kind) && job.due(now) {
+			due = append(due, job)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].RunAt.Equal(due[j].RunAt) {
+			return due[i].RunAt.Before(due[j].RunAt)
+		}
+		return due[i].ID < due[j].ID
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]*Job, 0, len(due))
+	for _, job := range due {
+		job.State = StateRunning
+		job.LockedUntil = now.Add(lease)
+		job.Attempts++
+		job.UpdatedAt = now
+		c := *job
+		claimed = append(claimed, &c)
+	}
+	return claimed, nil
+}
+
+// Update implements Store
+func (s *MemoryStore) Update(_ context.Context, job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; !ok {
+		return ErrJobNotFound
+	}
+	stored := *job
+	s.jobs[job.ID] = &stored
+	return nil
+}
+
+// Get implements Store
+func (s *MemoryStore) Get(_ context.Context, id uint64) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	c := *job
+	return &c, nil
+}
+
+// List implements Store
+func (s *MemoryStore) List(_ context.Context, filter Filter) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var jobs []*Job
+	for _, job := range s.jobs {
+		if (filter.State == "" || job.State == filter.State) && (filter.Kind == "" || job.Kind == filter.Kind) {
+			c := *job
+			jobs = append(jobs, &c)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID > jobs[j].ID })
+	if filter.Limit > 0 && len(jobs) > filter.Limit {
+		jobs = jobs[:filter.Limit]
+	}
+	return jobs, nil
+}
+
+// Counts implements Store
+func (s *MemoryStore) Counts(_ context.Context) (map[string]map[State]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[string]map[State]int)
+	for _, job := range s.jobs {
+		if counts[job.Kind] == nil {
+			counts[job.Kind] = make(map[State]int)
+		}
+		counts[job.Kind][job.State]++
+	}
+	return counts, nil
+}
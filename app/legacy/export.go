@@ -0,0 +1,114 @@
+// Package legacy imports accounts, WebAuthn credentials and service records
+// exported from the sonrhq/core chain into the x/did and x/svc modules and
+// the local credential database.
+package legacy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Export is a JSON export of the sonrhq/core identity and service state
+type Export struct {
+	Accounts       []Account       `json:"accounts"`
+	Credentials    []Credential    `json:"credentials"`
+	ServiceRecords []ServiceRecord `json:"service_records"`
+}
+
+// Account is a sonrhq/core identity controller account
+type Account struct {
+	// Address is the bech32 account address that controls the identity
+	Address string `json:"address"`
+	// Did is the legacy DID, usually did:snr:<address>
+	Did string `json:"did"`
+	// Alias is the human readable name registered for the account
+	Alias string `json:"alias"`
+	// PublicKey is the account public key
+	PublicKey []byte `json:"public_key"`
+	// KeyType is the account key curve, e.g. secp256k1
+	KeyType string `json:"key_type"`
+	// CreatedAt is the unix time the account was created
+	CreatedAt int64 `json:"created_at"`
+}
+
+// Credential is a sonrhq/core WebAuthn credential
+type Credential struct {
+	// Id is the raw WebAuthn credential ID
+	Id []byte `json:"id"`
+	// Controller is the legacy DID owning the credential
+	Controller string `json:"controller"`
+	// PublicKey is the COSE encoded credential public key
+	PublicKey []byte `json:"public_key"`
+	// Algorithm is the COSE algorithm identifier
+	Algorithm int32 `json:"algorithm"`
+	// AttestationType is the attestation format used at registration
+	AttestationType string `json:"attestation_type"`
+	// Transport lists the authenticator transports
+	Transport []string `json:"transport"`
+	// Authenticator describes the authenticator that created the credential
+	Authenticator Authenticator `json:"authenticator"`
+	// Origin is the relying party origin the credential was created for
+	Origin string `json:"origin"`
+	// Label is a user supplied device name
+	Label string `json:"label"`
+	// CreatedAt is the unix time the credential was registered
+	CreatedAt int64 `json:"created_at"`
+}
+
+// Authenticator is the authenticator data stored with a legacy credential
+type Authenticator struct {
+	Aaguid       []byte `json:"aaguid"`
+	SignCount    uint32 `json:"sign_count"`
+	CloneWarning bool   `json:"clone_warning"`
+}
+
+// ServiceRecord is a sonrhq/core registered service
+type ServiceRecord struct {
+	// Id is the service identifier
+	Id string `json:"id"`
+	// Controller is the address that registered the service
+	Controller string `json:"controller"`
+	// Origin is the service origin, e.g. https://app.example.com
+	Origin string `json:"origin"`
+	// Name is the display name of the service
+	Name string `json:"name"`
+	// Description is the service description
+	Description string `json:"description"`
+	// Permissions are the legacy permission names granted to the service
+	Permissions []string `json:"permissions"`
+	// CreatedAt is the unix time the service was registered
+	CreatedAt int64 `json:"created_at"`
+}
+
+// LoadExport reads and decodes a legacy export file, returning the export and
+// the hex encoded sha256 digest of the file contents
+func LoadExport(path string) (*Export, string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read legacy export: %w", err)
+	}
+
+	export, err := DecodeExport(bz)
+	if err != nil {
+		return nil, "", err
+	}
+
+	digest := sha256.Sum256(bz)
+	return export, hex.EncodeToString(digest[:]), nil
+}
+
+// DecodeExport decodes a legacy export, rejecting unknown fields so that a
+// mismatched export format is not silently imported as empty records
+func DecodeExport(bz []byte) (*Export, error) {
+	var export Export
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to decode legacy export: %w", err)
+	}
+	return &export, nil
+}
@@ -0,0 +1,160 @@
+package legacy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+// MigrateLocal imports legacy accounts and credentials into the local
+// credential database used by the auth server. An account is keyed by its
+// alias (or address when it has none) and a credential by its ID; existing
+// rows are reported as unchanged when they belong to the same account and as
+// conflicts otherwise. With dryRun nothing is written.
+func MigrateLocal(db *gorm.DB, export *Export, chainID string, dryRun bool) (*Report, error) {
+	report := &Report{DryRun: dryRun}
+
+	usernames := make(map[string]string)
+	for _, account := range export.Accounts {
+		username, err := migrateLocalAccount(db, report, account, chainID, dryRun)
+		if err != nil {
+			return report, err
+		}
+		if username == "" {
+			continue
+		}
+
+		address, _ := MigrateAddress(account.Address)
+		did, err := accountDID(account, address)
+		if err == nil {
+			usernames[did] = username
+		}
+	}
+
+	for _, cred := range export.Credentials {
+		if err := migrateLocalCredential(db, report, cred, usernames, dryRun); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// migrateLocalAccount imports a single account, returning the username it is
+// stored under or "" when it was not migrated
+func migrateLocalAccount(db *gorm.DB, report *Report, account Account, chainID string, dryRun bool) (string, error) {
+	address, err := MigrateAddress(account.Address)
+	if err != nil {
+		report.add(KindAccount, account.Address, ActionInvalid, err.Error())
+		return "", nil
+	}
+	did, err := accountDID(account, address)
+	if err != nil {
+		report.add(KindAccount, address, ActionInvalid, err.Error())
+		return "", nil
+	}
+
+	username := account.Alias
+	if username == "" {
+		username = address
+	}
+
+	var existing server.AccountInfo
+	err = db.Where("username = ? OR address = ?", username, address).First(&existing).Error
+	switch {
+	case err == nil:
+		if existing.Address != address || existing.Username != username {
+			report.add(KindAccount, username, ActionConflict, fmt.Sprintf("stored as %s for %s", existing.Username, existing.Address))
+			return "", nil
+		}
+		report.add(KindAccount, username, ActionUnchanged, "")
+		return username, nil
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return "", fmt.Errorf("failed to read account %s: %w", username, err)
+	}
+
+	if !dryRun {
+		keyType := account.KeyType
+		if keyType == "" {
+			keyType = "secp256k1"
+		}
+		err := db.Create(&server.AccountInfo{
+			Username:  username,
+			Address:   address,
+			DID:       did,
+			PublicKey: account.PublicKey,
+			KeyType:   keyType,
+			ChainID:   chainID,
+		}).Error
+		if err != nil {
+			return "", fmt.Errorf("failed to store account %s: %w", username, err)
+		}
+	}
+
+	report.add(KindAccount, username, ActionCreate, "")
+	return username, nil
+}
+
+func migrateLocalCredential(
+	db *gorm.DB,
+	report *Report,
+	cred Credential,
+	usernames map[string]string,
+	dryRun bool,
+) error {
+	id := CredentialID(cred)
+	if len(cred.Id) == 0 || len(cred.PublicKey) == 0 {
+		report.add(KindCredential, id, ActionInvalid, "missing credential ID or public key")
+		return nil
+	}
+
+	did, err := MigrateDID(cred.Controller)
+	if err != nil {
+		report.add(KindCredential, id, ActionInvalid, err.Error())
+		return nil
+	}
+	username, ok := usernames[did]
+	if !ok {
+		report.add(KindCredential, id, ActionInvalid, fmt.Sprintf("no migrated account for controller %s", did))
+		return nil
+	}
+
+	var existing server.StoredWebAuthnCredential
+	err = db.Where("credential_id = ?", id).First(&existing).Error
+	switch {
+	case err == nil:
+		if existing.Username != username {
+			report.add(KindCredential, id, ActionConflict, fmt.Sprintf("registered to %s", existing.Username))
+			return nil
+		}
+		report.add(KindCredential, id, ActionUnchanged, "")
+		return nil
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return fmt.Errorf("failed to read credential %s: %w", id, err)
+	}
+
+	if !dryRun {
+		stored := &server.StoredWebAuthnCredential{
+			CredentialID: id,
+			RawID:        id,
+			Username:     username,
+			PublicKey:    cred.PublicKey,
+			Algorithm:    cred.Algorithm,
+			Origin:       cred.Origin,
+			RPID:         rpID(cred.Origin),
+		}
+		if cred.CreatedAt > 0 {
+			stored.CreatedAt = time.Unix(cred.CreatedAt, 0)
+		}
+		if err := db.Create(stored).Error; err != nil {
+			return fmt.Errorf("failed to store credential %s: %w", id, err)
+		}
+	}
+
+	report.add(KindCredential, id, ActionCreate, "")
+	return nil
+}
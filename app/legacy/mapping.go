@@ -0,0 +1,239 @@
+package legacy
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	didv1 "github.com/sonr-io/sonr/api/did/v1"
+	svcv1 "github.com/sonr-io/sonr/api/svc/v1"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// legacyDIDMethodPrefix is the DID method used by sonrhq/core
+const legacyDIDMethodPrefix = "did:snr:"
+
+// legacyVerificationMethodKind marks verification methods created from
+// sonrhq/core credentials
+const legacyVerificationMethodKind = "WebAuthnCredential2024"
+
+// legacyPermissions maps sonrhq/core permission names to the x/svc
+// permissions that cover the same operations
+var legacyPermissions = map[string][]string{
+	"basic":        {"authenticate"},
+	"authenticate": {"authenticate"},
+	"read":         {"read"},
+	"write":        {"read", "write"},
+	"wallet":       {"read", "execute"},
+	"admin":        {"admin"},
+}
+
+// MigrateDID rewrites a legacy did:snr DID to the did:sonr method. DIDs that
+// already use did:sonr are returned unchanged.
+func MigrateDID(did string) (string, error) {
+	switch {
+	case strings.HasPrefix(did, didtypes.DIDMethodPrefix):
+		return did, nil
+	case strings.HasPrefix(did, legacyDIDMethodPrefix):
+		id := strings.TrimPrefix(did, legacyDIDMethodPrefix)
+		if id == "" {
+			return "", fmt.Errorf("empty DID identifier in %q", did)
+		}
+		return didtypes.DIDMethodPrefix + id, nil
+	default:
+		return "", fmt.Errorf("unsupported DID method in %q", did)
+	}
+}
+
+// MigrateAddress re-encodes a legacy bech32 address with the current account
+// prefix
+func MigrateAddress(address string) (string, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	return sdk.AccAddress(bz).String(), nil
+}
+
+// accountDID returns the migrated DID of an account, deriving one from the
+// address when the export does not carry a DID
+func accountDID(account Account, address string) (string, error) {
+	if account.Did == "" {
+		return didtypes.DIDMethodPrefix + address, nil
+	}
+	return MigrateDID(account.Did)
+}
+
+// CredentialID returns the base64url encoded WebAuthn credential ID used by
+// the current modules
+func CredentialID(cred Credential) string {
+	return base64.RawURLEncoding.EncodeToString(cred.Id)
+}
+
+// MapAccount builds the DID document for a legacy account and the credentials
+// it controls. Verification method IDs follow the order of creds, so mapping
+// the same export twice yields the same document.
+func MapAccount(account Account, creds []Credential, now int64) (*didv1.DIDDocument, error) {
+	address, err := MigrateAddress(account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := accountDID(account, address)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := account.CreatedAt
+	if createdAt == 0 {
+		createdAt = now
+	}
+
+	doc := &didv1.DIDDocument{
+		Id:                   did,
+		PrimaryController:    address,
+		Authentication:       []*didv1.VerificationMethodReference{},
+		AssertionMethod:      []*didv1.VerificationMethodReference{},
+		KeyAgreement:         []*didv1.VerificationMethodReference{},
+		CapabilityInvocation: []*didv1.VerificationMethodReference{},
+		CapabilityDelegation: []*didv1.VerificationMethodReference{},
+		Service:              []*didv1.Service{},
+		CreatedAt:            createdAt,
+		UpdatedAt:            now,
+		Version:              1,
+	}
+	if account.Did != "" && account.Did != did {
+		doc.AlsoKnownAs = append(doc.AlsoKnownAs, account.Did)
+	}
+
+	for i, cred := range creds {
+		if len(cred.Id) == 0 {
+			return nil, fmt.Errorf("credential %d has no ID", i)
+		}
+		if len(cred.PublicKey) == 0 {
+			return nil, fmt.Errorf("credential %s has no public key", CredentialID(cred))
+		}
+
+		vm := &didv1.VerificationMethod{
+			Id:                     fmt.Sprintf("%s#webauthn-%d", did, i+1),
+			VerificationMethodKind: legacyVerificationMethodKind,
+			Controller:             did,
+			WebauthnCredential: &didv1.WebAuthnCredential{
+				CredentialId:    CredentialID(cred),
+				RawId:           CredentialID(cred),
+				PublicKey:       cred.PublicKey,
+				Algorithm:       cred.Algorithm,
+				AttestationType: cred.AttestationType,
+				Origin:          cred.Origin,
+				RpId:            rpID(cred.Origin),
+				Transports:      cred.Transport,
+				CreatedAt:       cred.CreatedAt,
+			},
+		}
+		ref := &didv1.VerificationMethodReference{VerificationMethodId: vm.Id}
+
+		doc.VerificationMethod = append(doc.VerificationMethod, vm)
+		doc.Authentication = append(doc.Authentication, ref)
+		doc.AssertionMethod = append(doc.AssertionMethod, ref)
+		doc.CapabilityInvocation = append(doc.CapabilityInvocation, ref)
+	}
+
+	return doc, nil
+}
+
+// MapServiceRecord builds the x/svc service for a legacy service record. The
+// service is suspended unless its domain is already verified, so it cannot act
+// until the owner proves control of the domain on this chain.
+func MapServiceRecord(record ServiceRecord, domainVerified bool, now int64) (*svcv1.Service, error) {
+	if record.Id == "" {
+		return nil, fmt.Errorf("service ID cannot be empty")
+	}
+	owner, err := MigrateAddress(record.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := ServiceDomain(record)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := MapPermissions(record.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := record.CreatedAt
+	if createdAt == 0 {
+		createdAt = now
+	}
+
+	status := svcv1.ServiceStatus_SERVICE_STATUS_SUSPENDED
+	if domainVerified {
+		status = svcv1.ServiceStatus_SERVICE_STATUS_ACTIVE
+	}
+
+	return &svcv1.Service{
+		Id:          record.Id,
+		Domain:      domain,
+		Owner:       owner,
+		Permissions: permissions,
+		Status:      status,
+		CreatedAt:   createdAt,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// ServiceDomain returns the host of a legacy service origin
+func ServiceDomain(record ServiceRecord) (string, error) {
+	origin := record.Origin
+	if origin == "" {
+		return "", fmt.Errorf("service %s has no origin", record.Id)
+	}
+	if !strings.Contains(origin, "://") {
+		origin = "https://" + origin
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("invalid origin %q", record.Origin)
+	}
+	return strings.ToLower(u.Hostname()), nil
+}
+
+// MapPermissions converts legacy permission names, with or without the
+// PERMISSIONS_ enum prefix, to x/svc permissions
+func MapPermissions(legacy []string) ([]string, error) {
+	var permissions []string
+	seen := make(map[string]bool)
+	for _, name := range legacy {
+		key := strings.ToLower(strings.TrimPrefix(strings.ToUpper(name), "PERMISSIONS_"))
+		mapped, ok := legacyPermissions[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown legacy permission %q", name)
+		}
+		for _, p := range mapped {
+			if !seen[p] {
+				seen[p] = true
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf("at least one permission is required")
+	}
+	return permissions, nil
+}
+
+// rpID returns the relying party ID for a credential origin
+func rpID(origin string) string {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
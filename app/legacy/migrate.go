@@ -0,0 +1,312 @@
+package legacy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/orm/types/ormerrors"
+
+	didv1 "github.com/sonr-io/sonr/api/did/v1"
+	svcv1 "github.com/sonr-io/sonr/api/svc/v1"
+)
+
+// Target is the chain state a migration reads existing records from and
+// writes new records to. Getters return nil when a record does not exist.
+type Target interface {
+	GetDIDDocument(ctx context.Context, did string) (*didv1.DIDDocument, error)
+	GetService(ctx context.Context, id string) (*svcv1.Service, error)
+	GetServiceByDomain(ctx context.Context, domain string) (*svcv1.Service, error)
+	IsDomainVerified(ctx context.Context, domain string) (bool, error)
+	InsertDIDDocument(ctx context.Context, doc *didv1.DIDDocument) error
+	InsertService(ctx context.Context, service *svcv1.Service) error
+}
+
+// StoreTarget is a Target backed by the x/did and x/svc ORM stores
+type StoreTarget struct {
+	did            didv1.StateStore
+	svc            svcv1.StateStore
+	domainVerified func(ctx context.Context, domain string) bool
+}
+
+var _ Target = StoreTarget{}
+
+// NewStoreTarget creates a Target writing to the given module stores.
+// domainVerified reports whether a domain has a current verification, such as
+// the x/svc keeper's IsVerifiedDomain.
+func NewStoreTarget(
+	did didv1.StateStore,
+	svc svcv1.StateStore,
+	domainVerified func(ctx context.Context, domain string) bool,
+) StoreTarget {
+	return StoreTarget{did: did, svc: svc, domainVerified: domainVerified}
+}
+
+// GetDIDDocument implements Target
+func (t StoreTarget) GetDIDDocument(ctx context.Context, did string) (*didv1.DIDDocument, error) {
+	doc, err := t.did.DIDDocumentTable().Get(ctx, did)
+	if ormerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return doc, err
+}
+
+// GetService implements Target
+func (t StoreTarget) GetService(ctx context.Context, id string) (*svcv1.Service, error) {
+	service, err := t.svc.ServiceTable().Get(ctx, id)
+	if ormerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return service, err
+}
+
+// GetServiceByDomain implements Target
+func (t StoreTarget) GetServiceByDomain(ctx context.Context, domain string) (*svcv1.Service, error) {
+	service, err := t.svc.ServiceTable().GetByDomain(ctx, domain)
+	if ormerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return service, err
+}
+
+// IsDomainVerified implements Target
+func (t StoreTarget) IsDomainVerified(ctx context.Context, domain string) (bool, error) {
+	if t.domainVerified == nil {
+		return false, nil
+	}
+	return t.domainVerified(ctx, domain), nil
+}
+
+// InsertDIDDocument implements Target
+func (t StoreTarget) InsertDIDDocument(ctx context.Context, doc *didv1.DIDDocument) error {
+	return t.did.DIDDocumentTable().Insert(ctx, doc)
+}
+
+// InsertService implements Target
+func (t StoreTarget) InsertService(ctx context.Context, service *svcv1.Service) error {
+	return t.svc.ServiceTable().Insert(ctx, service)
+}
+
+// Migrate maps every account, credential and service record in export onto
+// target. Records that already exist with the same owner and content are
+// reported as unchanged, so the migration can be re-run safely; records that
+// collide with different state are reported as conflicts and never
+// overwritten. With dryRun nothing is written. now is the unix time recorded
+// on new records that carry no legacy timestamp.
+func Migrate(ctx context.Context, target Target, export *Export, dryRun bool, now int64) (*Report, error) {
+	report := &Report{DryRun: dryRun}
+
+	creds := groupCredentials(report, export)
+
+	seenDIDs := make(map[string]bool)
+	for _, account := range export.Accounts {
+		if err := migrateAccount(ctx, target, report, account, creds, seenDIDs, dryRun, now); err != nil {
+			return report, err
+		}
+	}
+
+	for _, did := range slices.Sorted(maps.Keys(creds)) {
+		if seenDIDs[did] {
+			continue
+		}
+		for _, cred := range creds[did] {
+			report.add(KindCredential, CredentialID(cred), ActionInvalid, fmt.Sprintf("no account for controller %s", did))
+		}
+	}
+
+	seenServices := make(map[string]bool)
+	seenDomains := make(map[string]bool)
+	for _, record := range export.ServiceRecords {
+		if err := migrateService(ctx, target, report, record, seenServices, seenDomains, dryRun, now); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// groupCredentials indexes credentials by migrated controller DID, reporting
+// credentials that cannot be attributed or repeat an earlier credential ID
+func groupCredentials(report *Report, export *Export) map[string][]Credential {
+	grouped := make(map[string][]Credential)
+	seen := make(map[string]bool)
+	for _, cred := range export.Credentials {
+		id := CredentialID(cred)
+		if seen[id] {
+			report.add(KindCredential, id, ActionInvalid, "duplicate credential ID in export")
+			continue
+		}
+		seen[id] = true
+
+		did, err := MigrateDID(cred.Controller)
+		if err != nil {
+			report.add(KindCredential, id, ActionInvalid, err.Error())
+			continue
+		}
+		grouped[did] = append(grouped[did], cred)
+	}
+	return grouped
+}
+
+func migrateAccount(
+	ctx context.Context,
+	target Target,
+	report *Report,
+	account Account,
+	creds map[string][]Credential,
+	seen map[string]bool,
+	dryRun bool,
+	now int64,
+) error {
+	address, err := MigrateAddress(account.Address)
+	if err != nil {
+		report.add(KindDIDDocument, account.Did, ActionInvalid, err.Error())
+		return nil
+	}
+	did, err := accountDID(account, address)
+	if err != nil {
+		report.add(KindDIDDocument, account.Did, ActionInvalid, err.Error())
+		return nil
+	}
+	if seen[did] {
+		report.add(KindDIDDocument, did, ActionInvalid, "duplicate account in export")
+		return nil
+	}
+	seen[did] = true
+
+	owned := creds[did]
+	doc, err := MapAccount(account, owned, now)
+	if err != nil {
+		report.add(KindDIDDocument, did, ActionInvalid, err.Error())
+		for _, cred := range owned {
+			report.add(KindCredential, CredentialID(cred), ActionInvalid, "account could not be migrated")
+		}
+		return nil
+	}
+
+	existing, err := target.GetDIDDocument(ctx, did)
+	if err != nil {
+		return fmt.Errorf("failed to read DID document %s: %w", did, err)
+	}
+
+	action, reason := ActionCreate, ""
+	if existing != nil {
+		action, reason = compareDocuments(existing, doc)
+	}
+
+	if action == ActionCreate && !dryRun {
+		if err := target.InsertDIDDocument(ctx, doc); err != nil {
+			return fmt.Errorf("failed to insert DID document %s: %w", did, err)
+		}
+	}
+
+	report.add(KindDIDDocument, did, action, reason)
+	for _, cred := range owned {
+		report.add(KindCredential, CredentialID(cred), action, reason)
+	}
+	return nil
+}
+
+// compareDocuments decides whether an existing DID document is the result of
+// an earlier migration of doc. The document may have gained verification
+// methods since, but it must keep the same controller and every legacy
+// credential.
+func compareDocuments(existing, doc *didv1.DIDDocument) (Action, string) {
+	if existing.PrimaryController != doc.PrimaryController {
+		return ActionConflict, fmt.Sprintf("controlled by %s", existing.PrimaryController)
+	}
+
+	var have []string
+	for _, vm := range existing.VerificationMethod {
+		if vm.WebauthnCredential != nil {
+			have = append(have, vm.WebauthnCredential.CredentialId)
+		}
+	}
+
+	missing := 0
+	for _, vm := range doc.VerificationMethod {
+		if !slices.Contains(have, vm.WebauthnCredential.CredentialId) {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return ActionConflict, fmt.Sprintf("existing document lacks %d legacy credentials", missing)
+	}
+
+	return ActionUnchanged, ""
+}
+
+func migrateService(
+	ctx context.Context,
+	target Target,
+	report *Report,
+	record ServiceRecord,
+	seenServices map[string]bool,
+	seenDomains map[string]bool,
+	dryRun bool,
+	now int64,
+) error {
+	if seenServices[record.Id] {
+		report.add(KindService, record.Id, ActionInvalid, "duplicate service in export")
+		return nil
+	}
+	seenServices[record.Id] = true
+
+	domain, err := ServiceDomain(record)
+	if err != nil {
+		report.add(KindService, record.Id, ActionInvalid, err.Error())
+		return nil
+	}
+	verified, err := target.IsDomainVerified(ctx, domain)
+	if err != nil {
+		return fmt.Errorf("failed to check domain %s: %w", domain, err)
+	}
+
+	service, err := MapServiceRecord(record, verified, now)
+	if err != nil {
+		report.add(KindService, record.Id, ActionInvalid, err.Error())
+		return nil
+	}
+
+	existing, err := target.GetService(ctx, service.Id)
+	if err != nil {
+		return fmt.Errorf("failed to read service %s: %w", service.Id, err)
+	}
+	if existing != nil {
+		if existing.Owner != service.Owner || existing.Domain != service.Domain {
+			report.add(KindService, service.Id, ActionConflict, fmt.Sprintf("registered to %s for %s", existing.Owner, existing.Domain))
+			return nil
+		}
+		report.add(KindService, service.Id, ActionUnchanged, "")
+		return nil
+	}
+
+	if seenDomains[service.Domain] {
+		report.add(KindService, service.Id, ActionConflict, fmt.Sprintf("domain %s used by another service in export", service.Domain))
+		return nil
+	}
+	seenDomains[service.Domain] = true
+
+	bound, err := target.GetServiceByDomain(ctx, service.Domain)
+	if err != nil {
+		return fmt.Errorf("failed to read services for domain %s: %w", service.Domain, err)
+	}
+	if bound != nil {
+		report.add(KindService, service.Id, ActionConflict, fmt.Sprintf("domain %s bound to service %s", service.Domain, bound.Id))
+		return nil
+	}
+
+	if !dryRun {
+		if err := target.InsertService(ctx, service); err != nil {
+			return fmt.Errorf("failed to insert service %s: %w", service.Id, err)
+		}
+	}
+
+	reason := ""
+	if service.Status == svcv1.ServiceStatus_SERVICE_STATUS_SUSPENDED {
+		reason = "suspended until domain is verified"
+	}
+	report.add(KindService, service.Id, ActionCreate, reason)
+	return nil
+}
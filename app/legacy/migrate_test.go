@@ -0,0 +1,160 @@
+package legacy_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	didv1 "github.com/sonr-io/sonr/api/did/v1"
+	svcv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/app/legacy"
+)
+
+// memTarget is an in-memory legacy.Target
+type memTarget struct {
+	docs     map[string]*didv1.DIDDocument
+	services map[string]*svcv1.Service
+	verified map[string]bool
+}
+
+func newMemTarget() *memTarget {
+	return &memTarget{
+		docs:     make(map[string]*didv1.DIDDocument),
+		services: make(map[string]*svcv1.Service),
+		verified: make(map[string]bool),
+	}
+}
+
+func (m *memTarget) GetDIDDocument(_ context.Context, did string) (*didv1.DIDDocument, error) {
+	return m.docs[did], nil
+}
+
+func (m *memTarget) GetService(_ context.Context, id string) (*svcv1.Service, error) {
+	return m.services[id], nil
+}
+
+func (m *memTarget) GetServiceByDomain(_ context.Context, domain string) (*svcv1.Service, error) {
+	for _, s := range m.services {
+		if s.Domain == domain {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memTarget) IsDomainVerified(_ context.Context, domain string) (bool, error) {
+	return m.verified[domain], nil
+}
+
+func (m *memTarget) InsertDIDDocument(_ context.Context, doc *didv1.DIDDocument) error {
+	m.docs[doc.Id] = doc
+	return nil
+}
+
+func (m *memTarget) InsertService(_ context.Context, service *svcv1.Service) error {
+	m.services[service.Id] = service
+	return nil
+}
+
+func testExport() *legacy.Export {
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	return &legacy.Export{
+		Accounts: []legacy.Account{
+			{Address: alice, Did: "did:snr:alice", Alias: "alice", CreatedAt: 1600000000},
+		},
+		Credentials: []legacy.Credential{
+			{Id: []byte("cred-1"), Controller: "did:snr:alice", PublicKey: []byte{1, 2, 3}, Algorithm: -7, Origin: "https://app.sonr.io"},
+			{Id: []byte("cred-2"), Controller: "did:snr:bob", PublicKey: []byte{4, 5, 6}, Algorithm: -7},
+		},
+		ServiceRecords: []legacy.ServiceRecord{
+			{Id: "app", Controller: alice, Origin: "https://App.Example.com", Permissions: []string{"PERMISSIONS_WRITE"}},
+			{Id: "bad", Controller: alice, Origin: "bad.example.com", Permissions: []string{"teleport"}},
+		},
+	}
+}
+
+func TestMigrateCreatesAndIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	target := newMemTarget()
+	target.verified["app.example.com"] = true
+
+	report, err := legacy.Migrate(ctx, target, testExport(), false, 1700000000)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Count(legacy.KindDIDDocument, legacy.ActionCreate))
+	require.Equal(t, 1, report.Count(legacy.KindCredential, legacy.ActionCreate))
+	require.Equal(t, 1, report.Count(legacy.KindCredential, legacy.ActionInvalid))
+	require.Equal(t, 1, report.Count(legacy.KindService, legacy.ActionCreate))
+	require.Equal(t, 1, report.Count(legacy.KindService, legacy.ActionInvalid))
+
+	doc := target.docs["did:sonr:alice"]
+	require.NotNil(t, doc)
+	require.Equal(t, []string{"did:snr:alice"}, doc.AlsoKnownAs)
+	require.Len(t, doc.VerificationMethod, 1)
+	require.Equal(t, "did:sonr:alice#webauthn-1", doc.VerificationMethod[0].Id)
+	require.Equal(t, "app.sonr.io", doc.VerificationMethod[0].WebauthnCredential.RpId)
+	require.Equal(t, int64(1600000000), doc.CreatedAt)
+
+	service := target.services["app"]
+	require.NotNil(t, service)
+	require.Equal(t, "app.example.com", service.Domain)
+	require.Equal(t, []string{"read", "write"}, service.Permissions)
+	require.Equal(t, svcv1.ServiceStatus_SERVICE_STATUS_ACTIVE, service.Status)
+
+	rerun, err := legacy.Migrate(ctx, target, testExport(), false, 1700000100)
+	require.NoError(t, err)
+	require.Equal(t, 1, rerun.Count(legacy.KindDIDDocument, legacy.ActionUnchanged))
+	require.Equal(t, 1, rerun.Count(legacy.KindService, legacy.ActionUnchanged))
+	require.Zero(t, rerun.Count(legacy.KindDIDDocument, legacy.ActionCreate))
+	require.Zero(t, rerun.Count(legacy.KindService, legacy.ActionCreate))
+}
+
+func TestMigrateDryRunWritesNothing(t *testing.T) {
+	target := newMemTarget()
+
+	report, err := legacy.Migrate(context.Background(), target, testExport(), true, 1700000000)
+	require.NoError(t, err)
+	require.True(t, report.DryRun)
+	require.Equal(t, 1, report.Count(legacy.KindDIDDocument, legacy.ActionCreate))
+	require.Equal(t, 1, report.Count(legacy.KindService, legacy.ActionCreate))
+	require.Empty(t, target.docs)
+	require.Empty(t, target.services)
+}
+
+func TestMigrateReportsConflicts(t *testing.T) {
+	target := newMemTarget()
+	other := sdk.AccAddress([]byte("mallory_____________")).String()
+	target.docs["did:sonr:alice"] = &didv1.DIDDocument{Id: "did:sonr:alice", PrimaryController: other}
+	target.services["squatter"] = &svcv1.Service{Id: "squatter", Domain: "app.example.com", Owner: other}
+
+	report, err := legacy.Migrate(context.Background(), target, testExport(), false, 1700000000)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Count(legacy.KindDIDDocument, legacy.ActionConflict))
+	require.Equal(t, 1, report.Count(legacy.KindService, legacy.ActionConflict))
+	require.True(t, report.HasProblems())
+
+	require.Equal(t, other, target.docs["did:sonr:alice"].PrimaryController)
+	require.Nil(t, target.services["app"])
+}
+
+func TestMigrateSuspendsUnverifiedServices(t *testing.T) {
+	target := newMemTarget()
+
+	_, err := legacy.Migrate(context.Background(), target, testExport(), false, 1700000000)
+	require.NoError(t, err)
+	require.Equal(t, svcv1.ServiceStatus_SERVICE_STATUS_SUSPENDED, target.services["app"].Status)
+}
+
+func TestMigrateDID(t *testing.T) {
+	did, err := legacy.MigrateDID("did:snr:abc")
+	require.NoError(t, err)
+	require.Equal(t, "did:sonr:abc", did)
+
+	did, err = legacy.MigrateDID("did:sonr:abc")
+	require.NoError(t, err)
+	require.Equal(t, "did:sonr:abc", did)
+
+	_, err = legacy.MigrateDID("did:key:abc")
+	require.Error(t, err)
+}
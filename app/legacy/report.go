@@ -0,0 +1,120 @@
+package legacy
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Record kinds covered by a migration report
+const (
+	KindDIDDocument = "did_document"
+	KindService     = "service"
+	KindCredential  = "credential"
+	KindAccount     = "account"
+)
+
+// Action is the outcome of migrating a single legacy record
+type Action string
+
+const (
+	// ActionCreate means the record was (or, in a dry run, would be) written
+	ActionCreate Action = "create"
+	// ActionUnchanged means an identical record already exists, typically
+	// because the migration was run before
+	ActionUnchanged Action = "unchanged"
+	// ActionConflict means a different record already occupies the target
+	// key; it is left untouched
+	ActionConflict Action = "conflict"
+	// ActionInvalid means the legacy record could not be mapped
+	ActionInvalid Action = "invalid"
+)
+
+// Entry records the outcome for a single legacy record
+type Entry struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Action Action `json:"action"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Report summarizes a migration run
+type Report struct {
+	DryRun  bool    `json:"dry_run"`
+	Entries []Entry `json:"entries"`
+}
+
+func (r *Report) add(kind, id string, action Action, reason string) {
+	r.Entries = append(r.Entries, Entry{Kind: kind, ID: id, Action: action, Reason: reason})
+}
+
+// Count returns the number of entries of kind with the given action
+func (r *Report) Count(kind string, action Action) int {
+	n := 0
+	for _, e := range r.Entries {
+		if e.Kind == kind && e.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasProblems reports whether any record conflicted or was invalid
+func (r *Report) HasProblems() bool {
+	for _, e := range r.Entries {
+		if e.Action == ActionConflict || e.Action == ActionInvalid {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge appends the entries of other to r
+func (r *Report) Merge(other *Report) {
+	if other == nil {
+		return
+	}
+	r.Entries = append(r.Entries, other.Entries...)
+}
+
+// WriteText writes a per-kind summary followed by every conflicting or
+// invalid record
+func (r *Report) WriteText(w io.Writer) error {
+	mode := "applied"
+	if r.DryRun {
+		mode = "dry run"
+	}
+	if _, err := fmt.Fprintf(w, "Legacy migration (%s)\n\n", mode); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tCREATE\tUNCHANGED\tCONFLICT\tINVALID")
+	for _, kind := range []string{KindDIDDocument, KindService, KindAccount, KindCredential} {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n",
+			kind,
+			r.Count(kind, ActionCreate),
+			r.Count(kind, ActionUnchanged),
+			r.Count(kind, ActionConflict),
+			r.Count(kind, ActionInvalid),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if !r.HasProblems() {
+		return nil
+	}
+
+	fmt.Fprintln(w, "\nProblems:")
+	for _, e := range r.Entries {
+		if e.Action != ActionConflict && e.Action != ActionInvalid {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "  %s %s %s: %s\n", e.Action, e.Kind, e.ID, e.Reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Package msgcatalog resolves user-facing strings by key and locale. APIs
+// answer with message keys and render them through a Catalog, so white-label
+// deployments can translate or reword copy by overriding keys in a file
+// instead of forking handlers.
+package msgcatalog
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultLanguage is used when no requested language has a translation
+const DefaultLanguage = "en"
+
+// Translations maps a language tag to the text of one message. Text may
+// reference arguments as "{name}".
+type Translations map[string]string
+
+// Catalog holds messages by key. It is built at startup and is safe for
+// concurrent reads afterwards.
+type Catalog struct {
+	fallback string
+	messages map[string]Translations
+}
+
+// New creates an empty catalog that falls back to the fallback language,
+// DefaultLanguage when empty
+func New(fallback string) *Catalog {
+	if fallback == "" {
+		fallback = DefaultLanguage
+	}
+	return &Catalog{
+		fallback: strings.ToLower(fallback),
+		messages: make(map[string]Translations),
+	}
+}
+
+// Fallback returns the language used when no requested language matches
+func (c *Catalog) Fallback() string {
+	return c.fallback
+}
+
+// Add sets the translations of a message. Languages the message already has
+// and t does not are kept, so overrides may translate a single language.
+func (c *Catalog) Add(key string, t Translations) {
+	msg, ok := c.messages[key]
+	if !ok {
+		msg = make(Translations, len(t))
+		c.messages[key] = msg
+	}
+	for lang, text := range t {
+		msg[strings.ToLower(lang)] = text
+	}
+}
+
+// Merge adds every message of messages
+func (c *Catalog) Merge(messages map[string]Translations) {
+	for key, t := range messages {
+		c.Add(key, t)
+	}
+}
+
+// Has reports whether the catalog holds a message
+func (c *Catalog) Has(key string) bool {
+	_, ok := c.messages[key]
+	return ok
+}
+
+// Keys returns the message keys in sorted order
+func (c *Catalog) Keys() []string {
+	keys := make([]string, 0, len(c.messages))
+	for key := range c.messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Lookup returns the text of a message in the first of langs that has a
+// translation, trying each tag and then its base language ("pt" for
+// "pt-BR"), and finally the fallback language
+func (c *Catalog) Lookup(key string, langs ...string) (text, lang string, ok bool) {
+	msg, found := c.messages[key]
+	if !found {
+		return "", "", false
+	}
+	for _, l := range langs {
+		l = strings.ToLower(l)
+		if t, ok := msg[l]; ok {
+			return t, l, true
+		}
+		if base, _, cut := strings.Cut(l, "-"); cut {
+			if t, ok := msg[base]; ok {
+				return t, base, true
+			}
+		}
+	}
+	t, ok := msg[c.fallback]
+	return t, c.fallback, ok
+}
+
+// Text renders a message in the best of langs, filling in "{name}"
+// references from args. An unknown key renders as the key itself, so a
+// missing translation is visible rather than blank.
+func (c *Catalog) Text(langs []string, key string, args map[string]string) string {
+	text, _, ok := c.Lookup(key, langs...)
+	if !ok {
+		return key
+	}
+	return Format(text, args)
+}
+
+// Bundle renders every message whose key starts with prefix in the best of
+// langs, leaving arguments unfilled for the client
+func (c *Catalog) Bundle(prefix string, langs ...string) map[string]string {
+	out := make(map[string]string)
+	for key := range c.messages {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if text, _, ok := c.Lookup(key, langs...); ok {
+			out[key] = text
+		}
+	}
+	return out
+}
+
+// Format replaces "{name}" references in text with args
+func Format(text string, args map[string]string) string {
+	if len(args) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+	pairs := make([]string, 0, 2*len(args))
+	for name, value := range args {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
@@ -0,0 +1,102 @@
+package msgcatalog_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+var builtin = map[string]msgcatalog.Translations{
+	"faucet.cooldown": {
+		"en": "already funded recently",
+		"es": "ya recibió fondos recientemente",
+	},
+	"faucet.no_did": {
+		"en": "no DID controlled by {address}",
+		"pt": "nenhum DID controlado por {address}",
+	},
+}
+
+func TestLookupFallbacks(t *testing.T) {
+	c := msgcatalog.New("")
+	c.Merge(builtin)
+
+	text, lang, ok := c.Lookup("faucet.cooldown", "fr", "ES")
+	require.True(t, ok)
+	require.Equal(t, "es", lang, "tags are matched case-insensitively in order")
+	require.Equal(t, "ya recibió fondos recientemente", text)
+
+	_, lang, _ = c.Lookup("faucet.no_did", "pt-BR")
+	require.Equal(t, "pt", lang, "a regional tag falls back to its base language")
+
+	_, lang, _ = c.Lookup("faucet.cooldown", "ja")
+	require.Equal(t, msgcatalog.DefaultLanguage, lang)
+
+	_, _, ok = c.Lookup("faucet.unknown", "en")
+	require.False(t, ok)
+	require.Equal(t, "faucet.unknown", c.Text(nil, "faucet.unknown", nil), "unknown keys render as the key")
+
+	require.Equal(t, "nenhum DID controlado por idx1abc",
+		c.Text([]string{"pt"}, "faucet.no_did", map[string]string{"address": "idx1abc"}))
+}
+
+func TestLoadOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "messages.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+faucet.cooldown:
+  en: You already claimed your Acme tokens
+faucet.welcome:
+  fr: Bienvenue
+`), 0o600))
+
+	c, err := msgcatalog.Load(msgcatalog.Config{DefaultLanguage: "fr", OverridesFile: path}, builtin)
+	require.NoError(t, err)
+
+	require.Equal(t, "You already claimed your Acme tokens", c.Text([]string{"en"}, "faucet.cooldown", nil))
+	require.Equal(t, "ya recibió fondos recientemente", c.Text([]string{"es"}, "faucet.cooldown", nil),
+		"languages the override does not name are kept")
+	require.Equal(t, "Bienvenue", c.Text([]string{"de"}, "faucet.welcome", nil), "the configured fallback applies")
+
+	require.NoError(t, os.WriteFile(path, []byte(`faucet.cooldown: {"": text}`), 0o600))
+	_, err = msgcatalog.Load(msgcatalog.Config{OverridesFile: path}, builtin)
+	require.ErrorContains(t, err, "empty language tag")
+
+	_, err = msgcatalog.Load(msgcatalog.Config{OverridesFile: filepath.Join(t.TempDir(), "missing.yaml")})
+	require.Error(t, err)
+}
+
+func TestLanguages(t *testing.T) {
+	require.Equal(t, []string{"fr-ca", "fr", "en"}, msgcatalog.Languages("en;q=0.5, fr-CA, fr;q=0.8, *"))
+	require.Empty(t, msgcatalog.Languages(""))
+
+	r := httptest.NewRequest(http.MethodGet, "/messages?lang=pt", nil)
+	r.Header.Set("Accept-Language", "es")
+	require.Equal(t, []string{"pt", "es"}, msgcatalog.RequestLanguages(r))
+}
+
+func TestHandler(t *testing.T) {
+	c := msgcatalog.New("")
+	c.Merge(builtin)
+	c.Add("consent.profile:read", msgcatalog.Translations{"en": "See your profile"})
+
+	r := httptest.NewRequest(http.MethodGet, "/sonr/messages?prefix=faucet.", nil)
+	r.Header.Set("Accept-Language", "es, en;q=0.5")
+	w := httptest.NewRecorder()
+	msgcatalog.Handler(c).ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var res msgcatalog.BundleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.Equal(t, "es", res.Language)
+	require.Equal(t, map[string]string{
+		"faucet.cooldown": "ya recibió fondos recientemente",
+		"faucet.no_did":   "no DID controlled by {address}",
+	}, res.Messages)
+}
@@ -0,0 +1,99 @@
+package msgcatalog
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cast"
+	"sigs.k8s.io/yaml"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Config selects the copy a node serves. Built-in messages are used unless
+// an overrides file replaces them.
+type Config struct {
+	// DefaultLanguage is served when no requested language has a translation.
+	DefaultLanguage string `mapstructure:"default-language"`
+	// OverridesFile is a YAML or JSON file of message key to translations
+	// that replace or extend the built-in messages.
+	OverridesFile string `mapstructure:"overrides-file"`
+}
+
+// DefaultConfig returns the default configuration, which serves the
+// built-in messages in DefaultLanguage.
+func DefaultConfig() Config {
+	return Config{DefaultLanguage: DefaultLanguage}
+}
+
+// ConfigFromAppOptions reads the [messages] section of app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if lang := cast.ToString(appOpts.Get("messages.default-language")); lang != "" {
+		cfg.DefaultLanguage = lang
+	}
+	cfg.OverridesFile = cast.ToString(appOpts.Get("messages.overrides-file"))
+	return cfg
+}
+
+// Load builds a catalog from built-in messages and the overrides file
+func Load(cfg Config, builtin ...map[string]Translations) (*Catalog, error) {
+	c := New(cfg.DefaultLanguage)
+	for _, messages := range builtin {
+		c.Merge(messages)
+	}
+	if cfg.OverridesFile != "" {
+		overrides, err := LoadFile(cfg.OverridesFile)
+		if err != nil {
+			return nil, err
+		}
+		c.Merge(overrides)
+	}
+	return c, nil
+}
+
+// LoadFile reads a YAML or JSON file of message key to translations:
+//
+//	faucet.cooldown:
+//	  en: Already funded recently
+//	  es: Ya recibiste fondos recientemente
+func LoadFile(path string) (map[string]Translations, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read messages: %w", err)
+	}
+	var messages map[string]Translations
+	if err := yaml.Unmarshal(bz, &messages); err != nil {
+		return nil, fmt.Errorf("invalid messages file %s: %w", path, err)
+	}
+	for key, t := range messages {
+		if key == "" {
+			return nil, fmt.Errorf("invalid messages file %s: empty message key", path)
+		}
+		for lang := range t {
+			if lang == "" {
+				return nil, fmt.Errorf("invalid messages file %s: %s has an empty language tag", path, key)
+			}
+		}
+	}
+	return messages, nil
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                             Message Catalog                             ###
+###############################################################################
+
+# User-facing strings served by the node, such as consent text, are resolved
+# from message keys per locale. Deployments can reword or translate them
+# without changing code.
+[messages]
+
+# Language served when a request asks for none the catalog has.
+default-language = "{{ .Messages.DefaultLanguage }}"
+
+# YAML or JSON file of message key to translations that replace or extend
+# the built-in messages. GET /sonr/messages lists the keys in use.
+overrides-file = "{{ .Messages.OverridesFile }}"
+`
@@ -0,0 +1,37 @@
+package msgcatalog
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// BundleResponse is the body served by Handler
+type BundleResponse struct {
+	// Language is the most preferred language requested, or the fallback
+	Language string            `json:"language"`
+	Messages map[string]string `json:"messages"`
+}
+
+// Handler serves the catalog rendered for the languages of each request
+// (see RequestLanguages). The "prefix" query parameter limits the keys
+// returned.
+func Handler(c *Catalog) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		langs := RequestLanguages(r)
+		lang := c.Fallback()
+		if len(langs) > 0 {
+			lang = langs[0]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Vary", "Accept-Language")
+		_ = json.NewEncoder(w).Encode(BundleResponse{
+			Language: lang,
+			Messages: c.Bundle(r.URL.Query().Get("prefix"), langs...),
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package msgcatalog
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Languages returns the language tags of an Accept-Language header, most
+// preferred first.
+func Languages(header string) []string {
+	type tag struct {
+		lang string
+		q    float64
+	}
+	var tags []tag
+	for _, part := range strings.Split(header, ",") {
+		lang, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if lang == "" || lang == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		tags = append(tags, tag{lang: strings.ToLower(lang), q: q})
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	langs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		langs = append(langs, t.lang)
+	}
+	return langs
+}
+
+// RequestLanguages returns the languages a request asks for: the "lang"
+// query parameter, then the Accept-Language header
+func RequestLanguages(r *http.Request) []string {
+	langs := Languages(r.Header.Get("Accept-Language"))
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		langs = append([]string{strings.ToLower(lang)}, langs...)
+	}
+	return langs
+}
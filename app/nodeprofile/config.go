@@ -0,0 +1,56 @@
+package nodeprofile
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Config selects the profile a node runs with
+type Config struct {
+	// Profile is the name of the profile, or empty to use app.toml as written
+	Profile string `mapstructure:"profile"`
+}
+
+// DefaultConfig returns the default configuration, which uses no profile
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// ConfigFromAppOptions reads the [node] section of app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	return Config{Profile: cast.ToString(appOpts.Get("node.profile"))}
+}
+
+// Apply validates the configured profile against appOpts and returns appOpts
+// with it applied. Without a profile appOpts is returned unchanged.
+func Apply(cfg Config, appOpts servertypes.AppOptions) (servertypes.AppOptions, *Profile, error) {
+	p, ok, err := Lookup(cfg.Profile)
+	if err != nil || !ok {
+		return appOpts, nil, err
+	}
+	if err := p.Validate(appOpts); err != nil {
+		return appOpts, nil, err
+	}
+	return p.AppOptions(appOpts), &p, nil
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                              Node Profile                               ###
+###############################################################################
+
+[node]
+
+# Profile tunes storage and caching for the node's role, overriding the
+# settings above. Leave empty to use them as written.
+#
+# "api-node" serves queries for highway backends. It keeps the last 100 states
+# (pruning every 10 blocks) and about a week of blocks, enables the
+# inter-block cache with twice the default IAVL cache, and takes no state sync
+# snapshots. It is sized for 8 GiB of memory and 100 GiB of disk, and requires
+# at least one of api, grpc or json-rpc to be enabled. Module history held in
+# consensus state, such as dex price history, is retained as on every node.
+profile = "{{ .Node.Profile }}"
+`
@@ -0,0 +1,105 @@
+// Package nodeprofile tunes a node's local storage and caching for the role
+// it serves. Profiles only change node-local settings: consensus state, and
+// the history modules keep in it, is the same on every node.
+package nodeprofile
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// APINode is the profile of nodes that only serve queries to highway
+// backends.
+const APINode = "api-node"
+
+// Profile overrides app.toml settings read when the app starts
+type Profile struct {
+	// Name is the value of node.profile selecting the profile
+	Name string
+	// Settings are the app.toml keys the profile overrides
+	Settings map[string]any
+	// MemoryTarget and DiskTarget are the resources the profile is sized for
+	MemoryTarget string
+	DiskTarget   string
+}
+
+var profiles = map[string]Profile{
+	APINode: {
+		Name: APINode,
+		Settings: map[string]any{
+			// Keep the last 100 states for queries and prune every 10 blocks
+			server.FlagPruning:           "custom",
+			server.FlagPruningKeepRecent: "100",
+			server.FlagPruningInterval:   "10",
+			// Keep about a week of blocks for transaction queries
+			server.FlagMinRetainBlocks: uint64(100_000),
+			// Cache store reads across blocks, and twice the default IAVL
+			// nodes, for query load
+			server.FlagInterBlockCache:     true,
+			server.FlagIAVLCacheSize:       uint64(1_562_500),
+			server.FlagDisableIAVLFastNode: false,
+			// API nodes do not serve state sync
+			server.FlagStateSyncSnapshotInterval: uint64(0),
+		},
+		MemoryTarget: "8 GiB",
+		DiskTarget:   "100 GiB",
+	},
+}
+
+// Lookup returns the named profile. The empty name is the default of using
+// app.toml as written, and returns false.
+func Lookup(name string) (Profile, bool, error) {
+	if name == "" {
+		return Profile{}, false, nil
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return Profile{}, false, fmt.Errorf("unknown node profile %q, expected one of %v", name, Names())
+	}
+	return p, true, nil
+}
+
+// Names returns the names of the available profiles
+func Names() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that the rest of app.toml suits the profile
+func (p Profile) Validate(appOpts servertypes.AppOptions) error {
+	switch p.Name {
+	case APINode:
+		if !cast.ToBool(appOpts.Get("api.enable")) &&
+			!cast.ToBool(appOpts.Get("grpc.enable")) &&
+			!cast.ToBool(appOpts.Get("json-rpc.enable")) {
+			return fmt.Errorf("node profile %s serves queries but api, grpc and json-rpc are all disabled", p.Name)
+		}
+	}
+	return nil
+}
+
+// AppOptions returns appOpts with the profile's settings applied
+func (p Profile) AppOptions(appOpts servertypes.AppOptions) servertypes.AppOptions {
+	return profileOptions{AppOptions: appOpts, settings: p.Settings}
+}
+
+type profileOptions struct {
+	servertypes.AppOptions
+	settings map[string]any
+}
+
+func (o profileOptions) Get(key string) any {
+	if v, ok := o.settings[key]; ok {
+		return v
+	}
+	return o.AppOptions.Get(key)
+}
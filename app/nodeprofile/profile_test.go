@@ -0,0 +1,43 @@
+package nodeprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+type mapOptions map[string]any
+
+func (m mapOptions) Get(key string) any { return m[key] }
+
+func TestApply(t *testing.T) {
+	appOpts := mapOptions{
+		server.FlagPruning:      "nothing",
+		server.FlagMinGasPrices: "0stake",
+		"api.enable":            true,
+	}
+
+	// Without a profile app.toml is used as written
+	opts, p, err := Apply(Config{}, appOpts)
+	require.NoError(t, err)
+	require.Nil(t, p)
+	require.Equal(t, "nothing", opts.Get(server.FlagPruning))
+
+	_, _, err = Apply(Config{Profile: "archive"}, appOpts)
+	require.ErrorContains(t, err, "unknown node profile")
+
+	// The profile overrides its settings and leaves the rest
+	opts, p, err = Apply(Config{Profile: APINode}, appOpts)
+	require.NoError(t, err)
+	require.Equal(t, APINode, p.Name)
+	require.Equal(t, "custom", opts.Get(server.FlagPruning))
+	require.Equal(t, true, opts.Get(server.FlagInterBlockCache))
+	require.Equal(t, "0stake", opts.Get(server.FlagMinGasPrices))
+
+	// An API node must serve queries
+	appOpts["api.enable"] = false
+	_, _, err = Apply(Config{Profile: APINode}, appOpts)
+	require.ErrorContains(t, err, "all disabled")
+}
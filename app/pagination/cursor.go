@@ -0,0 +1,101 @@
+package pagination
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+)
+
+// cursorVersion is the first byte of every encoded cursor
+const cursorVersion = 1
+
+const flagReverse = 1 << 0
+
+// minCursorLen is version, flags, scope tag, a one byte limit and checksum
+const minCursorLen = 1 + 1 + 4 + 1 + 4
+
+// ErrInvalidCursor is returned for page keys that were not produced by this
+// package, were altered or belong to another query
+var ErrInvalidCursor = errors.New("invalid pagination key")
+
+// Cursor is the decoded form of a page key
+type Cursor struct {
+	// Key is the store key of the last item returned
+	Key     []byte
+	Limit   uint64
+	Reverse bool
+
+	scope uint32
+}
+
+// Encode returns the opaque page key for a cursor:
+//
+//	version | flags | scope tag (4) | uvarint limit | key | crc32 (4)
+func Encode(c Cursor) []byte {
+	bz := make([]byte, 0, minCursorLen+binary.MaxVarintLen64+len(c.Key))
+	bz = append(bz, cursorVersion)
+
+	var flags byte
+	if c.Reverse {
+		flags |= flagReverse
+	}
+	bz = append(bz, flags)
+	bz = binary.BigEndian.AppendUint32(bz, c.scope)
+	bz = binary.AppendUvarint(bz, c.Limit)
+	bz = append(bz, c.Key...)
+	return binary.BigEndian.AppendUint32(bz, crc32.ChecksumIEEE(bz))
+}
+
+// Decode parses a page key produced by Encode
+func Decode(bz []byte) (Cursor, error) {
+	if len(bz) < minCursorLen {
+		return Cursor{}, fmt.Errorf("%w: too short", ErrInvalidCursor)
+	}
+	body, sum := bz[:len(bz)-4], bz[len(bz)-4:]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(sum) {
+		return Cursor{}, fmt.Errorf("%w: checksum mismatch", ErrInvalidCursor)
+	}
+	if body[0] != cursorVersion {
+		return Cursor{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidCursor, body[0])
+	}
+	flags := body[1]
+	if flags&^flagReverse != 0 {
+		return Cursor{}, fmt.Errorf("%w: unknown flags %#x", ErrInvalidCursor, flags)
+	}
+
+	c := Cursor{
+		Reverse: flags&flagReverse != 0,
+		scope:   binary.BigEndian.Uint32(body[2:6]),
+	}
+	limit, n := binary.Uvarint(body[6:])
+	if n <= 0 || n != uvarintLen(limit) {
+		return Cursor{}, fmt.Errorf("%w: malformed limit", ErrInvalidCursor)
+	}
+	if limit == 0 || limit > MaxLimit {
+		return Cursor{}, fmt.Errorf("%w: limit %d out of range", ErrInvalidCursor, limit)
+	}
+	c.Limit = limit
+
+	key := body[6+n:]
+	if len(key) == 0 {
+		return Cursor{}, fmt.Errorf("%w: empty key", ErrInvalidCursor)
+	}
+	c.Key = append([]byte(nil), key...)
+	return c, nil
+}
+
+// scopeTag fingerprints the query a cursor was issued for
+func scopeTag(scope string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(scope))
+	return h.Sum32()
+}
+
+// uvarintLen returns the canonical encoded length of v, so overlong limit
+// encodings are rejected and every cursor has exactly one encoding
+func uvarintLen(v uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], v)
+}
@@ -0,0 +1,212 @@
+// Package pagination implements the paging used by every list query. Clients
+// pass the standard cosmos.base.query.v1beta1.PageRequest; the next_key they
+// get back is an opaque cursor that records where the page ended, the page
+// size and the sort direction, and is bound to the query that produced it so
+// it cannot be replayed against a different listing.
+//
+// Query servers resolve a request with Parse and then read a page with
+// CollectORM for ORM tables or Collect for anything else that can be iterated
+// in key order.
+package pagination
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// DefaultLimit is the page size used when a request does not set one
+	DefaultLimit = 100
+
+	// MaxLimit caps the page size a client may request
+	MaxLimit = 1000
+)
+
+// ErrInvalidRequest is returned for page requests that cannot be served
+var ErrInvalidRequest = errors.New("invalid page request")
+
+// Page is a resolved page request
+type Page struct {
+	// Key is the store key of the last item on the previous page, or nil for
+	// the first page
+	Key []byte
+	// Offset is the number of items to skip; it is only used without a Key
+	Offset     uint64
+	Limit      uint64
+	Reverse    bool
+	CountTotal bool
+
+	scope string
+}
+
+// Parse resolves a page request for the query identified by scope, typically
+// the RPC name plus any filters that change the result set. A nil request
+// yields the first page with the default limit. When a cursor is given, its
+// direction and page size apply unless the request sets a limit.
+func Parse(req *query.PageRequest, scope string) (Page, error) {
+	p := Page{Limit: DefaultLimit, scope: scope}
+	if req == nil {
+		return p, nil
+	}
+
+	p.Offset = req.Offset
+	p.Reverse = req.Reverse
+	p.CountTotal = req.CountTotal
+
+	if len(req.Key) > 0 {
+		if req.Offset > 0 {
+			return Page{}, fmt.Errorf("%w: key and offset cannot both be set", ErrInvalidRequest)
+		}
+		c, err := Decode(req.Key)
+		if err != nil {
+			return Page{}, err
+		}
+		if c.scope != scopeTag(scope) {
+			return Page{}, fmt.Errorf("%w: key belongs to a different query", ErrInvalidCursor)
+		}
+		p.Key = c.Key
+		p.Reverse = c.Reverse
+		p.Limit = c.Limit
+	}
+
+	if req.Limit > 0 {
+		p.Limit = req.Limit
+	}
+	p.Limit = min(p.Limit, MaxLimit)
+
+	return p, nil
+}
+
+// ORMOptions returns the list options that position an ORM iterator at the
+// start of the page
+func (p Page) ORMOptions() []ormlist.Option {
+	var opts []ormlist.Option
+	if len(p.Key) > 0 {
+		opts = append(opts, ormlist.Cursor(p.Key))
+	}
+	if p.Reverse {
+		opts = append(opts, ormlist.Reverse())
+	}
+	return opts
+}
+
+// Response returns the page response for a page that ended at lastKey. A nil
+// lastKey marks the final page.
+func (p Page) Response(lastKey []byte, total uint64) *query.PageResponse {
+	res := &query.PageResponse{}
+	if lastKey != nil {
+		res.NextKey = Encode(Cursor{
+			Key:     lastKey,
+			Limit:   p.Limit,
+			Reverse: p.Reverse,
+			scope:   scopeTag(p.scope),
+		})
+	}
+	if p.CountTotal {
+		res.Total = total
+	}
+	return res
+}
+
+// Next returns the next item of an iteration and its store key, or ok false
+// once the iteration is exhausted
+type Next[T any] func() (item T, key []byte, ok bool, err error)
+
+// Collect reads one page from an iteration that already starts after
+// p.Key. Items rejected by keep are skipped without counting towards the
+// offset, the limit or the total; a nil keep accepts every item. The
+// iteration is read past the page only when a total was requested.
+func Collect[T any](p Page, next Next[T], keep func(T) bool) ([]T, *query.PageResponse, error) {
+	var (
+		items   []T
+		lastKey []byte
+		more    bool
+		matched uint64
+	)
+	for {
+		item, key, ok, err := next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			break
+		}
+		if keep != nil && !keep(item) {
+			continue
+		}
+		matched++
+
+		switch {
+		case len(p.Key) == 0 && matched <= p.Offset:
+		case uint64(len(items)) < p.Limit:
+			items = append(items, item)
+			lastKey = key
+		default:
+			more = true
+		}
+		if more && !p.CountTotal {
+			break
+		}
+	}
+
+	if !more {
+		lastKey = nil
+	}
+	return items, p.Response(lastKey, matched), nil
+}
+
+// ORMIterator is implemented by the iterators of generated ORM tables
+type ORMIterator[T any] interface {
+	Next() bool
+	Value() (T, error)
+	Cursor() ormlist.CursorT
+}
+
+// CollectORM reads one page from an ORM iterator opened with p.ORMOptions()
+func CollectORM[T any](p Page, it ORMIterator[T], keep func(T) bool) ([]T, *query.PageResponse, error) {
+	return Collect(p, func() (T, []byte, bool, error) {
+		var zero T
+		if !it.Next() {
+			return zero, nil, false, nil
+		}
+		item, err := it.Value()
+		if err != nil {
+			return zero, nil, false, err
+		}
+		return item, it.Cursor(), true, nil
+	}, keep)
+}
+
+// Slice reads one page from items already held in memory, in order. Cursors
+// record the position in the slice, so the slice must be built the same way
+// for every page.
+func Slice[T any](p Page, items []T) ([]T, *query.PageResponse, error) {
+	start := 0
+	if len(p.Key) > 0 {
+		if len(p.Key) != 8 {
+			return nil, nil, fmt.Errorf("%w: malformed position", ErrInvalidCursor)
+		}
+		start = len(items)
+		if pos := binary.BigEndian.Uint64(p.Key); pos < uint64(len(items)) {
+			start = int(pos) + 1
+		}
+	}
+
+	i := start
+	return Collect(p, func() (T, []byte, bool, error) {
+		var zero T
+		if i >= len(items) {
+			return zero, nil, false, nil
+		}
+		idx := i
+		if p.Reverse {
+			idx = len(items) - 1 - i
+		}
+		i++
+		return items[idx], binary.BigEndian.AppendUint64(nil, uint64(i-1)), true, nil
+	}, nil)
+}
@@ -0,0 +1,155 @@
+package pagination_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/pagination"
+)
+
+func readAll(t *testing.T, items []int, req *query.PageRequest) [][]int {
+	t.Helper()
+	var pages [][]int
+	for {
+		p, err := pagination.Parse(req, "test/items")
+		require.NoError(t, err)
+		page, res, err := pagination.Slice(p, items)
+		require.NoError(t, err)
+		pages = append(pages, page)
+		if res.NextKey == nil {
+			return pages
+		}
+		req = &query.PageRequest{Key: res.NextKey}
+	}
+}
+
+func TestPages(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	pages := readAll(t, items, &query.PageRequest{Limit: 2})
+	require.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, pages)
+
+	// The cursor carries the direction and page size
+	pages = readAll(t, items, &query.PageRequest{Limit: 3, Reverse: true})
+	require.Equal(t, [][]int{{5, 4, 3}, {2, 1}}, pages)
+
+	// An exactly full last page has no next key
+	pages = readAll(t, items[:4], &query.PageRequest{Limit: 2})
+	require.Equal(t, [][]int{{1, 2}, {3, 4}}, pages)
+}
+
+func TestOffsetAndTotal(t *testing.T) {
+	p, err := pagination.Parse(&query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}, "test/items")
+	require.NoError(t, err)
+
+	page, res, err := pagination.Slice(p, []int{1, 2, 3, 4, 5})
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3}, page)
+	require.EqualValues(t, 5, res.Total)
+	require.NotNil(t, res.NextKey)
+}
+
+func TestCollectFilters(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+	i := 0
+	next := func() (int, []byte, bool, error) {
+		if i == len(items) {
+			return 0, nil, false, nil
+		}
+		i++
+		return items[i-1], []byte{byte(i)}, true, nil
+	}
+	even := func(v int) bool { return v%2 == 0 }
+
+	p, err := pagination.Parse(&query.PageRequest{Limit: 2, CountTotal: true}, "test/items")
+	require.NoError(t, err)
+	page, res, err := pagination.Collect(p, next, even)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 4}, page)
+	require.EqualValues(t, 3, res.Total)
+
+	c, err := pagination.Decode(res.NextKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte{4}, c.Key, "resumes after the last returned item")
+}
+
+func TestParseRejects(t *testing.T) {
+	p, err := pagination.Parse(&query.PageRequest{Limit: 2}, "test/items")
+	require.NoError(t, err)
+	_, res, err := pagination.Slice(p, []int{1, 2, 3})
+	require.NoError(t, err)
+
+	_, err = pagination.Parse(&query.PageRequest{Key: res.NextKey}, "test/other")
+	require.ErrorIs(t, err, pagination.ErrInvalidCursor)
+
+	_, err = pagination.Parse(&query.PageRequest{Key: res.NextKey, Offset: 1}, "test/items")
+	require.ErrorIs(t, err, pagination.ErrInvalidRequest)
+
+	tampered := bytes.Clone(res.NextKey)
+	tampered[len(tampered)-5] ^= 0xff
+	_, err = pagination.Parse(&query.PageRequest{Key: tampered}, "test/items")
+	require.ErrorIs(t, err, pagination.ErrInvalidCursor)
+
+	p, err = pagination.Parse(&query.PageRequest{Limit: 1 << 20}, "test/items")
+	require.NoError(t, err)
+	require.EqualValues(t, pagination.MaxLimit, p.Limit)
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte("not a cursor"))
+	f.Add(pagination.Encode(pagination.Cursor{Key: []byte("did:sonr:alice"), Limit: 100}))
+	f.Add(pagination.Encode(pagination.Cursor{Key: []byte{0, 0, 0, 1}, Limit: 1, Reverse: true}))
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		c, err := pagination.Decode(bz)
+		if err != nil {
+			require.ErrorIs(t, err, pagination.ErrInvalidCursor)
+			return
+		}
+		require.NotEmpty(t, c.Key)
+		require.True(t, c.Limit > 0 && c.Limit <= pagination.MaxLimit)
+		// Accepted cursors have exactly one encoding
+		require.Equal(t, bz, pagination.Encode(c))
+	})
+}
+
+func FuzzParseKey(f *testing.F) {
+	p, _ := pagination.Parse(&query.PageRequest{Limit: 2}, "test/items")
+	_, res, _ := pagination.Slice(p, []int{1, 2, 3})
+	f.Add(res.NextKey, uint64(0))
+	f.Add([]byte{1, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0}, uint64(5))
+
+	f.Fuzz(func(t *testing.T, key []byte, limit uint64) {
+		p, err := pagination.Parse(&query.PageRequest{Key: key, Limit: limit}, "test/items")
+		if err != nil {
+			return
+		}
+		require.True(t, p.Limit > 0 && p.Limit <= pagination.MaxLimit)
+
+		// Whatever position a valid cursor names, paging stays in bounds
+		page, _, err := pagination.Slice(p, []int{1, 2, 3})
+		if err == nil {
+			require.LessOrEqual(t, len(page), 3)
+		}
+	})
+}
+
+func FuzzCursorRoundTrip(f *testing.F) {
+	f.Add([]byte("key"), uint64(100), false)
+	f.Add([]byte{0xff}, uint64(pagination.MaxLimit), true)
+
+	f.Fuzz(func(t *testing.T, key []byte, limit uint64, reverse bool) {
+		c := pagination.Cursor{Key: key, Limit: limit, Reverse: reverse}
+		decoded, err := pagination.Decode(pagination.Encode(c))
+		if len(key) == 0 || limit == 0 || limit > pagination.MaxLimit {
+			require.ErrorIs(t, err, pagination.ErrInvalidCursor)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, c, decoded)
+	})
+}
@@ -0,0 +1,351 @@
+// Package randomness provides a deterministic, consensus-safe randomness beacon
+// for on-chain features such as domain auctions, human-verification challenges
+// and lottery-style selection.
+//
+// Keepers must never use math/rand or crypto/rand in state transitions: the
+// former is predictable and the latter diverges between validators. The beacon
+// instead derives entropy from block data so every validator computes the same
+// output.
+//
+// The output is not secret and not unbiasable. Every input is public once the
+// block is committed, and the proposer chooses the header and block time, so it
+// can grind proposals towards a preferred outcome. Values that must be
+// unpredictable to third parties, such as WebAuthn challenges, have to mix in a
+// nonce supplied by the client via Challenge.
+//
+// Outcomes worth grinding for, auction tie-breaks and lottery draws, use
+// commit-reveal instead: participants publish Commit(domain, participant,
+// secret) in one block and reveal the secret in a later one, and the outcome
+// is drawn from RevealSeed over every verified reveal. The draw depends only
+// on the reveals, the domain and the round identifiers, never on the header,
+// height or block time, so the proposer of the block carrying the reveals has
+// nothing left to grind. A participant can still withhold its reveal, and a
+// proposer can leave reveals out of its block, so the consumer must fix which
+// reveals count when the round closes and penalize the missing ones, e.g. by
+// forfeiting a deposit. Seed refuses these domains.
+package randomness
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Domain separation tags for the built-in consumers of the beacon
+const (
+	DomainAuction            = "sonr/auction"
+	DomainHumanVerification  = "sonr/human-verification"
+	DomainLottery            = "sonr/lottery"
+	DomainDomainVerification = "sonr/domain-verification"
+	DomainWebAuthnChallenge  = "sonr/webauthn-challenge"
+	DomainEncryptionNonce    = "sonr/encryption-nonce"
+	DomainVaultKeygen        = "sonr/vault-keygen"
+)
+
+// SeedSize is the size in bytes of a beacon seed
+const SeedSize = sha256.Size
+
+// MinNonceSize is the minimum size of a client nonce accepted by Challenge
+const MinNonceSize = 16
+
+var (
+	// ErrEmptyDomain is returned when a seed is requested without a domain tag
+	ErrEmptyDomain = errors.New("randomness domain cannot be empty")
+
+	// ErrNonceTooShort is returned when a challenge is requested with a short client nonce
+	ErrNonceTooShort = errors.New("client nonce too short")
+
+	// ErrInvalidBound is returned when a bounded draw is requested with a zero bound
+	ErrInvalidBound = errors.New("random bound must be greater than zero")
+
+	// ErrRevealsRequired is returned when a seed for a commit-reveal domain is
+	// requested from block data alone
+	ErrRevealsRequired = errors.New("domain requires commit-reveal")
+
+	// ErrInvalidReveal is returned when a revealed secret does not open its
+	// commitment, or a set of reveals cannot be combined
+	ErrInvalidReveal = errors.New("invalid reveal")
+)
+
+// commitRevealDomains are drawn only from revealed secrets, as the proposer
+// could otherwise grind their outcome
+var commitRevealDomains = map[string]bool{
+	DomainAuction: true,
+	DomainLottery: true,
+}
+
+// Seed is a 32-byte beacon output
+type Seed [SeedSize]byte
+
+// String returns the hex encoding of the seed
+func (s Seed) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// Beacon derives per-block randomness from consensus data
+type Beacon struct {
+	chainID    string
+	height     int64
+	headerHash []byte
+	appHash    []byte
+	unixNano   int64
+}
+
+// NewBeacon creates a beacon bound to the block being executed
+func NewBeacon(ctx sdk.Context) *Beacon {
+	header := ctx.BlockHeader()
+	return &Beacon{
+		chainID:    ctx.ChainID(),
+		height:     ctx.BlockHeight(),
+		headerHash: ctx.HeaderHash(),
+		appHash:    header.AppHash,
+		unixNano:   ctx.BlockTime().UnixNano(),
+	}
+}
+
+// Seed derives a seed for the given domain, mixing in optional caller data
+// (for example a DID or a challenge ID). Commit-reveal domains are refused;
+// use RevealSeed.
+func (b *Beacon) Seed(domain string, extra ...[]byte) (Seed, error) {
+	if commitRevealDomains[domain] {
+		return Seed{}, fmt.Errorf("%w: %s", ErrRevealsRequired, domain)
+	}
+	return b.seed(domain, extra...)
+}
+
+// seed derives a seed for any domain
+func (b *Beacon) seed(domain string, extra ...[]byte) (Seed, error) {
+	if domain == "" {
+		return Seed{}, ErrEmptyDomain
+	}
+
+	h := sha256.New()
+	writeField(h, []byte(domain))
+	writeField(h, []byte(b.chainID))
+	writeField(h, binary.BigEndian.AppendUint64(nil, uint64(b.height)))
+	writeField(h, b.headerHash)
+	writeField(h, b.appHash)
+	writeField(h, binary.BigEndian.AppendUint64(nil, uint64(b.unixNano)))
+	for _, e := range extra {
+		writeField(h, e)
+	}
+
+	var seed Seed
+	copy(seed[:], h.Sum(nil))
+	return seed, nil
+}
+
+// Stream returns a deterministic byte stream for the given domain
+func (b *Beacon) Stream(domain string, extra ...[]byte) (*Stream, error) {
+	seed, err := b.Seed(domain, extra...)
+	if err != nil {
+		return nil, err
+	}
+	return NewStream(seed), nil
+}
+
+// RevealSeed derives a seed from the verified reveals of a commit-reveal
+// round and optional round identifiers such as the auction ID. Every reveal
+// must have been checked with VerifyReveal. Block data is left out, as the
+// proposer sees the reveals while it chooses it.
+func (b *Beacon) RevealSeed(domain string, reveals []Reveal, extra ...[]byte) (Seed, error) {
+	if domain == "" {
+		return Seed{}, ErrEmptyDomain
+	}
+	combined, err := CombineReveals(reveals)
+	if err != nil {
+		return Seed{}, err
+	}
+
+	h := sha256.New()
+	writeField(h, []byte("sonr/reveal"))
+	writeField(h, []byte(domain))
+	writeField(h, []byte(b.chainID))
+	writeField(h, combined[:])
+	for _, e := range extra {
+		writeField(h, e)
+	}
+
+	var seed Seed
+	copy(seed[:], h.Sum(nil))
+	return seed, nil
+}
+
+// RevealStream returns a deterministic byte stream from the reveals of a
+// commit-reveal round
+func (b *Beacon) RevealStream(domain string, reveals []Reveal, extra ...[]byte) (*Stream, error) {
+	seed, err := b.RevealSeed(domain, reveals, extra...)
+	if err != nil {
+		return nil, err
+	}
+	return NewStream(seed), nil
+}
+
+// Challenge derives a seed that is bound to the block and unpredictable to
+// anyone who does not know the client nonce. The nonce must be at least
+// MinNonceSize bytes of client-side randomness.
+func (b *Beacon) Challenge(domain string, nonce []byte, extra ...[]byte) (Seed, error) {
+	if len(nonce) < MinNonceSize {
+		return Seed{}, fmt.Errorf("%w: got %d bytes, need %d", ErrNonceTooShort, len(nonce), MinNonceSize)
+	}
+	return b.Seed(domain, append([][]byte{nonce}, extra...)...)
+}
+
+// Reveal is a participant's secret, opened after its commitment was recorded
+type Reveal struct {
+	Participant string
+	Secret      []byte
+}
+
+// Commit returns the commitment a participant publishes before revealing
+// secret. The secret must be at least MinNonceSize bytes of client-side
+// randomness; binding the domain and participant keeps a commitment from
+// being replayed by someone else or in another round type.
+func Commit(domain, participant string, secret []byte) (Seed, error) {
+	if domain == "" {
+		return Seed{}, ErrEmptyDomain
+	}
+	if len(secret) < MinNonceSize {
+		return Seed{}, fmt.Errorf("%w: got %d bytes, need %d", ErrNonceTooShort, len(secret), MinNonceSize)
+	}
+
+	h := sha256.New()
+	writeField(h, []byte("sonr/commit"))
+	writeField(h, []byte(domain))
+	writeField(h, []byte(participant))
+	writeField(h, secret)
+
+	var commitment Seed
+	copy(commitment[:], h.Sum(nil))
+	return commitment, nil
+}
+
+// VerifyReveal checks that a reveal opens the commitment its participant
+// published
+func VerifyReveal(domain string, commitment Seed, reveal Reveal) error {
+	expected, err := Commit(domain, reveal.Participant, reveal.Secret)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidReveal, err)
+	}
+	if subtle.ConstantTimeCompare(expected[:], commitment[:]) != 1 {
+		return fmt.Errorf("%w: secret of %s does not match its commitment", ErrInvalidReveal, reveal.Participant)
+	}
+	return nil
+}
+
+// CombineReveals hashes the reveals of a round into one value. The result
+// does not depend on the order the reveals arrived in, and changes if any
+// secret does. Each participant may reveal once.
+func CombineReveals(reveals []Reveal) (Seed, error) {
+	if len(reveals) == 0 {
+		return Seed{}, fmt.Errorf("%w: no reveals", ErrInvalidReveal)
+	}
+
+	sorted := make([]Reveal, len(reveals))
+	copy(sorted, reveals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Participant < sorted[j].Participant
+	})
+
+	h := sha256.New()
+	for i, r := range sorted {
+		if i > 0 && sorted[i-1].Participant == r.Participant {
+			return Seed{}, fmt.Errorf("%w: %s revealed twice", ErrInvalidReveal, r.Participant)
+		}
+		writeField(h, []byte(r.Participant))
+		writeField(h, r.Secret)
+	}
+
+	var combined Seed
+	copy(combined[:], h.Sum(nil))
+	return combined, nil
+}
+
+// writeField writes a length-prefixed field so adjacent fields cannot collide
+func writeField(h interface{ Write([]byte) (int, error) }, field []byte) {
+	_, _ = h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(field))))
+	_, _ = h.Write(field)
+}
+
+// Stream expands a seed into an arbitrarily long deterministic byte sequence
+// using SHA-256 in counter mode.
+type Stream struct {
+	seed    Seed
+	counter uint64
+	buf     []byte
+}
+
+// NewStream creates a stream from a seed
+func NewStream(seed Seed) *Stream {
+	return &Stream{seed: seed}
+}
+
+// Read fills p with deterministic pseudo-random bytes. It never returns an error.
+func (s *Stream) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(s.buf) == 0 {
+			block := sha256.Sum256(append(s.seed[:], binary.BigEndian.AppendUint64(nil, s.counter)...))
+			s.counter++
+			s.buf = block[:]
+		}
+		c := copy(p[n:], s.buf)
+		s.buf = s.buf[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// Uint64 returns the next uint64 from the stream
+func (s *Stream) Uint64() uint64 {
+	var b [8]byte
+	_, _ = s.Read(b[:])
+	return binary.BigEndian.Uint64(b[:])
+}
+
+// Uint64n returns a uniformly distributed value in [0, n) without modulo bias
+func (s *Stream) Uint64n(n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+
+	// Reject values from the incomplete final bucket
+	limit := ^uint64(0) - (^uint64(0) % n)
+	for {
+		v := s.Uint64()
+		if v < limit {
+			return v % n, nil
+		}
+	}
+}
+
+// Digits returns a string of n decimal digits, e.g. for human-verification codes
+func (s *Stream) Digits(n int) (string, error) {
+	out := make([]byte, n)
+	for i := range out {
+		d, err := s.Uint64n(10)
+		if err != nil {
+			return "", err
+		}
+		out[i] = byte('0' + d)
+	}
+	return string(out), nil
+}
+
+// Shuffle performs a deterministic Fisher-Yates shuffle over n elements
+func (s *Stream) Shuffle(n int, swap func(i, j int)) error {
+	for i := n - 1; i > 0; i-- {
+		j, err := s.Uint64n(uint64(i + 1))
+		if err != nil {
+			return err
+		}
+		swap(i, int(j))
+	}
+	return nil
+}
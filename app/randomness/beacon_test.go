@@ -0,0 +1,148 @@
+package randomness_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/app/randomness"
+)
+
+func testContext(height int64, headerHash []byte) sdk.Context {
+	return sdk.Context{}.
+		WithChainID("sonr-testnet-1").
+		WithBlockHeight(height).
+		WithBlockTime(time.Unix(1700000000, 0)).
+		WithHeaderHash(headerHash)
+}
+
+func TestBeaconSeedDeterministic(t *testing.T) {
+	ctx := testContext(10, []byte("header-a"))
+
+	seed1, err := randomness.NewBeacon(ctx).Seed(randomness.DomainDomainVerification, []byte("example.com"))
+	require.NoError(t, err)
+	seed2, err := randomness.NewBeacon(ctx).Seed(randomness.DomainDomainVerification, []byte("example.com"))
+	require.NoError(t, err)
+	require.Equal(t, seed1, seed2)
+
+	// Different domain, extra data or block data must change the output
+	other, err := randomness.NewBeacon(ctx).Seed(randomness.DomainHumanVerification, []byte("example.com"))
+	require.NoError(t, err)
+	require.NotEqual(t, seed1, other)
+
+	other, err = randomness.NewBeacon(ctx).Seed(randomness.DomainDomainVerification, []byte("example.org"))
+	require.NoError(t, err)
+	require.NotEqual(t, seed1, other)
+
+	other, err = randomness.NewBeacon(testContext(10, []byte("header-b"))).
+		Seed(randomness.DomainDomainVerification, []byte("example.com"))
+	require.NoError(t, err)
+	require.NotEqual(t, seed1, other)
+
+	_, err = randomness.NewBeacon(ctx).Seed("")
+	require.ErrorIs(t, err, randomness.ErrEmptyDomain)
+}
+
+func TestStreamBoundedDraws(t *testing.T) {
+	stream, err := randomness.NewBeacon(testContext(1, []byte("h"))).Stream(randomness.DomainHumanVerification)
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		v, err := stream.Uint64n(7)
+		require.NoError(t, err)
+		require.Less(t, v, uint64(7))
+	}
+
+	_, err = stream.Uint64n(0)
+	require.ErrorIs(t, err, randomness.ErrInvalidBound)
+
+	code, err := stream.Digits(6)
+	require.NoError(t, err)
+	require.Len(t, code, 6)
+}
+
+func TestChallengeRequiresNonce(t *testing.T) {
+	beacon := randomness.NewBeacon(testContext(5, []byte("h")))
+
+	_, err := beacon.Challenge(randomness.DomainWebAuthnChallenge, []byte("short"))
+	require.ErrorIs(t, err, randomness.ErrNonceTooShort)
+
+	nonceA := []byte("0123456789abcdef")
+	nonceB := []byte("fedcba9876543210")
+
+	a, err := beacon.Challenge(randomness.DomainWebAuthnChallenge, nonceA, []byte("alice"))
+	require.NoError(t, err)
+	b, err := beacon.Challenge(randomness.DomainWebAuthnChallenge, nonceB, []byte("alice"))
+	require.NoError(t, err)
+	require.NotEqual(t, a, b)
+
+	// The nonce is what makes the challenge unpredictable, so it must not be
+	// interchangeable with caller data.
+	plain, err := beacon.Seed(randomness.DomainWebAuthnChallenge, []byte("alice"))
+	require.NoError(t, err)
+	require.NotEqual(t, a, plain)
+}
+
+func TestCommitReveal(t *testing.T) {
+	beacon := randomness.NewBeacon(testContext(20, []byte("h")))
+
+	// Auctions and lotteries cannot be drawn from block data alone
+	_, err := beacon.Seed(randomness.DomainAuction, []byte("auction-1"))
+	require.ErrorIs(t, err, randomness.ErrRevealsRequired)
+
+	alice := randomness.Reveal{Participant: "did:sonr:alice", Secret: []byte("0123456789abcdef")}
+	bob := randomness.Reveal{Participant: "did:sonr:bob", Secret: []byte("fedcba9876543210")}
+
+	_, err = randomness.Commit(randomness.DomainAuction, alice.Participant, []byte("short"))
+	require.ErrorIs(t, err, randomness.ErrNonceTooShort)
+
+	commitment, err := randomness.Commit(randomness.DomainAuction, alice.Participant, alice.Secret)
+	require.NoError(t, err)
+	require.NoError(t, randomness.VerifyReveal(randomness.DomainAuction, commitment, alice))
+
+	// A commitment opens only for its participant, secret and domain
+	err = randomness.VerifyReveal(randomness.DomainAuction, commitment, randomness.Reveal{Participant: bob.Participant, Secret: alice.Secret})
+	require.ErrorIs(t, err, randomness.ErrInvalidReveal)
+	err = randomness.VerifyReveal(randomness.DomainAuction, commitment, randomness.Reveal{Participant: alice.Participant, Secret: bob.Secret})
+	require.ErrorIs(t, err, randomness.ErrInvalidReveal)
+	err = randomness.VerifyReveal(randomness.DomainLottery, commitment, alice)
+	require.ErrorIs(t, err, randomness.ErrInvalidReveal)
+
+	// The draw does not depend on the order reveals arrived in
+	a, err := beacon.RevealSeed(randomness.DomainAuction, []randomness.Reveal{alice, bob}, []byte("auction-1"))
+	require.NoError(t, err)
+	b, err := beacon.RevealSeed(randomness.DomainAuction, []randomness.Reveal{bob, alice}, []byte("auction-1"))
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	c, err := beacon.RevealSeed(randomness.DomainAuction, []randomness.Reveal{alice}, []byte("auction-1"))
+	require.NoError(t, err)
+	require.NotEqual(t, a, c)
+
+	// The proposer of the reveal block cannot move the draw by choosing the
+	// header, height or block time
+	ground := sdk.Context{}.
+		WithChainID("sonr-testnet-1").
+		WithBlockHeader(cmtproto.Header{AppHash: []byte("other-app-hash")}).
+		WithBlockHeight(21).
+		WithBlockTime(time.Unix(1700000999, 0)).
+		WithHeaderHash([]byte("other-header"))
+	d, err := randomness.NewBeacon(ground).
+		RevealSeed(randomness.DomainAuction, []randomness.Reveal{alice, bob}, []byte("auction-1"))
+	require.NoError(t, err)
+	require.Equal(t, a, d)
+
+	// but a different round draws differently
+	e, err := beacon.RevealSeed(randomness.DomainAuction, []randomness.Reveal{alice, bob}, []byte("auction-2"))
+	require.NoError(t, err)
+	require.NotEqual(t, a, e)
+
+	_, err = beacon.RevealSeed(randomness.DomainLottery, nil)
+	require.ErrorIs(t, err, randomness.ErrInvalidReveal)
+	_, err = beacon.RevealSeed(randomness.DomainLottery, []randomness.Reveal{alice, alice})
+	require.ErrorIs(t, err, randomness.ErrInvalidReveal)
+}
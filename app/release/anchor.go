@@ -0,0 +1,63 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Anchor finds the on-chain upgrade plan that shipped a release
+type Anchor interface {
+	// PlanInfo returns the info field of the passed upgrade plan with the
+	// given name, or found false if no such plan exists
+	PlanInfo(ctx context.Context, name string) (info string, found bool, err error)
+}
+
+// PlanInfo is the part of an upgrade plan's info JSON that pins release
+// artifacts. Binaries follows the cosmovisor convention of platform
+// ("linux/amd64" or "any") to download URL carrying a
+// "checksum=sha256:<hex>" query parameter.
+type PlanInfo struct {
+	Binaries map[string]string `json:"binaries"`
+	// ReleaseManifestSHA256 is the digest of the signed checksums manifest
+	ReleaseManifestSHA256 string `json:"release_manifest_sha256,omitempty"`
+}
+
+// ParsePlanInfo decodes an upgrade plan's info field. Plans without JSON
+// info pin nothing.
+func ParsePlanInfo(raw string) (PlanInfo, error) {
+	var info PlanInfo
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, "{") {
+		return info, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &info); err != nil {
+		return info, fmt.Errorf("invalid upgrade plan info: %w", err)
+	}
+	return info, nil
+}
+
+// Binary returns the artifact file name and SHA-256 digest the plan pins for
+// a platform
+func (p PlanInfo) Binary(goos, goarch string) (name, digest string, ok bool, err error) {
+	raw, found := p.Binaries[goos+"/"+goarch]
+	if !found {
+		raw, found = p.Binaries["any"]
+	}
+	if !found {
+		return "", "", false, nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", "", false, fmt.Errorf("invalid binary URL %q: %w", raw, err)
+	}
+	algo, digest, _ := strings.Cut(u.Query().Get("checksum"), ":")
+	if algo != "sha256" || digest == "" {
+		return "", "", false, fmt.Errorf("binary URL %q has no sha256 checksum", raw)
+	}
+	return path.Base(u.Path), strings.ToLower(digest), true, nil
+}
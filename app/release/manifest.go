@@ -0,0 +1,97 @@
+// Package release verifies that an snrd binary is an unmodified release
+// build. Releases publish a checksums manifest signed with the release cosign
+// key, and software upgrade plans anchor the release artifacts on chain, so a
+// validator can check a binary against both before it signs blocks or joins
+// MPC sessions.
+package release
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// DefaultManifestURL is where releases publish their checksums manifest.
+	// "{tag}" is replaced with the release tag.
+	DefaultManifestURL = "https://github.com/sonr-io/sonr/releases/download/{tag}/snrd_checksums.txt"
+
+	// SignatureSuffix is appended to the manifest URL to locate its cosign
+	// signature
+	SignatureSuffix = ".sig"
+)
+
+// ArtifactName is the manifest entry of the bare snrd binary for a platform,
+// e.g. snrd_linux_amd64
+func ArtifactName(goos, goarch string) string {
+	return fmt.Sprintf("snrd_%s_%s", goos, goarch)
+}
+
+// Tag returns the release tag of a version, which is also the name of the
+// upgrade plan that ships it
+func Tag(version string) string {
+	if version == "" || strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+// ManifestURL fills the release tag into a manifest URL template
+func ManifestURL(template, tag string) string {
+	return strings.ReplaceAll(template, "{tag}", tag)
+}
+
+// Checksums maps artifact names to their hex encoded SHA-256 digests
+type Checksums map[string]string
+
+// ParseChecksums reads a manifest in sha256sum format, one
+// "<hex digest>  <name>" line per artifact
+func ParseChecksums(bz []byte) (Checksums, error) {
+	sums := make(Checksums)
+	scanner := bufio.NewScanner(bytes.NewReader(bz))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid manifest line %d", line)
+		}
+		digest, name := strings.ToLower(fields[0]), strings.TrimPrefix(fields[1], "*")
+		if bz, err := hex.DecodeString(digest); err != nil || len(bz) != sha256.Size {
+			return nil, fmt.Errorf("invalid manifest line %d: bad digest for %s", line, name)
+		}
+		if _, dup := sums[name]; dup {
+			return nil, fmt.Errorf("invalid manifest line %d: duplicate entry for %s", line, name)
+		}
+		sums[name] = digest
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(sums) == 0 {
+		return nil, fmt.Errorf("manifest lists no artifacts")
+	}
+	return sums, nil
+}
+
+// HashFile returns the hex encoded SHA-256 digest of a file
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
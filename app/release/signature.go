@@ -0,0 +1,55 @@
+package release
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+)
+
+// ParsePublicKey decodes a PEM encoded release public key, as written by
+// `cosign generate-key-pair`. ECDSA and Ed25519 keys are supported.
+func ParsePublicKey(bz []byte) (any, error) {
+	block, _ := pem.Decode(bz)
+	if block == nil {
+		return nil, fmt.Errorf("release key is not PEM encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release key: %w", err)
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported release key type %T", key)
+	}
+}
+
+// VerifyBlob checks a `cosign sign-blob` signature over blob. The signature
+// is the base64 text cosign writes with --output-signature.
+func VerifyBlob(key any, blob, signature []byte) error {
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil {
+		return fmt.Errorf("signature is not base64: %w", err)
+	}
+
+	var ok bool
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(blob)
+		ok = ecdsa.VerifyASN1(k, digest[:], sig)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(k, blob, sig)
+	default:
+		return fmt.Errorf("unsupported release key type %T", key)
+	}
+	if !ok {
+		return fmt.Errorf("signature does not match the release key")
+	}
+	return nil
+}
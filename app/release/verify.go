@@ -0,0 +1,307 @@
+package release
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// maxDownloadSize bounds the manifest, signature and key downloads
+const maxDownloadSize = 1 << 20
+
+// Checks performed by Verify
+const (
+	CheckSignature = "signature"
+	CheckManifest  = "manifest"
+	CheckAnchor    = "anchor"
+)
+
+// Status is the outcome of a single check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+	// StatusSkip means the check could not be made, e.g. because no upgrade
+	// plan shipped the release. It does not fail verification.
+	StatusSkip Status = "skip"
+)
+
+// Options configures a verification
+type Options struct {
+	// Binary is the path of the binary to verify
+	Binary string
+	// Tag is the release the binary claims to be, e.g. v0.16.0
+	Tag string
+	// ManifestURL locates the checksums manifest; "{tag}" is replaced with
+	// Tag. Local paths are read from disk.
+	ManifestURL string
+	// SignatureURL locates the manifest signature, ManifestURL with
+	// SignatureSuffix when empty
+	SignatureURL string
+	// PublicKey is the PEM encoded release key
+	PublicKey []byte
+	// Anchor looks up the upgrade plan of the release; nil skips the check
+	Anchor Anchor
+	// RequireAnchor fails verification when no upgrade plan pins the binary
+	RequireAnchor bool
+	// GOOS and GOARCH select the platform, the running one when empty
+	GOOS, GOARCH string
+	// HTTPClient fetches remote files, a client with a 30s timeout when nil
+	HTTPClient *http.Client
+}
+
+// Check is the outcome of one verification step
+type Check struct {
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// Report is the result of verifying a binary
+type Report struct {
+	Binary   string  `json:"binary"`
+	SHA256   string  `json:"sha256"`
+	Tag      string  `json:"tag"`
+	Platform string  `json:"platform"`
+	Manifest string  `json:"manifest"`
+	Checks   []Check `json:"checks"`
+}
+
+// OK reports whether no check failed
+func (r *Report) OK() bool {
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Report) add(name string, status Status, format string, args ...any) {
+	r.Checks = append(r.Checks, Check{Name: name, Status: status, Detail: fmt.Sprintf(format, args...)})
+}
+
+// WriteText writes the binary digest followed by one line per check
+func (r *Report) WriteText(w io.Writer) error {
+	result := "VERIFIED"
+	if !r.OK() {
+		result = "FAILED"
+	}
+	if _, err := fmt.Fprintf(w, "Binary verification %s\n\n", result); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Binary:\t%s\n", r.Binary)
+	fmt.Fprintf(tw, "SHA-256:\t%s\n", r.SHA256)
+	fmt.Fprintf(tw, "Release:\t%s (%s)\n", r.Tag, r.Platform)
+	fmt.Fprintf(tw, "Manifest:\t%s\n", r.Manifest)
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tDETAIL")
+	for _, c := range r.Checks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", c.Name, c.Status, c.Detail)
+	}
+	return tw.Flush()
+}
+
+// Verify checks a binary against the signed release manifest and the
+// upgrade plan that shipped it. Failed checks are recorded in the report;
+// an error means verification could not run at all.
+func Verify(ctx context.Context, opts Options) (*Report, error) {
+	if opts.Tag == "" {
+		return nil, fmt.Errorf("release tag is required")
+	}
+	if len(opts.PublicKey) == 0 {
+		return nil, fmt.Errorf("release key is required")
+	}
+	key, err := ParsePublicKey(opts.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if opts.GOOS == "" {
+		opts.GOOS = runtime.GOOS
+	}
+	if opts.GOARCH == "" {
+		opts.GOARCH = runtime.GOARCH
+	}
+	if opts.ManifestURL == "" {
+		opts.ManifestURL = DefaultManifestURL
+	}
+	manifestURL := ManifestURL(opts.ManifestURL, opts.Tag)
+	signatureURL := opts.SignatureURL
+	if signatureURL == "" {
+		signatureURL = manifestURL + SignatureSuffix
+	}
+
+	digest, err := HashFile(opts.Binary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash binary: %w", err)
+	}
+	report := &Report{
+		Binary:   opts.Binary,
+		SHA256:   digest,
+		Tag:      opts.Tag,
+		Platform: opts.GOOS + "/" + opts.GOARCH,
+		Manifest: manifestURL,
+	}
+
+	manifest, sums := verifyManifest(ctx, opts, report, key, manifestURL, signatureURL)
+	verifyAnchor(ctx, opts, report, manifest, sums)
+	return report, nil
+}
+
+// verifyManifest records the signature and manifest checks and returns the
+// manifest when its signature is valid
+func verifyManifest(
+	ctx context.Context,
+	opts Options,
+	report *Report,
+	key any,
+	manifestURL, signatureURL string,
+) ([]byte, Checksums) {
+	manifest, err := Fetch(ctx, opts.HTTPClient, manifestURL)
+	if err != nil {
+		report.add(CheckSignature, StatusFail, "%v", err)
+		report.add(CheckManifest, StatusFail, "manifest unavailable")
+		return nil, nil
+	}
+	signature, err := Fetch(ctx, opts.HTTPClient, signatureURL)
+	if err == nil {
+		err = VerifyBlob(key, manifest, signature)
+	}
+	if err != nil {
+		report.add(CheckSignature, StatusFail, "%v", err)
+		report.add(CheckManifest, StatusFail, "manifest is not signed by the release key")
+		return nil, nil
+	}
+	report.add(CheckSignature, StatusPass, "manifest signed by the release key")
+
+	sums, err := ParseChecksums(manifest)
+	if err != nil {
+		report.add(CheckManifest, StatusFail, "%v", err)
+		return manifest, nil
+	}
+	name := ArtifactName(opts.GOOS, opts.GOARCH)
+	switch want, ok := sums[name]; {
+	case !ok:
+		report.add(CheckManifest, StatusFail, "manifest has no entry for %s", name)
+	case want != report.SHA256:
+		report.add(CheckManifest, StatusFail, "binary does not match %s (%s)", name, want)
+	default:
+		report.add(CheckManifest, StatusPass, "binary matches %s", name)
+	}
+	return manifest, sums
+}
+
+// verifyAnchor records the anchor check. The plan may pin the manifest
+// digest, the bare binary or an archive listed in the manifest.
+func verifyAnchor(ctx context.Context, opts Options, report *Report, manifest []byte, sums Checksums) {
+	skip := func(format string, args ...any) {
+		status := StatusSkip
+		if opts.RequireAnchor {
+			status = StatusFail
+		}
+		report.add(CheckAnchor, status, format, args...)
+	}
+
+	if opts.Anchor == nil {
+		skip("chain not queried")
+		return
+	}
+	raw, found, err := opts.Anchor.PlanInfo(ctx, opts.Tag)
+	if err != nil {
+		report.add(CheckAnchor, StatusFail, "failed to query upgrade plan: %v", err)
+		return
+	}
+	if !found {
+		skip("no passed upgrade plan named %s", opts.Tag)
+		return
+	}
+	info, err := ParsePlanInfo(raw)
+	if err != nil {
+		report.add(CheckAnchor, StatusFail, "%v", err)
+		return
+	}
+
+	var pinned []string
+	if want := strings.ToLower(info.ReleaseManifestSHA256); want != "" {
+		if manifest == nil {
+			report.add(CheckAnchor, StatusFail, "plan pins the manifest but it could not be verified")
+			return
+		}
+		got := sha256.Sum256(manifest)
+		if hex.EncodeToString(got[:]) != want {
+			report.add(CheckAnchor, StatusFail, "manifest does not match the digest in plan %s", opts.Tag)
+			return
+		}
+		pinned = append(pinned, "manifest")
+	}
+
+	name, want, ok, err := info.Binary(opts.GOOS, opts.GOARCH)
+	if err != nil {
+		report.add(CheckAnchor, StatusFail, "%v", err)
+		return
+	}
+	if ok {
+		switch {
+		case want == report.SHA256:
+			pinned = append(pinned, "binary")
+		case sums != nil && sums[name] == want:
+			pinned = append(pinned, name)
+		default:
+			report.add(CheckAnchor, StatusFail, "plan %s pins %s (%s), which matches neither the binary nor the manifest", opts.Tag, name, want)
+			return
+		}
+	}
+
+	if len(pinned) == 0 {
+		skip("plan %s pins no artifact for %s", opts.Tag, report.Platform)
+		return
+	}
+	report.add(CheckAnchor, StatusPass, "plan %s pins the %s", opts.Tag, strings.Join(pinned, " and "))
+}
+
+// Fetch reads a file from an http(s) URL or a local path
+func Fetch(ctx context.Context, client *http.Client, location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		bz, err := os.ReadFile(strings.TrimPrefix(location, "file://"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", location, err)
+		}
+		return bz, nil
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", location, resp.Status)
+	}
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+	if len(bz) > maxDownloadSize {
+		return nil, fmt.Errorf("failed to fetch %s: larger than %d bytes", location, maxDownloadSize)
+	}
+	return bz, nil
+}
@@ -0,0 +1,198 @@
+package release_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/release"
+)
+
+const tag = "v0.16.0"
+
+type fixture struct {
+	binary    string
+	digest    string
+	key       *ecdsa.PrivateKey
+	publicKey []byte
+	files     map[string][]byte
+	server    *httptest.Server
+}
+
+func setup(t *testing.T) *fixture {
+	t.Helper()
+
+	f := &fixture{files: make(map[string][]byte)}
+	f.binary = filepath.Join(t.TempDir(), "snrd")
+	require.NoError(t, os.WriteFile(f.binary, []byte("release build"), 0o700))
+	f.digest = sha256Hex([]byte("release build"))
+
+	var err error
+	f.key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&f.key.PublicKey)
+	require.NoError(t, err)
+	f.publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	f.publish(t, fmt.Sprintf("%s  snrd_linux_amd64\n%s  snrd_linux_x86_64.tar.gz\n", f.digest, sha256Hex([]byte("archive"))))
+
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, ok := f.files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(bz)
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// publish signs and serves a manifest the way `cosign sign-blob` does
+func (f *fixture) publish(t *testing.T, manifest string) {
+	digest := sha256.Sum256([]byte(manifest))
+	sig, err := ecdsa.SignASN1(rand.Reader, f.key, digest[:])
+	require.NoError(t, err)
+
+	f.files["/"+tag+"/snrd_checksums.txt"] = []byte(manifest)
+	f.files["/"+tag+"/snrd_checksums.txt.sig"] = []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+}
+
+func (f *fixture) options(anchor release.Anchor) release.Options {
+	return release.Options{
+		Binary:      f.binary,
+		Tag:         tag,
+		ManifestURL: f.server.URL + "/{tag}/snrd_checksums.txt",
+		PublicKey:   f.publicKey,
+		Anchor:      anchor,
+		GOOS:        "linux",
+		GOARCH:      "amd64",
+	}
+}
+
+type planAnchor map[string]string
+
+func (a planAnchor) PlanInfo(_ context.Context, name string) (string, bool, error) {
+	info, ok := a[name]
+	return info, ok, nil
+}
+
+func sha256Hex(bz []byte) string {
+	digest := sha256.Sum256(bz)
+	return hex.EncodeToString(digest[:])
+}
+
+func statuses(r *release.Report) map[string]release.Status {
+	out := make(map[string]release.Status)
+	for _, c := range r.Checks {
+		out[c.Name] = c.Status
+	}
+	return out
+}
+
+func TestVerifyReleaseBinary(t *testing.T) {
+	f := setup(t)
+	anchor := planAnchor{tag: fmt.Sprintf(
+		`{"binaries":{"linux/amd64":"https://example.com/snrd_linux_x86_64.tar.gz?checksum=sha256:%s"}}`,
+		sha256Hex([]byte("archive")),
+	)}
+
+	report, err := release.Verify(context.Background(), f.options(anchor))
+	require.NoError(t, err)
+	require.True(t, report.OK(), "%+v", report.Checks)
+	require.Equal(t, f.digest, report.SHA256)
+	require.Equal(t, map[string]release.Status{
+		release.CheckSignature: release.StatusPass,
+		release.CheckManifest:  release.StatusPass,
+		release.CheckAnchor:    release.StatusPass,
+	}, statuses(report))
+}
+
+func TestVerifyTamperedBinary(t *testing.T) {
+	f := setup(t)
+	require.NoError(t, os.WriteFile(f.binary, []byte("patched build"), 0o700))
+	anchor := planAnchor{tag: fmt.Sprintf(`{"binaries":{"linux/amd64":"https://example.com/snrd?checksum=sha256:%s"}}`, f.digest)}
+
+	report, err := release.Verify(context.Background(), f.options(anchor))
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.Equal(t, release.StatusPass, statuses(report)[release.CheckSignature])
+	require.Equal(t, release.StatusFail, statuses(report)[release.CheckManifest])
+	require.Equal(t, release.StatusFail, statuses(report)[release.CheckAnchor])
+}
+
+func TestVerifyForgedManifest(t *testing.T) {
+	f := setup(t)
+	f.files["/"+tag+"/snrd_checksums.txt"] = []byte(sha256Hex([]byte("patched build")) + "  snrd_linux_amd64\n")
+	require.NoError(t, os.WriteFile(f.binary, []byte("patched build"), 0o700))
+
+	report, err := release.Verify(context.Background(), f.options(nil))
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.Equal(t, release.StatusFail, statuses(report)[release.CheckSignature])
+	require.Equal(t, release.StatusFail, statuses(report)[release.CheckManifest])
+}
+
+func TestVerifyAnchor(t *testing.T) {
+	f := setup(t)
+	manifest := f.files["/"+tag+"/snrd_checksums.txt"]
+
+	t.Run("manifest digest", func(t *testing.T) {
+		anchor := planAnchor{tag: fmt.Sprintf(`{"release_manifest_sha256":%q}`, sha256Hex(manifest))}
+		report, err := release.Verify(context.Background(), f.options(anchor))
+		require.NoError(t, err)
+		require.Equal(t, release.StatusPass, statuses(report)[release.CheckAnchor])
+	})
+
+	t.Run("replaced manifest", func(t *testing.T) {
+		anchor := planAnchor{tag: fmt.Sprintf(`{"release_manifest_sha256":%q}`, sha256Hex([]byte("older manifest")))}
+		report, err := release.Verify(context.Background(), f.options(anchor))
+		require.NoError(t, err)
+		require.False(t, report.OK())
+		require.Equal(t, release.StatusFail, statuses(report)[release.CheckAnchor])
+	})
+
+	t.Run("no plan", func(t *testing.T) {
+		opts := f.options(planAnchor{})
+		report, err := release.Verify(context.Background(), opts)
+		require.NoError(t, err)
+		require.True(t, report.OK())
+		require.Equal(t, release.StatusSkip, statuses(report)[release.CheckAnchor])
+
+		opts.RequireAnchor = true
+		report, err = release.Verify(context.Background(), opts)
+		require.NoError(t, err)
+		require.False(t, report.OK())
+	})
+}
+
+func TestParseChecksums(t *testing.T) {
+	sums, err := release.ParseChecksums([]byte(sha256Hex([]byte("a")) + "  snrd_linux_amd64\n\n" + sha256Hex([]byte("b")) + " *snrd_darwin_arm64\n"))
+	require.NoError(t, err)
+	require.Equal(t, sha256Hex([]byte("b")), sums["snrd_darwin_arm64"])
+
+	_, err = release.ParseChecksums([]byte("abc  snrd_linux_amd64\n"))
+	require.ErrorContains(t, err, "bad digest")
+
+	_, err = release.ParseChecksums(nil)
+	require.ErrorContains(t, err, "no artifacts")
+}
+
+func TestTag(t *testing.T) {
+	require.Equal(t, "v0.16.0", release.Tag("0.16.0"))
+	require.Equal(t, "v0.16.0", release.Tag("v0.16.0"))
+}
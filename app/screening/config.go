@@ -0,0 +1,88 @@
+package screening
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cast"
+	"sigs.k8s.io/yaml"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	dextypes "github.com/sonr-io/sonr/x/dex/types"
+)
+
+// Config selects the address lists the dex module screens against. Whether
+// screening runs, and whether it blocks, is set by the dex module params.
+type Config struct {
+	// AddressLists are YAML or JSON files of listed DID or address to the
+	// reason it is listed. Each file is a list named after the file.
+	AddressLists []string `mapstructure:"address-lists"`
+}
+
+// DefaultConfig returns the default configuration, which has no lists
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// ConfigFromAppOptions reads the [screening] section of app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	return Config{AddressLists: cast.ToStringSlice(appOpts.Get("screening.address-lists"))}
+}
+
+// Load builds a screener over the configured lists, or returns nil when none
+// is configured
+func Load(cfg Config) (dextypes.Screener, error) {
+	if len(cfg.AddressLists) == 0 {
+		return nil, nil
+	}
+	providers := make([]dextypes.AddressListProvider, 0, len(cfg.AddressLists))
+	for _, path := range cfg.AddressLists {
+		list, err := LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, list)
+	}
+	return dextypes.NewAddressListScreener(providers...), nil
+}
+
+// LoadFile reads a YAML or JSON file of listed DID or address to reason:
+//
+//	idx1sanctioned...: OFAC SDN
+//	did:sonr:blocked: Internal review 2026-014
+func LoadFile(path string) (dextypes.AddressList, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return dextypes.AddressList{}, fmt.Errorf("failed to read address list: %w", err)
+	}
+	var entries map[string]string
+	if err := yaml.Unmarshal(bz, &entries); err != nil {
+		return dextypes.AddressList{}, fmt.Errorf("invalid address list %s: %w", path, err)
+	}
+	for party := range entries {
+		if party == "" {
+			return dextypes.AddressList{}, fmt.Errorf("invalid address list %s: empty entry", path)
+		}
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return dextypes.NewAddressList(name, entries), nil
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                           Compliance Screening                          ###
+###############################################################################
+
+# Lists the dex module screens swaps, limit orders and OTC offers against.
+# The dex screening params decide whether screening is off, only logged or
+# blocks flagged operations, and from which amounts it applies.
+[screening]
+
+# YAML or JSON files of listed DID or address to the reason it is listed.
+# Each file is reported by its name without extension.
+address-lists = [{{ range .Screening.AddressLists }}{{ printf "%q, " . }}{{ end }}]
+`
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/labstack/echo/v4"
+
+	dwnattachments "github.com/sonr-io/sonr/x/dwn/client/attachments"
+	dwnpincheck "github.com/sonr-io/sonr/x/dwn/client/pincheck"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
+)
+
+// startVaultPins starts the vault pin checker, the attachment releaser and
+// their admin listener when [vault-pins] is enabled. They run until the app
+// is closed.
+func (app *ChainApp) startVaultPins(clientCtx client.Context) {
+	cfg := app.vaultPins
+	if !cfg.Enabled {
+		return
+	}
+
+	ctx := app.servicesContext()
+	checker := dwnpincheck.NewChecker(
+		dwnpincheck.NewQueryVaultSource(dwntypes.NewQueryClient(clientCtx)),
+		cfg.Providers(),
+		app.addVaultContent,
+		nil,
+		cfg.Interval,
+	)
+	checker.Start(ctx)
+
+	rpc := cfg.CometRPC()
+	dwnpincheck.NewReleaser(rpc.Released, rpc.LatestHeight, cfg.Unpinners(), 0).
+		Start(ctx, cfg.ReleaseInterval)
+
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	dwnpincheck.RegisterAdminRoutes(e, checker)
+	go func() {
+		if err := e.Start(cfg.AdminAddress); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			app.Logger().Error("vault pin admin listener failed", "address", cfg.AdminAddress, "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = e.Close()
+	}()
+}
+
+// registerAttachments mounts the attachment endpoints on the API server when
+// [attachments] is enabled, pruning expired uploads until the app is closed
+func (app *ChainApp) registerAttachments(apiSvr *api.Server) error {
+	cfg := app.attachments
+	if !cfg.Enabled {
+		return nil
+	}
+	dir := cfg.Dir
+	if dir == "" {
+		dir = filepath.Join(app.homePath, "attachments")
+	}
+
+	usage, err := dwnattachments.OpenUsage(filepath.Join(dir, "usage.json"))
+	if err != nil {
+		return err
+	}
+	s, err := dwnattachments.NewServer(dwnattachments.Config{
+		Dir:          dir,
+		Policy:       app.DwnKeeper.UploadPolicy(),
+		UploadExpiry: cfg.UploadExpiry,
+	}, app.attachmentUploader(usage), app.DwnKeeper.FetchFromIPFS)
+	if err != nil {
+		return err
+	}
+	s.Start(app.servicesContext(), dwnattachments.DefaultPruneInterval)
+
+	e := echo.New()
+	e.HideBanner = true
+	dwnattachments.RegisterRoutes(e, s)
+	apiSvr.Router.PathPrefix("/attachments").Handler(e)
+	return nil
+}
+
+// attachmentUploader checks and pins attachments against the latest committed
+// state. Writes to a query context are discarded, so the bytes are charged to
+// the owner in usage instead, which counts against the quota alongside the
+// owner's usage on chain.
+func (app *ChainApp) attachmentUploader(usage *dwnattachments.Usage) dwnattachments.Uploader {
+	return func(ctx context.Context, u dwntypes.Upload) (string, error) {
+		return usage.Charge(u.Owner, uint64(len(u.Data)), func(pinned uint64) (string, error) {
+			sdkCtx, err := app.CreateQueryContext(0, false)
+			if err != nil {
+				return "", err
+			}
+			return app.DwnKeeper.UploadToIPFSWithPending(sdkCtx.WithContext(ctx), u, pinned)
+		})
+	}
+}
+
+// addVaultContent re-adds vault content through the keeper's IPFS client,
+// which added it originally, so it hashes to the recorded CID
+func (app *ChainApp) addVaultContent(_ context.Context, data []byte) (string, error) {
+	ipfsClient, err := app.DwnKeeper.GetIPFSClient()
+	if err != nil {
+		return "", err
+	}
+	return ipfsClient.Add(data)
+}
+
+// servicesContext returns the context off-chain services run under. It is
+// cancelled when the app is closed.
+func (app *ChainApp) servicesContext() context.Context {
+	if app.servicesCtx == nil {
+		app.servicesCtx, app.stopServices = context.WithCancel(context.Background())
+	}
+	return app.servicesCtx
+}
+
+// Close stops the off-chain services and closes the app
+func (app *ChainApp) Close() error {
+	if app.stopServices != nil {
+		app.stopServices()
+	}
+	return app.BaseApp.Close()
+}
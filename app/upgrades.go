@@ -8,19 +8,22 @@ import (
 
 	"github.com/sonr-io/sonr/app/upgrades"
 	"github.com/sonr-io/sonr/app/upgrades/noop"
+	v016 "github.com/sonr-io/sonr/app/upgrades/v016"
 )
 
 // Upgrades contains the list of chain upgrades to be applied.
 // Each upgrade defines the upgrade name, handler, and store migrations.
-var Upgrades = []upgrades.Upgrade{}
+var Upgrades = []upgrades.Upgrade{
+	v016.NewUpgrade(),
+}
 
 // RegisterUpgradeHandlers registers the chain upgrade handlers for all defined upgrades.
 // It sets up the upgrade handlers with the module manager and configurator,
 // and configures the store loader for the current upgrade if applicable.
-// If no upgrades are defined, it registers a no-op upgrade for testing purposes.
+// If no upgrade matches the current version, it registers a no-op upgrade for testing purposes.
 func (app *ChainApp) RegisterUpgradeHandlers() {
 	// setupLegacyKeyTables(&app.ParamsKeeper)
-	if len(Upgrades) == 0 {
+	if !hasUpgrade(app.Version()) {
 		// always have a unique upgrade registered for the current version to test in system tests
 		Upgrades = append(Upgrades, noop.NewUpgrade(app.Version()))
 	}
@@ -31,6 +34,11 @@ func (app *ChainApp) RegisterUpgradeHandlers() {
 		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
 		CapabilityKeeper:      app.CapabilityKeeper,
 		IBCKeeper:             app.IBCKeeper,
+		BankKeeper:            app.BankKeeper,
+		DenomTraceKeeper:      app.TransferKeeper,
+		DidKeeper:             &app.DidKeeper,
+		SvcKeeper:             &app.SvcKeeper,
+		HomePath:              app.homePath,
 		Codec:                 app.appCodec,
 		GetStoreKey:           app.GetKey,
 	}
@@ -66,3 +74,13 @@ func (app *ChainApp) RegisterUpgradeHandlers() {
 		}
 	}
 }
+
+// hasUpgrade reports whether an upgrade with the given name is defined.
+func hasUpgrade(name string) bool {
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+// Package denommetadata provides the upgrade step that publishes bank denom
+// metadata for the native token and bridged assets already in circulation.
+package denommetadata
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/sonr-io/sonr/app/assets"
+	"github.com/sonr-io/sonr/app/upgrades"
+)
+
+// CreateUpgradeHandler runs module migrations and then registers metadata for
+// every known denom in the total supply that does not have any.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		versionMap, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		registered, err := assets.RegisterDenomMetadata(
+			sdkCtx,
+			ak.BankKeeper,
+			ak.DenomTraceKeeper,
+			assets.DefaultRegistry(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.Logger().Info("registered denom metadata", "upgrade", plan.Name, "denoms", registered)
+
+		return versionMap, nil
+	}
+}
@@ -0,0 +1,103 @@
+// Package legacyimport provides the upgrade step that imports accounts,
+// credentials and service records exported from the sonrhq/core chain.
+package legacyimport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/sonr-io/sonr/app/legacy"
+	"github.com/sonr-io/sonr/app/upgrades"
+)
+
+// PlanInfo is the JSON carried in the upgrade plan's info field. The export
+// path is resolved against the node home, and every node must hold a file
+// with the same digest or the upgrade halts rather than diverging.
+type PlanInfo struct {
+	LegacyExport       string `json:"legacy_export"`
+	LegacyExportSHA256 string `json:"legacy_export_sha256"`
+}
+
+// CreateUpgradeHandler runs module migrations and then imports the legacy
+// export named in the plan info, if any.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		versionMap, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := parsePlanInfo(plan.Info)
+		if err != nil {
+			return nil, err
+		}
+		if info.LegacyExport == "" {
+			return versionMap, nil
+		}
+
+		path := info.LegacyExport
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(ak.HomePath, path)
+		}
+		export, digest, err := legacy.LoadExport(path)
+		if err != nil {
+			return nil, err
+		}
+		if digest != info.LegacyExportSHA256 {
+			return nil, fmt.Errorf("legacy export %s has digest %s, plan requires %s", path, digest, info.LegacyExportSHA256)
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		target := legacy.NewStoreTarget(ak.DidKeeper.OrmDB, ak.SvcKeeper.OrmDB, ak.SvcKeeper.IsVerifiedDomain)
+		report, err := legacy.Migrate(ctx, target, export, false, sdkCtx.BlockTime().Unix())
+		if err != nil {
+			return nil, err
+		}
+
+		logger := sdkCtx.Logger()
+		for _, entry := range report.Entries {
+			if entry.Action == legacy.ActionConflict || entry.Action == legacy.ActionInvalid {
+				logger.Warn("skipped legacy record",
+					"kind", entry.Kind,
+					"id", entry.ID,
+					"action", entry.Action,
+					"reason", entry.Reason,
+				)
+			}
+		}
+		logger.Info("imported legacy state",
+			"upgrade", plan.Name,
+			"did_documents", report.Count(legacy.KindDIDDocument, legacy.ActionCreate),
+			"credentials", report.Count(legacy.KindCredential, legacy.ActionCreate),
+			"services", report.Count(legacy.KindService, legacy.ActionCreate),
+		)
+
+		return versionMap, nil
+	}
+}
+
+// parsePlanInfo decodes the plan info. Plans without JSON info import nothing.
+func parsePlanInfo(raw string) (PlanInfo, error) {
+	var info PlanInfo
+	if raw == "" || raw[0] != '{' {
+		return info, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &info); err != nil {
+		return info, fmt.Errorf("invalid upgrade plan info: %w", err)
+	}
+	if info.LegacyExport != "" && info.LegacyExportSHA256 == "" {
+		return info, fmt.Errorf("upgrade plan info names a legacy export without its sha256")
+	}
+	return info, nil
+}
@@ -15,6 +15,10 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+
+	"github.com/sonr-io/sonr/app/assets"
+	didkeeper "github.com/sonr-io/sonr/x/did/keeper"
+	svckeeper "github.com/sonr-io/sonr/x/svc/keeper"
 )
 
 // AppKeepers holds references to all the keepers needed during chain upgrades.
@@ -28,6 +32,11 @@ type AppKeepers struct {
 	GetStoreKey           func(storeKey string) *storetypes.KVStoreKey
 	CapabilityKeeper      *capabilitykeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper
+	BankKeeper            assets.BankKeeper
+	DenomTraceKeeper      assets.DenomTraceKeeper
+	DidKeeper             *didkeeper.Keeper
+	SvcKeeper             *svckeeper.Keeper
+	HomePath              string
 }
 
 // ModuleManager defines the interface for running module migrations during upgrades.
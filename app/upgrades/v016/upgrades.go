@@ -0,0 +1,65 @@
+// Package v016 provides the v0.16.0 upgrade, which adds the referral module
+// and the dex protocol liquidity account, publishes denom metadata and imports
+// sonrhq/core state.
+package v016
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/sonr-io/sonr/app/upgrades"
+	"github.com/sonr-io/sonr/app/upgrades/denommetadata"
+	"github.com/sonr-io/sonr/app/upgrades/legacyimport"
+	dextypes "github.com/sonr-io/sonr/x/dex/types"
+	referraltypes "github.com/sonr-io/sonr/x/referral/types"
+)
+
+// UpgradeName is the name of the governance upgrade plan.
+const UpgradeName = "v0.16.0"
+
+// NewUpgrade creates the v0.16.0 upgrade. It is the only plan this binary
+// runs, so the referral store it adds is mounted however a chain reaches it.
+func NewUpgrade() upgrades.Upgrade {
+	return upgrades.Upgrade{
+		UpgradeName:          UpgradeName,
+		CreateUpgradeHandler: CreateUpgradeHandler,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added:   []string{referraltypes.StoreKey},
+			Deleted: []string{},
+		},
+	}
+}
+
+// CreateUpgradeHandler runs module migrations, which initializes the
+// referral module, creates the dex protocol liquidity account, then
+// publishes denom metadata and imports the legacy export named in the plan
+// info.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	steps := []upgradetypes.UpgradeHandler{
+		denommetadata.CreateUpgradeHandler(mm, configurator, ak),
+		legacyimport.CreateUpgradeHandler(mm, configurator, ak),
+	}
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// Each step runs module migrations first; after the first step the
+		// version map is current, so later steps only apply their own changes.
+		versionMap := fromVM
+		for _, step := range steps {
+			var err error
+			if versionMap, err = step(ctx, plan, versionMap); err != nil {
+				return nil, err
+			}
+		}
+
+		// x/dex was initialized before the account existed
+		ak.AccountKeeper.GetModuleAccount(ctx, dextypes.ProtocolLiquidityAccount)
+		return versionMap, nil
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Bridge reads blocks in order and delivers the events that match a route
+// to that route's sinks. Delivery is at least once: the height is recorded
+// only after every event of the block was handled, so a restart may resend
+// the events of the block that was in progress.
+type Bridge struct {
+	cfg     *Config
+	source  Source
+	router  *Router
+	sinks   map[string]Sink
+	metrics *Metrics
+
+	notifier  *Notifier
+	callbacks *Callbacks
+}
+
+// NewBridge creates a bridge over source that delivers to sinks
+func NewBridge(cfg *Config, source Source, sinks map[string]Sink, metrics *Metrics) *Bridge {
+	return &Bridge{
+		cfg:     cfg,
+		source:  source,
+		router:  NewRouter(cfg.Routes),
+		sinks:   sinks,
+		metrics: metrics,
+	}
+}
+
+// SetNotifier sends end-user notifications for the events of each block
+func (b *Bridge) SetNotifier(n *Notifier) {
+	b.notifier = n
+}
+
+// SetCallbacks calls services back when their swaps and orders settle
+func (b *Bridge) SetCallbacks(c *Callbacks) {
+	b.callbacks = c
+}
+
+// Run processes blocks until ctx is cancelled
+func (b *Bridge) Run(ctx context.Context) error {
+	next, err := b.startHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		latest, err := b.source.Latest(ctx)
+		if err != nil {
+			log.Printf("failed to read latest height: %v", err)
+		}
+		for ; err == nil && next <= latest; next++ {
+			if err = b.ProcessBlock(ctx, next); err != nil {
+				log.Printf("failed to process block %d: %v", next, err)
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Duration(b.cfg.PollInterval)):
+		}
+	}
+}
+
+// ProcessBlock delivers the routed events of the block at height and then
+// records it as processed
+func (b *Bridge) ProcessBlock(ctx context.Context, height int64) error {
+	events, err := b.source.Events(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for _, ev := range events {
+		for _, route := range b.router.Match(ev) {
+			b.deliver(ctx, route, ev)
+		}
+		if b.notifier != nil {
+			b.notifier.Handle(ctx, ev)
+		}
+		if b.callbacks != nil {
+			b.callbacks.Handle(ctx, ev)
+		}
+	}
+	if b.notifier != nil {
+		b.notifier.FlushDigests(ctx, time.Now())
+	}
+
+	if b.metrics != nil {
+		b.metrics.Height.Set(float64(height))
+	}
+	return b.saveHeight(height)
+}
+
+// deliver sends an event to each sink of a route, retrying with exponential
+// backoff. An event a sink still rejects after the last attempt is logged and
+// dropped so one unavailable sink cannot stall the others.
+func (b *Bridge) deliver(ctx context.Context, route RouteConfig, ev Event) {
+	d := Delivery{Route: route.Name, Event: ev}
+	payload, err := json.Marshal(d)
+	if err != nil {
+		log.Printf("failed to encode %s event at height %d: %v", ev.Type, ev.Height, err)
+		return
+	}
+
+	for _, name := range route.Sinks {
+		sink := b.sinks[name]
+		err := withRetry(ctx, b.cfg.Retry, func() error { return sink.Send(ctx, d, payload) })
+
+		result := "delivered"
+		if err != nil {
+			result = "failed"
+			log.Printf("route %s: dropped %s event at height %d for sink %s: %v", route.Name, ev.Type, ev.Height, name, err)
+		}
+		if b.metrics != nil {
+			b.metrics.Deliveries.WithLabelValues(route.Name, name, result).Inc()
+		}
+	}
+}
+
+// withRetry calls fn until it succeeds, the attempts run out or ctx is
+// cancelled, doubling the backoff after each failure
+func withRetry(ctx context.Context, retry RetryConfig, fn func() error) error {
+	backoff := time.Duration(retry.Backoff)
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= retry.Attempts || ctx.Err() != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// startHeight resumes after the recorded height, or starts from the
+// configured height or the latest block
+func (b *Bridge) startHeight(ctx context.Context) (int64, error) {
+	bz, err := os.ReadFile(b.cfg.StateFile)
+	switch {
+	case err == nil:
+		height, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid state file %s: %w", b.cfg.StateFile, err)
+		}
+		return height + 1, nil
+	case !errors.Is(err, os.ErrNotExist):
+		return 0, err
+	case b.cfg.StartHeight > 0:
+		return b.cfg.StartHeight, nil
+	default:
+		return b.source.Latest(ctx)
+	}
+}
+
+// saveHeight atomically records height as processed
+func (b *Bridge) saveHeight(height int64) error {
+	return writeFileAtomic(b.cfg.StateFile, []byte(strconv.FormatInt(height, 10)))
+}
+
+// writeFileAtomic replaces the file at path with bz, so a crash leaves either
+// the old or the new contents
+func writeFileAtomic(path string, bz []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".bridge-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
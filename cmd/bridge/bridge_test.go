@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+node: tcp://localhost:26657
+poll_interval: 1s
+retry:
+  attempts: 2
+  backoff: 1ms
+sinks:
+  crm:
+    type: webhook
+    url: ${CRM_URL}
+    secret: ${CRM_SECRET}
+routes:
+  - name: new-dids
+    event: did_created
+    where:
+      did: "did:sonr:*"
+    sinks: [crm]
+  - name: large-swaps
+    event: "*.EventSwap*"
+    where:
+      denom: "ibc/*"
+    sinks: [crm]
+`
+
+func loadTestConfig(t *testing.T, url string) *Config {
+	t.Helper()
+	t.Setenv("CRM_URL", url)
+	t.Setenv("CRM_SECRET", "s3cret")
+	cfg, err := ParseConfig([]byte(testConfig))
+	require.NoError(t, err)
+	cfg.StateFile = filepath.Join(t.TempDir(), "bridge.height")
+	return cfg
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg := loadTestConfig(t, "https://crm.example.com/hook")
+	require.Equal(t, "https://crm.example.com/hook", cfg.Sinks["crm"].URL)
+	require.Equal(t, "s3cret", cfg.Sinks["crm"].Secret)
+	require.Equal(t, Duration(10*time.Second), cfg.Sinks["crm"].Timeout)
+	require.Equal(t, Duration(time.Second), cfg.PollInterval)
+
+	_, err := ParseConfig([]byte("routes: [{name: r, event: x, sinks: [missing]}]"))
+	require.ErrorContains(t, err, "unknown sink")
+
+	_, err = ParseConfig([]byte("sinks: {q: {type: kafka}}\nroutes: [{name: r, event: x, sinks: [q]}]"))
+	require.ErrorContains(t, err, "unknown type")
+
+	_, err = ParseConfig([]byte("nodes: typo"))
+	require.Error(t, err, "unknown fields are rejected")
+}
+
+func TestRouterMatch(t *testing.T) {
+	router := NewRouter(loadTestConfig(t, "http://localhost").Routes)
+
+	testCases := []struct {
+		name  string
+		event Event
+		want  []string
+	}{
+		{
+			"plain attribute",
+			NewEvent(1, "", "did_created", [][2]string{{"did", "did:sonr:alice"}}),
+			[]string{"new-dids"},
+		},
+		{
+			"where mismatch",
+			NewEvent(1, "", "did_created", [][2]string{{"did", "did:key:z6Mk"}}),
+			nil,
+		},
+		{
+			"missing attribute",
+			NewEvent(1, "", "did_created", nil),
+			nil,
+		},
+		{
+			"typed event with quoted values",
+			NewEvent(1, "", "dex.v1.EventSwapExecuted", [][2]string{{"denom", `"ibc/27394FB"`}}),
+			[]string{"large-swaps"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, route := range router.Match(tc.event) {
+				got = append(got, route.Name)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGlob(t *testing.T) {
+	require.True(t, glob("*", ""))
+	require.True(t, glob("a*b*c", "abc"))
+	require.True(t, glob("a*b*c", "a-b-b-c"))
+	require.False(t, glob("a*a", "a"))
+	require.False(t, glob("abc", "abcd"))
+}
+
+// fakeSource serves a fixed set of blocks
+type fakeSource struct {
+	blocks map[int64][]Event
+	latest int64
+}
+
+func (s *fakeSource) Latest(context.Context) (int64, error) { return s.latest, nil }
+
+func (s *fakeSource) Events(_ context.Context, height int64) ([]Event, error) {
+	return s.blocks[height], nil
+}
+
+func TestBridgeDeliversAndResumes(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []Delivery
+		failures = 1
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, "sha256="+Sign("s3cret", body), r.Header.Get(SignatureHeader))
+
+		mu.Lock()
+		defer mu.Unlock()
+		// The first attempt fails and is retried
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var d Delivery
+		require.NoError(t, json.Unmarshal(body, &d))
+		received = append(received, d)
+	}))
+	defer server.Close()
+
+	cfg := loadTestConfig(t, server.URL)
+	source := &fakeSource{
+		latest: 2,
+		blocks: map[int64][]Event{
+			1: {NewEvent(1, "AB12", "did_created", [][2]string{{"did", "did:sonr:alice"}})},
+			2: {NewEvent(2, "CD34", "transfer", nil)},
+			3: {NewEvent(3, "EF56", "did_created", [][2]string{{"did", "did:sonr:bob"}})},
+		},
+	}
+	sink, err := NewSink(cfg.Sinks["crm"])
+	require.NoError(t, err)
+	bridge := NewBridge(cfg, source, map[string]Sink{"crm": sink}, nil)
+
+	start, err := bridge.startHeight(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, start, "without state the bridge starts at the latest block")
+
+	for h := int64(1); h <= 2; h++ {
+		require.NoError(t, bridge.ProcessBlock(context.Background(), h))
+	}
+	require.Len(t, received, 1)
+	require.Equal(t, "new-dids", received[0].Route)
+	require.Equal(t, "AB12", received[0].TxHash)
+	require.Equal(t, "did:sonr:alice", received[0].Attributes["did"])
+
+	// A restarted bridge continues after the last processed block
+	restarted := NewBridge(cfg, source, map[string]Sink{"crm": sink}, nil)
+	start, err = restarted.startHeight(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 3, start)
+}
+
+func TestNATSSink(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	published := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.WriteString(conn, "INFO {\"server_id\":\"test\"}\r\n")
+
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+				if !strings.Contains(line, `"auth_token":"tok"`) {
+					_, _ = io.WriteString(conn, "-ERR 'Authorization Violation'\r\n")
+				}
+			case strings.HasPrefix(line, "PUB "):
+				var subject string
+				var n int
+				_, _ = fmt.Sscanf(line, "PUB %s %d", &subject, &n)
+				payload := make([]byte, n+2)
+				_, _ = io.ReadFull(r, payload)
+				published <- subject + " " + string(payload[:n])
+			case line == "PING\r\n":
+				_, _ = io.WriteString(conn, "PONG\r\n")
+			}
+		}
+	}()
+
+	sink, err := NewSink(SinkConfig{
+		Type:    SinkNATS,
+		URL:     "nats://tok@" + ln.Addr().String(),
+		Subject: "sonr.{type}",
+		Timeout: Duration(time.Second),
+	})
+	require.NoError(t, err)
+
+	d := Delivery{Route: "r", Event: NewEvent(7, "", "did_created", nil)}
+	require.NoError(t, sink.Send(context.Background(), d, []byte(`{"ok":true}`)))
+	require.Equal(t, `sonr.did_created {"ok":true}`, <-published)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// callbackEvents maps the dex events that settle a swap or order to the kind
+// of callback they produce
+var callbackEvents = map[string]string{
+	"swap_settled":  "swap",
+	"order_filled":  "order",
+	"order_expired": "order",
+}
+
+// CallbackPayload is the signed body a service is called back with when a
+// swap or order it submitted settles
+type CallbackPayload struct {
+	// Kind is "swap" or "order"
+	Kind    string `json:"kind"`
+	ID      string `json:"id"`
+	Service string `json:"service"`
+	DID     string `json:"did"`
+	// Status is complete or failed for swaps, filled or expired for orders
+	Status       string `json:"status"`
+	ConnectionID string `json:"connection_id"`
+	// Sequence is the ICA packet sequence the swap or order was sent in
+	Sequence      uint64 `json:"sequence"`
+	TokenIn       string `json:"token_in"`
+	TokenOutDenom string `json:"token_out_denom"`
+	// AmountOut is the output the host chain reported, if any
+	AmountOut string `json:"amount_out,omitempty"`
+	// RequestTxHash submitted the swap or order; ResultTxHash settled it and
+	// is empty when it settled at the end of a block
+	RequestTxHash string `json:"request_tx_hash,omitempty"`
+	ResultTxHash  string `json:"result_tx_hash,omitempty"`
+	Height        int64  `json:"height"`
+}
+
+// Callbacks calls services back when the swaps and orders they submitted
+// settle. The dex module names the service, the audience of the UCAN the
+// message carried, in the settling event; services without a configured
+// endpoint are skipped.
+type Callbacks struct {
+	retry    RetryConfig
+	services map[string]Sink
+	metrics  *Metrics
+}
+
+// NewCallbacks creates a signed webhook for each configured service
+func NewCallbacks(cfg *CallbackConfig, retry RetryConfig, metrics *Metrics) (*Callbacks, error) {
+	c := &Callbacks{retry: retry, services: make(map[string]Sink, len(cfg.Services)), metrics: metrics}
+	for service, endpoint := range cfg.Services {
+		sink, err := NewSink(endpoint.SinkConfig())
+		if err != nil {
+			return nil, fmt.Errorf("callback %s: %w", service, err)
+		}
+		c.services[service] = sink
+	}
+	return c, nil
+}
+
+// Handle calls back the service named by a settling event
+func (c *Callbacks) Handle(ctx context.Context, ev Event) {
+	kind, ok := callbackEvents[ev.Type]
+	if !ok {
+		return
+	}
+	service := ev.Attributes["service"]
+	sink, ok := c.services[service]
+	if !ok {
+		return
+	}
+
+	payload, err := json.Marshal(NewCallbackPayload(kind, ev))
+	if err != nil {
+		log.Printf("failed to encode %s callback at height %d: %v", ev.Type, ev.Height, err)
+		return
+	}
+	d := Delivery{Route: "callback:" + service, Event: ev}
+	err = withRetry(ctx, c.retry, func() error { return sink.Send(ctx, d, payload) })
+
+	result := "delivered"
+	if err != nil {
+		result = "failed"
+		log.Printf("dropped %s callback to %s at height %d: %v", ev.Type, service, ev.Height, err)
+	}
+	if c.metrics != nil {
+		c.metrics.Callbacks.WithLabelValues(kind, result).Inc()
+	}
+}
+
+// NewCallbackPayload builds the callback of a settling swap or order event
+func NewCallbackPayload(kind string, ev Event) CallbackPayload {
+	attrs := ev.Attributes
+	p := CallbackPayload{
+		Kind:          kind,
+		Service:       attrs["service"],
+		DID:           attrs["did"],
+		ConnectionID:  attrs["connection"],
+		TokenIn:       attrs["token_in"],
+		TokenOutDenom: attrs["token_out_denom"],
+		RequestTxHash: attrs["request_tx_hash"],
+		ResultTxHash:  ev.TxHash,
+		Height:        ev.Height,
+	}
+	p.Sequence, _ = strconv.ParseUint(attrs["sequence"], 10, 64)
+
+	switch ev.Type {
+	case "swap_settled":
+		p.ID = attrs["swap_id"]
+		p.Status = attrs["status"]
+		p.AmountOut = attrs["amount_received"]
+	case "order_filled":
+		p.ID = attrs["order_id"]
+		p.Status = "filled"
+		p.AmountOut = attrs["filled_amount"]
+	case "order_expired":
+		p.ID = attrs["order_id"]
+		p.Status = "expired"
+		p.AmountOut = attrs["filled_amount"]
+	}
+	return p
+}
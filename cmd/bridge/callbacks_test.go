@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCallbackConfig = `
+retry:
+  attempts: 2
+  backoff: 1ms
+callbacks:
+  services:
+    "did:sonr:acme":
+      url: ${ACME_URL}
+      secret: acme-secret
+`
+
+func TestCallbacksSignSettlements(t *testing.T) {
+	var received []CallbackPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, "sha256="+Sign("acme-secret", body), r.Header.Get(SignatureHeader))
+
+		var p CallbackPayload
+		require.NoError(t, json.Unmarshal(body, &p))
+		received = append(received, p)
+	}))
+	defer server.Close()
+
+	t.Setenv("ACME_URL", server.URL)
+	cfg, err := ParseConfig([]byte(testCallbackConfig))
+	require.NoError(t, err)
+	cfg.StateFile = filepath.Join(t.TempDir(), "bridge.height")
+
+	callbacks, err := NewCallbacks(cfg.Callbacks, cfg.Retry, nil)
+	require.NoError(t, err)
+	source := &fakeSource{
+		latest: 1,
+		blocks: map[int64][]Event{
+			1: {
+				NewEvent(1, "AB12", "swap_settled", [][2]string{
+					{"did", "did:sonr:alice"},
+					{"connection", "connection-0"},
+					{"swap_id", "7"},
+					{"sequence", "3"},
+					{"status", "complete"},
+					{"token_in", "1000uatom"},
+					{"token_out_denom", "uosmo"},
+					{"amount_received", "950"},
+					{"service", "did:sonr:acme"},
+					{"request_tx_hash", "CD34"},
+				}),
+				// Other services and swaps without a service are not called
+				NewEvent(1, "AB12", "swap_settled", [][2]string{{"swap_id", "8"}, {"service", "did:sonr:other"}}),
+				NewEvent(1, "AB12", "swap_settled", [][2]string{{"swap_id", "9"}}),
+				NewEvent(1, "", "order_expired", [][2]string{
+					{"order_id", "4"},
+					{"sequence", "2"},
+					{"service", "did:sonr:acme"},
+				}),
+			},
+		},
+	}
+	bridge := NewBridge(cfg, source, nil, nil)
+	bridge.SetCallbacks(callbacks)
+	require.NoError(t, bridge.ProcessBlock(context.Background(), 1))
+
+	require.Len(t, received, 2)
+	require.Equal(t, CallbackPayload{
+		Kind:          "swap",
+		ID:            "7",
+		Service:       "did:sonr:acme",
+		DID:           "did:sonr:alice",
+		Status:        "complete",
+		ConnectionID:  "connection-0",
+		Sequence:      3,
+		TokenIn:       "1000uatom",
+		TokenOutDenom: "uosmo",
+		AmountOut:     "950",
+		RequestTxHash: "CD34",
+		ResultTxHash:  "AB12",
+		Height:        1,
+	}, received[0])
+	require.Equal(t, "order", received[1].Kind)
+	require.Equal(t, "4", received[1].ID)
+	require.Equal(t, "expired", received[1].Status)
+	require.Equal(t, uint64(2), received[1].Sequence)
+	require.Empty(t, received[1].ResultTxHash)
+}
+
+func TestCallbackConfigRequiresSecret(t *testing.T) {
+	_, err := ParseConfig([]byte(`callbacks: {services: {"did:sonr:acme": {url: "https://acme.example.com"}}}`))
+	require.ErrorContains(t, err, "secret is required")
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Sink types
+const (
+	// SinkWebhook POSTs each event as JSON to a URL
+	SinkWebhook = "webhook"
+	// SinkNATS publishes each event to a NATS subject
+	SinkNATS = "nats"
+	// SinkSQS sends each event to an Amazon SQS queue
+	SinkSQS = "sqs"
+)
+
+// Config is the bridge configuration, read from a YAML file
+type Config struct {
+	// Node is the CometBFT RPC endpoint events are read from
+	Node string `json:"node"`
+	// StateFile records the last processed height so restarts resume there
+	StateFile string `json:"state_file"`
+	// StartHeight is used when there is no state file; 0 starts at the
+	// latest block
+	StartHeight  int64    `json:"start_height"`
+	PollInterval Duration `json:"poll_interval"`
+	// MetricsAddr serves Prometheus metrics when set
+	MetricsAddr string `json:"metrics_addr"`
+
+	Retry  RetryConfig           `json:"retry"`
+	Sinks  map[string]SinkConfig `json:"sinks"`
+	Routes []RouteConfig         `json:"routes"`
+
+	// Notifications sends end-user notifications when set
+	Notifications *NotificationConfig `json:"notifications"`
+	// Callbacks calls services back when their swaps and orders settle
+	Callbacks *CallbackConfig `json:"callbacks"`
+}
+
+// RetryConfig controls redelivery of events a sink rejected
+type RetryConfig struct {
+	Attempts int      `json:"attempts"`
+	Backoff  Duration `json:"backoff"`
+}
+
+// SinkConfig configures one outbound destination
+type SinkConfig struct {
+	Type    string   `json:"type"`
+	Timeout Duration `json:"timeout"`
+
+	// Webhook settings; Secret signs the body with HMAC-SHA256
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Secret  string            `json:"secret"`
+
+	// NATS settings; URL is shared with webhooks. "{type}" in the subject
+	// is replaced with the event type.
+	Subject string `json:"subject"`
+
+	// SQS settings
+	QueueURL string `json:"queue_url"`
+	Region   string `json:"region"`
+}
+
+// RouteConfig maps matching events to sinks
+type RouteConfig struct {
+	Name string `json:"name"`
+	// Event is the event type to match; "*" matches any run of characters
+	Event string `json:"event"`
+	// Where requires attributes to match the given patterns
+	Where map[string]string `json:"where"`
+	Sinks []string          `json:"sinks"`
+}
+
+// CallbackConfig configures the services called back when the swaps and
+// orders they submitted settle
+type CallbackConfig struct {
+	// Services maps a service DID, the audience of the UCAN a swap or order
+	// was submitted with, to its endpoint
+	Services map[string]CallbackEndpoint `json:"services"`
+}
+
+// CallbackEndpoint is the webhook a service is called back on. Callbacks are
+// always signed with Secret.
+type CallbackEndpoint struct {
+	URL     string            `json:"url"`
+	Secret  string            `json:"secret"`
+	Headers map[string]string `json:"headers"`
+	Timeout Duration          `json:"timeout"`
+}
+
+// SinkConfig returns the webhook sink delivering to the endpoint
+func (e CallbackEndpoint) SinkConfig() SinkConfig {
+	return SinkConfig{Type: SinkWebhook, URL: e.URL, Secret: e.Secret, Headers: e.Headers, Timeout: e.Timeout}
+}
+
+// NotificationConfig configures end-user notifications
+type NotificationConfig struct {
+	// PreferencesFile is a JSON file of DID to notification preferences
+	PreferencesFile string `json:"preferences_file"`
+	// DigestFile records the notifications waiting for a digest
+	DigestFile string `json:"digest_file"`
+	// PushSink names the sink push notifications are sent through
+	PushSink string `json:"push_sink"`
+	// TemplatesDir holds templates that replace the built-in ones
+	TemplatesDir string `json:"templates_dir"`
+	// MessagesFile holds translations that replace or extend the built-in
+	// copy the templates render
+	MessagesFile string `json:"messages_file"`
+	// DefaultLanguage words notifications to users without a language
+	DefaultLanguage string             `json:"default_language"`
+	Email           EmailConfig        `json:"email"`
+	Rules           []NotificationRule `json:"rules"`
+}
+
+// EmailConfig configures the email provider
+type EmailConfig struct {
+	Provider string   `json:"provider"`
+	From     string   `json:"from"`
+	Timeout  Duration `json:"timeout"`
+
+	// SMTP settings
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// SES settings
+	Region string `json:"region"`
+}
+
+// NotificationRule maps matching events to a notification kind
+type NotificationRule struct {
+	// Event and Where match events as in routes
+	Event string            `json:"event"`
+	Where map[string]string `json:"where"`
+	Kind  string            `json:"kind"`
+	// Recipient is the attribute holding the DID to notify; defaults to "did"
+	Recipient string `json:"recipient"`
+}
+
+// RecipientAttribute returns the attribute holding the DID to notify
+func (r NotificationRule) RecipientAttribute() string {
+	if r.Recipient == "" {
+		return "did"
+	}
+	return r.Recipient
+}
+
+// Duration is a time.Duration written as a string such as "2s"
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// LoadConfig reads a YAML config file. ${VAR} references are expanded from
+// the environment so secrets can stay out of the file.
+func LoadConfig(path string) (*Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(bz)
+}
+
+// ParseConfig parses YAML config and applies defaults
+func ParseConfig(bz []byte) (*Config, error) {
+	cfg := &Config{
+		Node:         "tcp://localhost:26657",
+		StateFile:    "bridge.height",
+		PollInterval: Duration(2 * time.Second),
+		Retry:        RetryConfig{Attempts: 3, Backoff: Duration(time.Second)},
+	}
+	if err := yaml.UnmarshalStrict([]byte(os.ExpandEnv(string(bz))), cfg); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	for name, sink := range cfg.Sinks {
+		if sink.Timeout == 0 {
+			sink.Timeout = Duration(10 * time.Second)
+			cfg.Sinks[name] = sink
+		}
+	}
+	if c := cfg.Callbacks; c != nil {
+		for service, endpoint := range c.Services {
+			if endpoint.Timeout == 0 {
+				endpoint.Timeout = Duration(10 * time.Second)
+				c.Services[service] = endpoint
+			}
+		}
+	}
+	if n := cfg.Notifications; n != nil {
+		if n.DigestFile == "" {
+			n.DigestFile = "bridge.digests"
+		}
+		if n.Email.Timeout == 0 {
+			n.Email.Timeout = Duration(10 * time.Second)
+		}
+		if n.Email.Provider == EmailSMTP && n.Email.Port == 0 {
+			n.Email.Port = 587
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that every sink is complete and every route is deliverable
+func (c *Config) Validate() error {
+	if c.Node == "" {
+		return fmt.Errorf("node is required")
+	}
+	if c.PollInterval <= 0 {
+		return fmt.Errorf("poll interval must be positive")
+	}
+	if c.Retry.Attempts <= 0 {
+		return fmt.Errorf("retry attempts must be positive")
+	}
+
+	for name, sink := range c.Sinks {
+		switch sink.Type {
+		case SinkWebhook:
+			if !strings.HasPrefix(sink.URL, "http://") && !strings.HasPrefix(sink.URL, "https://") {
+				return fmt.Errorf("sink %s: webhook url must be http or https", name)
+			}
+		case SinkNATS:
+			if sink.URL == "" || sink.Subject == "" {
+				return fmt.Errorf("sink %s: nats requires url and subject", name)
+			}
+		case SinkSQS:
+			if sink.QueueURL == "" || sink.Region == "" {
+				return fmt.Errorf("sink %s: sqs requires queue_url and region", name)
+			}
+		default:
+			return fmt.Errorf("sink %s: unknown type %q", name, sink.Type)
+		}
+	}
+
+	if len(c.Routes) == 0 && (c.Notifications == nil || len(c.Notifications.Rules) == 0) &&
+		(c.Callbacks == nil || len(c.Callbacks.Services) == 0) {
+		return fmt.Errorf("at least one route, notification rule or callback is required")
+	}
+	seen := make(map[string]bool, len(c.Routes))
+	for i, route := range c.Routes {
+		if route.Name == "" {
+			return fmt.Errorf("route %d: name is required", i)
+		}
+		if seen[route.Name] {
+			return fmt.Errorf("route %s: duplicate name", route.Name)
+		}
+		seen[route.Name] = true
+		if route.Event == "" {
+			return fmt.Errorf("route %s: event is required", route.Name)
+		}
+		if len(route.Sinks) == 0 {
+			return fmt.Errorf("route %s: at least one sink is required", route.Name)
+		}
+		for _, sink := range route.Sinks {
+			if _, ok := c.Sinks[sink]; !ok {
+				return fmt.Errorf("route %s: unknown sink %q", route.Name, sink)
+			}
+		}
+	}
+
+	if c.Callbacks != nil {
+		for service, endpoint := range c.Callbacks.Services {
+			if !strings.HasPrefix(endpoint.URL, "http://") && !strings.HasPrefix(endpoint.URL, "https://") {
+				return fmt.Errorf("callback %s: url must be http or https", service)
+			}
+			if endpoint.Secret == "" {
+				return fmt.Errorf("callback %s: secret is required", service)
+			}
+		}
+	}
+	if c.Notifications != nil {
+		return c.validateNotifications()
+	}
+	return nil
+}
+
+func (c *Config) validateNotifications() error {
+	n := c.Notifications
+	if n.PreferencesFile == "" {
+		return fmt.Errorf("notifications: preferences_file is required")
+	}
+	if n.PushSink != "" {
+		if _, ok := c.Sinks[n.PushSink]; !ok {
+			return fmt.Errorf("notifications: unknown push sink %q", n.PushSink)
+		}
+	}
+
+	switch n.Email.Provider {
+	case EmailSMTP:
+		if n.Email.Host == "" {
+			return fmt.Errorf("notifications: smtp requires host")
+		}
+	case EmailSES:
+		if n.Email.Region == "" {
+			return fmt.Errorf("notifications: ses requires region")
+		}
+	default:
+		return fmt.Errorf("notifications: unknown email provider %q", n.Email.Provider)
+	}
+	if n.Email.From == "" {
+		return fmt.Errorf("notifications: email from is required")
+	}
+
+	for i, rule := range n.Rules {
+		if rule.Event == "" || rule.Kind == "" {
+			return fmt.Errorf("notification rule %d: event and kind are required", i)
+		}
+	}
+	return nil
+}
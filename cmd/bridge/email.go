@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+// Email providers
+const (
+	// EmailSMTP sends through an SMTP relay
+	EmailSMTP = "smtp"
+	// EmailSES sends through Amazon SES
+	EmailSES = "ses"
+)
+
+// Email is a plain text message to one recipient
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// EmailSender sends transactional emails
+type EmailSender interface {
+	SendEmail(ctx context.Context, email Email) error
+}
+
+// NewEmailSender creates the sender for the configured provider
+func NewEmailSender(cfg EmailConfig) (EmailSender, error) {
+	switch cfg.Provider {
+	case EmailSMTP:
+		return &SMTPSender{cfg: cfg}, nil
+	case EmailSES:
+		sess, err := session.NewSession(&aws.Config{Region: aws.String(cfg.Region)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create AWS session: %w", err)
+		}
+		return &SESSender{cfg: cfg, client: ses.New(sess)}, nil
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", cfg.Provider)
+	}
+}
+
+// SMTPSender sends emails through an SMTP relay, upgrading the connection
+// with STARTTLS when the server offers it
+type SMTPSender struct {
+	cfg EmailConfig
+}
+
+// SendEmail implements EmailSender
+func (s *SMTPSender) SendEmail(ctx context.Context, email Email) error {
+	from, err := mail.ParseAddress(s.cfg.From)
+	if err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	dialer := &net.Dialer{Timeout: time.Duration(s.cfg.Timeout)}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(time.Duration(s.cfg.Timeout)))
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.cfg.Host}); err != nil {
+			return err
+		}
+	}
+	if s.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(email.To); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(formatEmail(s.cfg.From, email)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// formatEmail renders the message with its headers
+func formatEmail(from string, email Email) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", email.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.Write(bytes.ReplaceAll([]byte(email.Body), []byte("\n"), []byte("\r\n")))
+	return buf.Bytes()
+}
+
+// SESSender sends emails through Amazon SES
+type SESSender struct {
+	cfg    EmailConfig
+	client *ses.SES
+}
+
+// SendEmail implements EmailSender
+func (s *SESSender) SendEmail(ctx context.Context, email Email) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(s.cfg.Timeout))
+	defer cancel()
+
+	_, err := s.client.SendEmailWithContext(ctx, &ses.SendEmailInput{
+		Source:      aws.String(s.cfg.From),
+		Destination: &ses.Destination{ToAddresses: []*string{aws.String(email.To)}},
+		Message: &ses.Message{
+			Subject: &ses.Content{Charset: aws.String("UTF-8"), Data: aws.String(email.Subject)},
+			Body: &ses.Body{
+				Text: &ses.Content{Charset: aws.String("UTF-8"), Data: aws.String(email.Body)},
+			},
+		},
+	})
+	return err
+}
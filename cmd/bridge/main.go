@@ -0,0 +1,114 @@
+// Command bridge forwards on-chain events to off-chain systems.
+//
+// It follows committed blocks over CometBFT RPC, matches each event against
+// the routes in a YAML config and delivers matches to webhooks, NATS
+// subjects or SQS queues, so operators can drive automation such as emails,
+// CRM updates or fraud checks without running an indexer.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("bridge", flag.ContinueOnError)
+	configPath := fs.String("config", envOr("BRIDGE_CONFIG", "bridge.yaml"), "path to the YAML routing config")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		return err
+	}
+
+	cfg, err := LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	sinks := make(map[string]Sink, len(cfg.Sinks))
+	for name, sinkCfg := range cfg.Sinks {
+		if sinks[name], err = NewSink(sinkCfg); err != nil {
+			return fmt.Errorf("sink %s: %w", name, err)
+		}
+	}
+	source, err := NewCometSource(cfg.Node)
+	if err != nil {
+		return err
+	}
+
+	reg := prometheus.NewRegistry()
+	metrics := NewMetrics(reg)
+	bridge := NewBridge(cfg, source, sinks, metrics)
+
+	if n := cfg.Notifications; n != nil {
+		prefs, err := NewPreferenceStore(n.PreferencesFile)
+		if err != nil {
+			return err
+		}
+		email, err := NewEmailSender(n.Email)
+		if err != nil {
+			return err
+		}
+		notifier, err := NewNotifier(n, cfg.Retry, prefs, email, sinks[n.PushSink], metrics)
+		if err != nil {
+			return err
+		}
+		bridge.SetNotifier(notifier)
+	}
+	if cfg.Callbacks != nil {
+		callbacks, err := NewCallbacks(cfg.Callbacks, cfg.Retry, metrics)
+		if err != nil {
+			return err
+		}
+		bridge.SetCallbacks(callbacks)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if cfg.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+		httpServer := &http.Server{
+			Addr:              cfg.MetricsAddr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}()
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = httpServer.Shutdown(shutdownCtx)
+		}()
+	}
+
+	fmt.Printf("bridge following %s with %d routes\n", cfg.Node, len(cfg.Routes))
+	return bridge.Run(ctx)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
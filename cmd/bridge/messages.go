@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+// defaultMessages is the built-in copy of notifications. Templates render it
+// with {{msg "key" "name" value ...}} in the recipient's language.
+var defaultMessages = map[string]msgcatalog.Translations{
+	"notify.credential_added.subject": {
+		"en": "A new passkey was added to your Sonr account",
+		"es": "Se agregó una nueva llave de acceso a tu cuenta de Sonr",
+		"fr": "Une nouvelle clé d'accès a été ajoutée à votre compte Sonr",
+	},
+	"notify.credential_added.body": {
+		"en": "A new credential was added to {did}.",
+		"es": "Se agregó una nueva credencial a {did}.",
+		"fr": "Un nouvel identifiant a été ajouté à {did}.",
+	},
+	"notify.credential_added.action": {
+		"en": "If you added this credential, no action is needed. If you did not, remove it\nfrom your account right away and review the devices that can sign for you.",
+		"es": "Si agregaste esta credencial, no tienes que hacer nada. Si no fuiste tú, elimínala\nde tu cuenta de inmediato y revisa los dispositivos que pueden firmar por ti.",
+		"fr": "Si vous avez ajouté cet identifiant, aucune action n'est nécessaire. Sinon, supprimez-le\nde votre compte immédiatement et vérifiez les appareils qui peuvent signer pour vous.",
+	},
+	"notify.new_device_login.subject": {
+		"en": "New device signed in to your Sonr account",
+		"es": "Un nuevo dispositivo inició sesión en tu cuenta de Sonr",
+		"fr": "Un nouvel appareil s'est connecté à votre compte Sonr",
+	},
+	"notify.new_device_login.body": {
+		"en": "A new device key was added to {did}, which lets that device sign in.",
+		"es": "Se agregó una nueva clave de dispositivo a {did}, que permite a ese dispositivo iniciar sesión.",
+		"fr": "Une nouvelle clé d'appareil a été ajoutée à {did}, ce qui permet à cet appareil de se connecter.",
+	},
+	"notify.new_device_login.action": {
+		"en": "If this was you, no action is needed. If you do not recognise this device,\nremove its key from your account right away.",
+		"es": "Si fuiste tú, no tienes que hacer nada. Si no reconoces este dispositivo,\nelimina su clave de tu cuenta de inmediato.",
+		"fr": "Si c'était vous, aucune action n'est nécessaire. Si vous ne reconnaissez pas cet appareil,\nsupprimez sa clé de votre compte immédiatement.",
+	},
+	"notify.activity.subject": {
+		"en": "Sonr account activity: {kind}",
+		"es": "Actividad de la cuenta de Sonr: {kind}",
+		"fr": "Activité du compte Sonr : {kind}",
+	},
+	"notify.activity.body": {
+		"en": "{event} at block {height} for {did}.",
+		"es": "{event} en el bloque {height} para {did}.",
+		"fr": "{event} au bloc {height} pour {did}.",
+	},
+	"notify.digest.subject": {
+		"en": "Your Sonr account activity ({count} updates)",
+		"es": "La actividad de tu cuenta de Sonr ({count} novedades)",
+		"fr": "L'activité de votre compte Sonr ({count} mises à jour)",
+	},
+	"notify.digest.body": {
+		"en": "Activity on {did} since {since}:",
+		"es": "Actividad en {did} desde {since}:",
+		"fr": "Activité sur {did} depuis {since} :",
+	},
+	"notify.digest.item": {
+		"en": "{subject} (block {height})",
+		"es": "{subject} (bloque {height})",
+		"fr": "{subject} (bloc {height})",
+	},
+	"notify.label.credential": {
+		"en": "Credential",
+		"es": "Credencial",
+		"fr": "Identifiant",
+	},
+	"notify.label.key": {
+		"en": "Key",
+		"es": "Clave",
+		"fr": "Clé",
+	},
+	"notify.label.block": {
+		"en": "Block",
+		"es": "Bloque",
+		"fr": "Bloc",
+	},
+	"notify.label.transaction": {
+		"en": "Transaction",
+		"es": "Transacción",
+		"fr": "Transaction",
+	},
+}
+
+// msgFunc returns the "msg" template function, which renders a message key
+// in langs with name/value argument pairs
+func msgFunc(messages *msgcatalog.Catalog, langs []string) func(key string, args ...any) (string, error) {
+	return func(key string, args ...any) (string, error) {
+		if len(args)%2 != 0 {
+			return "", fmt.Errorf("msg %s: arguments must be name/value pairs", key)
+		}
+		named := make(map[string]string, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			named[fmt.Sprint(args[i])] = fmt.Sprint(args[i+1])
+		}
+		return messages.Text(langs, key, named), nil
+	}
+}
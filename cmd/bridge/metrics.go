@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics are the Prometheus collectors exposed on /metrics
+type Metrics struct {
+	Height     prometheus.Gauge
+	Deliveries *prometheus.CounterVec
+	// Notifications counts end-user notifications by kind, channel and
+	// result
+	Notifications *prometheus.CounterVec
+	// Callbacks counts service callbacks by kind and result
+	Callbacks *prometheus.CounterVec
+}
+
+// NewMetrics creates and registers the bridge metrics
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		Height: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "sonr_bridge",
+			Name:      "height",
+			Help:      "Last block height processed.",
+		}),
+		Deliveries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sonr_bridge",
+			Name:      "deliveries_total",
+			Help:      "Event deliveries by route, sink and result.",
+		}, []string{"route", "sink", "result"}),
+		Notifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sonr_bridge",
+			Name:      "notifications_total",
+			Help:      "End-user notifications by kind, channel and result.",
+		}, []string{"kind", "channel", "result"}),
+		Callbacks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sonr_bridge",
+			Name:      "callbacks_total",
+			Help:      "Service callbacks by kind and result.",
+		}, []string{"kind", "result"}),
+	}
+
+	reg.MustRegister(m.Height, m.Deliveries, m.Notifications, m.Callbacks)
+	return m
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+// Security notification kinds. They are emailed by default and never wait
+// for a digest.
+const (
+	KindNewDeviceLogin  = "new_device_login"
+	KindCredentialAdded = "credential_added"
+)
+
+// IsSecurityKind reports whether a notification kind is a security event
+func IsSecurityKind(kind string) bool {
+	return kind == KindNewDeviceLogin || kind == KindCredentialAdded
+}
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Notification is a rendered message for one user
+type Notification struct {
+	Kind    string `json:"kind"`
+	DID     string `json:"did"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	Height  int64  `json:"height"`
+}
+
+// PushPayload is the body sent to the push sink
+type PushPayload struct {
+	Notification
+	PushToken string `json:"push_token"`
+	TxHash    string `json:"tx_hash,omitempty"`
+}
+
+// pendingDigest holds the emails waiting for a user's next digest
+type pendingDigest struct {
+	Since         time.Time      `json:"since"`
+	Notifications []Notification `json:"notifications"`
+}
+
+// Notifier turns events into end-user notifications. Rules pick the events
+// and the DID to notify; each user's preferences pick the channel. Emails
+// other than security notifications are batched into digests when the user
+// asks for one.
+type Notifier struct {
+	cfg       *NotificationConfig
+	retry     RetryConfig
+	prefs     *PreferenceStore
+	email     EmailSender
+	push      Sink
+	templates *template.Template
+	messages  *msgcatalog.Catalog
+	metrics   *Metrics
+
+	mu      sync.Mutex
+	digests map[string]*pendingDigest
+}
+
+// NewNotifier creates a notifier. push may be nil when no push sink is
+// configured. Queued digests are restored from the digest file.
+func NewNotifier(
+	cfg *NotificationConfig,
+	retry RetryConfig,
+	prefs *PreferenceStore,
+	email EmailSender,
+	push Sink,
+	metrics *Metrics,
+) (*Notifier, error) {
+	messages, err := msgcatalog.Load(msgcatalog.Config{
+		DefaultLanguage: cfg.DefaultLanguage,
+		OverridesFile:   cfg.MessagesFile,
+	}, defaultMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	// "msg" is bound to the recipient's languages when a template executes
+	tmpl, err := template.New("notification").
+		Funcs(template.FuncMap{"msg": msgFunc(messages, nil)}).
+		ParseFS(defaultTemplates, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TemplatesDir != "" {
+		// Templates in the directory replace the defaults of the same name
+		if tmpl, err = tmpl.ParseGlob(filepath.Join(cfg.TemplatesDir, "*.tmpl")); err != nil {
+			return nil, fmt.Errorf("failed to load templates: %w", err)
+		}
+	}
+
+	n := &Notifier{
+		cfg:       cfg,
+		retry:     retry,
+		prefs:     prefs,
+		email:     email,
+		push:      push,
+		templates: tmpl,
+		messages:  messages,
+		metrics:   metrics,
+		digests:   make(map[string]*pendingDigest),
+	}
+	bz, err := os.ReadFile(cfg.DigestFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(bz, &n.digests); err != nil {
+			return nil, fmt.Errorf("invalid digest file %s: %w", cfg.DigestFile, err)
+		}
+	}
+	return n, nil
+}
+
+// Handle notifies the users named by the rules an event matches
+func (n *Notifier) Handle(ctx context.Context, ev Event) {
+	for _, rule := range n.cfg.Rules {
+		if !matchEvent(rule.Event, rule.Where, ev) {
+			continue
+		}
+		did := ev.Attributes[rule.RecipientAttribute()]
+		if did == "" {
+			continue
+		}
+		n.notify(ctx, rule.Kind, did, ev)
+	}
+}
+
+func (n *Notifier) notify(ctx context.Context, kind, did string, ev Event) {
+	prefs, ok, err := n.prefs.Get(did)
+	if err != nil {
+		log.Printf("failed to reload notification preferences: %v", err)
+	}
+	if !ok {
+		return
+	}
+
+	channel := prefs.Channel(kind)
+	if channel == ChannelNone || (channel == ChannelEmail && prefs.Email == "") {
+		return
+	}
+	note, err := n.render(kind, did, ev, prefs.Languages())
+	if err != nil {
+		log.Printf("failed to render %s notification for %s: %v", kind, did, err)
+		return
+	}
+
+	switch {
+	case channel == ChannelPush && n.push == nil:
+		log.Printf("no push sink for %s notification to %s", kind, did)
+		return
+	case channel == ChannelPush:
+		payload, _ := json.Marshal(PushPayload{Notification: note, PushToken: prefs.PushToken, TxHash: ev.TxHash})
+		d := Delivery{Route: "notify:" + kind, Event: ev}
+		err = withRetry(ctx, n.retry, func() error { return n.push.Send(ctx, d, payload) })
+	case !IsSecurityKind(kind) && prefs.Digest.Period() > 0:
+		n.queueDigest(note)
+		n.record(kind, channel, "queued")
+		return
+	default:
+		email := Email{To: prefs.Email, Subject: note.Subject, Body: note.Body}
+		err = withRetry(ctx, n.retry, func() error { return n.email.SendEmail(ctx, email) })
+	}
+
+	result := "sent"
+	if err != nil {
+		result = "failed"
+		log.Printf("dropped %s notification to %s over %s: %v", kind, did, channel, err)
+	}
+	n.record(kind, channel, result)
+}
+
+// FlushDigests emails each user whose digest period has passed since their
+// oldest queued notification
+func (n *Notifier) FlushDigests(ctx context.Context, now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	dids := make([]string, 0, len(n.digests))
+	for did := range n.digests {
+		dids = append(dids, did)
+	}
+	sort.Strings(dids)
+
+	changed := false
+	for _, did := range dids {
+		digest := n.digests[did]
+		prefs, ok, _ := n.prefs.Get(did)
+		if ok && prefs.Email != "" && now.Sub(digest.Since) < prefs.Digest.Period() {
+			continue
+		}
+
+		// Users who removed their email or preferences get nothing
+		changed = true
+		delete(n.digests, did)
+		if !ok || prefs.Email == "" {
+			continue
+		}
+
+		var buf bytes.Buffer
+		data := struct {
+			DID           string
+			Since         time.Time
+			Notifications []Notification
+		}{did, digest.Since, digest.Notifications}
+		if err := n.execute(&buf, "digest.tmpl", data, prefs.Languages()); err != nil {
+			log.Printf("failed to render digest for %s: %v", did, err)
+			continue
+		}
+		subject, body := splitSubject(buf.String())
+		email := Email{To: prefs.Email, Subject: subject, Body: body}
+
+		result := "sent"
+		if err := withRetry(ctx, n.retry, func() error { return n.email.SendEmail(ctx, email) }); err != nil {
+			result = "failed"
+			log.Printf("dropped digest of %d notifications to %s: %v", len(digest.Notifications), did, err)
+		}
+		n.record("digest", ChannelEmail, result)
+	}
+
+	if changed {
+		n.saveDigests()
+	}
+}
+
+func (n *Notifier) queueDigest(note Notification) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	digest, ok := n.digests[note.DID]
+	if !ok {
+		digest = &pendingDigest{Since: time.Now()}
+		n.digests[note.DID] = digest
+	}
+	digest.Notifications = append(digest.Notifications, note)
+	n.saveDigests()
+}
+
+// saveDigests records the queued digests; the caller holds the lock
+func (n *Notifier) saveDigests() {
+	bz, err := json.Marshal(n.digests)
+	if err == nil {
+		err = writeFileAtomic(n.cfg.DigestFile, bz)
+	}
+	if err != nil {
+		log.Printf("failed to save digests: %v", err)
+	}
+}
+
+// render executes the template of a kind in langs, falling back to the
+// generic one
+func (n *Notifier) render(kind, did string, ev Event, langs []string) (Notification, error) {
+	name := kind + ".tmpl"
+	if n.templates.Lookup(name) == nil {
+		name = "notification.tmpl"
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		Kind  string
+		DID   string
+		Event Event
+	}{kind, did, ev}
+	if err := n.execute(&buf, name, data, langs); err != nil {
+		return Notification{}, err
+	}
+
+	subject, body := splitSubject(buf.String())
+	return Notification{Kind: kind, DID: did, Subject: subject, Body: body, Height: ev.Height}, nil
+}
+
+// execute runs a template with its copy worded in langs
+func (n *Notifier) execute(w io.Writer, name string, data any, langs []string) error {
+	tmpl, err := n.templates.Clone()
+	if err != nil {
+		return err
+	}
+	tmpl.Funcs(template.FuncMap{"msg": msgFunc(n.messages, langs)})
+	return tmpl.ExecuteTemplate(w, name, data)
+}
+
+func (n *Notifier) record(kind string, channel Channel, result string) {
+	if n.metrics != nil {
+		n.metrics.Notifications.WithLabelValues(kind, string(channel), result).Inc()
+	}
+}
+
+// splitSubject splits rendered template output into the "Subject:" line and
+// the body that follows the first blank line
+func splitSubject(s string) (subject, body string) {
+	head, body, _ := strings.Cut(s, "\n\n")
+	subject = strings.TrimSpace(strings.TrimPrefix(head, "Subject:"))
+	return subject, strings.TrimSpace(body) + "\n"
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testNotificationConfig = `
+notifications:
+  preferences_file: prefs.json
+  push_sink: push
+  email:
+    provider: smtp
+    host: smtp.example.com
+    from: Sonr <no-reply@sonr.io>
+  rules:
+    - event: did.v1.EventWebAuthnRegistered
+      kind: credential_added
+    - event: did.v1.EventVerificationMethodAdded
+      kind: new_device_login
+    - event: dex.v1.EventSwapExecuted
+      kind: swap_executed
+sinks:
+  push:
+    type: webhook
+    url: https://push.example.com
+`
+
+// fakeEmailSender records the emails it was asked to send
+type fakeEmailSender struct {
+	mu   sync.Mutex
+	sent []Email
+}
+
+func (s *fakeEmailSender) SendEmail(_ context.Context, email Email) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, email)
+	return nil
+}
+
+// fakeSink records the payloads it was sent
+type fakeSink struct {
+	payloads [][]byte
+}
+
+func (s *fakeSink) Send(_ context.Context, _ Delivery, payload []byte) error {
+	s.payloads = append(s.payloads, payload)
+	return nil
+}
+
+func setupNotifier(t *testing.T, prefs map[string]Preferences) (*Notifier, *fakeEmailSender, *fakeSink) {
+	t.Helper()
+	cfg, err := ParseConfig([]byte(testNotificationConfig))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	cfg.Notifications.PreferencesFile = filepath.Join(dir, "prefs.json")
+	cfg.Notifications.DigestFile = filepath.Join(dir, "bridge.digests")
+	bz, err := json.Marshal(prefs)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfg.Notifications.PreferencesFile, bz, 0o600))
+
+	store, err := NewPreferenceStore(cfg.Notifications.PreferencesFile)
+	require.NoError(t, err)
+	email, push := &fakeEmailSender{}, &fakeSink{}
+	notifier, err := NewNotifier(cfg.Notifications, cfg.Retry, store, email, push, nil)
+	require.NoError(t, err)
+	return notifier, email, push
+}
+
+func TestNotificationConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(testNotificationConfig))
+	require.NoError(t, err, "notification rules stand in for routes")
+	require.Equal(t, 587, cfg.Notifications.Email.Port)
+	require.Equal(t, "did", cfg.Notifications.Rules[0].RecipientAttribute())
+
+	_, err = ParseConfig([]byte("notifications: {preferences_file: p.json, email: {provider: fax, from: a@b.c}, rules: [{event: x, kind: y}]}"))
+	require.ErrorContains(t, err, "unknown email provider")
+
+	_, err = ParseConfig([]byte("notifications: {preferences_file: p.json, push_sink: apns, email: {provider: ses, region: us-east-1, from: a@b.c}, rules: [{event: x, kind: y}]}"))
+	require.ErrorContains(t, err, "unknown push sink")
+}
+
+func TestSecurityNotificationsAreEmailedImmediately(t *testing.T) {
+	notifier, email, _ := setupNotifier(t, map[string]Preferences{
+		"did:sonr:alice": {Email: "alice@example.com", Digest: DigestWeekly},
+		"did:sonr:bob":   {Email: "bob@example.com", Channels: map[string]Channel{KindCredentialAdded: ChannelNone}},
+	})
+	ctx := context.Background()
+
+	notifier.Handle(ctx, NewEvent(7, "AB12", "did.v1.EventWebAuthnRegistered", [][2]string{
+		{"did", `"did:sonr:alice"`}, {"credential_id", `"cred-1"`},
+	}))
+	notifier.Handle(ctx, NewEvent(7, "CD34", "did.v1.EventWebAuthnRegistered", [][2]string{
+		{"did", `"did:sonr:bob"`}, {"credential_id", `"cred-2"`},
+	}))
+	notifier.Handle(ctx, NewEvent(8, "", "did.v1.EventVerificationMethodAdded", [][2]string{
+		{"did", `"did:sonr:carol"`}, {"method_id", `"key-1"`},
+	}))
+
+	require.Len(t, email.sent, 1, "bob opted out and carol has no preferences")
+	require.Equal(t, "alice@example.com", email.sent[0].To)
+	require.Equal(t, "A new passkey was added to your Sonr account", email.sent[0].Subject)
+	require.Contains(t, email.sent[0].Body, "cred-1")
+	require.Contains(t, email.sent[0].Body, "AB12")
+}
+
+func TestDigestQueuesAndFlushes(t *testing.T) {
+	notifier, email, _ := setupNotifier(t, map[string]Preferences{
+		"did:sonr:alice": {
+			Email:    "alice@example.com",
+			Channels: map[string]Channel{"swap_executed": ChannelEmail},
+			Digest:   DigestDaily,
+		},
+	})
+	ctx := context.Background()
+
+	for h := int64(1); h <= 2; h++ {
+		notifier.Handle(ctx, NewEvent(h, "", "dex.v1.EventSwapExecuted", [][2]string{{"did", "did:sonr:alice"}}))
+	}
+	notifier.FlushDigests(ctx, time.Now())
+	require.Empty(t, email.sent, "the digest waits for its period")
+
+	// Queued notifications survive a restart
+	restarted, err := NewNotifier(notifier.cfg, notifier.retry, notifier.prefs, email, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, restarted.digests["did:sonr:alice"].Notifications, 2)
+
+	restarted.FlushDigests(ctx, time.Now().Add(25*time.Hour))
+	require.Len(t, email.sent, 1)
+	require.Equal(t, "Your Sonr account activity (2 updates)", email.sent[0].Subject)
+	require.Contains(t, email.sent[0].Body, "block 2")
+	require.Empty(t, restarted.digests)
+}
+
+func TestPushNotification(t *testing.T) {
+	notifier, email, push := setupNotifier(t, map[string]Preferences{
+		"did:sonr:alice": {
+			PushToken: "device-token",
+			Channels:  map[string]Channel{KindNewDeviceLogin: ChannelPush},
+		},
+	})
+
+	notifier.Handle(context.Background(), NewEvent(3, "EF56", "did.v1.EventVerificationMethodAdded", [][2]string{
+		{"did", "did:sonr:alice"}, {"method_id", "key-2"},
+	}))
+
+	require.Empty(t, email.sent)
+	require.Len(t, push.payloads, 1)
+	var payload PushPayload
+	require.NoError(t, json.Unmarshal(push.payloads[0], &payload))
+	require.Equal(t, "device-token", payload.PushToken)
+	require.Equal(t, KindNewDeviceLogin, payload.Kind)
+	require.Equal(t, "EF56", payload.TxHash)
+	require.Equal(t, "New device signed in to your Sonr account", payload.Subject)
+}
+
+func TestPreferencesValidate(t *testing.T) {
+	require.NoError(t, Preferences{Email: "alice@example.com", Digest: DigestDaily}.Validate())
+	require.Error(t, Preferences{Email: "not an address"}.Validate())
+	require.Error(t, Preferences{Channels: map[string]Channel{"x": "sms"}}.Validate())
+	require.Error(t, Preferences{Digest: "hourly"}.Validate())
+}
+
+func TestNotificationLanguage(t *testing.T) {
+	notifier, email, _ := setupNotifier(t, map[string]Preferences{
+		"did:sonr:alice": {Email: "alice@example.com", Language: "es-MX"},
+		"did:sonr:bob":   {Email: "bob@example.com", Language: "ja"},
+	})
+
+	for _, did := range []string{"did:sonr:alice", "did:sonr:bob"} {
+		notifier.Handle(context.Background(), NewEvent(9, "", "did.v1.EventWebAuthnRegistered", [][2]string{
+			{"did", did}, {"credential_id", "cred-1"},
+		}))
+	}
+
+	require.Len(t, email.sent, 2)
+	require.Equal(t, "Se agregó una nueva llave de acceso a tu cuenta de Sonr", email.sent[0].Subject)
+	require.Contains(t, email.sent[0].Body, "Credencial: cred-1")
+	require.Equal(t, "A new passkey was added to your Sonr account", email.sent[1].Subject,
+		"languages without a translation fall back to English")
+}
+
+func TestNotificationMessageOverrides(t *testing.T) {
+	cfg, err := ParseConfig([]byte(testNotificationConfig))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	cfg.Notifications.PreferencesFile = filepath.Join(dir, "prefs.json")
+	cfg.Notifications.DigestFile = filepath.Join(dir, "bridge.digests")
+	cfg.Notifications.MessagesFile = filepath.Join(dir, "messages.yaml")
+	require.NoError(t, os.WriteFile(cfg.Notifications.PreferencesFile,
+		[]byte(`{"did:sonr:alice": {"email": "alice@example.com"}}`), 0o600))
+	require.NoError(t, os.WriteFile(cfg.Notifications.MessagesFile, []byte(`
+notify.credential_added.subject:
+  en: A new passkey was added to your Acme Wallet
+`), 0o600))
+
+	store, err := NewPreferenceStore(cfg.Notifications.PreferencesFile)
+	require.NoError(t, err)
+	email := &fakeEmailSender{}
+	notifier, err := NewNotifier(cfg.Notifications, cfg.Retry, store, email, nil, nil)
+	require.NoError(t, err)
+
+	notifier.Handle(context.Background(), NewEvent(9, "", "did.v1.EventWebAuthnRegistered", [][2]string{
+		{"did", "did:sonr:alice"}, {"credential_id", "cred-1"},
+	}))
+	require.Len(t, email.sent, 1)
+	require.Equal(t, "A new passkey was added to your Acme Wallet", email.sent[0].Subject)
+	require.Contains(t, email.sent[0].Body, "A new credential was added to did:sonr:alice.",
+		"keys without an override keep the built-in copy")
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"os"
+	"sync"
+	"time"
+)
+
+// Channel is how a user wants to hear about a kind of notification
+type Channel string
+
+// Notification channels
+const (
+	ChannelNone  Channel = "none"
+	ChannelEmail Channel = "email"
+	ChannelPush  Channel = "push"
+)
+
+// DigestFrequency controls how often non-security emails are batched
+type DigestFrequency string
+
+// Digest frequencies
+const (
+	DigestImmediate DigestFrequency = "immediate"
+	DigestDaily     DigestFrequency = "daily"
+	DigestWeekly    DigestFrequency = "weekly"
+)
+
+// Period returns how long notifications wait for the digest; zero sends them
+// at once
+func (f DigestFrequency) Period() time.Duration {
+	switch f {
+	case DigestDaily:
+		return 24 * time.Hour
+	case DigestWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Preferences are one user's notification settings
+type Preferences struct {
+	Email string `json:"email"`
+	// PushToken is passed to the push sink to address the user's devices
+	PushToken string `json:"push_token"`
+	// Channels maps a notification kind to a channel
+	Channels map[string]Channel `json:"channels"`
+	Digest   DigestFrequency    `json:"digest"`
+	// Language is the language tag notifications are worded in, e.g. "es"
+	Language string `json:"language"`
+}
+
+// Languages returns the languages to word the user's notifications in
+func (p Preferences) Languages() []string {
+	if p.Language == "" {
+		return nil
+	}
+	return []string{p.Language}
+}
+
+// Channel returns the channel for a kind. Security notifications default to
+// email; other kinds are not sent unless the user asks for them.
+func (p Preferences) Channel(kind string) Channel {
+	if ch, ok := p.Channels[kind]; ok {
+		return ch
+	}
+	if IsSecurityKind(kind) {
+		return ChannelEmail
+	}
+	return ChannelNone
+}
+
+// Validate checks the channels, digest frequency and email address
+func (p Preferences) Validate() error {
+	if p.Email != "" {
+		if _, err := mail.ParseAddress(p.Email); err != nil {
+			return fmt.Errorf("invalid email: %w", err)
+		}
+	}
+	for kind, ch := range p.Channels {
+		switch ch {
+		case ChannelNone, ChannelEmail, ChannelPush:
+		default:
+			return fmt.Errorf("%s: unknown channel %q", kind, ch)
+		}
+	}
+	switch p.Digest {
+	case "", DigestImmediate, DigestDaily, DigestWeekly:
+	default:
+		return fmt.Errorf("unknown digest frequency %q", p.Digest)
+	}
+	return nil
+}
+
+// PreferenceStore serves preferences from a JSON file of DID to Preferences.
+// The file is re-read when its modification time changes, so preferences can
+// be updated without restarting the bridge.
+type PreferenceStore struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	prefs   map[string]Preferences
+}
+
+// NewPreferenceStore loads the preferences file at path
+func NewPreferenceStore(path string) (*PreferenceStore, error) {
+	s := &PreferenceStore{path: path}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get returns the preferences of a DID. A file that fails to reload keeps the
+// previous preferences in use.
+func (s *PreferenceStore) Get(did string) (Preferences, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.reload()
+	p, ok := s.prefs[did]
+	return p, ok, err
+}
+
+func (s *PreferenceStore) reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	if s.prefs != nil && info.ModTime().Equal(s.modTime) {
+		return nil
+	}
+
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	var prefs map[string]Preferences
+	if err := json.Unmarshal(bz, &prefs); err != nil {
+		return fmt.Errorf("invalid preferences file %s: %w", s.path, err)
+	}
+	for did, p := range prefs {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("preferences of %s: %w", did, err)
+		}
+	}
+
+	s.prefs, s.modTime = prefs, info.ModTime()
+	return nil
+}
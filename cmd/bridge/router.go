@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Event is a single on-chain event
+type Event struct {
+	Height int64 `json:"height"`
+	// TxHash is empty for events emitted outside transactions, such as in
+	// BeginBlock and EndBlock
+	TxHash     string            `json:"tx_hash,omitempty"`
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// NewEvent builds an event from raw attributes. Typed events JSON-encode
+// their attribute values, so quoted strings are unquoted to keep routes and
+// payloads readable.
+func NewEvent(height int64, txHash, typ string, attrs [][2]string) Event {
+	ev := Event{Height: height, TxHash: txHash, Type: typ, Attributes: make(map[string]string, len(attrs))}
+	for _, kv := range attrs {
+		value := kv[1]
+		if strings.HasPrefix(value, `"`) {
+			var s string
+			if err := json.Unmarshal([]byte(value), &s); err == nil {
+				value = s
+			}
+		}
+		ev.Attributes[kv[0]] = value
+	}
+	return ev
+}
+
+// Router matches events against the configured routes
+type Router struct {
+	routes []RouteConfig
+}
+
+// NewRouter creates a router over routes, which are matched in order
+func NewRouter(routes []RouteConfig) *Router {
+	return &Router{routes: routes}
+}
+
+// Match returns the routes an event matches
+func (r *Router) Match(ev Event) []RouteConfig {
+	var matched []RouteConfig
+	for _, route := range r.routes {
+		if matchEvent(route.Event, route.Where, ev) {
+			matched = append(matched, route)
+		}
+	}
+	return matched
+}
+
+// matchEvent reports whether an event has a type matching event and
+// attributes matching every pattern in where
+func matchEvent(event string, where map[string]string, ev Event) bool {
+	if !glob(event, ev.Type) {
+		return false
+	}
+	for key, pattern := range where {
+		value, found := ev.Attributes[key]
+		if !found || !glob(pattern, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// glob reports whether s matches pattern, where "*" matches any run of
+// characters, including none
+func glob(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, last)
+}
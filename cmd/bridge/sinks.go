@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of a webhook body when the
+// sink has a secret
+const SignatureHeader = "X-Sonr-Signature"
+
+// Delivery is the message sent to a sink
+type Delivery struct {
+	Route string `json:"route"`
+	Event
+}
+
+// Sink delivers events to an external system
+type Sink interface {
+	Send(ctx context.Context, d Delivery, payload []byte) error
+}
+
+// NewSink creates the sink described by cfg
+func NewSink(cfg SinkConfig) (Sink, error) {
+	switch cfg.Type {
+	case SinkWebhook:
+		return &WebhookSink{cfg: cfg, client: &http.Client{Timeout: time.Duration(cfg.Timeout)}}, nil
+	case SinkNATS:
+		return &NATSSink{cfg: cfg}, nil
+	case SinkSQS:
+		sess, err := session.NewSession(&aws.Config{Region: aws.String(cfg.Region)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create AWS session: %w", err)
+		}
+		return &SQSSink{cfg: cfg, client: sqs.New(sess)}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", cfg.Type)
+	}
+}
+
+// WebhookSink POSTs deliveries as JSON
+type WebhookSink struct {
+	cfg    SinkConfig
+	client *http.Client
+}
+
+// Send POSTs the payload and treats any non-2xx response as a failure
+func (s *WebhookSink) Send(ctx context.Context, d Delivery, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	if s.cfg.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(s.cfg.Secret, payload))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of payload, as sent in SignatureHeader
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SQSSink sends deliveries to an SQS queue
+type SQSSink struct {
+	cfg    SinkConfig
+	client *sqs.SQS
+}
+
+// Send enqueues the payload with the event type and route as message attributes
+func (s *SQSSink) Send(ctx context.Context, d Delivery, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(s.cfg.Timeout))
+	defer cancel()
+
+	_, err := s.client.SendMessageWithContext(ctx, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(s.cfg.QueueURL),
+		MessageBody: aws.String(string(payload)),
+		MessageAttributes: map[string]*sqs.MessageAttributeValue{
+			"event_type": {DataType: aws.String("String"), StringValue: aws.String(d.Type)},
+			"route":      {DataType: aws.String("String"), StringValue: aws.String(d.Route)},
+		},
+	})
+	return err
+}
+
+// NATSSink publishes deliveries over the NATS client protocol. It speaks
+// just enough of the protocol to publish: CONNECT, PUB and PING/PONG, with
+// user/password or token credentials taken from the URL. A tls:// URL
+// connects over TLS.
+type NATSSink struct {
+	cfg SinkConfig
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// Send publishes the payload and waits for the server to confirm it was
+// processed. The connection is dropped on any error and reopened on the
+// next send.
+func (s *NATSSink) Send(ctx context.Context, d Delivery, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+	if err := s.publish(ctx, strings.ReplaceAll(s.cfg.Subject, "{type}", d.Type), payload); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (s *NATSSink) connect(ctx context.Context) error {
+	u, err := url.Parse(s.cfg.URL)
+	if err != nil {
+		return fmt.Errorf("invalid nats url: %w", err)
+	}
+
+	dialer := &net.Dialer{Timeout: time.Duration(s.cfg.Timeout)}
+	var conn net.Conn
+	if u.Scheme == "tls" {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: u.Hostname()}}).DialContext(ctx, "tcp", u.Host)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", u.Host)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(time.Duration(s.cfg.Timeout)))
+	r := bufio.NewReader(conn)
+
+	// The server greets with INFO before accepting CONNECT
+	line, err := r.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		conn.Close()
+		return fmt.Errorf("unexpected nats greeting %q: %v", strings.TrimSpace(line), err)
+	}
+
+	opts := map[string]any{"verbose": false, "pedantic": false, "name": "sonr-bridge", "lang": "go"}
+	if u.User != nil {
+		if pass, ok := u.User.Password(); ok {
+			opts["user"], opts["pass"] = u.User.Username(), pass
+		} else {
+			opts["auth_token"] = u.User.Username()
+		}
+	}
+	bz, _ := json.Marshal(opts)
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\n", bz); err != nil {
+		conn.Close()
+		return err
+	}
+
+	s.conn, s.r = conn, r
+	return nil
+}
+
+func (s *NATSSink) publish(ctx context.Context, subject string, payload []byte) error {
+	deadline := time.Now().Add(time.Duration(s.cfg.Timeout))
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = s.conn.SetDeadline(deadline)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "PUB %s %d\r\n", subject, len(payload))
+	buf.Write(payload)
+	buf.WriteString("\r\nPING\r\n")
+	if _, err := s.conn.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	// The server processes commands in order, so the PONG confirms the
+	// publish; an -ERR means it was rejected
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := io.WriteString(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
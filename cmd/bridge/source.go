@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+)
+
+// Source reads the events of committed blocks
+type Source interface {
+	// Latest returns the height of the latest committed block
+	Latest(ctx context.Context) (int64, error)
+	// Events returns the events of the block at height, in emission order
+	Events(ctx context.Context, height int64) ([]Event, error)
+}
+
+// CometSource reads events from a CometBFT RPC endpoint
+type CometSource struct {
+	client *rpchttp.HTTP
+}
+
+// NewCometSource connects to the RPC endpoint at node
+func NewCometSource(node string) (*CometSource, error) {
+	client, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+	return &CometSource{client: client}, nil
+}
+
+// Latest implements Source
+func (s *CometSource) Latest(ctx context.Context) (int64, error) {
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// Events implements Source. Events of failed transactions are skipped, since
+// their state changes were reverted.
+func (s *CometSource) Events(ctx context.Context, height int64) ([]Event, error) {
+	block, err := s.client.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block %d: %w", height, err)
+	}
+	results, err := s.client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block results %d: %w", height, err)
+	}
+
+	var events []Event
+	for i, tx := range results.TxsResults {
+		if tx.Code != 0 || i >= len(block.Block.Txs) {
+			continue
+		}
+		hash := fmt.Sprintf("%X", block.Block.Txs[i].Hash())
+		for _, ev := range tx.Events {
+			attrs := make([][2]string, 0, len(ev.Attributes))
+			for _, attr := range ev.Attributes {
+				attrs = append(attrs, [2]string{attr.Key, attr.Value})
+			}
+			events = append(events, NewEvent(height, hash, ev.Type, attrs))
+		}
+	}
+	for _, ev := range results.FinalizeBlockEvents {
+		attrs := make([][2]string, 0, len(ev.Attributes))
+		for _, attr := range ev.Attributes {
+			attrs = append(attrs, [2]string{attr.Key, attr.Value})
+		}
+		events = append(events, NewEvent(height, "", ev.Type, attrs))
+	}
+	return events, nil
+}
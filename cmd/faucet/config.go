@@ -0,0 +1,157 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+// Gate modes control what a requester must prove before being funded
+const (
+	// ModeOpen funds any valid address, subject to rate limits (localnet and e2e)
+	ModeOpen = "open"
+	// ModeCaptcha requires a valid captcha response token
+	ModeCaptcha = "captcha"
+	// ModeDiscord requires a request relayed by the Discord bot with its shared token
+	ModeDiscord = "discord"
+	// ModeWebAuthn requires the address to control a DID with a WebAuthn credential
+	ModeWebAuthn = "webauthn"
+)
+
+// Config holds the faucet configuration
+type Config struct {
+	ListenAddr string
+	Mode       string
+
+	// Chain and signer settings used to send funds
+	Binary         string
+	Home           string
+	ChainID        string
+	Node           string
+	KeyName        string
+	KeyringBackend string
+	Fees           string
+
+	// RESTEndpoint is used by the WebAuthn gate to resolve DIDs
+	RESTEndpoint string
+
+	// DefaultCoins are sent when a request does not specify coins
+	DefaultCoins sdk.Coins
+	// MaxCoins caps what a single request may receive per denom
+	MaxCoins sdk.Coins
+
+	// Rate limits
+	AddressCooldown time.Duration
+	IPCooldown      time.Duration
+	IPBurst         int
+
+	// Gate secrets
+	CaptchaSecret    string
+	CaptchaVerifyURL string
+	DiscordToken     string
+
+	// Messages selects the language and overrides of response messages
+	Messages msgcatalog.Config
+}
+
+// LoadConfig reads the configuration from flags, falling back to FAUCET_* environment variables
+func LoadConfig(args []string) (*Config, error) {
+	fs := flag.NewFlagSet("faucet", flag.ContinueOnError)
+	cfg := &Config{}
+
+	fs.StringVar(&cfg.ListenAddr, "listen", env("FAUCET_LISTEN", ":8000"), "HTTP listen address")
+	fs.StringVar(&cfg.Mode, "mode", env("FAUCET_MODE", ModeOpen), "gate mode: open, captcha, discord or webauthn")
+	fs.StringVar(&cfg.Binary, "binary", env("FAUCET_BINARY", "snrd"), "path to the snrd binary")
+	fs.StringVar(&cfg.Home, "home", env("FAUCET_HOME", ""), "snrd home directory holding the faucet key")
+	fs.StringVar(&cfg.ChainID, "chain-id", env("FAUCET_CHAIN_ID", "sonrtest_1-1"), "chain ID")
+	fs.StringVar(&cfg.Node, "node", env("FAUCET_NODE", "tcp://localhost:26657"), "CometBFT RPC endpoint")
+	fs.StringVar(&cfg.KeyName, "key", env("FAUCET_KEY", "faucet"), "name of the funding key")
+	fs.StringVar(&cfg.KeyringBackend, "keyring-backend", env("FAUCET_KEYRING_BACKEND", "test"), "keyring backend")
+	fs.StringVar(&cfg.Fees, "fees", env("FAUCET_FEES", "5000usnr"), "fees paid per funding transaction")
+	fs.StringVar(&cfg.RESTEndpoint, "rest", env("FAUCET_REST", "http://localhost:1317"), "REST endpoint for DID lookups")
+	fs.StringVar(&cfg.CaptchaSecret, "captcha-secret", env("FAUCET_CAPTCHA_SECRET", ""), "captcha secret key")
+	fs.StringVar(&cfg.CaptchaVerifyURL, "captcha-verify-url",
+		env("FAUCET_CAPTCHA_VERIFY_URL", "https://hcaptcha.com/siteverify"), "captcha verification endpoint")
+	fs.StringVar(&cfg.DiscordToken, "discord-token", env("FAUCET_DISCORD_TOKEN", ""), "shared token of the Discord bot")
+	fs.StringVar(&cfg.Messages.DefaultLanguage, "language",
+		env("FAUCET_LANGUAGE", msgcatalog.DefaultLanguage), "language of responses when the request asks for none we have")
+	fs.StringVar(&cfg.Messages.OverridesFile, "messages", env("FAUCET_MESSAGES", ""),
+		"YAML or JSON file of message key to translations that replace the built-in responses")
+
+	defaultCoins := fs.String("coins", env("FAUCET_COINS", "10000000usnr"), "coins sent by default")
+	maxCoins := fs.String("max-coins", env("FAUCET_MAX_COINS", "100000000usnr"), "maximum coins per request")
+	addrCooldown := fs.Duration("address-cooldown", envDuration("FAUCET_ADDRESS_COOLDOWN", 24*time.Hour),
+		"minimum time between fundings of the same address")
+	ipCooldown := fs.Duration("ip-cooldown", envDuration("FAUCET_IP_COOLDOWN", time.Hour),
+		"window for per-IP request limits")
+	fs.IntVar(&cfg.IPBurst, "ip-burst", envInt("FAUCET_IP_BURST", 5), "requests allowed per IP per window")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if cfg.DefaultCoins, err = sdk.ParseCoinsNormalized(*defaultCoins); err != nil {
+		return nil, fmt.Errorf("invalid default coins: %w", err)
+	}
+	if cfg.MaxCoins, err = sdk.ParseCoinsNormalized(*maxCoins); err != nil {
+		return nil, fmt.Errorf("invalid max coins: %w", err)
+	}
+	cfg.AddressCooldown = *addrCooldown
+	cfg.IPCooldown = *ipCooldown
+
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that the selected mode has the secrets it needs
+func (c *Config) Validate() error {
+	switch c.Mode {
+	case ModeOpen, ModeWebAuthn:
+	case ModeCaptcha:
+		if c.CaptchaSecret == "" {
+			return fmt.Errorf("captcha mode requires a captcha secret")
+		}
+	case ModeDiscord:
+		if c.DiscordToken == "" {
+			return fmt.Errorf("discord mode requires a discord token")
+		}
+	default:
+		return fmt.Errorf("unknown mode %q", c.Mode)
+	}
+
+	if !c.DefaultCoins.IsAllLTE(c.MaxCoins) {
+		return fmt.Errorf("default coins %s exceed max coins %s", c.DefaultCoins, c.MaxCoins)
+	}
+	if c.IPBurst <= 0 {
+		return fmt.Errorf("ip burst must be positive")
+	}
+	return nil
+}
+
+func env(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func envDuration(key string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return fallback
+}
+
+func envInt(key string, fallback int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return n
+	}
+	return fallback
+}
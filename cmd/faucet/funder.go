@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Funder sends coins from the faucet key using the snrd CLI. Sends are
+// serialized so consecutive transactions never race on the account sequence.
+type Funder struct {
+	cfg *Config
+	mu  sync.Mutex
+}
+
+// NewFunder creates a funder
+func NewFunder(cfg *Config) *Funder {
+	return &Funder{cfg: cfg}
+}
+
+// Send transfers coins to the address and returns the transaction hash
+func (f *Funder) Send(ctx context.Context, address string, coins sdk.Coins) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	args := []string{
+		"tx", "bank", "send", f.cfg.KeyName, address, coins.String(),
+		"--chain-id", f.cfg.ChainID,
+		"--node", f.cfg.Node,
+		"--keyring-backend", f.cfg.KeyringBackend,
+		"--fees", f.cfg.Fees,
+		"--broadcast-mode", "sync",
+		"--output", "json",
+		"--yes",
+	}
+	if f.cfg.Home != "" {
+		args = append(args, "--home", f.cfg.Home)
+	}
+
+	out, err := exec.CommandContext(ctx, f.cfg.Binary, args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("send failed: %s", exitErr.Stderr)
+		}
+		return "", fmt.Errorf("send failed: %w", err)
+	}
+
+	var resp struct {
+		TxHash string `json:"txhash"`
+		Code   uint32 `json:"code"`
+		RawLog string `json:"raw_log"`
+	}
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return "", fmt.Errorf("failed to decode send output: %w", err)
+	}
+	if resp.Code != 0 {
+		return resp.TxHash, fmt.Errorf("send rejected with code %d: %s", resp.Code, resp.RawLog)
+	}
+
+	return resp.TxHash, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrGateRejected is returned when a requester fails the configured gate
+var ErrGateRejected = errors.New("request rejected by faucet gate")
+
+// Gate checks that a request is allowed before funds are sent. It returns the
+// key used for cooldown tracking, which defaults to the recipient address.
+type Gate interface {
+	Check(ctx context.Context, r *http.Request, req *CreditRequest) (string, error)
+}
+
+// NewGate returns the gate for the configured mode
+func NewGate(cfg *Config) Gate {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	switch cfg.Mode {
+	case ModeCaptcha:
+		return &captchaGate{secret: cfg.CaptchaSecret, verifyURL: cfg.CaptchaVerifyURL, client: httpClient}
+	case ModeDiscord:
+		return &discordGate{token: cfg.DiscordToken}
+	case ModeWebAuthn:
+		return &webAuthnGate{restEndpoint: strings.TrimRight(cfg.RESTEndpoint, "/"), client: httpClient}
+	default:
+		return openGate{}
+	}
+}
+
+// openGate accepts every request
+type openGate struct{}
+
+func (openGate) Check(_ context.Context, _ *http.Request, req *CreditRequest) (string, error) {
+	return req.Address, nil
+}
+
+// captchaGate verifies an hCaptcha/Turnstile style response token
+type captchaGate struct {
+	secret    string
+	verifyURL string
+	client    *http.Client
+}
+
+func (g *captchaGate) Check(ctx context.Context, r *http.Request, req *CreditRequest) (string, error) {
+	if req.CaptchaToken == "" {
+		return "", rejected(MsgCaptchaRequired, nil)
+	}
+
+	form := url.Values{
+		"secret":   {g.secret},
+		"response": {req.CaptchaToken},
+		"remoteip": {clientIP(r)},
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, g.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := g.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("captcha verification failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode captcha response: %w", err)
+	}
+	if !result.Success {
+		return "", rejected(MsgCaptchaInvalid, nil)
+	}
+
+	return req.Address, nil
+}
+
+// discordGate accepts requests relayed by the Discord bot and rate limits per Discord user
+type discordGate struct {
+	token string
+}
+
+func (g *discordGate) Check(_ context.Context, r *http.Request, req *CreditRequest) (string, error) {
+	bearer := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(bearer), []byte(g.token)) != 1 {
+		return "", rejected(MsgBotTokenInvalid, nil)
+	}
+	if req.DiscordUserID == "" {
+		return "", rejected(MsgDiscordUserRequired, nil)
+	}
+	return "discord:" + req.DiscordUserID, nil
+}
+
+// webAuthnGate only funds addresses that control a DID with a WebAuthn verification method
+type webAuthnGate struct {
+	restEndpoint string
+	client       *http.Client
+}
+
+func (g *webAuthnGate) Check(ctx context.Context, _ *http.Request, req *CreditRequest) (string, error) {
+	endpoint := fmt.Sprintf("%s/did/v1/documents/controller/%s", g.restEndpoint, url.PathEscape(req.Address))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := g.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("DID lookup failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", rejected(MsgNoDID, map[string]string{"address": req.Address})
+	}
+
+	var result struct {
+		DidDocuments []struct {
+			Id                 string `json:"id"`
+			VerificationMethod []struct {
+				WebauthnCredential *json.RawMessage `json:"webauthn_credential"`
+			} `json:"verification_method"`
+		} `json:"did_documents"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode DID documents: %w", err)
+	}
+
+	for _, doc := range result.DidDocuments {
+		for _, vm := range doc.VerificationMethod {
+			if vm.WebauthnCredential != nil {
+				// Rate limit per DID so one identity cannot drain via many addresses
+				return doc.Id, nil
+			}
+		}
+	}
+
+	return "", rejected(MsgNoWebAuthnDID, map[string]string{"address": req.Address})
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter tracks per-address cooldowns and per-IP request windows
+type Limiter struct {
+	mu sync.Mutex
+
+	addressCooldown time.Duration
+	ipWindow        time.Duration
+	ipBurst         int
+
+	lastFunded map[string]time.Time
+	ipRequests map[string][]time.Time
+}
+
+// NewLimiter creates a limiter
+func NewLimiter(addressCooldown, ipWindow time.Duration, ipBurst int) *Limiter {
+	return &Limiter{
+		addressCooldown: addressCooldown,
+		ipWindow:        ipWindow,
+		ipBurst:         ipBurst,
+		lastFunded:      make(map[string]time.Time),
+		ipRequests:      make(map[string][]time.Time),
+	}
+}
+
+// AllowIP records a request from ip and reports whether it is within the burst limit
+func (l *Limiter) AllowIP(ip string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := now.Add(-l.ipWindow)
+	recent := l.ipRequests[ip][:0]
+	for _, t := range l.ipRequests[ip] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+
+	if len(recent) >= l.ipBurst {
+		l.ipRequests[ip] = recent
+		return false
+	}
+	l.ipRequests[ip] = append(recent, now)
+	return true
+}
+
+// AddressReadyAt returns when the key (an address or external user ID) may be funded again
+func (l *Limiter) AddressReadyAt(key string) time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	last, ok := l.lastFunded[key]
+	if !ok {
+		return time.Time{}
+	}
+	return last.Add(l.addressCooldown)
+}
+
+// MarkFunded starts the cooldown for the key
+func (l *Limiter) MarkFunded(key string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastFunded[key] = now
+}
+
+// Prune drops entries whose windows have expired
+func (l *Limiter) Prune(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, last := range l.lastFunded {
+		if now.Sub(last) > l.addressCooldown {
+			delete(l.lastFunded, key)
+		}
+	}
+	for ip, times := range l.ipRequests {
+		if len(times) == 0 || now.Sub(times[len(times)-1]) > l.ipWindow {
+			delete(l.ipRequests, ip)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Command faucet runs an HTTP faucet that funds testnet accounts.
+//
+// It serves the Starship-compatible POST /credit endpoint used by the e2e
+// harness, applies per-IP and per-address rate limits, optionally gates
+// requests behind a captcha, the Discord bot or a WebAuthn-backed DID, and
+// exposes Prometheus metrics on /metrics.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/sonr-io/sonr/app"
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(app.Bech32PrefixAccAddr, app.Bech32PrefixAccPub)
+	config.Seal()
+
+	cfg, err := LoadConfig(os.Args[1:])
+	if err != nil {
+		return err
+	}
+
+	messages, err := msgcatalog.Load(cfg.Messages, defaultMessages)
+	if err != nil {
+		return err
+	}
+
+	reg := prometheus.NewRegistry()
+	server := NewServer(cfg, messages, NewMetrics(reg))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/credit", server.HandleCredit)
+	mux.HandleFunc("/status", server.HandleStatus)
+	mux.Handle("/messages", msgcatalog.Handler(messages))
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+
+	httpServer := &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				server.limiter.Prune(now)
+			}
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("faucet listening on %s (mode=%s)\n", cfg.ListenAddr, cfg.Mode)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+// Message keys of the failures reported by POST /credit
+const (
+	MsgPostRequired        = "faucet.post_required"
+	MsgIPLimited           = "faucet.ip_limited"
+	MsgInvalidBody         = "faucet.invalid_body"
+	MsgInvalidAddress      = "faucet.invalid_address"
+	MsgInvalidCoins        = "faucet.invalid_coins"
+	MsgCoinsOverLimit      = "faucet.coins_over_limit"
+	MsgCaptchaRequired     = "faucet.captcha_required"
+	MsgCaptchaInvalid      = "faucet.captcha_invalid"
+	MsgBotTokenInvalid     = "faucet.bot_token_invalid"
+	MsgDiscordUserRequired = "faucet.discord_user_required"
+	MsgNoDID               = "faucet.no_did"
+	MsgNoWebAuthnDID       = "faucet.no_webauthn_did"
+	MsgGateUnavailable     = "faucet.gate_unavailable"
+	MsgCooldown            = "faucet.cooldown"
+	MsgSendFailed          = "faucet.send_failed"
+)
+
+// defaultMessages is the built-in copy of the faucet's responses
+var defaultMessages = map[string]msgcatalog.Translations{
+	MsgPostRequired: {
+		"en": "POST required",
+		"es": "Se requiere POST",
+		"fr": "POST requis",
+	},
+	MsgIPLimited: {
+		"en": "too many requests from this IP",
+		"es": "demasiadas solicitudes desde esta IP",
+		"fr": "trop de demandes depuis cette IP",
+	},
+	MsgInvalidBody: {
+		"en": "invalid request body",
+		"es": "cuerpo de la solicitud no válido",
+		"fr": "corps de la demande invalide",
+	},
+	MsgInvalidAddress: {
+		"en": "invalid address",
+		"es": "dirección no válida",
+		"fr": "adresse invalide",
+	},
+	MsgInvalidCoins: {
+		"en": "invalid coins: {error}",
+		"es": "monedas no válidas: {error}",
+		"fr": "montants invalides : {error}",
+	},
+	MsgCoinsOverLimit: {
+		"en": "{coin} exceeds the faucet limit of {limit}",
+		"es": "{coin} supera el límite del faucet de {limit}",
+		"fr": "{coin} dépasse la limite du faucet de {limit}",
+	},
+	MsgCaptchaRequired: {
+		"en": "captcha token required",
+		"es": "se requiere el token del captcha",
+		"fr": "jeton captcha requis",
+	},
+	MsgCaptchaInvalid: {
+		"en": "invalid captcha",
+		"es": "captcha no válido",
+		"fr": "captcha invalide",
+	},
+	MsgBotTokenInvalid: {
+		"en": "invalid bot token",
+		"es": "token del bot no válido",
+		"fr": "jeton du bot invalide",
+	},
+	MsgDiscordUserRequired: {
+		"en": "discord user ID required",
+		"es": "se requiere el ID de usuario de Discord",
+		"fr": "identifiant utilisateur Discord requis",
+	},
+	MsgNoDID: {
+		"en": "no DID controlled by {address}",
+		"es": "{address} no controla ningún DID",
+		"fr": "aucun DID contrôlé par {address}",
+	},
+	MsgNoWebAuthnDID: {
+		"en": "{address} has no WebAuthn-backed DID",
+		"es": "{address} no tiene un DID respaldado por WebAuthn",
+		"fr": "{address} n'a pas de DID protégé par WebAuthn",
+	},
+	MsgGateUnavailable: {
+		"en": "could not verify the request: {error}",
+		"es": "no se pudo verificar la solicitud: {error}",
+		"fr": "impossible de vérifier la demande : {error}",
+	},
+	MsgCooldown: {
+		"en": "already funded recently",
+		"es": "ya recibió fondos recientemente",
+		"fr": "déjà financé récemment",
+	},
+	MsgSendFailed: {
+		"en": "failed to send funds: {error}",
+		"es": "no se pudieron enviar los fondos: {error}",
+		"fr": "échec de l'envoi des fonds : {error}",
+	},
+}
+
+// Message is a failure reported to the requester as a message key, rendered
+// in their language when the response is written
+type Message struct {
+	Key  string
+	Args map[string]string
+	// Err is the cause the message stands for, such as ErrGateRejected
+	Err error
+}
+
+// Error renders the message in the default language for logs
+func (m *Message) Error() string {
+	return msgcatalog.Format(defaultMessages[m.Key][msgcatalog.DefaultLanguage], m.Args)
+}
+
+// Unwrap returns the cause of the message
+func (m *Message) Unwrap() error {
+	return m.Err
+}
+
+// rejected returns a gate rejection reported with the message key
+func rejected(key string, args map[string]string) error {
+	return &Message{Key: key, Args: args, Err: ErrGateRejected}
+}
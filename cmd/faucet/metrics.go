@@ -0,0 +1,37 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics are the Prometheus collectors exposed on /metrics
+type Metrics struct {
+	Requests    *prometheus.CounterVec
+	FundedCoins *prometheus.CounterVec
+	TxLatency   prometheus.Histogram
+}
+
+// NewMetrics creates and registers the faucet metrics
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		Requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sonr_faucet",
+			Name:      "requests_total",
+			Help:      "Credit requests by gate mode and result.",
+		}, []string{"mode", "result"}),
+		FundedCoins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "sonr_faucet",
+			Name:      "funded_amount_total",
+			Help:      "Amount sent by the faucet per denom.",
+		}, []string{"denom"}),
+		TxLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "sonr_faucet",
+			Name:      "send_duration_seconds",
+			Help:      "Time taken to submit a funding transaction.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+	}
+
+	reg.MustRegister(m.Requests, m.FundedCoins, m.TxLatency)
+	return m
+}
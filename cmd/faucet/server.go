@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+// CreditRequest is the body of POST /credit. It matches the Starship faucet
+// API used by the e2e harness, plus optional gate fields.
+type CreditRequest struct {
+	Address       string   `json:"address"`
+	Coins         []string `json:"coins,omitempty"`
+	CaptchaToken  string   `json:"captcha_token,omitempty"`
+	DiscordUserID string   `json:"discord_user_id,omitempty"`
+}
+
+// CreditResponse is the response of POST /credit. Failures carry the message
+// key in Code and its text in the requester's language in Error.
+type CreditResponse struct {
+	Status     string `json:"status"`
+	TxHash     string `json:"tx_hash,omitempty"`
+	Code       string `json:"code,omitempty"`
+	Error      string `json:"error,omitempty"`
+	RetryAfter int64  `json:"retry_after,omitempty"`
+}
+
+// Server is the faucet HTTP server
+type Server struct {
+	cfg      *Config
+	gate     Gate
+	limiter  *Limiter
+	funder   *Funder
+	messages *msgcatalog.Catalog
+	metrics  *Metrics
+}
+
+// NewServer creates a faucet server that words its failures with messages
+func NewServer(cfg *Config, messages *msgcatalog.Catalog, metrics *Metrics) *Server {
+	return &Server{
+		cfg:      cfg,
+		gate:     NewGate(cfg),
+		limiter:  NewLimiter(cfg.AddressCooldown, cfg.IPCooldown, cfg.IPBurst),
+		funder:   NewFunder(cfg),
+		messages: messages,
+		metrics:  metrics,
+	}
+}
+
+// HandleCredit funds an address after applying rate limits and the configured gate
+func (s *Server) HandleCredit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.fail(w, r, http.StatusMethodNotAllowed, "invalid", &Message{Key: MsgPostRequired}, CreditResponse{})
+		return
+	}
+
+	now := time.Now()
+	if !s.limiter.AllowIP(clientIP(r), now) {
+		s.fail(w, r, http.StatusTooManyRequests, "ip_limited", &Message{Key: MsgIPLimited}, CreditResponse{
+			RetryAfter: int64(s.cfg.IPCooldown.Seconds()),
+		})
+		return
+	}
+
+	var req CreditRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4096)).Decode(&req); err != nil {
+		s.fail(w, r, http.StatusBadRequest, "invalid", &Message{Key: MsgInvalidBody}, CreditResponse{})
+		return
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		s.fail(w, r, http.StatusBadRequest, "invalid", &Message{Key: MsgInvalidAddress}, CreditResponse{})
+		return
+	}
+
+	coins, msg := s.requestedCoins(req.Coins)
+	if msg != nil {
+		s.fail(w, r, http.StatusBadRequest, "invalid", msg, CreditResponse{})
+		return
+	}
+
+	key, err := s.gate.Check(r.Context(), r, &req)
+	if err != nil {
+		status := http.StatusBadGateway
+		if errors.Is(err, ErrGateRejected) {
+			status = http.StatusForbidden
+		}
+		if !errors.As(err, &msg) {
+			msg = &Message{Key: MsgGateUnavailable, Args: map[string]string{"error": err.Error()}, Err: err}
+		}
+		s.fail(w, r, status, "rejected", msg, CreditResponse{})
+		return
+	}
+
+	for _, k := range []string{key, req.Address} {
+		if readyAt := s.limiter.AddressReadyAt(k); now.Before(readyAt) {
+			s.fail(w, r, http.StatusTooManyRequests, "cooldown", &Message{Key: MsgCooldown}, CreditResponse{
+				RetryAfter: int64(readyAt.Sub(now).Seconds()),
+			})
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	txHash, err := s.funder.Send(ctx, req.Address, coins)
+	s.metrics.TxLatency.Observe(time.Since(start).Seconds())
+	if err != nil {
+		msg := &Message{Key: MsgSendFailed, Args: map[string]string{"error": err.Error()}, Err: err}
+		s.fail(w, r, http.StatusInternalServerError, "failed", msg, CreditResponse{TxHash: txHash})
+		return
+	}
+
+	s.limiter.MarkFunded(key, now)
+	s.limiter.MarkFunded(req.Address, now)
+	for _, c := range coins {
+		amount, _ := c.Amount.ToLegacyDec().Float64()
+		s.metrics.FundedCoins.WithLabelValues(c.Denom).Add(amount)
+	}
+
+	s.reply(w, http.StatusOK, "success", CreditResponse{Status: "success", TxHash: txHash})
+}
+
+// HandleStatus reports the faucet mode and limits
+func (s *Server) HandleStatus(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"mode":             s.cfg.Mode,
+		"chain_id":         s.cfg.ChainID,
+		"default_coins":    s.cfg.DefaultCoins.String(),
+		"max_coins":        s.cfg.MaxCoins.String(),
+		"address_cooldown": s.cfg.AddressCooldown.String(),
+	})
+}
+
+// requestedCoins parses the requested coins and enforces the per-request cap
+func (s *Server) requestedCoins(raw []string) (sdk.Coins, *Message) {
+	if len(raw) == 0 {
+		return s.cfg.DefaultCoins, nil
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(strings.Join(raw, ","))
+	if err != nil {
+		return nil, &Message{Key: MsgInvalidCoins, Args: map[string]string{"error": err.Error()}, Err: err}
+	}
+	for _, c := range coins {
+		if limit := s.cfg.MaxCoins.AmountOf(c.Denom); c.Amount.GT(limit) {
+			return nil, &Message{Key: MsgCoinsOverLimit, Args: map[string]string{
+				"coin":  c.String(),
+				"limit": limit.String() + c.Denom,
+			}}
+		}
+	}
+	return coins, nil
+}
+
+// fail writes an error response worded in the languages the request asks for
+func (s *Server) fail(w http.ResponseWriter, r *http.Request, status int, result string, msg *Message, resp CreditResponse) {
+	resp.Status = "error"
+	resp.Code = msg.Key
+	resp.Error = s.messages.Text(msgcatalog.RequestLanguages(r), msg.Key, msg.Args)
+	s.reply(w, status, result, resp)
+}
+
+func (s *Server) reply(w http.ResponseWriter, status int, result string, resp CreditResponse) {
+	s.metrics.Requests.WithLabelValues(s.cfg.Mode, result).Inc()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// clientIP returns the requester IP, honoring the first X-Forwarded-For hop
+func clientIP(r *http.Request) string {
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		return strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -13,8 +13,15 @@ import (
 
 	"github.com/sonr-io/sonr/app"
 	util "github.com/sonr-io/sonr/app/commands"
+	"github.com/sonr-io/sonr/app/errreport"
+	"github.com/sonr-io/sonr/app/msgcatalog"
+	"github.com/sonr-io/sonr/app/nodeprofile"
+	"github.com/sonr-io/sonr/app/screening"
 	didcli "github.com/sonr-io/sonr/x/did/client/cli"
+	"github.com/sonr-io/sonr/x/dwn/client/attachments"
 	dwncli "github.com/sonr-io/sonr/x/dwn/client/cli"
+	"github.com/sonr-io/sonr/x/dwn/client/gateway"
+	"github.com/sonr-io/sonr/x/dwn/client/pincheck"
 
 	"cosmossdk.io/log"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
@@ -45,6 +52,8 @@ func AddCustomCommands(rootCmd *cobra.Command) {
 	didcli.AddAuthCmds(rootCmd)
 	dwncli.AddWalletCmds(rootCmd)
 	rootCmd.AddCommand(util.GovCmd())
+	rootCmd.AddCommand(util.LegacyCmd())
+	rootCmd.AddCommand(util.VerifyBinaryCmd())
 
 	// Add VRF keys management to keys command
 	keysCmd := findKeysCommand(rootCmd)
@@ -81,6 +90,14 @@ type CustomAppConfig struct {
 	EVM     evmosserverconfig.EVMConfig
 	JSONRPC evmosserverconfig.JSONRPCConfig
 	TLS     evmosserverconfig.TLSConfig
+
+	ErrorReporting errreport.Config          `mapstructure:"error-reporting"`
+	Messages       msgcatalog.Config         `mapstructure:"messages"`
+	Screening      screening.Config          `mapstructure:"screening"`
+	Node           nodeprofile.Config        `mapstructure:"node"`
+	VaultPins      pincheck.Config           `mapstructure:"vault-pins"`
+	Attachments    attachments.ServiceConfig `mapstructure:"attachments"`
+	IPFSGateways   gateway.ServiceConfig     `mapstructure:"ipfs-gateways"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -111,12 +128,34 @@ func initAppConfig() (string, any) {
 		EVM:     *evmosserverconfig.DefaultEVMConfig(),
 		JSONRPC: *evmosserverconfig.DefaultJSONRPCConfig(),
 		TLS:     *evmosserverconfig.DefaultTLSConfig(),
+
+		ErrorReporting: errreport.DefaultConfig(),
+		Messages:       msgcatalog.DefaultConfig(),
+		Screening:      screening.DefaultConfig(),
+		Node:           nodeprofile.DefaultConfig(),
+		VaultPins:      pincheck.DefaultConfig(),
+		Attachments:    attachments.DefaultServiceConfig(),
+		IPFSGateways:   gateway.DefaultServiceConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate
 
 	customAppTemplate += evmosserverconfig.DefaultEVMConfigTemplate
 
+	customAppTemplate += errreport.ConfigTemplate
+
+	customAppTemplate += msgcatalog.ConfigTemplate
+
+	customAppTemplate += screening.ConfigTemplate
+
+	customAppTemplate += nodeprofile.ConfigTemplate
+
+	customAppTemplate += pincheck.ConfigTemplate
+
+	customAppTemplate += attachments.ConfigTemplate
+
+	customAppTemplate += gateway.ConfigTemplate
+
 	return customAppTemplate, customAppConfig
 }
 
@@ -189,6 +228,7 @@ func queryCommand() *cobra.Command {
 		authcmd.QueryTxCmd(),
 		server.QueryBlocksCmd(),
 		server.QueryBlockResultsCmd(),
+		util.GasPriceCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -215,6 +255,8 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		util.SignOfflineCmd(),
+		util.QRCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -229,8 +271,28 @@ func newApp(
 	traceStore io.Writer,
 	appOpts servertypes.AppOptions,
 ) servertypes.Application {
+	// The node profile overrides the pruning and cache settings read below
+	appOpts, profile, err := nodeprofile.Apply(nodeprofile.ConfigFromAppOptions(appOpts), appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if profile != nil {
+		logger.Info("node profile enabled",
+			"profile", profile.Name,
+			"memory_target", profile.MemoryTarget,
+			"disk_target", profile.DiskTarget,
+		)
+	}
+
 	baseappOptions := server.DefaultBaseappOptions(appOpts)
 
+	reportCfg := errreport.ConfigFromAppOptions(appOpts, errreport.Release("snrd", Version))
+	if err := errreport.Init(reportCfg); err != nil {
+		logger.Error("error reporting disabled", "error", err)
+	} else if reportCfg.Active() {
+		logger.Info("error reporting enabled", "environment", reportCfg.Environment, "release", reportCfg.Release)
+	}
+
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
 		// TODO: Implement telemetry configuration
 		// This should set up telemetry options such as:
@@ -8,17 +8,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sonr-io/sonr/app"
+	"github.com/sonr-io/sonr/app/errreport"
 )
 
 func main() {
+	// Report crashes when error reporting was enabled in app.toml
+	defer errreport.RecoverAndRepanic()
+
 	setupSDKConfig()
 
 	// Standard snrd execution
 	rootCmd := NewRootCmd()
 	if err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome); err != nil {
 		fmt.Fprintln(rootCmd.OutOrStderr(), err)
+		errreport.Flush()
 		os.Exit(1)
 	}
+	errreport.Flush()
 }
 
 func setupSDKConfig() {
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// Status is the health of a component, from best to worst
+type Status string
+
+const (
+	// StatusOperational means the component works as expected
+	StatusOperational Status = "operational"
+	// StatusDegraded means the component works but is slow or lagging
+	StatusDegraded Status = "degraded"
+	// StatusDown means the component failed its check
+	StatusDown Status = "down"
+)
+
+// Worse reports whether s is worse than other
+func (s Status) Worse(other Status) bool {
+	return s.rank() > other.rank()
+}
+
+func (s Status) rank() int {
+	switch s {
+	case StatusOperational:
+		return 0
+	case StatusDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Result is the outcome of one run of a check
+type Result struct {
+	Name      string        `json:"name"`
+	Group     string        `json:"group"`
+	Status    Status        `json:"status"`
+	Detail    string        `json:"detail,omitempty"`
+	Latency   time.Duration `json:"latency_ns"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+// Check probes one component
+type Check interface {
+	Run(ctx context.Context) Result
+}
+
+// BlockSource reports the latest committed block
+type BlockSource interface {
+	LatestBlock(ctx context.Context) (height int64, at time.Time, err error)
+}
+
+// CometBlocks reads the latest block from a CometBFT RPC endpoint
+type CometBlocks struct {
+	client *rpchttp.HTTP
+}
+
+// NewCometBlocks connects to the RPC endpoint at node
+func NewCometBlocks(node string) (*CometBlocks, error) {
+	client, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+	return &CometBlocks{client: client}, nil
+}
+
+// LatestBlock implements BlockSource
+func (c *CometBlocks) LatestBlock(ctx context.Context) (int64, time.Time, error) {
+	status, err := c.client.Status(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return status.SyncInfo.LatestBlockHeight, status.SyncInfo.LatestBlockTime, nil
+}
+
+// BlockProductionCheck reports the chain down when no block was committed
+// within MaxAge, and degraded past half of it
+type BlockProductionCheck struct {
+	Source BlockSource
+	MaxAge time.Duration
+	now    func() time.Time
+}
+
+// Run implements Check
+func (c BlockProductionCheck) Run(ctx context.Context) Result {
+	res := Result{Name: "Block production", Group: "chain"}
+	start := time.Now()
+	height, at, err := c.Source.LatestBlock(ctx)
+	res.Latency = time.Since(start)
+	if err != nil {
+		res.Status, res.Detail = StatusDown, err.Error()
+		return res
+	}
+
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	age := now().Sub(at)
+	res.Detail = fmt.Sprintf("height %d, %s ago", height, age.Round(time.Second))
+	switch {
+	case age > c.MaxAge:
+		res.Status = StatusDown
+	case age > c.MaxAge/2:
+		res.Status = StatusDegraded
+	default:
+		res.Status = StatusOperational
+	}
+	return res
+}
+
+// RelayerLagCheck reports how many packets sent on a channel are still
+// waiting for the relayer to bring back an acknowledgement
+type RelayerLagCheck struct {
+	Client     channeltypes.QueryClient
+	Channel    ChannelConfig
+	MaxPending uint64
+}
+
+// Run implements Check
+func (c RelayerLagCheck) Run(ctx context.Context) Result {
+	name := c.Channel.Name
+	if name == "" {
+		name = c.Channel.Port + "/" + c.Channel.Channel
+	}
+	res := Result{Name: name, Group: "ibc"}
+	start := time.Now()
+	resp, err := c.Client.PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+		PortId:     c.Channel.Port,
+		ChannelId:  c.Channel.Channel,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	res.Latency = time.Since(start)
+	if err != nil {
+		res.Status, res.Detail = StatusDown, err.Error()
+		return res
+	}
+
+	var pending uint64
+	if resp.Pagination != nil {
+		pending = resp.Pagination.Total
+	}
+	res.Detail = fmt.Sprintf("%d packets awaiting acknowledgement", pending)
+	switch {
+	case pending > 2*c.MaxPending:
+		res.Status = StatusDown
+	case pending > c.MaxPending:
+		res.Status = StatusDegraded
+	default:
+		res.Status = StatusOperational
+	}
+	return res
+}
+
+// HTTPCheck probes an HTTP endpoint. Non-2xx responses and errors report the
+// component down; answers slower than MaxLatency report it degraded.
+type HTTPCheck struct {
+	Name       string
+	Group      string
+	Method     string
+	URL        string
+	MaxLatency time.Duration
+	Client     *http.Client
+}
+
+// NewIPFSPinCheck measures how long the IPFS node takes to confirm it pins
+// the probe CID
+func NewIPFSPinCheck(cfg IPFSConfig) HTTPCheck {
+	return HTTPCheck{
+		Name:       "IPFS pin latency",
+		Group:      "storage",
+		Method:     http.MethodPost,
+		URL:        cfg.API + "/api/v0/pin/ls?type=recursive&arg=" + url.QueryEscape(cfg.ProbeCID),
+		MaxLatency: time.Duration(cfg.MaxLatency),
+		Client:     &http.Client{Timeout: time.Duration(cfg.Timeout)},
+	}
+}
+
+// NewHighwayCheck probes a highway health endpoint
+func NewHighwayCheck(cfg HTTPCheckConfig) HTTPCheck {
+	return HTTPCheck{
+		Name:       cfg.Name,
+		Group:      "highway",
+		Method:     http.MethodGet,
+		URL:        cfg.URL,
+		MaxLatency: time.Duration(cfg.MaxLatency),
+		Client:     &http.Client{Timeout: time.Duration(cfg.Timeout)},
+	}
+}
+
+// Run implements Check
+func (c HTTPCheck) Run(ctx context.Context) Result {
+	res := Result{Name: c.Name, Group: c.Group}
+	req, err := http.NewRequestWithContext(ctx, c.Method, c.URL, nil)
+	if err != nil {
+		res.Status, res.Detail = StatusDown, err.Error()
+		return res
+	}
+
+	start := time.Now()
+	resp, err := c.Client.Do(req)
+	res.Latency = time.Since(start)
+	if err != nil {
+		res.Status, res.Detail = StatusDown, err.Error()
+		return res
+	}
+	resp.Body.Close()
+
+	res.Detail = fmt.Sprintf("HTTP %d in %s", resp.StatusCode, res.Latency.Round(time.Millisecond))
+	switch {
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		res.Status = StatusDown
+	case res.Latency > c.MaxLatency:
+		res.Status = StatusDegraded
+	default:
+		res.Status = StatusOperational
+	}
+	return res
+}
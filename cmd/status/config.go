@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Config is the status service configuration, read from a YAML file
+type Config struct {
+	// ListenAddr serves the page and the JSON API
+	ListenAddr string `json:"listen_addr"`
+	// Title heads the status page
+	Title string `json:"title"`
+	// Interval is how often every check runs
+	Interval Duration `json:"interval"`
+	// AdminToken is the bearer token required to post incidents; incident
+	// annotation is disabled when empty
+	AdminToken string `json:"admin_token"`
+	// IncidentsFile keeps incidents across restarts
+	IncidentsFile string `json:"incidents_file"`
+
+	Chain   ChainConfig       `json:"chain"`
+	Relayer *RelayerConfig    `json:"relayer"`
+	IPFS    *IPFSConfig       `json:"ipfs"`
+	Highway []HTTPCheckConfig `json:"highway"`
+}
+
+// ChainConfig checks block production
+type ChainConfig struct {
+	// Node is the CometBFT RPC endpoint
+	Node string `json:"node"`
+	// MaxBlockAge is how old the latest block may be before the chain is
+	// reported down; half of it reports the chain degraded
+	MaxBlockAge Duration `json:"max_block_age"`
+}
+
+// RelayerConfig checks IBC relayer lag on channels
+type RelayerConfig struct {
+	// GRPC is the node's gRPC endpoint
+	GRPC     string          `json:"grpc"`
+	Channels []ChannelConfig `json:"channels"`
+	// MaxPending is how many packets may wait for acknowledgement before a
+	// channel is reported degraded; twice as many report it down
+	MaxPending uint64 `json:"max_pending"`
+}
+
+// ChannelConfig names an IBC channel
+type ChannelConfig struct {
+	Name    string `json:"name"`
+	Port    string `json:"port"`
+	Channel string `json:"channel"`
+}
+
+// IPFSConfig checks IPFS pin latency
+type IPFSConfig struct {
+	// API is the Kubo RPC endpoint, e.g. http://localhost:5001
+	API string `json:"api"`
+	// ProbeCID is a CID the node keeps pinned
+	ProbeCID   string   `json:"probe_cid"`
+	MaxLatency Duration `json:"max_latency"`
+	Timeout    Duration `json:"timeout"`
+}
+
+// HTTPCheckConfig checks an HTTP health endpoint
+type HTTPCheckConfig struct {
+	Name       string   `json:"name"`
+	URL        string   `json:"url"`
+	MaxLatency Duration `json:"max_latency"`
+	Timeout    Duration `json:"timeout"`
+}
+
+// Duration is a time.Duration written as a string such as "2s"
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// LoadConfig reads a YAML config file. ${VAR} references are expanded from
+// the environment so secrets can stay out of the file.
+func LoadConfig(path string) (*Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(bz)
+}
+
+// ParseConfig parses YAML config and applies defaults
+func ParseConfig(bz []byte) (*Config, error) {
+	cfg := &Config{
+		ListenAddr:    ":8090",
+		Title:         "Sonr Status",
+		Interval:      Duration(30 * time.Second),
+		IncidentsFile: "status.incidents.json",
+		Chain: ChainConfig{
+			Node:        "tcp://localhost:26657",
+			MaxBlockAge: Duration(time.Minute),
+		},
+	}
+	if err := yaml.UnmarshalStrict([]byte(os.ExpandEnv(string(bz))), cfg); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if r := cfg.Relayer; r != nil && r.MaxPending == 0 {
+		r.MaxPending = 50
+	}
+	if p := cfg.IPFS; p != nil {
+		if p.MaxLatency == 0 {
+			p.MaxLatency = Duration(2 * time.Second)
+		}
+		if p.Timeout == 0 {
+			p.Timeout = Duration(10 * time.Second)
+		}
+	}
+	for i, h := range cfg.Highway {
+		if h.MaxLatency == 0 {
+			cfg.Highway[i].MaxLatency = Duration(time.Second)
+		}
+		if h.Timeout == 0 {
+			cfg.Highway[i].Timeout = Duration(5 * time.Second)
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that every configured check is complete
+func (c *Config) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if c.Chain.Node == "" {
+		return fmt.Errorf("chain node is required")
+	}
+	if c.Chain.MaxBlockAge <= 0 {
+		return fmt.Errorf("chain max block age must be positive")
+	}
+	if r := c.Relayer; r != nil {
+		if r.GRPC == "" {
+			return fmt.Errorf("relayer grpc endpoint is required")
+		}
+		for _, ch := range r.Channels {
+			if ch.Port == "" || ch.Channel == "" {
+				return fmt.Errorf("relayer channel %q needs a port and a channel", ch.Name)
+			}
+		}
+	}
+	if p := c.IPFS; p != nil && (p.API == "" || p.ProbeCID == "") {
+		return fmt.Errorf("ipfs api and probe_cid are required")
+	}
+	for _, h := range c.Highway {
+		if h.Name == "" || h.URL == "" {
+			return fmt.Errorf("highway checks need a name and a url")
+		}
+	}
+	return nil
+}
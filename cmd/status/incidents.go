@@ -0,0 +1,166 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Incident states, in the order an incident usually moves through them
+const (
+	IncidentInvestigating = "investigating"
+	IncidentIdentified    = "identified"
+	IncidentMonitoring    = "monitoring"
+	IncidentResolved      = "resolved"
+)
+
+// ErrIncidentNotFound is returned for an unknown incident ID
+var ErrIncidentNotFound = errors.New("incident not found")
+
+// Incident is an operator annotation shown on the status page
+type Incident struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// Impact is the status the affected components are shown with while
+	// the incident is open
+	Impact     Status           `json:"impact"`
+	Components []string         `json:"components,omitempty"`
+	Status     string           `json:"status"`
+	Updates    []IncidentUpdate `json:"updates"`
+	CreatedAt  time.Time        `json:"created_at"`
+	ResolvedAt *time.Time       `json:"resolved_at,omitempty"`
+}
+
+// IncidentUpdate is one message posted on an incident
+type IncidentUpdate struct {
+	Status  string    `json:"status"`
+	Message string    `json:"message"`
+	At      time.Time `json:"at"`
+}
+
+// Open reports whether the incident is not resolved
+func (i Incident) Open() bool {
+	return i.Status != IncidentResolved
+}
+
+// IncidentStore keeps incidents in memory and, when it has a path, in a JSON
+// file
+type IncidentStore struct {
+	path string
+
+	mu        sync.RWMutex
+	incidents []Incident
+}
+
+// NewIncidentStore loads the incidents kept at path. An empty path keeps
+// them in memory only.
+func NewIncidentStore(path string) (*IncidentStore, error) {
+	s := &IncidentStore{path: path}
+	if path == "" {
+		return s, nil
+	}
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &s.incidents); err != nil {
+		return nil, fmt.Errorf("invalid incidents file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Create opens an incident
+func (s *IncidentStore) Create(title string, impact Status, components []string, message string, now time.Time) (Incident, error) {
+	if title == "" || message == "" {
+		return Incident{}, errors.New("title and message are required")
+	}
+	if impact != StatusDegraded && impact != StatusDown {
+		return Incident{}, fmt.Errorf("impact must be %s or %s", StatusDegraded, StatusDown)
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return Incident{}, err
+	}
+	incident := Incident{
+		ID:         hex.EncodeToString(id),
+		Title:      title,
+		Impact:     impact,
+		Components: components,
+		Status:     IncidentInvestigating,
+		Updates:    []IncidentUpdate{{Status: IncidentInvestigating, Message: message, At: now}},
+		CreatedAt:  now,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.incidents = append(s.incidents, incident)
+	return incident, s.save()
+}
+
+// Update posts a message on an incident and moves it to status
+func (s *IncidentStore) Update(id, status, message string, now time.Time) (Incident, error) {
+	switch status {
+	case IncidentInvestigating, IncidentIdentified, IncidentMonitoring, IncidentResolved:
+	default:
+		return Incident{}, fmt.Errorf("unknown incident status %q", status)
+	}
+	if message == "" {
+		return Incident{}, errors.New("message is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.incidents {
+		incident := &s.incidents[i]
+		if incident.ID != id {
+			continue
+		}
+		incident.Status = status
+		incident.Updates = append(incident.Updates, IncidentUpdate{Status: status, Message: message, At: now})
+		if status == IncidentResolved {
+			incident.ResolvedAt = &now
+		} else {
+			incident.ResolvedAt = nil
+		}
+		return *incident, s.save()
+	}
+	return Incident{}, ErrIncidentNotFound
+}
+
+// Recent returns the open incidents and those resolved since, newest first
+func (s *IncidentStore) Recent(since time.Time) []Incident {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var recent []Incident
+	for _, incident := range s.incidents {
+		if incident.Open() || incident.ResolvedAt.After(since) {
+			recent = append(recent, incident)
+		}
+	}
+	sort.Slice(recent, func(i, j int) bool { return recent[i].CreatedAt.After(recent[j].CreatedAt) })
+	return recent
+}
+
+func (s *IncidentStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	bz, err := json.MarshalIndent(s.incidents, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
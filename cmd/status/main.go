@@ -0,0 +1,120 @@
+// Command status serves a public status page for the network.
+//
+// It checks block production over CometBFT RPC, IBC relayer lag on the
+// configured channels, IPFS pin latency and the health endpoints of highway
+// backends, and serves the results as a JSON API and a server-rendered page.
+// Operators annotate outages with incidents through an authenticated API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	configPath := fs.String("config", envOr("STATUS_CONFIG", "status.yaml"), "path to the YAML config")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		return err
+	}
+
+	cfg, err := LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+	checks, closeChecks, err := NewChecks(cfg)
+	if err != nil {
+		return err
+	}
+	defer closeChecks()
+
+	incidents, err := NewIncidentStore(cfg.IncidentsFile)
+	if err != nil {
+		return err
+	}
+	service := NewService(cfg.Title, checks, time.Duration(cfg.Interval), incidents)
+	server, err := NewServer(service, incidents, cfg.AdminToken)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	server.Routes(mux)
+	httpServer := &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go service.Run(ctx)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("status page listening on %s (%d checks)\n", cfg.ListenAddr, len(checks))
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NewChecks builds the configured checks. The returned function closes their
+// connections.
+func NewChecks(cfg *Config) ([]Check, func(), error) {
+	blocks, err := NewCometBlocks(cfg.Chain.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+	checks := []Check{BlockProductionCheck{Source: blocks, MaxAge: time.Duration(cfg.Chain.MaxBlockAge)}}
+	closeChecks := func() {}
+
+	if r := cfg.Relayer; r != nil {
+		conn, err := grpc.NewClient(r.GRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to connect to %s: %w", r.GRPC, err)
+		}
+		closeChecks = func() { _ = conn.Close() }
+		client := channeltypes.NewQueryClient(conn)
+		for _, ch := range r.Channels {
+			checks = append(checks, RelayerLagCheck{Client: client, Channel: ch, MaxPending: r.MaxPending})
+		}
+	}
+	if cfg.IPFS != nil {
+		checks = append(checks, NewIPFSPinCheck(*cfg.IPFS))
+	}
+	for _, h := range cfg.Highway {
+		checks = append(checks, NewHighwayCheck(h))
+	}
+	return checks, closeChecks, nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"crypto/subtle"
+	"embed"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// Server serves the status page, the JSON API and incident annotation
+type Server struct {
+	service    *Service
+	incidents  *IncidentStore
+	adminToken string
+	page       *template.Template
+	now        func() time.Time
+}
+
+// NewServer creates the HTTP server for service. Incidents can only be
+// posted when adminToken is set.
+func NewServer(service *Service, incidents *IncidentStore, adminToken string) (*Server, error) {
+	page, err := template.ParseFS(templates, "templates/status.html.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		service:    service,
+		incidents:  incidents,
+		adminToken: adminToken,
+		page:       page,
+		now:        time.Now,
+	}, nil
+}
+
+// Routes registers the page, the API and, with an admin token, the incident
+// endpoints
+func (s *Server) Routes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /{$}", s.HandlePage)
+	mux.HandleFunc("GET /api/status", s.HandleStatus)
+	if s.adminToken != "" {
+		mux.HandleFunc("POST /api/incidents", s.requireAdmin(s.HandleCreateIncident))
+		mux.HandleFunc("POST /api/incidents/{id}/updates", s.requireAdmin(s.HandleUpdateIncident))
+	}
+}
+
+// HandlePage renders the status page
+func (s *Server) HandlePage(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := s.page.Execute(w, s.service.Summary(s.now())); err != nil {
+		http.Error(w, "failed to render status page", http.StatusInternalServerError)
+	}
+}
+
+// HandleStatus returns the summary as JSON
+func (s *Server) HandleStatus(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.service.Summary(s.now()))
+}
+
+// CreateIncidentRequest is the body of POST /api/incidents
+type CreateIncidentRequest struct {
+	Title      string   `json:"title"`
+	Impact     Status   `json:"impact"`
+	Components []string `json:"components"`
+	Message    string   `json:"message"`
+}
+
+// HandleCreateIncident opens an incident
+func (s *Server) HandleCreateIncident(w http.ResponseWriter, r *http.Request) {
+	var req CreateIncidentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+		return
+	}
+	incident, err := s.incidents.Create(req.Title, req.Impact, req.Components, req.Message, s.now())
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, incident)
+}
+
+// UpdateIncidentRequest is the body of POST /api/incidents/{id}/updates
+type UpdateIncidentRequest struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// HandleUpdateIncident posts an update on an incident
+func (s *Server) HandleUpdateIncident(w http.ResponseWriter, r *http.Request) {
+	var req UpdateIncidentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+		return
+	}
+	incident, err := s.incidents.Update(r.PathValue("id"), req.Status, req.Message, s.now())
+	switch {
+	case errors.Is(err, ErrIncidentNotFound):
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+	case err != nil:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+	default:
+		writeJSON(w, http.StatusOK, incident)
+	}
+}
+
+func (s *Server) requireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "admin token required"})
+			return
+		}
+		next(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// incidentHistory is how long resolved incidents stay on the page
+const incidentHistory = 7 * 24 * time.Hour
+
+// Summary is the state served by the page and the JSON API
+type Summary struct {
+	Title      string      `json:"title"`
+	Status     Status      `json:"status"`
+	Components []Component `json:"components"`
+	Incidents  []Incident  `json:"incidents"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// Component is the last result of a check, with the impact of any open
+// incident naming it
+type Component struct {
+	Result
+	// Incident is the ID of the open incident that set the status, if any
+	Incident string `json:"incident,omitempty"`
+}
+
+// Service runs the checks on an interval and keeps their latest results
+type Service struct {
+	title     string
+	checks    []Check
+	interval  time.Duration
+	incidents *IncidentStore
+
+	mu        sync.RWMutex
+	results   []Result
+	updatedAt time.Time
+}
+
+// NewService creates a service running checks every interval
+func NewService(title string, checks []Check, interval time.Duration, incidents *IncidentStore) *Service {
+	return &Service{title: title, checks: checks, interval: interval, incidents: incidents}
+}
+
+// Run checks every component until ctx is done
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.Refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh runs every check once, concurrently, and stores the results
+func (s *Service) Refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	results := make([]Result, len(s.checks))
+	var wg sync.WaitGroup
+	for i, check := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := check.Run(ctx)
+			res.CheckedAt = time.Now()
+			results[i] = res
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	s.results = results
+	s.updatedAt = time.Now()
+	s.mu.Unlock()
+}
+
+// Summary returns the components with open incidents applied. An open
+// incident sets the status of the components it names, by name or group, or
+// of the whole service when it names none.
+func (s *Service) Summary(now time.Time) Summary {
+	s.mu.RLock()
+	results := slices.Clone(s.results)
+	updatedAt := s.updatedAt
+	s.mu.RUnlock()
+
+	incidents := s.incidents.Recent(now.Add(-incidentHistory))
+	summary := Summary{
+		Title:      s.title,
+		Status:     StatusOperational,
+		Components: make([]Component, 0, len(results)),
+		Incidents:  incidents,
+		UpdatedAt:  updatedAt,
+	}
+	for _, res := range results {
+		c := Component{Result: res}
+		for _, incident := range incidents {
+			if !incident.Open() || !incident.Impact.Worse(c.Status) {
+				continue
+			}
+			if slices.Contains(incident.Components, res.Name) || slices.Contains(incident.Components, res.Group) {
+				c.Status, c.Incident = incident.Impact, incident.ID
+			}
+		}
+		if c.Status.Worse(summary.Status) {
+			summary.Status = c.Status
+		}
+		summary.Components = append(summary.Components, c)
+	}
+	for _, incident := range incidents {
+		if incident.Open() && len(incident.Components) == 0 && incident.Impact.Worse(summary.Status) {
+			summary.Status = incident.Impact
+		}
+	}
+	return summary
+}
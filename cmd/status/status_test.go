@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBlocks struct {
+	height int64
+	at     time.Time
+}
+
+func (f fakeBlocks) LatestBlock(context.Context) (int64, time.Time, error) {
+	return f.height, f.at, nil
+}
+
+type staticCheck Result
+
+func (c staticCheck) Run(context.Context) Result { return Result(c) }
+
+func TestParseConfigDefaults(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`
+relayer:
+  grpc: localhost:9090
+  channels:
+    - {port: transfer, channel: channel-0}
+highway:
+  - {name: api, url: "http://localhost/health"}
+`))
+	require.NoError(t, err)
+	require.Equal(t, Duration(time.Minute), cfg.Chain.MaxBlockAge)
+	require.EqualValues(t, 50, cfg.Relayer.MaxPending)
+	require.Equal(t, Duration(5*time.Second), cfg.Highway[0].Timeout)
+
+	_, err = ParseConfig([]byte(`
+relayer:
+  grpc: localhost:9090
+  channels:
+    - {port: transfer}
+`))
+	require.ErrorContains(t, err, "needs a port and a channel")
+}
+
+func TestBlockProductionCheck(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	for _, tc := range []struct {
+		age  time.Duration
+		want Status
+	}{
+		{5 * time.Second, StatusOperational},
+		{40 * time.Second, StatusDegraded},
+		{2 * time.Minute, StatusDown},
+	} {
+		check := BlockProductionCheck{
+			Source: fakeBlocks{height: 10, at: now.Add(-tc.age)},
+			MaxAge: time.Minute,
+			now:    func() time.Time { return now },
+		}
+		require.Equal(t, tc.want, check.Run(context.Background()).Status, tc.age)
+	}
+}
+
+func TestHTTPCheck(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer healthy.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	check := NewHighwayCheck(HTTPCheckConfig{Name: "api", URL: healthy.URL, MaxLatency: Duration(time.Second), Timeout: Duration(time.Second)})
+	require.Equal(t, StatusOperational, check.Run(context.Background()).Status)
+
+	check.MaxLatency = 0
+	require.Equal(t, StatusDegraded, check.Run(context.Background()).Status)
+
+	check = NewHighwayCheck(HTTPCheckConfig{Name: "api", URL: failing.URL, MaxLatency: Duration(time.Second), Timeout: Duration(time.Second)})
+	require.Equal(t, StatusDown, check.Run(context.Background()).Status)
+}
+
+func TestSummaryAppliesIncidents(t *testing.T) {
+	store, err := NewIncidentStore(filepath.Join(t.TempDir(), "incidents.json"))
+	require.NoError(t, err)
+	service := NewService("Status", []Check{
+		staticCheck{Name: "Block production", Group: "chain", Status: StatusOperational},
+		staticCheck{Name: "transfer/channel-0", Group: "ibc", Status: StatusOperational},
+	}, time.Minute, store)
+	service.Refresh(context.Background())
+
+	now := time.Now()
+	require.Equal(t, StatusOperational, service.Summary(now).Status)
+
+	incident, err := store.Create("Delayed transfers", StatusDegraded, []string{"ibc"}, "investigating", now)
+	require.NoError(t, err)
+	summary := service.Summary(now)
+	require.Equal(t, StatusDegraded, summary.Status)
+	require.Equal(t, StatusOperational, summary.Components[0].Status)
+	require.Equal(t, StatusDegraded, summary.Components[1].Status)
+	require.Equal(t, incident.ID, summary.Components[1].Incident)
+
+	_, err = store.Update(incident.ID, IncidentResolved, "fixed", now.Add(time.Hour))
+	require.NoError(t, err)
+	summary = service.Summary(now.Add(time.Hour))
+	require.Equal(t, StatusOperational, summary.Status)
+	require.Len(t, summary.Incidents, 1)
+
+	// incidents persist across restarts
+	reloaded, err := NewIncidentStore(store.path)
+	require.NoError(t, err)
+	require.Len(t, reloaded.Recent(now.Add(-time.Hour)), 1)
+}
+
+func TestServerIncidentEndpoints(t *testing.T) {
+	store, err := NewIncidentStore(filepath.Join(t.TempDir(), "incidents.json"))
+	require.NoError(t, err)
+	service := NewService("Sonr Status", nil, time.Minute, store)
+	server, err := NewServer(service, store, "secret")
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	server.Routes(mux)
+
+	do := func(method, path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	body := `{"title":"Chain halted","impact":"down","message":"validators are upgrading"}`
+	require.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/api/incidents", "", body).Code)
+	require.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/api/incidents", "wrong", body).Code)
+
+	rec := do(http.MethodPost, "/api/incidents", "secret", body)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var incident Incident
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &incident))
+
+	rec = do(http.MethodGet, "/api/status", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var summary Summary
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summary))
+	require.Equal(t, StatusDown, summary.Status)
+
+	require.Equal(t, http.StatusNotFound, do(http.MethodPost, "/api/incidents/missing/updates", "secret", `{"status":"resolved"}`).Code)
+	require.Equal(t, http.StatusOK, do(http.MethodPost, "/api/incidents/"+incident.ID+"/updates", "secret", `{"status":"resolved","message":"done"}`).Code)
+
+	rec = do(http.MethodGet, "/", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "Chain halted")
+}
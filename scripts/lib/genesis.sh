@@ -160,10 +160,14 @@ update_genesis_params() {
         set_json_object "$genesis_file" 'app_state.erc20.token_pairs' '[{"contract_owner":1,"erc20_address":"0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE","denom":"'"$DENOM"'","enabled":true}]'
     fi
 
-    # Update feemarket parameters if present
+    # Update feemarket parameters if present. The base fee adjusts per block
+    # (EIP-1559); set FEEMARKET_NO_BASE_FEE=true for fixed zero fees. A
+    # non-zero FEEMARKET_MIN_GAS_PRICE also applies to gentxs, which are
+    # signed with zero fees by default.
     if json_path_exists "$genesis_file" '.app_state.feemarket'; then
-        set_json_bool "$genesis_file" 'app_state.feemarket.params.no_base_fee' true
-        set_json_string "$genesis_file" 'app_state.feemarket.params.base_fee' "0.000000000000000000"
+        set_json_bool "$genesis_file" 'app_state.feemarket.params.no_base_fee' "${FEEMARKET_NO_BASE_FEE:-false}"
+        set_json_string "$genesis_file" 'app_state.feemarket.params.base_fee' "${FEEMARKET_BASE_FEE:-0.010000000000000000}"
+        set_json_string "$genesis_file" 'app_state.feemarket.params.min_gas_price' "${FEEMARKET_MIN_GAS_PRICE:-0.000000000000000000}"
     fi
 
     # Update tokenfactory parameters if present
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sonrctx "github.com/sonr-io/sonr/app/context"
+)
+
+// Retry types are provided by the client SDK; they are aliased here so e2e
+// tests can keep referring to them through this package.
+type (
+	TxAttempt      = sonrctx.TxAttempt
+	TxBuildFunc    = sonrctx.TxBuildFunc
+	RetryOptions   = sonrctx.RetryOptions
+	BroadcastError = sonrctx.BroadcastError
+)
+
+// DefaultRetryOptions returns retry options suitable for local and test networks
+func DefaultRetryOptions(address string) RetryOptions {
+	return sonrctx.DefaultRetryOptions(address)
+}
+
+// BroadcastWithRetry signs, broadcasts and waits for a transaction using the
+// client SDK's retry loop. The REST gateway cannot inspect the mempool, so a
+// transaction that is accepted but never included is reported as stuck
+// rather than replaced.
+func (c *StarshipClient) BroadcastWithRetry(
+	ctx context.Context,
+	build TxBuildFunc,
+	opts RetryOptions,
+) (*sdk.TxResponse, error) {
+	return sonrctx.BroadcastWithRetry(ctx, restBroadcaster{c}, build, opts)
+}
+
+// restBroadcaster adapts StarshipClient to sonrctx.TxBroadcaster
+type restBroadcaster struct {
+	c *StarshipClient
+}
+
+// BroadcastTxSync implements sonrctx.TxBroadcaster
+func (b restBroadcaster) BroadcastTxSync(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	resp, err := b.c.BroadcastTx(ctx, txBytes, BroadcastModeSync)
+	if err != nil {
+		return nil, err
+	}
+	return resp.toSDK(), nil
+}
+
+// GetTx implements sonrctx.TxBroadcaster
+func (b restBroadcaster) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	resp, err := b.c.polling().GetTx(ctx, hash)
+	if errors.Is(err, ErrNotFound) {
+		return nil, sonrctx.ErrTxNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.TxResponse.toSDK(), nil
+}
+
+// InMempool implements sonrctx.TxBroadcaster
+func (b restBroadcaster) InMempool(context.Context, string) (bool, error) {
+	return false, sonrctx.ErrMempoolUnavailable
+}
+
+// AccountSequence implements sonrctx.TxBroadcaster
+func (b restBroadcaster) AccountSequence(ctx context.Context, address string) (uint64, error) {
+	return b.c.GetAccountSequence(ctx, address)
+}
+
+// toSDK converts a REST transaction response to its SDK form
+func (r TxResponse) toSDK() *sdk.TxResponse {
+	height, _ := strconv.ParseInt(r.Height, 10, 64)
+	gasUsed, _ := strconv.ParseInt(r.GasUsed, 10, 64)
+	gasWanted, _ := strconv.ParseInt(r.GasWanted, 10, 64)
+	return &sdk.TxResponse{
+		TxHash:    r.TxHash,
+		Code:      r.Code,
+		Codespace: r.Codespace,
+		RawLog:    r.RawLog,
+		Height:    height,
+		GasUsed:   gasUsed,
+		GasWanted: gasWanted,
+	}
+}
+
+// AccountInfo is the signing state of an account
+type AccountInfo struct {
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// GetAccountInfo returns the account number and current sequence of an account
+func (c *StarshipClient) GetAccountInfo(ctx context.Context, address string) (*AccountInfo, error) {
+	url := fmt.Sprintf("%s/cosmos/auth/v1beta1/account_info/%s", c.baseURL, address)
+
+	var infoResp struct {
+		Info struct {
+			AccountNumber string `json:"account_number"`
+			Sequence      string `json:"sequence"`
+		} `json:"info"`
+	}
+	if err := c.doRequest(ctx, url, &infoResp); err != nil {
+		return nil, fmt.Errorf("failed to query account info: %w", err)
+	}
+
+	var (
+		info AccountInfo
+		err  error
+	)
+	if infoResp.Info.AccountNumber != "" {
+		if info.AccountNumber, err = strconv.ParseUint(infoResp.Info.AccountNumber, 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse account number: %w", err)
+		}
+	}
+	if infoResp.Info.Sequence != "" {
+		if info.Sequence, err = strconv.ParseUint(infoResp.Info.Sequence, 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse account sequence: %w", err)
+		}
+	}
+	return &info, nil
+}
+
+// GetAccountSequence returns the current sequence of an account
+func (c *StarshipClient) GetAccountSequence(ctx context.Context, address string) (uint64, error) {
+	info, err := c.GetAccountInfo(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	return info.Sequence, nil
+}
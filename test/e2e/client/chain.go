@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +18,11 @@ import (
 type StarshipClient struct {
 	baseURL    string
 	httpClient *http.Client
+
+	// height pins all queries to a specific block height when non-zero
+	height int64
+	// retry is how queries are retried, see RetryPolicy
+	retry RetryPolicy
 }
 
 // NewStarshipClient creates a new Starship HTTP client
@@ -28,6 +32,7 @@ func NewStarshipClient(baseURL string) *StarshipClient {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		retry: DefaultRetryPolicy(),
 	}
 }
 
@@ -136,121 +141,6 @@ func (c *StarshipClient) GetNodeInfo(ctx context.Context) (*NodeInfoResponse, er
 	return &nodeInfo, nil
 }
 
-// doRequest performs HTTP GET request with retry logic
-func (c *StarshipClient) doRequest(ctx context.Context, url string, target any) error {
-	const maxRetries = 3
-	const retryDelay = 2 * time.Second
-
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
-		}
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			if attempt == maxRetries-1 {
-				return fmt.Errorf("request failed after %d attempts: %w", maxRetries, err)
-			}
-			time.Sleep(retryDelay)
-			continue
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			if attempt == maxRetries-1 {
-				return fmt.Errorf("request failed with status %d", resp.StatusCode)
-			}
-			time.Sleep(retryDelay)
-			continue
-		}
-
-		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
-
-		return nil
-	}
-
-	return fmt.Errorf("unreachable code")
-}
-
-// EventSearchResponse represents event search response
-type EventSearchResponse struct {
-	Events     []EventResult `json:"events"`
-	Pagination struct {
-		NextKey string `json:"next_key"`
-		Total   string `json:"total"`
-	} `json:"pagination"`
-}
-
-// EventResult represents a single event result
-type EventResult struct {
-	Type       string          `json:"type"`
-	Attributes []sdk.Attribute `json:"attributes"`
-	Height     string          `json:"height"`
-	TxHash     string          `json:"tx_hash"`
-}
-
-// BlockEventsResponse represents block events response
-type BlockEventsResponse struct {
-	Height           string      `json:"height"`
-	BeginBlockEvents []sdk.Event `json:"begin_block_events"`
-	EndBlockEvents   []sdk.Event `json:"end_block_events"`
-	TxEvents         []TxEvents  `json:"tx_events"`
-}
-
-// TxEvents represents transaction events
-type TxEvents struct {
-	TxHash string      `json:"tx_hash"`
-	Events []sdk.Event `json:"events"`
-}
-
-// QueryEventsByHeight queries events by block height
-func (c *StarshipClient) QueryEventsByHeight(ctx context.Context, height int64) (*BlockEventsResponse, error) {
-	url := fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/blocks/%d/events", c.baseURL, height)
-
-	var eventsResp BlockEventsResponse
-	if err := c.doRequest(ctx, url, &eventsResp); err != nil {
-		return nil, fmt.Errorf("failed to query events by height: %w", err)
-	}
-
-	return &eventsResp, nil
-}
-
-// QueryEventsByType queries events by event type
-func (c *StarshipClient) QueryEventsByType(ctx context.Context, eventType string, minHeight, maxHeight int64) (*EventSearchResponse, error) {
-	query := fmt.Sprintf("message.action='%s'", eventType)
-	return c.SearchEvents(ctx, query, minHeight, maxHeight)
-}
-
-// QueryEventsByAttribute queries events by attribute key-value pair
-func (c *StarshipClient) QueryEventsByAttribute(ctx context.Context, key, value string, minHeight, maxHeight int64) (*EventSearchResponse, error) {
-	query := fmt.Sprintf("%s='%s'", key, value)
-	return c.SearchEvents(ctx, query, minHeight, maxHeight)
-}
-
-// SearchEvents performs a general event search with CometBFT query syntax
-func (c *StarshipClient) SearchEvents(ctx context.Context, query string, minHeight, maxHeight int64) (*EventSearchResponse, error) {
-	queryParams := url.Values{}
-	queryParams.Add("query", query)
-	if minHeight > 0 {
-		queryParams.Add("min_height", strconv.FormatInt(minHeight, 10))
-	}
-	if maxHeight > 0 {
-		queryParams.Add("max_height", strconv.FormatInt(maxHeight, 10))
-	}
-
-	searchURL := fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/events?%s", c.baseURL, queryParams.Encode())
-
-	var eventsResp EventSearchResponse
-	if err := c.doRequest(ctx, searchURL, &eventsResp); err != nil {
-		return nil, fmt.Errorf("failed to search events: %w", err)
-	}
-
-	return &eventsResp, nil
-}
-
 // GetLatestBlockHeight gets the latest block height
 func (c *StarshipClient) GetLatestBlockHeight(ctx context.Context) (int64, error) {
 	url := fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/blocks/latest", c.baseURL)
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+
+	dextypes "github.com/sonr-io/sonr/x/dex/types"
+)
+
+// QueryDEXParams queries the x/dex module parameters
+func (c *StarshipClient) QueryDEXParams(ctx context.Context) (*dextypes.QueryParamsResponse, error) {
+	var resp dextypes.QueryParamsResponse
+	if err := c.doProtoRequest(ctx, c.baseURL+"/sonr/dex/v1/params", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryDEXAccount queries the DEX account of a DID on a connection
+func (c *StarshipClient) QueryDEXAccount(ctx context.Context, did, connectionID string) (*dextypes.QueryAccountResponse, error) {
+	var resp dextypes.QueryAccountResponse
+	path := fmt.Sprintf("%s/sonr/dex/v1/account/%s/%s", c.baseURL, url.PathEscape(did), url.PathEscape(connectionID))
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryDEXAccounts queries the DEX accounts of a DID on every connection
+func (c *StarshipClient) QueryDEXAccounts(ctx context.Context, did string) (*dextypes.QueryAccountsResponse, error) {
+	var resp dextypes.QueryAccountsResponse
+	path := fmt.Sprintf("%s/sonr/dex/v1/accounts/%s", c.baseURL, url.PathEscape(did))
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryDEXOrders queries the limit orders of a DID on every connection,
+// optionally filtered by status
+func (c *StarshipClient) QueryDEXOrders(ctx context.Context, did, status string) (*dextypes.QueryOrdersByDIDResponse, error) {
+	var resp dextypes.QueryOrdersByDIDResponse
+	path := fmt.Sprintf("%s/sonr/dex/v1/orders/%s", c.baseURL, url.PathEscape(did))
+	if status != "" {
+		path += "?status=" + url.QueryEscape(status)
+	}
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryDEXHistory queries the DEX transaction history of a DID
+func (c *StarshipClient) QueryDEXHistory(ctx context.Context, did string) (*dextypes.QueryHistoryResponse, error) {
+	var resp dextypes.QueryHistoryResponse
+	path := fmt.Sprintf("%s/sonr/dex/v1/history/%s", c.baseURL, url.PathEscape(did))
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryDEXDWNActivity reads the DEX activity stored in a DID's DWN vault
+func (c *StarshipClient) QueryDEXDWNActivity(ctx context.Context, did string) (*dextypes.QueryDWNActivityResponse, error) {
+	var resp dextypes.QueryDWNActivityResponse
+	path := fmt.Sprintf("%s/sonr/dex/v1/dwn_activity/%s", c.baseURL, url.PathEscape(did))
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EstimateDEXSwap quotes the output and platform fee of swapping tokenIn
+// (e.g. "1000uatom") for tokenOutDenom on a connection
+func (c *StarshipClient) EstimateDEXSwap(ctx context.Context, connectionID, tokenIn, tokenOutDenom string) (*dextypes.QueryEstimateSwapResponse, error) {
+	var resp dextypes.QueryEstimateSwapResponse
+	query := url.Values{"token_in": {tokenIn}, "token_out_denom": {tokenOutDenom}}
+	path := fmt.Sprintf("%s/sonr/dex/v1/estimate_swap/%s?%s", c.baseURL, url.PathEscape(connectionID), query.Encode())
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryDEXSwapRoute finds the route with the best estimated output for
+// swapping tokenIn for tokenOutDenom on a connection, within maxHops hops
+// (zero uses the chain default)
+func (c *StarshipClient) QueryDEXSwapRoute(ctx context.Context, connectionID, tokenIn, tokenOutDenom string, maxHops uint32) (*dextypes.QuerySwapRouteResponse, error) {
+	var resp dextypes.QuerySwapRouteResponse
+	query := url.Values{
+		"token_in":        {tokenIn},
+		"token_out_denom": {tokenOutDenom},
+		"max_hops":        {fmt.Sprintf("%d", maxHops)},
+	}
+	path := fmt.Sprintf("%s/sonr/dex/v1/swap_route/%s?%s", c.baseURL, url.PathEscape(connectionID), query.Encode())
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// doProtoRequest performs a GET request against the gRPC gateway and decodes
+// the response with the protobuf JSON mapping, which plain encoding/json
+// cannot do for enums and 64-bit integers
+func (c *StarshipClient) doProtoRequest(ctx context.Context, url string, target proto.Message) error {
+	var raw json.RawMessage
+	if err := c.doRequest(ctx, url, &raw); err != nil {
+		return err
+	}
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(raw), target); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txSearchPageLimit is the most transactions requested per search page; the
+// API caps larger limits anyway
+const txSearchPageLimit = 100
+
+// EventSearchResponse represents event search response
+type EventSearchResponse struct {
+	Events     []EventResult `json:"events"`
+	Pagination struct {
+		NextKey string `json:"next_key"`
+		Total   string `json:"total"`
+	} `json:"pagination"`
+}
+
+// EventResult represents a single event result
+type EventResult struct {
+	Type       string          `json:"type"`
+	Attributes []sdk.Attribute `json:"attributes"`
+	Height     string          `json:"height"`
+	TxHash     string          `json:"tx_hash"`
+}
+
+// Attribute returns the value of the event's attribute key. Typed events
+// JSON-encode their values; string values are returned unquoted.
+func (e EventResult) Attribute(key string) (string, bool) {
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			return unquoteAttribute(attr.Value), true
+		}
+	}
+	return "", false
+}
+
+// BlockEventsResponse represents block events response. Only transaction
+// events are indexed by the API, so block events are left empty.
+type BlockEventsResponse struct {
+	Height           string      `json:"height"`
+	BeginBlockEvents []sdk.Event `json:"begin_block_events"`
+	EndBlockEvents   []sdk.Event `json:"end_block_events"`
+	TxEvents         []TxEvents  `json:"tx_events"`
+}
+
+// TxEvents represents transaction events
+type TxEvents struct {
+	TxHash string      `json:"tx_hash"`
+	Events []sdk.Event `json:"events"`
+}
+
+// EventQuery selects events emitted by indexed transactions
+type EventQuery struct {
+	// Query is a CometBFT query condition on indexed transactions, such as
+	// "message.sender='idx1...'". It is combined with the height range.
+	Query string
+	// MinHeight and MaxHeight bound the searched blocks; zero is unbounded
+	MinHeight int64
+	MaxHeight int64
+
+	// Type keeps only events of this type, e.g. did.v1.EventDIDCreated
+	Type string
+	// Attributes keeps only events with these attribute values. A key may be
+	// qualified with the event type, e.g. did.v1.EventDIDCreated.did.
+	Attributes map[string]string
+
+	// Limit is the most events returned; zero returns all matches
+	Limit int
+	// PageKey is the NextKey of the previous page
+	PageKey string
+}
+
+// txSearchResponse is the part of a GetTxsEvent response events are read from
+type txSearchResponse struct {
+	TxResponses []struct {
+		Height string `json:"height"`
+		TxHash string `json:"txhash"`
+		Events []struct {
+			Type       string          `json:"type"`
+			Attributes []sdk.Attribute `json:"attributes"`
+		} `json:"events"`
+	} `json:"tx_responses"`
+	Total string `json:"total"`
+}
+
+// QueryEvents searches indexed transactions in the query's height range and
+// returns their events that match its type and attribute filters, oldest
+// first. Results are paginated by Limit and PageKey.
+func (c *StarshipClient) QueryEvents(ctx context.Context, q EventQuery) (*EventSearchResponse, error) {
+	offset := 0
+	if q.PageKey != "" {
+		var err error
+		if offset, err = strconv.Atoi(q.PageKey); err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid page key %q", q.PageKey)
+		}
+	}
+
+	var matched []EventResult
+	for page := 1; ; page++ {
+		res, err := c.searchTxs(ctx, eventSearchQuery(q), page)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range res.TxResponses {
+			for _, event := range tx.Events {
+				result := EventResult{Type: event.Type, Attributes: event.Attributes, Height: tx.Height, TxHash: tx.TxHash}
+				if q.matches(result) {
+					matched = append(matched, result)
+				}
+			}
+		}
+
+		total, _ := strconv.Atoi(res.Total)
+		if len(res.TxResponses) < txSearchPageLimit || page*txSearchPageLimit >= total {
+			break
+		}
+	}
+
+	resp := &EventSearchResponse{}
+	resp.Pagination.Total = strconv.Itoa(len(matched))
+	if offset >= len(matched) {
+		return resp, nil
+	}
+	end := len(matched)
+	if q.Limit > 0 && offset+q.Limit < end {
+		end = offset + q.Limit
+		resp.Pagination.NextKey = strconv.Itoa(end)
+	}
+	resp.Events = matched[offset:end]
+	return resp, nil
+}
+
+// WaitForEvents polls QueryEvents until at least one event matches. Events
+// become searchable only once the node has indexed the block that emitted
+// them, which can trail the transaction's inclusion.
+func (c *StarshipClient) WaitForEvents(ctx context.Context, q EventQuery, timeout time.Duration) (*EventSearchResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.QueryEvents(ctx, q)
+		if err == nil && len(resp.Events) > 0 {
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return nil, fmt.Errorf("no %s events indexed within %s: %w", q.describe(), timeout, err)
+			}
+			return nil, fmt.Errorf("no %s events indexed within %s", q.describe(), timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// QueryEventsByHeight queries the transaction events of a block
+func (c *StarshipClient) QueryEventsByHeight(ctx context.Context, height int64) (*BlockEventsResponse, error) {
+	resp := &BlockEventsResponse{Height: strconv.FormatInt(height, 10)}
+	for page := 1; ; page++ {
+		res, err := c.searchTxs(ctx, fmt.Sprintf("tx.height=%d", height), page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query events by height: %w", err)
+		}
+		for _, tx := range res.TxResponses {
+			txEvents := TxEvents{TxHash: tx.TxHash}
+			for _, event := range tx.Events {
+				e := sdk.Event{Type: event.Type}
+				for _, attr := range event.Attributes {
+					e.Attributes = append(e.Attributes, attr.ToKVPair())
+				}
+				txEvents.Events = append(txEvents.Events, e)
+			}
+			resp.TxEvents = append(resp.TxEvents, txEvents)
+		}
+
+		total, _ := strconv.Atoi(res.Total)
+		if len(res.TxResponses) < txSearchPageLimit || page*txSearchPageLimit >= total {
+			return resp, nil
+		}
+	}
+}
+
+// QueryEventsByType queries events by event type
+func (c *StarshipClient) QueryEventsByType(ctx context.Context, eventType string, minHeight, maxHeight int64) (*EventSearchResponse, error) {
+	return c.QueryEvents(ctx, EventQuery{Type: eventType, MinHeight: minHeight, MaxHeight: maxHeight})
+}
+
+// QueryEventsByAttribute queries events by attribute key-value pair
+func (c *StarshipClient) QueryEventsByAttribute(ctx context.Context, key, value string, minHeight, maxHeight int64) (*EventSearchResponse, error) {
+	return c.QueryEvents(ctx, EventQuery{
+		Attributes: map[string]string{key: value},
+		MinHeight:  minHeight,
+		MaxHeight:  maxHeight,
+	})
+}
+
+// SearchEvents performs a general event search with CometBFT query syntax
+func (c *StarshipClient) SearchEvents(ctx context.Context, query string, minHeight, maxHeight int64) (*EventSearchResponse, error) {
+	return c.QueryEvents(ctx, EventQuery{Query: query, MinHeight: minHeight, MaxHeight: maxHeight})
+}
+
+// searchTxs fetches one page of transactions matching a CometBFT query
+func (c *StarshipClient) searchTxs(ctx context.Context, query string, page int) (*txSearchResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("page", strconv.Itoa(page))
+	params.Set("limit", strconv.Itoa(txSearchPageLimit))
+	params.Set("order_by", "ORDER_BY_ASC")
+
+	searchURL := fmt.Sprintf("%s/cosmos/tx/v1beta1/txs?%s", c.baseURL, params.Encode())
+
+	var res txSearchResponse
+	if err := c.doRequest(ctx, searchURL, &res); err != nil {
+		return nil, fmt.Errorf("failed to search events: %w", err)
+	}
+	return &res, nil
+}
+
+// eventSearchQuery combines the query condition with its height range. The
+// search API needs at least one condition, so an unbounded search matches
+// every height.
+func eventSearchQuery(q EventQuery) string {
+	var conditions []string
+	if q.Query != "" {
+		conditions = append(conditions, q.Query)
+	}
+	if q.MinHeight > 0 {
+		conditions = append(conditions, fmt.Sprintf("tx.height>=%d", q.MinHeight))
+	}
+	if q.MaxHeight > 0 {
+		conditions = append(conditions, fmt.Sprintf("tx.height<=%d", q.MaxHeight))
+	}
+	if len(conditions) == 0 {
+		return "tx.height>0"
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func (q EventQuery) matches(e EventResult) bool {
+	if q.Type != "" && e.Type != q.Type {
+		return false
+	}
+	for key, want := range q.Attributes {
+		if eventType, attr, ok := cutAttributeKey(key); ok {
+			if e.Type != eventType {
+				return false
+			}
+			key = attr
+		}
+		got, found := e.Attribute(key)
+		if !found || got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// cutAttributeKey splits a type qualified attribute key at its last dot
+func cutAttributeKey(key string) (eventType, attr string, ok bool) {
+	i := strings.LastIndex(key, ".")
+	if i <= 0 || i == len(key)-1 {
+		return "", key, false
+	}
+	return key[:i], key[i+1:], true
+}
+
+func (q EventQuery) describe() string {
+	if q.Type != "" {
+		return q.Type
+	}
+	return "matching"
+}
+
+// unquoteAttribute strips the JSON quotes typed events put around strings
+func unquoteAttribute(value string) string {
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		if s, err := strconv.Unquote(value); err == nil {
+			return s
+		}
+	}
+	return value
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	sonrctx "github.com/sonr-io/sonr/app/context"
+)
+
+// Fee market types are provided by the client SDK
+type (
+	FeeMarketState = sonrctx.FeeMarketState
+	GasPriceTier   = sonrctx.GasPriceTier
+)
+
+// Gas price tiers, see sonrctx.GasPriceTier
+const (
+	GasPriceLow      = sonrctx.GasPriceLow
+	GasPriceStandard = sonrctx.GasPriceStandard
+	GasPriceFast     = sonrctx.GasPriceFast
+)
+
+// GetBaseFee queries the current base fee per unit of gas
+func (c *StarshipClient) GetBaseFee(ctx context.Context) (math.LegacyDec, error) {
+	url := fmt.Sprintf("%s/cosmos/evm/feemarket/v1/base_fee", c.baseURL)
+
+	var baseFeeResp struct {
+		BaseFee string `json:"base_fee"`
+	}
+	if err := c.doRequest(ctx, url, &baseFeeResp); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("failed to query base fee: %w", err)
+	}
+	return parseDec(baseFeeResp.BaseFee)
+}
+
+// GetFeeMarketState queries the fee market parameters, base fee, last
+// block's gas and block gas limit, from which congestion and the next base
+// fee are derived
+func (c *StarshipClient) GetFeeMarketState(ctx context.Context) (*FeeMarketState, error) {
+	var paramsResp struct {
+		Params struct {
+			NoBaseFee                bool   `json:"no_base_fee"`
+			BaseFeeChangeDenominator uint32 `json:"base_fee_change_denominator"`
+			ElasticityMultiplier     uint32 `json:"elasticity_multiplier"`
+			MinGasPrice              string `json:"min_gas_price"`
+		} `json:"params"`
+	}
+	if err := c.doRequest(ctx, c.baseURL+"/cosmos/evm/feemarket/v1/params", &paramsResp); err != nil {
+		return nil, fmt.Errorf("failed to query fee market params: %w", err)
+	}
+
+	state := &FeeMarketState{
+		NoBaseFee:                paramsResp.Params.NoBaseFee,
+		BaseFeeChangeDenominator: paramsResp.Params.BaseFeeChangeDenominator,
+		ElasticityMultiplier:     paramsResp.Params.ElasticityMultiplier,
+	}
+	var err error
+	if state.MinGasPrice, err = parseDec(paramsResp.Params.MinGasPrice); err != nil {
+		return nil, err
+	}
+	if state.BaseFee, err = c.GetBaseFee(ctx); err != nil {
+		return nil, err
+	}
+
+	var blockGasResp struct {
+		Gas string `json:"gas"`
+	}
+	if err := c.doRequest(ctx, c.baseURL+"/cosmos/evm/feemarket/v1/block_gas", &blockGasResp); err != nil {
+		return nil, fmt.Errorf("failed to query block gas: %w", err)
+	}
+	if gas, err := strconv.ParseInt(blockGasResp.Gas, 10, 64); err == nil && gas > 0 {
+		state.BlockGas = uint64(gas)
+	}
+
+	var consensusResp struct {
+		Params struct {
+			Block struct {
+				MaxGas string `json:"max_gas"`
+			} `json:"block"`
+		} `json:"params"`
+	}
+	if err := c.doRequest(ctx, c.baseURL+"/cosmos/consensus/v1/params", &consensusResp); err != nil {
+		return nil, fmt.Errorf("failed to query consensus params: %w", err)
+	}
+	if maxGas, err := strconv.ParseInt(consensusResp.Params.Block.MaxGas, 10, 64); err == nil && maxGas > 0 {
+		state.MaxBlockGas = uint64(maxGas)
+	}
+
+	return state, nil
+}
+
+// SuggestGasPrice returns the gas price to sign with for a tier
+func (c *StarshipClient) SuggestGasPrice(ctx context.Context, tier GasPriceTier) (math.LegacyDec, error) {
+	state, err := c.GetFeeMarketState(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return state.SuggestGasPrice(tier), nil
+}
+
+// parseDec parses a decimal, treating an empty string as zero
+func parseDec(s string) (math.LegacyDec, error) {
+	if s == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	d, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid decimal %q: %w", s, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	dextypes "github.com/sonr-io/sonr/x/dex/types"
+)
+
+// Channel states as reported by the REST API
+const (
+	ChannelStateInit    = "STATE_INIT"
+	ChannelStateTryOpen = "STATE_TRYOPEN"
+	ChannelStateOpen    = "STATE_OPEN"
+	ChannelStateClosed  = "STATE_CLOSED"
+)
+
+// icaChannelPollInterval is how often channel state is polled. It is shorter
+// than a block so the handshake is seen in the block that completes it.
+const icaChannelPollInterval = 500 * time.Millisecond
+
+// ICAChannel is the controller end of an interchain account channel
+type ICAChannel struct {
+	PortID                string
+	ChannelID             string
+	ConnectionID          string
+	State                 string
+	CounterpartyPortID    string
+	CounterpartyChannelID string
+	// Address is the interchain account on the host chain. The host sets it
+	// in the channel version during the handshake, so it is known once the
+	// channel is open.
+	Address string
+}
+
+// Open reports whether the handshake has completed
+func (ch *ICAChannel) Open() bool {
+	return ch.State == ChannelStateOpen
+}
+
+// icaMetadata is the ICS-27 channel version, possibly wrapped by ICS-29 fees
+type icaMetadata struct {
+	Address    string `json:"address"`
+	AppVersion string `json:"app_version"`
+}
+
+// icaAddressFromVersion reads the host account address from an ICS-27
+// channel version
+func icaAddressFromVersion(version string) string {
+	var md icaMetadata
+	if err := json.Unmarshal([]byte(version), &md); err != nil {
+		return ""
+	}
+	if md.Address == "" && md.AppVersion != "" {
+		return icaAddressFromVersion(md.AppVersion)
+	}
+	return md.Address
+}
+
+// GetConnectionChannels queries the IBC channels on a connection
+func (c *StarshipClient) GetConnectionChannels(ctx context.Context, connectionID string) (*ChannelsResponse, error) {
+	path := fmt.Sprintf("%s/ibc/core/channel/v1/connections/%s/channels", c.baseURL, url.PathEscape(connectionID))
+
+	var channelsResp ChannelsResponse
+	if err := c.doRequest(ctx, path, &channelsResp); err != nil {
+		return nil, fmt.Errorf("failed to query connection channels: %w", err)
+	}
+
+	return &channelsResp, nil
+}
+
+// GetICAChannel returns the channel of the interchain account on a
+// controller port. A port keeps its account across channels; when a closed
+// channel was replaced the open one is returned, otherwise the newest.
+func (c *StarshipClient) GetICAChannel(ctx context.Context, connectionID, portID string) (*ICAChannel, error) {
+	channels, err := c.GetConnectionChannels(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ICAChannel
+	for _, channel := range channels.Channels {
+		if channel.PortID != portID {
+			continue
+		}
+		ch := &ICAChannel{
+			PortID:                channel.PortID,
+			ChannelID:             channel.ChannelID,
+			ConnectionID:          connectionID,
+			State:                 channel.State,
+			CounterpartyPortID:    channel.Counterparty.PortID,
+			CounterpartyChannelID: channel.Counterparty.ChannelID,
+			Address:               icaAddressFromVersion(channel.Version),
+		}
+		switch {
+		case found == nil, ch.Open() && !found.Open():
+			found = ch
+		case ch.Open() == found.Open() && channelSequence(ch.ChannelID) > channelSequence(found.ChannelID):
+			found = ch
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no channel on port %s over %s: %w", portID, connectionID, ErrNotFound)
+	}
+	return found, nil
+}
+
+// WaitForICAChannel polls the controller channel of a DID's DEX account on a
+// connection until the handshake completes, and returns it with the host
+// account address. It fails early if the channel is closed.
+func (c *StarshipClient) WaitForICAChannel(ctx context.Context, did, connectionID string, timeout time.Duration) (*ICAChannel, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	portID := dextypes.ICAPortID(did, connectionID)
+	poller := c.polling()
+
+	ticker := time.NewTicker(icaChannelPollInterval)
+	defer ticker.Stop()
+
+	lastState := "no channel"
+	for {
+		ch, err := poller.GetICAChannel(ctx, connectionID, portID)
+		switch {
+		case err == nil && ch.Open():
+			if ch.Address == "" {
+				// Channels opened with an empty version carry no address
+				ch.Address, _ = poller.GetICAAddress(ctx, did, connectionID)
+			}
+			if ch.Address != "" {
+				return ch, nil
+			}
+			lastState = "open without an address"
+		case err == nil && ch.State == ChannelStateClosed:
+			return nil, fmt.Errorf("ICA channel %s/%s closed during the handshake", portID, ch.ChannelID)
+		case err == nil:
+			lastState = ch.State
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("ICA channel on %s not open within %s, last %s", portID, timeout, lastState)
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForDEXAccountActivation waits for the ICA handshake of a DID's DEX
+// account and returns the account, which the module activates in the same
+// block as the channel opens
+func (c *StarshipClient) WaitForDEXAccountActivation(ctx context.Context, did, connectionID string, timeout time.Duration) (*dextypes.InterchainDEXAccount, error) {
+	if _, err := c.WaitForICAChannel(ctx, did, connectionID, timeout); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.QueryDEXAccount(ctx, did, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Account == nil || resp.Account.Status != dextypes.ACCOUNT_STATUS_ACTIVE {
+		return nil, fmt.Errorf("DEX account of %s on %s is not active after its channel opened", did, connectionID)
+	}
+	return resp.Account, nil
+}
+
+// QueryDEXICAAddress queries the controller port and, once the account is
+// open, the host address of a DID's DEX account on a connection
+func (c *StarshipClient) QueryDEXICAAddress(ctx context.Context, did, connectionID string) (*dextypes.QueryICAAddressResponse, error) {
+	var resp dextypes.QueryICAAddressResponse
+	path := fmt.Sprintf("%s/sonr/dex/v1/ica_address/%s/%s", c.baseURL, url.PathEscape(did), url.PathEscape(connectionID))
+	if err := c.doProtoRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetICAAddress returns the host address of a DID's DEX account from the
+// ICA controller, or ErrNotFound before the handshake completes
+func (c *StarshipClient) GetICAAddress(ctx context.Context, did, connectionID string) (string, error) {
+	path := fmt.Sprintf("%s/ibc/apps/interchain_accounts/controller/v1/owners/%s/connections/%s",
+		c.baseURL, url.PathEscape(dextypes.ICAOwner(did, connectionID)), url.PathEscape(connectionID))
+
+	var addrResp struct {
+		Address string `json:"address"`
+	}
+	if err := c.doRequest(ctx, path, &addrResp); err != nil {
+		return "", fmt.Errorf("failed to query interchain account address: %w", err)
+	}
+	return addrResp.Address, nil
+}
+
+// channelSequence returns the number of a channel-N identifier
+func channelSequence(channelID string) uint64 {
+	n, _ := strconv.ParseUint(strings.TrimPrefix(channelID, "channel-"), 10, 64)
+	return n
+}
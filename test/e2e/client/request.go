@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when the queried state does not exist, which
+	// right after a transaction often means it is not yet committed or indexed
+	ErrNotFound = errors.New("not found")
+	// ErrUnavailable is returned when the node could not be reached or
+	// answered that it is temporarily unable to serve the query
+	ErrUnavailable = errors.New("unavailable")
+	// ErrInvalidResponse is returned when a response does not have the shape
+	// of the requested type
+	ErrInvalidResponse = errors.New("invalid response")
+)
+
+// gRPC status codes returned in gateway error bodies
+const (
+	grpcCodeNotFound    = 5
+	grpcCodeUnavailable = 14
+)
+
+// RequestError is a failed query. It wraps ErrNotFound, ErrUnavailable or
+// ErrInvalidResponse when the failure is one of those, so callers can tell
+// state that is not there yet from a request that can never succeed.
+type RequestError struct {
+	URL        string
+	StatusCode int
+	// Code and Message are the gRPC status of a gateway error body
+	Code    int
+	Message string
+	Err     error
+}
+
+func (e *RequestError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "GET %s", e.URL)
+	if e.StatusCode != 0 {
+		fmt.Fprintf(&sb, ": status %d", e.StatusCode)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&sb, ": %v", e.Err)
+	}
+	return sb.String()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// ResponseValidator is implemented by response types that check their own
+// content once decoded, e.g. that a required field is set
+type ResponseValidator interface {
+	Validate() error
+}
+
+// RetryPolicy controls how queries are retried. Unavailable nodes are always
+// retried; missing state only with RetryNotFound. Other failures are
+// returned at once.
+type RetryPolicy struct {
+	// Attempts is the most times a query is sent
+	Attempts int
+	// InitialBackoff is the wait before the first retry. It doubles with
+	// every retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// RetryNotFound retries queries for state that does not exist yet
+	RetryNotFound bool
+}
+
+// DefaultRetryPolicy retries for about ten seconds, long enough for a
+// committed transaction's state to be queryable and indexed
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Attempts:       6,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     4 * time.Second,
+		RetryNotFound:  true,
+	}
+}
+
+// retryable reports whether a failed query should be sent again
+func (p RetryPolicy) retryable(err error) bool {
+	return errors.Is(err, ErrUnavailable) || (p.RetryNotFound && errors.Is(err, ErrNotFound))
+}
+
+// backoff returns the wait before retry n, counted from zero
+func (p RetryPolicy) backoff(n int) time.Duration {
+	d := p.InitialBackoff
+	for i := 0; i < n && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// WithRetry returns a copy of the client that retries queries with policy
+func (c *StarshipClient) WithRetry(policy RetryPolicy) *StarshipClient {
+	retrying := *c
+	retrying.retry = policy
+	return &retrying
+}
+
+// polling returns a copy of the client for callers that poll for state
+// themselves, which should see ErrNotFound at once rather than after the
+// client's own retries
+func (c *StarshipClient) polling() *StarshipClient {
+	policy := c.retry
+	policy.RetryNotFound = false
+	return c.WithRetry(policy)
+}
+
+// Query sends a GET request for a REST path, such as
+// /sonr/did/v1/params, and decodes the JSON response into target
+func (c *StarshipClient) Query(ctx context.Context, path string, target any) error {
+	return c.doRequest(ctx, c.baseURL+path, target)
+}
+
+// doRequest performs an HTTP GET request, retrying with backoff according to
+// the client's RetryPolicy
+func (c *StarshipClient) doRequest(ctx context.Context, url string, target any) error {
+	attempts := max(c.retry.Attempts, 1)
+	for attempt := 0; ; attempt++ {
+		err := c.get(ctx, url, target)
+		if err == nil || attempt == attempts-1 || !c.retry.retryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(c.retry.backoff(attempt)):
+		}
+	}
+}
+
+// get sends a single GET request and classifies its failure
+func (c *StarshipClient) get(ctx context.Context, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.height > 0 {
+		req.Header.Set(BlockHeightHeader, strconv.FormatInt(c.height, 10))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		return &RequestError{URL: url, Err: fmt.Errorf("%w: %v", ErrUnavailable, err)}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &RequestError{URL: url, StatusCode: resp.StatusCode, Err: fmt.Errorf("%w: %v", ErrUnavailable, err)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return statusError(url, resp.StatusCode, body)
+	}
+
+	if err := decodeResponse(body, target); err != nil {
+		return &RequestError{URL: url, StatusCode: resp.StatusCode, Err: err}
+	}
+	return nil
+}
+
+// statusError classifies a non-OK response by its status and the gRPC code
+// of its gateway error body
+func statusError(url string, statusCode int, body []byte) *RequestError {
+	var status struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &status)
+
+	reqErr := &RequestError{URL: url, StatusCode: statusCode, Code: status.Code, Message: status.Message}
+	switch {
+	case statusCode == http.StatusNotFound || status.Code == grpcCodeNotFound:
+		reqErr.Err = ErrNotFound
+	case statusCode == http.StatusTooManyRequests,
+		statusCode == http.StatusBadGateway,
+		statusCode == http.StatusServiceUnavailable,
+		statusCode == http.StatusGatewayTimeout,
+		status.Code == grpcCodeUnavailable:
+		reqErr.Err = ErrUnavailable
+	}
+	return reqErr
+}
+
+// decodeResponse decodes a JSON object into target. A response sharing no
+// field with target is from the wrong endpoint or an error page, so it is
+// rejected rather than left as a zero value; targets implementing
+// ResponseValidator check the rest.
+func decodeResponse(body []byte, target any) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return fmt.Errorf("%w: not a JSON object: %v", ErrInvalidResponse, err)
+	}
+
+	if want := jsonFields(target); len(want) > 0 {
+		// encoding/json matches field names case-insensitively
+		found := false
+		for name := range fields {
+			for _, w := range want {
+				found = found || strings.EqualFold(name, w)
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: none of the fields %s", ErrInvalidResponse, strings.Join(want, ", "))
+		}
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if v, ok := target.(ResponseValidator); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the top-level JSON field names of a struct target, or
+// nil for other types
+func jsonFields(target any) []string {
+	t := reflect.TypeOf(target)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			if field.Anonymous {
+				// Embedded fields are promoted; checking them would need
+				// their own fields, so accept any object
+				return nil
+			}
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"cosmossdk.io/math"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmcodec "github.com/cosmos/evm/crypto/codec"
+	evmhd "github.com/cosmos/evm/crypto/hd"
+	evmkeyring "github.com/cosmos/evm/crypto/keyring"
+
+	"github.com/sonr-io/sonr/app"
+	"github.com/sonr-io/sonr/app/params"
+	dextypes "github.com/sonr-io/sonr/x/dex/types"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
+	svctypes "github.com/sonr-io/sonr/x/svc/types"
+)
+
+// DefaultGasAdjustment scales the simulated gas to get the signed gas limit
+const DefaultGasAdjustment = 1.5
+
+// DefaultGasPrice is the gas price test transactions are signed with
+var DefaultGasPrice = sdk.NewDecCoinFromDec("usnr", math.LegacyMustNewDecFromStr("0.025"))
+
+var (
+	encodingOnce sync.Once
+	encoding     params.EncodingConfig
+)
+
+// encodingConfig returns the codec and tx config used to sign test
+// transactions. The account prefix is set first so signer addresses in
+// messages decode with the chain's bech32 prefix.
+func encodingConfig() params.EncodingConfig {
+	encodingOnce.Do(func() {
+		cfg := sdk.GetConfig()
+		if cfg.GetBech32AccountAddrPrefix() != app.Bech32PrefixAccAddr {
+			cfg.SetBech32PrefixForAccount(app.Bech32PrefixAccAddr, app.Bech32PrefixAccPub)
+			cfg.SetBech32PrefixForValidator(app.Bech32PrefixValAddr, app.Bech32PrefixValPub)
+			cfg.SetBech32PrefixForConsensusNode(app.Bech32PrefixConsAddr, app.Bech32PrefixConsPub)
+		}
+
+		encoding = params.MakeEncodingConfig()
+		std.RegisterInterfaces(encoding.InterfaceRegistry)
+		evmcodec.RegisterInterfaces(encoding.InterfaceRegistry)
+		authtypes.RegisterInterfaces(encoding.InterfaceRegistry)
+		banktypes.RegisterInterfaces(encoding.InterfaceRegistry)
+		didtypes.RegisterInterfaces(encoding.InterfaceRegistry)
+		dextypes.RegisterInterfaces(encoding.InterfaceRegistry)
+		dwntypes.RegisterInterfaces(encoding.InterfaceRegistry)
+		svctypes.RegisterInterfaces(encoding.InterfaceRegistry)
+	})
+	return encoding
+}
+
+// Signer is a test account whose key is held in an in-memory keyring
+type Signer struct {
+	Name    string
+	Address sdk.AccAddress
+
+	// GasPrice is the base price fees are computed from. With AutoGasPrice it
+	// is the lowest price signed with.
+	GasPrice sdk.DecCoin
+	// AutoGasPrice signs each attempt at the fee market's suggested price for
+	// GasPriceTier when that is above GasPrice
+	AutoGasPrice bool
+	GasPriceTier GasPriceTier
+	// GasAdjustment scales the simulated gas to get the gas limit
+	GasAdjustment float64
+
+	keyring keyring.Keyring
+}
+
+// NewSigner recovers a signer from a mnemonic using the chain's
+// eth_secp256k1 key type and BIP44 coin type
+func NewSigner(name, mnemonic string) (*Signer, error) {
+	kr, err := keyring.New(name, keyring.BackendMemory, "", nil, encodingConfig().Codec, evmkeyring.Option())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create keyring: %w", err)
+	}
+
+	hdPath := hd.CreateHDPath(app.CoinType, 0, 0).String()
+	record, err := kr.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, hdPath, evmhd.EthSecp256k1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover key %s: %w", name, err)
+	}
+
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read address of key %s: %w", name, err)
+	}
+
+	return &Signer{
+		Name:          name,
+		Address:       address,
+		GasPrice:      DefaultGasPrice,
+		AutoGasPrice:  true,
+		GasPriceTier:  GasPriceStandard,
+		GasAdjustment: DefaultGasAdjustment,
+		keyring:       kr,
+	}, nil
+}
+
+// fee returns the fee for a gas limit at the attempt's gas price, or the
+// signer's if higher, scaled by the attempt's multiplier
+func (s *Signer) fee(gas uint64, attempt TxAttempt) sdk.Coins {
+	price := s.GasPrice.Amount
+	if !attempt.GasPrice.IsNil() && attempt.GasPrice.GT(price) {
+		price = attempt.GasPrice
+	}
+	price = price.Mul(math.LegacyMustNewDecFromStr(strconv.FormatFloat(attempt.GasPriceMultiplier, 'f', -1, 64)))
+	amount := price.MulInt64(int64(gas)).Ceil().TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+	return sdk.NewCoins(sdk.NewCoin(s.GasPrice.Denom, amount))
+}
+
+// sign builds, signs in direct mode and encodes a transaction
+func (s *Signer) sign(
+	ctx context.Context,
+	chainID string,
+	accountNumber, sequence, gas uint64,
+	fee sdk.Coins,
+	msgs []sdk.Msg,
+) ([]byte, error) {
+	txConfig := encodingConfig().TxConfig
+	builder := txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, fmt.Errorf("failed to set messages: %w", err)
+	}
+	builder.SetGasLimit(gas)
+	builder.SetFeeAmount(fee)
+
+	factory := clienttx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(s.keyring).
+		WithChainID(chainID).
+		WithAccountNumber(accountNumber).
+		WithSequence(sequence).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	if err := clienttx.Sign(ctx, factory, s.Name, builder, true); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return txConfig.TxEncoder()(builder.GetTx())
+}
+
+// SignAndBroadcastTx signs msgs with the signer's key, estimates gas by
+// simulation, and broadcasts the transaction until it is included. Sequence
+// mismatches and fee rejections are retried; a transaction that fails
+// CheckTx or DeliverTx is returned as a *BroadcastError.
+func (c *StarshipClient) SignAndBroadcastTx(ctx context.Context, signer *Signer, msgs ...sdk.Msg) (*TxResponse, error) {
+	if signer == nil {
+		return nil, errors.New("no signer configured")
+	}
+	if len(msgs) == 0 {
+		return nil, errors.New("no messages to sign")
+	}
+
+	nodeInfo, err := c.GetNodeInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainID := nodeInfo.DefaultNodeInfo.Network
+
+	account, err := c.GetAccountInfo(ctx, signer.Address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	simBytes, err := signer.sign(ctx, chainID, account.AccountNumber, account.Sequence, 0, nil, msgs)
+	if err != nil {
+		return nil, err
+	}
+	sim, err := c.SimulateTx(ctx, simBytes)
+	if err != nil {
+		return nil, err
+	}
+	gasUsed, err := strconv.ParseUint(sim.GasInfo.GasUsed, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse simulated gas: %w", err)
+	}
+	gas := uint64(float64(gasUsed) * signer.GasAdjustment)
+
+	build := func(ctx context.Context, attempt TxAttempt) ([]byte, error) {
+		fee := signer.fee(gas, attempt)
+		return signer.sign(ctx, chainID, account.AccountNumber, attempt.Sequence, gas, fee, msgs)
+	}
+
+	opts := DefaultRetryOptions(signer.Address.String())
+	if signer.AutoGasPrice {
+		opts.GasPrices = func(ctx context.Context) (math.LegacyDec, error) {
+			return c.SuggestGasPrice(ctx, signer.GasPriceTier)
+		}
+	}
+	included, err := c.BroadcastWithRetry(ctx, build, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the indexed response so callers can inspect emitted events
+	resp, err := c.GetTx(ctx, included.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.TxResponse, nil
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockHeightHeader is the gRPC-gateway header used to query state at a given height
+const BlockHeightHeader = "x-cosmos-block-height"
+
+// AtHeight returns a copy of the client whose queries are all pinned to the given
+// block height. A height of zero queries the latest state.
+func (c *StarshipClient) AtHeight(height int64) *StarshipClient {
+	pinned := *c
+	pinned.height = height
+	return &pinned
+}
+
+// Height returns the block height the client is pinned to, or zero for latest
+func (c *StarshipClient) Height() int64 {
+	return c.height
+}
+
+// OffChainRecord is data read from an off-chain store alongside a snapshot.
+// It carries its own timestamp since it is not bound to the snapshot height.
+type OffChainRecord struct {
+	Data      json.RawMessage `json:"data"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// ProfileSnapshot is a composite view of a profile whose chain reads all
+// come from the same block height
+type ProfileSnapshot struct {
+	Height      int64                     `json:"height"`
+	DID         string                    `json:"did"`
+	Address     string                    `json:"address"`
+	DIDDocument json.RawMessage           `json:"did_document,omitempty"`
+	Balances    []sdk.Coin                `json:"balances"`
+	Services    json.RawMessage           `json:"services,omitempty"`
+	OffChain    map[string]OffChainRecord `json:"off_chain,omitempty"`
+}
+
+// WithOffChain attaches an off-chain record labeled with its own update time
+func (s *ProfileSnapshot) WithOffChain(key string, data json.RawMessage, updatedAt time.Time) {
+	if s.OffChain == nil {
+		s.OffChain = make(map[string]OffChainRecord)
+	}
+	s.OffChain[key] = OffChainRecord{Data: data, UpdatedAt: updatedAt}
+}
+
+// GetProfileSnapshot reads the DID document, balances and owned services of a
+// profile at a single height so a render cannot mix state from different blocks.
+// If height is zero the latest height is resolved first and used for every read.
+func (c *StarshipClient) GetProfileSnapshot(ctx context.Context, did, address string, height int64) (*ProfileSnapshot, error) {
+	if height == 0 {
+		latest, err := c.GetLatestBlockHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+	pinned := c.AtHeight(height)
+
+	snapshot := &ProfileSnapshot{
+		Height:  height,
+		DID:     did,
+		Address: address,
+	}
+
+	if did != "" {
+		url := fmt.Sprintf("%s/did/v1/document/%s", pinned.baseURL, did)
+		if err := pinned.doRequest(ctx, url, &snapshot.DIDDocument); err != nil {
+			return nil, fmt.Errorf("failed to query DID document at height %d: %w", height, err)
+		}
+	}
+
+	if address != "" {
+		balances, err := pinned.GetAllBalances(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query balances at height %d: %w", height, err)
+		}
+		snapshot.Balances = balances
+
+		url := fmt.Sprintf("%s/svc/v1/services/owner/%s", pinned.baseURL, address)
+		if err := pinned.doRequest(ctx, url, &snapshot.Services); err != nil {
+			return nil, fmt.Errorf("failed to query services at height %d: %w", height, err)
+		}
+	}
+
+	return snapshot, nil
+}
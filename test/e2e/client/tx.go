@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -16,6 +17,7 @@ import (
 type TxResponse struct {
 	TxHash    string `json:"txhash"`
 	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace"`
 	RawLog    string `json:"raw_log"`
 	GasUsed   string `json:"gas_used"`
 	GasWanted string `json:"gas_wanted"`
@@ -166,15 +168,19 @@ func (c *StarshipClient) WaitForTx(ctx context.Context, txHash string, timeout t
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
 
+	poller := c.polling()
 	for {
 		select {
 		case <-ctx.Done():
 			return nil, fmt.Errorf("timeout waiting for transaction %s", txHash)
 		case <-ticker.C:
-			tx, err := c.GetTx(ctx, txHash)
+			tx, err := poller.GetTx(ctx, txHash)
 			if err == nil && tx.TxResponse.Code == 0 {
 				return tx, nil
 			}
+			if err != nil && !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrUnavailable) && ctx.Err() == nil {
+				return nil, err
+			}
 			// Continue waiting if transaction not found or failed
 		}
 	}
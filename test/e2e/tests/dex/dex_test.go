@@ -2,6 +2,7 @@ package dex
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/sonr-io/sonr/test/e2e/client"
 	"github.com/sonr-io/sonr/test/e2e/utils"
 	dextypes "github.com/sonr-io/sonr/x/dex/types"
 )
@@ -33,6 +35,11 @@ func TestDEXModuleOperations(t *testing.T) {
 		connectionID := "connection-0"
 		features := []string{"swap", "liquidity"}
 
+		// The ICA port is known before registration
+		icaResp, err := cfg.Client.QueryDEXICAAddress(ctx, did, connectionID)
+		require.NoError(t, err, "failed to query ICA address")
+		require.Equal(t, dextypes.ICAPortID(did, connectionID), icaResp.PortId)
+
 		msg := &dextypes.MsgRegisterDEXAccount{
 			Did:          did,
 			ConnectionId: connectionID,
@@ -50,6 +57,29 @@ func TestDEXModuleOperations(t *testing.T) {
 		require.NotNil(t, queryResp, "DEX account should exist")
 		require.Equal(t, did, queryResp.Account.Did)
 		require.Equal(t, connectionID, queryResp.Account.ConnectionId)
+		require.Equal(t, icaResp.PortId, queryResp.Account.PortId, "account should use the reported port")
+	})
+
+	t.Run("ica_channel_handshake", func(t *testing.T) {
+		// Waits on the account registered above
+		did := "did:sonr:e2e_test_user"
+		connectionID := "connection-0"
+
+		// The handshake needs a relayer; without one no channel is opened
+		_, err := cfg.Client.GetICAChannel(ctx, connectionID, dextypes.ICAPortID(did, connectionID))
+		if errors.Is(err, client.ErrNotFound) {
+			t.Skip("no ICA channel was opened for the account, is a relayer running?")
+		}
+		require.NoError(t, err, "failed to query ICA channel")
+
+		account, err := cfg.Client.WaitForDEXAccountActivation(ctx, did, connectionID, 2*time.Minute)
+		require.NoError(t, err, "ICA handshake should complete")
+		require.NotEmpty(t, account.AccountAddress)
+
+		icaResp, err := cfg.Client.QueryDEXICAAddress(ctx, did, connectionID)
+		require.NoError(t, err, "failed to query ICA address")
+		require.True(t, icaResp.Registered)
+		require.Equal(t, account.AccountAddress, icaResp.Address)
 	})
 
 	t.Run("execute_swap", func(t *testing.T) {
@@ -146,13 +176,15 @@ func TestDEXModuleOperations(t *testing.T) {
 		require.NoError(t, err, "failed to create limit order")
 		require.Equal(t, uint32(0), txResp.Code, "order creation should succeed")
 
-		// TODO: Query and verify the order was created
+		ordersResp, err := cfg.Client.QueryDEXOrders(ctx, did, "open")
+		require.NoError(t, err, "failed to query DEX orders")
+		require.NotEmpty(t, ordersResp.Orders, "order should be listed as open")
 	})
 
 	t.Run("query_dex_accounts", func(t *testing.T) {
-		// Query all DEX accounts
-		resp, err := cfg.Client.QueryAllDEXAccounts(ctx)
-		require.NoError(t, err, "failed to query all DEX accounts")
+		// Query the DEX accounts of the DID registered above
+		resp, err := cfg.Client.QueryDEXAccounts(ctx, "did:sonr:e2e_test_user")
+		require.NoError(t, err, "failed to query DEX accounts")
 		require.NotNil(t, resp, "response should not be nil")
 		// Should have at least the accounts created in previous tests
 		require.GreaterOrEqual(t, len(resp.Accounts), 1, "should have at least one account")
@@ -166,7 +198,7 @@ func TestDEXModuleOperations(t *testing.T) {
 		require.NoError(t, err, "failed to query DEX history")
 		require.NotNil(t, resp, "response should not be nil")
 		// Should have at least one transaction from the swap test
-		require.GreaterOrEqual(t, len(resp.History), 0, "history may be empty if ICA is not fully setup")
+		require.GreaterOrEqual(t, len(resp.Transactions), 0, "history may be empty if ICA is not fully setup")
 	})
 
 	t.Run("cancel_order", func(t *testing.T) {
@@ -124,7 +124,7 @@ func (suite *EventEmissionTestSuite) TestEventPersistenceAndReplay() {
 
 	// Create a test DID to generate events
 	testDID := fmt.Sprintf("did:sonr:persistence-test-%d", time.Now().Unix())
-	txResp := suite.createTestDID(suite.T(), testDID, suite.userAddrs[0])
+	txResp := suite.createTestDID(suite.T(), testDID)
 
 	// Wait for transaction to be included
 	_, err = suite.cfg.Client.WaitForTx(suite.ctx, txResp.TxHash, 30*time.Second)
@@ -158,7 +158,10 @@ func (suite *EventEmissionTestSuite) TestEventPersistenceAndReplay() {
 
 	suite.T().Run("events_queryable_by_attribute", func(t *testing.T) {
 		// Query events by DID attribute
-		events, err := suite.cfg.Client.QueryEventsByAttribute(suite.ctx, "did", testDID, currentHeight, 0)
+		events, err := suite.cfg.Client.WaitForEvents(suite.ctx, client.EventQuery{
+			Attributes: map[string]string{"did": testDID},
+			MinHeight:  currentHeight,
+		}, 30*time.Second)
 		require.NoError(t, err, "failed to query events by attribute")
 
 		// Should find at least the DID creation event
@@ -188,8 +191,8 @@ func (suite *EventEmissionTestSuite) TestEventQuerying() {
 	}
 
 	var txHashes []string
-	for i, testDID := range testDIDs {
-		txResp := suite.createTestDID(suite.T(), testDID, suite.userAddrs[i%len(suite.userAddrs)])
+	for _, testDID := range testDIDs {
+		txResp := suite.createTestDID(suite.T(), testDID)
 		txHashes = append(txHashes, txResp.TxHash)
 
 		// Wait for transaction
@@ -216,7 +219,7 @@ func (suite *EventEmissionTestSuite) TestEventQuerying() {
 
 	suite.T().Run("query_by_creator", func(t *testing.T) {
 		// Query events by specific creator
-		events, err := suite.cfg.Client.QueryEventsByAttribute(suite.ctx, "creator", suite.userAddrs[0], startHeight, endHeight)
+		events, err := suite.cfg.Client.QueryEventsByAttribute(suite.ctx, "creator", suite.cfg.TestAccount.Address.String(), startHeight, endHeight)
 		require.NoError(t, err, "failed to query by creator")
 
 		// Should find events created by this user
@@ -232,7 +235,7 @@ func (suite *EventEmissionTestSuite) TestEventQuerying() {
 
 	suite.T().Run("complex_query_patterns", func(t *testing.T) {
 		// Test complex query with multiple conditions
-		query := fmt.Sprintf("message.sender='%s' AND tx.height>=%d", suite.userAddrs[0], startHeight)
+		query := fmt.Sprintf("message.sender='%s' AND tx.height>=%d", suite.cfg.TestAccount.Address, startHeight)
 		events, err := suite.cfg.Client.SearchEvents(suite.ctx, query, startHeight, endHeight)
 		require.NoError(t, err, "failed to execute complex query")
 
@@ -251,7 +254,7 @@ func (suite *EventEmissionTestSuite) TestMultiEventTransactions() {
 
 	// For this test, we'll simulate a transaction that creates a DID with services
 	// which should emit both EventDIDCreated and EventServiceAdded
-	txResp := suite.createTestDIDWithService(suite.T(), testDID, suite.userAddrs[0])
+	txResp := suite.createTestDIDWithService(suite.T(), testDID)
 
 	// Wait for transaction to be included
 	finalTx, err := suite.cfg.Client.WaitForTx(suite.ctx, txResp.TxHash, 30*time.Second)
@@ -343,7 +346,7 @@ func (suite *EventEmissionTestSuite) TestEventSubscription() {
 
 		// Create a transaction to trigger an event
 		testDID := fmt.Sprintf("did:sonr:websocket-test-%d", time.Now().Unix())
-		txResp := suite.createTestDID(t, testDID, suite.userAddrs[0])
+		txResp := suite.createTestDID(t, testDID)
 
 		// Wait for the transaction event
 		event, err := subscription.WaitForEvent(suite.ctx, 30*time.Second, func(event *client.SubscriptionEvent) bool {
@@ -371,7 +374,7 @@ func (suite *EventEmissionTestSuite) TestEventSubscription() {
 
 		// Create a DID to trigger an event
 		testDID := fmt.Sprintf("did:sonr:did-sub-test-%d", time.Now().Unix())
-		_ = suite.createTestDID(t, testDID, suite.userAddrs[0])
+		_ = suite.createTestDID(t, testDID)
 
 		// Wait for the DID event
 		event, err := subscription.WaitForEventByType(suite.ctx, 30*time.Second, "EventDIDCreated")
@@ -406,10 +409,10 @@ func (suite *EventEmissionTestSuite) TestEventAttributeValidation() {
 	suite.T().Log("Testing event attribute validation")
 
 	testDID := fmt.Sprintf("did:sonr:attr-test-%d", time.Now().Unix())
-	creator := suite.userAddrs[0]
+	creator := suite.cfg.TestAccount.Address.String()
 
 	// Create a test DID
-	txResp := suite.createTestDID(suite.T(), testDID, creator)
+	txResp := suite.createTestDID(suite.T(), testDID)
 
 	// Wait for transaction
 	finalTx, err := suite.cfg.Client.WaitForTx(suite.ctx, txResp.TxHash, 30*time.Second)
@@ -453,84 +456,20 @@ func (suite *EventEmissionTestSuite) TestEventAttributeValidation() {
 
 // Helper methods for creating test transactions
 
-func (suite *EventEmissionTestSuite) createTestDID(t *testing.T, didID, creator string) *client.TxResponse {
-	// This is a placeholder - in a real implementation, you would:
-	// 1. Build a proper MsgCreateDID transaction
-	// 2. Sign it with the creator's key
-	// 3. Broadcast it to the network
-
-	// For now, we'll simulate this by creating a mock transaction response
-	// In the actual implementation, you would use the actual transaction building logic
-
-	t.Logf("Creating test DID: %s by creator: %s", didID, creator)
-
-	// Placeholder - replace with actual transaction building and broadcasting
-	return &client.TxResponse{
-		TxHash: fmt.Sprintf("mock-tx-%d", time.Now().Unix()),
-		Code:   0,
-		Height: fmt.Sprintf("%d", time.Now().Unix()),
-		Events: []struct {
-			Type       string `json:"type"`
-			Attributes []struct {
-				Key   string `json:"key"`
-				Value string `json:"value"`
-			} `json:"attributes"`
-		}{
-			{
-				Type: "did.v1.EventDIDCreated",
-				Attributes: []struct {
-					Key   string `json:"key"`
-					Value string `json:"value"`
-				}{
-					{Key: "did", Value: didID},
-					{Key: "creator", Value: creator},
-					{Key: "block_height", Value: fmt.Sprintf("%d", time.Now().Unix())},
-				},
-			},
-		},
-	}
+// createTestDID creates didID on chain, signed by the suite's test account
+func (suite *EventEmissionTestSuite) createTestDID(t *testing.T, didID string) *client.TxResponse {
+	t.Logf("Creating test DID: %s by creator: %s", didID, suite.cfg.TestAccount.Address)
+	return utils.CreateTestDID(t, suite.cfg, utils.WithDIDID(didID)).TxResponse
 }
 
-func (suite *EventEmissionTestSuite) createTestDIDWithService(t *testing.T, didID, creator string) *client.TxResponse {
-	// Similar to createTestDID but includes service creation
-	t.Logf("Creating test DID with service: %s by creator: %s", didID, creator)
-
-	return &client.TxResponse{
-		TxHash: fmt.Sprintf("mock-tx-with-service-%d", time.Now().Unix()),
-		Code:   0,
-		Height: fmt.Sprintf("%d", time.Now().Unix()),
-		Events: []struct {
-			Type       string `json:"type"`
-			Attributes []struct {
-				Key   string `json:"key"`
-				Value string `json:"value"`
-			} `json:"attributes"`
-		}{
-			{
-				Type: "did.v1.EventDIDCreated",
-				Attributes: []struct {
-					Key   string `json:"key"`
-					Value string `json:"value"`
-				}{
-					{Key: "did", Value: didID},
-					{Key: "creator", Value: creator},
-					{Key: "block_height", Value: fmt.Sprintf("%d", time.Now().Unix())},
-				},
-			},
-			{
-				Type: "did.v1.EventServiceAdded",
-				Attributes: []struct {
-					Key   string `json:"key"`
-					Value string `json:"value"`
-				}{
-					{Key: "did", Value: didID},
-					{Key: "service_id", Value: didID + "#service-1"},
-					{Key: "type", Value: "LinkedDomains"},
-					{Key: "endpoint", Value: "https://example.com"},
-				},
-			},
-		},
-	}
+// createTestDIDWithService creates didID with a LinkedDomains service
+func (suite *EventEmissionTestSuite) createTestDIDWithService(t *testing.T, didID string) *client.TxResponse {
+	t.Logf("Creating test DID with service: %s by creator: %s", didID, suite.cfg.TestAccount.Address)
+	did := utils.CreateTestDID(t, suite.cfg,
+		utils.WithDIDID(didID),
+		utils.WithDIDService("#service-1", "LinkedDomains", "https://example.com"),
+	)
+	return did.TxResponse
 }
 
 // Individual event test methods
@@ -539,7 +478,7 @@ func (suite *EventEmissionTestSuite) testDIDCreatedEvent(t *testing.T, startHeig
 	t.Log("Testing EventDIDCreated emission")
 
 	testDID := fmt.Sprintf("did:sonr:created-test-%d", time.Now().Unix())
-	txResp := suite.createTestDID(t, testDID, suite.userAddrs[0])
+	txResp := suite.createTestDID(t, testDID)
 
 	// Verify event was emitted
 	didEvents := client.FilterEventsByType(txResp.Events, "EventDIDCreated")
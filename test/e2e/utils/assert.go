@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -20,12 +21,29 @@ type TestConfig struct {
 	NormalDenom    string
 	Client         *client.StarshipClient
 	FaucetClient   *FaucetClient
+	TestAccount    *client.Signer
 	DefaultTimeout time.Duration
 	BlockTime      time.Duration
 }
 
+// TestAccountMnemonicEnv overrides the mnemonic of the account tests sign with
+const TestAccountMnemonicEnv = "E2E_TEST_MNEMONIC"
+
+// defaultTestMnemonic is the acc0 genesis account of fixtures/config.yaml
+const defaultTestMnemonic = "notice oak worry limit wrap speak medal online prefer cluster roof addict " +
+	"wrist behave treat actual wasp year salad speed social layer crew genius"
+
 // NewTestConfig creates a new test configuration
 func NewTestConfig() *TestConfig {
+	mnemonic := os.Getenv(TestAccountMnemonicEnv)
+	if mnemonic == "" {
+		mnemonic = defaultTestMnemonic
+	}
+	signer, err := client.NewSigner("acc0", mnemonic)
+	if err != nil {
+		panic(err)
+	}
+
 	return &TestConfig{
 		ChainID:        "sonrtest_1-1",
 		BaseURL:        "http://localhost:1317",
@@ -36,6 +54,7 @@ func NewTestConfig() *TestConfig {
 		BlockTime:      2 * time.Second,
 		Client:         client.NewStarshipClient("http://localhost:1317"),
 		FaucetClient:   NewFaucetClient("http://localhost:8000"),
+		TestAccount:    signer,
 	}
 }
 
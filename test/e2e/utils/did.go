@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/test/e2e/client"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// TestDIDKeyKind is the verification method type of generated DID keys
+const TestDIDKeyKind = "Ed25519VerificationKey2020"
+
+// TestDID is a DID created on chain by CreateTestDID
+type TestDID struct {
+	// ID is the DID, e.g. did:sonr:e2e-1a2b3c4d
+	ID string
+	// Controller is the address that created and controls the DID
+	Controller string
+	// VerificationMethodID is the id of the generated key's verification method
+	VerificationMethodID string
+	// PrivateKey is the generated key registered as the DID's authentication method
+	PrivateKey ed25519.PrivateKey
+	// Document is the document that was submitted
+	Document didtypes.DIDDocument
+	// TxResponse is the included transaction that created the DID
+	TxResponse *client.TxResponse
+}
+
+// TestDIDOption customizes the document built by CreateTestDID
+type TestDIDOption func(doc *didtypes.DIDDocument)
+
+// WithDIDID sets the DID instead of generating a random one
+func WithDIDID(id string) TestDIDOption {
+	return func(doc *didtypes.DIDDocument) {
+		doc.Id = id
+	}
+}
+
+// WithDIDService adds a service endpoint to the document. The id is
+// relative to the DID, e.g. "#service-1".
+func WithDIDService(id, kind, endpoint string) TestDIDOption {
+	return func(doc *didtypes.DIDDocument) {
+		doc.Service = append(doc.Service, &didtypes.Service{
+			Id:             id,
+			ServiceKind:    kind,
+			SingleEndpoint: endpoint,
+		})
+	}
+}
+
+// NewTestDIDID returns a random DID in the sonr method namespace
+func NewTestDIDID() (string, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate DID suffix: %w", err)
+	}
+	return "did:sonr:e2e-" + hex.EncodeToString(suffix), nil
+}
+
+// BuildTestDID generates an Ed25519 key and builds a MsgCreateDID whose
+// document is controlled by signer and authenticates with the new key
+func BuildTestDID(signer *client.Signer, opts ...TestDIDOption) (*didtypes.MsgCreateDID, ed25519.PrivateKey, error) {
+	if signer == nil {
+		return nil, nil, fmt.Errorf("no signer configured")
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate DID key: %w", err)
+	}
+
+	controller := signer.Address.String()
+	doc := didtypes.DIDDocument{PrimaryController: controller}
+	for _, opt := range opts {
+		opt(&doc)
+	}
+	if doc.Id == "" {
+		if doc.Id, err = NewTestDIDID(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Relative ids from options are resolved against the final DID
+	for _, service := range doc.Service {
+		if len(service.Id) > 0 && service.Id[0] == '#' {
+			service.Id = doc.Id + service.Id
+		}
+	}
+
+	vmID := doc.Id + "#key-1"
+	doc.VerificationMethod = append(doc.VerificationMethod, &didtypes.VerificationMethod{
+		Id:                     vmID,
+		VerificationMethodKind: TestDIDKeyKind,
+		Controller:             doc.Id,
+		PublicKeyHex:           hex.EncodeToString(pub),
+	})
+	doc.Authentication = append(doc.Authentication, &didtypes.VerificationMethodReference{
+		VerificationMethodId: vmID,
+	})
+
+	return &didtypes.MsgCreateDID{Controller: controller, DidDocument: doc}, priv, nil
+}
+
+// CreateTestDIDTx builds a DID with a generated key, signs it with signer
+// and waits for the transaction to be included
+func CreateTestDIDTx(ctx context.Context, cfg *TestConfig, signer *client.Signer, opts ...TestDIDOption) (*TestDID, error) {
+	msg, priv, err := BuildTestDID(signer, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cfg.Client.SignAndBroadcastTx(ctx, signer, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DID %s: %w", msg.DidDocument.Id, err)
+	}
+
+	return &TestDID{
+		ID:                   msg.DidDocument.Id,
+		Controller:           msg.Controller,
+		VerificationMethodID: msg.DidDocument.VerificationMethod[0].Id,
+		PrivateKey:           priv,
+		Document:             msg.DidDocument,
+		TxResponse:           resp,
+	}, nil
+}
+
+// CreateTestDID provisions a DID controlled by the config's test account,
+// failing the test if it cannot be created
+func CreateTestDID(t *testing.T, cfg *TestConfig, opts ...TestDIDOption) *TestDID {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*cfg.DefaultTimeout)
+	defer cancel()
+
+	did, err := CreateTestDIDTx(ctx, cfg, cfg.TestAccount, opts...)
+	require.NoError(t, err, "failed to create test DID")
+	return did
+}
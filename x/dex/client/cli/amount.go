@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sonr-io/sonr/app/assets"
+)
+
+// amountFormatter resolves precisions from the chain's denom metadata and
+// the asset registry, or from the registry alone when offline
+func amountFormatter(clientCtx client.Context) *assets.Formatter {
+	if clientCtx.Offline {
+		return assets.NewFormatter(assets.DefaultRegistry(), nil)
+	}
+	return assets.NewFormatter(assets.DefaultRegistry(), assets.QueryMetadata{Client: banktypes.NewQueryClient(clientCtx)})
+}
+
+// parseCoinArg reads a coin argument in its base unit ("1000000uatom") or in
+// any registered unit ("1atom")
+func parseCoinArg(cmd *cobra.Command, clientCtx client.Context, name, arg string) (sdk.Coin, error) {
+	coin, err := amountFormatter(clientCtx).ParseCoin(cmd.Context(), arg)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return coin, nil
+}
+
+// displayCoin renders a coin in its display unit, keeping the base amount so
+// nothing is lost to the reader; denoms of unknown precision are shown as is
+func displayCoin(cmd *cobra.Command, clientCtx client.Context, coin sdk.Coin) string {
+	s, err := amountFormatter(clientCtx).Format(cmd.Context(), coin)
+	if err != nil {
+		return coin.String()
+	}
+	return fmt.Sprintf("%s (%s)", s, coin)
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagTimeout is the ICA timeout for swaps and liquidity operations
+	FlagTimeout = "timeout"
+
+	// FlagExpiration is the lifetime of a limit order or OTC offer
+	FlagExpiration = "expiration"
+
+	// FlagTaker is the DID an OTC offer is reserved for
+	FlagTaker = "taker"
+
+	// FlagArbiter is the DID that may settle a disputed OTC offer
+	FlagArbiter = "arbiter"
+)
+
+// blockDeadline turns the duration in flag into an absolute deadline measured
+// from the latest block time, which is what the keeper compares against.
+// Wall-clock time is never used: a skewed local clock would produce deadlines
+// that are already elapsed (or far in the future) from the chain's point of
+// view. A zero duration, or an offline context, yields a zero deadline so the
+// keeper applies its own default.
+func blockDeadline(cmd *cobra.Command, clientCtx client.Context, flag string) (time.Time, error) {
+	d, err := cmd.Flags().GetDuration(flag)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d == 0 || clientCtx.Offline {
+		return time.Time{}, nil
+	}
+	if d < 0 {
+		return time.Time{}, fmt.Errorf("--%s must be positive", flag)
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return time.Time{}, err
+	}
+	status, err := node.Status(cmd.Context())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to query latest block time: %w", err)
+	}
+	return status.SyncInfo.LatestBlockTime.Add(d), nil
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,10 +26,24 @@ func NewQueryCmd() *cobra.Command {
 		CmdQueryParams(),
 		CmdQueryAccount(),
 		CmdQueryAccounts(),
+		CmdQueryICAAddress(),
 		CmdQueryBalance(),
 		CmdQueryPool(),
+		CmdQueryEstimateSwap(),
+		CmdQuerySwapRoute(),
 		CmdQueryOrders(),
+		CmdQueryOrdersByDID(),
 		CmdQueryHistory(),
+		CmdQueryDenomFilter(),
+		CmdQueryDailyVolume(),
+		CmdQueryCollectedFees(),
+		CmdQueryProtocolPosition(),
+		CmdQueryProtocolPositions(),
+		CmdQueryOTCOffer(),
+		CmdQueryOTCOffers(),
+		CmdQueryTWAMMOrder(),
+		CmdQueryPriceHistory(),
+		CmdQueryDWNActivity(),
 	)
 
 	return cmd
@@ -92,6 +107,38 @@ func CmdQueryAccount() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryICAAddress queries the ICA port and address of a DEX account
+func CmdQueryICAAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-address [did] [connection-id]",
+		Short: "Query the interchain account port and address of a DID on a connection",
+		Long: `Query the controller port and owner a DEX account is registered under, which
+are known before registration, and its host chain address once the host has
+opened the account.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ICAAddress(context.Background(), &types.QueryICAAddressRequest{
+				Did:          args[0],
+				ConnectionId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryAccounts queries all DEX accounts
 func CmdQueryAccounts() *cobra.Command {
 	cmd := &cobra.Command{
@@ -170,7 +217,7 @@ func CmdQueryBalance() *cobra.Command {
 func CmdQueryPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pool [connection-id] [pool-id]",
-		Short: "Query pool information on a remote chain",
+		Short: "Query the latest reserves read from a host chain pool",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -198,6 +245,74 @@ func CmdQueryPool() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryEstimateSwap quotes the output and platform fee of a swap
+func CmdQueryEstimateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap [connection-id] [token-in] [token-out-denom]",
+		Short: "Estimate the output and platform fee of a swap",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, _ := cmd.Flags().GetUint64("pool-id")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimateSwap(context.Background(), &types.QueryEstimateSwapRequest{
+				ConnectionId:  args[0],
+				TokenIn:       args[1],
+				TokenOutDenom: args[2],
+				PoolId:        poolID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64("pool-id", 0, "Pool to swap through")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQuerySwapRoute finds the best route for a swap through configured pools
+func CmdQuerySwapRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route [connection-id] [token-in] [token-out-denom]",
+		Short: "Find the route with the best estimated output for a swap",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxHops, _ := cmd.Flags().GetUint32(FlagMaxHops)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SwapRoute(context.Background(), &types.QuerySwapRouteRequest{
+				ConnectionId:  args[0],
+				TokenIn:       args[1],
+				TokenOutDenom: args[2],
+				MaxHops:       maxHops,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxHops, 0, "Most hops the route may take (0 uses the chain default)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryOrders queries orders for a DID
 func CmdQueryOrders() *cobra.Command {
 	cmd := &cobra.Command{
@@ -241,6 +356,46 @@ func CmdQueryOrders() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryOrdersByDID queries the orders of a DID on every connection
+func CmdQueryOrdersByDID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders-by-did [did]",
+		Short: "Query orders for a DID across all connections",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, _ := cmd.Flags().GetString("status")
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrdersByDID(context.Background(), &types.QueryOrdersByDIDRequest{
+				Did:        args[0],
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("status", "", "Filter by order status (open|filled|cancelled|expired)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "orders-by-did")
+	return cmd
+}
+
 // CmdQueryHistory queries transaction history
 func CmdQueryHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -284,3 +439,340 @@ func CmdQueryHistory() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "history")
 	return cmd
 }
+
+// CmdQueryDenomFilter queries the denom allow/deny list for a connection
+func CmdQueryDenomFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-filter [connection-id]",
+		Short: "Query the governance-managed denom allow/deny list for a connection",
+		Long: `Query the denom allow/deny list for a connection. Clients can use this to
+grey out assets that cannot be swapped. An empty result means every denom is allowed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomFilter(context.Background(), &types.QueryDenomFilterRequest{
+				ConnectionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryDailyVolume queries a DID's swap volume today and its remaining allowance
+func CmdQueryDailyVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daily-volume [did]",
+		Short: "Query a DID's swap volume today and the allowance left under the daily cap",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DailyVolume(context.Background(), &types.QueryDailyVolumeRequest{
+				Did: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCollectedFees queries the platform fees collected on a connection
+func CmdQueryCollectedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collected-fees [connection-id]",
+		Short: "Query the platform fees collected on a connection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CollectedFees(context.Background(), &types.QueryCollectedFeesRequest{
+				ConnectionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryProtocolPosition queries the protocol-owned liquidity in a pool
+func CmdQueryProtocolPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-position [connection-id] [pool-id]",
+		Short: "Query the protocol-owned liquidity in a host chain pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProtocolPosition(context.Background(), &types.QueryProtocolPositionRequest{
+				ConnectionId: args[0],
+				PoolId:       poolID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryProtocolPositions lists the protocol-owned liquidity in every pool
+func CmdQueryProtocolPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-positions",
+		Short: "List the protocol-owned liquidity in every pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProtocolPositions(context.Background(), &types.QueryProtocolPositionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "protocol-positions")
+	return cmd
+}
+
+// CmdQueryOTCOffer queries an OTC offer by ID
+func CmdQueryOTCOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-offer [offer-id]",
+		Short: "Query an OTC offer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OTCOffer(context.Background(), &types.QueryOTCOfferRequest{
+				OfferId: offerID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryTWAMMOrder queries the progress of a TWAMM order
+func CmdQueryTWAMMOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twamm-order [order-id]",
+		Short: "Query the progress of a TWAMM order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TWAMMOrder(context.Background(), &types.QueryTWAMMOrderRequest{
+				OrderId: orderID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceHistory queries the aggregated price history of a denom
+func CmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [denom]",
+		Short: "Query the aggregated price history of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, _ := cmd.Flags().GetInt64("from")
+			to, _ := cmd.Flags().GetInt64("to")
+			resolution, _ := cmd.Flags().GetUint64("resolution")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceHistory(context.Background(), &types.QueryPriceHistoryRequest{
+				Denom:             args[0],
+				From:              from,
+				To:                to,
+				ResolutionSeconds: resolution,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64("from", 0, "Unix time to start at")
+	cmd.Flags().Int64("to", 0, "Unix time to end at (defaults to the block time)")
+	cmd.Flags().Uint64("resolution", 0, "Bucket length in seconds, a multiple of the epoch length (defaults to one epoch)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryOTCOffers lists OTC offers
+func CmdQueryOTCOffers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-offers",
+		Short: "List OTC offers, optionally those involving a DID",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did, _ := cmd.Flags().GetString("did")
+			status, _ := cmd.Flags().GetString("status")
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OTCOffers(context.Background(), &types.QueryOTCOffersRequest{
+				Did:        did,
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("did", "", "Only offers the DID makes, takes or arbitrates")
+	cmd.Flags().String("status", "", "Filter by offer status (open|funded|completed|cancelled|expired|refunded)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "otc-offers")
+	return cmd
+}
+
+// CmdQueryDWNActivity reads the DEX activity stored in a DID's DWN vault
+func CmdQueryDWNActivity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dwn-activity [did]",
+		Short: "Read the DEX activity stored in a DID's DWN vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DWNActivity(context.Background(), &types.QueryDWNActivityRequest{
+				Did:        args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dwn-activity")
+	return cmd
+}
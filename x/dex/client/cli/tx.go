@@ -33,6 +33,14 @@ func NewTxCmd() *cobra.Command {
 		CmdRemoveLiquidity(),
 		CmdCreateLimitOrder(),
 		CmdCancelOrder(),
+		CmdCreateTWAMMOrder(),
+		CmdCancelTWAMMOrder(),
+		CmdCreateOTCOffer(),
+		CmdAcceptOTCOffer(),
+		CmdConfirmOTCOffer(),
+		CmdCancelOTCOffer(),
+		CmdResolveOTCOffer(),
+		CmdRefreshPoolReserves(),
 	)
 
 	return cmd
@@ -72,12 +80,43 @@ func CmdRegisterDEXAccount() *cobra.Command {
 	return cmd
 }
 
+const (
+	// FlagRoute is the pool route of a swap
+	FlagRoute = "route"
+
+	// FlagMaxHops limits the hops of a route chosen by the swap router
+	FlagMaxHops = "max-hops"
+)
+
 // CmdExecuteSwap returns a command to execute a swap
 func CmdExecuteSwap() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "swap [did] [connection-id] [token-in] [token-out-denom] [min-amount-out] [pool-id]",
+		Use:   "swap [did] [connection-id] [token-in] [token-out-denom] [min-amount-out]",
 		Short: "Execute a token swap through ICA",
-		Args:  cobra.ExactArgs(6),
+		Long: `Execute a token swap on the host chain through ICA along the pools in --route.
+
+A route lists one or more hops as pool:<id>, optionally followed by the denom the
+hop outputs (pool:<id>:<denom>). The last hop outputs token-out-denom, and
+intermediate hops without a denom output uosmo.
+
+Without --route the chain's swap router picks the route through its configured
+pools with the best estimated output after fees, taking at most --max-hops hops,
+and the chosen route is signed into the transaction.
+
+With --offline, as when generating a transaction for an air-gapped signer,
+--route is required and --timeout falls back to the chain default since
+neither the router nor the latest block time can be queried.
+
+token-in may be given in its base unit (1000uatom) or in any unit of a
+registered asset (0.001atom), converted with the precision in the chain's
+denom metadata. min-amount-out is always in the base unit of token-out-denom.
+
+Example:
+  snrd tx dex swap did:sonr:alice connection-0 1000uatom ujuno 900 --route pool:1,pool:42 --from alice
+  snrd tx dex swap did:sonr:alice connection-0 1000uatom ujuno 900 --max-hops 2 --from alice
+  snrd tx dex swap did:sonr:alice connection-0 1000uatom ujuno 900 --route pool:1 --from idx1... \
+    --generate-only --offline --account-number 12 --sequence 3 --chain-id sonrtest_1-1 > unsigned.json`,
+		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -87,9 +126,9 @@ func CmdExecuteSwap() *cobra.Command {
 			did := args[0]
 			connectionID := args[1]
 
-			tokenIn, err := sdk.ParseCoinNormalized(args[2])
+			tokenIn, err := parseCoinArg(cmd, clientCtx, "token-in", args[2])
 			if err != nil {
-				return fmt.Errorf("invalid token-in: %w", err)
+				return err
 			}
 
 			tokenOutDenom := args[3]
@@ -99,9 +138,42 @@ func CmdExecuteSwap() *cobra.Command {
 				return fmt.Errorf("invalid min-amount-out: %s", args[4])
 			}
 
-			poolID, err := strconv.ParseUint(args[5], 10, 64)
+			route, err := cmd.Flags().GetString(FlagRoute)
 			if err != nil {
-				return fmt.Errorf("invalid pool-id: %w", err)
+				return err
+			}
+			if route == "" && clientCtx.Offline {
+				return fmt.Errorf("--%s is required offline, the swap router needs network access", FlagRoute)
+			}
+			if route == "" {
+				maxHops, _ := cmd.Flags().GetUint32(FlagMaxHops)
+				res, err := types.NewQueryClient(clientCtx).SwapRoute(cmd.Context(), &types.QuerySwapRouteRequest{
+					ConnectionId:  connectionID,
+					TokenIn:       tokenIn.String(),
+					TokenOutDenom: tokenOutDenom,
+					MaxHops:       maxHops,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to find a swap route: %w", err)
+				}
+				route = res.Route
+				expected := res.TokenOut
+				if coin, err := sdk.ParseCoinNormalized(res.TokenOut); err == nil {
+					expected = displayCoin(cmd, clientCtx, coin)
+				}
+				cmd.PrintErrf("Routing through %s, expecting %s\n", route, expected)
+			}
+			hops, err := types.ParseSwapRoute(route)
+			if err != nil {
+				return fmt.Errorf("invalid --%s: %w", FlagRoute, err)
+			}
+			if _, err := types.ResolveSwapRoute(hops, tokenIn.Denom, tokenOutDenom); err != nil {
+				return fmt.Errorf("invalid --%s: %w", FlagRoute, err)
+			}
+
+			timeout, err := blockDeadline(cmd, clientCtx, FlagTimeout)
+			if err != nil {
+				return err
 			}
 
 			msg := &types.MsgExecuteSwap{
@@ -111,7 +183,8 @@ func CmdExecuteSwap() *cobra.Command {
 				TargetDenom:  tokenOutDenom,
 				Amount:       tokenIn.Amount,
 				MinAmountOut: minAmountOut,
-				Route:        fmt.Sprintf("pool:%d", poolID),
+				Route:        route,
+				Timeout:      timeout,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -122,6 +195,10 @@ func CmdExecuteSwap() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagRoute, "", "Pools to swap through, e.g. pool:1,pool:42 (default: the best route found by the swap router)")
+	cmd.Flags().Uint32(FlagMaxHops, 0, "Most hops of a route found by the swap router (0 uses the chain default)")
+	cmd.Flags().Duration(FlagTimeout, 5*time.Minute, "ICA timeout, measured from the latest block time (0 uses the chain default)")
+	cmd.MarkFlagsMutuallyExclusive(FlagRoute, FlagMaxHops)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -146,14 +223,14 @@ func CmdProvideLiquidity() *cobra.Command {
 				return fmt.Errorf("invalid pool-id: %w", err)
 			}
 
-			tokenA, err := sdk.ParseCoinNormalized(args[3])
+			tokenA, err := parseCoinArg(cmd, clientCtx, "token-a", args[3])
 			if err != nil {
-				return fmt.Errorf("invalid token-a: %w", err)
+				return err
 			}
 
-			tokenB, err := sdk.ParseCoinNormalized(args[4])
+			tokenB, err := parseCoinArg(cmd, clientCtx, "token-b", args[4])
 			if err != nil {
-				return fmt.Errorf("invalid token-b: %w", err)
+				return err
 			}
 
 			minShares, ok := math.NewIntFromString(args[5])
@@ -161,13 +238,18 @@ func CmdProvideLiquidity() *cobra.Command {
 				return fmt.Errorf("invalid min-shares: %s", args[5])
 			}
 
+			timeout, err := blockDeadline(cmd, clientCtx, FlagTimeout)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgProvideLiquidity{
 				Did:          did,
 				ConnectionId: connectionID,
 				PoolId:       fmt.Sprintf("%d", poolID),
 				Assets:       sdk.NewCoins(tokenA, tokenB),
 				MinShares:    minShares,
-				Timeout:      time.Now().Add(5 * time.Minute),
+				Timeout:      timeout,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -178,6 +260,7 @@ func CmdProvideLiquidity() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(FlagTimeout, 5*time.Minute, "ICA timeout, measured from the latest block time (0 uses the chain default)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -217,6 +300,11 @@ func CmdRemoveLiquidity() *cobra.Command {
 				return fmt.Errorf("invalid min-amount-b: %s", args[5])
 			}
 
+			timeout, err := blockDeadline(cmd, clientCtx, FlagTimeout)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRemoveLiquidity{
 				Did:          did,
 				ConnectionId: connectionID,
@@ -226,7 +314,7 @@ func CmdRemoveLiquidity() *cobra.Command {
 					sdk.NewCoin("token", minAmountA),
 					sdk.NewCoin("token", minAmountB),
 				),
-				Timeout: time.Now().Add(5 * time.Minute),
+				Timeout: timeout,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -237,6 +325,7 @@ func CmdRemoveLiquidity() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(FlagTimeout, 5*time.Minute, "ICA timeout, measured from the latest block time (0 uses the chain default)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -256,9 +345,9 @@ func CmdCreateLimitOrder() *cobra.Command {
 			did := args[0]
 			connectionID := args[1]
 
-			tokenIn, err := sdk.ParseCoinNormalized(args[2])
+			tokenIn, err := parseCoinArg(cmd, clientCtx, "token-in", args[2])
 			if err != nil {
-				return fmt.Errorf("invalid token-in: %w", err)
+				return err
 			}
 
 			tokenOutDenom := args[3]
@@ -268,6 +357,11 @@ func CmdCreateLimitOrder() *cobra.Command {
 				return fmt.Errorf("invalid price: %w", err)
 			}
 
+			expiration, err := blockDeadline(cmd, clientCtx, FlagExpiration)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateLimitOrder{
 				Did:          did,
 				ConnectionId: connectionID,
@@ -275,7 +369,7 @@ func CmdCreateLimitOrder() *cobra.Command {
 				BuyDenom:     tokenOutDenom,
 				Amount:       tokenIn.Amount,
 				Price:        price,
-				Expiration:   time.Now().Add(24 * time.Hour),
+				Expiration:   expiration,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -286,6 +380,7 @@ func CmdCreateLimitOrder() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(FlagExpiration, 24*time.Hour, "Order lifetime, measured from the latest block time (0 for good-til-cancelled)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -323,3 +418,344 @@ func CmdCancelOrder() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdCreateTWAMMOrder returns a command to swap in slices across blocks
+func CmdCreateTWAMMOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twamm-create [did] [connection-id] [pool-id] [token-in] [token-out-denom] [min-amount-out] [slices] [interval-blocks]",
+		Short: "Swap token-in in equal slices, one every interval-blocks blocks",
+		Long: `Swap token-in in equal slices, one every interval-blocks blocks, to limit the
+price impact of a large swap. min-amount-out applies to the whole order and is
+checked pro-rata for every slice; a slice whose estimate falls short is skipped
+and tried again after the interval. Each slice pays the swap fee and counts
+against the DID's rate limits and daily volume.
+
+Example:
+  snrd tx dex twamm-create did:sonr:alice connection-0 1 1000000uatom uosmo 900000 10 5 --from alice`,
+		Args: cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+			tokenIn, err := parseCoinArg(cmd, clientCtx, "token-in", args[3])
+			if err != nil {
+				return err
+			}
+			minAmountOut, ok := math.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid min-amount-out: %s", args[5])
+			}
+			slices, err := strconv.ParseUint(args[6], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid slices: %w", err)
+			}
+			interval, err := strconv.ParseUint(args[7], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid interval-blocks: %w", err)
+			}
+
+			msg := &types.MsgCreateTWAMMOrder{
+				Did:            args[0],
+				ConnectionId:   args[1],
+				PoolId:         poolID,
+				TokenIn:        tokenIn,
+				TokenOutDenom:  args[4],
+				MinAmountOut:   minAmountOut,
+				TotalSlices:    uint32(slices),
+				IntervalBlocks: uint32(interval),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCancelTWAMMOrder returns a command to cancel the remaining slices of a TWAMM order
+func CmdCancelTWAMMOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twamm-cancel [did] [order-id]",
+		Short: "Cancel the slices of a TWAMM order that have not executed yet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			msg := &types.MsgCancelTWAMMOrder{
+				Did:     args[0],
+				OrderId: orderID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCreateOTCOffer returns a command to escrow an OTC offer to another DID
+func CmdCreateOTCOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-create [maker-did] [offer] [ask]",
+		Short: "Escrow coins in an OTC offer in exchange for other coins",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offer, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid offer: %w", err)
+			}
+			ask, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid ask: %w", err)
+			}
+
+			taker, _ := cmd.Flags().GetString(FlagTaker)
+			arbiter, _ := cmd.Flags().GetString(FlagArbiter)
+
+			expiration, err := blockDeadline(cmd, clientCtx, FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateOTCOffer{
+				Sender:     clientCtx.GetFromAddress().String(),
+				MakerDid:   args[0],
+				TakerDid:   taker,
+				Offer:      offer,
+				Ask:        ask,
+				ArbiterDid: arbiter,
+				Expiration: expiration,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTaker, "", "DID allowed to take the offer (default: any DID)")
+	cmd.Flags().String(FlagArbiter, "", "DID that may release or refund the escrow once funded")
+	cmd.Flags().Duration(FlagExpiration, 24*time.Hour, "Offer lifetime, measured from the latest block time; unsettled offers are refunded")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdAcceptOTCOffer returns a command to escrow the ask of an open OTC offer
+func CmdAcceptOTCOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-accept [taker-did] [offer-id]",
+		Short: "Take an open OTC offer by escrowing its ask",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %w", err)
+			}
+
+			msg := &types.MsgAcceptOTCOffer{
+				Sender:   clientCtx.GetFromAddress().String(),
+				TakerDid: args[0],
+				OfferId:  offerID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdConfirmOTCOffer returns a command to confirm a funded OTC offer
+func CmdConfirmOTCOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-confirm [did] [offer-id]",
+		Short: "Confirm a funded OTC offer; the trade settles once both parties confirm",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %w", err)
+			}
+
+			msg := &types.MsgConfirmOTCOffer{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Did:     args[0],
+				OfferId: offerID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCancelOTCOffer returns a command to withdraw an OTC offer nobody has taken
+func CmdCancelOTCOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-cancel [maker-did] [offer-id]",
+		Short: "Withdraw an open OTC offer and refund the escrow",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %w", err)
+			}
+
+			msg := &types.MsgCancelOTCOffer{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Did:     args[0],
+				OfferId: offerID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdResolveOTCOffer returns a command for the arbiter to settle a funded OTC offer
+func CmdResolveOTCOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otc-resolve [arbiter-did] [offer-id] [release|refund]",
+		Short: "Release or refund the escrow of a funded OTC offer as its arbiter",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %w", err)
+			}
+
+			var release bool
+			switch args[2] {
+			case "release":
+				release = true
+			case "refund":
+			default:
+				return fmt.Errorf("invalid resolution %q: must be release or refund", args[2])
+			}
+
+			msg := &types.MsgResolveOTCOffer{
+				Sender:     clientCtx.GetFromAddress().String(),
+				ArbiterDid: args[0],
+				OfferId:    offerID,
+				Release:    release,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRefreshPoolReserves returns a command to read a pool's reserves over ICA
+func CmdRefreshPoolReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh-pool [did] [connection-id] [pool-id]",
+		Short: "Query the reserves of a host chain pool through a DID's DEX account",
+		Long: `Query the reserves of a host chain pool through a DID's DEX account.
+Once the query is acknowledged, swap estimates through the pool are priced
+against its reserves until they are older than the pricing TTL.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+
+			msg := &types.MsgRefreshPoolReserves{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Did:          args[0],
+				ConnectionId: args[1],
+				PoolId:       poolID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// GetArbitrageParams returns the opposite swap detection parameters, falling
+// back to defaults before the module params are set
+func (k Keeper) GetArbitrageParams(ctx sdk.Context) types.ArbitrageParams {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultArbitrageParams()
+	}
+	return params.Arbitrage
+}
+
+// checkOppositeSwap records the swap direction for the DID and looks for the
+// reverse direction submitted on another connection within the window. When
+// one is found the configured action is applied: a warn only emits an event,
+// a fee returns the extra fee to charge on tokenIn and a reject fails the swap.
+func (k Keeper) checkOppositeSwap(
+	ctx sdk.Context,
+	did string,
+	connectionID string,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+) (sdk.Coin, error) {
+	noFee := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ZeroInt())
+
+	params := k.GetArbitrageParams(ctx)
+	if !params.Enabled {
+		return noFee, nil
+	}
+
+	height := ctx.BlockHeight()
+	opposite, found, err := k.findOppositeIntent(ctx, params, did, connectionID, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return noFee, err
+	}
+
+	intent := types.SwapIntent{
+		ConnectionId:  connectionID,
+		TokenIn:       tokenIn.String(),
+		TokenOutDenom: tokenOutDenom,
+	}
+	key := collections.Join3(height, did, types.SwapPairKey(tokenIn.Denom, tokenOutDenom))
+	if err := k.SwapIntents.Set(ctx, key, intent); err != nil {
+		return noFee, err
+	}
+
+	if !found {
+		return noFee, nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOppositeSwapDetected,
+			sdk.NewAttribute("did", did),
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("opposite_connection", opposite.ConnectionId),
+			sdk.NewAttribute("token_in", tokenIn.String()),
+			sdk.NewAttribute("opposite_token_in", opposite.TokenIn),
+			sdk.NewAttribute("action", params.Action.String()),
+		),
+	)
+
+	switch params.Action {
+	case types.ARBITRAGE_ACTION_REJECT:
+		return noFee, errorsmod.Wrapf(
+			types.ErrOppositeSwap,
+			"%s swapped %s on %s", did, opposite.TokenIn, opposite.ConnectionId,
+		)
+	case types.ARBITRAGE_ACTION_FEE:
+		fee := tokenIn.Amount.MulRaw(int64(params.ExtraFeeBps)).QuoRaw(types.MaxArbitrageFeeBps)
+		return sdk.NewCoin(tokenIn.Denom, fee), nil
+	default:
+		return noFee, nil
+	}
+}
+
+// findOppositeIntent returns the most recent denomOut->denomIn swap the DID
+// submitted on a connection other than connectionID within the window
+func (k Keeper) findOppositeIntent(
+	ctx sdk.Context,
+	params types.ArbitrageParams,
+	did string,
+	connectionID string,
+	denomIn string,
+	denomOut string,
+) (types.SwapIntent, bool, error) {
+	pair := types.SwapPairKey(denomOut, denomIn)
+	height := ctx.BlockHeight()
+
+	for h := height; h >= 0 && h >= height-int64(params.WindowBlocks); h-- {
+		intent, err := k.SwapIntents.Get(ctx, collections.Join3(h, did, pair))
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			continue
+		case err != nil:
+			return types.SwapIntent{}, false, err
+		}
+		if intent.ConnectionId != connectionID {
+			return intent, true, nil
+		}
+	}
+	return types.SwapIntent{}, false, nil
+}
+
+// PruneSwapIntents removes recorded swaps that have fallen outside the detection window
+func (k Keeper) PruneSwapIntents(ctx sdk.Context) error {
+	params := k.GetArbitrageParams(ctx)
+	cutoff := ctx.BlockHeight() - int64(params.WindowBlocks)
+	if cutoff <= 0 {
+		return nil
+	}
+
+	var stale []collections.Triple[int64, string, string]
+	rng := collections.NewPrefixUntilTripleRange[int64, string, string](cutoff - 1)
+	err := k.SwapIntents.Walk(ctx, rng, func(key collections.Triple[int64, string, string], _ types.SwapIntent) (bool, error) {
+		stale = append(stale, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range stale {
+		if err := k.SwapIntents.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to prune swap intent: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+const testOtherConnectionID = "connection-1"
+
+// ArbitrageTestSuite tests opposite swap detection across connections
+type ArbitrageTestSuite struct {
+	suite.Suite
+	f *testFixture
+}
+
+func TestArbitrageSuite(t *testing.T) {
+	suite.Run(t, new(ArbitrageTestSuite))
+}
+
+func (suite *ArbitrageTestSuite) SetupTest() {
+	suite.f = SetupTest(suite.T())
+}
+
+// activeAccount registers an active DEX account for the DID on a connection
+func (suite *ArbitrageTestSuite) activeAccount(did, connectionID string) {
+	account, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, did, connectionID, []string{"swap"})
+	suite.Require().NoError(err)
+
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	suite.Require().NoError(
+		suite.f.k.Accounts.Set(suite.f.ctx, keeper.GetAccountKey(did, connectionID), *account),
+	)
+}
+
+func (suite *ArbitrageTestSuite) swap(ctx sdk.Context, did, connectionID, denomIn, denomOut string) error {
+	_, err := suite.f.k.ExecuteSwap(
+		ctx,
+		did,
+		connectionID,
+		sdk.NewCoin(denomIn, math.NewInt(10_000)),
+		denomOut,
+		math.NewInt(1),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	return err
+}
+
+// setParams updates the arbitrage params through the module params
+func (suite *ArbitrageTestSuite) setParams(params types.ArbitrageParams) {
+	moduleParams, _ := suite.f.k.Params.Get(suite.f.ctx)
+	moduleParams.Arbitrage = params
+	suite.Require().NoError(suite.f.k.UpdateParams(suite.f.ctx, suite.f.govModAddr, moduleParams))
+}
+
+func (suite *ArbitrageTestSuite) detections(ctx sdk.Context) int {
+	n := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeOppositeSwapDetected {
+			n++
+		}
+	}
+	return n
+}
+
+func (suite *ArbitrageTestSuite) TestParamsValidated() {
+	// Detection is on by default, warning on same-block swaps
+	suite.Require().Equal(types.DefaultArbitrageParams(), suite.f.k.GetArbitrageParams(suite.f.ctx))
+	suite.Require().Equal(types.DefaultArbitrageParams(), types.DefaultGenesisState().Params.Arbitrage)
+
+	_, err := suite.f.msgServer.UpdateParams(suite.f.ctx, &types.MsgUpdateParams{
+		Authority: suite.f.govModAddr,
+		Params: types.Params{Arbitrage: types.ArbitrageParams{
+			Enabled: true,
+			Action:  types.ARBITRAGE_ACTION_FEE,
+		}},
+	})
+	suite.Require().Error(err, "fee action requires an extra fee")
+
+	params := types.ArbitrageParams{Enabled: true, Action: types.ARBITRAGE_ACTION_FEE, ExtraFeeBps: 25}
+	_, err = suite.f.msgServer.UpdateParams(suite.f.ctx, &types.MsgUpdateParams{
+		Authority: suite.f.govModAddr,
+		Params:    types.Params{Arbitrage: params},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.f.k.GetArbitrageParams(suite.f.ctx))
+}
+
+func (suite *ArbitrageTestSuite) TestWarnOnOppositeSwap() {
+	did := "did:sonr:arb_1"
+	suite.activeAccount(did, testConnectionID)
+	suite.activeAccount(did, testOtherConnectionID)
+	ctx := suite.f.ctx.WithEventManager(sdk.NewEventManager())
+
+	suite.Require().NoError(suite.swap(ctx, did, testConnectionID, "uatom", "uosmo"))
+	// The same direction again or the reverse on the same connection is not flagged
+	suite.Require().NoError(suite.swap(ctx, did, testConnectionID, "uatom", "uosmo"))
+	suite.Require().NoError(suite.swap(ctx, did, testConnectionID, "uosmo", "uatom"))
+	suite.Require().Zero(suite.detections(ctx))
+
+	suite.Require().NoError(suite.swap(ctx, did, testOtherConnectionID, "uosmo", "uatom"))
+	suite.Require().Equal(1, suite.detections(ctx))
+}
+
+func (suite *ArbitrageTestSuite) TestRejectOppositeSwap() {
+	did := "did:sonr:arb_2"
+	suite.activeAccount(did, testConnectionID)
+	suite.activeAccount(did, testOtherConnectionID)
+
+	params := types.DefaultArbitrageParams()
+	params.Action = types.ARBITRAGE_ACTION_REJECT
+	params.WindowBlocks = 2
+	suite.setParams(params)
+
+	suite.Require().NoError(suite.swap(suite.f.ctx, did, testConnectionID, "uatom", "uosmo"))
+
+	inWindow := suite.f.ctx.WithBlockHeight(suite.f.ctx.BlockHeight() + 2)
+	err := suite.swap(inWindow, did, testOtherConnectionID, "uosmo", "uatom")
+	suite.Require().ErrorIs(err, types.ErrOppositeSwap)
+
+	// Another DID is unaffected
+	other := "did:sonr:arb_3"
+	suite.activeAccount(other, testOtherConnectionID)
+	suite.Require().NoError(suite.swap(inWindow, other, testOtherConnectionID, "uosmo", "uatom"))
+
+	outOfWindow := suite.f.ctx.WithBlockHeight(suite.f.ctx.BlockHeight() + 3)
+	suite.Require().NoError(suite.swap(outOfWindow, did, testOtherConnectionID, "uosmo", "uatom"))
+}
+
+func (suite *ArbitrageTestSuite) TestFeeOnOppositeSwap() {
+	did := "did:sonr:arb_4"
+	suite.activeAccount(did, testConnectionID)
+	suite.activeAccount(did, testOtherConnectionID)
+
+	params := types.DefaultArbitrageParams()
+	params.Action = types.ARBITRAGE_ACTION_FEE
+	params.ExtraFeeBps = 50
+	suite.setParams(params)
+
+	suite.Require().NoError(suite.swap(suite.f.ctx, did, testConnectionID, "uatom", "uosmo"))
+
+	// Charging the fee needs a fee collector
+	err := suite.swap(suite.f.ctx, did, testOtherConnectionID, "uosmo", "uatom")
+	suite.Require().ErrorIs(err, types.ErrOppositeSwap)
+
+	moduleParams, err := suite.f.k.Params.Get(suite.f.ctx)
+	suite.Require().NoError(err)
+	moduleParams.Enabled = true
+	moduleParams.Fees.FeeCollectors = testFeeCollectors
+	suite.Require().NoError(suite.f.k.Params.Set(suite.f.ctx, moduleParams))
+	suite.Require().NoError(suite.swap(suite.f.ctx, did, testOtherConnectionID, "uosmo", "uatom"))
+
+	batch, err := suite.f.k.PendingBatches.Get(suite.f.ctx, keeper.GetAccountKey(did, testOtherConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().Len(batch.Msgs, 2, "swap and extra fee are sent together")
+}
+
+func (suite *ArbitrageTestSuite) TestPruneSwapIntents() {
+	did := "did:sonr:arb_5"
+	suite.activeAccount(did, testConnectionID)
+	suite.Require().NoError(suite.swap(suite.f.ctx, did, testConnectionID, "uatom", "uosmo"))
+
+	// Same block window keeps the swap until the next block
+	suite.Require().NoError(suite.f.k.PruneSwapIntents(suite.f.ctx))
+	has, err := suite.f.k.SwapIntents.Has(suite.f.ctx, suite.intentKey(did))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	next := suite.f.ctx.WithBlockHeight(suite.f.ctx.BlockHeight() + 1)
+	suite.Require().NoError(suite.f.k.PruneSwapIntents(next))
+	has, err = suite.f.k.SwapIntents.Has(suite.f.ctx, suite.intentKey(did))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *ArbitrageTestSuite) intentKey(did string) collections.Triple[int64, string, string] {
+	return collections.Join3(suite.f.ctx.BlockHeight(), did, types.SwapPairKey("uatom", "uosmo"))
+}
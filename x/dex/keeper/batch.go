@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// GetBatchParams returns the ICA batching parameters, falling back to defaults
+func (k Keeper) GetBatchParams(ctx sdk.Context) types.BatchParams {
+	params, err := k.BatchParams.Get(ctx)
+	if err != nil {
+		return types.DefaultBatchParams()
+	}
+	return params
+}
+
+// SetBatchParams validates and stores the ICA batching parameters
+func (k Keeper) SetBatchParams(ctx sdk.Context, params types.BatchParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.BatchParams.Set(ctx, params)
+}
+
+// QueueDEXTransaction queues messages for the DID's ICA account so that several
+// operations in the same block share a single CosmosTx packet. If batching is
+// disabled the messages are sent immediately. It returns whether the messages
+// were queued and, if a packet was sent, its sequence.
+func (k Keeper) QueueDEXTransaction(
+	ctx sdk.Context,
+	did string,
+	connectionID string,
+	msgs []sdk.Msg,
+	memo string,
+	timeoutDuration time.Duration,
+) (bool, uint64, error) {
+	params := k.GetBatchParams(ctx)
+	if !params.Enabled {
+		sequence, err := k.SendDEXTransaction(ctx, did, connectionID, msgs, memo, timeoutDuration)
+		return false, sequence, err
+	}
+
+	account, err := k.GetDEXAccount(ctx, did, connectionID)
+	if err != nil {
+		return false, 0, err
+	}
+	if account.Status != types.ACCOUNT_STATUS_ACTIVE {
+		return false, 0, types.ErrAccountNotActive
+	}
+
+	anys := make([]*codectypes.Any, 0, len(msgs))
+	var size uint64
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return false, 0, fmt.Errorf("failed to pack message: %w", err)
+		}
+		anys = append(anys, anyMsg)
+		size += uint64(anyMsg.Size())
+	}
+
+	if uint64(len(anys)) > uint64(params.MaxMsgsPerPacket) || size > params.MaxPacketBytes {
+		return false, 0, fmt.Errorf(
+			"%w: operation exceeds batch limits (%d msgs, %d bytes)",
+			types.ErrICAOperationFailed, len(anys), size,
+		)
+	}
+
+	accountKey := GetAccountKey(did, connectionID)
+	batch, err := k.PendingBatches.Get(ctx, accountKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, 0, err
+	}
+
+	// Flush first if the new messages would overflow the host-side limits
+	var sequence uint64
+	if len(batch.Msgs) > 0 &&
+		(uint64(len(batch.Msgs)+len(anys)) > uint64(params.MaxMsgsPerPacket) ||
+			batch.Bytes+size > params.MaxPacketBytes) {
+		sequence, err = k.flushBatch(ctx, accountKey, batch)
+		if err != nil {
+			return false, 0, err
+		}
+		batch = types.PendingICABatch{}
+	}
+
+	if len(batch.Msgs) == 0 {
+		batch = types.PendingICABatch{
+			Did:               did,
+			ConnectionId:      connectionID,
+			FirstQueuedHeight: ctx.BlockHeight(),
+		}
+	}
+
+	batch.Msgs = append(batch.Msgs, anys...)
+	batch.Memos = append(batch.Memos, memo)
+	batch.Bytes += size
+
+	// Use the longest requested timeout so no operation expires early
+	if timeout := uint64(timeoutDuration.Seconds()); timeout > batch.TimeoutSeconds {
+		batch.TimeoutSeconds = timeout
+	}
+
+	if err := k.PendingBatches.Set(ctx, accountKey, batch); err != nil {
+		return false, 0, fmt.Errorf("failed to queue ICA messages: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeICABatchQueued,
+			sdk.NewAttribute("did", did),
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("queued_msgs", fmt.Sprintf("%d", len(batch.Msgs))),
+		),
+	)
+
+	return true, sequence, nil
+}
+
+// FlushPendingBatches sends every queued batch that has waited at least the
+// configured flush interval. When force is set, all batches are flushed.
+func (k Keeper) FlushPendingBatches(ctx sdk.Context, force bool) error {
+	params := k.GetBatchParams(ctx)
+
+	type pending struct {
+		key   string
+		batch types.PendingICABatch
+	}
+	var ready []pending
+
+	err := k.PendingBatches.Walk(ctx, nil, func(key string, batch types.PendingICABatch) (bool, error) {
+		age := ctx.BlockHeight() - batch.FirstQueuedHeight
+		if force || age >= int64(params.FlushIntervalBlocks)-1 {
+			ready = append(ready, pending{key: key, batch: batch})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range ready {
+		// Flush in a cached context so a failed send leaves no partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.flushBatch(cacheCtx, p.key, p.batch); err != nil {
+			// Keep the batch queued so it is retried on the next flush
+			k.Logger(ctx).Error("failed to flush ICA batch",
+				"did", p.batch.Did,
+				"connection", p.batch.ConnectionId,
+				"msgs", len(p.batch.Msgs),
+				"error", err,
+			)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// flushBatch sends a queued batch as one CosmosTx packet and clears it
+func (k Keeper) flushBatch(ctx sdk.Context, accountKey string, batch types.PendingICABatch) (uint64, error) {
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: batch.Msgs})
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize batch: %w", err)
+	}
+
+	timeout := time.Duration(batch.TimeoutSeconds) * time.Second
+	if timeout == 0 {
+		timeout = k.DefaultICATimeout(ctx)
+	}
+
+	sequence, err := k.sendICAPacket(
+		ctx,
+		batch.Did,
+		batch.ConnectionId,
+		data,
+		strings.Join(batch.Memos, ";"),
+		timeout,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.PendingBatches.Remove(ctx, accountKey); err != nil {
+		return 0, fmt.Errorf("failed to clear ICA batch: %w", err)
+	}
+	if err := k.linkBatchedSwaps(ctx, accountKey, sequence); err != nil {
+		return 0, fmt.Errorf("failed to link batched swaps: %w", err)
+	}
+	account, err := k.GetDEXAccount(ctx, batch.Did, batch.ConnectionId)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.linkBatchedFees(ctx, accountKey, account.PortId, sequence); err != nil {
+		return 0, fmt.Errorf("failed to link batched fees: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeICABatchFlushed,
+			sdk.NewAttribute("did", batch.Did),
+			sdk.NewAttribute("connection", batch.ConnectionId),
+			sdk.NewAttribute("msgs", fmt.Sprintf("%d", len(batch.Msgs))),
+			sdk.NewAttribute("bytes", fmt.Sprintf("%d", batch.Bytes)),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+		),
+	)
+
+	return sequence, nil
+}
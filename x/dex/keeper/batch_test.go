@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// BatchTestSuite tests ICA message batching
+type BatchTestSuite struct {
+	suite.Suite
+	f *testFixture
+}
+
+func TestBatchSuite(t *testing.T) {
+	suite.Run(t, new(BatchTestSuite))
+}
+
+func (suite *BatchTestSuite) SetupTest() {
+	suite.f = SetupTest(suite.T())
+}
+
+// activeAccount registers a DEX account and marks it active
+func (suite *BatchTestSuite) activeAccount(did string) types.InterchainDEXAccount {
+	account, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, did, testConnectionID, []string{"swap"})
+	suite.Require().NoError(err)
+
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	suite.Require().NoError(
+		suite.f.k.Accounts.Set(suite.f.ctx, keeper.GetAccountKey(did, testConnectionID), *account),
+	)
+	return *account
+}
+
+// claimChannel creates the channel capability the ICA packet is sent with
+func (suite *BatchTestSuite) claimChannel(account types.InterchainDEXAccount) {
+	_, err := suite.f.k.ScopedKeeper.NewCapability(
+		suite.f.ctx,
+		host.ChannelCapabilityPath(account.PortId, "channel-0"),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *BatchTestSuite) swap(did string) (uint64, error) {
+	return suite.f.k.ExecuteSwap(
+		suite.f.ctx,
+		did,
+		testConnectionID,
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+}
+
+func (suite *BatchTestSuite) TestOperationsAreQueued() {
+	did := "did:sonr:batch_1"
+	suite.activeAccount(did)
+
+	for range 2 {
+		sequence, err := suite.swap(did)
+		suite.Require().NoError(err)
+		suite.Require().Zero(sequence, "queued operations have no packet sequence yet")
+	}
+
+	batch, err := suite.f.k.PendingBatches.Get(suite.f.ctx, keeper.GetAccountKey(did, testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().Len(batch.Msgs, 2)
+	suite.Require().Len(batch.Memos, 2)
+	suite.Require().Equal(uint64(60), batch.TimeoutSeconds)
+}
+
+func (suite *BatchTestSuite) TestDisabledBatchingSendsImmediately() {
+	did := "did:sonr:batch_2"
+	account := suite.activeAccount(did)
+	suite.claimChannel(account)
+
+	params := types.DefaultBatchParams()
+	params.Enabled = false
+	suite.Require().NoError(suite.f.k.SetBatchParams(suite.f.ctx, params))
+
+	sequence, err := suite.swap(did)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+
+	has, err := suite.f.k.PendingBatches.Has(suite.f.ctx, keeper.GetAccountKey(did, testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *BatchTestSuite) TestFlushSendsQueuedBatch() {
+	did := "did:sonr:batch_3"
+	account := suite.activeAccount(did)
+	suite.claimChannel(account)
+
+	_, err := suite.swap(did)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, false))
+
+	has, err := suite.f.k.PendingBatches.Has(suite.f.ctx, keeper.GetAccountKey(did, testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	var flushed bool
+	for _, event := range suite.f.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeICABatchFlushed {
+			flushed = true
+		}
+	}
+	suite.Require().True(flushed)
+}
+
+func (suite *BatchTestSuite) TestFailedFlushKeepsBatch() {
+	did := "did:sonr:batch_4"
+	suite.activeAccount(did)
+
+	_, err := suite.swap(did)
+	suite.Require().NoError(err)
+
+	// Without a channel capability the send fails and nothing is written
+	before := len(suite.f.ctx.EventManager().Events())
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+	suite.Require().Len(suite.f.ctx.EventManager().Events(), before)
+
+	batch, err := suite.f.k.PendingBatches.Get(suite.f.ctx, keeper.GetAccountKey(did, testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().Len(batch.Msgs, 1)
+}
+
+func (suite *BatchTestSuite) TestUpdateBatchParams() {
+	params := types.BatchParams{
+		Enabled:             true,
+		MaxMsgsPerPacket:    4,
+		MaxPacketBytes:      4096,
+		FlushIntervalBlocks: 3,
+	}
+
+	// Only the module authority may update the params
+	_, err := suite.f.msgServer.UpdateBatchParams(suite.f.ctx, &types.MsgUpdateBatchParams{
+		Authority: suite.f.addrs[0].String(),
+		Params:    params,
+	})
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "invalid authority")
+
+	// Invalid params are rejected
+	_, err = suite.f.msgServer.UpdateBatchParams(suite.f.ctx, &types.MsgUpdateBatchParams{
+		Authority: suite.f.govModAddr,
+		Params:    types.BatchParams{Enabled: true},
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.f.msgServer.UpdateBatchParams(suite.f.ctx, &types.MsgUpdateBatchParams{
+		Authority: suite.f.govModAddr,
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.f.k.GetBatchParams(suite.f.ctx))
+}
+
+func (suite *BatchTestSuite) TestBatchParamsGenesis() {
+	params := types.BatchParams{
+		MaxMsgsPerPacket:    8,
+		MaxPacketBytes:      1024,
+		FlushIntervalBlocks: 2,
+	}
+	suite.Require().NoError(suite.f.k.SetBatchParams(suite.f.ctx, params))
+
+	exported := suite.f.k.ExportGenesis(suite.f.ctx)
+	suite.Require().Equal(params, exported.BatchParams)
+	suite.Require().NoError(exported.Validate())
+
+	// Genesis without batch params falls back to the defaults
+	f := suite.boundFixture()
+	f.k.InitGenesis(f.ctx, types.GenesisState{PortId: types.PortID})
+	suite.Require().Equal(types.DefaultBatchParams(), f.k.GetBatchParams(f.ctx))
+
+	f = suite.boundFixture()
+	f.k.InitGenesis(f.ctx, *exported)
+	suite.Require().Equal(params, f.k.GetBatchParams(f.ctx))
+}
+
+// boundFixture returns a fresh fixture whose module port is already bound, as
+// the test port keeper shares the module's scoped keeper
+func (suite *BatchTestSuite) boundFixture() *testFixture {
+	f := SetupTest(suite.T())
+	_, err := f.k.ScopedKeeper.NewCapability(f.ctx, host.PortPath(types.PortID))
+	suite.Require().NoError(err)
+	return f
+}
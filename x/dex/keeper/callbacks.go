@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// callbackServiceKey carries the service a swap or order is submitted for
+// through the keeper calls of a message
+type callbackServiceKey struct{}
+
+// WithCallbackService records on ctx the service, the audience of the
+// message's UCAN, that swaps and orders are submitted for. Their records
+// keep it, and the events that settle them name it so the bridge can call
+// the service back.
+func WithCallbackService(ctx sdk.Context, service string) sdk.Context {
+	if service == "" {
+		return ctx
+	}
+	return ctx.WithValue(callbackServiceKey{}, service)
+}
+
+// callbackOrigin returns the service recorded by WithCallbackService and the
+// hash of the transaction being delivered, as CometBFT reports it
+func callbackOrigin(ctx sdk.Context) (service, txHash string) {
+	service, _ = ctx.Value(callbackServiceKey{}).(string)
+	if bz := ctx.TxBytes(); len(bz) > 0 {
+		txHash = fmt.Sprintf("%X", sha256.Sum256(bz))
+	}
+	return service, txHash
+}
+
+// callbackAttributes returns the event attributes naming the service to call
+// back and the transaction that submitted the swap or order
+func callbackAttributes(service, txHash string) []sdk.Attribute {
+	var attrs []sdk.Attribute
+	if service != "" {
+		attrs = append(attrs, sdk.NewAttribute("service", service))
+	}
+	if txHash != "" {
+		attrs = append(attrs, sdk.NewAttribute("request_tx_hash", txHash))
+	}
+	return attrs
+}
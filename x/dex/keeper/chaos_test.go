@@ -0,0 +1,160 @@
+//go:build chaos
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sonr-io/sonr/app/chaos"
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// ChaosTestSuite checks that ICA operations survive dropped packets and late
+// acknowledgements
+type ChaosTestSuite struct {
+	suite.Suite
+	f       *testFixture
+	account types.InterchainDEXAccount
+}
+
+func TestChaosSuite(t *testing.T) {
+	suite.Run(t, new(ChaosTestSuite))
+}
+
+func (suite *ChaosTestSuite) SetupTest() {
+	chaos.Reset()
+	suite.f = SetupTest(suite.T())
+
+	account, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, "did:sonr:alice", testConnectionID, []string{"swap"})
+	suite.Require().NoError(err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	suite.Require().NoError(
+		suite.f.k.Accounts.Set(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID), *account),
+	)
+	suite.account = *account
+
+	_, err = suite.f.k.ScopedKeeper.NewCapability(
+		suite.f.ctx,
+		host.ChannelCapabilityPath(account.PortId, "channel-0"),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *ChaosTestSuite) TearDownTest() {
+	chaos.Reset()
+}
+
+func (suite *ChaosTestSuite) swap() {
+	_, err := suite.f.k.ExecuteSwap(
+		suite.f.ctx,
+		"did:sonr:alice",
+		testConnectionID,
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *ChaosTestSuite) queued() bool {
+	has, err := suite.f.k.PendingBatches.Has(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID))
+	suite.Require().NoError(err)
+	return has
+}
+
+func (suite *ChaosTestSuite) swapStatus(id uint64) types.SwapStatus {
+	swap, err := suite.f.k.GetSwap(suite.f.ctx, id)
+	suite.Require().NoError(err)
+	return swap.Status
+}
+
+func (suite *ChaosTestSuite) TestDroppedPacketKeepsBatchQueued() {
+	suite.swap()
+
+	// Every flush fails while packets are dropped, leaving the batch as it was
+	chaos.Inject(chaos.PacketDrop, 2)
+	for range 2 {
+		suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+		suite.Require().True(suite.queued())
+		suite.Require().Equal(types.SwapStatusPending, suite.swapStatus(0))
+	}
+	suite.Require().Equal(2, chaos.Hits(chaos.PacketDrop))
+
+	// The next flush goes through and links the swap to its packet
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+	suite.Require().False(suite.queued())
+	swap, err := suite.f.k.GetSwap(suite.f.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), swap.Sequence)
+}
+
+func (suite *ChaosTestSuite) TestDroppedPacketFailsUnbatchedSwap() {
+	params := types.DefaultBatchParams()
+	params.Enabled = false
+	suite.Require().NoError(suite.f.k.SetBatchParams(suite.f.ctx, params))
+
+	chaos.Inject(chaos.PacketDrop, 1)
+	_, err := suite.f.k.ExecuteSwap(
+		suite.f.ctx,
+		"did:sonr:alice",
+		testConnectionID,
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	suite.Require().ErrorIs(err, chaos.ErrInjected)
+}
+
+func (suite *ChaosTestSuite) TestDelayedAckSettlesOnRedelivery() {
+	suite.swap()
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{swapResponse("950")}})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+	bz, err := proto.Marshal(&ack)
+	suite.Require().NoError(err)
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: suite.account.PortId, SourceChannel: "channel-0"}
+
+	// A failed delivery leaves the swap pending for the relayer to retry
+	chaos.Inject(chaos.DelayedAck, 1)
+	suite.Require().ErrorIs(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, packet, bz, nil), chaos.ErrInjected)
+	suite.Require().Equal(types.SwapStatusPending, suite.swapStatus(0))
+
+	suite.Require().NoError(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, packet, bz, nil))
+	suite.Require().Equal(types.SwapStatusComplete, suite.swapStatus(0))
+}
+
+func (suite *ChaosTestSuite) TestAckAfterTimeoutIsIgnored() {
+	suite.swap()
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: suite.account.PortId, SourceChannel: "channel-0"}
+
+	// An ack delayed past the timeout finds the swap already failed
+	suite.Require().NoError(suite.f.k.OnTimeoutPacket(suite.f.ctx, packet, nil))
+	suite.Require().Equal(types.SwapStatusFailed, suite.swapStatus(0))
+
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{swapResponse("950")}})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+	bz, err := proto.Marshal(&ack)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, packet, bz, nil))
+	suite.Require().Equal(types.SwapStatusFailed, suite.swapStatus(0))
+}
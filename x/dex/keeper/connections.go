@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// UpdateParams replaces the module parameters. Only the module authority
+// (governance) may update them.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Params) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to store params: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamsUpdated,
+			sdk.NewAttribute("allowed_connections", fmt.Sprintf("%d", len(params.AllowedConnections))),
+		),
+	)
+	return nil
+}
+
+// AddAllowedConnection adds an open IBC connection to the connection allow
+// list. Only the module authority (governance) may update the list.
+func (k Keeper) AddAllowedConnection(ctx sdk.Context, authority, connectionID string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if err := k.ValidateConnection(ctx, connectionID); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidConnectionID, err.Error())
+	}
+
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(params.AllowedConnections, connectionID) {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionID, "%s is already allowed", connectionID)
+	}
+	params.AllowedConnections = append(params.AllowedConnections, connectionID)
+	if err := k.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to store params: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConnectionAllowed,
+			sdk.NewAttribute("connection", connectionID),
+		),
+	)
+	return nil
+}
+
+// RemoveAllowedConnection removes a connection from the allow list. The last
+// entry cannot be removed, as an empty list allows every connection; clear
+// the list with UpdateParams instead.
+func (k Keeper) RemoveAllowedConnection(ctx sdk.Context, authority, connectionID string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+	i := slices.Index(params.AllowedConnections, connectionID)
+	if i < 0 {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionID, "%s is not allowed", connectionID)
+	}
+	if len(params.AllowedConnections) == 1 {
+		return errorsmod.Wrapf(
+			types.ErrInvalidConnectionID,
+			"cannot remove %s, the last allowed connection; an empty list allows every connection", connectionID,
+		)
+	}
+	params.AllowedConnections = slices.Delete(params.AllowedConnections, i, i+1)
+	if err := k.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to store params: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConnectionDisallowed,
+			sdk.NewAttribute("connection", connectionID),
+		),
+	)
+	return nil
+}
+
+// IsConnectionAllowed reports whether new DEX operations may use a
+// connection. Every connection is allowed while the allow list is empty.
+func (k Keeper) IsConnectionAllowed(ctx sdk.Context, connectionID string) (bool, error) {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.IsConnectionAllowed(connectionID), nil
+}
+
+// checkConnectionAllowed returns ErrConnectionNotAllowed when the allow list
+// excludes the connection
+func (k Keeper) checkConnectionAllowed(ctx sdk.Context, connectionID string) error {
+	allowed, err := k.IsConnectionAllowed(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errorsmod.Wrap(types.ErrConnectionNotAllowed, connectionID)
+	}
+	return nil
+}
+
+// getParams returns the module parameters, or zero params when none are set
+func (k Keeper) getParams(ctx sdk.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.Params{}, err
+	}
+	return params, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// AllowedConnectionsTestSuite tests the governance-managed connection allow list
+type AllowedConnectionsTestSuite struct {
+	suite.Suite
+	f *testFixture
+}
+
+func TestAllowedConnectionsSuite(t *testing.T) {
+	suite.Run(t, new(AllowedConnectionsTestSuite))
+}
+
+func (suite *AllowedConnectionsTestSuite) SetupTest() {
+	suite.f = SetupTest(suite.T())
+}
+
+func (suite *AllowedConnectionsTestSuite) allowed() []string {
+	params, err := suite.f.k.Params.Get(suite.f.ctx)
+	suite.Require().NoError(err)
+	return params.AllowedConnections
+}
+
+func (suite *AllowedConnectionsTestSuite) TestOnlyAuthorityManagesConnections() {
+	_, err := suite.f.msgServer.UpdateParams(suite.f.ctx, &types.MsgUpdateParams{
+		Authority: suite.f.addrs[0].String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.f.msgServer.AddAllowedConnection(suite.f.ctx, &types.MsgAddAllowedConnection{
+		Authority:    suite.f.addrs[0].String(),
+		ConnectionId: testConnectionID,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.f.msgServer.RemoveAllowedConnection(suite.f.ctx, &types.MsgRemoveAllowedConnection{
+		Authority:    suite.f.addrs[0].String(),
+		ConnectionId: testConnectionID,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *AllowedConnectionsTestSuite) TestUpdateParams() {
+	params := types.Params{
+		Enabled:            true,
+		AllowedConnections: []string{"connection-0", "connection-1"},
+	}
+	_, err := suite.f.msgServer.UpdateParams(suite.f.ctx, &types.MsgUpdateParams{
+		Authority: suite.f.govModAddr,
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.AllowedConnections, suite.allowed())
+
+	params.AllowedConnections = []string{"connection-0", "connection-0"}
+	_, err = suite.f.msgServer.UpdateParams(suite.f.ctx, &types.MsgUpdateParams{
+		Authority: suite.f.govModAddr,
+		Params:    params,
+	})
+	suite.Require().Error(err)
+
+	params.AllowedConnections = []string{"channel-0"}
+	_, err = suite.f.msgServer.UpdateParams(suite.f.ctx, &types.MsgUpdateParams{
+		Authority: suite.f.govModAddr,
+		Params:    params,
+	})
+	suite.Require().Error(err)
+	suite.Require().Equal([]string{"connection-0", "connection-1"}, suite.allowed())
+}
+
+func (suite *AllowedConnectionsTestSuite) TestAddAndRemoveConnection() {
+	add := func(connectionID string) error {
+		_, err := suite.f.msgServer.AddAllowedConnection(suite.f.ctx, &types.MsgAddAllowedConnection{
+			Authority:    suite.f.govModAddr,
+			ConnectionId: connectionID,
+		})
+		return err
+	}
+	remove := func(connectionID string) error {
+		_, err := suite.f.msgServer.RemoveAllowedConnection(suite.f.ctx, &types.MsgRemoveAllowedConnection{
+			Authority:    suite.f.govModAddr,
+			ConnectionId: connectionID,
+		})
+		return err
+	}
+
+	suite.Require().NoError(add("connection-0"))
+	suite.Require().NoError(add("connection-1"))
+	suite.Require().Equal([]string{"connection-0", "connection-1"}, suite.allowed())
+	suite.Require().ErrorIs(add("connection-1"), types.ErrInvalidConnectionID)
+
+	suite.Require().ErrorIs(remove("connection-7"), types.ErrInvalidConnectionID)
+	suite.Require().NoError(remove("connection-0"))
+	suite.Require().Equal([]string{"connection-1"}, suite.allowed())
+
+	// Removing the last entry would allow every connection again
+	suite.Require().ErrorIs(remove("connection-1"), types.ErrInvalidConnectionID)
+	suite.Require().Equal([]string{"connection-1"}, suite.allowed())
+}
+
+func (suite *AllowedConnectionsTestSuite) TestOperationsRespectAllowList() {
+	did := "did:sonr:connections"
+
+	// An empty list allows every connection
+	allowed, err := suite.f.k.IsConnectionAllowed(suite.f.ctx, testConnectionID)
+	suite.Require().NoError(err)
+	suite.Require().True(allowed)
+
+	_, err = suite.f.msgServer.AddAllowedConnection(suite.f.ctx, &types.MsgAddAllowedConnection{
+		Authority:    suite.f.govModAddr,
+		ConnectionId: "connection-1",
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.f.k.RegisterDEXAccount(suite.f.ctx, did, testConnectionID, nil)
+	suite.Require().ErrorIs(err, types.ErrConnectionNotAllowed)
+
+	_, err = suite.f.k.ExecuteSwap(
+		suite.f.ctx,
+		did,
+		testConnectionID,
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	suite.Require().ErrorIs(err, types.ErrConnectionNotAllowed)
+
+	_, err = suite.f.k.CreateLimitOrder(
+		suite.f.ctx,
+		did,
+		testConnectionID,
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.LegacyNewDec(2),
+		time.Time{},
+		time.Minute,
+	)
+	suite.Require().ErrorIs(err, types.ErrConnectionNotAllowed)
+
+	// Allowed connections get past the check and fail on the missing account
+	_, err = suite.f.k.ExecuteSwap(
+		suite.f.ctx,
+		did,
+		"connection-1",
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	suite.Require().Error(err)
+	suite.Require().NotErrorIs(err, types.ErrConnectionNotAllowed)
+}
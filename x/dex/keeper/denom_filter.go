@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// SetDenomFilter stores the denom allow/deny list for a connection. Only the
+// module authority (governance) may update filters.
+func (k Keeper) SetDenomFilter(ctx sdk.Context, authority string, filter types.DenomFilter) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidConnectionID, err.Error())
+	}
+
+	if err := k.DenomFilters.Set(ctx, filter.ConnectionId, filter); err != nil {
+		return fmt.Errorf("failed to store denom filter: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomFilterUpdated,
+			sdk.NewAttribute("connection", filter.ConnectionId),
+			sdk.NewAttribute("allowed", fmt.Sprintf("%d", len(filter.AllowedDenoms))),
+			sdk.NewAttribute("denied", fmt.Sprintf("%d", len(filter.DeniedDenoms))),
+		),
+	)
+
+	return nil
+}
+
+// RemoveDenomFilter clears the denom filter for a connection
+func (k Keeper) RemoveDenomFilter(ctx sdk.Context, authority, connectionID string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	return k.DenomFilters.Remove(ctx, connectionID)
+}
+
+// GetDenomFilter returns the denom filter for a connection, if any
+func (k Keeper) GetDenomFilter(ctx sdk.Context, connectionID string) (types.DenomFilter, bool, error) {
+	filter, err := k.DenomFilters.Get(ctx, connectionID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomFilter{}, false, nil
+	}
+	if err != nil {
+		return types.DenomFilter{}, false, err
+	}
+	return filter, true, nil
+}
+
+// IsDenomAllowed reports whether a denom may be traded on a connection.
+// Connections without a filter allow every denom.
+func (k Keeper) IsDenomAllowed(ctx sdk.Context, connectionID, denom string) (bool, error) {
+	filter, found, err := k.GetDenomFilter(ctx, connectionID)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return true, nil
+	}
+	return filter.IsAllowed(denom), nil
+}
+
+// checkDenomsAllowed returns ErrDenomNotAllowed for the first denom the
+// connection's filter rejects
+func (k Keeper) checkDenomsAllowed(ctx sdk.Context, connectionID string, denoms ...string) error {
+	for _, denom := range denoms {
+		allowed, err := k.IsDenomAllowed(ctx, connectionID, denom)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s on %s", denom, connectionID)
+		}
+	}
+	return nil
+}
+
+// GetAllDenomFilters returns every configured denom filter
+func (k Keeper) GetAllDenomFilters(ctx sdk.Context) ([]types.DenomFilter, error) {
+	var filters []types.DenomFilter
+	err := k.DenomFilters.Walk(ctx, nil, func(_ string, filter types.DenomFilter) (bool, error) {
+		filters = append(filters, filter)
+		return false, nil
+	})
+	return filters, err
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// didSummaryValue encodes a DID summary as its (controller, deactivated) pair
+var didSummaryValue = collcodec.KeyToValueCodec(
+	collections.PairKeyCodec(collections.StringKey, collections.BoolKey),
+)
+
+// getDIDSummary returns the controller and status of a DID. While a block is
+// being finalized the result is cached in the transient store, so a DID
+// acting in several messages is read from the DID module once per block. The
+// cache is cleared at the end of the block, rolled back with a failed
+// transaction and dropped for a DID whose document changes. Checking and
+// simulating transactions always read the DID module, keeping their results
+// and gas out of the cache.
+func (k Keeper) getDIDSummary(ctx context.Context, did string) (didtypes.DIDSummary, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.ExecMode() != sdk.ExecModeFinalize {
+		return k.didKeeper.GetDIDSummary(ctx, did)
+	}
+
+	cached, err := k.didSummaries.Get(ctx, did)
+	if err == nil {
+		return didtypes.DIDSummary{Controller: cached.K1(), Deactivated: cached.K2()}, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return didtypes.DIDSummary{}, err
+	}
+
+	summary, err := k.didKeeper.GetDIDSummary(ctx, did)
+	if err != nil {
+		return didtypes.DIDSummary{}, err
+	}
+	if err := k.didSummaries.Set(ctx, did, collections.Join(summary.Controller, summary.Deactivated)); err != nil {
+		return didtypes.DIDSummary{}, err
+	}
+	return summary, nil
+}
+
+// DIDHooks drops cached DID summaries when the did module writes a document
+type DIDHooks struct {
+	k Keeper
+}
+
+var _ didtypes.DIDHooks = DIDHooks{}
+
+// DIDHooks returns the DID hooks of the dex module
+func (k Keeper) DIDHooks() DIDHooks {
+	return DIDHooks{k: k}
+}
+
+// AfterDIDRegistered implements didtypes.DIDHooks
+func (h DIDHooks) AfterDIDRegistered(context.Context, string, string, string) error {
+	return nil
+}
+
+// AfterDIDDocumentChanged implements didtypes.DIDHooks. The next read of the
+// DID goes to the DID module.
+func (h DIDHooks) AfterDIDDocumentChanged(ctx context.Context, did string) error {
+	return h.k.didSummaries.Remove(ctx, did)
+}
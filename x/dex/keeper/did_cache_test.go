@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestDIDSummaryCachedPerBlock(t *testing.T) {
+	f := SetupTest(t)
+	ctx := f.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	for range 3 {
+		require.NoError(t, f.k.AuthenticateDIDOperation(ctx, "did:sonr:alice", "swap", nil))
+	}
+	require.Equal(t, 1, f.didKeeper.reads, "a DID is read once per block")
+
+	// The did module drops the cached summary when it writes the document
+	f.didKeeper.deactivated["did:sonr:alice"] = true
+	require.NoError(t, f.k.DIDHooks().AfterDIDDocumentChanged(ctx, "did:sonr:alice"))
+	err := f.k.AuthenticateDIDOperation(ctx, "did:sonr:alice", "swap", nil)
+	require.ErrorIs(t, err, types.ErrInvalidDID)
+	require.Equal(t, 2, f.didKeeper.reads)
+}
+
+func TestDIDSummaryCacheRevertedWithTx(t *testing.T) {
+	f := SetupTest(t)
+	ctx := f.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	// A summary cached by a failed transaction is discarded with its writes
+	txCtx, _ := ctx.CacheContext()
+	require.NoError(t, f.k.AuthenticateDIDOperation(txCtx, "did:sonr:alice", "swap", nil))
+
+	f.didKeeper.deactivated["did:sonr:alice"] = true
+	err := f.k.AuthenticateDIDOperation(ctx, "did:sonr:alice", "swap", nil)
+	require.ErrorIs(t, err, types.ErrInvalidDID)
+	require.Equal(t, 2, f.didKeeper.reads)
+}
+
+func TestDIDSummaryNotCachedInCheckTx(t *testing.T) {
+	f := SetupTest(t)
+	ctx := f.ctx.WithExecMode(sdk.ExecModeCheck)
+
+	require.NoError(t, f.k.AuthenticateDIDOperation(ctx, "did:sonr:alice", "swap", nil))
+	f.didKeeper.deactivated["did:sonr:alice"] = true
+	_, err := f.k.RegisterDEXAccount(ctx, "did:sonr:alice", testConnectionID, []string{"swap"})
+	require.ErrorIs(t, err, types.ErrInvalidDID)
+	require.Equal(t, 2, f.didKeeper.reads)
+}
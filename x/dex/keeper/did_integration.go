@@ -4,58 +4,38 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sonr-io/sonr/x/dex/types"
 )
 
-// ValidateDIDOwnership verifies that the transaction sender owns the specified DID
+// ValidateDIDOwnership verifies that the transaction sender controls the
+// specified DID and that the DID is not deactivated
 func (k Keeper) ValidateDIDOwnership(ctx sdk.Context, did string, sender sdk.AccAddress) error {
-	// Get DID document from DID keeper
-	didDoc, err := k.didKeeper.GetDIDDocument(ctx, did)
+	summary, err := k.getDIDSummary(ctx, did)
 	if err != nil {
 		return fmt.Errorf("failed to get DID document: %w", err)
 	}
-
-	if didDoc == nil {
-		return fmt.Errorf("DID document not found for %s", did)
+	if summary.Deactivated {
+		return errorsmod.Wrapf(types.ErrInvalidDID, "DID %s is deactivated", did)
 	}
 
 	// Verify sender is the controller of the DID
-	if !k.isDIDController(didDoc, sender.String()) {
+	if summary.Controller != sender.String() {
 		return fmt.Errorf("sender %s is not the controller of DID %s", sender, did)
 	}
 
 	return nil
 }
 
-// isDIDController checks if an address is a controller of the DID
-func (k Keeper) isDIDController(didDoc any, address string) bool {
-	// This is a simplified check - actual implementation would depend on DID document structure
-	// For now, we'll assume the DID document has a Controller field or similar
-	// The actual implementation should match the x/did module's structure
-
-	// TODO: Implement proper controller verification based on actual DID document structure
-	// This might involve checking:
-	// - didDoc.Controller field
-	// - didDoc.Authentication keys
-	// - didDoc.AssertionMethod keys
-
-	return true // Placeholder - always return true for now
-}
-
 // GetDIDCapabilities retrieves the DEX-related capabilities for a DID
 func (k Keeper) GetDIDCapabilities(ctx sdk.Context, did string) ([]string, error) {
-	// Get DID document
-	didDoc, err := k.didKeeper.GetDIDDocument(ctx, did)
-	if err != nil {
+	// Verify the DID exists
+	if _, err := k.getDIDSummary(ctx, did); err != nil {
 		return nil, fmt.Errorf("failed to get DID document: %w", err)
 	}
 
-	if didDoc == nil {
-		return nil, fmt.Errorf("DID document not found for %s", did)
-	}
-
 	// Extract DEX-related capabilities from the DID document
 	// This would typically be stored in service endpoints or custom fields
 	capabilities := []string{
@@ -74,14 +54,13 @@ func (k Keeper) AuthenticateDIDOperation(
 	operation string,
 	params map[string]any,
 ) error {
-	// Get DID document to verify it exists and is active
-	didDoc, err := k.didKeeper.GetDIDDocument(ctx, did)
+	// Verify the DID exists and is active
+	summary, err := k.getDIDSummary(ctx, did)
 	if err != nil {
 		return fmt.Errorf("failed to authenticate DID: %w", err)
 	}
-
-	if didDoc == nil {
-		return fmt.Errorf("DID %s not found", did)
+	if summary.Deactivated {
+		return errorsmod.Wrapf(types.ErrInvalidDID, "DID %s is deactivated", did)
 	}
 
 	// Check if DID has the required capability for this operation
@@ -141,6 +120,7 @@ func (k Keeper) RecordDIDActivity(
 	if err := k.DIDActivities.Set(ctx, activityKey, activity); err != nil {
 		return fmt.Errorf("failed to record DID activity: %w", err)
 	}
+	k.storeActivityInDWN(ctx, did, activityKey, activity)
 
 	// Emit event for activity tracking
 	ctx.EventManager().EmitEvent(
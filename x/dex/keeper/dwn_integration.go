@@ -3,14 +3,114 @@ package keeper
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/sonr-io/sonr/x/dex/types"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
 )
 
+// storeActivityInDWN writes an activity to the DWN vault of its DID,
+// replacing the record written earlier for the same activity key. The vault
+// holds the user's copy of the activity, so failures are logged and never
+// fail the DEX operation.
+func (k Keeper) storeActivityInDWN(ctx sdk.Context, did, activityKey string, activity types.DEXActivity) {
+	if k.dwnKeeper == nil || did == "" {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.writeActivityRecord(cacheCtx, did, activityKey, activity); err != nil {
+		k.Logger(ctx).Error("failed to store DEX activity in DWN",
+			"did", did,
+			"activity_key", activityKey,
+			"error", err,
+		)
+		return
+	}
+	write()
+}
+
+func (k Keeper) writeActivityRecord(ctx sdk.Context, did, activityKey string, activity types.DEXActivity) error {
+	data, err := types.EncodeDWNActivity(k.cdc, activityKey, activity)
+	if err != nil {
+		return err
+	}
+	author := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	// Record IDs hash the data, so an updated activity is a new record
+	key := collections.Join(did, activityKey)
+	previous, err := k.DWNActivityRecords.Get(ctx, key)
+	switch {
+	case err == nil:
+		_, err = k.dwnKeeper.RecordsDelete(ctx, &dwntypes.MsgRecordsDelete{
+			Author:   author,
+			Target:   did,
+			RecordId: previous,
+		})
+		if err != nil && !errors.Is(err, dwntypes.ErrRecordNotFound) {
+			return fmt.Errorf("failed to replace record %s: %w", previous, err)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	res, err := k.dwnKeeper.RecordsWrite(ctx, &dwntypes.MsgRecordsWrite{
+		Author: author,
+		Target: did,
+		Descriptor_: &dwntypes.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Write",
+			MessageTimestamp: ctx.BlockTime().UTC().Format(time.RFC3339Nano),
+			DataFormat:       types.DWNActivityDataFormat,
+		},
+		Data:     data,
+		Protocol: types.DWNActivityProtocol,
+		Schema:   types.DWNActivitySchema,
+	})
+	if err != nil {
+		return err
+	}
+	return k.DWNActivityRecords.Set(ctx, key, res.RecordId)
+}
+
+// ReadDWNActivity reads the record of an activity back from the DWN vault of
+// a DID. Encrypted records are returned without their activity.
+func (k Keeper) ReadDWNActivity(ctx sdk.Context, did, activityKey, recordID string) (types.DWNActivityEntry, error) {
+	if k.dwnKeeper == nil {
+		return types.DWNActivityEntry{}, fmt.Errorf("DWN keeper not set")
+	}
+
+	res, err := k.dwnKeeper.Record(ctx, &dwntypes.QueryRecordRequest{Target: did, RecordId: recordID})
+	if err != nil {
+		return types.DWNActivityEntry{}, err
+	}
+	entry := types.DWNActivityEntry{RecordId: recordID, ActivityKey: activityKey}
+	if res.Record == nil {
+		return entry, fmt.Errorf("%w: %s", dwntypes.ErrRecordNotFound, recordID)
+	}
+	if res.Record.IsEncrypted {
+		entry.Encrypted = true
+		return entry, nil
+	}
+
+	key, version, activity, err := types.DecodeDWNActivity(k.cdc, res.Record.Data)
+	if err != nil {
+		return entry, err
+	}
+	if key != activityKey {
+		return entry, fmt.Errorf("record %s holds activity %s, not %s", recordID, key, activityKey)
+	}
+	entry.SchemaVersion = version
+	entry.Activity = &activity
+	return entry, nil
+}
+
 // StoreDEXAccountInDWN stores DEX account information in DWN
 func (k Keeper) StoreDEXAccountInDWN(
 	ctx sdk.Context,
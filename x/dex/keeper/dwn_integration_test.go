@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func testActivity(did string, at time.Time, status string) types.DEXActivity {
+	return types.DEXActivity{
+		Type:         "swap",
+		Did:          did,
+		ConnectionId: testConnectionID,
+		BlockHeight:  1,
+		Timestamp:    at.UTC(),
+		Status:       status,
+		Amount:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)),
+	}
+}
+
+func TestActivityStoredInDWN(t *testing.T) {
+	f := SetupTest(t)
+	did := "did:sonr:alice"
+	at := time.Unix(1_700_000_000, 0)
+	ctx := f.ctx.WithBlockTime(at)
+
+	activity := testActivity(did, at, "pending")
+	require.NoError(t, f.k.RecordDIDActivity(ctx, did, activity))
+
+	require.Len(t, f.dwn.records, 1)
+	for _, record := range f.dwn.records {
+		require.Equal(t, did, record.Target)
+		require.Equal(t, types.DWNActivityProtocol, record.Protocol)
+		require.Equal(t, types.DWNActivitySchema, record.Schema)
+		require.Equal(t, types.DWNActivityDataFormat, record.Descriptor_.DataFormat)
+	}
+
+	res, err := f.queryServer.DWNActivity(ctx, &types.QueryDWNActivityRequest{Did: did})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	entry := res.Entries[0]
+	require.Equal(t, keeper.GetDIDActivityKey(did, at.Unix()), entry.ActivityKey)
+	require.Equal(t, types.DWNActivitySchemaVersion, entry.SchemaVersion)
+	require.False(t, entry.Encrypted)
+	require.Equal(t, activity, *entry.Activity)
+}
+
+func TestActivityUpdateReplacesDWNRecord(t *testing.T) {
+	f := SetupTest(t)
+	did := "did:sonr:alice"
+	at := time.Unix(1_700_000_000, 0)
+	ctx := f.ctx.WithBlockTime(at)
+
+	require.NoError(t, f.k.RecordDIDActivity(ctx, did, testActivity(did, at, "pending")))
+	require.NoError(t, f.k.RecordDIDActivity(ctx, did, testActivity(did, at, "completed")))
+
+	require.Len(t, f.dwn.records, 1, "the previous record is deleted")
+	res, err := f.queryServer.DWNActivity(ctx, &types.QueryDWNActivityRequest{Did: did})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, "completed", res.Entries[0].Activity.Status)
+}
+
+func TestDWNActivityPagination(t *testing.T) {
+	f := SetupTest(t)
+	did := "did:sonr:alice"
+	start := time.Unix(1_700_000_000, 0)
+	for i := 0; i < 3; i++ {
+		at := start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, f.k.RecordDIDActivity(f.ctx.WithBlockTime(at), did, testActivity(did, at, "completed")))
+	}
+	require.NoError(t, f.k.RecordDIDActivity(f.ctx.WithBlockTime(start), "did:sonr:bob", testActivity("did:sonr:bob", start, "completed")))
+
+	res, err := f.queryServer.DWNActivity(f.ctx, &types.QueryDWNActivityRequest{
+		Did:        did,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.EqualValues(t, 3, res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+	require.Equal(t, start.Unix(), res.Entries[0].Activity.Timestamp.Unix())
+
+	res, err = f.queryServer.DWNActivity(f.ctx, &types.QueryDWNActivityRequest{
+		Did:        did,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Empty(t, res.Pagination.NextKey)
+	require.Equal(t, start.Add(2*time.Minute).Unix(), res.Entries[0].Activity.Timestamp.Unix())
+}
+
+func TestDWNActivityEncryptedAndRemovedRecords(t *testing.T) {
+	f := SetupTest(t)
+	did := "did:sonr:alice"
+	at := time.Unix(1_700_000_000, 0)
+	f.dwn.encrypt = true
+
+	require.NoError(t, f.k.RecordDIDActivity(f.ctx.WithBlockTime(at), did, testActivity(did, at, "completed")))
+	later := at.Add(time.Minute)
+	require.NoError(t, f.k.RecordDIDActivity(f.ctx.WithBlockTime(later), did, testActivity(did, later, "completed")))
+
+	// The user removes the second record from their vault
+	recordID, err := f.k.DWNActivityRecords.Get(f.ctx, collections.Join(did, keeper.GetDIDActivityKey(did, later.Unix())))
+	require.NoError(t, err)
+	delete(f.dwn.records, recordID)
+
+	res, err := f.queryServer.DWNActivity(f.ctx, &types.QueryDWNActivityRequest{Did: did})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.True(t, res.Entries[0].Encrypted)
+	require.Nil(t, res.Entries[0].Activity)
+	require.Equal(t, keeper.GetDIDActivityKey(did, at.Unix()), res.Entries[0].ActivityKey)
+}
+
+func TestDWNWriteFailureKeepsActivity(t *testing.T) {
+	f := SetupTest(t)
+	did := "did:sonr:alice"
+	at := time.Unix(1_700_000_000, 0)
+	f.dwn.writeErr = errors.New("vault unavailable")
+
+	require.NoError(t, f.k.RecordDIDActivity(f.ctx.WithBlockTime(at), did, testActivity(did, at, "completed")))
+
+	_, err := f.k.DIDActivities.Get(f.ctx, keeper.GetDIDActivityKey(did, at.Unix()))
+	require.NoError(t, err)
+	has, err := f.k.DWNActivityRecords.Has(f.ctx, collections.Join(did, keeper.GetDIDActivityKey(did, at.Unix())))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestLiquidityActivityStoredInDWN(t *testing.T) {
+	f, account := setupReserves(t)
+	did := account.Did
+	tokenA, tokenB := sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uosmo", 2_000)
+
+	provided, err := f.k.ProvideLiquidity(f.ctx, did, testConnectionID, 1, tokenA, tokenB, math.NewInt(100), time.Minute)
+	require.NoError(t, err)
+	_, err = f.k.RemoveLiquidity(f.ctx, did, testConnectionID, 1, math.NewInt(50), math.ZeroInt(), math.ZeroInt(), time.Minute)
+	require.NoError(t, err)
+
+	require.Len(t, f.dwn.records, 2)
+	res, err := f.queryServer.DWNActivity(f.ctx, &types.QueryDWNActivityRequest{Did: did})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+
+	byType := make(map[string]*types.DEXActivity)
+	for _, entry := range res.Entries {
+		byType[entry.Activity.Type] = entry.Activity
+	}
+	require.Contains(t, byType, "provide_liquidity")
+	require.Contains(t, byType, "remove_liquidity")
+	require.Equal(t, sdk.NewCoins(tokenA, tokenB), byType["provide_liquidity"].Amount)
+	require.Equal(t, "pending", byType["provide_liquidity"].Status)
+	require.Contains(t, byType["provide_liquidity"].Details, fmt.Sprintf(`"sequence":%d`, provided))
+	require.Contains(t, byType["remove_liquidity"].Details, `"shares":"50"`)
+}
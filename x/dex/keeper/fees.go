@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// DeductFee splits coin into the platform fee an operation on a connection
+// pays and the amount left for the operation itself
+func (k Keeper) DeductFee(
+	ctx sdk.Context,
+	operation string,
+	connectionID string,
+	coin sdk.Coin,
+) (fee, net sdk.Coin, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	fee = params.Fees.Fee(operation, connectionID, coin)
+	return fee, coin.Sub(fee), nil
+}
+
+// CollectFee sends the fees of an operation from the DID's ICA account to the
+// connection's fee collector on the host chain. The transfer is queued after
+// the operation, so with batching enabled both are sent in the same packet.
+// The fees count towards the totals of the connection once the packet is
+// acknowledged.
+func (k Keeper) CollectFee(
+	ctx sdk.Context,
+	did string,
+	connectionID string,
+	operation string,
+	fees sdk.Coins,
+	timeout time.Duration,
+) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	collector := params.Fees.Collector(connectionID)
+	if collector == "" {
+		return fmt.Errorf("no fee collector for %s", connectionID)
+	}
+	account, err := k.GetDEXAccount(ctx, did, connectionID)
+	if err != nil {
+		return fmt.Errorf("DEX account not found: %w", err)
+	}
+
+	queued, sequence, err := k.QueueDEXTransaction(
+		ctx,
+		did,
+		connectionID,
+		[]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: account.AccountAddress,
+			ToAddress:   collector,
+			Amount:      fees,
+		}},
+		fmt.Sprintf("%s_fee", operation),
+		timeout,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send fee transaction: %w", err)
+	}
+	if err := k.trackFees(ctx, account, queued, sequence, fees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeCollected,
+			sdk.NewAttribute("did", did),
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("operation", operation),
+			sdk.NewAttribute("fee", fees.String()),
+			sdk.NewAttribute("fee_collector", collector),
+		),
+	)
+	return nil
+}
+
+// trackFees holds fees sent from an account until their packet is
+// acknowledged. Fees queued in a batch wait for the batch to be sent; a
+// sequence returned with queued messages belongs to an earlier batch.
+func (k Keeper) trackFees(
+	ctx sdk.Context,
+	account *types.InterchainDEXAccount,
+	queued bool,
+	sequence uint64,
+	fees sdk.Coins,
+) error {
+	accountKey := GetAccountKey(account.Did, account.ConnectionId)
+	for _, fee := range fees {
+		var err error
+		if queued {
+			err = addAmount(ctx, k.UnsentFees, collections.Join(accountKey, fee.Denom), fee.Amount)
+		} else {
+			err = addAmount(ctx, k.PendingFees, collections.Join3(account.PortId, sequence, fee.Denom), fee.Amount)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to track fee: %w", err)
+		}
+	}
+	return nil
+}
+
+// linkBatchedFees moves the fees that were waiting in an account's batch to
+// the packet the batch was sent in
+func (k Keeper) linkBatchedFees(ctx sdk.Context, accountKey, portID string, sequence uint64) error {
+	iter, err := k.UnsentFees.Iterate(ctx, collections.NewPrefixedPairRange[string, string](accountKey))
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := addAmount(ctx, k.PendingFees, collections.Join3(portID, sequence, kv.Key.K2()), kv.Value); err != nil {
+			return err
+		}
+		if err := k.UnsentFees.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnFeeResult settles the fees sent in an ICA packet. Fees are added to the
+// connection's totals only when the host chain executed the packet; a failed
+// or timed out packet moved no funds.
+func (k Keeper) OnFeeResult(ctx sdk.Context, portID string, sequence uint64, success bool) error {
+	iter, err := k.PendingFees.Iterate(
+		ctx,
+		collections.NewSuperPrefixedTripleRange[string, uint64, string](portID, sequence),
+	)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	var connectionID string
+	if success {
+		account, err := k.getAccountByPort(ctx, portID)
+		if err != nil {
+			return err
+		}
+		connectionID = account.ConnectionId
+	}
+
+	for _, kv := range kvs {
+		if success {
+			key := collections.Join(connectionID, kv.Key.K3())
+			if err := addAmount(ctx, k.CollectedFees, key, kv.Value); err != nil {
+				return err
+			}
+		}
+		if err := k.PendingFees.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAmount adds amount to the total stored under key
+func addAmount[K any](ctx sdk.Context, m collections.Map[K, math.Int], key K, amount math.Int) error {
+	total, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	return m.Set(ctx, key, total.Add(amount))
+}
+
+// GetCollectedFees returns the platform fees collected on a connection
+func (k Keeper) GetCollectedFees(ctx sdk.Context, connectionID string) (sdk.Coins, error) {
+	iter, err := k.CollectedFees.Iterate(ctx, collections.NewPrefixedPairRange[string, string](connectionID))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var fees sdk.Coins
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		fees = fees.Add(sdk.NewCoin(kv.Key.K2(), kv.Value))
+	}
+	return fees, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestSwapFeeCollected(t *testing.T) {
+	f := SetupTest(t)
+	did := "did:sonr:fees"
+	account, err := f.k.RegisterDEXAccount(f.ctx, did, testConnectionID, []string{"swap"})
+	require.NoError(t, err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	require.NoError(t, f.k.Accounts.Set(f.ctx, keeper.GetAccountKey(did, testConnectionID), *account))
+
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{
+		Enabled: true,
+		Fees:    types.FeeParams{SwapFeeBps: 30, FeeCollectors: testFeeCollectors},
+	}))
+
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.msgServer.ExecuteSwap(ctx, &types.MsgExecuteSwap{
+		Did:          did,
+		ConnectionId: testConnectionID,
+		SourceDenom:  "uatom",
+		TargetDenom:  "uosmo",
+		Amount:       math.NewInt(10_000),
+		MinAmountOut: math.NewInt(1),
+		Route:        "pool:1",
+	})
+	require.NoError(t, err)
+
+	// The swap runs on the input after the fee, and the fee follows it in the batch
+	batch, err := f.k.PendingBatches.Get(f.ctx, keeper.GetAccountKey(did, testConnectionID))
+	require.NoError(t, err)
+	require.Len(t, batch.Msgs, 2)
+	var swap types.OsmosisMsgSwapExactAmountIn
+	require.NoError(t, swap.Unmarshal(batch.Msgs[0].Value))
+	require.Equal(t, sdk.NewInt64Coin("uatom", 9_970), swap.TokenIn)
+	var send banktypes.MsgSend
+	require.NoError(t, send.Unmarshal(batch.Msgs[1].Value))
+	require.Equal(t, "cosmos1feecollector", send.ToAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)), send.Amount)
+
+	collected := false
+	for _, event := range ctx.EventManager().Events() {
+		collected = collected || event.Type == types.EventTypeFeeCollected
+	}
+	require.True(t, collected)
+
+	// The fee counts once the host chain acknowledges the packet
+	collectedFees := func() sdk.Coins {
+		res, err := f.queryServer.CollectedFees(f.ctx, &types.QueryCollectedFeesRequest{ConnectionId: testConnectionID})
+		require.NoError(t, err)
+		return res.Fees
+	}
+	require.True(t, collectedFees().IsZero())
+
+	_, err = f.k.ScopedKeeper.NewCapability(f.ctx, host.ChannelCapabilityPath(account.PortId, "channel-0"))
+	require.NoError(t, err)
+	require.NoError(t, f.k.FlushPendingBatches(f.ctx, true))
+	require.True(t, collectedFees().IsZero())
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{})
+	bz, err := proto.Marshal(&ack)
+	require.NoError(t, err)
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: account.PortId, SourceChannel: "channel-0"}
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, bz, nil))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)), collectedFees())
+
+	// Fees of a packet that timed out were never paid
+	_, err = f.msgServer.ExecuteSwap(f.ctx, &types.MsgExecuteSwap{
+		Did:          did,
+		ConnectionId: testConnectionID,
+		SourceDenom:  "uatom",
+		TargetDenom:  "uosmo",
+		Amount:       math.NewInt(10_000),
+		MinAmountOut: math.NewInt(1),
+		Route:        "pool:1",
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.k.FlushPendingBatches(f.ctx, true))
+	require.NoError(t, f.k.OnTimeoutPacket(f.ctx, packet, nil))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)), collectedFees())
+	has, err := f.k.PendingFees.Has(f.ctx, collections.Join3(account.PortId, uint64(1), "uatom"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+// testFeeCollectors collects fees on the test connection only
+var testFeeCollectors = []types.FeeCollector{{ConnectionId: testConnectionID, Address: "cosmos1feecollector"}}
+
+func TestFeeParamsValidate(t *testing.T) {
+	require.NoError(t, types.FeeParams{}.Validate())
+	require.NoError(t, types.FeeParams{SwapFeeBps: 30, FeeCollectors: testFeeCollectors}.Validate())
+	require.Error(t, types.FeeParams{OrderFeeBps: 20}.Validate(), "fees require a collector")
+	require.Error(t, types.FeeParams{LiquidityFeeBps: 10_001, FeeCollectors: testFeeCollectors}.Validate())
+
+	fees := types.FeeParams{SwapFeeBps: 30, FeeCollectors: testFeeCollectors}
+	require.Error(t, types.FeeParams{FeeCollectors: []types.FeeCollector{{ConnectionId: testConnectionID}}}.Validate())
+	require.Error(t, types.FeeParams{
+		FeeCollectors: append(testFeeCollectors, types.FeeCollector{ConnectionId: testConnectionID, Address: "cosmos1other"}),
+	}.Validate(), "duplicate collector")
+
+	require.Equal(t, sdk.NewInt64Coin("uatom", 2), fees.Fee(types.FeeOperationSwap, testConnectionID, sdk.NewInt64Coin("uatom", 999)))
+	require.True(t, fees.Fee(types.FeeOperationOrder, testConnectionID, sdk.NewInt64Coin("uatom", 999)).IsZero())
+	// Connections without a collector are not charged
+	require.True(t, fees.Fee(types.FeeOperationSwap, "connection-7", sdk.NewInt64Coin("uatom", 999)).IsZero())
+}
+
+func TestEstimateSwap(t *testing.T) {
+	f := setupEstimate(t)
+
+	res, err := f.queryServer.EstimateSwap(f.ctx, &types.QueryEstimateSwapRequest{
+		ConnectionId:  testConnectionID,
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "uosmo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 30), res.Fee)
+	// 9,970 uatom at $0.50 buys 19,940 uosmo at $0.25
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 19_940), res.TokenOut)
+	require.Equal(t, "2.000000000000000000", res.Price)
+
+	_, err = f.queryServer.EstimateSwap(f.ctx, &types.QueryEstimateSwapRequest{
+		ConnectionId:  testConnectionID,
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "uatom",
+	})
+	require.Error(t, err, "same denom")
+}
+
+func setupEstimate(t *testing.T) *testFixture {
+	t.Helper()
+	f := SetupTest(t)
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{
+		Enabled: true,
+		Fees:    types.FeeParams{SwapFeeBps: 30, FeeCollectors: testFeeCollectors},
+	}))
+	f.k.SetOracleKeeper(mockOracleKeeper{
+		"uatom": math.LegacyMustNewDecFromStr("0.5"),
+		"uosmo": math.LegacyMustNewDecFromStr("0.25"),
+	})
+	return f
+}
@@ -30,18 +30,54 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		}
 	}
 
+	// Create the module account holding protocol liquidity funds, so
+	// community pool spends reach a module account rather than a new base
+	// account
+	k.accountKeeper.GetModuleAccount(ctx, types.ProtocolLiquidityAccount)
+
 	// Restore accounts
 	for _, account := range state.Accounts {
 		accountKey := GetAccountKey(account.Did, account.ConnectionId)
 		if err := k.Accounts.Set(ctx, accountKey, *account); err != nil {
 			panic(fmt.Sprintf("failed to set account: %v", err))
 		}
+		if err := k.PortAccounts.Set(ctx, account.PortId, accountKey); err != nil {
+			panic(fmt.Sprintf("failed to index account port: %v", err))
+		}
 	}
 
 	// Set account sequence
 	if err := k.AccountSequence.Set(ctx, state.AccountSequence); err != nil {
 		panic(fmt.Sprintf("failed to set account sequence: %v", err))
 	}
+
+	// Set batching params - use defaults if unset
+	batchParams := state.BatchParams
+	if batchParams == (types.BatchParams{}) {
+		batchParams = types.DefaultBatchParams()
+	}
+	if err := k.SetBatchParams(ctx, batchParams); err != nil {
+		panic(fmt.Sprintf("failed to set batch params: %v", err))
+	}
+
+	// Set price history params - use defaults if unset
+	priceHistoryParams := state.PriceHistoryParams
+	if priceHistoryParams == (types.PriceHistoryParams{}) {
+		priceHistoryParams = types.DefaultPriceHistoryParams()
+	}
+	if err := priceHistoryParams.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid price history params: %v", err))
+	}
+	if err := k.PriceHistoryParams.Set(ctx, priceHistoryParams); err != nil {
+		panic(fmt.Sprintf("failed to set price history params: %v", err))
+	}
+
+	// Restore denom filters
+	for _, filter := range state.DenomFilters {
+		if err := k.DenomFilters.Set(ctx, filter.ConnectionId, filter); err != nil {
+			panic(fmt.Sprintf("failed to set denom filter: %v", err))
+		}
+	}
 }
 
 // ExportGenesis exports the module's state
@@ -69,11 +105,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		sequence = 0
 	}
 
+	filters, err := k.GetAllDenomFilters(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("failed to export denom filters: %v", err))
+	}
+
 	return &types.GenesisState{
-		Params:          params,
-		PortId:          types.PortID,
-		Accounts:        accounts,
-		AccountSequence: sequence,
+		Params:             params,
+		PortId:             types.PortID,
+		Accounts:           accounts,
+		AccountSequence:    sequence,
+		BatchParams:        k.GetBatchParams(ctx),
+		DenomFilters:       filters,
+		PriceHistoryParams: k.GetPriceHistoryParams(ctx),
 	}
 }
 
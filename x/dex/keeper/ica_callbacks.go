@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sonr-io/sonr/app/chaos"
 	"github.com/sonr-io/sonr/x/dex/types"
 )
 
@@ -68,6 +70,10 @@ func (k Keeper) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	if err := chaos.Fail(chaos.DelayedAck); err != nil {
+		return err
+	}
+
 	var ack channeltypes.Acknowledgement
 	if err := k.cdc.Unmarshal(acknowledgement, &ack); err != nil {
 		return fmt.Errorf("failed to unmarshal acknowledgement: %w", err)
@@ -92,6 +98,25 @@ func (k Keeper) OnAcknowledgementPacket(
 		),
 	)
 
+	if err := k.OnLimitOrderCancelResult(ctx, packet.SourcePort, packet.Sequence, ack.Success()); err != nil {
+		return fmt.Errorf("failed to settle order cancellation: %w", err)
+	}
+	if err := k.OnSwapResult(ctx, packet.SourcePort, packet.Sequence, &ack); err != nil {
+		return fmt.Errorf("failed to settle swaps: %w", err)
+	}
+	if err := k.OnFeeResult(ctx, packet.SourcePort, packet.Sequence, ack.Success()); err != nil {
+		return fmt.Errorf("failed to settle fees: %w", err)
+	}
+	if err := k.OnPoolReservesResult(ctx, packet.SourcePort, packet.Sequence, &ack); err != nil {
+		return fmt.Errorf("failed to store pool reserves: %w", err)
+	}
+	if err := k.OnLimitOrderStatusResult(ctx, packet.SourcePort, packet.Sequence, &ack); err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+	if err := k.OnProtocolLiquidityResult(ctx, packet.SourcePort, packet.Sequence, &ack); err != nil {
+		return fmt.Errorf("failed to settle protocol liquidity: %w", err)
+	}
+
 	return nil
 }
 
@@ -117,6 +142,25 @@ func (k Keeper) OnTimeoutPacket(
 		),
 	)
 
+	if err := k.OnLimitOrderCancelResult(ctx, packet.SourcePort, packet.Sequence, false); err != nil {
+		return fmt.Errorf("failed to settle order cancellation: %w", err)
+	}
+	if err := k.OnSwapResult(ctx, packet.SourcePort, packet.Sequence, nil); err != nil {
+		return fmt.Errorf("failed to settle swaps: %w", err)
+	}
+	if err := k.OnFeeResult(ctx, packet.SourcePort, packet.Sequence, false); err != nil {
+		return fmt.Errorf("failed to settle fees: %w", err)
+	}
+	if err := k.OnPoolReservesResult(ctx, packet.SourcePort, packet.Sequence, nil); err != nil {
+		return fmt.Errorf("failed to store pool reserves: %w", err)
+	}
+	if err := k.OnLimitOrderStatusResult(ctx, packet.SourcePort, packet.Sequence, nil); err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+	if err := k.OnProtocolLiquidityResult(ctx, packet.SourcePort, packet.Sequence, nil); err != nil {
+		return fmt.Errorf("failed to settle protocol liquidity: %w", err)
+	}
+
 	return nil
 }
 
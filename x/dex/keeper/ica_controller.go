@@ -1,11 +1,16 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/sonr-io/sonr/app/chaos"
 	"github.com/sonr-io/sonr/x/dex/types"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -25,28 +30,88 @@ func (k Keeper) RegisterDEXAccount(
 	if connectionID == "" {
 		return nil, fmt.Errorf("connection ID cannot be empty")
 	}
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return nil, err
+	}
 
-	// Validate DID exists by trying to get the document
-	if _, err := k.didKeeper.GetDIDDocument(ctx, did); err != nil {
+	// Validate DID exists and is active
+	summary, err := k.getDIDSummary(ctx, did)
+	if err != nil {
 		return nil, fmt.Errorf("DID %s does not exist: %w", did, err)
 	}
+	if summary.Deactivated {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDID, "DID %s is deactivated", did)
+	}
+	if err := k.checkAccountLimit(ctx, did, connectionID); err != nil {
+		return nil, err
+	}
+
+	account, err := k.registerICA(ctx, did, connectionID, features)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update DID mappings
+	if err := k.addDIDMapping(ctx, did, connectionID); err != nil {
+		return nil, fmt.Errorf("failed to update DID mappings: %w", err)
+	}
+
+	return account, nil
+}
+
+// checkAccountLimit refuses a new account for a DID that already holds
+// MaxAccountsPerDid of them. Accounts the DID already has on the connection
+// are not counted again, so registering stays idempotent. Zero is unlimited.
+func (k Keeper) checkAccountLimit(ctx sdk.Context, did, connectionID string) error {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxAccountsPerDid == 0 {
+		return nil
+	}
 
+	didAccounts, err := k.DIDToAccounts.Get(ctx, did)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if slices.Contains(didAccounts.Accounts, connectionID) {
+		return nil
+	}
+	if len(didAccounts.Accounts) >= int(params.MaxAccountsPerDid) {
+		return errorsmod.Wrapf(types.ErrTooManyAccounts, "%s holds %d accounts", did, len(didAccounts.Accounts))
+	}
+	return nil
+}
+
+// registerICA opens an interchain account owned by owner on the connection
+// and stores its pending record. An existing account is returned as is.
+func (k Keeper) registerICA(
+	ctx sdk.Context,
+	owner string,
+	connectionID string,
+	features []string,
+) (*types.InterchainDEXAccount, error) {
 	// Check if account already exists
-	accountKey := GetAccountKey(did, connectionID)
+	accountKey := GetAccountKey(owner, connectionID)
 	existing, err := k.Accounts.Get(ctx, accountKey)
 	if err == nil {
 		// Return existing account regardless of status (idempotent)
 		return &existing, nil
 	}
 
-	// Generate unique port ID
-	portID := GetPortID(did, connectionID)
+	// The port is derived from the owner and connection; refuse to share it
+	// with another account
+	portID := GetPortID(owner, connectionID)
+	if taken, err := k.PortAccounts.Get(ctx, portID); err == nil && taken != accountKey {
+		return nil, errorsmod.Wrapf(types.ErrICAPortCollision, "%s is used by %s", portID, taken)
+	}
 
 	// Register ICA account
 	if err := k.icaControllerKeeper.RegisterInterchainAccount(
 		ctx,
 		connectionID,
-		portID,
+		types.ICAOwner(owner, connectionID),
 		"", // Use default version
 	); err != nil {
 		return nil, fmt.Errorf("failed to register ICA account: %w", err)
@@ -54,7 +119,7 @@ func (k Keeper) RegisterDEXAccount(
 
 	// Create DEX account record
 	account := types.InterchainDEXAccount{
-		Did:             did,
+		Did:             owner,
 		ConnectionId:    connectionID,
 		PortId:          portID,
 		EnabledFeatures: features,
@@ -66,10 +131,8 @@ func (k Keeper) RegisterDEXAccount(
 	if err := k.Accounts.Set(ctx, accountKey, account); err != nil {
 		return nil, fmt.Errorf("failed to store DEX account: %w", err)
 	}
-
-	// Update DID mappings
-	if err := k.addDIDMapping(ctx, did, connectionID); err != nil {
-		return nil, fmt.Errorf("failed to update DID mappings: %w", err)
+	if err := k.PortAccounts.Set(ctx, portID, accountKey); err != nil {
+		return nil, fmt.Errorf("failed to index DEX account port: %w", err)
 	}
 
 	return &account, nil
@@ -116,6 +179,24 @@ func (k Keeper) SendDEXTransaction(
 	msgs []sdk.Msg,
 	memo string,
 	timeoutDuration time.Duration,
+) (uint64, error) {
+	// Encode messages
+	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs, icatypes.EncodingProtobuf)
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize transaction: %w", err)
+	}
+
+	return k.sendICAPacket(ctx, did, connectionID, data, memo, timeoutDuration)
+}
+
+// sendICAPacket sends pre-serialized CosmosTx bytes through the DID's ICA channel
+func (k Keeper) sendICAPacket(
+	ctx sdk.Context,
+	did string,
+	connectionID string,
+	data []byte,
+	memo string,
+	timeoutDuration time.Duration,
 ) (uint64, error) {
 	// Get DEX account
 	account, err := k.GetDEXAccount(ctx, did, connectionID)
@@ -151,12 +232,6 @@ func (k Keeper) SendDEXTransaction(
 		return 0, fmt.Errorf("channel capability not found")
 	}
 
-	// Encode messages
-	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs, icatypes.EncodingProtobuf)
-	if err != nil {
-		return 0, fmt.Errorf("failed to serialize transaction: %w", err)
-	}
-
 	// Create packet data
 	packetData := icatypes.InterchainAccountPacketData{
 		Type: icatypes.EXECUTE_TX,
@@ -167,6 +242,10 @@ func (k Keeper) SendDEXTransaction(
 	// Calculate timeout
 	timeoutTimestamp := ctx.BlockTime().Add(timeoutDuration).UnixNano()
 
+	if err := chaos.Fail(chaos.PacketDrop); err != nil {
+		return 0, err
+	}
+
 	// Send transaction
 	sequence, err := k.icaControllerKeeper.SendTx(
 		ctx,
@@ -193,18 +272,9 @@ func (k Keeper) SendDEXTransaction(
 
 // OnICAAccountCreated handles successful ICA account creation
 func (k Keeper) OnICAAccountCreated(ctx sdk.Context, portID, address string) error {
-	// Find account by port ID
-	var account *types.InterchainDEXAccount
-	k.Accounts.Walk(ctx, nil, func(key string, value types.InterchainDEXAccount) (bool, error) {
-		if value.PortId == portID {
-			account = &value
-			return true, nil
-		}
-		return false, nil
-	})
-
-	if account == nil {
-		return fmt.Errorf("DEX account not found for port %s", portID)
+	account, err := k.getAccountByPort(ctx, portID)
+	if err != nil {
+		return err
 	}
 
 	// Update account status and address
@@ -221,6 +291,48 @@ func (k Keeper) OnICAAccountCreated(ctx sdk.Context, portID, address string) err
 	return nil
 }
 
+// getAccountByPort finds the DEX account registered on a controller port
+func (k Keeper) getAccountByPort(ctx sdk.Context, portID string) (*types.InterchainDEXAccount, error) {
+	accountKey, err := k.PortAccounts.Get(ctx, portID)
+	if err != nil {
+		return nil, fmt.Errorf("DEX account not found for port %s", portID)
+	}
+	account, err := k.Accounts.Get(ctx, accountKey)
+	if err != nil {
+		return nil, fmt.Errorf("DEX account not found for port %s: %w", portID, err)
+	}
+	return &account, nil
+}
+
+// GetICAAddress returns the controller port of the interchain account owned
+// by did on a connection and, once registered, the account itself. The port
+// is known up front; the host chain address is only assigned when the host
+// opens the account during the channel handshake.
+func (k Keeper) GetICAAddress(ctx sdk.Context, did, connectionID string) (*types.QueryICAAddressResponse, error) {
+	res := &types.QueryICAAddressResponse{
+		PortId: GetPortID(did, connectionID),
+		Owner:  types.ICAOwner(did, connectionID),
+	}
+	if conn, found := k.connectionKeeper.GetConnection(ctx, connectionID); found {
+		res.HostConnectionId = conn.Counterparty.ConnectionId
+	}
+
+	account, err := k.Accounts.Get(ctx, GetAccountKey(did, connectionID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Registered = true
+	res.Status = account.Status
+	res.Address = account.AccountAddress
+	if res.Address == "" {
+		res.Address, _ = k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, account.PortId)
+	}
+	return res, nil
+}
+
 // Helper functions
 
 func (k Keeper) addDIDMapping(ctx sdk.Context, did, connectionID string) error {
@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/sonr-io/sonr/x/dex/keeper"
 	"github.com/sonr-io/sonr/x/dex/types"
 )
 
@@ -51,8 +53,49 @@ func (suite *ICAControllerTestSuite) TestRegisterDEXAccount() {
 	suite.Require().NotEmpty(account.PortId)
 
 	// Verify port ID format
-	expectedPortPrefix := "dex-" + did
-	suite.Require().Contains(account.PortId, expectedPortPrefix)
+	suite.Require().Equal(types.ICAPortID(did, connectionID), account.PortId)
+	suite.Require().True(strings.HasPrefix(account.PortId, "icacontroller-did-"))
+}
+
+// TestRegisterDEXAccount_PortCollision tests that a port held by another
+// account is refused
+func (suite *ICAControllerTestSuite) TestRegisterDEXAccount_PortCollision() {
+	did := "did:sonr:test_ica_port"
+	portID := keeper.GetPortID(did, testConnectionID)
+	suite.Require().NoError(suite.f.k.PortAccounts.Set(suite.f.ctx, portID, "did:sonr:other:connection-0"))
+
+	_, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, did, testConnectionID, []string{"swap"})
+	suite.Require().ErrorIs(err, types.ErrICAPortCollision)
+}
+
+// TestICAAddress tests that the port is reported before registration and
+// the address once the host opens the account
+func (suite *ICAControllerTestSuite) TestICAAddress() {
+	did := "did:sonr:test_ica_address"
+	qs := keeper.NewQueryServerImpl(suite.f.k)
+	req := &types.QueryICAAddressRequest{Did: did, ConnectionId: testConnectionID}
+
+	res, err := qs.ICAAddress(suite.f.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ICAPortID(did, testConnectionID), res.PortId)
+	suite.Require().Equal(types.ICAOwner(did, testConnectionID), res.Owner)
+	suite.Require().Equal("connection-0", res.HostConnectionId)
+	suite.Require().False(res.Registered)
+	suite.Require().Empty(res.Address)
+
+	account, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, did, testConnectionID, []string{"swap"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.PortId, account.PortId, "registration uses the reported port")
+	suite.Require().NoError(suite.f.k.OnICAAccountCreated(suite.f.ctx, account.PortId, "osmo1ica"))
+
+	res, err = qs.ICAAddress(suite.f.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Registered)
+	suite.Require().Equal(types.ACCOUNT_STATUS_ACTIVE, res.Status)
+	suite.Require().Equal("osmo1ica", res.Address)
+
+	_, err = qs.ICAAddress(suite.f.ctx, &types.QueryICAAddressRequest{Did: did})
+	suite.Require().Error(err)
 }
 
 // TestRegisterDEXAccount_DuplicateRegistration tests duplicate registration
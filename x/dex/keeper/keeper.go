@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -38,6 +39,16 @@ type Keeper struct {
 	channelKeeper       types.ChannelKeeper
 	didKeeper           types.DIDKeeper
 	dwnKeeper           types.DWNKeeper
+	oracleKeeper        types.OracleKeeper
+	badgeKeeper         types.BadgeKeeper
+	transferKeeper      types.TransferKeeper
+
+	// Compliance screener consulted before large operations, nil when the
+	// node has none
+	screener types.Screener
+
+	// DID summaries read in the current block, kept in the transient store
+	didSummaries collections.Map[string, collections.Pair[string, bool]]
 
 	// UCAN functionality
 	ucanVerifier        *ucan.Verifier
@@ -46,14 +57,63 @@ type Keeper struct {
 	// Collections for state management
 	Params          collections.Item[types.Params]
 	Accounts        collections.Map[string, types.InterchainDEXAccount]
+	PortAccounts    collections.Map[string, string] // controller port -> account key
 	AccountSequence collections.Sequence
 	DIDToAccounts   collections.Map[string, types.DIDAccounts] // DID -> account mappings
 	DIDActivities   collections.Map[string, types.DEXActivity] // DID activity records
+	BatchParams     collections.Item[types.BatchParams]
+	PendingBatches  collections.Map[string, types.PendingICABatch] // account key -> queued ICA messages
+	DenomFilters    collections.Map[string, types.DenomFilter]     // connection ID -> denom allow/deny list
+
+	PriceHistoryParams collections.Item[types.PriceHistoryParams]
+	PriceHistory       collections.Map[collections.Pair[string, uint64], types.PriceEpoch] // (denom, epoch) -> aggregated price
+
+	TWAMMOrders   collections.Map[uint64, types.TWAMMOrder]
+	TWAMMSequence collections.Sequence
+	TWAMMSchedule collections.KeySet[collections.Pair[int64, uint64]] // (execution height, order ID)
+
+	SwapIntents collections.Map[collections.Triple[int64, string, string], types.SwapIntent] // (height, DID, pair) -> swap
+
+	LimitOrders        collections.Map[uint64, types.LimitOrder]
+	LimitOrderSequence collections.Sequence
+	LimitOrderExpiry   collections.KeySet[collections.Pair[int64, uint64]]  // (expiration, order ID) of open orders
+	LimitOrdersByDID   collections.KeySet[collections.Pair[string, uint64]] // (DID, order ID)
+
+	PendingOrderCancels collections.Map[collections.Pair[string, uint64], uint64] // (port, sequence) -> order ID
+	PendingOrderQueries collections.Map[collections.Pair[string, uint64], uint64] // (port, sequence) -> order ID
+	OrderMonitorCursor  collections.Item[uint64]                                  // last order ID queried by the monitor
+
+	Swaps         collections.Map[uint64, types.SwapRecord]
+	SwapSequence  collections.Sequence
+	UnsentSwaps   collections.KeySet[collections.Pair[string, uint64]]           // (account key, swap ID) of batched swaps
+	SwapsByPacket collections.KeySet[collections.Triple[string, uint64, uint64]] // (port, sequence, swap ID) of unsettled swaps
+
+	BlockOps      collections.Item[uint32]                      // swaps and orders in the current block
+	DIDOpCounters collections.Map[string, types.DIDOpCounter]   // DID -> daily operation counter
+	DailyVolumes  collections.Map[string, types.DIDDailyVolume] // DID -> daily swap volume
+
+	CollectedFees collections.Map[collections.Pair[string, string], math.Int]           // (connection, denom) -> fees collected
+	PendingFees   collections.Map[collections.Triple[string, uint64, string], math.Int] // (port, sequence, denom) -> fees in flight
+	UnsentFees    collections.Map[collections.Pair[string, string], math.Int]           // (account key, denom) -> fees waiting in a batch
+
+	OTCOffers        collections.Map[uint64, types.OTCOffer]
+	OTCOfferSequence collections.Sequence
+	OTCOfferExpiry   collections.KeySet[collections.Pair[int64, uint64]]  // (expiration, offer ID) of unsettled offers
+	OTCOffersByDID   collections.KeySet[collections.Pair[string, uint64]] // (DID, offer ID) of makers, takers and arbiters
+
+	PoolReserves       collections.Map[collections.Pair[string, uint64], types.PoolReserves] // (connection, pool ID) -> latest reserves
+	PendingPoolQueries collections.Map[collections.Pair[string, uint64], types.PoolReserves] // (port, sequence) -> pool being queried
+
+	DWNActivityRecords collections.Map[collections.Pair[string, string], string] // (DID, activity key) -> DWN record ID
+
+	ProtocolPositions        collections.Map[collections.Pair[string, uint64], types.ProtocolPosition]    // (connection, pool ID) -> protocol-owned liquidity
+	PendingProtocolLiquidity collections.Map[collections.Pair[string, uint64], types.ProtocolLiquidityOp] // (port, sequence) -> unacknowledged provision or removal
 }
 
 // SetDIDKeeper sets the DID keeper (called after initialization)
 func (k *Keeper) SetDIDKeeper(didKeeper types.DIDKeeper) {
 	k.didKeeper = didKeeper
+	k.initUCAN()
 }
 
 // SetDWNKeeper sets the DWN keeper (called after initialization)
@@ -61,10 +121,34 @@ func (k *Keeper) SetDWNKeeper(dwnKeeper types.DWNKeeper) {
 	k.dwnKeeper = dwnKeeper
 }
 
+// SetOracleKeeper sets the oracle keeper that prices swap volume (called after initialization)
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.oracleKeeper = oracleKeeper
+}
+
+// SetBadgeKeeper sets the keeper that tells verified DIDs apart for their
+// raised limits (called after initialization)
+func (k *Keeper) SetBadgeKeeper(badgeKeeper types.BadgeKeeper) {
+	k.badgeKeeper = badgeKeeper
+}
+
+// SetTransferKeeper sets the ICS-20 transfer keeper that funds the protocol
+// accounts (called after initialization)
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// SetScreener sets the compliance screener consulted before large swaps,
+// orders and OTC offers (called after initialization)
+func (k *Keeper) SetScreener(screener types.Screener) {
+	k.screener = screener
+}
+
 // NewKeeper creates a new DEX Keeper instance
 func NewKeeper(
 	appCodec codec.Codec,
 	storeService store.KVStoreService,
+	transientStoreService store.TransientStoreService,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	portKeeper *portkeeper.Keeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
@@ -78,6 +162,7 @@ func NewKeeper(
 	authority string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
 
 	k := Keeper{
 		cdc:          appCodec,
@@ -98,6 +183,14 @@ func NewKeeper(
 		didKeeper:           didKeeper,
 		dwnKeeper:           dwnKeeper,
 
+		didSummaries: collections.NewMap(
+			tsb,
+			types.DIDSummaryCachePrefix,
+			"did_summaries",
+			collections.StringKey,
+			didSummaryValue,
+		),
+
 		// State collections
 		Params: collections.NewItem(
 			sb,
@@ -112,6 +205,13 @@ func NewKeeper(
 			collections.StringKey,
 			codec.CollValue[types.InterchainDEXAccount](appCodec),
 		),
+		PortAccounts: collections.NewMap(
+			sb,
+			types.ICAPortAccountsPrefix,
+			"ica_port_accounts",
+			collections.StringKey,
+			collections.StringValue,
+		),
 		AccountSequence: collections.NewSequence(
 			sb,
 			collections.NewPrefix(2),
@@ -131,25 +231,258 @@ func NewKeeper(
 			collections.StringKey,
 			codec.CollValue[types.DEXActivity](appCodec),
 		),
+		BatchParams: collections.NewItem(
+			sb,
+			collections.NewPrefix(5),
+			"batch_params",
+			codec.CollValue[types.BatchParams](appCodec),
+		),
+		PendingBatches: collections.NewMap(
+			sb,
+			collections.NewPrefix(6),
+			"pending_batches",
+			collections.StringKey,
+			codec.CollValue[types.PendingICABatch](appCodec),
+		),
+		DenomFilters: collections.NewMap(
+			sb,
+			types.DenomFiltersPrefix,
+			"denom_filters",
+			collections.StringKey,
+			codec.CollValue[types.DenomFilter](appCodec),
+		),
+		PriceHistoryParams: collections.NewItem(
+			sb,
+			types.PriceHistoryParamsPrefix,
+			"price_history_params",
+			codec.CollValue[types.PriceHistoryParams](appCodec),
+		),
+		PriceHistory: collections.NewMap(
+			sb,
+			types.PriceHistoryPrefix,
+			"price_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PriceEpoch](appCodec),
+		),
+		TWAMMOrders: collections.NewMap(
+			sb,
+			types.TWAMMOrdersPrefix,
+			"twamm_orders",
+			collections.Uint64Key,
+			codec.CollValue[types.TWAMMOrder](appCodec),
+		),
+		TWAMMSequence: collections.NewSequence(
+			sb,
+			types.TWAMMSequencePrefix,
+			"twamm_sequence",
+		),
+		TWAMMSchedule: collections.NewKeySet(
+			sb,
+			types.TWAMMSchedulePrefix,
+			"twamm_schedule",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		SwapIntents: collections.NewMap(
+			sb,
+			types.SwapIntentsPrefix,
+			"swap_intents",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.SwapIntent](appCodec),
+		),
+		LimitOrders: collections.NewMap(
+			sb,
+			types.LimitOrdersPrefix,
+			"limit_orders",
+			collections.Uint64Key,
+			codec.CollValue[types.LimitOrder](appCodec),
+		),
+		LimitOrderSequence: collections.NewSequence(
+			sb,
+			types.LimitOrderSequencePrefix,
+			"limit_order_sequence",
+		),
+		LimitOrderExpiry: collections.NewKeySet(
+			sb,
+			types.LimitOrderExpiryPrefix,
+			"limit_order_expiry",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		LimitOrdersByDID: collections.NewKeySet(
+			sb,
+			types.LimitOrdersByDIDPrefix,
+			"limit_orders_by_did",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		PendingOrderCancels: collections.NewMap(
+			sb,
+			types.PendingOrderCancelsPrefix,
+			"pending_order_cancels",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		PendingOrderQueries: collections.NewMap(
+			sb,
+			types.PendingOrderQueriesPrefix,
+			"pending_order_queries",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		OrderMonitorCursor: collections.NewItem(
+			sb,
+			types.OrderMonitorCursorPrefix,
+			"order_monitor_cursor",
+			collections.Uint64Value,
+		),
+		Swaps: collections.NewMap(
+			sb,
+			types.SwapsPrefix,
+			"swaps",
+			collections.Uint64Key,
+			codec.CollValue[types.SwapRecord](appCodec),
+		),
+		SwapSequence: collections.NewSequence(
+			sb,
+			types.SwapSequencePrefix,
+			"swap_sequence",
+		),
+		UnsentSwaps: collections.NewKeySet(
+			sb,
+			types.UnsentSwapsPrefix,
+			"unsent_swaps",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		SwapsByPacket: collections.NewKeySet(
+			sb,
+			types.SwapsByPacketPrefix,
+			"swaps_by_packet",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key),
+		),
+		BlockOps: collections.NewItem(
+			sb,
+			types.BlockOpsPrefix,
+			"block_ops",
+			collections.Uint32Value,
+		),
+		DIDOpCounters: collections.NewMap(
+			sb,
+			types.DIDOpCountersPrefix,
+			"did_op_counters",
+			collections.StringKey,
+			codec.CollValue[types.DIDOpCounter](appCodec),
+		),
+		DailyVolumes: collections.NewMap(
+			sb,
+			types.DIDDailyVolumesPrefix,
+			"daily_volumes",
+			collections.StringKey,
+			codec.CollValue[types.DIDDailyVolume](appCodec),
+		),
+		CollectedFees: collections.NewMap(
+			sb,
+			types.CollectedFeesPrefix,
+			"collected_fees",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.IntValue,
+		),
+		PendingFees: collections.NewMap(
+			sb,
+			types.PendingFeesPrefix,
+			"pending_fees",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey),
+			sdk.IntValue,
+		),
+		UnsentFees: collections.NewMap(
+			sb,
+			types.UnsentFeesPrefix,
+			"unsent_fees",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.IntValue,
+		),
+		OTCOffers: collections.NewMap(
+			sb,
+			types.OTCOffersPrefix,
+			"otc_offers",
+			collections.Uint64Key,
+			codec.CollValue[types.OTCOffer](appCodec),
+		),
+		OTCOfferSequence: collections.NewSequence(
+			sb,
+			types.OTCOfferSequencePrefix,
+			"otc_offer_sequence",
+		),
+		OTCOfferExpiry: collections.NewKeySet(
+			sb,
+			types.OTCOfferExpiryPrefix,
+			"otc_offer_expiry",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		OTCOffersByDID: collections.NewKeySet(
+			sb,
+			types.OTCOffersByDIDPrefix,
+			"otc_offers_by_did",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		PoolReserves: collections.NewMap(
+			sb,
+			types.PoolReservesPrefix,
+			"pool_reserves",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PoolReserves](appCodec),
+		),
+		PendingPoolQueries: collections.NewMap(
+			sb,
+			types.PendingPoolQueriesPrefix,
+			"pending_pool_queries",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PoolReserves](appCodec),
+		),
+		DWNActivityRecords: collections.NewMap(
+			sb,
+			types.DWNActivityRecordsPrefix,
+			"dwn_activity_records",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.StringValue,
+		),
+		ProtocolPositions: collections.NewMap(
+			sb,
+			types.ProtocolPositionsPrefix,
+			"protocol_positions",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.ProtocolPosition](appCodec),
+		),
+		PendingProtocolLiquidity: collections.NewMap(
+			sb,
+			types.PendingProtocolLiquidityPrefix,
+			"pending_protocol_liquidity",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.ProtocolLiquidityOp](appCodec),
+		),
 	}
 
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
+	if _, err := tsb.Build(); err != nil {
+		panic(err)
+	}
 
 	k.schema = schema
 
-	// Initialize UCAN verifier and permission validator
-	if didKeeper != nil {
-		didResolver := &DEXDIDResolver{keeper: k}
-		k.ucanVerifier = ucan.NewVerifier(didResolver)
-		k.permissionValidator = NewPermissionValidator(k)
-	}
+	k.initUCAN()
 
 	return k
 }
 
+// initUCAN builds the UCAN verifier and permission validator. They resolve
+// DIDs through a copy of the keeper, so they are rebuilt whenever the DID
+// keeper changes.
+func (k *Keeper) initUCAN() {
+	didResolver := &DEXDIDResolver{keeper: *k}
+	k.ucanVerifier = ucan.NewVerifier(didResolver)
+	k.permissionValidator = NewPermissionValidator(*k)
+}
+
 // WithICS4Wrapper sets the ICS4Wrapper
 func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
@@ -170,12 +503,18 @@ func (k Keeper) GetPermissionValidator() *PermissionValidator {
 	return k.permissionValidator
 }
 
+// SetPermissionValidator replaces the UCAN permission validator. With a nil
+// validator UCAN tokens are skipped, or rejected when strict_ucan is set.
+func (k *Keeper) SetPermissionValidator(pv *PermissionValidator) {
+	k.permissionValidator = pv
+}
+
 // GetAccountKey generates a unique key for DEX accounts
 func GetAccountKey(did, connectionID string) string {
 	return fmt.Sprintf("%s:%s", did, connectionID)
 }
 
-// GetPortID generates a unique port ID for a DEX account
+// GetPortID returns the controller port of a DEX account, see types.ICAPortID
 func GetPortID(did, connectionID string) string {
-	return fmt.Sprintf("dex-%s-%s", did, connectionID)
+	return types.ICAPortID(did, connectionID)
 }
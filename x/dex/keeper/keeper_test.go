@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -41,6 +42,7 @@ import (
 	"github.com/sonr-io/sonr/x/dex/keeper"
 	"github.com/sonr-io/sonr/x/dex/types"
 	didtypes "github.com/sonr-io/sonr/x/did/types"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
 )
 
 var maccPerms = map[string][]string{
@@ -64,6 +66,11 @@ type testFixture struct {
 	stakingKeeper *stakingkeeper.Keeper
 	mintkeeper    mintkeeper.Keeper
 
+	didKeeper *mockDIDKeeper
+	bank      *mockBankKeeper
+	dwn       *mockDWNKeeper
+	ica       *mockICAControllerKeeper
+
 	addrs      []sdk.AccAddress
 	govModAddr string
 }
@@ -103,6 +110,7 @@ func SetupTest(t *testing.T) *testFixture {
 		stakingtypes.StoreKey, minttypes.StoreKey, capabilitytypes.StoreKey,
 	)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(types.TransientStoreKey)
 
 	cdc := encCfg.Codec
 
@@ -149,27 +157,28 @@ func SetupTest(t *testing.T) *testFixture {
 	// Create mock expected keepers
 	mockICS4Wrapper := &mockICS4Wrapper{}
 	mockAccountKeeper := &mockAccountKeeper{}
-	mockBankKeeper := &mockBankKeeper{}
-	mockICAControllerKeeper := &mockICAControllerKeeper{}
+	f.bank = &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+	f.ica = &mockICAControllerKeeper{}
 	mockConnectionKeeper := &mockConnectionKeeper{}
 	mockChannelKeeper := &mockChannelKeeper{}
-	mockDIDKeeper := &mockDIDKeeper{}
-	mockDWNKeeper := &mockDWNKeeper{}
+	f.didKeeper = &mockDIDKeeper{deactivated: make(map[string]bool), controllers: make(map[string]string)}
+	f.dwn = &mockDWNKeeper{records: make(map[string]dwntypes.DWNRecord)}
 
 	// Initialize DEX keeper
 	f.k = keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(keys[types.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[types.TransientStoreKey]),
 		mockICS4Wrapper,
 		&portKeeper,
 		scopedKeeper,
 		mockAccountKeeper,
-		mockBankKeeper,
-		mockICAControllerKeeper,
+		f.bank,
+		f.ica,
 		mockConnectionKeeper,
 		mockChannelKeeper,
-		mockDIDKeeper,
-		mockDWNKeeper,
+		f.didKeeper,
+		f.dwn,
 		authority.String(),
 	)
 
@@ -181,6 +190,13 @@ func SetupTest(t *testing.T) *testFixture {
 	for _, key := range memKeys {
 		cms.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
 	}
+	for _, key := range tkeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	// Reload so the memory and transient stores mounted above are available
+	if err := cms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
 
 	f.ctx = sdk.NewContext(cms, cmtproto.Header{
 		Height: 1,
@@ -285,7 +301,9 @@ func (suite *KeeperTestSuite) TestParamsOperations() {
 			SwapFeeBps:      30, // 0.3%
 			LiquidityFeeBps: 10, // 0.1%
 			OrderFeeBps:     20, // 0.2%
-			FeeCollector:    "sonr1feecolllector",
+			FeeCollectors: []types.FeeCollector{
+				{ConnectionId: "connection-0", Address: "sonr1feecolllector"},
+			},
 		},
 	}
 
@@ -354,7 +372,11 @@ func (m *mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
-type mockBankKeeper struct{}
+// mockBankKeeper tracks the balances moved in and out of module escrow;
+// plain sends are accepted without effect
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins // address or module name -> balance
+}
 
 func (m *mockBankKeeper) SendCoins(
 	ctx context.Context,
@@ -365,15 +387,55 @@ func (m *mockBankKeeper) SendCoins(
 }
 
 func (m *mockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
-	return sdk.NewCoins()
+	return m.balances[addr.String()]
 }
 
-type mockICAControllerKeeper struct{}
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(
+	ctx context.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	return m.move(senderAddr.String(), recipientModule, amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(
+	ctx context.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	return m.move(senderModule, recipientAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) move(from, to string, amt sdk.Coins) error {
+	balance, negative := m.balances[from].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", m.balances[from], amt)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+// mockICAControllerKeeper records the interchain accounts registered and the
+// packets sent through it. Registering fails with registerErr and sending
+// with sendErr when they are set.
+type mockICAControllerKeeper struct {
+	owners      []string
+	packets     []icatypes.InterchainAccountPacketData
+	registerErr error
+	sendErr     error
+}
 
 func (m *mockICAControllerKeeper) RegisterInterchainAccount(
 	ctx sdk.Context,
 	connectionID, owner, version string,
 ) error {
+	if m.registerErr != nil {
+		return m.registerErr
+	}
+	m.owners = append(m.owners, owner)
 	return nil
 }
 
@@ -391,6 +453,10 @@ func (m *mockICAControllerKeeper) SendTx(
 	icaPacketData icatypes.InterchainAccountPacketData,
 	timeoutTimestamp uint64,
 ) (uint64, error) {
+	if m.sendErr != nil {
+		return 0, m.sendErr
+	}
+	m.packets = append(m.packets, icaPacketData)
 	return 1, nil
 }
 
@@ -458,15 +524,107 @@ func (m *mockChannelKeeper) SendPacket(
 	return 1, nil
 }
 
-type mockDIDKeeper struct{}
+// mockDIDKeeper resolves every DID as active, except those marked deactivated,
+// and counts the reads made through it. A DID controls itself unless it has
+// an entry in controllers.
+type mockDIDKeeper struct {
+	deactivated map[string]bool
+	controllers map[string]string
+	reads       int
+}
 
-func (m *mockDIDKeeper) GetDIDDocument(
+func (m *mockDIDKeeper) GetDIDSummary(
 	ctx context.Context,
 	did string,
-) (*didtypes.DIDDocument, error) {
-	return &didtypes.DIDDocument{
-		Id: did,
+) (didtypes.DIDSummary, error) {
+	m.reads++
+	controller, ok := m.controllers[did]
+	if !ok {
+		controller = did
+	}
+	return didtypes.DIDSummary{
+		Controller:  controller,
+		Deactivated: m.deactivated[did],
 	}, nil
 }
 
-type mockDWNKeeper struct{}
+// mockDWNKeeper keeps DWN records in memory. Records are stored encrypted
+// when encrypt is set, and writes fail with writeErr when it is set.
+type mockDWNKeeper struct {
+	records  map[string]dwntypes.DWNRecord
+	next     int
+	encrypt  bool
+	writeErr error
+}
+
+func (m *mockDWNKeeper) RecordsWrite(
+	ctx context.Context,
+	msg *dwntypes.MsgRecordsWrite,
+) (*dwntypes.MsgRecordsWriteResponse, error) {
+	if m.writeErr != nil {
+		return nil, m.writeErr
+	}
+	m.next++
+	id := fmt.Sprintf("record-%d", m.next)
+	m.records[id] = dwntypes.DWNRecord{
+		RecordId:    id,
+		Target:      msg.Target,
+		Descriptor_: msg.Descriptor_,
+		Data:        msg.Data,
+		Protocol:    msg.Protocol,
+		Schema:      msg.Schema,
+		IsEncrypted: m.encrypt,
+	}
+	return &dwntypes.MsgRecordsWriteResponse{RecordId: id}, nil
+}
+
+func (m *mockDWNKeeper) RecordsDelete(
+	ctx context.Context,
+	msg *dwntypes.MsgRecordsDelete,
+) (*dwntypes.MsgRecordsDeleteResponse, error) {
+	record, ok := m.records[msg.RecordId]
+	if !ok || record.Target != msg.Target {
+		return nil, dwntypes.ErrRecordNotFound
+	}
+	delete(m.records, msg.RecordId)
+	return &dwntypes.MsgRecordsDeleteResponse{Success: true, DeletedCount: 1}, nil
+}
+
+func (m *mockDWNKeeper) Record(
+	ctx context.Context,
+	req *dwntypes.QueryRecordRequest,
+) (*dwntypes.QueryRecordResponse, error) {
+	record, ok := m.records[req.RecordId]
+	if !ok || record.Target != req.Target {
+		return nil, dwntypes.ErrRecordNotFound
+	}
+	return &dwntypes.QueryRecordResponse{Record: &record}, nil
+}
+
+func (suite *KeeperTestSuite) TestPriceHistory() {
+	start := time.Unix(1700000000, 0).Truncate(time.Hour)
+	ctx := suite.f.ctx.WithBlockTime(start)
+
+	prices := []string{"1.00", "1.20", "0.90", "1.10"}
+	for i, p := range prices {
+		blockCtx := ctx.WithBlockTime(start.Add(time.Duration(i) * 30 * time.Minute))
+		suite.Require().NoError(suite.f.k.RecordPrice(blockCtx, "uatom", math.LegacyMustNewDecFromStr(p)))
+	}
+
+	// Two hourly epochs at native resolution
+	history, err := suite.f.k.QueryPriceHistory(ctx, "uatom", start, start.Add(2*time.Hour), 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(uint64(2), history[0].Samples)
+
+	// Downsampled into a single two-hour bucket
+	history, err = suite.f.k.QueryPriceHistory(ctx, "uatom", start, start.Add(2*time.Hour), 2*time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 1)
+	suite.Require().Equal(uint64(4), history[0].Samples)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("1.20").String(), history[0].High)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("0.90").String(), history[0].Low)
+
+	_, err = suite.f.k.QueryPriceHistory(ctx, "uatom", start, start.Add(time.Hour), 90*time.Minute)
+	suite.Require().Error(err)
+}
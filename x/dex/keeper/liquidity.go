@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -20,7 +21,15 @@ func (k Keeper) ProvideLiquidity(
 	tokenA sdk.Coin,
 	tokenB sdk.Coin,
 	minShares math.Int,
+	timeout time.Duration,
 ) (uint64, error) {
+	if err := k.checkDenomsAllowed(ctx, connectionID, tokenA.Denom, tokenB.Denom); err != nil {
+		return 0, err
+	}
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return 0, err
+	}
+
 	// Get the DEX account
 	account, err := k.GetDEXAccount(ctx, did, connectionID)
 	if err != nil {
@@ -41,18 +50,23 @@ func (k Keeper) ProvideLiquidity(
 	}
 
 	// Send the liquidity transaction via ICA
-	sequence, err := k.SendDEXTransaction(
+	queued, sequence, err := k.QueueDEXTransaction(
 		ctx,
 		did,
 		connectionID,
 		[]sdk.Msg{lpMsg},
 		fmt.Sprintf("provide_liquidity_pool_%d", poolID),
-		30*time.Second,
+		timeout,
 	)
 	if err != nil {
 		return 0, fmt.Errorf("failed to send liquidity transaction: %w", err)
 	}
 
+	details := map[string]any{"pool_id": poolID, "min_shares": minShares.String()}
+	if err := k.recordLiquidityActivity(ctx, did, connectionID, "provide_liquidity", queued, sequence, sdk.NewCoins(tokenA, tokenB), details); err != nil {
+		return 0, err
+	}
+
 	// Emit liquidity event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -78,6 +92,7 @@ func (k Keeper) RemoveLiquidity(
 	shares math.Int,
 	minAmountA math.Int,
 	minAmountB math.Int,
+	timeout time.Duration,
 ) (uint64, error) {
 	// Get the DEX account
 	account, err := k.GetDEXAccount(ctx, did, connectionID)
@@ -99,18 +114,28 @@ func (k Keeper) RemoveLiquidity(
 	}
 
 	// Send the removal transaction via ICA
-	sequence, err := k.SendDEXTransaction(
+	queued, sequence, err := k.QueueDEXTransaction(
 		ctx,
 		did,
 		connectionID,
 		[]sdk.Msg{removeMsg},
 		fmt.Sprintf("remove_liquidity_pool_%d", poolID),
-		30*time.Second,
+		timeout,
 	)
 	if err != nil {
 		return 0, fmt.Errorf("failed to send liquidity removal transaction: %w", err)
 	}
 
+	details := map[string]any{
+		"pool_id":      poolID,
+		"shares":       shares.String(),
+		"min_amount_a": minAmountA.String(),
+		"min_amount_b": minAmountB.String(),
+	}
+	if err := k.recordLiquidityActivity(ctx, did, connectionID, "remove_liquidity", queued, sequence, nil, details); err != nil {
+		return 0, err
+	}
+
 	// Emit removal event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -126,6 +151,55 @@ func (k Keeper) RemoveLiquidity(
 	return sequence, nil
 }
 
+// recordLiquidityActivity adds a liquidity provision or removal to the DID's
+// activity and its DWN vault as pending. A queued operation has no packet
+// sequence of its own yet, so only one sent directly records it.
+func (k Keeper) recordLiquidityActivity(
+	ctx sdk.Context,
+	did string,
+	connectionID string,
+	operation string,
+	queued bool,
+	sequence uint64,
+	amount sdk.Coins,
+	details map[string]any,
+) error {
+	if !queued {
+		details["sequence"] = sequence
+	}
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return err
+	}
+	activity := types.DEXActivity{
+		Type:         operation,
+		Did:          did,
+		ConnectionId: connectionID,
+		BlockHeight:  ctx.BlockHeight(),
+		Timestamp:    ctx.BlockTime(),
+		Details:      string(encoded),
+		Status:       "pending",
+		Amount:       amount,
+	}
+	key, err := k.nextActivityKey(ctx, func(n int) string {
+		return GetLiquidityActivityKey(did, operation, ctx.BlockHeight(), n)
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.DIDActivities.Set(ctx, key, activity); err != nil {
+		return fmt.Errorf("failed to record liquidity activity: %w", err)
+	}
+	k.storeActivityInDWN(ctx, did, key, activity)
+	return nil
+}
+
+// GetLiquidityActivityKey returns the key of a liquidity operation in a DID's
+// activity; n tells apart several operations in the same block
+func GetLiquidityActivityKey(did, operation string, height int64, n int) string {
+	return fmt.Sprintf("%s%s_%d_%d", GetDIDActivityPrefix(did), operation, height, n)
+}
+
 // EstimateLPShares estimates the LP shares for given liquidity
 func (k Keeper) EstimateLPShares(
 	ctx sdk.Context,
@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/sonr-io/sonr/x/dex/types"
 )
 
@@ -52,168 +58,625 @@ func (ms msgServer) RegisterDEXAccount(
 	}, nil
 }
 
-// TODO: ExecuteSwap - Implement cross-chain swap execution via ICA
-// This method should handle token swaps on remote chains through Interchain Accounts
-// Required implementation steps:
-// 1. Validate the sender's DID exists and is active using did keeper
-// 2. Verify UCAN token has proper swap capabilities (resource: swap, action: execute)
-// 3. Retrieve the ICA account for this DID and connection from state
-// 4. Build the appropriate swap message for the target chain's DEX protocol
-// 5. Create ICA packet data with the swap transaction
-// 6. Send ICA packet through IBC channel and await acknowledgment
-// 7. Store transaction details in DWN for user history tracking
-// 8. Emit events for indexing and monitoring
-// Returns: Sequence number and transaction ID on success
 // ExecuteSwap implements types.MsgServer.
 func (ms msgServer) ExecuteSwap(
 	ctx context.Context,
 	msg *types.MsgExecuteSwap,
 ) (*types.MsgExecuteSwapResponse, error) {
 	// Validate UCAN permission if token provided
-	if msg.UcanToken != "" {
-		// Use connection ID as resource ID for swap operations
-		if err := ms.validateUCANPermission(ctx, msg.UcanToken, "swap", msg.ConnectionId, types.DEXOpExecuteSwap); err != nil {
-			return nil, err
-		}
+	service, err := ms.validateUCANPermission(ctx, msg.UcanToken, msg.ConnectionId, types.DEXOpExecuteSwap)
+	if err != nil {
+		return nil, err
 	}
 
-	// TODO: Implement swap execution via ICA
-	// 1. Validate DID
-	// 2. Get ICA account for this DID and connection
-	// 3. Construct swap message for remote chain
-	// 4. Send ICA packet with swap instruction
-	// 5. Track transaction in DWN
-	return &types.MsgExecuteSwapResponse{}, nil
+	// The service is told when the swap settles
+	sdkCtx := WithCallbackService(sdk.UnwrapSDKContext(ctx), service)
+	if err := ms.ConsumeRateLimit(sdkCtx, msg.Did); err != nil {
+		return nil, err
+	}
+	if err := ms.ConsumeDailyVolume(sdkCtx, msg.Did, sdk.NewCoin(msg.SourceDenom, msg.Amount)); err != nil {
+		return nil, err
+	}
+	if err := ms.Screen(sdkCtx, types.ScreeningRequest{
+		Operation: types.ScreeningOpSwap,
+		DID:       msg.Did,
+		Parties:   ms.screeningParties(sdkCtx, msg.Did, msg.ConnectionId),
+		Amount:    sdk.NewCoins(sdk.NewCoin(msg.SourceDenom, msg.Amount)),
+	}); err != nil {
+		return nil, err
+	}
+	timeout, err := ms.ICATimeout(sdkCtx, msg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := types.ParseSwapRoute(msg.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	// The platform fee is taken from the amount to swap
+	fee, tokenIn, err := ms.DeductFee(sdkCtx, types.FeeOperationSwap, msg.ConnectionId, sdk.NewCoin(msg.SourceDenom, msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := ms.Keeper.ExecuteSwap(
+		sdkCtx,
+		msg.Did,
+		msg.ConnectionId,
+		tokenIn,
+		msg.TargetDenom,
+		msg.MinAmountOut,
+		route,
+		timeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.CollectFee(sdkCtx, msg.Did, msg.ConnectionId, types.FeeOperationSwap, sdk.NewCoins(fee), timeout); err != nil {
+		return nil, err
+	}
+
+	// The realized output is only known once the packet is acknowledged; it is
+	// recorded on the swap and its DWN activity, and reported in the
+	// swap_settled event
+	return &types.MsgExecuteSwapResponse{Sequence: sequence}, nil
 }
 
-// validateUCANPermission validates UCAN token for a DEX operation
+// validateUCANPermission checks that a UCAN token grants the capability the
+// schema requires for the operation on the connection, and returns the
+// token's audience: the service the operation was submitted for. Messages
+// without a token are not checked. Without a permission validator the token
+// is skipped, unless strict_ucan is set.
 func (ms msgServer) validateUCANPermission(
 	ctx context.Context,
 	ucanToken string,
-	resourceType string,
-	resourceID string,
+	connectionID string,
 	operation types.DEXOperation,
-) error {
+) (string, error) {
+	if ucanToken == "" {
+		return "", nil
+	}
+	capability, ok := types.LookupDEXCapability(operation)
+	if !ok {
+		return "", errorsmod.Wrapf(types.ErrUnauthorized, "no UCAN capability defined for %s", operation)
+	}
+
 	if ms.permissionValidator == nil {
-		// Permission validator not available - skip validation
-		return nil
+		params, err := ms.getParams(sdk.UnwrapSDKContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		if params.StrictUcan {
+			return "", types.ErrNoPermissionValidator
+		}
+		return "", nil
 	}
 
-	return ms.permissionValidator.ValidatePermission(
+	token, err := ms.permissionValidator.ValidatePermission(
 		ctx,
 		ucanToken,
-		resourceType,
-		resourceID,
+		capability.Resource,
+		connectionID,
 		operation,
 	)
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrUnauthorized, err.Error())
+	}
+	return token.Audience, nil
 }
 
-// TODO: ProvideLiquidity - Implement cross-chain liquidity provision via ICA
-// This method should handle adding liquidity to pools on remote chains
-// Required implementation steps:
-// 1. Validate the sender's DID exists and is active using did keeper
-// 2. Verify UCAN token has liquidity provision capabilities (resource: liquidity, action: provide)
-// 3. Retrieve the ICA account for this DID and connection from state
-// 4. Calculate appropriate liquidity amounts based on pool ratios
-// 5. Build liquidity provision message for target chain's AMM protocol
-// 6. Create ICA packet data with the liquidity transaction
-// 7. Send ICA packet through IBC channel and await acknowledgment
-// 8. Store LP token information in DWN for tracking
-// 9. Update user's position records in state
-// Returns: Sequence number and LP token amount on success
 // ProvideLiquidity implements types.MsgServer.
 func (ms msgServer) ProvideLiquidity(
 	ctx context.Context,
 	msg *types.MsgProvideLiquidity,
 ) (*types.MsgProvideLiquidityResponse, error) {
-	// TODO: Implement liquidity provision via ICA
-	// 1. Validate DID and UCAN token
-	// 2. Get ICA account for this DID and connection
-	// 3. Construct liquidity provision message for remote chain
-	// 4. Send ICA packet with liquidity instruction
-	// 5. Track transaction in DWN
-	return &types.MsgProvideLiquidityResponse{}, nil
-}
-
-// TODO: RemoveLiquidity - Implement cross-chain liquidity removal via ICA
-// This method should handle removing liquidity from pools on remote chains
-// Required implementation steps:
-// 1. Validate the sender's DID exists and is active using did keeper
-// 2. Verify UCAN token has liquidity removal capabilities (resource: liquidity, action: remove)
-// 3. Retrieve the ICA account for this DID and connection from state
-// 4. Verify user has sufficient LP tokens to remove
-// 5. Build liquidity removal message for target chain's AMM protocol
-// 6. Create ICA packet data with the removal transaction
-// 7. Send ICA packet through IBC channel and await acknowledgment
-// 8. Update LP token information in DWN after removal
-// 9. Clear user's position records from state if fully withdrawn
-// Returns: Sequence number and withdrawn token amounts on success
+	if _, err := ms.validateUCANPermission(ctx, msg.UcanToken, msg.ConnectionId, types.DEXOpProvideLiquidity); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout, err := ms.ICATimeout(sdkCtx, msg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	poolID, err := strconv.ParseUint(msg.PoolId, 10, 64)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidLiquidityParams, "invalid pool id %q", msg.PoolId)
+	}
+	if len(msg.Assets) != 2 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidLiquidityParams,
+			"expected 2 assets, got %d", len(msg.Assets))
+	}
+
+	// The platform fee is taken from each asset provided
+	feeA, tokenA, err := ms.DeductFee(sdkCtx, types.FeeOperationLiquidity, msg.ConnectionId, msg.Assets[0])
+	if err != nil {
+		return nil, err
+	}
+	feeB, tokenB, err := ms.DeductFee(sdkCtx, types.FeeOperationLiquidity, msg.ConnectionId, msg.Assets[1])
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := ms.Keeper.ProvideLiquidity(
+		sdkCtx,
+		msg.Did,
+		msg.ConnectionId,
+		poolID,
+		tokenA,
+		tokenB,
+		msg.ShareOutAmount,
+		timeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	fees := sdk.NewCoins(feeA, feeB)
+	if err := ms.CollectFee(sdkCtx, msg.Did, msg.ConnectionId, types.FeeOperationLiquidity, fees, timeout); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProvideLiquidityResponse{Sequence: sequence}, nil
+}
+
 // RemoveLiquidity implements types.MsgServer.
 func (ms msgServer) RemoveLiquidity(
 	ctx context.Context,
 	msg *types.MsgRemoveLiquidity,
 ) (*types.MsgRemoveLiquidityResponse, error) {
-	// TODO: Implement liquidity removal via ICA
-	// 1. Validate DID and UCAN token
-	// 2. Get ICA account for this DID and connection
-	// 3. Construct liquidity removal message for remote chain
-	// 4. Send ICA packet with removal instruction
-	// 5. Track transaction in DWN
-	return &types.MsgRemoveLiquidityResponse{}, nil
-}
-
-// TODO: CreateLimitOrder - Implement cross-chain limit order creation via ICA
-// This method should handle placing limit orders on remote chain order books
-// Required implementation steps:
-// 1. Validate the sender's DID exists and is active using did keeper
-// 2. Verify UCAN token has order creation capabilities (resource: order, action: create)
-// 3. Retrieve the ICA account for this DID and connection from state
-// 4. Validate order parameters (price, amount, expiry) against market conditions
-// 5. Build limit order message for target chain's order book protocol
-// 6. Create ICA packet data with the order placement transaction
-// 7. Send ICA packet through IBC channel and await acknowledgment
-// 8. Store order details in local state for tracking
-// 9. Create order record in DWN with unique order ID
-// 10. Set up monitoring for order fills and expiration
-// Returns: Sequence number and unique order ID on success
+	if _, err := ms.validateUCANPermission(ctx, msg.UcanToken, msg.ConnectionId, types.DEXOpRemoveLiquidity); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout, err := ms.ICATimeout(sdkCtx, msg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	poolID, err := strconv.ParseUint(msg.PoolId, 10, 64)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidLiquidityParams, "invalid pool id %q", msg.PoolId)
+	}
+
+	minAmountA, minAmountB := math.ZeroInt(), math.ZeroInt()
+	if len(msg.MinAmounts) > 0 {
+		minAmountA = msg.MinAmounts[0].Amount
+	}
+	if len(msg.MinAmounts) > 1 {
+		minAmountB = msg.MinAmounts[1].Amount
+	}
+
+	sequence, err := ms.Keeper.RemoveLiquidity(
+		sdkCtx,
+		msg.Did,
+		msg.ConnectionId,
+		poolID,
+		msg.Shares,
+		minAmountA,
+		minAmountB,
+		timeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveLiquidityResponse{Sequence: sequence}, nil
+}
+
 // CreateLimitOrder implements types.MsgServer.
 func (ms msgServer) CreateLimitOrder(
 	ctx context.Context,
 	msg *types.MsgCreateLimitOrder,
 ) (*types.MsgCreateLimitOrderResponse, error) {
-	// TODO: Implement limit order creation via ICA
-	// 1. Validate DID and UCAN token
-	// 2. Get ICA account for this DID and connection
-	// 3. Construct limit order message for remote chain
-	// 4. Send ICA packet with order instruction
-	// 5. Track order in DWN
-	return &types.MsgCreateLimitOrderResponse{}, nil
-}
-
-// TODO: CancelOrder - Implement cross-chain order cancellation via ICA
-// This method should handle cancelling existing limit orders on remote chains
-// Required implementation steps:
-// 1. Validate the sender's DID exists and is active using did keeper
-// 2. Verify UCAN token has order cancellation capabilities (resource: order, action: cancel)
-// 3. Retrieve the ICA account for this DID and connection from state
-// 4. Verify the order exists and belongs to the sender
-// 5. Check order status is still open (not filled or already cancelled)
-// 6. Build order cancellation message for target chain's order book protocol
-// 7. Create ICA packet data with the cancellation transaction
-// 8. Send ICA packet through IBC channel and await acknowledgment
-// 9. Update order status in local state to cancelled
-// 10. Update order record in DWN with cancellation details
-// Returns: Sequence number on successful cancellation
+	service, err := ms.validateUCANPermission(ctx, msg.UcanToken, msg.ConnectionId, types.DEXOpLimitOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	// The service is told when the order fills or expires
+	sdkCtx := WithCallbackService(sdk.UnwrapSDKContext(ctx), service)
+	if err := ms.ConsumeRateLimit(sdkCtx, msg.Did); err != nil {
+		return nil, err
+	}
+	if err := ms.ConsumeDailyVolume(sdkCtx, msg.Did, sdk.NewCoin(msg.SellDenom, msg.Amount)); err != nil {
+		return nil, err
+	}
+	if err := ms.Screen(sdkCtx, types.ScreeningRequest{
+		Operation: types.ScreeningOpLimitOrder,
+		DID:       msg.Did,
+		Parties:   ms.screeningParties(sdkCtx, msg.Did, msg.ConnectionId),
+		Amount:    sdk.NewCoins(sdk.NewCoin(msg.SellDenom, msg.Amount)),
+	}); err != nil {
+		return nil, err
+	}
+	timeout, err := ms.ICATimeout(sdkCtx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	// The platform fee is taken from the amount to sell
+	fee, tokenIn, err := ms.DeductFee(sdkCtx, types.FeeOperationOrder, msg.ConnectionId, sdk.NewCoin(msg.SellDenom, msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := ms.Keeper.CreateLimitOrder(
+		sdkCtx,
+		msg.Did,
+		msg.ConnectionId,
+		tokenIn,
+		msg.BuyDenom,
+		msg.Price,
+		msg.Expiration,
+		timeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.CollectFee(sdkCtx, msg.Did, msg.ConnectionId, types.FeeOperationOrder, sdk.NewCoins(fee), timeout); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateLimitOrderResponse{
+		OrderId:  strconv.FormatUint(order.Id, 10),
+		Sequence: order.Sequence,
+	}, nil
+}
+
 // CancelOrder implements types.MsgServer.
 func (ms msgServer) CancelOrder(
 	ctx context.Context,
 	msg *types.MsgCancelOrder,
 ) (*types.MsgCancelOrderResponse, error) {
-	// TODO: Implement order cancellation via ICA
-	// 1. Validate DID and UCAN token
-	// 2. Get ICA account for this DID and connection
-	// 3. Construct order cancellation message for remote chain
-	// 4. Send ICA packet with cancellation instruction
-	// 5. Update order status in DWN
-	return &types.MsgCancelOrderResponse{}, nil
+	if _, err := ms.validateUCANPermission(ctx, msg.UcanToken, msg.ConnectionId, types.DEXOpCancelOrder); err != nil {
+		return nil, err
+	}
+
+	orderID, err := strconv.ParseUint(msg.OrderId, 10, 64)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrOrderNotFound, "invalid order id %q", msg.OrderId)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout, err := ms.ICATimeout(sdkCtx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := ms.Keeper.CancelOrder(sdkCtx, msg.Did, msg.ConnectionId, orderID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOrderResponse{Sequence: sequence}, nil
+}
+
+// UpdateBatchParams implements types.MsgServer.
+func (ms msgServer) UpdateBatchParams(
+	ctx context.Context,
+	msg *types.MsgUpdateBatchParams,
+) (*types.MsgUpdateBatchParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			ms.authority,
+			msg.Authority,
+		)
+	}
+
+	if err := ms.SetBatchParams(sdk.UnwrapSDKContext(ctx), msg.Params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgUpdateBatchParamsResponse{}, nil
+}
+
+// UpdatePriceHistoryParams implements types.MsgServer.
+func (ms msgServer) UpdatePriceHistoryParams(
+	ctx context.Context,
+	msg *types.MsgUpdatePriceHistoryParams,
+) (*types.MsgUpdatePriceHistoryParamsResponse, error) {
+	if err := ms.SetPriceHistoryParams(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdatePriceHistoryParamsResponse{}, nil
+}
+
+// SetDenomFilter implements types.MsgServer.
+func (ms msgServer) SetDenomFilter(
+	ctx context.Context,
+	msg *types.MsgSetDenomFilter,
+) (*types.MsgSetDenomFilterResponse, error) {
+	if err := ms.Keeper.SetDenomFilter(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.Filter); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetDenomFilterResponse{}, nil
+}
+
+// RemoveDenomFilter implements types.MsgServer.
+func (ms msgServer) RemoveDenomFilter(
+	ctx context.Context,
+	msg *types.MsgRemoveDenomFilter,
+) (*types.MsgRemoveDenomFilterResponse, error) {
+	if err := ms.Keeper.RemoveDenomFilter(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.ConnectionId); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveDenomFilterResponse{}, nil
+}
+
+// CreateOTCOffer implements types.MsgServer.
+func (ms msgServer) CreateOTCOffer(
+	ctx context.Context,
+	msg *types.MsgCreateOTCOffer,
+) (*types.MsgCreateOTCOfferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	offer, err := ms.Keeper.CreateOTCOffer(
+		sdk.UnwrapSDKContext(ctx),
+		sender,
+		msg.MakerDid,
+		msg.TakerDid,
+		msg.Offer,
+		msg.Ask,
+		msg.ArbiterDid,
+		msg.Expiration,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateOTCOfferResponse{OfferId: offer.Id}, nil
+}
+
+// AcceptOTCOffer implements types.MsgServer.
+func (ms msgServer) AcceptOTCOffer(
+	ctx context.Context,
+	msg *types.MsgAcceptOTCOffer,
+) (*types.MsgAcceptOTCOfferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := ms.Keeper.AcceptOTCOffer(sdk.UnwrapSDKContext(ctx), sender, msg.TakerDid, msg.OfferId); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptOTCOfferResponse{}, nil
+}
+
+// ConfirmOTCOffer implements types.MsgServer.
+func (ms msgServer) ConfirmOTCOffer(
+	ctx context.Context,
+	msg *types.MsgConfirmOTCOffer,
+) (*types.MsgConfirmOTCOfferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	completed, err := ms.Keeper.ConfirmOTCOffer(sdk.UnwrapSDKContext(ctx), sender, msg.Did, msg.OfferId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgConfirmOTCOfferResponse{Completed: completed}, nil
+}
+
+// CancelOTCOffer implements types.MsgServer.
+func (ms msgServer) CancelOTCOffer(
+	ctx context.Context,
+	msg *types.MsgCancelOTCOffer,
+) (*types.MsgCancelOTCOfferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := ms.Keeper.CancelOTCOffer(sdk.UnwrapSDKContext(ctx), sender, msg.Did, msg.OfferId); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelOTCOfferResponse{}, nil
+}
+
+// ResolveOTCOffer implements types.MsgServer.
+func (ms msgServer) ResolveOTCOffer(
+	ctx context.Context,
+	msg *types.MsgResolveOTCOffer,
+) (*types.MsgResolveOTCOfferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := ms.Keeper.ResolveOTCOffer(sdk.UnwrapSDKContext(ctx), sender, msg.ArbiterDid, msg.OfferId, msg.Release); err != nil {
+		return nil, err
+	}
+	return &types.MsgResolveOTCOfferResponse{}, nil
+}
+
+// RefreshPoolReserves implements types.MsgServer.
+func (ms msgServer) RefreshPoolReserves(
+	ctx context.Context,
+	msg *types.MsgRefreshPoolReserves,
+) (*types.MsgRefreshPoolReservesResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	sequence, err := ms.Keeper.RefreshPoolReserves(sdk.UnwrapSDKContext(ctx), sender, msg.Did, msg.ConnectionId, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRefreshPoolReservesResponse{Sequence: sequence}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (ms msgServer) UpdateParams(
+	ctx context.Context,
+	msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if err := ms.Keeper.UpdateParams(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddAllowedConnection implements types.MsgServer.
+func (ms msgServer) AddAllowedConnection(
+	ctx context.Context,
+	msg *types.MsgAddAllowedConnection,
+) (*types.MsgAddAllowedConnectionResponse, error) {
+	if err := ms.Keeper.AddAllowedConnection(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.ConnectionId); err != nil {
+		return nil, err
+	}
+	return &types.MsgAddAllowedConnectionResponse{}, nil
+}
+
+// RemoveAllowedConnection implements types.MsgServer.
+func (ms msgServer) RemoveAllowedConnection(
+	ctx context.Context,
+	msg *types.MsgRemoveAllowedConnection,
+) (*types.MsgRemoveAllowedConnectionResponse, error) {
+	if err := ms.Keeper.RemoveAllowedConnection(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.ConnectionId); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveAllowedConnectionResponse{}, nil
+}
+
+// RegisterProtocolAccount implements types.MsgServer.
+func (ms msgServer) RegisterProtocolAccount(
+	ctx context.Context,
+	msg *types.MsgRegisterProtocolAccount,
+) (*types.MsgRegisterProtocolAccountResponse, error) {
+	account, err := ms.Keeper.RegisterProtocolAccount(sdk.UnwrapSDKContext(ctx), msg.Authority, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterProtocolAccountResponse{PortId: account.PortId}, nil
+}
+
+// FundProtocolAccount implements types.MsgServer.
+func (ms msgServer) FundProtocolAccount(
+	ctx context.Context,
+	msg *types.MsgFundProtocolAccount,
+) (*types.MsgFundProtocolAccountResponse, error) {
+	sequences, err := ms.Keeper.FundProtocolAccount(
+		sdk.UnwrapSDKContext(ctx),
+		msg.Authority,
+		msg.ConnectionId,
+		msg.ChannelId,
+		msg.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgFundProtocolAccountResponse{Sequences: sequences}, nil
+}
+
+// GovProvideLiquidity implements types.MsgServer.
+func (ms msgServer) GovProvideLiquidity(
+	ctx context.Context,
+	msg *types.MsgGovProvideLiquidity,
+) (*types.MsgGovProvideLiquidityResponse, error) {
+	sequence, err := ms.Keeper.GovProvideLiquidity(
+		sdk.UnwrapSDKContext(ctx),
+		msg.Authority,
+		msg.ConnectionId,
+		msg.PoolId,
+		msg.Assets,
+		msg.ShareOutAmount,
+		time.Duration(msg.LockSeconds)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgGovProvideLiquidityResponse{Sequence: sequence}, nil
+}
+
+// GovRemoveLiquidity implements types.MsgServer.
+func (ms msgServer) GovRemoveLiquidity(
+	ctx context.Context,
+	msg *types.MsgGovRemoveLiquidity,
+) (*types.MsgGovRemoveLiquidityResponse, error) {
+	sequence, err := ms.Keeper.GovRemoveLiquidity(
+		sdk.UnwrapSDKContext(ctx),
+		msg.Authority,
+		msg.ConnectionId,
+		msg.PoolId,
+		msg.Shares,
+		msg.MinAmounts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgGovRemoveLiquidityResponse{Sequence: sequence}, nil
+}
+
+// CreateTWAMMOrder implements types.MsgServer. The order is screened once for
+// its whole amount; rate limits, daily volume and fees apply to each slice as
+// it executes.
+func (ms msgServer) CreateTWAMMOrder(
+	ctx context.Context,
+	msg *types.MsgCreateTWAMMOrder,
+) (*types.MsgCreateTWAMMOrderResponse, error) {
+	service, err := ms.validateUCANPermission(ctx, msg.UcanToken, msg.ConnectionId, types.DEXOpExecuteSwap)
+	if err != nil {
+		return nil, err
+	}
+
+	// The service is told as each slice settles
+	sdkCtx := WithCallbackService(sdk.UnwrapSDKContext(ctx), service)
+	if err := ms.Screen(sdkCtx, types.ScreeningRequest{
+		Operation: types.ScreeningOpSwap,
+		DID:       msg.Did,
+		Parties:   ms.screeningParties(sdkCtx, msg.Did, msg.ConnectionId),
+		Amount:    sdk.NewCoins(msg.TokenIn),
+	}); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.Keeper.CreateTWAMMOrder(
+		sdkCtx,
+		msg.Did,
+		msg.ConnectionId,
+		msg.PoolId,
+		msg.TokenIn,
+		msg.TokenOutDenom,
+		msg.MinAmountOut,
+		msg.TotalSlices,
+		msg.IntervalBlocks,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTWAMMOrderResponse{OrderId: id}, nil
+}
+
+// CancelTWAMMOrder implements types.MsgServer.
+func (ms msgServer) CancelTWAMMOrder(
+	ctx context.Context,
+	msg *types.MsgCancelTWAMMOrder,
+) (*types.MsgCancelTWAMMOrderResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	order, err := ms.GetTWAMMOrder(sdkCtx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ms.validateUCANPermission(ctx, msg.UcanToken, order.ConnectionId, types.DEXOpCancelOrder); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.CancelTWAMMOrder(sdkCtx, msg.Did, msg.OrderId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTWAMMOrderResponse{}, nil
 }
@@ -78,12 +78,10 @@ func (suite *MsgServerTestSuite) TestMsgExecuteSwap() {
 		Route:        "pool:1",
 	}
 
-	// Execute swap
-	resp, err := msgServer.ExecuteSwap(ctx, msg)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(resp)
-	// TODO: Check sequence when ExecuteSwap is implemented
-	// suite.Require().NotZero(resp.Sequence)
+	// The account is still pending its ICA handshake, so the swap is refused
+	_, err = msgServer.ExecuteSwap(ctx, msg)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "not active")
 }
 
 // TestMsgProvideLiquidity tests the ProvideLiquidity message handler
@@ -113,12 +111,10 @@ func (suite *MsgServerTestSuite) TestMsgProvideLiquidity() {
 		Timeout:   time.Now().Add(5 * time.Minute),
 	}
 
-	// Execute liquidity provision
-	resp, err := msgServer.ProvideLiquidity(ctx, msg)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(resp)
-	// TODO: Check sequence when ProvideLiquidity is implemented
-	// suite.Require().NotZero(resp.Sequence)
+	// The account is still pending its ICA handshake, so the provision is refused
+	_, err = msgServer.ProvideLiquidity(ctx, msg)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "not active")
 }
 
 // TestMsgRemoveLiquidity tests the RemoveLiquidity message handler
@@ -148,12 +144,10 @@ func (suite *MsgServerTestSuite) TestMsgRemoveLiquidity() {
 		Timeout: time.Now().Add(5 * time.Minute),
 	}
 
-	// Execute liquidity removal
-	resp, err := msgServer.RemoveLiquidity(ctx, msg)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(resp)
-	// TODO: Check sequence when RemoveLiquidity is implemented
-	// suite.Require().NotZero(resp.Sequence)
+	// The account is still pending its ICA handshake, so the removal is refused
+	_, err = msgServer.RemoveLiquidity(ctx, msg)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "not active")
 }
 
 // TestMsgCreateLimitOrder tests the CreateLimitOrder message handler
@@ -181,13 +175,9 @@ func (suite *MsgServerTestSuite) TestMsgCreateLimitOrder() {
 		Expiration:   time.Now().Add(24 * time.Hour),
 	}
 
-	// Execute order creation
-	resp, err := msgServer.CreateLimitOrder(ctx, msg)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(resp)
-	// TODO: Check sequence and OrderId when CreateLimitOrder is implemented
-	// suite.Require().NotZero(resp.Sequence)
-	// suite.Require().NotEmpty(resp.OrderId)
+	// The account is still pending its ICA handshake, so the order is refused
+	_, err = msgServer.CreateLimitOrder(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrAccountNotActive)
 }
 
 // TestMsgCancelOrder tests the CancelOrder message handler
@@ -195,7 +185,7 @@ func (suite *MsgServerTestSuite) TestMsgCancelOrder() {
 	msgServer := keeper.NewMsgServerImpl(suite.f.k)
 	ctx := sdk.WrapSDKContext(suite.f.ctx)
 
-	// First register an account and create an order
+	// First register an account
 	_, err := suite.f.k.RegisterDEXAccount(
 		suite.f.ctx,
 		"did:sonr:frank",
@@ -204,22 +194,15 @@ func (suite *MsgServerTestSuite) TestMsgCancelOrder() {
 	)
 	suite.Require().NoError(err)
 
-	// Since CreateLimitOrder is not implemented yet, use a mock order ID
-	mockOrderId := "order-123"
-
-	// Cancel the order
-	cancelMsg := &types.MsgCancelOrder{
-		Did:          "did:sonr:frank",
-		ConnectionId: "connection-0",
-		OrderId:      mockOrderId,
+	// Order IDs are decimal; unknown and malformed IDs are both not found
+	for _, orderID := range []string{"order-123", "42"} {
+		_, err = msgServer.CancelOrder(ctx, &types.MsgCancelOrder{
+			Did:          "did:sonr:frank",
+			ConnectionId: "connection-0",
+			OrderId:      orderID,
+		})
+		suite.Require().ErrorIs(err, types.ErrOrderNotFound)
 	}
-
-	// Execute order cancellation
-	resp, err := msgServer.CancelOrder(ctx, cancelMsg)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(resp)
-	// TODO: Check sequence when CancelOrder is implemented
-	// suite.Require().NotZero(resp.Sequence)
 }
 
 // TestMsgRegisterDEXAccount_InvalidDID tests registration with invalid DID
@@ -255,11 +238,9 @@ func (suite *MsgServerTestSuite) TestMsgExecuteSwap_AccountNotFound() {
 		Route:        "pool:1",
 	}
 
-	// TODO: Should fail when ExecuteSwap is implemented - account not found
 	_, err := msgServer.ExecuteSwap(ctx, msg)
-	suite.Require().NoError(err) // Currently returns empty response
-	// suite.Require().Error(err)
-	// suite.Require().Contains(err.Error(), "not found")
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "not found")
 }
 
 // TestMsgProvideLiquidity_InvalidAssets tests liquidity with invalid assets
@@ -314,3 +295,44 @@ func (suite *MsgServerTestSuite) TestMsgCreateLimitOrder_InvalidPrice() {
 	err = msg.ValidateBasic()
 	suite.Require().Error(err)
 }
+
+// TestDeadlinesUseBlockTime tests that timeouts and expirations are resolved
+// against block time rather than the local clock
+func (suite *MsgServerTestSuite) TestDeadlinesUseBlockTime() {
+	msgServer := keeper.NewMsgServerImpl(suite.f.k)
+	blockTime := suite.f.ctx.BlockTime()
+
+	// A zero deadline falls back to the default, a far deadline is capped.
+	d, err := suite.f.k.ICATimeout(suite.f.ctx, time.Time{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultICATimeout, d)
+
+	d, err = suite.f.k.ICATimeout(suite.f.ctx, blockTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MaxICATimeout, d)
+
+	// A deadline that is still ahead on the wall clock but behind the block
+	// is rejected.
+	ctx := suite.f.ctx.WithBlockTime(blockTime.Add(10 * time.Minute))
+	_, err = msgServer.ExecuteSwap(ctx, &types.MsgExecuteSwap{
+		Did:          "did:sonr:alice",
+		ConnectionId: "connection-0",
+		SourceDenom:  "usnr",
+		TargetDenom:  "uosmo",
+		Amount:       math.NewInt(1000),
+		MinAmountOut: math.NewInt(900),
+		Timeout:      blockTime.Add(5 * time.Minute),
+	})
+	suite.Require().ErrorIs(err, types.ErrDeadlineElapsed)
+
+	_, err = msgServer.CreateLimitOrder(ctx, &types.MsgCreateLimitOrder{
+		Did:          "did:sonr:alice",
+		ConnectionId: "connection-0",
+		SellDenom:    "usnr",
+		BuyDenom:     "uosmo",
+		Amount:       math.NewInt(1000),
+		Price:        math.LegacyNewDec(1),
+		Expiration:   blockTime,
+	})
+	suite.Require().ErrorIs(err, types.ErrDeadlineElapsed)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+var _ types.OracleKeeper = ReservesOracle{}
+
+// ReservesOracle prices denoms in USD from the pool reserves read over ICA,
+// for chains without an oracle module. A denom is priced against the
+// pricing usd_denom in the fresh pool holding both with the deepest USD
+// side.
+type ReservesOracle struct {
+	k Keeper
+}
+
+// NewReservesOracle creates an oracle reading the reserves kept by k
+func NewReservesOracle(k Keeper) ReservesOracle {
+	return ReservesOracle{k: k}
+}
+
+// GetAssetPrice implements types.OracleKeeper
+func (o ReservesOracle) GetAssetPrice(ctx context.Context, denom string) (math.LegacyDec, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pricing := o.k.GetPricingParams(sdkCtx)
+	if pricing.UsdDenom == "" {
+		return math.LegacyDec{}, fmt.Errorf("no usd_denom configured")
+	}
+
+	// One base unit of the USD stablecoin is worth 10^-exponent dollars
+	unit := math.LegacyOneDec().Quo(math.LegacyNewDec(10).Power(uint64(pricing.UsdExponent)))
+	if denom == pricing.UsdDenom {
+		return unit, nil
+	}
+
+	var (
+		deepest  math.Int
+		price    math.LegacyDec
+		now, ttl = sdkCtx.BlockTime(), pricing.ReservesTTL()
+	)
+	err := o.k.PoolReserves.Walk(sdkCtx, nil, func(_ collections.Pair[string, uint64], pool types.PoolReserves) (bool, error) {
+		if !pool.Fresh(now, ttl) {
+			return false, nil
+		}
+		usd := pool.Reserves.AmountOf(pricing.UsdDenom)
+		reserve := pool.Reserves.AmountOf(denom)
+		if !usd.IsPositive() || !reserve.IsPositive() {
+			return false, nil
+		}
+		if deepest.IsNil() || usd.GT(deepest) {
+			deepest = usd
+			price = math.LegacyNewDecFromInt(usd).QuoInt(reserve).Mul(unit)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if deepest.IsNil() {
+		return math.LegacyDec{}, fmt.Errorf("no fresh pool pairs %s with %s", denom, pricing.UsdDenom)
+	}
+	return price, nil
+}
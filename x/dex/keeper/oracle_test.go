@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// setupReservesOracle prices the routing pools against uusdc
+func setupReservesOracle(t *testing.T) *testFixture {
+	t.Helper()
+	f := setupRouting(t)
+
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.Pricing = types.PricingParams{UsdDenom: "uusdc", UsdExponent: 6}
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	f.k.SetOracleKeeper(keeper.NewReservesOracle(f.k))
+	return f
+}
+
+func TestReservesOraclePrices(t *testing.T) {
+	f := setupReservesOracle(t)
+	oracle := keeper.NewReservesOracle(f.k)
+
+	price, err := oracle.GetAssetPrice(f.ctx, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.000001"), price)
+
+	// 10 uusdc per uatom in the deep pool
+	price, err = oracle.GetAssetPrice(f.ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.00001"), price)
+
+	_, err = oracle.GetAssetPrice(f.ctx, "uosmo")
+	require.ErrorContains(t, err, "no fresh pool")
+
+	// Stale reserves price nothing
+	_, err = oracle.GetAssetPrice(f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Hour)), "uatom")
+	require.Error(t, err)
+}
+
+func TestRecordOraclePrices(t *testing.T) {
+	f := setupReservesOracle(t)
+
+	// Off the sample interval nothing is recorded
+	require.NoError(t, f.k.RecordOraclePrices(f.ctx.WithBlockHeight(11)))
+	res, err := f.queryServer.PriceHistory(f.ctx, &types.QueryPriceHistoryRequest{Denom: "uatom"})
+	require.NoError(t, err)
+	require.Empty(t, res.Prices)
+
+	require.NoError(t, f.k.RecordOraclePrices(f.ctx.WithBlockHeight(20)))
+	for _, denom := range []string{"uatom", "ujuno", "uusdc"} {
+		res, err = f.queryServer.PriceHistory(f.ctx, &types.QueryPriceHistoryRequest{Denom: denom})
+		require.NoError(t, err)
+		require.Len(t, res.Prices, 1, denom)
+		require.Equal(t, uint64(1), res.Prices[0].Samples)
+	}
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.000001").String(), res.Prices[0].Average)
+
+	epoch := types.DefaultPriceHistoryParams().EpochOf(f.ctx.BlockTime())
+	require.Equal(t, int64(epoch*types.DefaultPriceEpochSeconds), res.Prices[0].StartTime)
+
+	_, err = f.queryServer.PriceHistory(f.ctx, &types.QueryPriceHistoryRequest{Denom: "uatom", ResolutionSeconds: 90})
+	require.Error(t, err)
+}
+
+func TestUpdatePriceHistoryParams(t *testing.T) {
+	f := SetupTest(t)
+	params := types.PriceHistoryParams{EpochSeconds: 300, RetentionEpochs: 10}
+
+	_, err := f.msgServer.UpdatePriceHistoryParams(f.ctx, &types.MsgUpdatePriceHistoryParams{
+		Authority: "cosmos1notgov",
+		Params:    params,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = f.msgServer.UpdatePriceHistoryParams(f.ctx, &types.MsgUpdatePriceHistoryParams{
+		Authority: f.govModAddr,
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, f.k.GetPriceHistoryParams(f.ctx))
+}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// orderMonitorScanFactor bounds how many orders a check visits relative to
+// how many it may query, so closed orders cannot make a check unbounded
+const orderMonitorScanFactor = 4
+
+// MonitorLimitOrders asks the order books of the host chains for the status
+// of open limit orders. It runs every interval_blocks blocks and visits
+// orders round robin from where the previous check stopped, querying at most
+// max_orders_per_check of them. Orders whose account still has a queued
+// batch, with a cancellation or status query in flight, or on a connection
+// without an order book are skipped. A failing query is logged and retried on a later
+// check; answers are handled by OnLimitOrderStatusResult.
+func (k Keeper) MonitorLimitOrders(ctx sdk.Context) error {
+	params, err := k.getParams(ctx)
+	if err != nil {
+		return err
+	}
+	monitor := params.OrderMonitor
+	if !monitor.Enabled() || ctx.BlockHeight()%int64(monitor.IntervalBlocks) != 0 {
+		return nil
+	}
+
+	cursor, err := k.OrderMonitorCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	iter, err := k.LimitOrders.Iterate(ctx, new(collections.Range[uint64]).StartExclusive(cursor))
+	if err != nil {
+		return err
+	}
+
+	// Collect first; the store must not be written while it is iterated
+	limit := monitor.MaxOrdersPerCheckOrDefault()
+	var (
+		due     []types.LimitOrder
+		scanned int
+		last    uint64
+	)
+	for ; iter.Valid() && len(due) < limit && scanned < limit*orderMonitorScanFactor; iter.Next() {
+		order, err := iter.Value()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		scanned++
+		last = order.Id
+		if order.IsCancellable() && order.StatusSequence == 0 {
+			due = append(due, order)
+		}
+	}
+	// Start over from the first order once the end is reached
+	if !iter.Valid() {
+		last = 0
+	}
+	iter.Close()
+
+	for _, order := range due {
+		contract, ok := monitor.OrderBook(order.ConnectionId)
+		if !ok {
+			continue
+		}
+		// The placement may still be waiting in the account's batch
+		queued, err := k.PendingBatches.Has(ctx, GetAccountKey(order.Did, order.ConnectionId))
+		if err != nil {
+			return err
+		}
+		if queued {
+			continue
+		}
+		// Send in a cached context so a failed query leaves no partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.sendLimitOrderStatusQuery(cacheCtx, order, contract); err != nil {
+			k.Logger(ctx).Error("failed to query order status", "order_id", order.Id, "error", err)
+			continue
+		}
+		write()
+	}
+
+	return k.OrderMonitorCursor.Set(ctx, last)
+}
+
+// OnLimitOrderStatusResult applies the order status answered by a status
+// query. A growing filled amount is recorded and announced; an order the
+// book reports as filled or cancelled is closed locally. A nil ack means the
+// packet timed out. Failed or unreadable answers leave the order as it was
+// so the next check asks again.
+func (k Keeper) OnLimitOrderStatusResult(ctx sdk.Context, portID string, sequence uint64, ack *channeltypes.Acknowledgement) error {
+	key := collections.Join(portID, sequence)
+	orderID, err := k.PendingOrderQueries.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.PendingOrderQueries.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	order, err := k.GetLimitOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	order.StatusSequence = 0
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if ack == nil || !ack.Success() {
+		k.Logger(ctx).Info("order status query failed", "order_id", orderID, "sequence", sequence)
+		return nil
+	}
+	remote, err := types.LimitOrderStatusFromAck(ack.GetResult())
+	if err != nil {
+		k.Logger(ctx).Error("failed to read order status from acknowledgement", "order_id", orderID, "error", err)
+		return nil
+	}
+
+	order.CheckedHeight = ctx.BlockHeight()
+	// The order may have been closed while the query was in flight
+	if !order.IsOpen() {
+		return k.setLimitOrder(ctx, order)
+	}
+
+	if filled := order.Filled(); remote.FilledAmount.GT(filled) {
+		order.FilledAmount = remote.FilledAmount.String()
+		order.UpdatedHeight = ctx.BlockHeight()
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOrderFillUpdated,
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", orderID)),
+				sdk.NewAttribute("did", order.Did),
+				sdk.NewAttribute("connection", order.ConnectionId),
+				sdk.NewAttribute("filled_amount", order.FilledAmount),
+				sdk.NewAttribute("fill_delta", remote.FilledAmount.Sub(filled).String()),
+			),
+		)
+	}
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return err
+	}
+
+	switch remote.Status {
+	case types.LimitOrderStatusFilled:
+		if err := k.FillLimitOrder(ctx, orderID); err != nil {
+			return err
+		}
+	case types.LimitOrderStatusCancelled:
+		if order, err = k.closeLimitOrder(ctx, orderID, types.LimitOrderStatusCancelled); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOrderCancelled,
+				sdk.NewAttribute("did", order.Did),
+				sdk.NewAttribute("connection", order.ConnectionId),
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", orderID)),
+				sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+			),
+		)
+	default:
+		return nil
+	}
+
+	order, err = k.GetLimitOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	return k.StoreOrderRecordInDWN(ctx, order.Did, order.ConnectionId, fmt.Sprintf("%d", orderID), map[string]any{
+		"status":          order.Status.String(),
+		"token_in":        order.TokenIn.String(),
+		"token_out_denom": order.TokenOutDenom,
+		"price":           order.Price,
+		"filled_amount":   order.Filled().String(),
+	})
+}
+
+// sendLimitOrderStatusQuery sends a module query for the status of an order
+// to the order book contract of its connection
+func (k Keeper) sendLimitOrderStatusQuery(ctx sdk.Context, order types.LimitOrder, contract string) error {
+	account, err := k.GetDEXAccount(ctx, order.Did, order.ConnectionId)
+	if err != nil {
+		return fmt.Errorf("DEX account not found: %w", err)
+	}
+
+	// Queries bypass batching so the acknowledgement answers only this query
+	sequence, err := k.SendDEXTransaction(
+		ctx,
+		order.Did,
+		order.ConnectionId,
+		[]sdk.Msg{types.NewLimitOrderStatusQuery(account.AccountAddress, contract, order.Id)},
+		fmt.Sprintf("order_status_%d", order.Id),
+		k.DefaultICATimeout(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send order status query: %w", err)
+	}
+
+	if err := k.PendingOrderQueries.Set(ctx, collections.Join(account.PortId, sequence), order.Id); err != nil {
+		return err
+	}
+	order.StatusSequence = sequence
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOrderStatusRequested,
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute("did", order.Did),
+			sdk.NewAttribute("connection", order.ConnectionId),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// setupOrderMonitor gives alice a placed limit order on a connection whose
+// order book is monitored every block
+func setupOrderMonitor(t *testing.T) (*testFixture, types.InterchainDEXAccount, types.LimitOrder) {
+	t.Helper()
+	f, account := setupReserves(t)
+
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.OrderMonitor = types.OrderMonitorParams{
+		IntervalBlocks: 1,
+		OrderBooks:     []types.OrderBook{{ConnectionId: testConnectionID, Contract: "osmo1orderbook"}},
+	}
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	order, err := f.k.CreateLimitOrder(
+		f.ctx,
+		"did:sonr:alice",
+		testConnectionID,
+		sdk.NewInt64Coin("uatom", 1000),
+		"uosmo",
+		math.LegacyMustNewDecFromStr("1.5"),
+		time.Time{},
+		time.Minute,
+	)
+	require.NoError(t, err)
+	return f, account, order
+}
+
+// orderStatusAck builds the acknowledgement of an order status query
+// answered by the order book contract
+func orderStatusAck(t *testing.T, status, filledAmount string) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]string{"status": status, "filled_amount": filledAmount})
+	require.NoError(t, err)
+	value := protowire.AppendTag(nil, 1, protowire.VarintType)
+	value = protowire.AppendVarint(value, 42)
+	value = protowire.AppendTag(value, 2, protowire.BytesType)
+	value = protowire.AppendBytes(value, protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), data))
+
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{{
+		TypeUrl: "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse",
+		Value:   value,
+	}}})
+	require.NoError(t, err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+	bz, err := proto.Marshal(&ack)
+	require.NoError(t, err)
+	return bz
+}
+
+// checkOrder runs a monitor check and returns the status query packet sent
+// for the order
+func checkOrder(t *testing.T, f *testFixture, account types.InterchainDEXAccount, id uint64) channeltypes.Packet {
+	t.Helper()
+	require.NoError(t, f.k.MonitorLimitOrders(f.ctx))
+	order, err := f.k.GetLimitOrder(f.ctx, id)
+	require.NoError(t, err)
+	require.NotZero(t, order.StatusSequence)
+	return channeltypes.Packet{Sequence: order.StatusSequence, SourcePort: account.PortId, SourceChannel: "channel-0"}
+}
+
+func TestMonitorLimitOrderFills(t *testing.T) {
+	f, account, order := setupOrderMonitor(t)
+
+	// The placement is still queued, so the order is not asked about yet
+	require.NoError(t, f.k.MonitorLimitOrders(f.ctx))
+	stored, err := f.k.GetLimitOrder(f.ctx, order.Id)
+	require.NoError(t, err)
+	require.Zero(t, stored.StatusSequence)
+	require.NoError(t, f.k.FlushPendingBatches(f.ctx, true))
+
+	packet := checkOrder(t, f, account, order.Id)
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, orderStatusAck(t, "open", "400"), nil))
+
+	stored, err = f.k.GetLimitOrder(f.ctx, order.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.LimitOrderStatusOpen, stored.Status)
+	require.Equal(t, "400", stored.FilledAmount)
+	require.Zero(t, stored.StatusSequence)
+
+	var fill sdk.Event
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeOrderFillUpdated {
+			fill = event
+		}
+	}
+	delta, ok := fill.GetAttribute("fill_delta")
+	require.True(t, ok)
+	require.Equal(t, "400", delta.Value)
+
+	f.ctx = f.ctx.WithBlockHeight(f.ctx.BlockHeight() + 1)
+	packet = checkOrder(t, f, account, order.Id)
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, orderStatusAck(t, "filled", "1500"), nil))
+
+	stored, err = f.k.GetLimitOrder(f.ctx, order.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.LimitOrderStatusFilled, stored.Status)
+	require.Equal(t, "1500", stored.FilledAmount)
+
+	// Closed orders are not asked about again
+	require.NoError(t, f.k.MonitorLimitOrders(f.ctx))
+	stored, err = f.k.GetLimitOrder(f.ctx, order.Id)
+	require.NoError(t, err)
+	require.Zero(t, stored.StatusSequence)
+}
+
+func TestMonitorLimitOrderTimeout(t *testing.T) {
+	f, account, order := setupOrderMonitor(t)
+	require.NoError(t, f.k.FlushPendingBatches(f.ctx, true))
+
+	require.NoError(t, f.k.OnTimeoutPacket(f.ctx, checkOrder(t, f, account, order.Id), nil))
+	stored, err := f.k.GetLimitOrder(f.ctx, order.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.LimitOrderStatusOpen, stored.Status)
+	require.Zero(t, stored.StatusSequence)
+
+	// The order book reports a cancellation made on the host chain
+	packet := checkOrder(t, f, account, order.Id)
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, orderStatusAck(t, "cancelled", ""), nil))
+	stored, err = f.k.GetLimitOrder(f.ctx, order.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.LimitOrderStatusCancelled, stored.Status)
+}
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -11,7 +14,9 @@ import (
 	"github.com/sonr-io/sonr/x/dex/types"
 )
 
-// CreateLimitOrder creates a limit order through ICA
+// CreateLimitOrder places a limit order on the remote order book through the
+// DID's interchain account and records it as open. A zero expiration keeps
+// the order open until it is cancelled.
 func (k Keeper) CreateLimitOrder(
 	ctx sdk.Context,
 	did string,
@@ -19,111 +24,229 @@ func (k Keeper) CreateLimitOrder(
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	price math.LegacyDec,
-	orderType OrderType,
-) (uint64, error) {
-	// Get the DEX account
+	expiration time.Time,
+	timeout time.Duration,
+) (types.LimitOrder, error) {
+	if err := k.ValidateOrderParameters(tokenIn, tokenOutDenom, price, OrderTypeLimit); err != nil {
+		return types.LimitOrder{}, errorsmod.Wrap(types.ErrInvalidOrderParams, err.Error())
+	}
+	if err := k.ValidateOrderExpiration(ctx, expiration); err != nil {
+		return types.LimitOrder{}, err
+	}
+	if err := k.checkDenomsAllowed(ctx, connectionID, tokenIn.Denom, tokenOutDenom); err != nil {
+		return types.LimitOrder{}, err
+	}
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return types.LimitOrder{}, err
+	}
+
 	account, err := k.GetDEXAccount(ctx, did, connectionID)
 	if err != nil {
-		return 0, fmt.Errorf("DEX account not found: %w", err)
+		return types.LimitOrder{}, fmt.Errorf("DEX account not found: %w", err)
 	}
-
-	// Verify account is active
 	if account.Status != types.ACCOUNT_STATUS_ACTIVE {
-		return 0, fmt.Errorf("DEX account is not active")
+		return types.LimitOrder{}, types.ErrAccountNotActive
 	}
 
-	// Create limit order message for remote chain
-	// This is a placeholder - actual implementation would use chain-specific messages
-	orderMsg := &banktypes.MsgSend{
-		FromAddress: account.AccountAddress,
-		ToAddress:   account.AccountAddress, // Placeholder
-		Amount:      sdk.NewCoins(tokenIn),
+	id, err := k.LimitOrderSequence.Next(ctx)
+	if err != nil {
+		return types.LimitOrder{}, err
 	}
 
-	// Send the order transaction via ICA
-	sequence, err := k.SendDEXTransaction(
+	order := types.LimitOrder{
+		Id:            id,
+		Did:           did,
+		ConnectionId:  connectionID,
+		TokenIn:       tokenIn,
+		TokenOutDenom: tokenOutDenom,
+		Price:         price.String(),
+		Expiration:    expirationUnix(expiration),
+		Status:        types.LimitOrderStatusOpen,
+		CreatedHeight: ctx.BlockHeight(),
+		UpdatedHeight: ctx.BlockHeight(),
+	}
+	order.Service, order.TxHash = callbackOrigin(ctx)
+	if err := order.Validate(); err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	// Queued orders get their sequence when the batch is flushed
+	_, sequence, err := k.QueueDEXTransaction(
 		ctx,
 		did,
 		connectionID,
-		[]sdk.Msg{orderMsg},
-		fmt.Sprintf("limit_order_%s_for_%s", tokenIn.Denom, tokenOutDenom),
-		30*time.Second,
+		[]sdk.Msg{k.BuildLimitOrderMsg(account.AccountAddress, order)},
+		fmt.Sprintf("limit_order_%d", id),
+		timeout,
 	)
 	if err != nil {
-		return 0, fmt.Errorf("failed to send order transaction: %w", err)
+		return types.LimitOrder{}, fmt.Errorf("failed to send order transaction: %w", err)
 	}
+	order.Sequence = sequence
 
-	// Store order ID mapping (sequence -> order details)
-	orderID := fmt.Sprintf("%s_%s_%d", did, connectionID, sequence)
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return types.LimitOrder{}, err
+	}
+	if order.Expiration > 0 {
+		if err := k.LimitOrderExpiry.Set(ctx, collections.Join(order.Expiration, id)); err != nil {
+			return types.LimitOrder{}, fmt.Errorf("failed to schedule order expiry: %w", err)
+		}
+	}
 
-	// Emit order created event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeOrderCreated,
 			sdk.NewAttribute("did", did),
 			sdk.NewAttribute("connection", connectionID),
-			sdk.NewAttribute("order_id", orderID),
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", id)),
 			sdk.NewAttribute("token_in", tokenIn.String()),
 			sdk.NewAttribute("token_out", tokenOutDenom),
 			sdk.NewAttribute("price", price.String()),
+			sdk.NewAttribute("expiration", fmt.Sprintf("%d", order.Expiration)),
 			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
 		),
 	)
 
-	return sequence, nil
+	return order, nil
 }
 
-// CancelOrder cancels an existing order through ICA
+// CancelOrder sends the cancellation of an open order to the host chain. The
+// order's queued ICA messages are flushed together with the cancellation so
+// the placement cannot arrive after it. The order stays open until the
+// packet is acknowledged; see OnLimitOrderCancelResult.
 func (k Keeper) CancelOrder(
 	ctx sdk.Context,
 	did string,
 	connectionID string,
-	orderID string,
+	orderID uint64,
+	timeout time.Duration,
 ) (uint64, error) {
-	// Get the DEX account
-	account, err := k.GetDEXAccount(ctx, did, connectionID)
+	order, err := k.GetLimitOrder(ctx, orderID)
 	if err != nil {
-		return 0, fmt.Errorf("DEX account not found: %w", err)
+		return 0, err
 	}
-
-	// Verify account is active
-	if account.Status != types.ACCOUNT_STATUS_ACTIVE {
-		return 0, fmt.Errorf("DEX account is not active")
+	if order.Did != did {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "order %d is not owned by %s", orderID, did)
+	}
+	if order.ConnectionId != connectionID {
+		return 0, errorsmod.Wrapf(types.ErrInvalidConnectionID, "order %d is on %s", orderID, order.ConnectionId)
+	}
+	if !order.IsOpen() {
+		return 0, errorsmod.Wrapf(types.ErrOrderNotOpen, "order %d is %s", orderID, order.Status)
+	}
+	if !order.IsCancellable() {
+		return 0, errorsmod.Wrapf(
+			types.ErrOrderNotOpen, "order %d has a cancellation pending at sequence %d", orderID, order.CancelSequence,
+		)
 	}
 
-	// Create cancel order message for remote chain
-	// This is a placeholder - actual implementation would use chain-specific messages
-	cancelMsg := &banktypes.MsgSend{
-		FromAddress: account.AccountAddress,
-		ToAddress:   account.AccountAddress, // Placeholder
-		Amount:      sdk.NewCoins(),         // Empty amount for cancel
+	account, err := k.GetDEXAccount(ctx, did, connectionID)
+	if err != nil {
+		return 0, fmt.Errorf("DEX account not found: %w", err)
 	}
 
-	// Send the cancel transaction via ICA
-	sequence, err := k.SendDEXTransaction(
+	queued, sequence, err := k.QueueDEXTransaction(
 		ctx,
 		did,
 		connectionID,
-		[]sdk.Msg{cancelMsg},
-		fmt.Sprintf("cancel_order_%s", orderID),
-		30*time.Second,
+		[]sdk.Msg{k.BuildCancelLimitOrderMsg(account.AccountAddress, order)},
+		fmt.Sprintf("cancel_order_%d", orderID),
+		timeout,
 	)
 	if err != nil {
 		return 0, fmt.Errorf("failed to send cancel transaction: %w", err)
 	}
+	if queued {
+		accountKey := GetAccountKey(did, connectionID)
+		batch, err := k.PendingBatches.Get(ctx, accountKey)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load queued cancel batch: %w", err)
+		}
+		if sequence, err = k.flushBatch(ctx, accountKey, batch); err != nil {
+			return 0, fmt.Errorf("failed to send cancel transaction: %w", err)
+		}
+	}
+
+	if err := k.PendingOrderCancels.Set(ctx, collections.Join(account.PortId, sequence), orderID); err != nil {
+		return 0, err
+	}
+	order.CancelSequence = sequence
+	order.UpdatedHeight = ctx.BlockHeight()
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnLimitOrderCancelResult settles the cancellation sent as the given packet.
+// A successful acknowledgement cancels the order locally and in the owner's
+// DWN; an error acknowledgement or timeout leaves it open so it can be
+// cancelled again. Packets that carry no cancellation are ignored.
+func (k Keeper) OnLimitOrderCancelResult(ctx sdk.Context, portID string, sequence uint64, success bool) error {
+	key := collections.Join(portID, sequence)
+	orderID, err := k.PendingOrderCancels.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.PendingOrderCancels.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	order, err := k.GetLimitOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	order.CancelSequence = 0
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if !success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOrderCancelFailed,
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", orderID)),
+				sdk.NewAttribute("did", order.Did),
+				sdk.NewAttribute("connection", order.ConnectionId),
+				sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+			),
+		)
+		return nil
+	}
+
+	// The order may have been filled or expired while the cancellation was in flight
+	if !order.IsOpen() {
+		return nil
+	}
+	if order, err = k.closeLimitOrder(ctx, orderID, types.LimitOrderStatusCancelled); err != nil {
+		return err
+	}
+
+	if err := k.StoreOrderRecordInDWN(ctx, order.Did, order.ConnectionId, fmt.Sprintf("%d", orderID), map[string]any{
+		"status":          order.Status.String(),
+		"token_in":        order.TokenIn.String(),
+		"token_out_denom": order.TokenOutDenom,
+		"price":           order.Price,
+		"cancel_sequence": sequence,
+	}); err != nil {
+		return err
+	}
 
-	// Emit order cancelled event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeOrderCancelled,
-			sdk.NewAttribute("did", did),
-			sdk.NewAttribute("connection", connectionID),
-			sdk.NewAttribute("order_id", orderID),
+			sdk.NewAttribute("did", order.Did),
+			sdk.NewAttribute("connection", order.ConnectionId),
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", orderID)),
 			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
 		),
 	)
 
-	return sequence, nil
+	return nil
 }
 
 // OrderType represents the type of order
@@ -136,68 +259,200 @@ const (
 	OrderTypeTakeProfit
 )
 
-// OrderStatus represents the status of an order
-type OrderStatus int
+// GetLimitOrder returns a limit order by ID
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, error) {
+	order, err := k.LimitOrders.Get(ctx, id)
+	if err != nil {
+		return types.LimitOrder{}, errorsmod.Wrapf(types.ErrOrderNotFound, "order %d", id)
+	}
+	return order, nil
+}
 
-const (
-	OrderStatusPending OrderStatus = iota
-	OrderStatusOpen
-	OrderStatusPartiallyFilled
-	OrderStatusFilled
-	OrderStatusCancelled
-	OrderStatusExpired
-)
+// GetLimitOrdersByDID returns the orders placed by a DID, oldest first
+func (k Keeper) GetLimitOrdersByDID(ctx sdk.Context, did string) ([]types.LimitOrder, error) {
+	iter, err := k.LimitOrdersByDID.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](did))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
 
-// OrderInfo represents order information
-type OrderInfo struct {
-	OrderID         string
-	DID             string
-	ConnectionID    string
-	TokenIn         sdk.Coin
-	TokenOut        string
-	Price           math.LegacyDec
-	Type            OrderType
-	Status          OrderStatus
-	FilledAmount    math.Int
-	RemainingAmount math.Int
-	CreatedAt       int64
-	UpdatedAt       int64
+	orders := make([]types.LimitOrder, 0, len(keys))
+	for _, key := range keys {
+		order, err := k.LimitOrders.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
 }
 
-// GetOrderInfo retrieves order information
-func (k Keeper) GetOrderInfo(
-	ctx sdk.Context,
-	did string,
-	connectionID string,
-	orderID string,
-) (*OrderInfo, error) {
-	// This would retrieve order info from state or remote chain
-	// For now, return placeholder data
-	return &OrderInfo{
-		OrderID:         orderID,
-		DID:             did,
-		ConnectionID:    connectionID,
-		TokenIn:         sdk.NewCoin("uatom", math.NewInt(1000)),
-		TokenOut:        "uosmo",
-		Price:           math.LegacyNewDec(10),
-		Type:            OrderTypeLimit,
-		Status:          OrderStatusOpen,
-		FilledAmount:    math.ZeroInt(),
-		RemainingAmount: math.NewInt(1000),
-		CreatedAt:       ctx.BlockTime().Unix(),
-		UpdatedAt:       ctx.BlockTime().Unix(),
-	}, nil
+// FillLimitOrder marks an open order as filled once the host chain reports
+// that it was matched
+func (k Keeper) FillLimitOrder(ctx sdk.Context, id uint64) error {
+	order, err := k.closeLimitOrder(ctx, id, types.LimitOrderStatusFilled)
+	if err != nil {
+		return err
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute("order_id", fmt.Sprintf("%d", id)),
+		sdk.NewAttribute("did", order.Did),
+		sdk.NewAttribute("connection", order.ConnectionId),
+		sdk.NewAttribute("sequence", fmt.Sprintf("%d", order.Sequence)),
+		sdk.NewAttribute("token_in", order.TokenIn.String()),
+		sdk.NewAttribute("token_out_denom", order.TokenOutDenom),
+	}
+	if order.FilledAmount != "" {
+		attrs = append(attrs, sdk.NewAttribute("filled_amount", order.FilledAmount))
+	}
+	attrs = append(attrs, callbackAttributes(order.Service, order.TxHash)...)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeOrderFilled, attrs...))
+	return nil
 }
 
-// GetOrdersByDID retrieves all orders for a DID
-func (k Keeper) GetOrdersByDID(
+// ExpireLimitOrders expires every open order whose expiration is at or before
+// the current block time and asks the host chain to cancel it. A failing
+// cancellation is logged; the order is expired locally either way.
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockTime().Unix(), ^uint64(0)))
+
+	due, err := k.LimitOrderExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		order, err := k.closeLimitOrder(ctx, key.K2(), types.LimitOrderStatusExpired)
+		if err != nil {
+			// Not open any more; drop the stale index entry
+			if err := k.LimitOrderExpiry.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Send in a cached context so a failed cancel leaves no partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.sendLimitOrderCancel(cacheCtx, order, k.DefaultICATimeout(ctx)); err != nil {
+			k.Logger(ctx).Error("failed to cancel expired order", "order_id", order.Id, "error", err)
+		} else {
+			write()
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute("did", order.Did),
+			sdk.NewAttribute("connection", order.ConnectionId),
+			sdk.NewAttribute("expiration", fmt.Sprintf("%d", order.Expiration)),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", order.Sequence)),
+			sdk.NewAttribute("token_in", order.TokenIn.String()),
+			sdk.NewAttribute("token_out_denom", order.TokenOutDenom),
+		}
+		if order.FilledAmount != "" {
+			attrs = append(attrs, sdk.NewAttribute("filled_amount", order.FilledAmount))
+		}
+		attrs = append(attrs, callbackAttributes(order.Service, order.TxHash)...)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeOrderExpired, attrs...))
+	}
+
+	return nil
+}
+
+// BuildLimitOrderMsg builds the host chain message placing an order
+func (k Keeper) BuildLimitOrderMsg(senderAddress string, order types.LimitOrder) sdk.Msg {
+	// This would build the host chain's order book message
+	// For now, return a placeholder bank send
+	return &banktypes.MsgSend{
+		FromAddress: senderAddress,
+		ToAddress:   senderAddress,
+		Amount:      sdk.NewCoins(order.TokenIn),
+	}
+}
+
+// BuildCancelLimitOrderMsg builds the host chain message cancelling an order
+func (k Keeper) BuildCancelLimitOrderMsg(senderAddress string, order types.LimitOrder) sdk.Msg {
+	// This would build the host chain's order book message
+	// For now, return a placeholder bank send
+	return &banktypes.MsgSend{
+		FromAddress: senderAddress,
+		ToAddress:   senderAddress,
+		Amount:      sdk.NewCoins(),
+	}
+}
+
+// sendLimitOrderCancel sends the cancellation of an order to the host chain
+func (k Keeper) sendLimitOrderCancel(ctx sdk.Context, order types.LimitOrder, timeout time.Duration) error {
+	account, err := k.GetDEXAccount(ctx, order.Did, order.ConnectionId)
+	if err != nil {
+		return err
+	}
+	_, _, err = k.QueueDEXTransaction(
+		ctx,
+		order.Did,
+		order.ConnectionId,
+		[]sdk.Msg{k.BuildCancelLimitOrderMsg(account.AccountAddress, order)},
+		fmt.Sprintf("cancel_order_%d", order.Id),
+		timeout,
+	)
+	return err
+}
+
+// closeLimitOrder moves an open order to a final status and removes it from
+// the expiry index
+func (k Keeper) closeLimitOrder(
 	ctx sdk.Context,
-	did string,
-	status OrderStatus,
-) ([]*OrderInfo, error) {
-	// This would query orders from state or remote chain
-	// For now, return empty list
-	return []*OrderInfo{}, nil
+	id uint64,
+	status types.LimitOrderStatus,
+) (types.LimitOrder, error) {
+	order, err := k.GetLimitOrder(ctx, id)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if !order.IsOpen() {
+		return types.LimitOrder{}, errorsmod.Wrapf(types.ErrOrderNotOpen, "order %d is %s", id, order.Status)
+	}
+
+	if order.Expiration > 0 {
+		if err := k.LimitOrderExpiry.Remove(ctx, collections.Join(order.Expiration, id)); err != nil {
+			return types.LimitOrder{}, err
+		}
+	}
+	order.Status = status
+	order.UpdatedHeight = ctx.BlockHeight()
+	if err := k.setLimitOrder(ctx, order); err != nil {
+		return types.LimitOrder{}, err
+	}
+	return order, nil
+}
+
+// setLimitOrder stores an order and indexes it under its DID
+func (k Keeper) setLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	if err := k.LimitOrders.Set(ctx, order.Id, order); err != nil {
+		return fmt.Errorf("failed to store order: %w", err)
+	}
+	return k.LimitOrdersByDID.Set(ctx, collections.Join(order.Did, order.Id))
+}
+
+// expirationUnix converts an order expiration to unix seconds, rounding up so
+// an order never expires early. The zero time maps to 0 (good-til-cancelled).
+func expirationUnix(expiration time.Time) int64 {
+	if expiration.IsZero() {
+		return 0
+	}
+	secs := expiration.Unix()
+	if expiration.Nanosecond() > 0 {
+		secs++
+	}
+	return secs
 }
 
 // ValidateOrderParameters validates order parameters
@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// LimitOrderTestSuite tests the limit order book state machine
+type LimitOrderTestSuite struct {
+	suite.Suite
+	f       *testFixture
+	account types.InterchainDEXAccount
+}
+
+func TestLimitOrderSuite(t *testing.T) {
+	suite.Run(t, new(LimitOrderTestSuite))
+}
+
+func (suite *LimitOrderTestSuite) SetupTest() {
+	suite.f = SetupTest(suite.T())
+	suite.f.ctx = suite.f.ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	account, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, "did:sonr:alice", testConnectionID, []string{"order"})
+	suite.Require().NoError(err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	suite.Require().NoError(
+		suite.f.k.Accounts.Set(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID), *account),
+	)
+	suite.account = *account
+}
+
+// claimChannel lets the account's queued ICA messages be sent
+func (suite *LimitOrderTestSuite) claimChannel() {
+	_, err := suite.f.k.ScopedKeeper.NewCapability(
+		suite.f.ctx,
+		host.ChannelCapabilityPath(suite.account.PortId, "channel-0"),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *LimitOrderTestSuite) status(id uint64) types.LimitOrderStatus {
+	order, err := suite.f.k.GetLimitOrder(suite.f.ctx, id)
+	suite.Require().NoError(err)
+	return order.Status
+}
+
+func (suite *LimitOrderTestSuite) createOrder(expiration time.Time) types.LimitOrder {
+	order, err := suite.f.k.CreateLimitOrder(
+		suite.f.ctx,
+		"did:sonr:alice",
+		testConnectionID,
+		sdk.NewCoin("usnr", math.NewInt(1000)),
+		"uosmo",
+		math.LegacyMustNewDecFromStr("1.5"),
+		expiration,
+		time.Minute,
+	)
+	suite.Require().NoError(err)
+	return order
+}
+
+func (suite *LimitOrderTestSuite) TestCreateLimitOrder() {
+	first := suite.createOrder(suite.f.ctx.BlockTime().Add(time.Hour))
+	second := suite.createOrder(time.Time{})
+	suite.Require().Equal(first.Id+1, second.Id)
+
+	stored, err := suite.f.k.GetLimitOrder(suite.f.ctx, first.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LimitOrderStatusOpen, stored.Status)
+	suite.Require().Equal("1.500000000000000000", stored.Price)
+	suite.Require().Equal(suite.f.ctx.BlockTime().Add(time.Hour).Unix(), stored.Expiration)
+	suite.Require().Zero(second.Expiration)
+
+	orders, err := suite.f.k.GetLimitOrdersByDID(suite.f.ctx, "did:sonr:alice")
+	suite.Require().NoError(err)
+	suite.Require().Len(orders, 2)
+
+	// The placement is queued for the next ICA batch
+	batch, err := suite.f.k.PendingBatches.Get(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().Len(batch.Msgs, 2)
+
+	_, err = suite.f.k.GetLimitOrder(suite.f.ctx, 42)
+	suite.Require().ErrorIs(err, types.ErrOrderNotFound)
+}
+
+func (suite *LimitOrderTestSuite) TestCreateLimitOrderRejectsElapsedExpiration() {
+	_, err := suite.f.k.CreateLimitOrder(
+		suite.f.ctx,
+		"did:sonr:alice",
+		testConnectionID,
+		sdk.NewCoin("usnr", math.NewInt(1000)),
+		"uosmo",
+		math.LegacyOneDec(),
+		suite.f.ctx.BlockTime(),
+		time.Minute,
+	)
+	suite.Require().ErrorIs(err, types.ErrDeadlineElapsed)
+}
+
+func (suite *LimitOrderTestSuite) TestExpireLimitOrders() {
+	expiring := suite.createOrder(suite.f.ctx.BlockTime().Add(time.Minute))
+	later := suite.createOrder(suite.f.ctx.BlockTime().Add(time.Hour))
+	gtc := suite.createOrder(time.Time{})
+
+	ctx := suite.f.ctx.WithBlockTime(suite.f.ctx.BlockTime().Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.f.k.ExpireLimitOrders(ctx))
+
+	order, err := suite.f.k.GetLimitOrder(ctx, expiring.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LimitOrderStatusExpired, order.Status)
+	suite.Require().Len(ctx.EventManager().Events(), 2, "expired event and the queued cancel")
+
+	for _, id := range []uint64{later.Id, gtc.Id} {
+		order, err := suite.f.k.GetLimitOrder(ctx, id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.LimitOrderStatusOpen, order.Status)
+	}
+
+	// Closed orders cannot change state again
+	suite.Require().ErrorIs(suite.f.k.FillLimitOrder(ctx, expiring.Id), types.ErrOrderNotOpen)
+	suite.Require().NoError(suite.f.k.FillLimitOrder(ctx, later.Id))
+
+	// The filled order left the expiry index, so it is not expired later
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	suite.Require().NoError(suite.f.k.ExpireLimitOrders(ctx))
+	order, err = suite.f.k.GetLimitOrder(ctx, later.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LimitOrderStatusFilled, order.Status)
+}
+
+func (suite *LimitOrderTestSuite) TestCancelOrder() {
+	suite.claimChannel()
+	order := suite.createOrder(time.Time{})
+
+	_, err := suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:mallory", testConnectionID, order.Id, time.Minute)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:alice", "connection-9", order.Id, time.Minute)
+	suite.Require().ErrorIs(err, types.ErrInvalidConnectionID)
+
+	// The placement and the cancellation leave in the same packet
+	sequence, err := suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:alice", testConnectionID, order.Id, time.Minute)
+	suite.Require().NoError(err)
+	has, err := suite.f.k.PendingBatches.Has(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// The order stays open until the host chain acknowledges the cancellation
+	suite.Require().Equal(types.LimitOrderStatusOpen, suite.status(order.Id))
+	_, err = suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:alice", testConnectionID, order.Id, time.Minute)
+	suite.Require().ErrorIs(err, types.ErrOrderNotOpen)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	bz, err := proto.Marshal(&ack)
+	suite.Require().NoError(err)
+	packet := channeltypes.Packet{Sequence: sequence, SourcePort: suite.account.PortId, SourceChannel: "channel-0"}
+	suite.Require().NoError(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, packet, bz, nil))
+
+	suite.Require().Equal(types.LimitOrderStatusCancelled, suite.status(order.Id))
+	_, err = suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:alice", testConnectionID, order.Id, time.Minute)
+	suite.Require().ErrorIs(err, types.ErrOrderNotOpen)
+}
+
+func (suite *LimitOrderTestSuite) TestCancelOrderTimeoutKeepsOrderOpen() {
+	suite.claimChannel()
+	order := suite.createOrder(suite.f.ctx.BlockTime().Add(time.Hour))
+
+	sequence, err := suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:alice", testConnectionID, order.Id, time.Minute)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.Packet{Sequence: sequence, SourcePort: suite.account.PortId, SourceChannel: "channel-0"}
+	suite.Require().NoError(suite.f.k.OnTimeoutPacket(suite.f.ctx, packet, nil))
+
+	stored, err := suite.f.k.GetLimitOrder(suite.f.ctx, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LimitOrderStatusOpen, stored.Status)
+	suite.Require().Zero(stored.CancelSequence)
+
+	// A failed cancellation can be retried
+	_, err = suite.f.k.CancelOrder(suite.f.ctx, "did:sonr:alice", testConnectionID, order.Id, time.Minute)
+	suite.Require().NoError(err)
+}
+
+func (suite *LimitOrderTestSuite) TestQueryOrders() {
+	var ids []string
+	for range 3 {
+		ids = append(ids, fmt.Sprintf("%d", suite.createOrder(time.Time{}).Id))
+	}
+	first, err := suite.f.k.GetLimitOrdersByDID(suite.f.ctx, "did:sonr:alice")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.f.k.FillLimitOrder(suite.f.ctx, first[1].Id))
+
+	var seen []string
+	req := &types.QueryOrdersRequest{Did: "did:sonr:alice", Pagination: &query.PageRequest{Limit: 2}}
+	for {
+		res, err := suite.f.queryServer.Orders(suite.f.ctx, req)
+		suite.Require().NoError(err)
+		for _, order := range res.Orders {
+			seen = append(seen, order.OrderId)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req = &types.QueryOrdersRequest{Did: "did:sonr:alice", Pagination: &query.PageRequest{Key: res.Pagination.NextKey}}
+	}
+	suite.Require().Equal(ids, seen)
+
+	res, err := suite.f.queryServer.Orders(suite.f.ctx, &types.QueryOrdersRequest{
+		Did:        "did:sonr:alice",
+		Status:     "open",
+		Pagination: &query.PageRequest{Reverse: true, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 2)
+	suite.Require().Equal(ids[2], res.Orders[0].OrderId)
+	suite.Require().EqualValues(2, res.Pagination.Total)
+
+	_, err = suite.f.queryServer.Orders(suite.f.ctx, &types.QueryOrdersRequest{Did: "did:sonr:alice", Status: "pending"})
+	suite.Require().Error(err)
+}
+
+func (suite *LimitOrderTestSuite) TestQueryOrdersByDID() {
+	open := suite.createOrder(time.Time{})
+	filled := suite.createOrder(time.Time{})
+	suite.Require().NoError(suite.f.k.FillLimitOrder(suite.f.ctx, filled.Id))
+
+	res, err := suite.f.queryServer.OrdersByDID(suite.f.ctx, &types.QueryOrdersByDIDRequest{Did: "did:sonr:alice"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 2)
+
+	res, err = suite.f.queryServer.OrdersByDID(suite.f.ctx, &types.QueryOrdersByDIDRequest{Did: "did:sonr:alice", Status: "open"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 1)
+	suite.Require().Equal(fmt.Sprintf("%d", open.Id), res.Orders[0].OrderId)
+
+	_, err = suite.f.queryServer.OrdersByDID(suite.f.ctx, &types.QueryOrdersByDIDRequest{})
+	suite.Require().Error(err)
+}
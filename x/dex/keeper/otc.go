@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// CreateOTCOffer escrows the maker's side of a peer-to-peer trade in the
+// module account and opens the offer. An empty taker lets any DID accept the
+// offer; an arbiter, if set, may settle or refund it once it is funded.
+// Unsettled offers are refunded at expiration.
+func (k Keeper) CreateOTCOffer(
+	ctx sdk.Context,
+	maker sdk.AccAddress,
+	makerDID string,
+	takerDID string,
+	offer sdk.Coins,
+	ask sdk.Coins,
+	arbiterDID string,
+	expiration time.Time,
+) (types.OTCOffer, error) {
+	if err := k.authorizeOTCParty(ctx, makerDID, maker); err != nil {
+		return types.OTCOffer{}, err
+	}
+	for _, did := range []string{takerDID, arbiterDID} {
+		if did == "" {
+			continue
+		}
+		if err := k.checkOTCParty(ctx, did); err != nil {
+			return types.OTCOffer{}, err
+		}
+	}
+	if expiration.IsZero() {
+		return types.OTCOffer{}, errorsmod.Wrap(types.ErrInvalidOTCOffer, "expiration is required")
+	}
+	if err := k.ValidateOrderExpiration(ctx, expiration); err != nil {
+		return types.OTCOffer{}, err
+	}
+
+	id, err := k.OTCOfferSequence.Next(ctx)
+	if err != nil {
+		return types.OTCOffer{}, err
+	}
+
+	o := types.OTCOffer{
+		Id:            id,
+		MakerDid:      makerDID,
+		MakerAddress:  maker.String(),
+		TakerDid:      takerDID,
+		Offer:         offer,
+		Ask:           ask,
+		ArbiterDid:    arbiterDID,
+		Status:        types.OTCOfferStatusOpen,
+		Expiration:    expirationUnix(expiration),
+		CreatedHeight: ctx.BlockHeight(),
+		UpdatedHeight: ctx.BlockHeight(),
+	}
+	if err := o.Validate(); err != nil {
+		return types.OTCOffer{}, err
+	}
+	if err := k.Screen(ctx, types.ScreeningRequest{
+		Operation: types.ScreeningOpOTCOffer,
+		DID:       makerDID,
+		Parties:   otcScreeningParties(o),
+		Amount:    offer,
+	}); err != nil {
+		return types.OTCOffer{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, maker, types.ModuleName, offer); err != nil {
+		return types.OTCOffer{}, fmt.Errorf("failed to escrow offer: %w", err)
+	}
+	if err := k.setOTCOffer(ctx, o); err != nil {
+		return types.OTCOffer{}, err
+	}
+	if err := k.OTCOfferExpiry.Set(ctx, collections.Join(o.Expiration, id)); err != nil {
+		return types.OTCOffer{}, fmt.Errorf("failed to schedule offer expiry: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOTCOfferCreated,
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("maker", makerDID),
+			sdk.NewAttribute("taker", takerDID),
+			sdk.NewAttribute("arbiter", arbiterDID),
+			sdk.NewAttribute("offer", offer.String()),
+			sdk.NewAttribute("ask", ask.String()),
+			sdk.NewAttribute("expiration", fmt.Sprintf("%d", o.Expiration)),
+		),
+	)
+
+	return o, nil
+}
+
+// AcceptOTCOffer escrows the taker's side of an open offer. Both parties must
+// then confirm before the escrow is exchanged.
+func (k Keeper) AcceptOTCOffer(ctx sdk.Context, taker sdk.AccAddress, takerDID string, id uint64) (types.OTCOffer, error) {
+	o, err := k.GetOTCOffer(ctx, id)
+	if err != nil {
+		return types.OTCOffer{}, err
+	}
+	if o.Status != types.OTCOfferStatusOpen {
+		return types.OTCOffer{}, errorsmod.Wrapf(types.ErrOTCOfferState, "offer %d is %s", id, o.Status)
+	}
+	if o.TakerDid != "" && o.TakerDid != takerDID {
+		return types.OTCOffer{}, errorsmod.Wrapf(types.ErrUnauthorized, "offer %d is reserved for %s", id, o.TakerDid)
+	}
+	if takerDID == o.MakerDid || takerDID == o.ArbiterDid {
+		return types.OTCOffer{}, errorsmod.Wrapf(types.ErrInvalidOTCOffer, "%s cannot take offer %d", takerDID, id)
+	}
+	if err := k.authorizeOTCParty(ctx, takerDID, taker); err != nil {
+		return types.OTCOffer{}, err
+	}
+
+	o.TakerDid = takerDID
+	o.TakerAddress = taker.String()
+	if err := k.Screen(ctx, types.ScreeningRequest{
+		Operation: types.ScreeningOpOTCAccept,
+		DID:       takerDID,
+		Parties:   otcScreeningParties(o),
+		Amount:    o.Ask,
+	}); err != nil {
+		return types.OTCOffer{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, taker, types.ModuleName, o.Ask); err != nil {
+		return types.OTCOffer{}, fmt.Errorf("failed to escrow ask: %w", err)
+	}
+
+	o.Status = types.OTCOfferStatusFunded
+	o.UpdatedHeight = ctx.BlockHeight()
+	if err := k.setOTCOffer(ctx, o); err != nil {
+		return types.OTCOffer{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOTCOfferFunded,
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("maker", o.MakerDid),
+			sdk.NewAttribute("taker", takerDID),
+		),
+	)
+
+	return o, nil
+}
+
+// ConfirmOTCOffer records a party's confirmation of a funded offer and
+// exchanges the escrow once both the maker and the taker have confirmed. It
+// reports whether the trade completed.
+func (k Keeper) ConfirmOTCOffer(ctx sdk.Context, sender sdk.AccAddress, did string, id uint64) (bool, error) {
+	o, err := k.GetOTCOffer(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if o.Status != types.OTCOfferStatusFunded {
+		return false, errorsmod.Wrapf(types.ErrOTCOfferState, "offer %d is %s", id, o.Status)
+	}
+	switch did {
+	case o.MakerDid:
+		o.MakerConfirmed = true
+	case o.TakerDid:
+		o.TakerConfirmed = true
+	default:
+		return false, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a party to offer %d", did, id)
+	}
+	if err := k.authorizeOTCParty(ctx, did, sender); err != nil {
+		return false, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOTCOfferConfirmed,
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("did", did),
+		),
+	)
+
+	if !o.MakerConfirmed || !o.TakerConfirmed {
+		o.UpdatedHeight = ctx.BlockHeight()
+		return false, k.setOTCOffer(ctx, o)
+	}
+	if err := k.settleOTCOffer(ctx, o); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CancelOTCOffer withdraws an offer nobody has taken yet and refunds the
+// maker
+func (k Keeper) CancelOTCOffer(ctx sdk.Context, sender sdk.AccAddress, did string, id uint64) error {
+	o, err := k.GetOTCOffer(ctx, id)
+	if err != nil {
+		return err
+	}
+	if did != o.MakerDid {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "only the maker can cancel offer %d", id)
+	}
+	if err := k.authorizeOTCParty(ctx, did, sender); err != nil {
+		return err
+	}
+	if o.Status != types.OTCOfferStatusOpen {
+		return errorsmod.Wrapf(types.ErrOTCOfferState, "offer %d is %s", id, o.Status)
+	}
+
+	if err := k.refundOTCOffer(ctx, o, types.OTCOfferStatusCancelled); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOTCOfferCancelled,
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("maker", o.MakerDid),
+		),
+	)
+	return nil
+}
+
+// ResolveOTCOffer lets the arbiter of a funded offer settle a dispute, either
+// exchanging the escrow as agreed or refunding both parties
+func (k Keeper) ResolveOTCOffer(ctx sdk.Context, sender sdk.AccAddress, arbiterDID string, id uint64, release bool) error {
+	o, err := k.GetOTCOffer(ctx, id)
+	if err != nil {
+		return err
+	}
+	if o.ArbiterDid == "" || arbiterDID != o.ArbiterDid {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the arbiter of offer %d", arbiterDID, id)
+	}
+	if err := k.authorizeOTCParty(ctx, arbiterDID, sender); err != nil {
+		return err
+	}
+	if o.Status != types.OTCOfferStatusFunded {
+		return errorsmod.Wrapf(types.ErrOTCOfferState, "offer %d is %s", id, o.Status)
+	}
+
+	if release {
+		return k.settleOTCOffer(ctx, o)
+	}
+	if err := k.refundOTCOffer(ctx, o, types.OTCOfferStatusRefunded); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOTCOfferRefunded,
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("arbiter", arbiterDID),
+		),
+	)
+	return nil
+}
+
+// ExpireOTCOffers refunds every unsettled offer whose expiration is at or
+// before the current block time. A failing refund is logged and retried in
+// the next block.
+func (k Keeper) ExpireOTCOffers(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockTime().Unix(), ^uint64(0)))
+
+	due, err := k.OTCOfferExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		o, err := k.GetOTCOffer(ctx, key.K2())
+		if err != nil || o.IsSettled() {
+			// Already settled; drop the stale index entry
+			if err := k.OTCOfferExpiry.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Refund in a cached context so a failed refund leaves no partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundOTCOffer(cacheCtx, o, types.OTCOfferStatusExpired); err != nil {
+			k.Logger(ctx).Error("failed to refund expired OTC offer", "offer_id", o.Id, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOTCOfferExpired,
+				sdk.NewAttribute("offer_id", fmt.Sprintf("%d", o.Id)),
+				sdk.NewAttribute("maker", o.MakerDid),
+				sdk.NewAttribute("taker", o.TakerDid),
+				sdk.NewAttribute("expiration", fmt.Sprintf("%d", o.Expiration)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// GetOTCOffer returns an OTC offer by ID
+func (k Keeper) GetOTCOffer(ctx sdk.Context, id uint64) (types.OTCOffer, error) {
+	o, err := k.OTCOffers.Get(ctx, id)
+	if err != nil {
+		return types.OTCOffer{}, errorsmod.Wrapf(types.ErrOTCOfferNotFound, "offer %d", id)
+	}
+	return o, nil
+}
+
+// settleOTCOffer pays the offer to the taker and the ask to the maker
+func (k Keeper) settleOTCOffer(ctx sdk.Context, o types.OTCOffer) error {
+	maker, err := sdk.AccAddressFromBech32(o.MakerAddress)
+	if err != nil {
+		return err
+	}
+	taker, err := sdk.AccAddressFromBech32(o.TakerAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, taker, o.Offer); err != nil {
+		return fmt.Errorf("failed to release offer: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, maker, o.Ask); err != nil {
+		return fmt.Errorf("failed to release ask: %w", err)
+	}
+	if err := k.closeOTCOffer(ctx, o, types.OTCOfferStatusCompleted); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOTCOfferCompleted,
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", o.Id)),
+			sdk.NewAttribute("maker", o.MakerDid),
+			sdk.NewAttribute("taker", o.TakerDid),
+			sdk.NewAttribute("offer", o.Offer.String()),
+			sdk.NewAttribute("ask", o.Ask.String()),
+		),
+	)
+	return nil
+}
+
+// refundOTCOffer returns the escrow of an unsettled offer to whoever paid it
+// and closes the offer with the given status
+func (k Keeper) refundOTCOffer(ctx sdk.Context, o types.OTCOffer, status types.OTCOfferStatus) error {
+	maker, err := sdk.AccAddressFromBech32(o.MakerAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, maker, o.Offer); err != nil {
+		return fmt.Errorf("failed to refund offer: %w", err)
+	}
+	if o.Status == types.OTCOfferStatusFunded {
+		taker, err := sdk.AccAddressFromBech32(o.TakerAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, taker, o.Ask); err != nil {
+			return fmt.Errorf("failed to refund ask: %w", err)
+		}
+	}
+	return k.closeOTCOffer(ctx, o, status)
+}
+
+// closeOTCOffer moves an offer to a final status and removes it from the
+// expiry index
+func (k Keeper) closeOTCOffer(ctx sdk.Context, o types.OTCOffer, status types.OTCOfferStatus) error {
+	if err := k.OTCOfferExpiry.Remove(ctx, collections.Join(o.Expiration, o.Id)); err != nil {
+		return err
+	}
+	o.Status = status
+	o.UpdatedHeight = ctx.BlockHeight()
+	return k.setOTCOffer(ctx, o)
+}
+
+// setOTCOffer stores an offer and indexes it under every DID involved
+func (k Keeper) setOTCOffer(ctx sdk.Context, o types.OTCOffer) error {
+	if err := k.OTCOffers.Set(ctx, o.Id, o); err != nil {
+		return fmt.Errorf("failed to store offer: %w", err)
+	}
+	for _, did := range o.Parties() {
+		if err := k.OTCOffersByDID.Set(ctx, collections.Join(did, o.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorizeOTCParty checks that sender controls an active DID taking part in
+// a trade
+func (k Keeper) authorizeOTCParty(ctx sdk.Context, did string, sender sdk.AccAddress) error {
+	if did == "" {
+		return types.ErrInvalidDID
+	}
+	if err := k.ValidateDIDOwnership(ctx, did, sender); err != nil {
+		return errorsmod.Wrap(types.ErrUnauthorized, err.Error())
+	}
+	return nil
+}
+
+// checkOTCParty checks that a counterparty or arbiter DID exists and is active
+func (k Keeper) checkOTCParty(ctx sdk.Context, did string) error {
+	summary, err := k.getDIDSummary(ctx, did)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidDID, "%s: %s", did, err)
+	}
+	if summary.Deactivated {
+		return errorsmod.Wrapf(types.ErrInvalidDID, "DID %s is deactivated", did)
+	}
+	return nil
+}
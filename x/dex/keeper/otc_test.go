@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+const (
+	otcMaker   = "did:sonr:maker"
+	otcTaker   = "did:sonr:taker"
+	otcArbiter = "did:sonr:arbiter"
+)
+
+// setupOTC gives the maker, taker and arbiter DIDs their own controllers and
+// funds the maker and taker
+func setupOTC(t *testing.T) *testFixture {
+	t.Helper()
+	f := SetupTest(t)
+	f.ctx = f.ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	for i, did := range []string{otcMaker, otcTaker, otcArbiter} {
+		f.didKeeper.controllers[did] = f.addrs[i].String()
+	}
+	f.bank.balances[f.addrs[0].String()] = sdk.NewCoins(sdk.NewInt64Coin("usnr", 1_000))
+	f.bank.balances[f.addrs[1].String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 500))
+	return f
+}
+
+func createOTCOffer(t *testing.T, f *testFixture, taker, arbiter string) uint64 {
+	t.Helper()
+	res, err := f.msgServer.CreateOTCOffer(f.ctx, &types.MsgCreateOTCOffer{
+		Sender:     f.addrs[0].String(),
+		MakerDid:   otcMaker,
+		TakerDid:   taker,
+		Offer:      sdk.NewCoins(sdk.NewInt64Coin("usnr", 600)),
+		Ask:        sdk.NewCoins(sdk.NewInt64Coin("uatom", 300)),
+		ArbiterDid: arbiter,
+		Expiration: f.ctx.BlockTime().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	return res.OfferId
+}
+
+func TestOTCOfferSettlesAfterBothConfirm(t *testing.T) {
+	f := setupOTC(t)
+	id := createOTCOffer(t, f, otcTaker, "")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 600)), f.bank.balances[types.ModuleName])
+
+	// The maker's controller cannot take the offer for the taker
+	_, err := f.msgServer.AcceptOTCOffer(f.ctx, &types.MsgAcceptOTCOffer{
+		Sender: f.addrs[0].String(), TakerDid: otcTaker, OfferId: id,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = f.msgServer.AcceptOTCOffer(f.ctx, &types.MsgAcceptOTCOffer{
+		Sender: f.addrs[1].String(), TakerDid: otcTaker, OfferId: id,
+	})
+	require.NoError(t, err)
+
+	res, err := f.msgServer.ConfirmOTCOffer(f.ctx, &types.MsgConfirmOTCOffer{
+		Sender: f.addrs[0].String(), Did: otcMaker, OfferId: id,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Completed)
+
+	res, err = f.msgServer.ConfirmOTCOffer(f.ctx, &types.MsgConfirmOTCOffer{
+		Sender: f.addrs[1].String(), Did: otcTaker, OfferId: id,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Completed)
+
+	require.True(t, f.bank.balances[types.ModuleName].IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 300), sdk.NewInt64Coin("usnr", 400)), f.bank.balances[f.addrs[0].String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 200), sdk.NewInt64Coin("usnr", 600)), f.bank.balances[f.addrs[1].String()])
+
+	offer, err := f.queryServer.OTCOffer(f.ctx, &types.QueryOTCOfferRequest{OfferId: id})
+	require.NoError(t, err)
+	require.Equal(t, "completed", offer.Offer.Status)
+}
+
+func TestOTCOfferExpiryRefunds(t *testing.T) {
+	f := setupOTC(t)
+	id := createOTCOffer(t, f, "", "")
+	_, err := f.msgServer.AcceptOTCOffer(f.ctx, &types.MsgAcceptOTCOffer{
+		Sender: f.addrs[1].String(), TakerDid: otcTaker, OfferId: id,
+	})
+	require.NoError(t, err)
+
+	// Nothing is due before the expiration
+	require.NoError(t, f.k.ExpireOTCOffers(f.ctx))
+	offer, err := f.k.GetOTCOffer(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.OTCOfferStatusFunded, offer.Status)
+
+	require.NoError(t, f.k.ExpireOTCOffers(f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Hour))))
+	offer, err = f.k.GetOTCOffer(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.OTCOfferStatusExpired, offer.Status)
+	require.True(t, f.bank.balances[types.ModuleName].IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 1_000)), f.bank.balances[f.addrs[0].String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), f.bank.balances[f.addrs[1].String()])
+}
+
+func TestOTCOfferArbiterRefund(t *testing.T) {
+	f := setupOTC(t)
+	id := createOTCOffer(t, f, otcTaker, otcArbiter)
+	_, err := f.msgServer.AcceptOTCOffer(f.ctx, &types.MsgAcceptOTCOffer{
+		Sender: f.addrs[1].String(), TakerDid: otcTaker, OfferId: id,
+	})
+	require.NoError(t, err)
+
+	// Only the arbiter may resolve
+	_, err = f.msgServer.ResolveOTCOffer(f.ctx, &types.MsgResolveOTCOffer{
+		Sender: f.addrs[1].String(), ArbiterDid: otcTaker, OfferId: id, Release: true,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = f.msgServer.ResolveOTCOffer(f.ctx, &types.MsgResolveOTCOffer{
+		Sender: f.addrs[2].String(), ArbiterDid: otcArbiter, OfferId: id,
+	})
+	require.NoError(t, err)
+	require.True(t, f.bank.balances[types.ModuleName].IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), f.bank.balances[f.addrs[1].String()])
+
+	// Arbiters see the offers they arbitrate
+	res, err := f.queryServer.OTCOffers(f.ctx, &types.QueryOTCOffersRequest{Did: otcArbiter, Status: "refunded"})
+	require.NoError(t, err)
+	require.Len(t, res.Offers, 1)
+	require.Equal(t, id, res.Offers[0].OfferId)
+}
+
+func TestOTCOfferCancelAndOpenOffers(t *testing.T) {
+	f := setupOTC(t)
+	f.bank.balances[f.addrs[0].String()] = sdk.NewCoins(sdk.NewInt64Coin("usnr", 1_200))
+	cancelled := createOTCOffer(t, f, "", "")
+	open := createOTCOffer(t, f, "", "")
+
+	_, err := f.msgServer.CancelOTCOffer(f.ctx, &types.MsgCancelOTCOffer{
+		Sender: f.addrs[0].String(), Did: otcMaker, OfferId: cancelled,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 600)), f.bank.balances[types.ModuleName])
+
+	res, err := f.queryServer.OTCOffers(f.ctx, &types.QueryOTCOffersRequest{Status: "open"})
+	require.NoError(t, err)
+	require.Len(t, res.Offers, 1)
+	require.Equal(t, open, res.Offers[0].OfferId)
+
+	// An offer cannot escrow more than the maker holds
+	_, err = f.msgServer.CreateOTCOffer(f.ctx, &types.MsgCreateOTCOffer{
+		Sender:     f.addrs[0].String(),
+		MakerDid:   otcMaker,
+		Offer:      sdk.NewCoins(sdk.NewInt64Coin("usnr", 700)),
+		Ask:        sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+		Expiration: f.ctx.BlockTime().Add(time.Hour),
+	})
+	require.Error(t, err)
+}
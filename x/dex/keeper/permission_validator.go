@@ -28,18 +28,19 @@ func NewPermissionValidator(keeper Keeper) *PermissionValidator {
 	}
 }
 
-// ValidatePermission validates UCAN token for DEX operation
+// ValidatePermission validates UCAN token for DEX operation and returns the
+// verified token
 func (pv *PermissionValidator) ValidatePermission(
 	ctx context.Context,
 	tokenString string,
 	resourceType string,
 	resourceID string,
 	operation types.DEXOperation,
-) error {
+) (*ucan.Token, error) {
 	// Get required UCAN capabilities for the operation
 	capabilities, err := pv.permissions.GetRequiredUCANCapabilities(operation)
 	if err != nil {
-		return fmt.Errorf("failed to get required UCAN capabilities: %w", err)
+		return nil, fmt.Errorf("failed to get required UCAN capabilities: %w", err)
 	}
 
 	// Build resource URI for DEX
@@ -47,17 +48,17 @@ func (pv *PermissionValidator) ValidatePermission(
 	resourceURI := mapper.CreateDEXResourceURI(resourceType, resourceID)
 
 	// Verify UCAN token grants required capabilities
-	_, err = pv.verifier.VerifyCapability(
+	token, err := pv.verifier.VerifyCapability(
 		ctx,
 		tokenString,
 		resourceURI,
 		capabilities,
 	)
 	if err != nil {
-		return fmt.Errorf("UCAN validation failed: %w", err)
+		return nil, fmt.Errorf("UCAN validation failed: %w", err)
 	}
 
-	return nil
+	return token, nil
 }
 
 // ValidateSwapPermission validates UCAN token for swap operations
@@ -241,13 +242,12 @@ func (r *DEXDIDResolver) ResolveDIDKey(ctx context.Context, did string) (keys.DI
 
 	// Check if the DEX keeper has access to DID keeper
 	if r.keeper.didKeeper != nil {
-		didDoc, err := r.keeper.didKeeper.GetDIDDocument(ctx, did)
+		summary, err := r.keeper.getDIDSummary(ctx, did)
 		if err != nil {
 			return keys.DID{}, fmt.Errorf("failed to get DID document: %w", err)
 		}
-
-		if didDoc == nil {
-			return keys.DID{}, fmt.Errorf("DID document not found")
+		if summary.Deactivated {
+			return keys.DID{}, fmt.Errorf("DID %s is deactivated", did)
 		}
 
 		// Parse the DID string into a keys.DID
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// Pricing sources reported by swap estimates
+const (
+	PriceSourceReserves = "reserves"
+	PriceSourceOracle   = "oracle"
+)
+
+// RefreshPoolReserves sends a module query for the reserves of a host chain
+// pool through the DID's ICA account. The reserves are stored when the packet
+// is acknowledged and price swap estimates until they go stale.
+func (k Keeper) RefreshPoolReserves(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	did string,
+	connectionID string,
+	poolID uint64,
+) (uint64, error) {
+	if poolID == 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidSwapParams, "pool ID is required")
+	}
+	if err := k.ValidateDIDOwnership(ctx, did, sender); err != nil {
+		return 0, errorsmod.Wrap(types.ErrUnauthorized, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	if _, ok := params.NobleRoute(connectionID); ok {
+		return 0, errorsmod.Wrapf(types.ErrInvalidConnectionID, "%s routes swaps through Noble and has no pools", connectionID)
+	}
+	if !params.IsConnectionAllowed(connectionID) {
+		return 0, errorsmod.Wrap(types.ErrConnectionNotAllowed, connectionID)
+	}
+
+	account, err := k.GetDEXAccount(ctx, did, connectionID)
+	if err != nil {
+		return 0, fmt.Errorf("DEX account not found: %w", err)
+	}
+
+	// Queries bypass batching so the acknowledgement answers only this query
+	sequence, err := k.SendDEXTransaction(
+		ctx,
+		did,
+		connectionID,
+		[]sdk.Msg{types.NewPoolReservesQuery(account.AccountAddress, poolID)},
+		fmt.Sprintf("pool_reserves_%d", poolID),
+		k.DefaultICATimeout(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send pool reserves query: %w", err)
+	}
+
+	pending := types.PoolReserves{ConnectionId: connectionID, PoolId: poolID}
+	if err := k.PendingPoolQueries.Set(ctx, collections.Join(account.PortId, sequence), pending); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePoolReservesRequested,
+			sdk.NewAttribute("did", did),
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("pool_id", fmt.Sprintf("%d", poolID)),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+		),
+	)
+	return sequence, nil
+}
+
+// OnPoolReservesResult stores the reserves answered by a pool reserves query.
+// A nil ack means the packet timed out. Failed or unreadable answers keep the
+// previous snapshot, which goes stale on its own.
+func (k Keeper) OnPoolReservesResult(ctx sdk.Context, portID string, sequence uint64, ack *channeltypes.Acknowledgement) error {
+	key := collections.Join(portID, sequence)
+	pending, err := k.PendingPoolQueries.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.PendingPoolQueries.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	if ack == nil || !ack.Success() {
+		k.Logger(ctx).Info("pool reserves query failed",
+			"connection", pending.ConnectionId,
+			"pool_id", pending.PoolId,
+			"sequence", sequence,
+		)
+		return nil
+	}
+	height, reserves, err := types.PoolReservesFromAck(ack.GetResult())
+	if err != nil {
+		k.Logger(ctx).Error("failed to read pool reserves from acknowledgement",
+			"connection", pending.ConnectionId,
+			"pool_id", pending.PoolId,
+			"error", err,
+		)
+		return nil
+	}
+
+	pending.Reserves = reserves
+	pending.HostHeight = height
+	pending.UpdatedAt = ctx.BlockTime().Unix()
+	if err := k.PoolReserves.Set(ctx, collections.Join(pending.ConnectionId, pending.PoolId), pending); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePoolReservesUpdated,
+			sdk.NewAttribute("connection", pending.ConnectionId),
+			sdk.NewAttribute("pool_id", fmt.Sprintf("%d", pending.PoolId)),
+			sdk.NewAttribute("reserves", reserves.String()),
+			sdk.NewAttribute("host_height", fmt.Sprintf("%d", height)),
+		),
+	)
+	return nil
+}
+
+// GetPoolReserves returns the latest reserves read from a host chain pool
+func (k Keeper) GetPoolReserves(ctx sdk.Context, connectionID string, poolID uint64) (types.PoolReserves, error) {
+	return k.PoolReserves.Get(ctx, collections.Join(connectionID, poolID))
+}
+
+// GetPricingParams returns the swap estimate pricing parameters
+func (k Keeper) GetPricingParams(ctx sdk.Context) types.PricingParams {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.PricingParams{}
+	}
+	return params.Pricing
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// setupReserves prices uatom and uosmo with the oracle and gives alice an
+// active DEX account that can send pool queries
+func setupReserves(t *testing.T) (*testFixture, types.InterchainDEXAccount) {
+	t.Helper()
+	f := setupEstimate(t)
+	f.ctx = f.ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	f.didKeeper.controllers["did:sonr:alice"] = f.addrs[0].String()
+
+	account, err := f.k.RegisterDEXAccount(f.ctx, "did:sonr:alice", testConnectionID, []string{"swap"})
+	require.NoError(t, err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	require.NoError(t, f.k.Accounts.Set(f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID), *account))
+	_, err = f.k.ScopedKeeper.NewCapability(f.ctx, host.ChannelCapabilityPath(account.PortId, "channel-0"))
+	require.NoError(t, err)
+	return f, *account
+}
+
+// poolReservesAck builds the acknowledgement of a pool reserves query
+// answered at height with the given liquidity
+func poolReservesAck(t *testing.T, height uint64, liquidity sdk.Coins) []byte {
+	t.Helper()
+	var res []byte
+	for _, coin := range liquidity {
+		bz, err := coin.Marshal()
+		require.NoError(t, err)
+		res = protowire.AppendTag(res, 1, protowire.BytesType)
+		res = protowire.AppendBytes(res, bz)
+	}
+	value := protowire.AppendTag(nil, 1, protowire.VarintType)
+	value = protowire.AppendVarint(value, height)
+	value = protowire.AppendTag(value, 2, protowire.BytesType)
+	value = protowire.AppendBytes(value, res)
+
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{{
+		TypeUrl: "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse",
+		Value:   value,
+	}}})
+	require.NoError(t, err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+	bz, err := proto.Marshal(&ack)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestEstimateSwapWithPoolReserves(t *testing.T) {
+	f, account := setupReserves(t)
+
+	// Only alice's controller may query through her account
+	_, err := f.msgServer.RefreshPoolReserves(f.ctx, &types.MsgRefreshPoolReserves{
+		Sender: f.addrs[1].String(), Did: "did:sonr:alice", ConnectionId: testConnectionID, PoolId: 1,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := f.msgServer.RefreshPoolReserves(f.ctx, &types.MsgRefreshPoolReserves{
+		Sender: f.addrs[0].String(), Did: "did:sonr:alice", ConnectionId: testConnectionID, PoolId: 1,
+	})
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{Sequence: res.Sequence, SourcePort: account.PortId, SourceChannel: "channel-0"}
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 4_000_000))
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, poolReservesAck(t, 42, liquidity), nil))
+
+	pool, err := f.queryServer.Pool(f.ctx, &types.QueryPoolRequest{ConnectionId: testConnectionID, PoolId: "1"})
+	require.NoError(t, err)
+	require.Equal(t, liquidity, pool.Pool.Assets)
+	require.EqualValues(t, 42, pool.Pool.HostHeight)
+	require.False(t, pool.Pool.Stale)
+
+	req := &types.QueryEstimateSwapRequest{
+		ConnectionId:  testConnectionID,
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "uosmo",
+		PoolId:        1,
+	}
+	estimate, err := f.queryServer.EstimateSwap(f.ctx, req)
+	require.NoError(t, err)
+	// 9,970 uatom into 1M uatom / 4M uosmo reserves
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 39_486), estimate.TokenOut)
+	require.Equal(t, keeper.PriceSourceReserves, estimate.Source)
+
+	// Stale reserves fall back to the oracle
+	f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(types.DefaultReservesTTL))
+	estimate, err = f.queryServer.EstimateSwap(f.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 19_940), estimate.TokenOut)
+	require.Equal(t, keeper.PriceSourceOracle, estimate.Source)
+
+	pool, err = f.queryServer.Pool(f.ctx, &types.QueryPoolRequest{ConnectionId: testConnectionID, PoolId: "1"})
+	require.NoError(t, err)
+	require.True(t, pool.Pool.Stale)
+
+	// unless the params require reserves
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.Pricing = types.PricingParams{RequireReserves: true}
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+	_, err = f.queryServer.EstimateSwap(f.ctx, req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestFailedPoolReservesQueryKeepsSnapshot(t *testing.T) {
+	f, account := setupReserves(t)
+	refresh := func() channeltypes.Packet {
+		res, err := f.msgServer.RefreshPoolReserves(f.ctx, &types.MsgRefreshPoolReserves{
+			Sender: f.addrs[0].String(), Did: "did:sonr:alice", ConnectionId: testConnectionID, PoolId: 1,
+		})
+		require.NoError(t, err)
+		return channeltypes.Packet{Sequence: res.Sequence, SourcePort: account.PortId, SourceChannel: "channel-0"}
+	}
+
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 4_000_000))
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, refresh(), poolReservesAck(t, 42, liquidity), nil))
+
+	require.NoError(t, f.k.OnTimeoutPacket(f.ctx, refresh(), nil))
+	reserves, err := f.k.GetPoolReserves(f.ctx, testConnectionID, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, reserves.HostHeight)
+
+	_, err = f.k.PendingPoolQueries.Get(f.ctx, collections.Join(account.PortId, uint64(1)))
+	require.Error(t, err, "the timed out query is no longer pending")
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// GetPriceHistoryParams returns the price history parameters, falling back to defaults
+func (k Keeper) GetPriceHistoryParams(ctx sdk.Context) types.PriceHistoryParams {
+	params, err := k.PriceHistoryParams.Get(ctx)
+	if err != nil {
+		return types.DefaultPriceHistoryParams()
+	}
+	return params
+}
+
+// SetPriceHistoryParams updates the price history parameters. Only the module
+// authority (governance) may change them.
+func (k Keeper) SetPriceHistoryParams(ctx sdk.Context, authority string, params types.PriceHistoryParams) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.PriceHistoryParams.Set(ctx, params)
+}
+
+// RecordPrice folds an observed price for a denom into the current epoch
+func (k Keeper) RecordPrice(ctx sdk.Context, denom string, price math.LegacyDec) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if !price.IsPositive() {
+		return fmt.Errorf("price must be positive")
+	}
+
+	params := k.GetPriceHistoryParams(ctx)
+	key := collections.Join(denom, params.EpochOf(ctx.BlockTime()))
+
+	epoch, err := k.PriceHistory.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		epoch = types.NewPriceEpoch(denom, key.K2(), price)
+	case err != nil:
+		return err
+	default:
+		if err := epoch.Observe(price); err != nil {
+			return fmt.Errorf("failed to aggregate price: %w", err)
+		}
+	}
+
+	return k.PriceHistory.Set(ctx, key, epoch)
+}
+
+// QueryPriceHistory returns aggregated prices for a denom between from and to
+// (inclusive). Epochs are merged into buckets of the requested resolution,
+// which must be a multiple of the epoch length; a zero resolution returns raw epochs.
+func (k Keeper) QueryPriceHistory(
+	ctx sdk.Context,
+	denom string,
+	from, to time.Time,
+	resolution time.Duration,
+) ([]types.PriceEpoch, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("invalid range: %s is before %s", to, from)
+	}
+
+	params := k.GetPriceHistoryParams(ctx)
+	epochsPerBucket := uint64(1)
+	if resolution > 0 {
+		seconds := uint64(resolution / time.Second)
+		if seconds < params.EpochSeconds || seconds%params.EpochSeconds != 0 {
+			return nil, fmt.Errorf(
+				"resolution %s must be a multiple of the %ds epoch", resolution, params.EpochSeconds,
+			)
+		}
+		epochsPerBucket = seconds / params.EpochSeconds
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](denom).
+		StartInclusive(params.EpochOf(from)).
+		EndInclusive(params.EpochOf(to))
+
+	iter, err := k.PriceHistory.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var out []types.PriceEpoch
+	for ; iter.Valid(); iter.Next() {
+		epoch, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		bucket := epoch.Epoch / epochsPerBucket * epochsPerBucket
+		if n := len(out); n > 0 && out[n-1].Epoch == bucket {
+			if err := out[n-1].Merge(epoch); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		epoch.Epoch = bucket
+		out = append(out, epoch)
+	}
+
+	return out, nil
+}
+
+// priceSampleIntervalBlocks bounds how often the oracle is sampled into the
+// price history
+const priceSampleIntervalBlocks = 10
+
+// RecordOraclePrices samples the oracle price of every denom a routing pool
+// trades into the current epoch. It only does work every
+// priceSampleIntervalBlocks blocks; denoms the oracle cannot price are
+// skipped.
+func (k Keeper) RecordOraclePrices(ctx sdk.Context) error {
+	if k.oracleKeeper == nil || ctx.BlockHeight()%priceSampleIntervalBlocks != 0 {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, pool := range params.Routing.Pools {
+		for _, denom := range pool.Denoms {
+			if seen[denom] {
+				continue
+			}
+			seen[denom] = true
+
+			price, err := k.assetPrice(ctx, denom)
+			if err != nil {
+				continue
+			}
+			if err := k.RecordPrice(ctx, denom, price); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pricePruneIntervalBlocks bounds how often the price history is walked for pruning
+const pricePruneIntervalBlocks = 100
+
+// PrunePriceHistory removes epochs that fall outside the retention window. It
+// only does work every pricePruneIntervalBlocks blocks.
+func (k Keeper) PrunePriceHistory(ctx sdk.Context) error {
+	if ctx.BlockHeight()%pricePruneIntervalBlocks != 0 {
+		return nil
+	}
+
+	params := k.GetPriceHistoryParams(ctx)
+	if params.RetentionEpochs == 0 {
+		return nil
+	}
+
+	current := params.EpochOf(ctx.BlockTime())
+	if current <= params.RetentionEpochs {
+		return nil
+	}
+	cutoff := current - params.RetentionEpochs
+
+	var stale []collections.Pair[string, uint64]
+	err := k.PriceHistory.Walk(ctx, nil, func(key collections.Pair[string, uint64], _ types.PriceEpoch) (bool, error) {
+		if key.K2() < cutoff {
+			stale = append(stale, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range stale {
+		if err := k.PriceHistory.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	if len(stale) > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePriceHistoryPruned,
+				sdk.NewAttribute("epochs", fmt.Sprintf("%d", len(stale))),
+				sdk.NewAttribute("cutoff_epoch", fmt.Sprintf("%d", cutoff)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,374 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// RegisterProtocolAccount opens the interchain account holding protocol-owned
+// liquidity on a connection. Only the module authority (governance) may
+// register it; the account is owned by the module rather than a DID.
+func (k Keeper) RegisterProtocolAccount(ctx sdk.Context, authority, connectionID string) (*types.InterchainDEXAccount, error) {
+	if authority != k.authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return nil, err
+	}
+
+	account, err := k.registerICA(ctx, types.ProtocolAccountOwner, connectionID, []string{"liquidity"})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolAccountRegistered,
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("port_id", account.PortId),
+		),
+	)
+	return account, nil
+}
+
+// FundProtocolAccount sends treasury funds held by the protocol liquidity
+// module account to the protocol account on a connection, one ICS-20
+// transfer per coin over channelID. Governance moves community pool funds to
+// that account with MsgCommunityPoolSpend in the same proposal; the funds can
+// be provided to a pool once the transfers are received.
+func (k Keeper) FundProtocolAccount(
+	ctx sdk.Context,
+	authority string,
+	connectionID string,
+	channelID string,
+	amount sdk.Coins,
+) ([]uint64, error) {
+	if authority != k.authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if k.transferKeeper == nil {
+		return nil, fmt.Errorf("transfer keeper not set")
+	}
+
+	account, err := k.GetDEXAccount(ctx, types.ProtocolAccountOwner, connectionID)
+	if err != nil {
+		return nil, fmt.Errorf("protocol account not found: %w", err)
+	}
+	if account.Status != types.ACCOUNT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrap(types.ErrAccountNotActive, "protocol account is not active")
+	}
+
+	// The transfer must reach the chain the protocol account lives on
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidConnectionID, "transfer channel %s not found", channelID)
+	}
+	if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionID {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidConnectionID, "transfer channel %s is not on %s", channelID, connectionID,
+		)
+	}
+
+	sender := authtypes.NewModuleAddress(types.ProtocolLiquidityAccount).String()
+	timeout := uint64(ctx.BlockTime().Add(k.DefaultICATimeout(ctx)).UnixNano())
+	sequences := make([]uint64, 0, len(amount))
+	for _, coin := range amount {
+		res, err := k.transferKeeper.Transfer(ctx, &transfertypes.MsgTransfer{
+			SourcePort:       transfertypes.PortID,
+			SourceChannel:    channelID,
+			Token:            coin,
+			Sender:           sender,
+			Receiver:         account.AccountAddress,
+			TimeoutTimestamp: timeout,
+			Memo:             "protocol_liquidity_funding",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to transfer %s: %w", coin, err)
+		}
+		sequences = append(sequences, res.Sequence)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolAccountFunded,
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("channel", channelID),
+			sdk.NewAttribute("receiver", account.AccountAddress),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+	return sequences, nil
+}
+
+// GovProvideLiquidity joins a host chain pool with assets held by the
+// protocol account, minting exactly shareOut LP shares and paying at most
+// assets. Only the module authority may provide; the position cannot be
+// withdrawn until lock has passed, and a later provision extends the
+// timelock of the whole position if it ends later.
+func (k Keeper) GovProvideLiquidity(
+	ctx sdk.Context,
+	authority string,
+	connectionID string,
+	poolID uint64,
+	assets sdk.Coins,
+	shareOut math.Int,
+	lock time.Duration,
+) (uint64, error) {
+	if authority != k.authority {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if lock < types.MinProtocolLiquidityLock {
+		return 0, errorsmod.Wrapf(
+			types.ErrInvalidLiquidityParams, "lock %s is shorter than %s", lock, types.MinProtocolLiquidityLock,
+		)
+	}
+	if err := k.checkDenomsAllowed(ctx, connectionID, assets.Denoms()...); err != nil {
+		return 0, err
+	}
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return 0, err
+	}
+
+	account, err := k.GetDEXAccount(ctx, types.ProtocolAccountOwner, connectionID)
+	if err != nil {
+		return 0, fmt.Errorf("protocol account not found: %w", err)
+	}
+
+	joinMsg := &types.OsmosisMsgJoinPool{
+		Sender:         account.AccountAddress,
+		PoolId:         poolID,
+		ShareOutAmount: shareOut,
+		TokenInMaxs:    assets,
+	}
+
+	// Protocol liquidity bypasses batching so each acknowledgement settles
+	// exactly one provision
+	sequence, err := k.SendDEXTransaction(
+		ctx,
+		types.ProtocolAccountOwner,
+		connectionID,
+		[]sdk.Msg{joinMsg},
+		fmt.Sprintf("protocol_provide_liquidity_pool_%d", poolID),
+		k.DefaultICATimeout(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send protocol liquidity: %w", err)
+	}
+
+	op := types.ProtocolLiquidityOp{
+		ConnectionId: connectionID,
+		PoolId:       poolID,
+		Provide:      assets,
+		Shares:       shareOut.String(),
+	}
+	if err := k.PendingProtocolLiquidity.Set(ctx, collections.Join(account.PortId, sequence), op); err != nil {
+		return 0, err
+	}
+
+	position, err := k.GetProtocolPosition(ctx, connectionID, poolID)
+	if errors.Is(err, types.ErrPositionNotFound) {
+		position = types.ProtocolPosition{ConnectionId: connectionID, PoolId: poolID}
+	} else if err != nil {
+		return 0, err
+	}
+	if unlock := ctx.BlockTime().Add(lock).Unix(); unlock > position.UnlockTime {
+		position.UnlockTime = unlock
+	}
+	position.Pending++
+	position.UpdatedHeight = ctx.BlockHeight()
+	if err := k.ProtocolPositions.Set(ctx, collections.Join(connectionID, poolID), position); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolLiquiditySent,
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("pool_id", fmt.Sprintf("%d", poolID)),
+			sdk.NewAttribute("assets", assets.String()),
+			sdk.NewAttribute("shares", shareOut.String()),
+			sdk.NewAttribute("unlock_time", time.Unix(position.UnlockTime, 0).UTC().Format(time.RFC3339)),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+		),
+	)
+	return sequence, nil
+}
+
+// GovRemoveLiquidity exits a host chain pool with LP shares of a protocol
+// position, returning the assets to the protocol account. Only the module
+// authority may remove, only once the position's timelock has passed, and
+// only shares the position holds that no other removal has claimed.
+func (k Keeper) GovRemoveLiquidity(
+	ctx sdk.Context,
+	authority string,
+	connectionID string,
+	poolID uint64,
+	shares math.Int,
+	minAmounts sdk.Coins,
+) (uint64, error) {
+	if authority != k.authority {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	position, err := k.GetProtocolPosition(ctx, connectionID, poolID)
+	if err != nil {
+		return 0, err
+	}
+	if position.Locked(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(
+			types.ErrPositionLocked,
+			"pool %d on %s is locked until %s",
+			poolID, connectionID, time.Unix(position.UnlockTime, 0).UTC().Format(time.RFC3339),
+		)
+	}
+
+	if available := position.Available(); shares.GT(available) {
+		return 0, errorsmod.Wrapf(
+			types.ErrInvalidLiquidityParams,
+			"removing %s shares, but pool %d on %s holds %s", shares, poolID, connectionID, available,
+		)
+	}
+
+	account, err := k.GetDEXAccount(ctx, types.ProtocolAccountOwner, connectionID)
+	if err != nil {
+		return 0, fmt.Errorf("protocol account not found: %w", err)
+	}
+
+	exitMsg := &types.OsmosisMsgExitPool{
+		Sender:        account.AccountAddress,
+		PoolId:        poolID,
+		ShareInAmount: shares,
+		TokenOutMins:  minAmounts,
+	}
+
+	sequence, err := k.SendDEXTransaction(
+		ctx,
+		types.ProtocolAccountOwner,
+		connectionID,
+		[]sdk.Msg{exitMsg},
+		fmt.Sprintf("protocol_remove_liquidity_pool_%d", poolID),
+		k.DefaultICATimeout(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send protocol liquidity removal: %w", err)
+	}
+
+	op := types.ProtocolLiquidityOp{ConnectionId: connectionID, PoolId: poolID, RemoveShares: shares.String()}
+	if err := k.PendingProtocolLiquidity.Set(ctx, collections.Join(account.PortId, sequence), op); err != nil {
+		return 0, err
+	}
+
+	position.RemovingShares = position.Removing().Add(shares).String()
+	position.Pending++
+	position.UpdatedHeight = ctx.BlockHeight()
+	if err := k.ProtocolPositions.Set(ctx, collections.Join(connectionID, poolID), position); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolLiquiditySent,
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("pool_id", fmt.Sprintf("%d", poolID)),
+			sdk.NewAttribute("shares", shares.String()),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+		),
+	)
+	return sequence, nil
+}
+
+// OnProtocolLiquidityResult settles a protocol liquidity packet. A successful
+// provision adds the shares minted and the assets paid to the position; a
+// successful removal adds its shares to the removed total and the assets
+// returned to the withdrawn total. Failures and timeouts (nil ack) leave the
+// position's amounts unchanged and release the shares a removal claimed.
+func (k Keeper) OnProtocolLiquidityResult(ctx sdk.Context, portID string, sequence uint64, ack *channeltypes.Acknowledgement) error {
+	key := collections.Join(portID, sequence)
+	op, err := k.PendingProtocolLiquidity.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.PendingProtocolLiquidity.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	position, err := k.GetProtocolPosition(ctx, op.ConnectionId, op.PoolId)
+	if err != nil {
+		return err
+	}
+	if position.Pending > 0 {
+		position.Pending--
+	}
+
+	success := ack != nil && ack.Success()
+	if op.IsRemoval() {
+		shares, ok := math.NewIntFromString(op.RemoveShares)
+		if !ok {
+			return fmt.Errorf("invalid removed shares %q", op.RemoveShares)
+		}
+		// The shares are released whatever the outcome
+		if removing := position.Removing().Sub(shares); removing.IsPositive() {
+			position.RemovingShares = removing.String()
+		} else {
+			position.RemovingShares = ""
+		}
+		if success {
+			position.SharesRemoved = position.Removed().Add(shares).String()
+			out, err := types.ExitPoolResultFromAck(ack.GetResult())
+			if err != nil {
+				k.Logger(ctx).Error("failed to read exit pool result", "pool_id", op.PoolId, "error", err)
+			}
+			position.Withdrawn = position.Withdrawn.Add(out...)
+		}
+	} else if success {
+		// MsgJoinPool mints exactly the shares asked for and pays at most
+		// the assets sent; the response reports what was actually paid
+		minted, paid, err := types.JoinPoolResultFromAck(ack.GetResult())
+		if err != nil {
+			k.Logger(ctx).Error("failed to read join pool result", "pool_id", op.PoolId, "error", err)
+			minted, _ = math.NewIntFromString(op.Shares)
+			paid = op.Provide
+		}
+		position.Shares = position.Minted().Add(minted).String()
+		position.Provided = position.Provided.Add(paid...)
+	}
+	position.UpdatedHeight = ctx.BlockHeight()
+	if err := k.ProtocolPositions.Set(ctx, collections.Join(op.ConnectionId, op.PoolId), position); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolLiquiditySettled,
+			sdk.NewAttribute("connection", op.ConnectionId),
+			sdk.NewAttribute("pool_id", fmt.Sprintf("%d", op.PoolId)),
+			sdk.NewAttribute("operation", op.String()),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute("success", fmt.Sprintf("%t", success)),
+		),
+	)
+	return nil
+}
+
+// GetProtocolPosition returns the protocol-owned liquidity in a pool
+func (k Keeper) GetProtocolPosition(ctx sdk.Context, connectionID string, poolID uint64) (types.ProtocolPosition, error) {
+	position, err := k.ProtocolPositions.Get(ctx, collections.Join(connectionID, poolID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return position, errorsmod.Wrapf(types.ErrPositionNotFound, "pool %d on %s", poolID, connectionID)
+	}
+	return position, err
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// mockTransferKeeper records the ICS-20 transfers it is asked to send
+type mockTransferKeeper struct {
+	sent []*transfertypes.MsgTransfer
+}
+
+func (m *mockTransferKeeper) Transfer(
+	ctx context.Context,
+	msg *transfertypes.MsgTransfer,
+) (*transfertypes.MsgTransferResponse, error) {
+	m.sent = append(m.sent, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.sent))}, nil
+}
+
+// setupProtocolAccount registers the protocol account on the test
+// connection and opens its channel
+func setupProtocolAccount(t *testing.T) (*testFixture, types.InterchainDEXAccount) {
+	t.Helper()
+	f, _ := setupReserves(t)
+
+	res, err := f.msgServer.RegisterProtocolAccount(f.ctx, &types.MsgRegisterProtocolAccount{
+		Authority: f.k.GetAuthority(), ConnectionId: testConnectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, keeper.GetPortID(types.ProtocolAccountOwner, testConnectionID), res.PortId)
+
+	account, err := f.k.GetDEXAccount(f.ctx, types.ProtocolAccountOwner, testConnectionID)
+	require.NoError(t, err)
+	require.NoError(t, f.k.OnICAAccountCreated(f.ctx, account.PortId, "osmo1protocol"))
+	_, err = f.k.ScopedKeeper.NewCapability(f.ctx, host.ChannelCapabilityPath(account.PortId, "channel-0"))
+	require.NoError(t, err)
+
+	stored, err := f.k.GetDEXAccount(f.ctx, types.ProtocolAccountOwner, testConnectionID)
+	require.NoError(t, err)
+	return f, *stored
+}
+
+// poolAck builds the acknowledgement of a packet answered with one message
+// response of typeURL whose fields are given in order
+func poolAck(t *testing.T, typeURL string, fields ...any) []byte {
+	t.Helper()
+	var value []byte
+	for i, field := range fields {
+		num := protowire.Number(i + 1)
+		switch field := field.(type) {
+		case string:
+			value = protowire.AppendTag(value, num, protowire.BytesType)
+			value = protowire.AppendString(value, field)
+		case sdk.Coins:
+			for _, coin := range field {
+				bz, err := coin.Marshal()
+				require.NoError(t, err)
+				value = protowire.AppendTag(value, num, protowire.BytesType)
+				value = protowire.AppendBytes(value, bz)
+			}
+		}
+	}
+
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{{TypeUrl: typeURL, Value: value}}})
+	require.NoError(t, err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+	bz, err := proto.Marshal(&ack)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestRegisterProtocolAccountAuthority(t *testing.T) {
+	f, _ := setupReserves(t)
+	_, err := f.msgServer.RegisterProtocolAccount(f.ctx, &types.MsgRegisterProtocolAccount{
+		Authority: f.addrs[0].String(), ConnectionId: testConnectionID,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestFundProtocolAccount(t *testing.T) {
+	f, account := setupProtocolAccount(t)
+	transfers := &mockTransferKeeper{}
+	f.k.SetTransferKeeper(transfers)
+	msgServer := keeper.NewMsgServerImpl(f.k)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("usnr", 1000), sdk.NewInt64Coin("uatom", 500))
+
+	_, err := msgServer.FundProtocolAccount(f.ctx, &types.MsgFundProtocolAccount{
+		Authority: f.addrs[0].String(), ConnectionId: testConnectionID, ChannelId: "channel-1", Amount: amount,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := msgServer.FundProtocolAccount(f.ctx, &types.MsgFundProtocolAccount{
+		Authority: f.k.GetAuthority(), ConnectionId: testConnectionID, ChannelId: "channel-1", Amount: amount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, res.Sequences)
+
+	require.Len(t, transfers.sent, 2)
+	for _, sent := range transfers.sent {
+		// Never the dex module account, which escrows OTC offers
+		require.Equal(t, authtypes.NewModuleAddress(types.ProtocolLiquidityAccount).String(), sent.Sender)
+		require.Equal(t, account.AccountAddress, sent.Receiver)
+		require.Equal(t, "channel-1", sent.SourceChannel)
+	}
+}
+
+func TestGovProvideLiquidity(t *testing.T) {
+	f, account := setupProtocolAccount(t)
+	assets := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 2000))
+	msg := &types.MsgGovProvideLiquidity{
+		Authority:      f.k.GetAuthority(),
+		ConnectionId:   testConnectionID,
+		PoolId:         1,
+		Assets:         assets,
+		ShareOutAmount: math.NewInt(5000),
+		LockSeconds:    uint64((30 * 24 * time.Hour).Seconds()),
+	}
+
+	unauthorized := *msg
+	unauthorized.Authority = f.addrs[0].String()
+	_, err := f.msgServer.GovProvideLiquidity(f.ctx, &unauthorized)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	short := *msg
+	short.LockSeconds = 60
+	_, err = f.msgServer.GovProvideLiquidity(f.ctx, &short)
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityParams)
+
+	res, err := f.msgServer.GovProvideLiquidity(f.ctx, msg)
+	require.NoError(t, err)
+
+	position, err := f.k.GetProtocolPosition(f.ctx, testConnectionID, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), position.Pending)
+	require.True(t, position.Provided.IsZero(), "nothing provided before the ack")
+
+	// The pool took less than the most it was offered
+	paid := sdk.NewCoins(sdk.NewInt64Coin("uatom", 990), sdk.NewInt64Coin("uosmo", 2000))
+	packet := channeltypes.Packet{Sequence: res.Sequence, SourcePort: account.PortId, SourceChannel: "channel-0"}
+	ack := poolAck(t, "/osmosis.gamm.v1beta1.MsgJoinPoolResponse", "5000", paid)
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, ack, nil))
+
+	qs := keeper.NewQueryServerImpl(f.k)
+	got, err := qs.ProtocolPosition(f.ctx, &types.QueryProtocolPositionRequest{ConnectionId: testConnectionID, PoolId: 1})
+	require.NoError(t, err)
+	require.Equal(t, paid, got.Position.Provided)
+	require.Equal(t, "5000", got.Position.Shares)
+	require.Equal(t, "5000", got.Position.AvailableShares)
+	require.Equal(t, "osmo1protocol", got.Position.AccountAddress)
+	require.Equal(t, f.ctx.BlockTime().Add(30*24*time.Hour).UTC(), got.Position.UnlockTime)
+	require.True(t, got.Position.Locked)
+	require.Zero(t, got.Position.Pending)
+
+	list, err := qs.ProtocolPositions(f.ctx, &types.QueryProtocolPositionsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, list.Positions, 1)
+	require.Equal(t, uint64(1), list.Pagination.Total)
+}
+
+func TestGovRemoveLiquidityTimelock(t *testing.T) {
+	f, account := setupProtocolAccount(t)
+	remove := &types.MsgGovRemoveLiquidity{
+		Authority: f.k.GetAuthority(), ConnectionId: testConnectionID, PoolId: 1, Shares: math.NewInt(500),
+	}
+
+	_, err := f.msgServer.GovRemoveLiquidity(f.ctx, remove)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+	provided, err := f.msgServer.GovProvideLiquidity(f.ctx, &types.MsgGovProvideLiquidity{
+		Authority:      f.k.GetAuthority(),
+		ConnectionId:   testConnectionID,
+		PoolId:         1,
+		Assets:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 2000)),
+		ShareOutAmount: math.NewInt(800),
+		LockSeconds:    uint64(types.MinProtocolLiquidityLock.Seconds()),
+	})
+	require.NoError(t, err)
+	packet := channeltypes.Packet{Sequence: provided.Sequence, SourcePort: account.PortId, SourceChannel: "channel-0"}
+	ack := poolAck(t, "/osmosis.gamm.v1beta1.MsgJoinPoolResponse", "800", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, ack, nil))
+
+	_, err = f.msgServer.GovRemoveLiquidity(f.ctx, remove)
+	require.ErrorIs(t, err, types.ErrPositionLocked)
+
+	f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(types.MinProtocolLiquidityLock))
+	res, err := f.msgServer.GovRemoveLiquidity(f.ctx, remove)
+	require.NoError(t, err)
+
+	// The shares claimed by the removal in flight cannot be removed twice
+	_, err = f.msgServer.GovRemoveLiquidity(f.ctx, remove)
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityParams)
+
+	// A timed out removal leaves the position as it was
+	packet.Sequence = res.Sequence
+	require.NoError(t, f.k.OnTimeoutPacket(f.ctx, packet, nil))
+	position, err := f.k.GetProtocolPosition(f.ctx, testConnectionID, 1)
+	require.NoError(t, err)
+	require.True(t, position.Removed().IsZero())
+	require.Equal(t, "800", position.Available().String())
+
+	res, err = f.msgServer.GovRemoveLiquidity(f.ctx, remove)
+	require.NoError(t, err)
+	packet.Sequence = res.Sequence
+	out := sdk.NewCoins(sdk.NewInt64Coin("uatom", 600))
+	require.NoError(t, f.k.OnAcknowledgementPacket(f.ctx, packet, poolAck(t, "/osmosis.gamm.v1beta1.MsgExitPoolResponse", out), nil))
+	position, err = f.k.GetProtocolPosition(f.ctx, testConnectionID, 1)
+	require.NoError(t, err)
+	require.Equal(t, "500", position.Removed().String())
+	require.Equal(t, "300", position.Available().String())
+	require.Equal(t, out, position.Withdrawn)
+}
@@ -2,12 +2,22 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/sonr-io/sonr/app/pagination"
 	"github.com/sonr-io/sonr/x/dex/types"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -103,51 +113,247 @@ func (qs queryServer) Balance(ctx context.Context, req *types.QueryBalanceReques
 	}, nil
 }
 
-// TODO: Pool - Implement cross-chain liquidity pool query via IBC
-// This method should query pool information from remote DEX protocols
-// Required implementation steps:
-// 1. Validate request parameters (pool ID, connection ID)
-// 2. Construct IBC query packet for pool state on remote DEX
-// 3. Send IBC query through the appropriate channel
-// 4. Parse pool data including reserves, total shares, and fee parameters
-// 5. Calculate derived metrics (price, APY, volume) if available
-// 6. Cache pool data with appropriate TTL for performance
-// Returns: Pool reserves, LP token supply, fee rate, and current price
-// Pool queries pool information.
+// Pool queries the latest reserves read from a host chain pool with
+// MsgRefreshPoolReserves, and whether they are too old to price swaps.
 func (qs queryServer) Pool(ctx context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	poolID, err := strconv.ParseUint(req.PoolId, 10, 64)
+	if err != nil || poolID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool id %q", req.PoolId)
+	}
 
-	// TODO: Implement pool query via ICA
-	// This would require querying the remote chain through IBC
-	return &types.QueryPoolResponse{}, nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reserves, err := qs.GetPoolReserves(sdkCtx, req.ConnectionId, poolID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no reserves for pool %d on %s", poolID, req.ConnectionId)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolResponse{
+		Pool: &types.PoolInfo{
+			PoolId:     req.PoolId,
+			Assets:     reserves.Reserves,
+			HostHeight: reserves.HostHeight,
+			UpdatedAt:  time.Unix(reserves.UpdatedAt, 0).UTC(),
+			Stale:      !reserves.Fresh(sdkCtx.BlockTime(), qs.GetPricingParams(sdkCtx).ReservesTTL()),
+		},
+	}, nil
 }
 
-// TODO: Orders - Implement order book query for user's limit orders
-// This method should retrieve all orders for a specific DID across connections
-// Required implementation steps:
-// 1. Validate request parameters (DID, optional status filter)
-// 2. Query local state for stored order records by DID
-// 3. Filter orders by status (open, filled, cancelled) if specified
-// 4. For open orders, optionally query remote chain for current status
-// 5. Sort orders by creation time or specified sort parameter
-// 6. Apply pagination if limits are provided
-// 7. Include order fills and partial fill information
-// Returns: List of orders with status, amounts, prices, and timestamps
-// Orders queries orders for a DID.
-func (qs queryServer) Orders(ctx context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
+// EstimateSwap quotes a swap: the platform fee is taken from the input and
+// the rest is priced with EstimateSwapPrice.
+func (qs queryServer) EstimateSwap(ctx context.Context, req *types.QueryEstimateSwapRequest) (*types.QueryEstimateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "connection id is required")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token_in: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := qs.ValidateSwapParameters(sdkCtx, req.ConnectionId, tokenIn, req.TokenOutDenom, math.ZeroInt()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fee, net, err := qs.DeductFee(sdkCtx, types.FeeOperationSwap, req.ConnectionId, tokenIn)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out, source, err := qs.EstimateSwapPrice(sdkCtx, req.ConnectionId, req.PoolId, net, req.TokenOutDenom)
+	if errors.Is(err, types.ErrPriceUnavailable) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryEstimateSwapResponse{
+		TokenOut: sdk.NewCoin(req.TokenOutDenom, out),
+		Fee:      fee,
+		Source:   source,
+	}
+	if net.IsPositive() {
+		res.Price = math.LegacyNewDecFromInt(out).QuoInt(net.Amount).String()
+	}
+	return res, nil
+}
+
+// SwapRoute finds the route through configured pools with the best
+// estimated output for the input left after the platform fee
+func (qs queryServer) SwapRoute(ctx context.Context, req *types.QuerySwapRouteRequest) (*types.QuerySwapRouteResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "connection id is required")
+	}
+	if req.MaxHops > types.MaxRouteHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops cannot exceed %d", types.MaxRouteHops)
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token_in: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := qs.ValidateSwapParameters(sdkCtx, req.ConnectionId, tokenIn, req.TokenOutDenom, math.ZeroInt()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fee, net, err := qs.DeductFee(sdkCtx, types.FeeOperationSwap, req.ConnectionId, tokenIn)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	quote, err := qs.BestSwapRoute(sdkCtx, req.ConnectionId, net, req.TokenOutDenom, int(req.MaxHops))
+	if errors.Is(err, types.ErrNoSwapRoute) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	// TODO: Implement orders query
-	// This would require storing order information in state or DWN
-	return &types.QueryOrdersResponse{
-		Orders: []*types.Order{}, // Empty for now
+	return &types.QuerySwapRouteResponse{
+		Route:      types.FormatSwapRoute(quote.Route),
+		TokenOut:   quote.TokenOut,
+		Fee:        fee,
+		Hops:       quote.Hops,
+		Candidates: uint32(quote.Candidates),
 	}, nil
 }
 
+// Orders queries the limit orders placed by a DID, oldest first. The
+// connection and status filters are optional.
+func (qs queryServer) Orders(ctx context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Did == "" {
+		return nil, status.Error(codes.InvalidArgument, "did is required")
+	}
+
+	orders, pageRes, err := qs.listOrders(sdk.UnwrapSDKContext(ctx), req.Did, req.ConnectionId, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// OrdersByDID queries the limit orders placed by a DID on every connection,
+// oldest first. The status filter is optional.
+func (qs queryServer) OrdersByDID(ctx context.Context, req *types.QueryOrdersByDIDRequest) (*types.QueryOrdersByDIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Did == "" {
+		return nil, status.Error(codes.InvalidArgument, "did is required")
+	}
+
+	orders, pageRes, err := qs.listOrders(sdk.UnwrapSDKContext(ctx), req.Did, "", req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOrdersByDIDResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// listOrders pages through a DID's orders, keeping those on connectionID (any
+// connection when empty) in the named status (any status when empty)
+func (qs queryServer) listOrders(
+	sdkCtx sdk.Context,
+	did, connectionID, orderStatus string,
+	pageReq *query.PageRequest,
+) ([]*types.Order, *query.PageResponse, error) {
+	var (
+		wantStatus types.LimitOrderStatus
+		err        error
+	)
+	if orderStatus != "" {
+		if wantStatus, err = types.ParseLimitOrderStatus(orderStatus); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	page, err := pagination.Parse(
+		pageReq,
+		fmt.Sprintf("dex/Orders/%s/%s/%s", did, connectionID, orderStatus),
+	)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](did)
+	if len(page.Key) > 0 {
+		if len(page.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "malformed pagination key")
+		}
+		last := binary.BigEndian.Uint64(page.Key)
+		if page.Reverse {
+			rng = rng.EndExclusive(last)
+		} else {
+			rng = rng.StartExclusive(last)
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := qs.LimitOrdersByDID.Iterate(sdkCtx, rng)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	orders, pageRes, err := pagination.Collect(page, func() (types.LimitOrder, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.LimitOrder{}, nil, false, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return types.LimitOrder{}, nil, false, err
+		}
+		iter.Next()
+
+		order, err := qs.LimitOrders.Get(sdkCtx, key.K2())
+		if err != nil {
+			return types.LimitOrder{}, nil, false, err
+		}
+		return order, binary.BigEndian.AppendUint64(nil, order.Id), true, nil
+	}, func(order types.LimitOrder) bool {
+		if connectionID != "" && order.ConnectionId != connectionID {
+			return false
+		}
+		return orderStatus == "" || order.Status == wantStatus
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*types.Order, 0, len(orders))
+	for _, order := range orders {
+		res = append(res, &types.Order{
+			OrderId:      fmt.Sprintf("%d", order.Id),
+			OrderType:    "limit",
+			SellDenom:    order.TokenIn.Denom,
+			BuyDenom:     order.TokenOutDenom,
+			Amount:       order.TokenIn.Amount.String(),
+			Price:        order.Price,
+			Status:       order.Status.String(),
+			CreatedAt:    fmt.Sprintf("%d", order.CreatedHeight),
+			FilledAmount: order.Filled().String(),
+		})
+	}
+	return res, pageRes, nil
+}
+
 // TODO: History - Implement transaction history query from DWN storage
 // This method should retrieve complete transaction history for a DID
 // Required implementation steps:
@@ -172,3 +378,466 @@ func (qs queryServer) History(ctx context.Context, req *types.QueryHistoryReques
 		Transactions: []*types.Transaction{}, // Empty for now
 	}, nil
 }
+
+// DenomFilter queries the denom allow/deny list for a connection.
+func (qs queryServer) DenomFilter(ctx context.Context, req *types.QueryDenomFilterRequest) (*types.QueryDenomFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "connection_id is required")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	filter, found, err := qs.Keeper.GetDenomFilter(sdkCtx, req.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		filter.ConnectionId = req.ConnectionId
+	}
+
+	return &types.QueryDenomFilterResponse{Filter: filter, Found: found}, nil
+}
+
+// DailyVolume queries a DID's swap volume today and its remaining allowance.
+func (qs queryServer) DailyVolume(ctx context.Context, req *types.QueryDailyVolumeRequest) (*types.QueryDailyVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Did == "" {
+		return nil, status.Error(codes.InvalidArgument, "did is required")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	used, limit, capped, err := qs.Keeper.GetDailyVolume(sdkCtx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryDailyVolumeResponse{
+		Day:  types.OpsDay(sdkCtx.BlockTime()),
+		Used: used.String(),
+	}
+	if capped {
+		res.Cap = limit.String()
+		res.Remaining = math.LegacyMaxDec(limit.Sub(used), math.LegacyZeroDec()).String()
+	}
+	return res, nil
+}
+
+// CollectedFees queries the platform fees collected on a connection.
+func (qs queryServer) CollectedFees(ctx context.Context, req *types.QueryCollectedFeesRequest) (*types.QueryCollectedFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "connection id is required")
+	}
+
+	fees, err := qs.Keeper.GetCollectedFees(sdk.UnwrapSDKContext(ctx), req.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollectedFeesResponse{Fees: fees}, nil
+}
+
+// OTCOffer queries an OTC offer by ID.
+func (qs queryServer) OTCOffer(ctx context.Context, req *types.QueryOTCOfferRequest) (*types.QueryOTCOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	offer, err := qs.Keeper.GetOTCOffer(sdk.UnwrapSDKContext(ctx), req.OfferId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryOTCOfferResponse{Offer: otcOfferInfo(offer)}, nil
+}
+
+// OTCOffers queries OTC offers, oldest first. With a DID only the offers it
+// makes, takes or arbitrates are listed; the status filter is optional.
+func (qs queryServer) OTCOffers(ctx context.Context, req *types.QueryOTCOffersRequest) (*types.QueryOTCOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		wantStatus types.OTCOfferStatus
+		err        error
+	)
+	if req.Status != "" {
+		if wantStatus, err = types.ParseOTCOfferStatus(req.Status); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	page, err := pagination.Parse(req.Pagination, fmt.Sprintf("dex/OTCOffers/%s/%s", req.Did, req.Status))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var last uint64
+	if len(page.Key) > 0 {
+		if len(page.Key) != 8 {
+			return nil, status.Error(codes.InvalidArgument, "malformed pagination key")
+		}
+		last = binary.BigEndian.Uint64(page.Key)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var next func() (uint64, bool, error)
+	if req.Did != "" {
+		rng := collections.NewPrefixedPairRange[string, uint64](req.Did)
+		if len(page.Key) > 0 {
+			if page.Reverse {
+				rng = rng.EndExclusive(last)
+			} else {
+				rng = rng.StartExclusive(last)
+			}
+		}
+		if page.Reverse {
+			rng = rng.Descending()
+		}
+		iter, err := qs.OTCOffersByDID.Iterate(sdkCtx, rng)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer iter.Close()
+		next = func() (uint64, bool, error) {
+			if !iter.Valid() {
+				return 0, false, nil
+			}
+			key, err := iter.Key()
+			iter.Next()
+			return key.K2(), err == nil, err
+		}
+	} else {
+		rng := new(collections.Range[uint64])
+		if len(page.Key) > 0 {
+			if page.Reverse {
+				rng = rng.EndExclusive(last)
+			} else {
+				rng = rng.StartExclusive(last)
+			}
+		}
+		if page.Reverse {
+			rng = rng.Descending()
+		}
+		iter, err := qs.OTCOffers.Iterate(sdkCtx, rng)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer iter.Close()
+		next = func() (uint64, bool, error) {
+			if !iter.Valid() {
+				return 0, false, nil
+			}
+			key, err := iter.Key()
+			iter.Next()
+			return key, err == nil, err
+		}
+	}
+
+	offers, pageRes, err := pagination.Collect(page, func() (types.OTCOffer, []byte, bool, error) {
+		id, ok, err := next()
+		if !ok || err != nil {
+			return types.OTCOffer{}, nil, false, err
+		}
+		offer, err := qs.OTCOffers.Get(sdkCtx, id)
+		if err != nil {
+			return types.OTCOffer{}, nil, false, err
+		}
+		return offer, binary.BigEndian.AppendUint64(nil, offer.Id), true, nil
+	}, func(offer types.OTCOffer) bool {
+		return req.Status == "" || offer.Status == wantStatus
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryOTCOffersResponse{
+		Offers:     make([]*types.OTCOfferInfo, 0, len(offers)),
+		Pagination: pageRes,
+	}
+	for _, offer := range offers {
+		res.Offers = append(res.Offers, otcOfferInfo(offer))
+	}
+	return res, nil
+}
+
+// otcOfferInfo converts a stored offer to its query representation
+func otcOfferInfo(offer types.OTCOffer) *types.OTCOfferInfo {
+	return &types.OTCOfferInfo{
+		OfferId:        offer.Id,
+		MakerDid:       offer.MakerDid,
+		TakerDid:       offer.TakerDid,
+		ArbiterDid:     offer.ArbiterDid,
+		Offer:          offer.Offer,
+		Ask:            offer.Ask,
+		Status:         offer.Status.String(),
+		Expiration:     offer.Expiration,
+		MakerConfirmed: offer.MakerConfirmed,
+		TakerConfirmed: offer.TakerConfirmed,
+		CreatedHeight:  offer.CreatedHeight,
+	}
+}
+
+// TWAMMOrder queries the progress of a TWAMM order.
+func (qs queryServer) TWAMMOrder(ctx context.Context, req *types.QueryTWAMMOrderRequest) (*types.QueryTWAMMOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	order, err := qs.Keeper.GetTWAMMOrder(sdk.UnwrapSDKContext(ctx), req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	info := &types.TWAMMOrderInfo{
+		OrderId:        order.Id,
+		Did:            order.Did,
+		ConnectionId:   order.ConnectionId,
+		PoolId:         order.PoolId,
+		TokenIn:        order.TokenIn,
+		TokenOutDenom:  order.TokenOutDenom,
+		MinAmountOut:   order.MinAmountOut,
+		TotalSlices:    order.TotalSlices,
+		IntervalBlocks: order.IntervalBlocks,
+		SlicesExecuted: order.SlicesExecuted,
+		SlicesSkipped:  order.SlicesSkipped,
+		AmountExecuted: order.AmountExecuted,
+		CreatedHeight:  order.CreatedHeight,
+		Status:         order.Status.String(),
+	}
+	if order.Status == types.TWAMMOrderStatusActive {
+		info.NextExecutionHeight = order.NextExecutionHeight
+	}
+	return &types.QueryTWAMMOrderResponse{Order: info}, nil
+}
+
+// PriceHistory queries the aggregated prices of a denom between two unix
+// times, oldest first. A zero to reads up to the block time; a zero
+// resolution returns one entry per epoch.
+func (qs queryServer) PriceHistory(ctx context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil || req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom is required")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	to := sdkCtx.BlockTime()
+	if req.To != 0 {
+		to = time.Unix(req.To, 0)
+	}
+	resolution := time.Duration(req.ResolutionSeconds) * time.Second
+
+	epochs, err := qs.Keeper.QueryPriceHistory(sdkCtx, req.Denom, time.Unix(req.From, 0), to, resolution)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	epochSeconds := qs.Keeper.GetPriceHistoryParams(sdkCtx).EpochSeconds
+	prices := make([]types.PriceEpochInfo, 0, len(epochs))
+	for _, epoch := range epochs {
+		average, err := epoch.Average()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		prices = append(prices, types.PriceEpochInfo{
+			StartTime: int64(epoch.Epoch * epochSeconds),
+			Open:      epoch.Open,
+			High:      epoch.High,
+			Low:       epoch.Low,
+			Close:     epoch.Close,
+			Average:   average.String(),
+			Samples:   epoch.Samples,
+		})
+	}
+	return &types.QueryPriceHistoryResponse{Prices: prices}, nil
+}
+
+// DWNActivity reads the DEX activity stored in a DID's DWN vault
+func (qs queryServer) DWNActivity(ctx context.Context, req *types.QueryDWNActivityRequest) (*types.QueryDWNActivityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Did == "" {
+		return nil, status.Error(codes.InvalidArgument, "did is required")
+	}
+	if qs.dwnKeeper == nil {
+		return nil, status.Error(codes.Unavailable, "DWN storage is not configured")
+	}
+
+	page, err := pagination.Parse(req.Pagination, fmt.Sprintf("dex/DWNActivity/%s", req.Did))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rng := collections.NewPrefixedPairRange[string, string](req.Did)
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			rng = rng.EndExclusive(string(page.Key))
+		} else {
+			rng = rng.StartExclusive(string(page.Key))
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iter, err := qs.DWNActivityRecords.Iterate(sdkCtx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	entries, pageRes, err := pagination.Collect(page, func() (*types.DWNActivityEntry, []byte, bool, error) {
+		if !iter.Valid() {
+			return nil, nil, false, nil
+		}
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		iter.Next()
+
+		// Records the user removed from their vault are skipped
+		activityKey := kv.Key.K2()
+		entry, err := qs.ReadDWNActivity(sdkCtx, req.Did, activityKey, kv.Value)
+		if errors.Is(err, dwntypes.ErrRecordNotFound) {
+			return nil, []byte(activityKey), true, nil
+		}
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to read activity %s: %w", activityKey, err)
+		}
+		return &entry, []byte(activityKey), true, nil
+	}, func(entry *types.DWNActivityEntry) bool {
+		return entry != nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDWNActivityResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// ICAAddress returns the controller port and owner a DEX account on a
+// connection is registered under, and its host chain address once opened.
+func (qs queryServer) ICAAddress(ctx context.Context, req *types.QueryICAAddressRequest) (*types.QueryICAAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Did == "" || req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "did and connection_id are required")
+	}
+
+	res, err := qs.GetICAAddress(sdk.UnwrapSDKContext(ctx), req.Did, req.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
+// ProtocolPosition returns the protocol-owned liquidity in a pool.
+func (qs queryServer) ProtocolPosition(ctx context.Context, req *types.QueryProtocolPositionRequest) (*types.QueryProtocolPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	position, err := qs.GetProtocolPosition(sdkCtx, req.ConnectionId, req.PoolId)
+	if errors.Is(err, types.ErrPositionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	info := qs.protocolPositionInfo(sdkCtx, position)
+	return &types.QueryProtocolPositionResponse{Position: &info}, nil
+}
+
+// ProtocolPositions lists the protocol-owned liquidity in every pool,
+// ordered by connection and pool ID.
+func (qs queryServer) ProtocolPositions(ctx context.Context, req *types.QueryProtocolPositionsRequest) (*types.QueryProtocolPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	page, err := pagination.Parse(req.Pagination, "dex/ProtocolPositions")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	keyCodec := qs.ProtocolPositions.KeyCodec()
+	rng := new(collections.Range[collections.Pair[string, uint64]])
+	if len(page.Key) > 0 {
+		_, last, err := keyCodec.Decode(page.Key)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "malformed pagination key")
+		}
+		if page.Reverse {
+			rng = rng.EndExclusive(last)
+		} else {
+			rng = rng.StartExclusive(last)
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iter, err := qs.Keeper.ProtocolPositions.Iterate(sdkCtx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	positions, pageRes, err := pagination.Collect(page, func() (*types.ProtocolPositionInfo, []byte, bool, error) {
+		if !iter.Valid() {
+			return nil, nil, false, nil
+		}
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		iter.Next()
+
+		key := make([]byte, keyCodec.Size(kv.Key))
+		if _, err := keyCodec.Encode(key, kv.Key); err != nil {
+			return nil, nil, false, err
+		}
+		info := qs.protocolPositionInfo(sdkCtx, kv.Value)
+		return &info, key, true, nil
+	}, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryProtocolPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
+// protocolPositionInfo reports a protocol position with the host chain
+// address of the protocol account holding it
+func (qs queryServer) protocolPositionInfo(ctx sdk.Context, position types.ProtocolPosition) types.ProtocolPositionInfo {
+	info := types.ProtocolPositionInfo{
+		ConnectionId:    position.ConnectionId,
+		PoolId:          position.PoolId,
+		Provided:        position.Provided,
+		SharesRemoved:   position.Removed().String(),
+		UnlockTime:      time.Unix(position.UnlockTime, 0).UTC(),
+		Locked:          position.Locked(ctx.BlockTime()),
+		Pending:         position.Pending,
+		Shares:          position.Minted().String(),
+		AvailableShares: position.Available().String(),
+		Withdrawn:       position.Withdrawn,
+	}
+	if account, err := qs.GetDEXAccount(ctx, types.ProtocolAccountOwner, position.ConnectionId); err == nil {
+		info.AccountAddress = account.AccountAddress
+	}
+	return info
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// ConsumeRateLimit counts a swap or order submitted by did against the rate
+// limit params. It rejects the operation when the block already holds
+// max_ops_per_block operations, when the DID reached max_ops_per_did_per_day
+// today, or when the DID's previous operation is less than cooldown_blocks
+// old. Zero limits are not enforced. DIDs holding a verification badge get
+// their daily limit raised by verified_multiplier.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, did string) error {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	limits := params.RateLimits
+
+	blockOps, err := k.BlockOps.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if limits.MaxOpsPerBlock > 0 && blockOps >= limits.MaxOpsPerBlock {
+		return errorsmod.Wrapf(types.ErrBlockRateLimited, "%d operations at height %d", blockOps, ctx.BlockHeight())
+	}
+
+	counter, err := k.DIDOpCounters.Get(ctx, did)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	height := ctx.BlockHeight()
+	if limits.CooldownBlocks > 0 && counter.LastHeight > 0 &&
+		height-counter.LastHeight < int64(limits.CooldownBlocks) {
+		return errorsmod.Wrapf(
+			types.ErrRateLimitCooldown,
+			"%s may act again at height %d", did, counter.LastHeight+int64(limits.CooldownBlocks),
+		)
+	}
+
+	day := types.OpsDay(ctx.BlockTime())
+	if counter.Day != day {
+		counter = types.DIDOpCounter{Day: day}
+	}
+	maxOps := limits.MaxOpsPerDidPerDay
+	if maxOps > 0 {
+		multiplier, err := k.verifiedMultiplier(ctx, did, limits)
+		if err != nil {
+			return err
+		}
+		maxOps *= multiplier
+	}
+	if maxOps > 0 && counter.Ops >= maxOps {
+		return errorsmod.Wrapf(types.ErrDIDRateLimited, "%s made %d operations today", did, counter.Ops)
+	}
+
+	counter.Ops++
+	counter.LastHeight = height
+	if err := k.DIDOpCounters.Set(ctx, did, counter); err != nil {
+		return err
+	}
+	return k.BlockOps.Set(ctx, blockOps+1)
+}
+
+// verifiedMultiplier returns the factor the DID's daily limits are raised by:
+// verified_multiplier for DIDs holding a verification badge, 1 otherwise
+func (k Keeper) verifiedMultiplier(ctx sdk.Context, did string, limits types.RateLimitParams) (uint32, error) {
+	if limits.VerifiedMultiplier <= 1 || k.badgeKeeper == nil {
+		return 1, nil
+	}
+	verified, err := k.badgeKeeper.IsVerified(ctx, did)
+	if err != nil {
+		return 0, err
+	}
+	if !verified {
+		return 1, nil
+	}
+	return limits.VerifiedMultiplier, nil
+}
+
+// ResetBlockOps clears the per-block operation counter at the start of a block
+func (k Keeper) ResetBlockOps(ctx sdk.Context) error {
+	return k.BlockOps.Remove(ctx)
+}
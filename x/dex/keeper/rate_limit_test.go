@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func setRateLimits(t *testing.T, f *testFixture, limits types.RateLimitParams) {
+	t.Helper()
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{RateLimits: limits}))
+}
+
+func TestRateLimitPerBlock(t *testing.T) {
+	f := SetupTest(t)
+	setRateLimits(t, f, types.RateLimitParams{MaxOpsPerBlock: 2})
+
+	require.NoError(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:alice"))
+	require.NoError(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:bob"))
+	require.ErrorIs(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:carol"), types.ErrBlockRateLimited)
+
+	// The counter starts over in the next block
+	require.NoError(t, f.k.ResetBlockOps(f.ctx))
+	require.NoError(t, f.k.ConsumeRateLimit(f.ctx.WithBlockHeight(2), "did:sonr:carol"))
+}
+
+func TestRateLimitPerDIDPerDay(t *testing.T) {
+	f := SetupTest(t)
+	setRateLimits(t, f, types.RateLimitParams{MaxOpsPerDidPerDay: 2})
+	day := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := f.ctx.WithBlockTime(day)
+
+	require.NoError(t, f.k.ConsumeRateLimit(ctx, "did:sonr:alice"))
+	require.NoError(t, f.k.ConsumeRateLimit(ctx, "did:sonr:alice"))
+	require.ErrorIs(t, f.k.ConsumeRateLimit(ctx, "did:sonr:alice"), types.ErrDIDRateLimited)
+	require.NoError(t, f.k.ConsumeRateLimit(ctx, "did:sonr:bob"))
+
+	// A new UTC day resets the count
+	next := ctx.WithBlockTime(day.Add(12 * time.Hour))
+	require.NoError(t, f.k.ConsumeRateLimit(next, "did:sonr:alice"))
+	counter, err := f.k.DIDOpCounters.Get(next, "did:sonr:alice")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), counter.Ops)
+}
+
+func TestRateLimitCooldown(t *testing.T) {
+	f := SetupTest(t)
+	setRateLimits(t, f, types.RateLimitParams{CooldownBlocks: 3})
+	ctx := f.ctx.WithBlockHeight(10)
+
+	require.NoError(t, f.k.ConsumeRateLimit(ctx, "did:sonr:alice"))
+	require.ErrorIs(t, f.k.ConsumeRateLimit(ctx.WithBlockHeight(12), "did:sonr:alice"), types.ErrRateLimitCooldown)
+	require.NoError(t, f.k.ConsumeRateLimit(ctx.WithBlockHeight(12), "did:sonr:bob"))
+	require.NoError(t, f.k.ConsumeRateLimit(ctx.WithBlockHeight(13), "did:sonr:alice"))
+}
+
+func TestRateLimitRejectsSwap(t *testing.T) {
+	f := SetupTest(t)
+	setRateLimits(t, f, types.RateLimitParams{MaxOpsPerDidPerDay: 1})
+	require.NoError(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:alice"))
+
+	_, err := f.msgServer.ExecuteSwap(f.ctx, &types.MsgExecuteSwap{
+		Did:          "did:sonr:alice",
+		ConnectionId: testConnectionID,
+		Route:        "pool:1",
+	})
+	require.ErrorIs(t, err, types.ErrDIDRateLimited)
+}
+
+// mockBadgeKeeper reports the DIDs in verified as holding a badge
+type mockBadgeKeeper struct {
+	verified map[string]bool
+}
+
+func (m mockBadgeKeeper) IsVerified(_ context.Context, did string) (bool, error) {
+	return m.verified[did], nil
+}
+
+func TestRateLimitVerifiedMultiplier(t *testing.T) {
+	f := SetupTest(t)
+	setRateLimits(t, f, types.RateLimitParams{MaxOpsPerDidPerDay: 1, VerifiedMultiplier: 3})
+	f.k.SetBadgeKeeper(mockBadgeKeeper{verified: map[string]bool{"did:sonr:alice": true}})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:alice"))
+	}
+	require.ErrorIs(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:alice"), types.ErrDIDRateLimited)
+
+	require.NoError(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:bob"))
+	require.ErrorIs(t, f.k.ConsumeRateLimit(f.ctx, "did:sonr:bob"), types.ErrDIDRateLimited)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// SwapRouteQuote is the route selected for a swap and its estimate
+type SwapRouteQuote struct {
+	// Route is resolved, every hop naming its output denom
+	Route []types.SwapAmountInRoute
+	// Hops holds the estimate of each hop
+	Hops []types.SwapRouteHop
+	// TokenOut is the expected output after pool fees
+	TokenOut sdk.Coin
+	// Candidates is the number of routes compared
+	Candidates int
+}
+
+// BestSwapRoute selects the route through the connection's configured pools
+// with the highest estimated output. Each hop is priced with
+// EstimateSwapPrice and pays its pool's swap fee; routes through denoms the
+// connection does not allow, or that cannot be priced, are skipped. Ties go
+// to the route with fewer hops. maxHops limits the route length below the
+// routing params when positive.
+func (k Keeper) BestSwapRoute(
+	ctx sdk.Context,
+	connectionID string,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops int,
+) (SwapRouteQuote, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return SwapRouteQuote{}, err
+	}
+	limit := params.Routing.MaxHopsOrDefault()
+	if maxHops > 0 && maxHops < limit {
+		limit = maxHops
+	}
+
+	candidates := types.CandidateRoutes(params.Routing.PoolsFor(connectionID), tokenIn.Denom, tokenOutDenom, limit)
+	var (
+		best  SwapRouteQuote
+		found bool
+	)
+	for _, candidate := range candidates {
+		quote, ok, err := k.quoteSwapRoute(ctx, connectionID, tokenIn, candidate)
+		if err != nil {
+			return SwapRouteQuote{}, err
+		}
+		if ok && (!found || quote.TokenOut.Amount.GT(best.TokenOut.Amount)) {
+			best, found = quote, true
+		}
+	}
+	if !found {
+		return SwapRouteQuote{}, errorsmod.Wrapf(
+			types.ErrNoSwapRoute,
+			"%s to %s on %s within %d hops", tokenIn.Denom, tokenOutDenom, connectionID, limit,
+		)
+	}
+	best.Candidates = len(candidates)
+	return best, nil
+}
+
+// quoteSwapRoute estimates the output of a candidate route. It returns false
+// for routes that cannot be taken or priced.
+func (k Keeper) quoteSwapRoute(
+	ctx sdk.Context,
+	connectionID string,
+	tokenIn sdk.Coin,
+	candidate types.CandidateRoute,
+) (SwapRouteQuote, bool, error) {
+	quote := SwapRouteQuote{Route: candidate.Hops}
+	coin := tokenIn
+	for i, hop := range candidate.Hops {
+		allowed, err := k.IsDenomAllowed(ctx, connectionID, hop.TokenOutDenom)
+		if err != nil {
+			return SwapRouteQuote{}, false, err
+		}
+		if !allowed {
+			return SwapRouteQuote{}, false, nil
+		}
+
+		out, source, err := k.EstimateSwapPrice(ctx, connectionID, hop.PoolId, coin, hop.TokenOutDenom)
+		if errors.Is(err, types.ErrPriceUnavailable) {
+			return SwapRouteQuote{}, false, nil
+		}
+		if err != nil {
+			return SwapRouteQuote{}, false, err
+		}
+
+		poolFee := out.MulRaw(int64(candidate.Pools[i].SwapFeeBps)).QuoRaw(types.MaxFeeBps)
+		out = out.Sub(poolFee)
+		if !out.IsPositive() {
+			return SwapRouteQuote{}, false, nil
+		}
+
+		coin = sdk.NewCoin(hop.TokenOutDenom, out)
+		quote.Hops = append(quote.Hops, types.SwapRouteHop{
+			PoolId:   hop.PoolId,
+			TokenOut: coin,
+			PoolFee:  sdk.NewCoin(hop.TokenOutDenom, poolFee),
+			Source:   source,
+		})
+	}
+	quote.TokenOut = coin
+	return quote, true, nil
+}
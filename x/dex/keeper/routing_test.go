@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// setupRouting configures a thin uatom/ujuno pool next to deep pools that
+// pair both with USDC, all with fresh reserves
+func setupRouting(t *testing.T) *testFixture {
+	t.Helper()
+	f := setupEstimate(t)
+	f.ctx = f.ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.Routing = types.RoutingParams{Pools: []types.SwapPool{
+		{ConnectionId: testConnectionID, PoolId: 1, Denoms: []string{"uatom", "ujuno"}, SwapFeeBps: 20},
+		{ConnectionId: testConnectionID, PoolId: 2, Denoms: []string{"uatom", "uusdc"}, SwapFeeBps: 20},
+		{ConnectionId: testConnectionID, PoolId: 3, Denoms: []string{"uusdc", "ujuno"}, SwapFeeBps: 20},
+		{ConnectionId: "connection-1", PoolId: 4, Denoms: []string{"uatom", "ujuno"}},
+	}}
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	for id, reserves := range map[uint64]sdk.Coins{
+		1: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("ujuno", 1_000)),
+		2: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uusdc", 10_000_000)),
+		3: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10_000_000), sdk.NewInt64Coin("ujuno", 1_000_000)),
+	} {
+		require.NoError(t, f.k.PoolReserves.Set(f.ctx, collections.Join(testConnectionID, id), types.PoolReserves{
+			ConnectionId: testConnectionID,
+			PoolId:       id,
+			Reserves:     reserves,
+			UpdatedAt:    f.ctx.BlockTime().Unix(),
+		}))
+	}
+	return f
+}
+
+func TestSwapRouteThroughUSDC(t *testing.T) {
+	f := setupRouting(t)
+
+	res, err := f.queryServer.SwapRoute(f.ctx, &types.QuerySwapRouteRequest{
+		ConnectionId:  testConnectionID,
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "ujuno",
+	})
+	require.NoError(t, err)
+
+	// 9,970 uatom after the platform fee beats the thin direct pool (907
+	// ujuno) by going through USDC
+	require.Equal(t, "pool:2:uusdc,pool:3:ujuno", res.Route)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 30), res.Fee)
+	require.Equal(t, sdk.NewInt64Coin("ujuno", 9_736), res.TokenOut)
+	require.EqualValues(t, 2, res.Candidates)
+	require.Equal(t, []types.SwapRouteHop{
+		{PoolId: 2, TokenOut: sdk.NewInt64Coin("uusdc", 98_518), PoolFee: sdk.NewInt64Coin("uusdc", 197), Source: keeper.PriceSourceReserves},
+		{PoolId: 3, TokenOut: sdk.NewInt64Coin("ujuno", 9_736), PoolFee: sdk.NewInt64Coin("ujuno", 19), Source: keeper.PriceSourceReserves},
+	}, res.Hops)
+
+	// The route is accepted by the swap message
+	hops, err := types.ParseSwapRoute(res.Route)
+	require.NoError(t, err)
+	_, err = types.ResolveSwapRoute(hops, "uatom", "ujuno")
+	require.NoError(t, err)
+}
+
+func TestSwapRouteMaxHops(t *testing.T) {
+	f := setupRouting(t)
+
+	res, err := f.queryServer.SwapRoute(f.ctx, &types.QuerySwapRouteRequest{
+		ConnectionId:  testConnectionID,
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "ujuno",
+		MaxHops:       1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pool:1:ujuno", res.Route)
+	require.Equal(t, sdk.NewInt64Coin("ujuno", 907), res.TokenOut)
+	require.EqualValues(t, 1, res.Candidates)
+
+	_, err = f.queryServer.SwapRoute(f.ctx, &types.QuerySwapRouteRequest{
+		ConnectionId:  testConnectionID,
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "ujuno",
+		MaxHops:       types.MaxRouteHops + 1,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSwapRouteSkipsDeniedIntermediates(t *testing.T) {
+	f := setupRouting(t)
+	_, err := f.msgServer.SetDenomFilter(f.ctx, &types.MsgSetDenomFilter{
+		Authority: f.govModAddr,
+		Filter:    types.DenomFilter{ConnectionId: testConnectionID, DeniedDenoms: []string{"uusdc"}},
+	})
+	require.NoError(t, err)
+
+	quote, err := f.k.BestSwapRoute(f.ctx, testConnectionID, sdk.NewInt64Coin("uatom", 9_970), "ujuno", 0)
+	require.NoError(t, err)
+	require.Equal(t, "pool:1:ujuno", types.FormatSwapRoute(quote.Route))
+}
+
+func TestSwapRouteNotFound(t *testing.T) {
+	f := setupRouting(t)
+
+	_, err := f.k.BestSwapRoute(f.ctx, testConnectionID, sdk.NewInt64Coin("uatom", 9_970), "uosmo", 0)
+	require.ErrorIs(t, err, types.ErrNoSwapRoute)
+
+	// Pools are only used by their own connection
+	_, err = f.queryServer.SwapRoute(f.ctx, &types.QuerySwapRouteRequest{
+		ConnectionId:  "connection-2",
+		TokenIn:       "10000uatom",
+		TokenOutDenom: "ujuno",
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// Screen passes an operation about to move funds to the compliance screener
+// when the screening params enable it and the operation reaches a threshold.
+// Every decision is emitted as a screening_decision event and recorded in
+// the DID's activity history. In block mode flagged operations are refused, as are
+// operations that could not be screened because no screener is configured
+// or it failed; a refused transaction keeps no state, so those decisions are
+// only kept in the node's log.
+func (k Keeper) Screen(ctx sdk.Context, req types.ScreeningRequest) error {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	screening := params.Screening
+	if screening.Mode == types.SCREENING_MODE_OFF {
+		return nil
+	}
+
+	req.ValueUSD = k.screeningValue(ctx, req.Amount)
+	if !screening.Screens(req.Amount, req.ValueUSD) {
+		return nil
+	}
+
+	var decision types.ScreeningDecision
+	if k.screener == nil {
+		err = errors.New("no screener configured")
+	} else {
+		decision, err = k.screener.Screen(ctx, req)
+	}
+
+	outcome := types.ScreeningCleared
+	switch {
+	case err != nil:
+		outcome = types.ScreeningFailed
+		decision.Reason = err.Error()
+	case decision.Flagged && screening.Mode == types.SCREENING_MODE_BLOCK:
+		outcome = types.ScreeningBlocked
+	case decision.Flagged:
+		outcome = types.ScreeningFlagged
+	}
+
+	logger := k.Logger(ctx).With(
+		"operation", req.Operation,
+		"did", req.DID,
+		"amount", req.Amount.String(),
+		"outcome", outcome,
+		"provider", decision.Provider,
+		"party", decision.Party,
+		"reason", decision.Reason,
+	)
+	if screening.Mode == types.SCREENING_MODE_BLOCK {
+		switch outcome {
+		case types.ScreeningBlocked:
+			logger.Error("compliance screening blocked operation")
+			return errorsmod.Wrapf(
+				types.ErrScreeningBlocked,
+				"%s is listed by %s: %s", decision.Party, decision.Provider, decision.Reason,
+			)
+		case types.ScreeningFailed:
+			logger.Error("compliance screening failed")
+			return errorsmod.Wrap(types.ErrScreeningUnavailable, decision.Reason)
+		}
+	}
+	if outcome == types.ScreeningCleared {
+		logger.Debug("compliance screening cleared operation")
+	} else {
+		logger.Warn("compliance screening flagged operation")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScreeningDecision,
+			sdk.NewAttribute("operation", req.Operation),
+			sdk.NewAttribute("did", req.DID),
+			sdk.NewAttribute("amount", req.Amount.String()),
+			sdk.NewAttribute("outcome", outcome),
+			sdk.NewAttribute("provider", decision.Provider),
+			sdk.NewAttribute("party", decision.Party),
+			sdk.NewAttribute("reason", decision.Reason),
+		),
+	)
+
+	details, err := json.Marshal(map[string]string{
+		"operation": req.Operation,
+		"parties":   strings.Join(req.Parties, ","),
+		"provider":  decision.Provider,
+		"party":     decision.Party,
+		"reason":    decision.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	activity := types.DEXActivity{
+		Type:        "screening",
+		Did:         req.DID,
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime(),
+		Details:     string(details),
+		Status:      outcome,
+		Amount:      req.Amount,
+	}
+	key, err := k.nextActivityKey(ctx, func(n int) string {
+		return GetScreeningActivityKey(req.DID, ctx.BlockHeight(), n)
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.DIDActivities.Set(ctx, key, activity); err != nil {
+		return fmt.Errorf("failed to record screening decision: %w", err)
+	}
+	k.storeActivityInDWN(ctx, req.DID, key, activity)
+	return nil
+}
+
+// GetScreeningActivityKey returns the key of a screening decision in a DID's
+// activity. It shares the DID's activity prefix so decisions show up in the
+// DID's history; n tells apart several decisions in the same block.
+func GetScreeningActivityKey(did string, height int64, n int) string {
+	return fmt.Sprintf("%sscreening_%d_%d", GetDIDActivityPrefix(did), height, n)
+}
+
+// nextActivityKey returns the first unused activity key in the current
+// block, where key builds the n-th candidate
+func (k Keeper) nextActivityKey(ctx sdk.Context, key func(n int) string) (string, error) {
+	for n := 0; ; n++ {
+		key := key(n)
+		has, err := k.DIDActivities.Has(ctx, key)
+		if err != nil {
+			return "", err
+		}
+		if !has {
+			return key, nil
+		}
+	}
+}
+
+// screeningValue prices amount with the oracle, or returns nil when any coin
+// has no price
+func (k Keeper) screeningValue(ctx sdk.Context, amount sdk.Coins) *math.LegacyDec {
+	total := math.LegacyZeroDec()
+	for _, coin := range amount {
+		value, err := k.usdValue(ctx, coin)
+		if err != nil {
+			return nil
+		}
+		total = total.Add(value)
+	}
+	return &total
+}
+
+// screeningParties returns a DID and, when it has one on the connection, its
+// interchain account address
+func (k Keeper) screeningParties(ctx sdk.Context, did, connectionID string) []string {
+	parties := []string{did}
+	if account, err := k.GetDEXAccount(ctx, did, connectionID); err == nil && account.AccountAddress != "" {
+		parties = append(parties, account.AccountAddress)
+	}
+	return parties
+}
+
+// otcScreeningParties returns the DIDs and addresses on both sides of an
+// offer, skipping unset ones
+func otcScreeningParties(o types.OTCOffer) []string {
+	var parties []string
+	for _, party := range []string{o.MakerDid, o.MakerAddress, o.TakerDid, o.TakerAddress, o.ArbiterDid} {
+		if party != "" {
+			parties = append(parties, party)
+		}
+	}
+	return parties
+}
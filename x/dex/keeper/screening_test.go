@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+type failingScreener struct{}
+
+func (failingScreener) Screen(context.Context, types.ScreeningRequest) (types.ScreeningDecision, error) {
+	return types.ScreeningDecision{}, errors.New("provider unreachable")
+}
+
+// setScreening enables screening of offers of 500usnr or more
+func setScreening(t *testing.T, f *testFixture, mode types.ScreeningMode) {
+	t.Helper()
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{
+		Enabled: true,
+		Screening: types.ScreeningParams{
+			Mode:       mode,
+			Thresholds: sdk.NewCoins(sdk.NewInt64Coin("usnr", 500)),
+		},
+	}))
+}
+
+func tryOTCOffer(f *testFixture, taker string, amount int64) error {
+	_, err := f.msgServer.CreateOTCOffer(f.ctx, &types.MsgCreateOTCOffer{
+		Sender:     f.addrs[0].String(),
+		MakerDid:   otcMaker,
+		TakerDid:   taker,
+		Offer:      sdk.NewCoins(sdk.NewInt64Coin("usnr", amount)),
+		Ask:        sdk.NewCoins(sdk.NewInt64Coin("uatom", 300)),
+		Expiration: f.ctx.BlockTime().Add(time.Hour),
+	})
+	return err
+}
+
+func screeningActivities(t *testing.T, f *testFixture) []types.DEXActivity {
+	t.Helper()
+	history, err := f.k.GetDIDActivityHistory(f.ctx, otcMaker, 100)
+	require.NoError(t, err)
+	var screenings []types.DEXActivity
+	for _, activity := range history {
+		if activity.Type == "screening" {
+			screenings = append(screenings, activity)
+		}
+	}
+	return screenings
+}
+
+func TestScreeningBlockMode(t *testing.T) {
+	f := setupOTC(t)
+	setScreening(t, f, types.SCREENING_MODE_BLOCK)
+	f.k.SetScreener(types.NewAddressListScreener(
+		types.NewAddressList("sanctions", map[string]string{otcTaker: "SDN entry"}),
+	))
+
+	// A listed counterparty blocks the offer and nothing is escrowed
+	err := tryOTCOffer(f, otcTaker, 600)
+	require.ErrorIs(t, err, types.ErrScreeningBlocked)
+	require.Contains(t, err.Error(), "SDN entry")
+	require.True(t, f.bank.balances[types.ModuleName].IsZero())
+
+	// Offers below the threshold are not screened
+	require.NoError(t, tryOTCOffer(f, otcTaker, 100))
+
+	// Unlisted parties clear, and the decision is kept
+	require.NoError(t, tryOTCOffer(f, "", 600))
+	screenings := screeningActivities(t, f)
+	require.Len(t, screenings, 1)
+	require.Equal(t, types.ScreeningCleared, screenings[0].Status)
+}
+
+func TestScreeningLogOnlyMode(t *testing.T) {
+	f := setupOTC(t)
+	setScreening(t, f, types.SCREENING_MODE_LOG_ONLY)
+	f.k.SetScreener(types.NewAddressListScreener(
+		types.NewAddressList("sanctions", map[string]string{otcTaker: "SDN entry"}),
+	))
+
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	f.ctx = ctx
+	require.NoError(t, tryOTCOffer(f, otcTaker, 600))
+	require.NoError(t, tryOTCOffer(f, otcTaker, 300))
+
+	// Decisions in the same block are kept apart
+	screenings := screeningActivities(t, f)
+	require.Len(t, screenings, 2)
+	for _, activity := range screenings {
+		require.Equal(t, types.ScreeningFlagged, activity.Status)
+		require.Contains(t, activity.Details, "sanctions")
+	}
+	has, err := f.k.DIDActivities.Has(f.ctx, keeper.GetScreeningActivityKey(otcMaker, f.ctx.BlockHeight(), 1))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	var flagged int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeScreeningDecision {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "outcome" && attr.Value == types.ScreeningFlagged {
+				flagged++
+			}
+		}
+	}
+	require.Equal(t, 2, flagged)
+}
+
+func TestScreeningUnavailable(t *testing.T) {
+	f := setupOTC(t)
+
+	// Without a screener, block mode refuses what it cannot screen
+	setScreening(t, f, types.SCREENING_MODE_BLOCK)
+	require.ErrorIs(t, tryOTCOffer(f, otcTaker, 600), types.ErrScreeningUnavailable)
+
+	f.k.SetScreener(failingScreener{})
+	err := tryOTCOffer(f, otcTaker, 600)
+	require.ErrorIs(t, err, types.ErrScreeningUnavailable)
+	require.Contains(t, err.Error(), "provider unreachable")
+
+	// while log-only mode records the failure and lets the offer through
+	setScreening(t, f, types.SCREENING_MODE_LOG_ONLY)
+	require.NoError(t, tryOTCOffer(f, otcTaker, 600))
+	screenings := screeningActivities(t, f)
+	require.Len(t, screenings, 1)
+	require.Equal(t, types.ScreeningFailed, screenings[0].Status)
+
+	// and screening off skips the screener entirely
+	setScreening(t, f, types.SCREENING_MODE_OFF)
+	require.NoError(t, tryOTCOffer(f, otcTaker, 600))
+	require.Len(t, screeningActivities(t, f), 1)
+}
@@ -1,17 +1,25 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
 	"github.com/sonr-io/sonr/x/dex/types"
 )
 
-// ExecuteSwap handles swap execution through ICA
+// ExecuteSwap handles swap execution through ICA as an Osmosis poolmanager
+// swap along route. The packet times out after timeout, which callers resolve
+// from the message deadline via ICATimeout. When batching is enabled the swap
+// is queued and the returned sequence is zero until the batch is flushed.
 func (k Keeper) ExecuteSwap(
 	ctx sdk.Context,
 	did string,
@@ -19,8 +27,20 @@ func (k Keeper) ExecuteSwap(
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	minAmountOut math.Int,
-	poolID uint64,
+	route []types.SwapAmountInRoute,
+	timeout time.Duration,
 ) (uint64, error) {
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return 0, err
+	}
+	if err := k.ValidateSwapParameters(ctx, connectionID, tokenIn, tokenOutDenom, minAmountOut); err != nil {
+		return 0, err
+	}
+	route, err := types.ResolveSwapRoute(route, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return 0, err
+	}
+
 	// Get the DEX account
 	account, err := k.GetDEXAccount(ctx, did, connectionID)
 	if err != nil {
@@ -32,27 +52,66 @@ func (k Keeper) ExecuteSwap(
 		return 0, fmt.Errorf("DEX account is not active")
 	}
 
-	// Create swap message for remote chain
-	// This example uses a generic bank send as placeholder
-	// Actual implementation would use chain-specific swap messages
-	swapMsg := &banktypes.MsgSend{
-		FromAddress: account.AccountAddress,
-		ToAddress:   account.AccountAddress, // Swap to self as example
-		Amount:      sdk.NewCoins(tokenIn),
+	// Detect an opposite swap by the same DID on another connection
+	extraFee, err := k.checkOppositeSwap(ctx, did, connectionID, tokenIn, tokenOutDenom)
+	if err != nil {
+		return 0, err
+	}
+
+	swapMsg, err := k.buildSwapMsg(ctx, account, route, tokenIn, minAmountOut, timeout)
+	if err != nil {
+		return 0, err
+	}
+	msgs := []sdk.Msg{swapMsg}
+
+	// The extra fee is paid from the ICA account alongside the swap
+	if extraFee.IsPositive() {
+		params, err := k.Params.Get(ctx)
+		collector := params.Fees.Collector(connectionID)
+		if err != nil || collector == "" {
+			return 0, errorsmod.Wrapf(types.ErrOppositeSwap, "no fee collector for %s", connectionID)
+		}
+		msgs = append(msgs, &banktypes.MsgSend{
+			FromAddress: account.AccountAddress,
+			ToAddress:   collector,
+			Amount:      sdk.NewCoins(extraFee),
+		})
 	}
 
 	// Send the swap transaction via ICA
-	sequence, err := k.SendDEXTransaction(
+	queued, sequence, err := k.QueueDEXTransaction(
 		ctx,
 		did,
 		connectionID,
-		[]sdk.Msg{swapMsg},
+		msgs,
 		fmt.Sprintf("swap_%s_for_%s", tokenIn.Denom, tokenOutDenom),
-		30*time.Second,
+		timeout,
 	)
 	if err != nil {
 		return 0, fmt.Errorf("failed to send swap transaction: %w", err)
 	}
+	if extraFee.IsPositive() {
+		if err := k.trackFees(ctx, account, queued, sequence, sdk.NewCoins(extraFee)); err != nil {
+			return 0, err
+		}
+	}
+	// A sequence returned with queued messages belongs to an earlier batch.
+	// The swap's position in the packet locates its response in the ack.
+	var msgIndex uint32
+	if queued {
+		sequence = 0
+		batch, err := k.PendingBatches.Get(ctx, GetAccountKey(did, connectionID))
+		if err != nil {
+			return 0, err
+		}
+		msgIndex = uint32(len(batch.Msgs) - len(msgs))
+	}
+
+	// Track the swap until its packet is acknowledged
+	swapID, err := k.recordSwap(ctx, account, tokenIn, tokenOutDenom, minAmountOut, sequence, msgIndex)
+	if err != nil {
+		return 0, err
+	}
 
 	// Emit swap event
 	ctx.EventManager().EmitEvent(
@@ -60,6 +119,7 @@ func (k Keeper) ExecuteSwap(
 			types.EventTypeSwapExecuted,
 			sdk.NewAttribute("did", did),
 			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("swap_id", fmt.Sprintf("%d", swapID)),
 			sdk.NewAttribute("token_in", tokenIn.String()),
 			sdk.NewAttribute("token_out_denom", tokenOutDenom),
 			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
@@ -69,24 +129,86 @@ func (k Keeper) ExecuteSwap(
 	return sequence, nil
 }
 
-// BuildOsmosisSwapMsg builds an Osmosis-specific swap message
+// buildSwapMsg builds the host chain message that swaps tokenIn along a
+// resolved route: an IBC transfer for connections with a Noble route, an
+// Osmosis poolmanager swap otherwise
+func (k Keeper) buildSwapMsg(
+	ctx sdk.Context,
+	account *types.InterchainDEXAccount,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	minAmountOut math.Int,
+	timeout time.Duration,
+) (sdk.Msg, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if nobleRoute, ok := params.NobleRoute(account.ConnectionId); ok {
+		return k.BuildNobleSwapMsg(ctx, account.AccountAddress, nobleRoute, route, tokenIn, minAmountOut, timeout)
+	}
+	return k.BuildOsmosisSwapMsg(account.AccountAddress, route, tokenIn, minAmountOut), nil
+}
+
+// BuildOsmosisSwapMsg builds the Osmosis poolmanager MsgSwapExactAmountIn
+// that swaps tokenIn along a resolved route, each hop naming its output denom
 func (k Keeper) BuildOsmosisSwapMsg(
 	senderAddress string,
-	poolID uint64,
+	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
-	tokenOutDenom string,
 	minAmountOut math.Int,
 ) sdk.Msg {
-	// This would build an actual Osmosis swap message
-	// For now, return a placeholder bank send
-	return &banktypes.MsgSend{
-		FromAddress: senderAddress,
-		ToAddress:   senderAddress,
-		Amount:      sdk.NewCoins(tokenIn),
+	return &types.OsmosisMsgSwapExactAmountIn{
+		Sender:            senderAddress,
+		Routes:            route,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: minAmountOut,
+	}
+}
+
+// BuildNobleSwapMsg builds the IBC transfer that swaps tokenIn from a Noble
+// ICA account. The tokens are sent over the route's channel to the swap chain
+// with a memo that swaps them along a resolved pool route and sends the output
+// back to the sender. The transfer may only be relayed after the ICA packet
+// carrying it is, so it is given twice the packet's timeout.
+func (k Keeper) BuildNobleSwapMsg(
+	ctx sdk.Context,
+	senderAddress string,
+	nobleRoute types.NobleRoute,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	minAmountOut math.Int,
+	timeout time.Duration,
+) (sdk.Msg, error) {
+	if len(route) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidSwapParams, "swap route requires at least one pool")
+	}
+	tokenOutDenom := route[len(route)-1].TokenOutDenom
+
+	memo, err := types.NobleSwapMemo(
+		nobleRoute.SwapContract,
+		tokenOutDenom,
+		minAmountOut,
+		senderAddress,
+		route,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSwapParams, "noble swap memo: %s", err)
 	}
+
+	return &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    nobleRoute.ChannelId,
+		Token:            tokenIn,
+		Sender:           senderAddress,
+		Receiver:         nobleRoute.SwapContract,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: uint64(ctx.BlockTime().Add(2 * timeout).UnixNano()),
+		Memo:             memo,
+	}, nil
 }
 
-// EstimateSwapOutput estimates the output of a swap
+// EstimateSwapOutput estimates the output of a swap. See EstimateSwapPrice.
 func (k Keeper) EstimateSwapOutput(
 	ctx sdk.Context,
 	connectionID string,
@@ -94,13 +216,53 @@ func (k Keeper) EstimateSwapOutput(
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 ) (math.Int, error) {
-	// This would query the remote chain for swap estimation
-	// For now, return a placeholder value
-	return tokenIn.Amount.MulRaw(95).QuoRaw(100), nil // 95% of input as example
+	out, _, err := k.EstimateSwapPrice(ctx, connectionID, poolID, tokenIn, tokenOutDenom)
+	return out, err
 }
 
-// ValidateSwapParameters validates swap parameters
+// EstimateSwapPrice estimates the output of a swap and reports its pricing
+// source. A pool whose reserves were read within the pricing TTL is priced as
+// a constant product pool; otherwise, unless the params require reserves, the
+// input is converted at the ratio of the oracle's USD prices. Without either
+// the swap cannot be priced and ErrPriceUnavailable is returned.
+func (k Keeper) EstimateSwapPrice(
+	ctx sdk.Context,
+	connectionID string,
+	poolID uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+) (math.Int, string, error) {
+	pricing := k.GetPricingParams(ctx)
+	if poolID != 0 {
+		reserves, err := k.GetPoolReserves(ctx, connectionID, poolID)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return math.Int{}, "", err
+		}
+		if err == nil && reserves.Fresh(ctx.BlockTime(), pricing.ReservesTTL()) {
+			if out, ok := reserves.EstimateOut(tokenIn, tokenOutDenom); ok {
+				return out, PriceSourceReserves, nil
+			}
+		}
+	}
+	if pricing.RequireReserves {
+		return math.Int{}, "", errorsmod.Wrapf(types.ErrPriceUnavailable, "no fresh reserves for pool %d on %s", poolID, connectionID)
+	}
+
+	value, err := k.usdValue(ctx, tokenIn)
+	if err != nil {
+		return math.Int{}, "", err
+	}
+	priceOut, err := k.assetPrice(ctx, tokenOutDenom)
+	if err != nil {
+		return math.Int{}, "", err
+	}
+	return value.Quo(priceOut).TruncateInt(), PriceSourceOracle, nil
+}
+
+// ValidateSwapParameters validates swap parameters against the connection's denom filter
 func (k Keeper) ValidateSwapParameters(
+	ctx sdk.Context,
+	connectionID string,
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	minAmountOut math.Int,
@@ -121,5 +283,5 @@ func (k Keeper) ValidateSwapParameters(
 		return fmt.Errorf("minimum amount out cannot be negative")
 	}
 
-	return nil
+	return k.checkDenomsAllowed(ctx, connectionID, tokenIn.Denom, tokenOutDenom)
 }
@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// GetSwap returns a swap settlement record by ID
+func (k Keeper) GetSwap(ctx sdk.Context, id uint64) (types.SwapRecord, error) {
+	return k.Swaps.Get(ctx, id)
+}
+
+// GetSwapActivityKey returns the key of a swap's DEXActivity record. It shares
+// the DID's activity prefix so the swap shows up in the DID's history.
+func GetSwapActivityKey(did string, swapID uint64) string {
+	return fmt.Sprintf("%sswap_%d", GetDIDActivityPrefix(did), swapID)
+}
+
+// recordSwap stores a pending swap and its activity record. A zero sequence
+// means the swap waits in the account's batch and is linked to its packet
+// when the batch is flushed. msgIndex is the swap's position in the packet.
+func (k Keeper) recordSwap(
+	ctx sdk.Context,
+	account *types.InterchainDEXAccount,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	minAmountOut math.Int,
+	sequence uint64,
+	msgIndex uint32,
+) (uint64, error) {
+	id, err := k.SwapSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	swap := types.SwapRecord{
+		Id:            id,
+		Did:           account.Did,
+		ConnectionId:  account.ConnectionId,
+		PortId:        account.PortId,
+		TokenIn:       tokenIn,
+		TokenOutDenom: tokenOutDenom,
+		MinAmountOut:  minAmountOut.String(),
+		Sequence:      sequence,
+		Status:        types.SwapStatusPending,
+		ActivityKey:   GetSwapActivityKey(account.Did, id),
+		CreatedHeight: ctx.BlockHeight(),
+		MsgIndex:      msgIndex,
+	}
+	swap.Service, swap.TxHash = callbackOrigin(ctx)
+	if err := k.Swaps.Set(ctx, id, swap); err != nil {
+		return 0, fmt.Errorf("failed to record swap: %w", err)
+	}
+
+	if sequence == 0 {
+		err = k.UnsentSwaps.Set(ctx, collections.Join(GetAccountKey(account.Did, account.ConnectionId), id))
+	} else {
+		err = k.SwapsByPacket.Set(ctx, collections.Join3(swap.PortId, sequence, id))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to index swap: %w", err)
+	}
+
+	activity := types.DEXActivity{
+		Type:         "swap",
+		Did:          swap.Did,
+		ConnectionId: swap.ConnectionId,
+		BlockHeight:  ctx.BlockHeight(),
+		Timestamp:    ctx.BlockTime(),
+		Status:       swap.Status.String(),
+		Amount:       sdk.NewCoins(tokenIn),
+	}
+	if err := k.setSwapActivity(ctx, swap, &activity); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// linkBatchedSwaps records the packet sequence of the swaps that were waiting
+// in an account's batch once the batch is sent
+func (k Keeper) linkBatchedSwaps(ctx sdk.Context, accountKey string, sequence uint64) error {
+	iter, err := k.UnsentSwaps.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](accountKey))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		swap, err := k.Swaps.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		swap.Sequence = sequence
+		if err := k.Swaps.Set(ctx, swap.Id, swap); err != nil {
+			return err
+		}
+		if err := k.SwapsByPacket.Set(ctx, collections.Join3(swap.PortId, sequence, swap.Id)); err != nil {
+			return err
+		}
+		if err := k.UnsentSwaps.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnSwapResult settles the swaps sent in an ICA packet. A nil ack means the
+// packet timed out. On success each swap takes the output amount of the
+// response at its message index in the host chain's result; a result that
+// cannot be decoded settles the swaps without an amount.
+func (k Keeper) OnSwapResult(ctx sdk.Context, portID string, sequence uint64, ack *channeltypes.Acknowledgement) error {
+	iter, err := k.SwapsByPacket.Iterate(
+		ctx,
+		collections.NewSuperPrefixedTripleRange[string, uint64, uint64](portID, sequence),
+	)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	status := types.SwapStatusFailed
+	var amounts []math.Int
+	if ack != nil && ack.Success() {
+		status = types.SwapStatusComplete
+		if amounts, err = types.SwapAmountsOut(ack.GetResult()); err != nil {
+			k.Logger(ctx).Error("failed to read swap amounts from acknowledgement",
+				"port", portID,
+				"sequence", sequence,
+				"error", err,
+			)
+		}
+	}
+
+	for _, key := range keys {
+		swap, err := k.Swaps.Get(ctx, key.K3())
+		if err != nil {
+			return err
+		}
+		swap.Status = status
+		swap.SettledHeight = ctx.BlockHeight()
+		if i := int(swap.MsgIndex); i < len(amounts) && !amounts[i].IsNil() {
+			swap.AmountReceived = amounts[i].String()
+		}
+		if err := k.Swaps.Set(ctx, swap.Id, swap); err != nil {
+			return err
+		}
+		if err := k.SwapsByPacket.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.settleSwapActivity(ctx, swap); err != nil {
+			return err
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute("did", swap.Did),
+			sdk.NewAttribute("connection", swap.ConnectionId),
+			sdk.NewAttribute("swap_id", fmt.Sprintf("%d", swap.Id)),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute("status", swap.Status.String()),
+			sdk.NewAttribute("token_in", swap.TokenIn.String()),
+			sdk.NewAttribute("token_out_denom", swap.TokenOutDenom),
+		}
+		if swap.AmountReceived != "" {
+			attrs = append(attrs, sdk.NewAttribute("amount_received", swap.AmountReceived))
+		}
+		attrs = append(attrs, callbackAttributes(swap.Service, swap.TxHash)...)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSwapSettled, attrs...))
+	}
+	return nil
+}
+
+// settleSwapActivity moves a swap's activity record out of pending
+func (k Keeper) settleSwapActivity(ctx sdk.Context, swap types.SwapRecord) error {
+	activity, err := k.DIDActivities.Get(ctx, swap.ActivityKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	activity.Status = swap.Status.String()
+	if swap.AmountReceived != "" {
+		if amount, ok := math.NewIntFromString(swap.AmountReceived); ok {
+			activity.Amount = activity.Amount.Add(sdk.NewCoin(swap.TokenOutDenom, amount))
+		}
+	}
+	return k.setSwapActivity(ctx, swap, &activity)
+}
+
+// setSwapActivity stores a swap's activity record with its details
+func (k Keeper) setSwapActivity(ctx sdk.Context, swap types.SwapRecord, activity *types.DEXActivity) error {
+	details, err := json.Marshal(map[string]any{
+		"swap_id":         swap.Id,
+		"sequence":        swap.Sequence,
+		"token_out_denom": swap.TokenOutDenom,
+		"min_amount_out":  swap.MinAmountOut,
+		"amount_received": swap.AmountReceived,
+	})
+	if err != nil {
+		return err
+	}
+	activity.Details = string(details)
+
+	if err := k.DIDActivities.Set(ctx, swap.ActivityKey, *activity); err != nil {
+		return fmt.Errorf("failed to record swap activity: %w", err)
+	}
+	k.storeActivityInDWN(ctx, swap.Did, swap.ActivityKey, *activity)
+	return nil
+}
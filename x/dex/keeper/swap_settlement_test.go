@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// SwapSettlementTestSuite tests settling swaps from ICA acknowledgements
+type SwapSettlementTestSuite struct {
+	suite.Suite
+	f       *testFixture
+	account types.InterchainDEXAccount
+}
+
+func TestSwapSettlementSuite(t *testing.T) {
+	suite.Run(t, new(SwapSettlementTestSuite))
+}
+
+func (suite *SwapSettlementTestSuite) SetupTest() {
+	suite.f = SetupTest(suite.T())
+
+	account, err := suite.f.k.RegisterDEXAccount(suite.f.ctx, "did:sonr:alice", testConnectionID, []string{"swap"})
+	suite.Require().NoError(err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	suite.Require().NoError(
+		suite.f.k.Accounts.Set(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID), *account),
+	)
+	suite.account = *account
+
+	_, err = suite.f.k.ScopedKeeper.NewCapability(
+		suite.f.ctx,
+		host.ChannelCapabilityPath(account.PortId, "channel-0"),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *SwapSettlementTestSuite) swap() {
+	_, err := suite.f.k.ExecuteSwap(
+		suite.f.ctx,
+		"did:sonr:alice",
+		testConnectionID,
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *SwapSettlementTestSuite) packet(sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{Sequence: sequence, SourcePort: suite.account.PortId, SourceChannel: "channel-0"}
+}
+
+// ack builds a successful acknowledgement carrying the given message responses
+func (suite *SwapSettlementTestSuite) ack(responses ...*codectypes.Any) []byte {
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: responses})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+	bz, err := proto.Marshal(&ack)
+	suite.Require().NoError(err)
+	return bz
+}
+
+func swapResponse(amount string) *codectypes.Any {
+	value := protowire.AppendTag(nil, 1, protowire.BytesType)
+	value = protowire.AppendString(value, amount)
+	return &codectypes.Any{TypeUrl: "/osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse", Value: value}
+}
+
+func (suite *SwapSettlementTestSuite) requireSwap(id uint64, status types.SwapStatus, received string) {
+	swap, err := suite.f.k.GetSwap(suite.f.ctx, id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(status, swap.Status)
+	suite.Require().Equal(received, swap.AmountReceived)
+
+	activity, err := suite.f.k.DIDActivities.Get(suite.f.ctx, swap.ActivityKey)
+	suite.Require().NoError(err)
+	suite.Require().Equal(status.String(), activity.Status)
+}
+
+func (suite *SwapSettlementTestSuite) TestBatchedSwapsSettleOnAck() {
+	suite.swap()
+	suite.swap()
+	suite.requireSwap(0, types.SwapStatusPending, "")
+
+	batch, err := suite.f.k.PendingBatches.Get(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().Len(batch.Msgs, 2)
+	suite.Require().Equal("/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn", batch.Msgs[0].TypeUrl)
+
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+	swap, err := suite.f.k.GetSwap(suite.f.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), swap.Sequence, "flushing links the swaps to the packet")
+	suite.Require().Equal(uint32(1), swap.MsgIndex)
+
+	// Each swap reads the response at its own position in the packet
+	other := &codectypes.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSendResponse"}
+	bz := suite.ack(swapResponse("950"), swapResponse("960"), other)
+	suite.Require().NoError(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, suite.packet(1), bz, nil))
+
+	suite.requireSwap(0, types.SwapStatusComplete, "950")
+	suite.requireSwap(1, types.SwapStatusComplete, "960")
+
+	activity, err := suite.f.k.DIDActivities.Get(suite.f.ctx, keeper.GetSwapActivityKey("did:sonr:alice", 0))
+	suite.Require().NoError(err)
+	suite.Require().Equal("950", activity.Amount.AmountOf("uosmo").String())
+
+	var settled int
+	for _, event := range suite.f.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSwapSettled {
+			settled++
+		}
+	}
+	suite.Require().Equal(2, settled)
+
+	// A relayed duplicate finds nothing left to settle
+	suite.Require().NoError(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, suite.packet(1), bz, nil))
+	suite.requireSwap(0, types.SwapStatusComplete, "950")
+}
+
+func (suite *SwapSettlementTestSuite) TestNobleSwapSendsTransfer() {
+	route := types.NobleRoute{ConnectionId: testConnectionID, ChannelId: "channel-1", SwapContract: "osmo1swapcontract"}
+	suite.Require().NoError(suite.f.k.Params.Set(suite.f.ctx, types.Params{NobleRoutes: []types.NobleRoute{route}}))
+	suite.swap()
+
+	batch, err := suite.f.k.PendingBatches.Get(suite.f.ctx, keeper.GetAccountKey("did:sonr:alice", testConnectionID))
+	suite.Require().NoError(err)
+	suite.Require().Len(batch.Msgs, 1)
+	suite.Require().Equal("/ibc.applications.transfer.v1.MsgTransfer", batch.Msgs[0].TypeUrl)
+
+	var transfer transfertypes.MsgTransfer
+	suite.Require().NoError(transfer.Unmarshal(batch.Msgs[0].Value))
+	suite.Require().Equal("transfer", transfer.SourcePort)
+	suite.Require().Equal("channel-1", transfer.SourceChannel)
+	suite.Require().Equal("cosmos1test", transfer.Sender)
+	suite.Require().Equal("osmo1swapcontract", transfer.Receiver)
+	suite.Require().Equal(sdk.NewCoin("uatom", math.NewInt(1000)), transfer.Token)
+	suite.Require().Equal(
+		uint64(suite.f.ctx.BlockTime().Add(2*time.Minute).UnixNano()),
+		transfer.TimeoutTimestamp,
+	)
+	suite.Require().Contains(transfer.Memo, `"output_denom":"uosmo"`)
+	suite.Require().Contains(transfer.Memo, `"min_output_amount":"900"`)
+	suite.Require().Contains(transfer.Memo, `"receiver":"cosmos1test"`)
+}
+
+func (suite *SwapSettlementTestSuite) TestErrorAckFailsSwap() {
+	suite.swap()
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrICAOperationFailed)
+	bz, err := proto.Marshal(&ack)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.f.k.OnAcknowledgementPacket(suite.f.ctx, suite.packet(1), bz, nil))
+
+	suite.requireSwap(0, types.SwapStatusFailed, "")
+}
+
+func (suite *SwapSettlementTestSuite) TestTimeoutFailsUnbatchedSwap() {
+	params := types.DefaultBatchParams()
+	params.Enabled = false
+	suite.Require().NoError(suite.f.k.SetBatchParams(suite.f.ctx, params))
+
+	suite.swap()
+	suite.Require().NoError(suite.f.k.OnTimeoutPacket(suite.f.ctx, suite.packet(1), nil))
+
+	suite.requireSwap(0, types.SwapStatusFailed, "")
+}
+
+func (suite *SwapSettlementTestSuite) TestSettlementNamesCallbackService() {
+	txBytes := []byte("swap tx")
+	ctx := suite.f.ctx
+	suite.f.ctx = keeper.WithCallbackService(ctx.WithTxBytes(txBytes), "did:sonr:acme")
+	suite.swap()
+	suite.f.ctx = ctx
+
+	swap, err := suite.f.k.GetSwap(suite.f.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal("did:sonr:acme", swap.Service)
+	suite.Require().Equal(fmt.Sprintf("%X", sha256.Sum256(txBytes)), swap.TxHash)
+
+	suite.Require().NoError(suite.f.k.FlushPendingBatches(suite.f.ctx, true))
+	suite.Require().NoError(suite.f.k.OnTimeoutPacket(suite.f.ctx, suite.packet(1), nil))
+
+	var attrs map[string]string
+	for _, event := range suite.f.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSwapSettled {
+			continue
+		}
+		attrs = make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+	}
+	suite.Require().Equal("did:sonr:acme", attrs["service"])
+	suite.Require().Equal(swap.TxHash, attrs["request_tx_hash"])
+	suite.Require().Equal("failed", attrs["status"])
+	suite.Require().Equal("1", attrs["sequence"])
+}
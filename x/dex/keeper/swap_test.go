@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// activeSwapAccount registers an active DEX account for did on the test
+// connection and claims the capability of its ICA channel
+func activeSwapAccount(t *testing.T, f *testFixture, did string) types.InterchainDEXAccount {
+	t.Helper()
+	account, err := f.k.RegisterDEXAccount(f.ctx, did, testConnectionID, []string{"swap"})
+	require.NoError(t, err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	require.NoError(t, f.k.Accounts.Set(f.ctx, keeper.GetAccountKey(did, testConnectionID), *account))
+
+	_, err = f.k.ScopedKeeper.NewCapability(f.ctx, host.ChannelCapabilityPath(account.PortId, "channel-0"))
+	require.NoError(t, err)
+	return *account
+}
+
+func TestRegisterDEXAccountLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		max         uint32
+		connections []string
+		wantErr     error
+	}{
+		{
+			name:        "unlimited when zero",
+			connections: []string{"connection-0", "connection-1", "connection-2"},
+		},
+		{
+			name:        "within limit",
+			max:         2,
+			connections: []string{"connection-0", "connection-1"},
+		},
+		{
+			name:        "over limit",
+			max:         2,
+			connections: []string{"connection-0", "connection-1", "connection-2"},
+			wantErr:     types.ErrTooManyAccounts,
+		},
+		{
+			name:        "existing account at limit",
+			max:         2,
+			connections: []string{"connection-0", "connection-1", "connection-0"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			require.NoError(t, f.k.Params.Set(f.ctx, types.Params{Enabled: true, MaxAccountsPerDid: tc.max}))
+
+			var err error
+			for _, connectionID := range tc.connections {
+				_, err = f.k.RegisterDEXAccount(f.ctx, "did:sonr:limits", connectionID, []string{"swap"})
+			}
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				// The refused account never reaches the ICA controller
+				require.Len(t, f.ica.owners, len(tc.connections)-1)
+				return
+			}
+			require.NoError(t, err)
+
+			accounts, err := f.k.GetDEXAccountsByDID(f.ctx, "did:sonr:limits")
+			require.NoError(t, err)
+			require.Len(t, accounts, len(f.ica.owners))
+		})
+	}
+}
+
+func TestRegisterDEXAccountControllerFailure(t *testing.T) {
+	f := SetupTest(t)
+	f.ica.registerErr = errors.New("controller unavailable")
+
+	_, err := f.k.RegisterDEXAccount(f.ctx, "did:sonr:failure", testConnectionID, []string{"swap"})
+	require.ErrorContains(t, err, "controller unavailable")
+
+	_, err = f.k.GetDEXAccount(f.ctx, "did:sonr:failure", testConnectionID)
+	require.Error(t, err)
+}
+
+func TestValidateSwapParameters(t *testing.T) {
+	f := SetupTest(t)
+	tests := []struct {
+		name         string
+		tokenIn      sdk.Coin
+		tokenOut     string
+		minAmountOut math.Int
+		wantErr      string
+	}{
+		{"valid", sdk.NewInt64Coin("uatom", 1000), "uosmo", math.NewInt(900), ""},
+		{"zero amount", sdk.NewInt64Coin("uatom", 0), "uosmo", math.NewInt(900), "cannot be zero"},
+		{"no output denom", sdk.NewInt64Coin("uatom", 1000), "", math.NewInt(900), "cannot be empty"},
+		{"same denom", sdk.NewInt64Coin("uatom", 1000), "uatom", math.NewInt(900), "same token"},
+		{"negative minimum", sdk.NewInt64Coin("uatom", 1000), "uosmo", math.NewInt(-1), "cannot be negative"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.k.ValidateSwapParameters(f.ctx, testConnectionID, tc.tokenIn, tc.tokenOut, tc.minAmountOut)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSwapPacketConstruction(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  types.Params
+		typeURL string
+		check   func(t *testing.T, value []byte)
+	}{
+		{
+			name:    "osmosis poolmanager swap",
+			params:  types.Params{Enabled: true},
+			typeURL: "/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn",
+			check: func(t *testing.T, value []byte) {
+				var swap types.OsmosisMsgSwapExactAmountIn
+				require.NoError(t, swap.Unmarshal(value))
+				require.Equal(t, "cosmos1test", swap.Sender)
+				require.Equal(t, sdk.NewInt64Coin("uatom", 1000), swap.TokenIn)
+				require.Equal(t, math.NewInt(900), swap.TokenOutMinAmount)
+				require.Equal(t, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}}, swap.Routes)
+			},
+		},
+		{
+			name: "noble transfer swap",
+			params: types.Params{Enabled: true, NobleRoutes: []types.NobleRoute{{
+				ConnectionId: testConnectionID,
+				ChannelId:    "channel-1",
+				SwapContract: "osmo1swapcontract",
+			}}},
+			typeURL: "/ibc.applications.transfer.v1.MsgTransfer",
+			check: func(t *testing.T, value []byte) {
+				var transfer transfertypes.MsgTransfer
+				require.NoError(t, transfer.Unmarshal(value))
+				require.Equal(t, "channel-1", transfer.SourceChannel)
+				require.Equal(t, "osmo1swapcontract", transfer.Receiver)
+				require.Equal(t, sdk.NewInt64Coin("uatom", 1000), transfer.Token)
+				require.Contains(t, transfer.Memo, `"output_denom":"uosmo"`)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			require.NoError(t, f.k.Params.Set(f.ctx, tc.params))
+			batching := types.DefaultBatchParams()
+			batching.Enabled = false
+			require.NoError(t, f.k.SetBatchParams(f.ctx, batching))
+			activeSwapAccount(t, f, "did:sonr:packets")
+
+			ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+			sequence, err := f.k.ExecuteSwap(
+				ctx,
+				"did:sonr:packets",
+				testConnectionID,
+				sdk.NewInt64Coin("uatom", 1000),
+				"uosmo",
+				math.NewInt(900),
+				[]types.SwapAmountInRoute{{PoolId: 1}},
+				time.Minute,
+			)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), sequence)
+
+			require.Len(t, f.ica.packets, 1)
+			packet := f.ica.packets[0]
+			require.Equal(t, icatypes.EXECUTE_TX, packet.Type)
+			require.Equal(t, "swap_uatom_for_uosmo", packet.Memo)
+
+			var tx icatypes.CosmosTx
+			require.NoError(t, tx.Unmarshal(packet.Data))
+			require.Len(t, tx.Messages, 1)
+			require.Equal(t, tc.typeURL, tx.Messages[0].TypeUrl)
+			tc.check(t, tx.Messages[0].Value)
+
+			var executed *sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeSwapExecuted {
+					executed = &event
+				}
+			}
+			require.NotNil(t, executed)
+			attrs := make(map[string]string)
+			for _, attr := range executed.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			require.Equal(t, "did:sonr:packets", attrs["did"])
+			require.Equal(t, testConnectionID, attrs["connection"])
+			require.Equal(t, "1000uatom", attrs["token_in"])
+			require.Equal(t, "uosmo", attrs["token_out_denom"])
+			require.Equal(t, "1", attrs["sequence"])
+		})
+	}
+}
+
+func TestSwapSendFailure(t *testing.T) {
+	f := SetupTest(t)
+	batching := types.DefaultBatchParams()
+	batching.Enabled = false
+	require.NoError(t, f.k.SetBatchParams(f.ctx, batching))
+	activeSwapAccount(t, f, "did:sonr:unsent")
+	f.ica.sendErr = errors.New("channel closed")
+
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := f.k.ExecuteSwap(
+		ctx,
+		"did:sonr:unsent",
+		testConnectionID,
+		sdk.NewInt64Coin("uatom", 1000),
+		"uosmo",
+		math.NewInt(900),
+		[]types.SwapAmountInRoute{{PoolId: 1}},
+		time.Minute,
+	)
+	require.ErrorContains(t, err, "channel closed")
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeSwapExecuted, event.Type)
+	}
+}
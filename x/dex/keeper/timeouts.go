@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/app/clock"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// DefaultICATimeout returns the packet timeout applied when a message carries
+// no deadline: the module's default_timeout_seconds param if set, otherwise
+// types.DefaultICATimeout.
+func (k Keeper) DefaultICATimeout(ctx sdk.Context) time.Duration {
+	params, err := k.Params.Get(ctx)
+	if err == nil && params.DefaultTimeoutSeconds > 0 {
+		return time.Duration(params.DefaultTimeoutSeconds) * time.Second
+	}
+	return types.DefaultICATimeout
+}
+
+// ICATimeout resolves a client supplied deadline against the current block
+// time. A zero deadline yields the default timeout; an elapsed deadline is
+// rejected; the result never exceeds types.MaxICATimeout.
+func (k Keeper) ICATimeout(ctx sdk.Context, deadline time.Time) (time.Duration, error) {
+	d, err := clock.Remaining(ctx.BlockTime(), deadline, k.DefaultICATimeout(ctx), types.MaxICATimeout)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrDeadlineElapsed, err.Error())
+	}
+	return d, nil
+}
+
+// ValidateOrderExpiration rejects a limit order whose expiration is not after
+// the current block time. A zero expiration means good-til-cancelled.
+func (k Keeper) ValidateOrderExpiration(ctx sdk.Context, expiration time.Time) error {
+	if expiration.IsZero() || expiration.After(ctx.BlockTime()) {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrDeadlineElapsed,
+		"order expiration %s is not after block time %s",
+		expiration.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// CreateTWAMMOrder schedules a swap to be executed as totalSlices equal slices,
+// one every intervalBlocks blocks starting with the next block. The service
+// set on ctx with WithCallbackService is told when each slice settles.
+func (k Keeper) CreateTWAMMOrder(
+	ctx sdk.Context,
+	did string,
+	connectionID string,
+	poolID uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	minAmountOut math.Int,
+	totalSlices uint32,
+	intervalBlocks uint32,
+) (uint64, error) {
+	if err := k.checkConnectionAllowed(ctx, connectionID); err != nil {
+		return 0, err
+	}
+	if err := k.ValidateSwapParameters(ctx, connectionID, tokenIn, tokenOutDenom, minAmountOut); err != nil {
+		return 0, err
+	}
+
+	account, err := k.GetDEXAccount(ctx, did, connectionID)
+	if err != nil {
+		return 0, err
+	}
+	if account.Status != types.ACCOUNT_STATUS_ACTIVE {
+		return 0, types.ErrAccountNotActive
+	}
+
+	id, err := k.TWAMMSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	service, _ := callbackOrigin(ctx)
+
+	order := types.TWAMMOrder{
+		Id:                  id,
+		Did:                 did,
+		ConnectionId:        connectionID,
+		PoolId:              poolID,
+		TokenIn:             tokenIn,
+		TokenOutDenom:       tokenOutDenom,
+		MinAmountOut:        minAmountOut.String(),
+		TotalSlices:         totalSlices,
+		IntervalBlocks:      intervalBlocks,
+		AmountExecuted:      math.ZeroInt().String(),
+		NextExecutionHeight: ctx.BlockHeight() + 1,
+		CreatedHeight:       ctx.BlockHeight(),
+		Status:              types.TWAMMOrderStatusActive,
+		Service:             service,
+	}
+	if err := order.Validate(); err != nil {
+		return 0, err
+	}
+
+	if err := k.TWAMMOrders.Set(ctx, id, order); err != nil {
+		return 0, fmt.Errorf("failed to store TWAMM order: %w", err)
+	}
+	if err := k.TWAMMSchedule.Set(ctx, collections.Join(order.NextExecutionHeight, id)); err != nil {
+		return 0, fmt.Errorf("failed to schedule TWAMM order: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTWAMMOrderCreated,
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("did", did),
+			sdk.NewAttribute("connection", connectionID),
+			sdk.NewAttribute("token_in", tokenIn.String()),
+			sdk.NewAttribute("token_out_denom", tokenOutDenom),
+			sdk.NewAttribute("slices", fmt.Sprintf("%d", totalSlices)),
+			sdk.NewAttribute("interval_blocks", fmt.Sprintf("%d", intervalBlocks)),
+		),
+	)
+
+	return id, nil
+}
+
+// GetTWAMMOrder returns a TWAMM order by ID
+func (k Keeper) GetTWAMMOrder(ctx sdk.Context, id uint64) (types.TWAMMOrder, error) {
+	order, err := k.TWAMMOrders.Get(ctx, id)
+	if err != nil {
+		return types.TWAMMOrder{}, errorsmod.Wrapf(types.ErrTWAMMOrderNotFound, "order %d", id)
+	}
+	return order, nil
+}
+
+// CancelTWAMMOrder stops an active order before its remaining slices execute.
+// Slices already sent to the host chain are not reverted.
+func (k Keeper) CancelTWAMMOrder(ctx sdk.Context, did string, id uint64) error {
+	order, err := k.GetTWAMMOrder(ctx, id)
+	if err != nil {
+		return err
+	}
+	if order.Did != did {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "order %d is not owned by %s", id, did)
+	}
+	if order.Status != types.TWAMMOrderStatusActive {
+		return errorsmod.Wrapf(types.ErrTWAMMOrderNotActive, "order %d is %s", id, order.Status)
+	}
+
+	if err := k.TWAMMSchedule.Remove(ctx, collections.Join(order.NextExecutionHeight, id)); err != nil {
+		return err
+	}
+
+	order.Status = types.TWAMMOrderStatusCancelled
+	if err := k.TWAMMOrders.Set(ctx, id, order); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTWAMMOrderCancelled,
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("did", did),
+			sdk.NewAttribute("slices_executed", fmt.Sprintf("%d", order.SlicesExecuted)),
+			sdk.NewAttribute("amount_executed", order.AmountExecuted),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteDueTWAMMSlices sends the next slice of every order scheduled at or
+// before the current height. A failing order is logged and rescheduled so it
+// cannot block other orders.
+func (k Keeper) ExecuteDueTWAMMSlices(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockHeight(), ^uint64(0)))
+
+	due, err := k.TWAMMSchedule.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.TWAMMSchedule.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		order, err := k.TWAMMOrders.Get(ctx, key.K2())
+		if err != nil || order.Status != types.TWAMMOrderStatusActive {
+			continue
+		}
+
+		// Execute in a cached context so a failed slice leaves no partial writes
+		cacheCtx, write := ctx.CacheContext()
+		updated := order
+		if err := k.executeTWAMMSlice(cacheCtx, &updated); err != nil {
+			k.Logger(ctx).Error("failed to execute TWAMM slice", "order_id", order.Id, "error", err)
+		} else {
+			write()
+			order = updated
+		}
+
+		if order.Status == types.TWAMMOrderStatusActive {
+			order.NextExecutionHeight = ctx.BlockHeight() + int64(order.IntervalBlocks)
+			if err := k.TWAMMSchedule.Set(ctx, collections.Join(order.NextExecutionHeight, order.Id)); err != nil {
+				return err
+			}
+		}
+
+		if err := k.TWAMMOrders.Set(ctx, order.Id, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeTWAMMSlice swaps a single slice of the order and updates its
+// progress. Like a swap submitted on its own, a slice counts against the rate
+// limits and daily volume of the DID and pays the platform swap fee from its
+// input. The slice is skipped when the estimated output is below its pro-rata
+// min-out.
+func (k Keeper) executeTWAMMSlice(ctx sdk.Context, order *types.TWAMMOrder) error {
+	sliceIn := sdk.NewCoin(order.TokenIn.Denom, order.SliceAmount())
+	sliceMinOut := order.SliceMinAmountOut(sliceIn.Amount)
+
+	fee, netIn, err := k.DeductFee(ctx, types.FeeOperationSwap, order.ConnectionId, sliceIn)
+	if err != nil {
+		return err
+	}
+	estimate, err := k.EstimateSwapOutput(ctx, order.ConnectionId, order.PoolId, netIn, order.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+	if estimate.LT(sliceMinOut) {
+		order.SlicesSkipped++
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTWAMMSliceSkipped,
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+				sdk.NewAttribute("estimate", estimate.String()),
+				sdk.NewAttribute("min_amount_out", sliceMinOut.String()),
+			),
+		)
+		return nil
+	}
+
+	if err := k.ConsumeRateLimit(ctx, order.Did); err != nil {
+		return err
+	}
+	if err := k.ConsumeDailyVolume(ctx, order.Did, sliceIn); err != nil {
+		return err
+	}
+	timeout, err := k.ICATimeout(ctx, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	ctx = WithCallbackService(ctx, order.Service)
+	sequence, err := k.ExecuteSwap(
+		ctx,
+		order.Did,
+		order.ConnectionId,
+		netIn,
+		order.TokenOutDenom,
+		sliceMinOut,
+		[]types.SwapAmountInRoute{{PoolId: order.PoolId}},
+		timeout,
+	)
+	if err != nil {
+		return err
+	}
+	if err := k.CollectFee(ctx, order.Did, order.ConnectionId, types.FeeOperationSwap, sdk.NewCoins(fee), timeout); err != nil {
+		return err
+	}
+
+	executed, ok := math.NewIntFromString(order.AmountExecuted)
+	if !ok {
+		executed = math.ZeroInt()
+	}
+	order.AmountExecuted = executed.Add(sliceIn.Amount).String()
+	order.SlicesExecuted++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTWAMMSliceExecuted,
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute("slice", fmt.Sprintf("%d", order.SlicesExecuted)),
+			sdk.NewAttribute("token_in", netIn.String()),
+			sdk.NewAttribute("fee", fee.String()),
+			sdk.NewAttribute("min_amount_out", sliceMinOut.String()),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", sequence)),
+		),
+	)
+
+	if order.SlicesExecuted >= order.TotalSlices {
+		order.Status = types.TWAMMOrderStatusCompleted
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTWAMMOrderCompleted,
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+				sdk.NewAttribute("amount_executed", order.AmountExecuted),
+				sdk.NewAttribute("slices_skipped", fmt.Sprintf("%d", order.SlicesSkipped)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// setupTWAMM registers an active account for did on a fixture pricing swaps
+// through the oracle and charging a 30 bps swap fee
+func setupTWAMM(t *testing.T, did string) *testFixture {
+	t.Helper()
+	f := setupEstimate(t)
+	account, err := f.k.RegisterDEXAccount(f.ctx, did, testConnectionID, []string{"swap"})
+	require.NoError(t, err)
+	account.Status = types.ACCOUNT_STATUS_ACTIVE
+	account.AccountAddress = "cosmos1test"
+	require.NoError(t, f.k.Accounts.Set(f.ctx, keeper.GetAccountKey(did, testConnectionID), *account))
+	return f
+}
+
+func createTWAMMOrder(t *testing.T, f *testFixture, did string) uint64 {
+	t.Helper()
+	res, err := f.msgServer.CreateTWAMMOrder(f.ctx, &types.MsgCreateTWAMMOrder{
+		Did:            did,
+		ConnectionId:   testConnectionID,
+		PoolId:         1,
+		TokenIn:        sdk.NewInt64Coin("uatom", 10_000),
+		TokenOutDenom:  "uosmo",
+		MinAmountOut:   math.NewInt(1),
+		TotalSlices:    2,
+		IntervalBlocks: 5,
+	})
+	require.NoError(t, err)
+	return res.OrderId
+}
+
+func TestTWAMMSlicePaysFee(t *testing.T) {
+	did := "did:sonr:twamm"
+	f := setupTWAMM(t, did)
+	id := createTWAMMOrder(t, f, did)
+
+	ctx := f.ctx.WithBlockHeight(f.ctx.BlockHeight() + 1)
+	require.NoError(t, f.k.ExecuteDueTWAMMSlices(ctx))
+
+	// The slice swaps its input after the fee, and the fee follows it in the batch
+	batch, err := f.k.PendingBatches.Get(ctx, keeper.GetAccountKey(did, testConnectionID))
+	require.NoError(t, err)
+	require.Len(t, batch.Msgs, 2)
+	var swap types.OsmosisMsgSwapExactAmountIn
+	require.NoError(t, swap.Unmarshal(batch.Msgs[0].Value))
+	require.Equal(t, sdk.NewInt64Coin("uatom", 4_985), swap.TokenIn)
+	var send banktypes.MsgSend
+	require.NoError(t, send.Unmarshal(batch.Msgs[1].Value))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 15)), send.Amount)
+	require.Equal(t, uint64(f.k.DefaultICATimeout(ctx)/time.Second), batch.TimeoutSeconds)
+
+	res, err := f.queryServer.TWAMMOrder(ctx, &types.QueryTWAMMOrderRequest{OrderId: id})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Order.SlicesExecuted)
+	require.Equal(t, "5000", res.Order.AmountExecuted)
+	require.Equal(t, ctx.BlockHeight()+5, res.Order.NextExecutionHeight)
+	require.Equal(t, "active", res.Order.Status)
+
+	_, err = f.msgServer.CancelTWAMMOrder(ctx, &types.MsgCancelTWAMMOrder{Did: "did:sonr:mallory", OrderId: id})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.CancelTWAMMOrder(ctx, &types.MsgCancelTWAMMOrder{Did: did, OrderId: id})
+	require.NoError(t, err)
+
+	res, err = f.queryServer.TWAMMOrder(ctx, &types.QueryTWAMMOrderRequest{OrderId: id})
+	require.NoError(t, err)
+	require.Equal(t, "cancelled", res.Order.Status)
+	require.Zero(t, res.Order.NextExecutionHeight)
+}
+
+func TestTWAMMSliceRateLimited(t *testing.T) {
+	did := "did:sonr:twamm"
+	f := setupTWAMM(t, did)
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.RateLimits = types.RateLimitParams{MaxOpsPerDidPerDay: 1}
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+	require.NoError(t, f.k.ConsumeRateLimit(f.ctx, did))
+
+	id := createTWAMMOrder(t, f, did)
+	ctx := f.ctx.WithBlockHeight(f.ctx.BlockHeight() + 1)
+	require.NoError(t, f.k.ExecuteDueTWAMMSlices(ctx))
+
+	// The limited slice sends nothing and waits for the next interval
+	_, err = f.k.PendingBatches.Get(ctx, keeper.GetAccountKey(did, testConnectionID))
+	require.Error(t, err)
+	order, err := f.k.GetTWAMMOrder(ctx, id)
+	require.NoError(t, err)
+	require.Zero(t, order.SlicesExecuted)
+	require.Equal(t, ctx.BlockHeight()+5, order.NextExecutionHeight)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestUCANPermissionValidatorWired(t *testing.T) {
+	f := SetupTest(t)
+	require.NotNil(t, f.k.GetPermissionValidator())
+
+	// Every message carrying a token has it verified
+	_, err := f.msgServer.ExecuteSwap(f.ctx, &types.MsgExecuteSwap{
+		Did: "did:sonr:alice", ConnectionId: testConnectionID, UcanToken: "not-a-ucan",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.ProvideLiquidity(f.ctx, &types.MsgProvideLiquidity{
+		Did: "did:sonr:alice", ConnectionId: testConnectionID, UcanToken: "not-a-ucan",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.RemoveLiquidity(f.ctx, &types.MsgRemoveLiquidity{
+		Did: "did:sonr:alice", ConnectionId: testConnectionID, UcanToken: "not-a-ucan",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.CancelOrder(f.ctx, &types.MsgCancelOrder{
+		Did: "did:sonr:alice", ConnectionId: testConnectionID, OrderId: "x", UcanToken: "not-a-ucan",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// Messages without a token are not checked
+	_, err = f.msgServer.CancelOrder(f.ctx, &types.MsgCancelOrder{
+		Did: "did:sonr:alice", ConnectionId: testConnectionID, OrderId: "x",
+	})
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+}
+
+func TestUCANStrictModeWithoutValidator(t *testing.T) {
+	f := SetupTest(t)
+	f.k.SetPermissionValidator(nil)
+	msgServer := keeper.NewMsgServerImpl(f.k)
+	msg := &types.MsgCancelOrder{
+		Did: "did:sonr:alice", ConnectionId: testConnectionID, OrderId: "x", UcanToken: "not-a-ucan",
+	}
+
+	// Tokens are skipped by default
+	_, err := msgServer.CancelOrder(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{StrictUcan: true}))
+	_, err = msgServer.CancelOrder(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNoPermissionValidator)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+// ConsumeDailyVolume adds the USD value of tokenIn, priced by the oracle, to
+// the DID's volume for the day and rejects the swap when it would take the
+// volume past max_daily_volume, raised by verified_multiplier for verified
+// DIDs. Volume is not tracked while the cap is unset.
+func (k Keeper) ConsumeDailyVolume(ctx sdk.Context, did string, tokenIn sdk.Coin) error {
+	limit, capped, err := k.dailyVolumeCap(ctx, did)
+	if err != nil || !capped {
+		return err
+	}
+
+	value, err := k.usdValue(ctx, tokenIn)
+	if err != nil {
+		return err
+	}
+	used, err := k.dailyVolumeUsed(ctx, did)
+	if err != nil {
+		return err
+	}
+
+	total := used.Add(value)
+	if total.GT(limit) {
+		return errorsmod.Wrapf(
+			types.ErrDailyVolumeExceeded,
+			"%s has %s of %s left today, swap is worth %s", did, limit.Sub(used), limit, value,
+		)
+	}
+
+	return k.DailyVolumes.Set(ctx, did, types.DIDDailyVolume{
+		Day:    types.OpsDay(ctx.BlockTime()),
+		Volume: total.String(),
+	})
+}
+
+// GetDailyVolume returns the USD value the DID swapped today, the cap and
+// whether one is set
+func (k Keeper) GetDailyVolume(ctx sdk.Context, did string) (used, limit math.LegacyDec, capped bool, err error) {
+	limit, capped, err = k.dailyVolumeCap(ctx, did)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, false, err
+	}
+	used, err = k.dailyVolumeUsed(ctx, did)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, false, err
+	}
+	return used, limit, capped, nil
+}
+
+// dailyVolumeCap reads max_daily_volume from the params and applies the
+// DID's verified multiplier
+func (k Keeper) dailyVolumeCap(ctx sdk.Context, did string) (math.LegacyDec, bool, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, false, nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+	limit, capped, err := params.MaxDailyVolumeCap()
+	if err != nil || !capped {
+		return limit, capped, err
+	}
+	multiplier, err := k.verifiedMultiplier(ctx, did, params.RateLimits)
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+	return limit.MulInt64(int64(multiplier)), true, nil
+}
+
+// dailyVolumeUsed returns the USD value the DID swapped on the current day
+func (k Keeper) dailyVolumeUsed(ctx sdk.Context, did string) (math.LegacyDec, error) {
+	volume, err := k.DailyVolumes.Get(ctx, did)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, err
+	}
+	if volume.Day != types.OpsDay(ctx.BlockTime()) {
+		return math.LegacyZeroDec(), nil
+	}
+	return math.LegacyNewDecFromStr(volume.Volume)
+}
+
+// usdValue prices a coin with the oracle. Without an oracle, or a price for
+// the denom, a capped swap cannot be valued and is rejected.
+func (k Keeper) usdValue(ctx sdk.Context, coin sdk.Coin) (math.LegacyDec, error) {
+	price, err := k.assetPrice(ctx, coin.Denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return price.MulInt(coin.Amount), nil
+}
+
+// assetPrice returns the oracle's USD price of one base unit of denom
+func (k Keeper) assetPrice(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	if k.oracleKeeper == nil {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrPriceUnavailable, "no oracle configured")
+	}
+	price, err := k.oracleKeeper.GetAssetPrice(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "%s: %s", denom, err)
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "%s has no price", denom)
+	}
+	return price, nil
+}
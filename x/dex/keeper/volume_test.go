@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/keeper"
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+type mockOracleKeeper map[string]math.LegacyDec
+
+func (m mockOracleKeeper) GetAssetPrice(_ context.Context, denom string) (math.LegacyDec, error) {
+	price, ok := m[denom]
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("no price for %s", denom)
+	}
+	return price, nil
+}
+
+func TestDailyVolumeCap(t *testing.T) {
+	f := SetupTest(t)
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{MaxDailyVolume: "100"}))
+	day := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := f.ctx.WithBlockTime(day)
+
+	// Capped volume cannot be valued without an oracle
+	err := f.k.ConsumeDailyVolume(ctx, "did:sonr:alice", sdk.NewInt64Coin("uatom", 1))
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+
+	f.k.SetOracleKeeper(mockOracleKeeper{"uatom": math.LegacyMustNewDecFromStr("0.5")})
+	require.NoError(t, f.k.ConsumeDailyVolume(ctx, "did:sonr:alice", sdk.NewInt64Coin("uatom", 120)))
+	require.NoError(t, f.k.ConsumeDailyVolume(ctx, "did:sonr:alice", sdk.NewInt64Coin("uatom", 80)))
+	err = f.k.ConsumeDailyVolume(ctx, "did:sonr:alice", sdk.NewInt64Coin("uatom", 2))
+	require.ErrorIs(t, err, types.ErrDailyVolumeExceeded)
+	err = f.k.ConsumeDailyVolume(ctx, "did:sonr:alice", sdk.NewInt64Coin("ujuno", 1))
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+	require.NoError(t, f.k.ConsumeDailyVolume(ctx, "did:sonr:bob", sdk.NewInt64Coin("uatom", 2)))
+
+	res, err := keeper.NewQueryServerImpl(f.k).DailyVolume(ctx, &types.QueryDailyVolumeRequest{Did: "did:sonr:alice"})
+	require.NoError(t, err)
+	require.Equal(t, "100.000000000000000000", res.Used)
+	require.Equal(t, "0.000000000000000000", res.Remaining)
+
+	// A new UTC day starts from zero
+	next := ctx.WithBlockTime(day.Add(12 * time.Hour))
+	require.NoError(t, f.k.ConsumeDailyVolume(next, "did:sonr:alice", sdk.NewInt64Coin("uatom", 2)))
+	res, err = keeper.NewQueryServerImpl(f.k).DailyVolume(next, &types.QueryDailyVolumeRequest{Did: "did:sonr:alice"})
+	require.NoError(t, err)
+	require.Equal(t, "99.000000000000000000", res.Remaining)
+}
+
+func TestDailyVolumeUncapped(t *testing.T) {
+	f := SetupTest(t)
+
+	// No oracle is needed while the cap is unset
+	require.NoError(t, f.k.ConsumeDailyVolume(f.ctx, "did:sonr:alice", sdk.NewInt64Coin("uatom", 1_000_000)))
+
+	res, err := f.queryServer.DailyVolume(f.ctx, &types.QueryDailyVolumeRequest{Did: "did:sonr:alice"})
+	require.NoError(t, err)
+	require.Empty(t, res.Cap)
+	require.Empty(t, res.Remaining)
+}
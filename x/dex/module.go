@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
+	"cosmossdk.io/core/appmodule"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 )
 
@@ -25,6 +27,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasABCIEndBlock     = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
 )
 
 // AppModuleBasic is the module AppModuleBasic.
@@ -130,6 +134,48 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// BeginBlock resets the per-block operation counter used for rate limiting
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.ResetBlockOps(sdk.UnwrapSDKContext(ctx))
+}
+
+// EndBlock sends due TWAMM slices, expires limit orders past their
+// expiration, asks the host order books about open orders, flushes queued
+// ICA message batches whose flush interval has elapsed, samples oracle
+// prices into the price history and prunes epochs outside the retention
+// window, and drops recorded swaps older than the opposite swap detection
+// window.
+func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.ExecuteDueTWAMMSlices(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to execute TWAMM slices", "error", err)
+	}
+	if err := am.keeper.ExpireLimitOrders(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to expire limit orders", "error", err)
+	}
+	if err := am.keeper.MonitorLimitOrders(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to monitor limit orders", "error", err)
+	}
+	if err := am.keeper.FlushPendingBatches(sdkCtx, false); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to flush ICA batches", "error", err)
+	}
+	if err := am.keeper.RecordOraclePrices(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to record oracle prices", "error", err)
+	}
+	if err := am.keeper.PrunePriceHistory(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to prune price history", "error", err)
+	}
+	if err := am.keeper.PruneSwapIntents(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to prune swap intents", "error", err)
+	}
+
+	if err := am.keeper.ExpireOTCOffers(sdkCtx); err != nil {
+		am.keeper.Logger(sdkCtx).Error("failed to expire OTC offers", "error", err)
+	}
+
+	return []abci.ValidatorUpdate{}, nil
+}
+
 // ConsensusVersion returns the consensus state breaking version for the swap module.
 func (am AppModule) ConsensusVersion() uint64 { return 1 }
 
@@ -0,0 +1,66 @@
+package types
+
+import "fmt"
+
+const (
+	// MaxArbitrageWindowBlocks bounds how far back opposite swaps are looked up
+	MaxArbitrageWindowBlocks = 100
+
+	// MaxArbitrageFeeBps is the largest extra fee that may be charged (100%)
+	MaxArbitrageFeeBps = 10_000
+)
+
+// DefaultArbitrageParams returns the default parameters, which warn on
+// opposite swaps within the same block
+func DefaultArbitrageParams() ArbitrageParams {
+	return ArbitrageParams{
+		Enabled:      true,
+		WindowBlocks: 0,
+		Action:       ARBITRAGE_ACTION_WARN,
+	}
+}
+
+// Validate performs basic validation of the arbitrage parameters
+func (m ArbitrageParams) Validate() error {
+	if m.WindowBlocks > MaxArbitrageWindowBlocks {
+		return fmt.Errorf("window blocks cannot exceed %d", MaxArbitrageWindowBlocks)
+	}
+	switch m.Action {
+	case ARBITRAGE_ACTION_WARN, ARBITRAGE_ACTION_REJECT:
+	case ARBITRAGE_ACTION_FEE:
+		if m.ExtraFeeBps == 0 {
+			return fmt.Errorf("extra fee must be positive when action is fee")
+		}
+	default:
+		return fmt.Errorf("unknown arbitrage action %d", m.Action)
+	}
+	if m.ExtraFeeBps > MaxArbitrageFeeBps {
+		return fmt.Errorf("extra fee cannot exceed %d bps", MaxArbitrageFeeBps)
+	}
+	return nil
+}
+
+// SwapIntent records a swap direction submitted by a DID at a height
+type SwapIntent struct {
+	ConnectionId  string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	TokenIn       string `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (SwapIntent) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *SwapIntent) Reset() {
+	*m = SwapIntent{}
+}
+
+// String implements proto.Message
+func (m SwapIntent) String() string {
+	return fmt.Sprintf("%s -> %s on %s", m.TokenIn, m.TokenOutDenom, m.ConnectionId)
+}
+
+// SwapPairKey identifies a swap direction from denomIn to denomOut
+func SwapPairKey(denomIn, denomOut string) string {
+	return denomIn + ">" + denomOut
+}
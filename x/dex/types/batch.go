@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+const (
+	// DefaultMaxMsgsPerPacket is the default number of messages batched into one CosmosTx
+	DefaultMaxMsgsPerPacket = 16
+
+	// DefaultMaxPacketBytes is the default maximum serialized size of a batched CosmosTx
+	DefaultMaxPacketBytes = 64 * 1024
+
+	// DefaultFlushIntervalBlocks is the default number of blocks a batch may wait before flushing
+	DefaultFlushIntervalBlocks = 1
+)
+
+// DefaultBatchParams returns the default batching parameters
+func DefaultBatchParams() BatchParams {
+	return BatchParams{
+		Enabled:             true,
+		MaxMsgsPerPacket:    DefaultMaxMsgsPerPacket,
+		MaxPacketBytes:      DefaultMaxPacketBytes,
+		FlushIntervalBlocks: DefaultFlushIntervalBlocks,
+	}
+}
+
+// Validate performs basic validation of the batching parameters
+func (m BatchParams) Validate() error {
+	if m.MaxMsgsPerPacket == 0 {
+		return fmt.Errorf("max msgs per packet must be positive")
+	}
+	if m.MaxPacketBytes == 0 {
+		return fmt.Errorf("max packet bytes must be positive")
+	}
+	return nil
+}
@@ -27,6 +27,25 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, ModuleName+"/MsgRemoveLiquidity", nil)
 	cdc.RegisterConcrete(&MsgCreateLimitOrder{}, ModuleName+"/MsgCreateLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelOrder{}, ModuleName+"/MsgCancelOrder", nil)
+	cdc.RegisterConcrete(&MsgUpdateBatchParams{}, ModuleName+"/MsgUpdateBatchParams", nil)
+	cdc.RegisterConcrete(&MsgSetDenomFilter{}, ModuleName+"/MsgSetDenomFilter", nil)
+	cdc.RegisterConcrete(&MsgRemoveDenomFilter{}, ModuleName+"/MsgRemoveDenomFilter", nil)
+	cdc.RegisterConcrete(&MsgCreateOTCOffer{}, ModuleName+"/MsgCreateOTCOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptOTCOffer{}, ModuleName+"/MsgAcceptOTCOffer", nil)
+	cdc.RegisterConcrete(&MsgConfirmOTCOffer{}, ModuleName+"/MsgConfirmOTCOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelOTCOffer{}, ModuleName+"/MsgCancelOTCOffer", nil)
+	cdc.RegisterConcrete(&MsgResolveOTCOffer{}, ModuleName+"/MsgResolveOTCOffer", nil)
+	cdc.RegisterConcrete(&MsgRefreshPoolReserves{}, ModuleName+"/MsgRefreshPoolReserves", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedConnection{}, ModuleName+"/MsgAddAllowedConnection", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedConnection{}, ModuleName+"/MsgRemoveAllowedConnection", nil)
+	cdc.RegisterConcrete(&MsgRegisterProtocolAccount{}, ModuleName+"/MsgRegisterProtocolAccount", nil)
+	cdc.RegisterConcrete(&MsgFundProtocolAccount{}, ModuleName+"/MsgFundProtocolAccount", nil)
+	cdc.RegisterConcrete(&MsgGovProvideLiquidity{}, ModuleName+"/MsgGovProvideLiquidity", nil)
+	cdc.RegisterConcrete(&MsgGovRemoveLiquidity{}, ModuleName+"/MsgGovRemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgCreateTWAMMOrder{}, ModuleName+"/MsgCreateTWAMMOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelTWAMMOrder{}, ModuleName+"/MsgCancelTWAMMOrder", nil)
+	cdc.RegisterConcrete(&MsgUpdatePriceHistoryParams{}, ModuleName+"/MsgUpdatePriceHistoryParams", nil)
 }
 
 // RegisterInterfaces registers the x/dex interfaces types with a given
@@ -40,6 +59,25 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRemoveLiquidity{},
 		&MsgCreateLimitOrder{},
 		&MsgCancelOrder{},
+		&MsgUpdateBatchParams{},
+		&MsgSetDenomFilter{},
+		&MsgRemoveDenomFilter{},
+		&MsgCreateOTCOffer{},
+		&MsgAcceptOTCOffer{},
+		&MsgConfirmOTCOffer{},
+		&MsgCancelOTCOffer{},
+		&MsgResolveOTCOffer{},
+		&MsgRefreshPoolReserves{},
+		&MsgUpdateParams{},
+		&MsgAddAllowedConnection{},
+		&MsgRemoveAllowedConnection{},
+		&MsgRegisterProtocolAccount{},
+		&MsgFundProtocolAccount{},
+		&MsgGovProvideLiquidity{},
+		&MsgGovRemoveLiquidity{},
+		&MsgCreateTWAMMOrder{},
+		&MsgCancelTWAMMOrder{},
+		&MsgUpdatePriceHistoryParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomWildcard matches any denom sharing the preceding prefix, e.g. "ibc/*"
+const DenomWildcard = "*"
+
+// Validate performs basic validation of the denom filter
+func (m DenomFilter) Validate() error {
+	if m.ConnectionId == "" {
+		return fmt.Errorf("connection ID cannot be empty")
+	}
+	for _, pattern := range append(append([]string{}, m.AllowedDenoms...), m.DeniedDenoms...) {
+		if err := validateDenomPattern(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsAllowed reports whether a denom may be traded on the filter's connection.
+// Deny entries always win; an empty allow list permits every denom.
+func (m DenomFilter) IsAllowed(denom string) bool {
+	for _, pattern := range m.DeniedDenoms {
+		if matchDenom(pattern, denom) {
+			return false
+		}
+	}
+
+	if len(m.AllowedDenoms) == 0 {
+		return true
+	}
+
+	for _, pattern := range m.AllowedDenoms {
+		if matchDenom(pattern, denom) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchDenom(pattern, denom string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, DenomWildcard); ok {
+		return strings.HasPrefix(denom, prefix)
+	}
+	return pattern == denom
+}
+
+func validateDenomPattern(pattern string) error {
+	if pattern == DenomWildcard {
+		return nil
+	}
+	denom := strings.TrimSuffix(pattern, DenomWildcard)
+	if strings.Contains(denom, DenomWildcard) {
+		return fmt.Errorf("invalid denom pattern %q: wildcard only allowed as suffix", pattern)
+	}
+	if strings.HasSuffix(pattern, DenomWildcard) {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid denom pattern %q: %w", pattern, err)
+	}
+	return nil
+}
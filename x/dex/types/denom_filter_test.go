@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestDenomFilterIsAllowed(t *testing.T) {
+	filter := types.DenomFilter{
+		ConnectionId:  "connection-0",
+		AllowedDenoms: []string{"uosmo", "ibc/*"},
+		DeniedDenoms:  []string{"ibc/SCAM"},
+	}
+
+	require.True(t, filter.IsAllowed("uosmo"))
+	require.True(t, filter.IsAllowed("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+	require.False(t, filter.IsAllowed("ibc/SCAM"))
+	require.False(t, filter.IsAllowed("uatom"))
+
+	// An empty allow list permits everything that is not denied
+	denyOnly := types.DenomFilter{ConnectionId: "connection-1", DeniedDenoms: []string{"uscam"}}
+	require.True(t, denyOnly.IsAllowed("uatom"))
+	require.False(t, denyOnly.IsAllowed("uscam"))
+}
+
+func TestDenomFilterValidate(t *testing.T) {
+	require.NoError(t, types.DenomFilter{
+		ConnectionId:  "connection-0",
+		AllowedDenoms: []string{"uosmo", "ibc/*", "*"},
+	}.Validate())
+
+	require.Error(t, types.DenomFilter{AllowedDenoms: []string{"uosmo"}}.Validate())
+	require.Error(t, types.DenomFilter{
+		ConnectionId: "connection-0",
+		DeniedDenoms: []string{"ib*c/"},
+	}.Validate())
+}
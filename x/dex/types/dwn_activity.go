@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// DEX activity is written to the DWN vault of the DID it belongs to under
+// this protocol and schema
+const (
+	DWNActivityProtocol   = "https://sonr.io/protocols/dex"
+	DWNActivitySchema     = "https://sonr.io/schemas/dex/activity/v1"
+	DWNActivityDataFormat = "application/json"
+
+	// DWNActivitySchemaVersion is the version of the activity documents
+	// written to DWN. Readers reject documents from newer versions.
+	DWNActivitySchemaVersion uint32 = 1
+)
+
+// dwnActivityDocument is the JSON document stored in a DWN activity record
+type dwnActivityDocument struct {
+	Version     uint32          `json:"version"`
+	ActivityKey string          `json:"activity_key"`
+	Activity    json.RawMessage `json:"activity"`
+}
+
+// EncodeDWNActivity serializes an activity into a versioned DWN document
+func EncodeDWNActivity(cdc codec.JSONCodec, activityKey string, activity DEXActivity) ([]byte, error) {
+	bz, err := cdc.MarshalJSON(&activity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode activity: %w", err)
+	}
+	return json.Marshal(dwnActivityDocument{
+		Version:     DWNActivitySchemaVersion,
+		ActivityKey: activityKey,
+		Activity:    bz,
+	})
+}
+
+// DecodeDWNActivity reads an activity from a DWN document and returns its
+// key and schema version
+func DecodeDWNActivity(cdc codec.JSONCodec, data []byte) (string, uint32, DEXActivity, error) {
+	var doc dwnActivityDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", 0, DEXActivity{}, fmt.Errorf("failed to decode activity document: %w", err)
+	}
+	if doc.Version == 0 || doc.Version > DWNActivitySchemaVersion {
+		return "", 0, DEXActivity{}, fmt.Errorf("unsupported activity schema version %d", doc.Version)
+	}
+
+	var activity DEXActivity
+	if err := cdc.UnmarshalJSON(doc.Activity, &activity); err != nil {
+		return "", 0, DEXActivity{}, fmt.Errorf("failed to decode activity: %w", err)
+	}
+	return doc.ActivityKey, doc.Version, activity, nil
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestDWNActivityDocument(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	activity := types.DEXActivity{
+		Type:      "swap",
+		Did:       "did:sonr:alice",
+		Timestamp: time.Unix(1_700_000_000, 0).UTC(),
+		Status:    "completed",
+	}
+
+	bz, err := types.EncodeDWNActivity(cdc, "swap_activity_1", activity)
+	require.NoError(t, err)
+
+	key, version, decoded, err := types.DecodeDWNActivity(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, "swap_activity_1", key)
+	require.Equal(t, types.DWNActivitySchemaVersion, version)
+	require.Equal(t, activity.Status, decoded.Status)
+	require.True(t, activity.Timestamp.Equal(decoded.Timestamp))
+
+	_, _, _, err = types.DecodeDWNActivity(cdc, []byte(`{"version":2,"activity_key":"k","activity":{}}`))
+	require.ErrorContains(t, err, "unsupported activity schema version 2")
+	_, _, _, err = types.DecodeDWNActivity(cdc, []byte(`not json`))
+	require.Error(t, err)
+}
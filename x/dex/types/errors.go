@@ -14,4 +14,28 @@ var (
 	ErrInvalidLiquidityParams = sdkerrors.Register(ModuleName, 9, "invalid liquidity parameters")
 	ErrInvalidOrderParams     = sdkerrors.Register(ModuleName, 10, "invalid order parameters")
 	ErrICAOperationFailed     = sdkerrors.Register(ModuleName, 11, "ICA operation failed")
+	ErrDenomNotAllowed        = sdkerrors.Register(ModuleName, 12, "denom not allowed on connection")
+	ErrTWAMMOrderNotFound     = sdkerrors.Register(ModuleName, 13, "TWAMM order not found")
+	ErrTWAMMOrderNotActive    = sdkerrors.Register(ModuleName, 14, "TWAMM order not active")
+	ErrDeadlineElapsed        = sdkerrors.Register(ModuleName, 15, "deadline elapsed")
+	ErrOppositeSwap           = sdkerrors.Register(ModuleName, 16, "opposite swap across connections")
+	ErrOrderNotFound          = sdkerrors.Register(ModuleName, 17, "order not found")
+	ErrOrderNotOpen           = sdkerrors.Register(ModuleName, 18, "order not open")
+	ErrBlockRateLimited       = sdkerrors.Register(ModuleName, 19, "block operation limit reached")
+	ErrDIDRateLimited         = sdkerrors.Register(ModuleName, 20, "daily DID operation limit reached")
+	ErrRateLimitCooldown      = sdkerrors.Register(ModuleName, 21, "DID operation cooldown active")
+	ErrDailyVolumeExceeded    = sdkerrors.Register(ModuleName, 22, "daily volume cap exceeded")
+	ErrPriceUnavailable       = sdkerrors.Register(ModuleName, 23, "asset price unavailable")
+	ErrOTCOfferNotFound       = sdkerrors.Register(ModuleName, 24, "OTC offer not found")
+	ErrInvalidOTCOffer        = sdkerrors.Register(ModuleName, 25, "invalid OTC offer")
+	ErrOTCOfferState          = sdkerrors.Register(ModuleName, 26, "OTC offer not in required state")
+	ErrNoSwapRoute            = sdkerrors.Register(ModuleName, 27, "no swap route")
+	ErrConnectionNotAllowed   = sdkerrors.Register(ModuleName, 28, "connection not allowed")
+	ErrNoPermissionValidator  = sdkerrors.Register(ModuleName, 29, "UCAN permission validator not configured")
+	ErrPositionNotFound       = sdkerrors.Register(ModuleName, 30, "protocol liquidity position not found")
+	ErrPositionLocked         = sdkerrors.Register(ModuleName, 31, "protocol liquidity position locked")
+	ErrICAPortCollision       = sdkerrors.Register(ModuleName, 32, "interchain account port already taken")
+	ErrScreeningBlocked       = sdkerrors.Register(ModuleName, 33, "operation blocked by compliance screening")
+	ErrScreeningUnavailable   = sdkerrors.Register(ModuleName, 34, "compliance screening unavailable")
+	ErrTooManyAccounts        = sdkerrors.Register(ModuleName, 35, "DEX account limit per DID reached")
 )
@@ -3,14 +3,17 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	didtypes "github.com/sonr-io/sonr/x/did/types"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
 )
 
 // AccountKeeper defines the expected account keeper
@@ -25,6 +28,8 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // ICAControllerKeeper defines the expected ICA controller keeper
@@ -85,8 +90,26 @@ type ScopedKeeper interface {
 
 // DIDKeeper defines the expected DID keeper
 type DIDKeeper interface {
-	// GetDIDDocument retrieves a DID document
-	GetDIDDocument(ctx context.Context, did string) (*didtypes.DIDDocument, error)
+	// GetDIDSummary retrieves the controller and status of a DID
+	GetDIDSummary(ctx context.Context, did string) (didtypes.DIDSummary, error)
+}
+
+// OracleKeeper defines the expected oracle keeper
+type OracleKeeper interface {
+	// GetAssetPrice returns the USD price of one base unit of a denom
+	GetAssetPrice(ctx context.Context, denom string) (math.LegacyDec, error)
+}
+
+// BadgeKeeper defines the expected verification badge keeper
+type BadgeKeeper interface {
+	// IsVerified reports whether a DID, or a service its controller owns,
+	// holds an active verification badge
+	IsVerified(ctx context.Context, did string) (bool, error)
+}
+
+// TransferKeeper defines the expected ICS-20 transfer keeper
+type TransferKeeper interface {
+	Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
 }
 
 // UCANKeeper defines the expected UCAN keeper (placeholder)
@@ -95,7 +118,10 @@ type UCANKeeper interface {
 	ValidateCapability(ctx sdk.Context, token string, resource string, ability string) error
 }
 
-// DWNKeeper defines the expected DWN keeper
+// DWNKeeper defines the expected DWN keeper, which stores DEX activity in the
+// DWN vault of each DID
 type DWNKeeper interface {
-	// Placeholder interface - will be implemented when DWN methods are available
+	RecordsWrite(ctx context.Context, msg *dwntypes.MsgRecordsWrite) (*dwntypes.MsgRecordsWriteResponse, error)
+	RecordsDelete(ctx context.Context, msg *dwntypes.MsgRecordsDelete) (*dwntypes.MsgRecordsDeleteResponse, error)
+	Record(ctx context.Context, req *dwntypes.QueryRecordRequest) (*dwntypes.QueryRecordResponse, error)
 }
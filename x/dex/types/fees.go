@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxFeeBps is the largest platform fee that may be charged (100%)
+const MaxFeeBps = 10_000
+
+// Operations platform fees are charged on
+const (
+	FeeOperationSwap      = "swap"
+	FeeOperationLiquidity = "liquidity"
+	FeeOperationOrder     = "order"
+)
+
+// Validate checks the fee rates and collectors. Fees are paid on the host
+// chain, so charging any fee requires a fee collector on at least one
+// connection.
+func (m FeeParams) Validate() error {
+	seen := make(map[string]bool, len(m.FeeCollectors))
+	for _, collector := range m.FeeCollectors {
+		if err := host.ConnectionIdentifierValidator(collector.ConnectionId); err != nil {
+			return fmt.Errorf("invalid fee collector connection %q: %w", collector.ConnectionId, err)
+		}
+		if collector.Address == "" {
+			return fmt.Errorf("fee collector for %s requires an address", collector.ConnectionId)
+		}
+		if seen[collector.ConnectionId] {
+			return fmt.Errorf("duplicate fee collector for %s", collector.ConnectionId)
+		}
+		seen[collector.ConnectionId] = true
+	}
+
+	for _, operation := range []string{FeeOperationSwap, FeeOperationLiquidity, FeeOperationOrder} {
+		bps := m.Bps(operation)
+		if bps > MaxFeeBps {
+			return fmt.Errorf("%s fee cannot exceed %d bps", operation, MaxFeeBps)
+		}
+		if bps > 0 && len(m.FeeCollectors) == 0 {
+			return fmt.Errorf("%s fee requires a fee collector", operation)
+		}
+	}
+	return nil
+}
+
+// Collector returns the fee collector address on a connection's host chain,
+// or "" when fees are not collected there
+func (m FeeParams) Collector(connectionID string) string {
+	for _, collector := range m.FeeCollectors {
+		if collector.ConnectionId == connectionID {
+			return collector.Address
+		}
+	}
+	return ""
+}
+
+// Bps returns the fee rate of an operation
+func (m FeeParams) Bps(operation string) uint32 {
+	switch operation {
+	case FeeOperationSwap:
+		return m.SwapFeeBps
+	case FeeOperationLiquidity:
+		return m.LiquidityFeeBps
+	case FeeOperationOrder:
+		return m.OrderFeeBps
+	default:
+		return 0
+	}
+}
+
+// Fee returns the platform fee an operation on a connection pays on coin,
+// rounded down. No fee is charged on a connection without a fee collector.
+func (m FeeParams) Fee(operation, connectionID string, coin sdk.Coin) sdk.Coin {
+	bps := m.Bps(operation)
+	if bps == 0 || m.Collector(connectionID) == "" {
+		return sdk.NewCoin(coin.Denom, coin.Amount.ZeroInt())
+	}
+	return sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(bps)).QuoRaw(MaxFeeBps))
+}
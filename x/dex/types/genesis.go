@@ -1,11 +1,18 @@
 package types
 
-import host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
 
 // DefaultGenesisState returns the default module GenesisState.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId: PortID,
+		PortId:             PortID,
+		Params:             Params{Arbitrage: DefaultArbitrageParams()},
+		BatchParams:        DefaultBatchParams(),
+		PriceHistoryParams: DefaultPriceHistoryParams(),
 	}
 }
 
@@ -22,5 +29,33 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	seen := make(map[string]bool, len(gs.DenomFilters))
+	for _, filter := range gs.DenomFilters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+		if seen[filter.ConnectionId] {
+			return fmt.Errorf("duplicate denom filter for %s", filter.ConnectionId)
+		}
+		seen[filter.ConnectionId] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Genesis files that predate batching leave the params unset
+	if gs.BatchParams != (BatchParams{}) {
+		if err := gs.BatchParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if gs.PriceHistoryParams != (PriceHistoryParams{}) {
+		if err := gs.PriceHistoryParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
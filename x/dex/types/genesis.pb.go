@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +25,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ScreeningMode is how compliance screening decisions are enforced
+type ScreeningMode int32
+
+const (
+	// Operations are not screened
+	SCREENING_MODE_OFF ScreeningMode = 0
+	// Operations are screened and decisions recorded, but none is refused
+	SCREENING_MODE_LOG_ONLY ScreeningMode = 1
+	// Flagged operations are refused, as are operations that could not be
+	// screened
+	SCREENING_MODE_BLOCK ScreeningMode = 2
+)
+
+var ScreeningMode_name = map[int32]string{
+	0: "SCREENING_MODE_OFF",
+	1: "SCREENING_MODE_LOG_ONLY",
+	2: "SCREENING_MODE_BLOCK",
+}
+
+var ScreeningMode_value = map[string]int32{
+	"SCREENING_MODE_OFF":      0,
+	"SCREENING_MODE_LOG_ONLY": 1,
+	"SCREENING_MODE_BLOCK":    2,
+}
+
+func (x ScreeningMode) String() string {
+	return proto.EnumName(ScreeningMode_name, int32(x))
+}
+
+func (ScreeningMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{0}
+}
+
+// ArbitrageAction is how an economically opposite swap is handled
+type ArbitrageAction int32
+
+const (
+	// Only emits an event
+	ARBITRAGE_ACTION_WARN ArbitrageAction = 0
+	// Charges an extra fee on the swap input
+	ARBITRAGE_ACTION_FEE ArbitrageAction = 1
+	// Fails the swap
+	ARBITRAGE_ACTION_REJECT ArbitrageAction = 2
+)
+
+var ArbitrageAction_name = map[int32]string{
+	0: "ARBITRAGE_ACTION_WARN",
+	1: "ARBITRAGE_ACTION_FEE",
+	2: "ARBITRAGE_ACTION_REJECT",
+}
+
+var ArbitrageAction_value = map[string]int32{
+	"ARBITRAGE_ACTION_WARN":   0,
+	"ARBITRAGE_ACTION_FEE":    1,
+	"ARBITRAGE_ACTION_REJECT": 2,
+}
+
+func (x ArbitrageAction) String() string {
+	return proto.EnumName(ArbitrageAction_name, int32(x))
+}
+
+func (ArbitrageAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{1}
+}
+
 // GenesisState defines the DEX module's genesis state
 type GenesisState struct {
 	// Module parameters
@@ -33,6 +100,12 @@ type GenesisState struct {
 	Accounts []*InterchainDEXAccount `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	// Account sequence counter
 	AccountSequence uint64 `protobuf:"varint,4,opt,name=account_sequence,json=accountSequence,proto3" json:"account_sequence,omitempty"`
+	// ICA batching parameters
+	BatchParams BatchParams `protobuf:"bytes,5,opt,name=batch_params,json=batchParams,proto3" json:"batch_params"`
+	// Per-connection denom allow/deny lists
+	DenomFilters []DenomFilter `protobuf:"bytes,6,rep,name=denom_filters,json=denomFilters,proto3" json:"denom_filters"`
+	// Price history aggregation parameters
+	PriceHistoryParams PriceHistoryParams `protobuf:"bytes,7,opt,name=price_history_params,json=priceHistoryParams,proto3" json:"price_history_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +169,27 @@ func (m *GenesisState) GetAccountSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBatchParams() BatchParams {
+	if m != nil {
+		return m.BatchParams
+	}
+	return BatchParams{}
+}
+
+func (m *GenesisState) GetDenomFilters() []DenomFilter {
+	if m != nil {
+		return m.DenomFilters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistoryParams() PriceHistoryParams {
+	if m != nil {
+		return m.PriceHistoryParams
+	}
+	return PriceHistoryParams{}
+}
+
 // Params defines the parameters for the DEX module
 type Params struct {
 	// Enable/disable the module
@@ -114,6 +208,21 @@ type Params struct {
 	RateLimits RateLimitParams `protobuf:"bytes,7,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 	// Fee parameters
 	Fees FeeParams `protobuf:"bytes,8,opt,name=fees,proto3" json:"fees"`
+	// Connections to Noble whose swaps are routed over IBC transfer
+	NobleRoutes []NobleRoute `protobuf:"bytes,9,rep,name=noble_routes,json=nobleRoutes,proto3" json:"noble_routes"`
+	// Swap estimate pricing parameters
+	Pricing PricingParams `protobuf:"bytes,10,opt,name=pricing,proto3" json:"pricing"`
+	// Pools the swap router may route through
+	Routing RoutingParams `protobuf:"bytes,11,opt,name=routing,proto3" json:"routing"`
+	// Remote order book monitoring
+	OrderMonitor OrderMonitorParams `protobuf:"bytes,12,opt,name=order_monitor,json=orderMonitor,proto3" json:"order_monitor"`
+	// Reject UCAN tokens when no permission validator is configured instead
+	// of skipping their validation
+	StrictUcan bool `protobuf:"varint,13,opt,name=strict_ucan,json=strictUcan,proto3" json:"strict_ucan,omitempty"`
+	// Compliance screening of large swaps, orders and OTC offers
+	Screening ScreeningParams `protobuf:"bytes,14,opt,name=screening,proto3" json:"screening"`
+	// Detection of opposite swaps across connections
+	Arbitrage ArbitrageParams `protobuf:"bytes,15,opt,name=arbitrage,proto3" json:"arbitrage"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -157,6 +266,9 @@ type RateLimitParams struct {
 	MaxOpsPerDidPerDay uint32 `protobuf:"varint,2,opt,name=max_ops_per_did_per_day,json=maxOpsPerDidPerDay,proto3" json:"max_ops_per_did_per_day,omitempty"`
 	// Cooldown period between operations (in blocks)
 	CooldownBlocks uint32 `protobuf:"varint,3,opt,name=cooldown_blocks,json=cooldownBlocks,proto3" json:"cooldown_blocks,omitempty"`
+	// Factor applied to max_ops_per_did_per_day and max_daily_volume for DIDs
+	// holding a verification badge; 0 and 1 grant verified DIDs no more
+	VerifiedMultiplier uint32 `protobuf:"varint,4,opt,name=verified_multiplier,json=verifiedMultiplier,proto3" json:"verified_multiplier,omitempty"`
 }
 
 func (m *RateLimitParams) Reset()         { *m = RateLimitParams{} }
@@ -213,6 +325,13 @@ func (m *RateLimitParams) GetCooldownBlocks() uint32 {
 	return 0
 }
 
+func (m *RateLimitParams) GetVerifiedMultiplier() uint32 {
+	if m != nil {
+		return m.VerifiedMultiplier
+	}
+	return 0
+}
+
 // FeeParams defines fee parameters for DEX operations
 type FeeParams struct {
 	// Platform fee for swaps (basis points, e.g., 30 = 0.3%)
@@ -221,8 +340,9 @@ type FeeParams struct {
 	LiquidityFeeBps uint32 `protobuf:"varint,2,opt,name=liquidity_fee_bps,json=liquidityFeeBps,proto3" json:"liquidity_fee_bps,omitempty"`
 	// Platform fee for orders
 	OrderFeeBps uint32 `protobuf:"varint,3,opt,name=order_fee_bps,json=orderFeeBps,proto3" json:"order_fee_bps,omitempty"`
-	// Fee collector address
-	FeeCollector string `protobuf:"bytes,4,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// Fee collector address on the host chain of each connection; operations
+	// on a connection without one are not charged
+	FeeCollectors []FeeCollector `protobuf:"bytes,5,rep,name=fee_collectors,json=feeCollectors,proto3" json:"fee_collectors"`
 }
 
 func (m *FeeParams) Reset()         { *m = FeeParams{} }
@@ -279,413 +399,3974 @@ func (m *FeeParams) GetOrderFeeBps() uint32 {
 	return 0
 }
 
-func (m *FeeParams) GetFeeCollector() string {
+func (m *FeeParams) GetFeeCollectors() []FeeCollector {
 	if m != nil {
-		return m.FeeCollector
+		return m.FeeCollectors
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "dex.v1.GenesisState")
-	proto.RegisterType((*Params)(nil), "dex.v1.Params")
-	proto.RegisterType((*RateLimitParams)(nil), "dex.v1.RateLimitParams")
-	proto.RegisterType((*FeeParams)(nil), "dex.v1.FeeParams")
+// FeeCollector is the address platform fees are sent to on a connection's
+// host chain
+type FeeCollector struct {
+	// IBC connection the collector receives fees on
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Address on the host chain
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("dex/v1/genesis.proto", fileDescriptor_12a0429c56f27456) }
+func (m *FeeCollector) Reset()         { *m = FeeCollector{} }
+func (m *FeeCollector) String() string { return proto.CompactTextString(m) }
+func (*FeeCollector) ProtoMessage()    {}
+func (*FeeCollector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{4}
+}
+func (m *FeeCollector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCollector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCollector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeCollector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCollector.Merge(m, src)
+}
+func (m *FeeCollector) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCollector) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCollector.DiscardUnknown(m)
+}
 
-var fileDescriptor_12a0429c56f27456 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xce, 0xfe, 0x92, 0x5f, 0xda, 0x4c, 0x9a, 0xa6, 0x1d, 0x2b, 0x59, 0x8a, 0xa4, 0x21, 0x05,
-	0x4d, 0xfd, 0x93, 0xa5, 0x2d, 0x88, 0x08, 0x0a, 0x4d, 0x63, 0xa5, 0x20, 0x58, 0x36, 0x22, 0xe2,
-	0x65, 0x99, 0xec, 0xbc, 0x49, 0x07, 0x77, 0x67, 0xb6, 0x3b, 0x93, 0x26, 0x39, 0x7a, 0xf3, 0xe8,
-	0xd9, 0x93, 0xf8, 0x31, 0xfc, 0x04, 0x3d, 0xf6, 0xe8, 0x49, 0xa4, 0xfd, 0x22, 0x32, 0x33, 0xbb,
-	0xd1, 0x7a, 0xd9, 0x99, 0x79, 0x9e, 0xe7, 0x7d, 0x78, 0xe6, 0x7d, 0x77, 0xd0, 0x06, 0x85, 0x99,
-	0x77, 0xbe, 0xeb, 0x8d, 0x81, 0x83, 0x64, 0xb2, 0x9b, 0xa4, 0x42, 0x09, 0x5c, 0xa6, 0x30, 0xeb,
-	0x9e, 0xef, 0x6e, 0x6e, 0x8c, 0xc5, 0x58, 0x18, 0xc8, 0xd3, 0x3b, 0xcb, 0x6e, 0xae, 0x65, 0x35,
-	0x2c, 0x24, 0x16, 0x69, 0x7f, 0x77, 0xd0, 0xca, 0x4b, 0xeb, 0x30, 0x50, 0x44, 0x01, 0x7e, 0x88,
-	0xca, 0x09, 0x49, 0x49, 0x2c, 0x5d, 0xa7, 0xe5, 0x74, 0xaa, 0x7b, 0xab, 0x5d, 0xeb, 0xd8, 0x3d,
-	0x31, 0x68, 0xaf, 0x74, 0xf1, 0x73, 0xab, 0xe0, 0x67, 0x1a, 0xdc, 0x40, 0x4b, 0x89, 0x48, 0x55,
-	0xc0, 0xa8, 0xfb, 0x5f, 0xcb, 0xe9, 0x54, 0xfc, 0xb2, 0x3e, 0x1e, 0x53, 0xfc, 0x04, 0x2d, 0x93,
-	0x30, 0x14, 0x13, 0xae, 0xa4, 0x5b, 0x6c, 0x15, 0x3b, 0xd5, 0xbd, 0x3b, 0xb9, 0xd1, 0x31, 0x57,
-	0x90, 0x86, 0xa7, 0x84, 0xf1, 0xfe, 0x8b, 0x77, 0x07, 0x56, 0xe4, 0x2f, 0xd4, 0x78, 0x07, 0xad,
-	0x65, 0xfb, 0x40, 0xc2, 0xd9, 0x04, 0x78, 0x08, 0x6e, 0xa9, 0xe5, 0x74, 0x4a, 0x7e, 0x3d, 0xc3,
-	0x07, 0x19, 0xdc, 0xfe, 0x58, 0x44, 0x65, 0x1b, 0x0b, 0xbb, 0x68, 0x09, 0x38, 0x19, 0x46, 0x40,
-	0x4d, 0xee, 0x65, 0x3f, 0x3f, 0x62, 0x0f, 0x6d, 0xc4, 0x64, 0x16, 0xe4, 0xfe, 0x41, 0x02, 0x69,
-	0x40, 0xb3, 0xbc, 0x35, 0x7f, 0x3d, 0x26, 0xb3, 0x2c, 0x83, 0x3c, 0x81, 0xb4, 0xcf, 0x28, 0x7e,
-	0x8c, 0x1a, 0x14, 0x46, 0x64, 0x12, 0xa9, 0x40, 0xb1, 0x18, 0xc4, 0x44, 0x07, 0x09, 0x05, 0xa7,
-	0xfa, 0x26, 0x3a, 0xc7, 0xed, 0x8c, 0x7e, 0x63, 0xd9, 0x81, 0x25, 0xb1, 0x87, 0x6e, 0x91, 0x28,
-	0x12, 0x53, 0xa0, 0x41, 0x28, 0x38, 0x87, 0x50, 0x31, 0xc1, 0xa5, 0x5b, 0x6a, 0x15, 0x3b, 0x15,
-	0x1f, 0x67, 0xd4, 0xe1, 0x1f, 0x06, 0xdf, 0x45, 0xf5, 0x98, 0xf1, 0x40, 0x4e, 0x49, 0x12, 0x90,
-	0x58, 0x47, 0x70, 0xff, 0x37, 0x4d, 0xac, 0xc5, 0x8c, 0x0f, 0xa6, 0x24, 0x39, 0x30, 0x20, 0xee,
-	0xa0, 0x35, 0x7d, 0x03, 0x4a, 0x58, 0x34, 0x0f, 0xce, 0x45, 0x34, 0x89, 0xc1, 0x2d, 0x1b, 0xe1,
-	0x6a, 0x4c, 0x66, 0x7d, 0x0d, 0xbf, 0x35, 0x28, 0x7e, 0x8e, 0xaa, 0x29, 0x51, 0x10, 0x44, 0x2c,
-	0x66, 0x4a, 0xba, 0x4b, 0x66, 0x82, 0x8d, 0xbc, 0xf1, 0x3e, 0x51, 0xf0, 0x4a, 0x33, 0x37, 0x46,
-	0x89, 0xd2, 0x1c, 0x96, 0xf8, 0x01, 0x2a, 0x8d, 0x00, 0xa4, 0xbb, 0x6c, 0x0a, 0xd7, 0xf3, 0xc2,
-	0x23, 0x80, 0x1b, 0x25, 0x46, 0xf4, 0xb4, 0xf4, 0xe9, 0xeb, 0x56, 0xa1, 0xfd, 0xc5, 0x41, 0xf5,
-	0x7f, 0x8c, 0xf1, 0x0e, 0xd2, 0x6d, 0x0d, 0x44, 0x62, 0xbb, 0x3d, 0x8c, 0x44, 0xf8, 0xc1, 0x8c,
-	0xa5, 0x66, 0x12, 0xbf, 0x4e, 0x74, 0xab, 0x7b, 0x1a, 0xc5, 0xfb, 0xa8, 0xf1, 0xb7, 0x94, 0x32,
-	0x6a, 0x57, 0x32, 0xcf, 0x06, 0x84, 0x17, 0x05, 0x7d, 0x46, 0xf5, 0x97, 0xcc, 0xf1, 0x3d, 0x54,
-	0x0f, 0x85, 0x88, 0xa8, 0x98, 0x72, 0x6b, 0x6e, 0x27, 0x53, 0xf3, 0x57, 0x73, 0xd8, 0x98, 0xcb,
-	0xf6, 0x37, 0x07, 0x55, 0x16, 0xe1, 0x71, 0x0b, 0xad, 0x98, 0x5e, 0x8f, 0x00, 0x82, 0x61, 0x22,
-	0xb3, 0x44, 0x48, 0x63, 0x47, 0x00, 0xbd, 0x44, 0xe2, 0xfb, 0x68, 0x3d, 0x62, 0x67, 0x13, 0x46,
-	0x99, 0x9a, 0x2f, 0x64, 0x36, 0x47, 0x7d, 0x41, 0x64, 0xda, 0x36, 0xaa, 0x89, 0x94, 0x42, 0xba,
-	0xd0, 0xd9, 0x08, 0x55, 0x03, 0x66, 0x9a, 0x6d, 0x54, 0xd3, 0x6c, 0x28, 0xa2, 0x08, 0x42, 0x25,
-	0x52, 0xf3, 0x23, 0x57, 0xfc, 0x95, 0x11, 0xc0, 0x61, 0x8e, 0xf5, 0x9e, 0x5d, 0x5c, 0x35, 0x9d,
-	0xcb, 0xab, 0xa6, 0xf3, 0xeb, 0xaa, 0xe9, 0x7c, 0xbe, 0x6e, 0x16, 0x2e, 0xaf, 0x9b, 0x85, 0x1f,
-	0xd7, 0xcd, 0xc2, 0xfb, 0xed, 0x31, 0x53, 0xa7, 0x93, 0x61, 0x37, 0x14, 0xb1, 0x27, 0x05, 0x4f,
-	0x1f, 0x31, 0x61, 0x56, 0x6f, 0xe6, 0xe9, 0x87, 0xac, 0xe6, 0x09, 0xc8, 0x61, 0xd9, 0x3c, 0xe4,
-	0xfd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x4a, 0xbf, 0x16, 0x10, 0x04, 0x00, 0x00,
+var xxx_messageInfo_FeeCollector proto.InternalMessageInfo
+
+func (m *FeeCollector) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeCollector) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PricingParams controls how swap estimates are priced. Estimates use pool
+// reserves fetched from the host chain over ICA while they are fresh, and the
+// oracle otherwise.
+type PricingParams struct {
+	// Seconds a pool reserve snapshot is used for estimates; zero uses the
+	// default of one minute
+	ReservesTtlSeconds uint64 `protobuf:"varint,1,opt,name=reserves_ttl_seconds,json=reservesTtlSeconds,proto3" json:"reserves_ttl_seconds,omitempty"`
+	// Reject estimates without fresh pool reserves instead of falling back to
+	// oracle prices
+	RequireReserves bool `protobuf:"varint,2,opt,name=require_reserves,json=requireReserves,proto3" json:"require_reserves,omitempty"`
+	// USD stablecoin, as named on the swap chain, that pool reserves price
+	// other denoms against when no oracle module is configured
+	UsdDenom string `protobuf:"bytes,3,opt,name=usd_denom,json=usdDenom,proto3" json:"usd_denom,omitempty"`
+	// Decimal exponent of usd_denom, e.g. 6 for uusdc
+	UsdExponent uint32 `protobuf:"varint,4,opt,name=usd_exponent,json=usdExponent,proto3" json:"usd_exponent,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AccountSequence != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AccountSequence))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *PricingParams) Reset()         { *m = PricingParams{} }
+func (m *PricingParams) String() string { return proto.CompactTextString(m) }
+func (*PricingParams) ProtoMessage()    {}
+func (*PricingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{5}
+}
+func (m *PricingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PricingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricingParams.Merge(m, src)
+}
+func (m *PricingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricingParams.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PricingParams proto.InternalMessageInfo
+
+func (m *PricingParams) GetReservesTtlSeconds() uint64 {
+	if m != nil {
+		return m.ReservesTtlSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PricingParams) GetRequireReserves() bool {
+	if m != nil {
+		return m.RequireReserves
+	}
+	return false
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.RateLimits.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.MaxDailyVolume) > 0 {
-		i -= len(m.MaxDailyVolume)
-		copy(dAtA[i:], m.MaxDailyVolume)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MaxDailyVolume)))
-		i--
-		dAtA[i] = 0x32
+func (m *PricingParams) GetUsdDenom() string {
+	if m != nil {
+		return m.UsdDenom
 	}
-	if len(m.MinSwapAmount) > 0 {
-		i -= len(m.MinSwapAmount)
-		copy(dAtA[i:], m.MinSwapAmount)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MinSwapAmount)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *PricingParams) GetUsdExponent() uint32 {
+	if m != nil {
+		return m.UsdExponent
 	}
-	if len(m.AllowedConnections) > 0 {
-		for iNdEx := len(m.AllowedConnections) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedConnections[iNdEx])
-			copy(dAtA[i:], m.AllowedConnections[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedConnections[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// RoutingParams configures the swap router, which picks the route with the
+// best estimated output when a swap names none.
+type RoutingParams struct {
+	// Pools the router may route through
+	Pools []SwapPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+	// Most hops a selected route may take; zero uses the default of three
+	MaxHops uint32 `protobuf:"varint,2,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+}
+
+func (m *RoutingParams) Reset()         { *m = RoutingParams{} }
+func (m *RoutingParams) String() string { return proto.CompactTextString(m) }
+func (*RoutingParams) ProtoMessage()    {}
+func (*RoutingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{6}
+}
+func (m *RoutingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DefaultTimeoutSeconds != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DefaultTimeoutSeconds))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxAccountsPerDid != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAccountsPerDid))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+}
+func (m *RoutingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingParams.Merge(m, src)
+}
+func (m *RoutingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutingParams proto.InternalMessageInfo
+
+func (m *RoutingParams) GetPools() []SwapPool {
+	if m != nil {
+		return m.Pools
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RoutingParams) GetMaxHops() uint32 {
+	if m != nil {
+		return m.MaxHops
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RateLimitParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OrderMonitorParams configures the monitor that queries remote order books
+// for the status of open limit orders
+type OrderMonitorParams struct {
+	// Blocks between order status checks; zero disables the monitor
+	IntervalBlocks uint64 `protobuf:"varint,1,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// Most orders queried per check; zero uses the default of 20
+	MaxOrdersPerCheck uint32 `protobuf:"varint,2,opt,name=max_orders_per_check,json=maxOrdersPerCheck,proto3" json:"max_orders_per_check,omitempty"`
+	// Order book contracts queried for each connection
+	OrderBooks []OrderBook `protobuf:"bytes,3,rep,name=order_books,json=orderBooks,proto3" json:"order_books"`
 }
 
-func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CooldownBlocks != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CooldownBlocks))
-		i--
-		dAtA[i] = 0x18
+func (m *OrderMonitorParams) Reset()         { *m = OrderMonitorParams{} }
+func (m *OrderMonitorParams) String() string { return proto.CompactTextString(m) }
+func (*OrderMonitorParams) ProtoMessage()    {}
+func (*OrderMonitorParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{7}
+}
+func (m *OrderMonitorParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderMonitorParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderMonitorParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxOpsPerDidPerDay != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOpsPerDidPerDay))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *OrderMonitorParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderMonitorParams.Merge(m, src)
+}
+func (m *OrderMonitorParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderMonitorParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderMonitorParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderMonitorParams proto.InternalMessageInfo
+
+func (m *OrderMonitorParams) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
 	}
-	if m.MaxOpsPerBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOpsPerBlock))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *OrderMonitorParams) GetMaxOrdersPerCheck() uint32 {
+	if m != nil {
+		return m.MaxOrdersPerCheck
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FeeParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderMonitorParams) GetOrderBooks() []OrderBook {
+	if m != nil {
+		return m.OrderBooks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FeeParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScreeningParams configures compliance screening. When enabled, swaps,
+// limit orders and OTC offers at or above a threshold are passed to the
+// screener the node was configured with before any funds move.
+type ScreeningParams struct {
+	// How screening decisions are enforced
+	Mode ScreeningMode `protobuf:"varint,1,opt,name=mode,proto3,enum=dex.v1.ScreeningMode" json:"mode,omitempty"`
+	// USD value from which an operation is screened; operations the oracle
+	// cannot price are screened too
+	ThresholdUsd string `protobuf:"bytes,2,opt,name=threshold_usd,json=thresholdUsd,proto3" json:"threshold_usd,omitempty"`
+	// Amounts from which an operation is screened, per denom, whatever their
+	// price. Without any threshold every operation is screened.
+	Thresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=thresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"thresholds"`
 }
 
-func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeCollector) > 0 {
-		i -= len(m.FeeCollector)
-		copy(dAtA[i:], m.FeeCollector)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeCollector)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.OrderFeeBps != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderFeeBps))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LiquidityFeeBps != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquidityFeeBps))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SwapFeeBps != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapFeeBps))
-		i--
-		dAtA[i] = 0x8
+func (m *ScreeningParams) Reset()         { *m = ScreeningParams{} }
+func (m *ScreeningParams) String() string { return proto.CompactTextString(m) }
+func (*ScreeningParams) ProtoMessage()    {}
+func (*ScreeningParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{8}
+}
+func (m *ScreeningParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScreeningParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScreeningParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScreeningParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScreeningParams.Merge(m, src)
+}
+func (m *ScreeningParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScreeningParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScreeningParams.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ScreeningParams proto.InternalMessageInfo
+
+func (m *ScreeningParams) GetMode() ScreeningMode {
+	if m != nil {
+		return m.Mode
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return SCREENING_MODE_OFF
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ScreeningParams) GetThresholdUsd() string {
+	if m != nil {
+		return m.ThresholdUsd
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ScreeningParams) GetThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Thresholds
 	}
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+// ArbitrageParams controls detection of a DID swapping A->B on one connection
+// and B->A on another within a short window
+type ArbitrageParams struct {
+	// Toggles detection; disabled params record nothing
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Blocks before the current one that still count; zero means the same
+	// block only
+	WindowBlocks uint32 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// Applied to the second, opposite swap
+	Action ArbitrageAction `protobuf:"varint,3,opt,name=action,proto3,enum=dex.v1.ArbitrageAction" json:"action,omitempty"`
+	// Charged on the swap input when action is fee
+	ExtraFeeBps uint32 `protobuf:"varint,4,opt,name=extra_fee_bps,json=extraFeeBps,proto3" json:"extra_fee_bps,omitempty"`
+}
+
+func (m *ArbitrageParams) Reset()         { *m = ArbitrageParams{} }
+func (m *ArbitrageParams) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageParams) ProtoMessage()    {}
+func (*ArbitrageParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{9}
+}
+func (m *ArbitrageParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArbitrageParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArbitrageParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.AccountSequence != 0 {
-		n += 1 + sovGenesis(uint64(m.AccountSequence))
-	}
-	return n
+}
+func (m *ArbitrageParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageParams.Merge(m, src)
+}
+func (m *ArbitrageParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArbitrageParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageParams.DiscardUnknown(m)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ArbitrageParams proto.InternalMessageInfo
+
+func (m *ArbitrageParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	var l int
-	_ = l
-	if m.Enabled {
-		n += 2
+	return false
+}
+
+func (m *ArbitrageParams) GetWindowBlocks() uint32 {
+	if m != nil {
+		return m.WindowBlocks
 	}
-	if m.MaxAccountsPerDid != 0 {
-		n += 1 + sovGenesis(uint64(m.MaxAccountsPerDid))
+	return 0
+}
+
+func (m *ArbitrageParams) GetAction() ArbitrageAction {
+	if m != nil {
+		return m.Action
 	}
-	if m.DefaultTimeoutSeconds != 0 {
-		n += 1 + sovGenesis(uint64(m.DefaultTimeoutSeconds))
+	return ARBITRAGE_ACTION_WARN
+}
+
+func (m *ArbitrageParams) GetExtraFeeBps() uint32 {
+	if m != nil {
+		return m.ExtraFeeBps
 	}
-	if len(m.AllowedConnections) > 0 {
-		for _, s := range m.AllowedConnections {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+// OrderBook is the order book contract holding a connection's limit orders
+type OrderBook struct {
+	// Connection to the chain hosting the order book
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Order book contract address on the host chain
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *OrderBook) Reset()         { *m = OrderBook{} }
+func (m *OrderBook) String() string { return proto.CompactTextString(m) }
+func (*OrderBook) ProtoMessage()    {}
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{10}
+}
+func (m *OrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.MinSwapAmount)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.MaxDailyVolume)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *OrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBook.Merge(m, src)
+}
+func (m *OrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBook proto.InternalMessageInfo
+
+func (m *OrderBook) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	l = m.RateLimits.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Fees.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return ""
 }
 
-func (m *RateLimitParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderBook) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	var l int
-	_ = l
-	if m.MaxOpsPerBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.MaxOpsPerBlock))
+	return ""
+}
+
+// SwapPool is a swap chain pool the router may route through
+type SwapPool struct {
+	// Connection whose swaps may use the pool. Pools used by a Noble route are
+	// listed under the Noble connection.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Pool ID on the swap chain
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Denoms the pool trades, as named on the swap chain
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// Pool swap fee in basis points, taken at every hop through the pool
+	SwapFeeBps uint32 `protobuf:"varint,4,opt,name=swap_fee_bps,json=swapFeeBps,proto3" json:"swap_fee_bps,omitempty"`
+}
+
+func (m *SwapPool) Reset()         { *m = SwapPool{} }
+func (m *SwapPool) String() string { return proto.CompactTextString(m) }
+func (*SwapPool) ProtoMessage()    {}
+func (*SwapPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{11}
+}
+func (m *SwapPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxOpsPerDidPerDay != 0 {
-		n += 1 + sovGenesis(uint64(m.MaxOpsPerDidPerDay))
+}
+func (m *SwapPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapPool.Merge(m, src)
+}
+func (m *SwapPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapPool proto.InternalMessageInfo
+
+func (m *SwapPool) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	if m.CooldownBlocks != 0 {
-		n += 1 + sovGenesis(uint64(m.CooldownBlocks))
+	return ""
+}
+
+func (m *SwapPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return n
+	return 0
 }
 
-func (m *FeeParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapPool) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	var l int
+	return nil
+}
+
+func (m *SwapPool) GetSwapFeeBps() uint32 {
+	if m != nil {
+		return m.SwapFeeBps
+	}
+	return 0
+}
+
+// NobleRoute routes swaps from a Noble ICA account to a swap chain. Noble has
+// no general purpose pools, so the tokens are sent over IBC with a memo that
+// swaps them on arrival.
+type NobleRoute struct {
+	// IBC connection to Noble
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Noble's transfer channel to the swap chain
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Cross-chain swap contract on the swap chain that the memo calls
+	SwapContract string `protobuf:"bytes,3,opt,name=swap_contract,json=swapContract,proto3" json:"swap_contract,omitempty"`
+}
+
+func (m *NobleRoute) Reset()         { *m = NobleRoute{} }
+func (m *NobleRoute) String() string { return proto.CompactTextString(m) }
+func (*NobleRoute) ProtoMessage()    {}
+func (*NobleRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{12}
+}
+func (m *NobleRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NobleRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NobleRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NobleRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NobleRoute.Merge(m, src)
+}
+func (m *NobleRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *NobleRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_NobleRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NobleRoute proto.InternalMessageInfo
+
+func (m *NobleRoute) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *NobleRoute) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *NobleRoute) GetSwapContract() string {
+	if m != nil {
+		return m.SwapContract
+	}
+	return ""
+}
+
+// PriceHistoryParams controls how observed prices are aggregated and retained
+type PriceHistoryParams struct {
+	// Length of a single aggregation epoch
+	EpochSeconds uint64 `protobuf:"varint,1,opt,name=epoch_seconds,json=epochSeconds,proto3" json:"epoch_seconds,omitempty"`
+	// Epochs kept before being pruned; zero keeps everything
+	RetentionEpochs uint64 `protobuf:"varint,2,opt,name=retention_epochs,json=retentionEpochs,proto3" json:"retention_epochs,omitempty"`
+}
+
+func (m *PriceHistoryParams) Reset()         { *m = PriceHistoryParams{} }
+func (m *PriceHistoryParams) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryParams) ProtoMessage()    {}
+func (*PriceHistoryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{13}
+}
+func (m *PriceHistoryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryParams.Merge(m, src)
+}
+func (m *PriceHistoryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryParams proto.InternalMessageInfo
+
+func (m *PriceHistoryParams) GetEpochSeconds() uint64 {
+	if m != nil {
+		return m.EpochSeconds
+	}
+	return 0
+}
+
+func (m *PriceHistoryParams) GetRetentionEpochs() uint64 {
+	if m != nil {
+		return m.RetentionEpochs
+	}
+	return 0
+}
+
+// BatchParams controls how ICA messages are batched into packets
+type BatchParams struct {
+	// Toggles batching; when disabled every operation is sent immediately
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Maximum number of messages in a single CosmosTx
+	MaxMsgsPerPacket uint32 `protobuf:"varint,2,opt,name=max_msgs_per_packet,json=maxMsgsPerPacket,proto3" json:"max_msgs_per_packet,omitempty"`
+	// Maximum serialized size of a batched CosmosTx
+	MaxPacketBytes uint64 `protobuf:"varint,3,opt,name=max_packet_bytes,json=maxPacketBytes,proto3" json:"max_packet_bytes,omitempty"`
+	// Number of blocks a batch may wait before it is flushed
+	FlushIntervalBlocks uint32 `protobuf:"varint,4,opt,name=flush_interval_blocks,json=flushIntervalBlocks,proto3" json:"flush_interval_blocks,omitempty"`
+}
+
+func (m *BatchParams) Reset()         { *m = BatchParams{} }
+func (m *BatchParams) String() string { return proto.CompactTextString(m) }
+func (*BatchParams) ProtoMessage()    {}
+func (*BatchParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{14}
+}
+func (m *BatchParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchParams.Merge(m, src)
+}
+func (m *BatchParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchParams proto.InternalMessageInfo
+
+func (m *BatchParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *BatchParams) GetMaxMsgsPerPacket() uint32 {
+	if m != nil {
+		return m.MaxMsgsPerPacket
+	}
+	return 0
+}
+
+func (m *BatchParams) GetMaxPacketBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketBytes
+	}
+	return 0
+}
+
+func (m *BatchParams) GetFlushIntervalBlocks() uint32 {
+	if m != nil {
+		return m.FlushIntervalBlocks
+	}
+	return 0
+}
+
+// DenomFilter is a governance-managed allow/deny list of denoms for a connection
+type DenomFilter struct {
+	// IBC connection the filter applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Denoms that may be traded; empty allows every denom not denied.
+	// A trailing "*" matches by prefix, e.g. "ibc/*".
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// Denoms that may never be traded; deny entries always win
+	DeniedDenoms []string `protobuf:"bytes,3,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+}
+
+func (m *DenomFilter) Reset()         { *m = DenomFilter{} }
+func (m *DenomFilter) String() string { return proto.CompactTextString(m) }
+func (*DenomFilter) ProtoMessage()    {}
+func (*DenomFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a0429c56f27456, []int{15}
+}
+func (m *DenomFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomFilter.Merge(m, src)
+}
+func (m *DenomFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomFilter proto.InternalMessageInfo
+
+func (m *DenomFilter) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *DenomFilter) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *DenomFilter) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dex.v1.ScreeningMode", ScreeningMode_name, ScreeningMode_value)
+	proto.RegisterEnum("dex.v1.ArbitrageAction", ArbitrageAction_name, ArbitrageAction_value)
+	proto.RegisterType((*GenesisState)(nil), "dex.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "dex.v1.Params")
+	proto.RegisterType((*RateLimitParams)(nil), "dex.v1.RateLimitParams")
+	proto.RegisterType((*FeeParams)(nil), "dex.v1.FeeParams")
+	proto.RegisterType((*FeeCollector)(nil), "dex.v1.FeeCollector")
+	proto.RegisterType((*PricingParams)(nil), "dex.v1.PricingParams")
+	proto.RegisterType((*RoutingParams)(nil), "dex.v1.RoutingParams")
+	proto.RegisterType((*OrderMonitorParams)(nil), "dex.v1.OrderMonitorParams")
+	proto.RegisterType((*ScreeningParams)(nil), "dex.v1.ScreeningParams")
+	proto.RegisterType((*ArbitrageParams)(nil), "dex.v1.ArbitrageParams")
+	proto.RegisterType((*OrderBook)(nil), "dex.v1.OrderBook")
+	proto.RegisterType((*SwapPool)(nil), "dex.v1.SwapPool")
+	proto.RegisterType((*NobleRoute)(nil), "dex.v1.NobleRoute")
+	proto.RegisterType((*PriceHistoryParams)(nil), "dex.v1.PriceHistoryParams")
+	proto.RegisterType((*BatchParams)(nil), "dex.v1.BatchParams")
+	proto.RegisterType((*DenomFilter)(nil), "dex.v1.DenomFilter")
+}
+
+func init() { proto.RegisterFile("dex/v1/genesis.proto", fileDescriptor_12a0429c56f27456) }
+
+var fileDescriptor_12a0429c56f27456 = []byte{
+	// 1683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0x6f, 0x3c, 0x4e, 0xfc, 0x6c, 0xc7, 0x9e, 0x9a, 0xcc, 0xc6, 0x93, 0x85, 0x4c, 0xf0,
+	0x08, 0xc8, 0x0c, 0x3b, 0xf1, 0xce, 0xac, 0x40, 0x2b, 0x16, 0x56, 0xb2, 0x1d, 0x67, 0xd6, 0x4b,
+	0xfe, 0x8c, 0x3a, 0x59, 0x58, 0xb8, 0xb4, 0xca, 0x5d, 0x15, 0xbb, 0x94, 0xee, 0xae, 0x9e, 0xae,
+	0x72, 0xe2, 0x5c, 0x38, 0x70, 0xda, 0x03, 0x42, 0x7c, 0x04, 0x24, 0x84, 0x84, 0x10, 0x47, 0x3e,
+	0xc4, 0x4a, 0x48, 0x68, 0x2f, 0x48, 0x9c, 0x00, 0xcd, 0x7c, 0x11, 0x54, 0xff, 0xda, 0x6e, 0x07,
+	0xad, 0xe6, 0x12, 0xa7, 0x7f, 0xbf, 0xf7, 0xaa, 0x9e, 0xdf, 0xfb, 0xbd, 0xd7, 0xcf, 0xb0, 0x49,
+	0xe8, 0xac, 0x73, 0xf5, 0xac, 0x33, 0xa6, 0x09, 0x15, 0x4c, 0xec, 0xa7, 0x19, 0x97, 0x1c, 0x95,
+	0x09, 0x9d, 0xed, 0x5f, 0x3d, 0xdb, 0xde, 0x1c, 0xf3, 0x31, 0xd7, 0x50, 0x47, 0xfd, 0x67, 0xd8,
+	0xed, 0x9d, 0x90, 0x8b, 0x98, 0x8b, 0xce, 0x08, 0x0b, 0xda, 0xb9, 0x7a, 0x36, 0xa2, 0x12, 0x3f,
+	0xeb, 0x84, 0x9c, 0x25, 0x96, 0x6f, 0xda, 0x33, 0x59, 0x88, 0x0d, 0xd2, 0xfe, 0xed, 0x2a, 0xd4,
+	0x5e, 0x98, 0x1b, 0xce, 0x24, 0x96, 0x14, 0xbd, 0x0f, 0xe5, 0x14, 0x67, 0x38, 0x16, 0x2d, 0x6f,
+	0xd7, 0xdb, 0xab, 0x3e, 0xdf, 0xd8, 0x37, 0x37, 0xee, 0xbf, 0xd4, 0x68, 0xaf, 0xf4, 0xd5, 0xbf,
+	0x1f, 0xae, 0xf8, 0xd6, 0x06, 0x6d, 0xc1, 0x5a, 0xca, 0x33, 0x19, 0x30, 0xd2, 0x7a, 0x67, 0xd7,
+	0xdb, 0xab, 0xf8, 0x65, 0xf5, 0x38, 0x24, 0xe8, 0x23, 0x58, 0xc7, 0x61, 0xc8, 0xa7, 0x89, 0x14,
+	0xad, 0xd5, 0xdd, 0xd5, 0xbd, 0xea, 0xf3, 0x6f, 0xb9, 0x83, 0x86, 0x89, 0xa4, 0x59, 0x38, 0xc1,
+	0x2c, 0x39, 0x18, 0x7c, 0xd1, 0x35, 0x46, 0x7e, 0x6e, 0x8d, 0x1e, 0x43, 0xd3, 0xfe, 0x1f, 0x08,
+	0xfa, 0x6a, 0x4a, 0x93, 0x90, 0xb6, 0x4a, 0xbb, 0xde, 0x5e, 0xc9, 0x6f, 0x58, 0xfc, 0xcc, 0xc2,
+	0xe8, 0x27, 0x50, 0x1b, 0x61, 0x19, 0x4e, 0x02, 0x1b, 0xf1, 0x1d, 0x1d, 0xf1, 0x3d, 0x77, 0x51,
+	0x4f, 0x71, 0x85, 0xb0, 0xab, 0xa3, 0x39, 0x84, 0x3e, 0x81, 0x3a, 0xa1, 0x09, 0x8f, 0x83, 0x0b,
+	0x16, 0x49, 0x9a, 0x89, 0x56, 0x79, 0x77, 0x75, 0xd1, 0xfd, 0x40, 0x91, 0x87, 0x9a, 0xb3, 0xee,
+	0x35, 0x32, 0x87, 0x04, 0xf2, 0x61, 0x33, 0xcd, 0x58, 0x48, 0x83, 0x09, 0x13, 0x92, 0x67, 0x37,
+	0x2e, 0x8a, 0x35, 0x1d, 0xc5, 0x76, 0x9e, 0x37, 0x65, 0xf3, 0xa9, 0x31, 0x29, 0x04, 0x83, 0xd2,
+	0x5b, 0x4c, 0xfb, 0x77, 0x65, 0x28, 0xdb, 0xf0, 0x5a, 0xb0, 0x46, 0x13, 0x3c, 0x8a, 0x28, 0xd1,
+	0x95, 0x58, 0xf7, 0xdd, 0x23, 0xea, 0xc0, 0x66, 0x8c, 0x67, 0x81, 0xcb, 0x58, 0x90, 0xd2, 0x2c,
+	0x20, 0xb6, 0x02, 0x75, 0xff, 0x6e, 0x8c, 0x67, 0x36, 0xab, 0xe2, 0x25, 0xcd, 0x0e, 0x18, 0x41,
+	0x3f, 0x82, 0x2d, 0x42, 0x2f, 0xf0, 0x34, 0x92, 0x81, 0x64, 0x31, 0xe5, 0x53, 0x95, 0xda, 0x90,
+	0x27, 0x44, 0xd5, 0x46, 0x65, 0xf6, 0xbe, 0xa5, 0xcf, 0x0d, 0x7b, 0x66, 0x48, 0xd4, 0x81, 0x7b,
+	0x38, 0x8a, 0xf8, 0x35, 0x25, 0x41, 0xc8, 0x93, 0x84, 0x86, 0x92, 0xf1, 0x44, 0xb4, 0x4a, 0xbb,
+	0xab, 0x7b, 0x15, 0x1f, 0x59, 0xaa, 0x3f, 0x67, 0xd0, 0xf7, 0xa0, 0x11, 0xb3, 0x24, 0x10, 0xd7,
+	0x38, 0x0d, 0x70, 0xac, 0x42, 0xd0, 0x35, 0xa9, 0xf8, 0xf5, 0x98, 0x25, 0x67, 0xd7, 0x38, 0xed,
+	0x6a, 0x10, 0xed, 0x41, 0x53, 0x7d, 0x03, 0x82, 0x59, 0x74, 0x13, 0x5c, 0xf1, 0x68, 0x1a, 0xd3,
+	0x56, 0x59, 0x1b, 0x6e, 0xc4, 0x78, 0x76, 0xa0, 0xe0, 0x9f, 0x6b, 0x14, 0x7d, 0x02, 0xd5, 0x0c,
+	0x4b, 0x1a, 0x44, 0x2c, 0x66, 0xd2, 0xe5, 0x76, 0xcb, 0xe5, 0xd6, 0xc7, 0x92, 0x1e, 0x29, 0xa6,
+	0x90, 0x58, 0xc8, 0x1c, 0x2c, 0xd0, 0x0f, 0xa0, 0x74, 0x41, 0xa9, 0x68, 0xad, 0x6b, 0xc7, 0xbb,
+	0xce, 0xf1, 0x90, 0xd2, 0x82, 0x8b, 0x36, 0x42, 0x1f, 0x43, 0x2d, 0xe1, 0xa3, 0x88, 0x06, 0x19,
+	0x9f, 0x4a, 0x2a, 0x5a, 0x15, 0x2d, 0x08, 0xe4, 0x9c, 0x4e, 0x14, 0xe7, 0x2b, 0xca, 0xc9, 0x29,
+	0xc9, 0x11, 0x81, 0x7e, 0x08, 0x6b, 0xaa, 0xa0, 0x2c, 0x19, 0xb7, 0x40, 0x5f, 0x76, 0x7f, 0x51,
+	0x01, 0x2c, 0x19, 0x17, 0x2e, 0x74, 0xb6, 0xca, 0x4d, 0xdd, 0xa6, 0xdc, 0xaa, 0x45, 0x37, 0xdf,
+	0xc0, 0x45, 0x37, 0x6b, 0x8b, 0x06, 0x50, 0xe7, 0x19, 0xa1, 0x59, 0x10, 0xf3, 0x84, 0x49, 0x9e,
+	0xb5, 0x6a, 0x45, 0xd5, 0x9d, 0x2a, 0xf2, 0xd8, 0x70, 0x85, 0x13, 0x6a, 0x7c, 0x81, 0x41, 0x0f,
+	0xa1, 0x2a, 0x64, 0xc6, 0x42, 0x19, 0x4c, 0x43, 0x9c, 0xb4, 0xea, 0x5a, 0x68, 0x60, 0xa0, 0xcf,
+	0x43, 0x9c, 0xa0, 0x8f, 0xa1, 0x22, 0xc2, 0x8c, 0xd2, 0x44, 0x05, 0xb8, 0x51, 0xcc, 0xfe, 0x99,
+	0x23, 0x0a, 0x17, 0xcc, 0xed, 0x95, 0x33, 0xce, 0x46, 0x4c, 0x66, 0x78, 0x4c, 0x5b, 0x8d, 0xa2,
+	0x73, 0xd7, 0x11, 0x45, 0xe7, 0xdc, 0xfe, 0xc7, 0xa5, 0x2f, 0xff, 0xf0, 0x70, 0xa5, 0xfd, 0x77,
+	0x0f, 0x1a, 0x4b, 0x55, 0x46, 0x8f, 0x41, 0x69, 0x3c, 0xe0, 0xa9, 0x91, 0xfe, 0x28, 0xe2, 0xe1,
+	0xa5, 0xee, 0x91, 0xba, 0x96, 0xcf, 0x69, 0xaa, 0x74, 0xdf, 0x53, 0x28, 0xfa, 0x10, 0xb6, 0x16,
+	0x4d, 0x09, 0x23, 0xe6, 0x13, 0xdf, 0xd8, 0x6e, 0x41, 0xb9, 0xc3, 0x01, 0x23, 0xea, 0x2f, 0xbe,
+	0x41, 0xdf, 0x87, 0x46, 0xc8, 0x79, 0x44, 0xf8, 0x75, 0x62, 0x0e, 0x37, 0x6d, 0x52, 0xf7, 0x37,
+	0x1c, 0xac, 0x0f, 0xd7, 0xfd, 0x71, 0x45, 0x33, 0x76, 0xc1, 0x28, 0x09, 0xe2, 0x69, 0x24, 0x59,
+	0x1a, 0x31, 0x9a, 0xe9, 0x69, 0x55, 0xf7, 0x91, 0xa3, 0x8e, 0x73, 0xa6, 0xfd, 0x4f, 0x0f, 0x2a,
+	0xb9, 0xf4, 0xd0, 0x2e, 0xd4, 0x74, 0xa7, 0x5c, 0x50, 0x1a, 0x8c, 0x52, 0x61, 0xbf, 0x02, 0x28,
+	0xec, 0x90, 0xd2, 0x5e, 0x2a, 0xd0, 0x13, 0xb8, 0x1b, 0xb1, 0x57, 0x53, 0x46, 0x98, 0xbc, 0xc9,
+	0xcd, 0x4c, 0xe0, 0x8d, 0x9c, 0xb0, 0xb6, 0x6d, 0xa7, 0x08, 0x67, 0x67, 0x62, 0xae, 0x6a, 0xd0,
+	0xda, 0x74, 0x61, 0x43, 0xb1, 0x21, 0x8f, 0x22, 0x1a, 0x4a, 0x9e, 0xa9, 0x91, 0xa9, 0x24, 0xbe,
+	0xb9, 0xd0, 0x17, 0x7d, 0x47, 0xda, 0x92, 0xd4, 0x2f, 0x16, 0x30, 0xf1, 0x59, 0x69, 0xbd, 0xd4,
+	0xbc, 0xe3, 0xd7, 0x0b, 0xc7, 0xb4, 0x8f, 0xa1, 0xb6, 0xe8, 0x89, 0x1e, 0x41, 0x7d, 0x3e, 0x30,
+	0xd4, 0xcb, 0xc1, 0xd3, 0xcd, 0x5d, 0x9b, 0x83, 0x43, 0xa2, 0x06, 0x1c, 0x26, 0x24, 0xa3, 0x42,
+	0xd8, 0x77, 0x87, 0x7b, 0x6c, 0xff, 0xd5, 0x83, 0x7a, 0xa1, 0x69, 0xd0, 0x07, 0xb0, 0x99, 0x51,
+	0x41, 0xb3, 0x2b, 0x2a, 0x02, 0x29, 0xa3, 0x7c, 0x7c, 0x79, 0x7a, 0x7c, 0x21, 0xc7, 0x9d, 0xcb,
+	0xc8, 0xcd, 0xae, 0xc7, 0xd0, 0xcc, 0xe8, 0xab, 0x29, 0xcb, 0x68, 0xe0, 0x58, 0x7d, 0xcd, 0xba,
+	0xdf, 0xb0, 0xb8, 0x6f, 0x61, 0xf4, 0x1e, 0x54, 0xa6, 0x82, 0x04, 0x7a, 0xb8, 0xeb, 0xac, 0x55,
+	0xfc, 0xf5, 0xa9, 0x20, 0x7a, 0xfe, 0xa3, 0xef, 0x40, 0x4d, 0x91, 0x74, 0x96, 0xf2, 0x84, 0x26,
+	0xd2, 0x16, 0xb7, 0x3a, 0x15, 0x64, 0x60, 0xa1, 0xf6, 0x17, 0x50, 0x2f, 0xf4, 0x2a, 0x7a, 0x1f,
+	0xee, 0xa4, 0x9c, 0x47, 0x2a, 0x3c, 0x95, 0xdd, 0x66, 0xde, 0x30, 0xd7, 0x38, 0x7d, 0xc9, 0x79,
+	0x64, 0x33, 0x6b, 0x8c, 0xd0, 0x03, 0x58, 0x57, 0x1a, 0x9d, 0xf0, 0xbc, 0xb6, 0x6b, 0x31, 0x9e,
+	0x7d, 0xca, 0x53, 0xd1, 0xfe, 0xb3, 0x07, 0xe8, 0x76, 0x27, 0x2b, 0x81, 0x32, 0xf5, 0x12, 0xbd,
+	0xc2, 0x91, 0x13, 0xa8, 0x49, 0xc4, 0x86, 0x83, 0x73, 0x81, 0xea, 0x37, 0x85, 0x96, 0x80, 0xe9,
+	0x80, 0x70, 0x42, 0xc3, 0xcb, 0x85, 0x37, 0x85, 0x3e, 0x5d, 0xc9, 0xbf, 0xaf, 0x08, 0xf4, 0x11,
+	0x18, 0xbd, 0x04, 0x23, 0xce, 0x2f, 0xdd, 0x9b, 0xfb, 0x6e, 0x61, 0xa8, 0xf4, 0x38, 0xbf, 0x74,
+	0x83, 0x96, 0x3b, 0x40, 0xb4, 0xff, 0xe1, 0x41, 0x63, 0x69, 0x20, 0xa0, 0xc7, 0x50, 0x8a, 0x39,
+	0xa1, 0x3a, 0xb8, 0x8d, 0xf9, 0x60, 0xcb, 0xcd, 0x8e, 0x39, 0xa1, 0xbe, 0x36, 0x51, 0x8a, 0x91,
+	0x93, 0x8c, 0x8a, 0x09, 0x8f, 0x48, 0x30, 0x15, 0x6e, 0x9d, 0xa8, 0xe5, 0xe0, 0xe7, 0x82, 0xa0,
+	0x4b, 0x80, 0xfc, 0xd9, 0x05, 0xf7, 0x60, 0xdf, 0xec, 0x3c, 0xfb, 0x6a, 0xe7, 0xd9, 0xb7, 0x3b,
+	0xcf, 0x7e, 0x9f, 0xb3, 0xa4, 0xf7, 0x81, 0x0a, 0xf2, 0x2f, 0xff, 0x79, 0xb8, 0x37, 0x66, 0x72,
+	0x32, 0x1d, 0xed, 0x87, 0x3c, 0xee, 0xd8, 0x05, 0xc9, 0x7c, 0x3c, 0x15, 0xe4, 0xb2, 0x23, 0x6f,
+	0x52, 0x2a, 0xb4, 0x83, 0xf0, 0x17, 0x8e, 0x6f, 0xff, 0xc9, 0x83, 0xc6, 0xd2, 0x90, 0xfa, 0x86,
+	0x77, 0xf2, 0x23, 0xa8, 0x5f, 0xb3, 0x84, 0xf0, 0x6b, 0x57, 0x10, 0x93, 0xe2, 0x9a, 0x01, 0xf3,
+	0x72, 0x94, 0xb1, 0x56, 0xbf, 0x56, 0xd9, 0xc6, 0xff, 0x19, 0x86, 0x5d, 0x4d, 0xfb, 0xd6, 0x4c,
+	0xf5, 0x34, 0x9d, 0xc9, 0x0c, 0xe7, 0x3d, 0x6d, 0xd5, 0xa7, 0x41, 0xd3, 0xd3, 0xed, 0x23, 0xa8,
+	0xe4, 0x75, 0x79, 0xbb, 0xc6, 0xdb, 0x86, 0xf5, 0x90, 0x27, 0x32, 0xc3, 0xa1, 0xb4, 0x69, 0xce,
+	0x9f, 0xdb, 0xbf, 0xf1, 0x60, 0xdd, 0xc9, 0xf4, 0xed, 0x4e, 0xd3, 0x2b, 0x20, 0x8f, 0xdc, 0x0a,
+	0x58, 0x52, 0x2b, 0x20, 0x8f, 0x86, 0x04, 0xbd, 0x0b, 0x65, 0xdd, 0x52, 0xa6, 0x52, 0x15, 0xdf,
+	0x3e, 0xdd, 0x1a, 0x7b, 0xa5, 0xe5, 0xb1, 0xd7, 0x9e, 0x02, 0xcc, 0xdf, 0xb5, 0x6f, 0x17, 0xc5,
+	0xb7, 0x01, 0xc2, 0x09, 0x4e, 0x12, 0x1a, 0xcd, 0x77, 0xd1, 0x8a, 0x45, 0x86, 0xba, 0x3c, 0xfa,
+	0xce, 0xfc, 0x7b, 0x9b, 0x36, 0xd7, 0x81, 0xf4, 0xdd, 0x77, 0x27, 0x80, 0x6e, 0x2f, 0x6b, 0xca,
+	0x95, 0xa6, 0x3c, 0x9c, 0x2c, 0xcd, 0x9c, 0x9a, 0x06, 0x0b, 0xd3, 0x46, 0xd2, 0x44, 0x87, 0xa8,
+	0x19, 0x61, 0xb3, 0xd1, 0xc8, 0xf1, 0x81, 0x86, 0xdb, 0x7f, 0xf3, 0xa0, 0xba, 0xb0, 0x99, 0x7e,
+	0x83, 0xa6, 0x9e, 0xc2, 0x3d, 0xd5, 0xbd, 0xb1, 0x18, 0x9b, 0xde, 0x4d, 0x71, 0x78, 0x49, 0xa5,
+	0x55, 0x96, 0x5a, 0xa0, 0x8e, 0xc5, 0x58, 0xb5, 0xee, 0x4b, 0x8d, 0xbb, 0xa5, 0xca, 0x58, 0x05,
+	0xa3, 0x1b, 0xb5, 0xc1, 0x98, 0xf5, 0x4e, 0xbd, 0x15, 0x8d, 0x51, 0x4f, 0xa1, 0xe8, 0x39, 0xdc,
+	0xbf, 0x88, 0xa6, 0x62, 0x12, 0x2c, 0x4f, 0x11, 0x53, 0x8a, 0x7b, 0x9a, 0x1c, 0x16, 0x46, 0x49,
+	0xfb, 0xd7, 0x50, 0x5d, 0x58, 0x88, 0xdf, 0xae, 0x28, 0xdf, 0x85, 0x0d, 0xb7, 0x3f, 0x5a, 0x25,
+	0xbc, 0xa3, 0x95, 0x50, 0xb7, 0xa8, 0x3e, 0x50, 0x67, 0x98, 0xd0, 0x84, 0xcd, 0xad, 0x8c, 0x5e,
+	0x6a, 0x06, 0x34, 0x46, 0x4f, 0x08, 0xd4, 0x0b, 0x73, 0x03, 0xbd, 0x0b, 0xe8, 0xac, 0xef, 0x0f,
+	0x06, 0x27, 0xc3, 0x93, 0x17, 0xc1, 0xf1, 0xe9, 0xc1, 0x20, 0x38, 0x3d, 0x3c, 0x6c, 0xae, 0xa0,
+	0xf7, 0x60, 0x6b, 0x09, 0x3f, 0x3a, 0x7d, 0x11, 0x9c, 0x9e, 0x1c, 0xfd, 0xb2, 0xe9, 0xa1, 0x16,
+	0x6c, 0x2e, 0x91, 0xbd, 0xa3, 0xd3, 0xfe, 0xcf, 0x9a, 0xef, 0x6c, 0x97, 0xbe, 0xfc, 0xe3, 0xce,
+	0xca, 0x13, 0x06, 0x8d, 0xa5, 0x5e, 0x44, 0x0f, 0xe0, 0x7e, 0xd7, 0xef, 0x0d, 0xcf, 0xfd, 0xee,
+	0x8b, 0x41, 0xd0, 0xed, 0x9f, 0x0f, 0x4f, 0x4f, 0x82, 0x5f, 0x74, 0xfd, 0x93, 0xe6, 0x8a, 0x3a,
+	0xed, 0x16, 0x75, 0x38, 0x18, 0x34, 0x3d, 0x15, 0xc4, 0x2d, 0xc6, 0x1f, 0x7c, 0x36, 0xe8, 0x9f,
+	0xbb, 0xab, 0x7a, 0x3f, 0xfd, 0xea, 0xf5, 0x8e, 0xf7, 0xf5, 0xeb, 0x1d, 0xef, 0xbf, 0xaf, 0x77,
+	0xbc, 0xdf, 0xbf, 0xd9, 0x59, 0xf9, 0xfa, 0xcd, 0xce, 0xca, 0xbf, 0xde, 0xec, 0xac, 0xfc, 0xea,
+	0xd1, 0xc2, 0xbc, 0x12, 0x3c, 0xc9, 0x9e, 0x32, 0xae, 0x3f, 0x3b, 0xb3, 0x8e, 0xfa, 0xfd, 0xa6,
+	0x07, 0xd6, 0xa8, 0xac, 0x7f, 0xbf, 0x7d, 0xf8, 0xbf, 0x01, 0x00, 0x6c, 0xc1, 0xf7, 0xdb, 0x27,
+	0x0e, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.SwapFeeBps != 0 {
-		n += 1 + sovGenesis(uint64(m.SwapFeeBps))
+	{
+		size, err := m.PriceHistoryParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.LiquidityFeeBps != 0 {
-		n += 1 + sovGenesis(uint64(m.LiquidityFeeBps))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DenomFilters) > 0 {
+		for iNdEx := len(m.DenomFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.OrderFeeBps != 0 {
-		n += 1 + sovGenesis(uint64(m.OrderFeeBps))
+	{
+		size, err := m.BatchParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AccountSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AccountSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Arbitrage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.Screening.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.StrictUcan {
+		i--
+		if m.StrictUcan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.OrderMonitor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.Routing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Pricing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.NobleRoutes) > 0 {
+		for iNdEx := len(m.NobleRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NobleRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.RateLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.MaxDailyVolume) > 0 {
+		i -= len(m.MaxDailyVolume)
+		copy(dAtA[i:], m.MaxDailyVolume)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MaxDailyVolume)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinSwapAmount) > 0 {
+		i -= len(m.MinSwapAmount)
+		copy(dAtA[i:], m.MinSwapAmount)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MinSwapAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AllowedConnections) > 0 {
+		for iNdEx := len(m.AllowedConnections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedConnections[iNdEx])
+			copy(dAtA[i:], m.AllowedConnections[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedConnections[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DefaultTimeoutSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DefaultTimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAccountsPerDid != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAccountsPerDid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifiedMultiplier != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VerifiedMultiplier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CooldownBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CooldownBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOpsPerDidPerDay != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOpsPerDidPerDay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxOpsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOpsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeCollectors) > 0 {
+		for iNdEx := len(m.FeeCollectors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.OrderFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LiquidityFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquidityFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SwapFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapFeeBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCollector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCollector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCollector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsdExponent != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UsdExponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UsdDenom) > 0 {
+		i -= len(m.UsdDenom)
+		copy(dAtA[i:], m.UsdDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UsdDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequireReserves {
+		i--
+		if m.RequireReserves {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReservesTtlSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReservesTtlSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoutingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHops != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderMonitorParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderMonitorParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderMonitorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxOrdersPerCheck != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOrdersPerCheck))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScreeningParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScreeningParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScreeningParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ThresholdUsd) > 0 {
+		i -= len(m.ThresholdUsd)
+		copy(dAtA[i:], m.ThresholdUsd)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ThresholdUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArbitrageParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArbitrageParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArbitrageParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtraFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExtraFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Action != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SwapFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NobleRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NobleRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NobleRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapContract) > 0 {
+		i -= len(m.SwapContract)
+		copy(dAtA[i:], m.SwapContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SwapContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistoryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetentionEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlushIntervalBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FlushIntervalBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPacketBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPacketBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMsgsPerPacket != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMsgsPerPacket))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AccountSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.AccountSequence))
+	}
+	l = m.BatchParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomFilters) > 0 {
+		for _, e := range m.DenomFilters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PriceHistoryParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxAccountsPerDid != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxAccountsPerDid))
+	}
+	if m.DefaultTimeoutSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.DefaultTimeoutSeconds))
+	}
+	if len(m.AllowedConnections) > 0 {
+		for _, s := range m.AllowedConnections {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.MinSwapAmount)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.MaxDailyVolume)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RateLimits.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.NobleRoutes) > 0 {
+		for _, e := range m.NobleRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Pricing.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Routing.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OrderMonitor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.StrictUcan {
+		n += 2
+	}
+	l = m.Screening.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Arbitrage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxOpsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxOpsPerBlock))
+	}
+	if m.MaxOpsPerDidPerDay != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxOpsPerDidPerDay))
+	}
+	if m.CooldownBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.CooldownBlocks))
+	}
+	if m.VerifiedMultiplier != 0 {
+		n += 1 + sovGenesis(uint64(m.VerifiedMultiplier))
+	}
+	return n
+}
+
+func (m *FeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.SwapFeeBps))
+	}
+	if m.LiquidityFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.LiquidityFeeBps))
+	}
+	if m.OrderFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderFeeBps))
+	}
+	if len(m.FeeCollectors) > 0 {
+		for _, e := range m.FeeCollectors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeCollector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PricingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReservesTtlSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.ReservesTtlSeconds))
+	}
+	if m.RequireReserves {
+		n += 2
+	}
+	l = len(m.UsdDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UsdExponent != 0 {
+		n += 1 + sovGenesis(uint64(m.UsdExponent))
+	}
+	return n
+}
+
+func (m *RoutingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxHops))
+	}
+	return n
+}
+
+func (m *OrderMonitorParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.IntervalBlocks))
+	}
+	if m.MaxOrdersPerCheck != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxOrdersPerCheck))
+	}
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScreeningParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovGenesis(uint64(m.Mode))
+	}
+	l = len(m.ThresholdUsd)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArbitrageParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowBlocks))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGenesis(uint64(m.Action))
+	}
+	if m.ExtraFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.ExtraFeeBps))
+	}
+	return n
+}
+
+func (m *OrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SwapFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.SwapFeeBps))
+	}
+	return n
+}
+
+func (m *NobleRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.SwapContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceHistoryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochSeconds))
+	}
+	if m.RetentionEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.RetentionEpochs))
+	}
+	return n
+}
+
+func (m *BatchParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxMsgsPerPacket != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMsgsPerPacket))
+	}
+	if m.MaxPacketBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPacketBytes))
+	}
+	if m.FlushIntervalBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.FlushIntervalBlocks))
+	}
+	return n
+}
+
+func (m *DenomFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &InterchainDEXAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountSequence", wireType)
+			}
+			m.AccountSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomFilters = append(m.DenomFilters, DenomFilter{})
+			if err := m.DenomFilters[len(m.DenomFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceHistoryParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountsPerDid", wireType)
+			}
+			m.MaxAccountsPerDid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAccountsPerDid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutSeconds", wireType)
+			}
+			m.DefaultTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedConnections = append(m.AllowedConnections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDailyVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDailyVolume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NobleRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NobleRoutes = append(m.NobleRoutes, NobleRoute{})
+			if err := m.NobleRoutes[len(m.NobleRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Routing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderMonitor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderMonitor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictUcan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictUcan = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Screening", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Screening.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbitrage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arbitrage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpsPerBlock", wireType)
+			}
+			m.MaxOpsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpsPerDidPerDay", wireType)
+			}
+			m.MaxOpsPerDidPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpsPerDidPerDay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownBlocks", wireType)
+			}
+			m.CooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedMultiplier", wireType)
+			}
+			m.VerifiedMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeBps", wireType)
+			}
+			m.SwapFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFeeBps", wireType)
+			}
+			m.LiquidityFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderFeeBps", wireType)
+			}
+			m.OrderFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectors = append(m.FeeCollectors, FeeCollector{})
+			if err := m.FeeCollectors[len(m.FeeCollectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCollector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCollector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCollector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesTtlSeconds", wireType)
+			}
+			m.ReservesTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservesTtlSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireReserves", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireReserves = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdExponent", wireType)
+			}
+			m.UsdExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoutingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoutingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoutingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapPool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderMonitorParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderMonitorParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderMonitorParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersPerCheck", wireType)
+			}
+			m.MaxOrdersPerCheck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrdersPerCheck |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooks = append(m.OrderBooks, OrderBook{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScreeningParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScreeningParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScreeningParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ScreeningMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThresholdUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thresholds = append(m.Thresholds, types.Coin{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.FeeCollector)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *ArbitrageParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArbitrageParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArbitrageParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ArbitrageAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFeeBps", wireType)
+			}
+			m.ExtraFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *OrderBook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -708,17 +4389,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderBook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -728,28 +4409,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -777,13 +4457,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -793,31 +4523,80 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &InterchainDEXAccount{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeBps", wireType)
 			}
-			m.AccountSequence = 0
+			m.SwapFeeBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -827,7 +4606,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountSequence |= uint64(b&0x7F) << shift
+				m.SwapFeeBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -853,7 +4632,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *NobleRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -876,73 +4655,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: NobleRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NobleRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enabled = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountsPerDid", wireType)
-			}
-			m.MaxAccountsPerDid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxAccountsPerDid |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutSeconds", wireType)
-			}
-			m.DefaultTimeoutSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DefaultTimeoutSeconds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedConnections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -970,11 +4691,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedConnections = append(m.AllowedConnections, string(dAtA[iNdEx:postIndex]))
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1002,11 +4723,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapAmount = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDailyVolume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,13 +4755,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDailyVolume = string(dAtA[iNdEx:postIndex])
+			m.SwapContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHistoryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistoryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistoryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSeconds", wireType)
+			}
+			m.EpochSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1050,30 +4821,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RateLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionEpochs", wireType)
 			}
-			var msglen int
+			m.RetentionEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1083,25 +4840,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RetentionEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1123,7 +4866,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
+func (m *BatchParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1146,17 +4889,17 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpsPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.MaxOpsPerBlock = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1166,16 +4909,17 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxOpsPerBlock |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpsPerDidPerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerPacket", wireType)
 			}
-			m.MaxOpsPerDidPerDay = 0
+			m.MaxMsgsPerPacket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1185,16 +4929,16 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxOpsPerDidPerDay |= uint32(b&0x7F) << shift
+				m.MaxMsgsPerPacket |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CooldownBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketBytes", wireType)
 			}
-			m.CooldownBlocks = 0
+			m.MaxPacketBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1204,7 +4948,26 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CooldownBlocks |= uint32(b&0x7F) << shift
+				m.MaxPacketBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushIntervalBlocks", wireType)
+			}
+			m.FlushIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlushIntervalBlocks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1230,7 +4993,7 @@ func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeParams) Unmarshal(dAtA []byte) error {
+func (m *DenomFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,17 +5016,17 @@ func (m *FeeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeBps", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			m.SwapFeeBps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1273,16 +5036,29 @@ func (m *FeeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapFeeBps |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFeeBps", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
-			m.LiquidityFeeBps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1292,33 +5068,27 @@ func (m *FeeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityFeeBps |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderFeeBps", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.OrderFeeBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderFeeBps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1346,7 +5116,7 @@ func (m *FeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -286,65 +287,173 @@ func (m *DEXActivity) GetGasUsed() uint64 {
 	return 0
 }
 
+// PendingICABatch holds messages queued for a single ICA account
+type PendingICABatch struct {
+	// DID owning the ICA account
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// IBC connection of the ICA account
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Queued messages, sent together as one CosmosTx
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// Memo of each queued operation
+	Memos []string `protobuf:"bytes,4,rep,name=memos,proto3" json:"memos,omitempty"`
+	// Serialized size of the queued messages
+	Bytes uint64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Block height the first message was queued at
+	FirstQueuedHeight int64 `protobuf:"varint,6,opt,name=first_queued_height,json=firstQueuedHeight,proto3" json:"first_queued_height,omitempty"`
+	// Longest timeout requested by a queued operation (in seconds)
+	TimeoutSeconds uint64 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (m *PendingICABatch) Reset()         { *m = PendingICABatch{} }
+func (m *PendingICABatch) String() string { return proto.CompactTextString(m) }
+func (*PendingICABatch) ProtoMessage()    {}
+func (*PendingICABatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ed494d1227c1157, []int{2}
+}
+func (m *PendingICABatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingICABatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingICABatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingICABatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingICABatch.Merge(m, src)
+}
+func (m *PendingICABatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingICABatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingICABatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingICABatch proto.InternalMessageInfo
+
+func (m *PendingICABatch) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *PendingICABatch) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PendingICABatch) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *PendingICABatch) GetMemos() []string {
+	if m != nil {
+		return m.Memos
+	}
+	return nil
+}
+
+func (m *PendingICABatch) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *PendingICABatch) GetFirstQueuedHeight() int64 {
+	if m != nil {
+		return m.FirstQueuedHeight
+	}
+	return 0
+}
+
+func (m *PendingICABatch) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dex.v1.AccountStatus", AccountStatus_name, AccountStatus_value)
 	proto.RegisterEnum("dex.v1.DEXFeatures", DEXFeatures_name, DEXFeatures_value)
 	proto.RegisterType((*InterchainDEXAccount)(nil), "dex.v1.InterchainDEXAccount")
 	proto.RegisterType((*DEXActivity)(nil), "dex.v1.DEXActivity")
+	proto.RegisterType((*PendingICABatch)(nil), "dex.v1.PendingICABatch")
 }
 
 func init() { proto.RegisterFile("dex/v1/ica.proto", fileDescriptor_5ed494d1227c1157) }
 
 var fileDescriptor_5ed494d1227c1157 = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0x2d, 0xcb, 0xb3, 0x63, 0xba, 0x69, 0x05, 0x2e, 0x4d, 0x14, 0x0f, 0x90, 0xbd, 0xf4,
-	0xe2, 0x15, 0x88, 0x34, 0x67, 0xb7, 0x01, 0x3b, 0xc8, 0xb6, 0xd2, 0x0a, 0x2b, 0x9c, 0x4e, 0x96,
-	0xbb, 0x6e, 0x17, 0x81, 0x16, 0x59, 0x49, 0xa8, 0x2d, 0x1a, 0x22, 0x6d, 0xb8, 0xf7, 0x1d, 0x8a,
-	0x9d, 0xba, 0x6f, 0x30, 0x60, 0xb7, 0x9d, 0xf7, 0x21, 0x7a, 0x2c, 0x76, 0xda, 0x69, 0x1d, 0x92,
-	0x6f, 0xb0, 0x4f, 0x30, 0x90, 0xa2, 0x33, 0xa7, 0x08, 0x06, 0xec, 0x44, 0xf2, 0xff, 0x7f, 0x8f,
-	0xcf, 0xfc, 0xbd, 0x67, 0x01, 0x03, 0x93, 0x8d, 0xb3, 0xee, 0x3b, 0x59, 0x8c, 0xec, 0x65, 0x41,
-	0x39, 0x85, 0x75, 0x4c, 0x36, 0xf6, 0xba, 0xdf, 0x3e, 0x48, 0x68, 0x42, 0xa5, 0xe4, 0x88, 0x5d,
-	0xe9, 0xb6, 0x8f, 0x63, 0xca, 0x16, 0x94, 0x45, 0xa5, 0x51, 0x1e, 0x94, 0xd5, 0x49, 0x28, 0x4d,
-	0xe6, 0xc4, 0x91, 0xa7, 0xd9, 0xea, 0x85, 0xc3, 0xb3, 0x05, 0x61, 0x1c, 0x2d, 0x96, 0x2a, 0xc0,
-	0x2a, 0xc3, 0x9d, 0x19, 0x62, 0xc4, 0x59, 0xf7, 0x67, 0x84, 0xa3, 0xbe, 0x13, 0xd3, 0x2c, 0x2f,
-	0xfd, 0x93, 0xbf, 0xab, 0xe0, 0xc0, 0xcf, 0x39, 0x29, 0xe2, 0x14, 0x65, 0xf9, 0xc8, 0x7b, 0xee,
-	0xc6, 0x31, 0x5d, 0xe5, 0x1c, 0x1a, 0x40, 0xc7, 0x19, 0x36, 0xb5, 0xae, 0xd6, 0x6b, 0x06, 0x62,
-	0x0b, 0x1f, 0x80, 0xfd, 0x98, 0xe6, 0x39, 0x89, 0x79, 0x46, 0xf3, 0x28, 0xc3, 0x66, 0x55, 0x7a,
-	0x77, 0xfe, 0x15, 0x7d, 0x0c, 0x4f, 0xc0, 0x7e, 0x4a, 0x19, 0x8f, 0xe4, 0x75, 0x22, 0x48, 0x97,
-	0x41, 0x2d, 0x21, 0x0e, 0x85, 0xe6, 0x63, 0xe8, 0x82, 0x7b, 0xa8, 0xac, 0x12, 0x21, 0x8c, 0x0b,
-	0xc2, 0x98, 0x59, 0x13, 0x51, 0x03, 0xf3, 0xf7, 0xdf, 0x4e, 0x0f, 0xd4, 0xfb, 0xdc, 0xd2, 0x99,
-	0xf0, 0x22, 0xcb, 0x93, 0xe0, 0xae, 0x4a, 0x50, 0x2a, 0x3c, 0x02, 0x8d, 0x25, 0x2d, 0xb8, 0x28,
-	0xf0, 0x91, 0x2c, 0x50, 0x17, 0x47, 0x1f, 0xc3, 0x21, 0x00, 0x71, 0x41, 0x10, 0x27, 0x38, 0x42,
-	0xdc, 0xac, 0x77, 0xb5, 0x5e, 0xeb, 0xac, 0x6d, 0x97, 0x94, 0xec, 0x2d, 0x25, 0x3b, 0xdc, 0x52,
-	0x1a, 0xec, 0xbd, 0xfd, 0xb3, 0x53, 0x79, 0xf3, 0xbe, 0xa3, 0x05, 0x4d, 0x95, 0xe7, 0x72, 0xf8,
-	0x19, 0x30, 0x48, 0x8e, 0x66, 0x73, 0x82, 0xa3, 0x17, 0x04, 0xf1, 0x55, 0x41, 0x98, 0xd9, 0xe8,
-	0xea, 0xbd, 0x66, 0x70, 0x4f, 0xe9, 0xe7, 0x4a, 0x86, 0xa7, 0xa0, 0xce, 0x38, 0xe2, 0x2b, 0x66,
-	0xee, 0x75, 0xb5, 0xde, 0xdd, 0xb3, 0xfb, 0x76, 0xd9, 0x4a, 0x5b, 0x71, 0x9c, 0x48, 0x33, 0x50,
-	0x41, 0x5f, 0xd6, 0x5e, 0xff, 0xdc, 0xa9, 0x9c, 0xfc, 0xa8, 0x83, 0x96, 0x44, 0xcd, 0xb3, 0x75,
-	0xc6, 0x5f, 0x41, 0x08, 0x6a, 0xfc, 0xd5, 0x92, 0x28, 0xd8, 0x72, 0xbf, 0xe5, 0x5f, 0xfd, 0x0f,
-	0xfe, 0xfa, 0x2d, 0xfc, 0x8f, 0x40, 0x83, 0x6f, 0xa2, 0x14, 0xb1, 0xb4, 0x64, 0x1a, 0xd4, 0xf9,
-	0xe6, 0x31, 0x62, 0x29, 0xfc, 0x14, 0xdc, 0x99, 0xcd, 0x69, 0xfc, 0x32, 0x4a, 0x49, 0x96, 0xa4,
-	0x5c, 0x62, 0xd3, 0x83, 0x96, 0xd4, 0x1e, 0x4b, 0x09, 0x0e, 0x40, 0xf3, 0x7a, 0x7c, 0xfe, 0x1f,
-	0xba, 0xeb, 0x34, 0x68, 0x82, 0x06, 0x26, 0x1c, 0x65, 0x73, 0x41, 0x4c, 0xd4, 0xdf, 0x1e, 0xe1,
-	0xe1, 0x0d, 0x52, 0xcd, 0x2d, 0x12, 0x18, 0x83, 0x3a, 0x5a, 0x08, 0x54, 0x66, 0xb3, 0xab, 0xf7,
-	0x5a, 0x67, 0xc7, 0xb6, 0x9a, 0x00, 0x31, 0xb2, 0xb6, 0x1a, 0x59, 0x7b, 0x48, 0xb3, 0x7c, 0xf0,
-	0xb9, 0xa8, 0xf8, 0xeb, 0xfb, 0x4e, 0x2f, 0xc9, 0x78, 0xba, 0x9a, 0xd9, 0x31, 0x5d, 0xa8, 0xbf,
-	0x83, 0x5a, 0x4e, 0x19, 0x7e, 0xe9, 0x08, 0x7c, 0x4c, 0x26, 0xb0, 0x40, 0x5d, 0x0d, 0x8f, 0xc1,
-	0x5e, 0x82, 0x58, 0xb4, 0x62, 0x04, 0x9b, 0xa0, 0xab, 0xf5, 0x6a, 0x41, 0x23, 0x41, 0x6c, 0xca,
-	0x08, 0x7e, 0xf8, 0x83, 0x06, 0xf6, 0x6f, 0x34, 0x0b, 0xb6, 0xc1, 0xa1, 0x3b, 0x1c, 0x5e, 0x4c,
-	0xc7, 0x61, 0x34, 0x09, 0xdd, 0x70, 0x3a, 0x89, 0x9e, 0x7a, 0xe3, 0x91, 0x3f, 0x7e, 0x64, 0x54,
-	0xe0, 0x31, 0xb8, 0xff, 0x81, 0xe7, 0x0e, 0x43, 0xff, 0x99, 0x67, 0x68, 0xf0, 0x13, 0x70, 0xf4,
-	0x81, 0x35, 0xf2, 0x27, 0xee, 0xe0, 0x89, 0x37, 0x32, 0xaa, 0xb7, 0xe4, 0x9d, 0xbb, 0xbe, 0xb0,
-	0xf4, 0x76, 0xed, 0xf5, 0x2f, 0x56, 0xe5, 0xe1, 0x4f, 0x9a, 0x9c, 0x89, 0xeb, 0xc1, 0x3a, 0x00,
-	0xc6, 0xc8, 0x7b, 0x1e, 0x9d, 0x7b, 0x6e, 0x38, 0x0d, 0xbc, 0x68, 0xf2, 0xad, 0xfb, 0xb4, 0x2c,
-	0xbf, 0xab, 0x3e, 0xf1, 0xbf, 0x99, 0xfa, 0x23, 0x3f, 0xfc, 0xce, 0xd0, 0xe0, 0x21, 0x80, 0xbb,
-	0xd6, 0x45, 0x30, 0xf2, 0x82, 0x89, 0x51, 0x85, 0x47, 0xe0, 0xe3, 0x1b, 0x17, 0x85, 0xee, 0xd7,
-	0xe2, 0x29, 0xba, 0x78, 0xe6, 0xae, 0xf1, 0xe8, 0xe2, 0x99, 0x17, 0x8c, 0xdd, 0xf1, 0xd0, 0x33,
-	0x6a, 0xe5, 0x6f, 0x1a, 0x7c, 0xf5, 0xf6, 0xd2, 0xd2, 0xde, 0x5d, 0x5a, 0xda, 0x5f, 0x97, 0x96,
-	0xf6, 0xe6, 0xca, 0xaa, 0xbc, 0xbb, 0xb2, 0x2a, 0x7f, 0x5c, 0x59, 0x95, 0xef, 0x1f, 0xec, 0x74,
-	0x80, 0xd1, 0xbc, 0x38, 0xcd, 0xa8, 0x5c, 0x9d, 0x8d, 0x23, 0x3e, 0x6e, 0xb2, 0x05, 0xb3, 0xba,
-	0x1c, 0x9a, 0x2f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x12, 0x72, 0xbe, 0x56, 0xf0, 0x04, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x45, 0x45, 0xb2, 0x4e, 0xb1, 0xcd, 0x5e, 0x14, 0x9b, 0x56, 0x01, 0x49, 0x75, 0x86,
+	0xaa, 0x01, 0x4c, 0xd6, 0xee, 0x56, 0xa0, 0x03, 0x25, 0xd1, 0x09, 0xd1, 0x40, 0x76, 0x28, 0x29,
+	0x4d, 0xbb, 0x10, 0x27, 0xde, 0x99, 0x24, 0x62, 0xf1, 0x5c, 0xde, 0xd1, 0x90, 0xf6, 0x0e, 0x41,
+	0xa7, 0xf4, 0x1f, 0x14, 0xe8, 0xd6, 0xb9, 0x3f, 0x22, 0x63, 0xd0, 0xa9, 0x53, 0x53, 0xd8, 0xff,
+	0xa0, 0x4b, 0xd7, 0xe2, 0x8e, 0x27, 0xd7, 0x76, 0x83, 0x02, 0xcd, 0xc4, 0x7b, 0xdf, 0xf7, 0xee,
+	0x3d, 0xbd, 0xef, 0x7d, 0x27, 0x60, 0x60, 0xb2, 0xb0, 0xcf, 0xf7, 0xed, 0x24, 0x44, 0xd6, 0x59,
+	0x46, 0x39, 0x85, 0x55, 0x4c, 0x16, 0xd6, 0xf9, 0x7e, 0xab, 0x19, 0xd1, 0x88, 0x4a, 0xc8, 0x16,
+	0xa7, 0x82, 0x6d, 0xed, 0x84, 0x94, 0xcd, 0x29, 0x0b, 0x0a, 0xa2, 0x08, 0x14, 0xd5, 0x89, 0x28,
+	0x8d, 0x4e, 0x89, 0x2d, 0xa3, 0x59, 0x7e, 0x62, 0xf3, 0x64, 0x4e, 0x18, 0x47, 0xf3, 0x33, 0x95,
+	0xd0, 0x2e, 0xd2, 0xed, 0x19, 0x62, 0xc4, 0x3e, 0xdf, 0x9f, 0x11, 0x8e, 0xf6, 0xed, 0x90, 0x26,
+	0xe9, 0xaa, 0xf6, 0xed, 0x02, 0x28, 0x5d, 0x16, 0xd4, 0xee, 0x9f, 0x65, 0xd0, 0xf4, 0x52, 0x4e,
+	0xb2, 0x30, 0x46, 0x49, 0x3a, 0x74, 0x9f, 0x3b, 0x61, 0x48, 0xf3, 0x94, 0x43, 0x03, 0xe8, 0x38,
+	0xc1, 0xa6, 0xd6, 0xd5, 0x7a, 0x75, 0x5f, 0x1c, 0xe1, 0x03, 0xb0, 0x1e, 0xd2, 0x34, 0x25, 0x21,
+	0x4f, 0x68, 0x1a, 0x24, 0xd8, 0x2c, 0x4b, 0xee, 0xee, 0x3f, 0xa0, 0x87, 0xe1, 0x2e, 0x58, 0x8f,
+	0x29, 0xe3, 0x81, 0x2c, 0x27, 0x92, 0x74, 0x99, 0xd4, 0x10, 0xe0, 0x40, 0x60, 0x1e, 0x86, 0x0e,
+	0xd8, 0x44, 0x45, 0x97, 0x00, 0x61, 0x9c, 0x11, 0xc6, 0xcc, 0x8a, 0xc8, 0xea, 0x9b, 0xbf, 0xfe,
+	0xb2, 0xd7, 0x54, 0xa3, 0x3b, 0x05, 0x33, 0xe6, 0x59, 0x92, 0x46, 0xfe, 0x86, 0xba, 0xa0, 0x50,
+	0xb8, 0x0d, 0x6a, 0x67, 0x34, 0xe3, 0xa2, 0xc1, 0x1d, 0xd9, 0xa0, 0x2a, 0x42, 0x0f, 0xc3, 0x01,
+	0x00, 0x61, 0x46, 0x10, 0x27, 0x38, 0x40, 0xdc, 0xac, 0x76, 0xb5, 0x5e, 0xe3, 0xa0, 0x65, 0x15,
+	0xf3, 0x5b, 0xab, 0xf9, 0xad, 0xc9, 0x4a, 0xc0, 0xfe, 0xda, 0xeb, 0xdf, 0x3b, 0xa5, 0x57, 0x6f,
+	0x3b, 0x9a, 0x5f, 0x57, 0xf7, 0x1c, 0x0e, 0x3f, 0x01, 0x06, 0x49, 0xd1, 0xec, 0x94, 0xe0, 0xe0,
+	0x84, 0x20, 0x9e, 0x67, 0x84, 0x99, 0xb5, 0xae, 0xde, 0xab, 0xfb, 0x9b, 0x0a, 0x3f, 0x54, 0x30,
+	0xdc, 0x03, 0x55, 0xc6, 0x11, 0xcf, 0x99, 0xb9, 0xd6, 0xd5, 0x7a, 0x1b, 0x07, 0xf7, 0xad, 0x62,
+	0xcb, 0x96, 0xd2, 0x71, 0x2c, 0x49, 0x5f, 0x25, 0x7d, 0x5e, 0x79, 0xf9, 0x63, 0xa7, 0xb4, 0xfb,
+	0xbd, 0x0e, 0x1a, 0x52, 0x6a, 0x9e, 0x9c, 0x27, 0x7c, 0x09, 0x21, 0xa8, 0xf0, 0xe5, 0x19, 0x51,
+	0x62, 0xcb, 0xf3, 0x4a, 0xff, 0xf2, 0x7f, 0xe8, 0xaf, 0xbf, 0x43, 0xff, 0x6d, 0x50, 0xe3, 0x8b,
+	0x20, 0x46, 0x2c, 0x2e, 0x34, 0xf5, 0xab, 0x7c, 0xf1, 0x18, 0xb1, 0x18, 0x7e, 0x04, 0xee, 0xce,
+	0x4e, 0x69, 0xf8, 0x22, 0x88, 0x49, 0x12, 0xc5, 0x5c, 0xca, 0xa6, 0xfb, 0x0d, 0x89, 0x3d, 0x96,
+	0x10, 0xec, 0x83, 0xfa, 0x95, 0xb3, 0xfe, 0x9f, 0x74, 0x57, 0xd7, 0xa0, 0x09, 0x6a, 0x98, 0x70,
+	0x94, 0x9c, 0x0a, 0xc5, 0x44, 0xff, 0x55, 0x08, 0xb7, 0x6e, 0x28, 0x55, 0x5f, 0x49, 0x02, 0x43,
+	0x50, 0x45, 0x73, 0x21, 0x95, 0x59, 0xef, 0xea, 0xbd, 0xc6, 0xc1, 0x8e, 0xa5, 0x1c, 0x20, 0xdc,
+	0x6c, 0x29, 0x37, 0x5b, 0x03, 0x9a, 0xa4, 0xfd, 0x4f, 0x45, 0xc7, 0x9f, 0xdf, 0x76, 0x7a, 0x51,
+	0xc2, 0xe3, 0x7c, 0x66, 0x85, 0x74, 0xae, 0x5e, 0x8a, 0xfa, 0xec, 0x31, 0xfc, 0xc2, 0x16, 0xf2,
+	0x31, 0x79, 0x81, 0xf9, 0xaa, 0x34, 0xdc, 0x01, 0x6b, 0x11, 0x62, 0x41, 0xce, 0x08, 0x36, 0x41,
+	0x57, 0xeb, 0x55, 0xfc, 0x5a, 0x84, 0xd8, 0x94, 0x11, 0xbc, 0xfb, 0x97, 0x06, 0x36, 0x8f, 0x49,
+	0x8a, 0x93, 0x34, 0xf2, 0x06, 0x4e, 0x1f, 0xf1, 0x30, 0x7e, 0x5f, 0xf3, 0xf7, 0x40, 0x65, 0xce,
+	0x22, 0x66, 0xea, 0x72, 0x90, 0xe6, 0xbf, 0xb4, 0x73, 0xd2, 0xa5, 0x2f, 0x33, 0x60, 0x13, 0xdc,
+	0x99, 0x93, 0x39, 0x15, 0xc6, 0x17, 0xb6, 0x2a, 0x02, 0x81, 0xce, 0x96, 0x9c, 0x30, 0xb9, 0x9c,
+	0x8a, 0x5f, 0x04, 0xd0, 0x02, 0xf7, 0x4e, 0x92, 0x8c, 0xf1, 0xe0, 0xdb, 0x9c, 0xe4, 0x04, 0xaf,
+	0x16, 0x58, 0x95, 0x0b, 0xfc, 0x40, 0x52, 0x4f, 0x25, 0xa3, 0xd6, 0xf8, 0x31, 0xd8, 0x14, 0xfb,
+	0xa0, 0x39, 0x0f, 0x18, 0x09, 0x69, 0x8a, 0x8b, 0x55, 0x54, 0xfc, 0x0d, 0x05, 0x8f, 0x0b, 0xf4,
+	0xe1, 0x77, 0x1a, 0x58, 0xbf, 0x61, 0x53, 0xd8, 0x02, 0x5b, 0xce, 0x60, 0x70, 0x34, 0x1d, 0x4d,
+	0x82, 0xf1, 0xc4, 0x99, 0x4c, 0xc7, 0xc1, 0xb1, 0x3b, 0x1a, 0x7a, 0xa3, 0x47, 0x46, 0x09, 0xee,
+	0x80, 0xfb, 0xb7, 0x38, 0x67, 0x30, 0xf1, 0x9e, 0xb9, 0x86, 0x06, 0x3f, 0x04, 0xdb, 0xb7, 0xa8,
+	0xa1, 0x37, 0x76, 0xfa, 0x4f, 0xdc, 0xa1, 0x51, 0x7e, 0xc7, 0xbd, 0x43, 0xc7, 0x13, 0x94, 0xde,
+	0xaa, 0xbc, 0xfc, 0xa9, 0x5d, 0x7a, 0xf8, 0x83, 0x26, 0x5f, 0xc3, 0xd5, 0x93, 0x6a, 0x02, 0x63,
+	0xe8, 0x3e, 0x0f, 0x0e, 0x5d, 0x67, 0x32, 0xf5, 0xdd, 0x60, 0xfc, 0x95, 0x73, 0x5c, 0xb4, 0xbf,
+	0x8e, 0x3e, 0xf1, 0x9e, 0x4e, 0xbd, 0xa1, 0x37, 0xf9, 0xda, 0xd0, 0xe0, 0x16, 0x80, 0xd7, 0xa9,
+	0x23, 0x7f, 0xe8, 0xfa, 0x63, 0xa3, 0x0c, 0xb7, 0xc1, 0xbd, 0x1b, 0x85, 0x26, 0xce, 0x97, 0x62,
+	0x14, 0x5d, 0x8c, 0x79, 0x9d, 0x78, 0x74, 0xf4, 0xcc, 0xf5, 0x47, 0xce, 0x68, 0xe0, 0x1a, 0x95,
+	0xe2, 0x37, 0xf5, 0xbf, 0x78, 0x7d, 0xd1, 0xd6, 0xde, 0x5c, 0xb4, 0xb5, 0x3f, 0x2e, 0xda, 0xda,
+	0xab, 0xcb, 0x76, 0xe9, 0xcd, 0x65, 0xbb, 0xf4, 0xdb, 0x65, 0xbb, 0xf4, 0xcd, 0x83, 0x6b, 0xde,
+	0x63, 0x34, 0xcd, 0xf6, 0x12, 0x2a, 0xbf, 0xf6, 0xc2, 0x16, 0xff, 0xf8, 0xd2, 0x7c, 0xb3, 0xaa,
+	0x5c, 0xf9, 0x67, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x04, 0xd9, 0x4f, 0x43, 0x05, 0x06, 0x00,
 	0x00,
 }
 
@@ -525,6 +634,81 @@ func (m *DEXActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingICABatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingICABatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingICABatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintIca(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FirstQueuedHeight != 0 {
+		i = encodeVarintIca(dAtA, i, uint64(m.FirstQueuedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintIca(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Memos) > 0 {
+		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Memos[iNdEx])
+			copy(dAtA[i:], m.Memos[iNdEx])
+			i = encodeVarintIca(dAtA, i, uint64(len(m.Memos[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIca(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintIca(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintIca(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIca(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIca(v)
 	base := offset
@@ -623,6 +807,44 @@ func (m *DEXActivity) Size() (n int) {
 	return n
 }
 
+func (m *PendingICABatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovIca(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovIca(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovIca(uint64(l))
+		}
+	}
+	if len(m.Memos) > 0 {
+		for _, s := range m.Memos {
+			l = len(s)
+			n += 1 + l + sovIca(uint64(l))
+		}
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovIca(uint64(m.Bytes))
+	}
+	if m.FirstQueuedHeight != 0 {
+		n += 1 + sovIca(uint64(m.FirstQueuedHeight))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovIca(uint64(m.TimeoutSeconds))
+	}
+	return n
+}
+
 func sovIca(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1270,6 +1492,243 @@ func (m *DEXActivity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingICABatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIca
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingICABatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingICABatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIca
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIca
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIca
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memos = append(m.Memos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstQueuedHeight", wireType)
+			}
+			m.FirstQueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstQueuedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIca
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIca(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIca
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIca(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
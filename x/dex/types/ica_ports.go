@@ -0,0 +1,43 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	// ICAOwnerNamespaceDID prefixes the ICA owners of accounts held by DIDs
+	ICAOwnerNamespaceDID = "did"
+
+	// ICAOwnerNamespaceModule prefixes the ICA owners of module-owned
+	// accounts such as the protocol liquidity account
+	ICAOwnerNamespaceModule = "dex"
+
+	// icaOwnerHashBytes is the length of the owner hash. 128 bits keeps port
+	// IDs short while making accidental collisions practically impossible.
+	icaOwnerHashBytes = 16
+)
+
+// ICAOwner returns the interchain account owner registered with the ICA
+// controller for an account owner on a connection. DIDs contain characters
+// that are not valid in port identifiers, so the owner is a namespaced hash:
+// did-<hash> for DIDs and dex-<hash> for module-owned accounts.
+func ICAOwner(owner, connectionID string) string {
+	namespace := ICAOwnerNamespaceModule
+	if strings.HasPrefix(owner, "did:") {
+		namespace = ICAOwnerNamespaceDID
+	}
+
+	sum := sha256.Sum256([]byte(owner + "/" + connectionID))
+	return namespace + "-" + hex.EncodeToString(sum[:icaOwnerHashBytes])
+}
+
+// ICAPortID returns the controller port of the interchain account owned by
+// owner on a connection, icacontroller-did-<hash>. It is deterministic, so it
+// is known before the account is registered.
+func ICAPortID(owner, connectionID string) string {
+	return icatypes.ControllerPortPrefix + ICAOwner(owner, connectionID)
+}
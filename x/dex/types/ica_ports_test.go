@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestICAPortID(t *testing.T) {
+	did := "did:sonr:alice"
+
+	portID := types.ICAPortID(did, "connection-0")
+	require.NoError(t, host.PortIdentifierValidator(portID))
+	require.Regexp(t, `^icacontroller-did-[0-9a-f]{32}$`, portID)
+	require.Equal(t, portID, types.ICAPortID(did, "connection-0"), "port IDs are deterministic")
+
+	require.NotEqual(t, portID, types.ICAPortID(did, "connection-1"))
+	require.NotEqual(t, portID, types.ICAPortID("did:sonr:bob", "connection-0"))
+
+	moduleOwner := types.ICAPortID(types.ProtocolAccountOwner, "connection-0")
+	require.NoError(t, host.PortIdentifierValidator(moduleOwner))
+	require.Regexp(t, `^icacontroller-dex-`, moduleOwner)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the name of module.
 	ModuleName = "dex"
@@ -13,6 +15,10 @@ const (
 	// StoreKey is the store key string for the module.
 	StoreKey = ModuleName
 
+	// TransientStoreKey is the key of the module's transient store, which
+	// caches DID summaries for the current block.
+	TransientStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for the module.
 	RouterKey = ModuleName
 
@@ -20,15 +26,175 @@ const (
 	QuerierRoute = ModuleName
 )
 
+// DIDSummaryCachePrefix is the transient store prefix for DID summaries read
+// in the current block
+var DIDSummaryCachePrefix = collections.NewPrefix(0)
+
+// DenomFiltersPrefix is the store prefix for per-connection denom allow/deny lists
+var DenomFiltersPrefix = collections.NewPrefix(7)
+
+var (
+	// PriceHistoryParamsPrefix is the store prefix for price history parameters
+	PriceHistoryParamsPrefix = collections.NewPrefix(8)
+
+	// PriceHistoryPrefix is the store prefix for per-epoch aggregated prices
+	PriceHistoryPrefix = collections.NewPrefix(9)
+
+	// TWAMMOrdersPrefix is the store prefix for time-weighted orders
+	TWAMMOrdersPrefix = collections.NewPrefix(10)
+
+	// TWAMMSequencePrefix is the store prefix for the TWAMM order ID sequence
+	TWAMMSequencePrefix = collections.NewPrefix(11)
+
+	// TWAMMSchedulePrefix is the store prefix for the (height, order ID) execution schedule
+	TWAMMSchedulePrefix = collections.NewPrefix(12)
+)
+
+// SwapIntentsPrefix is the store prefix for the (height, DID, pair) recent
+// swap index. Prefix 13 held the arbitrage params before they moved into the
+// module params.
+var SwapIntentsPrefix = collections.NewPrefix(14)
+
+var (
+	// LimitOrdersPrefix is the store prefix for limit orders
+	LimitOrdersPrefix = collections.NewPrefix(15)
+
+	// LimitOrderSequencePrefix is the store prefix for the limit order ID sequence
+	LimitOrderSequencePrefix = collections.NewPrefix(16)
+
+	// LimitOrderExpiryPrefix is the store prefix for the (expiration, order ID) index of open orders
+	LimitOrderExpiryPrefix = collections.NewPrefix(17)
+
+	// LimitOrdersByDIDPrefix is the store prefix for the (DID, order ID) index
+	LimitOrdersByDIDPrefix = collections.NewPrefix(18)
+
+	// PendingOrderCancelsPrefix is the store prefix for the (port, sequence) index of unacknowledged cancellations
+	PendingOrderCancelsPrefix = collections.NewPrefix(19)
+)
+
+var (
+	// SwapsPrefix is the store prefix for swap settlement records
+	SwapsPrefix = collections.NewPrefix(20)
+
+	// SwapSequencePrefix is the store prefix for the swap ID sequence
+	SwapSequencePrefix = collections.NewPrefix(21)
+
+	// UnsentSwapsPrefix is the store prefix for the (account key, swap ID) index of swaps waiting in a batch
+	UnsentSwapsPrefix = collections.NewPrefix(22)
+
+	// SwapsByPacketPrefix is the store prefix for the (port, sequence, swap ID) index of unsettled swaps
+	SwapsByPacketPrefix = collections.NewPrefix(23)
+)
+
+var (
+	// BlockOpsPrefix is the store prefix for the number of operations in the current block
+	BlockOpsPrefix = collections.NewPrefix(24)
+
+	// DIDOpCountersPrefix is the store prefix for per-DID daily operation counters
+	DIDOpCountersPrefix = collections.NewPrefix(25)
+
+	// DIDDailyVolumesPrefix is the store prefix for per-DID daily swap volume
+	DIDDailyVolumesPrefix = collections.NewPrefix(26)
+
+	// CollectedFeesPrefix is the store prefix for platform fees collected per (connection, denom)
+	CollectedFeesPrefix = collections.NewPrefix(27)
+)
+
+var (
+	// OTCOffersPrefix is the store prefix for OTC offers
+	OTCOffersPrefix = collections.NewPrefix(28)
+
+	// OTCOfferSequencePrefix is the store prefix for the OTC offer ID sequence
+	OTCOfferSequencePrefix = collections.NewPrefix(29)
+
+	// OTCOfferExpiryPrefix is the store prefix for the (expiration, offer ID) index of unsettled offers
+	OTCOfferExpiryPrefix = collections.NewPrefix(30)
+
+	// OTCOffersByDIDPrefix is the store prefix for the (DID, offer ID) index of makers, takers and arbiters
+	OTCOffersByDIDPrefix = collections.NewPrefix(31)
+)
+
+var (
+	// PoolReservesPrefix is the store prefix for the latest reserves read from host chain pools
+	PoolReservesPrefix = collections.NewPrefix(32)
+
+	// PendingPoolQueriesPrefix is the store prefix for pool reserve queries awaiting their acknowledgement
+	PendingPoolQueriesPrefix = collections.NewPrefix(33)
+)
+
+var (
+	// DWNActivityRecordsPrefix is the store prefix for the (DID, activity key) index of DWN activity records
+	DWNActivityRecordsPrefix = collections.NewPrefix(34)
+)
+
+var (
+	// PendingOrderQueriesPrefix is the store prefix for the (port, sequence) index of unacknowledged order status queries
+	PendingOrderQueriesPrefix = collections.NewPrefix(35)
+
+	// OrderMonitorCursorPrefix is the store prefix for the ID of the last order the monitor queried
+	OrderMonitorCursorPrefix = collections.NewPrefix(36)
+
+	// ProtocolPositionsPrefix is the store prefix for protocol-owned liquidity positions
+	ProtocolPositionsPrefix = collections.NewPrefix(37)
+
+	// PendingProtocolLiquidityPrefix is the store prefix for the (port, sequence) index of unacknowledged protocol liquidity packets
+	PendingProtocolLiquidityPrefix = collections.NewPrefix(38)
+
+	// ICAPortAccountsPrefix is the store prefix for the controller port -> account key index
+	ICAPortAccountsPrefix = collections.NewPrefix(39)
+)
+
+var (
+	// PendingFeesPrefix is the store prefix for platform fees per (port, sequence, denom) awaiting their acknowledgement
+	PendingFeesPrefix = collections.NewPrefix(40)
+
+	// UnsentFeesPrefix is the store prefix for platform fees per (account key, denom) waiting in a batch
+	UnsentFeesPrefix = collections.NewPrefix(41)
+)
+
 // Event types
 const (
-	EventTypeICAPacketAcknowledged = "ica_packet_acknowledged"
-	EventTypeICAPacketTimeout      = "ica_packet_timeout"
-	EventTypeDEXAccountRegistered  = "dex_account_registered"
-	EventTypeSwapExecuted          = "swap_executed"
-	EventTypeLiquidityProvided     = "liquidity_provided"
-	EventTypeLiquidityRemoved      = "liquidity_removed"
-	EventTypeOrderCreated          = "order_created"
-	EventTypeOrderCancelled        = "order_cancelled"
-	EventTypeDIDActivity           = "did_activity"
+	EventTypeICAPacketAcknowledged     = "ica_packet_acknowledged"
+	EventTypeICAPacketTimeout          = "ica_packet_timeout"
+	EventTypeDEXAccountRegistered      = "dex_account_registered"
+	EventTypeSwapExecuted              = "swap_executed"
+	EventTypeSwapSettled               = "swap_settled"
+	EventTypeLiquidityProvided         = "liquidity_provided"
+	EventTypeLiquidityRemoved          = "liquidity_removed"
+	EventTypeOrderCreated              = "order_created"
+	EventTypeOrderCancelled            = "order_cancelled"
+	EventTypeOrderFilled               = "order_filled"
+	EventTypeOrderExpired              = "order_expired"
+	EventTypeOrderCancelFailed         = "order_cancel_failed"
+	EventTypeDIDActivity               = "did_activity"
+	EventTypeICABatchQueued            = "ica_batch_queued"
+	EventTypeICABatchFlushed           = "ica_batch_flushed"
+	EventTypeDenomFilterUpdated        = "denom_filter_updated"
+	EventTypePriceHistoryPruned        = "price_history_pruned"
+	EventTypeTWAMMOrderCreated         = "twamm_order_created"
+	EventTypeTWAMMSliceExecuted        = "twamm_slice_executed"
+	EventTypeTWAMMSliceSkipped         = "twamm_slice_skipped"
+	EventTypeTWAMMOrderCompleted       = "twamm_order_completed"
+	EventTypeTWAMMOrderCancelled       = "twamm_order_cancelled"
+	EventTypeOppositeSwapDetected      = "opposite_swap_detected"
+	EventTypeFeeCollected              = "fee_collected"
+	EventTypeOTCOfferCreated           = "otc_offer_created"
+	EventTypeOTCOfferFunded            = "otc_offer_funded"
+	EventTypeOTCOfferConfirmed         = "otc_offer_confirmed"
+	EventTypeOTCOfferCompleted         = "otc_offer_completed"
+	EventTypeOTCOfferCancelled         = "otc_offer_cancelled"
+	EventTypeOTCOfferExpired           = "otc_offer_expired"
+	EventTypeOTCOfferRefunded          = "otc_offer_refunded"
+	EventTypePoolReservesRequested     = "pool_reserves_requested"
+	EventTypePoolReservesUpdated       = "pool_reserves_updated"
+	EventTypeParamsUpdated             = "params_updated"
+	EventTypeConnectionAllowed         = "connection_allowed"
+	EventTypeConnectionDisallowed      = "connection_disallowed"
+	EventTypeOrderStatusRequested      = "order_status_requested"
+	EventTypeOrderFillUpdated          = "order_fill_updated"
+	EventTypeProtocolAccountRegistered = "protocol_account_registered"
+	EventTypeProtocolLiquiditySent     = "protocol_liquidity_sent"
+	EventTypeProtocolLiquiditySettled  = "protocol_liquidity_settled"
+	EventTypeProtocolAccountFunded     = "protocol_account_funded"
+	EventTypeScreeningDecision         = "screening_decision"
 )
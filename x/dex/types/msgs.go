@@ -2,11 +2,14 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -39,6 +42,9 @@ func (msg *MsgExecuteSwap) ValidateBasic() error {
 	if msg.MinAmountOut.IsNil() || !msg.MinAmountOut.IsPositive() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "min amount out must be positive")
 	}
+	if _, err := ParseSwapRoute(msg.Route); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,3 +126,375 @@ func (msg *MsgCancelOrder) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ValidateBasic performs basic validation of MsgCreateTWAMMOrder
+func (msg *MsgCreateTWAMMOrder) ValidateBasic() error {
+	if msg.Did == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "DID cannot be empty")
+	}
+	if msg.ConnectionId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "connection ID cannot be empty")
+	}
+	if msg.TokenOutDenom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "token out denom cannot be empty")
+	}
+	if !msg.MinAmountOut.IsNil() && msg.MinAmountOut.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "min amount out cannot be negative")
+	}
+	order := TWAMMOrder{
+		Did:            msg.Did,
+		ConnectionId:   msg.ConnectionId,
+		TokenIn:        msg.TokenIn,
+		TotalSlices:    msg.TotalSlices,
+		IntervalBlocks: msg.IntervalBlocks,
+	}
+	if err := order.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of MsgCancelTWAMMOrder
+func (msg *MsgCancelTWAMMOrder) ValidateBasic() error {
+	if msg.Did == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "DID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateBatchParams message
+func (msg *MsgUpdateBatchParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgUpdateBatchParams
+func (msg *MsgUpdateBatchParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdatePriceHistoryParams message
+func (msg *MsgUpdatePriceHistoryParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgUpdatePriceHistoryParams
+func (msg *MsgUpdatePriceHistoryParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetDenomFilter message
+func (msg *MsgSetDenomFilter) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgSetDenomFilter
+func (msg *MsgSetDenomFilter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := msg.Filter.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRemoveDenomFilter message
+func (msg *MsgRemoveDenomFilter) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgRemoveDenomFilter
+func (msg *MsgRemoveDenomFilter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ConnectionId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "connection ID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgCreateOTCOffer message
+func (msg *MsgCreateOTCOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgCreateOTCOffer
+func (msg *MsgCreateOTCOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.MakerDid == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "maker DID cannot be empty")
+	}
+	if !msg.Offer.IsValid() || msg.Offer.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid offer %s", msg.Offer)
+	}
+	if !msg.Ask.IsValid() || msg.Ask.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid ask %s", msg.Ask)
+	}
+	if msg.TakerDid == msg.MakerDid {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "maker and taker must differ")
+	}
+	if msg.ArbiterDid != "" && (msg.ArbiterDid == msg.MakerDid || msg.ArbiterDid == msg.TakerDid) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "arbiter must not be a party to the trade")
+	}
+	if msg.Expiration.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is required")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgAcceptOTCOffer message
+func (msg *MsgAcceptOTCOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgAcceptOTCOffer
+func (msg *MsgAcceptOTCOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.TakerDid == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "taker DID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgConfirmOTCOffer message
+func (msg *MsgConfirmOTCOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgConfirmOTCOffer
+func (msg *MsgConfirmOTCOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Did == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "DID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgCancelOTCOffer message
+func (msg *MsgCancelOTCOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgCancelOTCOffer
+func (msg *MsgCancelOTCOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Did == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "DID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgResolveOTCOffer message
+func (msg *MsgResolveOTCOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgResolveOTCOffer
+func (msg *MsgResolveOTCOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ArbiterDid == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "arbiter DID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRefreshPoolReserves message
+func (msg *MsgRefreshPoolReserves) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgRefreshPoolReserves
+func (msg *MsgRefreshPoolReserves) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Did == "" {
+		return ErrInvalidDID
+	}
+	if msg.ConnectionId == "" {
+		return ErrInvalidConnectionID
+	}
+	if msg.PoolId == 0 {
+		return errorsmod.Wrap(ErrInvalidSwapParams, "pool ID is required")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgUpdateParams
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgAddAllowedConnection message
+func (msg *MsgAddAllowedConnection) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgAddAllowedConnection
+func (msg *MsgAddAllowedConnection) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRemoveAllowedConnection message
+func (msg *MsgRemoveAllowedConnection) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgRemoveAllowedConnection
+func (msg *MsgRemoveAllowedConnection) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ConnectionId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "connection ID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRegisterProtocolAccount message
+func (msg *MsgRegisterProtocolAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgRegisterProtocolAccount
+func (msg *MsgRegisterProtocolAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgFundProtocolAccount message
+func (msg *MsgFundProtocolAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgFundProtocolAccount
+func (msg *MsgFundProtocolAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, err.Error())
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "amount must be positive")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgGovProvideLiquidity message
+func (msg *MsgGovProvideLiquidity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgGovProvideLiquidity
+func (msg *MsgGovProvideLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	if msg.PoolId == 0 {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "pool ID is required")
+	}
+	if len(msg.Assets) != 2 || !msg.Assets.IsValid() || !msg.Assets.IsAllPositive() {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "expected 2 positive assets")
+	}
+	if msg.ShareOutAmount.IsNil() || !msg.ShareOutAmount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "share out amount must be positive")
+	}
+	if lock := time.Duration(msg.LockSeconds) * time.Second; lock < MinProtocolLiquidityLock {
+		return errorsmod.Wrapf(ErrInvalidLiquidityParams, "lock must be at least %s", MinProtocolLiquidityLock)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgGovRemoveLiquidity message
+func (msg *MsgGovRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic performs basic validation of MsgGovRemoveLiquidity
+func (msg *MsgGovRemoveLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	if msg.PoolId == 0 {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "pool ID is required")
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "shares must be positive")
+	}
+	if !msg.MinAmounts.IsValid() {
+		return errorsmod.Wrap(ErrInvalidLiquidityParams, "invalid min amounts")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate performs basic validation of the Noble route
+func (m NobleRoute) Validate() error {
+	if m.ConnectionId == "" {
+		return fmt.Errorf("connection ID cannot be empty")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return fmt.Errorf("noble route %s: %w", m.ConnectionId, err)
+	}
+	if strings.TrimSpace(m.SwapContract) == "" {
+		return fmt.Errorf("noble route %s: swap contract cannot be empty", m.ConnectionId)
+	}
+	return nil
+}
+
+// NobleRoute returns the Noble route configured for a connection, if any
+func (m Params) NobleRoute(connectionID string) (NobleRoute, bool) {
+	for _, route := range m.NobleRoutes {
+		if route.ConnectionId == connectionID {
+			return route, true
+		}
+	}
+	return NobleRoute{}, false
+}
+
+// nobleSwapMemo is the transfer memo that has the swap chain's ibc-hooks call
+// the cross-chain swap contract with the transferred tokens
+type nobleSwapMemo struct {
+	Wasm struct {
+		Contract string `json:"contract"`
+		Msg      struct {
+			OsmosisSwap nobleOsmosisSwap `json:"osmosis_swap"`
+		} `json:"msg"`
+	} `json:"wasm"`
+}
+
+type nobleOsmosisSwap struct {
+	OutputDenom string `json:"output_denom"`
+	Slippage    struct {
+		MinOutputAmount string `json:"min_output_amount"`
+	} `json:"slippage"`
+	Receiver         string          `json:"receiver"`
+	OnFailedDelivery string          `json:"on_failed_delivery"`
+	Route            []nobleRouteHop `json:"route,omitempty"`
+}
+
+type nobleRouteHop struct {
+	PoolId        uint64 `json:"pool_id,string"`
+	TokenOutDenom string `json:"token_out_denom"`
+}
+
+// NobleSwapMemo builds the memo of a Noble transfer that swaps the tokens for
+// at least minAmountOut of tokenOutDenom on the swap chain, along route when
+// one is given, and sends the output to receiver
+func NobleSwapMemo(
+	contract string,
+	tokenOutDenom string,
+	minAmountOut math.Int,
+	receiver string,
+	route []SwapAmountInRoute,
+) (string, error) {
+	var memo nobleSwapMemo
+	memo.Wasm.Contract = contract
+
+	swap := &memo.Wasm.Msg.OsmosisSwap
+	swap.OutputDenom = tokenOutDenom
+	swap.Slippage.MinOutputAmount = minAmountOut.String()
+	swap.Receiver = receiver
+	swap.OnFailedDelivery = "do_nothing"
+	for _, hop := range route {
+		swap.Route = append(swap.Route, nobleRouteHop{PoolId: hop.PoolId, TokenOutDenom: hop.TokenOutDenom})
+	}
+
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestNobleSwapMemo(t *testing.T) {
+	memo, err := types.NobleSwapMemo(
+		"osmo1swapcontract",
+		"uatom",
+		math.NewInt(900),
+		"noble1ica",
+		[]types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 42, TokenOutDenom: "uatom"}},
+	)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"wasm":{"contract":"osmo1swapcontract","msg":{"osmosis_swap":{
+		"output_denom":"uatom",
+		"slippage":{"min_output_amount":"900"},
+		"receiver":"noble1ica",
+		"on_failed_delivery":"do_nothing",
+		"route":[{"pool_id":"1","token_out_denom":"uosmo"},{"pool_id":"42","token_out_denom":"uatom"}]
+	}}}}`, memo)
+}
+
+func TestNobleRouteValidate(t *testing.T) {
+	route := types.NobleRoute{ConnectionId: "connection-1", ChannelId: "channel-1", SwapContract: "osmo1swapcontract"}
+	require.NoError(t, route.Validate())
+
+	params := types.Params{NobleRoutes: []types.NobleRoute{route}}
+	found, ok := params.NobleRoute("connection-1")
+	require.True(t, ok)
+	require.Equal(t, route, found)
+	_, ok = params.NobleRoute("connection-0")
+	require.False(t, ok)
+
+	for _, invalid := range []types.NobleRoute{
+		{ChannelId: "channel-1", SwapContract: "osmo1swapcontract"},
+		{ConnectionId: "connection-1", ChannelId: "1", SwapContract: "osmo1swapcontract"},
+		{ConnectionId: "connection-1", ChannelId: "channel-1"},
+	} {
+		require.Error(t, invalid.Validate())
+	}
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// DefaultMaxOrdersPerCheck is how many orders a check queries when the
+// max_orders_per_check param is unset
+const DefaultMaxOrdersPerCheck = 20
+
+// WasmSmartContractStatePath is the host chain query that reads an order book
+// contract. The host must allow it for ICA module queries.
+const WasmSmartContractStatePath = "/cosmwasm.wasm.v1.Query/SmartContractState"
+
+// Validate performs basic validation of the order monitor parameters
+func (m OrderMonitorParams) Validate() error {
+	seen := make(map[string]bool, len(m.OrderBooks))
+	for _, book := range m.OrderBooks {
+		if book.ConnectionId == "" {
+			return fmt.Errorf("order book: connection ID cannot be empty")
+		}
+		if strings.TrimSpace(book.Contract) == "" {
+			return fmt.Errorf("order book %s: contract cannot be empty", book.ConnectionId)
+		}
+		if seen[book.ConnectionId] {
+			return fmt.Errorf("duplicate order book for %s", book.ConnectionId)
+		}
+		seen[book.ConnectionId] = true
+	}
+	return nil
+}
+
+// Enabled reports whether open orders are monitored
+func (m OrderMonitorParams) Enabled() bool {
+	return m.IntervalBlocks > 0 && len(m.OrderBooks) > 0
+}
+
+// MaxOrdersPerCheckOrDefault returns how many orders a check queries
+func (m OrderMonitorParams) MaxOrdersPerCheckOrDefault() int {
+	if m.MaxOrdersPerCheck == 0 {
+		return DefaultMaxOrdersPerCheck
+	}
+	return int(m.MaxOrdersPerCheck)
+}
+
+// OrderBook returns the order book contract configured for a connection
+func (m OrderMonitorParams) OrderBook(connectionID string) (string, bool) {
+	for _, book := range m.OrderBooks {
+		if book.ConnectionId == connectionID {
+			return book.Contract, true
+		}
+	}
+	return "", false
+}
+
+// RemoteOrderStatus is the state of a limit order as reported by the host
+// chain's order book
+type RemoteOrderStatus struct {
+	Status LimitOrderStatus
+	// FilledAmount is the amount of the output denom received so far
+	FilledAmount math.Int
+}
+
+// orderStatusQuery is the order book contract query for a single order
+type orderStatusQuery struct {
+	OrderStatus struct {
+		Owner         string `json:"owner"`
+		ClientOrderID string `json:"client_order_id"`
+	} `json:"order_status"`
+}
+
+// orderStatusResponse is the order book contract's answer to orderStatusQuery
+type orderStatusResponse struct {
+	Status       string `json:"status"`
+	FilledAmount string `json:"filled_amount"`
+}
+
+// NewLimitOrderStatusQuery builds the module query that reads the status of
+// an order from the order book contract. Orders are identified on the book
+// by their owner and the local order ID.
+func NewLimitOrderStatusQuery(signer, contract string, orderID uint64) *ICAMsgModuleQuerySafe {
+	var query orderStatusQuery
+	query.OrderStatus.Owner = signer
+	query.OrderStatus.ClientOrderID = strconv.FormatUint(orderID, 10)
+	queryData, _ := json.Marshal(query)
+
+	// QuerySmartContractStateRequest{address, query_data}
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.BytesType)
+	data = protowire.AppendString(data, contract)
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, queryData)
+	return &ICAMsgModuleQuerySafe{
+		Signer:   signer,
+		Requests: []ICAModuleQuery{{Path: WasmSmartContractStatePath, Data: data}},
+	}
+}
+
+// LimitOrderStatusFromAck reads the order status from the acknowledgement
+// result of a NewLimitOrderStatusQuery packet
+func LimitOrderStatusFromAck(result []byte) (RemoteOrderStatus, error) {
+	_, responses, err := moduleQueryResponses(result)
+	if err != nil {
+		return RemoteOrderStatus{}, err
+	}
+
+	// QuerySmartContractStateResponse holds the contract's JSON in field 1
+	var raw []byte
+	err = consumeFields(responses[0], func(num protowire.Number, v []byte) error {
+		if num == 1 {
+			raw = v
+		}
+		return nil
+	})
+	if err != nil {
+		return RemoteOrderStatus{}, fmt.Errorf("failed to decode contract response: %w", err)
+	}
+
+	var res orderStatusResponse
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return RemoteOrderStatus{}, fmt.Errorf("failed to decode order status: %w", err)
+	}
+	status, err := ParseLimitOrderStatus(res.Status)
+	if err != nil || status == LimitOrderStatusExpired {
+		return RemoteOrderStatus{}, fmt.Errorf("unexpected order status %q", res.Status)
+	}
+	filled := math.ZeroInt()
+	if res.FilledAmount != "" {
+		var ok bool
+		if filled, ok = math.NewIntFromString(res.FilledAmount); !ok || filled.IsNegative() {
+			return RemoteOrderStatus{}, fmt.Errorf("invalid filled amount %q", res.FilledAmount)
+		}
+	}
+	return RemoteOrderStatus{Status: status, FilledAmount: filled}, nil
+}
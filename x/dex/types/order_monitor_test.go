@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestOrderMonitorParamsValidate(t *testing.T) {
+	book := types.OrderBook{ConnectionId: "connection-0", Contract: "osmo1orderbook"}
+	require.NoError(t, types.OrderMonitorParams{}.Validate())
+	require.NoError(t, types.OrderMonitorParams{IntervalBlocks: 10, OrderBooks: []types.OrderBook{book}}.Validate())
+	require.Error(t, types.OrderMonitorParams{OrderBooks: []types.OrderBook{{ConnectionId: "connection-0"}}}.Validate())
+	require.Error(t, types.OrderMonitorParams{OrderBooks: []types.OrderBook{book, book}}.Validate())
+
+	require.False(t, types.OrderMonitorParams{IntervalBlocks: 10}.Enabled(), "no order books")
+	require.False(t, types.OrderMonitorParams{OrderBooks: []types.OrderBook{book}}.Enabled(), "no interval")
+	require.Equal(t, types.DefaultMaxOrdersPerCheck, types.OrderMonitorParams{}.MaxOrdersPerCheckOrDefault())
+
+	contract, ok := types.OrderMonitorParams{OrderBooks: []types.OrderBook{book}}.OrderBook("connection-0")
+	require.True(t, ok)
+	require.Equal(t, "osmo1orderbook", contract)
+}
+
+func TestLimitOrderStatusQuery(t *testing.T) {
+	msg := types.NewLimitOrderStatusQuery("osmo1ica", "osmo1orderbook", 7)
+	require.Equal(t, types.WasmSmartContractStatePath, msg.Requests[0].Path)
+
+	fields := map[protowire.Number][]byte{}
+	data := msg.Requests[0].Data
+	for len(data) > 0 {
+		num, _, n := protowire.ConsumeTag(data)
+		require.Positive(t, n)
+		v, m := protowire.ConsumeBytes(data[n:])
+		require.Positive(t, m)
+		fields[num] = v
+		data = data[n+m:]
+	}
+	require.Equal(t, "osmo1orderbook", string(fields[1]))
+
+	var query map[string]map[string]string
+	require.NoError(t, json.Unmarshal(fields[2], &query))
+	require.Equal(t, map[string]string{"owner": "osmo1ica", "client_order_id": "7"}, query["order_status"])
+
+	_, err := types.LimitOrderStatusFromAck([]byte{0xff})
+	require.Error(t, err)
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LimitOrderStatus is the lifecycle state of a limit order
+type LimitOrderStatus int32
+
+const (
+	LimitOrderStatusOpen      LimitOrderStatus = 0
+	LimitOrderStatusFilled    LimitOrderStatus = 1
+	LimitOrderStatusCancelled LimitOrderStatus = 2
+	LimitOrderStatusExpired   LimitOrderStatus = 3
+)
+
+// String returns the status name
+func (s LimitOrderStatus) String() string {
+	switch s {
+	case LimitOrderStatusOpen:
+		return "open"
+	case LimitOrderStatusFilled:
+		return "filled"
+	case LimitOrderStatusCancelled:
+		return "cancelled"
+	case LimitOrderStatusExpired:
+		return "expired"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
+// ParseLimitOrderStatus returns the status with the given name
+func ParseLimitOrderStatus(name string) (LimitOrderStatus, error) {
+	for _, s := range []LimitOrderStatus{
+		LimitOrderStatusOpen,
+		LimitOrderStatusFilled,
+		LimitOrderStatusCancelled,
+		LimitOrderStatusExpired,
+	} {
+		if s.String() == name {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown order status %q", name)
+}
+
+// LimitOrder is an order placed on a remote chain's order book through the
+// DID's interchain account
+type LimitOrder struct {
+	Id            uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Did           string   `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	ConnectionId  string   `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	TokenIn       sdk.Coin `protobuf:"bytes,4,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	TokenOutDenom string   `protobuf:"bytes,5,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	// Price is the limit price in units of TokenOutDenom per unit of TokenIn
+	Price string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// Expiration is the unix time after which the order expires; zero means good-til-cancelled
+	Expiration    int64            `protobuf:"varint,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Status        LimitOrderStatus `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Sequence      uint64           `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	CreatedHeight int64            `protobuf:"varint,10,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	UpdatedHeight int64            `protobuf:"varint,11,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// CancelSequence is the ICA packet sequence of an unacknowledged cancellation
+	CancelSequence uint64 `protobuf:"varint,12,opt,name=cancel_sequence,json=cancelSequence,proto3" json:"cancel_sequence,omitempty"`
+	// FilledAmount is the amount of TokenOutDenom the order book reported as received
+	FilledAmount string `protobuf:"bytes,13,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	// StatusSequence is the ICA packet sequence of an unacknowledged status query
+	StatusSequence uint64 `protobuf:"varint,14,opt,name=status_sequence,json=statusSequence,proto3" json:"status_sequence,omitempty"`
+	// CheckedHeight is the height of the last order book report on the order
+	CheckedHeight int64 `protobuf:"varint,15,opt,name=checked_height,json=checkedHeight,proto3" json:"checked_height,omitempty"`
+	// Service is the audience of the UCAN the order was placed with; it is
+	// named in the order_filled and order_expired events so the service can
+	// be called back
+	Service string `protobuf:"bytes,16,opt,name=service,proto3" json:"service,omitempty"`
+	// TxHash is the hash of the transaction that placed the order
+	TxHash string `protobuf:"bytes,17,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (LimitOrder) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *LimitOrder) Reset() {
+	*m = LimitOrder{}
+}
+
+// String implements proto.Message
+func (m LimitOrder) String() string {
+	return fmt.Sprintf("order#%d %s -> %s @ %s (%s)", m.Id, m.TokenIn, m.TokenOutDenom, m.Price, m.Status)
+}
+
+// Validate performs stateless validation of a new order
+func (m LimitOrder) Validate() error {
+	if m.Did == "" {
+		return ErrInvalidDID
+	}
+	if m.ConnectionId == "" {
+		return ErrInvalidConnectionID
+	}
+	if !m.TokenIn.IsValid() || m.TokenIn.IsZero() {
+		return fmt.Errorf("%w: invalid token in %s", ErrInvalidOrderParams, m.TokenIn)
+	}
+	if err := sdk.ValidateDenom(m.TokenOutDenom); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidOrderParams, err)
+	}
+	if m.TokenIn.Denom == m.TokenOutDenom {
+		return fmt.Errorf("%w: cannot create order with same token", ErrInvalidOrderParams)
+	}
+	price, err := math.LegacyNewDecFromStr(m.Price)
+	if err != nil || !price.IsPositive() {
+		return fmt.Errorf("%w: price must be positive", ErrInvalidOrderParams)
+	}
+	if m.Expiration < 0 {
+		return fmt.Errorf("%w: negative expiration", ErrInvalidOrderParams)
+	}
+	return nil
+}
+
+// IsOpen reports whether the order can still be filled or cancelled
+func (m LimitOrder) IsOpen() bool {
+	return m.Status == LimitOrderStatusOpen
+}
+
+// Filled returns the amount of TokenOutDenom received so far
+func (m LimitOrder) Filled() math.Int {
+	filled, ok := math.NewIntFromString(m.FilledAmount)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return filled
+}
+
+// IsCancellable reports whether the order is open with no cancellation in flight
+func (m LimitOrder) IsCancellable() bool {
+	return m.IsOpen() && m.CancelSequence == 0
+}
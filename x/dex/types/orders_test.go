@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestLimitOrderValidate(t *testing.T) {
+	order := types.LimitOrder{
+		Did:           "did:sonr:alice",
+		ConnectionId:  "connection-0",
+		TokenIn:       sdk.NewInt64Coin("uatom", 1000),
+		TokenOutDenom: "uosmo",
+		Price:         "1.5",
+	}
+	require.NoError(t, order.Validate())
+	require.True(t, order.IsOpen())
+
+	order.Price = "0"
+	require.ErrorIs(t, order.Validate(), types.ErrInvalidOrderParams)
+
+	order.Price = "1.5"
+	order.TokenOutDenom = "uatom"
+	require.ErrorIs(t, order.Validate(), types.ErrInvalidOrderParams)
+
+	order.TokenOutDenom = "uosmo"
+	order.Expiration = -1
+	require.ErrorIs(t, order.Validate(), types.ErrInvalidOrderParams)
+}
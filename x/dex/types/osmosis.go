@@ -0,0 +1,353 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// OsmosisBaseDenom is the output denom of intermediate route hops that do not
+// name one. Most multi-hop routes on Osmosis pass through OSMO.
+const OsmosisBaseDenom = "uosmo"
+
+// SwapAmountInRoute is one hop of an Osmosis poolmanager swap route
+type SwapAmountInRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+// ParseSwapRoute parses a swap route of comma separated hops of the form
+// "pool:<id>" or "pool:<id>:<denom>", e.g. "pool:1,pool:42". A hop's denom is
+// the token it outputs; it may be left out and is then filled in by
+// ResolveSwapRoute.
+func ParseSwapRoute(route string) ([]SwapAmountInRoute, error) {
+	if strings.TrimSpace(route) == "" {
+		return nil, errorsmod.Wrap(ErrInvalidSwapParams, "swap route requires at least one pool")
+	}
+
+	var hops []SwapAmountInRoute
+	for _, part := range strings.Split(route, ",") {
+		fields := strings.SplitN(strings.TrimSpace(part), ":", 3)
+		if len(fields) < 2 || fields[0] != "pool" {
+			return nil, errorsmod.Wrapf(ErrInvalidSwapParams, "invalid route hop %q, want pool:<id>", part)
+		}
+		id, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil || id == 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidSwapParams, "invalid pool id in route hop %q", part)
+		}
+
+		hop := SwapAmountInRoute{PoolId: id}
+		if len(fields) == 3 {
+			if err := sdk.ValidateDenom(fields[2]); err != nil {
+				return nil, errorsmod.Wrapf(ErrInvalidSwapParams, "route hop %q: %s", part, err)
+			}
+			hop.TokenOutDenom = fields[2]
+		}
+		hops = append(hops, hop)
+	}
+	return hops, nil
+}
+
+// ResolveSwapRoute fills in the output denom of each hop: the last hop outputs
+// tokenOutDenom and intermediate hops without a denom output OsmosisBaseDenom.
+// The hops are copied, not modified.
+func ResolveSwapRoute(hops []SwapAmountInRoute, tokenInDenom, tokenOutDenom string) ([]SwapAmountInRoute, error) {
+	if len(hops) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidSwapParams, "swap route requires at least one pool")
+	}
+
+	resolved := make([]SwapAmountInRoute, len(hops))
+	denomIn := tokenInDenom
+	for i, hop := range hops {
+		last := i == len(hops)-1
+		switch {
+		case last && hop.TokenOutDenom != "" && hop.TokenOutDenom != tokenOutDenom:
+			return nil, errorsmod.Wrapf(
+				ErrInvalidSwapParams,
+				"route ends in %s, not %s", hop.TokenOutDenom, tokenOutDenom,
+			)
+		case last:
+			hop.TokenOutDenom = tokenOutDenom
+		case hop.TokenOutDenom == "":
+			hop.TokenOutDenom = OsmosisBaseDenom
+		}
+		if hop.TokenOutDenom == denomIn {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidSwapParams,
+				"route hop through pool %d swaps %s for itself", hop.PoolId, denomIn,
+			)
+		}
+		denomIn = hop.TokenOutDenom
+		resolved[i] = hop
+	}
+	return resolved, nil
+}
+
+// OsmosisMsgSwapExactAmountIn is the Osmosis poolmanager MsgSwapExactAmountIn,
+// encoded with its Osmosis type URL so it can be sent to the host chain over
+// ICA without importing the Osmosis modules.
+type OsmosisMsgSwapExactAmountIn struct {
+	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Routes            []SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenIn           sdk.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	TokenOutMinAmount math.Int            `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3" json:"token_out_min_amount"`
+}
+
+// ProtoMessage implements proto.Message
+func (*OsmosisMsgSwapExactAmountIn) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *OsmosisMsgSwapExactAmountIn) Reset() {
+	*m = OsmosisMsgSwapExactAmountIn{}
+}
+
+// String implements proto.Message
+func (m *OsmosisMsgSwapExactAmountIn) String() string {
+	return fmt.Sprintf("swap %s for min %s via %v", m.TokenIn, m.TokenOutMinAmount, m.Routes)
+}
+
+// XXX_MessageName returns the Osmosis message name, which sets the type URL
+// of the message when it is packed into an Any
+func (*OsmosisMsgSwapExactAmountIn) XXX_MessageName() string {
+	return "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn"
+}
+
+// Marshal encodes the message in the Osmosis wire format
+func (m *OsmosisMsgSwapExactAmountIn) Marshal() ([]byte, error) {
+	var b []byte
+	if m.Sender != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, m.Sender)
+	}
+	for _, hop := range m.Routes {
+		var r []byte
+		if hop.PoolId != 0 {
+			r = protowire.AppendTag(r, 1, protowire.VarintType)
+			r = protowire.AppendVarint(r, hop.PoolId)
+		}
+		if hop.TokenOutDenom != "" {
+			r = protowire.AppendTag(r, 2, protowire.BytesType)
+			r = protowire.AppendString(r, hop.TokenOutDenom)
+		}
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, r)
+	}
+
+	coin, err := m.TokenIn.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, coin)
+
+	if !m.TokenOutMinAmount.IsNil() {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, m.TokenOutMinAmount.String())
+	}
+	return b, nil
+}
+
+// Unmarshal decodes a message in the Osmosis wire format
+func (m *OsmosisMsgSwapExactAmountIn) Unmarshal(bz []byte) error {
+	m.Reset()
+	return consumeFields(bz, func(num protowire.Number, v []byte) error {
+		switch num {
+		case 1:
+			m.Sender = string(v)
+		case 2:
+			var hop SwapAmountInRoute
+			err := consumeFields(v, func(num protowire.Number, v []byte) error {
+				switch num {
+				case 1:
+					id, n := protowire.ConsumeVarint(v)
+					if n < 0 {
+						return protowire.ParseError(n)
+					}
+					hop.PoolId = id
+				case 2:
+					hop.TokenOutDenom = string(v)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			m.Routes = append(m.Routes, hop)
+		case 3:
+			return m.TokenIn.Unmarshal(v)
+		case 4:
+			amount, ok := math.NewIntFromString(string(v))
+			if !ok {
+				return fmt.Errorf("invalid token out min amount %q", v)
+			}
+			m.TokenOutMinAmount = amount
+		}
+		return nil
+	})
+}
+
+// consumeFields calls fn with the number and raw value of each field in bz.
+// Varint values are passed still encoded; length-delimited values are passed
+// without their length prefix.
+func consumeFields(bz []byte, fn func(num protowire.Number, v []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		v := bz[:n]
+		if typ == protowire.BytesType {
+			v, _ = protowire.ConsumeBytes(v)
+		}
+		if err := fn(num, v); err != nil {
+			return err
+		}
+		bz = bz[n:]
+	}
+	return nil
+}
+
+// OsmosisMsgJoinPool is the Osmosis gamm MsgJoinPool: it mints exactly
+// ShareOutAmount LP shares of a pool, paying at most TokenInMaxs
+type OsmosisMsgJoinPool struct {
+	Sender         string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolId         uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ShareOutAmount math.Int  `protobuf:"bytes,3,opt,name=share_out_amount,json=shareOutAmount,proto3" json:"share_out_amount"`
+	TokenInMaxs    sdk.Coins `protobuf:"bytes,4,rep,name=token_in_maxs,json=tokenInMaxs,proto3" json:"token_in_maxs"`
+}
+
+// ProtoMessage implements proto.Message
+func (*OsmosisMsgJoinPool) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *OsmosisMsgJoinPool) Reset() {
+	*m = OsmosisMsgJoinPool{}
+}
+
+// String implements proto.Message
+func (m *OsmosisMsgJoinPool) String() string {
+	return fmt.Sprintf("join pool %d for %s shares paying at most %s", m.PoolId, m.ShareOutAmount, m.TokenInMaxs)
+}
+
+// XXX_MessageName returns the Osmosis message name, which sets the type URL
+// of the message when it is packed into an Any
+func (*OsmosisMsgJoinPool) XXX_MessageName() string {
+	return "osmosis.gamm.v1beta1.MsgJoinPool"
+}
+
+// Marshal encodes the message in the Osmosis wire format
+func (m *OsmosisMsgJoinPool) Marshal() ([]byte, error) {
+	return marshalPoolMsg(m.Sender, m.PoolId, m.ShareOutAmount, m.TokenInMaxs)
+}
+
+// Unmarshal decodes a message in the Osmosis wire format
+func (m *OsmosisMsgJoinPool) Unmarshal(bz []byte) error {
+	m.Reset()
+	return unmarshalPoolMsg(bz, &m.Sender, &m.PoolId, &m.ShareOutAmount, &m.TokenInMaxs)
+}
+
+// OsmosisMsgExitPool is the Osmosis gamm MsgExitPool: it burns
+// ShareInAmount LP shares of a pool for at least TokenOutMins
+type OsmosisMsgExitPool struct {
+	Sender        string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolId        uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ShareInAmount math.Int  `protobuf:"bytes,3,opt,name=share_in_amount,json=shareInAmount,proto3" json:"share_in_amount"`
+	TokenOutMins  sdk.Coins `protobuf:"bytes,4,rep,name=token_out_mins,json=tokenOutMins,proto3" json:"token_out_mins"`
+}
+
+// ProtoMessage implements proto.Message
+func (*OsmosisMsgExitPool) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *OsmosisMsgExitPool) Reset() {
+	*m = OsmosisMsgExitPool{}
+}
+
+// String implements proto.Message
+func (m *OsmosisMsgExitPool) String() string {
+	return fmt.Sprintf("exit pool %d with %s shares for at least %s", m.PoolId, m.ShareInAmount, m.TokenOutMins)
+}
+
+// XXX_MessageName returns the Osmosis message name, which sets the type URL
+// of the message when it is packed into an Any
+func (*OsmosisMsgExitPool) XXX_MessageName() string {
+	return "osmosis.gamm.v1beta1.MsgExitPool"
+}
+
+// Marshal encodes the message in the Osmosis wire format
+func (m *OsmosisMsgExitPool) Marshal() ([]byte, error) {
+	return marshalPoolMsg(m.Sender, m.PoolId, m.ShareInAmount, m.TokenOutMins)
+}
+
+// Unmarshal decodes a message in the Osmosis wire format
+func (m *OsmosisMsgExitPool) Unmarshal(bz []byte) error {
+	m.Reset()
+	return unmarshalPoolMsg(bz, &m.Sender, &m.PoolId, &m.ShareInAmount, &m.TokenOutMins)
+}
+
+// marshalPoolMsg encodes the layout MsgJoinPool and MsgExitPool share:
+// sender, pool ID, share amount and a list of coins
+func marshalPoolMsg(sender string, poolID uint64, shares math.Int, coins sdk.Coins) ([]byte, error) {
+	var b []byte
+	if sender != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, sender)
+	}
+	if poolID != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, poolID)
+	}
+	if !shares.IsNil() {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, shares.String())
+	}
+	for _, coin := range coins {
+		bz, err := coin.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendBytes(b, bz)
+	}
+	return b, nil
+}
+
+// unmarshalPoolMsg decodes the layout written by marshalPoolMsg
+func unmarshalPoolMsg(bz []byte, sender *string, poolID *uint64, shares *math.Int, coins *sdk.Coins) error {
+	return consumeFields(bz, func(num protowire.Number, v []byte) error {
+		switch num {
+		case 1:
+			*sender = string(v)
+		case 2:
+			id, n := protowire.ConsumeVarint(v)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			*poolID = id
+		case 3:
+			amount, ok := math.NewIntFromString(string(v))
+			if !ok {
+				return fmt.Errorf("invalid share amount %q", v)
+			}
+			*shares = amount
+		case 4:
+			var coin sdk.Coin
+			if err := coin.Unmarshal(v); err != nil {
+				return err
+			}
+			*coins = append(*coins, coin)
+		}
+		return nil
+	})
+}
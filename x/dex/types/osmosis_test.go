@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestParseSwapRoute(t *testing.T) {
+	hops, err := types.ParseSwapRoute("pool:1, pool:42:ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2,pool:7")
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapAmountInRoute{
+		{PoolId: 1},
+		{PoolId: 42, TokenOutDenom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+		{PoolId: 7},
+	}, hops)
+
+	for _, route := range []string{"", "1", "pool:", "pool:0", "pool:x", "pool:1,", "lp:1", "pool:1:!"} {
+		_, err := types.ParseSwapRoute(route)
+		require.ErrorIs(t, err, types.ErrInvalidSwapParams, route)
+	}
+}
+
+func TestResolveSwapRoute(t *testing.T) {
+	hops, err := types.ParseSwapRoute("pool:1,pool:42")
+	require.NoError(t, err)
+
+	route, err := types.ResolveSwapRoute(hops, "uatom", "ujuno")
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: types.OsmosisBaseDenom},
+		{PoolId: 42, TokenOutDenom: "ujuno"},
+	}, route)
+	require.Empty(t, hops[0].TokenOutDenom, "the parsed hops are not modified")
+
+	// An intermediate hop cannot default to the denom it swaps from
+	_, err = types.ResolveSwapRoute(hops, "uosmo", "ujuno")
+	require.ErrorIs(t, err, types.ErrInvalidSwapParams)
+
+	hops[1].TokenOutDenom = "uakt"
+	_, err = types.ResolveSwapRoute(hops, "uatom", "ujuno")
+	require.ErrorIs(t, err, types.ErrInvalidSwapParams)
+}
+
+func TestOsmosisMsgSwapExactAmountIn(t *testing.T) {
+	msg := &types.OsmosisMsgSwapExactAmountIn{
+		Sender: "osmo1sender",
+		Routes: []types.SwapAmountInRoute{
+			{PoolId: 1, TokenOutDenom: "uosmo"},
+			{PoolId: 42, TokenOutDenom: "ujuno"},
+		},
+		TokenIn:           sdk.NewInt64Coin("uatom", 1000),
+		TokenOutMinAmount: math.NewInt(900),
+	}
+
+	packed, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	require.Equal(t, "/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn", packed.TypeUrl)
+
+	var decoded types.OsmosisMsgSwapExactAmountIn
+	require.NoError(t, decoded.Unmarshal(packed.Value))
+	require.Equal(t, msg.Sender, decoded.Sender)
+	require.Equal(t, msg.Routes, decoded.Routes)
+	require.Equal(t, msg.TokenIn, decoded.TokenIn)
+	require.Equal(t, "900", decoded.TokenOutMinAmount.String())
+}
+
+func TestOsmosisPoolMsgs(t *testing.T) {
+	join := &types.OsmosisMsgJoinPool{
+		Sender:         "osmo1sender",
+		PoolId:         1,
+		ShareOutAmount: math.NewInt(5000),
+		TokenInMaxs:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 2000)),
+	}
+	packed, err := codectypes.NewAnyWithValue(join)
+	require.NoError(t, err)
+	require.Equal(t, "/osmosis.gamm.v1beta1.MsgJoinPool", packed.TypeUrl)
+
+	var decodedJoin types.OsmosisMsgJoinPool
+	require.NoError(t, decodedJoin.Unmarshal(packed.Value))
+	require.Equal(t, join.Sender, decodedJoin.Sender)
+	require.Equal(t, join.PoolId, decodedJoin.PoolId)
+	require.Equal(t, "5000", decodedJoin.ShareOutAmount.String())
+	require.Equal(t, join.TokenInMaxs, decodedJoin.TokenInMaxs)
+
+	exit := &types.OsmosisMsgExitPool{Sender: "osmo1sender", PoolId: 1, ShareInAmount: math.NewInt(500)}
+	packed, err = codectypes.NewAnyWithValue(exit)
+	require.NoError(t, err)
+	require.Equal(t, "/osmosis.gamm.v1beta1.MsgExitPool", packed.TypeUrl)
+
+	var decodedExit types.OsmosisMsgExitPool
+	require.NoError(t, decodedExit.Unmarshal(packed.Value))
+	require.Equal(t, "500", decodedExit.ShareInAmount.String())
+	require.Empty(t, decodedExit.TokenOutMins)
+}
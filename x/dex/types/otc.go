@@ -0,0 +1,143 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OTCOfferStatus is the lifecycle state of an OTC offer
+type OTCOfferStatus int32
+
+const (
+	// OTCOfferStatusOpen means the maker's side is escrowed and the offer waits for a taker
+	OTCOfferStatusOpen OTCOfferStatus = 0
+	// OTCOfferStatusFunded means both sides are escrowed and wait for confirmation
+	OTCOfferStatusFunded OTCOfferStatus = 1
+	// OTCOfferStatusCompleted means the escrowed assets were exchanged
+	OTCOfferStatusCompleted OTCOfferStatus = 2
+	// OTCOfferStatusCancelled means the maker withdrew the offer before it was taken
+	OTCOfferStatusCancelled OTCOfferStatus = 3
+	// OTCOfferStatusExpired means the offer timed out and the escrow was refunded
+	OTCOfferStatusExpired OTCOfferStatus = 4
+	// OTCOfferStatusRefunded means the arbiter refunded both parties
+	OTCOfferStatusRefunded OTCOfferStatus = 5
+)
+
+// String returns the status name
+func (s OTCOfferStatus) String() string {
+	switch s {
+	case OTCOfferStatusOpen:
+		return "open"
+	case OTCOfferStatusFunded:
+		return "funded"
+	case OTCOfferStatusCompleted:
+		return "completed"
+	case OTCOfferStatusCancelled:
+		return "cancelled"
+	case OTCOfferStatusExpired:
+		return "expired"
+	case OTCOfferStatusRefunded:
+		return "refunded"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
+// ParseOTCOfferStatus returns the status with the given name
+func ParseOTCOfferStatus(name string) (OTCOfferStatus, error) {
+	for _, s := range []OTCOfferStatus{
+		OTCOfferStatusOpen,
+		OTCOfferStatusFunded,
+		OTCOfferStatusCompleted,
+		OTCOfferStatusCancelled,
+		OTCOfferStatusExpired,
+		OTCOfferStatusRefunded,
+	} {
+		if s.String() == name {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown OTC offer status %q", name)
+}
+
+// OTCOffer is a peer-to-peer exchange between two DIDs settled from escrow
+// held by the module account
+type OTCOffer struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MakerDid string `protobuf:"bytes,2,opt,name=maker_did,json=makerDid,proto3" json:"maker_did,omitempty"`
+	// MakerAddress is the account that escrowed the offer and is paid the ask
+	MakerAddress string `protobuf:"bytes,3,opt,name=maker_address,json=makerAddress,proto3" json:"maker_address,omitempty"`
+	// TakerDid restricts who may accept the offer; empty means any DID
+	TakerDid string `protobuf:"bytes,4,opt,name=taker_did,json=takerDid,proto3" json:"taker_did,omitempty"`
+	// TakerAddress is the account that escrowed the ask and is paid the offer
+	TakerAddress string `protobuf:"bytes,5,opt,name=taker_address,json=takerAddress,proto3" json:"taker_address,omitempty"`
+	// Offer is what the maker gives
+	Offer sdk.Coins `protobuf:"bytes,6,rep,name=offer,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"offer"`
+	// Ask is what the maker wants in return
+	Ask sdk.Coins `protobuf:"bytes,7,rep,name=ask,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ask"`
+	// ArbiterDid may settle or refund a funded offer; empty means no arbiter
+	ArbiterDid string         `protobuf:"bytes,8,opt,name=arbiter_did,json=arbiterDid,proto3" json:"arbiter_did,omitempty"`
+	Status     OTCOfferStatus `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Expiration is the unix time at which an unsettled offer is refunded
+	Expiration     int64 `protobuf:"varint,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	MakerConfirmed bool  `protobuf:"varint,11,opt,name=maker_confirmed,json=makerConfirmed,proto3" json:"maker_confirmed,omitempty"`
+	TakerConfirmed bool  `protobuf:"varint,12,opt,name=taker_confirmed,json=takerConfirmed,proto3" json:"taker_confirmed,omitempty"`
+	CreatedHeight  int64 `protobuf:"varint,13,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	UpdatedHeight  int64 `protobuf:"varint,14,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (OTCOffer) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *OTCOffer) Reset() {
+	*m = OTCOffer{}
+}
+
+// String implements proto.Message
+func (m OTCOffer) String() string {
+	return fmt.Sprintf("otc#%d %s gives %s for %s (%s)", m.Id, m.MakerDid, m.Offer, m.Ask, m.Status)
+}
+
+// Validate performs stateless validation of a new offer
+func (m OTCOffer) Validate() error {
+	if m.MakerDid == "" {
+		return ErrInvalidDID
+	}
+	if _, err := sdk.AccAddressFromBech32(m.MakerAddress); err != nil {
+		return fmt.Errorf("%w: invalid maker address: %s", ErrInvalidOTCOffer, err)
+	}
+	if !m.Offer.IsValid() || m.Offer.IsZero() {
+		return fmt.Errorf("%w: invalid offer %s", ErrInvalidOTCOffer, m.Offer)
+	}
+	if !m.Ask.IsValid() || m.Ask.IsZero() {
+		return fmt.Errorf("%w: invalid ask %s", ErrInvalidOTCOffer, m.Ask)
+	}
+	if m.TakerDid == m.MakerDid {
+		return fmt.Errorf("%w: maker cannot trade with itself", ErrInvalidOTCOffer)
+	}
+	if m.ArbiterDid != "" && (m.ArbiterDid == m.MakerDid || m.ArbiterDid == m.TakerDid) {
+		return fmt.Errorf("%w: arbiter must not be a party to the trade", ErrInvalidOTCOffer)
+	}
+	if m.Expiration <= 0 {
+		return fmt.Errorf("%w: expiration is required", ErrInvalidOTCOffer)
+	}
+	return nil
+}
+
+// IsSettled reports whether the escrow of the offer has been released
+func (m OTCOffer) IsSettled() bool {
+	return m.Status != OTCOfferStatusOpen && m.Status != OTCOfferStatusFunded
+}
+
+// Parties returns the DIDs involved in the offer, for indexing
+func (m OTCOffer) Parties() []string {
+	parties := []string{m.MakerDid}
+	for _, did := range []string{m.TakerDid, m.ArbiterDid} {
+		if did != "" {
+			parties = append(parties, did)
+		}
+	}
+	return parties
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate performs basic validation of the module parameters
+func (m Params) Validate() error {
+	connections := make(map[string]bool, len(m.AllowedConnections))
+	for _, connectionID := range m.AllowedConnections {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return fmt.Errorf("invalid allowed connection %q: %w", connectionID, err)
+		}
+		if connections[connectionID] {
+			return fmt.Errorf("duplicate allowed connection %s", connectionID)
+		}
+		connections[connectionID] = true
+	}
+
+	if _, _, err := m.MaxDailyVolumeCap(); err != nil {
+		return err
+	}
+	if err := m.Fees.Validate(); err != nil {
+		return err
+	}
+
+	routes := make(map[string]bool, len(m.NobleRoutes))
+	for _, route := range m.NobleRoutes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if routes[route.ConnectionId] {
+			return fmt.Errorf("duplicate noble route for %s", route.ConnectionId)
+		}
+		routes[route.ConnectionId] = true
+	}
+	if err := m.Routing.Validate(); err != nil {
+		return err
+	}
+	if err := m.Pricing.Validate(); err != nil {
+		return err
+	}
+	if err := m.OrderMonitor.Validate(); err != nil {
+		return err
+	}
+	if err := m.Screening.Validate(); err != nil {
+		return err
+	}
+	return m.Arbitrage.Validate()
+}
+
+// IsConnectionAllowed reports whether DEX operations may use a connection.
+// An empty allow list permits every connection.
+func (m Params) IsConnectionAllowed(connectionID string) bool {
+	if len(m.AllowedConnections) == 0 {
+		return true
+	}
+	for _, allowed := range m.AllowedConnections {
+		if allowed == connectionID {
+			return true
+		}
+	}
+	return false
+}
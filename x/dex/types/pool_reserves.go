@@ -0,0 +1,255 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// DefaultReservesTTL is how long pool reserves are used for estimates when
+// the reserves_ttl_seconds param is unset
+const DefaultReservesTTL = time.Minute
+
+// OsmosisTotalPoolLiquidityPath is the host chain query that reads the
+// reserves of a pool. The host must allow it for ICA module queries.
+const OsmosisTotalPoolLiquidityPath = "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity"
+
+// moduleQuerySafeResponseTypeURL is the ICA host's response to a module query
+const moduleQuerySafeResponseTypeURL = "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse"
+
+// ReservesTTL returns how long pool reserves are used for estimates
+func (m PricingParams) ReservesTTL() time.Duration {
+	if m.ReservesTtlSeconds == 0 {
+		return DefaultReservesTTL
+	}
+	return time.Duration(m.ReservesTtlSeconds) * time.Second
+}
+
+// maxUsdExponent bounds usd_exponent to the precision of LegacyDec
+const maxUsdExponent = 18
+
+// Validate performs basic validation of the pricing parameters
+func (m PricingParams) Validate() error {
+	if m.UsdDenom != "" {
+		if err := sdk.ValidateDenom(m.UsdDenom); err != nil {
+			return fmt.Errorf("invalid usd denom: %w", err)
+		}
+	}
+	if m.UsdExponent > maxUsdExponent {
+		return fmt.Errorf("usd exponent %d exceeds %d", m.UsdExponent, maxUsdExponent)
+	}
+	return nil
+}
+
+// PoolReserves is a snapshot of a host chain pool's reserves, read over ICA
+type PoolReserves struct {
+	ConnectionId string    `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PoolId       uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Reserves     sdk.Coins `protobuf:"bytes,3,rep,name=reserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserves"`
+	// HostHeight is the host chain height the reserves were read at
+	HostHeight uint64 `protobuf:"varint,4,opt,name=host_height,json=hostHeight,proto3" json:"host_height,omitempty"`
+	// UpdatedAt is the unix time the reserves were received
+	UpdatedAt int64 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (PoolReserves) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *PoolReserves) Reset() {
+	*m = PoolReserves{}
+}
+
+// String implements proto.Message
+func (m PoolReserves) String() string {
+	return fmt.Sprintf("pool %d on %s: %s", m.PoolId, m.ConnectionId, m.Reserves)
+}
+
+// Fresh reports whether the snapshot may still price swaps at now
+func (m PoolReserves) Fresh(now time.Time, ttl time.Duration) bool {
+	return m.UpdatedAt > 0 && now.Before(time.Unix(m.UpdatedAt, 0).Add(ttl))
+}
+
+// EstimateOut prices tokenIn against the reserves as a constant product pool,
+// before the pool's own swap fee. It returns false when the pool does not
+// hold both denoms.
+func (m PoolReserves) EstimateOut(tokenIn sdk.Coin, tokenOutDenom string) (math.Int, bool) {
+	reserveIn := m.Reserves.AmountOf(tokenIn.Denom)
+	reserveOut := m.Reserves.AmountOf(tokenOutDenom)
+	if !reserveIn.IsPositive() || !reserveOut.IsPositive() {
+		return math.Int{}, false
+	}
+	return reserveOut.Mul(tokenIn.Amount).Quo(reserveIn.Add(tokenIn.Amount)), true
+}
+
+// ICAModuleQuery is a single query in an ICAMsgModuleQuerySafe
+type ICAModuleQuery struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+// ICAMsgModuleQuerySafe is the ICA host's MsgModuleQuerySafe, which runs
+// queries marked module_query_safe on the host chain and returns their
+// responses in the acknowledgement. It is encoded by hand like the Osmosis
+// messages.
+type ICAMsgModuleQuerySafe struct {
+	Signer   string           `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Requests []ICAModuleQuery `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests"`
+}
+
+// NewPoolReservesQuery builds the module query that reads the reserves of an
+// Osmosis pool from an ICA account
+func NewPoolReservesQuery(signer string, poolID uint64) *ICAMsgModuleQuerySafe {
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, poolID)
+	return &ICAMsgModuleQuerySafe{
+		Signer:   signer,
+		Requests: []ICAModuleQuery{{Path: OsmosisTotalPoolLiquidityPath, Data: data}},
+	}
+}
+
+// ProtoMessage implements proto.Message
+func (*ICAMsgModuleQuerySafe) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *ICAMsgModuleQuerySafe) Reset() {
+	*m = ICAMsgModuleQuerySafe{}
+}
+
+// String implements proto.Message
+func (m *ICAMsgModuleQuerySafe) String() string {
+	return fmt.Sprintf("module query by %s: %v", m.Signer, m.Requests)
+}
+
+// XXX_MessageName returns the ICA host message name, which sets the type URL
+// of the message when it is packed into an Any
+func (*ICAMsgModuleQuerySafe) XXX_MessageName() string {
+	return "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe"
+}
+
+// Marshal encodes the message in the ICA host wire format
+func (m *ICAMsgModuleQuerySafe) Marshal() ([]byte, error) {
+	var b []byte
+	if m.Signer != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, m.Signer)
+	}
+	for _, req := range m.Requests {
+		var r []byte
+		if req.Path != "" {
+			r = protowire.AppendTag(r, 1, protowire.BytesType)
+			r = protowire.AppendString(r, req.Path)
+		}
+		if len(req.Data) > 0 {
+			r = protowire.AppendTag(r, 2, protowire.BytesType)
+			r = protowire.AppendBytes(r, req.Data)
+		}
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, r)
+	}
+	return b, nil
+}
+
+// Unmarshal decodes a message in the ICA host wire format
+func (m *ICAMsgModuleQuerySafe) Unmarshal(bz []byte) error {
+	m.Reset()
+	return consumeFields(bz, func(num protowire.Number, v []byte) error {
+		switch num {
+		case 1:
+			m.Signer = string(v)
+		case 2:
+			var req ICAModuleQuery
+			err := consumeFields(v, func(num protowire.Number, v []byte) error {
+				switch num {
+				case 1:
+					req.Path = string(v)
+				case 2:
+					req.Data = append([]byte(nil), v...)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			m.Requests = append(m.Requests, req)
+		}
+		return nil
+	})
+}
+
+// PoolReservesFromAck reads the host height and pool liquidity from the
+// acknowledgement result of a NewPoolReservesQuery packet
+func PoolReservesFromAck(result []byte) (uint64, sdk.Coins, error) {
+	height, responses, err := moduleQueryResponses(result)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// TotalPoolLiquidityResponse holds the pool's coins in field 1
+	var liquidity sdk.Coins
+	err = consumeFields(responses[0], func(num protowire.Number, v []byte) error {
+		if num != 1 {
+			return nil
+		}
+		var coin sdk.Coin
+		if err := coin.Unmarshal(v); err != nil {
+			return err
+		}
+		liquidity = append(liquidity, coin)
+		return nil
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to decode pool liquidity: %w", err)
+	}
+	liquidity = liquidity.Sort()
+	if err := liquidity.Validate(); err != nil {
+		return 0, nil, fmt.Errorf("invalid pool liquidity: %w", err)
+	}
+	return height, liquidity, nil
+}
+
+// moduleQueryResponses reads the host height and the query responses from
+// the acknowledgement result of an ICAMsgModuleQuerySafe packet
+func moduleQueryResponses(result []byte) (uint64, [][]byte, error) {
+	var data sdk.TxMsgData
+	if err := proto.Unmarshal(result, &data); err != nil {
+		return 0, nil, fmt.Errorf("failed to decode acknowledgement result: %w", err)
+	}
+
+	for _, res := range data.MsgResponses {
+		if res == nil || res.TypeUrl != moduleQuerySafeResponseTypeURL {
+			continue
+		}
+
+		var (
+			height    uint64
+			responses [][]byte
+		)
+		err := consumeFields(res.Value, func(num protowire.Number, v []byte) error {
+			switch num {
+			case 1:
+				h, n := protowire.ConsumeVarint(v)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				height = h
+			case 2:
+				responses = append(responses, v)
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to decode module query response: %w", err)
+		}
+		if len(responses) == 0 {
+			return 0, nil, fmt.Errorf("module query returned no responses")
+		}
+		return height, responses, nil
+	}
+	return 0, nil, fmt.Errorf("missing module query response")
+}
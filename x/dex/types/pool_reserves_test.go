@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestPoolReservesEstimate(t *testing.T) {
+	reserves := types.PoolReserves{
+		Reserves:  sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uosmo", 2_000)),
+		UpdatedAt: 1_700_000_000,
+	}
+
+	out, ok := reserves.EstimateOut(sdk.NewInt64Coin("uatom", 1_000), "uosmo")
+	require.True(t, ok)
+	require.Equal(t, math.NewInt(1_000), out, "doubling the input reserve halves the output reserve")
+
+	_, ok = reserves.EstimateOut(sdk.NewInt64Coin("uatom", 1_000), "ujuno")
+	require.False(t, ok)
+
+	updated := time.Unix(reserves.UpdatedAt, 0)
+	require.True(t, reserves.Fresh(updated.Add(59*time.Second), time.Minute))
+	require.False(t, reserves.Fresh(updated.Add(time.Minute), time.Minute))
+	require.False(t, types.PoolReserves{}.Fresh(updated, time.Minute))
+
+	require.Equal(t, types.DefaultReservesTTL, types.PricingParams{}.ReservesTTL())
+	require.Equal(t, 5*time.Second, types.PricingParams{ReservesTtlSeconds: 5}.ReservesTTL())
+}
+
+func TestPoolReservesQuery(t *testing.T) {
+	msg := types.NewPoolReservesQuery("osmo1ica", 7)
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+
+	var decoded types.ICAMsgModuleQuerySafe
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, *msg, decoded)
+	require.Equal(t, types.OsmosisTotalPoolLiquidityPath, decoded.Requests[0].Path)
+	require.Equal(t, []byte{0x08, 0x07}, decoded.Requests[0].Data, "pool_id = 7")
+
+	_, _, err = types.PoolReservesFromAck([]byte{0xff})
+	require.Error(t, err)
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DefaultPriceEpochSeconds is the default length of a price aggregation epoch
+	DefaultPriceEpochSeconds = 60 * 60
+
+	// DefaultPriceRetentionEpochs is the default number of epochs kept per denom (90 days)
+	DefaultPriceRetentionEpochs = 24 * 90
+)
+
+// DefaultPriceHistoryParams returns the default price history parameters
+func DefaultPriceHistoryParams() PriceHistoryParams {
+	return PriceHistoryParams{
+		EpochSeconds:    DefaultPriceEpochSeconds,
+		RetentionEpochs: DefaultPriceRetentionEpochs,
+	}
+}
+
+// Validate performs basic validation of the price history parameters
+func (m PriceHistoryParams) Validate() error {
+	if m.EpochSeconds == 0 {
+		return fmt.Errorf("epoch seconds must be positive")
+	}
+	return nil
+}
+
+// EpochOf returns the epoch number containing the given time
+func (m PriceHistoryParams) EpochOf(t time.Time) uint64 {
+	if t.Unix() <= 0 {
+		return 0
+	}
+	return uint64(t.Unix()) / m.EpochSeconds
+}
+
+// PriceEpoch is the aggregated price of a denom over one epoch. Prices are
+// stored as decimal strings.
+type PriceEpoch struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Epoch   uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Open    string `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+	High    string `protobuf:"bytes,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low     string `protobuf:"bytes,5,opt,name=low,proto3" json:"low,omitempty"`
+	Close   string `protobuf:"bytes,6,opt,name=close,proto3" json:"close,omitempty"`
+	Sum     string `protobuf:"bytes,7,opt,name=sum,proto3" json:"sum,omitempty"`
+	Samples uint64 `protobuf:"varint,8,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (PriceEpoch) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *PriceEpoch) Reset() {
+	*m = PriceEpoch{}
+}
+
+// String implements proto.Message
+func (m PriceEpoch) String() string {
+	return fmt.Sprintf("%s@%d o=%s h=%s l=%s c=%s n=%d", m.Denom, m.Epoch, m.Open, m.High, m.Low, m.Close, m.Samples)
+}
+
+// NewPriceEpoch starts an epoch from its first observed price
+func NewPriceEpoch(denom string, epoch uint64, price math.LegacyDec) PriceEpoch {
+	p := price.String()
+	return PriceEpoch{
+		Denom:   denom,
+		Epoch:   epoch,
+		Open:    p,
+		High:    p,
+		Low:     p,
+		Close:   p,
+		Sum:     p,
+		Samples: 1,
+	}
+}
+
+// Observe folds another price sample into the epoch
+func (m *PriceEpoch) Observe(price math.LegacyDec) error {
+	high, err := math.LegacyNewDecFromStr(m.High)
+	if err != nil {
+		return err
+	}
+	low, err := math.LegacyNewDecFromStr(m.Low)
+	if err != nil {
+		return err
+	}
+	sum, err := math.LegacyNewDecFromStr(m.Sum)
+	if err != nil {
+		return err
+	}
+
+	if price.GT(high) {
+		m.High = price.String()
+	}
+	if price.LT(low) {
+		m.Low = price.String()
+	}
+	m.Close = price.String()
+	m.Sum = sum.Add(price).String()
+	m.Samples++
+	return nil
+}
+
+// Merge folds a later epoch into this one, e.g. when downsampling to a coarser resolution
+func (m *PriceEpoch) Merge(later PriceEpoch) error {
+	high, err := math.LegacyNewDecFromStr(m.High)
+	if err != nil {
+		return err
+	}
+	low, err := math.LegacyNewDecFromStr(m.Low)
+	if err != nil {
+		return err
+	}
+	sum, err := math.LegacyNewDecFromStr(m.Sum)
+	if err != nil {
+		return err
+	}
+	laterHigh, err := math.LegacyNewDecFromStr(later.High)
+	if err != nil {
+		return err
+	}
+	laterLow, err := math.LegacyNewDecFromStr(later.Low)
+	if err != nil {
+		return err
+	}
+	laterSum, err := math.LegacyNewDecFromStr(later.Sum)
+	if err != nil {
+		return err
+	}
+
+	if laterHigh.GT(high) {
+		m.High = later.High
+	}
+	if laterLow.LT(low) {
+		m.Low = later.Low
+	}
+	m.Close = later.Close
+	m.Sum = sum.Add(laterSum).String()
+	m.Samples += later.Samples
+	return nil
+}
+
+// Average returns the mean of all samples in the epoch
+func (m PriceEpoch) Average() (math.LegacyDec, error) {
+	if m.Samples == 0 {
+		return math.LegacyZeroDec(), nil
+	}
+	sum, err := math.LegacyNewDecFromStr(m.Sum)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return sum.QuoInt64(int64(m.Samples)), nil
+}
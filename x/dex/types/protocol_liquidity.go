@@ -0,0 +1,197 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ProtocolAccountOwner owns the interchain accounts holding protocol-owned
+// liquidity. It is not a DID, so it cannot collide with a user's account.
+const ProtocolAccountOwner = "protocol"
+
+// ProtocolLiquidityAccount is the module account holding treasury funds on
+// their way to the protocol account. It is kept apart from the dex module
+// account, which escrows users' OTC offers, so funding can never spend them.
+const ProtocolLiquidityAccount = ModuleName + "_protocol_liquidity"
+
+// MinProtocolLiquidityLock is the shortest timelock governance may put on a
+// protocol liquidity provision
+const MinProtocolLiquidityLock = 7 * 24 * time.Hour
+
+// ProtocolPosition is the liquidity the protocol holds in a host chain pool
+type ProtocolPosition struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PoolId       uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Provided is the total of the assets whose provision was acknowledged
+	Provided sdk.Coins `protobuf:"bytes,3,rep,name=provided,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"provided"`
+	// SharesRemoved is the total of the LP shares whose removal was acknowledged
+	SharesRemoved string `protobuf:"bytes,4,opt,name=shares_removed,json=sharesRemoved,proto3" json:"shares_removed,omitempty"`
+	// UnlockTime is the unix time before which the position cannot be withdrawn
+	UnlockTime int64 `protobuf:"varint,5,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// Pending is the number of unacknowledged provisions and removals
+	Pending       uint32 `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
+	UpdatedHeight int64  `protobuf:"varint,7,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// Shares is the total of the LP shares whose minting was acknowledged
+	Shares string `protobuf:"bytes,8,opt,name=shares,proto3" json:"shares,omitempty"`
+	// RemovingShares is the total of the LP shares in unacknowledged removals
+	RemovingShares string `protobuf:"bytes,9,opt,name=removing_shares,json=removingShares,proto3" json:"removing_shares,omitempty"`
+	// Withdrawn is the total of the assets returned by acknowledged removals
+	Withdrawn sdk.Coins `protobuf:"bytes,10,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+}
+
+// ProtoMessage implements proto.Message
+func (ProtocolPosition) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *ProtocolPosition) Reset() {
+	*m = ProtocolPosition{}
+}
+
+// String implements proto.Message
+func (m ProtocolPosition) String() string {
+	return fmt.Sprintf("protocol position in pool %d on %s: %s", m.PoolId, m.ConnectionId, m.Provided)
+}
+
+// Locked reports whether the position's timelock is still running at now
+func (m ProtocolPosition) Locked(now time.Time) bool {
+	return now.Unix() < m.UnlockTime
+}
+
+// Removed returns the LP shares whose removal was acknowledged
+func (m ProtocolPosition) Removed() math.Int {
+	return parseShares(m.SharesRemoved)
+}
+
+// Minted returns the LP shares whose minting was acknowledged
+func (m ProtocolPosition) Minted() math.Int {
+	return parseShares(m.Shares)
+}
+
+// Removing returns the LP shares in unacknowledged removals
+func (m ProtocolPosition) Removing() math.Int {
+	return parseShares(m.RemovingShares)
+}
+
+// Available returns the LP shares the position still holds that no removal
+// has claimed yet
+func (m ProtocolPosition) Available() math.Int {
+	available := m.Minted().Sub(m.Removed()).Sub(m.Removing())
+	if available.IsNegative() {
+		return math.ZeroInt()
+	}
+	return available
+}
+
+// parseShares reads a stored share amount, treating an unset one as zero
+func parseShares(s string) math.Int {
+	shares, ok := math.NewIntFromString(s)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return shares
+}
+
+// ProtocolLiquidityOp is a protocol liquidity packet awaiting its
+// acknowledgement: either a provision of assets or a removal of shares
+type ProtocolLiquidityOp struct {
+	ConnectionId string    `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PoolId       uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Provide      sdk.Coins `protobuf:"bytes,3,rep,name=provide,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"provide"`
+	RemoveShares string    `protobuf:"bytes,4,opt,name=remove_shares,json=removeShares,proto3" json:"remove_shares,omitempty"`
+	// Shares is the LP share amount a provision asked the pool to mint
+	Shares string `protobuf:"bytes,5,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (ProtocolLiquidityOp) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *ProtocolLiquidityOp) Reset() {
+	*m = ProtocolLiquidityOp{}
+}
+
+// String implements proto.Message
+func (m ProtocolLiquidityOp) String() string {
+	if m.IsRemoval() {
+		return fmt.Sprintf("remove %s shares from pool %d on %s", m.RemoveShares, m.PoolId, m.ConnectionId)
+	}
+	return fmt.Sprintf("provide %s for %s shares to pool %d on %s", m.Provide, m.Shares, m.PoolId, m.ConnectionId)
+}
+
+// IsRemoval reports whether the packet removes liquidity
+func (m ProtocolLiquidityOp) IsRemoval() bool {
+	return m.RemoveShares != ""
+}
+
+// JoinPoolResultFromAck returns the LP shares minted and the assets paid as
+// reported by the MsgJoinPool response in an ICA acknowledgement result
+func JoinPoolResultFromAck(result []byte) (math.Int, sdk.Coins, error) {
+	res, err := msgResponse(result, "/osmosis.gamm.v1beta1.MsgJoinPoolResponse")
+	if err != nil {
+		return math.Int{}, nil, err
+	}
+
+	shares := math.ZeroInt()
+	var paid sdk.Coins
+	err = consumeFields(res, func(num protowire.Number, v []byte) error {
+		switch num {
+		case 1:
+			amount, ok := math.NewIntFromString(string(v))
+			if !ok || amount.IsNegative() {
+				return fmt.Errorf("invalid share out amount %q", v)
+			}
+			shares = amount
+		case 2:
+			var coin sdk.Coin
+			if err := coin.Unmarshal(v); err != nil {
+				return err
+			}
+			paid = paid.Add(coin)
+		}
+		return nil
+	})
+	return shares, paid, err
+}
+
+// ExitPoolResultFromAck returns the assets returned as reported by the
+// MsgExitPool response in an ICA acknowledgement result
+func ExitPoolResultFromAck(result []byte) (sdk.Coins, error) {
+	res, err := msgResponse(result, "/osmosis.gamm.v1beta1.MsgExitPoolResponse")
+	if err != nil {
+		return nil, err
+	}
+
+	var out sdk.Coins
+	err = consumeFields(res, func(num protowire.Number, v []byte) error {
+		if num != 1 {
+			return nil
+		}
+		var coin sdk.Coin
+		if err := coin.Unmarshal(v); err != nil {
+			return err
+		}
+		out = out.Add(coin)
+		return nil
+	})
+	return out, err
+}
+
+// msgResponse returns the value of the first message response of typeURL
+// in an ICA acknowledgement result
+func msgResponse(result []byte, typeURL string) ([]byte, error) {
+	var data sdk.TxMsgData
+	if err := proto.Unmarshal(result, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode acknowledgement result: %w", err)
+	}
+	for _, res := range data.MsgResponses {
+		if res != nil && res.TypeUrl == typeURL {
+			return res.Value, nil
+		}
+	}
+	return nil, fmt.Errorf("acknowledgement has no %s", typeURL)
+}
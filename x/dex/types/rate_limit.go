@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DIDOpCounter counts the swaps and orders a DID submitted on its last active
+// day, and the height of the latest one
+type DIDOpCounter struct {
+	// Day is the UTC day, as returned by OpsDay, that Ops counts
+	Day        int64  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Ops        uint32 `protobuf:"varint,2,opt,name=ops,proto3" json:"ops,omitempty"`
+	LastHeight int64  `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (DIDOpCounter) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *DIDOpCounter) Reset() {
+	*m = DIDOpCounter{}
+}
+
+// String implements proto.Message
+func (m DIDOpCounter) String() string {
+	return fmt.Sprintf("day=%d ops=%d last_height=%d", m.Day, m.Ops, m.LastHeight)
+}
+
+// OpsDay returns the UTC day number that daily operation limits count in
+func OpsDay(t time.Time) int64 {
+	return t.Unix() / int64(24*time.Hour/time.Second)
+}
+
+// DIDDailyVolume is the USD value a DID swapped on its last active day
+type DIDDailyVolume struct {
+	// Day is the UTC day, as returned by OpsDay, that Volume counts
+	Day int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	// Volume is a decimal string
+	Volume string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (DIDDailyVolume) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *DIDDailyVolume) Reset() {
+	*m = DIDDailyVolume{}
+}
+
+// String implements proto.Message
+func (m DIDDailyVolume) String() string {
+	return fmt.Sprintf("day=%d volume=%s", m.Day, m.Volume)
+}
+
+// MaxDailyVolumeCap parses max_daily_volume. It reports false when the param
+// is empty or zero, in which case daily volume is not capped.
+func (m Params) MaxDailyVolumeCap() (math.LegacyDec, bool, error) {
+	if m.MaxDailyVolume == "" {
+		return math.LegacyDec{}, false, nil
+	}
+	limit, err := math.LegacyNewDecFromStr(m.MaxDailyVolume)
+	if err != nil {
+		return math.LegacyDec{}, false, fmt.Errorf("invalid max daily volume %q: %w", m.MaxDailyVolume, err)
+	}
+	if limit.IsNegative() {
+		return math.LegacyDec{}, false, fmt.Errorf("max daily volume cannot be negative")
+	}
+	return limit, limit.IsPositive(), nil
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxHops is the most hops a selected route takes when the
+	// max_hops param is unset
+	DefaultMaxHops = 3
+
+	// MaxRouteHops bounds max_hops, keeping route search small
+	MaxRouteHops = 4
+)
+
+// Validate performs basic validation of the routing params
+func (m RoutingParams) Validate() error {
+	if m.MaxHops > MaxRouteHops {
+		return fmt.Errorf("routing max hops cannot exceed %d", MaxRouteHops)
+	}
+
+	seen := make(map[string]bool, len(m.Pools))
+	for _, pool := range m.Pools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", pool.ConnectionId, pool.PoolId)
+		if seen[key] {
+			return fmt.Errorf("duplicate swap pool %d on %s", pool.PoolId, pool.ConnectionId)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// MaxHopsOrDefault returns the most hops a selected route may take
+func (m RoutingParams) MaxHopsOrDefault() int {
+	if m.MaxHops == 0 {
+		return DefaultMaxHops
+	}
+	return int(m.MaxHops)
+}
+
+// PoolsFor returns the pools swaps on a connection may route through
+func (m RoutingParams) PoolsFor(connectionID string) []SwapPool {
+	var pools []SwapPool
+	for _, pool := range m.Pools {
+		if pool.ConnectionId == connectionID {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
+}
+
+// Validate performs basic validation of a swap pool
+func (m SwapPool) Validate() error {
+	if m.ConnectionId == "" {
+		return fmt.Errorf("swap pool %d: connection ID cannot be empty", m.PoolId)
+	}
+	if m.PoolId == 0 {
+		return fmt.Errorf("swap pool on %s: pool ID cannot be zero", m.ConnectionId)
+	}
+	if len(m.Denoms) < 2 {
+		return fmt.Errorf("swap pool %d: at least two denoms are required", m.PoolId)
+	}
+	seen := make(map[string]bool, len(m.Denoms))
+	for _, denom := range m.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("swap pool %d: %w", m.PoolId, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("swap pool %d: duplicate denom %s", m.PoolId, denom)
+		}
+		seen[denom] = true
+	}
+	if m.SwapFeeBps >= MaxFeeBps {
+		return fmt.Errorf("swap pool %d: swap fee must be below %d bps", m.PoolId, MaxFeeBps)
+	}
+	return nil
+}
+
+// Trades reports whether the pool holds a denom
+func (m SwapPool) Trades(denom string) bool {
+	for _, d := range m.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// CandidateRoute is a route through configured pools, each hop naming its
+// output denom
+type CandidateRoute struct {
+	Hops  []SwapAmountInRoute
+	Pools []SwapPool
+}
+
+// CandidateRoutes lists the routes from tokenInDenom to tokenOutDenom through
+// pools that take at most maxHops hops. A route uses each pool and visits
+// each denom at most once. Routes are listed in a deterministic order,
+// shorter routes first.
+func CandidateRoutes(pools []SwapPool, tokenInDenom, tokenOutDenom string, maxHops int) []CandidateRoute {
+	var (
+		routes  []CandidateRoute
+		path    []SwapPool
+		hops    []SwapAmountInRoute
+		used    = make(map[int]bool, len(pools))
+		visited = map[string]bool{tokenInDenom: true}
+	)
+
+	var walk func(denomIn string, depth int)
+	walk = func(denomIn string, depth int) {
+		for i, pool := range pools {
+			if used[i] || !pool.Trades(denomIn) {
+				continue
+			}
+			for _, denomOut := range pool.Denoms {
+				if visited[denomOut] {
+					continue
+				}
+				hop := SwapAmountInRoute{PoolId: pool.PoolId, TokenOutDenom: denomOut}
+				if denomOut == tokenOutDenom {
+					if len(path)+1 == depth {
+						routes = append(routes, CandidateRoute{
+							Hops:  append(append([]SwapAmountInRoute(nil), hops...), hop),
+							Pools: append(append([]SwapPool(nil), path...), pool),
+						})
+					}
+					continue
+				}
+				if len(path)+1 >= depth {
+					continue
+				}
+
+				used[i], visited[denomOut] = true, true
+				path, hops = append(path, pool), append(hops, hop)
+				walk(denomOut, depth)
+				path, hops = path[:len(path)-1], hops[:len(hops)-1]
+				used[i], visited[denomOut] = false, false
+			}
+		}
+	}
+	// Searching by depth lists shorter routes first
+	for depth := 1; depth <= maxHops; depth++ {
+		walk(tokenInDenom, depth)
+	}
+	return routes
+}
+
+// FormatSwapRoute formats resolved hops in the format read by
+// ParseSwapRoute, naming the output denom of every hop
+func FormatSwapRoute(hops []SwapAmountInRoute) string {
+	parts := make([]string, len(hops))
+	for i, hop := range hops {
+		parts[i] = fmt.Sprintf("pool:%d:%s", hop.PoolId, hop.TokenOutDenom)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestCandidateRoutes(t *testing.T) {
+	pools := []types.SwapPool{
+		{ConnectionId: "connection-0", PoolId: 1, Denoms: []string{"uatom", "uosmo"}},
+		{ConnectionId: "connection-0", PoolId: 2, Denoms: []string{"uosmo", "ujuno"}},
+		{ConnectionId: "connection-0", PoolId: 3, Denoms: []string{"uatom", "uusdc"}},
+		{ConnectionId: "connection-0", PoolId: 4, Denoms: []string{"uusdc", "uosmo", "ujuno"}},
+	}
+
+	var routes []string
+	for _, route := range types.CandidateRoutes(pools, "uatom", "ujuno", 3) {
+		routes = append(routes, types.FormatSwapRoute(route.Hops))
+		require.Len(t, route.Pools, len(route.Hops))
+	}
+	require.Equal(t, []string{
+		"pool:1:uosmo,pool:2:ujuno",
+		"pool:1:uosmo,pool:4:ujuno",
+		"pool:3:uusdc,pool:4:ujuno",
+		"pool:3:uusdc,pool:4:uosmo,pool:2:ujuno",
+	}, routes, "shorter routes are listed first")
+
+	// No route reuses a pool or revisits a denom
+	for _, route := range types.CandidateRoutes(pools, "uatom", "ujuno", 3) {
+		seen := map[uint64]bool{}
+		for _, hop := range route.Hops {
+			require.False(t, seen[hop.PoolId], types.FormatSwapRoute(route.Hops))
+			seen[hop.PoolId] = true
+		}
+	}
+
+	require.Empty(t, types.CandidateRoutes(pools, "uatom", "ujuno", 1))
+	require.Empty(t, types.CandidateRoutes(pools, "uatom", "uother", 3))
+}
+
+func TestRoutingParamsValidate(t *testing.T) {
+	pool := types.SwapPool{ConnectionId: "connection-0", PoolId: 1, Denoms: []string{"uatom", "uusdc"}, SwapFeeBps: 30}
+	require.NoError(t, types.RoutingParams{Pools: []types.SwapPool{pool}, MaxHops: 2}.Validate())
+	require.Equal(t, types.DefaultMaxHops, types.RoutingParams{}.MaxHopsOrDefault())
+
+	for name, params := range map[string]types.RoutingParams{
+		"too many hops":  {MaxHops: types.MaxRouteHops + 1},
+		"duplicate pool": {Pools: []types.SwapPool{pool, pool}},
+		"one denom":      {Pools: []types.SwapPool{{ConnectionId: "connection-0", PoolId: 1, Denoms: []string{"uatom"}}}},
+		"no pool id":     {Pools: []types.SwapPool{{ConnectionId: "connection-0", Denoms: []string{"uatom", "uusdc"}}}},
+		"fee too high":   {Pools: []types.SwapPool{{ConnectionId: "connection-0", PoolId: 1, Denoms: []string{"uatom", "uusdc"}, SwapFeeBps: types.MaxFeeBps}}},
+	} {
+		require.Error(t, params.Validate(), name)
+	}
+}
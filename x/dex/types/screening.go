@@ -0,0 +1,171 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Operations passed to the screener
+const (
+	ScreeningOpSwap       = "swap"
+	ScreeningOpLimitOrder = "limit_order"
+	ScreeningOpOTCOffer   = "otc_offer"
+	ScreeningOpOTCAccept  = "otc_accept"
+)
+
+// Outcomes of a screening, recorded in the screening_decision event and the
+// DID's activity
+const (
+	ScreeningCleared = "cleared"
+	ScreeningFlagged = "flagged"
+	ScreeningBlocked = "blocked"
+	ScreeningFailed  = "failed"
+)
+
+// ScreeningRequest describes an operation about to move funds
+type ScreeningRequest struct {
+	// Operation is one of the ScreeningOp constants
+	Operation string
+	// DID is the DID performing the operation
+	DID string
+	// Parties are the DIDs and addresses on either side of the operation,
+	// including DID itself
+	Parties []string
+	// Amount is what the operation moves
+	Amount sdk.Coins
+	// ValueUSD is the oracle value of Amount, nil when it could not be priced
+	ValueUSD *math.LegacyDec
+}
+
+// ScreeningDecision is a screener's verdict on an operation
+type ScreeningDecision struct {
+	// Flagged is set when the operation should not proceed
+	Flagged bool
+	// Provider names the list or service that flagged the operation
+	Provider string
+	// Party is the flagged DID or address
+	Party string
+	// Reason is why the party was flagged, such as a list entry
+	Reason string
+}
+
+// Screener screens operations before they move funds. The module ships no
+// screener; deployments that must screen plug in their own, typically one
+// backed by sanctions list providers.
+type Screener interface {
+	Screen(ctx context.Context, req ScreeningRequest) (ScreeningDecision, error)
+}
+
+// AddressListProvider reports whether a DID or address is on a list, such as
+// a sanctions list
+type AddressListProvider interface {
+	// Name identifies the list in screening decisions
+	Name() string
+	// Listed reports whether party is on the list, and why
+	Listed(ctx context.Context, party string) (listed bool, reason string, err error)
+}
+
+// AddressListScreener is a Screener that flags an operation when any of its
+// parties is on one of its lists. Lists are consulted in order and the first
+// match decides.
+type AddressListScreener struct {
+	providers []AddressListProvider
+}
+
+var _ Screener = AddressListScreener{}
+
+// NewAddressListScreener returns a screener over the given lists
+func NewAddressListScreener(providers ...AddressListProvider) AddressListScreener {
+	return AddressListScreener{providers: providers}
+}
+
+// Screen implements Screener
+func (s AddressListScreener) Screen(ctx context.Context, req ScreeningRequest) (ScreeningDecision, error) {
+	for _, provider := range s.providers {
+		for _, party := range req.Parties {
+			listed, reason, err := provider.Listed(ctx, party)
+			if err != nil {
+				return ScreeningDecision{}, fmt.Errorf("%s: %w", provider.Name(), err)
+			}
+			if listed {
+				return ScreeningDecision{
+					Flagged:  true,
+					Provider: provider.Name(),
+					Party:    party,
+					Reason:   reason,
+				}, nil
+			}
+		}
+	}
+	return ScreeningDecision{}, nil
+}
+
+// AddressList is an AddressListProvider held in memory, mapping each listed
+// DID or address to the reason it is listed
+type AddressList struct {
+	name    string
+	entries map[string]string
+}
+
+var _ AddressListProvider = AddressList{}
+
+// NewAddressList returns a list of the given entries
+func NewAddressList(name string, entries map[string]string) AddressList {
+	return AddressList{name: name, entries: entries}
+}
+
+// Name implements AddressListProvider
+func (l AddressList) Name() string {
+	return l.name
+}
+
+// Listed implements AddressListProvider
+func (l AddressList) Listed(_ context.Context, party string) (bool, string, error) {
+	reason, ok := l.entries[party]
+	return ok, reason, nil
+}
+
+// Validate checks the thresholds of the screening params
+func (m ScreeningParams) Validate() error {
+	if _, ok := ScreeningMode_name[int32(m.Mode)]; !ok {
+		return fmt.Errorf("invalid screening mode %d", m.Mode)
+	}
+	if m.ThresholdUsd != "" {
+		threshold, err := math.LegacyNewDecFromStr(m.ThresholdUsd)
+		if err != nil {
+			return fmt.Errorf("invalid screening threshold_usd: %w", err)
+		}
+		if threshold.IsNegative() {
+			return fmt.Errorf("screening threshold_usd cannot be negative")
+		}
+	}
+	if err := m.Thresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid screening thresholds: %w", err)
+	}
+	return nil
+}
+
+// Screens reports whether an operation moving amount, worth valueUSD (nil
+// when unpriced), reaches a threshold. Without any threshold every operation
+// is screened.
+func (m ScreeningParams) Screens(amount sdk.Coins, valueUSD *math.LegacyDec) bool {
+	if m.ThresholdUsd == "" && m.Thresholds.Empty() {
+		return true
+	}
+	for _, threshold := range m.Thresholds {
+		if amount.AmountOf(threshold.Denom).GTE(threshold.Amount) {
+			return true
+		}
+	}
+	if m.ThresholdUsd == "" {
+		return false
+	}
+	if valueUSD == nil {
+		return true
+	}
+	threshold, err := math.LegacyNewDecFromStr(m.ThresholdUsd)
+	return err != nil || valueUSD.GTE(threshold)
+}
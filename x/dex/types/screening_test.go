@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestScreeningParamsScreens(t *testing.T) {
+	usd := func(s string) *math.LegacyDec {
+		d := math.LegacyMustNewDecFromStr(s)
+		return &d
+	}
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000))
+
+	// Without thresholds every operation is screened
+	require.True(t, types.ScreeningParams{}.Screens(amount, nil))
+
+	byDenom := types.ScreeningParams{Thresholds: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000))}
+	require.True(t, byDenom.Screens(amount, nil))
+	require.False(t, byDenom.Screens(sdk.NewCoins(sdk.NewInt64Coin("uatom", 999)), nil))
+	require.False(t, byDenom.Screens(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 5_000)), nil))
+
+	byValue := types.ScreeningParams{ThresholdUsd: "10000"}
+	require.True(t, byValue.Screens(amount, usd("10000")))
+	require.False(t, byValue.Screens(amount, usd("9999.99")))
+	require.True(t, byValue.Screens(amount, nil), "unpriced operations are screened")
+}
+
+func TestScreeningParamsValidate(t *testing.T) {
+	require.NoError(t, types.ScreeningParams{Mode: types.SCREENING_MODE_BLOCK, ThresholdUsd: "0.5"}.Validate())
+	require.Error(t, types.ScreeningParams{Mode: 7}.Validate())
+	require.Error(t, types.ScreeningParams{ThresholdUsd: "-1"}.Validate())
+	require.Error(t, types.ScreeningParams{ThresholdUsd: "ten"}.Validate())
+	require.Error(t, types.ScreeningParams{
+		Thresholds: sdk.Coins{sdk.Coin{Denom: "uatom", Amount: math.NewInt(-1)}},
+	}.Validate())
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// SwapStatus is the settlement state of a swap sent through ICA
+type SwapStatus int32
+
+const (
+	SwapStatusPending  SwapStatus = 0
+	SwapStatusComplete SwapStatus = 1
+	SwapStatusFailed   SwapStatus = 2
+)
+
+// String returns the status name, which is also used as the DEXActivity status
+func (s SwapStatus) String() string {
+	switch s {
+	case SwapStatusPending:
+		return "pending"
+	case SwapStatusComplete:
+		return "complete"
+	case SwapStatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
+// SwapRecord tracks a swap from submission until its ICA packet is
+// acknowledged or times out
+type SwapRecord struct {
+	Id            uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Did           string   `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	ConnectionId  string   `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId        string   `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	TokenIn       sdk.Coin `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	TokenOutDenom string   `protobuf:"bytes,6,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	MinAmountOut  string   `protobuf:"bytes,7,opt,name=min_amount_out,json=minAmountOut,proto3" json:"min_amount_out,omitempty"`
+	// Sequence is the ICA packet sequence; zero while the swap waits in a batch
+	Sequence uint64     `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status   SwapStatus `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	// AmountReceived is the realized output reported by the host chain, if any
+	AmountReceived string `protobuf:"bytes,10,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	// ActivityKey is the key of the swap's DEXActivity record
+	ActivityKey   string `protobuf:"bytes,11,opt,name=activity_key,json=activityKey,proto3" json:"activity_key,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,12,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	SettledHeight int64  `protobuf:"varint,13,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+	// MsgIndex is the position of the swap message in its ICA packet
+	MsgIndex uint32 `protobuf:"varint,14,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// Service is the audience of the UCAN the swap was submitted with; it is
+	// named in the swap_settled event so the service can be called back
+	Service string `protobuf:"bytes,15,opt,name=service,proto3" json:"service,omitempty"`
+	// TxHash is the hash of the transaction that submitted the swap
+	TxHash string `protobuf:"bytes,16,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (SwapRecord) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *SwapRecord) Reset() {
+	*m = SwapRecord{}
+}
+
+// String implements proto.Message
+func (m SwapRecord) String() string {
+	return fmt.Sprintf("swap#%d %s -> %s (%s)", m.Id, m.TokenIn, m.TokenOutDenom, m.Status)
+}
+
+// swapResponseTypeURLs are the host chain responses that report a swap's
+// output amount in field 1 (token_out_amount)
+var swapResponseTypeURLs = map[string]bool{
+	"/osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse": true,
+	"/osmosis.gamm.v1beta1.MsgSwapExactAmountInResponse":        true,
+}
+
+// SwapAmountsOut returns the output amounts reported in an ICA
+// acknowledgement result, indexed by the position of the message in the
+// packet. Positions of messages that are not swaps hold a nil amount.
+func SwapAmountsOut(result []byte) ([]math.Int, error) {
+	var data sdk.TxMsgData
+	if err := proto.Unmarshal(result, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode acknowledgement result: %w", err)
+	}
+
+	amounts := make([]math.Int, len(data.MsgResponses))
+	for i, res := range data.MsgResponses {
+		if res == nil || !swapResponseTypeURLs[res.TypeUrl] {
+			continue
+		}
+		amount, err := tokenOutAmount(res.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", res.TypeUrl, err)
+		}
+		amounts[i] = amount
+	}
+	return amounts, nil
+}
+
+// tokenOutAmount reads the token_out_amount string field of a swap response
+func tokenOutAmount(bz []byte) (math.Int, error) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return math.Int{}, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return math.Int{}, protowire.ParseError(n)
+			}
+			amount, ok := math.NewIntFromString(string(v))
+			if !ok || amount.IsNegative() {
+				return math.Int{}, fmt.Errorf("invalid token out amount %q", v)
+			}
+			return amount, nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return math.Int{}, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+	}
+	return math.Int{}, fmt.Errorf("missing token out amount")
+}
@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+const (
+	// DefaultICATimeout is the ICA packet timeout used when neither the message
+	// nor the module params specify one.
+	DefaultICATimeout = 30 * time.Second
+
+	// MaxICATimeout caps how far past the current block a packet may time out,
+	// so a far-future client deadline cannot keep funds in flight indefinitely.
+	MaxICATimeout = 10 * time.Minute
+)
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxTWAMMSlices is the maximum number of slices a TWAMM order may be split into
+	MaxTWAMMSlices = 1000
+
+	// MaxTWAMMIntervalBlocks is the maximum number of blocks between two slices
+	MaxTWAMMIntervalBlocks = 100_000
+)
+
+// TWAMMOrderStatus is the lifecycle state of a time-weighted order
+type TWAMMOrderStatus int32
+
+const (
+	TWAMMOrderStatusActive    TWAMMOrderStatus = 0
+	TWAMMOrderStatusCompleted TWAMMOrderStatus = 1
+	TWAMMOrderStatusCancelled TWAMMOrderStatus = 2
+)
+
+// String returns the status name
+func (s TWAMMOrderStatus) String() string {
+	switch s {
+	case TWAMMOrderStatusActive:
+		return "active"
+	case TWAMMOrderStatusCompleted:
+		return "completed"
+	case TWAMMOrderStatusCancelled:
+		return "cancelled"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
+// TWAMMOrder is a large swap executed as equal slices spread across blocks
+type TWAMMOrder struct {
+	Id             uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Did            string   `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	ConnectionId   string   `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PoolId         uint64   `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TokenIn        sdk.Coin `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	TokenOutDenom  string   `protobuf:"bytes,6,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	MinAmountOut   string   `protobuf:"bytes,7,opt,name=min_amount_out,json=minAmountOut,proto3" json:"min_amount_out,omitempty"`
+	TotalSlices    uint32   `protobuf:"varint,8,opt,name=total_slices,json=totalSlices,proto3" json:"total_slices,omitempty"`
+	IntervalBlocks uint32   `protobuf:"varint,9,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// SlicesExecuted counts slices sent to the host chain
+	SlicesExecuted uint32 `protobuf:"varint,10,opt,name=slices_executed,json=slicesExecuted,proto3" json:"slices_executed,omitempty"`
+	// SlicesSkipped counts slices deferred because the estimate was below the slice min-out
+	SlicesSkipped       uint32           `protobuf:"varint,11,opt,name=slices_skipped,json=slicesSkipped,proto3" json:"slices_skipped,omitempty"`
+	AmountExecuted      string           `protobuf:"bytes,12,opt,name=amount_executed,json=amountExecuted,proto3" json:"amount_executed,omitempty"`
+	NextExecutionHeight int64            `protobuf:"varint,13,opt,name=next_execution_height,json=nextExecutionHeight,proto3" json:"next_execution_height,omitempty"`
+	CreatedHeight       int64            `protobuf:"varint,14,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	Status              TWAMMOrderStatus `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`
+	// Service is the service told when each slice settles, if any
+	Service string `protobuf:"bytes,16,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (TWAMMOrder) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *TWAMMOrder) Reset() {
+	*m = TWAMMOrder{}
+}
+
+// String implements proto.Message
+func (m TWAMMOrder) String() string {
+	return fmt.Sprintf(
+		"twamm#%d %s -> %s (%d/%d slices, %s)",
+		m.Id, m.TokenIn, m.TokenOutDenom, m.SlicesExecuted, m.TotalSlices, m.Status,
+	)
+}
+
+// Validate performs stateless validation of a new order
+func (m TWAMMOrder) Validate() error {
+	if m.Did == "" {
+		return ErrInvalidDID
+	}
+	if m.ConnectionId == "" {
+		return ErrInvalidConnectionID
+	}
+	if !m.TokenIn.IsValid() || m.TokenIn.IsZero() {
+		return fmt.Errorf("%w: invalid token in %s", ErrInvalidOrderParams, m.TokenIn)
+	}
+	if m.TotalSlices == 0 || m.TotalSlices > MaxTWAMMSlices {
+		return fmt.Errorf("%w: slices must be between 1 and %d", ErrInvalidOrderParams, MaxTWAMMSlices)
+	}
+	if m.IntervalBlocks == 0 || m.IntervalBlocks > MaxTWAMMIntervalBlocks {
+		return fmt.Errorf(
+			"%w: interval must be between 1 and %d blocks", ErrInvalidOrderParams, MaxTWAMMIntervalBlocks,
+		)
+	}
+	if m.TokenIn.Amount.LT(math.NewInt(int64(m.TotalSlices))) {
+		return fmt.Errorf("%w: amount too small for %d slices", ErrInvalidOrderParams, m.TotalSlices)
+	}
+	return nil
+}
+
+// SliceAmount returns the input amount of the next slice. The last slice
+// absorbs the rounding remainder so the whole order is executed.
+func (m TWAMMOrder) SliceAmount() math.Int {
+	if m.SlicesExecuted+1 >= m.TotalSlices {
+		executed, ok := math.NewIntFromString(m.AmountExecuted)
+		if !ok {
+			executed = math.ZeroInt()
+		}
+		return m.TokenIn.Amount.Sub(executed)
+	}
+	return m.TokenIn.Amount.QuoRaw(int64(m.TotalSlices))
+}
+
+// SliceMinAmountOut returns the minimum output required for a slice of the given
+// input size, pro-rata to the order's overall minimum
+func (m TWAMMOrder) SliceMinAmountOut(sliceIn math.Int) math.Int {
+	minOut, ok := math.NewIntFromString(m.MinAmountOut)
+	if !ok || minOut.IsZero() {
+		return math.ZeroInt()
+	}
+	return minOut.Mul(sliceIn).Quo(m.TokenIn.Amount)
+}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestTWAMMOrderSlices(t *testing.T) {
+	order := types.TWAMMOrder{
+		Did:            "did:sonr:alice",
+		ConnectionId:   "connection-0",
+		TokenIn:        sdk.NewInt64Coin("uatom", 1000),
+		TokenOutDenom:  "uosmo",
+		MinAmountOut:   "900",
+		TotalSlices:    3,
+		IntervalBlocks: 10,
+		AmountExecuted: "0",
+	}
+	require.NoError(t, order.Validate())
+
+	// Equal slices until the last, which absorbs the remainder
+	require.Equal(t, math.NewInt(333), order.SliceAmount())
+	require.Equal(t, math.NewInt(299), order.SliceMinAmountOut(math.NewInt(333)))
+
+	order.SlicesExecuted = 2
+	order.AmountExecuted = "666"
+	require.Equal(t, math.NewInt(334), order.SliceAmount())
+}
+
+func TestTWAMMOrderValidate(t *testing.T) {
+	order := types.TWAMMOrder{
+		Did:            "did:sonr:alice",
+		ConnectionId:   "connection-0",
+		TokenIn:        sdk.NewInt64Coin("uatom", 2),
+		TotalSlices:    3,
+		IntervalBlocks: 1,
+	}
+	require.ErrorIs(t, order.Validate(), types.ErrInvalidOrderParams)
+
+	order.TokenIn = sdk.NewInt64Coin("uatom", 100)
+	order.IntervalBlocks = 0
+	require.ErrorIs(t, order.Validate(), types.ErrInvalidOrderParams)
+}
@@ -41,6 +41,50 @@ const (
 	UCANAll    = "*"      // Wildcard for all actions
 )
 
+// DEX capability vocabulary. These are the abilities a UCAN must grant on a
+// dex resource for the module's messages to accept it.
+const (
+	AbilitySwap        = "swap"         // Execute a swap
+	AbilityProvide     = "provide"      // Provide pool liquidity
+	AbilityRemove      = "remove"       // Remove pool liquidity
+	AbilityOrderCreate = "order:create" // Place a limit order
+	AbilityOrderCancel = "order:cancel" // Cancel a limit order
+)
+
+// DEX resource types. A resource URI is dex:<type>:<connection ID>.
+const (
+	ResourceSwap      = "swap"
+	ResourceLiquidity = "liquidity"
+	ResourceOrder     = "order"
+)
+
+// DEXCapability is the ability a UCAN must grant on a resource type for an
+// operation
+type DEXCapability struct {
+	Operation DEXOperation
+	Resource  string
+	Ability   string
+}
+
+// DEXCapabilitySchema lists the capabilities checked by the module's messages
+var DEXCapabilitySchema = []DEXCapability{
+	{Operation: DEXOpExecuteSwap, Resource: ResourceSwap, Ability: AbilitySwap},
+	{Operation: DEXOpProvideLiquidity, Resource: ResourceLiquidity, Ability: AbilityProvide},
+	{Operation: DEXOpRemoveLiquidity, Resource: ResourceLiquidity, Ability: AbilityRemove},
+	{Operation: DEXOpLimitOrder, Resource: ResourceOrder, Ability: AbilityOrderCreate},
+	{Operation: DEXOpCancelOrder, Resource: ResourceOrder, Ability: AbilityOrderCancel},
+}
+
+// LookupDEXCapability returns the schema entry of an operation
+func LookupDEXCapability(operation DEXOperation) (DEXCapability, bool) {
+	for _, capability := range DEXCapabilitySchema {
+		if capability.Operation == operation {
+			return capability, true
+		}
+	}
+	return DEXCapability{}, false
+}
+
 // DEXOperation represents the type of DEX operation being performed
 type DEXOperation string
 
@@ -76,25 +120,19 @@ func NewUCANCapabilityMapper() *UCANCapabilityMapper {
 	return &UCANCapabilityMapper{}
 }
 
-// GetUCANCapabilitiesForOperation returns UCAN-specific capabilities for a DEX operation
+// GetUCANCapabilitiesForOperation returns UCAN-specific capabilities for a DEX
+// operation. Operations in DEXCapabilitySchema require their schema ability.
 func (m *UCANCapabilityMapper) GetUCANCapabilitiesForOperation(operation DEXOperation) []string {
+	if capability, ok := LookupDEXCapability(operation); ok {
+		return []string{capability.Ability}
+	}
 	switch operation {
 	case DEXOpSwap:
 		return []string{UCANSwap, UCANUpdate}
-	case DEXOpExecuteSwap:
-		return []string{UCANExecuteSwap, UCANUpdate}
-	case DEXOpLimitOrder:
-		return []string{UCANLimitOrder, UCANCreate}
 	case DEXOpMarketOrder:
 		return []string{UCANMarketOrder, UCANCreate}
-	case DEXOpCancelOrder:
-		return []string{UCANCancelOrder, UCANDelete}
 	case DEXOpCancelAllOrders:
 		return []string{UCANCancelAllOrders, UCANDelete, UCANAdmin}
-	case DEXOpProvideLiquidity:
-		return []string{UCANProvideLiquidity, UCANCreate}
-	case DEXOpRemoveLiquidity:
-		return []string{UCANRemoveLiquidity, UCANDelete}
 	case DEXOpCreatePool:
 		return []string{UCANCreatePool, UCANCreate, UCANAdmin}
 	case DEXOpRegisterAccount:
@@ -230,6 +268,7 @@ func IsUCANAction(action string) bool {
 		UCANRegisterAccount, UCANUpdatePortfolio, UCANWithdraw, UCANDeposit,
 		UCANQueryPool, UCANQueryOrders, UCANQueryPortfolio,
 		UCANCreate, UCANRead, UCANUpdate, UCANDelete, UCANAdmin, UCANAll,
+		AbilityProvide, AbilityRemove, AbilityOrderCreate, AbilityOrderCancel,
 	}
 
 	for _, validAction := range validActions {
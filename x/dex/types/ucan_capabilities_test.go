@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dex/types"
+)
+
+func TestDEXCapabilitySchema(t *testing.T) {
+	mapper := types.NewUCANCapabilityMapper()
+	for _, capability := range types.DEXCapabilitySchema {
+		require.True(t, types.IsUCANAction(capability.Ability), capability.Ability)
+		require.Equal(t, []string{capability.Ability}, mapper.GetUCANCapabilitiesForOperation(capability.Operation))
+	}
+
+	capability, ok := types.LookupDEXCapability(types.DEXOpCancelOrder)
+	require.True(t, ok)
+	require.Equal(t, types.AbilityOrderCancel, capability.Ability)
+	require.Equal(t, "dex:order:connection-0", mapper.CreateDEXResourceURI(capability.Resource, "connection-0"))
+
+	_, ok = types.LookupDEXCapability(types.DEXOpCreatePool)
+	require.False(t, ok)
+}
@@ -32,6 +32,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "did"},
 					},
 				},
+				{
+					RpcMethod: "GetDIDDocumentVersion",
+					Use:       "document-version [did]",
+					Short:     "Get a DID document at an earlier version",
+					Long:      "Get a DID document at the version selected by --version-id, or the version that was current at --version-time (RFC 3339). Without either the latest version is returned.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
+				{
+					RpcMethod: "GetDIDDocumentHistory",
+					Use:       "document-history [did]",
+					Short:     "List every version of a DID document",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegatedControllers",
+					Use:       "delegated-controllers [did]",
+					Short:     "List the delegated controllers of a DID that have not expired",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
+				{
+					RpcMethod: "GetDIDAssets",
+					Use:       "assets [did]",
+					Short:     "List the NFTs attached to a DID profile and whether they are still owned",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"owned_only": {Usage: "Only list assets the attaching owner still holds"},
+					},
+				},
 				{
 					RpcMethod: "ListDIDDocuments",
 					Use:       "documents",
@@ -95,10 +131,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "GetUnverifiedCredentials",
+					Use:       "unverified-credentials [did]",
+					Short:     "List the WebAuthn credentials of a DID whose origin is no longer verified",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
+				{
+					RpcMethod: "VerifyCredential",
+					Use:       "verify-credential [credential-id]",
+					Short:     "Check the proof, revocation status and expiry of a verifiable credential",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "credential_id"},
+					},
+				},
+				{
+					RpcMethod: "GetStatusList",
+					Use:       "status-list [issuer] [list-id]",
+					Short:     "Show a StatusList2021 revocation list of an issuer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "issuer"},
+						{ProtoField: "list_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Msg_ServiceDesc.ServiceName,
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "UpdateParams",
@@ -129,6 +191,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "did"},
 					},
 				},
+				{
+					RpcMethod: "ReactivateDID",
+					Use:       "reactivate-did [did]",
+					Short:     "Reactivate a deactivated DID document",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
 				{
 					RpcMethod: "AddVerificationMethod",
 					Use:       "add-verification-method [did] [verification-method]",
@@ -187,6 +257,48 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"revocation_reason": {Usage: "Reason for credential revocation"},
 					},
 				},
+				{
+					RpcMethod: "AddDelegatedController",
+					Use:       "add-delegated-controller [did] [delegate] [expires-at]",
+					Short:     "Delegate control of a DID to an address until a unix time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+						{ProtoField: "delegate"},
+						{ProtoField: "expires_at"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"purpose": {Usage: "What the delegation is for"},
+					},
+				},
+				{
+					RpcMethod: "RemoveDelegatedController",
+					Use:       "remove-delegated-controller [did] [delegate]",
+					Short:     "End a delegation of a DID before it expires",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+						{ProtoField: "delegate"},
+					},
+				},
+				{
+					RpcMethod: "AttachNFT",
+					Use:       "attach-nft [did] [class-id] [nft-id]",
+					Short:     "Attach an NFT held by the controller to a DID profile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+						{ProtoField: "class_id"},
+						{ProtoField: "nft_id"},
+					},
+				},
+				{
+					RpcMethod: "DetachNFT",
+					Use:       "detach-nft [did] [class-id] [nft-id]",
+					Short:     "Remove an NFT from a DID profile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+						{ProtoField: "class_id"},
+						{ProtoField: "nft_id"},
+					},
+				},
 			},
 		},
 	}
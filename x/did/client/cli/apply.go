@@ -0,0 +1,546 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+const (
+	flagFile    = "file"
+	flagPrune   = "prune"
+	flagCurrent = "current"
+)
+
+// Verification relationships as named in the W3C DID specification.
+const (
+	RelAuthentication       = "authentication"
+	RelAssertionMethod      = "assertionMethod"
+	RelKeyAgreement         = "keyAgreement"
+	RelCapabilityInvocation = "capabilityInvocation"
+	RelCapabilityDelegation = "capabilityDelegation"
+)
+
+var relationships = []string{
+	RelAuthentication,
+	RelAssertionMethod,
+	RelKeyAgreement,
+	RelCapabilityInvocation,
+	RelCapabilityDelegation,
+}
+
+// Manifest is a declarative description of a DID document. Only the fields
+// it lists are managed; anything else on chain, such as WebAuthn credential
+// data, is left as is.
+type Manifest struct {
+	DID                 string            `json:"did"`
+	Controller          string            `json:"controller,omitempty"`
+	AlsoKnownAs         []string          `json:"alsoKnownAs,omitempty"`
+	VerificationMethods []ManifestMethod  `json:"verificationMethods,omitempty"`
+	Services            []ManifestService `json:"services,omitempty"`
+}
+
+// ManifestMethod describes a verification method and the relationships it
+// is used for.
+type ManifestMethod struct {
+	ID                  string   `json:"id"`
+	Type                string   `json:"type"`
+	Controller          string   `json:"controller"`
+	PublicKeyMultibase  string   `json:"publicKeyMultibase,omitempty"`
+	PublicKeyJwk        string   `json:"publicKeyJwk,omitempty"`
+	PublicKeyBase58     string   `json:"publicKeyBase58,omitempty"`
+	BlockchainAccountID string   `json:"blockchainAccountId,omitempty"`
+	Relationships       []string `json:"relationships,omitempty"`
+}
+
+// ManifestService describes a service endpoint.
+type ManifestService struct {
+	ID               string            `json:"id"`
+	Type             string            `json:"type"`
+	ServiceEndpoint  string            `json:"serviceEndpoint,omitempty"`
+	ServiceEndpoints []string          `json:"serviceEndpoints,omitempty"`
+	Properties       map[string]string `json:"properties,omitempty"`
+}
+
+// ParseManifest reads a YAML or JSON manifest.
+func ParseManifest(bz []byte) (*Manifest, error) {
+	var m Manifest
+	if err := yaml.UnmarshalStrict(bz, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	if m.DID == "" {
+		return nil, fmt.Errorf("invalid manifest: did is required")
+	}
+
+	seen := make(map[string]bool)
+	for _, vm := range m.VerificationMethods {
+		if vm.ID == "" || vm.Type == "" {
+			return nil, fmt.Errorf("invalid manifest: verification methods need an id and type")
+		}
+		if seen[vm.ID] {
+			return nil, fmt.Errorf("invalid manifest: duplicate verification method %s", vm.ID)
+		}
+		seen[vm.ID] = true
+		for _, rel := range vm.Relationships {
+			if !slices.Contains(relationships, rel) {
+				return nil, fmt.Errorf("invalid manifest: unknown relationship %q on %s", rel, vm.ID)
+			}
+		}
+	}
+	for _, svc := range m.Services {
+		if svc.ID == "" || svc.Type == "" {
+			return nil, fmt.Errorf("invalid manifest: services need an id and type")
+		}
+		if seen[svc.ID] {
+			return nil, fmt.Errorf("invalid manifest: duplicate id %s", svc.ID)
+		}
+		seen[svc.ID] = true
+	}
+	return &m, nil
+}
+
+// Change is a single difference between the manifest and on-chain state.
+type Change struct {
+	// Op is "+" for additions, "-" for removals and "~" for modifications.
+	Op     string
+	Kind   string
+	ID     string
+	Detail string
+}
+
+func (c Change) String() string {
+	if c.Detail == "" {
+		return fmt.Sprintf("%s %s %s", c.Op, c.Kind, c.ID)
+	}
+	return fmt.Sprintf("%s %s %s (%s)", c.Op, c.Kind, c.ID, c.Detail)
+}
+
+// Plan diffs the manifest against the current document (nil if the DID does
+// not exist) and returns the changes and the messages that apply them.
+// Additions and removals map to the granular add/remove messages; anything
+// that modifies existing entries or top-level fields is folded into a single
+// MsgUpdateDID. Entries missing from the manifest are only removed when prune
+// is set.
+func Plan(m *Manifest, current *types.DIDDocument, signer string, prune bool) ([]Change, []sdk.Msg) {
+	if current == nil {
+		doc := types.DIDDocument{Id: m.DID}
+		desired := render(m, &doc, prune)
+		changes := []Change{{Op: "+", Kind: "did", ID: m.DID}}
+		for _, vm := range m.VerificationMethods {
+			changes = append(changes, Change{Op: "+", Kind: "verificationMethod", ID: vm.ID})
+		}
+		for _, svc := range m.Services {
+			changes = append(changes, Change{Op: "+", Kind: "service", ID: svc.ID})
+		}
+		return changes, []sdk.Msg{&types.MsgCreateDID{Controller: signer, DidDocument: *desired}}
+	}
+
+	var (
+		changes    []Change
+		granular   []sdk.Msg
+		needUpdate bool
+	)
+
+	if m.Controller != "" && m.Controller != current.PrimaryController {
+		changes = append(changes, Change{Op: "~", Kind: "controller", ID: m.DID,
+			Detail: fmt.Sprintf("%s -> %s", current.PrimaryController, m.Controller)})
+		needUpdate = true
+	}
+	if m.AlsoKnownAs != nil && !slices.Equal(m.AlsoKnownAs, current.AlsoKnownAs) {
+		changes = append(changes, Change{Op: "~", Kind: "alsoKnownAs", ID: m.DID})
+		needUpdate = true
+	}
+
+	currentRels := relationshipsByMethod(current)
+	currentVMs := make(map[string]*types.VerificationMethod)
+	for _, vm := range current.VerificationMethod {
+		currentVMs[vm.Id] = vm
+	}
+	wantVMs := make(map[string]bool)
+	for _, want := range m.VerificationMethods {
+		wantVMs[want.ID] = true
+		have, ok := currentVMs[want.ID]
+		if !ok {
+			changes = append(changes, Change{Op: "+", Kind: "verificationMethod", ID: want.ID})
+			granular = append(granular, &types.MsgAddVerificationMethod{
+				Controller:         signer,
+				Did:                m.DID,
+				VerificationMethod: *applyMethod(want, &types.VerificationMethod{}),
+				Relationships:      want.Relationships,
+			})
+			continue
+		}
+		if diff := methodDiff(want, have, currentRels[want.ID]); diff != "" {
+			changes = append(changes, Change{Op: "~", Kind: "verificationMethod", ID: want.ID, Detail: diff})
+			needUpdate = true
+		}
+	}
+	if prune {
+		for _, vm := range current.VerificationMethod {
+			if !wantVMs[vm.Id] {
+				changes = append(changes, Change{Op: "-", Kind: "verificationMethod", ID: vm.Id})
+				granular = append(granular, &types.MsgRemoveVerificationMethod{
+					Controller:           signer,
+					Did:                  m.DID,
+					VerificationMethodId: vm.Id,
+				})
+			}
+		}
+	}
+
+	currentSvcs := make(map[string]*types.Service)
+	for _, svc := range current.Service {
+		currentSvcs[svc.Id] = svc
+	}
+	wantSvcs := make(map[string]bool)
+	for _, want := range m.Services {
+		wantSvcs[want.ID] = true
+		have, ok := currentSvcs[want.ID]
+		if !ok {
+			changes = append(changes, Change{Op: "+", Kind: "service", ID: want.ID})
+			granular = append(granular, &types.MsgAddService{
+				Controller: signer,
+				Did:        m.DID,
+				Service:    *applyService(want, &types.Service{}),
+			})
+			continue
+		}
+		if diff := serviceDiff(want, have); diff != "" {
+			changes = append(changes, Change{Op: "~", Kind: "service", ID: want.ID, Detail: diff})
+			needUpdate = true
+		}
+	}
+	if prune {
+		for _, svc := range current.Service {
+			if !wantSvcs[svc.Id] {
+				changes = append(changes, Change{Op: "-", Kind: "service", ID: svc.Id})
+				granular = append(granular, &types.MsgRemoveService{
+					Controller: signer,
+					Did:        m.DID,
+					ServiceId:  svc.Id,
+				})
+			}
+		}
+	}
+
+	if needUpdate {
+		// A full update already carries every addition and removal.
+		return changes, []sdk.Msg{&types.MsgUpdateDID{
+			Controller:  signer,
+			Did:         m.DID,
+			DidDocument: *render(m, current, prune),
+		}}
+	}
+	return changes, granular
+}
+
+// render overlays the manifest onto a copy of doc.
+func render(m *Manifest, doc *types.DIDDocument, prune bool) *types.DIDDocument {
+	out := *doc
+	if m.Controller != "" {
+		out.PrimaryController = m.Controller
+	}
+	if m.AlsoKnownAs != nil {
+		out.AlsoKnownAs = m.AlsoKnownAs
+	}
+
+	rels := relationshipsByMethod(doc)
+	wantVMs := make(map[string]ManifestMethod)
+	for _, want := range m.VerificationMethods {
+		wantVMs[want.ID] = want
+		rels[want.ID] = want.Relationships
+	}
+
+	out.VerificationMethod = nil
+	for _, vm := range doc.VerificationMethod {
+		want, managed := wantVMs[vm.Id]
+		switch {
+		case managed:
+			out.VerificationMethod = append(out.VerificationMethod, applyMethod(want, vm))
+			delete(wantVMs, vm.Id)
+		case !prune:
+			out.VerificationMethod = append(out.VerificationMethod, vm)
+		default:
+			delete(rels, vm.Id)
+		}
+	}
+	for _, want := range m.VerificationMethods {
+		if _, pending := wantVMs[want.ID]; pending {
+			out.VerificationMethod = append(out.VerificationMethod, applyMethod(want, &types.VerificationMethod{}))
+		}
+	}
+
+	refs := make(map[string][]*types.VerificationMethodReference)
+	for _, vm := range out.VerificationMethod {
+		for _, rel := range rels[vm.Id] {
+			refs[rel] = append(refs[rel], &types.VerificationMethodReference{VerificationMethodId: vm.Id})
+		}
+	}
+	out.Authentication = refs[RelAuthentication]
+	out.AssertionMethod = refs[RelAssertionMethod]
+	out.KeyAgreement = refs[RelKeyAgreement]
+	out.CapabilityInvocation = refs[RelCapabilityInvocation]
+	out.CapabilityDelegation = refs[RelCapabilityDelegation]
+
+	wantSvcs := make(map[string]ManifestService)
+	for _, want := range m.Services {
+		wantSvcs[want.ID] = want
+	}
+	out.Service = nil
+	for _, svc := range doc.Service {
+		want, managed := wantSvcs[svc.Id]
+		switch {
+		case managed:
+			out.Service = append(out.Service, applyService(want, svc))
+			delete(wantSvcs, svc.Id)
+		case !prune:
+			out.Service = append(out.Service, svc)
+		}
+	}
+	for _, want := range m.Services {
+		if _, pending := wantSvcs[want.ID]; pending {
+			out.Service = append(out.Service, applyService(want, &types.Service{}))
+		}
+	}
+	return &out
+}
+
+// relationshipsByMethod returns the relationships each verification method
+// is referenced from.
+func relationshipsByMethod(doc *types.DIDDocument) map[string][]string {
+	out := make(map[string][]string)
+	add := func(rel string, refs []*types.VerificationMethodReference) {
+		for _, ref := range refs {
+			id := ref.VerificationMethodId
+			if id == "" && ref.EmbeddedVerificationMethod != nil {
+				id = ref.EmbeddedVerificationMethod.Id
+			}
+			out[id] = append(out[id], rel)
+		}
+	}
+	add(RelAuthentication, doc.Authentication)
+	add(RelAssertionMethod, doc.AssertionMethod)
+	add(RelKeyAgreement, doc.KeyAgreement)
+	add(RelCapabilityInvocation, doc.CapabilityInvocation)
+	add(RelCapabilityDelegation, doc.CapabilityDelegation)
+	return out
+}
+
+// applyMethod returns a copy of base with the manifest fields set.
+func applyMethod(want ManifestMethod, base *types.VerificationMethod) *types.VerificationMethod {
+	vm := *base
+	vm.Id = want.ID
+	vm.VerificationMethodKind = want.Type
+	vm.Controller = want.Controller
+	vm.PublicKeyMultibase = want.PublicKeyMultibase
+	vm.PublicKeyJwk = want.PublicKeyJwk
+	vm.PublicKeyBase58 = want.PublicKeyBase58
+	vm.BlockchainAccountId = want.BlockchainAccountID
+	return &vm
+}
+
+// applyService returns a copy of base with the manifest fields set.
+func applyService(want ManifestService, base *types.Service) *types.Service {
+	svc := *base
+	svc.Id = want.ID
+	svc.ServiceKind = want.Type
+	svc.SingleEndpoint = want.ServiceEndpoint
+	svc.MultipleEndpoints = nil
+	if len(want.ServiceEndpoints) > 0 {
+		svc.MultipleEndpoints = &types.ServiceEndpoints{Endpoints: want.ServiceEndpoints}
+	}
+	svc.Properties = want.Properties
+	return &svc
+}
+
+// methodDiff lists the managed fields of a verification method that differ.
+func methodDiff(want ManifestMethod, have *types.VerificationMethod, haveRels []string) string {
+	var fields []string
+	if want.Type != have.VerificationMethodKind {
+		fields = append(fields, "type")
+	}
+	if want.Controller != have.Controller {
+		fields = append(fields, "controller")
+	}
+	if want.PublicKeyMultibase != have.PublicKeyMultibase ||
+		want.PublicKeyJwk != have.PublicKeyJwk ||
+		want.PublicKeyBase58 != have.PublicKeyBase58 {
+		fields = append(fields, "publicKey")
+	}
+	if want.BlockchainAccountID != have.BlockchainAccountId {
+		fields = append(fields, "blockchainAccountId")
+	}
+	if !sameSet(want.Relationships, haveRels) {
+		fields = append(fields, "relationships")
+	}
+	return strings.Join(fields, ", ")
+}
+
+// serviceDiff lists the managed fields of a service that differ.
+func serviceDiff(want ManifestService, have *types.Service) string {
+	var fields []string
+	if want.Type != have.ServiceKind {
+		fields = append(fields, "type")
+	}
+	if want.ServiceEndpoint != have.SingleEndpoint ||
+		!slices.Equal(want.ServiceEndpoints, have.GetMultipleEndpoints().GetEndpoints()) {
+		fields = append(fields, "endpoint")
+	}
+	if !maps.Equal(want.Properties, have.Properties) {
+		fields = append(fields, "properties")
+	}
+	return strings.Join(fields, ", ")
+}
+
+func sameSet(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// CmdApply returns the command that reconciles a DID document with a manifest.
+func CmdApply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f [manifest]",
+		Short: "Apply a declarative DID document manifest",
+		Long: `Diff a YAML or JSON DID document manifest against on-chain state and submit
+the minimal set of messages to reconcile them. Verification methods and
+services missing from the manifest are kept unless --prune is set.
+
+Use --dry-run to print the planned changes without signing or broadcasting.
+
+To plan offline, export the on-chain document on a networked machine with
+"snrd query did document [did] -o json" and pass it with --current. Combine
+with --generate-only and sign the result with "snrd tx sign-offline".`,
+		Example: `snrd tx did apply -f manifest.yaml --from alice --dry-run
+snrd tx did apply -f manifest.yaml --current alice.json --from idx1... --generate-only --offline \
+  --account-number 12 --sequence 3 --chain-id sonrtest_1-1 > unsigned.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path, _ := cmd.Flags().GetString(flagFile)
+			prune, _ := cmd.Flags().GetBool(flagPrune)
+			dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+
+			bz, err := readManifest(cmd.InOrStdin(), path)
+			if err != nil {
+				return err
+			}
+			manifest, err := ParseManifest(bz)
+			if err != nil {
+				return err
+			}
+
+			currentPath, _ := cmd.Flags().GetString(flagCurrent)
+			var current *types.DIDDocument
+			switch {
+			case currentPath != "":
+				current, err = readDocument(clientCtx, currentPath, manifest.DID)
+			case clientCtx.Offline:
+				err = fmt.Errorf("--%s is required offline; export it with \"snrd query did document %s -o json\"", flagCurrent, manifest.DID)
+			default:
+				current, err = fetchDocument(cmd, clientCtx, manifest.DID)
+			}
+			if err != nil {
+				return err
+			}
+
+			changes, msgs := Plan(manifest, current, clientCtx.GetFromAddress().String(), prune)
+			if len(changes) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("%s is up to date\n", manifest.DID))
+			}
+
+			var out strings.Builder
+			for _, c := range changes {
+				fmt.Fprintln(&out, c)
+			}
+			fmt.Fprintf(&out, "%d change(s), %d message(s)\n", len(changes), len(msgs))
+			if err := clientCtx.PrintString(out.String()); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().StringP(flagFile, "f", "", "Path to the manifest, or - for stdin")
+	cmd.Flags().Bool(flagPrune, false, "Remove verification methods and services not in the manifest")
+	cmd.Flags().String(flagCurrent, "", "Plan against this exported document query response instead of querying the chain")
+	_ = cmd.MarkFlagRequired(flagFile)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readManifest(stdin io.Reader, path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// fetchDocument returns the on-chain document, or nil if the DID does not exist.
+func fetchDocument(cmd *cobra.Command, clientCtx client.Context, did string) (*types.DIDDocument, error) {
+	res, err := types.NewQueryClient(clientCtx).GetDIDDocument(
+		cmd.Context(),
+		&types.QueryGetDIDDocumentRequest{Did: did},
+	)
+	// Registered module errors reach the client as Unknown with the error
+	// text preserved, so match on that as well as NotFound.
+	if status.Code(err) == codes.NotFound ||
+		(err != nil && strings.Contains(err.Error(), types.ErrDIDNotFound.Error())) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", did, err)
+	}
+	if res.DidDocument != nil && res.DidDocument.Deactivated {
+		return nil, fmt.Errorf("%s is deactivated", did)
+	}
+	return res.DidDocument, nil
+}
+
+// readDocument reads the on-chain document from a saved document query
+// response, so a plan can be made without network access. A response
+// without a document means the DID does not exist yet.
+func readDocument(clientCtx client.Context, path, did string) (*types.DIDDocument, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var res types.QueryGetDIDDocumentResponse
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if res.DidDocument == nil {
+		return nil, nil
+	}
+	if res.DidDocument.Id != did {
+		return nil, fmt.Errorf("%s holds %s, not %s", path, res.DidDocument.Id, did)
+	}
+	if res.DidDocument.Deactivated {
+		return nil, fmt.Errorf("%s is deactivated", did)
+	}
+	return res.DidDocument, nil
+}
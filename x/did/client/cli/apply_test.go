@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+const testManifest = `
+did: did:sonr:alice
+controller: did:sonr:alice
+verificationMethods:
+  - id: did:sonr:alice#key-1
+    type: Ed25519VerificationKey2020
+    controller: did:sonr:alice
+    publicKeyMultibase: z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK
+    relationships: [authentication, assertionMethod]
+services:
+  - id: did:sonr:alice#dwn
+    type: DecentralizedWebNode
+    serviceEndpoint: https://dwn.sonr.io
+`
+
+func currentDocument() *didtypes.DIDDocument {
+	return &didtypes.DIDDocument{
+		Id:                "did:sonr:alice",
+		PrimaryController: "did:sonr:alice",
+		VerificationMethod: []*didtypes.VerificationMethod{
+			{
+				Id:                     "did:sonr:alice#key-1",
+				VerificationMethodKind: "Ed25519VerificationKey2020",
+				Controller:             "did:sonr:alice",
+				PublicKeyMultibase:     "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+			},
+			{
+				Id:                     "did:sonr:alice#passkey",
+				VerificationMethodKind: "WebAuthnCredential2024",
+				Controller:             "did:sonr:alice",
+				WebauthnCredential:     &didtypes.WebAuthnCredential{CredentialId: "cred"},
+			},
+		},
+		Authentication: []*didtypes.VerificationMethodReference{
+			{VerificationMethodId: "did:sonr:alice#key-1"},
+			{VerificationMethodId: "did:sonr:alice#passkey"},
+		},
+		AssertionMethod: []*didtypes.VerificationMethodReference{
+			{VerificationMethodId: "did:sonr:alice#key-1"},
+		},
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	require.NoError(t, err)
+	require.Equal(t, "did:sonr:alice", m.DID)
+	require.Len(t, m.VerificationMethods, 1)
+	require.Equal(t, "https://dwn.sonr.io", m.Services[0].ServiceEndpoint)
+
+	_, err = ParseManifest([]byte("controller: did:sonr:alice"))
+	require.ErrorContains(t, err, "did is required")
+
+	_, err = ParseManifest([]byte(`
+did: did:sonr:alice
+verificationMethods:
+  - id: did:sonr:alice#key-1
+    type: Ed25519VerificationKey2020
+    relationships: [signing]
+`))
+	require.ErrorContains(t, err, "unknown relationship")
+}
+
+func TestPlanAddsOnlyMissingEntries(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	require.NoError(t, err)
+
+	changes, msgs := Plan(m, currentDocument(), "idx1signer", false)
+	require.Len(t, changes, 1)
+	require.Equal(t, "+ service did:sonr:alice#dwn", changes[0].String())
+	require.Len(t, msgs, 1)
+
+	add, ok := msgs[0].(*didtypes.MsgAddService)
+	require.True(t, ok)
+	require.Equal(t, "DecentralizedWebNode", add.Service.ServiceKind)
+	require.Equal(t, "idx1signer", add.Controller)
+}
+
+func TestPlanPrune(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	require.NoError(t, err)
+
+	_, msgs := Plan(m, currentDocument(), "idx1signer", true)
+	require.Len(t, msgs, 2)
+
+	remove, ok := msgs[0].(*didtypes.MsgRemoveVerificationMethod)
+	require.True(t, ok)
+	require.Equal(t, "did:sonr:alice#passkey", remove.VerificationMethodId)
+}
+
+func TestPlanModificationUsesSingleUpdate(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	require.NoError(t, err)
+	m.VerificationMethods[0].Relationships = []string{RelAuthentication}
+
+	changes, msgs := Plan(m, currentDocument(), "idx1signer", false)
+	require.Len(t, changes, 2)
+	require.Equal(t, "~", changes[0].Op)
+	require.Len(t, msgs, 1)
+
+	update, ok := msgs[0].(*didtypes.MsgUpdateDID)
+	require.True(t, ok)
+	doc := update.DidDocument
+	require.Len(t, doc.Service, 1)
+	require.Empty(t, doc.AssertionMethod)
+	require.Len(t, doc.Authentication, 2)
+
+	// Unmanaged entries keep their on-chain data.
+	require.Equal(t, "cred", doc.VerificationMethod[1].WebauthnCredential.CredentialId)
+}
+
+func TestPlanNoChanges(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	require.NoError(t, err)
+	m.Services = nil
+
+	changes, msgs := Plan(m, currentDocument(), "idx1signer", false)
+	require.Empty(t, changes)
+	require.Empty(t, msgs)
+}
+
+func TestPlanCreate(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	require.NoError(t, err)
+
+	changes, msgs := Plan(m, nil, "idx1signer", false)
+	require.Len(t, changes, 3)
+	create, ok := msgs[0].(*didtypes.MsgCreateDID)
+	require.True(t, ok)
+	require.Len(t, create.DidDocument.VerificationMethod, 1)
+	require.Len(t, create.DidDocument.AssertionMethod, 1)
+}
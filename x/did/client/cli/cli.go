@@ -12,6 +12,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// NewTxCmd returns the did tx command with the hand-written subcommands.
+// AutoCLI adds the generated Msg subcommands to it.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "did",
+		Short:                      "did transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdApply())
+	return cmd
+}
+
 func AddAuthCmds(rootCmd *cobra.Command) {
 	authCmd := &cobra.Command{
 		Use:   "auth",
@@ -24,6 +39,8 @@ func AddAuthCmds(rootCmd *cobra.Command) {
 	authCmd.AddCommand(
 		authLoginCmd(),
 		authRegisterCmd(),
+		authReconcileCmd(),
+		authSandboxCmd(),
 	)
 
 	// Add to root command
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/cobra"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+const (
+	flagApply     = "apply"
+	flagInterval  = "interval"
+	flagAdminAddr = "admin-addr"
+
+	// adminTokenEnv holds the bearer token for the reconciliation admin routes
+	adminTokenEnv = "SONR_ADMIN_TOKEN"
+)
+
+func authReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Reconcile stored WebAuthn credentials with on-chain DID documents",
+		Long: `Compare the WebAuthn credentials in the local database with the
+verification methods of each account's DID document and report mismatches.
+
+With --apply, revoked-on-chain credentials are revoked locally and orphaned
+verification methods are queued as unsigned removal transactions.
+
+With --interval, reconciliation runs on a schedule until interrupted. Adding
+--admin-addr also serves the admin endpoints on that address, authenticated
+with the bearer token in $` + adminTokenEnv + `.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			apply, _ := cmd.Flags().GetBool(flagApply)
+			interval, _ := cmd.Flags().GetDuration(flagInterval)
+			adminAddr, _ := cmd.Flags().GetString(flagAdminAddr)
+
+			if err := server.InitDB(); err != nil {
+				return err
+			}
+			defer server.CloseDB()
+
+			reconciler := server.NewCredentialReconciler(
+				server.ChainVerificationMethodSource(clientCtx),
+				interval,
+			)
+
+			if interval <= 0 {
+				if adminAddr != "" {
+					return fmt.Errorf("--%s requires --%s", flagAdminAddr, flagInterval)
+				}
+				return runReconcileOnce(cmd, reconciler, apply)
+			}
+
+			return runReconcileScheduled(cmd, reconciler, adminAddr)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagApply, false, "Apply the fix for every discrepancy found")
+	cmd.Flags().Duration(flagInterval, 0, "Run reconciliation on this interval until interrupted")
+	cmd.Flags().String(flagAdminAddr, "", "Serve the reconciliation admin endpoints on this address")
+
+	return cmd
+}
+
+func runReconcileOnce(cmd *cobra.Command, r *server.CredentialReconciler, apply bool) error {
+	report, err := r.Run(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(out))
+
+	if !apply || len(report.Discrepancies) == 0 {
+		return nil
+	}
+	if err := r.Apply(report.Discrepancies); err != nil {
+		return err
+	}
+	cmd.Printf("applied %d fix(es)\n", len(report.Discrepancies))
+	return nil
+}
+
+func runReconcileScheduled(cmd *cobra.Command, r *server.CredentialReconciler, adminAddr string) error {
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	r.Start(ctx)
+
+	if adminAddr != "" {
+		e := echo.New()
+		e.HideBanner = true
+		if err := server.RegisterReconciliationRoutes(e, r, os.Getenv(adminTokenEnv)); err != nil {
+			return fmt.Errorf("%w: set $%s", err, adminTokenEnv)
+		}
+
+		go func() {
+			if err := e.Start(adminAddr); err != nil && err != http.ErrServerClosed {
+				cmd.PrintErrf("reconciliation admin server stopped: %v\n", err)
+				cancel()
+			}
+		}()
+		defer func() {
+			shutdownCtx, done := context.WithTimeout(context.Background(), 5*time.Second)
+			defer done()
+			_ = e.Shutdown(shutdownCtx)
+		}()
+	}
+
+	<-ctx.Done()
+	return nil
+}
@@ -409,9 +409,10 @@ func broadcastWebAuthnCredential(
 		ClientDataJson:    credential.ClientDataJSON,
 		AttestationObject: credential.AttestationObject,
 		// Use the extracted fields from server processing
-		PublicKey: credential.PublicKey,
-		Algorithm: credential.Algorithm,
-		Origin:    credential.Origin,
+		PublicKey:  credential.PublicKey,
+		Algorithm:  credential.Algorithm,
+		Origin:     credential.Origin,
+		Transports: credential.Transports,
 	}
 
 	// Create the registration message
@@ -607,9 +608,10 @@ func broadcastWebAuthnCredentialWithAssertion(
 		ClientDataJson:    credential.ClientDataJSON,
 		AttestationObject: credential.AttestationObject,
 		// Use the extracted fields from server processing
-		PublicKey: credential.PublicKey,
-		Algorithm: credential.Algorithm,
-		Origin:    credential.Origin,
+		PublicKey:  credential.PublicKey,
+		Algorithm:  credential.Algorithm,
+		Origin:     credential.Origin,
+		Transports: credential.Transports,
 	}
 
 	// Create the registration message
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/cobra"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+const (
+	flagSandboxDB   = "db"
+	flagTraceDir    = "trace-dir"
+	flagSandboxAddr = "addr"
+)
+
+func authSandboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sandbox [recording-file...]",
+		Short: "Replay recorded WebAuthn ceremonies without touching real users",
+		Long: `Replay recorded registration and login ceremonies against a sandbox
+credential database. Replays run the same validation as the auth server, but
+usernames are namespaced with "` + server.SandboxNamespace + `" and chain writes are
+validated without being broadcast.
+
+Ceremonies are recorded by the auth server when $` + server.CeremonyRecordDirEnv + ` is set.
+Each replay prints a trace of the validation steps; --trace-dir also writes
+the traces as JSON files.
+
+With --addr, the sandbox is served on that address until interrupted, with
+replay, trace download and reset endpoints under /sandbox authenticated with
+the bearer token in $` + adminTokenEnv + `.`,
+		Example: `  SONR_CEREMONY_RECORD_DIR=./recordings snrd auth register
+  snrd auth sandbox ./recordings/*.json --trace-dir ./traces
+  snrd auth sandbox --addr localhost:8090`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbPath, _ := cmd.Flags().GetString(flagSandboxDB)
+			traceDir, _ := cmd.Flags().GetString(flagTraceDir)
+			addr, _ := cmd.Flags().GetString(flagSandboxAddr)
+
+			if len(args) == 0 && addr == "" {
+				return fmt.Errorf("provide recording files or --%s", flagSandboxAddr)
+			}
+
+			if dbPath == "" {
+				var err error
+				if dbPath, err = server.DefaultSandboxPath(); err != nil {
+					return err
+				}
+			}
+			sandbox, err := server.NewSandbox(dbPath)
+			if err != nil {
+				return err
+			}
+			defer sandbox.Close()
+
+			if err := replayRecordings(cmd, sandbox, args, traceDir); err != nil {
+				return err
+			}
+			if addr == "" {
+				return nil
+			}
+			return serveSandbox(cmd, sandbox, addr)
+		},
+	}
+
+	cmd.Flags().String(flagSandboxDB, "", "Sandbox credential database (default ~/.sonr/sandbox.db)")
+	cmd.Flags().String(flagTraceDir, "", "Write a JSON trace for every replay to this directory")
+	cmd.Flags().String(flagSandboxAddr, "", "Serve the sandbox endpoints on this address")
+
+	return cmd
+}
+
+func replayRecordings(cmd *cobra.Command, s *server.Sandbox, paths []string, traceDir string) error {
+	for _, path := range paths {
+		rec, err := server.ReadCeremonyRecording(path)
+		if err != nil {
+			return err
+		}
+
+		trace := s.Replay(rec)
+		status := "ok"
+		if !trace.OK {
+			status = "FAILED " + trace.Error
+		}
+		cmd.Printf("%s %s %s: %s\n", filepath.Base(path), trace.Ceremony, trace.Username, status)
+		for _, step := range trace.Steps {
+			mark := "✓"
+			if !step.OK {
+				mark = "✗"
+			}
+			cmd.Printf("  %s %s %s\n", mark, step.Name, step.Detail)
+		}
+
+		if traceDir == "" {
+			continue
+		}
+		if err := writeTrace(traceDir, trace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeTrace(dir string, trace *server.Trace) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(trace, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "trace-"+trace.ID+".json"), bz, 0o600)
+}
+
+func serveSandbox(cmd *cobra.Command, s *server.Sandbox, addr string) error {
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	e := echo.New()
+	e.HideBanner = true
+	if err := server.RegisterSandboxRoutes(e, s, os.Getenv(adminTokenEnv)); err != nil {
+		return fmt.Errorf("%w: set $%s", err, adminTokenEnv)
+	}
+
+	go func() {
+		if err := e.Start(addr); err != nil && err != http.ErrServerClosed {
+			cmd.PrintErrf("sandbox server stopped: %v\n", err)
+			cancel()
+		}
+	}()
+
+	<-ctx.Done()
+	shutdownCtx, done := context.WithTimeout(context.Background(), 5*time.Second)
+	defer done()
+	return e.Shutdown(shutdownCtx)
+}
@@ -0,0 +1,63 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// ErrMethodNotSupported is returned for DID URLs of methods other than sonr
+var ErrMethodNotSupported = errors.New("DID method not supported")
+
+// Dereferencer dereferences DID URLs that select a service, such as
+// did:sonr:abc#vault, to the service's endpoint. It is shared by the
+// resolver endpoint and by clients following did: URIs, e.g. the IPFS
+// gateway client fetching an app manifest icon.
+type Dereferencer struct {
+	client types.QueryClient
+}
+
+// NewDereferencer creates a dereferencer resolving DIDs through client
+func NewDereferencer(client types.QueryClient) *Dereferencer {
+	return &Dereferencer{client: client}
+}
+
+// Dereference returns the endpoint URL the DID URL selects. Services of
+// deactivated DIDs are not dereferenced.
+func (d *Dereferencer) Dereference(ctx context.Context, didURL string) (string, error) {
+	u, err := types.ParseDIDURL(didURL)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(u.DID, types.DIDMethodPrefix) {
+		return "", ErrMethodNotSupported
+	}
+
+	res, err := d.client.ResolveDID(ctx, &types.QueryResolveDIDRequest{Did: u.DID})
+	if err != nil {
+		return "", err
+	}
+	doc := res.DidDocument
+	if doc == nil {
+		return "", types.ErrDIDNotFound.Wrap(u.DID)
+	}
+	if doc.Deactivated {
+		return "", types.ErrDIDDeactivated.Wrap(u.DID)
+	}
+	return u.DereferenceService(doc)
+}
+
+// dereference redirects a DID URL to the endpoint of the service it
+// selects, as DID Resolution dereferences services
+func (h *Handler) dereference(w http.ResponseWriter, r *http.Request, didURL string) {
+	endpoint, err := NewDereferencer(h.client).Dereference(r.Context(), didURL)
+	if err != nil {
+		code, statusCode := classify(err)
+		fail(w, statusCode, code, err.Error())
+		return
+	}
+	http.Redirect(w, r, endpoint, http.StatusSeeOther)
+}
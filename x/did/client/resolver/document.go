@@ -0,0 +1,171 @@
+package resolver
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// DIDContext is the JSON-LD context of DID Core documents
+const DIDContext = "https://www.w3.org/ns/did/v1"
+
+// Document is a DID document in the DID Core JSON representation
+type Document struct {
+	Context              []string             `json:"@context,omitempty"`
+	ID                   string               `json:"id"`
+	Controller           string               `json:"controller,omitempty"`
+	AlsoKnownAs          []string             `json:"alsoKnownAs,omitempty"`
+	VerificationMethod   []VerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication       []any                `json:"authentication,omitempty"`
+	AssertionMethod      []any                `json:"assertionMethod,omitempty"`
+	KeyAgreement         []any                `json:"keyAgreement,omitempty"`
+	CapabilityInvocation []any                `json:"capabilityInvocation,omitempty"`
+	CapabilityDelegation []any                `json:"capabilityDelegation,omitempty"`
+	Service              []Service            `json:"service,omitempty"`
+}
+
+// VerificationMethod is a verification method in the DID Core JSON
+// representation. Legacy key encodings are kept under their registry names.
+type VerificationMethod struct {
+	ID                  string          `json:"id"`
+	Type                string          `json:"type"`
+	Controller          string          `json:"controller"`
+	PublicKeyJwk        json.RawMessage `json:"publicKeyJwk,omitempty"`
+	PublicKeyMultibase  string          `json:"publicKeyMultibase,omitempty"`
+	PublicKeyBase58     string          `json:"publicKeyBase58,omitempty"`
+	PublicKeyBase64     string          `json:"publicKeyBase64,omitempty"`
+	PublicKeyPem        string          `json:"publicKeyPem,omitempty"`
+	PublicKeyHex        string          `json:"publicKeyHex,omitempty"`
+	BlockchainAccountID string          `json:"blockchainAccountId,omitempty"`
+}
+
+// Service is a service endpoint in the DID Core JSON representation. Its
+// endpoint is a URL, a list of URLs or a map, as stored.
+type Service struct {
+	ID              string            `json:"id"`
+	Type            string            `json:"type"`
+	ServiceEndpoint any               `json:"serviceEndpoint"`
+	Properties      map[string]string `json:"properties,omitempty"`
+}
+
+// DocumentMetadata is the DID Core didDocumentMetadata. Times are RFC 3339.
+type DocumentMetadata struct {
+	Created       string   `json:"created,omitempty"`
+	Updated       string   `json:"updated,omitempty"`
+	Deactivated   bool     `json:"deactivated,omitempty"`
+	NextUpdate    string   `json:"nextUpdate,omitempty"`
+	VersionID     string   `json:"versionId,omitempty"`
+	NextVersionID string   `json:"nextVersionId,omitempty"`
+	EquivalentID  []string `json:"equivalentId,omitempty"`
+	CanonicalID   string   `json:"canonicalId,omitempty"`
+}
+
+// NewDocument renders a stored DID document. The @context is only set for
+// JSON-LD representations.
+func NewDocument(doc *types.DIDDocument, jsonld bool) *Document {
+	out := &Document{
+		ID:                   doc.Id,
+		Controller:           doc.PrimaryController,
+		AlsoKnownAs:          doc.AlsoKnownAs,
+		Authentication:       newRelationship(doc.Authentication),
+		AssertionMethod:      newRelationship(doc.AssertionMethod),
+		KeyAgreement:         newRelationship(doc.KeyAgreement),
+		CapabilityInvocation: newRelationship(doc.CapabilityInvocation),
+		CapabilityDelegation: newRelationship(doc.CapabilityDelegation),
+	}
+	if jsonld {
+		out.Context = []string{DIDContext}
+	}
+	for _, vm := range doc.VerificationMethod {
+		if vm != nil {
+			out.VerificationMethod = append(out.VerificationMethod, newVerificationMethod(vm))
+		}
+	}
+	for _, svc := range doc.Service {
+		if svc != nil {
+			out.Service = append(out.Service, newService(svc))
+		}
+	}
+	return out
+}
+
+func newVerificationMethod(vm *types.VerificationMethod) VerificationMethod {
+	out := VerificationMethod{
+		ID:                  vm.Id,
+		Type:                vm.VerificationMethodKind,
+		Controller:          vm.Controller,
+		PublicKeyMultibase:  vm.PublicKeyMultibase,
+		PublicKeyBase58:     vm.PublicKeyBase58,
+		PublicKeyBase64:     vm.PublicKeyBase64,
+		PublicKeyPem:        vm.PublicKeyPem,
+		PublicKeyHex:        vm.PublicKeyHex,
+		BlockchainAccountID: vm.BlockchainAccountId,
+	}
+	if vm.PublicKeyJwk != "" && json.Valid([]byte(vm.PublicKeyJwk)) {
+		out.PublicKeyJwk = json.RawMessage(vm.PublicKeyJwk)
+	}
+	return out
+}
+
+// newRelationship renders verification relationships, each either a
+// reference to a verification method or an embedded one
+func newRelationship(refs []*types.VerificationMethodReference) []any {
+	var out []any
+	for _, ref := range refs {
+		switch {
+		case ref == nil:
+		case ref.EmbeddedVerificationMethod != nil:
+			out = append(out, newVerificationMethod(ref.EmbeddedVerificationMethod))
+		case ref.VerificationMethodId != "":
+			out = append(out, ref.VerificationMethodId)
+		}
+	}
+	return out
+}
+
+func newService(svc *types.Service) Service {
+	out := Service{
+		ID:         svc.Id,
+		Type:       svc.ServiceKind,
+		Properties: svc.Properties,
+	}
+	switch {
+	case svc.SingleEndpoint != "":
+		out.ServiceEndpoint = svc.SingleEndpoint
+	case svc.MultipleEndpoints != nil:
+		out.ServiceEndpoint = svc.MultipleEndpoints.Endpoints
+	case len(svc.ComplexEndpoint) > 0 && json.Valid(svc.ComplexEndpoint):
+		out.ServiceEndpoint = json.RawMessage(svc.ComplexEndpoint)
+	default:
+		out.ServiceEndpoint = ""
+	}
+	return out
+}
+
+// NewDocumentMetadata renders stored document metadata. A deactivated
+// document is reported deactivated even if its metadata predates statuses.
+func NewDocumentMetadata(doc *types.DIDDocument, md *types.DIDDocumentMetadata) DocumentMetadata {
+	var out DocumentMetadata
+	if md != nil {
+		out = DocumentMetadata{
+			Created:       formatTime(md.Created),
+			Updated:       formatTime(md.Updated),
+			NextUpdate:    formatTime(md.NextUpdate),
+			VersionID:     md.VersionId,
+			NextVersionID: md.NextVersionId,
+			EquivalentID:  md.EquivalentId,
+			CanonicalID:   md.CanonicalId,
+		}
+	}
+	out.Deactivated = types.StatusOf(doc) == types.DIDStatus_DID_STATUS_DEACTIVATED
+	return out
+}
+
+// formatTime formats unix seconds as RFC 3339, or returns "" for zero
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,228 @@
+// Package resolver serves DID resolution over HTTP in the shape of the
+// Universal Resolver driver interface: GET /1.0/identifiers/{did} returns
+// the DID document with its resolution and document metadata.
+package resolver
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// PathPrefix is the path the resolver is served under, followed by the DID
+const PathPrefix = "/1.0/identifiers/"
+
+// Media types of resolution results and DID document representations
+const (
+	ContentTypeResolution = `application/ld+json;profile="https://w3id.org/did-resolution"`
+	ContentTypeDIDLDJSON  = "application/did+ld+json"
+	ContentTypeDIDJSON    = "application/did+json"
+
+	// ResolutionContext is the JSON-LD context of resolution results
+	ResolutionContext = "https://w3id.org/did-resolution/v1"
+)
+
+// Resolution error codes from the DID Resolution specification
+const (
+	ErrorInvalidDID                 = "invalidDid"
+	ErrorNotFound                   = "notFound"
+	ErrorRepresentationNotSupported = "representationNotSupported"
+	ErrorMethodNotSupported         = "methodNotSupported"
+	ErrorInvalidOptions             = "invalidOptions"
+	ErrorInternal                   = "internalError"
+)
+
+// Result is a DID resolution result
+type Result struct {
+	Context               string             `json:"@context"`
+	DIDDocument           *Document          `json:"didDocument"`
+	DIDResolutionMetadata ResolutionMetadata `json:"didResolutionMetadata"`
+	DIDDocumentMetadata   DocumentMetadata   `json:"didDocumentMetadata"`
+}
+
+// ResolutionMetadata is the didResolutionMetadata of a result
+type ResolutionMetadata struct {
+	ContentType  string `json:"contentType,omitempty"`
+	Error        string `json:"error,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// Handler resolves did:sonr DIDs through the did module's query service
+type Handler struct {
+	client types.QueryClient
+}
+
+// NewHandler creates a resolver querying client
+func NewHandler(client types.QueryClient) *Handler {
+	return &Handler{client: client}
+}
+
+// ServeHTTP resolves the DID at the end of the path. An escaped DID URL
+// selecting a service is dereferenced instead, redirecting to the service
+// endpoint. The versionId and versionTime query parameters resolve an
+// earlier version of the document.
+// The Accept header selects a full resolution result (the default) or the
+// bare document as application/did+ld+json or application/did+json.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	did, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), PathPrefix))
+	if err == nil && strings.ContainsAny(did, "/?#") {
+		// An escaped DID URL, e.g. did:sonr:abc%23vault, selects a service
+		h.dereference(w, r, did)
+		return
+	}
+
+	contentType, ok := negotiate(r.Header.Get("Accept"))
+	if !ok {
+		fail(w, http.StatusNotAcceptable, ErrorRepresentationNotSupported,
+			"supported representations are "+ContentTypeResolution+", "+ContentTypeDIDLDJSON+" and "+ContentTypeDIDJSON)
+		return
+	}
+	if err != nil || !types.IsValidDIDSyntax(did) {
+		fail(w, http.StatusBadRequest, ErrorInvalidDID, "not a valid DID")
+		return
+	}
+	if !strings.HasPrefix(did, types.DIDMethodPrefix) {
+		method := strings.SplitN(did, ":", 3)[1]
+		fail(w, http.StatusNotImplemented, ErrorMethodNotSupported, "method "+method+" is not supported")
+		return
+	}
+
+	doc, md, err := h.resolve(r, did)
+	if err == nil && doc == nil {
+		err = types.ErrDIDNotFound.Wrap(did)
+	}
+	if err != nil {
+		code, statusCode := classify(err)
+		fail(w, statusCode, code, err.Error())
+		return
+	}
+
+	// Deactivated DIDs still resolve, with 410 Gone as the universal
+	// resolver reports them
+	statusCode := http.StatusOK
+	if doc.Deactivated {
+		statusCode = http.StatusGone
+	}
+
+	if contentType != ContentTypeResolution {
+		writeJSON(w, contentType, statusCode, NewDocument(doc, contentType == ContentTypeDIDLDJSON))
+		return
+	}
+	writeJSON(w, contentType, statusCode, Result{
+		Context:               ResolutionContext,
+		DIDDocument:           NewDocument(doc, true),
+		DIDResolutionMetadata: ResolutionMetadata{ContentType: ContentTypeDIDLDJSON},
+		DIDDocumentMetadata:   NewDocumentMetadata(doc, md),
+	})
+}
+
+// resolve queries the current document, or the version selected by the
+// versionId or versionTime parameter
+func (h *Handler) resolve(r *http.Request, did string) (*types.DIDDocument, *types.DIDDocumentMetadata, error) {
+	query := r.URL.Query()
+	versionID, versionTime := query.Get("versionId"), query.Get("versionTime")
+	if versionID == "" && versionTime == "" {
+		res, err := h.client.ResolveDID(r.Context(), &types.QueryResolveDIDRequest{Did: did})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.DidDocument, res.DidDocumentMetadata, nil
+	}
+
+	req := &types.QueryGetDIDDocumentVersionRequest{Did: did, VersionTime: versionTime}
+	if versionID != "" {
+		id, err := strconv.ParseUint(versionID, 10, 64)
+		if err != nil {
+			return nil, nil, types.ErrInvalidRequest.Wrapf("invalid versionId %q", versionID)
+		}
+		req.VersionId = id
+	}
+	res, err := h.client.GetDIDDocumentVersion(r.Context(), req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.DidDocument, res.DidDocumentMetadata, nil
+}
+
+// fail writes a result carrying only resolution metadata with an error.
+// Errors are always a resolution result, as a bare document representation
+// has nowhere to put them.
+func fail(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, ContentTypeResolution, statusCode, Result{
+		Context:               ResolutionContext,
+		DIDResolutionMetadata: ResolutionMetadata{Error: code, ErrorMessage: message},
+	})
+}
+
+// classify maps a query error to a resolution error code and HTTP status
+func classify(err error) (string, int) {
+	switch {
+	case errors.Is(err, types.ErrDIDNotFound), errors.Is(err, types.ErrDIDVersionNotFound):
+		return ErrorNotFound, http.StatusNotFound
+	case errors.Is(err, types.ErrInvalidRequest):
+		return ErrorInvalidOptions, http.StatusBadRequest
+	case errors.Is(err, types.ErrEmptyDID), errors.Is(err, types.ErrInvalidDIDURL):
+		return ErrorInvalidDID, http.StatusBadRequest
+	case errors.Is(err, types.ErrServiceNotFound):
+		return ErrorNotFound, http.StatusNotFound
+	case errors.Is(err, types.ErrDIDDeactivated):
+		return ErrorNotFound, http.StatusGone
+	case errors.Is(err, ErrMethodNotSupported):
+		return ErrorMethodNotSupported, http.StatusNotImplemented
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return ErrorNotFound, http.StatusNotFound
+	case codes.InvalidArgument:
+		return ErrorInvalidOptions, http.StatusBadRequest
+	}
+	return ErrorInternal, http.StatusInternalServerError
+}
+
+// negotiate picks the representation for an Accept header. Media ranges are
+// taken in order; quality values are not weighed.
+func negotiate(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return ContentTypeResolution, true
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "application/ld+json":
+			if params["profile"] == "https://w3id.org/did-resolution" {
+				return ContentTypeResolution, true
+			}
+		case ContentTypeDIDLDJSON:
+			return ContentTypeDIDLDJSON, true
+		case ContentTypeDIDJSON:
+			return ContentTypeDIDJSON, true
+		case "application/json", "application/*", "*/*":
+			return ContentTypeResolution, true
+		}
+	}
+	return "", false
+}
+
+func writeJSON(w http.ResponseWriter, contentType string, statusCode int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,172 @@
+package resolver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/sonr-io/sonr/x/did/client/resolver"
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// fakeQueryClient serves DID documents from a map
+type fakeQueryClient struct {
+	types.QueryClient
+	docs map[string]*types.DIDDocument
+}
+
+func (c fakeQueryClient) ResolveDID(_ context.Context, req *types.QueryResolveDIDRequest, _ ...grpc.CallOption) (*types.QueryResolveDIDResponse, error) {
+	doc, ok := c.docs[req.Did]
+	if !ok {
+		return nil, types.ErrDIDNotFound.Wrap(req.Did)
+	}
+	return &types.QueryResolveDIDResponse{
+		DidDocument:         doc,
+		DidDocumentMetadata: &types.DIDDocumentMetadata{Did: req.Did, Created: 1700000000, VersionId: "1"},
+	}, nil
+}
+
+func (c fakeQueryClient) GetDIDDocumentVersion(_ context.Context, req *types.QueryGetDIDDocumentVersionRequest, _ ...grpc.CallOption) (*types.QueryGetDIDDocumentVersionResponse, error) {
+	if req.VersionId != 1 {
+		return nil, types.ErrDIDVersionNotFound.Wrapf("%s version %d", req.Did, req.VersionId)
+	}
+	return &types.QueryGetDIDDocumentVersionResponse{
+		DidDocument:         c.docs[req.Did],
+		DidDocumentMetadata: &types.DIDDocumentMetadata{Did: req.Did, VersionId: "1"},
+	}, nil
+}
+
+func TestResolver(t *testing.T) {
+	alice := &types.DIDDocument{
+		Id:                "did:sonr:alice",
+		PrimaryController: "did:sonr:alice",
+		VerificationMethod: []*types.VerificationMethod{{
+			Id:                     "did:sonr:alice#key-1",
+			VerificationMethodKind: "JsonWebKey2020",
+			Controller:             "did:sonr:alice",
+			PublicKeyJwk:           `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`,
+		}},
+		Authentication: []*types.VerificationMethodReference{{VerificationMethodId: "did:sonr:alice#key-1"}},
+		Service: []*types.Service{{
+			Id:             "did:sonr:alice#dwn",
+			ServiceKind:    "DecentralizedWebNode",
+			SingleEndpoint: "https://dwn.example.com",
+		}},
+	}
+	bob := &types.DIDDocument{Id: "did:sonr:bob", Deactivated: true}
+
+	handler := resolver.NewHandler(fakeQueryClient{docs: map[string]*types.DIDDocument{
+		alice.Id: alice,
+		bob.Id:   bob,
+	}})
+
+	get := func(path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("resolution result", func(t *testing.T) {
+		rec := get("/1.0/identifiers/did:sonr:alice", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, resolver.ContentTypeResolution, rec.Header().Get("Content-Type"))
+
+		var res struct {
+			DIDDocument           map[string]any              `json:"didDocument"`
+			DIDResolutionMetadata resolver.ResolutionMetadata `json:"didResolutionMetadata"`
+			DIDDocumentMetadata   resolver.DocumentMetadata   `json:"didDocumentMetadata"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, resolver.ContentTypeDIDLDJSON, res.DIDResolutionMetadata.ContentType)
+		require.Empty(t, res.DIDResolutionMetadata.Error)
+		require.Equal(t, "did:sonr:alice", res.DIDDocument["id"])
+		require.Equal(t, []any{resolver.DIDContext}, res.DIDDocument["@context"])
+		require.Equal(t, []any{"did:sonr:alice#key-1"}, res.DIDDocument["authentication"])
+		vm := res.DIDDocument["verificationMethod"].([]any)[0].(map[string]any)
+		require.Equal(t, "OKP", vm["publicKeyJwk"].(map[string]any)["kty"])
+		svc := res.DIDDocument["service"].([]any)[0].(map[string]any)
+		require.Equal(t, "https://dwn.example.com", svc["serviceEndpoint"])
+		require.Equal(t, "2023-11-14T22:13:20Z", res.DIDDocumentMetadata.Created)
+		require.Equal(t, "1", res.DIDDocumentMetadata.VersionID)
+	})
+
+	t.Run("document representations", func(t *testing.T) {
+		rec := get("/1.0/identifiers/did:sonr:alice", resolver.ContentTypeDIDJSON)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, resolver.ContentTypeDIDJSON, rec.Header().Get("Content-Type"))
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		require.Equal(t, "did:sonr:alice", doc["id"])
+		require.NotContains(t, doc, "@context")
+
+		rec = get("/1.0/identifiers/did:sonr:alice", "application/did+ld+json")
+		require.Equal(t, resolver.ContentTypeDIDLDJSON, rec.Header().Get("Content-Type"))
+		doc = nil
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		require.Contains(t, doc, "@context")
+
+		rec = get("/1.0/identifiers/did:sonr:alice", "text/html")
+		require.Equal(t, http.StatusNotAcceptable, rec.Code)
+		require.Contains(t, rec.Body.String(), resolver.ErrorRepresentationNotSupported)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, get("/1.0/identifiers/did:sonr:alice?versionId=1", "").Code)
+		require.Equal(t, http.StatusNotFound, get("/1.0/identifiers/did:sonr:alice?versionId=2", "").Code)
+		require.Equal(t, http.StatusBadRequest, get("/1.0/identifiers/did:sonr:alice?versionId=x", "").Code)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			path   string
+			status int
+			code   string
+		}{
+			{"/1.0/identifiers/did:sonr:carol", http.StatusNotFound, resolver.ErrorNotFound},
+			{"/1.0/identifiers/not-a-did", http.StatusBadRequest, resolver.ErrorInvalidDID},
+			{"/1.0/identifiers/did:sonr:", http.StatusBadRequest, resolver.ErrorInvalidDID},
+			{"/1.0/identifiers/did:web:example.com", http.StatusNotImplemented, resolver.ErrorMethodNotSupported},
+		} {
+			rec := get(tc.path, "")
+			require.Equal(t, tc.status, rec.Code, tc.path)
+
+			var res resolver.Result
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, tc.code, res.DIDResolutionMetadata.Error, tc.path)
+			require.Nil(t, res.DIDDocument)
+		}
+	})
+
+	t.Run("deactivated", func(t *testing.T) {
+		rec := get("/1.0/identifiers/did%3Asonr%3Abob", "")
+		require.Equal(t, http.StatusGone, rec.Code)
+
+		var res resolver.Result
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.DIDDocumentMetadata.Deactivated)
+		require.Equal(t, "did:sonr:bob", res.DIDDocument.ID)
+	})
+
+	t.Run("service dereferencing", func(t *testing.T) {
+		rec := get("/1.0/identifiers/did:sonr:alice%23dwn", "text/html")
+		require.Equal(t, http.StatusSeeOther, rec.Code)
+		require.Equal(t, "https://dwn.example.com", rec.Header().Get("Location"))
+
+		rec = get("/1.0/identifiers/did:sonr:alice%3Fservice%3Ddwn%26relativeRef%3D%2Fprofile", "")
+		require.Equal(t, http.StatusSeeOther, rec.Code)
+		require.Equal(t, "https://dwn.example.com/profile", rec.Header().Get("Location"))
+
+		require.Equal(t, http.StatusNotFound, get("/1.0/identifiers/did:sonr:alice%23vault", "").Code)
+		require.Equal(t, http.StatusGone, get("/1.0/identifiers/did:sonr:bob%23dwn", "").Code)
+		require.Equal(t, http.StatusNotImplemented, get("/1.0/identifiers/did:web:example.com%23dwn", "").Code)
+	})
+}
@@ -0,0 +1,48 @@
+//go:build chaos
+
+package server_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/app/chaos"
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+func TestSandboxReplaySurvivesDBReset(t *testing.T) {
+	chaos.Reset()
+	t.Cleanup(chaos.Reset)
+	s := newTestSandbox(t)
+
+	response, err := json.Marshal(map[string]any{
+		"id": "cred-1",
+		"response": map[string]string{
+			"clientDataJSON": clientData(t, "webauthn.get", "expected"),
+		},
+	})
+	require.NoError(t, err)
+	replay := func() *server.Trace {
+		return s.Replay(server.CeremonyRecording{
+			ID:        "rec-1",
+			Ceremony:  server.CeremonyAuthentication,
+			Username:  "alice",
+			Challenge: "expected",
+			Response:  response,
+		})
+	}
+
+	// A reset connection fails the lookup step and is reported in the trace
+	chaos.Inject(chaos.DBReset, 1)
+	trace := replay()
+	require.False(t, trace.OK)
+	require.Equal(t, []string{"decode_response", "verify_client_data", "lookup_credential"}, stepNames(trace))
+	require.Contains(t, trace.Error, string(chaos.DBReset))
+
+	// The sandbox keeps working once the database is back
+	trace = replay()
+	require.False(t, trace.OK)
+	require.Contains(t, trace.Error, "not registered in sandbox")
+}
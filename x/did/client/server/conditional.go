@@ -0,0 +1,210 @@
+package server
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// MediationConditional is the credential mediation requirement browsers use
+// to offer passkeys in the autofill menu of a username field
+const MediationConditional = "conditional"
+
+// maxPendingConditionalLogins bounds the autofill challenges waiting for an
+// assertion. Autofill logins start before the user is known, so anyone can
+// open one.
+const maxPendingConditionalLogins = 256
+
+// conditionalSessionPrefix prefixes session store keys of autofill logins,
+// which are keyed by challenge as they have no username
+const conditionalSessionPrefix = "conditional:"
+
+// MediationHints tells a login page how to offer passkeys in the browser's
+// autofill: the username field gets Autocomplete as its autocomplete
+// attribute, and the page fetches assertion options from OptionsURL and
+// passes them to navigator.credentials.get with the given mediation.
+type MediationHints struct {
+	Mediation    string `json:"mediation"`
+	Autocomplete string `json:"autocomplete"`
+	OptionsURL   string `json:"optionsUrl"`
+	FinishURL    string `json:"finishUrl"`
+	RPID         string `json:"rpId"`
+}
+
+// HandleMediationHints serves the hints a login page needs for autofill login
+func HandleMediationHints(c echo.Context) error {
+	return c.JSON(http.StatusOK, MediationHints{
+		Mediation:    MediationConditional,
+		Autocomplete: "username webauthn",
+		OptionsURL:   "/begin-login?mediation=" + MediationConditional,
+		FinishURL:    "/finish-login",
+		RPID:         "localhost",
+	})
+}
+
+// beginConditionalLogin issues discoverable-credential-only assertion options
+// for an autofill login. The challenge is not tied to a user; the assertion
+// names the user through the credential it was made with.
+func beginConditionalLogin(c echo.Context) error {
+	// Autofill prompts may stay open indefinitely, so they are not counted as
+	// in-flight ceremonies; a draining server only stops issuing them
+	if draining() {
+		c.Response().Header().Set("Retry-After", "30")
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": ErrDraining.Error()})
+	}
+	if authServer == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Auth server not running"})
+	}
+	if authServer.sessionStore == nil {
+		authServer.sessionStore = make(map[string]string)
+	}
+	if pendingConditionalLogins() >= maxPendingConditionalLogins {
+		c.Response().Header().Set("Retry-After", "30")
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Too many pending autofill logins"})
+	}
+
+	challenge, err := generateChallenge()
+	if err != nil {
+		logger.Error("Failed to generate challenge", "error", err)
+		return c.JSON(
+			http.StatusInternalServerError,
+			map[string]string{"error": "Failed to generate challenge"},
+		)
+	}
+	authServer.sessionStore[conditionalSessionPrefix+challenge] = challenge
+
+	logger.Info("Sending autofill authentication options", "challenge", challenge)
+	return c.JSON(http.StatusOK, map[string]any{
+		"mediation": MediationConditional,
+		"publicKey": assertionOptions(challenge, nil),
+	})
+}
+
+// finishConditionalLogin completes an autofill login. The challenge must be
+// one issued by beginConditionalLogin, and the user is the owner of the
+// credential that answered it.
+func finishConditionalLogin(c echo.Context) error {
+	var authResponse map[string]any
+	if err := c.Bind(&authResponse); err != nil {
+		logger.Error("Failed to parse authentication response", "error", err)
+		return c.JSON(
+			http.StatusBadRequest,
+			map[string]string{"error": "Invalid authentication response"},
+		)
+	}
+
+	credentialID, _ := authResponse["id"].(string)
+	response, _ := authResponse["response"].(map[string]any)
+	clientDataJSON, _ := response["clientDataJSON"].(string)
+	if credentialID == "" || clientDataJSON == "" {
+		return c.JSON(
+			http.StatusBadRequest,
+			map[string]string{"error": "Invalid authentication response"},
+		)
+	}
+
+	clientData, err := didtypes.ValidateClientDataJSONFormat(clientDataJSON)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid client data JSON"})
+	}
+	key := conditionalSessionPrefix + clientData.Challenge
+	var storedChallenge string
+	if authServer != nil && authServer.sessionStore != nil {
+		storedChallenge = authServer.sessionStore[key]
+	}
+	if storedChallenge == "" {
+		return c.JSON(
+			http.StatusBadRequest,
+			map[string]string{"error": "No pending autofill login for this challenge"},
+		)
+	}
+	if err := verifyClientDataForAuthentication(clientDataJSON, storedChallenge); err != nil {
+		logger.Error("Client data verification failed for authentication", "error", err)
+		return c.JSON(
+			http.StatusBadRequest,
+			map[string]string{"error": "Authentication verification failed"},
+		)
+	}
+
+	credential, err := NewWebAuthnCredentialService().GetByCredentialID(credentialID)
+	if err != nil {
+		logger.Error("Credential not found", "error", err, "credentialID", credentialID)
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Credential not found"})
+	}
+
+	// A discoverable credential returns the user ID it was registered with
+	if userHandle, _ := response["userHandle"].(string); userHandle != "" &&
+		!userHandleMatches(userHandle, credential.Username) {
+		return c.JSON(
+			http.StatusUnauthorized,
+			map[string]string{"error": "Credential does not belong to this user"},
+		)
+	}
+
+	recordCeremony(CeremonyAuthentication, credential.Username, storedChallenge, authResponse)
+	delete(authServer.sessionStore, key)
+	signalLoginDone(credential.Username)
+
+	logger.Info(
+		"WebAuthn autofill authentication completed successfully",
+		"username",
+		credential.Username,
+		"credentialID",
+		credentialID,
+	)
+	return c.JSON(http.StatusOK, map[string]any{
+		"success":      true,
+		"message":      "Authentication completed successfully",
+		"credentialId": credentialID,
+		"username":     credential.Username,
+	})
+}
+
+// pendingConditionalLogins counts the autofill challenges awaiting an
+// assertion
+func pendingConditionalLogins() int {
+	n := 0
+	for key := range authServer.sessionStore {
+		if strings.HasPrefix(key, conditionalSessionPrefix) {
+			n++
+		}
+	}
+	return n
+}
+
+// userHandleMatches reports whether a userHandle returned by the
+// authenticator is the user ID registered for username, which
+// baseRegisterOptions sets to the base64url encoded username
+func userHandleMatches(userHandle, username string) bool {
+	for _, enc := range []*base64.Encoding{base64.RawURLEncoding, base64.URLEncoding} {
+		if raw, err := enc.DecodeString(userHandle); err == nil {
+			// Clients may pass the user ID through unchanged
+			if string(raw) == username {
+				return true
+			}
+			if nested, err := base64.URLEncoding.DecodeString(string(raw)); err == nil && string(nested) == username {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// signalLoginDone signals a completed login to the waiting CLI
+func signalLoginDone(username string) {
+	if authServer != nil && authServer.registrationDone != nil {
+		select {
+		case authServer.registrationDone <- nil:
+			logger.Info("Authentication completion signaled to CLI", "username", username)
+		default:
+			logger.Warn(
+				"Failed to signal authentication completion - channel full",
+				"username",
+				username,
+			)
+		}
+	}
+}
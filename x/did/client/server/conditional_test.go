@@ -0,0 +1,45 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+func TestMediationHints(t *testing.T) {
+	e := echo.New()
+	e.GET("/login/mediation", server.HandleMediationHints)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/login/mediation", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var hints server.MediationHints
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &hints))
+	require.Equal(t, server.MediationConditional, hints.Mediation)
+	require.Equal(t, "username webauthn", hints.Autocomplete)
+	require.Equal(t, "/begin-login?mediation=conditional", hints.OptionsURL)
+	require.Equal(t, "/finish-login", hints.FinishURL)
+}
+
+func TestConditionalLoginWithoutPendingChallenge(t *testing.T) {
+	e := echo.New()
+	e.POST("/finish-login", server.HandleFinishLogin)
+
+	// clientDataJSON for a challenge that was never issued
+	clientData := `eyJ0eXBlIjoid2ViYXV0aG4uZ2V0IiwiY2hhbGxlbmdlIjoiYWJjIiwib3JpZ2luIjoiaHR0cDovL2xvY2FsaG9zdCJ9`
+	body := `{"id":"cred","response":{"clientDataJSON":"` + clientData + `"}}`
+	req := httptest.NewRequest(http.MethodPost, "/finish-login", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "No pending autofill login")
+}
@@ -1,27 +1,24 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var db *gorm.DB
 
 // InitDB initializes the SQLite database connection
 func InitDB() error {
-	// Create ~/.sonr directory if it doesn't exist
-	homeDir, err := os.UserHomeDir()
+	sonrDir, err := sonrHomeDir()
 	if err != nil {
-		return fmt.Errorf("failed to get user home directory: %w", err)
-	}
-
-	sonrDir := filepath.Join(homeDir, ".sonr")
-	if mkdirErr := os.MkdirAll(sonrDir, 0o750); mkdirErr != nil {
-		return fmt.Errorf("failed to create .sonr directory: %w", mkdirErr)
+		return err
 	}
 
 	// Database file path
@@ -34,6 +31,9 @@ func InitDB() error {
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
+	if err := injectDBFaults(db); err != nil {
+		return err
+	}
 
 	// Auto-migrate all models
 	err = db.AutoMigrate(
@@ -50,6 +50,20 @@ func InitDB() error {
 	return nil
 }
 
+// sonrHomeDir returns ~/.sonr, creating it if it doesn't exist
+func sonrHomeDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+
+	sonrDir := filepath.Join(homeDir, ".sonr")
+	if err := os.MkdirAll(sonrDir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create .sonr directory: %w", err)
+	}
+	return sonrDir, nil
+}
+
 // GetDB returns the database instance
 func GetDB() *gorm.DB {
 	return db
@@ -77,42 +91,82 @@ func NewWebAuthnCredentialService() *WebAuthnCredentialService {
 	return &WebAuthnCredentialService{}
 }
 
-// Store saves a WebAuthn credential to the database
+// Store saves a WebAuthn credential to the database. A revoked credential
+// with the same ID is reactivated in place so it can be registered again.
 func (s *WebAuthnCredentialService) Store(credential *StoredWebAuthnCredential) error {
-	return db.Create(credential).Error
+	var existing StoredWebAuthnCredential
+	err := db.Where("credential_id = ? AND revoked_at IS NOT NULL", credential.CredentialID).
+		First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return db.Create(credential).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	credential.ID = existing.ID
+	credential.CreatedAt = existing.CreatedAt
+	credential.RevokedAt = nil
+	return db.Save(credential).Error
 }
 
-// GetByCredentialID retrieves a credential by its ID
+// GetByCredentialID retrieves an active credential by its ID
 func (s *WebAuthnCredentialService) GetByCredentialID(
 	credentialID string,
 ) (*StoredWebAuthnCredential, error) {
 	var credential StoredWebAuthnCredential
-	err := db.Where("credential_id = ?", credentialID).First(&credential).Error
+	err := db.Where("credential_id = ? AND revoked_at IS NULL", credentialID).
+		First(&credential).Error
 	if err != nil {
 		return nil, err
 	}
 	return &credential, nil
 }
 
-// GetByUsername retrieves all credentials for a username
+// GetByUsername retrieves all active credentials for a username
 func (s *WebAuthnCredentialService) GetByUsername(
 	username string,
 ) ([]StoredWebAuthnCredential, error) {
 	var credentials []StoredWebAuthnCredential
-	err := db.Where("username = ?", username).Find(&credentials).Error
+	err := db.Where("username = ? AND revoked_at IS NULL", username).Find(&credentials).Error
 	return credentials, err
 }
 
-// UsernameExists checks if a username already has registered WebAuthn credentials
+// UsernameExists checks if a username already has active WebAuthn credentials
 func (s *WebAuthnCredentialService) UsernameExists(username string) (bool, error) {
 	var count int64
-	err := db.Model(&StoredWebAuthnCredential{}).Where("username = ?", username).Count(&count).Error
+	err := db.Model(&StoredWebAuthnCredential{}).
+		Where("username = ? AND revoked_at IS NULL", username).
+		Count(&count).Error
 	if err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
 
+// Revoke marks a credential as revoked without removing its record
+func (s *WebAuthnCredentialService) Revoke(credentialID string) error {
+	return db.Model(&StoredWebAuthnCredential{}).
+		Where("credential_id = ? AND revoked_at IS NULL", credentialID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// Restore clears the revocation marker of a credential
+func (s *WebAuthnCredentialService) Restore(credentialID string) error {
+	return db.Model(&StoredWebAuthnCredential{}).
+		Where("credential_id = ?", credentialID).
+		Update("revoked_at", nil).Error
+}
+
+// GetByUsernameWithRevoked retrieves all credentials for a username, including revoked ones
+func (s *WebAuthnCredentialService) GetByUsernameWithRevoked(
+	username string,
+) ([]StoredWebAuthnCredential, error) {
+	var credentials []StoredWebAuthnCredential
+	err := db.Where("username = ?", username).Find(&credentials).Error
+	return credentials, err
+}
+
 // AccountInfoService provides database operations for account information
 type AccountInfoService struct{}
 
@@ -136,6 +190,13 @@ func (s *AccountInfoService) GetByUsername(username string) (*AccountInfo, error
 	return &account, nil
 }
 
+// ListWithDID retrieves all accounts that are bound to a DID
+func (s *AccountInfoService) ListWithDID() ([]AccountInfo, error) {
+	var accounts []AccountInfo
+	err := db.Where("did <> ''").Find(&accounts).Error
+	return accounts, err
+}
+
 // UpdateSequence updates the account sequence number
 func (s *AccountInfoService) UpdateSequence(username string, sequence uint64) error {
 	return db.Model(&AccountInfo{}).
@@ -187,6 +248,15 @@ func (s *UnsignedTransactionService) Store(tx *UnsignedTransaction) error {
 	return db.Create(tx).Error
 }
 
+// Upsert saves an unsigned transaction, replacing the payload and resetting
+// the status of an existing transaction with the same TxID
+func (s *UnsignedTransactionService) Upsert(tx *UnsignedTransaction) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tx_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tx_data", "description", "status", "updated_at"}),
+	}).Create(tx).Error
+}
+
 // GetByTxID retrieves a transaction by its ID
 func (s *UnsignedTransactionService) GetByTxID(txID string) (*UnsignedTransaction, error) {
 	var tx UnsignedTransaction
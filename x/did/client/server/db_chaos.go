@@ -0,0 +1,32 @@
+//go:build chaos
+
+package server
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/sonr-io/sonr/app/chaos"
+)
+
+// injectDBFaults fails every statement on db while DBReset is armed
+func injectDBFaults(db *gorm.DB) error {
+	fail := func(tx *gorm.DB) {
+		if err := chaos.Fail(chaos.DBReset); err != nil {
+			_ = tx.AddError(err)
+		}
+	}
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Create().Before("gorm:create").Register("chaos:create", fail),
+		callbacks.Query().Before("gorm:query").Register("chaos:query", fail),
+		callbacks.Update().Before("gorm:update").Register("chaos:update", fail),
+		callbacks.Delete().Before("gorm:delete").Register("chaos:delete", fail),
+		callbacks.Row().Before("gorm:row").Register("chaos:row", fail),
+		callbacks.Raw().Before("gorm:raw").Register("chaos:raw", fail),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
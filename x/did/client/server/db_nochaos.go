@@ -0,0 +1,9 @@
+//go:build !chaos
+
+package server
+
+import "gorm.io/gorm"
+
+// injectDBFaults does nothing; faults are only injected with the chaos build
+// tag
+func injectDBFaults(*gorm.DB) error { return nil }
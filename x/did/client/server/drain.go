@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// CeremonyTimeout is how long an issued challenge counts as in flight. It
+	// matches the timeout the begin handlers send to the browser, so an
+	// abandoned ceremony cannot hold up a drain for longer.
+	CeremonyTimeout = 60 * time.Second
+
+	// DefaultDrainTimeout bounds how long Stop waits for in-flight ceremonies
+	DefaultDrainTimeout = 30 * time.Second
+
+	// drainPollInterval is how often Wait checks for finished ceremonies
+	drainPollInterval = 100 * time.Millisecond
+)
+
+// ErrDraining is returned when a ceremony is started on a draining server
+var ErrDraining = errors.New("auth server is draining")
+
+// DrainStatus reports whether a server is draining and how many ceremonies
+// it is still waiting for
+type DrainStatus struct {
+	Draining bool `json:"draining"`
+	InFlight int  `json:"in_flight"`
+}
+
+// Drainer tracks in-flight WebAuthn ceremonies. Once draining, it refuses new
+// ceremonies while those already started may finish, so the server can be
+// replaced without failing a user halfway through a ceremony.
+type Drainer struct {
+	mu       sync.Mutex
+	draining bool
+	inFlight map[string]time.Time // username -> challenge expiry
+}
+
+// NewDrainer returns a drainer that accepts ceremonies
+func NewDrainer() *Drainer {
+	return &Drainer{inFlight: make(map[string]time.Time)}
+}
+
+// Begin records a ceremony started for username. It returns ErrDraining once
+// the drainer is draining.
+func (d *Drainer) Begin(username string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return ErrDraining
+	}
+	d.inFlight[username] = time.Now().Add(CeremonyTimeout)
+	return nil
+}
+
+// Finish records that the ceremony of username completed
+func (d *Drainer) Finish(username string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.inFlight, username)
+}
+
+// Drain stops the drainer from accepting new ceremonies
+func (d *Drainer) Drain() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.draining = true
+}
+
+// Draining reports whether new ceremonies are refused
+func (d *Drainer) Draining() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.draining
+}
+
+// Status returns the drain state, dropping ceremonies whose challenge expired
+func (d *Drainer) Status() DrainStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	for username, expiry := range d.inFlight {
+		if now.After(expiry) {
+			delete(d.inFlight, username)
+		}
+	}
+	return DrainStatus{Draining: d.draining, InFlight: len(d.inFlight)}
+}
+
+// Wait blocks until no ceremony is in flight or ctx is done
+func (d *Drainer) Wait(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for d.Status().InFlight > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// RegisterDrainRoutes registers the drain admin endpoints behind a bearer
+// token. The token must not be empty.
+func RegisterDrainRoutes(e *echo.Echo, d *Drainer, adminToken string) error {
+	if adminToken == "" {
+		return errors.New("drain admin token is required")
+	}
+
+	g := e.Group("/admin/drain", requireAdminToken(adminToken))
+	g.GET("", d.HandleDrainStatus)
+	g.POST("", d.HandleDrain)
+	return nil
+}
+
+// HandleDrain starts draining and returns the drain status
+func (d *Drainer) HandleDrain(c echo.Context) error {
+	d.Drain()
+	logger.Info("Draining auth server", "in_flight", d.Status().InFlight)
+	return c.JSON(http.StatusAccepted, d.Status())
+}
+
+// HandleDrainStatus returns the drain status
+func (d *Drainer) HandleDrainStatus(c echo.Context) error {
+	return c.JSON(http.StatusOK, d.Status())
+}
+
+// beginCeremony records a ceremony on the running auth server, if any
+func beginCeremony(username string) error {
+	if authServer == nil || authServer.drainer == nil {
+		return nil
+	}
+	return authServer.drainer.Begin(username)
+}
+
+// finishCeremony records a completed ceremony on the running auth server
+func finishCeremony(username string) {
+	if authServer != nil && authServer.drainer != nil {
+		authServer.drainer.Finish(username)
+	}
+}
+
+// draining reports whether the running auth server is draining
+func draining() bool {
+	return authServer != nil && authServer.drainer != nil && authServer.drainer.Draining()
+}
@@ -0,0 +1,67 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+func TestDrainerRefusesNewCeremonies(t *testing.T) {
+	d := server.NewDrainer()
+	require.NoError(t, d.Begin("alice"))
+	require.Equal(t, server.DrainStatus{InFlight: 1}, d.Status())
+
+	d.Drain()
+	require.ErrorIs(t, d.Begin("bob"), server.ErrDraining)
+	require.Equal(t, server.DrainStatus{Draining: true, InFlight: 1}, d.Status())
+
+	d.Finish("alice")
+	require.Equal(t, server.DrainStatus{Draining: true}, d.Status())
+}
+
+func TestDrainerWaitsForInFlightCeremonies(t *testing.T) {
+	d := server.NewDrainer()
+	require.NoError(t, d.Begin("alice"))
+	d.Drain()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, d.Wait(ctx), context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		d.Finish("alice")
+	}()
+	require.NoError(t, d.Wait(context.Background()))
+}
+
+func TestDrainRoutes(t *testing.T) {
+	d := server.NewDrainer()
+	e := echo.New()
+	require.Error(t, server.RegisterDrainRoutes(e, d, ""))
+	require.NoError(t, server.RegisterDrainRoutes(e, d, "secret"))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/drain", nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.False(t, d.Draining())
+
+	require.NoError(t, d.Begin("alice"))
+	req := httptest.NewRequest(http.MethodPost, "/admin/drain", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer secret")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+
+	var status server.DrainStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Equal(t, server.DrainStatus{Draining: true, InFlight: 1}, status)
+}
@@ -26,6 +26,10 @@ func HandleIndex(c echo.Context) error {
 
 // HandleHealth handles the health route
 func HandleHealth(c echo.Context) error {
+	// Report draining servers unhealthy so load balancers stop routing to them
+	if draining() {
+		return c.String(http.StatusServiceUnavailable, "DRAINING")
+	}
 	return c.String(http.StatusOK, "OK")
 }
 
@@ -66,6 +70,10 @@ func HandleWebAuthnLogin(c echo.Context) error {
 
 // HandleBeginLogin starts the WebAuthn authentication ceremony
 func HandleBeginLogin(c echo.Context) error {
+	if c.QueryParam("mediation") == MediationConditional {
+		return beginConditionalLogin(c)
+	}
+
 	var username string
 
 	// Handle both GET and POST requests
@@ -108,6 +116,11 @@ func HandleBeginLogin(c echo.Context) error {
 		)
 	}
 
+	if err := beginCeremony(username); err != nil {
+		c.Response().Header().Set("Retry-After", "30")
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+	}
+
 	// Generate challenge
 	challenge, err := generateChallenge()
 	if err != nil {
@@ -119,20 +132,9 @@ func HandleBeginLogin(c echo.Context) error {
 	}
 
 	// Create authentication options
-	allowCredentials := make([]map[string]any, len(credentials))
-	for i, cred := range credentials {
-		allowCredentials[i] = map[string]any{
-			"type": "public-key",
-			"id":   cred.CredentialID,
-		}
-	}
-
-	options := map[string]any{
-		"challenge":        challenge,
-		"timeout":          60000,
-		"rpId":             "localhost",
-		"allowCredentials": allowCredentials,
-		"userVerification": "preferred", // Changed from required to preferred for broader compatibility
+	options := assertionOptions(challenge, credentials)
+	if c.QueryParam("hybrid") == "true" {
+		options["hints"] = []string{didtypes.TransportHybrid}
 	}
 
 	// Store challenge in session
@@ -159,10 +161,8 @@ func HandleBeginLogin(c echo.Context) error {
 func HandleFinishLogin(c echo.Context) error {
 	username := c.QueryParam("username")
 	if username == "" {
-		return c.JSON(
-			http.StatusBadRequest,
-			map[string]string{"error": "Username parameter required"},
-		)
+		// Autofill logins learn the user from the credential chosen
+		return finishConditionalLogin(c)
 	}
 
 	// Parse authentication response from client
@@ -191,6 +191,8 @@ func HandleFinishLogin(c echo.Context) error {
 		)
 	}
 
+	recordCeremony(CeremonyAuthentication, username, storedChallenge, authResponse)
+
 	// Extract credential data from the response
 	credentialID, ok := authResponse["id"].(string)
 	if !ok {
@@ -245,20 +247,10 @@ func HandleFinishLogin(c echo.Context) error {
 	if authServer != nil && authServer.sessionStore != nil {
 		delete(authServer.sessionStore, username)
 	}
+	finishCeremony(username)
 
 	// Signal completion to CLI
-	if authServer != nil && authServer.registrationDone != nil {
-		select {
-		case authServer.registrationDone <- nil:
-			logger.Info("Authentication completion signaled to CLI", "username", username)
-		default:
-			logger.Warn(
-				"Failed to signal authentication completion - channel full",
-				"username",
-				username,
-			)
-		}
-	}
+	signalLoginDone(username)
 
 	logger.Info(
 		"WebAuthn authentication completed successfully",
@@ -326,6 +318,11 @@ func HandleBeginRegister(c echo.Context) error {
 
 	logger.Info("Starting WebAuthn registration", "username", username)
 
+	if err := beginCeremony(username); err != nil {
+		c.Response().Header().Set("Retry-After", "30")
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+	}
+
 	// Generate challenge
 	challenge, err := generateChallenge()
 	if err != nil {
@@ -336,42 +333,13 @@ func HandleBeginRegister(c echo.Context) error {
 		)
 	}
 
-	// Create registration options
-	options := map[string]any{
-		"challenge": challenge,
-		"rp": map[string]string{
-			"id":   "localhost",
-			"name": "Sonr Identity Platform",
-		},
-		"user": map[string]any{
-			"id":          base64.URLEncoding.EncodeToString([]byte(username)),
-			"name":        username,
-			"displayName": username,
-		},
-		"pubKeyCredParams": []map[string]any{
-			{
-				"type": "public-key",
-				"alg":  -7, // ES256 algorithm (most common)
-			},
-			{
-				"type": "public-key",
-				"alg":  -257, // RS256 algorithm
-			},
-			{
-				"type": "public-key",
-				"alg":  -8, // EdDSA algorithm
-			},
-		},
-		"authenticatorSelection": map[string]any{
-			// Remove authenticatorAttachment to allow both platform and cross-platform authenticators
-			// "authenticatorAttachment": "platform", // Commented out to allow QR codes
-			"userVerification":   "preferred", // Changed from required to preferred for broader compatibility
-			"residentKey":        "preferred",
-			"requireResidentKey": false, // Allow non-resident keys for broader compatibility
-		},
-		"timeout":     60000,
-		"attestation": "none", // Changed from direct to none for broader compatibility
-	}
+	// Create registration options; discoverable=true makes the credential
+	// usable from the browser's autofill login, hybrid=true asks for a
+	// passkey on a phone
+	options := baseRegisterOptions(username, challenge, registrationPreferences{
+		Discoverable: c.QueryParam("discoverable") == "true",
+		Hybrid:       c.QueryParam("hybrid") == "true",
+	})
 
 	// Store challenge in session (in production, use proper session store)
 	if authServer != nil {
@@ -421,6 +389,8 @@ func HandleFinishRegister(c echo.Context) error {
 		)
 	}
 
+	recordCeremony(CeremonyRegistration, username, storedChallenge, regResponse)
+
 	// Extract credential data from the response
 	credentialID, ok := regResponse["id"].(string)
 	if !ok {
@@ -467,6 +437,7 @@ func HandleFinishRegister(c echo.Context) error {
 		AttestationObject: attestationObject,
 		Username:          username,
 		CreatedAt:         time.Now(),
+		Transports:        responseTransports(response),
 	}
 
 	// Process the registration and store in database
@@ -489,6 +460,7 @@ func HandleFinishRegister(c echo.Context) error {
 	if authServer != nil && authServer.sessionStore != nil {
 		delete(authServer.sessionStore, username)
 	}
+	finishCeremony(username)
 
 	// Send credential data to CLI if channel is available
 	if authServer != nil && authServer.credentialData != nil {
@@ -740,6 +712,8 @@ type WebAuthnCredential struct {
 	Origin    string
 	PublicKey []byte
 	Algorithm int32
+	// Transports the authenticator reported, e.g. "hybrid" for a phone
+	Transports []string
 }
 
 // storeWebAuthnCredential stores the WebAuthn credential in the database
@@ -761,6 +735,7 @@ func storeWebAuthnCredential(credential *WebAuthnCredential) error {
 		Origin:            "localhost", // Default for CLI registration
 		RPID:              "localhost",
 		Algorithm:         -7, // ES256 algorithm by default
+		Transports:        credential.Transports,
 	}
 
 	// Store using service
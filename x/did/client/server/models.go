@@ -6,18 +6,20 @@ import (
 
 // StoredWebAuthnCredential represents a stored WebAuthn credential in database
 type StoredWebAuthnCredential struct {
-	ID                uint      `gorm:"primaryKey"`
-	CredentialID      string    `gorm:"uniqueIndex;not null"`
-	RawID             string    `gorm:"not null"`
-	ClientDataJSON    string    `gorm:"type:text;not null"`
-	AttestationObject string    `gorm:"type:text;not null"`
-	Username          string    `gorm:"index;not null"`
-	PublicKey         []byte    `gorm:"type:blob"`
-	Algorithm         int32     `gorm:"not null"`
-	Origin            string    `gorm:"not null"`
-	RPID              string    `gorm:"not null"`
-	CreatedAt         time.Time `gorm:"autoCreateTime"`
-	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+	ID                uint       `gorm:"primaryKey"`
+	CredentialID      string     `gorm:"uniqueIndex;not null"`
+	RawID             string     `gorm:"not null"`
+	ClientDataJSON    string     `gorm:"type:text;not null"`
+	AttestationObject string     `gorm:"type:text;not null"`
+	Username          string     `gorm:"index;not null"`
+	PublicKey         []byte     `gorm:"type:blob"`
+	Algorithm         int32      `gorm:"not null"`
+	Origin            string     `gorm:"not null"`
+	RPID              string     `gorm:"not null"`
+	Transports        []string   `gorm:"serializer:json"` // Reported by the authenticator; sent back as hints at login
+	CreatedAt         time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time  `gorm:"autoUpdateTime"`
+	RevokedAt         *time.Time `gorm:"index"` // Set when revoked; the row is kept for reconciliation
 }
 
 // UnsignedTransaction represents an unsigned transaction waiting to be signed
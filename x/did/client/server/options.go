@@ -0,0 +1,105 @@
+package server
+
+import (
+	"encoding/base64"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// registrationPreferences selects the kind of credential registration asks
+// the browser for
+type registrationPreferences struct {
+	// Discoverable requires a credential the browser can offer without a
+	// username, as autofill login needs
+	Discoverable bool
+	// Hybrid asks for a passkey on another device, typically a phone reached
+	// by QR code, so the user can later sign in on any desktop with it
+	Hybrid bool
+}
+
+// baseRegisterOptions returns the credential creation options for username
+func baseRegisterOptions(username, challenge string, prefs registrationPreferences) map[string]any {
+	authenticatorSelection := map[string]any{
+		"userVerification":   "preferred",
+		"residentKey":        "preferred",
+		"requireResidentKey": false,
+	}
+	if prefs.Discoverable {
+		authenticatorSelection["residentKey"] = "required"
+		authenticatorSelection["requireResidentKey"] = true
+	}
+
+	options := map[string]any{
+		"challenge": challenge,
+		"rp": map[string]string{
+			"id":   "localhost",
+			"name": "Sonr Identity Platform",
+		},
+		"user": map[string]any{
+			"id":          base64.URLEncoding.EncodeToString([]byte(username)),
+			"name":        username,
+			"displayName": username,
+		},
+		"pubKeyCredParams": []map[string]any{
+			{
+				"type": "public-key",
+				"alg":  -7, // ES256 algorithm (most common)
+			},
+			{
+				"type": "public-key",
+				"alg":  -257, // RS256 algorithm
+			},
+			{
+				"type": "public-key",
+				"alg":  -8, // EdDSA algorithm
+			},
+		},
+		"authenticatorSelection": authenticatorSelection,
+		"timeout":                60000,
+		"attestation":            "none",
+	}
+	if prefs.Hybrid {
+		// Browsers that do not know hints fall back to the attachment
+		authenticatorSelection["authenticatorAttachment"] = "cross-platform"
+		options["hints"] = []string{didtypes.TransportHybrid}
+	}
+	return options
+}
+
+// assertionOptions returns the credential request options for a challenge.
+// Known transports are passed on so the browser can offer the right way to
+// reach each credential, e.g. a QR code for a passkey on a phone. With no
+// credentials, allowCredentials is empty, so only discoverable credentials
+// can answer, as conditional mediation requires.
+func assertionOptions(challenge string, credentials []StoredWebAuthnCredential) map[string]any {
+	allowCredentials := make([]map[string]any, len(credentials))
+	for i, cred := range credentials {
+		allowCredentials[i] = map[string]any{
+			"type": "public-key",
+			"id":   cred.CredentialID,
+		}
+		if len(cred.Transports) > 0 {
+			allowCredentials[i]["transports"] = cred.Transports
+		}
+	}
+
+	return map[string]any{
+		"challenge":        challenge,
+		"timeout":          60000,
+		"rpId":             "localhost",
+		"allowCredentials": allowCredentials,
+		"userVerification": "preferred",
+	}
+}
+
+// responseTransports reads the transports of a registration response
+func responseTransports(response map[string]any) []string {
+	raw, _ := response["transports"].([]any)
+	transports := make([]string, 0, len(raw))
+	for _, t := range raw {
+		if s, ok := t.(string); ok {
+			transports = append(transports, s)
+		}
+	}
+	return didtypes.NormalizeTransports(transports)
+}
@@ -0,0 +1,37 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+func TestBeginRegisterHybridOptions(t *testing.T) {
+	e := echo.New()
+	e.GET("/begin-register", server.HandleBeginRegister)
+
+	get := func(query string) map[string]any {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/begin-register?"+query, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var options map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &options))
+		return options
+	}
+
+	options := get("username=alice")
+	require.NotContains(t, options, "hints")
+	require.NotContains(t, options["authenticatorSelection"], "authenticatorAttachment")
+
+	options = get("username=alice&hybrid=true&discoverable=true")
+	require.Equal(t, []any{"hybrid"}, options["hints"])
+	selection := options["authenticatorSelection"].(map[string]any)
+	require.Equal(t, "cross-platform", selection["authenticatorAttachment"])
+	require.Equal(t, "required", selection["residentKey"])
+}
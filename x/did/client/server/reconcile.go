@@ -0,0 +1,361 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/labstack/echo/v4"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// DiscrepancyKind classifies a mismatch between stored credentials and on-chain state
+type DiscrepancyKind string
+
+const (
+	// DiscrepancyOrphanedOnChain is a WebAuthn verification method on chain with
+	// no active credential in the database (missing or revoked)
+	DiscrepancyOrphanedOnChain DiscrepancyKind = "orphaned_on_chain"
+
+	// DiscrepancyMissingOnChain is an active database credential that is not
+	// present in the DID document
+	DiscrepancyMissingOnChain DiscrepancyKind = "missing_on_chain"
+)
+
+// Reconciliation fix actions
+const (
+	// FixRemoveOnChain queues a MsgRemoveVerificationMethod for the user to sign
+	FixRemoveOnChain = "remove_on_chain"
+
+	// FixRevokeDB revokes the database credential
+	FixRevokeDB = "revoke_db"
+)
+
+// Discrepancy describes a single credential mismatch and the action that fixes it
+type Discrepancy struct {
+	Kind         DiscrepancyKind `json:"kind"`
+	Username     string          `json:"username"`
+	DID          string          `json:"did"`
+	Address      string          `json:"address"`
+	CredentialID string          `json:"credential_id"`
+	MethodID     string          `json:"method_id,omitempty"`
+	Revoked      bool            `json:"revoked"`
+	Fix          string          `json:"fix"`
+}
+
+// ReconciliationReport is the result of a reconciliation run
+type ReconciliationReport struct {
+	StartedAt     time.Time     `json:"started_at"`
+	CompletedAt   time.Time     `json:"completed_at"`
+	Accounts      int           `json:"accounts"`
+	Discrepancies []Discrepancy `json:"discrepancies"`
+	Errors        []string      `json:"errors,omitempty"`
+}
+
+// VerificationMethodSource fetches the verification methods of a DID document
+type VerificationMethodSource func(ctx context.Context, did string) ([]*didtypes.VerificationMethod, error)
+
+// DiffCredentials compares database credentials (including revoked ones)
+// against the WebAuthn verification methods of a DID document.
+func DiffCredentials(
+	account AccountInfo,
+	creds []StoredWebAuthnCredential,
+	methods []*didtypes.VerificationMethod,
+) []Discrepancy {
+	onChain := make(map[string]*didtypes.VerificationMethod)
+	for _, vm := range methods {
+		if vm == nil || vm.WebauthnCredential == nil || vm.WebauthnCredential.CredentialId == "" {
+			continue
+		}
+		onChain[vm.WebauthnCredential.CredentialId] = vm
+	}
+
+	var out []Discrepancy
+	seen := make(map[string]bool, len(creds))
+	for _, cred := range creds {
+		seen[cred.CredentialID] = true
+		revoked := cred.RevokedAt != nil
+		vm, present := onChain[cred.CredentialID]
+
+		switch {
+		case present && revoked:
+			out = append(out, Discrepancy{
+				Kind:         DiscrepancyOrphanedOnChain,
+				Username:     account.Username,
+				DID:          account.DID,
+				Address:      account.Address,
+				CredentialID: cred.CredentialID,
+				MethodID:     vm.Id,
+				Revoked:      true,
+				Fix:          FixRemoveOnChain,
+			})
+		case !present && !revoked:
+			out = append(out, Discrepancy{
+				Kind:         DiscrepancyMissingOnChain,
+				Username:     account.Username,
+				DID:          account.DID,
+				Address:      account.Address,
+				CredentialID: cred.CredentialID,
+				Fix:          FixRevokeDB,
+			})
+		}
+	}
+
+	for credID, vm := range onChain {
+		if seen[credID] {
+			continue
+		}
+		out = append(out, Discrepancy{
+			Kind:         DiscrepancyOrphanedOnChain,
+			Username:     account.Username,
+			DID:          account.DID,
+			Address:      account.Address,
+			CredentialID: credID,
+			MethodID:     vm.Id,
+			Fix:          FixRemoveOnChain,
+		})
+	}
+
+	return out
+}
+
+// CredentialReconciler periodically diffs stored credentials against DID documents
+type CredentialReconciler struct {
+	source   VerificationMethodSource
+	interval time.Duration
+
+	mu   sync.RWMutex
+	last *ReconciliationReport
+}
+
+// NewCredentialReconciler creates a reconciler that reads DID documents from source
+func NewCredentialReconciler(source VerificationMethodSource, interval time.Duration) *CredentialReconciler {
+	return &CredentialReconciler{
+		source:   source,
+		interval: interval,
+	}
+}
+
+// Start runs reconciliation on the configured interval until ctx is cancelled
+func (r *CredentialReconciler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := r.Run(ctx); err != nil {
+				logger.Error("credential reconciliation failed", "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Run performs a single reconciliation pass over all accounts bound to a DID
+func (r *CredentialReconciler) Run(ctx context.Context) (*ReconciliationReport, error) {
+	if db == nil {
+		return nil, errors.New("database not initialized")
+	}
+
+	report := &ReconciliationReport{StartedAt: time.Now()}
+
+	accounts, err := NewAccountInfoService().ListWithDID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	credService := NewWebAuthnCredentialService()
+	for _, account := range accounts {
+		creds, err := credService.GetByUsernameWithRevoked(account.Username)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", account.Username, err))
+			continue
+		}
+
+		methods, err := r.source(ctx, account.DID)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", account.DID, err))
+			continue
+		}
+
+		report.Accounts++
+		report.Discrepancies = append(report.Discrepancies, DiffCredentials(account, creds, methods)...)
+	}
+
+	report.CompletedAt = time.Now()
+
+	r.mu.Lock()
+	r.last = report
+	r.mu.Unlock()
+
+	return report, nil
+}
+
+// LastReport returns the most recent reconciliation report, if any
+func (r *CredentialReconciler) LastReport() *ReconciliationReport {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.last
+}
+
+// Apply executes the fix for each discrepancy. Database fixes are applied
+// directly; on-chain fixes are queued as unsigned transactions for the owner.
+// Re-applying the same discrepancy replaces the queued transaction.
+func (r *CredentialReconciler) Apply(discrepancies []Discrepancy) error {
+	credService := NewWebAuthnCredentialService()
+	txService := NewUnsignedTransactionService()
+
+	for _, d := range discrepancies {
+		switch d.Fix {
+		case FixRevokeDB:
+			if err := credService.Revoke(d.CredentialID); err != nil {
+				return fmt.Errorf("failed to revoke credential %s: %w", d.CredentialID, err)
+			}
+
+		case FixRemoveOnChain:
+			msg := &didtypes.MsgRemoveVerificationMethod{
+				Controller:           d.Address,
+				Did:                  d.DID,
+				VerificationMethodId: d.MethodID,
+			}
+			txData, err := msg.Marshal()
+			if err != nil {
+				return fmt.Errorf("failed to encode removal for %s: %w", d.MethodID, err)
+			}
+
+			tx := &UnsignedTransaction{
+				TxID:        fmt.Sprintf("reconcile-%s-%s", d.DID, d.MethodID),
+				Username:    d.Username,
+				TxData:      txData,
+				TxType:      "MsgRemoveVerificationMethod",
+				Description: fmt.Sprintf("Remove orphaned verification method %s", d.MethodID),
+				Status:      "pending",
+			}
+			if err := txService.Upsert(tx); err != nil {
+				return fmt.Errorf("failed to queue removal for %s: %w", d.MethodID, err)
+			}
+
+		default:
+			return fmt.Errorf("unknown reconciliation fix %q", d.Fix)
+		}
+	}
+
+	return nil
+}
+
+// RegisterReconciliationRoutes registers the admin endpoints of a reconciler
+// behind a bearer token. The token must not be empty.
+func RegisterReconciliationRoutes(e *echo.Echo, r *CredentialReconciler, adminToken string) error {
+	if adminToken == "" {
+		return errors.New("reconciliation admin token is required")
+	}
+
+	g := e.Group("/admin/reconciliation", requireAdminToken(adminToken))
+	g.GET("", r.HandleReport)
+	g.POST("/run", r.HandleRun)
+	g.POST("/apply", r.HandleApply)
+	return nil
+}
+
+// requireAdminToken rejects requests without the configured bearer token
+func requireAdminToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			bearer := strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+			}
+			return next(c)
+		}
+	}
+}
+
+// HandleReport returns the last reconciliation report
+func (r *CredentialReconciler) HandleReport(c echo.Context) error {
+	report := r.LastReport()
+	if report == nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "no reconciliation has run yet"})
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// HandleRun triggers a reconciliation pass and returns its report
+func (r *CredentialReconciler) HandleRun(c echo.Context) error {
+	report, err := r.Run(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// HandleApply recomputes the discrepancies and applies their fixes. The body
+// may narrow the set to specific credential IDs; discrepancies are never
+// taken from the client.
+func (r *CredentialReconciler) HandleApply(c echo.Context) error {
+	var req struct {
+		CredentialIDs []string `json:"credential_ids"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	report, err := r.Run(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	discrepancies := FilterDiscrepancies(report.Discrepancies, req.CredentialIDs)
+	if err := r.Apply(discrepancies); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]int{"applied": len(discrepancies)})
+}
+
+// FilterDiscrepancies keeps the discrepancies for the given credential IDs,
+// or all of them when no IDs are given
+func FilterDiscrepancies(discrepancies []Discrepancy, credentialIDs []string) []Discrepancy {
+	if len(credentialIDs) == 0 {
+		return discrepancies
+	}
+
+	wanted := make(map[string]bool, len(credentialIDs))
+	for _, id := range credentialIDs {
+		wanted[id] = true
+	}
+
+	var out []Discrepancy
+	for _, d := range discrepancies {
+		if wanted[d.CredentialID] {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// ChainVerificationMethodSource reads verification methods from the did
+// module query service
+func ChainVerificationMethodSource(conn gogogrpc.ClientConn) VerificationMethodSource {
+	queryClient := didtypes.NewQueryClient(conn)
+	return func(ctx context.Context, did string) ([]*didtypes.VerificationMethod, error) {
+		res, err := queryClient.GetDIDDocument(ctx, &didtypes.QueryGetDIDDocumentRequest{Did: did})
+		if err != nil {
+			return nil, err
+		}
+		if res.DidDocument == nil {
+			return nil, nil
+		}
+		return res.DidDocument.VerificationMethod, nil
+	}
+}
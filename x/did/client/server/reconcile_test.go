@@ -0,0 +1,118 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+func webauthnMethod(id, credentialID string) *didtypes.VerificationMethod {
+	return &didtypes.VerificationMethod{
+		Id:                 id,
+		WebauthnCredential: &didtypes.WebAuthnCredential{CredentialId: credentialID},
+	}
+}
+
+func TestDiffCredentials(t *testing.T) {
+	account := server.AccountInfo{
+		Username: "alice",
+		DID:      "did:sonr:alice",
+		Address:  "idx1alice",
+	}
+	revokedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		creds   []server.StoredWebAuthnCredential
+		methods []*didtypes.VerificationMethod
+		want    []server.Discrepancy
+	}{
+		{
+			name:    "in sync",
+			creds:   []server.StoredWebAuthnCredential{{CredentialID: "cred-1"}},
+			methods: []*didtypes.VerificationMethod{webauthnMethod("did:sonr:alice#key-1", "cred-1")},
+		},
+		{
+			name: "revoked in database but still on chain",
+			creds: []server.StoredWebAuthnCredential{
+				{CredentialID: "cred-1", RevokedAt: &revokedAt},
+			},
+			methods: []*didtypes.VerificationMethod{webauthnMethod("did:sonr:alice#key-1", "cred-1")},
+			want: []server.Discrepancy{{
+				Kind:         server.DiscrepancyOrphanedOnChain,
+				Username:     "alice",
+				DID:          "did:sonr:alice",
+				Address:      "idx1alice",
+				CredentialID: "cred-1",
+				MethodID:     "did:sonr:alice#key-1",
+				Revoked:      true,
+				Fix:          server.FixRemoveOnChain,
+			}},
+		},
+		{
+			name:  "on chain with no database record",
+			creds: nil,
+			methods: []*didtypes.VerificationMethod{
+				webauthnMethod("did:sonr:alice#key-2", "cred-2"),
+			},
+			want: []server.Discrepancy{{
+				Kind:         server.DiscrepancyOrphanedOnChain,
+				Username:     "alice",
+				DID:          "did:sonr:alice",
+				Address:      "idx1alice",
+				CredentialID: "cred-2",
+				MethodID:     "did:sonr:alice#key-2",
+				Fix:          server.FixRemoveOnChain,
+			}},
+		},
+		{
+			name:  "active in database but missing on chain",
+			creds: []server.StoredWebAuthnCredential{{CredentialID: "cred-3"}},
+			want: []server.Discrepancy{{
+				Kind:         server.DiscrepancyMissingOnChain,
+				Username:     "alice",
+				DID:          "did:sonr:alice",
+				Address:      "idx1alice",
+				CredentialID: "cred-3",
+				Fix:          server.FixRevokeDB,
+			}},
+		},
+		{
+			name: "revoked and removed everywhere",
+			creds: []server.StoredWebAuthnCredential{
+				{CredentialID: "cred-4", RevokedAt: &revokedAt},
+			},
+		},
+		{
+			name:  "non-webauthn methods are ignored",
+			creds: nil,
+			methods: []*didtypes.VerificationMethod{
+				nil,
+				{Id: "did:sonr:alice#ed25519"},
+				webauthnMethod("did:sonr:alice#empty", ""),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := server.DiffCredentials(account, tc.creds, tc.methods)
+			require.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
+func TestFilterDiscrepancies(t *testing.T) {
+	all := []server.Discrepancy{
+		{CredentialID: "cred-1"},
+		{CredentialID: "cred-2"},
+	}
+
+	require.Equal(t, all, server.FilterDiscrepancies(all, nil))
+	require.Equal(t, all[1:], server.FilterDiscrepancies(all, []string{"cred-2"}))
+	require.Empty(t, server.FilterDiscrepancies(all, []string{"cred-9"}))
+}
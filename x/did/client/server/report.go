@@ -0,0 +1,66 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sonr-io/sonr/app/errreport"
+)
+
+var initReportingOnce sync.Once
+
+// initErrorReporting enables error reporting for the auth server when opted
+// in through SONR_ERROR_REPORTING_* environment variables.
+func initErrorReporting() {
+	initReportingOnce.Do(func() {
+		cfg := errreport.ConfigFromEnv(errreport.Release("sonr-auth", "dev"))
+		if err := errreport.Init(cfg); err != nil {
+			logger.Error("Error reporting disabled", "error", err)
+		}
+	})
+}
+
+// errorReportingMiddleware reports handler errors, 5xx responses and panics.
+// It must be registered after middleware.Recover so panics are captured
+// before Recover turns them into a 500 response.
+func errorReportingMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !errreport.Enabled() {
+				return next(c)
+			}
+
+			tags := map[string]string{
+				"component":  "auth-server",
+				"route":      c.Path(),
+				"method":     c.Request().Method,
+				"request_id": c.Response().Header().Get(echo.HeaderXRequestID),
+			}
+
+			defer func() {
+				if r := recover(); r != nil {
+					errreport.CapturePanic(r, tags)
+					panic(r)
+				}
+			}()
+
+			err := next(c)
+			switch {
+			case err != nil:
+				if he, ok := err.(*echo.HTTPError); ok && he.Code < http.StatusInternalServerError {
+					break
+				}
+				errreport.CaptureError(err, tags)
+			case c.Response().Status >= http.StatusInternalServerError:
+				errreport.CaptureError(
+					fmt.Errorf("%s %s returned %d", c.Request().Method, c.Path(), c.Response().Status),
+					tags,
+				)
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,448 @@
+package server
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// Ceremony kinds recorded by the auth server
+const (
+	CeremonyRegistration   = "registration"
+	CeremonyAuthentication = "authentication"
+)
+
+const (
+	// SandboxNamespace prefixes every username the sandbox writes, so sandbox
+	// profiles can never be mistaken for real users
+	SandboxNamespace = "sandbox:"
+
+	// CeremonyRecordDirEnv names a directory the auth server records finished
+	// ceremony requests to. Recording is off when it is unset.
+	CeremonyRecordDirEnv = "SONR_CEREMONY_RECORD_DIR"
+
+	// maxSandboxTraces bounds the traces a sandbox keeps in memory
+	maxSandboxTraces = 256
+)
+
+// CeremonyRecording is a finish-ceremony request as received by the auth
+// server, together with the challenge that was issued for it
+type CeremonyRecording struct {
+	ID         string          `json:"id"`
+	Ceremony   string          `json:"ceremony"`
+	Username   string          `json:"username"`
+	Challenge  string          `json:"challenge"`
+	Response   json.RawMessage `json:"response"`
+	RecordedAt time.Time       `json:"recorded_at"`
+}
+
+// TraceStep is the outcome of a single validation step of a replay
+type TraceStep struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Trace describes how a recorded ceremony fared when replayed in the sandbox
+type Trace struct {
+	ID          string      `json:"id"`
+	RecordingID string      `json:"recording_id"`
+	Ceremony    string      `json:"ceremony"`
+	Username    string      `json:"username"`
+	Steps       []TraceStep `json:"steps"`
+	OK          bool        `json:"ok"`
+	Error       string      `json:"error,omitempty"`
+
+	// ChainWrite is the message that would have been broadcast. The sandbox
+	// validates it but never sends it.
+	ChainWrite json.RawMessage `json:"chain_write,omitempty"`
+
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// step appends a step to the trace, marking the trace failed when err is set
+func (t *Trace) step(name string, err error, detail string) bool {
+	s := TraceStep{Name: name, OK: err == nil, Detail: detail}
+	if err != nil {
+		s.Detail = err.Error()
+		t.Error = fmt.Sprintf("%s: %v", name, err)
+	}
+	t.Steps = append(t.Steps, s)
+	return err == nil
+}
+
+// Sandbox replays recorded ceremonies with the same validation as the auth
+// server against a separate credential database. Usernames are namespaced
+// with SandboxNamespace and chain writes are validated but not broadcast.
+type Sandbox struct {
+	db *gorm.DB
+
+	mu     sync.RWMutex
+	traces []*Trace
+}
+
+// DefaultSandboxPath returns the default sandbox database, next to vault.db
+func DefaultSandboxPath() (string, error) {
+	sonrDir, err := sonrHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(sonrDir, "sandbox.db"), nil
+}
+
+// NewSandbox opens the sandbox database at path. It must not be the auth
+// server database.
+func NewSandbox(path string) (*Sandbox, error) {
+	sandboxDB, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sandbox database: %w", err)
+	}
+	if err := injectDBFaults(sandboxDB); err != nil {
+		return nil, err
+	}
+	if err := sandboxDB.AutoMigrate(&StoredWebAuthnCredential{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate sandbox database: %w", err)
+	}
+	return &Sandbox{db: sandboxDB}, nil
+}
+
+// Close closes the sandbox database
+func (s *Sandbox) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// Replay runs a recorded ceremony through the auth server's validation and
+// returns its trace. A failing ceremony is reported in the trace, not as an
+// error.
+func (s *Sandbox) Replay(rec CeremonyRecording) *Trace {
+	trace := &Trace{
+		ID:          newRecordID(),
+		RecordingID: rec.ID,
+		Ceremony:    rec.Ceremony,
+		Username:    SandboxNamespace + rec.Username,
+		StartedAt:   time.Now(),
+	}
+
+	switch {
+	case rec.Username == "":
+		trace.step("decode_recording", errors.New("recording has no username"), "")
+	case rec.Challenge == "":
+		trace.step("decode_recording", errors.New("recording has no challenge"), "")
+	case rec.Ceremony == CeremonyRegistration:
+		s.replayRegistration(trace, rec)
+	case rec.Ceremony == CeremonyAuthentication:
+		s.replayAuthentication(trace, rec)
+	default:
+		trace.step("decode_recording", fmt.Errorf("unknown ceremony %q", rec.Ceremony), "")
+	}
+
+	trace.OK = trace.Error == ""
+	trace.CompletedAt = time.Now()
+
+	s.mu.Lock()
+	s.traces = append(s.traces, trace)
+	if len(s.traces) > maxSandboxTraces {
+		s.traces = s.traces[len(s.traces)-maxSandboxTraces:]
+	}
+	s.mu.Unlock()
+
+	return trace
+}
+
+func (s *Sandbox) replayRegistration(trace *Trace, rec CeremonyRecording) {
+	var resp struct {
+		ID       string `json:"id"`
+		RawID    string `json:"rawId"`
+		Response struct {
+			ClientDataJSON    string   `json:"clientDataJSON"`
+			AttestationObject string   `json:"attestationObject"`
+			Transports        []string `json:"transports"`
+		} `json:"response"`
+	}
+	err := json.Unmarshal(rec.Response, &resp)
+	if err == nil && (resp.ID == "" || resp.RawID == "") {
+		err = errors.New("missing credential ID")
+	}
+	if err == nil && (resp.Response.ClientDataJSON == "" || resp.Response.AttestationObject == "") {
+		err = errors.New("missing client data or attestation object")
+	}
+	if !trace.step("decode_response", err, resp.ID) {
+		return
+	}
+
+	err = verifyClientData(resp.Response.ClientDataJSON, rec.Challenge)
+	if !trace.step("verify_client_data", err, "") {
+		return
+	}
+
+	credential := &WebAuthnCredential{
+		CredentialID:      resp.ID,
+		RawID:             resp.RawID,
+		ClientDataJSON:    resp.Response.ClientDataJSON,
+		AttestationObject: resp.Response.AttestationObject,
+		Username:          trace.Username,
+		Transports:        didtypes.NormalizeTransports(resp.Response.Transports),
+		CreatedAt:         time.Now(),
+	}
+	err = processWebAuthnRegistration(credential)
+	if !trace.step("parse_attestation", err, fmt.Sprintf("origin %s, algorithm %d", credential.Origin, credential.Algorithm)) {
+		return
+	}
+
+	// Same message the CLI broadcasts, with the controller derived from the
+	// credential ID
+	controller := sha256.Sum256([]byte(credential.CredentialID))
+	msg := &didtypes.MsgRegisterWebAuthnCredential{
+		Controller: sdk.AccAddress(controller[:20]).String(),
+		Username:   credential.Username,
+		WebauthnCredential: didtypes.WebAuthnCredential{
+			CredentialId:      credential.CredentialID,
+			RawId:             credential.RawID,
+			ClientDataJson:    credential.ClientDataJSON,
+			AttestationObject: credential.AttestationObject,
+			PublicKey:         credential.PublicKey,
+			Algorithm:         credential.Algorithm,
+			Origin:            credential.Origin,
+			Transports:        credential.Transports,
+		},
+		VerificationMethodId: fmt.Sprintf("webauthn-%.8s", credential.CredentialID),
+	}
+	err = msg.ValidateBasic()
+	if !trace.step("validate_chain_write", err, "not broadcast") {
+		return
+	}
+	trace.ChainWrite, _ = json.Marshal(msg)
+
+	var existing StoredWebAuthnCredential
+	err = s.db.Where("credential_id = ?", credential.CredentialID).First(&existing).Error
+	switch {
+	case err == nil && existing.Username != credential.Username:
+		trace.step("store_credential", fmt.Errorf("credential already registered to %s", existing.Username), "")
+		return
+	case err == nil:
+		trace.step("store_credential", nil, "already registered")
+		return
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		trace.step("store_credential", err, "")
+		return
+	}
+
+	err = s.db.Create(&StoredWebAuthnCredential{
+		CredentialID:      credential.CredentialID,
+		RawID:             credential.RawID,
+		ClientDataJSON:    credential.ClientDataJSON,
+		AttestationObject: credential.AttestationObject,
+		Username:          credential.Username,
+		PublicKey:         credential.PublicKey,
+		Algorithm:         credential.Algorithm,
+		Origin:            credential.Origin,
+		RPID:              rpIDFromOrigin(credential.Origin),
+	}).Error
+	trace.step("store_credential", err, "")
+}
+
+func (s *Sandbox) replayAuthentication(trace *Trace, rec CeremonyRecording) {
+	var resp struct {
+		ID       string `json:"id"`
+		Response struct {
+			ClientDataJSON string `json:"clientDataJSON"`
+		} `json:"response"`
+	}
+	err := json.Unmarshal(rec.Response, &resp)
+	if err == nil && resp.ID == "" {
+		err = errors.New("missing credential ID")
+	}
+	if err == nil && resp.Response.ClientDataJSON == "" {
+		err = errors.New("missing client data")
+	}
+	if !trace.step("decode_response", err, resp.ID) {
+		return
+	}
+
+	err = verifyClientDataForAuthentication(resp.Response.ClientDataJSON, rec.Challenge)
+	if !trace.step("verify_client_data", err, "") {
+		return
+	}
+
+	var credential StoredWebAuthnCredential
+	err = s.db.Where("credential_id = ? AND revoked_at IS NULL", resp.ID).First(&credential).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		err = errors.New("credential not registered in sandbox; replay its registration first")
+	case err == nil && credential.Username != trace.Username:
+		err = fmt.Errorf("credential belongs to %s", credential.Username)
+	}
+	trace.step("lookup_credential", err, "")
+}
+
+// Traces returns the retained traces, oldest first
+func (s *Sandbox) Traces() []*Trace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Trace(nil), s.traces...)
+}
+
+// Trace returns a retained trace by ID
+func (s *Sandbox) Trace(id string) (*Trace, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.traces {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// Reset deletes every sandbox credential and trace
+func (s *Sandbox) Reset() error {
+	err := s.db.Where("username LIKE ?", SandboxNamespace+"%").
+		Delete(&StoredWebAuthnCredential{}).Error
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.traces = nil
+	s.mu.Unlock()
+	return nil
+}
+
+// RegisterSandboxRoutes registers the sandbox endpoints behind a bearer token.
+// The token must not be empty.
+func RegisterSandboxRoutes(e *echo.Echo, s *Sandbox, adminToken string) error {
+	if adminToken == "" {
+		return errors.New("sandbox admin token is required")
+	}
+
+	g := e.Group("/sandbox", requireAdminToken(adminToken))
+	g.POST("/replay", s.HandleReplay)
+	g.GET("/traces", s.HandleTraces)
+	g.GET("/traces/:id", s.HandleDownloadTrace)
+	g.DELETE("", s.HandleReset)
+	return nil
+}
+
+// HandleReplay replays the recording in the request body and returns its trace
+func (s *Sandbox) HandleReplay(c echo.Context) error {
+	var rec CeremonyRecording
+	if err := c.Bind(&rec); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid recording"})
+	}
+	return c.JSON(http.StatusOK, s.Replay(rec))
+}
+
+// HandleTraces lists the retained traces
+func (s *Sandbox) HandleTraces(c echo.Context) error {
+	return c.JSON(http.StatusOK, s.Traces())
+}
+
+// HandleDownloadTrace returns a trace as a JSON file download
+func (s *Sandbox) HandleDownloadTrace(c echo.Context) error {
+	trace, ok := s.Trace(c.Param("id"))
+	if !ok {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "trace not found"})
+	}
+	c.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", "trace-"+trace.ID+".json"),
+	)
+	return c.JSONPretty(http.StatusOK, trace, "  ")
+}
+
+// HandleReset clears the sandbox namespace
+func (s *Sandbox) HandleReset(c echo.Context) error {
+	if err := s.Reset(); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// recordCeremony writes a finish-ceremony request to $SONR_CEREMONY_RECORD_DIR
+// when it is set, before the request is validated, so failing ceremonies can
+// be replayed in a sandbox. Recording failures are logged and never fail the
+// ceremony.
+func recordCeremony(ceremony, username, challenge string, response map[string]any) {
+	dir := os.Getenv(CeremonyRecordDirEnv)
+	if dir == "" {
+		return
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		logger.Warn("Failed to encode ceremony recording", "error", err)
+		return
+	}
+	rec := CeremonyRecording{
+		ID:         newRecordID(),
+		Ceremony:   ceremony,
+		Username:   username,
+		Challenge:  challenge,
+		Response:   body,
+		RecordedAt: time.Now().UTC(),
+	}
+	if err := WriteCeremonyRecording(dir, rec); err != nil {
+		logger.Warn("Failed to record ceremony", "error", err)
+	}
+}
+
+// WriteCeremonyRecording writes a recording to <dir>/<id>.json
+func WriteCeremonyRecording(dir string, rec CeremonyRecording) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, rec.ID+".json"), bz, 0o600)
+}
+
+// ReadCeremonyRecording reads a recording written by WriteCeremonyRecording
+func ReadCeremonyRecording(path string) (CeremonyRecording, error) {
+	var rec CeremonyRecording
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return rec, err
+	}
+	if err := json.Unmarshal(bz, &rec); err != nil {
+		return rec, fmt.Errorf("invalid recording %s: %w", path, err)
+	}
+	return rec, nil
+}
+
+// newRecordID returns a sortable, unique ID for recordings and traces
+func newRecordID() string {
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return time.Now().UTC().Format("20060102T150405.000") + "-" + hex.EncodeToString(suffix)
+}
+
+// rpIDFromOrigin returns the host of a credential origin
+func rpIDFromOrigin(origin string) string {
+	if u, err := url.Parse(origin); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return origin
+}
@@ -0,0 +1,129 @@
+package server_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/client/server"
+)
+
+func newTestSandbox(t *testing.T) *server.Sandbox {
+	t.Helper()
+	s, err := server.NewSandbox(filepath.Join(t.TempDir(), "sandbox.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func clientData(t *testing.T, typ, challenge string) string {
+	t.Helper()
+	bz, err := json.Marshal(map[string]string{
+		"type":      typ,
+		"challenge": challenge,
+		"origin":    "http://localhost:8080",
+	})
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+func stepNames(trace *server.Trace) []string {
+	var names []string
+	for _, s := range trace.Steps {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func TestSandboxReplayRegistrationChallengeMismatch(t *testing.T) {
+	s := newTestSandbox(t)
+
+	response, err := json.Marshal(map[string]any{
+		"id":    "cred-1",
+		"rawId": "cred-1",
+		"response": map[string]string{
+			"clientDataJSON":    clientData(t, "webauthn.create", "issued-elsewhere"),
+			"attestationObject": "o2NmbXRkbm9uZQ",
+		},
+	})
+	require.NoError(t, err)
+
+	trace := s.Replay(server.CeremonyRecording{
+		ID:        "rec-1",
+		Ceremony:  server.CeremonyRegistration,
+		Username:  "alice",
+		Challenge: "expected",
+		Response:  response,
+	})
+	require.False(t, trace.OK)
+	require.Equal(t, "sandbox:alice", trace.Username)
+	require.Equal(t, []string{"decode_response", "verify_client_data"}, stepNames(trace))
+	require.Contains(t, trace.Error, "challenge mismatch")
+	require.Empty(t, trace.ChainWrite)
+
+	got, ok := s.Trace(trace.ID)
+	require.True(t, ok)
+	require.Equal(t, "rec-1", got.RecordingID)
+}
+
+func TestSandboxReplayAuthenticationRequiresSandboxCredential(t *testing.T) {
+	s := newTestSandbox(t)
+
+	response, err := json.Marshal(map[string]any{
+		"id": "cred-1",
+		"response": map[string]string{
+			"clientDataJSON": clientData(t, "webauthn.get", "challenge"),
+		},
+	})
+	require.NoError(t, err)
+
+	trace := s.Replay(server.CeremonyRecording{
+		Ceremony:  server.CeremonyAuthentication,
+		Username:  "alice",
+		Challenge: "challenge",
+		Response:  response,
+	})
+	require.False(t, trace.OK)
+	require.Equal(t, []string{"decode_response", "verify_client_data", "lookup_credential"}, stepNames(trace))
+	require.True(t, trace.Steps[1].OK)
+	require.Contains(t, trace.Error, "not registered in sandbox")
+}
+
+func TestSandboxReplayRejectsIncompleteRecordings(t *testing.T) {
+	s := newTestSandbox(t)
+
+	for _, rec := range []server.CeremonyRecording{
+		{Ceremony: server.CeremonyRegistration, Challenge: "c"},
+		{Ceremony: server.CeremonyRegistration, Username: "alice"},
+		{Ceremony: "recovery", Username: "alice", Challenge: "c"},
+	} {
+		trace := s.Replay(rec)
+		require.False(t, trace.OK)
+		require.Equal(t, []string{"decode_recording"}, stepNames(trace))
+	}
+
+	require.Len(t, s.Traces(), 3)
+	require.NoError(t, s.Reset())
+	require.Empty(t, s.Traces())
+}
+
+func TestCeremonyRecordingRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	rec := server.CeremonyRecording{
+		ID:        "rec-1",
+		Ceremony:  server.CeremonyAuthentication,
+		Username:  "alice",
+		Challenge: "challenge",
+		Response:  json.RawMessage(`{"id":"cred-1"}`),
+	}
+	require.NoError(t, server.WriteCeremonyRecording(dir, rec))
+
+	got, err := server.ReadCeremonyRecording(filepath.Join(dir, "rec-1.json"))
+	require.NoError(t, err)
+	require.Equal(t, rec.Username, got.Username)
+	require.Equal(t, rec.Challenge, got.Challenge)
+	require.JSONEq(t, string(rec.Response), string(got.Response))
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -21,6 +23,10 @@ var (
 	ErrFailedToStartAuthServer  = errors.New("failed to start auth server")
 )
 
+// AdminTokenEnv holds the bearer token for the auth server's drain endpoint.
+// The endpoint is not registered when it is unset.
+const AdminTokenEnv = "SONR_ADMIN_TOKEN"
+
 // AuthServer is a spawnable HTTP server for Auth service.
 type AuthServer struct {
 	*echo.Echo
@@ -32,6 +38,10 @@ type AuthServer struct {
 	registrationDone chan error               // Channel to signal registration completion
 	credentialData   chan *WebAuthnCredential // Channel to pass credential data to CLI
 	username         string                   // Current username being registered
+	drainer          *Drainer                 // Tracks in-flight ceremonies for graceful shutdown
+	DrainTimeout     time.Duration            // How long Stop waits for in-flight ceremonies
+	stopOnce         sync.Once
+	stopErr          error
 }
 
 var authServer *AuthServer
@@ -86,8 +96,8 @@ func StopAuthServer() error {
 }
 
 func (s *AuthServer) Start() error {
-	// Setup signal context
-	s.ctx, s.cancel = signal.NotifyContext(context.Background(), os.Interrupt)
+	// Setup signal context; deploy tooling stops the server with SIGTERM
+	s.ctx, s.cancel = signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 
 	// Start server in goroutine
 	go func() {
@@ -103,12 +113,30 @@ func (s *AuthServer) Start() error {
 	return nil
 }
 
+// Stop drains the server, waiting up to DrainTimeout for in-flight ceremonies
+// to complete, and then shuts it down. Only the first call has an effect.
 func (s *AuthServer) Stop() error {
+	s.stopOnce.Do(func() {
+		s.stopErr = s.stop()
+	})
+	return s.stopErr
+}
+
+func (s *AuthServer) stop() error {
 	// Cancel the signal context to trigger shutdown
 	if s.cancel != nil {
 		s.cancel()
 	}
 
+	if s.drainer != nil {
+		s.drainer.Drain()
+		ctx, cancel := context.WithTimeout(context.Background(), s.DrainTimeout)
+		if err := s.drainer.Wait(ctx); err != nil {
+			s.Logger.Warnf("Shutting down with %d ceremonies in flight", s.drainer.Status().InFlight)
+		}
+		cancel()
+	}
+
 	// Create shutdown context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -128,16 +156,10 @@ func (s *AuthServer) HandleKillSignal() {
 	select {
 	case <-s.KillChan:
 		// Manual stop via KillChan
-		s.Stop()
 	case <-s.ctx.Done():
-		// OS interrupt signal received
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-		if err := s.Shutdown(ctx); err != nil {
-			s.Logger.Fatal(err)
-		}
-		destroyAuthServer()
+		// OS interrupt or termination signal received
 	}
+	s.Stop()
 }
 
 // ╭───────────────────────────────────────────────────────────╮
@@ -173,6 +195,17 @@ func setupMiddleware(e *echo.Echo) {
 	// Disable HTTP request logging for cleaner CLI output
 	// e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
+
+	// Opt-in error reporting, inside Recover so panics are seen first
+	initErrorReporting()
+	e.Use(errorReportingMiddleware())
+}
+
+// registerDrainRoutes exposes the drain endpoint when $SONR_ADMIN_TOKEN is set
+func registerDrainRoutes(s *AuthServer) {
+	if token := os.Getenv(AdminTokenEnv); token != "" {
+		_ = RegisterDrainRoutes(s.Echo, s.drainer, token)
+	}
 }
 
 // destroyAuthServer destroys the auth server
@@ -183,15 +216,18 @@ func destroyAuthServer() {
 // setupAuthServer sets up the auth server
 func setupAuthServer() {
 	authServer = &AuthServer{
-		Echo:     echo.New(),
-		Port:     8080,
-		KillChan: make(chan bool),
+		Echo:         echo.New(),
+		Port:         8080,
+		KillChan:     make(chan bool),
+		drainer:      NewDrainer(),
+		DrainTimeout: DefaultDrainTimeout,
 	}
 	// Disable Echo framework logging for cleaner CLI output
 	authServer.HideBanner = true
 	authServer.HidePort = true
 	setupMiddleware(authServer.Echo)
 	setupRoutes(authServer.Echo)
+	registerDrainRoutes(authServer)
 }
 
 // setupAuthServerWithWebAuthn sets up the auth server with WebAuthn context
@@ -206,12 +242,15 @@ func setupAuthServerWithWebAuthn(port int, username string, done chan error) {
 		sessionStore:     make(map[string]string),
 		registrationDone: done,
 		username:         username,
+		drainer:          NewDrainer(),
+		DrainTimeout:     DefaultDrainTimeout,
 	}
 	// Disable Echo framework logging for cleaner CLI output
 	authServer.HideBanner = true
 	authServer.HidePort = true
 	setupMiddleware(authServer.Echo)
 	setupRoutes(authServer.Echo)
+	registerDrainRoutes(authServer)
 
 	// Set up automatic server shutdown after 15 seconds as failsafe
 	go func() {
@@ -250,12 +289,15 @@ func setupAuthServerWithWebAuthnAndCredentialChannel(
 		registrationDone: done,
 		credentialData:   credentialData,
 		username:         username,
+		drainer:          NewDrainer(),
+		DrainTimeout:     DefaultDrainTimeout,
 	}
 	// Disable Echo framework logging for cleaner CLI output
 	authServer.HideBanner = true
 	authServer.HidePort = true
 	setupMiddleware(authServer.Echo)
 	setupRoutes(authServer.Echo)
+	registerDrainRoutes(authServer)
 
 	// Set up automatic server shutdown after 15 seconds as failsafe
 	go func() {
@@ -285,12 +327,15 @@ func setupAuthServerForLogin(port int, username string, done chan error) {
 		sessionStore:     make(map[string]string),
 		registrationDone: done,
 		username:         username,
+		drainer:          NewDrainer(),
+		DrainTimeout:     DefaultDrainTimeout,
 	}
 	// Disable Echo framework logging for cleaner CLI output
 	authServer.HideBanner = true
 	authServer.HidePort = true
 	setupMiddleware(authServer.Echo)
 	setupLoginRoutes(authServer.Echo)
+	registerDrainRoutes(authServer)
 
 	// Set up automatic server shutdown after 45 seconds as failsafe (longer for login)
 	go func() {
@@ -320,4 +365,5 @@ func setupLoginRoutes(e *echo.Echo) {
 	e.POST("/begin-login", HandleBeginLogin) // POST also supported for client compatibility
 	e.POST("/finish-login", HandleFinishLogin)
 	e.POST("/login/verify", HandleFinishLogin) // Alternative endpoint for client compatibility
+	e.GET("/login/mediation", HandleMediationHints)
 }
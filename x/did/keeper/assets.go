@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// attachNFT stores a token on a DID's profile with its current x/nft
+// metadata. The token must exist and be owned by owner. Attaching a token
+// again refreshes its metadata.
+func (k Keeper) attachNFT(ctx context.Context, did, owner, classID, nftID string) (types.DIDAsset, error) {
+	if k.nftKeeper == nil {
+		return types.DIDAsset{}, errorsmod.Wrap(types.ErrInvalidAsset, "NFT module not available")
+	}
+	token, found := k.nftKeeper.GetNFT(ctx, classID, nftID)
+	if !found {
+		return types.DIDAsset{}, errorsmod.Wrapf(types.ErrInvalidAsset, "token %s/%s does not exist", classID, nftID)
+	}
+	if current := k.nftKeeper.GetOwner(ctx, classID, nftID); current.String() != owner {
+		return types.DIDAsset{}, errorsmod.Wrapf(types.ErrUnauthorized, "%s does not own token %s/%s", owner, classID, nftID)
+	}
+
+	key := collections.Join3(did, classID, nftID)
+	previous, err := k.DIDAssets.Get(ctx, key)
+	switch {
+	case err == nil:
+		k.removeNFTMetadata(ctx, previous)
+	case errors.Is(err, collections.ErrNotFound):
+		count, err := k.countDIDAssets(ctx, did)
+		if err != nil {
+			return types.DIDAsset{}, err
+		}
+		if count >= types.MaxAssetsPerDID {
+			return types.DIDAsset{}, errorsmod.Wrapf(
+				types.ErrInvalidAsset,
+				"DID %s already shows %d assets", did, types.MaxAssetsPerDID,
+			)
+		}
+	default:
+		return types.DIDAsset{}, err
+	}
+
+	asset := types.DIDAsset{
+		Did:        did,
+		ClassId:    classID,
+		NftId:      nftID,
+		Owner:      owner,
+		Uri:        token.Uri,
+		UriHash:    token.UriHash,
+		Interchain: types.IsInterchainClass(classID),
+		AttachedAt: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	}
+	if class, found := k.nftKeeper.GetClass(ctx, classID); found {
+		asset.ClassName = class.Name
+		asset.ClassUri = class.Uri
+	}
+	asset.DwnRecordId = k.cacheNFTMetadata(ctx, asset)
+
+	return asset, k.DIDAssets.Set(ctx, key, asset)
+}
+
+// detachNFT removes a token from a DID's profile and its cached metadata
+func (k Keeper) detachNFT(ctx context.Context, did, classID, nftID string) error {
+	key := collections.Join3(did, classID, nftID)
+	asset, err := k.DIDAssets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrAssetNotFound, "%s/%s on %s", classID, nftID, did)
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.DIDAssets.Remove(ctx, key); err != nil {
+		return err
+	}
+	k.removeNFTMetadata(ctx, asset)
+	return nil
+}
+
+// GetDIDAssets returns the tokens attached to a DID with their current
+// owners. A token counts as owned while the address that attached it still
+// holds it.
+func (k Keeper) GetDIDAssets(ctx context.Context, did string) ([]types.DIDAssetInfo, error) {
+	iter, err := k.DIDAssets.Iterate(ctx, collections.NewPrefixedTripleRange[string, string, string](did))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	assets := []types.DIDAssetInfo{}
+	for ; iter.Valid(); iter.Next() {
+		asset, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		info := types.DIDAssetInfo{Asset: asset}
+		if k.nftKeeper != nil {
+			if owner := k.nftKeeper.GetOwner(ctx, asset.ClassId, asset.NftId); !owner.Empty() {
+				info.CurrentOwner = owner.String()
+			}
+		}
+		info.Owned = info.CurrentOwner == asset.Owner
+		assets = append(assets, info)
+	}
+	return assets, nil
+}
+
+func (k Keeper) countDIDAssets(ctx context.Context, did string) (int, error) {
+	iter, err := k.DIDAssets.Iterate(ctx, collections.NewPrefixedTripleRange[string, string, string](did))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// cacheNFTMetadata writes an asset's metadata to its DID's DWN and returns
+// the record ID. The DWN only holds a copy, so failures are logged and leave
+// the asset uncached.
+func (k Keeper) cacheNFTMetadata(ctx context.Context, asset types.DIDAsset) string {
+	if k.nftMetadataCache == nil {
+		return ""
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	recordID, err := k.nftMetadataCache.CacheNFTMetadata(cacheCtx, asset)
+	if err != nil {
+		k.Logger().Error("failed to cache NFT metadata in DWN",
+			"did", asset.Did,
+			"class_id", asset.ClassId,
+			"nft_id", asset.NftId,
+			"error", err,
+		)
+		return ""
+	}
+	write()
+	return recordID
+}
+
+// removeNFTMetadata deletes the cached metadata of an asset, logging failures
+func (k Keeper) removeNFTMetadata(ctx context.Context, asset types.DIDAsset) {
+	if k.nftMetadataCache == nil || asset.DwnRecordId == "" {
+		return
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.nftMetadataCache.RemoveNFTMetadata(cacheCtx, asset.Did, asset.DwnRecordId); err != nil {
+		k.Logger().Error("failed to remove cached NFT metadata from DWN",
+			"did", asset.Did,
+			"record_id", asset.DwnRecordId,
+			"error", err,
+		)
+		return
+	}
+	write()
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/keeper"
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+type mockNFTKeeper struct {
+	owners map[string]sdk.AccAddress
+}
+
+func (m mockNFTKeeper) GetOwner(_ context.Context, classID, nftID string) sdk.AccAddress {
+	return m.owners[classID+"/"+nftID]
+}
+
+func (m mockNFTKeeper) GetNFT(_ context.Context, classID, nftID string) (nft.NFT, bool) {
+	_, found := m.owners[classID+"/"+nftID]
+	return nft.NFT{ClassId: classID, Id: nftID, Uri: "ipfs://" + nftID}, found
+}
+
+func (m mockNFTKeeper) GetClass(_ context.Context, classID string) (nft.Class, bool) {
+	return nft.Class{Id: classID, Name: "Class " + classID}, true
+}
+
+type mockNFTMetadataCache struct {
+	records map[string]types.DIDAsset
+}
+
+func (m mockNFTMetadataCache) CacheNFTMetadata(_ context.Context, asset types.DIDAsset) (string, error) {
+	id := "record-" + asset.ClassId + "-" + asset.NftId
+	m.records[id] = asset
+	return id, nil
+}
+
+func (m mockNFTMetadataCache) RemoveNFTMetadata(_ context.Context, _, recordID string) error {
+	delete(m.records, recordID)
+	return nil
+}
+
+func TestDIDAssets(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	owner, buyer := f.addrs[0], f.addrs[1]
+	nfts := mockNFTKeeper{owners: map[string]sdk.AccAddress{
+		"art/1":               owner,
+		"art/2":               buyer,
+		"ibc/ABCDEF/badges/7": owner,
+	}}
+	cache := mockNFTMetadataCache{records: map[string]types.DIDAsset{}}
+	f.k.SetNFTKeeper(nfts)
+	f.k.SetNFTMetadataCache(cache)
+	f.msgServer = keeper.NewMsgServerImpl(f.k)
+	f.queryServer = keeper.NewQuerier(f.k)
+
+	const did = "did:sonr:collector"
+	doc := types.DIDDocument{
+		Id:                did,
+		PrimaryController: owner.String(),
+		VerificationMethod: []*types.VerificationMethod{{
+			Id:                     did + "#key-1",
+			VerificationMethodKind: "Ed25519VerificationKey2020",
+			Controller:             did,
+			PublicKeyJwk:           `{"kty":"OKP","crv":"Ed25519","x":"test-public-key"}`,
+		}},
+		Authentication: []*types.VerificationMethodReference{{VerificationMethodId: did + "#key-1"}},
+	}
+	_, err := f.msgServer.CreateDID(f.ctx, &types.MsgCreateDID{Controller: owner.String(), DidDocument: doc})
+	require.NoError(err)
+
+	attach := func(signer sdk.AccAddress, classID, nftID string) (*types.MsgAttachNFTResponse, error) {
+		return f.msgServer.AttachNFT(f.ctx, &types.MsgAttachNFT{
+			Controller: signer.String(),
+			Did:        did,
+			ClassId:    classID,
+			NftId:      nftID,
+		})
+	}
+	assets := func(ownedOnly bool) []types.DIDAssetInfo {
+		res, err := f.queryServer.GetDIDAssets(f.ctx, &types.QueryGetDIDAssetsRequest{Did: did, OwnedOnly: ownedOnly})
+		require.NoError(err)
+		return res.Assets
+	}
+
+	// Only the controller can attach, and only tokens it owns
+	_, err = attach(buyer, "art", "2")
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = attach(owner, "art", "2")
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = attach(owner, "art", "missing")
+	require.ErrorIs(err, types.ErrInvalidAsset)
+
+	res, err := attach(owner, "art", "1")
+	require.NoError(err)
+	require.Equal("record-art-1", res.DwnRecordId)
+	require.Equal("ipfs://1", cache.records[res.DwnRecordId].Uri)
+	_, err = attach(owner, "ibc/ABCDEF/badges", "7")
+	require.NoError(err)
+
+	list := assets(false)
+	require.Len(list, 2)
+	require.Equal("Class art", list[0].Asset.ClassName)
+	require.True(list[0].Owned)
+	require.True(list[1].Asset.Interchain)
+
+	// A transferred token stays on the profile but is no longer owned
+	nfts.owners["art/1"] = buyer
+	require.Len(assets(false), 2)
+	require.Len(assets(true), 1)
+	require.Equal(buyer.String(), assets(false)[0].CurrentOwner)
+	require.False(assets(false)[0].Owned)
+
+	// Detaching removes the asset and its DWN record
+	_, err = f.msgServer.DetachNFT(f.ctx, &types.MsgDetachNFT{
+		Controller: owner.String(),
+		Did:        did,
+		ClassId:    "art",
+		NftId:      "1",
+	})
+	require.NoError(err)
+	require.NotContains(cache.records, "record-art-1")
+	require.Len(assets(false), 1)
+
+	_, err = f.msgServer.DetachNFT(f.ctx, &types.MsgDetachNFT{
+		Controller: owner.String(),
+		Did:        did,
+		ClassId:    "art",
+		NftId:      "1",
+	})
+	require.ErrorIs(err, types.ErrAssetNotFound)
+}
@@ -0,0 +1,399 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/mr-tron/base58"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// Proof types and Data Integrity cryptosuites verified on chain. Suites that
+// need RDF canonicalization, such as Ed25519Signature2020, are reported as
+// unsupported rather than accepted unchecked.
+const (
+	ProofKindDataIntegrity = "DataIntegrityProof"
+	ProofKindJWT           = "JwtProof2020"
+
+	CryptosuiteEdDSAJCS = "eddsa-jcs-2022"
+	CryptosuiteECDSAJCS = "ecdsa-jcs-2019"
+
+	proofPurposeAssertion = "assertionMethod"
+)
+
+// Multicodec prefixes of multibase public keys
+var (
+	multicodecEd25519   = []byte{0xed, 0x01}
+	multicodecP256      = []byte{0x80, 0x24}
+	multicodecSecp256k1 = []byte{0xe7, 0x01}
+)
+
+// verifyFunc checks a signature over msg for a JOSE algorithm
+type verifyFunc func(alg string, msg, sig []byte) error
+
+// verifyCredentialProofs checks every proof of a credential against the
+// issuer's assertion methods. A credential without proofs does not verify.
+func verifyCredentialProofs(issuer *types.DIDDocument, cred *types.VerifiableCredential) error {
+	if len(cred.Proof) == 0 {
+		return types.ErrInvalidCredential.Wrap("credential has no proof")
+	}
+	for _, proof := range cred.Proof {
+		if proof == nil {
+			return types.ErrInvalidCredential.Wrap("empty proof")
+		}
+		var err error
+		switch proof.ProofKind {
+		case ProofKindDataIntegrity:
+			err = verifyDataIntegrityProof(issuer, cred, proof)
+		case ProofKindJWT:
+			err = verifyJWTProof(issuer, cred, proof)
+		default:
+			err = fmt.Errorf("unsupported proof type %q", proof.ProofKind)
+		}
+		if err != nil {
+			return types.ErrInvalidCredential.Wrapf("%s proof: %s", proof.ProofKind, err)
+		}
+	}
+	return nil
+}
+
+// verifyDataIntegrityProof verifies an eddsa-jcs-2022 or ecdsa-jcs-2019
+// proof: the signature covers the SHA-256 hashes of the canonical proof
+// options followed by the canonical document
+func verifyDataIntegrityProof(issuer *types.DIDDocument, cred *types.VerifiableCredential, proof *types.CredentialProof) error {
+	suite := proof.Properties["cryptosuite"]
+	var alg string
+	switch suite {
+	case CryptosuiteEdDSAJCS:
+		alg = "EdDSA"
+	case CryptosuiteECDSAJCS:
+		alg = "ES256"
+	default:
+		return fmt.Errorf("unsupported cryptosuite %q", suite)
+	}
+	if proof.ProofPurpose != proofPurposeAssertion {
+		return fmt.Errorf("proof purpose must be %s", proofPurposeAssertion)
+	}
+
+	verify, err := assertionKey(issuer, proof.VerificationMethod)
+	if err != nil {
+		return err
+	}
+
+	doc, err := types.CredentialDocument(cred)
+	if err != nil {
+		return err
+	}
+	options := map[string]any{
+		"@context":           doc["@context"],
+		"type":               proof.ProofKind,
+		"verificationMethod": proof.VerificationMethod,
+		"proofPurpose":       proof.ProofPurpose,
+	}
+	if proof.Created != "" {
+		options["created"] = proof.Created
+	}
+	for k, v := range proof.Properties {
+		if k != "proofValue" {
+			options[k] = v
+		}
+	}
+
+	canonicalOptions, err := types.CanonicalJSON(options)
+	if err != nil {
+		return err
+	}
+	canonicalDoc, err := types.CanonicalJSON(doc)
+	if err != nil {
+		return err
+	}
+	optionsHash, docHash := sha256.Sum256(canonicalOptions), sha256.Sum256(canonicalDoc)
+	signed := append(optionsHash[:], docHash[:]...)
+
+	if !strings.HasPrefix(proof.Signature, "z") {
+		return fmt.Errorf("proof value must be base58btc multibase")
+	}
+	sig, err := base58.Decode(proof.Signature[1:])
+	if err != nil {
+		return fmt.Errorf("invalid proof value: %w", err)
+	}
+	return verify(alg, signed, sig)
+}
+
+// verifyJWTProof verifies a credential secured as a VC-JWT: the proof's
+// signature is the compact JWS, whose claims must name the credential's
+// issuer, ID and subject and whose vc claim must carry the same subject
+func verifyJWTProof(issuer *types.DIDDocument, cred *types.VerifiableCredential, proof *types.CredentialProof) error {
+	parts := strings.Split(proof.Signature, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("signature is not a compact JWS")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return fmt.Errorf("invalid JWS header: %w", err)
+	}
+	var claims struct {
+		Iss string `json:"iss"`
+		Sub string `json:"sub"`
+		Jti string `json:"jti"`
+		VC  struct {
+			CredentialSubject json.RawMessage `json:"credentialSubject"`
+		} `json:"vc"`
+	}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	kid := header.Kid
+	if kid == "" {
+		kid = proof.VerificationMethod
+	} else if strings.HasPrefix(kid, "#") {
+		kid = issuer.Id + kid
+	}
+	verify, err := assertionKey(issuer, kid)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("invalid JWS signature encoding: %w", err)
+	}
+	if err := verify(header.Alg, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return err
+	}
+
+	if claims.Iss != cred.Issuer {
+		return fmt.Errorf("iss %q is not the issuer", claims.Iss)
+	}
+	if claims.Jti != "" && claims.Jti != cred.Id {
+		return fmt.Errorf("jti %q is not the credential ID", claims.Jti)
+	}
+	if claims.Sub != "" && cred.Subject != "" && claims.Sub != cred.Subject {
+		return fmt.Errorf("sub %q is not the subject", claims.Sub)
+	}
+	signedSubject, err := canonicalRaw(claims.VC.CredentialSubject)
+	if err != nil {
+		return fmt.Errorf("invalid vc claim: %w", err)
+	}
+	storedSubject, err := canonicalRaw(cred.CredentialSubject)
+	if err != nil {
+		return fmt.Errorf("invalid credential subject: %w", err)
+	}
+	if signedSubject != storedSubject {
+		return fmt.Errorf("signed credential subject differs from the anchored one")
+	}
+	return nil
+}
+
+// assertionKey returns the key of a verification method the issuer lists
+// under assertionMethod
+func assertionKey(issuer *types.DIDDocument, vmID string) (verifyFunc, error) {
+	if vmID == "" {
+		return nil, fmt.Errorf("proof names no verification method")
+	}
+
+	var vm *types.VerificationMethod
+	for _, ref := range issuer.AssertionMethod {
+		if ref == nil {
+			continue
+		}
+		if ref.EmbeddedVerificationMethod != nil && ref.EmbeddedVerificationMethod.Id == vmID {
+			vm = ref.EmbeddedVerificationMethod
+			break
+		}
+		if ref.VerificationMethodId == vmID {
+			for _, m := range issuer.VerificationMethod {
+				if m != nil && m.Id == vmID {
+					vm = m
+					break
+				}
+			}
+			break
+		}
+	}
+	if vm == nil {
+		return nil, fmt.Errorf("%s is not an assertion method of %s", vmID, issuer.Id)
+	}
+	return methodKey(vm)
+}
+
+// methodKey reads the public key of a verification method from its JWK,
+// multibase, base58, base64 or hex key material
+func methodKey(vm *types.VerificationMethod) (verifyFunc, error) {
+	switch {
+	case vm.PublicKeyJwk != "":
+		var jwk struct {
+			Kty string `json:"kty"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}
+		if err := json.Unmarshal([]byte(vm.PublicKeyJwk), &jwk); err != nil {
+			return nil, fmt.Errorf("invalid JWK: %w", err)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWK x: %w", err)
+		}
+		if jwk.Kty == "OKP" && jwk.Crv == "Ed25519" {
+			return ed25519Key(x)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil || jwk.Kty != "EC" {
+			return nil, fmt.Errorf("unsupported JWK %s %s", jwk.Kty, jwk.Crv)
+		}
+		switch jwk.Crv {
+		case "P-256":
+			return p256Key(append(append([]byte{0x04}, x...), y...))
+		case "secp256k1":
+			return secp256k1Key(append(append([]byte{0x04}, x...), y...))
+		}
+		return nil, fmt.Errorf("unsupported JWK curve %s", jwk.Crv)
+
+	case vm.PublicKeyMultibase != "":
+		if !strings.HasPrefix(vm.PublicKeyMultibase, "z") {
+			return nil, fmt.Errorf("multibase key must be base58btc")
+		}
+		bz, err := base58.Decode(vm.PublicKeyMultibase[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid multibase key: %w", err)
+		}
+		switch {
+		case hasPrefix(bz, multicodecEd25519):
+			return ed25519Key(bz[2:])
+		case hasPrefix(bz, multicodecP256):
+			return p256Key(bz[2:])
+		case hasPrefix(bz, multicodecSecp256k1):
+			return secp256k1Key(bz[2:])
+		}
+		return nil, fmt.Errorf("unsupported multicodec key")
+
+	case vm.PublicKeyBase58 != "":
+		bz, err := base58.Decode(vm.PublicKeyBase58)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base58 key: %w", err)
+		}
+		return ed25519Key(bz)
+
+	case vm.PublicKeyBase64 != "":
+		bz, err := base64.StdEncoding.DecodeString(vm.PublicKeyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 key: %w", err)
+		}
+		return ed25519Key(bz)
+
+	case vm.PublicKeyHex != "":
+		bz, err := hex.DecodeString(vm.PublicKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex key: %w", err)
+		}
+		return ed25519Key(bz)
+	}
+	return nil, fmt.Errorf("verification method %s has no supported key material", vm.Id)
+}
+
+func ed25519Key(bz []byte) (verifyFunc, error) {
+	if len(bz) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid Ed25519 key size %d", len(bz))
+	}
+	pub := ed25519.PublicKey(bz)
+	return func(alg string, msg, sig []byte) error {
+		if alg != "EdDSA" {
+			return fmt.Errorf("algorithm %s does not match an Ed25519 key", alg)
+		}
+		if !ed25519.Verify(pub, msg, sig) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}, nil
+}
+
+func p256Key(bz []byte) (verifyFunc, error) {
+	var x, y *big.Int
+	switch len(bz) {
+	case 33:
+		x, y = elliptic.UnmarshalCompressed(elliptic.P256(), bz)
+	case 65:
+		x, y = elliptic.Unmarshal(elliptic.P256(), bz) //nolint:staticcheck // the key is validated on the curve
+	}
+	if x == nil {
+		return nil, fmt.Errorf("invalid P-256 key")
+	}
+	pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	return func(alg string, msg, sig []byte) error {
+		if alg != "ES256" {
+			return fmt.Errorf("algorithm %s does not match a P-256 key", alg)
+		}
+		if len(sig) != 64 {
+			return fmt.Errorf("invalid ES256 signature size %d", len(sig))
+		}
+		hash := sha256.Sum256(msg)
+		r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(pub, hash[:], r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}, nil
+}
+
+func secp256k1Key(bz []byte) (verifyFunc, error) {
+	switch {
+	case len(bz) == 65 && bz[0] == 0x04:
+		// Compress: the prefix carries the parity of y
+		compressed := make([]byte, 33)
+		compressed[0] = 0x02 | bz[64]&1
+		copy(compressed[1:], bz[1:33])
+		bz = compressed
+	case len(bz) != 33:
+		return nil, fmt.Errorf("invalid secp256k1 key size %d", len(bz))
+	}
+	pub := &secp256k1.PubKey{Key: bz}
+	return func(alg string, msg, sig []byte) error {
+		if alg != "ES256K" {
+			return fmt.Errorf("algorithm %s does not match a secp256k1 key", alg)
+		}
+		if !pub.VerifySignature(msg, sig) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}, nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	bz, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// canonicalRaw canonicalizes a JSON value for comparison
+func canonicalRaw(raw []byte) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return "", err
+	}
+	bz, err := types.CanonicalJSON(v)
+	return string(bz), err
+}
+
+func hasPrefix(bz, prefix []byte) bool {
+	return len(bz) > len(prefix) && string(bz[:len(prefix)]) == string(prefix)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// assignCredentialStatus reserves the StatusList2021 entry of a credential
+// being issued. An entry the issuer put in the credential, typically one
+// covered by its proof, is reserved as given: it must name one of the
+// issuer's lists, or the list after its last, at a free index. A credential
+// without a proof and without a status gets the next free index of the
+// issuer's current list. A signed credential without a status is left as
+// is, since adding one would break its proof; it can still be revoked, but
+// only the chain records it.
+func (k Keeper) assignCredentialStatus(ctx context.Context, cred *types.VerifiableCredential) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if cred.CredentialStatus != nil {
+		listID, index, err := types.ParseStatusListEntry(cred.Issuer, cred.CredentialStatus)
+		if err != nil {
+			return err
+		}
+		list, err := k.StatusLists.Get(ctx, collections.Join(cred.Issuer, listID))
+		if errors.Is(err, collections.ErrNotFound) {
+			last, found, err := k.lastStatusList(ctx, cred.Issuer)
+			if err != nil {
+				return err
+			}
+			if (found && listID != last.ListId+1) || (!found && listID != 1) {
+				return errorsmod.Wrapf(types.ErrStatusListNotFound, "%s", types.StatusListURL(cred.Issuer, listID))
+			}
+			list = types.NewStatusList(cred.Issuer, listID, now)
+		} else if err != nil {
+			return err
+		}
+		return k.reserveStatusEntry(ctx, &list, index, cred.Id)
+	}
+
+	if len(cred.Proof) > 0 {
+		return nil
+	}
+
+	list, found, err := k.lastStatusList(ctx, cred.Issuer)
+	if err != nil {
+		return err
+	}
+	if !found {
+		list = types.NewStatusList(cred.Issuer, 1, now)
+	} else if list.Allocated >= types.StatusListCapacity {
+		list = types.NewStatusList(cred.Issuer, list.ListId+1, now)
+	}
+	index := list.Allocated
+	if err := k.reserveStatusEntry(ctx, &list, index, cred.Id); err != nil {
+		return err
+	}
+	cred.CredentialStatus = types.NewStatusListEntry(cred.Issuer, list.ListId, index)
+	return nil
+}
+
+// reserveStatusEntry records that index of list belongs to a credential and
+// moves the list's allocation past it, skipping indexes the issuer took
+func (k Keeper) reserveStatusEntry(ctx context.Context, list *types.StatusList, index uint64, credentialID string) error {
+	key := collections.Join3(list.Issuer, list.ListId, index)
+	taken, err := k.StatusListEntries.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errorsmod.Wrapf(types.ErrStatusListEntryTaken, "%s#%d", types.StatusListURL(list.Issuer, list.ListId), index)
+	}
+	if err := k.StatusListEntries.Set(ctx, key, credentialID); err != nil {
+		return err
+	}
+
+	for list.Allocated < types.StatusListCapacity {
+		if list.Allocated != index {
+			taken, err := k.StatusListEntries.Has(ctx, collections.Join3(list.Issuer, list.ListId, list.Allocated))
+			if err != nil {
+				return err
+			}
+			if !taken {
+				break
+			}
+		}
+		list.Allocated++
+	}
+	return k.StatusLists.Set(ctx, collections.Join(list.Issuer, list.ListId), *list)
+}
+
+// lastStatusList returns the issuer's highest numbered list
+func (k Keeper) lastStatusList(ctx context.Context, issuer string) (types.StatusList, bool, error) {
+	iter, err := k.StatusLists.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](issuer).Descending())
+	if err != nil {
+		return types.StatusList{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.StatusList{}, false, nil
+	}
+	list, err := iter.Value()
+	return list, err == nil, err
+}
+
+// revokeCredentialStatus sets the status list entry of a revoked credential
+func (k Keeper) revokeCredentialStatus(ctx context.Context, cred *types.VerifiableCredential) error {
+	if cred.CredentialStatus == nil {
+		return nil
+	}
+	listID, index, err := types.ParseStatusListEntry(cred.Issuer, cred.CredentialStatus)
+	if err != nil {
+		return err
+	}
+	list, err := k.StatusLists.Get(ctx, collections.Join(cred.Issuer, listID))
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrStatusListNotFound, "%s", types.StatusListURL(cred.Issuer, listID))
+	}
+	list.Set(index)
+	list.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	return k.StatusLists.Set(ctx, collections.Join(cred.Issuer, listID), list)
+}
+
+// isCredentialRevoked reads the revocation of a credential from its status
+// list entry, or from the credential itself when it has none
+func (k Keeper) isCredentialRevoked(ctx context.Context, cred *types.VerifiableCredential) (bool, error) {
+	if cred.Revoked || cred.CredentialStatus == nil {
+		return cred.Revoked, nil
+	}
+	listID, index, err := types.ParseStatusListEntry(cred.Issuer, cred.CredentialStatus)
+	if err != nil {
+		return false, err
+	}
+	list, err := k.StatusLists.Get(ctx, collections.Join(cred.Issuer, listID))
+	if err != nil {
+		return false, errorsmod.Wrapf(types.ErrStatusListNotFound, "%s", types.StatusListURL(cred.Issuer, listID))
+	}
+	return list.IsSet(index), nil
+}
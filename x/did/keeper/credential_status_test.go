@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/keeper"
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+func TestCredentialStatusLists(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	const issuer = "did:sonr:university"
+	pub, priv, err := ed25519.GenerateKey(bytes.NewReader(make([]byte, 64)))
+	require.NoError(err)
+	vmID := issuer + "#key-1"
+	doc := &types.DIDDocument{
+		Id:                issuer,
+		PrimaryController: f.addrs[0].String(),
+		VerificationMethod: []*types.VerificationMethod{{
+			Id:                     vmID,
+			VerificationMethodKind: "Ed25519VerificationKey2020",
+			Controller:             issuer,
+			PublicKeyMultibase:     "z" + base58.Encode(append([]byte{0xed, 0x01}, pub...)),
+		}},
+		AssertionMethod: []*types.VerificationMethodReference{{VerificationMethodId: vmID}},
+		Version:         1,
+	}
+	require.NoError(f.k.OrmDB.DIDDocumentTable().Insert(f.ctx, doc.ToORM()))
+
+	blockTime := f.ctx.BlockTime()
+	// Issuer and subject are unique together, so each credential has its own
+	// subject
+	newCredential := func(id string) types.VerifiableCredential {
+		subject := "did:sonr:" + strings.TrimPrefix(id, "urn:cred:")
+		return types.VerifiableCredential{
+			Id:                id,
+			Context:           []string{"https://www.w3.org/2018/credentials/v1"},
+			CredentialKinds:   []string{"VerifiableCredential", "UniversityDegreeCredential"},
+			Issuer:            issuer,
+			Subject:           subject,
+			IssuanceDate:      blockTime.Format(time.RFC3339),
+			ExpirationDate:    blockTime.Add(24 * time.Hour).Format(time.RFC3339),
+			CredentialSubject: []byte(`{"id":"` + subject + `","degree":{"name":"Bachelor of Science","honors":1.5}}`),
+		}
+	}
+	issue := func(cred types.VerifiableCredential) error {
+		_, err := f.msgServer.IssueVerifiableCredential(f.ctx, &types.MsgIssueVerifiableCredential{
+			Issuer:     f.addrs[0].String(),
+			Credential: cred,
+		})
+		return err
+	}
+	verify := func(id string) *types.QueryVerifyCredentialResponse {
+		res, err := f.queryServer.VerifyCredential(f.ctx, &types.QueryVerifyCredentialRequest{CredentialId: id})
+		require.NoError(err)
+		return res
+	}
+	signDataIntegrity := func(cred *types.VerifiableCredential) {
+		proof := &types.CredentialProof{
+			ProofKind:          keeper.ProofKindDataIntegrity,
+			Created:            blockTime.Format(time.RFC3339),
+			VerificationMethod: vmID,
+			ProofPurpose:       "assertionMethod",
+			Properties:         map[string]string{"cryptosuite": keeper.CryptosuiteEdDSAJCS},
+		}
+		credDoc, err := types.CredentialDocument(cred)
+		require.NoError(err)
+		options, err := types.CanonicalJSON(map[string]any{
+			"@context":           credDoc["@context"],
+			"type":               proof.ProofKind,
+			"cryptosuite":        keeper.CryptosuiteEdDSAJCS,
+			"created":            proof.Created,
+			"verificationMethod": vmID,
+			"proofPurpose":       "assertionMethod",
+		})
+		require.NoError(err)
+		canonical, err := types.CanonicalJSON(credDoc)
+		require.NoError(err)
+		optionsHash, docHash := sha256.Sum256(options), sha256.Sum256(canonical)
+		proof.Signature = "z" + base58.Encode(ed25519.Sign(priv, append(optionsHash[:], docHash[:]...)))
+		cred.Proof = []*types.CredentialProof{proof}
+	}
+
+	// Unsigned credentials get the next entries of the issuer's first list
+	require.NoError(issue(newCredential("urn:cred:1")))
+	require.NoError(issue(newCredential("urn:cred:2")))
+	res, err := f.queryServer.GetVerifiableCredential(f.ctx, &types.QueryGetVerifiableCredentialRequest{CredentialId: "urn:cred:2"})
+	require.NoError(err)
+	require.Equal(types.NewStatusListEntry(issuer, 1, 1), res.Credential.CredentialStatus)
+
+	// An unsigned credential anchors no proof to verify
+	v := verify("urn:cred:1")
+	require.False(v.Valid)
+	require.False(v.ProofVerified)
+	require.False(v.Revoked)
+
+	// A signed credential carries the entry its proof covers
+	signed := newCredential("urn:cred:signed")
+	signed.CredentialStatus = types.NewStatusListEntry(issuer, 1, 5)
+	signDataIntegrity(&signed)
+	require.NoError(issue(signed))
+	v = verify("urn:cred:signed")
+	require.True(v.Valid, v.Reason)
+	require.True(v.ProofVerified)
+
+	// Entries cannot be shared, and lists must follow the issuer's last
+	taken := newCredential("urn:cred:taken")
+	taken.CredentialStatus = types.NewStatusListEntry(issuer, 1, 5)
+	require.ErrorIs(issue(taken), types.ErrStatusListEntryTaken)
+	skipped := newCredential("urn:cred:skipped")
+	skipped.CredentialStatus = types.NewStatusListEntry(issuer, 3, 0)
+	require.ErrorIs(issue(skipped), types.ErrStatusListNotFound)
+	foreign := newCredential("urn:cred:foreign")
+	foreign.CredentialStatus = types.NewStatusListEntry("did:sonr:other", 1, 0)
+	require.ErrorIs(issue(foreign), types.ErrInvalidCredential)
+
+	// Allocation continues after the issuer's entries
+	list, err := f.queryServer.GetStatusList(f.ctx, &types.QueryGetStatusListRequest{Issuer: issuer, ListId: 1})
+	require.NoError(err)
+	require.Equal(uint64(2), list.StatusList.Allocated)
+
+	// A tampered credential no longer verifies
+	tampered := newCredential("urn:cred:tampered")
+	signDataIntegrity(&tampered)
+	tampered.CredentialSubject = []byte(`{"id":"did:sonr:tampered","degree":{"name":"Doctor of Philosophy"}}`)
+	tampered.CredentialStatus = types.NewStatusListEntry(issuer, 1, 6)
+	require.NoError(issue(tampered))
+	v = verify("urn:cred:tampered")
+	require.False(v.ProofVerified)
+	require.Contains(v.Reason, "invalid signature")
+
+	// Revocation sets the entry in the published list
+	_, err = f.msgServer.RevokeVerifiableCredential(f.ctx, &types.MsgRevokeVerifiableCredential{
+		Issuer:       f.addrs[0].String(),
+		CredentialId: "urn:cred:signed",
+	})
+	require.NoError(err)
+	v = verify("urn:cred:signed")
+	require.True(v.Revoked)
+	require.True(v.ProofVerified)
+	require.False(v.Valid)
+
+	list, err = f.queryServer.GetStatusList(f.ctx, &types.QueryGetStatusListRequest{Issuer: issuer, ListId: 1})
+	require.NoError(err)
+	require.True(list.StatusList.IsSet(5))
+	require.False(list.StatusList.IsSet(0))
+
+	var statusCredential struct {
+		ID                string `json:"id"`
+		CredentialSubject struct {
+			EncodedList string `json:"encodedList"`
+		} `json:"credentialSubject"`
+	}
+	require.NoError(json.Unmarshal([]byte(list.Credential), &statusCredential))
+	require.Equal(types.StatusListURL(issuer, 1), statusCredential.ID)
+	compressed, err := base64.RawURLEncoding.DecodeString(statusCredential.CredentialSubject.EncodedList)
+	require.NoError(err)
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(err)
+	bits, err := io.ReadAll(zr)
+	require.NoError(err)
+	require.Len(bits, types.StatusListCapacity/8)
+	require.Equal(byte(0x04), bits[0])
+
+	// Expiry is measured against block time
+	v = verify("urn:cred:2")
+	require.False(v.Expired)
+	f.ctx = f.ctx.WithBlockTime(blockTime.Add(48 * time.Hour))
+	v = verify("urn:cred:2")
+	require.True(v.Expired)
+}
+
+func TestCredentialJWTProof(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	const issuer = "did:sonr:employer"
+	pub, priv, err := ed25519.GenerateKey(bytes.NewReader(bytes.Repeat([]byte{1}, 64)))
+	require.NoError(err)
+	doc := &types.DIDDocument{
+		Id:                issuer,
+		PrimaryController: f.addrs[0].String(),
+		AssertionMethod: []*types.VerificationMethodReference{{
+			EmbeddedVerificationMethod: &types.VerificationMethod{
+				Id:                     issuer + "#jwt",
+				VerificationMethodKind: "JsonWebKey2020",
+				Controller:             issuer,
+				PublicKeyJwk:           `{"kty":"OKP","crv":"Ed25519","x":"` + base64.RawURLEncoding.EncodeToString(pub) + `"}`,
+			},
+		}},
+		Version: 1,
+	}
+	require.NoError(f.k.OrmDB.DIDDocumentTable().Insert(f.ctx, doc.ToORM()))
+
+	jwt := func(subject string) string {
+		enc := func(v any) string {
+			bz, err := json.Marshal(v)
+			require.NoError(err)
+			return base64.RawURLEncoding.EncodeToString(bz)
+		}
+		input := enc(map[string]string{"alg": "EdDSA", "kid": "#jwt"}) + "." + enc(map[string]any{
+			"iss": issuer,
+			"sub": "did:sonr:bob",
+			"jti": "urn:cred:employment",
+			"vc":  map[string]any{"credentialSubject": json.RawMessage(subject)},
+		})
+		return input + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(priv, []byte(input)))
+	}
+
+	cred := types.VerifiableCredential{
+		Id:                "urn:cred:employment",
+		CredentialKinds:   []string{"VerifiableCredential", "EmploymentCredential"},
+		Issuer:            issuer,
+		Subject:           "did:sonr:bob",
+		CredentialSubject: []byte(`{"id":"did:sonr:bob","role":"engineer"}`),
+		Proof: []*types.CredentialProof{{
+			ProofKind: keeper.ProofKindJWT,
+			Signature: jwt(`{"role": "engineer", "id": "did:sonr:bob"}`),
+		}},
+	}
+	_, err = f.msgServer.IssueVerifiableCredential(f.ctx, &types.MsgIssueVerifiableCredential{
+		Issuer:     f.addrs[0].String(),
+		Credential: cred,
+	})
+	require.NoError(err)
+
+	res, err := f.queryServer.VerifyCredential(f.ctx, &types.QueryVerifyCredentialRequest{CredentialId: cred.Id})
+	require.NoError(err)
+	require.True(res.Valid, res.Reason)
+
+	// A JWT for other claims does not prove the anchored credential
+	cred.Id = "urn:cred:mismatch"
+	cred.Subject = "did:sonr:carol"
+	cred.Proof[0].Signature = jwt(`{"id":"did:sonr:bob","role":"director"}`)
+	_, err = f.msgServer.IssueVerifiableCredential(f.ctx, &types.MsgIssueVerifiableCredential{
+		Issuer:     f.addrs[0].String(),
+		Credential: cred,
+	})
+	require.NoError(err)
+	res, err = f.queryServer.VerifyCredential(f.ctx, &types.QueryVerifyCredentialRequest{CredentialId: cred.Id})
+	require.NoError(err)
+	require.False(res.ProofVerified)
+}
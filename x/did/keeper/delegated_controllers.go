@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// setDelegatedController stores a delegation, replacing the expiry of one the
+// controller already holds so the expiry index keeps a single entry for it
+func (k Keeper) setDelegatedController(ctx context.Context, delegation types.DelegatedController) error {
+	key := collections.Join(delegation.Did, delegation.Controller)
+	existing, err := k.DelegatedControllers.Get(ctx, key)
+	switch {
+	case err == nil:
+		if err := k.DelegationExpiries.Remove(ctx, collections.Join3(existing.ExpiresAt, existing.Did, existing.Controller)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.DelegatedControllers.Set(ctx, key, delegation); err != nil {
+		return err
+	}
+	return k.DelegationExpiries.Set(ctx, collections.Join3(delegation.ExpiresAt, delegation.Did, delegation.Controller))
+}
+
+// removeDelegatedController deletes a delegation and its expiry index entry
+func (k Keeper) removeDelegatedController(ctx context.Context, did, controller string) (types.DelegatedController, error) {
+	key := collections.Join(did, controller)
+	delegation, err := k.DelegatedControllers.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return delegation, errorsmod.Wrapf(types.ErrDelegationNotFound, "%s for %s", controller, did)
+	} else if err != nil {
+		return delegation, err
+	}
+
+	if err := k.DelegatedControllers.Remove(ctx, key); err != nil {
+		return delegation, err
+	}
+	return delegation, k.DelegationExpiries.Remove(ctx, collections.Join3(delegation.ExpiresAt, did, controller))
+}
+
+// isDelegatedController reports whether controller holds a delegation of did
+// that has not expired at the current block time. Expired delegations are
+// refused here even before the end of block removes them.
+func (k Keeper) isDelegatedController(ctx context.Context, did, controller string) (bool, error) {
+	delegation, err := k.DelegatedControllers.Get(ctx, collections.Join(did, controller))
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return delegation.ExpiresAt > sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), nil
+}
+
+// ActiveDelegatedControllers returns the delegations of a DID that have not
+// expired, soonest expiry first
+func (k Keeper) ActiveDelegatedControllers(ctx context.Context, did string) ([]types.DelegatedController, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	iter, err := k.DelegatedControllers.Iterate(ctx, collections.NewPrefixedPairRange[string, string](did))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var active []types.DelegatedController
+	for ; iter.Valid(); iter.Next() {
+		delegation, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		if delegation.ExpiresAt > now {
+			active = append(active, delegation)
+		}
+	}
+
+	sort.SliceStable(active, func(i, j int) bool { return active[i].ExpiresAt < active[j].ExpiresAt })
+	return active, nil
+}
+
+// RemoveExpiredDelegations removes the delegations whose expiry has passed
+// and emits an EventDelegatedControllerRemoved for each
+func (k Keeper) RemoveExpiredDelegations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	// Expiries are read before any is removed, as the set cannot be written
+	// while it is being iterated. Keys are ordered by expiry, so the scan
+	// stops at the first delegation still active.
+	iter, err := k.DelegationExpiries.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var expired []collections.Triple[int64, string, string]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if key.K1() > now {
+			break
+		}
+		expired = append(expired, key)
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		if _, err := k.removeDelegatedController(ctx, key.K2(), key.K3()); err != nil {
+			return err
+		}
+		event := &types.EventDelegatedControllerRemoved{
+			Did:         key.K2(),
+			Delegate:    key.K3(),
+			Expired:     true,
+			BlockHeight: uint64(sdkCtx.BlockHeight()),
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+			k.Logger().With("error", err).Error("Failed to emit EventDelegatedControllerRemoved")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+func TestDelegatedControllers(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	params := types.DefaultParams()
+	params.Document.MaxDelegatedControllers = 1
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	const did = "did:sonr:enterprise"
+	owner, delegate, other := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	doc := types.DIDDocument{
+		Id:                did,
+		PrimaryController: owner,
+		VerificationMethod: []*types.VerificationMethod{{
+			Id:                     did + "#key-1",
+			VerificationMethodKind: "Ed25519VerificationKey2020",
+			Controller:             did,
+			PublicKeyJwk:           `{"kty":"OKP","crv":"Ed25519","x":"test-public-key"}`,
+		}},
+		Authentication: []*types.VerificationMethodReference{{VerificationMethodId: did + "#key-1"}},
+	}
+	_, err := f.msgServer.CreateDID(f.ctx, &types.MsgCreateDID{Controller: owner, DidDocument: doc})
+	require.NoError(err)
+
+	blockTime := f.ctx.BlockTime()
+	expiresAt := blockTime.Add(time.Hour).Unix()
+	addDelegation := func(signer, to string, expiresAt int64) error {
+		_, err := f.msgServer.AddDelegatedController(f.ctx, &types.MsgAddDelegatedController{
+			Controller: signer,
+			Did:        did,
+			Delegate:   to,
+			ExpiresAt:  expiresAt,
+			Purpose:    "recovery",
+		})
+		return err
+	}
+	addService := func(signer, id string) error {
+		_, err := f.msgServer.AddService(f.ctx, &types.MsgAddService{
+			Controller: signer,
+			Did:        did,
+			Service: types.Service{
+				Id:             did + "#" + id,
+				ServiceKind:    "LinkedDomains",
+				SingleEndpoint: "https://example.com",
+			},
+		})
+		return err
+	}
+	active := func() []types.DelegatedController {
+		res, err := f.queryServer.GetDelegatedControllers(f.ctx, &types.QueryGetDelegatedControllersRequest{Did: did})
+		require.NoError(err)
+		return res.Delegations
+	}
+
+	// Only the document's controllers delegate, for a bounded time
+	require.ErrorIs(addService(delegate, "service-1"), types.ErrUnauthorized)
+	require.ErrorIs(addDelegation(delegate, other, expiresAt), types.ErrUnauthorized)
+	require.ErrorIs(addDelegation(owner, delegate, blockTime.Unix()), types.ErrInvalidDelegation)
+	require.ErrorIs(addDelegation(owner, delegate, blockTime.Add(91*24*time.Hour).Unix()), types.ErrInvalidDelegation)
+	require.NoError(addDelegation(owner, delegate, expiresAt))
+	require.ErrorIs(addDelegation(owner, other, expiresAt), types.ErrInvalidDelegation)
+
+	// A delegate manages services but cannot delegate further
+	require.NoError(addService(delegate, "service-1"))
+	require.ErrorIs(addDelegation(delegate, other, expiresAt), types.ErrUnauthorized)
+	require.Len(active(), 1)
+	require.Equal(owner, active()[0].GrantedBy)
+
+	// Renewing replaces the expiry rather than counting twice
+	expiresAt = blockTime.Add(2 * time.Hour).Unix()
+	require.NoError(addDelegation(owner, delegate, expiresAt))
+	require.Equal(expiresAt, active()[0].ExpiresAt)
+
+	// Expired delegations are refused before EndBlock removes them
+	f.ctx = f.ctx.WithBlockTime(time.Unix(expiresAt, 0))
+	require.ErrorIs(addService(delegate, "service-2"), types.ErrUnauthorized)
+	require.Empty(active())
+	has, err := f.k.DelegatedControllers.Has(f.ctx, collections.Join(did, delegate))
+	require.NoError(err)
+	require.True(has)
+
+	require.NoError(f.k.RemoveExpiredDelegations(f.ctx))
+	has, err = f.k.DelegatedControllers.Has(f.ctx, collections.Join(did, delegate))
+	require.NoError(err)
+	require.False(has)
+	var expired bool
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type == "did.v1.EventDelegatedControllerRemoved" {
+			expired = true
+		}
+	}
+	require.True(expired)
+
+	// A delegate may end its own delegation early
+	require.NoError(addDelegation(owner, other, f.ctx.BlockTime().Add(time.Hour).Unix()))
+	_, err = f.msgServer.RemoveDelegatedController(f.ctx, &types.MsgRemoveDelegatedController{
+		Controller: delegate,
+		Did:        did,
+		Delegate:   other,
+	})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = f.msgServer.RemoveDelegatedController(f.ctx, &types.MsgRemoveDelegatedController{
+		Controller: other,
+		Did:        did,
+		Delegate:   other,
+	})
+	require.NoError(err)
+	require.Empty(active())
+}
+
+func TestDelegatedControllerCannotTakeControl(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	const did = "did:sonr:recoverable"
+	owner, delegate := f.addrs[0].String(), f.addrs[1].String()
+	doc := types.DIDDocument{
+		Id:                did,
+		PrimaryController: owner,
+		VerificationMethod: []*types.VerificationMethod{{
+			Id:                     did + "#key-1",
+			VerificationMethodKind: "Ed25519VerificationKey2020",
+			Controller:             did,
+			PublicKeyJwk:           `{"kty":"OKP","crv":"Ed25519","x":"test-public-key"}`,
+		}},
+		Authentication: []*types.VerificationMethodReference{{VerificationMethodId: did + "#key-1"}},
+	}
+	_, err := f.msgServer.CreateDID(f.ctx, &types.MsgCreateDID{Controller: owner, DidDocument: doc})
+	require.NoError(err)
+
+	_, err = f.msgServer.AddDelegatedController(f.ctx, &types.MsgAddDelegatedController{
+		Controller: owner,
+		Did:        did,
+		Delegate:   delegate,
+		ExpiresAt:  f.ctx.BlockTime().Add(time.Hour).Unix(),
+		Purpose:    "recovery",
+	})
+	require.NoError(err)
+
+	// Making itself the primary controller
+	takeover := doc
+	takeover.PrimaryController = delegate
+	_, err = f.msgServer.UpdateDID(f.ctx, &types.MsgUpdateDID{Controller: delegate, Did: did, DidDocument: takeover})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// Adding a capabilityInvocation key it controls
+	_, err = f.msgServer.AddVerificationMethod(f.ctx, &types.MsgAddVerificationMethod{
+		Controller: delegate,
+		Did:        did,
+		VerificationMethod: types.VerificationMethod{
+			Id:                     did + "#delegate-key",
+			VerificationMethodKind: "Ed25519VerificationKey2020",
+			Controller:             delegate,
+			PublicKeyJwk:           `{"kty":"OKP","crv":"Ed25519","x":"delegate-public-key"}`,
+		},
+		Relationships: []string{"capabilityInvocation"},
+	})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// Removing the owner's keys, or deactivating the DID
+	_, err = f.msgServer.RemoveVerificationMethod(f.ctx, &types.MsgRemoveVerificationMethod{
+		Controller:           delegate,
+		Did:                  did,
+		VerificationMethodId: did + "#key-1",
+	})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = f.msgServer.DeactivateDID(f.ctx, &types.MsgDeactivateDID{Controller: delegate, Did: did})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// Nothing outlives the delegation
+	f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(2 * time.Hour))
+	require.NoError(f.k.RemoveExpiredDelegations(f.ctx))
+	ormDoc, err := f.k.OrmDB.DIDDocumentTable().Get(f.ctx, did)
+	require.NoError(err)
+	stored := types.DIDDocumentFromORM(ormDoc)
+	require.Equal(owner, stored.PrimaryController)
+	require.Len(stored.VerificationMethod, 1)
+	require.False(stored.Deactivated)
+}
@@ -231,6 +231,9 @@ func (k Keeper) UpdateDIDDocumentWithUCAN(
 	if err := k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdated); err != nil {
 		return fmt.Errorf("failed to update DID document with UCAN: %w", err)
 	}
+	if err := k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// recordDocumentVersion stores a DID document as the version it was just
+// written at and runs the document changed hooks. It must be called whenever
+// the document table is written, after the document's Version has been set.
+func (k Keeper) recordDocumentVersion(ctx context.Context, doc *types.DIDDocument) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	version := types.DIDDocumentVersion{
+		Did:         doc.Id,
+		VersionId:   doc.Version,
+		VersionTime: sdkCtx.BlockTime().Unix(),
+		BlockHeight: sdkCtx.BlockHeight(),
+		Document:    doc,
+	}
+	if err := k.DocumentVersions.Set(ctx, collections.Join(doc.Id, doc.Version), version); err != nil {
+		return fmt.Errorf("failed to store version %d of %s: %w", doc.Version, doc.Id, err)
+	}
+	return k.afterDIDDocumentChanged(ctx, doc.Id)
+}
+
+// GetDocumentVersion returns a version of a DID document by number
+func (k Keeper) GetDocumentVersion(ctx context.Context, did string, versionID uint64) (types.DIDDocumentVersion, error) {
+	version, err := k.DocumentVersions.Get(ctx, collections.Join(did, versionID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DIDDocumentVersion{}, types.ErrDIDVersionNotFound.Wrapf("%s version %d", did, versionID)
+	}
+	return version, err
+}
+
+// GetDocumentVersionAt returns the version of a DID document that was current
+// at t: the latest version written at or before it
+func (k Keeper) GetDocumentVersionAt(ctx context.Context, did string, t time.Time) (types.DIDDocumentVersion, error) {
+	rng := collections.NewPrefixedPairRange[string, uint64](did).Descending()
+	iter, err := k.DocumentVersions.Iterate(ctx, rng)
+	if err != nil {
+		return types.DIDDocumentVersion{}, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		version, err := iter.Value()
+		if err != nil {
+			return types.DIDDocumentVersion{}, err
+		}
+		if version.VersionTime <= t.Unix() {
+			return version, nil
+		}
+	}
+	return types.DIDDocumentVersion{}, types.ErrDIDVersionNotFound.Wrapf("%s at %s", did, t.UTC().Format(time.RFC3339))
+}
+
+// documentVersionMetadata returns the resolution metadata of a version. The
+// created time is that of the DID; next_update and next_version_id are set
+// when a later version replaced this one.
+func (k Keeper) documentVersionMetadata(ctx context.Context, version types.DIDDocumentVersion) (*types.DIDDocumentMetadata, error) {
+	metadata := &types.DIDDocumentMetadata{
+		Did:         version.Did,
+		Updated:     version.VersionTime,
+		VersionId:   strconv.FormatUint(version.VersionId, 10),
+		CanonicalId: version.Did,
+	}
+
+	first, err := k.DocumentVersions.Get(ctx, collections.Join(version.Did, uint64(1)))
+	switch {
+	case err == nil:
+		metadata.Created = first.VersionTime
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+	if current, err := k.OrmDB.DIDDocumentMetadataTable().Get(ctx, version.Did); err == nil {
+		if metadata.Created == 0 {
+			metadata.Created = current.Created
+		}
+		metadata.EquivalentId = current.EquivalentId
+	}
+
+	metadata.Status = types.StatusOf(version.Document)
+	if metadata.Status == types.DIDStatus_DID_STATUS_DEACTIVATED {
+		metadata.Deactivated = version.VersionTime
+	}
+
+	next, err := k.DocumentVersions.Get(ctx, collections.Join(version.Did, version.VersionId+1))
+	switch {
+	case err == nil:
+		metadata.NextUpdate = next.VersionTime
+		metadata.NextVersionId = strconv.FormatUint(next.VersionId, 10)
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+	return metadata, nil
+}
@@ -51,6 +51,11 @@ func (k *Keeper) InitGenesisWithORM(ctx context.Context, data *types.GenesisStat
 					"did", doc.Id,
 					"error", err,
 				)
+				continue
+			}
+			// History is not exported, so it restarts at the imported version
+			if err := k.recordDocumentVersion(ctx, types.DIDDocumentFromORM(doc)); err != nil {
+				return err
 			}
 		}
 	}
@@ -46,10 +46,36 @@ type Keeper struct {
 	Params collections.Item[types.Params]
 	OrmDB  apiv1.StateStore
 
+	// DocumentVersions holds every version of each DID document
+	DocumentVersions collections.Map[collections.Pair[string, uint64], types.DIDDocumentVersion]
+
+	// UnverifiedCredentials holds the WebAuthn credentials whose origin is no
+	// longer verified, and OriginCheckCursor the DID the last check stopped at
+	UnverifiedCredentials collections.Map[collections.Pair[string, string], types.UnverifiedCredential]
+	OriginCheckCursor     collections.Item[[]byte]
+
+	// StatusLists holds the StatusList2021 revocation lists of issuers, and
+	// StatusListEntries the credential each allocated index belongs to
+	StatusLists       collections.Map[collections.Pair[string, uint64], types.StatusList]
+	StatusListEntries collections.Map[collections.Triple[string, uint64, uint64], string]
+
+	// DelegatedControllers holds the temporary controllers of each DID, and
+	// DelegationExpiries the same delegations ordered by expiry time
+	DelegatedControllers collections.Map[collections.Pair[string, string], types.DelegatedController]
+	DelegationExpiries   collections.KeySet[collections.Triple[int64, string, string]]
+
+	// DIDAssets holds the x/nft tokens shown on each DID's profile
+	DIDAssets collections.Map[collections.Triple[string, string, string], types.DIDAsset]
+
 	// cross-module keeper dependencies
-	dwnKeeper     types.DWNKeeper
-	accountKeeper types.AccountKeeper
-	serviceKeeper types.ServiceKeeper
+	dwnKeeper        types.DWNKeeper
+	accountKeeper    types.AccountKeeper
+	serviceKeeper    types.ServiceKeeper
+	nftKeeper        types.NFTKeeper
+	nftMetadataCache types.NFTMetadataCache
+
+	// registration hooks, nil when no module listens
+	hooks types.DIDHooks
 
 	// UCAN permission validation
 	permissionValidator *PermissionValidator
@@ -138,6 +164,60 @@ func NewKeeper(
 			"params",
 			codec.CollValue[types.Params](cdc),
 		),
+		DocumentVersions: collections.NewMap(
+			sb,
+			types.DocumentVersionsKey,
+			"document_versions",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.DIDDocumentVersion](cdc),
+		),
+		UnverifiedCredentials: collections.NewMap(
+			sb,
+			types.UnverifiedCredentialsKey,
+			"unverified_credentials",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.UnverifiedCredential](cdc),
+		),
+		OriginCheckCursor: collections.NewItem(
+			sb,
+			types.OriginCheckCursorKey,
+			"origin_check_cursor",
+			collections.BytesValue,
+		),
+		StatusLists: collections.NewMap(
+			sb,
+			types.StatusListsKey,
+			"status_lists",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.StatusList](cdc),
+		),
+		StatusListEntries: collections.NewMap(
+			sb,
+			types.StatusListEntriesKey,
+			"status_list_entries",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key),
+			collections.StringValue,
+		),
+		DelegatedControllers: collections.NewMap(
+			sb,
+			types.DelegatedControllersKey,
+			"delegated_controllers",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.DelegatedController](cdc),
+		),
+		DelegationExpiries: collections.NewKeySet(
+			sb,
+			types.DelegationExpiriesKey,
+			"delegation_expiries",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey),
+		),
+		DIDAssets: collections.NewMap(
+			sb,
+			types.DIDAssetsKey,
+			"did_assets",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.DIDAsset](cdc),
+		),
 		OrmDB: store,
 
 		dwnKeeper:     nil, // Will be set later via SetDWNKeeper
@@ -226,6 +306,16 @@ func (k Keeper) GetDIDDocument(ctx context.Context, did string) (*types.DIDDocum
 	return didDoc, nil
 }
 
+// GetDIDSummary gets the controller and status of a DID without converting
+// the rest of its document
+func (k Keeper) GetDIDSummary(ctx context.Context, did string) (types.DIDSummary, error) {
+	ormDoc, err := k.OrmDB.DIDDocumentTable().Get(ctx, did)
+	if err != nil {
+		return types.DIDSummary{}, err
+	}
+	return types.DIDSummaryFromORM(ormDoc), nil
+}
+
 // VerifyDIDDocumentSignature verifies a DID document signature using verification methods
 func (k Keeper) VerifyDIDDocumentSignature(
 	ctx context.Context,
@@ -253,6 +343,17 @@ func (k Keeper) VerifyDIDDocumentSignature(
 			continue
 		}
 
+		// Credentials whose origin is no longer verified may be refused
+		if vm.WebauthnCredential != nil {
+			downgraded, err := k.IsCredentialDowngraded(ctx, did, vm.WebauthnCredential.CredentialId)
+			if err != nil {
+				return false, err
+			}
+			if downgraded {
+				continue
+			}
+		}
+
 		// Try to verify with this verification method
 		verified, err := k.verifyWithVerificationMethod(vm, signature)
 		if err != nil {
@@ -1005,6 +1106,37 @@ func (k *Keeper) SetServiceKeeper(serviceKeeper types.ServiceKeeper) {
 	k.serviceKeeper = serviceKeeper
 }
 
+// SetNFTKeeper sets the x/nft keeper tokens are attached to profiles from
+func (k *Keeper) SetNFTKeeper(nftKeeper types.NFTKeeper) {
+	k.nftKeeper = nftKeeper
+}
+
+// SetNFTMetadataCache sets where the metadata of attached tokens is cached
+func (k *Keeper) SetNFTMetadataCache(cache types.NFTMetadataCache) {
+	k.nftMetadataCache = cache
+}
+
+// SetHooks sets the DID hooks
+func (k *Keeper) SetHooks(hooks types.DIDHooks) {
+	k.hooks = hooks
+}
+
+// afterDIDRegistered runs the registration hooks for a new DID
+func (k Keeper) afterDIDRegistered(ctx context.Context, did, controller, referralCode string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterDIDRegistered(ctx, did, controller, referralCode)
+}
+
+// afterDIDDocumentChanged runs the hooks for a written DID document
+func (k Keeper) afterDIDDocumentChanged(ctx context.Context, did string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterDIDDocumentChanged(ctx, did)
+}
+
 // CreateVaultForDID creates a vault for a given DID using the DWN keeper
 func (k Keeper) CreateVaultForDID(
 	ctx context.Context,
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -191,6 +192,9 @@ func (ms msgServer) CreateDID(
 	if err := ms.k.OrmDB.DIDDocumentTable().Insert(ctx, ormDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToStoreDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, &didDocument); err != nil {
+		return nil, err
+	}
 
 	// Create DID document metadata
 	metadata := &types.DIDDocumentMetadata{
@@ -203,6 +207,7 @@ func (ms msgServer) CreateDID(
 		NextVersionId: "",
 		EquivalentId:  []string{},
 		CanonicalId:   didDocument.Id,
+		Status:        types.DIDStatus_DID_STATUS_ACTIVE,
 	}
 
 	// Convert metadata to ORM type and insert
@@ -211,6 +216,10 @@ func (ms msgServer) CreateDID(
 		return nil, errors.Wrapf(types.ErrFailedToStoreDIDMetadata, "%v", err)
 	}
 
+	if err := ms.k.afterDIDRegistered(ctx, didDocument.Id, msg.Controller, msg.ReferralCode); err != nil {
+		return nil, err
+	}
+
 	// Auto-create vault for the new DID
 	vaultID := fmt.Sprintf("%s-vault", didDocument.Id)
 	keyID := fmt.Sprintf("%s-key-1", didDocument.Id)
@@ -298,7 +307,7 @@ func (ms msgServer) UpdateDID(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(existingDoc, msg.Controller) {
+	if !ms.isDocumentController(existingDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized for DID %s",
@@ -338,6 +347,9 @@ func (ms msgServer) UpdateDID(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, &updatedDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -398,7 +410,7 @@ func (ms msgServer) DeactivateDID(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(existingDoc, msg.Controller) {
+	if !ms.isDocumentController(existingDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized to deactivate DID %s",
@@ -421,6 +433,9 @@ func (ms msgServer) DeactivateDID(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToDeactivateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, existingDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -431,6 +446,7 @@ func (ms msgServer) DeactivateDID(
 	metadata.Updated = blockTime
 	metadata.Deactivated = blockTime
 	metadata.VersionId = fmt.Sprintf("%d", existingDoc.Version)
+	metadata.Status = apiv1.DIDStatus_DID_STATUS_DEACTIVATED
 
 	if err := ms.k.OrmDB.DIDDocumentMetadataTable().Update(ctx, metadata); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDMetadata, "%v", err)
@@ -451,6 +467,93 @@ func (ms msgServer) DeactivateDID(
 	return &types.MsgDeactivateDIDResponse{}, nil
 }
 
+// ReactivateDID implements types.MsgServer. A reactivated DID keeps the
+// document it had when it was deactivated.
+func (ms msgServer) ReactivateDID(
+	ctx context.Context,
+	msg *types.MsgReactivateDID,
+) (*types.MsgReactivateDIDResponse, error) {
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// UCAN authorization validation
+	if err := ms.validateUCANPermission(ctx, msg.Did, msg.Controller, types.DIDOpReactivate); err != nil {
+		return nil, errors.Wrapf(types.ErrUCANValidationFailed, "UCAN validation failed: %v", err)
+	}
+
+	// Get existing DID document
+	ormDoc, err := ms.k.OrmDB.DIDDocumentTable().Get(ctx, msg.Did)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrDIDNotFound, "%s", msg.Did)
+	}
+
+	// Convert from ORM type
+	existingDoc := types.DIDDocumentFromORM(ormDoc)
+
+	if !existingDoc.Deactivated {
+		return nil, errors.Wrapf(types.ErrDIDNotDeactivated, "%s", msg.Did)
+	}
+
+	// Validate controller authorization
+	if !ms.isDocumentController(existingDoc, msg.Controller) {
+		return nil, errors.Wrapf(
+			types.ErrUnauthorized,
+			"controller %s not authorized to reactivate DID %s",
+			msg.Controller,
+			msg.Did,
+		)
+	}
+
+	// Update DID document
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockHeight := sdkCtx.BlockHeight()
+	blockTime := sdkCtx.BlockTime().Unix()
+
+	existingDoc.Deactivated = false
+	existingDoc.UpdatedAt = blockHeight
+	existingDoc.Version = existingDoc.Version + 1
+
+	// Convert to ORM type and update
+	ormUpdatedDoc := existingDoc.ToORM()
+	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
+		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
+	}
+	if err := ms.k.recordDocumentVersion(ctx, existingDoc); err != nil {
+		return nil, err
+	}
+
+	// Update metadata
+	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrFailedToGetDIDMetadata, "%v", err)
+	}
+
+	metadata.Updated = blockTime
+	metadata.Deactivated = 0
+	metadata.VersionId = fmt.Sprintf("%d", existingDoc.Version)
+	metadata.Status = apiv1.DIDStatus_DID_STATUS_ACTIVE
+
+	if err := ms.k.OrmDB.DIDDocumentMetadataTable().Update(ctx, metadata); err != nil {
+		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDMetadata, "%v", err)
+	}
+
+	// Emit typed event
+	event := &types.EventDIDReactivated{
+		Did:           msg.Did,
+		Reactivator:   msg.Controller,
+		ReactivatedAt: sdkCtx.BlockTime(),
+		BlockHeight:   uint64(sdkCtx.BlockHeight()),
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		ms.k.Logger().With("error", err).Error("Failed to emit EventDIDReactivated")
+	}
+
+	return &types.MsgReactivateDIDResponse{}, nil
+}
+
 // AddVerificationMethod implements types.MsgServer.
 func (ms msgServer) AddVerificationMethod(
 	ctx context.Context,
@@ -481,7 +584,7 @@ func (ms msgServer) AddVerificationMethod(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(didDoc, msg.Controller) {
+	if !ms.isDocumentController(didDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized for DID %s",
@@ -554,6 +657,9 @@ func (ms msgServer) AddVerificationMethod(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -614,7 +720,7 @@ func (ms msgServer) RemoveVerificationMethod(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(didDoc, msg.Controller) {
+	if !ms.isDocumentController(didDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized for DID %s",
@@ -677,6 +783,9 @@ func (ms msgServer) RemoveVerificationMethod(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -735,7 +844,7 @@ func (ms msgServer) AddService(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(didDoc, msg.Controller) {
+	if !ms.isAuthorizedController(ctx, didDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized for DID %s",
@@ -770,6 +879,9 @@ func (ms msgServer) AddService(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -830,7 +942,7 @@ func (ms msgServer) RemoveService(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(didDoc, msg.Controller) {
+	if !ms.isAuthorizedController(ctx, didDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized for DID %s",
@@ -867,6 +979,9 @@ func (ms msgServer) RemoveService(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -925,7 +1040,7 @@ func (ms msgServer) IssueVerifiableCredential(
 	}
 
 	// Validate issuer authorization
-	if !ms.isAuthorizedController(issuerDoc, msg.Issuer) {
+	if !ms.isAuthorizedController(ctx, issuerDoc, msg.Issuer) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"address %s not authorized for issuer DID %s",
@@ -960,6 +1075,11 @@ func (ms msgServer) IssueVerifiableCredential(
 		credential.ExpiresAt = 0 // No expiration
 	}
 
+	// Reserve the credential's revocation list entry
+	if err := ms.k.assignCredentialStatus(ctx, &credential); err != nil {
+		return nil, err
+	}
+
 	// Convert to ORM type and store the credential
 	ormCredential := credential.ToORM()
 	if err := ms.k.OrmDB.VerifiableCredentialTable().Insert(ctx, ormCredential); err != nil {
@@ -1024,7 +1144,7 @@ func (ms msgServer) RevokeVerifiableCredential(
 	issuerDoc := types.DIDDocumentFromORM(ormIssuerDoc)
 
 	// Validate issuer authorization
-	if !ms.isAuthorizedController(issuerDoc, msg.Issuer) {
+	if !ms.isAuthorizedController(ctx, issuerDoc, msg.Issuer) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"address %s not authorized for issuer DID %s",
@@ -1033,8 +1153,11 @@ func (ms msgServer) RevokeVerifiableCredential(
 		)
 	}
 
-	// Update credential status
+	// Update credential status and its revocation list entry
 	credential.Revoked = true
+	if err := ms.k.revokeCredentialStatus(ctx, credential); err != nil {
+		return nil, err
+	}
 
 	// Convert to ORM type and update the credential
 	ormUpdatedCredential := credential.ToORM()
@@ -1084,7 +1207,7 @@ func (ms msgServer) LinkExternalWallet(
 	}
 
 	// Validate controller authorization
-	if !ms.isAuthorizedController(didDoc, msg.Controller) {
+	if !ms.isDocumentController(didDoc, msg.Controller) {
 		return nil, errors.Wrapf(
 			types.ErrUnauthorized,
 			"controller %s not authorized for DID %s",
@@ -1164,6 +1287,9 @@ func (ms msgServer) LinkExternalWallet(
 	if err := ms.k.OrmDB.DIDDocumentTable().Update(ctx, ormUpdatedDoc); err != nil {
 		return nil, errors.Wrapf(types.ErrFailedToUpdateDIDDocument, "%v", err)
 	}
+	if err := ms.k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return nil, err
+	}
 
 	// Update metadata
 	metadata, err := ms.k.OrmDB.DIDDocumentMetadataTable().Get(ctx, msg.Did)
@@ -1195,6 +1321,277 @@ func (ms msgServer) LinkExternalWallet(
 	}, nil
 }
 
+// AddDelegatedController implements types.MsgServer.
+func (ms msgServer) AddDelegatedController(
+	ctx context.Context,
+	msg *types.MsgAddDelegatedController,
+) (*types.MsgAddDelegatedControllerResponse, error) {
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Get existing DID document
+	ormDoc, err := ms.k.OrmDB.DIDDocumentTable().Get(ctx, msg.Did)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrDIDNotFound, "%s", msg.Did)
+	}
+
+	// Convert from ORM type
+	didDoc := types.DIDDocumentFromORM(ormDoc)
+
+	// Check if DID is deactivated
+	if didDoc.Deactivated {
+		return nil, errors.Wrapf(types.ErrDIDDeactivated, "%s", msg.Did)
+	}
+
+	// Only controllers of the document itself may delegate, so a delegate
+	// cannot extend or pass on its own delegation
+	if !ms.isDocumentController(didDoc, msg.Controller) {
+		return nil, errors.Wrapf(
+			types.ErrUnauthorized,
+			"controller %s not authorized to delegate DID %s",
+			msg.Controller,
+			msg.Did,
+		)
+	}
+	if ms.isDocumentController(didDoc, msg.Delegate) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidDelegation,
+			"%s already controls DID %s",
+			msg.Delegate,
+			msg.Did,
+		)
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get params: %w", err)
+	}
+	documentParams := params.Document
+	if documentParams == nil {
+		documentParams = types.DefaultParams().Document
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	if msg.ExpiresAt <= now {
+		return nil, errors.Wrapf(types.ErrInvalidDelegation, "expiry %d is not in the future", msg.ExpiresAt)
+	}
+	if maxDuration := documentParams.MaxDelegationDuration; maxDuration > 0 && msg.ExpiresAt-now > maxDuration {
+		return nil, errors.Wrapf(
+			types.ErrInvalidDelegation,
+			"delegation cannot last longer than %d seconds",
+			maxDuration,
+		)
+	}
+
+	// A renewed delegation does not count against the limit
+	active, err := ms.k.ActiveDelegatedControllers(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	count := 0
+	for _, delegation := range active {
+		if delegation.Controller != msg.Delegate {
+			count++
+		}
+	}
+	if count >= int(documentParams.MaxDelegatedControllers) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidDelegation,
+			"DID %s already has %d delegated controllers",
+			msg.Did,
+			count,
+		)
+	}
+
+	delegation := types.DelegatedController{
+		Did:        msg.Did,
+		Controller: msg.Delegate,
+		GrantedBy:  msg.Controller,
+		Purpose:    msg.Purpose,
+		GrantedAt:  now,
+		ExpiresAt:  msg.ExpiresAt,
+	}
+	if err := ms.k.setDelegatedController(ctx, delegation); err != nil {
+		return nil, err
+	}
+
+	// Emit typed event
+	event := &types.EventDelegatedControllerAdded{
+		Did:         msg.Did,
+		Delegate:    msg.Delegate,
+		GrantedBy:   msg.Controller,
+		Purpose:     msg.Purpose,
+		ExpiresAt:   time.Unix(msg.ExpiresAt, 0).UTC(),
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		ms.k.Logger().With("error", err).Error("Failed to emit EventDelegatedControllerAdded")
+	}
+
+	return &types.MsgAddDelegatedControllerResponse{}, nil
+}
+
+// RemoveDelegatedController implements types.MsgServer.
+func (ms msgServer) RemoveDelegatedController(
+	ctx context.Context,
+	msg *types.MsgRemoveDelegatedController,
+) (*types.MsgRemoveDelegatedControllerResponse, error) {
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Get existing DID document
+	ormDoc, err := ms.k.OrmDB.DIDDocumentTable().Get(ctx, msg.Did)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrDIDNotFound, "%s", msg.Did)
+	}
+
+	// Convert from ORM type
+	didDoc := types.DIDDocumentFromORM(ormDoc)
+
+	// Controllers of the document may end any delegation, and a delegate its
+	// own. Deactivated DIDs may still drop their delegations.
+	if msg.Controller != msg.Delegate && !ms.isDocumentController(didDoc, msg.Controller) {
+		return nil, errors.Wrapf(
+			types.ErrUnauthorized,
+			"controller %s not authorized for DID %s",
+			msg.Controller,
+			msg.Did,
+		)
+	}
+
+	if _, err := ms.k.removeDelegatedController(ctx, msg.Did, msg.Delegate); err != nil {
+		return nil, err
+	}
+
+	// Emit typed event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := &types.EventDelegatedControllerRemoved{
+		Did:         msg.Did,
+		Delegate:    msg.Delegate,
+		RemovedBy:   msg.Controller,
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		ms.k.Logger().With("error", err).Error("Failed to emit EventDelegatedControllerRemoved")
+	}
+
+	return &types.MsgRemoveDelegatedControllerResponse{}, nil
+}
+
+// AttachNFT implements types.MsgServer.
+func (ms msgServer) AttachNFT(
+	ctx context.Context,
+	msg *types.MsgAttachNFT,
+) (*types.MsgAttachNFTResponse, error) {
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Get existing DID document
+	ormDoc, err := ms.k.OrmDB.DIDDocumentTable().Get(ctx, msg.Did)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrDIDNotFound, "%s", msg.Did)
+	}
+
+	// Convert from ORM type
+	didDoc := types.DIDDocumentFromORM(ormDoc)
+
+	// Check if DID is deactivated
+	if didDoc.Deactivated {
+		return nil, errors.Wrapf(types.ErrDIDDeactivated, "%s", msg.Did)
+	}
+
+	// Verify controller authorization
+	if !ms.isDocumentController(didDoc, msg.Controller) {
+		return nil, errors.Wrapf(
+			types.ErrUnauthorized,
+			"controller %s not authorized for DID %s",
+			msg.Controller,
+			msg.Did,
+		)
+	}
+
+	asset, err := ms.k.attachNFT(ctx, msg.Did, msg.Controller, msg.ClassId, msg.NftId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit typed event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := &types.EventNFTAttached{
+		Did:         msg.Did,
+		ClassId:     msg.ClassId,
+		NftId:       msg.NftId,
+		Owner:       msg.Controller,
+		Interchain:  asset.Interchain,
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		ms.k.Logger().With("error", err).Error("Failed to emit EventNFTAttached")
+	}
+
+	return &types.MsgAttachNFTResponse{DwnRecordId: asset.DwnRecordId}, nil
+}
+
+// DetachNFT implements types.MsgServer.
+func (ms msgServer) DetachNFT(
+	ctx context.Context,
+	msg *types.MsgDetachNFT,
+) (*types.MsgDetachNFTResponse, error) {
+	// Validate basic message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Get existing DID document
+	ormDoc, err := ms.k.OrmDB.DIDDocumentTable().Get(ctx, msg.Did)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrDIDNotFound, "%s", msg.Did)
+	}
+
+	// Convert from ORM type
+	didDoc := types.DIDDocumentFromORM(ormDoc)
+
+	// Deactivated DIDs may still clear their profile
+	if !ms.isDocumentController(didDoc, msg.Controller) {
+		return nil, errors.Wrapf(
+			types.ErrUnauthorized,
+			"controller %s not authorized for DID %s",
+			msg.Controller,
+			msg.Did,
+		)
+	}
+
+	if err := ms.k.detachNFT(ctx, msg.Did, msg.ClassId, msg.NftId); err != nil {
+		return nil, err
+	}
+
+	// Emit typed event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := &types.EventNFTDetached{
+		Did:         msg.Did,
+		ClassId:     msg.ClassId,
+		NftId:       msg.NftId,
+		RemovedBy:   msg.Controller,
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		ms.k.Logger().With("error", err).Error("Failed to emit EventNFTDetached")
+	}
+
+	return &types.MsgDetachNFTResponse{}, nil
+}
+
 // Helper functions for DID operations
 
 // validateDIDDocument validates a W3C DID document structure
@@ -1417,8 +1814,29 @@ func isHexDigit(ch byte) bool {
 		(ch >= 'a' && ch <= 'f')
 }
 
-// isAuthorizedController validates controller authorization through verification methods
-func (ms msgServer) isAuthorizedController(doc *types.DIDDocument, controller string) bool {
+// isAuthorizedController validates controller authorization through the
+// document, or through a delegation that has not expired. Delegates may only
+// manage services and credentials; operations that could change who controls
+// the DID use isDocumentController, so control ends with the delegation.
+func (ms msgServer) isAuthorizedController(
+	ctx context.Context,
+	doc *types.DIDDocument,
+	controller string,
+) bool {
+	if ms.isDocumentController(doc, controller) {
+		return true
+	}
+
+	delegated, err := ms.k.isDelegatedController(ctx, doc.Id, controller)
+	if err != nil {
+		ms.k.Logger().Error("failed to read delegated controller", "did", doc.Id, "error", err)
+		return false
+	}
+	return delegated
+}
+
+// isDocumentController validates controller authorization through verification methods
+func (ms msgServer) isDocumentController(doc *types.DIDDocument, controller string) bool {
 	// Check if controller is the primary controller
 	if doc.PrimaryController == controller {
 		return true
@@ -1584,6 +2002,8 @@ func (ms msgServer) RegisterWebAuthnCredential(
 		PublicKey:         msg.WebauthnCredential.PublicKey,
 		Algorithm:         msg.WebauthnCredential.Algorithm,
 		Origin:            msg.WebauthnCredential.Origin,
+		RPID:              msg.WebauthnCredential.RpId,
+		Transports:        msg.WebauthnCredential.Transports,
 	}
 
 	// Process the WebAuthn registration using existing keeper logic
@@ -1628,6 +2048,10 @@ func (ms msgServer) RegisterWebAuthnCredential(
 		)
 	}
 
+	if err := ms.k.afterDIDRegistered(ctx, didDoc.Id, controllerAddr.String(), msg.ReferralCode); err != nil {
+		return nil, err
+	}
+
 	// Create Controller entry in ORM
 	controller := &apiv1.Controller{
 		Did:             didDoc.Id,
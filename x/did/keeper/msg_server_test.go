@@ -337,6 +337,59 @@ func (suite *MsgServerTestSuite) TestDeactivateDID() {
 	}
 }
 
+// Test ReactivateDID
+func (suite *MsgServerTestSuite) TestReactivateDID() {
+	did := "did:example:reactivate123"
+	controller := suite.f.addrs[0].String()
+	_, err := suite.f.msgServer.CreateDID(suite.f.ctx, &types.MsgCreateDID{
+		Controller:  controller,
+		DidDocument: suite.createValidDIDDocument(did),
+	})
+	suite.Require().NoError(err)
+
+	status := func() *types.DIDDocumentMetadata {
+		resp, err := suite.f.queryServer.ResolveDID(suite.f.ctx, &types.QueryResolveDIDRequest{Did: did})
+		suite.Require().NoError(err)
+		return resp.DidDocumentMetadata
+	}
+	suite.Require().Equal(types.DIDStatus_DID_STATUS_ACTIVE, status().Status)
+
+	// Active DIDs cannot be reactivated
+	_, err = suite.f.msgServer.ReactivateDID(suite.f.ctx, &types.MsgReactivateDID{Controller: controller, Did: did})
+	suite.Require().ErrorIs(err, types.ErrDIDNotDeactivated)
+
+	_, err = suite.f.msgServer.DeactivateDID(suite.f.ctx, &types.MsgDeactivateDID{Controller: controller, Did: did})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DIDStatus_DID_STATUS_DEACTIVATED, status().Status)
+
+	// Deactivated DIDs cannot be updated until reactivated
+	_, err = suite.f.msgServer.AddService(suite.f.ctx, &types.MsgAddService{
+		Controller: controller,
+		Did:        did,
+		Service: types.Service{
+			Id:             did + "#service-2",
+			ServiceKind:    "LinkedDomains",
+			SingleEndpoint: "https://example.com",
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrDIDDeactivated)
+
+	// Only the controller may reactivate
+	_, err = suite.f.msgServer.ReactivateDID(suite.f.ctx, &types.MsgReactivateDID{
+		Controller: suite.f.addrs[1].String(),
+		Did:        did,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.f.msgServer.ReactivateDID(suite.f.ctx, &types.MsgReactivateDID{Controller: controller, Did: did})
+	suite.Require().NoError(err)
+
+	metadata := status()
+	suite.Require().Equal(types.DIDStatus_DID_STATUS_ACTIVE, metadata.Status)
+	suite.Require().Zero(metadata.Deactivated)
+	suite.Require().Equal("3", metadata.VersionId)
+}
+
 // Test AddVerificationMethod
 func (suite *MsgServerTestSuite) TestAddVerificationMethod() {
 	did := "did:example:addvm123"
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/did/v1"
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+// CheckCredentialOrigins re-checks the origins of the WebAuthn credentials
+// stored in DID documents. Every OriginCheckInterval blocks it checks the next
+// OriginCheckBatchSize documents, so all documents are covered in turn. A
+// credential whose origin is no longer verified in x/svc nor allowed by the
+// module params is flagged, and unflagged once its origin is verified again.
+// Only flagged credentials are written, so a check of a healthy chain costs
+// reads alone.
+func (k Keeper) CheckCredentialOrigins(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	webauthnParams := params.Webauthn
+	if webauthnParams == nil || webauthnParams.OriginCheckInterval <= 0 ||
+		sdkCtx.BlockHeight()%webauthnParams.OriginCheckInterval != 0 {
+		return nil
+	}
+
+	cursor, err := k.OriginCheckCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	docs, next, err := k.nextOriginCheckBatch(ctx, cursor, webauthnParams.OriginCheckBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		if err := k.checkDocumentOrigins(sdkCtx, doc, webauthnParams.DowngradeUnverifiedOrigins); err != nil {
+			return err
+		}
+	}
+
+	// The last batch wraps around to the first document
+	if next == nil {
+		return k.OriginCheckCursor.Remove(ctx)
+	}
+	return k.OriginCheckCursor.Set(ctx, next)
+}
+
+// nextOriginCheckBatch reads up to size DID documents after cursor. It returns
+// the cursor to continue from, or nil once the last document was read. The
+// documents are read before any is checked, as flags cannot be written while
+// the table is being iterated.
+func (k Keeper) nextOriginCheckBatch(
+	ctx context.Context,
+	cursor []byte,
+	size uint32,
+) ([]*types.DIDDocument, []byte, error) {
+	var opts []ormlist.Option
+	if len(cursor) > 0 {
+		opts = append(opts, ormlist.Cursor(cursor))
+	}
+
+	iter, err := k.OrmDB.DIDDocumentTable().List(ctx, apiv1.DIDDocumentPrimaryKey{}, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var docs []*types.DIDDocument
+	for uint32(len(docs)) < size && iter.Next() {
+		ormDoc, err := iter.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		docs = append(docs, types.DIDDocumentFromORM(ormDoc))
+		cursor = iter.Cursor()
+	}
+
+	if uint32(len(docs)) < size {
+		return docs, nil, nil
+	}
+	return docs, cursor, nil
+}
+
+// checkDocumentOrigins flags the WebAuthn credentials of a document whose
+// origin fails verification and unflags those whose origin passes again.
+// Flags of credentials no longer in the document are dropped.
+func (k Keeper) checkDocumentOrigins(ctx sdk.Context, doc *types.DIDDocument, downgrade bool) error {
+	current := make(map[string]bool)
+	querier := NewQuerier(k)
+
+	for _, vm := range doc.VerificationMethod {
+		if vm == nil || vm.WebauthnCredential == nil || vm.WebauthnCredential.CredentialId == "" {
+			continue
+		}
+		credential := vm.WebauthnCredential
+		current[credential.CredentialId] = true
+
+		// Credentials registered without an origin cannot be checked
+		if credential.Origin == "" {
+			continue
+		}
+
+		key := collections.Join(doc.Id, credential.CredentialId)
+		flagged, err := k.UnverifiedCredentials.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		originErr := querier.ValidateServiceOrigin(ctx, credential.Origin)
+		switch {
+		case originErr != nil && !flagged:
+			if err := k.UnverifiedCredentials.Set(ctx, key, types.UnverifiedCredential{
+				Did:                  doc.Id,
+				VerificationMethodId: vm.Id,
+				CredentialId:         credential.CredentialId,
+				Origin:               credential.Origin,
+				Reason:               originErr.Error(),
+				FlaggedAt:            ctx.BlockHeight(),
+			}); err != nil {
+				return err
+			}
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialOriginUnverified{
+				Did:          doc.Id,
+				CredentialId: credential.CredentialId,
+				Origin:       credential.Origin,
+				Reason:       originErr.Error(),
+				Downgraded:   downgrade,
+				BlockHeight:  uint64(ctx.BlockHeight()),
+			}); err != nil {
+				return err
+			}
+			k.Logger().Info("WebAuthn credential origin no longer verified",
+				"did", doc.Id,
+				"credential_id", credential.CredentialId,
+				"origin", credential.Origin,
+				"reason", originErr.Error(),
+			)
+
+		case originErr == nil && flagged:
+			if err := k.UnverifiedCredentials.Remove(ctx, key); err != nil {
+				return err
+			}
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialOriginReverified{
+				Did:          doc.Id,
+				CredentialId: credential.CredentialId,
+				Origin:       credential.Origin,
+				BlockHeight:  uint64(ctx.BlockHeight()),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	stale, err := k.unverifiedCredentials(ctx, doc.Id)
+	if err != nil {
+		return err
+	}
+	for _, flag := range stale {
+		if current[flag.CredentialId] {
+			continue
+		}
+		if err := k.UnverifiedCredentials.Remove(ctx, collections.Join(doc.Id, flag.CredentialId)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unverifiedCredentials returns the flagged credentials of a DID
+func (k Keeper) unverifiedCredentials(ctx context.Context, did string) ([]types.UnverifiedCredential, error) {
+	iter, err := k.UnverifiedCredentials.Iterate(ctx, collections.NewPrefixedPairRange[string, string](did))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// IsCredentialDowngraded reports whether a WebAuthn credential is refused
+// because its origin is no longer verified. Flagged credentials are only
+// refused while DowngradeUnverifiedOrigins is set.
+func (k Keeper) IsCredentialDowngraded(ctx context.Context, did, credentialID string) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if params.Webauthn == nil || !params.Webauthn.DowngradeUnverifiedOrigins {
+		return false, nil
+	}
+	return k.UnverifiedCredentials.Has(ctx, collections.Join(did, credentialID))
+}
+
+// requireVerifiedCredentialOrigin fails with ErrCredentialOriginUnverified
+// when a credential is downgraded
+func (k Keeper) requireVerifiedCredentialOrigin(ctx context.Context, did, credentialID string) error {
+	downgraded, err := k.IsCredentialDowngraded(ctx, did, credentialID)
+	if err != nil {
+		return err
+	}
+	if downgraded {
+		return errorsmod.Wrapf(types.ErrCredentialOriginUnverified, "credential %s of %s", credentialID, did)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+func TestCheckCredentialOrigins(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	params := types.DefaultParams()
+	params.Webauthn.AllowedOrigins = []string{"https://app.example.com", "https://other.example.com"}
+	params.Webauthn.OriginCheckInterval = 10
+	params.Webauthn.OriginCheckBatchSize = 2
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	// Three documents, so a run covers two and the next wraps around
+	for i := range 3 {
+		did := fmt.Sprintf("did:sonr:origin%d", i)
+		doc := &types.DIDDocument{
+			Id:                did,
+			PrimaryController: did,
+			VerificationMethod: []*types.VerificationMethod{{
+				Id:                     did + "#webauthn-1",
+				VerificationMethodKind: "WebAuthnAuthentication2023",
+				Controller:             did,
+				WebauthnCredential: &types.WebAuthnCredential{
+					CredentialId: fmt.Sprintf("cred-%d", i),
+					PublicKey:    []byte{0x01},
+					Origin:       "https://app.example.com",
+				},
+			}},
+			Version: 1,
+		}
+		require.NoError(f.k.OrmDB.DIDDocumentTable().Insert(f.ctx, doc.ToORM()))
+	}
+
+	// The origin loses its verification
+	params.Webauthn.AllowedOrigins = []string{"https://other.example.com"}
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	// Nothing is checked off the interval
+	require.NoError(f.k.CheckCredentialOrigins(f.ctx.WithBlockHeight(11)))
+	res, err := f.queryServer.GetUnverifiedCredentials(f.ctx, &types.QueryGetUnverifiedCredentialsRequest{Did: "did:sonr:origin0"})
+	require.NoError(err)
+	require.Empty(res.Credentials)
+
+	require.NoError(f.k.CheckCredentialOrigins(f.ctx.WithBlockHeight(10)))
+	res, err = f.queryServer.GetUnverifiedCredentials(f.ctx, &types.QueryGetUnverifiedCredentialsRequest{Did: "did:sonr:origin0"})
+	require.NoError(err)
+	require.Len(res.Credentials, 1)
+	require.Equal("cred-0", res.Credentials[0].CredentialId)
+	require.Equal(int64(10), res.Credentials[0].FlaggedAt)
+	require.False(res.Downgraded)
+
+	// The third document waits for the next run
+	res, err = f.queryServer.GetUnverifiedCredentials(f.ctx, &types.QueryGetUnverifiedCredentialsRequest{Did: "did:sonr:origin2"})
+	require.NoError(err)
+	require.Empty(res.Credentials)
+	require.NoError(f.k.CheckCredentialOrigins(f.ctx.WithBlockHeight(20)))
+	res, err = f.queryServer.GetUnverifiedCredentials(f.ctx, &types.QueryGetUnverifiedCredentialsRequest{Did: "did:sonr:origin2"})
+	require.NoError(err)
+	require.Len(res.Credentials, 1)
+
+	// Flagged credentials are only refused once downgrading is enabled
+	downgraded, err := f.k.IsCredentialDowngraded(f.ctx, "did:sonr:origin0", "cred-0")
+	require.NoError(err)
+	require.False(downgraded)
+
+	params.Webauthn.DowngradeUnverifiedOrigins = true
+	require.NoError(f.k.Params.Set(f.ctx, params))
+	downgraded, err = f.k.IsCredentialDowngraded(f.ctx, "did:sonr:origin0", "cred-0")
+	require.NoError(err)
+	require.True(downgraded)
+
+	// The origin is verified again and the next full pass clears the flags
+	params.Webauthn.AllowedOrigins = []string{"https://app.example.com"}
+	require.NoError(f.k.Params.Set(f.ctx, params))
+	require.NoError(f.k.CheckCredentialOrigins(f.ctx.WithBlockHeight(30)))
+	require.NoError(f.k.CheckCredentialOrigins(f.ctx.WithBlockHeight(40)))
+	for i := range 3 {
+		res, err = f.queryServer.GetUnverifiedCredentials(f.ctx, &types.QueryGetUnverifiedCredentialsRequest{Did: fmt.Sprintf("did:sonr:origin%d", i)})
+		require.NoError(err)
+		require.Empty(res.Credentials)
+	}
+}
@@ -6,14 +6,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"lukechampine.com/blake3"
 
 	apiv1 "github.com/sonr-io/sonr/api/did/v1"
 	"github.com/sonr-io/common/webauthn"
+	"github.com/sonr-io/sonr/app/pagination"
 	"github.com/sonr-io/sonr/x/did/types"
 )
 
@@ -72,10 +74,13 @@ func (k Querier) ResolveDID(
 		ormMetadata = nil
 	}
 
-	var metadata *types.DIDDocumentMetadata
+	metadata := &types.DIDDocumentMetadata{Did: req.Did}
 	if ormMetadata != nil {
 		metadata = types.DIDDocumentMetadataFromORM(ormMetadata)
 	}
+	// The document's flag is authoritative, and metadata written before
+	// statuses were recorded has none
+	metadata.Status = types.StatusOf(didDoc)
 
 	return &types.QueryResolveDIDResponse{
 		DidDocument:         didDoc,
@@ -112,76 +117,163 @@ func (k Querier) GetDIDDocument(
 	}, nil
 }
 
-// ListDIDDocuments implements types.QueryServer.
-func (k Querier) ListDIDDocuments(
+// GetDIDDocumentVersion implements types.QueryServer. Following DID
+// resolution, version_id selects a version by number and version_time the
+// version that was current at that time.
+func (k Querier) GetDIDDocumentVersion(
 	goCtx context.Context,
-	req *types.QueryListDIDDocumentsRequest,
-) (*types.QueryListDIDDocumentsResponse, error) {
+	req *types.QueryGetDIDDocumentVersionRequest,
+) (*types.QueryGetDIDDocumentVersionResponse, error) {
 	if req == nil {
 		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
 	}
+	if req.Did == "" {
+		return nil, errors.Wrap(types.ErrEmptyDID, "DID cannot be empty")
+	}
+	if req.VersionId != 0 && req.VersionTime != "" {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "version_id and version_time cannot both be set")
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Create pagination query
-	var pageReq *query.PageRequest
-	if req.Pagination != nil {
-		pageReq = req.Pagination
-	} else {
-		// Default pagination
-		pageReq = &query.PageRequest{
-			Limit: 100,
+	var (
+		version types.DIDDocumentVersion
+		err     error
+	)
+	switch {
+	case req.VersionId != 0:
+		version, err = k.GetDocumentVersion(ctx, req.Did, req.VersionId)
+	case req.VersionTime != "":
+		versionTime, parseErr := time.Parse(time.RFC3339, req.VersionTime)
+		if parseErr != nil {
+			return nil, errors.Wrapf(types.ErrInvalidRequest, "invalid version_time: %v", parseErr)
+		}
+		version, err = k.GetDocumentVersionAt(ctx, req.Did, versionTime)
+	default:
+		// The current document is read from the table, which also serves
+		// documents written before versions were recorded
+		ormDoc, getErr := k.OrmDB.DIDDocumentTable().Get(ctx, req.Did)
+		if getErr != nil {
+			return nil, errors.Wrapf(types.ErrDIDNotFound, "%s", req.Did)
+		}
+		version = types.DIDDocumentVersion{
+			Did:         req.Did,
+			VersionId:   ormDoc.Version,
+			BlockHeight: ormDoc.UpdatedAt,
+			Document:    types.DIDDocumentFromORM(ormDoc),
+		}
+		if ormMetadata, getErr := k.OrmDB.DIDDocumentMetadataTable().Get(ctx, req.Did); getErr == nil {
+			version.VersionTime = ormMetadata.Updated
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	// List DID documents with pagination
-	var documents []*types.DIDDocument
-	pageRes := &query.PageResponse{}
-
-	// Get all documents from the table
-	iter, err := k.OrmDB.DIDDocumentTable().List(ctx, apiv1.DIDDocumentPrimaryKey{})
+	metadata, err := k.documentVersionMetadata(ctx, version)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to list DID documents")
+		return nil, errors.Wrapf(types.ErrFailedToGetDIDMetadata, "%v", err)
+	}
+
+	return &types.QueryGetDIDDocumentVersionResponse{
+		DidDocument:         version.Document,
+		DidDocumentMetadata: metadata,
+	}, nil
+}
+
+// GetDIDDocumentHistory implements types.QueryServer.
+func (k Querier) GetDIDDocumentHistory(
+	goCtx context.Context,
+	req *types.QueryGetDIDDocumentHistoryRequest,
+) (*types.QueryGetDIDDocumentHistoryResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+	if req.Did == "" {
+		return nil, errors.Wrap(types.ErrEmptyDID, "DID cannot be empty")
 	}
-	defer iter.Close()
 
-	// Apply pagination manually
-	offset := pageReq.Offset
-	limit := pageReq.Limit
-	count := uint64(0)
-	totalCount := uint64(0)
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	for iter.Next() {
-		totalCount++
+	page, err := pagination.Parse(req.Pagination, "did/GetDIDDocumentHistory/"+req.Did)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
 
-		// Skip items before offset
-		if count < offset {
-			count++
-			continue
+	rng := collections.NewPrefixedPairRange[string, uint64](req.Did)
+	if len(page.Key) > 0 {
+		after := sdk.BigEndianToUint64(page.Key)
+		if page.Reverse {
+			rng = rng.EndExclusive(after)
+		} else {
+			rng = rng.StartExclusive(after)
 		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
 
-		// Stop if we've reached the limit
-		if uint64(len(documents)) >= limit && limit > 0 {
-			continue
-		}
+	iter, err := k.DocumentVersions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list DID document versions")
+	}
+	defer iter.Close()
 
-		ormDoc, err := iter.Value()
+	versions, pageRes, err := pagination.Collect(page, func() (types.DIDDocumentVersion, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.DIDDocumentVersion{}, nil, false, nil
+		}
+		version, err := iter.Value()
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get DID document from iterator")
+			return types.DIDDocumentVersion{}, nil, false, err
 		}
+		iter.Next()
+		return version, sdk.Uint64ToBigEndian(version.VersionId), true, nil
+	}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read DID document versions")
+	}
 
-		// Convert from ORM type
-		didDoc := types.DIDDocumentFromORM(ormDoc)
-		documents = append(documents, didDoc)
-		count++
+	res := &types.QueryGetDIDDocumentHistoryResponse{
+		Versions:   make([]*types.DIDDocumentVersion, 0, len(versions)),
+		Pagination: pageRes,
+	}
+	for i := range versions {
+		res.Versions = append(res.Versions, &versions[i])
 	}
+	return res, nil
+}
 
-	// Set page response
-	pageRes.Total = totalCount
-	if uint64(len(documents)) < limit || limit == 0 {
-		pageRes.NextKey = nil
-	} else {
-		pageRes.NextKey = []byte(documents[len(documents)-1].Id)
+// ListDIDDocuments implements types.QueryServer.
+func (k Querier) ListDIDDocuments(
+	goCtx context.Context,
+	req *types.QueryListDIDDocumentsRequest,
+) (*types.QueryListDIDDocumentsResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	page, err := pagination.Parse(req.Pagination, "did/ListDIDDocuments")
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	iter, err := k.OrmDB.DIDDocumentTable().List(ctx, apiv1.DIDDocumentPrimaryKey{}, page.ORMOptions()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list DID documents")
+	}
+	defer iter.Close()
+
+	ormDocs, pageRes, err := pagination.CollectORM(page, iter, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get DID document from iterator")
+	}
+
+	documents := make([]*types.DIDDocument, 0, len(ormDocs))
+	for _, ormDoc := range ormDocs {
+		documents = append(documents, types.DIDDocumentFromORM(ormDoc))
 	}
 
 	return &types.QueryListDIDDocumentsResponse{
@@ -208,30 +300,34 @@ func (k Querier) GetDIDDocumentsByController(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Get all DID documents by controller using the index
+	page, err := pagination.Parse(req.Pagination, "did/GetDIDDocumentsByController/"+req.Controller)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	// Get DID documents by controller using the index
 	indexKey := apiv1.DIDDocumentPrimaryControllerIndexKey{}.WithPrimaryController(
 		req.Controller,
 	)
-	iter, err := k.OrmDB.DIDDocumentTable().List(ctx, indexKey)
+	iter, err := k.OrmDB.DIDDocumentTable().List(ctx, indexKey, page.ORMOptions()...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list DID documents by controller")
 	}
 	defer iter.Close()
 
-	var documents []*types.DIDDocument
-	for iter.Next() {
-		ormDoc, err := iter.Value()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get DID document from iterator")
-		}
+	ormDocs, pageRes, err := pagination.CollectORM(page, iter, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get DID document from iterator")
+	}
 
-		// Convert from ORM type
-		didDoc := types.DIDDocumentFromORM(ormDoc)
-		documents = append(documents, didDoc)
+	documents := make([]*types.DIDDocument, 0, len(ormDocs))
+	for _, ormDoc := range ormDocs {
+		documents = append(documents, types.DIDDocumentFromORM(ormDoc))
 	}
 
 	return &types.QueryGetDIDDocumentsByControllerResponse{
 		DidDocuments: documents,
+		Pagination:   pageRes,
 	}, nil
 }
 
@@ -347,6 +443,92 @@ func (k Querier) GetVerifiableCredential(
 	}, nil
 }
 
+// VerifyCredential implements types.QueryServer. A credential that fails
+// verification is reported with a reason rather than an error.
+func (k Querier) VerifyCredential(
+	goCtx context.Context,
+	req *types.QueryVerifyCredentialRequest,
+) (*types.QueryVerifyCredentialResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+	if req.CredentialId == "" {
+		return nil, errors.Wrap(types.ErrEmptyCredentialID, "credential ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ormCred, err := k.OrmDB.VerifiableCredentialTable().Get(ctx, req.CredentialId)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrCredentialNotFound, "%s", req.CredentialId)
+	}
+	credential := types.VerifiableCredentialFromORM(ormCred)
+
+	res := &types.QueryVerifyCredentialResponse{}
+	var reasons []string
+
+	issuer, err := k.GetDIDDocument(ctx, credential.Issuer)
+	switch {
+	case err != nil || issuer == nil:
+		reasons = append(reasons, fmt.Sprintf("issuer %s cannot be resolved", credential.Issuer))
+	case issuer.Deactivated:
+		reasons = append(reasons, fmt.Sprintf("issuer %s is deactivated", credential.Issuer))
+	default:
+		if err := verifyCredentialProofs(issuer, credential); err != nil {
+			reasons = append(reasons, err.Error())
+		} else {
+			res.ProofVerified = true
+		}
+	}
+
+	if res.Revoked, err = k.isCredentialRevoked(ctx, credential); err != nil {
+		return nil, err
+	}
+	if res.Revoked {
+		reasons = append(reasons, "credential is revoked")
+	}
+
+	if credential.ExpirationDate != "" {
+		expires, err := time.Parse(time.RFC3339, credential.ExpirationDate)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("invalid expiration date %q", credential.ExpirationDate))
+		} else if !ctx.BlockTime().Before(expires) {
+			res.Expired = true
+			reasons = append(reasons, "credential is expired")
+		}
+	}
+
+	res.Valid = len(reasons) == 0
+	res.Reason = strings.Join(reasons, "; ")
+	return res, nil
+}
+
+// GetStatusList implements types.QueryServer.
+func (k Querier) GetStatusList(
+	goCtx context.Context,
+	req *types.QueryGetStatusListRequest,
+) (*types.QueryGetStatusListResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+	if req.Issuer == "" {
+		return nil, errors.Wrap(types.ErrEmptyDID, "issuer cannot be empty")
+	}
+
+	list, err := k.StatusLists.Get(goCtx, collections.Join(req.Issuer, req.ListId))
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrStatusListNotFound, "%s", types.StatusListURL(req.Issuer, req.ListId))
+	}
+	credential, err := list.Credential()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetStatusListResponse{
+		StatusList: list,
+		Credential: string(credential),
+	}, nil
+}
+
 // ListVerifiableCredentials implements types.QueryServer with filtering support.
 func (k Querier) ListVerifiableCredentials(
 	goCtx context.Context,
@@ -358,97 +540,56 @@ func (k Querier) ListVerifiableCredentials(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Create pagination query
-	var pageReq *query.PageRequest
-	if req.Pagination != nil {
-		pageReq = req.Pagination
-	} else {
-		// Default pagination
-		pageReq = &query.PageRequest{
-			Limit: 100,
-		}
+	page, err := pagination.Parse(req.Pagination, fmt.Sprintf(
+		"did/ListVerifiableCredentials/%s/%s/%t", req.Issuer, req.Holder, req.IncludeRevoked,
+	))
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
 	}
 
-	var credentials []*types.VerifiableCredential
-	pageRes := &query.PageResponse{}
-
 	// Determine which index to use based on filters
 	var iter apiv1.VerifiableCredentialIterator
-	var err error
-
 	if req.Issuer != "" {
 		// Use issuer index
 		indexKey := apiv1.VerifiableCredentialIssuerIndexKey{}.WithIssuer(req.Issuer)
-		iter, err = k.OrmDB.VerifiableCredentialTable().List(ctx, indexKey)
+		iter, err = k.OrmDB.VerifiableCredentialTable().List(ctx, indexKey, page.ORMOptions()...)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to list credentials by issuer")
 		}
 	} else if req.Holder != "" {
 		// Use subject/holder index
 		indexKey := apiv1.VerifiableCredentialSubjectIndexKey{}.WithSubject(req.Holder)
-		iter, err = k.OrmDB.VerifiableCredentialTable().List(ctx, indexKey)
+		iter, err = k.OrmDB.VerifiableCredentialTable().List(ctx, indexKey, page.ORMOptions()...)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to list credentials by holder")
 		}
 	} else {
 		// List all credentials
 		iter, err = k.OrmDB.VerifiableCredentialTable().
-			List(ctx, apiv1.VerifiableCredentialPrimaryKey{})
+			List(ctx, apiv1.VerifiableCredentialPrimaryKey{}, page.ORMOptions()...)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to list credentials")
 		}
 	}
 	defer iter.Close()
 
-	// Apply pagination and filters
-	offset := pageReq.Offset
-	limit := pageReq.Limit
-	count := uint64(0)
-	totalCount := uint64(0)
-
-	for iter.Next() {
-		ormCred, err := iter.Value()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get credential from iterator")
+	// Apply the filters the chosen index does not cover
+	ormCreds, pageRes, err := pagination.CollectORM(page, iter, func(c *apiv1.VerifiableCredential) bool {
+		if req.Issuer != "" && c.Issuer != req.Issuer {
+			return false
 		}
-
-		// Convert from ORM type
-		credential := types.VerifiableCredentialFromORM(ormCred)
-
-		// Apply additional filters
-		if req.Issuer != "" && credential.Issuer != req.Issuer {
-			continue
+		if req.Holder != "" && c.Subject != req.Holder {
+			return false
 		}
-		if req.Holder != "" && credential.Subject != req.Holder {
-			continue
-		}
-		if !req.IncludeRevoked && credential.Revoked {
-			continue
-		}
-
-		totalCount++
-
-		// Skip items before offset
-		if count < offset {
-			count++
-			continue
-		}
-
-		// Stop if we've reached the limit
-		if uint64(len(credentials)) >= limit && limit > 0 {
-			continue
-		}
-
-		credentials = append(credentials, credential)
-		count++
+		return req.IncludeRevoked || !c.Revoked
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get credential from iterator")
 	}
 
-	// Set page response
-	pageRes.Total = totalCount
-	if uint64(len(credentials)) < limit || limit == 0 {
-		pageRes.NextKey = nil
-	} else if len(credentials) > 0 {
-		pageRes.NextKey = []byte(credentials[len(credentials)-1].Id)
+	credentials := make([]*types.VerifiableCredential, 0, len(ormCreds))
+	for _, ormCred := range ormCreds {
+		credentials = append(credentials, types.VerifiableCredentialFromORM(ormCred))
 	}
 
 	return &types.QueryListVerifiableCredentialsResponse{
@@ -502,6 +643,13 @@ func (k Querier) GetCredentialsByDID(
 		return nil, errors.Wrap(types.ErrEmptyDID, "DID cannot be empty")
 	}
 
+	page, err := pagination.Parse(req.Pagination, fmt.Sprintf(
+		"did/GetCredentialsByDID/%s/%t/%t/%t", req.Did, req.IncludeWebauthn, req.IncludeVerifiable, req.IncludeRevoked,
+	))
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	var credentialInfos []*types.CredentialInfo
@@ -610,29 +758,94 @@ func (k Querier) GetCredentialsByDID(
 	}
 
 	// Apply pagination
-	pageRes := &query.PageResponse{}
-	if req.Pagination != nil {
-		start := int(req.Pagination.Offset)
-		end := start + int(req.Pagination.Limit)
-
-		if start > len(credentialInfos) {
-			credentialInfos = []*types.CredentialInfo{}
-		} else if end > len(credentialInfos) {
-			credentialInfos = credentialInfos[start:]
-		} else {
-			credentialInfos = credentialInfos[start:end]
-			pageRes.NextKey = []byte("next")
-		}
+	credentialInfos, pageRes, err := pagination.Slice(page, credentialInfos)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
 	}
 
-	pageRes.Total = uint64(len(credentialInfos))
-
 	return &types.QueryGetCredentialsByDIDResponse{
 		Credentials: credentialInfos,
 		Pagination:  pageRes,
 	}, nil
 }
 
+// GetUnverifiedCredentials implements types.QueryServer.
+func (k Querier) GetUnverifiedCredentials(
+	goCtx context.Context,
+	req *types.QueryGetUnverifiedCredentialsRequest,
+) (*types.QueryGetUnverifiedCredentialsResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+	if req.Did == "" {
+		return nil, errors.Wrap(types.ErrEmptyDID, "DID cannot be empty")
+	}
+
+	credentials, err := k.unverifiedCredentials(goCtx, req.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(goCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get module params")
+	}
+
+	return &types.QueryGetUnverifiedCredentialsResponse{
+		Credentials: credentials,
+		Downgraded:  params.Webauthn != nil && params.Webauthn.DowngradeUnverifiedOrigins,
+	}, nil
+}
+
+// GetDelegatedControllers implements types.QueryServer.
+func (k Querier) GetDelegatedControllers(
+	goCtx context.Context,
+	req *types.QueryGetDelegatedControllersRequest,
+) (*types.QueryGetDelegatedControllersResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+	if req.Did == "" {
+		return nil, errors.Wrap(types.ErrEmptyDID, "DID cannot be empty")
+	}
+
+	delegations, err := k.ActiveDelegatedControllers(goCtx, req.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetDelegatedControllersResponse{Delegations: delegations}, nil
+}
+
+// GetDIDAssets implements types.QueryServer.
+func (k Querier) GetDIDAssets(
+	goCtx context.Context,
+	req *types.QueryGetDIDAssetsRequest,
+) (*types.QueryGetDIDAssetsResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "request cannot be nil")
+	}
+	if req.Did == "" {
+		return nil, errors.Wrap(types.ErrEmptyDID, "DID cannot be empty")
+	}
+
+	assets, err := k.Keeper.GetDIDAssets(goCtx, req.Did)
+	if err != nil {
+		return nil, err
+	}
+	if req.OwnedOnly {
+		owned := assets[:0]
+		for _, asset := range assets {
+			if asset.Owned {
+				owned = append(owned, asset)
+			}
+		}
+		assets = owned
+	}
+
+	return &types.QueryGetDIDAssetsResponse{Assets: assets}, nil
+}
+
 // RegisterStart implements types.QueryServer.
 // TODO: Complete implementation per issue #269
 func (k Querier) RegisterStart(
@@ -753,6 +966,23 @@ func (k Querier) LoginStart(
 		return nil, errors.Wrapf(types.ErrNoCredentials, "no WebAuthn credentials found for controller %s", assertion.Controller)
 	}
 
+	// Credentials whose origin is no longer verified may be refused
+	usable := credentialIds[:0]
+	for _, id := range credentialIds {
+		downgraded, err := k.IsCredentialDowngraded(ctx, assertion.Controller, id)
+		if err != nil {
+			return nil, err
+		}
+		if !downgraded {
+			usable = append(usable, id)
+		}
+	}
+	if len(usable) == 0 {
+		return nil, errors.Wrapf(types.ErrCredentialOriginUnverified,
+			"every WebAuthn credential of controller %s is awaiting origin verification", assertion.Controller)
+	}
+	credentialIds = usable
+
 	ctx.Logger().Debug("extracted WebAuthn credentials",
 		"controller", assertion.Controller,
 		"credential_count", len(credentialIds),
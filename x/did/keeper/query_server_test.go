@@ -252,6 +252,134 @@ func (suite *QueryServerTestSuite) TestListDIDDocuments() {
 	}
 }
 
+// Test paging through ListDIDDocuments with next keys
+func (suite *QueryServerTestSuite) TestListDIDDocumentsCursor() {
+	dids := suite.createTestDIDDocuments(5)
+
+	var seen []string
+	req := &types.QueryListDIDDocumentsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	for {
+		resp, err := suite.f.queryServer.ListDIDDocuments(suite.f.ctx, req)
+		suite.Require().NoError(err)
+		for _, doc := range resp.DidDocuments {
+			seen = append(seen, doc.Id)
+		}
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		req = &types.QueryListDIDDocumentsRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		}
+	}
+	suite.Require().Equal(dids, seen)
+
+	// Keys are bound to the query that issued them
+	resp, err := suite.f.queryServer.ListDIDDocuments(suite.f.ctx, &types.QueryListDIDDocumentsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	_, err = suite.f.queryServer.GetDIDDocumentsByController(suite.f.ctx, &types.QueryGetDIDDocumentsByControllerRequest{
+		Controller: suite.f.addrs[0].String(),
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidRequest)
+}
+
+// Test GetDIDDocumentVersion and GetDIDDocumentHistory
+func (suite *QueryServerTestSuite) TestDIDDocumentVersions() {
+	did := suite.createTestDIDDocuments(1)[0]
+	start := time.Unix(1_700_000_000, 0)
+	created := suite.f.ctx.BlockTime()
+
+	// Two updates, an hour apart, each changing the service endpoint
+	for i, endpoint := range []string{"https://v2.example.com", "https://v3.example.com"} {
+		ctx := suite.f.ctx.WithBlockTime(start.Add(time.Duration(i) * time.Hour)).WithBlockHeight(int64(10 + i))
+		current, err := suite.f.queryServer.GetDIDDocument(ctx, &types.QueryGetDIDDocumentRequest{Did: did})
+		suite.Require().NoError(err)
+
+		doc := *current.DidDocument
+		doc.Service = []*types.Service{{
+			Id:             did + "#service-1",
+			ServiceKind:    "LinkedDomains",
+			SingleEndpoint: endpoint,
+		}}
+		_, err = suite.f.msgServer.UpdateDID(ctx, &types.MsgUpdateDID{
+			Controller:  suite.f.addrs[0].String(),
+			Did:         did,
+			DidDocument: doc,
+		})
+		suite.Require().NoError(err)
+	}
+
+	endpointOf := func(doc *types.DIDDocument) string {
+		suite.Require().Len(doc.Service, 1)
+		return doc.Service[0].SingleEndpoint
+	}
+
+	// By version number, with the version that replaced it
+	resp, err := suite.f.queryServer.GetDIDDocumentVersion(suite.f.ctx, &types.QueryGetDIDDocumentVersionRequest{
+		Did:       did,
+		VersionId: 2,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal("https://v2.example.com", endpointOf(resp.DidDocument))
+	suite.Require().Equal("2", resp.DidDocumentMetadata.VersionId)
+	suite.Require().Equal(start.Unix(), resp.DidDocumentMetadata.Updated)
+	suite.Require().Equal(created.Unix(), resp.DidDocumentMetadata.Created)
+	suite.Require().Equal("3", resp.DidDocumentMetadata.NextVersionId)
+	suite.Require().Equal(start.Add(time.Hour).Unix(), resp.DidDocumentMetadata.NextUpdate)
+
+	// By time: half way between the updates resolves to the first of them
+	resp, err = suite.f.queryServer.GetDIDDocumentVersion(suite.f.ctx, &types.QueryGetDIDDocumentVersionRequest{
+		Did:         did,
+		VersionTime: start.Add(30 * time.Minute).UTC().Format(time.RFC3339),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal("2", resp.DidDocumentMetadata.VersionId)
+
+	// Without a selector the latest version is returned
+	resp, err = suite.f.queryServer.GetDIDDocumentVersion(suite.f.ctx, &types.QueryGetDIDDocumentVersionRequest{Did: did})
+	suite.Require().NoError(err)
+	suite.Require().Equal("https://v3.example.com", endpointOf(resp.DidDocument))
+	suite.Require().Equal("3", resp.DidDocumentMetadata.VersionId)
+	suite.Require().Empty(resp.DidDocumentMetadata.NextVersionId)
+
+	_, err = suite.f.queryServer.GetDIDDocumentVersion(suite.f.ctx, &types.QueryGetDIDDocumentVersionRequest{
+		Did:       did,
+		VersionId: 4,
+	})
+	suite.Require().ErrorIs(err, types.ErrDIDVersionNotFound)
+	_, err = suite.f.queryServer.GetDIDDocumentVersion(suite.f.ctx, &types.QueryGetDIDDocumentVersionRequest{
+		Did:         did,
+		VersionId:   1,
+		VersionTime: start.Format(time.RFC3339),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidRequest)
+
+	// History pages through the versions in order
+	var versions []uint64
+	req := &types.QueryGetDIDDocumentHistoryRequest{
+		Did:        did,
+		Pagination: &query.PageRequest{Limit: 2},
+	}
+	for {
+		history, err := suite.f.queryServer.GetDIDDocumentHistory(suite.f.ctx, req)
+		suite.Require().NoError(err)
+		for _, v := range history.Versions {
+			suite.Require().Equal(did, v.Did)
+			suite.Require().Equal(v.VersionId, v.Document.Version)
+			versions = append(versions, v.VersionId)
+		}
+		if history.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: history.Pagination.NextKey}
+	}
+	suite.Require().Equal([]uint64{1, 2, 3}, versions)
+}
+
 // Test GetVerificationMethod
 func (suite *QueryServerTestSuite) TestGetVerificationMethod() {
 	did := "did:example:vm123"
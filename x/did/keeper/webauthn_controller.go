@@ -2,12 +2,12 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sonr-io/common/webauthn"
+	"github.com/sonr-io/sonr/app/randomness"
 	"github.com/sonr-io/sonr/x/did/types"
 )
 
@@ -75,6 +75,10 @@ func (v *WebAuthnControllerVerifier) VerifyControllerWithWebAuthn(
 		)
 	}
 
+	if err := v.keeper.requireVerifiedCredentialOrigin(ctx, did, assertion.CredentialID); err != nil {
+		return err
+	}
+
 	// Verify the WebAuthn assertion
 	return v.verifyWebAuthnAssertion(
 		ctx,
@@ -194,29 +198,26 @@ func (v *WebAuthnControllerVerifier) verifyWebAuthnAssertion(
 	return nil
 }
 
-// CreateWebAuthnChallenge creates a challenge for WebAuthn operations
+// CreateWebAuthnChallenge creates a challenge for WebAuthn operations. The
+// challenge is derived from the block randomness beacon and bound to the DID
+// and operation; clientNonce supplies the unpredictability block data lacks.
 func (v *WebAuthnControllerVerifier) CreateWebAuthnChallenge(
 	ctx context.Context,
 	did string,
 	operation string,
+	clientNonce []byte,
 ) (string, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Create challenge data
-	challengeData := fmt.Sprintf("%s:%s:%d:%d",
-		did,
-		operation,
-		sdkCtx.BlockHeight(),
-		sdkCtx.BlockTime().Unix(),
+	seed, err := randomness.NewBeacon(sdk.UnwrapSDKContext(ctx)).Challenge(
+		randomness.DomainWebAuthnChallenge,
+		clientNonce,
+		[]byte(did),
+		[]byte(operation),
 	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate challenge: %w", err)
+	}
 
-	// Hash the challenge data to create a fixed-length challenge
-	hash := sha256.Sum256([]byte(challengeData))
-
-	// Encode as base64url
-	challenge := base64.URLEncoding.EncodeToString(hash[:])
-
-	return challenge, nil
+	return base64.URLEncoding.EncodeToString(seed[:]), nil
 }
 
 // IsWebAuthnVerificationMethod checks if a verification method is a WebAuthn credential
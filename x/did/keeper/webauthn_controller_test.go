@@ -28,15 +28,28 @@ func (suite *WebAuthnControllerTestSuite) TestCreateWebAuthnChallenge() {
 	did := "did:sonr:test123"
 	operation := "authenticate"
 
+	nonce := []byte("client-nonce-0001")
+
 	// Create challenge
-	challenge, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, operation)
+	challenge, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, operation, nonce)
 	suite.Require().NoError(err)
 	suite.Require().NotEmpty(challenge)
 
 	// Challenge should be deterministic based on inputs
-	challenge2, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, operation)
+	challenge2, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, operation, nonce)
 	suite.Require().NoError(err)
 	suite.Require().Equal(challenge, challenge2)
+
+	// A different client nonce yields a different challenge in the same block
+	challenge3, err := suite.verifier.CreateWebAuthnChallenge(
+		suite.f.ctx, did, operation, []byte("client-nonce-0002"),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(challenge, challenge3)
+
+	// Without a client nonce the challenge would be predictable
+	_, err = suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, operation, nil)
+	suite.Require().Error(err)
 }
 
 func (suite *WebAuthnControllerTestSuite) TestValidateWebAuthnCredential() {
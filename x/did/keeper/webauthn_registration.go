@@ -2,17 +2,18 @@ package keeper
 
 import (
 	"context"
-	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"slices"
-	"time"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	webauthn "github.com/sonr-io/common/webauthn"
 	"github.com/sonr-io/common/webauthn/webauthncbor"
+	apiv1 "github.com/sonr-io/sonr/api/did/v1"
+	"github.com/sonr-io/sonr/app/randomness"
 	"github.com/sonr-io/sonr/x/did/types"
 )
 
@@ -26,6 +27,12 @@ type WebAuthnRegistrationData struct {
 	PublicKey         []byte
 	Algorithm         int32
 	Origin            string
+	// RPID is the relying party the credential was created for; the host of
+	// Origin when empty
+	RPID string
+	// Transports are the transports the authenticator reported, e.g.
+	// "hybrid" for a passkey on a phone
+	Transports []string
 }
 
 // ProcessWebAuthnRegistration processes a WebAuthn credential and creates a DID document
@@ -35,8 +42,11 @@ func (k Keeper) ProcessWebAuthnRegistration(
 ) (*types.DIDDocument, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// Generate a new DID
-	did := k.generateDID(regData.Username)
+	// Derive the DID from the credential public key
+	did, err := k.generateDID(regData)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create WebAuthn credential with full attestation data
 	webAuthnCredential := &types.WebAuthnCredential{
@@ -46,16 +56,35 @@ func (k Keeper) ProcessWebAuthnRegistration(
 		AttestationObject: regData.AttestationObject,
 		PublicKey:         regData.PublicKey,
 		Algorithm:         regData.Algorithm,
-		AttestationType:   "none", // For most platform authenticators
 		Origin:            regData.Origin,
+		RpId:              registrationRPID(regData),
+		Transports:        regData.Transports,
 		CreatedAt:         sdkCtx.BlockTime().Unix(),
 	}
 
+	// Verify the attestation statement and record what it says about the
+	// authenticator
+	attestation := types.NoAttestation
+	if regData.AttestationObject != "" {
+		attestation, err = types.VerifyAttestationStatement(regData.AttestationObject, regData.ClientDataJSON)
+		if err != nil {
+			return nil, types.ErrInvalidAttestation.Wrap(err.Error())
+		}
+	}
+	attestation.Apply(webAuthnCredential)
+
 	// Validate the WebAuthn credential using local types validation
 	if err := types.ValidateStructure(webAuthnCredential); err != nil {
 		return nil, fmt.Errorf("WebAuthn credential validation failed: %w", err)
 	}
 
+	// Services may restrict the authenticators of their relying party
+	if k.serviceKeeper != nil {
+		if err := k.serviceKeeper.CheckAttestation(ctx, webAuthnCredential.RpId, attestation); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check for credential uniqueness to prevent replay attacks
 	if k.HasExistingCredential(sdkCtx, regData.CredentialID) {
 		return nil, fmt.Errorf("WebAuthn credential already exists: %s", regData.CredentialID)
@@ -99,6 +128,7 @@ func (k Keeper) ProcessWebAuthnRegistration(
 		},
 		CapabilityDelegation: []*types.VerificationMethodReference{},
 		Service:              []*types.Service{},
+		Version:              1,
 	}
 
 	// Store the DID document
@@ -109,15 +139,24 @@ func (k Keeper) ProcessWebAuthnRegistration(
 	return didDoc, nil
 }
 
-// CreateWebAuthnChallenge creates a challenge for WebAuthn registration
-func (k Keeper) CreateWebAuthnChallenge(ctx context.Context, username string) (string, error) {
-	// Generate cryptographically secure challenge
-	challengeBytes := make([]byte, 32)
-	if _, err := rand.Read(challengeBytes); err != nil {
+// CreateWebAuthnChallenge creates a challenge for WebAuthn registration. Block
+// data alone is public, so the client must supply a random nonce of at least
+// randomness.MinNonceSize bytes to make the challenge unpredictable.
+func (k Keeper) CreateWebAuthnChallenge(
+	ctx context.Context,
+	username string,
+	clientNonce []byte,
+) (string, error) {
+	seed, err := randomness.NewBeacon(sdk.UnwrapSDKContext(ctx)).Challenge(
+		randomness.DomainWebAuthnChallenge,
+		clientNonce,
+		[]byte(username),
+	)
+	if err != nil {
 		return "", fmt.Errorf("failed to generate random challenge: %w", err)
 	}
 
-	challenge := base64.URLEncoding.EncodeToString(challengeBytes)
+	challenge := base64.URLEncoding.EncodeToString(seed[:])
 
 	// Store challenge with expiration (in production, use proper session storage)
 	// For now, we'll rely on the server-side session management
@@ -177,11 +216,28 @@ func (k Keeper) VerifyWebAuthnRegistration(
 	return nil
 }
 
-// generateDID generates a new DID identifier
-func (k Keeper) generateDID(username string) string {
-	// For now, generate a simple DID based on username and timestamp
-	// In production, this should be more sophisticated
-	return fmt.Sprintf("did:sonr:%s-%d", username, time.Now().Unix())
+// generateDID derives the canonical DID for a registration. The credential
+// public key is preferred; the credential ID is used when no key was supplied.
+func (k Keeper) generateDID(regData *WebAuthnRegistrationData) (string, error) {
+	if len(regData.PublicKey) > 0 {
+		return types.DeriveDIDFromPublicKey(regData.PublicKey)
+	}
+	if regData.CredentialID == "" {
+		return "", fmt.Errorf("credential ID or public key required to derive DID")
+	}
+	return types.GenerateDIDFromCredential(regData.CredentialID, regData.Username), nil
+}
+
+// registrationRPID returns the relying party ID of a registration, which
+// defaults to the host of its origin
+func registrationRPID(regData *WebAuthnRegistrationData) string {
+	if regData.RPID != "" {
+		return regData.RPID
+	}
+	if u, err := url.Parse(regData.Origin); err == nil {
+		return u.Hostname()
+	}
+	return ""
 }
 
 // storeDIDDocument stores a DID document in the state
@@ -193,6 +249,9 @@ func (k Keeper) storeDIDDocument(ctx context.Context, didDoc *types.DIDDocument)
 	if err := k.OrmDB.DIDDocumentTable().Insert(ctx, ormDoc); err != nil {
 		return fmt.Errorf("failed to insert DID document: %w", err)
 	}
+	if err := k.recordDocumentVersion(ctx, didDoc); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -283,6 +342,44 @@ func (k Keeper) GetWebAuthnCredentialsByDID(
 	return credentials, nil
 }
 
+// GetWebAuthnCredentialsByRPID retrieves the WebAuthn credentials registered
+// with a relying party, in DID order. Deactivated DIDs are skipped.
+func (k Keeper) GetWebAuthnCredentialsByRPID(
+	ctx context.Context,
+	rpID string,
+) ([]types.RPCredential, error) {
+	iterator, err := k.OrmDB.DIDDocumentTable().List(ctx, &apiv1.DIDDocumentPrimaryKey{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DID documents: %w", err)
+	}
+	defer iterator.Close()
+
+	var credentials []types.RPCredential
+	for iterator.Next() {
+		ormDoc, err := iterator.Value()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DID document: %w", err)
+		}
+		if ormDoc.Deactivated {
+			continue
+		}
+
+		didDoc := types.DIDDocumentFromORM(ormDoc)
+		for _, vm := range didDoc.VerificationMethod {
+			if vm.WebauthnCredential == nil || vm.WebauthnCredential.RpId != rpID {
+				continue
+			}
+			credentials = append(credentials, types.RPCredential{
+				DID:         didDoc.Id,
+				AlsoKnownAs: didDoc.AlsoKnownAs,
+				Credential:  vm.WebauthnCredential,
+			})
+		}
+	}
+
+	return credentials, nil
+}
+
 // ValidateWebAuthnCredential validates a WebAuthn credential exists and is valid
 func (k Keeper) ValidateWebAuthnCredential(ctx context.Context, did, credentialID string) error {
 	credentials, err := k.GetWebAuthnCredentialsByDID(ctx, did)
@@ -392,11 +392,12 @@ func (suite *WebAuthnSecurityTestSuite) TestUserVerificationFlags() {
 func (suite *WebAuthnSecurityTestSuite) TestChallengeUniqueness() {
 	// Test that different DIDs or operations produce different challenges
 	challenges := make(map[string]bool)
+	challengeNonce := []byte("security-test-nonce")
 
 	// Test with different DIDs
 	for i := 0; i < 10; i++ {
 		did := "did:sonr:challengetest" + string(rune('0'+i))
-		challenge, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, "authenticate")
+		challenge, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, "authenticate", challengeNonce)
 		suite.Require().NoError(err)
 		suite.Require().NotEmpty(challenge)
 
@@ -410,7 +411,7 @@ func (suite *WebAuthnSecurityTestSuite) TestChallengeUniqueness() {
 	did := "did:sonr:challengetest"
 	operations := []string{"authenticate", "register", "revoke", "update"}
 	for _, op := range operations {
-		challenge, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, op)
+		challenge, err := suite.verifier.CreateWebAuthnChallenge(suite.f.ctx, did, op, challengeNonce)
 		suite.Require().NoError(err)
 		suite.Require().NotEmpty(challenge)
 
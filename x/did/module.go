@@ -7,6 +7,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -19,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/sonr-io/sonr/x/did/client/cli"
 	"github.com/sonr-io/sonr/x/did/keeper"
 	"github.com/sonr-io/sonr/x/did/types"
 )
@@ -32,6 +34,7 @@ var (
 	_ module.AppModuleBasic   = AppModuleBasic{}
 	_ module.AppModuleGenesis = AppModule{}
 	_ module.AppModule        = AppModule{}
+	_ module.HasABCIEndBlock  = AppModule{}
 
 	_ autocli.HasAutoCLIConfig = AppModule{}
 )
@@ -99,17 +102,12 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux
 	}
 }
 
-// Disable in favor of autocli.go. If you wish to use these, it will override AutoCLI methods.
-/*
+// GetTxCmd returns the hand-written tx commands. AutoCLI enhances it with the
+// generated commands for every Msg (see EnhanceCustomCommand in autocli.go).
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd()
 }
 
-func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
-}
-*/
-
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
 }
@@ -154,6 +152,18 @@ func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper))
 }
 
+// EndBlock re-checks the origins of a batch of stored WebAuthn credentials
+// every origin check interval, and removes expired delegated controllers.
+func (a AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	if err := a.keeper.CheckCredentialOrigins(ctx); err != nil {
+		a.keeper.Logger().Error("failed to check WebAuthn credential origins", "error", err)
+	}
+	if err := a.keeper.RemoveExpiredDelegations(ctx); err != nil {
+		a.keeper.Logger().Error("failed to remove expired delegated controllers", "error", err)
+	}
+	return []abci.ValidatorUpdate{}, nil
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
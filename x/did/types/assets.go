@@ -0,0 +1,16 @@
+package types
+
+import "strings"
+
+// MaxAssetsPerDID caps the tokens a DID can show on its profile
+const MaxAssetsPerDID = 64
+
+// InterchainClassPrefix starts the class IDs ICS-721 gives tokens received
+// from other chains
+const InterchainClassPrefix = "ibc/"
+
+// IsInterchainClass reports whether a class holds tokens received over
+// ICS-721
+func IsInterchainClass(classID string) bool {
+	return strings.HasPrefix(classID, InterchainClassPrefix)
+}
@@ -0,0 +1,195 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/sonr-io/common/webauthn"
+	"github.com/sonr-io/common/webauthn/webauthncbor"
+)
+
+// Attestation statement formats whose statements are verified at
+// registration
+const (
+	AttestationFormatNone       = "none"
+	AttestationFormatPacked     = "packed"
+	AttestationFormatTPM        = "tpm"
+	AttestationFormatApple      = "apple"
+	AttestationFormatAndroidKey = "android-key"
+)
+
+// SupportedAttestationFormats lists the attestation formats accepted at
+// registration. Credentials attested in any other format are refused.
+var SupportedAttestationFormats = []string{
+	AttestationFormatNone,
+	AttestationFormatPacked,
+	AttestationFormatTPM,
+	AttestationFormatApple,
+	AttestationFormatAndroidKey,
+}
+
+// Attestation types, as defined by the WebAuthn specification
+const (
+	AttestationTypeNone   = "none"
+	AttestationTypeSelf   = "self"
+	AttestationTypeBasic  = "basic"
+	AttestationTypeAttCA  = "attca"
+	AttestationTypeAnonCA = "anonca"
+)
+
+// AttestationResult describes the authenticator behind a verified
+// attestation statement
+type AttestationResult struct {
+	// Format is the attestation statement format
+	Format string
+	// Type is the attestation type the statement was verified as
+	Type string
+	// AAGUID identifies the authenticator model
+	AAGUID []byte
+	// BackupEligible is set when the credential may be synced to other devices
+	BackupEligible bool
+	// BackupState is set when the credential was backed up at registration
+	BackupState bool
+	// CertificateChain is the DER encoded attestation certificate chain, leaf
+	// first; empty for "none" and self attestation
+	CertificateChain [][]byte
+}
+
+// NoAttestation is the result for a credential registered without an
+// attestation object
+var NoAttestation = AttestationResult{
+	Format: AttestationFormatNone,
+	Type:   AttestationTypeNone,
+}
+
+// Attested reports whether the statement was signed by an authenticator
+// vendor key rather than by the credential itself or not at all
+func (r AttestationResult) Attested() bool {
+	return r.Type != AttestationTypeNone && r.Type != AttestationTypeSelf
+}
+
+// AAGUIDString returns the AAGUID in UUID form
+func (r AttestationResult) AAGUIDString() string {
+	return FormatAAGUID(r.AAGUID)
+}
+
+// FormatAAGUID formats a 16 byte AAGUID in lowercase UUID form. It returns an
+// empty string for any other length.
+func FormatAAGUID(aaguid []byte) string {
+	if len(aaguid) != 16 {
+		return ""
+	}
+	h := hex.EncodeToString(aaguid)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// Apply records the verified attestation on the credential
+func (r AttestationResult) Apply(cred *WebAuthnCredential) {
+	cred.AttestationFormat = r.Format
+	cred.AttestationType = r.Type
+	cred.Aaguid = r.AAGUID
+	cred.BackupEligible = r.BackupEligible
+	cred.BackupState = r.BackupState
+}
+
+// VerifyAttestationStatement verifies the attestation statement of a
+// registration against the client data it signs. The statement's signature
+// and certificate requirements are checked for its format; whether its
+// certificate chain leads to a trusted root is left to the relying party's
+// attestation policy.
+func VerifyAttestationStatement(attestationObject, clientDataJSON string) (AttestationResult, error) {
+	attestationBytes, err := base64.RawURLEncoding.DecodeString(attestationObject)
+	if err != nil {
+		attestationBytes, err = base64.StdEncoding.DecodeString(attestationObject)
+		if err != nil {
+			return AttestationResult{}, fmt.Errorf("failed to decode attestation object: %w", err)
+		}
+	}
+
+	var obj webauthn.AttestationObject
+	if err := webauthncbor.Unmarshal(attestationBytes, &obj); err != nil {
+		return AttestationResult{}, fmt.Errorf("failed to unmarshal attestation object: %w", err)
+	}
+	if err := obj.AuthData.Unmarshal(obj.RawAuthData); err != nil {
+		return AttestationResult{}, fmt.Errorf("failed to unmarshal authenticator data: %w", err)
+	}
+	if !obj.AuthData.Flags.HasAttestedCredentialData() {
+		return AttestationResult{}, fmt.Errorf("attestation object missing attested credential data")
+	}
+	if !slices.Contains(SupportedAttestationFormats, obj.Format) {
+		return AttestationResult{}, fmt.Errorf("unsupported attestation format %q", obj.Format)
+	}
+
+	clientDataBytes, err := base64.RawURLEncoding.DecodeString(clientDataJSON)
+	if err != nil {
+		clientDataBytes, err = base64.StdEncoding.DecodeString(clientDataJSON)
+		if err != nil {
+			clientDataBytes = []byte(clientDataJSON)
+		}
+	}
+	clientDataHash := sha256.Sum256(clientDataBytes)
+
+	// No metadata service is consulted: the chain cannot fetch it, and trust
+	// in attestation roots is configured per relying party instead
+	if err := obj.VerifyAttestation(clientDataHash[:], nil); err != nil {
+		return AttestationResult{}, fmt.Errorf("invalid %s attestation statement: %w", obj.Format, err)
+	}
+
+	chain, err := attestationCertificates(obj.AttStatement)
+	if err != nil {
+		return AttestationResult{}, err
+	}
+
+	return AttestationResult{
+		Format:           obj.Format,
+		Type:             attestationType(obj.Format, len(chain) > 0),
+		AAGUID:           obj.AuthData.AttData.AAGUID,
+		BackupEligible:   obj.AuthData.Flags.HasBackupEligible(),
+		BackupState:      obj.AuthData.Flags.HasBackupState(),
+		CertificateChain: chain,
+	}, nil
+}
+
+// attestationType returns the attestation type a verified statement of the
+// given format attests
+func attestationType(format string, hasCertificates bool) string {
+	switch format {
+	case AttestationFormatNone:
+		return AttestationTypeNone
+	case AttestationFormatTPM:
+		return AttestationTypeAttCA
+	case AttestationFormatApple:
+		return AttestationTypeAnonCA
+	case AttestationFormatPacked:
+		if !hasCertificates {
+			return AttestationTypeSelf
+		}
+	}
+	return AttestationTypeBasic
+}
+
+// attestationCertificates reads the x5c certificate chain of an attestation
+// statement
+func attestationCertificates(statement map[string]any) ([][]byte, error) {
+	raw, ok := statement["x5c"]
+	if !ok {
+		return nil, nil
+	}
+	certs, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("attestation statement x5c is not an array")
+	}
+
+	chain := make([][]byte, 0, len(certs))
+	for _, c := range certs {
+		der, ok := c.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("attestation statement x5c holds a non-certificate value")
+		}
+		chain = append(chain, der)
+	}
+	return chain, nil
+}
@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+var testAAGUID = []byte{
+	0xcb, 0x69, 0x48, 0x1e, 0x8f, 0xf7, 0x40, 0x39,
+	0x93, 0xec, 0x0a, 0x27, 0x29, 0xa1, 0x54, 0xa8,
+}
+
+// packedSelfAttestation builds a registration signed with the credential key
+// itself, as packed self attestation does. flags are set on the
+// authenticator data in addition to UP and AT.
+func packedSelfAttestation(t *testing.T, format string, flags byte) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	coseKey, err := cbor.Marshal(map[int]any{
+		1:  2,
+		3:  -7,
+		-1: 1,
+		-2: key.PublicKey.X.FillBytes(make([]byte, 32)),
+		-3: key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	rpIDHash := sha256.Sum256([]byte("example.com"))
+	credentialID := []byte("credential-1")
+	authData := append([]byte{}, rpIDHash[:]...)
+	authData = append(authData, 0x41|flags, 0, 0, 0, 0)
+	authData = append(authData, testAAGUID...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(credentialID)))
+	authData = append(authData, credentialID...)
+	authData = append(authData, coseKey...)
+
+	clientData := []byte(`{"type":"webauthn.create","challenge":"Y2hhbGxlbmdl","origin":"https://example.com"}`)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	obj, err := cbor.Marshal(map[string]any{
+		"fmt":      format,
+		"attStmt":  map[string]any{"alg": -7, "sig": sig},
+		"authData": authData,
+	})
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(obj), base64.RawURLEncoding.EncodeToString(clientData)
+}
+
+func TestVerifyAttestationStatement(t *testing.T) {
+	const backupEligible, backupState = 0x08, 0x10
+
+	attestationObject, clientDataJSON := packedSelfAttestation(t, types.AttestationFormatPacked, backupEligible|backupState)
+	res, err := types.VerifyAttestationStatement(attestationObject, clientDataJSON)
+	require.NoError(t, err)
+	require.Equal(t, types.AttestationFormatPacked, res.Format)
+	require.Equal(t, types.AttestationTypeSelf, res.Type)
+	require.False(t, res.Attested())
+	require.Equal(t, "cb69481e-8ff7-4039-93ec-0a2729a154a8", res.AAGUIDString())
+	require.True(t, res.BackupEligible)
+	require.True(t, res.BackupState)
+	require.Empty(t, res.CertificateChain)
+
+	cred := &types.WebAuthnCredential{}
+	res.Apply(cred)
+	require.Equal(t, types.AttestationTypeSelf, cred.AttestationType)
+	require.Equal(t, testAAGUID, cred.Aaguid)
+	require.True(t, cred.BackupEligible)
+
+	// The statement signs the client data; other client data fails
+	_, otherClientData := packedSelfAttestation(t, types.AttestationFormatPacked, 0)
+	_, err = types.VerifyAttestationStatement(attestationObject, otherClientData)
+	require.ErrorContains(t, err, "invalid packed attestation statement")
+
+	// A none statement must not carry a signature
+	attestationObject, clientDataJSON = packedSelfAttestation(t, types.AttestationFormatNone, 0)
+	_, err = types.VerifyAttestationStatement(attestationObject, clientDataJSON)
+	require.Error(t, err)
+
+	attestationObject, clientDataJSON = packedSelfAttestation(t, "fido-u2f", 0)
+	_, err = types.VerifyAttestationStatement(attestationObject, clientDataJSON)
+	require.ErrorContains(t, err, "unsupported attestation format")
+}
+
+func TestFormatAAGUID(t *testing.T) {
+	require.Equal(t, "cb69481e-8ff7-4039-93ec-0a2729a154a8", types.FormatAAGUID(testAAGUID))
+	require.Equal(t, "00000000-0000-0000-0000-000000000000", types.FormatAAGUID(make([]byte, 16)))
+	require.Empty(t, types.FormatAAGUID(testAAGUID[:8]))
+}
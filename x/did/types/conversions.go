@@ -77,6 +77,10 @@ func (vm *VerificationMethod) ToORM() *apiv1.VerificationMethod {
 			RawId:              vm.WebauthnCredential.RawId,
 			ClientDataJson:     vm.WebauthnCredential.ClientDataJson,
 			AttestationObject:  vm.WebauthnCredential.AttestationObject,
+			AttestationFormat:  vm.WebauthnCredential.AttestationFormat,
+			Aaguid:             vm.WebauthnCredential.Aaguid,
+			BackupEligible:     vm.WebauthnCredential.BackupEligible,
+			BackupState:        vm.WebauthnCredential.BackupState,
 		}
 	}
 
@@ -168,6 +172,7 @@ func (m *DIDDocumentMetadata) ToORM() *apiv1.DIDDocumentMetadata {
 		NextVersionId: m.NextVersionId,
 		EquivalentId:  m.EquivalentId,
 		CanonicalId:   m.CanonicalId,
+		Status:        apiv1.DIDStatus(m.Status),
 	}
 }
 
@@ -248,6 +253,10 @@ func VerificationMethodFromORM(ormVM *apiv1.VerificationMethod) *VerificationMet
 			RawId:              ormVM.WebauthnCredential.RawId,
 			ClientDataJson:     ormVM.WebauthnCredential.ClientDataJson,
 			AttestationObject:  ormVM.WebauthnCredential.AttestationObject,
+			AttestationFormat:  ormVM.WebauthnCredential.AttestationFormat,
+			Aaguid:             ormVM.WebauthnCredential.Aaguid,
+			BackupEligible:     ormVM.WebauthnCredential.BackupEligible,
+			BackupState:        ormVM.WebauthnCredential.BackupState,
 		}
 	}
 
@@ -339,6 +348,7 @@ func DIDDocumentMetadataFromORM(ormMeta *apiv1.DIDDocumentMetadata) *DIDDocument
 		NextVersionId: ormMeta.NextVersionId,
 		EquivalentId:  ormMeta.EquivalentId,
 		CanonicalId:   ormMeta.CanonicalId,
+		Status:        DIDStatus(ormMeta.Status),
 	}
 }
 
@@ -397,3 +407,21 @@ func convertVerificationMethodReferencesFromORM(
 
 	return refs
 }
+
+// DIDSummary is the controller and status of a DID document, for modules that
+// act for a DID without needing the whole document
+type DIDSummary struct {
+	Controller  string
+	Deactivated bool
+}
+
+// DIDSummaryFromORM reads the summary of an ORM DID document
+func DIDSummaryFromORM(ormDoc *apiv1.DIDDocument) DIDSummary {
+	if ormDoc == nil {
+		return DIDSummary{}
+	}
+	return DIDSummary{
+		Controller:  ormDoc.PrimaryController,
+		Deactivated: ormDoc.Deactivated,
+	}
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// DIDMethodPrefix is the prefix of every Sonr DID
+	DIDMethodPrefix = "did:sonr:"
+
+	// derivedDIDVersion is the version byte of identifiers derived from a
+	// public key
+	derivedDIDVersion byte = 0x01
+
+	// credentialDIDVersion is the version byte of identifiers derived from a
+	// WebAuthn credential ID, when the public key is not available
+	credentialDIDVersion byte = 0x02
+
+	// derivedDIDHashSize is the number of hash bytes kept in the identifier
+	derivedDIDHashSize = 20
+
+	// derivedDIDChecksumSize is the number of checksum bytes appended to the identifier
+	derivedDIDChecksumSize = 4
+
+	// multibaseBase58BTC is the multibase prefix for base58btc
+	multibaseBase58BTC = 'z'
+
+	// derivedDIDDomain separates DID derivation from other uses of the key hash
+	derivedDIDDomain = "sonr/did/v1"
+
+	// credentialDIDDomain separates credential ID derivation from key derivation
+	credentialDIDDomain = "sonr/did/credential/v1"
+)
+
+// DeriveDIDFromPublicKey derives the canonical DID for a credential public key.
+//
+// The identifier is the multibase (base58btc) encoding of
+// version || sha256(domain || key)[:20] || checksum, where the checksum is the
+// first 4 bytes of a double SHA-256 over version and hash. The same key always
+// yields the same DID, and a mistyped DID fails its checksum.
+func DeriveDIDFromPublicKey(publicKey []byte) (string, error) {
+	if len(publicKey) == 0 {
+		return "", fmt.Errorf("public key cannot be empty")
+	}
+	return deriveDID(derivedDIDVersion, derivedDIDDomain, publicKey), nil
+}
+
+// VerifyDIDForPublicKey checks that did is the canonical DID of publicKey
+func VerifyDIDForPublicKey(did string, publicKey []byte) error {
+	expected, err := DeriveDIDFromPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	version, _, err := parseDerivedDID(did)
+	if err != nil {
+		return err
+	}
+	if version == credentialDIDVersion {
+		return fmt.Errorf("DID %s is derived from a credential ID, not a public key", did)
+	}
+	if did != expected {
+		return fmt.Errorf("DID %s does not match public key", did)
+	}
+	return nil
+}
+
+// ParseDerivedDID decodes a derived DID and validates its version and checksum,
+// returning the hash it commits to. Both key and credential ID derived DIDs
+// are accepted.
+func ParseDerivedDID(did string) ([]byte, error) {
+	_, hash, err := parseDerivedDID(did)
+	return hash, err
+}
+
+// IsCredentialDerivedDID reports whether did is a valid derived DID whose
+// identifier comes from a credential ID rather than a public key
+func IsCredentialDerivedDID(did string) bool {
+	version, _, err := parseDerivedDID(did)
+	return err == nil && version == credentialDIDVersion
+}
+
+// parseDerivedDID decodes a derived DID, returning its version and hash
+func parseDerivedDID(did string) (byte, []byte, error) {
+	identifier, ok := strings.CutPrefix(did, DIDMethodPrefix)
+	if !ok {
+		return 0, nil, fmt.Errorf("DID %s does not use the sonr method", did)
+	}
+	if len(identifier) < 2 || identifier[0] != multibaseBase58BTC {
+		return 0, nil, fmt.Errorf("DID %s is not a base58btc multibase identifier", did)
+	}
+
+	payload, err := base58.Decode(identifier[1:])
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid DID encoding: %w", err)
+	}
+	if len(payload) != 1+derivedDIDHashSize+derivedDIDChecksumSize {
+		return 0, nil, fmt.Errorf("invalid DID identifier length %d", len(payload))
+	}
+	version := payload[0]
+	if version != derivedDIDVersion && version != credentialDIDVersion {
+		return 0, nil, fmt.Errorf("unsupported DID identifier version %d", version)
+	}
+
+	body := payload[:1+derivedDIDHashSize]
+	if !bytes.Equal(payload[len(body):], didChecksum(body)) {
+		return 0, nil, fmt.Errorf("DID %s has an invalid checksum", did)
+	}
+
+	return version, body[1:], nil
+}
+
+// deriveDID encodes the identifier for material under a version and its
+// hashing domain
+func deriveDID(version byte, domain string, material []byte) string {
+	h := sha256.New()
+	h.Write([]byte(domain))
+	h.Write(material)
+	digest := h.Sum(nil)
+
+	body := make([]byte, 0, 1+derivedDIDHashSize+derivedDIDChecksumSize)
+	body = append(body, version)
+	body = append(body, digest[:derivedDIDHashSize]...)
+	body = append(body, didChecksum(body)...)
+
+	return DIDMethodPrefix + string(multibaseBase58BTC) + base58.Encode(body)
+}
+
+// didChecksum returns the checksum bytes for a version || hash body
+func didChecksum(body []byte) []byte {
+	first := sha256.Sum256(body)
+	second := sha256.Sum256(first[:])
+	return second[:derivedDIDChecksumSize]
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+func TestDeriveDIDFromPublicKey(t *testing.T) {
+	key := []byte{0x04, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}
+
+	did, err := types.DeriveDIDFromPublicKey(key)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(did, types.DIDMethodPrefix+"z"))
+
+	again, err := types.DeriveDIDFromPublicKey(key)
+	require.NoError(t, err)
+	require.Equal(t, did, again)
+
+	other, err := types.DeriveDIDFromPublicKey(append([]byte{0x05}, key[1:]...))
+	require.NoError(t, err)
+	require.NotEqual(t, did, other)
+
+	require.NoError(t, types.VerifyDIDForPublicKey(did, key))
+	require.Error(t, types.VerifyDIDForPublicKey(other, key))
+
+	_, err = types.DeriveDIDFromPublicKey(nil)
+	require.Error(t, err)
+}
+
+func TestParseDerivedDIDChecksum(t *testing.T) {
+	did, err := types.DeriveDIDFromPublicKey([]byte("public-key"))
+	require.NoError(t, err)
+
+	hash, err := types.ParseDerivedDID(did)
+	require.NoError(t, err)
+	require.Len(t, hash, 20)
+
+	// Flip the last character to break the checksum
+	last := did[len(did)-1]
+	replacement := byte('2')
+	if last == replacement {
+		replacement = '3'
+	}
+	_, err = types.ParseDerivedDID(did[:len(did)-1] + string(replacement))
+	require.Error(t, err)
+
+	_, err = types.ParseDerivedDID("did:sonr:alice-1700000000")
+	require.Error(t, err)
+}
+
+func TestCredentialDIDIsNotKeyDerived(t *testing.T) {
+	material := []byte("credential-1")
+	credentialDID := types.GenerateDIDFromCredential(string(material), "")
+	keyDID, err := types.DeriveDIDFromPublicKey(material)
+	require.NoError(t, err)
+	require.NotEqual(t, keyDID, credentialDID)
+
+	// Credential DIDs are well formed, but never verify against a key
+	_, err = types.ParseDerivedDID(credentialDID)
+	require.NoError(t, err)
+	require.True(t, types.IsCredentialDerivedDID(credentialDID))
+	require.False(t, types.IsCredentialDerivedDID(keyDID))
+	require.Error(t, types.VerifyDIDForPublicKey(credentialDID, material))
+	require.NoError(t, types.VerifyDIDForPublicKey(keyDID, material))
+}
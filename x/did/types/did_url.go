@@ -0,0 +1,95 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// didSyntax is the DID Core syntax: did:<method>:<method-specific-id>
+var didSyntax = regexp.MustCompile(`^did:[a-z0-9]+:(?:(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})*:)*(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})+$`)
+
+// IsValidDIDSyntax reports whether s is a DID in the DID Core syntax
+func IsValidDIDSyntax(s string) bool {
+	return didSyntax.MatchString(s)
+}
+
+// DIDURL is a DID followed by an optional path, query and fragment. A
+// service is selected by the fragment, did:sonr:abc#vault, or by the
+// service parameter, did:sonr:abc?service=vault&relativeRef=/config.json.
+type DIDURL struct {
+	DID      string
+	Path     string
+	Query    url.Values
+	Fragment string
+}
+
+// ParseDIDURL parses a DID URL
+func ParseDIDURL(s string) (DIDURL, error) {
+	rest, fragment, _ := strings.Cut(s, "#")
+	rest, rawQuery, _ := strings.Cut(rest, "?")
+	did, path, hasPath := strings.Cut(rest, "/")
+	if !IsValidDIDSyntax(did) {
+		return DIDURL{}, ErrInvalidDIDURL.Wrapf("%q does not start with a DID", s)
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return DIDURL{}, ErrInvalidDIDURL.Wrapf("%q: %s", s, err)
+	}
+
+	u := DIDURL{DID: did, Query: query, Fragment: fragment}
+	if hasPath {
+		u.Path = "/" + path
+	}
+	return u, nil
+}
+
+// ServiceID returns the ID of the service the URL selects, or "" when it
+// selects none
+func (u DIDURL) ServiceID() string {
+	name := u.Query.Get("service")
+	if name == "" {
+		name = u.Fragment
+	}
+	if name == "" {
+		return ""
+	}
+	return u.DID + "#" + name
+}
+
+// DereferenceService returns the endpoint of the service the URL selects in
+// doc, with the relativeRef parameter or the URL's path appended. A service
+// with several endpoints is dereferenced to its first.
+func (u DIDURL) DereferenceService(doc *DIDDocument) (string, error) {
+	id := u.ServiceID()
+	if id == "" {
+		return "", ErrInvalidDIDURL.Wrapf("%s selects no service", u.DID)
+	}
+	if doc.Id != u.DID {
+		return "", ErrInvalidDIDURL.Wrapf("document %s does not match %s", doc.Id, u.DID)
+	}
+
+	for _, svc := range doc.Service {
+		// Services may be stored with a relative ID
+		if svc.Id != id && u.DID+svc.Id != id {
+			continue
+		}
+		endpoint := svc.SingleEndpoint
+		if endpoint == "" && svc.MultipleEndpoints != nil && len(svc.MultipleEndpoints.Endpoints) > 0 {
+			endpoint = svc.MultipleEndpoints.Endpoints[0]
+		}
+		if endpoint == "" {
+			return "", ErrServiceNotFound.Wrapf("%s has no URL endpoint", id)
+		}
+
+		ref := u.Query.Get("relativeRef")
+		if ref == "" {
+			ref = u.Path
+		}
+		if ref != "" {
+			endpoint = strings.TrimRight(endpoint, "/") + "/" + strings.TrimLeft(ref, "/")
+		}
+		return endpoint, nil
+	}
+	return "", ErrServiceNotFound.Wrap(id)
+}
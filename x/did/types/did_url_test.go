@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+func TestParseDIDURL(t *testing.T) {
+	u, err := types.ParseDIDURL("did:sonr:alice/icons?service=cdn&version=2#frag")
+	require.NoError(t, err)
+	require.Equal(t, "did:sonr:alice", u.DID)
+	require.Equal(t, "/icons", u.Path)
+	require.Equal(t, "2", u.Query.Get("version"))
+	require.Equal(t, "frag", u.Fragment)
+	// The service parameter takes precedence over the fragment
+	require.Equal(t, "did:sonr:alice#cdn", u.ServiceID())
+
+	u, err = types.ParseDIDURL("did:sonr:alice")
+	require.NoError(t, err)
+	require.Empty(t, u.ServiceID())
+
+	_, err = types.ParseDIDURL("sonr:alice#vault")
+	require.ErrorIs(t, err, types.ErrInvalidDIDURL)
+}
+
+func TestDereferenceService(t *testing.T) {
+	doc := &types.DIDDocument{
+		Id: "did:sonr:alice",
+		Service: []*types.Service{
+			{Id: "did:sonr:alice#vault", SingleEndpoint: "ipfs://bafyvault/"},
+			{Id: "#cdn", MultipleEndpoints: &types.ServiceEndpoints{Endpoints: []string{"https://cdn1.example.com", "https://cdn2.example.com"}}},
+		},
+	}
+
+	for didURL, want := range map[string]string{
+		"did:sonr:alice#vault":                                  "ipfs://bafyvault/",
+		"did:sonr:alice/config.json#vault":                      "ipfs://bafyvault/config.json",
+		"did:sonr:alice?service=vault&relativeRef=/config.json": "ipfs://bafyvault/config.json",
+		"did:sonr:alice#cdn":                                    "https://cdn1.example.com",
+	} {
+		u, err := types.ParseDIDURL(didURL)
+		require.NoError(t, err)
+		got, err := u.DereferenceService(doc)
+		require.NoError(t, err, didURL)
+		require.Equal(t, want, got, didURL)
+	}
+
+	u, _ := types.ParseDIDURL("did:sonr:alice#missing")
+	_, err := u.DereferenceService(doc)
+	require.ErrorIs(t, err, types.ErrServiceNotFound)
+
+	u, _ = types.ParseDIDURL("did:sonr:bob#vault")
+	_, err = u.DereferenceService(doc)
+	require.ErrorIs(t, err, types.ErrInvalidDIDURL)
+}
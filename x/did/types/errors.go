@@ -174,6 +174,7 @@ var (
 		"credential ID already exists",
 	)
 	ErrDIDAlreadyDeactivated    = errors.Register(ModuleName, 47, "DID already deactivated")
+	ErrDIDNotDeactivated        = errors.Register(ModuleName, 68, "DID is not deactivated")
 	ErrCredentialAlreadyRevoked = errors.Register(
 		ModuleName,
 		48,
@@ -181,7 +182,8 @@ var (
 	)
 
 	// Query errors
-	ErrInvalidRequest = errors.Register(ModuleName, 49, "invalid request")
+	ErrInvalidRequest     = errors.Register(ModuleName, 49, "invalid request")
+	ErrDIDVersionNotFound = errors.Register(ModuleName, 67, "DID document version not found")
 
 	// Parameter errors
 	ErrInvalidParams = errors.Register(ModuleName, 62, "invalid module parameters")
@@ -260,6 +262,57 @@ var (
 		66,
 		"no WebAuthn credentials found",
 	)
+	ErrCredentialOriginUnverified = errors.Register(
+		ModuleName,
+		69,
+		"WebAuthn credential origin is no longer verified",
+	)
+	ErrInvalidAttestation = errors.Register(
+		ModuleName,
+		76,
+		"invalid WebAuthn attestation",
+	)
+	ErrStatusListNotFound = errors.Register(
+		ModuleName,
+		70,
+		"status list not found",
+	)
+	ErrStatusListEntryTaken = errors.Register(
+		ModuleName,
+		71,
+		"status list index already allocated",
+	)
+
+	// Delegated controller errors
+	ErrDelegationNotFound = errors.Register(
+		ModuleName,
+		72,
+		"delegated controller not found",
+	)
+	ErrInvalidDelegation = errors.Register(
+		ModuleName,
+		73,
+		"invalid delegated controller",
+	)
+
+	// Profile asset errors
+	ErrAssetNotFound = errors.Register(
+		ModuleName,
+		74,
+		"asset not attached to DID",
+	)
+	ErrInvalidAsset = errors.Register(
+		ModuleName,
+		75,
+		"invalid asset",
+	)
+
+	// DID URL errors
+	ErrInvalidDIDURL = errors.Register(
+		ModuleName,
+		77,
+		"invalid DID URL",
+	)
 
 	// UCAN authorization errors
 	ErrUCANValidationFailed = errors.Register(
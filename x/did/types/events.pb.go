@@ -273,6 +273,79 @@ func (m *EventDIDDeactivated) GetBlockHeight() uint64 {
 	return 0
 }
 
+// EventDIDReactivated is emitted when a deactivated DID is reactivated
+type EventDIDReactivated struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Reactivator address
+	Reactivator string `protobuf:"bytes,2,opt,name=reactivator,proto3" json:"reactivator,omitempty"`
+	// Reactivation timestamp
+	ReactivatedAt time.Time `protobuf:"bytes,3,opt,name=reactivated_at,json=reactivatedAt,proto3,stdtime" json:"reactivated_at"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventDIDReactivated) Reset()         { *m = EventDIDReactivated{} }
+func (m *EventDIDReactivated) String() string { return proto.CompactTextString(m) }
+func (*EventDIDReactivated) ProtoMessage()    {}
+func (*EventDIDReactivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{3}
+}
+func (m *EventDIDReactivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDIDReactivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDIDReactivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDIDReactivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDIDReactivated.Merge(m, src)
+}
+func (m *EventDIDReactivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDIDReactivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDIDReactivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDIDReactivated proto.InternalMessageInfo
+
+func (m *EventDIDReactivated) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *EventDIDReactivated) GetReactivator() string {
+	if m != nil {
+		return m.Reactivator
+	}
+	return ""
+}
+
+func (m *EventDIDReactivated) GetReactivatedAt() time.Time {
+	if m != nil {
+		return m.ReactivatedAt
+	}
+	return time.Time{}
+}
+
+func (m *EventDIDReactivated) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // EventVerificationMethodAdded is emitted when a verification method is added
 type EventVerificationMethodAdded struct {
 	// DID identifier
@@ -291,7 +364,7 @@ func (m *EventVerificationMethodAdded) Reset()         { *m = EventVerificationM
 func (m *EventVerificationMethodAdded) String() string { return proto.CompactTextString(m) }
 func (*EventVerificationMethodAdded) ProtoMessage()    {}
 func (*EventVerificationMethodAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{3}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{4}
 }
 func (m *EventVerificationMethodAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +442,7 @@ func (m *EventVerificationMethodRemoved) Reset()         { *m = EventVerificatio
 func (m *EventVerificationMethodRemoved) String() string { return proto.CompactTextString(m) }
 func (*EventVerificationMethodRemoved) ProtoMessage()    {}
 func (*EventVerificationMethodRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{4}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{5}
 }
 func (m *EventVerificationMethodRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +510,7 @@ func (m *EventServiceAdded) Reset()         { *m = EventServiceAdded{} }
 func (m *EventServiceAdded) String() string { return proto.CompactTextString(m) }
 func (*EventServiceAdded) ProtoMessage()    {}
 func (*EventServiceAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{5}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{6}
 }
 func (m *EventServiceAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +588,7 @@ func (m *EventServiceRemoved) Reset()         { *m = EventServiceRemoved{} }
 func (m *EventServiceRemoved) String() string { return proto.CompactTextString(m) }
 func (*EventServiceRemoved) ProtoMessage()    {}
 func (*EventServiceRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{6}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{7}
 }
 func (m *EventServiceRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +658,7 @@ func (m *EventCredentialIssued) Reset()         { *m = EventCredentialIssued{} }
 func (m *EventCredentialIssued) String() string { return proto.CompactTextString(m) }
 func (*EventCredentialIssued) ProtoMessage()    {}
 func (*EventCredentialIssued) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{7}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{8}
 }
 func (m *EventCredentialIssued) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +747,7 @@ func (m *EventCredentialRevoked) Reset()         { *m = EventCredentialRevoked{}
 func (m *EventCredentialRevoked) String() string { return proto.CompactTextString(m) }
 func (*EventCredentialRevoked) ProtoMessage()    {}
 func (*EventCredentialRevoked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{8}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{9}
 }
 func (m *EventCredentialRevoked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +827,7 @@ func (m *EventWebAuthnRegistered) Reset()         { *m = EventWebAuthnRegistered
 func (m *EventWebAuthnRegistered) String() string { return proto.CompactTextString(m) }
 func (*EventWebAuthnRegistered) ProtoMessage()    {}
 func (*EventWebAuthnRegistered) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{9}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{10}
 }
 func (m *EventWebAuthnRegistered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,7 +900,7 @@ func (m *EventExternalWalletLinked) Reset()         { *m = EventExternalWalletLi
 func (m *EventExternalWalletLinked) String() string { return proto.CompactTextString(m) }
 func (*EventExternalWalletLinked) ProtoMessage()    {}
 func (*EventExternalWalletLinked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5c44ba2e5af5f7, []int{10}
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{11}
 }
 func (m *EventExternalWalletLinked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,407 +957,613 @@ func (m *EventExternalWalletLinked) GetBlockHeight() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventDIDCreated)(nil), "did.v1.EventDIDCreated")
-	proto.RegisterType((*EventDIDUpdated)(nil), "did.v1.EventDIDUpdated")
-	proto.RegisterType((*EventDIDDeactivated)(nil), "did.v1.EventDIDDeactivated")
-	proto.RegisterType((*EventVerificationMethodAdded)(nil), "did.v1.EventVerificationMethodAdded")
-	proto.RegisterType((*EventVerificationMethodRemoved)(nil), "did.v1.EventVerificationMethodRemoved")
-	proto.RegisterType((*EventServiceAdded)(nil), "did.v1.EventServiceAdded")
-	proto.RegisterType((*EventServiceRemoved)(nil), "did.v1.EventServiceRemoved")
-	proto.RegisterType((*EventCredentialIssued)(nil), "did.v1.EventCredentialIssued")
-	proto.RegisterType((*EventCredentialRevoked)(nil), "did.v1.EventCredentialRevoked")
-	proto.RegisterType((*EventWebAuthnRegistered)(nil), "did.v1.EventWebAuthnRegistered")
-	proto.RegisterType((*EventExternalWalletLinked)(nil), "did.v1.EventExternalWalletLinked")
+// EventCredentialOriginUnverified is emitted when a WebAuthn credential's
+// origin is found to be no longer verified
+type EventCredentialOriginUnverified struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Origin the credential was registered at
+	Origin string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Why the origin failed verification
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the credential is refused until its origin is verified again
+	Downgraded bool `protobuf:"varint,5,opt,name=downgraded,proto3" json:"downgraded,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("did/v1/events.proto", fileDescriptor_ce5c44ba2e5af5f7) }
+func (m *EventCredentialOriginUnverified) Reset()         { *m = EventCredentialOriginUnverified{} }
+func (m *EventCredentialOriginUnverified) String() string { return proto.CompactTextString(m) }
+func (*EventCredentialOriginUnverified) ProtoMessage()    {}
+func (*EventCredentialOriginUnverified) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{12}
+}
+func (m *EventCredentialOriginUnverified) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCredentialOriginUnverified) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCredentialOriginUnverified.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCredentialOriginUnverified) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCredentialOriginUnverified.Merge(m, src)
+}
+func (m *EventCredentialOriginUnverified) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCredentialOriginUnverified) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCredentialOriginUnverified.DiscardUnknown(m)
+}
 
-var fileDescriptor_ce5c44ba2e5af5f7 = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xc1, 0x92, 0x22, 0x45,
-	0x10, 0xa5, 0x16, 0x96, 0xa1, 0x93, 0x65, 0x76, 0xed, 0xd5, 0xb5, 0x17, 0x5d, 0xc0, 0xdd, 0xd8,
-	0x08, 0x3c, 0x48, 0xc7, 0xea, 0xd9, 0x03, 0xcb, 0x4c, 0x84, 0xc4, 0xe8, 0xa5, 0x1d, 0x9d, 0x08,
-	0x2f, 0x44, 0xd3, 0x95, 0x03, 0x25, 0x4d, 0x17, 0xd1, 0x55, 0xe0, 0xf0, 0x17, 0x73, 0xd3, 0x93,
-	0x1f, 0xe0, 0x1f, 0xf8, 0x07, 0x73, 0xf0, 0x30, 0x37, 0x3d, 0xa9, 0xc1, 0x5c, 0xfc, 0x0c, 0xa3,
-	0xab, 0xaa, 0x1b, 0x62, 0x80, 0xd8, 0xe6, 0x44, 0xe5, 0xab, 0xcc, 0xca, 0xf7, 0xb2, 0x33, 0x33,
-	0x80, 0xa7, 0x94, 0x51, 0x77, 0xf1, 0xc6, 0xc5, 0x05, 0x46, 0x52, 0x74, 0x66, 0x31, 0x97, 0xdc,
-	0x2e, 0x53, 0x46, 0x3b, 0x8b, 0x37, 0xf5, 0xf7, 0x47, 0x7c, 0xc4, 0x15, 0xe4, 0x26, 0x27, 0x7d,
-	0x5b, 0x6f, 0x8e, 0x38, 0x1f, 0x85, 0xe8, 0x2a, 0x6b, 0x38, 0xbf, 0x74, 0x25, 0x9b, 0xa2, 0x90,
-	0xfe, 0x74, 0xa6, 0x1d, 0x5e, 0xae, 0x08, 0x3c, 0x3e, 0x4d, 0xde, 0x3b, 0xe9, 0x9f, 0xf4, 0x62,
-	0xf4, 0x25, 0x52, 0xfb, 0x09, 0x14, 0x29, 0xa3, 0x0e, 0x69, 0x91, 0xb6, 0xe5, 0x25, 0x47, 0xdb,
-	0x81, 0xa3, 0x20, 0xb9, 0xe4, 0xb1, 0xf3, 0x40, 0xa1, 0xa9, 0x69, 0x37, 0xa1, 0x3a, 0x9b, 0x0f,
-	0x43, 0x16, 0x0c, 0x26, 0xb8, 0x14, 0x4e, 0xb1, 0x55, 0x6c, 0x5b, 0x1e, 0x68, 0xe8, 0x0c, 0x97,
-	0xc2, 0xae, 0x43, 0x45, 0x60, 0xbc, 0x60, 0x01, 0x0a, 0xa7, 0xa4, 0x6e, 0x33, 0xdb, 0xee, 0x01,
-	0x04, 0x3a, 0xe7, 0xc0, 0x97, 0xce, 0xc3, 0x16, 0x69, 0x57, 0x3f, 0xaf, 0x77, 0x34, 0xe5, 0x4e,
-	0x4a, 0xb9, 0x73, 0x9e, 0x52, 0x7e, 0x5b, 0xb9, 0xf9, 0xbb, 0x59, 0xb8, 0xfe, 0xa7, 0x49, 0x3c,
-	0xcb, 0xc4, 0x75, 0xa5, 0xfd, 0x09, 0x3c, 0x1a, 0x86, 0x3c, 0x98, 0x0c, 0xc6, 0xc8, 0x46, 0x63,
-	0xe9, 0x94, 0x5b, 0xa4, 0x5d, 0xf2, 0xaa, 0x0a, 0xfb, 0x4a, 0x41, 0x2f, 0xff, 0xd8, 0x10, 0xf9,
-	0xdd, 0x8c, 0xee, 0x17, 0x39, 0x57, 0x97, 0x99, 0x48, 0x63, 0xda, 0xaf, 0xe1, 0xf8, 0x92, 0x61,
-	0x48, 0xc5, 0x40, 0x23, 0xd4, 0xe8, 0xac, 0x69, 0x34, 0x7d, 0xb2, 0x07, 0x60, 0xee, 0x13, 0x39,
-	0xa5, 0x43, 0xe4, 0x98, 0xb8, 0x1d, 0x72, 0x1e, 0x6e, 0xcb, 0xf9, 0x9d, 0xc0, 0xd3, 0x54, 0xce,
-	0x09, 0xfa, 0x81, 0x64, 0x8b, 0x3d, 0x92, 0x5a, 0x50, 0xa5, 0xa9, 0x43, 0xf6, 0xed, 0x36, 0x21,
-	0xfb, 0x0c, 0x8e, 0xe9, 0xfa, 0x89, 0x84, 0x77, 0xf1, 0x00, 0xde, 0xb5, 0x8d, 0xd8, 0x1d, 0xdc,
-	0x4b, 0xdb, 0xdc, 0x7f, 0x23, 0xf0, 0xb1, 0xe2, 0xfe, 0x3d, 0xc6, 0xec, 0x92, 0x05, 0xbe, 0x64,
-	0x3c, 0xfa, 0x06, 0xe5, 0x98, 0xd3, 0x2e, 0xa5, 0x3b, 0x45, 0x7c, 0x04, 0xd6, 0x54, 0x39, 0x0c,
-	0x18, 0x35, 0x12, 0x2a, 0x1a, 0xe8, 0x53, 0xfb, 0x39, 0x54, 0x26, 0xb8, 0x1c, 0xc8, 0xe5, 0x0c,
-	0x15, 0x73, 0xcb, 0x3b, 0x9a, 0xe0, 0xf2, 0x7c, 0x39, 0x43, 0xfb, 0x05, 0xc0, 0xba, 0x35, 0x15,
-	0x17, 0xcb, 0xb3, 0xb2, 0xce, 0xcc, 0x53, 0xe8, 0x18, 0x1a, 0x7b, 0xb8, 0x7a, 0x38, 0xe5, 0x8b,
-	0xc3, 0xd9, 0xde, 0xcf, 0x59, 0xdc, 0xce, 0xf9, 0x33, 0x81, 0xf7, 0x54, 0xd2, 0x6f, 0xf5, 0x94,
-	0xec, 0xab, 0xca, 0x0b, 0x00, 0x33, 0x47, 0xeb, 0x44, 0x96, 0x41, 0xfa, 0xd4, 0xb6, 0xa1, 0xb4,
-	0x51, 0x13, 0x75, 0x4e, 0x46, 0x11, 0x23, 0x3a, 0xe3, 0x2c, 0x92, 0xa6, 0x1c, 0x99, 0x9d, 0xa7,
-	0x1a, 0xcc, 0x74, 0x9d, 0x21, 0xb6, 0xbf, 0x04, 0xef, 0xa0, 0x96, 0xa3, 0x08, 0xff, 0x11, 0xf8,
-	0x40, 0xe5, 0xea, 0xc5, 0x48, 0x31, 0x92, 0xcc, 0x0f, 0xfb, 0x42, 0xcc, 0x91, 0xda, 0xaf, 0xa0,
-	0x16, 0x64, 0xd8, 0x20, 0xcb, 0xfb, 0x68, 0x0d, 0xf6, 0xa9, 0xfd, 0x0c, 0xca, 0x2c, 0x71, 0x4f,
-	0x3b, 0xde, 0x58, 0xc9, 0x84, 0x8b, 0xf9, 0xf0, 0x47, 0x0c, 0x64, 0xda, 0x2b, 0xc6, 0xcc, 0xca,
-	0x55, 0xda, 0x28, 0x57, 0x17, 0x2c, 0x15, 0x77, 0xf0, 0x72, 0xaa, 0xe8, 0xb0, 0x7c, 0xbb, 0xe9,
-	0x4f, 0x02, 0xcf, 0xee, 0x49, 0xf5, 0x70, 0xc1, 0x27, 0x79, 0xb5, 0x3a, 0x70, 0x14, 0x2b, 0xff,
-	0x6c, 0x6b, 0x19, 0x33, 0xa9, 0x42, 0x8c, 0xbe, 0xe0, 0x91, 0x11, 0x6b, 0xac, 0x64, 0x4d, 0x69,
-	0x97, 0xc3, 0xd7, 0x94, 0x89, 0xcb, 0xb7, 0xa6, 0x7e, 0x25, 0xf0, 0xa1, 0x52, 0x76, 0x81, 0xc3,
-	0xee, 0x5c, 0x8e, 0x23, 0x0f, 0x47, 0x4c, 0x48, 0x8c, 0x77, 0x36, 0xcd, 0x96, 0xd8, 0x07, 0x3b,
-	0xc4, 0x7e, 0x0a, 0x4f, 0x7c, 0x29, 0x13, 0x5e, 0xc9, 0x28, 0x6e, 0x4e, 0xfd, 0xe3, 0x0d, 0x5c,
-	0x4d, 0x7f, 0x8e, 0x5d, 0xf4, 0x0b, 0x81, 0xe7, 0x8a, 0xe0, 0xe9, 0x95, 0xc4, 0x38, 0xf2, 0xc3,
-	0x0b, 0x3f, 0x0c, 0x51, 0x7e, 0xcd, 0xa2, 0xc9, 0x4e, 0x8a, 0x4d, 0xa8, 0xfe, 0xa4, 0x3c, 0x74,
-	0x62, 0x4d, 0x10, 0x34, 0xa4, 0x72, 0xbe, 0x86, 0x63, 0xe3, 0xe0, 0x53, 0x1a, 0xa3, 0x10, 0x86,
-	0x5c, 0x4d, 0xa3, 0x5d, 0x0d, 0xe6, 0xa0, 0xf6, 0xf6, 0xcb, 0x9b, 0x55, 0x83, 0xdc, 0xae, 0x1a,
-	0xe4, 0xdf, 0x55, 0x83, 0x5c, 0xdf, 0x35, 0x0a, 0xb7, 0x77, 0x8d, 0xc2, 0x5f, 0x77, 0x8d, 0xc2,
-	0x0f, 0xaf, 0x46, 0x4c, 0x8e, 0xe7, 0xc3, 0x4e, 0xc0, 0xa7, 0xae, 0xe0, 0x51, 0xfc, 0x19, 0xe3,
-	0xea, 0xd7, 0xbd, 0x72, 0x93, 0xbf, 0x07, 0x09, 0x33, 0x31, 0x2c, 0xab, 0xcf, 0xf8, 0xc5, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x34, 0x6e, 0xea, 0x9a, 0x32, 0x08, 0x00, 0x00,
+var xxx_messageInfo_EventCredentialOriginUnverified proto.InternalMessageInfo
+
+func (m *EventCredentialOriginUnverified) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
 }
 
-func (m *EventDIDCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCredentialOriginUnverified) GetCredentialId() string {
+	if m != nil {
+		return m.CredentialId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDIDCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCredentialOriginUnverified) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
 }
 
-func (m *EventDIDCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x30
+func (m *EventCredentialOriginUnverified) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err1 != nil {
-		return 0, err1
+	return ""
+}
+
+func (m *EventCredentialOriginUnverified) GetDowngraded() bool {
+	if m != nil {
+		return m.Downgraded
 	}
-	i -= n1
-	i = encodeVarintEvents(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Services[iNdEx])
-			copy(dAtA[i:], m.Services[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Services[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	return false
+}
+
+func (m *EventCredentialOriginUnverified) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	return 0
+}
+
+// EventCredentialOriginReverified is emitted when a flagged WebAuthn
+// credential's origin is verified again
+type EventCredentialOriginReverified struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Origin the credential was registered at
+	Origin string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventCredentialOriginReverified) Reset()         { *m = EventCredentialOriginReverified{} }
+func (m *EventCredentialOriginReverified) String() string { return proto.CompactTextString(m) }
+func (*EventCredentialOriginReverified) ProtoMessage()    {}
+func (*EventCredentialOriginReverified) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{13}
+}
+func (m *EventCredentialOriginReverified) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCredentialOriginReverified) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCredentialOriginReverified.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventCredentialOriginReverified) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCredentialOriginReverified.Merge(m, src)
+}
+func (m *EventCredentialOriginReverified) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCredentialOriginReverified) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCredentialOriginReverified.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCredentialOriginReverified proto.InternalMessageInfo
+
+func (m *EventCredentialOriginReverified) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventCredentialOriginReverified) GetCredentialId() string {
+	if m != nil {
+		return m.CredentialId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDIDUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCredentialOriginReverified) GetOrigin() string {
+	if m != nil {
+		return m.Origin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDIDUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCredentialOriginReverified) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *EventDIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
+// EventDelegatedControllerAdded is emitted when control of a DID is delegated
+type EventDelegatedControllerAdded struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Delegated controller address
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// Controller that added the delegation
+	GrantedBy string `protobuf:"bytes,3,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	// What the delegation is for
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// When the delegation ends
+	ExpiresAt time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventDelegatedControllerAdded) Reset()         { *m = EventDelegatedControllerAdded{} }
+func (m *EventDelegatedControllerAdded) String() string { return proto.CompactTextString(m) }
+func (*EventDelegatedControllerAdded) ProtoMessage()    {}
+func (*EventDelegatedControllerAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{14}
+}
+func (m *EventDelegatedControllerAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegatedControllerAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegatedControllerAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintEvents(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.FieldsUpdated) > 0 {
-		for iNdEx := len(m.FieldsUpdated) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FieldsUpdated[iNdEx])
-			copy(dAtA[i:], m.FieldsUpdated[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.FieldsUpdated[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Updater) > 0 {
-		i -= len(m.Updater)
-		copy(dAtA[i:], m.Updater)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Updater)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventDelegatedControllerAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegatedControllerAdded.Merge(m, src)
+}
+func (m *EventDelegatedControllerAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegatedControllerAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegatedControllerAdded.DiscardUnknown(m)
 }
 
-func (m *EventDIDDeactivated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventDelegatedControllerAdded proto.InternalMessageInfo
+
+func (m *EventDelegatedControllerAdded) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDIDDeactivated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDelegatedControllerAdded) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
 }
 
-func (m *EventDIDDeactivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeactivatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeactivatedAt):])
-	if err3 != nil {
-		return 0, err3
+func (m *EventDelegatedControllerAdded) GetGrantedBy() string {
+	if m != nil {
+		return m.GrantedBy
 	}
-	i -= n3
-	i = encodeVarintEvents(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Deactivator) > 0 {
-		i -= len(m.Deactivator)
-		copy(dAtA[i:], m.Deactivator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deactivator)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventDelegatedControllerAdded) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDelegatedControllerAdded) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *EventVerificationMethodAdded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegatedControllerAdded) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventVerificationMethodAdded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDelegatedControllerRemoved is emitted when a delegation ends, either
+// removed by a controller or the delegate, or expired
+type EventDelegatedControllerRemoved struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Delegated controller address
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// Address that removed the delegation, empty when it expired
+	RemovedBy string `protobuf:"bytes,3,opt,name=removed_by,json=removedBy,proto3" json:"removed_by,omitempty"`
+	// Whether the delegation expired
+	Expired bool `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *EventVerificationMethodAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
+func (m *EventDelegatedControllerRemoved) Reset()         { *m = EventDelegatedControllerRemoved{} }
+func (m *EventDelegatedControllerRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventDelegatedControllerRemoved) ProtoMessage()    {}
+func (*EventDelegatedControllerRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{15}
+}
+func (m *EventDelegatedControllerRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegatedControllerRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegatedControllerRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventDelegatedControllerRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegatedControllerRemoved.Merge(m, src)
+}
+func (m *EventDelegatedControllerRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegatedControllerRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegatedControllerRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegatedControllerRemoved proto.InternalMessageInfo
+
+func (m *EventDelegatedControllerRemoved) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if len(m.KeyType) > 0 {
-		i -= len(m.KeyType)
-		copy(dAtA[i:], m.KeyType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeyType)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventDelegatedControllerRemoved) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	if len(m.MethodId) > 0 {
-		i -= len(m.MethodId)
-		copy(dAtA[i:], m.MethodId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MethodId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventDelegatedControllerRemoved) GetRemovedBy() string {
+	if m != nil {
+		return m.RemovedBy
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDelegatedControllerRemoved) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventVerificationMethodRemoved) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegatedControllerRemoved) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventVerificationMethodRemoved) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventNFTAttached is emitted when a token is attached to a DID's profile
+type EventNFTAttached struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Token class
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// Token ID
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// Controller that owns the token
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Whether the token was received over ICS-721
+	Interchain bool `protobuf:"varint,5,opt,name=interchain,proto3" json:"interchain,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *EventVerificationMethodRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+func (m *EventNFTAttached) Reset()         { *m = EventNFTAttached{} }
+func (m *EventNFTAttached) String() string { return proto.CompactTextString(m) }
+func (*EventNFTAttached) ProtoMessage()    {}
+func (*EventNFTAttached) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{16}
+}
+func (m *EventNFTAttached) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNFTAttached) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNFTAttached.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MethodId) > 0 {
-		i -= len(m.MethodId)
-		copy(dAtA[i:], m.MethodId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MethodId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventNFTAttached) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNFTAttached.Merge(m, src)
+}
+func (m *EventNFTAttached) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNFTAttached) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNFTAttached.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNFTAttached proto.InternalMessageInfo
+
+func (m *EventNFTAttached) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNFTAttached) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventServiceAdded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNFTAttached) GetNftId() string {
+	if m != nil {
+		return m.NftId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventServiceAdded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNFTAttached) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *EventServiceAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
+func (m *EventNFTAttached) GetInterchain() bool {
+	if m != nil {
+		return m.Interchain
 	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x22
+	return false
+}
+
+func (m *EventNFTAttached) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// EventNFTDetached is emitted when a token is removed from a DID's profile
+type EventNFTDetached struct {
+	// DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Token class
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// Token ID
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// Address that removed the token
+	RemovedBy string `protobuf:"bytes,4,opt,name=removed_by,json=removedBy,proto3" json:"removed_by,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventNFTDetached) Reset()         { *m = EventNFTDetached{} }
+func (m *EventNFTDetached) String() string { return proto.CompactTextString(m) }
+func (*EventNFTDetached) ProtoMessage()    {}
+func (*EventNFTDetached) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5c44ba2e5af5f7, []int{17}
+}
+func (m *EventNFTDetached) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNFTDetached) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNFTDetached.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventNFTDetached) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNFTDetached.Merge(m, src)
+}
+func (m *EventNFTDetached) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNFTDetached) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNFTDetached.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNFTDetached proto.InternalMessageInfo
+
+func (m *EventNFTDetached) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNFTDetached) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventServiceRemoved) Marshal() (dAtA []byte, err error) {
+func (m *EventNFTDetached) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+func (m *EventNFTDetached) GetRemovedBy() string {
+	if m != nil {
+		return m.RemovedBy
+	}
+	return ""
+}
+
+func (m *EventNFTDetached) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventDIDCreated)(nil), "did.v1.EventDIDCreated")
+	proto.RegisterType((*EventDIDUpdated)(nil), "did.v1.EventDIDUpdated")
+	proto.RegisterType((*EventDIDDeactivated)(nil), "did.v1.EventDIDDeactivated")
+	proto.RegisterType((*EventDIDReactivated)(nil), "did.v1.EventDIDReactivated")
+	proto.RegisterType((*EventVerificationMethodAdded)(nil), "did.v1.EventVerificationMethodAdded")
+	proto.RegisterType((*EventVerificationMethodRemoved)(nil), "did.v1.EventVerificationMethodRemoved")
+	proto.RegisterType((*EventServiceAdded)(nil), "did.v1.EventServiceAdded")
+	proto.RegisterType((*EventServiceRemoved)(nil), "did.v1.EventServiceRemoved")
+	proto.RegisterType((*EventCredentialIssued)(nil), "did.v1.EventCredentialIssued")
+	proto.RegisterType((*EventCredentialRevoked)(nil), "did.v1.EventCredentialRevoked")
+	proto.RegisterType((*EventWebAuthnRegistered)(nil), "did.v1.EventWebAuthnRegistered")
+	proto.RegisterType((*EventExternalWalletLinked)(nil), "did.v1.EventExternalWalletLinked")
+	proto.RegisterType((*EventCredentialOriginUnverified)(nil), "did.v1.EventCredentialOriginUnverified")
+	proto.RegisterType((*EventCredentialOriginReverified)(nil), "did.v1.EventCredentialOriginReverified")
+	proto.RegisterType((*EventDelegatedControllerAdded)(nil), "did.v1.EventDelegatedControllerAdded")
+	proto.RegisterType((*EventDelegatedControllerRemoved)(nil), "did.v1.EventDelegatedControllerRemoved")
+	proto.RegisterType((*EventNFTAttached)(nil), "did.v1.EventNFTAttached")
+	proto.RegisterType((*EventNFTDetached)(nil), "did.v1.EventNFTDetached")
+}
+
+func init() { proto.RegisterFile("did/v1/events.proto", fileDescriptor_ce5c44ba2e5af5f7) }
+
+var fileDescriptor_ce5c44ba2e5af5f7 = []byte{
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x6c, 0x1c, 0xc7, 0xae, 0x6c, 0xb2, 0x61, 0xb2, 0x1b, 0x9c, 0x40, 0xec, 0xb0, 0xab,
+	0x95, 0xc2, 0x01, 0x5b, 0x0b, 0x67, 0x0e, 0x4e, 0xb2, 0x08, 0x6b, 0xf9, 0x91, 0x86, 0x2c, 0x2b,
+	0x71, 0xb1, 0xc6, 0xd3, 0x95, 0x71, 0xe3, 0x49, 0xb7, 0xd5, 0xdd, 0x76, 0xe2, 0xb7, 0x58, 0x89,
+	0x03, 0x7b, 0xe2, 0x01, 0xe0, 0xc0, 0x99, 0x37, 0x58, 0x09, 0x0e, 0x7b, 0x83, 0x13, 0xa0, 0xe4,
+	0xc2, 0x89, 0x67, 0x40, 0xfd, 0x33, 0xf6, 0x24, 0xb1, 0xc9, 0x98, 0x9f, 0x53, 0xa6, 0xbe, 0xae,
+	0x9e, 0xfa, 0xbe, 0xea, 0xea, 0x6f, 0x62, 0xd8, 0x20, 0x94, 0x34, 0x86, 0x8f, 0x1a, 0x38, 0x44,
+	0xa6, 0x64, 0xbd, 0x2f, 0xb8, 0xe2, 0x7e, 0x91, 0x50, 0x52, 0x1f, 0x3e, 0xda, 0xbe, 0x1b, 0xf3,
+	0x98, 0x1b, 0xa8, 0xa1, 0x9f, 0xec, 0xea, 0x76, 0x2d, 0xe6, 0x3c, 0x4e, 0xb0, 0x61, 0xa2, 0xce,
+	0xe0, 0xb8, 0xa1, 0xe8, 0x09, 0x4a, 0x15, 0x9e, 0xf4, 0x6d, 0xc2, 0xfd, 0x73, 0x0f, 0xee, 0x3c,
+	0xd6, 0xef, 0x3b, 0x6c, 0x1d, 0x1e, 0x08, 0x0c, 0x15, 0x12, 0x7f, 0x1d, 0x16, 0x09, 0x25, 0x15,
+	0x6f, 0xd7, 0xdb, 0x2b, 0x07, 0xfa, 0xd1, 0xaf, 0xc0, 0x72, 0xa4, 0x17, 0xb9, 0xa8, 0xdc, 0x32,
+	0x68, 0x1a, 0xfa, 0x35, 0x58, 0xe9, 0x0f, 0x3a, 0x09, 0x8d, 0xda, 0x3d, 0x1c, 0xc9, 0xca, 0xe2,
+	0xee, 0xe2, 0x5e, 0x39, 0x00, 0x0b, 0x3d, 0xc1, 0x91, 0xf4, 0xb7, 0xa1, 0x24, 0x51, 0x0c, 0x69,
+	0x84, 0xb2, 0x52, 0x30, 0xab, 0xe3, 0xd8, 0x3f, 0x00, 0x88, 0x6c, 0xcd, 0x76, 0xa8, 0x2a, 0x4b,
+	0xbb, 0xde, 0xde, 0xca, 0xbb, 0xdb, 0x75, 0x4b, 0xb9, 0x9e, 0x52, 0xae, 0x1f, 0xa5, 0x94, 0xf7,
+	0x4b, 0x2f, 0x7f, 0xad, 0x2d, 0x3c, 0xff, 0xad, 0xe6, 0x05, 0x65, 0xb7, 0xaf, 0xa9, 0xfc, 0xb7,
+	0xe0, 0x76, 0x27, 0xe1, 0x51, 0xaf, 0xdd, 0x45, 0x1a, 0x77, 0x55, 0xa5, 0xb8, 0xeb, 0xed, 0x15,
+	0x82, 0x15, 0x83, 0x7d, 0x68, 0xa0, 0xfb, 0x3f, 0x65, 0x44, 0x3e, 0xed, 0x93, 0xd9, 0x22, 0x07,
+	0x66, 0x71, 0x2c, 0xd2, 0x85, 0xfe, 0x43, 0x58, 0x3b, 0xa6, 0x98, 0x10, 0xd9, 0xb6, 0x08, 0x71,
+	0x3a, 0x57, 0x2d, 0x9a, 0xbe, 0xf2, 0x00, 0xc0, 0xad, 0x6b, 0x39, 0x85, 0x79, 0xe4, 0xb8, 0x7d,
+	0x53, 0xe4, 0x2c, 0x5d, 0x97, 0xf3, 0x83, 0x07, 0x1b, 0xa9, 0x9c, 0x43, 0x0c, 0x23, 0x45, 0x87,
+	0x33, 0x24, 0xed, 0xc2, 0x0a, 0x49, 0x13, 0xc6, 0x67, 0x97, 0x85, 0xfc, 0x27, 0xb0, 0x46, 0x26,
+	0xaf, 0xd0, 0xbc, 0x17, 0xe7, 0xe0, 0xbd, 0x9a, 0xd9, 0x3b, 0x85, 0x7b, 0xe1, 0xef, 0xb9, 0x07,
+	0x37, 0x71, 0x17, 0xd7, 0xb9, 0x8b, 0xcb, 0xdc, 0xc5, 0xbf, 0xe0, 0x2e, 0xe6, 0xe5, 0xfe, 0xad,
+	0x07, 0x6f, 0x1a, 0xee, 0x9f, 0xa3, 0xa0, 0xc7, 0x34, 0x0a, 0x15, 0xe5, 0xec, 0x63, 0x54, 0x5d,
+	0x4e, 0x9a, 0x84, 0x4c, 0x15, 0xf1, 0x06, 0x94, 0x4f, 0x4c, 0x42, 0x9b, 0x12, 0x27, 0xa1, 0x64,
+	0x81, 0x16, 0xf1, 0xb7, 0xa0, 0xd4, 0xc3, 0x51, 0x5b, 0x8d, 0xfa, 0x68, 0x98, 0x97, 0x83, 0xe5,
+	0x1e, 0x8e, 0x8e, 0x46, 0x7d, 0xf4, 0x77, 0x00, 0x26, 0xd7, 0xca, 0x70, 0x29, 0x07, 0xe5, 0xf1,
+	0xad, 0xca, 0x33, 0x24, 0x02, 0xaa, 0x33, 0xb8, 0x06, 0x78, 0xc2, 0x87, 0xf3, 0xb3, 0xbd, 0x5a,
+	0x73, 0xf1, 0x7a, 0xcd, 0xaf, 0x3d, 0x78, 0xcd, 0x14, 0xfd, 0xcc, 0xde, 0xf0, 0x59, 0x5d, 0xd9,
+	0x01, 0x70, 0x1e, 0x30, 0x29, 0x54, 0x76, 0x48, 0x8b, 0xf8, 0x3e, 0x14, 0x32, 0x3d, 0x31, 0xcf,
+	0xda, 0x46, 0x90, 0x91, 0x3e, 0xa7, 0x4c, 0xb9, 0x76, 0x8c, 0xe3, 0x3c, 0xdd, 0xa0, 0xb0, 0x91,
+	0x25, 0x36, 0xbb, 0x05, 0x37, 0x50, 0xcb, 0xd1, 0x84, 0x3f, 0x3c, 0xb8, 0x67, 0x6a, 0x1d, 0x08,
+	0x24, 0xc8, 0x14, 0x0d, 0x93, 0x96, 0x94, 0x03, 0x24, 0xfe, 0x03, 0x58, 0x8d, 0xc6, 0x58, 0x7b,
+	0x5c, 0xf7, 0xf6, 0x04, 0x6c, 0x11, 0x7f, 0x13, 0x8a, 0x54, 0xa7, 0xa7, 0x13, 0xef, 0x22, 0xed,
+	0x4e, 0x72, 0xd0, 0xf9, 0x12, 0x23, 0x95, 0xce, 0x8a, 0x0b, 0xc7, 0xed, 0x2a, 0x64, 0xda, 0xd5,
+	0x84, 0xb2, 0xd9, 0x37, 0xb7, 0xb1, 0x96, 0xec, 0xb6, 0x7c, 0xbe, 0xfa, 0xb3, 0x07, 0x9b, 0x57,
+	0xa4, 0x06, 0x38, 0xe4, 0xbd, 0xbc, 0x5a, 0x2b, 0xb0, 0x2c, 0x4c, 0xfe, 0xd8, 0x71, 0x5d, 0xa8,
+	0xbb, 0x20, 0x30, 0x94, 0x9c, 0x39, 0xb1, 0x2e, 0xd2, 0x16, 0x6b, 0x53, 0xe6, 0xb7, 0x58, 0xb7,
+	0x2f, 0x9f, 0xc5, 0x7e, 0xe3, 0xc1, 0xeb, 0x46, 0xd9, 0x33, 0xec, 0x34, 0x07, 0xaa, 0xcb, 0x02,
+	0x8c, 0xa9, 0x54, 0x28, 0xa6, 0x0e, 0xcd, 0x35, 0xb1, 0xb7, 0xa6, 0x88, 0x7d, 0x1b, 0xd6, 0x43,
+	0xa5, 0x34, 0x2f, 0x7d, 0x15, 0xb3, 0xb7, 0xfe, 0x4e, 0x06, 0x37, 0xb7, 0x3f, 0x87, 0x17, 0xbd,
+	0xf0, 0x60, 0xcb, 0x10, 0x7c, 0x7c, 0xa6, 0x50, 0xb0, 0x30, 0x79, 0x16, 0x26, 0x09, 0xaa, 0x8f,
+	0x28, 0xeb, 0x4d, 0xa5, 0x58, 0x83, 0x95, 0x53, 0x93, 0x61, 0x0b, 0x5b, 0x82, 0x60, 0x21, 0x53,
+	0xf3, 0x21, 0xac, 0xb9, 0x84, 0x90, 0x10, 0x81, 0x52, 0x3a, 0x72, 0xab, 0x16, 0x6d, 0x5a, 0x30,
+	0x0f, 0xb5, 0x1f, 0x3d, 0xa8, 0x5d, 0x99, 0x8a, 0x4f, 0x05, 0x8d, 0x29, 0x7b, 0xca, 0x86, 0xc6,
+	0x8c, 0xfe, 0x79, 0x0f, 0x37, 0xa1, 0xc8, 0xcd, 0xab, 0xd2, 0xb1, 0xb0, 0x51, 0x66, 0x5c, 0x0a,
+	0x97, 0xc6, 0xa5, 0x0a, 0x40, 0xf8, 0x29, 0x8b, 0x45, 0x48, 0x90, 0x98, 0x73, 0x2e, 0x05, 0x19,
+	0x24, 0xcf, 0x8c, 0x7f, 0x35, 0x4b, 0x4d, 0x80, 0xff, 0x97, 0x9a, 0x1c, 0x3d, 0xfe, 0xd3, 0x83,
+	0x1d, 0xfb, 0x19, 0xc5, 0x04, 0x63, 0xfd, 0x09, 0x3b, 0xe0, 0x4c, 0x09, 0x9e, 0x24, 0x28, 0x66,
+	0xb9, 0xee, 0x36, 0x94, 0x88, 0xcb, 0x4e, 0xcd, 0x3d, 0x8d, 0xb5, 0xed, 0xc5, 0x22, 0x64, 0xfa,
+	0x33, 0xda, 0x19, 0x39, 0x3a, 0x65, 0x87, 0xec, 0x8f, 0xf4, 0x45, 0xed, 0x0f, 0x44, 0x9f, 0xcb,
+	0xd4, 0x65, 0xd2, 0x50, 0x5f, 0x48, 0x3c, 0xeb, 0x53, 0x81, 0x72, 0xee, 0x7f, 0xe1, 0xdc, 0xbe,
+	0x7c, 0x56, 0xf3, 0x5d, 0x7a, 0x0c, 0x53, 0x04, 0xcf, 0x76, 0xf3, 0x1b, 0x24, 0x0b, 0xbb, 0x31,
+	0x23, 0xd9, 0x21, 0x56, 0xb2, 0x25, 0x48, 0x8c, 0xe4, 0x52, 0x90, 0x86, 0x79, 0xec, 0xe3, 0x7b,
+	0x0f, 0xd6, 0x0d, 0xdb, 0x4f, 0x3e, 0x38, 0x6a, 0x2a, 0x15, 0x46, 0xdd, 0xa9, 0xf4, 0xb6, 0xa0,
+	0x14, 0x25, 0xa1, 0x94, 0x93, 0x01, 0x59, 0x36, 0x71, 0x8b, 0xf8, 0xf7, 0xa0, 0xc8, 0x8e, 0x95,
+	0x5e, 0xb0, 0xcc, 0x96, 0xd8, 0xb1, 0x6a, 0x11, 0xff, 0x2e, 0x2c, 0xf1, 0x53, 0x86, 0xc2, 0x1d,
+	0x83, 0x0d, 0xf4, 0x98, 0x53, 0xa6, 0x50, 0x44, 0xdd, 0x90, 0xb2, 0x74, 0xcc, 0x27, 0x48, 0x9e,
+	0xfe, 0xbe, 0xc8, 0x30, 0x3e, 0xc4, 0xff, 0x90, 0xf1, 0xe5, 0x36, 0x17, 0xae, 0xb6, 0xf9, 0xe6,
+	0x66, 0xee, 0xbf, 0xff, 0xf2, 0xbc, 0xea, 0xbd, 0x3a, 0xaf, 0x7a, 0xbf, 0x9f, 0x57, 0xbd, 0xe7,
+	0x17, 0xd5, 0x85, 0x57, 0x17, 0xd5, 0x85, 0x5f, 0x2e, 0xaa, 0x0b, 0x5f, 0x3c, 0x88, 0xa9, 0xea,
+	0x0e, 0x3a, 0xf5, 0x88, 0x9f, 0x34, 0x24, 0x67, 0xe2, 0x1d, 0xca, 0xcd, 0xdf, 0xc6, 0x59, 0x43,
+	0xff, 0x54, 0xd2, 0x4e, 0x27, 0x3b, 0x45, 0x33, 0x85, 0xef, 0xfd, 0x35, 0x00, 0xf0, 0xc4, 0x70,
+	0x05, 0x3e, 0x0d, 0x00, 0x00,
+}
+
+func (m *EventDIDCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1294,12 +1573,12 @@ func (m *EventServiceRemoved) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventServiceRemoved) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDIDCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventServiceRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDIDCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1307,12 +1586,38 @@ func (m *EventServiceRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x30
 	}
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Services[iNdEx])
+			copy(dAtA[i:], m.Services[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Services[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1326,7 +1631,7 @@ func (m *EventServiceRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCredentialIssued) Marshal() (dAtA []byte, err error) {
+func (m *EventDIDUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1336,12 +1641,12 @@ func (m *EventCredentialIssued) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCredentialIssued) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDIDUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCredentialIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1349,48 +1654,43 @@ func (m *EventCredentialIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x28
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.IssuedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IssuedAt):])
-	if err4 != nil {
-		return 0, err4
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n4
-	i = encodeVarintEvents(dAtA, i, uint64(n4))
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x2a
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if len(m.FieldsUpdated) > 0 {
+		for iNdEx := len(m.FieldsUpdated) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FieldsUpdated[iNdEx])
+			copy(dAtA[i:], m.FieldsUpdated[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.FieldsUpdated[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Issuer)))
+	if len(m.Updater) > 0 {
+		i -= len(m.Updater)
+		copy(dAtA[i:], m.Updater)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Updater)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.CredentialId) > 0 {
-		i -= len(m.CredentialId)
-		copy(dAtA[i:], m.CredentialId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCredentialRevoked) Marshal() (dAtA []byte, err error) {
+func (m *EventDIDDeactivated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1400,12 +1700,12 @@ func (m *EventCredentialRevoked) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCredentialRevoked) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDIDDeactivated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCredentialRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDIDDeactivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1413,41 +1713,34 @@ func (m *EventCredentialRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevokedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt):])
-	if err5 != nil {
-		return 0, err5
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeactivatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeactivatedAt):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n5
-	i = encodeVarintEvents(dAtA, i, uint64(n5))
+	i -= n3
+	i = encodeVarintEvents(dAtA, i, uint64(n3))
 	i--
-	dAtA[i] = 0x22
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Revoker) > 0 {
-		i -= len(m.Revoker)
-		copy(dAtA[i:], m.Revoker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Revoker)))
+	dAtA[i] = 0x1a
+	if len(m.Deactivator) > 0 {
+		i -= len(m.Deactivator)
+		copy(dAtA[i:], m.Deactivator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deactivator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.CredentialId) > 0 {
-		i -= len(m.CredentialId)
-		copy(dAtA[i:], m.CredentialId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWebAuthnRegistered) Marshal() (dAtA []byte, err error) {
+func (m *EventDIDReactivated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1457,12 +1750,12 @@ func (m *EventWebAuthnRegistered) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWebAuthnRegistered) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDIDReactivated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWebAuthnRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDIDReactivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1472,17 +1765,18 @@ func (m *EventWebAuthnRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.AttestationType) > 0 {
-		i -= len(m.AttestationType)
-		copy(dAtA[i:], m.AttestationType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.AttestationType)))
-		i--
-		dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReactivatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReactivatedAt):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if len(m.CredentialId) > 0 {
-		i -= len(m.CredentialId)
-		copy(dAtA[i:], m.CredentialId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+	i -= n4
+	i = encodeVarintEvents(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reactivator) > 0 {
+		i -= len(m.Reactivator)
+		copy(dAtA[i:], m.Reactivator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reactivator)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1496,7 +1790,7 @@ func (m *EventWebAuthnRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventExternalWalletLinked) Marshal() (dAtA []byte, err error) {
+func (m *EventVerificationMethodAdded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1506,12 +1800,12 @@ func (m *EventExternalWalletLinked) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventExternalWalletLinked) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventVerificationMethodAdded) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventExternalWalletLinked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventVerificationMethodAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1519,19 +1813,26 @@ func (m *EventExternalWalletLinked) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.WalletAddress)))
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeyType)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.WalletType) > 0 {
-		i -= len(m.WalletType)
-		copy(dAtA[i:], m.WalletType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.WalletType)))
+	if len(m.MethodId) > 0 {
+		i -= len(m.MethodId)
+		copy(dAtA[i:], m.MethodId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MethodId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1545,308 +1846,2310 @@ func (m *EventExternalWalletLinked) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventVerificationMethodRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventDIDCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventVerificationMethodRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVerificationMethodRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, s := range m.PublicKeys {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Services) > 0 {
-		for _, s := range m.Services {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.MethodId) > 0 {
+		i -= len(m.MethodId)
+		copy(dAtA[i:], m.MethodId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MethodId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovEvents(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDIDUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventServiceAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventServiceAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventServiceAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Updater)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.FieldsUpdated) > 0 {
-		for _, s := range m.FieldsUpdated {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovEvents(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDIDDeactivated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventServiceRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventServiceRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventServiceRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Deactivator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeactivatedAt)
-	n += 1 + l + sovEvents(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventVerificationMethodAdded) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCredentialIssued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCredentialIssued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCredentialIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.MethodId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.IssuedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IssuedAt):])
+	if err5 != nil {
+		return 0, err5
 	}
-	l = len(m.KeyType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i -= n5
+	i = encodeVarintEvents(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventVerificationMethodRemoved) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MethodId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+func (m *EventCredentialRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventServiceAdded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCredentialRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCredentialRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevokedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt):])
+	if err6 != nil {
+		return 0, err6
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i -= n6
+	i = encodeVarintEvents(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Revoker) > 0 {
+		i -= len(m.Revoker)
+		copy(dAtA[i:], m.Revoker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Revoker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventServiceRemoved) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+func (m *EventWebAuthnRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventCredentialIssued) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventWebAuthnRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWebAuthnRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CredentialId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IssuedAt)
-	n += 1 + l + sovEvents(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCredentialRevoked) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventExternalWalletLinked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExternalWalletLinked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExternalWalletLinked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CredentialId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Revoker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.WalletType) > 0 {
+		i -= len(m.WalletType)
+		copy(dAtA[i:], m.WalletType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WalletType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt)
-	n += 1 + l + sovEvents(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventWebAuthnRegistered) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCredentialOriginUnverified) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventCredentialOriginUnverified) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCredentialOriginUnverified) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.CredentialId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Downgraded {
+		i--
+		if m.Downgraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.AttestationType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCredentialOriginReverified) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCredentialOriginReverified) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCredentialOriginReverified) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegatedControllerAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegatedControllerAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegatedControllerAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintEvents(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GrantedBy) > 0 {
+		i -= len(m.GrantedBy)
+		copy(dAtA[i:], m.GrantedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GrantedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegatedControllerRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegatedControllerRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegatedControllerRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RemovedBy) > 0 {
+		i -= len(m.RemovedBy)
+		copy(dAtA[i:], m.RemovedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNFTAttached) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNFTAttached) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNFTAttached) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interchain {
+		i--
+		if m.Interchain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNFTDetached) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNFTDetached) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNFTDetached) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RemovedBy) > 0 {
+		i -= len(m.RemovedBy)
+		copy(dAtA[i:], m.RemovedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDIDCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, s := range m.PublicKeys {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventDIDUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FieldsUpdated) > 0 {
+		for _, s := range m.FieldsUpdated {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventDIDDeactivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Deactivator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeactivatedAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventDIDReactivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reactivator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReactivatedAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventVerificationMethodAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MethodId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventVerificationMethodRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MethodId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventServiceAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventServiceRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCredentialIssued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IssuedAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCredentialRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Revoker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventWebAuthnRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.AttestationType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventExternalWalletLinked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WalletType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCredentialOriginUnverified) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Downgraded {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCredentialOriginReverified) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventDelegatedControllerAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GrantedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventDelegatedControllerRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RemovedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventNFTAttached) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Interchain {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventNFTDetached) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RemovedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDIDCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDIDCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDIDUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldsUpdated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldsUpdated = append(m.FieldsUpdated, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDIDDeactivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDIDDeactivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deactivator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeactivatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDIDReactivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDIDReactivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDIDReactivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactivator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactivator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactivatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReactivatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVerificationMethodAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVerificationMethodAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventExternalWalletLinked) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.WalletType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *EventVerificationMethodRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVerificationMethodRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVerificationMethodRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
+func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1869,10 +4172,10 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDIDCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventServiceAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDIDCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventServiceAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1905,11 +4208,43 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1937,11 +4272,11 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1969,11 +4304,80 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, string(dAtA[iNdEx:postIndex]))
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventServiceRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventServiceRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventServiceRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2001,13 +4405,13 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2017,26 +4421,25 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2076,7 +4479,7 @@ func (m *EventDIDCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,15 +4502,15 @@ func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDIDUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCredentialIssued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCredentialIssued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2135,11 +4538,11 @@ func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2167,11 +4570,11 @@ func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updater = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldsUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2199,11 +4602,43 @@ func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FieldsUpdated = append(m.FieldsUpdated, string(dAtA[iNdEx:postIndex]))
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2230,11 +4665,11 @@ func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.IssuedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2274,7 +4709,7 @@ func (m *EventDIDUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
+func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2297,15 +4732,15 @@ func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDIDDeactivated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCredentialRevoked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDIDDeactivated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCredentialRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,11 +4768,11 @@ func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deactivator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2365,11 +4800,43 @@ func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deactivator = string(dAtA[iNdEx:postIndex])
+			m.Revoker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2396,11 +4863,11 @@ func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeactivatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevokedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2440,7 +4907,7 @@ func (m *EventDIDDeactivated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
+func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2463,10 +4930,10 @@ func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVerificationMethodAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWebAuthnRegistered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVerificationMethodAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWebAuthnRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2501,41 +4968,9 @@ func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
 			}
 			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MethodId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2563,11 +4998,11 @@ func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyType = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2595,9 +5030,9 @@ func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2637,7 +5072,7 @@ func (m *EventVerificationMethodAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventVerificationMethodRemoved) Unmarshal(dAtA []byte) error {
+func (m *EventExternalWalletLinked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2660,10 +5095,10 @@ func (m *EventVerificationMethodRemoved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVerificationMethodRemoved: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExternalWalletLinked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVerificationMethodRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExternalWalletLinked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2700,7 +5135,7 @@ func (m *EventVerificationMethodRemoved) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2728,9 +5163,41 @@ func (m *EventVerificationMethodRemoved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MethodId = string(dAtA[iNdEx:postIndex])
+			m.WalletType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2770,7 +5237,7 @@ func (m *EventVerificationMethodRemoved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
+func (m *EventCredentialOriginUnverified) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2793,10 +5260,10 @@ func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventServiceAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCredentialOriginUnverified: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventServiceAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCredentialOriginUnverified: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2833,7 +5300,7 @@ func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2861,11 +5328,11 @@ func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2893,11 +5360,11 @@ func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Origin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2925,9 +5392,29 @@ func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downgraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Downgraded = bool(v != 0)
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2967,7 +5454,7 @@ func (m *EventServiceAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventServiceRemoved) Unmarshal(dAtA []byte) error {
+func (m *EventCredentialOriginReverified) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2990,10 +5477,10 @@ func (m *EventServiceRemoved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventServiceRemoved: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCredentialOriginReverified: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventServiceRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCredentialOriginReverified: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3030,7 +5517,7 @@ func (m *EventServiceRemoved) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3058,9 +5545,41 @@ func (m *EventServiceRemoved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -3100,7 +5619,7 @@ func (m *EventServiceRemoved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
+func (m *EventDelegatedControllerAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3123,15 +5642,15 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCredentialIssued: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegatedControllerAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCredentialIssued: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegatedControllerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3159,11 +5678,11 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3191,11 +5710,11 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3223,11 +5742,11 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.GrantedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3255,11 +5774,11 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Purpose = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3286,7 +5805,7 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.IssuedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3330,7 +5849,7 @@ func (m *EventCredentialIssued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
+func (m *EventDelegatedControllerRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3353,15 +5872,15 @@ func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCredentialRevoked: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegatedControllerRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCredentialRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegatedControllerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3389,11 +5908,11 @@ func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3421,11 +5940,11 @@ func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revoker = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3453,13 +5972,13 @@ func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.RemovedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3469,25 +5988,12 @@ func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevokedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Expired = bool(v != 0)
 		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
@@ -3528,7 +6034,7 @@ func (m *EventCredentialRevoked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
+func (m *EventNFTAttached) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3551,10 +6057,10 @@ func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWebAuthnRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNFTAttached: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWebAuthnRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNFTAttached: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3591,7 +6097,7 @@ func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3619,11 +6125,11 @@ func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3651,9 +6157,61 @@ func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interchain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Interchain = bool(v != 0)
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -3693,7 +6251,7 @@ func (m *EventWebAuthnRegistered) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExternalWalletLinked) Unmarshal(dAtA []byte) error {
+func (m *EventNFTDetached) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3716,10 +6274,10 @@ func (m *EventExternalWalletLinked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExternalWalletLinked: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNFTDetached: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExternalWalletLinked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNFTDetached: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3756,7 +6314,7 @@ func (m *EventExternalWalletLinked) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3784,11 +6342,11 @@ func (m *EventExternalWalletLinked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletType = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3816,9 +6374,41 @@ func (m *EventExternalWalletLinked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
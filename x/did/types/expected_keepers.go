@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sonr-io/crypto/mpc"
 )
@@ -47,8 +48,40 @@ type VaultState struct {
 	UpdatedAt  int64  `json:"updated_at"`
 }
 
-// ServiceKeeper interface defines the methods needed from the Service keeper for origin validation
+// ServiceKeeper interface defines the methods needed from the Service keeper for origin
+// validation and attestation policies
 type ServiceKeeper interface {
 	// VerifyOrigin validates a relying party origin for WebAuthn operations
 	VerifyOrigin(ctx context.Context, origin string) error
+	// CheckAttestation applies the attestation policies of the services
+	// using rpID to a credential being registered
+	CheckAttestation(ctx context.Context, rpID string, attestation AttestationResult) error
+}
+
+// DIDHooks is implemented by modules that react to new and changed DIDs
+type DIDHooks interface {
+	// AfterDIDRegistered is called once a new DID is stored, with the invite
+	// code it was registered with, if any
+	AfterDIDRegistered(ctx context.Context, did, controller, referralCode string) error
+	// AfterDIDDocumentChanged is called whenever a DID document is written,
+	// including when it is registered or deactivated
+	AfterDIDDocumentChanged(ctx context.Context, did string) error
+}
+
+// NFTKeeper defines the x/nft methods needed to attach tokens to DID profiles
+type NFTKeeper interface {
+	GetOwner(ctx context.Context, classID string, nftID string) sdk.AccAddress
+	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
+	GetClass(ctx context.Context, classID string) (nft.Class, bool)
+}
+
+// NFTMetadataCache keeps the metadata of tokens attached to DID profiles in
+// each DID's DWN, so wallets can show them without querying x/nft
+type NFTMetadataCache interface {
+	// CacheNFTMetadata writes the asset's metadata to its DID's DWN and
+	// returns the record ID
+	CacheNFTMetadata(ctx context.Context, asset DIDAsset) (string, error)
+
+	// RemoveNFTMetadata deletes a record written by CacheNFTMetadata
+	RemoveNFTMetadata(ctx context.Context, did, recordID string) error
 }
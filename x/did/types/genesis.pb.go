@@ -157,6 +157,12 @@ type DocumentParams struct {
 	SupportedInvocationMethods []string `protobuf:"bytes,11,rep,name=supported_invocation_methods,json=supportedInvocationMethods,proto3" json:"supported_invocation_methods,omitempty"`
 	// Supported Delegation methods
 	SupportedDelegationMethods []string `protobuf:"bytes,12,rep,name=supported_delegation_methods,json=supportedDelegationMethods,proto3" json:"supported_delegation_methods,omitempty"`
+	// MaxDelegationDuration is the longest a delegated controller may be
+	// granted for, in seconds; zero leaves it unbounded
+	MaxDelegationDuration int64 `protobuf:"varint,13,opt,name=max_delegation_duration,json=maxDelegationDuration,proto3" json:"max_delegation_duration,omitempty"`
+	// MaxDelegatedControllers limits the number of active delegated
+	// controllers per DID; zero disables delegation
+	MaxDelegatedControllers int32 `protobuf:"varint,14,opt,name=max_delegated_controllers,json=maxDelegatedControllers,proto3" json:"max_delegated_controllers,omitempty"`
 }
 
 func (m *DocumentParams) Reset()         { *m = DocumentParams{} }
@@ -276,6 +282,20 @@ func (m *DocumentParams) GetSupportedDelegationMethods() []string {
 	return nil
 }
 
+func (m *DocumentParams) GetMaxDelegationDuration() int64 {
+	if m != nil {
+		return m.MaxDelegationDuration
+	}
+	return 0
+}
+
+func (m *DocumentParams) GetMaxDelegatedControllers() int32 {
+	if m != nil {
+		return m.MaxDelegatedControllers
+	}
+	return 0
+}
+
 // WebauthnParams defines the parameters for the WebAuthn module.
 type WebauthnParams struct {
 	// ChallengeTimeout is the default timeout in seconds
@@ -292,6 +312,14 @@ type WebauthnParams struct {
 	DefaultRpId string `protobuf:"bytes,6,opt,name=default_rp_id,json=defaultRpId,proto3" json:"default_rp_id,omitempty"`
 	// DefaultRPName is the default Relying Party name for WebAuthn operations
 	DefaultRpName string `protobuf:"bytes,7,opt,name=default_rp_name,json=defaultRpName,proto3" json:"default_rp_name,omitempty"`
+	// OriginCheckInterval is how many blocks apart the origins of stored
+	// WebAuthn credentials are re-checked; zero disables the check
+	OriginCheckInterval int64 `protobuf:"varint,8,opt,name=origin_check_interval,json=originCheckInterval,proto3" json:"origin_check_interval,omitempty"`
+	// OriginCheckBatchSize is the number of DID documents checked per run
+	OriginCheckBatchSize uint32 `protobuf:"varint,9,opt,name=origin_check_batch_size,json=originCheckBatchSize,proto3" json:"origin_check_batch_size,omitempty"`
+	// DowngradeUnverifiedOrigins refuses credentials whose origin is no longer
+	// verified until the origin is verified again
+	DowngradeUnverifiedOrigins bool `protobuf:"varint,10,opt,name=downgrade_unverified_origins,json=downgradeUnverifiedOrigins,proto3" json:"downgrade_unverified_origins,omitempty"`
 }
 
 func (m *WebauthnParams) Reset()         { *m = WebauthnParams{} }
@@ -376,6 +404,27 @@ func (m *WebauthnParams) GetDefaultRpName() string {
 	return ""
 }
 
+func (m *WebauthnParams) GetOriginCheckInterval() int64 {
+	if m != nil {
+		return m.OriginCheckInterval
+	}
+	return 0
+}
+
+func (m *WebauthnParams) GetOriginCheckBatchSize() uint32 {
+	if m != nil {
+		return m.OriginCheckBatchSize
+	}
+	return 0
+}
+
+func (m *WebauthnParams) GetDowngradeUnverifiedOrigins() bool {
+	if m != nil {
+		return m.DowngradeUnverifiedOrigins
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "did.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "did.v1.Params")
@@ -386,57 +435,63 @@ func init() {
 func init() { proto.RegisterFile("did/v1/genesis.proto", fileDescriptor_fda181cae44f7c00) }
 
 var fileDescriptor_fda181cae44f7c00 = []byte{
-	// 790 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x6e, 0x1b, 0x45,
-	0x18, 0xf7, 0x36, 0x89, 0x49, 0x26, 0xb5, 0x4d, 0xc6, 0x6e, 0xb2, 0x0d, 0xc8, 0xb1, 0x8c, 0xa0,
-	0x56, 0x01, 0xaf, 0x1c, 0x40, 0x42, 0x91, 0x40, 0xd0, 0x06, 0x41, 0x24, 0x0a, 0xd5, 0x06, 0x82,
-	0xc4, 0x65, 0x34, 0xd9, 0xf9, 0xb2, 0x1e, 0x75, 0x77, 0x67, 0x99, 0x99, 0x75, 0x9d, 0xbe, 0x01,
-	0x9c, 0x38, 0x72, 0xec, 0x23, 0x70, 0xe1, 0x1d, 0x7a, 0xec, 0x91, 0x53, 0x85, 0x92, 0x03, 0x3c,
-	0x06, 0x9a, 0x99, 0xdd, 0xb5, 0x1d, 0xf5, 0x62, 0xaf, 0x7e, 0xff, 0x66, 0xe6, 0xdb, 0xdf, 0x2c,
-	0xea, 0x31, 0xce, 0x82, 0xd9, 0x24, 0x88, 0x21, 0x03, 0xc5, 0xd5, 0x38, 0x97, 0x42, 0x0b, 0xdc,
-	0x64, 0x9c, 0x8d, 0x67, 0x93, 0xfd, 0x1d, 0x9a, 0xf2, 0x4c, 0x04, 0xf6, 0xd7, 0x51, 0xfb, 0xbd,
-	0x58, 0xc4, 0xc2, 0x3e, 0x06, 0xe6, 0xc9, 0xa1, 0xc3, 0x08, 0xdd, 0xfe, 0xda, 0x25, 0x9c, 0x6a,
-	0xaa, 0x01, 0x7f, 0x80, 0x9a, 0x39, 0x95, 0x34, 0x55, 0xbe, 0x37, 0xf0, 0x46, 0xdb, 0x87, 0xed,
-	0xb1, 0x4b, 0x1c, 0x3f, 0xb6, 0xe8, 0x83, 0xf5, 0x17, 0xaf, 0x0e, 0x1a, 0x61, 0xa9, 0xc1, 0xef,
-	0xa2, 0x36, 0xcc, 0x73, 0x21, 0x35, 0x99, 0x81, 0x54, 0x5c, 0x64, 0xfe, 0xad, 0x81, 0x37, 0x6a,
-	0x85, 0x2d, 0x87, 0x9e, 0x39, 0x70, 0xf8, 0xab, 0x87, 0x9a, 0xce, 0x8f, 0x0f, 0xd1, 0x26, 0x13,
-	0x51, 0x91, 0x42, 0xa6, 0xcb, 0x15, 0x76, 0xab, 0x15, 0x8e, 0x4b, 0xdc, 0x29, 0xc3, 0x5a, 0x67,
-	0x3c, 0x4f, 0xe1, 0x9c, 0x16, 0x7a, 0xea, 0xf2, 0x97, 0x3c, 0x3f, 0x95, 0x78, 0xe5, 0xa9, 0x74,
-	0x47, 0x7b, 0x7f, 0x3c, 0x3f, 0x68, 0xfc, 0xf7, 0xfc, 0xc0, 0xfb, 0xed, 0xdf, 0x3f, 0xef, 0x23,
-	0x33, 0x2b, 0xb7, 0xe5, 0xe1, 0x5f, 0x1b, 0xa8, 0xbd, 0xba, 0x12, 0xbe, 0x8f, 0x76, 0x68, 0xa1,
-	0x05, 0x89, 0x24, 0x50, 0x0d, 0x64, 0x46, 0x8b, 0xc4, 0x6d, 0x6e, 0x33, 0xec, 0x18, 0xe2, 0xa1,
-	0xc5, 0xcf, 0x0c, 0x8c, 0x3f, 0x45, 0x7e, 0x4a, 0xe7, 0xe6, 0xb8, 0xfc, 0x82, 0x47, 0x54, 0x73,
-	0x91, 0x91, 0x14, 0xf4, 0x54, 0x30, 0x65, 0xf7, 0xb6, 0x11, 0xee, 0xa6, 0x74, 0x7e, 0xb6, 0x44,
-	0x3f, 0x72, 0x2c, 0x3e, 0x44, 0x77, 0x8c, 0x53, 0x81, 0x9c, 0xf1, 0x08, 0x08, 0x64, 0x2c, 0x17,
-	0x3c, 0xd3, 0xca, 0x5f, 0xb3, 0xb6, 0x6e, 0x4a, 0xe7, 0xa7, 0x8e, 0xfb, 0xaa, 0xa2, 0xf0, 0x3d,
-	0xd4, 0x31, 0x9e, 0x48, 0x64, 0x5a, 0x8a, 0x24, 0x01, 0xa9, 0xfc, 0x75, 0xab, 0x6e, 0xa7, 0x74,
-	0xfe, 0x70, 0x81, 0xe2, 0x09, 0xba, 0xc3, 0x38, 0x23, 0xd5, 0xc8, 0x88, 0x5d, 0x89, 0x3f, 0x03,
-	0x7f, 0x63, 0xe0, 0x8d, 0xd6, 0x42, 0xcc, 0x38, 0xab, 0x0e, 0xfd, 0x88, 0xce, 0x4f, 0xf9, 0x33,
-	0xc0, 0x1f, 0xa3, 0x5d, 0x63, 0x91, 0xa0, 0x44, 0x52, 0xd8, 0x73, 0x68, 0x9e, 0x82, 0x28, 0xb4,
-	0xdf, 0xb4, 0x1e, 0x53, 0xb0, 0xb0, 0x26, 0x7f, 0x70, 0x9c, 0x39, 0xc5, 0x13, 0xb8, 0x24, 0x52,
-	0x68, 0x77, 0x76, 0x9e, 0x69, 0x90, 0x33, 0x9a, 0xf8, 0x6f, 0x58, 0x53, 0xf7, 0x09, 0x5c, 0x86,
-	0x25, 0x77, 0x52, 0x52, 0x38, 0x40, 0xdd, 0x48, 0x02, 0x83, 0x4c, 0x73, 0x9a, 0x90, 0x84, 0x5f,
-	0x80, 0x59, 0xc9, 0xdf, 0x74, 0x5b, 0x5b, 0x50, 0xdf, 0x96, 0x0c, 0xfe, 0x1c, 0xbd, 0xa5, 0x8a,
-	0xdc, 0x34, 0x08, 0x18, 0xa1, 0x4a, 0x81, 0x5c, 0x99, 0xf3, 0xd6, 0x60, 0x6d, 0xb4, 0x15, 0xde,
-	0xad, 0x25, 0x5f, 0x56, 0x8a, 0x6a, 0xd4, 0xdf, 0xa0, 0xc1, 0x92, 0xbf, 0xd0, 0x53, 0x93, 0x7f,
-	0xe3, 0x65, 0x21, 0x1b, 0xd2, 0x5f, 0x84, 0xac, 0xc8, 0xaa, 0xa4, 0x2f, 0xd0, 0xdb, 0x8b, 0x24,
-	0x9e, 0xcd, 0xc4, 0x8d, 0x94, 0x6d, 0x9b, 0xb2, 0x5f, 0x6b, 0x4e, 0x6a, 0xc9, 0x6b, 0x13, 0x18,
-	0x24, 0x10, 0xaf, 0x26, 0xdc, 0xbe, 0x91, 0x70, 0x5c, 0x4b, 0xca, 0x84, 0xa3, 0x75, 0x53, 0xe3,
-	0xe1, 0xab, 0x5b, 0xa8, 0xbd, 0xda, 0x76, 0xfc, 0x3e, 0xda, 0x89, 0xa6, 0x34, 0x49, 0x20, 0x8b,
-	0xa1, 0x7e, 0x79, 0x9e, 0x9d, 0xea, 0x9b, 0x35, 0x51, 0xbd, 0xb8, 0x7b, 0xa8, 0x43, 0x93, 0x44,
-	0x3c, 0x05, 0x46, 0x84, 0xe4, 0x31, 0xcf, 0x4c, 0x5f, 0xcd, 0xd2, 0xed, 0x12, 0xfe, 0xde, 0xa1,
-	0x78, 0x82, 0x7a, 0x4b, 0xc3, 0x4b, 0x62, 0x21, 0xb9, 0x9e, 0xa6, 0xa6, 0xa6, 0x46, 0xdd, 0x5d,
-	0x0c, 0xac, 0xa6, 0xf0, 0x11, 0xba, 0x2b, 0xe1, 0x97, 0x82, 0x4b, 0x20, 0x85, 0x02, 0xb9, 0x72,
-	0x3b, 0x6c, 0x61, 0x37, 0xc3, 0xbd, 0x52, 0xf0, 0xa3, 0x02, 0xb9, 0x7c, 0x3b, 0xf0, 0x27, 0x68,
-	0xcf, 0x56, 0xbc, 0x6e, 0x81, 0x22, 0x39, 0x48, 0xc2, 0x38, 0xb3, 0xdd, 0xdd, 0x08, 0x7b, 0xa6,
-	0xea, 0x0b, 0xf6, 0x31, 0xc8, 0x63, 0xce, 0xf0, 0x10, 0xb5, 0x18, 0x5c, 0x98, 0x2b, 0x49, 0x64,
-	0x4e, 0x38, 0xb3, 0xa5, 0xdd, 0x0a, 0xb7, 0x4b, 0x30, 0xcc, 0x4f, 0x18, 0x7e, 0x0f, 0x75, 0x96,
-	0x34, 0x19, 0x4d, 0xc1, 0xb6, 0x74, 0x2b, 0x6c, 0xd5, 0xaa, 0xef, 0x68, 0x0a, 0x6e, 0xc0, 0x0f,
-	0x3e, 0x7b, 0x71, 0xd5, 0xf7, 0x5e, 0x5e, 0xf5, 0xbd, 0x7f, 0xae, 0xfa, 0xde, 0xef, 0xd7, 0xfd,
-	0xc6, 0xcb, 0xeb, 0x7e, 0xe3, 0xef, 0xeb, 0x7e, 0xe3, 0xe7, 0x77, 0x62, 0xae, 0xa7, 0xc5, 0xf9,
-	0x38, 0x12, 0x69, 0xa0, 0x44, 0x26, 0x3f, 0xe4, 0xc2, 0xfe, 0x07, 0xf3, 0xc0, 0x7c, 0x58, 0xf4,
-	0x65, 0x0e, 0xea, 0xbc, 0x69, 0xbf, 0xa7, 0x1f, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x58, 0x4c,
-	0x95, 0x9a, 0x98, 0x05, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xcf, 0xd0, 0x36, 0x34, 0xee, 0x26, 0xa5, 0x4e, 0xb6, 0x99, 0x2d, 0xab, 0x34, 0x0a, 0x82,
+	0xad, 0x16, 0x48, 0xd4, 0xc2, 0x22, 0x54, 0x09, 0xb4, 0xb4, 0x45, 0x50, 0x89, 0x85, 0xd5, 0x94,
+	0x2d, 0x12, 0x17, 0xcb, 0x1d, 0xbf, 0x4e, 0xac, 0xce, 0x8c, 0x07, 0x8f, 0x27, 0x4d, 0xf7, 0x1b,
+	0x00, 0x17, 0x8e, 0x1c, 0xf7, 0xca, 0x8d, 0x8f, 0xb1, 0xc7, 0x3d, 0x72, 0x42, 0xa8, 0x3d, 0xc0,
+	0xc7, 0x40, 0xb6, 0xe7, 0x5f, 0xaa, 0xbd, 0xb4, 0xa3, 0xf7, 0xfb, 0x63, 0xfb, 0xf9, 0xfd, 0x1c,
+	0xd4, 0x63, 0x9c, 0x4d, 0x66, 0xbb, 0x93, 0x00, 0x62, 0x48, 0x79, 0x3a, 0x4e, 0xa4, 0x50, 0x02,
+	0x37, 0x19, 0x67, 0xe3, 0xd9, 0xee, 0xd6, 0x06, 0x8d, 0x78, 0x2c, 0x26, 0xe6, 0xaf, 0x85, 0xb6,
+	0x7a, 0x81, 0x08, 0x84, 0xf9, 0x9c, 0xe8, 0x2f, 0x5b, 0x1d, 0xf9, 0xe8, 0xce, 0x57, 0xd6, 0xe1,
+	0x44, 0x51, 0x05, 0xf8, 0x03, 0xd4, 0x4c, 0xa8, 0xa4, 0x51, 0xea, 0x3a, 0x43, 0x67, 0x67, 0x6d,
+	0xaf, 0x33, 0xb6, 0x8e, 0xe3, 0xa7, 0xa6, 0x7a, 0xb0, 0xfc, 0xf2, 0xef, 0xed, 0x86, 0x97, 0x73,
+	0xf0, 0xbb, 0xa8, 0x03, 0xf3, 0x44, 0x48, 0x45, 0x66, 0x20, 0x53, 0x2e, 0x62, 0xf7, 0x8d, 0xa1,
+	0xb3, 0xd3, 0xf6, 0xda, 0xb6, 0x7a, 0x6a, 0x8b, 0xa3, 0x9f, 0x1d, 0xd4, 0xb4, 0x7a, 0xbc, 0x87,
+	0x56, 0x99, 0xf0, 0xb3, 0x08, 0x62, 0x95, 0xaf, 0xb0, 0x59, 0xac, 0x70, 0x94, 0xd7, 0x2d, 0xd3,
+	0x2b, 0x79, 0x5a, 0x73, 0x09, 0x67, 0x34, 0x53, 0x53, 0xeb, 0x5f, 0xd3, 0xfc, 0x90, 0xd7, 0x0b,
+	0x4d, 0xc1, 0xdb, 0xef, 0xff, 0xfe, 0x62, 0xbb, 0xf1, 0xdf, 0x8b, 0x6d, 0xe7, 0x97, 0x7f, 0xff,
+	0x7c, 0x88, 0x74, 0xaf, 0xec, 0x96, 0x47, 0x7f, 0x34, 0x51, 0x67, 0x71, 0x25, 0xfc, 0x10, 0x6d,
+	0xd0, 0x4c, 0x09, 0xe2, 0x4b, 0xa0, 0x0a, 0xc8, 0x8c, 0x66, 0xa1, 0xdd, 0xdc, 0xaa, 0xb7, 0xae,
+	0x81, 0x43, 0x53, 0x3f, 0xd5, 0x65, 0xfc, 0x29, 0x72, 0x23, 0x3a, 0xd7, 0xc7, 0xe5, 0xe7, 0xdc,
+	0xa7, 0x8a, 0x8b, 0x98, 0x44, 0xa0, 0xa6, 0x82, 0xa5, 0x66, 0x6f, 0x2b, 0xde, 0x66, 0x44, 0xe7,
+	0xa7, 0x35, 0xf8, 0x89, 0x45, 0xf1, 0x1e, 0xba, 0xab, 0x95, 0x29, 0xc8, 0x19, 0xf7, 0x81, 0x40,
+	0xcc, 0x12, 0xc1, 0x63, 0x95, 0xba, 0x4b, 0x46, 0xd6, 0x8d, 0xe8, 0xfc, 0xc4, 0x62, 0x5f, 0x16,
+	0x10, 0x7e, 0x80, 0xd6, 0xb5, 0xc6, 0x17, 0xb1, 0x92, 0x22, 0x0c, 0x41, 0xa6, 0xee, 0xb2, 0x61,
+	0x77, 0x22, 0x3a, 0x3f, 0xac, 0xaa, 0x78, 0x17, 0xdd, 0x65, 0x9c, 0x91, 0xa2, 0x65, 0xc4, 0xac,
+	0xc4, 0x9f, 0x83, 0xbb, 0x32, 0x74, 0x76, 0x96, 0x3c, 0xcc, 0x38, 0x2b, 0x0e, 0xfd, 0x84, 0xce,
+	0x4f, 0xf8, 0x73, 0xc0, 0x1f, 0xa3, 0x4d, 0x2d, 0x91, 0x90, 0x8a, 0x30, 0x33, 0xe7, 0x50, 0x3c,
+	0x02, 0x91, 0x29, 0xb7, 0x69, 0x34, 0x7a, 0xc0, 0xbc, 0x12, 0xfc, 0xde, 0x62, 0xfa, 0x14, 0x17,
+	0x70, 0x45, 0xa4, 0x50, 0xf6, 0xec, 0x3c, 0x56, 0x20, 0x67, 0x34, 0x74, 0xdf, 0x34, 0xa2, 0xee,
+	0x05, 0x5c, 0x79, 0x39, 0x76, 0x9c, 0x43, 0x78, 0x82, 0xba, 0xbe, 0x04, 0x06, 0xb1, 0xe2, 0x34,
+	0x24, 0x21, 0x3f, 0x07, 0xbd, 0x92, 0xbb, 0x6a, 0xb7, 0x56, 0x41, 0xdf, 0xe4, 0x08, 0xfe, 0x1c,
+	0xbd, 0x9d, 0x66, 0x89, 0x9e, 0x20, 0x60, 0x84, 0xa6, 0x29, 0xc8, 0x85, 0x3e, 0xb7, 0x86, 0x4b,
+	0x3b, 0x2d, 0xef, 0x5e, 0x49, 0xf9, 0xa2, 0x60, 0x14, 0xad, 0xfe, 0x1a, 0x0d, 0x6b, 0xfa, 0x4c,
+	0x4d, 0xb5, 0xff, 0xad, 0xcb, 0x42, 0xc6, 0x64, 0x50, 0x99, 0x2c, 0xd0, 0x0a, 0xa7, 0xc7, 0xe8,
+	0x7e, 0xe5, 0xc4, 0xe3, 0x99, 0xb8, 0xe5, 0xb2, 0x66, 0x5c, 0xb6, 0x4a, 0xce, 0x71, 0x49, 0x79,
+	0xad, 0x03, 0x83, 0x10, 0x82, 0x45, 0x87, 0x3b, 0xb7, 0x1c, 0x8e, 0x4a, 0x4a, 0xe1, 0xf0, 0x09,
+	0xea, 0xeb, 0xeb, 0xac, 0x69, 0x59, 0x26, 0xcd, 0x87, 0xdb, 0x36, 0x2d, 0xd4, 0x73, 0x55, 0xc9,
+	0x8e, 0x72, 0x10, 0xef, 0xa3, 0x7b, 0x35, 0x1d, 0xb0, 0x85, 0x31, 0xea, 0x98, 0x31, 0xea, 0x57,
+	0x4a, 0x60, 0xb5, 0x79, 0xda, 0x5f, 0xd6, 0xd1, 0x19, 0xfd, 0xba, 0x8c, 0x3a, 0x8b, 0x09, 0xc3,
+	0xef, 0xa3, 0x0d, 0x7f, 0x4a, 0xc3, 0x10, 0xe2, 0x00, 0xca, 0x81, 0x71, 0xcc, 0x36, 0xde, 0x2a,
+	0x81, 0x62, 0x58, 0x1e, 0xa0, 0x75, 0x1a, 0x86, 0xe2, 0x12, 0x18, 0x11, 0x92, 0x07, 0x3c, 0xd6,
+	0x19, 0xd1, 0xc7, 0xed, 0xe4, 0xe5, 0xef, 0x6c, 0x15, 0xef, 0xa2, 0x5e, 0xed, 0xc2, 0xc2, 0x40,
+	0x48, 0xae, 0xa6, 0x91, 0x8e, 0x86, 0x66, 0x77, 0xab, 0x4b, 0x2a, 0x21, 0x7d, 0x3a, 0x09, 0x3f,
+	0x65, 0x5c, 0x02, 0xc9, 0x52, 0x90, 0x0b, 0x89, 0x34, 0x21, 0x59, 0xf5, 0xfa, 0x39, 0xe1, 0x59,
+	0x0a, 0xb2, 0x9e, 0x48, 0xfc, 0xc8, 0x76, 0xb4, 0x9a, 0xbc, 0x94, 0x24, 0x20, 0x09, 0xe3, 0xcc,
+	0xe4, 0x65, 0xc5, 0xeb, 0xe9, 0x78, 0x55, 0xe8, 0x53, 0x90, 0x47, 0x9c, 0xe1, 0x11, 0x6a, 0x33,
+	0x38, 0xd7, 0xcf, 0x00, 0x91, 0x09, 0xe1, 0xcc, 0x04, 0xa5, 0xe5, 0xad, 0xe5, 0x45, 0x2f, 0x39,
+	0x66, 0xf8, 0x3d, 0xb4, 0x5e, 0xe3, 0xc4, 0x34, 0x02, 0x93, 0x8c, 0x96, 0xd7, 0x2e, 0x59, 0xdf,
+	0xd2, 0x08, 0x74, 0x8e, 0x6c, 0x4b, 0x88, 0x3f, 0x05, 0xff, 0xa2, 0xca, 0x91, 0x4d, 0x45, 0xd7,
+	0x82, 0x87, 0x1a, 0x2b, 0x73, 0xf4, 0x08, 0xf5, 0x17, 0x34, 0x67, 0x54, 0xf9, 0x53, 0x1b, 0xf3,
+	0x96, 0x79, 0x76, 0x7b, 0x35, 0xd5, 0x81, 0x06, 0x4d, 0xd0, 0x1f, 0xa3, 0xfb, 0x4c, 0x5c, 0xc6,
+	0x81, 0xa4, 0x0c, 0x48, 0x16, 0xdb, 0x46, 0xd5, 0xae, 0x04, 0x99, 0x66, 0x6d, 0x95, 0x9c, 0x67,
+	0x25, 0x25, 0xbf, 0x1e, 0x3b, 0x0d, 0x07, 0x9f, 0xbd, 0xbc, 0x1e, 0x38, 0xaf, 0xae, 0x07, 0xce,
+	0x3f, 0xd7, 0x03, 0xe7, 0xb7, 0x9b, 0x41, 0xe3, 0xd5, 0xcd, 0xa0, 0xf1, 0xd7, 0xcd, 0xa0, 0xf1,
+	0xe3, 0x3b, 0x01, 0x57, 0xd3, 0xec, 0x6c, 0xec, 0x8b, 0x68, 0x92, 0x8a, 0x58, 0x7e, 0xc8, 0x85,
+	0xf9, 0x3f, 0x99, 0x4f, 0xf4, 0xcb, 0xab, 0xae, 0x12, 0x48, 0xcf, 0x9a, 0xe6, 0x07, 0xe7, 0xa3,
+	0xff, 0x07, 0x00, 0x1e, 0x2e, 0xb9, 0xd9, 0xb9, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -541,6 +596,12 @@ func (this *DocumentParams) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MaxDelegationDuration != that1.MaxDelegationDuration {
+		return false
+	}
+	if this.MaxDelegatedControllers != that1.MaxDelegatedControllers {
+		return false
+	}
 	return true
 }
 func (this *WebauthnParams) Equal(that interface{}) bool {
@@ -593,6 +654,15 @@ func (this *WebauthnParams) Equal(that interface{}) bool {
 	if this.DefaultRpName != that1.DefaultRpName {
 		return false
 	}
+	if this.OriginCheckInterval != that1.OriginCheckInterval {
+		return false
+	}
+	if this.OriginCheckBatchSize != that1.OriginCheckBatchSize {
+		return false
+	}
+	if this.DowngradeUnverifiedOrigins != that1.DowngradeUnverifiedOrigins {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -700,6 +770,16 @@ func (m *DocumentParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegatedControllers != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDelegatedControllers))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxDelegationDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDelegationDuration))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.SupportedDelegationMethods) > 0 {
 		for iNdEx := len(m.SupportedDelegationMethods) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SupportedDelegationMethods[iNdEx])
@@ -804,6 +884,26 @@ func (m *WebauthnParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowngradeUnverifiedOrigins {
+		i--
+		if m.DowngradeUnverifiedOrigins {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OriginCheckBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OriginCheckBatchSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OriginCheckInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OriginCheckInterval))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.DefaultRpName) > 0 {
 		i -= len(m.DefaultRpName)
 		copy(dAtA[i:], m.DefaultRpName)
@@ -955,6 +1055,12 @@ func (m *DocumentParams) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MaxDelegationDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDelegationDuration))
+	}
+	if m.MaxDelegatedControllers != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDelegatedControllers))
+	}
 	return n
 }
 
@@ -993,6 +1099,15 @@ func (m *WebauthnParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.OriginCheckInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.OriginCheckInterval))
+	}
+	if m.OriginCheckBatchSize != 0 {
+		n += 1 + sovGenesis(uint64(m.OriginCheckBatchSize))
+	}
+	if m.DowngradeUnverifiedOrigins {
+		n += 2
+	}
 	return n
 }
 
@@ -1536,6 +1651,44 @@ func (m *DocumentParams) Unmarshal(dAtA []byte) error {
 			}
 			m.SupportedDelegationMethods = append(m.SupportedDelegationMethods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationDuration", wireType)
+			}
+			m.MaxDelegationDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedControllers", wireType)
+			}
+			m.MaxDelegatedControllers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatedControllers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1772,6 +1925,64 @@ func (m *WebauthnParams) Unmarshal(dAtA []byte) error {
 			}
 			m.DefaultRpName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginCheckInterval", wireType)
+			}
+			m.OriginCheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginCheckInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginCheckBatchSize", wireType)
+			}
+			m.OriginCheckBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginCheckBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowngradeUnverifiedOrigins", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowngradeUnverifiedOrigins = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,34 @@
+package types
+
+import "context"
+
+var _ DIDHooks = MultiDIDHooks{}
+
+// MultiDIDHooks runs several modules' DID hooks in order, stopping at the
+// first error
+type MultiDIDHooks []DIDHooks
+
+// NewMultiDIDHooks combines DID hooks
+func NewMultiDIDHooks(hooks ...DIDHooks) MultiDIDHooks {
+	return hooks
+}
+
+// AfterDIDRegistered implements DIDHooks
+func (h MultiDIDHooks) AfterDIDRegistered(ctx context.Context, did, controller, referralCode string) error {
+	for _, hook := range h {
+		if err := hook.AfterDIDRegistered(ctx, did, controller, referralCode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterDIDDocumentChanged implements DIDHooks
+func (h MultiDIDHooks) AfterDIDDocumentChanged(ctx context.Context, did string) error {
+	for _, hook := range h {
+		if err := hook.AfterDIDDocumentChanged(ctx, did); err != nil {
+			return err
+		}
+	}
+	return nil
+}
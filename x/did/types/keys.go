@@ -6,8 +6,42 @@ import (
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 )
 
-// ParamsKey saves the current module params.
-var ParamsKey = collections.NewPrefix(0)
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// DocumentVersionsKey saves every version of each DID document by DID
+	// and version number.
+	DocumentVersionsKey = collections.NewPrefix(1)
+
+	// UnverifiedCredentialsKey saves the WebAuthn credentials whose origin
+	// is no longer verified, by DID and credential ID.
+	UnverifiedCredentialsKey = collections.NewPrefix(2)
+
+	// OriginCheckCursorKey saves the last DID whose credential origins were
+	// checked, so each run continues where the previous one stopped.
+	OriginCheckCursorKey = collections.NewPrefix(3)
+
+	// StatusListsKey saves the StatusList2021 revocation lists by issuer DID
+	// and list number.
+	StatusListsKey = collections.NewPrefix(4)
+
+	// StatusListEntriesKey saves the credential holding each allocated
+	// status list index, by issuer DID, list number and index.
+	StatusListEntriesKey = collections.NewPrefix(5)
+
+	// DelegatedControllersKey saves the temporary controllers of each DID,
+	// by DID and controller address.
+	DelegatedControllersKey = collections.NewPrefix(6)
+
+	// DelegationExpiriesKey indexes delegated controllers by expiry time, so
+	// expired ones are found without scanning every delegation.
+	DelegationExpiriesKey = collections.NewPrefix(7)
+
+	// DIDAssetsKey saves the x/nft tokens shown on each DID's profile, by
+	// DID, class ID and token ID.
+	DIDAssetsKey = collections.NewPrefix(8)
+)
 
 const (
 	ModuleName = "did"
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgCreateDID{}
 	_ sdk.Msg = &MsgUpdateDID{}
 	_ sdk.Msg = &MsgDeactivateDID{}
+	_ sdk.Msg = &MsgReactivateDID{}
 	_ sdk.Msg = &MsgAddVerificationMethod{}
 	_ sdk.Msg = &MsgRemoveVerificationMethod{}
 	_ sdk.Msg = &MsgAddService{}
@@ -19,6 +20,10 @@ var (
 	_ sdk.Msg = &MsgIssueVerifiableCredential{}
 	_ sdk.Msg = &MsgRevokeVerifiableCredential{}
 	_ sdk.Msg = &MsgLinkExternalWallet{}
+	_ sdk.Msg = &MsgAddDelegatedController{}
+	_ sdk.Msg = &MsgRemoveDelegatedController{}
+	_ sdk.Msg = &MsgAttachNFT{}
+	_ sdk.Msg = &MsgDetachNFT{}
 	_ sdk.Msg = &MsgRegisterWebAuthnCredential{}
 )
 
@@ -111,6 +116,19 @@ func (msg *MsgDeactivateDID) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic does a sanity check on MsgReactivateDID.
+func (msg *MsgReactivateDID) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(ErrInvalidControllerAddress, err.Error())
+	}
+
+	if msg.Did == "" {
+		return ErrEmptyDID
+	}
+
+	return nil
+}
+
 // ValidateBasic does a sanity check on MsgAddVerificationMethod.
 func (msg *MsgAddVerificationMethod) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
@@ -271,6 +289,82 @@ func (msg *MsgLinkExternalWallet) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic does a sanity check on MsgAddDelegatedController.
+func (msg *MsgAddDelegatedController) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(ErrInvalidControllerAddress, err.Error())
+	}
+
+	if msg.Did == "" {
+		return ErrEmptyDID
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errors.Wrap(ErrInvalidDelegation, err.Error())
+	}
+
+	if msg.Delegate == msg.Controller {
+		return errors.Wrap(ErrInvalidDelegation, "cannot delegate to the signing controller")
+	}
+
+	if msg.ExpiresAt <= 0 {
+		return errors.Wrap(ErrInvalidDelegation, "expiry is required")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on MsgRemoveDelegatedController.
+func (msg *MsgRemoveDelegatedController) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(ErrInvalidControllerAddress, err.Error())
+	}
+
+	if msg.Did == "" {
+		return ErrEmptyDID
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errors.Wrap(ErrInvalidDelegation, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on MsgAttachNFT.
+func (msg *MsgAttachNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(ErrInvalidControllerAddress, err.Error())
+	}
+
+	if msg.Did == "" {
+		return ErrEmptyDID
+	}
+
+	if msg.ClassId == "" || msg.NftId == "" {
+		return errors.Wrap(ErrInvalidAsset, "class ID and token ID are required")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on MsgDetachNFT.
+func (msg *MsgDetachNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(ErrInvalidControllerAddress, err.Error())
+	}
+
+	if msg.Did == "" {
+		return ErrEmptyDID
+	}
+
+	if msg.ClassId == "" || msg.NftId == "" {
+		return errors.Wrap(ErrInvalidAsset, "class ID and token ID are required")
+	}
+
+	return nil
+}
+
 // ValidateBasic does a sanity check on MsgRegisterWebAuthnCredential.
 func (msg *MsgRegisterWebAuthnCredential) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
@@ -293,6 +387,10 @@ func (msg *MsgRegisterWebAuthnCredential) ValidateBasic() error {
 		return errors.Wrap(ErrInvalidWebAuthnCredential, "public key cannot be empty")
 	}
 
+	if err := ValidateTransports(msg.WebauthnCredential.Transports); err != nil {
+		return err
+	}
+
 	if msg.VerificationMethodId == "" {
 		return ErrEmptyVerificationMethodID
 	}
@@ -13,14 +13,16 @@ import (
 func DefaultParams() Params {
 	return Params{
 		Document: &DocumentParams{
-			AutoCreateVault:        true,
-			MaxVerificationMethods: 20,       // Maximum verification methods per DID
-			MaxServiceEndpoints:    10,       // Maximum service endpoints per DID
-			MaxControllers:         5,        // Maximum controllers per DID
-			DidDocumentMaxSize:     65536,    // 64KB max DID document size
-			DidResolutionTimeout:   5,        // 5 seconds resolution timeout
-			KeyRotationInterval:    2592000,  // 30 days in seconds
-			CredentialLifetime:     31536000, // 1 year in seconds
+			AutoCreateVault:         true,
+			MaxVerificationMethods:  20,       // Maximum verification methods per DID
+			MaxServiceEndpoints:     10,       // Maximum service endpoints per DID
+			MaxControllers:          5,        // Maximum controllers per DID
+			DidDocumentMaxSize:      65536,    // 64KB max DID document size
+			DidResolutionTimeout:    5,        // 5 seconds resolution timeout
+			KeyRotationInterval:     2592000,  // 30 days in seconds
+			CredentialLifetime:      31536000, // 1 year in seconds
+			MaxDelegationDuration:   7776000,  // 90 days in seconds
+			MaxDelegatedControllers: 3,        // Maximum active delegations per DID
 			SupportedAssertionMethods: []string{
 				"Ed25519VerificationKey2018",
 				"EcdsaSecp256k1VerificationKey2019",
@@ -60,6 +62,8 @@ func DefaultParams() Params {
 			MaxCredentialsPerDid:    10,   // Reasonable limit to prevent resource exhaustion
 			DefaultRpId:             "localhost",
 			DefaultRpName:           "Sonr Identity Platform",
+			OriginCheckInterval:     100, // Re-check credential origins every 100 blocks
+			OriginCheckBatchSize:    100, // DID documents checked per run
 		},
 	}
 }
@@ -142,6 +146,14 @@ func validateWebAuthnParams(p *WebauthnParams) error {
 		return errors.Wrap(ErrInvalidParams, "default_rp_name must be between 1-256 characters")
 	}
 
+	// Validate origin checks (a zero interval disables them)
+	if p.OriginCheckInterval < 0 {
+		return errors.Wrap(ErrInvalidParams, "origin_check_interval cannot be negative")
+	}
+	if p.OriginCheckInterval > 0 && (p.OriginCheckBatchSize < 1 || p.OriginCheckBatchSize > 1000) {
+		return errors.Wrap(ErrInvalidParams, "origin_check_batch_size must be between 1-1000")
+	}
+
 	return nil
 }
 
@@ -203,6 +215,22 @@ func validateDIDParams(p *DocumentParams) error {
 		)
 	}
 
+	// Validate max delegation duration (unbounded when zero)
+	if p.MaxDelegationDuration < 0 {
+		return errors.Wrap(
+			ErrInvalidParams,
+			"max_delegation_duration cannot be negative",
+		)
+	}
+
+	// Validate max delegated controllers (0-10)
+	if p.MaxDelegatedControllers < 0 || p.MaxDelegatedControllers > 10 {
+		return errors.Wrap(
+			ErrInvalidParams,
+			"max_delegated_controllers must be between 0-10",
+		)
+	}
+
 	// Validate supported assertion methods
 	if len(p.SupportedAssertionMethods) == 0 {
 		return errors.Wrap(
@@ -319,25 +319,30 @@ func (m *QueryGetDIDDocumentResponse) GetDidDocumentMetadata() *DIDDocumentMetad
 	return nil
 }
 
-// QueryListDIDDocumentsRequest is the request type for the
-// Query/ListDIDDocuments RPC method.
-type QueryListDIDDocumentsRequest struct {
-	// pagination defines an optional pagination for the request
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetDIDDocumentVersionRequest is the request type for the
+// Query/GetDIDDocumentVersion RPC method. At most one of version_id and
+// version_time may be set; with neither the latest version is returned.
+type QueryGetDIDDocumentVersionRequest struct {
+	// did is the DID to resolve
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// version_id selects a version by number
+	VersionId uint64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// version_time selects the version that was current at an RFC 3339 time
+	VersionTime string `protobuf:"bytes,3,opt,name=version_time,json=versionTime,proto3" json:"version_time,omitempty"`
 }
 
-func (m *QueryListDIDDocumentsRequest) Reset()         { *m = QueryListDIDDocumentsRequest{} }
-func (m *QueryListDIDDocumentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListDIDDocumentsRequest) ProtoMessage()    {}
-func (*QueryListDIDDocumentsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDDocumentVersionRequest) Reset()         { *m = QueryGetDIDDocumentVersionRequest{} }
+func (m *QueryGetDIDDocumentVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDDocumentVersionRequest) ProtoMessage()    {}
+func (*QueryGetDIDDocumentVersionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{6}
 }
-func (m *QueryListDIDDocumentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDDocumentVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListDIDDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDDocumentVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListDIDDocumentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDDocumentVersionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -347,46 +352,62 @@ func (m *QueryListDIDDocumentsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryListDIDDocumentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListDIDDocumentsRequest.Merge(m, src)
+func (m *QueryGetDIDDocumentVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDDocumentVersionRequest.Merge(m, src)
 }
-func (m *QueryListDIDDocumentsRequest) XXX_Size() int {
+func (m *QueryGetDIDDocumentVersionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListDIDDocumentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListDIDDocumentsRequest.DiscardUnknown(m)
+func (m *QueryGetDIDDocumentVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDDocumentVersionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListDIDDocumentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDDocumentVersionRequest proto.InternalMessageInfo
 
-func (m *QueryListDIDDocumentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetDIDDocumentVersionRequest) GetDid() string {
 	if m != nil {
-		return m.Pagination
+		return m.Did
 	}
-	return nil
+	return ""
 }
 
-// QueryListDIDDocumentsResponse is the response type for the
-// Query/ListDIDDocuments RPC method.
-type QueryListDIDDocumentsResponse struct {
-	// did_documents is the list of DID documents
-	DidDocuments []*DIDDocument `protobuf:"bytes,1,rep,name=did_documents,json=didDocuments,proto3" json:"did_documents,omitempty"`
-	// pagination defines the pagination in the response
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetDIDDocumentVersionRequest) GetVersionId() uint64 {
+	if m != nil {
+		return m.VersionId
+	}
+	return 0
 }
 
-func (m *QueryListDIDDocumentsResponse) Reset()         { *m = QueryListDIDDocumentsResponse{} }
-func (m *QueryListDIDDocumentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListDIDDocumentsResponse) ProtoMessage()    {}
-func (*QueryListDIDDocumentsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDDocumentVersionRequest) GetVersionTime() string {
+	if m != nil {
+		return m.VersionTime
+	}
+	return ""
+}
+
+// QueryGetDIDDocumentVersionResponse is the response type for the
+// Query/GetDIDDocumentVersion RPC method.
+type QueryGetDIDDocumentVersionResponse struct {
+	// did_document is the DID document at the resolved version
+	DidDocument *DIDDocument `protobuf:"bytes,1,opt,name=did_document,json=didDocument,proto3" json:"did_document,omitempty"`
+	// did_document_metadata describes the resolved version: updated and
+	// version_id are those of the version, next_update and next_version_id
+	// those of the version that replaced it, if any
+	DidDocumentMetadata *DIDDocumentMetadata `protobuf:"bytes,2,opt,name=did_document_metadata,json=didDocumentMetadata,proto3" json:"did_document_metadata,omitempty"`
+}
+
+func (m *QueryGetDIDDocumentVersionResponse) Reset()         { *m = QueryGetDIDDocumentVersionResponse{} }
+func (m *QueryGetDIDDocumentVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDDocumentVersionResponse) ProtoMessage()    {}
+func (*QueryGetDIDDocumentVersionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{7}
 }
-func (m *QueryListDIDDocumentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDDocumentVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListDIDDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDDocumentVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListDIDDocumentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDDocumentVersionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -396,55 +417,53 @@ func (m *QueryListDIDDocumentsResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryListDIDDocumentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListDIDDocumentsResponse.Merge(m, src)
+func (m *QueryGetDIDDocumentVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDDocumentVersionResponse.Merge(m, src)
 }
-func (m *QueryListDIDDocumentsResponse) XXX_Size() int {
+func (m *QueryGetDIDDocumentVersionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListDIDDocumentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListDIDDocumentsResponse.DiscardUnknown(m)
+func (m *QueryGetDIDDocumentVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDDocumentVersionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListDIDDocumentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDDocumentVersionResponse proto.InternalMessageInfo
 
-func (m *QueryListDIDDocumentsResponse) GetDidDocuments() []*DIDDocument {
+func (m *QueryGetDIDDocumentVersionResponse) GetDidDocument() *DIDDocument {
 	if m != nil {
-		return m.DidDocuments
+		return m.DidDocument
 	}
 	return nil
 }
 
-func (m *QueryListDIDDocumentsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetDIDDocumentVersionResponse) GetDidDocumentMetadata() *DIDDocumentMetadata {
 	if m != nil {
-		return m.Pagination
+		return m.DidDocumentMetadata
 	}
 	return nil
 }
 
-// QueryGetDIDDocumentsByControllerRequest is the request type for the
-// Query/GetDIDDocumentsByController RPC method.
-type QueryGetDIDDocumentsByControllerRequest struct {
-	// controller is the controller to search for
-	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+// QueryGetDIDDocumentHistoryRequest is the request type for the
+// Query/GetDIDDocumentHistory RPC method.
+type QueryGetDIDDocumentHistoryRequest struct {
+	// did is the DID whose versions are listed
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 	// pagination defines an optional pagination for the request
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDIDDocumentsByControllerRequest) Reset() {
-	*m = QueryGetDIDDocumentsByControllerRequest{}
-}
-func (m *QueryGetDIDDocumentsByControllerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetDIDDocumentsByControllerRequest) ProtoMessage()    {}
-func (*QueryGetDIDDocumentsByControllerRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDDocumentHistoryRequest) Reset()         { *m = QueryGetDIDDocumentHistoryRequest{} }
+func (m *QueryGetDIDDocumentHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDDocumentHistoryRequest) ProtoMessage()    {}
+func (*QueryGetDIDDocumentHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{8}
 }
-func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDDocumentHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDDocumentHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDDocumentHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -454,55 +473,53 @@ func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest.Merge(m, src)
+func (m *QueryGetDIDDocumentHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDDocumentHistoryRequest.Merge(m, src)
 }
-func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Size() int {
+func (m *QueryGetDIDDocumentHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetDIDDocumentsByControllerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest.DiscardUnknown(m)
+func (m *QueryGetDIDDocumentHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDDocumentHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDDocumentHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryGetDIDDocumentsByControllerRequest) GetController() string {
+func (m *QueryGetDIDDocumentHistoryRequest) GetDid() string {
 	if m != nil {
-		return m.Controller
+		return m.Did
 	}
 	return ""
 }
 
-func (m *QueryGetDIDDocumentsByControllerRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetDIDDocumentHistoryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetDIDDocumentsByControllerResponse is the response type for the
-// Query/GetDIDDocumentsByController RPC method.
-type QueryGetDIDDocumentsByControllerResponse struct {
-	// did_documents is the list of DID documents controlled by the controller
-	DidDocuments []*DIDDocument `protobuf:"bytes,1,rep,name=did_documents,json=didDocuments,proto3" json:"did_documents,omitempty"`
+// QueryGetDIDDocumentHistoryResponse is the response type for the
+// Query/GetDIDDocumentHistory RPC method.
+type QueryGetDIDDocumentHistoryResponse struct {
+	// versions are the document's versions in version order
+	Versions []*DIDDocumentVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	// pagination defines the pagination in the response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDIDDocumentsByControllerResponse) Reset() {
-	*m = QueryGetDIDDocumentsByControllerResponse{}
-}
-func (m *QueryGetDIDDocumentsByControllerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetDIDDocumentsByControllerResponse) ProtoMessage()    {}
-func (*QueryGetDIDDocumentsByControllerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDDocumentHistoryResponse) Reset()         { *m = QueryGetDIDDocumentHistoryResponse{} }
+func (m *QueryGetDIDDocumentHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDDocumentHistoryResponse) ProtoMessage()    {}
+func (*QueryGetDIDDocumentHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{9}
 }
-func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDDocumentHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDDocumentHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDDocumentHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -512,53 +529,51 @@ func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse.Merge(m, src)
+func (m *QueryGetDIDDocumentHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDDocumentHistoryResponse.Merge(m, src)
 }
-func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Size() int {
+func (m *QueryGetDIDDocumentHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetDIDDocumentsByControllerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse.DiscardUnknown(m)
+func (m *QueryGetDIDDocumentHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDDocumentHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDDocumentHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryGetDIDDocumentsByControllerResponse) GetDidDocuments() []*DIDDocument {
+func (m *QueryGetDIDDocumentHistoryResponse) GetVersions() []*DIDDocumentVersion {
 	if m != nil {
-		return m.DidDocuments
+		return m.Versions
 	}
 	return nil
 }
 
-func (m *QueryGetDIDDocumentsByControllerResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetDIDDocumentHistoryResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetVerificationMethodRequest is the request type for the
-// Query/GetVerificationMethod RPC method.
-type QueryGetVerificationMethodRequest struct {
-	// did is the DID that contains the verification method
+// QueryGetDelegatedControllersRequest is the request type for the
+// Query/GetDelegatedControllers RPC method.
+type QueryGetDelegatedControllersRequest struct {
+	// did is the DID whose delegations are listed
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// method_id is the ID of the verification method
-	MethodId string `protobuf:"bytes,2,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty"`
 }
 
-func (m *QueryGetVerificationMethodRequest) Reset()         { *m = QueryGetVerificationMethodRequest{} }
-func (m *QueryGetVerificationMethodRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetVerificationMethodRequest) ProtoMessage()    {}
-func (*QueryGetVerificationMethodRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetDelegatedControllersRequest) Reset()         { *m = QueryGetDelegatedControllersRequest{} }
+func (m *QueryGetDelegatedControllersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatedControllersRequest) ProtoMessage()    {}
+func (*QueryGetDelegatedControllersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{10}
 }
-func (m *QueryGetVerificationMethodRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDelegatedControllersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetVerificationMethodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDelegatedControllersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetVerificationMethodRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDelegatedControllersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -568,51 +583,44 @@ func (m *QueryGetVerificationMethodRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetVerificationMethodRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetVerificationMethodRequest.Merge(m, src)
+func (m *QueryGetDelegatedControllersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatedControllersRequest.Merge(m, src)
 }
-func (m *QueryGetVerificationMethodRequest) XXX_Size() int {
+func (m *QueryGetDelegatedControllersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetVerificationMethodRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetVerificationMethodRequest.DiscardUnknown(m)
+func (m *QueryGetDelegatedControllersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatedControllersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetVerificationMethodRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDelegatedControllersRequest proto.InternalMessageInfo
 
-func (m *QueryGetVerificationMethodRequest) GetDid() string {
+func (m *QueryGetDelegatedControllersRequest) GetDid() string {
 	if m != nil {
 		return m.Did
 	}
 	return ""
 }
 
-func (m *QueryGetVerificationMethodRequest) GetMethodId() string {
-	if m != nil {
-		return m.MethodId
-	}
-	return ""
-}
-
-// QueryGetVerificationMethodResponse is the response type for the
-// Query/GetVerificationMethod RPC method.
-type QueryGetVerificationMethodResponse struct {
-	// verification_method is the retrieved verification method
-	VerificationMethod *VerificationMethod `protobuf:"bytes,1,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+// QueryGetDelegatedControllersResponse is the response type for the
+// Query/GetDelegatedControllers RPC method.
+type QueryGetDelegatedControllersResponse struct {
+	// delegations are the active delegations, soonest expiry first
+	Delegations []DelegatedController `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
 }
 
-func (m *QueryGetVerificationMethodResponse) Reset()         { *m = QueryGetVerificationMethodResponse{} }
-func (m *QueryGetVerificationMethodResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetVerificationMethodResponse) ProtoMessage()    {}
-func (*QueryGetVerificationMethodResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetDelegatedControllersResponse) Reset()         { *m = QueryGetDelegatedControllersResponse{} }
+func (m *QueryGetDelegatedControllersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatedControllersResponse) ProtoMessage()    {}
+func (*QueryGetDelegatedControllersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{11}
 }
-func (m *QueryGetVerificationMethodResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDelegatedControllersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetVerificationMethodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDelegatedControllersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetVerificationMethodResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDelegatedControllersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -622,46 +630,46 @@ func (m *QueryGetVerificationMethodResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetVerificationMethodResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetVerificationMethodResponse.Merge(m, src)
+func (m *QueryGetDelegatedControllersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatedControllersResponse.Merge(m, src)
 }
-func (m *QueryGetVerificationMethodResponse) XXX_Size() int {
+func (m *QueryGetDelegatedControllersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetVerificationMethodResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetVerificationMethodResponse.DiscardUnknown(m)
+func (m *QueryGetDelegatedControllersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatedControllersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetVerificationMethodResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDelegatedControllersResponse proto.InternalMessageInfo
 
-func (m *QueryGetVerificationMethodResponse) GetVerificationMethod() *VerificationMethod {
+func (m *QueryGetDelegatedControllersResponse) GetDelegations() []DelegatedController {
 	if m != nil {
-		return m.VerificationMethod
+		return m.Delegations
 	}
 	return nil
 }
 
-// QueryGetServiceRequest is the request type for the Query/GetService RPC
-// method.
-type QueryGetServiceRequest struct {
-	// did is the DID that contains the service
+// QueryGetDIDAssetsRequest is the request type for the Query/GetDIDAssets
+// RPC method.
+type QueryGetDIDAssetsRequest struct {
+	// did is the DID whose assets are listed
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// service_id is the ID of the service
-	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// owned_only omits tokens the DID's controllers no longer own
+	OwnedOnly bool `protobuf:"varint,2,opt,name=owned_only,json=ownedOnly,proto3" json:"owned_only,omitempty"`
 }
 
-func (m *QueryGetServiceRequest) Reset()         { *m = QueryGetServiceRequest{} }
-func (m *QueryGetServiceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetServiceRequest) ProtoMessage()    {}
-func (*QueryGetServiceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDAssetsRequest) Reset()         { *m = QueryGetDIDAssetsRequest{} }
+func (m *QueryGetDIDAssetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDAssetsRequest) ProtoMessage()    {}
+func (*QueryGetDIDAssetsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{12}
 }
-func (m *QueryGetServiceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDAssetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetServiceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDAssetsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -671,51 +679,51 @@ func (m *QueryGetServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetServiceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetServiceRequest.Merge(m, src)
+func (m *QueryGetDIDAssetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDAssetsRequest.Merge(m, src)
 }
-func (m *QueryGetServiceRequest) XXX_Size() int {
+func (m *QueryGetDIDAssetsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetServiceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetServiceRequest.DiscardUnknown(m)
+func (m *QueryGetDIDAssetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDAssetsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetServiceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDAssetsRequest proto.InternalMessageInfo
 
-func (m *QueryGetServiceRequest) GetDid() string {
+func (m *QueryGetDIDAssetsRequest) GetDid() string {
 	if m != nil {
 		return m.Did
 	}
 	return ""
 }
 
-func (m *QueryGetServiceRequest) GetServiceId() string {
+func (m *QueryGetDIDAssetsRequest) GetOwnedOnly() bool {
 	if m != nil {
-		return m.ServiceId
+		return m.OwnedOnly
 	}
-	return ""
+	return false
 }
 
-// QueryGetServiceResponse is the response type for the Query/GetService
+// QueryGetDIDAssetsResponse is the response type for the Query/GetDIDAssets
 // RPC method.
-type QueryGetServiceResponse struct {
-	// service is the retrieved service
-	Service *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+type QueryGetDIDAssetsResponse struct {
+	// assets are the tokens attached to the DID, by class and token ID
+	Assets []DIDAssetInfo `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets"`
 }
 
-func (m *QueryGetServiceResponse) Reset()         { *m = QueryGetServiceResponse{} }
-func (m *QueryGetServiceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetServiceResponse) ProtoMessage()    {}
-func (*QueryGetServiceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDAssetsResponse) Reset()         { *m = QueryGetDIDAssetsResponse{} }
+func (m *QueryGetDIDAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDAssetsResponse) ProtoMessage()    {}
+func (*QueryGetDIDAssetsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{13}
 }
-func (m *QueryGetServiceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDAssetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetServiceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDAssetsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -725,44 +733,47 @@ func (m *QueryGetServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetServiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetServiceResponse.Merge(m, src)
+func (m *QueryGetDIDAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDAssetsResponse.Merge(m, src)
 }
-func (m *QueryGetServiceResponse) XXX_Size() int {
+func (m *QueryGetDIDAssetsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetServiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetServiceResponse.DiscardUnknown(m)
+func (m *QueryGetDIDAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDAssetsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetServiceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDAssetsResponse proto.InternalMessageInfo
 
-func (m *QueryGetServiceResponse) GetService() *Service {
+func (m *QueryGetDIDAssetsResponse) GetAssets() []DIDAssetInfo {
 	if m != nil {
-		return m.Service
+		return m.Assets
 	}
 	return nil
 }
 
-// QueryGetVerifiableCredentialRequest is the request type for the
-// Query/GetVerifiableCredential RPC method.
-type QueryGetVerifiableCredentialRequest struct {
-	// credential_id is the ID of the credential to retrieve
-	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+// DIDAssetInfo is an attached token with its current ownership
+type DIDAssetInfo struct {
+	Asset DIDAsset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// current_owner is the address owning the token now, empty when it no
+	// longer exists
+	CurrentOwner string `protobuf:"bytes,2,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	// owned is set while a controller of the DID owns the token
+	Owned bool `protobuf:"varint,3,opt,name=owned,proto3" json:"owned,omitempty"`
 }
 
-func (m *QueryGetVerifiableCredentialRequest) Reset()         { *m = QueryGetVerifiableCredentialRequest{} }
-func (m *QueryGetVerifiableCredentialRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetVerifiableCredentialRequest) ProtoMessage()    {}
-func (*QueryGetVerifiableCredentialRequest) Descriptor() ([]byte, []int) {
+func (m *DIDAssetInfo) Reset()         { *m = DIDAssetInfo{} }
+func (m *DIDAssetInfo) String() string { return proto.CompactTextString(m) }
+func (*DIDAssetInfo) ProtoMessage()    {}
+func (*DIDAssetInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{14}
 }
-func (m *QueryGetVerifiableCredentialRequest) XXX_Unmarshal(b []byte) error {
+func (m *DIDAssetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetVerifiableCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DIDAssetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetVerifiableCredentialRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DIDAssetInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -772,44 +783,58 @@ func (m *QueryGetVerifiableCredentialRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryGetVerifiableCredentialRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetVerifiableCredentialRequest.Merge(m, src)
+func (m *DIDAssetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DIDAssetInfo.Merge(m, src)
 }
-func (m *QueryGetVerifiableCredentialRequest) XXX_Size() int {
+func (m *DIDAssetInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetVerifiableCredentialRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetVerifiableCredentialRequest.DiscardUnknown(m)
+func (m *DIDAssetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DIDAssetInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetVerifiableCredentialRequest proto.InternalMessageInfo
+var xxx_messageInfo_DIDAssetInfo proto.InternalMessageInfo
 
-func (m *QueryGetVerifiableCredentialRequest) GetCredentialId() string {
+func (m *DIDAssetInfo) GetAsset() DIDAsset {
 	if m != nil {
-		return m.CredentialId
+		return m.Asset
+	}
+	return DIDAsset{}
+}
+
+func (m *DIDAssetInfo) GetCurrentOwner() string {
+	if m != nil {
+		return m.CurrentOwner
 	}
 	return ""
 }
 
-// QueryGetVerifiableCredentialResponse is the response type for the
-// Query/GetVerifiableCredential RPC method.
-type QueryGetVerifiableCredentialResponse struct {
-	// credential is the retrieved verifiable credential
-	Credential *VerifiableCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+func (m *DIDAssetInfo) GetOwned() bool {
+	if m != nil {
+		return m.Owned
+	}
+	return false
 }
 
-func (m *QueryGetVerifiableCredentialResponse) Reset()         { *m = QueryGetVerifiableCredentialResponse{} }
-func (m *QueryGetVerifiableCredentialResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetVerifiableCredentialResponse) ProtoMessage()    {}
-func (*QueryGetVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
+// QueryListDIDDocumentsRequest is the request type for the
+// Query/ListDIDDocuments RPC method.
+type QueryListDIDDocumentsRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListDIDDocumentsRequest) Reset()         { *m = QueryListDIDDocumentsRequest{} }
+func (m *QueryListDIDDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDIDDocumentsRequest) ProtoMessage()    {}
+func (*QueryListDIDDocumentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{15}
 }
-func (m *QueryGetVerifiableCredentialResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListDIDDocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetVerifiableCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListDIDDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetVerifiableCredentialResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListDIDDocumentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -819,50 +844,46 @@ func (m *QueryGetVerifiableCredentialResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryGetVerifiableCredentialResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetVerifiableCredentialResponse.Merge(m, src)
+func (m *QueryListDIDDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDIDDocumentsRequest.Merge(m, src)
 }
-func (m *QueryGetVerifiableCredentialResponse) XXX_Size() int {
+func (m *QueryListDIDDocumentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetVerifiableCredentialResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetVerifiableCredentialResponse.DiscardUnknown(m)
+func (m *QueryListDIDDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDIDDocumentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetVerifiableCredentialResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListDIDDocumentsRequest proto.InternalMessageInfo
 
-func (m *QueryGetVerifiableCredentialResponse) GetCredential() *VerifiableCredential {
+func (m *QueryListDIDDocumentsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Credential
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryListVerifiableCredentialsRequest is the request type for the
-// Query/ListVerifiableCredentials RPC method.
-type QueryListVerifiableCredentialsRequest struct {
-	// pagination defines an optional pagination for the request
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// issuer filters by issuer DID (optional)
-	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// holder filters by holder DID (optional)
-	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
-	// include_revoked includes revoked credentials (default: false)
-	IncludeRevoked bool `protobuf:"varint,4,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+// QueryListDIDDocumentsResponse is the response type for the
+// Query/ListDIDDocuments RPC method.
+type QueryListDIDDocumentsResponse struct {
+	// did_documents is the list of DID documents
+	DidDocuments []*DIDDocument `protobuf:"bytes,1,rep,name=did_documents,json=didDocuments,proto3" json:"did_documents,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListVerifiableCredentialsRequest) Reset()         { *m = QueryListVerifiableCredentialsRequest{} }
-func (m *QueryListVerifiableCredentialsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListVerifiableCredentialsRequest) ProtoMessage()    {}
-func (*QueryListVerifiableCredentialsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryListDIDDocumentsResponse) Reset()         { *m = QueryListDIDDocumentsResponse{} }
+func (m *QueryListDIDDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDIDDocumentsResponse) ProtoMessage()    {}
+func (*QueryListDIDDocumentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{16}
 }
-func (m *QueryListVerifiableCredentialsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListDIDDocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListVerifiableCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListDIDDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListVerifiableCredentialsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListDIDDocumentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,69 +893,55 @@ func (m *QueryListVerifiableCredentialsRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryListVerifiableCredentialsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListVerifiableCredentialsRequest.Merge(m, src)
+func (m *QueryListDIDDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDIDDocumentsResponse.Merge(m, src)
 }
-func (m *QueryListVerifiableCredentialsRequest) XXX_Size() int {
+func (m *QueryListDIDDocumentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListVerifiableCredentialsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListVerifiableCredentialsRequest.DiscardUnknown(m)
+func (m *QueryListDIDDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDIDDocumentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListVerifiableCredentialsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListDIDDocumentsResponse proto.InternalMessageInfo
 
-func (m *QueryListVerifiableCredentialsRequest) GetPagination() *query.PageRequest {
+func (m *QueryListDIDDocumentsResponse) GetDidDocuments() []*DIDDocument {
 	if m != nil {
-		return m.Pagination
+		return m.DidDocuments
 	}
 	return nil
 }
 
-func (m *QueryListVerifiableCredentialsRequest) GetIssuer() string {
-	if m != nil {
-		return m.Issuer
-	}
-	return ""
-}
-
-func (m *QueryListVerifiableCredentialsRequest) GetHolder() string {
-	if m != nil {
-		return m.Holder
-	}
-	return ""
-}
-
-func (m *QueryListVerifiableCredentialsRequest) GetIncludeRevoked() bool {
+func (m *QueryListDIDDocumentsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.IncludeRevoked
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-// QueryListVerifiableCredentialsResponse is the response type for the
-// Query/ListVerifiableCredentials RPC method.
-type QueryListVerifiableCredentialsResponse struct {
-	// credentials is the list of verifiable credentials
-	Credentials []*VerifiableCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
-	// pagination defines the pagination in the response
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetDIDDocumentsByControllerRequest is the request type for the
+// Query/GetDIDDocumentsByController RPC method.
+type QueryGetDIDDocumentsByControllerRequest struct {
+	// controller is the controller to search for
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListVerifiableCredentialsResponse) Reset() {
-	*m = QueryListVerifiableCredentialsResponse{}
+func (m *QueryGetDIDDocumentsByControllerRequest) Reset() {
+	*m = QueryGetDIDDocumentsByControllerRequest{}
 }
-func (m *QueryListVerifiableCredentialsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListVerifiableCredentialsResponse) ProtoMessage()    {}
-func (*QueryListVerifiableCredentialsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDDocumentsByControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDDocumentsByControllerRequest) ProtoMessage()    {}
+func (*QueryGetDIDDocumentsByControllerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{17}
 }
-func (m *QueryListVerifiableCredentialsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListVerifiableCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListVerifiableCredentialsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -944,58 +951,55 @@ func (m *QueryListVerifiableCredentialsResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryListVerifiableCredentialsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListVerifiableCredentialsResponse.Merge(m, src)
+func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest.Merge(m, src)
 }
-func (m *QueryListVerifiableCredentialsResponse) XXX_Size() int {
+func (m *QueryGetDIDDocumentsByControllerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListVerifiableCredentialsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListVerifiableCredentialsResponse.DiscardUnknown(m)
+func (m *QueryGetDIDDocumentsByControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListVerifiableCredentialsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDIDDocumentsByControllerRequest proto.InternalMessageInfo
 
-func (m *QueryListVerifiableCredentialsResponse) GetCredentials() []*VerifiableCredential {
+func (m *QueryGetDIDDocumentsByControllerRequest) GetController() string {
 	if m != nil {
-		return m.Credentials
+		return m.Controller
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryListVerifiableCredentialsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetDIDDocumentsByControllerRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// CredentialInfo wraps credential data with vault status
-type CredentialInfo struct {
-	// credential can be either verifiable or WebAuthn
-	//
-	// Types that are valid to be assigned to Credential:
-	//	*CredentialInfo_VerifiableCredential
-	//	*CredentialInfo_WebauthnCredential
-	Credential isCredentialInfo_Credential `protobuf_oneof:"credential"`
-	// vault_id indicates if stored in vault (empty if not)
-	VaultId string `protobuf:"bytes,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
-	// is_encrypted indicates if encrypted in vault
-	IsEncrypted bool `protobuf:"varint,4,opt,name=is_encrypted,json=isEncrypted,proto3" json:"is_encrypted,omitempty"`
+// QueryGetDIDDocumentsByControllerResponse is the response type for the
+// Query/GetDIDDocumentsByController RPC method.
+type QueryGetDIDDocumentsByControllerResponse struct {
+	// did_documents is the list of DID documents controlled by the controller
+	DidDocuments []*DIDDocument `protobuf:"bytes,1,rep,name=did_documents,json=didDocuments,proto3" json:"did_documents,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
-func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
-func (*CredentialInfo) ProtoMessage()    {}
-func (*CredentialInfo) Descriptor() ([]byte, []int) {
+func (m *QueryGetDIDDocumentsByControllerResponse) Reset() {
+	*m = QueryGetDIDDocumentsByControllerResponse{}
+}
+func (m *QueryGetDIDDocumentsByControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDIDDocumentsByControllerResponse) ProtoMessage()    {}
+func (*QueryGetDIDDocumentsByControllerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae1fa9bb626e2869, []int{18}
 }
-func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1005,104 +1009,107 @@ func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *CredentialInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CredentialInfo.Merge(m, src)
+func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse.Merge(m, src)
 }
-func (m *CredentialInfo) XXX_Size() int {
+func (m *QueryGetDIDDocumentsByControllerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CredentialInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
-
-type isCredentialInfo_Credential interface {
-	isCredentialInfo_Credential()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type CredentialInfo_VerifiableCredential struct {
-	VerifiableCredential *VerifiableCredential `protobuf:"bytes,1,opt,name=verifiable_credential,json=verifiableCredential,proto3,oneof" json:"verifiable_credential,omitempty"`
-}
-type CredentialInfo_WebauthnCredential struct {
-	WebauthnCredential *WebAuthnCredential `protobuf:"bytes,2,opt,name=webauthn_credential,json=webauthnCredential,proto3,oneof" json:"webauthn_credential,omitempty"`
+func (m *QueryGetDIDDocumentsByControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse.DiscardUnknown(m)
 }
 
-func (*CredentialInfo_VerifiableCredential) isCredentialInfo_Credential() {}
-func (*CredentialInfo_WebauthnCredential) isCredentialInfo_Credential()   {}
+var xxx_messageInfo_QueryGetDIDDocumentsByControllerResponse proto.InternalMessageInfo
 
-func (m *CredentialInfo) GetCredential() isCredentialInfo_Credential {
+func (m *QueryGetDIDDocumentsByControllerResponse) GetDidDocuments() []*DIDDocument {
 	if m != nil {
-		return m.Credential
+		return m.DidDocuments
 	}
 	return nil
 }
 
-func (m *CredentialInfo) GetVerifiableCredential() *VerifiableCredential {
-	if x, ok := m.GetCredential().(*CredentialInfo_VerifiableCredential); ok {
-		return x.VerifiableCredential
+func (m *QueryGetDIDDocumentsByControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *CredentialInfo) GetWebauthnCredential() *WebAuthnCredential {
-	if x, ok := m.GetCredential().(*CredentialInfo_WebauthnCredential); ok {
-		return x.WebauthnCredential
+// QueryGetVerificationMethodRequest is the request type for the
+// Query/GetVerificationMethod RPC method.
+type QueryGetVerificationMethodRequest struct {
+	// did is the DID that contains the verification method
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// method_id is the ID of the verification method
+	MethodId string `protobuf:"bytes,2,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty"`
+}
+
+func (m *QueryGetVerificationMethodRequest) Reset()         { *m = QueryGetVerificationMethodRequest{} }
+func (m *QueryGetVerificationMethodRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerificationMethodRequest) ProtoMessage()    {}
+func (*QueryGetVerificationMethodRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{19}
+}
+func (m *QueryGetVerificationMethodRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerificationMethodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerificationMethodRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QueryGetVerificationMethodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerificationMethodRequest.Merge(m, src)
+}
+func (m *QueryGetVerificationMethodRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerificationMethodRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerificationMethodRequest.DiscardUnknown(m)
 }
 
-func (m *CredentialInfo) GetVaultId() string {
+var xxx_messageInfo_QueryGetVerificationMethodRequest proto.InternalMessageInfo
+
+func (m *QueryGetVerificationMethodRequest) GetDid() string {
 	if m != nil {
-		return m.VaultId
+		return m.Did
 	}
 	return ""
 }
 
-func (m *CredentialInfo) GetIsEncrypted() bool {
+func (m *QueryGetVerificationMethodRequest) GetMethodId() string {
 	if m != nil {
-		return m.IsEncrypted
-	}
-	return false
-}
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CredentialInfo) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CredentialInfo_VerifiableCredential)(nil),
-		(*CredentialInfo_WebauthnCredential)(nil),
+		return m.MethodId
 	}
+	return ""
 }
 
-// QueryGetCredentialsByDIDRequest is the request type for the
-// Query/GetCredentialsByDID RPC method.
-type QueryGetCredentialsByDIDRequest struct {
-	// did is the DID to retrieve all credentials for
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// include_verifiable includes verifiable credentials (default: true)
-	IncludeVerifiable bool `protobuf:"varint,2,opt,name=include_verifiable,json=includeVerifiable,proto3" json:"include_verifiable,omitempty"`
-	// include_webauthn includes WebAuthn credentials (default: true)
-	IncludeWebauthn bool `protobuf:"varint,3,opt,name=include_webauthn,json=includeWebauthn,proto3" json:"include_webauthn,omitempty"`
-	// include_revoked includes revoked credentials (default: false)
-	IncludeRevoked bool `protobuf:"varint,4,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
-	// pagination defines an optional pagination for the request
-	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetVerificationMethodResponse is the response type for the
+// Query/GetVerificationMethod RPC method.
+type QueryGetVerificationMethodResponse struct {
+	// verification_method is the retrieved verification method
+	VerificationMethod *VerificationMethod `protobuf:"bytes,1,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
 }
 
-func (m *QueryGetCredentialsByDIDRequest) Reset()         { *m = QueryGetCredentialsByDIDRequest{} }
-func (m *QueryGetCredentialsByDIDRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCredentialsByDIDRequest) ProtoMessage()    {}
-func (*QueryGetCredentialsByDIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae1fa9bb626e2869, []int{19}
+func (m *QueryGetVerificationMethodResponse) Reset()         { *m = QueryGetVerificationMethodResponse{} }
+func (m *QueryGetVerificationMethodResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerificationMethodResponse) ProtoMessage()    {}
+func (*QueryGetVerificationMethodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{20}
 }
-func (m *QueryGetCredentialsByDIDRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetVerificationMethodResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCredentialsByDIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetVerificationMethodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCredentialsByDIDRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetVerificationMethodResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,74 +1119,100 @@ func (m *QueryGetCredentialsByDIDRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCredentialsByDIDRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCredentialsByDIDRequest.Merge(m, src)
+func (m *QueryGetVerificationMethodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerificationMethodResponse.Merge(m, src)
 }
-func (m *QueryGetCredentialsByDIDRequest) XXX_Size() int {
+func (m *QueryGetVerificationMethodResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCredentialsByDIDRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCredentialsByDIDRequest.DiscardUnknown(m)
+func (m *QueryGetVerificationMethodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerificationMethodResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCredentialsByDIDRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetVerificationMethodResponse proto.InternalMessageInfo
 
-func (m *QueryGetCredentialsByDIDRequest) GetDid() string {
+func (m *QueryGetVerificationMethodResponse) GetVerificationMethod() *VerificationMethod {
 	if m != nil {
-		return m.Did
+		return m.VerificationMethod
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryGetCredentialsByDIDRequest) GetIncludeVerifiable() bool {
-	if m != nil {
-		return m.IncludeVerifiable
-	}
-	return false
+// QueryGetServiceRequest is the request type for the Query/GetService RPC
+// method.
+type QueryGetServiceRequest struct {
+	// did is the DID that contains the service
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// service_id is the ID of the service
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (m *QueryGetCredentialsByDIDRequest) GetIncludeWebauthn() bool {
-	if m != nil {
-		return m.IncludeWebauthn
+func (m *QueryGetServiceRequest) Reset()         { *m = QueryGetServiceRequest{} }
+func (m *QueryGetServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetServiceRequest) ProtoMessage()    {}
+func (*QueryGetServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{21}
+}
+func (m *QueryGetServiceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetServiceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *QueryGetServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetServiceRequest.Merge(m, src)
+}
+func (m *QueryGetServiceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetServiceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetCredentialsByDIDRequest) GetIncludeRevoked() bool {
+var xxx_messageInfo_QueryGetServiceRequest proto.InternalMessageInfo
+
+func (m *QueryGetServiceRequest) GetDid() string {
 	if m != nil {
-		return m.IncludeRevoked
+		return m.Did
 	}
-	return false
+	return ""
 }
 
-func (m *QueryGetCredentialsByDIDRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetServiceRequest) GetServiceId() string {
 	if m != nil {
-		return m.Pagination
+		return m.ServiceId
 	}
-	return nil
+	return ""
 }
 
-// QueryGetCredentialsByDIDResponse is the response type for the
-// Query/GetCredentialsByDID RPC method.
-type QueryGetCredentialsByDIDResponse struct {
-	// credentials is the list of all credentials associated with the DID
-	Credentials []*CredentialInfo `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
-	// pagination defines the pagination in the response
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetServiceResponse is the response type for the Query/GetService
+// RPC method.
+type QueryGetServiceResponse struct {
+	// service is the retrieved service
+	Service *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *QueryGetCredentialsByDIDResponse) Reset()         { *m = QueryGetCredentialsByDIDResponse{} }
-func (m *QueryGetCredentialsByDIDResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCredentialsByDIDResponse) ProtoMessage()    {}
-func (*QueryGetCredentialsByDIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae1fa9bb626e2869, []int{20}
+func (m *QueryGetServiceResponse) Reset()         { *m = QueryGetServiceResponse{} }
+func (m *QueryGetServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetServiceResponse) ProtoMessage()    {}
+func (*QueryGetServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{22}
 }
-func (m *QueryGetCredentialsByDIDResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCredentialsByDIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCredentialsByDIDResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetServiceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1189,51 +1222,44 @@ func (m *QueryGetCredentialsByDIDResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCredentialsByDIDResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCredentialsByDIDResponse.Merge(m, src)
+func (m *QueryGetServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetServiceResponse.Merge(m, src)
 }
-func (m *QueryGetCredentialsByDIDResponse) XXX_Size() int {
+func (m *QueryGetServiceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCredentialsByDIDResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCredentialsByDIDResponse.DiscardUnknown(m)
+func (m *QueryGetServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetServiceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCredentialsByDIDResponse proto.InternalMessageInfo
-
-func (m *QueryGetCredentialsByDIDResponse) GetCredentials() []*CredentialInfo {
-	if m != nil {
-		return m.Credentials
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetServiceResponse proto.InternalMessageInfo
 
-func (m *QueryGetCredentialsByDIDResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetServiceResponse) GetService() *Service {
 	if m != nil {
-		return m.Pagination
+		return m.Service
 	}
 	return nil
 }
 
-// QueryRegisterStartRequest is the request type for the
-// Query/RegisterStart RPC method.
-type QueryRegisterStartRequest struct {
-	// assertion_did is the DID to register (did:sonr:email:<blake3> or did:sonr:phone:<blake3>)
-	AssertionDid string `protobuf:"bytes,1,opt,name=assertion_did,json=assertionDid,proto3" json:"assertion_did,omitempty"`
+// QueryGetVerifiableCredentialRequest is the request type for the
+// Query/GetVerifiableCredential RPC method.
+type QueryGetVerifiableCredentialRequest struct {
+	// credential_id is the ID of the credential to retrieve
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 }
 
-func (m *QueryRegisterStartRequest) Reset()         { *m = QueryRegisterStartRequest{} }
-func (m *QueryRegisterStartRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRegisterStartRequest) ProtoMessage()    {}
-func (*QueryRegisterStartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae1fa9bb626e2869, []int{21}
+func (m *QueryGetVerifiableCredentialRequest) Reset()         { *m = QueryGetVerifiableCredentialRequest{} }
+func (m *QueryGetVerifiableCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerifiableCredentialRequest) ProtoMessage()    {}
+func (*QueryGetVerifiableCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{23}
 }
-func (m *QueryRegisterStartRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetVerifiableCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRegisterStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetVerifiableCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRegisterStartRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetVerifiableCredentialRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1243,48 +1269,44 @@ func (m *QueryRegisterStartRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryRegisterStartRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRegisterStartRequest.Merge(m, src)
+func (m *QueryGetVerifiableCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerifiableCredentialRequest.Merge(m, src)
 }
-func (m *QueryRegisterStartRequest) XXX_Size() int {
+func (m *QueryGetVerifiableCredentialRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRegisterStartRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRegisterStartRequest.DiscardUnknown(m)
+func (m *QueryGetVerifiableCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerifiableCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRegisterStartRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetVerifiableCredentialRequest proto.InternalMessageInfo
 
-func (m *QueryRegisterStartRequest) GetAssertionDid() string {
+func (m *QueryGetVerifiableCredentialRequest) GetCredentialId() string {
 	if m != nil {
-		return m.AssertionDid
+		return m.CredentialId
 	}
 	return ""
 }
 
-// QueryRegisterStartResponse is the response type for the
-// Query/RegisterStart RPC method.
-type QueryRegisterStartResponse struct {
-	// challenge for the attestation ceremony (32 bytes)
-	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	// relying_party_id identifier
-	RelyingPartyId string `protobuf:"bytes,2,opt,name=relying_party_id,json=relyingPartyId,proto3" json:"relying_party_id,omitempty"`
-	// user information (id, name, displayName)
-	User map[string]string `protobuf:"bytes,3,rep,name=user,proto3" json:"user,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// QueryGetVerifiableCredentialResponse is the response type for the
+// Query/GetVerifiableCredential RPC method.
+type QueryGetVerifiableCredentialResponse struct {
+	// credential is the retrieved verifiable credential
+	Credential *VerifiableCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
-func (m *QueryRegisterStartResponse) Reset()         { *m = QueryRegisterStartResponse{} }
-func (m *QueryRegisterStartResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRegisterStartResponse) ProtoMessage()    {}
-func (*QueryRegisterStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae1fa9bb626e2869, []int{22}
+func (m *QueryGetVerifiableCredentialResponse) Reset()         { *m = QueryGetVerifiableCredentialResponse{} }
+func (m *QueryGetVerifiableCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerifiableCredentialResponse) ProtoMessage()    {}
+func (*QueryGetVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{24}
 }
-func (m *QueryRegisterStartResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetVerifiableCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRegisterStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetVerifiableCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRegisterStartResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetVerifiableCredentialResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1294,58 +1316,44 @@ func (m *QueryRegisterStartResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryRegisterStartResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRegisterStartResponse.Merge(m, src)
+func (m *QueryGetVerifiableCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerifiableCredentialResponse.Merge(m, src)
 }
-func (m *QueryRegisterStartResponse) XXX_Size() int {
+func (m *QueryGetVerifiableCredentialResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRegisterStartResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRegisterStartResponse.DiscardUnknown(m)
+func (m *QueryGetVerifiableCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerifiableCredentialResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRegisterStartResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetVerifiableCredentialResponse proto.InternalMessageInfo
 
-func (m *QueryRegisterStartResponse) GetChallenge() []byte {
-	if m != nil {
-		return m.Challenge
-	}
-	return nil
-}
-
-func (m *QueryRegisterStartResponse) GetRelyingPartyId() string {
-	if m != nil {
-		return m.RelyingPartyId
-	}
-	return ""
-}
-
-func (m *QueryRegisterStartResponse) GetUser() map[string]string {
+func (m *QueryGetVerifiableCredentialResponse) GetCredential() *VerifiableCredential {
 	if m != nil {
-		return m.User
+		return m.Credential
 	}
 	return nil
 }
 
-// QueryLoginStartRequest is the request type for the
-// Query/LoginStart RPC method.
-type QueryLoginStartRequest struct {
-	// assertion_did is the assertion DID (did:sonr:email:<blake3> or did:sonr:phone:<blake3>)
-	AssertionDid string `protobuf:"bytes,1,opt,name=assertion_did,json=assertionDid,proto3" json:"assertion_did,omitempty"`
+// QueryVerifyCredentialRequest is the request type for the
+// Query/VerifyCredential RPC method.
+type QueryVerifyCredentialRequest struct {
+	// credential_id is the ID of the credential to verify
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 }
 
-func (m *QueryLoginStartRequest) Reset()         { *m = QueryLoginStartRequest{} }
-func (m *QueryLoginStartRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLoginStartRequest) ProtoMessage()    {}
-func (*QueryLoginStartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae1fa9bb626e2869, []int{23}
+func (m *QueryVerifyCredentialRequest) Reset()         { *m = QueryVerifyCredentialRequest{} }
+func (m *QueryVerifyCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCredentialRequest) ProtoMessage()    {}
+func (*QueryVerifyCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{25}
 }
-func (m *QueryLoginStartRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVerifyCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLoginStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLoginStartRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyCredentialRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1355,48 +1363,54 @@ func (m *QueryLoginStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryLoginStartRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLoginStartRequest.Merge(m, src)
+func (m *QueryVerifyCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCredentialRequest.Merge(m, src)
 }
-func (m *QueryLoginStartRequest) XXX_Size() int {
+func (m *QueryVerifyCredentialRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLoginStartRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLoginStartRequest.DiscardUnknown(m)
+func (m *QueryVerifyCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLoginStartRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryVerifyCredentialRequest proto.InternalMessageInfo
 
-func (m *QueryLoginStartRequest) GetAssertionDid() string {
+func (m *QueryVerifyCredentialRequest) GetCredentialId() string {
 	if m != nil {
-		return m.AssertionDid
+		return m.CredentialId
 	}
 	return ""
 }
 
-// QueryLoginStartResponse is the response type for the
-// Query/LoginStart RPC method.
-type QueryLoginStartResponse struct {
-	// credential_ids associated with this assertion
-	CredentialIds []string `protobuf:"bytes,1,rep,name=credential_ids,json=credentialIds,proto3" json:"credential_ids,omitempty"`
-	// challenge for the assertion ceremony (32 bytes)
-	Challenge []byte `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	// relying_party_id identifier
-	RelyingPartyId string `protobuf:"bytes,3,opt,name=relying_party_id,json=relyingPartyId,proto3" json:"relying_party_id,omitempty"`
-}
-
-func (m *QueryLoginStartResponse) Reset()         { *m = QueryLoginStartResponse{} }
-func (m *QueryLoginStartResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLoginStartResponse) ProtoMessage()    {}
-func (*QueryLoginStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae1fa9bb626e2869, []int{24}
-}
-func (m *QueryLoginStartResponse) XXX_Unmarshal(b []byte) error {
+// QueryVerifyCredentialResponse is the response type for the
+// Query/VerifyCredential RPC method.
+type QueryVerifyCredentialResponse struct {
+	// valid is set when the proof verifies and the credential is neither
+	// revoked nor expired
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// proof_verified is set when every proof verifies against the issuer's
+	// assertion methods
+	ProofVerified bool `protobuf:"varint,2,opt,name=proof_verified,json=proofVerified,proto3" json:"proof_verified,omitempty"`
+	// revoked is set when the credential's status list entry is set
+	Revoked bool `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// expired is set when the expiration date has passed
+	Expired bool `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// reason explains why the credential is not valid
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryVerifyCredentialResponse) Reset()         { *m = QueryVerifyCredentialResponse{} }
+func (m *QueryVerifyCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCredentialResponse) ProtoMessage()    {}
+func (*QueryVerifyCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{26}
+}
+func (m *QueryVerifyCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLoginStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLoginStartResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyCredentialResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1406,2214 +1420,5557 @@ func (m *QueryLoginStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryLoginStartResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLoginStartResponse.Merge(m, src)
+func (m *QueryVerifyCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCredentialResponse.Merge(m, src)
 }
-func (m *QueryLoginStartResponse) XXX_Size() int {
+func (m *QueryVerifyCredentialResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLoginStartResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLoginStartResponse.DiscardUnknown(m)
+func (m *QueryVerifyCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCredentialResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLoginStartResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVerifyCredentialResponse proto.InternalMessageInfo
 
-func (m *QueryLoginStartResponse) GetCredentialIds() []string {
+func (m *QueryVerifyCredentialResponse) GetValid() bool {
 	if m != nil {
-		return m.CredentialIds
+		return m.Valid
 	}
-	return nil
+	return false
 }
 
-func (m *QueryLoginStartResponse) GetChallenge() []byte {
+func (m *QueryVerifyCredentialResponse) GetProofVerified() bool {
 	if m != nil {
-		return m.Challenge
+		return m.ProofVerified
 	}
-	return nil
+	return false
 }
 
-func (m *QueryLoginStartResponse) GetRelyingPartyId() string {
+func (m *QueryVerifyCredentialResponse) GetRevoked() bool {
 	if m != nil {
-		return m.RelyingPartyId
+		return m.Revoked
 	}
-	return ""
+	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "did.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "did.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryResolveDIDRequest)(nil), "did.v1.QueryResolveDIDRequest")
-	proto.RegisterType((*QueryResolveDIDResponse)(nil), "did.v1.QueryResolveDIDResponse")
-	proto.RegisterType((*QueryGetDIDDocumentRequest)(nil), "did.v1.QueryGetDIDDocumentRequest")
-	proto.RegisterType((*QueryGetDIDDocumentResponse)(nil), "did.v1.QueryGetDIDDocumentResponse")
-	proto.RegisterType((*QueryListDIDDocumentsRequest)(nil), "did.v1.QueryListDIDDocumentsRequest")
-	proto.RegisterType((*QueryListDIDDocumentsResponse)(nil), "did.v1.QueryListDIDDocumentsResponse")
-	proto.RegisterType((*QueryGetDIDDocumentsByControllerRequest)(nil), "did.v1.QueryGetDIDDocumentsByControllerRequest")
-	proto.RegisterType((*QueryGetDIDDocumentsByControllerResponse)(nil), "did.v1.QueryGetDIDDocumentsByControllerResponse")
-	proto.RegisterType((*QueryGetVerificationMethodRequest)(nil), "did.v1.QueryGetVerificationMethodRequest")
-	proto.RegisterType((*QueryGetVerificationMethodResponse)(nil), "did.v1.QueryGetVerificationMethodResponse")
-	proto.RegisterType((*QueryGetServiceRequest)(nil), "did.v1.QueryGetServiceRequest")
-	proto.RegisterType((*QueryGetServiceResponse)(nil), "did.v1.QueryGetServiceResponse")
-	proto.RegisterType((*QueryGetVerifiableCredentialRequest)(nil), "did.v1.QueryGetVerifiableCredentialRequest")
-	proto.RegisterType((*QueryGetVerifiableCredentialResponse)(nil), "did.v1.QueryGetVerifiableCredentialResponse")
-	proto.RegisterType((*QueryListVerifiableCredentialsRequest)(nil), "did.v1.QueryListVerifiableCredentialsRequest")
-	proto.RegisterType((*QueryListVerifiableCredentialsResponse)(nil), "did.v1.QueryListVerifiableCredentialsResponse")
-	proto.RegisterType((*CredentialInfo)(nil), "did.v1.CredentialInfo")
-	proto.RegisterType((*QueryGetCredentialsByDIDRequest)(nil), "did.v1.QueryGetCredentialsByDIDRequest")
-	proto.RegisterType((*QueryGetCredentialsByDIDResponse)(nil), "did.v1.QueryGetCredentialsByDIDResponse")
-	proto.RegisterType((*QueryRegisterStartRequest)(nil), "did.v1.QueryRegisterStartRequest")
-	proto.RegisterType((*QueryRegisterStartResponse)(nil), "did.v1.QueryRegisterStartResponse")
-	proto.RegisterMapType((map[string]string)(nil), "did.v1.QueryRegisterStartResponse.UserEntry")
-	proto.RegisterType((*QueryLoginStartRequest)(nil), "did.v1.QueryLoginStartRequest")
-	proto.RegisterType((*QueryLoginStartResponse)(nil), "did.v1.QueryLoginStartResponse")
+func (m *QueryVerifyCredentialResponse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("did/v1/query.proto", fileDescriptor_ae1fa9bb626e2869) }
-
-var fileDescriptor_ae1fa9bb626e2869 = []byte{
-	// 1479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x3a, 0x6d, 0x1a, 0xbf, 0x71, 0xdc, 0x74, 0x9c, 0xa4, 0xc9, 0x26, 0x71, 0xdb, 0x4d,
-	0xd3, 0x7c, 0xb4, 0xf1, 0x92, 0x22, 0xa0, 0x42, 0x14, 0x95, 0xd4, 0xa5, 0x18, 0x5a, 0x51, 0xb6,
-	0x82, 0x0a, 0x2e, 0xd6, 0xda, 0x33, 0x75, 0x96, 0x6e, 0x76, 0xdd, 0x9d, 0xb5, 0xc1, 0x44, 0x91,
-	0x10, 0x27, 0x6e, 0x80, 0x38, 0x70, 0x45, 0x48, 0xe5, 0xc6, 0x01, 0xf1, 0x27, 0x7a, 0xac, 0xd4,
-	0x0b, 0x37, 0x50, 0xcb, 0xef, 0x40, 0x68, 0xe7, 0xc3, 0xfb, 0xe1, 0x5d, 0x27, 0xad, 0x8a, 0xd4,
-	0x93, 0x77, 0x9f, 0x79, 0x3f, 0x9e, 0x79, 0xe7, 0xdd, 0x99, 0x67, 0x0c, 0x08, 0x5b, 0x58, 0xef,
-	0x6e, 0xe9, 0xf7, 0x3b, 0xc4, 0xeb, 0x55, 0xda, 0x9e, 0xeb, 0xbb, 0x68, 0x0c, 0x5b, 0xb8, 0xd2,
-	0xdd, 0x52, 0x37, 0x9a, 0x2e, 0xdd, 0x75, 0xa9, 0xde, 0x30, 0x29, 0xe1, 0x06, 0x7a, 0x77, 0xab,
-	0x41, 0x7c, 0x73, 0x4b, 0x6f, 0x9b, 0x2d, 0xcb, 0x31, 0x7d, 0xcb, 0x75, 0xb8, 0x8f, 0x3a, 0x2d,
-	0xe2, 0xb4, 0x88, 0x43, 0xa8, 0x45, 0x05, 0x2a, 0xa3, 0x53, 0xdf, 0xf4, 0x49, 0x02, 0xf3, 0x7b,
-	0x6d, 0x22, 0xed, 0x16, 0x5b, 0xae, 0xdb, 0xb2, 0x89, 0x6e, 0xb6, 0x2d, 0xdd, 0x74, 0x1c, 0xd7,
-	0x67, 0xa1, 0xe5, 0xe8, 0x74, 0xcb, 0x6d, 0xb9, 0xec, 0x51, 0x0f, 0x9e, 0x38, 0xaa, 0x4d, 0x03,
-	0xfa, 0x28, 0xe0, 0x74, 0xcb, 0xf4, 0xcc, 0x5d, 0x6a, 0x90, 0xfb, 0x1d, 0x42, 0x7d, 0xed, 0x32,
-	0x94, 0x62, 0x28, 0x6d, 0xbb, 0x0e, 0x25, 0xe8, 0x1c, 0x8c, 0xb5, 0x19, 0x32, 0xa7, 0x9c, 0x56,
-	0xd6, 0x26, 0x2e, 0x16, 0x2b, 0x7c, 0x8e, 0x15, 0x61, 0x27, 0x46, 0xb5, 0x0d, 0x98, 0x65, 0xee,
-	0x06, 0xa1, 0xae, 0xdd, 0x25, 0xd5, 0x5a, 0x55, 0x04, 0x46, 0x53, 0x30, 0x8a, 0x2d, 0xcc, 0xdc,
-	0xf3, 0x46, 0xf0, 0xa8, 0xfd, 0xa2, 0xc0, 0xc9, 0x01, 0x63, 0x91, 0xef, 0x75, 0x28, 0x60, 0x0b,
-	0xd7, 0xb1, 0xdb, 0xec, 0xec, 0x12, 0xc7, 0x17, 0x59, 0x4b, 0x32, 0x6b, 0xb5, 0x56, 0xad, 0x8a,
-	0x21, 0x63, 0x02, 0x5b, 0x58, 0xbe, 0xa0, 0x0f, 0x61, 0x26, 0xea, 0x57, 0xdf, 0x25, 0xbe, 0x89,
-	0x4d, 0xdf, 0x9c, 0xcb, 0xb1, 0x00, 0x0b, 0x29, 0x01, 0x6e, 0x0a, 0x13, 0xa3, 0x14, 0x09, 0x24,
-	0x41, 0xad, 0x02, 0x2a, 0xe3, 0x78, 0x9d, 0xf8, 0xd1, 0xa4, 0x99, 0x93, 0xfa, 0x55, 0x81, 0x85,
-	0x54, 0x87, 0x97, 0x6d, 0x62, 0x77, 0x61, 0x91, 0xf1, 0xbc, 0x61, 0xd1, 0x28, 0x51, 0xd9, 0x08,
-	0xe8, 0x5d, 0x80, 0xb0, 0x49, 0x05, 0xcd, 0x73, 0x15, 0xde, 0xd1, 0x95, 0xa0, 0xa3, 0x2b, 0xbc,
-	0xe5, 0x45, 0x47, 0x57, 0x6e, 0x99, 0x2d, 0x22, 0x7c, 0x8d, 0x88, 0x67, 0xb0, 0xca, 0x4b, 0x19,
-	0x89, 0x44, 0x49, 0x2e, 0xc1, 0x64, 0x74, 0x6a, 0x41, 0x8b, 0x8d, 0x66, 0xd5, 0xa4, 0x10, 0x99,
-	0x0a, 0x45, 0xd7, 0x63, 0x1c, 0x79, 0x25, 0x56, 0x0f, 0xe4, 0xc8, 0xd3, 0xc6, 0x48, 0xfe, 0xa0,
-	0xc0, 0x6a, 0xca, 0xaa, 0xd1, 0xed, 0xde, 0x55, 0xd7, 0xf1, 0x3d, 0xd7, 0xb6, 0x89, 0x27, 0x0b,
-	0x53, 0x06, 0x68, 0xf6, 0x41, 0xb1, 0xf4, 0x11, 0x24, 0x51, 0xb8, 0xdc, 0x73, 0x17, 0xee, 0x37,
-	0x05, 0xd6, 0x0e, 0xe6, 0xf4, 0xf2, 0xd4, 0xd0, 0x80, 0x33, 0x92, 0xee, 0x27, 0xc4, 0xb3, 0xee,
-	0x5a, 0x4d, 0x86, 0xdf, 0x24, 0xfe, 0x8e, 0x8b, 0x33, 0x3f, 0x18, 0xb4, 0x00, 0xf9, 0x5d, 0x66,
-	0x52, 0xb7, 0x30, 0x4b, 0x9f, 0x37, 0xc6, 0x39, 0x50, 0xc3, 0xda, 0x7d, 0xd0, 0x86, 0xc5, 0x14,
-	0x93, 0xff, 0x00, 0x4a, 0xdd, 0xc8, 0x68, 0x9d, 0xbb, 0x8b, 0x9e, 0x55, 0x65, 0x09, 0x52, 0x02,
-	0xa0, 0xee, 0x00, 0xa6, 0xd5, 0xc4, 0x0e, 0x76, 0x9d, 0xf8, 0xb7, 0x89, 0xd7, 0xb5, 0x9a, 0x24,
-	0x9b, 0xfb, 0x12, 0x00, 0xe5, 0x36, 0x21, 0xf9, 0xbc, 0x40, 0x6a, 0x58, 0xab, 0x8a, 0xfd, 0x2d,
-	0x1a, 0x4a, 0x50, 0x5e, 0x87, 0x63, 0xc2, 0x4e, 0xd0, 0x3c, 0x2e, 0x69, 0x4a, 0x4b, 0x39, 0xae,
-	0xbd, 0x0f, 0xcb, 0xf1, 0x1a, 0x98, 0x0d, 0x9b, 0x5c, 0xf5, 0x08, 0x26, 0x8e, 0x6f, 0x99, 0xb6,
-	0x64, 0xb7, 0x0c, 0x93, 0xcd, 0x3e, 0x58, 0xef, 0xf3, 0x2c, 0x84, 0x60, 0x0d, 0x6b, 0x18, 0xce,
-	0x0e, 0x8f, 0x25, 0xe8, 0xbd, 0x05, 0x10, 0xfa, 0x09, 0x86, 0x8b, 0xf1, 0x42, 0x26, 0x3c, 0x23,
-	0xf6, 0xda, 0x43, 0x05, 0x56, 0xfa, 0x9f, 0x7c, 0x9a, 0xf5, 0x8b, 0xde, 0x64, 0xd0, 0x2c, 0x8c,
-	0x59, 0x94, 0x76, 0x88, 0x27, 0x16, 0x41, 0xbc, 0x05, 0xf8, 0x8e, 0x6b, 0x63, 0xe2, 0xcd, 0x8d,
-	0x72, 0x9c, 0xbf, 0xa1, 0x55, 0x38, 0x6e, 0x39, 0x4d, 0xbb, 0x83, 0x49, 0xdd, 0x23, 0x5d, 0xf7,
-	0x1e, 0xc1, 0x73, 0x47, 0x4e, 0x2b, 0x6b, 0xe3, 0x46, 0x51, 0xc0, 0x06, 0x47, 0xb5, 0xdf, 0x15,
-	0x38, 0x77, 0xd0, 0x54, 0x44, 0xcd, 0xde, 0x86, 0x89, 0xb0, 0x06, 0xf2, 0x03, 0x1c, 0x5e, 0xb4,
-	0xa8, 0xc3, 0x8b, 0xfb, 0x10, 0xbf, 0xce, 0x41, 0x31, 0x4c, 0x52, 0x73, 0xee, 0xba, 0xe8, 0x36,
-	0xcc, 0x74, 0xfb, 0x04, 0xea, 0xcf, 0xb6, 0xb4, 0xef, 0x8d, 0x18, 0xd3, 0xdd, 0x14, 0x1c, 0xdd,
-	0x84, 0xd2, 0x17, 0xa4, 0x61, 0x76, 0xfc, 0x1d, 0x27, 0x1a, 0x32, 0x17, 0xff, 0xec, 0xee, 0x90,
-	0xc6, 0x3b, 0x81, 0x49, 0x2c, 0x20, 0x92, 0x8e, 0x91, 0x70, 0xf3, 0x30, 0xde, 0x35, 0x3b, 0xb6,
-	0x1f, 0xf4, 0x2e, 0x5f, 0xad, 0x63, 0xec, 0xbd, 0x86, 0xd1, 0x19, 0x28, 0x58, 0xb4, 0x4e, 0x9c,
-	0xa6, 0xd7, 0x6b, 0xfb, 0xfd, 0xb5, 0x9a, 0xb0, 0xe8, 0x35, 0x09, 0x6d, 0x17, 0xa2, 0x1d, 0xab,
-	0xfd, 0xab, 0xc0, 0x29, 0xd9, 0xe8, 0x91, 0xb5, 0xda, 0xee, 0x0d, 0x13, 0x24, 0x68, 0x13, 0x90,
-	0xec, 0x8a, 0x70, 0xc2, 0x6c, 0x3e, 0xe3, 0xc6, 0x09, 0x31, 0x12, 0x56, 0x08, 0xad, 0xc3, 0x94,
-	0x34, 0x97, 0xd3, 0x61, 0xc4, 0xc7, 0x0d, 0xd9, 0x5c, 0x77, 0x04, 0x7c, 0xe8, 0x7e, 0x4b, 0x7c,
-	0x10, 0x47, 0x9f, 0xfb, 0xf0, 0x78, 0xa0, 0xc0, 0xe9, 0xec, 0x02, 0xf4, 0x0f, 0x8d, 0x94, 0x8e,
-	0x9d, 0x95, 0x0b, 0x17, 0x6f, 0xa1, 0xff, 0xa9, 0x57, 0xaf, 0xc0, 0xbc, 0x90, 0x80, 0x2d, 0x8b,
-	0xfa, 0xc4, 0xbb, 0xed, 0x9b, 0x9e, 0x1f, 0xd9, 0xd2, 0x4c, 0x4a, 0x89, 0xc7, 0x36, 0xf5, 0x70,
-	0xad, 0x0a, 0x7d, 0xb0, 0x6a, 0x61, 0xed, 0x2f, 0x45, 0x28, 0xb4, 0x44, 0x08, 0x31, 0xc7, 0x45,
-	0xc8, 0x37, 0x77, 0x4c, 0xdb, 0x26, 0x4e, 0x8b, 0x6f, 0xb5, 0x05, 0x23, 0x04, 0xd0, 0x1a, 0x4c,
-	0x79, 0xc4, 0xee, 0x59, 0x4e, 0xab, 0xde, 0x36, 0x3d, 0xbf, 0x17, 0x6e, 0xe3, 0x45, 0x81, 0xdf,
-	0x0a, 0xe0, 0x1a, 0x46, 0x57, 0xe0, 0x48, 0x87, 0xb2, 0x7d, 0x24, 0x28, 0xd2, 0x05, 0x59, 0xa4,
-	0xec, 0xcc, 0x95, 0x8f, 0x29, 0xf1, 0xae, 0x39, 0xbe, 0xd7, 0x33, 0x98, 0xa7, 0xfa, 0x06, 0xe4,
-	0xfb, 0x50, 0xd0, 0x7c, 0xf7, 0x48, 0x4f, 0x36, 0xdf, 0x3d, 0xd2, 0x43, 0xd3, 0x70, 0xb4, 0x6b,
-	0xda, 0x1d, 0x22, 0xf2, 0xf3, 0x97, 0x37, 0x73, 0x97, 0x14, 0xed, 0xb2, 0x38, 0x91, 0x6e, 0xb8,
-	0x2d, 0xcb, 0x79, 0xf6, 0x02, 0x7d, 0x2b, 0x65, 0x76, 0xd4, 0x5f, 0x54, 0x67, 0x05, 0x8a, 0xb1,
-	0x43, 0x83, 0x37, 0x41, 0xde, 0x98, 0x8c, 0x9e, 0x1a, 0x34, 0x5e, 0xc4, 0xdc, 0x61, 0x8a, 0x38,
-	0x9a, 0x56, 0xc4, 0x8b, 0x8f, 0x0b, 0x70, 0x94, 0x51, 0x41, 0x9f, 0xc2, 0x18, 0xbf, 0x39, 0x20,
-	0x35, 0x56, 0xca, 0xd8, 0x65, 0x44, 0x5d, 0x48, 0x1d, 0xe3, 0xdc, 0xb5, 0xd9, 0x6f, 0x1e, 0xff,
-	0xf3, 0x63, 0x6e, 0x0a, 0x15, 0x75, 0x71, 0x21, 0xe2, 0x57, 0x10, 0x64, 0x03, 0x84, 0x17, 0x0a,
-	0x54, 0x4e, 0xac, 0x54, 0xe2, 0x5a, 0xa2, 0x9e, 0xca, 0x1c, 0x17, 0x69, 0x96, 0x58, 0x9a, 0x93,
-	0x68, 0x46, 0xa6, 0xf1, 0xb8, 0x8d, 0xbe, 0x87, 0x2d, 0xbc, 0x8f, 0x7a, 0x50, 0x8c, 0xeb, 0x33,
-	0xa4, 0xc5, 0x22, 0xa6, 0xde, 0x1b, 0xd4, 0xe5, 0xa1, 0x36, 0x22, 0x73, 0x99, 0x65, 0x9e, 0x43,
-	0xb3, 0x32, 0xb3, 0x54, 0x77, 0x22, 0xf5, 0x57, 0x30, 0x95, 0xd4, 0xd4, 0xe8, 0x6c, 0x2c, 0x70,
-	0x86, 0xb6, 0x57, 0x57, 0x0e, 0xb0, 0x12, 0x04, 0xe6, 0x19, 0x81, 0x12, 0x3a, 0x91, 0x24, 0x40,
-	0xd1, 0x1f, 0x0a, 0x2c, 0x0c, 0xd1, 0xa5, 0x48, 0x1f, 0x32, 0xc1, 0x34, 0x55, 0xad, 0xbe, 0x72,
-	0x78, 0x07, 0xc1, 0x6e, 0x8b, 0xb1, 0x3b, 0x8f, 0xd6, 0x07, 0xd8, 0xe9, 0xa1, 0x1a, 0xd7, 0xf7,
-	0xc2, 0xe7, 0x7d, 0xf4, 0x40, 0x81, 0x99, 0x54, 0x29, 0x89, 0xd6, 0x93, 0xe9, 0x33, 0x25, 0xac,
-	0xba, 0x71, 0x18, 0x53, 0xc1, 0xf1, 0x35, 0xc6, 0x51, 0x47, 0x9b, 0x92, 0x63, 0x54, 0x70, 0x6e,
-	0x72, 0x9d, 0xca, 0x57, 0x53, 0xdf, 0xeb, 0xab, 0xe0, 0xa0, 0xa9, 0x20, 0xd4, 0x8c, 0x89, 0x16,
-	0x1e, 0xd0, 0xa5, 0x89, 0x16, 0x1e, 0x14, 0x9b, 0xda, 0x06, 0x63, 0x71, 0x16, 0x69, 0x92, 0x85,
-	0x90, 0x96, 0x32, 0x73, 0xa8, 0x61, 0xf7, 0xd1, 0xcf, 0x0a, 0x9c, 0xcc, 0x50, 0x87, 0xe8, 0x7c,
-	0xfa, 0xcc, 0x53, 0xf5, 0xa8, 0x7a, 0xe1, 0x70, 0xc6, 0x59, 0x14, 0xc3, 0x0d, 0x48, 0xdf, 0x8b,
-	0x6d, 0x51, 0xfb, 0xe8, 0x27, 0x05, 0xe6, 0x33, 0xe5, 0x18, 0xda, 0x1c, 0xe8, 0xed, 0x61, 0x0a,
-	0x54, 0xad, 0x1c, 0xd6, 0x5c, 0x10, 0x5d, 0x60, 0x44, 0x67, 0x50, 0x69, 0x90, 0x28, 0x45, 0xdf,
-	0x29, 0x50, 0x4a, 0x39, 0x70, 0xd1, 0x6a, 0xb2, 0x16, 0x19, 0x9a, 0x44, 0x5d, 0x3b, 0xd8, 0x50,
-	0xf0, 0x58, 0x61, 0x3c, 0x4e, 0xa1, 0xa5, 0x14, 0x1e, 0x0c, 0xe2, 0x7b, 0x44, 0x17, 0x26, 0x63,
-	0xa7, 0x13, 0x3a, 0x33, 0xec, 0xe4, 0xe2, 0x24, 0xb4, 0x83, 0x0f, 0x37, 0xb9, 0x37, 0x69, 0xb3,
-	0xe1, 0xae, 0xc8, 0xcd, 0x74, 0xca, 0xd2, 0x7c, 0x0e, 0x10, 0x1e, 0x37, 0x89, 0x0e, 0x1e, 0x38,
-	0xc7, 0x12, 0x1d, 0x3c, 0x78, 0x4e, 0xc9, 0xaa, 0x6b, 0xfd, 0xaa, 0xdb, 0x81, 0x0d, 0xcf, 0xb5,
-	0x7d, 0xf9, 0xe1, 0x93, 0xb2, 0xf2, 0xe8, 0x49, 0x59, 0xf9, 0xfb, 0x49, 0x59, 0xf9, 0xfe, 0x69,
-	0x79, 0xe4, 0xd1, 0xd3, 0xf2, 0xc8, 0x9f, 0x4f, 0xcb, 0x23, 0x9f, 0x2d, 0xb7, 0x2c, 0x7f, 0xa7,
-	0xd3, 0xa8, 0x34, 0xdd, 0x5d, 0x9d, 0xba, 0x8e, 0xb7, 0x69, 0xb9, 0xec, 0x57, 0xff, 0x92, 0xc5,
-	0x61, 0xff, 0xa0, 0x35, 0xc6, 0xd8, 0xdf, 0x61, 0xaf, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xa9,
-	0x88, 0xe5, 0x3e, 0xca, 0x13, 0x00, 0x00,
+func (m *QueryVerifyCredentialResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ResolveDID resolves a DID to its DID document
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_query_docs.md"}}
-	ResolveDID(ctx context.Context, in *QueryResolveDIDRequest, opts ...grpc.CallOption) (*QueryResolveDIDResponse, error)
-	// GetDIDDocument retrieves a DID document by its ID
-	GetDIDDocument(ctx context.Context, in *QueryGetDIDDocumentRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentResponse, error)
-	// ListDIDDocuments lists all DID documents with pagination
-	ListDIDDocuments(ctx context.Context, in *QueryListDIDDocumentsRequest, opts ...grpc.CallOption) (*QueryListDIDDocumentsResponse, error)
-	// GetDIDDocumentsByController retrieves DID documents by controller
-	GetDIDDocumentsByController(ctx context.Context, in *QueryGetDIDDocumentsByControllerRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentsByControllerResponse, error)
-	// GetVerificationMethod retrieves a specific verification method
-	GetVerificationMethod(ctx context.Context, in *QueryGetVerificationMethodRequest, opts ...grpc.CallOption) (*QueryGetVerificationMethodResponse, error)
-	// GetService retrieves a specific service endpoint
-	GetService(ctx context.Context, in *QueryGetServiceRequest, opts ...grpc.CallOption) (*QueryGetServiceResponse, error)
-	// GetVerifiableCredential retrieves a verifiable credential by ID
-	GetVerifiableCredential(ctx context.Context, in *QueryGetVerifiableCredentialRequest, opts ...grpc.CallOption) (*QueryGetVerifiableCredentialResponse, error)
-	// ListVerifiableCredentials lists all verifiable credentials with filtering options
-	ListVerifiableCredentials(ctx context.Context, in *QueryListVerifiableCredentialsRequest, opts ...grpc.CallOption) (*QueryListVerifiableCredentialsResponse, error)
-	// GetCredentialsByDID retrieves all credentials (verifiable and WebAuthn) associated with a DID
-	GetCredentialsByDID(ctx context.Context, in *QueryGetCredentialsByDIDRequest, opts ...grpc.CallOption) (*QueryGetCredentialsByDIDResponse, error)
-	// RegisterStart represents the start of the registration process
-	RegisterStart(ctx context.Context, in *QueryRegisterStartRequest, opts ...grpc.CallOption) (*QueryRegisterStartResponse, error)
-	// LoginStart represents the start of the login process
-	LoginStart(ctx context.Context, in *QueryLoginStartRequest, opts ...grpc.CallOption) (*QueryLoginStartResponse, error)
+// QueryGetStatusListRequest is the request type for the Query/GetStatusList
+// RPC method.
+type QueryGetStatusListRequest struct {
+	// issuer is the DID of the issuer
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// list_id is the number of the list
+	ListId uint64 `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetStatusListRequest) Reset()         { *m = QueryGetStatusListRequest{} }
+func (m *QueryGetStatusListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStatusListRequest) ProtoMessage()    {}
+func (*QueryGetStatusListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{27}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetStatusListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetStatusListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStatusListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ResolveDID(ctx context.Context, in *QueryResolveDIDRequest, opts ...grpc.CallOption) (*QueryResolveDIDResponse, error) {
-	out := new(QueryResolveDIDResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/ResolveDID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetStatusListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStatusListRequest.Merge(m, src)
+}
+func (m *QueryGetStatusListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStatusListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStatusListRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetDIDDocument(ctx context.Context, in *QueryGetDIDDocumentRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentResponse, error) {
-	out := new(QueryGetDIDDocumentResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStatusListRequest proto.InternalMessageInfo
+
+func (m *QueryGetStatusListRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListDIDDocuments(ctx context.Context, in *QueryListDIDDocumentsRequest, opts ...grpc.CallOption) (*QueryListDIDDocumentsResponse, error) {
-	out := new(QueryListDIDDocumentsResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/ListDIDDocuments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetStatusListRequest) GetListId() uint64 {
+	if m != nil {
+		return m.ListId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetDIDDocumentsByController(ctx context.Context, in *QueryGetDIDDocumentsByControllerRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentsByControllerResponse, error) {
-	out := new(QueryGetDIDDocumentsByControllerResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDDocumentsByController", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetStatusListResponse is the response type for the
+// Query/GetStatusList RPC method.
+type QueryGetStatusListResponse struct {
+	// status_list is the stored list
+	StatusList StatusList `protobuf:"bytes,1,opt,name=status_list,json=statusList,proto3" json:"status_list"`
+	// credential is the list as a StatusList2021Credential JSON document, with
+	// the bitstring gzip compressed and base64url encoded
+	Credential string `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
-func (c *queryClient) GetVerificationMethod(ctx context.Context, in *QueryGetVerificationMethodRequest, opts ...grpc.CallOption) (*QueryGetVerificationMethodResponse, error) {
-	out := new(QueryGetVerificationMethodResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/GetVerificationMethod", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetStatusListResponse) Reset()         { *m = QueryGetStatusListResponse{} }
+func (m *QueryGetStatusListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStatusListResponse) ProtoMessage()    {}
+func (*QueryGetStatusListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{28}
+}
+func (m *QueryGetStatusListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStatusListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStatusListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetStatusListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStatusListResponse.Merge(m, src)
+}
+func (m *QueryGetStatusListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStatusListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStatusListResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetService(ctx context.Context, in *QueryGetServiceRequest, opts ...grpc.CallOption) (*QueryGetServiceResponse, error) {
-	out := new(QueryGetServiceResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/GetService", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStatusListResponse proto.InternalMessageInfo
+
+func (m *QueryGetStatusListResponse) GetStatusList() StatusList {
+	if m != nil {
+		return m.StatusList
 	}
-	return out, nil
+	return StatusList{}
 }
 
-func (c *queryClient) GetVerifiableCredential(ctx context.Context, in *QueryGetVerifiableCredentialRequest, opts ...grpc.CallOption) (*QueryGetVerifiableCredentialResponse, error) {
-	out := new(QueryGetVerifiableCredentialResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/GetVerifiableCredential", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetStatusListResponse) GetCredential() string {
+	if m != nil {
+		return m.Credential
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListVerifiableCredentials(ctx context.Context, in *QueryListVerifiableCredentialsRequest, opts ...grpc.CallOption) (*QueryListVerifiableCredentialsResponse, error) {
-	out := new(QueryListVerifiableCredentialsResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/ListVerifiableCredentials", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryListVerifiableCredentialsRequest is the request type for the
+// Query/ListVerifiableCredentials RPC method.
+type QueryListVerifiableCredentialsRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// issuer filters by issuer DID (optional)
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// holder filters by holder DID (optional)
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// include_revoked includes revoked credentials (default: false)
+	IncludeRevoked bool `protobuf:"varint,4,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
 }
 
-func (c *queryClient) GetCredentialsByDID(ctx context.Context, in *QueryGetCredentialsByDIDRequest, opts ...grpc.CallOption) (*QueryGetCredentialsByDIDResponse, error) {
-	out := new(QueryGetCredentialsByDIDResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/GetCredentialsByDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListVerifiableCredentialsRequest) Reset()         { *m = QueryListVerifiableCredentialsRequest{} }
+func (m *QueryListVerifiableCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListVerifiableCredentialsRequest) ProtoMessage()    {}
+func (*QueryListVerifiableCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{29}
+}
+func (m *QueryListVerifiableCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListVerifiableCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListVerifiableCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListVerifiableCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListVerifiableCredentialsRequest.Merge(m, src)
+}
+func (m *QueryListVerifiableCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListVerifiableCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListVerifiableCredentialsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RegisterStart(ctx context.Context, in *QueryRegisterStartRequest, opts ...grpc.CallOption) (*QueryRegisterStartResponse, error) {
-	out := new(QueryRegisterStartResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/RegisterStart", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListVerifiableCredentialsRequest proto.InternalMessageInfo
+
+func (m *QueryListVerifiableCredentialsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LoginStart(ctx context.Context, in *QueryLoginStartRequest, opts ...grpc.CallOption) (*QueryLoginStartResponse, error) {
-	out := new(QueryLoginStartResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Query/LoginStart", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListVerifiableCredentialsRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ResolveDID resolves a DID to its DID document
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_query_docs.md"}}
-	ResolveDID(context.Context, *QueryResolveDIDRequest) (*QueryResolveDIDResponse, error)
-	// GetDIDDocument retrieves a DID document by its ID
-	GetDIDDocument(context.Context, *QueryGetDIDDocumentRequest) (*QueryGetDIDDocumentResponse, error)
-	// ListDIDDocuments lists all DID documents with pagination
-	ListDIDDocuments(context.Context, *QueryListDIDDocumentsRequest) (*QueryListDIDDocumentsResponse, error)
-	// GetDIDDocumentsByController retrieves DID documents by controller
-	GetDIDDocumentsByController(context.Context, *QueryGetDIDDocumentsByControllerRequest) (*QueryGetDIDDocumentsByControllerResponse, error)
-	// GetVerificationMethod retrieves a specific verification method
-	GetVerificationMethod(context.Context, *QueryGetVerificationMethodRequest) (*QueryGetVerificationMethodResponse, error)
-	// GetService retrieves a specific service endpoint
-	GetService(context.Context, *QueryGetServiceRequest) (*QueryGetServiceResponse, error)
-	// GetVerifiableCredential retrieves a verifiable credential by ID
-	GetVerifiableCredential(context.Context, *QueryGetVerifiableCredentialRequest) (*QueryGetVerifiableCredentialResponse, error)
-	// ListVerifiableCredentials lists all verifiable credentials with filtering options
-	ListVerifiableCredentials(context.Context, *QueryListVerifiableCredentialsRequest) (*QueryListVerifiableCredentialsResponse, error)
-	// GetCredentialsByDID retrieves all credentials (verifiable and WebAuthn) associated with a DID
-	GetCredentialsByDID(context.Context, *QueryGetCredentialsByDIDRequest) (*QueryGetCredentialsByDIDResponse, error)
-	// RegisterStart represents the start of the registration process
-	RegisterStart(context.Context, *QueryRegisterStartRequest) (*QueryRegisterStartResponse, error)
-	// LoginStart represents the start of the login process
-	LoginStart(context.Context, *QueryLoginStartRequest) (*QueryLoginStartResponse, error)
+func (m *QueryListVerifiableCredentialsRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryListVerifiableCredentialsRequest) GetIncludeRevoked() bool {
+	if m != nil {
+		return m.IncludeRevoked
+	}
+	return false
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ResolveDID(ctx context.Context, req *QueryResolveDIDRequest) (*QueryResolveDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveDID not implemented")
-}
-func (*UnimplementedQueryServer) GetDIDDocument(ctx context.Context, req *QueryGetDIDDocumentRequest) (*QueryGetDIDDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocument not implemented")
-}
-func (*UnimplementedQueryServer) ListDIDDocuments(ctx context.Context, req *QueryListDIDDocumentsRequest) (*QueryListDIDDocumentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDIDDocuments not implemented")
-}
-func (*UnimplementedQueryServer) GetDIDDocumentsByController(ctx context.Context, req *QueryGetDIDDocumentsByControllerRequest) (*QueryGetDIDDocumentsByControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocumentsByController not implemented")
+// QueryListVerifiableCredentialsResponse is the response type for the
+// Query/ListVerifiableCredentials RPC method.
+type QueryListVerifiableCredentialsResponse struct {
+	// credentials is the list of verifiable credentials
+	Credentials []*VerifiableCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) GetVerificationMethod(ctx context.Context, req *QueryGetVerificationMethodRequest) (*QueryGetVerificationMethodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationMethod not implemented")
+
+func (m *QueryListVerifiableCredentialsResponse) Reset() {
+	*m = QueryListVerifiableCredentialsResponse{}
 }
-func (*UnimplementedQueryServer) GetService(ctx context.Context, req *QueryGetServiceRequest) (*QueryGetServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetService not implemented")
+func (m *QueryListVerifiableCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListVerifiableCredentialsResponse) ProtoMessage()    {}
+func (*QueryListVerifiableCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{30}
 }
-func (*UnimplementedQueryServer) GetVerifiableCredential(ctx context.Context, req *QueryGetVerifiableCredentialRequest) (*QueryGetVerifiableCredentialResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiableCredential not implemented")
+func (m *QueryListVerifiableCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListVerifiableCredentials(ctx context.Context, req *QueryListVerifiableCredentialsRequest) (*QueryListVerifiableCredentialsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVerifiableCredentials not implemented")
+func (m *QueryListVerifiableCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListVerifiableCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetCredentialsByDID(ctx context.Context, req *QueryGetCredentialsByDIDRequest) (*QueryGetCredentialsByDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCredentialsByDID not implemented")
+func (m *QueryListVerifiableCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListVerifiableCredentialsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RegisterStart(ctx context.Context, req *QueryRegisterStartRequest) (*QueryRegisterStartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterStart not implemented")
+func (m *QueryListVerifiableCredentialsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LoginStart(ctx context.Context, req *QueryLoginStartRequest) (*QueryLoginStartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginStart not implemented")
+func (m *QueryListVerifiableCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListVerifiableCredentialsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryListVerifiableCredentialsResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryListVerifiableCredentialsResponse) GetCredentials() []*VerifiableCredential {
+	if m != nil {
+		return m.Credentials
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ResolveDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryResolveDIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ResolveDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/ResolveDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ResolveDID(ctx, req.(*QueryResolveDIDRequest))
+func (m *QueryListVerifiableCredentialsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetDIDDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDIDDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDIDDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/GetDIDDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDIDDocument(ctx, req.(*QueryGetDIDDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// CredentialInfo wraps credential data with vault status
+type CredentialInfo struct {
+	// credential can be either verifiable or WebAuthn
+	//
+	// Types that are valid to be assigned to Credential:
+	//	*CredentialInfo_VerifiableCredential
+	//	*CredentialInfo_WebauthnCredential
+	Credential isCredentialInfo_Credential `protobuf_oneof:"credential"`
+	// vault_id indicates if stored in vault (empty if not)
+	VaultId string `protobuf:"bytes,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	// is_encrypted indicates if encrypted in vault
+	IsEncrypted bool `protobuf:"varint,4,opt,name=is_encrypted,json=isEncrypted,proto3" json:"is_encrypted,omitempty"`
 }
 
-func _Query_ListDIDDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListDIDDocumentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListDIDDocuments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/ListDIDDocuments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListDIDDocuments(ctx, req.(*QueryListDIDDocumentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{31}
 }
-
-func _Query_GetDIDDocumentsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDIDDocumentsByControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDIDDocumentsByController(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/GetDIDDocumentsByController",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDIDDocumentsByController(ctx, req.(*QueryGetDIDDocumentsByControllerRequest))
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
 }
 
-func _Query_GetVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVerificationMethodRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVerificationMethod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/GetVerificationMethod",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVerificationMethod(ctx, req.(*QueryGetVerificationMethodRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+type isCredentialInfo_Credential interface {
+	isCredentialInfo_Credential()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func _Query_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/GetService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetService(ctx, req.(*QueryGetServiceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CredentialInfo_VerifiableCredential struct {
+	VerifiableCredential *VerifiableCredential `protobuf:"bytes,1,opt,name=verifiable_credential,json=verifiableCredential,proto3,oneof" json:"verifiable_credential,omitempty"`
+}
+type CredentialInfo_WebauthnCredential struct {
+	WebauthnCredential *WebAuthnCredential `protobuf:"bytes,2,opt,name=webauthn_credential,json=webauthnCredential,proto3,oneof" json:"webauthn_credential,omitempty"`
 }
 
-func _Query_GetVerifiableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVerifiableCredentialRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVerifiableCredential(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/GetVerifiableCredential",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVerifiableCredential(ctx, req.(*QueryGetVerifiableCredentialRequest))
+func (*CredentialInfo_VerifiableCredential) isCredentialInfo_Credential() {}
+func (*CredentialInfo_WebauthnCredential) isCredentialInfo_Credential()   {}
+
+func (m *CredentialInfo) GetCredential() isCredentialInfo_Credential {
+	if m != nil {
+		return m.Credential
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListVerifiableCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListVerifiableCredentialsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListVerifiableCredentials(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/ListVerifiableCredentials",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListVerifiableCredentials(ctx, req.(*QueryListVerifiableCredentialsRequest))
+func (m *CredentialInfo) GetVerifiableCredential() *VerifiableCredential {
+	if x, ok := m.GetCredential().(*CredentialInfo_VerifiableCredential); ok {
+		return x.VerifiableCredential
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetCredentialsByDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCredentialsByDIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCredentialsByDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/GetCredentialsByDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCredentialsByDID(ctx, req.(*QueryGetCredentialsByDIDRequest))
+func (m *CredentialInfo) GetWebauthnCredential() *WebAuthnCredential {
+	if x, ok := m.GetCredential().(*CredentialInfo_WebauthnCredential); ok {
+		return x.WebauthnCredential
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RegisterStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisterStartRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CredentialInfo) GetVaultId() string {
+	if m != nil {
+		return m.VaultId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisterStart(ctx, in)
+	return ""
+}
+
+func (m *CredentialInfo) GetIsEncrypted() bool {
+	if m != nil {
+		return m.IsEncrypted
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/RegisterStart",
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CredentialInfo) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CredentialInfo_VerifiableCredential)(nil),
+		(*CredentialInfo_WebauthnCredential)(nil),
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisterStart(ctx, req.(*QueryRegisterStartRequest))
+}
+
+// QueryGetCredentialsByDIDRequest is the request type for the
+// Query/GetCredentialsByDID RPC method.
+type QueryGetCredentialsByDIDRequest struct {
+	// did is the DID to retrieve all credentials for
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// include_verifiable includes verifiable credentials (default: true)
+	IncludeVerifiable bool `protobuf:"varint,2,opt,name=include_verifiable,json=includeVerifiable,proto3" json:"include_verifiable,omitempty"`
+	// include_webauthn includes WebAuthn credentials (default: true)
+	IncludeWebauthn bool `protobuf:"varint,3,opt,name=include_webauthn,json=includeWebauthn,proto3" json:"include_webauthn,omitempty"`
+	// include_revoked includes revoked credentials (default: false)
+	IncludeRevoked bool `protobuf:"varint,4,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetCredentialsByDIDRequest) Reset()         { *m = QueryGetCredentialsByDIDRequest{} }
+func (m *QueryGetCredentialsByDIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialsByDIDRequest) ProtoMessage()    {}
+func (*QueryGetCredentialsByDIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{32}
+}
+func (m *QueryGetCredentialsByDIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialsByDIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialsByDIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetCredentialsByDIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialsByDIDRequest.Merge(m, src)
+}
+func (m *QueryGetCredentialsByDIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialsByDIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialsByDIDRequest.DiscardUnknown(m)
 }
 
-func _Query_LoginStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLoginStartRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCredentialsByDIDRequest proto.InternalMessageInfo
+
+func (m *QueryGetCredentialsByDIDRequest) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LoginStart(ctx, in)
+	return ""
+}
+
+func (m *QueryGetCredentialsByDIDRequest) GetIncludeVerifiable() bool {
+	if m != nil {
+		return m.IncludeVerifiable
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Query/LoginStart",
+	return false
+}
+
+func (m *QueryGetCredentialsByDIDRequest) GetIncludeWebauthn() bool {
+	if m != nil {
+		return m.IncludeWebauthn
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LoginStart(ctx, req.(*QueryLoginStartRequest))
+	return false
+}
+
+func (m *QueryGetCredentialsByDIDRequest) GetIncludeRevoked() bool {
+	if m != nil {
+		return m.IncludeRevoked
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "did.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ResolveDID",
-			Handler:    _Query_ResolveDID_Handler,
-		},
-		{
-			MethodName: "GetDIDDocument",
-			Handler:    _Query_GetDIDDocument_Handler,
-		},
-		{
-			MethodName: "ListDIDDocuments",
-			Handler:    _Query_ListDIDDocuments_Handler,
-		},
-		{
-			MethodName: "GetDIDDocumentsByController",
-			Handler:    _Query_GetDIDDocumentsByController_Handler,
-		},
-		{
-			MethodName: "GetVerificationMethod",
-			Handler:    _Query_GetVerificationMethod_Handler,
-		},
-		{
-			MethodName: "GetService",
-			Handler:    _Query_GetService_Handler,
-		},
-		{
-			MethodName: "GetVerifiableCredential",
-			Handler:    _Query_GetVerifiableCredential_Handler,
-		},
-		{
-			MethodName: "ListVerifiableCredentials",
-			Handler:    _Query_ListVerifiableCredentials_Handler,
-		},
-		{
-			MethodName: "GetCredentialsByDID",
-			Handler:    _Query_GetCredentialsByDID_Handler,
-		},
-		{
-			MethodName: "RegisterStart",
-			Handler:    _Query_RegisterStart_Handler,
-		},
-		{
-			MethodName: "LoginStart",
-			Handler:    _Query_LoginStart_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "did/v1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetCredentialsByDIDRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryGetCredentialsByDIDResponse is the response type for the
+// Query/GetCredentialsByDID RPC method.
+type QueryGetCredentialsByDIDResponse struct {
+	// credentials is the list of all credentials associated with the DID
+	Credentials []*CredentialInfo `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetCredentialsByDIDResponse) Reset()         { *m = QueryGetCredentialsByDIDResponse{} }
+func (m *QueryGetCredentialsByDIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialsByDIDResponse) ProtoMessage()    {}
+func (*QueryGetCredentialsByDIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{33}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetCredentialsByDIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetCredentialsByDIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialsByDIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryResolveDIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetCredentialsByDIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialsByDIDResponse.Merge(m, src)
 }
-
-func (m *QueryResolveDIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetCredentialsByDIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialsByDIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialsByDIDResponse.DiscardUnknown(m)
 }
 
-func (m *QueryResolveDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGetCredentialsByDIDResponse proto.InternalMessageInfo
+
+func (m *QueryGetCredentialsByDIDResponse) GetCredentials() []*CredentialInfo {
+	if m != nil {
+		return m.Credentials
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryResolveDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetCredentialsByDIDResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryResolveDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRegisterStartRequest is the request type for the
+// Query/RegisterStart RPC method.
+type QueryRegisterStartRequest struct {
+	// assertion_did is the DID to register (did:sonr:email:<blake3> or did:sonr:phone:<blake3>)
+	AssertionDid string `protobuf:"bytes,1,opt,name=assertion_did,json=assertionDid,proto3" json:"assertion_did,omitempty"`
 }
 
-func (m *QueryResolveDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DidDocumentMetadata != nil {
-		{
-			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DidDocument != nil {
-		{
-			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRegisterStartRequest) Reset()         { *m = QueryRegisterStartRequest{} }
+func (m *QueryRegisterStartRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisterStartRequest) ProtoMessage()    {}
+func (*QueryRegisterStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{34}
+}
+func (m *QueryRegisterStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisterStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisterStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRegisterStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisterStartRequest.Merge(m, src)
+}
+func (m *QueryRegisterStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisterStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisterStartRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDIDDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRegisterStartRequest proto.InternalMessageInfo
+
+func (m *QueryRegisterStartRequest) GetAssertionDid() string {
+	if m != nil {
+		return m.AssertionDid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetDIDDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetDIDDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryRegisterStartResponse is the response type for the
+// Query/RegisterStart RPC method.
+type QueryRegisterStartResponse struct {
+	// challenge for the attestation ceremony (32 bytes)
+	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// relying_party_id identifier
+	RelyingPartyId string `protobuf:"bytes,2,opt,name=relying_party_id,json=relyingPartyId,proto3" json:"relying_party_id,omitempty"`
+	// user information (id, name, displayName)
+	User map[string]string `protobuf:"bytes,3,rep,name=user,proto3" json:"user,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *QueryGetDIDDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRegisterStartResponse) Reset()         { *m = QueryRegisterStartResponse{} }
+func (m *QueryRegisterStartResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisterStartResponse) ProtoMessage()    {}
+func (*QueryRegisterStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{35}
+}
+func (m *QueryRegisterStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisterStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisterStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetDIDDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRegisterStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisterStartResponse.Merge(m, src)
+}
+func (m *QueryRegisterStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisterStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisterStartResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDIDDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DidDocumentMetadata != nil {
-		{
-			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryRegisterStartResponse proto.InternalMessageInfo
+
+func (m *QueryRegisterStartResponse) GetChallenge() []byte {
+	if m != nil {
+		return m.Challenge
 	}
-	if m.DidDocument != nil {
-		{
-			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryRegisterStartResponse) GetRelyingPartyId() string {
+	if m != nil {
+		return m.RelyingPartyId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListDIDDocumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRegisterStartResponse) GetUser() map[string]string {
+	if m != nil {
+		return m.User
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListDIDDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLoginStartRequest is the request type for the
+// Query/LoginStart RPC method.
+type QueryLoginStartRequest struct {
+	// assertion_did is the assertion DID (did:sonr:email:<blake3> or did:sonr:phone:<blake3>)
+	AssertionDid string `protobuf:"bytes,1,opt,name=assertion_did,json=assertionDid,proto3" json:"assertion_did,omitempty"`
 }
 
-func (m *QueryListDIDDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLoginStartRequest) Reset()         { *m = QueryLoginStartRequest{} }
+func (m *QueryLoginStartRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLoginStartRequest) ProtoMessage()    {}
+func (*QueryLoginStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{36}
+}
+func (m *QueryLoginStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLoginStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLoginStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLoginStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLoginStartRequest.Merge(m, src)
+}
+func (m *QueryLoginStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLoginStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLoginStartRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListDIDDocumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLoginStartRequest proto.InternalMessageInfo
+
+func (m *QueryLoginStartRequest) GetAssertionDid() string {
+	if m != nil {
+		return m.AssertionDid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListDIDDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLoginStartResponse is the response type for the
+// Query/LoginStart RPC method.
+type QueryLoginStartResponse struct {
+	// credential_ids associated with this assertion
+	CredentialIds []string `protobuf:"bytes,1,rep,name=credential_ids,json=credentialIds,proto3" json:"credential_ids,omitempty"`
+	// challenge for the assertion ceremony (32 bytes)
+	Challenge []byte `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// relying_party_id identifier
+	RelyingPartyId string `protobuf:"bytes,3,opt,name=relying_party_id,json=relyingPartyId,proto3" json:"relying_party_id,omitempty"`
 }
 
-func (m *QueryListDIDDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DidDocuments) > 0 {
-		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLoginStartResponse) Reset()         { *m = QueryLoginStartResponse{} }
+func (m *QueryLoginStartResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLoginStartResponse) ProtoMessage()    {}
+func (*QueryLoginStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{37}
+}
+func (m *QueryLoginStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLoginStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLoginStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLoginStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLoginStartResponse.Merge(m, src)
+}
+func (m *QueryLoginStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLoginStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLoginStartResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDIDDocumentsByControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLoginStartResponse proto.InternalMessageInfo
+
+func (m *QueryLoginStartResponse) GetCredentialIds() []string {
+	if m != nil {
+		return m.CredentialIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDIDDocumentsByControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetDIDDocumentsByControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryLoginStartResponse) GetChallenge() []byte {
+	if m != nil {
+		return m.Challenge
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetDIDDocumentsByControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLoginStartResponse) GetRelyingPartyId() string {
+	if m != nil {
+		return m.RelyingPartyId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetDIDDocumentsByControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetUnverifiedCredentialsRequest is the request type for the
+// Query/GetUnverifiedCredentials RPC method.
+type QueryGetUnverifiedCredentialsRequest struct {
+	// did is the DID whose credentials are listed
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-func (m *QueryGetDIDDocumentsByControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DidDocuments) > 0 {
-		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetUnverifiedCredentialsRequest) Reset()         { *m = QueryGetUnverifiedCredentialsRequest{} }
+func (m *QueryGetUnverifiedCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUnverifiedCredentialsRequest) ProtoMessage()    {}
+func (*QueryGetUnverifiedCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{38}
+}
+func (m *QueryGetUnverifiedCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUnverifiedCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUnverifiedCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetVerificationMethodRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetUnverifiedCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUnverifiedCredentialsRequest.Merge(m, src)
 }
-
-func (m *QueryGetVerificationMethodRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetUnverifiedCredentialsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetVerificationMethodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MethodId) > 0 {
-		i -= len(m.MethodId)
-		copy(dAtA[i:], m.MethodId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MethodId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetUnverifiedCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUnverifiedCredentialsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetUnverifiedCredentialsRequest proto.InternalMessageInfo
+
+func (m *QueryGetUnverifiedCredentialsRequest) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetVerificationMethodResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetUnverifiedCredentialsResponse is the response type for the
+// Query/GetUnverifiedCredentials RPC method.
+type QueryGetUnverifiedCredentialsResponse struct {
+	// credentials are the flagged WebAuthn credentials
+	Credentials []UnverifiedCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials"`
+	// downgraded is set when flagged credentials are refused
+	Downgraded bool `protobuf:"varint,2,opt,name=downgraded,proto3" json:"downgraded,omitempty"`
 }
 
-func (m *QueryGetVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VerificationMethod != nil {
-		{
-			size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetUnverifiedCredentialsResponse) Reset()         { *m = QueryGetUnverifiedCredentialsResponse{} }
+func (m *QueryGetUnverifiedCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUnverifiedCredentialsResponse) ProtoMessage()    {}
+func (*QueryGetUnverifiedCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1fa9bb626e2869, []int{39}
 }
-
-func (m *QueryGetServiceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetUnverifiedCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUnverifiedCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUnverifiedCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetServiceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetUnverifiedCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUnverifiedCredentialsResponse.Merge(m, src)
 }
-
-func (m *QueryGetServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetUnverifiedCredentialsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetUnverifiedCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUnverifiedCredentialsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryGetUnverifiedCredentialsResponse proto.InternalMessageInfo
 
-func (m *QueryGetServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetUnverifiedCredentialsResponse) GetCredentials() []UnverifiedCredential {
+	if m != nil {
+		return m.Credentials
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetVerifiableCredentialRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetUnverifiedCredentialsResponse) GetDowngraded() bool {
+	if m != nil {
+		return m.Downgraded
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetVerifiableCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return false
 }
 
-func (m *QueryGetVerifiableCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CredentialId) > 0 {
-		i -= len(m.CredentialId)
-		copy(dAtA[i:], m.CredentialId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "did.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "did.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryResolveDIDRequest)(nil), "did.v1.QueryResolveDIDRequest")
+	proto.RegisterType((*QueryResolveDIDResponse)(nil), "did.v1.QueryResolveDIDResponse")
+	proto.RegisterType((*QueryGetDIDDocumentRequest)(nil), "did.v1.QueryGetDIDDocumentRequest")
+	proto.RegisterType((*QueryGetDIDDocumentResponse)(nil), "did.v1.QueryGetDIDDocumentResponse")
+	proto.RegisterType((*QueryGetDIDDocumentVersionRequest)(nil), "did.v1.QueryGetDIDDocumentVersionRequest")
+	proto.RegisterType((*QueryGetDIDDocumentVersionResponse)(nil), "did.v1.QueryGetDIDDocumentVersionResponse")
+	proto.RegisterType((*QueryGetDIDDocumentHistoryRequest)(nil), "did.v1.QueryGetDIDDocumentHistoryRequest")
+	proto.RegisterType((*QueryGetDIDDocumentHistoryResponse)(nil), "did.v1.QueryGetDIDDocumentHistoryResponse")
+	proto.RegisterType((*QueryGetDelegatedControllersRequest)(nil), "did.v1.QueryGetDelegatedControllersRequest")
+	proto.RegisterType((*QueryGetDelegatedControllersResponse)(nil), "did.v1.QueryGetDelegatedControllersResponse")
+	proto.RegisterType((*QueryGetDIDAssetsRequest)(nil), "did.v1.QueryGetDIDAssetsRequest")
+	proto.RegisterType((*QueryGetDIDAssetsResponse)(nil), "did.v1.QueryGetDIDAssetsResponse")
+	proto.RegisterType((*DIDAssetInfo)(nil), "did.v1.DIDAssetInfo")
+	proto.RegisterType((*QueryListDIDDocumentsRequest)(nil), "did.v1.QueryListDIDDocumentsRequest")
+	proto.RegisterType((*QueryListDIDDocumentsResponse)(nil), "did.v1.QueryListDIDDocumentsResponse")
+	proto.RegisterType((*QueryGetDIDDocumentsByControllerRequest)(nil), "did.v1.QueryGetDIDDocumentsByControllerRequest")
+	proto.RegisterType((*QueryGetDIDDocumentsByControllerResponse)(nil), "did.v1.QueryGetDIDDocumentsByControllerResponse")
+	proto.RegisterType((*QueryGetVerificationMethodRequest)(nil), "did.v1.QueryGetVerificationMethodRequest")
+	proto.RegisterType((*QueryGetVerificationMethodResponse)(nil), "did.v1.QueryGetVerificationMethodResponse")
+	proto.RegisterType((*QueryGetServiceRequest)(nil), "did.v1.QueryGetServiceRequest")
+	proto.RegisterType((*QueryGetServiceResponse)(nil), "did.v1.QueryGetServiceResponse")
+	proto.RegisterType((*QueryGetVerifiableCredentialRequest)(nil), "did.v1.QueryGetVerifiableCredentialRequest")
+	proto.RegisterType((*QueryGetVerifiableCredentialResponse)(nil), "did.v1.QueryGetVerifiableCredentialResponse")
+	proto.RegisterType((*QueryVerifyCredentialRequest)(nil), "did.v1.QueryVerifyCredentialRequest")
+	proto.RegisterType((*QueryVerifyCredentialResponse)(nil), "did.v1.QueryVerifyCredentialResponse")
+	proto.RegisterType((*QueryGetStatusListRequest)(nil), "did.v1.QueryGetStatusListRequest")
+	proto.RegisterType((*QueryGetStatusListResponse)(nil), "did.v1.QueryGetStatusListResponse")
+	proto.RegisterType((*QueryListVerifiableCredentialsRequest)(nil), "did.v1.QueryListVerifiableCredentialsRequest")
+	proto.RegisterType((*QueryListVerifiableCredentialsResponse)(nil), "did.v1.QueryListVerifiableCredentialsResponse")
+	proto.RegisterType((*CredentialInfo)(nil), "did.v1.CredentialInfo")
+	proto.RegisterType((*QueryGetCredentialsByDIDRequest)(nil), "did.v1.QueryGetCredentialsByDIDRequest")
+	proto.RegisterType((*QueryGetCredentialsByDIDResponse)(nil), "did.v1.QueryGetCredentialsByDIDResponse")
+	proto.RegisterType((*QueryRegisterStartRequest)(nil), "did.v1.QueryRegisterStartRequest")
+	proto.RegisterType((*QueryRegisterStartResponse)(nil), "did.v1.QueryRegisterStartResponse")
+	proto.RegisterMapType((map[string]string)(nil), "did.v1.QueryRegisterStartResponse.UserEntry")
+	proto.RegisterType((*QueryLoginStartRequest)(nil), "did.v1.QueryLoginStartRequest")
+	proto.RegisterType((*QueryLoginStartResponse)(nil), "did.v1.QueryLoginStartResponse")
+	proto.RegisterType((*QueryGetUnverifiedCredentialsRequest)(nil), "did.v1.QueryGetUnverifiedCredentialsRequest")
+	proto.RegisterType((*QueryGetUnverifiedCredentialsResponse)(nil), "did.v1.QueryGetUnverifiedCredentialsResponse")
 }
 
-func (m *QueryGetVerifiableCredentialResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("did/v1/query.proto", fileDescriptor_ae1fa9bb626e2869) }
+
+var fileDescriptor_ae1fa9bb626e2869 = []byte{
+	// 2090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x11, 0xe6, 0x50, 0x22, 0xc5, 0x2d, 0x2e, 0x69, 0xba, 0xf9, 0x5a, 0x0d, 0xa5, 0xa5, 0x34, 0x12,
+	0xc5, 0x87, 0xc5, 0x1d, 0x53, 0x41, 0x62, 0x25, 0x88, 0x02, 0x9b, 0xa2, 0x23, 0x6f, 0x2c, 0x41,
+	0xca, 0x28, 0x96, 0x91, 0x5c, 0x16, 0xc3, 0x9d, 0xd6, 0x72, 0xa2, 0xe1, 0xcc, 0x6a, 0x7a, 0x76,
+	0xe9, 0x35, 0x41, 0x20, 0xc8, 0x25, 0xb9, 0x04, 0x79, 0x01, 0xc9, 0x35, 0x30, 0xe0, 0x20, 0x39,
+	0xf8, 0x10, 0xe4, 0x4f, 0xe8, 0x68, 0x20, 0x97, 0x9c, 0x92, 0x40, 0xca, 0x3d, 0xff, 0x20, 0x08,
+	0xa6, 0xbb, 0x7a, 0x1e, 0x3b, 0x0f, 0xae, 0x1d, 0x19, 0xd0, 0x69, 0xa7, 0xab, 0xeb, 0xf1, 0x75,
+	0x75, 0x75, 0x75, 0x57, 0x2d, 0x10, 0xcb, 0xb6, 0xf4, 0xfe, 0x8e, 0xfe, 0xb4, 0x47, 0xfd, 0x41,
+	0xa3, 0xeb, 0x7b, 0x81, 0x47, 0x26, 0x2d, 0xdb, 0x6a, 0xf4, 0x77, 0xd4, 0xad, 0xb6, 0xc7, 0x0e,
+	0x3d, 0xa6, 0xef, 0x9b, 0x8c, 0x0a, 0x06, 0xbd, 0xbf, 0xb3, 0x4f, 0x03, 0x73, 0x47, 0xef, 0x9a,
+	0x1d, 0xdb, 0x35, 0x03, 0xdb, 0x73, 0x85, 0x8c, 0xba, 0x80, 0x7a, 0x3a, 0xd4, 0xa5, 0xcc, 0x66,
+	0x48, 0x95, 0xda, 0x59, 0x60, 0x06, 0x74, 0x88, 0x16, 0x0c, 0xba, 0x54, 0xf2, 0x5d, 0xe8, 0x78,
+	0x5e, 0xc7, 0xa1, 0xba, 0xd9, 0xb5, 0x75, 0xd3, 0x75, 0xbd, 0x80, 0xab, 0x96, 0xb3, 0x0b, 0x1d,
+	0xaf, 0xe3, 0xf1, 0x4f, 0x3d, 0xfc, 0x12, 0x54, 0x6d, 0x01, 0xc8, 0xf7, 0x43, 0x4c, 0x0f, 0x4c,
+	0xdf, 0x3c, 0x64, 0x06, 0x7d, 0xda, 0xa3, 0x2c, 0xd0, 0x6e, 0xc1, 0x7c, 0x8a, 0xca, 0xba, 0x9e,
+	0xcb, 0x28, 0xb9, 0x06, 0x93, 0x5d, 0x4e, 0xa9, 0x29, 0x97, 0x94, 0x8d, 0xe9, 0x1b, 0xb3, 0x0d,
+	0xb1, 0xc6, 0x06, 0xf2, 0xe1, 0xac, 0xb6, 0x05, 0x4b, 0x5c, 0xdc, 0xa0, 0xcc, 0x73, 0xfa, 0x74,
+	0xaf, 0xb9, 0x87, 0x8a, 0xc9, 0x1c, 0x9c, 0xb1, 0x6c, 0x8b, 0x8b, 0x57, 0x8c, 0xf0, 0x53, 0xfb,
+	0x44, 0x81, 0xe5, 0x0c, 0x33, 0xda, 0xfb, 0x06, 0x54, 0x2d, 0xdb, 0x6a, 0x59, 0x5e, 0xbb, 0x77,
+	0x48, 0xdd, 0x00, 0xad, 0xce, 0x4b, 0xab, 0x7b, 0xcd, 0xbd, 0x3d, 0x9c, 0x32, 0xa6, 0x2d, 0xdb,
+	0x92, 0x03, 0x72, 0x1f, 0x16, 0x93, 0x72, 0xad, 0x43, 0x1a, 0x98, 0x96, 0x19, 0x98, 0xb5, 0x71,
+	0xae, 0x60, 0x25, 0x47, 0xc1, 0x3d, 0x64, 0x31, 0xe6, 0x13, 0x8a, 0x24, 0x51, 0x6b, 0x80, 0xca,
+	0x31, 0xde, 0xa1, 0x41, 0xd2, 0x68, 0xe1, 0xa2, 0xfe, 0xa8, 0xc0, 0x4a, 0xae, 0xc0, 0xab, 0xb6,
+	0xb0, 0x23, 0xb8, 0x9c, 0x83, 0xf3, 0x11, 0xf5, 0x99, 0xed, 0xb9, 0x85, 0xeb, 0x23, 0x17, 0x01,
+	0xfa, 0x82, 0xa7, 0x65, 0x5b, 0xdc, 0xf8, 0x59, 0xa3, 0x82, 0x94, 0xa6, 0x45, 0x2e, 0x43, 0x55,
+	0x4e, 0x07, 0xf6, 0x21, 0xad, 0x9d, 0xe1, 0x92, 0xd3, 0x48, 0xfb, 0x81, 0x7d, 0x48, 0xb5, 0xcf,
+	0x14, 0xd0, 0xca, 0x2c, 0xbf, 0x6a, 0x8e, 0x3a, 0xc9, 0x75, 0xd4, 0x7b, 0x36, 0x0b, 0x3c, 0x7f,
+	0x50, 0xec, 0xa8, 0xef, 0x02, 0xc4, 0x87, 0x1c, 0x8d, 0x5f, 0x6b, 0x88, 0x8c, 0xd0, 0x08, 0x33,
+	0x42, 0x43, 0xa4, 0x0c, 0xcc, 0x08, 0x8d, 0x07, 0x66, 0x87, 0xa2, 0x36, 0x23, 0x21, 0xa9, 0x7d,
+	0x9a, 0xef, 0xae, 0xc8, 0x7e, 0xe4, 0xae, 0x29, 0x74, 0x72, 0x78, 0x44, 0xcf, 0x6c, 0x4c, 0xdf,
+	0x50, 0x73, 0x56, 0x2a, 0x9d, 0x1c, 0xf1, 0x92, 0x3b, 0x39, 0x30, 0xd7, 0x4f, 0x85, 0x29, 0x8c,
+	0xa6, 0x70, 0xbe, 0x05, 0x57, 0x22, 0x98, 0xd4, 0xa1, 0x1d, 0x33, 0xa0, 0xd6, 0x6d, 0xcf, 0x0d,
+	0x7c, 0xcf, 0x71, 0xa8, 0xcf, 0x8a, 0x4f, 0xcc, 0x13, 0xb8, 0x5a, 0x2e, 0x88, 0x2b, 0xbc, 0x0d,
+	0xd3, 0x96, 0x98, 0x4f, 0x2c, 0x32, 0xde, 0xce, 0xac, 0xe8, 0xee, 0xd9, 0x67, 0xff, 0x58, 0x1d,
+	0x33, 0x92, 0x52, 0xda, 0xfb, 0x50, 0x4b, 0x38, 0xf3, 0x1d, 0xc6, 0x68, 0xc0, 0x4a, 0x83, 0xdd,
+	0x3b, 0x72, 0xa9, 0xd5, 0xf2, 0x5c, 0x67, 0xc0, 0x9d, 0x33, 0x65, 0x54, 0x38, 0xe5, 0xbe, 0xeb,
+	0x0c, 0xb4, 0xfb, 0x70, 0x3e, 0x47, 0x19, 0xc2, 0xbd, 0x01, 0x93, 0x26, 0xa7, 0x20, 0xd2, 0x85,
+	0xc4, 0x76, 0x70, 0xd6, 0xa6, 0xfb, 0xd8, 0x43, 0x88, 0xc8, 0xa9, 0x1d, 0x41, 0x35, 0x39, 0x4b,
+	0xae, 0xc3, 0x04, 0x9f, 0xc1, 0xe0, 0x9f, 0x1b, 0x56, 0x81, 0xe2, 0x82, 0x89, 0x5c, 0x81, 0x99,
+	0x76, 0xcf, 0xf7, 0xc3, 0xa0, 0x0f, 0x31, 0xfa, 0x1c, 0x70, 0xc5, 0xa8, 0x22, 0xf1, 0x7e, 0x48,
+	0x23, 0x0b, 0x30, 0xc1, 0x17, 0xc0, 0x4f, 0xe6, 0x94, 0x21, 0x06, 0xda, 0x63, 0xb8, 0xc0, 0x57,
+	0x72, 0xd7, 0x66, 0xc9, 0x20, 0x8b, 0x5c, 0x93, 0x0e, 0x66, 0xe5, 0x4b, 0x07, 0xf3, 0x27, 0x0a,
+	0x5c, 0x2c, 0x30, 0x84, 0x6e, 0xbb, 0x09, 0x33, 0xc9, 0xe3, 0x2b, 0xbd, 0x97, 0x7b, 0xee, 0xab,
+	0x89, 0xe3, 0xfa, 0x12, 0x23, 0xf9, 0xd7, 0x0a, 0xac, 0xe7, 0x9c, 0x38, 0xb6, 0x3b, 0x88, 0x63,
+	0x4b, 0x3a, 0xa6, 0x0e, 0xd0, 0x8e, 0x88, 0x18, 0x3a, 0x09, 0xca, 0x4b, 0xcb, 0x02, 0x9f, 0x29,
+	0xb0, 0x71, 0x3a, 0xa6, 0x57, 0xc7, 0x87, 0x46, 0x9c, 0x34, 0x1f, 0x51, 0xdf, 0x7e, 0x6c, 0xb7,
+	0x39, 0xfd, 0x1e, 0x0d, 0x0e, 0x3c, 0xab, 0xf8, 0xc0, 0xad, 0x40, 0xe5, 0x90, 0xb3, 0xc8, 0xcb,
+	0xa5, 0x62, 0x4c, 0x09, 0x42, 0xd3, 0xd2, 0x9e, 0x82, 0x56, 0xa6, 0x13, 0x17, 0xff, 0x3e, 0xcc,
+	0xf7, 0x13, 0xb3, 0x2d, 0x21, 0x8e, 0x31, 0x1b, 0xe5, 0xc4, 0x1c, 0x05, 0xa4, 0x9f, 0xa1, 0x69,
+	0x4d, 0x7c, 0xce, 0xdc, 0xa1, 0xc1, 0x43, 0xea, 0xf7, 0xed, 0x36, 0x2d, 0x4d, 0x16, 0x4c, 0xf0,
+	0xc4, 0xe0, 0x2b, 0x48, 0x69, 0x5a, 0xda, 0x1e, 0x2c, 0x67, 0x54, 0x21, 0xe4, 0x4d, 0x38, 0x87,
+	0x7c, 0x08, 0xf3, 0x35, 0x09, 0x53, 0x72, 0xca, 0x79, 0xed, 0x7b, 0x71, 0x96, 0x15, 0x4b, 0x30,
+	0xf7, 0x1d, 0x7a, 0xdb, 0xa7, 0x16, 0x75, 0x03, 0xdb, 0x74, 0x24, 0xba, 0x30, 0x15, 0x44, 0xc4,
+	0x56, 0x84, 0xb3, 0x1a, 0x13, 0x9b, 0x96, 0x66, 0xc1, 0xd5, 0x72, 0x5d, 0x08, 0xef, 0xdb, 0x00,
+	0xb1, 0x1c, 0x22, 0xbc, 0x90, 0x76, 0xe4, 0x90, 0x64, 0x82, 0x5f, 0xbb, 0x8d, 0xa9, 0x85, 0x33,
+	0x0e, 0xbe, 0x24, 0xd4, 0x3f, 0xc9, 0xbc, 0x91, 0xd5, 0x82, 0x20, 0x17, 0x60, 0xa2, 0x6f, 0x3a,
+	0x28, 0x3e, 0x65, 0x88, 0x01, 0x59, 0x83, 0xd9, 0xae, 0xef, 0x79, 0x8f, 0x5b, 0x62, 0x6f, 0xa9,
+	0x85, 0x49, 0x7c, 0x86, 0x53, 0x1f, 0x21, 0x91, 0xd4, 0xe0, 0x9c, 0x4f, 0xfb, 0xde, 0x93, 0x28,
+	0x2d, 0xca, 0x61, 0x38, 0x43, 0x3f, 0xea, 0xda, 0x3e, 0xb5, 0x6a, 0x67, 0xc5, 0x0c, 0x0e, 0xc9,
+	0x12, 0x4c, 0xfa, 0xd4, 0x64, 0x9e, 0x5b, 0x9b, 0xe0, 0x80, 0x71, 0xa4, 0xdd, 0x8d, 0x2f, 0x85,
+	0x87, 0x81, 0x19, 0xf4, 0x58, 0x98, 0xea, 0xe4, 0x62, 0x97, 0x60, 0xd2, 0x66, 0xac, 0x17, 0xa5,
+	0x0a, 0x1c, 0x91, 0x65, 0x38, 0xe7, 0xd8, 0x2c, 0x88, 0x9f, 0x54, 0x93, 0xe1, 0xb0, 0x69, 0x69,
+	0x47, 0xa0, 0xe6, 0x69, 0xc3, 0x45, 0x7f, 0x13, 0xa6, 0x19, 0xa7, 0xb6, 0x42, 0x76, 0xdc, 0x1a,
+	0x12, 0x05, 0x4f, 0x24, 0x80, 0xf7, 0x04, 0xb0, 0x88, 0xc2, 0x13, 0x57, 0xbc, 0xa9, 0xe3, 0x98,
+	0xb8, 0xe2, 0x6d, 0x7b, 0xa6, 0xc0, 0x5a, 0x94, 0xa9, 0xf3, 0x36, 0xf9, 0x65, 0xdf, 0x0d, 0x09,
+	0xdf, 0x8c, 0xa7, 0x7c, 0xb3, 0x04, 0x93, 0x07, 0x9e, 0x63, 0x51, 0x1f, 0x1f, 0x93, 0x38, 0x22,
+	0xeb, 0xf0, 0x9a, 0xed, 0xb6, 0x9d, 0x9e, 0x45, 0x5b, 0x72, 0xf3, 0xc4, 0x16, 0xcd, 0x22, 0xd9,
+	0x10, 0x54, 0xed, 0x2f, 0x0a, 0x5c, 0x3b, 0x6d, 0x29, 0xe8, 0xd0, 0xef, 0xc0, 0x74, 0xec, 0x03,
+	0x99, 0x37, 0xcb, 0x63, 0x3d, 0x29, 0xf0, 0xf2, 0xf2, 0xe7, 0x4f, 0xc6, 0x61, 0x36, 0x36, 0xc2,
+	0x1f, 0x03, 0x0f, 0x61, 0xb1, 0x1f, 0x01, 0x68, 0x7d, 0xb1, 0x13, 0xf9, 0xde, 0x98, 0xb1, 0xd0,
+	0xcf, 0xa1, 0x93, 0x7b, 0x30, 0x7f, 0x44, 0xf7, 0xcd, 0x5e, 0x70, 0xe0, 0xb6, 0x86, 0xe2, 0x21,
+	0x91, 0x2d, 0x3f, 0xa4, 0xfb, 0xef, 0x84, 0x2c, 0x29, 0x85, 0x44, 0x0a, 0x26, 0xd4, 0x9d, 0x87,
+	0xa9, 0xbe, 0xd9, 0x73, 0x78, 0x20, 0x8b, 0xdd, 0x3a, 0xc7, 0xc7, 0xa2, 0x32, 0xb0, 0x59, 0x8b,
+	0xba, 0x6d, 0x7f, 0xd0, 0x0d, 0xa2, 0xbd, 0x9a, 0xb6, 0xd9, 0xbb, 0x92, 0xb4, 0x5b, 0x4d, 0xc6,
+	0xa4, 0xf6, 0x5f, 0x05, 0x56, 0x65, 0xec, 0x27, 0xf6, 0x6a, 0x77, 0x50, 0x56, 0x54, 0x92, 0x6d,
+	0x20, 0x32, 0x2a, 0xe2, 0x05, 0xe3, 0xa9, 0x7f, 0x1d, 0x67, 0x62, 0x0f, 0x91, 0x4d, 0x98, 0x93,
+	0xec, 0x72, 0x39, 0x98, 0x02, 0x64, 0x70, 0x7d, 0x88, 0xe4, 0x91, 0xe3, 0x6d, 0xe8, 0x40, 0x4c,
+	0xfc, 0x3f, 0x2f, 0xff, 0x4b, 0xc5, 0x0e, 0x88, 0xee, 0xfa, 0x9c, 0x88, 0x5d, 0x92, 0x1b, 0x97,
+	0x0e, 0xa1, 0xaf, 0x28, 0x56, 0xdf, 0xc6, 0x8c, 0x67, 0xd0, 0x8e, 0xcd, 0x02, 0xea, 0x3f, 0x0c,
+	0x4c, 0x3f, 0x48, 0xa4, 0x77, 0x93, 0x31, 0xea, 0xf3, 0xbb, 0x38, 0xde, 0xab, 0x6a, 0x44, 0xdc,
+	0xb3, 0x2d, 0xed, 0x9f, 0x0a, 0xa8, 0x79, 0x2a, 0x70, 0x8d, 0x17, 0xa0, 0xd2, 0x3e, 0x30, 0x1d,
+	0x87, 0xba, 0x1d, 0x71, 0x43, 0x56, 0x8d, 0x98, 0x40, 0x36, 0x60, 0xce, 0xa7, 0xce, 0xc0, 0x76,
+	0x3b, 0xad, 0xae, 0xe9, 0x07, 0x83, 0xf8, 0xf6, 0x9d, 0x45, 0xfa, 0x83, 0x90, 0xdc, 0xb4, 0xc8,
+	0xdb, 0x70, 0xb6, 0xc7, 0x78, 0x1e, 0x09, 0x9d, 0x74, 0x5d, 0x3a, 0xa9, 0xd8, 0x72, 0xe3, 0x03,
+	0x46, 0xfd, 0x77, 0xdd, 0xc0, 0x1f, 0x18, 0x5c, 0x52, 0x7d, 0x0b, 0x2a, 0x11, 0x29, 0x0c, 0xbe,
+	0x27, 0x74, 0x20, 0x83, 0xef, 0x09, 0x1d, 0xe0, 0x25, 0xd4, 0xa3, 0x68, 0x5f, 0x0c, 0xbe, 0x35,
+	0x7e, 0x53, 0xd1, 0x6e, 0xe1, 0x43, 0xe2, 0xae, 0xd7, 0xb1, 0xdd, 0x2f, 0xee, 0xa0, 0x9f, 0xcb,
+	0x56, 0x49, 0x52, 0x1e, 0xbd, 0xb3, 0x06, 0xb3, 0xa9, 0x0b, 0x54, 0x04, 0x41, 0xc5, 0x98, 0x49,
+	0xde, 0xa0, 0x2c, 0xed, 0xc4, 0xf1, 0x51, 0x9c, 0x78, 0x26, 0xcf, 0x89, 0xda, 0xcd, 0xf8, 0xd5,
+	0xf0, 0x81, 0x2b, 0xaf, 0xd5, 0x9c, 0x6b, 0x21, 0x5b, 0xe8, 0xfd, 0x42, 0x5e, 0x29, 0xc5, 0xa2,
+	0xb8, 0xa4, 0xbd, 0xd2, 0x34, 0x9c, 0x27, 0x2b, 0x6b, 0xbd, 0x64, 0x80, 0xd7, 0x01, 0x2c, 0xef,
+	0xc8, 0xed, 0xf8, 0xa6, 0x15, 0x5d, 0xfc, 0x09, 0xca, 0x8d, 0xff, 0x2c, 0xc0, 0x04, 0xc7, 0x43,
+	0x7e, 0x08, 0x93, 0xa2, 0x8f, 0x45, 0xd4, 0x54, 0x50, 0xa4, 0x5a, 0x63, 0xea, 0x4a, 0xee, 0x9c,
+	0x80, 0xac, 0x2d, 0xfd, 0xf4, 0x6f, 0xff, 0xfe, 0xed, 0xf8, 0x1c, 0x99, 0xd5, 0xb1, 0x3d, 0x27,
+	0x1a, 0x62, 0xc4, 0x01, 0x88, 0xdb, 0x5b, 0xa4, 0x3e, 0x14, 0x73, 0x43, 0x4d, 0x32, 0x75, 0xb5,
+	0x70, 0x1e, 0xcd, 0x5c, 0xe4, 0x66, 0x96, 0xc9, 0xa2, 0x34, 0xe3, 0x0b, 0x1e, 0xfd, 0xd8, 0xb2,
+	0xad, 0x13, 0x32, 0x80, 0xd9, 0x74, 0x81, 0x40, 0xb4, 0x94, 0xc6, 0xdc, 0x2e, 0x96, 0x7a, 0xa5,
+	0x94, 0x07, 0x2d, 0xd7, 0xb9, 0xe5, 0x1a, 0x59, 0x92, 0x96, 0x65, 0x79, 0x81, 0xa6, 0x7f, 0xa7,
+	0xc0, 0x62, 0x6e, 0x47, 0x87, 0x6c, 0x96, 0xa8, 0x4f, 0xf7, 0x9b, 0xd4, 0xad, 0x51, 0x58, 0x11,
+	0xd0, 0x35, 0x0e, 0xe8, 0x12, 0xa9, 0xe7, 0x03, 0xd2, 0xb1, 0xc5, 0x91, 0x03, 0x0c, 0x7b, 0x27,
+	0xa5, 0xc0, 0xd2, 0xfd, 0x1d, 0x75, 0x6b, 0x14, 0xd6, 0x11, 0x81, 0x1d, 0xa0, 0xf9, 0x3f, 0x28,
+	0xb0, 0x5c, 0xd0, 0xf4, 0x20, 0x6f, 0x64, 0xec, 0x15, 0xf7, 0x54, 0xd4, 0xeb, 0xa3, 0x31, 0x23,
+	0xbc, 0x2d, 0x0e, 0xef, 0x2a, 0xd1, 0x0a, 0xe0, 0x25, 0xda, 0x25, 0xe4, 0x63, 0xa8, 0x26, 0x9b,
+	0x1b, 0xe4, 0x52, 0x8e, 0x1b, 0x52, 0x4d, 0x14, 0xf5, 0x72, 0x09, 0x07, 0x02, 0x58, 0xe3, 0x00,
+	0x56, 0xc9, 0xc5, 0x02, 0x00, 0xa2, 0x19, 0x42, 0x3e, 0x86, 0xb9, 0xe1, 0x2e, 0x01, 0xb9, 0x9a,
+	0xd2, 0x5e, 0xd0, 0xad, 0x50, 0xd7, 0x4e, 0xe1, 0x42, 0x1c, 0xe7, 0x39, 0x8e, 0x79, 0xf2, 0xfa,
+	0x30, 0x0e, 0x46, 0xfe, 0xaa, 0xc0, 0x4a, 0x49, 0xa5, 0x4d, 0xf4, 0x92, 0x70, 0xc8, 0xeb, 0x13,
+	0xa8, 0x6f, 0x8e, 0x2e, 0x80, 0xe8, 0x76, 0x38, 0xba, 0x37, 0xc8, 0x66, 0x06, 0x9d, 0x1e, 0xf7,
+	0x17, 0xf4, 0xe3, 0xf8, 0xfb, 0x84, 0x7c, 0x2a, 0x22, 0x3d, 0x5b, 0xdb, 0x66, 0x23, 0xbd, 0xb0,
+	0x28, 0x57, 0xb7, 0x46, 0x61, 0x45, 0x8c, 0x5f, 0xe7, 0x18, 0x75, 0xb2, 0x2d, 0x31, 0x26, 0x4b,
+	0xe8, 0x6d, 0x51, 0x79, 0xe3, 0xa6, 0x1e, 0x47, 0x75, 0x7d, 0x98, 0xa5, 0x20, 0xae, 0x82, 0x87,
+	0x72, 0x62, 0xa6, 0xd2, 0x56, 0x57, 0x0b, 0xe7, 0x8b, 0x02, 0x1a, 0x8b, 0x65, 0x69, 0x39, 0xae,
+	0xca, 0x4f, 0xe4, 0x99, 0xcb, 0x7b, 0x23, 0x67, 0xcf, 0x5c, 0x49, 0x85, 0xad, 0x5e, 0x1f, 0x8d,
+	0xb9, 0x08, 0x62, 0x7c, 0x4f, 0xe9, 0xc7, 0xa9, 0xdb, 0xfb, 0x84, 0xfc, 0x5e, 0x81, 0xf3, 0x85,
+	0x95, 0x0a, 0xd9, 0xce, 0xc4, 0x76, 0x59, 0x71, 0xa6, 0x36, 0x46, 0x65, 0x47, 0xa0, 0x2b, 0x1c,
+	0xe8, 0x22, 0x99, 0xcf, 0x02, 0x65, 0xe4, 0x37, 0x0a, 0xcc, 0x0d, 0x17, 0xe0, 0x43, 0x47, 0xb2,
+	0xa0, 0xca, 0x57, 0xd7, 0x4e, 0xe1, 0x2a, 0x0a, 0xfa, 0x62, 0x3f, 0x89, 0x58, 0x1b, 0x90, 0x9f,
+	0x29, 0x30, 0x93, 0xaa, 0x8e, 0x49, 0x26, 0x05, 0x65, 0xea, 0x70, 0x55, 0x2b, 0x63, 0x41, 0x2c,
+	0x3a, 0xc7, 0xb2, 0x49, 0xd6, 0xf5, 0xc4, 0x9f, 0x70, 0x3d, 0xb6, 0x1d, 0x96, 0xda, 0x4c, 0x3f,
+	0x16, 0xd5, 0xe9, 0x89, 0x7e, 0x8c, 0x85, 0xfb, 0x09, 0xf9, 0xa5, 0x02, 0xf3, 0x39, 0x4f, 0x75,
+	0xb2, 0x3e, 0x6c, 0xac, 0xa0, 0x9a, 0x51, 0x37, 0x4e, 0x67, 0x2c, 0x4a, 0xa1, 0x89, 0x6d, 0xe2,
+	0x24, 0x71, 0x27, 0xff, 0x59, 0x81, 0x5a, 0xd1, 0x63, 0x8b, 0x64, 0x22, 0xb8, 0xec, 0x39, 0xa7,
+	0x6e, 0x8f, 0xc8, 0x8d, 0x00, 0xdf, 0xe4, 0x00, 0xb7, 0xc8, 0x46, 0x29, 0x40, 0xbd, 0x17, 0x29,
+	0x21, 0x7d, 0x98, 0x49, 0xbd, 0xc1, 0x87, 0xb6, 0x31, 0xaf, 0xb8, 0x50, 0xb5, 0x32, 0x96, 0xf4,
+	0xbb, 0x45, 0x5b, 0x8a, 0x5f, 0x4c, 0x82, 0x2d, 0xdc, 0x4f, 0x3f, 0x20, 0x3f, 0x06, 0x88, 0x1f,
+	0xd5, 0x43, 0xc9, 0x28, 0xf3, 0x5a, 0x57, 0x57, 0x0b, 0xe7, 0xd3, 0x07, 0x48, 0x8b, 0x0e, 0x90,
+	0x13, 0xf2, 0x08, 0x5b, 0xbb, 0xb7, 0x9e, 0x3d, 0xaf, 0x2b, 0x9f, 0x3f, 0xaf, 0x2b, 0xff, 0x7a,
+	0x5e, 0x57, 0x7e, 0xf5, 0xa2, 0x3e, 0xf6, 0xf9, 0x8b, 0xfa, 0xd8, 0xdf, 0x5f, 0xd4, 0xc7, 0x7e,
+	0x74, 0xa5, 0x63, 0x07, 0x07, 0xbd, 0xfd, 0x46, 0xdb, 0x3b, 0xd4, 0x99, 0xe7, 0xfa, 0xdb, 0xb6,
+	0xc7, 0x7f, 0xf5, 0x8f, 0xb8, 0x1e, 0xfe, 0x5f, 0xef, 0xfe, 0x24, 0xff, 0xe3, 0xf6, 0x6b, 0xff,
+	0x1b, 0x00, 0x1a, 0x33, 0x6e, 0x79, 0x74, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ResolveDID resolves a DID to its DID document
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_query_docs.md"}}
+	ResolveDID(ctx context.Context, in *QueryResolveDIDRequest, opts ...grpc.CallOption) (*QueryResolveDIDResponse, error)
+	// GetDIDDocument retrieves a DID document by its ID
+	GetDIDDocument(ctx context.Context, in *QueryGetDIDDocumentRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentResponse, error)
+	// GetDIDDocumentVersion resolves a DID document at an earlier version,
+	// selected by version_id or version_time as in DID resolution
+	GetDIDDocumentVersion(ctx context.Context, in *QueryGetDIDDocumentVersionRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentVersionResponse, error)
+	// GetDIDDocumentHistory lists every version of a DID document, oldest first
+	GetDIDDocumentHistory(ctx context.Context, in *QueryGetDIDDocumentHistoryRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentHistoryResponse, error)
+	// GetDelegatedControllers lists the delegations of a DID that have not
+	// expired
+	GetDelegatedControllers(ctx context.Context, in *QueryGetDelegatedControllersRequest, opts ...grpc.CallOption) (*QueryGetDelegatedControllersResponse, error)
+	// GetDIDAssets lists the tokens a DID shows on its profile and whether its
+	// controllers still own them
+	GetDIDAssets(ctx context.Context, in *QueryGetDIDAssetsRequest, opts ...grpc.CallOption) (*QueryGetDIDAssetsResponse, error)
+	// ListDIDDocuments lists all DID documents with pagination
+	ListDIDDocuments(ctx context.Context, in *QueryListDIDDocumentsRequest, opts ...grpc.CallOption) (*QueryListDIDDocumentsResponse, error)
+	// GetDIDDocumentsByController retrieves DID documents by controller
+	GetDIDDocumentsByController(ctx context.Context, in *QueryGetDIDDocumentsByControllerRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentsByControllerResponse, error)
+	// GetVerificationMethod retrieves a specific verification method
+	GetVerificationMethod(ctx context.Context, in *QueryGetVerificationMethodRequest, opts ...grpc.CallOption) (*QueryGetVerificationMethodResponse, error)
+	// GetService retrieves a specific service endpoint
+	GetService(ctx context.Context, in *QueryGetServiceRequest, opts ...grpc.CallOption) (*QueryGetServiceResponse, error)
+	// GetVerifiableCredential retrieves a verifiable credential by ID
+	GetVerifiableCredential(ctx context.Context, in *QueryGetVerifiableCredentialRequest, opts ...grpc.CallOption) (*QueryGetVerifiableCredentialResponse, error)
+	// ListVerifiableCredentials lists all verifiable credentials with filtering options
+	ListVerifiableCredentials(ctx context.Context, in *QueryListVerifiableCredentialsRequest, opts ...grpc.CallOption) (*QueryListVerifiableCredentialsResponse, error)
+	// VerifyCredential checks the proof, revocation status and expiry of a
+	// verifiable credential
+	VerifyCredential(ctx context.Context, in *QueryVerifyCredentialRequest, opts ...grpc.CallOption) (*QueryVerifyCredentialResponse, error)
+	// GetStatusList returns a StatusList2021 revocation list of an issuer as a
+	// StatusList2021Credential
+	GetStatusList(ctx context.Context, in *QueryGetStatusListRequest, opts ...grpc.CallOption) (*QueryGetStatusListResponse, error)
+	// GetCredentialsByDID retrieves all credentials (verifiable and WebAuthn) associated with a DID
+	GetCredentialsByDID(ctx context.Context, in *QueryGetCredentialsByDIDRequest, opts ...grpc.CallOption) (*QueryGetCredentialsByDIDResponse, error)
+	// GetUnverifiedCredentials lists the WebAuthn credentials of a DID whose
+	// origin is no longer verified
+	GetUnverifiedCredentials(ctx context.Context, in *QueryGetUnverifiedCredentialsRequest, opts ...grpc.CallOption) (*QueryGetUnverifiedCredentialsResponse, error)
+	// RegisterStart represents the start of the registration process
+	RegisterStart(ctx context.Context, in *QueryRegisterStartRequest, opts ...grpc.CallOption) (*QueryRegisterStartResponse, error)
+	// LoginStart represents the start of the login process
+	LoginStart(ctx context.Context, in *QueryLoginStartRequest, opts ...grpc.CallOption) (*QueryLoginStartResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetVerifiableCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ResolveDID(ctx context.Context, in *QueryResolveDIDRequest, opts ...grpc.CallOption) (*QueryResolveDIDResponse, error) {
+	out := new(QueryResolveDIDResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/ResolveDID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Credential != nil {
-		{
-			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetDIDDocument(ctx context.Context, in *QueryGetDIDDocumentRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentResponse, error) {
+	out := new(QueryGetDIDDocumentResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListVerifiableCredentialsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDIDDocumentVersion(ctx context.Context, in *QueryGetDIDDocumentVersionRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentVersionResponse, error) {
+	out := new(QueryGetDIDDocumentVersionResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDDocumentVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListVerifiableCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDIDDocumentHistory(ctx context.Context, in *QueryGetDIDDocumentHistoryRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentHistoryResponse, error) {
+	out := new(QueryGetDIDDocumentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDDocumentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListVerifiableCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRevoked {
-		i--
-		if m.IncludeRevoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetDelegatedControllers(ctx context.Context, in *QueryGetDelegatedControllersRequest, opts ...grpc.CallOption) (*QueryGetDelegatedControllersResponse, error) {
+	out := new(QueryGetDelegatedControllersResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDelegatedControllers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListVerifiableCredentialsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDIDAssets(ctx context.Context, in *QueryGetDIDAssetsRequest, opts ...grpc.CallOption) (*QueryGetDIDAssetsResponse, error) {
+	out := new(QueryGetDIDAssetsResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDAssets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListVerifiableCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListDIDDocuments(ctx context.Context, in *QueryListDIDDocumentsRequest, opts ...grpc.CallOption) (*QueryListDIDDocumentsResponse, error) {
+	out := new(QueryListDIDDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/ListDIDDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListVerifiableCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Credentials) > 0 {
-		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetDIDDocumentsByController(ctx context.Context, in *QueryGetDIDDocumentsByControllerRequest, opts ...grpc.CallOption) (*QueryGetDIDDocumentsByControllerResponse, error) {
+	out := new(QueryGetDIDDocumentsByControllerResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetDIDDocumentsByController", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CredentialInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetVerificationMethod(ctx context.Context, in *QueryGetVerificationMethodRequest, opts ...grpc.CallOption) (*QueryGetVerificationMethodResponse, error) {
+	out := new(QueryGetVerificationMethodResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetVerificationMethod", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CredentialInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetService(ctx context.Context, in *QueryGetServiceRequest, opts ...grpc.CallOption) (*QueryGetServiceResponse, error) {
+	out := new(QueryGetServiceResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CredentialInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsEncrypted {
-		i--
-		if m.IsEncrypted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.VaultId) > 0 {
-		i -= len(m.VaultId)
-		copy(dAtA[i:], m.VaultId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Credential != nil {
-		{
-			size := m.Credential.Size()
-			i -= size
-			if _, err := m.Credential.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CredentialInfo_VerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CredentialInfo_VerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.VerifiableCredential != nil {
-		{
-			size, err := m.VerifiableCredential.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetVerifiableCredential(ctx context.Context, in *QueryGetVerifiableCredentialRequest, opts ...grpc.CallOption) (*QueryGetVerifiableCredentialResponse, error) {
+	out := new(QueryGetVerifiableCredentialResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetVerifiableCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CredentialInfo_WebauthnCredential) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *CredentialInfo_WebauthnCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.WebauthnCredential != nil {
-		{
-			size, err := m.WebauthnCredential.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *QueryGetCredentialsByDIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListVerifiableCredentials(ctx context.Context, in *QueryListVerifiableCredentialsRequest, opts ...grpc.CallOption) (*QueryListVerifiableCredentialsResponse, error) {
+	out := new(QueryListVerifiableCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/ListVerifiableCredentials", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetCredentialsByDIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetCredentialsByDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.IncludeRevoked {
-		i--
-		if m.IncludeRevoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.IncludeWebauthn {
-		i--
-		if m.IncludeWebauthn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IncludeVerifiable {
-		i--
-		if m.IncludeVerifiable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) VerifyCredential(ctx context.Context, in *QueryVerifyCredentialRequest, opts ...grpc.CallOption) (*QueryVerifyCredentialResponse, error) {
+	out := new(QueryVerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/VerifyCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetCredentialsByDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetStatusList(ctx context.Context, in *QueryGetStatusListRequest, opts ...grpc.CallOption) (*QueryGetStatusListResponse, error) {
+	out := new(QueryGetStatusListResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetStatusList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetCredentialsByDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetCredentialsByDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Credentials) > 0 {
-		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetCredentialsByDID(ctx context.Context, in *QueryGetCredentialsByDIDRequest, opts ...grpc.CallOption) (*QueryGetCredentialsByDIDResponse, error) {
+	out := new(QueryGetCredentialsByDIDResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetCredentialsByDID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRegisterStartRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetUnverifiedCredentials(ctx context.Context, in *QueryGetUnverifiedCredentialsRequest, opts ...grpc.CallOption) (*QueryGetUnverifiedCredentialsResponse, error) {
+	out := new(QueryGetUnverifiedCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/GetUnverifiedCredentials", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRegisterStartRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRegisterStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssertionDid) > 0 {
-		i -= len(m.AssertionDid)
-		copy(dAtA[i:], m.AssertionDid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssertionDid)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RegisterStart(ctx context.Context, in *QueryRegisterStartRequest, opts ...grpc.CallOption) (*QueryRegisterStartResponse, error) {
+	out := new(QueryRegisterStartResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/RegisterStart", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRegisterStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LoginStart(ctx context.Context, in *QueryLoginStartRequest, opts ...grpc.CallOption) (*QueryLoginStartResponse, error) {
+	out := new(QueryLoginStartResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Query/LoginStart", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRegisterStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRegisterStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		for k := range m.User {
-			v := m.User[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RelyingPartyId) > 0 {
-		i -= len(m.RelyingPartyId)
-		copy(dAtA[i:], m.RelyingPartyId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelyingPartyId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ResolveDID resolves a DID to its DID document
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_query_docs.md"}}
+	ResolveDID(context.Context, *QueryResolveDIDRequest) (*QueryResolveDIDResponse, error)
+	// GetDIDDocument retrieves a DID document by its ID
+	GetDIDDocument(context.Context, *QueryGetDIDDocumentRequest) (*QueryGetDIDDocumentResponse, error)
+	// GetDIDDocumentVersion resolves a DID document at an earlier version,
+	// selected by version_id or version_time as in DID resolution
+	GetDIDDocumentVersion(context.Context, *QueryGetDIDDocumentVersionRequest) (*QueryGetDIDDocumentVersionResponse, error)
+	// GetDIDDocumentHistory lists every version of a DID document, oldest first
+	GetDIDDocumentHistory(context.Context, *QueryGetDIDDocumentHistoryRequest) (*QueryGetDIDDocumentHistoryResponse, error)
+	// GetDelegatedControllers lists the delegations of a DID that have not
+	// expired
+	GetDelegatedControllers(context.Context, *QueryGetDelegatedControllersRequest) (*QueryGetDelegatedControllersResponse, error)
+	// GetDIDAssets lists the tokens a DID shows on its profile and whether its
+	// controllers still own them
+	GetDIDAssets(context.Context, *QueryGetDIDAssetsRequest) (*QueryGetDIDAssetsResponse, error)
+	// ListDIDDocuments lists all DID documents with pagination
+	ListDIDDocuments(context.Context, *QueryListDIDDocumentsRequest) (*QueryListDIDDocumentsResponse, error)
+	// GetDIDDocumentsByController retrieves DID documents by controller
+	GetDIDDocumentsByController(context.Context, *QueryGetDIDDocumentsByControllerRequest) (*QueryGetDIDDocumentsByControllerResponse, error)
+	// GetVerificationMethod retrieves a specific verification method
+	GetVerificationMethod(context.Context, *QueryGetVerificationMethodRequest) (*QueryGetVerificationMethodResponse, error)
+	// GetService retrieves a specific service endpoint
+	GetService(context.Context, *QueryGetServiceRequest) (*QueryGetServiceResponse, error)
+	// GetVerifiableCredential retrieves a verifiable credential by ID
+	GetVerifiableCredential(context.Context, *QueryGetVerifiableCredentialRequest) (*QueryGetVerifiableCredentialResponse, error)
+	// ListVerifiableCredentials lists all verifiable credentials with filtering options
+	ListVerifiableCredentials(context.Context, *QueryListVerifiableCredentialsRequest) (*QueryListVerifiableCredentialsResponse, error)
+	// VerifyCredential checks the proof, revocation status and expiry of a
+	// verifiable credential
+	VerifyCredential(context.Context, *QueryVerifyCredentialRequest) (*QueryVerifyCredentialResponse, error)
+	// GetStatusList returns a StatusList2021 revocation list of an issuer as a
+	// StatusList2021Credential
+	GetStatusList(context.Context, *QueryGetStatusListRequest) (*QueryGetStatusListResponse, error)
+	// GetCredentialsByDID retrieves all credentials (verifiable and WebAuthn) associated with a DID
+	GetCredentialsByDID(context.Context, *QueryGetCredentialsByDIDRequest) (*QueryGetCredentialsByDIDResponse, error)
+	// GetUnverifiedCredentials lists the WebAuthn credentials of a DID whose
+	// origin is no longer verified
+	GetUnverifiedCredentials(context.Context, *QueryGetUnverifiedCredentialsRequest) (*QueryGetUnverifiedCredentialsResponse, error)
+	// RegisterStart represents the start of the registration process
+	RegisterStart(context.Context, *QueryRegisterStartRequest) (*QueryRegisterStartResponse, error)
+	// LoginStart represents the start of the login process
+	LoginStart(context.Context, *QueryLoginStartRequest) (*QueryLoginStartResponse, error)
 }
 
-func (m *QueryLoginStartRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryLoginStartRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ResolveDID(ctx context.Context, req *QueryResolveDIDRequest) (*QueryResolveDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDID not implemented")
+}
+func (*UnimplementedQueryServer) GetDIDDocument(ctx context.Context, req *QueryGetDIDDocumentRequest) (*QueryGetDIDDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocument not implemented")
+}
+func (*UnimplementedQueryServer) GetDIDDocumentVersion(ctx context.Context, req *QueryGetDIDDocumentVersionRequest) (*QueryGetDIDDocumentVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocumentVersion not implemented")
+}
+func (*UnimplementedQueryServer) GetDIDDocumentHistory(ctx context.Context, req *QueryGetDIDDocumentHistoryRequest) (*QueryGetDIDDocumentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocumentHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegatedControllers(ctx context.Context, req *QueryGetDelegatedControllersRequest) (*QueryGetDelegatedControllersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatedControllers not implemented")
+}
+func (*UnimplementedQueryServer) GetDIDAssets(ctx context.Context, req *QueryGetDIDAssetsRequest) (*QueryGetDIDAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDAssets not implemented")
+}
+func (*UnimplementedQueryServer) ListDIDDocuments(ctx context.Context, req *QueryListDIDDocumentsRequest) (*QueryListDIDDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDIDDocuments not implemented")
+}
+func (*UnimplementedQueryServer) GetDIDDocumentsByController(ctx context.Context, req *QueryGetDIDDocumentsByControllerRequest) (*QueryGetDIDDocumentsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDDocumentsByController not implemented")
+}
+func (*UnimplementedQueryServer) GetVerificationMethod(ctx context.Context, req *QueryGetVerificationMethodRequest) (*QueryGetVerificationMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationMethod not implemented")
+}
+func (*UnimplementedQueryServer) GetService(ctx context.Context, req *QueryGetServiceRequest) (*QueryGetServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetService not implemented")
+}
+func (*UnimplementedQueryServer) GetVerifiableCredential(ctx context.Context, req *QueryGetVerifiableCredentialRequest) (*QueryGetVerifiableCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerifiableCredential not implemented")
+}
+func (*UnimplementedQueryServer) ListVerifiableCredentials(ctx context.Context, req *QueryListVerifiableCredentialsRequest) (*QueryListVerifiableCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVerifiableCredentials not implemented")
+}
+func (*UnimplementedQueryServer) VerifyCredential(ctx context.Context, req *QueryVerifyCredentialRequest) (*QueryVerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (*UnimplementedQueryServer) GetStatusList(ctx context.Context, req *QueryGetStatusListRequest) (*QueryGetStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusList not implemented")
+}
+func (*UnimplementedQueryServer) GetCredentialsByDID(ctx context.Context, req *QueryGetCredentialsByDIDRequest) (*QueryGetCredentialsByDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredentialsByDID not implemented")
+}
+func (*UnimplementedQueryServer) GetUnverifiedCredentials(ctx context.Context, req *QueryGetUnverifiedCredentialsRequest) (*QueryGetUnverifiedCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnverifiedCredentials not implemented")
+}
+func (*UnimplementedQueryServer) RegisterStart(ctx context.Context, req *QueryRegisterStartRequest) (*QueryRegisterStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterStart not implemented")
+}
+func (*UnimplementedQueryServer) LoginStart(ctx context.Context, req *QueryLoginStartRequest) (*QueryLoginStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginStart not implemented")
 }
 
-func (m *QueryLoginStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssertionDid) > 0 {
-		i -= len(m.AssertionDid)
-		copy(dAtA[i:], m.AssertionDid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssertionDid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryLoginStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLoginStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLoginStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RelyingPartyId) > 0 {
-		i -= len(m.RelyingPartyId)
-		copy(dAtA[i:], m.RelyingPartyId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelyingPartyId)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_ResolveDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveDIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveDID(ctx, in)
 	}
-	if len(m.CredentialIds) > 0 {
-		for iNdEx := len(m.CredentialIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CredentialIds[iNdEx])
-			copy(dAtA[i:], m.CredentialIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/ResolveDID",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveDID(ctx, req.(*QueryResolveDIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_GetDIDDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDDocument(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetDIDDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDDocument(ctx, req.(*QueryGetDIDDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDIDDocumentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDDocumentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDDocumentVersion(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetDIDDocumentVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDDocumentVersion(ctx, req.(*QueryGetDIDDocumentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveDIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDIDDocumentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDDocumentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDDocumentHistory(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetDIDDocumentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDDocumentHistory(ctx, req.(*QueryGetDIDDocumentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDelegatedControllers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatedControllersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DidDocument != nil {
-		l = m.DidDocument.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatedControllers(ctx, in)
 	}
-	if m.DidDocumentMetadata != nil {
-		l = m.DidDocumentMetadata.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetDelegatedControllers",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatedControllers(ctx, req.(*QueryGetDelegatedControllersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDIDDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDIDAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDAssets(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetDIDAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDAssets(ctx, req.(*QueryGetDIDAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDIDDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListDIDDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDIDDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DidDocument != nil {
-		l = m.DidDocument.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListDIDDocuments(ctx, in)
 	}
-	if m.DidDocumentMetadata != nil {
-		l = m.DidDocumentMetadata.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/ListDIDDocuments",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDIDDocuments(ctx, req.(*QueryListDIDDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListDIDDocumentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDIDDocumentsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDIDDocumentsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDIDDocumentsByController(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetDIDDocumentsByController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDIDDocumentsByController(ctx, req.(*QueryGetDIDDocumentsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListDIDDocumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerificationMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DidDocuments) > 0 {
-		for _, e := range m.DidDocuments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerificationMethod(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetVerificationMethod",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerificationMethod(ctx, req.(*QueryGetVerificationMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDIDDocumentsByControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetService(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetService",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetService(ctx, req.(*QueryGetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDIDDocumentsByControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetVerifiableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerifiableCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DidDocuments) > 0 {
-		for _, e := range m.DidDocuments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerifiableCredential(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetVerifiableCredential",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerifiableCredential(ctx, req.(*QueryGetVerifiableCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetVerificationMethodRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListVerifiableCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListVerifiableCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListVerifiableCredentials(ctx, in)
 	}
-	l = len(m.MethodId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/ListVerifiableCredentials",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVerifiableCredentials(ctx, req.(*QueryListVerifiableCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetVerificationMethodResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.VerificationMethod != nil {
-		l = m.VerificationMethod.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCredential(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/VerifyCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCredential(ctx, req.(*QueryVerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetStatusList(ctx, in)
 	}
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetStatusList",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStatusList(ctx, req.(*QueryGetStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetCredentialsByDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCredentialsByDIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetCredentialsByDID(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetCredentialsByDID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCredentialsByDID(ctx, req.(*QueryGetCredentialsByDIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetVerifiableCredentialRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetUnverifiedCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUnverifiedCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CredentialId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetUnverifiedCredentials(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetVerifiableCredentialResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/GetUnverifiedCredentials",
 	}
-	var l int
-	_ = l
-	if m.Credential != nil {
-		l = m.Credential.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUnverifiedCredentials(ctx, req.(*QueryGetUnverifiedCredentialsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListVerifiableCredentialsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_RegisterStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisterStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RegisterStart(ctx, in)
 	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/RegisterStart",
 	}
-	if m.IncludeRevoked {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisterStart(ctx, req.(*QueryRegisterStartRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListVerifiableCredentialsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LoginStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLoginStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Credentials) > 0 {
-		for _, e := range m.Credentials {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LoginStart(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Query/LoginStart",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LoginStart(ctx, req.(*QueryLoginStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CredentialInfo) Size() (n int) {
-	if m == nil {
-		return 0
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "did.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ResolveDID",
+			Handler:    _Query_ResolveDID_Handler,
+		},
+		{
+			MethodName: "GetDIDDocument",
+			Handler:    _Query_GetDIDDocument_Handler,
+		},
+		{
+			MethodName: "GetDIDDocumentVersion",
+			Handler:    _Query_GetDIDDocumentVersion_Handler,
+		},
+		{
+			MethodName: "GetDIDDocumentHistory",
+			Handler:    _Query_GetDIDDocumentHistory_Handler,
+		},
+		{
+			MethodName: "GetDelegatedControllers",
+			Handler:    _Query_GetDelegatedControllers_Handler,
+		},
+		{
+			MethodName: "GetDIDAssets",
+			Handler:    _Query_GetDIDAssets_Handler,
+		},
+		{
+			MethodName: "ListDIDDocuments",
+			Handler:    _Query_ListDIDDocuments_Handler,
+		},
+		{
+			MethodName: "GetDIDDocumentsByController",
+			Handler:    _Query_GetDIDDocumentsByController_Handler,
+		},
+		{
+			MethodName: "GetVerificationMethod",
+			Handler:    _Query_GetVerificationMethod_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _Query_GetService_Handler,
+		},
+		{
+			MethodName: "GetVerifiableCredential",
+			Handler:    _Query_GetVerifiableCredential_Handler,
+		},
+		{
+			MethodName: "ListVerifiableCredentials",
+			Handler:    _Query_ListVerifiableCredentials_Handler,
+		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _Query_VerifyCredential_Handler,
+		},
+		{
+			MethodName: "GetStatusList",
+			Handler:    _Query_GetStatusList_Handler,
+		},
+		{
+			MethodName: "GetCredentialsByDID",
+			Handler:    _Query_GetCredentialsByDID_Handler,
+		},
+		{
+			MethodName: "GetUnverifiedCredentials",
+			Handler:    _Query_GetUnverifiedCredentials_Handler,
+		},
+		{
+			MethodName: "RegisterStart",
+			Handler:    _Query_RegisterStart_Handler,
+		},
+		{
+			MethodName: "LoginStart",
+			Handler:    _Query_LoginStart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "did/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Credential != nil {
-		n += m.Credential.Size()
-	}
-	l = len(m.VaultId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IsEncrypted {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CredentialInfo_VerifiableCredential) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VerifiableCredential != nil {
-		l = m.VerifiableCredential.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CredentialInfo_WebauthnCredential) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryResolveDIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WebauthnCredential != nil {
-		l = m.WebauthnCredential.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *QueryGetCredentialsByDIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryResolveDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.IncludeVerifiable {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IncludeWebauthn {
-		n += 2
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.IncludeRevoked {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCredentialsByDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetDIDDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Credentials) > 0 {
-		for _, e := range m.Credentials {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionTime) > 0 {
+		i -= len(m.VersionTime)
+		copy(dAtA[i:], m.VersionTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VersionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VersionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatedControllersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatedControllersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatedControllersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatedControllersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatedControllersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatedControllersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OwnedOnly {
+		i--
+		if m.OwnedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DIDAssetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DIDAssetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DIDAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Owned {
+		i--
+		if m.Owned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDIDDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDIDDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDIDDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDIDDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDIDDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDIDDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocuments) > 0 {
+		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentsByControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentsByControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentsByControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDIDDocumentsByControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDIDDocumentsByControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDIDDocumentsByControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocuments) > 0 {
+		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerificationMethodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerificationMethodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerificationMethodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MethodId) > 0 {
+		i -= len(m.MethodId)
+		copy(dAtA[i:], m.MethodId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MethodId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerificationMethodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationMethod != nil {
+		{
+			size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifiableCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifiableCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifiableCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifiableCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifiableCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProofVerified {
+		i--
+		if m.ProofVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStatusListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStatusListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStatusListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ListId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ListId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStatusListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.StatusList.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVerifiableCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVerifiableCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVerifiableCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRevoked {
+		i--
+		if m.IncludeRevoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVerifiableCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVerifiableCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVerifiableCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsEncrypted {
+		i--
+		if m.IsEncrypted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VaultId) > 0 {
+		i -= len(m.VaultId)
+		copy(dAtA[i:], m.VaultId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Credential != nil {
+		{
+			size := m.Credential.Size()
+			i -= size
+			if _, err := m.Credential.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialInfo_VerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialInfo_VerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VerifiableCredential != nil {
+		{
+			size, err := m.VerifiableCredential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CredentialInfo_WebauthnCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialInfo_WebauthnCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WebauthnCredential != nil {
+		{
+			size, err := m.WebauthnCredential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueryGetCredentialsByDIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialsByDIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialsByDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IncludeRevoked {
+		i--
+		if m.IncludeRevoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IncludeWebauthn {
+		i--
+		if m.IncludeWebauthn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncludeVerifiable {
+		i--
+		if m.IncludeVerifiable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialsByDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialsByDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialsByDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisterStartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisterStartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisterStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssertionDid) > 0 {
+		i -= len(m.AssertionDid)
+		copy(dAtA[i:], m.AssertionDid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssertionDid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisterStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisterStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisterStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		for k := range m.User {
+			v := m.User[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RelyingPartyId) > 0 {
+		i -= len(m.RelyingPartyId)
+		copy(dAtA[i:], m.RelyingPartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelyingPartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLoginStartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLoginStartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLoginStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssertionDid) > 0 {
+		i -= len(m.AssertionDid)
+		copy(dAtA[i:], m.AssertionDid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssertionDid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLoginStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLoginStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLoginStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelyingPartyId) > 0 {
+		i -= len(m.RelyingPartyId)
+		copy(dAtA[i:], m.RelyingPartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelyingPartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CredentialIds) > 0 {
+		for iNdEx := len(m.CredentialIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CredentialIds[iNdEx])
+			copy(dAtA[i:], m.CredentialIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUnverifiedCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUnverifiedCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUnverifiedCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUnverifiedCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUnverifiedCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUnverifiedCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downgraded {
+		i--
+		if m.Downgraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveDIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VersionId != 0 {
+		n += 1 + sovQuery(uint64(m.VersionId))
+	}
+	l = len(m.VersionTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatedControllersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatedControllersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetDIDAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OwnedOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetDIDAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DIDAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Owned {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListDIDDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDIDDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocuments) > 0 {
+		for _, e := range m.DidDocuments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentsByControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDIDDocumentsByControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocuments) > 0 {
+		for _, e := range m.DidDocuments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerificationMethodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MethodId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerificationMethodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerificationMethod != nil {
+		l = m.VerificationMethod.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifiableCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifiableCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.ProofVerified {
+		n += 2
+	}
+	if m.Revoked {
+		n += 2
+	}
+	if m.Expired {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStatusListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ListId != 0 {
+		n += 1 + sovQuery(uint64(m.ListId))
+	}
+	return n
+}
+
+func (m *QueryGetStatusListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StatusList.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListVerifiableCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRevoked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListVerifiableCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CredentialInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credential != nil {
+		n += m.Credential.Size()
+	}
+	l = len(m.VaultId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsEncrypted {
+		n += 2
+	}
+	return n
+}
+
+func (m *CredentialInfo_VerifiableCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerifiableCredential != nil {
+		l = m.VerifiableCredential.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *CredentialInfo_WebauthnCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WebauthnCredential != nil {
+		l = m.WebauthnCredential.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryGetCredentialsByDIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeVerifiable {
+		n += 2
+	}
+	if m.IncludeWebauthn {
+		n += 2
+	}
+	if m.IncludeRevoked {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialsByDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisterStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssertionDid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisterStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RelyingPartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.User) > 0 {
+		for k, v := range m.User {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryLoginStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssertionDid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLoginStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CredentialIds) > 0 {
+		for _, s := range m.CredentialIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RelyingPartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUnverifiedCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUnverifiedCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Downgraded {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DIDDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &DIDDocumentMetadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDIDDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDIDDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DIDDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &DIDDocumentMetadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDIDDocumentVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			m.VersionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VersionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDIDDocumentVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DIDDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &DIDDocumentMetadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDIDDocumentHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDIDDocumentHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDDocumentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &DIDDocumentVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatedControllersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatedControllersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatedControllersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatedControllersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatedControllersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatedControllersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegatedController{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryRegisterStartRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssertionDid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryRegisterStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RelyingPartyId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.User) > 0 {
-		for k, v := range m.User {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+func (m *QueryGetDIDAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryLoginStartRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssertionDid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryLoginStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CredentialIds) > 0 {
-		for _, s := range m.CredentialIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDIDAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDIDAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OwnedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RelyingPartyId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDIDAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3636,12 +6993,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDIDAssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDIDAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, DIDAssetInfo{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3663,7 +7054,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *DIDAssetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3682,21 +7073,54 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DIDAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DIDAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3706,28 +7130,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owned", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Owned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3749,7 +7189,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveDIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListDIDDocumentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3772,17 +7212,17 @@ func (m *QueryResolveDIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveDIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListDIDDocumentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListDIDDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3792,23 +7232,27 @@ func (m *QueryResolveDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3831,7 +7275,7 @@ func (m *QueryResolveDIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListDIDDocumentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3854,15 +7298,15 @@ func (m *QueryResolveDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListDIDDocumentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListDIDDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3889,16 +7333,14 @@ func (m *QueryResolveDIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocument == nil {
-				m.DidDocument = &DIDDocument{}
-			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DidDocuments = append(m.DidDocuments, &DIDDocument{})
+			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3925,10 +7367,10 @@ func (m *QueryResolveDIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocumentMetadata == nil {
-				m.DidDocumentMetadata = &DIDDocumentMetadata{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3953,7 +7395,7 @@ func (m *QueryResolveDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDIDDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDIDDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3976,15 +7418,15 @@ func (m *QueryGetDIDDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4012,7 +7454,43 @@ func (m *QueryGetDIDDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4035,7 +7513,7 @@ func (m *QueryGetDIDDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDIDDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDIDDocumentsByControllerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4058,15 +7536,15 @@ func (m *QueryGetDIDDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4093,16 +7571,14 @@ func (m *QueryGetDIDDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocument == nil {
-				m.DidDocument = &DIDDocument{}
-			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DidDocuments = append(m.DidDocuments, &DIDDocument{})
+			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4129,10 +7605,10 @@ func (m *QueryGetDIDDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocumentMetadata == nil {
-				m.DidDocumentMetadata = &DIDDocumentMetadata{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4157,7 +7633,7 @@ func (m *QueryGetDIDDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListDIDDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerificationMethodRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4180,17 +7656,17 @@ func (m *QueryListDIDDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListDIDDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerificationMethodRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListDIDDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVerificationMethodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4200,27 +7676,55 @@ func (m *QueryListDIDDocumentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MethodId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4243,7 +7747,7 @@ func (m *QueryListDIDDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListDIDDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4266,49 +7770,15 @@ func (m *QueryListDIDDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListDIDDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerificationMethodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListDIDDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DidDocuments = append(m.DidDocuments, &DIDDocument{})
-			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: QueryGetVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4335,10 +7805,10 @@ func (m *QueryListDIDDocumentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.VerificationMethod == nil {
+				m.VerificationMethod = &VerificationMethod{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4363,7 +7833,7 @@ func (m *QueryListDIDDocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDIDDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetServiceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4386,15 +7856,15 @@ func (m *QueryGetDIDDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetServiceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4422,13 +7892,13 @@ func (m *QueryGetDIDDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4438,27 +7908,23 @@ func (m *QueryGetDIDDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4481,7 +7947,7 @@ func (m *QueryGetDIDDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDIDDocumentsByControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetServiceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4504,49 +7970,15 @@ func (m *QueryGetDIDDocumentsByControllerResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetServiceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDIDDocumentsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DidDocuments = append(m.DidDocuments, &DIDDocument{})
-			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4573,10 +8005,10 @@ func (m *QueryGetDIDDocumentsByControllerResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4601,7 +8033,7 @@ func (m *QueryGetDIDDocumentsByControllerResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetVerificationMethodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerifiableCredentialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4624,47 +8056,15 @@ func (m *QueryGetVerificationMethodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerificationMethodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerificationMethodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MethodId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4692,7 +8092,7 @@ func (m *QueryGetVerificationMethodRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MethodId = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4715,7 +8115,7 @@ func (m *QueryGetVerificationMethodRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVerificationMethodResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4738,15 +8138,15 @@ func (m *QueryGetVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerificationMethodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4773,10 +8173,10 @@ func (m *QueryGetVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerificationMethod == nil {
-				m.VerificationMethod = &VerificationMethod{}
+			if m.Credential == nil {
+				m.Credential = &VerifiableCredential{}
 			}
-			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4801,7 +8201,7 @@ func (m *QueryGetVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCredentialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4824,47 +8224,15 @@ func (m *QueryGetServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCredentialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4892,7 +8260,7 @@ func (m *QueryGetServiceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4915,7 +8283,7 @@ func (m *QueryGetServiceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4938,17 +8306,97 @@ func (m *QueryGetServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProofVerified = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4958,27 +8406,23 @@ func (m *QueryGetServiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5001,7 +8445,7 @@ func (m *QueryGetServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVerifiableCredentialRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStatusListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5024,15 +8468,15 @@ func (m *QueryGetVerifiableCredentialRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStatusListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerifiableCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStatusListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5060,8 +8504,27 @@ func (m *QueryGetVerifiableCredentialRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListId", wireType)
+			}
+			m.ListId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5083,7 +8546,7 @@ func (m *QueryGetVerifiableCredentialRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStatusListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5106,15 +8569,15 @@ func (m *QueryGetVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStatusListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5141,13 +8604,42 @@ func (m *QueryGetVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credential == nil {
-				m.Credential = &VerifiableCredential{}
-			}
-			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatusList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6484,6 +9976,192 @@ func (m *QueryLoginStartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetUnverifiedCredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUnverifiedCredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUnverifiedCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUnverifiedCredentialsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUnverifiedCredentialsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUnverifiedCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = append(m.Credentials, UnverifiedCredential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downgraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Downgraded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DIDStatus is the lifecycle state of a DID
+type DIDStatus int32
+
+const (
+	// DID_STATUS_UNSPECIFIED is the status of metadata written before statuses
+	// were recorded; the document's deactivated flag applies
+	DIDStatus_DID_STATUS_UNSPECIFIED DIDStatus = 0
+	// DID_STATUS_ACTIVE DIDs can be updated and used to sign
+	DIDStatus_DID_STATUS_ACTIVE DIDStatus = 1
+	// DID_STATUS_DEACTIVATED DIDs resolve but cannot be updated or used to
+	// sign until their controller reactivates them
+	DIDStatus_DID_STATUS_DEACTIVATED DIDStatus = 2
+)
+
+var DIDStatus_name = map[int32]string{
+	0: "DID_STATUS_UNSPECIFIED",
+	1: "DID_STATUS_ACTIVE",
+	2: "DID_STATUS_DEACTIVATED",
+}
+
+var DIDStatus_value = map[string]int32{
+	"DID_STATUS_UNSPECIFIED": 0,
+	"DID_STATUS_ACTIVE":      1,
+	"DID_STATUS_DEACTIVATED": 2,
+}
+
+func (x DIDStatus) String() string {
+	return proto.EnumName(DIDStatus_name, int32(x))
+}
+
+func (DIDStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{0}
+}
+
 // Authentication is the authentication method to be used by the DID.
 type Authentication struct {
 	// The unique identifier of the assertion
@@ -667,6 +701,8 @@ type DIDDocumentMetadata struct {
 	EquivalentId []string `protobuf:"bytes,8,rep,name=equivalent_id,json=equivalentId,proto3" json:"equivalent_id,omitempty"`
 	// canonicalId is the canonical DID
 	CanonicalId string `protobuf:"bytes,9,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	// status is the lifecycle state of the DID
+	Status DIDStatus `protobuf:"varint,10,opt,name=status,proto3,enum=did.v1.DIDStatus" json:"status,omitempty"`
 }
 
 func (m *DIDDocumentMetadata) Reset()         { *m = DIDDocumentMetadata{} }
@@ -765,49 +801,41 @@ func (m *DIDDocumentMetadata) GetCanonicalId() string {
 	return ""
 }
 
-// VerifiableCredential represents a W3C Verifiable Credential
-type VerifiableCredential struct {
-	// id is the credential identifier
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// context is the JSON-LD contexts
-	Context []string `protobuf:"bytes,2,rep,name=context,proto3" json:"context,omitempty"`
-	// credential_kinds is the credential types
-	CredentialKinds []string `protobuf:"bytes,3,rep,name=credential_kinds,json=credentialKinds,proto3" json:"credential_kinds,omitempty"`
-	// issuer is the DID of the credential issuer
-	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// issuanceDate is when the credential was issued
-	IssuanceDate string `protobuf:"bytes,5,opt,name=issuance_date,json=issuanceDate,proto3" json:"issuance_date,omitempty"`
-	// expirationDate is when the credential expires (optional)
-	ExpirationDate string `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
-	// credentialSubject contains the claims about the subject as JSON
-	CredentialSubject []byte `protobuf:"bytes,7,opt,name=credential_subject,json=credentialSubject,proto3" json:"credential_subject,omitempty"`
-	// proof contains the cryptographic proof
-	Proof []*CredentialProof `protobuf:"bytes,8,rep,name=proof,proto3" json:"proof,omitempty"`
-	// credentialStatus contains information about credential revocation
-	// (optional)
-	CredentialStatus *CredentialStatus `protobuf:"bytes,9,opt,name=credential_status,json=credentialStatus,proto3" json:"credential_status,omitempty"`
-	// subject is the DID of the credential subject (for indexing)
-	Subject string `protobuf:"bytes,10,opt,name=subject,proto3" json:"subject,omitempty"`
-	// Block height when issued
-	IssuedAt int64 `protobuf:"varint,11,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
-	// Block height when expires (0 if no expiration)
-	ExpiresAt int64 `protobuf:"varint,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	// Whether the credential is revoked
-	Revoked bool `protobuf:"varint,13,opt,name=revoked,proto3" json:"revoked,omitempty"`
+func (m *DIDDocumentMetadata) GetStatus() DIDStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DIDStatus_DID_STATUS_UNSPECIFIED
 }
 
-func (m *VerifiableCredential) Reset()         { *m = VerifiableCredential{} }
-func (m *VerifiableCredential) String() string { return proto.CompactTextString(m) }
-func (*VerifiableCredential) ProtoMessage()    {}
-func (*VerifiableCredential) Descriptor() ([]byte, []int) {
+// DIDDocumentVersion is a DID document as it was after one change. Every
+// change to a document is stored as a new version, so earlier versions can be
+// resolved by versionId or versionTime.
+type DIDDocumentVersion struct {
+	// did is the DID the document belongs to
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// version_id is the document's version number, starting at 1
+	VersionId uint64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// version_time is the block time the version was written, in unix seconds
+	VersionTime int64 `protobuf:"varint,3,opt,name=version_time,json=versionTime,proto3" json:"version_time,omitempty"`
+	// block_height is the height the version was written at
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// document is the DID document at this version
+	Document *DIDDocument `protobuf:"bytes,5,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (m *DIDDocumentVersion) Reset()         { *m = DIDDocumentVersion{} }
+func (m *DIDDocumentVersion) String() string { return proto.CompactTextString(m) }
+func (*DIDDocumentVersion) ProtoMessage()    {}
+func (*DIDDocumentVersion) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f44bb702879c34b4, []int{7}
 }
-func (m *VerifiableCredential) XXX_Unmarshal(b []byte) error {
+func (m *DIDDocumentVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VerifiableCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DIDDocumentVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VerifiableCredential.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DIDDocumentVersion.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -817,133 +845,269 @@ func (m *VerifiableCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *VerifiableCredential) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifiableCredential.Merge(m, src)
+func (m *DIDDocumentVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DIDDocumentVersion.Merge(m, src)
 }
-func (m *VerifiableCredential) XXX_Size() int {
+func (m *DIDDocumentVersion) XXX_Size() int {
 	return m.Size()
 }
-func (m *VerifiableCredential) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifiableCredential.DiscardUnknown(m)
+func (m *DIDDocumentVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DIDDocumentVersion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifiableCredential proto.InternalMessageInfo
+var xxx_messageInfo_DIDDocumentVersion proto.InternalMessageInfo
 
-func (m *VerifiableCredential) GetId() string {
+func (m *DIDDocumentVersion) GetDid() string {
 	if m != nil {
-		return m.Id
+		return m.Did
 	}
 	return ""
 }
 
-func (m *VerifiableCredential) GetContext() []string {
+func (m *DIDDocumentVersion) GetVersionId() uint64 {
 	if m != nil {
-		return m.Context
+		return m.VersionId
 	}
-	return nil
+	return 0
 }
 
-func (m *VerifiableCredential) GetCredentialKinds() []string {
+func (m *DIDDocumentVersion) GetVersionTime() int64 {
 	if m != nil {
-		return m.CredentialKinds
+		return m.VersionTime
+	}
+	return 0
+}
+
+func (m *DIDDocumentVersion) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *DIDDocumentVersion) GetDocument() *DIDDocument {
+	if m != nil {
+		return m.Document
 	}
 	return nil
 }
 
-func (m *VerifiableCredential) GetIssuer() string {
+// UnverifiedCredential is a WebAuthn credential whose origin is no longer
+// verified by x/svc or allowed by the module params. It stays flagged until a
+// later check finds the origin verified again.
+type UnverifiedCredential struct {
+	// did is the DID whose document holds the credential
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// verification_method_id is the verification method carrying the credential
+	VerificationMethodId string `protobuf:"bytes,2,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+	// credential_id is the WebAuthn credential ID
+	CredentialId string `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// origin is the origin the credential was registered at
+	Origin string `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	// reason is why the origin failed verification
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// flagged_at is the block height the credential was flagged at
+	FlaggedAt int64 `protobuf:"varint,6,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+}
+
+func (m *UnverifiedCredential) Reset()         { *m = UnverifiedCredential{} }
+func (m *UnverifiedCredential) String() string { return proto.CompactTextString(m) }
+func (*UnverifiedCredential) ProtoMessage()    {}
+func (*UnverifiedCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{8}
+}
+func (m *UnverifiedCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnverifiedCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnverifiedCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnverifiedCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnverifiedCredential.Merge(m, src)
+}
+func (m *UnverifiedCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnverifiedCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnverifiedCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnverifiedCredential proto.InternalMessageInfo
+
+func (m *UnverifiedCredential) GetDid() string {
 	if m != nil {
-		return m.Issuer
+		return m.Did
 	}
 	return ""
 }
 
-func (m *VerifiableCredential) GetIssuanceDate() string {
+func (m *UnverifiedCredential) GetVerificationMethodId() string {
 	if m != nil {
-		return m.IssuanceDate
+		return m.VerificationMethodId
 	}
 	return ""
 }
 
-func (m *VerifiableCredential) GetExpirationDate() string {
+func (m *UnverifiedCredential) GetCredentialId() string {
 	if m != nil {
-		return m.ExpirationDate
+		return m.CredentialId
 	}
 	return ""
 }
 
-func (m *VerifiableCredential) GetCredentialSubject() []byte {
+func (m *UnverifiedCredential) GetOrigin() string {
 	if m != nil {
-		return m.CredentialSubject
+		return m.Origin
 	}
-	return nil
+	return ""
 }
 
-func (m *VerifiableCredential) GetProof() []*CredentialProof {
+func (m *UnverifiedCredential) GetReason() string {
 	if m != nil {
-		return m.Proof
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-func (m *VerifiableCredential) GetCredentialStatus() *CredentialStatus {
+func (m *UnverifiedCredential) GetFlaggedAt() int64 {
 	if m != nil {
-		return m.CredentialStatus
+		return m.FlaggedAt
 	}
-	return nil
+	return 0
 }
 
-func (m *VerifiableCredential) GetSubject() string {
+// StatusList is a StatusList2021 bitstring of an issuer. Bit i, counted from
+// the most significant bit of the first byte, is set when the credential
+// holding index i of the list is revoked.
+type StatusList struct {
+	// issuer is the DID of the issuer the list belongs to
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// list_id numbers the issuer's lists from 1
+	ListId uint64 `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	// purpose is the status purpose, always "revocation"
+	Purpose string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// bitstring is the uncompressed list
+	Bitstring []byte `protobuf:"bytes,4,opt,name=bitstring,proto3" json:"bitstring,omitempty"`
+	// allocated is the number of indexes handed out
+	Allocated uint64 `protobuf:"varint,5,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	// updated_at is the block time of the last change, in unix seconds
+	UpdatedAt int64 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *StatusList) Reset()         { *m = StatusList{} }
+func (m *StatusList) String() string { return proto.CompactTextString(m) }
+func (*StatusList) ProtoMessage()    {}
+func (*StatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{9}
+}
+func (m *StatusList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusList.Merge(m, src)
+}
+func (m *StatusList) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusList proto.InternalMessageInfo
+
+func (m *StatusList) GetIssuer() string {
 	if m != nil {
-		return m.Subject
+		return m.Issuer
 	}
 	return ""
 }
 
-func (m *VerifiableCredential) GetIssuedAt() int64 {
+func (m *StatusList) GetListId() uint64 {
 	if m != nil {
-		return m.IssuedAt
+		return m.ListId
 	}
 	return 0
 }
 
-func (m *VerifiableCredential) GetExpiresAt() int64 {
+func (m *StatusList) GetPurpose() string {
 	if m != nil {
-		return m.ExpiresAt
+		return m.Purpose
 	}
-	return 0
+	return ""
 }
 
-func (m *VerifiableCredential) GetRevoked() bool {
+func (m *StatusList) GetBitstring() []byte {
 	if m != nil {
-		return m.Revoked
+		return m.Bitstring
 	}
-	return false
+	return nil
 }
 
-// DIDController represents additional controllers for a DID document
-type DIDController struct {
-	// id is the auto-incrementing primary key
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// did is the DID this controller belongs to
-	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	// controller_did is the controller DID
-	ControllerDid string `protobuf:"bytes,3,opt,name=controller_did,json=controllerDid,proto3" json:"controller_did,omitempty"`
-	// added_at is when this controller was added
-	AddedAt int64 `protobuf:"varint,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+func (m *StatusList) GetAllocated() uint64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
 }
 
-func (m *DIDController) Reset()         { *m = DIDController{} }
-func (m *DIDController) String() string { return proto.CompactTextString(m) }
-func (*DIDController) ProtoMessage()    {}
-func (*DIDController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f44bb702879c34b4, []int{8}
+func (m *StatusList) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
 }
-func (m *DIDController) XXX_Unmarshal(b []byte) error {
+
+// DelegatedController is a temporary controller of a DID, such as a recovery
+// service or an enterprise admin. It is authorized like a controller until
+// expires_at, and removed at the end of the first block past it.
+type DelegatedController struct {
+	// did is the DID the controller was delegated
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// controller is the address of the delegated controller
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// granted_by is the controller that added the delegation
+	GrantedBy string `protobuf:"bytes,3,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	// purpose describes what the delegation is for
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// granted_at is the block time the delegation was added, in unix seconds
+	GrantedAt int64 `protobuf:"varint,5,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	// expires_at is the block time the delegation ends, in unix seconds
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *DelegatedController) Reset()         { *m = DelegatedController{} }
+func (m *DelegatedController) String() string { return proto.CompactTextString(m) }
+func (*DelegatedController) ProtoMessage()    {}
+func (*DelegatedController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{10}
+}
+func (m *DelegatedController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DIDController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegatedController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DIDController.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegatedController.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -953,344 +1117,574 @@ func (m *DIDController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DIDController) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DIDController.Merge(m, src)
+func (m *DelegatedController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedController.Merge(m, src)
 }
-func (m *DIDController) XXX_Size() int {
+func (m *DelegatedController) XXX_Size() int {
 	return m.Size()
 }
-func (m *DIDController) XXX_DiscardUnknown() {
-	xxx_messageInfo_DIDController.DiscardUnknown(m)
+func (m *DelegatedController) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedController.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DIDController proto.InternalMessageInfo
+var xxx_messageInfo_DelegatedController proto.InternalMessageInfo
 
-func (m *DIDController) GetId() uint64 {
+func (m *DelegatedController) GetDid() string {
 	if m != nil {
-		return m.Id
+		return m.Did
 	}
-	return 0
+	return ""
 }
 
-func (m *DIDController) GetDid() string {
+func (m *DelegatedController) GetController() string {
 	if m != nil {
-		return m.Did
+		return m.Controller
 	}
 	return ""
 }
 
-func (m *DIDController) GetControllerDid() string {
+func (m *DelegatedController) GetGrantedBy() string {
 	if m != nil {
-		return m.ControllerDid
+		return m.GrantedBy
 	}
 	return ""
 }
 
-func (m *DIDController) GetAddedAt() int64 {
+func (m *DelegatedController) GetPurpose() string {
 	if m != nil {
-		return m.AddedAt
+		return m.Purpose
+	}
+	return ""
+}
+
+func (m *DelegatedController) GetGrantedAt() int64 {
+	if m != nil {
+		return m.GrantedAt
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Authentication)(nil), "did.v1.Authentication")
-	proto.RegisterType((*Assertion)(nil), "did.v1.Assertion")
-	proto.RegisterType((*Controller)(nil), "did.v1.Controller")
-	proto.RegisterType((*Delegation)(nil), "did.v1.Delegation")
-	proto.RegisterType((*Invocation)(nil), "did.v1.Invocation")
-	proto.RegisterType((*DIDDocument)(nil), "did.v1.DIDDocument")
-	proto.RegisterType((*DIDDocumentMetadata)(nil), "did.v1.DIDDocumentMetadata")
-	proto.RegisterType((*VerifiableCredential)(nil), "did.v1.VerifiableCredential")
-	proto.RegisterType((*DIDController)(nil), "did.v1.DIDController")
+func (m *DelegatedController) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("did/v1/state.proto", fileDescriptor_f44bb702879c34b4) }
+// DIDAsset is an x/nft token a DID shows on its profile, such as one
+// received over ICS-721 from another chain. It records the token's metadata
+// when it was attached; ownership is checked again whenever it is queried.
+type DIDAsset struct {
+	// did is the DID showing the token
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// class_id is the token's x/nft class; tokens received over ICS-721 have a
+	// class ID starting with "ibc/"
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the token's ID within its class
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// owner is the controller address that owned the token when it was attached
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// uri points to the token's off-chain metadata
+	Uri string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the document at uri
+	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// class_name is the name of the token's class
+	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// class_uri points to the class's off-chain metadata
+	ClassUri string `protobuf:"bytes,8,opt,name=class_uri,json=classUri,proto3" json:"class_uri,omitempty"`
+	// interchain is set for tokens received over ICS-721
+	Interchain bool `protobuf:"varint,9,opt,name=interchain,proto3" json:"interchain,omitempty"`
+	// dwn_record_id is the DWN record caching the token's metadata for the DID,
+	// empty when it could not be cached
+	DwnRecordId string `protobuf:"bytes,10,opt,name=dwn_record_id,json=dwnRecordId,proto3" json:"dwn_record_id,omitempty"`
+	// attached_at is the block time the token was attached, in unix seconds
+	AttachedAt int64 `protobuf:"varint,11,opt,name=attached_at,json=attachedAt,proto3" json:"attached_at,omitempty"`
+}
+
+func (m *DIDAsset) Reset()         { *m = DIDAsset{} }
+func (m *DIDAsset) String() string { return proto.CompactTextString(m) }
+func (*DIDAsset) ProtoMessage()    {}
+func (*DIDAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{11}
+}
+func (m *DIDAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DIDAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DIDAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DIDAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DIDAsset.Merge(m, src)
+}
+func (m *DIDAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *DIDAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_DIDAsset.DiscardUnknown(m)
+}
 
-var fileDescriptor_f44bb702879c34b4 = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6e, 0x23, 0xc5,
-	0x13, 0xde, 0xb1, 0x1d, 0xdb, 0x53, 0xfe, 0x9b, 0xde, 0xfc, 0x76, 0xfb, 0xb7, 0x68, 0x8d, 0x71,
-	0xc4, 0xe2, 0x45, 0x9b, 0x58, 0xbb, 0x20, 0x90, 0x22, 0xad, 0x84, 0xb3, 0x5e, 0x84, 0x65, 0x2d,
-	0x42, 0x13, 0xb1, 0x42, 0x5c, 0x46, 0xed, 0xe9, 0x4e, 0xd2, 0xd8, 0x9e, 0x36, 0x33, 0x6d, 0x13,
-	0xbf, 0x01, 0x27, 0xc4, 0x13, 0x70, 0xdb, 0xd7, 0x40, 0x1c, 0x39, 0xae, 0xc4, 0x85, 0x23, 0x4a,
-	0x5e, 0x00, 0x90, 0xb8, 0xa3, 0xee, 0xe9, 0x1e, 0x4f, 0xfe, 0x08, 0x45, 0x9c, 0x90, 0x72, 0x4a,
-	0xea, 0xab, 0xea, 0xcf, 0xf5, 0xd5, 0x54, 0x55, 0xcf, 0x00, 0xa2, 0x9c, 0xf6, 0x96, 0x8f, 0x7b,
-	0xb1, 0x24, 0x92, 0xed, 0xce, 0x23, 0x21, 0x05, 0x2a, 0x52, 0x4e, 0x77, 0x97, 0x8f, 0xef, 0xdd,
-	0x0d, 0x44, 0x3c, 0x13, 0x71, 0x4f, 0x44, 0x33, 0x15, 0x22, 0xa2, 0x59, 0x12, 0x70, 0x6f, 0xcb,
-	0x1c, 0x3a, 0x62, 0x21, 0x8b, 0x79, 0x6c, 0x50, 0x4b, 0x25, 0x57, 0x73, 0x66, 0xb0, 0xce, 0x5f,
-	0x0e, 0xd4, 0xfb, 0x0b, 0x79, 0xcc, 0x42, 0xc9, 0x03, 0x22, 0xb9, 0x08, 0x51, 0x13, 0xf2, 0x94,
-	0x53, 0xec, 0xb4, 0x9d, 0xae, 0xeb, 0xa9, 0x7f, 0x51, 0x0b, 0x20, 0x10, 0xa1, 0x8c, 0xc4, 0x74,
-	0xca, 0x22, 0x9c, 0xd3, 0x8e, 0x0c, 0x82, 0x30, 0x94, 0xe2, 0xc5, 0xf8, 0x2b, 0x16, 0x48, 0x9c,
-	0xd7, 0x4e, 0x6b, 0xa2, 0x77, 0x61, 0x73, 0xbe, 0x18, 0x4f, 0x79, 0xe0, 0x4f, 0xd8, 0xca, 0x1f,
-	0x93, 0x98, 0x7d, 0xf0, 0x3e, 0x2e, 0xe8, 0x98, 0x46, 0xe2, 0x18, 0xb1, 0xd5, 0xbe, 0x86, 0xd1,
-	0xff, 0xa1, 0x4c, 0x39, 0xf5, 0x27, 0x3c, 0xa4, 0x78, 0x23, 0xa1, 0xa1, 0x9c, 0x8e, 0x78, 0x48,
-	0xd1, 0xdb, 0x50, 0x0f, 0x22, 0xa6, 0xd3, 0xf3, 0xc7, 0x53, 0x11, 0x4c, 0x70, 0xb1, 0xed, 0x74,
-	0xf3, 0x5e, 0xcd, 0xa2, 0xfb, 0x0a, 0xdc, 0x7b, 0xf8, 0xe7, 0x0f, 0xbf, 0x7c, 0x97, 0xdf, 0x86,
-	0x0d, 0xad, 0x00, 0x61, 0x40, 0xeb, 0x24, 0x1f, 0x99, 0x94, 0x9a, 0x0e, 0x76, 0xb0, 0xd3, 0xf9,
-	0xdd, 0x01, 0xb7, 0x1f, 0xc7, 0x2c, 0xba, 0x29, 0x92, 0x73, 0x9d, 0x6f, 0x73, 0x00, 0xcf, 0xd6,
-	0x0a, 0x2e, 0x6b, 0xc6, 0x50, 0x22, 0x94, 0x46, 0x2c, 0x8e, 0x8d, 0x60, 0x6b, 0xfe, 0x67, 0xd4,
-	0x7e, 0xac, 0xd5, 0x7e, 0x64, 0xd5, 0xd6, 0xd2, 0x84, 0x95, 0x44, 0x65, 0x5a, 0xc5, 0x39, 0xec,
-	0xa0, 0xbb, 0x57, 0xa4, 0xd6, 0xcc, 0x63, 0x07, 0xe7, 0x3b, 0x7f, 0x38, 0x00, 0x03, 0x36, 0x65,
-	0x47, 0x37, 0xa6, 0xe3, 0x0b, 0x5a, 0xf3, 0x30, 0x5c, 0x8a, 0x9b, 0x33, 0xe5, 0x1b, 0x9d, 0x57,
-	0x45, 0xa8, 0x0c, 0x86, 0x83, 0x81, 0x08, 0x16, 0x33, 0x16, 0x4a, 0x54, 0x87, 0x5c, 0xaa, 0x39,
-	0xc7, 0x29, 0xda, 0x01, 0x34, 0x8f, 0xf8, 0x8c, 0x44, 0x2b, 0xff, 0x92, 0xf4, 0x4d, 0xe3, 0xc9,
-	0x8c, 0x4c, 0x07, 0x6a, 0x64, 0x1a, 0x0b, 0x7f, 0x12, 0x8a, 0x6f, 0x42, 0x9f, 0xc4, 0x38, 0xdf,
-	0xce, 0x77, 0x5d, 0xaf, 0xa2, 0xc0, 0x91, 0xc2, 0xfa, 0x31, 0x1a, 0xc1, 0xed, 0x25, 0x8b, 0xf8,
-	0xa1, 0xd9, 0xa6, 0xfe, 0x8c, 0xc9, 0x63, 0x41, 0x71, 0xa1, 0x9d, 0xef, 0x56, 0x9e, 0xdc, 0xdb,
-	0x4d, 0x36, 0xf7, 0xee, 0xcb, 0x4c, 0xc8, 0x0b, 0x1d, 0xe1, 0xa1, 0xe5, 0x25, 0x0c, 0x8d, 0xa0,
-	0x4e, 0xce, 0x2d, 0x67, 0xbc, 0xa1, 0x79, 0xb6, 0xff, 0x81, 0x87, 0x1d, 0xb2, 0x88, 0x85, 0x01,
-	0xf3, 0x2e, 0x1c, 0x45, 0x9f, 0x42, 0x93, 0xd8, 0x8d, 0x67, 0xd3, 0x2a, 0x5e, 0x9f, 0xae, 0x91,
-	0x1e, 0x36, 0xc9, 0x7d, 0x02, 0x35, 0xf5, 0xb8, 0xc9, 0x51, 0xc4, 0x98, 0xaa, 0x2e, 0x2e, 0x5d,
-	0x9f, 0xac, 0x3a, 0x61, 0xab, 0xbe, 0x3d, 0x88, 0xbe, 0x80, 0xff, 0x05, 0x64, 0x4e, 0xc6, 0x7c,
-	0xca, 0xe5, 0xca, 0xe7, 0x69, 0x93, 0xe2, 0xf2, 0xf5, 0x19, 0xb7, 0xd6, 0x0c, 0x99, 0x2e, 0x3f,
-	0xcf, 0x4c, 0xd3, 0x91, 0xc7, 0xee, 0xbf, 0x62, 0xce, 0xec, 0x8c, 0x87, 0x50, 0x8a, 0x59, 0xb4,
-	0xe4, 0x01, 0xc3, 0xa0, 0xb9, 0x1a, 0x96, 0xeb, 0x20, 0x81, 0x3d, 0xeb, 0x47, 0xf7, 0x01, 0x74,
-	0x07, 0x33, 0xea, 0x13, 0x89, 0x2b, 0xba, 0xa7, 0x5d, 0x83, 0xf4, 0xa5, 0x72, 0x2f, 0xe6, 0xd4,
-	0xba, 0xab, 0x89, 0xdb, 0x20, 0x7d, 0x89, 0xda, 0x50, 0xa1, 0x8c, 0x04, 0x92, 0x2f, 0x15, 0x80,
-	0x6b, 0x6d, 0xa7, 0x5b, 0xf6, 0xb2, 0x90, 0x1a, 0xcc, 0x25, 0x8b, 0x62, 0x25, 0xab, 0xde, 0x76,
-	0xba, 0x05, 0xcf, 0x9a, 0x7b, 0x0f, 0xf4, 0xa8, 0xb4, 0xa1, 0xa0, 0xfa, 0x1e, 0xdd, 0xb9, 0xaa,
-	0xdb, 0x9b, 0x0e, 0x2e, 0x76, 0x7e, 0xcc, 0xc1, 0xed, 0xcc, 0x9c, 0xbc, 0x60, 0x92, 0x50, 0x22,
-	0xc9, 0xd5, 0x77, 0x84, 0xc9, 0x5c, 0x8f, 0x49, 0xde, 0xb3, 0xa6, 0xf2, 0x98, 0xa4, 0xf5, 0x7a,
-	0xc8, 0x7b, 0xd6, 0xbc, 0xa8, 0xa0, 0xa0, 0xbd, 0xe7, 0x14, 0xdc, 0x07, 0x30, 0x29, 0xfb, 0xdc,
-	0xae, 0x05, 0xd7, 0x20, 0x43, 0x8a, 0xde, 0x84, 0x4a, 0xc8, 0x4e, 0xa4, 0x9f, 0x10, 0x9a, 0xad,
-	0x00, 0x0a, 0xfa, 0x5c, 0x23, 0xe8, 0x01, 0x34, 0x74, 0x40, 0x86, 0xa4, 0xa4, 0x49, 0x6a, 0x0a,
-	0x7e, 0x99, 0x12, 0x6d, 0x43, 0x8d, 0x7d, 0xbd, 0xe0, 0x4b, 0x32, 0x65, 0xa1, 0x54, 0x51, 0x65,
-	0x3d, 0xc0, 0xd5, 0x35, 0x38, 0xa4, 0xe8, 0x2d, 0xa8, 0x06, 0x24, 0x14, 0x21, 0x0f, 0xc8, 0x54,
-	0xc5, 0xb8, 0x9a, 0xa9, 0x92, 0x62, 0x43, 0xba, 0xd7, 0xd0, 0x75, 0x75, 0xcd, 0x0a, 0xc2, 0xa5,
-	0xce, 0xab, 0x02, 0x6c, 0x25, 0x3d, 0x44, 0xc6, 0x53, 0xf6, 0x2c, 0x62, 0x54, 0x0d, 0x1e, 0x99,
-	0x5e, 0xda, 0x38, 0xaa, 0x7e, 0x22, 0x94, 0xec, 0x44, 0xe2, 0x9c, 0xfe, 0x6d, 0x6b, 0xa2, 0x87,
-	0xd0, 0x0c, 0xd2, 0x73, 0x7a, 0x3f, 0xda, 0xfd, 0xd2, 0x58, 0xe3, 0x6a, 0x4f, 0xc6, 0xe8, 0x0e,
-	0x14, 0x79, 0x1c, 0x2f, 0x58, 0x64, 0x96, 0xac, 0xb1, 0x94, 0x3c, 0xf5, 0x1f, 0x09, 0x03, 0xe6,
-	0xeb, 0x4a, 0x25, 0x95, 0xac, 0x5a, 0x70, 0xa0, 0x6a, 0xf5, 0x0e, 0x34, 0xd8, 0xc9, 0x9c, 0x47,
-	0xc9, 0x7a, 0x4a, 0x0b, 0xea, 0x7a, 0xf5, 0x35, 0xac, 0x03, 0x77, 0x00, 0x65, 0x12, 0xb2, 0xab,
-	0x5f, 0xd5, 0xb5, 0xea, 0x6d, 0xae, 0x3d, 0x07, 0xe6, 0x12, 0xd8, 0x81, 0x8d, 0x79, 0x24, 0xc4,
-	0xa1, 0x19, 0xda, 0xbb, 0x76, 0x1c, 0xd6, 0xc5, 0xf8, 0x4c, 0xb9, 0xbd, 0x24, 0x0a, 0x3d, 0x87,
-	0xcd, 0x2c, 0xbb, 0x24, 0x72, 0x11, 0xeb, 0x52, 0x57, 0x9e, 0xe0, 0xcb, 0x47, 0x0f, 0xb4, 0xdf,
-	0xcb, 0x54, 0x28, 0x41, 0xb2, 0x97, 0x12, 0x9c, 0xbf, 0x94, 0xde, 0x00, 0x57, 0x97, 0x25, 0x33,
-	0x74, 0xe5, 0x04, 0x48, 0x66, 0x4e, 0xab, 0x65, 0x71, 0x66, 0xe6, 0x0c, 0xd2, 0x97, 0x8a, 0x35,
-	0x62, 0x4b, 0x31, 0x49, 0xe7, 0xcd, 0x9a, 0x7b, 0x4f, 0xf5, 0x93, 0xff, 0xd0, 0x4c, 0x14, 0xd8,
-	0x07, 0xd1, 0x74, 0x50, 0x25, 0xf3, 0xf6, 0x81, 0xb6, 0xa0, 0x9e, 0x38, 0xd2, 0x0b, 0x49, 0xbd,
-	0x78, 0x94, 0x3b, 0x3f, 0x39, 0x50, 0x1b, 0x0c, 0x07, 0x99, 0x3b, 0x65, 0xdd, 0x20, 0x05, 0xdd,
-	0x20, 0x66, 0xe4, 0x72, 0xeb, 0x91, 0x53, 0xd7, 0x62, 0x1a, 0xef, 0x2b, 0x67, 0x72, 0xfd, 0xd6,
-	0xd6, 0xe8, 0x80, 0x53, 0x75, 0xb1, 0x12, 0x4a, 0x13, 0xb9, 0xc9, 0x88, 0x95, 0xb4, 0xdd, 0x97,
-	0x7b, 0x23, 0x9d, 0xf4, 0x73, 0x28, 0xaa, 0xdf, 0x6a, 0x3a, 0xc8, 0xd5, 0xbf, 0xa1, 0xdf, 0x99,
-	0xb6, 0x2e, 0x92, 0xeb, 0x57, 0x27, 0xac, 0x3f, 0x3b, 0x1e, 0x5d, 0xf0, 0x28, 0x09, 0xee, 0xfe,
-	0xd3, 0x9f, 0x4f, 0x5b, 0xce, 0xeb, 0xd3, 0x96, 0xf3, 0xdb, 0x69, 0xcb, 0xf9, 0xfe, 0xac, 0x75,
-	0xeb, 0xf5, 0x59, 0xeb, 0xd6, 0xaf, 0x67, 0xad, 0x5b, 0x5f, 0x6e, 0x1f, 0x71, 0x79, 0xbc, 0x18,
-	0xef, 0x06, 0x62, 0xd6, 0x8b, 0x45, 0x18, 0xed, 0x70, 0xa1, 0xff, 0xf6, 0x4e, 0x7a, 0xea, 0xcb,
-	0x43, 0x7f, 0x76, 0x8c, 0x8b, 0xfa, 0xbb, 0xe3, 0xbd, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x21, 0xbc, 0xac, 0xd8, 0x0c, 0x00, 0x00,
+var xxx_messageInfo_DIDAsset proto.InternalMessageInfo
+
+func (m *DIDAsset) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
 }
 
-func (m *Authentication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DIDAsset) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Authentication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DIDAsset) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
 }
 
-func (m *Authentication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreationBlock != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.DidKind) > 0 {
-		i -= len(m.DidKind)
-		copy(dAtA[i:], m.DidKind)
-		i = encodeVarintState(dAtA, i, uint64(len(m.DidKind)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PublicKeyBase64) > 0 {
-		i -= len(m.PublicKeyBase64)
-		copy(dAtA[i:], m.PublicKeyBase64)
-		i = encodeVarintState(dAtA, i, uint64(len(m.PublicKeyBase64)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+func (m *DIDAsset) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Assertion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DIDAsset) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Assertion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DIDAsset) GetUriHash() string {
+	if m != nil {
+		return m.UriHash
+	}
+	return ""
 }
 
-func (m *Assertion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreationBlock != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.DidKind) > 0 {
-		i -= len(m.DidKind)
-		copy(dAtA[i:], m.DidKind)
-		i = encodeVarintState(dAtA, i, uint64(len(m.DidKind)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PublicKeyBase64) > 0 {
-		i -= len(m.PublicKeyBase64)
-		copy(dAtA[i:], m.PublicKeyBase64)
-		i = encodeVarintState(dAtA, i, uint64(len(m.PublicKeyBase64)))
-		i--
-		dAtA[i] = 0x22
+func (m *DIDAsset) GetClassName() string {
+	if m != nil {
+		return m.ClassName
 	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *DIDAsset) GetClassUri() string {
+	if m != nil {
+		return m.ClassUri
 	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *DIDAsset) GetInterchain() bool {
+	if m != nil {
+		return m.Interchain
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *DIDAsset) GetDwnRecordId() string {
+	if m != nil {
+		return m.DwnRecordId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Controller) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DIDAsset) GetAttachedAt() int64 {
+	if m != nil {
+		return m.AttachedAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Controller) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VerifiableCredential represents a W3C Verifiable Credential
+type VerifiableCredential struct {
+	// id is the credential identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the JSON-LD contexts
+	Context []string `protobuf:"bytes,2,rep,name=context,proto3" json:"context,omitempty"`
+	// credential_kinds is the credential types
+	CredentialKinds []string `protobuf:"bytes,3,rep,name=credential_kinds,json=credentialKinds,proto3" json:"credential_kinds,omitempty"`
+	// issuer is the DID of the credential issuer
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// issuanceDate is when the credential was issued
+	IssuanceDate string `protobuf:"bytes,5,opt,name=issuance_date,json=issuanceDate,proto3" json:"issuance_date,omitempty"`
+	// expirationDate is when the credential expires (optional)
+	ExpirationDate string `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	// credentialSubject contains the claims about the subject as JSON
+	CredentialSubject []byte `protobuf:"bytes,7,opt,name=credential_subject,json=credentialSubject,proto3" json:"credential_subject,omitempty"`
+	// proof contains the cryptographic proof
+	Proof []*CredentialProof `protobuf:"bytes,8,rep,name=proof,proto3" json:"proof,omitempty"`
+	// credentialStatus contains information about credential revocation
+	// (optional)
+	CredentialStatus *CredentialStatus `protobuf:"bytes,9,opt,name=credential_status,json=credentialStatus,proto3" json:"credential_status,omitempty"`
+	// subject is the DID of the credential subject (for indexing)
+	Subject string `protobuf:"bytes,10,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Block height when issued
+	IssuedAt int64 `protobuf:"varint,11,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// Block height when expires (0 if no expiration)
+	ExpiresAt int64 `protobuf:"varint,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Whether the credential is revoked
+	Revoked bool `protobuf:"varint,13,opt,name=revoked,proto3" json:"revoked,omitempty"`
 }
 
-func (m *Controller) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreationBlock != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.DidKind) > 0 {
-		i -= len(m.DidKind)
-		copy(dAtA[i:], m.DidKind)
-		i = encodeVarintState(dAtA, i, uint64(len(m.DidKind)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PublicKeyBase64) > 0 {
-		i -= len(m.PublicKeyBase64)
-		copy(dAtA[i:], m.PublicKeyBase64)
-		i = encodeVarintState(dAtA, i, uint64(len(m.PublicKeyBase64)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *VerifiableCredential) Reset()         { *m = VerifiableCredential{} }
+func (m *VerifiableCredential) String() string { return proto.CompactTextString(m) }
+func (*VerifiableCredential) ProtoMessage()    {}
+func (*VerifiableCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{12}
+}
+func (m *VerifiableCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifiableCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifiableCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *VerifiableCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiableCredential.Merge(m, src)
+}
+func (m *VerifiableCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifiableCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiableCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiableCredential proto.InternalMessageInfo
+
+func (m *VerifiableCredential) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VerifiableCredential) GetContext() []string {
+	if m != nil {
+		return m.Context
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VerifiableCredential) GetCredentialKinds() []string {
+	if m != nil {
+		return m.CredentialKinds
+	}
+	return nil
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *VerifiableCredential) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *VerifiableCredential) GetIssuanceDate() string {
+	if m != nil {
+		return m.IssuanceDate
+	}
+	return ""
+}
+
+func (m *VerifiableCredential) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return ""
+}
+
+func (m *VerifiableCredential) GetCredentialSubject() []byte {
+	if m != nil {
+		return m.CredentialSubject
+	}
+	return nil
+}
+
+func (m *VerifiableCredential) GetProof() []*CredentialProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *VerifiableCredential) GetCredentialStatus() *CredentialStatus {
+	if m != nil {
+		return m.CredentialStatus
+	}
+	return nil
+}
+
+func (m *VerifiableCredential) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *VerifiableCredential) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *VerifiableCredential) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *VerifiableCredential) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+// DIDController represents additional controllers for a DID document
+type DIDController struct {
+	// id is the auto-incrementing primary key
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// did is the DID this controller belongs to
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// controller_did is the controller DID
+	ControllerDid string `protobuf:"bytes,3,opt,name=controller_did,json=controllerDid,proto3" json:"controller_did,omitempty"`
+	// added_at is when this controller was added
+	AddedAt int64 `protobuf:"varint,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (m *DIDController) Reset()         { *m = DIDController{} }
+func (m *DIDController) String() string { return proto.CompactTextString(m) }
+func (*DIDController) ProtoMessage()    {}
+func (*DIDController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44bb702879c34b4, []int{13}
+}
+func (m *DIDController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DIDController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DIDController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DIDController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DIDController.Merge(m, src)
+}
+func (m *DIDController) XXX_Size() int {
+	return m.Size()
+}
+func (m *DIDController) XXX_DiscardUnknown() {
+	xxx_messageInfo_DIDController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DIDController proto.InternalMessageInfo
+
+func (m *DIDController) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DIDController) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DIDController) GetControllerDid() string {
+	if m != nil {
+		return m.ControllerDid
+	}
+	return ""
+}
+
+func (m *DIDController) GetAddedAt() int64 {
+	if m != nil {
+		return m.AddedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("did.v1.DIDStatus", DIDStatus_name, DIDStatus_value)
+	proto.RegisterType((*Authentication)(nil), "did.v1.Authentication")
+	proto.RegisterType((*Assertion)(nil), "did.v1.Assertion")
+	proto.RegisterType((*Controller)(nil), "did.v1.Controller")
+	proto.RegisterType((*Delegation)(nil), "did.v1.Delegation")
+	proto.RegisterType((*Invocation)(nil), "did.v1.Invocation")
+	proto.RegisterType((*DIDDocument)(nil), "did.v1.DIDDocument")
+	proto.RegisterType((*DIDDocumentMetadata)(nil), "did.v1.DIDDocumentMetadata")
+	proto.RegisterType((*DIDDocumentVersion)(nil), "did.v1.DIDDocumentVersion")
+	proto.RegisterType((*UnverifiedCredential)(nil), "did.v1.UnverifiedCredential")
+	proto.RegisterType((*StatusList)(nil), "did.v1.StatusList")
+	proto.RegisterType((*DelegatedController)(nil), "did.v1.DelegatedController")
+	proto.RegisterType((*DIDAsset)(nil), "did.v1.DIDAsset")
+	proto.RegisterType((*VerifiableCredential)(nil), "did.v1.VerifiableCredential")
+	proto.RegisterType((*DIDController)(nil), "did.v1.DIDController")
+}
+
+func init() { proto.RegisterFile("did/v1/state.proto", fileDescriptor_f44bb702879c34b4) }
+
+var fileDescriptor_f44bb702879c34b4 = []byte{
+	// 1647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x14, 0xc9, 0x7d, 0x24, 0xa5, 0xd5, 0x58, 0xb6, 0x37, 0x4e, 0xad, 0x32, 0x14,
+	0x9a, 0xca, 0x41, 0x2c, 0x21, 0x6e, 0xd0, 0x02, 0x02, 0x02, 0x94, 0xf6, 0x2a, 0x30, 0xa1, 0xc6,
+	0x08, 0x56, 0x92, 0x51, 0xe4, 0xb2, 0x18, 0xee, 0x8c, 0xc8, 0xa9, 0x96, 0xbb, 0xec, 0xec, 0x90,
+	0xb6, 0xbe, 0x41, 0x4f, 0x45, 0x3f, 0x41, 0x6f, 0xf9, 0x02, 0x3d, 0x17, 0x45, 0x8f, 0x3d, 0x06,
+	0xed, 0xa5, 0xc7, 0xc2, 0x3e, 0xf5, 0xd6, 0x16, 0xe8, 0xbd, 0x98, 0x7f, 0xbb, 0x2b, 0x8a, 0x4d,
+	0x8c, 0x9e, 0x0a, 0x9f, 0xc8, 0xf7, 0x7b, 0x6f, 0x1e, 0xdf, 0x7b, 0xf3, 0xde, 0x6f, 0x1e, 0x08,
+	0x88, 0x30, 0x72, 0xb8, 0xfc, 0xe4, 0x30, 0x17, 0x58, 0xd0, 0x83, 0x39, 0xcf, 0x44, 0x86, 0x9a,
+	0x84, 0x91, 0x83, 0xe5, 0x27, 0xf7, 0xef, 0xc5, 0x59, 0x3e, 0xcb, 0xf2, 0xc3, 0x8c, 0xcf, 0xa4,
+	0x49, 0xc6, 0x67, 0xda, 0xe0, 0xfe, 0x8e, 0x39, 0x34, 0xa1, 0x29, 0xcd, 0x59, 0x6e, 0x50, 0xeb,
+	0x4a, 0x5c, 0xcd, 0xa9, 0xc1, 0x06, 0xff, 0x76, 0x60, 0x73, 0xb8, 0x10, 0x53, 0x9a, 0x0a, 0x16,
+	0x63, 0xc1, 0xb2, 0x14, 0x79, 0x50, 0x27, 0x8c, 0xf8, 0x4e, 0xdf, 0xd9, 0x77, 0x43, 0xf9, 0x15,
+	0xed, 0x02, 0xc4, 0x59, 0x2a, 0x78, 0x96, 0x24, 0x94, 0xfb, 0x35, 0xa5, 0xa8, 0x20, 0xc8, 0x87,
+	0x56, 0xbe, 0x18, 0xff, 0x82, 0xc6, 0xc2, 0xaf, 0x2b, 0xa5, 0x15, 0xd1, 0x47, 0xb0, 0x3d, 0x5f,
+	0x8c, 0x13, 0x16, 0x47, 0x97, 0xf4, 0x2a, 0x1a, 0xe3, 0x9c, 0xfe, 0xf8, 0x53, 0xbf, 0xa1, 0x6c,
+	0xb6, 0xb4, 0xe2, 0x84, 0x5e, 0x3d, 0x51, 0x30, 0x7a, 0x0f, 0xda, 0x84, 0x91, 0xe8, 0x92, 0xa5,
+	0xc4, 0xdf, 0xd0, 0x6e, 0x08, 0x23, 0x27, 0x2c, 0x25, 0xe8, 0x07, 0xb0, 0x19, 0x73, 0xaa, 0xc2,
+	0x8b, 0xc6, 0x49, 0x16, 0x5f, 0xfa, 0xcd, 0xbe, 0xb3, 0x5f, 0x0f, 0x7b, 0x16, 0x7d, 0x22, 0xc1,
+	0xa3, 0x87, 0xff, 0xfa, 0xed, 0x5f, 0x7e, 0x5d, 0xdf, 0x83, 0x0d, 0x95, 0x01, 0xf2, 0x01, 0x95,
+	0x41, 0x7e, 0x6c, 0x23, 0x74, 0x3c, 0xc7, 0x77, 0x06, 0xff, 0x70, 0xc0, 0x1d, 0xe6, 0x39, 0xe5,
+	0xef, 0x46, 0xca, 0xc8, 0x97, 0x79, 0xad, 0xcd, 0xb7, 0x66, 0xca, 0x31, 0xf8, 0x55, 0x0d, 0xe0,
+	0x69, 0x99, 0xc1, 0xcd, 0x9c, 0x7d, 0x68, 0x61, 0x42, 0x38, 0xcd, 0x73, 0x93, 0xb0, 0x15, 0xff,
+	0x6f, 0xb2, 0xfd, 0x5c, 0x65, 0xfb, 0x53, 0x7b, 0xc1, 0x3d, 0xcf, 0x29, 0x43, 0x76, 0x50, 0xaf,
+	0x8c, 0xd2, 0xf1, 0x6a, 0xe8, 0x9e, 0x57, 0x5f, 0x17, 0x9c, 0xe3, 0xd7, 0x07, 0xff, 0x74, 0x00,
+	0x02, 0x9a, 0xd0, 0x09, 0x7e, 0x57, 0xae, 0xff, 0xbf, 0xb6, 0x7a, 0xc3, 0x5e, 0xbf, 0xcc, 0x79,
+	0x94, 0x2e, 0xb3, 0x77, 0x65, 0xca, 0xfd, 0x8d, 0xef, 0x9c, 0xf3, 0xc1, 0xd7, 0x4d, 0xe8, 0x04,
+	0xa3, 0x20, 0xc8, 0xe2, 0xc5, 0x8c, 0xa6, 0x02, 0x6d, 0x42, 0xad, 0xc8, 0xb9, 0xc6, 0x08, 0x7a,
+	0x04, 0x68, 0xce, 0xd9, 0x0c, 0xf3, 0xab, 0xe8, 0x46, 0xea, 0xdb, 0x46, 0x53, 0x19, 0x99, 0x01,
+	0xf4, 0x70, 0x92, 0x67, 0xd1, 0x65, 0x9a, 0xbd, 0x4c, 0x23, 0x9c, 0xfb, 0xf5, 0x7e, 0x7d, 0xdf,
+	0x0d, 0x3b, 0x12, 0x3c, 0x91, 0xd8, 0x30, 0x47, 0x27, 0x70, 0x7b, 0x49, 0x39, 0xbb, 0x30, 0x6c,
+	0x1a, 0xcd, 0xa8, 0x98, 0x66, 0xc4, 0x6f, 0xf4, 0xeb, 0xfb, 0x9d, 0xc7, 0xf7, 0x0f, 0x34, 0x73,
+	0x1f, 0xbc, 0xa8, 0x98, 0x7c, 0xa1, 0x2c, 0x42, 0xb4, 0xbc, 0x81, 0xa1, 0x13, 0xd8, 0xc4, 0xd7,
+	0xc8, 0xd9, 0xdf, 0x50, 0x7e, 0xf6, 0xbe, 0xc5, 0x0f, 0xbd, 0xa0, 0x9c, 0xa6, 0x31, 0x0d, 0x57,
+	0x8e, 0xa2, 0xe7, 0xe0, 0x61, 0xcb, 0x78, 0x36, 0xac, 0xe6, 0xdb, 0xbb, 0xdb, 0x2a, 0x0e, 0x9b,
+	0xe0, 0x9e, 0x41, 0x4f, 0x5e, 0x37, 0x9e, 0x70, 0x4a, 0x65, 0x75, 0xfd, 0xd6, 0xdb, 0x3b, 0xeb,
+	0x5e, 0xd2, 0xab, 0xa1, 0x3d, 0x88, 0x7e, 0x0e, 0x77, 0x62, 0x3c, 0xc7, 0x63, 0x96, 0x30, 0x71,
+	0x15, 0xb1, 0xa2, 0x49, 0xfd, 0xf6, 0xdb, 0x7b, 0xdc, 0x29, 0x3d, 0x54, 0xba, 0xfc, 0xba, 0x67,
+	0x52, 0x8c, 0xbc, 0xef, 0xfe, 0x4f, 0x9e, 0x2b, 0x9c, 0xf1, 0x10, 0x5a, 0x39, 0xe5, 0x4b, 0x16,
+	0x53, 0x1f, 0x94, 0xaf, 0x2d, 0xeb, 0xeb, 0x54, 0xc3, 0xa1, 0xd5, 0xa3, 0x07, 0x00, 0xaa, 0x83,
+	0x29, 0x89, 0xb0, 0xf0, 0x3b, 0xaa, 0xa7, 0x5d, 0x83, 0x0c, 0x85, 0x54, 0x2f, 0xe6, 0xc4, 0xaa,
+	0xbb, 0x5a, 0x6d, 0x90, 0xa1, 0x40, 0x7d, 0xe8, 0x10, 0x8a, 0x63, 0xc1, 0x96, 0x12, 0xf0, 0x7b,
+	0x7d, 0x67, 0xbf, 0x1d, 0x56, 0x21, 0x39, 0x98, 0x4b, 0xca, 0x73, 0x99, 0xd6, 0x66, 0xdf, 0xd9,
+	0x6f, 0x84, 0x56, 0x3c, 0xfa, 0x50, 0x8d, 0x4a, 0x1f, 0xdd, 0x95, 0x6f, 0xc3, 0x9a, 0x4e, 0x6f,
+	0x42, 0x43, 0x4e, 0xc4, 0xe0, 0xef, 0x35, 0xb8, 0x5d, 0x99, 0x93, 0x2f, 0xa8, 0xc0, 0x04, 0x0b,
+	0xbc, 0xfe, 0x8d, 0x30, 0x91, 0xab, 0x31, 0xa9, 0x87, 0x56, 0x94, 0x1a, 0x13, 0xb4, 0xa2, 0x87,
+	0x7a, 0x68, 0xc5, 0xd5, 0x0c, 0x1a, 0x4a, 0x7b, 0x2d, 0x83, 0x07, 0x00, 0x26, 0xe4, 0x88, 0x59,
+	0x5a, 0x70, 0x0d, 0x32, 0x22, 0xe8, 0xfb, 0xd0, 0x49, 0xe9, 0x2b, 0x11, 0x69, 0x87, 0x86, 0x15,
+	0x40, 0x42, 0xe7, 0x0a, 0x41, 0x1f, 0xc2, 0x96, 0x32, 0xa8, 0x38, 0x69, 0x29, 0x27, 0x3d, 0x09,
+	0xbf, 0x28, 0x1c, 0xed, 0x41, 0x8f, 0xfe, 0x72, 0xc1, 0x96, 0x38, 0xa1, 0xa9, 0x90, 0x56, 0x6d,
+	0x35, 0xc0, 0xdd, 0x12, 0x1c, 0x11, 0xf4, 0x01, 0x74, 0x63, 0x9c, 0x66, 0x29, 0x8b, 0x71, 0x22,
+	0x6d, 0x5c, 0xe5, 0xa9, 0x53, 0x60, 0x23, 0x82, 0x1e, 0x42, 0x53, 0xee, 0x63, 0x8b, 0xdc, 0x87,
+	0xbe, 0xb3, 0xbf, 0xf9, 0x78, 0xdb, 0xde, 0x7d, 0x30, 0x0a, 0x4e, 0x95, 0x22, 0x34, 0x06, 0x47,
+	0x5b, 0xea, 0x0a, 0x5c, 0xbf, 0x65, 0x79, 0xf8, 0xf7, 0x0e, 0xa0, 0x4a, 0xad, 0x4d, 0x70, 0x6b,
+	0x4a, 0x7d, 0xbd, 0x28, 0x35, 0x75, 0xb3, 0x95, 0xa2, 0x7c, 0x00, 0x5d, 0xab, 0x16, 0x6c, 0x46,
+	0x4d, 0xd1, 0x3b, 0x06, 0x3b, 0x63, 0x33, 0x2a, 0x4d, 0x14, 0x8f, 0x46, 0x53, 0xca, 0x26, 0x53,
+	0x61, 0x2b, 0xaf, 0xb0, 0x67, 0x0a, 0x42, 0x87, 0xd0, 0x26, 0x26, 0x12, 0x55, 0xf7, 0xce, 0xe3,
+	0xdb, 0x95, 0x5c, 0x6c, 0x90, 0x61, 0x61, 0x34, 0xf8, 0xb3, 0x03, 0x3b, 0xe7, 0xa9, 0xe6, 0x2a,
+	0x4a, 0x9e, 0x72, 0x4a, 0x24, 0xc5, 0xe0, 0x64, 0x4d, 0x02, 0x9f, 0xc2, 0xdd, 0x35, 0x54, 0x68,
+	0x93, 0x71, 0xc3, 0x9d, 0x9b, 0x8c, 0xa7, 0xef, 0x28, 0x2e, 0xbc, 0x4a, 0x63, 0xfd, 0xd8, 0x74,
+	0x4b, 0x70, 0x44, 0xd0, 0x5d, 0x68, 0x66, 0x9c, 0x4d, 0x58, 0x6a, 0x9e, 0x19, 0x23, 0x49, 0x9c,
+	0x53, 0x9c, 0x2b, 0xa2, 0x54, 0xb8, 0x96, 0x64, 0x2d, 0x2f, 0x12, 0x3c, 0x99, 0xe8, 0x19, 0xd3,
+	0x0d, 0xe4, 0x1a, 0x64, 0x28, 0x06, 0xbf, 0x73, 0x00, 0xf4, 0xbd, 0xfd, 0x8c, 0xe5, 0x42, 0x7a,
+	0x61, 0x79, 0xbe, 0xa0, 0xdc, 0x64, 0x63, 0x24, 0x74, 0x0f, 0x5a, 0x09, 0xcb, 0x45, 0x79, 0x1d,
+	0x4d, 0x29, 0x8e, 0x54, 0xef, 0xcf, 0x17, 0x7c, 0x9e, 0xe5, 0xd4, 0x3e, 0x8d, 0x46, 0x44, 0xdf,
+	0x03, 0x77, 0xcc, 0x44, 0x2e, 0x38, 0x4b, 0x27, 0x2a, 0xd6, 0x6e, 0x58, 0x02, 0x52, 0x8b, 0x93,
+	0x44, 0x92, 0x15, 0xd5, 0x6d, 0xdf, 0x08, 0x4b, 0x60, 0x85, 0x18, 0x9a, 0x2b, 0xc4, 0x30, 0xf8,
+	0x83, 0x03, 0xb7, 0x0d, 0x21, 0x51, 0xf2, 0xad, 0x8b, 0xdd, 0x77, 0xbd, 0xec, 0x0f, 0x00, 0x26,
+	0x1c, 0xa7, 0xf2, 0x87, 0xc6, 0x57, 0x26, 0x03, 0xd7, 0x20, 0x4f, 0xae, 0xaa, 0xd9, 0x35, 0xae,
+	0x67, 0x57, 0x39, 0x88, 0x75, 0xff, 0xd4, 0x8b, 0x83, 0x9a, 0xd9, 0xe8, 0xab, 0x39, 0xe3, 0x34,
+	0xaf, 0x24, 0x60, 0x10, 0x59, 0xf5, 0x1a, 0xb4, 0x83, 0x51, 0x20, 0xd7, 0x70, 0xb1, 0x26, 0xea,
+	0xf7, 0xa0, 0x1d, 0x27, 0x38, 0xcf, 0xcb, 0x86, 0x69, 0x29, 0x79, 0x44, 0xd0, 0x1d, 0x68, 0xa6,
+	0x17, 0xa2, 0x6c, 0x8e, 0x8d, 0xf4, 0x42, 0x5e, 0xc3, 0x0e, 0x6c, 0x64, 0x2f, 0x53, 0xca, 0x4d,
+	0x98, 0x5a, 0x90, 0x9e, 0x17, 0x9c, 0x99, 0x86, 0x90, 0x5f, 0xa5, 0xe7, 0x05, 0x67, 0xd1, 0x14,
+	0xe7, 0x53, 0x15, 0x95, 0x1b, 0xb6, 0x16, 0x9c, 0x3d, 0xc3, 0xf9, 0x54, 0x71, 0xb5, 0xfa, 0xd1,
+	0x14, 0xcf, 0xa8, 0x21, 0x11, 0x57, 0x21, 0xcf, 0xf1, 0x8c, 0xa2, 0xf7, 0x41, 0x0b, 0x91, 0xf4,
+	0xd8, 0x56, 0x5a, 0x1d, 0xe4, 0x39, 0x67, 0xb2, 0xcc, 0x2c, 0x15, 0x94, 0xc7, 0x53, 0xcc, 0x52,
+	0x45, 0x1b, 0xed, 0xb0, 0x82, 0xc8, 0xf5, 0x81, 0xbc, 0x4c, 0x23, 0x4e, 0xe3, 0x8c, 0xab, 0x31,
+	0x00, 0xcd, 0x2c, 0xe4, 0x65, 0x1a, 0x2a, 0x4c, 0x53, 0x1d, 0x16, 0x02, 0xc7, 0xd3, 0xea, 0x63,
+	0x01, 0x16, 0x1a, 0x8a, 0xc1, 0xd7, 0x0d, 0xd8, 0xd1, 0xaf, 0x15, 0x1e, 0x27, 0xb4, 0x32, 0x7f,
+	0xab, 0xbb, 0x8d, 0x64, 0xea, 0x2c, 0x15, 0xf4, 0x95, 0xf0, 0x6b, 0x8a, 0xe5, 0xac, 0x88, 0x1e,
+	0x82, 0x57, 0x99, 0x30, 0xb9, 0x89, 0xd9, 0x4d, 0x66, 0xab, 0xc4, 0xe5, 0x46, 0x96, 0x57, 0x26,
+	0xa1, 0x71, 0x6d, 0x12, 0xf6, 0xa0, 0x27, 0xbf, 0xe1, 0x34, 0xa6, 0x91, 0xe2, 0x64, 0x5d, 0xdd,
+	0xae, 0x05, 0x03, 0xc9, 0xca, 0x3f, 0x84, 0x2d, 0x75, 0xd9, 0x7a, 0xfa, 0x0b, 0xea, 0x76, 0xc3,
+	0xcd, 0x12, 0x56, 0x86, 0x8f, 0x00, 0x55, 0x02, 0xb2, 0x0b, 0x5c, 0x4b, 0x4d, 0xcb, 0x76, 0xa9,
+	0x39, 0xd5, 0x0a, 0xf4, 0x08, 0x36, 0xe6, 0x3c, 0xcb, 0x2e, 0xcc, 0x7a, 0x70, 0xcf, 0x12, 0x56,
+	0x59, 0x8c, 0x2f, 0xa5, 0x3a, 0xd4, 0x56, 0xe8, 0x18, 0xb6, 0xab, 0xde, 0x35, 0x6f, 0xbb, 0x8a,
+	0xeb, 0xfc, 0x9b, 0x47, 0x0d, 0x7d, 0x7b, 0xf1, 0x0a, 0x52, 0x5d, 0x7f, 0xe1, 0xfa, 0xfa, 0xfb,
+	0x3e, 0xb8, 0xaa, 0x2c, 0x95, 0x1b, 0x6b, 0x6b, 0xe0, 0xc6, 0x0c, 0x74, 0x57, 0x66, 0x40, 0x7a,
+	0xe5, 0x74, 0x99, 0x5d, 0x16, 0x2f, 0xbb, 0x15, 0x8f, 0x3e, 0x53, 0x0f, 0xc7, 0x4f, 0x10, 0xd8,
+	0x2b, 0xf0, 0x1c, 0xd4, 0xf1, 0x6a, 0x45, 0x14, 0x68, 0xc7, 0x77, 0xbc, 0x3a, 0x6c, 0x6a, 0x65,
+	0xb1, 0xf6, 0xb6, 0xcd, 0x93, 0xfe, 0x47, 0x07, 0x7a, 0xc1, 0x28, 0xa8, 0xf0, 0x42, 0xd9, 0x20,
+	0x0d, 0xd5, 0x20, 0x66, 0xe2, 0x6a, 0xe5, 0xc4, 0xc9, 0x05, 0xbc, 0xb0, 0x8f, 0x48, 0x31, 0x5e,
+	0xbd, 0x12, 0x0d, 0xf4, 0x60, 0x62, 0x42, 0x74, 0xba, 0xfa, 0x49, 0x69, 0x29, 0x79, 0x28, 0x8e,
+	0x4e, 0x54, 0xd0, 0xc7, 0xc8, 0xf5, 0x1c, 0x33, 0xcf, 0x2a, 0xcc, 0xda, 0xaa, 0x63, 0xb9, 0xb3,
+	0x13, 0x46, 0x3e, 0x5e, 0xf9, 0x39, 0xc7, 0xab, 0xfb, 0x2e, 0x34, 0x65, 0xa0, 0x9e, 0xf3, 0xd1,
+	0x57, 0xe0, 0x16, 0xef, 0x29, 0xba, 0x0f, 0x77, 0x83, 0x51, 0x10, 0x9d, 0x9e, 0x0d, 0xcf, 0xce,
+	0x4f, 0xa3, 0xf3, 0xe7, 0xa7, 0x5f, 0x1e, 0x3f, 0x1d, 0x7d, 0x3e, 0x3a, 0x0e, 0xbc, 0x5b, 0xe8,
+	0x0e, 0x6c, 0x57, 0x74, 0xc3, 0xa7, 0x67, 0xa3, 0x17, 0xc7, 0x9e, 0xb3, 0x72, 0x24, 0x38, 0x56,
+	0x8a, 0xe1, 0xd9, 0x71, 0xe0, 0xd5, 0x9e, 0x7c, 0xf6, 0xa7, 0xd7, 0xbb, 0xce, 0x37, 0xaf, 0x77,
+	0x9d, 0xbf, 0xbd, 0xde, 0x75, 0x7e, 0xf3, 0x66, 0xf7, 0xd6, 0x37, 0x6f, 0x76, 0x6f, 0xfd, 0xf5,
+	0xcd, 0xee, 0xad, 0xaf, 0xf6, 0x26, 0x4c, 0x4c, 0x17, 0xe3, 0x83, 0x38, 0x9b, 0x1d, 0xe6, 0x59,
+	0xca, 0x1f, 0xb1, 0x4c, 0x7d, 0x1e, 0xbe, 0x3a, 0x94, 0xff, 0x9f, 0xa8, 0x3f, 0x4f, 0xc6, 0x4d,
+	0xf5, 0xef, 0xc9, 0x8f, 0xfe, 0x33, 0x00, 0xd8, 0x78, 0x8e, 0x05, 0x9e, 0x11, 0x00, 0x00,
+}
+
+func (m *Authentication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authentication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authentication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
 	if m.CreationBlock != 0 {
 		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
@@ -1335,7 +1729,7 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Invocation) Marshal() (dAtA []byte, err error) {
+func (m *Assertion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1345,12 +1739,12 @@ func (m *Invocation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Invocation) MarshalTo(dAtA []byte) (int, error) {
+func (m *Assertion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Invocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Assertion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1398,7 +1792,7 @@ func (m *Invocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DIDDocument) Marshal() (dAtA []byte, err error) {
+func (m *Controller) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1408,62 +1802,251 @@ func (m *DIDDocument) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DIDDocument) MarshalTo(dAtA []byte) (int, error) {
+func (m *Controller) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DIDDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Controller) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Version))
+	if m.CreationBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x30
 	}
-	if m.Deactivated {
+	if len(m.DidKind) > 0 {
+		i -= len(m.DidKind)
+		copy(dAtA[i:], m.DidKind)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DidKind)))
 		i--
-		if m.Deactivated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicKeyBase64) > 0 {
+		i -= len(m.PublicKeyBase64)
+		copy(dAtA[i:], m.PublicKeyBase64)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PublicKeyBase64)))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x22
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.UpdatedAt))
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.CreatedAt))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x12
 	}
-	if len(m.Service) > 0 {
-		for iNdEx := len(m.Service) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Service[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintState(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CapabilityDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	return len(dAtA) - i, nil
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DidKind) > 0 {
+		i -= len(m.DidKind)
+		copy(dAtA[i:], m.DidKind)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DidKind)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicKeyBase64) > 0 {
+		i -= len(m.PublicKeyBase64)
+		copy(dAtA[i:], m.PublicKeyBase64)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PublicKeyBase64)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Invocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Invocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Invocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreationBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DidKind) > 0 {
+		i -= len(m.DidKind)
+		copy(dAtA[i:], m.DidKind)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DidKind)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicKeyBase64) > 0 {
+		i -= len(m.PublicKeyBase64)
+		copy(dAtA[i:], m.PublicKeyBase64)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PublicKeyBase64)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DIDDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DIDDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DIDDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Deactivated {
+		i--
+		if m.Deactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Service) > 0 {
+		for iNdEx := len(m.Service) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Service[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintState(dAtA, i, uint64(size))
 			}
@@ -1587,6 +2170,11 @@ func (m *DIDDocumentMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.CanonicalId) > 0 {
 		i -= len(m.CanonicalId)
 		copy(dAtA[i:], m.CanonicalId)
@@ -1647,7 +2235,7 @@ func (m *DIDDocumentMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifiableCredential) Marshal() (dAtA []byte, err error) {
+func (m *DIDDocumentVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1657,46 +2245,19 @@ func (m *VerifiableCredential) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+func (m *DIDDocumentVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DIDDocumentVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Revoked {
-		i--
-		if m.Revoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.ExpiresAt != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IssuedAt != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.IssuedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.CredentialStatus != nil {
+	if m.Document != nil {
 		{
-			size, err := m.CredentialStatus.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1704,79 +2265,34 @@ func (m *VerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintState(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x2a
 	}
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintState(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.CredentialSubject) > 0 {
-		i -= len(m.CredentialSubject)
-		copy(dAtA[i:], m.CredentialSubject)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CredentialSubject)))
+	if m.VersionTime != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VersionTime))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x18
 	}
-	if len(m.ExpirationDate) > 0 {
-		i -= len(m.ExpirationDate)
-		copy(dAtA[i:], m.ExpirationDate)
-		i = encodeVarintState(dAtA, i, uint64(len(m.ExpirationDate)))
+	if m.VersionId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VersionId))
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IssuanceDate) > 0 {
-		i -= len(m.IssuanceDate)
-		copy(dAtA[i:], m.IssuanceDate)
-		i = encodeVarintState(dAtA, i, uint64(len(m.IssuanceDate)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CredentialKinds) > 0 {
-		for iNdEx := len(m.CredentialKinds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CredentialKinds[iNdEx])
-			copy(dAtA[i:], m.CredentialKinds[iNdEx])
-			i = encodeVarintState(dAtA, i, uint64(len(m.CredentialKinds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Context) > 0 {
-		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Context[iNdEx])
-			copy(dAtA[i:], m.Context[iNdEx])
-			i = encodeVarintState(dAtA, i, uint64(len(m.Context[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x10
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DIDController) Marshal() (dAtA []byte, err error) {
+func (m *UnverifiedCredential) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1786,163 +2302,480 @@ func (m *DIDController) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DIDController) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnverifiedCredential) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DIDController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnverifiedCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AddedAt != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.AddedAt))
+	if m.FlaggedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.FlaggedAt))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
 	}
-	if len(m.ControllerDid) > 0 {
-		i -= len(m.ControllerDid)
-		copy(dAtA[i:], m.ControllerDid)
-		i = encodeVarintState(dAtA, i, uint64(len(m.ControllerDid)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CredentialId)))
 		i--
 		dAtA[i] = 0x1a
 	}
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Did) > 0 {
 		i -= len(m.Did)
 		copy(dAtA[i:], m.Did)
 		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StatusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Authentication) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StatusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.UpdatedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Allocated != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Allocated))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Bitstring) > 0 {
+		i -= len(m.Bitstring)
+		copy(dAtA[i:], m.Bitstring)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Bitstring)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PublicKeyBase64)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DidKind)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.ListId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ListId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.CreationBlock != 0 {
-		n += 1 + sovState(uint64(m.CreationBlock))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Assertion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegatedController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatedController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.GrantedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.GrantedAt))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PublicKeyBase64)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.GrantedBy) > 0 {
+		i -= len(m.GrantedBy)
+		copy(dAtA[i:], m.GrantedBy)
+		i = encodeVarintState(dAtA, i, uint64(len(m.GrantedBy)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DidKind)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CreationBlock != 0 {
-		n += 1 + sovState(uint64(m.CreationBlock))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Controller) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DIDAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DIDAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DIDAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.AttachedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AttachedAt))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.DwnRecordId) > 0 {
+		i -= len(m.DwnRecordId)
+		copy(dAtA[i:], m.DwnRecordId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DwnRecordId)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.PublicKeyBase64)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Interchain {
+		i--
+		if m.Interchain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.DidKind)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.ClassUri) > 0 {
+		i -= len(m.ClassUri)
+		copy(dAtA[i:], m.ClassUri)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ClassUri)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.CreationBlock != 0 {
-		n += 1 + sovState(uint64(m.CreationBlock))
+	if len(m.ClassName) > 0 {
+		i -= len(m.ClassName)
+		copy(dAtA[i:], m.ClassName)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ClassName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintState(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifiableCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CredentialStatus != nil {
+		{
+			size, err := m.CredentialStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CredentialSubject) > 0 {
+		i -= len(m.CredentialSubject)
+		copy(dAtA[i:], m.CredentialSubject)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CredentialSubject)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IssuanceDate) > 0 {
+		i -= len(m.IssuanceDate)
+		copy(dAtA[i:], m.IssuanceDate)
+		i = encodeVarintState(dAtA, i, uint64(len(m.IssuanceDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CredentialKinds) > 0 {
+		for iNdEx := len(m.CredentialKinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CredentialKinds[iNdEx])
+			copy(dAtA[i:], m.CredentialKinds[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.CredentialKinds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DIDController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DIDController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DIDController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ControllerDid) > 0 {
+		i -= len(m.ControllerDid)
+		copy(dAtA[i:], m.ControllerDid)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ControllerDid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Authentication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
 	}
 	l = len(m.Subject)
 	if l > 0 {
@@ -1962,7 +2795,7 @@ func (m *Delegation) Size() (n int) {
 	return n
 }
 
-func (m *Invocation) Size() (n int) {
+func (m *Assertion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1994,43 +2827,139 @@ func (m *Invocation) Size() (n int) {
 	return n
 }
 
-func (m *DIDDocument) Size() (n int) {
+func (m *Controller) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Did)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	l = len(m.PrimaryController)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	if len(m.AlsoKnownAs) > 0 {
-		for _, s := range m.AlsoKnownAs {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
-		}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
 	}
-	if len(m.VerificationMethod) > 0 {
-		for _, e := range m.VerificationMethod {
-			l = e.Size()
-			n += 1 + l + sovState(uint64(l))
-		}
+	l = len(m.PublicKeyBase64)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
 	}
-	if len(m.Authentication) > 0 {
-		for _, e := range m.Authentication {
-			l = e.Size()
-			n += 1 + l + sovState(uint64(l))
-		}
+	l = len(m.DidKind)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
 	}
-	if len(m.AssertionMethod) > 0 {
-		for _, e := range m.AssertionMethod {
-			l = e.Size()
-			n += 1 + l + sovState(uint64(l))
-		}
+	if m.CreationBlock != 0 {
+		n += 1 + sovState(uint64(m.CreationBlock))
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.PublicKeyBase64)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DidKind)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.CreationBlock != 0 {
+		n += 1 + sovState(uint64(m.CreationBlock))
+	}
+	return n
+}
+
+func (m *Invocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.PublicKeyBase64)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DidKind)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.CreationBlock != 0 {
+		n += 1 + sovState(uint64(m.CreationBlock))
+	}
+	return n
+}
+
+func (m *DIDDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.PrimaryController)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.AlsoKnownAs) > 0 {
+		for _, s := range m.AlsoKnownAs {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.VerificationMethod) > 0 {
+		for _, e := range m.VerificationMethod {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.Authentication) > 0 {
+		for _, e := range m.Authentication {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for _, e := range m.AssertionMethod {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
 	}
 	if len(m.KeyAgreement) > 0 {
 		for _, e := range m.KeyAgreement {
@@ -2111,6 +3040,179 @@ func (m *DIDDocumentMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *DIDDocumentVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.VersionId != 0 {
+		n += 1 + sovState(uint64(m.VersionId))
+	}
+	if m.VersionTime != 0 {
+		n += 1 + sovState(uint64(m.VersionTime))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *UnverifiedCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.FlaggedAt != 0 {
+		n += 1 + sovState(uint64(m.FlaggedAt))
+	}
+	return n
+}
+
+func (m *StatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ListId != 0 {
+		n += 1 + sovState(uint64(m.ListId))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Bitstring)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Allocated != 0 {
+		n += 1 + sovState(uint64(m.Allocated))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovState(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *DelegatedController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.GrantedBy)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.GrantedAt != 0 {
+		n += 1 + sovState(uint64(m.GrantedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovState(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *DIDAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ClassName)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ClassUri)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Interchain {
+		n += 2
+	}
+	l = len(m.DwnRecordId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.AttachedAt != 0 {
+		n += 1 + sovState(uint64(m.AttachedAt))
+	}
 	return n
 }
 
@@ -2207,7 +3309,1152 @@ func sovState(x uint64) (n int) {
 func sozState(x uint64) (n int) {
 	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Authentication) Unmarshal(dAtA []byte) error {
+func (m *Authentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+			}
+			m.CreationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Assertion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assertion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assertion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+			}
+			m.CreationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Controller) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Controller: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Controller: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+			}
+			m.CreationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+			}
+			m.CreationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Invocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Invocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Invocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+			}
+			m.CreationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2230,15 +4477,15 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Authentication: wiretype end group for non-group")
+			return fmt.Errorf("proto: DIDDocument: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Authentication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DIDDocument: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2266,11 +4513,11 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryController", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2298,13 +4545,249 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.PrimaryController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
+			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authentication = append(m.Authentication, &VerificationMethodReference{})
+			if err := m.Authentication[len(m.Authentication)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssertionMethod = append(m.AssertionMethod, &VerificationMethodReference{})
+			if err := m.AssertionMethod[len(m.AssertionMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAgreement = append(m.KeyAgreement, &VerificationMethodReference{})
+			if err := m.KeyAgreement[len(m.KeyAgreement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityInvocation = append(m.CapabilityInvocation, &VerificationMethodReference{})
+			if err := m.CapabilityInvocation[len(m.CapabilityInvocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityDelegation = append(m.CapabilityDelegation, &VerificationMethodReference{})
+			if err := m.CapabilityDelegation[len(m.CapabilityDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2314,29 +4797,31 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Service = append(m.Service, &Service{})
+			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2346,29 +4831,16 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var stringLen uint64
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2378,29 +4850,36 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DidKind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.Deactivated = bool(v != 0)
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.CreationBlock = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2410,7 +4889,7 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationBlock |= int64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2436,7 +4915,7 @@ func (m *Authentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Assertion) Unmarshal(dAtA []byte) error {
+func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2459,10 +4938,10 @@ func (m *Assertion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Assertion: wiretype end group for non-group")
+			return fmt.Errorf("proto: DIDDocumentMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Assertion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DIDDocumentMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2498,10 +4977,10 @@ func (m *Assertion) Unmarshal(dAtA []byte) error {
 			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			m.Created = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2511,29 +4990,16 @@ func (m *Assertion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Created |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			m.Updated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2543,93 +5009,16 @@ func (m *Assertion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Updated |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DidKind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
 			}
-			m.CreationBlock = 0
+			m.Deactivated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2639,64 +5028,14 @@ func (m *Assertion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationBlock |= int64(b&0x7F) << shift
+				m.Deactivated |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Controller) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Controller: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Controller: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2724,13 +5063,13 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.VersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUpdate", wireType)
 			}
-			var stringLen uint64
+			m.NextUpdate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2740,27 +5079,14 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextUpdate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVersionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2788,11 +5114,11 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.NextVersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2820,11 +5146,11 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			m.EquivalentId = append(m.EquivalentId, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2852,13 +5178,13 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidKind = string(dAtA[iNdEx:postIndex])
+			m.CanonicalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.CreationBlock = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2868,7 +5194,7 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationBlock |= int64(b&0x7F) << shift
+				m.Status |= DIDStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2894,7 +5220,7 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *DIDDocumentVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,10 +5243,10 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DIDDocumentVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DIDDocumentVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2956,10 +5282,10 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 			}
-			var stringLen uint64
+			m.VersionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2969,29 +5295,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VersionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
 			}
-			var stringLen uint64
+			m.VersionTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3001,29 +5314,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VersionTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3033,29 +5333,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3065,43 +5352,28 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidKind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+			if m.Document == nil {
+				m.Document = &DIDDocument{}
 			}
-			m.CreationBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3123,7 +5395,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Invocation) Unmarshal(dAtA []byte) error {
+func (m *UnverifiedCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,10 +5418,10 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Invocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnverifiedCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Invocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnverifiedCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3186,7 +5458,7 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3214,11 +5486,11 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3246,11 +5518,11 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyBase64", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3278,11 +5550,11 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeyBase64 = string(dAtA[iNdEx:postIndex])
+			m.Origin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3310,13 +5582,13 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidKind = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlaggedAt", wireType)
 			}
-			m.CreationBlock = 0
+			m.FlaggedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3326,7 +5598,7 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationBlock |= int64(b&0x7F) << shift
+				m.FlaggedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3352,7 +5624,7 @@ func (m *Invocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DIDDocument) Unmarshal(dAtA []byte) error {
+func (m *StatusList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3375,15 +5647,15 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DIDDocument: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DIDDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3411,13 +5683,13 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryController", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListId", wireType)
 			}
-			var stringLen uint64
+			m.ListId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3427,27 +5699,14 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ListId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PrimaryController = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3475,13 +5734,13 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+			m.Purpose = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitstring", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3491,31 +5750,31 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
-			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bitstring = append(m.Bitstring[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitstring == nil {
+				m.Bitstring = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
 			}
-			var msglen int
+			m.Allocated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3525,31 +5784,16 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Allocated |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authentication = append(m.Authentication, &VerificationMethodReference{})
-			if err := m.Authentication[len(m.Authentication)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var msglen int
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3559,31 +5803,66 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssertionMethod = append(m.AssertionMethod, &VerificationMethodReference{})
-			if err := m.AssertionMethod[len(m.AssertionMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatedController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3593,31 +5872,29 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyAgreement = append(m.KeyAgreement, &VerificationMethodReference{})
-			if err := m.KeyAgreement[len(m.KeyAgreement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3627,31 +5904,29 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityInvocation = append(m.CapabilityInvocation, &VerificationMethodReference{})
-			if err := m.CapabilityInvocation[len(m.CapabilityInvocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3661,31 +5936,29 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityDelegation = append(m.CapabilityDelegation, &VerificationMethodReference{})
-			if err := m.CapabilityDelegation[len(m.CapabilityDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GrantedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3695,69 +5968,29 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = append(m.Service, &Service{})
-			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
-			}
-			m.UpdatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
 			}
-			var v int
+			m.GrantedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3767,17 +6000,16 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GrantedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Deactivated = bool(v != 0)
-		case 14:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.Version = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3787,7 +6019,7 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3813,7 +6045,7 @@ func (m *DIDDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
+func (m *DIDAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3836,10 +6068,10 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DIDDocumentMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: DIDAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DIDDocumentMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DIDAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3875,10 +6107,10 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			m.Created = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3888,16 +6120,29 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Created |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
-			m.Updated = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3907,16 +6152,29 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Updated |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.Deactivated = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3926,14 +6184,27 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deactivated |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3961,13 +6232,13 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextUpdate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
 			}
-			m.NextUpdate = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3977,14 +6248,27 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextUpdate |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextVersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4012,11 +6296,11 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextVersionId = string(dAtA[iNdEx:postIndex])
+			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4044,11 +6328,31 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EquivalentId = append(m.EquivalentId, string(dAtA[iNdEx:postIndex]))
+			m.ClassUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interchain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Interchain = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DwnRecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4076,8 +6380,27 @@ func (m *DIDDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanonicalId = string(dAtA[iNdEx:postIndex])
+			m.DwnRecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachedAt", wireType)
+			}
+			m.AttachedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttachedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
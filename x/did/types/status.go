@@ -0,0 +1,17 @@
+package types
+
+// StatusOf returns the lifecycle status of a DID document
+func StatusOf(doc *DIDDocument) DIDStatus {
+	if doc == nil {
+		return DIDStatus_DID_STATUS_UNSPECIFIED
+	}
+	if doc.Deactivated {
+		return DIDStatus_DID_STATUS_DEACTIVATED
+	}
+	return DIDStatus_DID_STATUS_ACTIVE
+}
+
+// IsActive reports whether a DID document can be updated and used to sign
+func (d *DIDDocument) IsActive() bool {
+	return StatusOf(d) == DIDStatus_DID_STATUS_ACTIVE
+}
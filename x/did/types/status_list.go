@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// StatusList2021EntryKind is the credentialStatus type of an entry in a
+	// status list
+	StatusList2021EntryKind = "StatusList2021Entry"
+
+	// StatusPurposeRevocation is the only status purpose kept on chain
+	StatusPurposeRevocation = "revocation"
+
+	// StatusListCapacity is the number of entries of a list, the 16KB
+	// minimum StatusList2021 recommends for herd privacy
+	StatusListCapacity = 131072
+
+	// Property names of a StatusList2021Entry
+	StatusPropertyPurpose    = "statusPurpose"
+	StatusPropertyIndex      = "statusListIndex"
+	StatusPropertyCredential = "statusListCredential"
+)
+
+// StatusListURL is the identifier of an issuer's status list: a DID URL
+// under the issuer, served by Query/GetStatusList
+func StatusListURL(issuer string, listID uint64) string {
+	return fmt.Sprintf("%s/credentials/status/%d", issuer, listID)
+}
+
+// NewStatusList creates an empty revocation list
+func NewStatusList(issuer string, listID uint64, updatedAt int64) StatusList {
+	return StatusList{
+		Issuer:    issuer,
+		ListId:    listID,
+		Purpose:   StatusPurposeRevocation,
+		Bitstring: make([]byte, StatusListCapacity/8),
+		UpdatedAt: updatedAt,
+	}
+}
+
+// IsSet reports whether the entry at index is set. Index 0 is the most
+// significant bit of the first byte.
+func (l StatusList) IsSet(index uint64) bool {
+	if index >= uint64(len(l.Bitstring))*8 {
+		return false
+	}
+	return l.Bitstring[index/8]&(0x80>>(index%8)) != 0
+}
+
+// Set sets the entry at index
+func (l *StatusList) Set(index uint64) {
+	if index < uint64(len(l.Bitstring))*8 {
+		l.Bitstring[index/8] |= 0x80 >> (index % 8)
+	}
+}
+
+// EncodedList is the bitstring gzip compressed and base64url encoded, the
+// encodedList of a StatusList2021Credential
+func (l StatusList) EncodedList() (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(l.Bitstring); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Credential renders the list as a StatusList2021Credential. It carries no
+// proof; the chain state it is read from is what attests to it.
+func (l StatusList) Credential() ([]byte, error) {
+	encoded, err := l.EncodedList()
+	if err != nil {
+		return nil, err
+	}
+	url := StatusListURL(l.Issuer, l.ListId)
+	return json.Marshal(map[string]any{
+		"@context": []string{
+			"https://www.w3.org/2018/credentials/v1",
+			"https://w3id.org/vc/status-list/2021/v1",
+		},
+		"id":           url,
+		"type":         []string{"VerifiableCredential", "StatusList2021Credential"},
+		"issuer":       l.Issuer,
+		"issuanceDate": time.Unix(l.UpdatedAt, 0).UTC().Format(time.RFC3339),
+		"credentialSubject": map[string]any{
+			"id":            url + "#list",
+			"type":          "StatusList2021",
+			"statusPurpose": l.Purpose,
+			"encodedList":   encoded,
+		},
+	})
+}
+
+// NewStatusListEntry builds the credentialStatus pointing at index of a list
+func NewStatusListEntry(issuer string, listID, index uint64) *CredentialStatus {
+	url := StatusListURL(issuer, listID)
+	return &CredentialStatus{
+		Id:         fmt.Sprintf("%s#%d", url, index),
+		StatusKind: StatusList2021EntryKind,
+		Properties: map[string]string{
+			StatusPropertyPurpose:    StatusPurposeRevocation,
+			StatusPropertyIndex:      strconv.FormatUint(index, 10),
+			StatusPropertyCredential: url,
+		},
+	}
+}
+
+// ParseStatusListEntry reads the list and index of a StatusList2021Entry
+// issued by issuer
+func ParseStatusListEntry(issuer string, status *CredentialStatus) (uint64, uint64, error) {
+	if status.StatusKind != StatusList2021EntryKind {
+		return 0, 0, ErrInvalidCredential.Wrapf("unsupported credential status type %q", status.StatusKind)
+	}
+	if purpose := status.Properties[StatusPropertyPurpose]; purpose != StatusPurposeRevocation {
+		return 0, 0, ErrInvalidCredential.Wrapf("unsupported status purpose %q", purpose)
+	}
+
+	prefix := StatusListURL(issuer, 0)
+	prefix = prefix[:len(prefix)-1]
+	list, ok := strings.CutPrefix(status.Properties[StatusPropertyCredential], prefix)
+	if !ok {
+		return 0, 0, ErrInvalidCredential.Wrapf("status list must be one of %s's lists at %s<n>", issuer, prefix)
+	}
+	listID, err := strconv.ParseUint(list, 10, 64)
+	if err != nil || listID == 0 {
+		return 0, 0, ErrInvalidCredential.Wrapf("invalid status list %q", list)
+	}
+	index, err := strconv.ParseUint(status.Properties[StatusPropertyIndex], 10, 64)
+	if err != nil || index >= StatusListCapacity {
+		return 0, 0, ErrInvalidCredential.Wrapf("status list index must be below %d", StatusListCapacity)
+	}
+	if status.Id != "" && status.Id != fmt.Sprintf("%s#%d", StatusListURL(issuer, listID), index) {
+		return 0, 0, ErrInvalidCredential.Wrap("credential status id does not match its list and index")
+	}
+	return listID, index, nil
+}
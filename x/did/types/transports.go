@@ -0,0 +1,72 @@
+package types
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+)
+
+// Authenticator transports a credential can be reached over, as defined by
+// the WebAuthn specification
+const (
+	TransportUSB       = "usb"
+	TransportNFC       = "nfc"
+	TransportBLE       = "ble"
+	TransportSmartCard = "smart-card"
+	// TransportHybrid reaches a passkey on a phone from another device, by
+	// scanning a QR code and proximity over BLE (formerly caBLE)
+	TransportHybrid   = "hybrid"
+	TransportInternal = "internal"
+)
+
+// SupportedTransports lists the transports recorded on credentials
+var SupportedTransports = []string{
+	TransportUSB,
+	TransportNFC,
+	TransportBLE,
+	TransportSmartCard,
+	TransportHybrid,
+	TransportInternal,
+}
+
+// legacyTransports maps transport names used by older browsers
+var legacyTransports = map[string]string{
+	"cable": TransportHybrid,
+}
+
+// NormalizeTransports returns the supported transports among those an
+// authenticator reported, in order and without duplicates. Browsers may
+// report transports this module does not know; they are only hints, so they
+// are dropped rather than failing the registration.
+func NormalizeTransports(transports []string) []string {
+	var normalized []string
+	for _, t := range transports {
+		if legacy, ok := legacyTransports[t]; ok {
+			t = legacy
+		}
+		if slices.Contains(SupportedTransports, t) && !slices.Contains(normalized, t) {
+			normalized = append(normalized, t)
+		}
+	}
+	return normalized
+}
+
+// ValidateTransports checks that a credential's transports are supported and
+// distinct
+func ValidateTransports(transports []string) error {
+	for i, t := range transports {
+		if !slices.Contains(SupportedTransports, t) {
+			return errors.Wrapf(ErrInvalidWebAuthnCredential, "unsupported transport %q", t)
+		}
+		if slices.Contains(transports[:i], t) {
+			return errors.Wrapf(ErrInvalidWebAuthnCredential, "duplicate transport %q", t)
+		}
+	}
+	return nil
+}
+
+// SupportsHybrid reports whether the credential can be used from another
+// device, e.g. a phone-held passkey signing in on a desktop
+func (c *WebAuthnCredential) SupportsHybrid() bool {
+	return slices.Contains(c.Transports, TransportHybrid)
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/did/types"
+)
+
+func TestNormalizeTransports(t *testing.T) {
+	require.Equal(t,
+		[]string{"hybrid", "internal"},
+		types.NormalizeTransports([]string{"cable", "internal", "hybrid", "carrier-pigeon"}),
+	)
+	require.Nil(t, types.NormalizeTransports(nil))
+}
+
+func TestValidateTransports(t *testing.T) {
+	require.NoError(t, types.ValidateTransports([]string{"usb", "hybrid"}))
+	require.ErrorIs(t, types.ValidateTransports([]string{"cable"}), types.ErrInvalidWebAuthnCredential)
+	require.ErrorIs(t, types.ValidateTransports([]string{"nfc", "nfc"}), types.ErrInvalidWebAuthnCredential)
+
+	cred := &types.WebAuthnCredential{Transports: []string{"internal", "hybrid"}}
+	require.True(t, cred.SupportsHybrid())
+}
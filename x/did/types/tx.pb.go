@@ -135,6 +135,8 @@ type MsgCreateDID struct {
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
 	// did_document is the DID document to create
 	DidDocument DIDDocument `protobuf:"bytes,2,opt,name=did_document,json=didDocument,proto3" json:"did_document"`
+	// referral_code is the optional invite code the DID was referred with
+	ReferralCode string `protobuf:"bytes,3,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
 }
 
 func (m *MsgCreateDID) Reset()         { *m = MsgCreateDID{} }
@@ -184,6 +186,13 @@ func (m *MsgCreateDID) GetDidDocument() DIDDocument {
 	return DIDDocument{}
 }
 
+func (m *MsgCreateDID) GetReferralCode() string {
+	if m != nil {
+		return m.ReferralCode
+	}
+	return ""
+}
+
 // MsgCreateDIDResponse defines the response for MsgCreateDID
 type MsgCreateDIDResponse struct {
 	// did is the created DID identifier
@@ -450,6 +459,98 @@ func (m *MsgDeactivateDIDResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeactivateDIDResponse proto.InternalMessageInfo
 
+// MsgReactivateDID reactivates a deactivated DID document
+type MsgReactivateDID struct {
+	// controller is the address reactivating the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID to reactivate
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *MsgReactivateDID) Reset()         { *m = MsgReactivateDID{} }
+func (m *MsgReactivateDID) String() string { return proto.CompactTextString(m) }
+func (*MsgReactivateDID) ProtoMessage()    {}
+func (*MsgReactivateDID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{8}
+}
+func (m *MsgReactivateDID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReactivateDID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReactivateDID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReactivateDID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReactivateDID.Merge(m, src)
+}
+func (m *MsgReactivateDID) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReactivateDID) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReactivateDID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReactivateDID proto.InternalMessageInfo
+
+func (m *MsgReactivateDID) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *MsgReactivateDID) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// MsgReactivateDIDResponse defines the response for MsgReactivateDID
+type MsgReactivateDIDResponse struct {
+}
+
+func (m *MsgReactivateDIDResponse) Reset()         { *m = MsgReactivateDIDResponse{} }
+func (m *MsgReactivateDIDResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReactivateDIDResponse) ProtoMessage()    {}
+func (*MsgReactivateDIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{9}
+}
+func (m *MsgReactivateDIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReactivateDIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReactivateDIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReactivateDIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReactivateDIDResponse.Merge(m, src)
+}
+func (m *MsgReactivateDIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReactivateDIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReactivateDIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReactivateDIDResponse proto.InternalMessageInfo
+
 // MsgAddVerificationMethod adds a verification method to a DID document
 type MsgAddVerificationMethod struct {
 	// controller is the address adding the verification method
@@ -466,7 +567,7 @@ func (m *MsgAddVerificationMethod) Reset()         { *m = MsgAddVerificationMeth
 func (m *MsgAddVerificationMethod) String() string { return proto.CompactTextString(m) }
 func (*MsgAddVerificationMethod) ProtoMessage()    {}
 func (*MsgAddVerificationMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{8}
+	return fileDescriptor_d73284df019ff211, []int{10}
 }
 func (m *MsgAddVerificationMethod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +633,7 @@ func (m *MsgAddVerificationMethodResponse) Reset()         { *m = MsgAddVerifica
 func (m *MsgAddVerificationMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddVerificationMethodResponse) ProtoMessage()    {}
 func (*MsgAddVerificationMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{9}
+	return fileDescriptor_d73284df019ff211, []int{11}
 }
 func (m *MsgAddVerificationMethodResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +676,7 @@ func (m *MsgRemoveVerificationMethod) Reset()         { *m = MsgRemoveVerificati
 func (m *MsgRemoveVerificationMethod) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveVerificationMethod) ProtoMessage()    {}
 func (*MsgRemoveVerificationMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{10}
+	return fileDescriptor_d73284df019ff211, []int{12}
 }
 func (m *MsgRemoveVerificationMethod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +735,7 @@ func (m *MsgRemoveVerificationMethodResponse) Reset()         { *m = MsgRemoveVe
 func (m *MsgRemoveVerificationMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveVerificationMethodResponse) ProtoMessage()    {}
 func (*MsgRemoveVerificationMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{11}
+	return fileDescriptor_d73284df019ff211, []int{13}
 }
 func (m *MsgRemoveVerificationMethodResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +778,7 @@ func (m *MsgAddService) Reset()         { *m = MsgAddService{} }
 func (m *MsgAddService) String() string { return proto.CompactTextString(m) }
 func (*MsgAddService) ProtoMessage()    {}
 func (*MsgAddService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{12}
+	return fileDescriptor_d73284df019ff211, []int{14}
 }
 func (m *MsgAddService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +836,7 @@ func (m *MsgAddServiceResponse) Reset()         { *m = MsgAddServiceResponse{} }
 func (m *MsgAddServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddServiceResponse) ProtoMessage()    {}
 func (*MsgAddServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{13}
+	return fileDescriptor_d73284df019ff211, []int{15}
 }
 func (m *MsgAddServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +879,7 @@ func (m *MsgRemoveService) Reset()         { *m = MsgRemoveService{} }
 func (m *MsgRemoveService) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveService) ProtoMessage()    {}
 func (*MsgRemoveService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{14}
+	return fileDescriptor_d73284df019ff211, []int{16}
 }
 func (m *MsgRemoveService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +937,7 @@ func (m *MsgRemoveServiceResponse) Reset()         { *m = MsgRemoveServiceRespon
 func (m *MsgRemoveServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveServiceResponse) ProtoMessage()    {}
 func (*MsgRemoveServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{15}
+	return fileDescriptor_d73284df019ff211, []int{17}
 }
 func (m *MsgRemoveServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -877,7 +978,7 @@ func (m *MsgIssueVerifiableCredential) Reset()         { *m = MsgIssueVerifiable
 func (m *MsgIssueVerifiableCredential) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueVerifiableCredential) ProtoMessage()    {}
 func (*MsgIssueVerifiableCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{16}
+	return fileDescriptor_d73284df019ff211, []int{18}
 }
 func (m *MsgIssueVerifiableCredential) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,7 +1032,7 @@ func (m *MsgIssueVerifiableCredentialResponse) Reset()         { *m = MsgIssueVe
 func (m *MsgIssueVerifiableCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueVerifiableCredentialResponse) ProtoMessage()    {}
 func (*MsgIssueVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{17}
+	return fileDescriptor_d73284df019ff211, []int{19}
 }
 func (m *MsgIssueVerifiableCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,7 +1082,7 @@ func (m *MsgRevokeVerifiableCredential) Reset()         { *m = MsgRevokeVerifiab
 func (m *MsgRevokeVerifiableCredential) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeVerifiableCredential) ProtoMessage()    {}
 func (*MsgRevokeVerifiableCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{18}
+	return fileDescriptor_d73284df019ff211, []int{20}
 }
 func (m *MsgRevokeVerifiableCredential) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1141,7 @@ func (m *MsgRevokeVerifiableCredentialResponse) Reset()         { *m = MsgRevoke
 func (m *MsgRevokeVerifiableCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeVerifiableCredentialResponse) ProtoMessage()    {}
 func (*MsgRevokeVerifiableCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{19}
+	return fileDescriptor_d73284df019ff211, []int{21}
 }
 func (m *MsgRevokeVerifiableCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1093,7 +1194,7 @@ func (m *MsgLinkExternalWallet) Reset()         { *m = MsgLinkExternalWallet{} }
 func (m *MsgLinkExternalWallet) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkExternalWallet) ProtoMessage()    {}
 func (*MsgLinkExternalWallet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{20}
+	return fileDescriptor_d73284df019ff211, []int{22}
 }
 func (m *MsgLinkExternalWallet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1289,7 @@ func (m *MsgLinkExternalWalletResponse) Reset()         { *m = MsgLinkExternalWa
 func (m *MsgLinkExternalWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkExternalWalletResponse) ProtoMessage()    {}
 func (*MsgLinkExternalWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{21}
+	return fileDescriptor_d73284df019ff211, []int{23}
 }
 func (m *MsgLinkExternalWalletResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1224,32 +1325,34 @@ func (m *MsgLinkExternalWalletResponse) GetVerificationMethodId() string {
 	return ""
 }
 
-// MsgRegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
-type MsgRegisterWebAuthnCredential struct {
-	// controller is the address that will control the created DID
+// MsgAddDelegatedController delegates control of a DID to another address
+// until expires_at. Adding a delegation the address already holds replaces
+// its expiry and purpose.
+type MsgAddDelegatedController struct {
+	// controller is the address that controls the DID
 	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// username is the human-readable identifier for the DID
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// webauthn_credential contains the WebAuthn credential data
-	WebauthnCredential WebAuthnCredential `protobuf:"bytes,3,opt,name=webauthn_credential,json=webauthnCredential,proto3" json:"webauthn_credential"`
-	// verification_method_id is the ID for the WebAuthn verification method
-	VerificationMethodId string `protobuf:"bytes,4,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
-	// auto_create_vault indicates whether to automatically create a vault
-	AutoCreateVault bool `protobuf:"varint,5,opt,name=auto_create_vault,json=autoCreateVault,proto3" json:"auto_create_vault,omitempty"`
+	// did is the DID being delegated
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// delegate is the address receiving control
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// expires_at is when the delegation ends, in unix seconds
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// purpose describes what the delegation is for
+	Purpose string `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`
 }
 
-func (m *MsgRegisterWebAuthnCredential) Reset()         { *m = MsgRegisterWebAuthnCredential{} }
-func (m *MsgRegisterWebAuthnCredential) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterWebAuthnCredential) ProtoMessage()    {}
-func (*MsgRegisterWebAuthnCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{22}
+func (m *MsgAddDelegatedController) Reset()         { *m = MsgAddDelegatedController{} }
+func (m *MsgAddDelegatedController) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDelegatedController) ProtoMessage()    {}
+func (*MsgAddDelegatedController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{24}
 }
-func (m *MsgRegisterWebAuthnCredential) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddDelegatedController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterWebAuthnCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddDelegatedController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterWebAuthnCredential.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddDelegatedController.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1259,79 +1362,70 @@ func (m *MsgRegisterWebAuthnCredential) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterWebAuthnCredential) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterWebAuthnCredential.Merge(m, src)
+func (m *MsgAddDelegatedController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDelegatedController.Merge(m, src)
 }
-func (m *MsgRegisterWebAuthnCredential) XXX_Size() int {
+func (m *MsgAddDelegatedController) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterWebAuthnCredential) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterWebAuthnCredential.DiscardUnknown(m)
+func (m *MsgAddDelegatedController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDelegatedController.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterWebAuthnCredential proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddDelegatedController proto.InternalMessageInfo
 
-func (m *MsgRegisterWebAuthnCredential) GetController() string {
+func (m *MsgAddDelegatedController) GetController() string {
 	if m != nil {
 		return m.Controller
 	}
 	return ""
 }
 
-func (m *MsgRegisterWebAuthnCredential) GetUsername() string {
+func (m *MsgAddDelegatedController) GetDid() string {
 	if m != nil {
-		return m.Username
+		return m.Did
 	}
 	return ""
 }
 
-func (m *MsgRegisterWebAuthnCredential) GetWebauthnCredential() WebAuthnCredential {
+func (m *MsgAddDelegatedController) GetDelegate() string {
 	if m != nil {
-		return m.WebauthnCredential
+		return m.Delegate
 	}
-	return WebAuthnCredential{}
+	return ""
 }
 
-func (m *MsgRegisterWebAuthnCredential) GetVerificationMethodId() string {
+func (m *MsgAddDelegatedController) GetExpiresAt() int64 {
 	if m != nil {
-		return m.VerificationMethodId
+		return m.ExpiresAt
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRegisterWebAuthnCredential) GetAutoCreateVault() bool {
+func (m *MsgAddDelegatedController) GetPurpose() string {
 	if m != nil {
-		return m.AutoCreateVault
+		return m.Purpose
 	}
-	return false
+	return ""
 }
 
-// MsgRegisterWebAuthnCredentialResponse defines the response for MsgRegisterWebAuthnCredential
-type MsgRegisterWebAuthnCredentialResponse struct {
-	// did is the created DID identifier
-	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
-	// verification_method_id is the ID of the created verification method
-	VerificationMethodId string `protobuf:"bytes,2,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
-	// vault_id is the ID of the auto-created vault (if requested)
-	VaultId string `protobuf:"bytes,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
-	// vault_public_key is the public key of the auto-created vault (if requested)
-	VaultPublicKey []byte `protobuf:"bytes,4,opt,name=vault_public_key,json=vaultPublicKey,proto3" json:"vault_public_key,omitempty"`
-	// enclave_id is the enclave ID of the auto-created vault (if requested)
-	EnclaveId string `protobuf:"bytes,5,opt,name=enclave_id,json=enclaveId,proto3" json:"enclave_id,omitempty"`
+// MsgAddDelegatedControllerResponse defines the response for
+// MsgAddDelegatedController
+type MsgAddDelegatedControllerResponse struct {
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) Reset()         { *m = MsgRegisterWebAuthnCredentialResponse{} }
-func (m *MsgRegisterWebAuthnCredentialResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterWebAuthnCredentialResponse) ProtoMessage()    {}
-func (*MsgRegisterWebAuthnCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d73284df019ff211, []int{23}
+func (m *MsgAddDelegatedControllerResponse) Reset()         { *m = MsgAddDelegatedControllerResponse{} }
+func (m *MsgAddDelegatedControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDelegatedControllerResponse) ProtoMessage()    {}
+func (*MsgAddDelegatedControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{25}
 }
-func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddDelegatedControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddDelegatedControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddDelegatedControllerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1341,1014 +1435,1412 @@ func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse.Merge(m, src)
+func (m *MsgAddDelegatedControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDelegatedControllerResponse.Merge(m, src)
 }
-func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Size() int {
+func (m *MsgAddDelegatedControllerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterWebAuthnCredentialResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse.DiscardUnknown(m)
+func (m *MsgAddDelegatedControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDelegatedControllerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddDelegatedControllerResponse proto.InternalMessageInfo
 
-func (m *MsgRegisterWebAuthnCredentialResponse) GetDid() string {
-	if m != nil {
-		return m.Did
-	}
-	return ""
+// MsgRemoveDelegatedController ends a delegation before it expires. A
+// delegate may remove its own delegation.
+type MsgRemoveDelegatedController struct {
+	// controller is the address that controls the DID, or the delegate
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the delegated DID
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// delegate is the address whose delegation ends
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) GetVerificationMethodId() string {
-	if m != nil {
-		return m.VerificationMethodId
+func (m *MsgRemoveDelegatedController) Reset()         { *m = MsgRemoveDelegatedController{} }
+func (m *MsgRemoveDelegatedController) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDelegatedController) ProtoMessage()    {}
+func (*MsgRemoveDelegatedController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{26}
+}
+func (m *MsgRemoveDelegatedController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDelegatedController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDelegatedController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgRemoveDelegatedController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDelegatedController.Merge(m, src)
+}
+func (m *MsgRemoveDelegatedController) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDelegatedController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDelegatedController.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) GetVaultId() string {
+var xxx_messageInfo_MsgRemoveDelegatedController proto.InternalMessageInfo
+
+func (m *MsgRemoveDelegatedController) GetController() string {
 	if m != nil {
-		return m.VaultId
+		return m.Controller
 	}
 	return ""
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) GetVaultPublicKey() []byte {
+func (m *MsgRemoveDelegatedController) GetDid() string {
 	if m != nil {
-		return m.VaultPublicKey
+		return m.Did
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) GetEnclaveId() string {
+func (m *MsgRemoveDelegatedController) GetDelegate() string {
 	if m != nil {
-		return m.EnclaveId
+		return m.Delegate
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "did.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "did.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateDID)(nil), "did.v1.MsgCreateDID")
-	proto.RegisterType((*MsgCreateDIDResponse)(nil), "did.v1.MsgCreateDIDResponse")
-	proto.RegisterType((*MsgUpdateDID)(nil), "did.v1.MsgUpdateDID")
-	proto.RegisterType((*MsgUpdateDIDResponse)(nil), "did.v1.MsgUpdateDIDResponse")
-	proto.RegisterType((*MsgDeactivateDID)(nil), "did.v1.MsgDeactivateDID")
-	proto.RegisterType((*MsgDeactivateDIDResponse)(nil), "did.v1.MsgDeactivateDIDResponse")
-	proto.RegisterType((*MsgAddVerificationMethod)(nil), "did.v1.MsgAddVerificationMethod")
-	proto.RegisterType((*MsgAddVerificationMethodResponse)(nil), "did.v1.MsgAddVerificationMethodResponse")
-	proto.RegisterType((*MsgRemoveVerificationMethod)(nil), "did.v1.MsgRemoveVerificationMethod")
-	proto.RegisterType((*MsgRemoveVerificationMethodResponse)(nil), "did.v1.MsgRemoveVerificationMethodResponse")
-	proto.RegisterType((*MsgAddService)(nil), "did.v1.MsgAddService")
-	proto.RegisterType((*MsgAddServiceResponse)(nil), "did.v1.MsgAddServiceResponse")
-	proto.RegisterType((*MsgRemoveService)(nil), "did.v1.MsgRemoveService")
-	proto.RegisterType((*MsgRemoveServiceResponse)(nil), "did.v1.MsgRemoveServiceResponse")
-	proto.RegisterType((*MsgIssueVerifiableCredential)(nil), "did.v1.MsgIssueVerifiableCredential")
-	proto.RegisterType((*MsgIssueVerifiableCredentialResponse)(nil), "did.v1.MsgIssueVerifiableCredentialResponse")
-	proto.RegisterType((*MsgRevokeVerifiableCredential)(nil), "did.v1.MsgRevokeVerifiableCredential")
-	proto.RegisterType((*MsgRevokeVerifiableCredentialResponse)(nil), "did.v1.MsgRevokeVerifiableCredentialResponse")
-	proto.RegisterType((*MsgLinkExternalWallet)(nil), "did.v1.MsgLinkExternalWallet")
-	proto.RegisterType((*MsgLinkExternalWalletResponse)(nil), "did.v1.MsgLinkExternalWalletResponse")
-	proto.RegisterType((*MsgRegisterWebAuthnCredential)(nil), "did.v1.MsgRegisterWebAuthnCredential")
-	proto.RegisterType((*MsgRegisterWebAuthnCredentialResponse)(nil), "did.v1.MsgRegisterWebAuthnCredentialResponse")
+// MsgRemoveDelegatedControllerResponse defines the response for
+// MsgRemoveDelegatedController
+type MsgRemoveDelegatedControllerResponse struct {
 }
 
-func init() { proto.RegisterFile("did/v1/tx.proto", fileDescriptor_d73284df019ff211) }
-
-var fileDescriptor_d73284df019ff211 = []byte{
-	// 1259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0x69, 0x1a, 0xbf, 0xfc, 0x70, 0xba, 0x75, 0x9b, 0xcd, 0x92, 0xb8, 0x96, 0x93,
-	0x50, 0x2b, 0x6d, 0x62, 0x5a, 0x10, 0x42, 0x11, 0x08, 0x25, 0x31, 0x12, 0x56, 0xb0, 0x14, 0x5c,
-	0xda, 0x4a, 0xbd, 0x58, 0xeb, 0x9d, 0xe9, 0x7a, 0x94, 0xf5, 0x8e, 0xb5, 0x33, 0x76, 0x92, 0x1b,
-	0xe2, 0xc2, 0x05, 0x21, 0x6e, 0x9c, 0x38, 0x70, 0xe4, 0x96, 0x03, 0x37, 0x24, 0xce, 0x3d, 0x56,
-	0x70, 0xe1, 0x84, 0x20, 0x39, 0xf4, 0xca, 0x9f, 0x80, 0x76, 0x76, 0xbc, 0xde, 0xf5, 0x7a, 0x9d,
-	0x40, 0xd3, 0x53, 0xb3, 0xdf, 0x7b, 0xf3, 0xde, 0xf7, 0xbe, 0x37, 0x3f, 0x5e, 0x0d, 0x59, 0x44,
-	0x50, 0xb9, 0xf7, 0xa0, 0xcc, 0x8f, 0xb7, 0x3a, 0x2e, 0xe5, 0x54, 0x9d, 0x42, 0x04, 0x6d, 0xf5,
-	0x1e, 0xe8, 0x8b, 0x26, 0x65, 0x6d, 0xca, 0xca, 0x6d, 0x66, 0x79, 0xf6, 0x36, 0xb3, 0x7c, 0x07,
-	0x7d, 0xc9, 0x37, 0x34, 0xc4, 0x57, 0xd9, 0xff, 0x90, 0xa6, 0x9c, 0x0c, 0x66, 0x61, 0x07, 0x33,
-	0xd2, 0x47, 0x55, 0x89, 0x32, 0x6e, 0x70, 0x3c, 0x84, 0xf1, 0x93, 0x0e, 0x0e, 0x56, 0x5b, 0xd4,
-	0xa2, 0x7e, 0x54, 0xef, 0x2f, 0x1f, 0x2d, 0x7e, 0xad, 0x40, 0xb6, 0xc6, 0xac, 0xc7, 0x1d, 0x64,
-	0x70, 0x7c, 0x60, 0xb8, 0x46, 0x9b, 0xa9, 0xef, 0x43, 0xc6, 0xe8, 0xf2, 0x16, 0x75, 0x09, 0x3f,
-	0xd1, 0x94, 0x82, 0x52, 0xca, 0xec, 0x6a, 0xbf, 0xfd, 0xbc, 0x99, 0x93, 0x64, 0x76, 0x10, 0x72,
-	0x31, 0x63, 0x8f, 0xb8, 0x4b, 0x1c, 0xab, 0x3e, 0x70, 0x55, 0xef, 0xc3, 0x54, 0x47, 0x44, 0xd0,
-	0x52, 0x05, 0xa5, 0x34, 0xf3, 0x70, 0x7e, 0xcb, 0x2f, 0x76, 0xcb, 0x8f, 0xbb, 0x3b, 0xf9, 0xe2,
-	0xcf, 0x3b, 0x13, 0x75, 0xe9, 0xb3, 0x3d, 0xff, 0xd5, 0xab, 0xd3, 0x8d, 0xc1, 0xea, 0xe2, 0x12,
-	0x2c, 0x0e, 0x11, 0xa9, 0x63, 0xd6, 0xa1, 0x0e, 0xc3, 0xc5, 0xef, 0x15, 0x98, 0xad, 0x31, 0x6b,
-	0xcf, 0xc5, 0x06, 0xc7, 0x95, 0x6a, 0x45, 0xfd, 0x00, 0xc0, 0xa4, 0x0e, 0x77, 0xa9, 0x6d, 0x63,
-	0xf7, 0x42, 0x8a, 0x21, 0x5f, 0xf5, 0x43, 0x98, 0x45, 0x04, 0x35, 0x10, 0x35, 0xbb, 0x6d, 0xec,
-	0x70, 0xc9, 0xf4, 0x66, 0x9f, 0x69, 0xa5, 0x5a, 0xa9, 0x48, 0x93, 0xa4, 0x3b, 0x83, 0x08, 0xea,
-	0x43, 0xdb, 0x59, 0x8f, 0x73, 0x28, 0x5c, 0xf1, 0x1b, 0x05, 0x72, 0x61, 0x66, 0x7d, 0xca, 0xea,
-	0x02, 0xa4, 0x11, 0x41, 0x3e, 0xb5, 0xba, 0xf7, 0xa7, 0xba, 0x04, 0xd3, 0x3d, 0xa3, 0x6b, 0xf3,
-	0x06, 0x41, 0x22, 0x6b, 0xa6, 0x7e, 0x5d, 0x7c, 0x57, 0x91, 0x5a, 0x82, 0x05, 0xdf, 0xd4, 0xe9,
-	0x36, 0x6d, 0x62, 0x36, 0x0e, 0xf1, 0x89, 0x96, 0x2e, 0x28, 0xa5, 0xd9, 0xfa, 0xbc, 0xc0, 0x0f,
-	0x04, 0xbc, 0x8f, 0x4f, 0xd4, 0x15, 0x00, 0xec, 0x98, 0xb6, 0xd1, 0xc3, 0x5e, 0x98, 0x49, 0x11,
-	0x26, 0x23, 0x91, 0x2a, 0x2a, 0xfe, 0xe4, 0x0b, 0xe5, 0x8b, 0xf8, 0x7a, 0x42, 0xc9, 0x02, 0x52,
-	0x83, 0x02, 0x86, 0xa5, 0x4b, 0xbf, 0x9e, 0x74, 0xb7, 0x85, 0x72, 0x01, 0xd5, 0xa0, 0xd9, 0x14,
-	0x16, 0x6a, 0xcc, 0xaa, 0x60, 0xc3, 0xe4, 0xa4, 0x77, 0xf5, 0x65, 0xc4, 0x89, 0xe8, 0xa0, 0x0d,
-	0x27, 0x0c, 0xc8, 0xfc, 0xa3, 0x08, 0xe3, 0x0e, 0x42, 0x4f, 0xb0, 0x4b, 0x9e, 0x13, 0xd3, 0xe0,
-	0x84, 0x3a, 0x35, 0xcc, 0x5b, 0x14, 0x5d, 0xa9, 0xb8, 0x9f, 0xc3, 0xcd, 0x5e, 0x28, 0x43, 0xa3,
-	0x2d, 0x52, 0x48, 0x8d, 0xf5, 0xbe, 0xc6, 0x71, 0x12, 0x52, 0x6a, 0xb5, 0x17, 0xa7, 0xb7, 0x06,
-	0x73, 0x2e, 0xb6, 0x05, 0xc2, 0x5a, 0xa4, 0xc3, 0xb4, 0xc9, 0x42, 0xba, 0x94, 0xa9, 0x47, 0xc1,
-	0xb8, 0x1c, 0x45, 0x28, 0x24, 0x55, 0x1c, 0xc8, 0x72, 0xaa, 0xc0, 0x5b, 0x35, 0x66, 0xd5, 0x71,
-	0x9b, 0xf6, 0xf0, 0x1b, 0x56, 0xe6, 0x3d, 0xb8, 0x3d, 0x42, 0x19, 0x6f, 0xfb, 0xa7, 0x85, 0x53,
-	0x2e, 0x5e, 0x7a, 0x75, 0x44, 0x97, 0xd7, 0x61, 0x75, 0x0c, 0xe3, 0xa0, 0xb2, 0x1f, 0x14, 0x98,
-	0xf3, 0xcb, 0x7f, 0x84, 0xdd, 0x1e, 0x31, 0xf1, 0x95, 0xd6, 0x52, 0x86, 0xeb, 0xcc, 0x0f, 0x2b,
-	0x3b, 0x9b, 0xed, 0x77, 0x56, 0x66, 0x93, 0xed, 0xec, 0x7b, 0xc5, 0xcb, 0x58, 0x84, 0x5b, 0x11,
-	0x7a, 0x01, 0xf1, 0x6f, 0x15, 0x71, 0x6e, 0xfc, 0x02, 0xdf, 0x04, 0xf7, 0x15, 0x00, 0xc9, 0x6a,
-	0xa0, 0x7d, 0x46, 0x22, 0xd5, 0xc4, 0x63, 0x15, 0xe1, 0x13, 0x90, 0xfd, 0x51, 0x81, 0xe5, 0x1a,
-	0xb3, 0xaa, 0x8c, 0x75, 0x65, 0x33, 0x8c, 0xa6, 0x8d, 0xf7, 0x5c, 0x8c, 0xb0, 0xc3, 0x89, 0x61,
-	0xab, 0xef, 0xc0, 0x14, 0xf1, 0x8c, 0x17, 0x93, 0x96, 0x7e, 0xea, 0x2e, 0x80, 0x19, 0xac, 0x97,
-	0xd7, 0xfa, 0x72, 0xf4, 0xdc, 0x44, 0x73, 0x48, 0xa9, 0x43, 0xab, 0xb6, 0x67, 0xbc, 0x1a, 0x64,
-	0xc0, 0xe2, 0x3e, 0xac, 0x8d, 0xa3, 0x18, 0xdc, 0xf4, 0xab, 0x30, 0x37, 0x08, 0xd1, 0x08, 0xee,
-	0xfc, 0xd9, 0x01, 0x58, 0x45, 0xde, 0x81, 0x59, 0x11, 0x6a, 0xf4, 0xe8, 0xe1, 0x55, 0x55, 0x1c,
-	0x4b, 0x9c, 0x8a, 0x27, 0x56, 0xef, 0xc1, 0x0d, 0x17, 0xf7, 0xa8, 0x3c, 0x3b, 0x2e, 0x36, 0x18,
-	0x75, 0x64, 0xf3, 0x16, 0x06, 0x86, 0xba, 0xc0, 0xa3, 0xf5, 0xdf, 0x85, 0xf5, 0xb1, 0x8c, 0x83,
-	0x66, 0xfe, 0x9d, 0x12, 0x7b, 0xf2, 0x33, 0xe2, 0x1c, 0x7e, 0x72, 0xcc, 0xb1, 0xeb, 0x18, 0xf6,
-	0x53, 0xc3, 0xb6, 0x31, 0xbf, 0xd2, 0xed, 0xb7, 0x0e, 0xf3, 0x47, 0x22, 0x6a, 0xc3, 0xf0, 0x57,
-	0xc9, 0x2a, 0xe6, 0x7c, 0x54, 0x86, 0x52, 0xdf, 0x86, 0xac, 0x74, 0x33, 0x5b, 0x06, 0x71, 0x06,
-	0xaf, 0xa4, 0xf4, 0xdb, 0xf3, 0xd0, 0x2a, 0x52, 0xef, 0xc0, 0x8c, 0xf4, 0xf3, 0x66, 0x24, 0xed,
-	0x9a, 0xf0, 0x01, 0x1f, 0xfa, 0xe2, 0xa4, 0x83, 0xd5, 0xbb, 0x90, 0xa5, 0x47, 0x0e, 0x76, 0xbd,
-	0x5b, 0xd2, 0x1b, 0xc6, 0xe8, 0x73, 0x6d, 0xca, 0x7f, 0x92, 0x03, 0xf8, 0xc0, 0x43, 0xd5, 0x65,
-	0xc8, 0x98, 0x2d, 0x6f, 0x9d, 0x63, 0x61, 0xed, 0xba, 0x70, 0x19, 0x00, 0x63, 0x6e, 0xaf, 0xe9,
-	0xff, 0x72, 0x7b, 0x3d, 0x16, 0xdb, 0x27, 0x2e, 0x71, 0xb0, 0x0b, 0x93, 0xf3, 0x28, 0xc9, 0x79,
-	0x8a, 0xbf, 0xa4, 0xe4, 0xb6, 0xb4, 0x08, 0xe3, 0xd8, 0x7d, 0x8a, 0x9b, 0x3b, 0x5d, 0xde, 0x72,
-	0x42, 0xdb, 0xf2, 0xff, 0xb7, 0x50, 0x87, 0xe9, 0x2e, 0xf3, 0xa8, 0xb6, 0xb1, 0xec, 0x63, 0xf0,
-	0xed, 0xbd, 0x76, 0x47, 0xb8, 0xe9, 0xcd, 0x7e, 0x4e, 0x23, 0x74, 0x6a, 0x87, 0x5e, 0xbb, 0x38,
-	0x9d, 0xfe, 0x6b, 0xd7, 0x5f, 0x1c, 0x22, 0x9a, 0x2c, 0xc0, 0x64, 0xb2, 0x00, 0xea, 0x06, 0xdc,
-	0x30, 0xba, 0x9c, 0x7a, 0x24, 0x0c, 0x8e, 0x1b, 0x62, 0xda, 0x12, 0x9b, 0x61, 0xba, 0x9e, 0xf5,
-	0x0c, 0xfe, 0x60, 0xf7, 0xc4, 0x83, 0xe3, 0x4d, 0xf9, 0x5d, 0x91, 0x47, 0x24, 0x49, 0xbd, 0x31,
-	0xd3, 0x60, 0x32, 0xdd, 0xd4, 0x18, 0xba, 0xe1, 0x19, 0x32, 0x7d, 0xf1, 0x0c, 0x39, 0x79, 0x89,
-	0x19, 0xf2, 0xda, 0xd0, 0x0c, 0xf9, 0xf0, 0xd7, 0x69, 0x48, 0xd7, 0x98, 0xa5, 0x7e, 0x0a, 0xb3,
-	0x91, 0xff, 0x15, 0x2c, 0xf6, 0xdb, 0x32, 0x34, 0xa5, 0xeb, 0x77, 0x12, 0x0c, 0x41, 0xf5, 0x1f,
-	0x43, 0x66, 0x30, 0xba, 0xe7, 0x42, 0xde, 0x01, 0xaa, 0x2f, 0x8f, 0x42, 0xc3, 0x01, 0x06, 0x23,
-	0x6d, 0x2e, 0x96, 0x6e, 0x38, 0x40, 0x6c, 0xa6, 0x54, 0xf7, 0x61, 0x2e, 0x3a, 0x50, 0x6a, 0x21,
-	0xf7, 0x88, 0x45, 0x2f, 0x24, 0x59, 0x82, 0x60, 0x26, 0xdc, 0x1a, 0x3d, 0x0f, 0x86, 0x97, 0x8e,
-	0xf4, 0xd0, 0x4b, 0x17, 0x79, 0x04, 0x49, 0x6c, 0xd0, 0x12, 0xa7, 0xab, 0xd5, 0x50, 0x94, 0x24,
-	0x27, 0xfd, 0xde, 0x25, 0x9c, 0x82, 0x6c, 0xbb, 0x00, 0xa1, 0x89, 0xe7, 0x56, 0x94, 0xa5, 0x84,
-	0xf5, 0x95, 0x91, 0x70, 0x58, 0xe3, 0xe8, 0xf0, 0xa1, 0xc5, 0x18, 0xf4, 0x23, 0x15, 0x92, 0x2c,
-	0x41, 0x30, 0x0a, 0x4b, 0xc9, 0xc3, 0xc1, 0x5a, 0x68, 0x79, 0xa2, 0x97, 0x7e, 0xff, 0x32, 0x5e,
-	0x41, 0x42, 0x17, 0xf4, 0x31, 0x8f, 0xf3, 0x7a, 0x84, 0x70, 0x92, 0x9b, 0xbe, 0x79, 0x29, 0xb7,
-	0x20, 0xe7, 0x33, 0x50, 0x47, 0x3c, 0x9a, 0x61, 0x99, 0xe3, 0x66, 0x7d, 0x7d, 0xac, 0x39, 0x5a,
-	0x4f, 0xe2, 0xad, 0x1e, 0xad, 0x27, 0xc9, 0x6d, 0xa8, 0x9e, 0x8b, 0x6e, 0x39, 0xfd, 0xda, 0x97,
-	0xaf, 0x4e, 0x37, 0x94, 0xdd, 0x8f, 0x5e, 0x9c, 0xe5, 0x95, 0x97, 0x67, 0x79, 0xe5, 0xaf, 0xb3,
-	0xbc, 0xf2, 0xdd, 0x79, 0x7e, 0xe2, 0xe5, 0x79, 0x7e, 0xe2, 0x8f, 0xf3, 0xfc, 0xc4, 0xb3, 0x55,
-	0x8b, 0xf0, 0x56, 0xb7, 0xb9, 0x65, 0xd2, 0x76, 0x99, 0x51, 0xc7, 0xdd, 0x24, 0x54, 0xfc, 0x5b,
-	0x3e, 0x2e, 0x23, 0x82, 0xfc, 0x5f, 0x2b, 0x9a, 0x53, 0xe2, 0x87, 0x89, 0x77, 0xff, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x18, 0x4c, 0x9e, 0xae, 0x3b, 0x11, 0x00, 0x00,
+func (m *MsgRemoveDelegatedControllerResponse) Reset()         { *m = MsgRemoveDelegatedControllerResponse{} }
+func (m *MsgRemoveDelegatedControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDelegatedControllerResponse) ProtoMessage()    {}
+func (*MsgRemoveDelegatedControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{27}
+}
+func (m *MsgRemoveDelegatedControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDelegatedControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDelegatedControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDelegatedControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDelegatedControllerResponse.Merge(m, src)
+}
+func (m *MsgRemoveDelegatedControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDelegatedControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDelegatedControllerResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRemoveDelegatedControllerResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgAttachNFT shows an x/nft token on a DID's profile. The signing
+// controller must own the token.
+type MsgAttachNFT struct {
+	// controller is the address that controls the DID and owns the token
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID whose profile shows the token
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// class_id is the token's x/nft class
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the token's ID within its class
+	NftId string `protobuf:"bytes,4,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateDID creates a new DID document
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_tx_docs.md"}}
-	CreateDID(ctx context.Context, in *MsgCreateDID, opts ...grpc.CallOption) (*MsgCreateDIDResponse, error)
-	// UpdateDID updates an existing DID document
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_tx_docs.md"}}
-	UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error)
-	// DeactivateDID deactivates a DID document
-	DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error)
-	// AddVerificationMethod adds a new verification method to a DID document
-	AddVerificationMethod(ctx context.Context, in *MsgAddVerificationMethod, opts ...grpc.CallOption) (*MsgAddVerificationMethodResponse, error)
-	// RemoveVerificationMethod removes a verification method from a DID document
-	RemoveVerificationMethod(ctx context.Context, in *MsgRemoveVerificationMethod, opts ...grpc.CallOption) (*MsgRemoveVerificationMethodResponse, error)
-	// AddService adds a new service endpoint to a DID document
-	AddService(ctx context.Context, in *MsgAddService, opts ...grpc.CallOption) (*MsgAddServiceResponse, error)
-	// RemoveService removes a service endpoint from a DID document
-	RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error)
-	// IssueVerifiableCredential issues a new verifiable credential
-	IssueVerifiableCredential(ctx context.Context, in *MsgIssueVerifiableCredential, opts ...grpc.CallOption) (*MsgIssueVerifiableCredentialResponse, error)
-	// RevokeVerifiableCredential revokes a verifiable credential
-	RevokeVerifiableCredential(ctx context.Context, in *MsgRevokeVerifiableCredential, opts ...grpc.CallOption) (*MsgRevokeVerifiableCredentialResponse, error)
-	// LinkExternalWallet links an external wallet as an assertion method
-	LinkExternalWallet(ctx context.Context, in *MsgLinkExternalWallet, opts ...grpc.CallOption) (*MsgLinkExternalWalletResponse, error)
-	// RegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_tx_docs.md"}}
-	RegisterWebAuthnCredential(ctx context.Context, in *MsgRegisterWebAuthnCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthnCredentialResponse, error)
+func (m *MsgAttachNFT) Reset()         { *m = MsgAttachNFT{} }
+func (m *MsgAttachNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgAttachNFT) ProtoMessage()    {}
+func (*MsgAttachNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{28}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAttachNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAttachNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttachNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttachNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttachNFT.Merge(m, src)
+}
+func (m *MsgAttachNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttachNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttachNFT.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAttachNFT proto.InternalMessageInfo
+
+func (m *MsgAttachNFT) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateDID(ctx context.Context, in *MsgCreateDID, opts ...grpc.CallOption) (*MsgCreateDIDResponse, error) {
-	out := new(MsgCreateDIDResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/CreateDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttachNFT) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error) {
-	out := new(MsgUpdateDIDResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/UpdateDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttachNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error) {
-	out := new(MsgDeactivateDIDResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/DeactivateDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttachNFT) GetNftId() string {
+	if m != nil {
+		return m.NftId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddVerificationMethod(ctx context.Context, in *MsgAddVerificationMethod, opts ...grpc.CallOption) (*MsgAddVerificationMethodResponse, error) {
-	out := new(MsgAddVerificationMethodResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/AddVerificationMethod", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAttachNFTResponse defines the response for MsgAttachNFT
+type MsgAttachNFTResponse struct {
+	// dwn_record_id is the DWN record caching the token's metadata, empty when
+	// it could not be cached
+	DwnRecordId string `protobuf:"bytes,1,opt,name=dwn_record_id,json=dwnRecordId,proto3" json:"dwn_record_id,omitempty"`
 }
 
-func (c *msgClient) RemoveVerificationMethod(ctx context.Context, in *MsgRemoveVerificationMethod, opts ...grpc.CallOption) (*MsgRemoveVerificationMethodResponse, error) {
-	out := new(MsgRemoveVerificationMethodResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/RemoveVerificationMethod", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttachNFTResponse) Reset()         { *m = MsgAttachNFTResponse{} }
+func (m *MsgAttachNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttachNFTResponse) ProtoMessage()    {}
+func (*MsgAttachNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{29}
+}
+func (m *MsgAttachNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttachNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttachNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAttachNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttachNFTResponse.Merge(m, src)
+}
+func (m *MsgAttachNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttachNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttachNFTResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddService(ctx context.Context, in *MsgAddService, opts ...grpc.CallOption) (*MsgAddServiceResponse, error) {
-	out := new(MsgAddServiceResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/AddService", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAttachNFTResponse proto.InternalMessageInfo
+
+func (m *MsgAttachNFTResponse) GetDwnRecordId() string {
+	if m != nil {
+		return m.DwnRecordId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error) {
-	out := new(MsgRemoveServiceResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/RemoveService", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDetachNFT removes a token from a DID's profile
+type MsgDetachNFT struct {
+	// controller is the address that controls the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// did is the DID whose profile shows the token
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// class_id is the token's x/nft class
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the token's ID within its class
+	NftId string `protobuf:"bytes,4,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (c *msgClient) IssueVerifiableCredential(ctx context.Context, in *MsgIssueVerifiableCredential, opts ...grpc.CallOption) (*MsgIssueVerifiableCredentialResponse, error) {
-	out := new(MsgIssueVerifiableCredentialResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/IssueVerifiableCredential", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetachNFT) Reset()         { *m = MsgDetachNFT{} }
+func (m *MsgDetachNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgDetachNFT) ProtoMessage()    {}
+func (*MsgDetachNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{30}
+}
+func (m *MsgDetachNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDetachNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDetachNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDetachNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDetachNFT.Merge(m, src)
+}
+func (m *MsgDetachNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDetachNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDetachNFT.DiscardUnknown(m)
 }
 
-func (c *msgClient) RevokeVerifiableCredential(ctx context.Context, in *MsgRevokeVerifiableCredential, opts ...grpc.CallOption) (*MsgRevokeVerifiableCredentialResponse, error) {
-	out := new(MsgRevokeVerifiableCredentialResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/RevokeVerifiableCredential", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDetachNFT proto.InternalMessageInfo
+
+func (m *MsgDetachNFT) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LinkExternalWallet(ctx context.Context, in *MsgLinkExternalWallet, opts ...grpc.CallOption) (*MsgLinkExternalWalletResponse, error) {
-	out := new(MsgLinkExternalWalletResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/LinkExternalWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetachNFT) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterWebAuthnCredential(ctx context.Context, in *MsgRegisterWebAuthnCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthnCredentialResponse, error) {
-	out := new(MsgRegisterWebAuthnCredentialResponse)
-	err := c.cc.Invoke(ctx, "/did.v1.Msg/RegisterWebAuthnCredential", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetachNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateDID creates a new DID document
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_tx_docs.md"}}
-	CreateDID(context.Context, *MsgCreateDID) (*MsgCreateDIDResponse, error)
-	// UpdateDID updates an existing DID document
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_tx_docs.md"}}
-	UpdateDID(context.Context, *MsgUpdateDID) (*MsgUpdateDIDResponse, error)
-	// DeactivateDID deactivates a DID document
-	DeactivateDID(context.Context, *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error)
-	// AddVerificationMethod adds a new verification method to a DID document
-	AddVerificationMethod(context.Context, *MsgAddVerificationMethod) (*MsgAddVerificationMethodResponse, error)
-	// RemoveVerificationMethod removes a verification method from a DID document
-	RemoveVerificationMethod(context.Context, *MsgRemoveVerificationMethod) (*MsgRemoveVerificationMethodResponse, error)
-	// AddService adds a new service endpoint to a DID document
-	AddService(context.Context, *MsgAddService) (*MsgAddServiceResponse, error)
-	// RemoveService removes a service endpoint from a DID document
-	RemoveService(context.Context, *MsgRemoveService) (*MsgRemoveServiceResponse, error)
-	// IssueVerifiableCredential issues a new verifiable credential
-	IssueVerifiableCredential(context.Context, *MsgIssueVerifiableCredential) (*MsgIssueVerifiableCredentialResponse, error)
-	// RevokeVerifiableCredential revokes a verifiable credential
-	RevokeVerifiableCredential(context.Context, *MsgRevokeVerifiableCredential) (*MsgRevokeVerifiableCredentialResponse, error)
-	// LinkExternalWallet links an external wallet as an assertion method
-	LinkExternalWallet(context.Context, *MsgLinkExternalWallet) (*MsgLinkExternalWalletResponse, error)
-	// RegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "did_tx_docs.md"}}
-	RegisterWebAuthnCredential(context.Context, *MsgRegisterWebAuthnCredential) (*MsgRegisterWebAuthnCredentialResponse, error)
+func (m *MsgDetachNFT) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgDetachNFTResponse defines the response for MsgDetachNFT
+type MsgDetachNFTResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgDetachNFTResponse) Reset()         { *m = MsgDetachNFTResponse{} }
+func (m *MsgDetachNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDetachNFTResponse) ProtoMessage()    {}
+func (*MsgDetachNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{31}
 }
-func (*UnimplementedMsgServer) CreateDID(ctx context.Context, req *MsgCreateDID) (*MsgCreateDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDID not implemented")
+func (m *MsgDetachNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateDID(ctx context.Context, req *MsgUpdateDID) (*MsgUpdateDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDID not implemented")
+func (m *MsgDetachNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDetachNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeactivateDID(ctx context.Context, req *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDID not implemented")
+func (m *MsgDetachNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDetachNFTResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddVerificationMethod(ctx context.Context, req *MsgAddVerificationMethod) (*MsgAddVerificationMethodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVerificationMethod not implemented")
+func (m *MsgDetachNFTResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveVerificationMethod(ctx context.Context, req *MsgRemoveVerificationMethod) (*MsgRemoveVerificationMethodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveVerificationMethod not implemented")
+func (m *MsgDetachNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDetachNFTResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddService(ctx context.Context, req *MsgAddService) (*MsgAddServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
+
+var xxx_messageInfo_MsgDetachNFTResponse proto.InternalMessageInfo
+
+// MsgRegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
+type MsgRegisterWebAuthnCredential struct {
+	// controller is the address that will control the created DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// username is the human-readable identifier for the DID
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// webauthn_credential contains the WebAuthn credential data
+	WebauthnCredential WebAuthnCredential `protobuf:"bytes,3,opt,name=webauthn_credential,json=webauthnCredential,proto3" json:"webauthn_credential"`
+	// verification_method_id is the ID for the WebAuthn verification method
+	VerificationMethodId string `protobuf:"bytes,4,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+	// auto_create_vault indicates whether to automatically create a vault
+	AutoCreateVault bool `protobuf:"varint,5,opt,name=auto_create_vault,json=autoCreateVault,proto3" json:"auto_create_vault,omitempty"`
+	// referral_code is the optional invite code the DID was referred with
+	ReferralCode string `protobuf:"bytes,6,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
 }
-func (*UnimplementedMsgServer) RemoveService(ctx context.Context, req *MsgRemoveService) (*MsgRemoveServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+
+func (m *MsgRegisterWebAuthnCredential) Reset()         { *m = MsgRegisterWebAuthnCredential{} }
+func (m *MsgRegisterWebAuthnCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterWebAuthnCredential) ProtoMessage()    {}
+func (*MsgRegisterWebAuthnCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{32}
 }
-func (*UnimplementedMsgServer) IssueVerifiableCredential(ctx context.Context, req *MsgIssueVerifiableCredential) (*MsgIssueVerifiableCredentialResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssueVerifiableCredential not implemented")
+func (m *MsgRegisterWebAuthnCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RevokeVerifiableCredential(ctx context.Context, req *MsgRevokeVerifiableCredential) (*MsgRevokeVerifiableCredentialResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeVerifiableCredential not implemented")
+func (m *MsgRegisterWebAuthnCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterWebAuthnCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) LinkExternalWallet(ctx context.Context, req *MsgLinkExternalWallet) (*MsgLinkExternalWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalWallet not implemented")
+func (m *MsgRegisterWebAuthnCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterWebAuthnCredential.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RegisterWebAuthnCredential(ctx context.Context, req *MsgRegisterWebAuthnCredential) (*MsgRegisterWebAuthnCredentialResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebAuthnCredential not implemented")
+func (m *MsgRegisterWebAuthnCredential) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRegisterWebAuthnCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterWebAuthnCredential.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgRegisterWebAuthnCredential proto.InternalMessageInfo
+
+func (m *MsgRegisterWebAuthnCredential) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/CreateDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDID(ctx, req.(*MsgCreateDID))
+func (m *MsgRegisterWebAuthnCredential) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/UpdateDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDID(ctx, req.(*MsgUpdateDID))
+func (m *MsgRegisterWebAuthnCredential) GetWebauthnCredential() WebAuthnCredential {
+	if m != nil {
+		return m.WebauthnCredential
 	}
-	return interceptor(ctx, in, info, handler)
+	return WebAuthnCredential{}
 }
 
-func _Msg_DeactivateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeactivateDID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeactivateDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/DeactivateDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeactivateDID(ctx, req.(*MsgDeactivateDID))
+func (m *MsgRegisterWebAuthnCredential) GetVerificationMethodId() string {
+	if m != nil {
+		return m.VerificationMethodId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddVerificationMethod)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddVerificationMethod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/AddVerificationMethod",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddVerificationMethod(ctx, req.(*MsgAddVerificationMethod))
+func (m *MsgRegisterWebAuthnCredential) GetAutoCreateVault() bool {
+	if m != nil {
+		return m.AutoCreateVault
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_RemoveVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveVerificationMethod)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveVerificationMethod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/RemoveVerificationMethod",
+func (m *MsgRegisterWebAuthnCredential) GetReferralCode() string {
+	if m != nil {
+		return m.ReferralCode
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveVerificationMethod(ctx, req.(*MsgRemoveVerificationMethod))
+	return ""
+}
+
+// MsgRegisterWebAuthnCredentialResponse defines the response for MsgRegisterWebAuthnCredential
+type MsgRegisterWebAuthnCredentialResponse struct {
+	// did is the created DID identifier
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// verification_method_id is the ID of the created verification method
+	VerificationMethodId string `protobuf:"bytes,2,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
+	// vault_id is the ID of the auto-created vault (if requested)
+	VaultId string `protobuf:"bytes,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	// vault_public_key is the public key of the auto-created vault (if requested)
+	VaultPublicKey []byte `protobuf:"bytes,4,opt,name=vault_public_key,json=vaultPublicKey,proto3" json:"vault_public_key,omitempty"`
+	// enclave_id is the enclave ID of the auto-created vault (if requested)
+	EnclaveId string `protobuf:"bytes,5,opt,name=enclave_id,json=enclaveId,proto3" json:"enclave_id,omitempty"`
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) Reset()         { *m = MsgRegisterWebAuthnCredentialResponse{} }
+func (m *MsgRegisterWebAuthnCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterWebAuthnCredentialResponse) ProtoMessage()    {}
+func (*MsgRegisterWebAuthnCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d73284df019ff211, []int{33}
+}
+func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse.Merge(m, src)
+}
+func (m *MsgRegisterWebAuthnCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterWebAuthnCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddService)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterWebAuthnCredentialResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterWebAuthnCredentialResponse) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddService(ctx, in)
+	return ""
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) GetVerificationMethodId() string {
+	if m != nil {
+		return m.VerificationMethodId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/AddService",
+	return ""
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) GetVaultId() string {
+	if m != nil {
+		return m.VaultId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddService(ctx, req.(*MsgAddService))
+	return ""
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) GetVaultPublicKey() []byte {
+	if m != nil {
+		return m.VaultPublicKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveService)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRegisterWebAuthnCredentialResponse) GetEnclaveId() string {
+	if m != nil {
+		return m.EnclaveId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/RemoveService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveService(ctx, req.(*MsgRemoveService))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_IssueVerifiableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIssueVerifiableCredential)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "did.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "did.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateDID)(nil), "did.v1.MsgCreateDID")
+	proto.RegisterType((*MsgCreateDIDResponse)(nil), "did.v1.MsgCreateDIDResponse")
+	proto.RegisterType((*MsgUpdateDID)(nil), "did.v1.MsgUpdateDID")
+	proto.RegisterType((*MsgUpdateDIDResponse)(nil), "did.v1.MsgUpdateDIDResponse")
+	proto.RegisterType((*MsgDeactivateDID)(nil), "did.v1.MsgDeactivateDID")
+	proto.RegisterType((*MsgDeactivateDIDResponse)(nil), "did.v1.MsgDeactivateDIDResponse")
+	proto.RegisterType((*MsgReactivateDID)(nil), "did.v1.MsgReactivateDID")
+	proto.RegisterType((*MsgReactivateDIDResponse)(nil), "did.v1.MsgReactivateDIDResponse")
+	proto.RegisterType((*MsgAddVerificationMethod)(nil), "did.v1.MsgAddVerificationMethod")
+	proto.RegisterType((*MsgAddVerificationMethodResponse)(nil), "did.v1.MsgAddVerificationMethodResponse")
+	proto.RegisterType((*MsgRemoveVerificationMethod)(nil), "did.v1.MsgRemoveVerificationMethod")
+	proto.RegisterType((*MsgRemoveVerificationMethodResponse)(nil), "did.v1.MsgRemoveVerificationMethodResponse")
+	proto.RegisterType((*MsgAddService)(nil), "did.v1.MsgAddService")
+	proto.RegisterType((*MsgAddServiceResponse)(nil), "did.v1.MsgAddServiceResponse")
+	proto.RegisterType((*MsgRemoveService)(nil), "did.v1.MsgRemoveService")
+	proto.RegisterType((*MsgRemoveServiceResponse)(nil), "did.v1.MsgRemoveServiceResponse")
+	proto.RegisterType((*MsgIssueVerifiableCredential)(nil), "did.v1.MsgIssueVerifiableCredential")
+	proto.RegisterType((*MsgIssueVerifiableCredentialResponse)(nil), "did.v1.MsgIssueVerifiableCredentialResponse")
+	proto.RegisterType((*MsgRevokeVerifiableCredential)(nil), "did.v1.MsgRevokeVerifiableCredential")
+	proto.RegisterType((*MsgRevokeVerifiableCredentialResponse)(nil), "did.v1.MsgRevokeVerifiableCredentialResponse")
+	proto.RegisterType((*MsgLinkExternalWallet)(nil), "did.v1.MsgLinkExternalWallet")
+	proto.RegisterType((*MsgLinkExternalWalletResponse)(nil), "did.v1.MsgLinkExternalWalletResponse")
+	proto.RegisterType((*MsgAddDelegatedController)(nil), "did.v1.MsgAddDelegatedController")
+	proto.RegisterType((*MsgAddDelegatedControllerResponse)(nil), "did.v1.MsgAddDelegatedControllerResponse")
+	proto.RegisterType((*MsgRemoveDelegatedController)(nil), "did.v1.MsgRemoveDelegatedController")
+	proto.RegisterType((*MsgRemoveDelegatedControllerResponse)(nil), "did.v1.MsgRemoveDelegatedControllerResponse")
+	proto.RegisterType((*MsgAttachNFT)(nil), "did.v1.MsgAttachNFT")
+	proto.RegisterType((*MsgAttachNFTResponse)(nil), "did.v1.MsgAttachNFTResponse")
+	proto.RegisterType((*MsgDetachNFT)(nil), "did.v1.MsgDetachNFT")
+	proto.RegisterType((*MsgDetachNFTResponse)(nil), "did.v1.MsgDetachNFTResponse")
+	proto.RegisterType((*MsgRegisterWebAuthnCredential)(nil), "did.v1.MsgRegisterWebAuthnCredential")
+	proto.RegisterType((*MsgRegisterWebAuthnCredentialResponse)(nil), "did.v1.MsgRegisterWebAuthnCredentialResponse")
+}
+
+func init() { proto.RegisterFile("did/v1/tx.proto", fileDescriptor_d73284df019ff211) }
+
+var fileDescriptor_d73284df019ff211 = []byte{
+	// 1524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x2d, 0x7f, 0x69, 0xfc, 0x19, 0x46, 0x8e, 0x69, 0xbe, 0xb6, 0xe3, 0xc8, 0x76, 0xe2,
+	0x37, 0x89, 0xad, 0x26, 0x2d, 0x8a, 0xc2, 0x68, 0x51, 0xd8, 0x56, 0x8b, 0x0a, 0xa9, 0x8b, 0x94,
+	0xf9, 0x02, 0x72, 0x11, 0x68, 0xee, 0x9a, 0x22, 0x42, 0x71, 0x05, 0xee, 0x4a, 0xb6, 0x6f, 0x45,
+	0x2f, 0xbd, 0x14, 0x45, 0xff, 0x40, 0x0f, 0x3d, 0xf6, 0x96, 0x02, 0x3d, 0xf6, 0x07, 0xe4, 0x18,
+	0xb4, 0x97, 0x9e, 0x8a, 0x34, 0x01, 0x9a, 0x6b, 0x7f, 0x42, 0x41, 0x72, 0x49, 0x2e, 0x45, 0x52,
+	0x56, 0x5a, 0xa5, 0xc8, 0x49, 0xe2, 0x33, 0xb3, 0x33, 0xcf, 0xcc, 0x2c, 0x77, 0x67, 0x08, 0xb3,
+	0xc8, 0x42, 0x95, 0xce, 0x8d, 0x0a, 0x3b, 0xd9, 0x6e, 0xb9, 0x84, 0x11, 0x79, 0x0c, 0x59, 0x68,
+	0xbb, 0x73, 0x43, 0x5d, 0x30, 0x08, 0x6d, 0x12, 0x5a, 0x69, 0x52, 0xd3, 0x93, 0x37, 0xa9, 0x19,
+	0x28, 0xa8, 0x8b, 0x81, 0xa0, 0xee, 0x3f, 0x55, 0x82, 0x07, 0x2e, 0x2a, 0x71, 0x63, 0x26, 0x76,
+	0x30, 0xb5, 0x42, 0x54, 0xe6, 0x28, 0x65, 0x3a, 0xc3, 0x5d, 0x18, 0x3b, 0x6d, 0xe1, 0x68, 0xb5,
+	0x49, 0x4c, 0x12, 0x58, 0xf5, 0xfe, 0x05, 0x68, 0xf9, 0x2b, 0x09, 0x66, 0x0f, 0xa8, 0x79, 0xaf,
+	0x85, 0x74, 0x86, 0x6f, 0xeb, 0xae, 0xde, 0xa4, 0xf2, 0xbb, 0x50, 0xd4, 0xdb, 0xac, 0x41, 0x5c,
+	0x8b, 0x9d, 0x2a, 0xd2, 0xaa, 0xb4, 0x59, 0xdc, 0x53, 0x7e, 0xf9, 0x69, 0xab, 0xc4, 0xc9, 0xec,
+	0x22, 0xe4, 0x62, 0x4a, 0xef, 0x30, 0xd7, 0x72, 0x4c, 0x2d, 0x56, 0x95, 0xaf, 0xc3, 0x58, 0xcb,
+	0xb7, 0xa0, 0x0c, 0xaf, 0x4a, 0x9b, 0x93, 0x37, 0x67, 0xb6, 0x83, 0x60, 0xb7, 0x03, 0xbb, 0x7b,
+	0x23, 0x4f, 0x7e, 0xbf, 0x38, 0xa4, 0x71, 0x9d, 0x9d, 0x99, 0x2f, 0x5f, 0x3e, 0xbe, 0x1a, 0xaf,
+	0x2e, 0x2f, 0xc2, 0x42, 0x17, 0x11, 0x0d, 0xd3, 0x16, 0x71, 0x28, 0x2e, 0xff, 0x2c, 0xc1, 0xd4,
+	0x01, 0x35, 0xf7, 0x5d, 0xac, 0x33, 0x5c, 0xad, 0x55, 0xe5, 0xf7, 0x00, 0x0c, 0xe2, 0x30, 0x97,
+	0xd8, 0x36, 0x76, 0xcf, 0xa4, 0x28, 0xe8, 0xca, 0xef, 0xc3, 0x14, 0xb2, 0x50, 0x1d, 0x11, 0xa3,
+	0xdd, 0xc4, 0x0e, 0xe3, 0x4c, 0xcf, 0x87, 0x4c, 0xab, 0xb5, 0x6a, 0x95, 0x8b, 0x38, 0xdd, 0x49,
+	0x64, 0xa1, 0x10, 0x92, 0xd7, 0x60, 0xda, 0xc5, 0x47, 0xd8, 0x75, 0x75, 0xbb, 0x6e, 0x10, 0x84,
+	0x95, 0x82, 0xe7, 0x5a, 0x9b, 0x0a, 0xc1, 0x7d, 0x82, 0xf0, 0xce, 0xac, 0x17, 0x98, 0xe0, 0xb3,
+	0xfc, 0xb5, 0x04, 0x25, 0x91, 0x7e, 0x18, 0x97, 0x3c, 0x07, 0x05, 0x64, 0xa1, 0x80, 0xbf, 0xe6,
+	0xfd, 0x95, 0x17, 0x61, 0xa2, 0xa3, 0xb7, 0x6d, 0x56, 0xb7, 0x90, 0x4f, 0xad, 0xa8, 0x8d, 0xfb,
+	0xcf, 0x35, 0x24, 0x6f, 0xc2, 0x5c, 0x20, 0x6a, 0xb5, 0x0f, 0x6d, 0xcb, 0xa8, 0x3f, 0xc2, 0xa7,
+	0xbe, 0xfb, 0x29, 0x6d, 0xc6, 0xc7, 0x6f, 0xfb, 0xf0, 0x2d, 0x7c, 0x2a, 0x2f, 0x03, 0x60, 0xc7,
+	0xb0, 0xf5, 0x0e, 0xf6, 0xcc, 0x8c, 0xf8, 0x66, 0x8a, 0x1c, 0xa9, 0xa1, 0xf2, 0x0f, 0x41, 0x36,
+	0x83, 0x4c, 0xff, 0xbb, 0x6c, 0xf2, 0x00, 0x86, 0xe3, 0x00, 0xba, 0xf3, 0x5b, 0x78, 0x95, 0xfc,
+	0xa6, 0x53, 0x77, 0x01, 0x4a, 0x22, 0xd5, 0x68, 0x47, 0x10, 0x98, 0x3b, 0xa0, 0x66, 0x15, 0xeb,
+	0x06, 0xb3, 0x3a, 0x83, 0x0f, 0x23, 0x4d, 0x44, 0x05, 0xa5, 0xdb, 0x61, 0x17, 0x19, 0xed, 0xbf,
+	0x26, 0xa3, 0x65, 0x92, 0xf9, 0x4b, 0xf2, 0x85, 0xbb, 0x08, 0xdd, 0xc7, 0xae, 0x75, 0x64, 0x19,
+	0x3a, 0xb3, 0x88, 0x73, 0x80, 0x59, 0x83, 0xa0, 0x81, 0x56, 0xfa, 0x73, 0x38, 0xdf, 0x11, 0x3c,
+	0xd4, 0x9b, 0xbe, 0x0b, 0x5e, 0x70, 0x35, 0x2c, 0x78, 0x9a, 0x04, 0xaf, 0xbb, 0xdc, 0x49, 0xd3,
+	0x5b, 0xf7, 0x5e, 0x2f, 0xdb, 0x47, 0x68, 0xc3, 0x6a, 0x51, 0x65, 0x64, 0xb5, 0xb0, 0x59, 0xd4,
+	0x92, 0x60, 0x3a, 0x1d, 0x65, 0x58, 0xcd, 0x8b, 0x38, 0x4a, 0xcb, 0x63, 0x09, 0xfe, 0xe7, 0xe7,
+	0xac, 0x49, 0x3a, 0xf8, 0x35, 0x67, 0xe6, 0x1d, 0xb8, 0x90, 0x91, 0x99, 0xba, 0x15, 0x24, 0xa7,
+	0xa8, 0x95, 0xd2, 0xa1, 0xd7, 0x32, 0xaa, 0xbc, 0x01, 0x6b, 0x3d, 0x18, 0x47, 0x91, 0x7d, 0x27,
+	0xc1, 0x74, 0x10, 0xfe, 0x1d, 0xec, 0x76, 0x2c, 0x03, 0x0f, 0x34, 0x96, 0x0a, 0x8c, 0xd3, 0xc0,
+	0x2c, 0xaf, 0xec, 0x6c, 0x58, 0x59, 0xee, 0x8d, 0x97, 0x33, 0xd4, 0x4a, 0x87, 0xb1, 0x00, 0xf3,
+	0x09, 0x7a, 0x11, 0xf1, 0x6f, 0x24, 0x98, 0x8b, 0x02, 0x7c, 0x1d, 0xdc, 0x97, 0x01, 0x38, 0xab,
+	0x38, 0xf7, 0x45, 0x8e, 0xd4, 0x7a, 0xbc, 0x56, 0x02, 0x9f, 0x88, 0xec, 0xf7, 0x12, 0x2c, 0x1d,
+	0x50, 0xb3, 0x46, 0x69, 0x9b, 0x17, 0x43, 0x3f, 0xb4, 0xf1, 0xbe, 0x8b, 0x11, 0x76, 0x98, 0xa5,
+	0xdb, 0xf2, 0x5b, 0x30, 0x66, 0x79, 0xc2, 0xb3, 0x49, 0x73, 0x3d, 0x79, 0x0f, 0xc0, 0x88, 0xd6,
+	0xf3, 0x8b, 0x68, 0x29, 0xf9, 0xde, 0x24, 0x7d, 0xf0, 0x54, 0x0b, 0xab, 0x76, 0x26, 0xbd, 0x18,
+	0xb8, 0xc1, 0xf2, 0x2d, 0x58, 0xef, 0x45, 0x31, 0xba, 0x76, 0xd6, 0x60, 0x3a, 0x36, 0x51, 0x8f,
+	0x2e, 0xa0, 0xa9, 0x18, 0xac, 0x21, 0xef, 0x85, 0x59, 0xf6, 0xb3, 0xd1, 0x21, 0x8f, 0x06, 0x15,
+	0x71, 0xca, 0xf1, 0x70, 0xda, 0xb1, 0x7c, 0x0d, 0xce, 0xb9, 0xb8, 0x43, 0xf8, 0xbb, 0xe3, 0x62,
+	0x9d, 0x12, 0x87, 0x17, 0x6f, 0x2e, 0x16, 0x68, 0x3e, 0x9e, 0x8c, 0xff, 0x0a, 0x6c, 0xf4, 0x64,
+	0x1c, 0x15, 0xf3, 0x8f, 0x61, 0x7f, 0x4f, 0x7e, 0x6a, 0x39, 0x8f, 0x3e, 0x3a, 0x61, 0xd8, 0x75,
+	0x74, 0xfb, 0x81, 0x6e, 0xdb, 0x98, 0x0d, 0x74, 0xfb, 0x6d, 0xc0, 0xcc, 0xb1, 0x6f, 0xb5, 0xae,
+	0x07, 0xab, 0x78, 0x14, 0xd3, 0x01, 0xca, 0x4d, 0xc9, 0x97, 0x61, 0x96, 0xab, 0x19, 0x0d, 0xdd,
+	0x72, 0xe2, 0x2b, 0x9b, 0xeb, 0xed, 0x7b, 0x68, 0x0d, 0xc9, 0x17, 0x61, 0x92, 0xeb, 0x79, 0x5d,
+	0x9d, 0x32, 0xea, 0xeb, 0x40, 0x00, 0xdd, 0x3d, 0x6d, 0x61, 0xf9, 0x0a, 0xcc, 0x92, 0x63, 0x07,
+	0xbb, 0xde, 0x29, 0xe9, 0xb5, 0x8f, 0xe4, 0x48, 0x19, 0x0b, 0xfa, 0x83, 0x08, 0xbe, 0xed, 0xa1,
+	0xf2, 0x12, 0x14, 0x8d, 0x86, 0xb7, 0xce, 0x31, 0xb1, 0x32, 0xee, 0xab, 0xc4, 0x40, 0x8f, 0xd3,
+	0x6b, 0xe2, 0x55, 0x4e, 0xaf, 0x7b, 0xb0, 0x9c, 0x99, 0xe2, 0x68, 0x17, 0xe6, 0xfb, 0x91, 0xf2,
+	0xfd, 0x94, 0x5f, 0x4a, 0xb0, 0x18, 0x1c, 0x27, 0x55, 0x6c, 0x63, 0x53, 0x67, 0x18, 0xed, 0xc7,
+	0x45, 0x18, 0xec, 0x29, 0x3e, 0x81, 0xb8, 0x0b, 0xa5, 0x70, 0x86, 0xa5, 0x48, 0xd3, 0xef, 0xbd,
+	0x4e, 0x5a, 0x96, 0x8b, 0x69, 0x5d, 0x67, 0x7e, 0x21, 0x0b, 0x5a, 0x91, 0x23, 0xbb, 0x4c, 0x56,
+	0x60, 0xbc, 0xd5, 0x76, 0x5b, 0x84, 0x86, 0x05, 0x0c, 0x1f, 0xd3, 0x09, 0x5c, 0x83, 0x4b, 0xb9,
+	0x81, 0x46, 0x3b, 0xf9, 0xc7, 0xe0, 0x58, 0x0a, 0xce, 0xac, 0x37, 0x32, 0x23, 0xe9, 0xc0, 0x2e,
+	0xc3, 0x7a, 0x2f, 0xca, 0xe2, 0xc5, 0xe6, 0xf5, 0xa9, 0xbb, 0x8c, 0xe9, 0x46, 0xe3, 0xb3, 0x8f,
+	0xef, 0x0e, 0x34, 0x96, 0x45, 0x98, 0x30, 0x6c, 0x9d, 0xd2, 0xf8, 0x66, 0x18, 0xf7, 0x9f, 0x6b,
+	0x48, 0x9e, 0x87, 0x31, 0xe7, 0x88, 0xc5, 0xef, 0xe1, 0xa8, 0x73, 0xc4, 0xb2, 0x76, 0xf8, 0x0e,
+	0x94, 0x44, 0x7a, 0xd1, 0xc6, 0x2e, 0xc3, 0x34, 0x3a, 0xf6, 0x4e, 0x2e, 0x83, 0xb8, 0xc2, 0x7e,
+	0x9e, 0x44, 0xc7, 0x8e, 0xe6, 0x63, 0x35, 0x14, 0xc6, 0x56, 0xc5, 0x6f, 0x68, 0x6c, 0x41, 0xdf,
+	0x5d, 0xc5, 0x5d, 0xb1, 0x95, 0x9f, 0x0d, 0xf3, 0x5b, 0xc1, 0xb4, 0x28, 0xc3, 0xee, 0x03, 0x7c,
+	0xb8, 0xdb, 0x66, 0x0d, 0x47, 0xb8, 0x15, 0xfe, 0x79, 0x20, 0x2a, 0x4c, 0xb4, 0xa9, 0x77, 0x52,
+	0x34, 0x31, 0x8f, 0x26, 0x7a, 0xf6, 0x9a, 0xcd, 0x63, 0x7c, 0xe8, 0x0d, 0x8b, 0x4e, 0x5d, 0xb8,
+	0x34, 0xbb, 0x9a, 0xcd, 0x34, 0x9d, 0xb0, 0xd9, 0x0c, 0x17, 0x0b, 0x44, 0xf3, 0xcf, 0x9f, 0x91,
+	0xfc, 0xf3, 0x47, 0xbe, 0x0a, 0xe7, 0xf4, 0x36, 0x23, 0x1e, 0x09, 0x9d, 0xe1, 0xba, 0x3f, 0x79,
+	0xf9, 0xaf, 0xf2, 0x84, 0x36, 0xeb, 0x09, 0x82, 0x21, 0xef, 0xbe, 0x07, 0xa7, 0xa7, 0xc5, 0xb1,
+	0x7e, 0xa6, 0xc5, 0x5f, 0x25, 0xd8, 0xe8, 0x99, 0xe2, 0x1e, 0xe3, 0x63, 0x7e, 0x4c, 0xc3, 0x3d,
+	0x62, 0x12, 0x87, 0xce, 0xc2, 0xd9, 0x43, 0xe7, 0x48, 0x1f, 0x43, 0xe7, 0x68, 0xd7, 0xd0, 0x79,
+	0xf3, 0xcf, 0x49, 0x28, 0x1c, 0x50, 0x53, 0xfe, 0x04, 0xa6, 0x12, 0xdf, 0x1a, 0x16, 0xc2, 0xda,
+	0x75, 0xcd, 0xfe, 0xea, 0xc5, 0x1c, 0x41, 0x14, 0xfd, 0x87, 0x50, 0x8c, 0x3f, 0x08, 0x94, 0x04,
+	0xed, 0x08, 0x55, 0x97, 0xb2, 0x50, 0xd1, 0x40, 0x3c, 0x03, 0x97, 0x52, 0xee, 0xba, 0x0d, 0xa4,
+	0x86, 0x50, 0xf9, 0x16, 0x4c, 0x27, 0x27, 0x50, 0x45, 0x50, 0x4f, 0x48, 0xd4, 0xd5, 0x3c, 0x89,
+	0x68, 0x4c, 0xcb, 0x35, 0xa6, 0xe5, 0x1a, 0xcb, 0x1c, 0x02, 0x65, 0x03, 0xe6, 0xb3, 0x07, 0x40,
+	0x71, 0x69, 0xa6, 0x86, 0xba, 0x79, 0x96, 0x46, 0xe4, 0xc4, 0x06, 0x25, 0x77, 0x9c, 0x5a, 0x4b,
+	0x50, 0xcc, 0x56, 0x52, 0xaf, 0xf5, 0xa1, 0x14, 0x79, 0xdb, 0x03, 0x10, 0x46, 0x9c, 0xf9, 0x24,
+	0x4b, 0x0e, 0xab, 0xcb, 0x99, 0x70, 0x32, 0xc7, 0xe2, 0xb4, 0xa1, 0xa4, 0x18, 0x84, 0x96, 0x56,
+	0xf3, 0x24, 0x91, 0x31, 0x02, 0x8b, 0xf9, 0xd3, 0xc0, 0xba, 0xb0, 0x3c, 0x57, 0x4b, 0xbd, 0xde,
+	0x8f, 0x56, 0xe4, 0xd0, 0x05, 0xb5, 0x47, 0x37, 0xbe, 0x91, 0x20, 0x9c, 0xa7, 0xa6, 0x6e, 0xf5,
+	0xa5, 0x16, 0xf9, 0x7c, 0x08, 0x72, 0x46, 0x97, 0x2c, 0xa6, 0x39, 0x2d, 0x56, 0x37, 0x7a, 0x8a,
+	0x23, 0xdb, 0x47, 0x70, 0x21, 0xa7, 0x8d, 0xbb, 0x94, 0x2c, 0x63, 0x86, 0x8a, 0xfa, 0xff, 0x33,
+	0x55, 0xc4, 0x42, 0xe5, 0xf7, 0x47, 0xeb, 0xa9, 0x3a, 0x67, 0x79, 0xbb, 0xde, 0x8f, 0x96, 0x78,
+	0xb0, 0xc4, 0x4d, 0x8b, 0x78, 0xb0, 0x44, 0xa8, 0xba, 0x94, 0x85, 0x8a, 0x06, 0xaa, 0x38, 0xcb,
+	0x40, 0x15, 0x67, 0x19, 0x48, 0x5d, 0xd3, 0xc1, 0x56, 0xc9, 0xbd, 0xa2, 0x93, 0x5b, 0x25, 0x4f,
+	0x4d, 0xdd, 0xea, 0x4b, 0x2d, 0xf4, 0xa9, 0x8e, 0x7e, 0xf1, 0xf2, 0xf1, 0x55, 0x69, 0xef, 0x83,
+	0x27, 0xcf, 0x57, 0xa4, 0xa7, 0xcf, 0x57, 0xa4, 0x67, 0xcf, 0x57, 0xa4, 0x6f, 0x5f, 0xac, 0x0c,
+	0x3d, 0x7d, 0xb1, 0x32, 0xf4, 0xdb, 0x8b, 0x95, 0xa1, 0x87, 0x6b, 0xa6, 0xc5, 0x1a, 0xed, 0xc3,
+	0x6d, 0x83, 0x34, 0x2b, 0x94, 0x38, 0xee, 0x96, 0x45, 0xfc, 0xdf, 0xca, 0x49, 0xc5, 0xfb, 0x5c,
+	0xed, 0x7f, 0xab, 0x3e, 0x1c, 0xf3, 0x3f, 0x4b, 0xbf, 0xfd, 0xf7, 0x00, 0x27, 0xe2, 0x8a, 0x05,
+	0x39, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateDID creates a new DID document
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_tx_docs.md"}}
+	CreateDID(ctx context.Context, in *MsgCreateDID, opts ...grpc.CallOption) (*MsgCreateDIDResponse, error)
+	// UpdateDID updates an existing DID document
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_tx_docs.md"}}
+	UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error)
+	// DeactivateDID deactivates a DID document
+	DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error)
+	// ReactivateDID restores a deactivated DID document
+	ReactivateDID(ctx context.Context, in *MsgReactivateDID, opts ...grpc.CallOption) (*MsgReactivateDIDResponse, error)
+	// AddVerificationMethod adds a new verification method to a DID document
+	AddVerificationMethod(ctx context.Context, in *MsgAddVerificationMethod, opts ...grpc.CallOption) (*MsgAddVerificationMethodResponse, error)
+	// RemoveVerificationMethod removes a verification method from a DID document
+	RemoveVerificationMethod(ctx context.Context, in *MsgRemoveVerificationMethod, opts ...grpc.CallOption) (*MsgRemoveVerificationMethodResponse, error)
+	// AddService adds a new service endpoint to a DID document
+	AddService(ctx context.Context, in *MsgAddService, opts ...grpc.CallOption) (*MsgAddServiceResponse, error)
+	// RemoveService removes a service endpoint from a DID document
+	RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error)
+	// IssueVerifiableCredential issues a new verifiable credential
+	IssueVerifiableCredential(ctx context.Context, in *MsgIssueVerifiableCredential, opts ...grpc.CallOption) (*MsgIssueVerifiableCredentialResponse, error)
+	// RevokeVerifiableCredential revokes a verifiable credential
+	RevokeVerifiableCredential(ctx context.Context, in *MsgRevokeVerifiableCredential, opts ...grpc.CallOption) (*MsgRevokeVerifiableCredentialResponse, error)
+	// LinkExternalWallet links an external wallet as an assertion method
+	LinkExternalWallet(ctx context.Context, in *MsgLinkExternalWallet, opts ...grpc.CallOption) (*MsgLinkExternalWalletResponse, error)
+	// AddDelegatedController delegates control of a DID to another address
+	// until an expiry time
+	AddDelegatedController(ctx context.Context, in *MsgAddDelegatedController, opts ...grpc.CallOption) (*MsgAddDelegatedControllerResponse, error)
+	// RemoveDelegatedController ends a delegation before it expires
+	RemoveDelegatedController(ctx context.Context, in *MsgRemoveDelegatedController, opts ...grpc.CallOption) (*MsgRemoveDelegatedControllerResponse, error)
+	// AttachNFT shows an x/nft token the DID's controller owns on its profile
+	AttachNFT(ctx context.Context, in *MsgAttachNFT, opts ...grpc.CallOption) (*MsgAttachNFTResponse, error)
+	// DetachNFT removes a token from the DID's profile
+	DetachNFT(ctx context.Context, in *MsgDetachNFT, opts ...grpc.CallOption) (*MsgDetachNFTResponse, error)
+	// RegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_tx_docs.md"}}
+	RegisterWebAuthnCredential(ctx context.Context, in *MsgRegisterWebAuthnCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthnCredentialResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).IssueVerifiableCredential(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) CreateDID(ctx context.Context, in *MsgCreateDID, opts ...grpc.CallOption) (*MsgCreateDIDResponse, error) {
+	out := new(MsgCreateDIDResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/CreateDID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/IssueVerifiableCredential",
+	return out, nil
+}
+
+func (c *msgClient) UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error) {
+	out := new(MsgUpdateDIDResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/UpdateDID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IssueVerifiableCredential(ctx, req.(*MsgIssueVerifiableCredential))
+	return out, nil
+}
+
+func (c *msgClient) DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error) {
+	out := new(MsgDeactivateDIDResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/DeactivateDID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RevokeVerifiableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeVerifiableCredential)
-	if err := dec(in); err != nil {
+func (c *msgClient) ReactivateDID(ctx context.Context, in *MsgReactivateDID, opts ...grpc.CallOption) (*MsgReactivateDIDResponse, error) {
+	out := new(MsgReactivateDIDResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/ReactivateDID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeVerifiableCredential(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) AddVerificationMethod(ctx context.Context, in *MsgAddVerificationMethod, opts ...grpc.CallOption) (*MsgAddVerificationMethodResponse, error) {
+	out := new(MsgAddVerificationMethodResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/AddVerificationMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/RevokeVerifiableCredential",
+	return out, nil
+}
+
+func (c *msgClient) RemoveVerificationMethod(ctx context.Context, in *MsgRemoveVerificationMethod, opts ...grpc.CallOption) (*MsgRemoveVerificationMethodResponse, error) {
+	out := new(MsgRemoveVerificationMethodResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/RemoveVerificationMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeVerifiableCredential(ctx, req.(*MsgRevokeVerifiableCredential))
+	return out, nil
+}
+
+func (c *msgClient) AddService(ctx context.Context, in *MsgAddService, opts ...grpc.CallOption) (*MsgAddServiceResponse, error) {
+	out := new(MsgAddServiceResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/AddService", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_LinkExternalWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLinkExternalWallet)
-	if err := dec(in); err != nil {
+func (c *msgClient) RemoveService(ctx context.Context, in *MsgRemoveService, opts ...grpc.CallOption) (*MsgRemoveServiceResponse, error) {
+	out := new(MsgRemoveServiceResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/RemoveService", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).LinkExternalWallet(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) IssueVerifiableCredential(ctx context.Context, in *MsgIssueVerifiableCredential, opts ...grpc.CallOption) (*MsgIssueVerifiableCredentialResponse, error) {
+	out := new(MsgIssueVerifiableCredentialResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/IssueVerifiableCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/LinkExternalWallet",
+	return out, nil
+}
+
+func (c *msgClient) RevokeVerifiableCredential(ctx context.Context, in *MsgRevokeVerifiableCredential, opts ...grpc.CallOption) (*MsgRevokeVerifiableCredentialResponse, error) {
+	out := new(MsgRevokeVerifiableCredentialResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/RevokeVerifiableCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LinkExternalWallet(ctx, req.(*MsgLinkExternalWallet))
+	return out, nil
+}
+
+func (c *msgClient) LinkExternalWallet(ctx context.Context, in *MsgLinkExternalWallet, opts ...grpc.CallOption) (*MsgLinkExternalWalletResponse, error) {
+	out := new(MsgLinkExternalWalletResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/LinkExternalWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RegisterWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterWebAuthnCredential)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddDelegatedController(ctx context.Context, in *MsgAddDelegatedController, opts ...grpc.CallOption) (*MsgAddDelegatedControllerResponse, error) {
+	out := new(MsgAddDelegatedControllerResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/AddDelegatedController", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterWebAuthnCredential(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) RemoveDelegatedController(ctx context.Context, in *MsgRemoveDelegatedController, opts ...grpc.CallOption) (*MsgRemoveDelegatedControllerResponse, error) {
+	out := new(MsgRemoveDelegatedControllerResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/RemoveDelegatedController", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/did.v1.Msg/RegisterWebAuthnCredential",
+	return out, nil
+}
+
+func (c *msgClient) AttachNFT(ctx context.Context, in *MsgAttachNFT, opts ...grpc.CallOption) (*MsgAttachNFTResponse, error) {
+	out := new(MsgAttachNFTResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/AttachNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterWebAuthnCredential(ctx, req.(*MsgRegisterWebAuthnCredential))
+	return out, nil
+}
+
+func (c *msgClient) DetachNFT(ctx context.Context, in *MsgDetachNFT, opts ...grpc.CallOption) (*MsgDetachNFTResponse, error) {
+	out := new(MsgDetachNFTResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/DetachNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "did.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateDID",
-			Handler:    _Msg_CreateDID_Handler,
-		},
-		{
-			MethodName: "UpdateDID",
-			Handler:    _Msg_UpdateDID_Handler,
-		},
-		{
-			MethodName: "DeactivateDID",
-			Handler:    _Msg_DeactivateDID_Handler,
-		},
-		{
-			MethodName: "AddVerificationMethod",
-			Handler:    _Msg_AddVerificationMethod_Handler,
-		},
-		{
-			MethodName: "RemoveVerificationMethod",
-			Handler:    _Msg_RemoveVerificationMethod_Handler,
-		},
-		{
-			MethodName: "AddService",
-			Handler:    _Msg_AddService_Handler,
-		},
-		{
-			MethodName: "RemoveService",
-			Handler:    _Msg_RemoveService_Handler,
-		},
-		{
-			MethodName: "IssueVerifiableCredential",
-			Handler:    _Msg_IssueVerifiableCredential_Handler,
-		},
-		{
-			MethodName: "RevokeVerifiableCredential",
-			Handler:    _Msg_RevokeVerifiableCredential_Handler,
-		},
-		{
-			MethodName: "LinkExternalWallet",
-			Handler:    _Msg_LinkExternalWallet_Handler,
-		},
-		{
-			MethodName: "RegisterWebAuthnCredential",
-			Handler:    _Msg_RegisterWebAuthnCredential_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "did/v1/tx.proto",
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterWebAuthnCredential(ctx context.Context, in *MsgRegisterWebAuthnCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthnCredentialResponse, error) {
+	out := new(MsgRegisterWebAuthnCredentialResponse)
+	err := c.cc.Invoke(ctx, "/did.v1.Msg/RegisterWebAuthnCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateDID creates a new DID document
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_tx_docs.md"}}
+	CreateDID(context.Context, *MsgCreateDID) (*MsgCreateDIDResponse, error)
+	// UpdateDID updates an existing DID document
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_tx_docs.md"}}
+	UpdateDID(context.Context, *MsgUpdateDID) (*MsgUpdateDIDResponse, error)
+	// DeactivateDID deactivates a DID document
+	DeactivateDID(context.Context, *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error)
+	// ReactivateDID restores a deactivated DID document
+	ReactivateDID(context.Context, *MsgReactivateDID) (*MsgReactivateDIDResponse, error)
+	// AddVerificationMethod adds a new verification method to a DID document
+	AddVerificationMethod(context.Context, *MsgAddVerificationMethod) (*MsgAddVerificationMethodResponse, error)
+	// RemoveVerificationMethod removes a verification method from a DID document
+	RemoveVerificationMethod(context.Context, *MsgRemoveVerificationMethod) (*MsgRemoveVerificationMethodResponse, error)
+	// AddService adds a new service endpoint to a DID document
+	AddService(context.Context, *MsgAddService) (*MsgAddServiceResponse, error)
+	// RemoveService removes a service endpoint from a DID document
+	RemoveService(context.Context, *MsgRemoveService) (*MsgRemoveServiceResponse, error)
+	// IssueVerifiableCredential issues a new verifiable credential
+	IssueVerifiableCredential(context.Context, *MsgIssueVerifiableCredential) (*MsgIssueVerifiableCredentialResponse, error)
+	// RevokeVerifiableCredential revokes a verifiable credential
+	RevokeVerifiableCredential(context.Context, *MsgRevokeVerifiableCredential) (*MsgRevokeVerifiableCredentialResponse, error)
+	// LinkExternalWallet links an external wallet as an assertion method
+	LinkExternalWallet(context.Context, *MsgLinkExternalWallet) (*MsgLinkExternalWalletResponse, error)
+	// AddDelegatedController delegates control of a DID to another address
+	// until an expiry time
+	AddDelegatedController(context.Context, *MsgAddDelegatedController) (*MsgAddDelegatedControllerResponse, error)
+	// RemoveDelegatedController ends a delegation before it expires
+	RemoveDelegatedController(context.Context, *MsgRemoveDelegatedController) (*MsgRemoveDelegatedControllerResponse, error)
+	// AttachNFT shows an x/nft token the DID's controller owns on its profile
+	AttachNFT(context.Context, *MsgAttachNFT) (*MsgAttachNFTResponse, error)
+	// DetachNFT removes a token from the DID's profile
+	DetachNFT(context.Context, *MsgDetachNFT) (*MsgDetachNFTResponse, error)
+	// RegisterWebAuthnCredential registers a new WebAuthn credential and creates a DID
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "did_tx_docs.md"}}
+	RegisterWebAuthnCredential(context.Context, *MsgRegisterWebAuthnCredential) (*MsgRegisterWebAuthnCredentialResponse, error)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CreateDID(ctx context.Context, req *MsgCreateDID) (*MsgCreateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDID not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateDID(ctx context.Context, req *MsgUpdateDID) (*MsgUpdateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDID not implemented")
 }
-
-func (m *MsgCreateDID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DeactivateDID(ctx context.Context, req *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDID not implemented")
+}
+func (*UnimplementedMsgServer) ReactivateDID(ctx context.Context, req *MsgReactivateDID) (*MsgReactivateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateDID not implemented")
+}
+func (*UnimplementedMsgServer) AddVerificationMethod(ctx context.Context, req *MsgAddVerificationMethod) (*MsgAddVerificationMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVerificationMethod not implemented")
+}
+func (*UnimplementedMsgServer) RemoveVerificationMethod(ctx context.Context, req *MsgRemoveVerificationMethod) (*MsgRemoveVerificationMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVerificationMethod not implemented")
+}
+func (*UnimplementedMsgServer) AddService(ctx context.Context, req *MsgAddService) (*MsgAddServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
+}
+func (*UnimplementedMsgServer) RemoveService(ctx context.Context, req *MsgRemoveService) (*MsgRemoveServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+}
+func (*UnimplementedMsgServer) IssueVerifiableCredential(ctx context.Context, req *MsgIssueVerifiableCredential) (*MsgIssueVerifiableCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueVerifiableCredential not implemented")
+}
+func (*UnimplementedMsgServer) RevokeVerifiableCredential(ctx context.Context, req *MsgRevokeVerifiableCredential) (*MsgRevokeVerifiableCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVerifiableCredential not implemented")
+}
+func (*UnimplementedMsgServer) LinkExternalWallet(ctx context.Context, req *MsgLinkExternalWallet) (*MsgLinkExternalWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalWallet not implemented")
+}
+func (*UnimplementedMsgServer) AddDelegatedController(ctx context.Context, req *MsgAddDelegatedController) (*MsgAddDelegatedControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDelegatedController not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDelegatedController(ctx context.Context, req *MsgRemoveDelegatedController) (*MsgRemoveDelegatedControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegatedController not implemented")
+}
+func (*UnimplementedMsgServer) AttachNFT(ctx context.Context, req *MsgAttachNFT) (*MsgAttachNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachNFT not implemented")
+}
+func (*UnimplementedMsgServer) DetachNFT(ctx context.Context, req *MsgDetachNFT) (*MsgDetachNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachNFT not implemented")
+}
+func (*UnimplementedMsgServer) RegisterWebAuthnCredential(ctx context.Context, req *MsgRegisterWebAuthnCredential) (*MsgRegisterWebAuthnCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebAuthnCredential not implemented")
 }
 
-func (m *MsgCreateDID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgCreateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EnclaveId) > 0 {
-		i -= len(m.EnclaveId)
-		copy(dAtA[i:], m.EnclaveId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EnclaveId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VaultPublicKey) > 0 {
-		i -= len(m.VaultPublicKey)
-		copy(dAtA[i:], m.VaultPublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultPublicKey)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDID(ctx, in)
 	}
-	if len(m.VaultId) > 0 {
-		i -= len(m.VaultId)
-		copy(dAtA[i:], m.VaultId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/CreateDID",
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDID(ctx, req.(*MsgCreateDID))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateDID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDID(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/UpdateDID",
 	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDID(ctx, req.(*MsgUpdateDID))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeactivateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateDID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/DeactivateDID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateDID(ctx, req.(*MsgDeactivateDID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeactivateDID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ReactivateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReactivateDID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeactivateDID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ReactivateDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/ReactivateDID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReactivateDID(ctx, req.(*MsgReactivateDID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeactivateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_AddVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddVerificationMethod)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).AddVerificationMethod(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/AddVerificationMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddVerificationMethod(ctx, req.(*MsgAddVerificationMethod))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeactivateDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveVerificationMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveVerificationMethod)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveVerificationMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/RemoveVerificationMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveVerificationMethod(ctx, req.(*MsgRemoveVerificationMethod))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeactivateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddService)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/AddService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddService(ctx, req.(*MsgAddService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeactivateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveService)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/RemoveService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveService(ctx, req.(*MsgRemoveService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddVerificationMethod) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_IssueVerifiableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueVerifiableCredential)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).IssueVerifiableCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/IssueVerifiableCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueVerifiableCredential(ctx, req.(*MsgIssueVerifiableCredential))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddVerificationMethod) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RevokeVerifiableCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVerifiableCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVerifiableCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/RevokeVerifiableCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVerifiableCredential(ctx, req.(*MsgRevokeVerifiableCredential))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_LinkExternalWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkExternalWallet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkExternalWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/LinkExternalWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkExternalWallet(ctx, req.(*MsgLinkExternalWallet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddDelegatedController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDelegatedController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDelegatedController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/AddDelegatedController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDelegatedController(ctx, req.(*MsgAddDelegatedController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDelegatedController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDelegatedController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDelegatedController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/RemoveDelegatedController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDelegatedController(ctx, req.(*MsgRemoveDelegatedController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttachNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttachNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttachNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/AttachNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttachNFT(ctx, req.(*MsgAttachNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DetachNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDetachNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DetachNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/DetachNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DetachNFT(ctx, req.(*MsgDetachNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterWebAuthnCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterWebAuthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/did.v1.Msg/RegisterWebAuthnCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterWebAuthnCredential(ctx, req.(*MsgRegisterWebAuthnCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "did.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateDID",
+			Handler:    _Msg_CreateDID_Handler,
+		},
+		{
+			MethodName: "UpdateDID",
+			Handler:    _Msg_UpdateDID_Handler,
+		},
+		{
+			MethodName: "DeactivateDID",
+			Handler:    _Msg_DeactivateDID_Handler,
+		},
+		{
+			MethodName: "ReactivateDID",
+			Handler:    _Msg_ReactivateDID_Handler,
+		},
+		{
+			MethodName: "AddVerificationMethod",
+			Handler:    _Msg_AddVerificationMethod_Handler,
+		},
+		{
+			MethodName: "RemoveVerificationMethod",
+			Handler:    _Msg_RemoveVerificationMethod_Handler,
+		},
+		{
+			MethodName: "AddService",
+			Handler:    _Msg_AddService_Handler,
+		},
+		{
+			MethodName: "RemoveService",
+			Handler:    _Msg_RemoveService_Handler,
+		},
+		{
+			MethodName: "IssueVerifiableCredential",
+			Handler:    _Msg_IssueVerifiableCredential_Handler,
+		},
+		{
+			MethodName: "RevokeVerifiableCredential",
+			Handler:    _Msg_RevokeVerifiableCredential_Handler,
+		},
+		{
+			MethodName: "LinkExternalWallet",
+			Handler:    _Msg_LinkExternalWallet_Handler,
+		},
+		{
+			MethodName: "AddDelegatedController",
+			Handler:    _Msg_AddDelegatedController_Handler,
+		},
+		{
+			MethodName: "RemoveDelegatedController",
+			Handler:    _Msg_RemoveDelegatedController_Handler,
+		},
+		{
+			MethodName: "AttachNFT",
+			Handler:    _Msg_AttachNFT_Handler,
+		},
+		{
+			MethodName: "DetachNFT",
+			Handler:    _Msg_DetachNFT_Handler,
+		},
+		{
+			MethodName: "RegisterWebAuthnCredential",
+			Handler:    _Msg_RegisterWebAuthnCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "did/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Relationships) > 0 {
-		for iNdEx := len(m.Relationships) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relationships[iNdEx])
-			copy(dAtA[i:], m.Relationships[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Relationships[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
 	{
-		size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2356,25 +2848,18 @@ func (m *MsgAddVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2384,12 +2869,12 @@ func (m *MsgAddVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddVerificationMethodResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2397,7 +2882,7 @@ func (m *MsgAddVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveVerificationMethod) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2407,30 +2892,33 @@ func (m *MsgRemoveVerificationMethod) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveVerificationMethod) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VerificationMethodId) > 0 {
-		i -= len(m.VerificationMethodId)
-		copy(dAtA[i:], m.VerificationMethodId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
+	if len(m.ReferralCode) > 0 {
+		i -= len(m.ReferralCode)
+		copy(dAtA[i:], m.ReferralCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferralCode)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Controller) > 0 {
 		i -= len(m.Controller)
 		copy(dAtA[i:], m.Controller)
@@ -2441,7 +2929,7 @@ func (m *MsgRemoveVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2451,20 +2939,48 @@ func (m *MsgRemoveVerificationMethodResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveVerificationMethodResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddService) Marshal() (dAtA []byte, err error) {
+	if len(m.EnclaveId) > 0 {
+		i -= len(m.EnclaveId)
+		copy(dAtA[i:], m.EnclaveId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EnclaveId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VaultPublicKey) > 0 {
+		i -= len(m.VaultPublicKey)
+		copy(dAtA[i:], m.VaultPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultId) > 0 {
+		i -= len(m.VaultId)
+		copy(dAtA[i:], m.VaultId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2474,18 +2990,18 @@ func (m *MsgAddService) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddService) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateDID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2511,7 +3027,7 @@ func (m *MsgAddService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddServiceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateDIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2521,12 +3037,12 @@ func (m *MsgAddServiceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2534,7 +3050,7 @@ func (m *MsgAddServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveService) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeactivateDID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2544,23 +3060,16 @@ func (m *MsgRemoveService) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveService) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Did) > 0 {
 		i -= len(m.Did)
 		copy(dAtA[i:], m.Did)
@@ -2578,7 +3087,7 @@ func (m *MsgRemoveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveServiceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeactivateDIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2588,12 +3097,12 @@ func (m *MsgRemoveServiceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeactivateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2601,7 +3110,7 @@ func (m *MsgRemoveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgIssueVerifiableCredential) Marshal() (dAtA []byte, err error) {
+func (m *MsgReactivateDID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2611,37 +3120,34 @@ func (m *MsgIssueVerifiableCredential) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgIssueVerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReactivateDID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIssueVerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReactivateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgIssueVerifiableCredentialResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReactivateDIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2651,27 +3157,20 @@ func (m *MsgIssueVerifiableCredentialResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgIssueVerifiableCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReactivateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIssueVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReactivateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CredentialId) > 0 {
-		i -= len(m.CredentialId)
-		copy(dAtA[i:], m.CredentialId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeVerifiableCredential) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddVerificationMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2681,41 +3180,53 @@ func (m *MsgRevokeVerifiableCredential) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeVerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddVerificationMethod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeVerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RevocationReason) > 0 {
-		i -= len(m.RevocationReason)
-		copy(dAtA[i:], m.RevocationReason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RevocationReason)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Relationships) > 0 {
+		for iNdEx := len(m.Relationships) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relationships[iNdEx])
+			copy(dAtA[i:], m.Relationships[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Relationships[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.CredentialId) > 0 {
-		i -= len(m.CredentialId)
-		copy(dAtA[i:], m.CredentialId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialId)))
+	{
+		size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeVerifiableCredentialResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2725,12 +3236,12 @@ func (m *MsgRevokeVerifiableCredentialResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeVerifiableCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddVerificationMethodResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2738,7 +3249,7 @@ func (m *MsgRevokeVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLinkExternalWallet) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveVerificationMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2748,12 +3259,12 @@ func (m *MsgLinkExternalWallet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLinkExternalWallet) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveVerificationMethod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLinkExternalWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2763,41 +3274,6 @@ func (m *MsgLinkExternalWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.VerificationMethodId)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
 		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OwnershipProof) > 0 {
-		i -= len(m.OwnershipProof)
-		copy(dAtA[i:], m.OwnershipProof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnershipProof)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.WalletType) > 0 {
-		i -= len(m.WalletType)
-		copy(dAtA[i:], m.WalletType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.WalletChainId) > 0 {
-		i -= len(m.WalletChainId)
-		copy(dAtA[i:], m.WalletChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletChainId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Did) > 0 {
@@ -2817,7 +3293,7 @@ func (m *MsgLinkExternalWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLinkExternalWalletResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveVerificationMethodResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2827,27 +3303,20 @@ func (m *MsgLinkExternalWalletResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLinkExternalWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveVerificationMethodResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLinkExternalWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveVerificationMethodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VerificationMethodId) > 0 {
-		i -= len(m.VerificationMethodId)
-		copy(dAtA[i:], m.VerificationMethodId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWebAuthnCredential) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddService) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2857,35 +3326,18 @@ func (m *MsgRegisterWebAuthnCredential) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterWebAuthnCredential) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddService) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterWebAuthnCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AutoCreateVault {
-		i--
-		if m.AutoCreateVault {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.VerificationMethodId) > 0 {
-		i -= len(m.VerificationMethodId)
-		copy(dAtA[i:], m.VerificationMethodId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
-		i--
-		dAtA[i] = 0x22
-	}
 	{
-		size, err := m.WebauthnCredential.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2894,10 +3346,10 @@ func (m *MsgRegisterWebAuthnCredential) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Username)))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2911,7 +3363,7 @@ func (m *MsgRegisterWebAuthnCredential) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddServiceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2921,479 +3373,2377 @@ func (m *MsgRegisterWebAuthnCredentialResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddServiceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterWebAuthnCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EnclaveId) > 0 {
-		i -= len(m.EnclaveId)
-		copy(dAtA[i:], m.EnclaveId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EnclaveId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.VaultPublicKey) > 0 {
-		i -= len(m.VaultPublicKey)
-		copy(dAtA[i:], m.VaultPublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultPublicKey)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.VaultId) > 0 {
-		i -= len(m.VaultId)
-		copy(dAtA[i:], m.VaultId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultId)))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.VerificationMethodId) > 0 {
-		i -= len(m.VerificationMethodId)
-		copy(dAtA[i:], m.VerificationMethodId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Did) > 0 {
 		i -= len(m.Did)
 		copy(dAtA[i:], m.Did)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRemoveServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgRemoveServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgIssueVerifiableCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.DidDocument.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIssueVerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueVerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultPublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.EnclaveId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIssueVerifiableCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueVerifiableCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.DidDocument.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeVerifiableCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeactivateDID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeVerifiableCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVerifiableCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RevocationReason) > 0 {
+		i -= len(m.RevocationReason)
+		copy(dAtA[i:], m.RevocationReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RevocationReason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeactivateDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeVerifiableCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddVerificationMethod) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.VerificationMethod.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Relationships) > 0 {
-		for _, s := range m.Relationships {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgRevokeVerifiableCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddVerificationMethodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeVerifiableCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveVerificationMethod) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLinkExternalWallet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkExternalWallet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkExternalWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.VerificationMethodId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OwnershipProof) > 0 {
+		i -= len(m.OwnershipProof)
+		copy(dAtA[i:], m.OwnershipProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnershipProof)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if len(m.WalletType) > 0 {
+		i -= len(m.WalletType)
+		copy(dAtA[i:], m.WalletType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WalletChainId) > 0 {
+		i -= len(m.WalletChainId)
+		copy(dAtA[i:], m.WalletChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveVerificationMethodResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLinkExternalWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddService) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgLinkExternalWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkExternalWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Service.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddDelegatedController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveService) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddDelegatedController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDelegatedController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddDelegatedControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgIssueVerifiableCredential) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Credential.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgAddDelegatedControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIssueVerifiableCredentialResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddDelegatedControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CredentialId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeVerifiableCredential) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveDelegatedController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDelegatedController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDelegatedController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.CredentialId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RevocationReason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeVerifiableCredentialResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveDelegatedControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDelegatedControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDelegatedControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLinkExternalWallet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAttachNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttachNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttachNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.WalletChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.WalletType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttachNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OwnershipProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttachNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttachNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DwnRecordId) > 0 {
+		i -= len(m.DwnRecordId)
+		copy(dAtA[i:], m.DwnRecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DwnRecordId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDetachNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.VerificationMethodId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgDetachNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDetachNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDetachNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDetachNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDetachNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterWebAuthnCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterWebAuthnCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWebAuthnCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferralCode) > 0 {
+		i -= len(m.ReferralCode)
+		copy(dAtA[i:], m.ReferralCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferralCode)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutoCreateVault {
+		i--
+		if m.AutoCreateVault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.WebauthnCredential.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnclaveId) > 0 {
+		i -= len(m.EnclaveId)
+		copy(dAtA[i:], m.EnclaveId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EnclaveId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VaultPublicKey) > 0 {
+		i -= len(m.VaultPublicKey)
+		copy(dAtA[i:], m.VaultPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultPublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VaultId) > 0 {
+		i -= len(m.VaultId)
+		copy(dAtA[i:], m.VaultId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DidDocument.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ReferralCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EnclaveId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DidDocument.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReactivateDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReactivateDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddVerificationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VerificationMethod.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Relationships) > 0 {
+		for _, s := range m.Relationships {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddVerificationMethodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveVerificationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveVerificationMethodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Service.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIssueVerifiableCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Credential.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgIssueVerifiableCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVerifiableCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RevocationReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVerifiableCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLinkExternalWallet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WalletChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WalletType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnershipProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLinkExternalWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddDelegatedController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddDelegatedControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDelegatedController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDelegatedControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttachNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttachNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DwnRecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDetachNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDetachNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterWebAuthnCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WebauthnCredential.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoCreateVault {
+		n += 2
+	}
+	l = len(m.ReferralCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterWebAuthnCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EnclaveId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultPublicKey = append(m.VaultPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VaultPublicKey == nil {
+				m.VaultPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnclaveId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReactivateDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReactivateDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReactivateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgLinkExternalWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VerificationMethodId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgRegisterWebAuthnCredential) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.WebauthnCredential.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.VerificationMethodId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AutoCreateVault {
-		n += 2
+func (m *MsgReactivateDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReactivateDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReactivateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRegisterWebAuthnCredentialResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VerificationMethodId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultPublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.EnclaveId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3416,15 +5766,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVerificationMethod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3452,11 +5802,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3483,10 +5865,42 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relationships = append(m.Relationships, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3508,7 +5922,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,10 +5945,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVerificationMethodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3558,7 +5972,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDID) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveVerificationMethod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3581,10 +5995,10 @@ func (m *MsgCreateDID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDID: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveVerificationMethod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3621,9 +6035,9 @@ func (m *MsgCreateDID) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3633,24 +6047,55 @@ func (m *MsgCreateDID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3673,7 +6118,7 @@ func (m *MsgCreateDID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3696,15 +6141,65 @@ func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3732,11 +6227,11 @@ func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3764,13 +6259,13 @@ func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultId = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultPublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3780,58 +6275,75 @@ func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultPublicKey = append(m.VaultPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.VaultPublicKey == nil {
-				m.VaultPublicKey = []byte{}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnclaveId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3853,7 +6365,7 @@ func (m *MsgCreateDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3876,10 +6388,10 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDID: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3948,9 +6460,9 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3960,24 +6472,23 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4000,7 +6511,7 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveServiceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4023,10 +6534,10 @@ func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveServiceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4050,7 +6561,7 @@ func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueVerifiableCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4073,15 +6584,15 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateDID: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueVerifiableCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4109,13 +6620,13 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4125,23 +6636,24 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4164,7 +6676,7 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,12 +6699,44 @@ func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4214,7 +6758,7 @@ func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4237,15 +6781,15 @@ func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddVerificationMethod: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVerifiableCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4273,11 +6817,11 @@ func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4305,44 +6849,11 @@ func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4370,7 +6881,7 @@ func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relationships = append(m.Relationships, string(dAtA[iNdEx:postIndex]))
+			m.RevocationReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4393,7 +6904,7 @@ func (m *MsgAddVerificationMethod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddVerificationMethodResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4416,10 +6927,10 @@ func (m *MsgAddVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddVerificationMethodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4443,7 +6954,7 @@ func (m *MsgAddVerificationMethodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveVerificationMethod) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4466,10 +6977,10 @@ func (m *MsgRemoveVerificationMethod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveVerificationMethod: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkExternalWallet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkExternalWallet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4538,7 +7049,7 @@ func (m *MsgRemoveVerificationMethod) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4566,113 +7077,77 @@ func (m *MsgRemoveVerificationMethod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletChainId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveVerificationMethodResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WalletChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveVerificationMethodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddService) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddService: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddService: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.WalletType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4682,29 +7157,31 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.OwnershipProof = append(m.OwnershipProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnershipProof == nil {
+				m.OwnershipProof = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4714,29 +7191,31 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Challenge = append(m.Challenge[:0], dAtA[iNdEx:postIndex]...)
+			if m.Challenge == nil {
+				m.Challenge = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4746,24 +7225,23 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4786,7 +7264,7 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkExternalWalletResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4809,12 +7287,44 @@ func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkExternalWalletResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkExternalWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4836,7 +7346,7 @@ func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
+func (m *MsgAddDelegatedController) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4859,10 +7369,10 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddDelegatedController: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddDelegatedController: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4931,7 +7441,7 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4959,113 +7469,13 @@ func (m *MsgRemoveService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveServiceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveServiceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgIssueVerifiableCredential) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueVerifiableCredential: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5075,29 +7485,16 @@ func (m *MsgIssueVerifiableCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5107,24 +7504,23 @@ func (m *MsgIssueVerifiableCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5147,7 +7543,7 @@ func (m *MsgIssueVerifiableCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddDelegatedControllerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5170,44 +7566,12 @@ func (m *MsgIssueVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddDelegatedControllerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CredentialId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			return fmt.Errorf("proto: MsgAddDelegatedControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5229,7 +7593,7 @@ func (m *MsgIssueVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDelegatedController) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,15 +7616,15 @@ func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeVerifiableCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDelegatedController: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeVerifiableCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDelegatedController: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5288,11 +7652,11 @@ func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5320,11 +7684,11 @@ func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5352,7 +7716,7 @@ func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RevocationReason = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5375,7 +7739,7 @@ func (m *MsgRevokeVerifiableCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDelegatedControllerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5398,10 +7762,10 @@ func (m *MsgRevokeVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDelegatedControllerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeVerifiableCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDelegatedControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5425,7 +7789,7 @@ func (m *MsgRevokeVerifiableCredentialResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
+func (m *MsgAttachNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5448,10 +7812,10 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkExternalWallet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttachNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkExternalWallet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttachNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5520,7 +7884,7 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5548,11 +7912,11 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5580,11 +7944,61 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletChainId = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttachNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttachNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttachNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DwnRecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5612,13 +8026,63 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletType = string(dAtA[iNdEx:postIndex])
+			m.DwnRecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDetachNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDetachNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDetachNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5628,31 +8092,29 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnershipProof = append(m.OwnershipProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.OwnershipProof == nil {
-				m.OwnershipProof = []byte{}
-			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5662,29 +8124,59 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = append(m.Challenge[:0], dAtA[iNdEx:postIndex]...)
-			if m.Challenge == nil {
-				m.Challenge = []byte{}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5712,7 +8204,7 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5735,7 +8227,7 @@ func (m *MsgLinkExternalWallet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkExternalWalletResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDetachNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5758,44 +8250,12 @@ func (m *MsgLinkExternalWalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkExternalWalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDetachNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkExternalWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDetachNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5995,6 +8455,38 @@ func (m *MsgRegisterWebAuthnCredential) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AutoCreateVault = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
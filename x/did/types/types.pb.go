@@ -385,6 +385,16 @@ type WebAuthnCredential struct {
 	ClientDataJson string `protobuf:"bytes,13,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
 	// attestation_object is the base64url encoded attestation object
 	AttestationObject string `protobuf:"bytes,14,opt,name=attestation_object,json=attestationObject,proto3" json:"attestation_object,omitempty"`
+	// attestation_format is the verified attestation statement format, e.g.
+	// "packed" or "none"
+	AttestationFormat string `protobuf:"bytes,15,opt,name=attestation_format,json=attestationFormat,proto3" json:"attestation_format,omitempty"`
+	// aaguid identifies the authenticator model; all zeros when the
+	// authenticator does not disclose it
+	Aaguid []byte `protobuf:"bytes,16,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	// backup_eligible indicates the credential may be synced to other devices
+	BackupEligible bool `protobuf:"varint,17,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	// backup_state indicates the credential was backed up at registration
+	BackupState bool `protobuf:"varint,18,opt,name=backup_state,json=backupState,proto3" json:"backup_state,omitempty"`
 }
 
 func (m *WebAuthnCredential) Reset()         { *m = WebAuthnCredential{} }
@@ -518,6 +528,34 @@ func (m *WebAuthnCredential) GetAttestationObject() string {
 	return ""
 }
 
+func (m *WebAuthnCredential) GetAttestationFormat() string {
+	if m != nil {
+		return m.AttestationFormat
+	}
+	return ""
+}
+
+func (m *WebAuthnCredential) GetAaguid() []byte {
+	if m != nil {
+		return m.Aaguid
+	}
+	return nil
+}
+
+func (m *WebAuthnCredential) GetBackupEligible() bool {
+	if m != nil {
+		return m.BackupEligible
+	}
+	return false
+}
+
+func (m *WebAuthnCredential) GetBackupState() bool {
+	if m != nil {
+		return m.BackupState
+	}
+	return false
+}
+
 // CredentialProof represents a cryptographic proof for a verifiable
 // credential
 type CredentialProof struct {
@@ -690,69 +728,73 @@ func init() {
 func init() { proto.RegisterFile("did/v1/types.proto", fileDescriptor_e3176cd2dbe76ac1) }
 
 var fileDescriptor_e3176cd2dbe76ac1 = []byte{
-	// 988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0xb6, 0xa4, 0x58, 0x8e, 0x46, 0x8a, 0x65, 0xaf, 0x1d, 0xff, 0x08, 0xff, 0x52, 0xc5, 0x55,
-	0x0a, 0x44, 0x2d, 0x10, 0x29, 0x71, 0xdd, 0xc0, 0x28, 0x10, 0x14, 0x4e, 0x1b, 0x24, 0x8e, 0x91,
-	0xd6, 0x60, 0x8a, 0x14, 0x28, 0x0a, 0x10, 0x2b, 0xee, 0x58, 0x5a, 0x8b, 0xdc, 0x25, 0x96, 0x2b,
-	0xc9, 0x7a, 0x8b, 0xbe, 0x4a, 0x5f, 0xa2, 0xe8, 0xa1, 0x87, 0x1c, 0xdb, 0x5b, 0x61, 0x5f, 0xfb,
-	0x06, 0xbd, 0x14, 0xdc, 0xa5, 0x48, 0x56, 0xd2, 0x35, 0x17, 0x7b, 0xf9, 0xcd, 0xb7, 0x9c, 0x7f,
-	0xdf, 0x8c, 0x08, 0x84, 0x71, 0xd6, 0x9b, 0x3c, 0xe9, 0xe9, 0x59, 0x84, 0x71, 0x37, 0x52, 0x52,
-	0x4b, 0x52, 0x65, 0x9c, 0x75, 0x27, 0x4f, 0xf6, 0xb7, 0x69, 0xc8, 0x85, 0xec, 0x99, 0xbf, 0xd6,
-	0xb4, 0xbf, 0x3b, 0x90, 0x03, 0x69, 0x8e, 0xbd, 0xe4, 0x64, 0xd1, 0xf6, 0x3f, 0x15, 0x20, 0xef,
-	0x50, 0xf1, 0x0b, 0xee, 0x53, 0xcd, 0xa5, 0x78, 0x83, 0x7a, 0x28, 0x19, 0xd9, 0x84, 0x32, 0x67,
-	0x4e, 0xe9, 0xa0, 0xd4, 0xa9, 0xb9, 0x65, 0xce, 0xc8, 0x31, 0x38, 0x93, 0x02, 0xcb, 0x0b, 0x0d,
-	0xcd, 0x1b, 0x71, 0xc1, 0x9c, 0xb2, 0x61, 0xed, 0x4d, 0x96, 0xde, 0x72, 0xc6, 0x05, 0x23, 0x2d,
-	0x00, 0x5f, 0x0a, 0xad, 0x64, 0x10, 0xa0, 0x72, 0x2a, 0x86, 0x5b, 0x40, 0xc8, 0x27, 0xb0, 0x19,
-	0x8d, 0xfb, 0x01, 0xf7, 0xbd, 0x11, 0xce, 0xbc, 0xcb, 0xe9, 0xc8, 0xb9, 0x65, 0x38, 0x0d, 0x8b,
-	0x9e, 0xe1, 0xec, 0xf5, 0x74, 0x44, 0x1e, 0xc3, 0x6e, 0x81, 0x15, 0x8e, 0x03, 0xcd, 0xfb, 0x34,
-	0x46, 0x67, 0xdd, 0x70, 0x49, 0xc6, 0x7d, 0x33, 0xb7, 0x90, 0xcf, 0x60, 0xbb, 0x70, 0x23, 0x81,
-	0xbe, 0x38, 0x76, 0xaa, 0x86, 0xde, 0xcc, 0xe8, 0xcf, 0x0d, 0xbc, 0x82, 0xfb, 0xf4, 0xc8, 0xd9,
-	0x58, 0xc1, 0x7d, 0x7a, 0xb4, 0x10, 0x6f, 0x84, 0xa1, 0x73, 0x7b, 0x21, 0xde, 0x73, 0x0c, 0x17,
-	0x58, 0x43, 0xbc, 0x72, 0x6a, 0x0b, 0xac, 0x57, 0x78, 0x45, 0xce, 0x60, 0x67, 0x8a, 0x7d, 0x3a,
-	0xd6, 0x43, 0xe1, 0xf9, 0x0a, 0x19, 0x0a, 0xcd, 0x69, 0xe0, 0xc0, 0x41, 0xa9, 0x53, 0x3f, 0xdc,
-	0xef, 0xda, 0x5e, 0x76, 0x7f, 0xc0, 0xfe, 0x49, 0x42, 0xf9, 0x3a, 0x63, 0xb8, 0x64, 0x7e, 0x2d,
-	0xc7, 0xc8, 0x21, 0xdc, 0xed, 0x07, 0xd2, 0x1f, 0xf9, 0x43, 0xca, 0x85, 0x47, 0x7d, 0x5f, 0x8e,
-	0x85, 0xf6, 0x38, 0x73, 0xea, 0xc6, 0xf3, 0x4e, 0x6e, 0x3c, 0xb1, 0xb6, 0x53, 0xd6, 0xfe, 0xa5,
-	0x04, 0xff, 0x5f, 0xee, 0xbe, 0x8b, 0x17, 0xa8, 0x50, 0xf8, 0x48, 0x8e, 0x60, 0x6f, 0x55, 0xdb,
-	0x33, 0x69, 0xec, 0x2e, 0x37, 0xfd, 0x94, 0x91, 0x9f, 0xe0, 0x1e, 0x86, 0x7d, 0x64, 0x0c, 0x99,
-	0xb7, 0xe2, 0xba, 0x11, 0x4c, 0x21, 0xbf, 0x15, 0x01, 0xec, 0xcf, 0xef, 0x2f, 0xdb, 0xda, 0x7f,
-	0x96, 0x61, 0xe3, 0x2d, 0xaa, 0x09, 0xf7, 0x71, 0x49, 0xa6, 0x1f, 0x43, 0x23, 0xb6, 0xa6, 0xa2,
-	0x34, 0xeb, 0x29, 0x66, 0xf4, 0xf8, 0x10, 0x9a, 0x31, 0x17, 0x83, 0x00, 0x3d, 0x14, 0x2c, 0x92,
-	0x5c, 0xe8, 0x54, 0x94, 0x9b, 0x16, 0x7e, 0x91, 0xa2, 0xe4, 0x25, 0x10, 0xa3, 0xb3, 0xa8, 0x40,
-	0x8d, 0x8d, 0x38, 0xeb, 0x87, 0xce, 0x3c, 0xf6, 0x34, 0x90, 0xf9, 0xa5, 0xd8, 0xdd, 0x9e, 0xdf,
-	0xc9, 0x20, 0xf2, 0x29, 0x6c, 0xf9, 0x32, 0x8c, 0x02, 0xbc, 0xca, 0x5d, 0x26, 0xba, 0x6d, 0xb8,
-	0xcd, 0x14, 0xcf, 0x7c, 0x7e, 0x05, 0x10, 0x29, 0x19, 0xa1, 0xd2, 0x1c, 0x63, 0xa7, 0x7a, 0x50,
-	0xe9, 0xd4, 0x0f, 0xef, 0x2f, 0xf8, 0xea, 0x9e, 0x67, 0x8c, 0x17, 0x42, 0xab, 0x99, 0x5b, 0xb8,
-	0xb2, 0xff, 0x0c, 0x9a, 0x0b, 0x66, 0xb2, 0x05, 0x95, 0x11, 0xce, 0xd2, 0x22, 0x25, 0x47, 0xb2,
-	0x0b, 0xeb, 0x13, 0x1a, 0x8c, 0x31, 0x2d, 0x8f, 0x7d, 0xf8, 0xb2, 0x7c, 0x5c, 0x6a, 0x3f, 0x86,
-	0xad, 0xc5, 0x8c, 0xc8, 0x3d, 0xa8, 0xe5, 0xe9, 0x97, 0x0e, 0x2a, 0x9d, 0x9a, 0x9b, 0x03, 0xed,
-	0xbf, 0x2b, 0x40, 0x96, 0x05, 0x4a, 0x1e, 0xc0, 0x9d, 0x5c, 0xd0, 0xb9, 0x5e, 0x1a, 0x39, 0x78,
-	0xca, 0xc8, 0x47, 0x00, 0xf9, 0x90, 0x98, 0x60, 0x1a, 0x6e, 0x2d, 0x1b, 0x90, 0xc4, 0x31, 0x0d,
-	0x06, 0x52, 0x71, 0x3d, 0x0c, 0x4d, 0x8f, 0xd6, 0xdd, 0x1c, 0x48, 0xaa, 0x4a, 0xb5, 0xc6, 0x58,
-	0x5b, 0x69, 0x25, 0x4b, 0x30, 0xdd, 0x1c, 0xcd, 0x02, 0xfe, 0xfd, 0x2c, 0x42, 0xb2, 0x07, 0x55,
-	0xa9, 0xf8, 0x80, 0x8b, 0x74, 0x5d, 0xa4, 0x4f, 0x89, 0x7f, 0x5f, 0x21, 0xd5, 0xc8, 0x3c, 0xaa,
-	0xcd, 0x6e, 0xa8, 0xb8, 0xb5, 0x14, 0x39, 0xd1, 0x64, 0x07, 0xd6, 0x55, 0x94, 0xc4, 0x6e, 0x37,
-	0xc1, 0x2d, 0x15, 0x9d, 0x32, 0xf2, 0x3f, 0xd8, 0x50, 0x91, 0x27, 0x68, 0x88, 0xe9, 0xdc, 0x57,
-	0x55, 0xf4, 0x2d, 0x0d, 0x31, 0xd9, 0x73, 0x5a, 0x51, 0x11, 0x47, 0x52, 0xe9, 0xd8, 0xa9, 0x99,
-	0x3a, 0x15, 0x90, 0xa4, 0x22, 0xe3, 0x18, 0x55, 0x3a, 0x10, 0xc8, 0xcc, 0x94, 0xdf, 0x76, 0x1b,
-	0x09, 0xf8, 0x2e, 0xc5, 0x48, 0x0f, 0x76, 0x62, 0x3e, 0x10, 0x54, 0x8f, 0x15, 0x7a, 0x79, 0xf2,
-	0x76, 0x82, 0x49, 0x66, 0x3a, 0xc9, 0xaa, 0x70, 0x17, 0xaa, 0x8a, 0x4e, 0x93, 0x20, 0x1b, 0xb6,
-	0x97, 0x8a, 0x4e, 0x4f, 0x19, 0xe9, 0xc0, 0x96, 0x1f, 0x70, 0x14, 0xda, 0x63, 0x54, 0x53, 0xef,
-	0x32, 0x96, 0xc2, 0xb9, 0x63, 0x55, 0x6e, 0xf1, 0x6f, 0xa8, 0xa6, 0xaf, 0x63, 0x29, 0xc8, 0x23,
-	0x20, 0xc5, 0x32, 0xca, 0xfe, 0x25, 0xfa, 0xda, 0xd9, 0x34, 0xdc, 0xed, 0x82, 0xe5, 0x3b, 0x63,
-	0x68, 0xff, 0x5a, 0x86, 0x66, 0xde, 0xe6, 0x73, 0x25, 0xe5, 0x85, 0x69, 0x63, 0x72, 0xb0, 0x23,
-	0x67, 0x1b, 0x5d, 0x33, 0x88, 0x19, 0x38, 0x07, 0x36, 0xd2, 0x9a, 0xa6, 0x7a, 0x9b, 0x3f, 0x26,
-	0xd9, 0xae, 0x5a, 0x0f, 0x76, 0x1c, 0xc9, 0xf2, 0x6a, 0x49, 0x6a, 0x68, 0x3d, 0x45, 0x63, 0x15,
-	0xc9, 0x18, 0xb3, 0x9f, 0x8a, 0x04, 0x3c, 0xb7, 0x58, 0x22, 0x9b, 0xac, 0x50, 0x69, 0xc3, 0x73,
-	0x80, 0xbc, 0x5c, 0x31, 0x61, 0x0f, 0xe7, 0x13, 0xb6, 0x90, 0xd9, 0x87, 0x9c, 0xb4, 0xdf, 0x4b,
-	0xb0, 0x95, 0xbb, 0x7b, 0xab, 0xa9, 0x1e, 0xc7, 0x4b, 0xeb, 0xec, 0x3e, 0xd4, 0x63, 0x63, 0x29,
-	0x6e, 0x33, 0xb0, 0x90, 0xa9, 0xed, 0xab, 0xff, 0x64, 0x53, 0x31, 0xd9, 0x74, 0x96, 0xb3, 0xb1,
-	0xaf, 0xff, 0x80, 0xe9, 0x3c, 0x7f, 0xf6, 0xdb, 0x75, 0xab, 0xf4, 0xfe, 0xba, 0x55, 0xfa, 0xeb,
-	0xba, 0x55, 0xfa, 0xf9, 0xa6, 0xb5, 0xf6, 0xfe, 0xa6, 0xb5, 0xf6, 0xc7, 0x4d, 0x6b, 0xed, 0xc7,
-	0x07, 0x03, 0xae, 0x87, 0xe3, 0x7e, 0xd7, 0x97, 0x61, 0x2f, 0x96, 0x42, 0x3d, 0xe2, 0xd2, 0xfc,
-	0xef, 0x5d, 0xf5, 0x92, 0xef, 0x17, 0xf3, 0xf1, 0xd2, 0xaf, 0x9a, 0x8f, 0x91, 0xcf, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x86, 0x7f, 0xe1, 0x22, 0xd3, 0x08, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0xed, 0xc4, 0xa9, 0xc7, 0x6e, 0xec, 0x4c, 0xd2, 0x30, 0x0a, 0xc5, 0x0d, 0x2e, 0x52,
+	0x0d, 0x52, 0xed, 0x36, 0x84, 0x2a, 0x42, 0xaa, 0x50, 0x0a, 0xa1, 0x4d, 0xa3, 0x42, 0xe4, 0xa2,
+	0x22, 0x21, 0xa4, 0xd5, 0xec, 0xce, 0x89, 0x3d, 0xf1, 0xee, 0xce, 0x6a, 0x76, 0xd6, 0x89, 0xdf,
+	0x82, 0x57, 0xe1, 0x25, 0x10, 0x17, 0x5c, 0xf4, 0x12, 0xee, 0x50, 0xf2, 0x18, 0x5c, 0x50, 0xcd,
+	0xcc, 0x7a, 0x77, 0x6b, 0xfb, 0xb6, 0x37, 0xc9, 0xce, 0x77, 0xbe, 0xd9, 0xf3, 0xf7, 0x9d, 0xe3,
+	0x45, 0x98, 0x71, 0xd6, 0x9f, 0x3c, 0xee, 0xab, 0x69, 0x04, 0x71, 0x2f, 0x92, 0x42, 0x09, 0x5c,
+	0x65, 0x9c, 0xf5, 0x26, 0x8f, 0x77, 0x37, 0x69, 0xc0, 0x43, 0xd1, 0x37, 0x7f, 0xad, 0x69, 0x77,
+	0x7b, 0x28, 0x86, 0xc2, 0x3c, 0xf6, 0xf5, 0x93, 0x45, 0x3b, 0xff, 0x55, 0x10, 0x7e, 0x03, 0x92,
+	0x9f, 0x73, 0x8f, 0x2a, 0x2e, 0xc2, 0x57, 0xa0, 0x46, 0x82, 0xe1, 0x0d, 0x54, 0xe6, 0x8c, 0x94,
+	0xf6, 0x4a, 0xdd, 0xda, 0xa0, 0xcc, 0x19, 0x3e, 0x44, 0x64, 0x52, 0x60, 0x39, 0x81, 0xa1, 0x39,
+	0x63, 0x1e, 0x32, 0x52, 0x36, 0xac, 0x9d, 0xc9, 0xc2, 0x5b, 0x4e, 0x79, 0xc8, 0x70, 0x1b, 0x21,
+	0x4f, 0x84, 0x4a, 0x0a, 0xdf, 0x07, 0x49, 0x2a, 0x86, 0x5b, 0x40, 0xf0, 0x67, 0x68, 0x23, 0x4a,
+	0x5c, 0x9f, 0x7b, 0xce, 0x18, 0xa6, 0xce, 0xc5, 0xe5, 0x98, 0xac, 0x1a, 0x4e, 0xc3, 0xa2, 0xa7,
+	0x30, 0x7d, 0x79, 0x39, 0xc6, 0x8f, 0xd0, 0x76, 0x81, 0x15, 0x24, 0xbe, 0xe2, 0x2e, 0x8d, 0x81,
+	0xac, 0x19, 0x2e, 0xce, 0xb8, 0xaf, 0x66, 0x16, 0xfc, 0x05, 0xda, 0x2c, 0xdc, 0xd0, 0xd0, 0x57,
+	0x87, 0xa4, 0x6a, 0xe8, 0xcd, 0x8c, 0xfe, 0xcc, 0xc0, 0x4b, 0xb8, 0x4f, 0x0e, 0xc8, 0xfa, 0x12,
+	0xee, 0x93, 0x83, 0xb9, 0x78, 0x23, 0x08, 0xc8, 0xad, 0xb9, 0x78, 0xcf, 0x20, 0x98, 0x63, 0x8d,
+	0xe0, 0x8a, 0xd4, 0xe6, 0x58, 0x2f, 0xe0, 0x0a, 0x9f, 0xa2, 0xad, 0x4b, 0x70, 0x69, 0xa2, 0x46,
+	0xa1, 0xe3, 0x49, 0x60, 0x10, 0x2a, 0x4e, 0x7d, 0x82, 0xf6, 0x4a, 0xdd, 0xfa, 0xfe, 0x6e, 0xcf,
+	0xf6, 0xb2, 0xf7, 0x33, 0xb8, 0x47, 0x9a, 0xf2, 0x6d, 0xc6, 0x18, 0xe0, 0xd9, 0xb5, 0x1c, 0xc3,
+	0xfb, 0xe8, 0x8e, 0xeb, 0x0b, 0x6f, 0xec, 0x8d, 0x28, 0x0f, 0x1d, 0xea, 0x79, 0x22, 0x09, 0x95,
+	0xc3, 0x19, 0xa9, 0x1b, 0xcf, 0x5b, 0xb9, 0xf1, 0xc8, 0xda, 0x4e, 0x58, 0xe7, 0xf7, 0x12, 0xfa,
+	0x78, 0xb1, 0xfb, 0x03, 0x38, 0x07, 0x09, 0xa1, 0x07, 0xf8, 0x00, 0xed, 0x2c, 0x6b, 0x7b, 0x26,
+	0x8d, 0xed, 0xc5, 0xa6, 0x9f, 0x30, 0xfc, 0x2b, 0xba, 0x0b, 0x81, 0x0b, 0x8c, 0x01, 0x73, 0x96,
+	0x5c, 0x27, 0xe5, 0xf7, 0xf3, 0x5b, 0x12, 0xc0, 0xee, 0xec, 0xfe, 0xa2, 0xad, 0xf3, 0x4f, 0x19,
+	0xad, 0xbf, 0x06, 0x39, 0xe1, 0x1e, 0x2c, 0xc8, 0xf4, 0x53, 0xd4, 0x88, 0xad, 0xa9, 0x28, 0xcd,
+	0x7a, 0x8a, 0x19, 0x3d, 0x3e, 0x40, 0xcd, 0x98, 0x87, 0x43, 0x1f, 0x1c, 0x08, 0x59, 0x24, 0x78,
+	0xa8, 0x52, 0x51, 0x6e, 0x58, 0xf8, 0x38, 0x45, 0xf1, 0x73, 0x84, 0x8d, 0xce, 0xa2, 0x02, 0x35,
+	0x36, 0xe2, 0xac, 0xef, 0x93, 0x59, 0xec, 0x69, 0x20, 0xb3, 0x4b, 0xf1, 0x60, 0x73, 0x76, 0x27,
+	0x83, 0xf0, 0xe7, 0xa8, 0xe5, 0x89, 0x20, 0xf2, 0xe1, 0x2a, 0x77, 0xa9, 0x75, 0xdb, 0x18, 0x34,
+	0x53, 0x3c, 0xf3, 0xf9, 0x0d, 0x42, 0x91, 0x14, 0x11, 0x48, 0xc5, 0x21, 0x26, 0xd5, 0xbd, 0x4a,
+	0xb7, 0xbe, 0x7f, 0x6f, 0xce, 0x57, 0xef, 0x2c, 0x63, 0x1c, 0x87, 0x4a, 0x4e, 0x07, 0x85, 0x2b,
+	0xbb, 0x4f, 0x51, 0x73, 0xce, 0x8c, 0x5b, 0xa8, 0x32, 0x86, 0x69, 0x5a, 0x24, 0xfd, 0x88, 0xb7,
+	0xd1, 0xda, 0x84, 0xfa, 0x09, 0xa4, 0xe5, 0xb1, 0x87, 0xaf, 0xcb, 0x87, 0xa5, 0xce, 0x23, 0xd4,
+	0x9a, 0xcf, 0x08, 0xdf, 0x45, 0xb5, 0x3c, 0xfd, 0xd2, 0x5e, 0xa5, 0x5b, 0x1b, 0xe4, 0x40, 0xe7,
+	0xff, 0x55, 0x84, 0x17, 0x05, 0x8a, 0xef, 0xa3, 0xdb, 0xb9, 0xa0, 0x73, 0xbd, 0x34, 0x72, 0xf0,
+	0x84, 0xe1, 0x4f, 0x10, 0xca, 0x87, 0xc4, 0x04, 0xd3, 0x18, 0xd4, 0xb2, 0x01, 0xd1, 0x8e, 0xa9,
+	0x3f, 0x14, 0x92, 0xab, 0x51, 0x60, 0x7a, 0xb4, 0x36, 0xc8, 0x01, 0x5d, 0x55, 0xaa, 0x14, 0xc4,
+	0xca, 0x4a, 0x4b, 0x2f, 0xc1, 0x74, 0x73, 0x34, 0x0b, 0xf8, 0x4f, 0xd3, 0x08, 0xf0, 0x0e, 0xaa,
+	0x0a, 0xc9, 0x87, 0x3c, 0x4c, 0xd7, 0x45, 0x7a, 0xd2, 0xfe, 0x3d, 0x09, 0x54, 0x01, 0x73, 0xa8,
+	0x32, 0xbb, 0xa1, 0x32, 0xa8, 0xa5, 0xc8, 0x91, 0xc2, 0x5b, 0x68, 0x4d, 0x46, 0x3a, 0x76, 0xbb,
+	0x09, 0x56, 0x65, 0x74, 0xc2, 0xf0, 0x47, 0x68, 0x5d, 0x46, 0x4e, 0x48, 0x03, 0x48, 0xe7, 0xbe,
+	0x2a, 0xa3, 0x1f, 0x68, 0x00, 0x7a, 0xcf, 0x29, 0x49, 0xc3, 0x38, 0x12, 0x52, 0xc5, 0xa4, 0x66,
+	0xea, 0x54, 0x40, 0x74, 0x45, 0x92, 0x18, 0x64, 0x3a, 0x10, 0xc0, 0xcc, 0x94, 0xdf, 0x1a, 0x34,
+	0x34, 0xf8, 0x26, 0xc5, 0x70, 0x1f, 0x6d, 0xc5, 0x7c, 0x18, 0x52, 0x95, 0x48, 0x70, 0xf2, 0xe4,
+	0xed, 0x04, 0xe3, 0xcc, 0x74, 0x94, 0x55, 0xe1, 0x0e, 0xaa, 0x4a, 0x7a, 0xa9, 0x83, 0x6c, 0xd8,
+	0x5e, 0x4a, 0x7a, 0x79, 0xc2, 0x70, 0x17, 0xb5, 0x3c, 0x9f, 0x43, 0xa8, 0x1c, 0x46, 0x15, 0x75,
+	0x2e, 0x62, 0x11, 0x92, 0xdb, 0x56, 0xe5, 0x16, 0xff, 0x8e, 0x2a, 0xfa, 0x32, 0x16, 0x21, 0x7e,
+	0x88, 0x70, 0xb1, 0x8c, 0xc2, 0xbd, 0x00, 0x4f, 0x91, 0x0d, 0xc3, 0xdd, 0x2c, 0x58, 0x7e, 0x34,
+	0x86, 0x79, 0xfa, 0xb9, 0x90, 0x01, 0x55, 0xa4, 0xb9, 0x40, 0xff, 0xde, 0x18, 0x74, 0xe5, 0x29,
+	0x1d, 0x26, 0x9c, 0x91, 0x96, 0xe9, 0x6e, 0x7a, 0xd2, 0x43, 0xe8, 0x52, 0x6f, 0x9c, 0x44, 0x0e,
+	0xf8, 0x7c, 0xc8, 0x5d, 0x1f, 0xc8, 0xa6, 0x29, 0xc7, 0x86, 0x85, 0x8f, 0x53, 0x54, 0x0f, 0x74,
+	0x4a, 0xd4, 0x2f, 0x06, 0x82, 0x0d, 0xab, 0x6e, 0xb1, 0xd7, 0x1a, 0xea, 0xfc, 0x51, 0x46, 0xcd,
+	0x5c, 0x79, 0x67, 0x52, 0x88, 0x73, 0xa3, 0x2c, 0xfd, 0x60, 0xb7, 0x80, 0xd5, 0x5e, 0xcd, 0x20,
+	0x66, 0x07, 0x10, 0xb4, 0x9e, 0xb6, 0x39, 0x1d, 0x81, 0xd9, 0x51, 0x37, 0x60, 0xd9, 0xc6, 0xb2,
+	0x1b, 0x02, 0x2f, 0x6e, 0x3b, 0xdd, 0x56, 0xeb, 0x29, 0x4a, 0x64, 0x24, 0x62, 0xc8, 0x7e, 0xbd,
+	0x34, 0x78, 0x66, 0x31, 0xad, 0xe4, 0xac, 0x77, 0xa9, 0x06, 0x73, 0x00, 0x3f, 0x5f, 0x32, 0xf4,
+	0x0f, 0x66, 0x43, 0x3f, 0x97, 0xd9, 0x87, 0x1c, 0xfe, 0xbf, 0x4a, 0xa8, 0x95, 0xbb, 0xd3, 0xc5,
+	0x4d, 0xe2, 0x85, 0x0d, 0x7b, 0x0f, 0xd5, 0x63, 0x63, 0x29, 0x2e, 0x58, 0x64, 0x21, 0x53, 0xdb,
+	0x17, 0xef, 0x65, 0x53, 0x31, 0xd9, 0x74, 0x17, 0xb3, 0xb1, 0xaf, 0xff, 0x80, 0xe9, 0x3c, 0x7b,
+	0xfa, 0xe7, 0x75, 0xbb, 0xf4, 0xf6, 0xba, 0x5d, 0xfa, 0xf7, 0xba, 0x5d, 0xfa, 0xed, 0xa6, 0xbd,
+	0xf2, 0xf6, 0xa6, 0xbd, 0xf2, 0xf7, 0x4d, 0x7b, 0xe5, 0x97, 0xfb, 0x43, 0xae, 0x46, 0x89, 0xdb,
+	0xf3, 0x44, 0xd0, 0x8f, 0x45, 0x28, 0x1f, 0x72, 0x61, 0xfe, 0xf7, 0xaf, 0xfa, 0xfa, 0x93, 0xca,
+	0x7c, 0x4f, 0xb9, 0x55, 0xf3, 0x7d, 0xf4, 0xe5, 0xbb, 0x01, 0x00, 0x7e, 0xce, 0xa0, 0x06, 0x66,
+	0x09, 0x00, 0x00,
 }
 
 func (m *VerificationMethod) Marshal() (dAtA []byte, err error) {
@@ -1036,6 +1078,46 @@ func (m *WebAuthnCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BackupState {
+		i--
+		if m.BackupState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BackupEligible {
+		i--
+		if m.BackupEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Aaguid) > 0 {
+		i -= len(m.Aaguid)
+		copy(dAtA[i:], m.Aaguid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Aaguid)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.AttestationFormat) > 0 {
+		i -= len(m.AttestationFormat)
+		copy(dAtA[i:], m.AttestationFormat)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AttestationFormat)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.AttestationObject) > 0 {
 		i -= len(m.AttestationObject)
 		copy(dAtA[i:], m.AttestationObject)
@@ -1465,6 +1547,20 @@ func (m *WebAuthnCredential) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.AttestationFormat)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Aaguid)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.BackupEligible {
+		n += 3
+	}
+	if m.BackupState {
+		n += 3
+	}
 	return n
 }
 
@@ -2926,6 +3022,112 @@ func (m *WebAuthnCredential) Unmarshal(dAtA []byte) error {
 			}
 			m.AttestationObject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aaguid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aaguid = append(m.Aaguid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Aaguid == nil {
+				m.Aaguid = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackupEligible = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackupState = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ const (
 	UCANRegister   = "register"   // Register DID with controller
 	UCANUpdate     = "update"     // Update DID document
 	UCANDeactivate = "deactivate" // Deactivate DID document
+	UCANReactivate = "reactivate" // Reactivate deactivated DID document
 	UCANRevoke     = "revoke"     // Revoke DID document (stronger than deactivate)
 
 	// Verification Method Actions
@@ -49,6 +50,7 @@ const (
 	DIDOpRegister                 DIDOperation = "register"
 	DIDOpUpdate                   DIDOperation = "update"
 	DIDOpDeactivate               DIDOperation = "deactivate"
+	DIDOpReactivate               DIDOperation = "reactivate"
 	DIDOpRevoke                   DIDOperation = "revoke"
 	DIDOpAddVerificationMethod    DIDOperation = "add_verification_method"
 	DIDOpRemoveVerificationMethod DIDOperation = "remove_verification_method"
@@ -84,6 +86,8 @@ func (m *UCANCapabilityMapper) GetUCANCapabilitiesForOperation(operation DIDOper
 		return []string{UCANUpdate}
 	case DIDOpDeactivate:
 		return []string{UCANDeactivate, UCANUpdate}
+	case DIDOpReactivate:
+		return []string{UCANReactivate, UCANUpdate}
 	case DIDOpRevoke:
 		return []string{UCANRevoke, UCANDelete, UCANAdmin}
 
@@ -296,7 +300,7 @@ func NewUCANPermissionRegistry() *UCANPermissionRegistry {
 // initializeDefaultCapabilities sets up default capability mappings
 func (r *UCANPermissionRegistry) initializeDefaultCapabilities() {
 	operations := []DIDOperation{
-		DIDOpCreate, DIDOpRegister, DIDOpUpdate, DIDOpDeactivate, DIDOpRevoke,
+		DIDOpCreate, DIDOpRegister, DIDOpUpdate, DIDOpDeactivate, DIDOpReactivate, DIDOpRevoke,
 		DIDOpAddVerificationMethod, DIDOpRemoveVerificationMethod,
 		DIDOpAddService, DIDOpRemoveService,
 		DIDOpIssueCredential, DIDOpRevokeCredential,
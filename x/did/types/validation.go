@@ -217,23 +217,21 @@ func GenerateAddressFromCredential(credentialID string) string {
 	return "0x" + address
 }
 
-// GenerateDIDFromCredential generates a deterministic DID from a WebAuthn credential
+// GenerateDIDFromCredential generates a deterministic DID from a WebAuthn
+// credential ID when the public key is not available. It uses the checksummed
+// encoding of DeriveDIDFromPublicKey under its own version byte and hashing
+// domain, so it never matches a key-derived DID and VerifyDIDForPublicKey
+// rejects it.
 func GenerateDIDFromCredential(credentialID, username string) string {
 	if credentialID == "" {
 		return ""
 	}
 
-	// Create a hash of credential ID + username for uniqueness
+	// Bind the username so the same credential ID cannot map to another account
 	data := credentialID
 	if username != "" {
 		data = credentialID + ":" + username
 	}
 
-	hash := sha256.Sum256([]byte(data))
-
-	// Encode as base58-like string (using hex for simplicity)
-	identifier := hex.EncodeToString(hash[:16])
-
-	// Return DID in did:sonr format
-	return fmt.Sprintf("did:sonr:%s", identifier)
+	return deriveDID(credentialDIDVersion, credentialDIDDomain, []byte(data))
 }
@@ -21,3 +21,11 @@ func (c *WebAuthnCredential) ValidateForGaslessRegistration(
 ) error {
 	return ValidateForGaslessRegistration(c, challenge, expectedOrigin)
 }
+
+// RPCredential is a WebAuthn credential registered with a relying party and
+// the DID document holding it
+type RPCredential struct {
+	DID         string
+	AlsoKnownAs []string
+	Credential  *WebAuthnCredential
+}
@@ -28,6 +28,9 @@ func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"schema":         {Usage: "Filter by schema URI"},
 						"parent_id":      {Usage: "Filter by parent record ID"},
 						"published_only": {Usage: "Filter to show only published records"},
+						"include_expired": {
+							Usage: "Include expired records that were not swept yet",
+						},
 					},
 				},
 				{
@@ -90,6 +93,19 @@ func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"owner": {Usage: "Filter by owner address (defaults to sender)"},
 					},
 				},
+				{
+					RpcMethod: "Storage",
+					Use:       "storage [did]",
+					Short:     "Query the storage used by a DID and its quota",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
+				{
+					RpcMethod: "StorageAccounts",
+					Use:       "storage-accounts",
+					Short:     "Query the storage used by every metered DID",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -116,6 +132,10 @@ func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"schema":        {Usage: "Schema URI for data validation"},
 						"parent_id":     {Usage: "Parent record ID for threading"},
 						"published":     {Usage: "Mark record as published"},
+						"ttl":           {Usage: "Seconds until the record expires (0 never expires)"},
+						"attachment_cids": {
+							Usage: "CIDs of attachments to unpin once no record references them",
+						},
 					},
 				},
 				{
@@ -168,6 +188,15 @@ func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"authorization": {Usage: "Authorization JWT or signature"},
 					},
 				},
+				{
+					RpcMethod: "PurchaseStorage",
+					Use:       "purchase-storage [did] [size-mib]",
+					Short:     "Purchases additional storage quota for a DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+						{ProtoField: "size_mib"},
+					},
+				},
 			},
 		},
 	}
@@ -0,0 +1,61 @@
+package attachments
+
+import (
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// DefaultPruneInterval is how often expired uploads are pruned
+const DefaultPruneInterval = time.Hour
+
+// ServiceConfig is the [attachments] section of app.toml, which serves the
+// endpoints from the node's API server
+type ServiceConfig struct {
+	// Enabled mounts the endpoints on the API server.
+	Enabled bool `mapstructure:"enabled"`
+	// Dir holds in-progress uploads and the download cache; empty uses
+	// attachments under the node home.
+	Dir string `mapstructure:"dir"`
+	// UploadExpiry is how long incomplete uploads are kept.
+	UploadExpiry time.Duration `mapstructure:"upload-expiry"`
+}
+
+// DefaultServiceConfig returns the default (disabled) configuration
+func DefaultServiceConfig() ServiceConfig {
+	return ServiceConfig{UploadExpiry: DefaultUploadExpiry}
+}
+
+// ServiceConfigFromAppOptions reads the [attachments] section of app.toml.
+func ServiceConfigFromAppOptions(appOpts servertypes.AppOptions) ServiceConfig {
+	cfg := DefaultServiceConfig()
+	cfg.Enabled = cast.ToBool(appOpts.Get("attachments.enabled"))
+	cfg.Dir = cast.ToString(appOpts.Get("attachments.dir"))
+	if v := cast.ToDuration(appOpts.Get("attachments.upload-expiry")); v > 0 {
+		cfg.UploadExpiry = v
+	}
+	return cfg
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                              Attachments                                ###
+###############################################################################
+
+# Serves resumable attachment uploads and ranged downloads under /attachments
+# on the API server. Uploads are not authenticated; enable this only behind a
+# proxy that authenticates the owner named in Upload-Metadata.
+[attachments]
+
+enabled = {{ .Attachments.Enabled }}
+
+# Directory for in-progress uploads and the download cache. Empty uses
+# attachments under the node home.
+dir = "{{ .Attachments.Dir }}"
+
+# How long incomplete uploads are kept.
+upload-expiry = "{{ .Attachments.UploadExpiry }}"
+`
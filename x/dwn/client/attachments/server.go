@@ -0,0 +1,171 @@
+// Package attachments serves DWN attachments to clients that cannot hold a
+// whole blob in memory. Uploads follow the tus resumable upload protocol with
+// a SHA-256 checksum on every chunk, and downloads honour HTTP Range requests
+// with a digest of each returned range.
+package attachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+var logger = log.NewLogger(os.Stderr).With("module", "attachments")
+
+// DefaultUploadExpiry is how long an incomplete upload is kept
+const DefaultUploadExpiry = 24 * time.Hour
+
+// Uploader pins a completed attachment and returns its CID. The keeper's
+// UploadToIPFS satisfies it.
+type Uploader func(ctx context.Context, u types.Upload) (string, error)
+
+// Fetcher returns the content of a pinned attachment
+type Fetcher func(ctx context.Context, cid string) ([]byte, error)
+
+// Config configures a Server
+type Config struct {
+	// Dir holds in-progress uploads and the download cache
+	Dir string
+	// Policy limits attachment size and content type. MaxAttachmentSize is
+	// enforced when an upload is created.
+	Policy types.UploadPolicy
+	// UploadExpiry is how long incomplete uploads are kept before PruneExpired
+	// removes them; zero uses DefaultUploadExpiry
+	UploadExpiry time.Duration
+}
+
+// Server stages resumable uploads on disk and serves ranged downloads
+type Server struct {
+	uploadDir string
+	cacheDir  string
+	policy    types.UploadPolicy
+	expiry    time.Duration
+	uploader  Uploader
+	fetcher   Fetcher
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewServer creates a server. fetcher may be nil, in which case only
+// attachments uploaded through this server can be downloaded.
+func NewServer(cfg Config, uploader Uploader, fetcher Fetcher) (*Server, error) {
+	if uploader == nil {
+		return nil, errors.New("attachments: uploader is required")
+	}
+	if err := cfg.Policy.Validate(); err != nil {
+		return nil, fmt.Errorf("attachments: %w", err)
+	}
+
+	s := &Server{
+		uploadDir: filepath.Join(cfg.Dir, "uploads"),
+		cacheDir:  filepath.Join(cfg.Dir, "cache"),
+		policy:    cfg.Policy,
+		expiry:    cfg.UploadExpiry,
+		uploader:  uploader,
+		fetcher:   fetcher,
+		locks:     make(map[string]*sync.Mutex),
+	}
+	if s.expiry == 0 {
+		s.expiry = DefaultUploadExpiry
+	}
+	for _, dir := range []string{s.uploadDir, s.cacheDir} {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return nil, fmt.Errorf("attachments: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// RegisterRoutes registers the upload and download endpoints. Middleware,
+// e.g. authentication, is applied to every route.
+func RegisterRoutes(e *echo.Echo, s *Server, m ...echo.MiddlewareFunc) {
+	g := e.Group("/attachments", m...)
+	g.OPTIONS("/uploads", s.HandleOptions)
+	g.POST("/uploads", s.HandleCreate)
+	g.HEAD("/uploads/:id", s.HandleStatus)
+	g.PATCH("/uploads/:id", s.HandlePatch)
+	g.DELETE("/uploads/:id", s.HandleTerminate)
+	g.GET("/:cid", s.HandleDownload)
+	g.HEAD("/:cid", s.HandleDownload)
+}
+
+// lock returns the mutex serializing writes to an upload
+func (s *Server) lock(id string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.locks[id]
+	if !ok {
+		l = &sync.Mutex{}
+		s.locks[id] = l
+	}
+	return l
+}
+
+// forget drops the mutex of a finished or removed upload
+func (s *Server) forget(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.locks, id)
+}
+
+// PruneExpired removes uploads that were created more than the upload expiry
+// before now and returns how many were removed
+func (s *Server) PruneExpired(now time.Time) (int, error) {
+	entries, err := os.ReadDir(s.uploadDir)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		id, ok := sessionID(entry.Name())
+		if !ok {
+			continue
+		}
+		l := s.lock(id)
+		if !l.TryLock() {
+			continue // being written to
+		}
+		session, err := s.loadSession(id)
+		if err == nil && now.Before(session.ExpiresAt(s.expiry)) {
+			l.Unlock()
+			continue
+		}
+		s.removeSession(id)
+		l.Unlock()
+		s.forget(id)
+		removed++
+	}
+	return removed, nil
+}
+
+// Start prunes expired uploads every interval until ctx is cancelled
+func (s *Server) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if n, err := s.PruneExpired(now); err != nil {
+					logger.Error("failed to prune attachment uploads", "error", err)
+				} else if n > 0 {
+					logger.Info("pruned expired attachment uploads", "count", n)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,213 @@
+package attachments_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/client/attachments"
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+const testCID = "bafkreitestattachment"
+
+type testServer struct {
+	e       *echo.Echo
+	s       *attachments.Server
+	pinned  map[string][]byte
+	pinErr  error
+	fetched int
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	ts := &testServer{e: echo.New(), pinned: map[string][]byte{}}
+
+	policy := types.DefaultUploadPolicy()
+	policy.MaxAttachmentSize = 8 << 20
+	policy.ProfileQuota = 0
+
+	uploader := func(_ context.Context, u types.Upload) (string, error) {
+		if ts.pinErr != nil {
+			return "", ts.pinErr
+		}
+		ts.pinned[testCID] = u.Data
+		return testCID, nil
+	}
+	fetcher := func(_ context.Context, cid string) ([]byte, error) {
+		ts.fetched++
+		data, ok := ts.pinned[cid]
+		if !ok {
+			return nil, http.ErrMissingFile
+		}
+		return data, nil
+	}
+
+	s, err := attachments.NewServer(attachments.Config{Dir: t.TempDir(), Policy: policy}, uploader, fetcher)
+	require.NoError(t, err)
+	ts.s = s
+	attachments.RegisterRoutes(ts.e, s)
+	return ts
+}
+
+func (ts *testServer) do(method, path string, body []byte, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	req.Header.Set(attachments.HeaderTusResumable, attachments.TusVersion)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	ts.e.ServeHTTP(rec, req)
+	return rec
+}
+
+func (ts *testServer) create(t *testing.T, length int) string {
+	t.Helper()
+	meta := "owner " + base64.StdEncoding.EncodeToString([]byte("alice")) +
+		",filetype " + base64.StdEncoding.EncodeToString([]byte("application/octet-stream"))
+	rec := ts.do(http.MethodPost, "/attachments/uploads", nil, map[string]string{
+		attachments.HeaderUploadLength:   strconv.Itoa(length),
+		attachments.HeaderUploadMetadata: meta,
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	return rec.Header().Get(echo.HeaderLocation)
+}
+
+func (ts *testServer) patch(location string, offset int, chunk []byte, checksum []byte) *httptest.ResponseRecorder {
+	if checksum == nil {
+		sum := sha256.Sum256(chunk)
+		checksum = sum[:]
+	}
+	return ts.do(http.MethodPatch, location, chunk, map[string]string{
+		echo.HeaderContentType:           attachments.ContentTypeOffset,
+		attachments.HeaderUploadOffset:   strconv.Itoa(offset),
+		attachments.HeaderUploadChecksum: "sha256 " + base64.StdEncoding.EncodeToString(checksum),
+	})
+}
+
+func TestResumableUpload(t *testing.T) {
+	ts := newTestServer(t)
+	data := bytes.Repeat([]byte("0123456789abcdef"), 1024)
+	location := ts.create(t, len(data))
+
+	rec := ts.patch(location, 0, data[:10000], nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	require.Equal(t, "10000", rec.Header().Get(attachments.HeaderUploadOffset))
+
+	// A corrupted chunk is discarded
+	rec = ts.patch(location, 10000, data[10000:], make([]byte, sha256.Size))
+	require.Equal(t, attachments.StatusChecksumMismatch, rec.Code)
+
+	// Stale offsets are rejected
+	rec = ts.patch(location, 0, data[:10], nil)
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = ts.do(http.MethodHead, location, nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "10000", rec.Header().Get(attachments.HeaderUploadOffset))
+
+	rec = ts.patch(location, 10000, data[10000:], nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	require.Equal(t, testCID, rec.Header().Get(attachments.HeaderAttachmentCID))
+	require.Equal(t, data, ts.pinned[testCID])
+}
+
+func TestUploadRetriesPinning(t *testing.T) {
+	ts := newTestServer(t)
+	data := []byte("encrypted attachment")
+	location := ts.create(t, len(data))
+
+	ts.pinErr = http.ErrHandlerTimeout
+	rec := ts.patch(location, 0, data, nil)
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+
+	ts.pinErr = nil
+	rec = ts.do(http.MethodPatch, location, nil, map[string]string{
+		echo.HeaderContentType:         attachments.ContentTypeOffset,
+		attachments.HeaderUploadOffset: strconv.Itoa(len(data)),
+	})
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	require.Equal(t, testCID, rec.Header().Get(attachments.HeaderAttachmentCID))
+}
+
+func TestUploadRejections(t *testing.T) {
+	ts := newTestServer(t)
+
+	rec := ts.do(http.MethodPost, "/attachments/uploads", nil, map[string]string{
+		attachments.HeaderUploadLength:   strconv.Itoa(9 << 20),
+		attachments.HeaderUploadMetadata: "owner " + base64.StdEncoding.EncodeToString([]byte("alice")),
+	})
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = ts.do(http.MethodPost, "/attachments/uploads", nil, map[string]string{
+		attachments.HeaderUploadLength: "10",
+	})
+	require.Equal(t, http.StatusBadRequest, rec.Code, "owner is required")
+
+	location := ts.create(t, 4)
+	rec = ts.patch(location, 0, []byte("too long"), nil)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = ts.do(http.MethodDelete, location, nil, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = ts.do(http.MethodHead, location, nil, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRangedDownload(t *testing.T) {
+	ts := newTestServer(t)
+	data := bytes.Repeat([]byte("sonr"), 1000)
+	ts.pinned[testCID] = data
+
+	rec := ts.do(http.MethodGet, "/attachments/"+testCID, nil, map[string]string{"Range": "bytes=100-199"})
+	require.Equal(t, http.StatusPartialContent, rec.Code, rec.Body.String())
+	require.Equal(t, data[100:200], rec.Body.Bytes())
+	require.Equal(t, "bytes 100-199/4000", rec.Header().Get("Content-Range"))
+
+	chunkSum := sha256.Sum256(data[100:200])
+	require.Equal(t, "sha-256=:"+base64.StdEncoding.EncodeToString(chunkSum[:])+":",
+		rec.Header().Get(attachments.HeaderContentDigest))
+	fullSum := sha256.Sum256(data)
+	require.Equal(t, "sha-256=:"+base64.StdEncoding.EncodeToString(fullSum[:])+":",
+		rec.Header().Get(attachments.HeaderReprDigest))
+
+	rec = ts.do(http.MethodGet, "/attachments/"+testCID, nil, map[string]string{"Range": "bytes=-10"})
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	require.Equal(t, data[3990:], rec.Body.Bytes())
+
+	rec = ts.do(http.MethodGet, "/attachments/"+testCID, nil, map[string]string{"Range": "bytes=5000-"})
+	require.Equal(t, http.StatusRequestedRangeNotSatisfiable, rec.Code)
+
+	rec = ts.do(http.MethodGet, "/attachments/"+testCID, nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, data, rec.Body.Bytes())
+	require.Equal(t, 1, ts.fetched, "later requests are served from the cache")
+
+	rec = ts.do(http.MethodGet, "/attachments/bafkreimissing", nil, nil)
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+}
+
+func TestPruneExpired(t *testing.T) {
+	ts := newTestServer(t)
+	location := ts.create(t, 10)
+
+	removed, err := ts.s.PruneExpired(time.Now())
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	removed, err = ts.s.PruneExpired(time.Now().Add(attachments.DefaultUploadExpiry + time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	rec := ts.do(http.MethodHead, location, nil, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
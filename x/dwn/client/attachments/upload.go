@@ -0,0 +1,454 @@
+package attachments
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+// tus protocol constants
+const (
+	TusVersion     = "1.0.0"
+	TusExtensions  = "creation,expiration,checksum,termination"
+	TusChecksumAlg = "sha256"
+
+	// ContentTypeOffset is the content type of PATCH requests
+	ContentTypeOffset = "application/offset+octet-stream"
+
+	// StatusChecksumMismatch is the tus status for a chunk whose checksum does not match
+	StatusChecksumMismatch = 460
+)
+
+// Headers used by the upload and download endpoints
+const (
+	HeaderTusResumable   = "Tus-Resumable"
+	HeaderTusVersion     = "Tus-Version"
+	HeaderTusExtension   = "Tus-Extension"
+	HeaderTusMaxSize     = "Tus-Max-Size"
+	HeaderTusChecksumAlg = "Tus-Checksum-Algorithm"
+	HeaderUploadLength   = "Upload-Length"
+	HeaderUploadOffset   = "Upload-Offset"
+	HeaderUploadMetadata = "Upload-Metadata"
+	HeaderUploadChecksum = "Upload-Checksum"
+	HeaderUploadExpires  = "Upload-Expires"
+	HeaderAttachmentCID  = "Attachment-Cid"
+	HeaderReprDigest     = "Repr-Digest"
+	HeaderContentDigest  = "Content-Digest"
+)
+
+const (
+	metadataOwner        = "owner"
+	metadataFileType     = "filetype"
+	sessionFileSuffix    = ".json"
+	sessionDataSuffix    = ".bin"
+	uploadLocationPrefix = "/attachments/uploads/"
+)
+
+// Session is the persisted state of a resumable upload
+type Session struct {
+	ID        string    `json:"id"`
+	Owner     string    `json:"owner"`
+	MIMEType  string    `json:"mime_type,omitempty"`
+	Length    int64     `json:"length"`
+	Offset    int64     `json:"offset"`
+	CreatedAt time.Time `json:"created_at"`
+	// CID is set once the upload is complete and pinned
+	CID string `json:"cid,omitempty"`
+}
+
+// ExpiresAt returns when the upload is removed if not completed
+func (s Session) ExpiresAt(expiry time.Duration) time.Time {
+	return s.CreatedAt.Add(expiry)
+}
+
+// HandleOptions advertises the supported tus version and extensions
+func (s *Server) HandleOptions(ctx echo.Context) error {
+	h := ctx.Response().Header()
+	h.Set(HeaderTusResumable, TusVersion)
+	h.Set(HeaderTusVersion, TusVersion)
+	h.Set(HeaderTusExtension, TusExtensions)
+	h.Set(HeaderTusChecksumAlg, TusChecksumAlg)
+	h.Set(HeaderTusMaxSize, strconv.FormatUint(s.policy.MaxAttachmentSize, 10))
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// HandleCreate starts an upload. The owner is required in Upload-Metadata;
+// the declared filetype is checked against the policy up front.
+func (s *Server) HandleCreate(ctx echo.Context) error {
+	if err := checkTusResumable(ctx); err != nil {
+		return err
+	}
+
+	length, err := strconv.ParseInt(ctx.Request().Header.Get(HeaderUploadLength), 10, 64)
+	if err != nil || length <= 0 {
+		return jsonError(ctx, http.StatusBadRequest, "Upload-Length must be a positive integer")
+	}
+	if uint64(length) > s.policy.MaxAttachmentSize {
+		return jsonError(ctx, http.StatusRequestEntityTooLarge, fmt.Sprintf(
+			"attachment is %d bytes, limit %d", length, s.policy.MaxAttachmentSize,
+		))
+	}
+
+	meta, err := parseMetadata(ctx.Request().Header.Get(HeaderUploadMetadata))
+	if err != nil {
+		return jsonError(ctx, http.StatusBadRequest, err.Error())
+	}
+	if meta[metadataOwner] == "" {
+		return jsonError(ctx, http.StatusBadRequest, "Upload-Metadata must include owner")
+	}
+	if mimeType := meta[metadataFileType]; mimeType != "" {
+		declared := types.DetectMIMEType(types.Upload{MIMEType: mimeType})
+		if !slices.Contains(s.policy.AllowedMIMETypes, declared) {
+			return jsonError(ctx, http.StatusUnsupportedMediaType, declared+" is not allowed")
+		}
+	}
+
+	id, err := newSessionID()
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, err.Error())
+	}
+	session := Session{
+		ID:        id,
+		Owner:     meta[metadataOwner],
+		MIMEType:  meta[metadataFileType],
+		Length:    length,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := os.WriteFile(s.dataPath(id), nil, 0o600); err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, err.Error())
+	}
+	if err := s.saveSession(session); err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	h := ctx.Response().Header()
+	h.Set(HeaderTusResumable, TusVersion)
+	h.Set(echo.HeaderLocation, uploadLocationPrefix+id)
+	h.Set(HeaderUploadOffset, "0")
+	h.Set(HeaderUploadExpires, session.ExpiresAt(s.expiry).Format(http.TimeFormat))
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// HandleStatus returns the offset to resume an upload from
+func (s *Server) HandleStatus(ctx echo.Context) error {
+	if err := checkTusResumable(ctx); err != nil {
+		return err
+	}
+
+	session, err := s.loadSession(ctx.Param("id"))
+	if err != nil {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	h := ctx.Response().Header()
+	h.Set(HeaderTusResumable, TusVersion)
+	h.Set(echo.HeaderCacheControl, "no-store")
+	h.Set(HeaderUploadOffset, strconv.FormatInt(session.Offset, 10))
+	h.Set(HeaderUploadLength, strconv.FormatInt(session.Length, 10))
+	h.Set(HeaderUploadExpires, session.ExpiresAt(s.expiry).Format(http.TimeFormat))
+	if session.CID != "" {
+		h.Set(HeaderAttachmentCID, session.CID)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// HandlePatch appends a chunk at Upload-Offset. The chunk must carry an
+// Upload-Checksum; a mismatch discards the chunk. When the last chunk arrives
+// the attachment is checked against the policy and pinned, and its CID is
+// returned in the Attachment-Cid header. A bodiless PATCH at the final offset
+// retries pinning after a failure.
+func (s *Server) HandlePatch(ctx echo.Context) error {
+	if err := checkTusResumable(ctx); err != nil {
+		return err
+	}
+	req := ctx.Request()
+	if req.Header.Get(echo.HeaderContentType) != ContentTypeOffset {
+		return jsonError(ctx, http.StatusUnsupportedMediaType, "Content-Type must be "+ContentTypeOffset)
+	}
+
+	id := ctx.Param("id")
+	l := s.lock(id)
+	if !l.TryLock() {
+		return jsonError(ctx, http.StatusLocked, "upload is being written to")
+	}
+	defer l.Unlock()
+
+	session, err := s.loadSession(id)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "upload not found")
+	}
+	if session.CID != "" {
+		return jsonError(ctx, http.StatusConflict, "upload is already complete")
+	}
+
+	offset, err := strconv.ParseInt(req.Header.Get(HeaderUploadOffset), 10, 64)
+	if err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Upload-Offset must be an integer")
+	}
+	if offset != session.Offset {
+		return jsonError(ctx, http.StatusConflict, fmt.Sprintf(
+			"Upload-Offset %d does not match current offset %d", offset, session.Offset,
+		))
+	}
+
+	if req.ContentLength != 0 {
+		n, status, err := s.writeChunk(session, req)
+		if err != nil {
+			return jsonError(ctx, status, err.Error())
+		}
+		session.Offset += n
+		if err := s.saveSession(session); err != nil {
+			return jsonError(ctx, http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	h := ctx.Response().Header()
+	h.Set(HeaderTusResumable, TusVersion)
+	h.Set(HeaderUploadOffset, strconv.FormatInt(session.Offset, 10))
+	h.Set(HeaderUploadExpires, session.ExpiresAt(s.expiry).Format(http.TimeFormat))
+	if session.Offset < session.Length {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	status, err := s.complete(ctx, &session)
+	if err != nil {
+		return jsonError(ctx, status, err.Error())
+	}
+	h.Set(HeaderAttachmentCID, session.CID)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// HandleTerminate removes an upload and its data
+func (s *Server) HandleTerminate(ctx echo.Context) error {
+	if err := checkTusResumable(ctx); err != nil {
+		return err
+	}
+
+	id := ctx.Param("id")
+	l := s.lock(id)
+	if !l.TryLock() {
+		return jsonError(ctx, http.StatusLocked, "upload is being written to")
+	}
+	defer s.forget(id)
+	defer l.Unlock()
+
+	if _, err := s.loadSession(id); err != nil {
+		return jsonError(ctx, http.StatusNotFound, "upload not found")
+	}
+	s.removeSession(id)
+
+	ctx.Response().Header().Set(HeaderTusResumable, TusVersion)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// writeChunk streams the request body into the upload at its current offset,
+// verifying the chunk checksum. On failure the data is truncated back to the
+// offset and the HTTP status to answer with is returned.
+func (s *Server) writeChunk(session Session, req *http.Request) (int64, int, error) {
+	expected, err := parseChecksum(req.Header.Get(HeaderUploadChecksum))
+	if err != nil {
+		return 0, http.StatusBadRequest, err
+	}
+
+	f, err := os.OpenFile(s.dataPath(session.ID), os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, http.StatusInternalServerError, err
+	}
+	defer f.Close()
+	if _, err := f.Seek(session.Offset, io.SeekStart); err != nil {
+		return 0, http.StatusInternalServerError, err
+	}
+
+	remaining := session.Length - session.Offset
+	hasher := sha256.New()
+	n, err := io.Copy(f, io.TeeReader(io.LimitReader(req.Body, remaining+1), hasher))
+
+	status := 0
+	switch {
+	case err != nil:
+		status, err = http.StatusBadRequest, fmt.Errorf("failed to read chunk: %w", err)
+	case n > remaining:
+		status, err = http.StatusRequestEntityTooLarge, fmt.Errorf("chunk exceeds Upload-Length by %d bytes", n-remaining)
+	case !bytes.Equal(hasher.Sum(nil), expected):
+		status, err = StatusChecksumMismatch, errors.New("chunk checksum mismatch")
+	}
+	if err != nil {
+		if terr := f.Truncate(session.Offset); terr != nil {
+			logger.Error("failed to discard chunk", "upload", session.ID, "error", terr)
+		}
+		return 0, status, err
+	}
+	return n, 0, f.Sync()
+}
+
+// complete pins a fully received upload and moves its data into the download cache
+func (s *Server) complete(ctx echo.Context, session *Session) (int, error) {
+	data, err := os.ReadFile(s.dataPath(session.ID))
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	upload := types.Upload{
+		Owner:    session.Owner,
+		Kind:     types.UploadKindAttachment,
+		Data:     data,
+		MIMEType: session.MIMEType,
+	}
+	if err := s.policy.Check(upload); err != nil {
+		s.removeSession(session.ID)
+		return http.StatusUnprocessableEntity, err
+	}
+
+	cid, err := s.uploader(ctx.Request().Context(), upload)
+	if err != nil {
+		return http.StatusBadGateway, fmt.Errorf("failed to pin attachment: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	if err := s.cacheBlob(cid, s.dataPath(session.ID), sum[:]); err != nil {
+		logger.Error("failed to cache uploaded attachment", "cid", cid, "error", err)
+	}
+
+	session.CID = cid
+	if err := s.saveSession(*session); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	ctx.Response().Header().Set(HeaderReprDigest, formatDigest(sum[:]))
+	return 0, nil
+}
+
+func (s *Server) sessionPath(id string) string {
+	return filepath.Join(s.uploadDir, id+sessionFileSuffix)
+}
+
+func (s *Server) dataPath(id string) string {
+	return filepath.Join(s.uploadDir, id+sessionDataSuffix)
+}
+
+func (s *Server) loadSession(id string) (Session, error) {
+	if !validSessionID(id) {
+		return Session{}, os.ErrNotExist
+	}
+	bz, err := os.ReadFile(s.sessionPath(id))
+	if err != nil {
+		return Session{}, err
+	}
+	var session Session
+	if err := json.Unmarshal(bz, &session); err != nil {
+		return Session{}, err
+	}
+	return session, nil
+}
+
+// saveSession writes the session atomically so a crash never leaves a torn record
+func (s *Server) saveSession(session Session) error {
+	bz, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	tmp := s.sessionPath(session.ID) + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.sessionPath(session.ID))
+}
+
+func (s *Server) removeSession(id string) {
+	for _, path := range []string{s.dataPath(id), s.sessionPath(id)} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.Error("failed to remove upload file", "path", path, "error", err)
+		}
+	}
+}
+
+func newSessionID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+func validSessionID(id string) bool {
+	if len(id) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
+
+// sessionID extracts the upload ID from a session record file name
+func sessionID(name string) (string, bool) {
+	id, ok := strings.CutSuffix(name, sessionFileSuffix)
+	return id, ok && validSessionID(id)
+}
+
+// checkTusResumable rejects requests for a protocol version other than TusVersion
+func checkTusResumable(ctx echo.Context) error {
+	if ctx.Request().Header.Get(HeaderTusResumable) == TusVersion {
+		return nil
+	}
+	ctx.Response().Header().Set(HeaderTusVersion, TusVersion)
+	return jsonError(ctx, http.StatusPreconditionFailed, "unsupported Tus-Resumable version")
+}
+
+// parseMetadata decodes an Upload-Metadata header: comma separated keys,
+// each optionally followed by a space and a base64 value
+func parseMetadata(header string) (map[string]string, error) {
+	meta := make(map[string]string)
+	if header == "" {
+		return meta, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, errors.New("Upload-Metadata has an empty key")
+		}
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("Upload-Metadata value for %s is not base64", key)
+		}
+		meta[key] = string(value)
+	}
+	return meta, nil
+}
+
+// parseChecksum decodes an Upload-Checksum header of the form "sha256 <base64>"
+func parseChecksum(header string) ([]byte, error) {
+	if header == "" {
+		return nil, errors.New("Upload-Checksum is required")
+	}
+	alg, encoded, _ := strings.Cut(header, " ")
+	if alg != TusChecksumAlg {
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", alg)
+	}
+	sum, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sum) != sha256.Size {
+		return nil, errors.New("Upload-Checksum is not a base64 SHA-256 digest")
+	}
+	return sum, nil
+}
+
+func jsonError(ctx echo.Context, status int, msg string) error {
+	if ctx.Request().Method == http.MethodHead {
+		return ctx.NoContent(status)
+	}
+	return ctx.JSON(status, map[string]string{"error": msg})
+}
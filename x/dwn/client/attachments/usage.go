@@ -0,0 +1,107 @@
+package attachments
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Usage counts the bytes each owner has pinned through a node's attachment
+// server. Those uploads are checked against the latest committed state but
+// never charged on chain, so the counts are kept on disk and count against
+// the owner's quota on every later upload, across requests and restarts.
+type Usage struct {
+	path string
+
+	mu     sync.Mutex
+	pinned map[string]uint64
+	locks  map[string]*sync.Mutex
+}
+
+// OpenUsage loads the counts stored at path, starting empty when the file
+// does not exist yet
+func OpenUsage(path string) (*Usage, error) {
+	u := &Usage{
+		path:   path,
+		pinned: make(map[string]uint64),
+		locks:  make(map[string]*sync.Mutex),
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return u, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("attachments: %w", err)
+	}
+	if err := json.Unmarshal(data, &u.pinned); err != nil {
+		return nil, fmt.Errorf("attachments: invalid usage file %s: %w", path, err)
+	}
+	return u, nil
+}
+
+// Pinned returns the bytes an owner has pinned through the server
+func (u *Usage) Pinned(owner string) uint64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.pinned[owner]
+}
+
+// Charge runs upload with the bytes the owner has already pinned and, when it
+// succeeds, adds size to them. Uploads of the same owner run one at a time so
+// that concurrent requests cannot pass the same quota check.
+func (u *Usage) Charge(owner string, size uint64, upload func(pinned uint64) (string, error)) (string, error) {
+	l := u.lock(owner)
+	l.Lock()
+	defer l.Unlock()
+
+	cid, err := upload(u.Pinned(owner))
+	if err != nil {
+		return "", err
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.pinned[owner] += size
+	if err := u.save(); err != nil {
+		// The content is pinned; keep counting it in memory
+		logger.Error("failed to persist attachment usage", "owner", owner, "error", err)
+	}
+	return cid, nil
+}
+
+// lock returns the mutex serializing an owner's uploads
+func (u *Usage) lock(owner string) *sync.Mutex {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	l, ok := u.locks[owner]
+	if !ok {
+		l = &sync.Mutex{}
+		u.locks[owner] = l
+	}
+	return l
+}
+
+// save writes the counts through a temporary file so that a crash never
+// leaves a partial file behind. The caller holds u.mu.
+func (u *Usage) save() error {
+	data, err := json.Marshal(u.pinned)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(u.path), ".usage-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), u.path)
+}
@@ -0,0 +1,44 @@
+package attachments_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/client/attachments"
+)
+
+func TestUsagePersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	usage, err := attachments.OpenUsage(path)
+	require.NoError(t, err)
+
+	// A quota of 100 bytes, checked with what the owner already pinned
+	upload := func(size uint64) func(pinned uint64) (string, error) {
+		return func(pinned uint64) (string, error) {
+			if pinned+size > 100 {
+				return "", errors.New("quota exceeded")
+			}
+			return testCID, nil
+		}
+	}
+
+	_, err = usage.Charge("alice", 60, upload(60))
+	require.NoError(t, err)
+	_, err = usage.Charge("alice", 60, upload(60))
+	require.Error(t, err)
+	require.EqualValues(t, 60, usage.Pinned("alice"))
+	require.Zero(t, usage.Pinned("bob"))
+
+	// The count survives a restart
+	reopened, err := attachments.OpenUsage(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 60, reopened.Pinned("alice"))
+	_, err = reopened.Charge("alice", 60, upload(60))
+	require.Error(t, err)
+	_, err = reopened.Charge("alice", 40, upload(40))
+	require.NoError(t, err)
+	require.EqualValues(t, 100, reopened.Pinned("alice"))
+}
@@ -0,0 +1,346 @@
+// Package gateway fetches IPFS content from several gateways and nodes, such
+// as a local Kubo node, a dedicated Pinata gateway and web3.storage. Gateways
+// are tried in order with a per-attempt timeout and retries; one that fails
+// is skipped until a health check finds it serving again, so a single
+// provider outage does not stall vault recovery or icon resolution.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ipfs/go-cid"
+
+	"github.com/sonr-io/sonr/x/dwn/client/ipns"
+)
+
+var logger = log.NewLogger(os.Stderr).With("module", "gateway")
+
+// Defaults applied to a zero Config
+const (
+	DefaultTimeout        = 30 * time.Second
+	DefaultRetryBackoff   = 500 * time.Millisecond
+	DefaultHealthInterval = time.Minute
+	DefaultMaxContentSize = 64 << 20
+)
+
+// Config configures a Client
+type Config struct {
+	// Timeout bounds each attempt on a gateway
+	Timeout time.Duration
+	// Retries is how many more times a failing gateway is tried before the
+	// client fails over to the next one
+	Retries int
+	// RetryBackoff is the pause before the first retry, doubled for each
+	// retry after it
+	RetryBackoff time.Duration
+	// HealthInterval is how often Start pings every gateway
+	HealthInterval time.Duration
+	// MaxContentSize is the largest content fetched, in bytes
+	MaxContentSize int64
+}
+
+func (c Config) withDefaults() Config {
+	if c.Timeout == 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.RetryBackoff == 0 {
+		c.RetryBackoff = DefaultRetryBackoff
+	}
+	if c.HealthInterval == 0 {
+		c.HealthInterval = DefaultHealthInterval
+	}
+	if c.MaxContentSize == 0 {
+		c.MaxContentSize = DefaultMaxContentSize
+	}
+	return c
+}
+
+// Client fetches content from the first healthy gateway that has it
+type Client struct {
+	cfg      Config
+	gateways []Gateway
+
+	mu        sync.RWMutex
+	unhealthy map[string]error
+	resolver  ipns.Resolver
+	dids      DIDDereferencer
+}
+
+// DIDDereferencer dereferences DID URLs that select a service, such as
+// did:sonr:abc#vault, to the service's endpoint
+type DIDDereferencer interface {
+	Dereference(ctx context.Context, didURL string) (string, error)
+}
+
+// NewClient creates a client over gateways, in order of preference
+func NewClient(cfg Config, gateways ...Gateway) (*Client, error) {
+	if len(gateways) == 0 {
+		return nil, errors.New("gateway: at least one gateway is required")
+	}
+	if cfg.Retries < 0 {
+		return nil, errors.New("gateway: retries cannot be negative")
+	}
+	seen := make(map[string]bool, len(gateways))
+	for _, g := range gateways {
+		if seen[g.Name()] {
+			return nil, fmt.Errorf("gateway: duplicate gateway %s", g.Name())
+		}
+		seen[g.Name()] = true
+	}
+	return &Client{
+		cfg:       cfg.withDefaults(),
+		gateways:  gateways,
+		unhealthy: make(map[string]error),
+	}, nil
+}
+
+// Fetch returns the content of cid. Healthy gateways are tried first, in
+// order, and unhealthy ones only once every healthy gateway failed. Content
+// addressed by a raw CID is checked against its hash, so a gateway cannot
+// serve anything else; other CIDs are served as the gateway decodes them.
+func (c *Client) Fetch(ctx context.Context, cidStr string) ([]byte, error) {
+	parsed, err := cid.Decode(cidStr)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: invalid CID %q: %w", cidStr, err)
+	}
+	return c.fetch(ctx, parsed.String(), &parsed)
+}
+
+// FetchURI returns the content an ipfs://{cid}[/path] URI points to. An
+// ipns://{name}[/path] URI is resolved first, when a resolver is set, and a
+// did: URL is dereferenced to its service endpoint, when a DID dereferencer
+// is set.
+func (c *Client) FetchURI(ctx context.Context, uri string) ([]byte, error) {
+	if strings.HasPrefix(uri, "did:") {
+		return c.fetchDID(ctx, uri)
+	}
+	if strings.HasPrefix(uri, "ipns://") {
+		return c.fetchName(ctx, uri)
+	}
+	path, ok := strings.CutPrefix(uri, "ipfs://")
+	if !ok {
+		return nil, fmt.Errorf("gateway: %q is not an ipfs:// URI", uri)
+	}
+	root, rest, hasPath := strings.Cut(path, "/")
+	parsed, err := cid.Decode(root)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: invalid CID in %q: %w", uri, err)
+	}
+	if hasPath && rest != "" {
+		// Content inside a directory cannot be checked against the root CID
+		return c.fetch(ctx, parsed.String()+"/"+rest, nil)
+	}
+	return c.fetch(ctx, parsed.String(), &parsed)
+}
+
+// SetResolver lets FetchURI follow ipns:// URIs, such as the DID service
+// endpoints of vaults, through r
+func (c *Client) SetResolver(r ipns.Resolver) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resolver = r
+}
+
+// SetDIDResolver lets FetchURI follow did: URLs, such as app manifest icons
+// served by a DID's service, through d
+func (c *Client) SetDIDResolver(d DIDDereferencer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dids = d
+}
+
+// fetchDID dereferences a DID URL and fetches the content at the endpoint,
+// which must be an ipfs:// or ipns:// URI
+func (c *Client) fetchDID(ctx context.Context, uri string) ([]byte, error) {
+	c.mu.RLock()
+	dids := c.dids
+	c.mu.RUnlock()
+	if dids == nil {
+		return nil, fmt.Errorf("gateway: no DID resolver for %q", uri)
+	}
+
+	endpoint, err := dids.Dereference(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	// Endpoints are not dereferenced again, so DIDs cannot point at each
+	// other in a loop
+	if !strings.HasPrefix(endpoint, "ipfs://") && !strings.HasPrefix(endpoint, "ipns://") {
+		return nil, fmt.Errorf("gateway: %s dereferenced to %q, not an IPFS or IPNS URI", uri, endpoint)
+	}
+	return c.FetchURI(ctx, endpoint)
+}
+
+// fetchName resolves an ipns:// URI and fetches the content it points to
+func (c *Client) fetchName(ctx context.Context, uri string) ([]byte, error) {
+	c.mu.RLock()
+	resolver := c.resolver
+	c.mu.RUnlock()
+	if resolver == nil {
+		return nil, fmt.Errorf("gateway: no IPNS resolver for %q", uri)
+	}
+
+	name, rest, err := ipns.ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := resolver.Resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	target, ok := strings.CutPrefix(resolved, "/ipfs/")
+	if !ok {
+		return nil, fmt.Errorf("gateway: %s resolved to %q, not an IPFS path", name, resolved)
+	}
+	if rest != "" {
+		target = strings.TrimRight(target, "/") + "/" + rest
+	}
+	return c.FetchURI(ctx, "ipfs://"+target)
+}
+
+func (c *Client) fetch(ctx context.Context, path string, verify *cid.Cid) ([]byte, error) {
+	var errs []error
+	for _, g := range c.ordered() {
+		data, err := c.fetchFrom(ctx, g, path)
+		if err == nil && verify != nil {
+			err = checkContent(*verify, data)
+		}
+		if err == nil {
+			c.markHealthy(g)
+			return data, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		errs = append(errs, err)
+		if !errors.Is(err, ErrNotFound) {
+			c.markUnhealthy(g, err)
+		}
+	}
+	return nil, fmt.Errorf("gateway: %s unavailable: %w", path, errors.Join(errs...))
+}
+
+// fetchFrom tries a gateway up to 1+Retries times, backing off between
+// attempts. Content a gateway reports missing is not retried.
+func (c *Client) fetchFrom(ctx context.Context, g Gateway, path string) ([]byte, error) {
+	backoff := c.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+		data, err := g.Fetch(attemptCtx, path, c.cfg.MaxContentSize)
+		cancel()
+		if err == nil || errors.Is(err, ErrNotFound) || attempt >= c.cfg.Retries {
+			return data, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// checkContent verifies that data hashes to a raw CID
+func checkContent(c cid.Cid, data []byte) error {
+	if c.Type() != cid.Raw {
+		return nil
+	}
+	sum, err := c.Prefix().Sum(data)
+	if err != nil {
+		return err
+	}
+	if !sum.Equals(c) {
+		return fmt.Errorf("gateway: content does not match %s", c)
+	}
+	return nil
+}
+
+// ordered returns the healthy gateways followed by the unhealthy ones
+func (c *Client) ordered() []Gateway {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	healthy := make([]Gateway, 0, len(c.gateways))
+	var unhealthy []Gateway
+	for _, g := range c.gateways {
+		if _, down := c.unhealthy[g.Name()]; down {
+			unhealthy = append(unhealthy, g)
+		} else {
+			healthy = append(healthy, g)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (c *Client) markHealthy(g Gateway) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, down := c.unhealthy[g.Name()]; down {
+		delete(c.unhealthy, g.Name())
+		logger.Info("gateway recovered", "gateway", g.Name())
+	}
+}
+
+func (c *Client) markUnhealthy(g Gateway, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, down := c.unhealthy[g.Name()]; !down {
+		logger.Error("gateway failed", "gateway", g.Name(), "error", err)
+	}
+	c.unhealthy[g.Name()] = err
+}
+
+// CheckHealth pings every gateway and updates which are skipped. It returns
+// the error of each unhealthy gateway by name.
+func (c *Client) CheckHealth(ctx context.Context) map[string]error {
+	for _, g := range c.gateways {
+		pingCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+		err := g.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			c.markUnhealthy(g, err)
+		} else {
+			c.markHealthy(g)
+		}
+	}
+	return c.Unhealthy()
+}
+
+// Unhealthy returns the last error of each gateway currently skipped
+func (c *Client) Unhealthy() map[string]error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	out := make(map[string]error, len(c.unhealthy))
+	for name, err := range c.unhealthy {
+		out[name] = err
+	}
+	return out
+}
+
+// Start checks the health of every gateway each HealthInterval until ctx is
+// cancelled
+func (c *Client) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.cfg.HealthInterval)
+		defer ticker.Stop()
+
+		for {
+			c.CheckHealth(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
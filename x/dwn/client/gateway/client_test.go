@@ -0,0 +1,194 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/client/gateway"
+	"github.com/sonr-io/sonr/x/dwn/client/ipns"
+)
+
+// rawCID returns the CIDv1 of data as raw content hashed with sha2-256
+func rawCID(t *testing.T, data []byte) string {
+	t.Helper()
+	c, err := cid.NewPrefixV1(cid.Raw, 0x12).Sum(data)
+	require.NoError(t, err)
+	return c.String()
+}
+
+// fakeGateway serves content from memory and counts its requests
+type fakeGateway struct {
+	name     string
+	content  map[string][]byte
+	err      error
+	requests int
+}
+
+func (g *fakeGateway) Name() string { return g.name }
+
+func (g *fakeGateway) Fetch(_ context.Context, path string, _ int64) ([]byte, error) {
+	g.requests++
+	if g.err != nil {
+		return nil, g.err
+	}
+	data, ok := g.content[path]
+	if !ok {
+		return nil, gateway.ErrNotFound
+	}
+	return data, nil
+}
+
+func (g *fakeGateway) Ping(context.Context) error { return g.err }
+
+func TestClientFailover(t *testing.T) {
+	data := []byte("vault payload")
+	id := rawCID(t, data)
+
+	down := &fakeGateway{name: "kubo", err: errors.New("connection refused")}
+	missing := &fakeGateway{name: "pinata", content: map[string][]byte{}}
+	serving := &fakeGateway{name: "web3.storage", content: map[string][]byte{id: data}}
+
+	c, err := gateway.NewClient(gateway.Config{Retries: 2, RetryBackoff: time.Millisecond}, down, missing, serving)
+	require.NoError(t, err)
+
+	got, err := c.Fetch(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	// A failing gateway is retried, missing content is not
+	require.Equal(t, 3, down.requests)
+	require.Equal(t, 1, missing.requests)
+	require.Contains(t, c.Unhealthy(), "kubo")
+	require.NotContains(t, c.Unhealthy(), "pinata")
+
+	// The unhealthy gateway is tried last until a health check clears it
+	_, err = c.Fetch(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, 3, down.requests)
+
+	down.err = nil
+	require.Empty(t, c.CheckHealth(context.Background()))
+}
+
+func TestClientRejectsTamperedContent(t *testing.T) {
+	id := rawCID(t, []byte("icon"))
+	bad := &fakeGateway{name: "bad", content: map[string][]byte{id: []byte("not the icon")}}
+	good := &fakeGateway{name: "good", content: map[string][]byte{id: []byte("icon")}}
+
+	c, err := gateway.NewClient(gateway.Config{}, bad, good)
+	require.NoError(t, err)
+
+	got, err := c.FetchURI(context.Background(), "ipfs://"+id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("icon"), got)
+	require.Contains(t, c.Unhealthy(), "bad")
+
+	_, err = c.FetchURI(context.Background(), "https://example.com/icon.png")
+	require.Error(t, err)
+}
+
+func TestHTTPGateway(t *testing.T) {
+	data := []byte("attachment")
+	id := rawCID(t, data)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("x-pinata-gateway-token"))
+		switch strings.TrimPrefix(r.URL.Path, "/ipfs/") {
+		case id:
+			_, _ = w.Write(data)
+		case "bafkqaaa":
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	g := gateway.NewHTTPGateway("pinata", srv.URL, map[string]string{"x-pinata-gateway-token": "secret"})
+	require.NoError(t, g.Ping(context.Background()))
+
+	got, err := g.Fetch(context.Background(), id, 0)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	_, err = g.Fetch(context.Background(), id, 4)
+	require.ErrorContains(t, err, "exceeds")
+
+	_, err = g.Fetch(context.Background(), rawCID(t, []byte("other")), 0)
+	require.ErrorIs(t, err, gateway.ErrNotFound)
+}
+
+// fakeResolver resolves names from memory
+type fakeResolver map[string]string
+
+func (r fakeResolver) Resolve(_ context.Context, name string) (string, error) {
+	path, ok := r[name]
+	if !ok {
+		return "", ipns.ErrNotFound
+	}
+	return path, nil
+}
+
+func TestClientFollowsIPNS(t *testing.T) {
+	data := []byte(`{"vault":"v2"}`)
+	id := rawCID(t, data)
+	key, err := cid.Prefix{Version: 1, Codec: cid.Libp2pKey, MhType: 0x00, MhLength: -1}.Sum([]byte("vault key"))
+	require.NoError(t, err)
+	name, err := ipns.ParseName(key.String())
+	require.NoError(t, err)
+
+	g := &fakeGateway{name: "kubo", content: map[string][]byte{id: data}}
+	c, err := gateway.NewClient(gateway.Config{}, g)
+	require.NoError(t, err)
+
+	_, err = c.FetchURI(context.Background(), ipns.URI(name))
+	require.ErrorContains(t, err, "no IPNS resolver")
+
+	c.SetResolver(fakeResolver{name: "/ipfs/" + id})
+	got, err := c.FetchURI(context.Background(), ipns.URI(name))
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+}
+
+// fakeDIDs dereferences DID URLs from memory
+type fakeDIDs map[string]string
+
+func (d fakeDIDs) Dereference(_ context.Context, didURL string) (string, error) {
+	endpoint, ok := d[didURL]
+	if !ok {
+		return "", errors.New("service not found")
+	}
+	return endpoint, nil
+}
+
+func TestClientFollowsDIDURLs(t *testing.T) {
+	data := []byte("icon")
+	id := rawCID(t, data)
+
+	g := &fakeGateway{name: "kubo", content: map[string][]byte{id: data}}
+	c, err := gateway.NewClient(gateway.Config{}, g)
+	require.NoError(t, err)
+
+	_, err = c.FetchURI(context.Background(), "did:sonr:alice#icon")
+	require.ErrorContains(t, err, "no DID resolver")
+
+	c.SetDIDResolver(fakeDIDs{
+		"did:sonr:alice#icon": "ipfs://" + id,
+		"did:sonr:alice#web":  "https://alice.example.com",
+		"did:sonr:alice#loop": "did:sonr:alice#icon",
+	})
+	got, err := c.FetchURI(context.Background(), "did:sonr:alice#icon")
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	_, err = c.FetchURI(context.Background(), "did:sonr:alice#web")
+	require.ErrorContains(t, err, "not an IPFS or IPNS URI")
+	_, err = c.FetchURI(context.Background(), "did:sonr:alice#loop")
+	require.ErrorContains(t, err, "not an IPFS or IPNS URI")
+}
@@ -0,0 +1,125 @@
+package gateway
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/sonr-io/sonr/x/dwn/client/ipns"
+)
+
+// ServiceConfig is the [ipfs-gateways] section of app.toml, selecting the
+// gateways a node reads vault content from when its IPFS client cannot
+type ServiceConfig struct {
+	// KuboEndpoint is a Kubo RPC API, e.g. http://127.0.0.1:5001.
+	KuboEndpoint string `mapstructure:"kubo-endpoint"`
+	// PinataDomain is a dedicated Pinata gateway domain, with its optional
+	// access token.
+	PinataDomain string `mapstructure:"pinata-domain"`
+	PinataToken  string `mapstructure:"pinata-token"`
+	// Web3Storage adds the public web3.storage gateway.
+	Web3Storage bool `mapstructure:"web3storage"`
+	// HTTPGateways are other path gateways, e.g. https://ipfs.io.
+	HTTPGateways []string `mapstructure:"http-gateways"`
+	// Timeout bounds each attempt on a gateway.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Retries is how many more times a failing gateway is tried.
+	Retries int `mapstructure:"retries"`
+}
+
+// DefaultServiceConfig returns the default configuration, which has no
+// gateways
+func DefaultServiceConfig() ServiceConfig {
+	return ServiceConfig{
+		HTTPGateways: []string{},
+		Timeout:      DefaultTimeout,
+		Retries:      1,
+	}
+}
+
+// ServiceConfigFromAppOptions reads the [ipfs-gateways] section of app.toml.
+func ServiceConfigFromAppOptions(appOpts servertypes.AppOptions) ServiceConfig {
+	cfg := DefaultServiceConfig()
+	cfg.KuboEndpoint = cast.ToString(appOpts.Get("ipfs-gateways.kubo-endpoint"))
+	cfg.PinataDomain = cast.ToString(appOpts.Get("ipfs-gateways.pinata-domain"))
+	cfg.PinataToken = cast.ToString(appOpts.Get("ipfs-gateways.pinata-token"))
+	cfg.Web3Storage = cast.ToBool(appOpts.Get("ipfs-gateways.web3storage"))
+	cfg.HTTPGateways = cast.ToStringSlice(appOpts.Get("ipfs-gateways.http-gateways"))
+	if v := cast.ToDuration(appOpts.Get("ipfs-gateways.timeout")); v > 0 {
+		cfg.Timeout = v
+	}
+	if v := appOpts.Get("ipfs-gateways.retries"); v != nil {
+		cfg.Retries = cast.ToInt(v)
+	}
+	return cfg
+}
+
+// Gateways returns the configured gateways in order of preference: the
+// local Kubo node, Pinata, web3.storage, then the other path gateways
+func (c ServiceConfig) Gateways() []Gateway {
+	var gateways []Gateway
+	if c.KuboEndpoint != "" {
+		gateways = append(gateways, NewKuboGateway("kubo", c.KuboEndpoint))
+	}
+	if c.PinataDomain != "" {
+		gateways = append(gateways, NewPinataGateway(c.PinataDomain, c.PinataToken))
+	}
+	if c.Web3Storage {
+		gateways = append(gateways, NewWeb3StorageGateway())
+	}
+	for i, endpoint := range c.HTTPGateways {
+		gateways = append(gateways, NewHTTPGateway(fmt.Sprintf("http-%d", i), endpoint, nil))
+	}
+	return gateways
+}
+
+// NewServiceClient returns a client over the configured gateways, or nil
+// when none is configured. ipns:// URIs are resolved through the Kubo node,
+// when one is configured.
+func NewServiceClient(cfg ServiceConfig) (*Client, error) {
+	gateways := cfg.Gateways()
+	if len(gateways) == 0 {
+		return nil, nil
+	}
+	c, err := NewClient(Config{Timeout: cfg.Timeout, Retries: cfg.Retries}, gateways...)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.KuboEndpoint != "" {
+		c.SetResolver(ipns.NewClient(cfg.KuboEndpoint, cfg.Timeout))
+	}
+	return c, nil
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                             IPFS Gateways                               ###
+###############################################################################
+
+# Gateways vault content is read from when the node's IPFS client is missing
+# or cannot serve it, tried in the order below. Leave all empty to disable.
+[ipfs-gateways]
+
+# Kubo RPC API, e.g. "http://127.0.0.1:5001". It also resolves ipns:// names.
+kubo-endpoint = "{{ .IPFSGateways.KuboEndpoint }}"
+
+# Dedicated Pinata gateway domain, e.g. "example.mypinata.cloud", and its
+# access token if the gateway is restricted.
+pinata-domain = "{{ .IPFSGateways.PinataDomain }}"
+pinata-token = "{{ .IPFSGateways.PinataToken }}"
+
+# Use the public web3.storage gateway.
+web3storage = {{ .IPFSGateways.Web3Storage }}
+
+# Other path gateways, e.g. "https://ipfs.io".
+http-gateways = [{{ range .IPFSGateways.HTTPGateways }}{{ printf "%q, " . }}{{ end }}]
+
+# Per-attempt timeout, and how many more times a failing gateway is tried
+# before the next one.
+timeout = "{{ .IPFSGateways.Timeout }}"
+retries = {{ .IPFSGateways.Retries }}
+`
@@ -0,0 +1,161 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNotFound is returned by a gateway that answered but does not have the
+// content. The client moves on to the next gateway without retrying.
+var ErrNotFound = errors.New("content not found")
+
+// emptyCID is the identity CID of empty raw content. Gateways serve it
+// without touching the network, which makes it a cheap health probe.
+const emptyCID = "bafkqaaa"
+
+// Gateway is a source of IPFS content
+type Gateway interface {
+	// Name identifies the gateway in logs and errors.
+	Name() string
+	// Fetch returns the content at path, a CID optionally followed by a
+	// path inside it.
+	Fetch(ctx context.Context, path string, maxSize int64) ([]byte, error)
+	// Ping reports whether the gateway is serving.
+	Ping(ctx context.Context) error
+}
+
+// HTTPGateway reads content from an IPFS path gateway, e.g.
+// https://ipfs.io/ipfs/{cid}
+type HTTPGateway struct {
+	name     string
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+// NewHTTPGateway creates a gateway for the path gateway at endpoint. headers
+// are sent with every request, e.g. an access token.
+func NewHTTPGateway(name, endpoint string, headers map[string]string) *HTTPGateway {
+	return &HTTPGateway{
+		name:     name,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		headers:  headers,
+		client:   &http.Client{},
+	}
+}
+
+// NewPinataGateway creates a gateway for a dedicated Pinata gateway domain,
+// e.g. example.mypinata.cloud. token is the gateway access token, if the
+// gateway is restricted.
+func NewPinataGateway(domain, token string) *HTTPGateway {
+	var headers map[string]string
+	if token != "" {
+		headers = map[string]string{"x-pinata-gateway-token": token}
+	}
+	return NewHTTPGateway("pinata", "https://"+domain, headers)
+}
+
+// NewWeb3StorageGateway creates a gateway for the public web3.storage gateway
+func NewWeb3StorageGateway() *HTTPGateway {
+	return NewHTTPGateway("web3.storage", "https://w3s.link", nil)
+}
+
+// Name implements Gateway.
+func (g *HTTPGateway) Name() string { return g.name }
+
+// Fetch implements Gateway.
+func (g *HTTPGateway) Fetch(ctx context.Context, path string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.endpoint+"/ipfs/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Ask for the file itself rather than a directory listing or HTML page
+	req.Header.Set("Accept", "application/octet-stream")
+	for k, v := range g.headers {
+		req.Header.Set(k, v)
+	}
+	return read(g.client, req, g.name, maxSize)
+}
+
+// Ping implements Gateway.
+func (g *HTTPGateway) Ping(ctx context.Context) error {
+	_, err := g.Fetch(ctx, emptyCID, 0)
+	return err
+}
+
+// KuboGateway reads content through the RPC API of a Kubo (go-ipfs) node
+type KuboGateway struct {
+	name     string
+	endpoint string
+	client   *http.Client
+}
+
+// NewKuboGateway creates a gateway for the Kubo RPC API at endpoint, e.g.
+// http://127.0.0.1:5001
+func NewKuboGateway(name, endpoint string) *KuboGateway {
+	return &KuboGateway{
+		name:     name,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		client:   &http.Client{},
+	}
+}
+
+// Name implements Gateway.
+func (g *KuboGateway) Name() string { return g.name }
+
+// Fetch implements Gateway.
+func (g *KuboGateway) Fetch(ctx context.Context, path string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, g.endpoint+"/api/v0/cat?"+url.Values{"arg": {path}}.Encode(), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return read(g.client, req, g.name, maxSize)
+}
+
+// Ping implements Gateway.
+func (g *KuboGateway) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.endpoint+"/api/v0/version", nil)
+	if err != nil {
+		return err
+	}
+	_, err = read(g.client, req, g.name, 4096)
+	return err
+}
+
+// read performs req and returns the body of a successful response, failing
+// once it grows past maxSize. Zero maxSize reads the body whatever its size.
+func read(client *http.Client, req *http.Request, name string, maxSize int64) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("%s: %s returned %d: %s", name, req.URL.Path, resp.StatusCode, body)
+	}
+
+	body := io.Reader(resp.Body)
+	if maxSize > 0 {
+		body = io.LimitReader(resp.Body, maxSize+1)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%s: content exceeds %d bytes", name, maxSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,169 @@
+package ipns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+)
+
+// ErrNotFound is returned when no record is published for a name
+var ErrNotFound = errors.New("ipns: name not found")
+
+// Defaults for records published without options. Records are republished
+// by the node while it runs, so the lifetime only bounds how long a record
+// outlives a node that stopped.
+const (
+	DefaultLifetime = 48 * time.Hour
+	DefaultTTL      = 5 * time.Minute
+)
+
+// Key is a keypair kept by the node that an IPNS name is published with
+type Key struct {
+	// Name is the node-local name of the key
+	Name string `json:"Name"`
+	// ID is the IPNS name the key publishes, in base36
+	ID string `json:"Id"`
+}
+
+// Entry is a published IPNS record
+type Entry struct {
+	// Name is the IPNS name the record was published under
+	Name string `json:"Name"`
+	// Value is the path the name points to, e.g. /ipfs/{cid}
+	Value string `json:"Value"`
+}
+
+// PublishOptions tune a published record
+type PublishOptions struct {
+	// Lifetime is how long the record is valid; zero uses DefaultLifetime
+	Lifetime time.Duration
+	// TTL is how long resolvers may cache the record; zero uses DefaultTTL
+	TTL time.Duration
+}
+
+// Resolver resolves IPNS names to the paths they point to
+type Resolver interface {
+	Resolve(ctx context.Context, name string) (string, error)
+}
+
+var _ Resolver = (*Client)(nil)
+
+// Client manages keys and publishes and resolves names through the RPC API
+// of a Kubo node. Keys never leave the node; a key is referred to by its
+// node-local name.
+type Client struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewClient creates a client for the Kubo RPC API at endpoint, e.g.
+// http://127.0.0.1:5001. Publishing waits for the record to reach the DHT,
+// so timeout should allow for a minute or more.
+func NewClient(endpoint string, timeout time.Duration) *Client {
+	return &Client{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// GenerateKey creates an ed25519 key named name on the node
+func (c *Client) GenerateKey(ctx context.Context, name string) (Key, error) {
+	var key Key
+	err := c.call(ctx, "key/gen", url.Values{"arg": {name}, "type": {"ed25519"}, "ipns-base": {"base36"}}, &key)
+	return key, err
+}
+
+// ListKeys returns the keys on the node, including its own "self" key
+func (c *Client) ListKeys(ctx context.Context) ([]Key, error) {
+	var out struct {
+		Keys []Key `json:"Keys"`
+	}
+	err := c.call(ctx, "key/list", url.Values{"l": {"true"}, "ipns-base": {"base36"}}, &out)
+	return out.Keys, err
+}
+
+// RemoveKey deletes a key from the node. The name it published stays
+// resolvable until its last record expires.
+func (c *Client) RemoveKey(ctx context.Context, name string) error {
+	return c.call(ctx, "key/rm", url.Values{"arg": {name}}, nil)
+}
+
+// Publish points the name of key at content, replacing the record published
+// before
+func (c *Client) Publish(ctx context.Context, key string, content cid.Cid, opts PublishOptions) (Entry, error) {
+	if opts.Lifetime == 0 {
+		opts.Lifetime = DefaultLifetime
+	}
+	if opts.TTL == 0 {
+		opts.TTL = DefaultTTL
+	}
+
+	var entry Entry
+	err := c.call(ctx, "name/publish", url.Values{
+		"arg":       {"/ipfs/" + content.String()},
+		"key":       {key},
+		"lifetime":  {opts.Lifetime.String()},
+		"ttl":       {opts.TTL.String()},
+		"ipns-base": {"base36"},
+		// Vault workers may run without peers; the node republishes once
+		// it is connected
+		"allow-offline": {"true"},
+	}, &entry)
+	return entry, err
+}
+
+// Resolve implements Resolver. It returns the path a name points to,
+// following names that point to other names.
+func (c *Client) Resolve(ctx context.Context, name string) (string, error) {
+	name, err := ParseName(name)
+	if err != nil {
+		return "", err
+	}
+	var out struct {
+		Path string `json:"Path"`
+	}
+	if err := c.call(ctx, "name/resolve", url.Values{"arg": {"/ipns/" + name}, "recursive": {"true"}}, &out); err != nil {
+		return "", err
+	}
+	return out.Path, nil
+}
+
+// call POSTs an RPC command and decodes its JSON response into out
+func (c *Client) call(ctx context.Context, command string, args url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/api/v0/"+command+"?"+args.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ipns: %s: %w", command, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Kubo reports command errors as {"Message": ..., "Type": "error"}
+		var rpcErr struct {
+			Message string `json:"Message"`
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(body, &rpcErr) != nil || rpcErr.Message == "" {
+			rpcErr.Message = strings.TrimSpace(string(body))
+		}
+		if command == "name/resolve" && strings.Contains(rpcErr.Message, "could not resolve name") {
+			return fmt.Errorf("%w: %s", ErrNotFound, args.Get("arg"))
+		}
+		return fmt.Errorf("ipns: %s returned %d: %s", command, resp.StatusCode, rpcErr.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,96 @@
+package ipns_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/client/ipns"
+)
+
+// testName returns an IPNS name for an identity-hashed key
+func testName(t *testing.T, key string) string {
+	t.Helper()
+	c, err := cid.Prefix{Version: 1, Codec: cid.Libp2pKey, MhType: 0x00, MhLength: -1}.Sum([]byte(key))
+	require.NoError(t, err)
+	name, err := ipns.ParseName(c.String())
+	require.NoError(t, err)
+	return name
+}
+
+func TestParseURI(t *testing.T) {
+	name := testName(t, "vault key")
+
+	got, path, err := ipns.ParseURI(ipns.URI(name) + "/config.json")
+	require.NoError(t, err)
+	require.Equal(t, name, got)
+	require.Equal(t, "config.json", path)
+
+	_, _, err = ipns.ParseURI("ipfs://" + name)
+	require.ErrorContains(t, err, "not an ipns:// URI")
+
+	// A content CID is not a name
+	content, err := cid.NewPrefixV1(cid.Raw, 0x12).Sum([]byte("vault"))
+	require.NoError(t, err)
+	_, _, err = ipns.ParseURI(ipns.URI(content.String()))
+	require.ErrorContains(t, err, "not a libp2p key CID")
+}
+
+func TestClientPublishAndResolve(t *testing.T) {
+	name := testName(t, "vault key")
+	content, err := cid.NewPrefixV1(cid.Raw, 0x12).Sum([]byte("vault config v2"))
+	require.NoError(t, err)
+
+	published := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/api/v0/key/gen":
+			require.Equal(t, "vault-1", q.Get("arg"))
+			require.Equal(t, "ed25519", q.Get("type"))
+			_, _ = w.Write([]byte(`{"Name":"vault-1","Id":"` + name + `"}`))
+		case "/api/v0/name/publish":
+			require.Equal(t, "vault-1", q.Get("key"))
+			require.Equal(t, "1h0m0s", q.Get("lifetime"))
+			require.Equal(t, ipns.DefaultTTL.String(), q.Get("ttl"))
+			published[name] = q.Get("arg")
+			_, _ = w.Write([]byte(`{"Name":"` + name + `","Value":"` + q.Get("arg") + `"}`))
+		case "/api/v0/name/resolve":
+			path, ok := published[q.Get("arg")[len("/ipns/"):]]
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"Message":"could not resolve name","Code":0,"Type":"error"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"Path":"` + path + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := ipns.NewClient(srv.URL, time.Second)
+	ctx := context.Background()
+
+	_, err = c.Resolve(ctx, name)
+	require.ErrorIs(t, err, ipns.ErrNotFound)
+
+	key, err := c.GenerateKey(ctx, "vault-1")
+	require.NoError(t, err)
+	require.Equal(t, name, key.ID)
+
+	entry, err := c.Publish(ctx, key.Name, content, ipns.PublishOptions{Lifetime: time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, name, entry.Name)
+	require.Equal(t, "/ipfs/"+content.String(), entry.Value)
+
+	path, err := c.Resolve(ctx, name)
+	require.NoError(t, err)
+	require.Equal(t, "/ipfs/"+content.String(), path)
+}
@@ -0,0 +1,46 @@
+// Package ipns manages IPNS keys and publishes and resolves IPNS records
+// through the RPC API of a Kubo node. A vault or service points its DID
+// service endpoint at an ipns://{name} URI once; republishing the name at a
+// new CID then moves the endpoint without a DID update.
+package ipns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multibase"
+)
+
+// ParseName parses an IPNS name, the CID of a libp2p public key such as
+// k51qzi5uqu5d..., and returns it in its canonical base36 form. Legacy
+// base58 peer IDs (12D3KooW..., Qm...) are not accepted.
+func ParseName(name string) (string, error) {
+	c, err := cid.Decode(name)
+	if err != nil {
+		return "", fmt.Errorf("ipns: invalid name %q: %w", name, err)
+	}
+	if c.Type() != cid.Libp2pKey {
+		return "", fmt.Errorf("ipns: %q is not a libp2p key CID", name)
+	}
+	return c.StringOfBase(multibase.Base36)
+}
+
+// ParseURI splits an ipns://{name}[/path] URI into its name and the path
+// inside the content the name points to
+func ParseURI(uri string) (name, path string, err error) {
+	rest, ok := strings.CutPrefix(uri, "ipns://")
+	if !ok {
+		return "", "", fmt.Errorf("ipns: %q is not an ipns:// URI", uri)
+	}
+	root, path, _ := strings.Cut(rest, "/")
+	if name, err = ParseName(root); err != nil {
+		return "", "", err
+	}
+	return name, path, nil
+}
+
+// URI returns the ipns:// URI of a name
+func URI(name string) string {
+	return "ipns://" + name
+}
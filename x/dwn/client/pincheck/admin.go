@@ -0,0 +1,31 @@
+package pincheck
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterAdminRoutes registers the pin consistency admin endpoints
+func RegisterAdminRoutes(e *echo.Echo, c *Checker) {
+	e.GET("/admin/vaults/pins", c.HandleReport)
+	e.POST("/admin/vaults/pins/run", c.HandleRun)
+}
+
+// HandleReport returns the summary of the last consistency check
+func (c *Checker) HandleReport(ctx echo.Context) error {
+	report := c.LastReport()
+	if report == nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": "no pin check has run yet"})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// HandleRun triggers a consistency check and returns its report
+func (c *Checker) HandleRun(ctx echo.Context) error {
+	report, err := c.Run(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
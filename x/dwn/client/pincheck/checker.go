@@ -0,0 +1,338 @@
+package pincheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+var logger = log.NewLogger(os.Stderr).With("module", "pincheck")
+
+// VaultStatus is the outcome of checking a single vault
+type VaultStatus string
+
+const (
+	// StatusHealthy means every provider holds a pin
+	StatusHealthy VaultStatus = "healthy"
+	// StatusRepinned means missing pins were restored from the network
+	StatusRepinned VaultStatus = "repinned"
+	// StatusDegraded means some providers hold the content but re-pinning others failed
+	StatusDegraded VaultStatus = "degraded"
+	// StatusRecovered means no provider held the content and it was re-added from the on-chain copy
+	StatusRecovered VaultStatus = "recovered"
+	// StatusUnrecoverable means the content is gone from every provider and could not be restored
+	StatusUnrecoverable VaultStatus = "unrecoverable"
+)
+
+// Vault is the on-chain record of a vault to check
+type Vault struct {
+	CID   string
+	Owner string
+	// Data is the encrypted enclave payload stored on chain, used to re-add
+	// content that no provider can serve anymore
+	Data []byte
+}
+
+// VaultResult describes the state of a vault after a check
+type VaultResult struct {
+	CID        string      `json:"cid"`
+	Owner      string      `json:"owner"`
+	Status     VaultStatus `json:"status"`
+	PinnedOn   []string    `json:"pinned_on,omitempty"`
+	RepinnedOn []string    `json:"repinned_on,omitempty"`
+	Errors     []string    `json:"errors,omitempty"`
+}
+
+// Report summarizes a consistency check run. Results only lists vaults that
+// were not healthy.
+type Report struct {
+	StartedAt     time.Time     `json:"started_at"`
+	CompletedAt   time.Time     `json:"completed_at"`
+	Providers     []string      `json:"providers"`
+	Checked       int           `json:"checked"`
+	Healthy       int           `json:"healthy"`
+	Repinned      int           `json:"repinned"`
+	Degraded      int           `json:"degraded"`
+	Recovered     int           `json:"recovered"`
+	Unrecoverable int           `json:"unrecoverable"`
+	Results       []VaultResult `json:"results"`
+}
+
+// VaultSource lists the vaults recorded on chain
+type VaultSource func(ctx context.Context) ([]Vault, error)
+
+// Uploader adds raw bytes to IPFS and returns the resulting CID
+type Uploader func(ctx context.Context, data []byte) (string, error)
+
+// Notifier is called once for each vault newly flagged as unrecoverable so
+// its owner can be told to restore from backup
+type Notifier func(ctx context.Context, result VaultResult)
+
+// NewQueryVaultSource lists vaults through the dwn Vaults query, following
+// pagination until every vault has been read.
+func NewQueryVaultSource(client types.QueryClient) VaultSource {
+	return func(ctx context.Context) ([]Vault, error) {
+		var (
+			vaults []Vault
+			next   []byte
+		)
+		for {
+			res, err := client.Vaults(ctx, &types.QueryVaultsRequest{
+				Pagination: &query.PageRequest{Key: next, Limit: 200},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list vaults: %w", err)
+			}
+			for _, v := range res.Vaults {
+				vault := Vault{CID: v.VaultId, Owner: v.Owner}
+				if v.EnclaveData != nil {
+					vault.Data = v.EnclaveData.PrivateData
+				}
+				vaults = append(vaults, vault)
+			}
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return vaults, nil
+			}
+			next = res.Pagination.NextKey
+		}
+	}
+}
+
+// Checker periodically verifies vault pins across providers
+type Checker struct {
+	source      VaultSource
+	providers   []Provider
+	uploader    Uploader
+	notify      Notifier
+	interval    time.Duration
+	concurrency int
+
+	mu      sync.RWMutex
+	last    *Report
+	flagged map[string]bool
+}
+
+// NewChecker creates a checker. uploader and notify may be nil, in which case
+// on-chain recovery and notifications are skipped.
+func NewChecker(
+	source VaultSource,
+	providers []Provider,
+	uploader Uploader,
+	notify Notifier,
+	interval time.Duration,
+) *Checker {
+	return &Checker{
+		source:      source,
+		providers:   providers,
+		uploader:    uploader,
+		notify:      notify,
+		interval:    interval,
+		concurrency: 8,
+		flagged:     make(map[string]bool),
+	}
+}
+
+// Start runs the checker every interval until ctx is cancelled
+func (c *Checker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := c.Run(ctx); err != nil {
+				logger.Error("vault pin check failed", "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Run performs a single pass over every vault
+func (c *Checker) Run(ctx context.Context) (*Report, error) {
+	if len(c.providers) == 0 {
+		return nil, errors.New("no pinning providers configured")
+	}
+
+	report := &Report{StartedAt: time.Now().UTC()}
+	for _, p := range c.providers {
+		report.Providers = append(report.Providers, p.Name())
+	}
+
+	vaults, err := c.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]VaultResult, len(vaults))
+	sem := make(chan struct{}, c.concurrency)
+	var wg sync.WaitGroup
+	for i, v := range vaults {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, v Vault) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = c.checkVault(ctx, v)
+		}(i, v)
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		report.Checked++
+		switch r.Status {
+		case StatusHealthy:
+			report.Healthy++
+			continue
+		case StatusRepinned:
+			report.Repinned++
+		case StatusDegraded:
+			report.Degraded++
+		case StatusRecovered:
+			report.Recovered++
+		case StatusUnrecoverable:
+			report.Unrecoverable++
+		}
+		report.Results = append(report.Results, r)
+	}
+	report.CompletedAt = time.Now().UTC()
+
+	c.flagUnrecoverable(ctx, results)
+
+	c.mu.Lock()
+	c.last = report
+	c.mu.Unlock()
+
+	logger.Info("vault pin check complete",
+		"checked", report.Checked,
+		"repinned", report.Repinned,
+		"degraded", report.Degraded,
+		"recovered", report.Recovered,
+		"unrecoverable", report.Unrecoverable,
+	)
+	return report, nil
+}
+
+// LastReport returns the report of the most recent run, if any
+func (c *Checker) LastReport() *Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.last
+}
+
+// checkVault verifies and repairs the pins of a single vault
+func (c *Checker) checkVault(ctx context.Context, v Vault) VaultResult {
+	result := VaultResult{CID: v.CID, Owner: v.Owner}
+
+	var missing []Provider
+	for _, p := range c.providers {
+		pinned, err := p.IsPinned(ctx, v.CID)
+		switch {
+		case err != nil:
+			// Unknown state is not treated as missing to avoid pin storms
+			// while a provider is down.
+			result.Errors = append(result.Errors, err.Error())
+		case pinned:
+			result.PinnedOn = append(result.PinnedOn, p.Name())
+		default:
+			missing = append(missing, p)
+		}
+	}
+
+	if len(missing) == 0 {
+		result.Status = StatusHealthy
+		if len(result.Errors) > 0 {
+			// A provider could not be queried; report without acting.
+			result.Status = StatusDegraded
+		}
+		return result
+	}
+
+	failed := c.repin(ctx, v.CID, missing, &result)
+	switch {
+	case len(failed) == 0:
+		result.Status = StatusRepinned
+	case len(result.PinnedOn) > 0 || len(result.RepinnedOn) > 0 || len(missing) < len(c.providers):
+		// The content still exists somewhere, or a provider that could not be
+		// queried may hold it.
+		result.Status = StatusDegraded
+	case c.restoreFromChain(ctx, v, &result):
+		if failed = c.repin(ctx, v.CID, failed, &result); len(failed) == 0 {
+			result.Status = StatusRecovered
+		} else {
+			result.Status = StatusDegraded
+		}
+	default:
+		result.Status = StatusUnrecoverable
+	}
+	return result
+}
+
+// repin pins cid on each provider and returns those that failed
+func (c *Checker) repin(ctx context.Context, cid string, providers []Provider, result *VaultResult) []Provider {
+	var failed []Provider
+	for _, p := range providers {
+		if err := p.Pin(ctx, cid); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			failed = append(failed, p)
+			continue
+		}
+		result.RepinnedOn = append(result.RepinnedOn, p.Name())
+	}
+	return failed
+}
+
+// restoreFromChain re-adds the on-chain copy of a vault and checks it hashes to the
+// recorded CID
+func (c *Checker) restoreFromChain(ctx context.Context, v Vault, result *VaultResult) bool {
+	if c.uploader == nil || len(v.Data) == 0 {
+		return false
+	}
+	cid, err := c.uploader(ctx, v.Data)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("re-add from chain: %v", err))
+		return false
+	}
+	if cid != v.CID {
+		result.Errors = append(result.Errors, fmt.Sprintf("re-added content hashed to %s, expected %s", cid, v.CID))
+		return false
+	}
+	return true
+}
+
+// flagUnrecoverable notifies owners of vaults that became unrecoverable and
+// clears flags of vaults that have since been restored
+func (c *Checker) flagUnrecoverable(ctx context.Context, results []VaultResult) {
+	var toNotify []VaultResult
+
+	c.mu.Lock()
+	for _, r := range results {
+		switch {
+		case r.Status == StatusUnrecoverable && !c.flagged[r.CID]:
+			c.flagged[r.CID] = true
+			toNotify = append(toNotify, r)
+		case r.Status != StatusUnrecoverable:
+			delete(c.flagged, r.CID)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, r := range toNotify {
+		logger.Error("vault content unrecoverable", "cid", r.CID, "owner", r.Owner)
+		if c.notify != nil {
+			c.notify(ctx, r)
+		}
+	}
+}
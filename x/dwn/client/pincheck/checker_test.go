@@ -0,0 +1,143 @@
+package pincheck_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/client/pincheck"
+)
+
+// fakeProvider is an in-memory pinning provider
+type fakeProvider struct {
+	name string
+
+	mu        sync.Mutex
+	pins      map[string]bool
+	canFetch  func(cid string) bool
+	queryErr  error
+	pinCalled int
+}
+
+func newFakeProvider(name string, cids ...string) *fakeProvider {
+	p := &fakeProvider{name: name, pins: map[string]bool{}, canFetch: func(string) bool { return true }}
+	for _, cid := range cids {
+		p.pins[cid] = true
+	}
+	return p
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) IsPinned(_ context.Context, cid string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.queryErr != nil {
+		return false, p.queryErr
+	}
+	return p.pins[cid], nil
+}
+
+func (p *fakeProvider) Pin(_ context.Context, cid string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pinCalled++
+	if !p.canFetch(cid) {
+		return errors.New("content not found on network")
+	}
+	p.pins[cid] = true
+	return nil
+}
+
+func source(vaults ...pincheck.Vault) pincheck.VaultSource {
+	return func(context.Context) ([]pincheck.Vault, error) { return vaults, nil }
+}
+
+func TestCheckerRepinsMissingContent(t *testing.T) {
+	a := newFakeProvider("kubo", "bafy-healthy", "bafy-partial")
+	b := newFakeProvider("remote", "bafy-healthy")
+
+	c := pincheck.NewChecker(
+		source(pincheck.Vault{CID: "bafy-healthy"}, pincheck.Vault{CID: "bafy-partial"}),
+		[]pincheck.Provider{a, b}, nil, nil, time.Minute,
+	)
+
+	report, err := c.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Checked)
+	require.Equal(t, 1, report.Healthy)
+	require.Equal(t, 1, report.Repinned)
+	require.Len(t, report.Results, 1)
+	require.Equal(t, []string{"remote"}, report.Results[0].RepinnedOn)
+	require.True(t, b.pins["bafy-partial"])
+	require.Same(t, report, c.LastReport())
+}
+
+func TestCheckerRecoversFromOnChainCopy(t *testing.T) {
+	p := newFakeProvider("kubo")
+	uploaded := false
+	p.canFetch = func(string) bool { return uploaded }
+
+	uploader := func(_ context.Context, data []byte) (string, error) {
+		require.Equal(t, []byte("ciphertext"), data)
+		uploaded = true
+		return "bafy-lost", nil
+	}
+
+	c := pincheck.NewChecker(
+		source(pincheck.Vault{CID: "bafy-lost", Owner: "idx1owner", Data: []byte("ciphertext")}),
+		[]pincheck.Provider{p}, uploader, nil, time.Minute,
+	)
+
+	report, err := c.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Recovered)
+	require.Equal(t, pincheck.StatusRecovered, report.Results[0].Status)
+	require.True(t, p.pins["bafy-lost"])
+}
+
+func TestCheckerFlagsUnrecoverableOnce(t *testing.T) {
+	p := newFakeProvider("kubo")
+	p.canFetch = func(string) bool { return false }
+
+	var notified []pincheck.VaultResult
+	notify := func(_ context.Context, r pincheck.VaultResult) { notified = append(notified, r) }
+
+	// The on-chain copy no longer matches the recorded CID.
+	uploader := func(context.Context, []byte) (string, error) { return "bafy-other", nil }
+
+	c := pincheck.NewChecker(
+		source(pincheck.Vault{CID: "bafy-gone", Owner: "idx1owner", Data: []byte("x")}),
+		[]pincheck.Provider{p}, uploader, notify, time.Minute,
+	)
+
+	for i := 0; i < 2; i++ {
+		report, err := c.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Unrecoverable)
+	}
+	require.Len(t, notified, 1)
+	require.Equal(t, "idx1owner", notified[0].Owner)
+}
+
+func TestCheckerDoesNotActWhenProviderUnavailable(t *testing.T) {
+	down := newFakeProvider("remote")
+	down.queryErr = errors.New("connection refused")
+	empty := newFakeProvider("kubo")
+	empty.canFetch = func(string) bool { return false }
+
+	c := pincheck.NewChecker(
+		source(pincheck.Vault{CID: "bafy-unknown"}),
+		[]pincheck.Provider{down, empty}, nil, nil, time.Minute,
+	)
+
+	report, err := c.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Degraded)
+	require.Zero(t, report.Unrecoverable)
+	require.Zero(t, down.pinCalled)
+}
@@ -0,0 +1,148 @@
+package pincheck
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// providerTimeout bounds a single call to a pinning provider
+const providerTimeout = 30 * time.Second
+
+// Config selects the providers a node checks vault pins on and releases
+// attachments from. Nothing runs unless Enabled is set.
+type Config struct {
+	// Enabled starts the pin checker and the attachment releaser.
+	Enabled bool `mapstructure:"enabled"`
+	// Interval is the time between pin checks.
+	Interval time.Duration `mapstructure:"interval"`
+	// ReleaseInterval is the time between attachment release sweeps.
+	ReleaseInterval time.Duration `mapstructure:"release-interval"`
+	// AdminAddress is the listener serving the admin endpoints. The
+	// endpoints are not authenticated, so it should not be public.
+	AdminAddress string `mapstructure:"admin-address"`
+	// RPCEndpoint is the CometBFT RPC the releaser reads block results from.
+	RPCEndpoint string `mapstructure:"rpc-endpoint"`
+	// KuboEndpoints are Kubo RPC APIs, e.g. http://127.0.0.1:5001.
+	KuboEndpoints []string `mapstructure:"kubo-endpoints"`
+	// PinningServiceEndpoint is an IPFS Pinning Service API, used with
+	// PinningServiceToken.
+	PinningServiceEndpoint string `mapstructure:"pinning-service-endpoint"`
+	PinningServiceToken    string `mapstructure:"pinning-service-token"`
+}
+
+// DefaultConfig returns the default (disabled) configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval:        time.Hour,
+		ReleaseInterval: time.Minute,
+		AdminAddress:    "127.0.0.1:1319",
+		RPCEndpoint:     "http://127.0.0.1:26657",
+		KuboEndpoints:   []string{},
+	}
+}
+
+// ConfigFromAppOptions reads the [vault-pins] section of app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	cfg.Enabled = cast.ToBool(appOpts.Get("vault-pins.enabled"))
+	if v := cast.ToDuration(appOpts.Get("vault-pins.interval")); v > 0 {
+		cfg.Interval = v
+	}
+	if v := cast.ToDuration(appOpts.Get("vault-pins.release-interval")); v > 0 {
+		cfg.ReleaseInterval = v
+	}
+	if v := cast.ToString(appOpts.Get("vault-pins.admin-address")); v != "" {
+		cfg.AdminAddress = v
+	}
+	if v := cast.ToString(appOpts.Get("vault-pins.rpc-endpoint")); v != "" {
+		cfg.RPCEndpoint = v
+	}
+	cfg.KuboEndpoints = cast.ToStringSlice(appOpts.Get("vault-pins.kubo-endpoints"))
+	cfg.PinningServiceEndpoint = cast.ToString(appOpts.Get("vault-pins.pinning-service-endpoint"))
+	cfg.PinningServiceToken = cast.ToString(appOpts.Get("vault-pins.pinning-service-token"))
+	return cfg
+}
+
+// Validate checks the configuration. A disabled configuration is always valid.
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if len(c.KuboEndpoints) == 0 && c.PinningServiceEndpoint == "" {
+		return fmt.Errorf("vault pin checks enabled but no providers configured")
+	}
+	if c.PinningServiceEndpoint != "" && c.PinningServiceToken == "" {
+		return fmt.Errorf("pinning service endpoint configured without a token")
+	}
+	if c.Interval <= 0 || c.ReleaseInterval <= 0 {
+		return fmt.Errorf("vault pin check intervals must be positive")
+	}
+	if c.RPCEndpoint == "" {
+		return fmt.Errorf("vault pin checks enabled but no rpc endpoint configured")
+	}
+	return nil
+}
+
+// Providers returns the configured providers. Every provider also
+// implements Unpinner.
+func (c Config) Providers() []Provider {
+	var providers []Provider
+	for i, endpoint := range c.KuboEndpoints {
+		providers = append(providers, NewKuboProvider(fmt.Sprintf("kubo-%d", i), endpoint, providerTimeout))
+	}
+	if c.PinningServiceEndpoint != "" {
+		providers = append(providers, NewPinningServiceProvider(
+			"pinning-service", c.PinningServiceEndpoint, c.PinningServiceToken, providerTimeout,
+		))
+	}
+	return providers
+}
+
+// Unpinners returns the configured providers as Unpinners
+func (c Config) Unpinners() []Unpinner {
+	var unpinners []Unpinner
+	for _, p := range c.Providers() {
+		unpinners = append(unpinners, p.(Unpinner))
+	}
+	return unpinners
+}
+
+// CometRPC returns a client for the configured CometBFT RPC
+func (c Config) CometRPC() *CometRPC {
+	return NewCometRPC(c.RPCEndpoint, providerTimeout)
+}
+
+// ConfigTemplate is appended to the app.toml template.
+const ConfigTemplate = `
+###############################################################################
+###                              Vault Pins                                 ###
+###############################################################################
+
+# Checks that every vault recorded on chain is pinned on the providers below,
+# re-pinning missing content, and unpins attachments the dwn module releases.
+[vault-pins]
+
+enabled = {{ .VaultPins.Enabled }}
+
+# Time between pin checks and between attachment release sweeps.
+interval = "{{ .VaultPins.Interval }}"
+release-interval = "{{ .VaultPins.ReleaseInterval }}"
+
+# Listener for GET /admin/vaults/pins and POST /admin/vaults/pins/run. The
+# endpoints are not authenticated; keep this address private.
+admin-address = "{{ .VaultPins.AdminAddress }}"
+
+# CometBFT RPC the releaser reads block results from.
+rpc-endpoint = "{{ .VaultPins.RPCEndpoint }}"
+
+# Kubo RPC APIs, e.g. "http://127.0.0.1:5001".
+kubo-endpoints = [{{ range .VaultPins.KuboEndpoints }}{{ printf "%q, " . }}{{ end }}]
+
+# IPFS Pinning Service API endpoint and its access token.
+pinning-service-endpoint = "{{ .VaultPins.PinningServiceEndpoint }}"
+pinning-service-token = "{{ .VaultPins.PinningServiceToken }}"
+`
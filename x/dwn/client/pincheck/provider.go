@@ -0,0 +1,212 @@
+// Package pincheck continuously verifies that every vault CID recorded on
+// chain is pinned and retrievable on the configured IPFS providers, re-pins
+// missing content, and flags vaults whose data can no longer be recovered.
+package pincheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Provider is an IPFS pinning backend.
+type Provider interface {
+	// Name identifies the provider in reports.
+	Name() string
+	// IsPinned reports whether the provider holds a pin for cid.
+	IsPinned(ctx context.Context, cid string) (bool, error)
+	// Pin asks the provider to pin cid, fetching it from the network.
+	Pin(ctx context.Context, cid string) error
+}
+
+// KuboProvider talks to a Kubo (go-ipfs) node over its RPC API.
+type KuboProvider struct {
+	name     string
+	endpoint string
+	client   *http.Client
+}
+
+// NewKuboProvider creates a provider for the Kubo RPC API at endpoint,
+// e.g. http://127.0.0.1:5001.
+func NewKuboProvider(name, endpoint string, timeout time.Duration) *KuboProvider {
+	return &KuboProvider{
+		name:     name,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Name implements Provider.
+func (p *KuboProvider) Name() string { return p.name }
+
+// IsPinned implements Provider.
+func (p *KuboProvider) IsPinned(ctx context.Context, cid string) (bool, error) {
+	resp, err := p.call(ctx, "/api/v0/pin/ls", url.Values{"arg": {cid}, "type": {"all"}})
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return true, nil
+	}
+
+	// Kubo answers 500 with a "not pinned" message for unpinned CIDs.
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if strings.Contains(string(body), "not pinned") {
+		return false, nil
+	}
+	return false, fmt.Errorf("%s: pin/ls returned %d: %s", p.name, resp.StatusCode, body)
+}
+
+// Pin implements Provider.
+func (p *KuboProvider) Pin(ctx context.Context, cid string) error {
+	resp, err := p.call(ctx, "/api/v0/pin/add", url.Values{"arg": {cid}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s: pin/add returned %d: %s", p.name, resp.StatusCode, body)
+	}
+	return nil
+}
+
+// Unpin implements Unpinner. Content that is not pinned is left alone.
+func (p *KuboProvider) Unpin(ctx context.Context, cid string) error {
+	resp, err := p.call(ctx, "/api/v0/pin/rm", url.Values{"arg": {cid}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if strings.Contains(string(body), "not pinned") {
+			return nil
+		}
+		return fmt.Errorf("%s: pin/rm returned %d: %s", p.name, resp.StatusCode, body)
+	}
+	return nil
+}
+
+func (p *KuboProvider) call(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.name, err)
+	}
+	return resp, nil
+}
+
+// PinningServiceProvider talks to a remote service implementing the IPFS
+// Pinning Service API (https://ipfs.github.io/pinning-services-api-spec/).
+type PinningServiceProvider struct {
+	name     string
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+// NewPinningServiceProvider creates a provider for a remote pinning service.
+func NewPinningServiceProvider(name, endpoint, token string, timeout time.Duration) *PinningServiceProvider {
+	return &PinningServiceProvider{
+		name:     name,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		token:    token,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Name implements Provider.
+func (p *PinningServiceProvider) Name() string { return p.name }
+
+// IsPinned implements Provider. Pins still being fetched count as pinned so
+// the checker does not queue duplicate requests.
+func (p *PinningServiceProvider) IsPinned(ctx context.Context, cid string) (bool, error) {
+	query := url.Values{"cid": {cid}, "status": {"queued,pinning,pinned"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/pins?"+query.Encode(), nil)
+	if err != nil {
+		return false, err
+	}
+
+	var out struct {
+		Count int `json:"count"`
+	}
+	if err := p.do(req, &out); err != nil {
+		return false, err
+	}
+	return out.Count > 0, nil
+}
+
+// Pin implements Provider.
+func (p *PinningServiceProvider) Pin(ctx context.Context, cid string) error {
+	body, err := json.Marshal(map[string]string{"cid": cid, "name": "sonr-vault-" + cid})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/pins", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return p.do(req, nil)
+}
+
+// Unpin implements Unpinner by removing every pin request for cid.
+func (p *PinningServiceProvider) Unpin(ctx context.Context, cid string) error {
+	query := url.Values{"cid": {cid}, "status": {"queued,pinning,pinned,failed"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/pins?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	var out struct {
+		Results []struct {
+			RequestID string `json:"requestid"`
+		} `json:"results"`
+	}
+	if err := p.do(req, &out); err != nil {
+		return err
+	}
+	for _, r := range out.Results {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, p.endpoint+"/pins/"+url.PathEscape(r.RequestID), nil)
+		if err != nil {
+			return err
+		}
+		if err := p.do(req, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *PinningServiceProvider) do(req *http.Request, out any) error {
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s: %s %s returned %d: %s", p.name, req.Method, req.URL.Path, resp.StatusCode, body)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
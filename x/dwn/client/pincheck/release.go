@@ -0,0 +1,211 @@
+package pincheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// releaseEvents are the typed dwn events whose released_cids attribute names
+// attachments no record references any more
+var releaseEvents = map[string]bool{
+	"dwn.v1.EventRecordExpired": true,
+	"dwn.v1.EventRecordDeleted": true,
+	"dwn.v1.EventRecordWritten": true,
+}
+
+// Unpinner is a provider that can drop pins
+type Unpinner interface {
+	// Name identifies the provider in logs.
+	Name() string
+	// Unpin removes the provider's pin for cid, if any.
+	Unpin(ctx context.Context, cid string) error
+}
+
+// ReleaseSource returns the attachment CIDs released in the block at height
+type ReleaseSource func(ctx context.Context, height int64) ([]string, error)
+
+// HeightSource returns the latest block height
+type HeightSource func(ctx context.Context) (int64, error)
+
+// CometRPC reads released attachments from the block results of a CometBFT
+// RPC endpoint, e.g. http://127.0.0.1:26657
+type CometRPC struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewCometRPC creates a client for the CometBFT RPC at endpoint
+func NewCometRPC(endpoint string, timeout time.Duration) *CometRPC {
+	return &CometRPC{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// LatestHeight implements HeightSource.
+func (c *CometRPC) LatestHeight(ctx context.Context) (int64, error) {
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, "/status", &out); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// Released implements ReleaseSource. Expired records are released at end
+// block, deleted and rewritten ones in transactions.
+func (c *CometRPC) Released(ctx context.Context, height int64) ([]string, error) {
+	type event struct {
+		Type       string `json:"type"`
+		Attributes []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"attributes"`
+	}
+	var out struct {
+		Result struct {
+			TxsResults []struct {
+				Code   uint32  `json:"code"`
+				Events []event `json:"events"`
+			} `json:"txs_results"`
+			FinalizeBlockEvents []event `json:"finalize_block_events"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, "/block_results?height="+strconv.FormatInt(height, 10), &out); err != nil {
+		return nil, err
+	}
+
+	events := out.Result.FinalizeBlockEvents
+	for _, tx := range out.Result.TxsResults {
+		if tx.Code == 0 {
+			events = append(events, tx.Events...)
+		}
+	}
+
+	var cids []string
+	for _, e := range events {
+		if !releaseEvents[e.Type] {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key != "released_cids" {
+				continue
+			}
+			var released []string
+			if err := json.Unmarshal([]byte(attr.Value), &released); err != nil {
+				return nil, fmt.Errorf("height %d: %s released_cids: %w", height, e.Type, err)
+			}
+			cids = append(cids, released...)
+		}
+	}
+	return cids, nil
+}
+
+func (c *CometRPC) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", path, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// Releaser unpins the attachments of expired and deleted DWN records. It
+// follows the chain block by block, unpinning every CID the dwn module
+// released, so pins only outlive their records until the next sweep.
+type Releaser struct {
+	source    ReleaseSource
+	latest    HeightSource
+	providers []Unpinner
+
+	mu   sync.Mutex
+	next int64
+}
+
+// NewReleaser creates a releaser that starts at height from; zero starts at
+// the next block.
+func NewReleaser(source ReleaseSource, latest HeightSource, providers []Unpinner, from int64) *Releaser {
+	return &Releaser{source: source, latest: latest, providers: providers, next: from}
+}
+
+// Start sweeps every interval until ctx is cancelled
+func (r *Releaser) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := r.Sweep(ctx); err != nil {
+				logger.Error("attachment release sweep failed", "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Sweep unpins the attachments released in the blocks since the last sweep
+// and returns how many were unpinned. A block is retried on the next sweep
+// until every provider unpinned its attachments.
+func (r *Releaser) Sweep(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	latest, err := r.latest(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if r.next == 0 {
+		r.next = latest + 1
+	}
+
+	unpinned := 0
+	for ; r.next <= latest; r.next++ {
+		cids, err := r.source(ctx, r.next)
+		if err != nil {
+			return unpinned, err
+		}
+		for _, cid := range cids {
+			for _, p := range r.providers {
+				if err := p.Unpin(ctx, cid); err != nil {
+					return unpinned, fmt.Errorf("height %d: %s: %w", r.next, p.Name(), err)
+				}
+			}
+			unpinned++
+		}
+		if len(cids) > 0 {
+			logger.Info("unpinned released attachments", "height", r.next, "count", len(cids))
+		}
+	}
+	return unpinned, nil
+}
+
+// Next returns the next height the releaser will sweep
+func (r *Releaser) Next() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.next
+}
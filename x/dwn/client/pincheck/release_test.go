@@ -0,0 +1,88 @@
+package pincheck_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/client/pincheck"
+)
+
+// fakeUnpinner records the CIDs it unpinned
+type fakeUnpinner struct {
+	unpinned []string
+	err      error
+}
+
+func (u *fakeUnpinner) Name() string { return "fake" }
+
+func (u *fakeUnpinner) Unpin(_ context.Context, cid string) error {
+	if u.err != nil {
+		return u.err
+	}
+	u.unpinned = append(u.unpinned, cid)
+	return nil
+}
+
+func TestReleaserUnpinsReleasedAttachments(t *testing.T) {
+	released := map[int64][]string{11: {"cid-a"}, 13: {"cid-b", "cid-c"}}
+	source := func(_ context.Context, height int64) ([]string, error) { return released[height], nil }
+	height := int64(12)
+	latest := func(context.Context) (int64, error) { return height, nil }
+
+	u := &fakeUnpinner{err: errors.New("provider down")}
+	r := pincheck.NewReleaser(source, latest, []pincheck.Unpinner{u}, 10)
+
+	// A failing provider holds the sweep at the block it failed on
+	_, err := r.Sweep(context.Background())
+	require.Error(t, err)
+	require.Equal(t, int64(11), r.Next())
+
+	u.err = nil
+	n, err := r.Sweep(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, int64(13), r.Next())
+
+	height = 13
+	n, err = r.Sweep(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"cid-a", "cid-b", "cid-c"}, u.unpinned)
+}
+
+func TestCometRPCReleased(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			_, _ = w.Write([]byte(`{"result":{"sync_info":{"latest_block_height":"42"}}}`))
+		case "/block_results":
+			require.Equal(t, "42", r.URL.Query().Get("height"))
+			_, _ = w.Write([]byte(`{"result":{
+				"txs_results":[
+					{"code":0,"events":[{"type":"dwn.v1.EventRecordDeleted","attributes":[{"key":"released_cids","value":"[\"cid-a\"]"}]}]},
+					{"code":5,"events":[{"type":"dwn.v1.EventRecordDeleted","attributes":[{"key":"released_cids","value":"[\"cid-failed\"]"}]}]}
+				],
+				"finalize_block_events":[
+					{"type":"dwn.v1.EventRecordExpired","attributes":[{"key":"record_id","value":"\"r1\""},{"key":"released_cids","value":"[\"cid-b\"]"}]},
+					{"type":"transfer","attributes":[{"key":"released_cids","value":"[\"cid-other\"]"}]}
+				]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := pincheck.NewCometRPC(srv.URL, 0)
+	height, err := c.LatestHeight(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(42), height)
+
+	cids, err := c.Released(context.Background(), height)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cid-b", "cid-a"}, cids)
+}
@@ -18,6 +18,7 @@ type Manager struct {
 	mu           sync.RWMutex
 	plugins      map[string]*PluginState
 	loaderConfig *LoaderConfig
+	scheduler    *SigningScheduler
 
 	// Cleanup configuration
 	cleanupInterval time.Duration
@@ -37,6 +38,7 @@ func NewManager(loaderConfig *LoaderConfig) *Manager {
 	m := &Manager{
 		plugins:         make(map[string]*PluginState),
 		loaderConfig:    loaderConfig,
+		scheduler:       DefaultScheduler,
 		cleanupInterval: 5 * time.Minute,
 		maxIdleTime:     30 * time.Minute,
 		stopCleanup:     make(chan struct{}),
@@ -403,8 +405,20 @@ func (p *managedPluginImpl) NewAttenuatedToken(
 
 // Cryptographic Operations with health monitoring
 
+// SignData queues the signing ceremony on the manager's scheduler, one queue
+// per plugin instance.
 func (p *managedPluginImpl) SignData(req *SignDataRequest) (*SignDataResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), p.state.Config.Timeouts.Signature)
+	var resp *SignDataResponse
+	err := p.manager.scheduler.Submit(context.Background(), p.state.ID, func(ctx context.Context) error {
+		var err error
+		resp, err = p.signData(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+func (p *managedPluginImpl) signData(ctx context.Context, req *SignDataRequest) (*SignDataResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.state.Config.Timeouts.Signature)
 	defer cancel()
 
 	reqBytes, err := json.Marshal(req)
@@ -32,6 +32,7 @@ package plugin
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 
@@ -94,7 +95,12 @@ func LoadPluginWithEnclave(
 	if err != nil {
 		return nil, err
 	}
-	return &pluginImpl{plugin: plugin}, nil
+	sum := sha256.Sum256(enclaveData)
+	return &pluginImpl{
+		plugin:    plugin,
+		user:      fmt.Sprintf("%s_%x", chainID, sum[:8]),
+		scheduler: DefaultScheduler,
+	}, nil
 }
 
 // LoadPluginWithManager loads a Motor plugin using the enhanced plugin manager.
@@ -115,6 +121,9 @@ func LoadPluginWithManager(ctx context.Context, config *EnclaveConfig) (Plugin,
 
 type pluginImpl struct {
 	plugin *extism.Plugin
+	// user identifies the enclave to the signing scheduler
+	user      string
+	scheduler *SigningScheduler
 }
 
 // UCAN Token Operations - Primary interface for the refactored Motor plugin
@@ -156,12 +165,24 @@ func (p *pluginImpl) NewAttenuatedToken(
 
 // Cryptographic Operations
 
+// SignData queues the signing ceremony on the scheduler, shedding it with an
+// OverloadError when the node is at capacity.
 func (p *pluginImpl) SignData(req *SignDataRequest) (*SignDataResponse, error) {
+	var resp *SignDataResponse
+	err := p.scheduler.Submit(context.Background(), p.user, func(ctx context.Context) error {
+		var err error
+		resp, err = p.signData(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+func (p *pluginImpl) signData(ctx context.Context, req *SignDataRequest) (*SignDataResponse, error) {
 	reqBytes, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
-	_, r, err := p.plugin.Call("sign_data", reqBytes)
+	_, r, err := p.plugin.CallWithContext(ctx, "sign_data", reqBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,395 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Scheduler errors
+var (
+	ErrSchedulerClosed = errors.New("signing scheduler closed")
+	ErrEmptyUserID     = errors.New("signing request requires a user id")
+)
+
+// OverloadError is returned when a signing request is shed because the
+// scheduler is at capacity. RetryAfter estimates when capacity frees up and is
+// suitable for a Retry-After response header.
+type OverloadError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *OverloadError) Error() string {
+	return fmt.Sprintf("signing scheduler overloaded: %s (retry after %s)", e.Reason, e.RetryAfter)
+}
+
+// CeremonyFunc performs one MPC signing ceremony.
+type CeremonyFunc func(ctx context.Context) error
+
+// SchedulerConfig bounds the signing scheduler.
+type SchedulerConfig struct {
+	MaxConcurrent   int           // Ceremonies allowed to run at once
+	MaxQueuePerUser int           // Requests a single user may have waiting
+	MaxQueueTotal   int           // Requests waiting across all users before shedding
+	MaxWait         time.Duration // Requests waiting longer than this are served next
+	CeremonyTimeout time.Duration // Upper bound on a single ceremony
+}
+
+// DefaultSchedulerConfig returns limits suited to a single vault node.
+func DefaultSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		MaxConcurrent:   8,
+		MaxQueuePerUser: 16,
+		MaxQueueTotal:   512,
+		MaxWait:         5 * time.Second,
+		CeremonyTimeout: 30 * time.Second,
+	}
+}
+
+// Validate checks the scheduler configuration.
+func (c SchedulerConfig) Validate() error {
+	if c.MaxConcurrent <= 0 {
+		return fmt.Errorf("max concurrent ceremonies must be positive")
+	}
+	if c.MaxQueuePerUser <= 0 || c.MaxQueueTotal <= 0 {
+		return fmt.Errorf("queue limits must be positive")
+	}
+	if c.MaxQueuePerUser > c.MaxQueueTotal {
+		return fmt.Errorf("per-user queue limit exceeds total queue limit")
+	}
+	if c.MaxWait <= 0 || c.CeremonyTimeout <= 0 {
+		return fmt.Errorf("max wait and ceremony timeout must be positive")
+	}
+	return nil
+}
+
+// SchedulerStats is a point-in-time view of the scheduler.
+type SchedulerStats struct {
+	Running         int           `json:"running"`
+	Queued          int           `json:"queued"`
+	ActiveUsers     int           `json:"active_users"`
+	AverageCeremony time.Duration `json:"average_ceremony"`
+}
+
+// signJob is a queued ceremony waiting for a slot.
+type signJob struct {
+	user       string
+	enqueuedAt time.Time
+	ready      chan struct{}
+	dispatched bool
+}
+
+// SigningScheduler runs MPC signing ceremonies with per-user queues. Users are
+// served round-robin so one busy user cannot monopolize the node, and any
+// request that has waited longer than MaxWait is served ahead of the rotation
+// to prevent starvation. When queues are full, requests are shed with an
+// OverloadError instead of piling up.
+type SigningScheduler struct {
+	cfg     SchedulerConfig
+	metrics *schedulerMetrics
+
+	mu       sync.Mutex
+	queues   map[string][]*signJob
+	ring     []string // users with queued jobs, in rotation order
+	next     int
+	running  int
+	queued   int
+	avgNanos float64 // EWMA of ceremony duration
+	closed   bool
+}
+
+// DefaultScheduler queues the SignData calls of every plugin loaded by this
+// package, so ceremonies on a node share one set of limits.
+var DefaultScheduler = mustNewSigningScheduler(DefaultSchedulerConfig(), prometheus.DefaultRegisterer)
+
+func mustNewSigningScheduler(cfg SchedulerConfig, reg prometheus.Registerer) *SigningScheduler {
+	s, err := NewSigningScheduler(cfg, reg)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewSigningScheduler creates a scheduler. Metrics are registered with reg
+// when it is non-nil.
+func NewSigningScheduler(cfg SchedulerConfig, reg prometheus.Registerer) (*SigningScheduler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	metrics := newSchedulerMetrics()
+	if reg != nil {
+		if err := metrics.register(reg); err != nil {
+			return nil, err
+		}
+	}
+	return &SigningScheduler{
+		cfg:      cfg,
+		metrics:  metrics,
+		queues:   make(map[string][]*signJob),
+		avgNanos: float64(time.Second),
+	}, nil
+}
+
+// Submit queues a ceremony for user and blocks until it has run, the context
+// is cancelled, or the request is shed.
+func (s *SigningScheduler) Submit(ctx context.Context, user string, fn CeremonyFunc) error {
+	if user == "" {
+		return ErrEmptyUserID
+	}
+
+	job, err := s.enqueue(user)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-job.ready:
+	case <-ctx.Done():
+		if s.abandon(job) {
+			return ctx.Err()
+		}
+		// Dispatched concurrently with cancellation; give the slot back.
+		s.finish(0)
+		return ctx.Err()
+	}
+
+	s.metrics.wait.Observe(time.Since(job.enqueuedAt).Seconds())
+
+	ceremonyCtx, cancel := context.WithTimeout(ctx, s.cfg.CeremonyTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err = fn(ceremonyCtx)
+	elapsed := time.Since(start)
+
+	s.metrics.duration.Observe(elapsed.Seconds())
+	if err != nil {
+		s.metrics.failures.Inc()
+	}
+	s.finish(elapsed)
+	return err
+}
+
+// Stats returns current queue and ceremony statistics.
+func (s *SigningScheduler) Stats() SchedulerStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return SchedulerStats{
+		Running:         s.running,
+		Queued:          s.queued,
+		ActiveUsers:     len(s.ring),
+		AverageCeremony: time.Duration(s.avgNanos),
+	}
+}
+
+// Close rejects new requests with ErrSchedulerClosed. Requests already queued
+// still run.
+func (s *SigningScheduler) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+}
+
+func (s *SigningScheduler) enqueue(user string) (*signJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrSchedulerClosed
+	}
+	if s.queued >= s.cfg.MaxQueueTotal {
+		s.metrics.shed.WithLabelValues("total").Inc()
+		return nil, &OverloadError{Reason: "queue full", RetryAfter: s.retryAfterLocked(s.queued)}
+	}
+	if len(s.queues[user]) >= s.cfg.MaxQueuePerUser {
+		s.metrics.shed.WithLabelValues("user").Inc()
+		return nil, &OverloadError{
+			Reason:     "too many pending requests for user",
+			RetryAfter: s.retryAfterLocked(len(s.queues[user])),
+		}
+	}
+
+	job := &signJob{user: user, enqueuedAt: time.Now(), ready: make(chan struct{})}
+	if len(s.queues[user]) == 0 {
+		s.ring = append(s.ring, user)
+	}
+	s.queues[user] = append(s.queues[user], job)
+	s.queued++
+	s.metrics.queueDepth.Set(float64(s.queued))
+
+	s.dispatchLocked()
+	return job, nil
+}
+
+// abandon removes a cancelled job from its queue. It returns false if the job
+// had already been dispatched.
+func (s *SigningScheduler) abandon(job *signJob) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if job.dispatched {
+		return false
+	}
+	queue := s.queues[job.user]
+	for i, j := range queue {
+		if j == job {
+			s.queues[job.user] = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+	s.queued--
+	s.metrics.queueDepth.Set(float64(s.queued))
+	if len(s.queues[job.user]) == 0 {
+		s.removeUserLocked(job.user)
+	}
+	return true
+}
+
+// finish releases a ceremony slot and dispatches the next job.
+func (s *SigningScheduler) finish(elapsed time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running--
+	s.metrics.running.Set(float64(s.running))
+	if elapsed > 0 {
+		const alpha = 0.2
+		s.avgNanos = alpha*float64(elapsed) + (1-alpha)*s.avgNanos
+	}
+	s.dispatchLocked()
+}
+
+// dispatchLocked hands free slots to queued jobs. Starving jobs go first,
+// otherwise users are served round-robin, one job per turn.
+func (s *SigningScheduler) dispatchLocked() {
+	for s.running < s.cfg.MaxConcurrent && s.queued > 0 {
+		user := s.starvingUserLocked()
+		if user == "" {
+			if s.next >= len(s.ring) {
+				s.next = 0
+			}
+			user = s.ring[s.next]
+			s.next++
+		}
+
+		queue := s.queues[user]
+		job := queue[0]
+		s.queues[user] = queue[1:]
+		if len(s.queues[user]) == 0 {
+			s.removeUserLocked(user)
+		}
+
+		s.queued--
+		s.running++
+		s.metrics.queueDepth.Set(float64(s.queued))
+		s.metrics.running.Set(float64(s.running))
+
+		job.dispatched = true
+		close(job.ready)
+	}
+}
+
+// starvingUserLocked returns the user whose head job has waited longest,
+// provided it has exceeded MaxWait.
+func (s *SigningScheduler) starvingUserLocked() string {
+	var (
+		oldest time.Time
+		user   string
+	)
+	for _, u := range s.ring {
+		head := s.queues[u][0]
+		if user == "" || head.enqueuedAt.Before(oldest) {
+			oldest, user = head.enqueuedAt, u
+		}
+	}
+	if user != "" && time.Since(oldest) > s.cfg.MaxWait {
+		s.metrics.starved.Inc()
+		return user
+	}
+	return ""
+}
+
+func (s *SigningScheduler) removeUserLocked(user string) {
+	delete(s.queues, user)
+	for i, u := range s.ring {
+		if u == user {
+			s.ring = append(s.ring[:i], s.ring[i+1:]...)
+			if i < s.next {
+				s.next--
+			}
+			return
+		}
+	}
+}
+
+// retryAfterLocked estimates how long it takes to drain ahead requests.
+func (s *SigningScheduler) retryAfterLocked(ahead int) time.Duration {
+	rounds := ahead/s.cfg.MaxConcurrent + 1
+	retry := time.Duration(float64(rounds) * s.avgNanos)
+	if retry < time.Second {
+		retry = time.Second
+	}
+	return retry.Round(time.Second)
+}
+
+// schedulerMetrics are the Prometheus collectors exported by the scheduler.
+type schedulerMetrics struct {
+	queueDepth prometheus.Gauge
+	running    prometheus.Gauge
+	wait       prometheus.Histogram
+	duration   prometheus.Histogram
+	failures   prometheus.Counter
+	starved    prometheus.Counter
+	shed       *prometheus.CounterVec
+}
+
+func newSchedulerMetrics() *schedulerMetrics {
+	return &schedulerMetrics{
+		queueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "sonr_vault_signing_queue_depth",
+			Help: "Signing requests waiting for a ceremony slot.",
+		}),
+		running: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "sonr_vault_signing_ceremonies_running",
+			Help: "Signing ceremonies currently in progress.",
+		}),
+		wait: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "sonr_vault_signing_queue_wait_seconds",
+			Help:    "Time signing requests spent queued.",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		}),
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "sonr_vault_signing_ceremony_duration_seconds",
+			Help:    "Duration of MPC signing ceremonies.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+		}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "sonr_vault_signing_ceremony_failures_total",
+			Help: "Signing ceremonies that returned an error.",
+		}),
+		starved: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "sonr_vault_signing_starvation_promotions_total",
+			Help: "Requests served out of turn after exceeding the max wait.",
+		}),
+		shed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "sonr_vault_signing_shed_total",
+			Help: "Signing requests rejected due to overload, by limit.",
+		}, []string{"limit"}),
+	}
+}
+
+func (m *schedulerMetrics) register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		m.queueDepth, m.running, m.wait, m.duration, m.failures, m.starved, m.shed,
+	} {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
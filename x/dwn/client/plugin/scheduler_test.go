@@ -0,0 +1,243 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func testSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		MaxConcurrent:   4,
+		MaxQueuePerUser: 64,
+		MaxQueueTotal:   256,
+		MaxWait:         time.Second,
+		CeremonyTimeout: time.Second,
+	}
+}
+
+func TestSigningSchedulerConcurrencyLimit(t *testing.T) {
+	cfg := testSchedulerConfig()
+	s, err := NewSigningScheduler(cfg, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatalf("failed to create scheduler: %v", err)
+	}
+
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+		completed   atomic.Int32
+		wg          sync.WaitGroup
+	)
+
+	ceremony := func(context.Context) error {
+		n := inFlight.Add(1)
+		for {
+			peak := maxInFlight.Load()
+			if n <= peak || maxInFlight.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		inFlight.Add(-1)
+		completed.Add(1)
+		return nil
+	}
+
+	users := []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi"}
+	for _, user := range users {
+		for i := 0; i < 25; i++ {
+			wg.Add(1)
+			go func(user string) {
+				defer wg.Done()
+				if err := s.Submit(context.Background(), user, ceremony); err != nil {
+					t.Errorf("submit failed: %v", err)
+				}
+			}(user)
+		}
+	}
+	wg.Wait()
+
+	if got := completed.Load(); got != int32(len(users)*25) {
+		t.Fatalf("expected %d ceremonies, got %d", len(users)*25, got)
+	}
+	if peak := maxInFlight.Load(); peak > int32(cfg.MaxConcurrent) {
+		t.Fatalf("concurrency limit exceeded: %d > %d", peak, cfg.MaxConcurrent)
+	}
+	if stats := s.Stats(); stats.Running != 0 || stats.Queued != 0 || stats.ActiveUsers != 0 {
+		t.Fatalf("scheduler not drained: %+v", stats)
+	}
+}
+
+func TestSigningSchedulerFairness(t *testing.T) {
+	cfg := testSchedulerConfig()
+	cfg.MaxConcurrent = 1
+	s, err := NewSigningScheduler(cfg, nil)
+	if err != nil {
+		t.Fatalf("failed to create scheduler: %v", err)
+	}
+
+	// Hold the only slot so the queue builds up deterministically.
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_ = s.Submit(context.Background(), "heavy", func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	var (
+		mu    sync.Mutex
+		order []string
+		wg    sync.WaitGroup
+	)
+	record := func(user string) CeremonyFunc {
+		return func(context.Context) error {
+			mu.Lock()
+			order = append(order, user)
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = s.Submit(context.Background(), "heavy", record("heavy"))
+		}()
+	}
+	waitForQueued(t, s, 20)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = s.Submit(context.Background(), "light", record("light"))
+	}()
+	waitForQueued(t, s, 21)
+
+	close(release)
+	wg.Wait()
+
+	for i, user := range order {
+		if user == "light" {
+			if i > 1 {
+				t.Fatalf("light user served at position %d behind a busy user", i)
+			}
+			return
+		}
+	}
+	t.Fatal("light user never served")
+}
+
+func TestSigningSchedulerShedsWhenOverloaded(t *testing.T) {
+	cfg := testSchedulerConfig()
+	cfg.MaxConcurrent = 1
+	cfg.MaxQueuePerUser = 2
+	cfg.MaxQueueTotal = 3
+	s, err := NewSigningScheduler(cfg, nil)
+	if err != nil {
+		t.Fatalf("failed to create scheduler: %v", err)
+	}
+
+	release := make(chan struct{})
+	block := func(context.Context) error {
+		<-release
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	submit := func(user string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = s.Submit(context.Background(), user, block)
+		}()
+	}
+
+	submit("alice") // running
+	waitForRunning(t, s, 1)
+	submit("alice")
+	submit("alice")
+	waitForQueued(t, s, 2)
+
+	var overload *OverloadError
+	err = s.Submit(context.Background(), "alice", block)
+	if !errors.As(err, &overload) {
+		t.Fatalf("expected per-user overload, got %v", err)
+	}
+	if overload.RetryAfter <= 0 {
+		t.Fatalf("expected positive retry-after, got %s", overload.RetryAfter)
+	}
+
+	submit("bob")
+	waitForQueued(t, s, 3)
+	if err := s.Submit(context.Background(), "carol", block); !errors.As(err, &overload) {
+		t.Fatalf("expected total overload, got %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+}
+
+func TestSigningSchedulerCancelWhileQueued(t *testing.T) {
+	cfg := testSchedulerConfig()
+	cfg.MaxConcurrent = 1
+	s, err := NewSigningScheduler(cfg, nil)
+	if err != nil {
+		t.Fatalf("failed to create scheduler: %v", err)
+	}
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = s.Submit(context.Background(), "alice", func(context.Context) error {
+			<-release
+			return nil
+		})
+	}()
+	waitForRunning(t, s, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Submit(ctx, "bob", func(context.Context) error { return nil }); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if stats := s.Stats(); stats.Queued != 0 {
+		t.Fatalf("cancelled request left in queue: %+v", stats)
+	}
+
+	close(release)
+	<-done
+}
+
+func waitForQueued(t *testing.T, s *SigningScheduler, n int) {
+	t.Helper()
+	waitFor(t, func() bool { return s.Stats().Queued == n })
+}
+
+func waitForRunning(t *testing.T, s *SigningScheduler, n int) {
+	t.Helper()
+	waitFor(t, func() bool { return s.Stats().Running == n })
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for scheduler state")
+}
@@ -0,0 +1,66 @@
+//go:build chaos
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/common/ipfs"
+	"github.com/sonr-io/sonr/app/chaos"
+)
+
+// memIPFS keeps added blocks in memory
+type memIPFS struct {
+	ipfs.IPFSClient
+	blocks map[string][]byte
+}
+
+func (m memIPFS) Add(data []byte) (string, error) {
+	// Raw block with a sha2-256 multihash
+	c, err := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: 0x12, MhLength: -1}.Sum(data)
+	if err != nil {
+		return "", err
+	}
+	m.blocks[c.String()] = data
+	return c.String(), nil
+}
+
+func (m memIPFS) Get(c string) ([]byte, error) {
+	return m.blocks[c], nil
+}
+
+func TestVaultSurvivesIPFSTimeouts(t *testing.T) {
+	chaos.Reset()
+	t.Cleanup(chaos.Reset)
+
+	k := Keeper{ipfsClient: chaos.IPFS(memIPFS{blocks: map[string][]byte{}})}
+	ctx := sdk.NewContext(nil, cmtproto.Header{ChainID: "sonr-test", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
+
+	// A timed out upload fails the vault before any state or event is written
+	chaos.Inject(chaos.IPFSTimeout, 1)
+	_, err := k.CreateEncryptedMPCVault(ctx, "did:sonr:alice", "idx1alice", "vault-1", "key-1")
+	require.ErrorIs(t, err, chaos.ErrInjected)
+	require.Empty(t, ctx.EventManager().Events())
+
+	res, err := k.CreateEncryptedMPCVault(ctx, "did:sonr:alice", "idx1alice", "vault-1", "key-1")
+	require.NoError(t, err)
+	require.NotEmpty(t, res.IpfsCid)
+
+	// Recovery fails while the node times out and succeeds once it answers
+	chaos.Inject(chaos.IPFSTimeout, 1)
+	_, err = k.RecoverVaultFromIPFS(ctx, "vault-1", res.IpfsCid)
+	require.ErrorIs(t, err, chaos.ErrInjected)
+
+	data, err := k.RecoverVaultFromIPFS(ctx, "vault-1", res.IpfsCid)
+	require.NoError(t, err)
+	require.NotEmpty(t, data.PubBytes)
+	require.Equal(t, 2, chaos.Hits(chaos.IPFSTimeout))
+}
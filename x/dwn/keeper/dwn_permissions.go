@@ -74,7 +74,7 @@ func (k Keeper) PermissionsGrant(
 		RecordId:      msg.RecordId,
 		Conditions:    msg.Conditions,
 		ExpiresAt:     msg.ExpiresAt,
-		CreatedAt:     time.Now().Unix(),
+		CreatedAt:     sdkCtx.BlockTime().Unix(),
 		Revoked:       false,
 		CreatedHeight: sdkCtx.BlockHeight(),
 	}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -75,7 +74,7 @@ func (k Keeper) ProtocolsConfigure(
 			ProtocolUri:   msg.ProtocolUri,
 			Definition:    msg.Definition,
 			Published:     msg.Published,
-			CreatedAt:     time.Now().Unix(),
+			CreatedAt:     sdkCtx.BlockTime().Unix(),
 			CreatedHeight: sdkCtx.BlockHeight(),
 		}
 
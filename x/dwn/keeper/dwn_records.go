@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
-	"time"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -103,8 +102,18 @@ func (k Keeper) RecordsWrite(
 	dataCID := "cid:" + hex.EncodeToString(dataHash[:16])
 
 	// Check if record exists
+	var expiresAt int64
 	existingRecord, err := k.OrmDB.DWNRecordTable().Get(ctx, recordID)
 	if err == nil && existingRecord != nil {
+		// Charge only the growth of the record against the quota
+		stored := int64(len(existingRecord.Data))
+		growth := int64(len(recordData)) - stored
+		if growth > 0 {
+			if err := k.checkStorageQuota(ctx, params.Storage, msg.Target, uint64(growth)); err != nil {
+				return nil, err
+			}
+		}
+
 		// Update existing record
 		existingRecord.Data = recordData // Use potentially encrypted data
 		existingRecord.Descriptor_ = &apiv1.DWNMessageDescriptor{
@@ -123,8 +132,19 @@ func (k Keeper) RecordsWrite(
 		existingRecord.Published = msg.Published
 		existingRecord.Encryption = msg.Encryption
 		existingRecord.Attestation = msg.Attestation
-		existingRecord.UpdatedAt = time.Now().Unix()
+		existingRecord.UpdatedAt = sdkCtx.BlockTime().Unix()
 		existingRecord.IsEncrypted = isEncrypted
+		previous := &apiv1.DWNRecord{
+			RecordId:       existingRecord.RecordId,
+			ExpiresAt:      existingRecord.ExpiresAt,
+			AttachmentCids: existingRecord.AttachmentCids,
+		}
+		existingRecord.ExpiresAt = recordExpiry(sdkCtx.BlockTime().Unix(), msg.Ttl)
+		existingRecord.AttachmentCids = msg.AttachmentCids
+		if previous.ExpiresAt == existingRecord.ExpiresAt {
+			// Still queued under the same key
+			previous.ExpiresAt = 0
+		}
 		if encryptionMetadata != nil {
 			existingRecord.EncryptionMetadata = encryptionMetadata.ToAPIEncryptionMetadata()
 		}
@@ -132,6 +152,18 @@ func (k Keeper) RecordsWrite(
 		if err := k.OrmDB.DWNRecordTable().Update(ctx, existingRecord); err != nil {
 			return nil, errors.Wrap(err, "failed to update record")
 		}
+		// Reference the new attachments before releasing the old ones, so
+		// attachments the record keeps are not released
+		if err := k.trackRecord(ctx, existingRecord); err != nil {
+			return nil, errors.Wrap(err, "failed to track record expiry")
+		}
+		released, err := k.untrackRecord(ctx, previous)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to untrack record")
+		}
+		if err := k.meterRecords(ctx, msg.Target, growth, 0); err != nil {
+			return nil, errors.Wrap(err, "failed to meter record storage")
+		}
 
 		k.Logger().Info("Updated DWN record",
 			"record_id", recordID,
@@ -141,20 +173,27 @@ func (k Keeper) RecordsWrite(
 
 		// Emit typed event
 		event := &types.EventRecordWritten{
-			RecordId:    recordID,
-			Target:      msg.Target,
-			Protocol:    msg.Protocol,
-			Schema:      msg.Schema,
-			DataCid:     dataCID,
-			DataSize:    uint64(len(msg.Data)),
-			Encrypted:   isEncrypted,
-			BlockHeight: uint64(sdkCtx.BlockHeight()),
+			RecordId:     recordID,
+			Target:       msg.Target,
+			Protocol:     msg.Protocol,
+			Schema:       msg.Schema,
+			DataCid:      dataCID,
+			DataSize:     uint64(len(msg.Data)),
+			Encrypted:    isEncrypted,
+			BlockHeight:  uint64(sdkCtx.BlockHeight()),
+			ExpiresAt:    existingRecord.ExpiresAt,
+			ReleasedCids: released,
 		}
 
 		if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
 			k.Logger().With("error", err).Error("Failed to emit EventRecordWritten")
 		}
+		expiresAt = existingRecord.ExpiresAt
 	} else {
+		if err := k.checkStorageQuota(ctx, params.Storage, msg.Target, uint64(len(recordData))); err != nil {
+			return nil, err
+		}
+
 		// Create new record
 		record := &apiv1.DWNRecord{
 			RecordId: recordID,
@@ -167,19 +206,21 @@ func (k Keeper) RecordsWrite(
 				DataSize:         int64(len(msg.Data)), // Original data size
 				DataFormat:       msg.Descriptor_.DataFormat,
 			},
-			Authorization: msg.Authorization,
-			Data:          recordData, // Use potentially encrypted data
-			Protocol:      msg.Protocol,
-			ProtocolPath:  msg.ProtocolPath,
-			Schema:        msg.Schema,
-			ParentId:      msg.ParentId,
-			Published:     msg.Published,
-			Attestation:   msg.Attestation,
-			Encryption:    msg.Encryption,
-			CreatedAt:     time.Now().Unix(),
-			UpdatedAt:     time.Now().Unix(),
-			CreatedHeight: sdkCtx.BlockHeight(),
-			IsEncrypted:   isEncrypted,
+			Authorization:  msg.Authorization,
+			Data:           recordData, // Use potentially encrypted data
+			Protocol:       msg.Protocol,
+			ProtocolPath:   msg.ProtocolPath,
+			Schema:         msg.Schema,
+			ParentId:       msg.ParentId,
+			Published:      msg.Published,
+			Attestation:    msg.Attestation,
+			Encryption:     msg.Encryption,
+			CreatedAt:      sdkCtx.BlockTime().Unix(),
+			UpdatedAt:      sdkCtx.BlockTime().Unix(),
+			CreatedHeight:  sdkCtx.BlockHeight(),
+			IsEncrypted:    isEncrypted,
+			ExpiresAt:      recordExpiry(sdkCtx.BlockTime().Unix(), msg.Ttl),
+			AttachmentCids: msg.AttachmentCids,
 		}
 
 		if encryptionMetadata != nil {
@@ -189,6 +230,12 @@ func (k Keeper) RecordsWrite(
 		if err := k.OrmDB.DWNRecordTable().Insert(ctx, record); err != nil {
 			return nil, errors.Wrap(err, "failed to insert record")
 		}
+		if err := k.meterRecords(ctx, msg.Target, int64(len(recordData)), 1); err != nil {
+			return nil, errors.Wrap(err, "failed to meter record storage")
+		}
+		if err := k.trackRecord(ctx, record); err != nil {
+			return nil, errors.Wrap(err, "failed to track record expiry")
+		}
 
 		k.Logger().Info("Created DWN record",
 			"record_id", recordID,
@@ -206,16 +253,19 @@ func (k Keeper) RecordsWrite(
 			DataSize:    uint64(len(msg.Data)),
 			Encrypted:   isEncrypted,
 			BlockHeight: uint64(sdkCtx.BlockHeight()),
+			ExpiresAt:   record.ExpiresAt,
 		}
 
 		if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
 			k.Logger().With("error", err).Error("Failed to emit EventRecordWritten")
 		}
+		expiresAt = record.ExpiresAt
 	}
 
 	return &types.MsgRecordsWriteResponse{
-		RecordId: recordID,
-		DataCid:  dataCID,
+		RecordId:  recordID,
+		DataCid:   dataCID,
+		ExpiresAt: expiresAt,
 	}, nil
 }
 
@@ -238,6 +288,7 @@ func (k Keeper) RecordsDelete(
 	}
 
 	deletedCount := int32(1)
+	var released []string
 
 	// Handle pruning of child records if requested
 	if msg.Prune && msg.RecordId != "" {
@@ -253,6 +304,14 @@ func (k Keeper) RecordsDelete(
 				}
 				if err := k.OrmDB.DWNRecordTable().Delete(ctx, childRecord); err == nil {
 					deletedCount++
+					if err := k.meterRecords(ctx, childRecord.Target, -int64(len(childRecord.Data)), -1); err != nil {
+						return nil, errors.Wrap(err, "failed to meter record storage")
+					}
+					childReleased, err := k.untrackRecord(ctx, childRecord)
+					if err != nil {
+						return nil, errors.Wrap(err, "failed to untrack record")
+					}
+					released = append(released, childReleased...)
 				}
 			}
 		}
@@ -262,16 +321,25 @@ func (k Keeper) RecordsDelete(
 	if err := k.OrmDB.DWNRecordTable().Delete(ctx, record); err != nil {
 		return nil, errors.Wrap(err, "failed to delete record")
 	}
+	if err := k.meterRecords(ctx, record.Target, -int64(len(record.Data)), -1); err != nil {
+		return nil, errors.Wrap(err, "failed to meter record storage")
+	}
+	recordReleased, err := k.untrackRecord(ctx, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to untrack record")
+	}
+	released = append(released, recordReleased...)
 
 	k.Logger().Info("Deleted DWN record", "record_id", msg.RecordId, "pruned_count", deletedCount)
 
 	// Emit typed event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	event := &types.EventRecordDeleted{
-		RecordId:    msg.RecordId,
-		Target:      msg.Target,
-		Deleter:     msg.Target, // The deleter is the target in this case
-		BlockHeight: uint64(sdkCtx.BlockHeight()),
+		RecordId:     msg.RecordId,
+		Target:       msg.Target,
+		Deleter:      msg.Target, // The deleter is the target in this case
+		BlockHeight:  uint64(sdkCtx.BlockHeight()),
+		ReleasedCids: released,
 	}
 
 	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
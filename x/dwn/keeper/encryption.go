@@ -6,7 +6,6 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/hmac"
-	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -17,6 +16,7 @@ import (
 	"golang.org/x/crypto/hkdf"
 
 	apiv1 "github.com/sonr-io/sonr/api/dwn/v1"
+	"github.com/sonr-io/sonr/app/randomness"
 	"github.com/sonr-io/sonr/x/dwn/types"
 )
 
@@ -105,10 +105,11 @@ func (es *EncryptionSubkeeper) EncryptData(
 		return nil, fmt.Errorf("failed to create GCM mode: %w", err)
 	}
 
-	// Generate random nonce
-	nonce := make([]byte, gcm.NonceSize())
-	if _, randErr := io.ReadFull(rand.Reader, nonce); randErr != nil {
-		return nil, fmt.Errorf("failed to generate nonce: %w", randErr)
+	// Derive the nonce deterministically so every validator produces the
+	// same ciphertext
+	nonce, err := deriveNonce(ctx, gcm.NonceSize(), consensusInput, plaintext)
+	if err != nil {
+		return nil, err
 	}
 
 	// Compute HMAC-SHA256 for data integrity verification before encryption
@@ -539,6 +540,22 @@ func (es *EncryptionSubkeeper) EncryptWithConsensusKey(
 	return es.encryptWithKey(ctx, plaintext, encryptionKey, consensusInput, sdkCtx.BlockHeight())
 }
 
+// deriveNonce derives a GCM nonce from the block randomness beacon, the
+// consensus input and the plaintext. The same key is used for many records, so
+// the plaintext digest keeps nonces distinct within a block.
+func deriveNonce(ctx context.Context, size int, consensusInput, plaintext []byte) ([]byte, error) {
+	digest := sha256.Sum256(plaintext)
+	seed, err := randomness.NewBeacon(sdk.UnwrapSDKContext(ctx)).Seed(
+		randomness.DomainEncryptionNonce,
+		consensusInput,
+		digest[:],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive nonce: %w", err)
+	}
+	return seed[:size], nil
+}
+
 // encryptWithKey performs the actual AES-256-GCM encryption
 func (es *EncryptionSubkeeper) encryptWithKey(
 	ctx context.Context,
@@ -559,10 +576,11 @@ func (es *EncryptionSubkeeper) encryptWithKey(
 		return nil, fmt.Errorf("failed to create GCM mode: %w", err)
 	}
 
-	// Generate random nonce
-	nonce := make([]byte, gcm.NonceSize())
-	if _, randErr := io.ReadFull(rand.Reader, nonce); randErr != nil {
-		return nil, fmt.Errorf("failed to generate nonce: %w", randErr)
+	// Derive the nonce deterministically so every validator produces the
+	// same ciphertext
+	nonce, err := deriveNonce(ctx, gcm.NonceSize(), consensusInput, plaintext)
+	if err != nil {
+		return nil, err
 	}
 
 	// Encrypt data with authenticated encryption
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
@@ -11,6 +10,7 @@ import (
 	"github.com/sonr-io/crypto/keys"
 	"github.com/sonr-io/crypto/mpc"
 	"github.com/sonr-io/common/ipfs"
+	"github.com/sonr-io/sonr/app/chaos"
 	"github.com/sonr-io/sonr/x/dwn/types"
 )
 
@@ -21,7 +21,7 @@ func (k Keeper) GetIPFSClient() (ipfs.IPFSClient, error) {
 		if err != nil {
 			return nil, err
 		}
-		k.ipfsClient = client
+		k.ipfsClient = chaos.IPFS(client)
 	}
 	return k.ipfsClient, nil
 }
@@ -49,8 +49,7 @@ func (k Keeper) AddEnclaveDataToIPFS(
 	)
 
 	// Get IPFS client (lazy initialization)
-	ipfsClient, err := k.GetIPFSClient()
-	if err != nil {
+	if _, err := k.GetIPFSClient(); err != nil {
 		return nil, fmt.Errorf(
 			"IPFS client not available - vault creation requires IPFS client: %w", err)
 	}
@@ -90,8 +89,13 @@ func (k Keeper) AddEnclaveDataToIPFS(
 		"key_version", encryptedData.Metadata.KeyVersion,
 	)
 
-	// Store the encrypted data to IPFS
-	vaultCID, err := ipfsClient.Add(dataToStore)
+	// Store the encrypted data to IPFS, subject to the upload policy and the
+	// owner's storage quota
+	vaultCID, err := k.UploadToIPFS(ctx, types.Upload{
+		Owner: owner,
+		Kind:  types.UploadKindBundle,
+		Data:  dataToStore,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to store vault data to IPFS: %w", err)
 	}
@@ -101,8 +105,8 @@ func (k Keeper) AddEnclaveDataToIPFS(
 		VaultId:       vaultCID,
 		Owner:         owner,
 		PublicKey:     pubKey,
-		CreatedAt:     time.Now().Unix(),
-		LastRefreshed: time.Now().Unix(),
+		CreatedAt:     sdkCtx.BlockTime().Unix(),
+		LastRefreshed: sdkCtx.BlockTime().Unix(),
 		CreatedHeight: sdkCtx.BlockHeight(), // Will be set by the block height in the message server
 		EnclaveData: &apiv1.EnclaveData{
 			PrivateData: dataToStore,
@@ -180,17 +184,14 @@ func (k Keeper) GetEnclaveDataFromIPFS(
 	return data, nil
 }
 
-// StoreEncryptedToIPFS stores encrypted data to IPFS with metadata tracking
+// StoreEncryptedToIPFS stores encrypted data to IPFS on behalf of owner,
+// subject to the upload policy and the owner's quota
 func (k Keeper) StoreEncryptedToIPFS(
 	ctx context.Context,
+	owner string,
 	data []byte,
 	protocol string,
 ) (string, error) {
-	ipfsClient, err := k.GetIPFSClient()
-	if err != nil {
-		return "", fmt.Errorf("IPFS client not available for operations: %w", err)
-	}
-
 	if len(data) == 0 {
 		return "", fmt.Errorf("cannot store empty data")
 	}
@@ -200,9 +201,13 @@ func (k Keeper) StoreEncryptedToIPFS(
 		"protocol", protocol,
 	)
 
-	// Store the encrypted data directly to IPFS
+	// Upload the encrypted data as an opaque bundle
 	// The data is assumed to already be encrypted by the caller
-	cid, err := ipfsClient.Add(data)
+	cid, err := k.UploadToIPFS(ctx, types.Upload{
+		Owner: owner,
+		Kind:  types.UploadKindBundle,
+		Data:  data,
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to store encrypted data to IPFS: %w", err)
 	}
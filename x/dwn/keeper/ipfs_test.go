@@ -212,7 +212,12 @@ func (suite *IPFSTestSuite) TestFullEncryptDecryptAddGetWorkflow() {
 			len(testData), len(encryptedResult.Ciphertext), encryptedResult.Metadata.Algorithm)
 
 	// Step 2: Store encrypted data to IPFS
-	cid, err := suite.k.StoreEncryptedToIPFS(suite.ctx, encryptedResult.Ciphertext, testProtocol)
+	cid, err := suite.k.StoreEncryptedToIPFS(
+		suite.ctx,
+		"did:sonr:workflow",
+		encryptedResult.Ciphertext,
+		testProtocol,
+	)
 	suite.Require().NoError(err, "Step 2: Should successfully store encrypted data to IPFS")
 	suite.Require().NotEmpty(cid, "IPFS CID should not be empty")
 	suite.Require().Contains(cid, "/ipfs/", "CID should contain IPFS path")
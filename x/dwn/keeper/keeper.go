@@ -25,6 +25,7 @@ import (
 	"cosmossdk.io/orm/model/ormdb"
 
 	apiv1 "github.com/sonr-io/sonr/api/dwn/v1"
+	"github.com/sonr-io/sonr/app/chaos"
 	sonrcontext "github.com/sonr-io/sonr/app/context"
 	"github.com/sonr-io/crypto/mpc"
 	"github.com/sonr-io/crypto/vrf"
@@ -43,6 +44,15 @@ type Keeper struct {
 	Params collections.Item[types.Params]
 	OrmDB  apiv1.StateStore
 
+	// StorageUsage tracks the bytes each profile has pinned through the keeper
+	StorageUsage collections.Map[string, uint64]
+	// StorageAccounts meters the records and purchased quota of each DID
+	StorageAccounts collections.Map[string, types.StorageAccount]
+	// RecordExpiries queues expiring records by expiry time and record ID
+	RecordExpiries collections.KeySet[collections.Pair[int64, string]]
+	// AttachmentRefs counts the records referencing each attachment CID
+	AttachmentRefs collections.Map[string, uint64]
+
 	// SDK keepers for wallet operations
 	accountKeeper  authkeeper.AccountKeeper
 	bankKeeper     bankkeeper.Keeper
@@ -56,6 +66,13 @@ type Keeper struct {
 
 	// vault client for enclave operations
 	ipfsClient ipfs.IPFSClient
+
+	// upload policy and optional content scanner applied before pinning
+	uploadPolicy   types.UploadPolicy
+	contentScanner types.ContentScanner
+
+	// optional fallback for vault content the IPFS client cannot serve
+	contentFetcher types.ContentFetcher
 	// vaultClient vault.VaultClient
 
 	// encryption subkeeper for consensus-based encryption
@@ -116,6 +133,35 @@ func NewKeeper(
 			codec.CollValue[types.Params](cdc),
 		),
 		OrmDB: store,
+		StorageUsage: collections.NewMap(
+			sb,
+			types.StorageUsagePrefix,
+			"storage_usage",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		StorageAccounts: collections.NewMap(
+			sb,
+			types.StorageAccountPrefix,
+			"storage_accounts",
+			collections.StringKey,
+			codec.CollValue[types.StorageAccount](cdc),
+		),
+		RecordExpiries: collections.NewKeySet(
+			sb,
+			types.RecordExpiriesPrefix,
+			"record_expiries",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		AttachmentRefs: collections.NewMap(
+			sb,
+			types.AttachmentRefsPrefix,
+			"attachment_refs",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+
+		uploadPolicy: types.DefaultUploadPolicy(),
 
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
@@ -152,7 +198,7 @@ func NewKeeper(
 		// Continue without IPFS client - this allows the keeper to still function
 		// but IPFS operations will fail gracefully
 	} else {
-		k.ipfsClient = ipfsClient
+		k.ipfsClient = chaos.IPFS(ipfsClient)
 	}
 
 	// Initialize encryption subkeeper
@@ -242,6 +288,8 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 			CreatedAt:           record.CreatedAt,
 			UpdatedAt:           record.UpdatedAt,
 			CreatedHeight:       record.CreatedHeight,
+			ExpiresAt:           record.ExpiresAt,
+			AttachmentCids:      record.AttachmentCids,
 		}
 		if record.Descriptor_ != nil {
 			apiRecord.Descriptor_ = &apiv1.DWNMessageDescriptor{
@@ -256,6 +304,9 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		if err := k.OrmDB.DWNRecordTable().Insert(ctx, apiRecord); err != nil {
 			return err
 		}
+		if err := k.trackRecord(ctx, apiRecord); err != nil {
+			return err
+		}
 	}
 
 	// Import DWN protocols
@@ -321,6 +372,13 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 	}
 
+	// Import storage accounts
+	for _, account := range data.StorageAccounts {
+		if err := k.StorageAccounts.Set(ctx, account.Did, account); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -332,11 +390,12 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	}
 
 	genesis := &types.GenesisState{
-		Params:      params,
-		Records:     []types.DWNRecord{},
-		Protocols:   []types.DWNProtocol{},
-		Permissions: []types.DWNPermission{},
-		Vaults:      []types.VaultState{},
+		Params:          params,
+		Records:         []types.DWNRecord{},
+		Protocols:       []types.DWNProtocol{},
+		Permissions:     []types.DWNPermission{},
+		Vaults:          []types.VaultState{},
+		StorageAccounts: []types.StorageAccount{},
 	}
 
 	// Export DWN records
@@ -393,6 +452,15 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		}
 	}
 
+	// Export storage accounts
+	err = k.StorageAccounts.Walk(ctx, nil, func(_ string, account types.StorageAccount) (bool, error) {
+		genesis.StorageAccounts = append(genesis.StorageAccounts, account)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
 
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	apiv1 "github.com/sonr-io/sonr/api/dwn/v1"
+	"github.com/sonr-io/sonr/app/clock"
 	"github.com/sonr-io/sonr/x/dwn/types"
 )
 
@@ -71,7 +72,7 @@ func (krs *KeyRotationScheduler) CheckRotationPolicy(ctx context.Context) error
 	}
 
 	// Check time-based rotation
-	if krs.shouldRotateByTime(keyState) {
+	if krs.shouldRotateByTime(keyState, sdkCtx.BlockTime()) {
 		krs.logger.Info("Time-based rotation triggered",
 			"last_rotation", keyState.LastRotation,
 			"interval", krs.policy.RotationInterval,
@@ -101,7 +102,7 @@ func (krs *KeyRotationScheduler) CheckRotationPolicy(ctx context.Context) error
 }
 
 // shouldRotateByTime checks if time-based rotation is due
-func (krs *KeyRotationScheduler) shouldRotateByTime(keyState *types.EncryptionKeyState) bool {
+func (krs *KeyRotationScheduler) shouldRotateByTime(keyState *types.EncryptionKeyState, now time.Time) bool {
 	if keyState.RotationInterval <= 0 {
 		// Use default interval if not set
 		keyState.RotationInterval = int64(krs.policy.RotationInterval.Seconds())
@@ -110,7 +111,7 @@ func (krs *KeyRotationScheduler) shouldRotateByTime(keyState *types.EncryptionKe
 	lastRotation := time.Unix(keyState.LastRotation, 0)
 	nextRotation := lastRotation.Add(time.Duration(keyState.RotationInterval) * time.Second)
 
-	return time.Now().After(nextRotation)
+	return now.After(nextRotation)
 }
 
 // shouldRotateByUsage checks if usage-based rotation is due
@@ -221,7 +222,7 @@ func (krs *KeyRotationScheduler) emitRotationEvent(ctx context.Context, reason s
 		sdk.NewEvent(
 			"key_rotation",
 			sdk.NewAttribute("reason", reason),
-			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", time.Now().Unix())),
+			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", sdkCtx.BlockTime().Unix())),
 		),
 	)
 }
@@ -249,7 +250,7 @@ func (krs *KeyRotationScheduler) ScheduleNextRotation(ctx context.Context) error
 	}
 
 	// Calculate next rotation time
-	nextRotation := time.Now().Add(krs.policy.RotationInterval)
+	nextRotation := clock.BlockTime(ctx).Add(krs.policy.RotationInterval)
 	keyState.NextRotation = nextRotation.Unix()
 
 	// Convert to API type for ORM storage
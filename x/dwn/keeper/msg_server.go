@@ -176,3 +176,15 @@ func (ms msgServer) PermissionsRevoke(
 
 	return ms.k.PermissionsRevoke(ctx, msg)
 }
+
+// PurchaseStorage implements the PurchaseStorage RPC method
+func (ms msgServer) PurchaseStorage(
+	ctx context.Context,
+	msg *types.MsgPurchaseStorage,
+) (*types.MsgPurchaseStorageResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return ms.k.PurchaseStorage(ctx, msg)
+}
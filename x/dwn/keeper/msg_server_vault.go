@@ -329,10 +329,6 @@ func (ms msgServer) updateVaultInIPFS(
 	vaultState *apiv1.VaultState,
 	reencryptedData []byte,
 ) error {
-	if ms.k.ipfsClient == nil {
-		return fmt.Errorf("IPFS client not available")
-	}
-
 	// Create a vault export structure for IPFS storage
 	vaultExport := map[string]any{
 		"vault_id":       vaultState.VaultId,
@@ -353,7 +349,11 @@ func (ms msgServer) updateVaultInIPFS(
 	}
 
 	// Store to IPFS and get new CID
-	newCID, err := ms.k.ipfsClient.Add(exportBytes)
+	newCID, err := ms.k.UploadToIPFS(ctx, types.Upload{
+		Owner: vaultState.Owner,
+		Kind:  types.UploadKindBundle,
+		Data:  exportBytes,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to store updated vault to IPFS: %w", err)
 	}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+var _ didtypes.NFTMetadataCache = NFTMetadataCache{}
+
+// NFTMetadataCache stores the metadata of NFTs attached to DID profiles as
+// records in the DWN of the DID, so clients can render a profile from the
+// vault alone.
+type NFTMetadataCache struct {
+	k Keeper
+}
+
+// NewNFTMetadataCache returns the x/did NFT metadata cache backed by k
+func NewNFTMetadataCache(k Keeper) NFTMetadataCache {
+	return NFTMetadataCache{k: k}
+}
+
+// CacheNFTMetadata implements didtypes.NFTMetadataCache.
+func (c NFTMetadataCache) CacheNFTMetadata(ctx context.Context, asset didtypes.DIDAsset) (string, error) {
+	data, err := json.Marshal(asset)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.k.RecordsWrite(ctx, &types.MsgRecordsWrite{
+		Author: authtypes.NewModuleAddress(didtypes.ModuleName).String(),
+		Target: asset.Did,
+		Descriptor_: &types.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Write",
+			MessageTimestamp: sdk.UnwrapSDKContext(ctx).BlockTime().UTC().Format(time.RFC3339Nano),
+			DataFormat:       types.NFTMetadataDataFormat,
+		},
+		Data:      data,
+		Protocol:  types.NFTMetadataProtocol,
+		Schema:    types.NFTMetadataSchema,
+		Published: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.RecordId, nil
+}
+
+// RemoveNFTMetadata implements didtypes.NFTMetadataCache. Records already
+// deleted from the DWN are ignored.
+func (c NFTMetadataCache) RemoveNFTMetadata(ctx context.Context, did, recordID string) error {
+	_, err := c.k.RecordsDelete(ctx, &types.MsgRecordsDelete{
+		Author:   authtypes.NewModuleAddress(didtypes.ModuleName).String(),
+		Target:   did,
+		RecordId: recordID,
+	})
+	if errors.Is(err, types.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
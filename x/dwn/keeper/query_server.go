@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/ipfs/go-cid"
 
 	apiv1 "github.com/sonr-io/sonr/api/dwn/v1"
+	"github.com/sonr-io/sonr/app/pagination"
 	"github.com/sonr-io/sonr/x/dwn/types"
 )
 
@@ -100,6 +102,11 @@ func (k Querier) Records(
 			continue
 		}
 
+		// Expired records linger until the end block sweep removes them
+		if !req.IncludeExpired && types.RecordExpired(record.ExpiresAt, ctx.BlockTime().Unix()) {
+			continue
+		}
+
 		count++
 
 		// Handle pagination
@@ -154,6 +161,9 @@ func (k Querier) Record(
 	if record.Target != req.Target {
 		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "record does not belong to target DWN")
 	}
+	if types.RecordExpired(record.ExpiresAt, ctx.BlockTime().Unix()) {
+		return nil, errors.Wrapf(types.ErrRecordExpired, "record %s", req.RecordId)
+	}
 	rec := types.ConvertAPIRecordToType(record)
 	return &types.QueryRecordResponse{
 		Record: &rec,
@@ -904,3 +914,81 @@ func (k Querier) VRFContributions(
 		Pagination:    pageRes,
 	}, nil
 }
+
+// Storage queries the storage a DID uses across records and IPFS, and its quota
+func (k Querier) Storage(
+	c context.Context,
+	req *types.QueryStorageRequest,
+) (*types.QueryStorageResponse, error) {
+	if req == nil {
+		return nil, types.ErrRequestCannotBeNil
+	}
+	if req.Did == "" {
+		return nil, types.ErrDIDEmpty
+	}
+
+	usage, err := k.GetStorageUsage(sdk.UnwrapSDKContext(c), req.Did)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStorageResponse{Usage: usage}, nil
+}
+
+// StorageAccounts lists the storage usage of every metered DID, ordered by DID
+func (k Querier) StorageAccounts(
+	c context.Context,
+	req *types.QueryStorageAccountsRequest,
+) (*types.QueryStorageAccountsResponse, error) {
+	if req == nil {
+		return nil, types.ErrRequestCannotBeNil
+	}
+
+	page, err := pagination.Parse(req.Pagination, "dwn/StorageAccounts")
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	rng := new(collections.Range[string])
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			rng = rng.EndExclusive(string(page.Key))
+		} else {
+			rng = rng.StartExclusive(string(page.Key))
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := k.storageParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := k.Keeper.StorageAccounts.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	usage, pageRes, err := pagination.Collect(page, func() (types.StorageUsage, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.StorageUsage{}, nil, false, nil
+		}
+		account, err := iter.Value()
+		if err != nil {
+			return types.StorageUsage{}, nil, false, err
+		}
+		iter.Next()
+
+		pinned, err := k.StorageUsed(ctx, account.Did)
+		if err != nil {
+			return types.StorageUsage{}, nil, false, err
+		}
+		return types.NewStorageUsage(params, account, pinned), []byte(account.Did), true, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStorageAccountsResponse{Usage: usage, Pagination: pageRes}, nil
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/dwn/v1"
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+// recordExpiry returns the expiry of a record written at now with ttl
+// seconds to live, zero if it does not expire
+func recordExpiry(now, ttl int64) int64 {
+	if ttl == 0 {
+		return 0
+	}
+	return now + ttl
+}
+
+// trackRecord queues an expiring record for the sweep and references its
+// attachments
+func (k Keeper) trackRecord(ctx context.Context, record *apiv1.DWNRecord) error {
+	if record.ExpiresAt != 0 {
+		if err := k.RecordExpiries.Set(ctx, collections.Join(record.ExpiresAt, record.RecordId)); err != nil {
+			return err
+		}
+	}
+	for _, c := range record.AttachmentCids {
+		refs, err := k.AttachmentRefs.Get(ctx, c)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.AttachmentRefs.Set(ctx, c, refs+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// untrackRecord undoes trackRecord for a record being removed or rewritten.
+// It returns the attachment CIDs no record references any more, which can
+// be unpinned.
+func (k Keeper) untrackRecord(ctx context.Context, record *apiv1.DWNRecord) ([]string, error) {
+	if record.ExpiresAt != 0 {
+		if err := k.RecordExpiries.Remove(ctx, collections.Join(record.ExpiresAt, record.RecordId)); err != nil {
+			return nil, err
+		}
+	}
+
+	var released []string
+	for _, c := range record.AttachmentCids {
+		refs, err := k.AttachmentRefs.Get(ctx, c)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if refs > 1 {
+			if err := k.AttachmentRefs.Set(ctx, c, refs-1); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := k.AttachmentRefs.Remove(ctx, c); err != nil {
+			return nil, err
+		}
+		released = append(released, c)
+	}
+	return released, nil
+}
+
+// SweepExpiredRecords deletes records that expired by the block time, at most
+// MaxExpiredRecordsPerBlock of them, and returns how many were deleted. An
+// EventRecordExpired names the attachments each deletion released, for
+// off-chain pinning services to unpin.
+func (k Keeper) SweepExpiredRecords(ctx context.Context) (int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	// Collect first; the queue cannot be modified while iterating it
+	iter, err := k.RecordExpiries.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, string](now))
+	if err != nil {
+		return 0, err
+	}
+	var due []collections.Pair[int64, string]
+	for ; iter.Valid() && len(due) < types.MaxExpiredRecordsPerBlock; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return 0, err
+		}
+		due = append(due, key)
+	}
+	iter.Close()
+
+	swept := 0
+	for _, key := range due {
+		record, err := k.OrmDB.DWNRecordTable().Get(ctx, key.K2())
+		if ormerrors.IsNotFound(err) || (err == nil && record.ExpiresAt != key.K1()) {
+			// Deleted or rewritten without clearing the queue entry
+			if err := k.RecordExpiries.Remove(ctx, key); err != nil {
+				return swept, err
+			}
+			continue
+		}
+		if err != nil {
+			return swept, err
+		}
+
+		if err := k.OrmDB.DWNRecordTable().Delete(ctx, record); err != nil {
+			return swept, errorsmod.Wrap(err, "failed to delete expired record")
+		}
+		if err := k.meterRecords(ctx, record.Target, -int64(len(record.Data)), -1); err != nil {
+			return swept, errorsmod.Wrap(err, "failed to meter record storage")
+		}
+		released, err := k.untrackRecord(ctx, record)
+		if err != nil {
+			return swept, err
+		}
+		swept++
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRecordExpired{
+			RecordId:     record.RecordId,
+			Target:       record.Target,
+			ExpiresAt:    record.ExpiresAt,
+			ReleasedCids: released,
+			BlockHeight:  uint64(sdkCtx.BlockHeight()),
+		}); err != nil {
+			k.Logger().With("error", err).Error("Failed to emit EventRecordExpired")
+		}
+	}
+	return swept, nil
+}
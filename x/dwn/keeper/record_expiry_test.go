@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+const (
+	expiryDID   = "did:sonr:expiry"
+	attachmentA = "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku"
+	attachmentB = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+)
+
+func writeExpiringRecord(t *testing.T, f *testFixture, data string, ttl int64, attachments ...string) string {
+	t.Helper()
+	resp, err := f.msgServer.RecordsWrite(f.ctx, &types.MsgRecordsWrite{
+		Author: f.addrs[0].String(),
+		Target: expiryDID,
+		Descriptor_: &types.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Write",
+			MessageTimestamp: "2024-01-01T00:00:00Z",
+			DataFormat:       "application/json",
+		},
+		Data:           []byte(data),
+		Ttl:            ttl,
+		AttachmentCids: attachments,
+	})
+	require.NoError(t, err)
+	return resp.RecordId
+}
+
+func TestExpiredRecordsSwept(t *testing.T) {
+	f := SetupTest(t)
+
+	session := writeExpiringRecord(t, f, `{"session":1}`, 60, attachmentA, attachmentB)
+	profile := writeExpiringRecord(t, f, `{"profile":1}`, 0, attachmentB)
+
+	// Nothing is due yet
+	swept, err := f.k.SweepExpiredRecords(f.ctx)
+	require.NoError(t, err)
+	require.Zero(t, swept)
+
+	f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Minute))
+
+	// Expired records are hidden before the sweep removes them
+	res, err := f.queryServer.Records(f.ctx, &types.QueryRecordsRequest{Target: expiryDID})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, profile, res.Records[0].RecordId)
+
+	res, err = f.queryServer.Records(f.ctx, &types.QueryRecordsRequest{Target: expiryDID, IncludeExpired: true})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 2)
+
+	_, err = f.queryServer.Record(f.ctx, &types.QueryRecordRequest{Target: expiryDID, RecordId: session})
+	require.ErrorIs(t, err, types.ErrRecordExpired)
+
+	swept, err = f.k.SweepExpiredRecords(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, swept)
+
+	has, err := f.k.OrmDB.DWNRecordTable().Has(f.ctx, session)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Only the attachment no other record references is released
+	var released string
+	for _, e := range f.ctx.EventManager().Events() {
+		if e.Type != "dwn.v1.EventRecordExpired" {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key == "released_cids" {
+				released = attr.Value
+			}
+		}
+	}
+	require.Contains(t, released, attachmentA)
+	require.NotContains(t, released, attachmentB)
+
+	refs, err := f.k.AttachmentRefs.Get(f.ctx, attachmentB)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), refs)
+	has, err = f.k.AttachmentRefs.Has(f.ctx, attachmentA)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	storage, err := f.queryServer.Storage(f.ctx, &types.QueryStorageRequest{Did: expiryDID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), storage.Usage.RecordCount)
+}
+
+func TestRecordsWriteRejectsInvalidExpiry(t *testing.T) {
+	f := SetupTest(t)
+	msg := &types.MsgRecordsWrite{
+		Author:      f.addrs[0].String(),
+		Target:      expiryDID,
+		Descriptor_: &types.DWNMessageDescriptor{InterfaceName: "Records", Method: "Write"},
+		Data:        []byte("{}"),
+	}
+
+	msg.Ttl = -1
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRecordTTL)
+
+	msg.Ttl = types.MaxRecordTTL + 1
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRecordTTL)
+
+	msg.Ttl = 60
+	msg.AttachmentCids = []string{"not-a-cid"}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidAttachment)
+
+	msg.AttachmentCids = []string{attachmentA, attachmentA}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidAttachment)
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+// GetStorageAccount returns the metered storage of a DID. DIDs that have
+// stored nothing get an empty account.
+func (k Keeper) GetStorageAccount(ctx context.Context, did string) (types.StorageAccount, error) {
+	account, err := k.StorageAccounts.Get(ctx, did)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.StorageAccount{Did: did}, nil
+	}
+	return account, err
+}
+
+// setStorageAccount saves an account, dropping it once it holds nothing
+func (k Keeper) setStorageAccount(ctx context.Context, account types.StorageAccount) error {
+	if account.RecordCount == 0 && account.RecordBytes == 0 && account.PurchasedBytes == 0 {
+		return k.StorageAccounts.Remove(ctx, account.Did)
+	}
+	return k.StorageAccounts.Set(ctx, account.Did, account)
+}
+
+// storageParams returns the storage params, disabled when none are set
+func (k Keeper) storageParams(ctx context.Context) (types.StorageParams, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.StorageParams{}, err
+	}
+	return params.Storage, nil
+}
+
+// GetStorageUsage returns the bytes a DID stores across DWN records and
+// pinned IPFS content, and its quota.
+func (k Keeper) GetStorageUsage(ctx context.Context, did string) (types.StorageUsage, error) {
+	params, err := k.storageParams(ctx)
+	if err != nil {
+		return types.StorageUsage{}, err
+	}
+	account, err := k.GetStorageAccount(ctx, did)
+	if err != nil {
+		return types.StorageUsage{}, err
+	}
+	pinned, err := k.StorageUsed(ctx, did)
+	if err != nil {
+		return types.StorageUsage{}, err
+	}
+	return types.NewStorageUsage(params, account, pinned), nil
+}
+
+// checkStorageQuota fails when storing size more bytes would take a DID over
+// its quota. Nothing is checked while metering is disabled.
+func (k Keeper) checkStorageQuota(ctx context.Context, params types.StorageParams, did string, size uint64) error {
+	if !params.Enabled() || size == 0 {
+		return nil
+	}
+	usage, err := k.GetStorageUsage(ctx, did)
+	if err != nil {
+		return err
+	}
+	if usage.UsedBytes+size > usage.QuotaBytes {
+		return errorsmod.Wrapf(
+			types.ErrStorageQuotaExceeded,
+			"%s uses %d of %d bytes, write needs %d", did, usage.UsedBytes, usage.QuotaBytes, size,
+		)
+	}
+	return nil
+}
+
+// meterRecords adjusts the record bytes and count of a DID. Records are
+// metered even while quotas are disabled so that enabling them later starts
+// from accurate usage.
+func (k Keeper) meterRecords(ctx context.Context, did string, bytesDelta, countDelta int64) error {
+	account, err := k.GetStorageAccount(ctx, did)
+	if err != nil {
+		return err
+	}
+	account.RecordBytes = addClamped(account.RecordBytes, bytesDelta)
+	account.RecordCount = addClamped(account.RecordCount, countDelta)
+	return k.setStorageAccount(ctx, account)
+}
+
+func addClamped(v uint64, delta int64) uint64 {
+	if delta < 0 {
+		if uint64(-delta) >= v {
+			return 0
+		}
+		return v - uint64(-delta)
+	}
+	return v + uint64(delta)
+}
+
+// PurchaseStorage charges the buyer for additional quota and credits it to
+// the DID. Payment goes to the fee collector.
+func (k Keeper) PurchaseStorage(
+	ctx context.Context,
+	msg *types.MsgPurchaseStorage,
+) (*types.MsgPurchaseStorageResponse, error) {
+	params, err := k.storageParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.Enabled() {
+		return nil, types.ErrStorageNotMetered
+	}
+	if msg.SizeMib > params.MaxPurchaseMib {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidRequest,
+			"purchase of %d MiB exceeds the limit of %d MiB", msg.SizeMib, params.MaxPurchaseMib,
+		)
+	}
+	if _, err := k.didKeeper.GetDIDDocument(ctx, msg.Did); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "DID %s not found: %s", msg.Did, err)
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddressFormat, err.Error())
+	}
+	cost := sdk.NewCoin(
+		params.PricePerMib.Denom,
+		params.PricePerMib.Amount.Mul(sdkmath.NewIntFromUint64(msg.SizeMib)),
+	)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, buyer, authtypes.FeeCollectorName, sdk.NewCoins(cost),
+	); err != nil {
+		return nil, err
+	}
+
+	account, err := k.GetStorageAccount(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	added := msg.SizeMib * types.BytesPerMiB
+	account.PurchasedBytes += added
+	if err := k.setStorageAccount(ctx, account); err != nil {
+		return nil, err
+	}
+	quota := params.Quota(account)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventStoragePurchased{
+		Did:         msg.Did,
+		Buyer:       msg.Buyer,
+		AddedBytes:  added,
+		QuotaBytes:  quota,
+		Cost:        cost.String(),
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}); err != nil {
+		k.Logger().With("error", err).Error("Failed to emit EventStoragePurchased")
+	}
+
+	return &types.MsgPurchaseStorageResponse{QuotaBytes: quota, Cost: cost}, nil
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+const storageDID = "did:sonr:storage"
+
+func writeRecord(t *testing.T, f *testFixture, target, data, timestamp string) string {
+	t.Helper()
+	resp, err := f.msgServer.RecordsWrite(f.ctx, &types.MsgRecordsWrite{
+		Author: f.addrs[0].String(),
+		Target: target,
+		Descriptor_: &types.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Write",
+			MessageTimestamp: timestamp,
+			DataFormat:       "application/json",
+		},
+		Data: []byte(data),
+	})
+	require.NoError(t, err)
+	return resp.RecordId
+}
+
+func setStorageParams(t *testing.T, f *testFixture, storage types.StorageParams) {
+	t.Helper()
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.Storage = storage
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+}
+
+func fund(t *testing.T, f *testFixture, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, addr, coins))
+}
+
+func TestRecordStorageMetering(t *testing.T) {
+	f := SetupTest(t)
+
+	first := writeRecord(t, f, storageDID, `{"n":1}`, "2024-01-01T00:00:00Z")
+	writeRecord(t, f, storageDID, `{"n":22}`, "2024-01-01T00:00:01Z")
+
+	res, err := f.queryServer.Storage(f.ctx, &types.QueryStorageRequest{Did: storageDID})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageUsage{
+		Did:         storageDID,
+		RecordBytes: 15,
+		RecordCount: 2,
+		UsedBytes:   15,
+		QuotaBytes:  types.DefaultFreeQuotaBytes,
+	}, res.Usage)
+
+	_, err = f.msgServer.RecordsDelete(f.ctx, &types.MsgRecordsDelete{
+		Author:   f.addrs[0].String(),
+		Target:   storageDID,
+		RecordId: first,
+		Descriptor_: &types.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Delete",
+			MessageTimestamp: "2024-01-01T00:00:02Z",
+		},
+	})
+	require.NoError(t, err)
+
+	usage, err := f.k.GetStorageUsage(f.ctx, storageDID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), usage.RecordBytes)
+	require.Equal(t, uint64(1), usage.RecordCount)
+
+	// Pinned IPFS content counts towards the same quota
+	require.NoError(t, f.k.StorageUsage.Set(f.ctx, storageDID, 100))
+	usage, err = f.k.GetStorageUsage(f.ctx, storageDID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), usage.IpfsBytes)
+	require.Equal(t, uint64(108), usage.UsedBytes)
+}
+
+func TestStorageQuotaEnforced(t *testing.T) {
+	f := SetupTest(t)
+	setStorageParams(t, f, types.StorageParams{
+		FreeQuotaBytes: 10,
+		PricePerMib:    sdk.NewInt64Coin("usnr", 1000),
+		MaxPurchaseMib: 4,
+	})
+
+	writeRecord(t, f, storageDID, `{"n":1}`, "2024-01-01T00:00:00Z")
+	_, err := f.msgServer.RecordsWrite(f.ctx, &types.MsgRecordsWrite{
+		Author: f.addrs[0].String(),
+		Target: storageDID,
+		Descriptor_: &types.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Write",
+			MessageTimestamp: "2024-01-01T00:00:01Z",
+		},
+		Data: []byte(`{"n":2}`),
+	})
+	require.ErrorIs(t, err, types.ErrStorageQuotaExceeded)
+
+	err = f.k.CheckUpload(f.ctx, types.Upload{
+		Owner:    storageDID,
+		Kind:     types.UploadKindAttachment,
+		Data:     []byte("four"),
+		MIMEType: "text/plain",
+	})
+	require.ErrorIs(t, err, types.ErrStorageQuotaExceeded)
+
+	buyer := f.addrs[1]
+	fund(t, f, buyer, sdk.NewCoins(sdk.NewInt64Coin("usnr", 5000)))
+	res, err := f.msgServer.PurchaseStorage(f.ctx, &types.MsgPurchaseStorage{
+		Buyer:   buyer.String(),
+		Did:     storageDID,
+		SizeMib: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10+2*types.BytesPerMiB, res.QuotaBytes)
+	require.Equal(t, sdk.NewInt64Coin("usnr", 2000), res.Cost)
+	require.Equal(t, int64(3000), f.bankkeeper.GetBalance(f.ctx, buyer, "usnr").Amount.Int64())
+	feeCollector := f.accountkeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, int64(2000), f.bankkeeper.GetBalance(f.ctx, feeCollector, "usnr").Amount.Int64())
+
+	writeRecord(t, f, storageDID, `{"n":2}`, "2024-01-01T00:00:01Z")
+
+	// Other DIDs keep the free tier
+	_, err = f.msgServer.RecordsWrite(f.ctx, &types.MsgRecordsWrite{
+		Author: f.addrs[0].String(),
+		Target: "did:sonr:other",
+		Descriptor_: &types.DWNMessageDescriptor{
+			InterfaceName:    "Records",
+			Method:           "Write",
+			MessageTimestamp: "2024-01-01T00:00:02Z",
+		},
+		Data: []byte(`{"n":"too long"}`),
+	})
+	require.ErrorIs(t, err, types.ErrStorageQuotaExceeded)
+}
+
+func TestPurchaseStorageRejected(t *testing.T) {
+	f := SetupTest(t)
+	buyer := f.addrs[1]
+	fund(t, f, buyer, sdk.NewCoins(sdk.NewInt64Coin("usnr", 1000)))
+
+	msg := &types.MsgPurchaseStorage{Buyer: buyer.String(), Did: storageDID, SizeMib: 2}
+	_, err := f.msgServer.PurchaseStorage(f.ctx, msg)
+	require.ErrorContains(t, err, "insufficient funds")
+
+	msg.SizeMib = types.DefaultMaxPurchaseMiB + 1
+	_, err = f.msgServer.PurchaseStorage(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	setStorageParams(t, f, types.StorageParams{})
+	msg.SizeMib = 1
+	_, err = f.msgServer.PurchaseStorage(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrStorageNotMetered)
+
+	msg.SizeMib = 0
+	_, err = f.msgServer.PurchaseStorage(f.ctx, msg)
+	require.Error(t, err)
+}
+
+func TestStorageAccountsQuery(t *testing.T) {
+	f := SetupTest(t)
+	for i, did := range []string{"did:sonr:c", "did:sonr:a", "did:sonr:b"} {
+		writeRecord(t, f, did, `{"n":1}`, fmt.Sprintf("2024-01-01T00:00:0%dZ", i))
+	}
+
+	res, err := f.queryServer.StorageAccounts(f.ctx, &types.QueryStorageAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Usage, 2)
+	require.Equal(t, "did:sonr:a", res.Usage[0].Did)
+	require.Equal(t, "did:sonr:b", res.Usage[1].Did)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = f.queryServer.StorageAccounts(f.ctx, &types.QueryStorageAccountsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Usage, 1)
+	require.Equal(t, "did:sonr:c", res.Usage[0].Did)
+	require.Equal(t, uint64(7), res.Usage[0].RecordBytes)
+
+	// Accounts survive a genesis round trip
+	exported := f.k.ExportGenesis(f.ctx)
+	require.Len(t, exported.StorageAccounts, 3)
+	require.NoError(t, exported.Validate())
+}
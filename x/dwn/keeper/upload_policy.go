@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+// SetUploadPolicy replaces the limits applied to IPFS uploads
+func (k *Keeper) SetUploadPolicy(policy types.UploadPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	k.uploadPolicy = policy
+	return nil
+}
+
+// UploadPolicy returns the limits applied to IPFS uploads
+func (k Keeper) UploadPolicy() types.UploadPolicy {
+	return k.uploadPolicy
+}
+
+// SetContentScanner installs a virus/abuse scanning hook for uploads
+func (k *Keeper) SetContentScanner(scanner types.ContentScanner) {
+	k.contentScanner = scanner
+}
+
+// SetContentFetcher installs a fallback for reading vault content when the
+// IPFS client is unavailable or cannot serve it, e.g. a gateway.Client
+func (k *Keeper) SetContentFetcher(fetcher types.ContentFetcher) {
+	k.contentFetcher = fetcher
+}
+
+// StorageUsed returns the number of bytes a profile has pinned
+func (k Keeper) StorageUsed(ctx context.Context, owner string) (uint64, error) {
+	used, err := k.StorageUsage.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return used, err
+}
+
+// CheckUpload applies the upload policy, the content scanner and the
+// owner's storage quota without uploading anything. While storage metering is
+// enabled the on-chain quota, shared with the owner's DWN records, applies;
+// otherwise the profile quota of the upload policy does.
+func (k Keeper) CheckUpload(ctx context.Context, u types.Upload) error {
+	return k.checkUpload(ctx, u, 0)
+}
+
+// checkUpload is CheckUpload with pending bytes the owner has pinned but the
+// chain has not charged counted against the quota
+func (k Keeper) checkUpload(ctx context.Context, u types.Upload, pending uint64) error {
+	if err := k.uploadPolicy.Check(u); err != nil {
+		return err
+	}
+
+	if k.contentScanner != nil {
+		verdict, err := k.contentScanner.Scan(ctx, u)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrServiceUnavailable, "content scan failed: "+err.Error())
+		}
+		if !verdict.Allowed {
+			return errorsmod.Wrap(types.ErrContentRejected, verdict.Reason)
+		}
+	}
+
+	storage, err := k.storageParams(ctx)
+	if err != nil {
+		return err
+	}
+	if storage.Enabled() {
+		return k.checkStorageQuota(ctx, storage, u.Owner, pending+uint64(len(u.Data)))
+	}
+
+	if quota := k.uploadPolicy.ProfileQuota; quota > 0 {
+		used, err := k.StorageUsed(ctx, u.Owner)
+		if err != nil {
+			return err
+		}
+		used += pending
+		if used+uint64(len(u.Data)) > quota {
+			return errorsmod.Wrapf(
+				types.ErrStorageQuotaExceeded,
+				"%s uses %d of %d bytes, upload needs %d", u.Owner, used, quota, len(u.Data),
+			)
+		}
+	}
+	return nil
+}
+
+// UploadToIPFS checks an upload against the policy, adds it to IPFS and
+// charges its size to the owner's quota.
+func (k Keeper) UploadToIPFS(ctx context.Context, u types.Upload) (string, error) {
+	return k.UploadToIPFSWithPending(ctx, u, 0)
+}
+
+// UploadToIPFSWithPending is UploadToIPFS for an owner with pending bytes
+// pinned outside the chain, e.g. by a node's attachment server, that count
+// against the quota alongside the owner's usage on chain.
+func (k Keeper) UploadToIPFSWithPending(ctx context.Context, u types.Upload, pending uint64) (string, error) {
+	if err := k.checkUpload(ctx, u, pending); err != nil {
+		return "", err
+	}
+
+	ipfsClient, err := k.GetIPFSClient()
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrIPFSClientNotAvailable, err.Error())
+	}
+	cid, err := ipfsClient.Add(u.Data)
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrNetworkOperation, "failed to add to IPFS: "+err.Error())
+	}
+
+	used, err := k.StorageUsed(ctx, u.Owner)
+	if err != nil {
+		return "", err
+	}
+	if err := k.StorageUsage.Set(ctx, u.Owner, used+uint64(len(u.Data))); err != nil {
+		return "", err
+	}
+	return cid, nil
+}
+
+// ReleaseStorage credits bytes back to a profile, e.g. when a vault is
+// replaced or deleted.
+func (k Keeper) ReleaseStorage(ctx context.Context, owner string, size uint64) error {
+	used, err := k.StorageUsed(ctx, owner)
+	if err != nil {
+		return err
+	}
+	if size >= used {
+		return k.StorageUsage.Remove(ctx, owner)
+	}
+	return k.StorageUsage.Set(ctx, owner, used-size)
+}
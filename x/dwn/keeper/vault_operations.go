@@ -4,17 +4,16 @@ import (
 	"context"
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ipfs/go-cid"
 
 	"github.com/sonr-io/crypto/mpc"
+	"github.com/sonr-io/sonr/app/randomness"
 	didtypes "github.com/sonr-io/sonr/x/did/types"
 	"github.com/sonr-io/sonr/x/dwn/types"
 )
@@ -45,7 +44,7 @@ func (k Keeper) CreateEncryptedMPCVault(
 	}
 
 	// Encrypt MPC data using AES-GCM
-	encryptedData, nonce, err := encryptMPCData(mpcData, encryptionKey)
+	encryptedData, nonce, err := encryptMPCData(ctx, mpcData, encryptionKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encrypt MPC data: %w", err)
 	}
@@ -77,7 +76,7 @@ func (k Keeper) CreateEncryptedMPCVault(
 	}
 
 	// Store encrypted data in IPFS
-	ipfsCID, err := k.storeInIPFS(ctx, jsonData)
+	ipfsCID, err := k.storeInIPFS(ctx, owner, jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to store in IPFS: %w", err)
 	}
@@ -134,16 +133,22 @@ func (k Keeper) generateMPCSecretData(ctx context.Context, did string, owner str
 	// 3. Create secret shares
 	// 4. Return enclave data
 
-	// For now, create mock MPC data
-	publicKey := make([]byte, 33)
-	if _, err := rand.Read(publicKey); err != nil {
+	// For now, create mock MPC data from the block beacon so every validator
+	// derives the same vault
+	stream, err := randomness.NewBeacon(sdk.UnwrapSDKContext(ctx)).Stream(
+		randomness.DomainVaultKeygen,
+		[]byte(did),
+		[]byte(owner),
+	)
+	if err != nil {
 		return nil, err
 	}
 
+	publicKey := make([]byte, 33)
+	_, _ = stream.Read(publicKey)
+
 	nonce := make([]byte, 12)
-	if _, err := rand.Read(nonce); err != nil {
-		return nil, err
-	}
+	_, _ = stream.Read(nonce)
 
 	// Create mock shares (in production these would be generated via MPC)
 	// For now, set to nil as they require protocol.Message type
@@ -176,7 +181,7 @@ func (k Keeper) deriveConsensusEncryptionKey(ctx context.Context, did string) ([
 }
 
 // encryptMPCData encrypts MPC data using AES-GCM
-func encryptMPCData(data *mpc.EnclaveData, key []byte) ([]byte, []byte, error) {
+func encryptMPCData(ctx context.Context, data *mpc.EnclaveData, key []byte) ([]byte, []byte, error) {
 	// Marshal MPC data to JSON
 	plaintext, err := json.Marshal(data)
 	if err != nil {
@@ -195,10 +200,9 @@ func encryptMPCData(data *mpc.EnclaveData, key []byte) ([]byte, []byte, error) {
 		return nil, nil, fmt.Errorf("failed to create GCM: %w", err)
 	}
 
-	// Generate nonce
-	nonce := make([]byte, aesGCM.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return nil, nil, fmt.Errorf("failed to generate nonce: %w", err)
+	nonce, err := deriveNonce(ctx, aesGCM.NonceSize(), []byte("vault.mpc"), plaintext)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Encrypt data
@@ -207,17 +211,15 @@ func encryptMPCData(data *mpc.EnclaveData, key []byte) ([]byte, []byte, error) {
 	return ciphertext, nonce, nil
 }
 
-// storeInIPFS stores data in IPFS and returns the CID
-func (k Keeper) storeInIPFS(ctx context.Context, data []byte) (string, error) {
-	// Check if IPFS client is available
-	if k.ipfsClient == nil {
-		return "", fmt.Errorf("IPFS client not initialized")
-	}
-
-	// Add data to IPFS
-	hash, err := k.ipfsClient.Add(data)
+// storeInIPFS uploads a vault bundle for owner and returns the CID
+func (k Keeper) storeInIPFS(ctx context.Context, owner string, data []byte) (string, error) {
+	hash, err := k.UploadToIPFS(ctx, types.Upload{
+		Owner: owner,
+		Kind:  types.UploadKindBundle,
+		Data:  data,
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to add to IPFS: %w", err)
+		return "", err
 	}
 
 	// Verify the CID is valid
@@ -265,7 +267,7 @@ func (k Keeper) RecoverVaultFromIPFS(
 	ipfsCID string,
 ) (*mpc.EnclaveData, error) {
 	// Retrieve from IPFS
-	data, err := k.retrieveFromIPFS(ctx, ipfsCID)
+	data, err := k.FetchFromIPFS(ctx, ipfsCID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve from IPFS: %w", err)
 	}
@@ -302,14 +304,22 @@ func (k Keeper) RecoverVaultFromIPFS(
 	return mpcData, nil
 }
 
-// retrieveFromIPFS retrieves data from IPFS by CID
-func (k Keeper) retrieveFromIPFS(ctx context.Context, ipfsCID string) ([]byte, error) {
+// FetchFromIPFS retrieves data from IPFS by CID, falling back to the
+// content fetcher when the IPFS client is missing or fails
+func (k Keeper) FetchFromIPFS(ctx context.Context, ipfsCID string) ([]byte, error) {
 	if k.ipfsClient == nil {
-		return nil, fmt.Errorf("IPFS client not initialized")
+		if k.contentFetcher == nil {
+			return nil, fmt.Errorf("IPFS client not initialized")
+		}
+		return k.contentFetcher.Fetch(ctx, ipfsCID)
 	}
 
 	// Get data from IPFS
 	data, err := k.ipfsClient.Get(ipfsCID)
+	if err != nil && k.contentFetcher != nil {
+		k.Logger().Info("IPFS client failed, fetching from gateways", "cid", ipfsCID, "error", err)
+		data, err = k.contentFetcher.Fetch(ctx, ipfsCID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve from IPFS: %w", err)
 	}
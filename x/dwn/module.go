@@ -164,8 +164,14 @@ func (a AppModule) ConsensusVersion() uint64 {
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the DWN module.
-// It performs automatic key rotation checks and returns an empty validator update set.
+// It performs automatic key rotation checks, sweeps expired records and
+// returns an empty validator update set.
 func (a AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	// Expired records must go, so a failed sweep fails the block
+	if _, err := a.keeper.SweepExpiredRecords(ctx); err != nil {
+		return nil, err
+	}
+
 	// Check if key rotation is due and perform it if needed
 	err := a.keeper.CheckAndPerformKeyRotation(ctx)
 	if err != nil {
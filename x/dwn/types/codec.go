@@ -28,6 +28,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgProtocolsConfigure{}, ModuleName+"/MsgProtocolsConfigure", nil)
 	cdc.RegisterConcrete(&MsgPermissionsGrant{}, ModuleName+"/MsgPermissionsGrant", nil)
 	cdc.RegisterConcrete(&MsgPermissionsRevoke{}, ModuleName+"/MsgPermissionsRevoke", nil)
+	cdc.RegisterConcrete(&MsgPurchaseStorage{}, ModuleName+"/MsgPurchaseStorage", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -39,6 +40,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgProtocolsConfigure{},
 		&MsgPermissionsGrant{},
 		&MsgPermissionsRevoke{},
+		&MsgPurchaseStorage{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
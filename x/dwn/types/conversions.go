@@ -22,6 +22,8 @@ func ConvertAPIRecordToType(record *apiv1.DWNRecord) DWNRecord {
 		CreatedAt:           record.CreatedAt,
 		UpdatedAt:           record.UpdatedAt,
 		CreatedHeight:       record.CreatedHeight,
+		ExpiresAt:           record.ExpiresAt,
+		AttachmentCids:      record.AttachmentCids,
 	}
 
 	// Convert descriptor
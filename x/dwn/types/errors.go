@@ -172,4 +172,14 @@ var (
 
 	// IPFS errors (117-126)
 	ErrIPFSClientNotAvailable = errors.Register(ModuleName, 117, "IPFS client not available")
+	ErrUploadTooLarge         = errors.Register(ModuleName, 118, "upload exceeds size limit")
+	ErrMIMETypeNotAllowed     = errors.Register(ModuleName, 119, "content type not allowed")
+	ErrContentRejected        = errors.Register(ModuleName, 120, "content rejected by scanner")
+	ErrStorageQuotaExceeded   = errors.Register(ModuleName, 121, "profile storage quota exceeded")
+	ErrStorageNotMetered      = errors.Register(ModuleName, 122, "storage metering is disabled")
+
+	// Record expiry errors (123-125)
+	ErrInvalidRecordTTL  = errors.Register(ModuleName, 123, "invalid record ttl")
+	ErrInvalidAttachment = errors.Register(ModuleName, 124, "invalid record attachment")
+	ErrRecordExpired     = errors.Register(ModuleName, 125, "record has expired")
 )
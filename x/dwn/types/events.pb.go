@@ -45,6 +45,10 @@ type EventRecordWritten struct {
 	Encrypted bool `protobuf:"varint,7,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
 	// Block height
 	BlockHeight uint64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Expiry timestamp (Unix timestamp), zero if the record does not expire
+	ExpiresAt int64 `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Attachment CIDs the rewrite dropped that no record references any more
+	ReleasedCids []string `protobuf:"bytes,10,rep,name=released_cids,json=releasedCids,proto3" json:"released_cids,omitempty"`
 }
 
 func (m *EventRecordWritten) Reset()         { *m = EventRecordWritten{} }
@@ -136,6 +140,20 @@ func (m *EventRecordWritten) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *EventRecordWritten) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *EventRecordWritten) GetReleasedCids() []string {
+	if m != nil {
+		return m.ReleasedCids
+	}
+	return nil
+}
+
 // EventRecordDeleted is emitted when a record is deleted from DWN
 type EventRecordDeleted struct {
 	// Record ID
@@ -146,6 +164,8 @@ type EventRecordDeleted struct {
 	Deleter string `protobuf:"bytes,3,opt,name=deleter,proto3" json:"deleter,omitempty"`
 	// Block height
 	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Attachment CIDs no longer referenced by any record
+	ReleasedCids []string `protobuf:"bytes,5,rep,name=released_cids,json=releasedCids,proto3" json:"released_cids,omitempty"`
 }
 
 func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
@@ -209,6 +229,95 @@ func (m *EventRecordDeleted) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *EventRecordDeleted) GetReleasedCids() []string {
+	if m != nil {
+		return m.ReleasedCids
+	}
+	return nil
+}
+
+// EventRecordExpired is emitted when an expired record is swept from DWN
+type EventRecordExpired struct {
+	// Record ID
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// Target DID
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Expiry timestamp (Unix timestamp)
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Attachment CIDs no longer referenced by any record
+	ReleasedCids []string `protobuf:"bytes,4,rep,name=released_cids,json=releasedCids,proto3" json:"released_cids,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventRecordExpired) Reset()         { *m = EventRecordExpired{} }
+func (m *EventRecordExpired) String() string { return proto.CompactTextString(m) }
+func (*EventRecordExpired) ProtoMessage()    {}
+func (*EventRecordExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93b2c15ef2ff67ab, []int{2}
+}
+func (m *EventRecordExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordExpired.Merge(m, src)
+}
+func (m *EventRecordExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordExpired proto.InternalMessageInfo
+
+func (m *EventRecordExpired) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *EventRecordExpired) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventRecordExpired) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *EventRecordExpired) GetReleasedCids() []string {
+	if m != nil {
+		return m.ReleasedCids
+	}
+	return nil
+}
+
+func (m *EventRecordExpired) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // EventProtocolConfigured is emitted when a protocol is configured
 type EventProtocolConfigured struct {
 	// Target DID
@@ -225,7 +334,7 @@ func (m *EventProtocolConfigured) Reset()         { *m = EventProtocolConfigured
 func (m *EventProtocolConfigured) String() string { return proto.CompactTextString(m) }
 func (*EventProtocolConfigured) ProtoMessage()    {}
 func (*EventProtocolConfigured) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93b2c15ef2ff67ab, []int{2}
+	return fileDescriptor_93b2c15ef2ff67ab, []int{3}
 }
 func (m *EventProtocolConfigured) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +413,7 @@ func (m *EventPermissionGranted) Reset()         { *m = EventPermissionGranted{}
 func (m *EventPermissionGranted) String() string { return proto.CompactTextString(m) }
 func (*EventPermissionGranted) ProtoMessage()    {}
 func (*EventPermissionGranted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93b2c15ef2ff67ab, []int{3}
+	return fileDescriptor_93b2c15ef2ff67ab, []int{4}
 }
 func (m *EventPermissionGranted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +505,7 @@ func (m *EventPermissionRevoked) Reset()         { *m = EventPermissionRevoked{}
 func (m *EventPermissionRevoked) String() string { return proto.CompactTextString(m) }
 func (*EventPermissionRevoked) ProtoMessage()    {}
 func (*EventPermissionRevoked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93b2c15ef2ff67ab, []int{4}
+	return fileDescriptor_93b2c15ef2ff67ab, []int{5}
 }
 func (m *EventPermissionRevoked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +571,7 @@ func (m *EventVaultCreated) Reset()         { *m = EventVaultCreated{} }
 func (m *EventVaultCreated) String() string { return proto.CompactTextString(m) }
 func (*EventVaultCreated) ProtoMessage()    {}
 func (*EventVaultCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93b2c15ef2ff67ab, []int{5}
+	return fileDescriptor_93b2c15ef2ff67ab, []int{6}
 }
 func (m *EventVaultCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +646,7 @@ func (m *EventVaultKeysRotated) Reset()         { *m = EventVaultKeysRotated{} }
 func (m *EventVaultKeysRotated) String() string { return proto.CompactTextString(m) }
 func (*EventVaultKeysRotated) ProtoMessage()    {}
 func (*EventVaultKeysRotated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93b2c15ef2ff67ab, []int{6}
+	return fileDescriptor_93b2c15ef2ff67ab, []int{7}
 }
 func (m *EventVaultKeysRotated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +730,7 @@ func (m *EventKeyRotation) Reset()         { *m = EventKeyRotation{} }
 func (m *EventKeyRotation) String() string { return proto.CompactTextString(m) }
 func (*EventKeyRotation) ProtoMessage()    {}
 func (*EventKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93b2c15ef2ff67ab, []int{7}
+	return fileDescriptor_93b2c15ef2ff67ab, []int{8}
 }
 func (m *EventKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,69 +801,171 @@ func (m *EventKeyRotation) GetValidatorCount() uint32 {
 	return 0
 }
 
+// EventStoragePurchased is emitted when quota is purchased for a DID
+type EventStoragePurchased struct {
+	// DID receiving the quota
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Account that paid
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// Quota added in bytes
+	AddedBytes uint64 `protobuf:"varint,3,opt,name=added_bytes,json=addedBytes,proto3" json:"added_bytes,omitempty"`
+	// Total quota after the purchase in bytes
+	QuotaBytes uint64 `protobuf:"varint,4,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// Amount charged
+	Cost string `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventStoragePurchased) Reset()         { *m = EventStoragePurchased{} }
+func (m *EventStoragePurchased) String() string { return proto.CompactTextString(m) }
+func (*EventStoragePurchased) ProtoMessage()    {}
+func (*EventStoragePurchased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93b2c15ef2ff67ab, []int{9}
+}
+func (m *EventStoragePurchased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStoragePurchased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStoragePurchased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStoragePurchased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStoragePurchased.Merge(m, src)
+}
+func (m *EventStoragePurchased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStoragePurchased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStoragePurchased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStoragePurchased proto.InternalMessageInfo
+
+func (m *EventStoragePurchased) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *EventStoragePurchased) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventStoragePurchased) GetAddedBytes() uint64 {
+	if m != nil {
+		return m.AddedBytes
+	}
+	return 0
+}
+
+func (m *EventStoragePurchased) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+func (m *EventStoragePurchased) GetCost() string {
+	if m != nil {
+		return m.Cost
+	}
+	return ""
+}
+
+func (m *EventStoragePurchased) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventRecordWritten)(nil), "dwn.v1.EventRecordWritten")
 	proto.RegisterType((*EventRecordDeleted)(nil), "dwn.v1.EventRecordDeleted")
+	proto.RegisterType((*EventRecordExpired)(nil), "dwn.v1.EventRecordExpired")
 	proto.RegisterType((*EventProtocolConfigured)(nil), "dwn.v1.EventProtocolConfigured")
 	proto.RegisterType((*EventPermissionGranted)(nil), "dwn.v1.EventPermissionGranted")
 	proto.RegisterType((*EventPermissionRevoked)(nil), "dwn.v1.EventPermissionRevoked")
 	proto.RegisterType((*EventVaultCreated)(nil), "dwn.v1.EventVaultCreated")
 	proto.RegisterType((*EventVaultKeysRotated)(nil), "dwn.v1.EventVaultKeysRotated")
 	proto.RegisterType((*EventKeyRotation)(nil), "dwn.v1.EventKeyRotation")
+	proto.RegisterType((*EventStoragePurchased)(nil), "dwn.v1.EventStoragePurchased")
 }
 
 func init() { proto.RegisterFile("dwn/v1/events.proto", fileDescriptor_93b2c15ef2ff67ab) }
 
 var fileDescriptor_93b2c15ef2ff67ab = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xae, 0xdb, 0x34, 0x71, 0xa6, 0x49, 0xda, 0xeb, 0xdb, 0xdb, 0xeb, 0xe6, 0x5e, 0xd2, 0x3f,
-	0x7e, 0xb2, 0x21, 0x56, 0x61, 0xcd, 0x82, 0x06, 0x04, 0x55, 0x44, 0x55, 0x19, 0x28, 0x12, 0x1b,
-	0xcb, 0xf1, 0x9c, 0x3a, 0xa3, 0xda, 0x33, 0xd1, 0x78, 0x92, 0x34, 0x7d, 0x00, 0xd6, 0xdd, 0x20,
-	0xf1, 0x2c, 0x3c, 0x41, 0x97, 0x5d, 0xb2, 0x02, 0xd4, 0xbe, 0x00, 0x2b, 0xd6, 0x68, 0x66, 0xec,
-	0xa4, 0x95, 0x59, 0x94, 0xae, 0x92, 0xef, 0x3b, 0xe7, 0xcc, 0xf9, 0xce, 0x37, 0x67, 0x64, 0xf4,
-	0x37, 0x1e, 0x51, 0x67, 0xb8, 0xed, 0xc0, 0x10, 0xa8, 0x48, 0x5a, 0x7d, 0xce, 0x04, 0xb3, 0x8a,
-	0x78, 0x44, 0x5b, 0xc3, 0xed, 0xfa, 0x72, 0xc8, 0x42, 0xa6, 0x28, 0x47, 0xfe, 0xd3, 0xd1, 0xfa,
-	0x5a, 0xc8, 0x58, 0x18, 0x81, 0xa3, 0x50, 0x77, 0x70, 0xe8, 0x08, 0x12, 0x43, 0x22, 0xfc, 0xb8,
-	0xaf, 0x13, 0x36, 0x7f, 0x1a, 0xc8, 0x7a, 0x2e, 0xcf, 0x73, 0x21, 0x60, 0x1c, 0xbf, 0xe3, 0x44,
-	0x08, 0xa0, 0xd6, 0x7f, 0xa8, 0xcc, 0x15, 0xe1, 0x11, 0x6c, 0x1b, 0xeb, 0x46, 0xb3, 0xec, 0x9a,
-	0x9a, 0xd8, 0xc5, 0xd6, 0x0a, 0x2a, 0x0a, 0x9f, 0x87, 0x20, 0xec, 0x59, 0x15, 0x49, 0x91, 0x55,
-	0x47, 0xa6, 0x3a, 0x34, 0x60, 0x91, 0x3d, 0xa7, 0x6b, 0x32, 0x2c, 0x6b, 0x92, 0xa0, 0x07, 0xb1,
-	0x6f, 0x17, 0x74, 0x8d, 0x46, 0xd6, 0x2a, 0x32, 0xb1, 0x2f, 0x7c, 0x2f, 0x20, 0xd8, 0x9e, 0x57,
-	0x91, 0x92, 0xc4, 0x6d, 0x82, 0xa5, 0x06, 0x15, 0x4a, 0xc8, 0x09, 0xd8, 0xc5, 0x75, 0xa3, 0x59,
-	0x70, 0x55, 0xee, 0x6b, 0x72, 0x02, 0xd6, 0xff, 0xa8, 0x0c, 0x34, 0xe0, 0xe3, 0xbe, 0x00, 0x6c,
-	0x97, 0xd6, 0x8d, 0xa6, 0xe9, 0x4e, 0x09, 0x6b, 0x03, 0x55, 0xba, 0x11, 0x0b, 0x8e, 0xbc, 0x1e,
-	0x90, 0xb0, 0x27, 0x6c, 0x53, 0x55, 0x2f, 0x28, 0xee, 0xa5, 0xa2, 0x36, 0x3f, 0x5c, 0x1f, 0xfc,
-	0x19, 0x44, 0x20, 0x2b, 0x6f, 0x35, 0xb8, 0x8d, 0x4a, 0x58, 0xd5, 0xf3, 0x74, 0xee, 0x0c, 0xe6,
-	0x84, 0x14, 0xf2, 0x42, 0x3e, 0x1a, 0xe8, 0x5f, 0x25, 0x64, 0x3f, 0xf5, 0xaa, 0xcd, 0xe8, 0x21,
-	0x09, 0x07, 0x1c, 0xae, 0x36, 0x34, 0xae, 0x35, 0xdc, 0x40, 0x95, 0xcc, 0x59, 0x6f, 0xc0, 0x49,
-	0x2a, 0x67, 0x21, 0xe3, 0xde, 0x72, 0x22, 0x0d, 0xea, 0x0f, 0xba, 0x11, 0x49, 0x7a, 0x80, 0x95,
-	0x2a, 0xd3, 0x9d, 0x12, 0x37, 0xd1, 0xf5, 0x69, 0x16, 0xad, 0x68, 0x5d, 0xc0, 0x63, 0x92, 0x24,
-	0x84, 0xd1, 0x17, 0xdc, 0xa7, 0xd2, 0xa4, 0x2d, 0x54, 0xed, 0x4f, 0xc8, 0xa9, 0x51, 0x95, 0x29,
-	0xb9, 0x8b, 0xa5, 0x29, 0xa1, 0xcc, 0x67, 0x3c, 0x95, 0x97, 0xc1, 0x49, 0x04, 0x20, 0xb3, 0x2b,
-	0x85, 0xd6, 0x3d, 0x54, 0x23, 0x54, 0x00, 0x3f, 0xf4, 0x03, 0xf0, 0xa8, 0x1f, 0x43, 0xba, 0x2d,
-	0xd5, 0x09, 0xbb, 0xe7, 0xc7, 0x20, 0x6d, 0x89, 0x41, 0xf4, 0x58, 0xb6, 0x32, 0x29, 0xb2, 0xda,
-	0x08, 0xc1, 0x71, 0x9f, 0x70, 0x48, 0x3c, 0x5f, 0xa8, 0x95, 0x59, 0x78, 0x54, 0x6f, 0xe9, 0x27,
-	0xd0, 0xca, 0x9e, 0x40, 0xeb, 0x4d, 0xf6, 0x04, 0x76, 0xcc, 0xb3, 0xaf, 0x6b, 0xc6, 0xe9, 0xb7,
-	0x35, 0xc3, 0x2d, 0xa7, 0x75, 0x4f, 0x45, 0xce, 0x9a, 0x52, 0xde, 0x9a, 0x93, 0x9c, 0x33, 0x2e,
-	0x0c, 0xd9, 0xd1, 0x1f, 0x38, 0xc3, 0x55, 0xfe, 0xc4, 0x99, 0x14, 0xe6, 0x7a, 0xcf, 0xfd, 0x76,
-	0x6f, 0xff, 0x52, 0xcd, 0x0f, 0xfc, 0x41, 0x24, 0xda, 0x1c, 0x7c, 0x79, 0x23, 0xab, 0xc8, 0x1c,
-	0x4a, 0x3c, 0x6d, 0x59, 0x52, 0x78, 0x17, 0x5b, 0xcb, 0x68, 0x9e, 0x8d, 0xe8, 0xa4, 0x97, 0x06,
-	0xd6, 0x1d, 0x84, 0xd4, 0x36, 0x04, 0xde, 0x11, 0x8c, 0xd3, 0x6b, 0xd0, 0xfb, 0x11, 0x74, 0x60,
-	0x7c, 0x93, 0xfd, 0xf8, 0x6c, 0xa0, 0x7f, 0xa6, 0x42, 0x3a, 0x30, 0x4e, 0x5c, 0x26, 0x6e, 0x27,
-	0xe6, 0x2e, 0xaa, 0x51, 0x18, 0x79, 0x39, 0x41, 0x15, 0x0a, 0xa3, 0xfd, 0x89, 0xa6, 0x07, 0x68,
-	0x91, 0xcb, 0x0e, 0xd2, 0xd9, 0x6b, 0xb2, 0x6a, 0x19, 0xad, 0x95, 0xe5, 0xc4, 0xcf, 0xe7, 0xc5,
-	0xff, 0x30, 0xd0, 0x92, 0x12, 0xdf, 0x81, 0xb1, 0x9b, 0x56, 0x5b, 0xf7, 0xd1, 0x22, 0x8b, 0xb0,
-	0xec, 0xef, 0x0d, 0x81, 0xcb, 0xcb, 0x52, 0xf2, 0x0b, 0x6e, 0x95, 0x45, 0xb8, 0x03, 0xe3, 0x03,
-	0x4d, 0xca, 0x3c, 0x29, 0xf7, 0x6a, 0xde, 0xac, 0xce, 0xa3, 0x30, 0xba, 0x92, 0xb7, 0x82, 0x8a,
-	0x1c, 0xfc, 0x84, 0xd1, 0x74, 0x9c, 0x14, 0xdd, 0xc0, 0x5c, 0xab, 0x89, 0x96, 0x12, 0x42, 0xc3,
-	0x08, 0x3c, 0xca, 0x30, 0x78, 0x31, 0xc3, 0xa0, 0xc6, 0x30, 0xdd, 0x9a, 0xe6, 0xf7, 0x18, 0x86,
-	0x57, 0x0c, 0x83, 0x74, 0x65, 0xe8, 0x47, 0x04, 0xfb, 0x82, 0x71, 0x2f, 0x60, 0x03, 0xaa, 0x17,
-	0xbf, 0xea, 0xd6, 0x26, 0x74, 0x5b, 0xb2, 0x3b, 0x4f, 0xce, 0x2e, 0x1a, 0xc6, 0xf9, 0x45, 0xc3,
-	0xf8, 0x7e, 0xd1, 0x30, 0x4e, 0x2f, 0x1b, 0x33, 0xe7, 0x97, 0x8d, 0x99, 0x2f, 0x97, 0x8d, 0x99,
-	0xf7, 0x5b, 0x21, 0x11, 0xbd, 0x41, 0xb7, 0x15, 0xb0, 0xd8, 0x49, 0x18, 0xe5, 0x0f, 0x09, 0x53,
-	0xbf, 0xce, 0xb1, 0x23, 0xbf, 0x38, 0x62, 0xdc, 0x87, 0xa4, 0x5b, 0x54, 0xef, 0xe7, 0xf1, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x7d, 0x20, 0x67, 0x85, 0x06, 0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x17, 0x4d, 0xc7, 0x8e, 0xdd, 0xae, 0xd8, 0x9e, 0x7c, 0xfd, 0x0d, 0xa1, 0x27, 0x80, 0xe3, 0x71,
+	0xf8, 0xf1, 0x06, 0x5b, 0x03, 0x6b, 0x16, 0xc4, 0x8c, 0x20, 0x8a, 0x18, 0x45, 0x3d, 0x30, 0x48,
+	0x6c, 0x5a, 0xe5, 0xae, 0x9b, 0x76, 0x29, 0xdd, 0x55, 0xa6, 0xaa, 0xda, 0x9e, 0xce, 0x03, 0xb0,
+	0x9e, 0x0d, 0x12, 0xef, 0xc0, 0x86, 0x25, 0x9a, 0x27, 0x98, 0xe5, 0x2c, 0x59, 0x01, 0x4a, 0x5e,
+	0x80, 0x47, 0x40, 0x55, 0x5d, 0x6d, 0xc7, 0x34, 0x12, 0x21, 0x2b, 0xd7, 0x39, 0x75, 0xab, 0xef,
+	0xb9, 0xe7, 0xde, 0x2a, 0x19, 0xfd, 0x9f, 0x2c, 0xd9, 0x78, 0xf1, 0x68, 0x0c, 0x0b, 0x60, 0x4a,
+	0x8e, 0xe6, 0x82, 0x2b, 0xee, 0x35, 0xc8, 0x92, 0x8d, 0x16, 0x8f, 0x0e, 0xee, 0xc7, 0x3c, 0xe6,
+	0x86, 0x1a, 0xeb, 0x55, 0xb1, 0x7b, 0x70, 0x18, 0x73, 0x1e, 0x27, 0x30, 0x36, 0x68, 0x9a, 0x9d,
+	0x8f, 0x15, 0x4d, 0x41, 0x2a, 0x9c, 0xce, 0x8b, 0x80, 0xc1, 0xcb, 0x6d, 0xe4, 0x3d, 0xd6, 0xdf,
+	0x0b, 0x20, 0xe2, 0x82, 0x7c, 0x23, 0xa8, 0x52, 0xc0, 0xbc, 0xb7, 0x50, 0x4b, 0x18, 0x22, 0xa4,
+	0xc4, 0x77, 0xfa, 0xce, 0xb0, 0x15, 0xb8, 0x05, 0x71, 0x42, 0xbc, 0x7d, 0xd4, 0x50, 0x58, 0xc4,
+	0xa0, 0xfc, 0x6d, 0xb3, 0x63, 0x91, 0x77, 0x80, 0x5c, 0xf3, 0xd1, 0x88, 0x27, 0x7e, 0xad, 0x38,
+	0x53, 0x62, 0x7d, 0x46, 0x46, 0x33, 0x48, 0xb1, 0x5f, 0x2f, 0xce, 0x14, 0xc8, 0x7b, 0x80, 0x5c,
+	0x82, 0x15, 0x0e, 0x23, 0x4a, 0xfc, 0x1d, 0xb3, 0xd3, 0xd4, 0x78, 0x42, 0x89, 0xd6, 0x60, 0xb6,
+	0x24, 0xbd, 0x04, 0xbf, 0xd1, 0x77, 0x86, 0xf5, 0xc0, 0xc4, 0x3e, 0xa5, 0x97, 0xe0, 0xbd, 0x8d,
+	0x5a, 0xc0, 0x22, 0x91, 0xcf, 0x15, 0x10, 0xbf, 0xd9, 0x77, 0x86, 0x6e, 0xb0, 0x26, 0xbc, 0x87,
+	0xa8, 0x3d, 0x4d, 0x78, 0x74, 0x11, 0xce, 0x80, 0xc6, 0x33, 0xe5, 0xbb, 0xe6, 0xf4, 0xae, 0xe1,
+	0xbe, 0x30, 0x94, 0xf7, 0x0e, 0x42, 0xf0, 0x7c, 0x4e, 0x05, 0xc8, 0x10, 0x2b, 0xbf, 0xd5, 0x77,
+	0x86, 0xb5, 0xa0, 0x65, 0x99, 0x4f, 0x95, 0x77, 0x84, 0x3a, 0x02, 0x12, 0xc0, 0x12, 0x88, 0xd6,
+	0x26, 0x7d, 0xd4, 0xaf, 0x0d, 0x5b, 0x41, 0xbb, 0x24, 0x27, 0x94, 0xc8, 0xc1, 0x4f, 0xce, 0x86,
+	0x79, 0x9f, 0x41, 0x02, 0x3a, 0xfb, 0x9d, 0xcc, 0xf3, 0x51, 0x93, 0x98, 0xf3, 0xc2, 0x7a, 0x57,
+	0xc2, 0x4a, 0x31, 0xf5, 0x6a, 0x31, 0x15, 0xb5, 0x3b, 0xff, 0xa0, 0xf6, 0xe7, 0x4d, 0xb5, 0x8f,
+	0x4d, 0xad, 0x77, 0x54, 0xbb, 0xe9, 0x5e, 0xed, 0x5f, 0xdd, 0xab, 0x57, 0xf5, 0x54, 0xea, 0xda,
+	0xa9, 0xd4, 0x35, 0xf8, 0xc1, 0x41, 0x6f, 0x1a, 0xc9, 0x67, 0x76, 0x8e, 0x26, 0x9c, 0x9d, 0xd3,
+	0x38, 0x13, 0x70, 0x53, 0x9a, 0xb3, 0x21, 0xed, 0x21, 0x6a, 0x97, 0x53, 0x17, 0x66, 0x82, 0x5a,
+	0xe1, 0xbb, 0x25, 0xf7, 0xb5, 0xa0, 0x7a, 0x78, 0xe6, 0xd9, 0x34, 0xa1, 0x72, 0x06, 0xc4, 0x88,
+	0x77, 0x83, 0x35, 0x71, 0x0b, 0xbf, 0x07, 0x3f, 0x6e, 0xa3, 0xfd, 0x42, 0x17, 0x88, 0x94, 0x4a,
+	0x49, 0x39, 0xfb, 0x5c, 0x60, 0xa6, 0x9b, 0x7f, 0x84, 0x3a, 0xf3, 0x15, 0xb9, 0xb6, 0xb4, 0xbd,
+	0x26, 0x4f, 0x88, 0x6e, 0x76, 0xac, 0xe3, 0xb9, 0xb0, 0xf2, 0x4a, 0xb8, 0xda, 0x01, 0x28, 0xc7,
+	0xc0, 0x42, 0xef, 0x3d, 0xd4, 0xa5, 0x4c, 0x81, 0x38, 0xc7, 0x11, 0x84, 0x0c, 0xa7, 0x60, 0x6f,
+	0x52, 0x67, 0xc5, 0x3e, 0xc1, 0x29, 0x68, 0x5b, 0x52, 0x50, 0x33, 0x5e, 0x5e, 0x27, 0x8b, 0xbc,
+	0xc9, 0x46, 0xc7, 0xf4, 0x75, 0xda, 0xfd, 0xe8, 0x60, 0x54, 0x3c, 0x0f, 0xa3, 0xf2, 0x79, 0x18,
+	0x7d, 0x55, 0x3e, 0x0f, 0xc7, 0xee, 0xab, 0xdf, 0x0e, 0x9d, 0x17, 0xbf, 0x1f, 0x3a, 0x37, 0xfb,
+	0xfa, 0x77, 0x6b, 0x9a, 0x55, 0x6b, 0x2e, 0x2b, 0xce, 0x04, 0xb0, 0xe0, 0x17, 0xff, 0xc1, 0x19,
+	0x61, 0xe2, 0x57, 0xce, 0x58, 0x58, 0xc9, 0x5d, 0xab, 0xe6, 0xfe, 0xde, 0x41, 0xff, 0x33, 0xc9,
+	0x9f, 0xe1, 0x2c, 0x51, 0x13, 0x01, 0x58, 0x77, 0xe4, 0x01, 0x72, 0x17, 0x1a, 0xaf, 0x53, 0x36,
+	0x0d, 0x3e, 0x21, 0xde, 0x7d, 0xb4, 0xc3, 0x97, 0x6c, 0x95, 0xab, 0x00, 0x7a, 0xb8, 0xcd, 0x34,
+	0x44, 0xe1, 0x05, 0xe4, 0xb6, 0x0d, 0xc5, 0x7c, 0x44, 0xa7, 0x90, 0xdf, 0x66, 0x3e, 0x5e, 0x3a,
+	0xe8, 0x8d, 0xb5, 0x90, 0x53, 0xc8, 0x65, 0xc0, 0xd5, 0xdd, 0xc4, 0xbc, 0x8b, 0xba, 0x0c, 0x96,
+	0x61, 0x45, 0x50, 0x9b, 0xc1, 0xf2, 0x6c, 0xa5, 0xe9, 0x03, 0x74, 0x4f, 0xe8, 0x0c, 0xda, 0xd9,
+	0x0d, 0x59, 0xdd, 0x92, 0xb6, 0x2f, 0xc5, 0x2d, 0x2e, 0xdd, 0x9f, 0x0e, 0xda, 0x33, 0xe2, 0x4f,
+	0x21, 0x0f, 0xec, 0x69, 0xef, 0x7d, 0x74, 0x8f, 0x27, 0x44, 0xe7, 0x0f, 0x17, 0x20, 0x74, 0xb3,
+	0x8c, 0xfc, 0x7a, 0xd0, 0xe1, 0x09, 0x39, 0x85, 0xfc, 0x59, 0x41, 0xea, 0x38, 0x2d, 0xf7, 0x66,
+	0xdc, 0x76, 0x11, 0xc7, 0x60, 0x79, 0x23, 0x6e, 0x1f, 0x35, 0x04, 0x60, 0xc9, 0x99, 0x2d, 0xc7,
+	0xa2, 0xdb, 0x3c, 0x76, 0x43, 0xb4, 0x27, 0x29, 0x8b, 0x13, 0x08, 0x19, 0x27, 0x10, 0xa6, 0x9c,
+	0x80, 0x29, 0xc3, 0x0d, 0xba, 0x05, 0xff, 0x84, 0x13, 0xf8, 0x92, 0x13, 0xd0, 0xae, 0x2c, 0x70,
+	0x42, 0x09, 0x56, 0x5c, 0x84, 0x11, 0xcf, 0x58, 0x31, 0xf8, 0x9d, 0xa0, 0xbb, 0xa2, 0x27, 0x9a,
+	0x1d, 0xfc, 0x52, 0xf6, 0xeb, 0xa9, 0xe2, 0x02, 0xc7, 0x70, 0x96, 0x89, 0x68, 0xa6, 0x1f, 0x2a,
+	0x6f, 0x0f, 0xd5, 0xc8, 0xaa, 0x55, 0x7a, 0xa9, 0xdb, 0x34, 0xcd, 0xf2, 0x75, 0x9b, 0x0c, 0xf0,
+	0x0e, 0xd1, 0x2e, 0x26, 0x04, 0x48, 0x38, 0xcd, 0x15, 0x48, 0x3b, 0x9c, 0xc8, 0x50, 0xc7, 0x9a,
+	0xd1, 0x01, 0xdf, 0x65, 0x5c, 0x61, 0x1b, 0x50, 0xd4, 0x85, 0x0c, 0x55, 0x04, 0x78, 0xa8, 0x1e,
+	0x71, 0xa9, 0xec, 0xb5, 0x35, 0xeb, 0x8a, 0x1b, 0x8d, 0x8a, 0x1b, 0xc7, 0x9f, 0xbc, 0xba, 0xea,
+	0x39, 0xaf, 0xaf, 0x7a, 0xce, 0x1f, 0x57, 0x3d, 0xe7, 0xc5, 0x75, 0x6f, 0xeb, 0xf5, 0x75, 0x6f,
+	0xeb, 0xd7, 0xeb, 0xde, 0xd6, 0xb7, 0x47, 0x31, 0x55, 0xb3, 0x6c, 0x3a, 0x8a, 0x78, 0x3a, 0x96,
+	0x9c, 0x89, 0x0f, 0x29, 0x37, 0xbf, 0xe3, 0xe7, 0x63, 0xfd, 0x47, 0x42, 0xe5, 0x73, 0x90, 0xd3,
+	0x86, 0xb9, 0xfa, 0x1f, 0xff, 0x35, 0x00, 0x80, 0xbe, 0x61, 0x74, 0x5c, 0x08, 0x00, 0x00,
 }
 
 func (m *EventRecordWritten) Marshal() (dAtA []byte, err error) {
@@ -777,6 +988,20 @@ func (m *EventRecordWritten) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReleasedCids) > 0 {
+		for iNdEx := len(m.ReleasedCids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReleasedCids[iNdEx])
+			copy(dAtA[i:], m.ReleasedCids[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ReleasedCids[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -855,6 +1080,15 @@ func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReleasedCids) > 0 {
+		for iNdEx := len(m.ReleasedCids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReleasedCids[iNdEx])
+			copy(dAtA[i:], m.ReleasedCids[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ReleasedCids[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -884,6 +1118,62 @@ func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRecordExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReleasedCids) > 0 {
+		for iNdEx := len(m.ReleasedCids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReleasedCids[iNdEx])
+			copy(dAtA[i:], m.ReleasedCids[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ReleasedCids[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventProtocolConfigured) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1214,6 +1504,65 @@ func (m *EventKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventStoragePurchased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStoragePurchased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStoragePurchased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Cost) > 0 {
+		i -= len(m.Cost)
+		copy(dAtA[i:], m.Cost)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Cost)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.QuotaBytes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QuotaBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AddedBytes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AddedBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1260,6 +1609,15 @@ func (m *EventRecordWritten) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovEvents(uint64(m.BlockHeight))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAt))
+	}
+	if len(m.ReleasedCids) > 0 {
+		for _, s := range m.ReleasedCids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1284,6 +1642,41 @@ func (m *EventRecordDeleted) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovEvents(uint64(m.BlockHeight))
 	}
+	if len(m.ReleasedCids) > 0 {
+		for _, s := range m.ReleasedCids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRecordExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAt))
+	}
+	if len(m.ReleasedCids) > 0 {
+		for _, s := range m.ReleasedCids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
 	return n
 }
 
@@ -1445,6 +1838,36 @@ func (m *EventKeyRotation) Size() (n int) {
 	return n
 }
 
+func (m *EventStoragePurchased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AddedBytes != 0 {
+		n += 1 + sovEvents(uint64(m.AddedBytes))
+	}
+	if m.QuotaBytes != 0 {
+		n += 1 + sovEvents(uint64(m.QuotaBytes))
+	}
+	l = len(m.Cost)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1659,11 +2082,247 @@ func (m *EventRecordWritten) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Encrypted = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedCids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedCids = append(m.ReleasedCids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var v int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1673,17 +2332,16 @@ func (m *EventRecordWritten) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Encrypted = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedCids", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1693,11 +2351,24 @@ func (m *EventRecordWritten) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedCids = append(m.ReleasedCids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1719,7 +2390,7 @@ func (m *EventRecordWritten) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +2413,10 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1813,8 +2484,27 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deleter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedCids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1842,9 +2532,9 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deleter = string(dAtA[iNdEx:postIndex])
+			m.ReleasedCids = append(m.ReleasedCids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2962,6 +3652,209 @@ func (m *EventKeyRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventStoragePurchased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStoragePurchased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStoragePurchased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBytes", wireType)
+			}
+			m.AddedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBytes", wireType)
+			}
+			m.QuotaBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,10 @@
+package types
+
+import "context"
+
+// ContentFetcher reads IPFS content from outside the node's own IPFS client,
+// e.g. from a set of gateways with failover. It is a fallback for content the
+// local node cannot serve.
+type ContentFetcher interface {
+	Fetch(ctx context.Context, cid string) ([]byte, error)
+}
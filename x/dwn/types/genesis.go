@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -13,5 +15,19 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.StorageAccounts))
+	for _, account := range gs.StorageAccounts {
+		if err := account.Validate(); err != nil {
+			return err
+		}
+		if seen[account.Did] {
+			return fmt.Errorf("duplicate storage account %s", account.Did)
+		}
+		seen[account.Did] = true
+	}
+	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -36,6 +37,8 @@ type GenesisState struct {
 	Permissions []DWNPermission `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"`
 	// Vaults
 	Vaults []VaultState `protobuf:"bytes,5,rep,name=vaults,proto3" json:"vaults"`
+	// Metered storage of each DID
+	StorageAccounts []StorageAccount `protobuf:"bytes,6,rep,name=storage_accounts,json=storageAccounts,proto3" json:"storage_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +109,13 @@ func (m *GenesisState) GetVaults() []VaultState {
 	return nil
 }
 
+func (m *GenesisState) GetStorageAccounts() []StorageAccount {
+	if m != nil {
+		return m.StorageAccounts
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// Maximum size for DWN record data in bytes
@@ -130,6 +140,8 @@ type Params struct {
 	EncryptedSchemas []string `protobuf:"bytes,10,rep,name=encrypted_schemas,json=encryptedSchemas,proto3" json:"encrypted_schemas,omitempty"`
 	// Enable single-node fallback for development
 	SingleNodeFallback bool `protobuf:"varint,11,opt,name=single_node_fallback,json=singleNodeFallback,proto3" json:"single_node_fallback,omitempty"`
+	// Per-DID storage quota and pricing
+	Storage StorageParams `protobuf:"bytes,12,opt,name=storage,proto3" json:"storage"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -241,6 +253,153 @@ func (m *Params) GetSingleNodeFallback() bool {
 	return false
 }
 
+func (m *Params) GetStorage() StorageParams {
+	if m != nil {
+		return m.Storage
+	}
+	return StorageParams{}
+}
+
+// StorageParams configures the storage quota of each DID. A DID may store
+// free_quota_bytes plus whatever it has purchased, counting both DWN records
+// and pinned IPFS content.
+type StorageParams struct {
+	// Bytes every DID may store for free. Zero disables metering, leaving
+	// only the node's upload policy in force.
+	FreeQuotaBytes uint64 `protobuf:"varint,1,opt,name=free_quota_bytes,json=freeQuotaBytes,proto3" json:"free_quota_bytes,omitempty"`
+	// Price of one MiB of additional quota, paid to the fee collector
+	PricePerMib types.Coin `protobuf:"bytes,2,opt,name=price_per_mib,json=pricePerMib,proto3" json:"price_per_mib"`
+	// Largest purchase accepted in a single message, in MiB
+	MaxPurchaseMib uint64 `protobuf:"varint,3,opt,name=max_purchase_mib,json=maxPurchaseMib,proto3" json:"max_purchase_mib,omitempty"`
+}
+
+func (m *StorageParams) Reset()         { *m = StorageParams{} }
+func (m *StorageParams) String() string { return proto.CompactTextString(m) }
+func (*StorageParams) ProtoMessage()    {}
+func (*StorageParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e7492a25d5871dc, []int{2}
+}
+func (m *StorageParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageParams.Merge(m, src)
+}
+func (m *StorageParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageParams proto.InternalMessageInfo
+
+func (m *StorageParams) GetFreeQuotaBytes() uint64 {
+	if m != nil {
+		return m.FreeQuotaBytes
+	}
+	return 0
+}
+
+func (m *StorageParams) GetPricePerMib() types.Coin {
+	if m != nil {
+		return m.PricePerMib
+	}
+	return types.Coin{}
+}
+
+func (m *StorageParams) GetMaxPurchaseMib() uint64 {
+	if m != nil {
+		return m.MaxPurchaseMib
+	}
+	return 0
+}
+
+// StorageAccount is the metered storage of a DID
+type StorageAccount struct {
+	// DID the storage belongs to
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Bytes held in DWN records targeting the DID
+	RecordBytes uint64 `protobuf:"varint,2,opt,name=record_bytes,json=recordBytes,proto3" json:"record_bytes,omitempty"`
+	// Number of DWN records targeting the DID
+	RecordCount uint64 `protobuf:"varint,3,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// Quota purchased on top of the free tier, in bytes
+	PurchasedBytes uint64 `protobuf:"varint,4,opt,name=purchased_bytes,json=purchasedBytes,proto3" json:"purchased_bytes,omitempty"`
+}
+
+func (m *StorageAccount) Reset()         { *m = StorageAccount{} }
+func (m *StorageAccount) String() string { return proto.CompactTextString(m) }
+func (*StorageAccount) ProtoMessage()    {}
+func (*StorageAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e7492a25d5871dc, []int{3}
+}
+func (m *StorageAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAccount.Merge(m, src)
+}
+func (m *StorageAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAccount proto.InternalMessageInfo
+
+func (m *StorageAccount) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *StorageAccount) GetRecordBytes() uint64 {
+	if m != nil {
+		return m.RecordBytes
+	}
+	return 0
+}
+
+func (m *StorageAccount) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *StorageAccount) GetPurchasedBytes() uint64 {
+	if m != nil {
+		return m.PurchasedBytes
+	}
+	return 0
+}
+
 type IPFSStatus struct {
 	PeerId   string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 	PeerName string `protobuf:"bytes,2,opt,name=peer_name,json=peerName,proto3" json:"peer_name,omitempty"`
@@ -252,7 +411,7 @@ func (m *IPFSStatus) Reset()         { *m = IPFSStatus{} }
 func (m *IPFSStatus) String() string { return proto.CompactTextString(m) }
 func (*IPFSStatus) ProtoMessage()    {}
 func (*IPFSStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e7492a25d5871dc, []int{2}
+	return fileDescriptor_8e7492a25d5871dc, []int{4}
 }
 func (m *IPFSStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,57 +471,72 @@ func (m *IPFSStatus) GetVersion() string {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dwn.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "dwn.v1.Params")
+	proto.RegisterType((*StorageParams)(nil), "dwn.v1.StorageParams")
+	proto.RegisterType((*StorageAccount)(nil), "dwn.v1.StorageAccount")
 	proto.RegisterType((*IPFSStatus)(nil), "dwn.v1.IPFSStatus")
 }
 
 func init() { proto.RegisterFile("dwn/v1/genesis.proto", fileDescriptor_8e7492a25d5871dc) }
 
 var fileDescriptor_8e7492a25d5871dc = []byte{
-	// 690 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x93, 0xc1, 0x4e, 0xdb, 0x4e,
-	0x10, 0xc6, 0x63, 0x12, 0x12, 0xb2, 0xf9, 0xf3, 0xa7, 0x59, 0x02, 0xb1, 0xa8, 0x14, 0x22, 0x2a,
-	0x55, 0x11, 0x2d, 0x31, 0xa1, 0xad, 0x2a, 0x51, 0x71, 0xa1, 0x14, 0x84, 0x2a, 0xa1, 0xc8, 0xa9,
-	0x5a, 0xa9, 0x17, 0x6b, 0x13, 0x0f, 0xc1, 0xc2, 0xde, 0xb5, 0x76, 0x4d, 0x12, 0xf3, 0x08, 0x3d,
-	0xf5, 0xd8, 0xde, 0x78, 0x84, 0x3e, 0x06, 0x97, 0x4a, 0x1c, 0x7b, 0xaa, 0x2a, 0x38, 0xb4, 0x8f,
-	0x51, 0x79, 0xd6, 0x4e, 0xe8, 0x05, 0xec, 0xf9, 0xbe, 0x5f, 0x66, 0xbe, 0xd1, 0x98, 0xd4, 0xdc,
-	0x31, 0xb7, 0x46, 0x1d, 0x6b, 0x08, 0x1c, 0x94, 0xa7, 0xda, 0xa1, 0x14, 0x91, 0xa0, 0x45, 0x77,
-	0xcc, 0xdb, 0xa3, 0xce, 0x5a, 0x95, 0x05, 0x1e, 0x17, 0x16, 0xfe, 0xd5, 0xd2, 0x1a, 0x4d, 0x01,
-	0x15, 0xb1, 0x08, 0xd2, 0x5a, 0x6d, 0x28, 0x86, 0x02, 0x1f, 0xad, 0xe4, 0x49, 0x57, 0x37, 0xbe,
-	0xce, 0x91, 0xff, 0x8e, 0xf4, 0xcf, 0xf6, 0x12, 0x33, 0x7d, 0x4a, 0x8a, 0x21, 0x93, 0x2c, 0x50,
-	0xa6, 0xd1, 0x34, 0x5a, 0x95, 0x9d, 0xff, 0xdb, 0xba, 0x4d, 0xbb, 0x8b, 0xd5, 0xfd, 0xc2, 0xf5,
-	0xcf, 0xf5, 0x9c, 0x9d, 0x7a, 0x68, 0x87, 0x94, 0x24, 0x0c, 0x84, 0x74, 0x95, 0x39, 0xd7, 0xcc,
-	0xb7, 0x2a, 0x3b, 0xd5, 0xcc, 0x7e, 0xf0, 0xe1, 0xc4, 0x46, 0x25, 0x25, 0x32, 0x1f, 0x7d, 0x49,
-	0xca, 0xd8, 0x7a, 0x20, 0x7c, 0x65, 0xe6, 0x11, 0x5a, 0xbe, 0x07, 0x75, 0x53, 0x2d, 0xc5, 0x66,
-	0x5e, 0xba, 0x47, 0x2a, 0x21, 0xc8, 0xc0, 0x53, 0xca, 0x13, 0x5c, 0x99, 0x05, 0x44, 0x57, 0xee,
-	0xa3, 0x53, 0x35, 0x85, 0xef, 0xfb, 0xe9, 0x36, 0x29, 0x8e, 0xd8, 0x85, 0x1f, 0x29, 0x73, 0x1e,
-	0x49, 0x9a, 0x91, 0xef, 0x93, 0x2a, 0x86, 0xcf, 0xc2, 0x69, 0xdf, 0xc6, 0xf7, 0x02, 0x29, 0xea,
-	0xd4, 0xf4, 0x31, 0x59, 0x0a, 0xd8, 0xc4, 0xd1, 0x19, 0x1c, 0xe5, 0x5d, 0x02, 0xae, 0xa7, 0x60,
-	0x2f, 0x06, 0x6c, 0xa2, 0x73, 0xf6, 0xbc, 0x4b, 0xa0, 0x1d, 0xb2, 0x92, 0xf8, 0xa6, 0x43, 0x3b,
-	0x21, 0x48, 0xc7, 0x1d, 0x73, 0x73, 0xae, 0x69, 0xb4, 0x16, 0x6d, 0x1a, 0xb0, 0x49, 0x16, 0x50,
-	0x75, 0x41, 0x1e, 0x8c, 0x39, 0x7d, 0x41, 0xea, 0x88, 0xcc, 0x46, 0x9d, 0x42, 0x79, 0x84, 0x6a,
-	0x09, 0x34, 0x53, 0x53, 0xec, 0x39, 0x59, 0xc5, 0x31, 0x9d, 0x81, 0x04, 0x16, 0x79, 0x82, 0x3b,
-	0xc0, 0x59, 0xdf, 0x07, 0xd7, 0x2c, 0x34, 0x8d, 0xd6, 0x82, 0x5d, 0x43, 0xf5, 0x75, 0x2a, 0xbe,
-	0xd1, 0x1a, 0x7d, 0x45, 0xd6, 0x02, 0x8f, 0x3b, 0x9a, 0x94, 0x70, 0x2a, 0x41, 0x9d, 0x39, 0x1e,
-	0x8f, 0x40, 0x8e, 0x98, 0x6f, 0xce, 0x63, 0xa4, 0x7a, 0xe0, 0x71, 0xdc, 0x89, 0xad, 0xf5, 0xe3,
-	0x54, 0xa6, 0x5b, 0x84, 0x02, 0x1f, 0xc8, 0x38, 0xfc, 0xa7, 0x5d, 0x11, 0xdb, 0x55, 0x67, 0x4a,
-	0xd6, 0x6b, 0x93, 0x54, 0xcf, 0x21, 0x76, 0xa4, 0x88, 0xf4, 0x7c, 0x2e, 0x8b, 0x95, 0x59, 0xc2,
-	0x48, 0x4b, 0xe7, 0x10, 0xdb, 0x69, 0xfd, 0x80, 0xc5, 0x8a, 0xee, 0x66, 0x73, 0xf9, 0x9e, 0xcb,
-	0x22, 0x21, 0x95, 0x73, 0x2a, 0xa4, 0x93, 0xe0, 0x43, 0xe0, 0xe6, 0x02, 0x42, 0xab, 0x38, 0x57,
-	0x66, 0x38, 0x14, 0xf2, 0x2d, 0xc4, 0x47, 0xc0, 0xa9, 0x45, 0x96, 0xd3, 0xe6, 0xe0, 0xce, 0x36,
-	0x6f, 0x96, 0x9b, 0xf9, 0x56, 0xd9, 0xa6, 0x53, 0x69, 0xba, 0x77, 0xfa, 0x84, 0x54, 0x67, 0x80,
-	0x1a, 0x9c, 0x41, 0xc0, 0x94, 0x49, 0xd0, 0xfe, 0x60, 0x2a, 0xf4, 0x74, 0x9d, 0x6e, 0x93, 0x9a,
-	0xf2, 0xf8, 0xd0, 0x07, 0x87, 0x0b, 0x17, 0x9c, 0x53, 0xe6, 0xfb, 0x7d, 0x36, 0x38, 0x37, 0x2b,
-	0x18, 0x9b, 0x6a, 0xed, 0x44, 0xb8, 0x70, 0x98, 0x2a, 0xbb, 0xf5, 0x2f, 0x57, 0xeb, 0xb9, 0x3f,
-	0x57, 0xeb, 0xc6, 0xa7, 0xdf, 0xdf, 0x36, 0x49, 0xf2, 0x25, 0xea, 0x8f, 0x65, 0x23, 0x26, 0xe4,
-	0xb8, 0x7b, 0xd8, 0x4b, 0x4e, 0xed, 0x42, 0xd1, 0x3a, 0x29, 0x85, 0x00, 0xd2, 0xf1, 0x5c, 0x3c,
-	0xa5, 0xb2, 0x5d, 0x4c, 0x5e, 0x8f, 0x5d, 0xfa, 0x90, 0x94, 0x51, 0xe0, 0x2c, 0x00, 0xbc, 0x9b,
-	0xb2, 0xbd, 0x90, 0x14, 0x4e, 0x58, 0x00, 0x53, 0x31, 0x8a, 0x43, 0xc0, 0xfb, 0x48, 0xc5, 0x77,
-	0x71, 0x08, 0xd4, 0x24, 0xa5, 0x11, 0xc8, 0xe4, 0x4a, 0xf0, 0x08, 0xca, 0x76, 0xf6, 0xba, 0xbf,
-	0x77, 0x7d, 0xdb, 0x30, 0x6e, 0x6e, 0x1b, 0xc6, 0xaf, 0xdb, 0x86, 0xf1, 0xf9, 0xae, 0x91, 0xbb,
-	0xb9, 0x6b, 0xe4, 0x7e, 0xdc, 0x35, 0x72, 0x1f, 0x1f, 0x0d, 0xbd, 0xe8, 0xec, 0xa2, 0xdf, 0x1e,
-	0x88, 0xc0, 0x52, 0x82, 0xcb, 0x2d, 0x4f, 0xe0, 0x7f, 0x6b, 0x62, 0x25, 0xa3, 0x27, 0x7d, 0x54,
-	0xbf, 0x88, 0x6b, 0x7d, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x68, 0xbb, 0x7a, 0x47, 0x89, 0x04,
-	0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x54, 0x4d, 0x6f, 0x23, 0x45,
+	0x10, 0xf5, 0xc4, 0x5e, 0x3b, 0x6e, 0xe7, 0xcb, 0xbd, 0xde, 0x64, 0x08, 0x92, 0x63, 0x8c, 0x04,
+	0xd6, 0xc2, 0x7a, 0xd6, 0x81, 0x15, 0x52, 0xd0, 0x1e, 0x48, 0x42, 0xa2, 0x08, 0x6d, 0x64, 0xc6,
+	0x08, 0x24, 0x2e, 0xa3, 0x9e, 0x99, 0x8a, 0xd3, 0x8a, 0xa7, 0xdb, 0x74, 0x8f, 0x9d, 0xcc, 0x9e,
+	0x39, 0x71, 0x82, 0x1b, 0xc7, 0xfd, 0x05, 0x88, 0x9f, 0xb1, 0xc7, 0x3d, 0x72, 0x42, 0x28, 0x39,
+	0xc0, 0xcf, 0x40, 0xfd, 0x31, 0x1e, 0xfb, 0x92, 0xf4, 0xbc, 0x57, 0xaf, 0xea, 0x55, 0xb9, 0xba,
+	0x51, 0x2b, 0xbe, 0x65, 0xde, 0x7c, 0xe0, 0x8d, 0x81, 0x81, 0xa4, 0xb2, 0x3f, 0x15, 0x3c, 0xe5,
+	0xb8, 0x1a, 0xdf, 0xb2, 0xfe, 0x7c, 0xb0, 0xdf, 0x24, 0x09, 0x65, 0xdc, 0xd3, 0x7f, 0x0d, 0xb5,
+	0xdf, 0x8e, 0xb8, 0x4c, 0xb8, 0xf4, 0x42, 0x22, 0xc1, 0x9b, 0x0f, 0x42, 0x48, 0xc9, 0xc0, 0x8b,
+	0x38, 0x65, 0x96, 0xc7, 0x36, 0xa1, 0x4c, 0x49, 0x0a, 0x16, 0x6b, 0x8d, 0xf9, 0x98, 0xeb, 0xa3,
+	0xa7, 0x4e, 0x06, 0xed, 0xde, 0xaf, 0xa1, 0x8d, 0x73, 0x53, 0x76, 0xa4, 0x82, 0xf1, 0xa7, 0xa8,
+	0x3a, 0x25, 0x82, 0x24, 0xd2, 0x75, 0x3a, 0x4e, 0xaf, 0x71, 0xb8, 0xd5, 0x37, 0x36, 0xfa, 0x43,
+	0x8d, 0x1e, 0x57, 0xde, 0xfe, 0x7d, 0x50, 0xf2, 0x6d, 0x0c, 0x1e, 0xa0, 0x9a, 0x80, 0x88, 0x8b,
+	0x58, 0xba, 0x6b, 0x9d, 0x72, 0xaf, 0x71, 0xd8, 0xcc, 0xc3, 0x4f, 0x7f, 0xb8, 0xf4, 0x35, 0x63,
+	0x15, 0x79, 0x1c, 0xfe, 0x02, 0xd5, 0x75, 0xe9, 0x88, 0x4f, 0xa4, 0x5b, 0xd6, 0xa2, 0xc7, 0x4b,
+	0xa2, 0xa1, 0xe5, 0xac, 0xac, 0x88, 0xc5, 0x2f, 0x51, 0x63, 0x0a, 0x22, 0xa1, 0x52, 0x52, 0xce,
+	0xa4, 0x5b, 0xd1, 0xd2, 0x27, 0xcb, 0xd2, 0x05, 0x6b, 0xc5, 0xcb, 0xf1, 0xf8, 0x39, 0xaa, 0xce,
+	0xc9, 0x6c, 0x92, 0x4a, 0xf7, 0x91, 0x56, 0xe2, 0x5c, 0xf9, 0xbd, 0x42, 0x75, 0xf3, 0x79, 0x73,
+	0x26, 0x0e, 0x9f, 0xa3, 0x1d, 0x99, 0x72, 0x41, 0xc6, 0x10, 0x90, 0x28, 0xe2, 0x33, 0x96, 0x4a,
+	0xb7, 0xaa, 0xb5, 0xbb, 0xb9, 0x76, 0x64, 0xf8, 0xaf, 0x0c, 0x6d, 0xf5, 0xdb, 0x72, 0x05, 0x95,
+	0xdd, 0x9f, 0x1f, 0xa1, 0xaa, 0x19, 0x1f, 0xfe, 0x08, 0x6d, 0x27, 0xe4, 0x2e, 0x30, 0xc3, 0x08,
+	0x24, 0x7d, 0x0d, 0x7a, 0xce, 0x15, 0x7f, 0x33, 0x21, 0x77, 0x66, 0x60, 0x23, 0xfa, 0x1a, 0xf0,
+	0x00, 0x3d, 0x51, 0x71, 0x8b, 0xee, 0x83, 0x29, 0x88, 0x20, 0xbe, 0x65, 0xee, 0x5a, 0xc7, 0xe9,
+	0x6d, 0xfa, 0x38, 0x21, 0x77, 0xf9, 0xa4, 0xe4, 0x10, 0xc4, 0xe9, 0x2d, 0xc3, 0x2f, 0xd0, 0x9e,
+	0x96, 0x14, 0x3d, 0x2f, 0x44, 0x65, 0x2d, 0x6a, 0x29, 0x51, 0xc1, 0x5a, 0xd9, 0xe7, 0x68, 0x57,
+	0xf7, 0x1b, 0x44, 0x02, 0x48, 0x4a, 0x39, 0x0b, 0x80, 0x91, 0x70, 0x02, 0xb1, 0x5b, 0xe9, 0x38,
+	0xbd, 0x75, 0xbf, 0xa5, 0xd9, 0x13, 0x4b, 0x7e, 0x6d, 0x38, 0xfc, 0x25, 0xda, 0x4f, 0x28, 0x0b,
+	0x8c, 0x52, 0xc0, 0x95, 0x00, 0x79, 0x1d, 0x50, 0x96, 0x82, 0x98, 0x93, 0x89, 0xfb, 0x48, 0xb7,
+	0xb4, 0x97, 0x50, 0xa6, 0x87, 0xeb, 0x1b, 0xfe, 0xc2, 0xd2, 0xf8, 0x19, 0xc2, 0xc0, 0x22, 0x91,
+	0x4d, 0x57, 0xca, 0x55, 0x75, 0xb9, 0x66, 0xc1, 0xe4, 0xb5, 0x9e, 0xa2, 0xe6, 0x0d, 0x64, 0x81,
+	0xe0, 0xa9, 0xf1, 0x17, 0x93, 0x4c, 0xba, 0x35, 0xdd, 0xd2, 0xf6, 0x0d, 0x64, 0xbe, 0xc5, 0x4f,
+	0x49, 0x26, 0xf1, 0x51, 0xee, 0x6b, 0x42, 0x63, 0x92, 0x72, 0x21, 0x83, 0x2b, 0x2e, 0x02, 0x25,
+	0x1f, 0x03, 0x73, 0xd7, 0xb5, 0x68, 0x57, 0xfb, 0xca, 0x03, 0xce, 0xb8, 0xf8, 0x06, 0xb2, 0x73,
+	0x60, 0xd8, 0x43, 0x8f, 0x6d, 0x71, 0x88, 0x8b, 0xc9, 0xbb, 0xf5, 0x4e, 0xb9, 0x57, 0xf7, 0xf1,
+	0x82, 0x5a, 0xcc, 0x1d, 0x7f, 0x82, 0x9a, 0x85, 0x40, 0x46, 0xd7, 0x90, 0x10, 0xe9, 0x22, 0x1d,
+	0xbe, 0xb3, 0x20, 0x46, 0x06, 0xc7, 0xcf, 0x51, 0x4b, 0x52, 0x36, 0x9e, 0x40, 0xc0, 0x78, 0x0c,
+	0xc1, 0x15, 0x99, 0x4c, 0x42, 0x12, 0xdd, 0xb8, 0x0d, 0xdd, 0x36, 0x36, 0xdc, 0x25, 0x8f, 0xe1,
+	0xcc, 0x32, 0xf8, 0x05, 0xaa, 0xd9, 0x4d, 0x72, 0x37, 0x3a, 0xce, 0xf2, 0xb2, 0xdb, 0xb5, 0x5b,
+	0xb9, 0x92, 0x79, 0xec, 0xd1, 0xde, 0xef, 0x6f, 0x0e, 0x4a, 0xff, 0xbd, 0x39, 0x70, 0x7e, 0xf9,
+	0xf7, 0xcf, 0xa7, 0x48, 0xbd, 0x04, 0xe6, 0xb2, 0x76, 0xff, 0x70, 0xd0, 0xe6, 0x8a, 0x12, 0xf7,
+	0xd0, 0xce, 0x95, 0x00, 0x08, 0x7e, 0x9a, 0xf1, 0x94, 0x04, 0x61, 0x96, 0x82, 0xb4, 0xeb, 0xb8,
+	0xa5, 0xf0, 0x6f, 0x15, 0x7c, 0xac, 0x50, 0x7c, 0x82, 0x36, 0xa7, 0x82, 0x46, 0xa0, 0x57, 0x2a,
+	0xa1, 0xa1, 0xde, 0xc3, 0xc6, 0xe1, 0x7b, 0x7d, 0xf3, 0x12, 0xf5, 0xd5, 0x4b, 0xd4, 0xb7, 0x2f,
+	0x51, 0xff, 0x84, 0xd3, 0xe2, 0x0a, 0x2a, 0xd5, 0x10, 0xc4, 0x2b, 0x1a, 0xaa, 0x72, 0x7a, 0x43,
+	0x67, 0x22, 0xba, 0x26, 0x12, 0x74, 0x9e, 0xb2, 0x29, 0xa7, 0x56, 0xd3, 0xc2, 0xaf, 0x68, 0x78,
+	0x54, 0x51, 0xfe, 0xbb, 0xbf, 0x39, 0x68, 0x6b, 0xf5, 0x86, 0xe1, 0x1d, 0x54, 0x8e, 0x69, 0xac,
+	0x4d, 0xd6, 0x7d, 0x75, 0xc4, 0x1f, 0xa0, 0x0d, 0x7b, 0x9b, 0x8c, 0xff, 0x35, 0x9d, 0xb0, 0x61,
+	0x30, 0x63, 0xbe, 0x08, 0xd1, 0x49, 0xdc, 0xf2, 0x72, 0xc8, 0x89, 0xce, 0xfb, 0x31, 0xda, 0xce,
+	0x6d, 0xe5, 0x89, 0x2a, 0xc6, 0xd9, 0x02, 0xd6, 0xb9, 0xba, 0x19, 0x42, 0x17, 0xc3, 0xb3, 0x91,
+	0x7a, 0x2f, 0x66, 0x12, 0xef, 0xa1, 0xda, 0x14, 0x40, 0x04, 0x0b, 0x4b, 0x55, 0xf5, 0x79, 0x11,
+	0xe3, 0xf7, 0x51, 0x5d, 0x13, 0x8c, 0x24, 0xa0, 0x2d, 0xd5, 0xfd, 0x75, 0x05, 0x5c, 0x92, 0x04,
+	0x16, 0x64, 0x9a, 0x4d, 0xc1, 0x2d, 0x17, 0xe4, 0x77, 0xd9, 0x14, 0xb0, 0x8b, 0x6a, 0x73, 0x10,
+	0xea, 0x86, 0x6a, 0x07, 0x75, 0x3f, 0xff, 0x3c, 0x7e, 0xf9, 0xf6, 0xbe, 0xed, 0xbc, 0xbb, 0x6f,
+	0x3b, 0xff, 0xdc, 0xb7, 0x9d, 0x5f, 0x1f, 0xda, 0xa5, 0x77, 0x0f, 0xed, 0xd2, 0x5f, 0x0f, 0xed,
+	0xd2, 0x8f, 0x1f, 0x8e, 0x69, 0x7a, 0x3d, 0x0b, 0xfb, 0x11, 0x4f, 0x3c, 0xc9, 0x99, 0x78, 0x46,
+	0xb9, 0xfe, 0xef, 0xdd, 0x79, 0xea, 0xf7, 0x57, 0x75, 0x64, 0x58, 0xd5, 0x2b, 0xfd, 0xd9, 0xff,
+	0x03, 0x00, 0xbc, 0xae, 0xa1, 0x9d, 0x6e, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -427,6 +601,39 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SingleNodeFallback != that1.SingleNodeFallback {
 		return false
 	}
+	if !this.Storage.Equal(&that1.Storage) {
+		return false
+	}
+	return true
+}
+func (this *StorageParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageParams)
+	if !ok {
+		that2, ok := that.(StorageParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FreeQuotaBytes != that1.FreeQuotaBytes {
+		return false
+	}
+	if !this.PricePerMib.Equal(&that1.PricePerMib) {
+		return false
+	}
+	if this.MaxPurchaseMib != that1.MaxPurchaseMib {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -449,6 +656,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageAccounts) > 0 {
+		for iNdEx := len(m.StorageAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Vaults) > 0 {
 		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -538,6 +759,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if m.SingleNodeFallback {
 		i--
 		if m.SingleNodeFallback {
@@ -619,6 +850,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StorageParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPurchaseMib != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPurchaseMib))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.PricePerMib.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.FreeQuotaBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FreeQuotaBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PurchasedBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PurchasedBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecordBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IPFSStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -713,6 +1032,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StorageAccounts) > 0 {
+		for _, e := range m.StorageAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -761,6 +1086,47 @@ func (m *Params) Size() (n int) {
 	if m.SingleNodeFallback {
 		n += 2
 	}
+	l = m.Storage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *StorageParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FreeQuotaBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.FreeQuotaBytes))
+	}
+	l = m.PricePerMib.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxPurchaseMib != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPurchaseMib))
+	}
+	return n
+}
+
+func (m *StorageAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RecordBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordBytes))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordCount))
+	}
+	if m.PurchasedBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.PurchasedBytes))
+	}
 	return n
 }
 
@@ -993,17 +1359,51 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccounts", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageAccounts = append(m.StorageAccounts, StorageAccount{})
+			if err := m.StorageAccounts[len(m.StorageAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1281,6 +1681,299 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SingleNodeFallback = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeQuotaBytes", wireType)
+			}
+			m.FreeQuotaBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeQuotaBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePerMib", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PricePerMib.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPurchaseMib", wireType)
+			}
+			m.MaxPurchaseMib = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPurchaseMib |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordBytes", wireType)
+			}
+			m.RecordBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchasedBytes", wireType)
+			}
+			m.PurchasedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchasedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -15,6 +17,7 @@ var (
 	_ sdk.Msg = &MsgPermissionsGrant{}
 	_ sdk.Msg = &MsgPermissionsRevoke{}
 	_ sdk.Msg = &MsgRotateVaultKeys{}
+	_ sdk.Msg = &MsgPurchaseStorage{}
 )
 
 // NewMsgUpdateParams creates new instance of MsgUpdateParams
@@ -73,7 +76,10 @@ func (m *MsgRecordsWrite) ValidateBasic() error {
 	if len(m.Data) == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "data cannot be empty")
 	}
-	return nil
+	if err := ValidateRecordTTL(m.Ttl); err != nil {
+		return err
+	}
+	return ValidateAttachmentCIDs(m.AttachmentCids)
 }
 
 // MsgRecordsDelete implementation
@@ -190,6 +196,29 @@ func (m *MsgRotateVaultKeys) ValidateBasic() error {
 	return nil
 }
 
+// GetSigners returns the expected signers for a MsgPurchaseStorage message
+func (m *MsgPurchaseStorage) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m *MsgPurchaseStorage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address: %s", err)
+	}
+	if m.Did == "" {
+		return ErrDIDEmpty
+	}
+	if m.SizeMib == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "size must be positive")
+	}
+	if m.SizeMib > math.MaxUint64/BytesPerMiB {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "size cannot exceed %d MiB", math.MaxUint64/BytesPerMiB)
+	}
+	return nil
+}
+
 // GetModuleAddress returns the dwn module account address
 func GetModuleAddress() sdk.AccAddress {
 	return address.Module(ModuleName)
@@ -0,0 +1,9 @@
+package types
+
+// DWN protocol for the NFT metadata x/did caches in the DWN of a DID whose
+// profile shows the token
+const (
+	NFTMetadataProtocol   = "https://sonr.io/protocols/did-assets"
+	NFTMetadataSchema     = "https://sonr.io/schemas/did-assets/nft/v1"
+	NFTMetadataDataFormat = "application/json"
+)
@@ -55,6 +55,7 @@ func DefaultParams() Params {
 		EncryptedProtocols:      DefaultEncryptedProtocols,
 		EncryptedSchemas:        DefaultEncryptedSchemas,
 		SingleNodeFallback:      DefaultSingleNodeFallback,
+		Storage:                 DefaultStorageParams(),
 	}
 }
 
@@ -94,6 +95,9 @@ func (p Params) Validate() error {
 	if err := validateEncryptedSchemas(p.EncryptedSchemas); err != nil {
 		return err
 	}
+	if err := p.Storage.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
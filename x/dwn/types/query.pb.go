@@ -311,6 +311,8 @@ type QueryRecordsRequest struct {
 	PublishedOnly bool `protobuf:"varint,5,opt,name=published_only,json=publishedOnly,proto3" json:"published_only,omitempty"`
 	// Pagination
 	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Include records that expired but were not swept yet
+	IncludeExpired bool `protobuf:"varint,7,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
 }
 
 func (m *QueryRecordsRequest) Reset()         { *m = QueryRecordsRequest{} }
@@ -388,6 +390,13 @@ func (m *QueryRecordsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryRecordsRequest) GetIncludeExpired() bool {
+	if m != nil {
+		return m.IncludeExpired
+	}
+	return false
+}
+
 // QueryRecordsResponse is the response type for querying DWN records
 type QueryRecordsResponse struct {
 	// List of records
@@ -1505,6 +1514,300 @@ func (m *QueryVRFContributionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// StorageUsage is the storage used by a DID against its quota
+type StorageUsage struct {
+	// DID the storage belongs to
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Bytes held in DWN records
+	RecordBytes uint64 `protobuf:"varint,2,opt,name=record_bytes,json=recordBytes,proto3" json:"record_bytes,omitempty"`
+	// Number of DWN records
+	RecordCount uint64 `protobuf:"varint,3,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// Bytes pinned to IPFS
+	IpfsBytes uint64 `protobuf:"varint,4,opt,name=ipfs_bytes,json=ipfsBytes,proto3" json:"ipfs_bytes,omitempty"`
+	// Total bytes used
+	UsedBytes uint64 `protobuf:"varint,5,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// Quota purchased on top of the free tier, in bytes
+	PurchasedBytes uint64 `protobuf:"varint,6,opt,name=purchased_bytes,json=purchasedBytes,proto3" json:"purchased_bytes,omitempty"`
+	// Total quota in bytes, zero when metering is disabled
+	QuotaBytes uint64 `protobuf:"varint,7,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+}
+
+func (m *StorageUsage) Reset()         { *m = StorageUsage{} }
+func (m *StorageUsage) String() string { return proto.CompactTextString(m) }
+func (*StorageUsage) ProtoMessage()    {}
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a31dfed63924cb3, []int{26}
+}
+func (m *StorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsage.Merge(m, src)
+}
+func (m *StorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsage proto.InternalMessageInfo
+
+func (m *StorageUsage) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *StorageUsage) GetRecordBytes() uint64 {
+	if m != nil {
+		return m.RecordBytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetIpfsBytes() uint64 {
+	if m != nil {
+		return m.IpfsBytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetUsedBytes() uint64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetPurchasedBytes() uint64 {
+	if m != nil {
+		return m.PurchasedBytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+// QueryStorageRequest is the request type for the Query/Storage RPC method
+type QueryStorageRequest struct {
+	// DID to query
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *QueryStorageRequest) Reset()         { *m = QueryStorageRequest{} }
+func (m *QueryStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRequest) ProtoMessage()    {}
+func (*QueryStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a31dfed63924cb3, []int{27}
+}
+func (m *QueryStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRequest.Merge(m, src)
+}
+func (m *QueryStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRequest proto.InternalMessageInfo
+
+func (m *QueryStorageRequest) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// QueryStorageResponse is the response type for the Query/Storage RPC method
+type QueryStorageResponse struct {
+	Usage StorageUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryStorageResponse) Reset()         { *m = QueryStorageResponse{} }
+func (m *QueryStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageResponse) ProtoMessage()    {}
+func (*QueryStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a31dfed63924cb3, []int{28}
+}
+func (m *QueryStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageResponse.Merge(m, src)
+}
+func (m *QueryStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageResponse proto.InternalMessageInfo
+
+func (m *QueryStorageResponse) GetUsage() StorageUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return StorageUsage{}
+}
+
+// QueryStorageAccountsRequest is the request type for the
+// Query/StorageAccounts RPC method
+type QueryStorageAccountsRequest struct {
+	// Pagination
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageAccountsRequest) Reset()         { *m = QueryStorageAccountsRequest{} }
+func (m *QueryStorageAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageAccountsRequest) ProtoMessage()    {}
+func (*QueryStorageAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a31dfed63924cb3, []int{29}
+}
+func (m *QueryStorageAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageAccountsRequest.Merge(m, src)
+}
+func (m *QueryStorageAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryStorageAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStorageAccountsResponse is the response type for the
+// Query/StorageAccounts RPC method
+type QueryStorageAccountsResponse struct {
+	// Usage of each DID that stores records or has purchased quota
+	Usage []StorageUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage"`
+	// Pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageAccountsResponse) Reset()         { *m = QueryStorageAccountsResponse{} }
+func (m *QueryStorageAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageAccountsResponse) ProtoMessage()    {}
+func (*QueryStorageAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a31dfed63924cb3, []int{30}
+}
+func (m *QueryStorageAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageAccountsResponse.Merge(m, src)
+}
+func (m *QueryStorageAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryStorageAccountsResponse) GetUsage() []StorageUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *QueryStorageAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dwn.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dwn.v1.QueryParamsResponse")
@@ -1532,114 +1835,134 @@ func init() {
 	proto.RegisterType((*QueryEncryptionStatusResponse)(nil), "dwn.v1.QueryEncryptionStatusResponse")
 	proto.RegisterType((*QueryVRFContributionsRequest)(nil), "dwn.v1.QueryVRFContributionsRequest")
 	proto.RegisterType((*QueryVRFContributionsResponse)(nil), "dwn.v1.QueryVRFContributionsResponse")
+	proto.RegisterType((*StorageUsage)(nil), "dwn.v1.StorageUsage")
+	proto.RegisterType((*QueryStorageRequest)(nil), "dwn.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "dwn.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryStorageAccountsRequest)(nil), "dwn.v1.QueryStorageAccountsRequest")
+	proto.RegisterType((*QueryStorageAccountsResponse)(nil), "dwn.v1.QueryStorageAccountsResponse")
 }
 
 func init() { proto.RegisterFile("dwn/v1/query.proto", fileDescriptor_9a31dfed63924cb3) }
 
 var fileDescriptor_9a31dfed63924cb3 = []byte{
-	// 1620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x37, 0x25, 0x5b, 0xb6, 0x46, 0x7e, 0xc8, 0x6b, 0x27, 0x96, 0x69, 0x47, 0x71, 0xe8, 0x3c,
-	0x9c, 0xe4, 0x1f, 0x31, 0xf6, 0x1f, 0x48, 0x4f, 0x49, 0xdb, 0xd8, 0x79, 0x18, 0x41, 0xd2, 0x84,
-	0x69, 0x53, 0xb4, 0x17, 0x82, 0x16, 0xd7, 0x32, 0x11, 0x89, 0xab, 0x90, 0x2b, 0x39, 0xae, 0xe1,
-	0x4b, 0xee, 0x05, 0x82, 0x3e, 0x2e, 0x3d, 0x14, 0x45, 0x3f, 0x42, 0x0f, 0xbd, 0xf4, 0x0b, 0xe4,
-	0x18, 0xa0, 0x97, 0x9e, 0x8a, 0x22, 0xe9, 0x35, 0xdf, 0xa1, 0xe0, 0xee, 0x2c, 0x45, 0xea, 0x11,
-	0xa3, 0xae, 0x4f, 0xd6, 0xce, 0xfc, 0x76, 0x7e, 0x33, 0x3b, 0x33, 0xbb, 0x43, 0x03, 0x71, 0x77,
-	0x7d, 0xb3, 0xbd, 0x6a, 0x3e, 0x6b, 0xd1, 0x60, 0xaf, 0xd2, 0x0c, 0x18, 0x67, 0x24, 0xe7, 0xee,
-	0xfa, 0x95, 0xf6, 0xaa, 0x7e, 0xa9, 0xca, 0xc2, 0x06, 0x0b, 0xcd, 0x2d, 0x27, 0xa4, 0x12, 0x60,
-	0xb6, 0x57, 0xb7, 0x28, 0x77, 0x56, 0xcd, 0xa6, 0x53, 0xf3, 0x7c, 0x87, 0x7b, 0xcc, 0x97, 0x7b,
-	0xf4, 0x59, 0xb4, 0x53, 0xa3, 0x3e, 0x0d, 0xbd, 0x10, 0xa5, 0xca, 0x7a, 0xc8, 0x1d, 0x4e, 0x15,
-	0xb2, 0xc6, 0x6a, 0x4c, 0xfc, 0x34, 0xa3, 0x5f, 0x28, 0x5d, 0xac, 0x31, 0x56, 0xab, 0x53, 0xd3,
-	0x69, 0x7a, 0xa6, 0xe3, 0xfb, 0x8c, 0x0b, 0xe3, 0x68, 0xc7, 0x98, 0x05, 0xf2, 0x28, 0xe2, 0x7f,
-	0xe8, 0x04, 0x4e, 0x23, 0xb4, 0xe8, 0xb3, 0x16, 0x0d, 0xb9, 0x71, 0x1d, 0x66, 0x52, 0xd2, 0xb0,
-	0xc9, 0xfc, 0x90, 0x92, 0xf3, 0x90, 0x6b, 0x0a, 0x49, 0x49, 0x5b, 0xd2, 0x56, 0x0a, 0x6b, 0x93,
-	0x15, 0x19, 0x4f, 0x05, 0x71, 0xa8, 0x35, 0x08, 0x14, 0xc5, 0xf6, 0xcd, 0x87, 0xb7, 0x1f, 0x2b,
-	0x93, 0x1f, 0xc2, 0x74, 0x42, 0x86, 0x06, 0x2f, 0x41, 0x2e, 0x0a, 0xa0, 0xa5, 0x0c, 0x12, 0x65,
-	0x30, 0x42, 0x3d, 0x16, 0x1a, 0x0b, 0x11, 0xc6, 0x32, 0x4c, 0x09, 0x03, 0xeb, 0x9b, 0x1b, 0x68,
-	0x93, 0x14, 0x21, 0x5b, 0xf5, 0x5c, 0xb1, 0x37, 0x6f, 0x45, 0x3f, 0x8d, 0x3b, 0xc8, 0x2c, 0x40,
-	0x48, 0x72, 0x1a, 0x0a, 0xd2, 0x84, 0x5d, 0x65, 0x2e, 0x15, 0xe8, 0x11, 0x0b, 0xa4, 0x68, 0x9d,
-	0xb9, 0x94, 0x10, 0x18, 0x76, 0x1d, 0xee, 0x94, 0x32, 0x4b, 0xda, 0xca, 0xb8, 0x25, 0x7e, 0x1b,
-	0xef, 0x34, 0x3c, 0x02, 0x8b, 0x56, 0x59, 0xe0, 0xaa, 0x93, 0x21, 0x27, 0x21, 0xc7, 0x9d, 0xa0,
-	0x46, 0x39, 0xb2, 0xe2, 0x8a, 0xe8, 0x30, 0x26, 0x0e, 0xb4, 0xca, 0xea, 0xc2, 0x4e, 0xde, 0x8a,
-	0xd7, 0xd1, 0x9e, 0xb0, 0xba, 0x43, 0x1b, 0x4e, 0x29, 0x2b, 0xf7, 0xc8, 0x15, 0x59, 0x80, 0x7c,
-	0xd3, 0x09, 0xa8, 0xcf, 0x6d, 0xcf, 0x2d, 0x0d, 0xe3, 0x26, 0x21, 0xd8, 0x74, 0xc9, 0x39, 0x98,
-	0x6c, 0xb6, 0xb6, 0xea, 0x5e, 0xb8, 0x43, 0x5d, 0x9b, 0xf9, 0xf5, 0xbd, 0xd2, 0xc8, 0x92, 0xb6,
-	0x32, 0x66, 0x4d, 0xc4, 0xd2, 0x4f, 0xfc, 0xfa, 0x1e, 0xb9, 0x0d, 0xd0, 0xa9, 0x98, 0x52, 0x4e,
-	0x9c, 0xe2, 0xf9, 0x8a, 0x2c, 0xaf, 0x4a, 0x54, 0x5e, 0x15, 0x59, 0x7f, 0x58, 0x5e, 0x95, 0x87,
-	0x4e, 0x8d, 0x62, 0x2c, 0x56, 0x62, 0xa7, 0xf1, 0x8d, 0x06, 0xb3, 0xe9, 0x78, 0xf1, 0xf4, 0x56,
-	0x61, 0x34, 0x90, 0xa2, 0x92, 0xb6, 0x94, 0x5d, 0x29, 0xac, 0x4d, 0xab, 0x1c, 0x6d, 0x7c, 0xfe,
-	0x40, 0x82, 0x6f, 0x0e, 0xbf, 0xfa, 0xf3, 0xf4, 0x90, 0xa5, 0x70, 0xe4, 0x4e, 0xca, 0xa7, 0x8c,
-	0xf0, 0xe9, 0xc2, 0xa1, 0x3e, 0x49, 0xbe, 0x94, 0x53, 0x9b, 0x58, 0x9c, 0x92, 0xe6, 0xb0, 0x14,
-	0x2c, 0x40, 0x5e, 0x7a, 0x10, 0x1d, 0x27, 0xe6, 0x40, 0x0a, 0x36, 0x5d, 0xe3, 0xa3, 0x54, 0x3a,
-	0xe3, 0xe8, 0x2e, 0x42, 0x4e, 0x42, 0xb0, 0x00, 0x7b, 0x83, 0xb3, 0x10, 0x60, 0xfc, 0xa8, 0xc1,
-	0x09, 0xd9, 0x14, 0x98, 0xd7, 0x43, 0x6b, 0xa2, 0x37, 0x85, 0x99, 0xc3, 0x53, 0x98, 0x3d, 0x72,
-	0x0a, 0x7f, 0xd0, 0xe0, 0x64, 0xb7, 0x83, 0x18, 0xe6, 0x07, 0x90, 0x57, 0xd5, 0xa8, 0xd2, 0x38,
-	0x93, 0x88, 0x54, 0x6d, 0xc0, 0x44, 0x76, 0xb0, 0xc7, 0x97, 0xca, 0x47, 0x58, 0x5e, 0x8a, 0xea,
-	0xb0, 0xb3, 0x3b, 0x03, 0xe3, 0xca, 0x0b, 0xbb, 0x15, 0x78, 0x98, 0xcf, 0x82, 0x92, 0x7d, 0x16,
-	0x78, 0xc6, 0xdd, 0xae, 0x7c, 0xc4, 0xd1, 0x9a, 0x89, 0x5e, 0x94, 0x69, 0xed, 0x17, 0x6c, 0xa7,
-	0x41, 0x8d, 0xef, 0x33, 0x30, 0x27, 0x4d, 0xd1, 0xa0, 0xe1, 0x85, 0x61, 0x74, 0x3f, 0x1e, 0xe6,
-	0x60, 0x09, 0x46, 0x6b, 0x81, 0xe3, 0x73, 0x16, 0xa0, 0x6f, 0x6a, 0x19, 0x6b, 0x28, 0xc5, 0x7e,
-	0x57, 0xcb, 0xa8, 0x20, 0x3c, 0x9f, 0xd3, 0x60, 0xdb, 0xa9, 0x52, 0xdb, 0x77, 0x1a, 0x14, 0xbb,
-	0x7e, 0x22, 0x96, 0x3e, 0x70, 0x1a, 0x34, 0xa2, 0x6c, 0x50, 0xbe, 0xc3, 0x5c, 0xd1, 0xf2, 0x79,
-	0x0b, 0x57, 0xe4, 0x02, 0x4c, 0x79, 0x7e, 0xb5, 0xde, 0x72, 0xa9, 0x1d, 0xd0, 0x36, 0x7b, 0x4a,
-	0x5d, 0xd1, 0xf0, 0x63, 0xd6, 0x24, 0x8a, 0x2d, 0x29, 0xed, 0xaa, 0xa8, 0xd1, 0x23, 0x57, 0xd4,
-	0xcf, 0x1a, 0x94, 0x7a, 0xcf, 0x05, 0x4f, 0xf9, 0x3a, 0x14, 0x9a, 0x1d, 0x31, 0x56, 0xd5, 0x89,
-	0xe4, 0x41, 0xc7, 0x5a, 0xac, 0xab, 0x24, 0xfe, 0xf8, 0x2a, 0xab, 0x82, 0x0f, 0xcb, 0x13, 0xa7,
-	0x55, 0xe7, 0x2a, 0x6b, 0xf3, 0x30, 0xd6, 0x8e, 0xd6, 0x76, 0xfc, 0x3c, 0x8c, 0x8a, 0xf5, 0xa6,
-	0x6b, 0xdc, 0xc0, 0x4b, 0x05, 0xf1, 0x18, 0xcd, 0x0a, 0x8c, 0x08, 0x40, 0xf7, 0x43, 0x24, 0x50,
-	0xd1, 0x4b, 0x44, 0x2d, 0x09, 0x30, 0x82, 0xe4, 0xfe, 0xb8, 0x4c, 0x66, 0x61, 0x84, 0xed, 0xfa,
-	0x34, 0x40, 0x36, 0xb9, 0xe8, 0x4a, 0x44, 0xe6, 0xc8, 0x89, 0x78, 0xa9, 0x5e, 0x23, 0x45, 0x8a,
-	0x5e, 0x5f, 0x85, 0x9c, 0x70, 0x4a, 0x1d, 0x7f, 0x1f, 0xb7, 0xf1, 0xec, 0x11, 0x77, 0x7c, 0xc7,
-	0x7e, 0x00, 0x0b, 0xc2, 0xa3, 0x5b, 0x7e, 0x35, 0xd8, 0x6b, 0x72, 0xea, 0xfe, 0xf7, 0x4b, 0x9a,
-	0x5c, 0x84, 0x62, 0x40, 0x79, 0x2b, 0xf0, 0x6d, 0xaa, 0xac, 0x8a, 0x16, 0x1a, 0xb3, 0xa6, 0xa4,
-	0x3c, 0x26, 0x33, 0x7e, 0xd3, 0x60, 0xb1, 0x3f, 0xff, 0xbf, 0xbe, 0xd9, 0xc9, 0x3d, 0x98, 0x41,
-	0x3e, 0x8f, 0xf9, 0x76, 0x83, 0x72, 0x27, 0x1e, 0x07, 0x0a, 0x6b, 0xba, 0xda, 0x77, 0x2b, 0x86,
-	0xdc, 0x47, 0x84, 0x45, 0x68, 0x8f, 0x8c, 0x2c, 0xc3, 0xc4, 0xae, 0x13, 0xda, 0x2e, 0x4d, 0x07,
-	0x30, 0xbe, 0xeb, 0x84, 0x1b, 0x4a, 0x66, 0x94, 0xd3, 0xce, 0x7b, 0xcc, 0xc7, 0x61, 0x07, 0x87,
-	0xa5, 0x9f, 0x32, 0x70, 0x6a, 0x00, 0x00, 0xc3, 0xab, 0xc0, 0x4c, 0xb5, 0x15, 0x88, 0xe1, 0xe1,
-	0x29, 0xdd, 0xb3, 0xdb, 0x34, 0x88, 0xda, 0x4a, 0xc4, 0x3a, 0x6c, 0x4d, 0xa3, 0xea, 0x1e, 0xdd,
-	0x7b, 0x22, 0x15, 0x91, 0x5b, 0x6d, 0xa7, 0xee, 0xb9, 0x0e, 0x67, 0x81, 0x1d, 0x52, 0x5e, 0xca,
-	0x2c, 0x65, 0x57, 0xf2, 0xd6, 0x78, 0x2c, 0x7c, 0x4c, 0x39, 0x59, 0x81, 0x62, 0xe8, 0xf9, 0xb5,
-	0x3a, 0xb5, 0x7d, 0xe6, 0x52, 0xbb, 0x11, 0x8d, 0x4b, 0xd2, 0xfd, 0x49, 0x29, 0x7f, 0xc0, 0x5c,
-	0x7a, 0x3f, 0x1a, 0x99, 0x96, 0x61, 0xa2, 0xee, 0x84, 0xdc, 0x0e, 0x70, 0x9c, 0x14, 0x17, 0x59,
-	0xd6, 0x1a, 0x8f, 0x84, 0x16, 0xca, 0x22, 0x90, 0x4f, 0x9f, 0x27, 0x40, 0x23, 0x12, 0x14, 0x09,
-	0x63, 0xd0, 0x35, 0x98, 0xe3, 0x8c, 0x3b, 0xf5, 0x4e, 0xca, 0x6d, 0x35, 0x6f, 0xe4, 0x44, 0x30,
-	0x27, 0x84, 0xba, 0x2b, 0xcd, 0xa1, 0xf1, 0xab, 0x2a, 0x80, 0x27, 0xd6, 0xed, 0x75, 0xe6, 0xf3,
-	0xc0, 0xdb, 0x6a, 0xf1, 0xe4, 0xc5, 0x7d, 0x19, 0xa6, 0x3b, 0x11, 0x3b, 0xae, 0x1b, 0xd0, 0x30,
-	0xc4, 0x62, 0x2c, 0xc6, 0x8a, 0x8f, 0xa5, 0x3c, 0x7a, 0x6e, 0xb6, 0xea, 0xac, 0xfa, 0xd4, 0xde,
-	0xa1, 0x5e, 0x6d, 0x87, 0x8b, 0xdc, 0x67, 0xad, 0x82, 0x90, 0xdd, 0x15, 0xa2, 0x63, 0x7b, 0xa6,
-	0xdf, 0x69, 0x98, 0xdb, 0x5e, 0xc7, 0x31, 0xb7, 0xeb, 0x30, 0x51, 0x4d, 0x2a, 0xb0, 0xb9, 0xe7,
-	0xe2, 0xe6, 0x4e, 0x6f, 0xc4, 0x0e, 0x4f, 0xef, 0x21, 0x37, 0x60, 0x42, 0x15, 0x48, 0xc0, 0x5a,
-	0xbe, 0x8b, 0xe5, 0x3c, 0x9f, 0x36, 0x12, 0x52, 0x3f, 0x6c, 0x85, 0x56, 0x04, 0xb0, 0xc6, 0x11,
-	0x2f, 0x56, 0x5d, 0x17, 0x45, 0xf6, 0xc8, 0x17, 0xc5, 0xda, 0x2f, 0x05, 0x18, 0x11, 0xf1, 0x92,
-	0x2f, 0x20, 0x27, 0x3f, 0x14, 0x48, 0xdc, 0x54, 0xbd, 0xdf, 0x1e, 0xfa, 0x42, 0x5f, 0x9d, 0x34,
-	0x6c, 0x9c, 0x7c, 0xf1, 0xfb, 0xdf, 0xdf, 0x66, 0x8a, 0x64, 0xd2, 0xc4, 0xef, 0x1f, 0xf9, 0xc5,
-	0x41, 0x1e, 0xc1, 0x70, 0xf4, 0xc9, 0x40, 0x4a, 0xa9, 0xcd, 0x89, 0xef, 0x0f, 0x7d, 0xbe, 0x8f,
-	0x06, 0x8d, 0xce, 0x0a, 0xa3, 0x93, 0x64, 0x5c, 0x19, 0xf5, 0x9a, 0xdb, 0x21, 0xf9, 0x14, 0xb2,
-	0xeb, 0x9b, 0x1b, 0x64, 0x2e, 0xb5, 0xaf, 0xf3, 0xf1, 0xa1, 0x97, 0x7a, 0x15, 0x68, 0x4f, 0x17,
-	0xf6, 0x66, 0x09, 0x49, 0xda, 0x33, 0xf7, 0xab, 0x9e, 0x7b, 0x40, 0xb6, 0x61, 0x14, 0x2b, 0x98,
-	0xa4, 0x03, 0x4d, 0x7f, 0x67, 0xe8, 0x8b, 0xfd, 0x95, 0xc8, 0xb0, 0x24, 0x18, 0x74, 0x52, 0x52,
-	0x0c, 0xd8, 0x32, 0xe6, 0xbe, 0xbc, 0x66, 0x0f, 0x08, 0x83, 0x9c, 0xdc, 0xd4, 0x75, 0xd6, 0xa9,
-	0x5b, 0x5a, 0x5f, 0xe8, 0xab, 0x43, 0x92, 0xff, 0x09, 0x92, 0xf3, 0xe4, 0xec, 0x20, 0x12, 0x73,
-	0x3f, 0xbe, 0xca, 0x0f, 0x88, 0x0f, 0xf9, 0x78, 0xee, 0x24, 0xa7, 0xd2, 0x39, 0xec, 0x1a, 0x98,
-	0xf5, 0xf2, 0x20, 0x35, 0x32, 0x1b, 0x82, 0x79, 0x91, 0xe8, 0x71, 0x96, 0x15, 0xa4, 0x13, 0xe0,
-	0x57, 0x30, 0xa6, 0x36, 0x92, 0xc5, 0xbe, 0xf6, 0x14, 0xdb, 0xa9, 0x01, 0x5a, 0x24, 0x5b, 0x15,
-	0x64, 0x97, 0xc9, 0xc5, 0xc1, 0x64, 0xe6, 0x7e, 0x72, 0x16, 0x3d, 0x20, 0x6d, 0x28, 0x24, 0x26,
-	0x22, 0x72, 0x3a, 0x4d, 0xd0, 0x33, 0x43, 0xea, 0x4b, 0x83, 0x01, 0xe8, 0xc4, 0x59, 0xe1, 0x44,
-	0x99, 0x2c, 0xc6, 0x4e, 0x74, 0x40, 0x9d, 0x98, 0x1d, 0x18, 0x11, 0x0f, 0x3b, 0x49, 0x17, 0x73,
-	0x72, 0xf2, 0xd1, 0xf5, 0x7e, 0x2a, 0x64, 0x39, 0x23, 0x58, 0x16, 0xc8, 0xbc, 0x62, 0x91, 0x43,
-	0x81, 0xb9, 0xaf, 0x66, 0xa5, 0x83, 0xa8, 0x47, 0xe5, 0x8c, 0x41, 0xfa, 0x18, 0x1a, 0xd0, 0xa3,
-	0xe9, 0xa1, 0xa4, 0xb7, 0x47, 0x71, 0xf4, 0xf8, 0x4e, 0x83, 0xa9, 0xae, 0x6b, 0x9c, 0x2c, 0xa7,
-	0x0c, 0xf5, 0x9f, 0x25, 0xf4, 0xb3, 0xef, 0x07, 0x21, 0xed, 0x35, 0x41, 0x7b, 0x95, 0x54, 0x14,
-	0x6d, 0xfc, 0xa0, 0x5c, 0x79, 0x6f, 0xe1, 0xbe, 0xd0, 0xa0, 0xd8, 0xfd, 0xcc, 0x92, 0xbe, 0x94,
-	0xdd, 0xcf, 0xb4, 0x7e, 0xee, 0x10, 0xd4, 0xa0, 0x63, 0xef, 0x8c, 0x0d, 0xa6, 0xfc, 0x37, 0x04,
-	0xf9, 0x5a, 0x83, 0x62, 0xf7, 0x7b, 0xd0, 0xe5, 0xc4, 0x80, 0x77, 0xae, 0xcb, 0x89, 0x41, 0x8f,
-	0x4a, 0x6f, 0x37, 0x27, 0x9c, 0x68, 0x07, 0xdb, 0x57, 0x52, 0xaf, 0xc7, 0xcd, 0xeb, 0xaf, 0xde,
-	0x94, 0xb5, 0xd7, 0x6f, 0xca, 0xda, 0x5f, 0x6f, 0xca, 0xda, 0xcb, 0xb7, 0xe5, 0xa1, 0xd7, 0x6f,
-	0xcb, 0x43, 0x7f, 0xbc, 0x2d, 0x0f, 0x7d, 0xb9, 0x5c, 0xf3, 0xf8, 0x4e, 0x6b, 0xab, 0x52, 0x65,
-	0x0d, 0x33, 0x64, 0x7e, 0x70, 0xc5, 0x63, 0xe2, 0xaf, 0xf9, 0x5c, 0x18, 0xe6, 0x7b, 0x4d, 0x1a,
-	0x6e, 0xe5, 0x44, 0xbf, 0xfc, 0xff, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xab, 0xd6, 0x26,
-	0x04, 0x13, 0x00, 0x00,
+	// 1860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x2d, 0x4b, 0xb6, 0x9e, 0xfc, 0x21, 0x8f, 0x9d, 0x58, 0xa1, 0x6d, 0xc5, 0x4b, 0xe7,
+	0xc3, 0xd9, 0x6d, 0xc4, 0x75, 0x0a, 0x6c, 0x4f, 0xd9, 0x76, 0xe3, 0x7c, 0x19, 0x8b, 0x4d, 0x13,
+	0xa6, 0x9b, 0xa2, 0xbd, 0x08, 0x34, 0x39, 0x96, 0x89, 0x48, 0x1c, 0x85, 0x1c, 0xca, 0x71, 0x0d,
+	0x5f, 0xf6, 0xde, 0x62, 0xd1, 0x2f, 0xa0, 0x3d, 0x14, 0x45, 0xff, 0x88, 0x5e, 0xfa, 0x0f, 0xec,
+	0x71, 0x81, 0x5e, 0x7a, 0x2a, 0x8a, 0xa4, 0xd7, 0xf6, 0x6f, 0x28, 0x66, 0xe6, 0x0d, 0x45, 0x52,
+	0x52, 0xdc, 0xba, 0x3e, 0x59, 0x7c, 0xef, 0x37, 0xef, 0xf7, 0xbe, 0x66, 0xe6, 0x8d, 0x81, 0xf8,
+	0x47, 0xa1, 0x3d, 0xd8, 0xb1, 0x5f, 0x27, 0x34, 0x3a, 0x6e, 0xf5, 0x23, 0xc6, 0x19, 0xa9, 0xf8,
+	0x47, 0x61, 0x6b, 0xb0, 0x63, 0x7e, 0xe8, 0xb1, 0xb8, 0xc7, 0x62, 0x7b, 0xdf, 0x8d, 0xa9, 0x02,
+	0xd8, 0x83, 0x9d, 0x7d, 0xca, 0xdd, 0x1d, 0xbb, 0xef, 0x76, 0x82, 0xd0, 0xe5, 0x01, 0x0b, 0xd5,
+	0x1a, 0x73, 0x05, 0xed, 0x74, 0x68, 0x48, 0xe3, 0x20, 0x46, 0xa9, 0xb6, 0x1e, 0x73, 0x97, 0x53,
+	0x8d, 0xec, 0xb0, 0x0e, 0x93, 0x3f, 0x6d, 0xf1, 0x0b, 0xa5, 0xeb, 0x1d, 0xc6, 0x3a, 0x5d, 0x6a,
+	0xbb, 0xfd, 0xc0, 0x76, 0xc3, 0x90, 0x71, 0x69, 0x1c, 0xed, 0x58, 0x2b, 0x40, 0x9e, 0x0b, 0xfe,
+	0x67, 0x6e, 0xe4, 0xf6, 0x62, 0x87, 0xbe, 0x4e, 0x68, 0xcc, 0xad, 0x7b, 0xb0, 0x9c, 0x93, 0xc6,
+	0x7d, 0x16, 0xc6, 0x94, 0xdc, 0x84, 0x4a, 0x5f, 0x4a, 0x1a, 0xc6, 0xa6, 0xb1, 0x5d, 0xbb, 0xbb,
+	0xd0, 0x52, 0xf1, 0xb4, 0x10, 0x87, 0x5a, 0x8b, 0x40, 0x5d, 0x2e, 0xdf, 0x7b, 0xf6, 0xe8, 0x85,
+	0x36, 0xf9, 0x7d, 0x58, 0xca, 0xc8, 0xd0, 0xe0, 0x87, 0x50, 0x11, 0x01, 0x24, 0xda, 0x20, 0xd1,
+	0x06, 0x05, 0xea, 0x85, 0xd4, 0x38, 0x88, 0xb0, 0xb6, 0x60, 0x51, 0x1a, 0xd8, 0xdd, 0x7b, 0x80,
+	0x36, 0x49, 0x1d, 0x4a, 0x5e, 0xe0, 0xcb, 0xb5, 0x55, 0x47, 0xfc, 0xb4, 0x1e, 0x43, 0x7d, 0x08,
+	0x42, 0x92, 0x6b, 0x50, 0x53, 0x26, 0xda, 0x1e, 0xf3, 0xa9, 0x44, 0x97, 0x1d, 0x50, 0xa2, 0x5d,
+	0xe6, 0x53, 0x42, 0x60, 0xda, 0x77, 0xb9, 0xdb, 0x98, 0xda, 0x34, 0xb6, 0xe7, 0x1c, 0xf9, 0xdb,
+	0xfa, 0xed, 0x14, 0xa6, 0xc0, 0xa1, 0x1e, 0x8b, 0x7c, 0x9d, 0x19, 0x72, 0x05, 0x2a, 0xdc, 0x8d,
+	0x3a, 0x94, 0x23, 0x2b, 0x7e, 0x11, 0x13, 0x66, 0x65, 0x42, 0x3d, 0xd6, 0x95, 0x76, 0xaa, 0x4e,
+	0xfa, 0x2d, 0xd6, 0xc4, 0xde, 0x21, 0xed, 0xb9, 0x8d, 0x92, 0x5a, 0xa3, 0xbe, 0xc8, 0x1a, 0x54,
+	0xfb, 0x6e, 0x44, 0x43, 0xde, 0x0e, 0xfc, 0xc6, 0x34, 0x2e, 0x92, 0x82, 0x3d, 0x9f, 0xdc, 0x80,
+	0x85, 0x7e, 0xb2, 0xdf, 0x0d, 0xe2, 0x43, 0xea, 0xb7, 0x59, 0xd8, 0x3d, 0x6e, 0x94, 0x37, 0x8d,
+	0xed, 0x59, 0x67, 0x3e, 0x95, 0xfe, 0x30, 0xec, 0x1e, 0x93, 0x47, 0x00, 0xc3, 0x8e, 0x69, 0x54,
+	0x64, 0x16, 0x6f, 0xb6, 0x54, 0x7b, 0xb5, 0x44, 0x7b, 0xb5, 0x54, 0xff, 0x61, 0x7b, 0xb5, 0x9e,
+	0xb9, 0x1d, 0x8a, 0xb1, 0x38, 0x99, 0x95, 0xe4, 0x16, 0x2c, 0x06, 0xa1, 0xd7, 0x4d, 0x7c, 0xda,
+	0xa6, 0x6f, 0xfa, 0x41, 0x44, 0xfd, 0xc6, 0x8c, 0xe4, 0x5b, 0x40, 0xf1, 0x43, 0x25, 0xb5, 0x7e,
+	0x69, 0xc0, 0x4a, 0x3e, 0x31, 0x98, 0xe6, 0x1d, 0x98, 0x89, 0x94, 0xa8, 0x61, 0x6c, 0x96, 0xb6,
+	0x6b, 0x77, 0x97, 0x74, 0x31, 0x1f, 0xfc, 0xf8, 0xa9, 0x02, 0xdf, 0x9f, 0xfe, 0xe6, 0xef, 0xd7,
+	0x2e, 0x39, 0x1a, 0x47, 0x1e, 0xe7, 0x9c, 0x9f, 0x92, 0xce, 0xdf, 0x3a, 0xd3, 0x79, 0xc5, 0x97,
+	0xf5, 0xde, 0xda, 0xc3, 0x2e, 0x56, 0x34, 0x67, 0xd5, 0x6a, 0x0d, 0xaa, 0xca, 0x03, 0x91, 0x77,
+	0x2c, 0x96, 0x12, 0xec, 0xf9, 0xd6, 0x0f, 0x72, 0x75, 0x4f, 0xa3, 0xbb, 0x0d, 0x15, 0x05, 0xc1,
+	0x4e, 0x1d, 0x0d, 0xce, 0x41, 0x80, 0xf5, 0x07, 0x03, 0x2e, 0xab, 0xdd, 0x83, 0x0d, 0x70, 0x66,
+	0xf3, 0x8c, 0xd6, 0x7a, 0xea, 0xec, 0x5a, 0x97, 0xce, 0x5b, 0x6b, 0xeb, 0xf7, 0x06, 0x5c, 0x29,
+	0x3a, 0x88, 0x61, 0x7e, 0x0f, 0xaa, 0xba, 0x6d, 0x75, 0x19, 0x97, 0x33, 0x91, 0xea, 0x05, 0x58,
+	0xc8, 0x21, 0xf6, 0xe2, 0x4a, 0xf9, 0x1c, 0xdb, 0x4b, 0x53, 0x9d, 0x95, 0xbb, 0x0f, 0x60, 0x4e,
+	0x7b, 0xd1, 0x4e, 0xa2, 0x00, 0xeb, 0x59, 0xd3, 0xb2, 0x2f, 0xa3, 0xc0, 0x7a, 0x52, 0xa8, 0x47,
+	0x1a, 0xad, 0x9d, 0xd9, 0xb4, 0xaa, 0xac, 0xe3, 0x82, 0x1d, 0xee, 0x64, 0xeb, 0x37, 0x53, 0xb0,
+	0xaa, 0x4c, 0xd1, 0xa8, 0x17, 0xc4, 0xb1, 0x38, 0x48, 0xcf, 0x72, 0xb0, 0x01, 0x33, 0x9d, 0xc8,
+	0x0d, 0x39, 0x8b, 0xd0, 0x37, 0xfd, 0x99, 0x6a, 0x28, 0xc5, 0x83, 0x41, 0x7f, 0x8a, 0x86, 0x08,
+	0x42, 0x4e, 0xa3, 0x03, 0xd7, 0xa3, 0xed, 0xd0, 0xed, 0x51, 0x3c, 0x1e, 0xe6, 0x53, 0xe9, 0x53,
+	0xb7, 0x47, 0x05, 0x65, 0x8f, 0xf2, 0x43, 0xe6, 0xcb, 0xb3, 0xa1, 0xea, 0xe0, 0x57, 0x76, 0x33,
+	0x47, 0x74, 0xc0, 0x5e, 0x51, 0xbf, 0x51, 0xc9, 0x6d, 0x66, 0x47, 0x49, 0x0b, 0x1d, 0x35, 0x73,
+	0xee, 0x8e, 0xfa, 0x93, 0x01, 0x8d, 0xd1, 0xbc, 0x60, 0x96, 0xef, 0x41, 0xad, 0x3f, 0x14, 0x63,
+	0x57, 0x5d, 0xce, 0x26, 0x3a, 0xd5, 0x62, 0x5f, 0x65, 0xf1, 0x17, 0xd7, 0x59, 0x2d, 0xbc, 0x81,
+	0x5e, 0xba, 0x49, 0x97, 0xeb, 0xaa, 0x5d, 0x85, 0xd9, 0x81, 0xf8, 0x6e, 0xa7, 0xf7, 0xc8, 0x8c,
+	0xfc, 0xde, 0xf3, 0xad, 0x4f, 0x81, 0x64, 0xf1, 0x18, 0xcd, 0x36, 0x94, 0x25, 0xa0, 0x78, 0x63,
+	0x49, 0x94, 0xb8, 0xb2, 0xa8, 0xa3, 0x00, 0x56, 0x94, 0x5d, 0x9f, 0xb6, 0xc9, 0x0a, 0x94, 0xd9,
+	0x51, 0x48, 0x23, 0x64, 0x53, 0x1f, 0xe4, 0xd1, 0x98, 0x20, 0xcf, 0x53, 0x88, 0xaf, 0x0d, 0x58,
+	0xce, 0x91, 0xa2, 0xd7, 0x1f, 0x43, 0x45, 0x3a, 0xa5, 0xd3, 0x3f, 0xc6, 0x6d, 0xcc, 0x3d, 0xe2,
+	0x2e, 0x2e, 0xed, 0xa7, 0xb0, 0x26, 0x3d, 0x7a, 0x18, 0x7a, 0xd1, 0x71, 0x9f, 0x53, 0xff, 0xff,
+	0x3f, 0xa4, 0xc9, 0x6d, 0xa8, 0x47, 0x94, 0x27, 0x51, 0xd8, 0xa6, 0xda, 0xaa, 0xdc, 0x42, 0xb3,
+	0xce, 0xa2, 0x92, 0xa7, 0x64, 0xd6, 0x5f, 0x0c, 0x58, 0x1f, 0xcf, 0xff, 0x3f, 0x9f, 0xec, 0xe4,
+	0x73, 0x58, 0x46, 0xbe, 0x80, 0x85, 0xed, 0x1e, 0xe5, 0x6e, 0x3a, 0x37, 0xd4, 0xee, 0x9a, 0x7a,
+	0xdd, 0xc3, 0x14, 0xf2, 0x05, 0x22, 0x1c, 0x42, 0x47, 0x64, 0x64, 0x0b, 0xe6, 0x8f, 0xdc, 0xb8,
+	0xed, 0xd3, 0x7c, 0x00, 0x73, 0x47, 0x6e, 0xfc, 0x40, 0xcb, 0xac, 0x66, 0xde, 0xf9, 0x80, 0x85,
+	0x38, 0x15, 0xe1, 0x54, 0xf5, 0xc7, 0x29, 0xd8, 0x98, 0x00, 0xc0, 0xf0, 0x5a, 0xb0, 0xec, 0x25,
+	0x91, 0x9c, 0x32, 0x5e, 0xd1, 0xe3, 0xf6, 0x80, 0x46, 0x62, 0x5b, 0xc9, 0x58, 0xa7, 0x9d, 0x25,
+	0x54, 0x7d, 0x4e, 0x8f, 0x5f, 0x2a, 0x85, 0x70, 0x6b, 0xe0, 0x76, 0x03, 0xdf, 0xe5, 0x2c, 0x6a,
+	0xc7, 0x94, 0x37, 0xa6, 0x36, 0x4b, 0xdb, 0x55, 0x67, 0x2e, 0x15, 0xbe, 0xa0, 0x9c, 0x6c, 0x43,
+	0x3d, 0x0e, 0xc2, 0x4e, 0x97, 0xb6, 0x43, 0xe6, 0xd3, 0x76, 0x4f, 0xcc, 0x55, 0xca, 0xfd, 0x05,
+	0x25, 0x7f, 0xca, 0x7c, 0xfa, 0x85, 0x98, 0xad, 0xb6, 0x60, 0xbe, 0xeb, 0xc6, 0xbc, 0x1d, 0xe1,
+	0xdc, 0x29, 0x0f, 0xb2, 0x92, 0x33, 0x27, 0x84, 0x0e, 0xca, 0x04, 0x28, 0xa4, 0x6f, 0x32, 0xa0,
+	0xb2, 0x02, 0x09, 0x61, 0x0a, 0xfa, 0x04, 0x56, 0x39, 0xe3, 0x6e, 0x77, 0x58, 0xf2, 0xb6, 0x9e,
+	0x37, 0x2a, 0x32, 0x98, 0xcb, 0x52, 0x5d, 0x28, 0x73, 0x6c, 0xfd, 0x59, 0x37, 0xc0, 0x4b, 0xe7,
+	0xd1, 0x2e, 0x0b, 0x79, 0x14, 0xec, 0x27, 0x3c, 0x7b, 0x70, 0x7f, 0x04, 0x4b, 0xc3, 0x88, 0x5d,
+	0xdf, 0x8f, 0x68, 0x1c, 0x63, 0x33, 0xd6, 0x53, 0xc5, 0x67, 0x4a, 0x2e, 0xae, 0x9b, 0xfd, 0x2e,
+	0xf3, 0x5e, 0xb5, 0x0f, 0x69, 0xd0, 0x39, 0xe4, 0xb2, 0xf6, 0x25, 0xa7, 0x26, 0x65, 0x4f, 0xa4,
+	0xe8, 0xc2, 0xae, 0xe9, 0x7f, 0x19, 0xb0, 0x31, 0xc1, 0x71, 0xac, 0xed, 0x2e, 0xcc, 0x7b, 0x59,
+	0x05, 0x6e, 0xee, 0xd5, 0x74, 0x73, 0xe7, 0x17, 0xe2, 0x0e, 0xcf, 0xaf, 0x21, 0x9f, 0xc2, 0xbc,
+	0x6e, 0x90, 0x88, 0x25, 0xa1, 0x8f, 0xed, 0x7c, 0x35, 0x6f, 0x24, 0xa6, 0x61, 0x9c, 0xc4, 0x8e,
+	0x00, 0x38, 0x73, 0x88, 0x97, 0x5f, 0xe4, 0xf1, 0x98, 0x70, 0xcf, 0x75, 0x50, 0xfc, 0xdb, 0x80,
+	0xb9, 0x17, 0x9c, 0x45, 0x6e, 0x87, 0x7e, 0x19, 0xbb, 0x1d, 0x2a, 0xc6, 0x7b, 0x7f, 0x38, 0xde,
+	0xfb, 0x81, 0x2f, 0xb2, 0x8f, 0x87, 0xc2, 0xfe, 0x31, 0xa7, 0xb1, 0x74, 0x75, 0xda, 0xa9, 0x29,
+	0xd9, 0x7d, 0x21, 0xca, 0x40, 0x3c, 0x96, 0x84, 0xbc, 0x51, 0xca, 0x42, 0x76, 0x85, 0x88, 0x6c,
+	0x00, 0x04, 0xfd, 0x83, 0x18, 0x6d, 0x4c, 0x4b, 0x40, 0x55, 0x48, 0x94, 0x85, 0x0d, 0x80, 0x24,
+	0xa6, 0x9a, 0xa2, 0xac, 0xd4, 0x42, 0xa2, 0xd4, 0xb7, 0x60, 0xb1, 0x9f, 0x44, 0xde, 0xa1, 0x3b,
+	0xc4, 0xa8, 0xfe, 0x5b, 0x48, 0xc5, 0x0a, 0x78, 0x0d, 0x6a, 0xaf, 0x13, 0xc6, 0x5d, 0x04, 0xcd,
+	0x48, 0x10, 0x48, 0x91, 0x04, 0x58, 0xb7, 0xf0, 0xac, 0xc6, 0xa0, 0x33, 0xaf, 0x9a, 0x7c, 0xd8,
+	0xd6, 0x13, 0x58, 0xc9, 0x03, 0xd3, 0x53, 0xbd, 0x9c, 0x88, 0x4c, 0xe1, 0xc9, 0xb5, 0xa2, 0x4b,
+	0x96, 0xcd, 0x22, 0x16, 0x5d, 0x01, 0x2d, 0x8a, 0x87, 0x31, 0x22, 0x3e, 0xf3, 0x64, 0x92, 0xd2,
+	0xad, 0x90, 0x6f, 0x5d, 0xe3, 0xdc, 0xad, 0xfb, 0x3b, 0xbd, 0xe7, 0x46, 0x78, 0x46, 0x3d, 0x2f,
+	0xfd, 0x57, 0x9e, 0x5f, 0xd8, 0x7d, 0x74, 0xf7, 0x17, 0xf3, 0x50, 0x96, 0xbe, 0x91, 0x9f, 0x40,
+	0x45, 0x3d, 0x5c, 0x49, 0x7a, 0x76, 0x8f, 0xbe, 0x85, 0xcd, 0xb5, 0xb1, 0x3a, 0x65, 0xd8, 0xba,
+	0xf2, 0xd5, 0x5f, 0xff, 0xf9, 0xab, 0xa9, 0x3a, 0x59, 0xb0, 0xf1, 0x3d, 0xae, 0x5e, 0xc0, 0xe4,
+	0x39, 0x4c, 0x8b, 0x27, 0x2c, 0x69, 0xe4, 0x16, 0x67, 0xde, 0xc3, 0xe6, 0xd5, 0x31, 0x1a, 0x34,
+	0xba, 0x22, 0x8d, 0x2e, 0x90, 0x39, 0x6d, 0x54, 0xf4, 0x26, 0xf9, 0x11, 0x94, 0x76, 0xf7, 0x1e,
+	0x90, 0xd5, 0xdc, 0xba, 0xe1, 0x63, 0xd8, 0x6c, 0x8c, 0x2a, 0xd0, 0x9e, 0x29, 0xed, 0xad, 0x10,
+	0x92, 0xb5, 0x67, 0x9f, 0x78, 0x81, 0x7f, 0x4a, 0x0e, 0x60, 0x06, 0x0f, 0x4a, 0x92, 0x0f, 0x34,
+	0xff, 0xee, 0x35, 0xd7, 0xc7, 0x2b, 0x91, 0x61, 0x53, 0x32, 0x98, 0xa4, 0xa1, 0x19, 0xf0, 0x64,
+	0xb6, 0x4f, 0xd4, 0x6d, 0x7e, 0x4a, 0x18, 0x54, 0xd4, 0xa2, 0x42, 0xae, 0x73, 0xc3, 0x80, 0xb9,
+	0x36, 0x56, 0x87, 0x24, 0xdf, 0x91, 0x24, 0x37, 0xc9, 0xf5, 0x49, 0x24, 0xf6, 0x49, 0x3a, 0x31,
+	0x9c, 0x92, 0x10, 0xaa, 0xe9, 0xf3, 0x86, 0x6c, 0xe4, 0x6b, 0x58, 0x78, 0x97, 0x99, 0xcd, 0x49,
+	0x6a, 0x64, 0xb6, 0x24, 0xf3, 0x3a, 0x31, 0xd3, 0x2a, 0x6b, 0xc8, 0x30, 0xc0, 0x9f, 0xc1, 0xac,
+	0x5e, 0x48, 0xd6, 0xc7, 0xda, 0xd3, 0x6c, 0x1b, 0x13, 0xb4, 0x48, 0xb6, 0x23, 0xc9, 0x3e, 0x22,
+	0xb7, 0x27, 0x93, 0xd9, 0x27, 0xd9, 0x27, 0xcf, 0x29, 0x19, 0x40, 0x2d, 0x33, 0x78, 0x93, 0x6b,
+	0x79, 0x82, 0x91, 0xa7, 0x8a, 0xb9, 0x39, 0x19, 0x80, 0x4e, 0x5c, 0x97, 0x4e, 0x34, 0xc9, 0x7a,
+	0xea, 0xc4, 0x10, 0x34, 0x8c, 0xd9, 0x85, 0xb2, 0x9c, 0x1f, 0x49, 0xbe, 0x99, 0xb3, 0x03, 0xb6,
+	0x69, 0x8e, 0x53, 0x21, 0xcb, 0x07, 0x92, 0x65, 0x8d, 0x5c, 0xd5, 0x2c, 0x6a, 0xf6, 0xb4, 0x4f,
+	0xf4, 0x48, 0x7e, 0x2a, 0xf6, 0xa8, 0x5c, 0x53, 0xdc, 0xa3, 0xb9, 0xa1, 0xda, 0x5c, 0x1b, 0xab,
+	0x9b, 0xb4, 0x47, 0x71, 0xc2, 0xfd, 0xb5, 0x01, 0x8b, 0x85, 0x69, 0x81, 0x6c, 0xe5, 0x0c, 0x8d,
+	0x1f, 0x59, 0xcd, 0xeb, 0xef, 0x07, 0x21, 0xed, 0x27, 0x92, 0xf6, 0x63, 0xd2, 0xd2, 0xb4, 0xe9,
+	0xdc, 0x72, 0xe7, 0xbd, 0x8d, 0xfb, 0x95, 0x01, 0xf5, 0xe2, 0x34, 0x47, 0xc6, 0x52, 0x16, 0xa7,
+	0x41, 0xf3, 0xc6, 0x19, 0xa8, 0x49, 0x69, 0x1f, 0x4e, 0xa7, 0xb6, 0xfa, 0xb7, 0x18, 0xf9, 0xb9,
+	0x01, 0xf5, 0xe2, 0xd8, 0x51, 0x70, 0x62, 0xc2, 0x38, 0x65, 0xde, 0x38, 0x03, 0x35, 0x69, 0x37,
+	0x67, 0x9c, 0x18, 0x44, 0x07, 0x77, 0xf2, 0x43, 0x8a, 0x07, 0x33, 0x78, 0x35, 0x14, 0x8e, 0xa9,
+	0xfc, 0xdd, 0x69, 0xae, 0x8f, 0x57, 0x22, 0xe7, 0x86, 0xe4, 0x5c, 0x25, 0x97, 0xed, 0xf4, 0xbf,
+	0xa7, 0x12, 0x60, 0x9f, 0xf8, 0x22, 0xf3, 0x09, 0x2c, 0x16, 0xee, 0xab, 0x42, 0x3f, 0x8c, 0xbf,
+	0x35, 0xcd, 0xeb, 0xef, 0x07, 0x21, 0xf9, 0xaa, 0x24, 0x5f, 0x22, 0x8b, 0x05, 0xf2, 0xfb, 0xf7,
+	0xbe, 0x79, 0xdb, 0x34, 0xbe, 0x7d, 0xdb, 0x34, 0xfe, 0xf1, 0xb6, 0x69, 0x7c, 0xfd, 0xae, 0x79,
+	0xe9, 0xdb, 0x77, 0xcd, 0x4b, 0x7f, 0x7b, 0xd7, 0xbc, 0xf4, 0xd3, 0xad, 0x4e, 0xc0, 0x0f, 0x93,
+	0xfd, 0x96, 0xc7, 0x7a, 0x76, 0xcc, 0xc2, 0xe8, 0x4e, 0xc0, 0xe4, 0x5f, 0xfb, 0x8d, 0xb4, 0xc1,
+	0x8f, 0xfb, 0x34, 0xde, 0xaf, 0xc8, 0xb3, 0xe0, 0xbb, 0xff, 0x19, 0x00, 0x43, 0x7c, 0x28, 0xce,
+	0x70, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1685,6 +2008,10 @@ type QueryClient interface {
 	EncryptionStatus(ctx context.Context, in *QueryEncryptionStatusRequest, opts ...grpc.CallOption) (*QueryEncryptionStatusResponse, error)
 	// VRFContributions lists VRF contributions for current consensus round
 	VRFContributions(ctx context.Context, in *QueryVRFContributionsRequest, opts ...grpc.CallOption) (*QueryVRFContributionsResponse, error)
+	// Storage queries the metered storage and quota of a DID
+	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageAccounts lists the metered storage of every DID
+	StorageAccounts(ctx context.Context, in *QueryStorageAccountsRequest, opts ...grpc.CallOption) (*QueryStorageAccountsResponse, error)
 }
 
 type queryClient struct {
@@ -1812,6 +2139,24 @@ func (c *queryClient) VRFContributions(ctx context.Context, in *QueryVRFContribu
 	return out, nil
 }
 
+func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
+	out := new(QueryStorageResponse)
+	err := c.cc.Invoke(ctx, "/dwn.v1.Query/Storage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageAccounts(ctx context.Context, in *QueryStorageAccountsRequest, opts ...grpc.CallOption) (*QueryStorageAccountsResponse, error) {
+	out := new(QueryStorageAccountsResponse)
+	err := c.cc.Invoke(ctx, "/dwn.v1.Query/StorageAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the module.
@@ -1845,6 +2190,10 @@ type QueryServer interface {
 	EncryptionStatus(context.Context, *QueryEncryptionStatusRequest) (*QueryEncryptionStatusResponse, error)
 	// VRFContributions lists VRF contributions for current consensus round
 	VRFContributions(context.Context, *QueryVRFContributionsRequest) (*QueryVRFContributionsResponse, error)
+	// Storage queries the metered storage and quota of a DID
+	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageAccounts lists the metered storage of every DID
+	StorageAccounts(context.Context, *QueryStorageAccountsRequest) (*QueryStorageAccountsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1890,6 +2239,12 @@ func (*UnimplementedQueryServer) EncryptionStatus(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) VRFContributions(ctx context.Context, req *QueryVRFContributionsRequest) (*QueryVRFContributionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VRFContributions not implemented")
 }
+func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+}
+func (*UnimplementedQueryServer) StorageAccounts(ctx context.Context, req *QueryStorageAccountsRequest) (*QueryStorageAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageAccounts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2129,6 +2484,42 @@ func _Query_VRFContributions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Storage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dwn.v1.Query/Storage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Storage(ctx, req.(*QueryStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dwn.v1.Query/StorageAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageAccounts(ctx, req.(*QueryStorageAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dwn.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2185,6 +2576,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VRFContributions",
 			Handler:    _Query_VRFContributions_Handler,
 		},
+		{
+			MethodName: "Storage",
+			Handler:    _Query_Storage_Handler,
+		},
+		{
+			MethodName: "StorageAccounts",
+			Handler:    _Query_StorageAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dwn/v1/query.proto",
@@ -2391,6 +2790,16 @@ func (m *QueryRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeExpired {
+		i--
+		if m.IncludeExpired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -3320,35 +3729,242 @@ func (m *QueryVRFContributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *StorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.QuotaBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuotaBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PurchasedBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PurchasedBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UsedBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UsedBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IpfsBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IpfsBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecordBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -3433,6 +4049,9 @@ func (m *QueryRecordsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.IncludeExpired {
+		n += 2
+	}
 	return n
 }
 
@@ -3793,6 +4412,93 @@ func (m *QueryVRFContributionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *StorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordBytes != 0 {
+		n += 1 + sovQuery(uint64(m.RecordBytes))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovQuery(uint64(m.RecordCount))
+	}
+	if m.IpfsBytes != 0 {
+		n += 1 + sovQuery(uint64(m.IpfsBytes))
+	}
+	if m.UsedBytes != 0 {
+		n += 1 + sovQuery(uint64(m.UsedBytes))
+	}
+	if m.PurchasedBytes != 0 {
+		n += 1 + sovQuery(uint64(m.PurchasedBytes))
+	}
+	if m.QuotaBytes != 0 {
+		n += 1 + sovQuery(uint64(m.QuotaBytes))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4469,22 +5175,42 @@ func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeExpired", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeExpired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -6857,6 +7583,573 @@ func (m *QueryVRFContributionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordBytes", wireType)
+			}
+			m.RecordBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsBytes", wireType)
+			}
+			m.IpfsBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IpfsBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
+			}
+			m.UsedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchasedBytes", wireType)
+			}
+			m.PurchasedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchasedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBytes", wireType)
+			}
+			m.QuotaBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, StorageUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/ipfs/go-cid"
+)
+
+// RecordExpiriesPrefix is the store prefix for the queue of expiring records,
+// keyed by expiry time and record ID
+var RecordExpiriesPrefix = collections.NewPrefix(3)
+
+// AttachmentRefsPrefix is the store prefix for the number of records
+// referencing each attachment CID
+var AttachmentRefsPrefix = collections.NewPrefix(4)
+
+const (
+	// MaxRecordTTL is the longest time to live a record can be written with,
+	// in seconds
+	MaxRecordTTL = 365 * 24 * 60 * 60
+	// MaxRecordAttachments is the most attachments a record can reference
+	MaxRecordAttachments = 32
+	// MaxExpiredRecordsPerBlock bounds the expired records swept in one
+	// block; the rest are swept in the following blocks
+	MaxExpiredRecordsPerBlock = 100
+)
+
+// ValidateRecordTTL checks a record's time to live in seconds. Zero means the
+// record does not expire.
+func ValidateRecordTTL(ttl int64) error {
+	if ttl < 0 || ttl > MaxRecordTTL {
+		return errors.Wrapf(ErrInvalidRecordTTL, "ttl %d must be between 0 and %d seconds", ttl, MaxRecordTTL)
+	}
+	return nil
+}
+
+// ValidateAttachmentCIDs checks the attachment CIDs a record references
+func ValidateAttachmentCIDs(cids []string) error {
+	if len(cids) > MaxRecordAttachments {
+		return errors.Wrapf(ErrInvalidAttachment, "at most %d attachments", MaxRecordAttachments)
+	}
+	seen := make(map[string]bool, len(cids))
+	for _, c := range cids {
+		if _, err := cid.Decode(c); err != nil {
+			return errors.Wrapf(ErrInvalidAttachment, "%q is not a CID", c)
+		}
+		if seen[c] {
+			return errors.Wrapf(ErrInvalidAttachment, "duplicate attachment %s", c)
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// RecordExpired reports whether a record with the given expiry has expired at
+// now (Unix timestamp)
+func RecordExpired(expiresAt, now int64) bool {
+	return expiresAt != 0 && expiresAt <= now
+}
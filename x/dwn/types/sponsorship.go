@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/app/clock"
 )
 
 // WalletSponsorship represents metadata for a wallet sponsorship
@@ -83,16 +85,15 @@ func (ws *WalletSponsorship) ValidateBasic() error {
 	return nil
 }
 
-// IsDailyLimitExceeded checks if the daily limit would be exceeded by the given amount
-func (ws *WalletSponsorship) IsDailyLimitExceeded(amount sdk.Coins) bool {
+// IsDailyLimitExceeded checks if the daily limit would be exceeded by the given
+// amount. now must be the block time so every node resets on the same block.
+func (ws *WalletSponsorship) IsDailyLimitExceeded(amount sdk.Coins, now time.Time) bool {
 	if ws.DailyLimit == nil {
 		return false // No daily limit
 	}
 
 	// Reset daily spent if it's a new day
-	now := time.Now()
-	if now.Day() != ws.LastResetDate.Day() || now.Month() != ws.LastResetDate.Month() ||
-		now.Year() != ws.LastResetDate.Year() {
+	if !clock.SameDay(now, ws.LastResetDate) {
 		ws.DailySpent = sdk.NewCoins()
 		ws.LastResetDate = now
 	}
@@ -110,12 +111,9 @@ func (ws *WalletSponsorship) IsDailyLimitExceeded(amount sdk.Coins) bool {
 }
 
 // AddDailySpent adds to the daily spent amount and updates the last used time
-func (ws *WalletSponsorship) AddDailySpent(amount sdk.Coins) {
-	now := time.Now()
-
+func (ws *WalletSponsorship) AddDailySpent(amount sdk.Coins, now time.Time) {
 	// Reset daily spent if it's a new day
-	if now.Day() != ws.LastResetDate.Day() || now.Month() != ws.LastResetDate.Month() ||
-		now.Year() != ws.LastResetDate.Year() {
+	if !clock.SameDay(now, ws.LastResetDate) {
 		ws.DailySpent = sdk.NewCoins()
 		ws.LastResetDate = now
 	}
@@ -934,6 +934,10 @@ type DWNRecord struct {
 	EncryptionMetadata *EncryptionMetadata `protobuf:"bytes,17,opt,name=encryption_metadata,json=encryptionMetadata,proto3" json:"encryption_metadata,omitempty"`
 	// Flag indicating if the record is encrypted
 	IsEncrypted bool `protobuf:"varint,18,opt,name=is_encrypted,json=isEncrypted,proto3" json:"is_encrypted,omitempty"`
+	// Expiry timestamp (Unix timestamp), zero if the record does not expire
+	ExpiresAt int64 `protobuf:"varint,19,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// CIDs of attachments pinned for the record
+	AttachmentCids []string `protobuf:"bytes,20,rep,name=attachment_cids,json=attachmentCids,proto3" json:"attachment_cids,omitempty"`
 }
 
 func (m *DWNRecord) Reset()         { *m = DWNRecord{} }
@@ -1095,6 +1099,20 @@ func (m *DWNRecord) GetIsEncrypted() bool {
 	return false
 }
 
+func (m *DWNRecord) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *DWNRecord) GetAttachmentCids() []string {
+	if m != nil {
+		return m.AttachmentCids
+	}
+	return nil
+}
+
 // DWNProtocol represents a configured protocol in a DWN
 type DWNProtocol struct {
 	// DID of the DWN target
@@ -1468,125 +1486,126 @@ func init() {
 func init() { proto.RegisterFile("dwn/v1/state.proto", fileDescriptor_040a9b061177db90) }
 
 var fileDescriptor_040a9b061177db90 = []byte{
-	// 1881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0x7b, 0xc6, 0x9e, 0xe9, 0x37, 0x33, 0x76, 0xbb, 0x9c, 0x3f, 0x9d, 0x6c, 0xe2, 0x4c,
-	0x66, 0x59, 0x30, 0xda, 0x60, 0x93, 0x00, 0xbb, 0x92, 0x45, 0x0e, 0x21, 0x76, 0x14, 0x63, 0x62,
-	0x45, 0xed, 0xdd, 0x44, 0xe2, 0xd2, 0x2a, 0x77, 0x97, 0x3d, 0x25, 0x4f, 0x77, 0x0d, 0xd5, 0x35,
-	0x13, 0x3b, 0x47, 0x4e, 0x9c, 0x10, 0x9f, 0x00, 0xbe, 0x00, 0xdc, 0x90, 0xf8, 0x0a, 0x1c, 0x38,
-	0xac, 0xc4, 0x05, 0x89, 0x0b, 0x4a, 0xc4, 0x81, 0x03, 0x42, 0xe2, 0x13, 0xa0, 0x7a, 0x55, 0xfd,
-	0x6f, 0x66, 0x48, 0x60, 0x4f, 0x33, 0xf5, 0x7b, 0xaf, 0xbb, 0xab, 0xde, 0xef, 0xbd, 0xdf, 0x7b,
-	0x05, 0x24, 0x7e, 0x9d, 0xee, 0x4c, 0x1f, 0xec, 0x64, 0x8a, 0x2a, 0xb6, 0x3d, 0x96, 0x42, 0x09,
-	0xb2, 0x12, 0xbf, 0x4e, 0xb7, 0xa7, 0x0f, 0x6e, 0xdd, 0x88, 0x44, 0x96, 0x88, 0x6c, 0x47, 0xc8,
-	0x44, 0xbb, 0x08, 0x99, 0x18, 0x87, 0xc1, 0x6f, 0x1a, 0x40, 0xf6, 0xd3, 0x48, 0x5e, 0x8e, 0x15,
-	0x17, 0xe9, 0x73, 0xa6, 0x68, 0x4c, 0x15, 0x25, 0xb7, 0xc1, 0xa5, 0xa3, 0x33, 0x21, 0xb9, 0x1a,
-	0x26, 0xbe, 0xd3, 0x77, 0xb6, 0xdc, 0xa0, 0x04, 0xc8, 0xb7, 0x60, 0x2d, 0x12, 0x69, 0xc6, 0xd2,
-	0x6c, 0x92, 0x85, 0x3c, 0x1d, 0x4f, 0x94, 0xbf, 0xd4, 0x77, 0xb6, 0xba, 0xc1, 0x6a, 0x01, 0x1f,
-	0x68, 0x94, 0x5c, 0x85, 0xe5, 0x54, 0xa4, 0x11, 0xf3, 0x1b, 0x68, 0x36, 0x0b, 0x72, 0x13, 0xda,
-	0x74, 0xa2, 0x86, 0xa1, 0xa2, 0x67, 0x7e, 0x13, 0x0d, 0x2d, 0xbd, 0xfe, 0x82, 0x9e, 0x91, 0x4f,
-	0x61, 0x9d, 0x15, 0xbb, 0x09, 0x87, 0x8c, 0x9f, 0x0d, 0x95, 0xbf, 0xdc, 0x77, 0xb6, 0x1a, 0x81,
-	0x57, 0x1a, 0x9e, 0x21, 0x4e, 0x3e, 0x86, 0xde, 0x94, 0x8e, 0x78, 0x4c, 0x95, 0x90, 0x61, 0xc6,
-	0x94, 0xbf, 0xd2, 0x6f, 0x6c, 0xb9, 0x41, 0xb7, 0x00, 0x8f, 0x99, 0x22, 0x77, 0xa1, 0x73, 0xce,
-	0x2e, 0xc3, 0x29, 0x93, 0x19, 0x17, 0xa9, 0xdf, 0xea, 0x3b, 0x5b, 0xcd, 0x00, 0xce, 0xd9, 0xe5,
-	0x4b, 0x83, 0x90, 0x2d, 0xf0, 0x32, 0x9e, 0x9e, 0x8d, 0x58, 0x98, 0x8a, 0x98, 0x85, 0x89, 0x88,
-	0x99, 0xdf, 0xee, 0x3b, 0x5b, 0xed, 0x60, 0xd5, 0xe0, 0x47, 0x22, 0x66, 0xcf, 0x45, 0xcc, 0xc8,
-	0x47, 0xe0, 0xea, 0xe0, 0x84, 0xc3, 0x84, 0x46, 0xbe, 0x8b, 0x1b, 0x6f, 0x6b, 0xe0, 0x59, 0x42,
-	0x23, 0xb2, 0x0d, 0x1b, 0xfa, 0x3b, 0x31, 0x93, 0x7c, 0x4a, 0x71, 0xf7, 0x19, 0x1d, 0x29, 0x1f,
-	0xd0, 0x6d, 0xfd, 0x9c, 0x5d, 0xee, 0x15, 0x96, 0x63, 0x3a, 0x52, 0x3a, 0x86, 0x34, 0x8e, 0xb9,
-	0x5e, 0xd3, 0x51, 0xa8, 0x5f, 0xe3, 0x77, 0x4c, 0x0c, 0x4b, 0x78, 0x8f, 0x2a, 0x3a, 0xf8, 0x7d,
-	0xb3, 0xca, 0xd0, 0x21, 0xbb, 0x3c, 0xd6, 0xfc, 0xea, 0x73, 0x45, 0x13, 0x29, 0x59, 0xaa, 0xc2,
-	0x73, 0x76, 0x89, 0x1c, 0x75, 0x03, 0xb0, 0xd0, 0x21, 0xbb, 0x9c, 0x3d, 0xf8, 0xd2, 0xdc, 0xc1,
-	0xe7, 0xc2, 0xd7, 0x58, 0x10, 0xbe, 0x47, 0xd0, 0x8b, 0x44, 0xaa, 0x24, 0x3f, 0x99, 0xe8, 0xcf,
-	0x67, 0x7e, 0xb3, 0xdf, 0xd8, 0xea, 0x3c, 0xbc, 0xb1, 0x6d, 0x12, 0x6b, 0xfb, 0x65, 0xf0, 0xf4,
-	0x49, 0xc5, 0x1e, 0xd4, 0xbd, 0xf5, 0x37, 0x46, 0x34, 0x53, 0xa1, 0x14, 0x0a, 0x8f, 0x6e, 0xb9,
-	0xec, 0x6a, 0x30, 0xb0, 0x98, 0x76, 0x4a, 0xd9, 0x45, 0xc5, 0x69, 0xc5, 0x38, 0x69, 0xb0, 0x70,
-	0x5a, 0x44, 0x53, 0x6b, 0x21, 0x4d, 0x77, 0xa1, 0x33, 0xc9, 0xe8, 0x19, 0x0b, 0x23, 0x31, 0x49,
-	0x15, 0x72, 0xd9, 0x0c, 0x00, 0xa1, 0x27, 0x1a, 0x21, 0xdf, 0x84, 0xb5, 0x84, 0x5e, 0x84, 0x55,
-	0x27, 0x17, 0x9d, 0x7a, 0x09, 0xbd, 0xf8, 0xb2, 0xf4, 0xfb, 0x14, 0xd6, 0xf3, 0x2d, 0x85, 0x3c,
-	0x55, 0x4c, 0x4e, 0xe9, 0x08, 0x09, 0x6d, 0x04, 0x5e, 0x6e, 0x38, 0xb0, 0x38, 0xb9, 0x03, 0x10,
-	0x49, 0x46, 0x15, 0x8b, 0x43, 0xaa, 0x90, 0xca, 0x46, 0xe0, 0x5a, 0xe4, 0xb1, 0x22, 0xdf, 0x85,
-	0xab, 0x63, 0xc9, 0xa6, 0x5c, 0x4c, 0xb2, 0xb0, 0x4a, 0x4b, 0x17, 0x3f, 0x4c, 0x72, 0xdb, 0x61,
-	0x41, 0xcf, 0xee, 0xa3, 0x7f, 0xff, 0xfa, 0xcf, 0xbf, 0x6c, 0x7c, 0x0e, 0xbd, 0x1a, 0x8f, 0x64,
-	0x7d, 0x26, 0xa2, 0x9e, 0xa3, 0xa1, 0x5a, 0xfc, 0xbc, 0x25, 0x7f, 0x65, 0xf0, 0xbb, 0x06, 0xac,
-	0x1b, 0x72, 0x4c, 0x41, 0x06, 0x62, 0x92, 0xc6, 0xe4, 0x1e, 0x74, 0xa5, 0xfe, 0x13, 0xa6, 0x93,
-	0xe4, 0x84, 0x49, 0x4c, 0x9b, 0x66, 0xd0, 0x41, 0xec, 0x08, 0xa1, 0x0f, 0xe7, 0xcd, 0x0f, 0xe0,
-	0xba, 0x64, 0x3f, 0x9b, 0x70, 0xc9, 0xe2, 0xb0, 0x9e, 0x1b, 0xba, 0xca, 0x7b, 0xc1, 0xb5, 0xdc,
-	0xfa, 0xa4, 0x96, 0x0a, 0xf8, 0x58, 0xc4, 0xf8, 0x74, 0xee, 0xb1, 0x66, 0xfe, 0x98, 0xb1, 0xd6,
-	0x1f, 0xbb, 0x0e, 0x2b, 0x5a, 0xd0, 0x26, 0x19, 0xa6, 0x8e, 0x1b, 0xd8, 0x95, 0x4e, 0x1a, 0x76,
-	0x31, 0xe6, 0xf2, 0x32, 0x57, 0x09, 0x9b, 0x34, 0x06, 0xb4, 0x0a, 0xf1, 0x6d, 0xf0, 0x78, 0xca,
-	0x15, 0x47, 0x5a, 0xac, 0x5f, 0x0b, 0xfd, 0xd6, 0x0a, 0xdc, 0xba, 0x2e, 0xd0, 0xb4, 0xf6, 0x42,
-	0x4d, 0xbb, 0x0d, 0x6e, 0x24, 0x92, 0xf1, 0x88, 0x29, 0x16, 0x63, 0xde, 0xb4, 0x83, 0x12, 0xd8,
-	0xfd, 0x1c, 0x59, 0x7b, 0x00, 0xab, 0xf5, 0x40, 0x13, 0xc8, 0x8f, 0x61, 0xf8, 0xaa, 0x6d, 0xdd,
-	0x5b, 0xf2, 0x5b, 0x83, 0x3f, 0x38, 0xb0, 0x56, 0x96, 0xb9, 0xae, 0xf1, 0x8c, 0x7c, 0x06, 0x37,
-	0x94, 0x50, 0x74, 0x14, 0x5a, 0xe9, 0x63, 0x71, 0x28, 0x59, 0x24, 0x64, 0x9c, 0x21, 0x71, 0x8d,
-	0xe0, 0x1a, 0x9a, 0xf7, 0x73, 0x6b, 0x60, 0x8c, 0xe5, 0x73, 0x31, 0x2b, 0xb4, 0x94, 0x49, 0x29,
-	0x64, 0x86, 0x74, 0xe6, 0xcf, 0xed, 0x15, 0xd6, 0x7d, 0x34, 0x92, 0xef, 0xc3, 0x75, 0xcc, 0xad,
-	0x79, 0x09, 0x6e, 0xe0, 0x63, 0x57, 0xb5, 0x75, 0x7f, 0x46, 0x86, 0x07, 0x7f, 0x72, 0xc0, 0xd5,
-	0x92, 0x76, 0xac, 0x84, 0x44, 0x91, 0x34, 0x7b, 0x0c, 0x79, 0x6c, 0x3b, 0x47, 0xdb, 0x00, 0x07,
-	0xb1, 0x2e, 0x12, 0xad, 0x8a, 0xe1, 0x94, 0x8e, 0x26, 0xcc, 0xf6, 0x0c, 0x57, 0x23, 0x2f, 0x35,
-	0x30, 0x53, 0x43, 0x8d, 0xd9, 0x1a, 0x9a, 0xc9, 0xcc, 0xe6, 0x5c, 0x66, 0xd6, 0xba, 0xd6, 0xf2,
-	0x4c, 0xd7, 0xda, 0xfd, 0x04, 0xa9, 0xb9, 0x0b, 0x9d, 0xca, 0x0e, 0xc9, 0x6a, 0xf5, 0x93, 0x9e,
-	0xe3, 0xb7, 0x07, 0x3f, 0x5f, 0x82, 0xb5, 0x19, 0x55, 0xd3, 0x4a, 0x50, 0x4a, 0x25, 0x8d, 0x63,
-	0xc9, 0xb2, 0xcc, 0x1e, 0xce, 0x2b, 0x0c, 0x8f, 0x0d, 0x4e, 0x36, 0x01, 0x24, 0x4d, 0x63, 0x91,
-	0xa4, 0xda, 0xcb, 0x1c, 0xb2, 0x82, 0xe8, 0xa6, 0x38, 0x96, 0x42, 0x9c, 0xe6, 0x4d, 0x11, 0x17,
-	0xba, 0x32, 0x4f, 0x46, 0x22, 0x3a, 0xcf, 0x23, 0xde, 0xc4, 0xd3, 0x77, 0x10, 0xb3, 0x29, 0x7a,
-	0x1b, 0x5c, 0xc5, 0x13, 0x96, 0x29, 0x9a, 0x8c, 0xad, 0x90, 0x96, 0xc0, 0xee, 0x21, 0x1e, 0x6f,
-	0x1f, 0xfa, 0xb0, 0x39, 0xb7, 0xd7, 0xfb, 0xd5, 0x57, 0x13, 0xaf, 0xfe, 0x29, 0xcf, 0x21, 0xbd,
-	0xca, 0x9b, 0xbd, 0x25, 0x7f, 0x79, 0xf0, 0x5b, 0xa7, 0x68, 0x3a, 0x2c, 0xde, 0x7b, 0x75, 0x64,
-	0x32, 0xeb, 0xfd, 0xe4, 0x7e, 0x02, 0xab, 0x65, 0x9e, 0x62, 0x43, 0x33, 0x67, 0xef, 0x15, 0xa8,
-	0xee, 0x67, 0xff, 0x65, 0x26, 0xf8, 0x20, 0xb7, 0x1f, 0x81, 0xcb, 0xc7, 0xa7, 0x59, 0x38, 0xa4,
-	0xd9, 0xd0, 0x72, 0xdb, 0xd6, 0xc0, 0x33, 0x9a, 0x0d, 0x07, 0xbf, 0x70, 0xa0, 0xb3, 0x9f, 0x46,
-	0x23, 0x3a, 0x65, 0xf8, 0x8d, 0x7b, 0xd0, 0x1d, 0x63, 0xb7, 0x65, 0x66, 0x23, 0xa6, 0x3b, 0x76,
-	0x2c, 0x86, 0x2e, 0x77, 0x00, 0xc6, 0x93, 0x93, 0x11, 0x8f, 0xb0, 0x7d, 0xda, 0x54, 0x34, 0x88,
-	0xee, 0x9e, 0x77, 0x00, 0x98, 0x79, 0xa1, 0x3e, 0x6a, 0xc3, 0xe4, 0x92, 0x45, 0x0e, 0x62, 0xe2,
-	0x43, 0xab, 0xba, 0xd5, 0x46, 0x90, 0x2f, 0x07, 0x7f, 0x75, 0xe0, 0xea, 0xde, 0xab, 0xa3, 0xe7,
-	0x2c, 0xd3, 0x8d, 0x64, 0x8f, 0x65, 0x91, 0xe4, 0x63, 0x25, 0xa4, 0x0e, 0x0f, 0x36, 0x91, 0x53,
-	0x1a, 0xb1, 0x30, 0xa5, 0x09, 0xb3, 0x01, 0xec, 0x15, 0xe8, 0x11, 0x4d, 0x98, 0xd6, 0xbb, 0x84,
-	0xa9, 0xa1, 0x88, 0x71, 0x4f, 0x6e, 0x60, 0x57, 0x3a, 0x05, 0x13, 0xf3, 0xce, 0xb0, 0x4c, 0x02,
-	0xb3, 0x2f, 0xcf, 0x1a, 0xbe, 0xc8, 0x71, 0x3d, 0x61, 0xe1, 0xa4, 0x12, 0xf1, 0x18, 0xf7, 0xe7,
-	0x06, 0x2d, 0xbd, 0x7e, 0xc2, 0xe3, 0x62, 0x88, 0xc9, 0xf8, 0x1b, 0x66, 0x93, 0x08, 0x7d, 0x8f,
-	0xf9, 0x1b, 0x64, 0x01, 0x8d, 0xa7, 0x42, 0x26, 0xd4, 0x48, 0xaa, 0x1b, 0x80, 0x86, 0x9e, 0x22,
-	0x32, 0xf8, 0xfb, 0x32, 0xb8, 0xff, 0x63, 0x3a, 0x5c, 0x87, 0x15, 0x45, 0xe5, 0x19, 0x53, 0xf9,
-	0x41, 0xcc, 0x8a, 0xfc, 0x10, 0x20, 0x2e, 0xa2, 0x82, 0x27, 0xe8, 0x3c, 0xbc, 0x9d, 0x8f, 0x13,
-	0x8b, 0x22, 0x17, 0x54, 0xfc, 0xc9, 0x37, 0xa0, 0xa7, 0x67, 0x45, 0x21, 0xf9, 0x1b, 0x33, 0x2b,
-	0x98, 0xe3, 0xd5, 0x41, 0x42, 0xa0, 0x89, 0xbc, 0x2f, 0x23, 0xad, 0xf8, 0x9f, 0xdc, 0x82, 0x36,
-	0x8e, 0xbc, 0x91, 0x18, 0xd9, 0x83, 0x15, 0x6b, 0xdd, 0x4c, 0xf2, 0xff, 0xe1, 0x98, 0xaa, 0x21,
-	0x36, 0x09, 0x37, 0xe8, 0xe6, 0xe0, 0x0b, 0xaa, 0x86, 0xd8, 0x89, 0xa2, 0x21, 0x4b, 0x28, 0x36,
-	0x06, 0xdd, 0x89, 0x70, 0xa5, 0xa3, 0x30, 0xa6, 0x38, 0x88, 0x71, 0xd3, 0x10, 0xf4, 0x9b, 0x11,
-	0x38, 0x88, 0x75, 0xcd, 0x62, 0x52, 0x65, 0x43, 0x16, 0xe3, 0xec, 0xd0, 0x0e, 0x4a, 0x80, 0xf4,
-	0xa1, 0x43, 0x95, 0xd2, 0xa4, 0xe1, 0x59, 0x3a, 0xf8, 0x70, 0x15, 0xd2, 0x62, 0x52, 0xaa, 0x31,
-	0x4e, 0x0b, 0x6e, 0x50, 0x41, 0xc8, 0x43, 0xb8, 0x36, 0x3b, 0x76, 0xea, 0x5d, 0x31, 0xbf, 0x87,
-	0xae, 0x1b, 0xf5, 0xc1, 0x13, 0x4d, 0x33, 0x32, 0xbb, 0x3a, 0x2b, 0xb3, 0x77, 0x00, 0x26, 0xe3,
-	0x38, 0x37, 0xaf, 0x19, 0xb3, 0x45, 0x1e, 0x2b, 0x9d, 0xc7, 0xf9, 0xd3, 0x56, 0xaa, 0x3c, 0x74,
-	0xe9, 0x59, 0xd4, 0x8a, 0xd5, 0x21, 0x6c, 0x54, 0xda, 0x48, 0x62, 0x2f, 0x16, 0xfe, 0x3a, 0xf2,
-	0x7d, 0x2b, 0xe7, 0x7b, 0xfe, 0xea, 0x11, 0x10, 0x36, 0x7f, 0x1d, 0xb9, 0x07, 0x5d, 0x9e, 0x95,
-	0x5d, 0xd0, 0x27, 0x18, 0xc8, 0x0e, 0xcf, 0x0a, 0x8d, 0xda, 0xfd, 0x09, 0xca, 0xdf, 0xd3, 0xba,
-	0xba, 0x6f, 0xc0, 0x9a, 0xc9, 0xba, 0xfb, 0x39, 0x93, 0xa6, 0xfd, 0x5a, 0xd0, 0x10, 0xe8, 0x2d,
-	0x69, 0xfd, 0x2b, 0x28, 0xf4, 0x1a, 0xbe, 0x33, 0xf8, 0xa7, 0x03, 0x9d, 0xbd, 0x57, 0x47, 0x2f,
-	0xf2, 0x04, 0x29, 0x93, 0xd9, 0xa9, 0x25, 0x33, 0x0a, 0x8d, 0x4d, 0x9c, 0x89, 0xe4, 0x36, 0xd5,
-	0x3b, 0x39, 0xf6, 0xa5, 0xe4, 0x9a, 0xc1, 0x98, 0x9d, 0xe2, 0xb8, 0x21, 0x52, 0x2b, 0x7a, 0x15,
-	0xa4, 0x9e, 0x21, 0xcd, 0xd9, 0x0c, 0xa9, 0x73, 0xb5, 0x3c, 0xcb, 0xd5, 0x3c, 0x19, 0x2b, 0x0b,
-	0xc8, 0xd8, 0xbd, 0x8b, 0xc1, 0xb9, 0x09, 0xd7, 0x60, 0x63, 0x26, 0x1e, 0x66, 0xd7, 0x83, 0x7f,
-	0x34, 0xa0, 0xa7, 0xcf, 0xcb, 0x64, 0xc2, 0xb3, 0xfc, 0x76, 0x30, 0x2e, 0x56, 0x65, 0x7d, 0x77,
-	0x4b, 0xd0, 0xc8, 0xe0, 0x99, 0xa4, 0xa9, 0x2e, 0x64, 0x73, 0xf2, 0x7c, 0x59, 0x58, 0x18, 0xb3,
-	0x22, 0x95, 0x2f, 0x2b, 0xa1, 0x6c, 0xd6, 0x42, 0x39, 0xaf, 0x8f, 0xcb, 0xef, 0xd7, 0xc7, 0x95,
-	0x9a, 0x3e, 0x56, 0xcb, 0xbb, 0x35, 0x53, 0xde, 0x35, 0x9d, 0x6a, 0xcf, 0xe8, 0xd4, 0x26, 0x40,
-	0x24, 0x52, 0x73, 0xe5, 0xca, 0xec, 0xb5, 0xae, 0x82, 0x60, 0x27, 0xd0, 0xd3, 0x1a, 0xcb, 0x34,
-	0x03, 0x66, 0xfc, 0x77, 0x2d, 0x62, 0xaa, 0xe5, 0x7d, 0x73, 0xbf, 0x0f, 0x2d, 0xc9, 0xa6, 0xe2,
-	0x9c, 0xc5, 0x58, 0xbc, 0xed, 0x20, 0x5f, 0x2e, 0xa0, 0xae, 0xb7, 0x88, 0xba, 0x17, 0x48, 0xdd,
-	0x8f, 0x61, 0x6d, 0x86, 0x0f, 0x9d, 0xdb, 0x36, 0xd8, 0xf7, 0x6d, 0x68, 0x3d, 0x87, 0xf4, 0xe1,
-	0xb6, 0x25, 0xb8, 0x1e, 0xcb, 0xfb, 0x26, 0x4a, 0xde, 0x92, 0xdf, 0x18, 0xfc, 0x6b, 0x09, 0xe0,
-	0x25, 0x9d, 0xe8, 0x81, 0x4d, 0x5f, 0x24, 0x6f, 0x42, 0x7b, 0xaa, 0x57, 0x25, 0xc7, 0x2d, 0x5c,
-	0x1f, 0xc4, 0xba, 0x55, 0x8b, 0xd7, 0x29, 0xcb, 0xc9, 0x35, 0x0b, 0xf2, 0x19, 0x74, 0xf3, 0xd6,
-	0x88, 0x25, 0x6d, 0x24, 0x7c, 0xa3, 0x52, 0xd2, 0x79, 0x1f, 0x0e, 0x3a, 0xac, 0xd2, 0x94, 0xeb,
-	0x1d, 0xb7, 0xb9, 0xa0, 0xe3, 0x7e, 0x20, 0xd3, 0xcd, 0xbd, 0x87, 0x9d, 0x4a, 0x86, 0xb5, 0x62,
-	0x33, 0x1d, 0xaf, 0x92, 0x39, 0xb8, 0x20, 0xaa, 0xad, 0xff, 0x43, 0x9d, 0xda, 0x5f, 0x47, 0x9d,
-	0x8a, 0xea, 0x82, 0x32, 0x92, 0xc4, 0xb5, 0xa1, 0xf3, 0x1c, 0xbf, 0xf9, 0xa3, 0x47, 0x7f, 0x7c,
-	0xbb, 0xe9, 0x7c, 0xf5, 0x76, 0xd3, 0xf9, 0xdb, 0xdb, 0x4d, 0xe7, 0x57, 0xef, 0x36, 0xaf, 0x7c,
-	0xf5, 0x6e, 0xf3, 0xca, 0x5f, 0xde, 0x6d, 0x5e, 0xf9, 0xe9, 0xc7, 0x67, 0x5c, 0x0d, 0x27, 0x27,
-	0xdb, 0x91, 0x48, 0x76, 0x32, 0x91, 0xca, 0xef, 0x70, 0x81, 0xbf, 0x3b, 0x17, 0x3b, 0xf1, 0xeb,
-	0x74, 0x47, 0x5d, 0x8e, 0x59, 0x76, 0xb2, 0x82, 0x89, 0xfc, 0xbd, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x02, 0xc5, 0x23, 0x60, 0xe1, 0x11, 0x00, 0x00,
+	// 1901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xcf, 0x8c, 0x67, 0xa6, 0xdf, 0xcc, 0xd8, 0xed, 0x72, 0xfe, 0x74, 0xb2, 0x89, 0x33,
+	0x3b, 0xcb, 0x82, 0xd1, 0x06, 0x9b, 0x04, 0xd8, 0x95, 0x2c, 0x72, 0x08, 0xb1, 0xa3, 0x98, 0x10,
+	0x2b, 0xea, 0xec, 0x26, 0x12, 0x97, 0x56, 0xb9, 0xbb, 0xec, 0x69, 0x79, 0xba, 0x6b, 0xa8, 0xaa,
+	0x99, 0xc4, 0x39, 0x72, 0xe2, 0x84, 0xf8, 0x04, 0xf0, 0x05, 0xd8, 0x1b, 0x12, 0x5f, 0x81, 0x03,
+	0x87, 0x95, 0xb8, 0x20, 0x71, 0x41, 0xc9, 0x89, 0x03, 0x42, 0xe2, 0x13, 0xa0, 0x7a, 0x55, 0xfd,
+	0x67, 0x7a, 0x86, 0x0d, 0xec, 0xc9, 0x53, 0xbf, 0xf7, 0xaa, 0xbb, 0xea, 0xfd, 0x7e, 0xf5, 0x7b,
+	0xd5, 0x06, 0x12, 0xbf, 0xca, 0xf6, 0xe6, 0x77, 0xf7, 0xa4, 0xa2, 0x8a, 0xed, 0x4e, 0x05, 0x57,
+	0x9c, 0xb4, 0xe3, 0x57, 0xd9, 0xee, 0xfc, 0xee, 0x8d, 0x6b, 0x11, 0x97, 0x29, 0x97, 0x7b, 0x5c,
+	0xa4, 0x3a, 0x85, 0x8b, 0xd4, 0x24, 0x8c, 0x7e, 0xd7, 0x04, 0x72, 0x98, 0x45, 0xe2, 0x62, 0xaa,
+	0x12, 0x9e, 0x3d, 0x65, 0x8a, 0xc6, 0x54, 0x51, 0x72, 0x13, 0x5c, 0x3a, 0x39, 0xe3, 0x22, 0x51,
+	0xe3, 0xd4, 0x77, 0x86, 0xce, 0x8e, 0x1b, 0x94, 0x00, 0xf9, 0x0e, 0x6c, 0x44, 0x3c, 0x93, 0x2c,
+	0x93, 0x33, 0x19, 0x26, 0xd9, 0x74, 0xa6, 0xfc, 0xc6, 0xd0, 0xd9, 0xe9, 0x07, 0xeb, 0x05, 0x7c,
+	0xa4, 0x51, 0x72, 0x19, 0xd6, 0x32, 0x9e, 0x45, 0xcc, 0x6f, 0x62, 0xd8, 0x0c, 0xc8, 0x75, 0xe8,
+	0xd2, 0x99, 0x1a, 0x87, 0x8a, 0x9e, 0xf9, 0x2d, 0x0c, 0x74, 0xf4, 0xf8, 0x73, 0x7a, 0x46, 0x3e,
+	0x81, 0x4d, 0x56, 0xac, 0x26, 0x1c, 0xb3, 0xe4, 0x6c, 0xac, 0xfc, 0xb5, 0xa1, 0xb3, 0xd3, 0x0c,
+	0xbc, 0x32, 0xf0, 0x18, 0x71, 0xf2, 0x11, 0x0c, 0xe6, 0x74, 0x92, 0xc4, 0x54, 0x71, 0x11, 0x4a,
+	0xa6, 0xfc, 0xf6, 0xb0, 0xb9, 0xe3, 0x06, 0xfd, 0x02, 0x7c, 0xce, 0x14, 0xb9, 0x0d, 0xbd, 0x73,
+	0x76, 0x11, 0xce, 0x99, 0x90, 0x09, 0xcf, 0xfc, 0xce, 0xd0, 0xd9, 0x69, 0x05, 0x70, 0xce, 0x2e,
+	0x5e, 0x18, 0x84, 0xec, 0x80, 0x27, 0x93, 0xec, 0x6c, 0xc2, 0xc2, 0x8c, 0xc7, 0x2c, 0x4c, 0x79,
+	0xcc, 0xfc, 0xee, 0xd0, 0xd9, 0xe9, 0x06, 0xeb, 0x06, 0x3f, 0xe6, 0x31, 0x7b, 0xca, 0x63, 0x46,
+	0x3e, 0x00, 0x57, 0x17, 0x27, 0x1c, 0xa7, 0x34, 0xf2, 0x5d, 0x5c, 0x78, 0x57, 0x03, 0x8f, 0x53,
+	0x1a, 0x91, 0x5d, 0xd8, 0xd2, 0xef, 0x89, 0x99, 0x48, 0xe6, 0x14, 0x57, 0x2f, 0xe9, 0x44, 0xf9,
+	0x80, 0x69, 0x9b, 0xe7, 0xec, 0xe2, 0xa0, 0x88, 0x3c, 0xa7, 0x13, 0xa5, 0x6b, 0x48, 0xe3, 0x38,
+	0xd1, 0x63, 0x3a, 0x09, 0xf5, 0x63, 0xfc, 0x9e, 0xa9, 0x61, 0x09, 0x1f, 0x50, 0x45, 0x47, 0x7f,
+	0x68, 0x55, 0x19, 0x7a, 0xc2, 0x2e, 0x9e, 0x6b, 0x7e, 0xf5, 0xbe, 0xa2, 0x99, 0x10, 0x2c, 0x53,
+	0xe1, 0x39, 0xbb, 0x40, 0x8e, 0xfa, 0x01, 0x58, 0xe8, 0x09, 0xbb, 0xa8, 0x6f, 0xbc, 0xb1, 0xb4,
+	0xf1, 0xa5, 0xf2, 0x35, 0x57, 0x94, 0xef, 0x3e, 0x0c, 0x22, 0x9e, 0x29, 0x91, 0x9c, 0xcc, 0xf4,
+	0xeb, 0xa5, 0xdf, 0x1a, 0x36, 0x77, 0x7a, 0xf7, 0xae, 0xed, 0x1a, 0x61, 0xed, 0xbe, 0x08, 0x1e,
+	0x3d, 0xac, 0xc4, 0x83, 0xc5, 0x6c, 0xfd, 0x8e, 0x09, 0x95, 0x2a, 0x14, 0x5c, 0xe1, 0xd6, 0x2d,
+	0x97, 0x7d, 0x0d, 0x06, 0x16, 0xd3, 0x49, 0x19, 0x7b, 0x5d, 0x49, 0x6a, 0x9b, 0x24, 0x0d, 0x16,
+	0x49, 0xab, 0x68, 0xea, 0xac, 0xa4, 0xe9, 0x36, 0xf4, 0x66, 0x92, 0x9e, 0xb1, 0x30, 0xe2, 0xb3,
+	0x4c, 0x21, 0x97, 0xad, 0x00, 0x10, 0x7a, 0xa8, 0x11, 0xf2, 0x6d, 0xd8, 0x48, 0xe9, 0xeb, 0xb0,
+	0x9a, 0xe4, 0x62, 0xd2, 0x20, 0xa5, 0xaf, 0xbf, 0x28, 0xf3, 0x3e, 0x81, 0xcd, 0x7c, 0x49, 0x61,
+	0x92, 0x29, 0x26, 0xe6, 0x74, 0x82, 0x84, 0x36, 0x03, 0x2f, 0x0f, 0x1c, 0x59, 0x9c, 0xdc, 0x02,
+	0x88, 0x04, 0xa3, 0x8a, 0xc5, 0x21, 0x55, 0x48, 0x65, 0x33, 0x70, 0x2d, 0xf2, 0x40, 0x91, 0xef,
+	0xc3, 0xe5, 0xa9, 0x60, 0xf3, 0x84, 0xcf, 0x64, 0x58, 0xa5, 0xa5, 0x8f, 0x2f, 0x26, 0x79, 0xec,
+	0x49, 0x41, 0xcf, 0xfe, 0xfd, 0x7f, 0xff, 0xf6, 0x2f, 0xbf, 0x6e, 0x7e, 0xe6, 0xb7, 0x61, 0xb0,
+	0xc0, 0x24, 0xd9, 0xf4, 0x9c, 0x5a, 0x55, 0xc9, 0xa6, 0xd7, 0xa8, 0xd5, 0x70, 0xf4, 0x65, 0x13,
+	0x36, 0x0d, 0x39, 0xe6, 0x40, 0x06, 0x7c, 0x96, 0xc5, 0xe4, 0x43, 0xe8, 0x0b, 0xfd, 0x23, 0xcc,
+	0x66, 0xe9, 0x09, 0x13, 0x28, 0x9b, 0x56, 0xd0, 0x43, 0xec, 0x18, 0xa1, 0xf7, 0xeb, 0xe6, 0x47,
+	0x70, 0x55, 0xb0, 0x5f, 0xcc, 0x12, 0xc1, 0xe2, 0x70, 0x51, 0x1b, 0xfa, 0x94, 0x0f, 0x82, 0x2b,
+	0x79, 0xb4, 0x2a, 0x0c, 0x69, 0xa6, 0x45, 0x2c, 0x99, 0x2f, 0x4d, 0x6b, 0xe5, 0xd3, 0x4c, 0x74,
+	0x71, 0xda, 0x55, 0x68, 0x6b, 0x43, 0x9b, 0x49, 0x94, 0x8e, 0x1b, 0xd8, 0x91, 0x16, 0x0d, 0x7b,
+	0x3d, 0x4d, 0xc4, 0x45, 0xee, 0x12, 0x56, 0x34, 0x06, 0xb4, 0x0e, 0xf1, 0x5d, 0xf0, 0x92, 0x2c,
+	0x51, 0x09, 0xd2, 0x62, 0xf3, 0x3a, 0x98, 0xb7, 0x51, 0xe0, 0x36, 0x75, 0x85, 0xa7, 0x75, 0x57,
+	0x7a, 0xda, 0x4d, 0x70, 0x23, 0x9e, 0x4e, 0x27, 0x4c, 0xb1, 0x18, 0x75, 0xd3, 0x0d, 0x4a, 0x60,
+	0xff, 0x33, 0x64, 0xed, 0xae, 0xdf, 0x81, 0xf5, 0xc5, 0x52, 0x13, 0xf0, 0x9c, 0x7c, 0x2b, 0x64,
+	0xb3, 0xb6, 0x78, 0xaf, 0x31, 0xfa, 0xa3, 0x03, 0x1b, 0xe5, 0x31, 0xd7, 0x67, 0x5c, 0x92, 0x4f,
+	0xe1, 0x9a, 0xe2, 0x8a, 0x4e, 0x42, 0x6b, 0x7d, 0x2c, 0x0e, 0x05, 0x8b, 0xb8, 0x88, 0x25, 0x12,
+	0xd7, 0x0c, 0xae, 0x60, 0xf8, 0x30, 0x8f, 0x06, 0x26, 0x58, 0xce, 0x8b, 0x59, 0xe1, 0xa5, 0x4c,
+	0x08, 0x2e, 0xa4, 0xdf, 0xa8, 0xcc, 0x3b, 0x28, 0xa2, 0x87, 0x18, 0x24, 0x3f, 0x84, 0xab, 0xa8,
+	0xab, 0x65, 0x0b, 0x6e, 0xe2, 0xb4, 0xcb, 0x3a, 0x7a, 0x58, 0xb3, 0xe1, 0xd1, 0x9f, 0x1d, 0x70,
+	0xb5, 0xa5, 0x3d, 0x57, 0x5c, 0xa0, 0x49, 0x9a, 0x35, 0x86, 0x49, 0x6c, 0x3b, 0x47, 0xd7, 0x00,
+	0x47, 0xb1, 0x3e, 0x24, 0xda, 0x15, 0xc3, 0x39, 0x9d, 0xcc, 0x98, 0xed, 0x19, 0xae, 0x46, 0x5e,
+	0x68, 0xa0, 0x76, 0x86, 0x9a, 0xf5, 0x33, 0x54, 0x53, 0x66, 0x6b, 0x49, 0x99, 0x0b, 0x5d, 0x6b,
+	0xad, 0xd6, 0xb5, 0xf6, 0x3f, 0x46, 0x6a, 0x6e, 0xfb, 0x5d, 0xe8, 0x55, 0xd6, 0x48, 0xd6, 0x3d,
+	0xa7, 0xfa, 0xda, 0xd1, 0x2f, 0x1b, 0xb0, 0x51, 0x73, 0x35, 0xed, 0x04, 0xa5, 0x55, 0xd2, 0x38,
+	0x16, 0x4c, 0x4a, 0xbb, 0x39, 0xaf, 0x08, 0x3c, 0x30, 0x38, 0xd9, 0x06, 0x10, 0x34, 0x8b, 0x79,
+	0x9a, 0xe9, 0x2c, 0xb3, 0xc9, 0x0a, 0xa2, 0x9b, 0xe2, 0x54, 0x70, 0x7e, 0x9a, 0x37, 0x45, 0x1c,
+	0xe8, 0x93, 0x79, 0x32, 0xe1, 0xd1, 0x79, 0x5e, 0xf1, 0x16, 0xee, 0xbe, 0x87, 0x98, 0x95, 0xe8,
+	0x4d, 0x70, 0x55, 0x92, 0x32, 0xa9, 0x68, 0x3a, 0xb5, 0x46, 0x5a, 0x02, 0xfb, 0x4f, 0x70, 0x7b,
+	0x87, 0xfe, 0x1a, 0x0c, 0x61, 0x7b, 0x69, 0xb5, 0x77, 0xaa, 0x0f, 0x27, 0x9e, 0xe7, 0x2c, 0xbe,
+	0x8e, 0x0c, 0xbc, 0x46, 0xe5, 0xe9, 0xa3, 0xdf, 0x3b, 0x45, 0xd3, 0x61, 0xf1, 0xc1, 0xcb, 0x63,
+	0xa3, 0xac, 0xaf, 0x27, 0xf7, 0x63, 0x58, 0x2f, 0x75, 0x8a, 0x0d, 0xcd, 0xec, 0x7d, 0x50, 0xa0,
+	0xba, 0x9f, 0xfd, 0x97, 0x3b, 0xc1, 0x7b, 0xb9, 0xfd, 0x00, 0xdc, 0x64, 0x7a, 0x2a, 0xc3, 0x31,
+	0x95, 0x63, 0xcb, 0x6d, 0x57, 0x03, 0x8f, 0xa9, 0x1c, 0x8f, 0x7e, 0xe5, 0x40, 0xef, 0x30, 0x8b,
+	0x26, 0x74, 0xce, 0xf0, 0x1d, 0x1f, 0x42, 0x7f, 0x8a, 0xdd, 0x96, 0x99, 0x85, 0x98, 0xee, 0xd8,
+	0xb3, 0x18, 0xa6, 0xdc, 0x02, 0x98, 0xce, 0x4e, 0x26, 0x49, 0x84, 0xed, 0xd3, 0x4a, 0xd1, 0x20,
+	0xba, 0x7b, 0xde, 0x02, 0x60, 0xe6, 0x81, 0x7a, 0xab, 0x4d, 0xa3, 0x25, 0x8b, 0x1c, 0xc5, 0xc4,
+	0x87, 0x4e, 0x75, 0xa9, 0xcd, 0x20, 0x1f, 0x8e, 0xfe, 0xe6, 0xc0, 0xe5, 0x83, 0x97, 0xc7, 0x4f,
+	0x99, 0xd4, 0x8d, 0xe4, 0x80, 0xc9, 0x48, 0x24, 0x53, 0xc5, 0x85, 0x2e, 0x0f, 0x36, 0x91, 0x53,
+	0x1a, 0xb1, 0x30, 0xa3, 0x29, 0xb3, 0x05, 0x1c, 0x14, 0xe8, 0x31, 0x4d, 0x99, 0xf6, 0xbb, 0x94,
+	0xa9, 0x31, 0x8f, 0x71, 0x4d, 0x6e, 0x60, 0x47, 0x5a, 0x82, 0xa9, 0x79, 0x66, 0x58, 0x8a, 0xc0,
+	0xac, 0xcb, 0xb3, 0x81, 0xcf, 0x73, 0x5c, 0xdf, 0xb0, 0xf0, 0xa6, 0x12, 0x25, 0x31, 0xae, 0xcf,
+	0x0d, 0x3a, 0x7a, 0xfc, 0x30, 0x89, 0x8b, 0x4b, 0x8c, 0x4c, 0xde, 0x30, 0x2b, 0x22, 0xcc, 0x7d,
+	0x9e, 0xbc, 0x41, 0x16, 0x30, 0x78, 0xca, 0x45, 0x4a, 0x8d, 0xa5, 0xba, 0x01, 0x68, 0xe8, 0x11,
+	0x22, 0xa3, 0x2f, 0xdb, 0xe0, 0xfe, 0x8f, 0x72, 0xb8, 0x0a, 0x6d, 0x45, 0xc5, 0x19, 0x53, 0xf9,
+	0x46, 0xcc, 0x88, 0xfc, 0x18, 0x20, 0x2e, 0xaa, 0x82, 0x3b, 0xe8, 0xdd, 0xbb, 0x99, 0x5f, 0x27,
+	0x56, 0x55, 0x2e, 0xa8, 0xe4, 0x93, 0x6f, 0xc1, 0x40, 0xdf, 0x15, 0xb9, 0x48, 0xde, 0x98, 0xbb,
+	0x82, 0xd9, 0xde, 0x22, 0x48, 0x08, 0xb4, 0x90, 0xf7, 0x35, 0xa4, 0x15, 0x7f, 0x93, 0x1b, 0xd0,
+	0xc5, 0x2b, 0x6f, 0xc4, 0x27, 0x76, 0x63, 0xc5, 0x58, 0x37, 0x93, 0xfc, 0x77, 0x38, 0xa5, 0x6a,
+	0x8c, 0x4d, 0xc2, 0x0d, 0xfa, 0x39, 0xf8, 0x8c, 0xaa, 0x31, 0x76, 0xa2, 0x68, 0xcc, 0x52, 0x8a,
+	0x8d, 0xc1, 0x0d, 0xec, 0x48, 0x57, 0x61, 0x4a, 0xf1, 0x22, 0x96, 0x98, 0x86, 0xa0, 0x9f, 0x8c,
+	0xc0, 0x51, 0xac, 0xcf, 0x2c, 0x8a, 0x4a, 0x8e, 0x59, 0x8c, 0x77, 0x87, 0x6e, 0x50, 0x02, 0x64,
+	0x08, 0x3d, 0xaa, 0x94, 0x26, 0x0d, 0xf7, 0xd2, 0xc3, 0xc9, 0x55, 0x48, 0x9b, 0x49, 0xe9, 0xc6,
+	0x78, 0x5b, 0x70, 0x83, 0x0a, 0x42, 0xee, 0xc1, 0x95, 0xfa, 0xb5, 0x53, 0xaf, 0x8a, 0xf9, 0x03,
+	0x4c, 0xdd, 0x5a, 0xbc, 0x78, 0x62, 0xa8, 0x66, 0xb3, 0xeb, 0x75, 0x9b, 0xbd, 0x05, 0x30, 0x9b,
+	0xc6, 0x79, 0x78, 0xc3, 0x84, 0x2d, 0xf2, 0x40, 0x69, 0x1d, 0xe7, 0xb3, 0xad, 0x55, 0x79, 0x98,
+	0x32, 0xb0, 0xa8, 0x35, 0xab, 0x27, 0xb0, 0x55, 0x69, 0x23, 0xa9, 0xfd, 0xb0, 0xf0, 0x37, 0x91,
+	0xef, 0x1b, 0x39, 0xdf, 0xcb, 0x9f, 0x1e, 0x01, 0x61, 0x4b, 0x98, 0x3e, 0xcf, 0x89, 0x2c, 0xbb,
+	0xa0, 0x4f, 0xb0, 0x90, 0xbd, 0x44, 0x16, 0x1e, 0x85, 0x07, 0x56, 0xb7, 0x54, 0x26, 0xf5, 0xaa,
+	0xb7, 0xcc, 0xaa, 0x2d, 0xf2, 0xc0, 0x5c, 0xb7, 0x95, 0xa2, 0xd1, 0x38, 0xd5, 0x44, 0x45, 0x49,
+	0x2c, 0xfd, 0xcb, 0x78, 0xdd, 0x5d, 0x2f, 0xe1, 0x87, 0x49, 0x2c, 0xf7, 0x7f, 0x86, 0x36, 0xfa,
+	0xc8, 0x77, 0x16, 0xbb, 0xc4, 0x16, 0x6c, 0x18, 0xfd, 0xde, 0xc9, 0x35, 0xe1, 0x39, 0xe6, 0xda,
+	0x65, 0x61, 0x2b, 0x86, 0x81, 0xd7, 0xac, 0xc8, 0x61, 0xf4, 0x4f, 0x07, 0x7a, 0x07, 0x2f, 0x8f,
+	0x9f, 0xe5, 0x42, 0x2b, 0x0f, 0x85, 0xb3, 0x70, 0x28, 0xd0, 0xb0, 0xac, 0x00, 0x67, 0x22, 0xb1,
+	0x47, 0xa6, 0x97, 0x63, 0x5f, 0x88, 0x44, 0x2b, 0x21, 0x66, 0xa7, 0x78, 0x6d, 0xe1, 0x99, 0x35,
+	0xcf, 0x0a, 0xb2, 0xa8, 0xb4, 0x56, 0x5d, 0x69, 0x8b, 0x9c, 0xaf, 0xd5, 0x39, 0x5f, 0x26, 0xb5,
+	0xbd, 0x82, 0xd4, 0xfd, 0xdb, 0x58, 0x9c, 0xeb, 0x7e, 0x03, 0xae, 0xc0, 0x56, 0xad, 0x1e, 0x7a,
+	0xdd, 0xa3, 0x7f, 0x34, 0x61, 0xa0, 0xf7, 0xcb, 0x44, 0x9a, 0xc8, 0xfc, 0x2b, 0x63, 0x5a, 0x8c,
+	0x4a, 0x9f, 0xe8, 0x97, 0xa0, 0xb1, 0xd3, 0x33, 0x41, 0x33, 0x6d, 0x08, 0x66, 0xe7, 0xf9, 0xb0,
+	0x88, 0x30, 0x66, 0xcd, 0x2e, 0x1f, 0x56, 0x4a, 0xd9, 0x5a, 0x28, 0xe5, 0xb2, 0xcf, 0xae, 0x7d,
+	0xbd, 0xcf, 0xb6, 0x17, 0x7c, 0xb6, 0x6a, 0x13, 0x9d, 0x9a, 0x4d, 0x2c, 0xf8, 0x5d, 0xb7, 0xe6,
+	0x77, 0xdb, 0x00, 0x11, 0xcf, 0xcc, 0xa7, 0x9b, 0xb4, 0x9f, 0x87, 0x15, 0xa4, 0x26, 0x50, 0xa8,
+	0x0b, 0xf4, 0x3d, 0xdf, 0x0f, 0x3e, 0x74, 0x04, 0x9b, 0xf3, 0x73, 0x16, 0xa3, 0x09, 0x74, 0x83,
+	0x7c, 0xb8, 0x82, 0xba, 0xc1, 0x2a, 0xea, 0x9e, 0x21, 0x75, 0x3f, 0xf5, 0x9b, 0xb0, 0x51, 0x63,
+	0x44, 0x6b, 0xdb, 0x96, 0xfb, 0x8e, 0x2d, 0xae, 0xe7, 0x90, 0xa1, 0xd7, 0x80, 0x9b, 0x96, 0xe2,
+	0xc5, 0x7a, 0xde, 0x31, 0x95, 0x1a, 0xfd, 0xab, 0x01, 0xf0, 0x82, 0xce, 0x26, 0xca, 0x7c, 0x90,
+	0x5e, 0x87, 0xee, 0x5c, 0x8f, 0x4a, 0x8e, 0x3b, 0x38, 0x3e, 0x8a, 0x75, 0xcb, 0xe7, 0xaf, 0x32,
+	0x96, 0x93, 0x6b, 0x06, 0xe4, 0x53, 0xe8, 0xe7, 0x2d, 0x16, 0xad, 0xc1, 0xb4, 0x82, 0xad, 0x8a,
+	0x35, 0xe4, 0xfd, 0x3c, 0xe8, 0xb1, 0x72, 0x50, 0xeb, 0xdc, 0xad, 0x15, 0x9d, 0xfb, 0x3d, 0x4a,
+	0x37, 0xdf, 0x4e, 0xec, 0x54, 0x30, 0x3c, 0x2b, 0x56, 0xe9, 0x1a, 0x0d, 0x72, 0x70, 0x45, 0x55,
+	0x3b, 0xff, 0x87, 0xcb, 0x75, 0xbf, 0x89, 0xcb, 0x15, 0xa7, 0xab, 0x05, 0x50, 0xd6, 0x92, 0xb8,
+	0x9e, 0x63, 0xcb, 0xf7, 0x93, 0xfb, 0x7f, 0x7a, 0xbb, 0xed, 0x7c, 0xf5, 0x76, 0xdb, 0xf9, 0xfb,
+	0xdb, 0x6d, 0xe7, 0x37, 0xef, 0xb6, 0x2f, 0x7d, 0xf5, 0x6e, 0xfb, 0xd2, 0x5f, 0xdf, 0x6d, 0x5f,
+	0xfa, 0xf9, 0x47, 0x67, 0x89, 0x1a, 0xcf, 0x4e, 0x76, 0x23, 0x9e, 0xee, 0x49, 0x9e, 0x89, 0xef,
+	0x25, 0x1c, 0xff, 0xee, 0xbd, 0xde, 0xd3, 0xff, 0x15, 0x52, 0x17, 0x53, 0x26, 0x4f, 0xda, 0x28,
+	0xe4, 0x1f, 0xfc, 0x67, 0x00, 0xb2, 0x0e, 0x3d, 0x26, 0x29, 0x12, 0x00, 0x00,
 }
 
 func (m *EncryptionMetadata) Marshal() (dAtA []byte, err error) {
@@ -2204,6 +2223,24 @@ func (m *DWNRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttachmentCids) > 0 {
+		for iNdEx := len(m.AttachmentCids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AttachmentCids[iNdEx])
+			copy(dAtA[i:], m.AttachmentCids[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.AttachmentCids[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.IsEncrypted {
 		i--
 		if m.IsEncrypted {
@@ -2989,6 +3026,15 @@ func (m *DWNRecord) Size() (n int) {
 	if m.IsEncrypted {
 		n += 3
 	}
+	if m.ExpiresAt != 0 {
+		n += 2 + sovState(uint64(m.ExpiresAt))
+	}
+	if len(m.AttachmentCids) > 0 {
+		for _, s := range m.AttachmentCids {
+			l = len(s)
+			n += 2 + l + sovState(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5703,6 +5749,57 @@ func (m *DWNRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsEncrypted = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachmentCids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttachmentCids = append(m.AttachmentCids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
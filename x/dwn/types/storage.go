@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StorageAccountPrefix is the store prefix for the metered storage of each DID
+var StorageAccountPrefix = collections.NewPrefix(2)
+
+// BytesPerMiB is the unit storage is purchased in
+const BytesPerMiB uint64 = 1 << 20
+
+const (
+	// DefaultFreeQuotaBytes matches the default profile quota of the upload
+	// policy
+	DefaultFreeQuotaBytes uint64 = 64 << 20
+	// DefaultMaxPurchaseMiB caps a single purchase at 10 GiB
+	DefaultMaxPurchaseMiB uint64 = 10 << 10
+)
+
+// DefaultStoragePricePerMiB is the default price of one MiB of quota
+var DefaultStoragePricePerMiB = sdk.NewInt64Coin("usnr", 1000)
+
+// DefaultStorageParams returns the default storage quota and pricing
+func DefaultStorageParams() StorageParams {
+	return StorageParams{
+		FreeQuotaBytes: DefaultFreeQuotaBytes,
+		PricePerMib:    DefaultStoragePricePerMiB,
+		MaxPurchaseMib: DefaultMaxPurchaseMiB,
+	}
+}
+
+// Enabled reports whether storage is metered against a quota
+func (p StorageParams) Enabled() bool {
+	return p.FreeQuotaBytes > 0
+}
+
+// Quota returns the bytes an account may store, zero when metering is
+// disabled
+func (p StorageParams) Quota(account StorageAccount) uint64 {
+	if !p.Enabled() {
+		return 0
+	}
+	if account.PurchasedBytes > math.MaxUint64-p.FreeQuotaBytes {
+		return math.MaxUint64
+	}
+	return p.FreeQuotaBytes + account.PurchasedBytes
+}
+
+// Validate checks the quota and pricing. Pricing is only required when
+// metering is enabled.
+func (p StorageParams) Validate() error {
+	if !p.Enabled() {
+		return nil
+	}
+	if err := p.PricePerMib.Validate(); err != nil {
+		return fmt.Errorf("invalid storage price: %w", err)
+	}
+	if !p.PricePerMib.IsPositive() {
+		return fmt.Errorf("storage price must be positive when metering is enabled")
+	}
+	if p.MaxPurchaseMib == 0 {
+		return fmt.Errorf("max storage purchase must be positive when metering is enabled")
+	}
+	if p.MaxPurchaseMib > math.MaxUint64/BytesPerMiB {
+		return fmt.Errorf("max storage purchase cannot exceed %d MiB", math.MaxUint64/BytesPerMiB)
+	}
+	return nil
+}
+
+// Validate checks a genesis storage account
+func (a StorageAccount) Validate() error {
+	if a.Did == "" {
+		return ErrDIDEmpty
+	}
+	if a.RecordCount == 0 && a.RecordBytes > 0 {
+		return fmt.Errorf("storage account %s has record bytes but no records", a.Did)
+	}
+	return nil
+}
+
+// NewStorageUsage combines an account with the bytes its DID has pinned to
+// IPFS
+func NewStorageUsage(params StorageParams, account StorageAccount, pinned uint64) StorageUsage {
+	return StorageUsage{
+		Did:            account.Did,
+		RecordBytes:    account.RecordBytes,
+		RecordCount:    account.RecordCount,
+		IpfsBytes:      pinned,
+		UsedBytes:      account.RecordBytes + pinned,
+		PurchasedBytes: account.PurchasedBytes,
+		QuotaBytes:     params.Quota(account),
+	}
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+func TestStorageParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultStorageParams().Validate())
+
+	// Pricing is ignored while metering is disabled
+	require.NoError(t, types.StorageParams{}.Validate())
+
+	params := types.DefaultStorageParams()
+	params.PricePerMib = sdk.NewInt64Coin("usnr", 0)
+	require.ErrorContains(t, params.Validate(), "price must be positive")
+
+	params = types.DefaultStorageParams()
+	params.MaxPurchaseMib = 0
+	require.ErrorContains(t, params.Validate(), "max storage purchase")
+}
+
+func TestStorageQuota(t *testing.T) {
+	params := types.DefaultStorageParams()
+	account := types.StorageAccount{Did: "did:sonr:alice", PurchasedBytes: 3 * types.BytesPerMiB}
+	require.Equal(t, types.DefaultFreeQuotaBytes+3*types.BytesPerMiB, params.Quota(account))
+	require.Zero(t, types.StorageParams{}.Quota(account))
+}
+
+func TestGenesisStorageAccounts(t *testing.T) {
+	gs := types.DefaultGenesis()
+	gs.StorageAccounts = []types.StorageAccount{
+		{Did: "did:sonr:alice", RecordBytes: 10, RecordCount: 1},
+		{Did: "did:sonr:bob", PurchasedBytes: types.BytesPerMiB},
+	}
+	require.NoError(t, gs.Validate())
+
+	gs.StorageAccounts = append(gs.StorageAccounts, types.StorageAccount{Did: "did:sonr:alice"})
+	require.ErrorContains(t, gs.Validate(), "duplicate storage account")
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -149,6 +150,11 @@ type MsgRecordsWrite struct {
 	Encryption string `protobuf:"bytes,11,opt,name=encryption,proto3" json:"encryption,omitempty"`
 	// Optional attestation
 	Attestation string `protobuf:"bytes,12,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// Optional time to live in seconds; zero keeps the record until deleted
+	Ttl int64 `protobuf:"varint,13,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// CIDs of attachments pinned for the record, unpinned once no record
+	// references them
+	AttachmentCids []string `protobuf:"bytes,14,rep,name=attachment_cids,json=attachmentCids,proto3" json:"attachment_cids,omitempty"`
 }
 
 func (m *MsgRecordsWrite) Reset()         { *m = MsgRecordsWrite{} }
@@ -268,12 +274,28 @@ func (m *MsgRecordsWrite) GetAttestation() string {
 	return ""
 }
 
+func (m *MsgRecordsWrite) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *MsgRecordsWrite) GetAttachmentCids() []string {
+	if m != nil {
+		return m.AttachmentCids
+	}
+	return nil
+}
+
 // MsgRecordsWriteResponse defines the response for RecordsWrite
 type MsgRecordsWriteResponse struct {
 	// Record ID of the created/updated record
 	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 	// CID of the data
 	DataCid string `protobuf:"bytes,2,opt,name=data_cid,json=dataCid,proto3" json:"data_cid,omitempty"`
+	// Expiry of the record (Unix timestamp), zero if it does not expire
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *MsgRecordsWriteResponse) Reset()         { *m = MsgRecordsWriteResponse{} }
@@ -323,6 +345,13 @@ func (m *MsgRecordsWriteResponse) GetDataCid() string {
 	return ""
 }
 
+func (m *MsgRecordsWriteResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // MsgRecordsDelete deletes a record from the DWN
 type MsgRecordsDelete struct {
 	// Author requesting deletion (DID or cosmos address)
@@ -1062,6 +1091,125 @@ func (m *MsgRotateVaultKeysResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgPurchaseStorage buys additional storage quota for a DID
+type MsgPurchaseStorage struct {
+	// Account paying for the storage
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// DID receiving the quota
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// Quota to add, in MiB
+	SizeMib uint64 `protobuf:"varint,3,opt,name=size_mib,json=sizeMib,proto3" json:"size_mib,omitempty"`
+}
+
+func (m *MsgPurchaseStorage) Reset()         { *m = MsgPurchaseStorage{} }
+func (m *MsgPurchaseStorage) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseStorage) ProtoMessage()    {}
+func (*MsgPurchaseStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32d2464465560de7, []int{14}
+}
+func (m *MsgPurchaseStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseStorage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPurchaseStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseStorage.Merge(m, src)
+}
+func (m *MsgPurchaseStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurchaseStorage proto.InternalMessageInfo
+
+func (m *MsgPurchaseStorage) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *MsgPurchaseStorage) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgPurchaseStorage) GetSizeMib() uint64 {
+	if m != nil {
+		return m.SizeMib
+	}
+	return 0
+}
+
+// MsgPurchaseStorageResponse defines the response for PurchaseStorage
+type MsgPurchaseStorageResponse struct {
+	// Total quota of the DID after the purchase, in bytes
+	QuotaBytes uint64 `protobuf:"varint,1,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// Amount charged
+	Cost types.Coin `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost"`
+}
+
+func (m *MsgPurchaseStorageResponse) Reset()         { *m = MsgPurchaseStorageResponse{} }
+func (m *MsgPurchaseStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseStorageResponse) ProtoMessage()    {}
+func (*MsgPurchaseStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32d2464465560de7, []int{15}
+}
+func (m *MsgPurchaseStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPurchaseStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseStorageResponse.Merge(m, src)
+}
+func (m *MsgPurchaseStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurchaseStorageResponse proto.InternalMessageInfo
+
+func (m *MsgPurchaseStorageResponse) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+func (m *MsgPurchaseStorageResponse) GetCost() types.Coin {
+	if m != nil {
+		return m.Cost
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dwn.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dwn.v1.MsgUpdateParamsResponse")
@@ -1077,84 +1225,97 @@ func init() {
 	proto.RegisterType((*MsgPermissionsRevokeResponse)(nil), "dwn.v1.MsgPermissionsRevokeResponse")
 	proto.RegisterType((*MsgRotateVaultKeys)(nil), "dwn.v1.MsgRotateVaultKeys")
 	proto.RegisterType((*MsgRotateVaultKeysResponse)(nil), "dwn.v1.MsgRotateVaultKeysResponse")
+	proto.RegisterType((*MsgPurchaseStorage)(nil), "dwn.v1.MsgPurchaseStorage")
+	proto.RegisterType((*MsgPurchaseStorageResponse)(nil), "dwn.v1.MsgPurchaseStorageResponse")
 }
 
 func init() { proto.RegisterFile("dwn/v1/tx.proto", fileDescriptor_32d2464465560de7) }
 
 var fileDescriptor_32d2464465560de7 = []byte{
-	// 1139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xce, 0xc6, 0x8e, 0x3f, 0x4e, 0xec, 0xa4, 0xef, 0xbc, 0x81, 0x6e, 0x36, 0x89, 0x6b, 0x9c,
-	0x06, 0x45, 0x15, 0x8d, 0x69, 0x90, 0x10, 0x8a, 0xe0, 0xa2, 0x49, 0x24, 0x1a, 0x45, 0xa9, 0xd2,
-	0x85, 0x36, 0xa2, 0x42, 0xb2, 0x36, 0x3b, 0x93, 0xf5, 0xaa, 0xf1, 0xce, 0x6a, 0x66, 0x9c, 0xc4,
-	0x5c, 0x55, 0x48, 0x88, 0x5b, 0x7e, 0x04, 0xdc, 0x57, 0x02, 0xf1, 0x1b, 0x2a, 0x71, 0x53, 0x71,
-	0xc5, 0x15, 0x82, 0xe4, 0xa2, 0x7f, 0x03, 0xcd, 0xcc, 0xee, 0x7a, 0x77, 0xed, 0xa4, 0xa8, 0x7c,
-	0x48, 0x5c, 0x79, 0xce, 0x73, 0xce, 0x3c, 0x73, 0xe6, 0x7c, 0xed, 0x18, 0x66, 0xf1, 0x69, 0xd0,
-	0x3e, 0xb9, 0xd3, 0x16, 0x67, 0x6b, 0x21, 0xa3, 0x82, 0xa2, 0x12, 0x3e, 0x0d, 0xd6, 0x4e, 0xee,
-	0x58, 0xd7, 0x5d, 0xca, 0x7b, 0x94, 0xb7, 0x7b, 0xdc, 0x93, 0xfa, 0x1e, 0xf7, 0xb4, 0x81, 0x35,
-	0xaf, 0x15, 0x1d, 0x25, 0xb5, 0xb5, 0x10, 0xa9, 0xe6, 0x22, 0x32, 0x8f, 0x04, 0x84, 0xfb, 0x31,
-	0x8a, 0x22, 0x94, 0x0b, 0x47, 0x90, 0xd8, 0xd2, 0xa3, 0x1e, 0xd5, 0x0c, 0x72, 0xa5, 0xd1, 0xd6,
-	0xd7, 0x06, 0xcc, 0xee, 0x71, 0xef, 0x61, 0x88, 0x1d, 0x41, 0xf6, 0x1d, 0xe6, 0xf4, 0x38, 0x7a,
-	0x1f, 0xaa, 0x4e, 0x5f, 0x74, 0x29, 0xf3, 0xc5, 0xc0, 0x34, 0x9a, 0xc6, 0x6a, 0x75, 0xd3, 0xfc,
-	0xf9, 0x87, 0xdb, 0x73, 0xd1, 0xc1, 0x77, 0x31, 0x66, 0x84, 0xf3, 0x4f, 0x04, 0xf3, 0x03, 0xcf,
-	0x1e, 0x9a, 0xa2, 0x77, 0xa0, 0x14, 0x2a, 0x06, 0x73, 0xb2, 0x69, 0xac, 0x4e, 0xaf, 0xcf, 0xac,
-	0xe9, 0x8b, 0xad, 0x69, 0xde, 0xcd, 0xe2, 0xf3, 0x5f, 0x6f, 0x4c, 0xd8, 0x91, 0xcd, 0xc6, 0xcc,
-	0x97, 0x2f, 0x9f, 0xdd, 0x1a, 0xee, 0x6e, 0xcd, 0xc3, 0xf5, 0x9c, 0x23, 0x36, 0xe1, 0x21, 0x0d,
-	0x38, 0x69, 0xfd, 0x58, 0x50, 0x4e, 0xda, 0xc4, 0xa5, 0x0c, 0xf3, 0x03, 0xe6, 0x0b, 0x82, 0xde,
-	0x85, 0x92, 0xde, 0xfb, 0x4a, 0x0f, 0x23, 0x3b, 0xf4, 0x26, 0x94, 0x84, 0xc3, 0x3c, 0x22, 0x94,
-	0x7b, 0x55, 0x3b, 0x92, 0xd0, 0x87, 0x00, 0x98, 0x70, 0x97, 0xf9, 0xa1, 0xa0, 0xcc, 0x2c, 0x28,
-	0xd7, 0x17, 0x63, 0xd7, 0xb7, 0x0f, 0xee, 0xef, 0x11, 0xce, 0x1d, 0x8f, 0x6c, 0x27, 0x36, 0x76,
-	0xca, 0x1e, 0xdd, 0x84, 0x7a, 0x74, 0x87, 0x2f, 0x1c, 0xe1, 0xd3, 0xc0, 0x2c, 0x2a, 0xf2, 0x2c,
-	0x88, 0x10, 0x14, 0xb1, 0x23, 0x1c, 0x73, 0xaa, 0x69, 0xac, 0xd6, 0x6c, 0xb5, 0x46, 0x16, 0x54,
-	0x54, 0x0e, 0x5c, 0x7a, 0x6c, 0x96, 0xd4, 0xa6, 0x44, 0x46, 0xcb, 0x50, 0x8f, 0xd7, 0x9d, 0xd0,
-	0x11, 0x5d, 0xb3, 0xac, 0x0c, 0x6a, 0x31, 0xb8, 0xef, 0x88, 0xae, 0xbc, 0x10, 0x77, 0xbb, 0xa4,
-	0xe7, 0x98, 0x15, 0x7d, 0x21, 0x2d, 0xa1, 0x05, 0xa8, 0x86, 0x0e, 0x23, 0x81, 0xe8, 0xf8, 0xd8,
-	0xac, 0x46, 0xcc, 0x0a, 0xd8, 0xc1, 0x68, 0x11, 0xaa, 0x61, 0xff, 0xf0, 0xd8, 0xe7, 0x5d, 0x82,
-	0x4d, 0x68, 0x1a, 0xab, 0x15, 0x7b, 0x08, 0xa0, 0x06, 0x00, 0x09, 0x5c, 0x36, 0x08, 0xd5, 0x55,
-	0xa6, 0xd5, 0xde, 0x14, 0x82, 0x9a, 0x30, 0xed, 0x08, 0x41, 0x64, 0x5d, 0x49, 0x83, 0x9a, 0x32,
-	0x48, 0x43, 0x1b, 0xd3, 0x32, 0xad, 0x51, 0xc8, 0x5b, 0x0f, 0x54, 0x4e, 0xd3, 0x79, 0x8b, 0x73,
-	0x2a, 0x9d, 0x64, 0x0a, 0x97, 0x4e, 0x1a, 0xda, 0x49, 0x0d, 0xec, 0x60, 0x34, 0x0f, 0x15, 0x19,
-	0xa2, 0x8e, 0xeb, 0xe3, 0x28, 0x59, 0x65, 0x29, 0x6f, 0xf9, 0xb8, 0xf5, 0x74, 0x12, 0xae, 0x0d,
-	0x39, 0xb7, 0xc9, 0x31, 0xf9, 0x5b, 0x8b, 0x21, 0xe3, 0x56, 0x21, 0xe7, 0x56, 0xb6, 0x52, 0x8a,
-	0x7f, 0xb5, 0x52, 0xa6, 0xc6, 0x55, 0xca, 0x1c, 0x4c, 0x85, 0xac, 0x1f, 0x10, 0x55, 0x12, 0x15,
-	0x5b, 0x0b, 0xd9, 0xa8, 0x7e, 0x06, 0x66, 0x3e, 0x02, 0x49, 0x58, 0x4d, 0x28, 0xf3, 0xbe, 0xeb,
-	0x12, 0xce, 0x55, 0x28, 0x2a, 0x76, 0x2c, 0xca, 0x92, 0xc2, 0xca, 0x16, 0x77, 0x5c, 0xda, 0x0f,
-	0xf4, 0xc5, 0xa7, 0xec, 0x5a, 0x04, 0x6e, 0x49, 0xac, 0xf5, 0xfd, 0x24, 0xbc, 0xb1, 0xc7, 0xbd,
-	0xfd, 0xa8, 0xcc, 0xf8, 0x16, 0x0d, 0x8e, 0x7c, 0xaf, 0xcf, 0xfe, 0x6b, 0xfd, 0xf6, 0x16, 0x24,
-	0xad, 0xd2, 0xe9, 0x33, 0x3f, 0x0a, 0xf5, 0x74, 0x8c, 0x3d, 0x64, 0xbe, 0x2c, 0x75, 0x4c, 0x8e,
-	0xfc, 0xc0, 0x57, 0x2c, 0x25, 0xd5, 0x98, 0x29, 0x24, 0xdb, 0x28, 0xe5, 0x5c, 0xa3, 0x64, 0x13,
-	0xf2, 0x39, 0x2c, 0x8d, 0x0d, 0x5a, 0x92, 0x95, 0xbc, 0x3b, 0xc6, 0xa8, 0x3b, 0xa9, 0xc4, 0x4d,
-	0x66, 0x12, 0xd7, 0xfa, 0xb6, 0x00, 0xff, 0x97, 0xf4, 0x84, 0xf5, 0x7c, 0xce, 0x7d, 0x1a, 0xf0,
-	0x8f, 0x99, 0x13, 0x08, 0xb4, 0x0e, 0x65, 0x4f, 0x2e, 0xfe, 0x44, 0x4a, 0x62, 0x43, 0x79, 0x8a,
-	0x5a, 0x12, 0x12, 0xf7, 0x55, 0x24, 0xa6, 0xb2, 0x55, 0xb8, 0x22, 0x5b, 0xff, 0x4c, 0xcd, 0xaf,
-	0xc0, 0x8c, 0x1f, 0x08, 0xc2, 0x8e, 0x1c, 0x97, 0x74, 0x02, 0xa7, 0x47, 0xa2, 0x79, 0x58, 0x4f,
-	0xd0, 0xfb, 0x4e, 0x4f, 0xb9, 0xd8, 0x23, 0xa2, 0x4b, 0x71, 0x34, 0x0d, 0x23, 0x29, 0x33, 0x48,
-	0x2b, 0xb9, 0x41, 0x9a, 0xe9, 0xe7, 0x6a, 0xae, 0x9f, 0x1b, 0x00, 0x2e, 0x0d, 0xb0, 0xca, 0x37,
-	0x57, 0xc3, 0xb0, 0x66, 0xa7, 0x10, 0xb4, 0x04, 0x40, 0xce, 0x42, 0x9f, 0x11, 0xde, 0x71, 0x84,
-	0x9a, 0x86, 0x05, 0xbb, 0x1a, 0x21, 0x77, 0xc5, 0x46, 0x4d, 0xd6, 0x40, 0x1c, 0xda, 0xd6, 0x26,
-	0x2c, 0x8c, 0xc9, 0x52, 0x52, 0x02, 0x72, 0xa2, 0x27, 0xba, 0xe1, 0xcc, 0xab, 0x0d, 0xc1, 0x1d,
-	0xdc, 0xfa, 0xdd, 0x80, 0xb9, 0x2c, 0x89, 0x4d, 0x4e, 0xe8, 0x13, 0xf2, 0x5a, 0xb9, 0x1e, 0x39,
-	0x71, 0x72, 0xf4, 0xc4, 0x7f, 0xa3, 0x19, 0x73, 0x71, 0xfa, 0x00, 0x16, 0xc7, 0x5d, 0xf1, 0xd5,
-	0x13, 0xac, 0xf5, 0x9d, 0x01, 0x48, 0x0e, 0x3e, 0x2a, 0x5f, 0x35, 0x8f, 0x9c, 0xfe, 0xb1, 0xd8,
-	0x25, 0x83, 0xd7, 0x7f, 0xae, 0xcc, 0x43, 0xe5, 0x44, 0x92, 0x0c, 0x43, 0x53, 0x56, 0xf2, 0x0e,
-	0x96, 0x95, 0xc6, 0x88, 0xc3, 0x69, 0x10, 0x37, 0x83, 0x96, 0xe4, 0x70, 0x3e, 0xa2, 0xcc, 0x25,
-	0xea, 0x9e, 0x15, 0x5b, 0x0b, 0x23, 0x2f, 0x99, 0xaf, 0x0c, 0xb0, 0x46, 0xfd, 0x4c, 0x2e, 0xb8,
-	0x02, 0x33, 0xea, 0x1c, 0xde, 0x61, 0xca, 0x42, 0x97, 0x42, 0xdd, 0xae, 0x6b, 0x54, 0x6f, 0xc3,
-	0xe8, 0x6d, 0x98, 0x0d, 0xc8, 0x69, 0xe7, 0x09, 0x19, 0x74, 0x4e, 0x08, 0x93, 0x91, 0x52, 0x5e,
-	0x16, 0xed, 0x7a, 0x40, 0x4e, 0x77, 0xc9, 0xe0, 0x91, 0x06, 0xd3, 0xf1, 0x2a, 0x64, 0xe2, 0xb5,
-	0xfe, 0x53, 0x11, 0x0a, 0x7b, 0xdc, 0x43, 0xf7, 0xa0, 0x96, 0x79, 0xdf, 0x5d, 0x8f, 0xf3, 0x9b,
-	0x7b, 0x6f, 0x59, 0x37, 0x2e, 0x51, 0x24, 0xae, 0xdf, 0x83, 0x5a, 0xe6, 0x11, 0x96, 0x66, 0x4a,
-	0x2b, 0x32, 0x4c, 0x63, 0x3f, 0xff, 0xbb, 0x50, 0xcf, 0x7e, 0xc2, 0xcd, 0xd1, 0x1d, 0x5a, 0x63,
-	0x35, 0x2f, 0xd3, 0x24, 0x64, 0x8f, 0x01, 0x8d, 0xf9, 0x62, 0x2d, 0xa5, 0xf6, 0x8d, 0xaa, 0xad,
-	0x95, 0x2b, 0xd5, 0x09, 0xf7, 0xa7, 0x70, 0x6d, 0x64, 0xf2, 0x2e, 0xa4, 0xb7, 0xe6, 0x94, 0xd6,
-	0xf2, 0x15, 0xca, 0x84, 0xf5, 0x00, 0xfe, 0x37, 0xda, 0xe4, 0x8b, 0xe3, 0x77, 0x6a, 0xad, 0x75,
-	0xf3, 0x2a, 0x6d, 0x42, 0xfc, 0x00, 0x66, 0xf3, 0xfd, 0x61, 0xa5, 0xe3, 0x97, 0xd5, 0x59, 0xad,
-	0xcb, 0x75, 0x31, 0xa5, 0x35, 0xf5, 0xf4, 0xe5, 0xb3, 0x5b, 0xc6, 0xe6, 0x47, 0xcf, 0xcf, 0x1b,
-	0xc6, 0x8b, 0xf3, 0x86, 0xf1, 0xdb, 0x79, 0xc3, 0xf8, 0xe6, 0xa2, 0x31, 0xf1, 0xe2, 0xa2, 0x31,
-	0xf1, 0xcb, 0x45, 0x63, 0xe2, 0xf1, 0xb2, 0xe7, 0x8b, 0x6e, 0xff, 0x70, 0xcd, 0xa5, 0xbd, 0x36,
-	0xa7, 0x01, 0xbb, 0xed, 0x53, 0xf5, 0xdb, 0x3e, 0x6b, 0xcb, 0xff, 0x21, 0x62, 0x10, 0x12, 0x7e,
-	0x58, 0x52, 0x23, 0xf9, 0xbd, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x12, 0x88, 0x9c, 0xfe,
-	0x0c, 0x00, 0x00,
+	// 1316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xdb, 0x6f, 0x1b, 0x45,
+	0x17, 0xcf, 0xc6, 0xf7, 0x63, 0x3b, 0xe9, 0x37, 0x5f, 0xbe, 0x2f, 0x9b, 0x6d, 0xea, 0x18, 0xb7,
+	0x85, 0xa8, 0xa2, 0x36, 0x69, 0x25, 0x84, 0x22, 0x78, 0x68, 0x52, 0x89, 0x46, 0x55, 0xaa, 0xb0,
+	0xa5, 0xad, 0xa8, 0x90, 0xac, 0xf1, 0xee, 0x64, 0x3d, 0x6a, 0xbc, 0xb3, 0xcc, 0x8c, 0x93, 0xba,
+	0x12, 0x52, 0x85, 0x84, 0x78, 0xe5, 0x2f, 0xe0, 0x09, 0xde, 0x2b, 0xc1, 0x1f, 0xd1, 0xc7, 0x8a,
+	0x27, 0x9e, 0x10, 0xb4, 0x0f, 0x7d, 0xe6, 0x3f, 0x40, 0x33, 0x7b, 0xf1, 0xee, 0x3a, 0x49, 0x51,
+	0xb9, 0x48, 0x3c, 0x79, 0xce, 0xef, 0x9c, 0x39, 0x73, 0xee, 0x7b, 0x0c, 0x8b, 0xee, 0x91, 0xdf,
+	0x3b, 0xdc, 0xe8, 0xc9, 0x87, 0xdd, 0x80, 0x33, 0xc9, 0x50, 0xd9, 0x3d, 0xf2, 0xbb, 0x87, 0x1b,
+	0x56, 0xcb, 0x61, 0x62, 0xc4, 0x44, 0x6f, 0x80, 0x05, 0xe9, 0x1d, 0x6e, 0x0c, 0x88, 0xc4, 0x1b,
+	0x3d, 0x87, 0x51, 0x3f, 0x94, 0xb3, 0x96, 0x23, 0xfe, 0x48, 0x78, 0xea, 0xfe, 0x48, 0x78, 0x11,
+	0x63, 0x25, 0x64, 0xf4, 0x35, 0xd5, 0x0b, 0x89, 0x88, 0xb5, 0x14, 0x3d, 0xe6, 0x11, 0x9f, 0x08,
+	0x1a, 0xa3, 0x28, 0x42, 0x85, 0xc4, 0x92, 0xc4, 0x92, 0x1e, 0xf3, 0x58, 0xa8, 0x41, 0x9d, 0x42,
+	0xb4, 0xf3, 0x95, 0x01, 0x8b, 0xbb, 0xc2, 0xbb, 0x13, 0xb8, 0x58, 0x92, 0x3d, 0xcc, 0xf1, 0x48,
+	0xa0, 0x77, 0xa1, 0x86, 0xc7, 0x72, 0xc8, 0x38, 0x95, 0x13, 0xd3, 0x68, 0x1b, 0xeb, 0xb5, 0x2d,
+	0xf3, 0xc7, 0x1f, 0x2e, 0x2f, 0x45, 0x0f, 0x5f, 0x73, 0x5d, 0x4e, 0x84, 0xb8, 0x2d, 0x39, 0xf5,
+	0x3d, 0x7b, 0x2a, 0x8a, 0xde, 0x86, 0x72, 0xa0, 0x35, 0x98, 0xf3, 0x6d, 0x63, 0xbd, 0x7e, 0x65,
+	0xa1, 0x1b, 0x3a, 0xde, 0x0d, 0xf5, 0x6e, 0x15, 0x9f, 0xfe, 0xbc, 0x36, 0x67, 0x47, 0x32, 0x9b,
+	0x0b, 0x5f, 0xbc, 0x7c, 0x72, 0x69, 0x7a, 0xbb, 0xb3, 0x02, 0xcb, 0x39, 0x43, 0x6c, 0x22, 0x02,
+	0xe6, 0x0b, 0xd2, 0xf9, 0xad, 0xa0, 0x8d, 0xb4, 0x89, 0xc3, 0xb8, 0x2b, 0xee, 0x71, 0x2a, 0x09,
+	0x7a, 0x07, 0xca, 0xe1, 0xdd, 0x57, 0x5a, 0x18, 0xc9, 0xa1, 0xff, 0x43, 0x59, 0x62, 0xee, 0x11,
+	0xa9, 0xcd, 0xab, 0xd9, 0x11, 0x85, 0xde, 0x07, 0x70, 0x89, 0x70, 0x38, 0x0d, 0x24, 0xe3, 0x66,
+	0x41, 0x9b, 0xbe, 0x1a, 0x9b, 0x7e, 0xfd, 0xde, 0xad, 0x5d, 0x22, 0x04, 0xf6, 0xc8, 0xf5, 0x44,
+	0xc6, 0x4e, 0xc9, 0xa3, 0x0b, 0xd0, 0x8c, 0x7c, 0x78, 0x84, 0x25, 0x65, 0xbe, 0x59, 0xd4, 0xca,
+	0xb3, 0x20, 0x42, 0x50, 0x74, 0xb1, 0xc4, 0x66, 0xa9, 0x6d, 0xac, 0x37, 0x6c, 0x7d, 0x46, 0x16,
+	0x54, 0x75, 0x0e, 0x1c, 0x76, 0x60, 0x96, 0xf5, 0xa5, 0x84, 0x46, 0xe7, 0xa1, 0x19, 0x9f, 0xfb,
+	0x01, 0x96, 0x43, 0xb3, 0xa2, 0x05, 0x1a, 0x31, 0xb8, 0x87, 0xe5, 0x50, 0x39, 0x24, 0x9c, 0x21,
+	0x19, 0x61, 0xb3, 0x1a, 0x3a, 0x14, 0x52, 0xe8, 0x2c, 0xd4, 0x02, 0xcc, 0x89, 0x2f, 0xfb, 0xd4,
+	0x35, 0x6b, 0x91, 0x66, 0x0d, 0xec, 0xb8, 0x68, 0x15, 0x6a, 0xc1, 0x78, 0x70, 0x40, 0xc5, 0x90,
+	0xb8, 0x26, 0xb4, 0x8d, 0xf5, 0xaa, 0x3d, 0x05, 0x50, 0x0b, 0x80, 0xf8, 0x0e, 0x9f, 0x04, 0xda,
+	0x95, 0xba, 0xbe, 0x9b, 0x42, 0x50, 0x1b, 0xea, 0x58, 0x4a, 0xa2, 0xea, 0x4a, 0x09, 0x34, 0xb4,
+	0x40, 0x1a, 0x42, 0x67, 0xa0, 0x20, 0xe5, 0x81, 0xd9, 0x6c, 0x1b, 0xeb, 0x05, 0x5b, 0x1d, 0xd1,
+	0x5b, 0xb0, 0x88, 0xa5, 0xc4, 0xce, 0x70, 0xa4, 0x4c, 0x72, 0xa8, 0x2b, 0xcc, 0x85, 0x76, 0x61,
+	0xbd, 0x66, 0x2f, 0x4c, 0xe1, 0x6d, 0xea, 0x8a, 0xcd, 0xba, 0xaa, 0x88, 0x28, 0x5b, 0x9d, 0x00,
+	0x96, 0x73, 0x29, 0x8f, 0xcb, 0x41, 0xf9, 0xc7, 0x35, 0xae, 0xfc, 0x33, 0x42, 0xff, 0x42, 0x60,
+	0xc7, 0x45, 0x2b, 0x50, 0x55, 0xd1, 0x55, 0xef, 0x44, 0x79, 0xae, 0x28, 0x7a, 0x9b, 0xba, 0xe8,
+	0x1c, 0x00, 0x79, 0x18, 0x50, 0x4e, 0x44, 0x1f, 0x4b, 0x9d, 0xe8, 0x82, 0x5d, 0x8b, 0x90, 0x6b,
+	0xb2, 0xf3, 0x78, 0x1e, 0xce, 0x4c, 0x9f, 0xbc, 0x4e, 0x0e, 0xc8, 0x5f, 0x5a, 0x66, 0x19, 0xab,
+	0x0b, 0x39, 0xab, 0xb3, 0x35, 0x58, 0xfc, 0xb3, 0x35, 0x58, 0x3a, 0xae, 0x06, 0x97, 0xa0, 0x14,
+	0xf0, 0xb1, 0x4f, 0x74, 0xb1, 0x55, 0xed, 0x90, 0xc8, 0x06, 0xfd, 0x13, 0x30, 0xf3, 0x11, 0x48,
+	0xa2, 0x6e, 0x42, 0x45, 0x8c, 0x1d, 0x87, 0x08, 0xa1, 0x43, 0x51, 0xb5, 0x63, 0x52, 0x15, 0xab,
+	0xab, 0x65, 0xdd, 0xbe, 0xc3, 0xc6, 0x7e, 0xe8, 0x78, 0xc9, 0x6e, 0x44, 0xe0, 0xb6, 0xc2, 0x3a,
+	0xdf, 0xcf, 0xc3, 0xff, 0x76, 0x85, 0xb7, 0x17, 0x15, 0xb0, 0xd8, 0x66, 0xfe, 0x3e, 0xf5, 0xc6,
+	0xfc, 0xdf, 0xd6, 0xc9, 0x6f, 0x40, 0xd2, 0x84, 0xfd, 0x31, 0xa7, 0x51, 0xa8, 0xeb, 0x31, 0x76,
+	0x87, 0x53, 0xd5, 0x44, 0x2e, 0xd9, 0xa7, 0x3e, 0xd5, 0x5a, 0xca, 0xba, 0xe5, 0x53, 0x48, 0xb6,
+	0x05, 0x2b, 0xb9, 0x16, 0xcc, 0x26, 0xe4, 0x53, 0x38, 0x77, 0x6c, 0xd0, 0x92, 0xac, 0xe4, 0xcd,
+	0x31, 0x66, 0xcd, 0x49, 0x25, 0x6e, 0x3e, 0x93, 0xb8, 0xce, 0xb7, 0x05, 0xf8, 0xaf, 0x52, 0x4f,
+	0xf8, 0x88, 0x0a, 0x41, 0x99, 0x2f, 0x3e, 0xe4, 0xd8, 0x97, 0xe8, 0x0a, 0x54, 0x3c, 0x75, 0xf8,
+	0x03, 0x29, 0x89, 0x05, 0xd5, 0x2b, 0xfa, 0x48, 0x48, 0xdc, 0x76, 0x11, 0x99, 0xca, 0x56, 0xe1,
+	0x94, 0x6c, 0xfd, 0x3d, 0x35, 0x7f, 0x11, 0x16, 0xa8, 0x2f, 0x09, 0xdf, 0xc7, 0x0e, 0xe9, 0xfb,
+	0x78, 0x44, 0xa2, 0x49, 0xdb, 0x4c, 0xd0, 0x5b, 0x78, 0xa4, 0x4d, 0x1c, 0x11, 0x39, 0x64, 0x6e,
+	0x34, 0x67, 0x23, 0x2a, 0x33, 0xa2, 0xab, 0xb9, 0x11, 0x9d, 0xe9, 0xe7, 0x5a, 0xae, 0x9f, 0x5b,
+	0x00, 0x0e, 0xf3, 0x5d, 0x9d, 0x6f, 0xa1, 0xc7, 0x6c, 0xc3, 0x4e, 0x21, 0xb9, 0x51, 0x54, 0xcf,
+	0x8d, 0xa2, 0xcd, 0x86, 0xaa, 0x81, 0x38, 0xb4, 0x9d, 0x2d, 0x38, 0x7b, 0x4c, 0x96, 0x92, 0x12,
+	0x50, 0xdf, 0x8a, 0x84, 0x37, 0x1d, 0x89, 0x8d, 0x29, 0xb8, 0xe3, 0x76, 0x7e, 0x35, 0x60, 0x29,
+	0xab, 0xc4, 0x26, 0x87, 0xec, 0x01, 0x79, 0xad, 0x5c, 0xcf, 0xbc, 0x38, 0x3f, 0xfb, 0xe2, 0x3f,
+	0xd1, 0x8c, 0xb9, 0x38, 0xbd, 0x07, 0xab, 0xc7, 0xb9, 0xf8, 0xea, 0x09, 0xd6, 0xf9, 0xce, 0x00,
+	0xa4, 0x06, 0x1f, 0x93, 0x58, 0x92, 0xbb, 0x78, 0x7c, 0x20, 0x6f, 0x92, 0xc9, 0xeb, 0x2f, 0x42,
+	0x2b, 0x50, 0x3d, 0x54, 0x4a, 0xa6, 0xa1, 0xa9, 0x68, 0x7a, 0xc7, 0x55, 0x95, 0xc6, 0x09, 0x16,
+	0xcc, 0x8f, 0x9b, 0x21, 0xa4, 0xd4, 0x70, 0xde, 0x67, 0xdc, 0x21, 0xda, 0xcf, 0xaa, 0x1d, 0x12,
+	0x33, 0x3b, 0xd2, 0x97, 0x06, 0x58, 0xb3, 0x76, 0x26, 0x0e, 0x5e, 0x84, 0x05, 0xfd, 0x8e, 0xe8,
+	0x73, 0x2d, 0x11, 0x96, 0x42, 0xd3, 0x6e, 0x86, 0x68, 0x78, 0xcd, 0x45, 0x6f, 0xc2, 0xa2, 0x4f,
+	0x8e, 0xfa, 0x0f, 0xc8, 0xa4, 0x7f, 0x48, 0xb8, 0x8a, 0x94, 0xb6, 0xb2, 0x68, 0x37, 0x7d, 0x72,
+	0x74, 0x93, 0x4c, 0xee, 0x86, 0x60, 0x3a, 0x5e, 0x85, 0x6c, 0xbc, 0x3e, 0xd7, 0xe1, 0xda, 0x1b,
+	0x73, 0x67, 0x88, 0x05, 0xb9, 0x2d, 0x19, 0xc7, 0x1e, 0x41, 0x5d, 0x28, 0x0d, 0xc6, 0x13, 0xf2,
+	0xea, 0x42, 0x0a, 0xc5, 0xd4, 0xaa, 0xe0, 0x26, 0x11, 0x52, 0x47, 0x15, 0x38, 0x41, 0x1f, 0x91,
+	0xfe, 0x88, 0x0e, 0xf4, 0x93, 0x45, 0xbb, 0xa2, 0xe8, 0x5d, 0x3a, 0xd8, 0x04, 0x15, 0x8a, 0xf0,
+	0x62, 0x87, 0x83, 0x35, 0xfb, 0x7c, 0x12, 0x85, 0x35, 0xa8, 0x7f, 0x36, 0x66, 0x12, 0xf7, 0x07,
+	0x13, 0x49, 0xc2, 0x54, 0x17, 0x6d, 0xd0, 0xd0, 0x96, 0x42, 0xd0, 0x55, 0x28, 0x3a, 0x4c, 0xc8,
+	0x68, 0x4b, 0x5d, 0xe9, 0x46, 0x36, 0xaa, 0xb5, 0xbc, 0x1b, 0xad, 0xe5, 0xdd, 0x6d, 0x46, 0xfd,
+	0x68, 0x61, 0xd5, 0xc2, 0x57, 0xbe, 0x29, 0x41, 0x61, 0x57, 0x78, 0xe8, 0x06, 0x34, 0x32, 0xcb,
+	0xf2, 0x72, 0x5c, 0xd2, 0xb9, 0xe5, 0xd5, 0x5a, 0x3b, 0x81, 0x91, 0xd8, 0x79, 0x03, 0x1a, 0x99,
+	0x8d, 0x36, 0xad, 0x29, 0xcd, 0xb0, 0xd6, 0x4e, 0x60, 0x24, 0x9a, 0x6e, 0x42, 0x33, 0xbb, 0xb5,
+	0x98, 0xb3, 0x37, 0x42, 0x8e, 0xd5, 0x3e, 0x89, 0x93, 0x28, 0xbb, 0x0f, 0xe8, 0x98, 0x8f, 0xf4,
+	0xb9, 0xd4, 0xbd, 0x59, 0xb6, 0x75, 0xf1, 0x54, 0x76, 0xa2, 0xfb, 0x63, 0x38, 0x33, 0xf3, 0xb1,
+	0x39, 0x9b, 0xbe, 0x9a, 0x63, 0x5a, 0xe7, 0x4f, 0x61, 0x26, 0x5a, 0xef, 0xc1, 0x7f, 0x66, 0xe7,
+	0xda, 0xea, 0xf1, 0x37, 0x43, 0xae, 0x75, 0xe1, 0x34, 0x6e, 0xa2, 0xf8, 0x23, 0x58, 0xcc, 0x8f,
+	0x04, 0x2b, 0x1d, 0xbf, 0x2c, 0xcf, 0xea, 0x9c, 0xcc, 0x4b, 0xab, 0xcc, 0xb7, 0x4d, 0x5a, 0x65,
+	0x8e, 0x67, 0x75, 0x4e, 0xe6, 0xc5, 0x2a, 0xad, 0xd2, 0xe3, 0x97, 0x4f, 0x2e, 0x19, 0x5b, 0x1f,
+	0x3c, 0x7d, 0xde, 0x32, 0x9e, 0x3d, 0x6f, 0x19, 0xbf, 0x3c, 0x6f, 0x19, 0x5f, 0xbf, 0x68, 0xcd,
+	0x3d, 0x7b, 0xd1, 0x9a, 0xfb, 0xe9, 0x45, 0x6b, 0xee, 0xfe, 0x79, 0x8f, 0xca, 0xe1, 0x78, 0xd0,
+	0x75, 0xd8, 0xa8, 0x27, 0x98, 0xcf, 0x2f, 0x53, 0xa6, 0x7f, 0x7b, 0x0f, 0x7b, 0xea, 0x7f, 0xa2,
+	0x9c, 0x04, 0x44, 0x0c, 0xca, 0xfa, 0xc3, 0x76, 0xf5, 0xf7, 0x01, 0x00, 0xb7, 0x4c, 0xc6, 0x9c,
+	0xbe, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1186,6 +1347,8 @@ type MsgClient interface {
 	PermissionsRevoke(ctx context.Context, in *MsgPermissionsRevoke, opts ...grpc.CallOption) (*MsgPermissionsRevokeResponse, error)
 	// DWN Vault Operations
 	RotateVaultKeys(ctx context.Context, in *MsgRotateVaultKeys, opts ...grpc.CallOption) (*MsgRotateVaultKeysResponse, error)
+	// DWN Storage Operations
+	PurchaseStorage(ctx context.Context, in *MsgPurchaseStorage, opts ...grpc.CallOption) (*MsgPurchaseStorageResponse, error)
 }
 
 type msgClient struct {
@@ -1259,6 +1422,15 @@ func (c *msgClient) RotateVaultKeys(ctx context.Context, in *MsgRotateVaultKeys,
 	return out, nil
 }
 
+func (c *msgClient) PurchaseStorage(ctx context.Context, in *MsgPurchaseStorage, opts ...grpc.CallOption) (*MsgPurchaseStorageResponse, error) {
+	out := new(MsgPurchaseStorageResponse)
+	err := c.cc.Invoke(ctx, "/dwn.v1.Msg/PurchaseStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the parameters.
@@ -1278,6 +1450,8 @@ type MsgServer interface {
 	PermissionsRevoke(context.Context, *MsgPermissionsRevoke) (*MsgPermissionsRevokeResponse, error)
 	// DWN Vault Operations
 	RotateVaultKeys(context.Context, *MsgRotateVaultKeys) (*MsgRotateVaultKeysResponse, error)
+	// DWN Storage Operations
+	PurchaseStorage(context.Context, *MsgPurchaseStorage) (*MsgPurchaseStorageResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1305,6 +1479,9 @@ func (*UnimplementedMsgServer) PermissionsRevoke(ctx context.Context, req *MsgPe
 func (*UnimplementedMsgServer) RotateVaultKeys(ctx context.Context, req *MsgRotateVaultKeys) (*MsgRotateVaultKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateVaultKeys not implemented")
 }
+func (*UnimplementedMsgServer) PurchaseStorage(ctx context.Context, req *MsgPurchaseStorage) (*MsgPurchaseStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseStorage not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1436,6 +1613,24 @@ func _Msg_RotateVaultKeys_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PurchaseStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseStorage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dwn.v1.Msg/PurchaseStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseStorage(ctx, req.(*MsgPurchaseStorage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dwn.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1468,6 +1663,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RotateVaultKeys",
 			Handler:    _Msg_RotateVaultKeys_Handler,
 		},
+		{
+			MethodName: "PurchaseStorage",
+			Handler:    _Msg_PurchaseStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dwn/v1/tx.proto",
@@ -1556,6 +1755,20 @@ func (m *MsgRecordsWrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttachmentCids) > 0 {
+		for iNdEx := len(m.AttachmentCids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AttachmentCids[iNdEx])
+			copy(dAtA[i:], m.AttachmentCids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AttachmentCids[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.Attestation) > 0 {
 		i -= len(m.Attestation)
 		copy(dAtA[i:], m.Attestation)
@@ -1671,6 +1884,11 @@ func (m *MsgRecordsWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DataCid) > 0 {
 		i -= len(m.DataCid)
 		copy(dAtA[i:], m.DataCid)
@@ -2238,6 +2456,86 @@ func (m *MsgRotateVaultKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPurchaseStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeMib != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SizeMib))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.QuotaBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QuotaBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2326,6 +2624,15 @@ func (m *MsgRecordsWrite) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Ttl != 0 {
+		n += 1 + sovTx(uint64(m.Ttl))
+	}
+	if len(m.AttachmentCids) > 0 {
+		for _, s := range m.AttachmentCids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2343,6 +2650,9 @@ func (m *MsgRecordsWriteResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -2589,6 +2899,40 @@ func (m *MsgRotateVaultKeysResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPurchaseStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SizeMib != 0 {
+		n += 1 + sovTx(uint64(m.SizeMib))
+	}
+	return n
+}
+
+func (m *MsgPurchaseStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuotaBytes != 0 {
+		n += 1 + sovTx(uint64(m.QuotaBytes))
+	}
+	l = m.Cost.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3167,6 +3511,57 @@ func (m *MsgRecordsWrite) Unmarshal(dAtA []byte) error {
 			}
 			m.Attestation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachmentCids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttachmentCids = append(m.AttachmentCids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3281,6 +3676,25 @@ func (m *MsgRecordsWriteResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.DataCid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4998,6 +5412,241 @@ func (m *MsgRotateVaultKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPurchaseStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurchaseStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurchaseStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeMib", wireType)
+			}
+			m.SizeMib = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeMib |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPurchaseStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurchaseStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurchaseStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBytes", wireType)
+			}
+			m.QuotaBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
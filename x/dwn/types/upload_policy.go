@@ -0,0 +1,129 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// StorageUsagePrefix is the store prefix for per-profile IPFS storage usage
+var StorageUsagePrefix = collections.NewPrefix(1)
+
+// UploadKind distinguishes vault bundles from user attachments
+type UploadKind string
+
+const (
+	// UploadKindBundle is an encrypted vault bundle
+	UploadKindBundle UploadKind = "bundle"
+	// UploadKindAttachment is a user-supplied attachment
+	UploadKindAttachment UploadKind = "attachment"
+)
+
+// Upload describes content about to be sent to the pinning providers
+type Upload struct {
+	Owner string
+	Kind  UploadKind
+	Data  []byte
+	// MIMEType is the declared content type. When empty it is sniffed from
+	// the data.
+	MIMEType string
+}
+
+// UploadPolicy limits what may be uploaded to IPFS on behalf of a profile
+type UploadPolicy struct {
+	MaxBundleSize     uint64
+	MaxAttachmentSize uint64
+	// AllowedMIMETypes applies to attachments; bundles are always opaque
+	// ciphertext.
+	AllowedMIMETypes []string
+	// ProfileQuota is the total number of bytes a profile may keep pinned.
+	// Zero disables the quota.
+	ProfileQuota uint64
+}
+
+// DefaultUploadPolicy returns the limits applied unless a chain overrides them
+func DefaultUploadPolicy() UploadPolicy {
+	return UploadPolicy{
+		MaxBundleSize:     4 << 20,
+		MaxAttachmentSize: 1 << 20,
+		AllowedMIMETypes: []string{
+			"application/json",
+			"application/octet-stream",
+			"application/pdf",
+			"image/jpeg",
+			"image/png",
+			"image/webp",
+			"text/plain",
+		},
+		ProfileQuota: 64 << 20,
+	}
+}
+
+// Validate checks the policy limits
+func (p UploadPolicy) Validate() error {
+	if p.MaxBundleSize == 0 || p.MaxAttachmentSize == 0 {
+		return fmt.Errorf("upload size limits must be positive")
+	}
+	if p.ProfileQuota != 0 && p.ProfileQuota < max(p.MaxBundleSize, p.MaxAttachmentSize) {
+		return fmt.Errorf("profile quota %d is smaller than the largest single upload", p.ProfileQuota)
+	}
+	return nil
+}
+
+// Check applies the size and content type limits to a single upload
+func (p UploadPolicy) Check(u Upload) error {
+	size := uint64(len(u.Data))
+	if size == 0 {
+		return errorsmod.Wrap(ErrInvalidRequest, "upload is empty")
+	}
+
+	switch u.Kind {
+	case UploadKindBundle:
+		if size > p.MaxBundleSize {
+			return errorsmod.Wrapf(ErrUploadTooLarge, "bundle is %d bytes, limit %d", size, p.MaxBundleSize)
+		}
+	case UploadKindAttachment:
+		if size > p.MaxAttachmentSize {
+			return errorsmod.Wrapf(ErrUploadTooLarge, "attachment is %d bytes, limit %d", size, p.MaxAttachmentSize)
+		}
+		mimeType := DetectMIMEType(u)
+		if !slices.Contains(p.AllowedMIMETypes, mimeType) {
+			return errorsmod.Wrapf(ErrMIMETypeNotAllowed, "%s", mimeType)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidRequest, "unknown upload kind %q", u.Kind)
+	}
+	return nil
+}
+
+// DetectMIMEType returns the media type of an upload without parameters,
+// sniffing the data when none was declared.
+func DetectMIMEType(u Upload) string {
+	declared := u.MIMEType
+	if declared == "" {
+		declared = http.DetectContentType(u.Data)
+	}
+	mediaType, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return declared
+	}
+	return mediaType
+}
+
+// ScanVerdict is the outcome of a content scan
+type ScanVerdict struct {
+	Allowed bool
+	// Reason explains a rejection, e.g. the matched signature or abuse rule
+	Reason string
+}
+
+// ContentScanner is a hook for virus and abuse scanning of uploads. It is
+// called after size and type checks pass and before content is pinned.
+type ContentScanner interface {
+	Scan(ctx context.Context, u Upload) (ScanVerdict, error)
+}
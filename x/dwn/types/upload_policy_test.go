@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/dwn/types"
+)
+
+func TestUploadPolicyCheck(t *testing.T) {
+	policy := types.DefaultUploadPolicy()
+	require.NoError(t, policy.Validate())
+
+	bundle := types.Upload{Owner: "idx1alice", Kind: types.UploadKindBundle, Data: []byte{0x01, 0x02}}
+	require.NoError(t, policy.Check(bundle))
+
+	bundle.Data = make([]byte, policy.MaxBundleSize+1)
+	require.ErrorIs(t, policy.Check(bundle), types.ErrUploadTooLarge)
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 16)...)
+	attachment := types.Upload{Owner: "idx1alice", Kind: types.UploadKindAttachment, Data: png}
+	require.NoError(t, policy.Check(attachment))
+
+	attachment.MIMEType = "application/x-msdownload"
+	require.ErrorIs(t, policy.Check(attachment), types.ErrMIMETypeNotAllowed)
+
+	attachment.MIMEType = "text/plain; charset=utf-8"
+	require.NoError(t, policy.Check(attachment))
+
+	attachment.Data = make([]byte, policy.MaxAttachmentSize+1)
+	require.ErrorIs(t, policy.Check(attachment), types.ErrUploadTooLarge)
+
+	require.ErrorIs(t, policy.Check(types.Upload{Kind: types.UploadKindBundle}), types.ErrInvalidRequest)
+}
+
+func TestUploadPolicyValidate(t *testing.T) {
+	policy := types.DefaultUploadPolicy()
+	policy.ProfileQuota = policy.MaxBundleSize - 1
+	require.Error(t, policy.Validate())
+
+	policy.ProfileQuota = 0
+	require.NoError(t, policy.Validate())
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+// CreateReferralCode binds a new invite code to a DID controlled by sender
+func (k Keeper) CreateReferralCode(ctx sdk.Context, sender sdk.AccAddress, ownerDID, code string) error {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return types.ErrDisabled
+	}
+	if err := types.ValidateCode(code); err != nil {
+		return err
+	}
+	if err := k.checkController(ctx, ownerDID, sender); err != nil {
+		return err
+	}
+
+	if has, err := k.Codes.Has(ctx, code); err != nil {
+		return err
+	} else if has {
+		return fmt.Errorf("%w: %s", types.ErrCodeExists, code)
+	}
+
+	owned, err := k.countCodes(ctx, ownerDID)
+	if err != nil {
+		return err
+	}
+	if owned >= params.MaxCodesPerDid {
+		return fmt.Errorf("%w: %s owns %d codes", types.ErrCodeLimit, ownerDID, owned)
+	}
+
+	if err := k.setCode(ctx, types.ReferralCode{
+		Code:          code,
+		OwnerDid:      ownerDID,
+		CreatedHeight: ctx.BlockHeight(),
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCodeCreated,
+			sdk.NewAttribute("code", code),
+			sdk.NewAttribute("owner", ownerDID),
+		),
+	)
+	return nil
+}
+
+// GetCode returns an invite code
+func (k Keeper) GetCode(ctx sdk.Context, code string) (types.ReferralCode, error) {
+	c, err := k.Codes.Get(ctx, code)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ReferralCode{}, fmt.Errorf("%w: %s", types.ErrCodeNotFound, code)
+	}
+	return c, err
+}
+
+// checkController verifies that sender controls an active DID
+func (k Keeper) checkController(ctx sdk.Context, did string, sender sdk.AccAddress) error {
+	summary, err := k.didKeeper.GetDIDSummary(ctx, did)
+	if err != nil {
+		return fmt.Errorf("%w: DID %s not found", types.ErrUnauthorized, did)
+	}
+	if summary.Deactivated {
+		return fmt.Errorf("%w: DID %s is deactivated", types.ErrUnauthorized, did)
+	}
+	if summary.Controller != sender.String() {
+		return fmt.Errorf("%w: %s does not control %s", types.ErrUnauthorized, sender, did)
+	}
+	return nil
+}
+
+func (k Keeper) countCodes(ctx sdk.Context, ownerDID string) (uint32, error) {
+	iter, err := k.CodesByOwner.Iterate(ctx, collections.NewPrefixedPairRange[string, string](ownerDID))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var n uint32
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+	return n, nil
+}
+
+func (k Keeper) setCode(ctx sdk.Context, code types.ReferralCode) error {
+	if err := k.Codes.Set(ctx, code.Code, code); err != nil {
+		return err
+	}
+	return k.CodesByOwner.Set(ctx, collections.Join(code.OwnerDid, code.Code))
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+// InitGenesis initializes the module's state from a specified GenesisState
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	if err := k.Params.Set(ctx, state.Params); err != nil {
+		panic(fmt.Sprintf("failed to set params: %v", err))
+	}
+
+	for _, code := range state.Codes {
+		if err := k.setCode(ctx, code); err != nil {
+			panic(fmt.Sprintf("failed to set referral code: %v", err))
+		}
+	}
+
+	for _, referral := range state.Referrals {
+		if err := k.Referrals.Set(ctx, referral.RefereeDid, referral); err != nil {
+			panic(fmt.Sprintf("failed to set referral: %v", err))
+		}
+		if err := k.ReferralsByReferrer.Set(ctx, collections.Join(referral.ReferrerDid, referral.RefereeDid)); err != nil {
+			panic(fmt.Sprintf("failed to index referral: %v", err))
+		}
+		if referral.Status != types.REFERRAL_STATUS_REWARDED {
+			continue
+		}
+		// Rewarded counts are derived from the referrals
+		rewarded, _ := k.RewardedCounts.Get(ctx, referral.ReferrerDid)
+		if err := k.RewardedCounts.Set(ctx, referral.ReferrerDid, rewarded+1); err != nil {
+			panic(fmt.Sprintf("failed to set rewarded count: %v", err))
+		}
+	}
+
+	for _, reward := range state.PendingRewards {
+		if err := k.PendingRewards.Set(ctx, reward.Did, reward); err != nil {
+			panic(fmt.Sprintf("failed to set pending reward: %v", err))
+		}
+	}
+}
+
+// ExportGenesis exports the module's state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	codes, err := collectValues(ctx, k.Codes)
+	if err != nil {
+		panic(fmt.Sprintf("failed to export referral codes: %v", err))
+	}
+	referrals, err := collectValues(ctx, k.Referrals)
+	if err != nil {
+		panic(fmt.Sprintf("failed to export referrals: %v", err))
+	}
+	pending, err := collectValues(ctx, k.PendingRewards)
+	if err != nil {
+		panic(fmt.Sprintf("failed to export pending rewards: %v", err))
+	}
+
+	return &types.GenesisState{
+		Params:         k.GetParams(ctx),
+		Codes:          codes,
+		Referrals:      referrals,
+		PendingRewards: pending,
+	}
+}
+
+func collectValues[V any](ctx sdk.Context, m collections.Map[string, V]) ([]V, error) {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// Hooks records referrals when the did module registers a DID
+type Hooks struct {
+	k Keeper
+}
+
+var _ didtypes.DIDHooks = Hooks{}
+
+// Hooks returns the DID registration hooks of the referral module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k: k}
+}
+
+// AfterDIDRegistered attributes a new DID to the invite code it registered
+// with. An unknown code fails the registration so the user can correct it.
+func (h Hooks) AfterDIDRegistered(ctx context.Context, did, controller, referralCode string) error {
+	if referralCode == "" {
+		return nil
+	}
+	return h.k.RecordReferral(sdk.UnwrapSDKContext(ctx), did, controller, referralCode)
+}
+
+// AfterDIDDocumentChanged implements didtypes.DIDHooks. Referrals do not
+// depend on the document.
+func (h Hooks) AfterDIDDocumentChanged(context.Context, string) error {
+	return nil
+}
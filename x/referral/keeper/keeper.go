@@ -0,0 +1,138 @@
+// Package keeper implements the referral module keeper: invite codes,
+// attribution of new DIDs to the codes they registered with, reward accrual
+// and periodic distribution from the rewards pool.
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+// Keeper defines the referral module keeper
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	logger    log.Logger
+	authority string
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	didKeeper     types.DIDKeeper
+
+	Schema              collections.Schema
+	Params              collections.Item[types.Params]
+	Codes               collections.Map[string, types.ReferralCode]
+	CodesByOwner        collections.KeySet[collections.Pair[string, string]] // (owner DID, code)
+	Referrals           collections.Map[string, types.Referral]              // referee DID -> referral
+	ReferralsByReferrer collections.KeySet[collections.Pair[string, string]] // (referrer DID, referee DID)
+	RewardedCounts      collections.Map[string, uint64]                      // referrer DID -> rewarded referrals
+	PendingRewards      collections.Map[string, types.PendingReward]         // DID -> undistributed rewards
+	DistributionCursor  collections.Item[string]                             // last DID of an unfinished distribution
+}
+
+// NewKeeper creates a new referral Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	didKeeper types.DIDKeeper,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:       cdc,
+		logger:    logger.With(log.ModuleKey, "x/"+types.ModuleName),
+		authority: authority,
+
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		didKeeper:     didKeeper,
+
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		Codes: collections.NewMap(
+			sb,
+			types.CodesPrefix,
+			"codes",
+			collections.StringKey,
+			codec.CollValue[types.ReferralCode](cdc),
+		),
+		CodesByOwner: collections.NewKeySet(
+			sb,
+			types.CodesByOwnerPrefix,
+			"codes_by_owner",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		Referrals: collections.NewMap(
+			sb,
+			types.ReferralsPrefix,
+			"referrals",
+			collections.StringKey,
+			codec.CollValue[types.Referral](cdc),
+		),
+		ReferralsByReferrer: collections.NewKeySet(
+			sb,
+			types.ReferralsByReferrerPrefix,
+			"referrals_by_referrer",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		RewardedCounts: collections.NewMap(
+			sb,
+			types.RewardedCountsPrefix,
+			"rewarded_counts",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		PendingRewards: collections.NewMap(
+			sb,
+			types.PendingRewardsPrefix,
+			"pending_rewards",
+			collections.StringKey,
+			codec.CollValue[types.PendingReward](cdc),
+		),
+		DistributionCursor: collections.NewItem(
+			sb,
+			types.DistributionCursorKey,
+			"distribution_cursor",
+			collections.StringValue,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns the module logger
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's governance authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults when unset
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+	return params
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	"github.com/sonr-io/sonr/x/referral/keeper"
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+const (
+	alice = "did:sonr:alice"
+	bob   = "did:sonr:bob"
+	carol = "did:sonr:carol"
+)
+
+type testFixture struct {
+	ctx         sdk.Context
+	k           keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+
+	bank *mockBankKeeper
+	dids *mockDIDKeeper
+
+	addrs []sdk.AccAddress
+	pool  sdk.AccAddress
+}
+
+// SetupTest creates a referral keeper over mock bank and DID keepers where
+// alice, bob and carol are controlled by the first three test addresses
+func SetupTest(t *testing.T) *testFixture {
+	t.Helper()
+	f := new(testFixture)
+
+	logger := log.NewTestLogger(t)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	keys := storetypes.NewKVStoreKeys(types.StoreKey)
+
+	f.addrs = simtestutil.CreateIncrementalAccounts(3)
+	f.pool = authtypes.NewModuleAddress(types.ModuleName)
+	f.bank = &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+	f.dids = &mockDIDKeeper{
+		controllers: map[string]string{
+			alice: f.addrs[0].String(),
+			bob:   f.addrs[1].String(),
+			carol: f.addrs[2].String(),
+		},
+		deactivated: make(map[string]bool),
+	}
+
+	f.k = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		logger,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		mockAccountKeeper{},
+		f.bank,
+		f.dids,
+	)
+	f.msgServer = keeper.NewMsgServerImpl(f.k)
+	f.queryServer = keeper.NewQueryServerImpl(f.k)
+
+	cms := integration.CreateMultiStore(keys, logger)
+	f.ctx = sdk.NewContext(cms, cmtproto.Header{Height: 1}, false, logger)
+
+	params := types.DefaultParams()
+	params.ReferrerReward = sdk.NewInt64Coin("usnr", 100)
+	params.RefereeReward = sdk.NewInt64Coin("usnr", 50)
+	params.DistributionInterval = 10
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	return f
+}
+
+// createCode registers an invite code owned by alice
+func (f *testFixture) createCode(t *testing.T, code string) {
+	t.Helper()
+	_, err := f.msgServer.CreateReferralCode(f.ctx, &types.MsgCreateReferralCode{
+		Sender:   f.addrs[0].String(),
+		OwnerDid: alice,
+		Code:     code,
+	})
+	require.NoError(t, err)
+}
+
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins // address -> balance
+}
+
+func (m *mockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(
+	ctx context.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	return m.move(senderAddr.String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(
+	ctx context.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	return m.move(authtypes.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) move(from, to string, amt sdk.Coins) error {
+	balance, negative := m.balances[from].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", m.balances[from], amt)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+type mockDIDKeeper struct {
+	controllers map[string]string
+	deactivated map[string]bool
+}
+
+func (m *mockDIDKeeper) GetDIDSummary(ctx context.Context, did string) (didtypes.DIDSummary, error) {
+	controller, ok := m.controllers[did]
+	if !ok {
+		return didtypes.DIDSummary{}, fmt.Errorf("DID %s not found", did)
+	}
+	return didtypes.DIDSummary{Controller: controller, Deactivated: m.deactivated[did]}, nil
+}
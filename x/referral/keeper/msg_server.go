@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams implements types.MsgServer.
+func (ms msgServer) UpdateParams(
+	ctx context.Context,
+	msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			ms.authority,
+			msg.Authority,
+		)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, ms.Params.Set(ctx, msg.Params)
+}
+
+// CreateReferralCode implements types.MsgServer.
+func (ms msgServer) CreateReferralCode(
+	ctx context.Context,
+	msg *types.MsgCreateReferralCode,
+) (*types.MsgCreateReferralCodeResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.Keeper.CreateReferralCode(sdk.UnwrapSDKContext(ctx), sender, msg.OwnerDid, msg.Code); err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateReferralCodeResponse{Code: msg.Code}, nil
+}
+
+// FundRewardsPool implements types.MsgServer.
+func (ms msgServer) FundRewardsPool(
+	ctx context.Context,
+	msg *types.MsgFundRewardsPool,
+) (*types.MsgFundRewardsPoolResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.Keeper.FundRewardsPool(sdk.UnwrapSDKContext(ctx), sender, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgFundRewardsPoolResponse{}, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sonr-io/sonr/app/pagination"
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the module QueryServer.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{Keeper: k}
+}
+
+// Params queries the module parameters.
+func (qs queryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return &types.QueryParamsResponse{Params: qs.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// Code queries an invite code.
+func (qs queryServer) Code(ctx context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
+	if req == nil || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	code, err := qs.GetCode(sdk.UnwrapSDKContext(ctx), req.Code)
+	if err != nil {
+		return nil, notFoundOr(err, types.ErrCodeNotFound)
+	}
+	return &types.QueryCodeResponse{Code: code}, nil
+}
+
+// Referral queries how a DID was referred.
+func (qs queryServer) Referral(ctx context.Context, req *types.QueryReferralRequest) (*types.QueryReferralResponse, error) {
+	if req == nil || req.RefereeDid == "" {
+		return nil, status.Error(codes.InvalidArgument, "referee_did is required")
+	}
+
+	referral, err := qs.GetReferral(sdk.UnwrapSDKContext(ctx), req.RefereeDid)
+	if err != nil {
+		return nil, notFoundOr(err, types.ErrReferralNotFound)
+	}
+	return &types.QueryReferralResponse{Referral: referral}, nil
+}
+
+// Referrals queries the registrations attributed to a referrer, ordered by
+// referee DID.
+func (qs queryServer) Referrals(ctx context.Context, req *types.QueryReferralsRequest) (*types.QueryReferralsResponse, error) {
+	if req == nil || req.ReferrerDid == "" {
+		return nil, status.Error(codes.InvalidArgument, "referrer_did is required")
+	}
+
+	page, err := pagination.Parse(req.Pagination, fmt.Sprintf("referral/Referrals/%s", req.ReferrerDid))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rng := collections.NewPrefixedPairRange[string, string](req.ReferrerDid)
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			rng = rng.EndExclusive(string(page.Key))
+		} else {
+			rng = rng.StartExclusive(string(page.Key))
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iter, err := qs.ReferralsByReferrer.Iterate(sdkCtx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	referrals, pageRes, err := pagination.Collect(page, func() (types.Referral, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.Referral{}, nil, false, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return types.Referral{}, nil, false, err
+		}
+		iter.Next()
+
+		referral, err := qs.Keeper.Referrals.Get(sdkCtx, key.K2())
+		if err != nil {
+			return types.Referral{}, nil, false, err
+		}
+		return referral, []byte(key.K2()), true, nil
+	}, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryReferralsResponse{Referrals: referrals, Pagination: pageRes}, nil
+}
+
+// Rewards queries the undistributed rewards of a DID and the rewards pool.
+func (qs queryServer) Rewards(ctx context.Context, req *types.QueryRewardsRequest) (*types.QueryRewardsResponse, error) {
+	if req == nil || req.Did == "" {
+		return nil, status.Error(codes.InvalidArgument, "did is required")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pending, err := qs.GetPendingRewards(sdkCtx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRewardsResponse{Pending: pending, Pool: qs.PoolBalance(sdkCtx)}, nil
+}
+
+// notFoundOr maps a not found error to codes.NotFound and anything else to
+// codes.Internal
+func notFoundOr(err, notFound error) error {
+	if errors.Is(err, notFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
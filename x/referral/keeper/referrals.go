@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+// RecordReferral attributes the registration of refereeDID, controlled by
+// refereeController, to an invite code. The referral is always recorded;
+// rewards accrue to both sides only if it passes the anti-abuse checks.
+// Registrations while the module is disabled are not attributed.
+func (k Keeper) RecordReferral(ctx sdk.Context, refereeDID, refereeController, code string) error {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		k.logger.Info("referrals disabled, not attributing registration", "did", refereeDID, "code", code)
+		return nil
+	}
+
+	c, err := k.GetCode(ctx, code)
+	if err != nil {
+		return err
+	}
+	if has, err := k.Referrals.Has(ctx, refereeDID); err != nil {
+		return err
+	} else if has {
+		return fmt.Errorf("%w: %s", types.ErrAlreadyReferred, refereeDID)
+	}
+
+	referral := types.Referral{
+		RefereeDid:  refereeDID,
+		ReferrerDid: c.OwnerDid,
+		Code:        code,
+		Height:      ctx.BlockHeight(),
+		Status:      types.REFERRAL_STATUS_REWARDED,
+	}
+	reason, err := k.ineligibility(ctx, params, c.OwnerDid, refereeController)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		referral.Status = types.REFERRAL_STATUS_INELIGIBLE
+		referral.Reason = reason
+	} else if err := k.accrueReferral(ctx, params, referral); err != nil {
+		return err
+	}
+
+	c.Uses++
+	if err := k.setCode(ctx, c); err != nil {
+		return err
+	}
+	if err := k.Referrals.Set(ctx, refereeDID, referral); err != nil {
+		return err
+	}
+	if err := k.ReferralsByReferrer.Set(ctx, collections.Join(c.OwnerDid, refereeDID)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReferralRecorded,
+			sdk.NewAttribute("referee", refereeDID),
+			sdk.NewAttribute("referrer", c.OwnerDid),
+			sdk.NewAttribute("code", code),
+			sdk.NewAttribute("status", referral.Status.String()),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// GetReferral returns how a DID was referred
+func (k Keeper) GetReferral(ctx sdk.Context, refereeDID string) (types.Referral, error) {
+	r, err := k.Referrals.Get(ctx, refereeDID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Referral{}, fmt.Errorf("%w: %s", types.ErrReferralNotFound, refereeDID)
+	}
+	return r, err
+}
+
+// ineligibility returns why a referral by referrerDID must not earn
+// rewards, or an empty string when it may
+func (k Keeper) ineligibility(ctx sdk.Context, params types.Params, referrerDID, refereeController string) (string, error) {
+	summary, err := k.didKeeper.GetDIDSummary(ctx, referrerDID)
+	if err != nil || summary.Deactivated {
+		return "referrer DID is not active", nil
+	}
+	// A controller inviting itself is the cheapest way to farm rewards
+	if summary.Controller == refereeController {
+		return "referrer and referee share a controller", nil
+	}
+
+	if params.MaxRewardedReferrals > 0 {
+		rewarded, err := k.RewardedCounts.Get(ctx, referrerDID)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return "", err
+		}
+		if rewarded >= params.MaxRewardedReferrals {
+			return "referrer reached the rewarded referral cap", nil
+		}
+	}
+	return "", nil
+}
+
+// accrueReferral credits the rewards of an eligible referral to both sides
+func (k Keeper) accrueReferral(ctx sdk.Context, params types.Params, referral types.Referral) error {
+	referrerReward, refereeReward := params.Rewards()
+	if err := k.accrue(ctx, referral.ReferrerDid, referrerReward); err != nil {
+		return err
+	}
+	if err := k.accrue(ctx, referral.RefereeDid, refereeReward); err != nil {
+		return err
+	}
+
+	rewarded, err := k.RewardedCounts.Get(ctx, referral.ReferrerDid)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.RewardedCounts.Set(ctx, referral.ReferrerDid, rewarded+1)
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+func TestCreateReferralCode(t *testing.T) {
+	f := SetupTest(t)
+	f.createCode(t, "alice-1")
+
+	// Codes are unique across DIDs
+	_, err := f.msgServer.CreateReferralCode(f.ctx, &types.MsgCreateReferralCode{
+		Sender: f.addrs[1].String(), OwnerDid: bob, Code: "alice-1",
+	})
+	require.ErrorIs(t, err, types.ErrCodeExists)
+
+	// Only the controller of a DID may bind codes to it
+	_, err = f.msgServer.CreateReferralCode(f.ctx, &types.MsgCreateReferralCode{
+		Sender: f.addrs[1].String(), OwnerDid: alice, Code: "alice-2",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	params := f.k.GetParams(f.ctx)
+	params.MaxCodesPerDid = 1
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+	_, err = f.msgServer.CreateReferralCode(f.ctx, &types.MsgCreateReferralCode{
+		Sender: f.addrs[0].String(), OwnerDid: alice, Code: "alice-2",
+	})
+	require.ErrorIs(t, err, types.ErrCodeLimit)
+}
+
+func TestReferralAccruesRewards(t *testing.T) {
+	f := SetupTest(t)
+	f.createCode(t, "alice-1")
+
+	require.NoError(t, f.k.Hooks().AfterDIDRegistered(f.ctx, bob, f.addrs[1].String(), "alice-1"))
+
+	res, err := f.queryServer.Referral(f.ctx, &types.QueryReferralRequest{RefereeDid: bob})
+	require.NoError(t, err)
+	require.Equal(t, alice, res.Referral.ReferrerDid)
+	require.Equal(t, types.REFERRAL_STATUS_REWARDED, res.Referral.Status)
+
+	code, err := f.k.GetCode(f.ctx, "alice-1")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, code.Uses)
+
+	pending, err := f.k.GetPendingRewards(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 100)), pending)
+	pending, err = f.k.GetPendingRewards(f.ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 50)), pending)
+
+	// Registrations without a code are not attributed; unknown codes are rejected
+	require.NoError(t, f.k.Hooks().AfterDIDRegistered(f.ctx, carol, f.addrs[2].String(), ""))
+	require.ErrorIs(t, f.k.Hooks().AfterDIDRegistered(f.ctx, carol, f.addrs[2].String(), "nope"), types.ErrCodeNotFound)
+}
+
+func TestReferralAntiAbuse(t *testing.T) {
+	f := SetupTest(t)
+	f.createCode(t, "alice-1")
+
+	// A DID registered by alice's own controller earns nothing
+	require.NoError(t, f.k.RecordReferral(f.ctx, "did:sonr:sybil", f.addrs[0].String(), "alice-1"))
+	referral, err := f.k.GetReferral(f.ctx, "did:sonr:sybil")
+	require.NoError(t, err)
+	require.Equal(t, types.REFERRAL_STATUS_INELIGIBLE, referral.Status)
+	require.NotEmpty(t, referral.Reason)
+
+	pending, err := f.k.GetPendingRewards(f.ctx, alice)
+	require.NoError(t, err)
+	require.True(t, pending.IsZero())
+
+	// Every attempt is still attributed to the referrer
+	res, err := f.queryServer.Referrals(f.ctx, &types.QueryReferralsRequest{
+		ReferrerDid: alice,
+		Pagination:  &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Referrals, 1)
+	require.EqualValues(t, 1, res.Pagination.Total)
+}
+
+func TestReferralRewardCap(t *testing.T) {
+	f := SetupTest(t)
+	params := f.k.GetParams(f.ctx)
+	params.MaxRewardedReferrals = 1
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+	f.createCode(t, "alice-1")
+
+	require.NoError(t, f.k.RecordReferral(f.ctx, bob, f.addrs[1].String(), "alice-1"))
+	require.NoError(t, f.k.RecordReferral(f.ctx, carol, f.addrs[2].String(), "alice-1"))
+
+	referral, err := f.k.GetReferral(f.ctx, carol)
+	require.NoError(t, err)
+	require.Equal(t, types.REFERRAL_STATUS_INELIGIBLE, referral.Status)
+
+	pending, err := f.k.GetPendingRewards(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 100)), pending)
+}
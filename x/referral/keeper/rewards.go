@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+// FundRewardsPool moves coins from sender into the rewards pool
+func (k Keeper) FundRewardsPool(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardsPoolFunded,
+			sdk.NewAttribute("sender", sender.String()),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+	return nil
+}
+
+// PoolBalance returns the coins available for reward distribution
+func (k Keeper) PoolBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.SpendableCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// GetPendingRewards returns the undistributed rewards of a DID
+func (k Keeper) GetPendingRewards(ctx sdk.Context, did string) (sdk.Coins, error) {
+	pending, err := k.PendingRewards.Get(ctx, did)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), nil
+	}
+	return pending.Amount, err
+}
+
+// DistributeRewards pays pending rewards from the pool to the controllers of
+// the rewarded DIDs every distribution interval. A block considers at most
+// MaxPayoutsPerBlock rewards; the rest are paid in the following blocks,
+// resuming after the stored cursor until the distribution is complete.
+// Rewards the pool cannot cover, or whose DID has no active controller, stay
+// pending for a later distribution.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	cursor, err := k.DistributionCursor.Get(ctx)
+	resuming := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if !resuming && (params.DistributionInterval <= 0 || ctx.BlockHeight()%params.DistributionInterval != 0) {
+		return nil
+	}
+
+	var rng *collections.Range[string]
+	if resuming {
+		rng = new(collections.Range[string]).StartExclusive(cursor)
+	}
+	due, more, err := k.nextPendingRewards(ctx, rng, params.MaxPayoutsPerBlock)
+	if err != nil {
+		return err
+	}
+	if more {
+		err = k.DistributionCursor.Set(ctx, due[len(due)-1].Did)
+	} else {
+		err = k.DistributionCursor.Remove(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	pool := k.PoolBalance(ctx)
+	for _, reward := range due {
+		if !pool.IsAllGTE(reward.Amount) {
+			k.logger.Info("rewards pool cannot cover pending reward", "did", reward.Did, "amount", reward.Amount, "pool", pool)
+			continue
+		}
+		summary, err := k.didKeeper.GetDIDSummary(ctx, reward.Did)
+		if err != nil || summary.Deactivated || summary.Controller == "" {
+			continue
+		}
+		recipient, err := sdk.AccAddressFromBech32(summary.Controller)
+		if err != nil {
+			continue
+		}
+
+		// Pay in a cached context so a failed payout leaves the reward pending
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.payReward(cacheCtx, reward, recipient); err != nil {
+			k.logger.Error("failed to distribute referral reward", "did", reward.Did, "error", err)
+			continue
+		}
+		write()
+		pool = pool.Sub(reward.Amount...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardsDistributed,
+				sdk.NewAttribute("did", reward.Did),
+				sdk.NewAttribute("recipient", recipient.String()),
+				sdk.NewAttribute("amount", reward.Amount.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// nextPendingRewards returns up to limit pending rewards in rng, and whether
+// more follow them
+func (k Keeper) nextPendingRewards(ctx sdk.Context, rng *collections.Range[string], limit uint32) ([]types.PendingReward, bool, error) {
+	iter, err := k.PendingRewards.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	var due []types.PendingReward
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && uint32(len(due)) == limit {
+			return due, true, nil
+		}
+		reward, err := iter.Value()
+		if err != nil {
+			return nil, false, err
+		}
+		due = append(due, reward)
+	}
+	return due, false, nil
+}
+
+func (k Keeper) payReward(ctx sdk.Context, reward types.PendingReward, recipient sdk.AccAddress) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, reward.Amount); err != nil {
+		return err
+	}
+	return k.PendingRewards.Remove(ctx, reward.Did)
+}
+
+// accrue adds coins to the pending rewards of a DID
+func (k Keeper) accrue(ctx sdk.Context, did string, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	pending, err := k.GetPendingRewards(ctx, did)
+	if err != nil {
+		return err
+	}
+	return k.PendingRewards.Set(ctx, did, types.PendingReward{Did: did, Amount: pending.Add(amount...)})
+}
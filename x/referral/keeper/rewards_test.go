@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/referral/types"
+)
+
+func TestDistributeRewards(t *testing.T) {
+	f := SetupTest(t)
+	f.createCode(t, "alice-1")
+	require.NoError(t, f.k.RecordReferral(f.ctx, bob, f.addrs[1].String(), "alice-1"))
+
+	// The pool only covers alice's reward
+	f.bank.balances[f.addrs[2].String()] = sdk.NewCoins(sdk.NewInt64Coin("usnr", 120))
+	_, err := f.msgServer.FundRewardsPool(f.ctx, &types.MsgFundRewardsPool{
+		Sender: f.addrs[2].String(),
+		Amount: sdk.NewCoins(sdk.NewInt64Coin("usnr", 120)),
+	})
+	require.NoError(t, err)
+
+	// Nothing is paid between distribution intervals
+	require.NoError(t, f.k.DistributeRewards(f.ctx.WithBlockHeight(9)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 120)), f.k.PoolBalance(f.ctx))
+
+	require.NoError(t, f.k.DistributeRewards(f.ctx.WithBlockHeight(10)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 100)), f.bank.balances[f.addrs[0].String()])
+	require.True(t, f.bank.balances[f.addrs[1].String()].IsZero())
+
+	res, err := f.queryServer.Rewards(f.ctx, &types.QueryRewardsRequest{Did: bob})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 50)), res.Pending)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 20)), res.Pool)
+
+	// Bob is paid once the pool is topped up
+	f.bank.balances[f.pool.String()] = f.bank.balances[f.pool.String()].Add(sdk.NewInt64Coin("usnr", 30))
+	require.NoError(t, f.k.DistributeRewards(f.ctx.WithBlockHeight(20)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 50)), f.bank.balances[f.addrs[1].String()])
+
+	pending, err := f.k.GetPendingRewards(f.ctx, bob)
+	require.NoError(t, err)
+	require.True(t, pending.IsZero())
+}
+
+func TestDistributeRewardsAcrossBlocks(t *testing.T) {
+	f := SetupTest(t)
+	params := f.k.GetParams(f.ctx)
+	params.MaxPayoutsPerBlock = 1
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	f.createCode(t, "alice-1")
+	require.NoError(t, f.k.RecordReferral(f.ctx, bob, f.addrs[1].String(), "alice-1"))
+	f.bank.balances[f.pool.String()] = sdk.NewCoins(sdk.NewInt64Coin("usnr", 150))
+
+	// The distribution pays alice, then resumes with bob in the next block
+	require.NoError(t, f.k.DistributeRewards(f.ctx.WithBlockHeight(10)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 100)), f.bank.balances[f.addrs[0].String()])
+	require.True(t, f.bank.balances[f.addrs[1].String()].IsZero())
+	cursor, err := f.k.DistributionCursor.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, alice, cursor)
+
+	require.NoError(t, f.k.DistributeRewards(f.ctx.WithBlockHeight(11)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usnr", 50)), f.bank.balances[f.addrs[1].String()])
+	has, err := f.k.DistributionCursor.Has(f.ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// With the distribution complete, nothing runs until the next interval
+	require.NoError(t, f.k.RecordReferral(f.ctx, carol, f.addrs[2].String(), "alice-1"))
+	require.NoError(t, f.k.DistributeRewards(f.ctx.WithBlockHeight(12)))
+	pending, err := f.k.GetPendingRewards(f.ctx, carol)
+	require.NoError(t, err)
+	require.False(t, pending.IsZero())
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := SetupTest(t)
+	f.createCode(t, "alice-1")
+	require.NoError(t, f.k.RecordReferral(f.ctx, bob, f.addrs[1].String(), "alice-1"))
+
+	exported := f.k.ExportGenesis(f.ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Codes, 1)
+	require.Len(t, exported.Referrals, 1)
+	require.Len(t, exported.PendingRewards, 2)
+
+	g := SetupTest(t)
+	g.k.InitGenesis(g.ctx, *exported)
+	require.Equal(t, exported, g.k.ExportGenesis(g.ctx))
+}
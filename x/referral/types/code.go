@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var codePattern = regexp.MustCompile(`^[a-z0-9-]{4,32}$`)
+
+// ValidateCode checks that an invite code is 4-32 lowercase letters, digits
+// or dashes
+func ValidateCode(code string) error {
+	if !codePattern.MatchString(code) {
+		return fmt.Errorf("%w: %q must be 4-32 lowercase letters, digits or dashes", ErrInvalidCode, code)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a stored code
+func (c ReferralCode) Validate() error {
+	if err := ValidateCode(c.Code); err != nil {
+		return err
+	}
+	if c.OwnerDid == "" {
+		return fmt.Errorf("%w: code %s has no owner", ErrInvalidCode, c.Code)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package types
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	ErrInvalidGenesisState = sdkerrors.Register(ModuleName, 1, "invalid genesis state")
+	ErrInvalidParams       = sdkerrors.Register(ModuleName, 2, "invalid params")
+	ErrInvalidCode         = sdkerrors.Register(ModuleName, 3, "invalid referral code")
+	ErrCodeExists          = sdkerrors.Register(ModuleName, 4, "referral code already exists")
+	ErrCodeNotFound        = sdkerrors.Register(ModuleName, 5, "referral code not found")
+	ErrCodeLimit           = sdkerrors.Register(ModuleName, 6, "referral code limit reached")
+	ErrReferralNotFound    = sdkerrors.Register(ModuleName, 7, "referral not found")
+	ErrAlreadyReferred     = sdkerrors.Register(ModuleName, 8, "DID already referred")
+	ErrUnauthorized        = sdkerrors.Register(ModuleName, 9, "unauthorized")
+	ErrDisabled            = sdkerrors.Register(ModuleName, 10, "referrals disabled")
+)
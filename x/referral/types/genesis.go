@@ -0,0 +1,54 @@
+package types
+
+import "fmt"
+
+// DefaultGenesisState returns the default module GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic validation of the GenesisState.
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	codes := make(map[string]bool, len(gs.Codes))
+	for _, code := range gs.Codes {
+		if err := code.Validate(); err != nil {
+			return err
+		}
+		if codes[code.Code] {
+			return fmt.Errorf("%w: duplicate code %s", ErrInvalidGenesisState, code.Code)
+		}
+		codes[code.Code] = true
+	}
+
+	referees := make(map[string]bool, len(gs.Referrals))
+	for _, referral := range gs.Referrals {
+		if referral.RefereeDid == "" || referral.ReferrerDid == "" {
+			return fmt.Errorf("%w: referral of %q is missing a DID", ErrInvalidGenesisState, referral.RefereeDid)
+		}
+		if !codes[referral.Code] {
+			return fmt.Errorf("%w: referral of %s uses unknown code %s", ErrInvalidGenesisState, referral.RefereeDid, referral.Code)
+		}
+		if referees[referral.RefereeDid] {
+			return fmt.Errorf("%w: duplicate referral of %s", ErrInvalidGenesisState, referral.RefereeDid)
+		}
+		referees[referral.RefereeDid] = true
+	}
+
+	pending := make(map[string]bool, len(gs.PendingRewards))
+	for _, reward := range gs.PendingRewards {
+		if reward.Did == "" || !reward.Amount.IsValid() {
+			return fmt.Errorf("%w: invalid pending reward for %q", ErrInvalidGenesisState, reward.Did)
+		}
+		if pending[reward.Did] {
+			return fmt.Errorf("%w: duplicate pending reward for %s", ErrInvalidGenesisState, reward.Did)
+		}
+		pending[reward.Did] = true
+	}
+	return nil
+}
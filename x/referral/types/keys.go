@@ -0,0 +1,52 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of module.
+	ModuleName = "referral"
+
+	// StoreKey is the store key string for the module.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module.
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the module.
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ParamsKey is the store key for the module parameters
+	ParamsKey = collections.NewPrefix(0)
+
+	// CodesPrefix is the store prefix for invite codes
+	CodesPrefix = collections.NewPrefix(1)
+
+	// CodesByOwnerPrefix is the store prefix for the (owner DID, code) index
+	CodesByOwnerPrefix = collections.NewPrefix(2)
+
+	// ReferralsPrefix is the store prefix for referrals keyed by referee DID
+	ReferralsPrefix = collections.NewPrefix(3)
+
+	// ReferralsByReferrerPrefix is the store prefix for the (referrer DID, referee DID) index
+	ReferralsByReferrerPrefix = collections.NewPrefix(4)
+
+	// RewardedCountsPrefix is the store prefix for the number of rewarded referrals per referrer
+	RewardedCountsPrefix = collections.NewPrefix(5)
+
+	// PendingRewardsPrefix is the store prefix for undistributed rewards per DID
+	PendingRewardsPrefix = collections.NewPrefix(6)
+
+	// DistributionCursorKey is the store key for the last DID paid by a
+	// distribution still in progress
+	DistributionCursorKey = collections.NewPrefix(7)
+)
+
+// Event types emitted by the referral module
+const (
+	EventTypeCodeCreated        = "referral_code_created"
+	EventTypeReferralRecorded   = "referral_recorded"
+	EventTypeRewardsPoolFunded  = "referral_pool_funded"
+	EventTypeRewardsDistributed = "referral_rewards_distributed"
+)
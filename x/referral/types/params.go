@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default referral parameters: referrals are
+// recorded but earn nothing until governance sets the rewards
+func DefaultParams() Params {
+	return Params{
+		Enabled:              true,
+		ReferrerReward:       sdk.NewInt64Coin("usnr", 0),
+		RefereeReward:        sdk.NewInt64Coin("usnr", 0),
+		MaxRewardedReferrals: 100,
+		MaxCodesPerDid:       5,
+		DistributionInterval: 100,
+		MaxPayoutsPerBlock:   100,
+	}
+}
+
+// Validate checks the parameters
+func (p Params) Validate() error {
+	for name, reward := range map[string]sdk.Coin{
+		"referrer reward": p.ReferrerReward,
+		"referee reward":  p.RefereeReward,
+	} {
+		if err := reward.Validate(); err != nil {
+			return fmt.Errorf("%w: %s: %s", ErrInvalidParams, name, err)
+		}
+	}
+	if p.MaxCodesPerDid == 0 {
+		return fmt.Errorf("%w: max codes per DID must be positive", ErrInvalidParams)
+	}
+	if p.DistributionInterval <= 0 {
+		return fmt.Errorf("%w: distribution interval must be positive", ErrInvalidParams)
+	}
+	if p.MaxPayoutsPerBlock == 0 {
+		return fmt.Errorf("%w: max payouts per block must be positive", ErrInvalidParams)
+	}
+	return nil
+}
+
+// Rewards returns the non-zero rewards accrued by the referrer and the
+// referee of an eligible referral
+func (p Params) Rewards() (referrer, referee sdk.Coins) {
+	return sdk.NewCoins(p.ReferrerReward), sdk.NewCoins(p.RefereeReward)
+}
@@ -62,6 +62,116 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "domain"},
 					},
 				},
+				{
+					RpcMethod: "FeeSponsorship",
+					Use:       "fee-sponsorship [service-id]",
+					Short:     "Query the fee payer, budget and spend of a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "FeeSponsorUsage",
+					Use:       "fee-sponsor-usage [service-id]",
+					Short:     "Query the fees a service has paid for each user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "ExportCredentials",
+					Use:       "export-credentials [service-id]",
+					Short:     "Export the credential and profile metadata of a service's users",
+					Long: "Export the public metadata of the WebAuthn credentials registered with a service's\n" +
+						"relying party, sealed with a SHA-256 digest. No private key material is exported.\n\n" +
+						"Example:\n" +
+						"  snrd query svc export-credentials my-app -o json > export.json",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "ImportedCredentials",
+					Use:       "imported-credentials [service-id]",
+					Short:     "Query the credentials a service imported from other relying parties",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "Badges",
+					Use:       "badges [service-id|did]",
+					Short:     "Query the verification badges of a service or profile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subject"},
+					},
+				},
+				{
+					RpcMethod: "BadgeIssuers",
+					Use:       "badge-issuers",
+					Short:     "Query the accounts designated to issue badges",
+				},
+				{
+					RpcMethod: "AppManifest",
+					Use:       "app-manifest [service-id]",
+					Short:     "Query the app manifest of a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "OIDCClient",
+					Use:       "oidc-client [client-id]",
+					Short:     "Query a registered OpenID Connect client",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "client_id"},
+					},
+				},
+				{
+					RpcMethod: "OIDCClients",
+					Use:       "oidc-clients [service-id]",
+					Short:     "List the OpenID Connect clients of a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "AttestationPolicy",
+					Use:       "attestation-policy [service-id]",
+					Short:     "Query the authenticators a service accepts at registration",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "BackupAnchors",
+					Use:       "backup-anchors [service-id] [dataset]",
+					Short:     "List the anchored backup digests of a service's dataset",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "dataset"},
+					},
+				},
+				{
+					RpcMethod: "ViewKey",
+					Use:       "view-key [id]",
+					Short:     "Query a view key and whether it is active",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ViewKeys",
+					Use:       "view-keys [owner-did]",
+					Short:     "List the view keys a DID has minted, including revoked and expired ones",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "AuthorizeView",
+					Skip:      true, // checked by API servers with a bearer token
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -124,6 +234,207 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "ExecOnBehalf",
+					Skip:      true, // wraps arbitrary messages; built by service clients
+				},
+				{
+					RpcMethod: "SetOnBehalfOptOut",
+					Use:       "set-on-behalf-opt-out [did] [service-ids...]",
+					Short:     "Refuse delegated execution for a DID",
+					Long: "Refuse execution of messages on behalf of a DID by the listed services, or by every service when none are listed.\n" +
+						"Only the DID's controller may opt it out.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc set-on-behalf-opt-out did:sonr:alice my-app --from alice",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+						{ProtoField: "service_ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "ClearOnBehalfOptOut",
+					Use:       "clear-on-behalf-opt-out [did]",
+					Short:     "Allow delegated execution for a DID again",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "did"},
+					},
+				},
+				{
+					RpcMethod: "SetFeeSponsorship",
+					Use:       "set-fee-sponsorship [service-id] [budget...]",
+					Short:     "Pay the transaction fees of a service's users",
+					Long: "Set the account that pays the fees of a service's users and the total it may spend.\n" +
+						"The payer co-signs sponsored transactions as their fee payer and defaults to the owner.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc set-fee-sponsorship my-app 5000000usnr --payer idx1payer... --from owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "budget", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "ImportCredentials",
+					Use:       "import-credentials [service-id] [export-json]",
+					Short:     "Import credentials exported from another relying party",
+					Long: "Import a sealed credential export into a service. Credentials of the service's relying\n" +
+						"party are active at once; the others wait for their users to re-register. Credential\n" +
+						"IDs already in use are skipped unless --fail-on-collision is set.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc import-credentials my-app \"$(jq -c .export export.json)\" --from owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "export"},
+					},
+				},
+				{
+					RpcMethod: "ReregisterCredential",
+					Use:       "reregister-credential [service-id] [credential-id] [new-credential-id]",
+					Short:     "Replace an imported credential with one registered with the service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "credential_id"},
+						{ProtoField: "new_credential_id"},
+					},
+				},
+				{
+					RpcMethod: "SetBadgeIssuer",
+					Skip:      true, // authority gated
+				},
+				{
+					RpcMethod: "RemoveBadgeIssuer",
+					Skip:      true, // authority gated
+				},
+				{
+					RpcMethod: "IssueBadge",
+					Use:       "issue-badge [service-id|did] [badge-type]",
+					Short:     "Attest that a service or profile passed a verification",
+					Long: "Issue a badge as a designated issuer. Domain and KYB badges are issued to service IDs,\n" +
+						"human-verified badges to DIDs. Issuing a badge the subject already holds replaces it.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc issue-badge my-app BADGE_TYPE_KYB_VERIFIED --evidence sha256:ab12... --from issuer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subject"},
+						{ProtoField: "badge_type"},
+					},
+				},
+				{
+					RpcMethod: "RevokeBadge",
+					Use:       "revoke-badge [service-id|did] [badge-type]",
+					Short:     "Withdraw a badge you issued",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subject"},
+						{ProtoField: "badge_type"},
+					},
+				},
+				{
+					RpcMethod: "SetAppManifest",
+					Use:       "set-app-manifest [manifest-json]",
+					Short:     "Publish the app manifest of a service you own",
+					Long: "Publish the name, icon, redirect URIs and deep link domains wallets show for a service.\n" +
+						"HTTPS redirect URIs and universal link domains must be on the service's verified domain.\n\n" +
+						"Example:\n" +
+						`  snrd tx svc set-app-manifest '{"service_id":"my-app","name":"My App","icon_uri":"https://example.com/icon.png",` +
+						`"redirect_uris":["https://example.com/callback","myapp://callback"],"universal_link_domains":["app.example.com"],` +
+						`"deep_link_scheme":"myapp"}' --from owner`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "manifest"},
+					},
+				},
+				{
+					RpcMethod: "RemoveAppManifest",
+					Use:       "remove-app-manifest [service-id]",
+					Short:     "Withdraw the app manifest of a service you own",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "RegisterOIDCClient",
+					Use:       "register-oidc-client [service-id] [redirect-uris] [scopes]",
+					Short:     "Register an OpenID Connect client for a service you own",
+					Long: "Register a public OpenID Connect client; the client ID is returned in the transaction response.\n" +
+						"HTTPS redirect URIs and the origin must be on the service's verified domain, other redirect URIs\n" +
+						"must use the deep link scheme of its app manifest, and the scopes must include openid.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc register-oidc-client my-app https://example.com/callback,myapp://callback openid,profile \\\n" +
+						"    --origin https://example.com --from owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "redirect_uris"},
+						{ProtoField: "scopes"},
+					},
+				},
+				{
+					RpcMethod: "RemoveOIDCClient",
+					Use:       "remove-oidc-client [client-id]",
+					Short:     "Remove an OpenID Connect client of a service you own",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "client_id"},
+					},
+				},
+				{
+					RpcMethod: "SetAttestationPolicy",
+					Use:       "set-attestation-policy [policy-json]",
+					Short:     "Restrict the authenticators that may register with a service you own",
+					Long: "Set the attestation policy checked when WebAuthn credentials are registered with the service's domain.\n" +
+						"Allowed AAGUIDs and trusted roots (base64 DER CA certificates) imply require_attestation.\n\n" +
+						"Example:\n" +
+						`  snrd tx svc set-attestation-policy '{"service_id":"my-app","require_attestation":true,` +
+						`"allowed_formats":["packed","tpm"],"allowed_aaguids":["cb69481e-8ff7-4039-93ec-0a2729a154a8"],` +
+						`"require_device_bound":true}' --from owner`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "policy"},
+					},
+				},
+				{
+					RpcMethod: "RemoveAttestationPolicy",
+					Use:       "remove-attestation-policy [service-id]",
+					Short:     "Lift the authenticator restrictions of a service you own",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "AnchorBackup",
+					Use:       "anchor-backup [service-id] [dataset] [digest] [snapshot-at]",
+					Short:     "Anchor the SHA-256 digest of a snapshot of a service's off-chain dataset",
+					Long: "Record the digest of a dataset snapshot, e.g. the profiles table of a service you own.\n" +
+						"The digest is base64 encoded and snapshot-at is the Unix time the snapshot was taken;\n" +
+						"snapshots of a dataset must be anchored in order.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc anchor-backup my-app profiles 47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU= 1735689600 --record-count 1200 --from owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "dataset"},
+						{ProtoField: "digest"},
+						{ProtoField: "snapshot_at"},
+					},
+				},
+				{
+					RpcMethod: "MintViewKey",
+					Use:       "mint-view-key [owner-did] [grantee-did] [scopes] [ttl-seconds]",
+					Short:     "Grant a third party read-only access to a DID's data",
+					Long: "Mint a view key letting the grantee read the given scopes of the owner's data until the TTL elapses.\n" +
+						"The grantee presents the key ID with a UCAN it issued for the view:{owner} resource.\n" +
+						"Scopes: dex/history, dex/positions, bank/balances, did/document, svc/services, dwn/records.\n\n" +
+						"Example:\n" +
+						"  snrd tx svc mint-view-key did:sonr:alice did:sonr:accountant dex/history,bank/balances 2592000 --from alice",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "grantee"},
+						{ProtoField: "scopes"},
+						{ProtoField: "ttl_seconds"},
+					},
+				},
+				{
+					RpcMethod: "RevokeViewKey",
+					Use:       "revoke-view-key [owner-did] [view-key-id]",
+					Short:     "Revoke a view key minted by a DID you control",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "view_key_id"},
+					},
+				},
 			},
 		},
 	}
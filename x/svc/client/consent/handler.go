@@ -0,0 +1,88 @@
+// Package consent serves the scope catalog and localized consent strings so
+// OIDC and UCAN issuance flows render the same consent screen for a request.
+package consent
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// PathPrefix is where the catalog and consent endpoints are served
+const PathPrefix = "/sonr/scopes"
+
+// Item is a single line of a consent screen. Key is the message catalog key
+// the text was rendered from.
+type Item struct {
+	Scope string          `json:"scope"`
+	Risk  types.ScopeRisk `json:"risk"`
+	Key   string          `json:"key"`
+	Text  string          `json:"text"`
+}
+
+// Handler serves a scope catalog
+type Handler struct {
+	catalog  types.ScopeCatalog
+	messages *msgcatalog.Catalog
+}
+
+// NewHandler creates a handler for catalog. Consent text is rendered from
+// messages, which deployments may override; a nil messages uses the text of
+// the scope definitions.
+func NewHandler(catalog types.ScopeCatalog, messages *msgcatalog.Catalog) *Handler {
+	if messages == nil {
+		messages = msgcatalog.New(types.DefaultConsentLanguage)
+		messages.Merge(catalog.Messages())
+	}
+	return &Handler{catalog: catalog, messages: messages}
+}
+
+// RegisterRoutes registers the catalog and consent endpoints
+func (h *Handler) RegisterRoutes(e *echo.Echo) {
+	e.GET(PathPrefix, h.HandleCatalog)
+	e.GET(PathPrefix+"/consent", h.HandleConsent)
+}
+
+// HandleCatalog returns every scope definition with all translations
+func (h *Handler) HandleCatalog(c echo.Context) error {
+	defs := make([]types.ScopeDefinition, 0, len(h.catalog))
+	for _, name := range h.catalog.Names() {
+		defs = append(defs, h.catalog[name])
+	}
+	return c.JSON(http.StatusOK, defs)
+}
+
+// HandleConsent resolves the requested scopes (repeated or space separated
+// "scope" query parameters, as in OAuth) and returns consent lines in the
+// language chosen by the "lang" parameter or the Accept-Language header.
+func (h *Handler) HandleConsent(c echo.Context) error {
+	var scopes []string
+	for _, v := range c.QueryParams()["scope"] {
+		scopes = append(scopes, strings.Fields(v)...)
+	}
+	if len(scopes) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "at least one scope is required")
+	}
+
+	resolved, err := h.catalog.ResolveAll(scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	langs := msgcatalog.RequestLanguages(c.Request())
+	items := make([]Item, 0, len(resolved))
+	for _, r := range resolved {
+		key := types.ConsentKey(r.Definition.Name)
+		items = append(items, Item{
+			Scope: r.Name,
+			Risk:  r.Definition.Risk,
+			Key:   key,
+			Text:  h.messages.Text(langs, key, r.ConsentArgs()),
+		})
+	}
+	return c.JSON(http.StatusOK, items)
+}
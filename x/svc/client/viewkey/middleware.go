@@ -0,0 +1,78 @@
+// Package viewkey enforces view keys on composite read endpoints. A third
+// party presents the view key ID and a UCAN issued by the grantee DID; the
+// request only proceeds if the key is active and grants the endpoint's scope.
+package viewkey
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+const (
+	// HeaderViewKey carries the numeric view key ID
+	HeaderViewKey = "X-Sonr-View-Key"
+
+	// contextKey stores the authorized view key on the echo context
+	contextKey = "sonr.view_key"
+)
+
+// Authorizer checks a view key against the grantee's UCAN for a scope. It is
+// satisfied by the svc keeper's AuthorizeView on a query context, or by a
+// client forwarding the check to a node.
+type Authorizer func(ctx context.Context, id uint64, token, scope string) (types.ViewKey, error)
+
+// Require returns middleware admitting only requests carrying a view key
+// that grants scope. Handlers read the owner whose data may be served with
+// Owner and must not return data belonging to anyone else.
+func Require(authorize Authorizer, scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			id, err := strconv.ParseUint(req.Header.Get(HeaderViewKey), 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing or invalid view key")
+			}
+			token, ok := strings.CutPrefix(req.Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok || token == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing grantee UCAN")
+			}
+
+			key, err := authorize(req.Context(), id, token, scope)
+			switch {
+			case err == nil:
+			case errorsmod.IsOf(err, types.ErrViewKeyNotFound):
+				return echo.NewHTTPError(http.StatusNotFound, "view key not found")
+			case errorsmod.IsOf(err, types.ErrViewKeyDenied, types.ErrUCANValidationFailed):
+				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+			default:
+				return err
+			}
+
+			c.Set(contextKey, key)
+			// Delegated reads must never be served from a shared cache.
+			c.Response().Header().Set("Cache-Control", "no-store")
+			return next(c)
+		}
+	}
+}
+
+// FromContext returns the view key authorized for the request, if any
+func FromContext(c echo.Context) (types.ViewKey, bool) {
+	key, ok := c.Get(contextKey).(types.ViewKey)
+	return key, ok
+}
+
+// Owner returns the DID whose data the request may read, or "" when the
+// request was not authorized through a view key
+func Owner(c echo.Context) string {
+	key, _ := FromContext(c)
+	return key.Owner
+}
@@ -0,0 +1,236 @@
+package viewkey
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	dextypes "github.com/sonr-io/sonr/x/dex/types"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	dwntypes "github.com/sonr-io/sonr/x/dwn/types"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// PathPrefix is where the composite read endpoints are served. Each scope is
+// served at PathPrefix + "/" + scope, e.g. /sonr/view/dex/history.
+const PathPrefix = "/sonr/view"
+
+// routes serves the composite read endpoints. Every handler reads the owner
+// of the authorized view key and queries nothing else.
+type routes struct {
+	codec codec.JSONCodec
+	bank  banktypes.QueryClient
+	dex   dextypes.QueryClient
+	did   didtypes.QueryClient
+	dwn   dwntypes.QueryClient
+	svc   types.QueryClient
+}
+
+// NewHandler returns the composite read endpoints, one per view scope, each
+// behind Require for its scope. Endpoints accept limit and key (base64)
+// query parameters where the underlying query is paginated, and a height
+// parameter pinning every chain read of the request to that block.
+func NewHandler(clientCtx client.Context, authorize Authorizer) http.Handler {
+	r := &routes{
+		codec: clientCtx.Codec,
+		bank:  banktypes.NewQueryClient(clientCtx),
+		dex:   dextypes.NewQueryClient(clientCtx),
+		did:   didtypes.NewQueryClient(clientCtx),
+		dwn:   dwntypes.NewQueryClient(clientCtx),
+		svc:   types.NewQueryClient(clientCtx),
+	}
+
+	handlers := map[string]echo.HandlerFunc{
+		types.ViewScopeDexHistory:   r.dexHistory,
+		types.ViewScopeDexPositions: r.dexPositions,
+		types.ViewScopeBalances:     r.balances,
+		types.ViewScopeDIDDocument:  r.didDocument,
+		types.ViewScopeServices:     r.services,
+		types.ViewScopeDWNRecords:   r.dwnRecords,
+	}
+
+	e := echo.New()
+	e.HideBanner = true
+	for _, scope := range types.ViewScopes {
+		// The height is pinned after authorization, which reads the latest state
+		e.GET(PathPrefix+"/"+scope, handlers[scope], Require(authorize, scope), pinHeight)
+	}
+	return e
+}
+
+func (r *routes) dexHistory(c echo.Context) error {
+	page, err := pageRequest(c)
+	if err != nil {
+		return err
+	}
+	res, err := r.dex.History(c.Request().Context(), &dextypes.QueryHistoryRequest{
+		Did:           Owner(c),
+		ConnectionId:  c.QueryParam("connection_id"),
+		OperationType: c.QueryParam("operation_type"),
+		Pagination:    page,
+	})
+	return r.respond(c, res, err)
+}
+
+func (r *routes) dexPositions(c echo.Context) error {
+	page, err := pageRequest(c)
+	if err != nil {
+		return err
+	}
+	res, err := r.dex.Accounts(c.Request().Context(), &dextypes.QueryAccountsRequest{
+		Did:        Owner(c),
+		Pagination: page,
+	})
+	return r.respond(c, res, err)
+}
+
+func (r *routes) didDocument(c echo.Context) error {
+	res, err := r.did.GetDIDDocument(c.Request().Context(), &didtypes.QueryGetDIDDocumentRequest{
+		Did: Owner(c),
+	})
+	return r.respond(c, res, err)
+}
+
+func (r *routes) dwnRecords(c echo.Context) error {
+	page, err := pageRequest(c)
+	if err != nil {
+		return err
+	}
+	res, err := r.dwn.Records(c.Request().Context(), &dwntypes.QueryRecordsRequest{
+		Target:     Owner(c),
+		Protocol:   c.QueryParam("protocol"),
+		Schema:     c.QueryParam("schema"),
+		Pagination: page,
+	})
+	return r.respond(c, res, err)
+}
+
+// balances and services are keyed by account, so they are served for the
+// primary controller of the owner DID
+func (r *routes) balances(c echo.Context) error {
+	controller, err := r.controller(c)
+	if err != nil {
+		return err
+	}
+	page, err := pageRequest(c)
+	if err != nil {
+		return err
+	}
+	res, err := r.bank.AllBalances(c.Request().Context(), &banktypes.QueryAllBalancesRequest{
+		Address:    controller,
+		Pagination: page,
+	})
+	return r.respond(c, res, err)
+}
+
+func (r *routes) services(c echo.Context) error {
+	controller, err := r.controller(c)
+	if err != nil {
+		return err
+	}
+	page, err := pageRequest(c)
+	if err != nil {
+		return err
+	}
+	res, err := r.svc.ServicesByOwner(c.Request().Context(), &types.QueryServicesByOwnerRequest{
+		Owner:      controller,
+		Pagination: page,
+	})
+	return r.respond(c, res, err)
+}
+
+// controller returns the primary controller address of the owner DID
+func (r *routes) controller(c echo.Context) (string, error) {
+	res, err := r.did.GetDIDDocument(c.Request().Context(), &didtypes.QueryGetDIDDocumentRequest{
+		Did: Owner(c),
+	})
+	if err != nil {
+		return "", httpError(err)
+	}
+	if res.DidDocument == nil || res.DidDocument.PrimaryController == "" {
+		return "", echo.NewHTTPError(http.StatusNotFound, "owner has no controller account")
+	}
+	return res.DidDocument.PrimaryController, nil
+}
+
+// respond writes a query response in the proto JSON encoding the gRPC
+// gateway uses
+func (r *routes) respond(c echo.Context, res proto.Message, err error) error {
+	if err != nil {
+		return httpError(err)
+	}
+	bz, err := r.codec.MarshalJSON(res)
+	if err != nil {
+		return err
+	}
+	return c.JSONBlob(http.StatusOK, bz)
+}
+
+// pinHeight reads the height query parameter, or the gateway's
+// x-cosmos-block-height header, and pins the request's chain reads to that
+// height so a composite view is not torn across blocks. The pinned height is
+// echoed in the response header.
+func pinHeight(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		raw := c.QueryParam("height")
+		if raw == "" {
+			raw = c.Request().Header.Get(grpctypes.GRPCBlockHeightHeader)
+		}
+		if raw == "" {
+			return next(c)
+		}
+		height, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || height <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid height")
+		}
+
+		pinned := strconv.FormatInt(height, 10)
+		req := c.Request()
+		ctx := metadata.AppendToOutgoingContext(req.Context(), grpctypes.GRPCBlockHeightHeader, pinned)
+		c.SetRequest(req.WithContext(ctx))
+		c.Response().Header().Set(grpctypes.GRPCBlockHeightHeader, pinned)
+		return next(c)
+	}
+}
+
+// pageRequest reads the limit and key query parameters
+func pageRequest(c echo.Context) (*query.PageRequest, error) {
+	page := &query.PageRequest{}
+	if limit := c.QueryParam("limit"); limit != "" {
+		n, err := strconv.ParseUint(limit, 10, 64)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+		page.Limit = n
+	}
+	if key := c.QueryParam("key"); key != "" {
+		bz, err := base64.URLEncoding.DecodeString(key)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid page key")
+		}
+		page.Key = bz
+	}
+	return page, nil
+}
+
+// httpError maps a query error to its HTTP status
+func httpError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return echo.NewHTTPError(http.StatusNotFound, status.Convert(err).Message())
+	case codes.InvalidArgument:
+		return echo.NewHTTPError(http.StatusBadRequest, status.Convert(err).Message())
+	}
+	return err
+}
@@ -3,6 +3,7 @@ package module
 import (
 	"os"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -46,6 +47,8 @@ type ModuleInputs struct {
 	StakingKeeper  stakingkeeper.Keeper
 	SlashingKeeper slashingkeeper.Keeper
 	DIDKeeper      didkeeper.Keeper
+
+	MsgServiceRouter baseapp.MessageRouter
 }
 
 type ModuleOutputs struct {
@@ -64,6 +67,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		log.NewLogger(os.Stderr),
 		govAddr,
 		in.DIDKeeper,
+		in.MsgServiceRouter,
 	)
 	m := NewAppModule(in.Cdc, k)
 
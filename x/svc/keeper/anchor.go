@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// anchorRange ranges over the anchors of a service's dataset
+func anchorRange(serviceID, dataset string) *collections.Range[collections.Triple[string, string, uint64]] {
+	return new(collections.Range[collections.Triple[string, string, uint64]]).
+		Prefix(collections.TripleSuperPrefix[string, string, uint64](serviceID, dataset))
+}
+
+// AnchorBackup records the digest of a snapshot of a dataset of a service
+// owned by owner and returns its sequence. Snapshots must be anchored in
+// order, so the anchors of a dataset form a timeline an audit can replay.
+func (k Keeper) AnchorBackup(ctx context.Context, owner string, anchor types.BackupAnchor) (uint64, error) {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, anchor.ServiceId)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrServiceNotFound, "%s", anchor.ServiceId)
+	}
+	if service.Owner != owner {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, anchor.ServiceId)
+	}
+	if err := types.ValidateBackupAnchor(anchor.Dataset, anchor.Digest, anchor.SnapshotAt); err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	if anchor.SnapshotAt > now {
+		return 0, errorsmod.Wrap(types.ErrInvalidBackupAnchor, "snapshot time is in the future")
+	}
+
+	latest, found, err := k.LatestBackupAnchor(ctx, anchor.ServiceId, anchor.Dataset)
+	if err != nil {
+		return 0, err
+	}
+	if found && anchor.SnapshotAt <= latest.SnapshotAt {
+		return 0, errorsmod.Wrapf(
+			types.ErrInvalidBackupAnchor,
+			"snapshot time must be after the last anchored snapshot at %d", latest.SnapshotAt,
+		)
+	}
+
+	anchor.Sequence = latest.Sequence + 1
+	anchor.Height = sdkCtx.BlockHeight()
+	anchor.AnchoredAt = now
+	key := collections.Join3(anchor.ServiceId, anchor.Dataset, anchor.Sequence)
+	if err := k.BackupAnchors.Set(ctx, key, anchor); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBackupAnchored,
+			sdk.NewAttribute("service_id", anchor.ServiceId),
+			sdk.NewAttribute("dataset", anchor.Dataset),
+			sdk.NewAttribute("sequence", strconv.FormatUint(anchor.Sequence, 10)),
+			sdk.NewAttribute("digest", hex.EncodeToString(anchor.Digest)),
+		),
+	)
+	return anchor.Sequence, nil
+}
+
+// LatestBackupAnchor returns the most recent anchor of a service's dataset
+func (k Keeper) LatestBackupAnchor(ctx context.Context, serviceID, dataset string) (types.BackupAnchor, bool, error) {
+	iter, err := k.BackupAnchors.Iterate(ctx, anchorRange(serviceID, dataset).Descending())
+	if err != nil {
+		return types.BackupAnchor{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.BackupAnchor{}, false, nil
+	}
+	anchor, err := iter.Value()
+	if err != nil {
+		return types.BackupAnchor{}, false, err
+	}
+	return anchor, true, nil
+}
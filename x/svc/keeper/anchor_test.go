@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestAnchorBackup(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+
+	now := f.ctx.BlockTime().Unix()
+	first := sha256.Sum256([]byte("profiles@1"))
+	msg := &types.MsgAnchorBackup{
+		Owner:       owner,
+		ServiceId:   "my-app",
+		Dataset:     "profiles",
+		Digest:      first[:],
+		RecordCount: 10,
+		SnapshotAt:  now - 60,
+	}
+	require.NoError(msg.ValidateBasic())
+
+	// Only the owner anchors the service's datasets
+	_, err := f.msgServer.AnchorBackup(f.ctx, &types.MsgAnchorBackup{
+		Owner:      f.addrs[1].String(),
+		ServiceId:  "my-app",
+		Dataset:    "profiles",
+		Digest:     first[:],
+		SnapshotAt: now - 60,
+	})
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+
+	res, err := f.msgServer.AnchorBackup(f.ctx, msg)
+	require.NoError(err)
+	require.Equal(uint64(1), res.Sequence)
+
+	// A snapshot must be newer than the last anchored one
+	_, err = f.msgServer.AnchorBackup(f.ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidBackupAnchor)
+
+	msg.SnapshotAt = now + 60
+	_, err = f.msgServer.AnchorBackup(f.ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidBackupAnchor)
+
+	f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Hour)).WithBlockHeight(f.ctx.BlockHeight() + 1)
+	second := sha256.Sum256([]byte("profiles@2"))
+	msg.Digest = second[:]
+	msg.RecordCount = 12
+	res, err = f.msgServer.AnchorBackup(f.ctx, msg)
+	require.NoError(err)
+	require.Equal(uint64(2), res.Sequence)
+
+	// Datasets are sequenced independently
+	msg.Dataset = "credentials"
+	res, err = f.msgServer.AnchorBackup(f.ctx, msg)
+	require.NoError(err)
+	require.Equal(uint64(1), res.Sequence)
+
+	anchors, err := f.queryServer.BackupAnchors(f.ctx, &types.QueryBackupAnchorsRequest{
+		ServiceId:  "my-app",
+		Dataset:    "profiles",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(anchors.Anchors, 1)
+	require.Equal(first[:], anchors.Anchors[0].Digest)
+	require.Equal(uint64(10), anchors.Anchors[0].RecordCount)
+	require.NotEmpty(anchors.Pagination.NextKey)
+
+	anchors, err = f.queryServer.BackupAnchors(f.ctx, &types.QueryBackupAnchorsRequest{
+		ServiceId:  "my-app",
+		Dataset:    "profiles",
+		Pagination: &query.PageRequest{Key: anchors.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(anchors.Anchors, 1)
+	require.Equal(second[:], anchors.Anchors[0].Digest)
+	require.Equal(uint64(2), anchors.Anchors[0].Sequence)
+	require.Equal(f.ctx.BlockHeight(), anchors.Anchors[0].Height)
+}
+
+func TestAnchorBackupValidateBasic(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	digest := sha256.Sum256([]byte("credentials"))
+	msg := &types.MsgAnchorBackup{
+		Owner:      owner,
+		ServiceId:  "my-app",
+		Dataset:    "Profiles",
+		Digest:     digest[:],
+		SnapshotAt: f.ctx.BlockTime().Unix(),
+	}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBackupAnchor)
+
+	msg.Dataset = "profiles"
+	msg.Digest = digest[:16]
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBackupAnchor)
+
+	msg.Digest = digest[:]
+	msg.SnapshotAt = 0
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBackupAnchor)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// SetAttestationPolicy sets the attestation policy of a service owned by
+// owner. It applies to credentials registered with the service's domain as
+// relying party ID from then on; existing credentials are not re-checked.
+func (k Keeper) SetAttestationPolicy(ctx context.Context, owner string, policy types.AttestationPolicy) error {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, policy.ServiceId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s", policy.ServiceId)
+	}
+	if service.Owner != owner {
+		return errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, policy.ServiceId)
+	}
+	policy.NormalizeAAGUIDs()
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	policy.UpdatedAt = sdkCtx.BlockTime().Unix()
+	if err := k.AttestationPolicies.Set(ctx, policy.ServiceId, policy); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestationPolicySet,
+			sdk.NewAttribute("service_id", policy.ServiceId),
+			sdk.NewAttribute("owner", owner),
+		),
+	)
+	return nil
+}
+
+// RemoveAttestationPolicy lifts the attestation policy of a service owned by
+// owner
+func (k Keeper) RemoveAttestationPolicy(ctx context.Context, owner, serviceID string) error {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s", serviceID)
+	}
+	if service.Owner != owner {
+		return errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, serviceID)
+	}
+	has, err := k.AttestationPolicies.Has(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(types.ErrAttestationPolicyNotFound, "%s", serviceID)
+	}
+	if err := k.AttestationPolicies.Remove(ctx, serviceID); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeAttestationPolicyRemoved, sdk.NewAttribute("service_id", serviceID)),
+	)
+	return nil
+}
+
+// GetAttestationPolicy returns the attestation policy of a service
+func (k Keeper) GetAttestationPolicy(ctx context.Context, serviceID string) (types.AttestationPolicy, error) {
+	policy, err := k.AttestationPolicies.Get(ctx, serviceID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return policy, errorsmod.Wrapf(types.ErrAttestationPolicyNotFound, "%s", serviceID)
+	}
+	return policy, err
+}
+
+// CheckAttestation applies the attestation policies of every service on the
+// rpID domain to a credential being registered. Relying parties without a
+// service, and services without a policy, accept any attestation the x/did
+// module verified.
+func (k Keeper) CheckAttestation(ctx context.Context, rpID string, attestation didtypes.AttestationResult) error {
+	if rpID == "" {
+		return nil
+	}
+	services, err := k.GetServicesByDomain(ctx, rpID)
+	if err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	for _, service := range services {
+		policy, err := k.AttestationPolicies.Get(ctx, service.Id)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := policy.Check(attestation, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
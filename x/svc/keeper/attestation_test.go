@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// attestationCA issues a CA certificate and a leaf signed by it, both DER
+// encoded
+func attestationCA(t *testing.T, notAfter time.Time) (root, leaf []byte) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Attestation Root"},
+		NotBefore:             time.Unix(0, 0),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	root, err = x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Test Authenticator Attestation"},
+		NotBefore:    time.Unix(0, 0),
+		NotAfter:     notAfter,
+	}
+	leaf, err = x509.CreateCertificate(rand.Reader, leafTemplate, caTemplate, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+	return root, leaf
+}
+
+func TestAttestationPolicy(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+
+	const aaguid = "cb69481e-8ff7-4039-93ec-0a2729a154a8"
+	policy := types.AttestationPolicy{
+		ServiceId:          "my-app",
+		RequireAttestation: true,
+		AllowedFormats:     []string{didtypes.AttestationFormatPacked},
+		AllowedAaguids:     []string{"CB69481E-8FF7-4039-93EC-0A2729A154A8"},
+		RequireDeviceBound: true,
+	}
+	attested := didtypes.AttestationResult{
+		Format: didtypes.AttestationFormatPacked,
+		Type:   didtypes.AttestationTypeBasic,
+		AAGUID: []byte{
+			0xcb, 0x69, 0x48, 0x1e, 0x8f, 0xf7, 0x40, 0x39,
+			0x93, 0xec, 0x0a, 0x27, 0x29, 0xa1, 0x54, 0xa8,
+		},
+	}
+
+	// Without a policy every verified attestation is accepted
+	require.NoError(f.k.CheckAttestation(f.ctx, "example.com", didtypes.NoAttestation))
+
+	_, err := f.msgServer.SetAttestationPolicy(f.ctx, &types.MsgSetAttestationPolicy{Owner: f.addrs[1].String(), Policy: policy})
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+	for _, bad := range []func(p *types.AttestationPolicy){
+		func(p *types.AttestationPolicy) { p.AllowedFormats = []string{"fido-u2f"} },
+		func(p *types.AttestationPolicy) { p.AllowedAaguids = []string{"not-a-uuid"} },
+		func(p *types.AttestationPolicy) { p.TrustedRoots = [][]byte{[]byte("not a certificate")} },
+	} {
+		p := policy
+		bad(&p)
+		_, err := f.msgServer.SetAttestationPolicy(f.ctx, &types.MsgSetAttestationPolicy{Owner: owner, Policy: p})
+		require.ErrorIs(err, types.ErrInvalidAttestationPolicy, "%+v", p)
+	}
+
+	_, err = f.msgServer.SetAttestationPolicy(f.ctx, &types.MsgSetAttestationPolicy{Owner: owner, Policy: policy})
+	require.NoError(err)
+	res, err := f.queryServer.AttestationPolicy(f.ctx, &types.QueryAttestationPolicyRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	require.Equal([]string{aaguid}, res.Policy.AllowedAaguids)
+	require.Equal(f.ctx.BlockTime().Unix(), res.Policy.UpdatedAt)
+
+	require.NoError(f.k.CheckAttestation(f.ctx, "example.com", attested))
+	// Other relying parties are not affected
+	require.NoError(f.k.CheckAttestation(f.ctx, "other.com", didtypes.NoAttestation))
+
+	for _, bad := range []func(a *didtypes.AttestationResult){
+		func(a *didtypes.AttestationResult) { *a = didtypes.NoAttestation },
+		func(a *didtypes.AttestationResult) { a.Type = didtypes.AttestationTypeSelf },
+		func(a *didtypes.AttestationResult) {
+			a.Format, a.Type = didtypes.AttestationFormatTPM, didtypes.AttestationTypeAttCA
+		},
+		func(a *didtypes.AttestationResult) { a.AAGUID = make([]byte, 16) },
+		func(a *didtypes.AttestationResult) { a.BackupEligible = true },
+	} {
+		a := attested
+		bad(&a)
+		require.ErrorIs(f.k.CheckAttestation(f.ctx, "example.com", a), types.ErrAttestationRejected, "%+v", a)
+	}
+
+	_, err = f.msgServer.RemoveAttestationPolicy(f.ctx, &types.MsgRemoveAttestationPolicy{Owner: owner, ServiceId: "my-app"})
+	require.NoError(err)
+	require.NoError(f.k.CheckAttestation(f.ctx, "example.com", didtypes.NoAttestation))
+	_, err = f.queryServer.AttestationPolicy(f.ctx, &types.QueryAttestationPolicyRequest{ServiceId: "my-app"})
+	require.ErrorIs(err, types.ErrAttestationPolicyNotFound)
+}
+
+func TestAttestationPolicyTrustedRoots(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+
+	root, leaf := attestationCA(t, f.ctx.BlockTime().Add(time.Hour))
+	_, otherLeaf := attestationCA(t, f.ctx.BlockTime().Add(time.Hour))
+
+	// Only CA certificates can be trusted roots
+	_, err := f.msgServer.SetAttestationPolicy(f.ctx, &types.MsgSetAttestationPolicy{
+		Owner:  owner,
+		Policy: types.AttestationPolicy{ServiceId: "my-app", TrustedRoots: [][]byte{leaf}},
+	})
+	require.ErrorIs(err, types.ErrInvalidAttestationPolicy)
+
+	_, err = f.msgServer.SetAttestationPolicy(f.ctx, &types.MsgSetAttestationPolicy{
+		Owner:  owner,
+		Policy: types.AttestationPolicy{ServiceId: "my-app", TrustedRoots: [][]byte{root}},
+	})
+	require.NoError(err)
+
+	attested := didtypes.AttestationResult{
+		Format:           didtypes.AttestationFormatPacked,
+		Type:             didtypes.AttestationTypeBasic,
+		CertificateChain: [][]byte{leaf},
+	}
+	require.NoError(f.k.CheckAttestation(f.ctx, "example.com", attested))
+
+	attested.CertificateChain = [][]byte{otherLeaf}
+	require.ErrorIs(f.k.CheckAttestation(f.ctx, "example.com", attested), types.ErrAttestationRejected)
+
+	// Certificates are checked at block time
+	attested.CertificateChain = [][]byte{leaf}
+	later := f.ctx.WithBlockTime(f.ctx.BlockTime().Add(2 * time.Hour))
+	require.ErrorIs(f.k.CheckAttestation(later, "example.com", attested), types.ErrAttestationRejected)
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// SetBadgeIssuer designates an account to issue badges of the given types,
+// replacing the types of an existing issuer
+func (k Keeper) SetBadgeIssuer(ctx context.Context, authority string, issuer types.BadgeIssuer) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, authority)
+	}
+	if err := issuer.Validate(); err != nil {
+		return err
+	}
+	if err := k.BadgeIssuers.Set(ctx, issuer.Address, issuer); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBadgeIssuerSet,
+			sdk.NewAttribute("issuer", issuer.Address),
+			sdk.NewAttribute("name", issuer.Name),
+		),
+	)
+	return nil
+}
+
+// RemoveBadgeIssuer withdraws an issuer's designation. Badges it already
+// issued stay valid until they expire or are revoked.
+func (k Keeper) RemoveBadgeIssuer(ctx context.Context, authority, address string) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, authority)
+	}
+	has, err := k.BadgeIssuers.Has(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(types.ErrNotBadgeIssuer, "%s", address)
+	}
+	if err := k.BadgeIssuers.Remove(ctx, address); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBadgeIssuerRemoved, sdk.NewAttribute("issuer", address)),
+	)
+	return nil
+}
+
+// IssueBadge records an attestation by a designated issuer that a service or
+// profile passed a verification. Service badges require an active service,
+// and a domain-verified badge also requires the service's domain to have
+// passed DNS verification; profile badges require an active DID.
+func (k Keeper) IssueBadge(
+	ctx context.Context,
+	issuer, subject string,
+	badgeType types.BadgeType,
+	evidence string,
+	expiresAt int64,
+) (types.Badge, error) {
+	if err := types.ValidateBadgeSubject(badgeType, subject); err != nil {
+		return types.Badge{}, err
+	}
+	designation, err := k.BadgeIssuers.Get(ctx, issuer)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && !designation.CanIssue(badgeType)) {
+		return types.Badge{}, errorsmod.Wrapf(types.ErrNotBadgeIssuer, "%s may not issue %s", issuer, badgeType)
+	}
+	if err != nil {
+		return types.Badge{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	if expiresAt != 0 && expiresAt <= now {
+		return types.Badge{}, errorsmod.Wrap(types.ErrInvalidBadge, "expiry must be in the future")
+	}
+	if err := k.validateBadgeSubject(ctx, badgeType, subject); err != nil {
+		return types.Badge{}, err
+	}
+
+	badge := types.Badge{
+		Subject:   subject,
+		BadgeType: badgeType,
+		Issuer:    issuer,
+		Evidence:  evidence,
+		IssuedAt:  now,
+		ExpiresAt: expiresAt,
+	}
+	if err := k.Badges.Set(ctx, collections.Join(subject, int32(badgeType)), badge); err != nil {
+		return types.Badge{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBadgeIssued,
+			sdk.NewAttribute("subject", subject),
+			sdk.NewAttribute("badge_type", badgeType.String()),
+			sdk.NewAttribute("issuer", issuer),
+			sdk.NewAttribute("expires_at", strconv.FormatInt(expiresAt, 10)),
+		),
+	)
+	return badge, nil
+}
+
+// validateBadgeSubject checks that the subject of a badge exists and is active
+func (k Keeper) validateBadgeSubject(ctx context.Context, badgeType types.BadgeType, subject string) error {
+	if badgeType.IssuedToDID() {
+		doc, err := k.didKeeper.GetDIDDocument(ctx, subject)
+		if err != nil || doc == nil || doc.Deactivated {
+			return errorsmod.Wrapf(types.ErrInvalidBadge, "%s is not an active DID", subject)
+		}
+		return nil
+	}
+
+	service, err := k.OrmDB.ServiceTable().Get(ctx, subject)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s", subject)
+	}
+	if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+		return errorsmod.Wrapf(types.ErrServiceNotActive, "%s", subject)
+	}
+	if badgeType == types.BadgeType_BADGE_TYPE_DOMAIN_VERIFIED {
+		verification, err := k.OrmDB.DomainVerificationTable().Get(ctx, service.Domain)
+		if err != nil || verification.Status != apiv1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED {
+			return errorsmod.Wrapf(types.ErrDomainNotVerified, "%s", service.Domain)
+		}
+	}
+	return nil
+}
+
+// RevokeBadge withdraws a badge. Only the account that issued it may revoke
+// it, even after its designation was removed.
+func (k Keeper) RevokeBadge(
+	ctx context.Context,
+	issuer, subject string,
+	badgeType types.BadgeType,
+	reason string,
+) error {
+	key := collections.Join(subject, int32(badgeType))
+	badge, err := k.Badges.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && badge.Revoked) {
+		return errorsmod.Wrapf(types.ErrBadgeNotFound, "%s has no %s badge", subject, badgeType)
+	}
+	if err != nil {
+		return err
+	}
+	if badge.Issuer != issuer {
+		return errorsmod.Wrapf(types.ErrNotBadgeIssuer, "badge was issued by %s", badge.Issuer)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	badge.Revoked = true
+	badge.RevokedAt = sdkCtx.BlockTime().Unix()
+	badge.RevocationReason = reason
+	if err := k.Badges.Set(ctx, key, badge); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBadgeRevoked,
+			sdk.NewAttribute("subject", subject),
+			sdk.NewAttribute("badge_type", badgeType.String()),
+			sdk.NewAttribute("issuer", issuer),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// GetBadges returns the badges issued to a subject in badge type order
+func (k Keeper) GetBadges(ctx context.Context, subject string) ([]types.Badge, error) {
+	iter, err := k.Badges.Iterate(ctx, collections.NewPrefixedPairRange[string, int32](subject))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// HasBadge reports whether a subject holds an active badge of the given type
+func (k Keeper) HasBadge(ctx context.Context, subject string, badgeType types.BadgeType) (bool, error) {
+	badge, err := k.Badges.Get(ctx, collections.Join(subject, int32(badgeType)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return badge.Active(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()), nil
+}
+
+// IsVerified reports whether a DID is verified for other modules' purposes:
+// it holds an active human-verified badge, or its primary controller owns an
+// active service holding an active KYB-verified badge
+func (k Keeper) IsVerified(ctx context.Context, did string) (bool, error) {
+	human, err := k.HasBadge(ctx, did, types.BadgeType_BADGE_TYPE_HUMAN_VERIFIED)
+	if err != nil || human {
+		return human, err
+	}
+
+	doc, err := k.didKeeper.GetDIDDocument(ctx, did)
+	if err != nil || doc == nil || doc.Deactivated || doc.PrimaryController == "" {
+		return false, nil
+	}
+
+	iter, err := k.OrmDB.ServiceTable().List(ctx, apiv1.ServiceOwnerIndexKey{}.WithOwner(doc.PrimaryController))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		service, err := iter.Value()
+		if err != nil {
+			return false, err
+		}
+		if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+			continue
+		}
+		kyb, err := k.HasBadge(ctx, service.Id, types.BadgeType_BADGE_TYPE_KYB_VERIFIED)
+		if err != nil || kyb {
+			return kyb, err
+		}
+	}
+	return false, nil
+}
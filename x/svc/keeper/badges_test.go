@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestBadgeIssuance(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+	issuer := f.addrs[1].String()
+
+	_, err := f.k.IssueBadge(f.ctx, issuer, "my-app", types.BadgeType_BADGE_TYPE_KYB_VERIFIED, "sha256:kyb", 0)
+	require.ErrorIs(err, types.ErrNotBadgeIssuer)
+
+	designation := types.BadgeIssuer{
+		Address: issuer,
+		Types:   []types.BadgeType{types.BadgeType_BADGE_TYPE_KYB_VERIFIED, types.BadgeType_BADGE_TYPE_DOMAIN_VERIFIED},
+		Name:    "Acme KYB",
+	}
+	err = f.k.SetBadgeIssuer(f.ctx, owner, designation)
+	require.Error(err, "only governance designates issuers")
+	_, err = f.msgServer.SetBadgeIssuer(f.ctx, &types.MsgSetBadgeIssuer{Authority: f.govModAddr, Issuer: designation})
+	require.NoError(err)
+
+	// Service badges go to service IDs, profile badges to DIDs
+	_, err = f.k.IssueBadge(f.ctx, issuer, "did:sonr:alice", types.BadgeType_BADGE_TYPE_KYB_VERIFIED, "", 0)
+	require.ErrorIs(err, types.ErrInvalidBadge)
+	_, err = f.k.IssueBadge(f.ctx, issuer, "did:sonr:alice", types.BadgeType_BADGE_TYPE_HUMAN_VERIFIED, "", 0)
+	require.ErrorIs(err, types.ErrNotBadgeIssuer)
+	_, err = f.k.IssueBadge(f.ctx, issuer, "unknown-app", types.BadgeType_BADGE_TYPE_KYB_VERIFIED, "", 0)
+	require.ErrorIs(err, types.ErrServiceNotFound)
+
+	_, err = f.msgServer.IssueBadge(f.ctx, &types.MsgIssueBadge{
+		Issuer: issuer, Subject: "my-app", BadgeType: types.BadgeType_BADGE_TYPE_KYB_VERIFIED, Evidence: "sha256:kyb",
+	})
+	require.NoError(err)
+	_, err = f.msgServer.IssueBadge(f.ctx, &types.MsgIssueBadge{
+		Issuer:    issuer,
+		Subject:   "my-app",
+		BadgeType: types.BadgeType_BADGE_TYPE_DOMAIN_VERIFIED,
+		ExpiresAt: f.ctx.BlockTime().Unix() + 60,
+	})
+	require.NoError(err)
+
+	res, err := f.queryServer.Badges(f.ctx, &types.QueryBadgesRequest{Subject: "my-app"})
+	require.NoError(err)
+	require.Len(res.Badges, 2)
+	for _, info := range res.Badges {
+		require.True(info.Active)
+		require.Equal(issuer, info.Badge.Issuer)
+	}
+
+	// An expired badge is only listed on request
+	later := f.ctx.WithBlockTime(f.ctx.BlockTime().Add(61 * time.Second))
+	res, err = f.queryServer.Badges(later, &types.QueryBadgesRequest{Subject: "my-app"})
+	require.NoError(err)
+	require.Len(res.Badges, 1)
+	require.Equal(types.BadgeType_BADGE_TYPE_KYB_VERIFIED, res.Badges[0].Badge.BadgeType)
+	res, err = f.queryServer.Badges(later, &types.QueryBadgesRequest{Subject: "my-app", IncludeInactive: true})
+	require.NoError(err)
+	require.Len(res.Badges, 2)
+
+	issuers, err := f.queryServer.BadgeIssuers(f.ctx, &types.QueryBadgeIssuersRequest{})
+	require.NoError(err)
+	require.Equal([]types.BadgeIssuer{designation}, issuers.Issuers)
+}
+
+func TestBadgeRevocationAndVerification(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+	issuer := f.addrs[1].String()
+	f.didKeeper.controllers = map[string]string{"did:sonr:owner": owner}
+
+	_, err := f.msgServer.SetBadgeIssuer(f.ctx, &types.MsgSetBadgeIssuer{
+		Authority: f.govModAddr,
+		Issuer: types.BadgeIssuer{
+			Address: issuer,
+			Types:   []types.BadgeType{types.BadgeType_BADGE_TYPE_KYB_VERIFIED, types.BadgeType_BADGE_TYPE_HUMAN_VERIFIED},
+		},
+	})
+	require.NoError(err)
+
+	verified, err := f.k.IsVerified(f.ctx, "did:sonr:owner")
+	require.NoError(err)
+	require.False(verified)
+
+	// The owner's DID is verified through the KYB badge of its service
+	_, err = f.k.IssueBadge(f.ctx, issuer, "my-app", types.BadgeType_BADGE_TYPE_KYB_VERIFIED, "", 0)
+	require.NoError(err)
+	verified, err = f.k.IsVerified(f.ctx, "did:sonr:owner")
+	require.NoError(err)
+	require.True(verified)
+
+	_, err = f.k.IssueBadge(f.ctx, issuer, "did:sonr:alice", types.BadgeType_BADGE_TYPE_HUMAN_VERIFIED, "", 0)
+	require.NoError(err)
+	verified, err = f.k.IsVerified(f.ctx, "did:sonr:alice")
+	require.NoError(err)
+	require.True(verified)
+
+	// Only the issuer may revoke, even after losing its designation
+	err = f.k.RevokeBadge(f.ctx, owner, "my-app", types.BadgeType_BADGE_TYPE_KYB_VERIFIED, "fraud")
+	require.ErrorIs(err, types.ErrNotBadgeIssuer)
+	_, err = f.msgServer.RemoveBadgeIssuer(f.ctx, &types.MsgRemoveBadgeIssuer{Authority: f.govModAddr, Issuer: issuer})
+	require.NoError(err)
+	_, err = f.msgServer.RevokeBadge(f.ctx, &types.MsgRevokeBadge{
+		Issuer: issuer, Subject: "my-app", BadgeType: types.BadgeType_BADGE_TYPE_KYB_VERIFIED, Reason: "fraud",
+	})
+	require.NoError(err)
+	err = f.k.RevokeBadge(f.ctx, issuer, "my-app", types.BadgeType_BADGE_TYPE_KYB_VERIFIED, "fraud")
+	require.ErrorIs(err, types.ErrBadgeNotFound)
+
+	verified, err = f.k.IsVerified(f.ctx, "did:sonr:owner")
+	require.NoError(err)
+	require.False(verified)
+	has, err := f.k.HasBadge(f.ctx, "did:sonr:alice", types.BadgeType_BADGE_TYPE_HUMAN_VERIFIED)
+	require.NoError(err)
+	require.True(has, "removing the issuer keeps its badges")
+}
@@ -3,9 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
 	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/app/clock"
 	"github.com/sonr-io/crypto/ucan"
 	"github.com/sonr-io/sonr/x/svc/types"
 )
@@ -72,7 +72,7 @@ func (k Keeper) CreateServiceRootCapability(
 	}
 
 	// Generate unique capability ID
-	capabilityID := fmt.Sprintf("cap_%s_%d", msg.ServiceId, time.Now().UnixNano())
+	capabilityID := fmt.Sprintf("cap_%s_%d", msg.ServiceId, clock.BlockTime(ctx).UnixNano())
 
 	k.logger.Info(
 		"Service root capability created",
@@ -162,7 +162,7 @@ func (k Keeper) CreatePermissionCapabilityChain(
 		capabilityID := fmt.Sprintf("cap_%s_%s_%d_%d",
 			serviceID,
 			permission,
-			time.Now().UnixNano(),
+			clock.BlockTime(ctx).UnixNano(),
 			i,
 		)
 
@@ -331,8 +331,7 @@ func (k Keeper) ValidateCapability(
 	}
 
 	// Validate expiration
-	currentTime := time.Now().Unix()
-	if capability.ExpiresAt > 0 && capability.ExpiresAt < currentTime {
+	if clock.Expired(clock.BlockTime(ctx), capability.ExpiresAt) {
 		return nil, fmt.Errorf("capability %s has expired", capabilityID)
 	}
 
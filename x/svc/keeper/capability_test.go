@@ -40,7 +40,7 @@ func (suite *CapabilityTestSuite) SetupTest() {
 		key,
 		storetypes.NewTransientStoreKey("transient_test"),
 	)
-	suite.ctx = testCtx.Ctx.WithBlockHeader(sdk.Context{}.BlockHeader())
+	suite.ctx = testCtx.Ctx.WithBlockHeader(sdk.Context{}.BlockHeader()).WithBlockTime(testBlockTime)
 
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 	suite.cdc = encCfg.Codec
@@ -56,6 +56,7 @@ func (suite *CapabilityTestSuite) SetupTest() {
 		log.NewNopLogger(),
 		authority.String(),
 		mockDIDKeeper,
+		nil,
 	)
 }
 
@@ -80,8 +81,8 @@ func (suite *CapabilityTestSuite) TestCreateCapability() {
 					Domain:       "example.com",
 					Abilities:    []string{"read", "write"},
 					Owner:        "cosmos1abc123",
-					CreatedAt:    time.Now().Unix(),
-					ExpiresAt:    time.Now().Add(24 * time.Hour).Unix(),
+					CreatedAt:    testBlockTime.Unix(),
+					ExpiresAt:    testBlockTime.Add(24 * time.Hour).Unix(),
 					Revoked:      false,
 				}
 			},
@@ -141,8 +142,8 @@ func (suite *CapabilityTestSuite) TestValidateCapability() {
 		Domain:       "test.com",
 		Abilities:    []string{"read", "write"},
 		Owner:        "cosmos1test",
-		CreatedAt:    time.Now().Unix(),
-		ExpiresAt:    time.Now().Add(24 * time.Hour).Unix(),
+		CreatedAt:    testBlockTime.Unix(),
+		ExpiresAt:    testBlockTime.Add(24 * time.Hour).Unix(),
 		Revoked:      false,
 	}
 	err := suite.keeper.StoreCapability(suite.ctx, capability)
@@ -214,8 +215,8 @@ func (suite *CapabilityTestSuite) TestRevokeCapability() {
 		Domain:       "revoke.com",
 		Abilities:    []string{"admin"},
 		Owner:        "cosmos1owner",
-		CreatedAt:    time.Now().Unix(),
-		ExpiresAt:    time.Now().Add(24 * time.Hour).Unix(),
+		CreatedAt:    testBlockTime.Unix(),
+		ExpiresAt:    testBlockTime.Add(24 * time.Hour).Unix(),
 		Revoked:      false,
 	}
 	err := suite.keeper.StoreCapability(suite.ctx, capability)
@@ -292,8 +293,8 @@ func (suite *CapabilityTestSuite) TestExpiredCapability() {
 		Domain:       "expired.com",
 		Abilities:    []string{"read"},
 		Owner:        "cosmos1expired",
-		CreatedAt:    time.Now().Add(-48 * time.Hour).Unix(),
-		ExpiresAt:    time.Now().Add(-24 * time.Hour).Unix(), // Expired
+		CreatedAt:    testBlockTime.Add(-48 * time.Hour).Unix(),
+		ExpiresAt:    testBlockTime.Add(-24 * time.Hour).Unix(), // Expired
 		Revoked:      false,
 	}
 	err := suite.keeper.StoreCapability(suite.ctx, expiredCapability)
@@ -320,8 +321,8 @@ func (suite *CapabilityTestSuite) TestCapabilityChainValidation() {
 			Domain:       "chain.com",
 			Abilities:    []string{"read"},
 			Owner:        "cosmos1chain",
-			CreatedAt:    time.Now().Unix(),
-			ExpiresAt:    time.Now().Add(24 * time.Hour).Unix(),
+			CreatedAt:    testBlockTime.Unix(),
+			ExpiresAt:    testBlockTime.Add(24 * time.Hour).Unix(),
 			Revoked:      false,
 		},
 		{
@@ -330,8 +331,8 @@ func (suite *CapabilityTestSuite) TestCapabilityChainValidation() {
 			Domain:       "chain.com",
 			Abilities:    []string{"write"},
 			Owner:        "cosmos1chain",
-			CreatedAt:    time.Now().Unix(),
-			ExpiresAt:    time.Now().Add(24 * time.Hour).Unix(),
+			CreatedAt:    testBlockTime.Unix(),
+			ExpiresAt:    testBlockTime.Add(24 * time.Hour).Unix(),
 			Revoked:      false,
 		},
 	}
@@ -425,8 +426,8 @@ func (m *MockDIDKeeper) ResolveDID(
 
 	metadata := &didtypes.DIDDocumentMetadata{
 		VersionId:   "1",
-		Created:     time.Now().Unix(),
-		Updated:     time.Now().Unix(),
+		Created:     testBlockTime.Unix(),
+		Updated:     testBlockTime.Unix(),
 		Deactivated: 0,
 	}
 
@@ -459,3 +460,14 @@ func (m *MockDIDKeeper) VerifyDIDDocumentSignature(
 	// For testing, always return true
 	return true, nil
 }
+
+func (m *MockDIDKeeper) HasExistingCredential(ctx sdk.Context, credentialId string) bool {
+	return false
+}
+
+func (m *MockDIDKeeper) GetWebAuthnCredentialsByRPID(
+	ctx context.Context,
+	rpID string,
+) ([]didtypes.RPCredential, error) {
+	return nil, nil
+}
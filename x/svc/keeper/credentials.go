@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// ExportCredentials exports the public metadata of the credentials registered
+// with a service's relying party, followed by the credentials it imported and
+// has not yet replaced. The export is sealed with its digest so an import can
+// detect modification; it never contains private key material.
+func (k Keeper) ExportCredentials(ctx context.Context, serviceID string) (types.CredentialExport, error) {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return types.CredentialExport{}, errorsmod.Wrapf(types.ErrServiceNotFound, "%s", serviceID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	export := types.CredentialExport{
+		ServiceId:  serviceID,
+		RpId:       service.Domain,
+		Height:     sdkCtx.BlockHeight(),
+		ExportedAt: sdkCtx.BlockTime().Unix(),
+	}
+
+	registered, err := k.didKeeper.GetWebAuthnCredentialsByRPID(ctx, service.Domain)
+	if err != nil {
+		return types.CredentialExport{}, err
+	}
+	for _, rc := range registered {
+		record := types.CredentialRecord{
+			CredentialId: rc.Credential.CredentialId,
+			PublicKey:    rc.Credential.PublicKey,
+			Algorithm:    rc.Credential.Algorithm,
+			RpId:         rc.Credential.RpId,
+			Transports:   rc.Credential.Transports,
+			CreatedAt:    rc.Credential.CreatedAt,
+			Did:          rc.DID,
+		}
+		if len(rc.AlsoKnownAs) > 0 {
+			record.Username = rc.AlsoKnownAs[0]
+		}
+		export.Credentials = append(export.Credentials, record)
+	}
+
+	err = k.walkImportedCredentials(ctx, serviceID, func(imported types.ImportedCredential) error {
+		if imported.Status != types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_REREGISTERED {
+			export.Credentials = append(export.Credentials, imported.Credential)
+		}
+		return nil
+	})
+	if err != nil {
+		return types.CredentialExport{}, err
+	}
+
+	if err := export.Seal(); err != nil {
+		return types.CredentialExport{}, err
+	}
+	return export, nil
+}
+
+// ImportCredentials imports a credential export from another relying party
+// into a service. Credentials registered with the service's own relying party
+// are active at once; the others wait for their users to register a new
+// credential. A credential whose ID is already registered or imported is a
+// collision: it is skipped and reported, or fails the whole import when
+// failOnCollision is set. Only the service owner may call it.
+func (k Keeper) ImportCredentials(
+	ctx context.Context,
+	owner, serviceID string,
+	export types.CredentialExport,
+	failOnCollision bool,
+) (*types.MsgImportCredentialsResponse, error) {
+	service, err := k.getOwnedActiveService(ctx, owner, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := export.Validate(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res := &types.MsgImportCredentialsResponse{}
+	skip := make([]bool, len(export.Credentials))
+	seen := make(map[string]bool, len(export.Credentials))
+	for i, cred := range export.Credentials {
+		collides := seen[cred.CredentialId] || k.didKeeper.HasExistingCredential(sdkCtx, cred.CredentialId)
+		if !collides {
+			collides, err = k.ImportedCredentials.Has(ctx, cred.CredentialId)
+			if err != nil {
+				return nil, err
+			}
+		}
+		seen[cred.CredentialId] = true
+		if collides {
+			if failOnCollision {
+				return nil, errorsmod.Wrapf(types.ErrCredentialCollision, "%s", cred.CredentialId)
+			}
+			skip[i] = true
+			res.Collisions = append(res.Collisions, cred.CredentialId)
+		}
+	}
+
+	for i, cred := range export.Credentials {
+		if skip[i] {
+			continue
+		}
+
+		imported := types.ImportedCredential{
+			ServiceId:  serviceID,
+			Credential: cred,
+			Status:     types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_ACTIVE,
+			ImportedAt: sdkCtx.BlockTime().Unix(),
+		}
+		// A credential is bound to the relying party it was registered with,
+		// so its authenticator will not sign for another domain
+		if cred.RpId != service.Domain {
+			imported.Status = types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_PENDING_REREGISTRATION
+			res.PendingReregistration++
+		} else {
+			res.Imported++
+		}
+
+		if err := k.ImportedCredentials.Set(ctx, cred.CredentialId, imported); err != nil {
+			return nil, err
+		}
+		if err := k.ImportedCredentialsByService.Set(ctx, collections.Join(serviceID, cred.CredentialId)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCredentialsImported{
+		ServiceId:             serviceID,
+		SourceRpId:            export.RpId,
+		Imported:              res.Imported,
+		PendingReregistration: res.PendingReregistration,
+		Collisions:            res.Collisions,
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReregisterCredential replaces an imported credential pending
+// re-registration with the credential its user registered with the service.
+// The new credential must already be registered on chain. Only the service
+// owner may call it.
+func (k Keeper) ReregisterCredential(
+	ctx context.Context,
+	owner, serviceID, credentialID, newCredentialID string,
+) error {
+	if _, err := k.getOwnedActiveService(ctx, owner, serviceID); err != nil {
+		return err
+	}
+
+	imported, err := k.ImportedCredentials.Get(ctx, credentialID)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && imported.ServiceId != serviceID) {
+		return errorsmod.Wrapf(types.ErrCredentialNotFound, "%s", credentialID)
+	}
+	if err != nil {
+		return err
+	}
+	if imported.Status != types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_PENDING_REREGISTRATION {
+		return errorsmod.Wrapf(types.ErrCredentialNotPending, "%s is %s", credentialID, imported.Status)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.didKeeper.HasExistingCredential(sdkCtx, newCredentialID) {
+		return errorsmod.Wrapf(types.ErrCredentialNotFound, "%s is not registered", newCredentialID)
+	}
+
+	imported.Status = types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_REREGISTERED
+	imported.ReregisteredAs = newCredentialID
+	if err := k.ImportedCredentials.Set(ctx, credentialID, imported); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventCredentialReregistered{
+		ServiceId:       serviceID,
+		CredentialId:    credentialID,
+		NewCredentialId: newCredentialID,
+	})
+}
+
+// getOwnedActiveService gets a service, checking that owner owns it and that
+// it is active
+func (k Keeper) getOwnedActiveService(ctx context.Context, owner, serviceID string) (*apiv1.Service, error) {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrServiceNotFound, "%s", serviceID)
+	}
+	if service.Owner != owner {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, serviceID)
+	}
+	if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(types.ErrServiceNotActive, "%s", serviceID)
+	}
+	return service, nil
+}
+
+// walkImportedCredentials calls fn with each credential a service imported,
+// in credential ID order
+func (k Keeper) walkImportedCredentials(
+	ctx context.Context,
+	serviceID string,
+	fn func(types.ImportedCredential) error,
+) error {
+	iter, err := k.ImportedCredentialsByService.Iterate(ctx, collections.NewPrefixedPairRange[string, string](serviceID))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		imported, err := k.ImportedCredentials.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := fn(imported); err != nil {
+			return err
+		}
+	}
+	return nil
+}
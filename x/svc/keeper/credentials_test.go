@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func sealedExport(t *testing.T, rpID string, records ...types.CredentialRecord) types.CredentialExport {
+	t.Helper()
+	export := types.CredentialExport{ServiceId: "legacy", RpId: rpID, Credentials: records}
+	require.NoError(t, export.Seal())
+	return export
+}
+
+func TestExportCredentials(t *testing.T) {
+	f, _ := setupSponsoredService(t)
+	require := require.New(t)
+
+	f.didKeeper.credentials = []didtypes.RPCredential{
+		{
+			DID:         "did:sonr:alice",
+			AlsoKnownAs: []string{"alice"},
+			Credential: &didtypes.WebAuthnCredential{
+				CredentialId: "cred-alice",
+				PublicKey:    []byte{1, 2, 3},
+				Algorithm:    -7,
+				RpId:         "example.com",
+				Transports:   []string{"internal"},
+			},
+		},
+		{
+			DID:        "did:sonr:bob",
+			Credential: &didtypes.WebAuthnCredential{CredentialId: "cred-bob", RpId: "other.com"},
+		},
+	}
+
+	res, err := f.queryServer.ExportCredentials(f.ctx, &types.QueryExportCredentialsRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	export := res.Export
+	require.Equal("example.com", export.RpId)
+	require.Len(export.Credentials, 1)
+	require.Equal("cred-alice", export.Credentials[0].CredentialId)
+	require.Equal("did:sonr:alice", export.Credentials[0].Did)
+	require.Equal("alice", export.Credentials[0].Username)
+	require.NoError(export.Validate())
+
+	// Any change to the export breaks its digest
+	export.Credentials[0].RpId = "evil.com"
+	require.ErrorIs(export.Validate(), types.ErrInvalidCredentialExport)
+}
+
+func TestImportCredentials(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+
+	f.didKeeper.credentials = []didtypes.RPCredential{{
+		DID:        "did:sonr:alice",
+		Credential: &didtypes.WebAuthnCredential{CredentialId: "cred-taken", RpId: "example.com"},
+	}}
+	export := sealedExport(t, "legacy.example.org",
+		types.CredentialRecord{CredentialId: "cred-same-rp", PublicKey: []byte{1}, RpId: "example.com", Username: "carol"},
+		types.CredentialRecord{CredentialId: "cred-old-rp", PublicKey: []byte{2}, RpId: "legacy.example.org"},
+		types.CredentialRecord{CredentialId: "cred-taken", PublicKey: []byte{3}, RpId: "example.com"},
+	)
+
+	_, err := f.k.ImportCredentials(f.ctx, f.addrs[1].String(), "my-app", export, false)
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+	_, err = f.k.ImportCredentials(f.ctx, owner, "my-app", export, true)
+	require.ErrorIs(err, types.ErrCredentialCollision)
+
+	res, err := f.msgServer.ImportCredentials(f.ctx, &types.MsgImportCredentials{
+		Owner:     owner,
+		ServiceId: "my-app",
+		Export:    export,
+	})
+	require.NoError(err)
+	require.Equal(uint32(1), res.Imported)
+	require.Equal(uint32(1), res.PendingReregistration)
+	require.Equal([]string{"cred-taken"}, res.Collisions)
+
+	// Importing the same export again collides on every credential
+	res, err = f.msgServer.ImportCredentials(f.ctx, &types.MsgImportCredentials{
+		Owner:     owner,
+		ServiceId: "my-app",
+		Export:    export,
+	})
+	require.NoError(err)
+	require.Len(res.Collisions, 3)
+
+	page, err := f.queryServer.ImportedCredentials(f.ctx, &types.QueryImportedCredentialsRequest{
+		ServiceId:  "my-app",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), page.Pagination.Total)
+	require.Equal("cred-old-rp", page.Credentials[0].Credential.CredentialId)
+	require.Equal(types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_PENDING_REREGISTRATION, page.Credentials[0].Status)
+	require.Equal(types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_ACTIVE, page.Credentials[1].Status)
+
+	// Active credentials need no re-registration
+	err = f.k.ReregisterCredential(f.ctx, owner, "my-app", "cred-same-rp", "cred-new")
+	require.ErrorIs(err, types.ErrCredentialNotPending)
+
+	// The new credential must be registered first
+	err = f.k.ReregisterCredential(f.ctx, owner, "my-app", "cred-old-rp", "cred-new")
+	require.ErrorIs(err, types.ErrCredentialNotFound)
+
+	f.didKeeper.credentials = append(f.didKeeper.credentials, didtypes.RPCredential{
+		DID:        "did:sonr:dave",
+		Credential: &didtypes.WebAuthnCredential{CredentialId: "cred-new", RpId: "example.com"},
+	})
+	_, err = f.msgServer.ReregisterCredential(f.ctx, &types.MsgReregisterCredential{
+		Owner:           owner,
+		ServiceId:       "my-app",
+		CredentialId:    "cred-old-rp",
+		NewCredentialId: "cred-new",
+	})
+	require.NoError(err)
+
+	imported, err := f.k.ImportedCredentials.Get(f.ctx, "cred-old-rp")
+	require.NoError(err)
+	require.Equal(types.ImportedCredentialStatus_IMPORTED_CREDENTIAL_STATUS_REREGISTERED, imported.Status)
+	require.Equal("cred-new", imported.ReregisteredAs)
+
+	// Replaced credentials are left out of the next export
+	res2, err := f.queryServer.ExportCredentials(f.ctx, &types.QueryExportCredentialsRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	var ids []string
+	for _, cred := range res2.Export.Credentials {
+		ids = append(ids, cred.CredentialId)
+	}
+	require.Equal([]string{"cred-taken", "cred-new", "cred-same-rp"}, ids)
+}
@@ -2,17 +2,19 @@ package keeper
 
 import (
 	"context"
-	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"net"
 	"strings"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	v1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/app/clock"
+	"github.com/sonr-io/sonr/app/randomness"
 )
 
 // Domain verification constants
@@ -41,7 +43,7 @@ func (k Keeper) InitiateDomainVerification(
 	existing, err := k.OrmDB.DomainVerificationTable().Get(ctx, domain)
 	if err == nil {
 		// Domain verification exists, check if it's still valid
-		if k.isDomainVerificationValid(existing) {
+		if k.isDomainVerificationValid(ctx, existing) {
 			return existing, status.Errorf(
 				codes.AlreadyExists,
 				"domain verification already exists and is valid",
@@ -52,13 +54,13 @@ func (k Keeper) InitiateDomainVerification(
 	}
 
 	// Generate a new verification token
-	token, err := k.generateVerificationToken()
+	token, err := k.generateVerificationToken(ctx, domain, owner)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate verification token: %v", err)
 	}
 
 	// Create new domain verification record
-	now := time.Now().Unix()
+	now := clock.BlockTime(ctx).Unix()
 	verification := &v1.DomainVerification{
 		Domain:            domain,
 		Owner:             owner,
@@ -97,7 +99,7 @@ func (k Keeper) VerifyDomainOwnership(
 	}
 
 	// Check if verification has expired
-	if k.isDomainVerificationExpired(verification) {
+	if k.isDomainVerificationExpired(ctx, verification) {
 		verification.Status = v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_EXPIRED
 		k.OrmDB.DomainVerificationTable().Update(ctx, verification)
 		return verification, status.Errorf(
@@ -126,7 +128,7 @@ func (k Keeper) VerifyDomainOwnership(
 	if verified {
 		// Mark as verified
 		verification.Status = v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED
-		verification.VerifiedAt = time.Now().Unix()
+		verification.VerifiedAt = clock.BlockTime(ctx).Unix()
 	} else {
 		// Verification record not found
 		verification.Status = v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_FAILED
@@ -192,13 +194,22 @@ func (k Keeper) IsVerifiedDomain(ctx context.Context, domain string) bool {
 	}
 
 	return verification.Status == v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED &&
-		!k.isDomainVerificationExpired(verification)
+		!k.isDomainVerificationExpired(ctx, verification)
 }
 
-// generateVerificationToken creates a cryptographically secure random token
-func (k Keeper) generateVerificationToken() (string, error) {
+// generateVerificationToken derives a verification token from the block randomness beacon
+func (k Keeper) generateVerificationToken(ctx context.Context, domain, owner string) (string, error) {
+	stream, err := randomness.NewBeacon(sdk.UnwrapSDKContext(ctx)).Stream(
+		randomness.DomainDomainVerification,
+		[]byte(domain),
+		[]byte(owner),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive verification token: %w", err)
+	}
+
 	bytes := make([]byte, TokenLength)
-	if _, err := rand.Read(bytes); err != nil {
+	if _, err := stream.Read(bytes); err != nil {
 		return "", fmt.Errorf("failed to generate random bytes: %w", err)
 	}
 	return hex.EncodeToString(bytes), nil
@@ -260,18 +271,24 @@ func (k Keeper) validateDomainFormat(domain string) error {
 }
 
 // isDomainVerificationValid checks if a domain verification is still valid (not expired)
-func (k Keeper) isDomainVerificationValid(verification *v1.DomainVerification) bool {
+func (k Keeper) isDomainVerificationValid(
+	ctx context.Context,
+	verification *v1.DomainVerification,
+) bool {
 	if verification.Status == v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED {
 		return true // Verified domains don't expire
 	}
 
-	return !k.isDomainVerificationExpired(verification)
+	return !k.isDomainVerificationExpired(ctx, verification)
 }
 
-// isDomainVerificationExpired checks if a domain verification has expired
-func (k Keeper) isDomainVerificationExpired(verification *v1.DomainVerification) bool {
-	now := time.Now().Unix()
-	return now > verification.ExpiresAt
+// isDomainVerificationExpired checks if a domain verification has expired at
+// the current block time
+func (k Keeper) isDomainVerificationExpired(
+	ctx context.Context,
+	verification *v1.DomainVerification,
+) bool {
+	return clock.Expired(clock.BlockTime(ctx), verification.ExpiresAt)
 }
 
 // GetDNSInstructions returns human-readable instructions for setting up DNS verification
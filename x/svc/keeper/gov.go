@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// RevokeServiceByGovernance revokes a service: its status becomes revoked, so
+// VerifyOrigin no longer accepts its domain, its permissions are cleared and
+// every capability it issued is revoked.
+func (k Keeper) RevokeServiceByGovernance(
+	ctx context.Context,
+	authority string,
+	proposal types.RevokeServiceProposal,
+) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, authority)
+	}
+	if err := proposal.Validate(); err != nil {
+		return err
+	}
+
+	service, err := k.OrmDB.ServiceTable().Get(ctx, proposal.ServiceID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s: %s", proposal.ServiceID, err)
+	}
+
+	revoked, err := k.revokeService(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeServiceRevoked,
+			sdk.NewAttribute("service_id", service.Id),
+			sdk.NewAttribute("domain", service.Domain),
+			sdk.NewAttribute("reason", proposal.Reason),
+			sdk.NewAttribute("capabilities_revoked", fmt.Sprintf("%d", revoked)),
+		),
+	)
+
+	return nil
+}
+
+// ResolveDomainDispute awards a domain to a new owner. The domain verification
+// record is reassigned, and services bound to the domain are either
+// transferred to the new owner or revoked.
+func (k Keeper) ResolveDomainDispute(
+	ctx context.Context,
+	authority string,
+	proposal types.DomainDisputeProposal,
+) error {
+	if authority != k.authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, authority)
+	}
+	if err := proposal.Validate(); err != nil {
+		return err
+	}
+
+	verification, err := k.OrmDB.DomainVerificationTable().Get(ctx, proposal.Domain)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrDomainNotVerified, "%s: %s", proposal.Domain, err)
+	}
+	previousOwner := verification.Owner
+
+	verification.Owner = proposal.AwardedOwner
+	if err := k.OrmDB.DomainVerificationTable().Update(ctx, verification); err != nil {
+		return fmt.Errorf("failed to reassign domain verification: %w", err)
+	}
+
+	iter, err := k.OrmDB.ServiceTable().List(ctx, apiv1.ServiceDomainIndexKey{}.WithDomain(proposal.Domain))
+	if err != nil {
+		return err
+	}
+	var services []*apiv1.Service
+	for iter.Next() {
+		service, err := iter.Value()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		services = append(services, service)
+	}
+	iter.Close()
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	for _, service := range services {
+		if proposal.TransferServices {
+			service.Owner = proposal.AwardedOwner
+			service.UpdatedAt = now
+			if err := k.OrmDB.ServiceTable().Update(ctx, service); err != nil {
+				return fmt.Errorf("failed to transfer service %s: %w", service.Id, err)
+			}
+			continue
+		}
+
+		if _, err := k.revokeService(ctx, service); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDomainDisputeResolved,
+			sdk.NewAttribute("domain", proposal.Domain),
+			sdk.NewAttribute("previous_owner", previousOwner),
+			sdk.NewAttribute("awarded_owner", proposal.AwardedOwner),
+			sdk.NewAttribute("services", fmt.Sprintf("%d", len(services))),
+			sdk.NewAttribute("transferred", fmt.Sprintf("%t", proposal.TransferServices)),
+		),
+	)
+
+	return nil
+}
+
+// revokeService marks a service revoked and revokes its capabilities,
+// returning how many capabilities were revoked
+func (k Keeper) revokeService(ctx context.Context, service *apiv1.Service) (int, error) {
+	service.Status = apiv1.ServiceStatus_SERVICE_STATUS_REVOKED
+	service.Permissions = nil
+	service.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if err := k.OrmDB.ServiceTable().Update(ctx, service); err != nil {
+		return 0, fmt.Errorf("failed to revoke service %s: %w", service.Id, err)
+	}
+
+	capabilities, err := k.GetCapabilitiesByService(ctx, service.Id)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, capability := range capabilities {
+		if capability.Revoked {
+			continue
+		}
+		capability.Revoked = true
+		if err := k.StoreCapability(ctx, capability); err != nil {
+			return revoked, fmt.Errorf("failed to revoke capability %s: %w", capability.CapabilityId, err)
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+// ProposalGetter loads a governance proposal by ID
+type ProposalGetter func(ctx context.Context, proposalID uint64) (govv1.Proposal, error)
+
+// GovHooks executes svc proposals carried in governance proposal metadata
+type GovHooks struct {
+	k         Keeper
+	proposals ProposalGetter
+}
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// NewGovHooks creates governance hooks for the svc module
+func NewGovHooks(k Keeper, proposals ProposalGetter) GovHooks {
+	return GovHooks{k: k, proposals: proposals}
+}
+
+// AfterProposalSubmission rejects svc proposals with malformed metadata
+func (h GovHooks) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
+	proposal, err := h.proposals(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	_, err = types.ParseProposalMetadata(proposal.Metadata)
+	return err
+}
+
+// AfterProposalVotingPeriodEnded executes passed svc proposals. Execution
+// errors are logged rather than returned so they cannot halt the gov EndBlocker.
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	proposal, err := h.proposals(ctx, proposalID)
+	if err != nil || proposal.Status != govv1.StatusPassed {
+		return nil
+	}
+
+	content, err := types.ParseProposalMetadata(proposal.Metadata)
+	if err != nil || content == nil {
+		return nil
+	}
+
+	// Apply in a cached context so a failed execution leaves no partial writes
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	switch p := content.(type) {
+	case types.RevokeServiceProposal:
+		err = h.k.RevokeServiceByGovernance(cacheCtx, h.k.authority, p)
+	case types.DomainDisputeProposal:
+		err = h.k.ResolveDomainDispute(cacheCtx, h.k.authority, p)
+	}
+	if err != nil {
+		h.k.logger.Error("failed to execute svc proposal", "proposal_id", proposalID, "error", err)
+		return nil
+	}
+
+	write()
+	return nil
+}
+
+// AfterProposalDeposit implements govtypes.GovHooks
+func (GovHooks) AfterProposalDeposit(context.Context, uint64, sdk.AccAddress) error { return nil }
+
+// AfterProposalVote implements govtypes.GovHooks
+func (GovHooks) AfterProposalVote(context.Context, uint64, sdk.AccAddress) error { return nil }
+
+// AfterProposalFailedMinDeposit implements govtypes.GovHooks
+func (GovHooks) AfterProposalFailedMinDeposit(context.Context, uint64) error { return nil }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -31,8 +32,48 @@ type Keeper struct {
 	Params collections.Item[types.Params]
 	OrmDB  apiv1.StateStore
 
+	// OnBehalfOptOuts is the registry of DIDs refusing delegated execution
+	OnBehalfOptOuts collections.Map[string, types.OnBehalfOptOut]
+
+	// ViewKeys holds read-only capabilities granted to third parties
+	ViewKeys        collections.Map[uint64, types.ViewKey]
+	ViewKeysByOwner collections.KeySet[collections.Pair[string, uint64]]
+	ViewKeySeq      collections.Sequence
+
+	// FeeSponsorships holds the fee payer and budget of sponsoring services
+	FeeSponsorships    collections.Map[string, types.FeeSponsorship]
+	FeeSponsorsByPayer collections.Map[string, string]
+	FeeSponsorUsage    collections.Map[collections.Pair[string, string], types.FeeSponsorUsage]
+
+	// ImportedCredentials holds credentials services migrated from other
+	// relying parties, by credential ID
+	ImportedCredentials          collections.Map[string, types.ImportedCredential]
+	ImportedCredentialsByService collections.KeySet[collections.Pair[string, string]]
+
+	// Badges holds verification attestations by subject and badge type
+	Badges       collections.Map[collections.Pair[string, int32], types.Badge]
+	BadgeIssuers collections.Map[string, types.BadgeIssuer]
+
+	// AppManifests holds the app metadata services publish, by service ID
+	AppManifests collections.Map[string, types.AppManifest]
+
+	// AttestationPolicies holds the authenticator restrictions of services,
+	// by service ID
+	AttestationPolicies collections.Map[string, types.AttestationPolicy]
+
+	// BackupAnchors holds the anchored digests of services' off-chain
+	// datasets, by service ID, dataset and sequence
+	BackupAnchors collections.Map[collections.Triple[string, string, uint64], types.BackupAnchor]
+
+	// OIDCClients holds the OpenID Connect clients services register, by
+	// client ID
+	OIDCClients          collections.Map[string, types.OIDCClient]
+	OIDCClientsByService collections.KeySet[collections.Pair[string, string]]
+	OIDCClientSeq        collections.Sequence
+
 	// dependencies
 	didKeeper types.DIDKeeper
+	router    baseapp.MessageRouter
 
 	// UCAN functionality
 	ucanVerifier        *ucan.Verifier
@@ -48,6 +89,7 @@ func NewKeeper(
 	logger log.Logger,
 	authority string,
 	didKeeper types.DIDKeeper,
+	router baseapp.MessageRouter,
 ) Keeper {
 	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
 
@@ -85,8 +127,113 @@ func NewKeeper(
 			codec.CollValue[types.Params](cdc),
 		),
 		OrmDB: store,
+		OnBehalfOptOuts: collections.NewMap(
+			sb,
+			types.OnBehalfOptOutsPrefix,
+			"on_behalf_opt_outs",
+			collections.StringKey,
+			codec.CollValue[types.OnBehalfOptOut](cdc),
+		),
+		ViewKeys: collections.NewMap(
+			sb,
+			types.ViewKeysPrefix,
+			"view_keys",
+			collections.Uint64Key,
+			codec.CollValue[types.ViewKey](cdc),
+		),
+		ViewKeysByOwner: collections.NewKeySet(
+			sb,
+			types.ViewKeysByOwnerPrefix,
+			"view_keys_by_owner",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		ViewKeySeq: collections.NewSequence(sb, types.ViewKeySeqPrefix, "view_key_seq"),
+		FeeSponsorships: collections.NewMap(
+			sb,
+			types.FeeSponsorshipsPrefix,
+			"fee_sponsorships",
+			collections.StringKey,
+			codec.CollValue[types.FeeSponsorship](cdc),
+		),
+		FeeSponsorsByPayer: collections.NewMap(
+			sb,
+			types.FeeSponsorsByPayerPrefix,
+			"fee_sponsors_by_payer",
+			collections.StringKey,
+			collections.StringValue,
+		),
+		FeeSponsorUsage: collections.NewMap(
+			sb,
+			types.FeeSponsorUsagePrefix,
+			"fee_sponsor_usage",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.FeeSponsorUsage](cdc),
+		),
+		ImportedCredentials: collections.NewMap(
+			sb,
+			types.ImportedCredentialsPrefix,
+			"imported_credentials",
+			collections.StringKey,
+			codec.CollValue[types.ImportedCredential](cdc),
+		),
+		ImportedCredentialsByService: collections.NewKeySet(
+			sb,
+			types.ImportedCredentialsByServicePrefix,
+			"imported_credentials_by_service",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		Badges: collections.NewMap(
+			sb,
+			types.BadgesPrefix,
+			"badges",
+			collections.PairKeyCodec(collections.StringKey, collections.Int32Key),
+			codec.CollValue[types.Badge](cdc),
+		),
+		BadgeIssuers: collections.NewMap(
+			sb,
+			types.BadgeIssuersPrefix,
+			"badge_issuers",
+			collections.StringKey,
+			codec.CollValue[types.BadgeIssuer](cdc),
+		),
+		AppManifests: collections.NewMap(
+			sb,
+			types.AppManifestsPrefix,
+			"app_manifests",
+			collections.StringKey,
+			codec.CollValue[types.AppManifest](cdc),
+		),
+		OIDCClients: collections.NewMap(
+			sb,
+			types.OIDCClientsPrefix,
+			"oidc_clients",
+			collections.StringKey,
+			codec.CollValue[types.OIDCClient](cdc),
+		),
+		OIDCClientsByService: collections.NewKeySet(
+			sb,
+			types.OIDCClientsByServicePrefix,
+			"oidc_clients_by_service",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		OIDCClientSeq: collections.NewSequence(sb, types.OIDCClientSeqPrefix, "oidc_client_seq"),
+		AttestationPolicies: collections.NewMap(
+			sb,
+			types.AttestationPoliciesPrefix,
+			"attestation_policies",
+			collections.StringKey,
+			codec.CollValue[types.AttestationPolicy](cdc),
+		),
+		BackupAnchors: collections.NewMap(
+			sb,
+			types.BackupAnchorsPrefix,
+			"backup_anchors",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.BackupAnchor](cdc),
+		),
 
 		didKeeper:    didKeeper,
+		router:       router,
 		ucanVerifier: ucanVerifier,
 		authority:    authority,
 	}
@@ -274,7 +421,7 @@ func (k Keeper) IsDomainVerified(ctx context.Context, domain string, owner strin
 	}
 
 	// Check if the verification hasn't expired
-	if k.isDomainVerificationExpired(verification) {
+	if k.isDomainVerificationExpired(ctx, verification) {
 		return false, nil
 	}
 
@@ -449,6 +596,11 @@ func (r *DIDKeeperResolver) ResolveDIDKey(ctx context.Context, did string) (keys
 		return keys.DID{}, types.ErrInvalidOwnerDID
 	}
 
+	// Deactivated DIDs cannot sign or delegate UCANs
+	if didDoc.Deactivated {
+		return keys.DID{}, types.ErrDIDDeactivated.Wrap(did)
+	}
+
 	// Parse the DID string into a keys.DID
 	// This assumes the DID keeper can provide the public key information
 	return keys.Parse(did)
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -34,6 +35,10 @@ import (
 	"github.com/sonr-io/sonr/x/svc/types"
 )
 
+// testBlockTime is the fixed block time of the test context, so expiry checks
+// resolved against block time are deterministic
+var testBlockTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
 var maccPerms = map[string][]string{
 	authtypes.FeeCollectorName:     nil,
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
@@ -43,7 +48,10 @@ var maccPerms = map[string][]string{
 }
 
 // SVCMockDIDKeeper provides a minimal mock implementation for SVC testing
-type SVCMockDIDKeeper struct{}
+type SVCMockDIDKeeper struct {
+	credentials []didtypes.RPCredential
+	controllers map[string]string
+}
 
 func (m *SVCMockDIDKeeper) ResolveDID(
 	ctx context.Context,
@@ -86,6 +94,7 @@ func (m *SVCMockDIDKeeper) GetDIDDocument(
 	}
 	return &didtypes.DIDDocument{
 		Id:                 did,
+		PrimaryController:  m.controllers[did],
 		VerificationMethod: []*didtypes.VerificationMethod{{Id: did + "#key-1"}},
 	}, nil
 }
@@ -98,6 +107,28 @@ func (m *SVCMockDIDKeeper) VerifyDIDDocumentSignature(
 	return true, nil
 }
 
+func (m *SVCMockDIDKeeper) HasExistingCredential(ctx sdk.Context, credentialId string) bool {
+	for _, rc := range m.credentials {
+		if rc.Credential.CredentialId == credentialId {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *SVCMockDIDKeeper) GetWebAuthnCredentialsByRPID(
+	ctx context.Context,
+	rpID string,
+) ([]didtypes.RPCredential, error) {
+	var credentials []didtypes.RPCredential
+	for _, rc := range m.credentials {
+		if rc.Credential.RpId == rpID {
+			credentials = append(credentials, rc)
+		}
+	}
+	return credentials, nil
+}
+
 type testFixture struct {
 	suite.Suite
 
@@ -114,6 +145,7 @@ type testFixture struct {
 
 	addrs      []sdk.AccAddress
 	govModAddr string
+	didKeeper  *SVCMockDIDKeeper
 }
 
 func SetupTest(t *testing.T) *testFixture {
@@ -146,7 +178,7 @@ func SetupTest(t *testing.T) *testFixture {
 	)
 	f.ctx = sdk.NewContext(
 		integration.CreateMultiStore(keys, logger),
-		cmtproto.Header{},
+		cmtproto.Header{Time: testBlockTime},
 		false,
 		logger,
 	)
@@ -164,12 +196,14 @@ func SetupTest(t *testing.T) *testFixture {
 
 	// Setup SVC Keeper with DID dependency only (UCAN is now internal).
 	mockDIDKeeper := &SVCMockDIDKeeper{}
+	f.didKeeper = mockDIDKeeper
 	f.k = keeper.NewKeeper(
 		encCfg.Codec,
 		runtime.NewKVStoreService(keys[types.ModuleName]),
 		logger,
 		f.govModAddr,
 		mockDIDKeeper,
+		nil,
 	)
 	f.msgServer = keeper.NewMsgServerImpl(f.k)
 	f.queryServer = keeper.NewQuerier(f.k)
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// SetAppManifest publishes the app manifest of a service owned by owner. The
+// service must be active and its domain verified, and every link in the
+// manifest must be on that domain.
+func (k Keeper) SetAppManifest(ctx context.Context, owner string, manifest types.AppManifest) error {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, manifest.ServiceId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s", manifest.ServiceId)
+	}
+	if service.Owner != owner {
+		return errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, manifest.ServiceId)
+	}
+	if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+		return errorsmod.Wrapf(types.ErrServiceNotActive, "%s", manifest.ServiceId)
+	}
+	if verified, _ := k.IsDomainVerified(ctx, service.Domain, ""); !verified {
+		return errorsmod.Wrapf(types.ErrDomainNotVerified, "%s", service.Domain)
+	}
+	if err := manifest.Validate(service.Domain); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	manifest.UpdatedAt = sdkCtx.BlockTime().Unix()
+	if err := k.AppManifests.Set(ctx, manifest.ServiceId, manifest); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAppManifestSet,
+			sdk.NewAttribute("service_id", manifest.ServiceId),
+			sdk.NewAttribute("owner", owner),
+		),
+	)
+	return nil
+}
+
+// RemoveAppManifest withdraws the app manifest of a service owned by owner
+func (k Keeper) RemoveAppManifest(ctx context.Context, owner, serviceID string) error {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s", serviceID)
+	}
+	if service.Owner != owner {
+		return errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, serviceID)
+	}
+	has, err := k.AppManifests.Has(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(types.ErrAppManifestNotFound, "%s", serviceID)
+	}
+	if err := k.AppManifests.Remove(ctx, serviceID); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeAppManifestRemoved, sdk.NewAttribute("service_id", serviceID)),
+	)
+	return nil
+}
+
+// GetAppManifest returns the app manifest of a service, the domain its links
+// were checked against, and whether it can be trusted now: the service must
+// still be active and its domain verification current.
+func (k Keeper) GetAppManifest(ctx context.Context, serviceID string) (types.AppManifest, string, bool, error) {
+	manifest, err := k.AppManifests.Get(ctx, serviceID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.AppManifest{}, "", false, errorsmod.Wrapf(types.ErrAppManifestNotFound, "%s", serviceID)
+	}
+	if err != nil {
+		return types.AppManifest{}, "", false, err
+	}
+
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return manifest, "", false, nil
+	}
+	verified := service.Status == apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE
+	if verified {
+		verified, _ = k.IsDomainVerified(ctx, service.Domain, "")
+	}
+	return manifest, service.Domain, verified, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestAppManifest(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+
+	manifest := types.AppManifest{
+		ServiceId:            "my-app",
+		Name:                 "My App",
+		IconUri:              "https://cdn.example.com/icon.png",
+		RedirectUris:         []string{"https://example.com/callback", "myapp://callback"},
+		UniversalLinkDomains: []string{"app.example.com"},
+		DeepLinkScheme:       "myapp",
+	}
+
+	_, err := f.msgServer.SetAppManifest(f.ctx, &types.MsgSetAppManifest{Owner: f.addrs[1].String(), Manifest: manifest})
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+
+	// Links must stay on the service's verified domain or the app's scheme
+	for _, bad := range []func(m *types.AppManifest){
+		func(m *types.AppManifest) { m.RedirectUris = []string{"https://evil.com/callback"} },
+		func(m *types.AppManifest) { m.RedirectUris = []string{"https://notexample.com/callback"} },
+		func(m *types.AppManifest) { m.RedirectUris = []string{"otherapp://callback"} },
+		func(m *types.AppManifest) { m.RedirectUris = []string{"https://example.com/cb#frag"} },
+		func(m *types.AppManifest) { m.UniversalLinkDomains = []string{"example.org"} },
+		func(m *types.AppManifest) { m.DeepLinkScheme = "https" },
+		func(m *types.AppManifest) { m.IconUri = "http://example.com/icon.png" },
+		func(m *types.AppManifest) { m.IconUri = "ipfs://not-a-cid/icon.png" },
+		// A content CID is not an IPNS name
+		func(m *types.AppManifest) {
+			m.IconUri = "ipns://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi/icon.png"
+		},
+		// A DID URL must select the service serving the icon
+		func(m *types.AppManifest) { m.IconUri = "did:sonr:alice/icon.png" },
+		func(m *types.AppManifest) { m.Name = "" },
+	} {
+		m := manifest
+		bad(&m)
+		_, err := f.msgServer.SetAppManifest(f.ctx, &types.MsgSetAppManifest{Owner: owner, Manifest: m})
+		require.ErrorIs(err, types.ErrInvalidAppManifest, "%+v", m)
+	}
+
+	_, err = f.queryServer.AppManifest(f.ctx, &types.QueryAppManifestRequest{ServiceId: "my-app"})
+	require.ErrorIs(err, types.ErrAppManifestNotFound)
+
+	_, err = f.msgServer.SetAppManifest(f.ctx, &types.MsgSetAppManifest{Owner: owner, Manifest: manifest})
+	require.NoError(err)
+
+	res, err := f.queryServer.AppManifest(f.ctx, &types.QueryAppManifestRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	require.True(res.Verified)
+	require.Equal("example.com", res.Domain)
+	require.Equal(f.ctx.BlockTime().Unix(), res.Manifest.UpdatedAt)
+	require.True(res.Manifest.AllowsRedirect("myapp://callback"))
+	require.False(res.Manifest.AllowsRedirect("myapp://other"))
+
+	_, err = f.msgServer.RemoveAppManifest(f.ctx, &types.MsgRemoveAppManifest{Owner: owner, ServiceId: "my-app"})
+	require.NoError(err)
+	_, err = f.msgServer.RemoveAppManifest(f.ctx, &types.MsgRemoveAppManifest{Owner: owner, ServiceId: "my-app"})
+	require.ErrorIs(err, types.ErrAppManifestNotFound)
+}
@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/errors"
 	v1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/app/clock"
 	"github.com/sonr-io/sonr/x/svc/types"
 )
 
@@ -317,7 +318,7 @@ func (ms msgServer) RegisterService(
 	}
 
 	// 9. Create and save the service
-	now := time.Now().Unix()
+	now := clock.BlockTime(ctx).Unix()
 	service := &v1.Service{
 		Id:                msg.ServiceId,
 		Domain:            msg.Domain,
@@ -354,3 +355,258 @@ func (ms msgServer) RegisterService(
 		ServiceId:         msg.ServiceId,
 	}, nil
 }
+
+// ExecOnBehalf implements types.MsgServer.
+func (ms msgServer) ExecOnBehalf(
+	ctx context.Context,
+	msg *types.MsgExecOnBehalf,
+) (*types.MsgExecOnBehalfResponse, error) {
+	results, err := ms.k.ExecOnBehalf(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecOnBehalfResponse{Results: results}, nil
+}
+
+// SetOnBehalfOptOut implements types.MsgServer.
+func (ms msgServer) SetOnBehalfOptOut(
+	ctx context.Context,
+	msg *types.MsgSetOnBehalfOptOut,
+) (*types.MsgSetOnBehalfOptOutResponse, error) {
+	if err := ms.k.SetOnBehalfOptOut(ctx, msg.Controller, msg.Did, msg.ServiceIds); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetOnBehalfOptOutResponse{}, nil
+}
+
+// ClearOnBehalfOptOut implements types.MsgServer.
+func (ms msgServer) ClearOnBehalfOptOut(
+	ctx context.Context,
+	msg *types.MsgClearOnBehalfOptOut,
+) (*types.MsgClearOnBehalfOptOutResponse, error) {
+	if err := ms.k.ClearOnBehalfOptOut(ctx, msg.Controller, msg.Did); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearOnBehalfOptOutResponse{}, nil
+}
+
+// SetFeeSponsorship implements types.MsgServer.
+func (ms msgServer) SetFeeSponsorship(
+	ctx context.Context,
+	msg *types.MsgSetFeeSponsorship,
+) (*types.MsgSetFeeSponsorshipResponse, error) {
+	if _, err := ms.k.SetFeeSponsorship(ctx, msg.Owner, msg.ServiceId, msg.Payer, msg.Budget); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeSponsorshipResponse{}, nil
+}
+
+// ImportCredentials implements types.MsgServer.
+func (ms msgServer) ImportCredentials(
+	ctx context.Context,
+	msg *types.MsgImportCredentials,
+) (*types.MsgImportCredentialsResponse, error) {
+	return ms.k.ImportCredentials(ctx, msg.Owner, msg.ServiceId, msg.Export, msg.FailOnCollision)
+}
+
+// ReregisterCredential implements types.MsgServer.
+func (ms msgServer) ReregisterCredential(
+	ctx context.Context,
+	msg *types.MsgReregisterCredential,
+) (*types.MsgReregisterCredentialResponse, error) {
+	err := ms.k.ReregisterCredential(ctx, msg.Owner, msg.ServiceId, msg.CredentialId, msg.NewCredentialId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReregisterCredentialResponse{}, nil
+}
+
+// SetBadgeIssuer implements types.MsgServer.
+func (ms msgServer) SetBadgeIssuer(
+	ctx context.Context,
+	msg *types.MsgSetBadgeIssuer,
+) (*types.MsgSetBadgeIssuerResponse, error) {
+	if err := ms.k.SetBadgeIssuer(ctx, msg.Authority, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBadgeIssuerResponse{}, nil
+}
+
+// RemoveBadgeIssuer implements types.MsgServer.
+func (ms msgServer) RemoveBadgeIssuer(
+	ctx context.Context,
+	msg *types.MsgRemoveBadgeIssuer,
+) (*types.MsgRemoveBadgeIssuerResponse, error) {
+	if err := ms.k.RemoveBadgeIssuer(ctx, msg.Authority, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveBadgeIssuerResponse{}, nil
+}
+
+// IssueBadge implements types.MsgServer.
+func (ms msgServer) IssueBadge(
+	ctx context.Context,
+	msg *types.MsgIssueBadge,
+) (*types.MsgIssueBadgeResponse, error) {
+	if _, err := ms.k.IssueBadge(ctx, msg.Issuer, msg.Subject, msg.BadgeType, msg.Evidence, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIssueBadgeResponse{}, nil
+}
+
+// RevokeBadge implements types.MsgServer.
+func (ms msgServer) RevokeBadge(
+	ctx context.Context,
+	msg *types.MsgRevokeBadge,
+) (*types.MsgRevokeBadgeResponse, error) {
+	if err := ms.k.RevokeBadge(ctx, msg.Issuer, msg.Subject, msg.BadgeType, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeBadgeResponse{}, nil
+}
+
+// SetAppManifest implements types.MsgServer.
+func (ms msgServer) SetAppManifest(
+	ctx context.Context,
+	msg *types.MsgSetAppManifest,
+) (*types.MsgSetAppManifestResponse, error) {
+	if err := ms.k.SetAppManifest(ctx, msg.Owner, msg.Manifest); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAppManifestResponse{}, nil
+}
+
+// RemoveAppManifest implements types.MsgServer.
+func (ms msgServer) RemoveAppManifest(
+	ctx context.Context,
+	msg *types.MsgRemoveAppManifest,
+) (*types.MsgRemoveAppManifestResponse, error) {
+	if err := ms.k.RemoveAppManifest(ctx, msg.Owner, msg.ServiceId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAppManifestResponse{}, nil
+}
+
+// RegisterOIDCClient implements types.MsgServer.
+func (ms msgServer) RegisterOIDCClient(
+	ctx context.Context,
+	msg *types.MsgRegisterOIDCClient,
+) (*types.MsgRegisterOIDCClientResponse, error) {
+	clientID, err := ms.k.RegisterOIDCClient(ctx, msg.Owner, types.OIDCClient{
+		ServiceId:    msg.ServiceId,
+		RedirectUris: msg.RedirectUris,
+		Scopes:       msg.Scopes,
+		Origin:       msg.Origin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterOIDCClientResponse{ClientId: clientID}, nil
+}
+
+// RemoveOIDCClient implements types.MsgServer.
+func (ms msgServer) RemoveOIDCClient(
+	ctx context.Context,
+	msg *types.MsgRemoveOIDCClient,
+) (*types.MsgRemoveOIDCClientResponse, error) {
+	if err := ms.k.RemoveOIDCClient(ctx, msg.Owner, msg.ClientId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveOIDCClientResponse{}, nil
+}
+
+// SetAttestationPolicy implements types.MsgServer.
+func (ms msgServer) SetAttestationPolicy(
+	ctx context.Context,
+	msg *types.MsgSetAttestationPolicy,
+) (*types.MsgSetAttestationPolicyResponse, error) {
+	if err := ms.k.SetAttestationPolicy(ctx, msg.Owner, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttestationPolicyResponse{}, nil
+}
+
+// RemoveAttestationPolicy implements types.MsgServer.
+func (ms msgServer) RemoveAttestationPolicy(
+	ctx context.Context,
+	msg *types.MsgRemoveAttestationPolicy,
+) (*types.MsgRemoveAttestationPolicyResponse, error) {
+	if err := ms.k.RemoveAttestationPolicy(ctx, msg.Owner, msg.ServiceId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAttestationPolicyResponse{}, nil
+}
+
+// AnchorBackup implements types.MsgServer.
+func (ms msgServer) AnchorBackup(
+	ctx context.Context,
+	msg *types.MsgAnchorBackup,
+) (*types.MsgAnchorBackupResponse, error) {
+	sequence, err := ms.k.AnchorBackup(ctx, msg.Owner, types.BackupAnchor{
+		ServiceId:   msg.ServiceId,
+		Dataset:     msg.Dataset,
+		Digest:      msg.Digest,
+		RecordCount: msg.RecordCount,
+		SnapshotAt:  msg.SnapshotAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAnchorBackupResponse{Sequence: sequence}, nil
+}
+
+// MintViewKey implements types.MsgServer.
+func (ms msgServer) MintViewKey(
+	ctx context.Context,
+	msg *types.MsgMintViewKey,
+) (*types.MsgMintViewKeyResponse, error) {
+	if err := ms.k.requireActiveDIDController(ctx, msg.Controller, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	key, err := ms.k.MintViewKey(
+		ctx,
+		msg.Owner,
+		msg.Grantee,
+		msg.Scopes,
+		time.Duration(msg.TtlSeconds)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintViewKeyResponse{ViewKey: key}, nil
+}
+
+// RevokeViewKey implements types.MsgServer. A controller may revoke keys of
+// a deactivated DID so access does not outlive the DID.
+func (ms msgServer) RevokeViewKey(
+	ctx context.Context,
+	msg *types.MsgRevokeViewKey,
+) (*types.MsgRevokeViewKeyResponse, error) {
+	if err := ms.k.requireDIDController(ctx, msg.Controller, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.RevokeViewKey(ctx, msg.Owner, msg.ViewKeyId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeViewKeyResponse{}, nil
+}
@@ -2,7 +2,6 @@ package keeper_test
 
 import (
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -130,7 +129,7 @@ func TestInitiateDomainVerification(t *testing.T) {
 				require.Equal(tc.creator, verification.Owner)
 				require.Equal(resp.VerificationToken, verification.VerificationToken)
 				require.Equal(v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_PENDING, verification.Status)
-				require.Greater(verification.ExpiresAt, time.Now().Unix())
+				require.Greater(verification.ExpiresAt, testBlockTime.Unix())
 			}
 		})
 	}
@@ -246,8 +245,8 @@ func TestRegisterService(t *testing.T) {
 		Owner:             creator,
 		VerificationToken: "test-token-12345",
 		Status:            v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED,
-		ExpiresAt:         time.Now().Unix() + 3600,
-		VerifiedAt:        time.Now().Unix(),
+		ExpiresAt:         testBlockTime.Unix() + 3600,
+		VerifiedAt:        testBlockTime.Unix(),
 	}
 	err := f.k.OrmDB.DomainVerificationTable().Insert(f.ctx, verification)
 	require.NoError(err)
@@ -349,8 +348,8 @@ func TestRegisterService_DomainAlreadyBound(t *testing.T) {
 		Owner:             creator,
 		VerificationToken: "test-token-bound",
 		Status:            v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED,
-		ExpiresAt:         time.Now().Unix() + 3600,
-		VerifiedAt:        time.Now().Unix(),
+		ExpiresAt:         testBlockTime.Unix() + 3600,
+		VerifiedAt:        testBlockTime.Unix(),
 	}
 	err := f.k.OrmDB.DomainVerificationTable().Insert(f.ctx, verification)
 	require.NoError(err)
@@ -418,7 +417,7 @@ func TestDomainVerificationWorkflow(t *testing.T) {
 	verification, err := f.k.GetDomainVerification(f.ctx, domain)
 	require.NoError(err)
 	verification.Status = v1.DomainVerificationStatus_DOMAIN_VERIFICATION_STATUS_VERIFIED
-	verification.VerifiedAt = time.Now().Unix()
+	verification.VerifiedAt = testBlockTime.Unix()
 	err = f.k.OrmDB.DomainVerificationTable().Update(f.ctx, verification)
 	require.NoError(err)
 
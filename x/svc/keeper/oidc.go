@@ -76,12 +76,7 @@ func (k Keeper) CreateDefaultOIDCConfig(ctx context.Context, serviceID string, d
 		TokenEndpoint:         fmt.Sprintf("https://%s/oauth/token", domain),
 		JwksUri:               fmt.Sprintf("https://%s/.well-known/jwks.json", domain),
 		UserinfoEndpoint:      fmt.Sprintf("https://%s/oauth/userinfo", domain),
-		ScopesSupported: []string{
-			"openid",
-			"profile",
-			"email",
-			"offline_access",
-		},
+		ScopesSupported:       defaultOIDCScopes(),
 		ResponseTypesSupported: []string{
 			"code",
 			"token",
@@ -268,3 +263,27 @@ func convertAPIJWKToTypes(key *apiv1.JWK) *types.JWK {
 		Y:   key.Y,
 	}
 }
+
+// defaultOIDCScopes returns the scopes a service supports until it stores its
+// own OIDC configuration
+func defaultOIDCScopes() []string {
+	return append([]string{
+		"openid",
+		"profile",
+		"email",
+		"offline_access",
+	}, oidcCatalogScopes()...)
+}
+
+// oidcCatalogScopes returns the catalog scopes that can be advertised as-is
+// in discovery documents; parameterized scopes are omitted.
+func oidcCatalogScopes() []string {
+	catalog := types.DefaultScopeCatalog()
+	var scopes []string
+	for _, name := range catalog.Names() {
+		if catalog[name].Param() == "" {
+			scopes = append(scopes, name)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// RegisterOIDCClient registers an OpenID Connect client for a service owned
+// by owner and returns its client ID. The service must be active and its
+// domain verified, and the client's URIs must be on that domain or use the
+// deep link scheme of the service's app manifest.
+func (k Keeper) RegisterOIDCClient(ctx context.Context, owner string, client types.OIDCClient) (string, error) {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, client.ServiceId)
+	if err != nil {
+		return "", errorsmod.Wrapf(types.ErrServiceNotFound, "%s", client.ServiceId)
+	}
+	if service.Owner != owner {
+		return "", errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, client.ServiceId)
+	}
+	if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+		return "", errorsmod.Wrapf(types.ErrServiceNotActive, "%s", client.ServiceId)
+	}
+	if verified, _ := k.IsDomainVerified(ctx, service.Domain, ""); !verified {
+		return "", errorsmod.Wrapf(types.ErrDomainNotVerified, "%s", service.Domain)
+	}
+
+	var deepLinkScheme string
+	manifest, err := k.AppManifests.Get(ctx, client.ServiceId)
+	switch {
+	case err == nil:
+		deepLinkScheme = manifest.DeepLinkScheme
+	case !errors.Is(err, collections.ErrNotFound):
+		return "", err
+	}
+	if err := client.Validate(service.Domain, deepLinkScheme, k.supportedOIDCScopes(ctx, client.ServiceId)); err != nil {
+		return "", err
+	}
+
+	existing, err := k.GetOIDCClients(ctx, client.ServiceId)
+	if err != nil {
+		return "", err
+	}
+	if len(existing) >= types.MaxOIDCClientsPerService {
+		return "", errorsmod.Wrapf(
+			types.ErrInvalidOIDCClient,
+			"service %s already has %d clients", client.ServiceId, types.MaxOIDCClientsPerService,
+		)
+	}
+
+	seq, err := k.OIDCClientSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	client.ClientId = types.NewOIDCClientID(client.ServiceId, seq)
+	client.RegisteredAt = sdkCtx.BlockTime().Unix()
+	if err := k.OIDCClients.Set(ctx, client.ClientId, client); err != nil {
+		return "", err
+	}
+	if err := k.OIDCClientsByService.Set(ctx, collections.Join(client.ServiceId, client.ClientId)); err != nil {
+		return "", err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOIDCClientRegistered,
+			sdk.NewAttribute("service_id", client.ServiceId),
+			sdk.NewAttribute("client_id", client.ClientId),
+			sdk.NewAttribute("owner", owner),
+		),
+	)
+	return client.ClientId, nil
+}
+
+// RemoveOIDCClient removes an OpenID Connect client of a service owned by
+// owner
+func (k Keeper) RemoveOIDCClient(ctx context.Context, owner, clientID string) error {
+	client, err := k.OIDCClients.Get(ctx, clientID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrOIDCClientNotFound, "%s", clientID)
+	}
+	if err != nil {
+		return err
+	}
+	service, err := k.OrmDB.ServiceTable().Get(ctx, client.ServiceId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrServiceNotFound, "%s", client.ServiceId)
+	}
+	if service.Owner != owner {
+		return errorsmod.Wrapf(types.ErrInvalidPermissions, "%s does not own service %s", owner, client.ServiceId)
+	}
+
+	if err := k.OIDCClients.Remove(ctx, clientID); err != nil {
+		return err
+	}
+	if err := k.OIDCClientsByService.Remove(ctx, collections.Join(client.ServiceId, clientID)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOIDCClientRemoved,
+			sdk.NewAttribute("service_id", client.ServiceId),
+			sdk.NewAttribute("client_id", clientID),
+		),
+	)
+	return nil
+}
+
+// GetOIDCClient returns an OpenID Connect client, the domain its URIs were
+// checked against, and whether it can be trusted now: the service must still
+// be active and its domain verification current.
+func (k Keeper) GetOIDCClient(ctx context.Context, clientID string) (types.OIDCClient, string, bool, error) {
+	client, err := k.OIDCClients.Get(ctx, clientID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.OIDCClient{}, "", false, errorsmod.Wrapf(types.ErrOIDCClientNotFound, "%s", clientID)
+	}
+	if err != nil {
+		return types.OIDCClient{}, "", false, err
+	}
+
+	service, err := k.OrmDB.ServiceTable().Get(ctx, client.ServiceId)
+	if err != nil {
+		return client, "", false, nil
+	}
+	verified := service.Status == apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE
+	if verified {
+		verified, _ = k.IsDomainVerified(ctx, service.Domain, "")
+	}
+	return client, service.Domain, verified, nil
+}
+
+// GetOIDCClients returns the OpenID Connect clients of a service
+func (k Keeper) GetOIDCClients(ctx context.Context, serviceID string) ([]types.OIDCClient, error) {
+	iter, err := k.OIDCClientsByService.Iterate(ctx, collections.NewPrefixedPairRange[string, string](serviceID))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	clients := []types.OIDCClient{}
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		client, err := k.OIDCClients.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+// AuthorizeOIDCClient checks an authorization request against a registered
+// client, as an OpenID provider must before it shows the consent screen: the
+// client's service must be trusted, redirectURI must be registered exactly
+// and every requested scope registered for the client.
+func (k Keeper) AuthorizeOIDCClient(
+	ctx context.Context,
+	clientID, redirectURI string,
+	scopes []string,
+) (types.OIDCClient, error) {
+	client, domain, verified, err := k.GetOIDCClient(ctx, clientID)
+	if err != nil {
+		return types.OIDCClient{}, err
+	}
+	if !verified {
+		return types.OIDCClient{}, errorsmod.Wrapf(
+			types.ErrDomainNotVerified,
+			"service %s of client %s is not active on a verified domain %s", client.ServiceId, clientID, domain,
+		)
+	}
+	if !client.AllowsRedirect(redirectURI) {
+		return types.OIDCClient{}, errorsmod.Wrapf(types.ErrInvalidOIDCClient, "redirect URI %q is not registered", redirectURI)
+	}
+	if !client.AllowsScopes(scopes) {
+		return types.OIDCClient{}, errorsmod.Wrapf(types.ErrInvalidOIDCClient, "scopes %v are not all registered", scopes)
+	}
+	return client, nil
+}
+
+// supportedOIDCScopes returns the scopes advertised by the service's OIDC
+// configuration, or the default scopes when it has stored none
+func (k Keeper) supportedOIDCScopes(ctx context.Context, serviceID string) []string {
+	if config, err := k.GetServiceOIDCConfig(ctx, serviceID); err == nil && len(config.ScopesSupported) > 0 {
+		return config.ScopesSupported
+	}
+	return defaultOIDCScopes()
+}
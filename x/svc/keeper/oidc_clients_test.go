@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestOIDCClientRegistration(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+
+	register := func(signer string, edit func(msg *types.MsgRegisterOIDCClient)) (string, error) {
+		msg := &types.MsgRegisterOIDCClient{
+			Owner:        signer,
+			ServiceId:    "my-app",
+			RedirectUris: []string{"https://example.com/callback"},
+			Scopes:       []string{"openid", "profile"},
+			Origin:       "https://app.example.com",
+		}
+		if edit != nil {
+			edit(msg)
+		}
+		res, err := f.msgServer.RegisterOIDCClient(f.ctx, msg)
+		if err != nil {
+			return "", err
+		}
+		return res.ClientId, nil
+	}
+
+	_, err := register(f.addrs[1].String(), nil)
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+
+	// URIs must stay on the service's verified domain, and scopes must be
+	// supported and include openid
+	for _, bad := range []func(msg *types.MsgRegisterOIDCClient){
+		func(msg *types.MsgRegisterOIDCClient) { msg.RedirectUris = nil },
+		func(msg *types.MsgRegisterOIDCClient) { msg.RedirectUris = []string{"https://evil.com/callback"} },
+		func(msg *types.MsgRegisterOIDCClient) { msg.RedirectUris = []string{"myapp://callback"} },
+		func(msg *types.MsgRegisterOIDCClient) { msg.Scopes = []string{"profile"} },
+		func(msg *types.MsgRegisterOIDCClient) { msg.Scopes = []string{"openid", "admin"} },
+		func(msg *types.MsgRegisterOIDCClient) { msg.Origin = "https://evil.com" },
+		func(msg *types.MsgRegisterOIDCClient) { msg.Origin = "https://example.com/login" },
+	} {
+		_, err := register(owner, bad)
+		require.ErrorIs(err, types.ErrInvalidOIDCClient)
+	}
+
+	// The app manifest's deep link scheme is accepted for native apps
+	_, err = f.msgServer.SetAppManifest(f.ctx, &types.MsgSetAppManifest{Owner: owner, Manifest: types.AppManifest{
+		ServiceId:      "my-app",
+		Name:           "My App",
+		DeepLinkScheme: "myapp",
+	}})
+	require.NoError(err)
+	native, err := register(owner, func(msg *types.MsgRegisterOIDCClient) {
+		msg.RedirectUris = []string{"myapp://callback"}
+		msg.Origin = ""
+	})
+	require.NoError(err)
+
+	web, err := register(owner, nil)
+	require.NoError(err)
+	require.NotEqual(native, web)
+
+	res, err := f.queryServer.OIDCClient(f.ctx, &types.QueryOIDCClientRequest{ClientId: web})
+	require.NoError(err)
+	require.True(res.Verified)
+	require.Equal("example.com", res.Domain)
+	require.Equal("my-app", res.Client.ServiceId)
+	require.Equal(f.ctx.BlockTime().Unix(), res.Client.RegisteredAt)
+
+	list, err := f.queryServer.OIDCClients(f.ctx, &types.QueryOIDCClientsRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	require.Len(list.Clients, 2)
+
+	// Authorization requests must match the registration exactly
+	_, err = f.k.AuthorizeOIDCClient(f.ctx, web, "https://example.com/callback", []string{"openid"})
+	require.NoError(err)
+	_, err = f.k.AuthorizeOIDCClient(f.ctx, web, "https://example.com/callback/", []string{"openid"})
+	require.ErrorIs(err, types.ErrInvalidOIDCClient)
+	_, err = f.k.AuthorizeOIDCClient(f.ctx, web, "https://example.com/callback", []string{"openid", "email"})
+	require.ErrorIs(err, types.ErrInvalidOIDCClient)
+
+	_, err = f.msgServer.RemoveOIDCClient(f.ctx, &types.MsgRemoveOIDCClient{Owner: f.addrs[1].String(), ClientId: web})
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+	_, err = f.msgServer.RemoveOIDCClient(f.ctx, &types.MsgRemoveOIDCClient{Owner: owner, ClientId: web})
+	require.NoError(err)
+	_, err = f.k.AuthorizeOIDCClient(f.ctx, web, "https://example.com/callback", []string{"openid"})
+	require.ErrorIs(err, types.ErrOIDCClientNotFound)
+
+	list, err = f.queryServer.OIDCClients(f.ctx, &types.QueryOIDCClientsRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	require.Len(list.Clients, 1)
+	require.Equal(native, list.Clients[0].ClientId)
+}
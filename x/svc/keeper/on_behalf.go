@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// SetOnBehalfOptOut records that a DID refuses delegated execution by the given
+// services (or by every service when none are listed). Only the DID's
+// controller may opt it out.
+func (k Keeper) SetOnBehalfOptOut(ctx context.Context, controller, did string, serviceIDs []string) error {
+	if err := k.requireDIDController(ctx, controller, did); err != nil {
+		return err
+	}
+
+	optOut := types.OnBehalfOptOut{
+		Did:        did,
+		ServiceIds: serviceIDs,
+		OptedOutAt: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	}
+	return k.OnBehalfOptOuts.Set(ctx, did, optOut)
+}
+
+// ClearOnBehalfOptOut removes a DID from the opt-out registry
+func (k Keeper) ClearOnBehalfOptOut(ctx context.Context, controller, did string) error {
+	if err := k.requireDIDController(ctx, controller, did); err != nil {
+		return err
+	}
+	return k.OnBehalfOptOuts.Remove(ctx, did)
+}
+
+// GetOnBehalfOptOut returns the opt-out record for a DID, if any
+func (k Keeper) GetOnBehalfOptOut(ctx context.Context, did string) (types.OnBehalfOptOut, bool, error) {
+	optOut, err := k.OnBehalfOptOuts.Get(ctx, did)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.OnBehalfOptOut{}, false, nil
+	}
+	if err != nil {
+		return types.OnBehalfOptOut{}, false, err
+	}
+	return optOut, true, nil
+}
+
+// VerifyOnBehalfExecution verifies that every message wrapped by msg is
+// allow-listed and acts for msg.Did, and that the sender may act as that DID.
+// A sender controlling the DID needs no delegation and false is returned.
+// Otherwise the sender must operate an active service, control the service
+// DID, and present an unexpired UCAN chain from the user whose audience is
+// the service DID.
+func (k Keeper) VerifyOnBehalfExecution(ctx context.Context, msg *types.MsgExecOnBehalf) (bool, error) {
+	doc, err := k.didKeeper.GetDIDDocument(ctx, msg.Did)
+	if err != nil || doc == nil {
+		return false, errorsmod.Wrapf(types.ErrInvalidOwnerDID, "DID %s not found", msg.Did)
+	}
+	if doc.Deactivated {
+		return false, errorsmod.Wrapf(types.ErrDIDDeactivated, "%s cannot be acted for", msg.Did)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return false, err
+	}
+	for _, inner := range msgs {
+		if err := checkOnBehalfMsg(inner, msg.Did, doc.PrimaryController); err != nil {
+			return false, err
+		}
+	}
+
+	if doc.PrimaryController == msg.Sender {
+		return false, nil
+	}
+
+	services, err := k.getActiveServicesByOwner(ctx, msg.Sender)
+	if err != nil {
+		return true, err
+	}
+	if len(services) == 0 {
+		return true, errorsmod.Wrapf(types.ErrServiceNotFound, "no active service owned by %s", msg.Sender)
+	}
+
+	if err := k.requireActiveDIDController(ctx, msg.Sender, msg.ServiceDid); err != nil {
+		return true, err
+	}
+
+	optOut, found, err := k.GetOnBehalfOptOut(ctx, msg.Did)
+	if err != nil {
+		return true, err
+	}
+	if found {
+		for _, service := range services {
+			if optOut.Covers(service.Id) {
+				return true, errorsmod.Wrapf(types.ErrOnBehalfOptedOut, "%s for service %s", msg.Did, service.Id)
+			}
+		}
+	}
+
+	if msg.UcanToken == "" {
+		return true, errorsmod.Wrap(types.ErrUCANValidationFailed, "missing UCAN token")
+	}
+
+	// The verifier checks signatures, time bounds and attenuation for the leaf
+	// token; the delegation chain check walks every proof back to the user.
+	token, err := k.ucanVerifier.VerifyCapability(
+		ctx,
+		msg.UcanToken,
+		types.CreateOnBehalfURI(msg.Did),
+		[]string{types.UCANExecOnBehalf},
+	)
+	if err != nil {
+		return true, errorsmod.Wrap(types.ErrUCANValidationFailed, err.Error())
+	}
+	if token.Audience != msg.ServiceDid {
+		return true, errorsmod.Wrapf(
+			types.ErrUCANValidationFailed,
+			"UCAN audience %s does not match service DID %s",
+			token.Audience,
+			msg.ServiceDid,
+		)
+	}
+
+	if err := k.ucanVerifier.VerifyDelegationChain(ctx, msg.UcanToken); err != nil {
+		return true, errorsmod.Wrap(types.ErrInvalidUCANDelegation, err.Error())
+	}
+
+	return true, nil
+}
+
+// ExecOnBehalf verifies msg and dispatches each wrapped message through the
+// message router, returning the data of each result
+func (k Keeper) ExecOnBehalf(ctx context.Context, msg *types.MsgExecOnBehalf) ([][]byte, error) {
+	if k.router == nil {
+		return nil, fmt.Errorf("message router not set")
+	}
+
+	if _, err := k.VerifyOnBehalfExecution(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	results := make([][]byte, len(msgs))
+	for i, inner := range msgs {
+		handler := k.router.Handler(inner)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(types.ErrOnBehalfNotAllowed, "no handler for %s", sdk.MsgTypeURL(inner))
+		}
+
+		res, err := handler(sdkCtx, inner)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d", i)
+		}
+		results[i] = res.Data
+
+		events := res.GetEvents()
+		sdkEvents := make([]sdk.Event, 0, len(events))
+		for _, event := range events {
+			sdkEvents = append(sdkEvents, sdk.Event(event))
+		}
+		sdkCtx.EventManager().EmitEvents(sdkEvents)
+	}
+
+	return results, nil
+}
+
+// requireDIDController returns ErrNotDIDController unless controller is the
+// primary controller of did
+func (k Keeper) requireDIDController(ctx context.Context, controller, did string) error {
+	_, err := k.controlledDIDDocument(ctx, controller, did)
+	return err
+}
+
+// requireActiveDIDController is requireDIDController for a DID that is about
+// to act, which must also not be deactivated
+func (k Keeper) requireActiveDIDController(ctx context.Context, controller, did string) error {
+	doc, err := k.controlledDIDDocument(ctx, controller, did)
+	if err != nil {
+		return err
+	}
+	if doc.Deactivated {
+		return errorsmod.Wrapf(types.ErrDIDDeactivated, "%s", did)
+	}
+	return nil
+}
+
+// controlledDIDDocument returns the document of did if controller is its
+// primary controller
+func (k Keeper) controlledDIDDocument(ctx context.Context, controller, did string) (*didtypes.DIDDocument, error) {
+	if did == "" {
+		return nil, types.ErrInvalidOwnerDID
+	}
+	doc, err := k.didKeeper.GetDIDDocument(ctx, did)
+	if err != nil || doc == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidOwnerDID, "DID %s not found", did)
+	}
+	if doc.PrimaryController != controller {
+		return nil, errorsmod.Wrapf(types.ErrNotDIDController, "%s does not control %s", controller, did)
+	}
+	return doc, nil
+}
+
+// checkOnBehalfMsg ensures a wrapped message is allow-listed and that every
+// signer field names the user, either by DID or by controller address
+func checkOnBehalfMsg(msg sdk.Msg, did, controller string) error {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if !types.IsOnBehalfMsgAllowed(msgTypeURL) {
+		return errorsmod.Wrap(types.ErrOnBehalfNotAllowed, msgTypeURL)
+	}
+
+	actors, err := msgSigners(msg)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrOnBehalfNotAllowed, "%s: %s", msgTypeURL, err)
+	}
+	for _, actor := range actors {
+		if actor != did && actor != controller {
+			return errorsmod.Wrapf(types.ErrOnBehalfNotAllowed, "%s acts for %s, not %s", msgTypeURL, actor, did)
+		}
+	}
+	return nil
+}
+
+// msgSigners reads the raw values of a message's cosmos.msg.v1.signer fields.
+// DEX messages name their signer by DID, so the values are compared as
+// strings rather than decoded as addresses.
+func msgSigners(msg sdk.Msg) ([]string, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	fields, _ := protov2.GetExtension(md.Options(), msgv1.E_Signer).([]string)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no signer fields")
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	dyn := dynamicpb.NewMessage(md)
+	if err := protov2.Unmarshal(bz, dyn); err != nil {
+		return nil, err
+	}
+
+	signers := make([]string, 0, len(fields))
+	for _, name := range fields {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			return nil, fmt.Errorf("unsupported signer field %s", name)
+		}
+		signers = append(signers, dyn.Get(fd).String())
+	}
+	return slices.Compact(signers), nil
+}
+
+// getActiveServicesByOwner returns the active services owned by an address
+func (k Keeper) getActiveServicesByOwner(ctx context.Context, owner string) ([]*apiv1.Service, error) {
+	iter, err := k.OrmDB.ServiceTable().List(ctx, apiv1.ServiceOwnerIndexKey{}.WithOwner(owner))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	defer iter.Close()
+
+	var services []*apiv1.Service
+	for iter.Next() {
+		service, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		if service.Status == apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+			services = append(services, service)
+		}
+	}
+	return services, nil
+}
@@ -232,6 +232,11 @@ func (r *ServiceDIDResolver) ResolveDIDKey(ctx context.Context, did string) (key
 		return keys.DID{}, types.ErrInvalidOwnerDID
 	}
 
+	// Deactivated DIDs cannot sign or delegate UCANs
+	if didDoc.Deactivated {
+		return keys.DID{}, types.ErrDIDDeactivated.Wrap(did)
+	}
+
 	// Parse the DID string into a keys.DID
 	// This assumes the DID keeper can provide the public key information
 	return keys.Parse(did)
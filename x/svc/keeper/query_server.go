@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/app/pagination"
 	"github.com/sonr-io/sonr/x/svc/types"
 )
 
@@ -115,24 +118,31 @@ func (k Querier) ServicesByOwner(
 	// Create index key for owner
 	ownerKey := apiv1.ServiceOwnerIndexKey{}.WithOwner(req.Owner)
 
+	page, err := pagination.Parse(req.Pagination, "svc/ServicesByOwner/"+req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
 	// List services by owner
-	iter, err := k.Keeper.OrmDB.ServiceTable().List(ctx, ownerKey)
+	iter, err := k.Keeper.OrmDB.ServiceTable().List(ctx, ownerKey, page.ORMOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list services by owner: %w", err)
 	}
 	defer iter.Close()
 
-	var services []*types.Service
-	for iter.Next() {
-		service, err := iter.Value()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get service value: %w", err)
-		}
+	v1Services, pageRes, err := pagination.CollectORM(page, iter, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service value: %w", err)
+	}
+
+	services := make([]*types.Service, 0, len(v1Services))
+	for _, service := range v1Services {
 		services = append(services, convertV1ServiceToTypes(service))
 	}
 
 	return &types.QueryServicesByOwnerResponse{
-		Services: services,
+		Services:   services,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -150,24 +160,31 @@ func (k Querier) ServicesByDomain(
 	// Create index key for domain
 	domainKey := apiv1.ServiceDomainIndexKey{}.WithDomain(req.Domain)
 
+	page, err := pagination.Parse(req.Pagination, "svc/ServicesByDomain/"+req.Domain)
+	if err != nil {
+		return nil, err
+	}
+
 	// List services by domain
-	iter, err := k.Keeper.OrmDB.ServiceTable().List(ctx, domainKey)
+	iter, err := k.Keeper.OrmDB.ServiceTable().List(ctx, domainKey, page.ORMOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list services by domain: %w", err)
 	}
 	defer iter.Close()
 
-	var services []*types.Service
-	for iter.Next() {
-		service, err := iter.Value()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get service value: %w", err)
-		}
+	v1Services, pageRes, err := pagination.CollectORM(page, iter, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service value: %w", err)
+	}
+
+	services := make([]*types.Service, 0, len(v1Services))
+	for _, service := range v1Services {
 		services = append(services, convertV1ServiceToTypes(service))
 	}
 
 	return &types.QueryServicesByDomainResponse{
-		Services: services,
+		Services:   services,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -306,3 +323,401 @@ func (k Querier) ServiceOIDCMetadata(goCtx context.Context, req *types.QueryServ
 		},
 	}, nil
 }
+
+// FeeSponsorship implements types.QueryServer.
+func (k Querier) FeeSponsorship(
+	goCtx context.Context,
+	req *types.QueryFeeSponsorshipRequest,
+) (*types.QueryFeeSponsorshipResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	sponsorship, err := k.Keeper.GetFeeSponsorship(goCtx, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeeSponsorshipResponse{
+		Sponsorship: &sponsorship,
+		Remaining:   sponsorship.Remaining(),
+	}, nil
+}
+
+// FeeSponsorUsage implements types.QueryServer. Users are listed in address
+// order.
+func (k Querier) FeeSponsorUsage(
+	goCtx context.Context,
+	req *types.QueryFeeSponsorUsageRequest,
+) (*types.QueryFeeSponsorUsageResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	page, err := pagination.Parse(req.Pagination, "svc/FeeSponsorUsage/"+req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, string](req.ServiceId)
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			rng = rng.EndExclusive(string(page.Key))
+		} else {
+			rng = rng.StartExclusive(string(page.Key))
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.Keeper.FeeSponsorUsage.Iterate(goCtx, rng)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee sponsor usage: %w", err)
+	}
+	defer iter.Close()
+
+	usage, pageRes, err := pagination.Collect(page, func() (types.FeeSponsorUsage, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.FeeSponsorUsage{}, nil, false, nil
+		}
+		entry, err := iter.KeyValue()
+		if err != nil {
+			return types.FeeSponsorUsage{}, nil, false, err
+		}
+		iter.Next()
+		return entry.Value, []byte(entry.Key.K2()), true, nil
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fee sponsor usage: %w", err)
+	}
+
+	res := &types.QueryFeeSponsorUsageResponse{
+		Usage:      make([]*types.FeeSponsorUsage, 0, len(usage)),
+		Pagination: pageRes,
+	}
+	for i := range usage {
+		res.Usage = append(res.Usage, &usage[i])
+	}
+	return res, nil
+}
+
+// ExportCredentials implements types.QueryServer.
+func (k Querier) ExportCredentials(
+	goCtx context.Context,
+	req *types.QueryExportCredentialsRequest,
+) (*types.QueryExportCredentialsResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	export, err := k.Keeper.ExportCredentials(goCtx, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryExportCredentialsResponse{Export: export}, nil
+}
+
+// ImportedCredentials implements types.QueryServer.
+func (k Querier) ImportedCredentials(
+	goCtx context.Context,
+	req *types.QueryImportedCredentialsRequest,
+) (*types.QueryImportedCredentialsResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	page, err := pagination.Parse(req.Pagination, "svc/ImportedCredentials/"+req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, string](req.ServiceId)
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			rng = rng.EndExclusive(string(page.Key))
+		} else {
+			rng = rng.StartExclusive(string(page.Key))
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.Keeper.ImportedCredentialsByService.Iterate(goCtx, rng)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list imported credentials: %w", err)
+	}
+	defer iter.Close()
+
+	credentials, pageRes, err := pagination.Collect(page, func() (types.ImportedCredential, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.ImportedCredential{}, nil, false, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return types.ImportedCredential{}, nil, false, err
+		}
+		iter.Next()
+		imported, err := k.Keeper.ImportedCredentials.Get(goCtx, key.K2())
+		if err != nil {
+			return types.ImportedCredential{}, nil, false, err
+		}
+		return imported, []byte(key.K2()), true, nil
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read imported credentials: %w", err)
+	}
+
+	res := &types.QueryImportedCredentialsResponse{
+		Credentials: make([]*types.ImportedCredential, 0, len(credentials)),
+		Pagination:  pageRes,
+	}
+	for i := range credentials {
+		res.Credentials = append(res.Credentials, &credentials[i])
+	}
+	return res, nil
+}
+
+// Badges implements types.QueryServer. Revoked and expired badges are only
+// listed when requested.
+func (k Querier) Badges(goCtx context.Context, req *types.QueryBadgesRequest) (*types.QueryBadgesResponse, error) {
+	if req == nil || req.Subject == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidBadge, "subject cannot be empty")
+	}
+
+	badges, err := k.Keeper.GetBadges(goCtx, req.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list badges: %w", err)
+	}
+
+	now := sdk.UnwrapSDKContext(goCtx).BlockTime().Unix()
+	res := &types.QueryBadgesResponse{Badges: make([]types.BadgeInfo, 0, len(badges))}
+	for _, badge := range badges {
+		active := badge.Active(now)
+		if !active && !req.IncludeInactive {
+			continue
+		}
+		res.Badges = append(res.Badges, types.BadgeInfo{Badge: badge, Active: active})
+	}
+	return res, nil
+}
+
+// BadgeIssuers implements types.QueryServer. Issuers are listed in address
+// order.
+func (k Querier) BadgeIssuers(
+	goCtx context.Context,
+	req *types.QueryBadgeIssuersRequest,
+) (*types.QueryBadgeIssuersResponse, error) {
+	if req == nil {
+		req = &types.QueryBadgeIssuersRequest{}
+	}
+
+	page, err := pagination.Parse(req.Pagination, "svc/BadgeIssuers")
+	if err != nil {
+		return nil, err
+	}
+
+	rng := new(collections.Range[string])
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			rng = rng.EndExclusive(string(page.Key))
+		} else {
+			rng = rng.StartExclusive(string(page.Key))
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.Keeper.BadgeIssuers.Iterate(goCtx, rng)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list badge issuers: %w", err)
+	}
+	defer iter.Close()
+
+	issuers, pageRes, err := pagination.Collect(page, func() (types.BadgeIssuer, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.BadgeIssuer{}, nil, false, nil
+		}
+		issuer, err := iter.Value()
+		if err != nil {
+			return types.BadgeIssuer{}, nil, false, err
+		}
+		iter.Next()
+		return issuer, []byte(issuer.Address), true, nil
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read badge issuers: %w", err)
+	}
+
+	return &types.QueryBadgeIssuersResponse{Issuers: issuers, Pagination: pageRes}, nil
+}
+
+// AppManifest implements types.QueryServer.
+func (k Querier) AppManifest(
+	goCtx context.Context,
+	req *types.QueryAppManifestRequest,
+) (*types.QueryAppManifestResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	manifest, domain, verified, err := k.Keeper.GetAppManifest(goCtx, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAppManifestResponse{Manifest: manifest, Domain: domain, Verified: verified}, nil
+}
+
+// OIDCClient implements types.QueryServer.
+func (k Querier) OIDCClient(
+	goCtx context.Context,
+	req *types.QueryOIDCClientRequest,
+) (*types.QueryOIDCClientResponse, error) {
+	if req == nil || req.ClientId == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidOIDCClient, "client ID cannot be empty")
+	}
+
+	client, domain, verified, err := k.Keeper.GetOIDCClient(goCtx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOIDCClientResponse{Client: client, Domain: domain, Verified: verified}, nil
+}
+
+// OIDCClients implements types.QueryServer.
+func (k Querier) OIDCClients(
+	goCtx context.Context,
+	req *types.QueryOIDCClientsRequest,
+) (*types.QueryOIDCClientsResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	clients, err := k.Keeper.GetOIDCClients(goCtx, req.ServiceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OIDC clients: %w", err)
+	}
+	return &types.QueryOIDCClientsResponse{Clients: clients}, nil
+}
+
+// AttestationPolicy implements types.QueryServer.
+func (k Querier) AttestationPolicy(
+	goCtx context.Context,
+	req *types.QueryAttestationPolicyRequest,
+) (*types.QueryAttestationPolicyResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	policy, err := k.Keeper.GetAttestationPolicy(goCtx, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationPolicyResponse{Policy: policy}, nil
+}
+
+// BackupAnchors implements types.QueryServer.
+func (k Querier) BackupAnchors(
+	goCtx context.Context,
+	req *types.QueryBackupAnchorsRequest,
+) (*types.QueryBackupAnchorsResponse, error) {
+	if req == nil || req.ServiceId == "" {
+		return nil, types.ErrInvalidServiceID
+	}
+
+	page, err := pagination.Parse(req.Pagination, "svc/BackupAnchors/"+req.ServiceId+"/"+req.Dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := anchorRange(req.ServiceId, req.Dataset)
+	if len(page.Key) > 0 {
+		after := collections.Join3(req.ServiceId, req.Dataset, sdk.BigEndianToUint64(page.Key))
+		if page.Reverse {
+			rng = rng.EndExclusive(after)
+		} else {
+			rng = rng.StartExclusive(after)
+		}
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.Keeper.BackupAnchors.Iterate(goCtx, rng)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backup anchors: %w", err)
+	}
+	defer iter.Close()
+
+	anchors, pageRes, err := pagination.Collect(page, func() (types.BackupAnchor, []byte, bool, error) {
+		if !iter.Valid() {
+			return types.BackupAnchor{}, nil, false, nil
+		}
+		anchor, err := iter.Value()
+		if err != nil {
+			return types.BackupAnchor{}, nil, false, err
+		}
+		iter.Next()
+		return anchor, sdk.Uint64ToBigEndian(anchor.Sequence), true, nil
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup anchors: %w", err)
+	}
+	return &types.QueryBackupAnchorsResponse{Anchors: anchors, Pagination: pageRes}, nil
+}
+
+// ViewKey implements types.QueryServer.
+func (k Querier) ViewKey(goCtx context.Context, req *types.QueryViewKeyRequest) (*types.QueryViewKeyResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrViewKeyNotFound, "request cannot be empty")
+	}
+
+	key, err := k.Keeper.GetViewKey(goCtx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(goCtx).BlockTime().Unix()
+	return &types.QueryViewKeyResponse{
+		ViewKey: types.ViewKeyInfo{ViewKey: key, Active: key.Active(now)},
+	}, nil
+}
+
+// ViewKeys implements types.QueryServer. Revoked and expired keys are listed
+// as inactive.
+func (k Querier) ViewKeys(goCtx context.Context, req *types.QueryViewKeysRequest) (*types.QueryViewKeysResponse, error) {
+	if req == nil || req.Owner == "" {
+		return nil, types.ErrInvalidOwnerDID
+	}
+
+	keys, err := k.Keeper.GetViewKeysByOwner(goCtx, req.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list view keys: %w", err)
+	}
+
+	now := sdk.UnwrapSDKContext(goCtx).BlockTime().Unix()
+	res := &types.QueryViewKeysResponse{ViewKeys: make([]types.ViewKeyInfo, 0, len(keys))}
+	for _, key := range keys {
+		res.ViewKeys = append(res.ViewKeys, types.ViewKeyInfo{ViewKey: key, Active: key.Active(now)})
+	}
+	return res, nil
+}
+
+// AuthorizeView implements types.QueryServer. It lets API servers check a
+// view key bearer against the chain state they serve.
+func (k Querier) AuthorizeView(
+	goCtx context.Context,
+	req *types.QueryAuthorizeViewRequest,
+) (*types.QueryAuthorizeViewResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrViewKeyDenied, "request cannot be empty")
+	}
+
+	key, err := k.Keeper.AuthorizeView(goCtx, req.Id, req.Token, req.Scope)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAuthorizeViewResponse{ViewKey: key}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sonr-io/sonr/x/svc/types"
@@ -111,6 +112,25 @@ func TestQueryServicesByOwner(t *testing.T) {
 	}
 	require.Contains(serviceIds, "service1")
 	require.Contains(serviceIds, "service2")
+
+	// Page through the same services one at a time
+	page, err := f.queryServer.ServicesByOwner(f.ctx, &types.QueryServicesByOwnerRequest{
+		Owner:      "idx1test",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(page.Services, 1)
+	require.NotNil(page.Pagination.NextKey)
+	first := page.Services[0].Id
+
+	page, err = f.queryServer.ServicesByOwner(f.ctx, &types.QueryServicesByOwnerRequest{
+		Owner:      "idx1test",
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(page.Services, 1)
+	require.Nil(page.Pagination.NextKey)
+	require.NotEqual(first, page.Services[0].Id)
 }
 
 func TestQueryServicesByDomain(t *testing.T) {
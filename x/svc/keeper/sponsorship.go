@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "github.com/sonr-io/sonr/api/svc/v1"
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// SetFeeSponsorship sets the account a service pays its users' fees from and
+// the total it is willing to spend. Fees already spent count against the new
+// budget; a budget they exhaust leaves the sponsorship suspended, and one
+// above them lifts a suspension. Only the service owner may call it.
+func (k Keeper) SetFeeSponsorship(
+	ctx context.Context,
+	owner, serviceID, payer string,
+	budget sdk.Coins,
+) (types.FeeSponsorship, error) {
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return types.FeeSponsorship{}, errorsmod.Wrapf(types.ErrServiceNotFound, "%s", serviceID)
+	}
+	if service.Owner != owner {
+		return types.FeeSponsorship{}, errorsmod.Wrapf(
+			types.ErrInvalidPermissions,
+			"%s does not own service %s", owner, serviceID,
+		)
+	}
+	if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+		return types.FeeSponsorship{}, errorsmod.Wrapf(types.ErrServiceNotActive, "%s", serviceID)
+	}
+	if payer == "" {
+		payer = owner
+	}
+
+	sponsoredID, err := k.FeeSponsorsByPayer.Get(ctx, payer)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return types.FeeSponsorship{}, err
+	case sponsoredID != serviceID:
+		return types.FeeSponsorship{}, errorsmod.Wrapf(types.ErrFeePayerInUse, "%s pays for %s", payer, sponsoredID)
+	}
+
+	sponsorship, err := k.FeeSponsorships.Get(ctx, serviceID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeSponsorship{}, err
+	}
+	if sponsorship.Payer != "" && sponsorship.Payer != payer {
+		if err := k.FeeSponsorsByPayer.Remove(ctx, sponsorship.Payer); err != nil {
+			return types.FeeSponsorship{}, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sponsorship.ServiceId = serviceID
+	sponsorship.Payer = payer
+	sponsorship.Budget = budget
+	sponsorship.Suspended = sponsorship.Exhausted()
+	sponsorship.UpdatedAt = sdkCtx.BlockTime().Unix()
+
+	if err := k.FeeSponsorships.Set(ctx, serviceID, sponsorship); err != nil {
+		return types.FeeSponsorship{}, err
+	}
+	if err := k.FeeSponsorsByPayer.Set(ctx, payer, serviceID); err != nil {
+		return types.FeeSponsorship{}, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventFeeSponsorshipUpdated{
+		ServiceId: serviceID,
+		Payer:     payer,
+		Budget:    budget,
+		Suspended: sponsorship.Suspended,
+	}); err != nil {
+		return types.FeeSponsorship{}, err
+	}
+	return sponsorship, nil
+}
+
+// GetFeeSponsorship returns a service's fee sponsorship
+func (k Keeper) GetFeeSponsorship(ctx context.Context, serviceID string) (types.FeeSponsorship, error) {
+	sponsorship, err := k.FeeSponsorships.Get(ctx, serviceID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FeeSponsorship{}, errorsmod.Wrapf(types.ErrFeeSponsorshipNotFound, "%s", serviceID)
+	}
+	return sponsorship, err
+}
+
+// ChargeSponsoredFee charges fee against the budget of the service whose fee
+// payer is payer, and records it as usage by user. It returns false when payer
+// does not sponsor any service. A charge that would overrun the budget is
+// rejected; one that uses up the budget suspends the sponsorship.
+func (k Keeper) ChargeSponsoredFee(ctx context.Context, payer, user string, fee sdk.Coins) (bool, error) {
+	serviceID, err := k.FeeSponsorsByPayer.Get(ctx, payer)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	sponsorship, err := k.FeeSponsorships.Get(ctx, serviceID)
+	if err != nil {
+		return true, err
+	}
+	if sponsorship.Suspended {
+		return true, errorsmod.Wrapf(types.ErrFeeSponsorshipSuspended, "%s", serviceID)
+	}
+	service, err := k.OrmDB.ServiceTable().Get(ctx, serviceID)
+	if err != nil {
+		return true, errorsmod.Wrapf(types.ErrServiceNotFound, "%s", serviceID)
+	}
+	if service.Status != apiv1.ServiceStatus_SERVICE_STATUS_ACTIVE {
+		return true, errorsmod.Wrapf(types.ErrServiceNotActive, "%s", serviceID)
+	}
+	if !sponsorship.Covers(fee) {
+		return true, errorsmod.Wrapf(
+			types.ErrFeeBudgetExceeded,
+			"fee %s, remaining %s", fee, sponsorship.Remaining(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sponsorship.Spent = sponsorship.Spent.Add(fee...)
+	sponsorship.TxCount++
+	sponsorship.Suspended = sponsorship.Exhausted()
+	if err := k.FeeSponsorships.Set(ctx, serviceID, sponsorship); err != nil {
+		return true, err
+	}
+
+	key := collections.Join(serviceID, user)
+	usage, err := k.FeeSponsorUsage.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return true, err
+	}
+	usage.ServiceId = serviceID
+	usage.User = user
+	usage.Spent = usage.Spent.Add(fee...)
+	usage.TxCount++
+	usage.LastUsedAt = sdkCtx.BlockTime().Unix()
+	if err := k.FeeSponsorUsage.Set(ctx, key, usage); err != nil {
+		return true, err
+	}
+
+	if sponsorship.Suspended {
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventFeeSponsorshipSuspended{
+			ServiceId:   serviceID,
+			Spent:       sponsorship.Spent,
+			BlockHeight: uint64(sdkCtx.BlockHeight()),
+		}); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func usnr(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin("usnr", math.NewInt(amount)))
+}
+
+func setupSponsoredService(t *testing.T) (*testFixture, string) {
+	t.Helper()
+	f := SetupTest(t)
+	owner := f.addrs[0].String()
+
+	_, err := f.k.InitiateDomainVerification(f.ctx, "example.com", owner)
+	require.NoError(t, err)
+	require.NoError(t, f.k.SetDomainVerified(f.ctx, "example.com"))
+	_, err = f.msgServer.RegisterService(f.ctx, &types.MsgRegisterService{
+		Creator:              owner,
+		ServiceId:            "my-app",
+		Domain:               "example.com",
+		RequestedPermissions: []string{"register"},
+	})
+	require.NoError(t, err)
+	return f, owner
+}
+
+func TestFeeSponsorshipBudget(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+	payer := f.addrs[1].String()
+	user := f.addrs[2].String()
+
+	_, err := f.k.SetFeeSponsorship(f.ctx, payer, "my-app", payer, usnr(100))
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+
+	_, err = f.k.SetFeeSponsorship(f.ctx, owner, "my-app", payer, usnr(100))
+	require.NoError(err)
+
+	// Transactions paid by other accounts are not sponsored
+	sponsored, err := f.k.ChargeSponsoredFee(f.ctx, user, payer, usnr(10))
+	require.NoError(err)
+	require.False(sponsored)
+
+	sponsored, err = f.k.ChargeSponsoredFee(f.ctx, payer, user, usnr(60))
+	require.NoError(err)
+	require.True(sponsored)
+
+	_, err = f.k.ChargeSponsoredFee(f.ctx, payer, user, usnr(50))
+	require.ErrorIs(err, types.ErrFeeBudgetExceeded)
+
+	// Using up the budget suspends the sponsorship
+	_, err = f.k.ChargeSponsoredFee(f.ctx, payer, owner, usnr(40))
+	require.NoError(err)
+	sponsorship, err := f.k.GetFeeSponsorship(f.ctx, "my-app")
+	require.NoError(err)
+	require.True(sponsorship.Suspended)
+	require.Equal(uint64(2), sponsorship.TxCount)
+	require.True(sponsorship.Remaining().IsZero())
+
+	var suspended bool
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type == "svc.v1.EventFeeSponsorshipSuspended" {
+			suspended = true
+		}
+	}
+	require.True(suspended)
+
+	_, err = f.k.ChargeSponsoredFee(f.ctx, payer, user, usnr(1))
+	require.ErrorIs(err, types.ErrFeeSponsorshipSuspended)
+
+	// Raising the budget lifts the suspension
+	_, err = f.msgServer.SetFeeSponsorship(f.ctx, &types.MsgSetFeeSponsorship{
+		Owner:     owner,
+		ServiceId: "my-app",
+		Payer:     payer,
+		Budget:    usnr(150),
+	})
+	require.NoError(err)
+	res, err := f.queryServer.FeeSponsorship(f.ctx, &types.QueryFeeSponsorshipRequest{ServiceId: "my-app"})
+	require.NoError(err)
+	require.False(res.Sponsorship.Suspended)
+	require.Equal(usnr(50), res.Remaining)
+}
+
+func TestQueryFeeSponsorUsage(t *testing.T) {
+	f, owner := setupSponsoredService(t)
+	require := require.New(t)
+	payer := f.addrs[1].String()
+
+	_, err := f.k.SetFeeSponsorship(f.ctx, owner, "my-app", payer, usnr(1000))
+	require.NoError(err)
+	for _, user := range []string{owner, f.addrs[2].String(), owner} {
+		_, err = f.k.ChargeSponsoredFee(f.ctx, payer, user, usnr(10))
+		require.NoError(err)
+	}
+
+	page, err := f.queryServer.FeeSponsorUsage(f.ctx, &types.QueryFeeSponsorUsageRequest{
+		ServiceId:  "my-app",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(page.Usage, 1)
+	require.Equal(uint64(2), page.Pagination.Total)
+	first := page.Usage[0]
+
+	page, err = f.queryServer.FeeSponsorUsage(f.ctx, &types.QueryFeeSponsorUsageRequest{
+		ServiceId:  "my-app",
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(page.Usage, 1)
+	require.Nil(page.Pagination.NextKey)
+
+	usage := map[string]*types.FeeSponsorUsage{first.User: first, page.Usage[0].User: page.Usage[0]}
+	require.Equal(uint64(2), usage[owner].TxCount)
+	require.Equal(usnr(20), usage[owner].Spent)
+	require.Equal(usnr(10), usage[f.addrs[2].String()].Spent)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+// MintViewKey grants grantee read-only access to the owner's data within the
+// given scopes until ttl elapses.
+func (k Keeper) MintViewKey(
+	ctx context.Context,
+	owner, grantee string,
+	scopes []string,
+	ttl time.Duration,
+) (types.ViewKey, error) {
+	if ttl <= 0 || ttl > types.MaxViewKeyTTL {
+		return types.ViewKey{}, errorsmod.Wrapf(
+			types.ErrInvalidPermissions,
+			"view key ttl must be between 0 and %s", types.MaxViewKeyTTL,
+		)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	key := types.ViewKey{
+		Owner:     owner,
+		Grantee:   grantee,
+		Scopes:    scopes,
+		CreatedAt: now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}
+	if err := key.Validate(); err != nil {
+		return types.ViewKey{}, errorsmod.Wrap(types.ErrInvalidPermissions, err.Error())
+	}
+
+	id, err := k.ViewKeySeq.Next(ctx)
+	if err != nil {
+		return types.ViewKey{}, err
+	}
+	key.Id = id
+
+	if err := k.ViewKeys.Set(ctx, id, key); err != nil {
+		return types.ViewKey{}, err
+	}
+	if err := k.ViewKeysByOwner.Set(ctx, collections.Join(owner, id)); err != nil {
+		return types.ViewKey{}, err
+	}
+	return key, nil
+}
+
+// GetViewKey returns a view key by ID
+func (k Keeper) GetViewKey(ctx context.Context, id uint64) (types.ViewKey, error) {
+	key, err := k.ViewKeys.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ViewKey{}, errorsmod.Wrapf(types.ErrViewKeyNotFound, "%d", id)
+	}
+	return key, err
+}
+
+// RevokeViewKey revokes a view key. Only its owner may revoke it.
+func (k Keeper) RevokeViewKey(ctx context.Context, owner string, id uint64) error {
+	key, err := k.GetViewKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.Owner != owner {
+		return errorsmod.Wrapf(types.ErrInvalidOwnerDID, "view key %d is not owned by %s", id, owner)
+	}
+	key.Revoked = true
+	return k.ViewKeys.Set(ctx, id, key)
+}
+
+// GetViewKeysByOwner returns every view key minted by owner, including revoked
+// and expired ones.
+func (k Keeper) GetViewKeysByOwner(ctx context.Context, owner string) ([]types.ViewKey, error) {
+	iter, err := k.ViewKeysByOwner.Iterate(
+		ctx,
+		collections.NewPrefixedPairRange[string, uint64](owner),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys []types.ViewKey
+	for ; iter.Valid(); iter.Next() {
+		pk, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		key, err := k.ViewKeys.Get(ctx, pk.K2())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// AuthorizeView checks that the bearer of token may read scope through view
+// key id. The token must be a valid UCAN issued by the key's grantee for the
+// owner's view resource; expiry is evaluated against block time so keys lapse
+// without any further transaction.
+func (k Keeper) AuthorizeView(
+	ctx context.Context,
+	id uint64,
+	token string,
+	scope string,
+) (types.ViewKey, error) {
+	key, err := k.GetViewKey(ctx, id)
+	if err != nil {
+		return types.ViewKey{}, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if !key.Allows(scope, now) {
+		return types.ViewKey{}, errorsmod.Wrapf(types.ErrViewKeyDenied, "view key %d for scope %s", id, scope)
+	}
+
+	ucanToken, err := k.ucanVerifier.VerifyCapability(
+		ctx,
+		token,
+		types.CreateViewURI(key.Owner),
+		[]string{types.UCANView},
+	)
+	if err != nil {
+		return types.ViewKey{}, errorsmod.Wrap(types.ErrUCANValidationFailed, err.Error())
+	}
+	if ucanToken.Issuer != key.Grantee {
+		return types.ViewKey{}, errorsmod.Wrapf(
+			types.ErrViewKeyDenied,
+			"token issued by %s, expected grantee %s", ucanToken.Issuer, key.Grantee,
+		)
+	}
+	return key, nil
+}
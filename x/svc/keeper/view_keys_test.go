@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestMintAndRevokeViewKey(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	controller := f.addrs[0].String()
+	f.didKeeper.controllers = map[string]string{"did:sonr:alice": controller}
+
+	msg := &types.MsgMintViewKey{
+		Controller: controller,
+		Owner:      "did:sonr:alice",
+		Grantee:    "did:sonr:accountant",
+		Scopes:     []string{types.ViewScopeDexHistory, types.ViewScopeBalances},
+		TtlSeconds: uint64(time.Hour / time.Second),
+	}
+	require.NoError(msg.ValidateBasic())
+
+	// TTLs beyond the maximum, or large enough to overflow, are rejected
+	tooLong := *msg
+	tooLong.TtlSeconds = uint64(types.MaxViewKeyTTL/time.Second) + 1
+	require.ErrorIs(tooLong.ValidateBasic(), types.ErrInvalidPermissions)
+	tooLong.TtlSeconds = 1 << 62
+	require.ErrorIs(tooLong.ValidateBasic(), types.ErrInvalidPermissions)
+
+	// Only the DID's controller mints its keys
+	_, err := f.msgServer.MintViewKey(f.ctx, &types.MsgMintViewKey{
+		Controller: f.addrs[1].String(),
+		Owner:      msg.Owner,
+		Grantee:    msg.Grantee,
+		Scopes:     msg.Scopes,
+		TtlSeconds: msg.TtlSeconds,
+	})
+	require.ErrorIs(err, types.ErrNotDIDController)
+
+	res, err := f.msgServer.MintViewKey(f.ctx, msg)
+	require.NoError(err)
+	require.Equal("did:sonr:alice", res.ViewKey.Owner)
+	require.Equal(f.ctx.BlockTime().Add(time.Hour).Unix(), res.ViewKey.ExpiresAt)
+
+	got, err := f.queryServer.ViewKey(f.ctx, &types.QueryViewKeyRequest{Id: res.ViewKey.Id})
+	require.NoError(err)
+	require.True(got.ViewKey.Active)
+	require.Equal(res.ViewKey, got.ViewKey.ViewKey)
+
+	// Keys lapse with block time
+	later := f.ctx.WithBlockTime(f.ctx.BlockTime().Add(2 * time.Hour))
+	got, err = f.queryServer.ViewKey(later, &types.QueryViewKeyRequest{Id: res.ViewKey.Id})
+	require.NoError(err)
+	require.False(got.ViewKey.Active)
+
+	_, err = f.msgServer.RevokeViewKey(f.ctx, &types.MsgRevokeViewKey{
+		Controller: f.addrs[1].String(),
+		Owner:      "did:sonr:alice",
+		ViewKeyId:  res.ViewKey.Id,
+	})
+	require.ErrorIs(err, types.ErrNotDIDController)
+
+	_, err = f.msgServer.RevokeViewKey(f.ctx, &types.MsgRevokeViewKey{
+		Controller: controller,
+		Owner:      "did:sonr:alice",
+		ViewKeyId:  res.ViewKey.Id,
+	})
+	require.NoError(err)
+
+	list, err := f.queryServer.ViewKeys(f.ctx, &types.QueryViewKeysRequest{Owner: "did:sonr:alice"})
+	require.NoError(err)
+	require.Len(list.ViewKeys, 1)
+	require.True(list.ViewKeys[0].ViewKey.Revoked)
+	require.False(list.ViewKeys[0].Active)
+
+	// A revoked key authorizes nothing
+	_, err = f.queryServer.AuthorizeView(f.ctx, &types.QueryAuthorizeViewRequest{
+		Id:    res.ViewKey.Id,
+		Token: "token",
+		Scope: types.ViewScopeDexHistory,
+	})
+	require.ErrorIs(err, types.ErrViewKeyDenied)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"crypto/sha256"
+	"regexp"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// BackupAnchorsPrefix is the store prefix for backup anchors by service ID,
+// dataset and sequence
+var BackupAnchorsPrefix = collections.NewPrefix(17)
+
+// EventTypeBackupAnchored is emitted when a backup digest is anchored
+const EventTypeBackupAnchored = "backup_anchored"
+
+var datasetPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// ValidateBackupAnchor checks the dataset name, digest and snapshot time of an
+// anchor
+func ValidateBackupAnchor(dataset string, digest []byte, snapshotAt int64) error {
+	if !datasetPattern.MatchString(dataset) {
+		return errorsmod.Wrapf(ErrInvalidBackupAnchor, "dataset %q must be lowercase snake case of at most 32 characters", dataset)
+	}
+	if len(digest) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidBackupAnchor, "digest must be a %d byte SHA-256 hash", sha256.Size)
+	}
+	if snapshotAt <= 0 {
+		return errorsmod.Wrap(ErrInvalidBackupAnchor, "snapshot time is required")
+	}
+	return nil
+}
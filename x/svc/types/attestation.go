@@ -0,0 +1,147 @@
+package types
+
+import (
+	"crypto/x509"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// AttestationPoliciesPrefix is the store prefix for attestation policies by
+// service ID
+var AttestationPoliciesPrefix = collections.NewPrefix(16)
+
+// Event types emitted for attestation policies
+const (
+	EventTypeAttestationPolicySet     = "attestation_policy_set"
+	EventTypeAttestationPolicyRemoved = "attestation_policy_removed"
+)
+
+// Limits on attestation policies
+const (
+	MaxPolicyAAGUIDs      = 64
+	MaxPolicyTrustedRoots = 8
+)
+
+var aaguidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Validate checks the policy's formats, AAGUIDs and trusted roots
+func (p AttestationPolicy) Validate() error {
+	if p.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+
+	for _, format := range p.AllowedFormats {
+		if !slices.Contains(didtypes.SupportedAttestationFormats, format) {
+			return errorsmod.Wrapf(ErrInvalidAttestationPolicy, "unsupported attestation format %q", format)
+		}
+	}
+
+	if len(p.AllowedAaguids) > MaxPolicyAAGUIDs {
+		return errorsmod.Wrapf(ErrInvalidAttestationPolicy, "at most %d AAGUIDs", MaxPolicyAAGUIDs)
+	}
+	for _, aaguid := range p.AllowedAaguids {
+		if !aaguidPattern.MatchString(aaguid) {
+			return errorsmod.Wrapf(ErrInvalidAttestationPolicy, "AAGUID %q is not a lowercase UUID", aaguid)
+		}
+	}
+
+	if len(p.TrustedRoots) > MaxPolicyTrustedRoots {
+		return errorsmod.Wrapf(ErrInvalidAttestationPolicy, "at most %d trusted roots", MaxPolicyTrustedRoots)
+	}
+	for i, der := range p.TrustedRoots {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidAttestationPolicy, "trusted root %d: %s", i, err)
+		}
+		if !cert.IsCA {
+			return errorsmod.Wrapf(ErrInvalidAttestationPolicy, "trusted root %d is not a CA certificate", i)
+		}
+	}
+	return nil
+}
+
+// RequiresAttestation reports whether the policy refuses credentials without
+// a vendor attestation. Allowed AAGUIDs and trusted roots can only be checked
+// against one.
+func (p AttestationPolicy) RequiresAttestation() bool {
+	return p.RequireAttestation || len(p.AllowedAaguids) > 0 || len(p.TrustedRoots) > 0
+}
+
+// Check applies the policy to the verified attestation of a credential being
+// registered at time now
+func (p AttestationPolicy) Check(attestation didtypes.AttestationResult, now time.Time) error {
+	if p.RequiresAttestation() && !attestation.Attested() {
+		return errorsmod.Wrapf(ErrAttestationRejected,
+			"service %s requires an attested authenticator, got %s attestation", p.ServiceId, attestation.Type)
+	}
+	if len(p.AllowedFormats) > 0 && !slices.Contains(p.AllowedFormats, attestation.Format) {
+		return errorsmod.Wrapf(ErrAttestationRejected,
+			"service %s does not accept %s attestation", p.ServiceId, attestation.Format)
+	}
+	if len(p.AllowedAaguids) > 0 && !slices.Contains(p.AllowedAaguids, attestation.AAGUIDString()) {
+		return errorsmod.Wrapf(ErrAttestationRejected,
+			"service %s does not accept authenticator %s", p.ServiceId, attestation.AAGUIDString())
+	}
+	if p.RequireDeviceBound && attestation.BackupEligible {
+		return errorsmod.Wrapf(ErrAttestationRejected,
+			"service %s requires a device-bound credential", p.ServiceId)
+	}
+	if len(p.TrustedRoots) > 0 {
+		if err := p.verifyChain(attestation.CertificateChain, now); err != nil {
+			return errorsmod.Wrapf(ErrAttestationRejected,
+				"service %s does not trust the attestation certificate: %s", p.ServiceId, err)
+		}
+	}
+	return nil
+}
+
+// verifyChain checks that chain, leaf first, leads to one of the policy's
+// trusted roots. Validity is checked at block time so every node agrees.
+func (p AttestationPolicy) verifyChain(chain [][]byte, now time.Time) error {
+	if len(chain) == 0 {
+		return errorsmod.Wrap(ErrAttestationRejected, "no certificate chain")
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, der := range chain[1:] {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return err
+		}
+		intermediates.AddCert(cert)
+	}
+	roots := x509.NewCertPool()
+	for _, der := range p.TrustedRoots {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return err
+		}
+		roots.AddCert(cert)
+	}
+
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err
+}
+
+// NormalizeAAGUIDs lowercases the AAGUIDs of the policy
+func (p *AttestationPolicy) NormalizeAAGUIDs() {
+	for i, aaguid := range p.AllowedAaguids {
+		p.AllowedAaguids[i] = strings.ToLower(aaguid)
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// BadgesPrefix is the store prefix for badges by subject and badge type
+	BadgesPrefix = collections.NewPrefix(10)
+	// BadgeIssuersPrefix is the store prefix for designated badge issuers
+	BadgeIssuersPrefix = collections.NewPrefix(11)
+)
+
+// Event types emitted by the badge system
+const (
+	EventTypeBadgeIssuerSet     = "badge_issuer_set"
+	EventTypeBadgeIssuerRemoved = "badge_issuer_removed"
+	EventTypeBadgeIssued        = "badge_issued"
+	EventTypeBadgeRevoked       = "badge_revoked"
+)
+
+// IssuedToDID reports whether badges of the type are issued to DIDs rather
+// than service IDs
+func (t BadgeType) IssuedToDID() bool {
+	return t == BadgeType_BADGE_TYPE_HUMAN_VERIFIED
+}
+
+// ValidateBadgeSubject checks that a badge type is known and that the subject
+// is a DID for profile badges and a service ID for service badges
+func ValidateBadgeSubject(badgeType BadgeType, subject string) error {
+	if _, ok := BadgeType_name[int32(badgeType)]; !ok || badgeType == BadgeType_BADGE_TYPE_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidBadge, "unknown badge type %d", badgeType)
+	}
+	if subject == "" {
+		return errorsmod.Wrap(ErrInvalidBadge, "subject cannot be empty")
+	}
+	isDID := strings.HasPrefix(subject, "did:")
+	if badgeType.IssuedToDID() != isDID {
+		if isDID {
+			return errorsmod.Wrapf(ErrInvalidBadge, "%s is issued to services, not DIDs", badgeType)
+		}
+		return errorsmod.Wrapf(ErrInvalidBadge, "%s is issued to DIDs", badgeType)
+	}
+	return nil
+}
+
+// Active reports whether the badge is neither revoked nor expired at the
+// given unix time
+func (m Badge) Active(now int64) bool {
+	return !m.Revoked && (m.ExpiresAt == 0 || now < m.ExpiresAt)
+}
+
+// CanIssue reports whether the issuer is designated for the badge type
+func (m BadgeIssuer) CanIssue(badgeType BadgeType) bool {
+	for _, t := range m.Types {
+		if t == badgeType {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the issuer's address and badge types
+func (m BadgeIssuer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBadge, "issuer address: %s", err)
+	}
+	if len(m.Types) == 0 {
+		return errorsmod.Wrap(ErrInvalidBadge, "issuer must be designated for at least one badge type")
+	}
+	seen := make(map[BadgeType]bool, len(m.Types))
+	for _, t := range m.Types {
+		if _, ok := BadgeType_name[int32(t)]; !ok || t == BadgeType_BADGE_TYPE_UNSPECIFIED {
+			return errorsmod.Wrapf(ErrInvalidBadge, "unknown badge type %d", t)
+		}
+		if seen[t] {
+			return errorsmod.Wrapf(ErrInvalidBadge, "duplicate badge type %s", t)
+		}
+		seen[t] = true
+	}
+	return nil
+}
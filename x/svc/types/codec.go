@@ -22,12 +22,42 @@ func init() {
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgExecOnBehalf{}, ModuleName+"/MsgExecOnBehalf", nil)
+	cdc.RegisterConcrete(&MsgSetOnBehalfOptOut{}, ModuleName+"/MsgSetOnBehalfOptOut", nil)
+	cdc.RegisterConcrete(&MsgClearOnBehalfOptOut{}, ModuleName+"/MsgClearOnBehalfOptOut", nil)
+	cdc.RegisterConcrete(&MsgSetFeeSponsorship{}, ModuleName+"/MsgSetFeeSponsorship", nil)
+	cdc.RegisterConcrete(&MsgImportCredentials{}, ModuleName+"/MsgImportCredentials", nil)
+	cdc.RegisterConcrete(&MsgReregisterCredential{}, ModuleName+"/MsgReregisterCredential", nil)
+	cdc.RegisterConcrete(&MsgSetBadgeIssuer{}, ModuleName+"/MsgSetBadgeIssuer", nil)
+	cdc.RegisterConcrete(&MsgRemoveBadgeIssuer{}, ModuleName+"/MsgRemoveBadgeIssuer", nil)
+	cdc.RegisterConcrete(&MsgIssueBadge{}, ModuleName+"/MsgIssueBadge", nil)
+	cdc.RegisterConcrete(&MsgRevokeBadge{}, ModuleName+"/MsgRevokeBadge", nil)
+	cdc.RegisterConcrete(&MsgSetAttestationPolicy{}, ModuleName+"/MsgSetAttestationPolicy", nil)
+	cdc.RegisterConcrete(&MsgRemoveAttestationPolicy{}, ModuleName+"/MsgRemoveAttestationPolicy", nil)
+	cdc.RegisterConcrete(&MsgAnchorBackup{}, ModuleName+"/MsgAnchorBackup", nil)
+	cdc.RegisterConcrete(&MsgMintViewKey{}, ModuleName+"/MsgMintViewKey", nil)
+	cdc.RegisterConcrete(&MsgRevokeViewKey{}, ModuleName+"/MsgRevokeViewKey", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgExecOnBehalf{},
+		&MsgSetOnBehalfOptOut{},
+		&MsgClearOnBehalfOptOut{},
+		&MsgSetFeeSponsorship{},
+		&MsgImportCredentials{},
+		&MsgReregisterCredential{},
+		&MsgSetBadgeIssuer{},
+		&MsgRemoveBadgeIssuer{},
+		&MsgIssueBadge{},
+		&MsgRevokeBadge{},
+		&MsgSetAttestationPolicy{},
+		&MsgRemoveAttestationPolicy{},
+		&MsgAnchorBackup{},
+		&MsgMintViewKey{},
+		&MsgRevokeViewKey{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	// ImportedCredentialsPrefix is the store prefix for imported credentials by
+	// credential ID
+	ImportedCredentialsPrefix = collections.NewPrefix(8)
+	// ImportedCredentialsByServicePrefix is the store prefix for the service
+	// index of imported credentials
+	ImportedCredentialsByServicePrefix = collections.NewPrefix(9)
+)
+
+// ComputeDigest returns the SHA-256 of the export encoded with an empty digest
+func (m CredentialExport) ComputeDigest() ([]byte, error) {
+	m.Digest = nil
+	bz, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(bz)
+	return sum[:], nil
+}
+
+// Seal sets the digest of the export
+func (m *CredentialExport) Seal() error {
+	digest, err := m.ComputeDigest()
+	if err != nil {
+		return err
+	}
+	m.Digest = digest
+	return nil
+}
+
+// Validate checks that the export is complete and has not been modified since
+// it was sealed
+func (m CredentialExport) Validate() error {
+	if m.RpId == "" {
+		return errorsmod.Wrap(ErrInvalidCredentialExport, "relying party ID cannot be empty")
+	}
+	for _, cred := range m.Credentials {
+		if cred.CredentialId == "" {
+			return errorsmod.Wrap(ErrInvalidCredentialExport, "credential ID cannot be empty")
+		}
+		if len(cred.PublicKey) == 0 {
+			return errorsmod.Wrapf(ErrInvalidCredentialExport, "credential %s has no public key", cred.CredentialId)
+		}
+	}
+
+	digest, err := m.ComputeDigest()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidCredentialExport, err.Error())
+	}
+	if !bytes.Equal(digest, m.Digest) {
+		return errorsmod.Wrap(ErrInvalidCredentialExport, "digest does not match the export")
+	}
+	return nil
+}
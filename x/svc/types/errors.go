@@ -8,20 +8,46 @@ import (
 const (
 	DefaultCodespace = ModuleName
 
-	ErrCodeDomainNotVerified        = 1001
-	ErrCodeInvalidServiceID         = 1002
-	ErrCodeServiceAlreadyExists     = 1003
-	ErrCodeDomainAlreadyBound       = 1004
-	ErrCodeFailedToSaveService      = 1005
-	ErrCodeInvalidPermissions       = 1006
-	ErrCodeUCANValidationFailed     = 1007
-	ErrCodeInvalidUCANDelegation    = 1008
-	ErrCodeFailedToCreateCapability = 1009
-	ErrCodeInvalidOwnerDID          = 1010
-	ErrCodeServiceNotFound          = 1011
-	ErrCodeServiceNotActive         = 1012
-	ErrCodeOIDCConfigNotFound       = 1013
-	ErrCodeInvalidIssuer            = 1014
+	ErrCodeDomainNotVerified         = 1001
+	ErrCodeInvalidServiceID          = 1002
+	ErrCodeServiceAlreadyExists      = 1003
+	ErrCodeDomainAlreadyBound        = 1004
+	ErrCodeFailedToSaveService       = 1005
+	ErrCodeInvalidPermissions        = 1006
+	ErrCodeUCANValidationFailed      = 1007
+	ErrCodeInvalidUCANDelegation     = 1008
+	ErrCodeFailedToCreateCapability  = 1009
+	ErrCodeInvalidOwnerDID           = 1010
+	ErrCodeServiceNotFound           = 1011
+	ErrCodeServiceNotActive          = 1012
+	ErrCodeOIDCConfigNotFound        = 1013
+	ErrCodeInvalidIssuer             = 1014
+	ErrCodeOnBehalfNotAllowed        = 1015
+	ErrCodeOnBehalfOptedOut          = 1016
+	ErrCodeInvalidProposal           = 1017
+	ErrCodeViewKeyNotFound           = 1018
+	ErrCodeViewKeyDenied             = 1019
+	ErrCodeNotDIDController          = 1020
+	ErrCodeFeeSponsorshipNotFound    = 1021
+	ErrCodeFeeSponsorshipSuspended   = 1022
+	ErrCodeFeeBudgetExceeded         = 1023
+	ErrCodeFeePayerInUse             = 1024
+	ErrCodeInvalidCredentialExport   = 1025
+	ErrCodeCredentialCollision       = 1026
+	ErrCodeCredentialNotFound        = 1027
+	ErrCodeCredentialNotPending      = 1028
+	ErrCodeInvalidBadge              = 1029
+	ErrCodeBadgeNotFound             = 1030
+	ErrCodeNotBadgeIssuer            = 1031
+	ErrCodeDIDDeactivated            = 1032
+	ErrCodeInvalidAppManifest        = 1033
+	ErrCodeAppManifestNotFound       = 1034
+	ErrCodeInvalidOIDCClient         = 1035
+	ErrCodeOIDCClientNotFound        = 1036
+	ErrCodeInvalidAttestationPolicy  = 1037
+	ErrCodeAttestationPolicyNotFound = 1038
+	ErrCodeAttestationRejected       = 1039
+	ErrCodeInvalidBackupAnchor       = 1040
 )
 
 // x/svc module errors
@@ -96,4 +122,134 @@ var (
 		ErrCodeInvalidIssuer,
 		"invalid OIDC issuer",
 	)
+	ErrOnBehalfNotAllowed = errors.Register(
+		DefaultCodespace,
+		ErrCodeOnBehalfNotAllowed,
+		"message type cannot be executed on behalf of a user",
+	)
+	ErrOnBehalfOptedOut = errors.Register(
+		DefaultCodespace,
+		ErrCodeOnBehalfOptedOut,
+		"user has opted out of delegated execution",
+	)
+	ErrInvalidProposal = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidProposal,
+		"invalid governance proposal",
+	)
+	ErrViewKeyNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeViewKeyNotFound,
+		"view key not found",
+	)
+	ErrViewKeyDenied = errors.Register(
+		DefaultCodespace,
+		ErrCodeViewKeyDenied,
+		"view key does not grant access",
+	)
+	ErrNotDIDController = errors.Register(
+		DefaultCodespace,
+		ErrCodeNotDIDController,
+		"signer does not control the DID",
+	)
+	ErrFeeSponsorshipNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeFeeSponsorshipNotFound,
+		"fee sponsorship not found",
+	)
+	ErrFeeSponsorshipSuspended = errors.Register(
+		DefaultCodespace,
+		ErrCodeFeeSponsorshipSuspended,
+		"fee sponsorship is suspended",
+	)
+	ErrFeeBudgetExceeded = errors.Register(
+		DefaultCodespace,
+		ErrCodeFeeBudgetExceeded,
+		"fee exceeds the remaining sponsorship budget",
+	)
+	ErrFeePayerInUse = errors.Register(
+		DefaultCodespace,
+		ErrCodeFeePayerInUse,
+		"fee payer already sponsors another service",
+	)
+	ErrInvalidCredentialExport = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidCredentialExport,
+		"invalid credential export",
+	)
+	ErrCredentialCollision = errors.Register(
+		DefaultCodespace,
+		ErrCodeCredentialCollision,
+		"credential ID is already in use",
+	)
+	ErrCredentialNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeCredentialNotFound,
+		"credential not found",
+	)
+	ErrCredentialNotPending = errors.Register(
+		DefaultCodespace,
+		ErrCodeCredentialNotPending,
+		"credential is not pending re-registration",
+	)
+	ErrInvalidBadge = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidBadge,
+		"invalid badge",
+	)
+	ErrBadgeNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeBadgeNotFound,
+		"badge not found",
+	)
+	ErrNotBadgeIssuer = errors.Register(
+		DefaultCodespace,
+		ErrCodeNotBadgeIssuer,
+		"signer is not a designated issuer of the badge type",
+	)
+	ErrDIDDeactivated = errors.Register(
+		DefaultCodespace,
+		ErrCodeDIDDeactivated,
+		"DID is deactivated",
+	)
+	ErrInvalidAppManifest = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidAppManifest,
+		"invalid app manifest",
+	)
+	ErrAppManifestNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeAppManifestNotFound,
+		"app manifest not found",
+	)
+	ErrInvalidOIDCClient = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidOIDCClient,
+		"invalid OIDC client",
+	)
+	ErrOIDCClientNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeOIDCClientNotFound,
+		"OIDC client not found",
+	)
+	ErrInvalidAttestationPolicy = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidAttestationPolicy,
+		"invalid attestation policy",
+	)
+	ErrAttestationPolicyNotFound = errors.Register(
+		DefaultCodespace,
+		ErrCodeAttestationPolicyNotFound,
+		"attestation policy not found",
+	)
+	ErrAttestationRejected = errors.Register(
+		DefaultCodespace,
+		ErrCodeAttestationRejected,
+		"authenticator attestation rejected by service policy",
+	)
+	ErrInvalidBackupAnchor = errors.Register(
+		DefaultCodespace,
+		ErrCodeInvalidBackupAnchor,
+		"invalid backup anchor",
+	)
 )
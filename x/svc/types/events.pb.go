@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -282,43 +284,352 @@ func (m *EventServiceRegistered) GetBlockHeight() uint64 {
 	return 0
 }
 
+// EventFeeSponsorshipUpdated is emitted when a service sets its fee payer or
+// budget
+type EventFeeSponsorshipUpdated struct {
+	// Service ID
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Fee payer address
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// Total budget
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	// Whether sponsored transactions are suspended after the change
+	Suspended bool `protobuf:"varint,4,opt,name=suspended,proto3" json:"suspended,omitempty"`
+}
+
+func (m *EventFeeSponsorshipUpdated) Reset()         { *m = EventFeeSponsorshipUpdated{} }
+func (m *EventFeeSponsorshipUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSponsorshipUpdated) ProtoMessage()    {}
+func (*EventFeeSponsorshipUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115c521c5ed1659f, []int{3}
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSponsorshipUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSponsorshipUpdated.Merge(m, src)
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSponsorshipUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSponsorshipUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSponsorshipUpdated proto.InternalMessageInfo
+
+func (m *EventFeeSponsorshipUpdated) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipUpdated) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipUpdated) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *EventFeeSponsorshipUpdated) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+// EventFeeSponsorshipSuspended is emitted when a sponsored transaction
+// exhausts a service's budget
+type EventFeeSponsorshipSuspended struct {
+	// Service ID
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Fees paid in total
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// Block height
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventFeeSponsorshipSuspended) Reset()         { *m = EventFeeSponsorshipSuspended{} }
+func (m *EventFeeSponsorshipSuspended) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSponsorshipSuspended) ProtoMessage()    {}
+func (*EventFeeSponsorshipSuspended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115c521c5ed1659f, []int{4}
+}
+func (m *EventFeeSponsorshipSuspended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSponsorshipSuspended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSponsorshipSuspended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSponsorshipSuspended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSponsorshipSuspended.Merge(m, src)
+}
+func (m *EventFeeSponsorshipSuspended) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSponsorshipSuspended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSponsorshipSuspended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSponsorshipSuspended proto.InternalMessageInfo
+
+func (m *EventFeeSponsorshipSuspended) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipSuspended) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *EventFeeSponsorshipSuspended) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// EventCredentialsImported is emitted when a service imports a credential
+// export
+type EventCredentialsImported struct {
+	// Service ID
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Relying party the credentials were exported from
+	SourceRpId string `protobuf:"bytes,2,opt,name=source_rp_id,json=sourceRpId,proto3" json:"source_rp_id,omitempty"`
+	// Number of credentials imported as active
+	Imported uint32 `protobuf:"varint,3,opt,name=imported,proto3" json:"imported,omitempty"`
+	// Number of credentials waiting for re-registration
+	PendingReregistration uint32 `protobuf:"varint,4,opt,name=pending_reregistration,json=pendingReregistration,proto3" json:"pending_reregistration,omitempty"`
+	// IDs of the credentials skipped because they are already in use
+	Collisions []string `protobuf:"bytes,5,rep,name=collisions,proto3" json:"collisions,omitempty"`
+}
+
+func (m *EventCredentialsImported) Reset()         { *m = EventCredentialsImported{} }
+func (m *EventCredentialsImported) String() string { return proto.CompactTextString(m) }
+func (*EventCredentialsImported) ProtoMessage()    {}
+func (*EventCredentialsImported) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115c521c5ed1659f, []int{5}
+}
+func (m *EventCredentialsImported) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCredentialsImported) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCredentialsImported.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCredentialsImported) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCredentialsImported.Merge(m, src)
+}
+func (m *EventCredentialsImported) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCredentialsImported) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCredentialsImported.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCredentialsImported proto.InternalMessageInfo
+
+func (m *EventCredentialsImported) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *EventCredentialsImported) GetSourceRpId() string {
+	if m != nil {
+		return m.SourceRpId
+	}
+	return ""
+}
+
+func (m *EventCredentialsImported) GetImported() uint32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *EventCredentialsImported) GetPendingReregistration() uint32 {
+	if m != nil {
+		return m.PendingReregistration
+	}
+	return 0
+}
+
+func (m *EventCredentialsImported) GetCollisions() []string {
+	if m != nil {
+		return m.Collisions
+	}
+	return nil
+}
+
+// EventCredentialReregistered is emitted when an imported credential is
+// replaced by one registered with the service
+type EventCredentialReregistered struct {
+	// Service ID
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// ID of the imported credential
+	CredentialId string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// ID of the replacing credential
+	NewCredentialId string `protobuf:"bytes,3,opt,name=new_credential_id,json=newCredentialId,proto3" json:"new_credential_id,omitempty"`
+}
+
+func (m *EventCredentialReregistered) Reset()         { *m = EventCredentialReregistered{} }
+func (m *EventCredentialReregistered) String() string { return proto.CompactTextString(m) }
+func (*EventCredentialReregistered) ProtoMessage()    {}
+func (*EventCredentialReregistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_115c521c5ed1659f, []int{6}
+}
+func (m *EventCredentialReregistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCredentialReregistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCredentialReregistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCredentialReregistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCredentialReregistered.Merge(m, src)
+}
+func (m *EventCredentialReregistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCredentialReregistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCredentialReregistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCredentialReregistered proto.InternalMessageInfo
+
+func (m *EventCredentialReregistered) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *EventCredentialReregistered) GetCredentialId() string {
+	if m != nil {
+		return m.CredentialId
+	}
+	return ""
+}
+
+func (m *EventCredentialReregistered) GetNewCredentialId() string {
+	if m != nil {
+		return m.NewCredentialId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventDomainVerificationInitiated)(nil), "svc.v1.EventDomainVerificationInitiated")
 	proto.RegisterType((*EventDomainVerified)(nil), "svc.v1.EventDomainVerified")
 	proto.RegisterType((*EventServiceRegistered)(nil), "svc.v1.EventServiceRegistered")
+	proto.RegisterType((*EventFeeSponsorshipUpdated)(nil), "svc.v1.EventFeeSponsorshipUpdated")
+	proto.RegisterType((*EventFeeSponsorshipSuspended)(nil), "svc.v1.EventFeeSponsorshipSuspended")
+	proto.RegisterType((*EventCredentialsImported)(nil), "svc.v1.EventCredentialsImported")
+	proto.RegisterType((*EventCredentialReregistered)(nil), "svc.v1.EventCredentialReregistered")
 }
 
 func init() { proto.RegisterFile("svc/v1/events.proto", fileDescriptor_115c521c5ed1659f) }
 
 var fileDescriptor_115c521c5ed1659f = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0xad, 0xab, 0x56, 0x17, 0x81, 0x94, 0x4d, 0x53, 0x54, 0x41, 0x5a, 0xc6, 0x81,
-	0x5d, 0x88, 0x35, 0x38, 0x73, 0x60, 0x62, 0x12, 0xbd, 0x06, 0xc4, 0x81, 0x4b, 0xe5, 0xc6, 0x6f,
-	0xae, 0x45, 0x63, 0x47, 0xf1, 0x5b, 0x80, 0x6f, 0xb1, 0xcf, 0xb4, 0xd3, 0x8e, 0x3d, 0x72, 0x40,
-	0x80, 0xda, 0x2f, 0x82, 0x6c, 0xa7, 0xa1, 0xa8, 0x17, 0x0e, 0x3b, 0xc5, 0xff, 0xdf, 0x7b, 0x7e,
-	0x79, 0xff, 0x7f, 0x42, 0x8f, 0x6c, 0x9d, 0xb3, 0xfa, 0x9c, 0x41, 0x0d, 0x1a, 0x6d, 0x5a, 0x56,
-	0x06, 0x4d, 0xd4, 0xb3, 0x75, 0x9e, 0xd6, 0xe7, 0xc3, 0x63, 0x69, 0xa4, 0xf1, 0x88, 0xb9, 0x53,
-	0xa8, 0x0e, 0x47, 0xd2, 0x18, 0xb9, 0x00, 0xe6, 0xd5, 0xec, 0xfa, 0x8a, 0xa1, 0x2a, 0xc0, 0x22,
-	0x2f, 0xca, 0xd0, 0x70, 0x7a, 0x4b, 0xe8, 0xf8, 0xd2, 0xcd, 0x7b, 0x6b, 0x0a, 0xae, 0xf4, 0x47,
-	0xa8, 0xd4, 0x95, 0xca, 0x39, 0x2a, 0xa3, 0x27, 0x5a, 0xa1, 0xe2, 0x08, 0x22, 0x3a, 0xa1, 0x3d,
-	0xe1, 0xcb, 0x31, 0x19, 0x93, 0xb3, 0x7e, 0xd6, 0xa8, 0xe8, 0x39, 0x7d, 0x54, 0x6f, 0x5d, 0x98,
-	0x2a, 0x11, 0xef, 0xf9, 0x86, 0x87, 0xdb, 0x78, 0x22, 0xa2, 0xc7, 0xb4, 0x9f, 0xcf, 0xf9, 0x62,
-	0x01, 0x5a, 0x42, 0xbc, 0xef, 0x5b, 0xfe, 0x02, 0x57, 0x55, 0xe1, 0x5d, 0xa6, 0x8a, 0xbb, 0xa1,
-	0xda, 0x82, 0xe8, 0x29, 0x7d, 0x30, 0x5b, 0x98, 0xfc, 0xf3, 0x74, 0x0e, 0x4a, 0xce, 0x31, 0x3e,
-	0x18, 0x93, 0xb3, 0x6e, 0x36, 0xf0, 0xec, 0x9d, 0x47, 0xa7, 0x3f, 0x08, 0x3d, 0xda, 0x31, 0x71,
-	0x1f, 0x7b, 0x0f, 0xe9, 0x61, 0x20, 0x50, 0x35, 0x6b, 0xb7, 0x3a, 0xba, 0xa4, 0x83, 0xe6, 0x2c,
-	0xa6, 0x1c, 0xfd, 0xde, 0x83, 0x97, 0xc3, 0x34, 0x04, 0x9e, 0x6e, 0x02, 0x4f, 0x3f, 0x6c, 0x02,
-	0xbf, 0x38, 0xbc, 0xfb, 0x39, 0xea, 0xdc, 0xfc, 0x1a, 0x91, 0x8c, 0x6e, 0x2e, 0xbe, 0xc1, 0xff,
-	0xb1, 0x77, 0x4b, 0xe8, 0x89, 0xb7, 0xf7, 0x1e, 0xaa, 0x5a, 0xe5, 0x90, 0x81, 0x54, 0x16, 0xa1,
-	0x02, 0x11, 0x3d, 0xa1, 0xd4, 0x06, 0xe8, 0x4c, 0x04, 0x97, 0xfd, 0x86, 0x4c, 0xb6, 0x03, 0xd8,
-	0xfb, 0x27, 0x80, 0x63, 0x7a, 0x60, 0xbe, 0xe8, 0xd6, 0x54, 0x10, 0xee, 0x3b, 0x80, 0x16, 0xa5,
-	0x51, 0x1a, 0x6d, 0xdc, 0x1d, 0xef, 0xbb, 0x59, 0x2d, 0x70, 0x59, 0x14, 0x80, 0x5c, 0x70, 0xe4,
-	0x7e, 0xc9, 0x7e, 0xd6, 0xea, 0x1d, 0x13, 0xbd, 0x1d, 0x13, 0x17, 0xaf, 0xef, 0x56, 0x09, 0x59,
-	0xae, 0x12, 0xf2, 0x7b, 0x95, 0x90, 0x9b, 0x75, 0xd2, 0x59, 0xae, 0x93, 0xce, 0xf7, 0x75, 0xd2,
-	0xf9, 0xf4, 0x4c, 0x2a, 0x9c, 0x5f, 0xcf, 0xd2, 0xdc, 0x14, 0xcc, 0x1a, 0x5d, 0xbd, 0x50, 0xc6,
-	0x3f, 0xd9, 0x57, 0xe6, 0x7e, 0x78, 0xfc, 0x56, 0x82, 0x9d, 0xf5, 0x7c, 0xa0, 0xaf, 0xfe, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x14, 0x6a, 0x5c, 0x80, 0x04, 0x03, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4e, 0x1b, 0x3d,
+	0x10, 0x8f, 0x09, 0x89, 0x88, 0x03, 0x1f, 0xfa, 0x16, 0x3e, 0xb4, 0x5f, 0x4a, 0x37, 0x69, 0x38,
+	0x34, 0xaa, 0xc4, 0x6e, 0x43, 0xd5, 0x63, 0x0f, 0x85, 0x52, 0x35, 0xd7, 0xa5, 0xed, 0xa1, 0x97,
+	0xc8, 0x59, 0x0f, 0x1b, 0x8b, 0xc4, 0x5e, 0xd9, 0xce, 0x52, 0x1e, 0xa2, 0x12, 0xcf, 0xd1, 0xc7,
+	0xa0, 0x17, 0x4e, 0x15, 0x87, 0x1e, 0x7a, 0xa8, 0x4a, 0x05, 0x2f, 0x52, 0xad, 0xbd, 0xf9, 0x43,
+	0x53, 0x09, 0x0e, 0x9c, 0xd6, 0xf3, 0x9b, 0xf1, 0x78, 0x7e, 0xf3, 0x9b, 0x49, 0xf0, 0x9a, 0x4a,
+	0xa3, 0x20, 0x6d, 0x07, 0x90, 0x02, 0xd7, 0xca, 0x4f, 0xa4, 0xd0, 0xc2, 0x29, 0xab, 0x34, 0xf2,
+	0xd3, 0x76, 0x6d, 0x3d, 0x16, 0xb1, 0x30, 0x50, 0x90, 0x9d, 0xac, 0xb7, 0x56, 0x8f, 0x85, 0x88,
+	0x07, 0x10, 0x18, 0xab, 0x37, 0x3a, 0x0c, 0x34, 0x1b, 0x82, 0xd2, 0x64, 0x98, 0xe4, 0x01, 0x5e,
+	0x24, 0xd4, 0x50, 0xa8, 0xa0, 0x47, 0x14, 0x04, 0x69, 0xbb, 0x07, 0x9a, 0xb4, 0x83, 0x48, 0x30,
+	0x6e, 0xfd, 0xcd, 0x33, 0x84, 0x1b, 0xfb, 0xd9, 0x7b, 0xaf, 0xc4, 0x90, 0x30, 0xfe, 0x1e, 0x24,
+	0x3b, 0x64, 0x11, 0xd1, 0x4c, 0xf0, 0x0e, 0x67, 0x9a, 0x11, 0x0d, 0xd4, 0xd9, 0xc0, 0x65, 0x6a,
+	0xdc, 0x2e, 0x6a, 0xa0, 0x56, 0x25, 0xcc, 0x2d, 0xe7, 0x31, 0x5e, 0x4d, 0x67, 0x2e, 0x74, 0x19,
+	0x75, 0x17, 0x4c, 0xc0, 0x3f, 0xb3, 0x70, 0x87, 0x3a, 0x9b, 0xb8, 0x12, 0xf5, 0xc9, 0x60, 0x00,
+	0x3c, 0x06, 0xb7, 0x68, 0x42, 0xa6, 0x40, 0xe6, 0x65, 0xf6, 0x2d, 0x21, 0xdd, 0x45, 0xeb, 0x9d,
+	0x00, 0xce, 0x23, 0xbc, 0xdc, 0x1b, 0x88, 0xe8, 0xa8, 0xdb, 0x07, 0x16, 0xf7, 0xb5, 0x5b, 0x6a,
+	0xa0, 0xd6, 0x62, 0x58, 0x35, 0xd8, 0x1b, 0x03, 0x35, 0x7f, 0x20, 0xbc, 0x36, 0x47, 0xe2, 0x3e,
+	0xea, 0xae, 0xe1, 0x25, 0x8b, 0x80, 0xcc, 0xcb, 0x9e, 0xd8, 0xce, 0x3e, 0xae, 0xe6, 0x67, 0xda,
+	0x25, 0xda, 0xd4, 0x5d, 0xdd, 0xa9, 0xf9, 0x56, 0x10, 0x7f, 0x2c, 0x88, 0xff, 0x76, 0x2c, 0xc8,
+	0xee, 0xd2, 0xf9, 0xcf, 0x7a, 0xe1, 0xf4, 0xb2, 0x8e, 0x42, 0x3c, 0xbe, 0xf8, 0x52, 0xdf, 0x85,
+	0xde, 0x19, 0xc2, 0x1b, 0x86, 0xde, 0x01, 0xc8, 0x94, 0x45, 0x10, 0x42, 0xcc, 0x94, 0x06, 0x09,
+	0xd4, 0x79, 0x88, 0xb1, 0xb2, 0x60, 0x46, 0xc2, 0xb2, 0xac, 0xe4, 0x48, 0x67, 0xb6, 0x01, 0x0b,
+	0x37, 0x1a, 0xb0, 0x8e, 0x4b, 0xe2, 0x98, 0x4f, 0x48, 0x59, 0x23, 0xd3, 0x01, 0x38, 0x4d, 0x04,
+	0xe3, 0x5a, 0xb9, 0x8b, 0x8d, 0x62, 0x96, 0x6b, 0x02, 0x64, 0xbd, 0x18, 0x82, 0x26, 0x94, 0x68,
+	0x62, 0x8a, 0xac, 0x84, 0x13, 0x7b, 0x8e, 0x44, 0x79, 0x9e, 0xc4, 0x37, 0x84, 0x6b, 0x86, 0xc4,
+	0x6b, 0x80, 0x83, 0x44, 0x70, 0x25, 0xa4, 0xea, 0xb3, 0xe4, 0x5d, 0x42, 0x89, 0xbe, 0x9d, 0xc8,
+	0x3a, 0x2e, 0x25, 0xe4, 0x04, 0x64, 0xce, 0xc3, 0x1a, 0x4e, 0x84, 0xcb, 0xbd, 0x11, 0x8d, 0x41,
+	0xbb, 0xc5, 0x46, 0xb1, 0x55, 0xdd, 0xf9, 0xdf, 0xb7, 0xd3, 0xee, 0x67, 0xd3, 0xee, 0xe7, 0xd3,
+	0xee, 0xef, 0x09, 0xc6, 0x77, 0x9f, 0x66, 0xcd, 0xff, 0x7c, 0x59, 0x6f, 0xc5, 0x4c, 0xf7, 0x47,
+	0x3d, 0x3f, 0x12, 0xc3, 0x20, 0x5f, 0x0d, 0xfb, 0xd9, 0x56, 0xf4, 0x28, 0xd0, 0x27, 0x09, 0x28,
+	0x73, 0x41, 0x85, 0x79, 0xea, 0xac, 0x2b, 0x6a, 0xa4, 0x12, 0xe0, 0x14, 0xa8, 0x51, 0x79, 0x29,
+	0x9c, 0x02, 0xcd, 0x2f, 0x08, 0x6f, 0xfe, 0x85, 0xd6, 0xc1, 0x38, 0xe0, 0x36, 0x62, 0x04, 0x97,
+	0xb2, 0x48, 0xed, 0x2e, 0xdc, 0x3f, 0x03, 0x9b, 0x79, 0x4e, 0x9c, 0xe2, 0xbc, 0x38, 0x5f, 0x11,
+	0x76, 0x0d, 0x8b, 0x3d, 0x09, 0x14, 0xb8, 0x66, 0x64, 0xa0, 0x3a, 0xc3, 0x44, 0xc8, 0x3b, 0x48,
+	0xd3, 0xc0, 0xcb, 0x4a, 0x8c, 0x64, 0x04, 0x5d, 0x99, 0x4c, 0x37, 0x09, 0x5b, 0x2c, 0x4c, 0xec,
+	0x16, 0xb1, 0x3c, 0x99, 0x79, 0x7c, 0x25, 0x9c, 0xd8, 0xce, 0x73, 0xbc, 0x91, 0x35, 0x8a, 0xf1,
+	0xb8, 0x2b, 0x41, 0x9a, 0xc1, 0x96, 0x66, 0xfb, 0x4c, 0xab, 0x57, 0xc2, 0xff, 0x72, 0x6f, 0x78,
+	0xc3, 0xe9, 0x78, 0x18, 0x47, 0x62, 0x30, 0x60, 0x8a, 0x09, 0xae, 0xdc, 0x92, 0x99, 0xd5, 0x19,
+	0xa4, 0xf9, 0x09, 0xe1, 0x07, 0x7f, 0x10, 0x1a, 0x67, 0xb8, 0xcb, 0xde, 0x6c, 0xe1, 0x95, 0x68,
+	0x72, 0x71, 0x4a, 0x6a, 0x79, 0x0a, 0x76, 0xa8, 0xf3, 0x04, 0xff, 0xcb, 0xe1, 0xb8, 0x7b, 0x33,
+	0xd0, 0x2e, 0xd4, 0x2a, 0x87, 0xe3, 0xbd, 0x99, 0xd8, 0xdd, 0x17, 0xe7, 0x57, 0x1e, 0xba, 0xb8,
+	0xf2, 0xd0, 0xaf, 0x2b, 0x0f, 0x9d, 0x5e, 0x7b, 0x85, 0x8b, 0x6b, 0xaf, 0xf0, 0xfd, 0xda, 0x2b,
+	0x7c, 0xd8, 0x9a, 0x91, 0x53, 0x09, 0x2e, 0xb7, 0x99, 0x30, 0xdf, 0xe0, 0x63, 0x90, 0xfd, 0x1d,
+	0x18, 0x3d, 0x7b, 0x65, 0xf3, 0x73, 0xf2, 0xec, 0xf7, 0x00, 0xd5, 0x71, 0x1b, 0x90, 0x22, 0x06,
+	0x00, 0x00,
 }
 
 func (m *EventDomainVerificationInitiated) Marshal() (dAtA []byte, err error) {
@@ -499,59 +810,269 @@ func (m *EventServiceRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventFeeSponsorshipUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventDomainVerificationInitiated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventFeeSponsorshipUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSponsorshipUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.VerificationId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDomainVerified) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFeeSponsorshipSuspended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSponsorshipSuspended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSponsorshipSuspended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.VerificationId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCredentialsImported) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCredentialsImported) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCredentialsImported) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collisions) > 0 {
+		for iNdEx := len(m.Collisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Collisions[iNdEx])
+			copy(dAtA[i:], m.Collisions[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Collisions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PendingReregistration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PendingReregistration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Imported != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceRpId) > 0 {
+		i -= len(m.SourceRpId)
+		copy(dAtA[i:], m.SourceRpId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceRpId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCredentialReregistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCredentialReregistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCredentialReregistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCredentialId) > 0 {
+		i -= len(m.NewCredentialId)
+		copy(dAtA[i:], m.NewCredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewCredentialId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDomainVerificationInitiated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventDomainVerified) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	l = len(m.Verifier)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -598,6 +1119,104 @@ func (m *EventServiceRegistered) Size() (n int) {
 	return n
 }
 
+func (m *EventFeeSponsorshipUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Suspended {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventFeeSponsorshipSuspended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCredentialsImported) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceRpId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Imported != 0 {
+		n += 1 + sovEvents(uint64(m.Imported))
+	}
+	if m.PendingReregistration != 0 {
+		n += 1 + sovEvents(uint64(m.PendingReregistration))
+	}
+	if len(m.Collisions) > 0 {
+		for _, s := range m.Collisions {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCredentialReregistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewCredentialId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -665,9 +1284,436 @@ func (m *EventDomainVerificationInitiated) Unmarshal(dAtA []byte) error {
 			}
 			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDomainVerified: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDomainVerified: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventServiceRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventServiceRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -695,11 +1741,11 @@ func (m *EventDomainVerificationInitiated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -727,11 +1773,11 @@ func (m *EventDomainVerificationInitiated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -759,9 +1805,9 @@ func (m *EventDomainVerificationInitiated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -801,7 +1847,7 @@ func (m *EventDomainVerificationInitiated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSponsorshipUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,15 +1870,15 @@ func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDomainVerified: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSponsorshipUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDomainVerified: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSponsorshipUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,11 +1906,11 @@ func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -892,11 +1938,115 @@ func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeSponsorshipSuspended) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeSponsorshipSuspended: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeSponsorshipSuspended: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -924,11 +2074,11 @@ func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Verifier = string(dAtA[iNdEx:postIndex])
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -955,11 +2105,12 @@ func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -999,7 +2150,7 @@ func (m *EventDomainVerified) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
+func (m *EventCredentialsImported) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,10 +2173,10 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventServiceRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCredentialsImported: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventServiceRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCredentialsImported: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1062,7 +2213,7 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRpId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1090,11 +2241,49 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.SourceRpId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			m.Imported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Imported |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReregistration", wireType)
+			}
+			m.PendingReregistration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingReregistration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1122,11 +2311,61 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Collisions = append(m.Collisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCredentialReregistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCredentialReregistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCredentialReregistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1154,11 +2393,11 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1186,13 +2425,13 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCredentialId", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1202,11 +2441,24 @@ func (m *EventServiceRegistered) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCredentialId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	didtypes "github.com/sonr-io/sonr/x/did/types"
 )
 
@@ -19,4 +21,11 @@ type DIDKeeper interface {
 
 	// VerifyDIDDocumentSignature verifies a DID document signature
 	VerifyDIDDocumentSignature(ctx context.Context, did string, signature []byte) (bool, error)
+
+	// HasExistingCredential checks if a WebAuthn credential ID is registered
+	HasExistingCredential(ctx sdk.Context, credentialId string) bool
+
+	// GetWebAuthnCredentialsByRPID gets the WebAuthn credentials registered
+	// with a relying party
+	GetWebAuthnCredentialsByRPID(ctx context.Context, rpID string) ([]didtypes.RPCredential, error)
 }
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ipfs/go-cid"
+
+	didtypes "github.com/sonr-io/sonr/x/did/types"
+)
+
+// AppManifestsPrefix is the store prefix for app manifests by service ID
+var AppManifestsPrefix = collections.NewPrefix(12)
+
+// Event types emitted for app manifests
+const (
+	EventTypeAppManifestSet     = "app_manifest_set"
+	EventTypeAppManifestRemoved = "app_manifest_removed"
+)
+
+// Limits on the size of an app manifest
+const (
+	MaxAppNameLength        = 64
+	MaxAppDescriptionLength = 512
+	MaxManifestURIs         = 16
+)
+
+// deepLinkSchemeRe matches a URI scheme as defined by RFC 3986
+var deepLinkSchemeRe = regexp.MustCompile(`^[a-z][a-z0-9+.-]*$`)
+
+// reservedSchemes cannot be claimed as deep link schemes, since the browser
+// or the operating system handles them
+var reservedSchemes = map[string]bool{
+	"http": true, "https": true, "javascript": true, "data": true,
+	"file": true, "blob": true, "about": true, "mailto": true, "tel": true,
+}
+
+// Validate checks the manifest's fields and that every link it declares
+// belongs to domain, the service's verified domain
+func (m AppManifest) Validate(domain string) error {
+	if m.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+	if m.Name == "" || len(m.Name) > MaxAppNameLength {
+		return errorsmod.Wrapf(ErrInvalidAppManifest, "name must be 1 to %d bytes", MaxAppNameLength)
+	}
+	if len(m.Description) > MaxAppDescriptionLength {
+		return errorsmod.Wrapf(ErrInvalidAppManifest, "description exceeds %d bytes", MaxAppDescriptionLength)
+	}
+	if m.IconUri != "" {
+		if err := validateIconURI(m.IconUri); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAppManifest, "icon URI: %s", err)
+		}
+	}
+	if m.DeepLinkScheme != "" {
+		if !deepLinkSchemeRe.MatchString(m.DeepLinkScheme) || reservedSchemes[m.DeepLinkScheme] {
+			return errorsmod.Wrapf(ErrInvalidAppManifest, "invalid deep link scheme %q", m.DeepLinkScheme)
+		}
+	}
+
+	if len(m.RedirectUris) > MaxManifestURIs || len(m.UniversalLinkDomains) > MaxManifestURIs {
+		return errorsmod.Wrapf(ErrInvalidAppManifest, "at most %d redirect URIs and universal link domains", MaxManifestURIs)
+	}
+	for _, raw := range m.RedirectUris {
+		if err := validateRedirectURI(raw, domain, m.DeepLinkScheme); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAppManifest, "redirect URI %q: %s", raw, err)
+		}
+	}
+	for _, host := range m.UniversalLinkDomains {
+		if !IsDomainOrSubdomain(host, domain) {
+			return errorsmod.Wrapf(ErrInvalidAppManifest, "universal link domain %q is not under %s", host, domain)
+		}
+	}
+	return nil
+}
+
+// AllowsRedirect reports whether the manifest lists redirectURI exactly
+func (m AppManifest) AllowsRedirect(redirectURI string) bool {
+	for _, uri := range m.RedirectUris {
+		if uri == redirectURI {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDomainOrSubdomain reports whether host is domain or one of its
+// subdomains, ignoring case
+func IsDomainOrSubdomain(host, domain string) bool {
+	host, domain = strings.ToLower(host), strings.ToLower(domain)
+	return domain != "" && (host == domain || strings.HasSuffix(host, "."+domain))
+}
+
+// validateRedirectURI accepts HTTPS URIs on the service's domain and URIs
+// using the app's own deep link scheme. Fragments are rejected as in OAuth.
+func validateRedirectURI(raw, domain, scheme string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Fragment != "" {
+		return errors.New("must not contain a fragment")
+	}
+	switch {
+	case u.Scheme == "https":
+		if !IsDomainOrSubdomain(u.Hostname(), domain) {
+			return fmt.Errorf("host is not under %s", domain)
+		}
+	case scheme != "" && u.Scheme == scheme:
+	default:
+		return errors.New("must use https or the deep link scheme")
+	}
+	return nil
+}
+
+// validateIconURI accepts an absolute HTTPS URI, an ipfs:// URI of a CID or
+// an ipns:// URI of a name, optionally followed by a path inside it, or a DID
+// URL selecting the service that serves it, such as
+// did:sonr:abc?service=icons&relativeRef=/app.png. An IPNS name or DID
+// service lets the icon change without a manifest update.
+func validateIconURI(raw string) error {
+	if strings.HasPrefix(raw, "did:") {
+		u, err := didtypes.ParseDIDURL(raw)
+		if err != nil {
+			return err
+		}
+		if u.ServiceID() == "" {
+			return fmt.Errorf("DID URL %q selects no service", raw)
+		}
+		return nil
+	}
+	if path, ok := strings.CutPrefix(raw, "ipns://"); ok {
+		root, _, _ := strings.Cut(path, "/")
+		if c, err := cid.Decode(root); err != nil || c.Type() != cid.Libp2pKey {
+			return fmt.Errorf("invalid IPNS name %q", root)
+		}
+		return nil
+	}
+	path, ok := strings.CutPrefix(raw, "ipfs://")
+	if !ok {
+		_, err := parseHTTPSURI(raw)
+		return err
+	}
+	root, _, _ := strings.Cut(path, "/")
+	if _, err := cid.Decode(root); err != nil {
+		return fmt.Errorf("invalid IPFS CID %q", root)
+	}
+	return nil
+}
+
+// parseHTTPSURI parses an absolute HTTPS URI
+func parseHTTPSURI(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("must be an absolute https URI")
+	}
+	return u, nil
+}
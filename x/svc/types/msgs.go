@@ -1,11 +1,23 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgExecOnBehalf{}
+	_ sdk.Msg = &MsgSetOnBehalfOptOut{}
+	_ sdk.Msg = &MsgClearOnBehalfOptOut{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgExecOnBehalf{}
+)
 
 // NewMsgUpdateParams creates new instance of MsgUpdateParams
 func NewMsgUpdateParams(
@@ -43,3 +55,278 @@ func (msg *MsgUpdateParams) Validate() error {
 
 	return msg.Params.Validate()
 }
+
+// NewMsgExecOnBehalf creates a MsgExecOnBehalf wrapping msgs
+func NewMsgExecOnBehalf(
+	sender sdk.AccAddress,
+	serviceDID string,
+	did string,
+	ucanToken string,
+	msgs []sdk.Msg,
+) (*MsgExecOnBehalf, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExecOnBehalf{
+		Sender:     sender.String(),
+		ServiceDid: serviceDID,
+		Did:        did,
+		UcanToken:  ucanToken,
+		Msgs:       anys,
+	}, nil
+}
+
+// GetMessages returns the cached messages wrapped by the MsgExecOnBehalf
+func (msg *MsgExecOnBehalf) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgExecOnBehalf")
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg *MsgExecOnBehalf) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// GetSigners returns the expected signers for a MsgExecOnBehalf message.
+func (msg *MsgExecOnBehalf) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgExecOnBehalf) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Did == "" {
+		return ErrInvalidOwnerDID
+	}
+	if len(msg.Msgs) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "no messages to execute")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetOnBehalfOptOut message.
+func (msg *MsgSetOnBehalfOptOut) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetOnBehalfOptOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Did == "" {
+		return ErrInvalidOwnerDID
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgClearOnBehalfOptOut message.
+func (msg *MsgClearOnBehalfOptOut) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgClearOnBehalfOptOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Did == "" {
+		return ErrInvalidOwnerDID
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetFeeSponsorship message.
+func (msg *MsgSetFeeSponsorship) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+	if msg.Payer != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Payer); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "payer: %s", err)
+		}
+	}
+	if !msg.Budget.IsValid() && !msg.Budget.Empty() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "budget: %s", msg.Budget)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgImportCredentials message.
+func (msg *MsgImportCredentials) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgImportCredentials) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+	return msg.Export.Validate()
+}
+
+// GetSigners returns the expected signers for a MsgReregisterCredential message.
+func (msg *MsgReregisterCredential) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgReregisterCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+	if msg.CredentialId == "" || msg.NewCredentialId == "" {
+		return errors.Wrap(ErrCredentialNotFound, "credential IDs cannot be empty")
+	}
+	if msg.CredentialId == msg.NewCredentialId {
+		return errors.Wrap(ErrCredentialCollision, "a credential cannot replace itself")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetBadgeIssuer message.
+func (msg *MsgSetBadgeIssuer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetBadgeIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return msg.Issuer.Validate()
+}
+
+// GetSigners returns the expected signers for a MsgRemoveBadgeIssuer message.
+func (msg *MsgRemoveBadgeIssuer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveBadgeIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "issuer: %s", err)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgIssueBadge message.
+func (msg *MsgIssueBadge) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgIssueBadge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := ValidateBadgeSubject(msg.BadgeType, msg.Subject); err != nil {
+		return err
+	}
+	if msg.ExpiresAt < 0 {
+		return errors.Wrap(ErrInvalidBadge, "expiry cannot be negative")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRevokeBadge message.
+func (msg *MsgRevokeBadge) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRevokeBadge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return ValidateBadgeSubject(msg.BadgeType, msg.Subject)
+}
+
+// GetSigners returns the expected signers for a MsgAnchorBackup message.
+func (msg *MsgAnchorBackup) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgAnchorBackup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+	return ValidateBackupAnchor(msg.Dataset, msg.Digest, msg.SnapshotAt)
+}
+
+// GetSigners returns the expected signers for a MsgMintViewKey message.
+func (msg *MsgMintViewKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgMintViewKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.TtlSeconds == 0 || msg.TtlSeconds > uint64(MaxViewKeyTTL/time.Second) {
+		return errors.Wrapf(ErrInvalidPermissions, "view key ttl must be between 0 and %s", MaxViewKeyTTL)
+	}
+	key := ViewKey{
+		Owner:     msg.Owner,
+		Grantee:   msg.Grantee,
+		Scopes:    msg.Scopes,
+		ExpiresAt: int64(msg.TtlSeconds),
+	}
+	if err := key.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidPermissions, err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgRevokeViewKey message.
+func (msg *MsgRevokeViewKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRevokeViewKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Owner == "" {
+		return ErrInvalidOwnerDID
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	// OIDCClientsPrefix is the store prefix for OIDC clients by client ID
+	OIDCClientsPrefix = collections.NewPrefix(13)
+	// OIDCClientsByServicePrefix indexes OIDC clients by service ID
+	OIDCClientsByServicePrefix = collections.NewPrefix(14)
+	// OIDCClientSeqPrefix is the store prefix for the OIDC client sequence
+	OIDCClientSeqPrefix = collections.NewPrefix(15)
+)
+
+// Event types emitted for OIDC clients
+const (
+	EventTypeOIDCClientRegistered = "oidc_client_registered"
+	EventTypeOIDCClientRemoved    = "oidc_client_removed"
+)
+
+// Limits on OIDC client registrations
+const (
+	MaxOIDCClientsPerService = 16
+	MaxOIDCClientScopes      = 32
+)
+
+// OIDCScopeOpenID must be among the scopes of every OIDC client
+const OIDCScopeOpenID = "openid"
+
+// NewOIDCClientID derives the client ID assigned to the seq-th registered
+// client. IDs are opaque so apps do not come to rely on their structure.
+func NewOIDCClientID(serviceID string, seq uint64) string {
+	h := sha256.New()
+	h.Write([]byte(serviceID))
+	_ = binary.Write(h, binary.BigEndian, seq)
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// Validate checks the client's fields and that its URIs belong to domain,
+// the service's verified domain. Non-HTTPS redirect URIs must use
+// deepLinkScheme, the scheme of the service's app manifest, and scopes must
+// be among supported.
+func (c OIDCClient) Validate(domain, deepLinkScheme string, supported []string) error {
+	if c.ServiceId == "" {
+		return ErrInvalidServiceID
+	}
+
+	if len(c.RedirectUris) == 0 || len(c.RedirectUris) > MaxManifestURIs {
+		return errorsmod.Wrapf(ErrInvalidOIDCClient, "1 to %d redirect URIs are required", MaxManifestURIs)
+	}
+	seen := make(map[string]bool, len(c.RedirectUris))
+	for _, raw := range c.RedirectUris {
+		if seen[raw] {
+			return errorsmod.Wrapf(ErrInvalidOIDCClient, "duplicate redirect URI %q", raw)
+		}
+		seen[raw] = true
+		if err := validateRedirectURI(raw, domain, deepLinkScheme); err != nil {
+			return errorsmod.Wrapf(ErrInvalidOIDCClient, "redirect URI %q: %s", raw, err)
+		}
+	}
+
+	if len(c.Scopes) > MaxOIDCClientScopes {
+		return errorsmod.Wrapf(ErrInvalidOIDCClient, "at most %d scopes", MaxOIDCClientScopes)
+	}
+	allowed := make(map[string]bool, len(supported))
+	for _, scope := range supported {
+		allowed[scope] = true
+	}
+	seen = make(map[string]bool, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		if seen[scope] {
+			return errorsmod.Wrapf(ErrInvalidOIDCClient, "duplicate scope %q", scope)
+		}
+		seen[scope] = true
+		if !allowed[scope] {
+			return errorsmod.Wrapf(ErrInvalidOIDCClient, "scope %q is not supported", scope)
+		}
+	}
+	if !seen[OIDCScopeOpenID] {
+		return errorsmod.Wrapf(ErrInvalidOIDCClient, "scopes must include %s", OIDCScopeOpenID)
+	}
+
+	if c.Origin != "" {
+		if err := validateOrigin(c.Origin, domain); err != nil {
+			return errorsmod.Wrapf(ErrInvalidOIDCClient, "origin %q: %s", c.Origin, err)
+		}
+	}
+	return nil
+}
+
+// AllowsRedirect reports whether the client lists redirectURI exactly
+func (c OIDCClient) AllowsRedirect(redirectURI string) bool {
+	for _, uri := range c.RedirectUris {
+		if uri == redirectURI {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsScopes reports whether every requested scope was registered for the
+// client
+func (c OIDCClient) AllowsScopes(requested []string) bool {
+	registered := make(map[string]bool, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		registered[scope] = true
+	}
+	for _, scope := range requested {
+		if !registered[scope] {
+			return false
+		}
+	}
+	return true
+}
+
+// validateOrigin accepts a bare HTTPS origin on the service's domain, as sent
+// in the Origin header
+func validateOrigin(raw, domain string) error {
+	u, err := parseHTTPSURI(raw)
+	if err != nil {
+		return err
+	}
+	if u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return errors.New("must be a scheme and host only")
+	}
+	if !IsDomainOrSubdomain(u.Hostname(), domain) {
+		return fmt.Errorf("host is not under %s", domain)
+	}
+	return nil
+}
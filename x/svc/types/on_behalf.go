@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+)
+
+// OnBehalfOptOutsPrefix is the store prefix for the on-behalf execution opt-out registry
+var OnBehalfOptOutsPrefix = collections.NewPrefix(1)
+
+// UCANExecOnBehalf is the UCAN action a user grants to let a service act as them
+const UCANExecOnBehalf = "exec-on-behalf"
+
+// DefaultOnBehalfMsgAllowlist lists the message type URLs services may execute
+// on behalf of a user. Anything not listed is rejected at the ante level.
+var DefaultOnBehalfMsgAllowlist = []string{
+	"/dex.v1.MsgExecuteSwap",
+	"/dex.v1.MsgProvideLiquidity",
+	"/dex.v1.MsgRemoveLiquidity",
+	"/dex.v1.MsgCreateLimitOrder",
+	"/dex.v1.MsgCancelOrder",
+	"/dex.v1.MsgCreateTWAMMOrder",
+	"/dex.v1.MsgCancelTWAMMOrder",
+}
+
+// IsOnBehalfMsgAllowed reports whether a message type may be executed on behalf of a user
+func IsOnBehalfMsgAllowed(msgTypeURL string) bool {
+	return slices.Contains(DefaultOnBehalfMsgAllowlist, msgTypeURL)
+}
+
+// CreateOnBehalfURI builds the UCAN resource URI for acting as a DID
+func CreateOnBehalfURI(did string) string {
+	return fmt.Sprintf("account:%s", did)
+}
+
+// OnBehalfOptOut records that a DID refuses delegated execution. An empty
+// service list opts out of every service.
+type OnBehalfOptOut struct {
+	Did        string   `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	ServiceIds []string `protobuf:"bytes,2,rep,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	OptedOutAt int64    `protobuf:"varint,3,opt,name=opted_out_at,json=optedOutAt,proto3" json:"opted_out_at,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (OnBehalfOptOut) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *OnBehalfOptOut) Reset() {
+	*m = OnBehalfOptOut{}
+}
+
+// String implements proto.Message
+func (m OnBehalfOptOut) String() string {
+	return fmt.Sprintf("%s opted out of %v", m.Did, m.ServiceIds)
+}
+
+// Covers reports whether the opt-out applies to the given service
+func (m OnBehalfOptOut) Covers(serviceID string) bool {
+	return len(m.ServiceIds) == 0 || slices.Contains(m.ServiceIds, serviceID)
+}
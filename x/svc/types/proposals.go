@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Governance proposal types carried in proposal metadata. A proposal whose
+// metadata is a JSON object with one of these "@type" values is executed by the
+// svc module once it passes.
+const (
+	ProposalTypeRevokeService        = "svc/revoke-service"
+	ProposalTypeResolveDomainDispute = "svc/resolve-domain-dispute"
+)
+
+// Event types emitted when governance proposals are executed
+const (
+	EventTypeServiceRevoked        = "service_revoked"
+	EventTypeDomainDisputeResolved = "domain_dispute_resolved"
+)
+
+// RevokeServiceProposal revokes a malicious service, disabling its origins
+// and all capabilities it has issued
+type RevokeServiceProposal struct {
+	ServiceID string `json:"service_id"`
+	Reason    string `json:"reason"`
+}
+
+// Validate performs stateless validation of the proposal
+func (p RevokeServiceProposal) Validate() error {
+	if strings.TrimSpace(p.ServiceID) == "" {
+		return errorsmod.Wrap(ErrInvalidProposal, "service ID cannot be empty")
+	}
+	if strings.TrimSpace(p.Reason) == "" {
+		return errorsmod.Wrap(ErrInvalidProposal, "revocation reason cannot be empty")
+	}
+	return nil
+}
+
+// DomainDisputeProposal awards a disputed domain to a new owner. Services bound
+// to the domain are transferred to the new owner when TransferServices is set
+// and revoked otherwise.
+type DomainDisputeProposal struct {
+	Domain           string `json:"domain"`
+	AwardedOwner     string `json:"awarded_owner"`
+	Evidence         string `json:"evidence"`
+	TransferServices bool   `json:"transfer_services"`
+}
+
+// Validate performs stateless validation of the proposal
+func (p DomainDisputeProposal) Validate() error {
+	if strings.TrimSpace(p.Domain) == "" {
+		return errorsmod.Wrap(ErrInvalidProposal, "domain cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.AwardedOwner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProposal, "invalid awarded owner: %s", err)
+	}
+	if strings.TrimSpace(p.Evidence) == "" {
+		return errorsmod.Wrap(ErrInvalidProposal, "dispute evidence cannot be empty")
+	}
+	return nil
+}
+
+// proposalEnvelope is the structured metadata of an svc governance proposal
+type proposalEnvelope struct {
+	Type    string          `json:"@type"`
+	Title   string          `json:"title,omitempty"`
+	Summary string          `json:"summary,omitempty"`
+	Content json.RawMessage `json:"content"`
+}
+
+// ParseProposalMetadata decodes svc proposal metadata. It returns a nil
+// proposal without error when the metadata does not describe an svc proposal,
+// so ordinary proposals are ignored.
+func ParseProposalMetadata(metadata string) (any, error) {
+	var envelope proposalEnvelope
+	if err := json.Unmarshal([]byte(metadata), &envelope); err != nil {
+		return nil, nil
+	}
+
+	switch envelope.Type {
+	case ProposalTypeRevokeService:
+		var p RevokeServiceProposal
+		if err := json.Unmarshal(envelope.Content, &p); err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidProposal, err.Error())
+		}
+		return p, p.Validate()
+
+	case ProposalTypeResolveDomainDispute:
+		var p DomainDisputeProposal
+		if err := json.Unmarshal(envelope.Content, &p); err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidProposal, err.Error())
+		}
+		return p, p.Validate()
+
+	default:
+		if strings.HasPrefix(envelope.Type, ModuleName+"/") {
+			return nil, errorsmod.Wrapf(ErrInvalidProposal, "unknown proposal type %s", envelope.Type)
+		}
+		return nil, nil
+	}
+}
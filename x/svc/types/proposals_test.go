@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestParseProposalMetadata(t *testing.T) {
+	content, err := types.ParseProposalMetadata(
+		`{"@type":"svc/revoke-service","content":{"service_id":"svc-1","reason":"phishing"}}`,
+	)
+	require.NoError(t, err)
+	require.Equal(t, types.RevokeServiceProposal{ServiceID: "svc-1", Reason: "phishing"}, content)
+
+	// Ordinary proposals are ignored
+	content, err = types.ParseProposalMetadata("ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi")
+	require.NoError(t, err)
+	require.Nil(t, content)
+
+	content, err = types.ParseProposalMetadata(`{"title":"text proposal"}`)
+	require.NoError(t, err)
+	require.Nil(t, content)
+
+	// Malformed svc proposals are rejected
+	_, err = types.ParseProposalMetadata(`{"@type":"svc/revoke-service","content":{"service_id":"svc-1"}}`)
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+
+	_, err = types.ParseProposalMetadata(`{"@type":"svc/unknown","content":{}}`)
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+
+	_, err = types.ParseProposalMetadata(
+		`{"@type":"svc/resolve-domain-dispute","content":{"domain":"example.com","awarded_owner":"bad","evidence":"dns"}}`,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+}
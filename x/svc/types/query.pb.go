@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -297,6 +301,8 @@ func (m *QueryServiceResponse) GetService() *Service {
 // RPC method.
 type QueryServicesByOwnerRequest struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryServicesByOwnerRequest) Reset()         { *m = QueryServicesByOwnerRequest{} }
@@ -339,10 +345,19 @@ func (m *QueryServicesByOwnerRequest) GetOwner() string {
 	return ""
 }
 
+func (m *QueryServicesByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryServicesByOwnerResponse is the response type for the
 // Query/ServicesByOwner RPC method.
 type QueryServicesByOwnerResponse struct {
 	Services []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryServicesByOwnerResponse) Reset()         { *m = QueryServicesByOwnerResponse{} }
@@ -385,10 +400,19 @@ func (m *QueryServicesByOwnerResponse) GetServices() []*Service {
 	return nil
 }
 
+func (m *QueryServicesByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryServicesByDomainRequest is the request type for the
 // Query/ServicesByDomain RPC method.
 type QueryServicesByDomainRequest struct {
 	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryServicesByDomainRequest) Reset()         { *m = QueryServicesByDomainRequest{} }
@@ -431,10 +455,19 @@ func (m *QueryServicesByDomainRequest) GetDomain() string {
 	return ""
 }
 
+func (m *QueryServicesByDomainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryServicesByDomainResponse is the response type for the
 // Query/ServicesByDomain RPC method.
 type QueryServicesByDomainResponse struct {
 	Services []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryServicesByDomainResponse) Reset()         { *m = QueryServicesByDomainResponse{} }
@@ -477,6 +510,13 @@ func (m *QueryServicesByDomainResponse) GetServices() []*Service {
 	return nil
 }
 
+func (m *QueryServicesByDomainResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryServiceOIDCDiscoveryRequest is the request type for the
 // Query/ServiceOIDCDiscovery RPC method.
 type QueryServiceOIDCDiscoveryRequest struct {
@@ -974,1551 +1014,7880 @@ func (m *QueryServiceOIDCMetadataResponse) GetMetadata() map[string]string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "svc.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "svc.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryDomainVerificationRequest)(nil), "svc.v1.QueryDomainVerificationRequest")
-	proto.RegisterType((*QueryDomainVerificationResponse)(nil), "svc.v1.QueryDomainVerificationResponse")
-	proto.RegisterType((*QueryServiceRequest)(nil), "svc.v1.QueryServiceRequest")
-	proto.RegisterType((*QueryServiceResponse)(nil), "svc.v1.QueryServiceResponse")
-	proto.RegisterType((*QueryServicesByOwnerRequest)(nil), "svc.v1.QueryServicesByOwnerRequest")
-	proto.RegisterType((*QueryServicesByOwnerResponse)(nil), "svc.v1.QueryServicesByOwnerResponse")
-	proto.RegisterType((*QueryServicesByDomainRequest)(nil), "svc.v1.QueryServicesByDomainRequest")
-	proto.RegisterType((*QueryServicesByDomainResponse)(nil), "svc.v1.QueryServicesByDomainResponse")
-	proto.RegisterType((*QueryServiceOIDCDiscoveryRequest)(nil), "svc.v1.QueryServiceOIDCDiscoveryRequest")
-	proto.RegisterType((*QueryServiceOIDCDiscoveryResponse)(nil), "svc.v1.QueryServiceOIDCDiscoveryResponse")
-	proto.RegisterType((*QueryServiceOIDCJWKSRequest)(nil), "svc.v1.QueryServiceOIDCJWKSRequest")
-	proto.RegisterType((*QueryServiceOIDCJWKSResponse)(nil), "svc.v1.QueryServiceOIDCJWKSResponse")
-	proto.RegisterType((*QueryServiceOIDCMetadataRequest)(nil), "svc.v1.QueryServiceOIDCMetadataRequest")
-	proto.RegisterType((*QueryServiceOIDCMetadataResponse)(nil), "svc.v1.QueryServiceOIDCMetadataResponse")
-	proto.RegisterMapType((map[string]string)(nil), "svc.v1.QueryServiceOIDCMetadataResponse.MetadataEntry")
+// QueryFeeSponsorshipRequest is the request type for the Query/FeeSponsorship
+// RPC method.
+type QueryFeeSponsorshipRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("svc/v1/query.proto", fileDescriptor_81a1010cdbf4bc9c) }
-
-var fileDescriptor_81a1010cdbf4bc9c = []byte{
-	// 1309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x13, 0x47,
-	0x14, 0x67, 0x13, 0x70, 0x9c, 0x97, 0xc6, 0x31, 0x13, 0x3b, 0x38, 0x4e, 0x70, 0xcc, 0x42, 0x82,
-	0x53, 0x8a, 0xb7, 0x24, 0x2d, 0x42, 0x2d, 0xb4, 0x0d, 0x04, 0x55, 0x10, 0x10, 0xa9, 0xc3, 0x1f,
-	0xb5, 0x97, 0xd5, 0xb2, 0x3b, 0x38, 0x43, 0xec, 0x9d, 0x65, 0x67, 0xd7, 0xd4, 0x45, 0x5c, 0xaa,
-	0xaa, 0x52, 0xa5, 0x1e, 0x2a, 0xf5, 0xda, 0x43, 0xd5, 0xaf, 0xd1, 0x2f, 0xd0, 0x4b, 0x25, 0xa4,
-	0x5e, 0x7a, 0xac, 0xa0, 0x1f, 0xa4, 0xda, 0xf9, 0x63, 0xef, 0xae, 0x37, 0x38, 0xea, 0xc9, 0x99,
-	0xf7, 0x7e, 0xef, 0xf7, 0x7e, 0x7e, 0xcf, 0xf3, 0xe6, 0x05, 0x10, 0xeb, 0xd9, 0x46, 0xef, 0x92,
-	0xf1, 0x2c, 0xc4, 0x7e, 0xbf, 0xe9, 0xf9, 0x34, 0xa0, 0x28, 0xc7, 0x7a, 0x76, 0xb3, 0x77, 0xa9,
-	0xba, 0xdc, 0xa6, 0xb4, 0xdd, 0xc1, 0x86, 0xe5, 0x11, 0xc3, 0x72, 0x5d, 0x1a, 0x58, 0x01, 0xa1,
-	0x2e, 0x13, 0xa8, 0x6a, 0x49, 0x46, 0xb6, 0xb1, 0x8b, 0x19, 0x51, 0x56, 0xc5, 0xc7, 0x02, 0x2b,
-	0xc0, 0xc2, 0xa6, 0x97, 0x00, 0x7d, 0x11, 0xd1, 0xef, 0x5a, 0xbe, 0xd5, 0x65, 0x2d, 0xfc, 0x2c,
-	0xc4, 0x2c, 0xd0, 0xaf, 0xc1, 0x7c, 0xc2, 0xca, 0x3c, 0xea, 0x32, 0x8c, 0xd6, 0x20, 0xe7, 0x71,
-	0x4b, 0x45, 0xab, 0x6b, 0x8d, 0x99, 0x8d, 0x42, 0x53, 0xa8, 0x69, 0x4a, 0x9c, 0xf4, 0xea, 0x57,
-	0xa0, 0xc6, 0xc3, 0xb7, 0x69, 0xd7, 0x22, 0xee, 0x43, 0xec, 0x93, 0x27, 0xc4, 0xe6, 0x02, 0x65,
-	0x02, 0xb4, 0x00, 0x39, 0x87, 0x3b, 0x39, 0xd3, 0x74, 0x4b, 0x9e, 0x74, 0x17, 0x56, 0x0e, 0x8d,
-	0x94, 0x22, 0x76, 0x60, 0x5e, 0x80, 0xcd, 0x5e, 0xcc, 0x2d, 0x15, 0x55, 0x95, 0xa2, 0x0c, 0x02,
-	0xe4, 0x8c, 0xd8, 0xf4, 0x0f, 0xe4, 0x17, 0xdd, 0xc3, 0x7e, 0x8f, 0xd8, 0x58, 0xc9, 0x3b, 0x0d,
-	0xc0, 0x84, 0xc5, 0x24, 0x8e, 0x94, 0x38, 0x2d, 0x2d, 0xb7, 0x1c, 0x7d, 0x0b, 0x4a, 0xc9, 0x28,
-	0x29, 0x6d, 0x1d, 0xa6, 0x24, 0x48, 0xca, 0x99, 0x53, 0x72, 0x14, 0x52, 0xf9, 0xf5, 0x4d, 0x58,
-	0x8a, 0x53, 0xb0, 0xeb, 0xfd, 0x7b, 0xcf, 0x5d, 0xec, 0x2b, 0x01, 0x25, 0x38, 0x41, 0xa3, 0xb3,
-	0xcc, 0x2d, 0x0e, 0xfa, 0x0e, 0x2c, 0x67, 0x07, 0xc9, 0xfc, 0x17, 0x20, 0x2f, 0xf9, 0xa3, 0x0e,
-	0x4d, 0x66, 0x09, 0x18, 0x00, 0xf4, 0xcb, 0x23, 0x64, 0xa2, 0x66, 0xe3, 0x5a, 0x74, 0x07, 0x4e,
-	0x1f, 0x12, 0xf7, 0x7f, 0x54, 0x6c, 0x41, 0x3d, 0xce, 0x76, 0xef, 0xd6, 0xf6, 0x8d, 0x6d, 0xc2,
-	0x6c, 0xda, 0xc3, 0x7e, 0xff, 0x88, 0xdd, 0xf8, 0x6d, 0x1a, 0xce, 0xbc, 0x85, 0x43, 0xaa, 0x5a,
-	0x80, 0x1c, 0x61, 0x2c, 0x1c, 0x94, 0x54, 0x9e, 0xd0, 0x87, 0xb0, 0x60, 0x85, 0xc1, 0x3e, 0xf5,
-	0xc9, 0x37, 0xfc, 0x27, 0x61, 0x62, 0xd7, 0xf1, 0x28, 0x71, 0x83, 0xca, 0x04, 0xc7, 0x95, 0x13,
-	0xde, 0x9b, 0xd2, 0x89, 0x56, 0xa1, 0x10, 0xd0, 0x03, 0x1c, 0x83, 0x4f, 0x72, 0xf8, 0x2c, 0xb7,
-	0x0e, 0x60, 0x8b, 0x90, 0x7f, 0xfa, 0xfc, 0x80, 0x99, 0xa1, 0x4f, 0x2a, 0xc7, 0x39, 0x60, 0x2a,
-	0x3a, 0x3f, 0xf0, 0x09, 0xba, 0x00, 0x27, 0x43, 0x86, 0x7d, 0xe2, 0x3e, 0xa1, 0x43, 0x92, 0x13,
-	0x1c, 0x53, 0x54, 0x8e, 0x01, 0xcf, 0x26, 0x94, 0x7d, 0xdc, 0x26, 0x2c, 0xf0, 0x53, 0x22, 0x73,
-	0x3c, 0xa0, 0x14, 0x77, 0x0e, 0x82, 0xd6, 0xa1, 0xc8, 0x6c, 0xea, 0x61, 0x66, 0xb2, 0xd0, 0xf3,
-	0xa8, 0x1f, 0x60, 0xa7, 0x32, 0x55, 0x9f, 0x6c, 0x4c, 0xb7, 0xe6, 0x84, 0x7d, 0x4f, 0x99, 0xd1,
-	0x15, 0xa8, 0xf8, 0xb2, 0x52, 0x66, 0xd0, 0x4f, 0x86, 0xe4, 0x79, 0xc8, 0x82, 0xf2, 0xdf, 0xef,
-	0x27, 0x22, 0x37, 0xa0, 0xdc, 0xf6, 0x2d, 0x37, 0x18, 0x09, 0x9b, 0xe6, 0x61, 0xf3, 0xdc, 0x99,
-	0x8a, 0xb9, 0x07, 0xab, 0xc4, 0x31, 0x45, 0xfd, 0x18, 0x69, 0xbb, 0xc4, 0x6d, 0x9b, 0x56, 0xa7,
-	0x6d, 0xf6, 0xac, 0x4e, 0x98, 0xe0, 0x00, 0xce, 0x51, 0x27, 0xce, 0xfd, 0x08, 0xbb, 0x27, 0xa0,
-	0x5b, 0x9d, 0xf6, 0x43, 0x0e, 0x1c, 0x12, 0x5e, 0x86, 0x53, 0x2c, 0x7c, 0xfc, 0x14, 0xdb, 0xa3,
-	0x32, 0x66, 0x38, 0x45, 0x59, 0xba, 0x53, 0x42, 0x76, 0x61, 0x35, 0xd9, 0x45, 0x33, 0xea, 0xb6,
-	0xd9, 0xc5, 0xc1, 0x3e, 0x75, 0xe2, 0x2c, 0xef, 0x70, 0x96, 0x33, 0x89, 0xe6, 0x6e, 0x85, 0xc1,
-	0xfe, 0x5d, 0x81, 0x1c, 0x32, 0xae, 0x43, 0xd1, 0xee, 0x58, 0xa4, 0x1b, 0x0f, 0x9e, 0x15, 0x35,
-	0x17, 0xf6, 0xec, 0x9a, 0x77, 0xa9, 0x93, 0x50, 0x5d, 0x48, 0xd6, 0xfc, 0x6e, 0xe4, 0x1e, 0x46,
-	0x6e, 0x42, 0x59, 0x5d, 0x08, 0x87, 0xda, 0x61, 0x17, 0xbb, 0x62, 0xfc, 0x57, 0xe6, 0xc4, 0xaf,
-	0x41, 0x3a, 0xb7, 0xe3, 0x3e, 0xf4, 0x3e, 0x94, 0x42, 0x62, 0x76, 0xa8, 0x6d, 0x75, 0x12, 0xa9,
-	0x8a, 0x3c, 0x15, 0x0a, 0xc9, 0x1d, 0xe1, 0x4a, 0x08, 0x94, 0xdf, 0x65, 0x34, 0xea, 0xa4, 0x10,
-	0x28, 0xfc, 0x23, 0x91, 0x9f, 0xc0, 0x92, 0x2f, 0x2e, 0xaf, 0xc9, 0x9f, 0x04, 0x1c, 0x60, 0x3f,
-	0x16, 0x8c, 0xea, 0x5a, 0x23, 0xdf, 0x5a, 0x94, 0x90, 0x5d, 0x85, 0x18, 0xc6, 0xdf, 0x84, 0x15,
-	0x15, 0x1f, 0xfa, 0x24, 0x93, 0x63, 0x9e, 0x73, 0x2c, 0x4b, 0xd8, 0x03, 0x9f, 0x64, 0xd0, 0x7c,
-	0x0e, 0xf5, 0xc8, 0x4f, 0x7c, 0x6c, 0xc6, 0xe9, 0xe2, 0x97, 0xa5, 0x52, 0xe2, 0x3c, 0xa7, 0x25,
-	0xae, 0x35, 0xa0, 0x6b, 0xc5, 0x40, 0x48, 0x87, 0x59, 0xea, 0x99, 0x1e, 0xed, 0x10, 0xbb, 0xcf,
-	0xef, 0x72, 0x99, 0x17, 0x7a, 0x86, 0x7a, 0xbb, 0xdc, 0x16, 0xdd, 0xe7, 0x65, 0x00, 0xea, 0x99,
-	0x01, 0x15, 0x97, 0x7d, 0x81, 0x03, 0xf2, 0xd4, 0xbb, 0x4f, 0xa3, 0xdb, 0xae, 0x5f, 0x4d, 0xce,
-	0xfb, 0x68, 0x46, 0xdd, 0x7e, 0xb4, 0xb3, 0x77, 0xc4, 0x11, 0xf7, 0x69, 0x72, 0x56, 0x0f, 0xa3,
-	0xe5, 0x70, 0x5b, 0x81, 0xe3, 0x07, 0xb8, 0xaf, 0xc6, 0xed, 0x8c, 0x1a, 0xb7, 0xb7, 0x1f, 0xed,
-	0xb4, 0xb8, 0x43, 0xff, 0x4c, 0xbe, 0xab, 0x31, 0x82, 0xbb, 0x38, 0xb0, 0x1c, 0x2b, 0xb0, 0x8e,
-	0x28, 0xe1, 0xcf, 0x89, 0xd1, 0x49, 0x3d, 0xa4, 0x90, 0x3a, 0x2e, 0x41, 0xce, 0xa6, 0xee, 0x13,
-	0xd2, 0x96, 0xef, 0xdf, 0x62, 0x6a, 0xf0, 0x47, 0x41, 0x37, 0x38, 0xa0, 0x25, 0x81, 0xe8, 0x3c,
-	0xcc, 0x89, 0x77, 0x1c, 0x3b, 0xa6, 0x7c, 0x6f, 0xc4, 0xe0, 0x2d, 0x28, 0xb3, 0x78, 0x5e, 0xd0,
-	0x55, 0x28, 0x28, 0x7d, 0xd1, 0x02, 0x13, 0x32, 0x3e, 0x71, 0x0b, 0x1b, 0xe5, 0x54, 0x8e, 0x3d,
-	0xee, 0x6c, 0xcd, 0xb2, 0xf8, 0x11, 0xb5, 0x20, 0xdf, 0x95, 0x6a, 0x2b, 0xc7, 0x79, 0x95, 0x2e,
-	0xab, 0xb8, 0x71, 0xdf, 0xaa, 0xa9, 0x0c, 0x37, 0xdd, 0xc0, 0xef, 0xb7, 0x06, 0x3c, 0xd5, 0x8f,
-	0x61, 0x36, 0xe1, 0x42, 0x45, 0x98, 0x3c, 0xc0, 0x7d, 0x59, 0xbb, 0xe8, 0xcf, 0xe8, 0x1d, 0xe7,
-	0x43, 0x4d, 0x7e, 0x27, 0x71, 0xf8, 0x68, 0xe2, 0x8a, 0xb6, 0xf1, 0x7b, 0x1e, 0x4e, 0xf0, 0xcc,
-	0xe8, 0x4b, 0xc8, 0x89, 0xfd, 0x09, 0x55, 0x13, 0x92, 0x12, 0x2b, 0x59, 0x75, 0x29, 0xd3, 0x27,
-	0x14, 0xea, 0x0b, 0xdf, 0xfe, 0xf5, 0xef, 0xcf, 0x13, 0x45, 0x54, 0x30, 0xe4, 0x8a, 0x27, 0x16,
-	0x31, 0xf4, 0xbd, 0x06, 0x68, 0x74, 0x13, 0x42, 0x6b, 0x09, 0xae, 0x43, 0xb7, 0xb4, 0xea, 0xf9,
-	0xb1, 0x38, 0x99, 0x7f, 0x85, 0xe7, 0x5f, 0x44, 0xa7, 0x54, 0x7e, 0xd1, 0x49, 0xe3, 0x85, 0xf8,
-	0x7c, 0x89, 0x9e, 0xc2, 0x94, 0xac, 0x30, 0x5a, 0xca, 0xaa, 0xbb, 0xca, 0xb8, 0x9c, 0xed, 0x94,
-	0x69, 0xce, 0xf1, 0x34, 0x35, 0xb4, 0xac, 0xd2, 0xc8, 0x1e, 0x1b, 0x2f, 0x86, 0xbf, 0xdc, 0x97,
-	0xe8, 0x3b, 0x0d, 0xe6, 0x52, 0x1b, 0x12, 0x3a, 0x9b, 0xc5, 0x9b, 0x5a, 0xba, 0xaa, 0xe7, 0xde,
-	0x0e, 0x92, 0x22, 0xd6, 0xb8, 0x88, 0x3a, 0xaa, 0xa5, 0x44, 0x30, 0x83, 0x2f, 0x69, 0xc6, 0x0b,
-	0xfe, 0xf1, 0x12, 0xfd, 0xa0, 0x41, 0x31, 0xbd, 0x23, 0xa1, 0xc3, 0x52, 0x24, 0x56, 0xaf, 0xea,
-	0xea, 0x18, 0x94, 0x54, 0xd2, 0xe0, 0x4a, 0x74, 0x54, 0x1f, 0x51, 0x92, 0x2e, 0xff, 0xaf, 0x1a,
-	0x94, 0xb2, 0xb6, 0x23, 0xd4, 0x38, 0xec, 0x12, 0xa4, 0x97, 0xb0, 0xea, 0xfa, 0x11, 0x90, 0x52,
-	0xd7, 0x26, 0xd7, 0x75, 0x11, 0x5d, 0x78, 0x5b, 0x9b, 0x0c, 0x4a, 0x1c, 0xdb, 0x70, 0x06, 0x4a,
-	0x7e, 0x1c, 0x76, 0x4d, 0x8d, 0xb7, 0xec, 0xae, 0xa5, 0x46, 0x67, 0x76, 0xd7, 0xd2, 0x13, 0x52,
-	0x6f, 0x72, 0x4d, 0x0d, 0xb4, 0x36, 0x5e, 0x53, 0xb4, 0xa0, 0xa1, 0x5f, 0x34, 0x98, 0xcf, 0x98,
-	0x09, 0xe8, 0xfc, 0xf8, 0xa9, 0x21, 0x64, 0x35, 0x8e, 0x3a, 0x5e, 0xf4, 0x0d, 0x2e, 0xed, 0x3d,
-	0xf4, 0xee, 0x78, 0x69, 0x6a, 0xf4, 0x5c, 0xbf, 0xf6, 0xc7, 0xeb, 0x9a, 0xf6, 0xea, 0x75, 0x4d,
-	0xfb, 0xe7, 0x75, 0x4d, 0xfb, 0xe9, 0x4d, 0xed, 0xd8, 0xab, 0x37, 0xb5, 0x63, 0x7f, 0xbf, 0xa9,
-	0x1d, 0xfb, 0xea, 0x6c, 0x9b, 0x04, 0xfb, 0xe1, 0xe3, 0xa6, 0x4d, 0xbb, 0x06, 0xa3, 0xae, 0x7f,
-	0x91, 0x50, 0xfe, 0x69, 0x7c, 0xcd, 0xe9, 0xf9, 0x7a, 0xf4, 0x38, 0xc7, 0xff, 0xf9, 0xdb, 0xfc,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0xff, 0x40, 0x87, 0xa3, 0x62, 0x0e, 0x00, 0x00,
+func (m *QueryFeeSponsorshipRequest) Reset()         { *m = QueryFeeSponsorshipRequest{} }
+func (m *QueryFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DomainVerification queries domain verification status by domain name.
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "svc_docs.md"}}
-	DomainVerification(ctx context.Context, in *QueryDomainVerificationRequest, opts ...grpc.CallOption) (*QueryDomainVerificationResponse, error)
-	// Service queries service information by service ID.
-	Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error)
-	// ServicesByOwner queries all services owned by a specific address.
-	ServicesByOwner(ctx context.Context, in *QueryServicesByOwnerRequest, opts ...grpc.CallOption) (*QueryServicesByOwnerResponse, error)
-	// ServicesByDomain queries services bound to a specific domain.
-	ServicesByDomain(ctx context.Context, in *QueryServicesByDomainRequest, opts ...grpc.CallOption) (*QueryServicesByDomainResponse, error)
-	// ServiceOIDCDiscovery queries OIDC discovery configuration for a service
-	ServiceOIDCDiscovery(ctx context.Context, in *QueryServiceOIDCDiscoveryRequest, opts ...grpc.CallOption) (*QueryServiceOIDCDiscoveryResponse, error)
-	// ServiceOIDCJWKS queries OIDC JWKS for a service
-	ServiceOIDCJWKS(ctx context.Context, in *QueryServiceOIDCJWKSRequest, opts ...grpc.CallOption) (*QueryServiceOIDCJWKSResponse, error)
-	// ServiceOIDCMetadata queries OIDC metadata for a service
-	ServiceOIDCMetadata(ctx context.Context, in *QueryServiceOIDCMetadataRequest, opts ...grpc.CallOption) (*QueryServiceOIDCMetadataResponse, error)
+func (m *QueryFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSponsorshipRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DomainVerification(ctx context.Context, in *QueryDomainVerificationRequest, opts ...grpc.CallOption) (*QueryDomainVerificationResponse, error) {
-	out := new(QueryDomainVerificationResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/DomainVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFeeSponsorshipResponse is the response type for the
+// Query/FeeSponsorship RPC method.
+type QueryFeeSponsorshipResponse struct {
+	Sponsorship *FeeSponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship,omitempty"`
+	// Budget left to spend
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
 }
 
-func (c *queryClient) Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error) {
-	out := new(QueryServiceResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/Service", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipResponse) Reset()         { *m = QueryFeeSponsorshipResponse{} }
+func (m *QueryFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{17}
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipResponse.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ServicesByOwner(ctx context.Context, in *QueryServicesByOwnerRequest, opts ...grpc.CallOption) (*QueryServicesByOwnerResponse, error) {
-	out := new(QueryServicesByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServicesByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSponsorshipResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipResponse) GetSponsorship() *FeeSponsorship {
+	if m != nil {
+		return m.Sponsorship
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ServicesByDomain(ctx context.Context, in *QueryServicesByDomainRequest, opts ...grpc.CallOption) (*QueryServicesByDomainResponse, error) {
-	out := new(QueryServicesByDomainResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServicesByDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ServiceOIDCDiscovery(ctx context.Context, in *QueryServiceOIDCDiscoveryRequest, opts ...grpc.CallOption) (*QueryServiceOIDCDiscoveryResponse, error) {
-	out := new(QueryServiceOIDCDiscoveryResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServiceOIDCDiscovery", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFeeSponsorUsageRequest is the request type for the
+// Query/FeeSponsorUsage RPC method.
+type QueryFeeSponsorUsageRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ServiceOIDCJWKS(ctx context.Context, in *QueryServiceOIDCJWKSRequest, opts ...grpc.CallOption) (*QueryServiceOIDCJWKSResponse, error) {
-	out := new(QueryServiceOIDCJWKSResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServiceOIDCJWKS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorUsageRequest) Reset()         { *m = QueryFeeSponsorUsageRequest{} }
+func (m *QueryFeeSponsorUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorUsageRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{18}
+}
+func (m *QueryFeeSponsorUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeSponsorUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorUsageRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorUsageRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ServiceOIDCMetadata(ctx context.Context, in *QueryServiceOIDCMetadataRequest, opts ...grpc.CallOption) (*QueryServiceOIDCMetadataResponse, error) {
-	out := new(QueryServiceOIDCMetadataResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServiceOIDCMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSponsorUsageRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorUsageRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DomainVerification queries domain verification status by domain name.
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "svc_docs.md"}}
-	DomainVerification(context.Context, *QueryDomainVerificationRequest) (*QueryDomainVerificationResponse, error)
-	// Service queries service information by service ID.
-	Service(context.Context, *QueryServiceRequest) (*QueryServiceResponse, error)
-	// ServicesByOwner queries all services owned by a specific address.
-	ServicesByOwner(context.Context, *QueryServicesByOwnerRequest) (*QueryServicesByOwnerResponse, error)
-	// ServicesByDomain queries services bound to a specific domain.
-	ServicesByDomain(context.Context, *QueryServicesByDomainRequest) (*QueryServicesByDomainResponse, error)
-	// ServiceOIDCDiscovery queries OIDC discovery configuration for a service
-	ServiceOIDCDiscovery(context.Context, *QueryServiceOIDCDiscoveryRequest) (*QueryServiceOIDCDiscoveryResponse, error)
-	// ServiceOIDCJWKS queries OIDC JWKS for a service
-	ServiceOIDCJWKS(context.Context, *QueryServiceOIDCJWKSRequest) (*QueryServiceOIDCJWKSResponse, error)
-	// ServiceOIDCMetadata queries OIDC metadata for a service
-	ServiceOIDCMetadata(context.Context, *QueryServiceOIDCMetadataRequest) (*QueryServiceOIDCMetadataResponse, error)
+func (m *QueryFeeSponsorUsageRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryFeeSponsorUsageResponse is the response type for the
+// Query/FeeSponsorUsage RPC method.
+type QueryFeeSponsorUsageResponse struct {
+	Usage []*FeeSponsorUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFeeSponsorUsageResponse) Reset()         { *m = QueryFeeSponsorUsageResponse{} }
+func (m *QueryFeeSponsorUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorUsageResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{19}
 }
-func (*UnimplementedQueryServer) DomainVerification(ctx context.Context, req *QueryDomainVerificationRequest) (*QueryDomainVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DomainVerification not implemented")
-}
-func (*UnimplementedQueryServer) Service(ctx context.Context, req *QueryServiceRequest) (*QueryServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Service not implemented")
+func (m *QueryFeeSponsorUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ServicesByOwner(ctx context.Context, req *QueryServicesByOwnerRequest) (*QueryServicesByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServicesByOwner not implemented")
+func (m *QueryFeeSponsorUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ServicesByDomain(ctx context.Context, req *QueryServicesByDomainRequest) (*QueryServicesByDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServicesByDomain not implemented")
+func (m *QueryFeeSponsorUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorUsageResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ServiceOIDCDiscovery(ctx context.Context, req *QueryServiceOIDCDiscoveryRequest) (*QueryServiceOIDCDiscoveryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCDiscovery not implemented")
+func (m *QueryFeeSponsorUsageResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ServiceOIDCJWKS(ctx context.Context, req *QueryServiceOIDCJWKSRequest) (*QueryServiceOIDCJWKSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCJWKS not implemented")
+func (m *QueryFeeSponsorUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorUsageResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ServiceOIDCMetadata(ctx context.Context, req *QueryServiceOIDCMetadataRequest) (*QueryServiceOIDCMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCMetadata not implemented")
+
+var xxx_messageInfo_QueryFeeSponsorUsageResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorUsageResponse) GetUsage() []*FeeSponsorUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryFeeSponsorUsageResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryExportCredentialsRequest is the request type for the
+// Query/ExportCredentials RPC method.
+type QueryExportCredentialsRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func _Query_DomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDomainVerificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DomainVerification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/DomainVerification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DomainVerification(ctx, req.(*QueryDomainVerificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryExportCredentialsRequest) Reset()         { *m = QueryExportCredentialsRequest{} }
+func (m *QueryExportCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExportCredentialsRequest) ProtoMessage()    {}
+func (*QueryExportCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{20}
 }
-
-func _Query_Service_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Service(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/Service",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Service(ctx, req.(*QueryServiceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryExportCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_ServicesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServicesByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServicesByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/ServicesByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServicesByOwner(ctx, req.(*QueryServicesByOwnerRequest))
+func (m *QueryExportCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryExportCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportCredentialsRequest.Merge(m, src)
+}
+func (m *QueryExportCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportCredentialsRequest.DiscardUnknown(m)
 }
 
-func _Query_ServicesByDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServicesByDomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServicesByDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/ServicesByDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServicesByDomain(ctx, req.(*QueryServicesByDomainRequest))
+var xxx_messageInfo_QueryExportCredentialsRequest proto.InternalMessageInfo
+
+func (m *QueryExportCredentialsRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ServiceOIDCDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceOIDCDiscoveryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceOIDCDiscovery(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/ServiceOIDCDiscovery",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceOIDCDiscovery(ctx, req.(*QueryServiceOIDCDiscoveryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryExportCredentialsResponse is the response type for the
+// Query/ExportCredentials RPC method.
+type QueryExportCredentialsResponse struct {
+	Export CredentialExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export"`
 }
 
-func _Query_ServiceOIDCJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceOIDCJWKSRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceOIDCJWKS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/ServiceOIDCJWKS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceOIDCJWKS(ctx, req.(*QueryServiceOIDCJWKSRequest))
+func (m *QueryExportCredentialsResponse) Reset()         { *m = QueryExportCredentialsResponse{} }
+func (m *QueryExportCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExportCredentialsResponse) ProtoMessage()    {}
+func (*QueryExportCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{21}
+}
+func (m *QueryExportCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryExportCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportCredentialsResponse.Merge(m, src)
+}
+func (m *QueryExportCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportCredentialsResponse.DiscardUnknown(m)
 }
 
-func _Query_ServiceOIDCMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceOIDCMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceOIDCMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Query/ServiceOIDCMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceOIDCMetadata(ctx, req.(*QueryServiceOIDCMetadataRequest))
+var xxx_messageInfo_QueryExportCredentialsResponse proto.InternalMessageInfo
+
+func (m *QueryExportCredentialsResponse) GetExport() CredentialExport {
+	if m != nil {
+		return m.Export
 	}
-	return interceptor(ctx, in, info, handler)
+	return CredentialExport{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "svc.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DomainVerification",
-			Handler:    _Query_DomainVerification_Handler,
-		},
-		{
-			MethodName: "Service",
-			Handler:    _Query_Service_Handler,
-		},
-		{
-			MethodName: "ServicesByOwner",
-			Handler:    _Query_ServicesByOwner_Handler,
-		},
-		{
-			MethodName: "ServicesByDomain",
-			Handler:    _Query_ServicesByDomain_Handler,
-		},
-		{
-			MethodName: "ServiceOIDCDiscovery",
-			Handler:    _Query_ServiceOIDCDiscovery_Handler,
-		},
-		{
-			MethodName: "ServiceOIDCJWKS",
-			Handler:    _Query_ServiceOIDCJWKS_Handler,
-		},
-		{
-			MethodName: "ServiceOIDCMetadata",
-			Handler:    _Query_ServiceOIDCMetadata_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "svc/v1/query.proto",
+// QueryImportedCredentialsRequest is the request type for the
+// Query/ImportedCredentials RPC method.
+type QueryImportedCredentialsRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryImportedCredentialsRequest) Reset()         { *m = QueryImportedCredentialsRequest{} }
+func (m *QueryImportedCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryImportedCredentialsRequest) ProtoMessage()    {}
+func (*QueryImportedCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{22}
+}
+func (m *QueryImportedCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryImportedCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryImportedCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryImportedCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryImportedCredentialsRequest.Merge(m, src)
+}
+func (m *QueryImportedCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryImportedCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryImportedCredentialsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryImportedCredentialsRequest proto.InternalMessageInfo
+
+func (m *QueryImportedCredentialsRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryImportedCredentialsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryImportedCredentialsResponse is the response type for the
+// Query/ImportedCredentials RPC method.
+type QueryImportedCredentialsResponse struct {
+	Credentials []*ImportedCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryImportedCredentialsResponse) Reset()         { *m = QueryImportedCredentialsResponse{} }
+func (m *QueryImportedCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryImportedCredentialsResponse) ProtoMessage()    {}
+func (*QueryImportedCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{23}
+}
+func (m *QueryImportedCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryImportedCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryImportedCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDomainVerificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryImportedCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryImportedCredentialsResponse.Merge(m, src)
 }
-
-func (m *QueryDomainVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryImportedCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryImportedCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryImportedCredentialsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDomainVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryImportedCredentialsResponse proto.InternalMessageInfo
+
+func (m *QueryImportedCredentialsResponse) GetCredentials() []*ImportedCredential {
+	if m != nil {
+		return m.Credentials
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryImportedCredentialsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBadgesRequest is the request type for the Query/Badges RPC method.
+type QueryBadgesRequest struct {
+	// Service ID or DID
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Also list revoked and expired badges
+	IncludeInactive bool `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
 }
 
-func (m *QueryDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DomainVerification != nil {
-		{
-			size, err := m.DomainVerification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBadgesRequest) Reset()         { *m = QueryBadgesRequest{} }
+func (m *QueryBadgesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBadgesRequest) ProtoMessage()    {}
+func (*QueryBadgesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{24}
+}
+func (m *QueryBadgesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBadgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadgesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryServiceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBadgesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadgesRequest.Merge(m, src)
 }
-
-func (m *QueryServiceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBadgesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBadgesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadgesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryBadgesRequest proto.InternalMessageInfo
+
+func (m *QueryBadgesRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBadgesRequest) GetIncludeInactive() bool {
+	if m != nil {
+		return m.IncludeInactive
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BadgeInfo is a badge with its current validity
+type BadgeInfo struct {
+	Badge Badge `protobuf:"bytes,1,opt,name=badge,proto3" json:"badge"`
+	// Whether the badge is neither revoked nor expired
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (m *QueryServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BadgeInfo) Reset()         { *m = BadgeInfo{} }
+func (m *BadgeInfo) String() string { return proto.CompactTextString(m) }
+func (*BadgeInfo) ProtoMessage()    {}
+func (*BadgeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{25}
+}
+func (m *BadgeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadgeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryServicesByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BadgeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadgeInfo.Merge(m, src)
 }
-
-func (m *QueryServicesByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BadgeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadgeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadgeInfo.DiscardUnknown(m)
 }
 
-func (m *QueryServicesByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_BadgeInfo proto.InternalMessageInfo
+
+func (m *BadgeInfo) GetBadge() Badge {
+	if m != nil {
+		return m.Badge
 	}
-	return len(dAtA) - i, nil
+	return Badge{}
 }
 
-func (m *QueryServicesByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BadgeInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryServicesByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBadgesResponse is the response type for the Query/Badges RPC method.
+type QueryBadgesResponse struct {
+	Badges []BadgeInfo `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges"`
 }
 
-func (m *QueryServicesByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBadgesResponse) Reset()         { *m = QueryBadgesResponse{} }
+func (m *QueryBadgesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBadgesResponse) ProtoMessage()    {}
+func (*QueryBadgesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{26}
+}
+func (m *QueryBadgesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBadgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadgesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBadgesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadgesResponse.Merge(m, src)
+}
+func (m *QueryBadgesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBadgesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadgesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServicesByDomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBadgesResponse proto.InternalMessageInfo
+
+func (m *QueryBadgesResponse) GetBadges() []BadgeInfo {
+	if m != nil {
+		return m.Badges
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryServicesByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBadgeIssuersRequest is the request type for the Query/BadgeIssuers RPC
+// method.
+type QueryBadgeIssuersRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServicesByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryBadgeIssuersRequest) Reset()         { *m = QueryBadgeIssuersRequest{} }
+func (m *QueryBadgeIssuersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBadgeIssuersRequest) ProtoMessage()    {}
+func (*QueryBadgeIssuersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{27}
 }
-
-func (m *QueryServicesByDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBadgeIssuersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBadgeIssuersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadgeIssuersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryBadgeIssuersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadgeIssuersRequest.Merge(m, src)
+}
+func (m *QueryBadgeIssuersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBadgeIssuersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadgeIssuersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryServicesByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBadgeIssuersRequest proto.InternalMessageInfo
+
+func (m *QueryBadgeIssuersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryServicesByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryBadgeIssuersResponse is the response type for the Query/BadgeIssuers
+// RPC method.
+type QueryBadgeIssuersResponse struct {
+	Issuers []BadgeIssuer `protobuf:"bytes,1,rep,name=issuers,proto3" json:"issuers"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBadgeIssuersResponse) Reset()         { *m = QueryBadgeIssuersResponse{} }
+func (m *QueryBadgeIssuersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBadgeIssuersResponse) ProtoMessage()    {}
+func (*QueryBadgeIssuersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{28}
+}
+func (m *QueryBadgeIssuersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBadgeIssuersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBadgeIssuersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBadgeIssuersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadgeIssuersResponse.Merge(m, src)
+}
+func (m *QueryBadgeIssuersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBadgeIssuersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadgeIssuersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCDiscoveryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBadgeIssuersResponse proto.InternalMessageInfo
+
+func (m *QueryBadgeIssuersResponse) GetIssuers() []BadgeIssuer {
+	if m != nil {
+		return m.Issuers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryServiceOIDCDiscoveryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBadgeIssuersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryServiceOIDCDiscoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0xa
+// QueryAppManifestRequest is the request type for the Query/AppManifest RPC
+// method.
+type QueryAppManifestRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (m *QueryAppManifestRequest) Reset()         { *m = QueryAppManifestRequest{} }
+func (m *QueryAppManifestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAppManifestRequest) ProtoMessage()    {}
+func (*QueryAppManifestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{29}
+}
+func (m *QueryAppManifestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppManifestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppManifestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAppManifestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppManifestRequest.Merge(m, src)
+}
+func (m *QueryAppManifestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppManifestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppManifestRequest.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCDiscoveryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAppManifestRequest proto.InternalMessageInfo
+
+func (m *QueryAppManifestRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryServiceOIDCDiscoveryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAppManifestResponse is the response type for the Query/AppManifest RPC
+// method.
+type QueryAppManifestResponse struct {
+	Manifest AppManifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest"`
+	// Domain of the service, which the manifest's links were checked against
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Whether the service is active and its domain verification is current.
+	// Wallets should not trust a manifest for which this is false.
+	Verified bool `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
-func (m *QueryServiceOIDCDiscoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OpTosUri) > 0 {
-		i -= len(m.OpTosUri)
-		copy(dAtA[i:], m.OpTosUri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OpTosUri)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
-	}
-	if len(m.OpPolicyUri) > 0 {
-		i -= len(m.OpPolicyUri)
-		copy(dAtA[i:], m.OpPolicyUri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OpPolicyUri)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
-	}
-	if m.RequireRequestUriRegistration {
-		i--
-		if m.RequireRequestUriRegistration {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryAppManifestResponse) Reset()         { *m = QueryAppManifestResponse{} }
+func (m *QueryAppManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppManifestResponse) ProtoMessage()    {}
+func (*QueryAppManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{30}
+}
+func (m *QueryAppManifestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppManifestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+		return b[:n], nil
 	}
-	if m.RequestUriParameterSupported {
-		i--
-		if m.RequestUriParameterSupported {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+}
+func (m *QueryAppManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppManifestResponse.Merge(m, src)
+}
+func (m *QueryAppManifestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAppManifestResponse proto.InternalMessageInfo
+
+func (m *QueryAppManifestResponse) GetManifest() AppManifest {
+	if m != nil {
+		return m.Manifest
 	}
-	if m.RequestParameterSupported {
-		i--
-		if m.RequestParameterSupported {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return AppManifest{}
+}
+
+func (m *QueryAppManifestResponse) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if len(m.ClaimsLocalesSupported) > 0 {
-		for iNdEx := len(m.ClaimsLocalesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ClaimsLocalesSupported[iNdEx])
-			copy(dAtA[i:], m.ClaimsLocalesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimsLocalesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	return ""
+}
+
+func (m *QueryAppManifestResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	if len(m.UiLocalesSupported) > 0 {
-		for iNdEx := len(m.UiLocalesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UiLocalesSupported[iNdEx])
-			copy(dAtA[i:], m.UiLocalesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.UiLocalesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
+	return false
+}
+
+// QueryOIDCClientRequest is the request type for the Query/OIDCClient RPC
+// method.
+type QueryOIDCClientRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryOIDCClientRequest) Reset()         { *m = QueryOIDCClientRequest{} }
+func (m *QueryOIDCClientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOIDCClientRequest) ProtoMessage()    {}
+func (*QueryOIDCClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{31}
+}
+func (m *QueryOIDCClientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOIDCClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOIDCClientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ServiceDocumentation) > 0 {
-		i -= len(m.ServiceDocumentation)
-		copy(dAtA[i:], m.ServiceDocumentation)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceDocumentation)))
-		i--
-		dAtA[i] = 0x7a
+}
+func (m *QueryOIDCClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOIDCClientRequest.Merge(m, src)
+}
+func (m *QueryOIDCClientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOIDCClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOIDCClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOIDCClientRequest proto.InternalMessageInfo
+
+func (m *QueryOIDCClientRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	if len(m.ResponseModesSupported) > 0 {
-		for iNdEx := len(m.ResponseModesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ResponseModesSupported[iNdEx])
-			copy(dAtA[i:], m.ResponseModesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ResponseModesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if len(m.ClaimsSupported) > 0 {
-		for iNdEx := len(m.ClaimsSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ClaimsSupported[iNdEx])
-			copy(dAtA[i:], m.ClaimsSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimsSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.TokenEndpointAuthMethodsSupported) > 0 {
-		for iNdEx := len(m.TokenEndpointAuthMethodsSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TokenEndpointAuthMethodsSupported[iNdEx])
-			copy(dAtA[i:], m.TokenEndpointAuthMethodsSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenEndpointAuthMethodsSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.SubjectTypesSupported) > 0 {
-		for iNdEx := len(m.SubjectTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SubjectTypesSupported[iNdEx])
-			copy(dAtA[i:], m.SubjectTypesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SubjectTypesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if len(m.IdTokenSigningAlgValuesSupported) > 0 {
-		for iNdEx := len(m.IdTokenSigningAlgValuesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.IdTokenSigningAlgValuesSupported[iNdEx])
-			copy(dAtA[i:], m.IdTokenSigningAlgValuesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.IdTokenSigningAlgValuesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.GrantTypesSupported) > 0 {
-		for iNdEx := len(m.GrantTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GrantTypesSupported[iNdEx])
-			copy(dAtA[i:], m.GrantTypesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GrantTypesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.ResponseTypesSupported) > 0 {
-		for iNdEx := len(m.ResponseTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ResponseTypesSupported[iNdEx])
-			copy(dAtA[i:], m.ResponseTypesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ResponseTypesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.ScopesSupported) > 0 {
-		for iNdEx := len(m.ScopesSupported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopesSupported[iNdEx])
-			copy(dAtA[i:], m.ScopesSupported[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopesSupported[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.RegistrationEndpoint) > 0 {
-		i -= len(m.RegistrationEndpoint)
-		copy(dAtA[i:], m.RegistrationEndpoint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RegistrationEndpoint)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.UserinfoEndpoint) > 0 {
-		i -= len(m.UserinfoEndpoint)
-		copy(dAtA[i:], m.UserinfoEndpoint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserinfoEndpoint)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.JwksUri) > 0 {
-		i -= len(m.JwksUri)
-		copy(dAtA[i:], m.JwksUri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.JwksUri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenEndpoint) > 0 {
-		i -= len(m.TokenEndpoint)
-		copy(dAtA[i:], m.TokenEndpoint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenEndpoint)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AuthorizationEndpoint) > 0 {
-		i -= len(m.AuthorizationEndpoint)
-		copy(dAtA[i:], m.AuthorizationEndpoint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthorizationEndpoint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryServiceOIDCJWKSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryOIDCClientResponse is the response type for the Query/OIDCClient RPC
+// method.
+type QueryOIDCClientResponse struct {
+	Client OIDCClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client"`
+	// Domain of the service, which the client's URIs were checked against
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Whether the service is active and its domain verification is current.
+	// Authorization servers must refuse clients for which this is false.
+	Verified bool `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (m *QueryOIDCClientResponse) Reset()         { *m = QueryOIDCClientResponse{} }
+func (m *QueryOIDCClientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOIDCClientResponse) ProtoMessage()    {}
+func (*QueryOIDCClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{32}
+}
+func (m *QueryOIDCClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOIDCClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOIDCClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryOIDCClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOIDCClientResponse.Merge(m, src)
+}
+func (m *QueryOIDCClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOIDCClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOIDCClientResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCJWKSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryOIDCClientResponse proto.InternalMessageInfo
+
+func (m *QueryOIDCClientResponse) GetClient() OIDCClient {
+	if m != nil {
+		return m.Client
+	}
+	return OIDCClient{}
 }
 
-func (m *QueryServiceOIDCJWKSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOIDCClientResponse) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryServiceOIDCJWKSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOIDCClientResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryServiceOIDCJWKSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOIDCClientsRequest is the request type for the Query/OIDCClients RPC
+// method.
+type QueryOIDCClientsRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (m *QueryServiceOIDCJWKSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOIDCClientsRequest) Reset()         { *m = QueryOIDCClientsRequest{} }
+func (m *QueryOIDCClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOIDCClientsRequest) ProtoMessage()    {}
+func (*QueryOIDCClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{33}
+}
+func (m *QueryOIDCClientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOIDCClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOIDCClientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOIDCClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOIDCClientsRequest.Merge(m, src)
+}
+func (m *QueryOIDCClientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOIDCClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOIDCClientsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOIDCClientsRequest proto.InternalMessageInfo
+
+func (m *QueryOIDCClientsRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryServiceOIDCMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOIDCClientsResponse is the response type for the Query/OIDCClients RPC
+// method.
+type QueryOIDCClientsResponse struct {
+	Clients []OIDCClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients"`
 }
 
-func (m *QueryServiceOIDCMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOIDCClientsResponse) Reset()         { *m = QueryOIDCClientsResponse{} }
+func (m *QueryOIDCClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOIDCClientsResponse) ProtoMessage()    {}
+func (*QueryOIDCClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{34}
+}
+func (m *QueryOIDCClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOIDCClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOIDCClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOIDCClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOIDCClientsResponse.Merge(m, src)
+}
+func (m *QueryOIDCClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOIDCClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOIDCClientsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOIDCClientsResponse proto.InternalMessageInfo
+
+func (m *QueryOIDCClientsResponse) GetClients() []OIDCClient {
+	if m != nil {
+		return m.Clients
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryServiceOIDCMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAttestationPolicyRequest is the request type for the
+// Query/AttestationPolicy RPC method.
+type QueryAttestationPolicyRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (m *QueryServiceOIDCMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		for k := range m.Metadata {
-			v := m.Metadata[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ServiceStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceStatus))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VerifiedDomain) > 0 {
-		i -= len(m.VerifiedDomain)
-		copy(dAtA[i:], m.VerifiedDomain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerifiedDomain)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAttestationPolicyRequest) Reset()         { *m = QueryAttestationPolicyRequest{} }
+func (m *QueryAttestationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationPolicyRequest) ProtoMessage()    {}
+func (*QueryAttestationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{35}
+}
+func (m *QueryAttestationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAttestationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationPolicyRequest.Merge(m, src)
+}
+func (m *QueryAttestationPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationPolicyRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAttestationPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationPolicyRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryAttestationPolicyResponse is the response type for the
+// Query/AttestationPolicy RPC method.
+type QueryAttestationPolicyResponse struct {
+	Policy AttestationPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAttestationPolicyResponse) Reset()         { *m = QueryAttestationPolicyResponse{} }
+func (m *QueryAttestationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationPolicyResponse) ProtoMessage()    {}
+func (*QueryAttestationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{36}
+}
+func (m *QueryAttestationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAttestationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationPolicyResponse.Merge(m, src)
+}
+func (m *QueryAttestationPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDomainVerificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAttestationPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationPolicyResponse) GetPolicy() AttestationPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return n
+	return AttestationPolicy{}
 }
 
-func (m *QueryDomainVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DomainVerification != nil {
-		l = m.DomainVerification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBackupAnchorsRequest is the request type for the Query/BackupAnchors
+// RPC method.
+type QueryBackupAnchorsRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Dataset   string `protobuf:"bytes,2,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBackupAnchorsRequest) Reset()         { *m = QueryBackupAnchorsRequest{} }
+func (m *QueryBackupAnchorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBackupAnchorsRequest) ProtoMessage()    {}
+func (*QueryBackupAnchorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{37}
+}
+func (m *QueryBackupAnchorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBackupAnchorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBackupAnchorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBackupAnchorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackupAnchorsRequest.Merge(m, src)
+}
+func (m *QueryBackupAnchorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBackupAnchorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackupAnchorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBackupAnchorsRequest proto.InternalMessageInfo
+
+func (m *QueryBackupAnchorsRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryServicesByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBackupAnchorsRequest) GetDataset() string {
+	if m != nil {
+		return m.Dataset
 	}
-	return n
+	return ""
 }
 
-func (m *QueryServicesByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryBackupAnchorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryServicesByDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBackupAnchorsResponse is the response type for the Query/BackupAnchors
+// RPC method.
+type QueryBackupAnchorsResponse struct {
+	Anchors []BackupAnchor `protobuf:"bytes,1,rep,name=anchors,proto3" json:"anchors"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServicesByDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBackupAnchorsResponse) Reset()         { *m = QueryBackupAnchorsResponse{} }
+func (m *QueryBackupAnchorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBackupAnchorsResponse) ProtoMessage()    {}
+func (*QueryBackupAnchorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{38}
+}
+func (m *QueryBackupAnchorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBackupAnchorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBackupAnchorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBackupAnchorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackupAnchorsResponse.Merge(m, src)
+}
+func (m *QueryBackupAnchorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBackupAnchorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackupAnchorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCDiscoveryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBackupAnchorsResponse proto.InternalMessageInfo
+
+func (m *QueryBackupAnchorsResponse) GetAnchors() []BackupAnchor {
+	if m != nil {
+		return m.Anchors
 	}
-	return n
+	return nil
 }
 
-func (m *QueryServiceOIDCDiscoveryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBackupAnchorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AuthorizationEndpoint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenEndpoint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.JwksUri)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.UserinfoEndpoint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RegistrationEndpoint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.ScopesSupported) > 0 {
-		for _, s := range m.ScopesSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryViewKeyRequest is the request type for the Query/ViewKey RPC method.
+type QueryViewKeyRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryViewKeyRequest) Reset()         { *m = QueryViewKeyRequest{} }
+func (m *QueryViewKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryViewKeyRequest) ProtoMessage()    {}
+func (*QueryViewKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{39}
+}
+func (m *QueryViewKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryViewKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryViewKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ResponseTypesSupported) > 0 {
-		for _, s := range m.ResponseTypesSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+}
+func (m *QueryViewKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryViewKeyRequest.Merge(m, src)
+}
+func (m *QueryViewKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryViewKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryViewKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryViewKeyRequest proto.InternalMessageInfo
+
+func (m *QueryViewKeyRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.GrantTypesSupported) > 0 {
-		for _, s := range m.GrantTypesSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// ViewKeyInfo is a view key with its current validity
+type ViewKeyInfo struct {
+	ViewKey ViewKey `protobuf:"bytes,1,opt,name=view_key,json=viewKey,proto3" json:"view_key"`
+	// Whether the key is neither revoked nor expired
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *ViewKeyInfo) Reset()         { *m = ViewKeyInfo{} }
+func (m *ViewKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*ViewKeyInfo) ProtoMessage()    {}
+func (*ViewKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{40}
+}
+func (m *ViewKeyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ViewKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ViewKeyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.IdTokenSigningAlgValuesSupported) > 0 {
-		for _, s := range m.IdTokenSigningAlgValuesSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+}
+func (m *ViewKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewKeyInfo.Merge(m, src)
+}
+func (m *ViewKeyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ViewKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewKeyInfo proto.InternalMessageInfo
+
+func (m *ViewKeyInfo) GetViewKey() ViewKey {
+	if m != nil {
+		return m.ViewKey
 	}
-	if len(m.SubjectTypesSupported) > 0 {
-		for _, s := range m.SubjectTypesSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return ViewKey{}
+}
+
+func (m *ViewKeyInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	if len(m.TokenEndpointAuthMethodsSupported) > 0 {
-		for _, s := range m.TokenEndpointAuthMethodsSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+// QueryViewKeyResponse is the response type for the Query/ViewKey RPC method.
+type QueryViewKeyResponse struct {
+	ViewKey ViewKeyInfo `protobuf:"bytes,1,opt,name=view_key,json=viewKey,proto3" json:"view_key"`
+}
+
+func (m *QueryViewKeyResponse) Reset()         { *m = QueryViewKeyResponse{} }
+func (m *QueryViewKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryViewKeyResponse) ProtoMessage()    {}
+func (*QueryViewKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{41}
+}
+func (m *QueryViewKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryViewKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryViewKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ClaimsSupported) > 0 {
-		for _, s := range m.ClaimsSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+}
+func (m *QueryViewKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryViewKeyResponse.Merge(m, src)
+}
+func (m *QueryViewKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryViewKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryViewKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryViewKeyResponse proto.InternalMessageInfo
+
+func (m *QueryViewKeyResponse) GetViewKey() ViewKeyInfo {
+	if m != nil {
+		return m.ViewKey
 	}
-	if len(m.ResponseModesSupported) > 0 {
-		for _, s := range m.ResponseModesSupported {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return ViewKeyInfo{}
+}
+
+// QueryViewKeysRequest is the request type for the Query/ViewKeys RPC method.
+type QueryViewKeysRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryViewKeysRequest) Reset()         { *m = QueryViewKeysRequest{} }
+func (m *QueryViewKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryViewKeysRequest) ProtoMessage()    {}
+func (*QueryViewKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{42}
+}
+func (m *QueryViewKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryViewKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryViewKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.ServiceDocumentation)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.UiLocalesSupported) > 0 {
-		for _, s := range m.UiLocalesSupported {
-			l = len(s)
-			n += 2 + l + sovQuery(uint64(l))
-		}
+}
+func (m *QueryViewKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryViewKeysRequest.Merge(m, src)
+}
+func (m *QueryViewKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryViewKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryViewKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryViewKeysRequest proto.InternalMessageInfo
+
+func (m *QueryViewKeysRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.ClaimsLocalesSupported) > 0 {
-		for _, s := range m.ClaimsLocalesSupported {
-			l = len(s)
-			n += 2 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryViewKeysResponse is the response type for the Query/ViewKeys RPC
+// method.
+type QueryViewKeysResponse struct {
+	ViewKeys []ViewKeyInfo `protobuf:"bytes,1,rep,name=view_keys,json=viewKeys,proto3" json:"view_keys"`
+}
+
+func (m *QueryViewKeysResponse) Reset()         { *m = QueryViewKeysResponse{} }
+func (m *QueryViewKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryViewKeysResponse) ProtoMessage()    {}
+func (*QueryViewKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{43}
+}
+func (m *QueryViewKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryViewKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryViewKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RequestParameterSupported {
-		n += 3
-	}
-	if m.RequestUriParameterSupported {
-		n += 3
-	}
-	if m.RequireRequestUriRegistration {
-		n += 3
-	}
-	l = len(m.OpPolicyUri)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OpTosUri)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryViewKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryViewKeysResponse.Merge(m, src)
+}
+func (m *QueryViewKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryViewKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryViewKeysResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCJWKSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryViewKeysResponse proto.InternalMessageInfo
+
+func (m *QueryViewKeysResponse) GetViewKeys() []ViewKeyInfo {
+	if m != nil {
+		return m.ViewKeys
 	}
-	return n
+	return nil
 }
 
-func (m *QueryServiceOIDCJWKSResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryAuthorizeViewRequest is the request type for the Query/AuthorizeView
+// RPC method.
+type QueryAuthorizeViewRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// UCAN issued by the key's grantee for the view ability on view:<owner>
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Scope being read
+	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (m *QueryAuthorizeViewRequest) Reset()         { *m = QueryAuthorizeViewRequest{} }
+func (m *QueryAuthorizeViewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorizeViewRequest) ProtoMessage()    {}
+func (*QueryAuthorizeViewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{44}
+}
+func (m *QueryAuthorizeViewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorizeViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorizeViewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAuthorizeViewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorizeViewRequest.Merge(m, src)
+}
+func (m *QueryAuthorizeViewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorizeViewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorizeViewRequest.DiscardUnknown(m)
 }
 
-func (m *QueryServiceOIDCMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAuthorizeViewRequest proto.InternalMessageInfo
+
+func (m *QueryAuthorizeViewRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *QueryServiceOIDCMetadataResponse) Size() (n int) {
+func (m *QueryAuthorizeViewRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryAuthorizeViewRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+// QueryAuthorizeViewResponse is the response type for the Query/AuthorizeView
+// RPC method.
+type QueryAuthorizeViewResponse struct {
+	ViewKey ViewKey `protobuf:"bytes,1,opt,name=view_key,json=viewKey,proto3" json:"view_key"`
+}
+
+func (m *QueryAuthorizeViewResponse) Reset()         { *m = QueryAuthorizeViewResponse{} }
+func (m *QueryAuthorizeViewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorizeViewResponse) ProtoMessage()    {}
+func (*QueryAuthorizeViewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a1010cdbf4bc9c, []int{45}
+}
+func (m *QueryAuthorizeViewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorizeViewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorizeViewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthorizeViewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorizeViewResponse.Merge(m, src)
+}
+func (m *QueryAuthorizeViewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorizeViewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorizeViewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthorizeViewResponse proto.InternalMessageInfo
+
+func (m *QueryAuthorizeViewResponse) GetViewKey() ViewKey {
+	if m != nil {
+		return m.ViewKey
+	}
+	return ViewKey{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "svc.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "svc.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDomainVerificationRequest)(nil), "svc.v1.QueryDomainVerificationRequest")
+	proto.RegisterType((*QueryDomainVerificationResponse)(nil), "svc.v1.QueryDomainVerificationResponse")
+	proto.RegisterType((*QueryServiceRequest)(nil), "svc.v1.QueryServiceRequest")
+	proto.RegisterType((*QueryServiceResponse)(nil), "svc.v1.QueryServiceResponse")
+	proto.RegisterType((*QueryServicesByOwnerRequest)(nil), "svc.v1.QueryServicesByOwnerRequest")
+	proto.RegisterType((*QueryServicesByOwnerResponse)(nil), "svc.v1.QueryServicesByOwnerResponse")
+	proto.RegisterType((*QueryServicesByDomainRequest)(nil), "svc.v1.QueryServicesByDomainRequest")
+	proto.RegisterType((*QueryServicesByDomainResponse)(nil), "svc.v1.QueryServicesByDomainResponse")
+	proto.RegisterType((*QueryServiceOIDCDiscoveryRequest)(nil), "svc.v1.QueryServiceOIDCDiscoveryRequest")
+	proto.RegisterType((*QueryServiceOIDCDiscoveryResponse)(nil), "svc.v1.QueryServiceOIDCDiscoveryResponse")
+	proto.RegisterType((*QueryServiceOIDCJWKSRequest)(nil), "svc.v1.QueryServiceOIDCJWKSRequest")
+	proto.RegisterType((*QueryServiceOIDCJWKSResponse)(nil), "svc.v1.QueryServiceOIDCJWKSResponse")
+	proto.RegisterType((*QueryServiceOIDCMetadataRequest)(nil), "svc.v1.QueryServiceOIDCMetadataRequest")
+	proto.RegisterType((*QueryServiceOIDCMetadataResponse)(nil), "svc.v1.QueryServiceOIDCMetadataResponse")
+	proto.RegisterMapType((map[string]string)(nil), "svc.v1.QueryServiceOIDCMetadataResponse.MetadataEntry")
+	proto.RegisterType((*QueryFeeSponsorshipRequest)(nil), "svc.v1.QueryFeeSponsorshipRequest")
+	proto.RegisterType((*QueryFeeSponsorshipResponse)(nil), "svc.v1.QueryFeeSponsorshipResponse")
+	proto.RegisterType((*QueryFeeSponsorUsageRequest)(nil), "svc.v1.QueryFeeSponsorUsageRequest")
+	proto.RegisterType((*QueryFeeSponsorUsageResponse)(nil), "svc.v1.QueryFeeSponsorUsageResponse")
+	proto.RegisterType((*QueryExportCredentialsRequest)(nil), "svc.v1.QueryExportCredentialsRequest")
+	proto.RegisterType((*QueryExportCredentialsResponse)(nil), "svc.v1.QueryExportCredentialsResponse")
+	proto.RegisterType((*QueryImportedCredentialsRequest)(nil), "svc.v1.QueryImportedCredentialsRequest")
+	proto.RegisterType((*QueryImportedCredentialsResponse)(nil), "svc.v1.QueryImportedCredentialsResponse")
+	proto.RegisterType((*QueryBadgesRequest)(nil), "svc.v1.QueryBadgesRequest")
+	proto.RegisterType((*BadgeInfo)(nil), "svc.v1.BadgeInfo")
+	proto.RegisterType((*QueryBadgesResponse)(nil), "svc.v1.QueryBadgesResponse")
+	proto.RegisterType((*QueryBadgeIssuersRequest)(nil), "svc.v1.QueryBadgeIssuersRequest")
+	proto.RegisterType((*QueryBadgeIssuersResponse)(nil), "svc.v1.QueryBadgeIssuersResponse")
+	proto.RegisterType((*QueryAppManifestRequest)(nil), "svc.v1.QueryAppManifestRequest")
+	proto.RegisterType((*QueryAppManifestResponse)(nil), "svc.v1.QueryAppManifestResponse")
+	proto.RegisterType((*QueryOIDCClientRequest)(nil), "svc.v1.QueryOIDCClientRequest")
+	proto.RegisterType((*QueryOIDCClientResponse)(nil), "svc.v1.QueryOIDCClientResponse")
+	proto.RegisterType((*QueryOIDCClientsRequest)(nil), "svc.v1.QueryOIDCClientsRequest")
+	proto.RegisterType((*QueryOIDCClientsResponse)(nil), "svc.v1.QueryOIDCClientsResponse")
+	proto.RegisterType((*QueryAttestationPolicyRequest)(nil), "svc.v1.QueryAttestationPolicyRequest")
+	proto.RegisterType((*QueryAttestationPolicyResponse)(nil), "svc.v1.QueryAttestationPolicyResponse")
+	proto.RegisterType((*QueryBackupAnchorsRequest)(nil), "svc.v1.QueryBackupAnchorsRequest")
+	proto.RegisterType((*QueryBackupAnchorsResponse)(nil), "svc.v1.QueryBackupAnchorsResponse")
+	proto.RegisterType((*QueryViewKeyRequest)(nil), "svc.v1.QueryViewKeyRequest")
+	proto.RegisterType((*ViewKeyInfo)(nil), "svc.v1.ViewKeyInfo")
+	proto.RegisterType((*QueryViewKeyResponse)(nil), "svc.v1.QueryViewKeyResponse")
+	proto.RegisterType((*QueryViewKeysRequest)(nil), "svc.v1.QueryViewKeysRequest")
+	proto.RegisterType((*QueryViewKeysResponse)(nil), "svc.v1.QueryViewKeysResponse")
+	proto.RegisterType((*QueryAuthorizeViewRequest)(nil), "svc.v1.QueryAuthorizeViewRequest")
+	proto.RegisterType((*QueryAuthorizeViewResponse)(nil), "svc.v1.QueryAuthorizeViewResponse")
+}
+
+func init() { proto.RegisterFile("svc/v1/query.proto", fileDescriptor_81a1010cdbf4bc9c) }
+
+var fileDescriptor_81a1010cdbf4bc9c = []byte{
+	// 2551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x59, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xd7, 0x50, 0xd2, 0x72, 0x59, 0x34, 0x0f, 0x35, 0xaf, 0xe5, 0x90, 0x5a, 0xae, 0x46, 0xe2,
+	0x25, 0x59, 0x3b, 0x3c, 0x2c, 0x99, 0xff, 0xbf, 0x65, 0x27, 0xd4, 0x65, 0xd0, 0xb2, 0x8e, 0x2c,
+	0x75, 0x44, 0x79, 0x59, 0x0c, 0x67, 0x5b, 0xcb, 0x16, 0xb9, 0x33, 0xeb, 0x99, 0xd9, 0x95, 0x19,
+	0x82, 0x31, 0x90, 0x38, 0x41, 0x82, 0x04, 0x41, 0x80, 0x38, 0x01, 0x02, 0xfb, 0x21, 0x48, 0x9e,
+	0x92, 0x8f, 0x91, 0x27, 0xbf, 0x04, 0x30, 0x90, 0x97, 0x3c, 0x04, 0x49, 0x20, 0xe5, 0x83, 0x04,
+	0xd3, 0x5d, 0x3d, 0x3b, 0xd7, 0x1e, 0x12, 0x08, 0xe4, 0x89, 0xec, 0xae, 0x5f, 0x55, 0xfd, 0xba,
+	0xfa, 0x98, 0xaa, 0x22, 0x81, 0xb8, 0x4d, 0x53, 0x6f, 0xae, 0xea, 0x9f, 0x34, 0xa8, 0x73, 0x50,
+	0xac, 0x3b, 0xb6, 0x67, 0x93, 0x8c, 0xdb, 0x34, 0x8b, 0xcd, 0x55, 0xf5, 0xa2, 0x69, 0xbb, 0x35,
+	0xdb, 0xd5, 0x77, 0x0c, 0x97, 0x0a, 0x80, 0xde, 0x5c, 0xdd, 0xa1, 0x9e, 0xb1, 0xaa, 0xd7, 0x8d,
+	0x2a, 0xb3, 0x0c, 0x8f, 0xd9, 0x96, 0xd0, 0x51, 0xf3, 0x61, 0xac, 0x44, 0x99, 0x36, 0x93, 0xf2,
+	0xf1, 0xaa, 0x5d, 0xb5, 0xf9, 0xaf, 0xba, 0xff, 0x1b, 0xce, 0xce, 0x56, 0x6d, 0xbb, 0xba, 0x4f,
+	0x75, 0xa3, 0xce, 0x74, 0xc3, 0xb2, 0x6c, 0x8f, 0x9b, 0x74, 0x51, 0x3a, 0x86, 0xdc, 0x0c, 0xcb,
+	0xdc, 0xb5, 0x1d, 0x9c, 0xcc, 0xc9, 0x49, 0xcf, 0xa3, 0xae, 0x17, 0xa6, 0x20, 0xe1, 0x3b, 0x46,
+	0xa5, 0x4a, 0xdd, 0x18, 0xdc, 0x74, 0x68, 0x85, 0x5a, 0x1e, 0x33, 0xf6, 0xa5, 0x64, 0x12, 0x25,
+	0x35, 0xc3, 0x62, 0xcf, 0xa8, 0xeb, 0xc9, 0xf9, 0x69, 0x9c, 0xb7, 0x59, 0xc5, 0x2c, 0x9b, 0xfb,
+	0x8c, 0x5a, 0x81, 0x68, 0x1c, 0x45, 0x55, 0x6a, 0x51, 0x97, 0xc5, 0x5d, 0xb8, 0x75, 0xdb, 0x72,
+	0x6d, 0xc7, 0xdd, 0x65, 0x75, 0x94, 0xc8, 0xe0, 0xfa, 0x3c, 0x69, 0xcc, 0x6d, 0x93, 0xd1, 0x17,
+	0xe5, 0x3d, 0x7a, 0x80, 0x56, 0xb4, 0x71, 0x20, 0xdf, 0xf1, 0x43, 0xfc, 0xc0, 0x70, 0x8c, 0x9a,
+	0x5b, 0xa2, 0x9f, 0x34, 0xa8, 0xeb, 0x69, 0xef, 0xc3, 0x58, 0x64, 0x96, 0xfb, 0xa0, 0x64, 0x01,
+	0x32, 0x75, 0x3e, 0x93, 0x53, 0x0a, 0xca, 0xd2, 0xe0, 0xda, 0x70, 0x51, 0x6c, 0x59, 0x11, 0x71,
+	0x28, 0xd5, 0x36, 0x20, 0xcf, 0xd5, 0x6f, 0xda, 0x35, 0x83, 0x59, 0x8f, 0xa9, 0xc3, 0x9e, 0x31,
+	0x93, 0xc7, 0x0c, 0x1d, 0x90, 0x49, 0xc8, 0x54, 0xb8, 0x90, 0x5b, 0x1a, 0x28, 0xe1, 0x48, 0xb3,
+	0x60, 0xae, 0xad, 0x26, 0x92, 0xb8, 0x03, 0x63, 0x02, 0x5c, 0x6e, 0x86, 0xc4, 0xc8, 0x48, 0x95,
+	0x8c, 0x52, 0x0c, 0x90, 0x4a, 0x62, 0x4e, 0x7b, 0x07, 0x17, 0xba, 0x4d, 0x9d, 0x26, 0x33, 0xa9,
+	0xa4, 0x77, 0x16, 0xc0, 0x15, 0x33, 0x65, 0x56, 0x41, 0x8a, 0x03, 0x38, 0xb3, 0x55, 0xd1, 0x36,
+	0x61, 0x3c, 0xaa, 0x85, 0xd4, 0x96, 0xa1, 0x1f, 0x41, 0x48, 0x67, 0x44, 0xd2, 0x91, 0x48, 0x29,
+	0xd7, 0x0e, 0x61, 0x26, 0x6c, 0xc2, 0xbd, 0x7e, 0x70, 0xff, 0x85, 0x45, 0x1d, 0x49, 0x60, 0x1c,
+	0x4e, 0xdb, 0xfe, 0x18, 0x7d, 0x8b, 0x01, 0xb9, 0x0d, 0xd0, 0xba, 0x01, 0xb9, 0x3e, 0xee, 0x62,
+	0xa1, 0x28, 0xae, 0x40, 0xd1, 0xbf, 0x02, 0x45, 0x71, 0x9f, 0xf0, 0x22, 0x14, 0x1f, 0x18, 0x55,
+	0xb9, 0xa4, 0x52, 0x48, 0x53, 0xfb, 0x42, 0x81, 0xd9, 0x74, 0xef, 0xb8, 0x90, 0x4b, 0x90, 0x45,
+	0xa2, 0xfe, 0x56, 0x9f, 0x4c, 0x5b, 0x49, 0x00, 0x20, 0x1f, 0xa6, 0xb0, 0x5a, 0xec, 0xca, 0x4a,
+	0x78, 0x8a, 0xd0, 0xfa, 0x41, 0x82, 0x95, 0xd8, 0xc5, 0x2e, 0x87, 0xe6, 0xd8, 0xc2, 0xf2, 0x1b,
+	0x05, 0xce, 0xb6, 0x21, 0xf0, 0x3f, 0x8d, 0xcb, 0x26, 0x14, 0xc2, 0xb4, 0xee, 0x6f, 0xdd, 0xbc,
+	0x71, 0x93, 0xb9, 0xa6, 0xdd, 0xa4, 0xce, 0x41, 0x8f, 0x27, 0xf6, 0x0f, 0x03, 0x70, 0xae, 0x83,
+	0x0d, 0x5c, 0xde, 0x24, 0x64, 0x98, 0xeb, 0x36, 0x82, 0x63, 0x87, 0x23, 0x72, 0x05, 0x26, 0x8d,
+	0x86, 0xb7, 0x6b, 0x3b, 0xec, 0xfb, 0x9c, 0x51, 0x99, 0x5a, 0x95, 0xba, 0xcd, 0x2c, 0x8f, 0xaf,
+	0x6a, 0xa0, 0x34, 0x11, 0x91, 0xde, 0x42, 0x21, 0x99, 0x87, 0x61, 0xcf, 0xde, 0xa3, 0x21, 0xf8,
+	0x49, 0x0e, 0x1f, 0xe2, 0xb3, 0x01, 0x6c, 0x1a, 0xb2, 0xcf, 0x5f, 0xec, 0xb9, 0xe5, 0x86, 0xc3,
+	0x72, 0xa7, 0x38, 0xa0, 0xdf, 0x1f, 0x3f, 0x72, 0x18, 0xb9, 0x04, 0x67, 0x1a, 0x2e, 0x75, 0x98,
+	0xf5, 0xcc, 0x6e, 0x19, 0x39, 0xcd, 0x31, 0xa3, 0x52, 0x10, 0xd8, 0x59, 0x87, 0x09, 0x87, 0x56,
+	0x99, 0xeb, 0x39, 0x31, 0x92, 0x19, 0xae, 0x30, 0x1e, 0x16, 0x06, 0x4a, 0xcb, 0x30, 0xea, 0x9a,
+	0x76, 0x9d, 0xba, 0x65, 0xb7, 0x51, 0xaf, 0xdb, 0x8e, 0x47, 0x2b, 0xb9, 0xfe, 0xc2, 0xc9, 0xa5,
+	0x81, 0xd2, 0x88, 0x98, 0xdf, 0x96, 0xd3, 0x64, 0x03, 0x72, 0x0e, 0x46, 0xaa, 0xec, 0x1d, 0x44,
+	0x55, 0xb2, 0x5c, 0x65, 0x52, 0xca, 0x1f, 0x1e, 0x44, 0x34, 0xd7, 0x60, 0xa2, 0xea, 0x18, 0x96,
+	0x97, 0x50, 0x1b, 0xe0, 0x6a, 0x63, 0x5c, 0x18, 0xd3, 0xb9, 0x0f, 0xf3, 0xac, 0x52, 0x16, 0xf1,
+	0x73, 0x59, 0xd5, 0x62, 0x56, 0xb5, 0x6c, 0xec, 0x57, 0xcb, 0x4d, 0x63, 0xbf, 0x11, 0xb1, 0x01,
+	0xdc, 0x46, 0x81, 0x55, 0x1e, 0xfa, 0xd8, 0x6d, 0x01, 0xdd, 0xdc, 0xaf, 0x3e, 0xe6, 0xc0, 0x96,
+	0xc1, 0xab, 0x30, 0xe5, 0x36, 0x76, 0x9e, 0x53, 0x33, 0x49, 0x63, 0x90, 0x9b, 0x98, 0x40, 0x71,
+	0x8c, 0xc8, 0x03, 0x98, 0x8f, 0xee, 0x62, 0xd9, 0xdf, 0xed, 0x72, 0x8d, 0x7a, 0xbb, 0x76, 0x25,
+	0x6c, 0xe5, 0x2d, 0x6e, 0xe5, 0x5c, 0x64, 0x73, 0x37, 0x1b, 0xde, 0xee, 0x5d, 0x81, 0x6c, 0x59,
+	0x5c, 0x86, 0x51, 0x73, 0xdf, 0x60, 0xb5, 0xb0, 0xf2, 0x90, 0x88, 0xb9, 0x98, 0x4f, 0x8f, 0x79,
+	0xcd, 0xae, 0x44, 0x58, 0x0f, 0x47, 0x63, 0x7e, 0xd7, 0x17, 0xb7, 0x34, 0xd7, 0x61, 0x42, 0x5e,
+	0x88, 0x8a, 0x6d, 0x36, 0x6a, 0xd4, 0x12, 0x5f, 0xed, 0xdc, 0x88, 0x38, 0x0d, 0x28, 0xbc, 0x19,
+	0x96, 0x91, 0x15, 0x18, 0x6f, 0xb0, 0xf2, 0xbe, 0x6d, 0x1a, 0xfb, 0x11, 0x57, 0xa3, 0xdc, 0x15,
+	0x69, 0xb0, 0x8f, 0x85, 0x28, 0x42, 0x10, 0xd7, 0x92, 0xd4, 0x3a, 0x23, 0x08, 0x0a, 0x79, 0x42,
+	0xf3, 0x03, 0x98, 0x71, 0xc4, 0xe5, 0x2d, 0xf3, 0xcf, 0x26, 0xf5, 0xa8, 0x13, 0x52, 0x26, 0x05,
+	0x65, 0x29, 0x5b, 0x9a, 0x46, 0xc8, 0x03, 0x89, 0x68, 0xe9, 0xdf, 0x82, 0x39, 0xa9, 0xdf, 0x70,
+	0x58, 0xaa, 0x8d, 0x31, 0x6e, 0x63, 0x16, 0x61, 0x8f, 0x1c, 0x96, 0x62, 0xe6, 0x43, 0x28, 0xf8,
+	0x72, 0xe6, 0xd0, 0x72, 0xd8, 0x5c, 0xf8, 0xb2, 0xe4, 0xc6, 0xb9, 0x9d, 0xb3, 0x88, 0x2b, 0x05,
+	0xe6, 0x4a, 0x21, 0x10, 0xd1, 0x60, 0xc8, 0xae, 0x97, 0xeb, 0xf6, 0x3e, 0x33, 0x0f, 0xf8, 0x5d,
+	0x9e, 0xe0, 0x81, 0x1e, 0xb4, 0xeb, 0x0f, 0xf8, 0x9c, 0x7f, 0x9f, 0x67, 0x01, 0xec, 0x7a, 0xd9,
+	0xb3, 0xc5, 0x65, 0x9f, 0xe4, 0x80, 0xac, 0x5d, 0x7f, 0x68, 0xfb, 0xb7, 0x5d, 0xbb, 0x06, 0x33,
+	0xf1, 0x37, 0xea, 0xa3, 0x27, 0x77, 0xb6, 0x7b, 0x7c, 0xe2, 0xbe, 0x05, 0xb3, 0xe9, 0xda, 0xf8,
+	0xb8, 0xcd, 0xc1, 0x29, 0x3f, 0xef, 0xc1, 0x77, 0x7b, 0x50, 0xbe, 0xdb, 0x1f, 0x3d, 0xb9, 0x53,
+	0xe2, 0x02, 0xed, 0xdb, 0x30, 0x17, 0x37, 0x70, 0x97, 0x7a, 0x46, 0xc5, 0xf0, 0x8c, 0x1e, 0x29,
+	0xfc, 0xb5, 0x0f, 0x0a, 0xed, 0x4d, 0x20, 0x8f, 0x55, 0xc8, 0x98, 0xb6, 0xf5, 0x8c, 0x55, 0x31,
+	0x47, 0x98, 0x8e, 0x7d, 0x41, 0x7c, 0xa5, 0x1b, 0x1c, 0x50, 0x42, 0x20, 0x59, 0x84, 0x11, 0x91,
+	0xeb, 0xd0, 0x4a, 0x19, 0xbf, 0x80, 0xe2, 0xe1, 0x1d, 0x96, 0xd3, 0xe2, 0x3b, 0x45, 0xae, 0xc1,
+	0xb0, 0xe4, 0xe7, 0x27, 0x7f, 0x0d, 0x97, 0xbf, 0xb8, 0xc3, 0x6b, 0x13, 0x31, 0x1f, 0xdb, 0x5c,
+	0x58, 0x1a, 0x72, 0xc3, 0x43, 0x52, 0x82, 0x6c, 0x0d, 0xd9, 0xe6, 0x4e, 0xf1, 0x28, 0x5d, 0x95,
+	0x7a, 0xdd, 0x56, 0x55, 0x94, 0x13, 0xb7, 0x2c, 0xcf, 0x39, 0x28, 0x05, 0x76, 0xd4, 0xf7, 0x60,
+	0x28, 0x22, 0x22, 0xa3, 0x70, 0x72, 0x8f, 0x1e, 0x60, 0xec, 0xfc, 0x5f, 0xfd, 0x5c, 0x87, 0x3f,
+	0x6a, 0xb8, 0x26, 0x31, 0xf8, 0xff, 0xbe, 0x0d, 0x45, 0x7b, 0x0f, 0x54, 0xee, 0xf8, 0x36, 0xa5,
+	0xdb, 0xad, 0x2c, 0xb7, 0xc7, 0xcd, 0xf8, 0x8b, 0x02, 0x33, 0xa9, 0xda, 0xb8, 0x0f, 0x1b, 0x30,
+	0x18, 0x4a, 0x9d, 0x71, 0x33, 0x26, 0xe5, 0x82, 0x63, 0x4a, 0x61, 0x28, 0x61, 0x30, 0xe0, 0x50,
+	0x3f, 0xde, 0xcc, 0xaa, 0xe6, 0xfa, 0x78, 0xa0, 0xa6, 0x23, 0xdf, 0x75, 0xf9, 0x45, 0xbf, 0x61,
+	0x33, 0xeb, 0xfa, 0xca, 0xd7, 0xff, 0x9c, 0x3b, 0xf1, 0xe7, 0x7f, 0xcd, 0x2d, 0x55, 0x99, 0xb7,
+	0xdb, 0xd8, 0x29, 0x9a, 0x76, 0x4d, 0x17, 0x60, 0xfc, 0x71, 0xd9, 0xad, 0xec, 0xe9, 0xfc, 0x49,
+	0xe6, 0x0a, 0x6e, 0xa9, 0x65, 0x5d, 0xfb, 0x3c, 0xb9, 0x88, 0x47, 0x6e, 0x2b, 0x7d, 0xe9, 0x12,
+	0x83, 0x63, 0xcb, 0x8c, 0x7e, 0x2b, 0x13, 0xc6, 0x04, 0x0d, 0x0c, 0xe6, 0x65, 0x38, 0xdd, 0xf0,
+	0x27, 0xf0, 0x76, 0x4d, 0x25, 0xc3, 0x28, 0xf0, 0x02, 0x75, 0x7c, 0xa9, 0xd1, 0x07, 0x98, 0xb1,
+	0xdd, 0xfa, 0xd4, 0x7f, 0xce, 0x6e, 0xb4, 0xaa, 0xad, 0x1e, 0x0f, 0xc9, 0x77, 0x21, 0xdf, 0x4e,
+	0x1f, 0x57, 0x76, 0x15, 0x32, 0x94, 0x0b, 0xf1, 0x84, 0xe4, 0xe4, 0xd2, 0x5a, 0x60, 0xa1, 0x7c,
+	0xfd, 0x94, 0xbf, 0xd1, 0x25, 0x44, 0x6b, 0x3f, 0x55, 0xf0, 0x39, 0xd9, 0xaa, 0x89, 0x97, 0xf6,
+	0xb5, 0xc9, 0x1d, 0xdb, 0xee, 0xfd, 0x49, 0x81, 0x42, 0x7b, 0x2a, 0xb8, 0xce, 0x6b, 0x30, 0x18,
+	0x2a, 0x56, 0x71, 0x1f, 0x83, 0x72, 0x2a, 0xa9, 0x59, 0x0a, 0xc3, 0x8f, 0x6f, 0x43, 0x9f, 0x62,
+	0x3d, 0x7a, 0x9d, 0x57, 0xd3, 0x32, 0x50, 0x39, 0xe8, 0xc7, 0xe4, 0x04, 0xa3, 0x24, 0x87, 0x7e,
+	0x2e, 0xc1, 0x2c, 0x73, 0xbf, 0x51, 0xa1, 0x65, 0x66, 0x19, 0xa6, 0xc7, 0x9a, 0xe2, 0x1d, 0xc9,
+	0x96, 0x46, 0x70, 0x7e, 0x0b, 0xa7, 0xb5, 0x7b, 0x30, 0xc0, 0xad, 0x6e, 0x59, 0xcf, 0x6c, 0xb2,
+	0x0c, 0xa7, 0x79, 0xc1, 0x8e, 0xbb, 0x3a, 0x24, 0x17, 0xca, 0x11, 0xb8, 0x95, 0x02, 0xe1, 0x67,
+	0xc5, 0x11, 0xc3, 0x38, 0xd2, 0x6e, 0x63, 0xed, 0x28, 0xa9, 0x62, 0x20, 0x75, 0xc8, 0x70, 0x3d,
+	0x19, 0xc3, 0x33, 0x11, 0xd3, 0xbe, 0x73, 0x79, 0x52, 0x04, 0x4c, 0xdb, 0x81, 0x5c, 0xcb, 0xce,
+	0x16, 0xcf, 0xb8, 0x83, 0x85, 0x47, 0x8f, 0x80, 0xf2, 0xc6, 0x47, 0xe0, 0x77, 0x0a, 0x4c, 0xa7,
+	0x38, 0x41, 0xca, 0xeb, 0xd0, 0x2f, 0x32, 0x7d, 0xc9, 0x79, 0x2c, 0xca, 0x99, 0xcb, 0x90, 0xb5,
+	0x44, 0x1e, 0xdf, 0x96, 0x6f, 0xc0, 0x14, 0xa7, 0xb6, 0x59, 0xaf, 0xdf, 0xc5, 0xa6, 0x48, 0x8f,
+	0xb7, 0xf7, 0xc7, 0x0a, 0xe4, 0x92, 0xaa, 0xb8, 0xa8, 0x2b, 0x90, 0x95, 0x3d, 0x16, 0x0c, 0x5c,
+	0xb0, 0xaa, 0x10, 0x1c, 0x57, 0x15, 0x40, 0x43, 0x45, 0x66, 0x5f, 0xa4, 0xc8, 0x54, 0x21, 0x2b,
+	0x3f, 0xb6, 0xfc, 0xa3, 0x9a, 0x2d, 0x05, 0x63, 0xed, 0x0a, 0x4c, 0x72, 0x1a, 0xfc, 0xd3, 0xcd,
+	0x5b, 0x37, 0x72, 0x01, 0x33, 0x30, 0x20, 0x7a, 0x39, 0x2d, 0xfe, 0x59, 0x31, 0xb1, 0x55, 0xd1,
+	0x3e, 0x83, 0xa9, 0x84, 0x1a, 0x92, 0x5f, 0x81, 0x8c, 0x80, 0x21, 0x75, 0x22, 0xa9, 0xb7, 0xb0,
+	0xf2, 0x14, 0x09, 0xdc, 0x1b, 0xf1, 0xde, 0x48, 0x10, 0xe8, 0xf5, 0xdd, 0xbc, 0x07, 0xb9, 0xa4,
+	0x26, 0x72, 0x5f, 0x83, 0x7e, 0xc1, 0x49, 0x9e, 0xa6, 0xf6, 0xe4, 0x25, 0x30, 0x78, 0xc7, 0x37,
+	0x5b, 0xed, 0x35, 0x91, 0x35, 0xf6, 0xc8, 0xe7, 0x29, 0xe4, 0xdb, 0xe9, 0x23, 0xab, 0x77, 0x21,
+	0x23, 0x72, 0xd3, 0x78, 0xda, 0x95, 0x50, 0x91, 0x81, 0x15, 0x70, 0xed, 0xab, 0xd6, 0xd5, 0x31,
+	0xf7, 0x1a, 0xf5, 0x4d, 0xde, 0x15, 0xec, 0xf5, 0x09, 0xcf, 0x41, 0xbf, 0x9f, 0xfa, 0xb8, 0x54,
+	0x96, 0xca, 0x72, 0x18, 0xbb, 0xd9, 0x27, 0xdf, 0xf8, 0x66, 0x7f, 0xa9, 0x80, 0x9a, 0x46, 0x0f,
+	0x97, 0xfd, 0x0e, 0xf4, 0x8b, 0x3e, 0xa6, 0xdc, 0x8c, 0xf1, 0xd6, 0xd5, 0x6e, 0xe1, 0xe5, 0x76,
+	0x20, 0xf4, 0xf8, 0xee, 0xf6, 0x3c, 0xbe, 0x91, 0x8f, 0x19, 0x7d, 0x71, 0x87, 0x06, 0xbb, 0x39,
+	0x0c, 0x7d, 0x18, 0xad, 0x53, 0xa5, 0x3e, 0x56, 0xd1, 0x9e, 0xc0, 0x20, 0x22, 0xf8, 0xe3, 0xbc,
+	0x02, 0x59, 0xd9, 0xa7, 0x8c, 0x37, 0xd2, 0x10, 0x26, 0x09, 0x37, 0xc5, 0xb0, 0xed, 0x1b, 0xfd,
+	0x31, 0x76, 0xea, 0x02, 0xff, 0x41, 0x58, 0xe2, 0x1e, 0xc6, 0x62, 0x1e, 0x42, 0x0f, 0xb5, 0xf4,
+	0xa2, 0xbd, 0x1d, 0xb5, 0xe6, 0x76, 0xec, 0xd6, 0x69, 0xf7, 0x61, 0x22, 0x86, 0x0e, 0x52, 0x8a,
+	0x01, 0xe9, 0x3c, 0xf1, 0xe0, 0x26, 0xbd, 0x67, 0xd1, 0xbb, 0xab, 0x3d, 0xc1, 0x83, 0xb8, 0x89,
+	0xdd, 0x16, 0xea, 0x83, 0xdb, 0x84, 0xd4, 0xe7, 0xc4, 0x2b, 0x71, 0x99, 0x55, 0xf3, 0x81, 0x3f,
+	0xcb, 0xdb, 0x1a, 0xd8, 0x89, 0x11, 0x03, 0xed, 0x1e, 0xa8, 0x69, 0x86, 0x83, 0xb7, 0xe8, 0x35,
+	0x77, 0x63, 0xed, 0x1f, 0x93, 0x70, 0x9a, 0x1b, 0x24, 0x4f, 0x21, 0x23, 0x7a, 0xc3, 0x44, 0x8d,
+	0x94, 0x12, 0x91, 0x76, 0xb3, 0x3a, 0x93, 0x2a, 0x13, 0xee, 0xb5, 0xc9, 0x1f, 0xfe, 0xed, 0x3f,
+	0xbf, 0xee, 0x1b, 0x25, 0xc3, 0x3a, 0xb6, 0xb0, 0x45, 0x93, 0x99, 0xfc, 0x44, 0x01, 0x92, 0xec,
+	0xf2, 0x92, 0x85, 0x88, 0xad, 0xb6, 0x1d, 0x68, 0x75, 0xb1, 0x2b, 0x0e, 0xfd, 0xcf, 0x71, 0xff,
+	0xd3, 0x64, 0x4a, 0xfa, 0x17, 0x0f, 0xab, 0x7e, 0x28, 0x7e, 0x1e, 0x91, 0xe7, 0xd0, 0x8f, 0x95,
+	0x11, 0x99, 0x49, 0xab, 0x97, 0xa4, 0xc7, 0xd9, 0x74, 0x21, 0xba, 0xb9, 0xc0, 0xdd, 0xe4, 0xc9,
+	0xac, 0x74, 0x83, 0x8f, 0x88, 0x7e, 0xd8, 0x7a, 0x5f, 0x8e, 0xc8, 0xe7, 0x0a, 0x8c, 0xc4, 0x9a,
+	0xb6, 0xe4, 0x7c, 0x9a, 0xdd, 0x58, 0x43, 0x59, 0xbd, 0xd0, 0x19, 0x84, 0x24, 0x16, 0x38, 0x89,
+	0x02, 0xc9, 0xc7, 0x48, 0xb8, 0x3a, 0x3f, 0xd2, 0xfa, 0x21, 0xff, 0x71, 0x44, 0x7e, 0xa6, 0xc0,
+	0x68, 0xbc, 0x49, 0x4a, 0xda, 0xb9, 0x88, 0x34, 0x71, 0xd5, 0xf9, 0x2e, 0x28, 0x64, 0xb2, 0xc4,
+	0x99, 0x68, 0xa4, 0x90, 0x60, 0x12, 0x0f, 0xff, 0xef, 0x15, 0x18, 0x4f, 0xeb, 0x6a, 0x92, 0xa5,
+	0x76, 0xc5, 0x6b, 0xbc, 0x79, 0xaa, 0x2e, 0xf7, 0x80, 0x44, 0x5e, 0xeb, 0x9c, 0xd7, 0x65, 0x72,
+	0xa9, 0xd3, 0x36, 0xf1, 0x3f, 0xe2, 0xe8, 0x95, 0x80, 0xc9, 0x2f, 0x5a, 0xbb, 0x26, 0xdb, 0x12,
+	0xe9, 0xbb, 0x16, 0x6b, 0x79, 0xa8, 0x17, 0x3a, 0x83, 0x90, 0x53, 0x91, 0x73, 0x5a, 0x22, 0x0b,
+	0xdd, 0x39, 0xf9, 0x8d, 0x55, 0xf2, 0x95, 0x02, 0x63, 0x29, 0xb5, 0x3c, 0x59, 0xec, 0x5e, 0xed,
+	0x0b, 0x5a, 0x4b, 0xbd, 0xb6, 0x05, 0xb4, 0x35, 0x4e, 0xed, 0x6d, 0x72, 0xb1, 0x3b, 0x35, 0xd9,
+	0x32, 0x20, 0x3f, 0x57, 0x60, 0x38, 0x5a, 0x7e, 0x13, 0x2d, 0xe2, 0x30, 0xb5, 0x1d, 0xa0, 0x9e,
+	0xef, 0x88, 0x41, 0x3e, 0x2b, 0x9c, 0xcf, 0x45, 0xb2, 0xd4, 0x91, 0x4f, 0xb8, 0xd8, 0xff, 0x42,
+	0x81, 0x91, 0x58, 0x15, 0x4b, 0xda, 0xb9, 0x0a, 0x97, 0xe6, 0xea, 0x85, 0xce, 0x20, 0x24, 0x74,
+	0x95, 0x13, 0x5a, 0x21, 0xc5, 0x5e, 0x09, 0xe9, 0xa2, 0x82, 0xfe, 0x52, 0x81, 0x33, 0x89, 0xa2,
+	0x95, 0x44, 0x2f, 0x57, 0xbb, 0xa2, 0x58, 0x5d, 0xe8, 0x06, 0x43, 0x72, 0xef, 0x72, 0x72, 0xab,
+	0x44, 0xef, 0x48, 0x2e, 0x54, 0x07, 0xea, 0xa2, 0xf8, 0x25, 0x7f, 0x54, 0x60, 0x2c, 0xa5, 0xd8,
+	0x8c, 0x9d, 0xb0, 0xf6, 0x95, 0xb1, 0xba, 0xd4, 0x1d, 0x88, 0x1c, 0xff, 0x8f, 0x73, 0x5c, 0x27,
+	0xab, 0x3d, 0x73, 0x64, 0x68, 0x8d, 0x98, 0x90, 0x11, 0xb5, 0x5b, 0xec, 0xe3, 0x14, 0xa9, 0x3d,
+	0xd5, 0x99, 0x54, 0x19, 0x7a, 0x2f, 0x70, 0xef, 0x2a, 0xc9, 0xe9, 0x91, 0xbf, 0x02, 0xeb, 0x87,
+	0x58, 0x9f, 0x1e, 0x11, 0x07, 0xde, 0x0a, 0xd7, 0x5c, 0xa4, 0x90, 0x34, 0x17, 0xad, 0xf9, 0xd4,
+	0x73, 0x1d, 0x10, 0xe8, 0xf6, 0x2c, 0x77, 0x3b, 0x45, 0x26, 0x22, 0x6e, 0xcb, 0xb2, 0x34, 0xfb,
+	0x0c, 0x06, 0x43, 0x25, 0x0e, 0x99, 0x8b, 0x18, 0x4c, 0x96, 0x59, 0x6a, 0xa1, 0x3d, 0x00, 0x1d,
+	0x5e, 0xe6, 0x0e, 0x17, 0xc9, 0x7c, 0xc7, 0x28, 0x07, 0x45, 0xd4, 0x0b, 0x80, 0x56, 0xae, 0x4f,
+	0xf2, 0x11, 0xf3, 0x89, 0x22, 0x49, 0x9d, 0x6b, 0x2b, 0x6f, 0xf7, 0x31, 0xe0, 0x0f, 0x06, 0xd6,
+	0x0e, 0xfa, 0x61, 0x50, 0x61, 0x1d, 0x91, 0x1f, 0x29, 0x30, 0xd8, 0x32, 0xe0, 0x92, 0x76, 0xa6,
+	0xdd, 0xf4, 0xa5, 0xa7, 0x94, 0x33, 0xda, 0x2a, 0x77, 0x7e, 0x89, 0x2c, 0x77, 0x7f, 0xc2, 0x90,
+	0x11, 0xbf, 0x9c, 0x89, 0xb2, 0x22, 0x76, 0x39, 0xdb, 0x55, 0x3a, 0xea, 0x42, 0x37, 0xd8, 0x6b,
+	0x5d, 0xce, 0xd0, 0xff, 0x2b, 0x60, 0x6f, 0x9e, 0xfc, 0x52, 0x81, 0xa1, 0x48, 0xb1, 0x40, 0xe2,
+	0x27, 0x2e, 0x59, 0xe7, 0xa8, 0x5a, 0x27, 0xc8, 0x6b, 0xbd, 0x65, 0x58, 0x63, 0xe8, 0x87, 0x58,
+	0x09, 0x1d, 0x91, 0x0a, 0xf4, 0x63, 0x22, 0x19, 0x4b, 0xa0, 0xa2, 0x55, 0x83, 0x3a, 0x9b, 0x2e,
+	0x44, 0xef, 0x79, 0xee, 0x3d, 0x47, 0x26, 0xf5, 0xf8, 0xbf, 0x3a, 0xe8, 0x87, 0xfe, 0xd1, 0xa8,
+	0x43, 0x16, 0x55, 0x5c, 0x92, 0x6a, 0x29, 0x58, 0xeb, 0xd9, 0x36, 0x52, 0x74, 0xb4, 0xc8, 0x1d,
+	0x9d, 0x23, 0x73, 0x49, 0x47, 0xd1, 0x2c, 0xe9, 0x31, 0x0c, 0x45, 0x32, 0xea, 0x58, 0x9c, 0xd3,
+	0xd2, 0x78, 0x55, 0xeb, 0x04, 0x11, 0x04, 0xae, 0xbf, 0xff, 0xf5, 0xcb, 0xbc, 0xf2, 0xcd, 0xcb,
+	0xbc, 0xf2, 0xef, 0x97, 0x79, 0xe5, 0x57, 0xaf, 0xf2, 0x27, 0xbe, 0x79, 0x95, 0x3f, 0xf1, 0xf7,
+	0x57, 0xf9, 0x13, 0xdf, 0x3b, 0x1f, 0xea, 0x31, 0xbb, 0xb6, 0xe5, 0x5c, 0x66, 0x36, 0xff, 0xa9,
+	0x7f, 0xca, 0xb9, 0xf2, 0x26, 0xf3, 0x4e, 0x86, 0xff, 0xe7, 0xc7, 0xfa, 0x7f, 0x07, 0x00, 0x1a,
+	0xcb, 0x50, 0x34, 0x84, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DomainVerification queries domain verification status by domain name.
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "svc_docs.md"}}
+	DomainVerification(ctx context.Context, in *QueryDomainVerificationRequest, opts ...grpc.CallOption) (*QueryDomainVerificationResponse, error)
+	// Service queries service information by service ID.
+	Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error)
+	// ServicesByOwner queries all services owned by a specific address.
+	ServicesByOwner(ctx context.Context, in *QueryServicesByOwnerRequest, opts ...grpc.CallOption) (*QueryServicesByOwnerResponse, error)
+	// ServicesByDomain queries services bound to a specific domain.
+	ServicesByDomain(ctx context.Context, in *QueryServicesByDomainRequest, opts ...grpc.CallOption) (*QueryServicesByDomainResponse, error)
+	// ServiceOIDCDiscovery queries OIDC discovery configuration for a service
+	ServiceOIDCDiscovery(ctx context.Context, in *QueryServiceOIDCDiscoveryRequest, opts ...grpc.CallOption) (*QueryServiceOIDCDiscoveryResponse, error)
+	// ServiceOIDCJWKS queries OIDC JWKS for a service
+	ServiceOIDCJWKS(ctx context.Context, in *QueryServiceOIDCJWKSRequest, opts ...grpc.CallOption) (*QueryServiceOIDCJWKSResponse, error)
+	// ServiceOIDCMetadata queries OIDC metadata for a service
+	ServiceOIDCMetadata(ctx context.Context, in *QueryServiceOIDCMetadataRequest, opts ...grpc.CallOption) (*QueryServiceOIDCMetadataResponse, error)
+	// FeeSponsorship queries a service's fee payer, budget and spend
+	FeeSponsorship(ctx context.Context, in *QueryFeeSponsorshipRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipResponse, error)
+	// FeeSponsorUsage queries the fees a service has paid for each user
+	FeeSponsorUsage(ctx context.Context, in *QueryFeeSponsorUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorUsageResponse, error)
+	// ExportCredentials exports the credential and profile metadata of a
+	// service's users
+	ExportCredentials(ctx context.Context, in *QueryExportCredentialsRequest, opts ...grpc.CallOption) (*QueryExportCredentialsResponse, error)
+	// ImportedCredentials queries the credentials a service imported
+	ImportedCredentials(ctx context.Context, in *QueryImportedCredentialsRequest, opts ...grpc.CallOption) (*QueryImportedCredentialsResponse, error)
+	// Badges queries the badges issued to a service ID or DID
+	Badges(ctx context.Context, in *QueryBadgesRequest, opts ...grpc.CallOption) (*QueryBadgesResponse, error)
+	// BadgeIssuers queries the designated badge issuers
+	BadgeIssuers(ctx context.Context, in *QueryBadgeIssuersRequest, opts ...grpc.CallOption) (*QueryBadgeIssuersResponse, error)
+	// AppManifest queries the app manifest of a service
+	AppManifest(ctx context.Context, in *QueryAppManifestRequest, opts ...grpc.CallOption) (*QueryAppManifestResponse, error)
+	// OIDCClient queries a registered OpenID Connect client
+	OIDCClient(ctx context.Context, in *QueryOIDCClientRequest, opts ...grpc.CallOption) (*QueryOIDCClientResponse, error)
+	// OIDCClients lists the OpenID Connect clients of a service
+	OIDCClients(ctx context.Context, in *QueryOIDCClientsRequest, opts ...grpc.CallOption) (*QueryOIDCClientsResponse, error)
+	// AttestationPolicy queries the authenticator restrictions of a service
+	AttestationPolicy(ctx context.Context, in *QueryAttestationPolicyRequest, opts ...grpc.CallOption) (*QueryAttestationPolicyResponse, error)
+	// BackupAnchors lists the anchored digests of a service's dataset
+	BackupAnchors(ctx context.Context, in *QueryBackupAnchorsRequest, opts ...grpc.CallOption) (*QueryBackupAnchorsResponse, error)
+	// ViewKey queries a view key by ID
+	ViewKey(ctx context.Context, in *QueryViewKeyRequest, opts ...grpc.CallOption) (*QueryViewKeyResponse, error)
+	// ViewKeys lists the view keys minted by a DID, including revoked and
+	// expired ones
+	ViewKeys(ctx context.Context, in *QueryViewKeysRequest, opts ...grpc.CallOption) (*QueryViewKeysResponse, error)
+	// AuthorizeView checks that a grantee's UCAN may read a scope through a
+	// view key. It has no REST route, so the token stays out of URLs and
+	// access logs.
+	AuthorizeView(ctx context.Context, in *QueryAuthorizeViewRequest, opts ...grpc.CallOption) (*QueryAuthorizeViewResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DomainVerification(ctx context.Context, in *QueryDomainVerificationRequest, opts ...grpc.CallOption) (*QueryDomainVerificationResponse, error) {
+	out := new(QueryDomainVerificationResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/DomainVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Service(ctx context.Context, in *QueryServiceRequest, opts ...grpc.CallOption) (*QueryServiceResponse, error) {
+	out := new(QueryServiceResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/Service", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServicesByOwner(ctx context.Context, in *QueryServicesByOwnerRequest, opts ...grpc.CallOption) (*QueryServicesByOwnerResponse, error) {
+	out := new(QueryServicesByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServicesByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServicesByDomain(ctx context.Context, in *QueryServicesByDomainRequest, opts ...grpc.CallOption) (*QueryServicesByDomainResponse, error) {
+	out := new(QueryServicesByDomainResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServicesByDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceOIDCDiscovery(ctx context.Context, in *QueryServiceOIDCDiscoveryRequest, opts ...grpc.CallOption) (*QueryServiceOIDCDiscoveryResponse, error) {
+	out := new(QueryServiceOIDCDiscoveryResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServiceOIDCDiscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceOIDCJWKS(ctx context.Context, in *QueryServiceOIDCJWKSRequest, opts ...grpc.CallOption) (*QueryServiceOIDCJWKSResponse, error) {
+	out := new(QueryServiceOIDCJWKSResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServiceOIDCJWKS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceOIDCMetadata(ctx context.Context, in *QueryServiceOIDCMetadataRequest, opts ...grpc.CallOption) (*QueryServiceOIDCMetadataResponse, error) {
+	out := new(QueryServiceOIDCMetadataResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ServiceOIDCMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSponsorship(ctx context.Context, in *QueryFeeSponsorshipRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipResponse, error) {
+	out := new(QueryFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/FeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSponsorUsage(ctx context.Context, in *QueryFeeSponsorUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorUsageResponse, error) {
+	out := new(QueryFeeSponsorUsageResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/FeeSponsorUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExportCredentials(ctx context.Context, in *QueryExportCredentialsRequest, opts ...grpc.CallOption) (*QueryExportCredentialsResponse, error) {
+	out := new(QueryExportCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ExportCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ImportedCredentials(ctx context.Context, in *QueryImportedCredentialsRequest, opts ...grpc.CallOption) (*QueryImportedCredentialsResponse, error) {
+	out := new(QueryImportedCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ImportedCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Badges(ctx context.Context, in *QueryBadgesRequest, opts ...grpc.CallOption) (*QueryBadgesResponse, error) {
+	out := new(QueryBadgesResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/Badges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BadgeIssuers(ctx context.Context, in *QueryBadgeIssuersRequest, opts ...grpc.CallOption) (*QueryBadgeIssuersResponse, error) {
+	out := new(QueryBadgeIssuersResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/BadgeIssuers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppManifest(ctx context.Context, in *QueryAppManifestRequest, opts ...grpc.CallOption) (*QueryAppManifestResponse, error) {
+	out := new(QueryAppManifestResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/AppManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OIDCClient(ctx context.Context, in *QueryOIDCClientRequest, opts ...grpc.CallOption) (*QueryOIDCClientResponse, error) {
+	out := new(QueryOIDCClientResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/OIDCClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OIDCClients(ctx context.Context, in *QueryOIDCClientsRequest, opts ...grpc.CallOption) (*QueryOIDCClientsResponse, error) {
+	out := new(QueryOIDCClientsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/OIDCClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationPolicy(ctx context.Context, in *QueryAttestationPolicyRequest, opts ...grpc.CallOption) (*QueryAttestationPolicyResponse, error) {
+	out := new(QueryAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/AttestationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BackupAnchors(ctx context.Context, in *QueryBackupAnchorsRequest, opts ...grpc.CallOption) (*QueryBackupAnchorsResponse, error) {
+	out := new(QueryBackupAnchorsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/BackupAnchors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ViewKey(ctx context.Context, in *QueryViewKeyRequest, opts ...grpc.CallOption) (*QueryViewKeyResponse, error) {
+	out := new(QueryViewKeyResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ViewKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ViewKeys(ctx context.Context, in *QueryViewKeysRequest, opts ...grpc.CallOption) (*QueryViewKeysResponse, error) {
+	out := new(QueryViewKeysResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/ViewKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizeView(ctx context.Context, in *QueryAuthorizeViewRequest, opts ...grpc.CallOption) (*QueryAuthorizeViewResponse, error) {
+	out := new(QueryAuthorizeViewResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Query/AuthorizeView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DomainVerification queries domain verification status by domain name.
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "svc_docs.md"}}
+	DomainVerification(context.Context, *QueryDomainVerificationRequest) (*QueryDomainVerificationResponse, error)
+	// Service queries service information by service ID.
+	Service(context.Context, *QueryServiceRequest) (*QueryServiceResponse, error)
+	// ServicesByOwner queries all services owned by a specific address.
+	ServicesByOwner(context.Context, *QueryServicesByOwnerRequest) (*QueryServicesByOwnerResponse, error)
+	// ServicesByDomain queries services bound to a specific domain.
+	ServicesByDomain(context.Context, *QueryServicesByDomainRequest) (*QueryServicesByDomainResponse, error)
+	// ServiceOIDCDiscovery queries OIDC discovery configuration for a service
+	ServiceOIDCDiscovery(context.Context, *QueryServiceOIDCDiscoveryRequest) (*QueryServiceOIDCDiscoveryResponse, error)
+	// ServiceOIDCJWKS queries OIDC JWKS for a service
+	ServiceOIDCJWKS(context.Context, *QueryServiceOIDCJWKSRequest) (*QueryServiceOIDCJWKSResponse, error)
+	// ServiceOIDCMetadata queries OIDC metadata for a service
+	ServiceOIDCMetadata(context.Context, *QueryServiceOIDCMetadataRequest) (*QueryServiceOIDCMetadataResponse, error)
+	// FeeSponsorship queries a service's fee payer, budget and spend
+	FeeSponsorship(context.Context, *QueryFeeSponsorshipRequest) (*QueryFeeSponsorshipResponse, error)
+	// FeeSponsorUsage queries the fees a service has paid for each user
+	FeeSponsorUsage(context.Context, *QueryFeeSponsorUsageRequest) (*QueryFeeSponsorUsageResponse, error)
+	// ExportCredentials exports the credential and profile metadata of a
+	// service's users
+	ExportCredentials(context.Context, *QueryExportCredentialsRequest) (*QueryExportCredentialsResponse, error)
+	// ImportedCredentials queries the credentials a service imported
+	ImportedCredentials(context.Context, *QueryImportedCredentialsRequest) (*QueryImportedCredentialsResponse, error)
+	// Badges queries the badges issued to a service ID or DID
+	Badges(context.Context, *QueryBadgesRequest) (*QueryBadgesResponse, error)
+	// BadgeIssuers queries the designated badge issuers
+	BadgeIssuers(context.Context, *QueryBadgeIssuersRequest) (*QueryBadgeIssuersResponse, error)
+	// AppManifest queries the app manifest of a service
+	AppManifest(context.Context, *QueryAppManifestRequest) (*QueryAppManifestResponse, error)
+	// OIDCClient queries a registered OpenID Connect client
+	OIDCClient(context.Context, *QueryOIDCClientRequest) (*QueryOIDCClientResponse, error)
+	// OIDCClients lists the OpenID Connect clients of a service
+	OIDCClients(context.Context, *QueryOIDCClientsRequest) (*QueryOIDCClientsResponse, error)
+	// AttestationPolicy queries the authenticator restrictions of a service
+	AttestationPolicy(context.Context, *QueryAttestationPolicyRequest) (*QueryAttestationPolicyResponse, error)
+	// BackupAnchors lists the anchored digests of a service's dataset
+	BackupAnchors(context.Context, *QueryBackupAnchorsRequest) (*QueryBackupAnchorsResponse, error)
+	// ViewKey queries a view key by ID
+	ViewKey(context.Context, *QueryViewKeyRequest) (*QueryViewKeyResponse, error)
+	// ViewKeys lists the view keys minted by a DID, including revoked and
+	// expired ones
+	ViewKeys(context.Context, *QueryViewKeysRequest) (*QueryViewKeysResponse, error)
+	// AuthorizeView checks that a grantee's UCAN may read a scope through a
+	// view key. It has no REST route, so the token stays out of URLs and
+	// access logs.
+	AuthorizeView(context.Context, *QueryAuthorizeViewRequest) (*QueryAuthorizeViewResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DomainVerification(ctx context.Context, req *QueryDomainVerificationRequest) (*QueryDomainVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainVerification not implemented")
+}
+func (*UnimplementedQueryServer) Service(ctx context.Context, req *QueryServiceRequest) (*QueryServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Service not implemented")
+}
+func (*UnimplementedQueryServer) ServicesByOwner(ctx context.Context, req *QueryServicesByOwnerRequest) (*QueryServicesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServicesByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ServicesByDomain(ctx context.Context, req *QueryServicesByDomainRequest) (*QueryServicesByDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServicesByDomain not implemented")
+}
+func (*UnimplementedQueryServer) ServiceOIDCDiscovery(ctx context.Context, req *QueryServiceOIDCDiscoveryRequest) (*QueryServiceOIDCDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCDiscovery not implemented")
+}
+func (*UnimplementedQueryServer) ServiceOIDCJWKS(ctx context.Context, req *QueryServiceOIDCJWKSRequest) (*QueryServiceOIDCJWKSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCJWKS not implemented")
+}
+func (*UnimplementedQueryServer) ServiceOIDCMetadata(ctx context.Context, req *QueryServiceOIDCMetadataRequest) (*QueryServiceOIDCMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceOIDCMetadata not implemented")
+}
+func (*UnimplementedQueryServer) FeeSponsorship(ctx context.Context, req *QueryFeeSponsorshipRequest) (*QueryFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorship not implemented")
+}
+func (*UnimplementedQueryServer) FeeSponsorUsage(ctx context.Context, req *QueryFeeSponsorUsageRequest) (*QueryFeeSponsorUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorUsage not implemented")
+}
+func (*UnimplementedQueryServer) ExportCredentials(ctx context.Context, req *QueryExportCredentialsRequest) (*QueryExportCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCredentials not implemented")
+}
+func (*UnimplementedQueryServer) ImportedCredentials(ctx context.Context, req *QueryImportedCredentialsRequest) (*QueryImportedCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportedCredentials not implemented")
+}
+func (*UnimplementedQueryServer) Badges(ctx context.Context, req *QueryBadgesRequest) (*QueryBadgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Badges not implemented")
+}
+func (*UnimplementedQueryServer) BadgeIssuers(ctx context.Context, req *QueryBadgeIssuersRequest) (*QueryBadgeIssuersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BadgeIssuers not implemented")
+}
+func (*UnimplementedQueryServer) AppManifest(ctx context.Context, req *QueryAppManifestRequest) (*QueryAppManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppManifest not implemented")
+}
+func (*UnimplementedQueryServer) OIDCClient(ctx context.Context, req *QueryOIDCClientRequest) (*QueryOIDCClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCClient not implemented")
+}
+func (*UnimplementedQueryServer) OIDCClients(ctx context.Context, req *QueryOIDCClientsRequest) (*QueryOIDCClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCClients not implemented")
+}
+func (*UnimplementedQueryServer) AttestationPolicy(ctx context.Context, req *QueryAttestationPolicyRequest) (*QueryAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationPolicy not implemented")
+}
+func (*UnimplementedQueryServer) BackupAnchors(ctx context.Context, req *QueryBackupAnchorsRequest) (*QueryBackupAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupAnchors not implemented")
+}
+func (*UnimplementedQueryServer) ViewKey(ctx context.Context, req *QueryViewKeyRequest) (*QueryViewKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewKey not implemented")
+}
+func (*UnimplementedQueryServer) ViewKeys(ctx context.Context, req *QueryViewKeysRequest) (*QueryViewKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewKeys not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizeView(ctx context.Context, req *QueryAuthorizeViewRequest) (*QueryAuthorizeViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeView not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/DomainVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainVerification(ctx, req.(*QueryDomainVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Service_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Service(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/Service",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Service(ctx, req.(*QueryServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServicesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServicesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServicesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ServicesByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServicesByOwner(ctx, req.(*QueryServicesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServicesByDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServicesByDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServicesByDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ServicesByDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServicesByDomain(ctx, req.(*QueryServicesByDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceOIDCDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceOIDCDiscoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceOIDCDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ServiceOIDCDiscovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceOIDCDiscovery(ctx, req.(*QueryServiceOIDCDiscoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceOIDCJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceOIDCJWKSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceOIDCJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ServiceOIDCJWKS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceOIDCJWKS(ctx, req.(*QueryServiceOIDCJWKSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceOIDCMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceOIDCMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceOIDCMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ServiceOIDCMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceOIDCMetadata(ctx, req.(*QueryServiceOIDCMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/FeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorship(ctx, req.(*QueryFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSponsorUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/FeeSponsorUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorUsage(ctx, req.(*QueryFeeSponsorUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExportCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExportCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ExportCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExportCredentials(ctx, req.(*QueryExportCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ImportedCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryImportedCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ImportedCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ImportedCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ImportedCredentials(ctx, req.(*QueryImportedCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Badges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Badges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/Badges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Badges(ctx, req.(*QueryBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BadgeIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadgeIssuersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BadgeIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/BadgeIssuers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BadgeIssuers(ctx, req.(*QueryBadgeIssuersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/AppManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppManifest(ctx, req.(*QueryAppManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOIDCClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/OIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OIDCClient(ctx, req.(*QueryOIDCClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OIDCClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOIDCClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OIDCClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/OIDCClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OIDCClients(ctx, req.(*QueryOIDCClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/AttestationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationPolicy(ctx, req.(*QueryAttestationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BackupAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackupAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BackupAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/BackupAnchors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackupAnchors(ctx, req.(*QueryBackupAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ViewKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryViewKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ViewKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ViewKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ViewKey(ctx, req.(*QueryViewKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ViewKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryViewKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ViewKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/ViewKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ViewKeys(ctx, req.(*QueryViewKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizeView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizeViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizeView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Query/AuthorizeView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizeView(ctx, req.(*QueryAuthorizeViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "svc.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DomainVerification",
+			Handler:    _Query_DomainVerification_Handler,
+		},
+		{
+			MethodName: "Service",
+			Handler:    _Query_Service_Handler,
+		},
+		{
+			MethodName: "ServicesByOwner",
+			Handler:    _Query_ServicesByOwner_Handler,
+		},
+		{
+			MethodName: "ServicesByDomain",
+			Handler:    _Query_ServicesByDomain_Handler,
+		},
+		{
+			MethodName: "ServiceOIDCDiscovery",
+			Handler:    _Query_ServiceOIDCDiscovery_Handler,
+		},
+		{
+			MethodName: "ServiceOIDCJWKS",
+			Handler:    _Query_ServiceOIDCJWKS_Handler,
+		},
+		{
+			MethodName: "ServiceOIDCMetadata",
+			Handler:    _Query_ServiceOIDCMetadata_Handler,
+		},
+		{
+			MethodName: "FeeSponsorship",
+			Handler:    _Query_FeeSponsorship_Handler,
+		},
+		{
+			MethodName: "FeeSponsorUsage",
+			Handler:    _Query_FeeSponsorUsage_Handler,
+		},
+		{
+			MethodName: "ExportCredentials",
+			Handler:    _Query_ExportCredentials_Handler,
+		},
+		{
+			MethodName: "ImportedCredentials",
+			Handler:    _Query_ImportedCredentials_Handler,
+		},
+		{
+			MethodName: "Badges",
+			Handler:    _Query_Badges_Handler,
+		},
+		{
+			MethodName: "BadgeIssuers",
+			Handler:    _Query_BadgeIssuers_Handler,
+		},
+		{
+			MethodName: "AppManifest",
+			Handler:    _Query_AppManifest_Handler,
+		},
+		{
+			MethodName: "OIDCClient",
+			Handler:    _Query_OIDCClient_Handler,
+		},
+		{
+			MethodName: "OIDCClients",
+			Handler:    _Query_OIDCClients_Handler,
+		},
+		{
+			MethodName: "AttestationPolicy",
+			Handler:    _Query_AttestationPolicy_Handler,
+		},
+		{
+			MethodName: "BackupAnchors",
+			Handler:    _Query_BackupAnchors_Handler,
+		},
+		{
+			MethodName: "ViewKey",
+			Handler:    _Query_ViewKey_Handler,
+		},
+		{
+			MethodName: "ViewKeys",
+			Handler:    _Query_ViewKeys_Handler,
+		},
+		{
+			MethodName: "AuthorizeView",
+			Handler:    _Query_AuthorizeView_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "svc/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainVerification != nil {
+		{
+			size, err := m.DomainVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicesByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicesByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicesByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicesByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicesByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicesByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicesByDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicesByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicesByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicesByDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicesByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicesByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceOIDCDiscoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOIDCDiscoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOIDCDiscoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceOIDCDiscoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOIDCDiscoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOIDCDiscoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OpTosUri) > 0 {
+		i -= len(m.OpTosUri)
+		copy(dAtA[i:], m.OpTosUri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OpTosUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.OpPolicyUri) > 0 {
+		i -= len(m.OpPolicyUri)
+		copy(dAtA[i:], m.OpPolicyUri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OpPolicyUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.RequireRequestUriRegistration {
+		i--
+		if m.RequireRequestUriRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RequestUriParameterSupported {
+		i--
+		if m.RequestUriParameterSupported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.RequestParameterSupported {
+		i--
+		if m.RequestParameterSupported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ClaimsLocalesSupported) > 0 {
+		for iNdEx := len(m.ClaimsLocalesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimsLocalesSupported[iNdEx])
+			copy(dAtA[i:], m.ClaimsLocalesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimsLocalesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.UiLocalesSupported) > 0 {
+		for iNdEx := len(m.UiLocalesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UiLocalesSupported[iNdEx])
+			copy(dAtA[i:], m.UiLocalesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.UiLocalesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ServiceDocumentation) > 0 {
+		i -= len(m.ServiceDocumentation)
+		copy(dAtA[i:], m.ServiceDocumentation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceDocumentation)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ResponseModesSupported) > 0 {
+		for iNdEx := len(m.ResponseModesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResponseModesSupported[iNdEx])
+			copy(dAtA[i:], m.ResponseModesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ResponseModesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ClaimsSupported) > 0 {
+		for iNdEx := len(m.ClaimsSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimsSupported[iNdEx])
+			copy(dAtA[i:], m.ClaimsSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimsSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TokenEndpointAuthMethodsSupported) > 0 {
+		for iNdEx := len(m.TokenEndpointAuthMethodsSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenEndpointAuthMethodsSupported[iNdEx])
+			copy(dAtA[i:], m.TokenEndpointAuthMethodsSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenEndpointAuthMethodsSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SubjectTypesSupported) > 0 {
+		for iNdEx := len(m.SubjectTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubjectTypesSupported[iNdEx])
+			copy(dAtA[i:], m.SubjectTypesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SubjectTypesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.IdTokenSigningAlgValuesSupported) > 0 {
+		for iNdEx := len(m.IdTokenSigningAlgValuesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IdTokenSigningAlgValuesSupported[iNdEx])
+			copy(dAtA[i:], m.IdTokenSigningAlgValuesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.IdTokenSigningAlgValuesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.GrantTypesSupported) > 0 {
+		for iNdEx := len(m.GrantTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GrantTypesSupported[iNdEx])
+			copy(dAtA[i:], m.GrantTypesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GrantTypesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ResponseTypesSupported) > 0 {
+		for iNdEx := len(m.ResponseTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResponseTypesSupported[iNdEx])
+			copy(dAtA[i:], m.ResponseTypesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ResponseTypesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScopesSupported) > 0 {
+		for iNdEx := len(m.ScopesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopesSupported[iNdEx])
+			copy(dAtA[i:], m.ScopesSupported[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RegistrationEndpoint) > 0 {
+		i -= len(m.RegistrationEndpoint)
+		copy(dAtA[i:], m.RegistrationEndpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RegistrationEndpoint)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UserinfoEndpoint) > 0 {
+		i -= len(m.UserinfoEndpoint)
+		copy(dAtA[i:], m.UserinfoEndpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserinfoEndpoint)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JwksUri) > 0 {
+		i -= len(m.JwksUri)
+		copy(dAtA[i:], m.JwksUri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JwksUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenEndpoint) > 0 {
+		i -= len(m.TokenEndpoint)
+		copy(dAtA[i:], m.TokenEndpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenEndpoint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthorizationEndpoint) > 0 {
+		i -= len(m.AuthorizationEndpoint)
+		copy(dAtA[i:], m.AuthorizationEndpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthorizationEndpoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceOIDCJWKSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOIDCJWKSRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOIDCJWKSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceOIDCJWKSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOIDCJWKSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOIDCJWKSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceOIDCMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOIDCMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOIDCMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceOIDCMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOIDCMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOIDCMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ServiceStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VerifiedDomain) > 0 {
+		i -= len(m.VerifiedDomain)
+		copy(dAtA[i:], m.VerifiedDomain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerifiedDomain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sponsorship != nil {
+		{
+			size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Export.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryImportedCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryImportedCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryImportedCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryImportedCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryImportedCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryImportedCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadgesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadgesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadgesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeInactive {
+		i--
+		if m.IncludeInactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadgeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadgeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadgeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Badge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Badges) > 0 {
+		for iNdEx := len(m.Badges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Badges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadgeIssuersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadgeIssuersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadgeIssuersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadgeIssuersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadgeIssuersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadgeIssuersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppManifestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppManifestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppManifestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppManifestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Manifest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOIDCClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOIDCClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOIDCClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOIDCClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOIDCClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOIDCClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOIDCClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOIDCClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOIDCClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOIDCClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOIDCClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOIDCClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackupAnchorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackupAnchorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackupAnchorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dataset) > 0 {
+		i -= len(m.Dataset)
+		copy(dAtA[i:], m.Dataset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Dataset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackupAnchorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackupAnchorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackupAnchorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Anchors) > 0 {
+		for iNdEx := len(m.Anchors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Anchors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryViewKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryViewKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryViewKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ViewKeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ViewKeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ViewKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ViewKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryViewKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryViewKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryViewKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ViewKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryViewKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryViewKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryViewKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryViewKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryViewKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryViewKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ViewKeys) > 0 {
+		for iNdEx := len(m.ViewKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ViewKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizeViewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizeViewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizeViewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizeViewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizeViewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizeViewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ViewKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainVerification != nil {
+		l = m.DomainVerification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServicesByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServicesByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServicesByDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServicesByDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOIDCDiscoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOIDCDiscoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AuthorizationEndpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenEndpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.JwksUri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UserinfoEndpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RegistrationEndpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ScopesSupported) > 0 {
+		for _, s := range m.ScopesSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ResponseTypesSupported) > 0 {
+		for _, s := range m.ResponseTypesSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GrantTypesSupported) > 0 {
+		for _, s := range m.GrantTypesSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.IdTokenSigningAlgValuesSupported) > 0 {
+		for _, s := range m.IdTokenSigningAlgValuesSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SubjectTypesSupported) > 0 {
+		for _, s := range m.SubjectTypesSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TokenEndpointAuthMethodsSupported) > 0 {
+		for _, s := range m.TokenEndpointAuthMethodsSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClaimsSupported) > 0 {
+		for _, s := range m.ClaimsSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ResponseModesSupported) > 0 {
+		for _, s := range m.ResponseModesSupported {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ServiceDocumentation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.UiLocalesSupported) > 0 {
+		for _, s := range m.UiLocalesSupported {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClaimsLocalesSupported) > 0 {
+		for _, s := range m.ClaimsLocalesSupported {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RequestParameterSupported {
+		n += 3
+	}
+	if m.RequestUriParameterSupported {
+		n += 3
+	}
+	if m.RequireRequestUriRegistration {
+		n += 3
+	}
+	l = len(m.OpPolicyUri)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OpTosUri)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOIDCJWKSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOIDCJWKSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceOIDCMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOIDCMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VerifiedDomain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ServiceStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceStatus))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sponsorship != nil {
+		l = m.Sponsorship.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExportCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExportCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Export.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryImportedCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryImportedCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBadgesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeInactive {
+		n += 2
+	}
+	return n
+}
+
+func (m *BadgeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Badge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBadgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Badges) > 0 {
+		for _, e := range m.Badges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBadgeIssuersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBadgeIssuersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for _, e := range m.Issuers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppManifestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppManifestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Manifest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOIDCClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOIDCClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Client.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOIDCClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOIDCClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestationPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBackupAnchorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Dataset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBackupAnchorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Anchors) > 0 {
+		for _, e := range m.Anchors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryViewKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *ViewKeyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ViewKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryViewKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ViewKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryViewKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryViewKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ViewKeys) > 0 {
+		for _, e := range m.ViewKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuthorizeViewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthorizeViewResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	l = m.ViewKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DomainVerification == nil {
+				m.DomainVerification = &DomainVerification{}
+			}
+			if err := m.DomainVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServicesByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServicesByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServicesByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServicesByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServicesByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServicesByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &Service{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServicesByDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServicesByDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServicesByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServicesByDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServicesByDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServicesByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &Service{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOIDCDiscoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizationEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JwksUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JwksUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserinfoEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserinfoEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopesSupported = append(m.ScopesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTypesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseTypesSupported = append(m.ResponseTypesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantTypesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantTypesSupported = append(m.GrantTypesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdTokenSigningAlgValuesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdTokenSigningAlgValuesSupported = append(m.IdTokenSigningAlgValuesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectTypesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectTypesSupported = append(m.SubjectTypesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenEndpointAuthMethodsSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenEndpointAuthMethodsSupported = append(m.TokenEndpointAuthMethodsSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimsSupported = append(m.ClaimsSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseModesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseModesSupported = append(m.ResponseModesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceDocumentation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceDocumentation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UiLocalesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UiLocalesSupported = append(m.UiLocalesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsLocalesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimsLocalesSupported = append(m.ClaimsLocalesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestParameterSupported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestParameterSupported = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestUriParameterSupported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestUriParameterSupported = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireRequestUriRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireRequestUriRegistration = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpPolicyUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpPolicyUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpTosUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpTosUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOIDCJWKSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOIDCJWKSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOIDCJWKSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOIDCJWKSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOIDCJWKSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOIDCJWKSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &JWK{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOIDCMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOIDCMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOIDCMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOIDCMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOIDCMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ServiceOIDCConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceStatus", wireType)
+			}
+			m.ServiceStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceStatus |= ServiceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.VerifiedDomain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.ServiceStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceStatus))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	return nil
+}
+func (m *QueryFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sponsorship == nil {
+				m.Sponsorship = &FeeSponsorship{}
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,12 +8910,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2568,7 +9005,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,15 +9028,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2626,10 +9063,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.Usage = append(m.Usage, &FeeSponsorUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2654,7 +9125,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDomainVerificationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExportCredentialsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2677,15 +9148,15 @@ func (m *QueryDomainVerificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDomainVerificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExportCredentialsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDomainVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExportCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2713,7 +9184,7 @@ func (m *QueryDomainVerificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2736,7 +9207,7 @@ func (m *QueryDomainVerificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDomainVerificationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExportCredentialsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2759,15 +9230,15 @@ func (m *QueryDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDomainVerificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExportCredentialsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExportCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainVerification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2794,10 +9265,7 @@ func (m *QueryDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DomainVerification == nil {
-				m.DomainVerification = &DomainVerification{}
-			}
-			if err := m.DomainVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Export.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2822,7 +9290,7 @@ func (m *QueryDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryImportedCredentialsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,10 +9313,10 @@ func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryImportedCredentialsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryImportedCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2883,6 +9351,42 @@ func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2904,7 +9408,7 @@ func (m *QueryServiceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryImportedCredentialsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2927,15 +9431,15 @@ func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryImportedCredentialsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryImportedCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2962,10 +9466,44 @@ func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			m.Credentials = append(m.Credentials, &ImportedCredential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2990,7 +9528,7 @@ func (m *QueryServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServicesByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBadgesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3013,15 +9551,15 @@ func (m *QueryServicesByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServicesByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadgesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServicesByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadgesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3049,8 +9587,28 @@ func (m *QueryServicesByOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeInactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeInactive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3072,7 +9630,7 @@ func (m *QueryServicesByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServicesByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *BadgeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3095,15 +9653,15 @@ func (m *QueryServicesByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServicesByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadgeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServicesByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Badge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3130,11 +9688,30 @@ func (m *QueryServicesByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &Service{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Badge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3156,7 +9733,7 @@ func (m *QueryServicesByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServicesByDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBadgesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3179,17 +9756,17 @@ func (m *QueryServicesByDomainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServicesByDomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadgesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServicesByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Badges", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3199,23 +9776,25 @@ func (m *QueryServicesByDomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Badges = append(m.Badges, BadgeInfo{})
+			if err := m.Badges[len(m.Badges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3238,7 +9817,7 @@ func (m *QueryServicesByDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServicesByDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBadgeIssuersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3261,15 +9840,15 @@ func (m *QueryServicesByDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServicesByDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadgeIssuersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServicesByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadgeIssuersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3296,8 +9875,10 @@ func (m *QueryServicesByDomainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &Service{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3322,7 +9903,7 @@ func (m *QueryServicesByDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOIDCDiscoveryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBadgeIssuersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3345,17 +9926,17 @@ func (m *QueryServiceOIDCDiscoveryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadgeIssuersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadgeIssuersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3365,23 +9946,61 @@ func (m *QueryServiceOIDCDiscoveryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.Issuers = append(m.Issuers, BadgeIssuer{})
+			if err := m.Issuers[len(m.Issuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3404,7 +10023,7 @@ func (m *QueryServiceOIDCDiscoveryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAppManifestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,79 +10046,15 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAppManifestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOIDCDiscoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAppManifestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationEndpoint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthorizationEndpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenEndpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3527,13 +10082,63 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenEndpoint = string(dAtA[iNdEx:postIndex])
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JwksUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3543,27 +10148,28 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JwksUri = string(dAtA[iNdEx:postIndex])
+			if err := m.Manifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserinfoEndpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3591,13 +10197,13 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserinfoEndpoint = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationEndpoint", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3607,27 +10213,65 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistrationEndpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOIDCClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOIDCClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3655,13 +10299,63 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopesSupported = append(m.ScopesSupported, string(dAtA[iNdEx:postIndex]))
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOIDCClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOIDCClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTypesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3671,27 +10365,28 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponseTypesSupported = append(m.ResponseTypesSupported, string(dAtA[iNdEx:postIndex]))
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrantTypesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3719,13 +10414,13 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GrantTypesSupported = append(m.GrantTypesSupported, string(dAtA[iNdEx:postIndex]))
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdTokenSigningAlgValuesSupported", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3735,27 +10430,65 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdTokenSigningAlgValuesSupported = append(m.IdTokenSigningAlgValuesSupported, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOIDCClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOIDCClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOIDCClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectTypesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3783,45 +10516,63 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubjectTypesSupported = append(m.SubjectTypesSupported, string(dAtA[iNdEx:postIndex]))
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenEndpointAuthMethodsSupported", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOIDCClientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenEndpointAuthMethodsSupported = append(m.TokenEndpointAuthMethodsSupported, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOIDCClientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOIDCClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3831,27 +10582,79 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimsSupported = append(m.ClaimsSupported, string(dAtA[iNdEx:postIndex]))
+			m.Clients = append(m.Clients, OIDCClient{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseModesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3879,13 +10682,63 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponseModesSupported = append(m.ResponseModesSupported, string(dAtA[iNdEx:postIndex]))
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceDocumentation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3895,27 +10748,78 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceDocumentation = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackupAnchorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackupAnchorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackupAnchorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UiLocalesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3943,11 +10847,11 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UiLocalesSupported = append(m.UiLocalesSupported, string(dAtA[iNdEx:postIndex]))
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsLocalesSupported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dataset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3975,13 +10879,13 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimsLocalesSupported = append(m.ClaimsLocalesSupported, string(dAtA[iNdEx:postIndex]))
+			m.Dataset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestParameterSupported", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3991,17 +10895,83 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequestParameterSupported = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestUriParameterSupported", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackupAnchorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackupAnchorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackupAnchorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anchors", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4011,17 +10981,31 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequestUriParameterSupported = bool(v != 0)
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireRequestUriRegistration", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Anchors = append(m.Anchors, BackupAnchor{})
+			if err := m.Anchors[len(m.Anchors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4031,17 +11015,83 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequireRequestUriRegistration = bool(v != 0)
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpPolicyUri", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryViewKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryViewKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryViewKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4051,29 +11101,66 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpPolicyUri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 22:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ViewKeyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ViewKeyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ViewKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpTosUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4083,24 +11170,45 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpTosUri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if err := m.ViewKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4122,7 +11230,7 @@ func (m *QueryServiceOIDCDiscoveryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOIDCJWKSRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryViewKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4145,17 +11253,17 @@ func (m *QueryServiceOIDCJWKSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOIDCJWKSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryViewKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOIDCJWKSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryViewKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4165,23 +11273,24 @@ func (m *QueryServiceOIDCJWKSRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			if err := m.ViewKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4204,7 +11313,7 @@ func (m *QueryServiceOIDCJWKSRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOIDCJWKSResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryViewKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4227,17 +11336,17 @@ func (m *QueryServiceOIDCJWKSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOIDCJWKSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryViewKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOIDCJWKSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryViewKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4247,25 +11356,23 @@ func (m *QueryServiceOIDCJWKSResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &JWK{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4288,7 +11395,7 @@ func (m *QueryServiceOIDCJWKSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOIDCMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryViewKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4311,17 +11418,17 @@ func (m *QueryServiceOIDCMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOIDCMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryViewKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOIDCMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryViewKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4331,23 +11438,25 @@ func (m *QueryServiceOIDCMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.ViewKeys = append(m.ViewKeys, ViewKeyInfo{})
+			if err := m.ViewKeys[len(m.ViewKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4370,7 +11479,7 @@ func (m *QueryServiceOIDCMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthorizeViewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4393,17 +11502,17 @@ func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOIDCMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthorizeViewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOIDCMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthorizeViewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4413,31 +11522,14 @@ func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &ServiceOIDCConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4465,13 +11557,13 @@ func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifiedDomain = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			m.ServiceStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4481,14 +11573,77 @@ func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceStatus |= ServiceStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizeViewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizeViewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizeViewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4515,103 +11670,9 @@ func (m *QueryServiceOIDCMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ViewKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,264 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sonr-io/sonr/app/msgcatalog"
+)
+
+// DefaultConsentLanguage is used when no requested language has a translation
+const DefaultConsentLanguage = "en"
+
+// ScopeRisk classifies how much a scope exposes, so consent screens can
+// highlight sensitive grants consistently.
+type ScopeRisk string
+
+const (
+	ScopeRiskLow    ScopeRisk = "low"
+	ScopeRiskMedium ScopeRisk = "medium"
+	ScopeRiskHigh   ScopeRisk = "high"
+)
+
+// ScopeDefinition describes a permission scope services can request. Names
+// follow resource:action[:qualifier]; a "{name}" qualifier is a parameter
+// filled in by the requesting service, e.g. dwn:write:{schema} matches
+// dwn:write:schema-x.
+type ScopeDefinition struct {
+	Name string    `json:"name"`
+	Risk ScopeRisk `json:"risk"`
+
+	// UCANResource is the resource URI template granted when the scope is
+	// issued as a UCAN; "{did}" is replaced with the user's DID.
+	UCANResource  string   `json:"ucan_resource"`
+	UCANAbilities []string `json:"ucan_abilities"`
+
+	// Consent holds the consent sentence per language tag. Parameters in the
+	// name may be referenced by the text.
+	Consent map[string]string `json:"consent"`
+}
+
+// Param returns the parameter name of the qualifier, if it is parameterized
+func (d ScopeDefinition) Param() string {
+	parts := strings.Split(d.Name, ":")
+	last := parts[len(parts)-1]
+	if len(parts) == 3 && strings.HasPrefix(last, "{") && strings.HasSuffix(last, "}") {
+		return strings.Trim(last, "{}")
+	}
+	return ""
+}
+
+// RequestedScope is a scope string resolved against the catalog
+type RequestedScope struct {
+	Name       string
+	Definition ScopeDefinition
+	// Value is the qualifier supplied for a parameterized scope
+	Value string
+}
+
+// ConsentText returns the consent sentence in the first of langs that has a
+// translation, falling back to DefaultConsentLanguage.
+func (r RequestedScope) ConsentText(langs ...string) string {
+	text := r.Definition.Consent[DefaultConsentLanguage]
+	for _, lang := range langs {
+		if t, ok := r.Definition.Consent[lang]; ok {
+			text = t
+			break
+		}
+		if base, _, found := strings.Cut(lang, "-"); found {
+			if t, ok := r.Definition.Consent[base]; ok {
+				text = t
+				break
+			}
+		}
+	}
+	if p := r.Definition.Param(); p != "" {
+		text = strings.ReplaceAll(text, "{"+p+"}", r.Value)
+	}
+	return text
+}
+
+// ConsentArgs returns the arguments of the consent text, the qualifier of a
+// parameterized scope
+func (r RequestedScope) ConsentArgs() map[string]string {
+	if p := r.Definition.Param(); p != "" {
+		return map[string]string{p: r.Value}
+	}
+	return nil
+}
+
+// UCANResourceFor returns the UCAN resource URI granted to a service for did
+func (r RequestedScope) UCANResourceFor(did string) string {
+	resource := strings.ReplaceAll(r.Definition.UCANResource, "{did}", did)
+	if p := r.Definition.Param(); p != "" {
+		resource = strings.ReplaceAll(resource, "{"+p+"}", r.Value)
+	}
+	return resource
+}
+
+// ScopeCatalog is the set of scopes services may request
+type ScopeCatalog map[string]ScopeDefinition
+
+// Names returns the scope names in sorted order
+func (c ScopeCatalog) Names() []string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Resolve matches a requested scope against the catalog, filling in the
+// qualifier of parameterized scopes.
+func (c ScopeCatalog) Resolve(scope string) (RequestedScope, error) {
+	if def, ok := c[scope]; ok && def.Param() == "" {
+		return RequestedScope{Name: scope, Definition: def}, nil
+	}
+
+	parts := strings.Split(scope, ":")
+	if len(parts) == 3 && parts[2] != "" && !strings.ContainsAny(parts[2], "{}") {
+		for _, def := range c {
+			p := def.Param()
+			if p != "" && def.Name == fmt.Sprintf("%s:%s:{%s}", parts[0], parts[1], p) {
+				return RequestedScope{Name: scope, Definition: def, Value: parts[2]}, nil
+			}
+		}
+	}
+	return RequestedScope{}, fmt.Errorf("unknown scope: %s", scope)
+}
+
+// ConsentKey returns the message catalog key of a scope's consent text
+func ConsentKey(scope string) string {
+	return "consent." + scope
+}
+
+// Messages returns the consent text of every scope keyed by ConsentKey, the
+// built-in copy of a message catalog
+func (c ScopeCatalog) Messages() map[string]msgcatalog.Translations {
+	out := make(map[string]msgcatalog.Translations, len(c))
+	for name, def := range c {
+		out[ConsentKey(name)] = msgcatalog.Translations(def.Consent)
+	}
+	return out
+}
+
+// ResolveAll resolves every scope, rejecting unknown or duplicate entries
+func (c ScopeCatalog) ResolveAll(scopes []string) ([]RequestedScope, error) {
+	seen := make(map[string]bool, len(scopes))
+	out := make([]RequestedScope, 0, len(scopes))
+	for _, s := range scopes {
+		if seen[s] {
+			return nil, fmt.Errorf("duplicate scope: %s", s)
+		}
+		seen[s] = true
+
+		r, err := c.Resolve(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// DefaultScopeCatalog returns the scopes understood by Sonr consent flows
+func DefaultScopeCatalog() ScopeCatalog {
+	defs := []ScopeDefinition{
+		{
+			Name:          "profile:read",
+			Risk:          ScopeRiskLow,
+			UCANResource:  "profile:{did}",
+			UCANAbilities: []string{UCANRead},
+			Consent: map[string]string{
+				"en": "See your profile name and avatar",
+				"es": "Ver el nombre y el avatar de tu perfil",
+				"fr": "Voir le nom et l'avatar de votre profil",
+			},
+		},
+		{
+			Name:          "profile:write",
+			Risk:          ScopeRiskMedium,
+			UCANResource:  "profile:{did}",
+			UCANAbilities: []string{UCANUpdate},
+			Consent: map[string]string{
+				"en": "Update your profile name and avatar",
+				"es": "Actualizar el nombre y el avatar de tu perfil",
+				"fr": "Modifier le nom et l'avatar de votre profil",
+			},
+		},
+		{
+			Name:          "did:read",
+			Risk:          ScopeRiskLow,
+			UCANResource:  "did:{did}",
+			UCANAbilities: []string{UCANRead},
+			Consent: map[string]string{
+				"en": "See your identity document and linked accounts",
+				"es": "Ver tu documento de identidad y las cuentas vinculadas",
+				"fr": "Voir votre document d'identité et vos comptes liés",
+			},
+		},
+		{
+			Name:          "wallet:read",
+			Risk:          ScopeRiskMedium,
+			UCANResource:  "account:{did}",
+			UCANAbilities: []string{UCANRead},
+			Consent: map[string]string{
+				"en": "See your wallet balances and transaction history",
+				"es": "Ver los saldos y el historial de transacciones de tu billetera",
+				"fr": "Voir les soldes et l'historique des transactions de votre portefeuille",
+			},
+		},
+		{
+			Name:          "wallet:sign:limited",
+			Risk:          ScopeRiskHigh,
+			UCANResource:  "account:{did}",
+			UCANAbilities: []string{UCANExecOnBehalf},
+			Consent: map[string]string{
+				"en": "Sign allow-listed transactions for you within your spending limits",
+				"es": "Firmar transacciones permitidas en tu nombre dentro de tus límites de gasto",
+				"fr": "Signer des transactions autorisées en votre nom dans vos limites de dépenses",
+			},
+		},
+		{
+			Name:          "dwn:read:{schema}",
+			Risk:          ScopeRiskMedium,
+			UCANResource:  "dwn:{did}/{schema}",
+			UCANAbilities: []string{UCANRead},
+			Consent: map[string]string{
+				"en": "Read your {schema} records",
+				"es": "Leer tus registros de {schema}",
+				"fr": "Lire vos enregistrements {schema}",
+			},
+		},
+		{
+			Name:          "dwn:write:{schema}",
+			Risk:          ScopeRiskHigh,
+			UCANResource:  "dwn:{did}/{schema}",
+			UCANAbilities: []string{UCANCreate, UCANUpdate},
+			Consent: map[string]string{
+				"en": "Create and update your {schema} records",
+				"es": "Crear y actualizar tus registros de {schema}",
+				"fr": "Créer et modifier vos enregistrements {schema}",
+			},
+		},
+		{
+			Name:          "view:dex-history",
+			Risk:          ScopeRiskMedium,
+			UCANResource:  "view:{did}",
+			UCANAbilities: []string{UCANView},
+			Consent: map[string]string{
+				"en": "See your trading history",
+				"es": "Ver tu historial de operaciones",
+				"fr": "Voir votre historique d'échanges",
+			},
+		},
+	}
+
+	catalog := make(ScopeCatalog, len(defs))
+	for _, def := range defs {
+		catalog[def.Name] = def
+	}
+	return catalog
+}
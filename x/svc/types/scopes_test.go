@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestScopeCatalogResolve(t *testing.T) {
+	catalog := types.DefaultScopeCatalog()
+
+	r, err := catalog.Resolve("profile:read")
+	require.NoError(t, err)
+	require.Equal(t, types.ScopeRiskLow, r.Definition.Risk)
+	require.Equal(t, "profile:did:sonr:alice", r.UCANResourceFor("did:sonr:alice"))
+
+	r, err = catalog.Resolve("dwn:write:schema-x")
+	require.NoError(t, err)
+	require.Equal(t, "schema-x", r.Value)
+	require.Equal(t, "Create and update your schema-x records", r.ConsentText())
+	require.Equal(t, "dwn:did:sonr:alice/schema-x", r.UCANResourceFor("did:sonr:alice"))
+
+	_, err = catalog.Resolve("dwn:write:")
+	require.Error(t, err)
+	_, err = catalog.Resolve("dwn:write:{schema}")
+	require.Error(t, err)
+	_, err = catalog.Resolve("wallet:drain")
+	require.Error(t, err)
+
+	_, err = catalog.ResolveAll([]string{"profile:read", "profile:read"})
+	require.Error(t, err)
+}
+
+func TestScopeConsentLanguages(t *testing.T) {
+	r, err := types.DefaultScopeCatalog().Resolve("dwn:read:photos")
+	require.NoError(t, err)
+
+	require.Equal(t, "Leer tus registros de photos", r.ConsentText("es-MX"))
+	require.Equal(t, "Lire vos enregistrements photos", r.ConsentText("de", "fr"))
+	require.Equal(t, "Read your photos records", r.ConsentText("ja"))
+}
+
+func TestDefaultScopeCatalogComplete(t *testing.T) {
+	for _, name := range types.DefaultScopeCatalog().Names() {
+		def := types.DefaultScopeCatalog()[name]
+		require.NotEmpty(t, def.Consent[types.DefaultConsentLanguage], name)
+		require.NotEmpty(t, def.UCANAbilities, name)
+	}
+}
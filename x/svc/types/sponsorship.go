@@ -0,0 +1,37 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// FeeSponsorshipsPrefix is the store prefix for fee sponsorships by service ID
+	FeeSponsorshipsPrefix = collections.NewPrefix(5)
+	// FeeSponsorsByPayerPrefix is the store prefix for the payer index of fee sponsorships
+	FeeSponsorsByPayerPrefix = collections.NewPrefix(6)
+	// FeeSponsorUsagePrefix is the store prefix for per-user sponsored fee usage
+	FeeSponsorUsagePrefix = collections.NewPrefix(7)
+)
+
+// Remaining returns the part of the budget not yet spent
+func (m FeeSponsorship) Remaining() sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, coin := range m.Budget {
+		if left := coin.Amount.Sub(m.Spent.AmountOf(coin.Denom)); left.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, left))
+		}
+	}
+	return remaining
+}
+
+// Covers reports whether the remaining budget can pay fee
+func (m FeeSponsorship) Covers(fee sdk.Coins) bool {
+	return m.Budget.IsAllGTE(m.Spent.Add(fee...))
+}
+
+// Exhausted reports whether the spent fees have reached the budget in every
+// denom
+func (m FeeSponsorship) Exhausted() bool {
+	return m.Spent.IsAllGTE(m.Budget)
+}
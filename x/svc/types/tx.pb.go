@@ -7,7 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -487,742 +491,9413 @@ func (m *MsgRegisterServiceResponse) GetServiceId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "svc.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "svc.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgInitiateDomainVerification)(nil), "svc.v1.MsgInitiateDomainVerification")
-	proto.RegisterType((*MsgInitiateDomainVerificationResponse)(nil), "svc.v1.MsgInitiateDomainVerificationResponse")
-	proto.RegisterType((*MsgVerifyDomain)(nil), "svc.v1.MsgVerifyDomain")
-	proto.RegisterType((*MsgVerifyDomainResponse)(nil), "svc.v1.MsgVerifyDomainResponse")
-	proto.RegisterType((*MsgRegisterService)(nil), "svc.v1.MsgRegisterService")
-	proto.RegisterType((*MsgRegisterServiceResponse)(nil), "svc.v1.MsgRegisterServiceResponse")
+// MsgExecOnBehalf executes messages as a user on behalf of a service
+type MsgExecOnBehalf struct {
+	// Address of the service operator submitting the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// DID of the submitting service; the UCAN audience must match it
+	ServiceDid string `protobuf:"bytes,2,opt,name=service_did,json=serviceDid,proto3" json:"service_did,omitempty"`
+	// DID the messages are executed as
+	Did string `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	// UCAN token granting exec-on-behalf for did. Not required when the sender
+	// controls did.
+	UcanToken string `protobuf:"bytes,4,opt,name=ucan_token,json=ucanToken,proto3" json:"ucan_token,omitempty"`
+	// Messages to execute; each must act for did
+	Msgs []*types.Any `protobuf:"bytes,5,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func init() { proto.RegisterFile("svc/v1/tx.proto", fileDescriptor_084252b8c07dd202) }
-
-var fileDescriptor_084252b8c07dd202 = []byte{
-	// 656 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x4e, 0x1b, 0x4d,
-	0x14, 0xf5, 0xf2, 0x63, 0xf0, 0x7c, 0x08, 0xeb, 0x1b, 0x20, 0x98, 0x95, 0x30, 0x68, 0x23, 0x14,
-	0x84, 0x62, 0xaf, 0x30, 0x52, 0x0a, 0xa4, 0x14, 0x01, 0x0a, 0x28, 0xac, 0x90, 0x25, 0x49, 0x91,
-	0x66, 0xb5, 0xec, 0x4c, 0xc6, 0x23, 0xd8, 0x99, 0xcd, 0xdc, 0xb1, 0x85, 0xab, 0x44, 0x69, 0xd2,
-	0xe6, 0x51, 0x28, 0xf2, 0x10, 0x94, 0x28, 0x55, 0xaa, 0x28, 0x82, 0x82, 0x37, 0x48, 0x1d, 0xed,
-	0xaf, 0x17, 0x13, 0x20, 0x8a, 0x94, 0xca, 0xbe, 0xf7, 0x9c, 0xb9, 0xe7, 0x9c, 0x9d, 0x1f, 0x54,
-	0x85, 0x9e, 0x6f, 0xf7, 0xd6, 0x6d, 0x7d, 0xd2, 0x0c, 0x95, 0xd4, 0x12, 0x97, 0xa1, 0xe7, 0x37,
-	0x7b, 0xeb, 0xe6, 0xbc, 0x2f, 0x21, 0x90, 0x60, 0x07, 0xc0, 0x22, 0x3c, 0x00, 0x96, 0x10, 0xcc,
-	0x85, 0x04, 0x70, 0xe3, 0xca, 0x4e, 0x8a, 0x14, 0x9a, 0x65, 0x92, 0xc9, 0xa4, 0x1f, 0xfd, 0xcb,
-	0xba, 0xa9, 0x04, 0xa3, 0x82, 0x02, 0x4f, 0xb9, 0xd6, 0x27, 0x03, 0x55, 0xdb, 0xc0, 0x5e, 0x85,
-	0xc4, 0xd3, 0x74, 0xdf, 0x53, 0x5e, 0x00, 0xf8, 0x09, 0xaa, 0x78, 0x5d, 0xdd, 0x91, 0x8a, 0xeb,
-	0x7e, 0xcd, 0x58, 0x36, 0x56, 0x2b, 0x5b, 0xb5, 0xaf, 0x5f, 0x1a, 0xb3, 0xa9, 0xc8, 0x33, 0x42,
-	0x14, 0x05, 0x38, 0xd0, 0x8a, 0x0b, 0xe6, 0x0c, 0xa8, 0xf8, 0x31, 0x2a, 0x87, 0xf1, 0x84, 0xda,
-	0xc8, 0xb2, 0xb1, 0xfa, 0x5f, 0x6b, 0xba, 0x99, 0x84, 0x68, 0x26, 0x73, 0xb7, 0xc6, 0xce, 0xbe,
-	0x2f, 0x95, 0x9c, 0x94, 0xb3, 0x39, 0xfd, 0xf1, 0xea, 0x74, 0x6d, 0xb0, 0xda, 0x5a, 0x40, 0xf3,
-	0x43, 0x46, 0x1c, 0x0a, 0xa1, 0x14, 0x40, 0xad, 0x3e, 0x5a, 0x6c, 0x03, 0xdb, 0x13, 0x5c, 0x73,
-	0x4f, 0xd3, 0x1d, 0x19, 0x78, 0x5c, 0xbc, 0xa6, 0x8a, 0xbf, 0xe5, 0xbe, 0xa7, 0xb9, 0x14, 0xb8,
-	0x85, 0x26, 0x7c, 0x45, 0x3d, 0x2d, 0xd5, 0xbd, 0x7e, 0x33, 0x22, 0x7e, 0x80, 0xca, 0x24, 0x9e,
-	0x14, 0xbb, 0xad, 0x38, 0x69, 0xb5, 0x39, 0x15, 0xf9, 0xca, 0x58, 0xd6, 0x7b, 0xb4, 0x72, 0xa7,
-	0x74, 0xe6, 0x11, 0x37, 0x10, 0xee, 0x15, 0xfa, 0xae, 0x96, 0x47, 0x54, 0x24, 0x6e, 0x9c, 0xff,
-	0x8b, 0xc8, 0xcb, 0x08, 0xc0, 0x8f, 0x50, 0x95, 0x08, 0x70, 0xb9, 0x00, 0xad, 0xba, 0x7e, 0xd4,
-	0x4f, 0x6d, 0x4c, 0x13, 0x01, 0x7b, 0x83, 0xae, 0x75, 0x14, 0xef, 0x4f, 0x2c, 0xd9, 0x4f, 0xe4,
-	0xff, 0x61, 0xda, 0xe7, 0xf1, 0x1e, 0x14, 0xc5, 0xf2, 0x7c, 0x26, 0x9a, 0x4c, 0x52, 0x50, 0x12,
-	0xab, 0x4e, 0x3a, 0x79, 0x8d, 0x6b, 0x68, 0x22, 0xa0, 0x00, 0x1e, 0xa3, 0xe9, 0xf4, 0xac, 0xb4,
-	0x7e, 0x1a, 0x08, 0xb7, 0x81, 0x39, 0x94, 0x71, 0xd0, 0x54, 0x1d, 0x50, 0xd5, 0xe3, 0x3e, 0xfd,
-	0xab, 0x04, 0x8b, 0x08, 0x41, 0xb2, 0xdc, 0xe5, 0x24, 0xd5, 0xa9, 0xa4, 0x9d, 0x3d, 0x52, 0x08,
-	0x38, 0x5a, 0x0c, 0x88, 0x37, 0xd0, 0x9c, 0xa2, 0xef, 0xba, 0x14, 0x34, 0x25, 0x6e, 0x48, 0x55,
-	0xc0, 0x01, 0xb8, 0x14, 0x50, 0x1b, 0x5b, 0x1e, 0x5d, 0xad, 0x38, 0xb3, 0x39, 0xb8, 0x3f, 0xc0,
-	0x70, 0x0b, 0xcd, 0x75, 0x7d, 0x4f, 0xb8, 0x84, 0x1e, 0x53, 0x96, 0xec, 0xa7, 0xdf, 0x89, 0x66,
-	0x8f, 0xc7, 0xb3, 0x67, 0x22, 0x70, 0x27, 0xc7, 0xb6, 0x3b, 0x37, 0xbf, 0xe4, 0x11, 0x32, 0x6f,
-	0xe6, 0xce, 0x3f, 0x66, 0x13, 0xcd, 0x28, 0x29, 0xb5, 0xeb, 0x7b, 0xa1, 0x77, 0xc8, 0x8f, 0xb9,
-	0xee, 0xbb, 0x3e, 0x27, 0xd9, 0x69, 0x89, 0xa0, 0xed, 0x1c, 0xd9, 0xe6, 0xe4, 0x9e, 0xec, 0xad,
-	0xab, 0x11, 0x34, 0xda, 0x06, 0x86, 0x77, 0xd1, 0xd4, 0xb5, 0x8b, 0x3c, 0x9f, 0x5d, 0xc0, 0xa1,
-	0x8b, 0x65, 0x2e, 0xdd, 0x02, 0xe4, 0x06, 0x15, 0x32, 0xef, 0xb8, 0x6e, 0x2b, 0x85, 0xe5, 0xb7,
-	0xd3, 0xcc, 0xc6, 0x1f, 0xd1, 0x72, 0xcd, 0x5d, 0x34, 0x75, 0xed, 0x98, 0x17, 0xdd, 0x17, 0x81,
-	0x6b, 0xee, 0x7f, 0x7b, 0x56, 0x5f, 0xa0, 0xea, 0xf0, 0x89, 0x33, 0x0b, 0x6b, 0x86, 0x30, 0xd3,
-	0xba, 0x1d, 0xcb, 0x46, 0x9a, 0xe3, 0x1f, 0xae, 0x4e, 0xd7, 0x8c, 0xad, 0xa7, 0x67, 0x17, 0x75,
-	0xe3, 0xfc, 0xa2, 0x6e, 0xfc, 0xb8, 0xa8, 0x1b, 0x9f, 0x2f, 0xeb, 0xa5, 0xf3, 0xcb, 0x7a, 0xe9,
-	0xdb, 0x65, 0xbd, 0xf4, 0xe6, 0x21, 0xe3, 0xba, 0xd3, 0x3d, 0x6c, 0xfa, 0x32, 0xb0, 0x41, 0x0a,
-	0xd5, 0xe0, 0x32, 0xfe, 0xb5, 0x4f, 0xec, 0xe8, 0xe1, 0xd5, 0xfd, 0x90, 0xc2, 0x61, 0x39, 0x7e,
-	0x74, 0x37, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x72, 0x4c, 0x0b, 0xe8, 0xef, 0x05, 0x00, 0x00,
+func (m *MsgExecOnBehalf) Reset()         { *m = MsgExecOnBehalf{} }
+func (m *MsgExecOnBehalf) String() string { return proto.CompactTextString(m) }
+func (*MsgExecOnBehalf) ProtoMessage()    {}
+func (*MsgExecOnBehalf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{8}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// InitiateDomainVerification starts the domain verification process
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "svc_docs.md"}}
-	InitiateDomainVerification(ctx context.Context, in *MsgInitiateDomainVerification, opts ...grpc.CallOption) (*MsgInitiateDomainVerificationResponse, error)
-	// VerifyDomain completes domain verification by checking DNS TXT records
-	VerifyDomain(ctx context.Context, in *MsgVerifyDomain, opts ...grpc.CallOption) (*MsgVerifyDomainResponse, error)
-	// RegisterService registers a new service with verified domain binding
-	RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error)
+func (m *MsgExecOnBehalf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgExecOnBehalf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecOnBehalf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgExecOnBehalf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecOnBehalf.Merge(m, src)
+}
+func (m *MsgExecOnBehalf) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecOnBehalf) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecOnBehalf.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExecOnBehalf proto.InternalMessageInfo
+
+func (m *MsgExecOnBehalf) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) InitiateDomainVerification(ctx context.Context, in *MsgInitiateDomainVerification, opts ...grpc.CallOption) (*MsgInitiateDomainVerificationResponse, error) {
-	out := new(MsgInitiateDomainVerificationResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Msg/InitiateDomainVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecOnBehalf) GetServiceDid() string {
+	if m != nil {
+		return m.ServiceDid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) VerifyDomain(ctx context.Context, in *MsgVerifyDomain, opts ...grpc.CallOption) (*MsgVerifyDomainResponse, error) {
-	out := new(MsgVerifyDomainResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Msg/VerifyDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecOnBehalf) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error) {
-	out := new(MsgRegisterServiceResponse)
-	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RegisterService", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecOnBehalf) GetUcanToken() string {
+	if m != nil {
+		return m.UcanToken
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// InitiateDomainVerification starts the domain verification process
-	//
-	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
-	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
-	//
-	// {{import "svc_docs.md"}}
-	InitiateDomainVerification(context.Context, *MsgInitiateDomainVerification) (*MsgInitiateDomainVerificationResponse, error)
-	// VerifyDomain completes domain verification by checking DNS TXT records
-	VerifyDomain(context.Context, *MsgVerifyDomain) (*MsgVerifyDomainResponse, error)
-	// RegisterService registers a new service with verified domain binding
-	RegisterService(context.Context, *MsgRegisterService) (*MsgRegisterServiceResponse, error)
+func (m *MsgExecOnBehalf) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgExecOnBehalfResponse defines the response for delegated execution
+type MsgExecOnBehalfResponse struct {
+	// Data returned by each executed message
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgExecOnBehalfResponse) Reset()         { *m = MsgExecOnBehalfResponse{} }
+func (m *MsgExecOnBehalfResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecOnBehalfResponse) ProtoMessage()    {}
+func (*MsgExecOnBehalfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{9}
 }
-func (*UnimplementedMsgServer) InitiateDomainVerification(ctx context.Context, req *MsgInitiateDomainVerification) (*MsgInitiateDomainVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiateDomainVerification not implemented")
+func (m *MsgExecOnBehalfResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) VerifyDomain(ctx context.Context, req *MsgVerifyDomain) (*MsgVerifyDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+func (m *MsgExecOnBehalfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecOnBehalfResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RegisterService(ctx context.Context, req *MsgRegisterService) (*MsgRegisterServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterService not implemented")
+func (m *MsgExecOnBehalfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecOnBehalfResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgExecOnBehalfResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecOnBehalfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecOnBehalfResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgExecOnBehalfResponse proto.InternalMessageInfo
+
+func (m *MsgExecOnBehalfResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_InitiateDomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitiateDomainVerification)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitiateDomainVerification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Msg/InitiateDomainVerification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitiateDomainVerification(ctx, req.(*MsgInitiateDomainVerification))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetOnBehalfOptOut refuses delegated execution for a DID
+type MsgSetOnBehalfOptOut struct {
+	// Address controlling the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// DID opting out
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// Services refused; empty refuses every service
+	ServiceIds []string `protobuf:"bytes,3,rep,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
 }
 
-func _Msg_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVerifyDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VerifyDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Msg/VerifyDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VerifyDomain(ctx, req.(*MsgVerifyDomain))
+func (m *MsgSetOnBehalfOptOut) Reset()         { *m = MsgSetOnBehalfOptOut{} }
+func (m *MsgSetOnBehalfOptOut) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOnBehalfOptOut) ProtoMessage()    {}
+func (*MsgSetOnBehalfOptOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{10}
+}
+func (m *MsgSetOnBehalfOptOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOnBehalfOptOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOnBehalfOptOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetOnBehalfOptOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOnBehalfOptOut.Merge(m, src)
+}
+func (m *MsgSetOnBehalfOptOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOnBehalfOptOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOnBehalfOptOut.DiscardUnknown(m)
 }
 
-func _Msg_RegisterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/svc.v1.Msg/RegisterService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterService(ctx, req.(*MsgRegisterService))
+var xxx_messageInfo_MsgSetOnBehalfOptOut proto.InternalMessageInfo
+
+func (m *MsgSetOnBehalfOptOut) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "svc.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "InitiateDomainVerification",
-			Handler:    _Msg_InitiateDomainVerification_Handler,
-		},
-		{
-			MethodName: "VerifyDomain",
-			Handler:    _Msg_VerifyDomain_Handler,
-		},
-		{
-			MethodName: "RegisterService",
-			Handler:    _Msg_RegisterService_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "svc/v1/tx.proto",
+func (m *MsgSetOnBehalfOptOut) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetOnBehalfOptOut) GetServiceIds() []string {
+	if m != nil {
+		return m.ServiceIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetOnBehalfOptOutResponse defines the response for opting out
+type MsgSetOnBehalfOptOutResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetOnBehalfOptOutResponse) Reset()         { *m = MsgSetOnBehalfOptOutResponse{} }
+func (m *MsgSetOnBehalfOptOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOnBehalfOptOutResponse) ProtoMessage()    {}
+func (*MsgSetOnBehalfOptOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{11}
+}
+func (m *MsgSetOnBehalfOptOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOnBehalfOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOnBehalfOptOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetOnBehalfOptOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOnBehalfOptOutResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetOnBehalfOptOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOnBehalfOptOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOnBehalfOptOutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetOnBehalfOptOutResponse proto.InternalMessageInfo
+
+// MsgClearOnBehalfOptOut allows delegated execution for a DID again
+type MsgClearOnBehalfOptOut struct {
+	// Address controlling the DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// DID opting back in
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-func (m *MsgInitiateDomainVerification) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClearOnBehalfOptOut) Reset()         { *m = MsgClearOnBehalfOptOut{} }
+func (m *MsgClearOnBehalfOptOut) String() string { return proto.CompactTextString(m) }
+func (*MsgClearOnBehalfOptOut) ProtoMessage()    {}
+func (*MsgClearOnBehalfOptOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{12}
+}
+func (m *MsgClearOnBehalfOptOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearOnBehalfOptOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearOnBehalfOptOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgInitiateDomainVerification) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClearOnBehalfOptOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearOnBehalfOptOut.Merge(m, src)
+}
+func (m *MsgClearOnBehalfOptOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearOnBehalfOptOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearOnBehalfOptOut.DiscardUnknown(m)
 }
 
-func (m *MsgInitiateDomainVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgClearOnBehalfOptOut proto.InternalMessageInfo
+
+func (m *MsgClearOnBehalfOptOut) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgInitiateDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClearOnBehalfOptOut) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgInitiateDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClearOnBehalfOptOutResponse defines the response for clearing an opt-out
+type MsgClearOnBehalfOptOutResponse struct {
 }
 
-func (m *MsgInitiateDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DnsInstruction) > 0 {
-		i -= len(m.DnsInstruction)
-		copy(dAtA[i:], m.DnsInstruction)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DnsInstruction)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VerificationToken) > 0 {
-		i -= len(m.VerificationToken)
-		copy(dAtA[i:], m.VerificationToken)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationToken)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgClearOnBehalfOptOutResponse) Reset()         { *m = MsgClearOnBehalfOptOutResponse{} }
+func (m *MsgClearOnBehalfOptOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearOnBehalfOptOutResponse) ProtoMessage()    {}
+func (*MsgClearOnBehalfOptOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{13}
 }
-
-func (m *MsgVerifyDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClearOnBehalfOptOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearOnBehalfOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearOnBehalfOptOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClearOnBehalfOptOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearOnBehalfOptOutResponse.Merge(m, src)
+}
+func (m *MsgClearOnBehalfOptOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearOnBehalfOptOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearOnBehalfOptOutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgVerifyDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClearOnBehalfOptOutResponse proto.InternalMessageInfo
+
+// MsgSetFeeSponsorship sets a service's fee payer and budget. Raising the
+// budget above the fees already spent lifts a suspension.
+type MsgSetFeeSponsorship struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service paying the fees
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Account that signs sponsored transactions as fee payer
+	Payer string `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty"`
+	// Total fees the service is willing to pay, including fees already spent
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
 }
 
-func (m *MsgVerifyDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetFeeSponsorship) Reset()         { *m = MsgSetFeeSponsorship{} }
+func (m *MsgSetFeeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSponsorship) ProtoMessage()    {}
+func (*MsgSetFeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{14}
+}
+func (m *MsgSetFeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetFeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSponsorship.Merge(m, src)
+}
+func (m *MsgSetFeeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSponsorship.DiscardUnknown(m)
 }
 
-func (m *MsgVerifyDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFeeSponsorship proto.InternalMessageInfo
+
+func (m *MsgSetFeeSponsorship) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgVerifyDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFeeSponsorship) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
 }
 
-func (m *MsgVerifyDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSetFeeSponsorship) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeSponsorship) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRegisterService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetFeeSponsorshipResponse defines the response for setting a fee
+// sponsorship
+type MsgSetFeeSponsorshipResponse struct {
 }
 
-func (m *MsgRegisterService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UcanDelegationChain) > 0 {
-		i -= len(m.UcanDelegationChain)
-		copy(dAtA[i:], m.UcanDelegationChain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UcanDelegationChain)))
-		i--
-		dAtA[i] = 0x2a
+func (m *MsgSetFeeSponsorshipResponse) Reset()         { *m = MsgSetFeeSponsorshipResponse{} }
+func (m *MsgSetFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{15}
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RequestedPermissions) > 0 {
-		for iNdEx := len(m.RequestedPermissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestedPermissions[iNdEx])
-			copy(dAtA[i:], m.RequestedPermissions[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedPermissions[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSponsorshipResponse proto.InternalMessageInfo
+
+// MsgImportCredentials imports a credential export into a service.
+// Credentials of the service's relying party become active; the others wait
+// for their users to re-register.
+type MsgImportCredentials struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service importing the credentials
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Sealed export of the credentials
+	Export CredentialExport `protobuf:"bytes,3,opt,name=export,proto3" json:"export"`
+	// Reject the whole import when a credential ID is already in use instead of
+	// skipping the colliding credentials
+	FailOnCollision bool `protobuf:"varint,4,opt,name=fail_on_collision,json=failOnCollision,proto3" json:"fail_on_collision,omitempty"`
+}
+
+func (m *MsgImportCredentials) Reset()         { *m = MsgImportCredentials{} }
+func (m *MsgImportCredentials) String() string { return proto.CompactTextString(m) }
+func (*MsgImportCredentials) ProtoMessage()    {}
+func (*MsgImportCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{16}
+}
+func (m *MsgImportCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgImportCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgImportCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgImportCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgImportCredentials.Merge(m, src)
+}
+func (m *MsgImportCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgImportCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgImportCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgImportCredentials proto.InternalMessageInfo
+
+func (m *MsgImportCredentials) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgImportCredentials) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgImportCredentials) GetExport() CredentialExport {
+	if m != nil {
+		return m.Export
 	}
-	return len(dAtA) - i, nil
+	return CredentialExport{}
 }
 
-func (m *MsgRegisterServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgImportCredentials) GetFailOnCollision() bool {
+	if m != nil {
+		return m.FailOnCollision
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgRegisterServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgImportCredentialsResponse defines the response for importing credentials
+type MsgImportCredentialsResponse struct {
+	// Number of credentials imported as active
+	Imported uint32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	// Number of credentials waiting for re-registration
+	PendingReregistration uint32 `protobuf:"varint,2,opt,name=pending_reregistration,json=pendingReregistration,proto3" json:"pending_reregistration,omitempty"`
+	// IDs of the credentials skipped because they are already in use
+	Collisions []string `protobuf:"bytes,3,rep,name=collisions,proto3" json:"collisions,omitempty"`
 }
 
-func (m *MsgRegisterServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceId) > 0 {
-		i -= len(m.ServiceId)
-		copy(dAtA[i:], m.ServiceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RootCapabilityCid) > 0 {
-		i -= len(m.RootCapabilityCid)
-		copy(dAtA[i:], m.RootCapabilityCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RootCapabilityCid)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgImportCredentialsResponse) Reset()         { *m = MsgImportCredentialsResponse{} }
+func (m *MsgImportCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgImportCredentialsResponse) ProtoMessage()    {}
+func (*MsgImportCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{17}
+}
+func (m *MsgImportCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgImportCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgImportCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgImportCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgImportCredentialsResponse.Merge(m, src)
+}
+func (m *MsgImportCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgImportCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgImportCredentialsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgImportCredentialsResponse proto.InternalMessageInfo
+
+func (m *MsgImportCredentialsResponse) GetImported() uint32 {
+	if m != nil {
+		return m.Imported
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgImportCredentialsResponse) GetPendingReregistration() uint32 {
+	if m != nil {
+		return m.PendingReregistration
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgImportCredentialsResponse) GetCollisions() []string {
+	if m != nil {
+		return m.Collisions
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgInitiateDomainVerification) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgReregisterCredential replaces an imported credential pending
+// re-registration with one its user registered with the service
+type MsgReregisterCredential struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service that imported the credential
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// ID of the imported credential
+	CredentialId string `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// ID of the credential registered with the service
+	NewCredentialId string `protobuf:"bytes,4,opt,name=new_credential_id,json=newCredentialId,proto3" json:"new_credential_id,omitempty"`
 }
 
-func (m *MsgInitiateDomainVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VerificationToken)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DnsInstruction)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgReregisterCredential) Reset()         { *m = MsgReregisterCredential{} }
+func (m *MsgReregisterCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgReregisterCredential) ProtoMessage()    {}
+func (*MsgReregisterCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{18}
+}
+func (m *MsgReregisterCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReregisterCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReregisterCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgReregisterCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReregisterCredential.Merge(m, src)
+}
+func (m *MsgReregisterCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReregisterCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReregisterCredential.DiscardUnknown(m)
 }
 
-func (m *MsgVerifyDomain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgReregisterCredential proto.InternalMessageInfo
+
+func (m *MsgReregisterCredential) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return ""
 }
 
-func (m *MsgVerifyDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReregisterCredential) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	var l int
-	_ = l
-	if m.Verified {
-		n += 2
+	return ""
+}
+
+func (m *MsgReregisterCredential) GetCredentialId() string {
+	if m != nil {
+		return m.CredentialId
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgReregisterCredential) GetNewCredentialId() string {
+	if m != nil {
+		return m.NewCredentialId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgRegisterService) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgReregisterCredentialResponse defines the response for re-registering a
+// credential
+type MsgReregisterCredentialResponse struct {
+}
+
+func (m *MsgReregisterCredentialResponse) Reset()         { *m = MsgReregisterCredentialResponse{} }
+func (m *MsgReregisterCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReregisterCredentialResponse) ProtoMessage()    {}
+func (*MsgReregisterCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{19}
+}
+func (m *MsgReregisterCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReregisterCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReregisterCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgReregisterCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReregisterCredentialResponse.Merge(m, src)
+}
+func (m *MsgReregisterCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReregisterCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReregisterCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReregisterCredentialResponse proto.InternalMessageInfo
+
+// MsgSetBadgeIssuer designates an account to issue badges, replacing the
+// badge types of an existing issuer
+type MsgSetBadgeIssuer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Issuer to designate
+	Issuer BadgeIssuer `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer"`
+}
+
+func (m *MsgSetBadgeIssuer) Reset()         { *m = MsgSetBadgeIssuer{} }
+func (m *MsgSetBadgeIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBadgeIssuer) ProtoMessage()    {}
+func (*MsgSetBadgeIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{20}
+}
+func (m *MsgSetBadgeIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBadgeIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBadgeIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetBadgeIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBadgeIssuer.Merge(m, src)
+}
+func (m *MsgSetBadgeIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBadgeIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBadgeIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBadgeIssuer proto.InternalMessageInfo
+
+func (m *MsgSetBadgeIssuer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetBadgeIssuer) GetIssuer() BadgeIssuer {
+	if m != nil {
+		return m.Issuer
 	}
-	if len(m.RequestedPermissions) > 0 {
-		for _, s := range m.RequestedPermissions {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return BadgeIssuer{}
+}
+
+// MsgSetBadgeIssuerResponse defines the response for designating an issuer
+type MsgSetBadgeIssuerResponse struct {
+}
+
+func (m *MsgSetBadgeIssuerResponse) Reset()         { *m = MsgSetBadgeIssuerResponse{} }
+func (m *MsgSetBadgeIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBadgeIssuerResponse) ProtoMessage()    {}
+func (*MsgSetBadgeIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{21}
+}
+func (m *MsgSetBadgeIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBadgeIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBadgeIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBadgeIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBadgeIssuerResponse.Merge(m, src)
+}
+func (m *MsgSetBadgeIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBadgeIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBadgeIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBadgeIssuerResponse proto.InternalMessageInfo
+
+// MsgRemoveBadgeIssuer withdraws an issuer's designation. Badges it issued
+// stay valid until they expire or are revoked.
+type MsgRemoveBadgeIssuer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Address of the issuer
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+}
+
+func (m *MsgRemoveBadgeIssuer) Reset()         { *m = MsgRemoveBadgeIssuer{} }
+func (m *MsgRemoveBadgeIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBadgeIssuer) ProtoMessage()    {}
+func (*MsgRemoveBadgeIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{22}
+}
+func (m *MsgRemoveBadgeIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBadgeIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBadgeIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBadgeIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBadgeIssuer.Merge(m, src)
+}
+func (m *MsgRemoveBadgeIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBadgeIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBadgeIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBadgeIssuer proto.InternalMessageInfo
+
+func (m *MsgRemoveBadgeIssuer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveBadgeIssuer) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+// MsgRemoveBadgeIssuerResponse defines the response for removing an issuer
+type MsgRemoveBadgeIssuerResponse struct {
+}
+
+func (m *MsgRemoveBadgeIssuerResponse) Reset()         { *m = MsgRemoveBadgeIssuerResponse{} }
+func (m *MsgRemoveBadgeIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBadgeIssuerResponse) ProtoMessage()    {}
+func (*MsgRemoveBadgeIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{23}
+}
+func (m *MsgRemoveBadgeIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBadgeIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBadgeIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBadgeIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBadgeIssuerResponse.Merge(m, src)
+}
+func (m *MsgRemoveBadgeIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBadgeIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBadgeIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBadgeIssuerResponse proto.InternalMessageInfo
+
+// MsgIssueBadge attests that a service or profile passed a verification.
+// Issuing a badge the subject already holds replaces it.
+type MsgIssueBadge struct {
+	// Address of a designated issuer
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Service ID or DID the badge is issued to
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Verification the badge attests
+	BadgeType BadgeType `protobuf:"varint,3,opt,name=badge_type,json=badgeType,proto3,enum=svc.v1.BadgeType" json:"badge_type,omitempty"`
+	// Hash or URI of the off-chain evidence
+	Evidence string `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// Unix timestamp the badge expires, zero if it does not
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *MsgIssueBadge) Reset()         { *m = MsgIssueBadge{} }
+func (m *MsgIssueBadge) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueBadge) ProtoMessage()    {}
+func (*MsgIssueBadge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{24}
+}
+func (m *MsgIssueBadge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueBadge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueBadge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueBadge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueBadge.Merge(m, src)
+}
+func (m *MsgIssueBadge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueBadge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueBadge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueBadge proto.InternalMessageInfo
+
+func (m *MsgIssueBadge) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgIssueBadge) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *MsgIssueBadge) GetBadgeType() BadgeType {
+	if m != nil {
+		return m.BadgeType
+	}
+	return BadgeType_BADGE_TYPE_UNSPECIFIED
+}
+
+func (m *MsgIssueBadge) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *MsgIssueBadge) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// MsgIssueBadgeResponse defines the response for issuing a badge
+type MsgIssueBadgeResponse struct {
+}
+
+func (m *MsgIssueBadgeResponse) Reset()         { *m = MsgIssueBadgeResponse{} }
+func (m *MsgIssueBadgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueBadgeResponse) ProtoMessage()    {}
+func (*MsgIssueBadgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{25}
+}
+func (m *MsgIssueBadgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueBadgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueBadgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueBadgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueBadgeResponse.Merge(m, src)
+}
+func (m *MsgIssueBadgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueBadgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueBadgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueBadgeResponse proto.InternalMessageInfo
+
+// MsgRevokeBadge withdraws a badge. Only the account that issued the badge
+// may revoke it.
+type MsgRevokeBadge struct {
+	// Address of the badge's issuer
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Service ID or DID holding the badge
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Verification the badge attests
+	BadgeType BadgeType `protobuf:"varint,3,opt,name=badge_type,json=badgeType,proto3,enum=svc.v1.BadgeType" json:"badge_type,omitempty"`
+	// Reason for the revocation
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRevokeBadge) Reset()         { *m = MsgRevokeBadge{} }
+func (m *MsgRevokeBadge) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBadge) ProtoMessage()    {}
+func (*MsgRevokeBadge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{26}
+}
+func (m *MsgRevokeBadge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBadge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBadge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeBadge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBadge.Merge(m, src)
+}
+func (m *MsgRevokeBadge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBadge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBadge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeBadge proto.InternalMessageInfo
+
+func (m *MsgRevokeBadge) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgRevokeBadge) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *MsgRevokeBadge) GetBadgeType() BadgeType {
+	if m != nil {
+		return m.BadgeType
+	}
+	return BadgeType_BADGE_TYPE_UNSPECIFIED
+}
+
+func (m *MsgRevokeBadge) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgRevokeBadgeResponse defines the response for revoking a badge
+type MsgRevokeBadgeResponse struct {
+}
+
+func (m *MsgRevokeBadgeResponse) Reset()         { *m = MsgRevokeBadgeResponse{} }
+func (m *MsgRevokeBadgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBadgeResponse) ProtoMessage()    {}
+func (*MsgRevokeBadgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{27}
+}
+func (m *MsgRevokeBadgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBadgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBadgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeBadgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBadgeResponse.Merge(m, src)
+}
+func (m *MsgRevokeBadgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBadgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBadgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeBadgeResponse proto.InternalMessageInfo
+
+// MsgSetAppManifest publishes or replaces the app manifest of a service
+type MsgSetAppManifest struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Manifest to publish; its updated_at is set by the chain
+	Manifest AppManifest `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest"`
+}
+
+func (m *MsgSetAppManifest) Reset()         { *m = MsgSetAppManifest{} }
+func (m *MsgSetAppManifest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppManifest) ProtoMessage()    {}
+func (*MsgSetAppManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{28}
+}
+func (m *MsgSetAppManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAppManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppManifest.Merge(m, src)
+}
+func (m *MsgSetAppManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAppManifest proto.InternalMessageInfo
+
+func (m *MsgSetAppManifest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSetAppManifest) GetManifest() AppManifest {
+	if m != nil {
+		return m.Manifest
+	}
+	return AppManifest{}
+}
+
+// MsgSetAppManifestResponse defines the response for publishing a manifest
+type MsgSetAppManifestResponse struct {
+}
+
+func (m *MsgSetAppManifestResponse) Reset()         { *m = MsgSetAppManifestResponse{} }
+func (m *MsgSetAppManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppManifestResponse) ProtoMessage()    {}
+func (*MsgSetAppManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{29}
+}
+func (m *MsgSetAppManifestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppManifestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAppManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppManifestResponse.Merge(m, src)
+}
+func (m *MsgSetAppManifestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAppManifestResponse proto.InternalMessageInfo
+
+// MsgRemoveAppManifest withdraws the app manifest of a service
+type MsgRemoveAppManifest struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service whose manifest is removed
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (m *MsgRemoveAppManifest) Reset()         { *m = MsgRemoveAppManifest{} }
+func (m *MsgRemoveAppManifest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAppManifest) ProtoMessage()    {}
+func (*MsgRemoveAppManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{30}
+}
+func (m *MsgRemoveAppManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAppManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAppManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAppManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAppManifest.Merge(m, src)
+}
+func (m *MsgRemoveAppManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAppManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAppManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAppManifest proto.InternalMessageInfo
+
+func (m *MsgRemoveAppManifest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRemoveAppManifest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+// MsgRemoveAppManifestResponse defines the response for removing a manifest
+type MsgRemoveAppManifestResponse struct {
+}
+
+func (m *MsgRemoveAppManifestResponse) Reset()         { *m = MsgRemoveAppManifestResponse{} }
+func (m *MsgRemoveAppManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAppManifestResponse) ProtoMessage()    {}
+func (*MsgRemoveAppManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{31}
+}
+func (m *MsgRemoveAppManifestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAppManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAppManifestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAppManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAppManifestResponse.Merge(m, src)
+}
+func (m *MsgRemoveAppManifestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAppManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAppManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAppManifestResponse proto.InternalMessageInfo
+
+// MsgRegisterOIDCClient registers an OpenID Connect client for a service
+type MsgRegisterOIDCClient struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service the client belongs to
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// URIs the authorization server may redirect to
+	RedirectUris []string `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	// Scopes the client may request
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// HTTPS origin of the client's browser requests
+	Origin string `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (m *MsgRegisterOIDCClient) Reset()         { *m = MsgRegisterOIDCClient{} }
+func (m *MsgRegisterOIDCClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterOIDCClient) ProtoMessage()    {}
+func (*MsgRegisterOIDCClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{32}
+}
+func (m *MsgRegisterOIDCClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterOIDCClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterOIDCClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterOIDCClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterOIDCClient.Merge(m, src)
+}
+func (m *MsgRegisterOIDCClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterOIDCClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterOIDCClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterOIDCClient proto.InternalMessageInfo
+
+func (m *MsgRegisterOIDCClient) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterOIDCClient) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *MsgRegisterOIDCClient) GetRedirectUris() []string {
+	if m != nil {
+		return m.RedirectUris
+	}
+	return nil
+}
+
+func (m *MsgRegisterOIDCClient) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *MsgRegisterOIDCClient) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+// MsgRegisterOIDCClientResponse defines the response for registering a client
+type MsgRegisterOIDCClientResponse struct {
+	// Identifier assigned to the client
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *MsgRegisterOIDCClientResponse) Reset()         { *m = MsgRegisterOIDCClientResponse{} }
+func (m *MsgRegisterOIDCClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterOIDCClientResponse) ProtoMessage()    {}
+func (*MsgRegisterOIDCClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{33}
+}
+func (m *MsgRegisterOIDCClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterOIDCClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterOIDCClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterOIDCClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterOIDCClientResponse.Merge(m, src)
+}
+func (m *MsgRegisterOIDCClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterOIDCClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterOIDCClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterOIDCClientResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterOIDCClientResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// MsgRemoveOIDCClient removes an OpenID Connect client of a service
+type MsgRemoveOIDCClient struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Client to remove
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *MsgRemoveOIDCClient) Reset()         { *m = MsgRemoveOIDCClient{} }
+func (m *MsgRemoveOIDCClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOIDCClient) ProtoMessage()    {}
+func (*MsgRemoveOIDCClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{34}
+}
+func (m *MsgRemoveOIDCClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOIDCClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOIDCClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOIDCClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOIDCClient.Merge(m, src)
+}
+func (m *MsgRemoveOIDCClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOIDCClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOIDCClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOIDCClient proto.InternalMessageInfo
+
+func (m *MsgRemoveOIDCClient) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRemoveOIDCClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// MsgRemoveOIDCClientResponse defines the response for removing a client
+type MsgRemoveOIDCClientResponse struct {
+}
+
+func (m *MsgRemoveOIDCClientResponse) Reset()         { *m = MsgRemoveOIDCClientResponse{} }
+func (m *MsgRemoveOIDCClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOIDCClientResponse) ProtoMessage()    {}
+func (*MsgRemoveOIDCClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{35}
+}
+func (m *MsgRemoveOIDCClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOIDCClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOIDCClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOIDCClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOIDCClientResponse.Merge(m, src)
+}
+func (m *MsgRemoveOIDCClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOIDCClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOIDCClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOIDCClientResponse proto.InternalMessageInfo
+
+// MsgSetAttestationPolicy sets or replaces the attestation policy of a service
+type MsgSetAttestationPolicy struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Policy to set; its updated_at is set by the chain
+	Policy AttestationPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetAttestationPolicy) Reset()         { *m = MsgSetAttestationPolicy{} }
+func (m *MsgSetAttestationPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttestationPolicy) ProtoMessage()    {}
+func (*MsgSetAttestationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{36}
+}
+func (m *MsgSetAttestationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttestationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttestationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttestationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttestationPolicy.Merge(m, src)
+}
+func (m *MsgSetAttestationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttestationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttestationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttestationPolicy proto.InternalMessageInfo
+
+func (m *MsgSetAttestationPolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSetAttestationPolicy) GetPolicy() AttestationPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return AttestationPolicy{}
+}
+
+// MsgSetAttestationPolicyResponse defines the response for setting a policy
+type MsgSetAttestationPolicyResponse struct {
+}
+
+func (m *MsgSetAttestationPolicyResponse) Reset()         { *m = MsgSetAttestationPolicyResponse{} }
+func (m *MsgSetAttestationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttestationPolicyResponse) ProtoMessage()    {}
+func (*MsgSetAttestationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{37}
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttestationPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttestationPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttestationPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttestationPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveAttestationPolicy removes the attestation policy of a service
+type MsgRemoveAttestationPolicy struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service whose policy is removed
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (m *MsgRemoveAttestationPolicy) Reset()         { *m = MsgRemoveAttestationPolicy{} }
+func (m *MsgRemoveAttestationPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAttestationPolicy) ProtoMessage()    {}
+func (*MsgRemoveAttestationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{38}
+}
+func (m *MsgRemoveAttestationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAttestationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAttestationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAttestationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAttestationPolicy.Merge(m, src)
+}
+func (m *MsgRemoveAttestationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAttestationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAttestationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAttestationPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveAttestationPolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRemoveAttestationPolicy) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+// MsgRemoveAttestationPolicyResponse defines the response for removing a
+// policy
+type MsgRemoveAttestationPolicyResponse struct {
+}
+
+func (m *MsgRemoveAttestationPolicyResponse) Reset()         { *m = MsgRemoveAttestationPolicyResponse{} }
+func (m *MsgRemoveAttestationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAttestationPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveAttestationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{39}
+}
+func (m *MsgRemoveAttestationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAttestationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAttestationPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAttestationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAttestationPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveAttestationPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAttestationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAttestationPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAttestationPolicyResponse proto.InternalMessageInfo
+
+// MsgAnchorBackup anchors the digest of a service's off-chain dataset
+type MsgAnchorBackup struct {
+	// Address of the service owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Service the dataset belongs to
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Dataset the digest covers, e.g. "profiles"
+	Dataset string `protobuf:"bytes,3,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// SHA-256 digest of the dataset snapshot
+	Digest []byte `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Number of records in the snapshot
+	RecordCount uint64 `protobuf:"varint,5,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// Unix timestamp the snapshot was taken
+	SnapshotAt int64 `protobuf:"varint,6,opt,name=snapshot_at,json=snapshotAt,proto3" json:"snapshot_at,omitempty"`
+}
+
+func (m *MsgAnchorBackup) Reset()         { *m = MsgAnchorBackup{} }
+func (m *MsgAnchorBackup) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorBackup) ProtoMessage()    {}
+func (*MsgAnchorBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{40}
+}
+func (m *MsgAnchorBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnchorBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorBackup.Merge(m, src)
+}
+func (m *MsgAnchorBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnchorBackup proto.InternalMessageInfo
+
+func (m *MsgAnchorBackup) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgAnchorBackup) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *MsgAnchorBackup) GetDataset() string {
+	if m != nil {
+		return m.Dataset
+	}
+	return ""
+}
+
+func (m *MsgAnchorBackup) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *MsgAnchorBackup) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *MsgAnchorBackup) GetSnapshotAt() int64 {
+	if m != nil {
+		return m.SnapshotAt
+	}
+	return 0
+}
+
+// MsgAnchorBackupResponse defines the response for anchoring a backup
+type MsgAnchorBackupResponse struct {
+	// Sequence of the new anchor within the dataset
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgAnchorBackupResponse) Reset()         { *m = MsgAnchorBackupResponse{} }
+func (m *MsgAnchorBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorBackupResponse) ProtoMessage()    {}
+func (*MsgAnchorBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{41}
+}
+func (m *MsgAnchorBackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorBackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnchorBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorBackupResponse.Merge(m, src)
+}
+func (m *MsgAnchorBackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnchorBackupResponse proto.InternalMessageInfo
+
+func (m *MsgAnchorBackupResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// MsgMintViewKey grants a third party read-only access to slices of a DID's
+// data
+type MsgMintViewKey struct {
+	// Address controlling the owner DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// DID whose data the key reads
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// DID of the third party
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Data slices granted, e.g. dex/history or bank/balances
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Seconds until the key lapses, at most a year
+	TtlSeconds uint64 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+}
+
+func (m *MsgMintViewKey) Reset()         { *m = MsgMintViewKey{} }
+func (m *MsgMintViewKey) String() string { return proto.CompactTextString(m) }
+func (*MsgMintViewKey) ProtoMessage()    {}
+func (*MsgMintViewKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{42}
+}
+func (m *MsgMintViewKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintViewKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintViewKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintViewKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintViewKey.Merge(m, src)
+}
+func (m *MsgMintViewKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintViewKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintViewKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintViewKey proto.InternalMessageInfo
+
+func (m *MsgMintViewKey) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *MsgMintViewKey) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgMintViewKey) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgMintViewKey) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *MsgMintViewKey) GetTtlSeconds() uint64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+// MsgMintViewKeyResponse defines the response for minting a view key
+type MsgMintViewKeyResponse struct {
+	ViewKey ViewKey `protobuf:"bytes,1,opt,name=view_key,json=viewKey,proto3" json:"view_key"`
+}
+
+func (m *MsgMintViewKeyResponse) Reset()         { *m = MsgMintViewKeyResponse{} }
+func (m *MsgMintViewKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintViewKeyResponse) ProtoMessage()    {}
+func (*MsgMintViewKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{43}
+}
+func (m *MsgMintViewKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintViewKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintViewKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintViewKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintViewKeyResponse.Merge(m, src)
+}
+func (m *MsgMintViewKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintViewKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintViewKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintViewKeyResponse proto.InternalMessageInfo
+
+func (m *MsgMintViewKeyResponse) GetViewKey() ViewKey {
+	if m != nil {
+		return m.ViewKey
+	}
+	return ViewKey{}
+}
+
+// MsgRevokeViewKey withdraws a view key
+type MsgRevokeViewKey struct {
+	// Address controlling the owner DID
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// DID that minted the key
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ViewKeyId uint64 `protobuf:"varint,3,opt,name=view_key_id,json=viewKeyId,proto3" json:"view_key_id,omitempty"`
+}
+
+func (m *MsgRevokeViewKey) Reset()         { *m = MsgRevokeViewKey{} }
+func (m *MsgRevokeViewKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeViewKey) ProtoMessage()    {}
+func (*MsgRevokeViewKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{44}
+}
+func (m *MsgRevokeViewKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeViewKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeViewKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeViewKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeViewKey.Merge(m, src)
+}
+func (m *MsgRevokeViewKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeViewKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeViewKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeViewKey proto.InternalMessageInfo
+
+func (m *MsgRevokeViewKey) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *MsgRevokeViewKey) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRevokeViewKey) GetViewKeyId() uint64 {
+	if m != nil {
+		return m.ViewKeyId
+	}
+	return 0
+}
+
+// MsgRevokeViewKeyResponse defines the response for revoking a view key
+type MsgRevokeViewKeyResponse struct {
+}
+
+func (m *MsgRevokeViewKeyResponse) Reset()         { *m = MsgRevokeViewKeyResponse{} }
+func (m *MsgRevokeViewKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeViewKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeViewKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_084252b8c07dd202, []int{45}
+}
+func (m *MsgRevokeViewKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeViewKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeViewKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeViewKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeViewKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeViewKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeViewKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeViewKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeViewKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "svc.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "svc.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgInitiateDomainVerification)(nil), "svc.v1.MsgInitiateDomainVerification")
+	proto.RegisterType((*MsgInitiateDomainVerificationResponse)(nil), "svc.v1.MsgInitiateDomainVerificationResponse")
+	proto.RegisterType((*MsgVerifyDomain)(nil), "svc.v1.MsgVerifyDomain")
+	proto.RegisterType((*MsgVerifyDomainResponse)(nil), "svc.v1.MsgVerifyDomainResponse")
+	proto.RegisterType((*MsgRegisterService)(nil), "svc.v1.MsgRegisterService")
+	proto.RegisterType((*MsgRegisterServiceResponse)(nil), "svc.v1.MsgRegisterServiceResponse")
+	proto.RegisterType((*MsgExecOnBehalf)(nil), "svc.v1.MsgExecOnBehalf")
+	proto.RegisterType((*MsgExecOnBehalfResponse)(nil), "svc.v1.MsgExecOnBehalfResponse")
+	proto.RegisterType((*MsgSetOnBehalfOptOut)(nil), "svc.v1.MsgSetOnBehalfOptOut")
+	proto.RegisterType((*MsgSetOnBehalfOptOutResponse)(nil), "svc.v1.MsgSetOnBehalfOptOutResponse")
+	proto.RegisterType((*MsgClearOnBehalfOptOut)(nil), "svc.v1.MsgClearOnBehalfOptOut")
+	proto.RegisterType((*MsgClearOnBehalfOptOutResponse)(nil), "svc.v1.MsgClearOnBehalfOptOutResponse")
+	proto.RegisterType((*MsgSetFeeSponsorship)(nil), "svc.v1.MsgSetFeeSponsorship")
+	proto.RegisterType((*MsgSetFeeSponsorshipResponse)(nil), "svc.v1.MsgSetFeeSponsorshipResponse")
+	proto.RegisterType((*MsgImportCredentials)(nil), "svc.v1.MsgImportCredentials")
+	proto.RegisterType((*MsgImportCredentialsResponse)(nil), "svc.v1.MsgImportCredentialsResponse")
+	proto.RegisterType((*MsgReregisterCredential)(nil), "svc.v1.MsgReregisterCredential")
+	proto.RegisterType((*MsgReregisterCredentialResponse)(nil), "svc.v1.MsgReregisterCredentialResponse")
+	proto.RegisterType((*MsgSetBadgeIssuer)(nil), "svc.v1.MsgSetBadgeIssuer")
+	proto.RegisterType((*MsgSetBadgeIssuerResponse)(nil), "svc.v1.MsgSetBadgeIssuerResponse")
+	proto.RegisterType((*MsgRemoveBadgeIssuer)(nil), "svc.v1.MsgRemoveBadgeIssuer")
+	proto.RegisterType((*MsgRemoveBadgeIssuerResponse)(nil), "svc.v1.MsgRemoveBadgeIssuerResponse")
+	proto.RegisterType((*MsgIssueBadge)(nil), "svc.v1.MsgIssueBadge")
+	proto.RegisterType((*MsgIssueBadgeResponse)(nil), "svc.v1.MsgIssueBadgeResponse")
+	proto.RegisterType((*MsgRevokeBadge)(nil), "svc.v1.MsgRevokeBadge")
+	proto.RegisterType((*MsgRevokeBadgeResponse)(nil), "svc.v1.MsgRevokeBadgeResponse")
+	proto.RegisterType((*MsgSetAppManifest)(nil), "svc.v1.MsgSetAppManifest")
+	proto.RegisterType((*MsgSetAppManifestResponse)(nil), "svc.v1.MsgSetAppManifestResponse")
+	proto.RegisterType((*MsgRemoveAppManifest)(nil), "svc.v1.MsgRemoveAppManifest")
+	proto.RegisterType((*MsgRemoveAppManifestResponse)(nil), "svc.v1.MsgRemoveAppManifestResponse")
+	proto.RegisterType((*MsgRegisterOIDCClient)(nil), "svc.v1.MsgRegisterOIDCClient")
+	proto.RegisterType((*MsgRegisterOIDCClientResponse)(nil), "svc.v1.MsgRegisterOIDCClientResponse")
+	proto.RegisterType((*MsgRemoveOIDCClient)(nil), "svc.v1.MsgRemoveOIDCClient")
+	proto.RegisterType((*MsgRemoveOIDCClientResponse)(nil), "svc.v1.MsgRemoveOIDCClientResponse")
+	proto.RegisterType((*MsgSetAttestationPolicy)(nil), "svc.v1.MsgSetAttestationPolicy")
+	proto.RegisterType((*MsgSetAttestationPolicyResponse)(nil), "svc.v1.MsgSetAttestationPolicyResponse")
+	proto.RegisterType((*MsgRemoveAttestationPolicy)(nil), "svc.v1.MsgRemoveAttestationPolicy")
+	proto.RegisterType((*MsgRemoveAttestationPolicyResponse)(nil), "svc.v1.MsgRemoveAttestationPolicyResponse")
+	proto.RegisterType((*MsgAnchorBackup)(nil), "svc.v1.MsgAnchorBackup")
+	proto.RegisterType((*MsgAnchorBackupResponse)(nil), "svc.v1.MsgAnchorBackupResponse")
+	proto.RegisterType((*MsgMintViewKey)(nil), "svc.v1.MsgMintViewKey")
+	proto.RegisterType((*MsgMintViewKeyResponse)(nil), "svc.v1.MsgMintViewKeyResponse")
+	proto.RegisterType((*MsgRevokeViewKey)(nil), "svc.v1.MsgRevokeViewKey")
+	proto.RegisterType((*MsgRevokeViewKeyResponse)(nil), "svc.v1.MsgRevokeViewKeyResponse")
+}
+
+func init() { proto.RegisterFile("svc/v1/tx.proto", fileDescriptor_084252b8c07dd202) }
+
+var fileDescriptor_084252b8c07dd202 = []byte{
+	// 2132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x1f, 0x8f, 0x13, 0x27, 0x79, 0xce, 0x9f, 0x4d, 0xe7, 0x9f, 0xd3, 0x99, 0xd8, 0x19, 0x4f,
+	0x86, 0x89, 0x46, 0xc4, 0x9e, 0x64, 0x34, 0x0b, 0x8a, 0xe0, 0x90, 0x78, 0x06, 0xad, 0x59, 0x85,
+	0x0c, 0x9d, 0xd9, 0x45, 0x5a, 0x09, 0x99, 0x76, 0x77, 0xa5, 0x53, 0xc4, 0xee, 0xf2, 0x76, 0x95,
+	0x9d, 0xf8, 0x04, 0xe2, 0x00, 0x07, 0x24, 0xb4, 0x08, 0x3e, 0x04, 0xe2, 0xb4, 0x12, 0x2b, 0xf1,
+	0x05, 0x40, 0xac, 0x38, 0xad, 0x90, 0x90, 0x38, 0x01, 0x9a, 0x39, 0xac, 0xf8, 0x02, 0x9c, 0x51,
+	0x75, 0x57, 0x95, 0xab, 0xbb, 0x6d, 0x67, 0x66, 0x67, 0x46, 0xe2, 0xb2, 0x93, 0x7a, 0xbf, 0xd7,
+	0xef, 0xfd, 0xde, 0xf3, 0xab, 0x57, 0xaf, 0x6a, 0x61, 0x81, 0xf6, 0x9c, 0x6a, 0x6f, 0xaf, 0xca,
+	0xae, 0x2a, 0x9d, 0x80, 0x30, 0x62, 0xe4, 0x68, 0xcf, 0xa9, 0xf4, 0xf6, 0xcc, 0x35, 0x87, 0xd0,
+	0x36, 0xa1, 0xd5, 0x36, 0xf5, 0x38, 0xde, 0xa6, 0x5e, 0xa4, 0x60, 0xae, 0x47, 0x40, 0x23, 0x5c,
+	0x55, 0xa3, 0x85, 0x80, 0x96, 0x3d, 0xe2, 0x91, 0x48, 0xce, 0xff, 0x92, 0x1f, 0x78, 0x84, 0x78,
+	0x2d, 0x54, 0x0d, 0x57, 0xcd, 0xee, 0x59, 0xd5, 0xf6, 0xfb, 0x02, 0x2a, 0x08, 0xef, 0x36, 0x63,
+	0x88, 0x32, 0x9b, 0x61, 0xe2, 0x0b, 0x64, 0x49, 0x20, 0x4d, 0xdb, 0xf5, 0x10, 0x4d, 0xa8, 0x3b,
+	0x01, 0x72, 0x91, 0xcf, 0xb0, 0xdd, 0x92, 0xc8, 0xaa, 0x40, 0xda, 0xb6, 0x8f, 0xcf, 0x10, 0x65,
+	0x8a, 0x91, 0x90, 0x7b, 0xc8, 0x47, 0x14, 0x27, 0xb5, 0x7b, 0x18, 0x5d, 0x36, 0x2e, 0x50, 0x5f,
+	0xca, 0x8b, 0x22, 0xe6, 0xa6, 0x4d, 0x51, 0xb5, 0xb7, 0xd7, 0x44, 0xcc, 0xde, 0xab, 0x3a, 0x04,
+	0x4b, 0x52, 0x8b, 0x76, 0x1b, 0xfb, 0xa4, 0x1a, 0xfe, 0x37, 0x12, 0x95, 0x7f, 0x91, 0x81, 0x85,
+	0x63, 0xea, 0x7d, 0xd0, 0x71, 0x6d, 0x86, 0x9e, 0xda, 0x81, 0xdd, 0xa6, 0xc6, 0xbb, 0x30, 0x63,
+	0x77, 0xd9, 0x39, 0x09, 0x30, 0xeb, 0x17, 0x32, 0x5b, 0x99, 0x9d, 0x99, 0xa3, 0xc2, 0xdf, 0x3e,
+	0xdb, 0x5d, 0x16, 0xb9, 0x3a, 0x74, 0xdd, 0x00, 0x51, 0x7a, 0xca, 0x02, 0xec, 0x7b, 0xd6, 0x40,
+	0xd5, 0xf8, 0x3a, 0xe4, 0x3a, 0xa1, 0x85, 0xc2, 0xcd, 0xad, 0xcc, 0x4e, 0x7e, 0x7f, 0xbe, 0x12,
+	0xfd, 0x16, 0x95, 0xc8, 0xee, 0xd1, 0xc4, 0xe7, 0xff, 0x2c, 0xdd, 0xb0, 0x84, 0xce, 0xc1, 0xfc,
+	0xcf, 0xbe, 0xfc, 0xf4, 0xfe, 0xe0, 0xeb, 0xf2, 0x3a, 0xac, 0x25, 0x88, 0x58, 0x88, 0x76, 0x88,
+	0x4f, 0x51, 0xb9, 0x0f, 0x9b, 0xc7, 0xd4, 0xab, 0xfb, 0x98, 0x61, 0x9b, 0xa1, 0xc7, 0xa4, 0x6d,
+	0x63, 0xff, 0x43, 0x14, 0xe0, 0x33, 0xec, 0x84, 0x39, 0x37, 0xf6, 0x61, 0xca, 0x09, 0x90, 0xcd,
+	0x48, 0x70, 0x2d, 0x5f, 0xa9, 0x68, 0xac, 0x42, 0xce, 0x0d, 0x2d, 0x85, 0x6c, 0x67, 0x2c, 0xb1,
+	0x3a, 0x98, 0xe5, 0xbc, 0xa4, 0x56, 0xf9, 0x27, 0x70, 0x77, 0xac, 0x6b, 0xc9, 0xd1, 0xd8, 0x05,
+	0xa3, 0xa7, 0xc9, 0x1b, 0x8c, 0x5c, 0x20, 0x3f, 0x62, 0x63, 0x2d, 0xea, 0xc8, 0x33, 0x0e, 0x18,
+	0xf7, 0x60, 0xc1, 0xf5, 0x69, 0x03, 0xfb, 0x94, 0x05, 0x5d, 0x87, 0xcb, 0x05, 0x8d, 0x79, 0xd7,
+	0xa7, 0xf5, 0x81, 0xb4, 0x7c, 0x11, 0xfe, 0x3e, 0xa1, 0xcb, 0x7e, 0xe4, 0xfe, 0x2d, 0x46, 0x7b,
+	0x02, 0x6b, 0x09, 0x67, 0x2a, 0x3e, 0x13, 0xa6, 0xa3, 0x28, 0x90, 0x1b, 0x7a, 0x9d, 0xb6, 0xd4,
+	0xda, 0x28, 0xc0, 0x54, 0x1b, 0x51, 0x6a, 0x7b, 0x48, 0x58, 0x97, 0xcb, 0xf2, 0x7f, 0x33, 0x60,
+	0x1c, 0x53, 0xcf, 0x42, 0x1e, 0xa6, 0x0c, 0x05, 0xa7, 0x28, 0xe8, 0x61, 0x07, 0x7d, 0xa5, 0x08,
+	0x36, 0x01, 0x68, 0xf4, 0x79, 0x03, 0xbb, 0xc2, 0xcf, 0x8c, 0x90, 0xd4, 0x5d, 0x2d, 0xc0, 0xac,
+	0x1e, 0xa0, 0xf1, 0x10, 0x56, 0x02, 0xf4, 0x71, 0x17, 0x51, 0x86, 0xdc, 0x46, 0x07, 0x05, 0x6d,
+	0x4c, 0x29, 0x26, 0x3e, 0x2d, 0x4c, 0x6c, 0x65, 0x77, 0x66, 0xac, 0x65, 0x05, 0x3e, 0x1d, 0x60,
+	0xc6, 0x3e, 0xac, 0x74, 0x1d, 0xdb, 0x6f, 0xb8, 0xa8, 0x85, 0xbc, 0xe8, 0xf7, 0x74, 0xce, 0xb9,
+	0xed, 0xc9, 0xd0, 0xf6, 0x12, 0x07, 0x1f, 0x2b, 0xac, 0x76, 0x9e, 0xce, 0xe4, 0x05, 0x98, 0xe9,
+	0xb8, 0x55, 0x32, 0x2b, 0xb0, 0x14, 0x10, 0xc2, 0x1a, 0x8e, 0xdd, 0xb1, 0x9b, 0xb8, 0x85, 0x59,
+	0xbf, 0xe1, 0x60, 0x57, 0x56, 0x0b, 0x87, 0x6a, 0x0a, 0xa9, 0x61, 0xf7, 0x9a, 0xd8, 0xcb, 0xff,
+	0x89, 0x36, 0xf1, 0x93, 0x2b, 0xe4, 0x9c, 0xf8, 0x47, 0xe8, 0xdc, 0x6e, 0x9d, 0x19, 0x0f, 0x20,
+	0x47, 0x91, 0xef, 0xa2, 0xeb, 0x33, 0x2c, 0xf4, 0x8c, 0x12, 0xe4, 0xa5, 0x13, 0x57, 0x79, 0x91,
+	0x7e, 0x1f, 0x63, 0xd7, 0x78, 0x07, 0xb2, 0x1c, 0x88, 0xf2, 0x9b, 0x75, 0x23, 0x5e, 0x61, 0x9e,
+	0xa2, 0x62, 0x9f, 0x88, 0x78, 0x71, 0x49, 0x54, 0xe4, 0x4f, 0x60, 0xa2, 0x4d, 0x3d, 0x5a, 0x98,
+	0xdc, 0xca, 0xee, 0xe4, 0xf7, 0x97, 0x2b, 0x51, 0x23, 0xad, 0xc8, 0x46, 0x5a, 0x39, 0xf4, 0xfb,
+	0x47, 0x1b, 0x7f, 0xfd, 0x6c, 0x57, 0xf4, 0xea, 0x0a, 0xef, 0x5b, 0x15, 0xd1, 0xb7, 0x2a, 0x3c,
+	0x7d, 0xe1, 0xe7, 0x07, 0x79, 0x9e, 0x59, 0xc1, 0xb2, 0xfc, 0x10, 0xd6, 0x12, 0xa1, 0xaa, 0xac,
+	0x16, 0x60, 0x2a, 0x40, 0xb4, 0xdb, 0x62, 0xb4, 0x90, 0xd9, 0xca, 0xee, 0xcc, 0x5a, 0x72, 0x59,
+	0xfe, 0x6d, 0x06, 0x96, 0x8f, 0xa9, 0x77, 0x8a, 0x98, 0xfc, 0xe8, 0xa4, 0xc3, 0x4e, 0xba, 0xcc,
+	0xf8, 0x26, 0x80, 0x43, 0x7c, 0x16, 0x90, 0x56, 0xeb, 0x25, 0x32, 0xa5, 0xe9, 0xca, 0x64, 0xdc,
+	0x1c, 0x24, 0x43, 0xcb, 0x1f, 0x76, 0x69, 0x21, 0xbb, 0x95, 0xd5, 0xf2, 0x57, 0x77, 0xe9, 0xc1,
+	0x02, 0x8f, 0x43, 0xb3, 0x51, 0x2e, 0xc2, 0xad, 0x61, 0xac, 0x54, 0xdf, 0xeb, 0xc2, 0xea, 0x31,
+	0xf5, 0x6a, 0x2d, 0x64, 0x07, 0x6f, 0x8f, 0x77, 0x9a, 0xd6, 0x16, 0x14, 0x87, 0xbb, 0x55, 0xc4,
+	0x7e, 0x79, 0x53, 0xe6, 0xf3, 0x3b, 0x08, 0x9d, 0x72, 0x19, 0x09, 0xe8, 0x39, 0xee, 0x18, 0x15,
+	0x98, 0x24, 0x97, 0xfe, 0x4b, 0x50, 0x8a, 0xd4, 0xae, 0xdb, 0xd4, 0x15, 0x98, 0xec, 0xd8, 0x7d,
+	0x14, 0x14, 0xb2, 0xd7, 0x99, 0x0b, 0xd5, 0x8c, 0x73, 0xc8, 0x35, 0xbb, 0xae, 0x87, 0x58, 0xb8,
+	0xbb, 0xf3, 0xfb, 0xeb, 0x95, 0x61, 0x95, 0x55, 0x23, 0xd8, 0x3f, 0x7a, 0xc4, 0x0f, 0xa3, 0xdf,
+	0xff, 0xab, 0xb4, 0xe3, 0x61, 0x76, 0xde, 0x6d, 0x56, 0x1c, 0xd2, 0x16, 0xc3, 0x80, 0xf8, 0x67,
+	0x97, 0xba, 0x17, 0x55, 0xd6, 0xef, 0x20, 0x1a, 0x7e, 0x40, 0x7f, 0xf7, 0xe5, 0xa7, 0xf7, 0x33,
+	0x96, 0xb0, 0x7f, 0x00, 0x3c, 0x69, 0x51, 0x10, 0x83, 0x9f, 0x31, 0x9e, 0x0c, 0x95, 0xad, 0xbf,
+	0x47, 0xd5, 0x57, 0x6f, 0x77, 0x48, 0xc0, 0x6a, 0x83, 0xb3, 0xff, 0x4d, 0x67, 0xeb, 0x5d, 0xc8,
+	0xa1, 0x2b, 0xee, 0x23, 0x4c, 0x57, 0x7e, 0xbf, 0x20, 0xcf, 0xdf, 0x81, 0xcf, 0x27, 0x21, 0x2e,
+	0x4f, 0xe2, 0x48, 0xdb, 0xb8, 0x0f, 0x8b, 0x67, 0x36, 0x6e, 0x35, 0x78, 0x97, 0x23, 0xad, 0x16,
+	0xe6, 0x3d, 0x30, 0xdc, 0xcc, 0xd3, 0xd6, 0x02, 0x07, 0x4e, 0xfc, 0x9a, 0x14, 0xc7, 0xe2, 0xfe,
+	0x75, 0x06, 0x6e, 0x0d, 0x8b, 0x4b, 0x3f, 0x33, 0x70, 0x08, 0x8a, 0x33, 0x63, 0xce, 0x52, 0x6b,
+	0xe3, 0x11, 0xac, 0x76, 0x90, 0xef, 0x62, 0xdf, 0x6b, 0x04, 0x28, 0x08, 0xfb, 0x64, 0x60, 0xab,
+	0x73, 0x70, 0xce, 0x5a, 0x11, 0xa8, 0x15, 0x03, 0x8d, 0x22, 0x2f, 0x7c, 0x41, 0x46, 0xed, 0xb1,
+	0x81, 0xa4, 0xfc, 0xe7, 0x4c, 0xd8, 0x1f, 0xe4, 0x57, 0x28, 0x18, 0xf0, 0x7a, 0xd3, 0xe9, 0xbe,
+	0x03, 0x73, 0x83, 0x41, 0xae, 0xa1, 0x1a, 0xe3, 0xec, 0x40, 0x58, 0x77, 0x79, 0x6e, 0x7d, 0x74,
+	0xd9, 0x88, 0x2b, 0x46, 0x8d, 0x72, 0xc1, 0x47, 0x97, 0x35, 0x4d, 0x37, 0x96, 0xdb, 0xdb, 0x50,
+	0x1a, 0x11, 0x86, 0x2a, 0xab, 0x5f, 0x65, 0x60, 0x31, 0xaa, 0xbb, 0x23, 0x3e, 0x64, 0xd6, 0x29,
+	0xed, 0xa2, 0xe0, 0x2b, 0x0f, 0x6f, 0x7b, 0x90, 0xc3, 0xa1, 0x05, 0x31, 0xbc, 0x2d, 0xc9, 0xe2,
+	0xd1, 0x8c, 0xcb, 0xba, 0x89, 0x14, 0x53, 0x13, 0xdc, 0x06, 0xac, 0xa7, 0xf8, 0x28, 0xb6, 0x9f,
+	0x44, 0x9b, 0xc0, 0x42, 0x6d, 0xd2, 0x43, 0x6f, 0x82, 0xf0, 0x83, 0x18, 0xe1, 0xb1, 0x07, 0xdc,
+	0x08, 0xbe, 0xd1, 0xbe, 0x4d, 0x31, 0xd2, 0xf7, 0xed, 0x1c, 0xaf, 0x6f, 0x2e, 0x0d, 0x71, 0xcd,
+	0x67, 0xe6, 0xe5, 0x7c, 0xf2, 0x33, 0x89, 0x76, 0x9b, 0x3f, 0x46, 0x0e, 0x93, 0xa3, 0x91, 0x58,
+	0x1a, 0x0f, 0x00, 0xc2, 0xcb, 0x41, 0x83, 0xf7, 0x98, 0xb0, 0x76, 0xe6, 0xf7, 0x17, 0x63, 0x49,
+	0x7f, 0xd6, 0xef, 0x20, 0x6b, 0xa6, 0x29, 0xff, 0xe4, 0xdb, 0x09, 0xf5, 0xb0, 0x8b, 0x7c, 0x07,
+	0x89, 0x12, 0x52, 0x6b, 0x5e, 0xab, 0xe8, 0xaa, 0x83, 0x03, 0x44, 0x1b, 0x36, 0x0b, 0xc7, 0x94,
+	0xac, 0x35, 0x23, 0x24, 0x87, 0x4c, 0x1c, 0xa1, 0x11, 0xa7, 0xf2, 0x1a, 0xac, 0xc4, 0xc2, 0x52,
+	0x01, 0xff, 0x21, 0x03, 0xf3, 0x61, 0x46, 0x7a, 0xe4, 0xe2, 0xff, 0x22, 0xe2, 0x55, 0xc8, 0x05,
+	0xc8, 0xa6, 0x44, 0xce, 0x16, 0x62, 0x15, 0x0f, 0xa7, 0x00, 0xab, 0x71, 0xd2, 0x2a, 0x9e, 0x9f,
+	0xab, 0x1d, 0x72, 0xd8, 0xe9, 0x1c, 0x8b, 0xab, 0xd5, 0x2b, 0xb7, 0x81, 0x47, 0x30, 0x2d, 0xaf,
+	0x65, 0xc9, 0xbd, 0xa1, 0x99, 0x15, 0x7b, 0x43, 0xa9, 0xc6, 0x76, 0xb3, 0xda, 0x19, 0xda, 0x07,
+	0x8a, 0xe5, 0xc7, 0xda, 0xc6, 0x78, 0x1d, 0x9e, 0xe3, 0xdb, 0xd5, 0x90, 0x13, 0x2b, 0xe5, 0x52,
+	0x51, 0xfa, 0x4b, 0x06, 0x56, 0xb4, 0xf1, 0xf5, 0xa4, 0xfe, 0xb8, 0x56, 0x6b, 0x61, 0xe4, 0xb3,
+	0xb7, 0xd0, 0x43, 0x03, 0xe4, 0xe2, 0x00, 0x39, 0xac, 0xd1, 0x0d, 0xb0, 0xec, 0xe8, 0xb3, 0x52,
+	0xf8, 0x41, 0x80, 0x29, 0xaf, 0x02, 0xea, 0x90, 0x0e, 0x92, 0x33, 0xbb, 0x58, 0x71, 0x39, 0x09,
+	0xb0, 0xa7, 0xc6, 0x72, 0xb1, 0x8a, 0x45, 0xfa, 0x2d, 0xd8, 0x1c, 0x1a, 0x88, 0x3a, 0xa3, 0x36,
+	0x60, 0xc6, 0x09, 0x25, 0x0d, 0x35, 0x80, 0x4f, 0x47, 0x82, 0xba, 0x5b, 0xf6, 0x61, 0x49, 0xe5,
+	0xe9, 0x35, 0x92, 0x10, 0xf3, 0x71, 0x33, 0xee, 0x23, 0xc6, 0x76, 0x13, 0x36, 0x86, 0xf8, 0xd3,
+	0x3b, 0xfe, 0x9a, 0xa8, 0xa3, 0xc1, 0x83, 0xc3, 0x53, 0xd2, 0xc2, 0x4e, 0xff, 0x95, 0x39, 0x7d,
+	0x03, 0x72, 0x9d, 0xf0, 0x4b, 0x51, 0xd3, 0xeb, 0xaa, 0xa6, 0x93, 0xa6, 0xd5, 0xbd, 0x3d, 0x5c,
+	0x0d, 0x39, 0xa5, 0x86, 0xf1, 0x51, 0x9c, 0x2f, 0xc1, 0x54, 0x21, 0xbd, 0x3e, 0xeb, 0x57, 0xa8,
+	0xf1, 0x6d, 0x28, 0x8f, 0x76, 0xac, 0xe8, 0x3d, 0x8f, 0xae, 0x4e, 0x87, 0xbe, 0x73, 0x4e, 0x82,
+	0x23, 0xdb, 0xb9, 0xe8, 0xbe, 0xf1, 0x21, 0xb6, 0x00, 0x53, 0xae, 0xcd, 0x6c, 0x8a, 0x98, 0x98,
+	0x10, 0xe4, 0x32, 0xbc, 0xb3, 0x62, 0x8f, 0xf7, 0x15, 0xde, 0xde, 0x66, 0x2d, 0xb1, 0x32, 0x6e,
+	0xc3, 0x6c, 0x80, 0x1c, 0x12, 0xb8, 0x0d, 0x87, 0x74, 0xfd, 0xa8, 0x9d, 0x4f, 0x58, 0xf9, 0x48,
+	0x56, 0xe3, 0xa2, 0xf0, 0xb2, 0xe1, 0xdb, 0x1d, 0x7a, 0x4e, 0x18, 0x6f, 0xf8, 0xb9, 0xb0, 0xe1,
+	0x83, 0x14, 0x1d, 0xc6, 0xdb, 0xcf, 0x23, 0x58, 0x4b, 0xc4, 0xa8, 0x8f, 0x68, 0x94, 0xdf, 0x80,
+	0xf9, 0x99, 0x92, 0x09, 0xdd, 0xa8, 0x75, 0xf9, 0x4f, 0xd1, 0x71, 0x70, 0x8c, 0x7d, 0xf6, 0x21,
+	0x46, 0x97, 0xef, 0xa3, 0xfe, 0x6b, 0xdc, 0x3b, 0x96, 0x65, 0x52, 0xa3, 0xfc, 0x88, 0xd4, 0x15,
+	0x60, 0xca, 0x0b, 0x6c, 0x9f, 0x21, 0x24, 0x73, 0x23, 0x96, 0x23, 0x37, 0x7d, 0x09, 0xf2, 0x8c,
+	0xb5, 0x1a, 0x14, 0x39, 0xc4, 0x77, 0xa9, 0x48, 0x0d, 0x30, 0xd6, 0x3a, 0x8d, 0x24, 0xe9, 0xeb,
+	0xcc, 0x77, 0x61, 0x35, 0x1e, 0x85, 0x0a, 0xfe, 0x01, 0x4c, 0xcb, 0x27, 0xb4, 0x30, 0x96, 0xfc,
+	0xfe, 0x82, 0xdc, 0x05, 0x42, 0x55, 0xd4, 0xfe, 0x54, 0x2f, 0x5a, 0x96, 0x7f, 0x93, 0x81, 0x77,
+	0xd4, 0x61, 0xf3, 0xb6, 0x92, 0x52, 0x84, 0xbc, 0xa4, 0x25, 0xc7, 0xca, 0x09, 0x6b, 0x46, 0x50,
+	0xa8, 0x0f, 0xb9, 0xb0, 0x99, 0x50, 0x48, 0x92, 0x92, 0x31, 0xee, 0xff, 0x71, 0x01, 0xb2, 0xc7,
+	0xd4, 0x33, 0xde, 0x83, 0xd9, 0xd8, 0x23, 0xdf, 0x9a, 0x8c, 0x34, 0xf1, 0xe8, 0x66, 0x96, 0x46,
+	0x00, 0x2a, 0x6b, 0x01, 0x98, 0x63, 0x9e, 0xe2, 0xee, 0x6a, 0x9f, 0x8f, 0x56, 0x33, 0x77, 0x5f,
+	0x4a, 0x4d, 0xf9, 0x7c, 0x0f, 0x66, 0x63, 0x4f, 0x60, 0x3a, 0x7b, 0x1d, 0x30, 0x4b, 0x23, 0x00,
+	0x65, 0xe9, 0xfb, 0xb0, 0x90, 0x7c, 0x8d, 0x32, 0xb5, 0x6f, 0x12, 0x98, 0x59, 0x1e, 0x8d, 0xe9,
+	0xe4, 0x62, 0x4f, 0x2f, 0x3a, 0x39, 0x1d, 0x30, 0x4b, 0x23, 0x00, 0x65, 0xe9, 0x07, 0xb0, 0x98,
+	0x7e, 0xa3, 0xb8, 0xa5, 0x7d, 0x95, 0x42, 0xcd, 0xed, 0x71, 0xa8, 0x32, 0xfc, 0x43, 0x58, 0x1a,
+	0xf6, 0x8c, 0x50, 0xd4, 0x3e, 0x1e, 0x82, 0x9b, 0x5f, 0x1b, 0x8f, 0x27, 0x78, 0x27, 0xde, 0x02,
+	0x12, 0xbc, 0xe3, 0xa8, 0xb9, 0x3d, 0x0e, 0xd5, 0x0d, 0xa7, 0xaf, 0xcd, 0xba, 0xe1, 0x14, 0x6a,
+	0x6e, 0x8f, 0x43, 0x95, 0xe1, 0x1f, 0xc1, 0xf2, 0xd0, 0x3b, 0x62, 0x29, 0xf6, 0x7b, 0xa7, 0x15,
+	0xcc, 0x7b, 0xd7, 0x28, 0x28, 0x0f, 0xdf, 0x83, 0xf9, 0xc4, 0xd5, 0x6c, 0x3d, 0x1e, 0xb2, 0x06,
+	0x99, 0xb7, 0x47, 0x42, 0x7a, 0x2a, 0xd2, 0x97, 0xa7, 0x5b, 0x31, 0x36, 0x09, 0xd4, 0xdc, 0x1e,
+	0x87, 0x2a, 0xc3, 0x47, 0x00, 0xda, 0x15, 0x67, 0x45, 0x4f, 0x9f, 0x12, 0x9b, 0x9b, 0x43, 0xc5,
+	0xca, 0xc6, 0x13, 0xc8, 0xeb, 0xb7, 0x86, 0xd5, 0x98, 0x63, 0x25, 0x37, 0x8b, 0xc3, 0xe5, 0x89,
+	0x9c, 0xe9, 0x43, 0x70, 0x22, 0x67, 0x1a, 0x64, 0xde, 0x1e, 0x09, 0xa5, 0x73, 0xa6, 0x9b, 0x4c,
+	0xe7, 0x4c, 0xb7, 0xba, 0x3d, 0x0e, 0x55, 0x86, 0x3f, 0x02, 0x63, 0xc8, 0x70, 0xbc, 0x39, 0xa4,
+	0x59, 0x0c, 0x60, 0xf3, 0xee, 0x58, 0x58, 0xd9, 0x7e, 0x06, 0xef, 0xa4, 0x26, 0xce, 0x8d, 0x14,
+	0x2b, 0xcd, 0xee, 0x9d, 0x31, 0xa0, 0x5e, 0xf0, 0x43, 0xe7, 0xc6, 0x52, 0x22, 0x8b, 0x49, 0x05,
+	0xf3, 0xde, 0x35, 0x0a, 0xca, 0x03, 0x86, 0xb5, 0x51, 0x63, 0x5e, 0x39, 0x9d, 0xd4, 0x94, 0x9f,
+	0xfb, 0xd7, 0xeb, 0xe8, 0x1d, 0x37, 0x36, 0xb1, 0xe9, 0x1d, 0x57, 0x07, 0xcc, 0xd2, 0x08, 0x40,
+	0x2f, 0x5c, 0x7d, 0xbe, 0xd1, 0x0b, 0x57, 0x93, 0x9b, 0xc5, 0xe1, 0x72, 0x65, 0xe6, 0x7d, 0x98,
+	0x8b, 0xcf, 0x04, 0x85, 0x54, 0xa5, 0x4b, 0x53, 0x5b, 0xa3, 0x10, 0x69, 0xcc, 0x9c, 0xfc, 0x29,
+	0x7f, 0x6a, 0x3c, 0xfa, 0xf6, 0xe7, 0xcf, 0x8b, 0x99, 0x2f, 0x9e, 0x17, 0x33, 0xff, 0x7e, 0x5e,
+	0xcc, 0x7c, 0xf2, 0xa2, 0x78, 0xe3, 0x8b, 0x17, 0xc5, 0x1b, 0xff, 0x78, 0x51, 0xbc, 0xf1, 0xd1,
+	0x1d, 0xed, 0xcd, 0x92, 0x12, 0x3f, 0xd8, 0xc5, 0x24, 0xfc, 0xb7, 0x7a, 0x55, 0xe5, 0xff, 0x67,
+	0x30, 0x7c, 0xb4, 0x6c, 0xe6, 0xc2, 0x67, 0xf6, 0x87, 0xff, 0x1b, 0x00, 0x3d, 0x9c, 0x3a, 0xf7,
+	0x22, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// InitiateDomainVerification starts the domain verification process
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "svc_docs.md"}}
+	InitiateDomainVerification(ctx context.Context, in *MsgInitiateDomainVerification, opts ...grpc.CallOption) (*MsgInitiateDomainVerificationResponse, error)
+	// VerifyDomain completes domain verification by checking DNS TXT records
+	VerifyDomain(ctx context.Context, in *MsgVerifyDomain, opts ...grpc.CallOption) (*MsgVerifyDomainResponse, error)
+	// RegisterService registers a new service with verified domain binding
+	RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error)
+	// ExecOnBehalf executes allow-listed messages as a user, authorized by a
+	// UCAN the user delegated to the submitting service
+	ExecOnBehalf(ctx context.Context, in *MsgExecOnBehalf, opts ...grpc.CallOption) (*MsgExecOnBehalfResponse, error)
+	// SetOnBehalfOptOut refuses delegated execution for a DID
+	SetOnBehalfOptOut(ctx context.Context, in *MsgSetOnBehalfOptOut, opts ...grpc.CallOption) (*MsgSetOnBehalfOptOutResponse, error)
+	// ClearOnBehalfOptOut allows delegated execution for a DID again
+	ClearOnBehalfOptOut(ctx context.Context, in *MsgClearOnBehalfOptOut, opts ...grpc.CallOption) (*MsgClearOnBehalfOptOutResponse, error)
+	// SetFeeSponsorship sets the account and budget a service uses to pay its
+	// users' transaction fees
+	SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorship, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error)
+	// ImportCredentials migrates credentials exported from another relying party
+	// into a service
+	ImportCredentials(ctx context.Context, in *MsgImportCredentials, opts ...grpc.CallOption) (*MsgImportCredentialsResponse, error)
+	// ReregisterCredential links an imported credential to the credential its
+	// user registered with the service
+	ReregisterCredential(ctx context.Context, in *MsgReregisterCredential, opts ...grpc.CallOption) (*MsgReregisterCredentialResponse, error)
+	// SetBadgeIssuer designates an account to issue badges
+	SetBadgeIssuer(ctx context.Context, in *MsgSetBadgeIssuer, opts ...grpc.CallOption) (*MsgSetBadgeIssuerResponse, error)
+	// RemoveBadgeIssuer withdraws an issuer's designation
+	RemoveBadgeIssuer(ctx context.Context, in *MsgRemoveBadgeIssuer, opts ...grpc.CallOption) (*MsgRemoveBadgeIssuerResponse, error)
+	// IssueBadge attests that a service or profile passed a verification
+	IssueBadge(ctx context.Context, in *MsgIssueBadge, opts ...grpc.CallOption) (*MsgIssueBadgeResponse, error)
+	// RevokeBadge withdraws a badge
+	RevokeBadge(ctx context.Context, in *MsgRevokeBadge, opts ...grpc.CallOption) (*MsgRevokeBadgeResponse, error)
+	// SetAppManifest publishes the app metadata of a service
+	SetAppManifest(ctx context.Context, in *MsgSetAppManifest, opts ...grpc.CallOption) (*MsgSetAppManifestResponse, error)
+	// RemoveAppManifest withdraws the app metadata of a service
+	RemoveAppManifest(ctx context.Context, in *MsgRemoveAppManifest, opts ...grpc.CallOption) (*MsgRemoveAppManifestResponse, error)
+	// RegisterOIDCClient registers an OpenID Connect client for a service
+	RegisterOIDCClient(ctx context.Context, in *MsgRegisterOIDCClient, opts ...grpc.CallOption) (*MsgRegisterOIDCClientResponse, error)
+	// RemoveOIDCClient removes an OpenID Connect client of a service
+	RemoveOIDCClient(ctx context.Context, in *MsgRemoveOIDCClient, opts ...grpc.CallOption) (*MsgRemoveOIDCClientResponse, error)
+	// SetAttestationPolicy restricts the authenticators that may register
+	// credentials with a service
+	SetAttestationPolicy(ctx context.Context, in *MsgSetAttestationPolicy, opts ...grpc.CallOption) (*MsgSetAttestationPolicyResponse, error)
+	// RemoveAttestationPolicy lifts the authenticator restrictions of a service
+	RemoveAttestationPolicy(ctx context.Context, in *MsgRemoveAttestationPolicy, opts ...grpc.CallOption) (*MsgRemoveAttestationPolicyResponse, error)
+	// AnchorBackup records the digest of a snapshot of a service's off-chain
+	// dataset
+	AnchorBackup(ctx context.Context, in *MsgAnchorBackup, opts ...grpc.CallOption) (*MsgAnchorBackupResponse, error)
+	// MintViewKey grants a third party read-only access to slices of a DID's
+	// data until the key expires
+	MintViewKey(ctx context.Context, in *MsgMintViewKey, opts ...grpc.CallOption) (*MsgMintViewKeyResponse, error)
+	// RevokeViewKey withdraws a view key before it expires
+	RevokeViewKey(ctx context.Context, in *MsgRevokeViewKey, opts ...grpc.CallOption) (*MsgRevokeViewKeyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateDomainVerification(ctx context.Context, in *MsgInitiateDomainVerification, opts ...grpc.CallOption) (*MsgInitiateDomainVerificationResponse, error) {
+	out := new(MsgInitiateDomainVerificationResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/InitiateDomainVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyDomain(ctx context.Context, in *MsgVerifyDomain, opts ...grpc.CallOption) (*MsgVerifyDomainResponse, error) {
+	out := new(MsgVerifyDomainResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/VerifyDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterService(ctx context.Context, in *MsgRegisterService, opts ...grpc.CallOption) (*MsgRegisterServiceResponse, error) {
+	out := new(MsgRegisterServiceResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RegisterService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecOnBehalf(ctx context.Context, in *MsgExecOnBehalf, opts ...grpc.CallOption) (*MsgExecOnBehalfResponse, error) {
+	out := new(MsgExecOnBehalfResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/ExecOnBehalf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOnBehalfOptOut(ctx context.Context, in *MsgSetOnBehalfOptOut, opts ...grpc.CallOption) (*MsgSetOnBehalfOptOutResponse, error) {
+	out := new(MsgSetOnBehalfOptOutResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/SetOnBehalfOptOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearOnBehalfOptOut(ctx context.Context, in *MsgClearOnBehalfOptOut, opts ...grpc.CallOption) (*MsgClearOnBehalfOptOutResponse, error) {
+	out := new(MsgClearOnBehalfOptOutResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/ClearOnBehalfOptOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorship, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error) {
+	out := new(MsgSetFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/SetFeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ImportCredentials(ctx context.Context, in *MsgImportCredentials, opts ...grpc.CallOption) (*MsgImportCredentialsResponse, error) {
+	out := new(MsgImportCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/ImportCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReregisterCredential(ctx context.Context, in *MsgReregisterCredential, opts ...grpc.CallOption) (*MsgReregisterCredentialResponse, error) {
+	out := new(MsgReregisterCredentialResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/ReregisterCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBadgeIssuer(ctx context.Context, in *MsgSetBadgeIssuer, opts ...grpc.CallOption) (*MsgSetBadgeIssuerResponse, error) {
+	out := new(MsgSetBadgeIssuerResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/SetBadgeIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBadgeIssuer(ctx context.Context, in *MsgRemoveBadgeIssuer, opts ...grpc.CallOption) (*MsgRemoveBadgeIssuerResponse, error) {
+	out := new(MsgRemoveBadgeIssuerResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RemoveBadgeIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IssueBadge(ctx context.Context, in *MsgIssueBadge, opts ...grpc.CallOption) (*MsgIssueBadgeResponse, error) {
+	out := new(MsgIssueBadgeResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/IssueBadge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeBadge(ctx context.Context, in *MsgRevokeBadge, opts ...grpc.CallOption) (*MsgRevokeBadgeResponse, error) {
+	out := new(MsgRevokeBadgeResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RevokeBadge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAppManifest(ctx context.Context, in *MsgSetAppManifest, opts ...grpc.CallOption) (*MsgSetAppManifestResponse, error) {
+	out := new(MsgSetAppManifestResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/SetAppManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAppManifest(ctx context.Context, in *MsgRemoveAppManifest, opts ...grpc.CallOption) (*MsgRemoveAppManifestResponse, error) {
+	out := new(MsgRemoveAppManifestResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RemoveAppManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterOIDCClient(ctx context.Context, in *MsgRegisterOIDCClient, opts ...grpc.CallOption) (*MsgRegisterOIDCClientResponse, error) {
+	out := new(MsgRegisterOIDCClientResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RegisterOIDCClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveOIDCClient(ctx context.Context, in *MsgRemoveOIDCClient, opts ...grpc.CallOption) (*MsgRemoveOIDCClientResponse, error) {
+	out := new(MsgRemoveOIDCClientResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RemoveOIDCClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAttestationPolicy(ctx context.Context, in *MsgSetAttestationPolicy, opts ...grpc.CallOption) (*MsgSetAttestationPolicyResponse, error) {
+	out := new(MsgSetAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/SetAttestationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAttestationPolicy(ctx context.Context, in *MsgRemoveAttestationPolicy, opts ...grpc.CallOption) (*MsgRemoveAttestationPolicyResponse, error) {
+	out := new(MsgRemoveAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RemoveAttestationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AnchorBackup(ctx context.Context, in *MsgAnchorBackup, opts ...grpc.CallOption) (*MsgAnchorBackupResponse, error) {
+	out := new(MsgAnchorBackupResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/AnchorBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintViewKey(ctx context.Context, in *MsgMintViewKey, opts ...grpc.CallOption) (*MsgMintViewKeyResponse, error) {
+	out := new(MsgMintViewKeyResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/MintViewKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeViewKey(ctx context.Context, in *MsgRevokeViewKey, opts ...grpc.CallOption) (*MsgRevokeViewKeyResponse, error) {
+	out := new(MsgRevokeViewKeyResponse)
+	err := c.cc.Invoke(ctx, "/svc.v1.Msg/RevokeViewKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// InitiateDomainVerification starts the domain verification process
+	//
+	// {{.MethodDescriptorProto.Name}} is a call with the method(s) {{$first := true}}{{range .Bindings}}{{if $first}}{{$first = false}}{{else}}, {{end}}{{.HTTPMethod}}{{end}} within the "{{.Service.Name}}" service.
+	// It takes in "{{.RequestType.Name}}" and returns a "{{.ResponseType.Name}}".
+	//
+	// {{import "svc_docs.md"}}
+	InitiateDomainVerification(context.Context, *MsgInitiateDomainVerification) (*MsgInitiateDomainVerificationResponse, error)
+	// VerifyDomain completes domain verification by checking DNS TXT records
+	VerifyDomain(context.Context, *MsgVerifyDomain) (*MsgVerifyDomainResponse, error)
+	// RegisterService registers a new service with verified domain binding
+	RegisterService(context.Context, *MsgRegisterService) (*MsgRegisterServiceResponse, error)
+	// ExecOnBehalf executes allow-listed messages as a user, authorized by a
+	// UCAN the user delegated to the submitting service
+	ExecOnBehalf(context.Context, *MsgExecOnBehalf) (*MsgExecOnBehalfResponse, error)
+	// SetOnBehalfOptOut refuses delegated execution for a DID
+	SetOnBehalfOptOut(context.Context, *MsgSetOnBehalfOptOut) (*MsgSetOnBehalfOptOutResponse, error)
+	// ClearOnBehalfOptOut allows delegated execution for a DID again
+	ClearOnBehalfOptOut(context.Context, *MsgClearOnBehalfOptOut) (*MsgClearOnBehalfOptOutResponse, error)
+	// SetFeeSponsorship sets the account and budget a service uses to pay its
+	// users' transaction fees
+	SetFeeSponsorship(context.Context, *MsgSetFeeSponsorship) (*MsgSetFeeSponsorshipResponse, error)
+	// ImportCredentials migrates credentials exported from another relying party
+	// into a service
+	ImportCredentials(context.Context, *MsgImportCredentials) (*MsgImportCredentialsResponse, error)
+	// ReregisterCredential links an imported credential to the credential its
+	// user registered with the service
+	ReregisterCredential(context.Context, *MsgReregisterCredential) (*MsgReregisterCredentialResponse, error)
+	// SetBadgeIssuer designates an account to issue badges
+	SetBadgeIssuer(context.Context, *MsgSetBadgeIssuer) (*MsgSetBadgeIssuerResponse, error)
+	// RemoveBadgeIssuer withdraws an issuer's designation
+	RemoveBadgeIssuer(context.Context, *MsgRemoveBadgeIssuer) (*MsgRemoveBadgeIssuerResponse, error)
+	// IssueBadge attests that a service or profile passed a verification
+	IssueBadge(context.Context, *MsgIssueBadge) (*MsgIssueBadgeResponse, error)
+	// RevokeBadge withdraws a badge
+	RevokeBadge(context.Context, *MsgRevokeBadge) (*MsgRevokeBadgeResponse, error)
+	// SetAppManifest publishes the app metadata of a service
+	SetAppManifest(context.Context, *MsgSetAppManifest) (*MsgSetAppManifestResponse, error)
+	// RemoveAppManifest withdraws the app metadata of a service
+	RemoveAppManifest(context.Context, *MsgRemoveAppManifest) (*MsgRemoveAppManifestResponse, error)
+	// RegisterOIDCClient registers an OpenID Connect client for a service
+	RegisterOIDCClient(context.Context, *MsgRegisterOIDCClient) (*MsgRegisterOIDCClientResponse, error)
+	// RemoveOIDCClient removes an OpenID Connect client of a service
+	RemoveOIDCClient(context.Context, *MsgRemoveOIDCClient) (*MsgRemoveOIDCClientResponse, error)
+	// SetAttestationPolicy restricts the authenticators that may register
+	// credentials with a service
+	SetAttestationPolicy(context.Context, *MsgSetAttestationPolicy) (*MsgSetAttestationPolicyResponse, error)
+	// RemoveAttestationPolicy lifts the authenticator restrictions of a service
+	RemoveAttestationPolicy(context.Context, *MsgRemoveAttestationPolicy) (*MsgRemoveAttestationPolicyResponse, error)
+	// AnchorBackup records the digest of a snapshot of a service's off-chain
+	// dataset
+	AnchorBackup(context.Context, *MsgAnchorBackup) (*MsgAnchorBackupResponse, error)
+	// MintViewKey grants a third party read-only access to slices of a DID's
+	// data until the key expires
+	MintViewKey(context.Context, *MsgMintViewKey) (*MsgMintViewKeyResponse, error)
+	// RevokeViewKey withdraws a view key before it expires
+	RevokeViewKey(context.Context, *MsgRevokeViewKey) (*MsgRevokeViewKeyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) InitiateDomainVerification(ctx context.Context, req *MsgInitiateDomainVerification) (*MsgInitiateDomainVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDomainVerification not implemented")
+}
+func (*UnimplementedMsgServer) VerifyDomain(ctx context.Context, req *MsgVerifyDomain) (*MsgVerifyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
+func (*UnimplementedMsgServer) RegisterService(ctx context.Context, req *MsgRegisterService) (*MsgRegisterServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterService not implemented")
+}
+func (*UnimplementedMsgServer) ExecOnBehalf(ctx context.Context, req *MsgExecOnBehalf) (*MsgExecOnBehalfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecOnBehalf not implemented")
+}
+func (*UnimplementedMsgServer) SetOnBehalfOptOut(ctx context.Context, req *MsgSetOnBehalfOptOut) (*MsgSetOnBehalfOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnBehalfOptOut not implemented")
+}
+func (*UnimplementedMsgServer) ClearOnBehalfOptOut(ctx context.Context, req *MsgClearOnBehalfOptOut) (*MsgClearOnBehalfOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearOnBehalfOptOut not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeSponsorship(ctx context.Context, req *MsgSetFeeSponsorship) (*MsgSetFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) ImportCredentials(ctx context.Context, req *MsgImportCredentials) (*MsgImportCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCredentials not implemented")
+}
+func (*UnimplementedMsgServer) ReregisterCredential(ctx context.Context, req *MsgReregisterCredential) (*MsgReregisterCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReregisterCredential not implemented")
+}
+func (*UnimplementedMsgServer) SetBadgeIssuer(ctx context.Context, req *MsgSetBadgeIssuer) (*MsgSetBadgeIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBadgeIssuer not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBadgeIssuer(ctx context.Context, req *MsgRemoveBadgeIssuer) (*MsgRemoveBadgeIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBadgeIssuer not implemented")
+}
+func (*UnimplementedMsgServer) IssueBadge(ctx context.Context, req *MsgIssueBadge) (*MsgIssueBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueBadge not implemented")
+}
+func (*UnimplementedMsgServer) RevokeBadge(ctx context.Context, req *MsgRevokeBadge) (*MsgRevokeBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBadge not implemented")
+}
+func (*UnimplementedMsgServer) SetAppManifest(ctx context.Context, req *MsgSetAppManifest) (*MsgSetAppManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppManifest not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAppManifest(ctx context.Context, req *MsgRemoveAppManifest) (*MsgRemoveAppManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppManifest not implemented")
+}
+func (*UnimplementedMsgServer) RegisterOIDCClient(ctx context.Context, req *MsgRegisterOIDCClient) (*MsgRegisterOIDCClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOIDCClient not implemented")
+}
+func (*UnimplementedMsgServer) RemoveOIDCClient(ctx context.Context, req *MsgRemoveOIDCClient) (*MsgRemoveOIDCClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOIDCClient not implemented")
+}
+func (*UnimplementedMsgServer) SetAttestationPolicy(ctx context.Context, req *MsgSetAttestationPolicy) (*MsgSetAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttestationPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAttestationPolicy(ctx context.Context, req *MsgRemoveAttestationPolicy) (*MsgRemoveAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestationPolicy not implemented")
+}
+func (*UnimplementedMsgServer) AnchorBackup(ctx context.Context, req *MsgAnchorBackup) (*MsgAnchorBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorBackup not implemented")
+}
+func (*UnimplementedMsgServer) MintViewKey(ctx context.Context, req *MsgMintViewKey) (*MsgMintViewKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintViewKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokeViewKey(ctx context.Context, req *MsgRevokeViewKey) (*MsgRevokeViewKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeViewKey not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateDomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDomainVerification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDomainVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/InitiateDomainVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDomainVerification(ctx, req.(*MsgInitiateDomainVerification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/VerifyDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyDomain(ctx, req.(*MsgVerifyDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterService)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RegisterService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterService(ctx, req.(*MsgRegisterService))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecOnBehalf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecOnBehalf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecOnBehalf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/ExecOnBehalf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecOnBehalf(ctx, req.(*MsgExecOnBehalf))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOnBehalfOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOnBehalfOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOnBehalfOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/SetOnBehalfOptOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOnBehalfOptOut(ctx, req.(*MsgSetOnBehalfOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearOnBehalfOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearOnBehalfOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearOnBehalfOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/ClearOnBehalfOptOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearOnBehalfOptOut(ctx, req.(*MsgClearOnBehalfOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/SetFeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, req.(*MsgSetFeeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ImportCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgImportCredentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ImportCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/ImportCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ImportCredentials(ctx, req.(*MsgImportCredentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReregisterCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReregisterCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReregisterCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/ReregisterCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReregisterCredential(ctx, req.(*MsgReregisterCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBadgeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBadgeIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBadgeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/SetBadgeIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBadgeIssuer(ctx, req.(*MsgSetBadgeIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBadgeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBadgeIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBadgeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RemoveBadgeIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBadgeIssuer(ctx, req.(*MsgRemoveBadgeIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IssueBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueBadge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/IssueBadge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueBadge(ctx, req.(*MsgIssueBadge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBadge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RevokeBadge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBadge(ctx, req.(*MsgRevokeBadge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAppManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAppManifest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAppManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/SetAppManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAppManifest(ctx, req.(*MsgSetAppManifest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAppManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAppManifest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAppManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RemoveAppManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAppManifest(ctx, req.(*MsgRemoveAppManifest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterOIDCClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RegisterOIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOIDCClient(ctx, req.(*MsgRegisterOIDCClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOIDCClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RemoveOIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOIDCClient(ctx, req.(*MsgRemoveOIDCClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttestationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/SetAttestationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttestationPolicy(ctx, req.(*MsgSetAttestationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAttestationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RemoveAttestationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAttestationPolicy(ctx, req.(*MsgRemoveAttestationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AnchorBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnchorBackup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnchorBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/AnchorBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnchorBackup(ctx, req.(*MsgAnchorBackup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintViewKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintViewKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintViewKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/MintViewKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintViewKey(ctx, req.(*MsgMintViewKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeViewKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeViewKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeViewKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.v1.Msg/RevokeViewKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeViewKey(ctx, req.(*MsgRevokeViewKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "svc.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "InitiateDomainVerification",
+			Handler:    _Msg_InitiateDomainVerification_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _Msg_VerifyDomain_Handler,
+		},
+		{
+			MethodName: "RegisterService",
+			Handler:    _Msg_RegisterService_Handler,
+		},
+		{
+			MethodName: "ExecOnBehalf",
+			Handler:    _Msg_ExecOnBehalf_Handler,
+		},
+		{
+			MethodName: "SetOnBehalfOptOut",
+			Handler:    _Msg_SetOnBehalfOptOut_Handler,
+		},
+		{
+			MethodName: "ClearOnBehalfOptOut",
+			Handler:    _Msg_ClearOnBehalfOptOut_Handler,
+		},
+		{
+			MethodName: "SetFeeSponsorship",
+			Handler:    _Msg_SetFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "ImportCredentials",
+			Handler:    _Msg_ImportCredentials_Handler,
+		},
+		{
+			MethodName: "ReregisterCredential",
+			Handler:    _Msg_ReregisterCredential_Handler,
+		},
+		{
+			MethodName: "SetBadgeIssuer",
+			Handler:    _Msg_SetBadgeIssuer_Handler,
+		},
+		{
+			MethodName: "RemoveBadgeIssuer",
+			Handler:    _Msg_RemoveBadgeIssuer_Handler,
+		},
+		{
+			MethodName: "IssueBadge",
+			Handler:    _Msg_IssueBadge_Handler,
+		},
+		{
+			MethodName: "RevokeBadge",
+			Handler:    _Msg_RevokeBadge_Handler,
+		},
+		{
+			MethodName: "SetAppManifest",
+			Handler:    _Msg_SetAppManifest_Handler,
+		},
+		{
+			MethodName: "RemoveAppManifest",
+			Handler:    _Msg_RemoveAppManifest_Handler,
+		},
+		{
+			MethodName: "RegisterOIDCClient",
+			Handler:    _Msg_RegisterOIDCClient_Handler,
+		},
+		{
+			MethodName: "RemoveOIDCClient",
+			Handler:    _Msg_RemoveOIDCClient_Handler,
+		},
+		{
+			MethodName: "SetAttestationPolicy",
+			Handler:    _Msg_SetAttestationPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveAttestationPolicy",
+			Handler:    _Msg_RemoveAttestationPolicy_Handler,
+		},
+		{
+			MethodName: "AnchorBackup",
+			Handler:    _Msg_AnchorBackup_Handler,
+		},
+		{
+			MethodName: "MintViewKey",
+			Handler:    _Msg_MintViewKey_Handler,
+		},
+		{
+			MethodName: "RevokeViewKey",
+			Handler:    _Msg_RevokeViewKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "svc/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDomainVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDomainVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDomainVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDomainVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDomainVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDomainVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DnsInstruction) > 0 {
+		i -= len(m.DnsInstruction)
+		copy(dAtA[i:], m.DnsInstruction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DnsInstruction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationToken) > 0 {
+		i -= len(m.VerificationToken)
+		copy(dAtA[i:], m.VerificationToken)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UcanDelegationChain) > 0 {
+		i -= len(m.UcanDelegationChain)
+		copy(dAtA[i:], m.UcanDelegationChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UcanDelegationChain)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RequestedPermissions) > 0 {
+		for iNdEx := len(m.RequestedPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestedPermissions[iNdEx])
+			copy(dAtA[i:], m.RequestedPermissions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedPermissions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootCapabilityCid) > 0 {
+		i -= len(m.RootCapabilityCid)
+		copy(dAtA[i:], m.RootCapabilityCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RootCapabilityCid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecOnBehalf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecOnBehalf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecOnBehalf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UcanToken) > 0 {
+		i -= len(m.UcanToken)
+		copy(dAtA[i:], m.UcanToken)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UcanToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceDid) > 0 {
+		i -= len(m.ServiceDid)
+		copy(dAtA[i:], m.ServiceDid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceDid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecOnBehalfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecOnBehalfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecOnBehalfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOnBehalfOptOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOnBehalfOptOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOnBehalfOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceIds) > 0 {
+		for iNdEx := len(m.ServiceIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ServiceIds[iNdEx])
+			copy(dAtA[i:], m.ServiceIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOnBehalfOptOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOnBehalfOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOnBehalfOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearOnBehalfOptOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearOnBehalfOptOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearOnBehalfOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearOnBehalfOptOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearOnBehalfOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearOnBehalfOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgImportCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgImportCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgImportCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailOnCollision {
+		i--
+		if m.FailOnCollision {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Export.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgImportCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgImportCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgImportCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collisions) > 0 {
+		for iNdEx := len(m.Collisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Collisions[iNdEx])
+			copy(dAtA[i:], m.Collisions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Collisions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PendingReregistration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingReregistration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Imported != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReregisterCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReregisterCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReregisterCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCredentialId) > 0 {
+		i -= len(m.NewCredentialId)
+		copy(dAtA[i:], m.NewCredentialId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCredentialId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReregisterCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReregisterCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReregisterCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBadgeIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBadgeIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBadgeIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Issuer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBadgeIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBadgeIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBadgeIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBadgeIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBadgeIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBadgeIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBadgeIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBadgeIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBadgeIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueBadge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueBadge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueBadge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BadgeType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BadgeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueBadgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueBadgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueBadgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBadge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBadge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBadge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BadgeType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BadgeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBadgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBadgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBadgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Manifest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppManifestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppManifestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAppManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAppManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAppManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAppManifestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAppManifestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAppManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterOIDCClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterOIDCClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterOIDCClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RedirectUris) > 0 {
+		for iNdEx := len(m.RedirectUris) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RedirectUris[iNdEx])
+			copy(dAtA[i:], m.RedirectUris[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RedirectUris[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterOIDCClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterOIDCClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterOIDCClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOIDCClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOIDCClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOIDCClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOIDCClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOIDCClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOIDCClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttestationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttestationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttestationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttestationPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttestationPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttestationPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAttestationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAttestationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAttestationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAttestationPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAttestationPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAttestationPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SnapshotAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Dataset) > 0 {
+		i -= len(m.Dataset)
+		copy(dAtA[i:], m.Dataset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dataset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorBackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintViewKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintViewKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintViewKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TtlSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TtlSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintViewKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintViewKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintViewKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ViewKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeViewKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeViewKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeViewKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ViewKeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ViewKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeViewKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeViewKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeViewKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateDomainVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateDomainVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationToken)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DnsInstruction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RequestedPermissions) > 0 {
+		for _, s := range m.RequestedPermissions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.UcanDelegationChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootCapabilityCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecOnBehalf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceDid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UcanToken)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecOnBehalfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetOnBehalfOptOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ServiceIds) > 0 {
+		for _, s := range m.ServiceIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetOnBehalfOptOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearOnBehalfOptOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearOnBehalfOptOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgImportCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Export.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FailOnCollision {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgImportCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Imported != 0 {
+		n += 1 + sovTx(uint64(m.Imported))
+	}
+	if m.PendingReregistration != 0 {
+		n += 1 + sovTx(uint64(m.PendingReregistration))
+	}
+	if len(m.Collisions) > 0 {
+		for _, s := range m.Collisions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReregisterCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewCredentialId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReregisterCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBadgeIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Issuer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBadgeIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBadgeIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBadgeIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIssueBadge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BadgeType != 0 {
+		n += 1 + sovTx(uint64(m.BadgeType))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgIssueBadgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeBadge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BadgeType != 0 {
+		n += 1 + sovTx(uint64(m.BadgeType))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeBadgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAppManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Manifest.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAppManifestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAppManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAppManifestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterOIDCClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RedirectUris) > 0 {
+		for _, s := range m.RedirectUris {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterOIDCClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOIDCClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOIDCClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAttestationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAttestationPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAttestationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAttestationPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAnchorBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Dataset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovTx(uint64(m.RecordCount))
+	}
+	if m.SnapshotAt != 0 {
+		n += 1 + sovTx(uint64(m.SnapshotAt))
+	}
+	return n
+}
+
+func (m *MsgAnchorBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgMintViewKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TtlSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TtlSeconds))
+	}
+	return n
+}
+
+func (m *MsgMintViewKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ViewKey.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRevokeViewKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ViewKeyId != 0 {
+		n += 1 + sovTx(uint64(m.ViewKeyId))
+	}
+	return n
+}
+
+func (m *MsgRevokeViewKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateDomainVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDomainVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDomainVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateDomainVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDomainVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsInstruction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnsInstruction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedPermissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedPermissions = append(m.RequestedPermissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UcanDelegationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UcanDelegationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootCapabilityCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootCapabilityCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecOnBehalf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecOnBehalf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecOnBehalf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UcanToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UcanToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecOnBehalfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecOnBehalfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecOnBehalfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOnBehalfOptOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOnBehalfOptOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOnBehalfOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceIds = append(m.ServiceIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOnBehalfOptOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOnBehalfOptOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOnBehalfOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearOnBehalfOptOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearOnBehalfOptOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearOnBehalfOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearOnBehalfOptOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearOnBehalfOptOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearOnBehalfOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types1.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgImportCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgImportCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgImportCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Export.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailOnCollision", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailOnCollision = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgImportCredentialsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgImportCredentialsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgImportCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			m.Imported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Imported |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReregistration", wireType)
+			}
+			m.PendingReregistration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingReregistration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collisions = append(m.Collisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReregisterCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReregisterCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReregisterCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCredentialId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCredentialId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReregisterCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReregisterCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReregisterCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBadgeIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBadgeIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBadgeIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBadgeIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBadgeIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBadgeIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBadgeIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBadgeIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBadgeIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBadgeIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBadgeIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBadgeIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueBadge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueBadge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueBadge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadgeType", wireType)
+			}
+			m.BadgeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadgeType |= BadgeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueBadgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueBadgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueBadgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeBadge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeBadge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeBadge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadgeType", wireType)
+			}
+			m.BadgeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadgeType |= BadgeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeBadgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeBadgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeBadgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAppManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAppManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAppManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Manifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAppManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAppManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAppManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAppManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAppManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAppManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAppManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAppManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAppManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterOIDCClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterOIDCClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterOIDCClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectUris = append(m.RedirectUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterOIDCClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterOIDCClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.UcanDelegationChain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgRegisterServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RootCapabilityCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ServiceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOIDCClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1245,15 +9920,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOIDCClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOIDCClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1281,13 +9956,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1297,24 +9972,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1337,7 +10011,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,10 +10034,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1387,7 +10061,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDomainVerification) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttestationPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1410,15 +10084,15 @@ func (m *MsgInitiateDomainVerification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDomainVerification: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttestationPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDomainVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttestationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1446,13 +10120,13 @@ func (m *MsgInitiateDomainVerification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1462,23 +10136,24 @@ func (m *MsgInitiateDomainVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1501,7 +10176,7 @@ func (m *MsgInitiateDomainVerification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateDomainVerificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttestationPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1524,15 +10199,65 @@ func (m *MsgInitiateDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateDomainVerificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttestationPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateDomainVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttestationPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAttestationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAttestationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAttestationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1560,11 +10285,11 @@ func (m *MsgInitiateDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationToken = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DnsInstruction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1592,7 +10317,7 @@ func (m *MsgInitiateDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DnsInstruction = string(dAtA[iNdEx:postIndex])
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1615,7 +10340,57 @@ func (m *MsgInitiateDomainVerificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVerifyDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAttestationPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAttestationPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAttestationPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnchorBackup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1634,19 +10409,83 @@ func (m *MsgVerifyDomain) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyDomain: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyDomain: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorBackup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dataset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1674,13 +10513,13 @@ func (m *MsgVerifyDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Dataset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1690,24 +10529,64 @@ func (m *MsgVerifyDomain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotAt", wireType)
+			}
+			m.SnapshotAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1729,7 +10608,7 @@ func (m *MsgVerifyDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVerifyDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAnchorBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,37 +10631,17 @@ func (m *MsgVerifyDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAnchorBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAnchorBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Verified = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1792,24 +10651,11 @@ func (m *MsgVerifyDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1831,7 +10677,7 @@ func (m *MsgVerifyDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
+func (m *MsgMintViewKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,15 +10700,15 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintViewKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintViewKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1890,11 +10736,11 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1922,11 +10768,11 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1954,11 +10800,11 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedPermissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1986,13 +10832,82 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedPermissions = append(m.RequestedPermissions, string(dAtA[iNdEx:postIndex]))
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+			}
+			m.TtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TtlSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintViewKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintViewKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintViewKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UcanDelegationChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2002,23 +10917,24 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UcanDelegationChain = string(dAtA[iNdEx:postIndex])
+			if err := m.ViewKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2041,7 +10957,7 @@ func (m *MsgRegisterService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeViewKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2064,15 +10980,15 @@ func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeViewKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeViewKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootCapabilityCid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2100,11 +11016,11 @@ func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootCapabilityCid = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2132,8 +11048,77 @@ func (m *MsgRegisterServiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKeyId", wireType)
+			}
+			m.ViewKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ViewKeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeViewKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeViewKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeViewKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
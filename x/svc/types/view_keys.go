@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+)
+
+var (
+	// ViewKeysPrefix is the store prefix for view keys by ID
+	ViewKeysPrefix = collections.NewPrefix(2)
+	// ViewKeysByOwnerPrefix is the store prefix for the owner index of view keys
+	ViewKeysByOwnerPrefix = collections.NewPrefix(3)
+	// ViewKeySeqPrefix is the store prefix for the view key ID sequence
+	ViewKeySeqPrefix = collections.NewPrefix(4)
+)
+
+// UCANView is the UCAN action a view key grantee proves when reading data
+const UCANView = "view"
+
+// MaxViewKeyTTL bounds how long a view key may stay valid
+const MaxViewKeyTTL = 365 * 24 * time.Hour
+
+// View key scopes name the read-only data slices a third party may access.
+const (
+	ViewScopeDexHistory   = "dex/history"
+	ViewScopeDexPositions = "dex/positions"
+	ViewScopeBalances     = "bank/balances"
+	ViewScopeDIDDocument  = "did/document"
+	ViewScopeServices     = "svc/services"
+	ViewScopeDWNRecords   = "dwn/records"
+)
+
+// ViewScopes lists every scope a view key may grant
+var ViewScopes = []string{
+	ViewScopeDexHistory,
+	ViewScopeDexPositions,
+	ViewScopeBalances,
+	ViewScopeDIDDocument,
+	ViewScopeServices,
+	ViewScopeDWNRecords,
+}
+
+// IsValidViewScope reports whether scope is a known view key scope
+func IsValidViewScope(scope string) bool {
+	return slices.Contains(ViewScopes, scope)
+}
+
+// CreateViewURI builds the UCAN resource URI for reading a DID's data
+func CreateViewURI(did string) string {
+	return fmt.Sprintf("view:%s", did)
+}
+
+// Validate checks the view key fields
+func (m ViewKey) Validate() error {
+	if m.Owner == "" {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if m.Grantee == "" {
+		return fmt.Errorf("grantee cannot be empty")
+	}
+	if m.Owner == m.Grantee {
+		return fmt.Errorf("owner cannot grant a view key to itself")
+	}
+	if len(m.Scopes) == 0 {
+		return fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range m.Scopes {
+		if !IsValidViewScope(scope) {
+			return fmt.Errorf("invalid view scope: %s", scope)
+		}
+	}
+	if m.ExpiresAt <= m.CreatedAt {
+		return fmt.Errorf("expiry must be after creation")
+	}
+	return nil
+}
+
+// Active reports whether the key is unrevoked and unexpired at now (unix seconds)
+func (m ViewKey) Active(now int64) bool {
+	return !m.Revoked && now < m.ExpiresAt
+}
+
+// Allows reports whether the key grants scope at now (unix seconds)
+func (m ViewKey) Allows(scope string, now int64) bool {
+	return m.Active(now) && slices.Contains(m.Scopes, scope)
+}
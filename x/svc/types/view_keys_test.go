@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sonr-io/sonr/x/svc/types"
+)
+
+func TestViewKeyValidate(t *testing.T) {
+	key := types.ViewKey{
+		Owner:     "did:sonr:alice",
+		Grantee:   "did:sonr:accountant",
+		Scopes:    []string{types.ViewScopeDexHistory},
+		CreatedAt: 100,
+		ExpiresAt: 200,
+	}
+	require.NoError(t, key.Validate())
+
+	self := key
+	self.Grantee = key.Owner
+	require.Error(t, self.Validate())
+
+	unknown := key
+	unknown.Scopes = []string{"dex/write"}
+	require.Error(t, unknown.Validate())
+
+	expired := key
+	expired.ExpiresAt = expired.CreatedAt
+	require.Error(t, expired.Validate())
+}
+
+func TestViewKeyAllows(t *testing.T) {
+	key := types.ViewKey{
+		Owner:     "did:sonr:alice",
+		Grantee:   "did:sonr:accountant",
+		Scopes:    []string{types.ViewScopeDexHistory},
+		CreatedAt: 100,
+		ExpiresAt: 200,
+	}
+
+	require.True(t, key.Allows(types.ViewScopeDexHistory, 150))
+	require.False(t, key.Allows(types.ViewScopeBalances, 150))
+	require.False(t, key.Allows(types.ViewScopeDexHistory, 200))
+
+	key.Revoked = true
+	require.False(t, key.Allows(types.ViewScopeDexHistory, 150))
+}